@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package main generates the OpenAPI document served by the server from the registered routes and the
+// API specifications.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/thunder-id/thunderid/internal/system/apidocs"
+)
+
+func main() {
+	sourceRoot := flag.String("source", ".", "Root directory of the backend module")
+	specDir := flag.String("specs", "../api", "Directory containing the API specifications")
+	output := flag.String("out", "internal/system/apidocs/openapi.json", "File to write the OpenAPI document to")
+	flag.Parse()
+
+	doc, err := apidocs.Generate(*sourceRoot, *specDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to generate the OpenAPI document: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Clean(*output), doc, 0o600); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write the OpenAPI document: %v\n", err)
+		os.Exit(1)
+	}
+}
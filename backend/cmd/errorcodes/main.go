@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package main generates the error code registry served by the server from the service errors declared in
+// the sources.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/thunder-id/thunderid/internal/system/error/errorcode"
+)
+
+func main() {
+	sourceRoot := flag.String("source", ".", "Root directory of the backend module")
+	output := flag.String("out", "internal/system/error/errorcode/error_codes.json",
+		"File to write the error code registry to")
+	flag.Parse()
+
+	registry, err := errorcode.Generate(*sourceRoot)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to generate the error code registry: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Clean(*output), registry, 0o600); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write the error code registry: %v\n", err)
+		os.Exit(1)
+	}
+}
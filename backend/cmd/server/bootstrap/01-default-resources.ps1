@@ -403,6 +403,8 @@ Write-Host ""
 #           └── Action handle "view"       → permission "system:group:view"
 #       └── Resource handle "usertype"      → permission "system:usertype"
 #           └── Action handle "view"       → permission "system:usertype:view"
+#       └── Resource handle "application"  → permission "system:application"
+#           └── Action handle "view"       → permission "system:application:view"
 # ============================================================================
 
 Log-Info "Creating 'system' resource under the system resource server..."
@@ -776,6 +778,86 @@ else {
 
 Write-Host ""
 
+Log-Info "Creating 'application' sub-resource under the 'system' resource..."
+
+if (-not $SYSTEM_RESOURCE_ID) {
+    Log-Error "System resource ID is not available. Cannot create application resource."
+    exit 1
+}
+
+$applicationResourceData = @{
+    name        = "Application"
+    description = "Application resource"
+    handle      = "application"
+    parent      = $SYSTEM_RESOURCE_ID
+} | ConvertTo-Json -Depth 10
+
+$response = Invoke-Api -Method POST -Endpoint "/resource-servers/$SYSTEM_RS_ID/resources" -Data $applicationResourceData
+
+if ($response.StatusCode -eq 201 -or $response.StatusCode -eq 200) {
+    Log-Success "Application resource created successfully (permission: system:application)"
+    $body = $response.Body | ConvertFrom-Json
+    $APPLICATION_RESOURCE_ID = $body.id
+    if ($APPLICATION_RESOURCE_ID) {
+        Log-Info "Application resource ID: $APPLICATION_RESOURCE_ID"
+    }
+    else {
+        Log-Error "Could not extract application resource ID from response"
+        exit 1
+    }
+}
+elseif ($response.StatusCode -eq 409) {
+    Log-Warning "Application resource already exists, retrieving ID..."
+    $response = Invoke-Api -Method GET -Endpoint "/resource-servers/$SYSTEM_RS_ID/resources?parentId=$SYSTEM_RESOURCE_ID"
+
+    if ($response.StatusCode -eq 200) {
+        $body = $response.Body | ConvertFrom-Json
+        $applicationResource = $body.resources | Where-Object { $_.handle -eq "application" } | Select-Object -First 1
+
+        if ($applicationResource) {
+            $APPLICATION_RESOURCE_ID = $applicationResource.id
+            Log-Success "Found application resource ID: $APPLICATION_RESOURCE_ID"
+        }
+        else {
+            Log-Error "Could not find application resource in response"
+            exit 1
+        }
+    }
+    else {
+        Log-Error "Failed to fetch resources (HTTP $($response.StatusCode))"
+        exit 1
+    }
+}
+else {
+    Log-Error "Failed to create application resource (HTTP $($response.StatusCode))"
+    Log-Error "Response: $($response.Body)"
+    exit 1
+}
+
+Log-Info "Creating 'view' action under the 'application' resource..."
+
+$applicationViewActionData = @{
+    name        = "View"
+    description = "Read-only access to applications"
+    handle      = "view"
+} | ConvertTo-Json -Depth 10
+
+$response = Invoke-Api -Method POST -Endpoint "/resource-servers/$SYSTEM_RS_ID/resources/$APPLICATION_RESOURCE_ID/actions" -Data $applicationViewActionData
+
+if ($response.StatusCode -eq 201 -or $response.StatusCode -eq 200) {
+    Log-Success "Application view action created successfully (permission: system:application:view)"
+}
+elseif ($response.StatusCode -eq 409) {
+    Log-Warning "Application view action already exists, skipping"
+}
+else {
+    Log-Error "Failed to create application view action (HTTP $($response.StatusCode))"
+    Log-Error "Response: $($response.Body)"
+    exit 1
+}
+
+Write-Host ""
+
 # ============================================================================
 # Create Administrator Group
 # ============================================================================
@@ -916,9 +998,10 @@ $AUTH_FLOWS_DIR = Join-Path $PSScriptRoot "flows" "authentication"
 $REG_FLOWS_DIR = Join-Path $PSScriptRoot "flows" "registration"
 $USER_ONBOARDING_FLOWS_DIR = Join-Path $PSScriptRoot "flows" "user_onboarding"
 $RECOVERY_FLOWS_DIR = Join-Path $PSScriptRoot "flows" "recovery"
+$MFA_ENROLLMENT_FLOWS_DIR = Join-Path $PSScriptRoot "flows" "mfa_enrollment"
 
 # Check if flows directories exist
-if (-not (Test-Path $AUTH_FLOWS_DIR) -and -not (Test-Path $REG_FLOWS_DIR) -and -not (Test-Path $USER_ONBOARDING_FLOWS_DIR) -and -not (Test-Path $RECOVERY_FLOWS_DIR)) {
+if (-not (Test-Path $AUTH_FLOWS_DIR) -and -not (Test-Path $REG_FLOWS_DIR) -and -not (Test-Path $USER_ONBOARDING_FLOWS_DIR) -and -not (Test-Path $RECOVERY_FLOWS_DIR) -and -not (Test-Path $MFA_ENROLLMENT_FLOWS_DIR)) {
     Log-Warning "Flow definitions directories not found, skipping flow creation"
 }
 else {
@@ -1154,6 +1237,60 @@ else {
         }
     }
 
+    # Process MFA enrollment flows
+    if (Test-Path $MFA_ENROLLMENT_FLOWS_DIR) {
+        $mfaEnrollmentFlowFiles = Get-ChildItem -Path $MFA_ENROLLMENT_FLOWS_DIR -Filter "*.json" -File -ErrorAction SilentlyContinue
+
+        if ($mfaEnrollmentFlowFiles.Count -gt 0) {
+            Log-Info "Processing MFA enrollment flows..."
+
+            # Fetch existing MFA enrollment flows
+            $listResponse = Invoke-Api -Method GET -Endpoint "/flows?flowType=MFA_ENROLLMENT&limit=200"
+
+            # Store existing MFA enrollment flows by handle in a hashtable
+            $existingMFAEnrollmentFlows = @{}
+            if ($listResponse.StatusCode -eq 200) {
+                $listBody = $listResponse.Body | ConvertFrom-Json
+                foreach ($flow in $listBody.flows) {
+                    $existingMFAEnrollmentFlows[$flow.handle] = $flow.id
+                }
+            }
+
+            foreach ($flowFile in $mfaEnrollmentFlowFiles) {
+                $flowCount++
+
+                # Get flow handle and name from file
+                $flowContent = Get-Content -Path $flowFile.FullName -Raw | ConvertFrom-Json
+                $flowHandle = $flowContent.handle
+                $flowName = $flowContent.name
+
+                # Check if flow exists by handle
+                if ($existingMFAEnrollmentFlows.ContainsKey($flowHandle)) {
+                    # Update existing flow
+                    $flowId = $existingMFAEnrollmentFlows[$flowHandle]
+                    Log-Info "Updating existing MFA enrollment flow: $flowName (handle: $flowHandle)"
+                    $result = Update-Flow -FlowId $flowId -FlowFilePath $flowFile.FullName
+                    if ($result) {
+                        $flowSuccess++
+                    }
+                }
+                else {
+                    # Create new flow
+                    $flowId = Create-Flow -FlowFilePath $flowFile.FullName
+                    if ($flowId) {
+                        $flowSuccess++
+                    }
+                    elseif ($flowId -eq "") {
+                        $flowSkipped++
+                    }
+                }
+            }
+        }
+        else {
+            Log-Info "No MFA enrollment flow files found"
+        }
+    }
+
     if ($flowCount -gt 0) {
         Log-Info "Flow creation summary: $flowSuccess created/updated, $flowSkipped skipped, $($flowCount - $flowSuccess - $flowSkipped) failed"
     }
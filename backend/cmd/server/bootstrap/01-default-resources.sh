@@ -389,6 +389,8 @@ echo ""
 #           └── Action handle "view"       → permission "system:group:view"
 #       └── Resource handle "usertype"      → permission "system:usertype"
 #           └── Action handle "view"       → permission "system:usertype:view"
+#       └── Resource handle "application"  → permission "system:application"
+#           └── Action handle "view"       → permission "system:application:view"
 # ============================================================================
 
 log_info "Creating 'system' resource under the system resource server..."
@@ -708,6 +710,74 @@ fi
 
 echo ""
 
+log_info "Creating 'application' sub-resource under the 'system' resource..."
+
+RESPONSE=$(api_call POST "/resource-servers/${SYSTEM_RS_ID}/resources" "{
+  \"name\": \"Application\",
+  \"description\": \"Application resource\",
+  \"handle\": \"application\",
+  \"parent\": \"${SYSTEM_RESOURCE_ID}\"
+}")
+
+HTTP_CODE="${RESPONSE: -3}"
+BODY="${RESPONSE%???}"
+
+if [[ "$HTTP_CODE" == "201" ]] || [[ "$HTTP_CODE" == "200" ]]; then
+    log_success "Application resource created successfully (permission: system:application)"
+    APPLICATION_RESOURCE_ID=$(echo "$BODY" | grep -o '"id":"[^"]*"' | head -1 | cut -d'"' -f4)
+    if [[ -n "$APPLICATION_RESOURCE_ID" ]]; then
+        log_info "Application resource ID: $APPLICATION_RESOURCE_ID"
+    else
+        log_error "Could not extract application resource ID from response"
+        exit 1
+    fi
+elif [[ "$HTTP_CODE" == "409" ]]; then
+    log_warning "Application resource already exists, retrieving ID..."
+    RESPONSE=$(api_call GET "/resource-servers/${SYSTEM_RS_ID}/resources?parentId=${SYSTEM_RESOURCE_ID}")
+    HTTP_CODE="${RESPONSE: -3}"
+    BODY="${RESPONSE%???}"
+
+    if [[ "$HTTP_CODE" == "200" ]]; then
+        APPLICATION_RESOURCE_ID=$(echo "$BODY" | sed 's/},{/}\n{/g' | grep '"handle":"application"' | grep -o '"id":"[^"]*"' | head -1 | cut -d'"' -f4)
+        if [[ -n "$APPLICATION_RESOURCE_ID" ]]; then
+            log_success "Found application resource ID: $APPLICATION_RESOURCE_ID"
+        else
+            log_error "Could not find application resource in response"
+            exit 1
+        fi
+    else
+        log_error "Failed to fetch resources (HTTP $HTTP_CODE)"
+        exit 1
+    fi
+else
+    log_error "Failed to create application resource (HTTP $HTTP_CODE)"
+    echo "Response: $BODY"
+    exit 1
+fi
+
+log_info "Creating 'view' action under the 'application' resource..."
+
+RESPONSE=$(api_call POST "/resource-servers/${SYSTEM_RS_ID}/resources/${APPLICATION_RESOURCE_ID}/actions" '{
+  "name": "View",
+  "description": "Read-only access to applications",
+  "handle": "view"
+}')
+
+HTTP_CODE="${RESPONSE: -3}"
+BODY="${RESPONSE%???}"
+
+if [[ "$HTTP_CODE" == "201" ]] || [[ "$HTTP_CODE" == "200" ]]; then
+    log_success "Application view action created successfully (permission: system:application:view)"
+elif [[ "$HTTP_CODE" == "409" ]]; then
+    log_warning "Application view action already exists, skipping"
+else
+    log_error "Failed to create application view action (HTTP $HTTP_CODE)"
+    echo "Response: $BODY"
+    exit 1
+fi
+
+echo ""
+
 # ============================================================================
 # Create Administrator Group
 # ============================================================================
@@ -843,9 +913,10 @@ AUTH_FLOWS_DIR="${SCRIPT_DIR}/flows/authentication"
 REG_FLOWS_DIR="${SCRIPT_DIR}/flows/registration"
 USER_ONBOARDING_FLOWS_DIR="${SCRIPT_DIR}/flows/user_onboarding"
 RECOVERY_FLOWS_DIR="${SCRIPT_DIR}/flows/recovery"
+MFA_ENROLLMENT_FLOWS_DIR="${SCRIPT_DIR}/flows/mfa_enrollment"
 
 # Check if flows directory exists
-if [[ ! -d "$AUTH_FLOWS_DIR" ]] && [[ ! -d "$REG_FLOWS_DIR" ]] && [[ ! -d "$USER_ONBOARDING_FLOWS_DIR" ]] && [[ ! -d "$RECOVERY_FLOWS_DIR" ]]; then
+if [[ ! -d "$AUTH_FLOWS_DIR" ]] && [[ ! -d "$REG_FLOWS_DIR" ]] && [[ ! -d "$USER_ONBOARDING_FLOWS_DIR" ]] && [[ ! -d "$RECOVERY_FLOWS_DIR" ]] && [[ ! -d "$MFA_ENROLLMENT_FLOWS_DIR" ]]; then
     log_warning "Flow definition directories not found, skipping flow creation"
 else
     FLOW_COUNT=0
@@ -1102,6 +1173,65 @@ else
         fi
     fi
 
+    # Process MFA enrollment flows
+    if [[ -d "$MFA_ENROLLMENT_FLOWS_DIR" ]]; then
+        shopt -s nullglob
+        MFA_ENROLLMENT_FILES=("$MFA_ENROLLMENT_FLOWS_DIR"/*.json)
+        shopt -u nullglob
+
+        if [[ ${#MFA_ENROLLMENT_FILES[@]} -gt 0 ]]; then
+            log_info "Processing MFA enrollment flows..."
+
+            # Fetch existing MFA enrollment flows
+            RESPONSE=$(api_call GET "/flows?flowType=MFA_ENROLLMENT&limit=200")
+            HTTP_CODE="${RESPONSE: -3}"
+            BODY="${RESPONSE%???}"
+
+            # Store existing MFA enrollment flows as "handle|id" pairs
+            EXISTING_MFA_ENROLLMENT_FLOWS=""
+            if [[ "$HTTP_CODE" == "200" ]]; then
+                while IFS= read -r line; do
+                    FLOW_ID=$(echo "$line" | grep -o '"id":"[^"]*"' | cut -d'"' -f4)
+                    FLOW_HANDLE=$(echo "$line" | grep -o '"handle":"[^"]*"' | cut -d'"' -f4)
+                    if [[ -n "$FLOW_ID" ]] && [[ -n "$FLOW_HANDLE" ]]; then
+                        EXISTING_MFA_ENROLLMENT_FLOWS="${EXISTING_MFA_ENROLLMENT_FLOWS}${FLOW_HANDLE}|${FLOW_ID}"$'\n'
+                    fi
+                done < <(echo "$BODY" | grep -o '{[^}]*"id":"[^"]*"[^}]*"handle":"[^"]*"[^}]*}')
+            fi
+
+            for FLOW_FILE in "$MFA_ENROLLMENT_FLOWS_DIR"/*.json; do
+                [[ ! -f "$FLOW_FILE" ]] && continue
+
+                FLOW_COUNT=$((FLOW_COUNT + 1))
+                FLOW_HANDLE=$(grep -o '"handle"[[:space:]]*:[[:space:]]*"[^"]*"' "$FLOW_FILE" | head -1 | sed 's/"handle"[[:space:]]*:[[:space:]]*"\([^"]*\)"/\1/')
+                FLOW_NAME=$(grep -o '"name"[[:space:]]*:[[:space:]]*"[^"]*"' "$FLOW_FILE" | head -1 | sed 's/"name"[[:space:]]*:[[:space:]]*"\([^"]*\)"/\1/')
+
+                # Check if flow exists by handle
+                if echo "$EXISTING_MFA_ENROLLMENT_FLOWS" | grep -q "^${FLOW_HANDLE}|"; then
+                    # Update existing flow
+                    FLOW_ID=$(echo "$EXISTING_MFA_ENROLLMENT_FLOWS" | grep "^${FLOW_HANDLE}|" | cut -d'|' -f2)
+                    log_info "Updating existing MFA enrollment flow: $FLOW_NAME (handle: $FLOW_HANDLE)"
+                    update_flow "$FLOW_ID" "$FLOW_FILE"
+                    RESULT=$?
+                    if [[ $RESULT -eq 0 ]]; then
+                        FLOW_SUCCESS=$((FLOW_SUCCESS + 1))
+                    fi
+                else
+                    # Create new flow
+                    create_flow "$FLOW_FILE"
+                    RESULT=$?
+                    if [[ $RESULT -eq 0 ]]; then
+                        FLOW_SUCCESS=$((FLOW_SUCCESS + 1))
+                    elif [[ $RESULT -eq 2 ]]; then
+                        FLOW_SKIPPED=$((FLOW_SKIPPED + 1))
+                    fi
+                fi
+            done
+        else
+            log_debug "No MFA enrollment flow files found"
+        fi
+    fi
+
     if [[ $FLOW_COUNT -gt 0 ]]; then
         log_info "Flow creation summary: $FLOW_SUCCESS created/updated, $FLOW_SKIPPED skipped, $((FLOW_COUNT - FLOW_SUCCESS - FLOW_SKIPPED)) failed"
     fi
@@ -175,6 +175,11 @@ func loadCertConfig(logger *log.Logger, cfg *config.Config, serverHome string) *
 	if err != nil {
 		logger.Fatal("Failed to load TLS configuration", log.Error(err))
 	}
+
+	// Add the certificates of the custom domains so that they are selected by SNI.
+	if err := pkiservice.AddCustomDomainCertificates(tlsConfig, cfg.CustomDomains, serverHome); err != nil {
+		logger.Fatal("Failed to load custom domain certificates", log.Error(err))
+	}
 	return tlsConfig
 }
 
@@ -184,9 +189,13 @@ func createHTTPServer(logger *log.Logger, cfg *config.Config, mux *http.ServeMux
 	securityMiddleware := createSecurityMiddleware(logger, mux, jwtService)
 
 	// Build the middleware chain with proper execution order.
-	// Request flow: CorrelationID (outermost) -> AccessLog -> Security -> Route Handler (innermost)
+	// Request flow: CorrelationID (outermost) -> ClientIP -> CustomDomain -> RequestCache -> AccessLog ->
+	// Security -> Route Handler (innermost)
 	// Note: Middlewares are wrapped in reverse order - the last added will execute first.
 	handler := log.AccessLogHandler(logger, securityMiddleware)
+	handler = middleware.RequestCacheMiddleware(handler)
+	handler = middleware.CustomDomainMiddleware(handler)
+	handler = middleware.ClientIPMiddleware(handler)
 	handler = middleware.CorrelationIDMiddleware(handler)
 
 	// Build the server address using hostname and port from the configurations.
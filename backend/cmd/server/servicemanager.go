@@ -38,35 +38,50 @@ import (
 	authnOIDC "github.com/thunder-id/thunderid/internal/authn/oidc"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/authz"
 	"github.com/thunder-id/thunderid/internal/cert"
 	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/design/asset"
 	layoutmgt "github.com/thunder-id/thunderid/internal/design/layout/mgt"
 	"github.com/thunder-id/thunderid/internal/design/resolve"
 	thememgt "github.com/thunder-id/thunderid/internal/design/theme/mgt"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/erasure"
 	flowcore "github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/flowanalytics"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	"github.com/thunder-id/thunderid/internal/flow/flowmeta"
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/flow/sandbox"
+	"github.com/thunder-id/thunderid/internal/grantrevocation"
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	"github.com/thunder-id/thunderid/internal/notification"
 	"github.com/thunder-id/thunderid/internal/oauth"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/organization"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/provisioning"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/saml"
+	"github.com/thunder-id/thunderid/internal/signingkey"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/apidocs"
+	"github.com/thunder-id/thunderid/internal/system/backup"
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/errorcode"
 	"github.com/thunder-id/thunderid/internal/system/export"
 	healthcheckservice "github.com/thunder-id/thunderid/internal/system/healthcheck/service"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
@@ -78,6 +93,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/mcp"
 	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
 	"github.com/thunder-id/thunderid/internal/system/services"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/template"
@@ -87,6 +103,9 @@ import (
 // observabilitySvc is the observability service instance. This is used for graceful shutdown.
 var observabilitySvc observability.ObservabilityServiceInterface
 
+// schedulerSvc is the scheduler running the recurring jobs. This is used for graceful shutdown.
+var schedulerSvc scheduler.SchedulerServiceInterface
+
 // registerServices registers all the services with the provided HTTP multiplexer.
 func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterface) jwt.JWTServiceInterface {
 	logger := log.GetLogger()
@@ -126,7 +145,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		logger.Fatal("Failed to initialize system authorization service", log.Error(err))
 	}
 
-	ouService, ouHierarchyResolver, ouExporter, err := ou.Initialize(mux, ouAuthzService)
+	ouService, ouHierarchyResolver, ouExporter, err := ou.Initialize(mux, ouAuthzService, cacheManager)
 	if err != nil {
 		logger.Fatal("Failed to initialize OrganizationUnitService", log.Error(err))
 	}
@@ -147,7 +166,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 
 	// Initialize consent service
-	consentService := consent.Initialize()
+	consentService := consent.Initialize(mux)
 
 	// Initialize user type service
 	entityTypeService, entityTypeExporter, err := entitytype.Initialize(
@@ -163,6 +182,16 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		logger.Fatal("Failed to initialize EntityService", log.Error(err))
 	}
 
+	// Initialize the outbound provisioning. The entity service is decorated to queue the user lifecycle changes
+	// to be pushed to the provisioning targets, which is done by a scheduled job.
+	provisioningService, entityService, provisioningJob := provisioning.Initialize(mux, entityService)
+
+	// Initialize the SSO sessions and the grant revocation. The entity service is decorated to revoke the
+	// refresh tokens, authorization codes and SSO sessions of a user when the user is deleted or disabled, or
+	// when the credentials of the user are updated.
+	ssoSessionService := ssosession.Initialize(mux)
+	grantRevocationService, entityService := grantrevocation.Initialize(entityService, ssoSessionService)
+
 	// Initialize entity provider
 	entityProvider := entityprovider.InitializeEntityProvider(entityService)
 
@@ -176,6 +205,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	groupService, ouGroupResolver, groupExporter, err := group.Initialize(
 		mux, dbprovider.GetDBProvider(), ouService, entityService, entityTypeService, ouAuthzService,
+		provisioningService,
 	)
 	if err != nil {
 		logger.Fatal("Failed to initialize GroupService", log.Error(err))
@@ -200,27 +230,43 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	exporters = append(exporters, roleExporter)
 	authZService := authz.Initialize(roleService)
 
-	idpService, idpExporter, err := idp.Initialize(cacheManager, mux)
+	// Initialize the scope management service.
+	scopeService, scopeValidator := scope.Initialize(mux, resourceService)
+
+	// Initialize MCP server
+	mcpServer, toolCallAuditSvc := mcp.Initialize(mux, jwtService)
+
+	idpService, idpExporter, err := idp.Initialize(cacheManager, mux, mcpServer)
 	if err != nil {
 		logger.Fatal("Failed to initialize IDPService", log.Error(err))
 	}
 	exporters = append(exporters, idpExporter)
 
-	templateService, err := template.Initialize()
+	organizationService, err := organization.Initialize(mux, ouService, idpService)
+	if err != nil {
+		logger.Fatal("Failed to initialize OrganizationService", log.Error(err))
+	}
+
+	templateService, err := template.Initialize(mux, i18nService)
 	if err != nil {
 		logger.Fatal("Failed to initialize template service", log.Error(err))
 	}
 
-	_, otpService, notifSenderSvc, notificationExporter, err := notification.Initialize(
-		mux, jwtService, templateService)
+	var emailClient email.EmailClientInterface
+	emailClient, err = email.Initialize()
+	if err != nil {
+		logger.Debug("Email client not configured. "+
+			"EmailExecutor will be registered but will not send emails.", log.Error(err))
+		emailClient = nil
+	}
+
+	_, otpService, notifSenderSvc, _, sendAuditSvc, pushDeviceSvc, notificationExporter, err := notification.Initialize(
+		mux, jwtService, templateService, emailClient)
 	if err != nil {
 		logger.Fatal("Failed to initialize NotificationService", log.Error(err))
 	}
 	exporters = append(exporters, notificationExporter)
 
-	// Initialize MCP server
-	mcpServer := mcp.Initialize(mux, jwtService)
-
 	// Initialize passkey service
 	passkeyService := passkey.Initialize(entityService)
 
@@ -230,6 +276,9 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Initialize otp core service
 	otpCoreService := otp.Initialize(otpService, entityProvider)
 
+	// Initialize totp service
+	totpService := totp.Initialize(entityService, configCryptoSvc)
+
 	// Initialize federated authentication services.
 	oauthAuthnService := authnOAuth.Initialize(idpService, entityProvider)
 	oidcAuthnService := authnOIDC.Initialize(oauthAuthnService, jwtService)
@@ -258,21 +307,14 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	// Initialize flow and executor services.
 	flowFactory, graphCache := flowcore.Initialize(cacheManager)
-	var emailClient email.EmailClientInterface
-	emailClient, err = email.Initialize()
-	if err != nil {
-		logger.Debug("Email client not configured. "+
-			"EmailExecutor will be registered but will not send emails.", log.Error(err))
-		emailClient = nil
-	}
 	execRegistry := executor.Initialize(flowFactory, ouService, idpService, notifSenderSvc, jwtService, authAssertGen,
-		consentEnforcer, authnProvider, otpCoreService, passkeyService, magicLinkService, authZService,
+		consentEnforcer, authnProvider, otpCoreService, passkeyService, magicLinkService, totpService, authZService,
 		entityTypeService, groupService, roleService, roleAssignmentService, entityProvider,
-		attributeCacheService, emailClient, templateService, oauthAuthnService, oidcAuthnService,
-		githubAuthnService, googleAuthnService)
+		attributeCacheService, emailClient, sendAuditSvc, templateService, oauthAuthnService, oidcAuthnService,
+		githubAuthnService, googleAuthnService, resourceService, scopeService, organizationService)
 
 	flowMgtService, flowMgtExporter, err := flowmgt.Initialize(
-		mux, mcpServer, cacheManager, flowFactory, execRegistry, graphCache)
+		mux, mcpServer, cacheManager, flowFactory, execRegistry, graphCache, idpService)
 	if err != nil {
 		logger.Fatal("Failed to initialize FlowMgtService", log.Error(err))
 	}
@@ -295,6 +337,10 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 	exporters = append(exporters, layoutExporter)
 
+	if _, err := asset.Initialize(mux); err != nil {
+		logger.Fatal("Failed to initialize DesignAssetService", log.Error(err))
+	}
+
 	inboundClientService, err := inboundclient.Initialize(
 		cacheManager, certservice, entityProvider,
 		themeMgtService, layoutMgtService, flowMgtService, entityTypeService, consentService)
@@ -315,16 +361,17 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 
 	// Initialize design resolve service for theme and layout resolution
-	designResolveService := resolve.Initialize(mux, themeMgtService, layoutMgtService, applicationService)
+	designResolveService := resolve.Initialize(
+		mux, themeMgtService, layoutMgtService, applicationService, ouService)
 
 	// Initialize flow metadata service
 	_ = flowmeta.Initialize(mux, inboundClientService, entityProvider, ouService, designResolveService, i18nService)
 
 	// Initialize export service with collected exporters
-	_ = export.Initialize(mux, exporters)
+	exportService := export.Initialize(mux, exporters)
 
 	// Initialize import service
-	_ = importer.Initialize(
+	importService := importer.Initialize(
 		mux,
 		applicationService,
 		idpService,
@@ -341,20 +388,60 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		i18nService,
 	)
 
+	// Initialize backup service on top of the export and import services.
+	_ = backup.Initialize(mux, exportService, importService, pkiService)
+
+	flowAnalyticsService := flowanalytics.Initialize(mux, flowMgtService)
 	flowExecService, err := flowexec.Initialize(mux, flowMgtService, inboundClientService, entityProvider,
-		execRegistry, observabilitySvc, runtimeCryptoSvc)
+		execRegistry, observabilitySvc, flowAnalyticsService, runtimeCryptoSvc)
 	if err != nil {
 		logger.Fatal("Failed to initialize flow execution service", log.Error(err))
 	}
 
+	// Initialize sandbox flow execution.
+	sandboxRuntimeFactory := sandbox.Initialize(flowFactory, ouService, idpService, jwtService, authAssertGen,
+		consentEnforcer, authZService, entityTypeService, groupService, roleService, roleAssignmentService,
+		attributeCacheService, sendAuditSvc, templateService, hashService, resourceService, scopeService,
+		organizationService, configCryptoSvc)
+	_ = flowexec.InitializeSandbox(mux, flowMgtService, sandboxRuntimeFactory, inboundClientService, entityProvider,
+		observabilitySvc)
+
 	// Initialize OAuth services.
 	err = oauth.Initialize(mux, applicationService, inboundClientService, authnProvider, jwtService, jweService,
 		flowExecService, observabilitySvc, pkiService, ouService, attributeCacheService, authZService, entityProvider,
-		resourceService, i18nService, idpService)
+		resourceService, scopeService, scopeValidator, i18nService, idpService, notifSenderSvc, ssoSessionService,
+		grantRevocationService)
 	if err != nil {
 		logger.Fatal("Failed to initialize OAuth services", log.Error(err))
 	}
 
+	// Initialize the SAML identity provider.
+	saml.Initialize(mux, flowExecService, jwtService, attributeCacheService, pkiService)
+
+	// Initialize the erasure request API. The erasure requests are processed by a scheduled job.
+	_, erasureJob := erasure.Initialize(mux, userService, groupService, roleAssignmentService, consentService,
+		ssoSessionService, grantRevocationService, pushDeviceSvc, sendAuditSvc, toolCallAuditSvc)
+
+	// Initialize the signing key API. The retired signing keys are deleted by a scheduled job.
+	_, signingKeyJob, err := signingkey.Initialize(mux, pkiService, configCryptoSvc)
+	if err != nil {
+		logger.Fatal("Failed to initialize the signing key service", log.Error(err))
+	}
+
+	// Start the scheduler running the recurring jobs.
+	schedulerSvc, err = scheduler.Initialize(mux, erasureJob, provisioningJob, signingKeyJob)
+	if err != nil {
+		logger.Fatal("Failed to initialize the scheduler", log.Error(err))
+	}
+
+	// Serve the OpenAPI document of the server.
+	if err := apidocs.Initialize(mux); err != nil {
+		logger.Fatal("Failed to initialize the API documentation", log.Error(err))
+	}
+	if err := errorcode.Initialize(mux); err != nil {
+		logger.Fatal("Failed to initialize the error code registry", log.Error(err))
+	}
+
 	// Register the health service.
 	healthSvc := healthcheckservice.Initialize(dbprovider.GetDBProvider(), dbprovider.GetRedisProvider())
 	services.NewHealthCheckService(mux, healthSvc)
@@ -364,6 +451,9 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 // unregisterServices unregisters all services that require cleanup during shutdown.
 func unregisterServices() {
+	if schedulerSvc != nil {
+		schedulerSvc.Shutdown()
+	}
 	observabilitySvc.Shutdown()
 }
 
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	backupPath  = "/backup"
+	restorePath = "/backup/restore"
+)
+
+// restoreRequest is the request body of the restore API.
+type restoreRequest struct {
+	Snapshot  json.RawMessage        `json:"snapshot"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	DryRun    bool                   `json:"dryRun,omitempty"`
+}
+
+// restoreResponse is the part of the restore API response used to report failed resources and keys that
+// are not available on the server.
+type restoreResponse struct {
+	Summary *struct {
+		Failed int `json:"failed"`
+	} `json:"summary"`
+	Keys []struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	} `json:"keys"`
+}
+
+// restoreOptions holds the flags of the backup restore sub command.
+type restoreOptions struct {
+	file    string
+	envFile string
+	dryRun  bool
+}
+
+// backupCommand returns the commands for backing up and restoring the configuration of the server.
+func backupCommand(c *cli) *cobra.Command {
+	var file string
+	createCmd := &cobra.Command{
+		Use:   "create [--file <file>]",
+		Short: "Create a snapshot of the configuration",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return createBackup(cmd.Context(), c, file)
+		},
+	}
+	createCmd.Flags().StringVar(&file, "file", "", "File to write the snapshot to, instead of stdout")
+
+	var opts restoreOptions
+	restoreCmd := &cobra.Command{
+		Use:   "restore -f <file> [--env-file <file>] [--dry-run]",
+		Short: "Restore a snapshot into the server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return restoreBackup(cmd.Context(), c, opts)
+		},
+	}
+	restoreCmd.Flags().StringVarP(&opts.file, "file", "f", "", "File with the snapshot, or - to read from stdin")
+	restoreCmd.Flags().StringVar(&opts.envFile, "env-file", "",
+		"File with values that override the template variables recorded in the snapshot")
+	restoreCmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Validate the snapshot without restoring it")
+
+	return newGroupCommand("backup", "Back up and restore the configuration of the server", nil,
+		createCmd, restoreCmd)
+}
+
+func createBackup(ctx context.Context, c *cli, file string) error {
+	data, err := c.client.doJSON(ctx, http.MethodPost, backupPath, nil, nil)
+	if err != nil {
+		return err
+	}
+	if file == "" {
+		return printJSON(c.stdout, data)
+	}
+
+	if err := os.WriteFile(filepath.Clean(file), data, filePerm); err != nil {
+		return fmt.Errorf("failed to write %s: %w", file, err)
+	}
+	if c.output == outputJSON {
+		return nil
+	}
+	_, err = fmt.Fprintf(c.stdout, "Wrote the snapshot to %s\n", file)
+	return err
+}
+
+func restoreBackup(ctx context.Context, c *cli, opts restoreOptions) error {
+	if err := requiredFlag("file", opts.file); err != nil {
+		return err
+	}
+
+	snapshot, err := readFile(c, opts.file)
+	if err != nil {
+		return err
+	}
+	if !json.Valid(snapshot) {
+		return fmt.Errorf("%s is not a valid snapshot", opts.file)
+	}
+	req := restoreRequest{Snapshot: snapshot, DryRun: opts.dryRun}
+	if opts.envFile != "" {
+		envContent, err := readFile(c, opts.envFile)
+		if err != nil {
+			return err
+		}
+		req.Variables = parseEnvFile(envContent)
+	}
+
+	data, err := c.client.doJSON(ctx, http.MethodPost, restorePath, nil, req)
+	if err != nil {
+		return err
+	}
+	if err := c.printResult(data, "results",
+		[]string{"resourceType", "resourceName", "operation", "status", "message"}); err != nil {
+		return err
+	}
+
+	var resp restoreResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return fmt.Errorf("failed to parse the restore response: %w", err)
+	}
+	for _, key := range resp.Keys {
+		if key.Status != "present" {
+			fmt.Fprintf(c.stderr, "Warning: signing key %q of the snapshot is %s on the server\n", key.ID, key.Status)
+		}
+	}
+	if resp.Summary != nil && resp.Summary.Failed > 0 {
+		return fmt.Errorf("%d resource(s) failed to restore", resp.Summary.Failed)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultBootstrapDir = "./bootstrap"
+	commonScriptName    = "common.sh"
+	statusSuccess       = "SUCCESS"
+	statusFailed        = "FAILED"
+	statusSkipped       = "SKIPPED"
+)
+
+// bootstrapResult is the outcome of a single bootstrap script.
+type bootstrapResult struct {
+	Script     string `json:"script"`
+	Status     string `json:"status"`
+	DurationMs int64  `json:"durationMs"`
+	Error      string `json:"error,omitempty"`
+}
+
+// bootstrapOptions configures a bootstrap run.
+type bootstrapOptions struct {
+	dir                string
+	skipPattern        *regexp.Regexp
+	onlyPattern        *regexp.Regexp
+	failFast           bool
+	publicURL          string
+	systemRSHandle     string
+	systemRSIdentifier string
+}
+
+// bootstrapFlags holds the flags of the bootstrap run sub command.
+type bootstrapFlags struct {
+	dir                string
+	skip               string
+	only               string
+	failFast           bool
+	publicURL          string
+	systemRSHandle     string
+	systemRSIdentifier string
+}
+
+// bootstrapCommand returns the command that runs the bootstrap scripts against the server.
+func bootstrapCommand(c *cli) *cobra.Command {
+	var flags bootstrapFlags
+	runCmd := &cobra.Command{
+		Use:   "run [options]",
+		Short: "Run the bootstrap scripts in file name order",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runBootstrap(cmd.Context(), c, flags)
+		},
+	}
+	runCmd.Flags().StringVar(&flags.dir, "dir", defaultBootstrapDir, "Directory containing the bootstrap scripts")
+	runCmd.Flags().StringVar(&flags.skip, "skip", "",
+		"Skip the scripts whose file names match this regular expression")
+	runCmd.Flags().StringVar(&flags.only, "only", "",
+		"Run only the scripts whose file names match this regular expression")
+	runCmd.Flags().BoolVar(&flags.failFast, "fail-fast", true, "Stop at the first failing script")
+	runCmd.Flags().StringVar(&flags.publicURL, "public-url", "",
+		"Public URL of the server. Defaults to the server URL")
+	runCmd.Flags().StringVar(&flags.systemRSHandle, "system-rs-handle", "", "Handle of the system resource server")
+	runCmd.Flags().StringVar(&flags.systemRSIdentifier, "system-rs-identifier", "",
+		"Identifier of the system resource server")
+
+	return newGroupCommand("bootstrap", "Run the bootstrap scripts against the server", nil, runCmd)
+}
+
+func runBootstrap(ctx context.Context, c *cli, flags bootstrapFlags) error {
+	opts := bootstrapOptions{
+		dir:                flags.dir,
+		failFast:           flags.failFast,
+		publicURL:          flags.publicURL,
+		systemRSHandle:     flags.systemRSHandle,
+		systemRSIdentifier: flags.systemRSIdentifier,
+	}
+	if opts.publicURL == "" {
+		opts.publicURL = c.server
+	}
+	var err error
+	if opts.skipPattern, err = compilePattern(flags.skip); err != nil {
+		return err
+	}
+	if opts.onlyPattern, err = compilePattern(flags.only); err != nil {
+		return err
+	}
+
+	results, err := executeBootstrapScripts(ctx, c, opts)
+	if err != nil {
+		return err
+	}
+	if printErr := printBootstrapResults(c, results); printErr != nil {
+		return printErr
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Status == statusFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d bootstrap script(s) failed", failed)
+	}
+	return nil
+}
+
+// executeBootstrapScripts runs the bootstrap scripts of the directory in file name order, in the same way
+// as the setup script of the distribution. The output of the scripts is written to the error output so
+// that the standard output only carries the results.
+func executeBootstrapScripts(ctx context.Context, c *cli, opts bootstrapOptions) ([]bootstrapResult, error) {
+	scripts, err := findBootstrapScripts(opts.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	env := append(os.Environ(),
+		"API_BASE="+c.server,
+		"PUBLIC_URL="+strings.TrimSuffix(opts.publicURL, "/"),
+		"SYSTEM_RS_HANDLE="+opts.systemRSHandle,
+		"SYSTEM_RS_IDENTIFIER="+opts.systemRSIdentifier,
+	)
+
+	results := make([]bootstrapResult, 0, len(scripts))
+	for _, script := range scripts {
+		name := filepath.Base(script)
+		if (opts.skipPattern != nil && opts.skipPattern.MatchString(name)) ||
+			(opts.onlyPattern != nil && !opts.onlyPattern.MatchString(name)) {
+			results = append(results, bootstrapResult{Script: name, Status: statusSkipped})
+			continue
+		}
+
+		start := time.Now()
+		// #nosec G204 -- The scripts are selected by the user from the bootstrap directory.
+		cmd := exec.CommandContext(ctx, "bash", script)
+		cmd.Env = env
+		cmd.Stdout = c.stderr
+		cmd.Stderr = c.stderr
+		runErr := cmd.Run()
+
+		result := bootstrapResult{
+			Script:     name,
+			Status:     statusSuccess,
+			DurationMs: time.Since(start).Milliseconds(),
+		}
+		if runErr != nil {
+			result.Status = statusFailed
+			result.Error = runErr.Error()
+		}
+		results = append(results, result)
+
+		if runErr != nil && opts.failFast {
+			break
+		}
+	}
+	return results, nil
+}
+
+// findBootstrapScripts returns the shell scripts of the bootstrap directory sorted by file name, leaving
+// out the shared helper script.
+func findBootstrapScripts(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the bootstrap directory: %w", err)
+	}
+
+	scripts := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || name == commonScriptName {
+			continue
+		}
+		if strings.HasSuffix(name, ".sh") || strings.HasSuffix(name, ".bash") {
+			scripts = append(scripts, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(scripts)
+	return scripts, nil
+}
+
+// printBootstrapResults writes the outcome of the bootstrap scripts in the selected output format.
+func printBootstrapResults(c *cli, results []bootstrapResult) error {
+	data, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	return c.printResult(data, "", []string{"script", "status", "durationMs", "error"})
+}
+
+// compilePattern compiles an optional regular expression.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
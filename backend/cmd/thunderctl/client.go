@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const requestTimeout = 60 * time.Second
+
+// apiClient calls the ThunderID admin APIs.
+type apiClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// apiError is an error response returned by the server.
+type apiError struct {
+	StatusCode  int
+	Code        string
+	Message     string
+	Description string
+}
+
+// Error returns the error message, including the server error code and description when available.
+func (e *apiError) Error() string {
+	msg := fmt.Sprintf("request failed with status %d", e.StatusCode)
+	if e.Code != "" {
+		msg += " (" + e.Code + ")"
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+	return msg
+}
+
+// errorResponse is the error body returned by the server. The message and description are either plain
+// strings or localizable messages carrying a default value.
+type errorResponse struct {
+	Code        string          `json:"code"`
+	Message     json.RawMessage `json:"message"`
+	Description json.RawMessage `json:"description"`
+}
+
+// newAPIClient creates a client for the server at the given base URL.
+func newAPIClient(baseURL, token string, insecure bool) *apiClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		// #nosec G402 -- Explicitly requested by the user for servers using self-signed certificates.
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true, MinVersion: tls.VersionTLS12}
+	}
+	return &apiClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		httpClient: &http.Client{
+			Timeout:   requestTimeout,
+			Transport: transport,
+		},
+	}
+}
+
+// doJSON sends a request with an optional JSON body and returns the raw JSON response body.
+func (c *apiClient) doJSON(ctx context.Context, method, path string, query url.Values,
+	body interface{}) (json.RawMessage, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	respBody, err := c.do(ctx, method, path, query, reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		return nil, nil
+	}
+	if !json.Valid(respBody) {
+		return nil, fmt.Errorf("server returned an invalid JSON response")
+	}
+	return respBody, nil
+}
+
+// do sends a request and returns the response body, converting error responses into an apiError.
+func (c *apiClient) do(ctx context.Context, method, path string, query url.Values,
+	body io.Reader) ([]byte, error) {
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s %s: %w", method, path, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response: %w", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, parseAPIError(resp.StatusCode, respBody)
+	}
+	return respBody, nil
+}
+
+// parseAPIError builds an apiError from an error response body.
+func parseAPIError(statusCode int, body []byte) *apiError {
+	apiErr := &apiError{StatusCode: statusCode}
+	var errResp errorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		apiErr.Message = strings.TrimSpace(string(body))
+		return apiErr
+	}
+	apiErr.Code = errResp.Code
+	apiErr.Message = readMessage(errResp.Message)
+	apiErr.Description = readMessage(errResp.Description)
+	return apiErr
+}
+
+// readMessage reads an error message that is either a plain string or a localizable message.
+func readMessage(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+	var msg struct {
+		DefaultValue string `json:"defaultValue"`
+	}
+	if err := json.Unmarshal(raw, &msg); err == nil {
+		return msg.DefaultValue
+	}
+	return ""
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	exportPath = "/export"
+	importPath = "/import"
+	filePerm   = 0o600
+)
+
+// exportRequest is the request body of the export API.
+type exportRequest struct {
+	Flows   []string       `json:"flows"`
+	Options *exportOptions `json:"options,omitempty"`
+}
+
+// exportOptions are the options of the export API.
+type exportOptions struct {
+	IncludeDependencies bool `json:"includeDependencies,omitempty"`
+}
+
+// exportResponse is the response body of the export API.
+type exportResponse struct {
+	Resources            string `json:"resources"`
+	EnvironmentVariables string `json:"environment_variables"`
+}
+
+// importRequest is the request body of the import API.
+type importRequest struct {
+	Content   string                 `json:"content"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	DryRun    bool                   `json:"dryRun,omitempty"`
+}
+
+// importResponse is the part of the import API response used to detect failed resources.
+type importResponse struct {
+	Summary *struct {
+		Failed int `json:"failed"`
+	} `json:"summary"`
+}
+
+// flowTransferOptions holds the flags of the flow export and import sub commands.
+type flowTransferOptions struct {
+	file     string
+	envFile  string
+	withDeps bool
+	dryRun   bool
+}
+
+// flowTransferCommands returns the flow sub commands that move flows between servers. They are added to
+// the generic flow resource commands.
+func flowTransferCommands(c *cli) []*cobra.Command {
+	var exportOpts, importOpts flowTransferOptions
+
+	exportCmd := &cobra.Command{
+		Use:   "export [--file <file>] [--env-file <file>] [--include-dependencies] <id>...",
+		Short: "Export flows as declarative resources",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return exportFlows(cmd.Context(), c, exportOpts, args)
+		},
+	}
+	exportCmd.Flags().StringVar(&exportOpts.file, "file", "",
+		"File to write the exported resources to, instead of stdout")
+	exportCmd.Flags().StringVar(&exportOpts.envFile, "env-file", "",
+		"File to write the template variables of the export to")
+	exportCmd.Flags().BoolVar(&exportOpts.withDeps, "include-dependencies", false,
+		"Also export the resources the flows depend on")
+
+	importCmd := &cobra.Command{
+		Use:   "import -f <file> [--env-file <file>] [--dry-run]",
+		Short: "Import flows from declarative resources",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return importFlows(cmd.Context(), c, importOpts)
+		},
+	}
+	importCmd.Flags().StringVarP(&importOpts.file, "file", "f", "",
+		"File with the exported resources, or - to read from stdin")
+	importCmd.Flags().StringVar(&importOpts.envFile, "env-file", "",
+		"File with the values of the template variables used in the resources")
+	importCmd.Flags().BoolVar(&importOpts.dryRun, "dry-run", false, "Validate the resources without importing them")
+
+	return []*cobra.Command{exportCmd, importCmd}
+}
+
+func exportFlows(ctx context.Context, c *cli, opts flowTransferOptions, flowIDs []string) error {
+	req := exportRequest{Flows: flowIDs}
+	if opts.withDeps {
+		req.Options = &exportOptions{IncludeDependencies: true}
+	}
+	data, err := c.client.doJSON(ctx, http.MethodPost, exportPath, nil, req)
+	if err != nil {
+		return err
+	}
+
+	var resp exportResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return fmt.Errorf("failed to parse the export response: %w", err)
+	}
+	if opts.envFile != "" {
+		if err := os.WriteFile(filepath.Clean(opts.envFile), []byte(resp.EnvironmentVariables), filePerm); err != nil {
+			return fmt.Errorf("failed to write %s: %w", opts.envFile, err)
+		}
+	}
+	if opts.file != "" {
+		if err := os.WriteFile(filepath.Clean(opts.file), []byte(resp.Resources), filePerm); err != nil {
+			return fmt.Errorf("failed to write %s: %w", opts.file, err)
+		}
+		if c.output == outputJSON {
+			return nil
+		}
+		_, err := fmt.Fprintf(c.stdout, "Exported %d flow(s) to %s\n", len(flowIDs), opts.file)
+		return err
+	}
+
+	if c.output == outputJSON {
+		return printJSON(c.stdout, data)
+	}
+	_, err = fmt.Fprintln(c.stdout, resp.Resources)
+	return err
+}
+
+func importFlows(ctx context.Context, c *cli, opts flowTransferOptions) error {
+	if err := requiredFlag("file", opts.file); err != nil {
+		return err
+	}
+
+	content, err := readFile(c, opts.file)
+	if err != nil {
+		return err
+	}
+	req := importRequest{Content: string(content), DryRun: opts.dryRun}
+	if opts.envFile != "" {
+		envContent, err := readFile(c, opts.envFile)
+		if err != nil {
+			return err
+		}
+		req.Variables = parseEnvFile(envContent)
+	}
+
+	data, err := c.client.doJSON(ctx, http.MethodPost, importPath, nil, req)
+	if err != nil {
+		return err
+	}
+	if err := c.printResult(data, "results",
+		[]string{"resourceType", "resourceName", "operation", "status", "message"}); err != nil {
+		return err
+	}
+
+	var resp importResponse
+	if err := json.Unmarshal(data, &resp); err == nil && resp.Summary != nil && resp.Summary.Failed > 0 {
+		return fmt.Errorf("%d resource(s) failed to import", resp.Summary.Failed)
+	}
+	return nil
+}
+
+// readFile reads a file, or stdin when the file is "-".
+func readFile(c *cli, file string) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if file == "-" {
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(c.stdin)
+		data = buf.Bytes()
+	} else {
+		data, err = os.ReadFile(filepath.Clean(file))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	return data, nil
+}
+
+// parseEnvFile parses KEY=VALUE lines, as written by the export API, into import variables. Blank lines
+// and comments are ignored.
+func parseEnvFile(content []byte) map[string]interface{} {
+	variables := make(map[string]interface{})
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		variables[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return variables
+}
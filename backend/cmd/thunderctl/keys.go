@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	signingKeysPath      = "/signing-keys"
+	rotateSigningKeyPath = "/signing-keys/rotate"
+)
+
+// signingKeyColumns are the columns printed for the signing keys of the server.
+var signingKeyColumns = []string{"id", "kid", "algorithm", "status", "source", "retireAt"}
+
+// rotateKeyRequest is the request body of the signing key rotation API.
+type rotateKeyRequest struct {
+	Algorithm      string `json:"algorithm,omitempty"`
+	RolloverWindow *int64 `json:"rolloverWindow,omitempty"`
+}
+
+// keysCommand returns the commands for managing the signing keys of the server.
+func keysCommand(c *cli) *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the signing keys published in the JWKS",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return listKeys(cmd.Context(), c)
+		},
+	}
+
+	var algorithm string
+	var rolloverWindow int64
+	rotateCmd := &cobra.Command{
+		Use:   "rotate [--algorithm <alg>] [--rollover-window <seconds>]",
+		Short: "Generate a new signing key and retire the current one",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := rotateKeyRequest{Algorithm: algorithm}
+			if cmd.Flags().Changed("rollover-window") {
+				req.RolloverWindow = &rolloverWindow
+			}
+			return rotateKey(cmd.Context(), c, req)
+		},
+	}
+	rotateCmd.Flags().StringVar(&algorithm, "algorithm", "",
+		"Algorithm of the new key. Defaults to the algorithm of the current signing key")
+	rotateCmd.Flags().Int64Var(&rolloverWindow, "rollover-window", 0,
+		"Seconds the current signing key stays published. Defaults to the configured rollover window")
+
+	return newGroupCommand("keys", "Manage the signing keys of the server", nil, listCmd, rotateCmd)
+}
+
+func listKeys(ctx context.Context, c *cli) error {
+	data, err := c.client.doJSON(ctx, http.MethodGet, signingKeysPath, nil, nil)
+	if err != nil {
+		return err
+	}
+	return c.printResult(data, "keys", signingKeyColumns)
+}
+
+func rotateKey(ctx context.Context, c *cli, req rotateKeyRequest) error {
+	data, err := c.client.doJSON(ctx, http.MethodPost, rotateSigningKeyPath, nil, req)
+	if err != nil {
+		return err
+	}
+	return c.printResult(data, "", signingKeyColumns)
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package main is the entry point of thunderctl, the command line client for the ThunderID
+// administrative APIs.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	envServer       = "THUNDERCTL_SERVER"
+	envToken        = "THUNDERCTL_TOKEN"
+	defaultServer   = "https://localhost:8090"
+	outputJSON      = "json"
+	outputTable     = "table"
+	exitCodeSuccess = 0
+	exitCodeFailure = 1
+	exitCodeUsage   = 2
+)
+
+// errUsage is returned when a command is invoked with invalid arguments.
+var errUsage = errors.New("invalid usage")
+
+// cli holds the state shared by all commands of an invocation.
+type cli struct {
+	client   *apiClient
+	output   string
+	server   string
+	token    string
+	insecure bool
+	stdin    io.Reader
+	stdout   io.Writer
+	stderr   io.Writer
+	// started is set once the arguments have been parsed and validated, so that the errors returned
+	// before it are reported as usage errors.
+	started bool
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run executes the command selected by the arguments. It returns the process exit code.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	c := &cli{
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+	}
+	root := newRootCommand(c)
+	root.SetArgs(args)
+	root.SetIn(stdin)
+	root.SetOut(stdout)
+	root.SetErr(stderr)
+
+	cmd, err := root.ExecuteContextC(ctx)
+	if err == nil {
+		return exitCodeSuccess
+	}
+	fmt.Fprintf(stderr, "Error: %v\n", err)
+	if errors.Is(err, errUsage) || !c.started {
+		fmt.Fprint(stderr, cmd.UsageString())
+		return exitCodeUsage
+	}
+	return exitCodeFailure
+}
+
+// newRootCommand builds the thunderctl command tree. The commands share the state of the invocation
+// through c.
+func newRootCommand(c *cli) *cobra.Command {
+	root := &cobra.Command{
+		Use:           "thunderctl",
+		Short:         "Command line client for the ThunderID admin APIs",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			if c.output != outputJSON && c.output != outputTable {
+				return fmt.Errorf("%w: unsupported output format %q", errUsage, c.output)
+			}
+			c.client = newAPIClient(c.server, c.token, c.insecure)
+			c.server = strings.TrimSuffix(c.server, "/")
+			c.started = true
+			return nil
+		},
+		RunE: runGroup,
+	}
+	root.CompletionOptions.DisableDefaultCmd = true
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&c.server, "server", getEnv(envServer, defaultServer), "Base URL of the ThunderID server")
+	flags.StringVar(&c.token, "token", os.Getenv(envToken), "Bearer access token used to call the admin APIs")
+	flags.BoolVar(&c.insecure, "insecure", false, "Skip TLS certificate verification")
+	flags.StringVarP(&c.output, "output", "o", outputTable, "Output format: json or table")
+
+	for _, res := range resources {
+		root.AddCommand(res.command(c))
+	}
+	root.AddCommand(keysCommand(c), backupCommand(c), bootstrapCommand(c))
+	return root
+}
+
+// newGroupCommand creates a command that only groups the given sub commands, such as "users".
+func newGroupCommand(use, short string, aliases []string, subCommands ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		RunE:    runGroup,
+	}
+	cmd.AddCommand(subCommands...)
+	return cmd
+}
+
+// runGroup runs a command that only groups sub commands, which is reached when no known sub command is
+// given.
+func runGroup(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: a command is required", errUsage)
+	}
+	return fmt.Errorf("%w: unknown command %q for %q", errUsage, args[0], cmd.CommandPath())
+}
+
+// requiredFlag returns a usage error when a required flag of the command is empty.
+func requiredFlag(name, value string) error {
+	if value == "" {
+		return fmt.Errorf("%w: the --%s flag is required", errUsage, name)
+	}
+	return nil
+}
+
+// getEnv returns the value of an environment variable, or the fallback when it is not set.
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
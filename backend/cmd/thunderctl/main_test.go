@@ -0,0 +1,448 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ThunderctlTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	handler  http.HandlerFunc
+	requests []*recordedRequest
+}
+
+type recordedRequest struct {
+	method string
+	path   string
+	query  string
+	auth   string
+	body   string
+}
+
+func TestThunderctlTestSuite(t *testing.T) {
+	suite.Run(t, new(ThunderctlTestSuite))
+}
+
+func (suite *ThunderctlTestSuite) SetupTest() {
+	suite.requests = nil
+	suite.handler = nil
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		suite.requests = append(suite.requests, &recordedRequest{
+			method: r.Method,
+			path:   r.URL.Path,
+			query:  r.URL.RawQuery,
+			auth:   r.Header.Get("Authorization"),
+			body:   string(body),
+		})
+		suite.handler(w, r)
+	}))
+}
+
+func (suite *ThunderctlTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *ThunderctlTestSuite) respond(status int, body string) {
+	suite.handler = func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func (suite *ThunderctlTestSuite) run(args ...string) (int, string, string) {
+	return suite.runWithInput("", args...)
+}
+
+func (suite *ThunderctlTestSuite) runWithInput(input string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	fullArgs := append([]string{"--server", suite.server.URL, "--token", "test-token"}, args...)
+	code := run(context.Background(), fullArgs, strings.NewReader(input), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func (suite *ThunderctlTestSuite) TestListUsers_Table() {
+	suite.respond(http.StatusOK, `{"totalResults":1,"users":[{"id":"u1","type":"Person","ouHandle":"default"}]}`)
+
+	code, stdout, _ := suite.run("users", "list", "--limit", "10")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "ID")
+	suite.Contains(stdout, "OUHANDLE")
+	suite.Contains(stdout, "u1")
+	suite.Contains(stdout, "Person")
+	suite.Require().Len(suite.requests, 1)
+	suite.Equal(http.MethodGet, suite.requests[0].method)
+	suite.Equal("/users", suite.requests[0].path)
+	suite.Equal("limit=10", suite.requests[0].query)
+	suite.Equal("Bearer test-token", suite.requests[0].auth)
+}
+
+func (suite *ThunderctlTestSuite) TestListIdentityProviders_JSON() {
+	suite.respond(http.StatusOK, `[{"id":"idp1","name":"Google","type":"GOOGLE"}]`)
+
+	code, stdout, _ := suite.run("-o", "json", "idps", "list")
+
+	suite.Equal(exitCodeSuccess, code)
+	var items []map[string]interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(stdout), &items))
+	suite.Require().Len(items, 1)
+	suite.Equal("idp1", items[0]["id"])
+}
+
+func (suite *ThunderctlTestSuite) TestGetApplication_UsesAlias() {
+	suite.respond(http.StatusOK, `{"id":"app1","name":"Console","clientId":"console"}`)
+
+	code, stdout, _ := suite.run("app", "get", "app1")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "Console")
+	suite.Equal("/applications/app1", suite.requests[0].path)
+}
+
+func (suite *ThunderctlTestSuite) TestCreateOrganizationUnit_FromFile() {
+	suite.respond(http.StatusCreated, `{"id":"ou1","handle":"eng","name":"Engineering"}`)
+	file := filepath.Join(suite.T().TempDir(), "ou.json")
+	suite.Require().NoError(os.WriteFile(file, []byte(`{"handle":"eng","name":"Engineering"}`), 0o600))
+
+	code, stdout, _ := suite.run("ous", "create", "-f", file)
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "Engineering")
+	suite.Equal(http.MethodPost, suite.requests[0].method)
+	suite.Equal("/organization-units", suite.requests[0].path)
+	suite.JSONEq(`{"handle":"eng","name":"Engineering"}`, suite.requests[0].body)
+}
+
+func (suite *ThunderctlTestSuite) TestUpdateUser_FromStdin() {
+	suite.respond(http.StatusOK, `{"id":"u1","type":"Person"}`)
+
+	code, _, _ := suite.runWithInput(`{"type":"Person"}`, "users", "update", "-f", "-", "u1")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Equal(http.MethodPut, suite.requests[0].method)
+	suite.Equal("/users/u1", suite.requests[0].path)
+	suite.JSONEq(`{"type":"Person"}`, suite.requests[0].body)
+}
+
+func (suite *ThunderctlTestSuite) TestCreate_InvalidJSON() {
+	code, _, stderr := suite.runWithInput("not json", "users", "create", "-f", "-")
+
+	suite.Equal(exitCodeFailure, code)
+	suite.Contains(stderr, "does not contain valid JSON")
+	suite.Empty(suite.requests)
+}
+
+func (suite *ThunderctlTestSuite) TestDeleteFlow_JSON() {
+	suite.respond(http.StatusNoContent, "")
+
+	code, stdout, _ := suite.run("-o", "json", "flows", "delete", "f1")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.JSONEq(`{"id":"f1","deleted":true}`, stdout)
+	suite.Equal(http.MethodDelete, suite.requests[0].method)
+	suite.Equal("/flows/f1", suite.requests[0].path)
+}
+
+func (suite *ThunderctlTestSuite) TestServerError() {
+	suite.respond(http.StatusNotFound,
+		`{"code":"USR-1003","message":{"key":"k","defaultValue":"User not found"},"description":"No such user"}`)
+
+	code, _, stderr := suite.run("users", "get", "missing")
+
+	suite.Equal(exitCodeFailure, code)
+	suite.Contains(stderr, "status 404")
+	suite.Contains(stderr, "USR-1003")
+	suite.Contains(stderr, "User not found")
+	suite.Contains(stderr, "No such user")
+}
+
+func (suite *ThunderctlTestSuite) TestUsageErrors() {
+	testCases := []struct {
+		name string
+		args []string
+	}{
+		{name: "NoCommand", args: nil},
+		{name: "UnknownCommand", args: []string{"widgets", "list"}},
+		{name: "MissingSubCommand", args: []string{"users"}},
+		{name: "UnknownSubCommand", args: []string{"users", "purge"}},
+		{name: "MissingID", args: []string{"users", "get"}},
+		{name: "MissingFile", args: []string{"users", "create"}},
+		{name: "UnsupportedOutput", args: []string{"-o", "xml", "users", "list"}},
+		{name: "UnknownFlag", args: []string{"users", "list", "--unknown"}},
+		{name: "UnexpectedArgument", args: []string{"keys", "rotate", "extra"}},
+		{name: "InvalidRolloverWindow", args: []string{"keys", "rotate", "--rollover-window", "soon"}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			code, _, stderr := suite.run(tc.args...)
+			suite.Equal(exitCodeUsage, code)
+			suite.Contains(stderr, "Usage:")
+			suite.Empty(suite.requests)
+		})
+	}
+}
+
+func (suite *ThunderctlTestSuite) TestHelp() {
+	code, stdout, _ := suite.run("keys", "--help")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "rotate")
+	suite.Contains(stdout, "list")
+	suite.Empty(suite.requests)
+}
+
+func (suite *ThunderctlTestSuite) TestExportFlows_ToFile() {
+	suite.respond(http.StatusOK, `{"resources":"# File: login.yaml\nname: Login\n","environment_variables":"A=1\n"}`)
+	dir := suite.T().TempDir()
+	file := filepath.Join(dir, "flows.yaml")
+	envFile := filepath.Join(dir, "flows.env")
+
+	code, stdout, _ := suite.run("flows", "export", "--file", file, "--env-file", envFile,
+		"--include-dependencies", "f1", "f2")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "Exported 2 flow(s)")
+	suite.Equal("/export", suite.requests[0].path)
+	suite.JSONEq(`{"flows":["f1","f2"],"options":{"includeDependencies":true}}`, suite.requests[0].body)
+	content, err := os.ReadFile(file)
+	suite.Require().NoError(err)
+	suite.Equal("# File: login.yaml\nname: Login\n", string(content))
+	envContent, err := os.ReadFile(envFile)
+	suite.Require().NoError(err)
+	suite.Equal("A=1\n", string(envContent))
+}
+
+func (suite *ThunderctlTestSuite) TestExportFlows_ToStdout() {
+	suite.respond(http.StatusOK, `{"resources":"name: Login","environment_variables":""}`)
+
+	code, stdout, _ := suite.run("flows", "export", "f1")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Equal("name: Login\n", stdout)
+}
+
+func (suite *ThunderctlTestSuite) TestImportFlows() {
+	suite.respond(http.StatusOK, `{"summary":{"totalDocuments":1,"imported":1,"failed":0},`+
+		`"results":[{"resourceType":"flow","resourceName":"Login","operation":"create","status":"success"}]}`)
+	dir := suite.T().TempDir()
+	envFile := filepath.Join(dir, "flows.env")
+	suite.Require().NoError(os.WriteFile(envFile, []byte("# comment\nCLIENT_ID=abc\n\nNAME=\"x\"\n"), 0o600))
+
+	code, stdout, _ := suite.runWithInput("name: Login", "flows", "import", "-f", "-", "--env-file", envFile,
+		"--dry-run")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "Login")
+	suite.Equal("/import", suite.requests[0].path)
+	suite.JSONEq(`{"content":"name: Login","variables":{"CLIENT_ID":"abc","NAME":"x"},"dryRun":true}`,
+		suite.requests[0].body)
+}
+
+func (suite *ThunderctlTestSuite) TestImportFlows_FailedResources() {
+	suite.respond(http.StatusOK, `{"summary":{"totalDocuments":1,"imported":0,"failed":1},`+
+		`"results":[{"resourceType":"flow","status":"failed","message":"invalid"}]}`)
+
+	code, _, stderr := suite.runWithInput("name: Login", "flows", "import", "-f", "-")
+
+	suite.Equal(exitCodeFailure, code)
+	suite.Contains(stderr, "1 resource(s) failed to import")
+}
+
+func (suite *ThunderctlTestSuite) TestListKeys() {
+	suite.respond(http.StatusOK, `{"totalResults":1,"keys":[{"id":"key-1","kid":"kid-1","algorithm":"RS256",`+
+		`"status":"ACTIVE","source":"CONFIG"}]}`)
+
+	code, stdout, _ := suite.run("keys", "list")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "key-1")
+	suite.Contains(stdout, "RS256")
+	suite.Contains(stdout, "ACTIVE")
+	suite.Equal(http.MethodGet, suite.requests[0].method)
+	suite.Equal(signingKeysPath, suite.requests[0].path)
+}
+
+func (suite *ThunderctlTestSuite) TestRotateKey() {
+	suite.respond(http.StatusCreated, `{"id":"key-2","kid":"kid-2","algorithm":"ES256","status":"ACTIVE",`+
+		`"source":"GENERATED"}`)
+
+	code, stdout, _ := suite.run("keys", "rotate", "--algorithm", "ES256", "--rollover-window", "600")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "key-2")
+	suite.Contains(stdout, "GENERATED")
+	suite.Equal(http.MethodPost, suite.requests[0].method)
+	suite.Equal(rotateSigningKeyPath, suite.requests[0].path)
+	suite.JSONEq(`{"algorithm":"ES256","rolloverWindow":600}`, suite.requests[0].body)
+}
+
+func (suite *ThunderctlTestSuite) TestRotateKey_Defaults() {
+	suite.respond(http.StatusCreated, `{"id":"key-2","algorithm":"RS256","status":"ACTIVE"}`)
+
+	code, stdout, _ := suite.run("-o", "json", "keys", "rotate")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.JSONEq(`{"id":"key-2","algorithm":"RS256","status":"ACTIVE"}`, stdout)
+	suite.JSONEq(`{}`, suite.requests[0].body)
+}
+
+func (suite *ThunderctlTestSuite) TestRotateKey_ZeroRolloverWindow() {
+	suite.respond(http.StatusCreated, `{"id":"key-2"}`)
+
+	code, _, _ := suite.run("keys", "rotate", "--rollover-window", "0")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.JSONEq(`{"rolloverWindow":0}`, suite.requests[0].body)
+}
+
+func (suite *ThunderctlTestSuite) TestRotateKey_ServerError() {
+	suite.respond(http.StatusBadRequest,
+		`{"code":"SGK-1002","message":{"key":"k","defaultValue":"Unsupported algorithm"}}`)
+
+	code, _, stderr := suite.run("keys", "rotate", "--algorithm", "DSA")
+
+	suite.Equal(exitCodeFailure, code)
+	suite.Contains(stderr, "status 400")
+	suite.Contains(stderr, "Unsupported algorithm")
+}
+
+func (suite *ThunderctlTestSuite) TestCreateBackup_ToFile() {
+	suite.respond(http.StatusOK, `{"formatVersion":"1","checksum":"sha256:abc","resources":"name: Login"}`)
+	file := filepath.Join(suite.T().TempDir(), "backup.json")
+
+	code, stdout, _ := suite.run("backup", "create", "--file", file)
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "Wrote the snapshot to")
+	suite.Equal(http.MethodPost, suite.requests[0].method)
+	suite.Equal(backupPath, suite.requests[0].path)
+	content, err := os.ReadFile(file)
+	suite.Require().NoError(err)
+	suite.JSONEq(`{"formatVersion":"1","checksum":"sha256:abc","resources":"name: Login"}`, string(content))
+}
+
+func (suite *ThunderctlTestSuite) TestCreateBackup_ToStdout() {
+	suite.respond(http.StatusOK, `{"formatVersion":"1"}`)
+
+	code, stdout, _ := suite.run("backup", "create")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.JSONEq(`{"formatVersion":"1"}`, stdout)
+}
+
+func (suite *ThunderctlTestSuite) TestRestoreBackup() {
+	suite.respond(http.StatusOK, `{"dryRun":true,"summary":{"totalDocuments":1,"imported":1,"failed":0},`+
+		`"results":[{"resourceType":"flow","resourceName":"Login","operation":"update","status":"success"}],`+
+		`"keys":[{"id":"key-1","status":"present"},{"id":"key-2","status":"missing"}]}`)
+	envFile := filepath.Join(suite.T().TempDir(), "override.env")
+	suite.Require().NoError(os.WriteFile(envFile, []byte("CLIENT_ID=abc\n"), 0o600))
+
+	code, stdout, stderr := suite.runWithInput(`{"checksum":"sha256:abc"}`, "backup", "restore", "-f", "-",
+		"--env-file", envFile, "--dry-run")
+
+	suite.Equal(exitCodeSuccess, code)
+	suite.Contains(stdout, "Login")
+	suite.Contains(stderr, `signing key "key-2" of the snapshot is missing`)
+	suite.NotContains(stderr, "key-1")
+	suite.Equal(restorePath, suite.requests[0].path)
+	suite.JSONEq(`{"snapshot":{"checksum":"sha256:abc"},"variables":{"CLIENT_ID":"abc"},"dryRun":true}`,
+		suite.requests[0].body)
+}
+
+func (suite *ThunderctlTestSuite) TestRestoreBackup_Failures() {
+	suite.respond(http.StatusOK, `{"summary":{"failed":2},"results":[]}`)
+
+	code, _, stderr := suite.runWithInput(`{}`, "backup", "restore", "-f", "-")
+	suite.Equal(exitCodeFailure, code)
+	suite.Contains(stderr, "2 resource(s) failed to restore")
+
+	code, _, stderr = suite.runWithInput("not json", "backup", "restore", "-f", "-")
+	suite.Equal(exitCodeFailure, code)
+	suite.Contains(stderr, "- is not a valid snapshot")
+	suite.Len(suite.requests, 1)
+}
+
+func (suite *ThunderctlTestSuite) TestBootstrapRun() {
+	dir := suite.T().TempDir()
+	out := filepath.Join(dir, "out.txt")
+	scripts := map[string]string{
+		"01-first.sh":  "echo \"$API_BASE $PUBLIC_URL\" > " + out + "\n",
+		"02-second.sh": "exit 3\n",
+		"03-third.sh":  "exit 0\n",
+		"04-skip.sh":   "exit 1\n",
+		"common.sh":    "exit 1\n",
+		"README.md":    "not a script",
+	}
+	for name, content := range scripts {
+		suite.Require().NoError(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	code, stdout, stderr := suite.run("-o", "json", "bootstrap", "run", "--dir", dir, "--skip", "skip",
+		"--fail-fast=false", "--public-url", "https://id.example.com/")
+
+	suite.Equal(exitCodeFailure, code)
+	suite.Contains(stderr, "1 bootstrap script(s) failed")
+	var results []bootstrapResult
+	suite.Require().NoError(json.Unmarshal([]byte(stdout), &results))
+	suite.Require().Len(results, 4)
+	suite.Equal("01-first.sh", results[0].Script)
+	suite.Equal(statusSuccess, results[0].Status)
+	suite.Equal(statusFailed, results[1].Status)
+	suite.Equal(statusSuccess, results[2].Status)
+	suite.Equal(statusSkipped, results[3].Status)
+
+	content, err := os.ReadFile(out)
+	suite.Require().NoError(err)
+	suite.Equal(suite.server.URL+" https://id.example.com\n", string(content))
+}
+
+func (suite *ThunderctlTestSuite) TestBootstrapRun_FailFast() {
+	dir := suite.T().TempDir()
+	suite.Require().NoError(os.WriteFile(filepath.Join(dir, "01-fail.sh"), []byte("exit 1\n"), 0o600))
+	suite.Require().NoError(os.WriteFile(filepath.Join(dir, "02-next.sh"), []byte("exit 0\n"), 0o600))
+
+	code, stdout, _ := suite.run("bootstrap", "run", "--dir", dir, "--only", "^0")
+
+	suite.Equal(exitCodeFailure, code)
+	suite.Contains(stdout, "01-fail.sh")
+	suite.NotContains(stdout, "02-next.sh")
+}
+
+func (suite *ThunderctlTestSuite) TestBootstrapRun_InvalidPattern() {
+	code, _, stderr := suite.run("bootstrap", "run", "--dir", suite.T().TempDir(), "--only", "(")
+
+	suite.Equal(exitCodeFailure, code)
+	suite.Contains(stderr, "invalid pattern")
+}
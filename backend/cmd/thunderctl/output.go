@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// printJSON writes a JSON value indented, so that it can be consumed by scripts.
+func printJSON(w io.Writer, data json.RawMessage) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return fmt.Errorf("failed to format the response: %w", err)
+	}
+	buf.WriteByte('\n')
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// printTable writes the given columns of a list of JSON objects as an aligned table.
+func printTable(w io.Writer, columns []string, items []map[string]interface{}) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = strings.ToUpper(column)
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, item := range items {
+		values := make([]string, len(columns))
+		for i, column := range columns {
+			values[i] = formatValue(item[column])
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
+
+// formatValue formats a JSON value for a table cell.
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64, bool:
+		return fmt.Sprint(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(encoded)
+	}
+}
+
+// printResult writes a JSON response in the output format selected for the CLI. Table output shows the
+// given columns of a list found under listField, or of the object itself when listField is empty and the
+// response is not an array.
+func (c *cli) printResult(data json.RawMessage, listField string, columns []string) error {
+	if c.output == outputJSON {
+		return printJSON(c.stdout, data)
+	}
+	items, err := extractItems(data, listField)
+	if err != nil {
+		return err
+	}
+	return printTable(c.stdout, columns, items)
+}
+
+// extractItems returns the objects to show in a table from a JSON response.
+func extractItems(data json.RawMessage, listField string) ([]map[string]interface{}, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if listField != "" {
+		var wrapper map[string]json.RawMessage
+		if err := json.Unmarshal(data, &wrapper); err != nil {
+			return nil, fmt.Errorf("failed to parse the response: %w", err)
+		}
+		data = wrapper[listField]
+		if len(data) == 0 {
+			return nil, nil
+		}
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var items []map[string]interface{}
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, fmt.Errorf("failed to parse the response: %w", err)
+		}
+		return items, nil
+	}
+
+	var item map[string]interface{}
+	if err := json.Unmarshal(trimmed, &item); err != nil {
+		return nil, fmt.Errorf("failed to parse the response: %w", err)
+	}
+	return []map[string]interface{}{item}, nil
+}
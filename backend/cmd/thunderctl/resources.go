@@ -0,0 +1,265 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// resource describes an admin API resource managed through the generic list, get, create, update and
+// delete sub commands.
+type resource struct {
+	name        string
+	aliases     []string
+	singular    string
+	description string
+	path        string
+	listField   string
+	columns     []string
+	paginated   bool
+	// extraCommands returns the sub commands of the resource that are not generic resource operations.
+	extraCommands func(c *cli) []*cobra.Command
+}
+
+// resources lists the admin API resources managed by thunderctl.
+var resources = []resource{
+	{
+		name:        "users",
+		aliases:     []string{"user"},
+		singular:    "user",
+		description: "Manage users",
+		path:        "/users",
+		listField:   "users",
+		columns:     []string{"id", "type", "ouHandle", "display"},
+		paginated:   true,
+	},
+	{
+		name:        "ous",
+		aliases:     []string{"ou", "organization-units"},
+		singular:    "organization unit",
+		description: "Manage organization units",
+		path:        "/organization-units",
+		listField:   "organizationUnits",
+		columns:     []string{"id", "handle", "name"},
+		paginated:   true,
+	},
+	{
+		name:        "apps",
+		aliases:     []string{"app", "applications"},
+		singular:    "application",
+		description: "Manage applications",
+		path:        "/applications",
+		listField:   "applications",
+		columns:     []string{"id", "name", "clientId"},
+	},
+	{
+		name:        "idps",
+		aliases:     []string{"idp", "identity-providers"},
+		singular:    "identity provider",
+		description: "Manage identity providers",
+		path:        "/identity-providers",
+		columns:     []string{"id", "name", "type"},
+	},
+	{
+		name:          "flows",
+		aliases:       []string{"flow"},
+		singular:      "flow",
+		description:   "Manage, export and import flows",
+		path:          "/flows",
+		listField:     "flows",
+		columns:       []string{"id", "handle", "name", "flowType", "activeVersion"},
+		paginated:     true,
+		extraCommands: flowTransferCommands,
+	},
+}
+
+// deleteResult is the output of a delete sub command.
+type deleteResult struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+// command builds the command group of the resource.
+func (r resource) command(c *cli) *cobra.Command {
+	cmd := newGroupCommand(r.name, r.description, r.aliases,
+		r.listCommand(c), r.getCommand(c), r.createCommand(c), r.updateCommand(c), r.deleteCommand(c))
+	if r.extraCommands != nil {
+		cmd.AddCommand(r.extraCommands(c)...)
+	}
+	return cmd
+}
+
+func (r resource) listCommand(c *cli) *cobra.Command {
+	var limit, offset int
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List " + r.name,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return r.list(cmd.Context(), c, limit, offset)
+		},
+	}
+	if r.paginated {
+		cmd.Flags().IntVar(&limit, "limit", 0, "Maximum number of results to return")
+		cmd.Flags().IntVar(&offset, "offset", 0, "Number of results to skip")
+	}
+	return cmd
+}
+
+func (r resource) getCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get a " + r.singular + " by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return r.get(cmd.Context(), c, args[0])
+		},
+	}
+}
+
+func (r resource) createCommand(c *cli) *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "create -f <file>",
+		Short: "Create a " + r.singular + " from a JSON file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return r.create(cmd.Context(), c, file)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "",
+		"JSON file with the "+r.singular+" definition, or - to read from stdin")
+	return cmd
+}
+
+func (r resource) updateCommand(c *cli) *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "update -f <file> <id>",
+		Short: "Update a " + r.singular + " from a JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return r.update(cmd.Context(), c, file, args[0])
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "",
+		"JSON file with the "+r.singular+" definition, or - to read from stdin")
+	return cmd
+}
+
+func (r resource) deleteCommand(c *cli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a " + r.singular + " by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return r.delete(cmd.Context(), c, args[0])
+		},
+	}
+}
+
+func (r resource) list(ctx context.Context, c *cli, limit, offset int) error {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+	data, err := c.client.doJSON(ctx, http.MethodGet, r.path, query, nil)
+	if err != nil {
+		return err
+	}
+	return c.printResult(data, r.listField, r.columns)
+}
+
+func (r resource) get(ctx context.Context, c *cli, id string) error {
+	data, err := c.client.doJSON(ctx, http.MethodGet, r.itemPath(id), nil, nil)
+	if err != nil {
+		return err
+	}
+	return c.printResult(data, "", r.columns)
+}
+
+func (r resource) create(ctx context.Context, c *cli, file string) error {
+	payload, err := readPayload(c, file)
+	if err != nil {
+		return err
+	}
+	data, err := c.client.doJSON(ctx, http.MethodPost, r.path, nil, payload)
+	if err != nil {
+		return err
+	}
+	return c.printResult(data, "", r.columns)
+}
+
+func (r resource) update(ctx context.Context, c *cli, file, id string) error {
+	payload, err := readPayload(c, file)
+	if err != nil {
+		return err
+	}
+	data, err := c.client.doJSON(ctx, http.MethodPut, r.itemPath(id), nil, payload)
+	if err != nil {
+		return err
+	}
+	return c.printResult(data, "", r.columns)
+}
+
+func (r resource) delete(ctx context.Context, c *cli, id string) error {
+	if _, err := c.client.doJSON(ctx, http.MethodDelete, r.itemPath(id), nil, nil); err != nil {
+		return err
+	}
+	if c.output == outputJSON {
+		data, err := json.Marshal(deleteResult{ID: id, Deleted: true})
+		if err != nil {
+			return err
+		}
+		return printJSON(c.stdout, data)
+	}
+	_, err := fmt.Fprintf(c.stdout, "Deleted %s %s\n", r.singular, id)
+	return err
+}
+
+// itemPath returns the path of a single item of the resource.
+func (r resource) itemPath(id string) string {
+	return r.path + "/" + url.PathEscape(id)
+}
+
+// readPayload reads a JSON request body from a file, or from stdin when the file is "-".
+func readPayload(c *cli, file string) (json.RawMessage, error) {
+	if err := requiredFlag("file", file); err != nil {
+		return nil, err
+	}
+
+	data, err := readFile(c, file)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("%s does not contain valid JSON", file)
+	}
+	return data, nil
+}
@@ -125,6 +125,16 @@ CREATE TABLE "OAUTH_INBOUND_PROFILE" (
     FOREIGN KEY (ENTITY_ID) REFERENCES "INBOUND_CLIENT"(ENTITY_ID) ON DELETE CASCADE
 );
 
+-- Table to store registration access tokens of dynamically registered OAuth clients.
+CREATE TABLE "OAUTH_REGISTRATION_TOKEN" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ENTITY_ID VARCHAR(36) NOT NULL,
+    TOKEN_HASH VARCHAR(255) NOT NULL,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (ENTITY_ID, DEPLOYMENT_ID),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "INBOUND_CLIENT"(ENTITY_ID) ON DELETE CASCADE
+);
+
 -- Table to store identity providers.
 CREATE TABLE "IDP" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
@@ -148,6 +158,7 @@ CREATE TABLE "NOTIFICATION_SENDER" (
     DESCRIPTION VARCHAR(500),
     TYPE VARCHAR(20) NOT NULL,
     PROVIDER VARCHAR(20) NOT NULL,
+    OU_ID VARCHAR(36),
     PROPERTIES TEXT,
     CREATED_AT TEXT DEFAULT (datetime('now')),
     UPDATED_AT TEXT DEFAULT (datetime('now'))
@@ -156,6 +167,59 @@ CREATE TABLE "NOTIFICATION_SENDER" (
 -- Composite index for name-based notification sender lookups
 CREATE INDEX idx_notification_sender_name_deployment ON "NOTIFICATION_SENDER" (DEPLOYMENT_ID, NAME);
 
+-- Composite index for OU-scoped notification sender lookups
+CREATE INDEX idx_notification_sender_ou_deployment ON "NOTIFICATION_SENDER" (DEPLOYMENT_ID, OU_ID);
+
+-- Table to store the mappings of system events to notification templates and channels.
+CREATE TABLE "NOTIFICATION_TRIGGER" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    EVENT VARCHAR(50) NOT NULL,
+    CHANNEL VARCHAR(20) NOT NULL,
+    SCENARIO VARCHAR(50) NOT NULL,
+    SENDER_ID VARCHAR(36),
+    OU_ID VARCHAR(36),
+    IS_ENABLED CHAR(1) DEFAULT '1',
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now'))
+);
+
+-- Composite index for event-based notification trigger lookups
+CREATE INDEX idx_notification_trigger_event_deployment ON "NOTIFICATION_TRIGGER" (DEPLOYMENT_ID, EVENT);
+
+-- Table to store the limits on OTP and verification messages per channel, optionally scoped to an organization unit
+CREATE TABLE "NOTIFICATION_RATE_LIMIT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    CHANNEL VARCHAR(20) NOT NULL,
+    OU_ID VARCHAR(36),
+    RECIPIENT_LIMIT INTEGER NOT NULL DEFAULT 0,
+    IP_LIMIT INTEGER NOT NULL DEFAULT 0,
+    WINDOW_SECONDS INTEGER NOT NULL,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now'))
+);
+
+-- Composite index for channel-based notification rate limit lookups
+CREATE INDEX idx_notification_rate_limit_channel_deployment ON "NOTIFICATION_RATE_LIMIT" (DEPLOYMENT_ID, CHANNEL);
+
+-- Table to store custom message templates. Localized subject and body variants are stored as translations.
+CREATE TABLE "TEMPLATE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    DISPLAY_NAME VARCHAR(255) NOT NULL,
+    SCENARIO VARCHAR(50) NOT NULL,
+    TYPE VARCHAR(20) NOT NULL,
+    OU_ID VARCHAR(36),
+    CONTENT_TYPE VARCHAR(50),
+    PLACEHOLDERS TEXT,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now'))
+);
+
+-- Composite index for scenario-based template lookups
+CREATE INDEX idx_template_scenario_deployment ON "TEMPLATE" (DEPLOYMENT_ID, SCENARIO, TYPE, OU_ID);
+
 -- Table to store certificates associated with various entities.
 CREATE TABLE "CERTIFICATE" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
@@ -300,6 +364,21 @@ CREATE TABLE "FLOW_VERSION" (
         ON DELETE CASCADE
 );
 
+-- Table to store unpublished draft flow definitions
+CREATE TABLE "FLOW_DRAFT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    NAME VARCHAR(100) NOT NULL,
+    BASE_VERSION INTEGER NOT NULL,
+    NODES TEXT NOT NULL,
+    CREATED_AT TEXT DEFAULT (datetime('now')),
+    UPDATED_AT TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (FLOW_ID, DEPLOYMENT_ID),
+    FOREIGN KEY (FLOW_ID)
+        REFERENCES "FLOW"(ID)
+        ON DELETE CASCADE
+);
+
 -- Table to store i18n translations
 CREATE TABLE "TRANSLATION" (
     DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
@@ -314,3 +393,45 @@ CREATE TABLE "TRANSLATION" (
 
 -- Index for efficient language and namespace combination lookups
 CREATE INDEX idx_translation_lang_namespace ON "TRANSLATION" (DEPLOYMENT_ID, LANGUAGE_CODE, NAMESPACE);
+
+-- Table to store application and organization unit specific translation overrides
+CREATE TABLE "TRANSLATION_OVERRIDE" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    SCOPE_TYPE      VARCHAR(10) NOT NULL,
+    SCOPE_ID        VARCHAR(36) NOT NULL,
+    MESSAGE_KEY     VARCHAR(255) NOT NULL,
+    LANGUAGE_CODE   VARCHAR(10) NOT NULL,
+    NAMESPACE       VARCHAR(50) NOT NULL,
+    VALUE           TEXT NOT NULL,
+    CREATED_AT      TEXT DEFAULT (datetime('now')),
+    UPDATED_AT      TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (DEPLOYMENT_ID, SCOPE_TYPE, SCOPE_ID, NAMESPACE, MESSAGE_KEY, LANGUAGE_CODE)
+);
+
+-- Table to store the signing keys generated at runtime by key rotation
+CREATE TABLE "SIGNING_KEY" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ID              VARCHAR(36)  PRIMARY KEY,
+    ALGORITHM       VARCHAR(20)  NOT NULL,
+    CERTIFICATE     TEXT         NOT NULL,
+    PRIVATE_KEY     TEXT         NOT NULL,
+    STATUS          VARCHAR(20)  NOT NULL,
+    CREATED_AT      TEXT         NOT NULL,
+    RETIRE_AT       TEXT
+);
+
+-- Index for looking up the signing keys of a deployment by status
+CREATE INDEX idx_signing_key_status ON "SIGNING_KEY" (DEPLOYMENT_ID, STATUS);
+
+-- Table to store the OAuth scopes defined through the scope management API
+CREATE TABLE "OAUTH_SCOPE" (
+    DEPLOYMENT_ID       VARCHAR(255) NOT NULL,
+    ID                  VARCHAR(36)  PRIMARY KEY,
+    NAME                VARCHAR(255) NOT NULL,
+    DESCRIPTION         TEXT,
+    CLAIMS              TEXT         NOT NULL,
+    CONSENT_REQUIRED    INTEGER      NOT NULL DEFAULT 0,
+    CREATED_AT          TEXT DEFAULT (datetime('now')),
+    UPDATED_AT          TEXT DEFAULT (datetime('now')),
+    UNIQUE (DEPLOYMENT_ID, NAME)
+);
@@ -18,6 +18,10 @@
 -- ============================================================
 -- Stored procedure: purge all expired runtimedb rows.
 --
+-- The server deletes the same rows through its expired-token-cleanup and
+-- flow-context-gc scheduled jobs. Schedule this procedure only when those
+-- jobs are disabled.
+--
 -- Run once manually (ad-hoc / on-demand):
 --   PGPASSWORD=<pass> psql -h <host> -p <port> -U <user> -d <runtimedb> \
 --     -c "CALL cleanup_expired_runtimedb_data();"
@@ -56,5 +60,16 @@ BEGIN
     DELETE FROM "WEBAUTHN_SESSION"      WHERE EXPIRY_TIME < v_now;
     DELETE FROM "ATTRIBUTE_CACHE"       WHERE EXPIRY_TIME < v_now;
     DELETE FROM "PAR_REQUEST"           WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "CIBA_REQUEST"          WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "SAML_MESSAGE_CONTEXT"  WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "SSO_SESSION_CLIENT"    WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "SSO_SESSION"           WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "BACKCHANNEL_LOGOUT_DELIVERY" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "NOTIFICATION_DELIVERY_STATUS" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "NOTIFICATION_DEAD_LETTER" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "NOTIFICATION_RATE_LIMIT_COUNTER" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "NOTIFICATION_SEND_AUDIT" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "MCP_TOOL_CALL_AUDIT" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "TOKEN_RATE_LIMIT_COUNTER" WHERE EXPIRY_TIME < v_now;
 END;
 $$;
@@ -78,3 +78,255 @@ CREATE TABLE "PAR_REQUEST" (
 
 -- Index for expiry time on PAR_REQUEST (supports cleanup and expiry checks)
 CREATE INDEX idx_par_request_expiry_time ON "PAR_REQUEST" (EXPIRY_TIME);
+
+-- Table to store client-initiated backchannel authentication (CIBA) requests
+CREATE TABLE "CIBA_REQUEST" (
+    AUTH_REQ_ID VARCHAR(43) PRIMARY KEY,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    REQUEST_DATA TEXT NOT NULL,
+    EXPIRY_TIME DATETIME NOT NULL
+);
+
+-- Index for expiry time on CIBA_REQUEST (supports cleanup and expiry checks)
+CREATE INDEX idx_ciba_request_expiry_time ON "CIBA_REQUEST" (EXPIRY_TIME);
+
+-- Table to store the contexts of SAML authentication requests and responses
+CREATE TABLE "SAML_MESSAGE_CONTEXT" (
+    CONTEXT_ID VARCHAR(43) PRIMARY KEY,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    CONTEXT_DATA TEXT NOT NULL,
+    EXPIRY_TIME DATETIME NOT NULL
+);
+
+-- Index for expiry time on SAML_MESSAGE_CONTEXT (supports cleanup and expiry checks)
+CREATE INDEX idx_saml_message_context_expiry_time ON "SAML_MESSAGE_CONTEXT" (EXPIRY_TIME);
+
+-- Table to store single sign-on sessions
+CREATE TABLE "SSO_SESSION" (
+    SESSION_ID VARCHAR(36) PRIMARY KEY,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    USER_ID VARCHAR(255) NOT NULL,
+    AUTH_TIME TIMESTAMP NOT NULL,
+    COMPLETED_ACR VARCHAR(255),
+    COMPLETED_AMR VARCHAR(255),
+    ATTRIBUTE_CACHE_ID VARCHAR(36),
+    TOKEN_HASH VARCHAR(64),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    LAST_ACCESSED_AT TIMESTAMP NOT NULL,
+    EXPIRY_TIME DATETIME NOT NULL
+);
+
+-- Index for expiry time on SSO_SESSION (supports cleanup)
+CREATE INDEX idx_sso_session_expiry_time ON "SSO_SESSION" (EXPIRY_TIME);
+
+-- Composite index for listing the SSO sessions of a user
+CREATE INDEX idx_sso_session_user ON "SSO_SESSION" (DEPLOYMENT_ID, USER_ID);
+
+-- Table to store the clients that were issued tokens in an SSO session
+CREATE TABLE "SSO_SESSION_CLIENT" (
+    SESSION_ID VARCHAR(36) NOT NULL,
+    CLIENT_ID VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    EXPIRY_TIME DATETIME NOT NULL,
+    PRIMARY KEY (SESSION_ID, CLIENT_ID, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on SSO_SESSION_CLIENT (supports cleanup)
+CREATE INDEX idx_sso_session_client_expiry_time ON "SSO_SESSION_CLIENT" (EXPIRY_TIME);
+
+-- Table to track the delivery of back-channel logout tokens to clients
+CREATE TABLE "BACKCHANNEL_LOGOUT_DELIVERY" (
+    ID VARCHAR(36) PRIMARY KEY,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    APPLICATION_ID VARCHAR(36) NOT NULL,
+    CLIENT_ID VARCHAR(255) NOT NULL,
+    SESSION_ID VARCHAR(36) NOT NULL,
+    LOGOUT_URI VARCHAR(2048) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    ATTEMPTS INTEGER NOT NULL,
+    LAST_ERROR VARCHAR(1024),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UPDATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME DATETIME NOT NULL
+);
+
+-- Composite index for listing the logout deliveries of an application
+CREATE INDEX idx_backchannel_logout_delivery_app ON "BACKCHANNEL_LOGOUT_DELIVERY" (DEPLOYMENT_ID, APPLICATION_ID);
+
+-- Index for expiry time on BACKCHANNEL_LOGOUT_DELIVERY (supports cleanup)
+CREATE INDEX idx_backchannel_logout_delivery_expiry_time ON "BACKCHANNEL_LOGOUT_DELIVERY" (EXPIRY_TIME);
+
+-- Table to store delivery status callbacks reported by notification providers
+CREATE TABLE "NOTIFICATION_DELIVERY_STATUS" (
+    ID VARCHAR(36) PRIMARY KEY,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    SENDER_ID VARCHAR(36) NOT NULL,
+    MESSAGE_ID VARCHAR(255) NOT NULL,
+    RECIPIENT VARCHAR(255),
+    STATE VARCHAR(20) NOT NULL,
+    PROVIDER_STATUS VARCHAR(50),
+    ERROR_CODE VARCHAR(50),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME DATETIME NOT NULL
+);
+
+-- Composite index for listing delivery statuses of a sender
+CREATE INDEX idx_notification_delivery_status_sender ON "NOTIFICATION_DELIVERY_STATUS" (DEPLOYMENT_ID, SENDER_ID);
+
+-- Index for expiry time on NOTIFICATION_DELIVERY_STATUS (supports cleanup)
+CREATE INDEX idx_notification_delivery_status_expiry_time ON "NOTIFICATION_DELIVERY_STATUS" (EXPIRY_TIME);
+
+-- Table to store notifications that could not be delivered after all retry attempts
+CREATE TABLE "NOTIFICATION_DEAD_LETTER" (
+    ID VARCHAR(36) PRIMARY KEY,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    CHANNEL VARCHAR(20) NOT NULL,
+    SENDER_ID VARCHAR(36),
+    OU_ID VARCHAR(36),
+    RECIPIENT VARCHAR(320) NOT NULL,
+    SUBJECT VARCHAR(255),
+    BODY TEXT NOT NULL,
+    IS_HTML CHAR(1) DEFAULT '0',
+    ATTEMPTS INTEGER NOT NULL,
+    LAST_ERROR VARCHAR(1024),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME DATETIME NOT NULL
+);
+
+-- Composite index for listing dead-letter notifications
+CREATE INDEX idx_notification_dead_letter_created_at ON "NOTIFICATION_DEAD_LETTER" (DEPLOYMENT_ID, CREATED_AT);
+
+-- Index for expiry time on NOTIFICATION_DEAD_LETTER (supports cleanup)
+CREATE INDEX idx_notification_dead_letter_expiry_time ON "NOTIFICATION_DEAD_LETTER" (EXPIRY_TIME);
+
+-- Table to store the counters of the notification rate limits for the current time window
+CREATE TABLE "NOTIFICATION_RATE_LIMIT_COUNTER" (
+    COUNTER_KEY VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    REQUEST_COUNT INTEGER NOT NULL,
+    EXPIRY_TIME DATETIME NOT NULL,
+    PRIMARY KEY (COUNTER_KEY, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on NOTIFICATION_RATE_LIMIT_COUNTER (supports cleanup)
+CREATE INDEX idx_notification_rate_limit_counter_expiry_time ON "NOTIFICATION_RATE_LIMIT_COUNTER" (EXPIRY_TIME);
+
+-- Table to store the counters of the token endpoint rate limits for the current time window
+CREATE TABLE "TOKEN_RATE_LIMIT_COUNTER" (
+    COUNTER_KEY VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    REQUEST_COUNT INTEGER NOT NULL,
+    EXPIRY_TIME DATETIME NOT NULL,
+    PRIMARY KEY (COUNTER_KEY, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on TOKEN_RATE_LIMIT_COUNTER (supports cleanup)
+CREATE INDEX idx_token_rate_limit_counter_expiry_time ON "TOKEN_RATE_LIMIT_COUNTER" (EXPIRY_TIME);
+
+-- Table to store the time up to which the refresh tokens and authorization codes issued to a user are revoked
+CREATE TABLE "USER_GRANT_REVOCATION" (
+    USER_ID VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    REVOKED_AT DATETIME NOT NULL,
+    PRIMARY KEY (USER_ID, DEPLOYMENT_ID)
+);
+
+-- Table to store the audit records of notification send attempts
+CREATE TABLE "NOTIFICATION_SEND_AUDIT" (
+    ID VARCHAR(36) PRIMARY KEY,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    CHANNEL VARCHAR(20) NOT NULL,
+    TEMPLATE VARCHAR(100),
+    RECIPIENT VARCHAR(320) NOT NULL,
+    RECIPIENT_HASH VARCHAR(64) NOT NULL,
+    SENDER_ID VARCHAR(36),
+    PROVIDER VARCHAR(50),
+    STATUS VARCHAR(20) NOT NULL,
+    PROVIDER_RESPONSE VARCHAR(1024),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME DATETIME NOT NULL
+);
+
+-- Composite index for querying the send audit records of a recipient
+CREATE INDEX idx_notification_send_audit_recipient ON "NOTIFICATION_SEND_AUDIT" (DEPLOYMENT_ID, RECIPIENT_HASH, CREATED_AT);
+
+-- Composite index for listing the send audit records
+CREATE INDEX idx_notification_send_audit_created_at ON "NOTIFICATION_SEND_AUDIT" (DEPLOYMENT_ID, CREATED_AT);
+
+-- Index for expiry time on NOTIFICATION_SEND_AUDIT (supports cleanup)
+CREATE INDEX idx_notification_send_audit_expiry_time ON "NOTIFICATION_SEND_AUDIT" (EXPIRY_TIME);
+
+-- Table to store the audit records of MCP tool invocations
+CREATE TABLE "MCP_TOOL_CALL_AUDIT" (
+    ID VARCHAR(36) PRIMARY KEY,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    TOOL_NAME VARCHAR(100) NOT NULL,
+    ARGUMENTS TEXT,
+    SUBJECT VARCHAR(255),
+    CLIENT_ID VARCHAR(255),
+    STATUS VARCHAR(20) NOT NULL,
+    ERROR_MESSAGE VARCHAR(1024),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME DATETIME NOT NULL
+);
+
+-- Composite index for querying the tool call audit records of a subject
+CREATE INDEX idx_mcp_tool_call_audit_subject ON "MCP_TOOL_CALL_AUDIT" (DEPLOYMENT_ID, SUBJECT, CREATED_AT);
+
+-- Composite index for listing the tool call audit records
+CREATE INDEX idx_mcp_tool_call_audit_created_at ON "MCP_TOOL_CALL_AUDIT" (DEPLOYMENT_ID, CREATED_AT);
+
+-- Index for expiry time on MCP_TOOL_CALL_AUDIT (supports cleanup)
+CREATE INDEX idx_mcp_tool_call_audit_expiry_time ON "MCP_TOOL_CALL_AUDIT" (EXPIRY_TIME);
+
+-- Table to store the flow executions recorded for flow analytics
+CREATE TABLE "FLOW_EXECUTION_ANALYTICS" (
+    EXECUTION_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    FLOW_TYPE VARCHAR(50) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    LAST_NODE_ID VARCHAR(100),
+    FAILURE_REASON VARCHAR(1024),
+    DURATION_MS BIGINT,
+    STARTED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ENDED_AT TIMESTAMP,
+    EXPIRY_TIME DATETIME NOT NULL,
+    PRIMARY KEY (EXECUTION_ID, DEPLOYMENT_ID)
+);
+
+-- Composite index for querying the executions of a flow over a period
+CREATE INDEX idx_flow_execution_analytics_flow ON "FLOW_EXECUTION_ANALYTICS" (DEPLOYMENT_ID, FLOW_ID, STARTED_AT);
+
+-- Index for expiry time on FLOW_EXECUTION_ANALYTICS (supports cleanup)
+CREATE INDEX idx_flow_execution_analytics_expiry_time ON "FLOW_EXECUTION_ANALYTICS" (EXPIRY_TIME);
+
+-- Table to store the node executions recorded for flow analytics
+CREATE TABLE "FLOW_NODE_EXECUTION_ANALYTICS" (
+    ID VARCHAR(36) PRIMARY KEY,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    EXECUTION_ID VARCHAR(36) NOT NULL,
+    FLOW_ID VARCHAR(36) NOT NULL,
+    NODE_ID VARCHAR(100) NOT NULL,
+    NODE_TYPE VARCHAR(50) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    FAILURE_REASON VARCHAR(1024),
+    DURATION_MS BIGINT NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME DATETIME NOT NULL
+);
+
+-- Composite index for querying the node executions of a flow over a period
+CREATE INDEX idx_flow_node_execution_analytics_flow ON "FLOW_NODE_EXECUTION_ANALYTICS" (DEPLOYMENT_ID, FLOW_ID, CREATED_AT);
+
+-- Index for expiry time on FLOW_NODE_EXECUTION_ANALYTICS (supports cleanup)
+CREATE INDEX idx_flow_node_execution_analytics_expiry_time ON "FLOW_NODE_EXECUTION_ANALYTICS" (EXPIRY_TIME);
+
+-- Table to store the locks taken by the nodes of a cluster to run scheduled jobs
+CREATE TABLE "SCHEDULER_JOB_LOCK" (
+    JOB_NAME VARCHAR(100) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OWNER VARCHAR(255) NOT NULL,
+    EXPIRY_TIME DATETIME NOT NULL,
+    PRIMARY KEY (JOB_NAME, DEPLOYMENT_ID)
+);
@@ -16,6 +16,32 @@ CREATE TABLE "ORGANIZATION_UNIT" (
 -- Composite index for handle-based OU lookups (queryGetRootOrganizationUnitByHandle, queryGetOrganizationUnitByHandle)
 CREATE INDEX idx_ou_handle_parent ON "ORGANIZATION_UNIT" (DEPLOYMENT_ID, HANDLE, PARENT_ID);
 
+-- Table to store the B2B organizations built on organization units
+CREATE TABLE "ORGANIZATION" (
+    DEPLOYMENT_ID           VARCHAR(255) NOT NULL,
+    ID                      VARCHAR(36)  PRIMARY KEY,
+    HANDLE                  VARCHAR(100) NOT NULL,
+    NAME                    VARCHAR(255) NOT NULL,
+    OU_ID                   VARCHAR(36)  NOT NULL,
+    IDENTITY_PROVIDERS      TEXT,
+    AUTHENTICATION_POLICY   TEXT,
+    CREATED_AT              TEXT NOT NULL,
+    UPDATED_AT              TEXT NOT NULL,
+    UNIQUE (DEPLOYMENT_ID, HANDLE),
+    UNIQUE (DEPLOYMENT_ID, OU_ID)
+);
+
+-- Table to store the email domains used to discover the organization of a user
+CREATE TABLE "ORGANIZATION_DOMAIN" (
+    DEPLOYMENT_ID       VARCHAR(255) NOT NULL,
+    DOMAIN              VARCHAR(255) NOT NULL,
+    ORGANIZATION_ID     VARCHAR(36)  NOT NULL,
+    PRIMARY KEY (DOMAIN, DEPLOYMENT_ID)
+);
+
+-- Composite index for listing the email domains of an organization
+CREATE INDEX idx_organization_domain_org ON "ORGANIZATION_DOMAIN" (DEPLOYMENT_ID, ORGANIZATION_ID);
+
 -- Table to store Entities (unified identity principals: users, applications, agents)
 CREATE TABLE "ENTITY" (
     DEPLOYMENT_ID       VARCHAR(255) NOT NULL,
@@ -78,3 +104,71 @@ CREATE TABLE "ENTITY_IDENTIFIER" (
 
 -- Index for fast identifier lookups (primary use case for authentication)
 CREATE INDEX idx_entity_identifier_lookup ON "ENTITY_IDENTIFIER" (NAME, VALUE);
+
+-- Table to store the mobile devices registered by users to receive push notifications
+CREATE TABLE "PUSH_DEVICE" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ID              VARCHAR(36)  PRIMARY KEY,
+    USER_ID         VARCHAR(36)  NOT NULL,
+    PROVIDER        VARCHAR(20)  NOT NULL,
+    DEVICE_TOKEN    VARCHAR(512) NOT NULL,
+    DEVICE_NAME     VARCHAR(255),
+    CREATED_AT      TEXT NOT NULL,
+    UPDATED_AT      TEXT NOT NULL,
+    UNIQUE (DEPLOYMENT_ID, DEVICE_TOKEN)
+);
+
+-- Composite index for listing the push devices of a user
+CREATE INDEX idx_push_device_user_deployment ON "PUSH_DEVICE" (DEPLOYMENT_ID, USER_ID);
+
+-- Table to store the requests to erase users and their completion reports
+CREATE TABLE "ERASURE_REQUEST" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ID              VARCHAR(36)  PRIMARY KEY,
+    USER_ID         VARCHAR(36)  NOT NULL,
+    REASON          VARCHAR(1024),
+    STATUS          VARCHAR(20)  NOT NULL,
+    REQUESTED_BY    VARCHAR(255),
+    REPORT          TEXT,
+    CREATED_AT      TEXT NOT NULL,
+    UPDATED_AT      TEXT NOT NULL,
+    COMPLETED_AT    TEXT
+);
+
+-- Composite index for listing the erasure requests waiting to be processed
+CREATE INDEX idx_erasure_request_status ON "ERASURE_REQUEST" (DEPLOYMENT_ID, STATUS, CREATED_AT);
+
+-- Composite index for looking up the erasure requests of a user
+CREATE INDEX idx_erasure_request_user ON "ERASURE_REQUEST" (DEPLOYMENT_ID, USER_ID);
+
+-- Table to store the user and group changes pushed to the downstream SCIM endpoints and their sync status
+CREATE TABLE "PROVISIONING_OPERATION" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ID              VARCHAR(36)  PRIMARY KEY,
+    TARGET_ID       VARCHAR(255) NOT NULL,
+    RESOURCE_TYPE   VARCHAR(20)  NOT NULL,
+    RESOURCE_ID     VARCHAR(36)  NOT NULL,
+    OPERATION       VARCHAR(20)  NOT NULL,
+    PAYLOAD         TEXT,
+    STATUS          VARCHAR(20)  NOT NULL,
+    ATTEMPTS        INTEGER      NOT NULL DEFAULT 0,
+    LAST_ERROR      VARCHAR(1024),
+    CREATED_AT      TEXT NOT NULL,
+    UPDATED_AT      TEXT NOT NULL,
+    NEXT_ATTEMPT_AT TEXT,
+    COMPLETED_AT    TEXT
+);
+
+-- Composite index for listing the provisioning operations waiting to be pushed
+CREATE INDEX idx_provisioning_operation_status ON "PROVISIONING_OPERATION" (DEPLOYMENT_ID, STATUS, CREATED_AT);
+
+-- Table to store the IDs assigned to the provisioned users and groups by the downstream SCIM endpoints
+CREATE TABLE "PROVISIONED_RESOURCE" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    TARGET_ID       VARCHAR(255) NOT NULL,
+    RESOURCE_TYPE   VARCHAR(20)  NOT NULL,
+    RESOURCE_ID     VARCHAR(36)  NOT NULL,
+    EXTERNAL_ID     VARCHAR(255) NOT NULL,
+    CREATED_AT      TEXT NOT NULL,
+    PRIMARY KEY (TARGET_ID, RESOURCE_TYPE, RESOURCE_ID, DEPLOYMENT_ID)
+);
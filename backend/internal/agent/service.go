@@ -1267,6 +1267,21 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			Key:          "error.agentservice.redirect_uri_fragment_not_allowed_description",
 			DefaultValue: "Redirect URIs must not contain a fragment component",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURIWildcardNotAllowed):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.agentservice.redirect_uri_wildcard_not_allowed_description",
+			DefaultValue: "Wildcard redirect URIs are not allowed by the redirect URI policy",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURISchemeNotAllowed):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.agentservice.redirect_uri_scheme_not_allowed_description",
+			DefaultValue: "Redirect URIs must use http, https, or a custom scheme allowed by the redirect URI policy",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRedirectURIPolicy):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.agentservice.invalid_redirect_uri_policy_description",
+			DefaultValue: "Allowed custom schemes must be valid URI schemes in reverse domain name form",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs):
 		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
 			Key:          "error.agentservice.auth_code_requires_redirect_uris_description",
@@ -1293,6 +1308,11 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			Key:          "error.agentservice.refresh_token_cannot_be_sole_grant_description",
 			DefaultValue: "refresh_token grant type cannot be used without another grant type",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthRefreshTokenRequiresIssuingGrant):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.agentservice.refresh_token_requires_issuing_grant_description",
+			DefaultValue: "refresh_token grant type requires the authorization_code or CIBA grant type",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthPKCERequiresAuthCode):
 		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
 			Key:          "error.agentservice.pkce_requires_authorization_code_description",
@@ -1349,6 +1369,25 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			Key:          "error.agentservice.public_client_must_have_pkce_description",
 			DefaultValue: "Public clients must have PKCE required set to true",
 		})
+
+	// OAuth: token validity periods
+	case errors.Is(err, inboundclient.ErrOAuthInvalidTokenValidityPeriod):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.agentservice.invalid_token_validity_period_description",
+			DefaultValue: "Token validity periods must not be negative",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSlidingRefreshTokenRequiresMaxLifetime):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.agentservice.sliding_refresh_token_requires_max_lifetime_description",
+			DefaultValue: "Sliding refresh token expiration requires a maximum lifetime",
+		})
+
+	// OAuth: ACR flows
+	case errors.Is(err, inboundclient.ErrOAuthInvalidAcrFlow):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.agentservice.invalid_acr_flow_description",
+			DefaultValue: "ACR flow mappings must reference an allowed ACR value and a flow ID",
+		})
 	}
 	return nil
 }
@@ -974,6 +974,15 @@ func (suite *AgentServiceTestSuite) TestTranslateOAuthValidationError() {
 		{"RedirectURIFragmentNotAllowed", inboundclient.ErrOAuthRedirectURIFragmentNotAllowed,
 			ErrorInvalidRedirectURI.Code,
 			"error.agentservice.redirect_uri_fragment_not_allowed_description"},
+		{"RedirectURIWildcardNotAllowed", inboundclient.ErrOAuthRedirectURIWildcardNotAllowed,
+			ErrorInvalidRedirectURI.Code,
+			"error.agentservice.redirect_uri_wildcard_not_allowed_description"},
+		{"RedirectURISchemeNotAllowed", inboundclient.ErrOAuthRedirectURISchemeNotAllowed,
+			ErrorInvalidRedirectURI.Code,
+			"error.agentservice.redirect_uri_scheme_not_allowed_description"},
+		{"InvalidRedirectURIPolicy", inboundclient.ErrOAuthInvalidRedirectURIPolicy,
+			ErrorInvalidOAuthConfiguration.Code,
+			"error.agentservice.invalid_redirect_uri_policy_description"},
 		{"AuthCodeRequiresRedirectURIs", inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs,
 			ErrorInvalidOAuthConfiguration.Code,
 			"error.agentservice.auth_code_requires_redirect_uris_description"},
@@ -988,6 +997,9 @@ func (suite *AgentServiceTestSuite) TestTranslateOAuthValidationError() {
 		{"RefreshTokenCannotBeSoleGrant", inboundclient.ErrOAuthRefreshTokenCannotBeSoleGrant,
 			ErrorInvalidOAuthConfiguration.Code,
 			"error.agentservice.refresh_token_cannot_be_sole_grant_description"},
+		{"RefreshTokenRequiresIssuingGrant", inboundclient.ErrOAuthRefreshTokenRequiresIssuingGrant,
+			ErrorInvalidOAuthConfiguration.Code,
+			"error.agentservice.refresh_token_requires_issuing_grant_description"},
 		{"PKCERequiresAuthCode", inboundclient.ErrOAuthPKCERequiresAuthCode,
 			ErrorInvalidOAuthConfiguration.Code,
 			"error.agentservice.pkce_requires_authorization_code_description"},
@@ -110,6 +110,82 @@ func (_c *ApplicationServiceInterfaceMock_CreateApplication_Call) RunAndReturn(r
 	return _c
 }
 
+// CreateClientSecret provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) CreateClientSecret(ctx context.Context, appID string, request *model.ClientSecretRequest) (*model.ClientSecret, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, appID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClientSecret")
+	}
+
+	var r0 *model.ClientSecret
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.ClientSecretRequest) (*model.ClientSecret, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, appID, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.ClientSecretRequest) *model.ClientSecret); ok {
+		r0 = returnFunc(ctx, appID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ClientSecret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *model.ClientSecretRequest) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, appID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_CreateClientSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClientSecret'
+type ApplicationServiceInterfaceMock_CreateClientSecret_Call struct {
+	*mock.Call
+}
+
+// CreateClientSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - request *model.ClientSecretRequest
+func (_e *ApplicationServiceInterfaceMock_Expecter) CreateClientSecret(ctx interface{}, appID interface{}, request interface{}) *ApplicationServiceInterfaceMock_CreateClientSecret_Call {
+	return &ApplicationServiceInterfaceMock_CreateClientSecret_Call{Call: _e.mock.On("CreateClientSecret", ctx, appID, request)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_CreateClientSecret_Call) Run(run func(ctx context.Context, appID string, request *model.ClientSecretRequest)) *ApplicationServiceInterfaceMock_CreateClientSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *model.ClientSecretRequest
+		if args[2] != nil {
+			arg2 = args[2].(*model.ClientSecretRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_CreateClientSecret_Call) Return(clientSecret *model.ClientSecret, serviceError *serviceerror.ServiceError) *ApplicationServiceInterfaceMock_CreateClientSecret_Call {
+	_c.Call.Return(clientSecret, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_CreateClientSecret_Call) RunAndReturn(run func(ctx context.Context, appID string, request *model.ClientSecretRequest) (*model.ClientSecret, *serviceerror.ServiceError)) *ApplicationServiceInterfaceMock_CreateClientSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteApplication provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) DeleteApplication(ctx context.Context, appID string) *serviceerror.ServiceError {
 	ret := _mock.Called(ctx, appID)
@@ -169,6 +245,71 @@ func (_c *ApplicationServiceInterfaceMock_DeleteApplication_Call) RunAndReturn(r
 	return _c
 }
 
+// DeleteClientSecret provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) DeleteClientSecret(ctx context.Context, appID string, secretID string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, appID, secretID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteClientSecret")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, appID, secretID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// ApplicationServiceInterfaceMock_DeleteClientSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteClientSecret'
+type ApplicationServiceInterfaceMock_DeleteClientSecret_Call struct {
+	*mock.Call
+}
+
+// DeleteClientSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - secretID string
+func (_e *ApplicationServiceInterfaceMock_Expecter) DeleteClientSecret(ctx interface{}, appID interface{}, secretID interface{}) *ApplicationServiceInterfaceMock_DeleteClientSecret_Call {
+	return &ApplicationServiceInterfaceMock_DeleteClientSecret_Call{Call: _e.mock.On("DeleteClientSecret", ctx, appID, secretID)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_DeleteClientSecret_Call) Run(run func(ctx context.Context, appID string, secretID string)) *ApplicationServiceInterfaceMock_DeleteClientSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_DeleteClientSecret_Call) Return(serviceError *serviceerror.ServiceError) *ApplicationServiceInterfaceMock_DeleteClientSecret_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_DeleteClientSecret_Call) RunAndReturn(run func(ctx context.Context, appID string, secretID string) *serviceerror.ServiceError) *ApplicationServiceInterfaceMock_DeleteClientSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetApplication provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) GetApplication(ctx context.Context, appID string) (*model.Application, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, appID)
@@ -303,6 +444,129 @@ func (_c *ApplicationServiceInterfaceMock_GetApplicationList_Call) RunAndReturn(
 	return _c
 }
 
+// GetApplicationTemplates provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) GetApplicationTemplates(ctx context.Context) *model.ApplicationTemplateListResponse {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApplicationTemplates")
+	}
+
+	var r0 *model.ApplicationTemplateListResponse
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *model.ApplicationTemplateListResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplateListResponse)
+		}
+	}
+	return r0
+}
+
+// ApplicationServiceInterfaceMock_GetApplicationTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApplicationTemplates'
+type ApplicationServiceInterfaceMock_GetApplicationTemplates_Call struct {
+	*mock.Call
+}
+
+// GetApplicationTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ApplicationServiceInterfaceMock_Expecter) GetApplicationTemplates(ctx interface{}) *ApplicationServiceInterfaceMock_GetApplicationTemplates_Call {
+	return &ApplicationServiceInterfaceMock_GetApplicationTemplates_Call{Call: _e.mock.On("GetApplicationTemplates", ctx)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationTemplates_Call) Run(run func(ctx context.Context)) *ApplicationServiceInterfaceMock_GetApplicationTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationTemplates_Call) Return(applicationTemplateListResponse *model.ApplicationTemplateListResponse) *ApplicationServiceInterfaceMock_GetApplicationTemplates_Call {
+	_c.Call.Return(applicationTemplateListResponse)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationTemplates_Call) RunAndReturn(run func(ctx context.Context) *model.ApplicationTemplateListResponse) *ApplicationServiceInterfaceMock_GetApplicationTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClientSecrets provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) GetClientSecrets(ctx context.Context, appID string) (*model.ClientSecretListResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientSecrets")
+	}
+
+	var r0 *model.ClientSecretListResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.ClientSecretListResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, appID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.ClientSecretListResponse); ok {
+		r0 = returnFunc(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ClientSecretListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, appID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_GetClientSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClientSecrets'
+type ApplicationServiceInterfaceMock_GetClientSecrets_Call struct {
+	*mock.Call
+}
+
+// GetClientSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+func (_e *ApplicationServiceInterfaceMock_Expecter) GetClientSecrets(ctx interface{}, appID interface{}) *ApplicationServiceInterfaceMock_GetClientSecrets_Call {
+	return &ApplicationServiceInterfaceMock_GetClientSecrets_Call{Call: _e.mock.On("GetClientSecrets", ctx, appID)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetClientSecrets_Call) Run(run func(ctx context.Context, appID string)) *ApplicationServiceInterfaceMock_GetClientSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetClientSecrets_Call) Return(clientSecretListResponse *model.ClientSecretListResponse, serviceError *serviceerror.ServiceError) *ApplicationServiceInterfaceMock_GetClientSecrets_Call {
+	_c.Call.Return(clientSecretListResponse, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetClientSecrets_Call) RunAndReturn(run func(ctx context.Context, appID string) (*model.ClientSecretListResponse, *serviceerror.ServiceError)) *ApplicationServiceInterfaceMock_GetClientSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOAuthApplication provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) GetOAuthApplication(ctx context.Context, clientID string) (*model0.OAuthClient, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, clientID)
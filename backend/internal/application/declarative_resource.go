@@ -180,6 +180,7 @@ func parseToApplicationDTO(data []byte) (*model.ApplicationDTO, error) {
 			Assertion:                 appRequest.Assertion,
 			Certificate:               appRequest.Certificate,
 			AllowedUserTypes:          appRequest.AllowedUserTypes,
+			LoginExperience:           appRequest.LoginExperience,
 			LoginConsent:              appRequest.LoginConsent,
 		},
 		Template:  appRequest.Template,
@@ -203,6 +204,11 @@ func parseToApplicationDTO(data []byte) (*model.ApplicationDTO, error) {
 					ClientID:                           config.OAuthConfig.ClientID,
 					ClientSecret:                       config.OAuthConfig.ClientSecret,
 					RedirectURIs:                       config.OAuthConfig.RedirectURIs,
+					RedirectURIPolicy:                  config.OAuthConfig.RedirectURIPolicy,
+					BackchannelAuthentication:          config.OAuthConfig.BackchannelAuthentication,
+					PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+					BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+					FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
 					GrantTypes:                         config.OAuthConfig.GrantTypes,
 					ResponseTypes:                      config.OAuthConfig.ResponseTypes,
 					TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
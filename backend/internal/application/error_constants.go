@@ -501,4 +501,71 @@ var (
 			DefaultValue: "The provided recovery flow ID is invalid",
 		},
 	}
+	// ErrorInvalidLoginExperience is the error returned when an invalid login experience configuration is provided.
+	ErrorInvalidLoginExperience = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1037",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_login_experience",
+			DefaultValue: "Invalid login experience configuration",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.applicationservice.invalid_login_experience_description",
+			DefaultValue: "Identity provider IDs must not be empty, post-login redirect URIs must be absolute " +
+				"HTTP(S) URLs, and the remember me lifetime must not be negative",
+		},
+	}
+	// ErrorClientSecretsNotSupported is the error returned when client secrets are managed for an application
+	// that does not authenticate with a client secret.
+	ErrorClientSecretsNotSupported = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1038",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.client_secrets_not_supported",
+			DefaultValue: "Client secrets not supported",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.client_secrets_not_supported_description",
+			DefaultValue: "The application does not authenticate with a client secret",
+		},
+	}
+	// ErrorInvalidClientSecretExpiry is the error returned when a client secret expiry is not in the future.
+	ErrorInvalidClientSecretExpiry = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1039",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_client_secret_expiry",
+			DefaultValue: "Invalid client secret expiry",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_client_secret_expiry_description",
+			DefaultValue: "The expiry time of a client secret must be in the future",
+		},
+	}
+	// ErrorClientSecretNotFound is the error returned when a client secret is not found.
+	ErrorClientSecretNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1040",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.client_secret_not_found",
+			DefaultValue: "Client secret not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.client_secret_not_found_description",
+			DefaultValue: "The requested client secret could not be found",
+		},
+	}
+	// ErrorCannotDeleteLastClientSecret is the error returned when deleting the only active client secret.
+	ErrorCannotDeleteLastClientSecret = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1041",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.cannot_delete_last_client_secret",
+			DefaultValue: "Cannot delete the last client secret",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.cannot_delete_last_client_secret_description",
+			DefaultValue: "Create a new client secret before deleting the last active client secret of the application",
+		},
+	}
 )
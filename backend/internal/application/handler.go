@@ -73,6 +73,7 @@ func (ah *applicationHandler) HandleApplicationPostRequest(w http.ResponseWriter
 			Assertion:                 appRequest.Assertion,
 			Certificate:               appRequest.Certificate,
 			AllowedUserTypes:          appRequest.AllowedUserTypes,
+			LoginExperience:           appRequest.LoginExperience,
 			LoginConsent:              appRequest.LoginConsent,
 		},
 		Template:  appRequest.Template,
@@ -108,6 +109,7 @@ func (ah *applicationHandler) HandleApplicationPostRequest(w http.ResponseWriter
 			Assertion:                 createdAppDTO.Assertion,
 			Certificate:               createdAppDTO.Certificate,
 			AllowedUserTypes:          createdAppDTO.AllowedUserTypes,
+			LoginExperience:           createdAppDTO.LoginExperience,
 			LoginConsent:              createdAppDTO.LoginConsent,
 		},
 		Template:  createdAppDTO.Template,
@@ -148,6 +150,11 @@ func (ah *applicationHandler) HandleApplicationListRequest(w http.ResponseWriter
 	sysutils.WriteSuccessResponse(w, http.StatusOK, listResponse)
 }
 
+// HandleApplicationTemplateListRequest handles the application template list request.
+func (ah *applicationHandler) HandleApplicationTemplateListRequest(w http.ResponseWriter, r *http.Request) {
+	sysutils.WriteSuccessResponse(w, http.StatusOK, ah.service.GetApplicationTemplates(r.Context()))
+}
+
 // HandleApplicationGetRequest handles the application request.
 func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -186,6 +193,7 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 			Assertion:                 appDTO.Assertion,
 			Certificate:               appDTO.Certificate,
 			AllowedUserTypes:          appDTO.AllowedUserTypes,
+			LoginExperience:           appDTO.LoginExperience,
 			LoginConsent:              appDTO.LoginConsent,
 		},
 		Template:  appDTO.Template,
@@ -251,6 +259,11 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 			oAuthAppConfig := inboundmodel.OAuthConfig{
 				ClientID:                           config.OAuthConfig.ClientID,
 				RedirectURIs:                       redirectURIs,
+				RedirectURIPolicy:                  config.OAuthConfig.RedirectURIPolicy,
+				BackchannelAuthentication:          config.OAuthConfig.BackchannelAuthentication,
+				PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+				BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+				FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
 				GrantTypes:                         grantTypes,
 				ResponseTypes:                      responseTypes,
 				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
@@ -263,6 +276,7 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				AcrFlows:                           config.OAuthConfig.AcrFlows,
 			}
 			returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfig{
 				Type:        config.Type,
@@ -319,6 +333,7 @@ func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter,
 			Assertion:                 appRequest.Assertion,
 			Certificate:               appRequest.Certificate,
 			AllowedUserTypes:          appRequest.AllowedUserTypes,
+			LoginExperience:           appRequest.LoginExperience,
 			LoginConsent:              appRequest.LoginConsent,
 		},
 		Template:  appRequest.Template,
@@ -354,6 +369,7 @@ func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter,
 			Assertion:                 updatedAppDTO.Assertion,
 			Certificate:               updatedAppDTO.Certificate,
 			AllowedUserTypes:          updatedAppDTO.AllowedUserTypes,
+			LoginExperience:           updatedAppDTO.LoginExperience,
 			LoginConsent:              updatedAppDTO.LoginConsent,
 		},
 		Template:  updatedAppDTO.Template,
@@ -405,6 +421,55 @@ func (ah *applicationHandler) HandleApplicationDeleteRequest(w http.ResponseWrit
 	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
 }
 
+// HandleClientSecretListRequest handles the request to list the client secrets of an application.
+func (ah *applicationHandler) HandleClientSecretListRequest(w http.ResponseWriter, r *http.Request) {
+	listResponse, svcErr := ah.service.GetClientSecrets(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		ah.handleError(w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, listResponse)
+}
+
+// HandleClientSecretPostRequest handles the request to create a client secret for an application.
+// The request body is optional and only carries the expiry time of the secret.
+func (ah *applicationHandler) HandleClientSecretPostRequest(w http.ResponseWriter, r *http.Request) {
+	secretRequest := &model.ClientSecretRequest{}
+	if r.ContentLength != 0 {
+		decoded, err := sysutils.DecodeJSONBody[model.ClientSecretRequest](r)
+		if err != nil {
+			errResp := apierror.ErrorResponse{
+				Code:        ErrorInvalidRequestFormat.Code,
+				Message:     ErrorInvalidRequestFormat.Error,
+				Description: ErrorInvalidRequestFormat.ErrorDescription,
+			}
+			sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+			return
+		}
+		secretRequest = decoded
+	}
+
+	secret, svcErr := ah.service.CreateClientSecret(r.Context(), r.PathValue("id"), secretRequest)
+	if svcErr != nil {
+		ah.handleError(w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, secret)
+}
+
+// HandleClientSecretDeleteRequest handles the request to delete a client secret of an application.
+func (ah *applicationHandler) HandleClientSecretDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	svcErr := ah.service.DeleteClientSecret(r.Context(), r.PathValue("id"), r.PathValue("secretId"))
+	if svcErr != nil {
+		ah.handleError(w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
 // processInboundAuthConfig prepares the response for OAuth app configuration.
 func (ah *applicationHandler) processInboundAuthConfig(logger *log.Logger, appDTO *model.ApplicationDTO,
 	returnApp *model.ApplicationCompleteResponse) bool {
@@ -443,6 +508,11 @@ func (ah *applicationHandler) processInboundAuthConfig(logger *log.Logger, appDT
 				ClientID:                           config.OAuthConfig.ClientID,
 				ClientSecret:                       config.OAuthConfig.ClientSecret,
 				RedirectURIs:                       redirectURIs,
+				RedirectURIPolicy:                  config.OAuthConfig.RedirectURIPolicy,
+				BackchannelAuthentication:          config.OAuthConfig.BackchannelAuthentication,
+				PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+				BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+				FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
 				GrantTypes:                         grantTypes,
 				ResponseTypes:                      responseTypes,
 				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
@@ -455,6 +525,7 @@ func (ah *applicationHandler) processInboundAuthConfig(logger *log.Logger, appDT
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				AcrFlows:                           config.OAuthConfig.AcrFlows,
 			}
 			returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfigWithSecret{
 				Type:        config.Type,
@@ -480,7 +551,7 @@ func (ah *applicationHandler) handleError(w http.ResponseWriter, r *http.Request
 
 	statusCode := http.StatusInternalServerError
 	if svcErr.Type == serviceerror.ClientErrorType {
-		if svcErr.Code == ErrorApplicationNotFound.Code {
+		if svcErr.Code == ErrorApplicationNotFound.Code || svcErr.Code == ErrorClientSecretNotFound.Code {
 			statusCode = http.StatusNotFound
 		} else {
 			statusCode = http.StatusBadRequest
@@ -519,6 +590,11 @@ func (ah *applicationHandler) processInboundAuthConfigFromRequest(
 				ClientID:                           config.OAuthConfig.ClientID,
 				ClientSecret:                       config.OAuthConfig.ClientSecret,
 				RedirectURIs:                       config.OAuthConfig.RedirectURIs,
+				RedirectURIPolicy:                  config.OAuthConfig.RedirectURIPolicy,
+				BackchannelAuthentication:          config.OAuthConfig.BackchannelAuthentication,
+				PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+				BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+				FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
 				GrantTypes:                         config.OAuthConfig.GrantTypes,
 				ResponseTypes:                      config.OAuthConfig.ResponseTypes,
 				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
@@ -531,6 +607,7 @@ func (ah *applicationHandler) processInboundAuthConfigFromRequest(
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				AcrFlows:                           config.OAuthConfig.AcrFlows,
 			},
 		}
 		inboundAuthConfigDTOs = append(inboundAuthConfigDTOs, inboundAuthConfigDTO)
@@ -371,6 +371,30 @@ func (suite *HandlerTestSuite) TestHandleApplicationListRequest_Success() {
 	mockService.AssertExpectations(suite.T())
 }
 
+func (suite *HandlerTestSuite) TestHandleApplicationTemplateListRequest() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplicationTemplates", mock.Anything).Return(&model.ApplicationTemplateListResponse{
+		Templates: []model.ApplicationTemplate{{ID: "spa", Name: "Single-Page Application"}},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/templates", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationTemplateListRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response model.ApplicationTemplateListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), response.Templates, 1)
+	assert.Equal(suite.T(), "spa", response.Templates[0].ID)
+
+	mockService.AssertExpectations(suite.T())
+}
+
 func (suite *HandlerTestSuite) TestHandleApplicationListRequest_WithTemplate() {
 	mockService := NewApplicationServiceInterfaceMock(suite.T())
 	handler := newApplicationHandler(mockService)
@@ -1006,6 +1030,116 @@ func (suite *HandlerTestSuite) TestHandleApplicationDeleteRequest_NotFound() {
 	mockService.AssertExpectations(suite.T())
 }
 
+func (suite *HandlerTestSuite) TestHandleClientSecretListRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetClientSecrets", mock.Anything, "test-app-id").Return(&model.ClientSecretListResponse{
+		TotalResults:  1,
+		ClientSecrets: []model.ClientSecret{{ID: "secret-1"}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id/client-secrets", nil)
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleClientSecretListRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response model.ClientSecretListResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(suite.T(), 1, response.TotalResults)
+	assert.Equal(suite.T(), "secret-1", response.ClientSecrets[0].ID)
+	assert.NotContains(suite.T(), w.Body.String(), "clientSecret\"")
+}
+
+func (suite *HandlerTestSuite) TestHandleClientSecretPostRequest_WithoutBody() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("CreateClientSecret", mock.Anything, "test-app-id", &model.ClientSecretRequest{}).
+		Return(&model.ClientSecret{ID: "secret-2", Secret: "generated"}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/applications/test-app-id/client-secrets", nil)
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleClientSecretPostRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	var response model.ClientSecret
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(suite.T(), "secret-2", response.ID)
+	assert.Equal(suite.T(), "generated", response.Secret)
+}
+
+func (suite *HandlerTestSuite) TestHandleClientSecretPostRequest_WithExpiry() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("CreateClientSecret", mock.Anything, "test-app-id",
+		mock.MatchedBy(func(request *model.ClientSecretRequest) bool {
+			return request.ExpiresAt != nil && request.ExpiresAt.Year() == 2030
+		})).Return(&model.ClientSecret{ID: "secret-2", Secret: "generated"}, nil)
+
+	body := bytes.NewBufferString(`{"expiresAt":"2030-01-01T00:00:00Z"}`)
+	req := httptest.NewRequest(http.MethodPost, "/applications/test-app-id/client-secrets", body)
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleClientSecretPostRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleClientSecretPostRequest_InvalidBody() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodPost, "/applications/test-app-id/client-secrets",
+		bytes.NewBufferString(`{"expiresAt":"tomorrow"}`))
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleClientSecretPostRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(suite.T(), "CreateClientSecret", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerTestSuite) TestHandleClientSecretDeleteRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("DeleteClientSecret", mock.Anything, "test-app-id", "secret-1").Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/applications/test-app-id/client-secrets/secret-1", nil)
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("secretId", "secret-1")
+	w := httptest.NewRecorder()
+
+	handler.HandleClientSecretDeleteRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleClientSecretDeleteRequest_NotFound() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("DeleteClientSecret", mock.Anything, "test-app-id", "missing").
+		Return(&ErrorClientSecretNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/applications/test-app-id/client-secrets/missing", nil)
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("secretId", "missing")
+	w := httptest.NewRecorder()
+
+	handler.HandleClientSecretDeleteRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
 func (suite *HandlerTestSuite) TestHandleApplicationDeleteRequest_ServiceError() {
 	mockService := NewApplicationServiceInterfaceMock(suite.T())
 	handler := newApplicationHandler(mockService)
@@ -32,6 +32,7 @@ import (
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	"github.com/thunder-id/thunderid/internal/system/mcp/resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 )
 
@@ -64,11 +65,16 @@ func Initialize(
 		}
 	}
 
+	if mcpServer != nil {
+		appService = newNotifyingApplicationService(appService, resource.NewNotifier(mcpServer))
+	}
+
 	appHandler := newApplicationHandler(appService)
 	registerRoutes(mux, appHandler)
 
 	if mcpServer != nil {
 		registerMCPTools(mcpServer, appService)
+		registerMCPResources(mcpServer, appService)
 	}
 
 	exporter := newApplicationExporter(appService)
@@ -97,6 +103,8 @@ func registerRoutes(mux *http.ServeMux, appHandler *applicationHandler) {
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
+	mux.HandleFunc(middleware.WithCORS("GET /applications/templates",
+		appHandler.HandleApplicationTemplateListRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("GET /applications/{id}",
 		appHandler.HandleApplicationGetRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("PUT /applications/{id}",
@@ -107,4 +115,25 @@ func registerRoutes(mux *http.ServeMux, appHandler *applicationHandler) {
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts2))
+
+	opts3 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /applications/{id}/client-secrets",
+		appHandler.HandleClientSecretListRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("POST /applications/{id}/client-secrets",
+		appHandler.HandleClientSecretPostRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("DELETE /applications/{id}/client-secrets/{secretId}",
+		appHandler.HandleClientSecretDeleteRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /applications/{id}/client-secrets",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /applications/{id}/client-secrets/{secretId}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
 }
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import "time"
+
+// ClientSecret represents a client secret of an application. The secret value is only returned when the
+// secret is created.
+type ClientSecret struct {
+	ID        string     `json:"id"`
+	Secret    string     `json:"clientSecret,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// ClientSecretRequest represents the request body for creating a client secret.
+type ClientSecretRequest struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// ClientSecretListResponse represents the response structure for listing the client secrets of an application.
+type ClientSecretListResponse struct {
+	TotalResults  int            `json:"totalResults"`
+	ClientSecrets []ClientSecret `json:"clientSecrets"`
+}
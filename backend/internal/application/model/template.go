@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+// ApplicationTemplate represents a creation template for a common type of client.
+type ApplicationTemplate struct {
+	ID          string             `json:"id" jsonschema:"Template ID to set as the template of a new application."`
+	Name        string             `json:"name" jsonschema:"Display name of the template."`
+	Description string             `json:"description" jsonschema:"Type of client the template is intended for."`
+	Defaults    ApplicationRequest `json:"defaults" jsonschema:"Default application settings applied by the template."`
+}
+
+// ApplicationTemplateListResponse represents the response structure for listing application templates.
+type ApplicationTemplateListResponse struct {
+	Templates []ApplicationTemplate `json:"templates" jsonschema:"Available application templates."`
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/thunder-id/thunderid/internal/application/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/mcp/resource"
+)
+
+// applicationsResourceURI is the URI of the MCP resource listing the applications.
+var applicationsResourceURI = resource.BuildURI("applications")
+
+// applicationResources provides MCP resources exposing the application metadata.
+type applicationResources struct {
+	appService ApplicationServiceInterface
+}
+
+// registerMCPResources registers all application resources with the MCP server.
+func registerMCPResources(server *mcp.Server, appService ApplicationServiceInterface) {
+	resources := &applicationResources{
+		appService: appService,
+	}
+
+	server.AddResource(&mcp.Resource{
+		URI:         applicationsResourceURI,
+		Name:        "applications",
+		Title:       "Applications",
+		Description: `Basic details of all registered applications.`,
+		MIMEType:    resource.MIMETypeJSON,
+	}, resources.readApplications)
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: applicationsResourceURI + "/{id}",
+		Name:        "application",
+		Title:       "Application",
+		Description: `Full details of an application including OAuth settings, customizations, and flow ` +
+			`associations.`,
+		MIMEType: resource.MIMETypeJSON,
+	}, resources.readApplication)
+}
+
+// readApplications handles reads of the application list resource.
+func (r *applicationResources) readApplications(
+	ctx context.Context,
+	req *mcp.ReadResourceRequest,
+) (*mcp.ReadResourceResult, error) {
+	listResponse, svcErr := r.appService.GetApplicationList(ctx)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to list applications: %s", svcErr.ErrorDescription)
+	}
+
+	return resource.JSONContents(req.Params.URI, model.ApplicationListOutput{
+		TotalCount:   listResponse.TotalResults,
+		Applications: listResponse.Applications,
+	})
+}
+
+// readApplication handles reads of an application resource.
+func (r *applicationResources) readApplication(
+	ctx context.Context,
+	req *mcp.ReadResourceRequest,
+) (*mcp.ReadResourceResult, error) {
+	appID, ok := resource.ExtractID(req.Params.URI, applicationsResourceURI)
+	if !ok {
+		return nil, mcp.ResourceNotFoundError(req.Params.URI)
+	}
+
+	app, svcErr := r.appService.GetApplication(ctx, appID)
+	if svcErr != nil {
+		if svcErr.Code == ErrorApplicationNotFound.Code {
+			return nil, mcp.ResourceNotFoundError(req.Params.URI)
+		}
+		return nil, fmt.Errorf("failed to get application: %s", svcErr.ErrorDescription)
+	}
+
+	return resource.JSONContents(req.Params.URI, app)
+}
+
+// notifyingApplicationService decorates the application service to notify the MCP clients subscribed to the
+// application resources when an application changes.
+type notifyingApplicationService struct {
+	ApplicationServiceInterface
+	notifier resource.NotifierInterface
+}
+
+// newNotifyingApplicationService creates an application service that sends resource change notifications.
+func newNotifyingApplicationService(
+	appService ApplicationServiceInterface,
+	notifier resource.NotifierInterface,
+) ApplicationServiceInterface {
+	return &notifyingApplicationService{
+		ApplicationServiceInterface: appService,
+		notifier:                    notifier,
+	}
+}
+
+// CreateApplication creates an application and notifies the subscribers of the application list.
+func (s *notifyingApplicationService) CreateApplication(
+	ctx context.Context, app *model.ApplicationDTO,
+) (*model.ApplicationDTO, *serviceerror.ServiceError) {
+	createdApp, svcErr := s.ApplicationServiceInterface.CreateApplication(ctx, app)
+	if svcErr == nil {
+		s.notifier.NotifyUpdated(ctx, applicationsResourceURI)
+	}
+	return createdApp, svcErr
+}
+
+// UpdateApplication updates an application and notifies the subscribers of the application and the
+// application list.
+func (s *notifyingApplicationService) UpdateApplication(
+	ctx context.Context, appID string, app *model.ApplicationDTO,
+) (*model.ApplicationDTO, *serviceerror.ServiceError) {
+	updatedApp, svcErr := s.ApplicationServiceInterface.UpdateApplication(ctx, appID, app)
+	if svcErr == nil {
+		s.notifyApplicationChanged(ctx, appID)
+	}
+	return updatedApp, svcErr
+}
+
+// DeleteApplication deletes an application and notifies the subscribers of the application and the
+// application list.
+func (s *notifyingApplicationService) DeleteApplication(
+	ctx context.Context, appID string,
+) *serviceerror.ServiceError {
+	svcErr := s.ApplicationServiceInterface.DeleteApplication(ctx, appID)
+	if svcErr == nil {
+		s.notifyApplicationChanged(ctx, appID)
+	}
+	return svcErr
+}
+
+// notifyApplicationChanged notifies the subscribers of the given application and of the application list.
+func (s *notifyingApplicationService) notifyApplicationChanged(ctx context.Context, appID string) {
+	s.notifier.NotifyUpdated(ctx, resource.BuildURI("applications", appID), applicationsResourceURI)
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/application/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/mcp/resourcemock"
+)
+
+const testApplicationResourceURI = "thunderid://applications/app-1"
+
+type ApplicationResourcesTestSuite struct {
+	suite.Suite
+	mockService  *ApplicationServiceInterfaceMock
+	mockNotifier *resourcemock.NotifierInterfaceMock
+	resources    *applicationResources
+}
+
+func TestApplicationResourcesTestSuite(t *testing.T) {
+	suite.Run(t, new(ApplicationResourcesTestSuite))
+}
+
+func (s *ApplicationResourcesTestSuite) SetupTest() {
+	s.mockService = NewApplicationServiceInterfaceMock(s.T())
+	s.mockNotifier = resourcemock.NewNotifierInterfaceMock(s.T())
+	s.resources = &applicationResources{appService: s.mockService}
+}
+
+func (s *ApplicationResourcesTestSuite) newReadResourceRequest(uri string) *mcp.ReadResourceRequest {
+	return &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}}
+}
+
+func (s *ApplicationResourcesTestSuite) TestReadApplications_Success() {
+	s.mockService.On("GetApplicationList", mock.Anything).Return(&model.ApplicationListResponse{
+		TotalResults: 1,
+		Count:        1,
+		Applications: []model.BasicApplicationResponse{{ID: "app-1", Name: "Test App"}},
+	}, nil)
+
+	result, err := s.resources.readApplications(context.Background(),
+		s.newReadResourceRequest(applicationsResourceURI))
+
+	s.Require().NoError(err)
+	s.Require().Len(result.Contents, 1)
+
+	var output model.ApplicationListOutput
+	s.Require().NoError(json.Unmarshal([]byte(result.Contents[0].Text), &output))
+	s.Equal(1, output.TotalCount)
+	s.Require().Len(output.Applications, 1)
+	s.Equal("app-1", output.Applications[0].ID)
+}
+
+func (s *ApplicationResourcesTestSuite) TestReadApplications_Error() {
+	s.mockService.On("GetApplicationList", mock.Anything).Return(nil, &serviceerror.InternalServerError)
+
+	result, err := s.resources.readApplications(context.Background(),
+		s.newReadResourceRequest(applicationsResourceURI))
+
+	s.Error(err)
+	s.Nil(result)
+}
+
+func (s *ApplicationResourcesTestSuite) TestReadApplication_Success() {
+	s.mockService.On("GetApplication", mock.Anything, "app-1").
+		Return(&model.Application{ID: "app-1", Name: "Test App"}, nil)
+
+	result, err := s.resources.readApplication(context.Background(),
+		s.newReadResourceRequest(testApplicationResourceURI))
+
+	s.Require().NoError(err)
+	s.Require().Len(result.Contents, 1)
+
+	var app model.Application
+	s.Require().NoError(json.Unmarshal([]byte(result.Contents[0].Text), &app))
+	s.Equal("app-1", app.ID)
+	s.Equal("Test App", app.Name)
+}
+
+func (s *ApplicationResourcesTestSuite) TestReadApplication_NotFound() {
+	s.mockService.On("GetApplication", mock.Anything, "app-1").Return(nil, &ErrorApplicationNotFound)
+
+	result, err := s.resources.readApplication(context.Background(),
+		s.newReadResourceRequest(testApplicationResourceURI))
+
+	s.Nil(result)
+	var rpcErr *jsonrpc.Error
+	s.Require().True(errors.As(err, &rpcErr))
+	s.Equal(int64(mcp.CodeResourceNotFound), rpcErr.Code)
+}
+
+func (s *ApplicationResourcesTestSuite) TestNotifyingApplicationService_CreateApplication() {
+	service := newNotifyingApplicationService(s.mockService, s.mockNotifier)
+	app := &model.ApplicationDTO{Name: "Test App"}
+	s.mockService.On("CreateApplication", mock.Anything, app).Return(&model.ApplicationDTO{ID: "app-1"}, nil)
+	s.mockNotifier.On("NotifyUpdated", mock.Anything, applicationsResourceURI).Return()
+
+	createdApp, svcErr := service.CreateApplication(context.Background(), app)
+
+	s.Nil(svcErr)
+	s.Equal("app-1", createdApp.ID)
+}
+
+func (s *ApplicationResourcesTestSuite) TestNotifyingApplicationService_UpdateApplication() {
+	service := newNotifyingApplicationService(s.mockService, s.mockNotifier)
+	app := &model.ApplicationDTO{Name: "Test App"}
+	s.mockService.On("UpdateApplication", mock.Anything, "app-1", app).
+		Return(&model.ApplicationDTO{ID: "app-1"}, nil)
+	s.mockNotifier.On("NotifyUpdated", mock.Anything, testApplicationResourceURI, applicationsResourceURI).Return()
+
+	_, svcErr := service.UpdateApplication(context.Background(), "app-1", app)
+
+	s.Nil(svcErr)
+}
+
+func (s *ApplicationResourcesTestSuite) TestNotifyingApplicationService_DeleteApplication() {
+	service := newNotifyingApplicationService(s.mockService, s.mockNotifier)
+	s.mockService.On("DeleteApplication", mock.Anything, "app-1").Return(nil)
+	s.mockNotifier.On("NotifyUpdated", mock.Anything, testApplicationResourceURI, applicationsResourceURI).Return()
+
+	s.Nil(service.DeleteApplication(context.Background(), "app-1"))
+}
+
+func (s *ApplicationResourcesTestSuite) TestNotifyingApplicationService_NoNotificationOnError() {
+	service := newNotifyingApplicationService(s.mockService, s.mockNotifier)
+	s.mockService.On("DeleteApplication", mock.Anything, "app-1").Return(&ErrorApplicationNotFound)
+
+	svcErr := service.DeleteApplication(context.Background(), "app-1")
+
+	s.Equal(&ErrorApplicationNotFound, svcErr)
+	s.mockNotifier.AssertNotCalled(s.T(), "NotifyUpdated", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -22,7 +22,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"slices"
+	"strings"
+	"time"
 
 	"encoding/json"
 
@@ -57,6 +60,12 @@ type ApplicationServiceInterface interface {
 		ctx context.Context, appID string, app *model.ApplicationDTO) (
 		*model.ApplicationDTO, *serviceerror.ServiceError)
 	DeleteApplication(ctx context.Context, appID string) *serviceerror.ServiceError
+	GetApplicationTemplates(ctx context.Context) *model.ApplicationTemplateListResponse
+	GetClientSecrets(ctx context.Context, appID string) (
+		*model.ClientSecretListResponse, *serviceerror.ServiceError)
+	CreateClientSecret(ctx context.Context, appID string, request *model.ClientSecretRequest) (
+		*model.ClientSecret, *serviceerror.ServiceError)
+	DeleteClientSecret(ctx context.Context, appID string, secretID string) *serviceerror.ServiceError
 }
 
 // ApplicationService is the default implementation of the ApplicationServiceInterface.
@@ -107,6 +116,8 @@ func (as *applicationService) CreateApplication(ctx context.Context, app *model.
 		return nil, &ErrorCannotModifyDeclarativeResource
 	}
 
+	applyApplicationTemplate(app)
+
 	processedDTO, inboundAuthConfig, svcErr := as.ValidateApplication(ctx, app)
 	if svcErr != nil {
 		return nil, svcErr
@@ -540,6 +551,170 @@ func (as *applicationService) DeleteApplication(ctx context.Context, appID strin
 	return as.deleteLocalizedVariants(ctx, appID)
 }
 
+// GetApplicationTemplates returns the templates available for creating applications of common client types.
+func (as *applicationService) GetApplicationTemplates(ctx context.Context) *model.ApplicationTemplateListResponse {
+	return &model.ApplicationTemplateListResponse{Templates: getApplicationTemplates()}
+}
+
+// GetClientSecrets returns the client secrets of an application. Secret values are never returned.
+func (as *applicationService) GetClientSecrets(ctx context.Context, appID string) (
+	*model.ClientSecretListResponse, *serviceerror.ServiceError) {
+	if svcErr := as.validateClientSecretApplication(ctx, appID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	credentials, epErr := as.entityProvider.GetSystemCredentials(appID, fieldClientSecret)
+	if epErr != nil {
+		if svcErr := mapEntityProviderError(epErr); svcErr != nil {
+			return nil, svcErr
+		}
+		as.logger.Error("Failed to get client secrets", log.String("appID", appID), log.Error(epErr))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	secrets := make([]model.ClientSecret, 0, len(credentials))
+	for _, credential := range credentials {
+		secrets = append(secrets, toClientSecret(credential))
+	}
+	return &model.ClientSecretListResponse{
+		TotalResults:  len(secrets),
+		ClientSecrets: secrets,
+	}, nil
+}
+
+// CreateClientSecret generates a new client secret for an application. Existing client secrets stay valid
+// until they expire or are deleted, so the secret can be rotated without downtime.
+func (as *applicationService) CreateClientSecret(ctx context.Context, appID string,
+	request *model.ClientSecretRequest) (*model.ClientSecret, *serviceerror.ServiceError) {
+	if svcErr := as.validateClientSecretModification(ctx, appID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	var expiresAt *time.Time
+	if request != nil && request.ExpiresAt != nil {
+		if !request.ExpiresAt.After(time.Now()) {
+			return nil, &ErrorInvalidClientSecretExpiry
+		}
+		expiry := request.ExpiresAt.UTC()
+		expiresAt = &expiry
+	}
+
+	secretValue, err := oauthutils.GenerateOAuth2ClientSecret()
+	if err != nil {
+		as.logger.Error("Failed to generate OAuth client secret", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	credential, epErr := as.entityProvider.AddSystemCredential(appID, fieldClientSecret, secretValue, expiresAt)
+	if epErr != nil {
+		if svcErr := mapEntityProviderError(epErr); svcErr != nil {
+			return nil, svcErr
+		}
+		as.logger.Error("Failed to add client secret", log.String("appID", appID), log.Error(epErr))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	secret := toClientSecret(*credential)
+	secret.Secret = secretValue
+	return &secret, nil
+}
+
+// DeleteClientSecret deletes a client secret of an application. The last active client secret cannot be
+// deleted, as the application could no longer authenticate.
+func (as *applicationService) DeleteClientSecret(ctx context.Context, appID string,
+	secretID string) *serviceerror.ServiceError {
+	if svcErr := as.validateClientSecretModification(ctx, appID); svcErr != nil {
+		return svcErr
+	}
+	if secretID == "" {
+		return &ErrorClientSecretNotFound
+	}
+
+	credentials, epErr := as.entityProvider.GetSystemCredentials(appID, fieldClientSecret)
+	if epErr != nil {
+		if svcErr := mapEntityProviderError(epErr); svcErr != nil {
+			return svcErr
+		}
+		as.logger.Error("Failed to get client secrets", log.String("appID", appID), log.Error(epErr))
+		return &serviceerror.InternalServerError
+	}
+
+	now := time.Now()
+	found := false
+	otherActive := false
+	for _, credential := range credentials {
+		if credential.ID == secretID {
+			found = true
+		} else if credential.ExpiresAt == nil || now.Before(*credential.ExpiresAt) {
+			otherActive = true
+		}
+	}
+	if !found {
+		return &ErrorClientSecretNotFound
+	}
+	if !otherActive {
+		return &ErrorCannotDeleteLastClientSecret
+	}
+
+	if epErr := as.entityProvider.DeleteSystemCredential(appID, fieldClientSecret, secretID); epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeCredentialNotFound {
+			return &ErrorClientSecretNotFound
+		}
+		if svcErr := mapEntityProviderError(epErr); svcErr != nil {
+			return svcErr
+		}
+		as.logger.Error("Failed to delete client secret", log.String("appID", appID), log.Error(epErr))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// validateClientSecretModification checks that the client secrets of an application can be modified.
+func (as *applicationService) validateClientSecretModification(
+	ctx context.Context, appID string) *serviceerror.ServiceError {
+	if isDeclarativeModeEnabled() {
+		return &ErrorCannotModifyDeclarativeResource
+	}
+	if appID != "" && as.inboundClientService.IsDeclarative(ctx, appID) {
+		return &ErrorCannotModifyDeclarativeResource
+	}
+	return as.validateClientSecretApplication(ctx, appID)
+}
+
+// validateClientSecretApplication checks that the application exists and authenticates with a client secret.
+func (as *applicationService) validateClientSecretApplication(
+	ctx context.Context, appID string) *serviceerror.ServiceError {
+	if appID == "" {
+		return &ErrorInvalidApplicationID
+	}
+
+	app, svcErr := as.getApplication(ctx, appID)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	oauthConfig := getOAuthInboundAuthConfigProcessedDTO(app.InboundAuthConfig)
+	if oauthConfig == nil || oauthConfig.OAuthConfig == nil || oauthConfig.OAuthConfig.PublicClient {
+		return &ErrorClientSecretsNotSupported
+	}
+	switch oauthConfig.OAuthConfig.TokenEndpointAuthMethod {
+	case oauth2const.TokenEndpointAuthMethodClientSecretBasic,
+		oauth2const.TokenEndpointAuthMethodClientSecretPost:
+		return nil
+	default:
+		return &ErrorClientSecretsNotSupported
+	}
+}
+
+// toClientSecret converts the metadata of a stored client secret to its API representation.
+func toClientSecret(credential entityprovider.CredentialMetadata) model.ClientSecret {
+	return model.ClientSecret{
+		ID:        credential.ID,
+		CreatedAt: credential.CreatedAt,
+		ExpiresAt: credential.ExpiresAt,
+	}
+}
+
 // isIdentifierTaken checks if an entity with the given identifier already exists.
 // If excludeID is non-empty, the entity with that ID is excluded from the check
 // (used during declarative loading and updates where the entity already exists).
@@ -625,6 +800,7 @@ func toInboundClient(dto *model.ApplicationProcessedDTO) inboundmodel.InboundCli
 		Assertion:                 dto.Assertion,
 		LoginConsent:              dto.LoginConsent,
 		AllowedUserTypes:          dto.AllowedUserTypes,
+		LoginExperience:           dto.LoginExperience,
 	}
 
 	// Pack remaining fields into Properties.
@@ -675,6 +851,7 @@ func toProcessedDTO(
 			Assertion:                 dao.Assertion,
 			LoginConsent:              dao.LoginConsent,
 			AllowedUserTypes:          dao.AllowedUserTypes,
+			LoginExperience:           dao.LoginExperience,
 		},
 	}
 
@@ -774,6 +951,11 @@ func buildOAuthProfileFromProcessed(inboundAuth inboundmodel.InboundAuthConfigPr
 	oa := inboundAuth.OAuthConfig
 	return &inboundmodel.OAuthProfile{
 		RedirectURIs:                       oa.RedirectURIs,
+		RedirectURIPolicy:                  oa.RedirectURIPolicy,
+		BackchannelAuthentication:          oa.BackchannelAuthentication,
+		PostLogoutRedirectURIs:             oa.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               oa.BackchannelLogoutURI,
+		FrontchannelLogoutURI:              oa.FrontchannelLogoutURI,
 		GrantTypes:                         sysutils.ConvertToStringSlice(oa.GrantTypes),
 		ResponseTypes:                      sysutils.ConvertToStringSlice(oa.ResponseTypes),
 		TokenEndpointAuthMethod:            string(oa.TokenEndpointAuthMethod),
@@ -786,6 +968,7 @@ func buildOAuthProfileFromProcessed(inboundAuth inboundmodel.InboundAuthConfigPr
 		UserInfo:                           oa.UserInfo,
 		Certificate:                        oa.Certificate,
 		AcrValues:                          oa.AcrValues,
+		AcrFlows:                           oa.AcrFlows,
 	}
 }
 
@@ -925,6 +1108,9 @@ func (as *applicationService) validateApplicationFields(
 	if app.LogoURL != "" && !sysutils.IsValidLogoURI(app.LogoURL) {
 		return &ErrorInvalidLogoURL
 	}
+	if !isValidLoginExperience(app.LoginExperience) {
+		return &ErrorInvalidLoginExperience
+	}
 	// Reject requests with more than one OAuth-typed inbound auth entry — at most one
 	// inbound auth config per protocol per application is allowed.
 	isOAuthConfig := false
@@ -941,6 +1127,29 @@ func (as *applicationService) validateApplicationFields(
 	return nil
 }
 
+// isValidLoginExperience reports whether the login experience configuration is well-formed.
+// A nil configuration is valid and leaves the login flow unrestricted.
+func isValidLoginExperience(le *inboundmodel.LoginExperienceConfig) bool {
+	if le == nil {
+		return true
+	}
+	for _, idpID := range le.AllowedIDPs {
+		if strings.TrimSpace(idpID) == "" {
+			return false
+		}
+	}
+	for _, rule := range le.PostLoginRedirects {
+		parsed, err := url.Parse(rule.RedirectURI)
+		if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			return false
+		}
+	}
+	if le.RememberMe != nil && le.RememberMe.Lifetime < 0 {
+		return false
+	}
+	return true
+}
+
 // validateConsentConfig validates the consent configuration for the application.
 func (as *applicationService) validateConsentConfig(appDTO *model.ApplicationDTO) {
 	if appDTO.LoginConsent == nil {
@@ -1058,11 +1267,41 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 	// OAuth: redirect URI
 	case errors.Is(err, inboundclient.ErrOAuthInvalidRedirectURI):
 		return &ErrorInvalidRedirectURI
+	case errors.Is(err, inboundclient.ErrOAuthInvalidPostLogoutRedirectURI):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_post_logout_redirect_uri_description",
+			DefaultValue: "Post-logout redirect URIs must be absolute URIs without a fragment or a wildcard",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidBackchannelLogoutURI):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_backchannel_logout_uri_description",
+			DefaultValue: "The back-channel logout URI must be an absolute https URI without a fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidFrontchannelLogoutURI):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_frontchannel_logout_uri_description",
+			DefaultValue: "The front-channel logout URI must be an absolute https URI without a fragment",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthRedirectURIFragmentNotAllowed):
 		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
 			Key:          "error.applicationservice.redirect_uri_fragment_not_allowed_description",
 			DefaultValue: "Redirect URIs must not contain a fragment component",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURIWildcardNotAllowed):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.applicationservice.redirect_uri_wildcard_not_allowed_description",
+			DefaultValue: "Wildcard redirect URIs are not allowed by the redirect URI policy",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURISchemeNotAllowed):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.applicationservice.redirect_uri_scheme_not_allowed_description",
+			DefaultValue: "Redirect URIs must use http, https, or a custom scheme allowed by the redirect URI policy",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRedirectURIPolicy):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_redirect_uri_policy_description",
+			DefaultValue: "Allowed custom schemes must be valid URI schemes in reverse domain name form",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs):
 		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
 			Key:          "error.applicationservice.auth_code_requires_redirect_uris_description",
@@ -1089,6 +1328,11 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			Key:          "error.applicationservice.refresh_token_cannot_be_sole_grant_description",
 			DefaultValue: "refresh_token grant type cannot be used without another grant type",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthRefreshTokenRequiresIssuingGrant):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.refresh_token_requires_issuing_grant_description",
+			DefaultValue: "refresh_token grant type requires the authorization_code or CIBA grant type",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthPKCERequiresAuthCode):
 		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
 			Key:          "error.applicationservice.pkce_requires_authorization_code_description",
@@ -1100,6 +1344,45 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			DefaultValue: "Response types can only be configured with the authorization_code grant type",
 		})
 
+	// OAuth: backchannel authentication
+	case errors.Is(err, inboundclient.ErrOAuthInvalidBackchannelTokenDeliveryMode):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_backchannel_token_delivery_mode_description",
+			DefaultValue: "Backchannel token delivery mode must be either 'poll' or 'ping'",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthPingModeRequiresNotificationEndpoint):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.ping_mode_requires_notification_endpoint_description",
+			DefaultValue: "The 'ping' backchannel token delivery mode requires a client notification endpoint",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidClientNotificationEndpoint):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_client_notification_endpoint_description",
+			DefaultValue: "The client notification endpoint must be an absolute https URL",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidClaimMapping):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_claim_mapping_description",
+			DefaultValue: "Token claim mappings must map each attribute to a distinct, non-reserved claim name",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidTokenValidityPeriod):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_token_validity_period_description",
+			DefaultValue: "Token validity periods must not be negative",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthSlidingRefreshTokenRequiresMaxLifetime):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.sliding_refresh_token_requires_max_lifetime_description",
+			DefaultValue: "Sliding refresh token expiration requires a maximum lifetime",
+		})
+
+	// OAuth: ACR flows
+	case errors.Is(err, inboundclient.ErrOAuthInvalidAcrFlow):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_acr_flow_description",
+			DefaultValue: "ACR flow mappings must reference an allowed ACR value and a flow ID",
+		})
+
 	// OAuth: token endpoint auth method
 	case errors.Is(err, inboundclient.ErrOAuthInvalidTokenEndpointAuthMethod):
 		return &ErrorInvalidTokenEndpointAuthMethod
@@ -1514,6 +1797,7 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *model.Applica
 			LayoutID:                  dto.LayoutID,
 			Assertion:                 dto.Assertion,
 			AllowedUserTypes:          dto.AllowedUserTypes,
+			LoginExperience:           dto.LoginExperience,
 			LoginConsent:              dto.LoginConsent,
 		},
 		Template:  dto.Template,
@@ -1533,6 +1817,11 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *model.Applica
 				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
 					ClientID:                           oauthAppConfig.ClientID,
 					RedirectURIs:                       oauthAppConfig.RedirectURIs,
+					RedirectURIPolicy:                  oauthAppConfig.RedirectURIPolicy,
+					BackchannelAuthentication:          oauthAppConfig.BackchannelAuthentication,
+					PostLogoutRedirectURIs:             oauthAppConfig.PostLogoutRedirectURIs,
+					BackchannelLogoutURI:               oauthAppConfig.BackchannelLogoutURI,
+					FrontchannelLogoutURI:              oauthAppConfig.FrontchannelLogoutURI,
 					GrantTypes:                         oauthAppConfig.GrantTypes,
 					ResponseTypes:                      oauthAppConfig.ResponseTypes,
 					TokenEndpointAuthMethod:            oauthAppConfig.TokenEndpointAuthMethod,
@@ -1544,6 +1833,7 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *model.Applica
 					UserInfo:                           oauthAppConfig.UserInfo,
 					ScopeClaims:                        oauthAppConfig.ScopeClaims,
 					AcrValues:                          oauthAppConfig.AcrValues,
+					AcrFlows:                           oauthAppConfig.AcrFlows,
 				},
 			})
 		}
@@ -1615,6 +1905,7 @@ func buildBaseApplicationProcessedDTO(appID string, app *model.ApplicationDTO,
 			LayoutID:                  app.LayoutID,
 			Assertion:                 assertion,
 			AllowedUserTypes:          app.AllowedUserTypes,
+			LoginExperience:           app.LoginExperience,
 			LoginConsent:              app.LoginConsent,
 		},
 		Template:  app.Template,
@@ -1656,6 +1947,11 @@ func buildOAuthInboundAuthConfigProcessedDTO(
 			ID:                                 appID,
 			ClientID:                           inboundAuthConfig.OAuthConfig.ClientID,
 			RedirectURIs:                       inboundAuthConfig.OAuthConfig.RedirectURIs,
+			RedirectURIPolicy:                  inboundAuthConfig.OAuthConfig.RedirectURIPolicy,
+			BackchannelAuthentication:          inboundAuthConfig.OAuthConfig.BackchannelAuthentication,
+			PostLogoutRedirectURIs:             inboundAuthConfig.OAuthConfig.PostLogoutRedirectURIs,
+			BackchannelLogoutURI:               inboundAuthConfig.OAuthConfig.BackchannelLogoutURI,
+			FrontchannelLogoutURI:              inboundAuthConfig.OAuthConfig.FrontchannelLogoutURI,
 			GrantTypes:                         inboundAuthConfig.OAuthConfig.GrantTypes,
 			ResponseTypes:                      inboundAuthConfig.OAuthConfig.ResponseTypes,
 			TokenEndpointAuthMethod:            inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod,
@@ -1668,6 +1964,7 @@ func buildOAuthInboundAuthConfigProcessedDTO(
 			ScopeClaims:                        scopeClaims,
 			Certificate:                        certificate,
 			AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
+			AcrFlows:                           inboundAuthConfig.OAuthConfig.AcrFlows,
 		},
 	}
 }
@@ -1694,6 +1991,7 @@ func buildReturnApplicationDTO(
 			Assertion:                 assertion,
 			Certificate:               app.Certificate,
 			AllowedUserTypes:          app.AllowedUserTypes,
+			LoginExperience:           app.LoginExperience,
 			LoginConsent:              app.LoginConsent,
 		},
 		Template:  app.Template,
@@ -1715,6 +2013,11 @@ func buildReturnApplicationDTO(
 				ClientID:                           inboundAuthConfig.OAuthConfig.ClientID,
 				ClientSecret:                       inboundAuthConfig.OAuthConfig.ClientSecret,
 				RedirectURIs:                       inboundAuthConfig.OAuthConfig.RedirectURIs,
+				RedirectURIPolicy:                  inboundAuthConfig.OAuthConfig.RedirectURIPolicy,
+				BackchannelAuthentication:          inboundAuthConfig.OAuthConfig.BackchannelAuthentication,
+				PostLogoutRedirectURIs:             inboundAuthConfig.OAuthConfig.PostLogoutRedirectURIs,
+				BackchannelLogoutURI:               inboundAuthConfig.OAuthConfig.BackchannelLogoutURI,
+				FrontchannelLogoutURI:              inboundAuthConfig.OAuthConfig.FrontchannelLogoutURI,
 				GrantTypes:                         inboundAuthConfig.OAuthConfig.GrantTypes,
 				ResponseTypes:                      inboundAuthConfig.OAuthConfig.ResponseTypes,
 				TokenEndpointAuthMethod:            inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod,
@@ -1727,6 +2030,7 @@ func buildReturnApplicationDTO(
 				ScopeClaims:                        scopeClaims,
 				Certificate:                        oauthCert,
 				AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
+				AcrFlows:                           inboundAuthConfig.OAuthConfig.AcrFlows,
 			},
 		}
 		returnApp.InboundAuthConfig = []inboundmodel.InboundAuthConfigWithSecret{returnInboundAuthConfig}
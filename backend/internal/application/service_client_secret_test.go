@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/application/model"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+)
+
+// setupClientSecretTestService wires a service for an application with the given token endpoint
+// authentication method and returns the entity provider mock for credential expectations.
+func (suite *ServiceTestSuite) setupClientSecretTestService(
+	authMethod oauth2const.TokenEndpointAuthMethod,
+) (*applicationService, *inboundclientmock.InboundClientServiceInterfaceMock,
+	*entityprovidermock.EntityProviderInterfaceMock) {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("/tmp/test", &config.Config{})
+	require.NoError(suite.T(), err)
+	suite.T().Cleanup(config.ResetServerRuntime)
+
+	service, mockStore := suite.setupTestService()
+	mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Maybe().Return(false)
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{
+		ID:   testServiceAppID,
+		Name: "Secret App",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigProcessed{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthClient{
+					ClientID:                "secret-client",
+					GrantTypes:              []oauth2const.GrantType{oauth2const.GrantTypeClientCredentials},
+					TokenEndpointAuthMethod: authMethod,
+				},
+			},
+		},
+	})
+	return service, mockStore, service.entityProvider.(*entityprovidermock.EntityProviderInterfaceMock)
+}
+
+func (suite *ServiceTestSuite) TestGetClientSecrets_Success() {
+	service, _, ep := suite.setupClientSecretTestService(oauth2const.TokenEndpointAuthMethodClientSecretBasic)
+	createdAt := time.Now().UTC()
+	ep.On("GetSystemCredentials", testServiceAppID, fieldClientSecret).Return([]entityprovider.CredentialMetadata{
+		{ID: "secret-1", CreatedAt: &createdAt},
+		{ID: "secret-2"},
+	}, (*entityprovider.EntityProviderError)(nil))
+
+	result, svcErr := service.GetClientSecrets(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), svcErr)
+	require.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), 2, result.TotalResults)
+	assert.Equal(suite.T(), model.ClientSecret{ID: "secret-1", CreatedAt: &createdAt}, result.ClientSecrets[0])
+	assert.Empty(suite.T(), result.ClientSecrets[1].Secret)
+}
+
+func (suite *ServiceTestSuite) TestGetClientSecrets_NotSupported() {
+	service, _, _ := suite.setupClientSecretTestService(oauth2const.TokenEndpointAuthMethodPrivateKeyJWT)
+
+	result, svcErr := service.GetClientSecrets(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), result)
+	require.NotNil(suite.T(), svcErr)
+	assert.Equal(suite.T(), ErrorClientSecretsNotSupported.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestGetClientSecrets_EmptyAppID() {
+	service, _ := suite.setupTestService()
+
+	result, svcErr := service.GetClientSecrets(context.Background(), "")
+
+	assert.Nil(suite.T(), result)
+	require.NotNil(suite.T(), svcErr)
+	assert.Equal(suite.T(), ErrorInvalidApplicationID.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestCreateClientSecret_Success() {
+	service, _, ep := suite.setupClientSecretTestService(oauth2const.TokenEndpointAuthMethodClientSecretPost)
+	expiresAt := time.Now().Add(24 * time.Hour).UTC()
+	var secretValue string
+	ep.On("AddSystemCredential", testServiceAppID, fieldClientSecret, mock.AnythingOfType("string"), &expiresAt).
+		Run(func(args mock.Arguments) { secretValue = args.String(2) }).
+		Return(&entityprovider.CredentialMetadata{ID: "secret-2", ExpiresAt: &expiresAt},
+			(*entityprovider.EntityProviderError)(nil))
+
+	result, svcErr := service.CreateClientSecret(context.Background(), testServiceAppID,
+		&model.ClientSecretRequest{ExpiresAt: &expiresAt})
+
+	assert.Nil(suite.T(), svcErr)
+	require.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), "secret-2", result.ID)
+	assert.NotEmpty(suite.T(), result.Secret)
+	assert.Equal(suite.T(), secretValue, result.Secret)
+	assert.Equal(suite.T(), &expiresAt, result.ExpiresAt)
+}
+
+func (suite *ServiceTestSuite) TestCreateClientSecret_ExpiryInPast() {
+	service, _, ep := suite.setupClientSecretTestService(oauth2const.TokenEndpointAuthMethodClientSecretBasic)
+	expiresAt := time.Now().Add(-time.Minute)
+
+	result, svcErr := service.CreateClientSecret(context.Background(), testServiceAppID,
+		&model.ClientSecretRequest{ExpiresAt: &expiresAt})
+
+	assert.Nil(suite.T(), result)
+	require.NotNil(suite.T(), svcErr)
+	assert.Equal(suite.T(), ErrorInvalidClientSecretExpiry.Code, svcErr.Code)
+	ep.AssertNotCalled(suite.T(), "AddSystemCredential", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestCreateClientSecret_DeclarativeApplication() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("/tmp/test", &config.Config{})
+	require.NoError(suite.T(), err)
+	defer config.ResetServerRuntime()
+
+	service, mockStore := suite.setupTestService()
+	mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Return(true)
+
+	result, svcErr := service.CreateClientSecret(context.Background(), testServiceAppID, nil)
+
+	assert.Nil(suite.T(), result)
+	require.NotNil(suite.T(), svcErr)
+	assert.Equal(suite.T(), ErrorCannotModifyDeclarativeResource.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestDeleteClientSecret_Success() {
+	service, _, ep := suite.setupClientSecretTestService(oauth2const.TokenEndpointAuthMethodClientSecretBasic)
+	ep.On("GetSystemCredentials", testServiceAppID, fieldClientSecret).Return([]entityprovider.CredentialMetadata{
+		{ID: "secret-1"},
+		{ID: "secret-2"},
+	}, (*entityprovider.EntityProviderError)(nil))
+	ep.On("DeleteSystemCredential", testServiceAppID, fieldClientSecret, "secret-1").
+		Return((*entityprovider.EntityProviderError)(nil))
+
+	svcErr := service.DeleteClientSecret(context.Background(), testServiceAppID, "secret-1")
+
+	assert.Nil(suite.T(), svcErr)
+}
+
+func (suite *ServiceTestSuite) TestDeleteClientSecret_NotFound() {
+	service, _, ep := suite.setupClientSecretTestService(oauth2const.TokenEndpointAuthMethodClientSecretBasic)
+	ep.On("GetSystemCredentials", testServiceAppID, fieldClientSecret).Return([]entityprovider.CredentialMetadata{
+		{ID: "secret-1"},
+	}, (*entityprovider.EntityProviderError)(nil))
+
+	svcErr := service.DeleteClientSecret(context.Background(), testServiceAppID, "missing")
+
+	require.NotNil(suite.T(), svcErr)
+	assert.Equal(suite.T(), ErrorClientSecretNotFound.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestDeleteClientSecret_LastActiveSecret() {
+	service, _, ep := suite.setupClientSecretTestService(oauth2const.TokenEndpointAuthMethodClientSecretBasic)
+	expired := time.Now().Add(-time.Hour)
+	ep.On("GetSystemCredentials", testServiceAppID, fieldClientSecret).Return([]entityprovider.CredentialMetadata{
+		{ID: "secret-1"},
+		{ID: "secret-2", ExpiresAt: &expired},
+	}, (*entityprovider.EntityProviderError)(nil))
+
+	svcErr := service.DeleteClientSecret(context.Background(), testServiceAppID, "secret-1")
+
+	require.NotNil(suite.T(), svcErr)
+	assert.Equal(suite.T(), ErrorCannotDeleteLastClientSecret.Code, svcErr.Code)
+	ep.AssertNotCalled(suite.T(), "DeleteSystemCredential", mock.Anything, mock.Anything, mock.Anything)
+}
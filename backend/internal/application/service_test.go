@@ -1351,6 +1351,103 @@ func (suite *ServiceTestSuite) TestValidateApplication_InvalidLogoURL() {
 	assert.Equal(suite.T(), &ErrorInvalidLogoURL, svcErr)
 }
 
+func (suite *ServiceTestSuite) TestValidateApplication_InvalidLoginExperience() {
+	testConfig := &config.Config{}
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("/tmp/test", testConfig)
+	require.NoError(suite.T(), err)
+	defer config.ResetServerRuntime()
+
+	service, _ := suite.setupTestService()
+
+	app := &model.ApplicationDTO{
+		Name: "Test App",
+		OUID: testOUID,
+		InboundAuthProfile: inboundmodel.InboundAuthProfile{
+			AuthFlowID: "edc013d0-e893-4dc0-990c-3e1d203e005b",
+			LoginExperience: &inboundmodel.LoginExperienceConfig{
+				PostLoginRedirects: []inboundmodel.PostLoginRedirectRule{
+					{UserType: "employee", RedirectURI: "javascript:alert(1)"},
+				},
+			},
+		},
+	}
+
+	result, inboundAuth, svcErr := service.ValidateApplication(context.Background(), app)
+
+	assert.Nil(suite.T(), result)
+	assert.Nil(suite.T(), inboundAuth)
+	assert.Equal(suite.T(), &ErrorInvalidLoginExperience, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestIsValidLoginExperience() {
+	testCases := []struct {
+		name            string
+		loginExperience *inboundmodel.LoginExperienceConfig
+		expected        bool
+	}{
+		{"Nil config", nil, true},
+		{"Empty config", &inboundmodel.LoginExperienceConfig{}, true},
+		{
+			name: "Valid config",
+			loginExperience: &inboundmodel.LoginExperienceConfig{
+				AllowedIDPs: []string{"google-idp"},
+				PostLoginRedirects: []inboundmodel.PostLoginRedirectRule{
+					{UserType: "employee", OUID: testOUID, RedirectURI: "https://intranet.example.com/home"},
+					{RedirectURI: "http://localhost:3000"},
+				},
+			},
+			expected: true,
+		},
+		{
+			name:            "Blank IDP ID",
+			loginExperience: &inboundmodel.LoginExperienceConfig{AllowedIDPs: []string{"google-idp", " "}},
+			expected:        false,
+		},
+		{
+			name: "Missing redirect URI",
+			loginExperience: &inboundmodel.LoginExperienceConfig{
+				PostLoginRedirects: []inboundmodel.PostLoginRedirectRule{{UserType: "employee"}},
+			},
+			expected: false,
+		},
+		{
+			name: "Relative redirect URI",
+			loginExperience: &inboundmodel.LoginExperienceConfig{
+				PostLoginRedirects: []inboundmodel.PostLoginRedirectRule{{RedirectURI: "/home"}},
+			},
+			expected: false,
+		},
+		{
+			name: "Non HTTP redirect URI",
+			loginExperience: &inboundmodel.LoginExperienceConfig{
+				PostLoginRedirects: []inboundmodel.PostLoginRedirectRule{{RedirectURI: "ftp://files.example.com"}},
+			},
+			expected: false,
+		},
+		{
+			name: "Remember me enabled",
+			loginExperience: &inboundmodel.LoginExperienceConfig{
+				RememberMe: &inboundmodel.RememberMeConfig{Enabled: true, Lifetime: 604800},
+			},
+			expected: true,
+		},
+		{
+			name: "Negative remember me lifetime",
+			loginExperience: &inboundmodel.LoginExperienceConfig{
+				RememberMe: &inboundmodel.RememberMeConfig{Enabled: true, Lifetime: -1},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			assert.Equal(suite.T(), tc.expected, isValidLoginExperience(tc.loginExperience))
+		})
+	}
+}
+
 func (suite *ServiceTestSuite) TestCreateApplication_StoreErrorWithRollback() {
 	suite.runCreateApplicationStoreErrorTest()
 }
@@ -1690,6 +1787,63 @@ func (suite *ServiceTestSuite) TestCreateApplication_WithOAuthCertificate_Succes
 	assert.Equal(suite.T(), `{"keys":[]}`, result.InboundAuthConfig[0].OAuthConfig.Certificate.Value)
 }
 
+func (suite *ServiceTestSuite) TestCreateApplication_WithTemplate_AppliesDefaults() {
+	testConfig := &config.Config{
+		DeclarativeResources: config.DeclarativeResources{
+			Enabled: false,
+		},
+	}
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("/tmp/test", testConfig)
+	require.NoError(suite.T(), err)
+	defer config.ResetServerRuntime()
+
+	service, mockStore := suite.setupTestService()
+
+	app := &model.ApplicationDTO{
+		Name:     "Test SPA",
+		OUID:     testOUID,
+		Template: templateSPA,
+		InboundAuthProfile: inboundmodel.InboundAuthProfile{
+			AuthFlowID: "auth-flow-id",
+		},
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
+					RedirectURIs: []string{"https://example.com/callback"},
+				},
+			},
+		},
+	}
+
+	mockStore.On("CreateInboundClient",
+		mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	result, svcErr := service.CreateApplication(context.Background(), app)
+
+	require.Nil(suite.T(), svcErr)
+	require.Len(suite.T(), result.InboundAuthConfig, 1)
+	oauthConfig := result.InboundAuthConfig[0].OAuthConfig
+	require.NotNil(suite.T(), oauthConfig)
+	assert.Equal(suite.T(), []string{"https://example.com/callback"}, oauthConfig.RedirectURIs)
+	assert.Equal(suite.T(), oauth2const.TokenEndpointAuthMethodNone, oauthConfig.TokenEndpointAuthMethod)
+	assert.True(suite.T(), oauthConfig.PKCERequired)
+	assert.True(suite.T(), oauthConfig.PublicClient)
+	assert.Empty(suite.T(), oauthConfig.ClientSecret)
+	assert.Contains(suite.T(), oauthConfig.GrantTypes, oauth2const.GrantTypeRefreshToken)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationTemplates() {
+	service := &applicationService{}
+
+	result := service.GetApplicationTemplates(context.Background())
+
+	require.NotNil(suite.T(), result)
+	assert.Len(suite.T(), result.Templates, 4)
+}
+
 func (suite *ServiceTestSuite) TestCreateApplication_StoreErrorWithOAuthCertRollback() {
 	testConfig := &config.Config{
 		DeclarativeResources: config.DeclarativeResources{
@@ -2934,6 +3088,24 @@ func (suite *ServiceTestSuite) TestTranslateOAuthValidationError() {
 			wantCode:    ErrorInvalidRedirectURI.Code,
 			wantDescKey: "error.applicationservice.redirect_uri_fragment_not_allowed_description",
 		},
+		{
+			name:        "RedirectURIWildcardNotAllowed",
+			err:         inboundclient.ErrOAuthRedirectURIWildcardNotAllowed,
+			wantCode:    ErrorInvalidRedirectURI.Code,
+			wantDescKey: "error.applicationservice.redirect_uri_wildcard_not_allowed_description",
+		},
+		{
+			name:        "RedirectURISchemeNotAllowed",
+			err:         inboundclient.ErrOAuthRedirectURISchemeNotAllowed,
+			wantCode:    ErrorInvalidRedirectURI.Code,
+			wantDescKey: "error.applicationservice.redirect_uri_scheme_not_allowed_description",
+		},
+		{
+			name:        "InvalidRedirectURIPolicy",
+			err:         inboundclient.ErrOAuthInvalidRedirectURIPolicy,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.invalid_redirect_uri_policy_description",
+		},
 		{
 			name:        "AuthCodeRequiresRedirectURIs",
 			err:         inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs,
@@ -2968,6 +3140,12 @@ func (suite *ServiceTestSuite) TestTranslateOAuthValidationError() {
 			wantCode:    ErrorInvalidOAuthConfiguration.Code,
 			wantDescKey: "error.applicationservice.refresh_token_cannot_be_sole_grant_description",
 		},
+		{
+			name:        "RefreshTokenRequiresIssuingGrant",
+			err:         inboundclient.ErrOAuthRefreshTokenRequiresIssuingGrant,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.refresh_token_requires_issuing_grant_description",
+		},
 		{
 			name:        "PKCERequiresAuthCode",
 			err:         inboundclient.ErrOAuthPKCERequiresAuthCode,
@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/application/model"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+)
+
+// Application template IDs.
+const (
+	templateSPA    = "spa"
+	templateWeb    = "web"
+	templateNative = "native"
+	templateM2M    = "m2m"
+)
+
+// Token validity periods applied by the application templates, in seconds.
+const (
+	publicClientAccessTokenValidity       int64 = 900
+	confidentialClientAccessTokenValidity int64 = 3600
+	templateIDTokenValidity               int64 = 3600
+)
+
+// getApplicationTemplates returns the application templates for common client types. A new slice is
+// built on every call so that callers may modify the returned defaults.
+func getApplicationTemplates() []model.ApplicationTemplate {
+	return []model.ApplicationTemplate{
+		{
+			ID:          templateSPA,
+			Name:        "Single-Page Application",
+			Description: "Browser-based application that signs users in without a client secret.",
+			Defaults: newTemplateDefaults(&inboundmodel.OAuthConfigWithSecret{
+				GrantTypes: []oauth2const.GrantType{
+					oauth2const.GrantTypeAuthorizationCode, oauth2const.GrantTypeRefreshToken,
+				},
+				ResponseTypes:           []oauth2const.ResponseType{oauth2const.ResponseTypeCode},
+				TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodNone,
+				PKCERequired:            true,
+				PublicClient:            true,
+				Scopes:                  slices.Clone(model.DefaultScopes),
+				Token:                   newTemplateTokenConfig(publicClientAccessTokenValidity, true),
+			}),
+		},
+		{
+			ID:          templateWeb,
+			Name:        "Server-Side Web Application",
+			Description: "Web application that signs users in from a backend able to keep a client secret.",
+			Defaults: newTemplateDefaults(&inboundmodel.OAuthConfigWithSecret{
+				GrantTypes: []oauth2const.GrantType{
+					oauth2const.GrantTypeAuthorizationCode, oauth2const.GrantTypeRefreshToken,
+				},
+				ResponseTypes:           []oauth2const.ResponseType{oauth2const.ResponseTypeCode},
+				TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodClientSecretBasic,
+				PKCERequired:            true,
+				Scopes:                  slices.Clone(model.DefaultScopes),
+				Token:                   newTemplateTokenConfig(confidentialClientAccessTokenValidity, true),
+			}),
+		},
+		{
+			ID:          templateNative,
+			Name:        "Native or Mobile Application",
+			Description: "Desktop or mobile application that signs users in without a client secret.",
+			Defaults: newTemplateDefaults(&inboundmodel.OAuthConfigWithSecret{
+				GrantTypes: []oauth2const.GrantType{
+					oauth2const.GrantTypeAuthorizationCode, oauth2const.GrantTypeRefreshToken,
+				},
+				ResponseTypes:           []oauth2const.ResponseType{oauth2const.ResponseTypeCode},
+				TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodNone,
+				PKCERequired:            true,
+				PublicClient:            true,
+				Scopes:                  slices.Clone(model.DefaultScopes),
+				Token:                   newTemplateTokenConfig(publicClientAccessTokenValidity, true),
+			}),
+		},
+		{
+			ID:          templateM2M,
+			Name:        "Machine-to-Machine Application",
+			Description: "Service that calls APIs on its own behalf using the client credentials grant.",
+			Defaults: newTemplateDefaults(&inboundmodel.OAuthConfigWithSecret{
+				GrantTypes:              []oauth2const.GrantType{oauth2const.GrantTypeClientCredentials},
+				TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodClientSecretBasic,
+				Token:                   newTemplateTokenConfig(confidentialClientAccessTokenValidity, false),
+			}),
+		},
+	}
+}
+
+// newTemplateDefaults builds the default application settings of a template with the given OAuth
+// configuration.
+func newTemplateDefaults(oauthConfig *inboundmodel.OAuthConfigWithSecret) model.ApplicationRequest {
+	return model.ApplicationRequest{
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{Type: inboundmodel.OAuthInboundAuthType, OAuthConfig: oauthConfig},
+		},
+	}
+}
+
+// newTemplateTokenConfig builds the token configuration of a template. The ID token configuration is
+// included only for templates of clients that sign users in.
+func newTemplateTokenConfig(accessTokenValidity int64, withIDToken bool) *inboundmodel.OAuthTokenConfig {
+	tokenConfig := &inboundmodel.OAuthTokenConfig{
+		AccessToken: &inboundmodel.AccessTokenConfig{ValidityPeriod: accessTokenValidity},
+	}
+	if withIDToken {
+		tokenConfig.AccessToken.UserAttributes = slices.Clone(model.DefaultUserAttributes)
+		tokenConfig.IDToken = &inboundmodel.IDTokenConfig{
+			ValidityPeriod: templateIDTokenValidity,
+			UserAttributes: slices.Clone(model.DefaultUserAttributes),
+		}
+	}
+	return tokenConfig
+}
+
+// getApplicationTemplate returns the application template with the given ID.
+func getApplicationTemplate(templateID string) (*model.ApplicationTemplate, bool) {
+	for _, template := range getApplicationTemplates() {
+		if template.ID == templateID {
+			return &template, true
+		}
+	}
+	return nil, false
+}
+
+// applyApplicationTemplate fills the OAuth settings the application leaves unset with the defaults of
+// the template it references. Applications referencing an unknown template are left unchanged. Security
+// settings enabled by the template, such as PKCE, are always enabled.
+func applyApplicationTemplate(app *model.ApplicationDTO) {
+	template, ok := getApplicationTemplate(app.Template)
+	if !ok {
+		return
+	}
+	defaults := template.Defaults.InboundAuthConfig[0]
+
+	for i := range app.InboundAuthConfig {
+		if app.InboundAuthConfig[i].Type != inboundmodel.OAuthInboundAuthType {
+			continue
+		}
+		if app.InboundAuthConfig[i].OAuthConfig == nil {
+			app.InboundAuthConfig[i].OAuthConfig = defaults.OAuthConfig
+		} else {
+			mergeOAuthTemplateDefaults(app.InboundAuthConfig[i].OAuthConfig, defaults.OAuthConfig)
+		}
+		return
+	}
+	app.InboundAuthConfig = append(app.InboundAuthConfig, defaults)
+}
+
+// mergeOAuthTemplateDefaults fills the unset fields of the OAuth configuration with the template defaults.
+func mergeOAuthTemplateDefaults(cfg, defaults *inboundmodel.OAuthConfigWithSecret) {
+	if len(cfg.GrantTypes) == 0 {
+		cfg.GrantTypes = defaults.GrantTypes
+	}
+	if len(cfg.ResponseTypes) == 0 {
+		cfg.ResponseTypes = defaults.ResponseTypes
+	}
+	if cfg.TokenEndpointAuthMethod == "" {
+		cfg.TokenEndpointAuthMethod = defaults.TokenEndpointAuthMethod
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = defaults.Scopes
+	}
+	cfg.PKCERequired = cfg.PKCERequired || defaults.PKCERequired
+	cfg.PublicClient = cfg.PublicClient || defaults.PublicClient
+
+	if defaults.Token == nil {
+		return
+	}
+	if cfg.Token == nil {
+		cfg.Token = defaults.Token
+		return
+	}
+	if cfg.Token.AccessToken == nil {
+		cfg.Token.AccessToken = defaults.Token.AccessToken
+	} else if defaults.Token.AccessToken != nil {
+		if cfg.Token.AccessToken.ValidityPeriod == 0 {
+			cfg.Token.AccessToken.ValidityPeriod = defaults.Token.AccessToken.ValidityPeriod
+		}
+		if len(cfg.Token.AccessToken.UserAttributes) == 0 {
+			cfg.Token.AccessToken.UserAttributes = defaults.Token.AccessToken.UserAttributes
+		}
+	}
+	if cfg.Token.IDToken == nil {
+		cfg.Token.IDToken = defaults.Token.IDToken
+	} else if defaults.Token.IDToken != nil {
+		if cfg.Token.IDToken.ValidityPeriod == 0 {
+			cfg.Token.IDToken.ValidityPeriod = defaults.Token.IDToken.ValidityPeriod
+		}
+		if len(cfg.Token.IDToken.UserAttributes) == 0 {
+			cfg.Token.IDToken.UserAttributes = defaults.Token.IDToken.UserAttributes
+		}
+	}
+}
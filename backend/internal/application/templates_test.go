@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/application/model"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+)
+
+type TemplatesTestSuite struct {
+	suite.Suite
+}
+
+func TestTemplatesTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplatesTestSuite))
+}
+
+func (s *TemplatesTestSuite) TestGetApplicationTemplates() {
+	templates := getApplicationTemplates()
+
+	ids := make([]string, 0, len(templates))
+	for _, template := range templates {
+		ids = append(ids, template.ID)
+		s.NotEmpty(template.Name)
+		s.Require().Len(template.Defaults.InboundAuthConfig, 1)
+		oauthConfig := template.Defaults.InboundAuthConfig[0].OAuthConfig
+		s.Require().NotNil(oauthConfig)
+		s.NotEmpty(oauthConfig.GrantTypes)
+		s.NotEmpty(oauthConfig.TokenEndpointAuthMethod)
+		if oauthConfig.PublicClient {
+			s.True(oauthConfig.PKCERequired)
+			s.Equal(oauth2const.TokenEndpointAuthMethodNone, oauthConfig.TokenEndpointAuthMethod)
+		}
+	}
+	s.Equal([]string{templateSPA, templateWeb, templateNative, templateM2M}, ids)
+}
+
+func (s *TemplatesTestSuite) TestGetApplicationTemplates_ReturnsIndependentCopies() {
+	first := getApplicationTemplates()
+	first[0].Defaults.InboundAuthConfig[0].OAuthConfig.Scopes[0] = "modified"
+
+	second := getApplicationTemplates()
+	s.Equal(model.DefaultScopes[0], second[0].Defaults.InboundAuthConfig[0].OAuthConfig.Scopes[0])
+}
+
+func (s *TemplatesTestSuite) TestGetApplicationTemplate() {
+	template, ok := getApplicationTemplate(templateM2M)
+	s.True(ok)
+	s.Equal(templateM2M, template.ID)
+
+	_, ok = getApplicationTemplate("unknown")
+	s.False(ok)
+}
+
+func (s *TemplatesTestSuite) TestApplyApplicationTemplate_UnknownTemplate() {
+	app := &model.ApplicationDTO{Template: "react"}
+
+	applyApplicationTemplate(app)
+
+	s.Empty(app.InboundAuthConfig)
+}
+
+func (s *TemplatesTestSuite) TestApplyApplicationTemplate_AddsOAuthConfig() {
+	app := &model.ApplicationDTO{Template: templateM2M}
+
+	applyApplicationTemplate(app)
+
+	s.Require().Len(app.InboundAuthConfig, 1)
+	oauthConfig := app.InboundAuthConfig[0].OAuthConfig
+	s.Require().NotNil(oauthConfig)
+	s.Equal([]oauth2const.GrantType{oauth2const.GrantTypeClientCredentials}, oauthConfig.GrantTypes)
+	s.Equal(oauth2const.TokenEndpointAuthMethodClientSecretBasic, oauthConfig.TokenEndpointAuthMethod)
+	s.Nil(oauthConfig.Token.IDToken)
+}
+
+func (s *TemplatesTestSuite) TestApplyApplicationTemplate_FillsNilOAuthConfig() {
+	app := &model.ApplicationDTO{
+		Template:          templateWeb,
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{{Type: inboundmodel.OAuthInboundAuthType}},
+	}
+
+	applyApplicationTemplate(app)
+
+	s.Require().Len(app.InboundAuthConfig, 1)
+	s.Require().NotNil(app.InboundAuthConfig[0].OAuthConfig)
+	s.True(app.InboundAuthConfig[0].OAuthConfig.PKCERequired)
+}
+
+func (s *TemplatesTestSuite) TestApplyApplicationTemplate_KeepsExplicitSettings() {
+	app := &model.ApplicationDTO{
+		Template: templateSPA,
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
+					RedirectURIs: []string{"https://example.com/callback"},
+					GrantTypes:   []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+					Scopes:       []string{"openid"},
+					Token: &inboundmodel.OAuthTokenConfig{
+						AccessToken: &inboundmodel.AccessTokenConfig{ValidityPeriod: 300},
+					},
+				},
+			},
+		},
+	}
+
+	applyApplicationTemplate(app)
+
+	oauthConfig := app.InboundAuthConfig[0].OAuthConfig
+	s.Equal([]string{"https://example.com/callback"}, oauthConfig.RedirectURIs)
+	s.Equal([]oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode}, oauthConfig.GrantTypes)
+	s.Equal([]string{"openid"}, oauthConfig.Scopes)
+	s.Equal([]oauth2const.ResponseType{oauth2const.ResponseTypeCode}, oauthConfig.ResponseTypes)
+	s.Equal(oauth2const.TokenEndpointAuthMethodNone, oauthConfig.TokenEndpointAuthMethod)
+	s.True(oauthConfig.PKCERequired)
+	s.True(oauthConfig.PublicClient)
+	s.Equal(int64(300), oauthConfig.Token.AccessToken.ValidityPeriod)
+	s.Equal(model.DefaultUserAttributes, oauthConfig.Token.AccessToken.UserAttributes)
+	s.Require().NotNil(oauthConfig.Token.IDToken)
+	s.Equal(templateIDTokenValidity, oauthConfig.Token.IDToken.ValidityPeriod)
+}
@@ -76,7 +76,8 @@ func registerMCPTools(server *mcp.Server, appService ApplicationServiceInterface
 		Name: "thunderid_create_application",
 		Description: `Create a new application optionally with OAuth configuration.
 
-Use get_application_templates to get pre-configured minimal templates for common app types (SPA, Mobile, Server, M2M).
+Use get_application_templates to get the templates for common app types (SPA, server-side web, native, M2M). ` +
+			`Set template to a template ID to fill unset OAuth settings with the template defaults.
 
 Prerequisites: Create flows first using create_flow if custom authentication/registration flows are needed.
 
@@ -111,12 +112,11 @@ Any field not provided will be reset to empty/default.`,
 
 	mcp.AddTool(server, &mcp.Tool{
 		Name: "thunderid_get_application_templates",
-		Description: `Get minimal OAuth configuration templates for common application types.
+		Description: `Get the creation templates for common application types.
 
-Templates contain ONLY the required fields to create each app type. ` +
-			`Optional fields with service-layer defaults are omitted.
-` +
-			`Prompt the user for any missing required placeholders.`,
+Each template carries secure defaults for grant types, token settings, and PKCE. ` +
+			`Create an application with its template set to the template ID to apply the defaults. ` +
+			`Prompt the user for the name and redirect URIs, which templates do not provide.`,
 		Annotations: &mcp.ToolAnnotations{
 			Title:        "Get Application Templates",
 			ReadOnlyHint: true,
@@ -206,106 +206,12 @@ func (t *applicationTools) updateApplication(
 }
 
 // getApplicationTemplates handles the get_application_templates tool call.
-// Returns pre-configured templates with placeholder values for common application types.
 func (t *applicationTools) getApplicationTemplates(
 	ctx context.Context,
 	req *mcp.CallToolRequest,
 	_ any,
-) (*mcp.CallToolResult, map[string]interface{}, error) {
-	templates := map[string]interface{}{
-		"spa": map[string]interface{}{
-			"name": "<APP_NAME>",
-			"token": map[string]interface{}{
-				"user_attributes": model.DefaultUserAttributes,
-			},
-			"inbound_auth_config": []map[string]interface{}{
-				{
-					"type": "oauth2",
-					"config": map[string]interface{}{
-						"redirect_uris":              []string{"<REDIRECT_URI>"},
-						"grant_types":                []string{"authorization_code", "refresh_token"},
-						"token_endpoint_auth_method": "none",
-						"pkce_required":              true,
-						"public_client":              true,
-						"scopes":                     model.DefaultScopes,
-						"token": map[string]interface{}{
-							"access_token": map[string]interface{}{
-								"user_attributes": model.DefaultUserAttributes,
-							},
-							"id_token": map[string]interface{}{
-								"user_attributes": model.DefaultUserAttributes,
-							},
-						},
-					},
-				},
-			},
-		},
-		"mobile": map[string]interface{}{
-			"name": "<APP_NAME>",
-			"token": map[string]interface{}{
-				"user_attributes": model.DefaultUserAttributes,
-			},
-			"inbound_auth_config": []map[string]interface{}{
-				{
-					"type": "oauth2",
-					"config": map[string]interface{}{
-						"redirect_uris":              []string{"<CUSTOM_SCHEME>://callback"},
-						"grant_types":                []string{"authorization_code", "refresh_token"},
-						"token_endpoint_auth_method": "none",
-						"pkce_required":              true,
-						"public_client":              true,
-						"scopes":                     model.DefaultScopes,
-						"token": map[string]interface{}{
-							"access_token": map[string]interface{}{
-								"user_attributes": model.DefaultUserAttributes,
-							},
-							"id_token": map[string]interface{}{
-								"user_attributes": model.DefaultUserAttributes,
-							},
-						},
-					},
-				},
-			},
-		},
-		"server": map[string]interface{}{
-			"name": "<APP_NAME>",
-			"token": map[string]interface{}{
-				"user_attributes": model.DefaultUserAttributes,
-			},
-			"inbound_auth_config": []map[string]interface{}{
-				{
-					"type": "oauth2",
-					"config": map[string]interface{}{
-						"redirect_uris": []string{"<REDIRECT_URI>"},
-						"grant_types":   []string{"authorization_code", "refresh_token"},
-						"pkce_required": true,
-						"scopes":        model.DefaultScopes,
-						"token": map[string]interface{}{
-							"access_token": map[string]interface{}{
-								"user_attributes": model.DefaultUserAttributes,
-							},
-							"id_token": map[string]interface{}{
-								"user_attributes": model.DefaultUserAttributes,
-							},
-						},
-					},
-				},
-			},
-		},
-		"m2m": map[string]interface{}{
-			"name": "<APP_NAME>",
-			"inbound_auth_config": []map[string]interface{}{
-				{
-					"type": "oauth2",
-					"config": map[string]interface{}{
-						"grant_types": []string{"client_credentials"},
-					},
-				},
-			},
-		},
-	}
-
-	return nil, templates, nil
+) (*mcp.CallToolResult, *model.ApplicationTemplateListResponse, error) {
+	return nil, t.appService.GetApplicationTemplates(ctx), nil
 }
 
 // getCommonSchemaModifiers returns the common schema modifiers for ApplicationDTO.
@@ -61,6 +61,14 @@ func GetAuthenticatorFactors(name string) []AuthenticationFactor {
 	return []AuthenticationFactor{}
 }
 
+// GetAuthenticatorAMR returns the authentication method reference values for the given authenticator.
+func GetAuthenticatorAMR(name string) []string {
+	if auth := getAuthenticatorMetaData(name); auth != nil {
+		return auth.AMR
+	}
+	return []string{}
+}
+
 // GetAuthenticatorNameForIDPType returns the authenticator name for a given IDP type.
 func GetAuthenticatorNameForIDPType(idpType idp.IDPType) (string, error) {
 	registryMu.RLock()
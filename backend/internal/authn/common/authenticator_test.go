@@ -40,10 +40,12 @@ func (suite *AuthenticatorTestSuite) SetupTest() {
 	RegisterAuthenticator(AuthenticatorMeta{
 		Name:    AuthenticatorCredentials,
 		Factors: []AuthenticationFactor{FactorKnowledge},
+		AMR:     []string{AMRPassword},
 	})
 	RegisterAuthenticator(AuthenticatorMeta{
 		Name:    AuthenticatorSMSOTP,
 		Factors: []AuthenticationFactor{FactorPossession},
+		AMR:     []string{AMROTP, AMRSMS},
 	})
 	RegisterAuthenticator(AuthenticatorMeta{
 		Name:          AuthenticatorGoogle,
@@ -205,6 +207,42 @@ func (suite *AuthenticatorTestSuite) TestGetAuthenticatorFactors() {
 	}
 }
 
+func (suite *AuthenticatorTestSuite) TestGetAuthenticatorAMR() {
+	testCases := []struct {
+		name          string
+		authenticator string
+		expectedAMR   []string
+	}{
+		{
+			name:          "Credentials authenticator",
+			authenticator: AuthenticatorCredentials,
+			expectedAMR:   []string{AMRPassword},
+		},
+		{
+			name:          "SMS OTP authenticator",
+			authenticator: AuthenticatorSMSOTP,
+			expectedAMR:   []string{AMROTP, AMRSMS},
+		},
+		{
+			name:          "Authenticator without AMR values",
+			authenticator: AuthenticatorGoogle,
+			expectedAMR:   nil,
+		},
+		{
+			name:          "Unknown authenticator",
+			authenticator: "UnknownAuthenticator",
+			expectedAMR:   []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			result := GetAuthenticatorAMR(tc.authenticator)
+			suite.Equal(tc.expectedAMR, result)
+		})
+	}
+}
+
 func (suite *AuthenticatorTestSuite) TestGetAuthenticatorNameForIDPType() {
 	testCases := []struct {
 		name             string
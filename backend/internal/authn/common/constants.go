@@ -30,6 +30,7 @@ const (
 	AuthenticatorCredentials = "CredentialsAuthenticator"
 	AuthenticatorSMSOTP      = "SMSOTPAuthenticator"
 	AuthenticatorMagicLink   = "MagicLinkAuthenticator"
+	AuthenticatorTOTP        = "TOTPAuthenticator"
 	AuthenticatorGoogle      = "GoogleOIDCAuthenticator"
 	AuthenticatorGithub      = "GithubOAuthAuthenticator"
 	AuthenticatorOAuth       = "OAuthAuthenticator"
@@ -48,3 +49,17 @@ const (
 	// FactorInherence represents "something you are" (e.g., biometrics).
 	FactorInherence AuthenticationFactor = "INHERENCE"
 )
+
+// Authentication method reference (amr) values defined by RFC 8176 and reported for the authenticators.
+const (
+	// AMRPassword is reported for password based authentication.
+	AMRPassword = "pwd"
+	// AMROTP is reported for one-time password or one-time link based authentication.
+	AMROTP = "otp"
+	// AMRSMS is reported for authentication confirmed through an SMS message.
+	AMRSMS = "sms"
+	// AMRHardwareKey is reported for proof of possession of a hardware-secured key.
+	AMRHardwareKey = "hwk"
+	// AMRFederated is reported for authentication delegated to a federated identity provider.
+	AMRFederated = "fed"
+)
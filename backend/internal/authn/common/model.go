@@ -65,6 +65,8 @@ type AuthenticatorMeta struct {
 	Factors []AuthenticationFactor
 	// AssociatedIDP is the optional identity provider type this authenticator is associated with.
 	AssociatedIDP idp.IDPType
+	// AMR lists the authentication method reference values reported when this authenticator is used.
+	AMR []string
 }
 
 // AuthenticatorReference represents an engaged authenticator in the authentication flow.
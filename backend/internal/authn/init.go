@@ -58,38 +58,51 @@ func Initialize(
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:    common.AuthenticatorCredentials,
 		Factors: []common.AuthenticationFactor{common.FactorKnowledge},
+		AMR:     []string{common.AMRPassword},
 	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:    common.AuthenticatorSMSOTP,
 		Factors: []common.AuthenticationFactor{common.FactorPossession},
+		AMR:     []string{common.AMROTP, common.AMRSMS},
 	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:    common.AuthenticatorPasskey,
 		Factors: []common.AuthenticationFactor{common.FactorPossession, common.FactorInherence},
+		AMR:     []string{common.AMRHardwareKey},
 	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:          common.AuthenticatorOAuth,
 		Factors:       []common.AuthenticationFactor{common.FactorKnowledge},
 		AssociatedIDP: idp.IDPTypeOAuth,
+		AMR:           []string{common.AMRFederated},
 	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:          common.AuthenticatorOIDC,
 		Factors:       []common.AuthenticationFactor{common.FactorKnowledge},
 		AssociatedIDP: idp.IDPTypeOIDC,
+		AMR:           []string{common.AMRFederated},
 	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:          common.AuthenticatorGithub,
 		Factors:       []common.AuthenticationFactor{common.FactorKnowledge},
 		AssociatedIDP: idp.IDPTypeGitHub,
+		AMR:           []string{common.AMRFederated},
 	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:          common.AuthenticatorGoogle,
 		Factors:       []common.AuthenticationFactor{common.FactorKnowledge},
 		AssociatedIDP: idp.IDPTypeGoogle,
+		AMR:           []string{common.AMRFederated},
 	})
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:    common.AuthenticatorMagicLink,
 		Factors: []common.AuthenticationFactor{common.FactorPossession},
+		AMR:     []string{common.AMROTP},
+	})
+	common.RegisterAuthenticator(common.AuthenticatorMeta{
+		Name:    common.AuthenticatorTOTP,
+		Factors: []common.AuthenticationFactor{common.FactorPossession},
+		AMR:     []string{common.AMROTP},
 	})
 
 	authnService := newAuthenticationService(
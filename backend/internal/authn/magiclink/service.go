@@ -229,5 +229,6 @@ func (s *magicLinkAuthnService) getMetadata() common.AuthenticatorMeta {
 	return common.AuthenticatorMeta{
 		Name:    common.AuthenticatorMagicLink,
 		Factors: []common.AuthenticationFactor{common.FactorPossession},
+		AMR:     []string{common.AMROTP},
 	}
 }
@@ -45,7 +45,7 @@ var supportedChannels = []notifcommon.ChannelType{notifcommon.ChannelTypeSMS}
 // This is a wrapper over the notification.OTPServiceInterface to perform user authentication.
 type OTPAuthnServiceInterface interface {
 	SendOTP(ctx context.Context, senderID string, channel notifcommon.ChannelType,
-		recipient string) (string, *serviceerror.ServiceError)
+		recipient string, options notifcommon.OTPOptions) (string, *serviceerror.ServiceError)
 	VerifyOTP(ctx context.Context, sessionToken, otp string) *serviceerror.ServiceError
 	Authenticate(ctx context.Context, sessionToken, otp string) (*entityprovider.Entity, *serviceerror.ServiceError)
 }
@@ -65,9 +65,9 @@ func newOTPAuthnService(otpSvc notification.OTPServiceInterface,
 	}
 }
 
-// SendOTP sends an OTP to the specified recipient using the provided sender.
+// SendOTP sends an OTP generated with the given options to the specified recipient using the provided sender.
 func (s *otpAuthnService) SendOTP(ctx context.Context, senderID string, channel notifcommon.ChannelType,
-	recipient string) (string, *serviceerror.ServiceError) {
+	recipient string, options notifcommon.OTPOptions) (string, *serviceerror.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
 	logger.Debug("Sending OTP for authentication", log.MaskedString("recipient", recipient),
 		log.String("channel", string(channel)))
@@ -80,6 +80,7 @@ func (s *otpAuthnService) SendOTP(ctx context.Context, senderID string, channel
 		SenderID:  senderID,
 		Channel:   string(channel),
 		Recipient: recipient,
+		Options:   options,
 	}
 	result, svcErr := s.otpService.SendOTP(ctx, otpData)
 	if svcErr != nil {
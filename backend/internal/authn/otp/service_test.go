@@ -65,11 +65,13 @@ func (suite *OTPAuthnServiceTestSuite) TestSendOTPSuccess() {
 		SessionToken: testSessionToken,
 	}
 
+	options := notifcommon.OTPOptions{Length: 8, ValidityPeriod: 300}
 	suite.mockOTPService.On("SendOTP", mock.Anything, mock.MatchedBy(func(dto notifcommon.SendOTPDTO) bool {
-		return dto.SenderID == testSenderID && dto.Channel == string(channel) && dto.Recipient == recipient
+		return dto.SenderID == testSenderID && dto.Channel == string(channel) && dto.Recipient == recipient &&
+			dto.Options == options
 	})).Return(result, nil)
 
-	token, err := suite.service.SendOTP(context.Background(), testSenderID, channel, recipient)
+	token, err := suite.service.SendOTP(context.Background(), testSenderID, channel, recipient, options)
 	suite.Nil(err)
 	suite.Equal(testSessionToken, token)
 }
@@ -107,7 +109,8 @@ func (suite *OTPAuthnServiceTestSuite) TestSendOTPInvalidInputs() {
 
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
-			token, err := suite.service.SendOTP(context.Background(), tc.senderID, tc.channel, tc.recipient)
+			token, err := suite.service.SendOTP(context.Background(), tc.senderID, tc.channel, tc.recipient,
+				notifcommon.OTPOptions{})
 			suite.Empty(token)
 			suite.NotNil(err)
 			suite.Equal(tc.expectedCode, err.Code)
@@ -154,7 +157,7 @@ func (suite *OTPAuthnServiceTestSuite) TestSendOTPWithServiceError() {
 			freshOTP.On("SendOTP", mock.Anything, mock.Anything).Return(nil, tc.mockReturnErr)
 
 			token, err := suite.service.SendOTP(context.Background(), testSenderID,
-				notifcommon.ChannelTypeSMS, "+1234567890")
+				notifcommon.ChannelTypeSMS, "+1234567890", notifcommon.OTPOptions{})
 			suite.Empty(token)
 			suite.NotNil(err)
 			suite.Equal(tc.expectedErrCode, err.Code)
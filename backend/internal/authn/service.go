@@ -193,7 +193,7 @@ func (as *authenticationService) AuthenticateWithCredentials(ctx context.Context
 // SendOTP sends an OTP to the specified recipient for authentication.
 func (as *authenticationService) SendOTP(ctx context.Context, senderID string, channel notifcommon.ChannelType,
 	recipient string) (string, *serviceerror.ServiceError) {
-	return as.otpService.SendOTP(ctx, senderID, channel, recipient)
+	return as.otpService.SendOTP(ctx, senderID, channel, recipient, notifcommon.OTPOptions{})
 }
 
 // VerifyOTP verifies an OTP and returns the authenticated user.
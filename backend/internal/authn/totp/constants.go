@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+const (
+	// DefaultIssuer is the issuer shown in authenticator apps when none is configured.
+	DefaultIssuer = "ThunderID"
+
+	// credentialTypeTOTP is the system credential type under which the TOTP secret of a user is stored.
+	credentialTypeTOTP = "totp"
+
+	// secretLength is the length of the generated TOTP secrets in bytes, as recommended by RFC 4226.
+	secretLength = 20
+	// codeDigits is the number of digits in a TOTP code.
+	codeDigits = 6
+	// timeStepSeconds is the TOTP time step in seconds.
+	timeStepSeconds = 30
+	// allowedClockSkewSteps is the number of time steps before and after the current one in which a
+	// code is accepted, to tolerate clock drift between the server and the authenticator.
+	allowedClockSkewSteps = 1
+
+	// provisioningAlgorithm is the HMAC algorithm advertised in the provisioning URI.
+	provisioningAlgorithm = "SHA1"
+
+	loggerComponentName = "TOTPAuthnService"
+)
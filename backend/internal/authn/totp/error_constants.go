@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for TOTP authentication service
+var (
+	// ErrorInvalidUserID is the error returned when the provided user ID is invalid.
+	ErrorInvalidUserID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.authntotpservice.invalid_user_id",
+			DefaultValue: "Invalid user ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authntotpservice.invalid_user_id_description",
+			DefaultValue: "The provided user ID is invalid or empty",
+		},
+	}
+	// ErrorInvalidAccountName is the error returned when the provided account name is invalid.
+	ErrorInvalidAccountName = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.authntotpservice.invalid_account_name",
+			DefaultValue: "Invalid account name",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authntotpservice.invalid_account_name_description",
+			DefaultValue: "The account name to show in the authenticator app is invalid or empty",
+		},
+	}
+	// ErrorInvalidTOTPCode is the error returned when the provided TOTP code is malformed.
+	ErrorInvalidTOTPCode = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.authntotpservice.invalid_totp_code",
+			DefaultValue: "Invalid TOTP code",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authntotpservice.invalid_totp_code_description",
+			DefaultValue: "The provided TOTP code must be a 6 digit number",
+		},
+	}
+	// ErrorIncorrectTOTPCode is the error returned when the provided TOTP code does not match.
+	ErrorIncorrectTOTPCode = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.authntotpservice.incorrect_totp_code",
+			DefaultValue: "Incorrect TOTP code",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authntotpservice.incorrect_totp_code_description",
+			DefaultValue: "The provided TOTP code is incorrect or has already been used",
+		},
+	}
+	// ErrorInvalidEnrollmentToken is the error returned when the enrollment token cannot be read.
+	ErrorInvalidEnrollmentToken = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1005",
+		Error: core.I18nMessage{
+			Key:          "error.authntotpservice.invalid_enrollment_token",
+			DefaultValue: "Invalid enrollment token",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authntotpservice.invalid_enrollment_token_description",
+			DefaultValue: "The provided TOTP enrollment token is invalid or empty",
+		},
+	}
+	// ErrorTOTPNotEnrolled is the error returned when the user has no TOTP authenticator registered.
+	ErrorTOTPNotEnrolled = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUTHN-TOTP-1006",
+		Error: core.I18nMessage{
+			Key:          "error.authntotpservice.totp_not_enrolled",
+			DefaultValue: "TOTP not enrolled",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.authntotpservice.totp_not_enrolled_description",
+			DefaultValue: "The user has not registered a TOTP authenticator",
+		},
+	}
+)
@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+// TOTPEnrollment holds the details required to register a TOTP authenticator for a user.
+type TOTPEnrollment struct {
+	// Secret is the base32 encoded shared secret, to be entered manually in the authenticator app.
+	Secret string
+	// ProvisioningURI is the otpauth:// URI rendered as a QR code for the authenticator app.
+	ProvisioningURI string
+	// EnrollmentToken is the encrypted secret to be presented when completing the enrollment.
+	EnrollmentToken string
+}
+
+// totpCredential is the TOTP credential stored against a user.
+type totpCredential struct {
+	// Secret is the encrypted shared secret.
+	Secret string `json:"secret"`
+	// LastUsedStep is the time step of the last accepted code, used to reject replayed codes.
+	LastUsedStep int64 `json:"lastUsedStep,omitempty"`
+}
@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package totp implements the time-based one-time password (TOTP) authentication service.
+package totp
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// TOTPAuthnServiceInterface defines the interface for TOTP enrollment and authentication operations.
+type TOTPAuthnServiceInterface interface {
+	// StartEnrollment generates a new TOTP secret for the given account and returns the details to
+	// register it in an authenticator app. The secret is not stored until the enrollment is completed.
+	StartEnrollment(ctx context.Context, accountName, issuer string) (*TOTPEnrollment, *serviceerror.ServiceError)
+	// CompleteEnrollment verifies a code generated from the secret of the given enrollment token and stores
+	// the secret against the user, replacing any TOTP authenticator registered before.
+	CompleteEnrollment(ctx context.Context, userID, enrollmentToken, code string) *serviceerror.ServiceError
+	// Verify verifies a code against the TOTP authenticator registered for the user. A code is accepted once.
+	Verify(ctx context.Context, userID, code string) *serviceerror.ServiceError
+}
+
+// totpAuthnService is the default implementation of TOTPAuthnServiceInterface.
+type totpAuthnService struct {
+	entityService  entity.EntityServiceInterface
+	cryptoProvider kmprovider.ConfigCryptoProvider
+	now            func() time.Time
+	logger         *log.Logger
+}
+
+// newTOTPAuthnService creates a new instance of totpAuthnService.
+func newTOTPAuthnService(entitySvc entity.EntityServiceInterface,
+	cryptoProvider kmprovider.ConfigCryptoProvider) TOTPAuthnServiceInterface {
+	return &totpAuthnService{
+		entityService:  entitySvc,
+		cryptoProvider: cryptoProvider,
+		now:            time.Now,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// StartEnrollment generates a new TOTP secret and returns the details to register it in an authenticator app.
+func (s *totpAuthnService) StartEnrollment(ctx context.Context, accountName,
+	issuer string) (*TOTPEnrollment, *serviceerror.ServiceError) {
+	if strings.TrimSpace(accountName) == "" {
+		return nil, &ErrorInvalidAccountName
+	}
+	if strings.TrimSpace(issuer) == "" {
+		issuer = DefaultIssuer
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		s.logger.Error("Failed to generate TOTP secret", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	encryptedSecret, err := s.cryptoProvider.Encrypt(ctx, secret)
+	if err != nil {
+		s.logger.Error("Failed to encrypt TOTP secret", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	encodedSecret := secretEncoding.EncodeToString(secret)
+	return &TOTPEnrollment{
+		Secret:          encodedSecret,
+		ProvisioningURI: buildProvisioningURI(issuer, accountName, encodedSecret),
+		EnrollmentToken: base64.RawURLEncoding.EncodeToString(encryptedSecret),
+	}, nil
+}
+
+// CompleteEnrollment verifies the code against the secret of the enrollment token and stores the secret
+// against the user.
+func (s *totpAuthnService) CompleteEnrollment(ctx context.Context, userID, enrollmentToken,
+	code string) *serviceerror.ServiceError {
+	if svcErr := validateVerifyRequest(userID, code); svcErr != nil {
+		return svcErr
+	}
+	encryptedSecret, err := base64.RawURLEncoding.DecodeString(enrollmentToken)
+	if err != nil || len(encryptedSecret) == 0 {
+		return &ErrorInvalidEnrollmentToken
+	}
+	secret, err := s.cryptoProvider.Decrypt(ctx, encryptedSecret)
+	if err != nil {
+		s.logger.Debug("Failed to decrypt TOTP enrollment token", log.Error(err))
+		return &ErrorInvalidEnrollmentToken
+	}
+
+	step, ok := s.matchCode(secret, code, -1)
+	if !ok {
+		return &ErrorIncorrectTOTPCode
+	}
+
+	createdAt := s.now().UTC()
+	stored := entity.StoredCredential{CreatedAt: &createdAt}
+	if svcErr := s.storeCredential(ctx, userID, stored,
+		totpCredential{Secret: string(encryptedSecret), LastUsedStep: step}); svcErr != nil {
+		return svcErr
+	}
+
+	s.logger.Debug("TOTP enrollment completed", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// Verify verifies the code against the TOTP authenticator registered for the user.
+func (s *totpAuthnService) Verify(ctx context.Context, userID, code string) *serviceerror.ServiceError {
+	if svcErr := validateVerifyRequest(userID, code); svcErr != nil {
+		return svcErr
+	}
+
+	entries, err := s.entityService.GetCredentialsByType(ctx, userID, credentialTypeTOTP)
+	if err != nil {
+		return s.handleEntityError(err, userID, "Failed to retrieve TOTP credentials")
+	}
+	if len(entries) == 0 {
+		return &ErrorTOTPNotEnrolled
+	}
+
+	stored := entries[0]
+	var credential totpCredential
+	if err := json.Unmarshal([]byte(stored.Value), &credential); err != nil {
+		s.logger.Error("Failed to unmarshal TOTP credential", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	secret, err := s.cryptoProvider.Decrypt(ctx, []byte(credential.Secret))
+	if err != nil {
+		s.logger.Error("Failed to decrypt TOTP secret", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	step, ok := s.matchCode(secret, code, credential.LastUsedStep)
+	if !ok {
+		return &ErrorIncorrectTOTPCode
+	}
+
+	credential.LastUsedStep = step
+	return s.storeCredential(ctx, userID, stored, credential)
+}
+
+// matchCode returns the time step within the allowed clock skew for which the secret generates the code.
+// Steps up to and including lastUsedStep are skipped so that a code cannot be replayed.
+func (s *totpAuthnService) matchCode(secret []byte, code string, lastUsedStep int64) (int64, bool) {
+	currentStep := s.now().Unix() / timeStepSeconds
+	for step := currentStep - allowedClockSkewSteps; step <= currentStep+allowedClockSkewSteps; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(generateCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// storeCredential stores the TOTP credential as the only TOTP credential of the user. The identity and
+// creation time of the given stored credential are retained.
+func (s *totpAuthnService) storeCredential(ctx context.Context, userID string, stored entity.StoredCredential,
+	credential totpCredential) *serviceerror.ServiceError {
+	value, err := json.Marshal(credential)
+	if err != nil {
+		s.logger.Error("Failed to marshal TOTP credential", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	stored.Value = string(value)
+
+	payload, err := json.Marshal(map[string][]entity.StoredCredential{
+		credentialTypeTOTP: {stored},
+	})
+	if err != nil {
+		s.logger.Error("Failed to marshal TOTP credentials", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if err := s.entityService.UpdateSystemCredentials(ctx, userID, payload); err != nil {
+		return s.handleEntityError(err, userID, "Failed to store TOTP credential")
+	}
+	return nil
+}
+
+// handleEntityError maps an error returned by the entity service to a service error.
+func (s *totpAuthnService) handleEntityError(err error, userID, message string) *serviceerror.ServiceError {
+	if errors.Is(err, entity.ErrEntityNotFound) {
+		s.logger.Debug("User not found", log.MaskedString(log.LoggerKeyUserID, userID))
+		return &authncm.ErrorUserNotFound
+	}
+	s.logger.Error(message, log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+	return &serviceerror.InternalServerError
+}
+
+// validateVerifyRequest validates the parameters for verifying a TOTP code.
+func validateVerifyRequest(userID, code string) *serviceerror.ServiceError {
+	if strings.TrimSpace(userID) == "" {
+		return &ErrorInvalidUserID
+	}
+	if !isValidCodeFormat(code) {
+		return &ErrorInvalidTOTPCode
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+)
+
+const (
+	testUserID      = "user-123"
+	testAccountName = "alice@example.com"
+)
+
+var (
+	testSecret    = []byte("12345678901234567890")
+	testNow       = time.Unix(1111111109, 0)
+	testValidCode = "081804"
+)
+
+type TOTPAuthnServiceTestSuite struct {
+	suite.Suite
+	mockEntityService  *entitymock.EntityServiceInterfaceMock
+	mockCryptoProvider *cryptomock.ConfigCryptoProviderMock
+	service            *totpAuthnService
+}
+
+func TestTOTPAuthnServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TOTPAuthnServiceTestSuite))
+}
+
+func (suite *TOTPAuthnServiceTestSuite) SetupTest() {
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockCryptoProvider = cryptomock.NewConfigCryptoProviderMock(suite.T())
+	suite.service = newTOTPAuthnService(suite.mockEntityService, suite.mockCryptoProvider).(*totpAuthnService)
+	suite.service.now = func() time.Time { return testNow }
+
+	// Reversible stand-in for the encryption service.
+	suite.mockCryptoProvider.On("Encrypt", mock.Anything, mock.Anything).Maybe().Return(
+		func(_ context.Context, content []byte) ([]byte, error) {
+			return append([]byte("enc:"), content...), nil
+		})
+	suite.mockCryptoProvider.On("Decrypt", mock.Anything, mock.Anything).Maybe().Return(
+		func(_ context.Context, content []byte) ([]byte, error) {
+			if !bytes.HasPrefix(content, []byte("enc:")) {
+				return nil, errors.New("invalid ciphertext")
+			}
+			return bytes.TrimPrefix(content, []byte("enc:")), nil
+		})
+}
+
+func (suite *TOTPAuthnServiceTestSuite) storedCredentials(lastUsedStep int64) []entity.StoredCredential {
+	value, err := json.Marshal(totpCredential{Secret: "enc:" + string(testSecret), LastUsedStep: lastUsedStep})
+	suite.Require().NoError(err)
+	return []entity.StoredCredential{{ID: "cred-1", Value: string(value)}}
+}
+
+func parseStoredTOTPCredential(payload json.RawMessage) (entity.StoredCredential, totpCredential, bool) {
+	var creds map[string][]entity.StoredCredential
+	if err := json.Unmarshal(payload, &creds); err != nil || len(creds[credentialTypeTOTP]) != 1 {
+		return entity.StoredCredential{}, totpCredential{}, false
+	}
+	stored := creds[credentialTypeTOTP][0]
+	var credential totpCredential
+	if err := json.Unmarshal([]byte(stored.Value), &credential); err != nil {
+		return entity.StoredCredential{}, totpCredential{}, false
+	}
+	return stored, credential, true
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestStartEnrollmentSuccess() {
+	enrollment, svcErr := suite.service.StartEnrollment(context.Background(), testAccountName, "Acme")
+
+	suite.Nil(svcErr)
+	suite.NotNil(enrollment)
+
+	secret, err := secretEncoding.DecodeString(enrollment.Secret)
+	suite.NoError(err)
+	suite.Len(secret, secretLength)
+
+	parsed, err := url.Parse(enrollment.ProvisioningURI)
+	suite.NoError(err)
+	suite.Equal("/Acme:"+testAccountName, parsed.Path)
+	suite.Equal(enrollment.Secret, parsed.Query().Get("secret"))
+	suite.Equal("Acme", parsed.Query().Get("issuer"))
+
+	token, err := base64.RawURLEncoding.DecodeString(enrollment.EnrollmentToken)
+	suite.NoError(err)
+	suite.Equal(append([]byte("enc:"), secret...), token)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestStartEnrollmentDefaultIssuer() {
+	enrollment, svcErr := suite.service.StartEnrollment(context.Background(), testAccountName, " ")
+
+	suite.Nil(svcErr)
+	parsed, err := url.Parse(enrollment.ProvisioningURI)
+	suite.NoError(err)
+	suite.Equal(DefaultIssuer, parsed.Query().Get("issuer"))
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestStartEnrollmentInvalidAccountName() {
+	enrollment, svcErr := suite.service.StartEnrollment(context.Background(), "  ", "Acme")
+
+	suite.Nil(enrollment)
+	suite.Equal(&ErrorInvalidAccountName, svcErr)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestStartEnrollmentEncryptionFailure() {
+	cryptoProvider := cryptomock.NewConfigCryptoProviderMock(suite.T())
+	cryptoProvider.On("Encrypt", mock.Anything, mock.Anything).Return(nil, errors.New("no key"))
+	suite.service.cryptoProvider = cryptoProvider
+
+	enrollment, svcErr := suite.service.StartEnrollment(context.Background(), testAccountName, "")
+
+	suite.Nil(enrollment)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestCompleteEnrollmentSuccess() {
+	token := base64.RawURLEncoding.EncodeToString(append([]byte("enc:"), testSecret...))
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID,
+		mock.MatchedBy(func(payload json.RawMessage) bool {
+			stored, credential, ok := parseStoredTOTPCredential(payload)
+			return ok && stored.CreatedAt != nil && credential.Secret == "enc:"+string(testSecret) &&
+				credential.LastUsedStep == testNow.Unix()/timeStepSeconds
+		})).Return(nil)
+
+	svcErr := suite.service.CompleteEnrollment(context.Background(), testUserID, token, testValidCode)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestCompleteEnrollmentInvalidInputs() {
+	token := base64.RawURLEncoding.EncodeToString(append([]byte("enc:"), testSecret...))
+	tests := []struct {
+		name        string
+		userID      string
+		token       string
+		code        string
+		expectedErr *serviceerror.ServiceError
+	}{
+		{"EmptyUserID", "", token, testValidCode, &ErrorInvalidUserID},
+		{"MalformedCode", testUserID, token, "12ab56", &ErrorInvalidTOTPCode},
+		{"EmptyToken", testUserID, "", testValidCode, &ErrorInvalidEnrollmentToken},
+		{"UndecodableToken", testUserID, "%%%", testValidCode, &ErrorInvalidEnrollmentToken},
+		{"UndecryptableToken", testUserID, base64.RawURLEncoding.EncodeToString([]byte("plain")),
+			testValidCode, &ErrorInvalidEnrollmentToken},
+		{"IncorrectCode", testUserID, token, "000000", &ErrorIncorrectTOTPCode},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			svcErr := suite.service.CompleteEnrollment(context.Background(), tc.userID, tc.token, tc.code)
+			suite.Equal(tc.expectedErr, svcErr)
+		})
+	}
+	suite.mockEntityService.AssertNotCalled(suite.T(), "UpdateSystemCredentials",
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestCompleteEnrollmentUserNotFound() {
+	token := base64.RawURLEncoding.EncodeToString(append([]byte("enc:"), testSecret...))
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID, mock.Anything).
+		Return(entity.ErrEntityNotFound)
+
+	svcErr := suite.service.CompleteEnrollment(context.Background(), testUserID, token, testValidCode)
+
+	suite.Equal(&authncm.ErrorUserNotFound, svcErr)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestVerifySuccess() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, credentialTypeTOTP).
+		Return(suite.storedCredentials(0), nil)
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID,
+		mock.MatchedBy(func(payload json.RawMessage) bool {
+			stored, credential, ok := parseStoredTOTPCredential(payload)
+			return ok && stored.ID == "cred-1" && credential.LastUsedStep == testNow.Unix()/timeStepSeconds
+		})).Return(nil)
+
+	svcErr := suite.service.Verify(context.Background(), testUserID, testValidCode)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestVerifyAcceptsAdjacentTimeStep() {
+	suite.service.now = func() time.Time { return testNow.Add(timeStepSeconds * time.Second) }
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, credentialTypeTOTP).
+		Return(suite.storedCredentials(0), nil)
+	suite.mockEntityService.On("UpdateSystemCredentials", mock.Anything, testUserID, mock.Anything).Return(nil)
+
+	svcErr := suite.service.Verify(context.Background(), testUserID, testValidCode)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestVerifyRejectsExpiredCode() {
+	suite.service.now = func() time.Time { return testNow.Add(2 * timeStepSeconds * time.Second) }
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, credentialTypeTOTP).
+		Return(suite.storedCredentials(0), nil)
+
+	svcErr := suite.service.Verify(context.Background(), testUserID, testValidCode)
+
+	suite.Equal(&ErrorIncorrectTOTPCode, svcErr)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestVerifyRejectsReplayedCode() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, credentialTypeTOTP).
+		Return(suite.storedCredentials(testNow.Unix()/timeStepSeconds), nil)
+
+	svcErr := suite.service.Verify(context.Background(), testUserID, testValidCode)
+
+	suite.Equal(&ErrorIncorrectTOTPCode, svcErr)
+	suite.mockEntityService.AssertNotCalled(suite.T(), "UpdateSystemCredentials",
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestVerifyNotEnrolled() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, credentialTypeTOTP).
+		Return(nil, nil)
+
+	svcErr := suite.service.Verify(context.Background(), testUserID, testValidCode)
+
+	suite.Equal(&ErrorTOTPNotEnrolled, svcErr)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestVerifyUserNotFound() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, credentialTypeTOTP).
+		Return(nil, entity.ErrEntityNotFound)
+
+	svcErr := suite.service.Verify(context.Background(), testUserID, testValidCode)
+
+	suite.Equal(&authncm.ErrorUserNotFound, svcErr)
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestVerifyInvalidInputs() {
+	suite.Equal(&ErrorInvalidUserID, suite.service.Verify(context.Background(), " ", testValidCode))
+	suite.Equal(&ErrorInvalidTOTPCode, suite.service.Verify(context.Background(), testUserID, ""))
+}
+
+func (suite *TOTPAuthnServiceTestSuite) TestVerifyCorruptedCredential() {
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, testUserID, credentialTypeTOTP).
+		Return([]entity.StoredCredential{{Value: `{"secret":"tampered"}`}}, nil)
+
+	svcErr := suite.service.Verify(context.Background(), testUserID, testValidCode)
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package totp
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TOTPUtilsTestSuite struct {
+	suite.Suite
+}
+
+func TestTOTPUtilsTestSuite(t *testing.T) {
+	suite.Run(t, new(TOTPUtilsTestSuite))
+}
+
+func (suite *TOTPUtilsTestSuite) TestGenerateCodeRFC6238Vectors() {
+	// Test vectors from RFC 6238 Appendix B for HMAC-SHA1, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		unixTime int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tc := range tests {
+		suite.Equal(tc.expected, generateCode(secret, tc.unixTime/timeStepSeconds))
+	}
+}
+
+func (suite *TOTPUtilsTestSuite) TestGenerateSecret() {
+	secret1, err := generateSecret()
+	suite.NoError(err)
+	suite.Len(secret1, secretLength)
+
+	secret2, err := generateSecret()
+	suite.NoError(err)
+	suite.NotEqual(secret1, secret2)
+}
+
+func (suite *TOTPUtilsTestSuite) TestIsValidCodeFormat() {
+	suite.True(isValidCodeFormat("123456"))
+	suite.True(isValidCodeFormat("000000"))
+	suite.False(isValidCodeFormat(""))
+	suite.False(isValidCodeFormat("12345"))
+	suite.False(isValidCodeFormat("1234567"))
+	suite.False(isValidCodeFormat("12a456"))
+}
+
+func (suite *TOTPUtilsTestSuite) TestBuildProvisioningURI() {
+	uri := buildProvisioningURI("Acme Corp", "alice@example.com", "JBSWY3DPEHPK3PXP")
+
+	parsed, err := url.Parse(uri)
+	suite.NoError(err)
+	suite.Equal("otpauth", parsed.Scheme)
+	suite.Equal("totp", parsed.Host)
+	suite.Equal("/Acme Corp:alice@example.com", parsed.Path)
+
+	query := parsed.Query()
+	suite.Equal("JBSWY3DPEHPK3PXP", query.Get("secret"))
+	suite.Equal("Acme Corp", query.Get("issuer"))
+	suite.Equal("SHA1", query.Get("algorithm"))
+	suite.Equal("6", query.Get("digits"))
+	suite.Equal("30", query.Get("period"))
+}
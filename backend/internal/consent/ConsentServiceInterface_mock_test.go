@@ -598,6 +598,82 @@ func (_c *ConsentServiceInterfaceMock_ListConsentPurposes_Call) RunAndReturn(run
 	return _c
 }
 
+// ListUserConsents provides a mock function for the type ConsentServiceInterfaceMock
+func (_mock *ConsentServiceInterfaceMock) ListUserConsents(ctx context.Context, ouID string, userID string) ([]Consent, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, ouID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserConsents")
+	}
+
+	var r0 []Consent
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]Consent, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, ouID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []Consent); ok {
+		r0 = returnFunc(ctx, ouID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Consent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, ouID, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ConsentServiceInterfaceMock_ListUserConsents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserConsents'
+type ConsentServiceInterfaceMock_ListUserConsents_Call struct {
+	*mock.Call
+}
+
+// ListUserConsents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ouID string
+//   - userID string
+func (_e *ConsentServiceInterfaceMock_Expecter) ListUserConsents(ctx interface{}, ouID interface{}, userID interface{}) *ConsentServiceInterfaceMock_ListUserConsents_Call {
+	return &ConsentServiceInterfaceMock_ListUserConsents_Call{Call: _e.mock.On("ListUserConsents", ctx, ouID, userID)}
+}
+
+func (_c *ConsentServiceInterfaceMock_ListUserConsents_Call) Run(run func(ctx context.Context, ouID string, userID string)) *ConsentServiceInterfaceMock_ListUserConsents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_ListUserConsents_Call) Return(consents []Consent, serviceError *serviceerror.ServiceError) *ConsentServiceInterfaceMock_ListUserConsents_Call {
+	_c.Call.Return(consents, serviceError)
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_ListUserConsents_Call) RunAndReturn(run func(ctx context.Context, ouID string, userID string) ([]Consent, *serviceerror.ServiceError)) *ConsentServiceInterfaceMock_ListUserConsents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokeConsent provides a mock function for the type ConsentServiceInterfaceMock
 func (_mock *ConsentServiceInterfaceMock) RevokeConsent(ctx context.Context, ouID string, consentID string, payload *ConsentRevokeRequest) *serviceerror.ServiceError {
 	ret := _mock.Called(ctx, ouID, consentID, payload)
@@ -669,6 +745,77 @@ func (_c *ConsentServiceInterfaceMock_RevokeConsent_Call) RunAndReturn(run func(
 	return _c
 }
 
+// RevokeUserConsent provides a mock function for the type ConsentServiceInterfaceMock
+func (_mock *ConsentServiceInterfaceMock) RevokeUserConsent(ctx context.Context, ouID string, userID string, consentID string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, ouID, userID, consentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeUserConsent")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, ouID, userID, consentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// ConsentServiceInterfaceMock_RevokeUserConsent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeUserConsent'
+type ConsentServiceInterfaceMock_RevokeUserConsent_Call struct {
+	*mock.Call
+}
+
+// RevokeUserConsent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ouID string
+//   - userID string
+//   - consentID string
+func (_e *ConsentServiceInterfaceMock_Expecter) RevokeUserConsent(ctx interface{}, ouID interface{}, userID interface{}, consentID interface{}) *ConsentServiceInterfaceMock_RevokeUserConsent_Call {
+	return &ConsentServiceInterfaceMock_RevokeUserConsent_Call{Call: _e.mock.On("RevokeUserConsent", ctx, ouID, userID, consentID)}
+}
+
+func (_c *ConsentServiceInterfaceMock_RevokeUserConsent_Call) Run(run func(ctx context.Context, ouID string, userID string, consentID string)) *ConsentServiceInterfaceMock_RevokeUserConsent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_RevokeUserConsent_Call) Return(serviceError *serviceerror.ServiceError) *ConsentServiceInterfaceMock_RevokeUserConsent_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *ConsentServiceInterfaceMock_RevokeUserConsent_Call) RunAndReturn(run func(ctx context.Context, ouID string, userID string, consentID string) *serviceerror.ServiceError) *ConsentServiceInterfaceMock_RevokeUserConsent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchConsents provides a mock function for the type ConsentServiceInterfaceMock
 func (_mock *ConsentServiceInterfaceMock) SearchConsents(ctx context.Context, ouID string, filter *ConsentSearchFilter) ([]Consent, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, ouID, filter)
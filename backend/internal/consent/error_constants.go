@@ -279,4 +279,19 @@ var (
 			DefaultValue: "The consent update request was rejected by the consent service as invalid",
 		},
 	}
+
+	// ErrorUnauthenticatedUser is returned when a self-service consent request is not made by an
+	// authenticated user.
+	ErrorUnauthenticatedUser = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "CSE-1019",
+		Error: core.I18nMessage{
+			Key:          "error.consentservice.unauthenticated_user",
+			DefaultValue: "Unauthenticated user",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.consentservice.unauthenticated_user_description",
+			DefaultValue: "The request must be made by an authenticated user",
+		},
+	}
 )
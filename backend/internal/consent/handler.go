@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package consent
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// consentHandler handles the self-service HTTP requests for managing the consents of a user.
+type consentHandler struct {
+	consentService ConsentServiceInterface
+}
+
+// newConsentHandler creates a new instance of consentHandler.
+func newConsentHandler(consentService ConsentServiceInterface) *consentHandler {
+	return &consentHandler{
+		consentService: consentService,
+	}
+}
+
+// HandleUserConsentListRequest handles the request to list the active consents of the authenticated user.
+func (h *consentHandler) HandleUserConsentListRequest(w http.ResponseWriter, r *http.Request) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		h.handleError(w, &ErrorUnauthenticatedUser)
+		return
+	}
+
+	consents, svcErr := h.consentService.ListUserConsents(r.Context(), security.GetOUID(r.Context()), userID)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	response := make([]UserConsentResponse, 0, len(consents))
+	for _, consent := range consents {
+		response = append(response, getUserConsentResponse(consent))
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleUserConsentRevokeRequest handles the request to revoke a consent of the authenticated user.
+func (h *consentHandler) HandleUserConsentRevokeRequest(w http.ResponseWriter, r *http.Request) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		h.handleError(w, &ErrorUnauthenticatedUser)
+		return
+	}
+
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorConsentRecordNotFound)
+		return
+	}
+
+	if svcErr := h.consentService.RevokeUserConsent(r.Context(), security.GetOUID(r.Context()),
+		userID, id); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// handleError writes the error response for the given service error.
+func (h *consentHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorConsentRecordNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorUnauthenticatedUser.Code:
+			statusCode = http.StatusUnauthorized
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
+
+// getUserConsentResponse converts a consent record to its self-service response representation.
+func getUserConsentResponse(consent Consent) UserConsentResponse {
+	purposes := make([]UserConsentPurposeResponse, 0, len(consent.Purposes))
+	for _, purpose := range consent.Purposes {
+		elements := make([]UserConsentElementResponse, 0, len(purpose.Elements))
+		for _, element := range purpose.Elements {
+			elements = append(elements, UserConsentElementResponse{
+				Name:     element.Name,
+				Approved: element.IsUserApproved,
+			})
+		}
+		purposes = append(purposes, UserConsentPurposeResponse{
+			Name:     purpose.Name,
+			Elements: elements,
+		})
+	}
+
+	return UserConsentResponse{
+		ID:            consent.ID,
+		ApplicationID: consent.GroupID,
+		Status:        consent.Status,
+		ValidityTime:  consent.ValidityTime,
+		Purposes:      purposes,
+		CreatedTime:   consent.CreatedTime,
+		UpdatedTime:   consent.UpdatedTime,
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package consent
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+const (
+	testUserID    = "user-1"
+	testOUID      = "ou-1"
+	testConsentID = "consent-1"
+)
+
+type ConsentHandlerTestSuite struct {
+	suite.Suite
+	mockService *ConsentServiceInterfaceMock
+	handler     *consentHandler
+}
+
+func TestConsentHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsentHandlerTestSuite))
+}
+
+func (suite *ConsentHandlerTestSuite) SetupTest() {
+	suite.mockService = NewConsentServiceInterfaceMock(suite.T())
+	suite.handler = newConsentHandler(suite.mockService)
+}
+
+// withUser returns the request with a security context for the test user.
+func (suite *ConsentHandlerTestSuite) withUser(req *http.Request) *http.Request {
+	authCtx := security.NewSecurityContextForTest(testUserID, testOUID, "", nil, nil)
+	return req.WithContext(security.WithSecurityContextTest(context.Background(), authCtx))
+}
+
+func (suite *ConsentHandlerTestSuite) TestHandleUserConsentListRequest() {
+	consents := []Consent{{
+		ID:      testConsentID,
+		GroupID: "app-1",
+		Status:  ConsentStatusActive,
+		Purposes: []ConsentPurposeItem{{
+			Name: "app:app-1:attrs",
+			Elements: []ConsentElementApproval{
+				{Name: "email", Namespace: NamespaceAttribute, IsUserApproved: true},
+				{Name: "mobile", Namespace: NamespaceAttribute, IsUserApproved: false},
+			},
+		}},
+	}}
+	suite.mockService.EXPECT().ListUserConsents(mock.Anything, testOUID, testUserID).Return(consents, nil).Once()
+
+	req := suite.withUser(httptest.NewRequest(http.MethodGet, "/users/me/consents", nil))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleUserConsentListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response []UserConsentResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Len(response, 1)
+	suite.Equal(testConsentID, response[0].ID)
+	suite.Equal("app-1", response[0].ApplicationID)
+	suite.Require().Len(response[0].Purposes, 1)
+	suite.Equal([]UserConsentElementResponse{
+		{Name: "email", Approved: true},
+		{Name: "mobile", Approved: false},
+	}, response[0].Purposes[0].Elements)
+}
+
+func (suite *ConsentHandlerTestSuite) TestHandleUserConsentListRequest_Unauthenticated() {
+	req := httptest.NewRequest(http.MethodGet, "/users/me/consents", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleUserConsentListRequest(rr, req)
+
+	suite.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+func (suite *ConsentHandlerTestSuite) TestHandleUserConsentListRequest_ServiceError() {
+	suite.mockService.EXPECT().ListUserConsents(mock.Anything, testOUID, testUserID).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	req := suite.withUser(httptest.NewRequest(http.MethodGet, "/users/me/consents", nil))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleUserConsentListRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (suite *ConsentHandlerTestSuite) TestHandleUserConsentRevokeRequest() {
+	suite.mockService.EXPECT().RevokeUserConsent(mock.Anything, testOUID, testUserID, testConsentID).
+		Return(nil).Once()
+
+	req := suite.withUser(httptest.NewRequest(http.MethodDelete, "/users/me/consents/"+testConsentID, nil))
+	req.SetPathValue("id", testConsentID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleUserConsentRevokeRequest(rr, req)
+
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *ConsentHandlerTestSuite) TestHandleUserConsentRevokeRequest_NotFound() {
+	suite.mockService.EXPECT().RevokeUserConsent(mock.Anything, testOUID, testUserID, "missing").
+		Return(&ErrorConsentRecordNotFound).Once()
+
+	req := suite.withUser(httptest.NewRequest(http.MethodDelete, "/users/me/consents/missing", nil))
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleUserConsentRevokeRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorConsentRecordNotFound.Code)
+}
+
+func (suite *ConsentHandlerTestSuite) TestHandleUserConsentRevokeRequest_Unauthenticated() {
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/consents/"+testConsentID, nil)
+	req.SetPathValue("id", testConsentID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleUserConsentRevokeRequest(rr, req)
+
+	suite.Equal(http.StatusUnauthorized, rr.Code)
+}
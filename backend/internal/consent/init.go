@@ -19,12 +19,47 @@
 package consent
 
 import (
+	"net/http"
+
 	httpservice "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
 )
 
-// Initialize initializes the consent service and returns an instance of ConsentServiceInterface.
-func Initialize() ConsentServiceInterface {
-	return newConsentService(
+// Initialize initializes the consent service, registers the self-service consent routes and returns an
+// instance of ConsentServiceInterface.
+func Initialize(mux *http.ServeMux) ConsentServiceInterface {
+	consentService := newConsentService(
 		newDefaultClient(httpservice.NewHTTPClient()),
 	)
+	registerRoutes(mux, newConsentHandler(consentService))
+	return consentService
+}
+
+// registerRoutes registers the routes for the self-service consent APIs.
+func registerRoutes(mux *http.ServeMux, handler *consentHandler) {
+	listOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/consents",
+		handler.HandleUserConsentListRequest, listOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/consents",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, listOpts))
+
+	revokeOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/consents/{id}",
+		handler.HandleUserConsentRevokeRequest, revokeOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/consents/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, revokeOpts))
 }
@@ -19,6 +19,7 @@
 package consent
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -46,7 +47,7 @@ func (s *InitTestSuite) TestInitialize_ReturnsNonNilService() {
 	require.NoError(s.T(), config.InitializeServerRuntime("/tmp/test", cfg))
 	s.T().Cleanup(config.ResetServerRuntime)
 
-	svc := Initialize()
+	svc := Initialize(http.NewServeMux())
 
 	s.NotNil(svc)
 }
@@ -62,7 +63,7 @@ func (s *InitTestSuite) TestInitialize_DisabledConsent() {
 	require.NoError(s.T(), config.InitializeServerRuntime("/tmp/test", cfg))
 	s.T().Cleanup(config.ResetServerRuntime)
 
-	svc := Initialize()
+	svc := Initialize(http.NewServeMux())
 
 	s.NotNil(svc)
 	s.False(svc.IsEnabled())
@@ -82,6 +82,12 @@ type ConsentServiceInterface interface {
 	// RevokeConsent revokes an active consent record (idempotent)
 	RevokeConsent(ctx context.Context, ouID string, consentID string,
 		payload *ConsentRevokeRequest) *serviceerror.ServiceError
+
+	// ListUserConsents retrieves the active consent records of a user
+	ListUserConsents(ctx context.Context, ouID, userID string) ([]Consent, *serviceerror.ServiceError)
+
+	// RevokeUserConsent revokes an active consent record of a user
+	RevokeUserConsent(ctx context.Context, ouID, userID, consentID string) *serviceerror.ServiceError
 }
 
 // consentClientInterface defines the contract for pluggable consent client implementations.
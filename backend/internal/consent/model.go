@@ -51,6 +51,9 @@ const (
 	ConsentTypeAuthentication ConsentType = "AUTHENTICATION"
 )
 
+// userRevocationReason is the reason recorded when a user revokes their own consent.
+const userRevocationReason = "Revoked by the user"
+
 // ConsentAuthorizationStatus defines the possible statuses for a consent authorization record.
 type ConsentAuthorizationStatus string
 
@@ -259,3 +262,28 @@ type ConsentRevokeRequest struct {
 	// Reason is an optional human-readable reason for the revocation
 	Reason string
 }
+
+// ----- Self-service API data models -----
+
+// UserConsentResponse represents a consent record of the authenticated user in the API response.
+type UserConsentResponse struct {
+	ID            string                       `json:"id"`
+	ApplicationID string                       `json:"applicationId"`
+	Status        ConsentStatus                `json:"status"`
+	ValidityTime  int64                        `json:"validityTime,omitempty"`
+	Purposes      []UserConsentPurposeResponse `json:"purposes"`
+	CreatedTime   int64                        `json:"createdTime"`
+	UpdatedTime   int64                        `json:"updatedTime"`
+}
+
+// UserConsentPurposeResponse represents a consent purpose of a user consent record in the API response.
+type UserConsentPurposeResponse struct {
+	Name     string                       `json:"name"`
+	Elements []UserConsentElementResponse `json:"elements"`
+}
+
+// UserConsentElementResponse represents the user's decision for a consent element in the API response.
+type UserConsentElementResponse struct {
+	Name     string `json:"name"`
+	Approved bool   `json:"approved"`
+}
@@ -21,6 +21,7 @@ package consent
 
 import (
 	"context"
+	"slices"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -176,3 +177,34 @@ func (s *consentService) RevokeConsent(ctx context.Context, ouID, consentID stri
 	}
 	return s.client.revokeConsent(ctx, ouID, consentID, payload)
 }
+
+// ----- Self-service consent operations -----
+
+// ListUserConsents retrieves the active consent records of a user. Returns an empty list if the consent
+// service is disabled.
+func (s *consentService) ListUserConsents(ctx context.Context, ouID, userID string) (
+	[]Consent, *serviceerror.ServiceError) {
+	if !s.enabled {
+		return []Consent{}, nil
+	}
+	return s.client.searchConsents(ctx, ouID, &ConsentSearchFilter{
+		UserIDs:         []string{userID},
+		ConsentStatuses: []ConsentStatus{ConsentStatusActive},
+	})
+}
+
+// RevokeUserConsent revokes an active consent record of a user. The user is prompted for consent again on the
+// next sign-in to the application. Returns ErrorConsentRecordNotFound if the user has no active consent record
+// with the given ID.
+func (s *consentService) RevokeUserConsent(ctx context.Context, ouID, userID,
+	consentID string) *serviceerror.ServiceError {
+	consents, svcErr := s.ListUserConsents(ctx, ouID, userID)
+	if svcErr != nil {
+		return svcErr
+	}
+	if !slices.ContainsFunc(consents, func(c Consent) bool { return c.ID == consentID }) {
+		return &ErrorConsentRecordNotFound
+	}
+
+	return s.client.revokeConsent(ctx, ouID, consentID, &ConsentRevokeRequest{Reason: userRevocationReason})
+}
@@ -639,3 +639,72 @@ func (s *ConsentServiceTestSuite) TestUpdateConsent_NilInput() {
 	s.NotNil(svcErr)
 	s.Equal(&ErrorInvalidRequestFormat, svcErr)
 }
+
+// ----- ListUserConsents -----
+
+func (s *ConsentServiceTestSuite) TestListUserConsents_Success() {
+	clientMock := newConsentClientInterfaceMock(s.T())
+	svc := newServiceWithMockClient(s.T(), true, clientMock)
+
+	expected := []Consent{{ID: "consent-1", GroupID: "app-1", Status: ConsentStatusActive}}
+	clientMock.EXPECT().searchConsents(mock.Anything, "ou1", &ConsentSearchFilter{
+		UserIDs:         []string{"user-1"},
+		ConsentStatuses: []ConsentStatus{ConsentStatusActive},
+	}).Return(expected, nil)
+
+	result, svcErr := svc.ListUserConsents(context.Background(), "ou1", "user-1")
+
+	s.Nil(svcErr)
+	s.Equal(expected, result)
+}
+
+func (s *ConsentServiceTestSuite) TestListUserConsents_Disabled() {
+	clientMock := newConsentClientInterfaceMock(s.T())
+	svc := newServiceWithMockClient(s.T(), false, clientMock)
+
+	result, svcErr := svc.ListUserConsents(context.Background(), "ou1", "user-1")
+
+	s.Nil(svcErr)
+	s.Empty(result)
+}
+
+// ----- RevokeUserConsent -----
+
+func (s *ConsentServiceTestSuite) TestRevokeUserConsent_Success() {
+	clientMock := newConsentClientInterfaceMock(s.T())
+	svc := newServiceWithMockClient(s.T(), true, clientMock)
+
+	clientMock.EXPECT().searchConsents(mock.Anything, "ou1", mock.Anything).
+		Return([]Consent{{ID: "consent-1"}}, nil)
+	clientMock.EXPECT().revokeConsent(mock.Anything, "ou1", "consent-1",
+		&ConsentRevokeRequest{Reason: userRevocationReason}).Return(nil)
+
+	svcErr := svc.RevokeUserConsent(context.Background(), "ou1", "user-1", "consent-1")
+
+	s.Nil(svcErr)
+}
+
+func (s *ConsentServiceTestSuite) TestRevokeUserConsent_ConsentOfAnotherUser() {
+	clientMock := newConsentClientInterfaceMock(s.T())
+	svc := newServiceWithMockClient(s.T(), true, clientMock)
+
+	clientMock.EXPECT().searchConsents(mock.Anything, "ou1", mock.Anything).
+		Return([]Consent{{ID: "consent-1"}}, nil)
+
+	svcErr := svc.RevokeUserConsent(context.Background(), "ou1", "user-1", "consent-2")
+
+	s.Equal(&ErrorConsentRecordNotFound, svcErr)
+	clientMock.AssertNotCalled(s.T(), "revokeConsent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ConsentServiceTestSuite) TestRevokeUserConsent_SearchError() {
+	clientMock := newConsentClientInterfaceMock(s.T())
+	svc := newServiceWithMockClient(s.T(), true, clientMock)
+
+	clientMock.EXPECT().searchConsents(mock.Anything, "ou1", mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+
+	svcErr := svc.RevokeUserConsent(context.Background(), "ou1", "user-1", "consent-1")
+
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+}
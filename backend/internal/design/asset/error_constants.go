@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+var (
+	// ErrorInvalidAssetRequest is returned when the upload request does not carry a valid asset file.
+	ErrorInvalidAssetRequest = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DAS-1001",
+		Error: core.I18nMessage{
+			Key:          "design.asset.error.invalid_request",
+			DefaultValue: "Invalid asset upload request",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "design.asset.error.invalid_request_description",
+			DefaultValue: "The request must be a multipart form carrying the asset in the 'file' field",
+		},
+	}
+
+	// ErrorAssetTooLarge is returned when the uploaded asset exceeds the configured maximum size.
+	ErrorAssetTooLarge = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DAS-1002",
+		Error: core.I18nMessage{
+			Key:          "design.asset.error.too_large",
+			DefaultValue: "Asset too large",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "design.asset.error.too_large_description",
+			DefaultValue: "The uploaded asset exceeds the maximum allowed size",
+		},
+	}
+
+	// ErrorUnsupportedContentType is returned when the content type of the uploaded asset is not allowed.
+	ErrorUnsupportedContentType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DAS-1003",
+		Error: core.I18nMessage{
+			Key:          "design.asset.error.unsupported_content_type",
+			DefaultValue: "Unsupported content type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "design.asset.error.unsupported_content_type_description",
+			DefaultValue: "The content type of the uploaded asset is not allowed",
+		},
+	}
+
+	// ErrorContentTypeMismatch is returned when the content of the uploaded asset does not match its
+	// declared content type.
+	ErrorContentTypeMismatch = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DAS-1004",
+		Error: core.I18nMessage{
+			Key:          "design.asset.error.content_type_mismatch",
+			DefaultValue: "Content type mismatch",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "design.asset.error.content_type_mismatch_description",
+			DefaultValue: "The content of the uploaded asset does not match its declared content type",
+		},
+	}
+
+	// ErrorAssetNotFound is returned when an asset is not found.
+	ErrorAssetNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DAS-1005",
+		Error: core.I18nMessage{
+			Key:          "design.asset.error.not_found",
+			DefaultValue: "Asset not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "design.asset.error.not_found_description",
+			DefaultValue: "The requested asset was not found",
+		},
+	}
+)
+
+// errAssetNotFound is returned by asset storages when the requested asset does not exist.
+var errAssetNotFound = errors.New("asset not found")
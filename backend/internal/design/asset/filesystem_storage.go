@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const defaultFileSystemStoragePath = "repository/resources/design/assets"
+
+// fileSystemStorage stores assets as files in a directory on the local filesystem.
+type fileSystemStorage struct {
+	dir string
+}
+
+// newFileSystemStorage creates a filesystem storage rooted at the configured path, creating the
+// directory if it does not exist.
+func newFileSystemStorage(cfg config.DesignAssetFileSystemConfig, serverHome string) (
+	assetStorageInterface, error) {
+	dir := cfg.Path
+	if dir == "" {
+		dir = defaultFileSystemStoragePath
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(serverHome, dir)
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create design asset directory: %w", err)
+	}
+	return &fileSystemStorage{dir: dir}, nil
+}
+
+// Put writes the asset data to a file named by the key. The data is written to a temporary file first
+// so that a partially written asset is never served.
+func (s *fileSystemStorage) Put(_ context.Context, key, _ string, data []byte) error {
+	tmp, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create asset file: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write asset file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write asset file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path(key)); err != nil {
+		return fmt.Errorf("failed to store asset file: %w", err)
+	}
+	return nil
+}
+
+// Get reads the asset data from the file named by the key.
+func (s *fileSystemStorage) Get(_ context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errAssetNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read asset file: %w", err)
+	}
+	return data, nil
+}
+
+// Delete removes the file named by the key.
+func (s *fileSystemStorage) Delete(_ context.Context, key string) error {
+	err := os.Remove(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return errAssetNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete asset file: %w", err)
+	}
+	return nil
+}
+
+// path returns the path of the file the asset with the given key is stored in.
+func (s *fileSystemStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.Base(key))
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type FileSystemStorageTestSuite struct {
+	suite.Suite
+	serverHome string
+	storage    assetStorageInterface
+}
+
+func TestFileSystemStorageTestSuite(t *testing.T) {
+	suite.Run(t, new(FileSystemStorageTestSuite))
+}
+
+func (suite *FileSystemStorageTestSuite) SetupTest() {
+	suite.serverHome = suite.T().TempDir()
+	storage, err := newFileSystemStorage(config.DesignAssetFileSystemConfig{Path: "assets"}, suite.serverHome)
+	suite.Require().NoError(err)
+	suite.storage = storage
+}
+
+func (suite *FileSystemStorageTestSuite) TestPutGetDelete() {
+	ctx := context.Background()
+
+	suite.Require().NoError(suite.storage.Put(ctx, testAssetID, "image/png", pngHeader))
+	suite.FileExists(filepath.Join(suite.serverHome, "assets", testAssetID))
+
+	data, err := suite.storage.Get(ctx, testAssetID)
+	suite.NoError(err)
+	suite.Equal(pngHeader, data)
+
+	suite.NoError(suite.storage.Delete(ctx, testAssetID))
+	_, err = suite.storage.Get(ctx, testAssetID)
+	suite.ErrorIs(err, errAssetNotFound)
+	suite.ErrorIs(suite.storage.Delete(ctx, testAssetID), errAssetNotFound)
+}
+
+func (suite *FileSystemStorageTestSuite) TestPutLeavesNoTemporaryFiles() {
+	suite.Require().NoError(suite.storage.Put(context.Background(), testAssetID, "image/png", pngHeader))
+
+	entries, err := os.ReadDir(filepath.Join(suite.serverHome, "assets"))
+	suite.Require().NoError(err)
+	suite.Len(entries, 1)
+}
+
+func (suite *FileSystemStorageTestSuite) TestKeyCannotEscapeDirectory() {
+	suite.Require().NoError(suite.storage.Put(context.Background(), "../escaped.png", "image/png", pngHeader))
+
+	suite.NoFileExists(filepath.Join(suite.serverHome, "escaped.png"))
+	suite.FileExists(filepath.Join(suite.serverHome, "assets", "escaped.png"))
+}
+
+func (suite *FileSystemStorageTestSuite) TestNewAssetStorage() {
+	storage, err := newAssetStorage(config.DesignAssetConfig{}, suite.serverHome)
+	suite.NoError(err)
+	suite.IsType(&fileSystemStorage{}, storage)
+	suite.DirExists(filepath.Join(suite.serverHome, defaultFileSystemStoragePath))
+
+	_, err = newAssetStorage(config.DesignAssetConfig{Storage: "unknown"}, suite.serverHome)
+	suite.ErrorContains(err, "unsupported design asset storage")
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	handlerLoggerComponentName = "DesignAssetHandler"
+	// assetFormField is the multipart form field carrying the uploaded asset.
+	assetFormField = "file"
+	// multipartOverhead is the allowance made for the multipart encoding on top of the asset size.
+	multipartOverhead = 64 * 1024
+	// assetContentSecurityPolicy prevents scripts embedded in SVG assets from running when an asset is
+	// opened directly.
+	assetContentSecurityPolicy = "default-src 'none'; style-src 'unsafe-inline'; sandbox"
+)
+
+// assetHandler is the handler for design asset operations.
+type assetHandler struct {
+	assetService AssetServiceInterface
+	maxSize      int64
+	cacheMaxAge  int
+	logger       *log.Logger
+}
+
+// newAssetHandler creates a new instance of assetHandler.
+func newAssetHandler(assetService AssetServiceInterface, maxSize int64, cacheMaxAge int) *assetHandler {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+	return &assetHandler{
+		assetService: assetService,
+		maxSize:      maxSize,
+		cacheMaxAge:  cacheMaxAge,
+		logger:       logger,
+	}
+}
+
+// HandleAssetUploadRequest handles the upload asset request.
+func (ah *assetHandler) HandleAssetUploadRequest(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, ah.maxSize+multipartOverhead)
+	file, header, err := r.FormFile(assetFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			handleError(w, &ErrorAssetTooLarge)
+			return
+		}
+		handleError(w, &ErrorInvalidAssetRequest)
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	data, err := io.ReadAll(io.LimitReader(file, ah.maxSize+1))
+	if err != nil {
+		handleError(w, &ErrorInvalidAssetRequest)
+		return
+	}
+
+	asset, svcErr := ah.assetService.UploadAsset(r.Context(), header.Header.Get("Content-Type"), data)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, asset)
+
+	ah.logger.Debug("Successfully uploaded asset", log.String("id", asset.ID))
+}
+
+// HandleAssetContentRequest serves the content of an asset. Asset IDs change on every upload, so the
+// content of an ID never changes and is cached as immutable.
+func (ah *assetHandler) HandleAssetContentRequest(w http.ResponseWriter, r *http.Request) {
+	content, svcErr := ah.assetService.GetAssetContent(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	etag := strconv.Quote(content.ID)
+	w.Header().Set("ETag", etag)
+	if ah.cacheMaxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d, immutable", ah.cacheMaxAge))
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", assetContentSecurityPolicy)
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", content.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(content.Data)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(content.Data); err != nil {
+		ah.logger.Error("Failed to write asset content", log.String("id", content.ID), log.Error(err))
+	}
+}
+
+// HandleAssetDeleteRequest handles the delete asset request.
+func (ah *assetHandler) HandleAssetDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if svcErr := ah.assetService.DeleteAsset(r.Context(), id); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	ah.logger.Debug("Successfully deleted asset", log.String("id", id))
+}
+
+// handleError handles service errors and returns appropriate HTTP responses.
+func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	switch {
+	case svcErr == &ErrorAssetNotFound:
+		statusCode = http.StatusNotFound
+	case svcErr == &ErrorAssetTooLarge:
+		statusCode = http.StatusRequestEntityTooLarge
+	case svcErr == &ErrorUnsupportedContentType:
+		statusCode = http.StatusUnsupportedMediaType
+	case svcErr.Type == serviceerror.ClientErrorType:
+		statusCode = http.StatusBadRequest
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type AssetHandlerTestSuite struct {
+	suite.Suite
+	mockService *AssetServiceInterfaceMock
+	handler     *assetHandler
+}
+
+func TestAssetHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AssetHandlerTestSuite))
+}
+
+func (suite *AssetHandlerTestSuite) SetupTest() {
+	suite.mockService = NewAssetServiceInterfaceMock(suite.T())
+	suite.handler = newAssetHandler(suite.mockService, 1024, 3600)
+}
+
+// newUploadRequest builds a multipart upload request carrying the data in the given form field.
+func (suite *AssetHandlerTestSuite) newUploadRequest(field, contentType string, data []byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="`+field+`"; filename="logo"`)
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	suite.Require().NoError(err)
+	_, err = part.Write(data)
+	suite.Require().NoError(err)
+	suite.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/design/assets", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func (suite *AssetHandlerTestSuite) TestHandleAssetUploadRequest_Success() {
+	suite.mockService.On("UploadAsset", mock.Anything, "image/png", pngHeader).Return(&Asset{
+		ID:          testAssetID,
+		ContentType: "image/png",
+		Size:        int64(len(pngHeader)),
+		URL:         "https://localhost:8090/design/assets/" + testAssetID + "/content",
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	suite.handler.HandleAssetUploadRequest(rr, suite.newUploadRequest(assetFormField, "image/png", pngHeader))
+
+	suite.Equal(http.StatusCreated, rr.Code)
+	suite.Contains(rr.Body.String(), `"id":"`+testAssetID+`"`)
+	suite.Contains(rr.Body.String(), `"contentType":"image/png"`)
+}
+
+func (suite *AssetHandlerTestSuite) TestHandleAssetUploadRequest_MissingFile() {
+	rr := httptest.NewRecorder()
+	suite.handler.HandleAssetUploadRequest(rr, suite.newUploadRequest("other", "image/png", pngHeader))
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidAssetRequest.Code)
+}
+
+func (suite *AssetHandlerTestSuite) TestHandleAssetUploadRequest_BodyTooLarge() {
+	data := make([]byte, 1024+multipartOverhead)
+
+	rr := httptest.NewRecorder()
+	suite.handler.HandleAssetUploadRequest(rr, suite.newUploadRequest(assetFormField, "image/png", data))
+
+	suite.Equal(http.StatusRequestEntityTooLarge, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorAssetTooLarge.Code)
+}
+
+func (suite *AssetHandlerTestSuite) TestHandleAssetUploadRequest_ServiceErrors() {
+	testCases := []struct {
+		name           string
+		svcErr         *serviceerror.ServiceError
+		expectedStatus int
+	}{
+		{name: "TooLarge", svcErr: &ErrorAssetTooLarge, expectedStatus: http.StatusRequestEntityTooLarge},
+		{name: "Unsupported", svcErr: &ErrorUnsupportedContentType, expectedStatus: http.StatusUnsupportedMediaType},
+		{name: "Mismatch", svcErr: &ErrorContentTypeMismatch, expectedStatus: http.StatusBadRequest},
+		{name: "Internal", svcErr: &serviceerror.InternalServerError, expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockService.On("UploadAsset", mock.Anything, "image/png", pngHeader).Return(nil, tc.svcErr)
+
+			rr := httptest.NewRecorder()
+			suite.handler.HandleAssetUploadRequest(rr, suite.newUploadRequest(assetFormField, "image/png", pngHeader))
+
+			suite.Equal(tc.expectedStatus, rr.Code)
+			suite.Contains(rr.Body.String(), tc.svcErr.Code)
+		})
+	}
+}
+
+func (suite *AssetHandlerTestSuite) TestHandleAssetContentRequest_Success() {
+	suite.mockService.On("GetAssetContent", mock.Anything, testAssetID).Return(&AssetContent{
+		ID: testAssetID, ContentType: "image/png", Data: pngHeader,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/design/assets/"+testAssetID+"/content", nil)
+	req.SetPathValue("id", testAssetID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleAssetContentRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal(pngHeader, rr.Body.Bytes())
+	suite.Equal("image/png", rr.Header().Get("Content-Type"))
+	suite.Equal("public, max-age=3600, immutable", rr.Header().Get("Cache-Control"))
+	suite.Equal(`"`+testAssetID+`"`, rr.Header().Get("ETag"))
+	suite.Equal("nosniff", rr.Header().Get("X-Content-Type-Options"))
+	suite.Equal(assetContentSecurityPolicy, rr.Header().Get("Content-Security-Policy"))
+}
+
+func (suite *AssetHandlerTestSuite) TestHandleAssetContentRequest_NotModified() {
+	suite.handler.cacheMaxAge = 0
+	suite.mockService.On("GetAssetContent", mock.Anything, testAssetID).Return(&AssetContent{
+		ID: testAssetID, ContentType: "image/png", Data: pngHeader,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/design/assets/"+testAssetID+"/content", nil)
+	req.SetPathValue("id", testAssetID)
+	req.Header.Set("If-None-Match", `"`+testAssetID+`"`)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleAssetContentRequest(rr, req)
+
+	suite.Equal(http.StatusNotModified, rr.Code)
+	suite.Empty(rr.Body.Bytes())
+	suite.Equal("no-cache", rr.Header().Get("Cache-Control"))
+}
+
+func (suite *AssetHandlerTestSuite) TestHandleAssetContentRequest_NotFound() {
+	suite.mockService.On("GetAssetContent", mock.Anything, "missing").Return(nil, &ErrorAssetNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/design/assets/missing/content", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleAssetContentRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *AssetHandlerTestSuite) TestHandleAssetDeleteRequest() {
+	suite.mockService.On("DeleteAsset", mock.Anything, testAssetID).Return(nil).Once()
+	req := httptest.NewRequest(http.MethodDelete, "/design/assets/"+testAssetID, nil)
+	req.SetPathValue("id", testAssetID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleAssetDeleteRequest(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+
+	suite.mockService.On("DeleteAsset", mock.Anything, testAssetID).Return(&ErrorAssetNotFound).Once()
+	rr = httptest.NewRecorder()
+	suite.handler.HandleAssetDeleteRequest(rr, req)
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// defaultMaxSize is the maximum asset size used when none is configured.
+const defaultMaxSize = 2 * 1024 * 1024
+
+// Initialize initializes the design asset service and registers its routes.
+func Initialize(mux *http.ServeMux) (AssetServiceInterface, error) {
+	runtime := config.GetServerRuntime()
+	cfg := runtime.Config.DesignAsset
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = defaultMaxSize
+	}
+
+	storage, err := newAssetStorage(cfg, runtime.ServerHome)
+	if err != nil {
+		return nil, err
+	}
+
+	assetService := newAssetService(storage, cfg)
+	assetHandler := newAssetHandler(assetService, cfg.MaxSize, cfg.CacheMaxAge)
+	registerRoutes(mux, assetHandler)
+
+	return assetService, nil
+}
+
+// registerRoutes registers the routes for design asset operations.
+func registerRoutes(mux *http.ServeMux, assetHandler *assetHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /design/assets", assetHandler.HandleAssetUploadRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /design/assets", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("DELETE /design/assets/{id}", assetHandler.HandleAssetDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /design/assets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts2))
+
+	opts3 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /design/assets/{id}/content",
+		assetHandler.HandleAssetContentRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /design/assets/{id}/content",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+}
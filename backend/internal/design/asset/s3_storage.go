@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+const (
+	s3HTTPClientTimeout = 30 * time.Second
+	s3SigningAlgorithm  = "AWS4-HMAC-SHA256"
+	s3ServiceName       = "s3"
+)
+
+// s3Storage stores assets as objects in an S3 bucket. Requests are signed with AWS Signature Version 4
+// so that any S3 compatible object store can be used.
+type s3Storage struct {
+	cfg        config.DesignAssetS3Config
+	bucketURL  *url.URL
+	httpClient syshttp.HTTPClientInterface
+	now        func() time.Time
+}
+
+// newS3Storage creates an S3 storage for the configured bucket.
+func newS3Storage(cfg config.DesignAssetS3Config) (assetStorageInterface, error) {
+	if cfg.Bucket == "" || cfg.Region == "" {
+		return nil, errors.New("design asset s3 storage requires a bucket and region")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("design asset s3 storage requires an access key id and secret access key")
+	}
+
+	rawURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com", cfg.Bucket, cfg.Region)
+	if cfg.Endpoint != "" {
+		rawURL = strings.TrimSuffix(cfg.Endpoint, "/") + "/" + cfg.Bucket
+	}
+	bucketURL, err := url.Parse(rawURL)
+	if err != nil || bucketURL.Host == "" {
+		return nil, fmt.Errorf("invalid design asset s3 endpoint: %s", cfg.Endpoint)
+	}
+
+	return &s3Storage{
+		cfg:        cfg,
+		bucketURL:  bucketURL,
+		httpClient: syshttp.NewHTTPClientWithTimeout(s3HTTPClientTimeout),
+		now:        time.Now,
+	}, nil
+}
+
+// Put uploads the asset data as an object named by the key.
+func (s *s3Storage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, contentType, data)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to upload asset object: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Get downloads the object named by the key.
+func (s *s3Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errAssetNotFound
+	default:
+		return nil, fmt.Errorf("failed to download asset object: unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read asset object: %w", err)
+	}
+	return data, nil
+}
+
+// Delete removes the object named by the key. S3 does not report missing objects on delete, so the
+// object is looked up first.
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodHead, key, "", nil)
+	if err != nil {
+		return err
+	}
+	closeBody(resp)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return errAssetNotFound
+	default:
+		return fmt.Errorf("failed to look up asset object: unexpected status %d", resp.StatusCode)
+	}
+
+	resp, err = s.do(ctx, http.MethodDelete, key, "", nil)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to delete asset object: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// do sends a signed request for the object named by the key.
+func (s *s3Storage) do(ctx context.Context, method, key, contentType string, body []byte) (*http.Response, error) {
+	objectURL := *s.bucketURL
+	objectURL.Path = "/" + strings.TrimPrefix(path.Join(s.bucketURL.Path, s.cfg.Prefix, key), "/")
+
+	req, err := http.NewRequestWithContext(ctx, method, objectURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create asset object request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	s.sign(req, body)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("asset object request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// sign adds the AWS Signature Version 4 authorization header to the request.
+func (s *s3Storage) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.cfg.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(req.Header.Get(name))
+	}
+	signedHeaders := make([]string, 0, len(headers))
+	for name := range headers {
+		signedHeaders = append(signedHeaders, name)
+	}
+	sort.Strings(signedHeaders)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaderList := strings.Join(signedHeaders, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaderList,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, s.cfg.Region, s3ServiceName, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{s3SigningAlgorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))},
+		"\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, s3ServiceName)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, s.cfg.AccessKeyID, scope, signedHeaderList, signature))
+}
+
+// closeBody drains and closes the response body so the connection can be reused.
+func closeBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type S3StorageTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	mu       sync.Mutex
+	objects  map[string][]byte
+	requests []*http.Request
+	storage  *s3Storage
+}
+
+func TestS3StorageTestSuite(t *testing.T) {
+	suite.Run(t, new(S3StorageTestSuite))
+}
+
+func (suite *S3StorageTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("/tmp/test", &config.Config{}))
+
+	suite.objects = map[string][]byte{}
+	suite.requests = nil
+	suite.server = httptest.NewServer(http.HandlerFunc(suite.serveObject))
+
+	storage, err := newS3Storage(config.DesignAssetS3Config{
+		Bucket:          "branding",
+		Region:          "us-east-1",
+		Endpoint:        suite.server.URL + "/",
+		Prefix:          "assets",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		SessionToken:    "token",
+	})
+	suite.Require().NoError(err)
+	suite.storage = storage.(*s3Storage)
+	suite.storage.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+}
+
+func (suite *S3StorageTestSuite) TearDownTest() {
+	suite.server.Close()
+	config.ResetServerRuntime()
+}
+
+// serveObject is a minimal in-memory S3 object endpoint.
+func (suite *S3StorageTestSuite) serveObject(w http.ResponseWriter, r *http.Request) {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+	suite.requests = append(suite.requests, r)
+
+	data, exists := suite.objects[r.URL.Path]
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		suite.objects[r.URL.Path] = body
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(suite.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (suite *S3StorageTestSuite) TestPutGetDelete() {
+	ctx := context.Background()
+
+	suite.Require().NoError(suite.storage.Put(ctx, testAssetID, "image/png", pngHeader))
+	suite.Equal(pngHeader, suite.objects["/branding/assets/"+testAssetID])
+
+	data, err := suite.storage.Get(ctx, testAssetID)
+	suite.NoError(err)
+	suite.Equal(pngHeader, data)
+
+	suite.NoError(suite.storage.Delete(ctx, testAssetID))
+	_, err = suite.storage.Get(ctx, testAssetID)
+	suite.ErrorIs(err, errAssetNotFound)
+	suite.ErrorIs(suite.storage.Delete(ctx, testAssetID), errAssetNotFound)
+}
+
+func (suite *S3StorageTestSuite) TestRequestsAreSigned() {
+	suite.Require().NoError(suite.storage.Put(context.Background(), testAssetID, "image/png", pngHeader))
+
+	req := suite.requests[0]
+	suite.Equal("20260102T030405Z", req.Header.Get("X-Amz-Date"))
+	suite.Equal(sha256Hex(pngHeader), req.Header.Get("X-Amz-Content-Sha256"))
+	suite.Equal("token", req.Header.Get("X-Amz-Security-Token"))
+	suite.Regexp(`^AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20260102/us-east-1/s3/aws4_request, `+
+		`SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date;x-amz-security-token, `+
+		`Signature=[0-9a-f]{64}$`, req.Header.Get("Authorization"))
+}
+
+func (suite *S3StorageTestSuite) TestUnexpectedStatus() {
+	suite.server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	suite.ErrorContains(suite.storage.Put(context.Background(), testAssetID, "image/png", pngHeader),
+		"unexpected status 403")
+	_, err := suite.storage.Get(context.Background(), testAssetID)
+	suite.ErrorContains(err, "unexpected status 403")
+	suite.ErrorContains(suite.storage.Delete(context.Background(), testAssetID), "unexpected status 403")
+}
+
+func (suite *S3StorageTestSuite) TestNewS3Storage_Validation() {
+	_, err := newS3Storage(config.DesignAssetS3Config{Region: "us-east-1"})
+	suite.ErrorContains(err, "requires a bucket and region")
+
+	_, err = newS3Storage(config.DesignAssetS3Config{Bucket: "branding", Region: "us-east-1"})
+	suite.ErrorContains(err, "requires an access key id")
+
+	storage, err := newS3Storage(config.DesignAssetS3Config{
+		Bucket: "branding", Region: "eu-west-1", AccessKeyID: "id", SecretAccessKey: "secret",
+	})
+	suite.NoError(err)
+	suite.Equal("https://branding.s3.eu-west-1.amazonaws.com", storage.(*s3Storage).bucketURL.String())
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package asset provides upload and delivery of branding assets, such as logos and background images,
+// referenced from themes and layouts.
+package asset
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const loggerComponentName = "DesignAssetService"
+
+// svgSniffLength is the number of leading bytes searched for the root element of an SVG image.
+const svgSniffLength = 1024
+
+// contentTypeExtensions maps the supported asset content types to the file extension of the asset ID.
+var contentTypeExtensions = map[string]string{
+	"image/png":     "png",
+	"image/jpeg":    "jpg",
+	"image/gif":     "gif",
+	"image/webp":    "webp",
+	"image/svg+xml": "svg",
+	"image/x-icon":  "ico",
+}
+
+// AssetServiceInterface defines the interface for the design asset service.
+type AssetServiceInterface interface {
+	UploadAsset(ctx context.Context, contentType string, data []byte) (*Asset, *serviceerror.ServiceError)
+	GetAssetContent(ctx context.Context, id string) (*AssetContent, *serviceerror.ServiceError)
+	DeleteAsset(ctx context.Context, id string) *serviceerror.ServiceError
+}
+
+// assetService is the default implementation of the AssetServiceInterface.
+type assetService struct {
+	storage assetStorageInterface
+	cfg     config.DesignAssetConfig
+	logger  *log.Logger
+}
+
+// newAssetService creates a new instance of the asset service with injected dependencies.
+func newAssetService(storage assetStorageInterface, cfg config.DesignAssetConfig) AssetServiceInterface {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	return &assetService{
+		storage: storage,
+		cfg:     cfg,
+		logger:  logger,
+	}
+}
+
+// UploadAsset validates and stores an asset. The asset ID carries the file extension of the content
+// type so that the content type can be resolved when the asset is served.
+func (as *assetService) UploadAsset(ctx context.Context, contentType string, data []byte) (
+	*Asset, *serviceerror.ServiceError) {
+	if len(data) == 0 {
+		return nil, &ErrorInvalidAssetRequest
+	}
+	if as.cfg.MaxSize > 0 && int64(len(data)) > as.cfg.MaxSize {
+		return nil, &ErrorAssetTooLarge
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, &ErrorUnsupportedContentType
+	}
+	extension, supported := contentTypeExtensions[mediaType]
+	if !supported || !slices.Contains(as.cfg.AllowedContentTypes, mediaType) {
+		return nil, &ErrorUnsupportedContentType
+	}
+	if !matchesContentType(mediaType, data) {
+		return nil, &ErrorContentTypeMismatch
+	}
+
+	uuid, err := utils.GenerateUUIDv7()
+	if err != nil {
+		as.logger.Error("Failed to generate UUID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	id := uuid + "." + extension
+
+	if err := as.storage.Put(ctx, id, mediaType, data); err != nil {
+		as.logger.Error("Failed to store asset", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	as.logger.Debug("Successfully uploaded asset", log.String("id", id))
+	return &Asset{
+		ID:          id,
+		ContentType: mediaType,
+		Size:        int64(len(data)),
+		URL:         getAssetURL(ctx, id),
+	}, nil
+}
+
+// GetAssetContent retrieves the content of an asset.
+func (as *assetService) GetAssetContent(ctx context.Context, id string) (*AssetContent, *serviceerror.ServiceError) {
+	contentType, valid := parseAssetID(id)
+	if !valid {
+		return nil, &ErrorAssetNotFound
+	}
+
+	data, err := as.storage.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, errAssetNotFound) {
+			return nil, &ErrorAssetNotFound
+		}
+		as.logger.Error("Failed to retrieve asset", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &AssetContent{ID: id, ContentType: contentType, Data: data}, nil
+}
+
+// DeleteAsset deletes an asset.
+func (as *assetService) DeleteAsset(ctx context.Context, id string) *serviceerror.ServiceError {
+	if _, valid := parseAssetID(id); !valid {
+		return &ErrorAssetNotFound
+	}
+
+	if err := as.storage.Delete(ctx, id); err != nil {
+		if errors.Is(err, errAssetNotFound) {
+			return &ErrorAssetNotFound
+		}
+		as.logger.Error("Failed to delete asset", log.String("id", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	as.logger.Debug("Successfully deleted asset", log.String("id", id))
+	return nil
+}
+
+// parseAssetID validates an asset ID and returns the content type of the asset. Only IDs generated by
+// UploadAsset are valid, which also keeps the ID safe to use as a storage key.
+func parseAssetID(id string) (string, bool) {
+	uuid, extension, found := strings.Cut(id, ".")
+	if !found || !utils.IsValidUUID(uuid) {
+		return "", false
+	}
+	for contentType, ext := range contentTypeExtensions {
+		if ext == extension {
+			return contentType, true
+		}
+	}
+	return "", false
+}
+
+// matchesContentType reports whether the content of an asset matches its declared content type.
+func matchesContentType(contentType string, data []byte) bool {
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	if contentType != "image/svg+xml" {
+		return sniffed == contentType
+	}
+	if sniffed != "text/xml" && sniffed != "text/plain" {
+		return false
+	}
+	head := data[:min(len(data), svgSniffLength)]
+	return bytes.Contains(bytes.ToLower(head), []byte("<svg"))
+}
+
+// getAssetURL returns the public URL the asset with the given ID is served from.
+func getAssetURL(ctx context.Context, id string) string {
+	return config.GetPublicURL(ctx) + "/design/assets/" + id + "/content"
+}
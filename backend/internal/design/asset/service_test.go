@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+const testAssetID = "01890a5d-ac96-774b-bcce-b302099a8057.png"
+
+// pngHeader is the signature of a PNG image, enough for content sniffing.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type AssetServiceTestSuite struct {
+	suite.Suite
+	mockStorage *assetStorageInterfaceMock
+	service     AssetServiceInterface
+}
+
+func TestAssetServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AssetServiceTestSuite))
+}
+
+func (suite *AssetServiceTestSuite) SetupTest() {
+	testConfig := &config.Config{
+		Server: config.ServerConfig{Hostname: "localhost", Port: 8090},
+	}
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("/tmp/test", testConfig))
+
+	suite.mockStorage = newAssetStorageInterfaceMock(suite.T())
+	suite.service = newAssetService(suite.mockStorage, config.DesignAssetConfig{
+		MaxSize:             128,
+		AllowedContentTypes: []string{"image/png", "image/svg+xml"},
+	})
+}
+
+func (suite *AssetServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *AssetServiceTestSuite) TestUploadAsset_Success() {
+	suite.mockStorage.On("Put", mock.Anything, mock.MatchedBy(func(key string) bool {
+		return strings.HasSuffix(key, ".png")
+	}), "image/png", pngHeader).Return(nil)
+
+	asset, svcErr := suite.service.UploadAsset(context.Background(), "image/png", pngHeader)
+
+	suite.Nil(svcErr)
+	suite.True(strings.HasSuffix(asset.ID, ".png"))
+	suite.Equal("image/png", asset.ContentType)
+	suite.Equal(int64(len(pngHeader)), asset.Size)
+	suite.Equal("https://localhost:8090/design/assets/"+asset.ID+"/content", asset.URL)
+}
+
+func (suite *AssetServiceTestSuite) TestUploadAsset_SVG() {
+	svg := []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`)
+	suite.mockStorage.On("Put", mock.Anything, mock.Anything, "image/svg+xml", svg).Return(nil)
+
+	asset, svcErr := suite.service.UploadAsset(context.Background(), "image/svg+xml", svg)
+
+	suite.Nil(svcErr)
+	suite.True(strings.HasSuffix(asset.ID, ".svg"))
+}
+
+func (suite *AssetServiceTestSuite) TestUploadAsset_ValidationErrors() {
+	testCases := []struct {
+		name        string
+		contentType string
+		data        []byte
+		expectedErr *serviceerror.ServiceError
+	}{
+		{name: "Empty", contentType: "image/png", data: nil, expectedErr: &ErrorInvalidAssetRequest},
+		{name: "TooLarge", contentType: "image/png", data: make([]byte, 129), expectedErr: &ErrorAssetTooLarge},
+		{name: "InvalidContentType", contentType: "image/", data: pngHeader,
+			expectedErr: &ErrorUnsupportedContentType},
+		{name: "NotAllowed", contentType: "image/gif", data: []byte("GIF89a"),
+			expectedErr: &ErrorUnsupportedContentType},
+		{name: "NotSupported", contentType: "application/pdf", data: []byte("%PDF-"),
+			expectedErr: &ErrorUnsupportedContentType},
+		{name: "Mismatch", contentType: "image/png", data: []byte("GIF89a"),
+			expectedErr: &ErrorContentTypeMismatch},
+		{name: "SVGWithoutRoot", contentType: "image/svg+xml", data: []byte("<html></html>"),
+			expectedErr: &ErrorContentTypeMismatch},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			asset, svcErr := suite.service.UploadAsset(context.Background(), tc.contentType, tc.data)
+
+			suite.Nil(asset)
+			suite.Equal(tc.expectedErr, svcErr)
+		})
+	}
+}
+
+func (suite *AssetServiceTestSuite) TestUploadAsset_StorageError() {
+	suite.mockStorage.On("Put", mock.Anything, mock.Anything, "image/png", pngHeader).
+		Return(errors.New("disk full"))
+
+	asset, svcErr := suite.service.UploadAsset(context.Background(), "image/png; charset=binary", pngHeader)
+
+	suite.Nil(asset)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *AssetServiceTestSuite) TestGetAssetContent_Success() {
+	suite.mockStorage.On("Get", mock.Anything, testAssetID).Return(pngHeader, nil)
+
+	content, svcErr := suite.service.GetAssetContent(context.Background(), testAssetID)
+
+	suite.Nil(svcErr)
+	suite.Equal(testAssetID, content.ID)
+	suite.Equal("image/png", content.ContentType)
+	suite.Equal(pngHeader, content.Data)
+}
+
+func (suite *AssetServiceTestSuite) TestGetAssetContent_InvalidID() {
+	for _, id := range []string{"", "../../etc/passwd", "not-a-uuid.png", "01890a5d-ac96-774b-bcce-b302099a8057.exe"} {
+		content, svcErr := suite.service.GetAssetContent(context.Background(), id)
+
+		suite.Nil(content)
+		suite.Equal(&ErrorAssetNotFound, svcErr)
+	}
+}
+
+func (suite *AssetServiceTestSuite) TestGetAssetContent_StorageErrors() {
+	suite.mockStorage.On("Get", mock.Anything, testAssetID).Return(nil, errAssetNotFound).Once()
+	_, svcErr := suite.service.GetAssetContent(context.Background(), testAssetID)
+	suite.Equal(&ErrorAssetNotFound, svcErr)
+
+	suite.mockStorage.On("Get", mock.Anything, testAssetID).Return(nil, errors.New("io error")).Once()
+	_, svcErr = suite.service.GetAssetContent(context.Background(), testAssetID)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *AssetServiceTestSuite) TestDeleteAsset() {
+	suite.mockStorage.On("Delete", mock.Anything, testAssetID).Return(nil).Once()
+	suite.Nil(suite.service.DeleteAsset(context.Background(), testAssetID))
+
+	suite.mockStorage.On("Delete", mock.Anything, testAssetID).Return(errAssetNotFound).Once()
+	suite.Equal(&ErrorAssetNotFound, suite.service.DeleteAsset(context.Background(), testAssetID))
+
+	suite.mockStorage.On("Delete", mock.Anything, testAssetID).Return(errors.New("io error")).Once()
+	suite.Equal(&serviceerror.InternalServerError, suite.service.DeleteAsset(context.Background(), testAssetID))
+
+	suite.Equal(&ErrorAssetNotFound, suite.service.DeleteAsset(context.Background(), "invalid"))
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const (
+	// storageTypeFileSystem stores assets in a directory on the local filesystem.
+	storageTypeFileSystem = "filesystem"
+	// storageTypeS3 stores assets in an S3 bucket.
+	storageTypeS3 = "s3"
+)
+
+// assetStorageInterface defines the interface of the storages assets are persisted in.
+type assetStorageInterface interface {
+	// Put stores the asset data under the given key.
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Get returns the asset data stored under the given key, or errAssetNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the asset data stored under the given key, or returns errAssetNotFound.
+	Delete(ctx context.Context, key string) error
+}
+
+// newAssetStorage creates the asset storage selected by the design asset configuration.
+func newAssetStorage(cfg config.DesignAssetConfig, serverHome string) (assetStorageInterface, error) {
+	switch cfg.Storage {
+	case "", storageTypeFileSystem:
+		return newFileSystemStorage(cfg.FileSystem, serverHome)
+	case storageTypeS3:
+		return newS3Storage(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unsupported design asset storage: %s", cfg.Storage)
+	}
+}
@@ -51,19 +51,6 @@ var (
 			DefaultValue: "The 'id' query parameter is required",
 		},
 	}
-	// ErrorUnsupportedResolveType is the error returned when resolve type is not yet supported.
-	ErrorUnsupportedResolveType = serviceerror.ServiceError{
-		Type: serviceerror.ClientErrorType,
-		Code: "DSR-1003",
-		Error: core.I18nMessage{
-			Key:          "design.resolve.error.unsupported_type",
-			DefaultValue: "Unsupported resolve type",
-		},
-		ErrorDescription: core.I18nMessage{
-			Key:          "design.resolve.error.unsupported_type_description",
-			DefaultValue: "The specified resolve type is not yet supported. Currently only 'APP' type is supported",
-		},
-	}
 	// ErrorApplicationNotFound is the error returned when an application is not found.
 	ErrorApplicationNotFound = serviceerror.ServiceError{
 		Type: serviceerror.ClientErrorType,
@@ -90,4 +77,31 @@ var (
 			DefaultValue: "The specified application does not have an associated theme or layout configuration",
 		},
 	}
+	// ErrorOrganizationUnitNotFound is the error returned when an organization unit is not found.
+	ErrorOrganizationUnitNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DSR-1006",
+		Error: core.I18nMessage{
+			Key:          "design.resolve.error.ou_not_found",
+			DefaultValue: "Organization unit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "design.resolve.error.ou_not_found_description",
+			DefaultValue: "The organization unit with the specified id does not exist",
+		},
+	}
+	// ErrorOrganizationUnitHasNoDesign is the error returned when neither an organization unit, its parents,
+	// nor the global defaults have an associated design.
+	ErrorOrganizationUnitHasNoDesign = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DSR-1007",
+		Error: core.I18nMessage{
+			Key:          "design.resolve.error.ou_no_design",
+			DefaultValue: "Organization unit has no design configuration",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "design.resolve.error.ou_no_design_description",
+			DefaultValue: "The specified organization unit does not have an associated theme or layout configuration",
+		},
+	}
 )
@@ -71,11 +71,12 @@ func (rh *designResolveHandler) handleError(w http.ResponseWriter, svcErr *servi
 	if svcErr.Type == serviceerror.ClientErrorType {
 		switch svcErr.Code {
 		case common.ErrorInvalidResolveType.Code,
-			common.ErrorMissingResolveID.Code,
-			common.ErrorUnsupportedResolveType.Code:
+			common.ErrorMissingResolveID.Code:
 			statusCode = http.StatusBadRequest
 		case common.ErrorApplicationHasNoDesign.Code,
-			common.ErrorApplicationNotFound.Code:
+			common.ErrorApplicationNotFound.Code,
+			common.ErrorOrganizationUnitHasNoDesign.Code,
+			common.ErrorOrganizationUnitNotFound.Code:
 			statusCode = http.StatusNotFound
 		default:
 			statusCode = http.StatusBadRequest
@@ -152,25 +152,26 @@ func (suite *ResolveHandlerTestSuite) TestHandleResolveRequest_MissingID() {
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
 }
 
-// Test HandleResolveRequest - Unsupported type
-func (suite *ResolveHandlerTestSuite) TestHandleResolveRequest_UnsupportedType() {
+// Test HandleResolveRequest - Organization unit not found
+func (suite *ResolveHandlerTestSuite) TestHandleResolveRequest_OrganizationUnitNotFound() {
 	mockService := &mockDesignResolveService{
 		resolveDesignFn: func(
 			ctx context.Context,
 			resolveType common.DesignResolveType,
 			id string,
 		) (*common.DesignResponse, *serviceerror.ServiceError) {
-			return nil, &common.ErrorUnsupportedResolveType
+			assert.Equal(suite.T(), common.DesignResolveTypeOU, resolveType)
+			return nil, &common.ErrorOrganizationUnitNotFound
 		},
 	}
 
 	handler := newDesignResolveHandler(mockService)
-	req := httptest.NewRequest(http.MethodGet, "/design/resolve?type=OU&id=ou-123", nil)
+	req := httptest.NewRequest(http.MethodGet, "/design/resolve?type=ou&id=ou-123", nil)
 	w := httptest.NewRecorder()
 
 	handler.HandleResolveRequest(w, req)
 
-	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
 }
 
 // Test HandleResolveRequest - Application not found
@@ -255,11 +256,6 @@ func (suite *ResolveHandlerTestSuite) TestHandleError_StatusCodeMapping() {
 			svcErr:         &common.ErrorMissingResolveID,
 			expectedStatus: http.StatusBadRequest,
 		},
-		{
-			name:           "UnsupportedResolveType",
-			svcErr:         &common.ErrorUnsupportedResolveType,
-			expectedStatus: http.StatusBadRequest,
-		},
 		{
 			name:           "ApplicationHasNoDesign",
 			svcErr:         &common.ErrorApplicationHasNoDesign,
@@ -270,6 +266,16 @@ func (suite *ResolveHandlerTestSuite) TestHandleError_StatusCodeMapping() {
 			svcErr:         &common.ErrorApplicationNotFound,
 			expectedStatus: http.StatusNotFound,
 		},
+		{
+			name:           "OrganizationUnitHasNoDesign",
+			svcErr:         &common.ErrorOrganizationUnitHasNoDesign,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "OrganizationUnitNotFound",
+			svcErr:         &common.ErrorOrganizationUnitNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
 		{
 			name:           "InternalServerError",
 			svcErr:         &serviceerror.InternalServerError,
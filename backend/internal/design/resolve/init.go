@@ -24,6 +24,8 @@ import (
 	"github.com/thunder-id/thunderid/internal/application"
 	layoutmgt "github.com/thunder-id/thunderid/internal/design/layout/mgt"
 	thememgt "github.com/thunder-id/thunderid/internal/design/theme/mgt"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 )
 
@@ -33,8 +35,11 @@ func Initialize(
 	themeMgtService thememgt.ThemeMgtServiceInterface,
 	layoutMgtService layoutmgt.LayoutMgtServiceInterface,
 	applicationService application.ApplicationServiceInterface,
+	ouService ou.OrganizationUnitServiceInterface,
 ) DesignResolveServiceInterface {
-	designResolveService := newDesignResolveService(themeMgtService, layoutMgtService, applicationService)
+	cfg := config.GetServerRuntime().Config
+	designResolveService := newDesignResolveService(themeMgtService, layoutMgtService, applicationService,
+		ouService, cfg.Theme.DefaultID, cfg.Layout.DefaultID)
 	designResolveHandler := newDesignResolveHandler(designResolveService)
 	registerRoutes(mux, designResolveHandler)
 	return designResolveService
@@ -27,10 +27,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/design/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/tests/mocks/applicationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/design/layoutmock"
 	"github.com/thunder-id/thunderid/tests/mocks/design/thememock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 )
 
 // Test Suite
@@ -48,8 +50,13 @@ func (suite *InitTestSuite) TestInitialize() {
 	mockTheme := thememock.NewThemeMgtServiceInterfaceMock(suite.T())
 	mockLayout := layoutmock.NewLayoutMgtServiceInterfaceMock(suite.T())
 	mockApp := applicationmock.NewApplicationServiceInterfaceMock(suite.T())
+	mockOU := oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
 
-	service := Initialize(mux, mockTheme, mockLayout, mockApp)
+	config.ResetServerRuntime()
+	defer config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	service := Initialize(mux, mockTheme, mockLayout, mockApp, mockOU)
 
 	assert.NotNil(suite.T(), service)
 	assert.Implements(suite.T(), (*DesignResolveServiceInterface)(nil), service)
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resolve
+
+import "encoding/json"
+
+// mergeDesignConfig merges a design configuration over a base configuration following JSON merge patch
+// semantics (RFC 7386). Objects are merged recursively, so nested token groups such as the light and dark
+// color schemes of a theme are merged independently, a null value removes the token from the base, and any
+// other value replaces the base value.
+func mergeDesignConfig(base, override json.RawMessage) (json.RawMessage, error) {
+	if len(override) == 0 {
+		return base, nil
+	}
+
+	var overrideValue interface{}
+	if err := json.Unmarshal(override, &overrideValue); err != nil {
+		return nil, err
+	}
+
+	var baseValue interface{}
+	if len(base) > 0 {
+		if err := json.Unmarshal(base, &baseValue); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(mergeValue(baseValue, overrideValue))
+}
+
+// mergeValue applies the override value over the base value.
+func mergeValue(base, override interface{}) interface{} {
+	overrideObj, ok := override.(map[string]interface{})
+	if !ok {
+		return override
+	}
+
+	baseObj, ok := base.(map[string]interface{})
+	if !ok {
+		baseObj = make(map[string]interface{}, len(overrideObj))
+	}
+	for key, value := range overrideObj {
+		if value == nil {
+			delete(baseObj, key)
+			continue
+		}
+		baseObj[key] = mergeValue(baseObj[key], value)
+	}
+	return baseObj
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resolve
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MergeTestSuite struct {
+	suite.Suite
+}
+
+func TestMergeTestSuite(t *testing.T) {
+	suite.Run(t, new(MergeTestSuite))
+}
+
+func (suite *MergeTestSuite) TestMergeDesignConfig() {
+	testCases := []struct {
+		name     string
+		base     string
+		override string
+		expected string
+	}{
+		{"EmptyBase", "", `{"a": 1}`, `{"a": 1}`},
+		{"EmptyOverride", `{"a": 1}`, "", `{"a": 1}`},
+		{"NestedObjectsMerged", `{"a": {"b": 1, "c": 2}}`, `{"a": {"c": 3}}`, `{"a": {"b": 1, "c": 3}}`},
+		{"NullRemovesKey", `{"a": 1, "b": 2}`, `{"b": null}`, `{"a": 1}`},
+		{"ArrayReplaced", `{"a": [1, 2]}`, `{"a": [3]}`, `{"a": [3]}`},
+		{"ScalarReplacedByObject", `{"a": 1}`, `{"a": {"b": 2}}`, `{"a": {"b": 2}}`},
+		{"NonObjectOverride", `{"a": 1}`, `[1]`, `[1]`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			result, err := mergeDesignConfig(json.RawMessage(tc.base), json.RawMessage(tc.override))
+
+			suite.NoError(err)
+			suite.JSONEq(tc.expected, string(result))
+		})
+	}
+}
+
+func (suite *MergeTestSuite) TestMergeDesignConfig_InvalidJSON() {
+	_, err := mergeDesignConfig(json.RawMessage(`{"a": 1}`), json.RawMessage(`{invalid`))
+	suite.Error(err)
+
+	_, err = mergeDesignConfig(json.RawMessage(`{invalid`), json.RawMessage(`{"a": 1}`))
+	suite.Error(err)
+}
@@ -21,13 +21,18 @@ package resolve
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
 
 	"github.com/thunder-id/thunderid/internal/application"
+	appmodel "github.com/thunder-id/thunderid/internal/application/model"
 	"github.com/thunder-id/thunderid/internal/design/common"
 	layoutmgt "github.com/thunder-id/thunderid/internal/design/layout/mgt"
 	thememgt "github.com/thunder-id/thunderid/internal/design/theme/mgt"
+	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
 )
 
 const serviceLogger = "DesignResolveService"
@@ -44,30 +49,43 @@ type designResolveService struct {
 	themeMgtService    thememgt.ThemeMgtServiceInterface
 	layoutMgtService   layoutmgt.LayoutMgtServiceInterface
 	applicationService application.ApplicationServiceInterface
+	ouService          ou.OrganizationUnitServiceInterface
+	defaultThemeID     string
+	defaultLayoutID    string
 	logger             *log.Logger
 }
 
 // newDesignResolveService creates a new instance of DesignResolveService with injected dependencies.
+// The default theme and layout apply when neither the entity nor its organization unit hierarchy has one.
 func newDesignResolveService(
 	themeMgtService thememgt.ThemeMgtServiceInterface,
 	layoutMgtService layoutmgt.LayoutMgtServiceInterface,
 	applicationService application.ApplicationServiceInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+	defaultThemeID string,
+	defaultLayoutID string,
 ) DesignResolveServiceInterface {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, serviceLogger))
 	return &designResolveService{
 		themeMgtService:    themeMgtService,
 		layoutMgtService:   layoutMgtService,
 		applicationService: applicationService,
+		ouService:          ouService,
+		defaultThemeID:     defaultThemeID,
+		defaultLayoutID:    defaultLayoutID,
 		logger:             logger,
 	}
 }
 
-// ResolveDesign resolves a design configuration by type and ID.
-// TODO: Add support for OU type and fallback logic.
+// ResolveDesign resolves a design configuration by type and ID. The design tokens are merged across levels,
+// starting from the configured defaults, followed by the organization unit hierarchy from the root down to the
+// closest organization unit, and finally the theme and layout configured for the entity, so that a more specific
+// level only needs to define the tokens it overrides. The light and dark color schemes of a theme are merged
+// independently and returned together in the resolved theme.
 func (drs *designResolveService) ResolveDesign(
 	ctx context.Context, resolveType common.DesignResolveType, id string,
 ) (*common.DesignResponse, *serviceerror.ServiceError) {
-	if resolveType == "" {
+	if resolveType != common.DesignResolveTypeAPP && resolveType != common.DesignResolveTypeOU {
 		return nil, &common.ErrorInvalidResolveType
 	}
 
@@ -75,12 +93,130 @@ func (drs *designResolveService) ResolveDesign(
 		return nil, &common.ErrorMissingResolveID
 	}
 
-	// Currently only APP type is supported
-	if resolveType != common.DesignResolveTypeAPP {
-		return nil, &common.ErrorUnsupportedResolveType
+	var themeIDs, layoutIDs []string
+	ouID := id
+	if resolveType == common.DesignResolveTypeAPP {
+		app, svcErr := drs.getApplication(ctx, id)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		themeIDs = appendDesignID(themeIDs, app.ThemeID)
+		layoutIDs = appendDesignID(layoutIDs, app.LayoutID)
+		ouID = app.OUID
+	}
+
+	themeIDs, layoutIDs, svcErr := drs.collectOUDesignIDs(ctx, resolveType, ouID, themeIDs, layoutIDs)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	themeIDs = appendDesignID(themeIDs, drs.defaultThemeID)
+	layoutIDs = appendDesignID(layoutIDs, drs.defaultLayoutID)
+
+	// Check if a theme or layout is configured
+	if len(themeIDs) == 0 && len(layoutIDs) == 0 {
+		if resolveType == common.DesignResolveTypeOU {
+			return nil, &common.ErrorOrganizationUnitHasNoDesign
+		}
+		return nil, &common.ErrorApplicationHasNoDesign
 	}
 
-	// Get the application by ID
+	designResponse := &common.DesignResponse{}
+
+	// Merge theme configurations from the least specific level to the most specific level
+	for i := len(themeIDs) - 1; i >= 0; i-- {
+		theme, svcErr := drs.getTheme(resolveType, id, themeIDs[i])
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		if designResponse.Theme, svcErr = drs.mergeConfig(designResponse.Theme, theme, themeIDs[i]); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	// Merge layout configurations from the least specific level to the most specific level
+	for i := len(layoutIDs) - 1; i >= 0; i-- {
+		layout, svcErr := drs.getLayout(resolveType, id, layoutIDs[i])
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		if designResponse.Layout, svcErr = drs.mergeConfig(designResponse.Layout, layout,
+			layoutIDs[i]); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	drs.logger.Debug("Successfully resolved design configuration",
+		log.String("type", string(resolveType)),
+		log.String("id", id),
+		log.Any("themeIds", themeIDs),
+		log.Any("layoutIds", layoutIDs))
+
+	return designResponse, nil
+}
+
+// getTheme retrieves the theme configuration referenced while resolving the design.
+func (drs *designResolveService) getTheme(
+	resolveType common.DesignResolveType, id, themeID string,
+) (json.RawMessage, *serviceerror.ServiceError) {
+	if drs.themeMgtService == nil {
+		drs.logger.Error("Theme management service is not available")
+		return nil, &serviceerror.InternalServerError
+	}
+
+	themeConfig, svcErr := drs.themeMgtService.GetTheme(themeID)
+	if svcErr != nil {
+		if svcErr.Code == thememgt.ErrorThemeNotFound.Code {
+			drs.logger.Error("Data integrity issue: design references non-existent theme",
+				log.String("type", string(resolveType)),
+				log.String("id", id),
+				log.String("themeId", themeID))
+			return nil, &serviceerror.InternalServerError
+		}
+		return nil, svcErr
+	}
+	return themeConfig.Theme, nil
+}
+
+// getLayout retrieves the layout configuration referenced while resolving the design.
+func (drs *designResolveService) getLayout(
+	resolveType common.DesignResolveType, id, layoutID string,
+) (json.RawMessage, *serviceerror.ServiceError) {
+	if drs.layoutMgtService == nil {
+		drs.logger.Error("Layout management service is not available")
+		return nil, &serviceerror.InternalServerError
+	}
+
+	layoutConfig, svcErr := drs.layoutMgtService.GetLayout(layoutID)
+	if svcErr != nil {
+		if svcErr.Code == layoutmgt.ErrorLayoutNotFound.Code {
+			drs.logger.Error("Data integrity issue: design references non-existent layout",
+				log.String("type", string(resolveType)),
+				log.String("id", id),
+				log.String("layoutId", layoutID))
+			return nil, &serviceerror.InternalServerError
+		}
+		return nil, svcErr
+	}
+	return layoutConfig.Layout, nil
+}
+
+// mergeConfig merges a design configuration over the configuration resolved from the less specific levels.
+func (drs *designResolveService) mergeConfig(
+	base, override json.RawMessage, configID string,
+) (json.RawMessage, *serviceerror.ServiceError) {
+	merged, err := mergeDesignConfig(base, override)
+	if err != nil {
+		drs.logger.Error("Failed to merge design configuration", log.String("configId", configID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return merged, nil
+}
+
+// getApplication retrieves the application to resolve the design for.
+func (drs *designResolveService) getApplication(
+	ctx context.Context, id string,
+) (*appmodel.Application, *serviceerror.ServiceError) {
 	if drs.applicationService == nil {
 		drs.logger.Error("Application service is not available")
 		return nil, &serviceerror.InternalServerError
@@ -97,61 +233,60 @@ func (drs *designResolveService) ResolveDesign(
 		}
 		return nil, svcErr
 	}
+	return app, nil
+}
 
-	// Check if the application has theme or layout configured
-	if app.ThemeID == "" && app.LayoutID == "" {
-		return nil, &common.ErrorApplicationHasNoDesign
+// collectOUDesignIDs appends the theme and layout IDs of the organization unit and its parents, ordered from the
+// closest organization unit to the root. The organization unit must exist when the design is resolved for it;
+// a missing organization unit of an application ends the lookup.
+func (drs *designResolveService) collectOUDesignIDs(
+	ctx context.Context, resolveType common.DesignResolveType, ouID string, themeIDs, layoutIDs []string,
+) ([]string, []string, *serviceerror.ServiceError) {
+	if ouID == "" {
+		return themeIDs, layoutIDs, nil
+	}
+	if drs.ouService == nil {
+		drs.logger.Error("Organization unit service is not available")
+		return nil, nil, &serviceerror.InternalServerError
 	}
 
-	designResponse := &common.DesignResponse{}
-
-	// Get theme configuration if available
-	if app.ThemeID != "" {
-		if drs.themeMgtService == nil {
-			drs.logger.Error("Theme management service is not available")
-			return nil, &serviceerror.InternalServerError
-		}
+	// The design is resolved for unauthenticated sign-in pages, so the organization units are read as an
+	// internal caller.
+	runtimeCtx := security.WithRuntimeContext(ctx)
+	visited := make(map[string]bool)
+	for currentID := ouID; currentID != "" && !visited[currentID]; {
+		visited[currentID] = true
 
-		themeConfig, svcErr := drs.themeMgtService.GetTheme(app.ThemeID)
+		orgUnit, svcErr := drs.ouService.GetOrganizationUnit(runtimeCtx, currentID)
 		if svcErr != nil {
-			if svcErr.Code == thememgt.ErrorThemeNotFound.Code {
-				drs.logger.Error("Data integrity issue: application references non-existent theme",
-					log.String("applicationId", id),
-					log.String("themeId", app.ThemeID))
-				return nil, &serviceerror.InternalServerError
+			if svcErr.Code != ou.ErrorOrganizationUnitNotFound.Code {
+				return nil, nil, svcErr
 			}
-			return nil, svcErr
+			if resolveType == common.DesignResolveTypeOU && currentID == ouID {
+				return nil, nil, &common.ErrorOrganizationUnitNotFound
+			}
+			drs.logger.Warn("Organization unit referenced in the design hierarchy does not exist",
+				log.String("ouId", currentID))
+			break
 		}
 
-		designResponse.Theme = themeConfig.Theme
-	}
-
-	// Get layout configuration if available
-	if app.LayoutID != "" {
-		if drs.layoutMgtService == nil {
-			drs.logger.Error("Layout management service is not available")
-			return nil, &serviceerror.InternalServerError
-		}
+		themeIDs = appendDesignID(themeIDs, orgUnit.ThemeID)
+		layoutIDs = appendDesignID(layoutIDs, orgUnit.LayoutID)
 
-		layoutConfig, svcErr := drs.layoutMgtService.GetLayout(app.LayoutID)
-		if svcErr != nil {
-			if svcErr.Code == layoutmgt.ErrorLayoutNotFound.Code {
-				drs.logger.Error("Data integrity issue: application references non-existent layout",
-					log.String("applicationId", id),
-					log.String("layoutId", app.LayoutID))
-				return nil, &serviceerror.InternalServerError
-			}
-			return nil, svcErr
+		currentID = ""
+		if orgUnit.Parent != nil {
+			currentID = *orgUnit.Parent
 		}
-
-		designResponse.Layout = layoutConfig.Layout
 	}
 
-	drs.logger.Debug("Successfully resolved design configuration",
-		log.String("type", string(resolveType)),
-		log.String("id", id),
-		log.String("themeId", app.ThemeID),
-		log.String("layoutId", app.LayoutID))
+	return themeIDs, layoutIDs, nil
+}
 
-	return designResponse, nil
+// appendDesignID appends a theme or layout ID unless it is empty or already present, since merging the same
+// configuration again would not change the result.
+func appendDesignID(ids []string, id string) []string {
+	if id == "" || slices.Contains(ids, id) {
+		return ids
+	}
+	return append(ids, id)
 }
@@ -35,10 +35,13 @@ import (
 	"github.com/thunder-id/thunderid/internal/design/common"
 	layoutmgt "github.com/thunder-id/thunderid/internal/design/layout/mgt"
 	thememgt "github.com/thunder-id/thunderid/internal/design/theme/mgt"
+	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/tests/mocks/applicationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/design/layoutmock"
 	"github.com/thunder-id/thunderid/tests/mocks/design/thememock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 )
 
 // Test Suite
@@ -47,6 +50,7 @@ type ResolveServiceTestSuite struct {
 	mockThemeService  *thememock.ThemeMgtServiceInterfaceMock
 	mockLayoutService *layoutmock.LayoutMgtServiceInterfaceMock
 	mockAppService    *applicationmock.ApplicationServiceInterfaceMock
+	mockOUService     *oumock.OrganizationUnitServiceInterfaceMock
 	service           DesignResolveServiceInterface
 }
 
@@ -58,7 +62,9 @@ func (suite *ResolveServiceTestSuite) SetupTest() {
 	suite.mockThemeService = thememock.NewThemeMgtServiceInterfaceMock(suite.T())
 	suite.mockLayoutService = layoutmock.NewLayoutMgtServiceInterfaceMock(suite.T())
 	suite.mockAppService = applicationmock.NewApplicationServiceInterfaceMock(suite.T())
-	suite.service = newDesignResolveService(suite.mockThemeService, suite.mockLayoutService, suite.mockAppService)
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.service = newDesignResolveService(suite.mockThemeService, suite.mockLayoutService, suite.mockAppService,
+		suite.mockOUService, "", "")
 }
 
 // Test ResolveDesign - Empty resolve type
@@ -79,19 +85,20 @@ func (suite *ResolveServiceTestSuite) TestResolveDesign_EmptyID() {
 	assert.Equal(suite.T(), common.ErrorMissingResolveID.Code, err.Code)
 }
 
-// Test ResolveDesign - Unsupported resolve type
-func (suite *ResolveServiceTestSuite) TestResolveDesign_UnsupportedType() {
-	result, err := suite.service.ResolveDesign(context.Background(), common.DesignResolveTypeOU,
+// Test ResolveDesign - Invalid resolve type
+func (suite *ResolveServiceTestSuite) TestResolveDesign_InvalidType() {
+	result, err := suite.service.ResolveDesign(context.Background(), "USER",
 		"00000000-0000-0000-0000-000000000002")
 
 	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), err)
-	assert.Equal(suite.T(), common.ErrorUnsupportedResolveType.Code, err.Code)
+	assert.Equal(suite.T(), common.ErrorInvalidResolveType.Code, err.Code)
 }
 
 // Test ResolveDesign - Nil application service
 func (suite *ResolveServiceTestSuite) TestResolveDesign_NilApplicationService() {
-	service := newDesignResolveService(suite.mockThemeService, suite.mockLayoutService, nil)
+	service := newDesignResolveService(suite.mockThemeService, suite.mockLayoutService, nil,
+		suite.mockOUService, "", "")
 
 	result, err := service.ResolveDesign(context.Background(), common.DesignResolveTypeAPP,
 		"00000000-0000-0000-0000-000000000001")
@@ -301,7 +308,8 @@ func (suite *ResolveServiceTestSuite) TestResolveDesign_ThemeServiceError() {
 
 // Test ResolveDesign - Nil theme service
 func (suite *ResolveServiceTestSuite) TestResolveDesign_NilThemeService() {
-	service := newDesignResolveService(nil, suite.mockLayoutService, suite.mockAppService)
+	service := newDesignResolveService(nil, suite.mockLayoutService, suite.mockAppService,
+		suite.mockOUService, "", "")
 	app := &appmodel.Application{
 		ID:   "00000000-0000-0000-0000-000000000001",
 		Name: "Test App",
@@ -369,7 +377,8 @@ func (suite *ResolveServiceTestSuite) TestResolveDesign_LayoutServiceError() {
 
 // Test ResolveDesign - Nil layout service
 func (suite *ResolveServiceTestSuite) TestResolveDesign_NilLayoutService() {
-	service := newDesignResolveService(suite.mockThemeService, nil, suite.mockAppService)
+	service := newDesignResolveService(suite.mockThemeService, nil, suite.mockAppService,
+		suite.mockOUService, "", "")
 	app := &appmodel.Application{
 		ID:   "00000000-0000-0000-0000-000000000001",
 		Name: "Test App",
@@ -387,3 +396,211 @@ func (suite *ResolveServiceTestSuite) TestResolveDesign_NilLayoutService() {
 	assert.NotNil(suite.T(), err)
 	assert.Equal(suite.T(), serviceerror.InternalServerError.Code, err.Code)
 }
+
+// Test ResolveDesign - Organization unit design inherited from the parent organization unit
+func (suite *ResolveServiceTestSuite) TestResolveDesign_OUInheritsFromParent() {
+	parentID := "ou-parent"
+	isRuntimeContext := mock.MatchedBy(func(ctx context.Context) bool { return security.IsRuntimeContext(ctx) })
+	suite.mockOUService.On("GetOrganizationUnit", isRuntimeContext, "ou-child").
+		Return(ou.OrganizationUnit{ID: "ou-child", Parent: &parentID, ThemeID: "theme-child"}, nil)
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, parentID).
+		Return(ou.OrganizationUnit{ID: parentID, ThemeID: "theme-parent", LayoutID: "layout-parent"}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-child").
+		Return(&thememgt.Theme{ID: "theme-child", Theme: json.RawMessage(`{"child": true}`)}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-parent").
+		Return(&thememgt.Theme{ID: "theme-parent", Theme: json.RawMessage(`{"parent": true}`)}, nil)
+	suite.mockLayoutService.On("GetLayout", "layout-parent").
+		Return(&layoutmgt.Layout{ID: "layout-parent", Layout: json.RawMessage(`{"parent": true}`)}, nil)
+
+	result, err := suite.service.ResolveDesign(context.Background(), common.DesignResolveTypeOU, "ou-child")
+
+	assert.Nil(suite.T(), err)
+	assert.JSONEq(suite.T(), `{"parent": true, "child": true}`, string(result.Theme))
+	assert.JSONEq(suite.T(), `{"parent": true}`, string(result.Layout))
+}
+
+// Test ResolveDesign - Organization unit design falls back to the configured defaults
+func (suite *ResolveServiceTestSuite) TestResolveDesign_OUFallsBackToDefaults() {
+	service := newDesignResolveService(suite.mockThemeService, suite.mockLayoutService, suite.mockAppService,
+		suite.mockOUService, "theme-default", "layout-default")
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-1").
+		Return(ou.OrganizationUnit{ID: "ou-1", LayoutID: "layout-ou"}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-default").
+		Return(&thememgt.Theme{ID: "theme-default", Theme: json.RawMessage(`{"default": true}`)}, nil)
+	suite.mockLayoutService.On("GetLayout", "layout-ou").
+		Return(&layoutmgt.Layout{ID: "layout-ou", Layout: json.RawMessage(`{"ou": true}`)}, nil)
+	suite.mockLayoutService.On("GetLayout", "layout-default").
+		Return(&layoutmgt.Layout{ID: "layout-default", Layout: json.RawMessage(`{"ou": false, "default": true}`)}, nil)
+
+	result, err := service.ResolveDesign(context.Background(), common.DesignResolveTypeOU, "ou-1")
+
+	assert.Nil(suite.T(), err)
+	assert.JSONEq(suite.T(), `{"default": true}`, string(result.Theme))
+	assert.JSONEq(suite.T(), `{"ou": true, "default": true}`, string(result.Layout))
+}
+
+// Test ResolveDesign - Organization unit not found
+func (suite *ResolveServiceTestSuite) TestResolveDesign_OUNotFound() {
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-missing").
+		Return(ou.OrganizationUnit{}, &ou.ErrorOrganizationUnitNotFound)
+
+	result, err := suite.service.ResolveDesign(context.Background(), common.DesignResolveTypeOU, "ou-missing")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), common.ErrorOrganizationUnitNotFound.Code, err.Code)
+}
+
+// Test ResolveDesign - Organization unit without design
+func (suite *ResolveServiceTestSuite) TestResolveDesign_OUWithoutDesign() {
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-1").
+		Return(ou.OrganizationUnit{ID: "ou-1"}, nil)
+
+	result, err := suite.service.ResolveDesign(context.Background(), common.DesignResolveTypeOU, "ou-1")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), common.ErrorOrganizationUnitHasNoDesign.Code, err.Code)
+}
+
+// Test ResolveDesign - Organization unit service error propagation
+func (suite *ResolveServiceTestSuite) TestResolveDesign_OUServiceError() {
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-1").
+		Return(ou.OrganizationUnit{}, &serviceerror.InternalServerError)
+
+	result, err := suite.service.ResolveDesign(context.Background(), common.DesignResolveTypeOU, "ou-1")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), serviceerror.InternalServerError.Code, err.Code)
+}
+
+// Test ResolveDesign - Nil organization unit service
+func (suite *ResolveServiceTestSuite) TestResolveDesign_NilOUService() {
+	service := newDesignResolveService(suite.mockThemeService, suite.mockLayoutService, suite.mockAppService,
+		nil, "", "")
+
+	result, err := service.ResolveDesign(context.Background(), common.DesignResolveTypeOU, "ou-1")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), serviceerror.InternalServerError.Code, err.Code)
+}
+
+// Test ResolveDesign - Application design inherited from its organization unit
+func (suite *ResolveServiceTestSuite) TestResolveDesign_AppInheritsFromOU() {
+	app := &appmodel.Application{
+		ID:                 "00000000-0000-0000-0000-000000000001",
+		OUID:               "ou-1",
+		InboundAuthProfile: inboundmodel.InboundAuthProfile{ThemeID: "theme-app"},
+	}
+	suite.mockAppService.On("GetApplication", mock.Anything, app.ID).Return(app, nil)
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-1").
+		Return(ou.OrganizationUnit{ID: "ou-1", ThemeID: "theme-ou", LayoutID: "layout-ou"}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-app").
+		Return(&thememgt.Theme{ID: "theme-app", Theme: json.RawMessage(`{"app": true}`)}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-ou").
+		Return(&thememgt.Theme{ID: "theme-ou", Theme: json.RawMessage(`{"app": false, "ou": true}`)}, nil)
+	suite.mockLayoutService.On("GetLayout", "layout-ou").
+		Return(&layoutmgt.Layout{ID: "layout-ou", Layout: json.RawMessage(`{"ou": true}`)}, nil)
+
+	result, err := suite.service.ResolveDesign(context.Background(), common.DesignResolveTypeAPP, app.ID)
+
+	assert.Nil(suite.T(), err)
+	assert.JSONEq(suite.T(), `{"app": true, "ou": true}`, string(result.Theme))
+	assert.JSONEq(suite.T(), `{"ou": true}`, string(result.Layout))
+}
+
+// Test ResolveDesign - Missing organization unit of an application falls back to the configured defaults
+func (suite *ResolveServiceTestSuite) TestResolveDesign_AppWithMissingOUUsesDefaults() {
+	service := newDesignResolveService(suite.mockThemeService, suite.mockLayoutService, suite.mockAppService,
+		suite.mockOUService, "theme-default", "")
+	app := &appmodel.Application{ID: "00000000-0000-0000-0000-000000000001", OUID: "ou-missing"}
+	suite.mockAppService.On("GetApplication", mock.Anything, app.ID).Return(app, nil)
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-missing").
+		Return(ou.OrganizationUnit{}, &ou.ErrorOrganizationUnitNotFound)
+	suite.mockThemeService.On("GetTheme", "theme-default").
+		Return(&thememgt.Theme{ID: "theme-default", Theme: json.RawMessage(`{"default": true}`)}, nil)
+
+	result, err := service.ResolveDesign(context.Background(), common.DesignResolveTypeAPP, app.ID)
+
+	assert.Nil(suite.T(), err)
+	assert.JSONEq(suite.T(), `{"default": true}`, string(result.Theme))
+	assert.Nil(suite.T(), result.Layout)
+}
+
+// Test ResolveDesign - Cyclic organization unit hierarchy is only visited once
+func (suite *ResolveServiceTestSuite) TestResolveDesign_OUCycle() {
+	parentID := "ou-2"
+	childID := "ou-1"
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, childID).
+		Return(ou.OrganizationUnit{ID: childID, Parent: &parentID}, nil).Once()
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, parentID).
+		Return(ou.OrganizationUnit{ID: parentID, Parent: &childID}, nil).Once()
+
+	result, err := suite.service.ResolveDesign(context.Background(), common.DesignResolveTypeOU, childID)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), common.ErrorOrganizationUnitHasNoDesign.Code, err.Code)
+}
+
+// Test ResolveDesign - Color schemes are merged per variant across the default, organization unit and application
+func (suite *ResolveServiceTestSuite) TestResolveDesign_MergesColorSchemesAcrossLevels() {
+	service := newDesignResolveService(suite.mockThemeService, suite.mockLayoutService, suite.mockAppService,
+		suite.mockOUService, "theme-default", "")
+	parentID := "ou-parent"
+	app := &appmodel.Application{
+		ID:                 "00000000-0000-0000-0000-000000000001",
+		OUID:               "ou-child",
+		InboundAuthProfile: inboundmodel.InboundAuthProfile{ThemeID: "theme-app"},
+	}
+	suite.mockAppService.On("GetApplication", mock.Anything, app.ID).Return(app, nil)
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-child").
+		Return(ou.OrganizationUnit{ID: "ou-child", Parent: &parentID}, nil)
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, parentID).
+		Return(ou.OrganizationUnit{ID: parentID, ThemeID: "theme-ou"}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-default").
+		Return(&thememgt.Theme{ID: "theme-default", Theme: json.RawMessage(`{
+			"defaultColorScheme": "light",
+			"shape": {"borderRadius": 8},
+			"colorSchemes": {
+				"light": {"palette": {"primary": {"main": "#111111"}, "background": {"default": "#ffffff"}}},
+				"dark": {"palette": {"primary": {"main": "#222222"}, "background": {"default": "#000000"}}}
+			}
+		}`)}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-ou").
+		Return(&thememgt.Theme{ID: "theme-ou", Theme: json.RawMessage(`{
+			"colorSchemes": {"dark": {"palette": {"primary": {"main": "#333333"}}}}
+		}`)}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-app").
+		Return(&thememgt.Theme{ID: "theme-app", Theme: json.RawMessage(`{
+			"defaultColorScheme": "dark",
+			"shape": null,
+			"colorSchemes": {"light": {"palette": {"primary": {"main": "#444444"}}}}
+		}`)}, nil)
+
+	result, err := service.ResolveDesign(context.Background(), common.DesignResolveTypeAPP, app.ID)
+
+	assert.Nil(suite.T(), err)
+	assert.JSONEq(suite.T(), `{
+		"defaultColorScheme": "dark",
+		"colorSchemes": {
+			"light": {"palette": {"primary": {"main": "#444444"}, "background": {"default": "#ffffff"}}},
+			"dark": {"palette": {"primary": {"main": "#333333"}, "background": {"default": "#000000"}}}
+		}
+	}`, string(result.Theme))
+	assert.Nil(suite.T(), result.Layout)
+}
+
+// Test ResolveDesign - Invalid configuration in a level fails the merge
+func (suite *ResolveServiceTestSuite) TestResolveDesign_MergeInvalidConfig() {
+	service := newDesignResolveService(suite.mockThemeService, suite.mockLayoutService, suite.mockAppService,
+		suite.mockOUService, "theme-default", "")
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-1").
+		Return(ou.OrganizationUnit{ID: "ou-1", ThemeID: "theme-ou"}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-default").
+		Return(&thememgt.Theme{ID: "theme-default", Theme: json.RawMessage(`{"default": true}`)}, nil)
+	suite.mockThemeService.On("GetTheme", "theme-ou").
+		Return(&thememgt.Theme{ID: "theme-ou", Theme: json.RawMessage(`{invalid`)}, nil)
+
+	result, err := service.ResolveDesign(context.Background(), common.DesignResolveTypeOU, "ou-1")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), serviceerror.InternalServerError.Code, err.Code)
+}
@@ -37,6 +37,9 @@ var (
 	// ErrInvalidCredential is returned when a credential value is invalid.
 	ErrInvalidCredential = errors.New("invalid credential")
 
+	// ErrCredentialNotFound is returned when a credential with the given ID does not exist.
+	ErrCredentialNotFound = errors.New("credential not found")
+
 	// ErrAmbiguousEntity is returned when multiple entities match the provided filters.
 	ErrAmbiguousEntity = errors.New("ambiguous entity")
 
@@ -45,6 +45,18 @@ func Initialize(
 	return svc, nil
 }
 
+// InitializeInMemory creates an entity service backed by a private in-memory store.
+// Entities created through the returned service are never persisted and are not visible to
+// the main entity service. It is used to simulate user stores, e.g. for flow sandbox sessions.
+func InitializeInMemory(
+	hashService hash.HashServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) EntityServiceInterface {
+	return newEntityService(newEntityMemoryStore(), hashService, entityTypeService, ouService,
+		transaction.NewNoOpTransactioner())
+}
+
 // initializeStore always creates a composite store (DB + in-memory file store).
 func initializeStore(cacheManager cache.CacheManagerInterface) (
 	entityStoreInterface, transaction.Transactioner, error) {
@@ -0,0 +1,345 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// entityMemoryStore implements entityStoreInterface on top of a private, mutable in-memory map.
+// Unlike the file-based store it supports updates and deletes, and it never shares data with
+// the declarative resource store. It backs isolated entity services such as the flow sandbox.
+type entityMemoryStore struct {
+	mu      sync.RWMutex
+	entries map[string]*entityStoreEntry
+	order   []string
+}
+
+// newEntityMemoryStore creates a new, empty in-memory entity store.
+func newEntityMemoryStore() *entityMemoryStore {
+	return &entityMemoryStore{
+		entries: make(map[string]*entityStoreEntry),
+		order:   make([]string, 0),
+	}
+}
+
+// CreateEntity stores a new entity with its credentials.
+func (m *entityMemoryStore) CreateEntity(ctx context.Context, entity Entity,
+	credentials json.RawMessage, systemCredentials json.RawMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.entries[entity.ID]; exists {
+		return errors.New("entity already exists")
+	}
+	m.entries[entity.ID] = &entityStoreEntry{
+		Entity:            entity,
+		Credentials:       credentials,
+		SystemCredentials: systemCredentials,
+	}
+	m.order = append(m.order, entity.ID)
+	return nil
+}
+
+// GetEntity retrieves an entity by ID.
+func (m *entityMemoryStore) GetEntity(ctx context.Context, id string) (Entity, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[id]
+	if !ok {
+		return Entity{}, ErrEntityNotFound
+	}
+	return entry.Entity, nil
+}
+
+// GetEntityWithCredentials retrieves an entity together with its stored credentials.
+func (m *entityMemoryStore) GetEntityWithCredentials(ctx context.Context, id string) (
+	*entityWithCredentials, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[id]
+	if !ok {
+		return nil, ErrEntityNotFound
+	}
+	entity := entry.Entity
+	return &entityWithCredentials{
+		Entity:            &entity,
+		SchemaCredentials: entry.Credentials,
+		SystemCredentials: entry.SystemCredentials,
+	}, nil
+}
+
+// UpdateEntity replaces the core fields of an existing entity.
+func (m *entityMemoryStore) UpdateEntity(ctx context.Context, entity *Entity) error {
+	return m.update(entity.ID, func(entry *entityStoreEntry) {
+		entry.Entity.OUID = entity.OUID
+		entry.Entity.Type = entity.Type
+		entry.Entity.State = entity.State
+		entry.Entity.Attributes = entity.Attributes
+		if len(entity.SystemAttributes) > 0 {
+			entry.Entity.SystemAttributes = entity.SystemAttributes
+		}
+	})
+}
+
+// UpdateAttributes replaces the schema-defined attributes of an entity.
+func (m *entityMemoryStore) UpdateAttributes(
+	ctx context.Context, entityID string, attributes json.RawMessage) error {
+	return m.update(entityID, func(entry *entityStoreEntry) {
+		entry.Entity.Attributes = attributes
+	})
+}
+
+// UpdateSystemAttributes replaces the system-managed attributes of an entity.
+func (m *entityMemoryStore) UpdateSystemAttributes(ctx context.Context, entityID string,
+	attrs json.RawMessage) error {
+	return m.update(entityID, func(entry *entityStoreEntry) {
+		entry.Entity.SystemAttributes = attrs
+	})
+}
+
+// UpdateCredentials replaces the schema-defined credentials of an entity.
+func (m *entityMemoryStore) UpdateCredentials(ctx context.Context, entityID string,
+	creds json.RawMessage) error {
+	return m.update(entityID, func(entry *entityStoreEntry) {
+		entry.Credentials = creds
+	})
+}
+
+// UpdateSystemCredentials replaces the system-managed credentials of an entity.
+func (m *entityMemoryStore) UpdateSystemCredentials(ctx context.Context, entityID string,
+	creds json.RawMessage) error {
+	return m.update(entityID, func(entry *entityStoreEntry) {
+		entry.SystemCredentials = creds
+	})
+}
+
+// DeleteEntity removes an entity by ID.
+func (m *entityMemoryStore) DeleteEntity(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.entries[id]; !ok {
+		return ErrEntityNotFound
+	}
+	delete(m.entries, id)
+	for i, existing := range m.order {
+		if existing == id {
+			m.order = append(m.order[:i], m.order[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// IdentifyEntity identifies an entity with the given filters by linear search.
+func (m *entityMemoryStore) IdentifyEntity(ctx context.Context,
+	filters map[string]interface{}) (*string, error) {
+	matched := m.filterEntities(func(e Entity) bool {
+		return matchesFilters(mergeJSONObjects(e.Attributes, e.SystemAttributes), filters)
+	})
+
+	if len(matched) == 0 {
+		return nil, ErrEntityNotFound
+	}
+	if len(matched) > 1 {
+		return nil, ErrAmbiguousEntity
+	}
+	return &matched[0].ID, nil
+}
+
+// SearchEntities searches for all entities matching the provided filters.
+func (m *entityMemoryStore) SearchEntities(ctx context.Context,
+	filters map[string]interface{}) ([]Entity, error) {
+	matched := m.filterEntities(func(e Entity) bool {
+		return matchesFilters(mergeJSONObjects(e.Attributes, e.SystemAttributes), filters)
+	})
+
+	if len(matched) == 0 {
+		return nil, ErrEntityNotFound
+	}
+	return matched, nil
+}
+
+// GetEntityListCount retrieves the total count of entities in a category.
+func (m *entityMemoryStore) GetEntityListCount(ctx context.Context, category string,
+	filters map[string]interface{}) (int, error) {
+	return len(m.filterEntities(categoryMatcher(category, nil, filters))), nil
+}
+
+// GetEntityList retrieves entities in a category with pagination and filtering.
+func (m *entityMemoryStore) GetEntityList(ctx context.Context, category string,
+	limit, offset int, filters map[string]interface{}) ([]Entity, error) {
+	return applyPagination(m.filterEntities(categoryMatcher(category, nil, filters)), limit, offset), nil
+}
+
+// GetEntityListCountByOUIDs retrieves the total count of entities in a category scoped to OU IDs.
+func (m *entityMemoryStore) GetEntityListCountByOUIDs(ctx context.Context, category string,
+	ouIDs []string, filters map[string]interface{}) (int, error) {
+	return len(m.filterEntities(categoryMatcher(category, ouIDs, filters))), nil
+}
+
+// GetEntityListByOUIDs retrieves entities in a category scoped to OU IDs with pagination and filtering.
+func (m *entityMemoryStore) GetEntityListByOUIDs(ctx context.Context, category string,
+	ouIDs []string, limit, offset int, filters map[string]interface{}) ([]Entity, error) {
+	return applyPagination(m.filterEntities(categoryMatcher(category, ouIDs, filters)), limit, offset), nil
+}
+
+// ValidateEntityIDs returns the IDs that do not exist in the store.
+func (m *entityMemoryStore) ValidateEntityIDs(ctx context.Context, entityIDs []string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	invalid := make([]string, 0)
+	for _, id := range entityIDs {
+		if _, ok := m.entries[id]; !ok {
+			invalid = append(invalid, id)
+		}
+	}
+	return invalid, nil
+}
+
+// GetEntitiesByIDs retrieves the entities that exist for the given IDs.
+func (m *entityMemoryStore) GetEntitiesByIDs(ctx context.Context, entityIDs []string) ([]Entity, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entities := make([]Entity, 0, len(entityIDs))
+	for _, id := range entityIDs {
+		if entry, ok := m.entries[id]; ok {
+			entities = append(entities, entry.Entity)
+		}
+	}
+	return entities, nil
+}
+
+// ValidateEntityIDsInOUs returns the IDs that do not exist or fall outside the given OU scope.
+func (m *entityMemoryStore) ValidateEntityIDsInOUs(
+	ctx context.Context, entityIDs []string, ouIDs []string,
+) ([]string, error) {
+	if len(entityIDs) == 0 {
+		return []string{}, nil
+	}
+	if len(ouIDs) == 0 {
+		return append([]string{}, entityIDs...), nil
+	}
+
+	ouSet := make(map[string]bool, len(ouIDs))
+	for _, ou := range ouIDs {
+		ouSet[ou] = true
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	outOfScope := make([]string, 0)
+	for _, id := range entityIDs {
+		entry, ok := m.entries[id]
+		if !ok || !ouSet[entry.Entity.OUID] {
+			outOfScope = append(outOfScope, id)
+		}
+	}
+	return outOfScope, nil
+}
+
+// GetGroupCountForEntity returns 0 as the in-memory store does not track group memberships.
+func (m *entityMemoryStore) GetGroupCountForEntity(ctx context.Context, entityID string) (int, error) {
+	return 0, nil
+}
+
+// GetEntityGroups returns empty as the in-memory store does not track group memberships.
+func (m *entityMemoryStore) GetEntityGroups(ctx context.Context, entityID string,
+	limit, offset int) ([]EntityGroup, error) {
+	return []EntityGroup{}, nil
+}
+
+// GetTransitiveEntityGroups returns empty as the in-memory store does not track group memberships.
+func (m *entityMemoryStore) GetTransitiveEntityGroups(ctx context.Context, entityID string) ([]EntityGroup, error) {
+	return []EntityGroup{}, nil
+}
+
+// IsEntityDeclarative returns false as entities in the in-memory store are always mutable.
+func (m *entityMemoryStore) IsEntityDeclarative(ctx context.Context, id string) (bool, error) {
+	return false, nil
+}
+
+// GetIndexedAttributes returns nil as the in-memory store filters by linear search.
+func (m *entityMemoryStore) GetIndexedAttributes() map[string]bool {
+	return nil
+}
+
+// LoadIndexedAttributes is a no-op for the in-memory store.
+func (m *entityMemoryStore) LoadIndexedAttributes(_ []string) error {
+	return nil
+}
+
+// update applies the given mutation to an existing entry under the write lock.
+func (m *entityMemoryStore) update(entityID string, mutate func(entry *entityStoreEntry)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[entityID]
+	if !ok {
+		return ErrEntityNotFound
+	}
+	mutate(entry)
+	return nil
+}
+
+// filterEntities returns the entities accepted by the matcher in insertion order.
+func (m *entityMemoryStore) filterEntities(matcher func(e Entity) bool) []Entity {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entities := make([]Entity, 0)
+	for _, id := range m.order {
+		if entity := m.entries[id].Entity; matcher(entity) {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+// categoryMatcher builds a matcher for list queries scoped to a category and, optionally, to OU IDs.
+func categoryMatcher(category string, ouIDs []string, filters map[string]interface{}) func(e Entity) bool {
+	var ouIDSet map[string]struct{}
+	if ouIDs != nil {
+		ouIDSet = make(map[string]struct{}, len(ouIDs))
+		for _, id := range ouIDs {
+			ouIDSet[id] = struct{}{}
+		}
+	}
+
+	return func(e Entity) bool {
+		if string(e.Category) != category {
+			return false
+		}
+		if ouIDSet != nil {
+			if _, ok := ouIDSet[e.OUID]; !ok {
+				return false
+			}
+		}
+		return matchesFilters(mergeJSONObjects(e.Attributes, e.SystemAttributes), filters)
+	}
+}
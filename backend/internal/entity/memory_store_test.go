@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MemoryStoreTestSuite struct {
+	suite.Suite
+	store *entityMemoryStore
+	ctx   context.Context
+}
+
+func TestMemoryStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(MemoryStoreTestSuite))
+}
+
+func (s *MemoryStoreTestSuite) SetupTest() {
+	s.store = newEntityMemoryStore()
+	s.ctx = context.Background()
+}
+
+func (s *MemoryStoreTestSuite) TestCreateAndGetEntity() {
+	e := makeTestEntity("m1", "user", "ou1")
+	creds := json.RawMessage(`{"password":"hashed"}`)
+	s.Require().NoError(s.store.CreateEntity(s.ctx, e, creds, nil))
+
+	got, err := s.store.GetEntity(s.ctx, "m1")
+	s.NoError(err)
+	s.Equal(e.ID, got.ID)
+
+	withCreds, err := s.store.GetEntityWithCredentials(s.ctx, "m1")
+	s.NoError(err)
+	s.Equal(string(creds), string(withCreds.SchemaCredentials))
+}
+
+func (s *MemoryStoreTestSuite) TestCreateEntity_Duplicate() {
+	e := makeTestEntity("m1", "user", "ou1")
+	s.Require().NoError(s.store.CreateEntity(s.ctx, e, nil, nil))
+	s.Error(s.store.CreateEntity(s.ctx, e, nil, nil))
+}
+
+func (s *MemoryStoreTestSuite) TestGetEntity_NotFound() {
+	_, err := s.store.GetEntity(s.ctx, "missing")
+	s.ErrorIs(err, ErrEntityNotFound)
+
+	_, err = s.store.GetEntityWithCredentials(s.ctx, "missing")
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *MemoryStoreTestSuite) TestMutations() {
+	e := makeTestEntity("m2", "user", "ou1")
+	s.Require().NoError(s.store.CreateEntity(s.ctx, e, nil, nil))
+
+	updated := e
+	updated.OUID = "ou2"
+	updated.Attributes = json.RawMessage(`{"username":"renamed"}`)
+	s.NoError(s.store.UpdateEntity(s.ctx, &updated))
+	s.NoError(s.store.UpdateSystemAttributes(s.ctx, "m2", json.RawMessage(`{"clientId":"c1"}`)))
+	s.NoError(s.store.UpdateCredentials(s.ctx, "m2", json.RawMessage(`{"password":"new"}`)))
+	s.NoError(s.store.UpdateSystemCredentials(s.ctx, "m2", json.RawMessage(`{"secret":"s"}`)))
+
+	got, err := s.store.GetEntityWithCredentials(s.ctx, "m2")
+	s.Require().NoError(err)
+	s.Equal("ou2", got.Entity.OUID)
+	s.JSONEq(`{"username":"renamed"}`, string(got.Entity.Attributes))
+	s.JSONEq(`{"clientId":"c1"}`, string(got.Entity.SystemAttributes))
+	s.JSONEq(`{"password":"new"}`, string(got.SchemaCredentials))
+	s.JSONEq(`{"secret":"s"}`, string(got.SystemCredentials))
+
+	s.NoError(s.store.UpdateAttributes(s.ctx, "m2", json.RawMessage(`{"username":"again"}`)))
+	id, err := s.store.IdentifyEntity(s.ctx, map[string]interface{}{"username": "again"})
+	s.NoError(err)
+	s.Equal("m2", *id)
+}
+
+func (s *MemoryStoreTestSuite) TestMutations_NotFound() {
+	e := makeTestEntity("missing", "user", "ou1")
+
+	s.ErrorIs(s.store.UpdateEntity(s.ctx, &e), ErrEntityNotFound)
+	s.ErrorIs(s.store.UpdateAttributes(s.ctx, "missing", nil), ErrEntityNotFound)
+	s.ErrorIs(s.store.UpdateSystemAttributes(s.ctx, "missing", nil), ErrEntityNotFound)
+	s.ErrorIs(s.store.UpdateCredentials(s.ctx, "missing", nil), ErrEntityNotFound)
+	s.ErrorIs(s.store.UpdateSystemCredentials(s.ctx, "missing", nil), ErrEntityNotFound)
+	s.ErrorIs(s.store.DeleteEntity(s.ctx, "missing"), ErrEntityNotFound)
+}
+
+func (s *MemoryStoreTestSuite) TestDeleteEntity() {
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("d1", "user", "ou1"), nil, nil))
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("d2", "user", "ou1"), nil, nil))
+
+	s.NoError(s.store.DeleteEntity(s.ctx, "d1"))
+
+	_, err := s.store.GetEntity(s.ctx, "d1")
+	s.ErrorIs(err, ErrEntityNotFound)
+	list, err := s.store.GetEntityList(s.ctx, "user", 0, 0, nil)
+	s.NoError(err)
+	s.Len(list, 1)
+	s.Equal("d2", list[0].ID)
+}
+
+func (s *MemoryStoreTestSuite) TestIdentifyAndSearch() {
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("i1", "user", "ou1"), nil, nil))
+	dup := Entity{ID: "i2", Category: EntityCategoryUser, Attributes: json.RawMessage(`{"email":"i1@test.com"}`)}
+	s.Require().NoError(s.store.CreateEntity(s.ctx, dup, nil, nil))
+
+	_, err := s.store.IdentifyEntity(s.ctx, map[string]interface{}{"email": "nobody@test.com"})
+	s.ErrorIs(err, ErrEntityNotFound)
+
+	_, err = s.store.IdentifyEntity(s.ctx, map[string]interface{}{"email": "i1@test.com"})
+	s.ErrorIs(err, ErrAmbiguousEntity)
+
+	id, err := s.store.IdentifyEntity(s.ctx, map[string]interface{}{"username": "i1-user"})
+	s.NoError(err)
+	s.Equal("i1", *id)
+
+	matched, err := s.store.SearchEntities(s.ctx, map[string]interface{}{"email": "i1@test.com"})
+	s.NoError(err)
+	s.Len(matched, 2)
+
+	_, err = s.store.SearchEntities(s.ctx, map[string]interface{}{"email": "nobody@test.com"})
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *MemoryStoreTestSuite) TestListQueries() {
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("l1", "user", "ou-A"), nil, nil))
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("l2", "user", "ou-B"), nil, nil))
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("l3", "app", "ou-A"), nil, nil))
+
+	count, err := s.store.GetEntityListCount(s.ctx, "user", nil)
+	s.NoError(err)
+	s.Equal(2, count)
+
+	page, err := s.store.GetEntityList(s.ctx, "user", 1, 1, nil)
+	s.NoError(err)
+	s.Require().Len(page, 1)
+	s.Equal("l2", page[0].ID)
+
+	count, err = s.store.GetEntityListCountByOUIDs(s.ctx, "user", []string{"ou-A"}, nil)
+	s.NoError(err)
+	s.Equal(1, count)
+
+	byOU, err := s.store.GetEntityListByOUIDs(s.ctx, "user", []string{"ou-B"}, 0, 0, nil)
+	s.NoError(err)
+	s.Require().Len(byOU, 1)
+	s.Equal("l2", byOU[0].ID)
+}
+
+func (s *MemoryStoreTestSuite) TestValidationQueries() {
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("v1", "user", "ou-A"), nil, nil))
+	s.Require().NoError(s.store.CreateEntity(s.ctx, makeTestEntity("v2", "user", "ou-B"), nil, nil))
+
+	invalid, err := s.store.ValidateEntityIDs(s.ctx, []string{"v1", "v3"})
+	s.NoError(err)
+	s.Equal([]string{"v3"}, invalid)
+
+	entities, err := s.store.GetEntitiesByIDs(s.ctx, []string{"v2", "v3"})
+	s.NoError(err)
+	s.Require().Len(entities, 1)
+	s.Equal("v2", entities[0].ID)
+
+	outOfScope, err := s.store.ValidateEntityIDsInOUs(s.ctx, []string{"v1", "v2", "v3"}, []string{"ou-A"})
+	s.NoError(err)
+	s.Equal([]string{"v2", "v3"}, outOfScope)
+
+	outOfScope, err = s.store.ValidateEntityIDsInOUs(s.ctx, []string{"v1"}, nil)
+	s.NoError(err)
+	s.Equal([]string{"v1"}, outOfScope)
+}
+
+func (s *MemoryStoreTestSuite) TestGroupsAndMetadata() {
+	count, err := s.store.GetGroupCountForEntity(s.ctx, "any")
+	s.NoError(err)
+	s.Zero(count)
+
+	groups, err := s.store.GetEntityGroups(s.ctx, "any", 10, 0)
+	s.NoError(err)
+	s.Empty(groups)
+
+	groups, err = s.store.GetTransitiveEntityGroups(s.ctx, "any")
+	s.NoError(err)
+	s.Empty(groups)
+
+	declarative, err := s.store.IsEntityDeclarative(s.ctx, "any")
+	s.NoError(err)
+	s.False(declarative)
+
+	s.Nil(s.store.GetIndexedAttributes())
+	s.NoError(s.store.LoadIndexedAttributes([]string{"email"}))
+}
@@ -20,7 +20,10 @@
 package entity
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 )
@@ -101,9 +104,27 @@ type AuthenticateResult struct {
 // StoredCredential represents a single credential entry stored in the entity's schema or
 // system credentials column.
 type StoredCredential struct {
+	ID                string              `json:"id,omitempty"`
 	StorageAlgo       hash.CredAlgorithm  `json:"storageAlgo"`
 	StorageAlgoParams hash.CredParameters `json:"storageAlgoParams"`
 	Value             string              `json:"value"`
+	CreatedAt         *time.Time          `json:"createdAt,omitempty"`
+	ExpiresAt         *time.Time          `json:"expiresAt,omitempty"`
+}
+
+// GetID returns the ID of the credential. Credentials stored without an ID are identified by a
+// fingerprint of their stored value.
+func (c StoredCredential) GetID() string {
+	if c.ID != "" {
+		return c.ID
+	}
+	sum := sha256.Sum256([]byte(c.Value))
+	return hex.EncodeToString(sum[:16])
+}
+
+// IsExpired reports whether the credential has expired at the given time.
+func (c StoredCredential) IsExpired(now time.Time) bool {
+	return c.ExpiresAt != nil && !now.Before(*c.ExpiresAt)
 }
 
 // DeclarativeLoaderConfig configures declarative resource loading for a specific entity category.
@@ -20,6 +20,7 @@ package entity
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -40,3 +41,23 @@ func (s *ModelTestSuite) TestEntityCategoryString() {
 func (s *ModelTestSuite) TestEntityStateString() {
 	s.Equal("ACTIVE", EntityStateActive.String())
 }
+
+func (s *ModelTestSuite) TestStoredCredentialGetID() {
+	s.Equal("cred-1", StoredCredential{ID: "cred-1", Value: "hash"}.GetID())
+
+	legacyID := StoredCredential{Value: "hash"}.GetID()
+	s.Len(legacyID, 32)
+	s.Equal(legacyID, StoredCredential{Value: "hash"}.GetID())
+	s.NotEqual(legacyID, StoredCredential{Value: "other"}.GetID())
+}
+
+func (s *ModelTestSuite) TestStoredCredentialIsExpired() {
+	now := time.Now()
+	past := now.Add(-time.Second)
+	future := now.Add(time.Hour)
+
+	s.False(StoredCredential{}.IsExpired(now))
+	s.True(StoredCredential{ExpiresAt: &past}.IsExpired(now))
+	s.True(StoredCredential{ExpiresAt: &now}.IsExpired(now))
+	s.False(StoredCredential{ExpiresAt: &future}.IsExpired(now))
+}
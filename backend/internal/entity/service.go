@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/ou"
@@ -51,6 +52,9 @@ type EntityServiceInterface interface {
 		plaintextUpdates json.RawMessage) error
 	UpdateSystemCredentials(ctx context.Context, entityID string,
 		plaintextUpdates json.RawMessage) error
+	AddSystemCredential(ctx context.Context, entityID, credType, value string,
+		expiresAt *time.Time) (*StoredCredential, error)
+	DeleteSystemCredential(ctx context.Context, entityID, credType, credentialID string) error
 
 	// Identification
 	IdentifyEntity(ctx context.Context, filters map[string]interface{}) (*string, error)
@@ -521,11 +525,15 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 		return ErrAuthenticationFailed
 	}
 
-	// Verify each credential against stored values.
+	// Verify each credential against stored values, skipping expired entries.
+	now := time.Now()
 	for credType, credValue := range credentialsToVerify {
 		credList := storedCreds[credType]
 		verified := false
 		for _, stored := range credList {
+			if stored.IsExpired(now) {
+				continue
+			}
 			ref := hash.Credential{
 				Algorithm: stored.StorageAlgo,
 				Hash:      stored.Value,
@@ -721,6 +729,109 @@ func (s *entityService) UpdateSystemCredentials(ctx context.Context, entityID st
 	})
 }
 
+// AddSystemCredential hashes a plaintext value and adds it to the system credentials of the given
+// type. Existing credentials of the type are preserved, so several credentials can be valid at once.
+func (s *entityService) AddSystemCredential(ctx context.Context, entityID, credType, value string,
+	expiresAt *time.Time) (*StoredCredential, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, fmt.Errorf("%w: empty value for credential type %q", ErrInvalidCredential, credType)
+	}
+
+	credHash, err := s.hashService.Generate([]byte(value))
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash credential %q: %w", credType, err)
+	}
+	credentialID, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate credential ID: %w", err)
+	}
+	createdAt := time.Now().UTC()
+	credential := StoredCredential{
+		ID:          credentialID,
+		StorageAlgo: credHash.Algorithm,
+		StorageAlgoParams: hash.CredParameters{
+			Salt:       credHash.Parameters.Salt,
+			Iterations: credHash.Parameters.Iterations,
+			KeySize:    credHash.Parameters.KeySize,
+		},
+		Value:     credHash.Hash,
+		CreatedAt: &createdAt,
+		ExpiresAt: expiresAt,
+	}
+
+	err = s.modifySystemCredentials(ctx, entityID, credType,
+		func(existing []StoredCredential) ([]StoredCredential, error) {
+			return append(existing, credential), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// DeleteSystemCredential removes the system credential with the given ID from the credentials of the
+// given type.
+func (s *entityService) DeleteSystemCredential(ctx context.Context, entityID, credType,
+	credentialID string) error {
+	return s.modifySystemCredentials(ctx, entityID, credType,
+		func(existing []StoredCredential) ([]StoredCredential, error) {
+			for i, credential := range existing {
+				if credential.GetID() == credentialID {
+					return append(existing[:i], existing[i+1:]...), nil
+				}
+			}
+			return nil, ErrCredentialNotFound
+		})
+}
+
+// modifySystemCredentials applies a modification to the system credentials of the given type within a
+// transaction. Credentials of other types are preserved, and the type is dropped when no credential remains.
+func (s *entityService) modifySystemCredentials(ctx context.Context, entityID, credType string,
+	modify func(existing []StoredCredential) ([]StoredCredential, error)) error {
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.GetEntityWithCredentials(txCtx, entityID)
+		if err != nil {
+			return err
+		}
+
+		systemCreds := make(map[string]json.RawMessage)
+		if len(existing.SystemCredentials) > 0 {
+			if err := json.Unmarshal(existing.SystemCredentials, &systemCreds); err != nil {
+				return fmt.Errorf("failed to unmarshal existing credentials: %w", err)
+			}
+		}
+
+		var credentials []StoredCredential
+		if raw, ok := systemCreds[credType]; ok {
+			if err := json.Unmarshal(raw, &credentials); err != nil {
+				return fmt.Errorf("failed to unmarshal credentials of type %q: %w", credType, err)
+			}
+		}
+
+		credentials, err = modify(credentials)
+		if err != nil {
+			return err
+		}
+
+		if len(credentials) == 0 {
+			delete(systemCreds, credType)
+		} else {
+			raw, err := json.Marshal(credentials)
+			if err != nil {
+				return fmt.Errorf("failed to marshal credentials of type %q: %w", credType, err)
+			}
+			systemCreds[credType] = raw
+		}
+
+		mergedJSON, err := json.Marshal(systemCreds)
+		if err != nil {
+			return fmt.Errorf("failed to marshal merged credentials: %w", err)
+		}
+
+		return s.store.UpdateSystemCredentials(txCtx, entityID, mergedJSON)
+	})
+}
+
 // populateOUHandles resolves OU handles for a slice of entities in-place.
 func (s *entityService) populateOUHandles(ctx context.Context, entities []Entity) {
 	if s.ouService == nil || len(entities) == 0 {
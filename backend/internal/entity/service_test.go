@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -188,6 +189,70 @@ func (s *ServiceTestSuite) TestUpdateSystemCredentials_Delegates() {
 	s.NoError(s.svc.UpdateSystemCredentials(s.ctx, "e1", creds))
 }
 
+func (s *ServiceTestSuite) TestAddSystemCredential_AppendsToExisting() {
+	e := testEntity("e1")
+	existing := json.RawMessage(`{"clientSecret":[{"value":"oldhash","storageAlgo":"PBKDF2",` +
+		`"storageAlgoParams":{}}],"other":[{"value":"x","storageAlgo":"PBKDF2","storageAlgoParams":{}}]}`)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SystemCredentials: existing}, nil)
+
+	var stored json.RawMessage
+	s.store.On("UpdateSystemCredentials", mock.Anything, e.ID, mock.Anything).
+		Run(func(args mock.Arguments) { stored = args.Get(2).(json.RawMessage) }).Return(nil)
+
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	credential, err := s.svc.AddSystemCredential(s.ctx, e.ID, "clientSecret", "new-secret", &expiresAt)
+	s.Require().NoError(err)
+	s.NotEmpty(credential.ID)
+	s.NotNil(credential.CreatedAt)
+	s.Equal(&expiresAt, credential.ExpiresAt)
+	s.Equal("testhash", credential.Value)
+
+	var storedMap map[string][]StoredCredential
+	s.Require().NoError(json.Unmarshal(stored, &storedMap))
+	s.Len(storedMap["clientSecret"], 2)
+	s.Equal("oldhash", storedMap["clientSecret"][0].Value)
+	s.Equal(credential.ID, storedMap["clientSecret"][1].ID)
+	s.Len(storedMap["other"], 1)
+}
+
+func (s *ServiceTestSuite) TestAddSystemCredential_EmptyValue() {
+	_, err := s.svc.AddSystemCredential(s.ctx, "e1", "clientSecret", " ", nil)
+	s.ErrorIs(err, ErrInvalidCredential)
+}
+
+func (s *ServiceTestSuite) TestDeleteSystemCredential_RemovesCredential() {
+	e := testEntity("e1")
+	existing := json.RawMessage(`{"clientSecret":[` +
+		`{"id":"c1","value":"hash1","storageAlgo":"PBKDF2","storageAlgoParams":{}},` +
+		`{"id":"c2","value":"hash2","storageAlgo":"PBKDF2","storageAlgoParams":{}}]}`)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SystemCredentials: existing}, nil)
+
+	var stored json.RawMessage
+	s.store.On("UpdateSystemCredentials", mock.Anything, e.ID, mock.Anything).
+		Run(func(args mock.Arguments) { stored = args.Get(2).(json.RawMessage) }).Return(nil)
+
+	s.NoError(s.svc.DeleteSystemCredential(s.ctx, e.ID, "clientSecret", "c1"))
+
+	var storedMap map[string][]StoredCredential
+	s.Require().NoError(json.Unmarshal(stored, &storedMap))
+	s.Len(storedMap["clientSecret"], 1)
+	s.Equal("c2", storedMap["clientSecret"][0].ID)
+}
+
+func (s *ServiceTestSuite) TestDeleteSystemCredential_NotFound() {
+	e := testEntity("e1")
+	existing := json.RawMessage(`{"clientSecret":[{"id":"c1","value":"hash1","storageAlgo":"PBKDF2",` +
+		`"storageAlgoParams":{}}]}`)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SystemCredentials: existing}, nil)
+
+	err := s.svc.DeleteSystemCredential(s.ctx, e.ID, "clientSecret", "missing")
+	s.ErrorIs(err, ErrCredentialNotFound)
+	s.store.AssertNotCalled(s.T(), "UpdateSystemCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (s *ServiceTestSuite) TestGetCredentialsByType_NoCredentials() {
 	e := testEntity("ecreds")
 	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
@@ -471,6 +536,25 @@ func (s *ServiceTestSuite) TestAuthenticateEntityByID_WrongCredentials() {
 	s.ErrorIs(err, ErrAuthenticationFailed)
 }
 
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_SkipsExpiredCredentials() {
+	expired := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	storedCreds := json.RawMessage(`{"clientSecret":[` +
+		`{"value":"expiredhash","storageAlgo":"PBKDF2","storageAlgoParams":{},"expiresAt":"` + expired + `"},` +
+		`{"value":"activehash","storageAlgo":"PBKDF2","storageAlgoParams":{}}]}`)
+	e := testEntity("auth-expired-1")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SystemCredentials: storedCreds}, nil)
+	s.hashService.On("Verify", []byte("secret"), mock.MatchedBy(func(ref hash.Credential) bool {
+		return ref.Hash == "activehash"
+	})).Return(false, nil)
+
+	_, err := s.svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"clientSecret": "secret"})
+	s.ErrorIs(err, ErrAuthenticationFailed)
+	s.hashService.AssertNotCalled(s.T(), "Verify", []byte("secret"), mock.MatchedBy(func(ref hash.Credential) bool {
+		return ref.Hash == "expiredhash"
+	}))
+}
+
 func (s *ServiceTestSuite) TestAuthenticateEntity_DelegatesToByID() {
 	id := "delegate-1"
 	filters := map[string]interface{}{"username": "user1"}
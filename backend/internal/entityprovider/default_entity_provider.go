@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -180,6 +181,47 @@ func (p *defaultEntityProvider) UpdateSystemCredentials(
 	return nil
 }
 
+// GetSystemCredentials returns the metadata of the system-managed credentials of the given type.
+func (p *defaultEntityProvider) GetSystemCredentials(
+	entityID string, credType string,
+) ([]CredentialMetadata, *EntityProviderError) {
+	ctx := security.WithRuntimeContext(context.Background())
+	credentials, err := p.entitySvc.GetCredentialsByType(ctx, entityID, credType)
+	if err != nil {
+		return nil, mapEntityError(err)
+	}
+
+	result := make([]CredentialMetadata, 0, len(credentials))
+	for _, credential := range credentials {
+		result = append(result, toCredentialMetadata(credential))
+	}
+	return result, nil
+}
+
+// AddSystemCredential adds a system-managed credential of the given type, keeping the existing ones.
+func (p *defaultEntityProvider) AddSystemCredential(
+	entityID string, credType string, value string, expiresAt *time.Time,
+) (*CredentialMetadata, *EntityProviderError) {
+	ctx := security.WithRuntimeContext(context.Background())
+	credential, err := p.entitySvc.AddSystemCredential(ctx, entityID, credType, value, expiresAt)
+	if err != nil {
+		return nil, mapEntityError(err)
+	}
+	metadata := toCredentialMetadata(*credential)
+	return &metadata, nil
+}
+
+// DeleteSystemCredential deletes the system-managed credential with the given ID.
+func (p *defaultEntityProvider) DeleteSystemCredential(
+	entityID string, credType string, credentialID string,
+) *EntityProviderError {
+	ctx := security.WithRuntimeContext(context.Background())
+	if err := p.entitySvc.DeleteSystemCredential(ctx, entityID, credType, credentialID); err != nil {
+		return mapEntityError(err)
+	}
+	return nil
+}
+
 // GetTransitiveEntityGroups retrieves all groups an entity belongs to, including inherited groups.
 func (p *defaultEntityProvider) GetTransitiveEntityGroups(
 	entityID string,
@@ -291,12 +333,23 @@ func toServiceEntity(e *Entity) *entity.Entity {
 	}
 }
 
+// toCredentialMetadata converts a stored credential into its metadata.
+func toCredentialMetadata(credential entity.StoredCredential) CredentialMetadata {
+	return CredentialMetadata{
+		ID:        credential.GetID(),
+		CreatedAt: credential.CreatedAt,
+		ExpiresAt: credential.ExpiresAt,
+	}
+}
+
 // mapEntityError converts an entity service error into an EntityProviderError,
 // preserving the underlying error code semantics where possible.
 func mapEntityError(err error) *EntityProviderError {
 	switch {
 	case errors.Is(err, entity.ErrEntityNotFound):
 		return NewEntityProviderError(ErrorCodeEntityNotFound, "Entity not found", err.Error())
+	case errors.Is(err, entity.ErrCredentialNotFound):
+		return NewEntityProviderError(ErrorCodeCredentialNotFound, "Credential not found", err.Error())
 	case errors.Is(err, entity.ErrAmbiguousEntity):
 		return NewEntityProviderError(ErrorCodeAmbiguousEntity, "Ambiguous entity", err.Error())
 	case errors.Is(err, entity.ErrAttributeConflict):
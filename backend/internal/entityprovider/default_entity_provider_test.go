@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -320,6 +321,61 @@ func (suite *DefaultEntityProviderTestSuite) TestUpdateSystemAttributes() {
 	suite.Equal(ErrorCodeInvalidRequestFormat, err.Code)
 }
 
+func (suite *DefaultEntityProviderTestSuite) TestGetSystemCredentials() {
+	createdAt := time.Now().UTC()
+	suite.mockService.On("GetCredentialsByType", mock.Anything, testEntityID, "clientSecret").
+		Return([]entity.StoredCredential{
+			{ID: "cred-1", Value: "hash1", CreatedAt: &createdAt},
+			{Value: "hash2"},
+		}, nil).Once()
+
+	credentials, err := suite.provider.GetSystemCredentials(testEntityID, "clientSecret")
+	suite.Nil(err)
+	suite.Len(credentials, 2)
+	suite.Equal(CredentialMetadata{ID: "cred-1", CreatedAt: &createdAt}, credentials[0])
+	suite.Equal(entity.StoredCredential{Value: "hash2"}.GetID(), credentials[1].ID)
+
+	suite.mockService.On("GetCredentialsByType", mock.Anything, testEntityID, "clientSecret").
+		Return(nil, entity.ErrEntityNotFound).Once()
+
+	credentials, err = suite.provider.GetSystemCredentials(testEntityID, "clientSecret")
+	suite.Nil(credentials)
+	suite.NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *DefaultEntityProviderTestSuite) TestAddSystemCredential() {
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	suite.mockService.On("AddSystemCredential", mock.Anything, testEntityID, "clientSecret", "secret",
+		&expiresAt).Return(&entity.StoredCredential{ID: "cred-1", Value: "hash", ExpiresAt: &expiresAt}, nil).Once()
+
+	credential, err := suite.provider.AddSystemCredential(testEntityID, "clientSecret", "secret", &expiresAt)
+	suite.Nil(err)
+	suite.Equal(&CredentialMetadata{ID: "cred-1", ExpiresAt: &expiresAt}, credential)
+
+	suite.mockService.On("AddSystemCredential", mock.Anything, testEntityID, "clientSecret", "",
+		(*time.Time)(nil)).Return(nil, entity.ErrInvalidCredential).Once()
+
+	credential, err = suite.provider.AddSystemCredential(testEntityID, "clientSecret", "", nil)
+	suite.Nil(credential)
+	suite.NotNil(err)
+	suite.Equal(ErrorCodeInvalidRequestFormat, err.Code)
+}
+
+func (suite *DefaultEntityProviderTestSuite) TestDeleteSystemCredential() {
+	suite.mockService.On("DeleteSystemCredential", mock.Anything, testEntityID, "clientSecret", "cred-1").
+		Return(nil).Once()
+
+	suite.Nil(suite.provider.DeleteSystemCredential(testEntityID, "clientSecret", "cred-1"))
+
+	suite.mockService.On("DeleteSystemCredential", mock.Anything, testEntityID, "clientSecret", "missing").
+		Return(entity.ErrCredentialNotFound).Once()
+
+	err := suite.provider.DeleteSystemCredential(testEntityID, "clientSecret", "missing")
+	suite.NotNil(err)
+	suite.Equal(ErrorCodeCredentialNotFound, err.Code)
+}
+
 func (suite *DefaultEntityProviderTestSuite) TestUpdateSystemCredentials() {
 	creds := json.RawMessage(`{"clientSecret":"secret"}`)
 
@@ -20,6 +20,7 @@ package entityprovider
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // errNotImplemented is the error returned when a method is not implemented.
@@ -86,6 +87,21 @@ func (p *disabledEntityProvider) UpdateSystemCredentials(_ string,
 	return errNotImplemented
 }
 
+func (p *disabledEntityProvider) GetSystemCredentials(_ string,
+	_ string) ([]CredentialMetadata, *EntityProviderError) {
+	return nil, errNotImplemented
+}
+
+func (p *disabledEntityProvider) AddSystemCredential(_ string, _ string, _ string,
+	_ *time.Time) (*CredentialMetadata, *EntityProviderError) {
+	return nil, errNotImplemented
+}
+
+func (p *disabledEntityProvider) DeleteSystemCredential(_ string, _ string,
+	_ string) *EntityProviderError {
+	return errNotImplemented
+}
+
 func (p *disabledEntityProvider) GetTransitiveEntityGroups(
 	_ string) ([]EntityGroup, *EntityProviderError) {
 	return nil, errNotImplemented
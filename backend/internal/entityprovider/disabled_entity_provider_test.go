@@ -82,6 +82,23 @@ func (suite *DisabledEntityProviderTestSuite) TestUpdateSystemCredentials() {
 	suite.Equal(errNotImplemented, err)
 }
 
+func (suite *DisabledEntityProviderTestSuite) TestGetSystemCredentials() {
+	credentials, err := suite.provider.GetSystemCredentials("entity-id", "clientSecret")
+	suite.Nil(credentials)
+	suite.Equal(errNotImplemented, err)
+}
+
+func (suite *DisabledEntityProviderTestSuite) TestAddSystemCredential() {
+	credential, err := suite.provider.AddSystemCredential("entity-id", "clientSecret", "secret", nil)
+	suite.Nil(credential)
+	suite.Equal(errNotImplemented, err)
+}
+
+func (suite *DisabledEntityProviderTestSuite) TestDeleteSystemCredential() {
+	err := suite.provider.DeleteSystemCredential("entity-id", "clientSecret", "cred-id")
+	suite.Equal(errNotImplemented, err)
+}
+
 func (suite *DisabledEntityProviderTestSuite) TestGetTransitiveEntityGroups() {
 	groups, err := suite.provider.GetTransitiveEntityGroups("entity-id")
 	suite.Nil(groups)
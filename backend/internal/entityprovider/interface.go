@@ -20,6 +20,7 @@ package entityprovider
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // EntityProviderInterface defines the boundary contract between the gateway layer and the
@@ -60,6 +61,16 @@ type EntityProviderInterface interface {
 	UpdateSystemCredentials(entityID string,
 		credentials json.RawMessage) *EntityProviderError
 
+	// GetSystemCredentials returns the metadata of the system-managed credentials of the given type.
+	GetSystemCredentials(entityID string, credType string) ([]CredentialMetadata, *EntityProviderError)
+
+	// AddSystemCredential adds a system-managed credential of the given type, keeping the existing ones.
+	AddSystemCredential(entityID string, credType string, value string,
+		expiresAt *time.Time) (*CredentialMetadata, *EntityProviderError)
+
+	// DeleteSystemCredential deletes the system-managed credential with the given ID.
+	DeleteSystemCredential(entityID string, credType string, credentialID string) *EntityProviderError
+
 	// GetTransitiveEntityGroups retrieves all groups an entity belongs to, including inherited groups.
 	GetTransitiveEntityGroups(entityID string) ([]EntityGroup, *EntityProviderError)
 
@@ -21,7 +21,10 @@
 // to communicate with the entity service without depending on directory-layer internals.
 package entityprovider
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
+)
 
 // EntityCategory represents the category of an entity (e.g., user, application, agent).
 type EntityCategory string
@@ -71,3 +74,10 @@ type EntityGroup struct {
 	Name string `json:"name"`
 	OUID string `json:"ouId"`
 }
+
+// CredentialMetadata describes a stored credential without revealing its value.
+type CredentialMetadata struct {
+	ID        string     `json:"id"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
@@ -0,0 +1,386 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/grantrevocation"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/notification"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	mcpaudit "github.com/thunder-id/thunderid/internal/system/mcp/audit"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// pseudonymPrefix is the prefix of the pseudonyms replacing erased users in the records that are retained.
+const pseudonymPrefix = "erased-"
+
+// revocationReason is the reason recorded when the consents of an erased user are revoked.
+const revocationReason = "User erasure"
+
+// userEraserInterface defines the interface for erasing the data of a user from all stores.
+type userEraserInterface interface {
+	erase(ctx context.Context, userID string) (ErasureReport, bool)
+}
+
+// userEraser erases the data of a user from the stores holding it. Records that must be retained, such as
+// audit records, are pseudonymized instead of deleted.
+type userEraser struct {
+	userService            user.UserServiceInterface
+	groupService           group.GroupServiceInterface
+	roleAssignmentService  role.RoleAssignmentServiceInterface
+	consentService         consent.ConsentServiceInterface
+	ssoSessionService      ssosession.SSOSessionServiceInterface
+	grantRevocationService grantrevocation.GrantRevocationServiceInterface
+	pushDeviceService      notification.PushDeviceServiceInterface
+	sendAuditService       notification.SendAuditServiceInterface
+	toolCallAuditService   mcpaudit.ToolCallAuditServiceInterface
+	logger                 *log.Logger
+}
+
+// newUserEraser returns a new instance of userEraserInterface.
+func newUserEraser(userService user.UserServiceInterface, groupService group.GroupServiceInterface,
+	roleAssignmentService role.RoleAssignmentServiceInterface, consentService consent.ConsentServiceInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface,
+	grantRevocationService grantrevocation.GrantRevocationServiceInterface,
+	pushDeviceService notification.PushDeviceServiceInterface,
+	sendAuditService notification.SendAuditServiceInterface,
+	toolCallAuditService mcpaudit.ToolCallAuditServiceInterface) userEraserInterface {
+	return &userEraser{
+		userService:            userService,
+		groupService:           groupService,
+		roleAssignmentService:  roleAssignmentService,
+		consentService:         consentService,
+		ssoSessionService:      ssoSessionService,
+		grantRevocationService: grantRevocationService,
+		pushDeviceService:      pushDeviceService,
+		sendAuditService:       sendAuditService,
+		toolCallAuditService:   toolCallAuditService,
+		logger:                 log.GetLogger().With(log.String(log.LoggerKeyComponentName, "UserEraser")),
+	}
+}
+
+// erase erases the data of a user from all stores and returns the completion report, along with whether the
+// user was erased from all of them. The profile is deleted last, and only if the other stores were processed
+// successfully, so that a failed erasure can be requested again.
+func (e *userEraser) erase(ctx context.Context, userID string) (ErasureReport, bool) {
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		e.logger.Error("Failed to generate the pseudonym of the user", log.Error(err))
+		return ErasureReport{Steps: []ErasureStep{failedStep(storeProfile, StepActionDeleted,
+			"Failed to generate the pseudonym of the user")}}, false
+	}
+	report := ErasureReport{Pseudonym: pseudonymPrefix + id}
+
+	usr, svcErr := e.userService.GetUser(ctx, userID, false)
+	if svcErr != nil {
+		message := "Failed to retrieve the user"
+		if svcErr.Code == user.ErrorUserNotFound.Code {
+			message = "The user does not exist"
+		}
+		report.Steps = []ErasureStep{failedStep(storeProfile, StepActionDeleted, message)}
+		return report, false
+	}
+
+	report.Steps = []ErasureStep{
+		e.revokeConsents(ctx, usr),
+		e.deleteSSOSessions(ctx, userID),
+		e.revokeGrants(ctx, userID),
+		e.deletePushDevices(ctx, userID),
+		e.pseudonymizeNotificationAudit(ctx, usr, report.Pseudonym),
+		e.pseudonymizeMCPAudit(ctx, userID, report.Pseudonym),
+		e.removeGroupMemberships(ctx, userID),
+		e.removeRoleAssignments(ctx, userID),
+	}
+
+	for _, step := range report.Steps {
+		if step.Status == StepStatusFailed {
+			report.Steps = append(report.Steps, ErasureStep{
+				Store:   storeProfile,
+				Action:  StepActionDeleted,
+				Status:  StepStatusSkipped,
+				Message: "The profile is retained since the user could not be erased from all stores",
+			})
+			return report, false
+		}
+	}
+
+	profileStep := e.deleteProfile(ctx, userID)
+	report.Steps = append(report.Steps, profileStep)
+
+	return report, profileStep.Status == StepStatusSuccess
+}
+
+// revokeConsents revokes the active consents of the user.
+func (e *userEraser) revokeConsents(ctx context.Context, usr *user.User) ErasureStep {
+	if e.consentService == nil || !e.consentService.IsEnabled() {
+		return ErasureStep{Store: storeConsents, Action: StepActionRevoked, Status: StepStatusSkipped,
+			Message: "The consent service is not enabled"}
+	}
+
+	consents, svcErr := e.consentService.SearchConsents(ctx, usr.OUID, &consent.ConsentSearchFilter{
+		UserIDs:         []string{usr.ID},
+		ConsentStatuses: []consent.ConsentStatus{consent.ConsentStatusActive},
+	})
+	if svcErr != nil {
+		e.logServiceError("Failed to search the consents of the user", svcErr)
+		return failedStep(storeConsents, StepActionRevoked, "Failed to search the consents of the user")
+	}
+
+	var revoked int64
+	for _, c := range consents {
+		if svcErr := e.consentService.RevokeConsent(ctx, usr.OUID, c.ID,
+			&consent.ConsentRevokeRequest{Reason: revocationReason}); svcErr != nil {
+			e.logServiceError("Failed to revoke a consent of the user", svcErr)
+			return countedFailedStep(storeConsents, StepActionRevoked, revoked,
+				"Failed to revoke a consent of the user")
+		}
+		revoked++
+	}
+
+	return ErasureStep{Store: storeConsents, Action: StepActionRevoked, Status: StepStatusSuccess, Count: revoked}
+}
+
+// deleteSSOSessions deletes the SSO sessions of the user.
+func (e *userEraser) deleteSSOSessions(ctx context.Context, userID string) ErasureStep {
+	if e.ssoSessionService == nil || !e.ssoSessionService.IsEnabled() {
+		return ErasureStep{Store: storeSSOSessions, Action: StepActionDeleted, Status: StepStatusSkipped,
+			Message: "SSO sessions are not enabled"}
+	}
+
+	sessions, svcErr := e.ssoSessionService.ListUserSessions(ctx, userID)
+	if svcErr != nil {
+		e.logServiceError("Failed to list the SSO sessions of the user", svcErr)
+		return failedStep(storeSSOSessions, StepActionDeleted, "Failed to list the SSO sessions of the user")
+	}
+
+	var deleted int64
+	for _, session := range sessions {
+		if svcErr := e.ssoSessionService.DeleteSession(ctx, session.ID); svcErr != nil {
+			e.logServiceError("Failed to delete an SSO session of the user", svcErr)
+			return countedFailedStep(storeSSOSessions, StepActionDeleted, deleted,
+				"Failed to delete an SSO session of the user")
+		}
+		deleted++
+	}
+
+	return ErasureStep{Store: storeSSOSessions, Action: StepActionDeleted, Status: StepStatusSuccess,
+		Count: deleted}
+}
+
+// revokeGrants revokes the refresh tokens and authorization codes issued to the user. Access tokens are
+// self-contained and are not revoked, so they remain valid until they expire.
+func (e *userEraser) revokeGrants(ctx context.Context, userID string) ErasureStep {
+	if e.grantRevocationService == nil {
+		return ErasureStep{Store: storeTokens, Action: StepActionRevoked, Status: StepStatusSkipped,
+			Message: "Grant revocation is not available"}
+	}
+
+	if svcErr := e.grantRevocationService.RevokeUserGrants(ctx, userID); svcErr != nil {
+		e.logServiceError("Failed to revoke the grants of the user", svcErr)
+		return failedStep(storeTokens, StepActionRevoked, "Failed to revoke the grants of the user")
+	}
+
+	return ErasureStep{Store: storeTokens, Action: StepActionRevoked, Status: StepStatusSuccess,
+		Message: "Refresh tokens and authorization codes are revoked; access tokens expire on their own"}
+}
+
+// deletePushDevices deletes the push devices registered by the user.
+func (e *userEraser) deletePushDevices(ctx context.Context, userID string) ErasureStep {
+	if e.pushDeviceService == nil {
+		return ErasureStep{Store: storePushDevices, Action: StepActionDeleted, Status: StepStatusSkipped,
+			Message: "Push device registration is not available"}
+	}
+
+	deleted, svcErr := e.pushDeviceService.DeleteUserDevices(ctx, userID)
+	if svcErr != nil {
+		e.logServiceError("Failed to delete the push devices of the user", svcErr)
+		return failedStep(storePushDevices, StepActionDeleted, "Failed to delete the push devices of the user")
+	}
+
+	return ErasureStep{Store: storePushDevices, Action: StepActionDeleted, Status: StepStatusSuccess,
+		Count: deleted}
+}
+
+// pseudonymizeNotificationAudit replaces the recipient of the notification send audit records of the user
+// with the pseudonym. The records are looked up by each attribute value of the user, since the audit only
+// holds the email address or mobile number the notification was sent to.
+func (e *userEraser) pseudonymizeNotificationAudit(ctx context.Context, usr *user.User,
+	pseudonym string) ErasureStep {
+	if e.sendAuditService == nil {
+		return ErasureStep{Store: storeNotificationAudit, Action: StepActionPseudonymized,
+			Status: StepStatusSkipped, Message: "The notification send audit is not available"}
+	}
+
+	var updated int64
+	for _, recipient := range getAttributeValues(usr.Attributes) {
+		count, svcErr := e.sendAuditService.PseudonymizeRecipient(ctx, recipient, pseudonym)
+		if svcErr != nil {
+			e.logServiceError("Failed to pseudonymize the notification send audit of the user", svcErr)
+			return countedFailedStep(storeNotificationAudit, StepActionPseudonymized, updated,
+				"Failed to pseudonymize the notification send audit of the user")
+		}
+		updated += count
+	}
+
+	return ErasureStep{Store: storeNotificationAudit, Action: StepActionPseudonymized, Status: StepStatusSuccess,
+		Count: updated}
+}
+
+// pseudonymizeMCPAudit replaces the subject of the MCP tool call audit records of the user with the pseudonym.
+func (e *userEraser) pseudonymizeMCPAudit(ctx context.Context, userID, pseudonym string) ErasureStep {
+	if e.toolCallAuditService == nil {
+		return ErasureStep{Store: storeMCPAudit, Action: StepActionPseudonymized, Status: StepStatusSkipped,
+			Message: "The MCP tool call audit is not available"}
+	}
+
+	updated, svcErr := e.toolCallAuditService.PseudonymizeSubject(ctx, userID, pseudonym)
+	if svcErr != nil {
+		e.logServiceError("Failed to pseudonymize the MCP tool call audit of the user", svcErr)
+		return failedStep(storeMCPAudit, StepActionPseudonymized,
+			"Failed to pseudonymize the MCP tool call audit of the user")
+	}
+
+	return ErasureStep{Store: storeMCPAudit, Action: StepActionPseudonymized, Status: StepStatusSuccess,
+		Count: updated}
+}
+
+// removeGroupMemberships removes the user from the groups the user is a direct member of.
+func (e *userEraser) removeGroupMemberships(ctx context.Context, userID string) ErasureStep {
+	var groupIDs []string
+	for offset := 0; ; offset += constants.MaxPageSize {
+		groups, svcErr := e.userService.GetUserGroups(ctx, userID, constants.MaxPageSize, offset)
+		if svcErr != nil {
+			e.logServiceError("Failed to list the groups of the user", svcErr)
+			return failedStep(storeGroupMemberships, StepActionDeleted, "Failed to list the groups of the user")
+		}
+		for _, g := range groups.Groups {
+			groupIDs = append(groupIDs, g.ID)
+		}
+		if len(groups.Groups) == 0 || offset+len(groups.Groups) >= groups.TotalResults {
+			break
+		}
+	}
+
+	var removed int64
+	members := []group.Member{{ID: userID, Type: group.MemberTypeUser}}
+	for _, groupID := range groupIDs {
+		if _, svcErr := e.groupService.RemoveGroupMembers(ctx, groupID, members); svcErr != nil {
+			e.logServiceError("Failed to remove the user from a group", svcErr)
+			return countedFailedStep(storeGroupMemberships, StepActionDeleted, removed,
+				"Failed to remove the user from a group")
+		}
+		removed++
+	}
+
+	return ErasureStep{Store: storeGroupMemberships, Action: StepActionDeleted, Status: StepStatusSuccess,
+		Count: removed}
+}
+
+// removeRoleAssignments removes the user from the roles the user is directly assigned to.
+func (e *userEraser) removeRoleAssignments(ctx context.Context, userID string) ErasureStep {
+	if e.roleAssignmentService == nil {
+		return ErasureStep{Store: storeRoleAssignments, Action: StepActionDeleted, Status: StepStatusSkipped,
+			Message: "Role assignments are not available"}
+	}
+
+	removed, svcErr := e.roleAssignmentService.RemoveEntityAssignments(ctx, userID)
+	if svcErr != nil {
+		e.logServiceError("Failed to remove the role assignments of the user", svcErr)
+		return failedStep(storeRoleAssignments, StepActionDeleted,
+			"Failed to remove the role assignments of the user")
+	}
+
+	return ErasureStep{Store: storeRoleAssignments, Action: StepActionDeleted, Status: StepStatusSuccess,
+		Count: removed}
+}
+
+// deleteProfile deletes the profile of the user along with the credentials and identifiers of the user.
+func (e *userEraser) deleteProfile(ctx context.Context, userID string) ErasureStep {
+	if svcErr := e.userService.DeleteUser(ctx, userID); svcErr != nil {
+		e.logServiceError("Failed to delete the profile of the user", svcErr)
+		return failedStep(storeProfile, StepActionDeleted,
+			fmt.Sprintf("Failed to delete the profile of the user: %s", svcErr.ErrorDescription.DefaultValue))
+	}
+
+	return ErasureStep{Store: storeProfile, Action: StepActionDeleted, Status: StepStatusSuccess, Count: 1}
+}
+
+// logServiceError logs the error returned by a service while erasing a user.
+func (e *userEraser) logServiceError(message string, svcErr *serviceerror.ServiceError) {
+	e.logger.Error(message, log.String("code", svcErr.Code),
+		log.String("description", svcErr.ErrorDescription.DefaultValue))
+}
+
+// failedStep returns a failed erasure step.
+func failedStep(store string, action StepAction, message string) ErasureStep {
+	return countedFailedStep(store, action, 0, message)
+}
+
+// countedFailedStep returns a failed erasure step that processed some records before failing.
+func countedFailedStep(store string, action StepAction, count int64, message string) ErasureStep {
+	return ErasureStep{Store: store, Action: action, Status: StepStatusFailed, Count: count, Message: message}
+}
+
+// getAttributeValues returns the distinct string values of the attributes of a user, including those nested in
+// objects and arrays.
+func getAttributeValues(attributes json.RawMessage) []string {
+	if len(attributes) == 0 {
+		return nil
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(attributes, &decoded); err != nil {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var values []string
+	var collect func(value interface{})
+	collect = func(value interface{}) {
+		switch v := value.(type) {
+		case string:
+			normalized := strings.ToLower(strings.TrimSpace(v))
+			if normalized != "" && !seen[normalized] {
+				seen[normalized] = true
+				values = append(values, v)
+			}
+		case map[string]interface{}:
+			for _, nested := range v {
+				collect(nested)
+			}
+		case []interface{}:
+			for _, nested := range v {
+				collect(nested)
+			}
+		}
+	}
+	collect(decoded)
+
+	return values
+}
@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/tests/mocks/consentmock"
+	"github.com/thunder-id/thunderid/tests/mocks/grantrevocationmock"
+	"github.com/thunder-id/thunderid/tests/mocks/groupmock"
+	"github.com/thunder-id/thunderid/tests/mocks/mcp/auditmock"
+	"github.com/thunder-id/thunderid/tests/mocks/notification/notificationmock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+	"github.com/thunder-id/thunderid/tests/mocks/ssosessionmock"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+const testOUID = "ou-1"
+
+type UserEraserTestSuite struct {
+	suite.Suite
+	mockUserService           *usermock.UserServiceInterfaceMock
+	mockGroupService          *groupmock.GroupServiceInterfaceMock
+	mockRoleAssignmentService *rolemock.RoleAssignmentServiceInterfaceMock
+	mockConsentService        *consentmock.ConsentServiceInterfaceMock
+	mockSSOSessionService     *ssosessionmock.SSOSessionServiceInterfaceMock
+	mockGrantRevocationSvc    *grantrevocationmock.GrantRevocationServiceInterfaceMock
+	mockPushDeviceService     *notificationmock.PushDeviceServiceInterfaceMock
+	mockSendAuditService      *notificationmock.SendAuditServiceInterfaceMock
+	mockToolCallAuditSvc      *auditmock.ToolCallAuditServiceInterfaceMock
+	eraser                    *userEraser
+	user                      *user.User
+}
+
+func TestUserEraserTestSuite(t *testing.T) {
+	suite.Run(t, new(UserEraserTestSuite))
+}
+
+func (suite *UserEraserTestSuite) SetupTest() {
+	suite.mockUserService = usermock.NewUserServiceInterfaceMock(suite.T())
+	suite.mockGroupService = groupmock.NewGroupServiceInterfaceMock(suite.T())
+	suite.mockRoleAssignmentService = rolemock.NewRoleAssignmentServiceInterfaceMock(suite.T())
+	suite.mockConsentService = consentmock.NewConsentServiceInterfaceMock(suite.T())
+	suite.mockSSOSessionService = ssosessionmock.NewSSOSessionServiceInterfaceMock(suite.T())
+	suite.mockGrantRevocationSvc = grantrevocationmock.NewGrantRevocationServiceInterfaceMock(suite.T())
+	suite.mockPushDeviceService = notificationmock.NewPushDeviceServiceInterfaceMock(suite.T())
+	suite.mockSendAuditService = notificationmock.NewSendAuditServiceInterfaceMock(suite.T())
+	suite.mockToolCallAuditSvc = auditmock.NewToolCallAuditServiceInterfaceMock(suite.T())
+	suite.eraser = &userEraser{
+		userService:            suite.mockUserService,
+		groupService:           suite.mockGroupService,
+		roleAssignmentService:  suite.mockRoleAssignmentService,
+		consentService:         suite.mockConsentService,
+		ssoSessionService:      suite.mockSSOSessionService,
+		grantRevocationService: suite.mockGrantRevocationSvc,
+		pushDeviceService:      suite.mockPushDeviceService,
+		sendAuditService:       suite.mockSendAuditService,
+		toolCallAuditService:   suite.mockToolCallAuditSvc,
+		logger:                 log.GetLogger(),
+	}
+	suite.user = &user.User{
+		ID:         testUserID,
+		OUID:       testOUID,
+		Attributes: json.RawMessage(`{"email":"alice@example.com","mobile":{"number":"+15551234567"}}`),
+	}
+}
+
+func (suite *UserEraserTestSuite) TestNewUserEraser() {
+	suite.NotNil(newUserEraser(suite.mockUserService, suite.mockGroupService, suite.mockRoleAssignmentService,
+		suite.mockConsentService, suite.mockSSOSessionService, suite.mockGrantRevocationSvc,
+		suite.mockPushDeviceService, suite.mockSendAuditService, suite.mockToolCallAuditSvc))
+}
+
+// expectStoresErased sets the expectations of erasing the user from all stores other than the profile.
+func (suite *UserEraserTestSuite) expectStoresErased() {
+	suite.mockUserService.EXPECT().GetUser(mock.Anything, testUserID, false).Return(suite.user, nil).Once()
+	suite.mockConsentService.EXPECT().IsEnabled().Return(true).Once()
+	suite.mockConsentService.EXPECT().SearchConsents(mock.Anything, testOUID, &consent.ConsentSearchFilter{
+		UserIDs:         []string{testUserID},
+		ConsentStatuses: []consent.ConsentStatus{consent.ConsentStatusActive},
+	}).Return([]consent.Consent{{ID: "consent-1"}}, nil).Once()
+	suite.mockConsentService.EXPECT().RevokeConsent(mock.Anything, testOUID, "consent-1",
+		&consent.ConsentRevokeRequest{Reason: revocationReason}).Return(nil).Once()
+	suite.mockSSOSessionService.EXPECT().IsEnabled().Return(true).Once()
+	suite.mockSSOSessionService.EXPECT().ListUserSessions(mock.Anything, testUserID).
+		Return([]ssosession.SSOSession{{ID: "session-1"}, {ID: "session-2"}}, nil).Once()
+	suite.mockSSOSessionService.EXPECT().DeleteSession(mock.Anything, mock.Anything).Return(nil).Twice()
+	suite.mockGrantRevocationSvc.EXPECT().RevokeUserGrants(mock.Anything, testUserID).Return(nil).Once()
+	suite.mockPushDeviceService.EXPECT().DeleteUserDevices(mock.Anything, testUserID).Return(int64(2), nil).Once()
+	suite.mockSendAuditService.EXPECT().PseudonymizeRecipient(mock.Anything, "alice@example.com",
+		mock.MatchedBy(isPseudonym)).Return(int64(2), nil).Once()
+	suite.mockSendAuditService.EXPECT().PseudonymizeRecipient(mock.Anything, "+15551234567",
+		mock.MatchedBy(isPseudonym)).Return(int64(1), nil).Once()
+	suite.mockToolCallAuditSvc.EXPECT().PseudonymizeSubject(mock.Anything, testUserID,
+		mock.MatchedBy(isPseudonym)).Return(int64(4), nil).Once()
+	suite.mockUserService.EXPECT().GetUserGroups(mock.Anything, testUserID, mock.Anything, 0).
+		Return(&user.UserGroupListResponse{TotalResults: 1, Groups: []entity.EntityGroup{{ID: "group-1"}}}, nil).
+		Once()
+	suite.mockGroupService.EXPECT().RemoveGroupMembers(mock.Anything, "group-1",
+		[]group.Member{{ID: testUserID, Type: group.MemberTypeUser}}).Return(&group.Group{}, nil).Once()
+	suite.mockRoleAssignmentService.EXPECT().RemoveEntityAssignments(mock.Anything, testUserID).
+		Return(int64(3), nil).Once()
+}
+
+func (suite *UserEraserTestSuite) TestErase() {
+	suite.expectStoresErased()
+	suite.mockUserService.EXPECT().DeleteUser(mock.Anything, testUserID).Return(nil).Once()
+
+	report, erased := suite.eraser.erase(context.Background(), testUserID)
+	suite.True(erased)
+	suite.True(isPseudonym(report.Pseudonym))
+
+	counts := make(map[string]int64)
+	for _, step := range report.Steps {
+		suite.Equal(StepStatusSuccess, step.Status, step.Store)
+		counts[step.Store] = step.Count
+	}
+	suite.Equal(map[string]int64{
+		storeConsents:          1,
+		storeSSOSessions:       2,
+		storeTokens:            0,
+		storePushDevices:       2,
+		storeNotificationAudit: 3,
+		storeMCPAudit:          4,
+		storeGroupMemberships:  1,
+		storeRoleAssignments:   3,
+		storeProfile:           1,
+	}, counts)
+}
+
+func (suite *UserEraserTestSuite) TestErase_ProfileDeletionFails() {
+	suite.expectStoresErased()
+	suite.mockUserService.EXPECT().DeleteUser(mock.Anything, testUserID).
+		Return(&user.ErrorCannotModifyDeclarativeResource).Once()
+
+	report, erased := suite.eraser.erase(context.Background(), testUserID)
+	suite.False(erased)
+	profileStep := report.Steps[len(report.Steps)-1]
+	suite.Equal(storeProfile, profileStep.Store)
+	suite.Equal(StepStatusFailed, profileStep.Status)
+}
+
+func (suite *UserEraserTestSuite) TestErase_RetainsProfileWhenAStoreFails() {
+	suite.mockUserService.EXPECT().GetUser(mock.Anything, testUserID, false).Return(suite.user, nil).Once()
+	suite.mockConsentService.EXPECT().IsEnabled().Return(false).Once()
+	suite.mockSSOSessionService.EXPECT().IsEnabled().Return(false).Once()
+	suite.mockGrantRevocationSvc.EXPECT().RevokeUserGrants(mock.Anything, testUserID).Return(nil).Once()
+	suite.mockPushDeviceService.EXPECT().DeleteUserDevices(mock.Anything, testUserID).Return(int64(0), nil).Once()
+	suite.mockSendAuditService.EXPECT().PseudonymizeRecipient(mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), &serviceerror.InternalServerError).Once()
+	suite.mockToolCallAuditSvc.EXPECT().PseudonymizeSubject(mock.Anything, testUserID, mock.Anything).
+		Return(int64(0), nil).Once()
+	suite.mockUserService.EXPECT().GetUserGroups(mock.Anything, testUserID, mock.Anything, 0).
+		Return(&user.UserGroupListResponse{}, nil).Once()
+	suite.mockRoleAssignmentService.EXPECT().RemoveEntityAssignments(mock.Anything, testUserID).
+		Return(int64(0), nil).Once()
+
+	report, erased := suite.eraser.erase(context.Background(), testUserID)
+	suite.False(erased)
+
+	statuses := make(map[string]StepStatus)
+	for _, step := range report.Steps {
+		statuses[step.Store] = step.Status
+	}
+	suite.Equal(StepStatusSkipped, statuses[storeConsents])
+	suite.Equal(StepStatusSkipped, statuses[storeSSOSessions])
+	suite.Equal(StepStatusFailed, statuses[storeNotificationAudit])
+	suite.Equal(StepStatusSuccess, statuses[storeMCPAudit])
+	suite.Equal(StepStatusSkipped, statuses[storeProfile])
+	suite.mockUserService.AssertNotCalled(suite.T(), "DeleteUser", mock.Anything, mock.Anything)
+}
+
+func (suite *UserEraserTestSuite) TestErase_UserNotFound() {
+	suite.mockUserService.EXPECT().GetUser(mock.Anything, testUserID, false).
+		Return(nil, &user.ErrorUserNotFound).Once()
+
+	report, erased := suite.eraser.erase(context.Background(), testUserID)
+	suite.False(erased)
+	suite.Require().Len(report.Steps, 1)
+	suite.Equal(StepStatusFailed, report.Steps[0].Status)
+	suite.Equal("The user does not exist", report.Steps[0].Message)
+}
+
+func (suite *UserEraserTestSuite) TestRemoveGroupMemberships_Pages() {
+	groups := make([]entity.EntityGroup, 100)
+	for i := range groups {
+		groups[i] = entity.EntityGroup{ID: "group"}
+	}
+	suite.mockUserService.EXPECT().GetUserGroups(mock.Anything, testUserID, 100, 0).
+		Return(&user.UserGroupListResponse{TotalResults: 101, Groups: groups}, nil).Once()
+	suite.mockUserService.EXPECT().GetUserGroups(mock.Anything, testUserID, 100, 100).
+		Return(&user.UserGroupListResponse{TotalResults: 101, Groups: []entity.EntityGroup{{ID: "last"}}}, nil).
+		Once()
+	suite.mockGroupService.EXPECT().RemoveGroupMembers(mock.Anything, mock.Anything, mock.Anything).
+		Return(&group.Group{}, nil).Times(101)
+
+	step := suite.eraser.removeGroupMemberships(context.Background(), testUserID)
+	suite.Equal(StepStatusSuccess, step.Status)
+	suite.Equal(int64(101), step.Count)
+}
+
+func (suite *UserEraserTestSuite) TestRevokeGrants_Fails() {
+	suite.mockGrantRevocationSvc.EXPECT().RevokeUserGrants(mock.Anything, testUserID).
+		Return(&serviceerror.InternalServerError).Once()
+
+	step := suite.eraser.revokeGrants(context.Background(), testUserID)
+	suite.Equal(storeTokens, step.Store)
+	suite.Equal(StepActionRevoked, step.Action)
+	suite.Equal(StepStatusFailed, step.Status)
+}
+
+func (suite *UserEraserTestSuite) TestDeletePushDevices_Fails() {
+	suite.mockPushDeviceService.EXPECT().DeleteUserDevices(mock.Anything, testUserID).
+		Return(int64(0), &serviceerror.InternalServerError).Once()
+
+	step := suite.eraser.deletePushDevices(context.Background(), testUserID)
+	suite.Equal(storePushDevices, step.Store)
+	suite.Equal(StepStatusFailed, step.Status)
+}
+
+func (suite *UserEraserTestSuite) TestRemoveRoleAssignments_Fails() {
+	suite.mockRoleAssignmentService.EXPECT().RemoveEntityAssignments(mock.Anything, testUserID).
+		Return(int64(0), &serviceerror.InternalServerError).Once()
+
+	step := suite.eraser.removeRoleAssignments(context.Background(), testUserID)
+	suite.Equal(storeRoleAssignments, step.Store)
+	suite.Equal(StepStatusFailed, step.Status)
+}
+
+func (suite *UserEraserTestSuite) TestSteps_SkippedWhenServicesUnavailable() {
+	suite.eraser.grantRevocationService = nil
+	suite.eraser.pushDeviceService = nil
+	suite.eraser.roleAssignmentService = nil
+
+	suite.Equal(StepStatusSkipped, suite.eraser.revokeGrants(context.Background(), testUserID).Status)
+	suite.Equal(StepStatusSkipped, suite.eraser.deletePushDevices(context.Background(), testUserID).Status)
+	suite.Equal(StepStatusSkipped, suite.eraser.removeRoleAssignments(context.Background(), testUserID).Status)
+}
+
+func (suite *UserEraserTestSuite) TestGetAttributeValues() {
+	values := getAttributeValues(json.RawMessage(
+		`{"email":"Alice@Example.com","emails":["alice@example.com","bob@example.com"],"age":30,"name":" "}`))
+	suite.Require().Len(values, 2)
+	suite.ElementsMatch([]string{"alice@example.com", "bob@example.com"},
+		[]string{strings.ToLower(values[0]), strings.ToLower(values[1])})
+
+	suite.Nil(getAttributeValues(nil))
+	suite.Nil(getAttributeValues(json.RawMessage(`not json`)))
+}
+
+func isPseudonym(value string) bool {
+	return strings.HasPrefix(value, pseudonymPrefix) && len(value) > len(pseudonymPrefix)
+}
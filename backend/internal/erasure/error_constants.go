@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for erasure request operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ERS-1001",
+		Error: core.I18nMessage{
+			Key:          "error.erasureservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.erasureservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorMissingUserID is the error returned when the ID of the user to erase is not provided.
+	ErrorMissingUserID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ERS-1002",
+		Error: core.I18nMessage{
+			Key:          "error.erasureservice.missing_user_id",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.erasureservice.missing_user_id_description",
+			DefaultValue: "The ID of the user to erase must be provided",
+		},
+	}
+
+	// ErrorUserNotFound is the error returned when the user to erase does not exist.
+	ErrorUserNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ERS-1003",
+		Error: core.I18nMessage{
+			Key:          "error.erasureservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.erasureservice.user_not_found_description",
+			DefaultValue: "The user with the given ID does not exist",
+		},
+	}
+
+	// ErrorErasureRequestNotFound is the error returned when an erasure request with the given ID does not exist.
+	ErrorErasureRequestNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ERS-1004",
+		Error: core.I18nMessage{
+			Key:          "error.erasureservice.request_not_found",
+			DefaultValue: "Erasure request not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.erasureservice.request_not_found_description",
+			DefaultValue: "The erasure request with the given ID does not exist",
+		},
+	}
+
+	// ErrorErasureAlreadyRequested is the error returned when an erasure request is already queued for the user.
+	ErrorErasureAlreadyRequested = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ERS-1005",
+		Error: core.I18nMessage{
+			Key:          "error.erasureservice.already_requested",
+			DefaultValue: "Erasure already requested",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.erasureservice.already_requested_description",
+			DefaultValue: "An erasure request for the user is already pending or in progress",
+		},
+	}
+
+	// ErrorInvalidLimit is the error returned when the limit query parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ERS-1006",
+		Error: core.I18nMessage{
+			Key:          "error.erasureservice.invalid_limit",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.erasureservice.invalid_limit_description",
+			DefaultValue: "The limit parameter must be a positive integer not greater than the maximum page size",
+		},
+	}
+
+	// ErrorInvalidOffset is the error returned when the offset query parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ERS-1007",
+		Error: core.I18nMessage{
+			Key:          "error.erasureservice.invalid_offset",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.erasureservice.invalid_offset_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+
+	// ErrorReasonTooLong is the error returned when the reason of an erasure request is too long.
+	ErrorReasonTooLong = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ERS-1008",
+		Error: core.I18nMessage{
+			Key:          "error.erasureservice.reason_too_long",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.erasureservice.reason_too_long_description",
+			DefaultValue: "The reason must not exceed 1024 characters",
+		},
+	}
+)
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// erasureHandler defines the handler for the erasure request API.
+type erasureHandler struct {
+	service ErasureServiceInterface
+	logger  *log.Logger
+}
+
+func newErasureHandler(service ErasureServiceInterface) *erasureHandler {
+	return &erasureHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ErasureHandler")),
+	}
+}
+
+// HandleErasureRequestPostRequest handles the request to queue the erasure of a user. The user is erased in the
+// background.
+func (h *erasureHandler) HandleErasureRequestPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[CreateErasureRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	erasureRequest, svcErr := h.service.CreateErasureRequest(r.Context(), request)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, erasureRequest)
+}
+
+// HandleErasureRequestListRequest handles the request to list the erasure requests.
+func (h *erasureHandler) HandleErasureRequestListRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := constants.DefaultPageSize
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidOffset)
+			return
+		}
+		offset = parsed
+	}
+
+	result, svcErr := h.service.ListErasureRequests(r.Context(), limit, offset)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, result)
+}
+
+// HandleErasureRequestGetRequest handles the request to get an erasure request along with its completion
+// report.
+func (h *erasureHandler) HandleErasureRequestGetRequest(w http.ResponseWriter, r *http.Request) {
+	erasureRequest, svcErr := h.service.GetErasureRequest(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, erasureRequest)
+}
+
+// handleError handles service errors and sends appropriate HTTP responses.
+func (h *erasureHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorUserNotFound.Code, ErrorErasureRequestNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorErasureAlreadyRequested.Code:
+			statusCode = http.StatusConflict
+		case serviceerror.ErrorUnauthorized.Code:
+			statusCode = http.StatusForbidden
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	if statusCode == http.StatusInternalServerError {
+		h.logger.Error("Erasure request failed with server error",
+			log.String("code", svcErr.Code),
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	serviceMock *ErasureServiceInterfaceMock
+	handler     *erasureHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.serviceMock = NewErasureServiceInterfaceMock(suite.T())
+	suite.handler = newErasureHandler(suite.serviceMock)
+}
+
+func (suite *HandlerTestSuite) TestCreateErasureRequest() {
+	suite.serviceMock.EXPECT().CreateErasureRequest(mock.Anything,
+		&CreateErasureRequest{UserID: testUserID, Reason: "Customer request"}).
+		Return(&ErasureRequest{ID: testRequestID, UserID: testUserID, Status: RequestStatusPending}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/erasure-requests",
+		strings.NewReader(`{"userId":"user-1","reason":"Customer request"}`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErasureRequestPostRequest(rr, req)
+
+	suite.Equal(http.StatusAccepted, rr.Code)
+
+	var request ErasureRequest
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &request))
+	suite.Equal(testRequestID, request.ID)
+	suite.Equal(RequestStatusPending, request.Status)
+}
+
+func (suite *HandlerTestSuite) TestCreateErasureRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/erasure-requests", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErasureRequestPostRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestCreateErasureRequest_Errors() {
+	testCases := []struct {
+		name       string
+		svcErr     *serviceerror.ServiceError
+		statusCode int
+	}{
+		{"UserNotFound", &ErrorUserNotFound, http.StatusNotFound},
+		{"AlreadyRequested", &ErrorErasureAlreadyRequested, http.StatusConflict},
+		{"Unauthorized", &serviceerror.ErrorUnauthorized, http.StatusForbidden},
+		{"MissingUserID", &ErrorMissingUserID, http.StatusBadRequest},
+		{"ServerError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.serviceMock.EXPECT().CreateErasureRequest(mock.Anything, mock.Anything).
+				Return(nil, tc.svcErr).Once()
+
+			req := httptest.NewRequest(http.MethodPost, "/erasure-requests", strings.NewReader(`{"userId":"user-1"}`))
+			rr := httptest.NewRecorder()
+
+			suite.handler.HandleErasureRequestPostRequest(rr, req)
+
+			suite.Equal(tc.statusCode, rr.Code)
+			var errResp apierror.ErrorResponse
+			suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+			suite.Equal(tc.svcErr.Code, errResp.Code)
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestListErasureRequests() {
+	suite.serviceMock.EXPECT().ListErasureRequests(mock.Anything, 5, 10).
+		Return(&ErasureRequestList{TotalResults: 1, Requests: []ErasureRequest{{ID: testRequestID}}}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/erasure-requests?limit=5&offset=10", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErasureRequestListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var result ErasureRequestList
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &result))
+	suite.Equal(1, result.TotalResults)
+}
+
+func (suite *HandlerTestSuite) TestListErasureRequests_InvalidParams() {
+	req := httptest.NewRequest(http.MethodGet, "/erasure-requests?limit=abc", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErasureRequestListRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidLimit.Code, errResp.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/erasure-requests?offset=abc", nil)
+	rr = httptest.NewRecorder()
+
+	suite.handler.HandleErasureRequestListRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidOffset.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestGetErasureRequest() {
+	suite.serviceMock.EXPECT().GetErasureRequest(mock.Anything, testRequestID).
+		Return(&ErasureRequest{ID: testRequestID, Status: RequestStatusCompleted}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/erasure-requests/"+testRequestID, nil)
+	req.SetPathValue("id", testRequestID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErasureRequestGetRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+
+	suite.serviceMock.EXPECT().GetErasureRequest(mock.Anything, "missing").
+		Return(nil, &ErrorErasureRequestNotFound).Once()
+
+	req = httptest.NewRequest(http.MethodGet, "/erasure-requests/missing", nil)
+	req.SetPathValue("id", "missing")
+	rr = httptest.NewRecorder()
+
+	suite.handler.HandleErasureRequestGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorErasureRequestNotFound.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestOptionsRoutes() {
+	mux := http.NewServeMux()
+	registerRoutes(mux, suite.handler)
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodOptions, "/erasure-requests", nil))
+	suite.Equal(http.StatusNoContent, rr.Code)
+
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodOptions, "/erasure-requests/"+testRequestID, nil))
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package erasure provides the API to request the erasure of users and the job erasing them from all stores,
+// recording a completion report for each request.
+package erasure
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/grantrevocation"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/notification"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	mcpaudit "github.com/thunder-id/thunderid/internal/system/mcp/audit"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// erasureRequestsPath is the path of the erasure request API.
+const erasureRequestsPath = "/erasure-requests"
+
+// Initialize creates the erasure service, registers the erasure request API with the provided mux and returns
+// the service along with the job processing the erasure requests, which is to be run by the scheduler.
+func Initialize(
+	mux *http.ServeMux,
+	userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface,
+	roleAssignmentService role.RoleAssignmentServiceInterface,
+	consentService consent.ConsentServiceInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface,
+	grantRevocationService grantrevocation.GrantRevocationServiceInterface,
+	pushDeviceService notification.PushDeviceServiceInterface,
+	sendAuditService notification.SendAuditServiceInterface,
+	toolCallAuditService mcpaudit.ToolCallAuditServiceInterface,
+) (ErasureServiceInterface, scheduler.Job) {
+	store := newErasureStore()
+	erasureService := newErasureService(store, userService)
+	eraser := newUserEraser(userService, groupService, roleAssignmentService, consentService, ssoSessionService,
+		grantRevocationService, pushDeviceService, sendAuditService, toolCallAuditService)
+
+	registerRoutes(mux, newErasureHandler(erasureService))
+
+	return erasureService, newErasureJob(store, eraser)
+}
+
+// registerRoutes registers the HTTP routes of the erasure request API.
+func registerRoutes(mux *http.ServeMux, handler *erasureHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("POST "+erasureRequestsPath, handler.HandleErasureRequestPostRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+erasureRequestsPath, handler.HandleErasureRequestListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+erasureRequestsPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+erasureRequestsPath+"/{id}", handler.HandleErasureRequestGetRequest,
+		opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+erasureRequestsPath+"/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
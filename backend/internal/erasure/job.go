@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+const (
+	// ErasureJobName is the name of the job processing the erasure requests.
+	ErasureJobName = "user-erasure"
+	// erasureJobSchedule is the default schedule of the job processing the erasure requests.
+	erasureJobSchedule = "@every 1m"
+	// erasureBatchSize is the maximum number of erasure requests processed in a run of the job.
+	erasureBatchSize = 10
+	// staleRequestTimeout is the duration after which a request left in progress, for example by a node that
+	// stopped while processing it, is processed again.
+	staleRequestTimeout = time.Hour
+)
+
+// erasureJob processes the queued erasure requests. It implements scheduler.Job.
+type erasureJob struct {
+	store  erasureStoreInterface
+	eraser userEraserInterface
+	logger *log.Logger
+}
+
+// newErasureJob returns a new erasure job.
+func newErasureJob(store erasureStoreInterface, eraser userEraserInterface) *erasureJob {
+	return &erasureJob{
+		store:  store,
+		eraser: eraser,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ErasureJob")),
+	}
+}
+
+func (j *erasureJob) Name() string {
+	return ErasureJobName
+}
+
+func (j *erasureJob) Description() string {
+	return "Erases the users of the queued erasure requests and records the completion reports"
+}
+
+func (j *erasureJob) DefaultSchedule() string {
+	return erasureJobSchedule
+}
+
+// Run processes a batch of the queued erasure requests. The requests are processed as the server itself, since
+// they were authorized when they were created.
+func (j *erasureJob) Run(ctx context.Context) (string, error) {
+	ctx = security.WithRuntimeContext(ctx)
+
+	staleBefore := time.Now().UTC().Add(-staleRequestTimeout)
+	requests, err := j.store.listQueuedErasureRequests(ctx, staleBefore, erasureBatchSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to list the queued erasure requests: %w", err)
+	}
+
+	var completed, failed int
+	for _, request := range requests {
+		claimed, err := j.store.claimErasureRequest(ctx, request.ID, time.Now().UTC(), staleBefore)
+		if err != nil {
+			return summarize(completed, failed), fmt.Errorf("failed to claim erasure request %s: %w", request.ID, err)
+		}
+		if !claimed {
+			continue
+		}
+
+		report, erased := j.eraser.erase(ctx, request.UserID)
+		status := RequestStatusCompleted
+		if erased {
+			completed++
+		} else {
+			status = RequestStatusFailed
+			failed++
+		}
+
+		if err := j.store.completeErasureRequest(ctx, request.ID, status, report, time.Now().UTC()); err != nil {
+			return summarize(completed, failed),
+				fmt.Errorf("failed to record the outcome of erasure request %s: %w", request.ID, err)
+		}
+		j.logger.Info("Erasure request processed", log.String("requestId", request.ID),
+			log.String("status", string(status)))
+	}
+
+	return summarize(completed, failed), nil
+}
+
+// summarize returns the summary of a run of the erasure job.
+func summarize(completed, failed int) string {
+	return fmt.Sprintf("Processed %d erasure requests: %d completed, %d failed", completed+failed, completed, failed)
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+type ErasureJobTestSuite struct {
+	suite.Suite
+	mockStore  *erasureStoreInterfaceMock
+	mockEraser *userEraserInterfaceMock
+	job        *erasureJob
+}
+
+func TestErasureJobTestSuite(t *testing.T) {
+	suite.Run(t, new(ErasureJobTestSuite))
+}
+
+func (suite *ErasureJobTestSuite) SetupTest() {
+	suite.mockStore = newErasureStoreInterfaceMock(suite.T())
+	suite.mockEraser = newUserEraserInterfaceMock(suite.T())
+	suite.job = newErasureJob(suite.mockStore, suite.mockEraser)
+}
+
+func (suite *ErasureJobTestSuite) TestJobDetails() {
+	suite.Equal(ErasureJobName, suite.job.Name())
+	suite.NotEmpty(suite.job.Description())
+	suite.Equal(erasureJobSchedule, suite.job.DefaultSchedule())
+}
+
+func (suite *ErasureJobTestSuite) TestRun() {
+	runtimeCtx := mock.MatchedBy(security.IsRuntimeContext)
+	suite.mockStore.EXPECT().listQueuedErasureRequests(runtimeCtx, mock.Anything, erasureBatchSize).
+		Return([]ErasureRequest{
+			{ID: "request-1", UserID: "user-1"},
+			{ID: "request-2", UserID: "user-2"},
+			{ID: "request-3", UserID: "user-3"},
+		}, nil).Once()
+	suite.mockStore.EXPECT().claimErasureRequest(runtimeCtx, "request-1", mock.Anything, mock.Anything).
+		Return(true, nil).Once()
+	suite.mockStore.EXPECT().claimErasureRequest(runtimeCtx, "request-2", mock.Anything, mock.Anything).
+		Return(false, nil).Once()
+	suite.mockStore.EXPECT().claimErasureRequest(runtimeCtx, "request-3", mock.Anything, mock.Anything).
+		Return(true, nil).Once()
+	suite.mockEraser.EXPECT().erase(runtimeCtx, "user-1").
+		Return(ErasureReport{Pseudonym: "erased-1"}, true).Once()
+	suite.mockEraser.EXPECT().erase(runtimeCtx, "user-3").
+		Return(ErasureReport{Pseudonym: "erased-3"}, false).Once()
+	suite.mockStore.EXPECT().completeErasureRequest(runtimeCtx, "request-1", RequestStatusCompleted,
+		ErasureReport{Pseudonym: "erased-1"}, mock.Anything).Return(nil).Once()
+	suite.mockStore.EXPECT().completeErasureRequest(runtimeCtx, "request-3", RequestStatusFailed,
+		ErasureReport{Pseudonym: "erased-3"}, mock.Anything).Return(nil).Once()
+
+	message, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+	suite.Equal("Processed 2 erasure requests: 1 completed, 1 failed", message)
+}
+
+func (suite *ErasureJobTestSuite) TestRun_StoreErrors() {
+	suite.mockStore.EXPECT().listQueuedErasureRequests(mock.Anything, mock.Anything, erasureBatchSize).
+		Return(nil, errors.New("db err")).Once()
+
+	_, err := suite.job.Run(context.Background())
+	suite.ErrorContains(err, "failed to list the queued erasure requests")
+
+	suite.mockStore.EXPECT().listQueuedErasureRequests(mock.Anything, mock.Anything, erasureBatchSize).
+		Return([]ErasureRequest{{ID: "request-1", UserID: "user-1"}}, nil).Once()
+	suite.mockStore.EXPECT().claimErasureRequest(mock.Anything, "request-1", mock.Anything, mock.Anything).
+		Return(true, nil).Once()
+	suite.mockEraser.EXPECT().erase(mock.Anything, "user-1").Return(ErasureReport{}, true).Once()
+	suite.mockStore.EXPECT().completeErasureRequest(mock.Anything, "request-1", RequestStatusCompleted,
+		mock.Anything, mock.Anything).Return(errors.New("db err")).Once()
+
+	message, err := suite.job.Run(context.Background())
+	suite.ErrorContains(err, "failed to record the outcome of erasure request request-1")
+	suite.Equal("Processed 1 erasure requests: 1 completed, 0 failed", message)
+}
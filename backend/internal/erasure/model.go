@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// RequestStatus represents the status of an erasure request.
+type RequestStatus string
+
+const (
+	// RequestStatusPending indicates that the erasure request is waiting to be processed.
+	RequestStatusPending RequestStatus = "PENDING"
+	// RequestStatusInProgress indicates that the erasure request is being processed.
+	RequestStatusInProgress RequestStatus = "IN_PROGRESS"
+	// RequestStatusCompleted indicates that the user was erased from all stores.
+	RequestStatusCompleted RequestStatus = "COMPLETED"
+	// RequestStatusFailed indicates that the user could not be erased from one or more stores.
+	RequestStatusFailed RequestStatus = "FAILED"
+)
+
+// StepStatus represents the outcome of erasing the data of a user from a store.
+type StepStatus string
+
+const (
+	// StepStatusSuccess indicates that the data of the user was erased from the store.
+	StepStatusSuccess StepStatus = "SUCCESS"
+	// StepStatusFailed indicates that the data of the user could not be erased from the store.
+	StepStatusFailed StepStatus = "FAILED"
+	// StepStatusSkipped indicates that the store was not processed.
+	StepStatusSkipped StepStatus = "SKIPPED"
+)
+
+// StepAction represents how the data of a user is erased from a store.
+type StepAction string
+
+const (
+	// StepActionDeleted indicates that the records of the user are deleted.
+	StepActionDeleted StepAction = "DELETED"
+	// StepActionRevoked indicates that the records of the user are revoked.
+	StepActionRevoked StepAction = "REVOKED"
+	// StepActionPseudonymized indicates that the records are kept with the user replaced by a pseudonym.
+	StepActionPseudonymized StepAction = "PSEUDONYMIZED"
+)
+
+// Stores of which the data of a user is erased, in the order they are processed.
+const (
+	storeConsents          = "consents"
+	storeSSOSessions       = "sso-sessions"
+	storeTokens            = "tokens"
+	storePushDevices       = "push-devices"
+	storeNotificationAudit = "notification-send-audit"
+	storeMCPAudit          = "mcp-tool-call-audit"
+	storeGroupMemberships  = "group-memberships"
+	storeRoleAssignments   = "role-assignments"
+	storeProfile           = "profile"
+)
+
+// ErasureStep represents the outcome of erasing the data of a user from a store.
+type ErasureStep struct {
+	Store   string     `json:"store"`
+	Action  StepAction `json:"action"`
+	Status  StepStatus `json:"status"`
+	Count   int64      `json:"count"`
+	Message string     `json:"message,omitempty"`
+}
+
+// ErasureReport represents the completion report of an erasure request.
+type ErasureReport struct {
+	Pseudonym string        `json:"pseudonym"`
+	Steps     []ErasureStep `json:"steps"`
+}
+
+// ErasureRequest represents a request to erase a user.
+type ErasureRequest struct {
+	ID          string         `json:"id"`
+	UserID      string         `json:"userId"`
+	Reason      string         `json:"reason,omitempty"`
+	Status      RequestStatus  `json:"status"`
+	RequestedBy string         `json:"requestedBy,omitempty"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+	CompletedAt *time.Time     `json:"completedAt,omitempty"`
+	Report      *ErasureReport `json:"report,omitempty"`
+}
+
+// CreateErasureRequest represents the request body for creating an erasure request.
+type CreateErasureRequest struct {
+	UserID string `json:"userId"`
+	Reason string `json:"reason"`
+}
+
+// ErasureRequestList represents a page of erasure requests.
+type ErasureRequestList struct {
+	TotalResults int              `json:"totalResults"`
+	StartIndex   int              `json:"startIndex"`
+	Count        int              `json:"count"`
+	Requests     []ErasureRequest `json:"requests"`
+	Links        []utils.Link     `json:"links"`
+}
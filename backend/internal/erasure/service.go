@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// maxReasonLength is the maximum length of the reason of an erasure request.
+const maxReasonLength = 1024
+
+// ErasureServiceInterface defines the interface for managing the requests to erase users.
+type ErasureServiceInterface interface {
+	CreateErasureRequest(ctx context.Context, request *CreateErasureRequest) (
+		*ErasureRequest, *serviceerror.ServiceError)
+	GetErasureRequest(ctx context.Context, id string) (*ErasureRequest, *serviceerror.ServiceError)
+	ListErasureRequests(ctx context.Context, limit, offset int) (*ErasureRequestList, *serviceerror.ServiceError)
+}
+
+// erasureService implements ErasureServiceInterface.
+type erasureService struct {
+	store       erasureStoreInterface
+	userService user.UserServiceInterface
+	logger      *log.Logger
+}
+
+// newErasureService returns a new instance of ErasureServiceInterface.
+func newErasureService(store erasureStoreInterface, userService user.UserServiceInterface) ErasureServiceInterface {
+	return &erasureService{
+		store:       store,
+		userService: userService,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ErasureService")),
+	}
+}
+
+// CreateErasureRequest queues a request to erase a user. The user is looked up with the context of the caller,
+// so the caller must be allowed to access the user. The request is processed in the background by the erasure
+// job.
+func (s *erasureService) CreateErasureRequest(ctx context.Context, request *CreateErasureRequest) (
+	*ErasureRequest, *serviceerror.ServiceError) {
+	if request == nil {
+		return nil, &ErrorInvalidRequestFormat
+	}
+	userID := strings.TrimSpace(request.UserID)
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+	reason := strings.TrimSpace(request.Reason)
+	if len(reason) > maxReasonLength {
+		return nil, &ErrorReasonTooLong
+	}
+
+	if _, svcErr := s.userService.GetUser(ctx, userID, false); svcErr != nil {
+		switch svcErr.Code {
+		case user.ErrorUserNotFound.Code, user.ErrorMissingUserID.Code:
+			return nil, &ErrorUserNotFound
+		case serviceerror.ErrorUnauthorized.Code:
+			return nil, svcErr
+		default:
+			s.logger.Error("Failed to retrieve the user to erase", log.MaskedString(log.LoggerKeyUserID, userID),
+				log.String("code", svcErr.Code))
+			return nil, &serviceerror.InternalServerError
+		}
+	}
+
+	active, err := s.store.countActiveErasureRequests(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to check the active erasure requests of the user", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if active > 0 {
+		return nil, &ErrorErasureAlreadyRequested
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID for the erasure request", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	now := time.Now().UTC()
+	erasureRequest := ErasureRequest{
+		ID:          id,
+		UserID:      userID,
+		Reason:      reason,
+		Status:      RequestStatusPending,
+		RequestedBy: security.GetSubject(ctx),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.store.createErasureRequest(ctx, erasureRequest); err != nil {
+		s.logger.Error("Failed to create the erasure request", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Info("Erasure request queued", log.String("requestId", id),
+		log.MaskedString(log.LoggerKeyUserID, userID))
+
+	return &erasureRequest, nil
+}
+
+// GetErasureRequest retrieves an erasure request along with its completion report.
+func (s *erasureService) GetErasureRequest(ctx context.Context, id string) (
+	*ErasureRequest, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorErasureRequestNotFound
+	}
+
+	request, err := s.store.getErasureRequest(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the erasure request", log.String("requestId", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if request == nil {
+		return nil, &ErrorErasureRequestNotFound
+	}
+
+	return request, nil
+}
+
+// ListErasureRequests retrieves a page of the erasure requests, most recent first.
+func (s *erasureService) ListErasureRequests(ctx context.Context, limit, offset int) (
+	*ErasureRequestList, *serviceerror.ServiceError) {
+	if limit <= 0 || limit > constants.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return nil, &ErrorInvalidOffset
+	}
+
+	totalCount, err := s.store.countErasureRequests(ctx)
+	if err != nil {
+		s.logger.Error("Failed to count erasure requests", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	requests, err := s.store.listErasureRequests(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list erasure requests", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &ErasureRequestList{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(requests),
+		Requests:     requests,
+		Links:        sysutils.BuildPaginationLinks(erasureRequestsPath, limit, offset, totalCount, ""),
+	}, nil
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+type ErasureServiceTestSuite struct {
+	suite.Suite
+	mockStore       *erasureStoreInterfaceMock
+	mockUserService *usermock.UserServiceInterfaceMock
+	service         *erasureService
+}
+
+func TestErasureServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ErasureServiceTestSuite))
+}
+
+func (suite *ErasureServiceTestSuite) SetupTest() {
+	suite.mockStore = newErasureStoreInterfaceMock(suite.T())
+	suite.mockUserService = usermock.NewUserServiceInterfaceMock(suite.T())
+	suite.service = &erasureService{
+		store:       suite.mockStore,
+		userService: suite.mockUserService,
+		logger:      log.GetLogger(),
+	}
+}
+
+func (suite *ErasureServiceTestSuite) TestNewErasureService() {
+	suite.NotNil(newErasureService(suite.mockStore, suite.mockUserService))
+}
+
+func (suite *ErasureServiceTestSuite) TestCreateErasureRequest() {
+	ctx := security.WithVerifiedToken(context.Background(), "token", map[string]interface{}{"sub": "admin"})
+	suite.mockUserService.EXPECT().GetUser(ctx, testUserID, false).Return(&user.User{ID: testUserID}, nil).Once()
+	suite.mockStore.EXPECT().countActiveErasureRequests(ctx, testUserID).Return(0, nil).Once()
+	suite.mockStore.EXPECT().createErasureRequest(ctx, mock.MatchedBy(func(r ErasureRequest) bool {
+		return r.ID != "" && r.UserID == testUserID && r.Reason == "Customer request" &&
+			r.Status == RequestStatusPending && !r.CreatedAt.IsZero()
+	})).Return(nil).Once()
+
+	request, err := suite.service.CreateErasureRequest(ctx, &CreateErasureRequest{
+		UserID: " " + testUserID + " ",
+		Reason: "Customer request",
+	})
+	suite.Nil(err)
+	suite.Require().NotNil(request)
+	suite.Equal(RequestStatusPending, request.Status)
+	suite.Equal(testUserID, request.UserID)
+}
+
+func (suite *ErasureServiceTestSuite) TestCreateErasureRequest_InvalidRequest() {
+	testCases := []struct {
+		name         string
+		request      *CreateErasureRequest
+		expectedCode string
+	}{
+		{"NilRequest", nil, ErrorInvalidRequestFormat.Code},
+		{"MissingUserID", &CreateErasureRequest{UserID: " "}, ErrorMissingUserID.Code},
+		{"ReasonTooLong", &CreateErasureRequest{UserID: testUserID, Reason: strings.Repeat("a", 1025)},
+			ErrorReasonTooLong.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			request, err := suite.service.CreateErasureRequest(context.Background(), tc.request)
+			suite.Nil(request)
+			suite.Equal(tc.expectedCode, err.Code)
+		})
+	}
+}
+
+func (suite *ErasureServiceTestSuite) TestCreateErasureRequest_UserErrors() {
+	testCases := []struct {
+		name         string
+		userErr      *serviceerror.ServiceError
+		expectedCode string
+	}{
+		{"UserNotFound", &user.ErrorUserNotFound, ErrorUserNotFound.Code},
+		{"Unauthorized", &serviceerror.ErrorUnauthorized, serviceerror.ErrorUnauthorized.Code},
+		{"ServerError", &serviceerror.InternalServerError, serviceerror.InternalServerError.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockUserService.EXPECT().GetUser(mock.Anything, testUserID, false).Return(nil, tc.userErr).Once()
+
+			request, err := suite.service.CreateErasureRequest(context.Background(),
+				&CreateErasureRequest{UserID: testUserID})
+			suite.Nil(request)
+			suite.Equal(tc.expectedCode, err.Code)
+		})
+	}
+}
+
+func (suite *ErasureServiceTestSuite) TestCreateErasureRequest_AlreadyRequested() {
+	suite.mockUserService.EXPECT().GetUser(mock.Anything, testUserID, false).
+		Return(&user.User{ID: testUserID}, nil).Once()
+	suite.mockStore.EXPECT().countActiveErasureRequests(mock.Anything, testUserID).Return(1, nil).Once()
+
+	request, err := suite.service.CreateErasureRequest(context.Background(), &CreateErasureRequest{UserID: testUserID})
+	suite.Nil(request)
+	suite.Equal(ErrorErasureAlreadyRequested.Code, err.Code)
+}
+
+func (suite *ErasureServiceTestSuite) TestCreateErasureRequest_StoreError() {
+	suite.mockUserService.EXPECT().GetUser(mock.Anything, testUserID, false).
+		Return(&user.User{ID: testUserID}, nil).Once()
+	suite.mockStore.EXPECT().countActiveErasureRequests(mock.Anything, testUserID).Return(0, nil).Once()
+	suite.mockStore.EXPECT().createErasureRequest(mock.Anything, mock.Anything).Return(errors.New("db err")).Once()
+
+	request, err := suite.service.CreateErasureRequest(context.Background(), &CreateErasureRequest{UserID: testUserID})
+	suite.Nil(request)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *ErasureServiceTestSuite) TestGetErasureRequest() {
+	suite.mockStore.EXPECT().getErasureRequest(mock.Anything, testRequestID).
+		Return(&ErasureRequest{ID: testRequestID}, nil).Once()
+
+	request, err := suite.service.GetErasureRequest(context.Background(), testRequestID)
+	suite.Nil(err)
+	suite.Equal(testRequestID, request.ID)
+}
+
+func (suite *ErasureServiceTestSuite) TestGetErasureRequest_Failures() {
+	request, err := suite.service.GetErasureRequest(context.Background(), "")
+	suite.Nil(request)
+	suite.Equal(ErrorErasureRequestNotFound.Code, err.Code)
+
+	suite.mockStore.EXPECT().getErasureRequest(mock.Anything, testRequestID).Return(nil, nil).Once()
+	request, err = suite.service.GetErasureRequest(context.Background(), testRequestID)
+	suite.Nil(request)
+	suite.Equal(ErrorErasureRequestNotFound.Code, err.Code)
+
+	suite.mockStore.EXPECT().getErasureRequest(mock.Anything, testRequestID).Return(nil, errors.New("db err")).Once()
+	request, err = suite.service.GetErasureRequest(context.Background(), testRequestID)
+	suite.Nil(request)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *ErasureServiceTestSuite) TestListErasureRequests() {
+	suite.mockStore.EXPECT().countErasureRequests(mock.Anything).Return(3, nil).Once()
+	suite.mockStore.EXPECT().listErasureRequests(mock.Anything, 2, 0).
+		Return([]ErasureRequest{{ID: "request-1"}, {ID: "request-2"}}, nil).Once()
+
+	result, err := suite.service.ListErasureRequests(context.Background(), 2, 0)
+	suite.Nil(err)
+	suite.Equal(3, result.TotalResults)
+	suite.Equal(1, result.StartIndex)
+	suite.Equal(2, result.Count)
+	suite.NotEmpty(result.Links)
+}
+
+func (suite *ErasureServiceTestSuite) TestListErasureRequests_Failures() {
+	result, err := suite.service.ListErasureRequests(context.Background(), 0, 0)
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidLimit.Code, err.Code)
+
+	result, err = suite.service.ListErasureRequests(context.Background(), 10, -1)
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidOffset.Code, err.Code)
+
+	suite.mockStore.EXPECT().countErasureRequests(mock.Anything).Return(0, errors.New("db err")).Once()
+	result, err = suite.service.ListErasureRequests(context.Background(), 10, 0)
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// erasureStoreInterface defines the interface for erasure request storage operations.
+type erasureStoreInterface interface {
+	createErasureRequest(ctx context.Context, request ErasureRequest) error
+	getErasureRequest(ctx context.Context, id string) (*ErasureRequest, error)
+	countErasureRequests(ctx context.Context) (int, error)
+	listErasureRequests(ctx context.Context, limit, offset int) ([]ErasureRequest, error)
+	countActiveErasureRequests(ctx context.Context, userID string) (int, error)
+	listQueuedErasureRequests(ctx context.Context, staleBefore time.Time, limit int) ([]ErasureRequest, error)
+	claimErasureRequest(ctx context.Context, id string, now, staleBefore time.Time) (bool, error)
+	completeErasureRequest(ctx context.Context, id string, status RequestStatus, report ErasureReport,
+		completedAt time.Time) error
+}
+
+// erasureStore is the user database implementation of erasureStoreInterface.
+type erasureStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newErasureStore returns a new instance of erasureStoreInterface.
+func newErasureStore() erasureStoreInterface {
+	return &erasureStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createErasureRequest stores a new erasure request.
+func (s *erasureStore) createErasureRequest(ctx context.Context, request ErasureRequest) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateErasureRequest, request.ID, request.UserID,
+		dbutils.ToNullableString(request.Reason), string(request.Status), dbutils.ToNullableString(request.RequestedBy),
+		request.CreatedAt, request.UpdatedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// getErasureRequest retrieves an erasure request by its ID. It returns nil if the request does not exist.
+func (s *erasureStore) getErasureRequest(ctx context.Context, id string) (*ErasureRequest, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetErasureRequest, id, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	return buildErasureRequestFromResultRow(results[0])
+}
+
+// countErasureRequests returns the number of erasure requests.
+func (s *erasureStore) countErasureRequests(ctx context.Context) (int, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountErasureRequests, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return parseTotal(results)
+}
+
+// listErasureRequests retrieves a page of the erasure requests, most recent first.
+func (s *erasureStore) listErasureRequests(ctx context.Context, limit, offset int) ([]ErasureRequest, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListErasureRequests, s.deploymentID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return buildErasureRequestsFromResultRows(results)
+}
+
+// countActiveErasureRequests returns the number of erasure requests of a user that are pending or in progress.
+func (s *erasureStore) countActiveErasureRequests(ctx context.Context, userID string) (int, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountActiveErasureRequests, userID, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return parseTotal(results)
+}
+
+// listQueuedErasureRequests retrieves the erasure requests waiting to be processed, oldest first. Requests
+// left in progress before staleBefore are included.
+func (s *erasureStore) listQueuedErasureRequests(ctx context.Context, staleBefore time.Time, limit int) (
+	[]ErasureRequest, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListQueuedErasureRequests, s.deploymentID, staleBefore,
+		limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return buildErasureRequestsFromResultRows(results)
+}
+
+// claimErasureRequest marks an erasure request as in progress. It returns false if the request was claimed by
+// another node or is no longer waiting to be processed.
+func (s *erasureStore) claimErasureRequest(ctx context.Context, id string, now, staleBefore time.Time) (
+	bool, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryClaimErasureRequest, id, now, staleBefore, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// completeErasureRequest records the final status and the completion report of an erasure request.
+func (s *erasureStore) completeErasureRequest(ctx context.Context, id string, status RequestStatus,
+	report ErasureReport, completedAt time.Time) error {
+	reportJSON, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the erasure report: %w", err)
+	}
+
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCompleteErasureRequest, id, string(status), string(reportJSON),
+		completedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildErasureRequestsFromResultRows constructs the erasure requests from database result rows.
+func buildErasureRequestsFromResultRows(results []map[string]interface{}) ([]ErasureRequest, error) {
+	requests := make([]ErasureRequest, 0, len(results))
+	for _, row := range results {
+		request, err := buildErasureRequestFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *request)
+	}
+
+	return requests, nil
+}
+
+// buildErasureRequestFromResultRow constructs an ErasureRequest from a database result row.
+func buildErasureRequestFromResultRow(row map[string]interface{}) (*ErasureRequest, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse user_id as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse status as string")
+	}
+
+	// Optional columns may be NULL.
+	reason, _ := row["reason"].(string)
+	requestedBy, _ := row["requested_by"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := dbutils.ParseTimeField(row["updated_at"], "updated_at")
+	if err != nil {
+		return nil, err
+	}
+
+	request := &ErasureRequest{
+		ID:          id,
+		UserID:      userID,
+		Reason:      reason,
+		Status:      RequestStatus(status),
+		RequestedBy: requestedBy,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+
+	if row["completed_at"] != nil {
+		completedAt, err := dbutils.ParseTimeField(row["completed_at"], "completed_at")
+		if err != nil {
+			return nil, err
+		}
+		request.CompletedAt = &completedAt
+	}
+
+	var reportJSON []byte
+	switch v := row["report"].(type) {
+	case string:
+		reportJSON = []byte(v)
+	case []byte:
+		reportJSON = v
+	}
+	if len(reportJSON) > 0 {
+		var report ErasureReport
+		if err := json.Unmarshal(reportJSON, &report); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal report: %w", err)
+		}
+		request.Report = &report
+	}
+
+	return request, nil
+}
+
+// parseTotal parses the total of a count query.
+func parseTotal(results []map[string]interface{}) (int, error) {
+	if len(results) == 0 {
+		return 0, nil
+	}
+
+	switch v := results[0]["total"].(type) {
+	case int64:
+		return int(v), nil
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected type for total: %T", v)
+	}
+}
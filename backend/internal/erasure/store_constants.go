@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+// erasureRequestColumns lists the columns selected when retrieving erasure requests.
+const erasureRequestColumns = `ID, USER_ID, REASON, STATUS, REQUESTED_BY, REPORT, CREATED_AT, UPDATED_AT, ` +
+	`COMPLETED_AT`
+
+var (
+	// queryCreateErasureRequest is the query to create an erasure request.
+	queryCreateErasureRequest = dbmodel.DBQuery{
+		ID: "ERQ-01",
+		Query: `INSERT INTO "ERASURE_REQUEST" ` +
+			`(ID, USER_ID, REASON, STATUS, REQUESTED_BY, CREATED_AT, UPDATED_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+
+	// queryGetErasureRequest is the query to get an erasure request by its ID.
+	queryGetErasureRequest = dbmodel.DBQuery{
+		ID: "ERQ-02",
+		Query: `SELECT ` + erasureRequestColumns + ` FROM "ERASURE_REQUEST" ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCountErasureRequests is the query to count the erasure requests.
+	queryCountErasureRequests = dbmodel.DBQuery{
+		ID:    "ERQ-03",
+		Query: `SELECT COUNT(*) as total FROM "ERASURE_REQUEST" WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryListErasureRequests is the query to list a page of the erasure requests, most recent first.
+	queryListErasureRequests = dbmodel.DBQuery{
+		ID: "ERQ-04",
+		Query: `SELECT ` + erasureRequestColumns + ` FROM "ERASURE_REQUEST" WHERE DEPLOYMENT_ID = $1 ` +
+			`ORDER BY CREATED_AT DESC LIMIT $2 OFFSET $3`,
+	}
+
+	// queryCountActiveErasureRequests is the query to count the erasure requests of a user that are pending or
+	// in progress.
+	queryCountActiveErasureRequests = dbmodel.DBQuery{
+		ID: "ERQ-05",
+		Query: `SELECT COUNT(*) as total FROM "ERASURE_REQUEST" WHERE USER_ID = $1 ` +
+			`AND STATUS IN ('PENDING', 'IN_PROGRESS') AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryListQueuedErasureRequests is the query to list the erasure requests waiting to be processed, oldest
+	// first. Requests left in progress before the given time are included so that they are resumed.
+	queryListQueuedErasureRequests = dbmodel.DBQuery{
+		ID: "ERQ-06",
+		Query: `SELECT ` + erasureRequestColumns + ` FROM "ERASURE_REQUEST" WHERE DEPLOYMENT_ID = $1 ` +
+			`AND (STATUS = 'PENDING' OR (STATUS = 'IN_PROGRESS' AND UPDATED_AT < $2)) ` +
+			`ORDER BY CREATED_AT LIMIT $3`,
+	}
+
+	// queryClaimErasureRequest is the query to mark an erasure request as in progress. The request is only
+	// updated if it is still waiting to be processed, so that it is processed by a single node.
+	queryClaimErasureRequest = dbmodel.DBQuery{
+		ID: "ERQ-07",
+		Query: `UPDATE "ERASURE_REQUEST" SET STATUS = 'IN_PROGRESS', UPDATED_AT = $2 ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $4 ` +
+			`AND (STATUS = 'PENDING' OR (STATUS = 'IN_PROGRESS' AND UPDATED_AT < $3))`,
+	}
+
+	// queryCompleteErasureRequest is the query to record the outcome of an erasure request.
+	queryCompleteErasureRequest = dbmodel.DBQuery{
+		ID: "ERQ-08",
+		Query: `UPDATE "ERASURE_REQUEST" SET STATUS = $2, REPORT = $3, UPDATED_AT = $4, COMPLETED_AT = $4 ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $5`,
+	}
+)
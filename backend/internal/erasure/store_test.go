@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package erasure
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment"
+	testRequestID    = "request-1"
+	testUserID       = "user-1"
+)
+
+type ErasureStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *erasureStore
+}
+
+func TestErasureStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ErasureStoreTestSuite))
+}
+
+func (suite *ErasureStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &erasureStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *ErasureStoreTestSuite) TestCreateErasureRequest() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateErasureRequest, testRequestID,
+		testUserID, nil, "PENDING", "admin", createdAt, createdAt, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createErasureRequest(context.Background(), ErasureRequest{
+		ID:          testRequestID,
+		UserID:      testUserID,
+		Status:      RequestStatusPending,
+		RequestedBy: "admin",
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	})
+	suite.NoError(err)
+}
+
+func (suite *ErasureStoreTestSuite) TestCreateErasureRequest_DBClientError() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createErasureRequest(context.Background(), ErasureRequest{ID: testRequestID})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *ErasureStoreTestSuite) TestGetErasureRequest() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetErasureRequest, testRequestID,
+		testDeploymentID).Return([]map[string]interface{}{{
+		"id":           testRequestID,
+		"user_id":      testUserID,
+		"reason":       "Customer request",
+		"status":       "COMPLETED",
+		"requested_by": "admin",
+		"report": `{"pseudonym":"erased-1","steps":[{"store":"profile","action":"DELETED",` +
+			`"status":"SUCCESS","count":1}]}`,
+		"created_at":   "2026-01-02 03:04:05",
+		"updated_at":   "2026-01-02 03:05:05",
+		"completed_at": time.Date(2026, 1, 2, 3, 5, 5, 0, time.UTC),
+	}}, nil).Once()
+
+	request, err := suite.store.getErasureRequest(context.Background(), testRequestID)
+	suite.Require().NoError(err)
+	suite.Equal(testUserID, request.UserID)
+	suite.Equal(RequestStatusCompleted, request.Status)
+	suite.Equal("Customer request", request.Reason)
+	suite.Require().NotNil(request.CompletedAt)
+	suite.Require().NotNil(request.Report)
+	suite.Equal("erased-1", request.Report.Pseudonym)
+	suite.Equal(StepStatusSuccess, request.Report.Steps[0].Status)
+}
+
+func (suite *ErasureStoreTestSuite) TestGetErasureRequest_NotFound() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(mock.Anything, queryGetErasureRequest, testRequestID,
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	request, err := suite.store.getErasureRequest(context.Background(), testRequestID)
+	suite.NoError(err)
+	suite.Nil(request)
+}
+
+func (suite *ErasureStoreTestSuite) TestGetErasureRequest_InvalidRow() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(mock.Anything, queryGetErasureRequest, testRequestID,
+		testDeploymentID).Return([]map[string]interface{}{{"id": testRequestID}}, nil).Once()
+
+	request, err := suite.store.getErasureRequest(context.Background(), testRequestID)
+	suite.Error(err)
+	suite.Nil(request)
+}
+
+func (suite *ErasureStoreTestSuite) TestListErasureRequests() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Twice()
+	suite.mockDBClient.EXPECT().QueryContext(mock.Anything, queryCountErasureRequests, testDeploymentID).
+		Return([]map[string]interface{}{{"total": int64(1)}}, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(mock.Anything, queryListErasureRequests, testDeploymentID, 10, 0).
+		Return([]map[string]interface{}{{
+			"id":         testRequestID,
+			"user_id":    testUserID,
+			"status":     "PENDING",
+			"created_at": "2026-01-02 03:04:05",
+			"updated_at": "2026-01-02 03:04:05",
+		}}, nil).Once()
+
+	count, err := suite.store.countErasureRequests(context.Background())
+	suite.NoError(err)
+	suite.Equal(1, count)
+
+	requests, err := suite.store.listErasureRequests(context.Background(), 10, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(requests, 1)
+	suite.Nil(requests[0].Report)
+	suite.Nil(requests[0].CompletedAt)
+}
+
+func (suite *ErasureStoreTestSuite) TestCountActiveErasureRequests() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(mock.Anything, queryCountActiveErasureRequests, testUserID,
+		testDeploymentID).Return([]map[string]interface{}{{"total": int64(2)}}, nil).Once()
+
+	count, err := suite.store.countActiveErasureRequests(context.Background(), testUserID)
+	suite.NoError(err)
+	suite.Equal(2, count)
+}
+
+func (suite *ErasureStoreTestSuite) TestListQueuedErasureRequests() {
+	staleBefore := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(mock.Anything, queryListQueuedErasureRequests, testDeploymentID,
+		staleBefore, 5).Return(nil, errors.New("db err")).Once()
+
+	requests, err := suite.store.listQueuedErasureRequests(context.Background(), staleBefore, 5)
+	suite.Error(err)
+	suite.Nil(requests)
+	suite.Contains(err.Error(), "failed to execute query")
+}
+
+func (suite *ErasureStoreTestSuite) TestClaimErasureRequest() {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	staleBefore := now.Add(-time.Hour)
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Twice()
+	suite.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryClaimErasureRequest, testRequestID, now,
+		staleBefore, testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryClaimErasureRequest, testRequestID, now,
+		staleBefore, testDeploymentID).Return(int64(0), nil).Once()
+
+	claimed, err := suite.store.claimErasureRequest(context.Background(), testRequestID, now, staleBefore)
+	suite.NoError(err)
+	suite.True(claimed)
+
+	claimed, err = suite.store.claimErasureRequest(context.Background(), testRequestID, now, staleBefore)
+	suite.NoError(err)
+	suite.False(claimed)
+}
+
+func (suite *ErasureStoreTestSuite) TestCompleteErasureRequest() {
+	completedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	report := ErasureReport{
+		Pseudonym: "erased-1",
+		Steps:     []ErasureStep{{Store: storeProfile, Action: StepActionDeleted, Status: StepStatusSuccess, Count: 1}},
+	}
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryCompleteErasureRequest, testRequestID,
+		"COMPLETED", `{"pseudonym":"erased-1","steps":[{"store":"profile","action":"DELETED","status":"SUCCESS",`+
+			`"count":1}]}`, completedAt, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.completeErasureRequest(context.Background(), testRequestID, RequestStatusCompleted, report,
+		completedAt)
+	suite.NoError(err)
+}
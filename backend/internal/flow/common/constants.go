@@ -33,6 +33,8 @@ const (
 	FlowTypeUserOnboarding FlowType = "USER_ONBOARDING"
 	// FlowTypeRecovery represents a flow execution for account recovery (e.g., password reset).
 	FlowTypeRecovery FlowType = "RECOVERY"
+	// FlowTypeMFAEnrollment represents a flow execution for enrolling second factors for an authenticated user.
+	FlowTypeMFAEnrollment FlowType = "MFA_ENROLLMENT"
 )
 
 // FlowStatus defines the status of a flow execution.
@@ -69,6 +71,28 @@ const (
 	NodeTypeTaskExecution NodeType = "TASK_EXECUTION"
 	// NodeTypePrompt represents a prompt node
 	NodeTypePrompt NodeType = "PROMPT"
+	// NodeTypeDecision represents a decision node that selects its outgoing edge by evaluating conditions
+	NodeTypeDecision NodeType = "DECISION"
+	// NodeTypeFork represents a fork node that executes its branches concurrently
+	NodeTypeFork NodeType = "FORK"
+	// NodeTypeJoin represents the node where the branches of a fork node join (representation node)
+	NodeTypeJoin NodeType = "JOIN"
+)
+
+// ConditionOperator defines the operators supported in decision node branch conditions.
+type ConditionOperator string
+
+const (
+	// ConditionOperatorEquals matches when the resolved key equals the value.
+	ConditionOperatorEquals ConditionOperator = "equals"
+	// ConditionOperatorNotEquals matches when the resolved key does not equal the value.
+	ConditionOperatorNotEquals ConditionOperator = "notEquals"
+	// ConditionOperatorIn matches when the resolved key equals one of the comma separated values.
+	ConditionOperatorIn ConditionOperator = "in"
+	// ConditionOperatorExists matches when the key resolves to a non-empty value.
+	ConditionOperatorExists ConditionOperator = "exists"
+	// ConditionOperatorNotExists matches when the key does not resolve to a non-empty value.
+	ConditionOperatorNotExists ConditionOperator = "notExists"
 )
 
 // NodeStatus defines the status of a node in the flow execution.
@@ -131,6 +155,9 @@ const (
 	DataIDPName = "idpName"
 	// DataConsentPrompt is the key used for the consent prompt data in the flow response.
 	DataConsentPrompt = "consentPrompt"
+	// DataConsentScopes is the key used for the descriptions of the requested scopes shown on the
+	// consent prompt.
+	DataConsentScopes = "consentScopes"
 	// DataStepTimeout is the key used for the step expiry timestamp in the flow response.
 	DataStepTimeout = "stepTimeout"
 	// DataInviteLink is the key used for the invite link in the flow response additional data.
@@ -143,6 +170,8 @@ const (
 	DataRootOUID = "rootOuId"
 	// DataPromptMessage is the key used to pass a message to be displayed in the prompt node.
 	DataPromptMessage = "message"
+	// DataOrganizationIDPs is the key used to pass the selectable identity providers of an organization.
+	DataOrganizationIDPs = "organizationIdps"
 )
 
 // DefaultHTTPTimeout defines the default timeout duration for HTTP requests.
@@ -183,6 +212,8 @@ const (
 	RuntimeKeySkipProvisioning = "skipProvisioning"
 	// RuntimeKeyClientID holds the OAuth client ID for the current flow execution, if applicable.
 	RuntimeKeyClientID = "clientId"
+	// RuntimeKeyRequestedScopes holds the space-separated OIDC scopes requested by the OAuth client.
+	RuntimeKeyRequestedScopes = "requested_scopes"
 	// RuntimeKeyRequestedPermissions holds the space-separated permission scopes requested by the OAuth client.
 	RuntimeKeyRequestedPermissions = "requested_permissions"
 	// RuntimeKeyRequiredEssentialAttributes holds the space-separated essential user attributes required for the flow.
@@ -227,8 +258,18 @@ const (
 	// RuntimeKeySMSOTPPhoneAttr holds the schema attribute name used to look up the mobile number.
 	// TODO: Revisit when the generic OTP executor is implemented.
 	RuntimeKeySMSOTPPhoneAttr = "smsOTPPhoneAttr"
+	// RuntimeKeySMSOTPVerifyAttemptCount holds the number of failed verification attempts of the last SMS OTP sent.
+	RuntimeKeySMSOTPVerifyAttemptCount = "smsOTPVerifyAttemptCount"
 	// RuntimeKeyMagicLinkUsedJti is the JWT ID claim value of a magic link token that has already been used.
 	RuntimeKeyMagicLinkUsedJti = "magicLinkUsedJti"
+	// RuntimeKeyTOTPEnrollmentToken holds the encrypted TOTP secret of an enrollment awaiting confirmation.
+	RuntimeKeyTOTPEnrollmentToken = "totpEnrollmentToken"
+	// RuntimeKeyRequireMFA is set to "true" by an adaptive authentication script requiring an additional factor.
+	RuntimeKeyRequireMFA = "requireMfa"
+	// RuntimeKeyAdaptiveClaims holds the JSON encoded claims set by adaptive authentication scripts.
+	RuntimeKeyAdaptiveClaims = "adaptiveClaims"
+	// RuntimeKeyFailedAttemptsPrefix prefixes the ID of a node with a retry limit to hold its failed attempt count.
+	RuntimeKeyFailedAttemptsPrefix = "failedAttempts_"
 	// RuntimeKeyOAuthState holds the generated OAuth state parameter for CSRF validation.
 	RuntimeKeyOAuthState = "oauthState"
 	// RuntimeKeyRequestedAuthClasses holds the space-separated ACR values from acr_values.
@@ -237,6 +278,18 @@ const (
 	RuntimeKeySelectedAuthClass = "selected_auth_class"
 	// RuntimeKeyAllowedLoginOptions holds the space-separated action refs allowed on a LOGIN_OPTIONS node.
 	RuntimeKeyAllowedLoginOptions = "allowed_login_options"
+	// RuntimeKeyRequestedOrganization holds the organization handle or ID requested by the OAuth client.
+	RuntimeKeyRequestedOrganization = "requested_organization"
+	// RuntimeKeyOrganizationID holds the ID of the organization resolved for the current login.
+	RuntimeKeyOrganizationID = "organization_id"
+	// RuntimeKeyOrganizationOUID holds the ID of the organization unit backing the resolved organization.
+	RuntimeKeyOrganizationOUID = "organization_ou_id"
+	// RuntimeKeyOrganizationIDPs holds the space-separated identity provider IDs of the resolved organization.
+	RuntimeKeyOrganizationIDPs = "organization_idps"
+	// RuntimeKeyOrganizationRequireIDP indicates whether the resolved organization requires federated login.
+	RuntimeKeyOrganizationRequireIDP = "organization_require_idp"
+	// RuntimeKeyOrganizationIDP holds the identity provider ID selected for the resolved organization.
+	RuntimeKeyOrganizationIDP = "organization_idp"
 )
 
 // TODO: Define a go type for InputType when formalizing input types
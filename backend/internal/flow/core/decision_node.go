@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// failureReasonNoDecisionBranch is the failure reason returned when no branch of a decision node matches
+// and no default branch is configured.
+const failureReasonNoDecisionBranch = "no matching decision branch found"
+
+// userAttributePattern matches {{ user.attribute }} with optional whitespace.
+var userAttributePattern = regexp.MustCompile(`^{{\s*user\.\s*(\w+)\s*}}$`)
+
+// DecisionNodeInterface extends NodeInterface for decision nodes.
+// Decision nodes select their outgoing edge by evaluating branch conditions over the flow context,
+// falling back to the onSuccess node when no branch matches.
+type DecisionNodeInterface interface {
+	NodeInterface
+	GetBranches() []DecisionBranch
+	SetBranches(branches []DecisionBranch)
+	GetOnSuccess() string
+	SetOnSuccess(nodeID string)
+}
+
+// decisionNode implements the DecisionNodeInterface
+type decisionNode struct {
+	*node
+	branches  []DecisionBranch
+	onSuccess string
+}
+
+// Ensure decisionNode implements DecisionNodeInterface
+var _ DecisionNodeInterface = (*decisionNode)(nil)
+
+// newDecisionNode creates a new decision node with the given details.
+func newDecisionNode(id string, properties map[string]interface{}, isStartNode bool,
+	isFinalNode bool) NodeInterface {
+	return &decisionNode{
+		node: &node{
+			id:               id,
+			_type:            common.NodeTypeDecision,
+			properties:       properties,
+			isStartNode:      isStartNode,
+			isFinalNode:      isFinalNode,
+			nextNodeList:     []string{},
+			previousNodeList: []string{},
+		},
+		branches: []DecisionBranch{},
+	}
+}
+
+// Execute evaluates the branches in order and sets the next node to the first matching branch.
+// If no branch matches, the onSuccess node is used as the default branch.
+func (n *decisionNode) Execute(ctx *NodeContext) (*common.NodeResponse, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DecisionNode"),
+		log.String(log.LoggerKeyNodeID, n.id), log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	response := &common.NodeResponse{
+		Status:         common.NodeStatusComplete,
+		RuntimeData:    make(map[string]string),
+		AdditionalData: make(map[string]string),
+	}
+
+	for i, branch := range n.branches {
+		if evaluateBranchCondition(ctx, branch.Condition) {
+			logger.Debug("Decision branch matched", log.Int("branchIndex", i),
+				log.String("nextNodeID", branch.NextNodeID))
+			response.NextNodeID = branch.NextNodeID
+			return response, nil
+		}
+	}
+
+	if n.onSuccess == "" {
+		logger.Debug("No decision branch matched and no default branch configured")
+		response.Status = common.NodeStatusFailure
+		response.FailureReason = failureReasonNoDecisionBranch
+		return response, nil
+	}
+
+	logger.Debug("No decision branch matched; using the default branch", log.String("nextNodeID", n.onSuccess))
+	response.NextNodeID = n.onSuccess
+	return response, nil
+}
+
+// GetBranches returns the branches of the decision node
+func (n *decisionNode) GetBranches() []DecisionBranch {
+	return n.branches
+}
+
+// SetBranches sets the branches of the decision node
+func (n *decisionNode) SetBranches(branches []DecisionBranch) {
+	if branches == nil {
+		n.branches = []DecisionBranch{}
+	} else {
+		n.branches = branches
+	}
+}
+
+// GetOnSuccess returns the default node ID used when no branch matches
+func (n *decisionNode) GetOnSuccess() string {
+	return n.onSuccess
+}
+
+// SetOnSuccess sets the default node ID used when no branch matches
+func (n *decisionNode) SetOnSuccess(nodeID string) {
+	n.onSuccess = nodeID
+}
+
+// IsValidConditionOperator checks whether the given operator is supported in branch conditions.
+// An empty operator is valid and defaults to equals.
+func IsValidConditionOperator(operator common.ConditionOperator) bool {
+	switch operator {
+	case "", common.ConditionOperatorEquals, common.ConditionOperatorNotEquals, common.ConditionOperatorIn,
+		common.ConditionOperatorExists, common.ConditionOperatorNotExists:
+		return true
+	default:
+		return false
+	}
+}
+
+// evaluateBranchCondition resolves the condition key against the node context and evaluates the condition.
+func evaluateBranchCondition(ctx *NodeContext, condition BranchCondition) bool {
+	resolved := resolveConditionKey(ctx, condition.Key)
+
+	switch condition.Operator {
+	case "", common.ConditionOperatorEquals:
+		return resolved == condition.Value
+	case common.ConditionOperatorNotEquals:
+		return resolved != condition.Value
+	case common.ConditionOperatorIn:
+		for _, value := range strings.Split(condition.Value, ",") {
+			if resolved == strings.TrimSpace(value) {
+				return true
+			}
+		}
+		return false
+	case common.ConditionOperatorExists:
+		return resolved != ""
+	case common.ConditionOperatorNotExists:
+		return resolved == ""
+	default:
+		return false
+	}
+}
+
+// resolveConditionKey resolves a condition key to its value. "{{ user.attribute }}" keys are resolved from
+// the authenticated user's attributes, other placeholders are resolved with ResolvePlaceholder. Unresolved
+// placeholders are treated as empty values.
+func resolveConditionKey(ctx *NodeContext, key string) string {
+	if submatches := userAttributePattern.FindStringSubmatch(key); len(submatches) == 2 {
+		value, ok := ctx.AuthenticatedUser.Attributes[submatches[1]]
+		if !ok || value == nil {
+			return ""
+		}
+		return fmt.Sprint(value)
+	}
+
+	resolved := ResolvePlaceholder(ctx, key)
+	if placeholderPattern.MatchString(resolved) {
+		return ""
+	}
+	return resolved
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+)
+
+type DecisionNodeTestSuite struct {
+	suite.Suite
+}
+
+func TestDecisionNodeTestSuite(t *testing.T) {
+	suite.Run(t, new(DecisionNodeTestSuite))
+}
+
+func (s *DecisionNodeTestSuite) newDecisionNode(branches []DecisionBranch, onSuccess string) DecisionNodeInterface {
+	node := newDecisionNode("decision", nil, false, false)
+	decisionNode, ok := node.(DecisionNodeInterface)
+	s.True(ok)
+	decisionNode.SetBranches(branches)
+	decisionNode.SetOnSuccess(onSuccess)
+	return decisionNode
+}
+
+func (s *DecisionNodeTestSuite) TestNewDecisionNode() {
+	node := newDecisionNode("decision", map[string]interface{}{"key": "value"}, false, false)
+
+	s.Equal("decision", node.GetID())
+	s.Equal(common.NodeTypeDecision, node.GetType())
+	s.False(node.IsStartNode())
+	s.False(node.IsFinalNode())
+	s.Equal("value", node.GetProperties()["key"])
+
+	decisionNode, ok := node.(DecisionNodeInterface)
+	s.True(ok)
+	s.Empty(decisionNode.GetBranches())
+	s.Empty(decisionNode.GetOnSuccess())
+}
+
+func (s *DecisionNodeTestSuite) TestSetBranchesNil() {
+	node := s.newDecisionNode(nil, "")
+
+	s.NotNil(node.GetBranches())
+	s.Empty(node.GetBranches())
+}
+
+func (s *DecisionNodeTestSuite) TestExecuteFirstMatchingBranch() {
+	node := s.newDecisionNode([]DecisionBranch{
+		{Condition: BranchCondition{Key: "{{ context.userType }}", Value: "employee"}, NextNodeID: "employee"},
+		{Condition: BranchCondition{Key: "{{ context.userType }}", Value: "customer"}, NextNodeID: "customer"},
+		{Condition: BranchCondition{Key: "{{ context.userType }}", Operator: common.ConditionOperatorExists},
+			NextNodeID: "other"},
+	}, "default")
+
+	resp, err := node.Execute(&NodeContext{
+		ExecutionID: "test-flow",
+		RuntimeData: map[string]string{"userType": "customer"},
+	})
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("customer", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecuteDefaultBranch() {
+	node := s.newDecisionNode([]DecisionBranch{
+		{Condition: BranchCondition{Key: "{{ context.userType }}", Value: "employee"}, NextNodeID: "employee"},
+	}, "default")
+
+	resp, err := node.Execute(&NodeContext{
+		ExecutionID: "test-flow",
+		RuntimeData: map[string]string{"userType": "customer"},
+	})
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("default", resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestExecuteNoMatchWithoutDefault() {
+	node := s.newDecisionNode([]DecisionBranch{
+		{Condition: BranchCondition{Key: "{{ context.userType }}", Value: "employee"}, NextNodeID: "employee"},
+	}, "")
+
+	resp, err := node.Execute(&NodeContext{ExecutionID: "test-flow"})
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusFailure, resp.Status)
+	s.Equal(failureReasonNoDecisionBranch, resp.FailureReason)
+	s.Empty(resp.NextNodeID)
+}
+
+func (s *DecisionNodeTestSuite) TestEvaluateBranchCondition() {
+	ctx := &NodeContext{
+		RuntimeData: map[string]string{"userType": "customer"},
+		UserInputs:  map[string]string{"action": "signup"},
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			Attributes: map[string]interface{}{"country": "LK", "age": 30},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		condition BranchCondition
+		expected  bool
+	}{
+		{"EqualsRuntimeData", BranchCondition{Key: "{{ context.userType }}", Value: "customer"}, true},
+		{"EqualsUserInput", BranchCondition{Key: "{{context.action}}",
+			Operator: common.ConditionOperatorEquals, Value: "signup"}, true},
+		{"EqualsUserAttribute", BranchCondition{Key: "{{ user.country }}", Value: "LK"}, true},
+		{"EqualsNonStringUserAttribute", BranchCondition{Key: "{{ user.age }}", Value: "30"}, true},
+		{"EqualsLiteral", BranchCondition{Key: "fixed", Value: "fixed"}, true},
+		{"NotEquals", BranchCondition{Key: "{{ context.userType }}",
+			Operator: common.ConditionOperatorNotEquals, Value: "employee"}, true},
+		{"NotEqualsSameValue", BranchCondition{Key: "{{ context.userType }}",
+			Operator: common.ConditionOperatorNotEquals, Value: "customer"}, false},
+		{"In", BranchCondition{Key: "{{ user.country }}",
+			Operator: common.ConditionOperatorIn, Value: "US, LK"}, true},
+		{"NotIn", BranchCondition{Key: "{{ user.country }}",
+			Operator: common.ConditionOperatorIn, Value: "US,UK"}, false},
+		{"Exists", BranchCondition{Key: "{{ context.userType }}",
+			Operator: common.ConditionOperatorExists}, true},
+		{"ExistsUnresolved", BranchCondition{Key: "{{ context.missing }}",
+			Operator: common.ConditionOperatorExists}, false},
+		{"ExistsMissingUserAttribute", BranchCondition{Key: "{{ user.missing }}",
+			Operator: common.ConditionOperatorExists}, false},
+		{"NotExistsUnresolved", BranchCondition{Key: "{{ context.missing }}",
+			Operator: common.ConditionOperatorNotExists}, true},
+		{"NotExistsResolved", BranchCondition{Key: "{{ context.userType }}",
+			Operator: common.ConditionOperatorNotExists}, false},
+		{"UnsupportedOperator", BranchCondition{Key: "{{ context.userType }}",
+			Operator: "contains", Value: "customer"}, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, evaluateBranchCondition(ctx, tc.condition))
+		})
+	}
+}
+
+func (s *DecisionNodeTestSuite) TestIsValidConditionOperator() {
+	s.True(IsValidConditionOperator(""))
+	s.True(IsValidConditionOperator(common.ConditionOperatorEquals))
+	s.True(IsValidConditionOperator(common.ConditionOperatorNotEquals))
+	s.True(IsValidConditionOperator(common.ConditionOperatorIn))
+	s.True(IsValidConditionOperator(common.ConditionOperatorExists))
+	s.True(IsValidConditionOperator(common.ConditionOperatorNotExists))
+	s.False(IsValidConditionOperator("contains"))
+}
@@ -62,7 +62,11 @@ func (f *flowFactory) CreateNode(id, _type string, properties map[string]interfa
 		return newTaskExecutionNode(id, properties, isStartNode, isFinalNode), nil
 	case common.NodeTypePrompt:
 		return newPromptNode(id, properties, isStartNode, isFinalNode), nil
-	case common.NodeTypeStart, common.NodeTypeEnd:
+	case common.NodeTypeDecision:
+		return newDecisionNode(id, properties, isStartNode, isFinalNode), nil
+	case common.NodeTypeFork:
+		return newForkNode(id, properties, isStartNode, isFinalNode), nil
+	case common.NodeTypeStart, common.NodeTypeEnd, common.NodeTypeJoin:
 		return newRepresentationNode(id, nodeType, properties, isStartNode, isFinalNode), nil
 	default:
 		return nil, errors.New("unsupported node type: " + _type)
@@ -130,14 +134,34 @@ func (f *flowFactory) CloneNode(source NodeInterface) (NodeInterface, error) {
 		})
 	}
 
-	// Copy onSuccess for representation nodes (START/END)
+	// Copy onSuccess for representation nodes (START/END/JOIN)
 	if repSource, ok := source.(RepresentationNodeInterface); ok {
 		if repCopy, ok := nodeCopy.(RepresentationNodeInterface); ok {
 			repCopy.SetOnSuccess(repSource.GetOnSuccess())
 		}
 	}
 
-	// Copy executor name, inputs, onSuccess, and onFailure if the node is executor-backed
+	// Copy branches and the default branch for decision nodes
+	if decisionSource, ok := source.(DecisionNodeInterface); ok {
+		if decisionCopy, ok := nodeCopy.(DecisionNodeInterface); ok {
+			decisionCopy.SetBranches(append([]DecisionBranch{}, decisionSource.GetBranches()...))
+			decisionCopy.SetOnSuccess(decisionSource.GetOnSuccess())
+		} else {
+			return nil, errors.New("mismatch in node types during cloning. copy is not a decision node")
+		}
+	}
+
+	// Copy branches and the join node for fork nodes
+	if forkSource, ok := source.(ForkNodeInterface); ok {
+		if forkCopy, ok := nodeCopy.(ForkNodeInterface); ok {
+			forkCopy.SetBranches(append([]string{}, forkSource.GetBranches()...))
+			forkCopy.SetOnSuccess(forkSource.GetOnSuccess())
+		} else {
+			return nil, errors.New("mismatch in node types during cloning. copy is not a fork node")
+		}
+	}
+
+	// Copy executor name, inputs, navigation, and the retry limit if the node is executor-backed
 	if executableSource, ok := source.(ExecutorBackedNodeInterface); ok {
 		if executableCopy, ok := nodeCopy.(ExecutorBackedNodeInterface); ok {
 			executableCopy.SetExecutorName(executableSource.GetExecutorName())
@@ -145,6 +169,7 @@ func (f *flowFactory) CloneNode(source NodeInterface) (NodeInterface, error) {
 			executableCopy.SetOnSuccess(executableSource.GetOnSuccess())
 			executableCopy.SetOnFailure(executableSource.GetOnFailure())
 			executableCopy.SetOnIncomplete(executableSource.GetOnIncomplete())
+			executableCopy.SetMaxAttempts(executableSource.GetMaxAttempts())
 		} else {
 			return nil, errors.New("mismatch in node types during cloning. copy is not executor-backed")
 		}
@@ -62,6 +62,12 @@ func (s *FlowFactoryTestSuite) TestCreateNodeSuccess() {
 			map[string]interface{}{}, true, false, common.NodeTypeStart},
 		{"Create end node", "node-5", string(common.NodeTypeEnd),
 			map[string]interface{}{}, false, true, common.NodeTypeEnd},
+		{"Create decision node", "node-6", string(common.NodeTypeDecision),
+			map[string]interface{}{}, false, false, common.NodeTypeDecision},
+		{"Create fork node", "node-7", string(common.NodeTypeFork),
+			map[string]interface{}{}, false, false, common.NodeTypeFork},
+		{"Create join node", "node-8", string(common.NodeTypeJoin),
+			map[string]interface{}{}, false, false, common.NodeTypeJoin},
 	}
 
 	for _, tt := range tests {
@@ -281,6 +287,7 @@ func (s *FlowFactoryTestSuite) TestCloneTaskExecutionNodeWithOnSuccess() {
 		execNode.SetOnSuccess("success-node")
 		execNode.SetOnFailure("failure-node")
 		execNode.SetExecutorName("test-executor")
+		execNode.SetMaxAttempts(3)
 	}
 
 	clonedNode, err := s.factory.CloneNode(node)
@@ -292,11 +299,58 @@ func (s *FlowFactoryTestSuite) TestCloneTaskExecutionNodeWithOnSuccess() {
 		s.Equal("success-node", clonedExecNode.GetOnSuccess())
 		s.Equal("failure-node", clonedExecNode.GetOnFailure())
 		s.Equal("test-executor", clonedExecNode.GetExecutorName())
+		s.Equal(3, clonedExecNode.GetMaxAttempts())
 	} else {
 		s.Fail("Cloned node should be ExecutorBackedNodeInterface")
 	}
 }
 
+func (s *FlowFactoryTestSuite) TestCloneDecisionNodeWithBranches() {
+	node, _ := s.factory.CreateNode("decision", string(common.NodeTypeDecision),
+		map[string]interface{}{}, false, false)
+
+	decisionNode, ok := node.(DecisionNodeInterface)
+	s.True(ok, "Node should implement DecisionNodeInterface")
+	decisionNode.SetBranches([]DecisionBranch{
+		{Condition: BranchCondition{Key: "{{ context.userType }}", Value: "customer"}, NextNodeID: "customer"},
+	})
+	decisionNode.SetOnSuccess("default")
+
+	clonedNode, err := s.factory.CloneNode(node)
+
+	s.NoError(err)
+	clonedDecisionNode, ok := clonedNode.(DecisionNodeInterface)
+	s.True(ok, "Cloned node should implement DecisionNodeInterface")
+	s.Equal(decisionNode.GetBranches(), clonedDecisionNode.GetBranches())
+	s.Equal("default", clonedDecisionNode.GetOnSuccess())
+
+	// Verify deep copy - modifying cloned branches doesn't affect source
+	clonedDecisionNode.GetBranches()[0].NextNodeID = "other"
+	s.Equal("customer", decisionNode.GetBranches()[0].NextNodeID)
+}
+
+func (s *FlowFactoryTestSuite) TestCloneForkNodeWithBranches() {
+	node, _ := s.factory.CreateNode("fork", string(common.NodeTypeFork),
+		map[string]interface{}{}, false, false)
+
+	forkNode, ok := node.(ForkNodeInterface)
+	s.True(ok, "Node should implement ForkNodeInterface")
+	forkNode.SetBranches([]string{"risk-score", "send-otp"})
+	forkNode.SetOnSuccess("join")
+
+	clonedNode, err := s.factory.CloneNode(node)
+
+	s.NoError(err)
+	clonedForkNode, ok := clonedNode.(ForkNodeInterface)
+	s.True(ok, "Cloned node should implement ForkNodeInterface")
+	s.Equal([]string{"risk-score", "send-otp"}, clonedForkNode.GetBranches())
+	s.Equal("join", clonedForkNode.GetOnSuccess())
+
+	// Verify deep copy - modifying cloned branches doesn't affect source
+	clonedForkNode.GetBranches()[0] = "other"
+	s.Equal("risk-score", forkNode.GetBranches()[0])
+}
+
 func (s *FlowFactoryTestSuite) TestCloneNodeWithMeta() {
 	promptNode, _ := s.factory.CreateNode("prompt-1", string(common.NodeTypePrompt),
 		map[string]interface{}{}, false, false)
@@ -484,6 +538,12 @@ func (f *fakeExecutorBackedNode) GetMode() string {
 
 func (f *fakeExecutorBackedNode) SetMode(mode string) {}
 
+func (f *fakeExecutorBackedNode) GetMaxAttempts() int {
+	return 0
+}
+
+func (f *fakeExecutorBackedNode) SetMaxAttempts(maxAttempts int) {}
+
 func (s *FlowFactoryTestSuite) TestCloneNodeMismatchExecutorBacked() {
 	// source claims to be executor-backed but GetType returns Prompt which
 	// CreateNode maps to a non-executor-backed node. This should trigger the
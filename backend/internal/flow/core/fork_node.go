@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// ForkNodeInterface extends NodeInterface for fork nodes.
+// Fork nodes start a set of branches that are executed concurrently by the flow engine. Each branch is a chain
+// of task execution nodes that ends at the join node referenced by onSuccess, where the flow resumes once all
+// branches complete.
+type ForkNodeInterface interface {
+	NodeInterface
+	GetBranches() []string
+	SetBranches(branches []string)
+	GetOnSuccess() string
+	SetOnSuccess(nodeID string)
+}
+
+// forkNode implements the ForkNodeInterface
+type forkNode struct {
+	*node
+	branches  []string
+	onSuccess string
+}
+
+// Ensure forkNode implements ForkNodeInterface
+var _ ForkNodeInterface = (*forkNode)(nil)
+
+// newForkNode creates a new fork node with the given details.
+func newForkNode(id string, properties map[string]interface{}, isStartNode bool,
+	isFinalNode bool) NodeInterface {
+	return &forkNode{
+		node: &node{
+			id:               id,
+			_type:            common.NodeTypeFork,
+			properties:       properties,
+			isStartNode:      isStartNode,
+			isFinalNode:      isFinalNode,
+			nextNodeList:     []string{},
+			previousNodeList: []string{},
+		},
+		branches: []string{},
+	}
+}
+
+// Execute completes the fork node and moves to the join node. The branches of the fork node are executed
+// by the flow engine, which merges their results into the flow context before the join node is reached.
+func (n *forkNode) Execute(ctx *NodeContext) (*common.NodeResponse, *serviceerror.ServiceError) {
+	return &common.NodeResponse{
+		Status:         common.NodeStatusComplete,
+		NextNodeID:     n.onSuccess,
+		RuntimeData:    make(map[string]string),
+		AdditionalData: make(map[string]string),
+	}, nil
+}
+
+// GetBranches returns the IDs of the first nodes of the branches of the fork node
+func (n *forkNode) GetBranches() []string {
+	return n.branches
+}
+
+// SetBranches sets the IDs of the first nodes of the branches of the fork node
+func (n *forkNode) SetBranches(branches []string) {
+	if branches == nil {
+		n.branches = []string{}
+	} else {
+		n.branches = branches
+	}
+}
+
+// GetOnSuccess returns the ID of the join node of the fork node
+func (n *forkNode) GetOnSuccess() string {
+	return n.onSuccess
+}
+
+// SetOnSuccess sets the ID of the join node of the fork node
+func (n *forkNode) SetOnSuccess(nodeID string) {
+	n.onSuccess = nodeID
+}
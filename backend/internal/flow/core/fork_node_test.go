@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+)
+
+type ForkNodeTestSuite struct {
+	suite.Suite
+}
+
+func TestForkNodeTestSuite(t *testing.T) {
+	suite.Run(t, new(ForkNodeTestSuite))
+}
+
+func (s *ForkNodeTestSuite) TestNewForkNode() {
+	node := newForkNode("fork", map[string]interface{}{"key": "value"}, false, false)
+
+	s.Equal("fork", node.GetID())
+	s.Equal(common.NodeTypeFork, node.GetType())
+	s.False(node.IsStartNode())
+	s.False(node.IsFinalNode())
+	s.Equal("value", node.GetProperties()["key"])
+
+	forkNode, ok := node.(ForkNodeInterface)
+	s.True(ok)
+	s.Empty(forkNode.GetBranches())
+	s.Empty(forkNode.GetOnSuccess())
+}
+
+func (s *ForkNodeTestSuite) TestSetBranches() {
+	forkNode := newForkNode("fork", nil, false, false).(ForkNodeInterface)
+
+	forkNode.SetBranches([]string{"risk-score", "send-otp"})
+	s.Equal([]string{"risk-score", "send-otp"}, forkNode.GetBranches())
+
+	forkNode.SetBranches(nil)
+	s.NotNil(forkNode.GetBranches())
+	s.Empty(forkNode.GetBranches())
+}
+
+func (s *ForkNodeTestSuite) TestExecuteMovesToJoinNode() {
+	forkNode := newForkNode("fork", nil, false, false).(ForkNodeInterface)
+	forkNode.SetBranches([]string{"risk-score", "send-otp"})
+	forkNode.SetOnSuccess("join")
+
+	resp, err := forkNode.Execute(&NodeContext{ExecutionID: "test-flow"})
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusComplete, resp.Status)
+	s.Equal("join", resp.NextNodeID)
+}
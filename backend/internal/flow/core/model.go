@@ -45,6 +45,10 @@ type NodeContext struct {
 	RuntimeData    map[string]string
 	ForwardedData  map[string]interface{}
 
+	// DisallowedActions holds prompt action refs that must not be offered in this execution,
+	// such as options leading to identity providers not enabled for the application.
+	DisallowedActions []string
+
 	Application       appmodel.Application
 	AuthenticatedUser authncm.AuthenticatedUser
 	AuthUser          manager.AuthUser
@@ -60,6 +64,21 @@ type NodeCondition struct {
 	OnSkip string
 }
 
+// DecisionBranch represents an outgoing edge of a decision node that is taken when its condition matches.
+type DecisionBranch struct {
+	Condition  BranchCondition
+	NextNodeID string
+}
+
+// BranchCondition represents the condition of a decision branch. Key is a placeholder expression such as
+// "{{ context.key }}" or "{{ user.attribute }}" which is resolved against the flow context and compared
+// with Value using Operator.
+type BranchCondition struct {
+	Key      string
+	Operator common.ConditionOperator
+	Value    string
+}
+
 // Segment represents a contiguous section of a flow graph bounded by display-only prompt nodes.
 type Segment struct {
 	ID          string
@@ -174,6 +174,13 @@ func (n *promptNode) executeLoginOptions(ctx *NodeContext,
 	authClassToAction := n.authClassToActionMapping()
 
 	if ctx.CurrentAction != "" {
+		if slices.Contains(ctx.DisallowedActions, ctx.CurrentAction) {
+			logger.Debug("Selected action is not allowed for the application",
+				log.String("actionRef", ctx.CurrentAction))
+			nodeResp.Status = common.NodeStatusFailure
+			nodeResp.FailureReason = failureReasonInvalidAction
+			return nodeResp, nil
+		}
 		if allowedRaw := ctx.RuntimeData[common.RuntimeKeyAllowedLoginOptions]; allowedRaw != "" {
 			if !slices.Contains(strings.Fields(allowedRaw), ctx.CurrentAction) {
 				logger.Debug("Selected action is not in allowed login options",
@@ -193,7 +200,8 @@ func (n *promptNode) executeLoginOptions(ctx *NodeContext,
 	}
 
 	requestedAuthClasses := parseAuthClasses(ctx.RuntimeData[common.RuntimeKeyRequestedAuthClasses])
-	effectivePrompts := n.filterAndOrderPrompts(requestedAuthClasses, authClassToAction)
+	effectivePrompts := excludeDisallowedPrompts(
+		n.filterAndOrderPrompts(requestedAuthClasses, authClassToAction), ctx.DisallowedActions)
 	actions := make([]common.Action, 0)
 	for _, p := range effectivePrompts {
 		if p.Action != nil {
@@ -486,7 +494,7 @@ func (n *promptNode) enrichInputsFromForwardedData(ctx *NodeContext, nodeResp *c
 // to the response if they haven't been selected yet.
 // Returns true if an action is already selected or no actions are defined, otherwise false.
 func (n *promptNode) hasSelectedAction(ctx *NodeContext, nodeResp *common.NodeResponse) bool {
-	actions := n.getAllActions()
+	actions := n.getAvailableActions(ctx)
 	if len(actions) == 0 {
 		return true
 	}
@@ -511,7 +519,7 @@ func (n *promptNode) hasSelectedAction(ctx *NodeContext, nodeResp *common.NodeRe
 // explicit user action.
 // Returns true if an action was auto-selected, otherwise false.
 func (n *promptNode) tryAutoSelectSingleAction(ctx *NodeContext) bool {
-	actions := n.getAllActions()
+	actions := n.getAvailableActions(ctx)
 	allInputs := n.getAllInputs()
 
 	// Auto-select only when: single action, no action selected, and has inputs defined
@@ -552,6 +560,17 @@ func (n *promptNode) getAllActions() []common.Action {
 	return actions
 }
 
+// getAvailableActions returns the actions from prompts, excluding those disallowed in the context.
+func (n *promptNode) getAvailableActions(ctx *NodeContext) []common.Action {
+	actions := n.getAllActions()
+	if len(ctx.DisallowedActions) == 0 {
+		return actions
+	}
+	return slices.DeleteFunc(actions, func(action common.Action) bool {
+		return slices.Contains(ctx.DisallowedActions, action.Ref)
+	})
+}
+
 // getNextNodeForActionRef finds the next node for the given action reference.
 func (n *promptNode) getNextNodeForActionRef(actionRef string) string {
 	actions := n.getAllActions()
@@ -979,6 +998,22 @@ func (n *promptNode) authClassToActionMapping() map[string]string {
 	return result
 }
 
+// excludeDisallowedPrompts returns the prompts whose actions are not in disallowedActions. Prompts
+// without an action are kept. The input slice is not modified.
+func excludeDisallowedPrompts(prompts []common.Prompt, disallowedActions []string) []common.Prompt {
+	if len(disallowedActions) == 0 {
+		return prompts
+	}
+	result := make([]common.Prompt, 0, len(prompts))
+	for _, p := range prompts {
+		if p.Action != nil && slices.Contains(disallowedActions, p.Action.Ref) {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
 // joinActionRefs returns a space-separated list of action refs from the given prompts.
 func joinActionRefs(prompts []common.Prompt) string {
 	refs := make([]string, 0, len(prompts))
@@ -425,6 +425,54 @@ func (s *PromptOnlyNodeTestSuite) TestExecuteWithInvalidAction() {
 	s.Equal("login", resp.Actions[0].Ref)
 }
 
+func (s *PromptOnlyNodeTestSuite) TestExecuteWithDisallowedActions_ExcludedFromResponse() {
+	node := newPromptNode("prompt-1", map[string]interface{}{}, false, false)
+	promptNode := node.(PromptNodeInterface)
+
+	promptNode.SetPrompts([]common.Prompt{
+		{Action: &common.Action{Ref: "action_001", NextNode: "basic_auth"}},
+		{Action: &common.Action{Ref: "action_002", NextNode: "google_auth"}},
+	})
+
+	ctx := &NodeContext{
+		ExecutionID:       "test-flow",
+		UserInputs:        map[string]string{},
+		DisallowedActions: []string{"action_002"},
+	}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusIncomplete, resp.Status)
+	s.Len(resp.Actions, 1)
+	s.Equal("action_001", resp.Actions[0].Ref)
+}
+
+func (s *PromptOnlyNodeTestSuite) TestExecuteWithDisallowedActionSelected() {
+	node := newPromptNode("prompt-1", map[string]interface{}{}, false, false)
+	promptNode := node.(PromptNodeInterface)
+
+	promptNode.SetPrompts([]common.Prompt{
+		{Action: &common.Action{Ref: "action_001", NextNode: "basic_auth"}},
+		{Action: &common.Action{Ref: "action_002", NextNode: "google_auth"}},
+	})
+
+	ctx := &NodeContext{
+		ExecutionID:       "test-flow",
+		CurrentAction:     "action_002",
+		UserInputs:        map[string]string{},
+		DisallowedActions: []string{"action_002"},
+	}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusIncomplete, resp.Status, "disallowed action must be treated as not selected")
+	s.Empty(resp.NextNodeID)
+	s.Len(resp.Actions, 1)
+	s.Equal("action_001", resp.Actions[0].Ref)
+}
+
 func (s *PromptOnlyNodeTestSuite) TestAutoSelectSingleAction_NoInputs() {
 	node := newPromptNode("prompt-1", map[string]interface{}{}, false, false)
 	promptNode := node.(PromptNodeInterface)
@@ -3103,6 +3151,54 @@ func (s *PromptOnlyNodeTestSuite) TestLoginOptionsVariant_DisallowedActionReject
 	s.Equal("Invalid action selected", resp.FailureReason)
 }
 
+func (s *PromptOnlyNodeTestSuite) TestLoginOptionsVariant_DisallowedActionsExcluded() {
+	node := newPromptNode("login-chooser", loginOptionsProps(), false, false)
+	pn := node.(PromptNodeInterface)
+	pn.SetVariant(common.NodeVariantLoginOptions)
+	pn.SetPrompts([]common.Prompt{
+		{Action: &common.Action{Ref: "pwd", NextNode: "pwd-node"}},
+		{Action: &common.Action{Ref: "otp", NextNode: "otp-node"}},
+		{Action: &common.Action{Ref: "google", NextNode: "google-node"}},
+	})
+
+	ctx := &NodeContext{
+		ExecutionID:       "test-flow",
+		UserInputs:        map[string]string{},
+		RuntimeData:       map[string]string{},
+		DisallowedActions: []string{"google"},
+	}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusIncomplete, resp.Status)
+	s.Len(resp.Actions, 2)
+	s.ElementsMatch([]string{"pwd", "otp"}, []string{resp.Actions[0].Ref, resp.Actions[1].Ref})
+	s.Equal("pwd otp", resp.RuntimeData[common.RuntimeKeyAllowedLoginOptions])
+}
+
+func (s *PromptOnlyNodeTestSuite) TestLoginOptionsVariant_DisallowedActionSelectedRejected() {
+	node := newPromptNode("login-chooser", loginOptionsProps(), false, false)
+	pn := node.(PromptNodeInterface)
+	pn.SetVariant(common.NodeVariantLoginOptions)
+	pn.SetPrompts([]common.Prompt{
+		{Action: &common.Action{Ref: "pwd", NextNode: "pwd-node"}},
+		{Action: &common.Action{Ref: "google", NextNode: "google-node"}},
+	})
+
+	ctx := &NodeContext{
+		ExecutionID:       "test-flow",
+		UserInputs:        map[string]string{},
+		CurrentAction:     "google",
+		RuntimeData:       map[string]string{},
+		DisallowedActions: []string{"google"},
+	}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusFailure, resp.Status)
+	s.Equal("Invalid action selected", resp.FailureReason)
+}
+
 func (s *PromptOnlyNodeTestSuite) TestLoginOptionsVariant_AllowedLoginOptionsCaptured() {
 	node := newPromptNode("login-chooser", loginOptionsProps(), false, false)
 	pn := node.(PromptNodeInterface)
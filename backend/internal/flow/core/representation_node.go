@@ -23,7 +23,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 )
 
-// RepresentationNodeInterface extends NodeInterface for representation nodes (START/END).
+// RepresentationNodeInterface extends NodeInterface for representation nodes (START/END/JOIN).
 // These nodes use simple onSuccess navigation for linear flow.
 type RepresentationNodeInterface interface {
 	NodeInterface
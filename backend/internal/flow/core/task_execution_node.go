@@ -19,6 +19,8 @@
 package core
 
 import (
+	"strconv"
+
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -42,6 +44,8 @@ type ExecutorBackedNodeInterface interface {
 	SetOnIncomplete(nodeID string)
 	GetMode() string
 	SetMode(mode string)
+	GetMaxAttempts() int
+	SetMaxAttempts(maxAttempts int)
 }
 
 // taskExecutionNode represents a node that executes a task via an executor
@@ -54,6 +58,7 @@ type taskExecutionNode struct {
 	onSuccess    string
 	onFailure    string
 	onIncomplete string
+	maxAttempts  int
 	logger       *log.Logger
 }
 
@@ -110,6 +115,13 @@ func (n *taskExecutionNode) Execute(ctx *NodeContext) (*common.NodeResponse, *se
 
 	nodeResp := n.buildNodeResponse(execResp)
 
+	// Let the user retry a failed attempt until the retry limit of the node is reached
+	if n.isFailedAttempt(nodeResp) && !n.recordFailedAttempt(ctx, nodeResp) {
+		logger.Debug("Allowing the user to retry the failed attempt")
+		n.prepareRetry(nodeResp)
+		return nodeResp, nil
+	}
+
 	// Set the next node ID based on execution outcome
 	if nodeResp.Status == common.NodeStatusComplete {
 		if n.onSuccess != "" {
@@ -159,6 +171,50 @@ func (n *taskExecutionNode) Execute(ctx *NodeContext) (*common.NodeResponse, *se
 	return nodeResp, nil
 }
 
+// isFailedAttempt checks whether the node response is a failed attempt counted against the retry limit of
+// the node. Only failures with a reason are counted, so that prompting for missing inputs is not a failure.
+func (n *taskExecutionNode) isFailedAttempt(nodeResp *common.NodeResponse) bool {
+	if n.maxAttempts <= 0 || nodeResp.FailureReason == "" {
+		return false
+	}
+	return nodeResp.Status == common.NodeStatusFailure ||
+		(nodeResp.Status == common.NodeStatusIncomplete && nodeResp.Type == common.NodeResponseTypeView)
+}
+
+// recordFailedAttempt increments the failed attempt count of the node in the runtime data and returns
+// whether the retry limit of the node is reached. Once the limit is reached, the failed attempt is
+// converted to a failure so that the flow moves to the onFailure node or fails.
+func (n *taskExecutionNode) recordFailedAttempt(ctx *NodeContext, nodeResp *common.NodeResponse) bool {
+	runtimeKey := common.RuntimeKeyFailedAttemptsPrefix + n.id
+	failedAttempts, _ := strconv.Atoi(ctx.RuntimeData[runtimeKey])
+	failedAttempts++
+	nodeResp.RuntimeData[runtimeKey] = strconv.Itoa(failedAttempts)
+
+	if failedAttempts < n.maxAttempts {
+		return false
+	}
+
+	n.logger.Debug("Retry limit of the node reached", log.Int("maxAttempts", n.maxAttempts))
+	nodeResp.Status = common.NodeStatusFailure
+	nodeResp.Type = ""
+	return true
+}
+
+// prepareRetry converts a failed attempt to a prompt for the node inputs, so that the user can retry
+// with the failure reason displayed.
+func (n *taskExecutionNode) prepareRetry(nodeResp *common.NodeResponse) {
+	if nodeResp.Status == common.NodeStatusIncomplete {
+		return
+	}
+
+	nodeResp.Status = common.NodeStatusIncomplete
+	nodeResp.Type = common.NodeResponseTypeView
+	nodeResp.Inputs = n.inputs
+	if len(nodeResp.Inputs) == 0 && n.executor != nil {
+		nodeResp.Inputs = n.executor.GetDefaultInputs()
+	}
+}
+
 // enrichRuntimeData initializes the runtime data map and attaches identifiers like application, IDP,
 // and sender IDs so downstream executors and placeholders can use them.
 func (n *taskExecutionNode) enrichRuntimeData(ctx *NodeContext) {
@@ -320,6 +376,16 @@ func (n *taskExecutionNode) SetMode(mode string) {
 	n.mode = mode
 }
 
+// GetMaxAttempts returns the number of failed attempts allowed before the node fails
+func (n *taskExecutionNode) GetMaxAttempts() int {
+	return n.maxAttempts
+}
+
+// SetMaxAttempts sets the number of failed attempts allowed before the node fails
+func (n *taskExecutionNode) SetMaxAttempts(maxAttempts int) {
+	n.maxAttempts = maxAttempts
+}
+
 // GetInputs returns the inputs required for the task execution node
 func (n *taskExecutionNode) GetInputs() []common.Input {
 	return n.inputs
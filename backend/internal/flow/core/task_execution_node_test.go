@@ -1018,3 +1018,134 @@ func (s *TaskExecutionNodeTestSuite) TestGetExecutionPolicy_WithEmptyMode() {
 	s.NotNil(policy)
 	s.False(policy.SkipChallengeValidation)
 }
+
+func (s *TaskExecutionNodeTestSuite) TestMaxAttemptsMethods() {
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+
+	s.Equal(0, execNode.GetMaxAttempts())
+	execNode.SetMaxAttempts(3)
+	s.Equal(3, execNode.GetMaxAttempts())
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteRetryableFailureBelowMaxAttempts() {
+	inputs := []common.Input{{Identifier: "password", Type: "PASSWORD_INPUT", Required: true}}
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(
+		&common.ExecutorResponse{
+			Status:        common.ExecUserInputRequired,
+			FailureReason: "Invalid credentials",
+			Inputs:        inputs,
+		}, nil,
+	).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetOnFailure("recovery-prompt")
+	execNode.SetMaxAttempts(3)
+	execNode.SetExecutor(s.mockExecutor)
+
+	ctx := &NodeContext{ExecutionID: "test-flow", RuntimeData: map[string]string{"failedAttempts_task-1": "1"}}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusIncomplete, resp.Status)
+	s.Equal(common.NodeResponseTypeView, resp.Type)
+	s.Empty(resp.NextNodeID)
+	s.Equal("Invalid credentials", resp.FailureReason)
+	s.Equal(inputs, resp.Inputs)
+	s.Equal("2", resp.RuntimeData["failedAttempts_task-1"])
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteFailureBelowMaxAttemptsPromptsForNodeInputs() {
+	inputs := []common.Input{{Identifier: "otp", Type: "OTP_INPUT", Required: true}}
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(
+		&common.ExecutorResponse{Status: common.ExecFailure, FailureReason: "Invalid OTP"}, nil,
+	).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetInputs(inputs)
+	execNode.SetMaxAttempts(3)
+	execNode.SetExecutor(s.mockExecutor)
+
+	resp, err := node.Execute(&NodeContext{ExecutionID: "test-flow"})
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusIncomplete, resp.Status)
+	s.Equal(common.NodeResponseTypeView, resp.Type)
+	s.Equal(inputs, resp.Inputs)
+	s.Equal("Invalid OTP", resp.FailureReason)
+	s.Equal("1", resp.RuntimeData["failedAttempts_task-1"])
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteMaxAttemptsReachedWithOnFailureHandler() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(
+		&common.ExecutorResponse{
+			Status:        common.ExecUserInputRequired,
+			FailureReason: "Invalid credentials",
+			Inputs:        []common.Input{{Identifier: "password", Type: "PASSWORD_INPUT", Required: true}},
+		}, nil,
+	).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetOnFailure("recovery-prompt")
+	execNode.SetMaxAttempts(3)
+	execNode.SetExecutor(s.mockExecutor)
+
+	ctx := &NodeContext{ExecutionID: "test-flow", RuntimeData: map[string]string{"failedAttempts_task-1": "2"}}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusForward, resp.Status)
+	s.Equal("recovery-prompt", resp.NextNodeID)
+	s.Equal("Invalid credentials", resp.RuntimeData["failureReason"])
+	s.Equal("3", resp.RuntimeData["failedAttempts_task-1"])
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteMaxAttemptsReachedWithoutOnFailureHandler() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(
+		&common.ExecutorResponse{
+			Status:        common.ExecUserInputRequired,
+			FailureReason: "Invalid credentials",
+			Inputs:        []common.Input{{Identifier: "password", Type: "PASSWORD_INPUT", Required: true}},
+		}, nil,
+	).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetMaxAttempts(1)
+	execNode.SetExecutor(s.mockExecutor)
+
+	resp, err := node.Execute(&NodeContext{ExecutionID: "test-flow"})
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusFailure, resp.Status)
+	s.Empty(resp.Type)
+	s.Equal("Invalid credentials", resp.FailureReason)
+}
+
+func (s *TaskExecutionNodeTestSuite) TestExecuteMissingInputsNotCountedAsFailedAttempt() {
+	s.mockExecutor.On("GetName").Return("test-executor").Once()
+	s.mockExecutor.On("Execute", mock.Anything).Return(
+		&common.ExecutorResponse{
+			Status: common.ExecUserInputRequired,
+			Inputs: []common.Input{{Identifier: "password", Type: "PASSWORD_INPUT", Required: true}},
+		}, nil,
+	).Once()
+
+	node := newTaskExecutionNode("task-1", map[string]interface{}{}, false, false)
+	execNode, _ := node.(ExecutorBackedNodeInterface)
+	execNode.SetMaxAttempts(1)
+	execNode.SetExecutor(s.mockExecutor)
+
+	resp, err := node.Execute(&NodeContext{ExecutionID: "test-flow"})
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusIncomplete, resp.Status)
+	s.NotContains(resp.RuntimeData, "failedAttempts_task-1")
+}
@@ -0,0 +1,350 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	adaptiveScriptLoggerComponentName = "AdaptiveScriptExecutor"
+
+	// Default script execution timeout in milliseconds
+	defaultScriptTimeout = 200
+	// Maximum allowed script execution timeout in milliseconds
+	maxScriptTimeout = 1000
+	// Maximum call stack depth allowed for a script
+	maxScriptCallStackSize = 128
+)
+
+// scriptResult holds the decisions emitted by an adaptive authentication script.
+type scriptResult struct {
+	requireMFA   bool
+	denied       bool
+	denialReason string
+	claims       map[string]interface{}
+}
+
+// compiledScript holds the compiled program of the script configured for a node.
+type compiledScript struct {
+	script  string
+	program *goja.Program
+}
+
+// scriptRuntimeKeys are the runtime data keys exposed to scripts. They carry the user, application, attempt
+// and risk data of the flow. Other runtime data, such as tokens, secrets and OAuth state, is not exposed.
+var scriptRuntimeKeys = []string{
+	common.RuntimeKeyUserAutoProvisioned,
+	common.RuntimeKeyUserEligibleForProvisioning,
+	common.RuntimeKeyUserAmbiguous,
+	common.RuntimeKeyClientID,
+	common.RuntimeKeyRequestedScopes,
+	common.RuntimeKeyRequestedPermissions,
+	common.RuntimeKeyRequestedAuthClasses,
+	common.RuntimeKeySelectedAuthClass,
+	common.RuntimeKeyRequestedOrganization,
+	common.RuntimeKeyOrganizationID,
+	common.RuntimeKeySMSOTPVerifyAttemptCount,
+	common.RuntimeKeyRequireMFA,
+	common.RuntimeKeyAdaptiveClaims,
+}
+
+// adaptiveScriptExecutor implements the ExecutorInterface for evaluating adaptive authentication scripts.
+// Scripts are written in JavaScript, read a snapshot of the flow context through the "context" object
+// and emit decisions through the requireMFA, deny and setClaim functions. Scripts have no access to
+// the file system, network or the host environment. Compiled scripts are cached per node, so the cache
+// holds at most one program for each node that runs a script.
+type adaptiveScriptExecutor struct {
+	core.ExecutorInterface
+	programs sync.Map
+	logger   *log.Logger
+}
+
+var _ core.ExecutorInterface = (*adaptiveScriptExecutor)(nil)
+
+// newAdaptiveScriptExecutor creates a new instance of AdaptiveScriptExecutor.
+func newAdaptiveScriptExecutor(flowFactory core.FlowFactoryInterface) *adaptiveScriptExecutor {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, adaptiveScriptLoggerComponentName),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameAdaptiveScript))
+
+	base := flowFactory.CreateExecutor(ExecutorNameAdaptiveScript, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{})
+
+	return &adaptiveScriptExecutor{
+		ExecutorInterface: base,
+		logger:            logger,
+	}
+}
+
+// Execute evaluates the adaptive authentication script configured for the node.
+func (a *adaptiveScriptExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := a.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing adaptive script executor")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	script, _ := ctx.NodeProperties[propertyKeyScript].(string)
+	if script == "" {
+		logger.Error("Adaptive authentication script is not configured for the node")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Configuration error: script is required"
+		return execResp, nil
+	}
+
+	program, err := a.getProgram(ctx.CurrentNodeID, script)
+	if err != nil {
+		logger.Error("Failed to compile the adaptive authentication script", log.Error(err))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Configuration error: " + err.Error()
+		return execResp, nil
+	}
+
+	result, err := a.runProgram(ctx, program, getScriptTimeout(ctx.NodeProperties), logger)
+	if err != nil {
+		logger.Error("Failed to evaluate the adaptive authentication script", log.Error(err))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonScriptError
+		return execResp, nil
+	}
+
+	if result.denied {
+		logger.Debug("Access denied by the adaptive authentication script",
+			log.String("reason", result.denialReason))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonAccessDenied
+		return execResp, nil
+	}
+
+	if result.requireMFA {
+		execResp.RuntimeData[common.RuntimeKeyRequireMFA] = dataValueTrue
+	}
+
+	if len(result.claims) > 0 {
+		claimsJSON, err := mergeAdaptiveClaims(ctx.RuntimeData[common.RuntimeKeyAdaptiveClaims], result.claims)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode adaptive claims: %w", err)
+		}
+		execResp.RuntimeData[common.RuntimeKeyAdaptiveClaims] = claimsJSON
+	}
+
+	execResp.Status = common.ExecComplete
+	return execResp, nil
+}
+
+// getProgram returns the compiled program for the script of the node, compiling and caching it when the node
+// runs a script for the first time or its script has changed.
+func (a *adaptiveScriptExecutor) getProgram(nodeID, script string) (*goja.Program, error) {
+	if cached, ok := a.programs.Load(nodeID); ok && cached.(*compiledScript).script == script {
+		return cached.(*compiledScript).program, nil
+	}
+
+	program, err := goja.Compile("", script, true)
+	if err != nil {
+		return nil, err
+	}
+	a.programs.Store(nodeID, &compiledScript{script: script, program: program})
+
+	return program, nil
+}
+
+// runProgram runs the compiled script in a new sandboxed runtime and collects the emitted decisions.
+func (a *adaptiveScriptExecutor) runProgram(ctx *core.NodeContext, program *goja.Program,
+	timeout time.Duration, logger *log.Logger) (*scriptResult, error) {
+	vm := goja.New()
+	vm.SetMaxCallStackSize(maxScriptCallStackSize)
+
+	result := &scriptResult{claims: make(map[string]interface{})}
+	if err := a.registerScriptAPI(ctx, vm, result, logger); err != nil {
+		return nil, err
+	}
+
+	timer := time.AfterFunc(timeout, func() {
+		vm.Interrupt("script execution timed out")
+	})
+	defer timer.Stop()
+
+	if _, err := vm.RunProgram(program); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// registerScriptAPI exposes the flow context and the decision functions to the script runtime.
+func (a *adaptiveScriptExecutor) registerScriptAPI(ctx *core.NodeContext, vm *goja.Runtime,
+	result *scriptResult, logger *log.Logger) error {
+	if err := vm.Set("context", buildScriptContext(ctx)); err != nil {
+		return err
+	}
+
+	if err := vm.Set("requireMFA", func() {
+		result.requireMFA = true
+	}); err != nil {
+		return err
+	}
+
+	if err := vm.Set("deny", func(reason string) {
+		result.denied = true
+		result.denialReason = reason
+	}); err != nil {
+		return err
+	}
+
+	if err := vm.Set("setClaim", func(name string, value goja.Value) {
+		if name == "" || isProtectedAdaptiveClaim(name) {
+			panic(vm.NewTypeError("claim %q cannot be set by an adaptive authentication script", name))
+		}
+		result.claims[name] = value.Export()
+	}); err != nil {
+		return err
+	}
+
+	return vm.Set("log", func(message string) {
+		logger.Debug("Adaptive authentication script log", log.String("message", message))
+	})
+}
+
+// buildScriptContext builds a snapshot of the flow context exposed to scripts. Sensitive user inputs
+// such as passwords and one time codes are excluded, and only the allow-listed runtime data is included.
+func buildScriptContext(ctx *core.NodeContext) map[string]interface{} {
+	inputs := make(map[string]interface{}, len(ctx.UserInputs))
+	for key, value := range ctx.UserInputs {
+		if !slices.Contains(nonSearchableInputs, key) {
+			inputs[key] = value
+		}
+	}
+
+	runtime := make(map[string]interface{})
+	for key, value := range ctx.RuntimeData {
+		if slices.Contains(scriptRuntimeKeys, key) || strings.HasPrefix(key, common.RuntimeKeyFailedAttemptsPrefix) {
+			runtime[key] = value
+		}
+	}
+
+	attributes := make(map[string]interface{}, len(ctx.AuthenticatedUser.Attributes))
+	for key, value := range ctx.AuthenticatedUser.Attributes {
+		attributes[key] = value
+	}
+
+	records := make([]*common.NodeExecutionRecord, 0, len(ctx.ExecutionHistory))
+	for _, record := range ctx.ExecutionHistory {
+		if record != nil {
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Step < records[j].Step })
+
+	steps := make([]interface{}, len(records))
+	for i, record := range records {
+		steps[i] = map[string]interface{}{
+			"nodeId":       record.NodeID,
+			"executorName": record.ExecutorName,
+			"status":       string(record.Status),
+		}
+	}
+
+	return map[string]interface{}{
+		"flowType":      string(ctx.FlowType),
+		"applicationId": ctx.EntityID,
+		"inputs":        inputs,
+		"runtime":       runtime,
+		"steps":         steps,
+		"user": map[string]interface{}{
+			"isAuthenticated": ctx.AuthenticatedUser.IsAuthenticated,
+			"userId":          ctx.AuthenticatedUser.UserID,
+			"ouId":            ctx.AuthenticatedUser.OUID,
+			"userType":        ctx.AuthenticatedUser.UserType,
+			"attributes":      attributes,
+		},
+	}
+}
+
+// getScriptTimeout reads the script execution timeout in milliseconds from the node properties,
+// applying the default and the upper limit.
+func getScriptTimeout(properties map[string]interface{}) time.Duration {
+	timeout := defaultScriptTimeout
+	switch v := properties[propertyKeyScriptTimeout].(type) {
+	case float64:
+		timeout = int(v)
+	case int:
+		timeout = v
+	case string:
+		if parsed, err := strconv.Atoi(v); err == nil {
+			timeout = parsed
+		}
+	}
+
+	if timeout <= 0 {
+		timeout = defaultScriptTimeout
+	}
+	if timeout > maxScriptTimeout {
+		timeout = maxScriptTimeout
+	}
+
+	return time.Duration(timeout) * time.Millisecond
+}
+
+// isProtectedAdaptiveClaim checks whether the claim is set by the server and cannot be overridden by scripts.
+func isProtectedAdaptiveClaim(name string) bool {
+	return oauth2const.IsReservedTokenClaim(name) ||
+		name == oauth2const.ClaimCompletedAuthClass ||
+		name == oauth2const.ClaimCompletedAuthMethods ||
+		name == oauth2const.ClaimRememberMe ||
+		name == oauth2const.UserAttributeGroups ||
+		name == oauth2const.UserAttributeRoles
+}
+
+// mergeAdaptiveClaims merges the claims set by a script into the JSON encoded claims set by
+// previously evaluated scripts and returns the JSON encoded result.
+func mergeAdaptiveClaims(existingJSON string, claims map[string]interface{}) (string, error) {
+	merged := make(map[string]interface{})
+	if existingJSON != "" {
+		if err := json.Unmarshal([]byte(existingJSON), &merged); err != nil {
+			return "", errors.New("existing adaptive claims are not valid JSON")
+		}
+	}
+	for name, value := range claims {
+		merged[name] = value
+	}
+
+	mergedJSON, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+
+	return string(mergedJSON), nil
+}
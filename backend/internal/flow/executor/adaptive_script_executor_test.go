@@ -0,0 +1,283 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type AdaptiveScriptExecutorTestSuite struct {
+	suite.Suite
+	executor *adaptiveScriptExecutor
+}
+
+func TestAdaptiveScriptExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(AdaptiveScriptExecutorTestSuite))
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) SetupSuite() {
+	_ = config.InitializeServerRuntime("test", &config.Config{})
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) SetupTest() {
+	mockFlowFactory := coremock.NewFlowFactoryInterfaceMock(suite.T())
+	mockFlowFactory.On("CreateExecutor", ExecutorNameAdaptiveScript, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{}).
+		Return(newMockExecutor(ExecutorNameAdaptiveScript, common.ExecutorTypeUtility,
+			[]common.Input{}, []common.Input{}))
+	suite.executor = newAdaptiveScriptExecutor(mockFlowFactory)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) newContext(script string) *core.NodeContext {
+	return &core.NodeContext{
+		ExecutionID:    "test-execution",
+		FlowType:       common.FlowTypeAuthentication,
+		EntityID:       "app-1",
+		CurrentNodeID:  "adaptive_check",
+		NodeProperties: map[string]interface{}{propertyKeyScript: script},
+		UserInputs:     map[string]string{"username": "alice", "password": "secret"},
+		RuntimeData:    map[string]string{common.RuntimeKeyClientID: "client-1"},
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-1",
+			UserType:        "employee",
+			Attributes:      map[string]interface{}{"department": "finance"},
+		},
+		ExecutionHistory: map[string]*common.NodeExecutionRecord{
+			"basic_auth": {NodeID: "basic_auth", ExecutorName: ExecutorNameBasicAuth, Step: 1,
+				Status: common.FlowStatusComplete},
+		},
+	}
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_NoDecisions() {
+	resp, err := suite.executor.Execute(suite.newContext(`log("nothing to decide");`))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Empty(resp.RuntimeData)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_RequireMFA() {
+	script := `
+		if (context.user.attributes.department === "finance" && context.runtime.clientId === "client-1") {
+			requireMFA();
+		}`
+
+	resp, err := suite.executor.Execute(suite.newContext(script))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(dataValueTrue, resp.RuntimeData[common.RuntimeKeyRequireMFA])
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_Deny() {
+	resp, err := suite.executor.Execute(suite.newContext(`deny("Login from this location is not allowed");`))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonAccessDenied, resp.FailureReason)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_DenyWithoutReason() {
+	resp, err := suite.executor.Execute(suite.newContext(`deny();`))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonAccessDenied, resp.FailureReason)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_SetClaim() {
+	ctx := suite.newContext(`setClaim("risk", "low"); setClaim("level", 2);`)
+	ctx.RuntimeData[common.RuntimeKeyAdaptiveClaims] = `{"risk":"high","region":"apac"}`
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+
+	var claims map[string]interface{}
+	suite.NoError(json.Unmarshal([]byte(resp.RuntimeData[common.RuntimeKeyAdaptiveClaims]), &claims))
+	suite.Equal(map[string]interface{}{"risk": "low", "level": float64(2), "region": "apac"}, claims)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_SetReservedClaim() {
+	resp, err := suite.executor.Execute(suite.newContext(`setClaim("sub", "someone-else");`))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonScriptError, resp.FailureReason)
+	suite.Empty(resp.RuntimeData)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_SetProtectedClaims() {
+	for _, claim := range []string{"amr", "org_id", "groups", "roles"} {
+		suite.Run(claim, func() {
+			resp, err := suite.executor.Execute(suite.newContext(`setClaim("` + claim + `", "admin");`))
+
+			suite.NoError(err)
+			suite.Equal(common.ExecFailure, resp.Status)
+			suite.Equal(failureReasonScriptError, resp.FailureReason)
+			suite.Empty(resp.RuntimeData)
+		})
+	}
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_ContextSnapshot() {
+	script := `
+		if (context.inputs.password !== undefined) { deny("password exposed"); }
+		if (context.runtime.clientId !== "client-1" || context.runtime.failedAttempts_otp !== "2") {
+			deny("runtime");
+		}
+		if (context.runtime.totpEnrollmentToken !== undefined || context.runtime.oauthState !== undefined ||
+			context.runtime.magicLinkUsedJti !== undefined) {
+			deny("secrets exposed");
+		}
+		if (context.inputs.username !== "alice") { deny("username missing"); }
+		if (context.flowType !== "AUTHENTICATION" || context.applicationId !== "app-1") { deny("flow"); }
+		if (context.user.userId !== "user-1" || context.user.userType !== "employee") { deny("user"); }
+		if (context.steps.length !== 1 || context.steps[0].executorName !== "BasicAuthExecutor") {
+			deny("steps");
+		}`
+
+	ctx := suite.newContext(script)
+	ctx.RuntimeData[common.RuntimeKeyFailedAttemptsPrefix+"otp"] = "2"
+	ctx.RuntimeData[common.RuntimeKeyTOTPEnrollmentToken] = "encrypted-secret"
+	ctx.RuntimeData[common.RuntimeKeyOAuthState] = "state"
+	ctx.RuntimeData[common.RuntimeKeyMagicLinkUsedJti] = "jti"
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_MissingScript() {
+	ctx := suite.newContext("")
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Contains(resp.FailureReason, "script is required")
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_SyntaxError() {
+	resp, err := suite.executor.Execute(suite.newContext(`if (`))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Contains(resp.FailureReason, "Configuration error")
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_RuntimeError() {
+	resp, err := suite.executor.Execute(suite.newContext(`undefinedFunction();`))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonScriptError, resp.FailureReason)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_Timeout() {
+	ctx := suite.newContext(`while (true) {}`)
+	ctx.NodeProperties[propertyKeyScriptTimeout] = float64(50)
+
+	start := time.Now()
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonScriptError, resp.FailureReason)
+	suite.Less(time.Since(start), time.Duration(maxScriptTimeout)*time.Millisecond)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_ProgramIsCached() {
+	script := `requireMFA();`
+
+	_, err := suite.executor.Execute(suite.newContext(script))
+	suite.NoError(err)
+
+	cached, ok := suite.executor.programs.Load("adaptive_check")
+	suite.True(ok)
+
+	_, err = suite.executor.Execute(suite.newContext(script))
+	suite.NoError(err)
+
+	cachedAgain, _ := suite.executor.programs.Load("adaptive_check")
+	suite.Same(cached, cachedAgain)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestExecute_ProgramIsReplacedWhenScriptChanges() {
+	_, err := suite.executor.Execute(suite.newContext(`requireMFA();`))
+	suite.NoError(err)
+
+	resp, err := suite.executor.Execute(suite.newContext(`deny();`))
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+
+	count := 0
+	suite.executor.programs.Range(func(_, _ interface{}) bool {
+		count++
+		return true
+	})
+	suite.Equal(1, count)
+
+	cached, _ := suite.executor.programs.Load("adaptive_check")
+	suite.Equal(`deny();`, cached.(*compiledScript).script)
+}
+
+func (suite *AdaptiveScriptExecutorTestSuite) TestGetScriptTimeout() {
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expected time.Duration
+	}{
+		{"Default", nil, defaultScriptTimeout * time.Millisecond},
+		{"Float", float64(500), 500 * time.Millisecond},
+		{"Int", 300, 300 * time.Millisecond},
+		{"String", "400", 400 * time.Millisecond},
+		{"InvalidString", "abc", defaultScriptTimeout * time.Millisecond},
+		{"Negative", float64(-1), defaultScriptTimeout * time.Millisecond},
+		{"AboveMaximum", float64(5000), maxScriptTimeout * time.Millisecond},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			properties := map[string]interface{}{}
+			if tc.value != nil {
+				properties[propertyKeyScriptTimeout] = tc.value
+			}
+			suite.Equal(tc.expected, getScriptTimeout(properties))
+		})
+	}
+}
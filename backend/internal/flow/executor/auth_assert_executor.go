@@ -35,9 +35,11 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/ssosession"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
@@ -104,6 +106,16 @@ func (a *authAssertExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorRes
 	}
 
 	if ctx.AuthenticatedUser.IsAuthenticated {
+		failureReason, err := a.validateOrganizationPolicy(ctx, logger)
+		if err != nil {
+			return nil, err
+		}
+		if failureReason != "" {
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = failureReason
+			return execResp, nil
+		}
+
 		token, err := a.generateAuthAssertion(ctx, logger)
 		if err != nil {
 			return nil, err
@@ -168,10 +180,34 @@ func (a *authAssertExecutor) generateAuthAssertion(ctx *core.NodeContext, logger
 		jwtClaims["authorized_permissions"] = permissions
 	}
 
-	if completedACR, exists := ctx.RuntimeData[common.RuntimeKeySelectedAuthClass]; exists && completedACR != "" {
+	completedAMR := resolveCompletedAMR(authenticatorRefs)
+	if len(completedAMR) > 0 {
+		jwtClaims[oauth2const.ClaimCompletedAuthMethods] = strings.Join(completedAMR, " ")
+	}
+
+	// An authentication class chosen by the user takes precedence over the one derived from the
+	// authentication methods used in the flow.
+	completedACR := ctx.RuntimeData[common.RuntimeKeySelectedAuthClass]
+	if completedACR == "" {
+		completedACR = resolveCompletedAuthClass(ctx.RuntimeData[common.RuntimeKeyRequestedAuthClasses], completedAMR)
+	}
+	if completedACR != "" {
 		jwtClaims[oauth2const.ClaimCompletedAuthClass] = completedACR
 	}
 
+	// The "remember_me" claim carries the lifetime in seconds of the persistent session to be established for a
+	// user who chose to stay signed in, when the entity and the organization unit of the user allow it.
+	if rememberMe, err := strconv.ParseBool(ctx.UserInputs[userInputRememberMe]); err == nil && rememberMe {
+		var rememberMeConfig *inboundmodel.RememberMeConfig
+		if ctx.Application.LoginExperience != nil {
+			rememberMeConfig = ctx.Application.LoginExperience.RememberMe
+		}
+		lifetime := ssosession.ResolveRememberMeLifetime(rememberMeConfig, ctx.AuthenticatedUser.OUID)
+		if lifetime > 0 {
+			jwtClaims[oauth2const.ClaimRememberMe] = int64(lifetime.Seconds())
+		}
+	}
+
 	requiredAttributes := a.getRequiredUserAttributes(ctx)
 
 	resolvedAttributes, attrErr := a.resolveUserAttributes(ctx, requiredAttributes)
@@ -179,6 +215,32 @@ func (a *authAssertExecutor) generateAuthAssertion(ctx *core.NodeContext, logger
 		return "", attrErr
 	}
 
+	// Add the claims set by adaptive authentication scripts evaluated in the flow. They are added before the
+	// server resolved claims below and never replace a resolved user attribute, so the server resolved claims win.
+	if adaptiveClaimsJSON := ctx.RuntimeData[common.RuntimeKeyAdaptiveClaims]; adaptiveClaimsJSON != "" {
+		var adaptiveClaims map[string]interface{}
+		if err := json.Unmarshal([]byte(adaptiveClaimsJSON), &adaptiveClaims); err != nil {
+			logger.Error("Failed to parse adaptive claims from runtime data", log.Error(err))
+			return "", errors.New("something went wrong while processing adaptive claims")
+		}
+		if resolvedAttributes == nil {
+			resolvedAttributes = make(map[string]interface{})
+		}
+		for name, value := range adaptiveClaims {
+			if _, exists := resolvedAttributes[name]; !exists {
+				resolvedAttributes[name] = value
+			}
+		}
+	}
+
+	// Add the organization the user logged in to, so that it is carried to the issued tokens.
+	if orgID := ctx.RuntimeData[common.RuntimeKeyOrganizationID]; orgID != "" {
+		if resolvedAttributes == nil {
+			resolvedAttributes = make(map[string]interface{})
+		}
+		resolvedAttributes[oauth2const.ClaimOrgID] = orgID
+	}
+
 	if ttlSecondsStr, exists := ctx.RuntimeData[common.RuntimeKeyUserAttributesCacheTTLSeconds]; exists {
 		// We are not in an App Native flow, so we need to cache the user attributes
 		if len(resolvedAttributes) > 0 {
@@ -220,6 +282,57 @@ func (a *authAssertExecutor) generateAuthAssertion(ctx *core.NodeContext, logger
 	return token, nil
 }
 
+// validateOrganizationPolicy validates the authenticated user against the organization resolved for the
+// login, if any. The user must belong to the organization unit of the organization or one of its
+// descendants, and must have logged in with a federated identity provider when the organization requires
+// it. Returns a failure reason if the validation fails.
+func (a *authAssertExecutor) validateOrganizationPolicy(ctx *core.NodeContext, logger *log.Logger) (
+	string, error) {
+	orgID := ctx.RuntimeData[common.RuntimeKeyOrganizationID]
+	if orgID == "" {
+		return "", nil
+	}
+
+	if orgOUID := ctx.RuntimeData[common.RuntimeKeyOrganizationOUID]; orgOUID != "" {
+		isMember := ctx.AuthenticatedUser.OUID == orgOUID
+		if !isMember && ctx.AuthenticatedUser.OUID != "" {
+			var svcErr *serviceerror.ServiceError
+			isMember, svcErr = a.ouService.IsParent(ctx.Context, orgOUID, ctx.AuthenticatedUser.OUID)
+			if svcErr != nil {
+				if svcErr.Type == serviceerror.ServerErrorType {
+					logger.Error("Failed to validate organization membership",
+						log.String("error", svcErr.Error.DefaultValue))
+					return "", errors.New("something went wrong while validating organization membership")
+				}
+				isMember = false
+			}
+		}
+		if !isMember {
+			logger.Debug("Authenticated user does not belong to the organization",
+				log.String("organizationID", orgID))
+			return failureReasonUserNotInOrg, nil
+		}
+	}
+
+	if ctx.RuntimeData[common.RuntimeKeyOrganizationRequireIDP] == dataValueTrue {
+		federated := false
+		for _, ref := range a.extractAuthenticatorReferences(ctx.ExecutionHistory) {
+			switch ref.Authenticator {
+			case authncm.AuthenticatorOAuth, authncm.AuthenticatorOIDC,
+				authncm.AuthenticatorGithub, authncm.AuthenticatorGoogle:
+				federated = true
+			}
+		}
+		if !federated {
+			logger.Debug("Organization requires login with an organization identity provider",
+				log.String("organizationID", orgID))
+			return failureReasonOrgIDPRequired, nil
+		}
+	}
+
+	return "", nil
+}
+
 // extractAuthenticatorReferences extracts authenticator references from execution history.
 func (a *authAssertExecutor) extractAuthenticatorReferences(
 	history map[string]*common.NodeExecutionRecord) []authncm.AuthenticatorReference {
@@ -266,6 +379,67 @@ func (a *authAssertExecutor) extractAuthenticatorReferences(
 	return refs
 }
 
+// resolveCompletedAMR returns the authentication method reference values of the engaged authenticators, in
+// the order the authenticators were engaged.
+func resolveCompletedAMR(refs []authncm.AuthenticatorReference) []string {
+	amrs := make([]string, 0)
+	for _, ref := range refs {
+		for _, amr := range authncm.GetAuthenticatorAMR(ref.Authenticator) {
+			if !slices.Contains(amrs, amr) {
+				amrs = append(amrs, amr)
+			}
+		}
+	}
+	return amrs
+}
+
+// resolveCompletedAuthClass derives the authentication class satisfied by the given authentication methods from
+// the ACR-AMR mapping of the deployment. AMR keys of the mapping are matched case-insensitively. The first
+// satisfied class among the requested ones is returned. Without requested classes, the satisfied class that
+// requires the most methods is returned.
+func resolveCompletedAuthClass(requestedAuthClasses string, amrs []string) string {
+	acrAMR := config.GetServerRuntime().Config.OAuth.AuthClass.AcrAMR
+	if len(amrs) == 0 || len(acrAMR) == 0 {
+		return ""
+	}
+
+	isSatisfied := func(acr string) bool {
+		requiredAMRs, ok := acrAMR[acr]
+		if !ok {
+			return false
+		}
+		for _, required := range requiredAMRs {
+			if !slices.ContainsFunc(amrs, func(amr string) bool { return strings.EqualFold(amr, required) }) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if requested := strings.Fields(requestedAuthClasses); len(requested) > 0 {
+		for _, acr := range requested {
+			if isSatisfied(acr) {
+				return acr
+			}
+		}
+		return ""
+	}
+
+	acrs := make([]string, 0, len(acrAMR))
+	for acr := range acrAMR {
+		acrs = append(acrs, acr)
+	}
+	sort.Strings(acrs)
+
+	completedACR := ""
+	for _, acr := range acrs {
+		if isSatisfied(acr) && (completedACR == "" || len(acrAMR[acr]) > len(acrAMR[completedACR])) {
+			completedACR = acr
+		}
+	}
+	return completedACR
+}
+
 // getRequiredUserAttributes determines the list of user attribute keys that should be included in the
 // assertion based on runtime and application configuration.
 func (a *authAssertExecutor) getRequiredUserAttributes(ctx *core.NodeContext) (userAttributes []string) {
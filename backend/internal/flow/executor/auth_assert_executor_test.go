@@ -226,6 +226,80 @@ func (suite *AuthAssertExecutorTestSuite) TestExecute_WithAuthorizedPermissions(
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *AuthAssertExecutorTestSuite) TestExecute_WithRememberMe() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("/tmp/test", &config.Config{
+		JWT: config.JWTConfig{Issuer: "https://auth.example.com", ValidityPeriod: 3600},
+		SSOSession: config.SSOSessionConfig{
+			Enabled:    true,
+			RememberMe: config.RememberMeConfig{Enabled: false, Lifetime: 86400},
+		},
+	})
+	defer func() {
+		config.ResetServerRuntime()
+		_ = initializeTestRuntime()
+	}()
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+		},
+		UserInputs:       map[string]string{userInputRememberMe: "true"},
+		RuntimeData:      map[string]string{},
+		ExecutionHistory: map[string]*common.NodeExecutionRecord{},
+		Application: appmodel.Application{
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{
+				LoginExperience: &inboundmodel.LoginExperienceConfig{
+					RememberMe: &inboundmodel.RememberMeConfig{Enabled: true},
+				},
+			},
+		},
+	}
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[oauth2const.ClaimRememberMe] == int64(86400)
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_WithRememberMe_NotAllowed() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+		},
+		UserInputs:       map[string]string{userInputRememberMe: "true"},
+		RuntimeData:      map[string]string{},
+		ExecutionHistory: map[string]*common.NodeExecutionRecord{},
+		Application:      appmodel.Application{},
+	}
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, ok := claims[oauth2const.ClaimRememberMe]
+			return !ok
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *AuthAssertExecutorTestSuite) TestExecute_WithUserAttributes() {
 	attrs := map[string]interface{}{"email": testEmail, "phone": "1234567890"}
 	attrsJSON, _ := json.Marshal(attrs)
@@ -333,6 +407,159 @@ func (suite *AuthAssertExecutorTestSuite) TestExecute_AssertionGenerationFails_S
 	suite.mockAssertGenerator.AssertExpectations(suite.T())
 }
 
+func (suite *AuthAssertExecutorTestSuite) newOrganizationContext(userOUID string,
+	executorName string) *core.NodeContext {
+	return &core.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+			OUID:            userOUID,
+		},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyOrganizationID:         "org-123",
+			common.RuntimeKeyOrganizationOUID:       testAuthOUID,
+			common.RuntimeKeyOrganizationRequireIDP: dataValueTrue,
+		},
+		ExecutionHistory: map[string]*common.NodeExecutionRecord{
+			"node1": {
+				ExecutorName: executorName,
+				ExecutorType: common.ExecutorTypeAuthentication,
+				Status:       common.FlowStatusComplete,
+				Step:         1,
+			},
+		},
+		Application: appmodel.Application{},
+	}
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_Organization_AddsOrgIDClaim() {
+	ctx := suite.newOrganizationContext(testAssertOUID, ExecutorNameOIDCAuth)
+
+	suite.mockOUService.On("IsParent", mock.Anything, testAuthOUID, testAssertOUID).Return(true, nil).Once()
+	suite.mockAssertGenerator.On("GenerateAssertion", mock.Anything).Return(&authnassert.AssertionResult{
+		Context: &authnassert.AssuranceContext{},
+	}, nil)
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[oauth2const.ClaimOrgID] == "org-123"
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "jwt-token", resp.Assertion)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_AddsAdaptiveClaims() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+		},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyAdaptiveClaims: `{"risk":"low","level":2}`,
+		},
+		Application: appmodel.Application{},
+	}
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["risk"] == "low" && claims["level"] == float64(2)
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "jwt-token", resp.Assertion)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_AdaptiveClaimsDoNotOverrideServerClaims() {
+	ctx := suite.newOrganizationContext(testAssertOUID, ExecutorNameOIDCAuth)
+	ctx.RuntimeData[common.RuntimeKeyAdaptiveClaims] = `{"org_id":"org-other","risk":"low"}`
+
+	suite.mockOUService.On("IsParent", mock.Anything, testAuthOUID, testAssertOUID).Return(true, nil).Once()
+	suite.mockAssertGenerator.On("GenerateAssertion", mock.Anything).Return(&authnassert.AssertionResult{
+		Context: &authnassert.AssuranceContext{},
+	}, nil)
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[oauth2const.ClaimOrgID] == "org-123" && claims["risk"] == "low"
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_InvalidAdaptiveClaims() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+		},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyAdaptiveClaims: "not-json",
+		},
+		Application: appmodel.Application{},
+	}
+
+	_, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT")
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_Organization_UserNotInOrganization() {
+	ctx := suite.newOrganizationContext(testAssertOUID, ExecutorNameOIDCAuth)
+
+	suite.mockOUService.On("IsParent", mock.Anything, testAuthOUID, testAssertOUID).Return(false, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), failureReasonUserNotInOrg, resp.FailureReason)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT")
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_Organization_RequiresOrganizationIDP() {
+	ctx := suite.newOrganizationContext(testAuthOUID, ExecutorNameBasicAuth)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), failureReasonOrgIDPRequired, resp.FailureReason)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT")
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_Organization_MembershipCheckFails() {
+	ctx := suite.newOrganizationContext(testAssertOUID, ExecutorNameOIDCAuth)
+
+	suite.mockOUService.On("IsParent", mock.Anything, testAuthOUID, testAssertOUID).
+		Return(false, &serviceerror.InternalServerError).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), resp)
+}
+
 func (suite *AuthAssertExecutorTestSuite) TestExtractAuthenticatorReferences() {
 	history := map[string]*common.NodeExecutionRecord{
 		"node1": {
@@ -423,6 +650,107 @@ func (suite *AuthAssertExecutorTestSuite) TestExtractAuthenticatorReferences_SMS
 	assert.Equal(suite.T(), 1, refs[0].Step)
 }
 
+func (suite *AuthAssertExecutorTestSuite) TestResolveCompletedAMR() {
+	authncm.RegisterAuthenticator(authncm.AuthenticatorMeta{
+		Name: authncm.AuthenticatorCredentials,
+		AMR:  []string{authncm.AMRPassword},
+	})
+	authncm.RegisterAuthenticator(authncm.AuthenticatorMeta{
+		Name: authncm.AuthenticatorSMSOTP,
+		AMR:  []string{authncm.AMROTP, authncm.AMRSMS},
+	})
+	authncm.RegisterAuthenticator(authncm.AuthenticatorMeta{
+		Name: authncm.AuthenticatorMagicLink,
+		AMR:  []string{authncm.AMROTP},
+	})
+
+	refs := []authncm.AuthenticatorReference{
+		{Authenticator: authncm.AuthenticatorCredentials, Step: 1},
+		{Authenticator: authncm.AuthenticatorSMSOTP, Step: 2},
+		{Authenticator: authncm.AuthenticatorMagicLink, Step: 3},
+		{Authenticator: "UnknownAuthenticator", Step: 4},
+	}
+
+	amrs := resolveCompletedAMR(refs)
+
+	assert.Equal(suite.T(), []string{authncm.AMRPassword, authncm.AMROTP, authncm.AMRSMS}, amrs)
+	assert.Empty(suite.T(), resolveCompletedAMR(nil))
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestResolveCompletedAuthClass() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("/tmp/test", &config.Config{
+		OAuth: config.OAuthConfig{
+			AuthClass: config.AuthClassConfig{
+				AcrAMR: map[string][]string{
+					"urn:thunder:acr:password": {"PWD"},
+					"urn:thunder:acr:mfa":      {"pwd", "otp"},
+				},
+			},
+		},
+	})
+	defer func() {
+		config.ResetServerRuntime()
+		_ = initializeTestRuntime()
+	}()
+
+	testCases := []struct {
+		name      string
+		requested string
+		amrs      []string
+		expected  string
+	}{
+		{
+			name:      "No requested classes returns the strongest satisfied class",
+			requested: "",
+			amrs:      []string{"pwd", "otp", "sms"},
+			expected:  "urn:thunder:acr:mfa",
+		},
+		{
+			name:      "No requested classes with partial methods",
+			requested: "",
+			amrs:      []string{"pwd"},
+			expected:  "urn:thunder:acr:password",
+		},
+		{
+			name:      "First satisfied requested class is returned",
+			requested: "urn:thunder:acr:password urn:thunder:acr:mfa",
+			amrs:      []string{"pwd", "otp"},
+			expected:  "urn:thunder:acr:password",
+		},
+		{
+			name:      "Unsatisfied requested classes are skipped",
+			requested: "urn:thunder:acr:mfa urn:thunder:acr:password",
+			amrs:      []string{"pwd"},
+			expected:  "urn:thunder:acr:password",
+		},
+		{
+			name:      "No requested class satisfied",
+			requested: "urn:thunder:acr:mfa",
+			amrs:      []string{"otp"},
+			expected:  "",
+		},
+		{
+			name:      "Unknown requested class",
+			requested: "urn:thunder:acr:unknown",
+			amrs:      []string{"pwd", "otp"},
+			expected:  "",
+		},
+		{
+			name:      "No completed methods",
+			requested: "",
+			amrs:      []string{},
+			expected:  "",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			assert.Equal(suite.T(), tc.expected, resolveCompletedAuthClass(tc.requested, tc.amrs))
+		})
+	}
+}
+
 func (suite *AuthAssertExecutorTestSuite) TestGetUserAttributesFromUserProvider_Success() {
 	attrs := map[string]interface{}{"email": testEmail, "name": "Test User"}
 	attrsJSON, _ := json.Marshal(attrs)
@@ -34,6 +34,8 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -43,6 +45,14 @@ const (
 	failureReasonConsentDenied = "User denied consent"
 )
 
+// consentScope describes a requested scope on the consent prompt.
+type consentScope struct {
+	Scope          string `json:"scope"`
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	ResourceServer string `json:"resourceServer,omitempty"`
+}
+
 // consentExecutor handles consent collection during identity journeys.
 // It checks whether the authenticated user has the required consents for the application,
 // prompts if not, and records the user's decisions after they are collected by the prompt node.
@@ -50,6 +60,8 @@ type consentExecutor struct {
 	core.ExecutorInterface
 	consentEnforcer consentauthn.ConsentEnforcerServiceInterface
 	authnProvider   authnprovidermgr.AuthnProviderManagerInterface
+	resourceService resource.ResourceServiceInterface
+	scopeService    scope.ScopeServiceInterface
 	logger          *log.Logger
 }
 
@@ -60,6 +72,8 @@ func newConsentExecutor(
 	flowFactory core.FlowFactoryInterface,
 	consentEnforcer consentauthn.ConsentEnforcerServiceInterface,
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	resourceService resource.ResourceServiceInterface,
+	scopeService scope.ScopeServiceInterface,
 ) *consentExecutor {
 	logger := log.GetLogger().With(
 		log.String(log.LoggerKeyComponentName, "ConsentExecutor"),
@@ -87,6 +101,8 @@ func newConsentExecutor(
 		ExecutorInterface: base,
 		consentEnforcer:   consentEnforcer,
 		authnProvider:     authnProvider,
+		resourceService:   resourceService,
+		scopeService:      scopeService,
 		logger:            logger,
 	}
 }
@@ -163,6 +179,9 @@ func (e *consentExecutor) checkConsent(ctx *core.NodeContext, execResp *common.E
 
 	execResp.ForwardedData[common.ForwardedDataKeyConsentPrompt] = promptData.Purposes
 	execResp.AdditionalData[common.DataConsentPrompt] = string(promptJSON)
+	if scopesJSON := e.buildConsentScopes(ctx); scopesJSON != "" {
+		execResp.AdditionalData[common.DataConsentScopes] = scopesJSON
+	}
 
 	// Store the session token in RuntimeData for validation during consent recording
 	if promptData.SessionToken != "" {
@@ -186,6 +205,81 @@ func (e *consentExecutor) checkConsent(ctx *core.NodeContext, execResp *common.E
 	return execResp, nil
 }
 
+// buildConsentScopes describes the scopes requested by the OAuth client. Scopes defined through the scope
+// management API are described with their definitions and shown only when they require consent. The remaining
+// permission scopes are described using the names and descriptions registered on their resource servers, and are
+// omitted when they are not registered. An empty string is returned when there is nothing to describe.
+func (e *consentExecutor) buildConsentScopes(ctx *core.NodeContext) string {
+	permissions := strings.Fields(ctx.RuntimeData[common.RuntimeKeyRequestedPermissions])
+	requested := slices.Concat(strings.Fields(ctx.RuntimeData[common.RuntimeKeyRequestedScopes]), permissions)
+	if len(requested) == 0 {
+		return ""
+	}
+
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	definitions := make(map[string]scope.Scope)
+	if e.scopeService != nil {
+		defined, svcErr := e.scopeService.GetScopesByNames(ctx.Context, requested)
+		if svcErr != nil {
+			logger.Error("Failed to resolve requested scope definitions", log.String("error_code", svcErr.Code))
+			return ""
+		}
+		for _, definition := range defined {
+			definitions[definition.Name] = definition
+		}
+	}
+
+	detailsByPermission := make(map[string]resource.PermissionDetail)
+	permissions = slices.DeleteFunc(permissions, func(permission string) bool {
+		_, ok := definitions[permission]
+		return ok
+	})
+	if len(permissions) > 0 && e.resourceService != nil {
+		details, svcErr := e.resourceService.GetPermissionDetails(ctx.Context, permissions)
+		if svcErr != nil {
+			logger.Error("Failed to resolve requested permission details", log.String("error_code", svcErr.Code))
+			return ""
+		}
+		for _, detail := range details {
+			detailsByPermission[detail.Permission] = detail
+		}
+	}
+
+	scopes := make([]consentScope, 0, len(requested))
+	for _, requestedScope := range requested {
+		if definition, ok := definitions[requestedScope]; ok {
+			if definition.ConsentRequired {
+				scopes = append(scopes, consentScope{
+					Scope:       requestedScope,
+					Name:        definition.Name,
+					Description: definition.Description,
+				})
+			}
+			continue
+		}
+		detail, ok := detailsByPermission[requestedScope]
+		if !ok {
+			continue
+		}
+		scopes = append(scopes, consentScope{
+			Scope:          requestedScope,
+			Name:           detail.Name,
+			Description:    detail.Description,
+			ResourceServer: detail.ResourceServerName,
+		})
+	}
+	if len(scopes) == 0 {
+		return ""
+	}
+
+	scopesJSON, err := json.Marshal(scopes)
+	if err != nil {
+		logger.Error("Failed to marshal consent scopes", log.Error(err))
+		return ""
+	}
+	return string(scopesJSON)
+}
+
 // handleConsentDecisions processes the user's consent decisions.
 func (e *consentExecutor) handleConsentDecisions(ctx *core.NodeContext, execResp *common.ExecutorResponse,
 	ouID, appID, userID string) (*common.ExecutorResponse, error) {
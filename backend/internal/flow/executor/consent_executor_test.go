@@ -38,11 +38,15 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/consentenforcermock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/scopemock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 )
 
 const (
@@ -54,6 +58,8 @@ type ConsentExecutorTestSuite struct {
 	mockConsentEnforcer *consentenforcermock.ConsentEnforcerServiceInterfaceMock
 	mockAuthnProvider   *managermock.AuthnProviderManagerInterfaceMock
 	mockFlowFactory     *coremock.FlowFactoryInterfaceMock
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+	mockScopeService    *scopemock.ScopeServiceInterfaceMock
 	executor            *consentExecutor
 }
 
@@ -65,12 +71,15 @@ func (suite *ConsentExecutorTestSuite) SetupTest() {
 	suite.mockConsentEnforcer = consentenforcermock.NewConsentEnforcerServiceInterfaceMock(suite.T())
 	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerInterfaceMock(suite.T())
 	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.mockScopeService = scopemock.NewScopeServiceInterfaceMock(suite.T())
 
 	mockExec := createMockExecutorWithInputs(suite.T())
 	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameConsent, common.ExecutorTypeUtility,
 		mock.AnythingOfType("[]common.Input"), mock.AnythingOfType("[]common.Input")).Return(mockExec)
 
-	suite.executor = newConsentExecutor(suite.mockFlowFactory, suite.mockConsentEnforcer, suite.mockAuthnProvider)
+	suite.executor = newConsentExecutor(suite.mockFlowFactory, suite.mockConsentEnforcer, suite.mockAuthnProvider,
+		suite.mockResourceService, suite.mockScopeService)
 }
 
 // createMockExecutorWithInputs creates a mock executor that supports ValidatePrerequisites and HasRequiredInputs
@@ -356,6 +365,116 @@ func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PromptRequired_NoTim
 	assert.Equal(suite.T(), "app:app-123:attrs", parsedPrompt[0].PurposeName)
 }
 
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PromptRequired_WithRequestedScopes() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyRequestedPermissions] = "orders:read unknown orders:write"
+
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(false)
+
+	promptData := &consentauthn.ConsentPromptData{
+		Purposes: []consentauthn.ConsentPurposePrompt{{PurposeName: "purpose-1", Optional: []string{"email"}}},
+	}
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "user-123",
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(promptData, nil)
+	suite.mockScopeService.On("GetScopesByNames", mock.Anything,
+		[]string{"orders:read", "unknown", "orders:write"}).Return([]scope.Scope{}, nil)
+	suite.mockResourceService.On("GetPermissionDetails", mock.Anything,
+		[]string{"orders:read", "unknown", "orders:write"}).
+		Return([]resource.PermissionDetail{
+			{Permission: "orders:write", Name: "Write", ResourceServerName: "Orders API"},
+			{Permission: "orders:read", Name: "Read", Description: "Read your orders",
+				ResourceServerName: "Orders API"},
+		}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+
+	var parsedScopes []consentScope
+	parseErr := json.Unmarshal([]byte(resp.AdditionalData[common.DataConsentScopes]), &parsedScopes)
+	assert.NoError(suite.T(), parseErr)
+	assert.Equal(suite.T(), []consentScope{
+		{Scope: "orders:read", Name: "Read", Description: "Read your orders", ResourceServer: "Orders API"},
+		{Scope: "orders:write", Name: "Write", ResourceServer: "Orders API"},
+	}, parsedScopes)
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PromptRequired_WithDefinedScopes() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyRequestedScopes] = "openid profile"
+	ctx.RuntimeData[common.RuntimeKeyRequestedPermissions] = "docs:read orders:read audit:read"
+
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(false)
+
+	promptData := &consentauthn.ConsentPromptData{
+		Purposes: []consentauthn.ConsentPurposePrompt{{PurposeName: "purpose-1", Optional: []string{"email"}}},
+	}
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "user-123",
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(promptData, nil)
+	suite.mockScopeService.On("GetScopesByNames", mock.Anything,
+		[]string{"openid", "profile", "docs:read", "orders:read", "audit:read"}).
+		Return([]scope.Scope{
+			{Name: "profile", Description: "Your basic profile", ConsentRequired: true},
+			{Name: "docs:read", Description: "Read your documents", ConsentRequired: true},
+			{Name: "audit:read", Description: "Read the audit log"},
+		}, nil)
+	suite.mockResourceService.On("GetPermissionDetails", mock.Anything, []string{"orders:read"}).
+		Return([]resource.PermissionDetail{
+			{Permission: "orders:read", Name: "Read", ResourceServerName: "Orders API"},
+		}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+
+	var parsedScopes []consentScope
+	parseErr := json.Unmarshal([]byte(resp.AdditionalData[common.DataConsentScopes]), &parsedScopes)
+	assert.NoError(suite.T(), parseErr)
+	assert.Equal(suite.T(), []consentScope{
+		{Scope: "profile", Name: "profile", Description: "Your basic profile"},
+		{Scope: "docs:read", Name: "docs:read", Description: "Read your documents"},
+		{Scope: "orders:read", Name: "Read", ResourceServer: "Orders API"},
+	}, parsedScopes)
+}
+
+func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PromptRequired_ScopeDetailsError() {
+	ctx := buildConsentNodeContext()
+	ctx.RuntimeData[common.RuntimeKeyRequestedPermissions] = "orders:read"
+
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("ValidatePrerequisites", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(true)
+	suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock).
+		On("HasRequiredInputs", ctx, mock.AnythingOfType("*common.ExecutorResponse")).Return(false)
+
+	promptData := &consentauthn.ConsentPromptData{
+		Purposes: []consentauthn.ConsentPurposePrompt{{PurposeName: "purpose-1", Optional: []string{"email"}}},
+	}
+	suite.mockConsentEnforcer.On("ResolveConsent", mock.Anything, "default", "app-123", "user-123",
+		mock.Anything, mock.Anything, mock.Anything).
+		Return(promptData, nil)
+	suite.mockScopeService.On("GetScopesByNames", mock.Anything, []string{"orders:read"}).
+		Return([]scope.Scope{}, nil)
+	suite.mockResourceService.On("GetPermissionDetails", mock.Anything, []string{"orders:read"}).
+		Return(nil, &serviceerror.InternalServerError)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+	assert.NotEmpty(suite.T(), resp.AdditionalData[common.DataConsentPrompt])
+	assert.NotContains(suite.T(), resp.AdditionalData, common.DataConsentScopes)
+}
+
 func (suite *ConsentExecutorTestSuite) TestExecute_NoInputs_PromptRequired_StoresSessionToken() {
 	ctx := buildConsentNodeContext()
 
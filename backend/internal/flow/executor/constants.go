@@ -23,6 +23,7 @@ const (
 	ExecutorNameBasicAuth     = "BasicAuthExecutor"
 	ExecutorNameSMSAuth       = "SMSOTPAuthExecutor"
 	ExecutorNameMagicLinkAuth = "MagicLinkAuthExecutor"
+	ExecutorNameTOTPAuth      = "TOTPAuthExecutor"
 	// nolint:gosec // G101: This is an executor name, not a credential
 	ExecutorNamePasskeyAuth                  = "PasskeyAuthExecutor"
 	ExecutorNameOAuth                        = "OAuthExecutor"
@@ -46,6 +47,9 @@ const (
 	ExecutorNameAttributeUniquenessValidator = "AttributeUniquenessValidator"
 	ExecutorNameSMSExecutor                  = "SMSExecutor"
 	ExecutorNameFederatedAuthResolver        = "FederatedAuthResolverExecutor"
+	ExecutorNameOrganizationResolver         = "OrganizationResolverExecutor"
+	ExecutorNameAdaptiveScript               = "AdaptiveScriptExecutor"
+	ExecutorNameProgressiveProfile           = "ProgressiveProfileExecutor"
 )
 
 // Executor mode constants
@@ -55,6 +59,7 @@ const (
 	ExecutorModeVerify   = "verify"
 	ExecutorModeIdentify = "identify"
 	ExecutorModeResolve  = "resolve"
+	ExecutorModeEnroll   = "enroll"
 )
 
 // User attribute and input constants
@@ -75,8 +80,12 @@ const (
 	userInputOuDesc           = "ouDescription"
 	userInputInviteToken      = "inviteToken"
 	userInputOTP              = "otp"
+	userInputTOTP             = "totp"
 	userInputMagicLinkToken   = "token"
 	userInputConsentDecisions = "consent_decisions"
+	userInputRememberMe       = "rememberMe"
+	userInputOrganization     = "organization"
+	userInputIDPID            = "idpId"
 
 	ouIDKey        = "ouId"
 	defaultOUIDKey = "defaultOUID"
@@ -101,10 +110,19 @@ const (
 	propertyKeyDynamicInputsIncludeOptional            = "includeOptional"
 	propertyKeyDynamicInputsIncludeOptionalCredentials = "includeOptionalCredentials"
 	propertyKeyMaxDynamicInputsPerPrompt               = "maxPerPrompt"
+	propertyKeyOTPLength                               = "otpLength"
+	propertyKeyOTPValidityPeriod                       = "otpValidityPeriod"
+	propertyKeyOTPMaxAttempts                          = "maxAttempts"
+	propertyKeyTOTPIssuer                              = "issuer"
+	propertyKeyTOTPAccountNameAttribute                = "accountNameAttribute"
+	propertyKeyScript                                  = "script"
+	propertyKeyScriptTimeout                           = "timeout"
+	propertyKeyRequiredAttributes                      = "requiredAttributes"
 )
 
 // nonSearchableInputs contains the list of user inputs/ attributes that are non-searchable.
-var nonSearchableInputs = []string{"password", "code", "nonce", "otp", "token", "userInputMagicLinkToken"}
+var nonSearchableInputs = []string{"password", "code", "nonce", "otp", "totp", "token", "userInputMagicLinkToken",
+	userInputOrganization, userInputIDPID}
 
 // Failure reason constants
 const (
@@ -114,5 +132,13 @@ const (
 	failureReasonFailedToIdentifyUser = "Failed to identify user"
 	failureReasonAmbiguousUser        = "User identity is ambiguous"
 	failureReasonInvalidOTP           = "invalid OTP provided"
+	failureReasonInvalidTOTP          = "invalid TOTP code provided"
 	failureReasonInvalidMagicLink     = "Invalid magic link token"
+	failureReasonIDPNotAllowed        = "Identity provider is not allowed for the application"
+	failureReasonIDPUnavailable       = "Identity provider is temporarily unavailable"
+	failureReasonOrgNotFound          = "Organization not found"
+	failureReasonOrgIDPRequired       = "Organization requires login with an organization identity provider"
+	failureReasonUserNotInOrg         = "User does not belong to the organization"
+	failureReasonAccessDenied         = "Access denied"
+	failureReasonScriptError          = "Failed to evaluate the adaptive authentication script"
 )
@@ -25,6 +25,8 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/notification"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
 	"github.com/thunder-id/thunderid/internal/system/email"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/template"
@@ -32,10 +34,12 @@ import (
 
 // emailExecutor sends emails based on the configured email template and runtime context data.
 // When email is not configured (emailClient is nil), it returns a failure status.
+// Send attempts are recorded in the notification send audit when the audit service is configured.
 type emailExecutor struct {
 	core.ExecutorInterface
 	logger          *log.Logger
 	emailClient     email.EmailClientInterface
+	sendAuditSvc    notification.SendAuditServiceInterface
 	templateService template.TemplateServiceInterface
 	entityProvider  entityprovider.EntityProviderInterface
 }
@@ -50,7 +54,7 @@ var defaultEmailInput = common.Input{
 
 // newEmailExecutor creates a new instance of the email executor.
 func newEmailExecutor(flowFactory core.FlowFactoryInterface, emailClient email.EmailClientInterface,
-	templateService template.TemplateServiceInterface,
+	sendAuditSvc notification.SendAuditServiceInterface, templateService template.TemplateServiceInterface,
 	entityProvider entityprovider.EntityProviderInterface) *emailExecutor {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "EmailExecutor"))
 	base := flowFactory.CreateExecutor(
@@ -65,6 +69,7 @@ func newEmailExecutor(flowFactory core.FlowFactoryInterface, emailClient email.E
 		ExecutorInterface: base,
 		logger:            logger,
 		emailClient:       emailClient,
+		sendAuditSvc:      sendAuditSvc,
 		templateService:   templateService,
 		entityProvider:    entityProvider,
 	}
@@ -150,7 +155,9 @@ func (e *emailExecutor) executeSend(ctx *core.NodeContext) (*common.ExecutorResp
 		IsHTML:  rendered.IsHTML,
 	}
 
-	if err := e.emailClient.Send(emailData); err != nil {
+	err = e.emailClient.Send(emailData)
+	e.recordSend(ctx, scenario, recipient, err)
+	if err != nil {
 		if isEmailError(err) {
 			logger.Error("Error sending mail : ", log.Error(err))
 			execResp.Status = common.ExecFailure
@@ -168,6 +175,26 @@ func (e *emailExecutor) executeSend(ctx *core.NodeContext) (*common.ExecutorResp
 	return execResp, nil
 }
 
+// recordSend records the email send attempt in the notification send audit.
+func (e *emailExecutor) recordSend(ctx *core.NodeContext, scenario template.ScenarioType, recipient string,
+	sendErr error) {
+	if e.sendAuditSvc == nil {
+		return
+	}
+
+	record := notifcm.SendAuditRecord{
+		Channel:   notifcm.ChannelTypeEmail,
+		Template:  string(scenario),
+		Recipient: recipient,
+		Status:    notifcm.SendStatusSuccess,
+	}
+	if sendErr != nil {
+		record.Status = notifcm.SendStatusFailed
+		record.ProviderResponse = sendErr.Error()
+	}
+	e.sendAuditSvc.RecordSend(ctx.Context, record)
+}
+
 // resolveRecipientEmail retrieves the recipient email from user inputs, runtime data, or forwarded data.
 func (e *emailExecutor) resolveRecipientEmail(ctx *core.NodeContext, logger *log.Logger) (string, error) {
 	emailAttr := e.resolveEmailInput(ctx).Identifier
@@ -22,17 +22,20 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
 	"github.com/thunder-id/thunderid/internal/system/email"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/template"
 	"github.com/thunder-id/thunderid/tests/mocks/emailmock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/notification/notificationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/templatemock"
 )
 
@@ -42,6 +45,7 @@ type EmailExecutorTestSuite struct {
 	mockEmailClient     *emailmock.EmailClientInterfaceMock
 	mockTemplateService *templatemock.TemplateServiceInterfaceMock
 	mockEntityProvider  *entityprovidermock.EntityProviderInterfaceMock
+	mockSendAuditSvc    *notificationmock.SendAuditServiceInterfaceMock
 	executor            *emailExecutor
 }
 
@@ -51,6 +55,8 @@ func (suite *EmailExecutorTestSuite) SetupTest() {
 	suite.mockEmailClient = emailmock.NewEmailClientInterfaceMock(suite.T())
 	suite.mockTemplateService = templatemock.NewTemplateServiceInterfaceMock(suite.T())
 	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockSendAuditSvc = notificationmock.NewSendAuditServiceInterfaceMock(suite.T())
+	suite.mockSendAuditSvc.EXPECT().RecordSend(mock.Anything, mock.Anything).Maybe()
 
 	suite.mockFlowFactory.On("CreateExecutor",
 		ExecutorNameEmailExecutor,
@@ -64,6 +70,7 @@ func (suite *EmailExecutorTestSuite) SetupTest() {
 	suite.executor = newEmailExecutor(
 		suite.mockFlowFactory,
 		suite.mockEmailClient,
+		suite.mockSendAuditSvc,
 		suite.mockTemplateService,
 		suite.mockEntityProvider,
 	)
@@ -476,7 +483,8 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_NilTemplateService() {
 		},
 	).Return(mockBaseExecutor)
 
-	noServiceExecutor := newEmailExecutor(mockFactory, suite.mockEmailClient, nil, suite.mockEntityProvider)
+	noServiceExecutor := newEmailExecutor(mockFactory, suite.mockEmailClient, suite.mockSendAuditSvc, nil,
+		suite.mockEntityProvider)
 
 	ctx := &core.NodeContext{
 		ExecutionID:  "test-execution-id",
@@ -540,6 +548,50 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_ClientError() {
 	suite.Equal("Failed to send email", resp.FailureReason)
 }
 
+func (suite *EmailExecutorTestSuite) TestExecute_SendMode_RecordsSendAudit() {
+	cases := []struct {
+		name           string
+		sendErr        error
+		expectedStatus notifcm.SendStatus
+	}{
+		{name: "Success", sendErr: nil, expectedStatus: notifcm.SendStatusSuccess},
+		{name: "Failure", sendErr: email.ErrorSMTPConnection, expectedStatus: notifcm.SendStatusFailed},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := &core.NodeContext{
+				ExecutionID:  "test-execution-id",
+				FlowType:     common.FlowTypeRecovery,
+				ExecutorMode: ExecutorModeSend,
+				UserInputs: map[string]string{
+					"email": "user@example.com",
+				},
+				NodeProperties: map[string]interface{}{
+					"emailTemplate": "PASSWORD_RESET",
+				},
+			}
+
+			suite.mockTemplateService.On("Render", ctx.Context, template.ScenarioType("PASSWORD_RESET"),
+				template.TemplateTypeEmail, mock.Anything).
+				Return(&template.RenderedTemplate{Subject: "Reset", Body: "Reset your password"}, nil)
+			suite.mockEmailClient.On("Send", mock.Anything).Return(tc.sendErr)
+
+			mockSendAuditSvc := notificationmock.NewSendAuditServiceInterfaceMock(suite.T())
+			mockSendAuditSvc.EXPECT().RecordSend(mock.Anything,
+				mock.MatchedBy(func(record notifcm.SendAuditRecord) bool {
+					return record.Channel == notifcm.ChannelTypeEmail && record.Template == "PASSWORD_RESET" &&
+						record.Recipient == "user@example.com" && record.Status == tc.expectedStatus
+				})).Once()
+			suite.executor.sendAuditSvc = mockSendAuditSvc
+
+			_, err := suite.executor.Execute(ctx)
+			suite.NoError(err)
+		})
+	}
+}
+
 func (suite *EmailExecutorTestSuite) TestExecute_SendMode_KnownSMTPErrors() {
 	cases := []struct {
 		name    string
@@ -649,7 +701,8 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_NilEmailClient_Returns
 		},
 	).Return(mockBaseExecutor)
 
-	noEmailExecutor := newEmailExecutor(mockFactory, nil, suite.mockTemplateService, suite.mockEntityProvider)
+	noEmailExecutor := newEmailExecutor(mockFactory, nil, suite.mockSendAuditSvc, suite.mockTemplateService,
+		suite.mockEntityProvider)
 
 	ctx := &core.NodeContext{
 		ExecutionID:  "test-execution-id",
@@ -993,7 +1046,8 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_NilEntityProvider_Retu
 	).Return(mockBaseExecutor)
 
 	// Create executor with nil entity provider
-	noProviderExecutor := newEmailExecutor(mockFactory, suite.mockEmailClient, suite.mockTemplateService, nil)
+	noProviderExecutor := newEmailExecutor(mockFactory, suite.mockEmailClient, suite.mockSendAuditSvc,
+		suite.mockTemplateService, nil)
 
 	ctx := &core.NodeContext{
 		ExecutionID:  "test-execution-id",
@@ -21,6 +21,7 @@ package executor
 import (
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	authnoauth "github.com/thunder-id/thunderid/internal/authn/oauth"
@@ -50,6 +51,7 @@ func TestGithubAuthExecutorTestSuite(t *testing.T) {
 func (suite *GithubAuthExecutorTestSuite) SetupTest() {
 	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
 	suite.mockIDPService = idpmock.NewIDPServiceInterfaceMock(suite.T())
+	suite.mockIDPService.EXPECT().IsIdentityProviderAvailable(mock.Anything).Return(true).Maybe()
 	suite.mockEntityTypeService = entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T())
 	suite.mockGithubService = githubmock.NewGithubOAuthAuthnServiceInterfaceMock(suite.T())
 	suite.mockOAuthService = oauthmock.NewOAuthAuthnCoreServiceInterfaceMock(suite.T())
@@ -21,6 +21,7 @@ package executor
 import (
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	authnoidc "github.com/thunder-id/thunderid/internal/authn/oidc"
@@ -50,6 +51,7 @@ func TestGoogleAuthExecutorTestSuite(t *testing.T) {
 func (suite *GoogleAuthExecutorTestSuite) SetupTest() {
 	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
 	suite.mockIDPService = idpmock.NewIDPServiceInterfaceMock(suite.T())
+	suite.mockIDPService.EXPECT().IsIdentityProviderAvailable(mock.Anything).Return(true).Maybe()
 	suite.mockEntityTypeService = entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T())
 	suite.mockGoogleService = googlemock.NewGoogleOIDCAuthnServiceInterfaceMock(suite.T())
 	suite.mockOIDCService = oidcmock.NewOIDCAuthnCoreServiceInterfaceMock(suite.T())
@@ -164,6 +164,18 @@ func (o *oAuthExecutor) BuildAuthorizeFlow(ctx *core.NodeContext, execResp *comm
 	if err != nil {
 		return err
 	}
+	if !isIDPAllowed(ctx, idpID) {
+		logger.Debug("Identity provider is not allowed for the application", log.String("idpId", idpID))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonIDPNotAllowed
+		return nil
+	}
+	if !o.idpService.IsIdentityProviderAvailable(idpID) {
+		logger.Debug("Identity provider is circuit-broken, failing fast", log.String("idpId", idpID))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonIDPUnavailable
+		return nil
+	}
 
 	authorizeURL, svcErr := o.authService.BuildAuthorizeURL(ctx.Context, idpID)
 	if svcErr != nil {
@@ -234,6 +246,19 @@ func (o *oAuthExecutor) ProcessAuthFlowResponse(ctx *core.NodeContext,
 	if err != nil {
 		return err
 	}
+	if !isIDPAllowed(ctx, idpID) {
+		logger.Debug("Rejected federated login with an identity provider not allowed for the application",
+			log.String("idpId", idpID))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonIDPNotAllowed
+		return nil
+	}
+	if !o.idpService.IsIdentityProviderAvailable(idpID) {
+		logger.Debug("Identity provider is circuit-broken, failing fast", log.String("idpId", idpID))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonIDPUnavailable
+		return nil
+	}
 
 	credentials := map[string]interface{}{
 		"federated": &authncm.FederatedAuthCredential{
@@ -313,6 +338,10 @@ func (o *oAuthExecutor) GetIdpID(ctx *core.NodeContext) (string, error) {
 			}
 		}
 	}
+	// Fall back to the identity provider of the organization resolved for the current login.
+	if idpID := ctx.RuntimeData[common.RuntimeKeyOrganizationIDP]; idpID != "" {
+		return idpID, nil
+	}
 	return "", errors.New("idpId is not configured in node properties")
 }
 
@@ -60,6 +60,7 @@ func TestOAuthExecutorSuite(t *testing.T) {
 func (suite *OAuthExecutorTestSuite) SetupTest() {
 	suite.mockOAuthService = oauthmock.NewOAuthAuthnCoreServiceInterfaceMock(suite.T())
 	suite.mockIDPService = idpmock.NewIDPServiceInterfaceMock(suite.T())
+	suite.mockIDPService.EXPECT().IsIdentityProviderAvailable(mock.Anything).Return(true).Maybe()
 	suite.mockEntityTypeService = entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T())
 	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
 	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerInterfaceMock(suite.T())
@@ -193,6 +194,60 @@ func (suite *OAuthExecutorTestSuite) TestBuildAuthorizeFlow_IDPNotConfigured() {
 	assert.Contains(suite.T(), err.Error(), "idpId is not configured")
 }
 
+func (suite *OAuthExecutorTestSuite) TestBuildAuthorizeFlow_IDPNotAllowed() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		NodeProperties: map[string]interface{}{
+			"idpId": "idp-123",
+		},
+		Application: appmodel.Application{
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{
+				LoginExperience: &inboundmodel.LoginExperienceConfig{AllowedIDPs: []string{"idp-456"}},
+			},
+		},
+	}
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	err := suite.executor.BuildAuthorizeFlow(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	assert.Equal(suite.T(), failureReasonIDPNotAllowed, execResp.FailureReason)
+	assert.Empty(suite.T(), execResp.RedirectURL)
+	suite.mockOAuthService.AssertNotCalled(suite.T(), "BuildAuthorizeURL", mock.Anything, mock.Anything)
+}
+
+func (suite *OAuthExecutorTestSuite) TestBuildAuthorizeFlow_IDPCircuitOpen() {
+	suite.mockIDPService.ExpectedCalls = nil
+	suite.mockIDPService.EXPECT().IsIdentityProviderAvailable("idp-123").Return(false)
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		NodeProperties: map[string]interface{}{
+			"idpId": "idp-123",
+		},
+	}
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	err := suite.executor.BuildAuthorizeFlow(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	assert.Equal(suite.T(), failureReasonIDPUnavailable, execResp.FailureReason)
+	assert.Empty(suite.T(), execResp.RedirectURL)
+	suite.mockOAuthService.AssertNotCalled(suite.T(), "BuildAuthorizeURL", mock.Anything, mock.Anything)
+}
+
 func (suite *OAuthExecutorTestSuite) TestBuildAuthorizeFlow_BuildURLClientError() {
 	ctx := &core.NodeContext{
 		ExecutionID: "flow-123",
@@ -268,6 +323,22 @@ func (suite *OAuthExecutorTestSuite) TestGetIdpID_Success() {
 	assert.Equal(suite.T(), "idp-123", idpID)
 }
 
+func (suite *OAuthExecutorTestSuite) TestGetIdpID_FromOrganization() {
+	ctx := &core.NodeContext{
+		ExecutionID:    "flow-123",
+		FlowType:       common.FlowTypeAuthentication,
+		NodeProperties: map[string]interface{}{},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyOrganizationIDP: "org-idp-123",
+		},
+	}
+
+	idpID, err := suite.executor.GetIdpID(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "org-idp-123", idpID)
+}
+
 func (suite *OAuthExecutorTestSuite) TestGetIdpID_NotConfigured() {
 	ctx := &core.NodeContext{
 		ExecutionID:    "flow-123",
@@ -405,6 +476,67 @@ func (suite *OAuthExecutorTestSuite) TestProcessAuthFlowResponse_NoCodeProvided(
 	assert.False(suite.T(), execResp.AuthenticatedUser.IsAuthenticated)
 }
 
+func (suite *OAuthExecutorTestSuite) TestProcessAuthFlowResponse_IDPNotAllowed() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			"code": "auth_code_123",
+		},
+		NodeProperties: map[string]interface{}{
+			"idpId": "idp-123",
+		},
+		Application: appmodel.Application{
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{
+				LoginExperience: &inboundmodel.LoginExperienceConfig{AllowedIDPs: []string{"idp-456"}},
+			},
+		},
+	}
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	err := suite.executor.ProcessAuthFlowResponse(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	assert.Equal(suite.T(), failureReasonIDPNotAllowed, execResp.FailureReason)
+	assert.False(suite.T(), execResp.AuthenticatedUser.IsAuthenticated)
+	suite.mockAuthnProvider.AssertNotCalled(suite.T(), "AuthenticateUser", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OAuthExecutorTestSuite) TestProcessAuthFlowResponse_IDPCircuitOpen() {
+	suite.mockIDPService.ExpectedCalls = nil
+	suite.mockIDPService.EXPECT().IsIdentityProviderAvailable("idp-123").Return(false)
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			"code": "auth_code_123",
+		},
+		NodeProperties: map[string]interface{}{
+			"idpId": "idp-123",
+		},
+	}
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	err := suite.executor.ProcessAuthFlowResponse(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	assert.Equal(suite.T(), failureReasonIDPUnavailable, execResp.FailureReason)
+	suite.mockAuthnProvider.AssertNotCalled(suite.T(), "AuthenticateUser", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *OAuthExecutorTestSuite) TestProcessAuthFlowResponse_ProviderClientError() { //nolint:dupl
 	ctx := &core.NodeContext{
 		ExecutionID: "flow-123",
@@ -53,6 +53,7 @@ type oidcAuthExecutor struct {
 	oAuthExecutorInterface
 	authService   authnoidc.OIDCAuthnCoreServiceInterface
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface
+	idpService    idp.IDPServiceInterface
 	idpType       idp.IDPType
 	logger        *log.Logger
 }
@@ -88,6 +89,7 @@ func newOIDCAuthExecutor(
 		oAuthExecutorInterface: base,
 		authService:            authService,
 		authnProvider:          authnProvider,
+		idpService:             idpService,
 		idpType:                idpType,
 		logger:                 logger,
 	}
@@ -155,6 +157,19 @@ func (o *oidcAuthExecutor) ProcessAuthFlowResponse(ctx *core.NodeContext,
 	if err != nil {
 		return err
 	}
+	if !isIDPAllowed(ctx, idpID) {
+		logger.Debug("Rejected federated login with an identity provider not allowed for the application",
+			log.String("idpId", idpID))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonIDPNotAllowed
+		return nil
+	}
+	if !o.idpService.IsIdentityProviderAvailable(idpID) {
+		logger.Debug("Identity provider is circuit-broken, failing fast", log.String("idpId", idpID))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonIDPUnavailable
+		return nil
+	}
 
 	credentials := map[string]interface{}{
 		"federated": &authncm.FederatedAuthCredential{
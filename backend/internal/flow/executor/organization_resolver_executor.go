@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/organization"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+var _ core.ExecutorInterface = (*organizationResolverExecutor)(nil)
+
+// organizationResolverExecutor resolves the organization a user is logging in to.
+// The organization is taken from the organization requested by the OAuth client, or discovered from
+// the organization handle or email address entered by the user. The resolved organization and its
+// identity providers are stored in the runtime data so that the federated authentication executors
+// and the auth assert executor can apply the organization's authentication policy.
+type organizationResolverExecutor struct {
+	core.ExecutorInterface
+	orgService organization.OrganizationServiceInterface
+	logger     *log.Logger
+}
+
+// newOrganizationResolverExecutor creates a new organization resolver executor.
+func newOrganizationResolverExecutor(
+	flowFactory core.FlowFactoryInterface,
+	orgService organization.OrganizationServiceInterface,
+) *organizationResolverExecutor {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "OrganizationResolverExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameOrganizationResolver))
+
+	defaultInputs := []common.Input{
+		{
+			Ref:        "organization_input",
+			Identifier: userInputOrganization,
+			Type:       common.InputTypeText,
+			Required:   true,
+		},
+	}
+
+	base := flowFactory.CreateExecutor(
+		ExecutorNameOrganizationResolver,
+		common.ExecutorTypeUtility,
+		defaultInputs,
+		[]common.Input{},
+	)
+	return &organizationResolverExecutor{
+		ExecutorInterface: base,
+		orgService:        orgService,
+		logger:            logger,
+	}
+}
+
+// Execute resolves the organization and the organization identity provider for the current login.
+func (e *organizationResolverExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	execResp := &common.ExecutorResponse{
+		RuntimeData:    make(map[string]string),
+		AdditionalData: make(map[string]string),
+		ForwardedData:  make(map[string]interface{}),
+	}
+
+	var org *organization.Organization
+	if orgID := ctx.RuntimeData[common.RuntimeKeyOrganizationID]; orgID != "" {
+		var svcErr *serviceerror.ServiceError
+		org, svcErr = e.orgService.GetOrganization(ctx.Context, orgID)
+		if svcErr != nil {
+			if svcErr.Type == serviceerror.ClientErrorType {
+				execResp.Status = common.ExecFailure
+				execResp.FailureReason = failureReasonOrgNotFound
+				return execResp, nil
+			}
+			return nil, errors.New("failed to retrieve organization: " + svcErr.Error.DefaultValue)
+		}
+	} else {
+		var err error
+		org, err = e.resolveOrganization(ctx, execResp, logger)
+		if err != nil {
+			return nil, err
+		}
+		if org == nil {
+			return execResp, nil
+		}
+
+		logger.Debug("Organization resolved", log.String("organizationID", org.ID))
+		execResp.RuntimeData[common.RuntimeKeyOrganizationID] = org.ID
+		execResp.RuntimeData[common.RuntimeKeyOrganizationOUID] = org.OUID
+		execResp.RuntimeData[common.RuntimeKeyOrganizationIDPs] = strings.Join(org.IdentityProviders, " ")
+		execResp.RuntimeData[common.RuntimeKeyOrganizationRequireIDP] =
+			strconv.FormatBool(org.AuthenticationPolicy.RequireOrganizationIDP)
+	}
+
+	e.resolveIdentityProvider(ctx, org, execResp, logger)
+	return execResp, nil
+}
+
+// resolveOrganization resolves the organization requested by the OAuth client or entered by the user.
+// A nil organization is returned when the executor response has been populated with a user input
+// request or a failure.
+func (e *organizationResolverExecutor) resolveOrganization(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse, logger *log.Logger) (*organization.Organization, error) {
+	if requested := ctx.RuntimeData[common.RuntimeKeyRequestedOrganization]; requested != "" {
+		org, err := e.getRequestedOrganization(ctx, requested)
+		if err != nil {
+			return nil, err
+		}
+		if org == nil {
+			logger.Debug("Requested organization not found", log.String("organization", requested))
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = failureReasonOrgNotFound
+		}
+		return org, nil
+	}
+
+	value := strings.TrimSpace(ctx.UserInputs[userInputOrganization])
+	if value == "" {
+		logger.Debug("Organization not provided, requesting organization input")
+		e.requestOrganizationInput(ctx, execResp)
+		return nil, nil
+	}
+
+	var org *organization.Organization
+	var svcErr *serviceerror.ServiceError
+	if at := strings.LastIndex(value, "@"); at >= 0 {
+		org, svcErr = e.orgService.GetOrganizationByEmailDomain(ctx.Context, value[at+1:])
+	} else {
+		org, svcErr = e.orgService.GetOrganizationByHandle(ctx.Context, strings.ToLower(value))
+	}
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			logger.Debug("No organization found for the provided value")
+			e.requestOrganizationInput(ctx, execResp)
+			execResp.FailureReason = failureReasonOrgNotFound
+			return nil, nil
+		}
+		return nil, errors.New("failed to resolve organization: " + svcErr.Error.DefaultValue)
+	}
+
+	return org, nil
+}
+
+// getRequestedOrganization retrieves the organization requested by the OAuth client either by its
+// handle or by its ID. Returns nil if no such organization exists.
+func (e *organizationResolverExecutor) getRequestedOrganization(ctx *core.NodeContext,
+	requested string) (*organization.Organization, error) {
+	org, svcErr := e.orgService.GetOrganizationByHandle(ctx.Context, requested)
+	if svcErr == nil {
+		return org, nil
+	}
+	if svcErr.Type != serviceerror.ClientErrorType {
+		return nil, errors.New("failed to resolve organization: " + svcErr.Error.DefaultValue)
+	}
+
+	org, svcErr = e.orgService.GetOrganization(ctx.Context, requested)
+	if svcErr == nil {
+		return org, nil
+	}
+	if svcErr.Type != serviceerror.ClientErrorType {
+		return nil, errors.New("failed to resolve organization: " + svcErr.Error.DefaultValue)
+	}
+	return nil, nil
+}
+
+// requestOrganizationInput populates the executor response with a request for the organization input.
+func (e *organizationResolverExecutor) requestOrganizationInput(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) {
+	execResp.Status = common.ExecUserInputRequired
+	execResp.Inputs = e.GetRequiredInputs(ctx)
+	execResp.ForwardedData[common.ForwardedDataKeyInputs] = execResp.Inputs
+}
+
+// resolveIdentityProvider selects the identity provider to be used for the organization. When the
+// organization has more than one identity provider, the user is prompted to select one.
+func (e *organizationResolverExecutor) resolveIdentityProvider(ctx *core.NodeContext,
+	org *organization.Organization, execResp *common.ExecutorResponse, logger *log.Logger) {
+	switch len(org.IdentityProviders) {
+	case 0:
+		execResp.Status = common.ExecComplete
+		return
+	case 1:
+		execResp.RuntimeData[common.RuntimeKeyOrganizationIDP] = org.IdentityProviders[0]
+		execResp.Status = common.ExecComplete
+		return
+	}
+
+	selected := ctx.UserInputs[userInputIDPID]
+	if slices.Contains(org.IdentityProviders, selected) {
+		logger.Debug("Organization identity provider selected", log.String(userInputIDPID, selected))
+		execResp.RuntimeData[common.RuntimeKeyOrganizationIDP] = selected
+		execResp.Status = common.ExecComplete
+		return
+	}
+
+	if selected != "" {
+		execResp.FailureReason = failureReasonIDPNotAllowed
+	}
+	execResp.Status = common.ExecUserInputRequired
+	execResp.Inputs = []common.Input{
+		{
+			Ref:        "organization_idp_input",
+			Identifier: userInputIDPID,
+			Type:       common.InputTypeText,
+			Required:   true,
+		},
+	}
+	execResp.AdditionalData[common.DataOrganizationIDPs] = strings.Join(org.IdentityProviders, " ")
+	execResp.ForwardedData[common.ForwardedDataKeyInputs] = execResp.Inputs
+}
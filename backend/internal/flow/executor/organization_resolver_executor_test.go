@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/organization"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/organizationmock"
+)
+
+const (
+	testOrgID    = "org-123"
+	testOrgOUID  = "org-ou-123"
+	testOrgIDP1  = "org-idp-1"
+	testOrgIDP2  = "org-idp-2"
+	testOrgEmail = "alice@acme.com"
+)
+
+type OrganizationResolverExecutorTestSuite struct {
+	suite.Suite
+	mockFlowFactory *coremock.FlowFactoryInterfaceMock
+	mockOrgService  *organizationmock.OrganizationServiceInterfaceMock
+	executor        *organizationResolverExecutor
+}
+
+func TestOrganizationResolverExecutorSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationResolverExecutorTestSuite))
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) SetupTest() {
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockOrgService = organizationmock.NewOrganizationServiceInterfaceMock(suite.T())
+
+	defaultInputs := []common.Input{
+		{
+			Ref:        "organization_input",
+			Identifier: userInputOrganization,
+			Type:       common.InputTypeText,
+			Required:   true,
+		},
+	}
+
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameOrganizationResolver, common.ExecutorTypeUtility,
+		defaultInputs, []common.Input{}).Return(
+		newMockExecutor(ExecutorNameOrganizationResolver, common.ExecutorTypeUtility, defaultInputs,
+			[]common.Input{}))
+
+	suite.executor = newOrganizationResolverExecutor(suite.mockFlowFactory, suite.mockOrgService)
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) newOrganization(idps ...string) *organization.Organization {
+	return &organization.Organization{
+		ID:                   testOrgID,
+		Handle:               "acme",
+		OUID:                 testOrgOUID,
+		IdentityProviders:    idps,
+		AuthenticationPolicy: organization.AuthenticationPolicy{RequireOrganizationIDP: true},
+	}
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) newContext(
+	userInputs, runtimeData map[string]string) *core.NodeContext {
+	if userInputs == nil {
+		userInputs = map[string]string{}
+	}
+	if runtimeData == nil {
+		runtimeData = map[string]string{}
+	}
+	return &core.NodeContext{
+		ExecutionID: "test-flow",
+		Context:     context.Background(),
+		UserInputs:  userInputs,
+		RuntimeData: runtimeData,
+	}
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_PromptsForOrganization() {
+	resp, err := suite.executor.Execute(suite.newContext(nil, nil))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Require().Len(resp.Inputs, 1)
+	suite.Equal(userInputOrganization, resp.Inputs[0].Identifier)
+	suite.Empty(resp.FailureReason)
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_ResolvesByHandle() {
+	suite.mockOrgService.EXPECT().GetOrganizationByHandle(mock.Anything, "acme").
+		Return(suite.newOrganization(testOrgIDP1), nil).Once()
+
+	resp, err := suite.executor.Execute(suite.newContext(map[string]string{userInputOrganization: "Acme"}, nil))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(testOrgID, resp.RuntimeData[common.RuntimeKeyOrganizationID])
+	suite.Equal(testOrgOUID, resp.RuntimeData[common.RuntimeKeyOrganizationOUID])
+	suite.Equal(testOrgIDP1, resp.RuntimeData[common.RuntimeKeyOrganizationIDPs])
+	suite.Equal(testOrgIDP1, resp.RuntimeData[common.RuntimeKeyOrganizationIDP])
+	suite.Equal(dataValueTrue, resp.RuntimeData[common.RuntimeKeyOrganizationRequireIDP])
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_DiscoversByEmailDomain() {
+	suite.mockOrgService.EXPECT().GetOrganizationByEmailDomain(mock.Anything, "acme.com").
+		Return(suite.newOrganization(), nil).Once()
+
+	resp, err := suite.executor.Execute(suite.newContext(map[string]string{userInputOrganization: testOrgEmail}, nil))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(testOrgID, resp.RuntimeData[common.RuntimeKeyOrganizationID])
+	suite.Empty(resp.RuntimeData[common.RuntimeKeyOrganizationIDP])
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_OrganizationNotFound() {
+	suite.mockOrgService.EXPECT().GetOrganizationByHandle(mock.Anything, "unknown").
+		Return(nil, &organization.ErrorOrganizationNotFound).Once()
+
+	resp, err := suite.executor.Execute(suite.newContext(map[string]string{userInputOrganization: "unknown"}, nil))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal(failureReasonOrgNotFound, resp.FailureReason)
+	suite.Empty(resp.RuntimeData[common.RuntimeKeyOrganizationID])
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_ServerError() {
+	suite.mockOrgService.EXPECT().GetOrganizationByHandle(mock.Anything, "acme").
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	resp, err := suite.executor.Execute(suite.newContext(map[string]string{userInputOrganization: "acme"}, nil))
+
+	suite.Error(err)
+	suite.Nil(resp)
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_RequestedOrganizationByID() {
+	suite.mockOrgService.EXPECT().GetOrganizationByHandle(mock.Anything, testOrgID).
+		Return(nil, &organization.ErrorOrganizationNotFound).Once()
+	suite.mockOrgService.EXPECT().GetOrganization(mock.Anything, testOrgID).
+		Return(suite.newOrganization(testOrgIDP1), nil).Once()
+
+	resp, err := suite.executor.Execute(suite.newContext(nil,
+		map[string]string{common.RuntimeKeyRequestedOrganization: testOrgID}))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(testOrgID, resp.RuntimeData[common.RuntimeKeyOrganizationID])
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_RequestedOrganizationNotFound() {
+	suite.mockOrgService.EXPECT().GetOrganizationByHandle(mock.Anything, "unknown").
+		Return(nil, &organization.ErrorOrganizationNotFound).Once()
+	suite.mockOrgService.EXPECT().GetOrganization(mock.Anything, "unknown").
+		Return(nil, &organization.ErrorOrganizationNotFound).Once()
+
+	resp, err := suite.executor.Execute(suite.newContext(
+		map[string]string{userInputOrganization: "acme"},
+		map[string]string{common.RuntimeKeyRequestedOrganization: "unknown"}))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonOrgNotFound, resp.FailureReason)
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_PromptsForIdentityProvider() {
+	suite.mockOrgService.EXPECT().GetOrganizationByHandle(mock.Anything, "acme").
+		Return(suite.newOrganization(testOrgIDP1, testOrgIDP2), nil).Once()
+
+	resp, err := suite.executor.Execute(suite.newContext(map[string]string{userInputOrganization: "acme"}, nil))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Require().Len(resp.Inputs, 1)
+	suite.Equal(userInputIDPID, resp.Inputs[0].Identifier)
+	suite.Equal(testOrgIDP1+" "+testOrgIDP2, resp.AdditionalData[common.DataOrganizationIDPs])
+	suite.Equal(testOrgID, resp.RuntimeData[common.RuntimeKeyOrganizationID])
+	suite.Empty(resp.RuntimeData[common.RuntimeKeyOrganizationIDP])
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_SelectsIdentityProvider() {
+	suite.mockOrgService.EXPECT().GetOrganization(mock.Anything, testOrgID).
+		Return(suite.newOrganization(testOrgIDP1, testOrgIDP2), nil).Once()
+
+	resp, err := suite.executor.Execute(suite.newContext(
+		map[string]string{userInputIDPID: testOrgIDP2},
+		map[string]string{common.RuntimeKeyOrganizationID: testOrgID}))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(testOrgIDP2, resp.RuntimeData[common.RuntimeKeyOrganizationIDP])
+}
+
+func (suite *OrganizationResolverExecutorTestSuite) TestExecute_RejectsUnknownIdentityProvider() {
+	suite.mockOrgService.EXPECT().GetOrganization(mock.Anything, testOrgID).
+		Return(suite.newOrganization(testOrgIDP1, testOrgIDP2), nil).Once()
+
+	resp, err := suite.executor.Execute(suite.newContext(
+		map[string]string{userInputIDPID: "other-idp"},
+		map[string]string{common.RuntimeKeyOrganizationID: testOrgID}))
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal(failureReasonIDPNotAllowed, resp.FailureReason)
+}
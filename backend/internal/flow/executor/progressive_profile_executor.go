@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	progressiveProfileLoggerComponentName = "ProgressiveProfileExecutor"
+)
+
+// progressiveProfileExecutor is an executor that completes the profile of an authenticated user gradually.
+// It prompts for the attributes in the requiredAttributes node property that are missing from the user
+// profile and persists them once all prompted attributes are submitted. When maxPerPrompt is set, only that
+// many attributes are prompted per execution so the remaining attributes are collected in subsequent logins.
+type progressiveProfileExecutor struct {
+	core.ExecutorInterface
+	entityProvider entityprovider.EntityProviderInterface
+	logger         *log.Logger
+}
+
+var _ core.ExecutorInterface = (*progressiveProfileExecutor)(nil)
+
+// newProgressiveProfileExecutor creates a new instance of ProgressiveProfileExecutor.
+func newProgressiveProfileExecutor(
+	flowFactory core.FlowFactoryInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) *progressiveProfileExecutor {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, progressiveProfileLoggerComponentName),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameProgressiveProfile))
+
+	base := flowFactory.CreateExecutor(ExecutorNameProgressiveProfile, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{})
+
+	return &progressiveProfileExecutor{
+		ExecutorInterface: base,
+		entityProvider:    entityProvider,
+		logger:            logger,
+	}
+}
+
+// Execute prompts for the missing profile attributes of the authenticated user and persists them.
+func (p *progressiveProfileExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := p.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing progressive profile executor")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	if !ctx.AuthenticatedUser.IsAuthenticated {
+		logger.Debug("User is not authenticated, cannot complete the user profile")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonUserNotAuthenticated
+		return execResp, nil
+	}
+
+	requiredAttributes := p.getRequiredAttributes(ctx)
+	if len(requiredAttributes) == 0 {
+		logger.Debug("No required attributes configured, skipping progressive profiling")
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
+
+	userID := p.GetUserIDFromContext(ctx)
+	user, epErr := p.entityProvider.GetEntity(userID)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = failureReasonUserNotFound
+			return execResp, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve user: %s", epErr.Message)
+	}
+
+	userAttributes := make(map[string]interface{})
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &userAttributes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal user attributes: %w", err)
+		}
+	}
+
+	missingAttributes := make([]string, 0, len(requiredAttributes))
+	for _, attr := range requiredAttributes {
+		if !hasProfileAttribute(userAttributes, attr) {
+			missingAttributes = append(missingAttributes, attr)
+		}
+	}
+	if len(missingAttributes) == 0 {
+		logger.Debug("User profile already contains all required attributes")
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
+
+	// Only the attributes prompted in this login must be submitted. The rest are left for later logins.
+	promptAttributes := missingAttributes
+	if maxPerPrompt := p.getMaxPerPrompt(ctx); maxPerPrompt > 0 && len(promptAttributes) > maxPerPrompt {
+		promptAttributes = promptAttributes[:maxPerPrompt]
+	}
+
+	submitted := make(map[string]interface{})
+	pending := make([]string, 0, len(promptAttributes))
+	for _, attr := range promptAttributes {
+		if value, ok := ctx.UserInputs[attr]; ok && value != "" {
+			submitted[attr] = value
+		} else {
+			pending = append(pending, attr)
+		}
+	}
+
+	if len(pending) > 0 {
+		execResp.Inputs = p.getPromptInputs(ctx, pending)
+		logger.Debug("Prompting for missing profile attributes", log.Int("count", len(execResp.Inputs)))
+		execResp.Status = common.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	for attr, value := range submitted {
+		userAttributes[attr] = value
+	}
+	updatedAttributes, err := json.Marshal(userAttributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal user attributes: %w", err)
+	}
+	if epErr := p.entityProvider.UpdateAttributes(userID, updatedAttributes); epErr != nil {
+		logger.Error("Failed to update user attributes", log.String("error", epErr.Message))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "Failed to update user attributes"
+		return execResp, nil
+	}
+
+	logger.Debug("User profile updated with collected attributes", log.Int("count", len(submitted)),
+		log.MaskedString(log.LoggerKeyUserID, userID))
+	execResp.Status = common.ExecComplete
+	return execResp, nil
+}
+
+// getRequiredAttributes reads the requiredAttributes node property.
+func (p *progressiveProfileExecutor) getRequiredAttributes(ctx *core.NodeContext) []string {
+	val, ok := ctx.NodeProperties[propertyKeyRequiredAttributes]
+	if !ok {
+		return nil
+	}
+
+	var attributes []string
+	switch v := val.(type) {
+	case []string:
+		attributes = append(attributes, v...)
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				attributes = append(attributes, s)
+			}
+		}
+	}
+
+	result := make([]string, 0, len(attributes))
+	for _, attr := range attributes {
+		// Credentials and the user identifier must never be collected through the profile.
+		if attr == "" || attr == userAttributeUserID || attr == userAttributePassword {
+			continue
+		}
+		result = append(result, attr)
+	}
+	return result
+}
+
+// getMaxPerPrompt reads the maxPerPrompt node property.
+// Returns 0 when absent, meaning all missing attributes are prompted at once.
+func (p *progressiveProfileExecutor) getMaxPerPrompt(ctx *core.NodeContext) int {
+	if val, ok := ctx.NodeProperties[propertyKeyMaxDynamicInputsPerPrompt]; ok {
+		switch v := val.(type) {
+		case int:
+			return v
+		case float64:
+			return int(v)
+		}
+	}
+	return 0
+}
+
+// getPromptInputs builds the inputs to prompt for the given missing attributes. Node inputs with a matching
+// identifier are used as is so that flows can customize the input type, otherwise a string input is used.
+func (p *progressiveProfileExecutor) getPromptInputs(ctx *core.NodeContext,
+	missingAttributes []string) []common.Input {
+	inputs := make([]common.Input, 0, len(missingAttributes))
+	for _, attr := range missingAttributes {
+		input := common.Input{Identifier: attr, Type: "string", Required: true}
+		for _, nodeInput := range ctx.NodeInputs {
+			if nodeInput.Identifier == attr {
+				input = nodeInput
+				input.Required = true
+				break
+			}
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
+
+// hasProfileAttribute checks whether the user profile contains a non-empty value for the attribute.
+func hasProfileAttribute(userAttributes map[string]interface{}, attr string) bool {
+	value, ok := userAttributes[attr]
+	if !ok || value == nil {
+		return false
+	}
+	if s, ok := value.(string); ok {
+		return s != ""
+	}
+	return true
+}
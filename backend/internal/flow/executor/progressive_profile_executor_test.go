@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type ProgressiveProfileExecutorTestSuite struct {
+	suite.Suite
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockFlowFactory    *coremock.FlowFactoryInterfaceMock
+	executor           *progressiveProfileExecutor
+}
+
+func TestProgressiveProfileExecutorSuite(t *testing.T) {
+	suite.Run(t, new(ProgressiveProfileExecutorTestSuite))
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) SetupTest() {
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+
+	mockExec := coremock.NewExecutorInterfaceMock(suite.T())
+	mockExec.On("GetUserIDFromContext", mock.Anything).
+		Return(func(ctx *core.NodeContext) string {
+			return ctx.AuthenticatedUser.UserID
+		}).Maybe()
+
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameProgressiveProfile, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{}).Return(mockExec)
+
+	suite.executor = newProgressiveProfileExecutor(suite.mockFlowFactory, suite.mockEntityProvider)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) newContext(properties map[string]interface{},
+	userInputs map[string]string) *core.NodeContext {
+	return &core.NodeContext{
+		ExecutionID:    "flow-123",
+		FlowType:       common.FlowTypeAuthentication,
+		NodeProperties: properties,
+		UserInputs:     userInputs,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          testUserID,
+		},
+	}
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) mockUser(attrs map[string]interface{}) {
+	attrsJSON, _ := json.Marshal(attrs)
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(&entityprovider.Entity{
+		ID:         testUserID,
+		Attributes: attrsJSON,
+	}, nil)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_UserNotAuthenticated() {
+	ctx := &core.NodeContext{ExecutionID: "flow-123"}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonUserNotAuthenticated, resp.FailureReason)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_NoRequiredAttributes() {
+	ctx := suite.newContext(map[string]interface{}{}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "GetEntity", mock.Anything)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_ProfileComplete() {
+	suite.mockUser(map[string]interface{}{"email": "test@example.com", "mobileNumber": "+94771234567"})
+	ctx := suite.newContext(map[string]interface{}{
+		propertyKeyRequiredAttributes: []interface{}{"email", "mobileNumber"},
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Empty(resp.Inputs)
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "UpdateAttributes", mock.Anything, mock.Anything)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_PromptsMissingAttributes() {
+	suite.mockUser(map[string]interface{}{"email": "test@example.com", "country": ""})
+	ctx := suite.newContext(map[string]interface{}{
+		propertyKeyRequiredAttributes: []interface{}{"email", "mobileNumber", "country", "password"},
+	}, nil)
+	ctx.NodeInputs = []common.Input{{Ref: "country_input", Identifier: "country", Type: "SELECT"}}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal([]common.Input{
+		{Identifier: "mobileNumber", Type: "string", Required: true},
+		{Ref: "country_input", Identifier: "country", Type: "SELECT", Required: true},
+	}, resp.Inputs)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_LimitsPromptsWithMaxPerPrompt() {
+	suite.mockUser(map[string]interface{}{})
+	ctx := suite.newContext(map[string]interface{}{
+		propertyKeyRequiredAttributes:        []interface{}{"mobileNumber", "country", "birthdate"},
+		propertyKeyMaxDynamicInputsPerPrompt: float64(1),
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Len(resp.Inputs, 1)
+	suite.Equal("mobileNumber", resp.Inputs[0].Identifier)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_PersistsSubmittedAttributes() {
+	suite.mockUser(map[string]interface{}{"email": "test@example.com"})
+	suite.mockEntityProvider.On("UpdateAttributes", testUserID, mock.MatchedBy(func(attrs json.RawMessage) bool {
+		var m map[string]interface{}
+		if err := json.Unmarshal(attrs, &m); err != nil {
+			return false
+		}
+		_, hasCountry := m["country"]
+		return m["email"] == "test@example.com" && m["mobileNumber"] == "+94771234567" && !hasCountry
+	})).Return(nil)
+	ctx := suite.newContext(map[string]interface{}{
+		propertyKeyRequiredAttributes:        []interface{}{"mobileNumber", "country"},
+		propertyKeyMaxDynamicInputsPerPrompt: 1,
+	}, map[string]string{"mobileNumber": "+94771234567", "email": "other@example.com"})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_PartialSubmissionPromptsRemaining() {
+	suite.mockUser(map[string]interface{}{})
+	ctx := suite.newContext(map[string]interface{}{
+		propertyKeyRequiredAttributes: []interface{}{"mobileNumber", "country"},
+	}, map[string]string{"mobileNumber": "+94771234567"})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal([]common.Input{{Identifier: "country", Type: "string", Required: true}}, resp.Inputs)
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "UpdateAttributes", mock.Anything, mock.Anything)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_PersistsAllPromptedAttributes() {
+	suite.mockUser(map[string]interface{}{})
+	suite.mockEntityProvider.On("UpdateAttributes", testUserID, mock.MatchedBy(func(attrs json.RawMessage) bool {
+		var m map[string]interface{}
+		if err := json.Unmarshal(attrs, &m); err != nil {
+			return false
+		}
+		return m["mobileNumber"] == "+94771234567" && m["country"] == "LK"
+	})).Return(nil)
+	ctx := suite.newContext(map[string]interface{}{
+		propertyKeyRequiredAttributes: []interface{}{"mobileNumber", "country"},
+	}, map[string]string{"mobileNumber": "+94771234567", "country": "LK"})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_UpdateFailure() {
+	suite.mockUser(map[string]interface{}{})
+	suite.mockEntityProvider.On("UpdateAttributes", testUserID, mock.Anything).
+		Return(&entityprovider.EntityProviderError{Message: "update failed"})
+	ctx := suite.newContext(map[string]interface{}{
+		propertyKeyRequiredAttributes: []interface{}{"mobileNumber"},
+	}, map[string]string{"mobileNumber": "+94771234567"})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal("Failed to update user attributes", resp.FailureReason)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_UserNotFound() {
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""))
+	ctx := suite.newContext(map[string]interface{}{
+		propertyKeyRequiredAttributes: []interface{}{"mobileNumber"},
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonUserNotFound, resp.FailureReason)
+}
+
+func (suite *ProgressiveProfileExecutorTestSuite) TestExecute_EntityProviderError() {
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "system error", ""))
+	ctx := suite.newContext(map[string]interface{}{
+		propertyKeyRequiredAttributes: []interface{}{"mobileNumber"},
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.Error(err)
+	suite.Nil(resp)
+}
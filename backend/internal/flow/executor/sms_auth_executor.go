@@ -42,6 +42,10 @@ var mobileNumberInput = common.Input{
 	Required:   true,
 }
 
+// defaultOTPMaxAttempts is the number of OTPs that can be sent, and of failed verification attempts allowed
+// for each OTP, when the maxAttempts node property is not set.
+const defaultOTPMaxAttempts = 3
+
 // smsOTPAuthExecutor implements the ExecutorInterface for SMS OTP authentication.
 type smsOTPAuthExecutor struct {
 	core.ExecutorInterface
@@ -525,7 +529,8 @@ func (s *smsOTPAuthExecutor) generateAndSendOTP(mobileNumber string, ctx *core.N
 	}
 
 	// Send the OTP
-	sessionToken, svcErr := s.otpService.SendOTP(ctx.Context, senderID, notifcommon.ChannelTypeSMS, mobileNumber)
+	sessionToken, svcErr := s.otpService.SendOTP(ctx.Context, senderID, notifcommon.ChannelTypeSMS, mobileNumber,
+		s.getOTPOptions(ctx))
 	if svcErr != nil {
 		return fmt.Errorf("failed to send OTP: %s", svcErr.ErrorDescription.DefaultValue)
 	}
@@ -536,6 +541,7 @@ func (s *smsOTPAuthExecutor) generateAndSendOTP(mobileNumber string, ctx *core.N
 	}
 	execResp.RuntimeData["otpSessionToken"] = sessionToken
 	execResp.RuntimeData["attemptCount"] = strconv.Itoa(attemptCount + 1)
+	execResp.RuntimeData[common.RuntimeKeySMSOTPVerifyAttemptCount] = "0"
 
 	return nil
 }
@@ -556,7 +562,7 @@ func (s *smsOTPAuthExecutor) validateAttempts(ctx *core.NodeContext, execResp *c
 		attemptCount = count
 	}
 
-	if attemptCount >= s.getOTPMaxAttempts() {
+	if attemptCount >= s.getOTPMaxAttempts(ctx) {
 		logger.Debug("Maximum OTP attempts reached", log.MaskedString(log.LoggerKeyUserID, userID),
 			log.Int("attemptCount", attemptCount))
 		execResp.Status = common.ExecFailure
@@ -567,10 +573,44 @@ func (s *smsOTPAuthExecutor) validateAttempts(ctx *core.NodeContext, execResp *c
 	return attemptCount, nil
 }
 
-// getOTPMaxAttempts returns the maximum number of attempts allowed for OTP validation.
-func (s *smsOTPAuthExecutor) getOTPMaxAttempts() int {
-	// TODO: This needs to be configured as a IDP property.
-	return 3
+// getOTPMaxAttempts returns the maximum number of OTPs that can be sent, and the maximum number of failed
+// verification attempts allowed for each OTP, from the maxAttempts node property.
+func (s *smsOTPAuthExecutor) getOTPMaxAttempts(ctx *core.NodeContext) int {
+	if maxAttempts, ok := getIntNodeProperty(ctx, propertyKeyOTPMaxAttempts); ok && maxAttempts > 0 {
+		return maxAttempts
+	}
+	return defaultOTPMaxAttempts
+}
+
+// getOTPOptions returns the length and the validity period in seconds of the OTPs sent from the otpLength
+// and otpValidityPeriod node properties. Unset properties fall back to the defaults of the OTP service.
+func (s *smsOTPAuthExecutor) getOTPOptions(ctx *core.NodeContext) notifcommon.OTPOptions {
+	var options notifcommon.OTPOptions
+	if length, ok := getIntNodeProperty(ctx, propertyKeyOTPLength); ok {
+		options.Length = length
+	}
+	if validityPeriod, ok := getIntNodeProperty(ctx, propertyKeyOTPValidityPeriod); ok {
+		options.ValidityPeriod = int64(validityPeriod)
+	}
+	return options
+}
+
+// handleIncorrectOTP records a failed verification attempt of the OTP sent. The user is prompted for the OTP
+// again until the maximum number of attempts is reached, after which the execution fails.
+func (s *smsOTPAuthExecutor) handleIncorrectOTP(ctx *core.NodeContext, execResp *common.ExecutorResponse) {
+	attemptCount, _ := strconv.Atoi(ctx.RuntimeData[common.RuntimeKeySMSOTPVerifyAttemptCount])
+	attemptCount++
+	execResp.RuntimeData[common.RuntimeKeySMSOTPVerifyAttemptCount] = strconv.Itoa(attemptCount)
+
+	if attemptCount >= s.getOTPMaxAttempts(ctx) {
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = fmt.Sprintf("maximum OTP verification attempts reached: %d", attemptCount)
+		return
+	}
+
+	execResp.Status = common.ExecUserInputRequired
+	execResp.Inputs = s.GetRequiredInputs(ctx)
+	execResp.FailureReason = failureReasonInvalidOTP
 }
 
 // getAuthenticatedUser returns the authenticated user details for the given user ID.
@@ -614,9 +654,7 @@ func (s *smsOTPAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
 		if svcErr != nil {
 			if svcErr.Code == otp.ErrorIncorrectOTP.Code {
 				logger.Debug("OTP verification failed", log.MaskedString(log.LoggerKeyUserID, userID))
-				execResp.Status = common.ExecUserInputRequired
-				execResp.Inputs = s.GetRequiredInputs(ctx)
-				execResp.FailureReason = failureReasonInvalidOTP
+				s.handleIncorrectOTP(ctx, execResp)
 				return nil, nil
 			}
 			logger.Error("Failed to verify OTP",
@@ -645,9 +683,7 @@ func (s *smsOTPAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
 	if svcErr != nil {
 		if svcErr.Code == authnprovidermgr.ErrorAuthenticationFailed.Code {
 			logger.Debug("OTP verification failed", log.MaskedString(log.LoggerKeyUserID, userID))
-			execResp.Status = common.ExecUserInputRequired
-			execResp.Inputs = s.GetRequiredInputs(ctx)
-			execResp.FailureReason = failureReasonInvalidOTP
+			s.handleIncorrectOTP(ctx, execResp)
 			return nil, nil
 		}
 		logger.Error("Failed to verify OTP",
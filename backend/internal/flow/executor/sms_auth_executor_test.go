@@ -31,6 +31,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	notifcommon "github.com/thunder-id/thunderid/internal/notification/common"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/otpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
@@ -610,3 +611,78 @@ func (suite *SMSAuthExecutorTestSuite) TestGetAuthenticatedUser_FetchFromStore_N
 	assert.Equal(suite.T(), "user-123", result.UserID)
 	suite.mockEntityProvider.AssertExpectations(suite.T())
 }
+
+func (suite *SMSAuthExecutorTestSuite) TestGenerateAndSendOTP_UsesConfiguredOTPOptions() {
+	suite.mockOTPService.On("SendOTP", mock.Anything, "sender-1", notifcommon.ChannelTypeSMS, "+1234567890",
+		notifcommon.OTPOptions{Length: 8, ValidityPeriod: 300}).Return("session-token", nil).Once()
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		NodeProperties: map[string]interface{}{
+			"senderId":          "sender-1",
+			"otpLength":         float64(8),
+			"otpValidityPeriod": "300",
+		},
+		RuntimeData: map[string]string{},
+	}
+	execResp := &common.ExecutorResponse{RuntimeData: make(map[string]string)}
+
+	err := suite.executor.generateAndSendOTP("+1234567890", ctx, execResp, suite.executor.logger)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "session-token", execResp.RuntimeData["otpSessionToken"])
+	assert.Equal(suite.T(), "1", execResp.RuntimeData["attemptCount"])
+	assert.Equal(suite.T(), "0", execResp.RuntimeData[common.RuntimeKeySMSOTPVerifyAttemptCount])
+}
+
+func (suite *SMSAuthExecutorTestSuite) TestGenerateAndSendOTP_MaxAttemptsReached() {
+	ctx := &core.NodeContext{
+		ExecutionID:    "flow-123",
+		NodeProperties: map[string]interface{}{"senderId": "sender-1", "maxAttempts": float64(5)},
+		RuntimeData:    map[string]string{"attemptCount": "5"},
+	}
+	execResp := &common.ExecutorResponse{RuntimeData: make(map[string]string)}
+
+	err := suite.executor.generateAndSendOTP("+1234567890", ctx, execResp, suite.executor.logger)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	suite.mockOTPService.AssertNotCalled(suite.T(), "SendOTP")
+}
+
+func (suite *SMSAuthExecutorTestSuite) TestGetAuthenticatedUser_IncorrectOTP_CountsVerifyAttempts() {
+	suite.mockAuthnProvider.On("AuthenticateUser",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(authnprovidermgr.AuthUser{}, nil, &authnprovidermgr.ErrorAuthenticationFailed)
+
+	newContext := func(verifyAttemptCount string) *core.NodeContext {
+		return &core.NodeContext{
+			ExecutionID:    "flow-123",
+			FlowType:       common.FlowTypeAuthentication,
+			UserInputs:     map[string]string{userInputOTP: "000000"},
+			NodeProperties: map[string]interface{}{"maxAttempts": "2"},
+			RuntimeData: map[string]string{
+				common.RuntimeKeySMSOTPMobileNumber:       "+1234567890",
+				common.RuntimeKeySMSOTPVerifyAttemptCount: verifyAttemptCount,
+				"otpSessionToken":                         "test-session-token",
+			},
+		}
+	}
+
+	execResp := &common.ExecutorResponse{RuntimeData: make(map[string]string)}
+	result, err := suite.executor.getAuthenticatedUser(newContext("0"), execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, execResp.Status)
+	assert.Equal(suite.T(), failureReasonInvalidOTP, execResp.FailureReason)
+	assert.Equal(suite.T(), "1", execResp.RuntimeData[common.RuntimeKeySMSOTPVerifyAttemptCount])
+
+	execResp = &common.ExecutorResponse{RuntimeData: make(map[string]string)}
+	result, err = suite.executor.getAuthenticatedUser(newContext("1"), execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	assert.Equal(suite.T(), "2", execResp.RuntimeData[common.RuntimeKeySMSOTPVerifyAttemptCount])
+}
@@ -97,9 +97,17 @@ func (e *smsExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse,
 		return execResp, nil
 	}
 
-	senderID, err := resolveStringNodeProperty(ctx, propertyKeyNotificationSenderID)
-	if err != nil {
-		return nil, fmt.Errorf("senderId is not configured in node properties: %w", err)
+	// Fall back to the sender assigned to the organization unit when the node does not pin a sender.
+	var senderID, ouID string
+	if _, ok := ctx.NodeProperties[propertyKeyNotificationSenderID]; !ok {
+		ouID = resolveSenderOUID(ctx)
+	}
+	if ouID == "" {
+		var err error
+		senderID, err = resolveStringNodeProperty(ctx, propertyKeyNotificationSenderID)
+		if err != nil {
+			return nil, fmt.Errorf("senderId is not configured in node properties: %w", err)
+		}
 	}
 
 	tmplProp, ok := ctx.NodeProperties[propertyKeySMSTemplate]
@@ -130,8 +138,13 @@ func (e *smsExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse,
 		return nil, fmt.Errorf("failed to render SMS template: %s", svcErr.Code)
 	}
 
-	notifSvcErr := e.notifSenderSvc.Send(ctx.Context, notifcm.ChannelTypeSMS, senderID,
-		notifcm.NotificationData{Recipient: recipient, Body: rendered.Body})
+	notifData := notifcm.NotificationData{Recipient: recipient, Body: rendered.Body, Template: tmplStr}
+	var notifSvcErr *serviceerror.ServiceError
+	if ouID != "" {
+		notifSvcErr = e.notifSenderSvc.SendForOU(ctx.Context, notifcm.ChannelTypeSMS, ouID, notifData)
+	} else {
+		notifSvcErr = e.notifSenderSvc.Send(ctx.Context, notifcm.ChannelTypeSMS, senderID, notifData)
+	}
 	if notifSvcErr != nil {
 		if ctx.FlowType == common.FlowTypeUserOnboarding && notifSvcErr.Type == serviceerror.ClientErrorType {
 			execResp.Status = common.ExecFailure
@@ -148,6 +161,17 @@ func (e *smsExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse,
 	return execResp, nil
 }
 
+// resolveSenderOUID resolves the organization unit whose notification sender should be used.
+func resolveSenderOUID(ctx *core.NodeContext) string {
+	if ctx.AuthenticatedUser.OUID != "" {
+		return ctx.AuthenticatedUser.OUID
+	}
+	if ouID, ok := ctx.RuntimeData[ouIDKey]; ok && ouID != "" {
+		return ouID
+	}
+	return ctx.Application.OUID
+}
+
 // resolveRecipientMobile retrieves the recipient mobile number from user inputs or runtime data
 // using the given attribute name as the lookup key.
 func resolveRecipientMobile(ctx *core.NodeContext, phoneAttr string) string {
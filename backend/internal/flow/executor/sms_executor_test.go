@@ -87,7 +87,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_Success() {
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
-		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody},
+		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody,
+			Template: string(template.ScenarioSelfRegistration)},
 	).Return(nil)
 
 	resp, err := suite.executor.Execute(ctx)
@@ -119,7 +120,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_RecipientFromRuntimeData
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
-		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody},
+		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody,
+			Template: string(template.ScenarioSelfRegistration)},
 	).Return(nil)
 
 	resp, err := suite.executor.Execute(ctx)
@@ -153,7 +155,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_UserInputOverridesRuntim
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
-		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody},
+		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody,
+			Template: string(template.ScenarioSelfRegistration)},
 	).Return(nil)
 
 	resp, err := suite.executor.Execute(ctx)
@@ -188,7 +191,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_CustomPhoneAttribute() {
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
-		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody},
+		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody,
+			Template: string(template.ScenarioSelfRegistration)},
 	).Return(nil)
 
 	resp, err := suite.executor.Execute(ctx)
@@ -267,6 +271,41 @@ func (suite *SMSExecutorTestSuite) TestExecute_SendMode_MissingSenderID() {
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
+func (suite *SMSExecutorTestSuite) TestExecute_SendMode_NoSenderID_UsesOUSender() {
+	ctx := &core.NodeContext{
+		ExecutionID:  "test-flow-id",
+		ExecutorMode: ExecutorModeSend,
+		UserInputs: map[string]string{
+			common.AttributeMobileNumber: "+94714627887",
+		},
+		RuntimeData: map[string]string{
+			ouIDKey: "ou-123",
+		},
+		NodeProperties: map[string]interface{}{
+			propertyKeySMSTemplate: string(template.ScenarioSelfRegistration),
+		},
+	}
+
+	suite.mockBaseExecutor.On("GetRequiredInputs", mock.Anything).Return([]common.Input{
+		{Identifier: common.AttributeMobileNumber, Type: common.InputTypePhone, Required: true},
+	}).Maybe()
+	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioSelfRegistration,
+		template.TemplateTypeSMS, mock.Anything).
+		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
+	suite.mockSMSSenderSvc.On("SendForOU",
+		mock.Anything, notifcm.ChannelTypeSMS, "ou-123",
+		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody,
+			Template: string(template.ScenarioSelfRegistration)},
+	).Return(nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.mockSMSSenderSvc.AssertNotCalled(suite.T(), "Send",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *SMSExecutorTestSuite) TestExecute_SendMode_InvalidSenderIDType() {
 	ctx := &core.NodeContext{
 		ExecutionID:  "test-flow-id",
@@ -545,7 +584,8 @@ func (suite *SMSExecutorTestSuite) TestExecute_SMSTemplatePropertySet_UsesCustom
 		Return(&template.RenderedTemplate{Body: testRenderedSMSBody}, nil)
 	suite.mockSMSSenderSvc.On("Send",
 		mock.Anything, mock.Anything, "sender-uuid-001",
-		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody},
+		notifcm.NotificationData{Recipient: "+94714627887", Body: testRenderedSMSBody,
+			Template: string(template.ScenarioSelfRegistration)},
 	).Return(nil)
 
 	resp, err := suite.executor.Execute(ctx)
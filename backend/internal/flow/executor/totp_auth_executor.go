@@ -0,0 +1,311 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// Additional data keys returned when a TOTP enrollment is started.
+const (
+	// nolint:gosec // G101: This is a data key, not a credential
+	additionalDataTOTPSecret          = "totpSecret"
+	additionalDataTOTPProvisioningURI = "totpProvisioningUri"
+)
+
+// totpAuthExecutor implements the ExecutorInterface for TOTP enrollment and authentication.
+type totpAuthExecutor struct {
+	core.ExecutorInterface
+	totpService    totp.TOTPAuthnServiceInterface
+	entityProvider entityprovider.EntityProviderInterface
+	logger         *log.Logger
+}
+
+var _ core.ExecutorInterface = (*totpAuthExecutor)(nil)
+
+// newTOTPAuthExecutor creates a new instance of TOTPAuthExecutor.
+func newTOTPAuthExecutor(
+	flowFactory core.FlowFactoryInterface,
+	totpService totp.TOTPAuthnServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) *totpAuthExecutor {
+	defaultInputs := []common.Input{
+		{
+			Ref:        "totp_input",
+			Identifier: userInputTOTP,
+			Type:       common.InputTypeOTP,
+			Required:   true,
+		},
+	}
+	prerequisites := []common.Input{
+		{
+			Identifier: userAttributeUserID,
+			Type:       "string",
+			Required:   true,
+		},
+	}
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TOTPAuthExecutor"),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameTOTPAuth))
+
+	base := flowFactory.CreateExecutor(ExecutorNameTOTPAuth, common.ExecutorTypeAuthentication,
+		defaultInputs, prerequisites)
+
+	return &totpAuthExecutor{
+		ExecutorInterface: base,
+		totpService:       totpService,
+		entityProvider:    entityProvider,
+		logger:            logger,
+	}
+}
+
+// Execute executes the TOTP enrollment or authentication logic based on the executor mode.
+func (t *totpAuthExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing TOTP authentication executor")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	if !t.ValidatePrerequisites(ctx, execResp) {
+		logger.Debug("Prerequisites not met for TOTP authentication executor")
+		return execResp, nil
+	}
+
+	switch ctx.ExecutorMode {
+	case ExecutorModeEnroll:
+		return t.executeEnroll(ctx, execResp)
+	case ExecutorModeVerify:
+		return t.executeVerify(ctx, execResp)
+	default:
+		return execResp, fmt.Errorf("invalid executor mode: %s", ctx.ExecutorMode)
+	}
+}
+
+// executeEnroll registers a TOTP authenticator for the user. The first execution generates a secret and
+// returns it along with the provisioning URI, and the next execution confirms the enrollment with a code
+// generated by the authenticator app.
+func (t *totpAuthExecutor) executeEnroll(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) (*common.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	userID := t.GetUserIDFromContext(ctx)
+
+	enrollmentToken := ctx.RuntimeData[common.RuntimeKeyTOTPEnrollmentToken]
+	if enrollmentToken == "" {
+		return t.startEnrollment(ctx, execResp, userID)
+	}
+
+	if !t.HasRequiredInputs(ctx, execResp) {
+		logger.Debug("Required inputs for TOTP enrollment are not provided")
+		execResp.Status = common.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	svcErr := t.totpService.CompleteEnrollment(ctx.Context, userID, enrollmentToken, ctx.UserInputs[userInputTOTP])
+	if svcErr != nil {
+		if t.handleTOTPServiceError(ctx, execResp, svcErr) {
+			return execResp, nil
+		}
+		logger.Error("Failed to complete TOTP enrollment", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Any("serviceError", svcErr))
+		return execResp, fmt.Errorf("failed to complete TOTP enrollment: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	execResp.RuntimeData[common.RuntimeKeyTOTPEnrollmentToken] = ""
+
+	if ctx.FlowType != common.FlowTypeRegistration {
+		authenticatedUser, err := t.getAuthenticatedUser(ctx, userID)
+		if err != nil {
+			return execResp, fmt.Errorf("failed to get authenticated user details: %w", err)
+		}
+		execResp.AuthenticatedUser = *authenticatedUser
+	}
+
+	execResp.Status = common.ExecComplete
+	logger.Debug("TOTP enrollment completed successfully", log.MaskedString(log.LoggerKeyUserID, userID))
+	return execResp, nil
+}
+
+// startEnrollment generates a TOTP secret for the user and prompts for a code generated from it.
+func (t *totpAuthExecutor) startEnrollment(ctx *core.NodeContext, execResp *common.ExecutorResponse,
+	userID string) (*common.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Starting TOTP enrollment", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	accountName, err := t.getAccountName(ctx, userID)
+	if err != nil {
+		return execResp, err
+	}
+
+	enrollment, svcErr := t.totpService.StartEnrollment(ctx.Context, accountName, t.getIssuer(ctx))
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = svcErr.ErrorDescription.DefaultValue
+			return execResp, nil
+		}
+		logger.Error("Failed to start TOTP enrollment", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Any("serviceError", svcErr))
+		return execResp, fmt.Errorf("failed to start TOTP enrollment: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+
+	execResp.RuntimeData[common.RuntimeKeyTOTPEnrollmentToken] = enrollment.EnrollmentToken
+	execResp.AdditionalData[additionalDataTOTPSecret] = enrollment.Secret
+	execResp.AdditionalData[additionalDataTOTPProvisioningURI] = enrollment.ProvisioningURI
+	execResp.Inputs = t.GetRequiredInputs(ctx)
+	execResp.Status = common.ExecUserInputRequired
+	return execResp, nil
+}
+
+// executeVerify verifies a code against the TOTP authenticator registered for the user.
+func (t *totpAuthExecutor) executeVerify(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) (*common.ExecutorResponse, error) {
+	logger := t.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	userID := t.GetUserIDFromContext(ctx)
+
+	if !t.HasRequiredInputs(ctx, execResp) {
+		logger.Debug("Required inputs for TOTP verification are not provided")
+		execResp.Status = common.ExecUserInputRequired
+		return execResp, nil
+	}
+
+	svcErr := t.totpService.Verify(ctx.Context, userID, ctx.UserInputs[userInputTOTP])
+	if svcErr != nil {
+		if t.handleTOTPServiceError(ctx, execResp, svcErr) {
+			return execResp, nil
+		}
+		logger.Error("Failed to verify TOTP code", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Any("serviceError", svcErr))
+		return execResp, fmt.Errorf("failed to verify TOTP code: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+
+	authenticatedUser, err := t.getAuthenticatedUser(ctx, userID)
+	if err != nil {
+		return execResp, fmt.Errorf("failed to get authenticated user details: %w", err)
+	}
+	execResp.AuthenticatedUser = *authenticatedUser
+	execResp.Status = common.ExecComplete
+
+	logger.Debug("TOTP verification completed successfully", log.MaskedString(log.LoggerKeyUserID, userID))
+	return execResp, nil
+}
+
+// handleTOTPServiceError updates the executor response for client errors returned by the TOTP service.
+// An incorrect code prompts for the code again, while other client errors fail the executor. Returns false
+// if the error is a server error.
+func (t *totpAuthExecutor) handleTOTPServiceError(ctx *core.NodeContext, execResp *common.ExecutorResponse,
+	svcErr *serviceerror.ServiceError) bool {
+	switch svcErr.Code {
+	case totp.ErrorIncorrectTOTPCode.Code, totp.ErrorInvalidTOTPCode.Code:
+		execResp.Status = common.ExecUserInputRequired
+		execResp.Inputs = t.GetRequiredInputs(ctx)
+		execResp.FailureReason = failureReasonInvalidTOTP
+		return true
+	case authncm.ErrorUserNotFound.Code:
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonUserNotFound
+		return true
+	}
+	if svcErr.Type == serviceerror.ClientErrorType {
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = svcErr.ErrorDescription.DefaultValue
+		return true
+	}
+	return false
+}
+
+// getAccountName returns the account name shown for the user in the authenticator app. It is the value of
+// the user attribute configured in the node properties, falling back to the username, email and user ID.
+func (t *totpAuthExecutor) getAccountName(ctx *core.NodeContext, userID string) (string, error) {
+	attributes := []string{userAttributeUsername, userAttributeEmail}
+	if attr, ok := ctx.NodeProperties[propertyKeyTOTPAccountNameAttribute].(string); ok && attr != "" {
+		attributes = []string{attr}
+	}
+
+	for _, attr := range attributes {
+		if value, ok := ctx.AuthenticatedUser.Attributes[attr].(string); ok && value != "" {
+			return value, nil
+		}
+	}
+
+	user, providerErr := t.entityProvider.GetEntity(userID)
+	if providerErr != nil {
+		if providerErr.Code == entityprovider.ErrorCodeNotImplemented {
+			return userID, nil
+		}
+		return "", fmt.Errorf("failed to get user details: %s", providerErr.Error())
+	}
+	for _, attr := range attributes {
+		if value, err := GetUserAttribute(user, attr); err == nil {
+			return value, nil
+		}
+	}
+	return userID, nil
+}
+
+// getIssuer returns the issuer shown in the authenticator app from the node properties, falling back to the
+// application name.
+func (t *totpAuthExecutor) getIssuer(ctx *core.NodeContext) string {
+	if issuer, ok := ctx.NodeProperties[propertyKeyTOTPIssuer].(string); ok && issuer != "" {
+		return issuer
+	}
+	return ctx.Application.Name
+}
+
+// getAuthenticatedUser returns the authenticated user details for the given user ID.
+func (t *totpAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
+	userID string) (*authncm.AuthenticatedUser, error) {
+	if ctx.AuthenticatedUser.IsAuthenticated && ctx.AuthenticatedUser.UserID == userID {
+		return &ctx.AuthenticatedUser, nil
+	}
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	user, providerErr := t.entityProvider.GetEntity(userID)
+	if providerErr != nil {
+		return nil, fmt.Errorf("failed to get user details: %s", providerErr.Error())
+	}
+
+	attrs := make(map[string]interface{})
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &attrs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal user attributes: %w", err)
+		}
+	}
+
+	return &authncm.AuthenticatedUser{
+		IsAuthenticated: true,
+		UserID:          user.ID,
+		OUID:            user.OUID,
+		UserType:        user.Type,
+		Attributes:      attrs,
+	}, nil
+}
@@ -0,0 +1,328 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	appmodel "github.com/thunder-id/thunderid/internal/application/model"
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/totpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+const (
+	totpTestUserID          = "user-123"
+	totpTestExecutionID     = "flow-123"
+	totpTestCode            = "123456"
+	totpTestEnrollmentToken = "enrollment-token"
+)
+
+var testTOTPInput = common.Input{
+	Ref:        "totp_input",
+	Identifier: userInputTOTP,
+	Type:       common.InputTypeOTP,
+	Required:   true,
+}
+
+type TOTPAuthExecutorTestSuite struct {
+	suite.Suite
+	mockTOTPService    *totpmock.TOTPAuthnServiceInterfaceMock
+	mockFlowFactory    *coremock.FlowFactoryInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	executor           *totpAuthExecutor
+}
+
+func TestTOTPAuthExecutorSuite(t *testing.T) {
+	suite.Run(t, new(TOTPAuthExecutorTestSuite))
+}
+
+func (suite *TOTPAuthExecutorTestSuite) SetupTest() {
+	suite.mockTOTPService = totpmock.NewTOTPAuthnServiceInterfaceMock(suite.T())
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+
+	mockExec := createMockTOTPAuthExecutor(suite.T())
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameTOTPAuth, common.ExecutorTypeAuthentication,
+		[]common.Input{testTOTPInput}, mock.Anything).Return(mockExec)
+
+	suite.executor = newTOTPAuthExecutor(suite.mockFlowFactory, suite.mockTOTPService, suite.mockEntityProvider)
+}
+
+func createMockTOTPAuthExecutor(t *testing.T) core.ExecutorInterface {
+	mockExec := coremock.NewExecutorInterfaceMock(t)
+	mockExec.On("GetName").Return(ExecutorNameTOTPAuth).Maybe()
+	mockExec.On("GetRequiredInputs", mock.Anything).Return([]common.Input{testTOTPInput}).Maybe()
+	mockExec.On("ValidatePrerequisites", mock.Anything, mock.Anything).Return(
+		func(ctx *core.NodeContext, execResp *common.ExecutorResponse) bool {
+			if ctx.AuthenticatedUser.UserID == "" && ctx.RuntimeData[userAttributeUserID] == "" {
+				execResp.Status = common.ExecFailure
+				execResp.FailureReason = "Prerequisite not met: " + userAttributeUserID
+				return false
+			}
+			return true
+		}).Maybe()
+	mockExec.On("GetUserIDFromContext", mock.Anything).Return(
+		func(ctx *core.NodeContext) string {
+			if ctx.AuthenticatedUser.UserID != "" {
+				return ctx.AuthenticatedUser.UserID
+			}
+			return ctx.RuntimeData[userAttributeUserID]
+		}).Maybe()
+	mockExec.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(
+		func(ctx *core.NodeContext, execResp *common.ExecutorResponse) bool {
+			if ctx.UserInputs[userInputTOTP] == "" {
+				execResp.Inputs = []common.Input{testTOTPInput}
+				return false
+			}
+			return true
+		}).Maybe()
+	return mockExec
+}
+
+func newTOTPTestContext(mode string, userInputs map[string]string,
+	runtimeData map[string]string) *core.NodeContext {
+	if runtimeData == nil {
+		runtimeData = make(map[string]string)
+	}
+	return &core.NodeContext{
+		Context:      context.Background(),
+		ExecutionID:  totpTestExecutionID,
+		FlowType:     common.FlowTypeAuthentication,
+		ExecutorMode: mode,
+		UserInputs:   userInputs,
+		RuntimeData:  runtimeData,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          totpTestUserID,
+			Attributes:      map[string]interface{}{userAttributeUsername: "alice"},
+		},
+		Application: appmodel.Application{Name: "Acme"},
+	}
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestExecute_InvalidMode() {
+	ctx := newTOTPTestContext("unknown", nil, nil)
+
+	_, err := suite.executor.Execute(ctx)
+
+	suite.Error(err)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestExecute_PrerequisitesNotMet() {
+	ctx := newTOTPTestContext(ExecutorModeVerify, nil, nil)
+	ctx.AuthenticatedUser = authncm.AuthenticatedUser{}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.mockTOTPService.AssertNotCalled(suite.T(), "Verify", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestEnroll_StartsEnrollment() {
+	ctx := newTOTPTestContext(ExecutorModeEnroll, nil, nil)
+	suite.mockTOTPService.On("StartEnrollment", mock.Anything, "alice", "Acme").Return(&totp.TOTPEnrollment{
+		Secret:          "JBSWY3DPEHPK3PXP",
+		ProvisioningURI: "otpauth://totp/Acme:alice?secret=JBSWY3DPEHPK3PXP",
+		EnrollmentToken: totpTestEnrollmentToken,
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal([]common.Input{testTOTPInput}, resp.Inputs)
+	suite.Equal(totpTestEnrollmentToken, resp.RuntimeData[common.RuntimeKeyTOTPEnrollmentToken])
+	suite.Equal("JBSWY3DPEHPK3PXP", resp.AdditionalData[additionalDataTOTPSecret])
+	suite.Equal("otpauth://totp/Acme:alice?secret=JBSWY3DPEHPK3PXP",
+		resp.AdditionalData[additionalDataTOTPProvisioningURI])
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestEnroll_UsesConfiguredIssuerAndAccountAttribute() {
+	ctx := newTOTPTestContext(ExecutorModeEnroll, nil, nil)
+	ctx.NodeProperties = map[string]interface{}{
+		propertyKeyTOTPIssuer:               "Acme Corp",
+		propertyKeyTOTPAccountNameAttribute: userAttributeEmail,
+	}
+	suite.mockEntityProvider.On("GetEntity", totpTestUserID).Return(&entityprovider.Entity{
+		ID:         totpTestUserID,
+		Attributes: json.RawMessage(`{"email":"alice@example.com"}`),
+	}, nil)
+	suite.mockTOTPService.On("StartEnrollment", mock.Anything, "alice@example.com", "Acme Corp").Return(
+		&totp.TOTPEnrollment{EnrollmentToken: totpTestEnrollmentToken}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestEnroll_StartEnrollmentServerError() {
+	ctx := newTOTPTestContext(ExecutorModeEnroll, nil, nil)
+	suite.mockTOTPService.On("StartEnrollment", mock.Anything, "alice", "Acme").Return(
+		nil, &serviceerror.InternalServerError)
+
+	_, err := suite.executor.Execute(ctx)
+
+	suite.Error(err)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestEnroll_PromptsForCode() {
+	ctx := newTOTPTestContext(ExecutorModeEnroll, nil,
+		map[string]string{common.RuntimeKeyTOTPEnrollmentToken: totpTestEnrollmentToken})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal([]common.Input{testTOTPInput}, resp.Inputs)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestEnroll_CompletesEnrollment() {
+	ctx := newTOTPTestContext(ExecutorModeEnroll, map[string]string{userInputTOTP: totpTestCode},
+		map[string]string{common.RuntimeKeyTOTPEnrollmentToken: totpTestEnrollmentToken})
+	suite.mockTOTPService.On("CompleteEnrollment", mock.Anything, totpTestUserID, totpTestEnrollmentToken,
+		totpTestCode).Return(nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal("", resp.RuntimeData[common.RuntimeKeyTOTPEnrollmentToken])
+	suite.True(resp.AuthenticatedUser.IsAuthenticated)
+	suite.Equal(totpTestUserID, resp.AuthenticatedUser.UserID)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestEnroll_CompletesEnrollmentInRegistrationFlow() {
+	ctx := newTOTPTestContext(ExecutorModeEnroll, map[string]string{userInputTOTP: totpTestCode},
+		map[string]string{common.RuntimeKeyTOTPEnrollmentToken: totpTestEnrollmentToken})
+	ctx.FlowType = common.FlowTypeRegistration
+	suite.mockTOTPService.On("CompleteEnrollment", mock.Anything, totpTestUserID, totpTestEnrollmentToken,
+		totpTestCode).Return(nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.False(resp.AuthenticatedUser.IsAuthenticated)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestEnroll_IncorrectCode() {
+	ctx := newTOTPTestContext(ExecutorModeEnroll, map[string]string{userInputTOTP: totpTestCode},
+		map[string]string{common.RuntimeKeyTOTPEnrollmentToken: totpTestEnrollmentToken})
+	suite.mockTOTPService.On("CompleteEnrollment", mock.Anything, totpTestUserID, totpTestEnrollmentToken,
+		totpTestCode).Return(&totp.ErrorIncorrectTOTPCode)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal(failureReasonInvalidTOTP, resp.FailureReason)
+	suite.NotContains(resp.RuntimeData, common.RuntimeKeyTOTPEnrollmentToken)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestVerify_PromptsForCode() {
+	ctx := newTOTPTestContext(ExecutorModeVerify, nil, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal([]common.Input{testTOTPInput}, resp.Inputs)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestVerify_Success() {
+	ctx := newTOTPTestContext(ExecutorModeVerify, map[string]string{userInputTOTP: totpTestCode}, nil)
+	suite.mockTOTPService.On("Verify", mock.Anything, totpTestUserID, totpTestCode).Return(nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(totpTestUserID, resp.AuthenticatedUser.UserID)
+	suite.Equal("alice", resp.AuthenticatedUser.Attributes[userAttributeUsername])
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestVerify_SuccessForIdentifiedUser() {
+	ctx := newTOTPTestContext(ExecutorModeVerify, map[string]string{userInputTOTP: totpTestCode},
+		map[string]string{userAttributeUserID: totpTestUserID})
+	ctx.AuthenticatedUser = authncm.AuthenticatedUser{}
+	suite.mockTOTPService.On("Verify", mock.Anything, totpTestUserID, totpTestCode).Return(nil)
+	suite.mockEntityProvider.On("GetEntity", totpTestUserID).Return(&entityprovider.Entity{
+		ID:         totpTestUserID,
+		OUID:       "ou-123",
+		Type:       "INTERNAL",
+		Attributes: json.RawMessage(`{"username":"alice"}`),
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.True(resp.AuthenticatedUser.IsAuthenticated)
+	suite.Equal("ou-123", resp.AuthenticatedUser.OUID)
+	suite.Equal("INTERNAL", resp.AuthenticatedUser.UserType)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestVerify_IncorrectCode() {
+	ctx := newTOTPTestContext(ExecutorModeVerify, map[string]string{userInputTOTP: totpTestCode}, nil)
+	suite.mockTOTPService.On("Verify", mock.Anything, totpTestUserID, totpTestCode).
+		Return(&totp.ErrorIncorrectTOTPCode)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecUserInputRequired, resp.Status)
+	suite.Equal([]common.Input{testTOTPInput}, resp.Inputs)
+	suite.Equal(failureReasonInvalidTOTP, resp.FailureReason)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestVerify_NotEnrolled() {
+	ctx := newTOTPTestContext(ExecutorModeVerify, map[string]string{userInputTOTP: totpTestCode}, nil)
+	suite.mockTOTPService.On("Verify", mock.Anything, totpTestUserID, totpTestCode).
+		Return(&totp.ErrorTOTPNotEnrolled)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(totp.ErrorTOTPNotEnrolled.ErrorDescription.DefaultValue, resp.FailureReason)
+}
+
+func (suite *TOTPAuthExecutorTestSuite) TestVerify_ServerError() {
+	ctx := newTOTPTestContext(ExecutorModeVerify, map[string]string{userInputTOTP: totpTestCode}, nil)
+	suite.mockTOTPService.On("Verify", mock.Anything, totpTestUserID, totpTestCode).
+		Return(&serviceerror.InternalServerError)
+
+	_, err := suite.executor.Execute(ctx)
+
+	suite.Error(err)
+}
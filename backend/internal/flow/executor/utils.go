@@ -22,6 +22,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
@@ -33,12 +36,15 @@ import (
 // Returns empty string if executor doesn't map to an authn service.
 func getAuthnServiceName(executorName string) string {
 	executorToAuthnServiceMap := map[string]string{
-		ExecutorNameBasicAuth:  authncm.AuthenticatorCredentials,
-		ExecutorNameSMSAuth:    authncm.AuthenticatorSMSOTP,
-		ExecutorNameOAuth:      authncm.AuthenticatorOAuth,
-		ExecutorNameOIDCAuth:   authncm.AuthenticatorOIDC,
-		ExecutorNameGitHubAuth: authncm.AuthenticatorGithub,
-		ExecutorNameGoogleAuth: authncm.AuthenticatorGoogle,
+		ExecutorNameBasicAuth:     authncm.AuthenticatorCredentials,
+		ExecutorNameSMSAuth:       authncm.AuthenticatorSMSOTP,
+		ExecutorNamePasskeyAuth:   authncm.AuthenticatorPasskey,
+		ExecutorNameMagicLinkAuth: authncm.AuthenticatorMagicLink,
+		ExecutorNameTOTPAuth:      authncm.AuthenticatorTOTP,
+		ExecutorNameOAuth:         authncm.AuthenticatorOAuth,
+		ExecutorNameOIDCAuth:      authncm.AuthenticatorOIDC,
+		ExecutorNameGitHubAuth:    authncm.AuthenticatorGithub,
+		ExecutorNameGoogleAuth:    authncm.AuthenticatorGoogle,
 	}
 	return executorToAuthnServiceMap[executorName]
 }
@@ -63,6 +69,24 @@ func GetUserAttribute(user *entityprovider.Entity, attributeKey string) (string,
 	return "", fmt.Errorf("attribute '%s' not found or is empty", attributeKey)
 }
 
+// getIntNodeProperty returns the integer value of a node property given as a number or a numeric string.
+// The second return value is false if the property is absent or not an integer.
+func getIntNodeProperty(ctx *core.NodeContext, key string) (int, bool) {
+	switch v := ctx.NodeProperties[key].(type) {
+	case int:
+		return v, true
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), true
+		}
+	case string:
+		if parsed, err := strconv.Atoi(v); err == nil {
+			return parsed, true
+		}
+	}
+	return 0, false
+}
+
 // isAuthenticationWithoutLocalUserAllowed returns the value of the AllowAuthenticationWithoutLocalUser
 // node property, defaulting to false if absent or not a bool.
 // This is used to determine if authentication flow can proceed without a local user account.
@@ -91,6 +115,21 @@ func isRegistrationWithExistingUserAllowed(ctx *core.NodeContext) bool {
 	return false
 }
 
+// isIDPAllowed reports whether the identity provider may be used to sign in to the application in the
+// node context. When an organization is resolved for the login, only the identity providers registered
+// for the organization are allowed. Otherwise, all identity providers are allowed when the application
+// does not restrict them in its login experience configuration.
+func isIDPAllowed(ctx *core.NodeContext, idpID string) bool {
+	if ctx.RuntimeData[common.RuntimeKeyOrganizationID] != "" {
+		return slices.Contains(strings.Fields(ctx.RuntimeData[common.RuntimeKeyOrganizationIDPs]), idpID)
+	}
+	loginExperience := ctx.Application.LoginExperience
+	if loginExperience == nil || len(loginExperience.AllowedIDPs) == 0 {
+		return true
+	}
+	return slices.Contains(loginExperience.AllowedIDPs, idpID)
+}
+
 // isCrossOUProvisioningAllowed returns the value of the AllowCrossOUProvisioning node property,
 // defaulting to false if absent or not a bool.
 // This is used to determine if provisioning can proceed across organizational units (OUs).
@@ -24,10 +24,12 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	appmodel "github.com/thunder-id/thunderid/internal/application/model"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
 )
 
@@ -158,6 +160,32 @@ func (s *UtilsTestSuite) TestGetUserAttribute() {
 	}
 }
 
+func (s *UtilsTestSuite) TestGetIntNodeProperty() {
+	tests := []struct {
+		name       string
+		properties map[string]interface{}
+		expected   int
+		expectedOK bool
+	}{
+		{name: "Int value", properties: map[string]interface{}{"count": 5}, expected: 5, expectedOK: true},
+		{name: "Float value", properties: map[string]interface{}{"count": float64(6)}, expected: 6, expectedOK: true},
+		{name: "String value", properties: map[string]interface{}{"count": "7"}, expected: 7, expectedOK: true},
+		{name: "Fractional value", properties: map[string]interface{}{"count": 1.5}},
+		{name: "Non-numeric string", properties: map[string]interface{}{"count": "seven"}},
+		{name: "Property missing", properties: map[string]interface{}{"other": 1}},
+		{name: "Nil properties"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			ctx := &core.NodeContext{NodeProperties: tt.properties}
+			result, ok := getIntNodeProperty(ctx, "count")
+			s.Equal(tt.expected, result)
+			s.Equal(tt.expectedOK, ok)
+		})
+	}
+}
+
 func (s *UtilsTestSuite) TestIsAuthenticationWithoutLocalUserAllowed() {
 	tests := []struct {
 		name       string
@@ -292,3 +320,58 @@ func (s *UtilsTestSuite) TestIsCrossOUProvisioningAllowed() {
 		})
 	}
 }
+
+func (s *UtilsTestSuite) TestIsIDPAllowed() {
+	orgRuntimeData := map[string]string{
+		common.RuntimeKeyOrganizationID:   "org-1",
+		common.RuntimeKeyOrganizationIDPs: "idp-3 idp-4",
+	}
+	tests := []struct {
+		name            string
+		loginExperience *inboundmodel.LoginExperienceConfig
+		runtimeData     map[string]string
+		idpID           string
+		expected        bool
+	}{
+		{name: "No login experience", loginExperience: nil, idpID: "idp-1", expected: true},
+		{name: "No allowed IDPs", loginExperience: &inboundmodel.LoginExperienceConfig{}, idpID: "idp-1",
+			expected: true},
+		{
+			name:            "IDP allowed",
+			loginExperience: &inboundmodel.LoginExperienceConfig{AllowedIDPs: []string{"idp-1", "idp-2"}},
+			idpID:           "idp-2",
+			expected:        true,
+		},
+		{
+			name:            "IDP not allowed",
+			loginExperience: &inboundmodel.LoginExperienceConfig{AllowedIDPs: []string{"idp-1"}},
+			idpID:           "idp-2",
+			expected:        false,
+		},
+		{
+			name:            "Organization IDP allowed",
+			loginExperience: &inboundmodel.LoginExperienceConfig{AllowedIDPs: []string{"idp-1"}},
+			runtimeData:     orgRuntimeData,
+			idpID:           "idp-4",
+			expected:        true,
+		},
+		{
+			name:        "IDP not registered for organization",
+			runtimeData: orgRuntimeData,
+			idpID:       "idp-1",
+			expected:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			ctx := &core.NodeContext{
+				Application: appmodel.Application{
+					InboundAuthProfile: inboundmodel.InboundAuthProfile{LoginExperience: tt.loginExperience},
+				},
+				RuntimeData: tt.runtimeData,
+			}
+			s.Equal(tt.expected, isIDPAllowed(ctx, tt.idpID))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowanalytics
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for flow analytics operations.
+var (
+	// ErrorInvalidPeriod is the error returned when an invalid analytics period is provided.
+	ErrorInvalidPeriod = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FLA-1001",
+		Error: core.I18nMessage{
+			Key:          "error.flowanalyticsservice.invalid_period",
+			DefaultValue: "Invalid analytics period",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.flowanalyticsservice.invalid_period_description",
+			DefaultValue: "The from and to parameters must be RFC 3339 timestamps and from must be before to",
+		},
+	}
+	// ErrorFlowNotFound is the error returned when the flow is not found.
+	ErrorFlowNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FLA-1002",
+		Error: core.I18nMessage{
+			Key:          "error.flowanalyticsservice.flow_not_found",
+			DefaultValue: "Flow not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.flowanalyticsservice.flow_not_found_description",
+			DefaultValue: "The flow with the specified ID does not exist",
+		},
+	}
+)
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowanalytics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// defaultAnalyticsPeriod is the period of the flow analytics when the start of the period is not provided.
+const defaultAnalyticsPeriod = 7 * 24 * time.Hour
+
+// flowAnalyticsHandler handles HTTP requests for the flow analytics.
+type flowAnalyticsHandler struct {
+	analyticsService FlowAnalyticsServiceInterface
+}
+
+// newFlowAnalyticsHandler creates a new instance of flowAnalyticsHandler.
+func newFlowAnalyticsHandler(analyticsService FlowAnalyticsServiceInterface) *flowAnalyticsHandler {
+	return &flowAnalyticsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+// HandleFlowAnalyticsRequest handles the request to retrieve the execution analytics of a flow. The period is
+// given by the optional from and to query parameters, and defaults to the last seven days.
+func (h *flowAnalyticsHandler) HandleFlowAnalyticsRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	period := AnalyticsPeriod{To: time.Now().UTC()}
+	if toStr := query.Get("to"); toStr != "" {
+		parsed, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidPeriod)
+			return
+		}
+		period.To = parsed.UTC()
+	}
+	period.From = period.To.Add(-defaultAnalyticsPeriod)
+	if fromStr := query.Get("from"); fromStr != "" {
+		parsed, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidPeriod)
+			return
+		}
+		period.From = parsed.UTC()
+	}
+
+	analytics, svcErr := h.analyticsService.GetFlowAnalytics(r.Context(), r.PathValue("flowId"), period)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, analytics)
+}
+
+// handleError writes the HTTP error response for the given service error.
+func (h *flowAnalyticsHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode = http.StatusBadRequest
+		if svcErr.Code == ErrorFlowNotFound.Code {
+			statusCode = http.StatusNotFound
+		}
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
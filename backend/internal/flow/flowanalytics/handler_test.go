@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowanalytics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type FlowAnalyticsHandlerTestSuite struct {
+	suite.Suite
+	mockService *FlowAnalyticsServiceInterfaceMock
+	handler     *flowAnalyticsHandler
+}
+
+func TestFlowAnalyticsHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowAnalyticsHandlerTestSuite))
+}
+
+func (suite *FlowAnalyticsHandlerTestSuite) SetupTest() {
+	suite.mockService = NewFlowAnalyticsServiceInterfaceMock(suite.T())
+	suite.handler = newFlowAnalyticsHandler(suite.mockService)
+}
+
+func (suite *FlowAnalyticsHandlerTestSuite) newRequest(target string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.SetPathValue("flowId", testFlowID)
+	return req
+}
+
+func (suite *FlowAnalyticsHandlerTestSuite) TestHandleFlowAnalyticsRequest() {
+	req := suite.newRequest("/flows/" + testFlowID + "/analytics?from=2026-01-01T00:00:00Z&to=2026-01-08T00:00:00Z")
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().GetFlowAnalytics(mock.Anything, testFlowID, testPeriod).Return(&FlowAnalytics{
+		FlowID:         testFlowID,
+		From:           testPeriod.From,
+		To:             testPeriod.To,
+		Started:        4,
+		Completed:      3,
+		CompletionRate: 0.75,
+		Nodes:          []NodeAnalytics{{NodeID: testNodeID, Executions: 4, Completed: 3}},
+		FailureReasons: []FailureReasonCount{},
+	}, nil).Once()
+
+	suite.handler.HandleFlowAnalyticsRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var res FlowAnalytics
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Equal(4, res.Started)
+	suite.Equal(0.75, res.CompletionRate)
+	suite.Len(res.Nodes, 1)
+}
+
+func (suite *FlowAnalyticsHandlerTestSuite) TestHandleFlowAnalyticsRequest_DefaultPeriod() {
+	req := suite.newRequest("/flows/" + testFlowID + "/analytics")
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().GetFlowAnalytics(mock.Anything, testFlowID, mock.MatchedBy(
+		func(period AnalyticsPeriod) bool {
+			return period.To.Sub(period.From) == defaultAnalyticsPeriod && time.Since(period.To) < time.Minute
+		})).Return(&FlowAnalytics{FlowID: testFlowID}, nil).Once()
+
+	suite.handler.HandleFlowAnalyticsRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *FlowAnalyticsHandlerTestSuite) TestHandleFlowAnalyticsRequest_InvalidFrom() {
+	req := suite.newRequest("/flows/" + testFlowID + "/analytics?from=yesterday")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleFlowAnalyticsRequest(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidPeriod.Code)
+}
+
+func (suite *FlowAnalyticsHandlerTestSuite) TestHandleFlowAnalyticsRequest_FlowNotFound() {
+	req := suite.newRequest("/flows/" + testFlowID + "/analytics")
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().GetFlowAnalytics(mock.Anything, testFlowID, mock.Anything).
+		Return(nil, &ErrorFlowNotFound).Once()
+
+	suite.handler.HandleFlowAnalyticsRequest(rr, req)
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *FlowAnalyticsHandlerTestSuite) TestHandleFlowAnalyticsRequest_ServerError() {
+	req := suite.newRequest("/flows/" + testFlowID + "/analytics")
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().GetFlowAnalytics(mock.Anything, testFlowID, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	suite.handler.HandleFlowAnalyticsRequest(rr, req)
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
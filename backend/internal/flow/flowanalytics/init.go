@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowanalytics
+
+import (
+	"net/http"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the flow analytics and registers its API with the provided mux. Flow analytics relies
+// on the runtime database, so it is disabled and nil is returned when the runtime store is Redis.
+func Initialize(mux *http.ServeMux, flowMgtService flowmgt.FlowMgtServiceInterface) FlowAnalyticsServiceInterface {
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return nil
+	}
+
+	analyticsService := newFlowAnalyticsService(newFlowAnalyticsStore(), flowMgtService)
+	registerRoutes(mux, newFlowAnalyticsHandler(analyticsService))
+	return analyticsService
+}
+
+// registerRoutes registers the HTTP routes for the flow analytics.
+func registerRoutes(mux *http.ServeMux, handler *flowAnalyticsHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET /flows/{flowId}/analytics", handler.HandleFlowAnalyticsRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/{flowId}/analytics",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
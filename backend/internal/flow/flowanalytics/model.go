@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package flowanalytics records the outcome of flow executions and provides the API to analyze where users
+// complete, fail or drop out of a flow.
+package flowanalytics
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+)
+
+// ExecutionStatus defines the status of a flow execution recorded for analytics.
+type ExecutionStatus string
+
+const (
+	// ExecutionStatusInProgress indicates the flow execution has started and has not ended yet.
+	ExecutionStatusInProgress ExecutionStatus = "IN_PROGRESS"
+	// ExecutionStatusComplete indicates the flow execution completed successfully.
+	ExecutionStatusComplete ExecutionStatus = "COMPLETE"
+	// ExecutionStatusError indicates the flow execution ended with an error.
+	ExecutionStatusError ExecutionStatus = "ERROR"
+)
+
+// NodeExecutionStatus defines the outcome of a node execution recorded for analytics.
+type NodeExecutionStatus string
+
+const (
+	// NodeExecutionStatusComplete indicates the node completed and the flow moved on.
+	NodeExecutionStatusComplete NodeExecutionStatus = "COMPLETE"
+	// NodeExecutionStatusIncomplete indicates the node is waiting for input from the user.
+	NodeExecutionStatusIncomplete NodeExecutionStatus = "INCOMPLETE"
+	// NodeExecutionStatusFailure indicates the node rejected the user input, e.g. invalid credentials.
+	NodeExecutionStatusFailure NodeExecutionStatus = "FAILURE"
+	// NodeExecutionStatusError indicates the node could not be executed due to an error.
+	NodeExecutionStatusError NodeExecutionStatus = "ERROR"
+)
+
+// FlowExecutionStart represents the start of a flow execution.
+type FlowExecutionStart struct {
+	ExecutionID string
+	FlowID      string
+	FlowType    common.FlowType
+}
+
+// NodeExecution represents a single execution of a node within a flow execution.
+type NodeExecution struct {
+	ExecutionID   string
+	FlowID        string
+	NodeID        string
+	NodeType      string
+	Status        NodeExecutionStatus
+	FailureReason string
+	DurationMs    int64
+}
+
+// FlowExecutionEnd represents the end of a flow execution.
+type FlowExecutionEnd struct {
+	ExecutionID   string
+	Status        ExecutionStatus
+	FailureReason string
+	DurationMs    int64
+}
+
+// AnalyticsPeriod represents the period of the flow executions included in the analytics. Executions are
+// included when they started at or after From and before To.
+type AnalyticsPeriod struct {
+	From time.Time
+	To   time.Time
+}
+
+// executionCount holds the number and the total duration of the flow executions in a status.
+type executionCount struct {
+	Count           int
+	TotalDurationMs int64
+}
+
+// nodeExecutionAggregate holds the number and the durations of the executions of a node with a status.
+type nodeExecutionAggregate struct {
+	NodeID          string
+	NodeType        string
+	Status          NodeExecutionStatus
+	Count           int
+	TotalDurationMs int64
+	MaxDurationMs   int64
+}
+
+// FlowAnalytics represents the execution analytics of a flow over a period.
+type FlowAnalytics struct {
+	FlowID            string               `json:"flowId"`
+	From              time.Time            `json:"from"`
+	To                time.Time            `json:"to"`
+	Started           int                  `json:"started"`
+	Completed         int                  `json:"completed"`
+	Failed            int                  `json:"failed"`
+	Abandoned         int                  `json:"abandoned"`
+	InProgress        int                  `json:"inProgress"`
+	CompletionRate    float64              `json:"completionRate"`
+	AbandonmentRate   float64              `json:"abandonmentRate"`
+	AverageDurationMs int64                `json:"averageDurationMs"`
+	Nodes             []NodeAnalytics      `json:"nodes"`
+	FailureReasons    []FailureReasonCount `json:"failureReasons"`
+}
+
+// NodeAnalytics represents the execution analytics of a node of a flow.
+type NodeAnalytics struct {
+	NodeID            string `json:"nodeId"`
+	NodeType          string `json:"nodeType"`
+	Executions        int    `json:"executions"`
+	Completed         int    `json:"completed"`
+	Incomplete        int    `json:"incomplete"`
+	Failed            int    `json:"failed"`
+	Errors            int    `json:"errors"`
+	Abandoned         int    `json:"abandoned"`
+	AverageDurationMs int64  `json:"averageDurationMs"`
+	MaxDurationMs     int64  `json:"maxDurationMs"`
+}
+
+// FailureReasonCount represents the number of times a node failed with a given reason.
+type FailureReasonCount struct {
+	NodeID string `json:"nodeId"`
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+}
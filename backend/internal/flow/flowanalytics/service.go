@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowanalytics
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// maxFailureReasonLength is the maximum length of the failure reason stored for an execution.
+	maxFailureReasonLength = 1024
+	// maxFailureReasons is the maximum number of failure reasons returned in the flow analytics.
+	maxFailureReasons = 20
+)
+
+// FlowAnalyticsServiceInterface defines the interface for recording flow executions and querying the flow
+// analytics.
+type FlowAnalyticsServiceInterface interface {
+	RecordFlowStarted(ctx context.Context, start FlowExecutionStart)
+	RecordNodeExecution(ctx context.Context, execution NodeExecution)
+	RecordFlowEnded(ctx context.Context, end FlowExecutionEnd)
+	GetFlowAnalytics(ctx context.Context, flowID string, period AnalyticsPeriod) (
+		*FlowAnalytics, *serviceerror.ServiceError)
+}
+
+// flowAnalyticsService implements FlowAnalyticsServiceInterface.
+type flowAnalyticsService struct {
+	store          flowAnalyticsStoreInterface
+	flowMgtService flowmgt.FlowMgtServiceInterface
+	logger         *log.Logger
+}
+
+// newFlowAnalyticsService returns a new instance of FlowAnalyticsServiceInterface.
+func newFlowAnalyticsService(store flowAnalyticsStoreInterface,
+	flowMgtService flowmgt.FlowMgtServiceInterface) FlowAnalyticsServiceInterface {
+	return &flowAnalyticsService{
+		store:          store,
+		flowMgtService: flowMgtService,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowAnalyticsService")),
+	}
+}
+
+// RecordFlowStarted records the start of a flow execution. Failures to record the execution are logged and do
+// not affect the flow.
+func (s *flowAnalyticsService) RecordFlowStarted(ctx context.Context, start FlowExecutionStart) {
+	if err := s.store.createFlowExecution(ctx, start, time.Now().UTC()); err != nil {
+		s.logger.Error("Failed to record the start of the flow execution",
+			log.String(log.LoggerKeyExecutionID, start.ExecutionID), log.Error(err))
+	}
+}
+
+// RecordNodeExecution records a node execution of a flow execution. Failures to record the execution are logged
+// and do not affect the flow.
+func (s *flowAnalyticsService) RecordNodeExecution(ctx context.Context, execution NodeExecution) {
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID for the node execution record", log.Error(err))
+		return
+	}
+	execution.FailureReason = truncate(execution.FailureReason, maxFailureReasonLength)
+
+	if err := s.store.createNodeExecution(ctx, id, execution, time.Now().UTC()); err != nil {
+		s.logger.Error("Failed to record the node execution",
+			log.String(log.LoggerKeyExecutionID, execution.ExecutionID), log.String("nodeID", execution.NodeID),
+			log.Error(err))
+	}
+}
+
+// RecordFlowEnded records the end of a flow execution. Failures to record the execution are logged and do not
+// affect the flow.
+func (s *flowAnalyticsService) RecordFlowEnded(ctx context.Context, end FlowExecutionEnd) {
+	end.FailureReason = truncate(end.FailureReason, maxFailureReasonLength)
+
+	if err := s.store.endFlowExecution(ctx, end, time.Now().UTC()); err != nil {
+		s.logger.Error("Failed to record the end of the flow execution",
+			log.String(log.LoggerKeyExecutionID, end.ExecutionID), log.String("status", string(end.Status)),
+			log.Error(err))
+	}
+}
+
+// GetFlowAnalytics returns the execution analytics of a flow for the executions started in the given period.
+func (s *flowAnalyticsService) GetFlowAnalytics(ctx context.Context, flowID string, period AnalyticsPeriod) (
+	*FlowAnalytics, *serviceerror.ServiceError) {
+	if !period.From.Before(period.To) {
+		return nil, &ErrorInvalidPeriod
+	}
+
+	if _, svcErr := s.flowMgtService.GetFlow(ctx, flowID); svcErr != nil {
+		if svcErr.Code == flowmgt.ErrorFlowNotFound.Code {
+			return nil, &ErrorFlowNotFound
+		}
+		s.logger.Error("Failed to retrieve the flow for analytics", log.String("flowID", flowID),
+			log.String("error", svcErr.Error.DefaultValue))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	counts, err := s.store.countFlowExecutions(ctx, flowID, period)
+	if err != nil {
+		s.logger.Error("Failed to count the flow executions", log.String("flowID", flowID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	abandonedByNode, err := s.store.countAbandonedFlowExecutions(ctx, flowID, period, time.Now().UTC())
+	if err != nil {
+		s.logger.Error("Failed to count the abandoned flow executions", log.String("flowID", flowID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	aggregates, err := s.store.aggregateNodeExecutions(ctx, flowID, period)
+	if err != nil {
+		s.logger.Error("Failed to aggregate the node executions", log.String("flowID", flowID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	failureReasons, err := s.store.countFailureReasons(ctx, flowID, period, maxFailureReasons)
+	if err != nil {
+		s.logger.Error("Failed to count the failure reasons", log.String("flowID", flowID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	analytics := &FlowAnalytics{
+		FlowID:         flowID,
+		From:           period.From,
+		To:             period.To,
+		Completed:      counts[ExecutionStatusComplete].Count,
+		Failed:         counts[ExecutionStatusError].Count,
+		Nodes:          buildNodeAnalytics(aggregates, abandonedByNode),
+		FailureReasons: failureReasons,
+	}
+	for _, count := range counts {
+		analytics.Started += count.Count
+	}
+	for _, count := range abandonedByNode {
+		analytics.Abandoned += count
+	}
+	analytics.InProgress = counts[ExecutionStatusInProgress].Count - analytics.Abandoned
+	analytics.CompletionRate = ratio(analytics.Completed, analytics.Started)
+	analytics.AbandonmentRate = ratio(analytics.Abandoned, analytics.Started)
+	if analytics.Completed > 0 {
+		analytics.AverageDurationMs = counts[ExecutionStatusComplete].TotalDurationMs / int64(analytics.Completed)
+	}
+
+	return analytics, nil
+}
+
+// buildNodeAnalytics combines the node execution aggregates and the abandoned executions of each node into the
+// node analytics, ordered by node ID.
+func buildNodeAnalytics(aggregates []nodeExecutionAggregate, abandonedByNode map[string]int) []NodeAnalytics {
+	nodes := make(map[string]*NodeAnalytics)
+	getNode := func(nodeID string) *NodeAnalytics {
+		node, ok := nodes[nodeID]
+		if !ok {
+			node = &NodeAnalytics{NodeID: nodeID}
+			nodes[nodeID] = node
+		}
+		return node
+	}
+
+	totalDurations := make(map[string]int64)
+	for _, aggregate := range aggregates {
+		node := getNode(aggregate.NodeID)
+		node.NodeType = aggregate.NodeType
+		node.Executions += aggregate.Count
+		switch aggregate.Status {
+		case NodeExecutionStatusComplete:
+			node.Completed += aggregate.Count
+		case NodeExecutionStatusIncomplete:
+			node.Incomplete += aggregate.Count
+		case NodeExecutionStatusFailure:
+			node.Failed += aggregate.Count
+		case NodeExecutionStatusError:
+			node.Errors += aggregate.Count
+		}
+		totalDurations[aggregate.NodeID] += aggregate.TotalDurationMs
+		node.MaxDurationMs = max(node.MaxDurationMs, aggregate.MaxDurationMs)
+	}
+	for nodeID, count := range abandonedByNode {
+		if nodeID != "" {
+			getNode(nodeID).Abandoned += count
+		}
+	}
+
+	result := make([]NodeAnalytics, 0, len(nodes))
+	for nodeID, node := range nodes {
+		if node.Executions > 0 {
+			node.AverageDurationMs = totalDurations[nodeID] / int64(node.Executions)
+		}
+		result = append(result, *node)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].NodeID < result[j].NodeID
+	})
+
+	return result
+}
+
+// ratio returns the ratio of the given counts rounded to four decimal places, or zero when the total is zero.
+func ratio(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)/float64(total)*10000) / 10000
+}
+
+// truncate shortens the value to the given maximum length.
+func truncate(value string, maxLength int) string {
+	if len(value) > maxLength {
+		return value[:maxLength]
+	}
+	return value
+}
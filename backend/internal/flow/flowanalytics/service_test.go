@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowanalytics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+)
+
+type FlowAnalyticsServiceTestSuite struct {
+	suite.Suite
+	mockStore          *flowAnalyticsStoreInterfaceMock
+	mockFlowMgtService *flowmgtmock.FlowMgtServiceInterfaceMock
+	service            FlowAnalyticsServiceInterface
+}
+
+func TestFlowAnalyticsServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowAnalyticsServiceTestSuite))
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) SetupTest() {
+	suite.mockStore = newFlowAnalyticsStoreInterfaceMock(suite.T())
+	suite.mockFlowMgtService = flowmgtmock.NewFlowMgtServiceInterfaceMock(suite.T())
+	suite.service = newFlowAnalyticsService(suite.mockStore, suite.mockFlowMgtService)
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestRecordFlowStarted() {
+	start := FlowExecutionStart{ExecutionID: testExecutionID, FlowID: testFlowID}
+	suite.mockStore.EXPECT().createFlowExecution(mock.Anything, start, mock.Anything).Return(nil).Once()
+
+	suite.service.RecordFlowStarted(context.Background(), start)
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestRecordFlowStarted_StoreErrorIsIgnored() {
+	suite.mockStore.EXPECT().createFlowExecution(mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("db err")).Once()
+
+	suite.NotPanics(func() {
+		suite.service.RecordFlowStarted(context.Background(), FlowExecutionStart{ExecutionID: testExecutionID})
+	})
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestRecordNodeExecution_TruncatesFailureReason() {
+	suite.mockStore.EXPECT().createNodeExecution(mock.Anything, mock.AnythingOfType("string"),
+		mock.MatchedBy(func(execution NodeExecution) bool {
+			return execution.NodeID == testNodeID && len(execution.FailureReason) == maxFailureReasonLength
+		}), mock.Anything).Return(nil).Once()
+
+	suite.service.RecordNodeExecution(context.Background(), NodeExecution{
+		ExecutionID:   testExecutionID,
+		NodeID:        testNodeID,
+		Status:        NodeExecutionStatusFailure,
+		FailureReason: strings.Repeat("x", maxFailureReasonLength+10),
+	})
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestRecordFlowEnded() {
+	end := FlowExecutionEnd{ExecutionID: testExecutionID, Status: ExecutionStatusComplete, DurationMs: 1200}
+	suite.mockStore.EXPECT().endFlowExecution(mock.Anything, end, mock.Anything).Return(nil).Once()
+
+	suite.service.RecordFlowEnded(context.Background(), end)
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestGetFlowAnalytics() {
+	suite.mockFlowMgtService.EXPECT().GetFlow(mock.Anything, testFlowID).
+		Return(&flowmgt.CompleteFlowDefinition{ID: testFlowID}, nil).Once()
+	suite.mockStore.EXPECT().countFlowExecutions(mock.Anything, testFlowID, testPeriod).
+		Return(map[ExecutionStatus]executionCount{
+			ExecutionStatusComplete:   {Count: 6, TotalDurationMs: 12000},
+			ExecutionStatusError:      {Count: 1, TotalDurationMs: 500},
+			ExecutionStatusInProgress: {Count: 3},
+		}, nil).Once()
+	suite.mockStore.EXPECT().countAbandonedFlowExecutions(mock.Anything, testFlowID, testPeriod, mock.Anything).
+		Return(map[string]int{"prompt_credentials": 1, "": 1}, nil).Once()
+	suite.mockStore.EXPECT().aggregateNodeExecutions(mock.Anything, testFlowID, testPeriod).
+		Return([]nodeExecutionAggregate{
+			{NodeID: testNodeID, NodeType: "TASK_EXECUTION", Status: NodeExecutionStatusComplete, Count: 6,
+				TotalDurationMs: 600, MaxDurationMs: 150},
+			{NodeID: testNodeID, NodeType: "TASK_EXECUTION", Status: NodeExecutionStatusFailure, Count: 2,
+				TotalDurationMs: 200, MaxDurationMs: 180},
+			{NodeID: "prompt_credentials", NodeType: "PROMPT", Status: NodeExecutionStatusIncomplete, Count: 9,
+				TotalDurationMs: 9, MaxDurationMs: 2},
+		}, nil).Once()
+	suite.mockStore.EXPECT().countFailureReasons(mock.Anything, testFlowID, testPeriod, maxFailureReasons).
+		Return([]FailureReasonCount{{NodeID: testNodeID, Reason: "Invalid credentials", Count: 2}}, nil).Once()
+
+	analytics, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, testPeriod)
+	suite.Nil(svcErr)
+	suite.Equal(10, analytics.Started)
+	suite.Equal(6, analytics.Completed)
+	suite.Equal(1, analytics.Failed)
+	suite.Equal(2, analytics.Abandoned)
+	suite.Equal(1, analytics.InProgress)
+	suite.Equal(0.6, analytics.CompletionRate)
+	suite.Equal(0.2, analytics.AbandonmentRate)
+	suite.Equal(int64(2000), analytics.AverageDurationMs)
+	suite.Equal([]NodeAnalytics{
+		{NodeID: testNodeID, NodeType: "TASK_EXECUTION", Executions: 8, Completed: 6, Failed: 2,
+			AverageDurationMs: 100, MaxDurationMs: 180},
+		{NodeID: "prompt_credentials", NodeType: "PROMPT", Executions: 9, Incomplete: 9, Abandoned: 1,
+			AverageDurationMs: 1, MaxDurationMs: 2},
+	}, analytics.Nodes)
+	suite.Len(analytics.FailureReasons, 1)
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestGetFlowAnalytics_NoExecutions() {
+	suite.mockFlowMgtService.EXPECT().GetFlow(mock.Anything, testFlowID).
+		Return(&flowmgt.CompleteFlowDefinition{ID: testFlowID}, nil).Once()
+	suite.mockStore.EXPECT().countFlowExecutions(mock.Anything, testFlowID, testPeriod).
+		Return(map[ExecutionStatus]executionCount{}, nil).Once()
+	suite.mockStore.EXPECT().countAbandonedFlowExecutions(mock.Anything, testFlowID, testPeriod, mock.Anything).
+		Return(map[string]int{}, nil).Once()
+	suite.mockStore.EXPECT().aggregateNodeExecutions(mock.Anything, testFlowID, testPeriod).
+		Return([]nodeExecutionAggregate{}, nil).Once()
+	suite.mockStore.EXPECT().countFailureReasons(mock.Anything, testFlowID, testPeriod, maxFailureReasons).
+		Return([]FailureReasonCount{}, nil).Once()
+
+	analytics, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, testPeriod)
+	suite.Nil(svcErr)
+	suite.Zero(analytics.Started)
+	suite.Zero(analytics.CompletionRate)
+	suite.Zero(analytics.AverageDurationMs)
+	suite.Empty(analytics.Nodes)
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestGetFlowAnalytics_InvalidPeriod() {
+	_, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, AnalyticsPeriod{
+		From: testPeriod.To,
+		To:   testPeriod.From,
+	})
+	suite.Equal(&ErrorInvalidPeriod, svcErr)
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestGetFlowAnalytics_FlowNotFound() {
+	suite.mockFlowMgtService.EXPECT().GetFlow(mock.Anything, testFlowID).
+		Return(nil, &flowmgt.ErrorFlowNotFound).Once()
+
+	_, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, testPeriod)
+	suite.Equal(&ErrorFlowNotFound, svcErr)
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestGetFlowAnalytics_StoreError() {
+	suite.mockFlowMgtService.EXPECT().GetFlow(mock.Anything, testFlowID).
+		Return(&flowmgt.CompleteFlowDefinition{ID: testFlowID}, nil).Once()
+	suite.mockStore.EXPECT().countFlowExecutions(mock.Anything, testFlowID, testPeriod).
+		Return(nil, errors.New("db err")).Once()
+
+	_, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, testPeriod)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *FlowAnalyticsServiceTestSuite) TestGetFlowAnalytics_EmptyPeriod() {
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, svcErr := suite.service.GetFlowAnalytics(context.Background(), testFlowID, AnalyticsPeriod{From: at, To: at})
+	suite.Equal(&ErrorInvalidPeriod, svcErr)
+}
@@ -0,0 +1,295 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowanalytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// flowAnalyticsRetention is the duration for which the flow and node executions are retained for analytics.
+const flowAnalyticsRetention = 90 * 24 * time.Hour
+
+// flowAnalyticsStoreInterface defines the interface for flow analytics storage operations.
+type flowAnalyticsStoreInterface interface {
+	createFlowExecution(ctx context.Context, start FlowExecutionStart, startedAt time.Time) error
+	createNodeExecution(ctx context.Context, id string, execution NodeExecution, createdAt time.Time) error
+	endFlowExecution(ctx context.Context, end FlowExecutionEnd, endedAt time.Time) error
+	countFlowExecutions(ctx context.Context, flowID string, period AnalyticsPeriod) (
+		map[ExecutionStatus]executionCount, error)
+	countAbandonedFlowExecutions(ctx context.Context, flowID string, period AnalyticsPeriod, now time.Time) (
+		map[string]int, error)
+	aggregateNodeExecutions(ctx context.Context, flowID string, period AnalyticsPeriod) (
+		[]nodeExecutionAggregate, error)
+	countFailureReasons(ctx context.Context, flowID string, period AnalyticsPeriod, limit int) (
+		[]FailureReasonCount, error)
+}
+
+// flowAnalyticsStore is the runtime database implementation of flowAnalyticsStoreInterface.
+type flowAnalyticsStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newFlowAnalyticsStore returns a new instance of flowAnalyticsStoreInterface.
+func newFlowAnalyticsStore() flowAnalyticsStoreInterface {
+	return &flowAnalyticsStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createFlowExecution records the start of a flow execution.
+func (s *flowAnalyticsStore) createFlowExecution(ctx context.Context, start FlowExecutionStart,
+	startedAt time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateFlowExecution, start.ExecutionID, start.FlowID,
+		string(start.FlowType), string(ExecutionStatusInProgress), startedAt, startedAt.Add(flowAnalyticsRetention),
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// createNodeExecution records a node execution and updates the last node reached by the flow execution.
+func (s *flowAnalyticsStore) createNodeExecution(ctx context.Context, id string, execution NodeExecution,
+	createdAt time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateNodeExecution, id, execution.ExecutionID, execution.FlowID,
+		execution.NodeID, execution.NodeType, string(execution.Status), dbutils.ToNullableString(execution.FailureReason),
+		execution.DurationMs, createdAt, createdAt.Add(flowAnalyticsRetention), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateLastNode, execution.ExecutionID, execution.NodeID,
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// endFlowExecution records the end of a flow execution.
+func (s *flowAnalyticsStore) endFlowExecution(ctx context.Context, end FlowExecutionEnd, endedAt time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryEndFlowExecution, end.ExecutionID, string(end.Status),
+		dbutils.ToNullableString(end.FailureReason), end.DurationMs, endedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// countFlowExecutions returns the number and the total duration of the executions of a flow in each status.
+func (s *flowAnalyticsStore) countFlowExecutions(ctx context.Context, flowID string, period AnalyticsPeriod) (
+	map[ExecutionStatus]executionCount, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountFlowExecutions, flowID, period.From, period.To,
+		s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	counts := make(map[ExecutionStatus]executionCount, len(results))
+	for _, row := range results {
+		status, ok := row["status"].(string)
+		if !ok {
+			return nil, errors.New("failed to parse status as string")
+		}
+		total, err := parseIntField(row["total"], "total")
+		if err != nil {
+			return nil, err
+		}
+		totalDuration, err := parseIntField(row["total_duration"], "total_duration")
+		if err != nil {
+			return nil, err
+		}
+		counts[ExecutionStatus(status)] = executionCount{Count: total, TotalDurationMs: int64(totalDuration)}
+	}
+
+	return counts, nil
+}
+
+// countAbandonedFlowExecutions returns the number of abandoned executions of a flow by the last node they
+// reached. Executions abandoned before reaching a node are counted under an empty node ID.
+func (s *flowAnalyticsStore) countAbandonedFlowExecutions(ctx context.Context, flowID string,
+	period AnalyticsPeriod, now time.Time) (map[string]int, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountAbandonedFlowExecutions, flowID, period.From, period.To,
+		s.deploymentID, string(ExecutionStatusInProgress), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	counts := make(map[string]int, len(results))
+	for _, row := range results {
+		// The last node is NULL for executions abandoned before reaching a node.
+		lastNodeID, _ := row["last_node_id"].(string)
+		total, err := parseIntField(row["total"], "total")
+		if err != nil {
+			return nil, err
+		}
+		counts[lastNodeID] += total
+	}
+
+	return counts, nil
+}
+
+// aggregateNodeExecutions returns the number and the durations of the executions of each node of a flow by
+// status.
+func (s *flowAnalyticsStore) aggregateNodeExecutions(ctx context.Context, flowID string,
+	period AnalyticsPeriod) ([]nodeExecutionAggregate, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryAggregateNodeExecutions, flowID, period.From, period.To,
+		s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	aggregates := make([]nodeExecutionAggregate, 0, len(results))
+	for _, row := range results {
+		aggregate, err := buildNodeExecutionAggregateFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build node execution aggregate from result row: %w", err)
+		}
+		aggregates = append(aggregates, *aggregate)
+	}
+
+	return aggregates, nil
+}
+
+// countFailureReasons returns the most frequent failure reasons of the node executions of a flow.
+func (s *flowAnalyticsStore) countFailureReasons(ctx context.Context, flowID string, period AnalyticsPeriod,
+	limit int) ([]FailureReasonCount, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountFailureReasons, flowID, period.From, period.To,
+		s.deploymentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	reasons := make([]FailureReasonCount, 0, len(results))
+	for _, row := range results {
+		nodeID, ok := row["node_id"].(string)
+		if !ok {
+			return nil, errors.New("failed to parse node_id as string")
+		}
+		reason, ok := row["failure_reason"].(string)
+		if !ok {
+			return nil, errors.New("failed to parse failure_reason as string")
+		}
+		total, err := parseIntField(row["total"], "total")
+		if err != nil {
+			return nil, err
+		}
+		reasons = append(reasons, FailureReasonCount{NodeID: nodeID, Reason: reason, Count: total})
+	}
+
+	return reasons, nil
+}
+
+// buildNodeExecutionAggregateFromResultRow constructs a nodeExecutionAggregate from a database result row.
+func buildNodeExecutionAggregateFromResultRow(row map[string]interface{}) (*nodeExecutionAggregate, error) {
+	nodeID, ok := row["node_id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse node_id as string")
+	}
+	nodeType, ok := row["node_type"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse node_type as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse status as string")
+	}
+	total, err := parseIntField(row["total"], "total")
+	if err != nil {
+		return nil, err
+	}
+	totalDuration, err := parseIntField(row["total_duration"], "total_duration")
+	if err != nil {
+		return nil, err
+	}
+	maxDuration, err := parseIntField(row["max_duration"], "max_duration")
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodeExecutionAggregate{
+		NodeID:          nodeID,
+		NodeType:        nodeType,
+		Status:          NodeExecutionStatus(status),
+		Count:           total,
+		TotalDurationMs: int64(totalDuration),
+		MaxDurationMs:   int64(maxDuration),
+	}, nil
+}
+
+// parseIntField parses an integer column value returned by the database driver.
+func parseIntField(value interface{}, fieldName string) (int, error) {
+	switch v := value.(type) {
+	case int64:
+		return int(v), nil
+	case int32:
+		return int(v), nil
+	case int:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("failed to parse %s as integer", fieldName)
+	}
+}
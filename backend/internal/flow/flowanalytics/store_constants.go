@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowanalytics
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateFlowExecution is the query to record the start of a flow execution. An execution that is
+	// already recorded is left unchanged.
+	queryCreateFlowExecution = dbmodel.DBQuery{
+		ID: "FLQ-FLOW_ANL-01",
+		Query: `INSERT INTO "FLOW_EXECUTION_ANALYTICS" ` +
+			`(EXECUTION_ID, FLOW_ID, FLOW_TYPE, STATUS, STARTED_AT, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (EXECUTION_ID, DEPLOYMENT_ID) DO NOTHING`,
+	}
+
+	// queryCreateNodeExecution is the query to record a node execution.
+	queryCreateNodeExecution = dbmodel.DBQuery{
+		ID: "FLQ-FLOW_ANL-02",
+		Query: `INSERT INTO "FLOW_NODE_EXECUTION_ANALYTICS" ` +
+			`(ID, EXECUTION_ID, FLOW_ID, NODE_ID, NODE_TYPE, STATUS, FAILURE_REASON, DURATION_MS, CREATED_AT, ` +
+			`EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+	}
+
+	// queryUpdateLastNode is the query to update the last node reached by a flow execution.
+	queryUpdateLastNode = dbmodel.DBQuery{
+		ID: "FLQ-FLOW_ANL-03",
+		Query: `UPDATE "FLOW_EXECUTION_ANALYTICS" SET LAST_NODE_ID = $2 ` +
+			`WHERE EXECUTION_ID = $1 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryEndFlowExecution is the query to record the end of a flow execution.
+	queryEndFlowExecution = dbmodel.DBQuery{
+		ID: "FLQ-FLOW_ANL-04",
+		Query: `UPDATE "FLOW_EXECUTION_ANALYTICS" SET STATUS = $2, FAILURE_REASON = $3, DURATION_MS = $4, ` +
+			`ENDED_AT = $5 WHERE EXECUTION_ID = $1 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryFlowExecutionPeriodFilter is the condition shared by the analytics queries selecting the executions of
+	// a flow that started in a period.
+	queryFlowExecutionPeriodFilter = `WHERE e.FLOW_ID = $1 AND e.STARTED_AT >= $2 AND e.STARTED_AT < $3 ` +
+		`AND e.DEPLOYMENT_ID = $4`
+
+	// queryCountFlowExecutions is the query to count the executions of a flow in each status.
+	queryCountFlowExecutions = dbmodel.DBQuery{
+		ID: "FLQ-FLOW_ANL-05",
+		Query: `SELECT e.STATUS AS status, COUNT(*) AS total, ` +
+			`CAST(COALESCE(SUM(e.DURATION_MS), 0) AS BIGINT) AS total_duration ` +
+			`FROM "FLOW_EXECUTION_ANALYTICS" e ` + queryFlowExecutionPeriodFilter + ` GROUP BY e.STATUS`,
+	}
+
+	// queryCountAbandonedFlowExecutions is the query to count the executions of a flow that were abandoned,
+	// grouped by the last node they reached. An execution is abandoned when it has not ended and its flow
+	// context has expired.
+	queryCountAbandonedFlowExecutions = dbmodel.DBQuery{
+		ID: "FLQ-FLOW_ANL-06",
+		Query: `SELECT e.LAST_NODE_ID AS last_node_id, COUNT(*) AS total ` +
+			`FROM "FLOW_EXECUTION_ANALYTICS" e ` + queryFlowExecutionPeriodFilter + ` AND e.STATUS = $5 ` +
+			`AND NOT EXISTS (SELECT 1 FROM "FLOW_CONTEXT" c WHERE c.FLOW_ID = e.EXECUTION_ID ` +
+			`AND c.DEPLOYMENT_ID = e.DEPLOYMENT_ID AND c.EXPIRY_TIME > $6) GROUP BY e.LAST_NODE_ID`,
+	}
+
+	// queryNodeExecutionPeriodFilter is the condition shared by the analytics queries selecting the node
+	// executions of a flow recorded in a period.
+	queryNodeExecutionPeriodFilter = `WHERE FLOW_ID = $1 AND CREATED_AT >= $2 AND CREATED_AT < $3 ` +
+		`AND DEPLOYMENT_ID = $4`
+
+	// queryAggregateNodeExecutions is the query to aggregate the node executions of a flow by node and status.
+	queryAggregateNodeExecutions = dbmodel.DBQuery{
+		ID: "FLQ-FLOW_ANL-07",
+		Query: `SELECT NODE_ID AS node_id, NODE_TYPE AS node_type, STATUS AS status, COUNT(*) AS total, ` +
+			`CAST(COALESCE(SUM(DURATION_MS), 0) AS BIGINT) AS total_duration, ` +
+			`CAST(COALESCE(MAX(DURATION_MS), 0) AS BIGINT) AS max_duration ` +
+			`FROM "FLOW_NODE_EXECUTION_ANALYTICS" ` + queryNodeExecutionPeriodFilter +
+			` GROUP BY NODE_ID, NODE_TYPE, STATUS`,
+	}
+
+	// queryCountFailureReasons is the query to count the failure reasons of the node executions of a flow, most
+	// frequent first.
+	queryCountFailureReasons = dbmodel.DBQuery{
+		ID: "FLQ-FLOW_ANL-08",
+		Query: `SELECT NODE_ID AS node_id, FAILURE_REASON AS failure_reason, COUNT(*) AS total ` +
+			`FROM "FLOW_NODE_EXECUTION_ANALYTICS" ` + queryNodeExecutionPeriodFilter +
+			` AND FAILURE_REASON IS NOT NULL GROUP BY NODE_ID, FAILURE_REASON ORDER BY total DESC, NODE_ID LIMIT $5`,
+	}
+)
@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowanalytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment"
+	testExecutionID  = "execution-1"
+	testFlowID       = "flow-1"
+	testNodeID       = "basic_auth"
+)
+
+var testPeriod = AnalyticsPeriod{
+	From: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	To:   time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC),
+}
+
+type FlowAnalyticsStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *flowAnalyticsStore
+}
+
+func TestFlowAnalyticsStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowAnalyticsStoreTestSuite))
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &flowAnalyticsStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestCreateFlowExecution() {
+	startedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateFlowExecution, testExecutionID,
+		testFlowID, "AUTHENTICATION", "IN_PROGRESS", startedAt, startedAt.Add(flowAnalyticsRetention),
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createFlowExecution(context.Background(), FlowExecutionStart{
+		ExecutionID: testExecutionID,
+		FlowID:      testFlowID,
+		FlowType:    common.FlowTypeAuthentication,
+	}, startedAt)
+	suite.NoError(err)
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestCreateFlowExecution_DBClientError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createFlowExecution(context.Background(), FlowExecutionStart{}, time.Now())
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestCreateNodeExecution() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateNodeExecution, "node-exec-1",
+		testExecutionID, testFlowID, testNodeID, "TASK_EXECUTION", "FAILURE", "Invalid credentials", int64(42),
+		createdAt, createdAt.Add(flowAnalyticsRetention), testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateLastNode, testExecutionID,
+		testNodeID, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createNodeExecution(context.Background(), "node-exec-1", NodeExecution{
+		ExecutionID:   testExecutionID,
+		FlowID:        testFlowID,
+		NodeID:        testNodeID,
+		NodeType:      "TASK_EXECUTION",
+		Status:        NodeExecutionStatusFailure,
+		FailureReason: "Invalid credentials",
+		DurationMs:    42,
+	}, createdAt)
+	suite.NoError(err)
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestCreateNodeExecution_UpdateLastNodeError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryCreateNodeExecution, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, nil, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryUpdateLastNode, mock.Anything, mock.Anything,
+		mock.Anything).Return(int64(0), errors.New("db err")).Once()
+
+	err := suite.store.createNodeExecution(context.Background(), "node-exec-1", NodeExecution{
+		ExecutionID: testExecutionID,
+		NodeID:      testNodeID,
+		Status:      NodeExecutionStatusComplete,
+	}, time.Now())
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to execute query")
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestEndFlowExecution() {
+	endedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryEndFlowExecution, testExecutionID,
+		"COMPLETE", nil, int64(1500), endedAt, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.endFlowExecution(context.Background(), FlowExecutionEnd{
+		ExecutionID: testExecutionID,
+		Status:      ExecutionStatusComplete,
+		DurationMs:  1500,
+	}, endedAt)
+	suite.NoError(err)
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestCountFlowExecutions() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryCountFlowExecutions, testFlowID,
+		testPeriod.From, testPeriod.To, testDeploymentID).Return([]map[string]interface{}{
+		{"status": "COMPLETE", "total": int64(4), "total_duration": int64(8000)},
+		{"status": "IN_PROGRESS", "total": int64(2), "total_duration": int64(0)},
+	}, nil).Once()
+
+	counts, err := suite.store.countFlowExecutions(context.Background(), testFlowID, testPeriod)
+	suite.NoError(err)
+	suite.Equal(map[ExecutionStatus]executionCount{
+		ExecutionStatusComplete:   {Count: 4, TotalDurationMs: 8000},
+		ExecutionStatusInProgress: {Count: 2},
+	}, counts)
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestCountFlowExecutions_InvalidRow() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(mock.Anything, queryCountFlowExecutions, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return([]map[string]interface{}{
+		{"status": "COMPLETE", "total": "four"},
+	}, nil).Once()
+
+	_, err := suite.store.countFlowExecutions(context.Background(), testFlowID, testPeriod)
+	suite.Error(err)
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestCountAbandonedFlowExecutions() {
+	now := time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryCountAbandonedFlowExecutions, testFlowID,
+		testPeriod.From, testPeriod.To, testDeploymentID, "IN_PROGRESS", now).Return([]map[string]interface{}{
+		{"last_node_id": testNodeID, "total": int64(3)},
+		{"last_node_id": nil, "total": int64(1)},
+	}, nil).Once()
+
+	counts, err := suite.store.countAbandonedFlowExecutions(context.Background(), testFlowID, testPeriod, now)
+	suite.NoError(err)
+	suite.Equal(map[string]int{testNodeID: 3, "": 1}, counts)
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestAggregateNodeExecutions() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryAggregateNodeExecutions, testFlowID,
+		testPeriod.From, testPeriod.To, testDeploymentID).Return([]map[string]interface{}{
+		{"node_id": testNodeID, "node_type": "TASK_EXECUTION", "status": "FAILURE", "total": int64(2),
+			"total_duration": int64(300), "max_duration": int64(200)},
+	}, nil).Once()
+
+	aggregates, err := suite.store.aggregateNodeExecutions(context.Background(), testFlowID, testPeriod)
+	suite.NoError(err)
+	suite.Equal([]nodeExecutionAggregate{{
+		NodeID:          testNodeID,
+		NodeType:        "TASK_EXECUTION",
+		Status:          NodeExecutionStatusFailure,
+		Count:           2,
+		TotalDurationMs: 300,
+		MaxDurationMs:   200,
+	}}, aggregates)
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestAggregateNodeExecutions_QueryError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(mock.Anything, queryAggregateNodeExecutions, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db err")).Once()
+
+	_, err := suite.store.aggregateNodeExecutions(context.Background(), testFlowID, testPeriod)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to execute query")
+}
+
+func (suite *FlowAnalyticsStoreTestSuite) TestCountFailureReasons() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryCountFailureReasons, testFlowID,
+		testPeriod.From, testPeriod.To, testDeploymentID, 5).Return([]map[string]interface{}{
+		{"node_id": testNodeID, "failure_reason": "Invalid credentials", "total": int64(7)},
+	}, nil).Once()
+
+	reasons, err := suite.store.countFailureReasons(context.Background(), testFlowID, testPeriod, 5)
+	suite.NoError(err)
+	suite.Equal([]FailureReasonCount{{NodeID: testNodeID, Reason: "Invalid credentials", Count: 7}}, reasons)
+}
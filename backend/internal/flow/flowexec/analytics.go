@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/flowanalytics"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// recordFlowStartedAnalytics records the start of the flow execution for flow analytics.
+func recordFlowStartedAnalytics(ctx *EngineContext, analyticsSvc flowanalytics.FlowAnalyticsServiceInterface) {
+	if analyticsSvc == nil || ctx.Graph == nil {
+		return
+	}
+
+	analyticsSvc.RecordFlowStarted(ctx.Context, flowanalytics.FlowExecutionStart{
+		ExecutionID: ctx.ExecutionID,
+		FlowID:      ctx.Graph.GetID(),
+		FlowType:    ctx.FlowType,
+	})
+}
+
+// recordNodeExecutionAnalytics records the outcome and the latency of a node execution for flow analytics.
+func recordNodeExecutionAnalytics(ctx *EngineContext, node core.NodeInterface, nodeResp *common.NodeResponse,
+	nodeErr *serviceerror.ServiceError, executionStartTime int64, executionEndTime int64,
+	analyticsSvc flowanalytics.FlowAnalyticsServiceInterface) {
+	if analyticsSvc == nil || ctx.Graph == nil {
+		return
+	}
+
+	execution := flowanalytics.NodeExecution{
+		ExecutionID: ctx.ExecutionID,
+		FlowID:      ctx.Graph.GetID(),
+		NodeID:      node.GetID(),
+		NodeType:    string(node.GetType()),
+		Status:      flowanalytics.NodeExecutionStatusComplete,
+		DurationMs:  executionEndTime - executionStartTime,
+	}
+	if nodeErr != nil {
+		execution.Status = flowanalytics.NodeExecutionStatusError
+		execution.FailureReason = nodeErr.Code
+	} else if nodeResp != nil {
+		switch nodeResp.Status {
+		case common.NodeStatusIncomplete:
+			execution.Status = flowanalytics.NodeExecutionStatusIncomplete
+		case common.NodeStatusFailure:
+			execution.Status = flowanalytics.NodeExecutionStatusFailure
+			execution.FailureReason = nodeResp.FailureReason
+		}
+	}
+
+	analyticsSvc.RecordNodeExecution(ctx.Context, execution)
+}
+
+// recordFlowEndedAnalytics records the end of the flow execution for flow analytics. The duration of the flow
+// execution is measured from the start of the first node execution.
+func recordFlowEndedAnalytics(ctx *EngineContext, status flowanalytics.ExecutionStatus, failureReason string,
+	flowEndTime int64, analyticsSvc flowanalytics.FlowAnalyticsServiceInterface) {
+	if analyticsSvc == nil || ctx.Graph == nil {
+		return
+	}
+
+	var durationMs int64
+	if flowStartTime := getFirstExecutionStartTime(ctx); flowStartTime > 0 {
+		durationMs = flowEndTime - flowStartTime
+	}
+
+	analyticsSvc.RecordFlowEnded(ctx.Context, flowanalytics.FlowExecutionEnd{
+		ExecutionID:   ctx.ExecutionID,
+		Status:        status,
+		FailureReason: failureReason,
+		DurationMs:    durationMs,
+	})
+}
+
+// getFirstExecutionStartTime returns the start time in milliseconds of the earliest node execution of the flow
+// execution, or zero if no node has been executed.
+func getFirstExecutionStartTime(ctx *EngineContext) int64 {
+	var startTime int64
+	for _, record := range ctx.ExecutionHistory {
+		for _, attempt := range record.Executions {
+			if attempt.StartTime > 0 && (startTime == 0 || attempt.StartTime < startTime) {
+				startTime = attempt.StartTime
+			}
+		}
+	}
+	return startTime
+}
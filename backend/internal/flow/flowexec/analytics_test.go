@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowanalytics"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowanalyticsmock"
+)
+
+// newAnalyticsTestContext creates an engine context of a flow execution with the given graph.
+func newAnalyticsTestContext(t *testing.T) *EngineContext {
+	t.Helper()
+
+	graph := coremock.NewGraphInterfaceMock(t)
+	graph.On("GetID").Return("flow-001").Maybe()
+
+	return &EngineContext{
+		Context:          context.Background(),
+		ExecutionID:      "execution-001",
+		FlowType:         common.FlowTypeRegistration,
+		Graph:            graph,
+		ExecutionHistory: make(map[string]*common.NodeExecutionRecord),
+	}
+}
+
+func TestRecordFlowStartedAnalytics(t *testing.T) {
+	analyticsSvc := flowanalyticsmock.NewFlowAnalyticsServiceInterfaceMock(t)
+	analyticsSvc.EXPECT().RecordFlowStarted(mock.Anything, flowanalytics.FlowExecutionStart{
+		ExecutionID: "execution-001",
+		FlowID:      "flow-001",
+		FlowType:    common.FlowTypeRegistration,
+	}).Once()
+
+	recordFlowStartedAnalytics(newAnalyticsTestContext(t), analyticsSvc)
+}
+
+func TestRecordFlowStartedAnalytics_NilService(t *testing.T) {
+	assert.NotPanics(t, func() {
+		recordFlowStartedAnalytics(newAnalyticsTestContext(t), nil)
+	})
+}
+
+func TestRecordNodeExecutionAnalytics(t *testing.T) {
+	tests := []struct {
+		name           string
+		nodeResp       *common.NodeResponse
+		nodeErr        *serviceerror.ServiceError
+		expectedStatus flowanalytics.NodeExecutionStatus
+		expectedReason string
+	}{
+		{
+			name:           "Complete",
+			nodeResp:       &common.NodeResponse{Status: common.NodeStatusComplete},
+			expectedStatus: flowanalytics.NodeExecutionStatusComplete,
+		},
+		{
+			name:           "Incomplete",
+			nodeResp:       &common.NodeResponse{Status: common.NodeStatusIncomplete},
+			expectedStatus: flowanalytics.NodeExecutionStatusIncomplete,
+		},
+		{
+			name:           "Failure",
+			nodeResp:       &common.NodeResponse{Status: common.NodeStatusFailure, FailureReason: "Invalid OTP"},
+			expectedStatus: flowanalytics.NodeExecutionStatusFailure,
+			expectedReason: "Invalid OTP",
+		},
+		{
+			name:           "Error",
+			nodeErr:        &serviceerror.InternalServerError,
+			expectedStatus: flowanalytics.NodeExecutionStatusError,
+			expectedReason: serviceerror.InternalServerError.Code,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := coremock.NewNodeInterfaceMock(t)
+			node.On("GetID").Return("node-001")
+			node.On("GetType").Return(common.NodeTypeTaskExecution)
+
+			analyticsSvc := flowanalyticsmock.NewFlowAnalyticsServiceInterfaceMock(t)
+			analyticsSvc.EXPECT().RecordNodeExecution(mock.Anything, flowanalytics.NodeExecution{
+				ExecutionID:   "execution-001",
+				FlowID:        "flow-001",
+				NodeID:        "node-001",
+				NodeType:      string(common.NodeTypeTaskExecution),
+				Status:        tt.expectedStatus,
+				FailureReason: tt.expectedReason,
+				DurationMs:    250,
+			}).Once()
+
+			recordNodeExecutionAnalytics(newAnalyticsTestContext(t), node, tt.nodeResp, tt.nodeErr, 1000, 1250,
+				analyticsSvc)
+		})
+	}
+}
+
+func TestRecordFlowEndedAnalytics(t *testing.T) {
+	ctx := newAnalyticsTestContext(t)
+	ctx.ExecutionHistory["node-001"] = &common.NodeExecutionRecord{
+		Executions: []common.ExecutionAttempt{{StartTime: 2000, EndTime: 2100}, {StartTime: 5000, EndTime: 5100}},
+	}
+	ctx.ExecutionHistory["node-002"] = &common.NodeExecutionRecord{
+		Executions: []common.ExecutionAttempt{{StartTime: 1000, EndTime: 1050}},
+	}
+
+	analyticsSvc := flowanalyticsmock.NewFlowAnalyticsServiceInterfaceMock(t)
+	analyticsSvc.EXPECT().RecordFlowEnded(mock.Anything, flowanalytics.FlowExecutionEnd{
+		ExecutionID:   "execution-001",
+		Status:        flowanalytics.ExecutionStatusError,
+		FailureReason: "Invalid OTP",
+		DurationMs:    5000,
+	}).Once()
+
+	recordFlowEndedAnalytics(ctx, flowanalytics.ExecutionStatusError, "Invalid OTP", 6000, analyticsSvc)
+}
+
+func TestRecordFlowEndedAnalytics_NoNodeExecuted(t *testing.T) {
+	analyticsSvc := flowanalyticsmock.NewFlowAnalyticsServiceInterfaceMock(t)
+	analyticsSvc.EXPECT().RecordFlowEnded(mock.Anything, flowanalytics.FlowExecutionEnd{
+		ExecutionID: "execution-001",
+		Status:      flowanalytics.ExecutionStatusComplete,
+	}).Once()
+
+	recordFlowEndedAnalytics(newAnalyticsTestContext(t), flowanalytics.ExecutionStatusComplete, "", 6000,
+		analyticsSvc)
+}
+
+func TestRecordFlowEndedAnalytics_NoGraph(t *testing.T) {
+	analyticsSvc := flowanalyticsmock.NewFlowAnalyticsServiceInterfaceMock(t)
+	ctx := newAnalyticsTestContext(t)
+	ctx.Graph = nil
+
+	recordFlowEndedAnalytics(ctx, flowanalytics.ExecutionStatusComplete, "", 6000, analyticsSvc)
+	analyticsSvc.AssertNotCalled(t, "RecordFlowEnded", mock.Anything, mock.Anything)
+}
@@ -22,11 +22,14 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/flowanalytics"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -44,17 +47,21 @@ type flowEngineInterface interface {
 type flowEngine struct {
 	executorRegistry executor.ExecutorRegistryInterface
 	observabilitySvc observability.ObservabilityServiceInterface
+	analyticsSvc     flowanalytics.FlowAnalyticsServiceInterface
 	logger           *log.Logger
 }
 
-// newFlowEngine creates a new flow engine with the given dependencies.
+// newFlowEngine creates a new flow engine with the given dependencies. The analyticsSvc parameter is optional
+// (can be nil) - if nil, the flow executions are not recorded for analytics.
 func newFlowEngine(
 	executorRegistry executor.ExecutorRegistryInterface,
 	observabilitySvc observability.ObservabilityServiceInterface,
+	analyticsSvc flowanalytics.FlowAnalyticsServiceInterface,
 ) flowEngineInterface {
 	return &flowEngine{
 		executorRegistry: executorRegistry,
 		observabilitySvc: observabilitySvc,
+		analyticsSvc:     analyticsSvc,
 		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowEngine")),
 	}
 }
@@ -73,11 +80,14 @@ func (fe *flowEngine) Execute(ctx *EngineContext) (FlowStep, *serviceerror.Servi
 	// Publish flow started event (only if this is the first execution - check if ExecutionHistory is empty)
 	if len(ctx.ExecutionHistory) == 0 {
 		publishFlowStartedEvent(ctx, fe.observabilitySvc)
+		recordFlowStartedAnalytics(ctx, fe.analyticsSvc)
 	}
 
 	if err := fe.setCurrentExecutionNode(ctx, logger); err != nil {
 		// Publish flow failed event before returning error
 		publishFlowFailedEvent(ctx, err, flowStartTime, time.Now().UnixMilli(), fe.observabilitySvc)
+		recordFlowEndedAnalytics(ctx, flowanalytics.ExecutionStatusError, err.Code, time.Now().UnixMilli(),
+			fe.analyticsSvc)
 		return flowStep, err
 	}
 
@@ -102,6 +112,7 @@ func (fe *flowEngine) Execute(ctx *EngineContext) (FlowStep, *serviceerror.Servi
 			CurrentNodeID:     ctx.CurrentNode.GetID(),
 			RuntimeData:       ctx.RuntimeData,
 			ForwardedData:     ctx.ForwardedData,
+			DisallowedActions: getDisallowedIDPActions(ctx, currentNode),
 			Application:       ctx.Application,
 			AuthenticatedUser: ctx.AuthenticatedUser,
 			AuthUser:          ctx.AuthUser,
@@ -158,13 +169,21 @@ func (fe *flowEngine) Execute(ctx *EngineContext) (FlowStep, *serviceerror.Servi
 		// Publish node execution started event
 		publishNodeExecutionStartedEvent(ctx, currentNode, fe.observabilitySvc)
 
-		nodeResp, nodeErr := currentNode.Execute(nodeCtx)
+		var nodeResp *common.NodeResponse
+		var nodeErr *serviceerror.ServiceError
+		if forkNode, ok := currentNode.(core.ForkNodeInterface); ok {
+			nodeResp, nodeErr = fe.executeForkNode(ctx, nodeCtx, forkNode, logger)
+		} else {
+			nodeResp, nodeErr = currentNode.Execute(nodeCtx)
+		}
 		executionEndTime := time.Now().UnixMilli()
 
 		// Clear sensitive inputs from context after executor has consumed them.
 		fe.clearSensitiveInputs(ctx, currentNode)
 
 		recordNodeExecution(ctx, currentNode, nodeResp, nodeErr, executionStartTime, executionEndTime)
+		recordNodeExecutionAnalytics(ctx, currentNode, nodeResp, nodeErr, executionStartTime, executionEndTime,
+			fe.analyticsSvc)
 
 		// Publish node execution completed or failed event
 		publishNodeExecutionCompletedEvent(
@@ -175,6 +194,8 @@ func (fe *flowEngine) Execute(ctx *EngineContext) (FlowStep, *serviceerror.Servi
 		if nodeErr != nil {
 			// Publish flow failed event before returning error
 			publishFlowFailedEvent(ctx, nodeErr, flowStartTime, time.Now().UnixMilli(), fe.observabilitySvc)
+			recordFlowEndedAnalytics(ctx, flowanalytics.ExecutionStatusError, nodeErr.Code, time.Now().UnixMilli(),
+				fe.analyticsSvc)
 			return flowStep, nodeErr
 		}
 
@@ -184,18 +205,24 @@ func (fe *flowEngine) Execute(ctx *EngineContext) (FlowStep, *serviceerror.Servi
 		if svcErr != nil {
 			// Publish flow failed event before returning error
 			publishFlowFailedEvent(ctx, svcErr, flowStartTime, time.Now().UnixMilli(), fe.observabilitySvc)
+			recordFlowEndedAnalytics(ctx, flowanalytics.ExecutionStatusError, svcErr.Code, time.Now().UnixMilli(),
+				fe.analyticsSvc)
 			return flowStep, svcErr
 		}
 		if !continueExecution {
 			// Check if flow failed or just incomplete
 			if flowStep.Status == common.FlowStatusError {
 				publishFlowFailedEvent(ctx, nil, flowStartTime, time.Now().UnixMilli(), fe.observabilitySvc)
+				recordFlowEndedAnalytics(ctx, flowanalytics.ExecutionStatusError, flowStep.FailureReason,
+					time.Now().UnixMilli(), fe.analyticsSvc)
 				return flowStep, nil
 			}
 
 			// Flow is incomplete — rotate challenge token so the next step is bound to a fresh token
 			if svcErr := fe.rotateChallengeToken(ctx, &flowStep); svcErr != nil {
 				publishFlowFailedEvent(ctx, svcErr, flowStartTime, time.Now().UnixMilli(), fe.observabilitySvc)
+				recordFlowEndedAnalytics(ctx, flowanalytics.ExecutionStatusError, svcErr.Code,
+					time.Now().UnixMilli(), fe.analyticsSvc)
 				return flowStep, svcErr
 			}
 
@@ -210,10 +237,16 @@ func (fe *flowEngine) Execute(ctx *EngineContext) (FlowStep, *serviceerror.Servi
 	if ctx.Assertion != "" {
 		flowStep.Assertion = ctx.Assertion
 	}
+	if ctx.FlowType == common.FlowTypeAuthentication {
+		if redirectURL := resolvePostLoginRedirect(ctx); redirectURL != "" {
+			flowStep.Data.RedirectURL = redirectURL
+		}
+	}
 
 	// Publish flow completed event
 	flowEndTime := time.Now().UnixMilli()
 	publishFlowCompletedEvent(ctx, flowStartTime, flowEndTime, fe.observabilitySvc)
+	recordFlowEndedAnalytics(ctx, flowanalytics.ExecutionStatusComplete, "", flowEndTime, fe.analyticsSvc)
 
 	return flowStep, nil
 }
@@ -467,6 +500,8 @@ func (fe *flowEngine) shouldUpdateAuthenticatedUser(engineCtx *EngineContext) bo
 		return executorInst.GetType() == common.ExecutorTypeAuthentication
 	}
 
+	// MFA enrollment flows run for the user signed in when the flow started, and the factors enrolled
+	// in the flow must not replace that user.
 	return false
 }
 
@@ -517,6 +552,218 @@ func (fe *flowEngine) processNodeResponse(ctx *EngineContext, nodeResp *common.N
 	}
 }
 
+// branchNodeExecution holds the outcome of executing a node in a parallel branch of a fork node.
+type branchNodeExecution struct {
+	node      core.NodeInterface
+	nodeResp  *common.NodeResponse
+	nodeErr   *serviceerror.ServiceError
+	startTime int64
+	endTime   int64
+}
+
+// branchResult holds the outcome of executing a parallel branch of a fork node.
+type branchResult struct {
+	executions    []branchNodeExecution
+	exitNodeID    string
+	failureReason string
+	svcErr        *serviceerror.ServiceError
+}
+
+// executeForkNode executes the branches of a fork node concurrently and waits until all of them complete.
+// The node executions of the branches are then recorded and applied to the engine context in branch order,
+// as if the branches were executed one after another. The flow continues at the join node, or at the node a
+// branch left the fork to, such as the onFailure prompt of a failed node.
+func (fe *flowEngine) executeForkNode(ctx *EngineContext, nodeCtx *core.NodeContext,
+	forkNode core.ForkNodeInterface, logger *log.Logger) (*common.NodeResponse, *serviceerror.ServiceError) {
+	joinNodeID := forkNode.GetOnSuccess()
+	branches := forkNode.GetBranches()
+	logger.Debug("Executing parallel branches", log.String("nodeID", forkNode.GetID()),
+		log.Int("branchCount", len(branches)))
+
+	results := make([]branchResult, len(branches))
+	var executorMu sync.Mutex
+	var wg sync.WaitGroup
+	for i, startNodeID := range branches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = fe.executeBranch(ctx, nodeCtx, startNodeID, joinNodeID, &executorMu, logger)
+		}()
+	}
+	wg.Wait()
+
+	forkNodeCtx := ctx.CurrentNode
+	for _, result := range results {
+		for _, execution := range result.executions {
+			publishNodeExecutionStartedEvent(ctx, execution.node, fe.observabilitySvc)
+			fe.clearSensitiveInputs(ctx, execution.node)
+			recordNodeExecution(ctx, execution.node, execution.nodeResp, execution.nodeErr,
+				execution.startTime, execution.endTime)
+			publishNodeExecutionCompletedEvent(ctx, execution.node, execution.nodeResp, execution.nodeErr,
+				execution.startTime, execution.endTime, fe.observabilitySvc)
+
+			if execution.nodeErr == nil {
+				ctx.CurrentNode = execution.node
+				fe.updateContextWithNodeResponse(ctx, execution.nodeResp)
+			}
+		}
+	}
+	ctx.CurrentNode = forkNodeCtx
+
+	nodeResp := &common.NodeResponse{
+		Status:         common.NodeStatusComplete,
+		NextNodeID:     joinNodeID,
+		RuntimeData:    make(map[string]string),
+		AdditionalData: make(map[string]string),
+	}
+	for _, result := range results {
+		if result.svcErr != nil {
+			return nil, result.svcErr
+		}
+		if result.failureReason != "" {
+			nodeResp.Status = common.NodeStatusFailure
+			nodeResp.NextNodeID = ""
+			nodeResp.FailureReason = result.failureReason
+			return nodeResp, nil
+		}
+	}
+	for _, result := range results {
+		if result.exitNodeID != "" {
+			logger.Debug("Parallel branch left the fork", log.String("nextNodeID", result.exitNodeID))
+			nodeResp.Status = common.NodeStatusForward
+			nodeResp.NextNodeID = result.exitNodeID
+			break
+		}
+	}
+
+	return nodeResp, nil
+}
+
+// executeBranch executes the task execution nodes of a parallel branch until the join node is reached.
+// Each branch works on its own copy of the user inputs, runtime data, authenticated user attributes and
+// execution history so that branches do not observe or race on each other's changes.
+func (fe *flowEngine) executeBranch(ctx *EngineContext, forkNodeCtx *core.NodeContext, startNodeID string,
+	joinNodeID string, executorMu *sync.Mutex, logger *log.Logger) branchResult {
+	result := branchResult{}
+	userInputs := make(map[string]string, len(forkNodeCtx.UserInputs))
+	maps.Copy(userInputs, forkNodeCtx.UserInputs)
+	runtimeData := make(map[string]string, len(forkNodeCtx.RuntimeData))
+	maps.Copy(runtimeData, forkNodeCtx.RuntimeData)
+	authenticatedUser := forkNodeCtx.AuthenticatedUser
+	authenticatedUser.Attributes = sysutils.DeepCopyMap(forkNodeCtx.AuthenticatedUser.Attributes)
+	executionHistory := copyExecutionHistory(forkNodeCtx.ExecutionHistory)
+	visited := make(map[string]bool)
+
+	nodeID := startNodeID
+	for nodeID != joinNodeID {
+		node, ok := ctx.Graph.GetNode(nodeID)
+		if !ok {
+			logger.Error("Parallel branch node not found in the flow graph", log.String("nodeID", nodeID))
+			result.svcErr = &serviceerror.InternalServerError
+			return result
+		}
+		if node.GetType() != common.NodeTypeTaskExecution {
+			result.exitNodeID = nodeID
+			return result
+		}
+		if visited[nodeID] {
+			logger.Error("Parallel branch does not reach the join node", log.String("nodeID", nodeID))
+			result.svcErr = &serviceerror.InternalServerError
+			return result
+		}
+		visited[nodeID] = true
+
+		nodeCtx := &core.NodeContext{
+			Context:           forkNodeCtx.Context,
+			ExecutionID:       forkNodeCtx.ExecutionID,
+			FlowType:          forkNodeCtx.FlowType,
+			EntityID:          forkNodeCtx.EntityID,
+			CurrentAction:     forkNodeCtx.CurrentAction,
+			Verbose:           forkNodeCtx.Verbose,
+			NodeInputs:        getNodeInputs(node),
+			UserInputs:        userInputs,
+			CurrentNodeID:     nodeID,
+			RuntimeData:       runtimeData,
+			ForwardedData:     make(map[string]interface{}),
+			Application:       forkNodeCtx.Application,
+			AuthenticatedUser: authenticatedUser,
+			AuthUser:          forkNodeCtx.AuthUser,
+			ExecutionHistory:  executionHistory,
+		}
+		if nodeCtx.NodeInputs == nil {
+			nodeCtx.NodeInputs = make([]common.Input, 0)
+		}
+
+		if !node.ShouldExecute(nodeCtx) {
+			condition := node.GetCondition()
+			if condition == nil || condition.OnSkip == "" {
+				logger.Error("Node has condition but onSkip is not specified", log.String("nodeID", nodeID))
+				result.svcErr = &serviceerror.InternalServerError
+				return result
+			}
+			nodeID = condition.OnSkip
+			continue
+		}
+
+		executorMu.Lock()
+		svcErr := fe.setNodeExecutor(node, logger)
+		executorMu.Unlock()
+		if svcErr != nil {
+			result.svcErr = svcErr
+			return result
+		}
+
+		startTime := time.Now().UnixMilli()
+		nodeResp, nodeErr := node.Execute(nodeCtx)
+		result.executions = append(result.executions, branchNodeExecution{
+			node:      node,
+			nodeResp:  nodeResp,
+			nodeErr:   nodeErr,
+			startTime: startTime,
+			endTime:   time.Now().UnixMilli(),
+		})
+		if nodeErr != nil {
+			result.svcErr = nodeErr
+			return result
+		}
+
+		switch nodeResp.Status {
+		case common.NodeStatusComplete, common.NodeStatusForward:
+			if nodeResp.NextNodeID == "" {
+				logger.Error("Parallel branch does not reach the join node", log.String("nodeID", nodeID))
+				result.svcErr = &serviceerror.InternalServerError
+				return result
+			}
+			maps.Copy(runtimeData, nodeResp.RuntimeData)
+			nodeID = nodeResp.NextNodeID
+		case common.NodeStatusFailure:
+			result.failureReason = nodeResp.FailureReason
+			return result
+		default:
+			logger.Error("Nodes in parallel branches cannot request user interaction",
+				log.String("nodeID", nodeID), log.String("status", string(nodeResp.Status)))
+			result.svcErr = &serviceerror.InternalServerError
+			return result
+		}
+	}
+
+	return result
+}
+
+// copyExecutionHistory returns a copy of the execution history with copies of its records.
+func copyExecutionHistory(history map[string]*common.NodeExecutionRecord) map[string]*common.NodeExecutionRecord {
+	copied := make(map[string]*common.NodeExecutionRecord, len(history))
+	for nodeID, record := range history {
+		if record == nil {
+			continue
+		}
+		recordCopy := *record
+		recordCopy.Executions = slices.Clone(record.Executions)
+		copied[nodeID] = &recordCopy
+	}
+	return copied
+}
+
 // isDisplayOnlyPromptNode checks if the current node is a display-only prompt node.
 func (fe *flowEngine) isDisplayOnlyPromptNode(node core.NodeInterface) bool {
 	promptNode, ok := node.(core.PromptNodeInterface)
@@ -1149,3 +1396,51 @@ func publishFlowFailedEvent(ctx *EngineContext, svcErr *serviceerror.ServiceErro
 
 	obsSvc.PublishEvent(evt)
 }
+
+// getDisallowedIDPActions returns the refs of the prompt actions on the given node that lead to identity
+// provider executors not enabled in the application's login experience configuration.
+func getDisallowedIDPActions(ctx *EngineContext, node core.NodeInterface) []string {
+	loginExperience := ctx.Application.LoginExperience
+	if loginExperience == nil || len(loginExperience.AllowedIDPs) == 0 || ctx.Graph == nil {
+		return nil
+	}
+	promptNode, ok := node.(core.PromptNodeInterface)
+	if !ok {
+		return nil
+	}
+
+	var disallowed []string
+	for _, prompt := range promptNode.GetPrompts() {
+		if prompt.Action == nil || prompt.Action.NextNode == "" {
+			continue
+		}
+		nextNode, exists := ctx.Graph.GetNode(prompt.Action.NextNode)
+		if !exists || nextNode == nil {
+			continue
+		}
+		idpID, _ := nextNode.GetProperties()["idpId"].(string)
+		if idpID != "" && !slices.Contains(loginExperience.AllowedIDPs, idpID) {
+			disallowed = append(disallowed, prompt.Action.Ref)
+		}
+	}
+	return disallowed
+}
+
+// resolvePostLoginRedirect returns the redirect URI of the first post-login redirect rule in the
+// application's login experience configuration that matches the authenticated user.
+func resolvePostLoginRedirect(ctx *EngineContext) string {
+	loginExperience := ctx.Application.LoginExperience
+	if loginExperience == nil || !ctx.AuthenticatedUser.IsAuthenticated {
+		return ""
+	}
+	for _, rule := range loginExperience.PostLoginRedirects {
+		if rule.UserType != "" && rule.UserType != ctx.AuthenticatedUser.UserType {
+			continue
+		}
+		if rule.OUID != "" && rule.OUID != ctx.AuthenticatedUser.OUID {
+			continue
+		}
+		return rule.RedirectURI
+	}
+	return ""
+}
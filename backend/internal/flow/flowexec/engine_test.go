@@ -21,12 +21,17 @@ package flowexec
 import (
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	appmodel "github.com/thunder-id/thunderid/internal/application/model"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
@@ -1965,3 +1970,331 @@ func (s *EngineTestSuite) TestHandleDisplayOnlyPromptResponse_ForwardToNextNode_
 	s.False(complete)
 	s.Equal("", ctx.CurrentSegmentID)
 }
+
+func (s *EngineTestSuite) TestGetDisallowedIDPActions() {
+	t := s.T()
+	promptNode := coremock.NewPromptNodeInterfaceMock(t)
+	promptNode.On("GetPrompts").Return([]common.Prompt{
+		{Action: &common.Action{Ref: "basic", NextNode: "basic_auth"}},
+		{Action: &common.Action{Ref: "google", NextNode: "google_auth"}},
+		{Action: &common.Action{Ref: "github", NextNode: "github_auth"}},
+		{Inputs: []common.Input{{Identifier: "username"}}},
+	})
+	basicNode := coremock.NewNodeInterfaceMock(t)
+	basicNode.On("GetProperties").Return(map[string]interface{}{})
+	googleNode := coremock.NewNodeInterfaceMock(t)
+	googleNode.On("GetProperties").Return(map[string]interface{}{"idpId": "google-idp"})
+	githubNode := coremock.NewNodeInterfaceMock(t)
+	githubNode.On("GetProperties").Return(map[string]interface{}{"idpId": "github-idp"})
+	graph := coremock.NewGraphInterfaceMock(t)
+	graph.On("GetNode", "basic_auth").Return(basicNode, true)
+	graph.On("GetNode", "google_auth").Return(googleNode, true)
+	graph.On("GetNode", "github_auth").Return(githubNode, true)
+
+	ctx := &EngineContext{
+		Graph: graph,
+		Application: appmodel.Application{
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{
+				LoginExperience: &inboundmodel.LoginExperienceConfig{AllowedIDPs: []string{"google-idp"}},
+			},
+		},
+	}
+
+	s.Equal([]string{"github"}, getDisallowedIDPActions(ctx, promptNode))
+}
+
+func (s *EngineTestSuite) TestGetDisallowedIDPActions_NoRestriction() {
+	promptNode := coremock.NewPromptNodeInterfaceMock(s.T())
+	graph := coremock.NewGraphInterfaceMock(s.T())
+
+	s.Nil(getDisallowedIDPActions(&EngineContext{Graph: graph}, promptNode))
+
+	ctx := &EngineContext{
+		Graph: graph,
+		Application: appmodel.Application{
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{
+				LoginExperience: &inboundmodel.LoginExperienceConfig{},
+			},
+		},
+	}
+	s.Nil(getDisallowedIDPActions(ctx, promptNode))
+}
+
+func (s *EngineTestSuite) TestGetDisallowedIDPActions_NonPromptNode() {
+	ctx := &EngineContext{
+		Graph: coremock.NewGraphInterfaceMock(s.T()),
+		Application: appmodel.Application{
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{
+				LoginExperience: &inboundmodel.LoginExperienceConfig{AllowedIDPs: []string{"google-idp"}},
+			},
+		},
+	}
+
+	s.Nil(getDisallowedIDPActions(ctx, coremock.NewNodeInterfaceMock(s.T())))
+}
+
+func (s *EngineTestSuite) TestResolvePostLoginRedirect() {
+	loginExperience := &inboundmodel.LoginExperienceConfig{
+		PostLoginRedirects: []inboundmodel.PostLoginRedirectRule{
+			{UserType: "employee", OUID: "ou-1", RedirectURI: "https://intranet.example.com"},
+			{UserType: "employee", RedirectURI: "https://staff.example.com"},
+			{RedirectURI: "https://www.example.com"},
+		},
+	}
+	newCtx := func(userType, ouID string) *EngineContext {
+		return &EngineContext{
+			Application: appmodel.Application{
+				InboundAuthProfile: inboundmodel.InboundAuthProfile{LoginExperience: loginExperience},
+			},
+			AuthenticatedUser: authncm.AuthenticatedUser{
+				IsAuthenticated: true,
+				UserType:        userType,
+				OUID:            ouID,
+			},
+		}
+	}
+
+	s.Equal("https://intranet.example.com", resolvePostLoginRedirect(newCtx("employee", "ou-1")))
+	s.Equal("https://staff.example.com", resolvePostLoginRedirect(newCtx("employee", "ou-2")))
+	s.Equal("https://www.example.com", resolvePostLoginRedirect(newCtx("customer", "ou-1")))
+
+	unauthenticated := newCtx("employee", "ou-1")
+	unauthenticated.AuthenticatedUser.IsAuthenticated = false
+	s.Empty(resolvePostLoginRedirect(unauthenticated))
+}
+
+func (s *EngineTestSuite) TestResolvePostLoginRedirect_NoRules() {
+	ctx := &EngineContext{
+		AuthenticatedUser: authncm.AuthenticatedUser{IsAuthenticated: true, UserType: "employee"},
+	}
+
+	s.Empty(resolvePostLoginRedirect(ctx))
+}
+
+func (s *EngineTestSuite) newForkBranchNode(id string,
+	nodeResp *common.NodeResponse) *coremock.ExecutorBackedNodeInterfaceMock {
+	mockExecutor := coremock.NewExecutorInterfaceMock(s.T())
+	mockExecutor.On("GetName").Return(id).Maybe()
+	mockExecutor.On("GetType").Return(common.ExecutorTypeUtility).Maybe()
+
+	mockNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+	mockNode.On("GetID").Return(id).Maybe()
+	mockNode.On("GetType").Return(common.NodeTypeTaskExecution).Maybe()
+	mockNode.On("GetInputs").Return([]common.Input{}).Maybe()
+	mockNode.On("GetExecutor").Return(mockExecutor).Maybe()
+	mockNode.On("GetMode").Return("").Maybe()
+	mockNode.On("ShouldExecute", mock.Anything).Return(true)
+	mockNode.On("Execute", mock.Anything).Return(nodeResp, nil)
+	return mockNode
+}
+
+func (s *EngineTestSuite) newForkNode(branches ...string) *coremock.ForkNodeInterfaceMock {
+	mockForkNode := coremock.NewForkNodeInterfaceMock(s.T())
+	mockForkNode.On("GetID").Return("fork").Maybe()
+	mockForkNode.On("GetBranches").Return(branches)
+	mockForkNode.On("GetOnSuccess").Return("join")
+	return mockForkNode
+}
+
+func (s *EngineTestSuite) newForkEngineContext(forkNode core.NodeInterface,
+	nodes ...core.NodeInterface) *EngineContext {
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	for _, node := range nodes {
+		mockGraph.On("GetNode", node.GetID()).Return(node, true).Maybe()
+	}
+
+	return &EngineContext{
+		ExecutionID:      "test-flow",
+		FlowType:         common.FlowTypeRegistration,
+		Graph:            mockGraph,
+		CurrentNode:      forkNode,
+		RuntimeData:      map[string]string{"existing": "value"},
+		ExecutionHistory: map[string]*common.NodeExecutionRecord{},
+	}
+}
+
+func (s *EngineTestSuite) newForkFlowEngine() *flowEngine {
+	mockObservability := observabilitymock.NewObservabilityServiceInterfaceMock(s.T())
+	mockObservability.On("IsEnabled").Return(false).Maybe()
+
+	return &flowEngine{
+		observabilitySvc: mockObservability,
+		logger:           log.GetLogger(),
+	}
+}
+
+func (s *EngineTestSuite) TestExecuteForkNode_MergesBranchResults() {
+	riskNode := s.newForkBranchNode("risk-score", &common.NodeResponse{
+		Status:      common.NodeStatusComplete,
+		NextNodeID:  "risk-check",
+		RuntimeData: map[string]string{"riskScore": "20"},
+	})
+	riskCheckNode := s.newForkBranchNode("risk-check", &common.NodeResponse{
+		Status:      common.NodeStatusComplete,
+		NextNodeID:  "join",
+		RuntimeData: map[string]string{"riskLevel": "low"},
+	})
+	otpNode := s.newForkBranchNode("send-otp", &common.NodeResponse{
+		Status:      common.NodeStatusComplete,
+		NextNodeID:  "join",
+		RuntimeData: map[string]string{"otpSessionToken": "token"},
+	})
+	forkNode := s.newForkNode("risk-score", "send-otp")
+	ctx := s.newForkEngineContext(forkNode, riskNode, riskCheckNode, otpNode)
+	fe := s.newForkFlowEngine()
+
+	nodeResp, svcErr := fe.executeForkNode(ctx, &core.NodeContext{
+		ExecutionID: ctx.ExecutionID,
+		FlowType:    ctx.FlowType,
+		UserInputs:  map[string]string{},
+		RuntimeData: ctx.RuntimeData,
+	}, forkNode, fe.logger)
+
+	s.Nil(svcErr)
+	s.Equal(common.NodeStatusComplete, nodeResp.Status)
+	s.Equal("join", nodeResp.NextNodeID)
+	s.Equal(forkNode, ctx.CurrentNode)
+	s.Equal("value", ctx.RuntimeData["existing"])
+	s.Equal("20", ctx.RuntimeData["riskScore"])
+	s.Equal("low", ctx.RuntimeData["riskLevel"])
+	s.Equal("token", ctx.RuntimeData["otpSessionToken"])
+	s.Len(ctx.ExecutionHistory, 3)
+	s.Equal(common.FlowStatusComplete, ctx.ExecutionHistory["risk-check"].Status)
+}
+
+func (s *EngineTestSuite) TestExecuteForkNode_BranchFailure() {
+	riskNode := s.newForkBranchNode("risk-score", &common.NodeResponse{
+		Status:     common.NodeStatusComplete,
+		NextNodeID: "join",
+	})
+	otpNode := s.newForkBranchNode("send-otp", &common.NodeResponse{
+		Status:        common.NodeStatusFailure,
+		FailureReason: "Failed to send OTP",
+	})
+	forkNode := s.newForkNode("risk-score", "send-otp")
+	ctx := s.newForkEngineContext(forkNode, riskNode, otpNode)
+	fe := s.newForkFlowEngine()
+
+	nodeResp, svcErr := fe.executeForkNode(ctx, &core.NodeContext{ExecutionID: ctx.ExecutionID}, forkNode,
+		fe.logger)
+
+	s.Nil(svcErr)
+	s.Equal(common.NodeStatusFailure, nodeResp.Status)
+	s.Equal("Failed to send OTP", nodeResp.FailureReason)
+	s.Empty(nodeResp.NextNodeID)
+	s.Len(ctx.ExecutionHistory, 2)
+}
+
+func (s *EngineTestSuite) TestExecuteForkNode_BranchForwardsToFailurePrompt() {
+	riskNode := s.newForkBranchNode("risk-score", &common.NodeResponse{
+		Status:     common.NodeStatusComplete,
+		NextNodeID: "join",
+	})
+	otpNode := s.newForkBranchNode("send-otp", &common.NodeResponse{
+		Status:      common.NodeStatusForward,
+		NextNodeID:  "error-prompt",
+		RuntimeData: map[string]string{"failureReason": "Failed to send OTP"},
+	})
+	promptNode := coremock.NewNodeInterfaceMock(s.T())
+	promptNode.On("GetID").Return("error-prompt")
+	promptNode.On("GetType").Return(common.NodeTypePrompt)
+	forkNode := s.newForkNode("risk-score", "send-otp")
+	ctx := s.newForkEngineContext(forkNode, riskNode, otpNode, promptNode)
+	fe := s.newForkFlowEngine()
+
+	nodeResp, svcErr := fe.executeForkNode(ctx, &core.NodeContext{ExecutionID: ctx.ExecutionID}, forkNode,
+		fe.logger)
+
+	s.Nil(svcErr)
+	s.Equal(common.NodeStatusForward, nodeResp.Status)
+	s.Equal("error-prompt", nodeResp.NextNodeID)
+	s.Equal("Failed to send OTP", ctx.RuntimeData["failureReason"])
+}
+
+func (s *EngineTestSuite) TestExecuteForkNode_BranchRequiresUserInput() {
+	riskNode := s.newForkBranchNode("risk-score", &common.NodeResponse{
+		Status:     common.NodeStatusComplete,
+		NextNodeID: "join",
+	})
+	otpNode := s.newForkBranchNode("send-otp", &common.NodeResponse{
+		Status: common.NodeStatusIncomplete,
+		Type:   common.NodeResponseTypeView,
+	})
+	forkNode := s.newForkNode("risk-score", "send-otp")
+	ctx := s.newForkEngineContext(forkNode, riskNode, otpNode)
+	fe := s.newForkFlowEngine()
+
+	nodeResp, svcErr := fe.executeForkNode(ctx, &core.NodeContext{ExecutionID: ctx.ExecutionID}, forkNode,
+		fe.logger)
+
+	s.Nil(nodeResp)
+	s.NotNil(svcErr)
+}
+
+// attributeWritingExecutor is an executor that updates the authenticated user attributes and the execution
+// history of the node context, as executors of parallel branches can do.
+type attributeWritingExecutor struct {
+	core.ExecutorInterface
+}
+
+func (e *attributeWritingExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	for i := range 100 {
+		ctx.AuthenticatedUser.Attributes[e.GetName()] = i
+		ctx.ExecutionHistory[e.GetName()] = &common.NodeExecutionRecord{NodeID: ctx.CurrentNodeID, Step: i}
+		ctx.ExecutionHistory["previous"].Executions = append(ctx.ExecutionHistory["previous"].Executions,
+			common.ExecutionAttempt{Attempt: i})
+	}
+
+	return &common.ExecutorResponse{
+		Status:      common.ExecComplete,
+		RuntimeData: map[string]string{e.GetName(): "done"},
+	}, nil
+}
+
+func (s *EngineTestSuite) TestExecuteForkNode_BranchesDoNotShareContextState() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("/tmp/test", &config.Config{})
+	defer config.ResetServerRuntime()
+
+	flowFactory, _ := core.Initialize(cache.Initialize())
+	branchNodes := make([]core.NodeInterface, 0, 2)
+	for _, id := range []string{"risk-score", "send-otp"} {
+		node, err := flowFactory.CreateNode(id, string(common.NodeTypeTaskExecution), nil, false, false)
+		s.Require().NoError(err)
+		executableNode, ok := node.(core.ExecutorBackedNodeInterface)
+		s.Require().True(ok)
+		executableNode.SetOnSuccess("join")
+		executableNode.SetExecutor(&attributeWritingExecutor{
+			ExecutorInterface: flowFactory.CreateExecutor(id, common.ExecutorTypeUtility, nil, nil),
+		})
+		branchNodes = append(branchNodes, node)
+	}
+	forkNode := s.newForkNode("risk-score", "send-otp")
+	ctx := s.newForkEngineContext(forkNode, branchNodes...)
+	ctx.AuthenticatedUser = authncm.AuthenticatedUser{
+		Attributes: map[string]interface{}{"email": "alice@example.com"},
+	}
+	ctx.ExecutionHistory["previous"] = &common.NodeExecutionRecord{
+		NodeID:     "previous",
+		Executions: []common.ExecutionAttempt{{Attempt: 1}},
+	}
+	fe := s.newForkFlowEngine()
+
+	nodeResp, svcErr := fe.executeForkNode(ctx, &core.NodeContext{
+		ExecutionID:       ctx.ExecutionID,
+		FlowType:          ctx.FlowType,
+		UserInputs:        map[string]string{},
+		RuntimeData:       ctx.RuntimeData,
+		AuthenticatedUser: ctx.AuthenticatedUser,
+		ExecutionHistory:  ctx.ExecutionHistory,
+	}, forkNode, fe.logger)
+
+	s.Nil(svcErr)
+	s.Equal(common.NodeStatusComplete, nodeResp.Status)
+	s.Equal("join", nodeResp.NextNodeID)
+	s.Equal("done", ctx.RuntimeData["risk-score"])
+	s.Equal("done", ctx.RuntimeData["send-otp"])
+	s.Equal(map[string]interface{}{"email": "alice@example.com"}, ctx.AuthenticatedUser.Attributes)
+	s.Len(ctx.ExecutionHistory["previous"].Executions, 1)
+	s.Len(ctx.ExecutionHistory, 3)
+}
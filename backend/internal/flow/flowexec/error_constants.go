@@ -164,3 +164,60 @@ var ErrorInvalidChallengeToken = serviceerror.ServiceError{
 		DefaultValue: "The challenge token is missing or invalid",
 	},
 }
+
+// ErrorSandboxFlowNotSpecified defines the error response for sandbox executions without a flow.
+var ErrorSandboxFlowNotSpecified = serviceerror.ServiceError{
+	Code: "FES-1011",
+	Type: serviceerror.ClientErrorType,
+	Error: core.I18nMessage{
+		Key:          "error.flowexecservice.sandbox_flow_not_specified",
+		DefaultValue: "Flow not specified",
+	},
+	ErrorDescription: core.I18nMessage{
+		Key:          "error.flowexecservice.sandbox_flow_not_specified_description",
+		DefaultValue: "Either a flow ID or an inline flow definition must be provided",
+	},
+}
+
+// ErrorSandboxSessionLimitReached defines the error response when the sandbox session limit is reached.
+var ErrorSandboxSessionLimitReached = serviceerror.ServiceError{
+	Code: "FES-1012",
+	Type: serviceerror.ClientErrorType,
+	Error: core.I18nMessage{
+		Key:          "error.flowexecservice.sandbox_session_limit_reached",
+		DefaultValue: "Sandbox session limit reached",
+	},
+	ErrorDescription: core.I18nMessage{
+		Key:          "error.flowexecservice.sandbox_session_limit_reached_description",
+		DefaultValue: "The maximum number of concurrent sandbox executions has been reached",
+	},
+}
+
+// ErrorInvalidSandboxMockUser defines the error response for invalid sandbox mock users.
+var ErrorInvalidSandboxMockUser = serviceerror.ServiceError{
+	Code: "FES-1013",
+	Type: serviceerror.ClientErrorType,
+	Error: core.I18nMessage{
+		Key:          "error.flowexecservice.invalid_sandbox_mock_user",
+		DefaultValue: "Invalid mock user",
+	},
+	ErrorDescription: core.I18nMessage{
+		Key:          "error.flowexecservice.invalid_sandbox_mock_user_description",
+		DefaultValue: "A mock user is missing its type or organization unit, or its attributes are invalid",
+	},
+}
+
+// ErrorAuthenticationRequired defines the error response when a flow that requires an authenticated user
+// is started without one.
+var ErrorAuthenticationRequired = serviceerror.ServiceError{
+	Code: "FES-1014",
+	Type: serviceerror.ClientErrorType,
+	Error: core.I18nMessage{
+		Key:          "error.flowexecservice.authentication_required",
+		DefaultValue: "Authentication required",
+	},
+	ErrorDescription: core.I18nMessage{
+		Key:          "error.flowexecservice.authentication_required_description",
+		DefaultValue: "The flow can only be started with the access token of an authenticated user",
+	},
+}
@@ -37,6 +37,72 @@ func (_m *flowStoreInterfaceMock) EXPECT() *flowStoreInterfaceMock_Expecter {
 	return &flowStoreInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// ConsumeFlowContext provides a mock function for the type flowStoreInterfaceMock
+func (_mock *flowStoreInterfaceMock) ConsumeFlowContext(ctx context.Context, executionID string) (bool, error) {
+	ret := _mock.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeFlowContext")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, executionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, executionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, executionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// flowStoreInterfaceMock_ConsumeFlowContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeFlowContext'
+type flowStoreInterfaceMock_ConsumeFlowContext_Call struct {
+	*mock.Call
+}
+
+// ConsumeFlowContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - executionID string
+func (_e *flowStoreInterfaceMock_Expecter) ConsumeFlowContext(ctx interface{}, executionID interface{}) *flowStoreInterfaceMock_ConsumeFlowContext_Call {
+	return &flowStoreInterfaceMock_ConsumeFlowContext_Call{Call: _e.mock.On("ConsumeFlowContext", ctx, executionID)}
+}
+
+func (_c *flowStoreInterfaceMock_ConsumeFlowContext_Call) Run(run func(ctx context.Context, executionID string)) *flowStoreInterfaceMock_ConsumeFlowContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *flowStoreInterfaceMock_ConsumeFlowContext_Call) Return(b bool, err error) *flowStoreInterfaceMock_ConsumeFlowContext_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *flowStoreInterfaceMock_ConsumeFlowContext_Call) RunAndReturn(run func(ctx context.Context, executionID string) (bool, error)) *flowStoreInterfaceMock_ConsumeFlowContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteFlowContext provides a mock function for the type flowStoreInterfaceMock
 func (_mock *flowStoreInterfaceMock) DeleteFlowContext(ctx context.Context, executionID string) error {
 	ret := _mock.Called(ctx, executionID)
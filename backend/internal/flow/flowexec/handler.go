@@ -21,6 +21,8 @@ package flowexec
 import (
 	"net/http"
 
+	"github.com/thunder-id/thunderid/internal/flow/sandbox"
+
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -32,6 +34,17 @@ type flowExecutionHandler struct {
 	flowExecService FlowExecServiceInterface
 }
 
+// flowSandboxHandler handles sandbox flow execution requests.
+type flowSandboxHandler struct {
+	flowSandboxService FlowSandboxServiceInterface
+}
+
+func newFlowSandboxHandler(flowSandboxService FlowSandboxServiceInterface) *flowSandboxHandler {
+	return &flowSandboxHandler{
+		flowSandboxService: flowSandboxService,
+	}
+}
+
 func newFlowExecutionHandler(flowExecService FlowExecServiceInterface) *flowExecutionHandler {
 	return &flowExecutionHandler{
 		flowExecService: flowExecService,
@@ -82,6 +95,54 @@ func (h *flowExecutionHandler) HandleFlowExecutionRequest(w http.ResponseWriter,
 		log.String(log.LoggerKeyExecutionID, flowResp.ExecutionID))
 }
 
+// HandleFlowSandboxRequest handles the sandbox flow execution request.
+func (h *flowSandboxHandler) HandleFlowSandboxRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowSandboxHandler"))
+
+	sandboxR, err := sysutils.DecodeJSONBody[FlowSandboxRequest](r)
+	if err != nil {
+		sysutils.WriteErrorResponse(w, http.StatusBadRequest, APIErrorFlowRequestJSONDecodeError)
+		return
+	}
+
+	// Sanitize the input to prevent injection attacks
+	sandboxR.FlowID = sysutils.SanitizeString(sandboxR.FlowID)
+	sandboxR.ApplicationID = sysutils.SanitizeString(sandboxR.ApplicationID)
+	sandboxR.ExecutionID = sysutils.SanitizeString(sandboxR.ExecutionID)
+	sandboxR.Action = sysutils.SanitizeString(sandboxR.Action)
+	sandboxR.Inputs = sysutils.SanitizeStringMap(sandboxR.Inputs)
+	sandboxR.ChallengeToken = sysutils.SanitizeString(sandboxR.ChallengeToken)
+
+	sandboxStep, flowErr := h.flowSandboxService.Execute(r.Context(), sandboxR)
+	if flowErr != nil {
+		handleFlowError(w, flowErr)
+		return
+	}
+
+	notifications := sandboxStep.Notifications
+	if notifications == nil {
+		notifications = []sandbox.CapturedNotification{}
+	}
+
+	sandboxResp := FlowSandboxResponse{
+		FlowResponse: FlowResponse{
+			ExecutionID:    sandboxStep.ExecutionID,
+			StepID:         sandboxStep.StepID,
+			FlowStatus:     string(sandboxStep.Status),
+			Type:           string(sandboxStep.Type),
+			Data:           sandboxStep.Data,
+			FailureReason:  sandboxStep.FailureReason,
+			ChallengeToken: sandboxStep.ChallengeToken,
+		},
+		Notifications: notifications,
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, sandboxResp)
+
+	logger.Debug("Sandbox flow execution request handled successfully",
+		log.String(log.LoggerKeyExecutionID, sandboxResp.ExecutionID))
+}
+
 // handleFlowError handles errors that occur during flow execution as an API error response.
 func handleFlowError(w http.ResponseWriter, flowErr *serviceerror.ServiceError) {
 	errResp := apierror.ErrorResponse{
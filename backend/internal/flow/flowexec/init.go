@@ -23,7 +23,9 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/flowanalytics"
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/flow/sandbox"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
@@ -35,6 +37,7 @@ import (
 
 // Initialize creates and configures the flow execution service components.
 // The observabilitySvc parameter is optional (can be nil) - if nil, observability events won't be published.
+// The analyticsSvc parameter is optional (can be nil) - if nil, flow executions won't be recorded for analytics.
 func Initialize(
 	mux *http.ServeMux,
 	flowMgtService flowmgt.FlowMgtServiceInterface,
@@ -42,6 +45,7 @@ func Initialize(
 	entityProvider entityprovider.EntityProviderInterface,
 	executorRegistry executor.ExecutorRegistryInterface,
 	observabilitySvc observability.ObservabilityServiceInterface,
+	analyticsSvc flowanalytics.FlowAnalyticsServiceInterface,
 	cryptoSvc kmprovider.RuntimeCryptoProvider,
 ) (FlowExecServiceInterface, error) {
 	var flowStore flowStoreInterface
@@ -59,7 +63,7 @@ func Initialize(
 		}
 		flowStore = newFlowStore(dbProvider)
 	}
-	flowEngine := newFlowEngine(executorRegistry, observabilitySvc)
+	flowEngine := newFlowEngine(executorRegistry, observabilitySvc, analyticsSvc)
 	flowExecService := newFlowExecService(flowMgtService, flowStore, flowEngine,
 		inboundClientService, entityProvider, observabilitySvc, transactioner, cryptoSvc)
 
@@ -69,6 +73,30 @@ func Initialize(
 	return flowExecService, nil
 }
 
+// InitializeSandbox creates the sandbox flow execution service and registers its routes.
+// Returns nil if sandbox execution is disabled in the configuration.
+func InitializeSandbox(
+	mux *http.ServeMux,
+	flowMgtService flowmgt.FlowMgtServiceInterface,
+	runtimeFactory sandbox.RuntimeFactoryInterface,
+	inboundClientService inboundclient.InboundClientServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) FlowSandboxServiceInterface {
+	sandboxConfig := config.GetServerRuntime().Config.Flow.Sandbox
+	if !sandboxConfig.Enabled {
+		return nil
+	}
+
+	flowSandboxService := newFlowSandboxService(flowMgtService, runtimeFactory, inboundClientService,
+		entityProvider, observabilitySvc, sandboxConfig.SessionTimeout, sandboxConfig.MaxSessions)
+
+	handler := newFlowSandboxHandler(flowSandboxService)
+	registerSandboxRoutes(mux, handler)
+
+	return flowSandboxService
+}
+
 func registerRoutes(mux *http.ServeMux, handler *flowExecutionHandler) {
 	opts := middleware.CORSOptions{
 		AllowedMethods:   []string{"POST"},
@@ -83,3 +111,18 @@ func registerRoutes(mux *http.ServeMux, handler *flowExecutionHandler) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts))
 }
+
+func registerSandboxRoutes(mux *http.ServeMux, handler *flowSandboxHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /flow/sandbox/execute",
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(handler.HandleFlowSandboxRequest)).ServeHTTP, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flow/sandbox/execute",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
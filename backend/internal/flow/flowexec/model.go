@@ -31,6 +31,8 @@ import (
 	managerpkg "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/flow/sandbox"
 )
 
 // EngineContext holds the overall context used by the flow engine during execution.
@@ -108,11 +110,49 @@ type FlowRequest struct {
 	Inputs         map[string]string `json:"inputs"`
 }
 
+// FlowSandboxRequest represents the sandbox flow execution API request body.
+// A new sandbox execution runs either the flow identified by FlowID, optionally pinned to a
+// version, or the inline Flow definition. Subsequent steps only carry the execution ID.
+type FlowSandboxRequest struct {
+	FlowID         string                         `json:"flowId,omitempty"`
+	Version        int                            `json:"version,omitempty"`
+	Flow           *flowmgt.FlowDefinitionRequest `json:"flow,omitempty"`
+	ApplicationID  string                         `json:"applicationId,omitempty"`
+	MockUsers      []SandboxMockUser              `json:"mockUsers,omitempty"`
+	Verbose        bool                           `json:"verbose,omitempty"`
+	ExecutionID    string                         `json:"executionId"`
+	ChallengeToken string                         `json:"challengeToken,omitempty"`
+	Action         string                         `json:"action"`
+	Inputs         map[string]string              `json:"inputs"`
+}
+
+// SandboxMockUser represents a user seeded into the in-memory user store of a sandbox execution.
+// Credentials such as passwords are given as attributes and hashed like real users.
+type SandboxMockUser struct {
+	Type       string          `json:"type"`
+	OUID       string          `json:"ouId"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+}
+
+// FlowSandboxStep represents the outcome of a sandbox flow execution step.
+type FlowSandboxStep struct {
+	FlowStep
+	Notifications []sandbox.CapturedNotification
+}
+
+// FlowSandboxResponse represents the sandbox flow execution API response body.
+type FlowSandboxResponse struct {
+	FlowResponse
+	Notifications []sandbox.CapturedNotification `json:"notifications"`
+}
+
 // FlowInitContext represents the context for initiating a new flow with runtime data
 type FlowInitContext struct {
 	ApplicationID string
 	FlowType      string
-	RuntimeData   map[string]string
+	// FlowID overrides the flow configured on the application for the flow type, when set.
+	FlowID      string
+	RuntimeData map[string]string
 }
 
 // FlowContextDB represents the database row for a flow context.
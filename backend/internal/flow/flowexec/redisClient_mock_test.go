@@ -486,6 +486,65 @@ func (_c *redisClientMock_Get_Call) RunAndReturn(run func(ctx context.Context, k
 	return _c
 }
 
+// GetDel provides a mock function for the type redisClientMock
+func (_mock *redisClientMock) GetDel(ctx context.Context, key string) *redis.StringCmd {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDel")
+	}
+
+	var r0 *redis.StringCmd
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *redis.StringCmd); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.StringCmd)
+		}
+	}
+	return r0
+}
+
+// redisClientMock_GetDel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDel'
+type redisClientMock_GetDel_Call struct {
+	*mock.Call
+}
+
+// GetDel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *redisClientMock_Expecter) GetDel(ctx interface{}, key interface{}) *redisClientMock_GetDel_Call {
+	return &redisClientMock_GetDel_Call{Call: _e.mock.On("GetDel", ctx, key)}
+}
+
+func (_c *redisClientMock_GetDel_Call) Run(run func(ctx context.Context, key string)) *redisClientMock_GetDel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *redisClientMock_GetDel_Call) Return(stringCmd *redis.StringCmd) *redisClientMock_GetDel_Call {
+	_c.Call.Return(stringCmd)
+	return _c
+}
+
+func (_c *redisClientMock_GetDel_Call) RunAndReturn(run func(ctx context.Context, key string) *redis.StringCmd) *redisClientMock_GetDel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ScriptExists provides a mock function for the type redisClientMock
 func (_mock *redisClientMock) ScriptExists(ctx context.Context, hashes ...string) *redis.BoolSliceCmd {
 	// string
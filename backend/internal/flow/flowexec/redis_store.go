@@ -45,6 +45,7 @@ type redisClient interface {
 	redis.Scripter
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
 	Get(ctx context.Context, key string) *redis.StringCmd
+	GetDel(ctx context.Context, key string) *redis.StringCmd
 	Del(ctx context.Context, keys ...string) *redis.IntCmd
 }
 
@@ -132,3 +133,15 @@ func (s *redisFlowStore) DeleteFlowContext(ctx context.Context, executionID stri
 	}
 	return nil
 }
+
+// ConsumeFlowContext atomically claims and removes the flow context from Redis via GETDEL. It reports
+// whether this caller removed the context; when several callers race, only one of them observes true.
+func (s *redisFlowStore) ConsumeFlowContext(ctx context.Context, executionID string) (bool, error) {
+	if err := s.client.GetDel(ctx, s.flowKey(executionID)).Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to consume flow context from Redis: %w", err)
+	}
+	return true, nil
+}
@@ -249,3 +249,36 @@ func (suite *RedisFlowStoreTestSuite) TestDeleteFlowContext_DelError() {
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to delete flow context from Redis")
 }
+
+// Tests for ConsumeFlowContext
+
+func (suite *RedisFlowStoreTestSuite) TestConsumeFlowContext_Claimed() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal("{}")
+	suite.mockClient.On("GetDel", suite.ctx, suite.flowKey).Return(stringCmd)
+
+	consumed, err := suite.store.ConsumeFlowContext(suite.ctx, redisTestFlowID)
+	suite.NoError(err)
+	suite.True(consumed)
+}
+
+func (suite *RedisFlowStoreTestSuite) TestConsumeFlowContext_AlreadyConsumed() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(redis.Nil)
+	suite.mockClient.On("GetDel", suite.ctx, suite.flowKey).Return(stringCmd)
+
+	consumed, err := suite.store.ConsumeFlowContext(suite.ctx, redisTestFlowID)
+	suite.NoError(err)
+	suite.False(consumed)
+}
+
+func (suite *RedisFlowStoreTestSuite) TestConsumeFlowContext_GetDelError() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("GetDel", suite.ctx, suite.flowKey).Return(stringCmd)
+
+	consumed, err := suite.store.ConsumeFlowContext(suite.ctx, redisTestFlowID)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to consume flow context from Redis")
+	suite.False(consumed)
+}
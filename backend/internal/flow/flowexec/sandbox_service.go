@@ -0,0 +1,327 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/flow/sandbox"
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	defaultSandboxSessionTimeout int64 = 1800 // 30 minutes in seconds
+	defaultSandboxMaxSessions          = 100
+)
+
+// FlowSandboxServiceInterface defines the interface for executing flows in sandbox mode.
+type FlowSandboxServiceInterface interface {
+	Execute(ctx context.Context, request *FlowSandboxRequest) (*FlowSandboxStep, *serviceerror.ServiceError)
+}
+
+// sandboxSession holds the state of an ongoing sandbox execution.
+type sandboxSession struct {
+	engineCtx *EngineContext
+	engine    flowEngineInterface
+	runtime   *sandbox.Runtime
+	expiry    time.Time
+}
+
+// flowSandboxService is the implementation of FlowSandboxServiceInterface. Sandbox executions are
+// kept in memory only and each of them runs against its own isolated sandbox runtime.
+type flowSandboxService struct {
+	flowMgtService   flowmgt.FlowMgtServiceInterface
+	runtimeFactory   sandbox.RuntimeFactoryInterface
+	appLoader        *flowExecService
+	observabilitySvc observability.ObservabilityServiceInterface
+	sessionTimeout   time.Duration
+	maxSessions      int
+
+	mu       sync.Mutex
+	sessions map[string]*sandboxSession
+}
+
+func newFlowSandboxService(flowMgtService flowmgt.FlowMgtServiceInterface,
+	runtimeFactory sandbox.RuntimeFactoryInterface,
+	inboundClientService inboundclient.InboundClientServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	sessionTimeout int64, maxSessions int) FlowSandboxServiceInterface {
+	if sessionTimeout <= 0 {
+		sessionTimeout = defaultSandboxSessionTimeout
+	}
+	if maxSessions <= 0 {
+		maxSessions = defaultSandboxMaxSessions
+	}
+
+	return &flowSandboxService{
+		flowMgtService: flowMgtService,
+		runtimeFactory: runtimeFactory,
+		appLoader: &flowExecService{
+			inboundClientService: inboundClientService,
+			entityProvider:       entityProvider,
+		},
+		observabilitySvc: observabilitySvc,
+		sessionTimeout:   time.Duration(sessionTimeout) * time.Second,
+		maxSessions:      maxSessions,
+		sessions:         make(map[string]*sandboxSession),
+	}
+}
+
+// Execute executes a step of a sandbox flow execution. The assertion of a completed sandbox
+// execution is never returned, and the notifications captured during the step are returned instead
+// of being delivered.
+func (s *flowSandboxService) Execute(ctx context.Context, request *FlowSandboxRequest) (
+	*FlowSandboxStep, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowSandboxService"))
+
+	if request == nil {
+		return nil, &ErrorSandboxFlowNotSpecified
+	}
+
+	var session *sandboxSession
+	var svcErr *serviceerror.ServiceError
+	newFlow := isNewFlow(request.ExecutionID)
+	if newFlow {
+		session, svcErr = s.newSession(ctx, request, logger)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+	} else {
+		session = s.claimSession(request.ExecutionID)
+		if session == nil {
+			return nil, &ErrorInvalidExecutionID
+		}
+		session.engineCtx.Context = ctx
+		session.engineCtx.ChallengeTokenIn = request.ChallengeToken
+	}
+
+	prepareContext(session.engineCtx, request.Action, request.Inputs)
+	session.engineCtx.TraceID = sysContext.GetTraceID(ctx)
+
+	flowStep, flowErr := session.engine.Execute(session.engineCtx)
+	notifications := session.runtime.Recorder.Drain()
+	if flowErr != nil {
+		if !newFlow && flowErr.Code == ErrorInvalidChallengeToken.Code {
+			s.releaseSession(session)
+		}
+		return nil, flowErr
+	}
+
+	flowStep.Assertion = ""
+	if !isComplete(flowStep) {
+		s.releaseSession(session)
+	}
+
+	logger.Debug("Sandbox flow step executed", log.String(log.LoggerKeyExecutionID, flowStep.ExecutionID),
+		log.String("status", string(flowStep.Status)))
+
+	return &FlowSandboxStep{
+		FlowStep:      flowStep,
+		Notifications: notifications,
+	}, nil
+}
+
+// newSession creates a new sandbox session for the flow given in the request.
+func (s *flowSandboxService) newSession(ctx context.Context, request *FlowSandboxRequest,
+	logger *log.Logger) (*sandboxSession, *serviceerror.ServiceError) {
+	s.mu.Lock()
+	s.removeExpiredSessions()
+	limitReached := len(s.sessions) >= s.maxSessions
+	s.mu.Unlock()
+	if limitReached {
+		return nil, &ErrorSandboxSessionLimitReached
+	}
+
+	graph, svcErr := s.buildGraph(ctx, request, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	runtime := s.runtimeFactory.NewRuntime()
+	if svcErr := seedMockUsers(ctx, runtime.EntityService, request.MockUsers, logger); svcErr != nil {
+		return nil, svcErr
+	}
+
+	executionID, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		logger.Error("Failed to generate UUID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	engineCtx := &EngineContext{
+		ExecutionID: executionID,
+		FlowType:    graph.GetType(),
+		Graph:       graph,
+		Context:     ctx,
+		AppID:       request.ApplicationID,
+		Verbose:     request.Verbose,
+	}
+	if request.ApplicationID != "" && engineCtx.FlowType != common.FlowTypeUserOnboarding {
+		app, svcErr := s.appLoader.buildFlowApplication(ctx, request.ApplicationID, logger)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		engineCtx.Application = *app
+	}
+
+	// Sandbox executions are not recorded for flow analytics.
+	return &sandboxSession{
+		engineCtx: engineCtx,
+		engine:    newFlowEngine(runtime.ExecutorRegistry, s.observabilitySvc, nil),
+		runtime:   runtime,
+	}, nil
+}
+
+// buildGraph builds a fresh graph for the flow given in the request. Inline flow definitions take
+// precedence over the flow ID. Graphs built for sandbox executions are never cached.
+func (s *flowSandboxService) buildGraph(ctx context.Context, request *FlowSandboxRequest,
+	logger *log.Logger) (core.GraphInterface, *serviceerror.ServiceError) {
+	var flowDef *flowmgt.FlowDefinition
+	switch {
+	case request.Flow != nil:
+		flowDef = &flowmgt.FlowDefinition{
+			Handle:   request.Flow.Handle,
+			Name:     request.Flow.Name,
+			FlowType: request.Flow.FlowType,
+			Nodes:    request.Flow.Nodes,
+		}
+	case request.FlowID == "":
+		return nil, &ErrorSandboxFlowNotSpecified
+	case request.Version != 0:
+		version, svcErr := s.flowMgtService.GetFlowVersion(ctx, request.FlowID, request.Version)
+		if svcErr != nil {
+			return nil, handleFlowMgtError(svcErr, request.FlowID, logger)
+		}
+		flowDef = &flowmgt.FlowDefinition{
+			ID:       version.ID,
+			Handle:   version.Handle,
+			Name:     version.Name,
+			FlowType: common.FlowType(version.FlowType),
+			Nodes:    version.Nodes,
+		}
+	default:
+		flow, svcErr := s.flowMgtService.GetFlow(ctx, request.FlowID)
+		if svcErr != nil {
+			return nil, handleFlowMgtError(svcErr, request.FlowID, logger)
+		}
+		flowDef = &flowmgt.FlowDefinition{
+			ID:       flow.ID,
+			Handle:   flow.Handle,
+			Name:     flow.Name,
+			FlowType: flow.FlowType,
+			Nodes:    flow.Nodes,
+		}
+	}
+
+	graph, svcErr := s.flowMgtService.BuildDraftGraph(ctx, flowDef)
+	if svcErr != nil {
+		return nil, handleFlowMgtError(svcErr, request.FlowID, logger)
+	}
+	return graph, nil
+}
+
+// claimSession removes the session from the session store and returns it, so that a session is
+// never executed concurrently. Returns nil if the session does not exist or has expired.
+func (s *flowSandboxService) claimSession(executionID string) *sandboxSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[executionID]
+	if !ok {
+		return nil
+	}
+	delete(s.sessions, executionID)
+	if time.Now().After(session.expiry) {
+		return nil
+	}
+	return session
+}
+
+// releaseSession stores the session so that the sandbox execution can be continued.
+func (s *flowSandboxService) releaseSession(session *sandboxSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session.expiry = time.Now().Add(s.sessionTimeout)
+	s.sessions[session.engineCtx.ExecutionID] = session
+}
+
+// removeExpiredSessions removes the expired sessions. The caller must hold the lock.
+func (s *flowSandboxService) removeExpiredSessions() {
+	now := time.Now()
+	for id, session := range s.sessions {
+		if now.After(session.expiry) {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+// seedMockUsers creates the given mock users in the in-memory user store of a sandbox runtime.
+func seedMockUsers(ctx context.Context, entitySvc entity.EntityServiceInterface,
+	mockUsers []SandboxMockUser, logger *log.Logger) *serviceerror.ServiceError {
+	for _, mockUser := range mockUsers {
+		if mockUser.Type == "" || mockUser.OUID == "" {
+			return &ErrorInvalidSandboxMockUser
+		}
+
+		_, err := entitySvc.CreateEntity(ctx, &entity.Entity{
+			Category:   entity.EntityCategoryUser,
+			Type:       mockUser.Type,
+			State:      entity.EntityStateActive,
+			OUID:       mockUser.OUID,
+			Attributes: mockUser.Attributes,
+		}, nil)
+		if err != nil {
+			if errors.Is(err, entity.ErrSchemaValidationFailed) || errors.Is(err, entity.ErrAttributeConflict) ||
+				errors.Is(err, entity.ErrInvalidCredential) {
+				logger.Debug("Invalid sandbox mock user", log.String("type", mockUser.Type), log.Error(err))
+				return &ErrorInvalidSandboxMockUser
+			}
+			logger.Error("Failed to create sandbox mock user", log.String("type", mockUser.Type), log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+	}
+	return nil
+}
+
+// handleFlowMgtError returns client errors from the flow management service as they are and
+// converts server errors to an internal server error.
+func handleFlowMgtError(svcErr *serviceerror.ServiceError, flowID string,
+	logger *log.Logger) *serviceerror.ServiceError {
+	if svcErr.Type == serviceerror.ClientErrorType {
+		return svcErr
+	}
+	logger.Error("Error retrieving flow for sandbox execution", log.String("flowID", flowID),
+		log.String("error", svcErr.Error.DefaultValue))
+	return &serviceerror.InternalServerError
+}
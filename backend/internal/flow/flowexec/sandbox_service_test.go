@@ -0,0 +1,345 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/flow/sandbox"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/executormock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/sandboxmock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+)
+
+type FlowSandboxServiceTestSuite struct {
+	suite.Suite
+	mockFlowMgtSvc     *flowmgtmock.FlowMgtServiceInterfaceMock
+	mockRuntimeFactory *sandboxmock.RuntimeFactoryInterfaceMock
+	mockInboundClient  *inboundclientmock.InboundClientServiceInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	testGraph          core.GraphInterface
+	service            *flowSandboxService
+}
+
+func TestFlowSandboxServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowSandboxServiceTestSuite))
+}
+
+func (suite *FlowSandboxServiceTestSuite) SetupTest() {
+	_ = config.InitializeServerRuntime("/tmp/test", &config.Config{})
+
+	flowFactory, _ := core.Initialize(cache.Initialize())
+	suite.testGraph = flowFactory.CreateGraph("sandbox-graph", common.FlowTypeAuthentication)
+
+	suite.mockFlowMgtSvc = flowmgtmock.NewFlowMgtServiceInterfaceMock(suite.T())
+	suite.mockRuntimeFactory = sandboxmock.NewRuntimeFactoryInterfaceMock(suite.T())
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.service = newFlowSandboxService(suite.mockFlowMgtSvc, suite.mockRuntimeFactory,
+		suite.mockInboundClient, suite.mockEntityProvider, nil, 60, 2).(*flowSandboxService)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *FlowSandboxServiceTestSuite) newRuntime() *sandbox.Runtime {
+	return &sandbox.Runtime{
+		ExecutorRegistry: executormock.NewExecutorRegistryInterfaceMock(suite.T()),
+		EntityService:    entitymock.NewEntityServiceInterfaceMock(suite.T()),
+		Recorder:         &sandbox.Recorder{},
+	}
+}
+
+func (suite *FlowSandboxServiceTestSuite) storeSession(executionID string,
+	engine flowEngineInterface) *sandboxSession {
+	session := &sandboxSession{
+		engineCtx: &EngineContext{ExecutionID: executionID},
+		engine:    engine,
+		runtime:   suite.newRuntime(),
+	}
+	suite.service.releaseSession(session)
+	return session
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestNewFlowSandboxService_Defaults() {
+	svc := newFlowSandboxService(suite.mockFlowMgtSvc, suite.mockRuntimeFactory,
+		suite.mockInboundClient, suite.mockEntityProvider, nil, 0, 0).(*flowSandboxService)
+
+	suite.Equal(time.Duration(defaultSandboxSessionTimeout)*time.Second, svc.sessionTimeout)
+	suite.Equal(defaultSandboxMaxSessions, svc.maxSessions)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestBuildGraph_InlineFlow() {
+	nodes := []flowmgt.NodeDefinition{{ID: "start", Type: "START"}}
+	suite.mockFlowMgtSvc.EXPECT().BuildDraftGraph(mock.Anything, &flowmgt.FlowDefinition{
+		Handle:   "draft",
+		Name:     "Draft",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes:    nodes,
+	}).Return(suite.testGraph, nil)
+
+	graph, svcErr := suite.service.buildGraph(context.Background(), &FlowSandboxRequest{
+		FlowID: "ignored",
+		Flow: &flowmgt.FlowDefinitionRequest{
+			Handle:   "draft",
+			Name:     "Draft",
+			FlowType: common.FlowTypeAuthentication,
+			Nodes:    nodes,
+		},
+	}, log.GetLogger())
+
+	suite.Nil(svcErr)
+	suite.Equal(suite.testGraph, graph)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestBuildGraph_SavedFlow() {
+	suite.mockFlowMgtSvc.EXPECT().GetFlow(mock.Anything, "flow-1").Return(&flowmgt.CompleteFlowDefinition{
+		ID:       "flow-1",
+		Handle:   "login",
+		Name:     "Login",
+		FlowType: common.FlowTypeAuthentication,
+	}, nil)
+	suite.mockFlowMgtSvc.EXPECT().BuildDraftGraph(mock.Anything, mock.MatchedBy(
+		func(def *flowmgt.FlowDefinition) bool {
+			return def.ID == "flow-1" && def.Handle == "login"
+		})).Return(suite.testGraph, nil)
+
+	graph, svcErr := suite.service.buildGraph(context.Background(),
+		&FlowSandboxRequest{FlowID: "flow-1"}, log.GetLogger())
+
+	suite.Nil(svcErr)
+	suite.Equal(suite.testGraph, graph)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestBuildGraph_FlowVersion() {
+	suite.mockFlowMgtSvc.EXPECT().GetFlowVersion(mock.Anything, "flow-1", 3).Return(&flowmgt.FlowVersion{
+		ID:       "flow-1",
+		Handle:   "login",
+		Name:     "Login",
+		FlowType: string(common.FlowTypeAuthentication),
+		Version:  3,
+	}, nil)
+	suite.mockFlowMgtSvc.EXPECT().BuildDraftGraph(mock.Anything, mock.MatchedBy(
+		func(def *flowmgt.FlowDefinition) bool {
+			return def.ID == "flow-1" && def.FlowType == common.FlowTypeAuthentication
+		})).Return(suite.testGraph, nil)
+
+	graph, svcErr := suite.service.buildGraph(context.Background(),
+		&FlowSandboxRequest{FlowID: "flow-1", Version: 3}, log.GetLogger())
+
+	suite.Nil(svcErr)
+	suite.Equal(suite.testGraph, graph)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestBuildGraph_FlowNotSpecified() {
+	_, svcErr := suite.service.buildGraph(context.Background(), &FlowSandboxRequest{}, log.GetLogger())
+
+	suite.Equal(ErrorSandboxFlowNotSpecified.Code, svcErr.Code)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestBuildGraph_FlowMgtErrors() {
+	suite.mockFlowMgtSvc.EXPECT().GetFlow(mock.Anything, "missing").Return(nil, &flowmgt.ErrorFlowNotFound)
+	suite.mockFlowMgtSvc.EXPECT().GetFlow(mock.Anything, "broken").Return(nil, &serviceerror.InternalServerError)
+
+	_, svcErr := suite.service.buildGraph(context.Background(),
+		&FlowSandboxRequest{FlowID: "missing"}, log.GetLogger())
+	suite.Equal(flowmgt.ErrorFlowNotFound.Code, svcErr.Code)
+
+	_, svcErr = suite.service.buildGraph(context.Background(),
+		&FlowSandboxRequest{FlowID: "broken"}, log.GetLogger())
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestNewSession_Success() {
+	runtime := suite.newRuntime()
+	mockEntitySvc := runtime.EntityService.(*entitymock.EntityServiceInterfaceMock)
+	suite.mockFlowMgtSvc.EXPECT().GetFlow(mock.Anything, "flow-1").
+		Return(&flowmgt.CompleteFlowDefinition{ID: "flow-1"}, nil)
+	suite.mockFlowMgtSvc.EXPECT().BuildDraftGraph(mock.Anything, mock.Anything).Return(suite.testGraph, nil)
+	suite.mockRuntimeFactory.EXPECT().NewRuntime().Return(runtime)
+	mockEntitySvc.EXPECT().CreateEntity(mock.Anything, mock.MatchedBy(func(e *entity.Entity) bool {
+		return e.Category == entity.EntityCategoryUser && e.State == entity.EntityStateActive &&
+			e.Type == "Customer" && e.OUID == "ou-1"
+	}), mock.Anything).Return(&entity.Entity{ID: "user-1"}, nil)
+	suite.mockInboundClient.EXPECT().GetInboundClientByEntityID(mock.Anything, "app-1").
+		Return(&inboundmodel.InboundClient{ID: "app-1"}, nil)
+	suite.mockEntityProvider.EXPECT().GetEntity("app-1").
+		Return(&entityprovider.Entity{ID: "app-1"}, (*entityprovider.EntityProviderError)(nil))
+
+	session, svcErr := suite.service.newSession(context.Background(), &FlowSandboxRequest{
+		FlowID:        "flow-1",
+		ApplicationID: "app-1",
+		Verbose:       true,
+		MockUsers: []SandboxMockUser{
+			{Type: "Customer", OUID: "ou-1", Attributes: []byte(`{"username":"alice","password":"secret"}`)},
+		},
+	}, log.GetLogger())
+
+	suite.Nil(svcErr)
+	suite.NotEmpty(session.engineCtx.ExecutionID)
+	suite.Equal(common.FlowTypeAuthentication, session.engineCtx.FlowType)
+	suite.Equal("app-1", session.engineCtx.Application.ID)
+	suite.True(session.engineCtx.Verbose)
+	suite.Equal(runtime, session.runtime)
+	suite.NotNil(session.engine)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestNewSession_InvalidMockUser() {
+	suite.mockFlowMgtSvc.EXPECT().GetFlow(mock.Anything, "flow-1").
+		Return(&flowmgt.CompleteFlowDefinition{ID: "flow-1"}, nil)
+	suite.mockFlowMgtSvc.EXPECT().BuildDraftGraph(mock.Anything, mock.Anything).Return(suite.testGraph, nil)
+	suite.mockRuntimeFactory.EXPECT().NewRuntime().Return(suite.newRuntime())
+
+	_, svcErr := suite.service.newSession(context.Background(), &FlowSandboxRequest{
+		FlowID:    "flow-1",
+		MockUsers: []SandboxMockUser{{Type: "Customer"}},
+	}, log.GetLogger())
+
+	suite.Equal(ErrorInvalidSandboxMockUser.Code, svcErr.Code)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestNewSession_SessionLimitReached() {
+	suite.storeSession("exec-1", nil)
+	suite.storeSession("exec-2", nil)
+
+	_, svcErr := suite.service.newSession(context.Background(),
+		&FlowSandboxRequest{FlowID: "flow-1"}, log.GetLogger())
+
+	suite.Equal(ErrorSandboxSessionLimitReached.Code, svcErr.Code)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestNewSession_ExpiredSessionsAreRemoved() {
+	suite.storeSession("exec-1", nil).expiry = time.Now().Add(-time.Minute)
+	suite.storeSession("exec-2", nil).expiry = time.Now().Add(-time.Minute)
+	suite.mockFlowMgtSvc.EXPECT().GetFlow(mock.Anything, "flow-1").Return(nil, &flowmgt.ErrorFlowNotFound)
+
+	_, svcErr := suite.service.newSession(context.Background(),
+		&FlowSandboxRequest{FlowID: "flow-1"}, log.GetLogger())
+
+	suite.Equal(flowmgt.ErrorFlowNotFound.Code, svcErr.Code)
+	suite.Empty(suite.service.sessions)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestSeedMockUsers_EntityErrors() {
+	mockEntitySvc := entitymock.NewEntityServiceInterfaceMock(suite.T())
+	mockEntitySvc.EXPECT().CreateEntity(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, entity.ErrSchemaValidationFailed).Once()
+	mockEntitySvc.EXPECT().CreateEntity(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("store failure")).Once()
+	mockUsers := []SandboxMockUser{{Type: "Customer", OUID: "ou-1"}}
+
+	svcErr := seedMockUsers(context.Background(), mockEntitySvc, mockUsers, log.GetLogger())
+	suite.Equal(ErrorInvalidSandboxMockUser.Code, svcErr.Code)
+
+	svcErr = seedMockUsers(context.Background(), mockEntitySvc, mockUsers, log.GetLogger())
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestExecute_NilRequest() {
+	_, svcErr := suite.service.Execute(context.Background(), nil)
+
+	suite.Equal(ErrorSandboxFlowNotSpecified.Code, svcErr.Code)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestExecute_UnknownExecution() {
+	_, svcErr := suite.service.Execute(context.Background(), &FlowSandboxRequest{ExecutionID: "unknown"})
+
+	suite.Equal(ErrorInvalidExecutionID.Code, svcErr.Code)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestExecute_ExpiredExecution() {
+	suite.storeSession("exec-1", nil).expiry = time.Now().Add(-time.Minute)
+
+	_, svcErr := suite.service.Execute(context.Background(), &FlowSandboxRequest{ExecutionID: "exec-1"})
+
+	suite.Equal(ErrorInvalidExecutionID.Code, svcErr.Code)
+	suite.Empty(suite.service.sessions)
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestExecute_IncompleteStepKeepsSession() {
+	mockEngine := newFlowEngineInterfaceMock(suite.T())
+	mockEngine.EXPECT().Execute(mock.MatchedBy(func(ctx *EngineContext) bool {
+		return ctx.ChallengeTokenIn == "token-1" && ctx.CurrentAction == "submit" &&
+			ctx.UserInputs["username"] == "alice"
+	})).Return(FlowStep{ExecutionID: "exec-1", Status: common.FlowStatusIncomplete}, nil)
+	suite.storeSession("exec-1", mockEngine)
+
+	step, svcErr := suite.service.Execute(context.Background(), &FlowSandboxRequest{
+		ExecutionID:    "exec-1",
+		ChallengeToken: "token-1",
+		Action:         "submit",
+		Inputs:         map[string]string{"username": "alice"},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal(common.FlowStatusIncomplete, step.Status)
+	suite.Contains(suite.service.sessions, "exec-1")
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestExecute_CompleteStepRemovesSessionAndAssertion() {
+	mockEngine := newFlowEngineInterfaceMock(suite.T())
+	mockEngine.EXPECT().Execute(mock.Anything).Return(FlowStep{
+		ExecutionID: "exec-1",
+		Status:      common.FlowStatusComplete,
+		Assertion:   "assertion",
+	}, nil)
+	suite.storeSession("exec-1", mockEngine)
+
+	step, svcErr := suite.service.Execute(context.Background(), &FlowSandboxRequest{ExecutionID: "exec-1"})
+
+	suite.Nil(svcErr)
+	suite.Equal(common.FlowStatusComplete, step.Status)
+	suite.Empty(step.Assertion)
+	suite.NotContains(suite.service.sessions, "exec-1")
+}
+
+func (suite *FlowSandboxServiceTestSuite) TestExecute_EngineErrors() {
+	mockEngine := newFlowEngineInterfaceMock(suite.T())
+	mockEngine.EXPECT().Execute(mock.Anything).Return(FlowStep{}, &ErrorInvalidChallengeToken).Once()
+	mockEngine.EXPECT().Execute(mock.Anything).Return(FlowStep{}, &serviceerror.InternalServerError).Once()
+	suite.storeSession("exec-1", mockEngine)
+
+	_, svcErr := suite.service.Execute(context.Background(), &FlowSandboxRequest{ExecutionID: "exec-1"})
+	suite.Equal(ErrorInvalidChallengeToken.Code, svcErr.Code)
+	suite.Contains(suite.service.sessions, "exec-1")
+
+	_, svcErr = suite.service.Execute(context.Background(), &FlowSandboxRequest{ExecutionID: "exec-1"})
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+	suite.NotContains(suite.service.sessions, "exec-1")
+}
@@ -26,6 +26,7 @@ import (
 	"fmt"
 
 	appmodel "github.com/thunder-id/thunderid/internal/application/model"
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
@@ -39,6 +40,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/observability"
 	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -56,6 +58,7 @@ const (
 	defaultRegistrationFlowExpiry   int64 = 3600  // 60 minutes in seconds
 	defaultUserOnboardingFlowExpiry int64 = 86400 // 24 hours in seconds
 	defaultRecoveryFlowExpiry       int64 = 1800  // 30 minutes in seconds
+	defaultMFAEnrollmentFlowExpiry  int64 = 1800  // 30 minutes in seconds
 )
 
 // flowExecService is the implementation of FlowExecServiceInterface
@@ -160,11 +163,19 @@ func (s *flowExecService) Execute(ctx context.Context,
 
 	if isComplete(flowStep) {
 		if !isNewFlow(executionID) {
-			if removeErr := s.removeContext(ctx, engineCtx.ExecutionID, logger); removeErr != nil {
+			consumed, consumeErr := s.consumeContext(ctx, engineCtx.ExecutionID, logger)
+			if consumeErr != nil {
 				logger.Error("Failed to remove flow context after completion",
-					log.String(log.LoggerKeyExecutionID, engineCtx.ExecutionID), log.Error(removeErr))
+					log.String(log.LoggerKeyExecutionID, engineCtx.ExecutionID), log.Error(consumeErr))
 				return nil, &serviceerror.InternalServerError
 			}
+			// A concurrent request with the same execution ID completed the flow first; discard this result
+			// so the flow state cannot be replayed.
+			if !consumed {
+				logger.Debug("Flow context already consumed by a concurrent request",
+					log.String(log.LoggerKeyExecutionID, engineCtx.ExecutionID))
+				return nil, &ErrorInvalidExecutionID
+			}
 		}
 	} else {
 		if isNewFlow(executionID) {
@@ -194,7 +205,7 @@ func (s *flowExecService) loadNewContext(ctx context.Context, appID, flowTypeStr
 		return nil, err
 	}
 
-	engineCtx, err := s.initContext(ctx, appID, flowType, verbose, logger)
+	engineCtx, err := s.initContext(ctx, appID, flowType, "", verbose, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -203,12 +214,15 @@ func (s *flowExecService) loadNewContext(ctx context.Context, appID, flowTypeStr
 	return engineCtx, nil
 }
 
-// initContext initializes a new flow context with the given details.
+// initContext initializes a new flow context with the given details. The flow configured on the application
+// for the flow type is used unless a graph ID is given.
 func (s *flowExecService) initContext(ctx context.Context, appID string, flowType common.FlowType,
-	verbose bool, logger *log.Logger) (*EngineContext, *serviceerror.ServiceError) {
-	graphID, svcErr := s.getFlowGraph(ctx, appID, flowType, logger)
-	if svcErr != nil {
-		return nil, svcErr
+	graphID string, verbose bool, logger *log.Logger) (*EngineContext, *serviceerror.ServiceError) {
+	if graphID == "" {
+		var svcErr *serviceerror.ServiceError
+		if graphID, svcErr = s.getFlowGraph(ctx, appID, flowType, logger); svcErr != nil {
+			return nil, svcErr
+		}
 	}
 
 	engineCtx := EngineContext{}
@@ -226,6 +240,12 @@ func (s *flowExecService) initContext(ctx context.Context, appID string, flowTyp
 		return nil, &serviceerror.InternalServerError
 	}
 
+	if graph.GetType() != flowType {
+		logger.Error("Flow graph type does not match the requested flow type",
+			log.String("graphID", graphID), log.String("flowType", string(flowType)))
+		return nil, &ErrorInvalidFlowInitContext
+	}
+
 	engineCtx.FlowType = graph.GetType()
 	engineCtx.Graph = graph
 	engineCtx.Context = ctx
@@ -237,6 +257,11 @@ func (s *flowExecService) initContext(ctx context.Context, appID string, flowTyp
 		return nil, err
 	}
 
+	// Set the signed-in user for flows that run on behalf of an authenticated user
+	if err := s.setAuthenticatedUserToContext(&engineCtx, logger); err != nil {
+		return nil, err
+	}
+
 	return &engineCtx, nil
 }
 
@@ -251,6 +276,8 @@ func (s *flowExecService) getFlowExpirySeconds(flowType common.FlowType) int64 {
 		return defaultUserOnboardingFlowExpiry
 	case common.FlowTypeRecovery:
 		return defaultRecoveryFlowExpiry
+	case common.FlowTypeMFAEnrollment:
+		return defaultMFAEnrollmentFlowExpiry
 	default:
 		// Fallback to auth flow expiry
 		return defaultAuthFlowExpiry
@@ -328,9 +355,54 @@ func (s *flowExecService) setApplicationToContext(engineCtx *EngineContext,
 	return nil
 }
 
+// setAuthenticatedUserToContext sets the user of the access token that started an MFA enrollment flow as the
+// authenticated user of the flow, so that the enrolled factors are registered for that user.
+func (s *flowExecService) setAuthenticatedUserToContext(engineCtx *EngineContext,
+	logger *log.Logger) *serviceerror.ServiceError {
+	if engineCtx.FlowType != common.FlowTypeMFAEnrollment {
+		return nil
+	}
+
+	userID := security.GetSubject(engineCtx.Context)
+	if userID == "" {
+		return &ErrorAuthenticationRequired
+	}
+
+	user, epErr := s.entityProvider.GetEntity(userID)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return &ErrorAuthenticationRequired
+		}
+		logger.Error("Failed to retrieve the authenticated user for flow context",
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Error(epErr))
+		return &serviceerror.InternalServerError
+	}
+	if user.Category != entityprovider.EntityCategoryUser {
+		return &ErrorAuthenticationRequired
+	}
+
+	attrs := make(map[string]interface{})
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &attrs); err != nil {
+			logger.Error("Failed to unmarshal the attributes of the authenticated user",
+				log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+	}
+
+	engineCtx.AuthenticatedUser = authncm.AuthenticatedUser{
+		IsAuthenticated: true,
+		UserID:          user.ID,
+		OUID:            user.OUID,
+		UserType:        user.Type,
+		Attributes:      attrs,
+	}
+	return nil
+}
+
 // buildFlowApplication assembles the minimal model.Application view that downstream executors
 // read from engineCtx.Application. Only fields actually consumed by executors are populated:
-// Name, AllowedUserTypes, Assertion, LoginConsent, Metadata, and InboundAuthConfig (ClientID).
+// Name, AllowedUserTypes, Assertion, LoginConsent, LoginExperience, Metadata, and InboundAuthConfig (ClientID).
 func (s *flowExecService) buildFlowApplication(
 	ctx context.Context, appID string, logger *log.Logger,
 ) (*appmodel.Application, *serviceerror.ServiceError) {
@@ -360,6 +432,7 @@ func (s *flowExecService) buildFlowApplication(
 			Assertion:        client.Assertion,
 			LoginConsent:     client.LoginConsent,
 			AllowedUserTypes: client.AllowedUserTypes,
+			LoginExperience:  client.LoginExperience,
 		},
 	}
 
@@ -416,6 +489,29 @@ func (s *flowExecService) removeContext(ctx context.Context, executionID string,
 	return nil
 }
 
+// consumeContext atomically claims and removes the flow context from the store. It reports whether
+// this call removed the context, so that only one of several concurrent completions succeeds.
+func (s *flowExecService) consumeContext(ctx context.Context, executionID string,
+	logger *log.Logger) (bool, error) {
+	if executionID == "" {
+		return false, fmt.Errorf("flow ID cannot be empty")
+	}
+
+	var consumed bool
+	txErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		var err error
+		consumed, err = s.flowStore.ConsumeFlowContext(txCtx, executionID)
+		return err
+	})
+	if txErr != nil {
+		return false, fmt.Errorf("failed to consume flow context from database: %w", txErr)
+	}
+
+	logger.Debug("Flow context consumed from database", log.String(log.LoggerKeyExecutionID, executionID),
+		log.Bool("consumed", consumed))
+	return consumed, nil
+}
+
 // updateContext updates the flow context in the store based on the flow step status.
 func (s *flowExecService) updateContext(ctx context.Context, engineCtx *EngineContext,
 	flowStep *FlowStep, logger *log.Logger) error {
@@ -502,6 +598,11 @@ func (s *flowExecService) getFlowGraph(ctx context.Context, appID string, flowTy
 		return "", &ErrorInvalidAppID
 	}
 
+	// The MFA enrollment flow is shared by all applications
+	if flowType == common.FlowTypeMFAEnrollment {
+		return s.getSystemFlowGraph(ctx, flowType, logger)
+	}
+
 	client, err := s.inboundClientService.GetInboundClientByEntityID(ctx, appID)
 	if err != nil {
 		if errors.Is(err, inboundclient.ErrInboundClientNotFound) {
@@ -550,7 +651,7 @@ func (s *flowExecService) getFlowGraph(ctx context.Context, appID string, flowTy
 func validateFlowType(flowTypeStr string) (common.FlowType, *serviceerror.ServiceError) {
 	switch common.FlowType(flowTypeStr) {
 	case common.FlowTypeAuthentication, common.FlowTypeRegistration, common.FlowTypeUserOnboarding,
-		common.FlowTypeRecovery:
+		common.FlowTypeRecovery, common.FlowTypeMFAEnrollment:
 		return common.FlowType(flowTypeStr), nil
 	default:
 		return "", &ErrorInvalidFlowType
@@ -569,6 +670,8 @@ func (s *flowExecService) getSystemFlowGraph(ctx context.Context, flowType commo
 	switch flowType {
 	case common.FlowTypeUserOnboarding:
 		handle = config.GetServerRuntime().Config.Flow.UserOnboardingFlowHandle
+	case common.FlowTypeMFAEnrollment:
+		handle = config.GetServerRuntime().Config.Flow.MFAEnrollmentFlowHandle
 	default:
 		return "", &ErrorInvalidFlowType
 	}
@@ -630,7 +733,7 @@ func (s *flowExecService) InitiateFlow(ctx context.Context,
 
 	// Initialize the engine context
 	// This uses verbose true to ensure step layouts are returned during execution
-	engineCtx, err := s.initContext(ctx, initContext.ApplicationID, flowType, true, logger)
+	engineCtx, err := s.initContext(ctx, initContext.ApplicationID, flowType, initContext.FlowID, true, logger)
 	if err != nil {
 		logger.Error("Failed to initialize flow context",
 			log.String("appID", initContext.ApplicationID),
@@ -21,6 +21,8 @@ package flowexec
 import (
 	"context"
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -41,6 +43,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
@@ -280,6 +283,69 @@ func TestInitiateFlowSuccessScenarios(t *testing.T) {
 	}
 }
 
+func TestInitiateFlowWithFlowIDOverride(t *testing.T) {
+	appID := "test-app-123"
+
+	_ = config.InitializeServerRuntime("/tmp/test", &config.Config{})
+	flowFactory, _ := core.Initialize(cache.Initialize())
+
+	mockStore := newFlowStoreInterfaceMock(t)
+	mockInboundClient := inboundclientmock.NewInboundClientServiceInterfaceMock(t)
+	mockEntityProvider := entityprovidermock.NewEntityProviderInterfaceMock(t)
+	mockFlowMgtSvc := flowmgtmock.NewFlowMgtServiceInterfaceMock(t)
+	mockCrypto := cryptomock.NewRuntimeCryptoProviderMock(t)
+	mockCrypto.EXPECT().Encrypt(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]byte("encrypted-ctx"), nil, nil)
+
+	service := &flowExecService{
+		flowMgtService:       mockFlowMgtSvc,
+		flowStore:            mockStore,
+		inboundClientService: mockInboundClient,
+		entityProvider:       mockEntityProvider,
+		transactioner:        &stubTransactioner{},
+		cryptoSvc:            mockCrypto,
+	}
+
+	mfaGraph := flowFactory.CreateGraph("mfa-graph", common.FlowTypeAuthentication)
+	mockFlowMgtSvc.EXPECT().GetGraph(mock.Anything, "mfa-graph").Return(mfaGraph, nil)
+	mockInboundClient.EXPECT().GetInboundClientByEntityID(mock.Anything, appID).
+		Return(&inboundmodel.InboundClient{ID: appID, AuthFlowID: "auth-graph-1"}, nil).Once()
+	mockEntityProvider.EXPECT().GetEntity(appID).
+		Return(&entityprovider.Entity{ID: appID, Category: entityprovider.EntityCategoryApp},
+			(*entityprovider.EntityProviderError)(nil))
+	mockStore.EXPECT().StoreFlowContext(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	executionID, svcErr := service.InitiateFlow(context.Background(), &FlowInitContext{
+		ApplicationID: appID,
+		FlowType:      string(common.FlowTypeAuthentication),
+		FlowID:        "mfa-graph",
+	})
+
+	assert.Nil(t, svcErr)
+	assert.NotEmpty(t, executionID)
+}
+
+func TestInitiateFlowWithFlowIDOverrideTypeMismatch(t *testing.T) {
+	_ = config.InitializeServerRuntime("/tmp/test", &config.Config{})
+	flowFactory, _ := core.Initialize(cache.Initialize())
+
+	mockFlowMgtSvc := flowmgtmock.NewFlowMgtServiceInterfaceMock(t)
+	service := &flowExecService{flowMgtService: mockFlowMgtSvc}
+
+	registrationGraph := flowFactory.CreateGraph("reg-graph", common.FlowTypeRegistration)
+	mockFlowMgtSvc.EXPECT().GetGraph(mock.Anything, "reg-graph").Return(registrationGraph, nil)
+
+	executionID, svcErr := service.InitiateFlow(context.Background(), &FlowInitContext{
+		ApplicationID: "test-app-123",
+		FlowType:      string(common.FlowTypeAuthentication),
+		FlowID:        "reg-graph",
+	})
+
+	assert.Empty(t, executionID)
+	assert.NotNil(t, svcErr)
+	assert.Equal(t, ErrorInvalidFlowInitContext.Code, svcErr.Code)
+}
+
 func TestInitiateFlowErrorScenarios(t *testing.T) {
 	appID := "test-app-123"
 
@@ -440,6 +506,11 @@ func TestGetFlowExpirySeconds(t *testing.T) {
 			flowType: common.FlowTypeUserOnboarding,
 			expected: defaultUserOnboardingFlowExpiry,
 		},
+		{
+			name:     "MFA enrollment flow",
+			flowType: common.FlowTypeMFAEnrollment,
+			expected: defaultMFAEnrollmentFlowExpiry,
+		},
 		{
 			name:     "Unknown flow type (fallback)",
 			flowType: common.FlowType("UNKNOWN_FLOW"),
@@ -1109,6 +1180,134 @@ func TestExecute_EngineError_NonChallengeToken_RemovesContext(t *testing.T) {
 	assert.Nil(t, flowStep)
 }
 
+// newCompletingFlowExecService builds a service whose engine completes the stored flow
+// "existing-execution-id" on every execution.
+func newCompletingFlowExecService(t *testing.T, mockStore *flowStoreInterfaceMock) *flowExecService {
+	flowFactory, _ := core.Initialize(cache.Initialize())
+	testGraph := flowFactory.CreateGraph("test-graph-id", common.FlowTypeAuthentication)
+
+	engineCtx := EngineContext{
+		ExecutionID: "existing-execution-id",
+		AppID:       "test-app-id",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			Attributes: map[string]interface{}{},
+		},
+		UserInputs:       map[string]string{},
+		RuntimeData:      map[string]string{},
+		ExecutionHistory: map[string]*common.NodeExecutionRecord{},
+		Graph:            testGraph,
+	}
+	storedCtx, err := FromEngineContext(engineCtx)
+	assert.NoError(t, err)
+
+	mockFlowMgtSvc := flowmgtmock.NewFlowMgtServiceInterfaceMock(t)
+	mockEngine := newFlowEngineInterfaceMock(t)
+	mockInboundClient := inboundclientmock.NewInboundClientServiceInterfaceMock(t)
+	mockEntityProvider := entityprovidermock.NewEntityProviderInterfaceMock(t)
+
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "existing-execution-id").RunAndReturn(
+		func(_ context.Context, _ string) (*FlowContextDB, error) {
+			stored := *storedCtx
+			return &stored, nil
+		})
+	mockFlowMgtSvc.EXPECT().GetGraph(mock.Anything, "test-graph-id").Return(testGraph, nil)
+	mockInboundClient.EXPECT().GetInboundClientByEntityID(mock.Anything, "test-app-id").Return(
+		&inboundmodel.InboundClient{ID: "test-app-id", AuthFlowID: "test-graph-id"}, nil)
+	mockEntityProvider.EXPECT().GetEntity("test-app-id").Return(
+		&entityprovider.Entity{ID: "test-app-id", Category: entityprovider.EntityCategoryApp},
+		(*entityprovider.EntityProviderError)(nil))
+	mockEngine.EXPECT().Execute(mock.Anything).Return(
+		FlowStep{ExecutionID: "existing-execution-id", Status: common.FlowStatusComplete}, nil)
+
+	return &flowExecService{
+		flowStore:            mockStore,
+		flowMgtService:       mockFlowMgtSvc,
+		flowEngine:           mockEngine,
+		inboundClientService: mockInboundClient,
+		entityProvider:       mockEntityProvider,
+		transactioner:        &stubTransactioner{},
+	}
+}
+
+func TestExecute_ExistingFlowCompletion_ConsumesContext(t *testing.T) {
+	mockStore := newFlowStoreInterfaceMock(t)
+	mockStore.EXPECT().ConsumeFlowContext(
+		mock.MatchedBy(func(ctx context.Context) bool { return ctx.Value(txMarkerKey{}) == "tx" }),
+		"existing-execution-id").Return(true, nil)
+	service := newCompletingFlowExecService(t, mockStore)
+
+	flowStep, svcErr := service.Execute(context.Background(), "test-app", "existing-execution-id",
+		string(common.FlowTypeAuthentication), false, "submit", map[string]string{}, "")
+
+	assert.Nil(t, svcErr)
+	assert.NotNil(t, flowStep)
+	assert.Equal(t, common.FlowStatusComplete, flowStep.Status)
+}
+
+func TestExecute_ExistingFlowCompletion_AlreadyConsumed(t *testing.T) {
+	mockStore := newFlowStoreInterfaceMock(t)
+	mockStore.EXPECT().ConsumeFlowContext(mock.Anything, "existing-execution-id").Return(false, nil)
+	service := newCompletingFlowExecService(t, mockStore)
+
+	flowStep, svcErr := service.Execute(context.Background(), "test-app", "existing-execution-id",
+		string(common.FlowTypeAuthentication), false, "submit", map[string]string{}, "")
+
+	assert.Nil(t, flowStep)
+	assert.NotNil(t, svcErr)
+	assert.Equal(t, ErrorInvalidExecutionID.Code, svcErr.Code)
+}
+
+func TestExecute_ExistingFlowCompletion_ConsumeError(t *testing.T) {
+	mockStore := newFlowStoreInterfaceMock(t)
+	mockStore.EXPECT().ConsumeFlowContext(mock.Anything, "existing-execution-id").
+		Return(false, errors.New("db error"))
+	service := newCompletingFlowExecService(t, mockStore)
+
+	flowStep, svcErr := service.Execute(context.Background(), "test-app", "existing-execution-id",
+		string(common.FlowTypeAuthentication), false, "submit", map[string]string{}, "")
+
+	assert.Nil(t, flowStep)
+	assert.NotNil(t, svcErr)
+	assert.Equal(t, serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func TestExecute_ExistingFlowCompletion_ConcurrentReplayRejected(t *testing.T) {
+	// The store hands the context to exactly one caller, as the delete-and-check-rows and GETDEL
+	// implementations do.
+	var claimed atomic.Bool
+	mockStore := newFlowStoreInterfaceMock(t)
+	mockStore.EXPECT().ConsumeFlowContext(mock.Anything, "existing-execution-id").RunAndReturn(
+		func(_ context.Context, _ string) (bool, error) {
+			return claimed.CompareAndSwap(false, true), nil
+		})
+	service := newCompletingFlowExecService(t, mockStore)
+
+	const concurrentRequests = 8
+	var wg sync.WaitGroup
+	var completed, rejected atomic.Int32
+	start := make(chan struct{})
+	for i := 0; i < concurrentRequests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			flowStep, svcErr := service.Execute(context.Background(), "test-app", "existing-execution-id",
+				string(common.FlowTypeAuthentication), false, "submit", map[string]string{}, "")
+			if svcErr == nil && flowStep != nil && flowStep.Status == common.FlowStatusComplete {
+				completed.Add(1)
+			} else if svcErr != nil && svcErr.Code == ErrorInvalidExecutionID.Code {
+				rejected.Add(1)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), completed.Load())
+	assert.Equal(t, int32(concurrentRequests-1), rejected.Load())
+}
+
 func TestExecute_EngineError_NewFlow_ContextNeverRemoved(t *testing.T) {
 	testConfig := &config.Config{}
 	config.ResetServerRuntime()
@@ -1250,6 +1449,114 @@ func TestBuildFlowApplication_Success_WithMetadataAndClientID(t *testing.T) {
 	assert.Equal(t, "client-1", app.InboundAuthConfig[0].OAuthConfig.ClientID)
 }
 
+func TestSetAuthenticatedUserToContext_SkipsOtherFlowTypes(t *testing.T) {
+	svc, _, _ := newBuildAppService(t)
+	engineCtx := &EngineContext{Context: context.Background(), FlowType: common.FlowTypeAuthentication}
+
+	svcErr := svc.setAuthenticatedUserToContext(engineCtx, log.GetLogger())
+
+	assert.Nil(t, svcErr)
+	assert.False(t, engineCtx.AuthenticatedUser.IsAuthenticated)
+}
+
+func TestSetAuthenticatedUserToContext_NoSubject(t *testing.T) {
+	svc, _, _ := newBuildAppService(t)
+	engineCtx := &EngineContext{Context: context.Background(), FlowType: common.FlowTypeMFAEnrollment}
+
+	svcErr := svc.setAuthenticatedUserToContext(engineCtx, log.GetLogger())
+
+	assert.Equal(t, ErrorAuthenticationRequired.Code, svcErr.Code)
+}
+
+func TestSetAuthenticatedUserToContext_UserNotFound(t *testing.T) {
+	svc, _, mockEP := newBuildAppService(t)
+	mockEP.EXPECT().GetEntity("user-1").Return(
+		(*entityprovider.Entity)(nil),
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "missing", ""))
+	engineCtx := &EngineContext{Context: newUserSecurityContext("user-1"), FlowType: common.FlowTypeMFAEnrollment}
+
+	svcErr := svc.setAuthenticatedUserToContext(engineCtx, log.GetLogger())
+
+	assert.Equal(t, ErrorAuthenticationRequired.Code, svcErr.Code)
+}
+
+func TestSetAuthenticatedUserToContext_EntityLoadError(t *testing.T) {
+	svc, _, mockEP := newBuildAppService(t)
+	mockEP.EXPECT().GetEntity("user-1").Return(
+		(*entityprovider.Entity)(nil),
+		entityprovider.NewEntityProviderError("INTERNAL_ERROR", "boom", ""))
+	engineCtx := &EngineContext{Context: newUserSecurityContext("user-1"), FlowType: common.FlowTypeMFAEnrollment}
+
+	svcErr := svc.setAuthenticatedUserToContext(engineCtx, log.GetLogger())
+
+	assert.Equal(t, serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func TestSetAuthenticatedUserToContext_SubjectIsNotUser(t *testing.T) {
+	svc, _, mockEP := newBuildAppService(t)
+	mockEP.EXPECT().GetEntity("app-1").Return(
+		&entityprovider.Entity{ID: "app-1", Category: entityprovider.EntityCategoryApp},
+		(*entityprovider.EntityProviderError)(nil))
+	engineCtx := &EngineContext{Context: newUserSecurityContext("app-1"), FlowType: common.FlowTypeMFAEnrollment}
+
+	svcErr := svc.setAuthenticatedUserToContext(engineCtx, log.GetLogger())
+
+	assert.Equal(t, ErrorAuthenticationRequired.Code, svcErr.Code)
+}
+
+func TestSetAuthenticatedUserToContext_Success(t *testing.T) {
+	svc, _, mockEP := newBuildAppService(t)
+	mockEP.EXPECT().GetEntity("user-1").Return(
+		&entityprovider.Entity{
+			ID:         "user-1",
+			Category:   entityprovider.EntityCategoryUser,
+			Type:       "customer",
+			OUID:       "ou-1",
+			Attributes: []byte(`{"username":"alice"}`),
+		},
+		(*entityprovider.EntityProviderError)(nil))
+	engineCtx := &EngineContext{Context: newUserSecurityContext("user-1"), FlowType: common.FlowTypeMFAEnrollment}
+
+	svcErr := svc.setAuthenticatedUserToContext(engineCtx, log.GetLogger())
+
+	assert.Nil(t, svcErr)
+	assert.Equal(t, authncm.AuthenticatedUser{
+		IsAuthenticated: true,
+		UserID:          "user-1",
+		OUID:            "ou-1",
+		UserType:        "customer",
+		Attributes:      map[string]interface{}{"username": "alice"},
+	}, engineCtx.AuthenticatedUser)
+}
+
+func TestGetFlowGraph_MFAEnrollment(t *testing.T) {
+	testConfig := &config.Config{}
+	testConfig.Flow.MFAEnrollmentFlowHandle = "mfa-enrollment"
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("/tmp/test", testConfig)
+	defer config.ResetServerRuntime()
+
+	mockFlowMgtSvc := flowmgtmock.NewFlowMgtServiceInterfaceMock(t)
+	svc := &flowExecService{flowMgtService: mockFlowMgtSvc}
+
+	_, svcErr := svc.getFlowGraph(context.Background(), "", common.FlowTypeMFAEnrollment, log.GetLogger())
+	assert.Equal(t, ErrorInvalidAppID.Code, svcErr.Code)
+
+	mockFlowMgtSvc.EXPECT().GetFlowByHandle(mock.Anything, "mfa-enrollment", common.FlowTypeMFAEnrollment).
+		Return(&flowmgt.CompleteFlowDefinition{ID: "mfa-flow-1"}, nil)
+
+	flowID, svcErr := svc.getFlowGraph(context.Background(), "app-1", common.FlowTypeMFAEnrollment,
+		log.GetLogger())
+	assert.Nil(t, svcErr)
+	assert.Equal(t, "mfa-flow-1", flowID)
+}
+
+// newUserSecurityContext returns a context carrying the security context of the given subject.
+func newUserSecurityContext(subject string) context.Context {
+	return security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest(subject, "", "", nil, nil))
+}
+
 func TestReadEntitySystemAttributes_NilEntity(t *testing.T) {
 	assert.Empty(t, readEntitySystemAttributes(nil))
 }
@@ -35,6 +35,7 @@ type flowStoreInterface interface {
 	GetFlowContext(ctx context.Context, executionID string) (*FlowContextDB, error)
 	UpdateFlowContext(ctx context.Context, dbModel FlowContextDB) error
 	DeleteFlowContext(ctx context.Context, executionID string) error
+	ConsumeFlowContext(ctx context.Context, executionID string) (bool, error)
 }
 
 // flowStore implements the FlowStoreInterface for managing flow contexts.
@@ -110,6 +111,26 @@ func (s *flowStore) DeleteFlowContext(ctx context.Context, executionID string) e
 	})
 }
 
+// ConsumeFlowContext atomically claims and removes the flow context from the database. It reports
+// whether this caller removed the context; when several callers race, only one of them observes true.
+func (s *flowStore) ConsumeFlowContext(ctx context.Context, executionID string) (bool, error) {
+	var consumed bool
+
+	err := withRuntimeDBClientContext(ctx, s.dbProvider, func(dbClient provider.DBClientInterface) error {
+		rowsAffected, err := dbClient.ExecuteContext(ctx, QueryDeleteFlowContext, executionID, s.deploymentID)
+		if err != nil {
+			return err
+		}
+		consumed = rowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return consumed, nil
+}
+
 // withRuntimeDBClientContext is a helper to execute a function with a runtime database client.
 func withRuntimeDBClientContext(_ context.Context, dbProvider provider.DBProviderInterface,
 	fn func(provider.DBClientInterface) error) error {
@@ -21,6 +21,7 @@ package flowexec
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -788,3 +789,41 @@ func (s *StoreTestSuite) TestEngineContextRoundTrip_WithAuthUser() {
 	s.NoError(err)
 	s.True(restoredCtx.AuthUser.IsAuthenticated())
 }
+
+func (s *StoreTestSuite) TestConsumeFlowContext() {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		execErr      error
+		expected     bool
+		expectErr    bool
+	}{
+		{name: "Claimed", rowsAffected: 1, expected: true},
+		{name: "AlreadyConsumed", rowsAffected: 0, expected: false},
+		{name: "DeleteError", execErr: errors.New("delete failed"), expectErr: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockDBProvider := providermock.NewDBProviderInterfaceMock(s.T())
+			mockDBClient := providermock.NewDBClientInterfaceMock(s.T())
+			mockDBProvider.On("GetRuntimeDBClient").Return(mockDBClient, nil)
+			mockDBClient.EXPECT().ExecuteContext(mock.Anything, QueryDeleteFlowContext,
+				"test-flow-id", "test-deployment").Return(tc.rowsAffected, tc.execErr)
+
+			store := &flowStore{
+				dbProvider:   mockDBProvider,
+				deploymentID: "test-deployment",
+			}
+
+			consumed, err := store.ConsumeFlowContext(context.Background(), "test-flow-id")
+
+			if tc.expectErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			s.Equal(tc.expected, consumed)
+		})
+	}
+}
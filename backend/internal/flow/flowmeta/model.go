@@ -32,13 +32,14 @@ type FlowMetadataResponse struct {
 
 // ApplicationMetadata represents application-specific metadata.
 type ApplicationMetadata struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	LogoURL     string `json:"logoUrl,omitempty"`
-	URL         string `json:"url,omitempty"`
-	TosURI      string `json:"tosUri,omitempty"`
-	PolicyURI   string `json:"policyUri,omitempty"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	LogoURL     string   `json:"logoUrl,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	TosURI      string   `json:"tosUri,omitempty"`
+	PolicyURI   string   `json:"policyUri,omitempty"`
+	AllowedIDPs []string `json:"allowedIdps,omitempty"`
 }
 
 // OUMetadata represents organization unit metadata.
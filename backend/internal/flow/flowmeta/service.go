@@ -102,7 +102,7 @@ func (fms *flowMetaService) GetFlowMetadata(
 	lang, ns := resolveLanguageAndNamespace(language, namespace)
 
 	if metaType == "" {
-		fms.populateI18nMetadata(response, lang, ns)
+		fms.populateI18nMetadata(response, lang, ns, i18nmgt.TranslationScope{})
 		return response, nil
 	}
 
@@ -120,7 +120,12 @@ func (fms *flowMetaService) GetFlowMetadata(
 	}
 
 	fms.populateDesignMetadata(ctx, metaType, id, ouID, response)
-	fms.populateI18nMetadata(response, lang, ns)
+
+	scope := i18nmgt.TranslationScope{OUID: ouID}
+	if metaType == MetaTypeAPP {
+		scope.AppID = id
+	}
+	fms.populateI18nMetadata(response, lang, ns, scope)
 
 	fms.logger.Debug("Successfully retrieved flow metadata",
 		log.String("type", string(metaType)),
@@ -189,6 +194,9 @@ func (fms *flowMetaService) populateTypeMetadata(
 	response.IsRegistrationFlowEnabled = client.IsRegistrationFlowEnabled
 	response.IsRecoveryFlowEnabled = client.IsRecoveryFlowEnabled
 	response.Application = buildApplicationMetadata(client.ID, entity, client.Properties)
+	if client.LoginExperience != nil {
+		response.Application.AllowedIDPs = client.LoginExperience.AllowedIDPs
+	}
 
 	ouList, ouErr := fms.ouService.GetOrganizationUnitList(ctx, 1, 0, nil)
 	if ouErr != nil {
@@ -280,8 +288,9 @@ func (fms *flowMetaService) populateDesignMetadata(
 	}
 }
 
-func (fms *flowMetaService) populateI18nMetadata(response *FlowMetadataResponse, lang string, ns string) {
-	i18nResp, i18nErr := fms.i18nService.ResolveTranslations(lang, ns)
+func (fms *flowMetaService) populateI18nMetadata(
+	response *FlowMetadataResponse, lang string, ns string, scope i18nmgt.TranslationScope) {
+	i18nResp, i18nErr := fms.i18nService.ResolveTranslations(lang, ns, scope)
 	if i18nErr != nil {
 		fms.logger.Debug("Failed to get i18n translations",
 			log.String("language", lang),
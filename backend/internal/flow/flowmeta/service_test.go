@@ -154,7 +154,8 @@ func (suite *FlowMetaServiceTestSuite) TestGetFlowMetadata_APP_Success() {
 	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, ouID).Return(mockOU, nil)
 	suite.mockDesignResolve.On("ResolveDesign", mock.Anything, common.DesignResolveTypeAPP, appID).
 		Return(mockDesign, nil)
-	suite.mockI18nService.On("ResolveTranslations", language, namespace).Return(mockTranslations, nil)
+	suite.mockI18nService.On("ResolveTranslations", language, namespace,
+		i18nmgt.TranslationScope{AppID: testAppID, OUID: testOUID}).Return(mockTranslations, nil)
 	suite.mockI18nService.On("ListLanguages").Return([]string{"en", "es"}, nil)
 
 	// Act
@@ -203,7 +204,8 @@ func (suite *FlowMetaServiceTestSuite) TestGetFlowMetadata_OU_Success() {
 
 	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, ouID).Return(mockOU, nil)
 	suite.mockDesignResolve.On("ResolveDesign", mock.Anything, common.DesignResolveTypeOU, ouID).Return(mockDesign, nil)
-	suite.mockI18nService.On("ResolveTranslations", "en-US", "").Return(mockTranslations, nil)
+	suite.mockI18nService.On("ResolveTranslations", "en-US", "",
+		i18nmgt.TranslationScope{OUID: testOUID}).Return(mockTranslations, nil)
 	suite.mockI18nService.On("ListLanguages").Return([]string{"en"}, nil)
 
 	// Act
@@ -250,6 +252,29 @@ func (suite *FlowMetaServiceTestSuite) TestGetFlowMetadata_ApplicationNotFound()
 	assert.Equal(suite.T(), ErrorApplicationNotFound.Code, svcErr.Code)
 }
 
+func (suite *FlowMetaServiceTestSuite) TestPopulateTypeMetadata_IncludesAllowedIDPs() {
+	appID := testAppID
+	client := &inboundmodel.InboundClient{
+		ID: appID,
+		LoginExperience: &inboundmodel.LoginExperienceConfig{
+			AllowedIDPs: []string{"google-idp"},
+		},
+	}
+	suite.mockInboundClient.On("GetInboundClientByEntityID", mock.Anything, appID).Return(client, nil)
+	suite.mockEntityProvider.On("GetEntity", appID).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+	suite.mockOUService.On("GetOrganizationUnitList", mock.Anything, 1, 0, mock.Anything).
+		Return(&ou.OrganizationUnitListResponse{}, nil)
+
+	response := &FlowMetadataResponse{}
+	fms := suite.service.(*flowMetaService)
+	_, svcErr := fms.populateTypeMetadata(suite.ctx, MetaTypeAPP, appID, response)
+
+	assert.Nil(suite.T(), svcErr)
+	assert.NotNil(suite.T(), response.Application)
+	assert.Equal(suite.T(), []string{"google-idp"}, response.Application.AllowedIDPs)
+}
+
 func (suite *FlowMetaServiceTestSuite) TestGetFlowMetadata_OUNotFound() {
 	// Arrange
 	metaType := MetaTypeOU
@@ -292,7 +317,8 @@ func (suite *FlowMetaServiceTestSuite) TestGetFlowMetadata_DesignResolveError_Co
 	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, ouID).Return(mockOU, nil)
 	suite.mockDesignResolve.On("ResolveDesign", mock.Anything, common.DesignResolveTypeAPP, appID).
 		Return(nil, &serviceerror.InternalServerError)
-	suite.mockI18nService.On("ResolveTranslations", "en-US", "").
+	suite.mockI18nService.On("ResolveTranslations", "en-US", "",
+		i18nmgt.TranslationScope{AppID: testAppID, OUID: testOUID}).
 		Return(&i18nmgt.LanguageTranslationsResponse{
 			Language:     "en-US",
 			TotalResults: 0,
@@ -327,7 +353,7 @@ func (suite *FlowMetaServiceTestSuite) TestGetFlowMetadata_I18nError_ContinuesWi
 			Theme:  json.RawMessage(`{}`),
 			Layout: json.RawMessage(`{}`),
 		}, nil)
-	suite.mockI18nService.On("ResolveTranslations", "en-US", "").
+	suite.mockI18nService.On("ResolveTranslations", "en-US", "", i18nmgt.TranslationScope{OUID: testOUID}).
 		Return(nil, &serviceerror.ServiceError{Code: "I18N-5000", Type: serviceerror.ServerErrorType})
 	suite.mockI18nService.On("ListLanguages").Return([]string{"en"}, nil)
 
@@ -351,7 +377,8 @@ func (suite *FlowMetaServiceTestSuite) TestGetFlowMetadata_SystemFlow_NoTypeOrID
 		},
 	}
 
-	suite.mockI18nService.On("ResolveTranslations", "en-US", "").Return(mockTranslations, nil)
+	suite.mockI18nService.On("ResolveTranslations", "en-US", "", i18nmgt.TranslationScope{}).
+		Return(mockTranslations, nil)
 	suite.mockI18nService.On("ListLanguages").Return([]string{"en-US"}, nil)
 
 	// Act
@@ -40,6 +40,76 @@ func (_m *FlowMgtServiceInterfaceMock) EXPECT() *FlowMgtServiceInterfaceMock_Exp
 	return &FlowMgtServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// BuildDraftGraph provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) BuildDraftGraph(ctx context.Context, flowDef *FlowDefinition) (core.GraphInterface, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, flowDef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildDraftGraph")
+	}
+
+	var r0 core.GraphInterface
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *FlowDefinition) (core.GraphInterface, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, flowDef)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *FlowDefinition) core.GraphInterface); ok {
+		r0 = returnFunc(ctx, flowDef)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.GraphInterface)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *FlowDefinition) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, flowDef)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowMgtServiceInterfaceMock_BuildDraftGraph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildDraftGraph'
+type FlowMgtServiceInterfaceMock_BuildDraftGraph_Call struct {
+	*mock.Call
+}
+
+// BuildDraftGraph is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowDef *FlowDefinition
+func (_e *FlowMgtServiceInterfaceMock_Expecter) BuildDraftGraph(ctx interface{}, flowDef interface{}) *FlowMgtServiceInterfaceMock_BuildDraftGraph_Call {
+	return &FlowMgtServiceInterfaceMock_BuildDraftGraph_Call{Call: _e.mock.On("BuildDraftGraph", ctx, flowDef)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_BuildDraftGraph_Call) Run(run func(ctx context.Context, flowDef *FlowDefinition)) *FlowMgtServiceInterfaceMock_BuildDraftGraph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *FlowDefinition
+		if args[1] != nil {
+			arg1 = args[1].(*FlowDefinition)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_BuildDraftGraph_Call) Return(graphInterface core.GraphInterface, serviceError *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_BuildDraftGraph_Call {
+	_c.Call.Return(graphInterface, serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_BuildDraftGraph_Call) RunAndReturn(run func(ctx context.Context, flowDef *FlowDefinition) (core.GraphInterface, *serviceerror.ServiceError)) *FlowMgtServiceInterfaceMock_BuildDraftGraph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateFlow provides a mock function for the type FlowMgtServiceInterfaceMock
 func (_mock *FlowMgtServiceInterfaceMock) CreateFlow(ctx context.Context, flowDef *FlowDefinition) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, flowDef)
@@ -169,6 +239,135 @@ func (_c *FlowMgtServiceInterfaceMock_DeleteFlow_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// DeleteFlowDraft provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) DeleteFlowDraft(ctx context.Context, flowID string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, flowID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFlowDraft")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, flowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFlowDraft'
+type FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call struct {
+	*mock.Call
+}
+
+// DeleteFlowDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowID string
+func (_e *FlowMgtServiceInterfaceMock_Expecter) DeleteFlowDraft(ctx interface{}, flowID interface{}) *FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call {
+	return &FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call{Call: _e.mock.On("DeleteFlowDraft", ctx, flowID)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call) Run(run func(ctx context.Context, flowID string)) *FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call) Return(serviceError *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call) RunAndReturn(run func(ctx context.Context, flowID string) *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_DeleteFlowDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportFlow provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) ExportFlow(ctx context.Context, flowID string) (*FlowBundle, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, flowID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportFlow")
+	}
+
+	var r0 *FlowBundle
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*FlowBundle, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, flowID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *FlowBundle); ok {
+		r0 = returnFunc(ctx, flowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FlowBundle)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, flowID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowMgtServiceInterfaceMock_ExportFlow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportFlow'
+type FlowMgtServiceInterfaceMock_ExportFlow_Call struct {
+	*mock.Call
+}
+
+// ExportFlow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowID string
+func (_e *FlowMgtServiceInterfaceMock_Expecter) ExportFlow(ctx interface{}, flowID interface{}) *FlowMgtServiceInterfaceMock_ExportFlow_Call {
+	return &FlowMgtServiceInterfaceMock_ExportFlow_Call{Call: _e.mock.On("ExportFlow", ctx, flowID)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ExportFlow_Call) Run(run func(ctx context.Context, flowID string)) *FlowMgtServiceInterfaceMock_ExportFlow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ExportFlow_Call) Return(flowBundle *FlowBundle, serviceError *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_ExportFlow_Call {
+	_c.Call.Return(flowBundle, serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ExportFlow_Call) RunAndReturn(run func(ctx context.Context, flowID string) (*FlowBundle, *serviceerror.ServiceError)) *FlowMgtServiceInterfaceMock_ExportFlow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlow provides a mock function for the type FlowMgtServiceInterfaceMock
 func (_mock *FlowMgtServiceInterfaceMock) GetFlow(ctx context.Context, flowID string) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, flowID)
@@ -315,6 +514,76 @@ func (_c *FlowMgtServiceInterfaceMock_GetFlowByHandle_Call) RunAndReturn(run fun
 	return _c
 }
 
+// GetFlowDraft provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) GetFlowDraft(ctx context.Context, flowID string) (*FlowDraft, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, flowID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlowDraft")
+	}
+
+	var r0 *FlowDraft
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*FlowDraft, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, flowID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *FlowDraft); ok {
+		r0 = returnFunc(ctx, flowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FlowDraft)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, flowID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowMgtServiceInterfaceMock_GetFlowDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlowDraft'
+type FlowMgtServiceInterfaceMock_GetFlowDraft_Call struct {
+	*mock.Call
+}
+
+// GetFlowDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowID string
+func (_e *FlowMgtServiceInterfaceMock_Expecter) GetFlowDraft(ctx interface{}, flowID interface{}) *FlowMgtServiceInterfaceMock_GetFlowDraft_Call {
+	return &FlowMgtServiceInterfaceMock_GetFlowDraft_Call{Call: _e.mock.On("GetFlowDraft", ctx, flowID)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_GetFlowDraft_Call) Run(run func(ctx context.Context, flowID string)) *FlowMgtServiceInterfaceMock_GetFlowDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_GetFlowDraft_Call) Return(flowDraft *FlowDraft, serviceError *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_GetFlowDraft_Call {
+	_c.Call.Return(flowDraft, serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_GetFlowDraft_Call) RunAndReturn(run func(ctx context.Context, flowID string) (*FlowDraft, *serviceerror.ServiceError)) *FlowMgtServiceInterfaceMock_GetFlowDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlowVersion provides a mock function for the type FlowMgtServiceInterfaceMock
 func (_mock *FlowMgtServiceInterfaceMock) GetFlowVersion(ctx context.Context, flowID string, version int) (*FlowVersion, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, flowID, version)
@@ -461,6 +730,76 @@ func (_c *FlowMgtServiceInterfaceMock_GetGraph_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// ImportFlow provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) ImportFlow(ctx context.Context, request *FlowImportRequest) (*FlowImportResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportFlow")
+	}
+
+	var r0 *FlowImportResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *FlowImportRequest) (*FlowImportResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *FlowImportRequest) *FlowImportResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FlowImportResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *FlowImportRequest) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowMgtServiceInterfaceMock_ImportFlow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportFlow'
+type FlowMgtServiceInterfaceMock_ImportFlow_Call struct {
+	*mock.Call
+}
+
+// ImportFlow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *FlowImportRequest
+func (_e *FlowMgtServiceInterfaceMock_Expecter) ImportFlow(ctx interface{}, request interface{}) *FlowMgtServiceInterfaceMock_ImportFlow_Call {
+	return &FlowMgtServiceInterfaceMock_ImportFlow_Call{Call: _e.mock.On("ImportFlow", ctx, request)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ImportFlow_Call) Run(run func(ctx context.Context, request *FlowImportRequest)) *FlowMgtServiceInterfaceMock_ImportFlow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *FlowImportRequest
+		if args[1] != nil {
+			arg1 = args[1].(*FlowImportRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ImportFlow_Call) Return(flowImportResponse *FlowImportResponse, serviceError *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_ImportFlow_Call {
+	_c.Call.Return(flowImportResponse, serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ImportFlow_Call) RunAndReturn(run func(ctx context.Context, request *FlowImportRequest) (*FlowImportResponse, *serviceerror.ServiceError)) *FlowMgtServiceInterfaceMock_ImportFlow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsValidFlow provides a mock function for the type FlowMgtServiceInterfaceMock
 func (_mock *FlowMgtServiceInterfaceMock) IsValidFlow(ctx context.Context, flowID string, flowType common.FlowType) (bool, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, flowID, flowType)
@@ -687,6 +1026,76 @@ func (_c *FlowMgtServiceInterfaceMock_ListFlows_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// PublishFlowDraft provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) PublishFlowDraft(ctx context.Context, flowID string) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, flowID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishFlowDraft")
+	}
+
+	var r0 *CompleteFlowDefinition
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*CompleteFlowDefinition, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, flowID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *CompleteFlowDefinition); ok {
+		r0 = returnFunc(ctx, flowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CompleteFlowDefinition)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, flowID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowMgtServiceInterfaceMock_PublishFlowDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishFlowDraft'
+type FlowMgtServiceInterfaceMock_PublishFlowDraft_Call struct {
+	*mock.Call
+}
+
+// PublishFlowDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowID string
+func (_e *FlowMgtServiceInterfaceMock_Expecter) PublishFlowDraft(ctx interface{}, flowID interface{}) *FlowMgtServiceInterfaceMock_PublishFlowDraft_Call {
+	return &FlowMgtServiceInterfaceMock_PublishFlowDraft_Call{Call: _e.mock.On("PublishFlowDraft", ctx, flowID)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_PublishFlowDraft_Call) Run(run func(ctx context.Context, flowID string)) *FlowMgtServiceInterfaceMock_PublishFlowDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_PublishFlowDraft_Call) Return(completeFlowDefinition *CompleteFlowDefinition, serviceError *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_PublishFlowDraft_Call {
+	_c.Call.Return(completeFlowDefinition, serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_PublishFlowDraft_Call) RunAndReturn(run func(ctx context.Context, flowID string) (*CompleteFlowDefinition, *serviceerror.ServiceError)) *FlowMgtServiceInterfaceMock_PublishFlowDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreFlowVersion provides a mock function for the type FlowMgtServiceInterfaceMock
 func (_mock *FlowMgtServiceInterfaceMock) RestoreFlowVersion(ctx context.Context, flowID string, version int) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, flowID, version)
@@ -763,6 +1172,82 @@ func (_c *FlowMgtServiceInterfaceMock_RestoreFlowVersion_Call) RunAndReturn(run
 	return _c
 }
 
+// SaveFlowDraft provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) SaveFlowDraft(ctx context.Context, flowID string, flowDef *FlowDefinition) (*FlowDraft, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, flowID, flowDef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveFlowDraft")
+	}
+
+	var r0 *FlowDraft
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *FlowDefinition) (*FlowDraft, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, flowID, flowDef)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *FlowDefinition) *FlowDraft); ok {
+		r0 = returnFunc(ctx, flowID, flowDef)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FlowDraft)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *FlowDefinition) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, flowID, flowDef)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowMgtServiceInterfaceMock_SaveFlowDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveFlowDraft'
+type FlowMgtServiceInterfaceMock_SaveFlowDraft_Call struct {
+	*mock.Call
+}
+
+// SaveFlowDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowID string
+//   - flowDef *FlowDefinition
+func (_e *FlowMgtServiceInterfaceMock_Expecter) SaveFlowDraft(ctx interface{}, flowID interface{}, flowDef interface{}) *FlowMgtServiceInterfaceMock_SaveFlowDraft_Call {
+	return &FlowMgtServiceInterfaceMock_SaveFlowDraft_Call{Call: _e.mock.On("SaveFlowDraft", ctx, flowID, flowDef)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_SaveFlowDraft_Call) Run(run func(ctx context.Context, flowID string, flowDef *FlowDefinition)) *FlowMgtServiceInterfaceMock_SaveFlowDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *FlowDefinition
+		if args[2] != nil {
+			arg2 = args[2].(*FlowDefinition)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_SaveFlowDraft_Call) Return(flowDraft *FlowDraft, serviceError *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_SaveFlowDraft_Call {
+	_c.Call.Return(flowDraft, serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_SaveFlowDraft_Call) RunAndReturn(run func(ctx context.Context, flowID string, flowDef *FlowDefinition) (*FlowDraft, *serviceerror.ServiceError)) *FlowMgtServiceInterfaceMock_SaveFlowDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateFlow provides a mock function for the type FlowMgtServiceInterfaceMock
 func (_mock *FlowMgtServiceInterfaceMock) UpdateFlow(ctx context.Context, flowID string, flowDef *FlowDefinition) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, flowID, flowDef)
@@ -838,3 +1323,73 @@ func (_c *FlowMgtServiceInterfaceMock_UpdateFlow_Call) RunAndReturn(run func(ctx
 	_c.Call.Return(run)
 	return _c
 }
+
+// ValidateFlow provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) ValidateFlow(ctx context.Context, flowDef *FlowDefinition) (*FlowValidationResult, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, flowDef)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateFlow")
+	}
+
+	var r0 *FlowValidationResult
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *FlowDefinition) (*FlowValidationResult, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, flowDef)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *FlowDefinition) *FlowValidationResult); ok {
+		r0 = returnFunc(ctx, flowDef)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FlowValidationResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *FlowDefinition) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, flowDef)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowMgtServiceInterfaceMock_ValidateFlow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateFlow'
+type FlowMgtServiceInterfaceMock_ValidateFlow_Call struct {
+	*mock.Call
+}
+
+// ValidateFlow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowDef *FlowDefinition
+func (_e *FlowMgtServiceInterfaceMock_Expecter) ValidateFlow(ctx interface{}, flowDef interface{}) *FlowMgtServiceInterfaceMock_ValidateFlow_Call {
+	return &FlowMgtServiceInterfaceMock_ValidateFlow_Call{Call: _e.mock.On("ValidateFlow", ctx, flowDef)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ValidateFlow_Call) Run(run func(ctx context.Context, flowDef *FlowDefinition)) *FlowMgtServiceInterfaceMock_ValidateFlow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *FlowDefinition
+		if args[1] != nil {
+			arg1 = args[1].(*FlowDefinition)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ValidateFlow_Call) Return(flowValidationResult *FlowValidationResult, serviceError *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_ValidateFlow_Call {
+	_c.Call.Return(flowValidationResult, serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ValidateFlow_Call) RunAndReturn(run func(ctx context.Context, flowDef *FlowDefinition) (*FlowValidationResult, *serviceerror.ServiceError)) *FlowMgtServiceInterfaceMock_ValidateFlow_Call {
+	_c.Call.Return(run)
+	return _c
+}
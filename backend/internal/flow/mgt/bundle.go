@@ -0,0 +1,257 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ExportFlow exports a flow definition as a portable flow bundle that carries the metadata of the executors
+// and identity providers the flow refers to.
+func (s *flowMgtService) ExportFlow(ctx context.Context, flowID string) (
+	*FlowBundle, *serviceerror.ServiceError) {
+	flow, svcErr := s.GetFlow(ctx, flowID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	idps, svcErr := s.getBundleIdentityProviders(ctx, flow.Nodes)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &FlowBundle{
+		Flow: FlowBundleDefinition{
+			Handle:   flow.Handle,
+			Name:     flow.Name,
+			FlowType: flow.FlowType,
+			Nodes:    flow.Nodes,
+		},
+		Executors:         s.getBundleExecutors(flow.Nodes),
+		IdentityProviders: idps,
+	}, nil
+}
+
+// ImportFlow imports a flow bundle exported from another environment. The identity provider references of
+// the flow are remapped to the identity providers of this environment, and the flow is validated before it is
+// saved. A flow with the same handle and type is updated to a new version; otherwise a new flow is created.
+func (s *flowMgtService) ImportFlow(ctx context.Context, request *FlowImportRequest) (
+	*FlowImportResponse, *serviceerror.ServiceError) {
+	if request == nil || strings.TrimSpace(request.Content) == "" {
+		return nil, &ErrorInvalidFlowBundle
+	}
+
+	var bundle FlowBundle
+	if err := yaml.Unmarshal([]byte(request.Content), &bundle); err != nil {
+		s.logger.Debug("Failed to parse flow bundle", log.Error(err))
+		return nil, &ErrorInvalidFlowBundle
+	}
+
+	flowDef := &FlowDefinition{
+		Handle:   bundle.Flow.Handle,
+		Name:     bundle.Flow.Name,
+		FlowType: bundle.Flow.FlowType,
+		Nodes:    bundle.Flow.Nodes,
+	}
+	if err := validateFlowDefinition(flowDef); err != nil {
+		return nil, err
+	}
+
+	if svcErr := s.remapIDPReferences(ctx, flowDef.Nodes, bundle.IdentityProviders,
+		request.IDPMappings); svcErr != nil {
+		return nil, svcErr
+	}
+
+	result, svcErr := s.ValidateFlow(ctx, flowDef)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	for _, diagnostic := range result.Diagnostics {
+		if diagnostic.Severity == DiagnosticSeverityError {
+			return nil, serviceerror.CustomServiceError(ErrorInvalidFlowData, i18ncore.I18nMessage{
+				Key:          "error.flowmgtservice.invalid_imported_flow_description",
+				DefaultValue: diagnostic.Message,
+			})
+		}
+	}
+
+	existingFlow, svcErr := s.GetFlowByHandle(ctx, flowDef.Handle, flowDef.FlowType)
+	if svcErr != nil {
+		if svcErr.Code != ErrorFlowNotFound.Code {
+			return nil, svcErr
+		}
+
+		createdFlow, svcErr := s.CreateFlow(ctx, flowDef)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		return &FlowImportResponse{Operation: importOperationCreate, Flow: createdFlow}, nil
+	}
+
+	updatedFlow, svcErr := s.UpdateFlow(ctx, existingFlow.ID, flowDef)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return &FlowImportResponse{Operation: importOperationUpdate, Flow: updatedFlow}, nil
+}
+
+// getBundleExecutors returns the metadata of the executors referred to by the given nodes.
+func (s *flowMgtService) getBundleExecutors(nodeDefs []NodeDefinition) []FlowBundleExecutor {
+	executors := make([]FlowBundleExecutor, 0)
+	seen := make(map[string]bool)
+	for _, nodeDef := range nodeDefs {
+		if nodeDef.Executor == nil || nodeDef.Executor.Name == "" || seen[nodeDef.Executor.Name] {
+			continue
+		}
+		seen[nodeDef.Executor.Name] = true
+
+		bundleExecutor := FlowBundleExecutor{Name: nodeDef.Executor.Name}
+		if executor, err := s.executorRegistry.GetExecutor(nodeDef.Executor.Name); err == nil {
+			bundleExecutor.Type = string(executor.GetType())
+		}
+		executors = append(executors, bundleExecutor)
+	}
+
+	return executors
+}
+
+// getBundleIdentityProviders returns the metadata of the identity providers referred to by the given nodes.
+// Identity providers that do not exist are exported with their ID only.
+func (s *flowMgtService) getBundleIdentityProviders(ctx context.Context, nodeDefs []NodeDefinition) (
+	[]FlowBundleIdentityProvider, *serviceerror.ServiceError) {
+	idps := make([]FlowBundleIdentityProvider, 0)
+	seen := make(map[string]bool)
+	for _, nodeDef := range nodeDefs {
+		idpID, _ := nodeDef.Properties[nodePropertyIDPID].(string)
+		if idpID == "" || seen[idpID] {
+			continue
+		}
+		seen[idpID] = true
+
+		bundleIDP := FlowBundleIdentityProvider{ID: idpID}
+		idp, svcErr := s.idpService.GetIdentityProvider(ctx, idpID)
+		if svcErr != nil && svcErr.Type != serviceerror.ClientErrorType {
+			s.logger.Error("Failed to get identity provider for flow export",
+				log.String("idpID", idpID), log.String("error", svcErr.Error.DefaultValue))
+			return nil, &serviceerror.InternalServerError
+		}
+		if svcErr == nil {
+			bundleIDP.Name = idp.Name
+			bundleIDP.Type = string(idp.Type)
+		}
+		idps = append(idps, bundleIDP)
+	}
+
+	return idps, nil
+}
+
+// remapIDPReferences replaces the identity provider IDs of the source environment in the node properties with
+// the IDs of the matching identity providers in this environment.
+func (s *flowMgtService) remapIDPReferences(ctx context.Context, nodeDefs []NodeDefinition,
+	bundleIDPs []FlowBundleIdentityProvider, idpMappings map[string]string) *serviceerror.ServiceError {
+	resolved := make(map[string]string)
+	for i := range nodeDefs {
+		sourceID, _ := nodeDefs[i].Properties[nodePropertyIDPID].(string)
+		if sourceID == "" {
+			continue
+		}
+
+		targetID, ok := resolved[sourceID]
+		if !ok {
+			var svcErr *serviceerror.ServiceError
+			targetID, svcErr = s.resolveIDPReference(ctx, sourceID, bundleIDPs, idpMappings)
+			if svcErr != nil {
+				return svcErr
+			}
+			resolved[sourceID] = targetID
+		}
+		nodeDefs[i].Properties[nodePropertyIDPID] = targetID
+	}
+
+	return nil
+}
+
+// resolveIDPReference finds the identity provider in this environment that matches an identity provider of the
+// source environment. An explicit mapping takes precedence, followed by an identity provider with the same name,
+// and finally an identity provider with the same ID.
+func (s *flowMgtService) resolveIDPReference(ctx context.Context, sourceID string,
+	bundleIDPs []FlowBundleIdentityProvider, idpMappings map[string]string) (string, *serviceerror.ServiceError) {
+	candidateID := sourceID
+	if mappedID, ok := idpMappings[sourceID]; ok {
+		candidateID = mappedID
+	} else {
+		for _, bundleIDP := range bundleIDPs {
+			if bundleIDP.ID != sourceID || bundleIDP.Name == "" {
+				continue
+			}
+
+			idp, svcErr := s.idpService.GetIdentityProviderByName(ctx, bundleIDP.Name)
+			if svcErr == nil {
+				return idp.ID, nil
+			}
+			if svcErr.Type != serviceerror.ClientErrorType {
+				s.logger.Error("Failed to get identity provider for flow import",
+					log.String("idpName", bundleIDP.Name), log.String("error", svcErr.Error.DefaultValue))
+				return "", &serviceerror.InternalServerError
+			}
+			break
+		}
+	}
+
+	exists, svcErr := s.isExistingIDP(ctx, candidateID)
+	if svcErr != nil {
+		return "", svcErr
+	}
+	if !exists {
+		return "", serviceerror.CustomServiceError(ErrorUnresolvedIDPReference, i18ncore.I18nMessage{
+			Key: "error.flowmgtservice.unresolved_idp_reference_description",
+			DefaultValue: fmt.Sprintf("No identity provider in this environment matches the identity provider %s "+
+				"of the flow. Map it to an existing identity provider with idpMappings", sourceID),
+		})
+	}
+
+	return candidateID, nil
+}
+
+// isExistingIDP checks whether an identity provider with the given ID exists.
+func (s *flowMgtService) isExistingIDP(ctx context.Context, idpID string) (bool, *serviceerror.ServiceError) {
+	if idpID == "" {
+		return false, nil
+	}
+
+	_, svcErr := s.idpService.GetIdentityProvider(ctx, idpID)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			return false, nil
+		}
+		s.logger.Error("Failed to get identity provider", log.String("idpID", idpID),
+			log.String("error", svcErr.Error.DefaultValue))
+		return false, &serviceerror.InternalServerError
+	}
+
+	return true, nil
+}
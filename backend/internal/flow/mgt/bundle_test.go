@@ -0,0 +1,313 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/executormock"
+	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
+)
+
+const (
+	testBundleFlowID   = "bundle-flow-id"
+	testBundleExecutor = "GoogleOIDCAuthExecutor"
+	testSourceIDPID    = "source-idp-id"
+	testTargetIDPID    = "target-idp-id"
+	testBundleIDPName  = "Google"
+)
+
+// testFlowBundle is a flow bundle whose flow refers to the identity provider of the source environment.
+const testFlowBundle = `
+flow:
+  handle: google-login
+  name: Google Login
+  flowType: AUTHENTICATION
+  nodes:
+    - id: start
+      type: START
+      onSuccess: google
+    - id: google
+      type: TASK_EXECUTION
+      executor:
+        name: GoogleOIDCAuthExecutor
+      properties:
+        idpId: source-idp-id
+      onSuccess: end
+    - id: end
+      type: END
+executors:
+  - name: GoogleOIDCAuthExecutor
+    type: AUTHENTICATION
+identityProviders:
+  - id: source-idp-id
+    name: Google
+    type: GOOGLE
+`
+
+type FlowBundleTestSuite struct {
+	suite.Suite
+	service              FlowMgtServiceInterface
+	mockStore            *flowStoreInterfaceMock
+	mockGraphBuilder     *graphBuilderInterfaceMock
+	mockExecutorRegistry *executormock.ExecutorRegistryInterfaceMock
+	mockIDPService       *idpmock.IDPServiceInterfaceMock
+}
+
+func TestFlowBundleTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowBundleTestSuite))
+}
+
+func (s *FlowBundleTestSuite) SetupTest() {
+	s.mockStore = newFlowStoreInterfaceMock(s.T())
+	s.mockGraphBuilder = newGraphBuilderInterfaceMock(s.T())
+	s.mockExecutorRegistry = executormock.NewExecutorRegistryInterfaceMock(s.T())
+	s.mockIDPService = idpmock.NewIDPServiceInterfaceMock(s.T())
+	s.service = newFlowMgtService(s.mockStore, newFlowInferenceServiceInterfaceMock(s.T()), s.mockGraphBuilder,
+		s.mockExecutorRegistry, s.mockIDPService, nil, &stubTransactioner{})
+
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{})
+}
+
+func (s *FlowBundleTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *FlowBundleTestSuite) newStoredFlow() *CompleteFlowDefinition {
+	return &CompleteFlowDefinition{
+		ID:       testBundleFlowID,
+		Handle:   "google-login",
+		Name:     "Google Login",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes: []NodeDefinition{
+			{ID: "start", Type: "START", OnSuccess: "google"},
+			{
+				ID:         "google",
+				Type:       "TASK_EXECUTION",
+				Executor:   &ExecutorDefinition{Name: testBundleExecutor},
+				Properties: map[string]interface{}{"idpId": testSourceIDPID},
+				OnSuccess:  "end",
+			},
+			{ID: "end", Type: "END"},
+		},
+		ActiveVersion: 3,
+	}
+}
+
+// ExportFlow tests
+
+func (s *FlowBundleTestSuite) TestExportFlow_Success() {
+	storedFlow := s.newStoredFlow()
+	mockExecutor := coremock.NewExecutorInterfaceMock(s.T())
+	mockExecutor.EXPECT().GetType().Return(common.ExecutorTypeAuthentication)
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testBundleFlowID).Return(storedFlow, nil)
+	s.mockExecutorRegistry.EXPECT().GetExecutor(testBundleExecutor).Return(mockExecutor, nil)
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testSourceIDPID).Return(&idp.IDPDTO{
+		ID:   testSourceIDPID,
+		Name: testBundleIDPName,
+		Type: idp.IDPTypeGoogle,
+	}, nil)
+
+	bundle, err := s.service.ExportFlow(context.Background(), testBundleFlowID)
+
+	s.Nil(err)
+	s.Equal(&FlowBundle{
+		Flow: FlowBundleDefinition{
+			Handle:   storedFlow.Handle,
+			Name:     storedFlow.Name,
+			FlowType: storedFlow.FlowType,
+			Nodes:    storedFlow.Nodes,
+		},
+		Executors: []FlowBundleExecutor{{Name: testBundleExecutor, Type: "AUTHENTICATION"}},
+		IdentityProviders: []FlowBundleIdentityProvider{
+			{ID: testSourceIDPID, Name: testBundleIDPName, Type: "GOOGLE"},
+		},
+	}, bundle)
+}
+
+func (s *FlowBundleTestSuite) TestExportFlow_MissingReferences() {
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testBundleFlowID).Return(s.newStoredFlow(), nil)
+	s.mockExecutorRegistry.EXPECT().GetExecutor(testBundleExecutor).Return(nil, errors.New("not registered"))
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testSourceIDPID).Return(nil, &idp.ErrorIDPNotFound)
+
+	bundle, err := s.service.ExportFlow(context.Background(), testBundleFlowID)
+
+	s.Nil(err)
+	s.Equal([]FlowBundleExecutor{{Name: testBundleExecutor}}, bundle.Executors)
+	s.Equal([]FlowBundleIdentityProvider{{ID: testSourceIDPID}}, bundle.IdentityProviders)
+}
+
+func (s *FlowBundleTestSuite) TestExportFlow_FlowNotFound() {
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testBundleFlowID).Return(nil, errFlowNotFound)
+
+	bundle, err := s.service.ExportFlow(context.Background(), testBundleFlowID)
+
+	s.Nil(bundle)
+	s.Equal(&ErrorFlowNotFound, err)
+}
+
+func (s *FlowBundleTestSuite) TestExportFlow_IDPServiceError() {
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testBundleFlowID).Return(s.newStoredFlow(), nil)
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testSourceIDPID).
+		Return(nil, &serviceerror.InternalServerError)
+
+	bundle, err := s.service.ExportFlow(context.Background(), testBundleFlowID)
+
+	s.Nil(bundle)
+	s.Equal(&serviceerror.InternalServerError, err)
+}
+
+// ImportFlow tests
+
+func (s *FlowBundleTestSuite) TestImportFlow_InvalidContent() {
+	testCases := []struct {
+		name    string
+		request *FlowImportRequest
+	}{
+		{name: "NilRequest", request: nil},
+		{name: "EmptyContent", request: &FlowImportRequest{Content: "  "}},
+		{name: "MalformedContent", request: &FlowImportRequest{Content: "flow: [unclosed"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result, err := s.service.ImportFlow(context.Background(), tc.request)
+
+			s.Nil(result)
+			s.Equal(&ErrorInvalidFlowBundle, err)
+		})
+	}
+}
+
+func (s *FlowBundleTestSuite) TestImportFlow_CreatesFlowWithIDPMatchedByName() {
+	s.mockIDPService.EXPECT().GetIdentityProviderByName(mock.Anything, testBundleIDPName).
+		Return(&idp.IDPDTO{ID: testTargetIDPID, Name: testBundleIDPName}, nil)
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testTargetIDPID).
+		Return(&idp.IDPDTO{ID: testTargetIDPID}, nil)
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testBundleExecutor).Return(true)
+	s.mockGraphBuilder.EXPECT().BuildGraph(mock.Anything).Return(nil, nil)
+	s.mockStore.EXPECT().GetFlowByHandle(mock.Anything, "google-login", common.FlowTypeAuthentication).
+		Return(nil, errFlowNotFound)
+	s.mockStore.EXPECT().IsFlowExistsByHandle(mock.Anything, "google-login", common.FlowTypeAuthentication).
+		Return(false, nil)
+	s.mockStore.EXPECT().CreateFlow(mock.Anything, mock.Anything, mock.MatchedBy(func(flowDef *FlowDefinition) bool {
+		return flowDef.Nodes[1].Properties["idpId"] == testTargetIDPID
+	})).Return(&CompleteFlowDefinition{ID: testBundleFlowID}, nil)
+
+	result, err := s.service.ImportFlow(context.Background(), &FlowImportRequest{Content: testFlowBundle})
+
+	s.Nil(err)
+	s.Equal(importOperationCreate, result.Operation)
+	s.Equal(testBundleFlowID, result.Flow.ID)
+}
+
+func (s *FlowBundleTestSuite) TestImportFlow_UpdatesFlowWithMappedIDP() {
+	existingFlow := s.newStoredFlow()
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testTargetIDPID).
+		Return(&idp.IDPDTO{ID: testTargetIDPID}, nil)
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testBundleExecutor).Return(true)
+	s.mockGraphBuilder.EXPECT().BuildGraph(mock.Anything).Return(nil, nil)
+	s.mockGraphBuilder.EXPECT().InvalidateCache(mock.Anything, testBundleFlowID).Return()
+	s.mockStore.EXPECT().GetFlowByHandle(mock.Anything, "google-login", common.FlowTypeAuthentication).
+		Return(existingFlow, nil)
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testBundleFlowID).Return(existingFlow, nil)
+	s.mockStore.EXPECT().UpdateFlow(mock.Anything, testBundleFlowID, mock.MatchedBy(func(flowDef *FlowDefinition) bool {
+		return flowDef.Nodes[1].Properties["idpId"] == testTargetIDPID
+	})).Return(&CompleteFlowDefinition{ID: testBundleFlowID, ActiveVersion: 4}, nil)
+
+	result, err := s.service.ImportFlow(context.Background(), &FlowImportRequest{
+		Content:     testFlowBundle,
+		IDPMappings: map[string]string{testSourceIDPID: testTargetIDPID},
+	})
+
+	s.Nil(err)
+	s.Equal(importOperationUpdate, result.Operation)
+	s.Equal(4, result.Flow.ActiveVersion)
+	s.mockIDPService.AssertNotCalled(s.T(), "GetIdentityProviderByName", mock.Anything, mock.Anything)
+}
+
+func (s *FlowBundleTestSuite) TestImportFlow_UnresolvedIDPReference() {
+	testCases := []struct {
+		name        string
+		idpMappings map[string]string
+		setup       func()
+	}{
+		{
+			name: "NoMatchingIDP",
+			setup: func() {
+				s.mockIDPService.EXPECT().GetIdentityProviderByName(mock.Anything, testBundleIDPName).
+					Return(nil, &idp.ErrorIDPNotFound).Once()
+				s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testSourceIDPID).
+					Return(nil, &idp.ErrorIDPNotFound).Once()
+			},
+		},
+		{
+			name:        "MappedIDPNotFound",
+			idpMappings: map[string]string{testSourceIDPID: "unknown-idp-id"},
+			setup: func() {
+				s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, "unknown-idp-id").
+					Return(nil, &idp.ErrorIDPNotFound).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := s.service.ImportFlow(context.Background(), &FlowImportRequest{
+				Content:     testFlowBundle,
+				IDPMappings: tc.idpMappings,
+			})
+
+			s.Nil(result)
+			s.Require().NotNil(err)
+			s.Equal(ErrorUnresolvedIDPReference.Code, err.Code)
+			s.Contains(err.ErrorDescription.DefaultValue, testSourceIDPID)
+		})
+	}
+}
+
+func (s *FlowBundleTestSuite) TestImportFlow_InvalidFlow() {
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testTargetIDPID).
+		Return(&idp.IDPDTO{ID: testTargetIDPID}, nil)
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testBundleExecutor).Return(false)
+
+	result, err := s.service.ImportFlow(context.Background(), &FlowImportRequest{
+		Content:     testFlowBundle,
+		IDPMappings: map[string]string{testSourceIDPID: testTargetIDPID},
+	})
+
+	s.Nil(result)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidFlowData.Code, err.Code)
+	s.Equal("Executor GoogleOIDCAuthExecutor of node google is not registered", err.ErrorDescription.DefaultValue)
+	s.mockStore.AssertNotCalled(s.T(), "CreateFlow", mock.Anything, mock.Anything, mock.Anything)
+}
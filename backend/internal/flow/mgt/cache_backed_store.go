@@ -191,6 +191,24 @@ func (s *cacheBackedFlowStore) RestoreFlowVersion(ctx context.Context, flowID st
 	return restoredFlow, nil
 }
 
+// GetFlowDraft retrieves the draft of a flow.
+// Note: Drafts are not cached as they are only read by management operations.
+func (s *cacheBackedFlowStore) GetFlowDraft(ctx context.Context, flowID string) (*FlowDraft, error) {
+	return s.store.GetFlowDraft(ctx, flowID)
+}
+
+// SaveFlowDraft creates or replaces the draft of a flow.
+// The cached flow is left untouched since saving a draft does not change the active version.
+func (s *cacheBackedFlowStore) SaveFlowDraft(ctx context.Context, flowID string, baseVersion int,
+	flow *FlowDefinition) (*FlowDraft, error) {
+	return s.store.SaveFlowDraft(ctx, flowID, baseVersion, flow)
+}
+
+// DeleteFlowDraft deletes the draft of a flow.
+func (s *cacheBackedFlowStore) DeleteFlowDraft(ctx context.Context, flowID string) error {
+	return s.store.DeleteFlowDraft(ctx, flowID)
+}
+
 // cacheFlow caches the flow definition by ID and by handle.
 func (s *cacheBackedFlowStore) cacheFlow(ctx context.Context, flow *CompleteFlowDefinition) {
 	if flow == nil {
@@ -613,6 +613,25 @@ func (s *CacheBackedFlowStoreTestSuite) TestRestoreFlowVersionError() {
 	s.False(ok)
 }
 
+func (s *CacheBackedFlowStoreTestSuite) TestSaveFlowDraftDoesNotTouchCache() {
+	flowDef := &FlowDefinition{Name: "Draft"}
+	draft := &FlowDraft{ID: "flow-1", Name: "Draft", BaseVersion: 2}
+	s.mockStore.EXPECT().SaveFlowDraft(mock.Anything, "flow-1", 2, flowDef).Return(draft, nil)
+	s.mockStore.EXPECT().GetFlowDraft(mock.Anything, "flow-1").Return(draft, nil)
+	s.mockStore.EXPECT().DeleteFlowDraft(mock.Anything, "flow-1").Return(nil)
+
+	saved, err := s.cachedStore.SaveFlowDraft(context.Background(), "flow-1", 2, flowDef)
+	s.NoError(err)
+	s.Equal(draft, saved)
+
+	result, err := s.cachedStore.GetFlowDraft(context.Background(), "flow-1")
+	s.NoError(err)
+	s.Equal(draft, result)
+
+	s.NoError(s.cachedStore.DeleteFlowDraft(context.Background(), "flow-1"))
+	s.Empty(s.cacheData)
+}
+
 func (s *CacheBackedFlowStoreTestSuite) TestCacheFlowNil() {
 	s.cachedStore.cacheFlow(context.Background(), nil)
 
@@ -157,6 +157,22 @@ func (c *compositeFlowStore) RestoreFlowVersion(ctx context.Context, flowID stri
 	return c.dbStore.RestoreFlowVersion(ctx, flowID, version)
 }
 
+// GetFlowDraft retrieves a flow draft from the database store only.
+func (c *compositeFlowStore) GetFlowDraft(ctx context.Context, flowID string) (*FlowDraft, error) {
+	return c.dbStore.GetFlowDraft(ctx, flowID)
+}
+
+// SaveFlowDraft saves a flow draft in the database store only.
+func (c *compositeFlowStore) SaveFlowDraft(ctx context.Context, flowID string, baseVersion int,
+	flow *FlowDefinition) (*FlowDraft, error) {
+	return c.dbStore.SaveFlowDraft(ctx, flowID, baseVersion, flow)
+}
+
+// DeleteFlowDraft deletes a flow draft from the database store only.
+func (c *compositeFlowStore) DeleteFlowDraft(ctx context.Context, flowID string) error {
+	return c.dbStore.DeleteFlowDraft(ctx, flowID)
+}
+
 // IsFlowExistsByHandle checks if a flow exists by handle in either store.
 func (c *compositeFlowStore) IsFlowExistsByHandle(ctx context.Context, handle string,
 	flowType common.FlowType) (bool, error) {
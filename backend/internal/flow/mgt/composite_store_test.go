@@ -512,6 +512,26 @@ func (s *CompositeStoreTestSuite) TestRestoreFlowVersion_Error() {
 	s.Nil(result)
 }
 
+func (s *CompositeStoreTestSuite) TestFlowDraftOperations_UseDBStore() {
+	flowDef := &FlowDefinition{Handle: testFlowHandle, Name: "Test Flow", FlowType: common.FlowTypeAuthentication}
+	draft := &FlowDraft{ID: testFlowID, Name: "Test Flow", BaseVersion: 1}
+
+	s.mockDBStore.EXPECT().SaveFlowDraft(mock.Anything, testFlowID, 1, flowDef).Return(draft, nil).Once()
+	s.mockDBStore.EXPECT().GetFlowDraft(mock.Anything, testFlowID).Return(draft, nil).Once()
+	s.mockDBStore.EXPECT().DeleteFlowDraft(mock.Anything, testFlowID).Return(nil).Once()
+
+	saved, err := s.compositeStore.SaveFlowDraft(context.Background(), testFlowID, 1, flowDef)
+	s.NoError(err)
+	s.Equal(draft, saved)
+
+	result, err := s.compositeStore.GetFlowDraft(context.Background(), testFlowID)
+	s.NoError(err)
+	s.Equal(draft, result)
+
+	s.NoError(s.compositeStore.DeleteFlowDraft(context.Background(), testFlowID))
+	s.mockFileStore.AssertNotCalled(s.T(), "GetFlowDraft")
+}
+
 // Write operation error tests
 func (s *CompositeStoreTestSuite) TestCreateFlow_Error() {
 	flowDef := &FlowDefinition{
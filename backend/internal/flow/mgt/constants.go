@@ -48,6 +48,35 @@ const (
 	defaultNodeYPos = 0
 )
 
+// Flow validation diagnostic codes
+const (
+	diagnosticCodeGraphBuildFailure    = "GRAPH_BUILD_FAILURE"
+	diagnosticCodeDuplicateNodeID      = "DUPLICATE_NODE_ID"
+	diagnosticCodeMissingStartNode     = "MISSING_START_NODE"
+	diagnosticCodeMultipleStartNodes   = "MULTIPLE_START_NODES"
+	diagnosticCodeUnknownNodeReference = "UNKNOWN_NODE_REFERENCE"
+	diagnosticCodeMissingExecutor      = "MISSING_EXECUTOR"
+	diagnosticCodeUnknownExecutor      = "UNKNOWN_EXECUTOR"
+	diagnosticCodeUnknownIDP           = "UNKNOWN_IDP"
+	diagnosticCodeUnreachableNode      = "UNREACHABLE_NODE"
+	diagnosticCodeCycle                = "CYCLE_WITHOUT_PROMPT"
+)
+
+// Flow import operations
+const (
+	importOperationCreate = "create"
+	importOperationUpdate = "update"
+)
+
+// Flow export formats
+const (
+	exportFormatJSON = "json"
+	exportFormatYAML = "yaml"
+)
+
+// nodePropertyIDPID is the node property that refers to an identity provider.
+const nodePropertyIDPID = "idpId"
+
 // authToRegLabelTerms maps authentication UI label terms to their registration equivalents.
 // Ordered by specificity (longest/most-specific first) to avoid partial matches.
 var authToRegLabelTerms = []struct{ auth, reg string }{
@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// GetFlowDraft retrieves the unpublished draft of a flow definition.
+func (s *flowMgtService) GetFlowDraft(ctx context.Context, flowID string) (
+	*FlowDraft, *serviceerror.ServiceError) {
+	if flowID == "" {
+		return nil, &ErrorMissingFlowID
+	}
+
+	logger := s.logger.With(log.String(logKeyFlowID, flowID))
+
+	if _, err := s.store.GetFlowByID(ctx, flowID); err != nil {
+		if errors.Is(err, errFlowNotFound) {
+			return nil, &ErrorFlowNotFound
+		}
+		logger.Error("Failed to get existing flow", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	draft, err := s.store.GetFlowDraft(ctx, flowID)
+	if err != nil {
+		if errors.Is(err, errDraftNotFound) {
+			return nil, &ErrorDraftNotFound
+		}
+		logger.Error("Failed to get flow draft", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return draft, nil
+}
+
+// SaveFlowDraft creates or replaces the draft of a flow definition. The active version keeps serving flow
+// executions until the draft is published.
+func (s *flowMgtService) SaveFlowDraft(ctx context.Context, flowID string, flowDef *FlowDefinition) (
+	*FlowDraft, *serviceerror.ServiceError) {
+	if flowID == "" {
+		return nil, &ErrorMissingFlowID
+	}
+	if err := validateFlowDefinition(flowDef); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(log.String(logKeyFlowID, flowID))
+
+	var savedDraft *FlowDraft
+	var validationSvcErr *serviceerror.ServiceError
+	txErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existingFlow, err := s.store.GetFlowByID(txCtx, flowID)
+		if err != nil {
+			return err
+		}
+
+		if existingFlow.IsReadOnly {
+			validationSvcErr = &ErrorFlowDeclarativeReadOnly
+			return errClientValidation
+		}
+		if existingFlow.FlowType != flowDef.FlowType {
+			validationSvcErr = &ErrorCannotUpdateFlowType
+			return errClientValidation
+		}
+		if existingFlow.Handle != flowDef.Handle {
+			validationSvcErr = &ErrorHandleUpdateNotAllowed
+			return errClientValidation
+		}
+
+		var saveErr error
+		savedDraft, saveErr = s.store.SaveFlowDraft(txCtx, flowID, existingFlow.ActiveVersion, flowDef)
+		return saveErr
+	})
+	if txErr != nil {
+		if errors.Is(txErr, errClientValidation) {
+			return nil, validationSvcErr
+		}
+		if errors.Is(txErr, errFlowNotFound) {
+			return nil, &ErrorFlowNotFound
+		}
+		logger.Error("Failed to save flow draft", log.Error(txErr))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Flow draft saved successfully")
+
+	return savedDraft, nil
+}
+
+// DeleteFlowDraft discards the draft of a flow definition. The active version is not affected.
+func (s *flowMgtService) DeleteFlowDraft(ctx context.Context, flowID string) *serviceerror.ServiceError {
+	if flowID == "" {
+		return &ErrorMissingFlowID
+	}
+
+	logger := s.logger.With(log.String(logKeyFlowID, flowID))
+
+	if err := s.store.DeleteFlowDraft(ctx, flowID); err != nil {
+		logger.Error("Failed to delete flow draft", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Flow draft deleted successfully")
+
+	return nil
+}
+
+// PublishFlowDraft publishes the draft of a flow definition as a new active version and discards the draft.
+// The draft must pass validation so that a broken definition never starts serving flow executions, and must be
+// based on the active version so that updates made to the flow after the draft was created are not overwritten.
+func (s *flowMgtService) PublishFlowDraft(ctx context.Context, flowID string) (
+	*CompleteFlowDefinition, *serviceerror.ServiceError) {
+	draft, svcErr := s.GetFlowDraft(ctx, flowID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	logger := s.logger.With(log.String(logKeyFlowID, flowID))
+
+	flowDef := &FlowDefinition{
+		Handle:   draft.Handle,
+		Name:     draft.Name,
+		FlowType: draft.FlowType,
+		Nodes:    draft.Nodes,
+	}
+	result, svcErr := s.ValidateFlow(ctx, flowDef)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	for _, diagnostic := range result.Diagnostics {
+		if diagnostic.Severity == DiagnosticSeverityError {
+			return nil, serviceerror.CustomServiceError(ErrorInvalidFlowData, i18ncore.I18nMessage{
+				Key:          "error.flowmgtservice.invalid_draft_flow_description",
+				DefaultValue: diagnostic.Message,
+			})
+		}
+	}
+
+	var publishedFlow *CompleteFlowDefinition
+	txErr := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		currentFlow, err := s.store.GetFlowByID(txCtx, flowID)
+		if err != nil {
+			return err
+		}
+		if currentFlow.ActiveVersion != draft.BaseVersion {
+			return errClientValidation
+		}
+
+		publishedFlow, err = s.store.UpdateFlow(txCtx, flowID, flowDef)
+		if err != nil {
+			return err
+		}
+		return s.store.DeleteFlowDraft(txCtx, flowID)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, errClientValidation) {
+			logger.Debug("Flow was updated after the draft was created", log.Int(logKeyVersion, draft.BaseVersion))
+			return nil, &ErrorDraftOutdated
+		}
+		if errors.Is(txErr, errFlowNotFound) {
+			return nil, &ErrorFlowNotFound
+		}
+		logger.Error("Failed to publish flow draft", log.Error(txErr))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Flow draft published successfully", log.Int(logKeyVersion, publishedFlow.ActiveVersion))
+
+	// Invalidate the cached graph so that executions pick up the published version
+	s.graphBuilder.InvalidateCache(ctx, flowID)
+
+	return publishedFlow, nil
+}
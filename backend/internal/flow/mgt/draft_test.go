@@ -0,0 +1,352 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/executormock"
+)
+
+const (
+	testDraftFlowID   = "draft-flow-id"
+	testDraftExecutor = "BasicAuthExecutor"
+)
+
+type FlowDraftTestSuite struct {
+	suite.Suite
+	service              FlowMgtServiceInterface
+	mockStore            *flowStoreInterfaceMock
+	mockGraphBuilder     *graphBuilderInterfaceMock
+	mockExecutorRegistry *executormock.ExecutorRegistryInterfaceMock
+}
+
+func TestFlowDraftTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowDraftTestSuite))
+}
+
+func (s *FlowDraftTestSuite) SetupTest() {
+	s.mockStore = newFlowStoreInterfaceMock(s.T())
+	s.mockGraphBuilder = newGraphBuilderInterfaceMock(s.T())
+	s.mockExecutorRegistry = executormock.NewExecutorRegistryInterfaceMock(s.T())
+	s.service = newFlowMgtService(s.mockStore, newFlowInferenceServiceInterfaceMock(s.T()), s.mockGraphBuilder,
+		s.mockExecutorRegistry, nil, nil, &stubTransactioner{})
+
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{})
+}
+
+func (s *FlowDraftTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *FlowDraftTestSuite) newFlowDefinition() *FlowDefinition {
+	return &FlowDefinition{
+		Handle:   "basic-login",
+		Name:     "Basic Login",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes: []NodeDefinition{
+			{ID: "start", Type: "START", OnSuccess: "basic"},
+			{ID: "basic", Type: "TASK_EXECUTION", Executor: &ExecutorDefinition{Name: testDraftExecutor},
+				OnSuccess: "end"},
+			{ID: "end", Type: "END"},
+		},
+	}
+}
+
+func (s *FlowDraftTestSuite) newStoredFlow() *CompleteFlowDefinition {
+	flowDef := s.newFlowDefinition()
+	return &CompleteFlowDefinition{
+		ID:            testDraftFlowID,
+		Handle:        flowDef.Handle,
+		Name:          flowDef.Name,
+		FlowType:      flowDef.FlowType,
+		Nodes:         flowDef.Nodes,
+		ActiveVersion: 3,
+	}
+}
+
+func (s *FlowDraftTestSuite) newStoredDraft() *FlowDraft {
+	flowDef := s.newFlowDefinition()
+	return &FlowDraft{
+		ID:          testDraftFlowID,
+		Handle:      flowDef.Handle,
+		Name:        "Basic Login Draft",
+		FlowType:    flowDef.FlowType,
+		BaseVersion: 3,
+		Nodes:       flowDef.Nodes,
+	}
+}
+
+// GetFlowDraft tests
+
+func (s *FlowDraftTestSuite) TestGetFlowDraft_Success() {
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(s.newStoredFlow(), nil)
+	s.mockStore.EXPECT().GetFlowDraft(mock.Anything, testDraftFlowID).Return(s.newStoredDraft(), nil)
+
+	draft, err := s.service.GetFlowDraft(context.Background(), testDraftFlowID)
+
+	s.Nil(err)
+	s.Equal("Basic Login Draft", draft.Name)
+	s.Equal(3, draft.BaseVersion)
+}
+
+func (s *FlowDraftTestSuite) TestGetFlowDraft_Errors() {
+	testCases := []struct {
+		name        string
+		flowID      string
+		setup       func()
+		expectedErr *serviceerror.ServiceError
+	}{
+		{
+			name:        "missing flow ID",
+			flowID:      "",
+			setup:       func() {},
+			expectedErr: &ErrorMissingFlowID,
+		},
+		{
+			name:   "flow not found",
+			flowID: testDraftFlowID,
+			setup: func() {
+				s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(nil, errFlowNotFound)
+			},
+			expectedErr: &ErrorFlowNotFound,
+		},
+		{
+			name:   "draft not found",
+			flowID: testDraftFlowID,
+			setup: func() {
+				s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(s.newStoredFlow(), nil)
+				s.mockStore.EXPECT().GetFlowDraft(mock.Anything, testDraftFlowID).Return(nil, errDraftNotFound)
+			},
+			expectedErr: &ErrorDraftNotFound,
+		},
+		{
+			name:   "store error",
+			flowID: testDraftFlowID,
+			setup: func() {
+				s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(s.newStoredFlow(), nil)
+				s.mockStore.EXPECT().GetFlowDraft(mock.Anything, testDraftFlowID).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: &serviceerror.InternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.setup()
+
+			draft, err := s.service.GetFlowDraft(context.Background(), tc.flowID)
+
+			s.Nil(draft)
+			s.Equal(tc.expectedErr, err)
+		})
+	}
+}
+
+// SaveFlowDraft tests
+
+func (s *FlowDraftTestSuite) TestSaveFlowDraft_Success() {
+	flowDef := s.newFlowDefinition()
+	flowDef.Name = "Basic Login Draft"
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(s.newStoredFlow(), nil)
+	s.mockStore.EXPECT().SaveFlowDraft(mock.Anything, testDraftFlowID, 3, flowDef).Return(s.newStoredDraft(), nil)
+
+	draft, err := s.service.SaveFlowDraft(context.Background(), testDraftFlowID, flowDef)
+
+	s.Nil(err)
+	s.Equal(3, draft.BaseVersion)
+	s.mockStore.AssertNotCalled(s.T(), "UpdateFlow", mock.Anything, mock.Anything, mock.Anything)
+	s.mockGraphBuilder.AssertNotCalled(s.T(), "InvalidateCache", mock.Anything, mock.Anything)
+}
+
+func (s *FlowDraftTestSuite) TestSaveFlowDraft_Errors() {
+	testCases := []struct {
+		name        string
+		modify      func(flowDef *FlowDefinition)
+		storedFlow  func() *CompleteFlowDefinition
+		storeErr    error
+		expectedErr *serviceerror.ServiceError
+	}{
+		{
+			name:        "flow not found",
+			modify:      func(flowDef *FlowDefinition) {},
+			storedFlow:  func() *CompleteFlowDefinition { return nil },
+			storeErr:    errFlowNotFound,
+			expectedErr: &ErrorFlowNotFound,
+		},
+		{
+			name:   "declarative flow",
+			modify: func(flowDef *FlowDefinition) {},
+			storedFlow: func() *CompleteFlowDefinition {
+				flow := s.newStoredFlow()
+				flow.IsReadOnly = true
+				return flow
+			},
+			expectedErr: &ErrorFlowDeclarativeReadOnly,
+		},
+		{
+			name:        "flow type changed",
+			modify:      func(flowDef *FlowDefinition) { flowDef.FlowType = common.FlowTypeRegistration },
+			storedFlow:  s.newStoredFlow,
+			expectedErr: &ErrorCannotUpdateFlowType,
+		},
+		{
+			name:        "handle changed",
+			modify:      func(flowDef *FlowDefinition) { flowDef.Handle = "other-login" },
+			storedFlow:  s.newStoredFlow,
+			expectedErr: &ErrorHandleUpdateNotAllowed,
+		},
+		{
+			name:        "store error",
+			modify:      func(flowDef *FlowDefinition) {},
+			storedFlow:  func() *CompleteFlowDefinition { return nil },
+			storeErr:    errors.New("db error"),
+			expectedErr: &serviceerror.InternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			flowDef := s.newFlowDefinition()
+			tc.modify(flowDef)
+			s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(tc.storedFlow(), tc.storeErr)
+
+			draft, err := s.service.SaveFlowDraft(context.Background(), testDraftFlowID, flowDef)
+
+			s.Nil(draft)
+			s.Equal(tc.expectedErr, err)
+		})
+	}
+}
+
+func (s *FlowDraftTestSuite) TestSaveFlowDraft_InvalidDefinition() {
+	flowDef := s.newFlowDefinition()
+	flowDef.Name = ""
+
+	draft, err := s.service.SaveFlowDraft(context.Background(), testDraftFlowID, flowDef)
+
+	s.Nil(draft)
+	s.Equal(&ErrorMissingFlowName, err)
+}
+
+// DeleteFlowDraft tests
+
+func (s *FlowDraftTestSuite) TestDeleteFlowDraft_Success() {
+	s.mockStore.EXPECT().DeleteFlowDraft(mock.Anything, testDraftFlowID).Return(nil)
+
+	err := s.service.DeleteFlowDraft(context.Background(), testDraftFlowID)
+
+	s.Nil(err)
+}
+
+func (s *FlowDraftTestSuite) TestDeleteFlowDraft_Errors() {
+	s.Equal(&ErrorMissingFlowID, s.service.DeleteFlowDraft(context.Background(), ""))
+
+	s.mockStore.EXPECT().DeleteFlowDraft(mock.Anything, testDraftFlowID).Return(errors.New("db error"))
+	s.Equal(&serviceerror.InternalServerError, s.service.DeleteFlowDraft(context.Background(), testDraftFlowID))
+}
+
+// PublishFlowDraft tests
+
+func (s *FlowDraftTestSuite) TestPublishFlowDraft_Success() {
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(s.newStoredFlow(), nil)
+	s.mockStore.EXPECT().GetFlowDraft(mock.Anything, testDraftFlowID).Return(s.newStoredDraft(), nil)
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testDraftExecutor).Return(true)
+	s.mockGraphBuilder.EXPECT().BuildGraph(mock.Anything).Return(nil, nil)
+	s.mockStore.EXPECT().UpdateFlow(mock.Anything, testDraftFlowID, mock.MatchedBy(func(flowDef *FlowDefinition) bool {
+		return flowDef.Name == "Basic Login Draft" && flowDef.Handle == "basic-login"
+	})).Return(&CompleteFlowDefinition{ID: testDraftFlowID, ActiveVersion: 4}, nil)
+	s.mockStore.EXPECT().DeleteFlowDraft(mock.Anything, testDraftFlowID).Return(nil)
+	s.mockGraphBuilder.EXPECT().InvalidateCache(mock.Anything, testDraftFlowID).Return()
+
+	flow, err := s.service.PublishFlowDraft(context.Background(), testDraftFlowID)
+
+	s.Nil(err)
+	s.Equal(4, flow.ActiveVersion)
+}
+
+func (s *FlowDraftTestSuite) TestPublishFlowDraft_DraftNotFound() {
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(s.newStoredFlow(), nil)
+	s.mockStore.EXPECT().GetFlowDraft(mock.Anything, testDraftFlowID).Return(nil, errDraftNotFound)
+
+	flow, err := s.service.PublishFlowDraft(context.Background(), testDraftFlowID)
+
+	s.Nil(flow)
+	s.Equal(&ErrorDraftNotFound, err)
+}
+
+func (s *FlowDraftTestSuite) TestPublishFlowDraft_InvalidDraft() {
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(s.newStoredFlow(), nil)
+	s.mockStore.EXPECT().GetFlowDraft(mock.Anything, testDraftFlowID).Return(s.newStoredDraft(), nil)
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testDraftExecutor).Return(false)
+
+	flow, err := s.service.PublishFlowDraft(context.Background(), testDraftFlowID)
+
+	s.Nil(flow)
+	s.NotNil(err)
+	s.Equal(ErrorInvalidFlowData.Code, err.Code)
+	s.Equal("error.flowmgtservice.invalid_draft_flow_description", err.ErrorDescription.Key)
+	s.mockStore.AssertNotCalled(s.T(), "UpdateFlow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *FlowDraftTestSuite) TestPublishFlowDraft_FlowUpdatedAfterDraft() {
+	updatedFlow := s.newStoredFlow()
+	updatedFlow.ActiveVersion = 4
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(s.newStoredFlow(), nil).Once()
+	s.mockStore.EXPECT().GetFlowDraft(mock.Anything, testDraftFlowID).Return(s.newStoredDraft(), nil)
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testDraftExecutor).Return(true)
+	s.mockGraphBuilder.EXPECT().BuildGraph(mock.Anything).Return(nil, nil)
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(updatedFlow, nil).Once()
+
+	flow, err := s.service.PublishFlowDraft(context.Background(), testDraftFlowID)
+
+	s.Nil(flow)
+	s.Equal(&ErrorDraftOutdated, err)
+	s.mockStore.AssertNotCalled(s.T(), "UpdateFlow", mock.Anything, mock.Anything, mock.Anything)
+	s.mockStore.AssertNotCalled(s.T(), "DeleteFlowDraft", mock.Anything, mock.Anything)
+	s.mockGraphBuilder.AssertNotCalled(s.T(), "InvalidateCache", mock.Anything, mock.Anything)
+}
+
+func (s *FlowDraftTestSuite) TestPublishFlowDraft_StoreError() {
+	s.mockStore.EXPECT().GetFlowByID(mock.Anything, testDraftFlowID).Return(s.newStoredFlow(), nil)
+	s.mockStore.EXPECT().GetFlowDraft(mock.Anything, testDraftFlowID).Return(s.newStoredDraft(), nil)
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testDraftExecutor).Return(true)
+	s.mockGraphBuilder.EXPECT().BuildGraph(mock.Anything).Return(nil, nil)
+	s.mockStore.EXPECT().UpdateFlow(mock.Anything, testDraftFlowID, mock.Anything).
+		Return(&CompleteFlowDefinition{ID: testDraftFlowID, ActiveVersion: 4}, nil)
+	s.mockStore.EXPECT().DeleteFlowDraft(mock.Anything, testDraftFlowID).Return(errors.New("db error"))
+
+	flow, err := s.service.PublishFlowDraft(context.Background(), testDraftFlowID)
+
+	s.Nil(flow)
+	s.Equal(&serviceerror.InternalServerError, err)
+	s.mockGraphBuilder.AssertNotCalled(s.T(), "InvalidateCache", mock.Anything, mock.Anything)
+}
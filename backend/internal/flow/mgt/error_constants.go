@@ -280,10 +280,82 @@ var (
 			DefaultValue: "Flow ID already exists",
 		},
 	}
+
+	// ErrorInvalidFlowBundle is the error returned when a flow bundle cannot be parsed.
+	ErrorInvalidFlowBundle = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FLM-1020",
+		Error: core.I18nMessage{
+			Key:          "error.flowmgtservice.invalid_flow_bundle",
+			DefaultValue: "Invalid flow bundle",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.flowmgtservice.invalid_flow_bundle_description",
+			DefaultValue: "The flow bundle must be a JSON or YAML document",
+		},
+	}
+
+	// ErrorUnresolvedIDPReference is the error returned when an identity provider referred to by an imported
+	// flow cannot be matched to an identity provider in this environment.
+	ErrorUnresolvedIDPReference = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FLM-1021",
+		Error: core.I18nMessage{
+			Key:          "error.flowmgtservice.unresolved_idp_reference",
+			DefaultValue: "Unresolved identity provider reference",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.flowmgtservice.unresolved_idp_reference_description",
+			DefaultValue: "An identity provider referred to by the flow does not exist in this environment",
+		},
+	}
+
+	// ErrorInvalidExportFormat is the error returned when the requested export format is not supported.
+	ErrorInvalidExportFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FLM-1022",
+		Error: core.I18nMessage{
+			Key:          "error.flowmgtservice.invalid_export_format",
+			DefaultValue: "Invalid export format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.flowmgtservice.invalid_export_format_description",
+			DefaultValue: "The export format must be either json or yaml",
+		},
+	}
+
+	// ErrorDraftNotFound is the error returned when a flow does not have a draft.
+	ErrorDraftNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FLM-1023",
+		Error: core.I18nMessage{
+			Key:          "error.flowmgtservice.flow_draft_not_found",
+			DefaultValue: "Flow draft not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.flowmgtservice.flow_draft_not_found_description",
+			DefaultValue: "The flow does not have an unpublished draft",
+		},
+	}
+
+	// ErrorDraftOutdated is the error returned when a draft is published after the flow was updated.
+	ErrorDraftOutdated = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FLM-1024",
+		Error: core.I18nMessage{
+			Key:          "error.flowmgtservice.flow_draft_outdated",
+			DefaultValue: "Flow draft is outdated",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.flowmgtservice.flow_draft_outdated_description",
+			DefaultValue: "The flow was updated after the draft was created",
+		},
+	}
 )
 
 // Internal errors
 var (
 	errFlowNotFound    = errors.New("flow not found")
 	errVersionNotFound = errors.New("version not found")
+	errDraftNotFound   = errors.New("draft not found")
 )
@@ -166,6 +166,22 @@ func (f *fileBasedStore) RestoreFlowVersion(_ context.Context, flowID string, ve
 	return nil, errors.New("RestoreFlowVersion is not supported in file-based store")
 }
 
+// GetFlowDraft implements flowStoreInterface.
+func (f *fileBasedStore) GetFlowDraft(_ context.Context, flowID string) (*FlowDraft, error) {
+	return nil, errors.New("GetFlowDraft is not supported in file-based store")
+}
+
+// SaveFlowDraft implements flowStoreInterface.
+func (f *fileBasedStore) SaveFlowDraft(_ context.Context, flowID string, baseVersion int,
+	flow *FlowDefinition) (*FlowDraft, error) {
+	return nil, errors.New("SaveFlowDraft is not supported in file-based store")
+}
+
+// DeleteFlowDraft implements flowStoreInterface.
+func (f *fileBasedStore) DeleteFlowDraft(_ context.Context, flowID string) error {
+	return errors.New("DeleteFlowDraft is not supported in file-based store")
+}
+
 // IsFlowExistsByHandle implements flowStoreInterface.
 func (f *fileBasedStore) IsFlowExistsByHandle(_ context.Context, handle string,
 	flowType common.FlowType) (bool, error) {
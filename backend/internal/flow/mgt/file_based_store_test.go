@@ -251,6 +251,17 @@ func (s *FileBasedStoreTestSuite) TestRestoreFlowVersion_NotSupported() {
 	assert.Contains(s.T(), err.Error(), "not supported in file-based store")
 }
 
+func (s *FileBasedStoreTestSuite) TestFlowDraftOperations_NotSupported() {
+	_, err := s.store.GetFlowDraft(context.Background(), "flow-001")
+	assert.ErrorContains(s.T(), err, "not supported in file-based store")
+
+	_, err = s.store.SaveFlowDraft(context.Background(), "flow-001", 1, &FlowDefinition{})
+	assert.ErrorContains(s.T(), err, "not supported in file-based store")
+
+	err = s.store.DeleteFlowDraft(context.Background(), "flow-001")
+	assert.ErrorContains(s.T(), err, "not supported in file-based store")
+}
+
 func (s *FileBasedStoreTestSuite) TestCreate_ImplementsStorer() {
 	completeFlow := &CompleteFlowDefinition{
 		ID:            "flow-001",
@@ -34,6 +34,7 @@ import (
 // graphBuilderInterface defines the interface for building flow graphs.
 type graphBuilderInterface interface {
 	GetGraph(ctx context.Context, flow *CompleteFlowDefinition) (core.GraphInterface, *serviceerror.ServiceError)
+	BuildGraph(flow *CompleteFlowDefinition) (core.GraphInterface, *serviceerror.ServiceError)
 	InvalidateCache(ctx context.Context, flowID string)
 }
 
@@ -62,6 +63,31 @@ func newGraphBuilder(
 // GetGraph retrieves a cached graph or builds a new one from the flow definition.
 func (b *graphBuilder) GetGraph(ctx context.Context, flow *CompleteFlowDefinition) (
 	core.GraphInterface, *serviceerror.ServiceError) {
+	if flow != nil && len(flow.Nodes) > 0 {
+		// Check cache first
+		if cachedGraph, ok := b.graphCache.Get(ctx, flow.ID); ok {
+			b.logger.Debug("Graph retrieved from cache", log.String("flowID", flow.ID))
+			return cachedGraph, nil
+		}
+	}
+
+	graph, svcErr := b.BuildGraph(flow)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	logger := b.logger.With(log.String("flowID", flow.ID))
+	// Cache the built graph
+	if cacheErr := b.graphCache.Set(ctx, flow.ID, graph); cacheErr != nil {
+		logger.Error("Failed to cache graph", log.Error(cacheErr))
+	}
+	logger.Debug("Graph built and cached successfully")
+
+	return graph, nil
+}
+
+// BuildGraph builds a new graph from the flow definition without consulting or updating the cache.
+func (b *graphBuilder) BuildGraph(flow *CompleteFlowDefinition) (core.GraphInterface, *serviceerror.ServiceError) {
 	if flow == nil || len(flow.Nodes) == 0 {
 		return nil, serviceerror.CustomServiceError(ErrorInvalidFlowData, i18ncore.I18nMessage{
 			Key:          "error.flowmgtservice.flow_definition_nil_or_empty_description",
@@ -69,28 +95,15 @@ func (b *graphBuilder) GetGraph(ctx context.Context, flow *CompleteFlowDefinitio
 		})
 	}
 
-	logger := b.logger.With(log.String("flowID", flow.ID))
-	// Check cache first
-	if cachedGraph, ok := b.graphCache.Get(ctx, flow.ID); ok {
-		logger.Debug("Graph retrieved from cache")
-		return cachedGraph, nil
-	}
-
 	graph, err := b.buildGraph(flow)
 	if err != nil {
-		logger.Error("Failed to build graph", log.Error(err))
+		b.logger.Error("Failed to build graph", log.String("flowID", flow.ID), log.Error(err))
 		return nil, serviceerror.CustomServiceError(ErrorGraphBuildFailure, i18ncore.I18nMessage{
 			Key:          "error.flowmgtservice.graph_build_failure_description",
 			DefaultValue: err.Error(),
 		})
 	}
 
-	// Cache the built graph
-	if cacheErr := b.graphCache.Set(ctx, flow.ID, graph); cacheErr != nil {
-		logger.Error("Failed to cache graph", log.Error(cacheErr))
-	}
-	logger.Debug("Graph built and cached successfully")
-
 	return graph, nil
 }
 
@@ -143,6 +156,7 @@ func (b *graphBuilder) processNode(nodeDef *NodeDefinition, allNodes []NodeDefin
 	isFinalNode := nodeDef.OnSuccess == "" &&
 		nodeDef.OnFailure == "" &&
 		len(nodeDef.Prompts) == 0 &&
+		len(nodeDef.Branches) == 0 &&
 		nodeDef.Next == ""
 
 	// Construct a new node. Here we set isStartNode to false by default
@@ -160,6 +174,9 @@ func (b *graphBuilder) processNode(nodeDef *NodeDefinition, allNodes []NodeDefin
 	b.configureNodeMeta(nodeDef, node)
 	b.configureNodeVariant(nodeDef, node)
 	b.configureNodeCondition(nodeDef, node)
+	if err := b.configureNodeMaxAttempts(nodeDef, node); err != nil {
+		return err
+	}
 
 	if err := b.configureNodePrompts(nodeDef, node, edges); err != nil {
 		return err
@@ -167,6 +184,9 @@ func (b *graphBuilder) processNode(nodeDef *NodeDefinition, allNodes []NodeDefin
 	if err := b.configureDisplayOnlyProperties(nodeDef, node, edges, boundaries); err != nil {
 		return err
 	}
+	if err := b.configureNodeBranches(nodeDef, allNodes, node, edges); err != nil {
+		return err
+	}
 	if err := b.configureNodeExecutor(nodeDef, node); err != nil {
 		return err
 	}
@@ -318,6 +338,25 @@ func (b *graphBuilder) configureNodeCondition(nodeDef *NodeDefinition, node core
 	}
 }
 
+// configureNodeMaxAttempts configures the retry limit for executor-backed nodes.
+func (b *graphBuilder) configureNodeMaxAttempts(nodeDef *NodeDefinition, node core.NodeInterface) error {
+	if nodeDef.MaxAttempts == 0 {
+		return nil
+	}
+	if nodeDef.MaxAttempts < 0 {
+		return fmt.Errorf("'maxAttempts' of node %s must be a positive number", nodeDef.ID)
+	}
+
+	executorNode, ok := node.(core.ExecutorBackedNodeInterface)
+	if !ok {
+		return fmt.Errorf("'maxAttempts' field is only valid on TASK_EXECUTION nodes, but node %s is of type %s",
+			nodeDef.ID, nodeDef.Type)
+	}
+	executorNode.SetMaxAttempts(nodeDef.MaxAttempts)
+
+	return nil
+}
+
 // configureNodePrompts configures the prompts for a prompt node.
 func (b *graphBuilder) configureNodePrompts(nodeDef *NodeDefinition, node core.NodeInterface,
 	edges map[string][]string) error {
@@ -412,6 +451,138 @@ func (b *graphBuilder) configureDisplayOnlyProperties(nodeDef *NodeDefinition, n
 	return nil
 }
 
+// configureNodeBranches configures the branches for a decision or fork node.
+func (b *graphBuilder) configureNodeBranches(nodeDef *NodeDefinition, allNodes []NodeDefinition,
+	node core.NodeInterface, edges map[string][]string) error {
+	if forkNode, ok := node.(core.ForkNodeInterface); ok {
+		return b.configureForkBranches(nodeDef, allNodes, forkNode, edges)
+	}
+
+	decisionNode, ok := node.(core.DecisionNodeInterface)
+	if !ok {
+		if len(nodeDef.Branches) > 0 {
+			return fmt.Errorf("'branches' field is only valid on DECISION and FORK nodes, but node %s is of type %s",
+				nodeDef.ID, nodeDef.Type)
+		}
+		return nil
+	}
+
+	if len(nodeDef.Branches) == 0 {
+		return fmt.Errorf("decision node %s must define at least one branch", nodeDef.ID)
+	}
+
+	branches := make([]core.DecisionBranch, len(nodeDef.Branches))
+	for i, branchDef := range nodeDef.Branches {
+		if branchDef.Condition == nil || branchDef.Condition.Key == "" {
+			return fmt.Errorf("branch %d of decision node %s must define a condition key", i, nodeDef.ID)
+		}
+		if branchDef.Next == "" {
+			return fmt.Errorf("branch %d of decision node %s must define the next node", i, nodeDef.ID)
+		}
+		operator := common.ConditionOperator(branchDef.Condition.Operator)
+		if !core.IsValidConditionOperator(operator) {
+			return fmt.Errorf("branch %d of decision node %s has an unsupported operator %s",
+				i, nodeDef.ID, branchDef.Condition.Operator)
+		}
+
+		branches[i] = core.DecisionBranch{
+			Condition: core.BranchCondition{
+				Key:      branchDef.Condition.Key,
+				Operator: operator,
+				Value:    branchDef.Condition.Value,
+			},
+			NextNodeID: branchDef.Next,
+		}
+
+		if _, exists := edges[nodeDef.ID]; !exists {
+			edges[nodeDef.ID] = []string{}
+		}
+		edges[nodeDef.ID] = append(edges[nodeDef.ID], branchDef.Next)
+	}
+	decisionNode.SetBranches(branches)
+
+	return nil
+}
+
+// configureForkBranches configures the parallel branches for a fork node. Each branch must start with a
+// TASK_EXECUTION node, and the onSuccess node of the fork node must be the JOIN node where the branches end.
+func (b *graphBuilder) configureForkBranches(nodeDef *NodeDefinition, allNodes []NodeDefinition,
+	forkNode core.ForkNodeInterface, edges map[string][]string) error {
+	if len(nodeDef.Branches) < 2 {
+		return fmt.Errorf("fork node %s must define at least two branches", nodeDef.ID)
+	}
+	if nodeDef.OnSuccess == "" {
+		return fmt.Errorf("fork node %s must define the join node in onSuccess", nodeDef.ID)
+	}
+	if !hasNodeOfType(allNodes, nodeDef.OnSuccess, common.NodeTypeJoin) {
+		return fmt.Errorf("onSuccess of fork node %s must refer to a JOIN node", nodeDef.ID)
+	}
+
+	branches := make([]string, len(nodeDef.Branches))
+	for i, branchDef := range nodeDef.Branches {
+		if branchDef.Condition != nil {
+			return fmt.Errorf("branch %d of fork node %s must not define a condition", i, nodeDef.ID)
+		}
+		if branchDef.Next == "" {
+			return fmt.Errorf("branch %d of fork node %s must define the next node", i, nodeDef.ID)
+		}
+		if !hasNodeOfType(allNodes, branchDef.Next, common.NodeTypeTaskExecution) {
+			return fmt.Errorf("branch %d of fork node %s must start with a TASK_EXECUTION node", i, nodeDef.ID)
+		}
+		if err := validateForkBranchNodes(allNodes, nodeDef.ID, branchDef.Next, nodeDef.OnSuccess); err != nil {
+			return err
+		}
+
+		branches[i] = branchDef.Next
+		edges[nodeDef.ID] = append(edges[nodeDef.ID], branchDef.Next)
+	}
+	forkNode.SetBranches(branches)
+
+	return nil
+}
+
+// validateForkBranchNodes checks the TASK_EXECUTION nodes a parallel branch runs before reaching the join
+// node. Nodes in parallel branches cannot prompt the user, so they must not define a retry limit.
+func validateForkBranchNodes(nodes []NodeDefinition, forkNodeID, startNodeID, joinNodeID string) error {
+	nodesByID := make(map[string]*NodeDefinition, len(nodes))
+	for i := range nodes {
+		nodesByID[nodes[i].ID] = &nodes[i]
+	}
+
+	visited := make(map[string]bool)
+	pending := []string{startNodeID}
+	for len(pending) > 0 {
+		nodeID := pending[0]
+		pending = pending[1:]
+		node, ok := nodesByID[nodeID]
+		if !ok || nodeID == joinNodeID || visited[nodeID] || node.Type != string(common.NodeTypeTaskExecution) {
+			continue
+		}
+		visited[nodeID] = true
+
+		if node.MaxAttempts != 0 {
+			return fmt.Errorf("node %s in a branch of fork node %s must not define 'maxAttempts' since "+
+				"nodes in parallel branches cannot prompt the user", nodeID, forkNodeID)
+		}
+		pending = append(pending, node.OnSuccess)
+		if node.Condition != nil {
+			pending = append(pending, node.Condition.OnSkip)
+		}
+	}
+
+	return nil
+}
+
+// hasNodeOfType checks whether a node with the given ID and type exists in the node definitions.
+func hasNodeOfType(nodes []NodeDefinition, nodeID string, nodeType common.NodeType) bool {
+	for _, node := range nodes {
+		if node.ID == nodeID {
+			return node.Type == string(nodeType)
+		}
+	}
+	return false
+}
+
 // computeSegments builds the segments slice from detected display-only prompt boundaries.
 // Segment 0 starts at the graph start node; each boundary yields a subsequent segment
 // starting at the boundary's next node.
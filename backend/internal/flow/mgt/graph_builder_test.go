@@ -28,6 +28,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
@@ -187,6 +188,43 @@ func (s *GraphBuilderTestSuite) TestGetGraph_BuildFailure() {
 	s.Contains(err.ErrorDescription.DefaultValue, "node creation error")
 }
 
+// Test BuildGraph method
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_NilFlow() {
+	graph, err := s.builder.BuildGraph(nil)
+
+	s.Nil(graph)
+	s.NotNil(err)
+	s.Equal(ErrorInvalidFlowData.Code, err.Code)
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_BypassesCache() {
+	flow := &CompleteFlowDefinition{
+		ID:       "flow-1",
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes: []NodeDefinition{
+			{ID: "start", Type: "START"},
+		},
+	}
+
+	mockGraph := coremock.NewGraphInterfaceMock(s.T())
+	s.mockFlowFactory.EXPECT().CreateGraph("flow-1", common.FlowTypeAuthentication).Return(mockGraph)
+	s.mockFlowFactory.EXPECT().CreateNode(
+		"start", "START", map[string]interface{}(nil), false, true).Return(
+		nil, errors.New("node creation error"))
+
+	graph, err := s.builder.BuildGraph(flow)
+
+	s.Nil(graph)
+	s.NotNil(err)
+	s.Equal(ErrorGraphBuildFailure.Code, err.Code)
+	s.Contains(err.ErrorDescription.DefaultValue, "node creation error")
+	s.mockGraphCache.AssertNotCalled(s.T(), "Get", mock.Anything, mock.Anything)
+	s.mockGraphCache.AssertNotCalled(s.T(), "Set", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (s *GraphBuilderTestSuite) TestGetGraph_CacheSetError() {
 	flow := &CompleteFlowDefinition{
 		ID:       "flow-1",
@@ -1490,3 +1528,388 @@ func (s *GraphBuilderTestSuite) TestComputeSegments_GetStartNodeFails() {
 		{boundaryNodeID: "prompt", nextNodeID: "task"},
 	})
 }
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeBranches_WithBranches() {
+	nodeDef := &NodeDefinition{
+		ID:   "decision",
+		Type: "DECISION",
+		Branches: []BranchDefinition{
+			{
+				Condition: &BranchConditionDefinition{Key: "{{ context.userType }}", Value: "employee"},
+				Next:      "employee-login",
+			},
+			{
+				Condition: &BranchConditionDefinition{Key: "{{ user.country }}", Operator: "in", Value: "US,LK"},
+				Next:      "regional-login",
+			},
+		},
+	}
+
+	mockDecisionNode := coremock.NewDecisionNodeInterfaceMock(s.T())
+	mockDecisionNode.EXPECT().SetBranches([]core.DecisionBranch{
+		{
+			Condition:  core.BranchCondition{Key: "{{ context.userType }}", Value: "employee"},
+			NextNodeID: "employee-login",
+		},
+		{
+			Condition: core.BranchCondition{Key: "{{ user.country }}",
+				Operator: common.ConditionOperatorIn, Value: "US,LK"},
+			NextNodeID: "regional-login",
+		},
+	})
+
+	edges := map[string][]string{}
+
+	err := s.builder.configureNodeBranches(nodeDef, nil, mockDecisionNode, edges)
+
+	s.Nil(err)
+	s.Equal([]string{"employee-login", "regional-login"}, edges["decision"])
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeBranches_InvalidBranches() {
+	testCases := []struct {
+		name     string
+		branches []BranchDefinition
+		errMsg   string
+	}{
+		{"NoBranches", nil, "must define at least one branch"},
+		{"NilCondition", []BranchDefinition{{Next: "next"}}, "must define a condition key"},
+		{"EmptyConditionKey", []BranchDefinition{
+			{Condition: &BranchConditionDefinition{Value: "value"}, Next: "next"},
+		}, "must define a condition key"},
+		{"NoNextNode", []BranchDefinition{
+			{Condition: &BranchConditionDefinition{Key: "{{ context.key }}"}},
+		}, "must define the next node"},
+		{"UnsupportedOperator", []BranchDefinition{
+			{Condition: &BranchConditionDefinition{Key: "{{ context.key }}", Operator: "contains"}, Next: "next"},
+		}, "unsupported operator contains"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			nodeDef := &NodeDefinition{ID: "decision", Type: "DECISION", Branches: tc.branches}
+			mockDecisionNode := coremock.NewDecisionNodeInterfaceMock(s.T())
+
+			err := s.builder.configureNodeBranches(nodeDef, nil, mockDecisionNode, map[string][]string{})
+
+			s.NotNil(err)
+			s.Contains(err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeBranches_OnNonDecisionNode() {
+	nodeDef := &NodeDefinition{
+		ID:   "task-1",
+		Type: "TASK_EXECUTION",
+		Branches: []BranchDefinition{
+			{Condition: &BranchConditionDefinition{Key: "{{ context.key }}"}, Next: "next"},
+		},
+	}
+
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	err := s.builder.configureNodeBranches(nodeDef, nil, mockTaskNode, map[string][]string{})
+
+	s.NotNil(err)
+	s.Contains(err.Error(), "'branches' field is only valid on DECISION and FORK nodes")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeBranches_NoBranchesOnNonDecisionNode() {
+	nodeDef := &NodeDefinition{ID: "task-1", Type: "TASK_EXECUTION"}
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+	edges := map[string][]string{}
+
+	err := s.builder.configureNodeBranches(nodeDef, nil, mockTaskNode, edges)
+
+	s.Nil(err)
+	s.Empty(edges)
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_WithDecisionNode() {
+	flowFactory, _ := core.Initialize(cache.Initialize())
+	s.builder.flowFactory = flowFactory
+
+	flow := &CompleteFlowDefinition{
+		ID:       "flow-1",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes: []NodeDefinition{
+			{ID: "start", Type: "START", OnSuccess: "decision"},
+			{
+				ID:   "decision",
+				Type: "DECISION",
+				Branches: []BranchDefinition{
+					{
+						Condition: &BranchConditionDefinition{Key: "{{ context.userType }}", Value: "employee"},
+						Next:      "employee-end",
+					},
+				},
+				OnSuccess: "end",
+			},
+			{ID: "employee-end", Type: "END"},
+			{ID: "end", Type: "END"},
+		},
+	}
+
+	graph, err := s.builder.BuildGraph(flow)
+
+	s.Nil(err)
+	node, exists := graph.GetNode("decision")
+	s.True(exists)
+	s.False(node.IsFinalNode())
+	s.ElementsMatch([]string{"employee-end", "end"}, node.GetNextNodeList())
+
+	decisionNode, ok := node.(core.DecisionNodeInterface)
+	s.True(ok)
+	s.Equal("end", decisionNode.GetOnSuccess())
+	s.Len(decisionNode.GetBranches(), 1)
+	s.Equal("employee-end", decisionNode.GetBranches()[0].NextNodeID)
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeBranches_ForkNode() {
+	allNodes := []NodeDefinition{
+		{ID: "risk-score", Type: "TASK_EXECUTION"},
+		{ID: "send-otp", Type: "TASK_EXECUTION"},
+		{ID: "join", Type: "JOIN"},
+	}
+	nodeDef := &NodeDefinition{
+		ID:        "fork",
+		Type:      "FORK",
+		Branches:  []BranchDefinition{{Next: "risk-score"}, {Next: "send-otp"}},
+		OnSuccess: "join",
+	}
+
+	mockForkNode := coremock.NewForkNodeInterfaceMock(s.T())
+	mockForkNode.EXPECT().SetBranches([]string{"risk-score", "send-otp"})
+
+	edges := map[string][]string{}
+
+	err := s.builder.configureNodeBranches(nodeDef, allNodes, mockForkNode, edges)
+
+	s.Nil(err)
+	s.Equal([]string{"risk-score", "send-otp"}, edges["fork"])
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeBranches_InvalidForkBranches() {
+	allNodes := []NodeDefinition{
+		{ID: "risk-score", Type: "TASK_EXECUTION"},
+		{ID: "send-otp", Type: "TASK_EXECUTION"},
+		{ID: "prompt", Type: "PROMPT"},
+		{ID: "join", Type: "JOIN"},
+	}
+
+	testCases := []struct {
+		name      string
+		branches  []BranchDefinition
+		onSuccess string
+		errMsg    string
+	}{
+		{"SingleBranch", []BranchDefinition{{Next: "risk-score"}}, "join", "must define at least two branches"},
+		{"NoJoinNode", []BranchDefinition{{Next: "risk-score"}, {Next: "send-otp"}}, "",
+			"must define the join node in onSuccess"},
+		{"OnSuccessNotJoinNode", []BranchDefinition{{Next: "risk-score"}, {Next: "send-otp"}}, "prompt",
+			"must refer to a JOIN node"},
+		{"ConditionDefined", []BranchDefinition{
+			{Condition: &BranchConditionDefinition{Key: "{{ context.key }}"}, Next: "risk-score"},
+			{Next: "send-otp"},
+		}, "join", "must not define a condition"},
+		{"NoNextNode", []BranchDefinition{{Next: "risk-score"}, {}}, "join", "must define the next node"},
+		{"BranchStartsWithPrompt", []BranchDefinition{{Next: "risk-score"}, {Next: "prompt"}}, "join",
+			"must start with a TASK_EXECUTION node"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			nodeDef := &NodeDefinition{ID: "fork", Type: "FORK", Branches: tc.branches, OnSuccess: tc.onSuccess}
+			mockForkNode := coremock.NewForkNodeInterfaceMock(s.T())
+
+			err := s.builder.configureNodeBranches(nodeDef, allNodes, mockForkNode, map[string][]string{})
+
+			s.NotNil(err)
+			s.Contains(err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_WithForkNode() {
+	flowFactory, _ := core.Initialize(cache.Initialize())
+	s.builder.flowFactory = flowFactory
+	s.mockExecutorRegistry.EXPECT().IsRegistered("HTTPRequestExecutor").Return(true)
+	s.mockExecutorRegistry.EXPECT().IsRegistered("SMSOTPAuthExecutor").Return(true)
+
+	flow := &CompleteFlowDefinition{
+		ID:       "flow-1",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes: []NodeDefinition{
+			{ID: "start", Type: "START", OnSuccess: "fork"},
+			{
+				ID:        "fork",
+				Type:      "FORK",
+				Branches:  []BranchDefinition{{Next: "risk-score"}, {Next: "send-otp"}},
+				OnSuccess: "join",
+			},
+			{
+				ID:        "risk-score",
+				Type:      "TASK_EXECUTION",
+				Executor:  &ExecutorDefinition{Name: "HTTPRequestExecutor"},
+				OnSuccess: "join",
+			},
+			{
+				ID:        "send-otp",
+				Type:      "TASK_EXECUTION",
+				Executor:  &ExecutorDefinition{Name: "SMSOTPAuthExecutor", Mode: "send"},
+				OnSuccess: "join",
+			},
+			{ID: "join", Type: "JOIN", OnSuccess: "end"},
+			{ID: "end", Type: "END"},
+		},
+	}
+
+	graph, err := s.builder.BuildGraph(flow)
+
+	s.Nil(err)
+	node, exists := graph.GetNode("fork")
+	s.True(exists)
+	s.False(node.IsFinalNode())
+	s.ElementsMatch([]string{"join", "risk-score", "send-otp"}, node.GetNextNodeList())
+
+	forkNode, ok := node.(core.ForkNodeInterface)
+	s.True(ok)
+	s.Equal("join", forkNode.GetOnSuccess())
+	s.Equal([]string{"risk-score", "send-otp"}, forkNode.GetBranches())
+
+	joinNode, exists := graph.GetNode("join")
+	s.True(exists)
+	s.Equal(common.NodeTypeJoin, joinNode.GetType())
+	s.ElementsMatch([]string{"fork", "risk-score", "send-otp"}, joinNode.GetPreviousNodeList())
+}
+
+func (s *GraphBuilderTestSuite) TestBuildGraph_ForkBranchWithMaxAttempts() {
+	flowFactory, _ := core.Initialize(cache.Initialize())
+	s.builder.flowFactory = flowFactory
+	s.mockExecutorRegistry.EXPECT().IsRegistered(mock.Anything).Return(true).Maybe()
+
+	flow := &CompleteFlowDefinition{
+		ID:       "flow-1",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes: []NodeDefinition{
+			{ID: "start", Type: "START", OnSuccess: "fork"},
+			{
+				ID:        "fork",
+				Type:      "FORK",
+				Branches:  []BranchDefinition{{Next: "risk-score"}, {Next: "send-otp"}},
+				OnSuccess: "join",
+			},
+			{
+				ID:        "risk-score",
+				Type:      "TASK_EXECUTION",
+				Executor:  &ExecutorDefinition{Name: "HTTPRequestExecutor"},
+				OnSuccess: "verify-otp",
+			},
+			{
+				ID:          "verify-otp",
+				Type:        "TASK_EXECUTION",
+				Executor:    &ExecutorDefinition{Name: "SMSOTPAuthExecutor", Mode: "verify"},
+				MaxAttempts: 3,
+				OnSuccess:   "join",
+			},
+			{
+				ID:        "send-otp",
+				Type:      "TASK_EXECUTION",
+				Executor:  &ExecutorDefinition{Name: "SMSOTPAuthExecutor", Mode: "send"},
+				OnSuccess: "join",
+			},
+			{ID: "join", Type: "JOIN", OnSuccess: "end"},
+			{ID: "end", Type: "END"},
+		},
+	}
+
+	graph, err := s.builder.BuildGraph(flow)
+
+	s.Nil(graph)
+	s.NotNil(err)
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeBranches_ForkBranchWithMaxAttempts() {
+	allNodes := []NodeDefinition{
+		{ID: "risk-score", Type: "TASK_EXECUTION", OnSuccess: "verify-otp"},
+		{ID: "verify-otp", Type: "TASK_EXECUTION", MaxAttempts: 3, OnSuccess: "join"},
+		{ID: "send-otp", Type: "TASK_EXECUTION", OnSuccess: "join"},
+		{ID: "join", Type: "JOIN", OnSuccess: "end"},
+	}
+	nodeDef := &NodeDefinition{
+		ID:        "fork",
+		Type:      "FORK",
+		Branches:  []BranchDefinition{{Next: "risk-score"}, {Next: "send-otp"}},
+		OnSuccess: "join",
+	}
+
+	err := s.builder.configureNodeBranches(nodeDef, allNodes, coremock.NewForkNodeInterfaceMock(s.T()),
+		map[string][]string{})
+
+	s.NotNil(err)
+	s.Contains(err.Error(), "node verify-otp in a branch of fork node fork must not define 'maxAttempts'")
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeBranches_ForkAllowsMaxAttemptsAfterJoin() {
+	allNodes := []NodeDefinition{
+		{ID: "risk-score", Type: "TASK_EXECUTION", OnSuccess: "join"},
+		{ID: "send-otp", Type: "TASK_EXECUTION", OnSuccess: "join"},
+		{ID: "join", Type: "JOIN", OnSuccess: "verify-otp"},
+		{ID: "verify-otp", Type: "TASK_EXECUTION", MaxAttempts: 3},
+	}
+	nodeDef := &NodeDefinition{
+		ID:        "fork",
+		Type:      "FORK",
+		Branches:  []BranchDefinition{{Next: "risk-score"}, {Next: "send-otp"}},
+		OnSuccess: "join",
+	}
+	mockForkNode := coremock.NewForkNodeInterfaceMock(s.T())
+	mockForkNode.EXPECT().SetBranches([]string{"risk-score", "send-otp"})
+
+	err := s.builder.configureNodeBranches(nodeDef, allNodes, mockForkNode, map[string][]string{})
+
+	s.Nil(err)
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeMaxAttempts() {
+	nodeDef := &NodeDefinition{ID: "basic-auth", Type: "TASK_EXECUTION", MaxAttempts: 3}
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+	mockTaskNode.EXPECT().SetMaxAttempts(3)
+
+	err := s.builder.configureNodeMaxAttempts(nodeDef, mockTaskNode)
+
+	s.Nil(err)
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeMaxAttempts_NotSet() {
+	nodeDef := &NodeDefinition{ID: "basic-auth", Type: "TASK_EXECUTION"}
+	mockTaskNode := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+
+	err := s.builder.configureNodeMaxAttempts(nodeDef, mockTaskNode)
+
+	s.Nil(err)
+}
+
+func (s *GraphBuilderTestSuite) TestConfigureNodeMaxAttempts_Invalid() {
+	testCases := []struct {
+		name    string
+		nodeDef *NodeDefinition
+		node    core.NodeInterface
+		errMsg  string
+	}{
+		{"NegativeValue", &NodeDefinition{ID: "basic-auth", Type: "TASK_EXECUTION", MaxAttempts: -1},
+			coremock.NewExecutorBackedNodeInterfaceMock(s.T()), "must be a positive number"},
+		{"NonTaskExecutionNode", &NodeDefinition{ID: "prompt", Type: "PROMPT", MaxAttempts: 3},
+			coremock.NewPromptNodeInterfaceMock(s.T()), "'maxAttempts' field is only valid on TASK_EXECUTION nodes"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := s.builder.configureNodeMaxAttempts(tc.nodeDef, tc.node)
+
+			s.NotNil(err)
+			s.Contains(err.Error(), tc.errMsg)
+		})
+	}
+}
@@ -22,7 +22,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -41,6 +44,7 @@ const (
 	pathParamFlowID    = "flowId"
 	pathParamVersion   = "version"
 	queryParamFlowType = "flowType"
+	queryParamFormat   = "format"
 	queryParamLimit    = "limit"
 	queryParamOffset   = "offset"
 )
@@ -169,6 +173,90 @@ func (h *flowMgtHandler) deleteFlow(w http.ResponseWriter, r *http.Request) {
 	h.logger.Debug("Flow deleted successfully", log.String(logKeyFlowID, flowID))
 }
 
+// validateFlow handles POST requests to validate a flow definition without saving it.
+func (h *flowMgtHandler) validateFlow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	flowDefRequest, err := utils.DecodeJSONBody[FlowDefinitionRequest](r)
+	if err != nil {
+		handleInvalidRequestError(w)
+		return
+	}
+
+	sanitized := sanitizeFlowDefinitionRequest(flowDefRequest)
+	result, svcErr := h.service.ValidateFlow(ctx, sanitized)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, result)
+	h.logger.Debug("Flow validated successfully", log.Int(logKeyCount, len(result.Diagnostics)))
+}
+
+// exportFlow handles GET requests to export a flow definition as a portable flow bundle. The bundle is
+// returned as JSON unless the format query parameter requests YAML.
+func (h *flowMgtHandler) exportFlow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	flowID := r.PathValue(pathParamFlowID)
+	if flowID == "" {
+		handleError(w, &ErrorMissingFlowID)
+		return
+	}
+
+	format := r.URL.Query().Get(queryParamFormat)
+	if format != "" && format != exportFormatJSON && format != exportFormatYAML {
+		handleError(w, &ErrorInvalidExportFormat)
+		return
+	}
+
+	bundle, svcErr := h.service.ExportFlow(ctx, flowID)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	if format == exportFormatYAML {
+		content, err := yaml.Marshal(bundle)
+		if err != nil {
+			h.logger.Error("Failed to encode flow bundle", log.String(logKeyFlowID, flowID), log.Error(err))
+			handleError(w, &serviceerror.InternalServerError)
+			return
+		}
+		w.Header().Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeYAML)
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(content); err != nil {
+			h.logger.Error("Failed to write flow bundle", log.String(logKeyFlowID, flowID), log.Error(err))
+		}
+	} else {
+		utils.WriteSuccessResponse(w, http.StatusOK, bundle)
+	}
+	h.logger.Debug("Flow exported successfully", log.String(logKeyFlowID, flowID))
+}
+
+// importFlow handles POST requests to import a flow bundle exported from another environment.
+func (h *flowMgtHandler) importFlow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	importRequest, err := utils.DecodeJSONBody[FlowImportRequest](r)
+	if err != nil {
+		handleInvalidRequestError(w)
+		return
+	}
+
+	result, svcErr := h.service.ImportFlow(ctx, importRequest)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	statusCode := http.StatusOK
+	if result.Operation == importOperationCreate {
+		statusCode = http.StatusCreated
+	}
+	utils.WriteSuccessResponse(w, statusCode, result)
+	h.logger.Debug("Flow imported successfully", log.String(logKeyFlowID, result.Flow.ID),
+		log.String("operation", result.Operation))
+}
+
 // Flow version management HTTP handler methods
 
 // listFlowVersions handles GET requests to list all versions of a specific flow definition.
@@ -244,6 +332,90 @@ func (h *flowMgtHandler) restoreFlowVersion(w http.ResponseWriter, r *http.Reque
 		log.String(logKeyFlowID, flowID), log.Int(logKeyVersion, request.Version))
 }
 
+// getFlowDraft handles GET requests to retrieve the unpublished draft of a flow.
+func (h *flowMgtHandler) getFlowDraft(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	flowID := r.PathValue(pathParamFlowID)
+	if flowID == "" {
+		handleError(w, &ErrorMissingFlowID)
+		return
+	}
+
+	draft, svcErr := h.service.GetFlowDraft(ctx, flowID)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, draft)
+	h.logger.Debug("Flow draft retrieved successfully", log.String(logKeyFlowID, flowID))
+}
+
+// saveFlowDraft handles PUT requests to create or replace the draft of a flow.
+func (h *flowMgtHandler) saveFlowDraft(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	flowID := r.PathValue(pathParamFlowID)
+	if flowID == "" {
+		handleError(w, &ErrorMissingFlowID)
+		return
+	}
+
+	flowDefRequest, err := utils.DecodeJSONBody[FlowDefinitionRequest](r)
+	if err != nil {
+		handleInvalidRequestError(w)
+		return
+	}
+
+	sanitized := sanitizeFlowDefinitionRequest(flowDefRequest)
+	draft, svcErr := h.service.SaveFlowDraft(ctx, flowID, sanitized)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, draft)
+	h.logger.Debug("Flow draft saved successfully", log.String(logKeyFlowID, flowID))
+}
+
+// deleteFlowDraft handles DELETE requests to discard the draft of a flow.
+func (h *flowMgtHandler) deleteFlowDraft(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	flowID := r.PathValue(pathParamFlowID)
+	if flowID == "" {
+		handleError(w, &ErrorMissingFlowID)
+		return
+	}
+
+	svcErr := h.service.DeleteFlowDraft(ctx, flowID)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	h.logger.Debug("Flow draft deleted successfully", log.String(logKeyFlowID, flowID))
+}
+
+// publishFlowDraft handles POST requests to publish the draft of a flow as its active version.
+func (h *flowMgtHandler) publishFlowDraft(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	flowID := r.PathValue(pathParamFlowID)
+	if flowID == "" {
+		handleError(w, &ErrorMissingFlowID)
+		return
+	}
+
+	flow, svcErr := h.service.PublishFlowDraft(ctx, flowID)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, flow)
+	h.logger.Debug("Flow draft published successfully",
+		log.String(logKeyFlowID, flowID), log.Int(logKeyVersion, flow.ActiveVersion))
+}
+
 // parsePaginationParams extracts and validates pagination parameters from the request.
 func parsePaginationParams(r *http.Request) (int, int, *serviceerror.ServiceError) {
 	limitStr := r.URL.Query().Get(queryParamLimit)
@@ -304,9 +476,9 @@ func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
 
 	statusCode := http.StatusBadRequest
 	switch svcErr.Code {
-	case ErrorFlowNotFound.Code, ErrorVersionNotFound.Code:
+	case ErrorFlowNotFound.Code, ErrorVersionNotFound.Code, ErrorDraftNotFound.Code:
 		statusCode = http.StatusNotFound
-	case ErrorDuplicateFlowID.Code:
+	case ErrorDuplicateFlowID.Code, ErrorDraftOutdated.Code:
 		statusCode = http.StatusConflict
 	case serviceerror.InternalServerError.Code:
 		statusCode = http.StatusInternalServerError
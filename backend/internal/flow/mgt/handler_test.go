@@ -27,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -205,6 +206,199 @@ func (s *FlowMgtHandlerTestSuite) TestCreateFlow_ServiceError() {
 	s.Equal(http.StatusBadRequest, w.Code)
 }
 
+// Test validateFlow
+
+func (s *FlowMgtHandlerTestSuite) TestValidateFlow_Success() {
+	flowDef := &FlowDefinition{
+		Handle:   "new-flow-handle",
+		Name:     "New Flow",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes: []NodeDefinition{
+			{ID: "start", Type: "START"},
+		},
+	}
+	result := &FlowValidationResult{
+		Valid: false,
+		Diagnostics: []FlowDiagnostic{
+			newErrorDiagnostic(diagnosticCodeUnknownNodeReference, "start", "Node start refers to an unknown node"),
+		},
+	}
+
+	s.mockService.EXPECT().ValidateFlow(mock.Anything, flowDef).Return(result, nil)
+
+	body, _ := json.Marshal(flowDef)
+	req := httptest.NewRequest(http.MethodPost, "/flows/validate", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.validateFlow(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response FlowValidationResult
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	s.NoError(err)
+	s.Equal(*result, response)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestValidateFlow_InvalidJSON() {
+	req := httptest.NewRequest(http.MethodPost, "/flows/validate", bytes.NewReader([]byte("invalid json")))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.validateFlow(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestValidateFlow_ServiceError() {
+	flowDef := &FlowDefinition{
+		Handle:   "new-flow-handle",
+		FlowType: common.FlowTypeAuthentication,
+	}
+
+	s.mockService.EXPECT().ValidateFlow(mock.Anything, flowDef).Return(nil, &ErrorMissingFlowName)
+
+	body, _ := json.Marshal(flowDef)
+	req := httptest.NewRequest(http.MethodPost, "/flows/validate", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.validateFlow(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
+// Test exportFlow
+
+func (s *FlowMgtHandlerTestSuite) TestExportFlow() {
+	bundle := &FlowBundle{
+		Flow: FlowBundleDefinition{
+			Handle:   "test-handle",
+			Name:     "Test Flow",
+			FlowType: common.FlowTypeAuthentication,
+			Nodes:    []NodeDefinition{{ID: "start", Type: "START"}},
+		},
+		Executors:         []FlowBundleExecutor{{Name: "BasicAuthExecutor", Type: "AUTHENTICATION"}},
+		IdentityProviders: []FlowBundleIdentityProvider{},
+	}
+
+	testCases := []struct {
+		name                string
+		query               string
+		expectedContentType string
+		decode              func([]byte, interface{}) error
+	}{
+		{name: "DefaultFormat", expectedContentType: "application/json", decode: json.Unmarshal},
+		{name: "JSONFormat", query: "?format=json", expectedContentType: "application/json", decode: json.Unmarshal},
+		{name: "YAMLFormat", query: "?format=yaml", expectedContentType: "application/yaml", decode: yaml.Unmarshal},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockService.EXPECT().ExportFlow(mock.Anything, testFlowIDHandler).Return(bundle, nil).Once()
+
+			req := httptest.NewRequest(http.MethodGet, "/flows/"+testFlowIDHandler+"/export"+tc.query, nil)
+			req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+			w := httptest.NewRecorder()
+
+			s.handler.exportFlow(w, req)
+
+			s.Equal(http.StatusOK, w.Code)
+			s.Equal(tc.expectedContentType, w.Header().Get("Content-Type"))
+			var response FlowBundle
+			s.NoError(tc.decode(w.Body.Bytes(), &response))
+			s.Equal(*bundle, response)
+		})
+	}
+}
+
+func (s *FlowMgtHandlerTestSuite) TestExportFlow_InvalidFormat() {
+	req := httptest.NewRequest(http.MethodGet, "/flows/"+testFlowIDHandler+"/export?format=xml", nil)
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	w := httptest.NewRecorder()
+
+	s.handler.exportFlow(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+	s.mockService.AssertNotCalled(s.T(), "ExportFlow", mock.Anything, mock.Anything)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestExportFlow_NotFound() {
+	s.mockService.EXPECT().ExportFlow(mock.Anything, testFlowIDHandler).Return(nil, &ErrorFlowNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/flows/"+testFlowIDHandler+"/export", nil)
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	w := httptest.NewRecorder()
+
+	s.handler.exportFlow(w, req)
+
+	s.Equal(http.StatusNotFound, w.Code)
+}
+
+// Test importFlow
+
+func (s *FlowMgtHandlerTestSuite) TestImportFlow() {
+	testCases := []struct {
+		name           string
+		operation      string
+		expectedStatus int
+	}{
+		{name: "Create", operation: importOperationCreate, expectedStatus: http.StatusCreated},
+		{name: "Update", operation: importOperationUpdate, expectedStatus: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			request := &FlowImportRequest{
+				Content:     "flow:\n  handle: test-handle\n",
+				IDPMappings: map[string]string{"source-idp": "target-idp"},
+			}
+			result := &FlowImportResponse{
+				Operation: tc.operation,
+				Flow:      &CompleteFlowDefinition{ID: testFlowIDHandler, Handle: "test-handle"},
+			}
+			s.mockService.EXPECT().ImportFlow(mock.Anything, request).Return(result, nil).Once()
+
+			body, _ := json.Marshal(request)
+			req := httptest.NewRequest(http.MethodPost, "/flows/import", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			s.handler.importFlow(w, req)
+
+			s.Equal(tc.expectedStatus, w.Code)
+			var response FlowImportResponse
+			s.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			s.Equal(tc.operation, response.Operation)
+			s.Equal(testFlowIDHandler, response.Flow.ID)
+		})
+	}
+}
+
+func (s *FlowMgtHandlerTestSuite) TestImportFlow_InvalidJSON() {
+	req := httptest.NewRequest(http.MethodPost, "/flows/import", bytes.NewReader([]byte("invalid json")))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.importFlow(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestImportFlow_ServiceError() {
+	request := &FlowImportRequest{Content: "invalid"}
+	s.mockService.EXPECT().ImportFlow(mock.Anything, request).Return(nil, &ErrorInvalidFlowBundle)
+
+	body, _ := json.Marshal(request)
+	req := httptest.NewRequest(http.MethodPost, "/flows/import", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.importFlow(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
 // Test getFlow
 
 func (s *FlowMgtHandlerTestSuite) TestGetFlow_Success() {
@@ -562,6 +756,132 @@ func (s *FlowMgtHandlerTestSuite) TestRestoreFlowVersion_NotFound() {
 	s.Equal(http.StatusNotFound, w.Code)
 }
 
+// Test flow draft handlers
+
+func (s *FlowMgtHandlerTestSuite) TestGetFlowDraft_Success() {
+	draft := &FlowDraft{ID: testFlowIDHandler, Name: "Draft Flow", BaseVersion: 2}
+	s.mockService.EXPECT().GetFlowDraft(mock.Anything, testFlowIDHandler).Return(draft, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/flows/"+testFlowIDHandler+"/draft", nil)
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	w := httptest.NewRecorder()
+
+	s.handler.getFlowDraft(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response FlowDraft
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Equal("Draft Flow", response.Name)
+	s.Equal(2, response.BaseVersion)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestGetFlowDraft_NotFound() {
+	s.mockService.EXPECT().GetFlowDraft(mock.Anything, testFlowIDHandler).Return(nil, &ErrorDraftNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/flows/"+testFlowIDHandler+"/draft", nil)
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	w := httptest.NewRecorder()
+
+	s.handler.getFlowDraft(w, req)
+
+	s.Equal(http.StatusNotFound, w.Code)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestSaveFlowDraft_Success() {
+	request := FlowDefinitionRequest{
+		Handle:   "test-handle",
+		Name:     "Draft Flow",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes:    []NodeDefinition{{ID: "start", Type: "START"}},
+	}
+	draft := &FlowDraft{ID: testFlowIDHandler, Name: "Draft Flow", BaseVersion: 2}
+	s.mockService.EXPECT().SaveFlowDraft(mock.Anything, testFlowIDHandler, mock.MatchedBy(
+		func(flowDef *FlowDefinition) bool { return flowDef.Name == "Draft Flow" })).Return(draft, nil)
+
+	body, _ := json.Marshal(request)
+	req := httptest.NewRequest(http.MethodPut, "/flows/"+testFlowIDHandler+"/draft", bytes.NewReader(body))
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.saveFlowDraft(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestSaveFlowDraft_InvalidJSON() {
+	req := httptest.NewRequest(http.MethodPut, "/flows/"+testFlowIDHandler+"/draft",
+		bytes.NewReader([]byte("invalid")))
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.saveFlowDraft(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestDeleteFlowDraft_Success() {
+	s.mockService.EXPECT().DeleteFlowDraft(mock.Anything, testFlowIDHandler).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/flows/"+testFlowIDHandler+"/draft", nil)
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	w := httptest.NewRecorder()
+
+	s.handler.deleteFlowDraft(w, req)
+
+	s.Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestDeleteFlowDraft_MissingFlowID() {
+	req := httptest.NewRequest(http.MethodDelete, "/flows//draft", nil)
+	w := httptest.NewRecorder()
+
+	s.handler.deleteFlowDraft(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestPublishFlowDraft_Success() {
+	publishedFlow := &CompleteFlowDefinition{ID: testFlowIDHandler, ActiveVersion: 3}
+	s.mockService.EXPECT().PublishFlowDraft(mock.Anything, testFlowIDHandler).Return(publishedFlow, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/flows/"+testFlowIDHandler+"/publish", nil)
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	w := httptest.NewRecorder()
+
+	s.handler.publishFlowDraft(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response CompleteFlowDefinition
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Equal(3, response.ActiveVersion)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestPublishFlowDraft_NotFound() {
+	s.mockService.EXPECT().PublishFlowDraft(mock.Anything, testFlowIDHandler).Return(nil, &ErrorDraftNotFound)
+
+	req := httptest.NewRequest(http.MethodPost, "/flows/"+testFlowIDHandler+"/publish", nil)
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	w := httptest.NewRecorder()
+
+	s.handler.publishFlowDraft(w, req)
+
+	s.Equal(http.StatusNotFound, w.Code)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestPublishFlowDraft_Outdated() {
+	s.mockService.EXPECT().PublishFlowDraft(mock.Anything, testFlowIDHandler).Return(nil, &ErrorDraftOutdated)
+
+	req := httptest.NewRequest(http.MethodPost, "/flows/"+testFlowIDHandler+"/publish", nil)
+	req.SetPathValue(pathParamFlowID, testFlowIDHandler)
+	w := httptest.NewRecorder()
+
+	s.handler.publishFlowDraft(w, req)
+
+	s.Equal(http.StatusConflict, w.Code)
+}
+
 // Test parsePaginationParams
 
 func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_DefaultValues() {
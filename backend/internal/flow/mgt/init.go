@@ -26,11 +26,13 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/idp"
 
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/mcp/resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
@@ -43,6 +45,7 @@ func Initialize(
 	flowFactory core.FlowFactoryInterface,
 	executorRegistry executor.ExecutorRegistryInterface,
 	graphCache core.GraphCacheInterface,
+	idpService idp.IDPServiceInterface,
 ) (FlowMgtServiceInterface, declarativeresource.ResourceExporter, error) {
 	store, compositeStore, transactioner, err := initializeStore(cacheManager)
 	if err != nil {
@@ -51,14 +54,19 @@ func Initialize(
 
 	inferenceService := newFlowInferenceService()
 	graphBuilder := newGraphBuilder(flowFactory, executorRegistry, graphCache)
-	service := newFlowMgtService(store, inferenceService, graphBuilder, executorRegistry, compositeStore, transactioner)
+	service := newFlowMgtService(store, inferenceService, graphBuilder, executorRegistry, idpService,
+		compositeStore, transactioner)
+	if mcpServer != nil {
+		service = newNotifyingFlowService(service, resource.NewNotifier(mcpServer))
+	}
 
 	handler := newFlowMgtHandler(service)
 	registerRoutes(mux, handler)
 
-	// Register MCP tools
+	// Register MCP tools and resources
 	if mcpServer != nil {
 		registerMCPTools(mcpServer, service)
+		registerMCPResources(mcpServer, service)
 	}
 
 	// Create and return exporter
@@ -178,6 +186,14 @@ func registerRoutes(mux *http.ServeMux, handler *flowMgtHandler) {
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/{flowId}", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	}, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET /flows/{flowId}/draft", handler.getFlowDraft, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /flows/{flowId}/draft", handler.saveFlowDraft, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /flows/{flowId}/draft", handler.deleteFlowDraft, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/{flowId}/draft",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2),
+	)
 
 	opts3 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET"},
@@ -197,6 +213,12 @@ func registerRoutes(mux *http.ServeMux, handler *flowMgtHandler) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts3),
 	)
+	mux.HandleFunc(middleware.WithCORS("GET /flows/{flowId}/export", handler.exportFlow, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/{flowId}/export",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3),
+	)
 
 	opts4 := middleware.CORSOptions{
 		AllowedMethods:   []string{"POST"},
@@ -210,4 +232,22 @@ func registerRoutes(mux *http.ServeMux, handler *flowMgtHandler) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts4),
 	)
+	mux.HandleFunc(middleware.WithCORS("POST /flows/{flowId}/publish", handler.publishFlowDraft, opts4))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/{flowId}/publish",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts4),
+	)
+	mux.HandleFunc(middleware.WithCORS("POST /flows/validate", handler.validateFlow, opts4))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/validate",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts4),
+	)
+	mux.HandleFunc(middleware.WithCORS("POST /flows/import", handler.importFlow, opts4))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/import",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts4),
+	)
 }
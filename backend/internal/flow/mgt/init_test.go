@@ -111,6 +111,11 @@ func (s *InitTestSuite) TestRegisterRoutes_AllRoutesRegistered() {
 		{"OPTIONS /flows/{flowId}/versions", "/flows/test-id/versions"},
 		{"OPTIONS /flows/{flowId}/versions/{version}", "/flows/test-id/versions/1"},
 		{"OPTIONS /flows/{flowId}/restore", "/flows/test-id/restore"},
+		{"OPTIONS /flows/validate", "/flows/validate"},
+		{"OPTIONS /flows/import", "/flows/import"},
+		{"OPTIONS /flows/{flowId}/export", "/flows/test-id/export"},
+		{"OPTIONS /flows/{flowId}/draft", "/flows/test-id/draft"},
+		{"OPTIONS /flows/{flowId}/publish", "/flows/test-id/publish"},
 	}
 
 	for _, tc := range testCases {
@@ -165,6 +170,36 @@ func (s *InitTestSuite) TestRegisterRoutes_CORSHeadersConfigured() {
 			path:                   "/flows/" + testFlowIDInit + "/restore",
 			expectedAllowedMethods: "POST",
 		},
+		{
+			name:                   "CORS for /flows/validate",
+			method:                 http.MethodOptions,
+			path:                   "/flows/validate",
+			expectedAllowedMethods: "POST",
+		},
+		{
+			name:                   "CORS for /flows/import",
+			method:                 http.MethodOptions,
+			path:                   "/flows/import",
+			expectedAllowedMethods: "POST",
+		},
+		{
+			name:                   "CORS for /flows/{flowId}/export",
+			method:                 http.MethodOptions,
+			path:                   "/flows/" + testFlowIDInit + "/export",
+			expectedAllowedMethods: "GET",
+		},
+		{
+			name:                   "CORS for /flows/{flowId}/draft",
+			method:                 http.MethodOptions,
+			path:                   "/flows/" + testFlowIDInit + "/draft",
+			expectedAllowedMethods: "GET, PUT, DELETE",
+		},
+		{
+			name:                   "CORS for /flows/{flowId}/publish",
+			method:                 http.MethodOptions,
+			path:                   "/flows/" + testFlowIDInit + "/publish",
+			expectedAllowedMethods: "POST",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -200,6 +235,11 @@ func (s *InitTestSuite) TestRegisterRoutes_OPTIONSHandlers() {
 		"/flows/" + testFlowIDInit + "/versions",
 		"/flows/" + testFlowIDInit + "/versions/1",
 		"/flows/" + testFlowIDInit + "/restore",
+		"/flows/validate",
+		"/flows/import",
+		"/flows/" + testFlowIDInit + "/export",
+		"/flows/" + testFlowIDInit + "/draft",
+		"/flows/" + testFlowIDInit + "/publish",
 	}
 
 	for _, path := range optionsPaths {
@@ -112,6 +112,85 @@ type RestoreVersionRequest struct {
 	Version int `json:"version" validate:"required"`
 }
 
+// FlowDraft represents an unpublished edit of a flow definition. The draft is based on the version that was
+// active when it was last saved and does not affect flow execution until it is published.
+type FlowDraft struct {
+	ID          string           `json:"id"`
+	Handle      string           `json:"handle"`
+	Name        string           `json:"name"`
+	FlowType    common.FlowType  `json:"flowType"`
+	BaseVersion int              `json:"baseVersion"`
+	Nodes       []NodeDefinition `json:"nodes"`
+	CreatedAt   string           `json:"createdAt"`
+	UpdatedAt   string           `json:"updatedAt"`
+}
+
+// FlowValidationResult represents the outcome of validating a flow definition.
+type FlowValidationResult struct {
+	Valid       bool             `json:"valid"`
+	Diagnostics []FlowDiagnostic `json:"diagnostics"`
+}
+
+// FlowDiagnostic represents a single issue found while validating a flow definition.
+type FlowDiagnostic struct {
+	Severity DiagnosticSeverity `json:"severity"`
+	Code     string             `json:"code"`
+	Message  string             `json:"message"`
+	NodeID   string             `json:"nodeId,omitempty"`
+}
+
+// DiagnosticSeverity represents the severity of a flow diagnostic.
+type DiagnosticSeverity string
+
+const (
+	// DiagnosticSeverityError marks an issue that prevents the flow from being executed.
+	DiagnosticSeverityError DiagnosticSeverity = "ERROR"
+	// DiagnosticSeverityWarning marks an issue that does not prevent the flow from being executed.
+	DiagnosticSeverityWarning DiagnosticSeverity = "WARNING"
+)
+
+// FlowBundle represents a portable flow definition together with the metadata of the executors and identity
+// providers it refers to. It is used to promote a flow from one environment to another.
+type FlowBundle struct {
+	Flow              FlowBundleDefinition         `json:"flow" yaml:"flow"`
+	Executors         []FlowBundleExecutor         `json:"executors" yaml:"executors"`
+	IdentityProviders []FlowBundleIdentityProvider `json:"identityProviders" yaml:"identityProviders"`
+}
+
+// FlowBundleDefinition represents the environment independent part of a flow definition in a flow bundle.
+type FlowBundleDefinition struct {
+	Handle   string           `json:"handle" yaml:"handle"`
+	Name     string           `json:"name" yaml:"name"`
+	FlowType common.FlowType  `json:"flowType" yaml:"flowType"`
+	Nodes    []NodeDefinition `json:"nodes" yaml:"nodes"`
+}
+
+// FlowBundleExecutor represents an executor referred to by the flow in a flow bundle.
+type FlowBundleExecutor struct {
+	Name string `json:"name" yaml:"name"`
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// FlowBundleIdentityProvider represents an identity provider referred to by the flow in a flow bundle.
+type FlowBundleIdentityProvider struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// FlowImportRequest represents a request to import a flow bundle. The content holds the flow bundle in JSON
+// or YAML, and the IdP mappings map the identity provider IDs in the bundle to the IDs in this environment.
+type FlowImportRequest struct {
+	Content     string            `json:"content"`
+	IDPMappings map[string]string `json:"idpMappings,omitempty"`
+}
+
+// FlowImportResponse represents the outcome of importing a flow bundle.
+type FlowImportResponse struct {
+	Operation string                  `json:"operation"`
+	Flow      *CompleteFlowDefinition `json:"flow"`
+}
+
 // Link represents a hypermedia link for pagination.
 type Link struct {
 	Href string `json:"href"`
@@ -139,7 +218,7 @@ type NodePosition struct {
 // NodeDefinition represents a single node in a flow definition.
 type NodeDefinition struct {
 	ID           string                 `json:"id" yaml:"id" jsonschema:"Unique node identifier within the flow. Example: 'start', 'username-password', 'end'"`
-	Type         string                 `json:"type" yaml:"type" jsonschema:"Node type: 'START' (entry point), 'END' (exit point), 'TASK_EXECUTION' (backend logic), or 'PROMPT' (user input)"`
+	Type         string                 `json:"type" yaml:"type" jsonschema:"Node type: 'START' (entry point), 'END' (exit point), 'TASK_EXECUTION' (backend logic), 'PROMPT' (user input), 'DECISION' (conditional routing), 'FORK' (parallel branches) or 'JOIN' (end of parallel branches)"`
 	Layout       *NodeLayout            `json:"layout,omitempty" yaml:"layout,omitempty" jsonschema:"Optional UI layout information for flow composer (position and size on canvas)"`
 	Meta         interface{}            `json:"meta,omitempty" yaml:"meta,omitempty" jsonschema:"Optional metadata. For PROMPT nodes, must include 'components' array for UI rendering. See existing flows for examples."`
 	Prompts      []PromptDefinition     `json:"prompts,omitempty" yaml:"prompts,omitempty" jsonschema:"For PROMPT nodes: defines user inputs and actions. Each prompt has inputs (form fields) and an action (what happens on submit)."`
@@ -151,7 +230,9 @@ type NodeDefinition struct {
 	OnSuccess    string                 `json:"onSuccess,omitempty" yaml:"onSuccess,omitempty" jsonschema:"ID of the next node to execute on successful completion"`
 	OnFailure    string                 `json:"onFailure,omitempty" yaml:"onFailure,omitempty" jsonschema:"ID of the next node to execute on failure"`
 	OnIncomplete string                 `json:"onIncomplete,omitempty" yaml:"onIncomplete,omitempty" jsonschema:"For TASK_EXECUTION nodes: ID of the PROMPT node to forward to when user input is required."`
+	MaxAttempts  int                    `json:"maxAttempts,omitempty" yaml:"maxAttempts,omitempty" jsonschema:"For TASK_EXECUTION nodes: number of failed attempts allowed. The user retries on the same step until the limit is reached, after which the flow moves to 'onFailure', or fails if it is not set."`
 	Condition    *ConditionDefinition   `json:"condition,omitempty" yaml:"condition,omitempty" jsonschema:"Optional condition to determine if this node should execute"`
+	Branches     []BranchDefinition     `json:"branches,omitempty" yaml:"branches,omitempty" jsonschema:"For DECISION nodes: ordered list of conditional branches. The first branch whose condition matches is taken; 'onSuccess' is used as the default branch. For FORK nodes: branches executed concurrently, without conditions. 'onSuccess' is the JOIN node where the branches end."`
 }
 
 // InputDefinition represents an input parameter for a node.
@@ -189,6 +270,19 @@ type ConditionDefinition struct {
 	OnSkip string `json:"onSkip" yaml:"onSkip" jsonschema:"Node ID to skip to if condition is not met."`
 }
 
+// BranchDefinition represents an outgoing edge of a decision or fork node.
+type BranchDefinition struct {
+	Condition *BranchConditionDefinition `json:"condition,omitempty" yaml:"condition,omitempty" jsonschema:"For DECISION nodes: condition that selects this branch."`
+	Next      string                     `json:"next" yaml:"next" jsonschema:"ID of the node to transition to when the condition matches. For FORK nodes: ID of the first node of the branch."`
+}
+
+// BranchConditionDefinition represents the condition of a decision branch.
+type BranchConditionDefinition struct {
+	Key      string `json:"key" yaml:"key" jsonschema:"Expression to evaluate. Example: '{{ context.userType }}' for runtime data and user inputs, '{{ user.country }}' for authenticated user attributes."`
+	Operator string `json:"operator,omitempty" yaml:"operator,omitempty" jsonschema:"Comparison operator: 'equals' (default), 'notEquals', 'in' (comma separated values), 'exists' or 'notExists'."`
+	Value    string `json:"value,omitempty" yaml:"value,omitempty" jsonschema:"Value to compare the resolved expression with."`
+}
+
 // nodeDefinitionAlias is used to avoid infinite recursion during marshaling/unmarshaling.
 type nodeDefinitionAlias NodeDefinition
 
@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/mcp/resource"
+)
+
+// flowsResourceURI is the URI of the MCP resource listing the flows.
+var flowsResourceURI = resource.BuildURI("flows")
+
+// flowResources provides MCP resources exposing the flow definitions.
+type flowResources struct {
+	flowService FlowMgtServiceInterface
+}
+
+// registerMCPResources registers all flow resources with the MCP server.
+func registerMCPResources(server *mcp.Server, flowService FlowMgtServiceInterface) {
+	resources := &flowResources{
+		flowService: flowService,
+	}
+
+	server.AddResource(&mcp.Resource{
+		URI:         flowsResourceURI,
+		Name:        "flows",
+		Title:       "Flows",
+		Description: `Basic details of all authentication and registration flows.`,
+		MIMEType:    resource.MIMETypeJSON,
+	}, resources.readFlows)
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: flowsResourceURI + "/{id}",
+		Name:        "flow",
+		Title:       "Flow",
+		Description: `Complete definition of a flow, including the nodes and transitions of its graph.`,
+		MIMEType:    resource.MIMETypeJSON,
+	}, resources.readFlow)
+}
+
+// readFlows handles reads of the flow list resource.
+func (r *flowResources) readFlows(
+	ctx context.Context,
+	req *mcp.ReadResourceRequest,
+) (*mcp.ReadResourceResult, error) {
+	flows := make([]BasicFlowDefinition, 0)
+	for offset := 0; ; {
+		listResponse, svcErr := r.flowService.ListFlows(ctx, maxPageSize, offset, "")
+		if svcErr != nil {
+			return nil, fmt.Errorf("failed to list flows: %s", svcErr.ErrorDescription)
+		}
+
+		flows = append(flows, listResponse.Flows...)
+		offset += len(listResponse.Flows)
+		if len(listResponse.Flows) == 0 || offset >= listResponse.TotalResults {
+			break
+		}
+	}
+
+	return resource.JSONContents(req.Params.URI, flowListOutput{
+		TotalCount: len(flows),
+		Flows:      flows,
+	})
+}
+
+// readFlow handles reads of a flow resource.
+func (r *flowResources) readFlow(
+	ctx context.Context,
+	req *mcp.ReadResourceRequest,
+) (*mcp.ReadResourceResult, error) {
+	flowID, ok := resource.ExtractID(req.Params.URI, flowsResourceURI)
+	if !ok {
+		return nil, mcp.ResourceNotFoundError(req.Params.URI)
+	}
+
+	flow, svcErr := r.flowService.GetFlow(ctx, flowID)
+	if svcErr != nil {
+		if svcErr.Code == ErrorFlowNotFound.Code {
+			return nil, mcp.ResourceNotFoundError(req.Params.URI)
+		}
+		return nil, fmt.Errorf("failed to get flow: %s", svcErr.ErrorDescription)
+	}
+
+	return resource.JSONContents(req.Params.URI, flow)
+}
+
+// notifyingFlowService decorates the flow management service to notify the MCP clients subscribed to the
+// flow resources when a flow changes, regardless of whether the change is made through the REST API or an
+// MCP tool.
+type notifyingFlowService struct {
+	FlowMgtServiceInterface
+	notifier resource.NotifierInterface
+}
+
+// newNotifyingFlowService creates a flow management service that sends resource change notifications.
+func newNotifyingFlowService(
+	flowService FlowMgtServiceInterface,
+	notifier resource.NotifierInterface,
+) FlowMgtServiceInterface {
+	return &notifyingFlowService{
+		FlowMgtServiceInterface: flowService,
+		notifier:                notifier,
+	}
+}
+
+// CreateFlow creates a flow and notifies the subscribers of the flow list.
+func (s *notifyingFlowService) CreateFlow(
+	ctx context.Context, flowDef *FlowDefinition,
+) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
+	flow, svcErr := s.FlowMgtServiceInterface.CreateFlow(ctx, flowDef)
+	if svcErr == nil {
+		s.notifier.NotifyUpdated(ctx, flowsResourceURI)
+	}
+	return flow, svcErr
+}
+
+// UpdateFlow updates a flow and notifies the subscribers of the flow and the flow list.
+func (s *notifyingFlowService) UpdateFlow(
+	ctx context.Context, flowID string, flowDef *FlowDefinition,
+) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
+	flow, svcErr := s.FlowMgtServiceInterface.UpdateFlow(ctx, flowID, flowDef)
+	if svcErr == nil {
+		s.notifyFlowChanged(ctx, flowID)
+	}
+	return flow, svcErr
+}
+
+// DeleteFlow deletes a flow and notifies the subscribers of the flow and the flow list.
+func (s *notifyingFlowService) DeleteFlow(ctx context.Context, flowID string) *serviceerror.ServiceError {
+	svcErr := s.FlowMgtServiceInterface.DeleteFlow(ctx, flowID)
+	if svcErr == nil {
+		s.notifyFlowChanged(ctx, flowID)
+	}
+	return svcErr
+}
+
+// RestoreFlowVersion restores a flow version and notifies the subscribers of the flow and the flow list.
+func (s *notifyingFlowService) RestoreFlowVersion(
+	ctx context.Context, flowID string, version int,
+) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
+	flow, svcErr := s.FlowMgtServiceInterface.RestoreFlowVersion(ctx, flowID, version)
+	if svcErr == nil {
+		s.notifyFlowChanged(ctx, flowID)
+	}
+	return flow, svcErr
+}
+
+// PublishFlowDraft publishes a flow draft and notifies the subscribers of the flow and the flow list.
+func (s *notifyingFlowService) PublishFlowDraft(
+	ctx context.Context, flowID string,
+) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
+	flow, svcErr := s.FlowMgtServiceInterface.PublishFlowDraft(ctx, flowID)
+	if svcErr == nil {
+		s.notifyFlowChanged(ctx, flowID)
+	}
+	return flow, svcErr
+}
+
+// ImportFlow imports a flow and notifies the subscribers of the flow list, and of the flow when it is updated.
+func (s *notifyingFlowService) ImportFlow(
+	ctx context.Context, request *FlowImportRequest,
+) (*FlowImportResponse, *serviceerror.ServiceError) {
+	result, svcErr := s.FlowMgtServiceInterface.ImportFlow(ctx, request)
+	if svcErr == nil {
+		if result.Operation == importOperationUpdate {
+			s.notifyFlowChanged(ctx, result.Flow.ID)
+		} else {
+			s.notifier.NotifyUpdated(ctx, flowsResourceURI)
+		}
+	}
+	return result, svcErr
+}
+
+// notifyFlowChanged notifies the subscribers of the given flow and of the flow list.
+func (s *notifyingFlowService) notifyFlowChanged(ctx context.Context, flowID string) {
+	s.notifier.NotifyUpdated(ctx, resource.BuildURI("flows", flowID), flowsResourceURI)
+}
@@ -0,0 +1,269 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	flowCommon "github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/tests/mocks/mcp/resourcemock"
+)
+
+const testFlowResourceURI = "thunderid://flows/flow1"
+
+type FlowResourcesTestSuite struct {
+	suite.Suite
+}
+
+func TestFlowResourcesTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowResourcesTestSuite))
+}
+
+func newReadResourceRequest(uri string) *mcp.ReadResourceRequest {
+	return &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}}
+}
+
+func (suite *FlowResourcesTestSuite) TestReadFlows_Success() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	resources := &flowResources{flowService: mockService}
+
+	mockService.On("ListFlows", mock.Anything, maxPageSize, 0, flowCommon.FlowType("")).
+		Return(&FlowListResponse{
+			TotalResults: 2,
+			Flows:        []BasicFlowDefinition{{ID: "flow1"}},
+		}, nil)
+	mockService.On("ListFlows", mock.Anything, maxPageSize, 1, flowCommon.FlowType("")).
+		Return(&FlowListResponse{
+			TotalResults: 2,
+			Flows:        []BasicFlowDefinition{{ID: "flow2"}},
+		}, nil)
+
+	result, err := resources.readFlows(context.Background(), newReadResourceRequest(flowsResourceURI))
+
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), result.Contents, 1)
+	assert.Equal(suite.T(), flowsResourceURI, result.Contents[0].URI)
+
+	var output flowListOutput
+	require.NoError(suite.T(), json.Unmarshal([]byte(result.Contents[0].Text), &output))
+	assert.Equal(suite.T(), 2, output.TotalCount)
+	require.Len(suite.T(), output.Flows, 2)
+	assert.Equal(suite.T(), "flow2", output.Flows[1].ID)
+}
+
+func (suite *FlowResourcesTestSuite) TestReadFlows_Error() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	resources := &flowResources{flowService: mockService}
+
+	mockService.On("ListFlows", mock.Anything, maxPageSize, 0, flowCommon.FlowType("")).
+		Return(nil, &serviceerror.ServiceError{
+			ErrorDescription: core.I18nMessage{Key: "error.test.database_error", DefaultValue: "database error"},
+		})
+
+	result, err := resources.readFlows(context.Background(), newReadResourceRequest(flowsResourceURI))
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "failed to list flows")
+}
+
+func (suite *FlowResourcesTestSuite) TestReadFlow_Success() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	resources := &flowResources{flowService: mockService}
+
+	mockService.On("GetFlow", mock.Anything, "flow1").Return(&CompleteFlowDefinition{
+		ID:    "flow1",
+		Nodes: []NodeDefinition{{ID: "start", Type: "START"}, {ID: "end", Type: "END"}},
+	}, nil)
+
+	result, err := resources.readFlow(context.Background(), newReadResourceRequest(testFlowResourceURI))
+
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), result.Contents, 1)
+
+	var flow CompleteFlowDefinition
+	require.NoError(suite.T(), json.Unmarshal([]byte(result.Contents[0].Text), &flow))
+	assert.Equal(suite.T(), "flow1", flow.ID)
+	assert.Len(suite.T(), flow.Nodes, 2)
+}
+
+func (suite *FlowResourcesTestSuite) TestReadFlow_NotFound() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	resources := &flowResources{flowService: mockService}
+
+	mockService.On("GetFlow", mock.Anything, "flow1").Return(nil, &ErrorFlowNotFound)
+
+	result, err := resources.readFlow(context.Background(), newReadResourceRequest(testFlowResourceURI))
+
+	assert.Nil(suite.T(), result)
+	var rpcErr *jsonrpc.Error
+	require.True(suite.T(), errors.As(err, &rpcErr))
+	assert.Equal(suite.T(), int64(mcp.CodeResourceNotFound), rpcErr.Code)
+}
+
+func (suite *FlowResourcesTestSuite) TestReadFlow_InvalidURI() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	resources := &flowResources{flowService: mockService}
+
+	result, err := resources.readFlow(context.Background(), newReadResourceRequest(flowsResourceURI+"/"))
+
+	assert.Nil(suite.T(), result)
+	var rpcErr *jsonrpc.Error
+	require.True(suite.T(), errors.As(err, &rpcErr))
+	assert.Equal(suite.T(), int64(mcp.CodeResourceNotFound), rpcErr.Code)
+}
+
+func (suite *FlowResourcesTestSuite) TestNotifyingFlowService_CreateFlow() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	mockNotifier := resourcemock.NewNotifierInterfaceMock(suite.T())
+	service := newNotifyingFlowService(mockService, mockNotifier)
+
+	flowDef := &FlowDefinition{Handle: "basic-login"}
+	mockService.On("CreateFlow", mock.Anything, flowDef).Return(&CompleteFlowDefinition{ID: "flow1"}, nil)
+	mockNotifier.On("NotifyUpdated", mock.Anything, flowsResourceURI).Return()
+
+	flow, svcErr := service.CreateFlow(context.Background(), flowDef)
+
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), "flow1", flow.ID)
+}
+
+func (suite *FlowResourcesTestSuite) TestNotifyingFlowService_UpdateFlow() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	mockNotifier := resourcemock.NewNotifierInterfaceMock(suite.T())
+	service := newNotifyingFlowService(mockService, mockNotifier)
+
+	flowDef := &FlowDefinition{Handle: "basic-login"}
+	mockService.On("UpdateFlow", mock.Anything, "flow1", flowDef).Return(&CompleteFlowDefinition{ID: "flow1"}, nil)
+	mockNotifier.On("NotifyUpdated", mock.Anything, testFlowResourceURI, flowsResourceURI).Return()
+
+	_, svcErr := service.UpdateFlow(context.Background(), "flow1", flowDef)
+
+	assert.Nil(suite.T(), svcErr)
+}
+
+func (suite *FlowResourcesTestSuite) TestNotifyingFlowService_DeleteFlow() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	mockNotifier := resourcemock.NewNotifierInterfaceMock(suite.T())
+	service := newNotifyingFlowService(mockService, mockNotifier)
+
+	mockService.On("DeleteFlow", mock.Anything, "flow1").Return(nil)
+	mockNotifier.On("NotifyUpdated", mock.Anything, testFlowResourceURI, flowsResourceURI).Return()
+
+	assert.Nil(suite.T(), service.DeleteFlow(context.Background(), "flow1"))
+}
+
+func (suite *FlowResourcesTestSuite) TestNotifyingFlowService_RestoreFlowVersion() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	mockNotifier := resourcemock.NewNotifierInterfaceMock(suite.T())
+	service := newNotifyingFlowService(mockService, mockNotifier)
+
+	mockService.On("RestoreFlowVersion", mock.Anything, "flow1", 2).Return(&CompleteFlowDefinition{ID: "flow1"}, nil)
+	mockNotifier.On("NotifyUpdated", mock.Anything, testFlowResourceURI, flowsResourceURI).Return()
+
+	_, svcErr := service.RestoreFlowVersion(context.Background(), "flow1", 2)
+
+	assert.Nil(suite.T(), svcErr)
+}
+
+func (suite *FlowResourcesTestSuite) TestNotifyingFlowService_PublishFlowDraft() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	mockNotifier := resourcemock.NewNotifierInterfaceMock(suite.T())
+	service := newNotifyingFlowService(mockService, mockNotifier)
+
+	mockService.On("PublishFlowDraft", mock.Anything, "flow1").Return(&CompleteFlowDefinition{ID: "flow1"}, nil)
+	mockNotifier.On("NotifyUpdated", mock.Anything, testFlowResourceURI, flowsResourceURI).Return()
+
+	_, svcErr := service.PublishFlowDraft(context.Background(), "flow1")
+
+	assert.Nil(suite.T(), svcErr)
+}
+
+func (suite *FlowResourcesTestSuite) TestNotifyingFlowService_ImportFlow() {
+	testCases := []struct {
+		name         string
+		operation    string
+		expectedURIs []interface{}
+	}{
+		{name: "Create", operation: importOperationCreate, expectedURIs: []interface{}{flowsResourceURI}},
+		{
+			name:         "Update",
+			operation:    importOperationUpdate,
+			expectedURIs: []interface{}{testFlowResourceURI, flowsResourceURI},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+			mockNotifier := resourcemock.NewNotifierInterfaceMock(suite.T())
+			service := newNotifyingFlowService(mockService, mockNotifier)
+
+			request := &FlowImportRequest{Content: "flow: {}"}
+			mockService.On("ImportFlow", mock.Anything, request).Return(&FlowImportResponse{
+				Operation: tc.operation,
+				Flow:      &CompleteFlowDefinition{ID: "flow1"},
+			}, nil)
+			mockNotifier.On("NotifyUpdated", append([]interface{}{mock.Anything}, tc.expectedURIs...)...).Return()
+
+			result, svcErr := service.ImportFlow(context.Background(), request)
+
+			assert.Nil(suite.T(), svcErr)
+			assert.Equal(suite.T(), tc.operation, result.Operation)
+		})
+	}
+}
+
+func (suite *FlowResourcesTestSuite) TestNotifyingFlowService_NoNotificationOnError() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	mockNotifier := resourcemock.NewNotifierInterfaceMock(suite.T())
+	service := newNotifyingFlowService(mockService, mockNotifier)
+
+	mockService.On("DeleteFlow", mock.Anything, "flow1").Return(&ErrorFlowNotFound)
+
+	svcErr := service.DeleteFlow(context.Background(), "flow1")
+
+	assert.Equal(suite.T(), &ErrorFlowNotFound, svcErr)
+	mockNotifier.AssertNotCalled(suite.T(), "NotifyUpdated", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *FlowResourcesTestSuite) TestNotifyingFlowService_DelegatesReads() {
+	mockService := NewFlowMgtServiceInterfaceMock(suite.T())
+	mockNotifier := resourcemock.NewNotifierInterfaceMock(suite.T())
+	service := newNotifyingFlowService(mockService, mockNotifier)
+
+	mockService.On("GetFlow", mock.Anything, "flow1").Return(&CompleteFlowDefinition{ID: "flow1"}, nil)
+
+	flow, svcErr := service.GetFlow(context.Background(), "flow1")
+
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), "flow1", flow.ID)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
@@ -65,8 +66,17 @@ type FlowMgtServiceInterface interface {
 	GetFlowVersion(ctx context.Context, flowID string, version int) (*FlowVersion, *serviceerror.ServiceError)
 	RestoreFlowVersion(ctx context.Context, flowID string, version int) (
 		*CompleteFlowDefinition, *serviceerror.ServiceError)
+	GetFlowDraft(ctx context.Context, flowID string) (*FlowDraft, *serviceerror.ServiceError)
+	SaveFlowDraft(ctx context.Context, flowID string, flowDef *FlowDefinition) (
+		*FlowDraft, *serviceerror.ServiceError)
+	DeleteFlowDraft(ctx context.Context, flowID string) *serviceerror.ServiceError
+	PublishFlowDraft(ctx context.Context, flowID string) (*CompleteFlowDefinition, *serviceerror.ServiceError)
 	GetGraph(ctx context.Context, flowID string) (core.GraphInterface, *serviceerror.ServiceError)
+	BuildDraftGraph(ctx context.Context, flowDef *FlowDefinition) (core.GraphInterface, *serviceerror.ServiceError)
 	IsValidFlow(ctx context.Context, flowID string, flowType common.FlowType) (bool, *serviceerror.ServiceError)
+	ValidateFlow(ctx context.Context, flowDef *FlowDefinition) (*FlowValidationResult, *serviceerror.ServiceError)
+	ExportFlow(ctx context.Context, flowID string) (*FlowBundle, *serviceerror.ServiceError)
+	ImportFlow(ctx context.Context, request *FlowImportRequest) (*FlowImportResponse, *serviceerror.ServiceError)
 }
 
 // flowMgtService is the default implementation of the FlowMgtServiceInterface.
@@ -75,6 +85,7 @@ type flowMgtService struct {
 	inferenceService flowInferenceServiceInterface
 	graphBuilder     graphBuilderInterface
 	executorRegistry executor.ExecutorRegistryInterface
+	idpService       idp.IDPServiceInterface
 	compositeStore   *compositeFlowStore
 	transactioner    transaction.Transactioner
 	logger           *log.Logger
@@ -86,6 +97,7 @@ func newFlowMgtService(
 	inferenceService flowInferenceServiceInterface,
 	graphBuilder graphBuilderInterface,
 	executorRegistry executor.ExecutorRegistryInterface,
+	idpService idp.IDPServiceInterface,
 	compositeStore *compositeFlowStore,
 	transactioner transaction.Transactioner,
 ) FlowMgtServiceInterface {
@@ -94,6 +106,7 @@ func newFlowMgtService(
 		inferenceService: inferenceService,
 		graphBuilder:     graphBuilder,
 		executorRegistry: executorRegistry,
+		idpService:       idpService,
 		compositeStore:   compositeStore,
 		transactioner:    transactioner,
 		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
@@ -459,6 +472,23 @@ func (s *flowMgtService) GetGraph(ctx context.Context, flowID string) (
 	return s.graphBuilder.GetGraph(ctx, flow)
 }
 
+// BuildDraftGraph validates the given flow definition and builds a graph from it without persisting
+// the definition or caching the graph. It is used to execute unsaved or historical flow versions.
+func (s *flowMgtService) BuildDraftGraph(ctx context.Context, flowDef *FlowDefinition) (
+	core.GraphInterface, *serviceerror.ServiceError) {
+	if err := validateFlowDefinition(flowDef); err != nil {
+		return nil, err
+	}
+
+	return s.graphBuilder.BuildGraph(&CompleteFlowDefinition{
+		ID:       flowDef.ID,
+		Handle:   flowDef.Handle,
+		Name:     flowDef.Name,
+		FlowType: flowDef.FlowType,
+		Nodes:    flowDef.Nodes,
+	})
+}
+
 // IsValidFlow checks if a flow exists for the given flow ID and matches the expected type.
 // Returns (false, nil) when the flow is not found or the type does not match (client error).
 // Returns (false, *serviceerror.ServiceError) when a store failure occurs (server error).
@@ -486,7 +516,8 @@ func isValidFlowType(flowType common.FlowType) bool {
 	return flowType == common.FlowTypeAuthentication ||
 		flowType == common.FlowTypeRegistration ||
 		flowType == common.FlowTypeUserOnboarding ||
-		flowType == common.FlowTypeRecovery
+		flowType == common.FlowTypeRecovery ||
+		flowType == common.FlowTypeMFAEnrollment
 }
 
 // buildPaginationLinks constructs pagination links for the flow list response.
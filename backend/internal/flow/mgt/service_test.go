@@ -61,7 +61,7 @@ func (s *FlowMgtServiceTestSuite) SetupTest() {
 	s.mockGraphBuilder = newGraphBuilderInterfaceMock(s.T())
 	s.mockExecutorRegistry = executormock.NewExecutorRegistryInterfaceMock(s.T())
 	s.service = newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		s.mockExecutorRegistry, nil, &stubTransactioner{})
+		s.mockExecutorRegistry, nil, nil, &stubTransactioner{})
 
 	testConfig := &config.Config{
 		Flow: config.FlowConfig{
@@ -1023,6 +1023,42 @@ func (s *FlowMgtServiceTestSuite) TestGetGraph_StoreError() {
 	s.Equal(&serviceerror.InternalServerError, err)
 }
 
+// BuildDraftGraph tests
+
+func (s *FlowMgtServiceTestSuite) TestBuildDraftGraph_Success() {
+	flowDef := &FlowDefinition{
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes:    []NodeDefinition{{Type: "start"}, {Type: "action"}, {Type: "end"}},
+	}
+	s.mockGraphBuilder.EXPECT().BuildGraph(&CompleteFlowDefinition{
+		Handle:   flowDef.Handle,
+		Name:     flowDef.Name,
+		FlowType: flowDef.FlowType,
+		Nodes:    flowDef.Nodes,
+	}).Return(nil, nil)
+
+	result, err := s.service.BuildDraftGraph(context.Background(), flowDef)
+
+	s.Nil(err)
+	s.Nil(result)
+	s.mockStore.AssertNotCalled(s.T(), "CreateFlow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *FlowMgtServiceTestSuite) TestBuildDraftGraph_InvalidDefinition() {
+	flowDef := &FlowDefinition{
+		Handle:   "test-handle",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes:    []NodeDefinition{{Type: "start"}, {Type: "action"}, {Type: "end"}},
+	}
+
+	result, err := s.service.BuildDraftGraph(context.Background(), flowDef)
+
+	s.Nil(result)
+	s.Equal(&ErrorMissingFlowName, err)
+}
+
 // IsValidFlow tests
 
 func (s *FlowMgtServiceTestSuite) TestIsValidFlow_Success() {
@@ -1091,7 +1127,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_Success() {
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, nil, &stubTransactioner{})
 
 	authFlowDef := &FlowDefinition{
 		Handle:   "auth-flow",
@@ -1151,7 +1187,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_SkipsNonAuthFlow(
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, nil, &stubTransactioner{})
 
 	regFlowDef := &FlowDefinition{
 		Handle:   "reg-flow",
@@ -1179,7 +1215,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_HandlesInferenceE
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, nil, &stubTransactioner{})
 
 	authFlowDef := &FlowDefinition{
 		Handle:   "auth-flow",
@@ -1209,7 +1245,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_HandlesStoreError
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, nil, &stubTransactioner{})
 
 	authFlowDef := &FlowDefinition{
 		Handle:   "auth-flow",
@@ -1249,7 +1285,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_DisabledAutoInfer
 	// Auto-inference is disabled in SetupTest, so just verify early return
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, nil, &stubTransactioner{})
 
 	authFlowDef := &FlowDefinition{
 		Handle:   "auth-flow",
@@ -1277,7 +1313,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_SkipsPasskeyRegis
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, nil, &stubTransactioner{})
 
 	// Auth flow with PasskeyAuthExecutor in register_start and register_finish modes
 	authFlowDef := &FlowDefinition{
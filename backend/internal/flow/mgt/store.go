@@ -42,6 +42,7 @@ const (
 	colCreatedAt     = "created_at"
 	colUpdatedAt     = "updated_at"
 	colVersion       = "version"
+	colBaseVersion   = "base_version"
 	colCount         = "count"
 )
 
@@ -59,6 +60,9 @@ type flowStoreInterface interface {
 	GetFlowVersion(ctx context.Context, flowID string, version int) (*FlowVersion, error)
 	RestoreFlowVersion(ctx context.Context, flowID string, version int) (*CompleteFlowDefinition, error)
 	IsFlowExistsByHandle(ctx context.Context, handle string, flowType common.FlowType) (bool, error)
+	GetFlowDraft(ctx context.Context, flowID string) (*FlowDraft, error)
+	SaveFlowDraft(ctx context.Context, flowID string, baseVersion int, flow *FlowDefinition) (*FlowDraft, error)
+	DeleteFlowDraft(ctx context.Context, flowID string) error
 }
 
 // flowStore is the default implementation of flowStoreInterface.
@@ -380,6 +384,61 @@ func (s *flowStore) RestoreFlowVersion(ctx context.Context, flowID string, versi
 	return s.GetFlowByID(ctx, flowID)
 }
 
+// GetFlowDraft retrieves the draft of a flow definition.
+func (s *flowStore) GetFlowDraft(ctx context.Context, flowID string) (*FlowDraft, error) {
+	var draft *FlowDraft
+
+	err := s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
+		results, err := dbClient.QueryContext(ctx, queryGetFlowDraft, flowID, s.deploymentID)
+		if err != nil {
+			return fmt.Errorf("failed to get flow draft: %w", err)
+		}
+		if len(results) == 0 {
+			return errDraftNotFound
+		}
+
+		draft, err = s.buildFlowDraftFromRow(results[0])
+		return err
+	})
+
+	return draft, err
+}
+
+// SaveFlowDraft creates or replaces the draft of a flow definition.
+// The active version of the flow is left unchanged.
+func (s *flowStore) SaveFlowDraft(ctx context.Context, flowID string, baseVersion int, flow *FlowDefinition) (
+	*FlowDraft, error) {
+	nodesJSON, err := json.Marshal(flow.Nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal nodes: %w", err)
+	}
+
+	err = s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
+		_, err := dbClient.ExecuteContext(ctx, queryUpsertFlowDraft, flowID, flow.Name, baseVersion,
+			string(nodesJSON), s.deploymentID)
+		if err != nil {
+			return fmt.Errorf("failed to save flow draft: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetFlowDraft(ctx, flowID)
+}
+
+// DeleteFlowDraft deletes the draft of a flow definition.
+func (s *flowStore) DeleteFlowDraft(ctx context.Context, flowID string) error {
+	return s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
+		_, err := dbClient.ExecuteContext(ctx, queryDeleteFlowDraft, flowID, s.deploymentID)
+		if err != nil {
+			return fmt.Errorf("failed to delete flow draft: %w", err)
+		}
+		return nil
+	})
+}
+
 // pushToVersionStack adds a new version to the version history and removes the oldest version
 // if the count exceeds max_version_history.
 func (s *flowStore) pushToVersionStack(ctx context.Context, dbClient provider.DBClientInterface,
@@ -678,6 +737,65 @@ func (s *flowStore) buildFlowVersionFromRow(row map[string]interface{}) (*FlowVe
 	return flowVersion, nil
 }
 
+// buildFlowDraftFromRow builds a FlowDraft from a single joined database row.
+func (s *flowStore) buildFlowDraftFromRow(row map[string]interface{}) (*FlowDraft, error) {
+	flowID, err := s.getString(row, colFlowID)
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := s.getString(row, colHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := s.getString(row, colName)
+	if err != nil {
+		return nil, err
+	}
+
+	flowTypeStr, err := s.getString(row, colFlowType)
+	if err != nil {
+		return nil, err
+	}
+
+	baseVersion, err := s.getInt64(row, colBaseVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := s.getTimestamp(row, colCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := s.getTimestamp(row, colUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesJSON, err := s.getString(row, colNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := &FlowDraft{
+		ID:          flowID,
+		Handle:      handle,
+		Name:        name,
+		FlowType:    common.FlowType(flowTypeStr),
+		BaseVersion: int(baseVersion),
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+
+	if err := json.Unmarshal([]byte(nodesJSON), &draft.Nodes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal nodes: %w", err)
+	}
+
+	return draft, nil
+}
+
 // getMaxVersionHistory retrieves the maximum version history size from configuration.
 // If not set or invalid, returns the default value.
 func getMaxVersionHistory() int {
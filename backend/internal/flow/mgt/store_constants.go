@@ -140,4 +140,32 @@ var (
 			`AND f.DEPLOYMENT_ID = fv.DEPLOYMENT_ID AND f.ACTIVE_VERSION = fv.VERSION ` +
 			`WHERE f.HANDLE = $1 AND f.FLOW_TYPE = $2 AND f.DEPLOYMENT_ID = $3`,
 	}
+
+	// queryGetFlowDraft is the query to retrieve the draft of a flow with its flow metadata.
+	queryGetFlowDraft = model.DBQuery{
+		ID: "FLQ-FLOW_MGT-19",
+		Query: `SELECT f.ID, f.HANDLE, d.NAME, f.FLOW_TYPE, d.BASE_VERSION, d.NODES, d.CREATED_AT, ` +
+			`d.UPDATED_AT FROM "FLOW_DRAFT" d INNER JOIN "FLOW" f ON d.FLOW_ID = f.ID ` +
+			`AND d.DEPLOYMENT_ID = f.DEPLOYMENT_ID WHERE d.FLOW_ID = $1 AND d.DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpsertFlowDraft is the query to create or replace the draft of a flow.
+	queryUpsertFlowDraft = model.DBQuery{
+		ID: "FLQ-FLOW_MGT-20",
+		Query: `INSERT INTO "FLOW_DRAFT" (FLOW_ID, NAME, BASE_VERSION, NODES, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5) ON CONFLICT (FLOW_ID, DEPLOYMENT_ID) DO UPDATE SET ` +
+			`NAME = $2, BASE_VERSION = $3, NODES = $4, UPDATED_AT = datetime('now')`,
+		SQLiteQuery: `INSERT INTO "FLOW_DRAFT" (FLOW_ID, NAME, BASE_VERSION, NODES, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5) ON CONFLICT (FLOW_ID, DEPLOYMENT_ID) DO UPDATE SET ` +
+			`NAME = $2, BASE_VERSION = $3, NODES = $4, UPDATED_AT = datetime('now')`,
+		PostgresQuery: `INSERT INTO "FLOW_DRAFT" (FLOW_ID, NAME, BASE_VERSION, NODES, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5) ON CONFLICT (FLOW_ID, DEPLOYMENT_ID) DO UPDATE SET ` +
+			`NAME = $2, BASE_VERSION = $3, NODES = $4, UPDATED_AT = CURRENT_TIMESTAMP`,
+	}
+
+	// queryDeleteFlowDraft is the query to delete the draft of a flow.
+	queryDeleteFlowDraft = model.DBQuery{
+		ID:    "FLQ-FLOW_MGT-21",
+		Query: `DELETE FROM "FLOW_DRAFT" WHERE FLOW_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
 )
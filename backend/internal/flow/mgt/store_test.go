@@ -1156,3 +1156,135 @@ func (s *FlowStoreTestSuite) TestGetMaxVersionHistory() {
 		})
 	}
 }
+
+func (s *FlowStoreTestSuite) TestGetFlowDraftSuccess() {
+	draftData := map[string]interface{}{
+		colFlowID:      "flow-123",
+		colHandle:      "test-handle",
+		colName:        "Test Flow Draft",
+		colFlowType:    string(common.FlowTypeAuthentication),
+		colBaseVersion: int64(3),
+		colNodes:       `[{"id":"node-1","type":"basic-auth"}]`,
+		colCreatedAt:   "2025-01-02T00:00:00Z",
+		colUpdatedAt:   "2025-01-03T00:00:00Z",
+	}
+
+	s.mockDBProvider.EXPECT().GetConfigDBClient().Return(s.mockDBClient, nil)
+	s.mockDBClient.EXPECT().QueryContext(mock.Anything, queryGetFlowDraft, "flow-123", "test-deployment").
+		Return([]map[string]interface{}{draftData}, nil).Once()
+
+	draft, err := s.store.GetFlowDraft(context.Background(), "flow-123")
+
+	s.NoError(err)
+	s.NotNil(draft)
+	s.Equal("flow-123", draft.ID)
+	s.Equal("Test Flow Draft", draft.Name)
+	s.Equal(common.FlowTypeAuthentication, draft.FlowType)
+	s.Equal(3, draft.BaseVersion)
+	s.Equal("2025-01-03T00:00:00Z", draft.UpdatedAt)
+	s.Len(draft.Nodes, 1)
+}
+
+func (s *FlowStoreTestSuite) TestGetFlowDraftNotFound() {
+	s.mockDBProvider.EXPECT().GetConfigDBClient().Return(s.mockDBClient, nil)
+	s.mockDBClient.EXPECT().QueryContext(mock.Anything, queryGetFlowDraft, "flow-1", "test-deployment").
+		Return([]map[string]interface{}{}, nil).Once()
+
+	draft, err := s.store.GetFlowDraft(context.Background(), "flow-1")
+
+	s.ErrorIs(err, errDraftNotFound)
+	s.Nil(draft)
+}
+
+func (s *FlowStoreTestSuite) TestGetFlowDraftQueryError() {
+	s.mockDBProvider.EXPECT().GetConfigDBClient().Return(s.mockDBClient, nil)
+	s.mockDBClient.EXPECT().QueryContext(mock.Anything, queryGetFlowDraft, "flow-1", "test-deployment").
+		Return(nil, errors.New("query failed")).Once()
+
+	draft, err := s.store.GetFlowDraft(context.Background(), "flow-1")
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to get flow draft")
+	s.Nil(draft)
+}
+
+func (s *FlowStoreTestSuite) TestGetFlowDraftBuildError() {
+	s.mockDBProvider.EXPECT().GetConfigDBClient().Return(s.mockDBClient, nil)
+	s.mockDBClient.EXPECT().QueryContext(mock.Anything, queryGetFlowDraft, "flow-1", "test-deployment").
+		Return([]map[string]interface{}{{colFlowID: "flow-1"}}, nil).Once()
+
+	draft, err := s.store.GetFlowDraft(context.Background(), "flow-1")
+
+	s.Error(err)
+	s.Nil(draft)
+}
+
+func (s *FlowStoreTestSuite) TestSaveFlowDraftSuccess() {
+	flowDef := &FlowDefinition{
+		Handle:   "test-handle",
+		Name:     "Test Flow Draft",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes:    []NodeDefinition{{ID: "node-1", Type: "basic-auth"}},
+	}
+	draftData := map[string]interface{}{
+		colFlowID:      "flow-123",
+		colHandle:      "test-handle",
+		colName:        "Test Flow Draft",
+		colFlowType:    string(common.FlowTypeAuthentication),
+		colBaseVersion: int64(2),
+		colNodes:       `[{"id":"node-1","type":"basic-auth"}]`,
+		colCreatedAt:   "2025-01-02T00:00:00Z",
+		colUpdatedAt:   "2025-01-02T00:00:00Z",
+	}
+
+	s.mockDBProvider.EXPECT().GetConfigDBClient().Return(s.mockDBClient, nil)
+	s.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryUpsertFlowDraft, "flow-123", "Test Flow Draft", 2,
+		mock.AnythingOfType("string"), "test-deployment").Return(int64(1), nil).Once()
+	s.mockDBClient.EXPECT().QueryContext(mock.Anything, queryGetFlowDraft, "flow-123", "test-deployment").
+		Return([]map[string]interface{}{draftData}, nil).Once()
+
+	draft, err := s.store.SaveFlowDraft(context.Background(), "flow-123", 2, flowDef)
+
+	s.NoError(err)
+	s.NotNil(draft)
+	s.Equal(2, draft.BaseVersion)
+}
+
+func (s *FlowStoreTestSuite) TestSaveFlowDraftExecuteError() {
+	flowDef := &FlowDefinition{
+		Handle:   "test-handle",
+		Name:     "Test Flow Draft",
+		FlowType: common.FlowTypeAuthentication,
+	}
+
+	s.mockDBProvider.EXPECT().GetConfigDBClient().Return(s.mockDBClient, nil)
+	s.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryUpsertFlowDraft, "flow-123", "Test Flow Draft", 2,
+		mock.AnythingOfType("string"), "test-deployment").Return(int64(0), errors.New("insert failed")).Once()
+
+	draft, err := s.store.SaveFlowDraft(context.Background(), "flow-123", 2, flowDef)
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to save flow draft")
+	s.Nil(draft)
+}
+
+func (s *FlowStoreTestSuite) TestDeleteFlowDraftSuccess() {
+	s.mockDBProvider.EXPECT().GetConfigDBClient().Return(s.mockDBClient, nil)
+	s.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryDeleteFlowDraft, "flow-1", "test-deployment").
+		Return(int64(1), nil).Once()
+
+	err := s.store.DeleteFlowDraft(context.Background(), "flow-1")
+
+	s.NoError(err)
+}
+
+func (s *FlowStoreTestSuite) TestDeleteFlowDraftExecuteError() {
+	s.mockDBProvider.EXPECT().GetConfigDBClient().Return(s.mockDBClient, nil)
+	s.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryDeleteFlowDraft, "flow-1", "test-deployment").
+		Return(int64(0), errors.New("delete failed")).Once()
+
+	err := s.store.DeleteFlowDraft(context.Background(), "flow-1")
+
+	s.Error(err)
+	s.Contains(err.Error(), "failed to delete flow draft")
+}
@@ -0,0 +1,324 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// ValidateFlow validates the given flow definition without persisting it. It runs a set of semantic checks
+// followed by the graph builder, and reports every issue found as a diagnostic.
+func (s *flowMgtService) ValidateFlow(ctx context.Context, flowDef *FlowDefinition) (
+	*FlowValidationResult, *serviceerror.ServiceError) {
+	if err := validateFlowDefinition(flowDef); err != nil {
+		return nil, err
+	}
+
+	nodes := indexNodeDefinitions(flowDef.Nodes)
+	diagnostics := make([]FlowDiagnostic, 0)
+	diagnostics = append(diagnostics, checkDuplicateNodeIDs(flowDef.Nodes)...)
+	diagnostics = append(diagnostics, checkStartNodes(flowDef.Nodes)...)
+	diagnostics = append(diagnostics, checkNodeReferences(flowDef.Nodes, nodes)...)
+	diagnostics = append(diagnostics, s.checkExecutors(flowDef.Nodes)...)
+
+	idpDiagnostics, svcErr := s.checkIDPReferences(ctx, flowDef.Nodes)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	diagnostics = append(diagnostics, idpDiagnostics...)
+	diagnostics = append(diagnostics, checkUnreachableNodes(flowDef.Nodes, nodes)...)
+	diagnostics = append(diagnostics, checkCycles(flowDef.Nodes, nodes)...)
+
+	// The graph builder stops at the first problem it finds, so it only runs once the checks above pass to
+	// avoid reporting the same problem twice.
+	if !hasErrorDiagnostic(diagnostics) {
+		_, buildErr := s.graphBuilder.BuildGraph(&CompleteFlowDefinition{
+			ID:       flowDef.ID,
+			Handle:   flowDef.Handle,
+			Name:     flowDef.Name,
+			FlowType: flowDef.FlowType,
+			Nodes:    flowDef.Nodes,
+		})
+		if buildErr != nil {
+			diagnostics = append(diagnostics, newErrorDiagnostic(diagnosticCodeGraphBuildFailure, "",
+				buildErr.ErrorDescription.DefaultValue))
+		}
+	}
+
+	return &FlowValidationResult{
+		Valid:       !hasErrorDiagnostic(diagnostics),
+		Diagnostics: diagnostics,
+	}, nil
+}
+
+// checkExecutors checks that every task execution node refers to a registered executor.
+func (s *flowMgtService) checkExecutors(nodeDefs []NodeDefinition) []FlowDiagnostic {
+	diagnostics := make([]FlowDiagnostic, 0)
+	for _, nodeDef := range nodeDefs {
+		if nodeDef.Type != string(common.NodeTypeTaskExecution) {
+			continue
+		}
+		if nodeDef.Executor == nil || nodeDef.Executor.Name == "" {
+			diagnostics = append(diagnostics, newErrorDiagnostic(diagnosticCodeMissingExecutor, nodeDef.ID,
+				fmt.Sprintf("Task execution node %s does not define an executor", nodeDef.ID)))
+			continue
+		}
+		if !s.executorRegistry.IsRegistered(nodeDef.Executor.Name) {
+			diagnostics = append(diagnostics, newErrorDiagnostic(diagnosticCodeUnknownExecutor, nodeDef.ID,
+				fmt.Sprintf("Executor %s of node %s is not registered", nodeDef.Executor.Name, nodeDef.ID)))
+		}
+	}
+
+	return diagnostics
+}
+
+// checkIDPReferences checks that the identity providers referred to by the node properties exist.
+func (s *flowMgtService) checkIDPReferences(ctx context.Context, nodeDefs []NodeDefinition) (
+	[]FlowDiagnostic, *serviceerror.ServiceError) {
+	diagnostics := make([]FlowDiagnostic, 0)
+	knownIDPs := make(map[string]bool)
+	for _, nodeDef := range nodeDefs {
+		value, ok := nodeDef.Properties[nodePropertyIDPID]
+		if !ok {
+			continue
+		}
+
+		idpID, _ := value.(string)
+		exists, checked := knownIDPs[idpID]
+		if !checked {
+			var svcErr *serviceerror.ServiceError
+			exists, svcErr = s.isExistingIDP(ctx, idpID)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			knownIDPs[idpID] = exists
+		}
+
+		if !exists {
+			diagnostics = append(diagnostics, newErrorDiagnostic(diagnosticCodeUnknownIDP, nodeDef.ID,
+				fmt.Sprintf("Node %s refers to an identity provider %v that does not exist", nodeDef.ID, value)))
+		}
+	}
+
+	return diagnostics, nil
+}
+
+// checkDuplicateNodeIDs checks that every node ID is defined only once.
+func checkDuplicateNodeIDs(nodeDefs []NodeDefinition) []FlowDiagnostic {
+	diagnostics := make([]FlowDiagnostic, 0)
+	seen := make(map[string]bool, len(nodeDefs))
+	for _, nodeDef := range nodeDefs {
+		if seen[nodeDef.ID] {
+			diagnostics = append(diagnostics, newErrorDiagnostic(diagnosticCodeDuplicateNodeID, nodeDef.ID,
+				fmt.Sprintf("Node ID %s is defined more than once", nodeDef.ID)))
+		}
+		seen[nodeDef.ID] = true
+	}
+
+	return diagnostics
+}
+
+// checkStartNodes checks that the flow has exactly one start node.
+func checkStartNodes(nodeDefs []NodeDefinition) []FlowDiagnostic {
+	diagnostics := make([]FlowDiagnostic, 0)
+	startNodeID := ""
+	for _, nodeDef := range nodeDefs {
+		if nodeDef.Type != string(common.NodeTypeStart) {
+			continue
+		}
+		if startNodeID != "" {
+			diagnostics = append(diagnostics, newErrorDiagnostic(diagnosticCodeMultipleStartNodes, nodeDef.ID,
+				fmt.Sprintf("Node %s is a second start node; %s is already the start node",
+					nodeDef.ID, startNodeID)))
+			continue
+		}
+		startNodeID = nodeDef.ID
+	}
+
+	if startNodeID == "" {
+		diagnostics = append(diagnostics, newErrorDiagnostic(diagnosticCodeMissingStartNode, "",
+			"Flow definition does not contain a start node"))
+	}
+
+	return diagnostics
+}
+
+// checkNodeReferences checks that every node transition refers to a node defined in the flow.
+func checkNodeReferences(nodeDefs []NodeDefinition, nodes map[string]*NodeDefinition) []FlowDiagnostic {
+	diagnostics := make([]FlowDiagnostic, 0)
+	for i := range nodeDefs {
+		for _, targetID := range getNodeTargets(&nodeDefs[i]) {
+			if _, exists := nodes[targetID]; !exists {
+				diagnostics = append(diagnostics, newErrorDiagnostic(diagnosticCodeUnknownNodeReference,
+					nodeDefs[i].ID, fmt.Sprintf("Node %s refers to node %s that does not exist",
+						nodeDefs[i].ID, targetID)))
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// checkUnreachableNodes checks that every node can be reached from a start node.
+func checkUnreachableNodes(nodeDefs []NodeDefinition, nodes map[string]*NodeDefinition) []FlowDiagnostic {
+	visited := make(map[string]bool, len(nodeDefs))
+	queue := make([]string, 0)
+	for _, nodeDef := range nodeDefs {
+		if nodeDef.Type == string(common.NodeTypeStart) && !visited[nodeDef.ID] {
+			visited[nodeDef.ID] = true
+			queue = append(queue, nodeDef.ID)
+		}
+	}
+	if len(queue) == 0 {
+		// A missing start node is already reported, and every node would be reported as unreachable.
+		return nil
+	}
+
+	for len(queue) > 0 {
+		nodeID := queue[0]
+		queue = queue[1:]
+		for _, targetID := range getNodeTargets(nodes[nodeID]) {
+			if _, exists := nodes[targetID]; exists && !visited[targetID] {
+				visited[targetID] = true
+				queue = append(queue, targetID)
+			}
+		}
+	}
+
+	diagnostics := make([]FlowDiagnostic, 0)
+	for _, nodeDef := range nodeDefs {
+		if !visited[nodeDef.ID] {
+			diagnostics = append(diagnostics, newWarningDiagnostic(diagnosticCodeUnreachableNode, nodeDef.ID,
+				fmt.Sprintf("Node %s cannot be reached from the start node", nodeDef.ID)))
+		}
+	}
+
+	return diagnostics
+}
+
+// checkCycles checks for cycles that do not pass through a prompt node. Cycles through a prompt node are
+// expected, for example when the user retries after a failed attempt, but any other cycle executes without
+// user interaction and may never end.
+func checkCycles(nodeDefs []NodeDefinition, nodes map[string]*NodeDefinition) []FlowDiagnostic {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+
+	diagnostics := make([]FlowDiagnostic, 0)
+	reported := make(map[string]bool)
+	state := make(map[string]int, len(nodeDefs))
+
+	var visit func(nodeID string)
+	visit = func(nodeID string) {
+		state[nodeID] = inProgress
+		for _, targetID := range getNodeTargets(nodes[nodeID]) {
+			target, exists := nodes[targetID]
+			if !exists || target.Type == string(common.NodeTypePrompt) {
+				continue
+			}
+			switch state[targetID] {
+			case unvisited:
+				visit(targetID)
+			case inProgress:
+				if !reported[targetID] {
+					reported[targetID] = true
+					diagnostics = append(diagnostics, newWarningDiagnostic(diagnosticCodeCycle, targetID,
+						fmt.Sprintf("Node %s is part of a cycle that does not pass through a prompt node",
+							targetID)))
+				}
+			}
+		}
+		state[nodeID] = done
+	}
+
+	for _, nodeDef := range nodeDefs {
+		if nodeDef.Type != string(common.NodeTypePrompt) && state[nodeDef.ID] == unvisited {
+			visit(nodeDef.ID)
+		}
+	}
+
+	return diagnostics
+}
+
+// indexNodeDefinitions maps the node IDs to their definitions. The first definition wins when a node ID
+// is defined more than once.
+func indexNodeDefinitions(nodeDefs []NodeDefinition) map[string]*NodeDefinition {
+	nodes := make(map[string]*NodeDefinition, len(nodeDefs))
+	for i := range nodeDefs {
+		if _, exists := nodes[nodeDefs[i].ID]; !exists {
+			nodes[nodeDefs[i].ID] = &nodeDefs[i]
+		}
+	}
+
+	return nodes
+}
+
+// getNodeTargets returns the IDs of the nodes a node can transition to.
+func getNodeTargets(nodeDef *NodeDefinition) []string {
+	targets := make([]string, 0)
+	appendTarget := func(targetID string) {
+		if targetID != "" {
+			targets = append(targets, targetID)
+		}
+	}
+
+	appendTarget(nodeDef.OnSuccess)
+	appendTarget(nodeDef.OnFailure)
+	appendTarget(nodeDef.OnIncomplete)
+	appendTarget(nodeDef.Next)
+	for _, prompt := range nodeDef.Prompts {
+		if prompt.Action != nil {
+			appendTarget(prompt.Action.NextNode)
+		}
+	}
+	for _, branch := range nodeDef.Branches {
+		appendTarget(branch.Next)
+	}
+	if nodeDef.Condition != nil {
+		appendTarget(nodeDef.Condition.OnSkip)
+	}
+
+	return targets
+}
+
+// hasErrorDiagnostic checks whether any of the diagnostics is an error.
+func hasErrorDiagnostic(diagnostics []FlowDiagnostic) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// newErrorDiagnostic creates an error diagnostic.
+func newErrorDiagnostic(code, nodeID, message string) FlowDiagnostic {
+	return FlowDiagnostic{Severity: DiagnosticSeverityError, Code: code, Message: message, NodeID: nodeID}
+}
+
+// newWarningDiagnostic creates a warning diagnostic.
+func newWarningDiagnostic(code, nodeID, message string) FlowDiagnostic {
+	return FlowDiagnostic{Severity: DiagnosticSeverityWarning, Code: code, Message: message, NodeID: nodeID}
+}
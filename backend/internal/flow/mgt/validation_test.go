@@ -0,0 +1,320 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/executormock"
+	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
+)
+
+const testValidationExecutor = "BasicAuthExecutor"
+
+type FlowValidationTestSuite struct {
+	suite.Suite
+	service              FlowMgtServiceInterface
+	mockGraphBuilder     *graphBuilderInterfaceMock
+	mockExecutorRegistry *executormock.ExecutorRegistryInterfaceMock
+	mockIDPService       *idpmock.IDPServiceInterfaceMock
+}
+
+func TestFlowValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowValidationTestSuite))
+}
+
+func (s *FlowValidationTestSuite) SetupTest() {
+	s.mockGraphBuilder = newGraphBuilderInterfaceMock(s.T())
+	s.mockExecutorRegistry = executormock.NewExecutorRegistryInterfaceMock(s.T())
+	s.mockIDPService = idpmock.NewIDPServiceInterfaceMock(s.T())
+	s.service = newFlowMgtService(newFlowStoreInterfaceMock(s.T()), newFlowInferenceServiceInterfaceMock(s.T()),
+		s.mockGraphBuilder, s.mockExecutorRegistry, s.mockIDPService, nil, &stubTransactioner{})
+}
+
+func (s *FlowValidationTestSuite) newFlowDefinition(nodes []NodeDefinition) *FlowDefinition {
+	return &FlowDefinition{
+		Handle:   "test-flow",
+		Name:     "Test Flow",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes:    nodes,
+	}
+}
+
+func (s *FlowValidationTestSuite) validNodes() []NodeDefinition {
+	return []NodeDefinition{
+		{ID: "start", Type: "START", OnSuccess: "prompt"},
+		{
+			ID:   "prompt",
+			Type: "PROMPT",
+			Prompts: []PromptDefinition{
+				{Action: &ActionDefinition{Ref: "submit", NextNode: "auth"}},
+			},
+		},
+		{
+			ID:           "auth",
+			Type:         "TASK_EXECUTION",
+			Executor:     &ExecutorDefinition{Name: testValidationExecutor},
+			OnSuccess:    "end",
+			OnIncomplete: "prompt",
+		},
+		{ID: "end", Type: "END"},
+	}
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_ValidFlow() {
+	flowDef := s.newFlowDefinition(s.validNodes())
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testValidationExecutor).Return(true)
+	s.mockGraphBuilder.EXPECT().BuildGraph(mock.Anything).Return(nil, nil)
+
+	result, err := s.service.ValidateFlow(context.Background(), flowDef)
+
+	s.Nil(err)
+	s.True(result.Valid)
+	s.Empty(result.Diagnostics)
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_InvalidDefinition() {
+	flowDef := s.newFlowDefinition(s.validNodes())
+	flowDef.Name = ""
+
+	result, err := s.service.ValidateFlow(context.Background(), flowDef)
+
+	s.Nil(result)
+	s.Equal(&ErrorMissingFlowName, err)
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_GraphBuildFailure() {
+	flowDef := s.newFlowDefinition(s.validNodes())
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testValidationExecutor).Return(true)
+	s.mockGraphBuilder.EXPECT().BuildGraph(mock.Anything).Return(nil,
+		serviceerror.CustomServiceError(ErrorGraphBuildFailure, ErrorGraphBuildFailure.ErrorDescription))
+
+	result, err := s.service.ValidateFlow(context.Background(), flowDef)
+
+	s.Nil(err)
+	s.False(result.Valid)
+	s.Len(result.Diagnostics, 1)
+	s.Equal(diagnosticCodeGraphBuildFailure, result.Diagnostics[0].Code)
+	s.Equal(DiagnosticSeverityError, result.Diagnostics[0].Severity)
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_StartNodes() {
+	testCases := []struct {
+		name         string
+		nodes        []NodeDefinition
+		expectedCode string
+		expectedNode string
+	}{
+		{
+			name: "MissingStartNode",
+			nodes: []NodeDefinition{
+				{ID: "prompt", Type: "PROMPT", Next: "end"},
+				{ID: "decision", Type: "DECISION", OnSuccess: "end"},
+				{ID: "end", Type: "END"},
+			},
+			expectedCode: diagnosticCodeMissingStartNode,
+		},
+		{
+			name: "MultipleStartNodes",
+			nodes: []NodeDefinition{
+				{ID: "start", Type: "START", OnSuccess: "prompt"},
+				{ID: "start-2", Type: "START", OnSuccess: "prompt"},
+				{ID: "prompt", Type: "PROMPT", Next: "end"},
+				{ID: "end", Type: "END"},
+			},
+			expectedCode: diagnosticCodeMultipleStartNodes,
+			expectedNode: "start-2",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result, err := s.service.ValidateFlow(context.Background(), s.newFlowDefinition(tc.nodes))
+
+			s.Nil(err)
+			s.False(result.Valid)
+			s.Len(result.Diagnostics, 1)
+			s.Equal(tc.expectedCode, result.Diagnostics[0].Code)
+			s.Equal(tc.expectedNode, result.Diagnostics[0].NodeID)
+		})
+	}
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_DuplicateNodeID() {
+	nodes := append(s.validNodes(), NodeDefinition{ID: "end", Type: "END"})
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testValidationExecutor).Return(true)
+
+	result, err := s.service.ValidateFlow(context.Background(), s.newFlowDefinition(nodes))
+
+	s.Nil(err)
+	s.False(result.Valid)
+	s.Len(result.Diagnostics, 1)
+	s.Equal(diagnosticCodeDuplicateNodeID, result.Diagnostics[0].Code)
+	s.Equal("end", result.Diagnostics[0].NodeID)
+	s.mockGraphBuilder.AssertNotCalled(s.T(), "BuildGraph", mock.Anything)
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_UnknownNodeReference() {
+	nodes := s.validNodes()
+	nodes[2].OnSuccess = "missing"
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testValidationExecutor).Return(true)
+
+	result, err := s.service.ValidateFlow(context.Background(), s.newFlowDefinition(nodes))
+
+	s.Nil(err)
+	s.False(result.Valid)
+	s.Equal([]FlowDiagnostic{
+		newErrorDiagnostic(diagnosticCodeUnknownNodeReference, "auth",
+			"Node auth refers to node missing that does not exist"),
+		newWarningDiagnostic(diagnosticCodeUnreachableNode, "end", "Node end cannot be reached from the start node"),
+	}, result.Diagnostics)
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_Executors() {
+	testCases := []struct {
+		name         string
+		executor     *ExecutorDefinition
+		registered   bool
+		expectedCode string
+	}{
+		{name: "MissingExecutor", executor: nil, expectedCode: diagnosticCodeMissingExecutor},
+		{name: "EmptyExecutorName", executor: &ExecutorDefinition{}, expectedCode: diagnosticCodeMissingExecutor},
+		{
+			name:         "UnregisteredExecutor",
+			executor:     &ExecutorDefinition{Name: "UnknownExecutor"},
+			expectedCode: diagnosticCodeUnknownExecutor,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			nodes := s.validNodes()
+			nodes[2].Executor = tc.executor
+			if tc.executor != nil && tc.executor.Name != "" {
+				s.mockExecutorRegistry.EXPECT().IsRegistered(tc.executor.Name).Return(false).Once()
+			}
+
+			result, err := s.service.ValidateFlow(context.Background(), s.newFlowDefinition(nodes))
+
+			s.Nil(err)
+			s.False(result.Valid)
+			s.Len(result.Diagnostics, 1)
+			s.Equal(tc.expectedCode, result.Diagnostics[0].Code)
+			s.Equal("auth", result.Diagnostics[0].NodeID)
+		})
+	}
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_IDPReferences() {
+	nodes := s.validNodes()
+	nodes[2].Properties = map[string]interface{}{"idpId": "known-idp"}
+	nodes = append(nodes,
+		NodeDefinition{
+			ID:         "google",
+			Type:       "TASK_EXECUTION",
+			Executor:   &ExecutorDefinition{Name: "GoogleOIDCAuthExecutor"},
+			Properties: map[string]interface{}{"idpId": "missing-idp"},
+			OnSuccess:  "end",
+		},
+		NodeDefinition{
+			ID:         "github",
+			Type:       "TASK_EXECUTION",
+			Executor:   &ExecutorDefinition{Name: "GithubOAuthExecutor"},
+			Properties: map[string]interface{}{"idpId": "missing-idp"},
+			OnSuccess:  "end",
+		},
+	)
+	nodes[1].Prompts = append(nodes[1].Prompts,
+		PromptDefinition{Action: &ActionDefinition{Ref: "google", NextNode: "google"}},
+		PromptDefinition{Action: &ActionDefinition{Ref: "github", NextNode: "github"}},
+	)
+	s.mockExecutorRegistry.EXPECT().IsRegistered(mock.Anything).Return(true)
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, "known-idp").Return(&idp.IDPDTO{}, nil)
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, "missing-idp").
+		Return(nil, &idp.ErrorIDPNotFound).Once()
+
+	result, err := s.service.ValidateFlow(context.Background(), s.newFlowDefinition(nodes))
+
+	s.Nil(err)
+	s.False(result.Valid)
+	s.Len(result.Diagnostics, 2)
+	s.Equal(diagnosticCodeUnknownIDP, result.Diagnostics[0].Code)
+	s.Equal("google", result.Diagnostics[0].NodeID)
+	s.Equal(diagnosticCodeUnknownIDP, result.Diagnostics[1].Code)
+	s.Equal("github", result.Diagnostics[1].NodeID)
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_IDPServiceError() {
+	nodes := s.validNodes()
+	nodes[2].Properties = map[string]interface{}{"idpId": "idp-1"}
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testValidationExecutor).Return(true)
+	s.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, "idp-1").
+		Return(nil, &serviceerror.InternalServerError)
+
+	result, err := s.service.ValidateFlow(context.Background(), s.newFlowDefinition(nodes))
+
+	s.Nil(result)
+	s.Equal(&serviceerror.InternalServerError, err)
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_UnreachableNode() {
+	nodes := append(s.validNodes(), NodeDefinition{ID: "orphan", Type: "PROMPT", Next: "end"})
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testValidationExecutor).Return(true)
+	s.mockGraphBuilder.EXPECT().BuildGraph(mock.Anything).Return(nil, nil)
+
+	result, err := s.service.ValidateFlow(context.Background(), s.newFlowDefinition(nodes))
+
+	s.Nil(err)
+	s.True(result.Valid)
+	s.Len(result.Diagnostics, 1)
+	s.Equal(diagnosticCodeUnreachableNode, result.Diagnostics[0].Code)
+	s.Equal(DiagnosticSeverityWarning, result.Diagnostics[0].Severity)
+	s.Equal("orphan", result.Diagnostics[0].NodeID)
+}
+
+func (s *FlowValidationTestSuite) TestValidateFlow_CycleWithoutPrompt() {
+	nodes := s.validNodes()
+	nodes[2].OnSuccess = "decision"
+	nodes = append(nodes, NodeDefinition{
+		ID:        "decision",
+		Type:      "DECISION",
+		OnSuccess: "end",
+		Branches: []BranchDefinition{
+			{Condition: &BranchConditionDefinition{Key: "retry", Operator: "equals", Value: "true"}, Next: "auth"},
+		},
+	})
+	s.mockExecutorRegistry.EXPECT().IsRegistered(testValidationExecutor).Return(true)
+	s.mockGraphBuilder.EXPECT().BuildGraph(mock.Anything).Return(nil, nil)
+
+	result, err := s.service.ValidateFlow(context.Background(), s.newFlowDefinition(nodes))
+
+	s.Nil(err)
+	s.True(result.Valid)
+	s.Len(result.Diagnostics, 1)
+	s.Equal(diagnosticCodeCycle, result.Diagnostics[0].Code)
+	s.Equal(DiagnosticSeverityWarning, result.Diagnostics[0].Severity)
+	s.Equal("auth", result.Diagnostics[0].NodeID)
+}
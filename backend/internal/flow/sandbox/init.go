@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sandbox
+
+import (
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	authnassert "github.com/thunder-id/thunderid/internal/authn/assert"
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	authnconsent "github.com/thunder-id/thunderid/internal/authn/consent"
+	"github.com/thunder-id/thunderid/internal/authn/github"
+	"github.com/thunder-id/thunderid/internal/authn/google"
+	"github.com/thunder-id/thunderid/internal/authn/magiclink"
+	authnoauth "github.com/thunder-id/thunderid/internal/authn/oauth"
+	authnoidc "github.com/thunder-id/thunderid/internal/authn/oidc"
+	"github.com/thunder-id/thunderid/internal/authn/otp"
+	"github.com/thunder-id/thunderid/internal/authn/passkey"
+	"github.com/thunder-id/thunderid/internal/authn/totp"
+	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	"github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/notification"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/organization"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/template"
+)
+
+// Runtime holds the isolated services used to execute a single sandbox session.
+type Runtime struct {
+	// ExecutorRegistry resolves the executors wired to the sandbox services.
+	ExecutorRegistry executor.ExecutorRegistryInterface
+	// EntityService is the in-memory user store of the session. Mock users are seeded through it.
+	EntityService entity.EntityServiceInterface
+	// Recorder holds the notifications captured during the session.
+	Recorder *Recorder
+}
+
+// RuntimeFactoryInterface creates isolated sandbox runtimes.
+type RuntimeFactoryInterface interface {
+	NewRuntime() *Runtime
+}
+
+// runtimeFactory implements RuntimeFactoryInterface.
+type runtimeFactory struct {
+	flowFactory           core.FlowFactoryInterface
+	ouService             ou.OrganizationUnitServiceInterface
+	idpService            idp.IDPServiceInterface
+	jwtService            jwt.JWTServiceInterface
+	authAssertGen         authnassert.AuthAssertGeneratorInterface
+	consentEnforcer       authnconsent.ConsentEnforcerServiceInterface
+	authZService          authz.AuthorizationServiceInterface
+	entityTypeService     entitytype.EntityTypeServiceInterface
+	groupService          group.GroupServiceInterface
+	roleService           role.RoleServiceInterface
+	roleAssignmentService role.RoleAssignmentServiceInterface
+	attributeCacheSvc     attributecache.AttributeCacheServiceInterface
+	sendAuditSvc          notification.SendAuditServiceInterface
+	templateService       template.TemplateServiceInterface
+	hashService           hash.HashServiceInterface
+	resourceService       resource.ResourceServiceInterface
+	scopeService          scope.ScopeServiceInterface
+	orgService            organization.OrganizationServiceInterface
+	cryptoProvider        kmprovider.ConfigCryptoProvider
+}
+
+// Initialize creates the sandbox runtime factory. Each runtime created by the factory has its own
+// in-memory user store and notification recorder, while read-only configuration such as identity
+// providers, roles and templates is served by the given services. Writes to organization units,
+// groups, role assignments and consents are simulated and never persisted.
+func Initialize(
+	flowFactory core.FlowFactoryInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+	idpService idp.IDPServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	authAssertGen authnassert.AuthAssertGeneratorInterface,
+	consentEnforcer authnconsent.ConsentEnforcerServiceInterface,
+	authZService authz.AuthorizationServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	groupService group.GroupServiceInterface,
+	roleService role.RoleServiceInterface,
+	roleAssignmentService role.RoleAssignmentServiceInterface,
+	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
+	sendAuditSvc notification.SendAuditServiceInterface,
+	templateService template.TemplateServiceInterface,
+	hashService hash.HashServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+	scopeService scope.ScopeServiceInterface,
+	orgService organization.OrganizationServiceInterface,
+	cryptoProvider kmprovider.ConfigCryptoProvider,
+) RuntimeFactoryInterface {
+	return &runtimeFactory{
+		flowFactory:           flowFactory,
+		ouService:             ouService,
+		idpService:            idpService,
+		jwtService:            jwtService,
+		authAssertGen:         authAssertGen,
+		consentEnforcer:       newConsentEnforcer(consentEnforcer),
+		authZService:          authZService,
+		entityTypeService:     entityTypeService,
+		groupService:          newGroupService(groupService),
+		roleService:           roleService,
+		roleAssignmentService: newRoleAssignmentService(roleAssignmentService),
+		attributeCacheSvc:     attributeCacheSvc,
+		sendAuditSvc:          newSendAuditService(sendAuditSvc),
+		templateService:       templateService,
+		hashService:           hashService,
+		resourceService:       resourceService,
+		scopeService:          scopeService,
+		orgService:            orgService,
+		cryptoProvider:        cryptoProvider,
+	}
+}
+
+// NewRuntime creates a new isolated sandbox runtime.
+func (f *runtimeFactory) NewRuntime() *Runtime {
+	recorder := newRecorder()
+	ouService := newOUService(f.ouService)
+
+	entitySvc := entity.InitializeInMemory(f.hashService, f.entityTypeService, ouService)
+	entityProvider := entityprovider.InitializeEntityProvider(entitySvc)
+
+	passkeyService := passkey.Initialize(entitySvc)
+	magicLinkService := magiclink.Initialize(f.jwtService, entityProvider)
+	otpService := otp.Initialize(newOTPService(recorder), entityProvider)
+	totpService := totp.Initialize(entitySvc, f.cryptoProvider)
+
+	oauthService := authnoauth.Initialize(f.idpService, entityProvider)
+	oidcService := authnoidc.Initialize(oauthService, f.jwtService)
+	googleService := google.Initialize(oidcService, f.jwtService)
+	githubService := github.Initialize(oauthService)
+
+	authnProvider := authnprovidermgr.InitializeAuthnProviderManager(entitySvc, passkeyService, otpService,
+		map[idp.IDPType]authncm.FederatedAuthenticator{
+			idp.IDPTypeOAuth:  oauthService,
+			idp.IDPTypeOIDC:   oidcService,
+			idp.IDPTypeGoogle: googleService,
+			idp.IDPTypeGitHub: githubService,
+		})
+
+	registry := executor.Initialize(f.flowFactory, ouService, f.idpService, newNotificationSender(recorder),
+		f.jwtService, f.authAssertGen, f.consentEnforcer, authnProvider, otpService, passkeyService,
+		magicLinkService, totpService, f.authZService, f.entityTypeService, f.groupService, f.roleService,
+		f.roleAssignmentService, entityProvider, f.attributeCacheSvc, newEmailClient(recorder),
+		f.sendAuditSvc, f.templateService, oauthService, oidcService, githubService, googleService,
+		f.resourceService, f.scopeService, f.orgService)
+
+	return &Runtime{
+		ExecutorRegistry: registry,
+		EntityService:    entitySvc,
+		Recorder:         recorder,
+	}
+}
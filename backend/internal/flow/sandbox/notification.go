@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sandbox
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	sandboxOTPLength   = 6
+	sandboxOTPValidity = 5 * time.Minute
+)
+
+// notificationSender implements notification.NotificationSenderServiceInterface by capturing messages.
+type notificationSender struct {
+	recorder *Recorder
+}
+
+// newNotificationSender creates a notification sender that captures messages in the given recorder.
+func newNotificationSender(recorder *Recorder) notification.NotificationSenderServiceInterface {
+	return &notificationSender{recorder: recorder}
+}
+
+// Send captures a notification addressed through a specific sender.
+func (s *notificationSender) Send(_ context.Context, channel notifcm.ChannelType, _ string,
+	data notifcm.NotificationData) *serviceerror.ServiceError {
+	s.capture(channel, data)
+	return nil
+}
+
+// SendForOU captures a notification addressed through an organization unit's sender.
+func (s *notificationSender) SendForOU(_ context.Context, channel notifcm.ChannelType, _ string,
+	data notifcm.NotificationData) *serviceerror.ServiceError {
+	s.capture(channel, data)
+	return nil
+}
+
+// SendPush captures a push notification addressed to a user's devices.
+func (s *notificationSender) SendPush(_ context.Context, userID string,
+	pushMessage notifcm.PushMessage) *serviceerror.ServiceError {
+	s.recorder.record(CapturedNotification{
+		Channel:    string(notifcm.ChannelTypePush),
+		Recipients: []string{userID},
+		Subject:    pushMessage.Title,
+		Body:       pushMessage.Body,
+	})
+	return nil
+}
+
+func (s *notificationSender) capture(channel notifcm.ChannelType, data notifcm.NotificationData) {
+	s.recorder.record(CapturedNotification{
+		Channel:    string(channel),
+		Recipients: []string{data.Recipient},
+		Subject:    data.Title,
+		Body:       data.Body,
+	})
+}
+
+// emailClient implements email.EmailClientInterface by capturing messages.
+type emailClient struct {
+	recorder *Recorder
+}
+
+// newEmailClient creates an email client that captures messages in the given recorder.
+func newEmailClient(recorder *Recorder) email.EmailClientInterface {
+	return &emailClient{recorder: recorder}
+}
+
+// Send captures the email instead of delivering it.
+func (c *emailClient) Send(emailData email.EmailData) error {
+	recipients := make([]string, 0, len(emailData.To)+len(emailData.CC)+len(emailData.BCC))
+	recipients = append(recipients, emailData.To...)
+	recipients = append(recipients, emailData.CC...)
+	recipients = append(recipients, emailData.BCC...)
+
+	c.recorder.record(CapturedNotification{
+		Channel:    string(notifcm.ChannelTypeEmail),
+		Recipients: recipients,
+		Subject:    emailData.Subject,
+		Body:       emailData.Body,
+	})
+	return nil
+}
+
+// otpSession holds the state of an OTP issued by the sandbox OTP service.
+type otpSession struct {
+	recipient string
+	otp       string
+	expiry    time.Time
+}
+
+// otpService implements notification.OTPServiceInterface without a notification sender. Generated
+// OTPs are captured as SMS notifications and verified against in-memory sessions.
+type otpService struct {
+	recorder *Recorder
+	mu       sync.Mutex
+	sessions map[string]otpSession
+}
+
+// newOTPService creates an OTP service that captures OTP messages in the given recorder.
+func newOTPService(recorder *Recorder) notification.OTPServiceInterface {
+	return &otpService{
+		recorder: recorder,
+		sessions: make(map[string]otpSession),
+	}
+}
+
+// SendOTP generates an OTP for the recipient and captures the message carrying it.
+func (s *otpService) SendOTP(_ context.Context, request notifcm.SendOTPDTO) (
+	*notifcm.SendOTPResultDTO, *serviceerror.ServiceError) {
+	if request.Recipient == "" {
+		return nil, &notification.ErrorInvalidRecipient
+	}
+	if request.Channel == "" {
+		return nil, &notification.ErrorInvalidChannel
+	}
+	if request.Channel != string(notifcm.ChannelTypeSMS) {
+		return nil, &notification.ErrorUnsupportedChannel
+	}
+
+	otp, err := generateNumericOTP(sandboxOTPLength)
+	if err != nil {
+		return nil, &serviceerror.InternalServerError
+	}
+	sessionToken, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.mu.Lock()
+	s.sessions[sessionToken] = otpSession{
+		recipient: request.Recipient,
+		otp:       otp,
+		expiry:    time.Now().Add(sandboxOTPValidity),
+	}
+	s.mu.Unlock()
+
+	s.recorder.record(CapturedNotification{
+		Channel:    request.Channel,
+		Recipients: []string{request.Recipient},
+		Body:       fmt.Sprintf("Your verification code is %s", otp),
+	})
+
+	return &notifcm.SendOTPResultDTO{SessionToken: sessionToken}, nil
+}
+
+// VerifyOTP verifies the OTP against the session created when it was sent.
+func (s *otpService) VerifyOTP(_ context.Context, request notifcm.VerifyOTPDTO) (
+	*notifcm.VerifyOTPResultDTO, *serviceerror.ServiceError) {
+	if request.SessionToken == "" {
+		return nil, &notification.ErrorInvalidSessionToken
+	}
+	if request.OTPCode == "" {
+		return nil, &notification.ErrorInvalidOTP
+	}
+
+	s.mu.Lock()
+	session, ok := s.sessions[request.SessionToken]
+	s.mu.Unlock()
+	if !ok {
+		return nil, &notification.ErrorInvalidSessionToken
+	}
+
+	status := notifcm.OTPVerifyStatusVerified
+	if time.Now().After(session.expiry) || request.OTPCode != session.otp {
+		status = notifcm.OTPVerifyStatusInvalid
+	}
+
+	return &notifcm.VerifyOTPResultDTO{
+		Status:    status,
+		Recipient: session.recipient,
+	}, nil
+}
+
+// generateNumericOTP generates a random numeric OTP of the given length.
+func generateNumericOTP(length int) (string, error) {
+	digits := make([]byte, length)
+	for i := range digits {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate random number: %w", err)
+		}
+		digits[i] = byte('0' + n.Int64())
+	}
+	return string(digits), nil
+}
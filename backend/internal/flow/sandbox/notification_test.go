@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sandbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/email"
+)
+
+type NotificationCaptureTestSuite struct {
+	suite.Suite
+	recorder *Recorder
+}
+
+func TestNotificationCaptureTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationCaptureTestSuite))
+}
+
+func (suite *NotificationCaptureTestSuite) SetupTest() {
+	suite.recorder = newRecorder()
+}
+
+func (suite *NotificationCaptureTestSuite) TestNotificationSender_CapturesMessages() {
+	sender := newNotificationSender(suite.recorder)
+
+	suite.Nil(sender.Send(context.Background(), notifcm.ChannelTypeSMS, "sender-1",
+		notifcm.NotificationData{Recipient: "+94771234567", Body: "hello"}))
+	suite.Nil(sender.SendForOU(context.Background(), notifcm.ChannelTypeSMS, "ou-1",
+		notifcm.NotificationData{Recipient: "+94777654321", Body: "hi"}))
+	suite.Nil(sender.SendPush(context.Background(), "user-1",
+		notifcm.PushMessage{Title: "Sign in", Body: "Approve sign in"}))
+
+	captured := suite.recorder.Drain()
+	suite.Len(captured, 3)
+	suite.Equal(CapturedNotification{
+		Channel: string(notifcm.ChannelTypeSMS), Recipients: []string{"+94771234567"}, Body: "hello",
+	}, captured[0])
+	suite.Equal([]string{"+94777654321"}, captured[1].Recipients)
+	suite.Equal(string(notifcm.ChannelTypePush), captured[2].Channel)
+	suite.Equal([]string{"user-1"}, captured[2].Recipients)
+	suite.Equal("Sign in", captured[2].Subject)
+
+	suite.Empty(suite.recorder.Drain())
+}
+
+func (suite *NotificationCaptureTestSuite) TestEmailClient_CapturesAllRecipients() {
+	client := newEmailClient(suite.recorder)
+
+	err := client.Send(email.EmailData{
+		To:      []string{"to@example.com"},
+		CC:      []string{"cc@example.com"},
+		BCC:     []string{"bcc@example.com"},
+		Subject: "Welcome",
+		Body:    "<p>Welcome</p>",
+		IsHTML:  true,
+	})
+
+	suite.NoError(err)
+	captured := suite.recorder.Drain()
+	suite.Len(captured, 1)
+	suite.Equal(string(notifcm.ChannelTypeEmail), captured[0].Channel)
+	suite.Equal([]string{"to@example.com", "cc@example.com", "bcc@example.com"}, captured[0].Recipients)
+	suite.Equal("Welcome", captured[0].Subject)
+}
+
+func (suite *NotificationCaptureTestSuite) TestOTPService_SendAndVerify() {
+	svc := newOTPService(suite.recorder)
+
+	result, svcErr := svc.SendOTP(context.Background(), notifcm.SendOTPDTO{
+		Recipient: "+94771234567",
+		Channel:   string(notifcm.ChannelTypeSMS),
+	})
+	suite.Nil(svcErr)
+	suite.NotEmpty(result.SessionToken)
+
+	captured := suite.recorder.Drain()
+	suite.Len(captured, 1)
+	otp := svc.(*otpService).sessions[result.SessionToken].otp
+	suite.Len(otp, sandboxOTPLength)
+	suite.Contains(captured[0].Body, otp)
+
+	verifyResult, svcErr := svc.VerifyOTP(context.Background(), notifcm.VerifyOTPDTO{
+		SessionToken: result.SessionToken,
+		OTPCode:      otp,
+	})
+	suite.Nil(svcErr)
+	suite.Equal(notifcm.OTPVerifyStatusVerified, verifyResult.Status)
+	suite.Equal("+94771234567", verifyResult.Recipient)
+}
+
+func (suite *NotificationCaptureTestSuite) TestOTPService_VerifyWrongOTP() {
+	svc := newOTPService(suite.recorder)
+	result, svcErr := svc.SendOTP(context.Background(), notifcm.SendOTPDTO{
+		Recipient: "+94771234567",
+		Channel:   string(notifcm.ChannelTypeSMS),
+	})
+	suite.Nil(svcErr)
+
+	otp := svc.(*otpService).sessions[result.SessionToken].otp
+	wrong := "000000"
+	if otp == wrong {
+		wrong = "111111"
+	}
+	verifyResult, svcErr := svc.VerifyOTP(context.Background(), notifcm.VerifyOTPDTO{
+		SessionToken: result.SessionToken,
+		OTPCode:      wrong,
+	})
+	suite.Nil(svcErr)
+	suite.Equal(notifcm.OTPVerifyStatusInvalid, verifyResult.Status)
+}
+
+func (suite *NotificationCaptureTestSuite) TestOTPService_SendValidationErrors() {
+	svc := newOTPService(suite.recorder)
+
+	_, svcErr := svc.SendOTP(context.Background(), notifcm.SendOTPDTO{Channel: string(notifcm.ChannelTypeSMS)})
+	suite.Equal(notification.ErrorInvalidRecipient.Code, svcErr.Code)
+
+	_, svcErr = svc.SendOTP(context.Background(), notifcm.SendOTPDTO{Recipient: "+94771234567"})
+	suite.Equal(notification.ErrorInvalidChannel.Code, svcErr.Code)
+
+	_, svcErr = svc.SendOTP(context.Background(), notifcm.SendOTPDTO{
+		Recipient: "user@example.com",
+		Channel:   string(notifcm.ChannelTypeEmail),
+	})
+	suite.Equal(notification.ErrorUnsupportedChannel.Code, svcErr.Code)
+	suite.Empty(suite.recorder.Drain())
+}
+
+func (suite *NotificationCaptureTestSuite) TestOTPService_VerifyUnknownSession() {
+	svc := newOTPService(suite.recorder)
+
+	_, svcErr := svc.VerifyOTP(context.Background(), notifcm.VerifyOTPDTO{
+		SessionToken: "unknown",
+		OTPCode:      "123456",
+	})
+	suite.Equal(notification.ErrorInvalidSessionToken.Code, svcErr.Code)
+}
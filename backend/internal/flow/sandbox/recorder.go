@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package sandbox provides isolated runtimes for executing flows in preview mode. A sandbox runtime
+// wires the flow executors to an in-memory user store and captures every outbound notification
+// instead of delivering it, so that flows can be exercised end to end without touching real data.
+package sandbox
+
+import (
+	"sync"
+)
+
+// CapturedNotification represents a notification that was captured instead of being delivered.
+type CapturedNotification struct {
+	Channel    string   `json:"channel"`
+	Recipients []string `json:"recipients"`
+	Subject    string   `json:"subject,omitempty"`
+	Body       string   `json:"body"`
+}
+
+// Recorder collects the notifications emitted by a sandbox runtime.
+type Recorder struct {
+	mu            sync.Mutex
+	notifications []CapturedNotification
+}
+
+// newRecorder creates a new, empty notification recorder.
+func newRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// record appends a captured notification.
+func (r *Recorder) record(notification CapturedNotification) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.notifications = append(r.notifications, notification)
+}
+
+// Drain returns the notifications captured since the previous call and clears the recorder.
+func (r *Recorder) Drain() []CapturedNotification {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	captured := r.notifications
+	r.notifications = nil
+	return captured
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sandbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	authnconsent "github.com/thunder-id/thunderid/internal/authn/consent"
+	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/notification"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ouService wraps the organization unit service so that organization units created during a
+// sandbox execution are kept in memory. Reads fall back to the wrapped service.
+type ouService struct {
+	ou.OrganizationUnitServiceInterface
+	mu      sync.RWMutex
+	created map[string]ou.OrganizationUnit
+}
+
+// newOUService creates a sandbox organization unit service wrapping the given service.
+func newOUService(delegate ou.OrganizationUnitServiceInterface) ou.OrganizationUnitServiceInterface {
+	return &ouService{
+		OrganizationUnitServiceInterface: delegate,
+		created:                          make(map[string]ou.OrganizationUnit),
+	}
+}
+
+// CreateOrganizationUnit simulates the creation of an organization unit without persisting it.
+func (s *ouService) CreateOrganizationUnit(ctx context.Context, request ou.OrganizationUnitRequestWithID) (
+	ou.OrganizationUnit, *serviceerror.ServiceError) {
+	if request.Parent != nil && *request.Parent != "" {
+		exists, svcErr := s.IsOrganizationUnitExists(ctx, *request.Parent)
+		if svcErr != nil {
+			return ou.OrganizationUnit{}, svcErr
+		}
+		if !exists {
+			return ou.OrganizationUnit{}, &ou.ErrorParentOrganizationUnitNotFound
+		}
+	}
+
+	id := request.ID
+	if id == "" {
+		var err error
+		if id, err = sysutils.GenerateUUIDv7(); err != nil {
+			return ou.OrganizationUnit{}, &serviceerror.InternalServerError
+		}
+	}
+
+	now := time.Now().UTC()
+	created := ou.OrganizationUnit{
+		ID:              id,
+		Handle:          request.Handle,
+		Name:            request.Name,
+		Description:     request.Description,
+		Parent:          request.Parent,
+		ThemeID:         request.ThemeID,
+		LayoutID:        request.LayoutID,
+		LogoURL:         request.LogoURL,
+		TosURI:          request.TosURI,
+		PolicyURI:       request.PolicyURI,
+		CookiePolicyURI: request.CookiePolicyURI,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	s.mu.Lock()
+	s.created[id] = created
+	s.mu.Unlock()
+
+	return created, nil
+}
+
+// GetOrganizationUnit returns a simulated organization unit, or delegates to the wrapped service.
+func (s *ouService) GetOrganizationUnit(ctx context.Context, id string) (
+	ou.OrganizationUnit, *serviceerror.ServiceError) {
+	s.mu.RLock()
+	created, ok := s.created[id]
+	s.mu.RUnlock()
+	if ok {
+		return created, nil
+	}
+	return s.OrganizationUnitServiceInterface.GetOrganizationUnit(ctx, id)
+}
+
+// IsOrganizationUnitExists checks the simulated organization units before delegating.
+func (s *ouService) IsOrganizationUnitExists(ctx context.Context, id string) (bool, *serviceerror.ServiceError) {
+	s.mu.RLock()
+	_, ok := s.created[id]
+	s.mu.RUnlock()
+	if ok {
+		return true, nil
+	}
+	return s.OrganizationUnitServiceInterface.IsOrganizationUnitExists(ctx, id)
+}
+
+// groupService wraps the group service so that membership changes are not persisted.
+type groupService struct {
+	group.GroupServiceInterface
+}
+
+// newGroupService creates a sandbox group service wrapping the given service.
+func newGroupService(delegate group.GroupServiceInterface) group.GroupServiceInterface {
+	return &groupService{GroupServiceInterface: delegate}
+}
+
+// AddGroupMembers validates that the group exists without adding the members.
+func (s *groupService) AddGroupMembers(ctx context.Context, groupID string, _ []group.Member) (
+	*group.Group, *serviceerror.ServiceError) {
+	return s.GetGroup(ctx, groupID, false)
+}
+
+// roleAssignmentService wraps the role assignment service so that assignments are not persisted.
+type roleAssignmentService struct {
+	role.RoleAssignmentServiceInterface
+}
+
+// newRoleAssignmentService creates a sandbox role assignment service wrapping the given service.
+func newRoleAssignmentService(delegate role.RoleAssignmentServiceInterface) role.RoleAssignmentServiceInterface {
+	return &roleAssignmentService{RoleAssignmentServiceInterface: delegate}
+}
+
+// AddAssignments accepts the assignments without persisting them.
+func (s *roleAssignmentService) AddAssignments(_ context.Context, _ string,
+	_ []role.RoleAssignment) *serviceerror.ServiceError {
+	return nil
+}
+
+// consentEnforcer wraps the consent enforcer so that consent decisions are not persisted.
+type consentEnforcer struct {
+	authnconsent.ConsentEnforcerServiceInterface
+}
+
+// newConsentEnforcer creates a sandbox consent enforcer wrapping the given enforcer.
+func newConsentEnforcer(
+	delegate authnconsent.ConsentEnforcerServiceInterface) authnconsent.ConsentEnforcerServiceInterface {
+	return &consentEnforcer{ConsentEnforcerServiceInterface: delegate}
+}
+
+// RecordConsent builds the consent record for the decisions without persisting it.
+func (s *consentEnforcer) RecordConsent(_ context.Context, _, appID, userID string,
+	decisions *authnconsent.ConsentDecisions, _ string, validityPeriod int64) (
+	*consent.Consent, *serviceerror.ServiceError) {
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		return nil, &serviceerror.InternalServerError
+	}
+
+	record := &consent.Consent{
+		ID:      id,
+		Type:    consent.ConsentTypeAuthentication,
+		GroupID: appID,
+		Status:  consent.ConsentStatusActive,
+		Authorizations: []consent.ConsentAuthorization{
+			{UserID: userID},
+		},
+	}
+	if validityPeriod > 0 {
+		record.ValidityTime = time.Now().Unix() + validityPeriod
+	}
+	if decisions != nil {
+		for _, pd := range decisions.Purposes {
+			item := consent.ConsentPurposeItem{Name: pd.PurposeName}
+			for _, ed := range pd.Elements {
+				item.Elements = append(item.Elements, consent.ConsentElementApproval{
+					Name:           ed.Name,
+					Namespace:      consent.NamespaceAttribute,
+					IsUserApproved: ed.Approved,
+				})
+			}
+			record.Purposes = append(record.Purposes, item)
+		}
+	}
+
+	return record, nil
+}
+
+// sendAuditService discards send audit records produced during a sandbox execution.
+type sendAuditService struct {
+	notification.SendAuditServiceInterface
+}
+
+// newSendAuditService creates a sandbox send audit service wrapping the given service.
+func newSendAuditService(delegate notification.SendAuditServiceInterface) notification.SendAuditServiceInterface {
+	return &sendAuditService{SendAuditServiceInterface: delegate}
+}
+
+// RecordSend discards the send audit record.
+func (s *sendAuditService) RecordSend(_ context.Context, _ notifcm.SendAuditRecord) {}
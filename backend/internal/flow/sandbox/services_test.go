@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sandbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authnconsent "github.com/thunder-id/thunderid/internal/authn/consent"
+	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/group"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/tests/mocks/authn/consentenforcermock"
+	"github.com/thunder-id/thunderid/tests/mocks/groupmock"
+	"github.com/thunder-id/thunderid/tests/mocks/notification/notificationmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+)
+
+type ServicesTestSuite struct {
+	suite.Suite
+}
+
+func TestServicesTestSuite(t *testing.T) {
+	suite.Run(t, new(ServicesTestSuite))
+}
+
+func (suite *ServicesTestSuite) TestOUService_CreateIsSimulated() {
+	delegate := oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	delegate.On("IsOrganizationUnitExists", mock.Anything, "parent-ou").Return(true, nil)
+	svc := newOUService(delegate)
+
+	parent := "parent-ou"
+	created, svcErr := svc.CreateOrganizationUnit(context.Background(), ou.OrganizationUnitRequestWithID{
+		Handle: "acme",
+		Name:   "Acme",
+		Parent: &parent,
+	})
+	suite.Nil(svcErr)
+	suite.NotEmpty(created.ID)
+	suite.Equal("acme", created.Handle)
+
+	fetched, svcErr := svc.GetOrganizationUnit(context.Background(), created.ID)
+	suite.Nil(svcErr)
+	suite.Equal(created, fetched)
+
+	exists, svcErr := svc.IsOrganizationUnitExists(context.Background(), created.ID)
+	suite.Nil(svcErr)
+	suite.True(exists)
+	delegate.AssertNotCalled(suite.T(), "CreateOrganizationUnit", mock.Anything, mock.Anything)
+}
+
+func (suite *ServicesTestSuite) TestOUService_CreateWithMissingParent() {
+	delegate := oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	delegate.On("IsOrganizationUnitExists", mock.Anything, "missing").Return(false, nil)
+	svc := newOUService(delegate)
+
+	parent := "missing"
+	_, svcErr := svc.CreateOrganizationUnit(context.Background(), ou.OrganizationUnitRequestWithID{
+		Handle: "acme",
+		Name:   "Acme",
+		Parent: &parent,
+	})
+	suite.Equal(ou.ErrorParentOrganizationUnitNotFound.Code, svcErr.Code)
+}
+
+func (suite *ServicesTestSuite) TestOUService_GetDelegatesUnknownIDs() {
+	delegate := oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	delegate.On("GetOrganizationUnit", mock.Anything, "ou-1").Return(ou.OrganizationUnit{ID: "ou-1"}, nil)
+	svc := newOUService(delegate)
+
+	fetched, svcErr := svc.GetOrganizationUnit(context.Background(), "ou-1")
+	suite.Nil(svcErr)
+	suite.Equal("ou-1", fetched.ID)
+}
+
+func (suite *ServicesTestSuite) TestGroupService_AddMembersIsNotPersisted() {
+	delegate := groupmock.NewGroupServiceInterfaceMock(suite.T())
+	delegate.On("GetGroup", mock.Anything, "group-1", false).Return(&group.Group{ID: "group-1"}, nil)
+	svc := newGroupService(delegate)
+
+	result, svcErr := svc.AddGroupMembers(context.Background(), "group-1",
+		[]group.Member{{ID: "user-1", Type: group.MemberTypeUser}})
+	suite.Nil(svcErr)
+	suite.Equal("group-1", result.ID)
+	delegate.AssertNotCalled(suite.T(), "AddGroupMembers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServicesTestSuite) TestRoleAssignmentService_AddAssignmentsIsNotPersisted() {
+	delegate := rolemock.NewRoleAssignmentServiceInterfaceMock(suite.T())
+	svc := newRoleAssignmentService(delegate)
+
+	svcErr := svc.AddAssignments(context.Background(), "role-1",
+		[]role.RoleAssignment{{ID: "user-1", Type: role.AssigneeTypeUser}})
+	suite.Nil(svcErr)
+	delegate.AssertNotCalled(suite.T(), "AddAssignments", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServicesTestSuite) TestConsentEnforcer_RecordConsentIsNotPersisted() {
+	delegate := consentenforcermock.NewConsentEnforcerServiceInterfaceMock(suite.T())
+	svc := newConsentEnforcer(delegate)
+
+	record, svcErr := svc.RecordConsent(context.Background(), "ou-1", "app-1", "user-1",
+		&authnconsent.ConsentDecisions{Purposes: []authnconsent.PurposeDecision{
+			{
+				PurposeName: "profile",
+				Approved:    true,
+				Elements: []authnconsent.ElementDecision{
+					{Name: "email", Approved: true},
+					{Name: "mobile", Approved: false},
+				},
+			},
+		}}, "session-token", 3600)
+
+	suite.Nil(svcErr)
+	suite.NotEmpty(record.ID)
+	suite.Equal("app-1", record.GroupID)
+	suite.Equal(consent.ConsentStatusActive, record.Status)
+	suite.Positive(record.ValidityTime)
+	suite.Len(record.Purposes, 1)
+	suite.Equal([]consent.ConsentElementApproval{
+		{Name: "email", Namespace: consent.NamespaceAttribute, IsUserApproved: true},
+		{Name: "mobile", Namespace: consent.NamespaceAttribute, IsUserApproved: false},
+	}, record.Purposes[0].Elements)
+	delegate.AssertNotCalled(suite.T(), "RecordConsent", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServicesTestSuite) TestSendAuditService_RecordSendIsDiscarded() {
+	delegate := notificationmock.NewSendAuditServiceInterfaceMock(suite.T())
+	svc := newSendAuditService(delegate)
+
+	svc.RecordSend(context.Background(), notifcm.SendAuditRecord{})
+	delegate.AssertNotCalled(suite.T(), "RecordSend", mock.Anything, mock.Anything)
+}
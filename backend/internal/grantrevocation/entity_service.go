@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grantrevocation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// revocationEntityService decorates the entity service to revoke the grants of a user when the user is deleted
+// or disabled, or when the credentials of the user are updated. Decorating the entity service covers the changes
+// made through the user API as well as through the registration and recovery flows.
+type revocationEntityService struct {
+	entity.EntityServiceInterface
+	revocationService GrantRevocationServiceInterface
+	logger            *log.Logger
+}
+
+// newRevocationEntityService creates an entity service that revokes the grants of a user on lifecycle changes.
+func newRevocationEntityService(
+	entityService entity.EntityServiceInterface,
+	revocationService GrantRevocationServiceInterface,
+) entity.EntityServiceInterface {
+	return &revocationEntityService{
+		EntityServiceInterface: entityService,
+		revocationService:      revocationService,
+		logger: log.GetLogger().With(
+			log.String(log.LoggerKeyComponentName, "RevocationEntityService")),
+	}
+}
+
+// UpdateEntity updates an entity and revokes the grants of the user if the entity is a user that is no longer
+// active.
+func (s *revocationEntityService) UpdateEntity(ctx context.Context, entityID string,
+	e *entity.Entity) (*entity.Entity, error) {
+	updated, err := s.EntityServiceInterface.UpdateEntity(ctx, entityID, e)
+	if err == nil && updated != nil && updated.State != entity.EntityStateActive {
+		s.revokeUserGrants(ctx, updated)
+	}
+	return updated, err
+}
+
+// DeleteEntity deletes an entity and revokes the grants of the user if the entity is a user.
+func (s *revocationEntityService) DeleteEntity(ctx context.Context, entityID string) error {
+	// The entity is retrieved before it is deleted to find whether it is a user.
+	existing, getErr := s.EntityServiceInterface.GetEntity(ctx, entityID)
+
+	if err := s.EntityServiceInterface.DeleteEntity(ctx, entityID); err != nil {
+		return err
+	}
+	if getErr == nil {
+		s.revokeUserGrants(ctx, existing)
+	}
+
+	return nil
+}
+
+// UpdateCredentials updates the credentials of an entity and revokes the grants of the user if the entity is a
+// user.
+func (s *revocationEntityService) UpdateCredentials(ctx context.Context, entityID string,
+	plaintextUpdates json.RawMessage) error {
+	if err := s.EntityServiceInterface.UpdateCredentials(ctx, entityID, plaintextUpdates); err != nil {
+		return err
+	}
+
+	updated, err := s.EntityServiceInterface.GetEntity(ctx, entityID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the entity to revoke the grants of", log.MaskedString("id", entityID),
+			log.Error(err))
+		return nil
+	}
+	s.revokeUserGrants(ctx, updated)
+
+	return nil
+}
+
+// revokeUserGrants revokes the grants of an entity if the entity is a user. The change of the entity is already
+// applied, so a failure to revoke the grants is logged rather than returned.
+func (s *revocationEntityService) revokeUserGrants(ctx context.Context, e *entity.Entity) {
+	if e == nil || e.Category != entity.EntityCategoryUser {
+		return
+	}
+
+	if svcErr := s.revocationService.RevokeUserGrants(ctx, e.ID); svcErr != nil {
+		s.logger.Error("Failed to revoke the grants of the user", log.MaskedString("id", e.ID),
+			log.String("error", svcErr.Code))
+	}
+}
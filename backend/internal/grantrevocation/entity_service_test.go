@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grantrevocation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+)
+
+type RevocationEntityServiceTestSuite struct {
+	suite.Suite
+	mockEntityService     *entitymock.EntityServiceInterfaceMock
+	mockRevocationService *GrantRevocationServiceInterfaceMock
+	service               entity.EntityServiceInterface
+}
+
+func TestRevocationEntityServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RevocationEntityServiceTestSuite))
+}
+
+func (suite *RevocationEntityServiceTestSuite) SetupTest() {
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockRevocationService = NewGrantRevocationServiceInterfaceMock(suite.T())
+	suite.service = newRevocationEntityService(suite.mockEntityService, suite.mockRevocationService)
+}
+
+func (suite *RevocationEntityServiceTestSuite) TestUpdateEntity_UserDisabled() {
+	user := &entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser, State: "DISABLED"}
+	suite.mockEntityService.EXPECT().UpdateEntity(mock.Anything, testUserID, user).Return(user, nil).Once()
+	suite.mockRevocationService.EXPECT().RevokeUserGrants(mock.Anything, testUserID).Return(nil).Once()
+
+	updated, err := suite.service.UpdateEntity(context.Background(), testUserID, user)
+	suite.NoError(err)
+	suite.Equal(user, updated)
+}
+
+func (suite *RevocationEntityServiceTestSuite) TestUpdateEntity_UserActive() {
+	user := &entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser, State: entity.EntityStateActive}
+	suite.mockEntityService.EXPECT().UpdateEntity(mock.Anything, testUserID, user).Return(user, nil).Once()
+
+	_, err := suite.service.UpdateEntity(context.Background(), testUserID, user)
+	suite.NoError(err)
+}
+
+func (suite *RevocationEntityServiceTestSuite) TestUpdateEntity_NotUser() {
+	app := &entity.Entity{ID: "app-1", Category: entity.EntityCategoryApp, State: "DISABLED"}
+	suite.mockEntityService.EXPECT().UpdateEntity(mock.Anything, "app-1", app).Return(app, nil).Once()
+
+	_, err := suite.service.UpdateEntity(context.Background(), "app-1", app)
+	suite.NoError(err)
+}
+
+func (suite *RevocationEntityServiceTestSuite) TestUpdateEntity_Error() {
+	user := &entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser, State: "DISABLED"}
+	suite.mockEntityService.EXPECT().UpdateEntity(mock.Anything, testUserID, user).
+		Return(nil, errors.New("update failed")).Once()
+
+	_, err := suite.service.UpdateEntity(context.Background(), testUserID, user)
+	suite.Error(err)
+}
+
+func (suite *RevocationEntityServiceTestSuite) TestDeleteEntity_User() {
+	user := &entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser}
+	suite.mockEntityService.EXPECT().GetEntity(mock.Anything, testUserID).Return(user, nil).Once()
+	suite.mockEntityService.EXPECT().DeleteEntity(mock.Anything, testUserID).Return(nil).Once()
+	suite.mockRevocationService.EXPECT().RevokeUserGrants(mock.Anything, testUserID).Return(nil).Once()
+
+	err := suite.service.DeleteEntity(context.Background(), testUserID)
+	suite.NoError(err)
+}
+
+func (suite *RevocationEntityServiceTestSuite) TestDeleteEntity_RevocationFailureIsNotReturned() {
+	user := &entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser}
+	suite.mockEntityService.EXPECT().GetEntity(mock.Anything, testUserID).Return(user, nil).Once()
+	suite.mockEntityService.EXPECT().DeleteEntity(mock.Anything, testUserID).Return(nil).Once()
+	suite.mockRevocationService.EXPECT().RevokeUserGrants(mock.Anything, testUserID).
+		Return(&serviceerror.InternalServerError).Once()
+
+	err := suite.service.DeleteEntity(context.Background(), testUserID)
+	suite.NoError(err)
+}
+
+func (suite *RevocationEntityServiceTestSuite) TestDeleteEntity_Error() {
+	user := &entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser}
+	suite.mockEntityService.EXPECT().GetEntity(mock.Anything, testUserID).Return(user, nil).Once()
+	suite.mockEntityService.EXPECT().DeleteEntity(mock.Anything, testUserID).
+		Return(errors.New("delete failed")).Once()
+
+	err := suite.service.DeleteEntity(context.Background(), testUserID)
+	suite.Error(err)
+}
+
+func (suite *RevocationEntityServiceTestSuite) TestUpdateCredentials_User() {
+	user := &entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser}
+	creds := json.RawMessage(`{"password":"new-password"}`)
+	suite.mockEntityService.EXPECT().UpdateCredentials(mock.Anything, testUserID, creds).Return(nil).Once()
+	suite.mockEntityService.EXPECT().GetEntity(mock.Anything, testUserID).Return(user, nil).Once()
+	suite.mockRevocationService.EXPECT().RevokeUserGrants(mock.Anything, testUserID).Return(nil).Once()
+
+	err := suite.service.UpdateCredentials(context.Background(), testUserID, creds)
+	suite.NoError(err)
+}
+
+func (suite *RevocationEntityServiceTestSuite) TestUpdateCredentials_Error() {
+	creds := json.RawMessage(`{"password":"new-password"}`)
+	suite.mockEntityService.EXPECT().UpdateCredentials(mock.Anything, testUserID, creds).
+		Return(errors.New("update failed")).Once()
+
+	err := suite.service.UpdateCredentials(context.Background(), testUserID, creds)
+	suite.Error(err)
+}
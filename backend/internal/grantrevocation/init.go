@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package grantrevocation revokes the grants issued to a user when the lifecycle of the user changes, so that
+// the refresh tokens, authorization codes and SSO sessions issued before the change can no longer be used.
+package grantrevocation
+
+import (
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// Initialize creates the grant revocation service. It returns the service and the entity service decorated to
+// revoke the grants of a user when the user is deleted or disabled, or when the credentials of the user are
+// updated. The revocation times are kept in Redis when the runtime store is Redis, and in the runtime database
+// otherwise.
+func Initialize(entityService entity.EntityServiceInterface, ssoSessionService ssosession.SSOSessionServiceInterface) (
+	GrantRevocationServiceInterface, entity.EntityServiceInterface) {
+	runtimeConfig := config.GetServerRuntime().Config
+
+	var store grantRevocationStoreInterface
+	if runtimeConfig.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		store = newRedisGrantRevocationStore(provider.GetRedisProvider(), runtimeConfig.Server.Identifier)
+	} else {
+		store = newGrantRevocationStore(provider.GetDBProvider(), runtimeConfig.Server.Identifier)
+	}
+
+	revocationService := newGrantRevocationService(store, ssoSessionService)
+	return revocationService, newRevocationEntityService(entityService, revocationService)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grantrevocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// redisClient abstracts the Redis commands used by the grant revocation store.
+type redisClient interface {
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+}
+
+// redisGrantRevocationStore is the implementation of grantRevocationStoreInterface used when the runtime store
+// is Redis.
+type redisGrantRevocationStore struct {
+	client       redisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisGrantRevocationStore creates a new grant revocation store that keeps the revocation times in Redis.
+func newRedisGrantRevocationStore(p provider.RedisProviderInterface,
+	deploymentID string) grantRevocationStoreInterface {
+	return &redisGrantRevocationStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// revocationKey builds the Redis key for the grant revocation time of a user.
+func (s *redisGrantRevocationStore) revocationKey(userID string) string {
+	return fmt.Sprintf("%s:runtime:%s:grantrevocation:user:%s", s.keyPrefix, s.deploymentID, userID)
+}
+
+// SetRevocationTime stores the grant revocation time of a user in Redis, in unix seconds. The revocation time
+// does not expire.
+func (s *redisGrantRevocationStore) SetRevocationTime(ctx context.Context, userID string,
+	revokedAt time.Time) error {
+	if err := s.client.Set(ctx, s.revocationKey(userID), revokedAt.Unix(), 0).Err(); err != nil {
+		return fmt.Errorf("failed to store grant revocation time in Redis: %w", err)
+	}
+	return nil
+}
+
+// GetRevocationTime retrieves the grant revocation time of a user from Redis.
+func (s *redisGrantRevocationStore) GetRevocationTime(ctx context.Context, userID string) (time.Time, error) {
+	revokedAt, err := s.client.Get(ctx, s.revocationKey(userID)).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("failed to get grant revocation time from Redis: %w", err)
+	}
+	return time.Unix(revokedAt, 0).UTC(), nil
+}
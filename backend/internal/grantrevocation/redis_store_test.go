@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grantrevocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment"
+)
+
+type RedisGrantRevocationStoreTestSuite struct {
+	suite.Suite
+	store      *redisGrantRevocationStore
+	mockClient *redisClientMock
+	ctx        context.Context
+	key        string
+}
+
+func TestRedisGrantRevocationStoreSuite(t *testing.T) {
+	suite.Run(t, new(RedisGrantRevocationStoreTestSuite))
+}
+
+func (suite *RedisGrantRevocationStoreTestSuite) SetupTest() {
+	suite.mockClient = newRedisClientMock(suite.T())
+	suite.ctx = context.Background()
+	suite.store = &redisGrantRevocationStore{
+		client:       suite.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+	}
+	suite.key = fmt.Sprintf("%s:runtime:%s:grantrevocation:user:%s",
+		redisTestKeyPrefix, redisTestDeploymentID, testUserID)
+}
+
+func (suite *RedisGrantRevocationStoreTestSuite) TestRevocationKey() {
+	suite.Equal(suite.key, suite.store.revocationKey(testUserID))
+}
+
+// Tests for SetRevocationTime
+
+func (suite *RedisGrantRevocationStoreTestSuite) TestSetRevocationTime_Success() {
+	revokedAt := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	statusCmd := redis.NewStatusCmd(suite.ctx)
+	statusCmd.SetVal("OK")
+	suite.mockClient.On("Set", suite.ctx, suite.key, revokedAt.Unix(), time.Duration(0)).Return(statusCmd).Once()
+
+	err := suite.store.SetRevocationTime(suite.ctx, testUserID, revokedAt)
+
+	suite.Nil(err)
+}
+
+func (suite *RedisGrantRevocationStoreTestSuite) TestSetRevocationTime_Error() {
+	statusCmd := redis.NewStatusCmd(suite.ctx)
+	statusCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Set", suite.ctx, suite.key, time.Unix(0, 0).Unix(), time.Duration(0)).
+		Return(statusCmd).Once()
+
+	err := suite.store.SetRevocationTime(suite.ctx, testUserID, time.Unix(0, 0))
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "failed to store grant revocation time in Redis")
+}
+
+// Tests for GetRevocationTime
+
+func (suite *RedisGrantRevocationStoreTestSuite) TestGetRevocationTime_Success() {
+	revokedAt := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal(fmt.Sprintf("%d", revokedAt.Unix()))
+	suite.mockClient.On("Get", suite.ctx, suite.key).Return(stringCmd).Once()
+
+	result, err := suite.store.GetRevocationTime(suite.ctx, testUserID)
+
+	suite.Nil(err)
+	suite.Equal(revokedAt, result)
+}
+
+func (suite *RedisGrantRevocationStoreTestSuite) TestGetRevocationTime_NotRevoked() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(redis.Nil)
+	suite.mockClient.On("Get", suite.ctx, suite.key).Return(stringCmd).Once()
+
+	result, err := suite.store.GetRevocationTime(suite.ctx, testUserID)
+
+	suite.Nil(err)
+	suite.True(result.IsZero())
+}
+
+func (suite *RedisGrantRevocationStoreTestSuite) TestGetRevocationTime_Error() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Get", suite.ctx, suite.key).Return(stringCmd).Once()
+
+	_, err := suite.store.GetRevocationTime(suite.ctx, testUserID)
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "failed to get grant revocation time from Redis")
+}
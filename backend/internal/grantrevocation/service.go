@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grantrevocation
+
+import (
+	"context"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// GrantRevocationServiceInterface defines the interface for revoking the grants issued to a user.
+type GrantRevocationServiceInterface interface {
+	// RevokeUserGrants revokes the refresh tokens and authorization codes issued to a user up to now, and deletes
+	// the SSO sessions of the user.
+	RevokeUserGrants(ctx context.Context, userID string) *serviceerror.ServiceError
+
+	// IsGrantRevoked reports whether a refresh token or authorization code issued to a user at the given time has
+	// been revoked.
+	IsGrantRevoked(ctx context.Context, userID string, issuedAt time.Time) (bool, *serviceerror.ServiceError)
+}
+
+// grantRevocationService is the default implementation of GrantRevocationServiceInterface.
+type grantRevocationService struct {
+	store             grantRevocationStoreInterface
+	ssoSessionService ssosession.SSOSessionServiceInterface
+	logger            *log.Logger
+}
+
+// newGrantRevocationService creates a new instance of grantRevocationService with injected dependencies.
+func newGrantRevocationService(store grantRevocationStoreInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface) GrantRevocationServiceInterface {
+	return &grantRevocationService{
+		store:             store,
+		ssoSessionService: ssoSessionService,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "GrantRevocationService")),
+	}
+}
+
+// RevokeUserGrants records the current time as the revocation time of the user and deletes the SSO sessions of
+// the user. Token and authorization code timestamps have a precision of a second, so the grants issued in the
+// second of the revocation remain valid.
+func (s *grantRevocationService) RevokeUserGrants(ctx context.Context, userID string) *serviceerror.ServiceError {
+	revokedAt := time.Now().UTC().Truncate(time.Second)
+	if err := s.store.SetRevocationTime(ctx, userID, revokedAt); err != nil {
+		s.logger.Error("Failed to record the grant revocation of the user", log.MaskedString("userID", userID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	if !s.ssoSessionService.IsEnabled() {
+		return nil
+	}
+	sessions, svcErr := s.ssoSessionService.ListUserSessions(ctx, userID)
+	if svcErr != nil {
+		s.logger.Error("Failed to list the SSO sessions of the user", log.MaskedString("userID", userID),
+			log.String("error", svcErr.Code))
+		return &serviceerror.InternalServerError
+	}
+	for _, session := range sessions {
+		if svcErr := s.ssoSessionService.DeleteSession(ctx, session.ID); svcErr != nil {
+			s.logger.Error("Failed to delete an SSO session of the user", log.MaskedString("userID", userID),
+				log.String("error", svcErr.Code))
+			return &serviceerror.InternalServerError
+		}
+	}
+
+	return nil
+}
+
+// IsGrantRevoked reports whether a grant issued to a user at the given time was issued before the revocation
+// time of the user.
+func (s *grantRevocationService) IsGrantRevoked(ctx context.Context, userID string,
+	issuedAt time.Time) (bool, *serviceerror.ServiceError) {
+	revokedAt, err := s.store.GetRevocationTime(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the grant revocation time of the user",
+			log.MaskedString("userID", userID), log.Error(err))
+		return false, &serviceerror.InternalServerError
+	}
+
+	return !revokedAt.IsZero() && issuedAt.Before(revokedAt), nil
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grantrevocation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/ssosessionmock"
+)
+
+const testUserID = "test-user-id"
+
+type GrantRevocationServiceTestSuite struct {
+	suite.Suite
+	mockStore         *grantRevocationStoreInterfaceMock
+	mockSSOSessionSvc *ssosessionmock.SSOSessionServiceInterfaceMock
+	service           GrantRevocationServiceInterface
+	ctx               context.Context
+}
+
+func TestGrantRevocationServiceSuite(t *testing.T) {
+	suite.Run(t, new(GrantRevocationServiceTestSuite))
+}
+
+func (suite *GrantRevocationServiceTestSuite) SetupTest() {
+	suite.mockStore = newGrantRevocationStoreInterfaceMock(suite.T())
+	suite.mockSSOSessionSvc = ssosessionmock.NewSSOSessionServiceInterfaceMock(suite.T())
+	suite.service = newGrantRevocationService(suite.mockStore, suite.mockSSOSessionSvc)
+	suite.ctx = context.Background()
+}
+
+// Tests for RevokeUserGrants
+
+func (suite *GrantRevocationServiceTestSuite) TestRevokeUserGrants_Success() {
+	before := time.Now().UTC().Truncate(time.Second)
+	suite.mockStore.On("SetRevocationTime", suite.ctx, testUserID, mock.MatchedBy(func(revokedAt time.Time) bool {
+		return !revokedAt.Before(before) && revokedAt.Equal(revokedAt.Truncate(time.Second))
+	})).Return(nil).Once()
+	suite.mockSSOSessionSvc.On("IsEnabled").Return(true).Once()
+	suite.mockSSOSessionSvc.On("ListUserSessions", suite.ctx, testUserID).
+		Return([]ssosession.SSOSession{{ID: "session-1"}, {ID: "session-2"}}, nil).Once()
+	suite.mockSSOSessionSvc.On("DeleteSession", suite.ctx, "session-1").Return(nil).Once()
+	suite.mockSSOSessionSvc.On("DeleteSession", suite.ctx, "session-2").Return(nil).Once()
+
+	svcErr := suite.service.RevokeUserGrants(suite.ctx, testUserID)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *GrantRevocationServiceTestSuite) TestRevokeUserGrants_SSOSessionsDisabled() {
+	suite.mockStore.On("SetRevocationTime", suite.ctx, testUserID, mock.Anything).Return(nil).Once()
+	suite.mockSSOSessionSvc.On("IsEnabled").Return(false).Once()
+
+	svcErr := suite.service.RevokeUserGrants(suite.ctx, testUserID)
+
+	suite.Nil(svcErr)
+	suite.mockSSOSessionSvc.AssertNotCalled(suite.T(), "ListUserSessions", mock.Anything, mock.Anything)
+}
+
+func (suite *GrantRevocationServiceTestSuite) TestRevokeUserGrants_StoreError() {
+	suite.mockStore.On("SetRevocationTime", suite.ctx, testUserID, mock.Anything).
+		Return(errors.New("store error")).Once()
+
+	svcErr := suite.service.RevokeUserGrants(suite.ctx, testUserID)
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+	suite.mockSSOSessionSvc.AssertNotCalled(suite.T(), "IsEnabled")
+}
+
+func (suite *GrantRevocationServiceTestSuite) TestRevokeUserGrants_ListSessionsError() {
+	suite.mockStore.On("SetRevocationTime", suite.ctx, testUserID, mock.Anything).Return(nil).Once()
+	suite.mockSSOSessionSvc.On("IsEnabled").Return(true).Once()
+	suite.mockSSOSessionSvc.On("ListUserSessions", suite.ctx, testUserID).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	svcErr := suite.service.RevokeUserGrants(suite.ctx, testUserID)
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *GrantRevocationServiceTestSuite) TestRevokeUserGrants_DeleteSessionError() {
+	suite.mockStore.On("SetRevocationTime", suite.ctx, testUserID, mock.Anything).Return(nil).Once()
+	suite.mockSSOSessionSvc.On("IsEnabled").Return(true).Once()
+	suite.mockSSOSessionSvc.On("ListUserSessions", suite.ctx, testUserID).
+		Return([]ssosession.SSOSession{{ID: "session-1"}}, nil).Once()
+	suite.mockSSOSessionSvc.On("DeleteSession", suite.ctx, "session-1").
+		Return(&serviceerror.InternalServerError).Once()
+
+	svcErr := suite.service.RevokeUserGrants(suite.ctx, testUserID)
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+// Tests for IsGrantRevoked
+
+func (suite *GrantRevocationServiceTestSuite) TestIsGrantRevoked() {
+	revokedAt := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name      string
+		revokedAt time.Time
+		issuedAt  time.Time
+		expected  bool
+	}{
+		{"NeverRevoked", time.Time{}, revokedAt, false},
+		{"IssuedBeforeRevocation", revokedAt, revokedAt.Add(-time.Second), true},
+		{"IssuedAtRevocation", revokedAt, revokedAt, false},
+		{"IssuedAfterRevocation", revokedAt, revokedAt.Add(time.Minute), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			store := newGrantRevocationStoreInterfaceMock(suite.T())
+			store.On("GetRevocationTime", suite.ctx, testUserID).Return(tc.revokedAt, nil).Once()
+			service := newGrantRevocationService(store, suite.mockSSOSessionSvc)
+
+			revoked, svcErr := service.IsGrantRevoked(suite.ctx, testUserID, tc.issuedAt)
+
+			suite.Nil(svcErr)
+			suite.Equal(tc.expected, revoked)
+		})
+	}
+}
+
+func (suite *GrantRevocationServiceTestSuite) TestIsGrantRevoked_StoreError() {
+	suite.mockStore.On("GetRevocationTime", suite.ctx, testUserID).
+		Return(time.Time{}, errors.New("store error")).Once()
+
+	revoked, svcErr := suite.service.IsGrantRevoked(suite.ctx, testUserID, time.Now())
+
+	suite.False(revoked)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
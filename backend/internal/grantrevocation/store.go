@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grantrevocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// grantRevocationStoreInterface defines the interface for the grant revocation store.
+type grantRevocationStoreInterface interface {
+	// SetRevocationTime records the time up to which the grants issued to a user are revoked.
+	SetRevocationTime(ctx context.Context, userID string, revokedAt time.Time) error
+
+	// GetRevocationTime retrieves the grant revocation time of a user. It returns the zero time if the grants of
+	// the user were never revoked.
+	GetRevocationTime(ctx context.Context, userID string) (time.Time, error)
+}
+
+// grantRevocationStore is the runtime database implementation of grantRevocationStoreInterface.
+type grantRevocationStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newGrantRevocationStore creates a new instance of grantRevocationStore.
+func newGrantRevocationStore(dbProvider provider.DBProviderInterface,
+	deploymentID string) grantRevocationStoreInterface {
+	return &grantRevocationStore{
+		dbProvider:   dbProvider,
+		deploymentID: deploymentID,
+	}
+}
+
+// SetRevocationTime records the grant revocation time of a user in the database.
+func (s *grantRevocationStore) SetRevocationTime(ctx context.Context, userID string, revokedAt time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpsertRevocationTime, userID, revokedAt,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to record grant revocation time: %w", err)
+	}
+
+	return nil
+}
+
+// GetRevocationTime retrieves the grant revocation time of a user from the database.
+func (s *grantRevocationStore) GetRevocationTime(ctx context.Context, userID string) (time.Time, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetRevocationTime, userID, s.deploymentID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return time.Time{}, nil
+	}
+
+	return dbutils.ParseTimeField(results[0]["revoked_at"], "revoked_at")
+}
@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grantrevocation
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryUpsertRevocationTime records the grant revocation time of a user, replacing the previous one.
+	queryUpsertRevocationTime = dbmodel.DBQuery{
+		ID: "UGRQ-01",
+		Query: `INSERT INTO "USER_GRANT_REVOCATION" (USER_ID, REVOKED_AT, DEPLOYMENT_ID) VALUES ($1, $2, $3) ` +
+			`ON CONFLICT (USER_ID, DEPLOYMENT_ID) DO UPDATE SET REVOKED_AT = excluded.REVOKED_AT`,
+	}
+
+	// queryGetRevocationTime retrieves the grant revocation time of a user.
+	queryGetRevocationTime = dbmodel.DBQuery{
+		ID:    "UGRQ-02",
+		Query: `SELECT REVOKED_AT FROM "USER_GRANT_REVOCATION" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
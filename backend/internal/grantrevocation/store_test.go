@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package grantrevocation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+type GrantRevocationStoreTestSuite struct {
+	suite.Suite
+	store            *grantRevocationStore
+	mockDBProvider   *providermock.DBProviderInterfaceMock
+	mockDBClient     *providermock.DBClientInterfaceMock
+	ctx              context.Context
+	testDeploymentID string
+}
+
+func TestGrantRevocationStoreSuite(t *testing.T) {
+	suite.Run(t, new(GrantRevocationStoreTestSuite))
+}
+
+func (suite *GrantRevocationStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.ctx = context.Background()
+	suite.testDeploymentID = "test-deployment-id"
+	suite.store = &grantRevocationStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: suite.testDeploymentID,
+	}
+}
+
+// Tests for SetRevocationTime
+
+func (suite *GrantRevocationStoreTestSuite) TestSetRevocationTime_Success() {
+	revokedAt := time.Now().UTC()
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpsertRevocationTime, testUserID, revokedAt,
+		suite.testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.SetRevocationTime(suite.ctx, testUserID, revokedAt)
+
+	suite.Nil(err)
+}
+
+func (suite *GrantRevocationStoreTestSuite) TestSetRevocationTime_DBProviderError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db provider error")).Once()
+
+	err := suite.store.SetRevocationTime(suite.ctx, testUserID, time.Now())
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *GrantRevocationStoreTestSuite) TestSetRevocationTime_ExecuteError() {
+	revokedAt := time.Now().UTC()
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpsertRevocationTime, testUserID, revokedAt,
+		suite.testDeploymentID).Return(int64(0), errors.New("execute error")).Once()
+
+	err := suite.store.SetRevocationTime(suite.ctx, testUserID, revokedAt)
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "failed to record grant revocation time")
+}
+
+// Tests for GetRevocationTime
+
+func (suite *GrantRevocationStoreTestSuite) TestGetRevocationTime_Success() {
+	revokedAt := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetRevocationTime, testUserID, suite.testDeploymentID).
+		Return([]map[string]interface{}{{"revoked_at": revokedAt}}, nil).Once()
+
+	result, err := suite.store.GetRevocationTime(suite.ctx, testUserID)
+
+	suite.Nil(err)
+	suite.Equal(revokedAt, result)
+}
+
+func (suite *GrantRevocationStoreTestSuite) TestGetRevocationTime_StringValue() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetRevocationTime, testUserID, suite.testDeploymentID).
+		Return([]map[string]interface{}{{"revoked_at": "2026-01-01 10:00:00 +0000 UTC"}}, nil).Once()
+
+	result, err := suite.store.GetRevocationTime(suite.ctx, testUserID)
+
+	suite.Nil(err)
+	suite.Equal(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), result)
+}
+
+func (suite *GrantRevocationStoreTestSuite) TestGetRevocationTime_NotRevoked() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetRevocationTime, testUserID, suite.testDeploymentID).
+		Return([]map[string]interface{}{}, nil).Once()
+
+	result, err := suite.store.GetRevocationTime(suite.ctx, testUserID)
+
+	suite.Nil(err)
+	suite.True(result.IsZero())
+}
+
+func (suite *GrantRevocationStoreTestSuite) TestGetRevocationTime_DBProviderError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db provider error")).Once()
+
+	_, err := suite.store.GetRevocationTime(suite.ctx, testUserID)
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *GrantRevocationStoreTestSuite) TestGetRevocationTime_QueryError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetRevocationTime, testUserID, suite.testDeploymentID).
+		Return(nil, errors.New("query error")).Once()
+
+	_, err := suite.store.GetRevocationTime(suite.ctx, testUserID)
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "failed to execute query")
+}
+
+func (suite *GrantRevocationStoreTestSuite) TestGetRevocationTime_InvalidValue() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetRevocationTime, testUserID, suite.testDeploymentID).
+		Return([]map[string]interface{}{{"revoked_at": 12}}, nil).Once()
+
+	_, err := suite.store.GetRevocationTime(suite.ctx, testUserID)
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "unexpected type for revoked_at")
+}
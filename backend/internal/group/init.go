@@ -25,6 +25,8 @@ import (
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/provisioning"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -39,6 +41,7 @@ func Initialize(
 	entityService entity.EntityServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	provisioningService provisioning.ProvisioningServiceInterface,
 ) (GroupServiceInterface, oupkg.OUGroupResolver, declarativeresource.ResourceExporter, error) {
 	transactioner, err := dbProvider.GetUserDBTransactioner()
 	if err != nil {
@@ -49,6 +52,9 @@ func Initialize(
 	groupService := newGroupServiceWithStore(
 		groupStore, ouService, entityService, entityTypeService, authzService, transactioner,
 	)
+	if provisioningService != nil && hasGroupProvisioningTargets() {
+		groupService = newProvisioningGroupService(groupService, provisioningService)
+	}
 
 	// Create resolver for OU package to query group data without cross-DB access
 	ouGroupResolver := newOUGroupResolver(groupStore)
@@ -60,6 +66,16 @@ func Initialize(
 	return groupService, ouGroupResolver, exporter, nil
 }
 
+// hasGroupProvisioningTargets returns true if the group changes are pushed to any provisioning target.
+func hasGroupProvisioningTargets() bool {
+	for _, target := range config.GetServerRuntime().Config.Provisioning.Targets {
+		if target.ProvisionGroups {
+			return true
+		}
+	}
+	return false
+}
+
 // registerRoutes registers the routes for group management operations.
 func registerRoutes(mux *http.ServeMux, groupHandler *groupHandler) {
 	opts1 := middleware.CORSOptions{
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package group
+
+import (
+	"context"
+
+	"github.com/thunder-id/thunderid/internal/provisioning"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// provisioningGroupService decorates the group service to queue the group lifecycle changes to be pushed to the
+// provisioning targets.
+type provisioningGroupService struct {
+	GroupServiceInterface
+	provisioningService provisioning.ProvisioningServiceInterface
+}
+
+// newProvisioningGroupService creates a group service that queues the group lifecycle changes.
+func newProvisioningGroupService(
+	groupService GroupServiceInterface,
+	provisioningService provisioning.ProvisioningServiceInterface,
+) GroupServiceInterface {
+	return &provisioningGroupService{
+		GroupServiceInterface: groupService,
+		provisioningService:   provisioningService,
+	}
+}
+
+// CreateGroup creates a group and queues its creation.
+func (s *provisioningGroupService) CreateGroup(
+	ctx context.Context, request CreateGroupRequest,
+) (*Group, *serviceerror.ServiceError) {
+	group, svcErr := s.GroupServiceInterface.CreateGroup(ctx, request)
+	if svcErr == nil {
+		s.queueGroupChange(ctx, provisioning.OperationCreate, group)
+	}
+	return group, svcErr
+}
+
+// CreateGroupByPath creates a group under the organization unit of the handle path and queues its creation.
+func (s *provisioningGroupService) CreateGroupByPath(
+	ctx context.Context, handlePath string, request CreateGroupByPathRequest,
+) (*Group, *serviceerror.ServiceError) {
+	group, svcErr := s.GroupServiceInterface.CreateGroupByPath(ctx, handlePath, request)
+	if svcErr == nil {
+		s.queueGroupChange(ctx, provisioning.OperationCreate, group)
+	}
+	return group, svcErr
+}
+
+// UpdateGroup updates a group and queues its update.
+func (s *provisioningGroupService) UpdateGroup(
+	ctx context.Context, groupID string, request UpdateGroupRequest,
+) (*Group, *serviceerror.ServiceError) {
+	group, svcErr := s.GroupServiceInterface.UpdateGroup(ctx, groupID, request)
+	if svcErr == nil {
+		s.queueGroupChange(ctx, provisioning.OperationUpdate, group)
+	}
+	return group, svcErr
+}
+
+// DeleteGroup deletes a group and queues its deletion.
+func (s *provisioningGroupService) DeleteGroup(ctx context.Context, groupID string) *serviceerror.ServiceError {
+	svcErr := s.GroupServiceInterface.DeleteGroup(ctx, groupID)
+	if svcErr == nil {
+		s.provisioningService.QueueChange(ctx, provisioning.ResourceChange{
+			ResourceType: provisioning.ResourceTypeGroup,
+			ResourceID:   groupID,
+			Operation:    provisioning.OperationDelete,
+		})
+	}
+	return svcErr
+}
+
+// AddGroupMembers adds members to a group and queues the update of the group.
+func (s *provisioningGroupService) AddGroupMembers(
+	ctx context.Context, groupID string, members []Member,
+) (*Group, *serviceerror.ServiceError) {
+	group, svcErr := s.GroupServiceInterface.AddGroupMembers(ctx, groupID, members)
+	if svcErr == nil {
+		s.queueGroupChange(ctx, provisioning.OperationUpdate, group)
+	}
+	return group, svcErr
+}
+
+// RemoveGroupMembers removes members from a group and queues the update of the group.
+func (s *provisioningGroupService) RemoveGroupMembers(
+	ctx context.Context, groupID string, members []Member,
+) (*Group, *serviceerror.ServiceError) {
+	group, svcErr := s.GroupServiceInterface.RemoveGroupMembers(ctx, groupID, members)
+	if svcErr == nil {
+		s.queueGroupChange(ctx, provisioning.OperationUpdate, group)
+	}
+	return group, svcErr
+}
+
+// queueGroupChange queues a change of a group along with the users that are members of the group.
+func (s *provisioningGroupService) queueGroupChange(
+	ctx context.Context, operation provisioning.Operation, group *Group,
+) {
+	if group == nil {
+		return
+	}
+
+	var userIDs []string
+	for offset := 0; ; offset += serverconst.MaxPageSize {
+		members, svcErr := s.GroupServiceInterface.GetGroupMembers(ctx, group.ID, serverconst.MaxPageSize, offset,
+			false)
+		if svcErr != nil {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Error(
+				"Failed to retrieve the members of the group to provision", log.String("id", group.ID),
+				log.String("code", svcErr.Code))
+			return
+		}
+		for _, member := range members.Members {
+			if member.Type == MemberTypeUser {
+				userIDs = append(userIDs, member.ID)
+			}
+		}
+		if len(members.Members) < serverconst.MaxPageSize {
+			break
+		}
+	}
+
+	s.provisioningService.QueueChange(ctx, provisioning.ResourceChange{
+		ResourceType: provisioning.ResourceTypeGroup,
+		ResourceID:   group.ID,
+		Operation:    operation,
+		DisplayName:  group.Name,
+		Members:      userIDs,
+	})
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package group
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/provisioning"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/provisioningmock"
+)
+
+type ProvisioningGroupServiceTestSuite struct {
+	suite.Suite
+	mockGroupService        *GroupServiceInterfaceMock
+	mockProvisioningService *provisioningmock.ProvisioningServiceInterfaceMock
+	service                 GroupServiceInterface
+}
+
+func TestProvisioningGroupServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProvisioningGroupServiceTestSuite))
+}
+
+func (suite *ProvisioningGroupServiceTestSuite) SetupTest() {
+	suite.mockGroupService = NewGroupServiceInterfaceMock(suite.T())
+	suite.mockProvisioningService = provisioningmock.NewProvisioningServiceInterfaceMock(suite.T())
+	suite.service = newProvisioningGroupService(suite.mockGroupService, suite.mockProvisioningService)
+}
+
+func (suite *ProvisioningGroupServiceTestSuite) expectMembers(groupID string, members ...Member) {
+	suite.mockGroupService.EXPECT().GetGroupMembers(mock.Anything, groupID, serverconst.MaxPageSize, 0, false).
+		Return(&MemberListResponse{TotalResults: len(members), Members: members}, nil).Once()
+}
+
+func (suite *ProvisioningGroupServiceTestSuite) TestCreateGroup() {
+	request := CreateGroupRequest{Name: "Admins"}
+	suite.mockGroupService.EXPECT().CreateGroup(mock.Anything, request).
+		Return(&Group{ID: "group-1", Name: "Admins"}, nil).Once()
+	suite.expectMembers("group-1",
+		Member{ID: "user-1", Type: MemberTypeUser},
+		Member{ID: "app-1", Type: MemberTypeApp},
+		Member{ID: "group-2", Type: MemberTypeGroup})
+	suite.mockProvisioningService.EXPECT().QueueChange(mock.Anything, provisioning.ResourceChange{
+		ResourceType: provisioning.ResourceTypeGroup,
+		ResourceID:   "group-1",
+		Operation:    provisioning.OperationCreate,
+		DisplayName:  "Admins",
+		Members:      []string{"user-1"},
+	}).Once()
+
+	group, svcErr := suite.service.CreateGroup(context.Background(), request)
+	suite.Nil(svcErr)
+	suite.Equal("group-1", group.ID)
+}
+
+func (suite *ProvisioningGroupServiceTestSuite) TestCreateGroup_Error() {
+	suite.mockGroupService.EXPECT().CreateGroup(mock.Anything, mock.Anything).
+		Return(nil, &ErrorGroupNameConflict).Once()
+
+	_, svcErr := suite.service.CreateGroup(context.Background(), CreateGroupRequest{Name: "Admins"})
+	suite.Equal(ErrorGroupNameConflict.Code, svcErr.Code)
+}
+
+func (suite *ProvisioningGroupServiceTestSuite) TestAddGroupMembers() {
+	members := []Member{{ID: "user-2", Type: MemberTypeUser}}
+	suite.mockGroupService.EXPECT().AddGroupMembers(mock.Anything, "group-1", members).
+		Return(&Group{ID: "group-1", Name: "Admins"}, nil).Once()
+	suite.expectMembers("group-1", Member{ID: "user-1", Type: MemberTypeUser},
+		Member{ID: "user-2", Type: MemberTypeUser})
+	suite.mockProvisioningService.EXPECT().QueueChange(mock.Anything,
+		mock.MatchedBy(func(change provisioning.ResourceChange) bool {
+			return change.Operation == provisioning.OperationUpdate && len(change.Members) == 2
+		})).Once()
+
+	_, svcErr := suite.service.AddGroupMembers(context.Background(), "group-1", members)
+	suite.Nil(svcErr)
+}
+
+func (suite *ProvisioningGroupServiceTestSuite) TestUpdateGroup_MembersError() {
+	suite.mockGroupService.EXPECT().UpdateGroup(mock.Anything, "group-1", mock.Anything).
+		Return(&Group{ID: "group-1", Name: "Admins"}, nil).Once()
+	suite.mockGroupService.EXPECT().GetGroupMembers(mock.Anything, "group-1", serverconst.MaxPageSize, 0, false).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	_, svcErr := suite.service.UpdateGroup(context.Background(), "group-1", UpdateGroupRequest{Name: "Admins"})
+	suite.Nil(svcErr)
+}
+
+func (suite *ProvisioningGroupServiceTestSuite) TestDeleteGroup() {
+	suite.mockGroupService.EXPECT().DeleteGroup(mock.Anything, "group-1").Return(nil).Once()
+	suite.mockProvisioningService.EXPECT().QueueChange(mock.Anything, provisioning.ResourceChange{
+		ResourceType: provisioning.ResourceTypeGroup,
+		ResourceID:   "group-1",
+		Operation:    provisioning.OperationDelete,
+	}).Once()
+
+	suite.Nil(suite.service.DeleteGroup(context.Background(), "group-1"))
+}
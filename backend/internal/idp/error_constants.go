@@ -176,4 +176,18 @@ var (
 			DefaultValue: "The total number of records exceeds the maximum limit in composite mode",
 		},
 	}
+	// ErrorIDPHealthCheckDisabled is the error returned when the health of the identity providers is requested
+	// while the health checks are disabled.
+	ErrorIDPHealthCheckDisabled = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "IDP-1012",
+		Error: core.I18nMessage{
+			Key:          "error.idpservice.health_check_disabled",
+			DefaultValue: "Health checks disabled",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.idpservice.health_check_disabled_description",
+			DefaultValue: "The health checks of the identity providers are not enabled",
+		},
+	}
 )
@@ -96,18 +96,21 @@ func (ih *idpHandler) HandleIDPListRequest(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	idpListResponse := make([]basicIDPResponse, 0, len(idpList))
-	for _, idp := range idpList {
-		idpListResponse = append(idpListResponse, basicIDPResponse{
-			ID:          idp.ID,
-			Name:        idp.Name,
-			Description: idp.Description,
-			Type:        string(idp.Type),
-			IsReadOnly:  idp.IsReadOnly,
-		})
+	sysutils.WriteSuccessResponse(w, http.StatusOK, getBasicIDPListResponse(idpList))
+}
+
+// HandleIDPHealthRequest handles the request for the health of the identity providers.
+func (ih *idpHandler) HandleIDPHealthRequest(w http.ResponseWriter, r *http.Request) {
+	statuses, svcErr := ih.idpService.GetIdentityProviderHealth(r.Context())
+	if svcErr != nil {
+		writeServiceErrorResponse(w, svcErr)
+		return
 	}
 
-	sysutils.WriteSuccessResponse(w, http.StatusOK, idpListResponse)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, idpHealthListResponse{
+		TotalResults:      len(statuses),
+		IdentityProviders: statuses,
+	})
 }
 
 // HandleIDPGetRequest handles the get identity provider request.
@@ -243,7 +246,7 @@ func writeServiceErrorResponse(w http.ResponseWriter, svcErr *serviceerror.Servi
 // getClientErrorStatusCode returns the appropriate HTTP status code for client errors.
 func getClientErrorStatusCode(errorCode string) int {
 	switch errorCode {
-	case ErrorIDPNotFound.Code:
+	case ErrorIDPNotFound.Code, ErrorIDPHealthCheckDisabled.Code:
 		return http.StatusNotFound
 	case ErrorIDPAlreadyExists.Code:
 		return http.StatusConflict
@@ -270,6 +273,21 @@ func getSanitizedProperties(propDTOs []cmodels.PropertyDTO) ([]cmodels.Property,
 	return properties, nil
 }
 
+// getBasicIDPListResponse constructs the response for a list of identity providers.
+func getBasicIDPListResponse(idpList []BasicIDPDTO) []basicIDPResponse {
+	idpListResponse := make([]basicIDPResponse, 0, len(idpList))
+	for _, idp := range idpList {
+		idpListResponse = append(idpListResponse, basicIDPResponse{
+			ID:          idp.ID,
+			Name:        idp.Name,
+			Description: idp.Description,
+			Type:        string(idp.Type),
+			IsReadOnly:  idp.IsReadOnly,
+		})
+	}
+	return idpListResponse
+}
+
 // getIDPResponse constructs the response for a identity provider.
 func getIDPResponse(idp IDPDTO) (idpResponse, error) {
 	returnIDP := idpResponse{
@@ -174,6 +174,41 @@ func (s *IDPHandlerTestSuite) TestHandleIDPListRequest_Success() {
 	s.False(response[1].IsReadOnly, "Second IDP should be mutable")
 }
 
+// TestHandleIDPHealthRequest_Success tests retrieving the health of the IDPs
+func (s *IDPHandlerTestSuite) TestHandleIDPHealthRequest_Success() {
+	req := httptest.NewRequest(http.MethodGet, "/identity-providers/health", nil)
+	rr := httptest.NewRecorder()
+
+	statuses := []IDPHealthStatus{
+		{ID: "idp-1", Name: "IDP 1", Status: IDPHealthStateUp},
+		{ID: "idp-2", Name: "IDP 2", Status: IDPHealthStateDown, CircuitOpen: true, ConsecutiveFailures: 3},
+	}
+	s.mockService.On("GetIdentityProviderHealth", mock.Anything).Return(statuses, (*serviceerror.ServiceError)(nil))
+
+	s.handler.HandleIDPHealthRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var response idpHealthListResponse
+	err := json.NewDecoder(rr.Body).Decode(&response)
+	s.NoError(err)
+	s.Equal(2, response.TotalResults)
+	s.Equal(IDPHealthStateDown, response.IdentityProviders[1].Status)
+	s.True(response.IdentityProviders[1].CircuitOpen)
+}
+
+// TestHandleIDPHealthRequest_Disabled tests retrieving the health of the IDPs when health checks are disabled
+func (s *IDPHandlerTestSuite) TestHandleIDPHealthRequest_Disabled() {
+	req := httptest.NewRequest(http.MethodGet, "/identity-providers/health", nil)
+	rr := httptest.NewRecorder()
+
+	s.mockService.On("GetIdentityProviderHealth", mock.Anything).
+		Return(([]IDPHealthStatus)(nil), &ErrorIDPHealthCheckDisabled)
+
+	s.handler.HandleIDPHealthRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
 // TestHandleIDPListRequest_WithReadOnlyIDPs tests IDP list retrieval with read-only IDPs
 func (s *IDPHandlerTestSuite) TestHandleIDPListRequest_WithReadOnlyIDPs() {
 	req := httptest.NewRequest(http.MethodGet, "/identity-providers", nil)
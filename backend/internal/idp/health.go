@@ -0,0 +1,292 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	defaultHealthCheckInterval         = 60
+	defaultHealthCheckTimeout          = 5
+	defaultHealthCheckFailureThreshold = 3
+)
+
+// probedEndpoints lists the endpoint properties probed by the health checks, in the order they are reported.
+var probedEndpoints = []string{PropTokenEndpoint, PropUserInfoEndpoint, PropJwksEndpoint}
+
+// idpHealthMonitorInterface tracks the health of the identity providers and the state of their circuits.
+type idpHealthMonitorInterface interface {
+	isAvailable(idpID string) bool
+	getHealthStatuses() []IDPHealthStatus
+}
+
+// idpHealthMonitor periodically probes the endpoints of the identity providers. The circuit of an identity
+// provider opens after a configured number of consecutive failed probes and closes on the next successful one.
+// The state is kept in memory, so each node of a cluster probes and tracks the identity providers on its own.
+type idpHealthMonitor struct {
+	idpStore         idpStoreInterface
+	httpClient       syshttp.HTTPClientInterface
+	interval         time.Duration
+	failureThreshold int
+	mu               sync.RWMutex
+	statuses         map[string]*IDPHealthStatus
+	metrics          idpHealthMetrics
+	logger           *log.Logger
+}
+
+// idpHealthMetrics holds the instruments recording the outcome of the probes and the state of the circuits.
+type idpHealthMetrics struct {
+	probes      metric.Int64Counter
+	circuitOpen metric.Int64ObservableGauge
+}
+
+var _ idpHealthMonitorInterface = (*idpHealthMonitor)(nil)
+
+// newIDPHealthMonitor creates a new health monitor for the identity providers in the given store.
+func newIDPHealthMonitor(idpStore idpStoreInterface, httpClient syshttp.HTTPClientInterface,
+	healthConfig config.IDPHealthCheckConfig) *idpHealthMonitor {
+	interval := healthConfig.Interval
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+	failureThreshold := healthConfig.FailureThreshold
+	if failureThreshold <= 0 {
+		failureThreshold = defaultHealthCheckFailureThreshold
+	}
+
+	monitor := &idpHealthMonitor{
+		idpStore:         idpStore,
+		httpClient:       httpClient,
+		interval:         time.Duration(interval) * time.Second,
+		failureThreshold: failureThreshold,
+		statuses:         make(map[string]*IDPHealthStatus),
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "IDPHealthMonitor")),
+	}
+	monitor.initMetrics()
+
+	return monitor
+}
+
+// getHealthCheckTimeout returns the time to wait for an endpoint to respond to a probe.
+func getHealthCheckTimeout(healthConfig config.IDPHealthCheckConfig) time.Duration {
+	if healthConfig.Timeout <= 0 {
+		return defaultHealthCheckTimeout * time.Second
+	}
+	return time.Duration(healthConfig.Timeout) * time.Second
+}
+
+// initMetrics registers the instruments of the health checks.
+func (m *idpHealthMonitor) initMetrics() {
+	meter := otel.Meter("github.com/thunder-id/thunderid/idp/health")
+	m.metrics.probes, _ = meter.Int64Counter(
+		"thunderid_idp_probes_total",
+		metric.WithDescription("Total probes of identity provider endpoints"),
+	)
+	m.metrics.circuitOpen, _ = meter.Int64ObservableGauge(
+		"thunderid_idp_circuit_open",
+		metric.WithDescription("Whether the circuit of an identity provider is open (1) or closed (0)"),
+		metric.WithInt64Callback(func(_ context.Context, observer metric.Int64Observer) error {
+			for _, status := range m.getHealthStatuses() {
+				value := int64(0)
+				if status.CircuitOpen {
+					value = 1
+				}
+				observer.Observe(value, metric.WithAttributes(attribute.String("idp_id", status.ID)))
+			}
+			return nil
+		}),
+	)
+}
+
+// start probes the identity providers once and then at the configured interval in a background routine.
+func (m *idpHealthMonitor) start() {
+	m.logger.Debug("Starting identity provider health checks", log.Any("interval", m.interval))
+
+	go func() {
+		m.probeAll(context.Background())
+
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			m.probeAll(context.Background())
+		}
+	}()
+}
+
+// isAvailable reports whether the executors may use the identity provider. Identity providers that have not been
+// probed yet are considered available.
+func (m *idpHealthMonitor) isAvailable(idpID string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	status, ok := m.statuses[idpID]
+	return !ok || !status.CircuitOpen
+}
+
+// getHealthStatuses returns the health of the probed identity providers ordered by name.
+func (m *idpHealthMonitor) getHealthStatuses() []IDPHealthStatus {
+	m.mu.RLock()
+	statuses := make([]IDPHealthStatus, 0, len(m.statuses))
+	for _, status := range m.statuses {
+		statusCopy := *status
+		statusCopy.Endpoints = append([]IDPEndpointHealth(nil), status.Endpoints...)
+		statuses = append(statuses, statusCopy)
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Name != statuses[j].Name {
+			return statuses[i].Name < statuses[j].Name
+		}
+		return statuses[i].ID < statuses[j].ID
+	})
+	return statuses
+}
+
+// probeAll probes every identity provider in the store and drops the state of the deleted ones.
+func (m *idpHealthMonitor) probeAll(ctx context.Context) {
+	idpList, err := m.idpStore.GetIdentityProviderList(ctx)
+	if err != nil {
+		m.logger.Error("Failed to list identity providers for health checks", log.Error(err))
+		return
+	}
+
+	seen := make(map[string]struct{}, len(idpList))
+	for _, basicIDP := range idpList {
+		seen[basicIDP.ID] = struct{}{}
+
+		idp, err := m.idpStore.GetIdentityProvider(ctx, basicIDP.ID)
+		if err != nil || idp == nil {
+			m.logger.Debug("Skipping health check of identity provider that could not be retrieved",
+				log.String("idpId", basicIDP.ID), log.Error(err))
+			continue
+		}
+		m.probeIDP(ctx, idp)
+	}
+
+	m.mu.Lock()
+	for idpID := range m.statuses {
+		if _, ok := seen[idpID]; !ok {
+			delete(m.statuses, idpID)
+		}
+	}
+	m.mu.Unlock()
+}
+
+// probeIDP probes the configured endpoints of an identity provider and updates the state of its circuit.
+func (m *idpHealthMonitor) probeIDP(ctx context.Context, idp *IDPDTO) {
+	endpoints := make([]IDPEndpointHealth, 0, len(probedEndpoints))
+	healthy := true
+	for _, name := range probedEndpoints {
+		endpointURL := GetPropertyValue(idp.Properties, name)
+		if endpointURL == "" {
+			continue
+		}
+
+		endpoint := IDPEndpointHealth{Name: name, URL: endpointURL, Status: IDPHealthStateUp}
+		result := "success"
+		if err := m.probeEndpoint(ctx, endpointURL); err != nil {
+			endpoint.Status = IDPHealthStateDown
+			endpoint.Error = err.Error()
+			result = "failure"
+			healthy = false
+		}
+		m.metrics.probes.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("idp_id", idp.ID),
+			attribute.String("endpoint", name),
+			attribute.String("result", result),
+		))
+		endpoints = append(endpoints, endpoint)
+	}
+
+	now := time.Now().UTC()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status, ok := m.statuses[idp.ID]
+	if !ok {
+		status = &IDPHealthStatus{ID: idp.ID}
+		m.statuses[idp.ID] = status
+	}
+	status.Name = idp.Name
+	status.Endpoints = endpoints
+	status.LastCheckedAt = &now
+
+	switch {
+	case len(endpoints) == 0:
+		status.Status = IDPHealthStateUnknown
+		status.ConsecutiveFailures = 0
+		status.CircuitOpen = false
+	case healthy:
+		if status.CircuitOpen {
+			m.logger.Info("Identity provider recovered, closing its circuit", log.String("idpId", idp.ID))
+		}
+		status.Status = IDPHealthStateUp
+		status.ConsecutiveFailures = 0
+		status.CircuitOpen = false
+	default:
+		status.Status = IDPHealthStateDown
+		status.ConsecutiveFailures++
+		if !status.CircuitOpen && status.ConsecutiveFailures >= m.failureThreshold {
+			m.logger.Warn("Identity provider is unreachable, opening its circuit", log.String("idpId", idp.ID),
+				log.Int("consecutiveFailures", status.ConsecutiveFailures))
+			status.CircuitOpen = true
+		}
+	}
+}
+
+// probeEndpoint checks that an endpoint is reachable. Any response other than a server error counts as
+// reachable, as the probe does not authenticate and the endpoints reject unauthenticated requests.
+func (m *idpHealthMonitor) probeEndpoint(ctx context.Context, endpointURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpointURL, nil)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint URL: %w", err)
+	}
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("endpoint is unreachable: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
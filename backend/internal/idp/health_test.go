@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+const (
+	testHealthIDPID         = "idp-1"
+	testHealthTokenEndpoint = "https://idp.example.com/token"
+	testHealthJwksEndpoint  = "https://idp.example.com/jwks"
+)
+
+type IDPHealthMonitorTestSuite struct {
+	suite.Suite
+	mockStore      *idpStoreInterfaceMock
+	mockHTTPClient *httpmock.HTTPClientInterfaceMock
+	monitor        *idpHealthMonitor
+}
+
+func TestIDPHealthMonitorTestSuite(t *testing.T) {
+	suite.Run(t, new(IDPHealthMonitorTestSuite))
+}
+
+func (s *IDPHealthMonitorTestSuite) SetupTest() {
+	s.mockStore = newIdpStoreInterfaceMock(s.T())
+	s.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(s.T())
+	s.monitor = newIDPHealthMonitor(s.mockStore, s.mockHTTPClient, config.IDPHealthCheckConfig{
+		Enabled:          true,
+		Interval:         30,
+		FailureThreshold: 2,
+	})
+}
+
+func (s *IDPHealthMonitorTestSuite) newTestIDP(id, name string, endpoints map[string]string) *IDPDTO {
+	properties := make([]cmodels.Property, 0, len(endpoints))
+	for propName, value := range endpoints {
+		prop, err := cmodels.NewProperty(propName, value, false)
+		s.Require().NoError(err)
+		properties = append(properties, *prop)
+	}
+	return &IDPDTO{ID: id, Name: name, Type: IDPTypeOIDC, Properties: properties}
+}
+
+func (s *IDPHealthMonitorTestSuite) expectIDPs(idps ...*IDPDTO) {
+	basicIDPs := make([]BasicIDPDTO, 0, len(idps))
+	for _, idp := range idps {
+		basicIDPs = append(basicIDPs, BasicIDPDTO{ID: idp.ID, Name: idp.Name, Type: idp.Type})
+		s.mockStore.EXPECT().GetIdentityProvider(mock.Anything, idp.ID).Return(idp, nil).Once()
+	}
+	s.mockStore.EXPECT().GetIdentityProviderList(mock.Anything).Return(basicIDPs, nil).Once()
+}
+
+func (s *IDPHealthMonitorTestSuite) respondWithStatus(url string, statusCode int) {
+	s.mockHTTPClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == url
+	})).RunAndReturn(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(""))}, nil
+	}).Once()
+}
+
+func (s *IDPHealthMonitorTestSuite) respondWithError(url string) {
+	s.mockHTTPClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == url
+	})).Return(nil, errors.New("connection refused")).Once()
+}
+
+func (s *IDPHealthMonitorTestSuite) TestNewIDPHealthMonitor_Defaults() {
+	monitor := newIDPHealthMonitor(s.mockStore, s.mockHTTPClient, config.IDPHealthCheckConfig{Enabled: true})
+
+	s.Equal(defaultHealthCheckInterval*time.Second, monitor.interval)
+	s.Equal(defaultHealthCheckFailureThreshold, monitor.failureThreshold)
+}
+
+func (s *IDPHealthMonitorTestSuite) TestGetHealthCheckTimeout() {
+	s.Equal(defaultHealthCheckTimeout*time.Second, getHealthCheckTimeout(config.IDPHealthCheckConfig{}))
+	s.Equal(10*time.Second, getHealthCheckTimeout(config.IDPHealthCheckConfig{Timeout: 10}))
+}
+
+func (s *IDPHealthMonitorTestSuite) TestIsAvailable_NotProbed() {
+	s.True(s.monitor.isAvailable(testHealthIDPID))
+	s.Empty(s.monitor.getHealthStatuses())
+}
+
+func (s *IDPHealthMonitorTestSuite) TestProbeAll_Reachable() {
+	idp := s.newTestIDP(testHealthIDPID, "Example", map[string]string{
+		PropTokenEndpoint: testHealthTokenEndpoint,
+		PropJwksEndpoint:  testHealthJwksEndpoint,
+		PropClientID:      "client",
+	})
+	s.expectIDPs(idp)
+	// The token endpoint rejects the unauthenticated probe, which still proves it is reachable.
+	s.respondWithStatus(testHealthTokenEndpoint, http.StatusMethodNotAllowed)
+	s.respondWithStatus(testHealthJwksEndpoint, http.StatusOK)
+
+	s.monitor.probeAll(context.Background())
+
+	statuses := s.monitor.getHealthStatuses()
+	s.Require().Len(statuses, 1)
+	s.Equal(testHealthIDPID, statuses[0].ID)
+	s.Equal("Example", statuses[0].Name)
+	s.Equal(IDPHealthStateUp, statuses[0].Status)
+	s.False(statuses[0].CircuitOpen)
+	s.NotNil(statuses[0].LastCheckedAt)
+	s.Require().Len(statuses[0].Endpoints, 2)
+	s.Equal(PropTokenEndpoint, statuses[0].Endpoints[0].Name)
+	s.Equal(PropJwksEndpoint, statuses[0].Endpoints[1].Name)
+	s.True(s.monitor.isAvailable(testHealthIDPID))
+}
+
+func (s *IDPHealthMonitorTestSuite) TestProbeAll_OpensCircuitAfterThreshold() {
+	idp := s.newTestIDP(testHealthIDPID, "Example", map[string]string{PropTokenEndpoint: testHealthTokenEndpoint})
+
+	s.expectIDPs(idp)
+	s.respondWithError(testHealthTokenEndpoint)
+	s.monitor.probeAll(context.Background())
+
+	statuses := s.monitor.getHealthStatuses()
+	s.Require().Len(statuses, 1)
+	s.Equal(IDPHealthStateDown, statuses[0].Status)
+	s.Equal(1, statuses[0].ConsecutiveFailures)
+	s.False(statuses[0].CircuitOpen)
+	s.Contains(statuses[0].Endpoints[0].Error, "endpoint is unreachable")
+	s.True(s.monitor.isAvailable(testHealthIDPID))
+
+	s.expectIDPs(idp)
+	s.respondWithStatus(testHealthTokenEndpoint, http.StatusServiceUnavailable)
+	s.monitor.probeAll(context.Background())
+
+	statuses = s.monitor.getHealthStatuses()
+	s.Equal(2, statuses[0].ConsecutiveFailures)
+	s.True(statuses[0].CircuitOpen)
+	s.Contains(statuses[0].Endpoints[0].Error, "503")
+	s.False(s.monitor.isAvailable(testHealthIDPID))
+}
+
+func (s *IDPHealthMonitorTestSuite) TestProbeAll_ClosesCircuitOnRecovery() {
+	idp := s.newTestIDP(testHealthIDPID, "Example", map[string]string{PropTokenEndpoint: testHealthTokenEndpoint})
+	s.monitor.statuses[testHealthIDPID] = &IDPHealthStatus{
+		ID: testHealthIDPID, Status: IDPHealthStateDown, ConsecutiveFailures: 5, CircuitOpen: true,
+	}
+	s.False(s.monitor.isAvailable(testHealthIDPID))
+
+	s.expectIDPs(idp)
+	s.respondWithStatus(testHealthTokenEndpoint, http.StatusBadRequest)
+	s.monitor.probeAll(context.Background())
+
+	statuses := s.monitor.getHealthStatuses()
+	s.Require().Len(statuses, 1)
+	s.Equal(IDPHealthStateUp, statuses[0].Status)
+	s.Zero(statuses[0].ConsecutiveFailures)
+	s.False(statuses[0].CircuitOpen)
+	s.True(s.monitor.isAvailable(testHealthIDPID))
+}
+
+func (s *IDPHealthMonitorTestSuite) TestProbeAll_NoEndpoints() {
+	idp := s.newTestIDP(testHealthIDPID, "Example", map[string]string{PropClientID: "client"})
+	s.expectIDPs(idp)
+
+	s.monitor.probeAll(context.Background())
+
+	statuses := s.monitor.getHealthStatuses()
+	s.Require().Len(statuses, 1)
+	s.Equal(IDPHealthStateUnknown, statuses[0].Status)
+	s.Empty(statuses[0].Endpoints)
+	s.True(s.monitor.isAvailable(testHealthIDPID))
+	s.mockHTTPClient.AssertNotCalled(s.T(), "Do", mock.Anything)
+}
+
+func (s *IDPHealthMonitorTestSuite) TestProbeAll_DropsDeletedIDPs() {
+	s.monitor.statuses["deleted-idp"] = &IDPHealthStatus{ID: "deleted-idp", CircuitOpen: true}
+	idp := s.newTestIDP(testHealthIDPID, "Example", map[string]string{PropTokenEndpoint: testHealthTokenEndpoint})
+	s.expectIDPs(idp)
+	s.respondWithStatus(testHealthTokenEndpoint, http.StatusBadRequest)
+
+	s.monitor.probeAll(context.Background())
+
+	statuses := s.monitor.getHealthStatuses()
+	s.Require().Len(statuses, 1)
+	s.Equal(testHealthIDPID, statuses[0].ID)
+	s.True(s.monitor.isAvailable("deleted-idp"))
+}
+
+func (s *IDPHealthMonitorTestSuite) TestProbeAll_ListError() {
+	s.monitor.statuses[testHealthIDPID] = &IDPHealthStatus{ID: testHealthIDPID, CircuitOpen: true}
+	s.mockStore.EXPECT().GetIdentityProviderList(mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	s.monitor.probeAll(context.Background())
+
+	s.False(s.monitor.isAvailable(testHealthIDPID))
+}
+
+func (s *IDPHealthMonitorTestSuite) TestProbeAll_SkipsIDPThatCannotBeRetrieved() {
+	s.mockStore.EXPECT().GetIdentityProviderList(mock.Anything).
+		Return([]BasicIDPDTO{{ID: testHealthIDPID, Name: "Example"}}, nil).Once()
+	s.mockStore.EXPECT().GetIdentityProvider(mock.Anything, testHealthIDPID).
+		Return(nil, errors.New("db error")).Once()
+
+	s.monitor.probeAll(context.Background())
+
+	s.Empty(s.monitor.getHealthStatuses())
+}
+
+func (s *IDPHealthMonitorTestSuite) TestGetHealthStatuses_OrderedByName() {
+	s.monitor.statuses["idp-b"] = &IDPHealthStatus{ID: "idp-b", Name: "Beta"}
+	s.monitor.statuses["idp-a"] = &IDPHealthStatus{ID: "idp-a", Name: "Alpha"}
+
+	statuses := s.monitor.getHealthStatuses()
+
+	s.Require().Len(statuses, 2)
+	s.Equal("Alpha", statuses[0].Name)
+	s.Equal("Beta", statuses[1].Name)
+}
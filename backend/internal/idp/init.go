@@ -23,17 +23,21 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/mcp/resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
 
-// Initialize initializes the IDP service and registers its routes.
+// Initialize initializes the IDP service and registers its routes and MCP resources.
 func Initialize(
-	cacheManager cache.CacheManagerInterface, mux *http.ServeMux,
+	cacheManager cache.CacheManagerInterface, mux *http.ServeMux, mcpServer *mcp.Server,
 ) (IDPServiceInterface, declarativeresource.ResourceExporter, error) {
 	// Create store and transactioner based on store mode
 	idpStore, transactioner, err := initializeStore(cacheManager)
@@ -41,11 +45,28 @@ func Initialize(
 		return nil, nil, err
 	}
 
-	idpService := newIDPService(idpStore, transactioner)
+	var healthMonitor idpHealthMonitorInterface
+	healthConfig := config.GetServerRuntime().Config.IdentityProvider.HealthCheck
+	if healthConfig.Enabled {
+		monitor := newIDPHealthMonitor(idpStore,
+			syshttp.NewHTTPClientWithTimeout(getHealthCheckTimeout(healthConfig)), healthConfig)
+		monitor.start()
+		healthMonitor = monitor
+	}
+
+	idpService := newIDPService(idpStore, transactioner, healthMonitor)
+	if mcpServer != nil {
+		idpService = newNotifyingIDPService(idpService, resource.NewNotifier(mcpServer))
+	}
 
 	idpHandler := newIDPHandler(idpService)
 	registerRoutes(mux, idpHandler)
 
+	// Register MCP resources
+	if mcpServer != nil {
+		registerMCPResources(mcpServer, idpService)
+	}
+
 	// Create and return exporter
 	exporter := newIDPExporter(idpService)
 	return idpService, exporter, nil
@@ -172,6 +193,19 @@ func registerRoutes(mux *http.ServeMux, idpHandler *idpHandler) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts1))
 
+	opts3 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /identity-providers/health",
+		idpHandler.HandleIDPHealthRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /identity-providers/health",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+
 	opts2 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -18,7 +18,11 @@
 
 package idp
 
-import "github.com/thunder-id/thunderid/internal/system/cmodels"
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+)
 
 // IDPDTO represents the data transfer object for an identity provider.
 type IDPDTO struct {
@@ -72,3 +76,40 @@ type idpRequestWithID struct {
 	Type        string                `yaml:"type"`
 	Properties  []cmodels.PropertyDTO `yaml:"properties,omitempty"`
 }
+
+// IDPHealthState represents the health of an identity provider or one of its endpoints.
+type IDPHealthState string
+
+const (
+	// IDPHealthStateUp indicates that the probed endpoints responded.
+	IDPHealthStateUp IDPHealthState = "UP"
+	// IDPHealthStateDown indicates that at least one probed endpoint did not respond.
+	IDPHealthStateDown IDPHealthState = "DOWN"
+	// IDPHealthStateUnknown indicates that the identity provider has not been probed yet.
+	IDPHealthStateUnknown IDPHealthState = "UNKNOWN"
+)
+
+// IDPHealthStatus holds the health of an identity provider as seen by the last probe.
+type IDPHealthStatus struct {
+	ID                  string              `json:"id"`
+	Name                string              `json:"name"`
+	Status              IDPHealthState      `json:"status"`
+	CircuitOpen         bool                `json:"circuitOpen"`
+	ConsecutiveFailures int                 `json:"consecutiveFailures"`
+	LastCheckedAt       *time.Time          `json:"lastCheckedAt,omitempty"`
+	Endpoints           []IDPEndpointHealth `json:"endpoints,omitempty"`
+}
+
+// IDPEndpointHealth holds the outcome of the last probe of an endpoint of an identity provider.
+type IDPEndpointHealth struct {
+	Name   string         `json:"name"`
+	URL    string         `json:"url"`
+	Status IDPHealthState `json:"status"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// idpHealthListResponse represents the response payload of the identity provider health API.
+type idpHealthListResponse struct {
+	TotalResults      int               `json:"totalResults"`
+	IdentityProviders []IDPHealthStatus `json:"identityProviders"`
+}
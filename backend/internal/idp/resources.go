@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/mcp/resource"
+)
+
+// idpsResourceURI is the URI of the MCP resource listing the identity providers.
+var idpsResourceURI = resource.BuildURI("identity-providers")
+
+// idpResources provides MCP resources exposing the identity provider configurations.
+type idpResources struct {
+	idpService IDPServiceInterface
+}
+
+// registerMCPResources registers all identity provider resources with the MCP server.
+func registerMCPResources(server *mcp.Server, idpService IDPServiceInterface) {
+	resources := &idpResources{
+		idpService: idpService,
+	}
+
+	server.AddResource(&mcp.Resource{
+		URI:         idpsResourceURI,
+		Name:        "identity-providers",
+		Title:       "Identity Providers",
+		Description: `Basic details of all identity providers.`,
+		MIMEType:    resource.MIMETypeJSON,
+	}, resources.readIdentityProviders)
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: idpsResourceURI + "/{id}",
+		Name:        "identity-provider",
+		Title:       "Identity Provider",
+		Description: `Configuration of an identity provider. Secret properties are masked.`,
+		MIMEType:    resource.MIMETypeJSON,
+	}, resources.readIdentityProvider)
+}
+
+// readIdentityProviders handles reads of the identity provider list resource.
+func (r *idpResources) readIdentityProviders(
+	ctx context.Context,
+	req *mcp.ReadResourceRequest,
+) (*mcp.ReadResourceResult, error) {
+	idpList, svcErr := r.idpService.GetIdentityProviderList(ctx)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to list identity providers: %s", svcErr.ErrorDescription)
+	}
+
+	return resource.JSONContents(req.Params.URI, getBasicIDPListResponse(idpList))
+}
+
+// readIdentityProvider handles reads of an identity provider resource.
+func (r *idpResources) readIdentityProvider(
+	ctx context.Context,
+	req *mcp.ReadResourceRequest,
+) (*mcp.ReadResourceResult, error) {
+	idpID, ok := resource.ExtractID(req.Params.URI, idpsResourceURI)
+	if !ok {
+		return nil, mcp.ResourceNotFoundError(req.Params.URI)
+	}
+
+	idp, svcErr := r.idpService.GetIdentityProvider(ctx, idpID)
+	if svcErr != nil {
+		if svcErr.Code == ErrorIDPNotFound.Code {
+			return nil, mcp.ResourceNotFoundError(req.Params.URI)
+		}
+		return nil, fmt.Errorf("failed to get identity provider: %s", svcErr.ErrorDescription)
+	}
+
+	// Build the same response as the REST API so that secret properties are masked.
+	idpResp, err := getIDPResponse(*idp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build identity provider: %w", err)
+	}
+
+	return resource.JSONContents(req.Params.URI, idpResp)
+}
+
+// notifyingIDPService decorates the identity provider service to notify the MCP clients subscribed to the
+// identity provider resources when an identity provider changes.
+type notifyingIDPService struct {
+	IDPServiceInterface
+	notifier resource.NotifierInterface
+}
+
+// newNotifyingIDPService creates an identity provider service that sends resource change notifications.
+func newNotifyingIDPService(
+	idpService IDPServiceInterface,
+	notifier resource.NotifierInterface,
+) IDPServiceInterface {
+	return &notifyingIDPService{
+		IDPServiceInterface: idpService,
+		notifier:            notifier,
+	}
+}
+
+// CreateIdentityProvider creates an identity provider and notifies the subscribers of the identity
+// provider list.
+func (s *notifyingIDPService) CreateIdentityProvider(
+	ctx context.Context, idp *IDPDTO,
+) (*IDPDTO, *serviceerror.ServiceError) {
+	createdIDP, svcErr := s.IDPServiceInterface.CreateIdentityProvider(ctx, idp)
+	if svcErr == nil {
+		s.notifier.NotifyUpdated(ctx, idpsResourceURI)
+	}
+	return createdIDP, svcErr
+}
+
+// UpdateIdentityProvider updates an identity provider and notifies the subscribers of the identity
+// provider and the identity provider list.
+func (s *notifyingIDPService) UpdateIdentityProvider(
+	ctx context.Context, idpID string, idp *IDPDTO,
+) (*IDPDTO, *serviceerror.ServiceError) {
+	updatedIDP, svcErr := s.IDPServiceInterface.UpdateIdentityProvider(ctx, idpID, idp)
+	if svcErr == nil {
+		s.notifyIDPChanged(ctx, idpID)
+	}
+	return updatedIDP, svcErr
+}
+
+// DeleteIdentityProvider deletes an identity provider and notifies the subscribers of the identity
+// provider and the identity provider list.
+func (s *notifyingIDPService) DeleteIdentityProvider(ctx context.Context, idpID string) *serviceerror.ServiceError {
+	svcErr := s.IDPServiceInterface.DeleteIdentityProvider(ctx, idpID)
+	if svcErr == nil {
+		s.notifyIDPChanged(ctx, idpID)
+	}
+	return svcErr
+}
+
+// notifyIDPChanged notifies the subscribers of the given identity provider and of the identity provider list.
+func (s *notifyingIDPService) notifyIDPChanged(ctx context.Context, idpID string) {
+	s.notifier.NotifyUpdated(ctx, resource.BuildURI("identity-providers", idpID), idpsResourceURI)
+}
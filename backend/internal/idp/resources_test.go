@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package idp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/mcp/resourcemock"
+)
+
+const testIDPResourceURI = "thunderid://identity-providers/idp-1"
+
+type IDPResourcesTestSuite struct {
+	suite.Suite
+	mockService  *IDPServiceInterfaceMock
+	mockNotifier *resourcemock.NotifierInterfaceMock
+	resources    *idpResources
+}
+
+func TestIDPResourcesTestSuite(t *testing.T) {
+	suite.Run(t, new(IDPResourcesTestSuite))
+}
+
+func (s *IDPResourcesTestSuite) SetupTest() {
+	s.mockService = NewIDPServiceInterfaceMock(s.T())
+	s.mockNotifier = resourcemock.NewNotifierInterfaceMock(s.T())
+	s.resources = &idpResources{idpService: s.mockService}
+}
+
+func (s *IDPResourcesTestSuite) newReadResourceRequest(uri string) *mcp.ReadResourceRequest {
+	return &mcp.ReadResourceRequest{Params: &mcp.ReadResourceParams{URI: uri}}
+}
+
+func (s *IDPResourcesTestSuite) TestReadIdentityProviders_Success() {
+	s.mockService.On("GetIdentityProviderList", mock.Anything).Return([]BasicIDPDTO{
+		{ID: "idp-1", Name: testIdpName, Type: IDPTypeOIDC},
+	}, nil)
+
+	result, err := s.resources.readIdentityProviders(context.Background(),
+		s.newReadResourceRequest(idpsResourceURI))
+
+	s.Require().NoError(err)
+	s.Require().Len(result.Contents, 1)
+
+	var idpList []basicIDPResponse
+	s.Require().NoError(json.Unmarshal([]byte(result.Contents[0].Text), &idpList))
+	s.Require().Len(idpList, 1)
+	s.Equal("idp-1", idpList[0].ID)
+	s.Equal(string(IDPTypeOIDC), idpList[0].Type)
+}
+
+func (s *IDPResourcesTestSuite) TestReadIdentityProviders_Error() {
+	s.mockService.On("GetIdentityProviderList", mock.Anything).Return(nil, &serviceerror.InternalServerError)
+
+	result, err := s.resources.readIdentityProviders(context.Background(),
+		s.newReadResourceRequest(idpsResourceURI))
+
+	s.Error(err)
+	s.Nil(result)
+}
+
+func (s *IDPResourcesTestSuite) TestReadIdentityProvider_MasksSecrets() {
+	clientID, _ := cmodels.NewProperty("client_id", "test-client", false)
+	clientSecret, _ := cmodels.NewProperty("client_secret", "", true)
+	s.mockService.On("GetIdentityProvider", mock.Anything, "idp-1").Return(&IDPDTO{
+		ID:         "idp-1",
+		Name:       testIdpName,
+		Type:       IDPTypeOIDC,
+		Properties: []cmodels.Property{*clientID, *clientSecret},
+	}, nil)
+
+	result, err := s.resources.readIdentityProvider(context.Background(),
+		s.newReadResourceRequest(testIDPResourceURI))
+
+	s.Require().NoError(err)
+	s.Require().Len(result.Contents, 1)
+
+	var idpResp idpResponse
+	s.Require().NoError(json.Unmarshal([]byte(result.Contents[0].Text), &idpResp))
+	s.Equal("idp-1", idpResp.ID)
+	s.Require().Len(idpResp.Properties, 2)
+	s.Equal("test-client", idpResp.Properties[0].Value)
+	s.Equal("******", idpResp.Properties[1].Value)
+}
+
+func (s *IDPResourcesTestSuite) TestReadIdentityProvider_NotFound() {
+	s.mockService.On("GetIdentityProvider", mock.Anything, "idp-1").Return(nil, &ErrorIDPNotFound)
+
+	result, err := s.resources.readIdentityProvider(context.Background(),
+		s.newReadResourceRequest(testIDPResourceURI))
+
+	s.Nil(result)
+	var rpcErr *jsonrpc.Error
+	s.Require().True(errors.As(err, &rpcErr))
+	s.Equal(int64(mcp.CodeResourceNotFound), rpcErr.Code)
+}
+
+func (s *IDPResourcesTestSuite) TestNotifyingIDPService_CreateIdentityProvider() {
+	service := newNotifyingIDPService(s.mockService, s.mockNotifier)
+	idp := &IDPDTO{Name: testIdpName}
+	s.mockService.On("CreateIdentityProvider", mock.Anything, idp).Return(&IDPDTO{ID: "idp-1"}, nil)
+	s.mockNotifier.On("NotifyUpdated", mock.Anything, idpsResourceURI).Return()
+
+	createdIDP, svcErr := service.CreateIdentityProvider(context.Background(), idp)
+
+	s.Nil(svcErr)
+	s.Equal("idp-1", createdIDP.ID)
+}
+
+func (s *IDPResourcesTestSuite) TestNotifyingIDPService_UpdateIdentityProvider() {
+	service := newNotifyingIDPService(s.mockService, s.mockNotifier)
+	idp := &IDPDTO{Name: testIdpName}
+	s.mockService.On("UpdateIdentityProvider", mock.Anything, "idp-1", idp).Return(&IDPDTO{ID: "idp-1"}, nil)
+	s.mockNotifier.On("NotifyUpdated", mock.Anything, testIDPResourceURI, idpsResourceURI).Return()
+
+	_, svcErr := service.UpdateIdentityProvider(context.Background(), "idp-1", idp)
+
+	s.Nil(svcErr)
+}
+
+func (s *IDPResourcesTestSuite) TestNotifyingIDPService_DeleteIdentityProvider() {
+	service := newNotifyingIDPService(s.mockService, s.mockNotifier)
+	s.mockService.On("DeleteIdentityProvider", mock.Anything, "idp-1").Return(nil)
+	s.mockNotifier.On("NotifyUpdated", mock.Anything, testIDPResourceURI, idpsResourceURI).Return()
+
+	s.Nil(service.DeleteIdentityProvider(context.Background(), "idp-1"))
+}
+
+func (s *IDPResourcesTestSuite) TestNotifyingIDPService_NoNotificationOnError() {
+	service := newNotifyingIDPService(s.mockService, s.mockNotifier)
+	s.mockService.On("DeleteIdentityProvider", mock.Anything, "idp-1").Return(&ErrorIDPNotFound)
+
+	svcErr := service.DeleteIdentityProvider(context.Background(), "idp-1")
+
+	s.Equal(&ErrorIDPNotFound, svcErr)
+	s.mockNotifier.AssertNotCalled(s.T(), "NotifyUpdated", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -40,20 +40,26 @@ type IDPServiceInterface interface {
 	GetIdentityProviderByIssuer(ctx context.Context, issuer string) (*IDPDTO, *serviceerror.ServiceError)
 	UpdateIdentityProvider(ctx context.Context, idpID string, idp *IDPDTO) (*IDPDTO, *serviceerror.ServiceError)
 	DeleteIdentityProvider(ctx context.Context, idpID string) *serviceerror.ServiceError
+	GetIdentityProviderHealth(ctx context.Context) ([]IDPHealthStatus, *serviceerror.ServiceError)
+	IsIdentityProviderAvailable(idpID string) bool
 }
 
 // idpService is the default implementation of the IdPServiceInterface.
 type idpService struct {
 	idpStore      idpStoreInterface
 	transactioner transaction.Transactioner
+	healthMonitor idpHealthMonitorInterface
 	logger        *log.Logger
 }
 
-// newIDPService creates a new instance of IdPService.
-func newIDPService(idpStore idpStoreInterface, transactioner transaction.Transactioner) IDPServiceInterface {
+// newIDPService creates a new instance of IdPService. The health monitor is nil when the health checks are
+// disabled.
+func newIDPService(idpStore idpStoreInterface, transactioner transaction.Transactioner,
+	healthMonitor idpHealthMonitorInterface) IDPServiceInterface {
 	return &idpService{
 		idpStore:      idpStore,
 		transactioner: transactioner,
+		healthMonitor: healthMonitor,
 		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "IdPService")),
 	}
 }
@@ -293,3 +299,21 @@ func (is *idpService) DeleteIdentityProvider(ctx context.Context, idpID string)
 
 	return nil
 }
+
+// GetIdentityProviderHealth returns the health of the identity providers as seen by the last health checks.
+func (is *idpService) GetIdentityProviderHealth(ctx context.Context) ([]IDPHealthStatus,
+	*serviceerror.ServiceError) {
+	if is.healthMonitor == nil {
+		return nil, &ErrorIDPHealthCheckDisabled
+	}
+	return is.healthMonitor.getHealthStatuses(), nil
+}
+
+// IsIdentityProviderAvailable reports whether the identity provider can be used for authentication. It returns
+// false only when the health checks have opened the circuit of the identity provider.
+func (is *idpService) IsIdentityProviderAvailable(idpID string) bool {
+	if is.healthMonitor == nil {
+		return true
+	}
+	return is.healthMonitor.isAvailable(idpID)
+}
@@ -820,7 +820,7 @@ func (s *IDPServiceTestSuite) TestUpdateIdentityProvider_FailsForDeclarativeIDP(
 	fileStore.On("GetIdentityProviderByName", context.Background(), "Updated Name").
 		Return((*IDPDTO)(nil), ErrIDPNotFound)
 
-	service := newIDPService(compositeStore, &mockTransactioner{})
+	service := newIDPService(compositeStore, &mockTransactioner{}, nil)
 
 	updatedIDP := &IDPDTO{
 		Name:        "Updated Name",
@@ -871,7 +871,7 @@ func (s *IDPServiceTestSuite) TestUpdateIdentityProvider_SucceedsForMutableIDP()
 		return dto.ID == idpID && dto.Name == "Updated Name"
 	})).Return(nil)
 
-	service := newIDPService(compositeStore, &mockTransactioner{})
+	service := newIDPService(compositeStore, &mockTransactioner{}, nil)
 
 	updatedIDP := &IDPDTO{
 		Name:        "Updated Name",
@@ -913,7 +913,7 @@ func (s *IDPServiceTestSuite) TestDeleteIdentityProvider_FailsForDeclarativeIDP(
 	dbStore.On("GetIdentityProvider", context.Background(), idpID).Return((*IDPDTO)(nil), ErrIDPNotFound)
 	fileStore.On("GetIdentityProvider", context.Background(), idpID).Return(existingIDP, nil)
 
-	service := newIDPService(compositeStore, &mockTransactioner{})
+	service := newIDPService(compositeStore, &mockTransactioner{}, nil)
 
 	err := service.DeleteIdentityProvider(context.Background(), idpID)
 
@@ -950,7 +950,7 @@ func (s *IDPServiceTestSuite) TestDeleteIdentityProvider_SucceedsForMutableIDP()
 	dbStore.On("GetIdentityProvider", context.Background(), idpID).Return(existingIDP, nil)
 	dbStore.On("DeleteIdentityProvider", context.Background(), idpID).Return(nil)
 
-	service := newIDPService(compositeStore, &mockTransactioner{})
+	service := newIDPService(compositeStore, &mockTransactioner{}, nil)
 
 	err := service.DeleteIdentityProvider(context.Background(), idpID)
 
@@ -959,3 +959,35 @@ func (s *IDPServiceTestSuite) TestDeleteIdentityProvider_SucceedsForMutableIDP()
 
 	config.ResetServerRuntime()
 }
+
+func (s *IDPServiceTestSuite) TestGetIdentityProviderHealth_Disabled() {
+	statuses, err := s.idpService.GetIdentityProviderHealth(context.Background())
+
+	s.Nil(statuses)
+	s.NotNil(err)
+	s.Equal(ErrorIDPHealthCheckDisabled.Code, err.Code)
+}
+
+func (s *IDPServiceTestSuite) TestGetIdentityProviderHealth_Success() {
+	monitor := newIdpHealthMonitorInterfaceMock(s.T())
+	monitor.EXPECT().getHealthStatuses().Return([]IDPHealthStatus{
+		{ID: "idp-1", Name: "IDP 1", Status: IDPHealthStateUp},
+	})
+	s.idpService.healthMonitor = monitor
+
+	statuses, err := s.idpService.GetIdentityProviderHealth(context.Background())
+
+	s.Nil(err)
+	s.Require().Len(statuses, 1)
+	s.Equal("idp-1", statuses[0].ID)
+}
+
+func (s *IDPServiceTestSuite) TestIsIdentityProviderAvailable() {
+	s.True(s.idpService.IsIdentityProviderAvailable("idp-1"), "IDPs are available when health checks are disabled")
+
+	monitor := newIdpHealthMonitorInterfaceMock(s.T())
+	monitor.EXPECT().isAvailable("idp-1").Return(false)
+	s.idpService.healthMonitor = monitor
+
+	s.False(s.idpService.IsIdentityProviderAvailable("idp-1"))
+}
@@ -71,6 +71,22 @@ var (
 	ErrOAuthInvalidRedirectURI = errors.New("invalid redirect URI")
 	// ErrOAuthRedirectURIFragmentNotAllowed is returned when a redirect URI contains a fragment.
 	ErrOAuthRedirectURIFragmentNotAllowed = errors.New("redirect URI must not contain a fragment")
+	// ErrOAuthRedirectURIWildcardNotAllowed is returned when a redirect URI contains a wildcard that
+	// neither the deployment nor the application's redirect URI policy allows.
+	ErrOAuthRedirectURIWildcardNotAllowed = errors.New("wildcard redirect URIs are not allowed")
+	// ErrOAuthRedirectURISchemeNotAllowed is returned when a redirect URI uses a scheme that is not allowed.
+	ErrOAuthRedirectURISchemeNotAllowed = errors.New("redirect URI scheme is not allowed")
+	// ErrOAuthInvalidRedirectURIPolicy is returned when the redirect URI policy lists an invalid custom scheme.
+	ErrOAuthInvalidRedirectURIPolicy = errors.New("invalid redirect URI policy")
+	// ErrOAuthInvalidPostLogoutRedirectURI is returned when a post-logout redirect URI is not an absolute URI
+	// without a fragment or a wildcard.
+	ErrOAuthInvalidPostLogoutRedirectURI = errors.New("invalid post-logout redirect URI")
+	// ErrOAuthInvalidBackchannelLogoutURI is returned when the back-channel logout URI is not an absolute https
+	// URI without a fragment.
+	ErrOAuthInvalidBackchannelLogoutURI = errors.New("invalid back-channel logout URI")
+	// ErrOAuthInvalidFrontchannelLogoutURI is returned when the front-channel logout URI is not an absolute
+	// https URI or has a fragment.
+	ErrOAuthInvalidFrontchannelLogoutURI = errors.New("invalid front-channel logout URI")
 	// ErrOAuthAuthCodeRequiresRedirectURIs is returned when authorization_code grant has no redirect URIs.
 	ErrOAuthAuthCodeRequiresRedirectURIs = errors.New("authorization_code grant requires redirect URIs")
 	// ErrOAuthInvalidGrantType is returned when an unsupported grant type is specified.
@@ -83,10 +99,36 @@ var (
 	ErrOAuthAuthCodeRequiresCodeResponseType = errors.New("authorization_code grant requires code response type")
 	// ErrOAuthRefreshTokenCannotBeSoleGrant is returned when refresh_token is the only grant type.
 	ErrOAuthRefreshTokenCannotBeSoleGrant = errors.New("refresh_token cannot be the sole grant type")
+	// ErrOAuthRefreshTokenRequiresIssuingGrant is returned when refresh_token is configured without a grant type
+	// that issues refresh tokens.
+	ErrOAuthRefreshTokenRequiresIssuingGrant = errors.New(
+		"refresh_token grant requires a grant type that issues refresh tokens")
 	// ErrOAuthPKCERequiresAuthCode is returned when PKCE is enabled without authorization_code grant.
 	ErrOAuthPKCERequiresAuthCode = errors.New("PKCE requires authorization_code grant type")
 	// ErrOAuthResponseTypesRequireAuthCode is returned when response types are set without authorization_code grant.
 	ErrOAuthResponseTypesRequireAuthCode = errors.New("response types require authorization_code grant type")
+	// ErrOAuthInvalidBackchannelTokenDeliveryMode is returned when an unsupported CIBA token delivery mode
+	// is specified.
+	ErrOAuthInvalidBackchannelTokenDeliveryMode = errors.New("invalid backchannel token delivery mode")
+	// ErrOAuthPingModeRequiresNotificationEndpoint is returned when the CIBA ping mode has no client
+	// notification endpoint.
+	ErrOAuthPingModeRequiresNotificationEndpoint = errors.New(
+		"ping token delivery mode requires a client notification endpoint")
+	// ErrOAuthInvalidClientNotificationEndpoint is returned when the CIBA client notification endpoint is
+	// not an absolute https URL.
+	ErrOAuthInvalidClientNotificationEndpoint = errors.New("invalid client notification endpoint")
+	// ErrOAuthInvalidClaimMapping is returned when a token claim mapping is empty, targets a reserved claim,
+	// or maps two attributes to the same claim.
+	ErrOAuthInvalidClaimMapping = errors.New("invalid token claim mapping")
+	// ErrOAuthInvalidTokenValidityPeriod is returned when a token validity period is negative.
+	ErrOAuthInvalidTokenValidityPeriod = errors.New("invalid token validity period")
+	// ErrOAuthSlidingRefreshTokenRequiresMaxLifetime is returned when sliding refresh token expiration is
+	// enabled without an absolute maximum lifetime.
+	ErrOAuthSlidingRefreshTokenRequiresMaxLifetime = errors.New(
+		"sliding refresh token expiration requires a maximum lifetime")
+	// ErrOAuthInvalidAcrFlow is returned when an ACR-to-flow mapping targets an ACR value that is not
+	// allowed for the client or has no flow ID.
+	ErrOAuthInvalidAcrFlow = errors.New("invalid ACR flow mapping")
 	// ErrOAuthInvalidTokenEndpointAuthMethod is returned when an unsupported auth method is specified.
 	ErrOAuthInvalidTokenEndpointAuthMethod = errors.New("invalid token endpoint auth method")
 	// ErrOAuthPrivateKeyJWTRequiresCertificate is returned when private_key_jwt is used without a certificate.
@@ -38,23 +38,25 @@ type InboundClient struct {
 	Assertion                 *AssertionConfig
 	LoginConsent              *LoginConsentConfig
 	AllowedUserTypes          []string
+	LoginExperience           *LoginExperienceConfig
 	Properties                map[string]interface{}
 	IsReadOnly                bool
 }
 
 // InboundAuthProfile is the wire field block embedded in entity DTOs (requests and responses).
 type InboundAuthProfile struct {
-	AuthFlowID                string              `json:"authFlowId,omitempty"           yaml:"auth_flow_id,omitempty"           jsonschema:"Authentication flow ID. Optional. Specifies which login flow to use (e.g., MFA, passwordless). If omitted, the default authentication flow is used."`
-	RegistrationFlowID        string              `json:"registrationFlowId,omitempty"   yaml:"registration_flow_id,omitempty"   jsonschema:"Registration flow ID. Optional. Specifies the user registration/signup flow."`
-	IsRegistrationFlowEnabled bool                `json:"isRegistrationFlowEnabled"      yaml:"is_registration_flow_enabled"     jsonschema:"Enable self-service registration. Set to true to allow users to sign up themselves. Requires registrationFlowId to be set."`
-	RecoveryFlowID            string              `json:"recoveryFlowId,omitempty"        yaml:"recovery_flow_id,omitempty"        jsonschema:"Recovery flow ID. Optional. Specifies the user recovery flow."`
-	IsRecoveryFlowEnabled     bool                `json:"isRecoveryFlowEnabled"          yaml:"is_recovery_flow_enabled"       jsonschema:"Enable self-service recovery. Set to true to allow users to recover their accounts (e.g., password reset). Requires recoveryFlowId to be set."`
-	ThemeID                   string              `json:"themeId,omitempty"              yaml:"theme_id,omitempty"               jsonschema:"Theme configuration ID. Optional. Customizes the visual styling of login pages."`
-	LayoutID                  string              `json:"layoutId,omitempty"             yaml:"layout_id,omitempty"              jsonschema:"Layout configuration ID. Optional. Customizes the screen structure and component positioning of login pages."`
-	Assertion                 *AssertionConfig    `json:"assertion,omitempty"            yaml:"assertion,omitempty"              jsonschema:"Assertion configuration. Optional. Customize assertion validity periods and included user attributes."`
-	LoginConsent              *LoginConsentConfig `json:"loginConsent,omitempty"         yaml:"login_consent,omitempty"          jsonschema:"Login consent configuration settings."`
-	AllowedUserTypes          []string            `json:"allowedUserTypes,omitempty"     yaml:"allowed_user_types,omitempty"     jsonschema:"Allowed user types. Optional. Restricts which user types can authenticate to and register against this resource."`
-	Certificate               *Certificate        `json:"certificate,omitempty"          yaml:"certificate,omitempty"            jsonschema:"Resource-level certificate. Optional. For certificate-based authentication or JWT validation."`
+	AuthFlowID                string                 `json:"authFlowId,omitempty"           yaml:"auth_flow_id,omitempty"           jsonschema:"Authentication flow ID. Optional. Specifies which login flow to use (e.g., MFA, passwordless). If omitted, the default authentication flow is used."`
+	RegistrationFlowID        string                 `json:"registrationFlowId,omitempty"   yaml:"registration_flow_id,omitempty"   jsonschema:"Registration flow ID. Optional. Specifies the user registration/signup flow."`
+	IsRegistrationFlowEnabled bool                   `json:"isRegistrationFlowEnabled"      yaml:"is_registration_flow_enabled"     jsonschema:"Enable self-service registration. Set to true to allow users to sign up themselves. Requires registrationFlowId to be set."`
+	RecoveryFlowID            string                 `json:"recoveryFlowId,omitempty"        yaml:"recovery_flow_id,omitempty"        jsonschema:"Recovery flow ID. Optional. Specifies the user recovery flow."`
+	IsRecoveryFlowEnabled     bool                   `json:"isRecoveryFlowEnabled"          yaml:"is_recovery_flow_enabled"       jsonschema:"Enable self-service recovery. Set to true to allow users to recover their accounts (e.g., password reset). Requires recoveryFlowId to be set."`
+	ThemeID                   string                 `json:"themeId,omitempty"              yaml:"theme_id,omitempty"               jsonschema:"Theme configuration ID. Optional. Customizes the visual styling of login pages."`
+	LayoutID                  string                 `json:"layoutId,omitempty"             yaml:"layout_id,omitempty"              jsonschema:"Layout configuration ID. Optional. Customizes the screen structure and component positioning of login pages."`
+	Assertion                 *AssertionConfig       `json:"assertion,omitempty"            yaml:"assertion,omitempty"              jsonschema:"Assertion configuration. Optional. Customize assertion validity periods and included user attributes."`
+	LoginConsent              *LoginConsentConfig    `json:"loginConsent,omitempty"         yaml:"login_consent,omitempty"          jsonschema:"Login consent configuration settings."`
+	AllowedUserTypes          []string               `json:"allowedUserTypes,omitempty"     yaml:"allowed_user_types,omitempty"     jsonschema:"Allowed user types. Optional. Restricts which user types can authenticate to and register against this resource."`
+	LoginExperience           *LoginExperienceConfig `json:"loginExperience,omitempty"      yaml:"login_experience,omitempty"       jsonschema:"Login experience configuration. Optional. Restricts the identity providers offered on the login page and selects where users are sent after signing in."`
+	Certificate               *Certificate           `json:"certificate,omitempty"          yaml:"certificate,omitempty"            jsonschema:"Resource-level certificate. Optional. For certificate-based authentication or JWT validation."`
 }
 
 // AssertionConfig is the entity-level assertion config; token configs fall back to it.
@@ -68,6 +70,26 @@ type LoginConsentConfig struct {
 	ValidityPeriod int64 `json:"validityPeriod" yaml:"validity_period" jsonschema:"Consent validity period in seconds. 0 means never expire."`
 }
 
+// LoginExperienceConfig is the per-entity login experience configuration applied by the flow engine.
+type LoginExperienceConfig struct {
+	AllowedIDPs        []string                `json:"allowedIdps,omitempty"        yaml:"allowed_idps,omitempty"         jsonschema:"IDs of the identity providers that may be used to sign in. Optional. Only these are offered as login options and federated logins through other identity providers are rejected. All identity providers in the login flow are allowed when omitted."`
+	PostLoginRedirects []PostLoginRedirectRule `json:"postLoginRedirects,omitempty" yaml:"post_login_redirects,omitempty" jsonschema:"Post-login redirect rules. Optional. Evaluated in order; the first rule matching the authenticated user is applied."`
+	RememberMe         *RememberMeConfig       `json:"rememberMe,omitempty"         yaml:"remember_me,omitempty"          jsonschema:"Keep me signed in configuration. Optional. Overrides the server and organization unit settings for persistent sessions."`
+}
+
+// RememberMeConfig controls whether users may keep a persistent session after signing in to an entity.
+type RememberMeConfig struct {
+	Enabled  bool  `json:"enabled"            yaml:"enabled"            jsonschema:"Allow users to choose to stay signed in."`
+	Lifetime int64 `json:"lifetime,omitempty" yaml:"lifetime,omitempty" jsonschema:"Lifetime of persistent sessions in seconds. Optional. Defaults to the server setting."`
+}
+
+// PostLoginRedirectRule selects the post-login redirect URI for users matching its conditions.
+type PostLoginRedirectRule struct {
+	UserType    string `json:"userType,omitempty" yaml:"user_type,omitempty" jsonschema:"User type to match. Optional. Matches any user type when omitted."`
+	OUID        string `json:"ouId,omitempty"     yaml:"ou_id,omitempty"     jsonschema:"Organization unit ID to match. Optional. Matches any organization unit when omitted."`
+	RedirectURI string `json:"redirectUri"        yaml:"redirect_uri"        jsonschema:"Absolute URI to redirect the user to after a successful login."`
+}
+
 // Certificate is a user-supplied certificate input.
 type Certificate struct {
 	Type  cert.CertificateType `json:"type,omitempty"  yaml:"type,omitempty"  jsonschema:"Certificate type (PEM, JWK, etc.)."`
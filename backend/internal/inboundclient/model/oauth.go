@@ -22,7 +22,9 @@
 package model
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"slices"
 	"strings"
@@ -43,16 +45,25 @@ const (
 	OAuthInboundAuthType InboundAuthType = "oauth2"
 )
 
-// OAuthTokenConfig wraps access and ID token configs.
+// OAuthTokenConfig wraps access, refresh and ID token configs.
 type OAuthTokenConfig struct {
-	AccessToken *AccessTokenConfig `json:"accessToken,omitempty" yaml:"access_token,omitempty" jsonschema:"Access token configuration."`
-	IDToken     *IDTokenConfig     `json:"idToken,omitempty"    yaml:"id_token,omitempty"     jsonschema:"ID token configuration."`
+	AccessToken  *AccessTokenConfig  `json:"accessToken,omitempty"  yaml:"access_token,omitempty"  jsonschema:"Access token configuration."`
+	RefreshToken *RefreshTokenConfig `json:"refreshToken,omitempty" yaml:"refresh_token,omitempty" jsonschema:"Refresh token configuration."`
+	IDToken      *IDTokenConfig      `json:"idToken,omitempty"      yaml:"id_token,omitempty"      jsonschema:"ID token configuration."`
 }
 
 // AccessTokenConfig is the access token configuration.
 type AccessTokenConfig struct {
-	ValidityPeriod int64    `json:"validityPeriod,omitempty" yaml:"validity_period,omitempty" jsonschema:"Access token validity period in seconds."`
-	UserAttributes []string `json:"userAttributes,omitempty" yaml:"user_attributes,omitempty" jsonschema:"User attributes to embed in the access token."`
+	ValidityPeriod int64             `json:"validityPeriod,omitempty" yaml:"validity_period,omitempty" jsonschema:"Access token validity period in seconds."`
+	UserAttributes []string          `json:"userAttributes,omitempty" yaml:"user_attributes,omitempty" jsonschema:"User attributes to embed in the access token."`
+	ClaimMappings  map[string]string `json:"claimMappings,omitempty"  yaml:"claim_mappings,omitempty"  jsonschema:"Maps user attribute names to the claim names used in the access token. Unmapped attributes keep their names."`
+}
+
+// RefreshTokenConfig is the refresh token configuration.
+type RefreshTokenConfig struct {
+	ValidityPeriod    int64 `json:"validityPeriod,omitempty"    yaml:"validity_period,omitempty"    jsonschema:"Refresh token validity period in seconds. Defaults to the deployment-wide refresh token validity period."`
+	SlidingExpiration bool  `json:"slidingExpiration,omitempty" yaml:"sliding_expiration,omitempty" jsonschema:"Renew the refresh token on every use so that the validity period acts as an idle timeout. Requires maxLifetime."`
+	MaxLifetime       int64 `json:"maxLifetime,omitempty"       yaml:"max_lifetime,omitempty"       jsonschema:"Absolute lifetime in seconds of a sliding refresh token session, measured from the original grant."`
 }
 
 // IDTokenConfig is the ID token configuration.
@@ -62,6 +73,7 @@ type IDTokenConfig struct {
 	ResponseType   IDTokenResponseType `json:"responseType,omitempty"   yaml:"response_type,omitempty"   jsonschema:"ID token response type (JWT, JWE, NESTED_JWT). Defaults to JWT."`
 	EncryptionAlg  string              `json:"encryptionAlg,omitempty"  yaml:"encryption_alg,omitempty"  jsonschema:"JWE key-management algorithm. Required when responseType is JWE or NESTED_JWT."`
 	EncryptionEnc  string              `json:"encryptionEnc,omitempty"  yaml:"encryption_enc,omitempty"  jsonschema:"JWE content-encryption algorithm. Required when responseType is JWE or NESTED_JWT."`
+	ClaimMappings  map[string]string   `json:"claimMappings,omitempty"  yaml:"claim_mappings,omitempty"  jsonschema:"Maps user attribute names to the claim names used in the ID token. Unmapped attributes keep their names."`
 }
 
 // IDTokenResponseType is the response format of the ID token.
@@ -107,21 +119,84 @@ var (
 	SupportedUserInfoEncryptionEncs = []string{string(jwe.A128CBCHS256), string(jwe.A256GCM)}
 )
 
+// BackchannelAuthConfig holds the client-initiated backchannel authentication (CIBA) settings of a client.
+type BackchannelAuthConfig struct {
+	TokenDeliveryMode          oauth2const.BackchannelTokenDeliveryMode `json:"tokenDeliveryMode,omitempty"          yaml:"token_delivery_mode,omitempty"          jsonschema:"CIBA token delivery mode (poll or ping). Defaults to poll."`
+	ClientNotificationEndpoint string                                   `json:"clientNotificationEndpoint,omitempty" yaml:"client_notification_endpoint,omitempty" jsonschema:"Endpoint notified when the end user completes authentication. Required for the ping mode."`
+}
+
+// GetTokenDeliveryMode returns the configured token delivery mode, defaulting to poll.
+func (c *BackchannelAuthConfig) GetTokenDeliveryMode() oauth2const.BackchannelTokenDeliveryMode {
+	if c == nil || c.TokenDeliveryMode == "" {
+		return oauth2const.BackchannelTokenDeliveryModePoll
+	}
+	return c.TokenDeliveryMode
+}
+
+// RedirectURIPolicy holds the per-client rules for registering and matching redirect URIs.
+// The zero value requires an exact match against a registered redirect URI.
+type RedirectURIPolicy struct {
+	AllowWildcardSubdomains bool     `json:"allowWildcardSubdomains,omitempty" yaml:"allow_wildcard_subdomains,omitempty" jsonschema:"Allow * inside host labels of registered redirect URIs (e.g. https://pr-*.example.com/callback). Path wildcards still require the deployment-wide setting."`
+	AllowLoopbackAnyPort    bool     `json:"allowLoopbackAnyPort,omitempty"    yaml:"allow_loopback_any_port,omitempty"    jsonschema:"Match registered http loopback redirect URIs (127.0.0.1, [::1], localhost) on any port, as native apps require (RFC 8252)."`
+	AllowedCustomSchemes    []string `json:"allowedCustomSchemes,omitempty"    yaml:"allowed_custom_schemes,omitempty"    jsonschema:"Private-use URI schemes allowed in redirect URIs, in reverse domain name form (e.g. com.example.app). When set, other non-HTTP schemes are rejected."`
+}
+
+// AllowsCustomScheme reports whether the policy permits redirect URIs with the given private-use scheme.
+// Any scheme is permitted unless the policy restricts custom schemes.
+func (p *RedirectURIPolicy) AllowsCustomScheme(scheme string) bool {
+	if p == nil || len(p.AllowedCustomSchemes) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(p.AllowedCustomSchemes, func(allowed string) bool {
+		return strings.EqualFold(allowed, scheme)
+	})
+}
+
+// allowsHostWildcard reports whether the policy permits matching the pattern as a host wildcard pattern.
+// Only patterns whose wildcards are confined to the host qualify.
+func (p *RedirectURIPolicy) allowsHostWildcard(pattern string) bool {
+	if p == nil || !p.AllowWildcardSubdomains {
+		return false
+	}
+	parsed, err := url.Parse(pattern)
+	if err != nil {
+		return false
+	}
+	return strings.ContainsRune(parsed.Host, '*') && !strings.ContainsRune(parsed.Path, '*')
+}
+
+// Redirect URI policy violations reported at authorization time.
+var (
+	// ErrRedirectURISchemeNotAllowed is returned when the redirect URI uses a custom scheme the client's
+	// policy does not allow.
+	ErrRedirectURISchemeNotAllowed = errors.New("redirect URI scheme is not allowed for the application")
+	// ErrRedirectURILoopbackPortMismatch is returned when a loopback redirect URI differs from a registered
+	// one only by port and the client's policy does not allow any loopback port.
+	ErrRedirectURILoopbackPortMismatch = errors.New(
+		"redirect URI port does not match the registered loopback redirect URI")
+)
+
 // OAuthProfile is the persistence shape (OAUTH_PROFILE JSONB column).
 type OAuthProfile struct {
-	RedirectURIs                       []string            `json:"redirectUris"`
-	GrantTypes                         []string            `json:"grantTypes"`
-	ResponseTypes                      []string            `json:"responseTypes"`
-	TokenEndpointAuthMethod            string              `json:"tokenEndpointAuthMethod"`
-	PKCERequired                       bool                `json:"pkceRequired"`
-	PublicClient                       bool                `json:"publicClient"`
-	RequirePushedAuthorizationRequests bool                `json:"requirePushedAuthorizationRequests"`
-	Token                              *OAuthTokenConfig   `json:"token,omitempty"`
-	Scopes                             []string            `json:"scopes,omitempty"`
-	UserInfo                           *UserInfoConfig     `json:"userInfo,omitempty"`
-	ScopeClaims                        map[string][]string `json:"scopeClaims,omitempty"`
-	Certificate                        *Certificate        `json:"certificate,omitempty"`
-	AcrValues                          []string            `json:"acrValues,omitempty"`
+	RedirectURIs                       []string               `json:"redirectUris"`
+	RedirectURIPolicy                  *RedirectURIPolicy     `json:"redirectUriPolicy,omitempty"`
+	GrantTypes                         []string               `json:"grantTypes"`
+	ResponseTypes                      []string               `json:"responseTypes"`
+	TokenEndpointAuthMethod            string                 `json:"tokenEndpointAuthMethod"`
+	PKCERequired                       bool                   `json:"pkceRequired"`
+	PublicClient                       bool                   `json:"publicClient"`
+	RequirePushedAuthorizationRequests bool                   `json:"requirePushedAuthorizationRequests"`
+	Token                              *OAuthTokenConfig      `json:"token,omitempty"`
+	Scopes                             []string               `json:"scopes,omitempty"`
+	UserInfo                           *UserInfoConfig        `json:"userInfo,omitempty"`
+	ScopeClaims                        map[string][]string    `json:"scopeClaims,omitempty"`
+	Certificate                        *Certificate           `json:"certificate,omitempty"`
+	AcrValues                          []string               `json:"acrValues,omitempty"`
+	AcrFlows                           map[string]string      `json:"acrFlows,omitempty"`
+	BackchannelAuthentication          *BackchannelAuthConfig `json:"backchannelAuthentication,omitempty"`
+	PostLogoutRedirectURIs             []string               `json:"postLogoutRedirectUris,omitempty"`
+	BackchannelLogoutURI               string                 `json:"backchannelLogoutUri,omitempty"`
+	FrontchannelLogoutURI              string                 `json:"frontchannelLogoutUri,omitempty"`
 }
 
 // OAuthConfigWithSecret is the wire input shape and the create/update echo response shape.
@@ -130,6 +205,7 @@ type OAuthConfigWithSecret struct {
 	ClientID                           string                              `json:"clientId,omitempty"                          yaml:"client_id,omitempty"                          jsonschema:"OAuth client ID (auto-generated if not provided)"`
 	ClientSecret                       string                              `json:"clientSecret,omitempty"                      yaml:"client_secret,omitempty"                      jsonschema:"OAuth client secret (auto-generated if not provided)"`
 	RedirectURIs                       []string                            `json:"redirectUris,omitempty"                      yaml:"redirect_uris,omitempty"                      jsonschema:"Allowed redirect URIs. Required for Public (SPA/Mobile) and Confidential (Server) clients. Omit for M2M."`
+	RedirectURIPolicy                  *RedirectURIPolicy                  `json:"redirectUriPolicy,omitempty"                 yaml:"redirect_uri_policy,omitempty"                jsonschema:"Redirect URI policy. Optional. Omit for exact matching of registered redirect URIs."`
 	GrantTypes                         []oauth2const.GrantType             `json:"grantTypes,omitempty"                        yaml:"grant_types,omitempty"                        jsonschema:"OAuth grant types. Common: [authorization_code, refresh_token] for user apps, [client_credentials] for M2M."`
 	ResponseTypes                      []oauth2const.ResponseType          `json:"responseTypes,omitempty"                     yaml:"response_types,omitempty"                     jsonschema:"OAuth response types. Common: [code] for user apps. Omit for M2M."`
 	TokenEndpointAuthMethod            oauth2const.TokenEndpointAuthMethod `json:"tokenEndpointAuthMethod,omitempty"           yaml:"token_endpoint_auth_method,omitempty"         jsonschema:"Client authentication method. Use 'none' for Public clients, 'client_secret_basic' for Confidential/M2M."`
@@ -142,6 +218,11 @@ type OAuthConfigWithSecret struct {
 	ScopeClaims                        map[string][]string                 `json:"scopeClaims,omitempty"                       yaml:"scope_claims,omitempty"                       jsonschema:"Scope-to-claims mapping. Maps OAuth scopes to user claims for both ID token and userinfo."`
 	Certificate                        *Certificate                        `json:"certificate,omitempty"                       yaml:"certificate,omitempty"                        jsonschema:"Application certificate. Optional. For certificate-based authentication or JWT validation."`
 	AcrValues                          []string                            `json:"acrValues,omitempty"                         yaml:"acr_values,omitempty"                         jsonschema:"Default ACR values applied when the request does not specify acr_values."`
+	AcrFlows                           map[string]string                   `json:"acrFlows,omitempty"                          yaml:"acr_flows,omitempty"                          jsonschema:"ACR-to-flow mapping. Maps an ACR value to the authentication flow run when that ACR is requested."`
+	BackchannelAuthentication          *BackchannelAuthConfig              `json:"backchannelAuthentication,omitempty"         yaml:"backchannel_authentication,omitempty"         jsonschema:"Client-initiated backchannel authentication (CIBA) settings. Used with the urn:openid:params:grant-type:ciba grant type."`
+	PostLogoutRedirectURIs             []string                            `json:"postLogoutRedirectUris,omitempty"            yaml:"post_logout_redirect_uris,omitempty"          jsonschema:"URIs the user agent may be redirected to after RP-initiated logout. Matched exactly against post_logout_redirect_uri."`
+	BackchannelLogoutURI               string                              `json:"backchannelLogoutUri,omitempty"              yaml:"backchannel_logout_uri,omitempty"             jsonschema:"HTTPS URI that receives a logout token when a session the client was issued tokens in is terminated."`
+	FrontchannelLogoutURI              string                              `json:"frontchannelLogoutUri,omitempty"              yaml:"frontchannel_logout_uri,omitempty"             jsonschema:"HTTPS URI rendered in an iframe to sign the user out of the client during RP-initiated logout."`
 }
 
 // OAuthConfig is the wire output shape (GET responses). ClientSecret is structurally absent.
@@ -149,6 +230,7 @@ type OAuthConfigWithSecret struct {
 type OAuthConfig struct {
 	ClientID                           string                              `json:"clientId,omitempty"`
 	RedirectURIs                       []string                            `json:"redirectUris,omitempty"`
+	RedirectURIPolicy                  *RedirectURIPolicy                  `json:"redirectUriPolicy,omitempty"`
 	GrantTypes                         []oauth2const.GrantType             `json:"grantTypes,omitempty"`
 	ResponseTypes                      []oauth2const.ResponseType          `json:"responseTypes,omitempty"`
 	TokenEndpointAuthMethod            oauth2const.TokenEndpointAuthMethod `json:"tokenEndpointAuthMethod,omitempty"`
@@ -161,6 +243,11 @@ type OAuthConfig struct {
 	ScopeClaims                        map[string][]string                 `json:"scopeClaims,omitempty"`
 	Certificate                        *Certificate                        `json:"certificate,omitempty"`
 	AcrValues                          []string                            `json:"acrValues,omitempty"`
+	AcrFlows                           map[string]string                   `json:"acrFlows,omitempty"`
+	BackchannelAuthentication          *BackchannelAuthConfig              `json:"backchannelAuthentication,omitempty"`
+	PostLogoutRedirectURIs             []string                            `json:"postLogoutRedirectUris,omitempty"`
+	BackchannelLogoutURI               string                              `json:"backchannelLogoutUri,omitempty"`
+	FrontchannelLogoutURI              string                              `json:"frontchannelLogoutUri,omitempty"`
 }
 
 // SupportedIDTokenEncryptionAlgs lists JWE key-management algorithms supported for ID token encryption.
@@ -175,6 +262,7 @@ type OAuthClient struct {
 	OUID                               string                              `yaml:"ou_id,omitempty"`
 	ClientID                           string                              `yaml:"client_id,omitempty"`
 	RedirectURIs                       []string                            `yaml:"redirect_uris,omitempty"`
+	RedirectURIPolicy                  *RedirectURIPolicy                  `yaml:"redirect_uri_policy,omitempty"`
 	GrantTypes                         []oauth2const.GrantType             `yaml:"grant_types,omitempty"`
 	ResponseTypes                      []oauth2const.ResponseType          `yaml:"response_types,omitempty"`
 	TokenEndpointAuthMethod            oauth2const.TokenEndpointAuthMethod `yaml:"token_endpoint_auth_method,omitempty"`
@@ -187,6 +275,11 @@ type OAuthClient struct {
 	ScopeClaims                        map[string][]string                 `yaml:"scope_claims,omitempty"`
 	Certificate                        *Certificate                        `yaml:"certificate,omitempty"`
 	AcrValues                          []string                            `yaml:"acr_values,omitempty"`
+	AcrFlows                           map[string]string                   `yaml:"acr_flows,omitempty"`
+	BackchannelAuthentication          *BackchannelAuthConfig              `yaml:"backchannel_authentication,omitempty"`
+	PostLogoutRedirectURIs             []string                            `yaml:"post_logout_redirect_uris,omitempty"`
+	BackchannelLogoutURI               string                              `yaml:"backchannel_logout_uri,omitempty"`
+	FrontchannelLogoutURI              string                              `yaml:"frontchannel_logout_uri,omitempty"`
 }
 
 // IsAllowedGrantType reports whether the given grant type is allowed for this client.
@@ -204,9 +297,16 @@ func (o *OAuthClient) IsAllowedTokenEndpointAuthMethod(method oauth2const.TokenE
 	return o.TokenEndpointAuthMethod == method
 }
 
-// ValidateRedirectURI validates the given redirect URI against this client's registered URIs.
+// ValidateRedirectURI validates the given redirect URI against this client's registered URIs and
+// redirect URI policy.
 func (o *OAuthClient) ValidateRedirectURI(redirectURI string) error {
-	return ValidateRedirectURI(o.RedirectURIs, redirectURI)
+	return ValidateRedirectURI(o.RedirectURIs, o.RedirectURIPolicy, redirectURI)
+}
+
+// IsValidPostLogoutRedirectURI reports whether the given URI exactly matches one of this client's registered
+// post-logout redirect URIs.
+func (o *OAuthClient) IsValidPostLogoutRedirectURI(uri string) bool {
+	return uri != "" && slices.Contains(o.PostLogoutRedirectURIs, uri)
 }
 
 // RequiresPKCE reports whether PKCE is required for this client.
@@ -253,8 +353,9 @@ func IsAllowedResponseType(responseTypes []oauth2const.ResponseType, responseTyp
 	return slices.Contains(responseTypes, oauth2const.ResponseType(responseType))
 }
 
-// ValidateRedirectURI validates the provided redirect URI against the registered list.
-func ValidateRedirectURI(redirectURIs []string, redirectURI string) error {
+// ValidateRedirectURI validates the provided redirect URI against the registered list, applying the
+// client's redirect URI policy. A nil policy requires an exact match.
+func ValidateRedirectURI(redirectURIs []string, policy *RedirectURIPolicy, redirectURI string) error {
 	logger := log.GetLogger()
 
 	if redirectURI == "" {
@@ -266,21 +367,32 @@ func ValidateRedirectURI(redirectURIs []string, redirectURI string) error {
 			return fmt.Errorf("redirect URI is required in the authorization request")
 		}
 		parsed, err := url.Parse(redirectURIs[0])
-		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		if err != nil || parsed.Scheme == "" || (parsed.Host == "" && !IsCustomScheme(parsed.Scheme)) {
 			return fmt.Errorf("registered redirect URI is not fully qualified")
 		}
 		return nil
 	}
 
-	if !matchAnyRedirectURIPattern(redirectURIs, redirectURI) {
-		return fmt.Errorf("your application's redirect URL does not match with the registered redirect URLs")
-	}
-
 	parsedRedirectURI, err := utils.ParseURL(redirectURI)
 	if err != nil {
 		logger.Error("Failed to parse redirect URI", log.Error(err))
 		return fmt.Errorf("invalid redirect URI: %s", err.Error())
 	}
+	if IsCustomScheme(parsedRedirectURI.Scheme) && !policy.AllowsCustomScheme(parsedRedirectURI.Scheme) {
+		return ErrRedirectURISchemeNotAllowed
+	}
+
+	if !matchAnyRedirectURIPattern(redirectURIs, policy, redirectURI) {
+		if policy == nil || !policy.AllowLoopbackAnyPort {
+			for _, registered := range redirectURIs {
+				if matchLoopbackRedirectURI(registered, redirectURI) {
+					return ErrRedirectURILoopbackPortMismatch
+				}
+			}
+		}
+		return fmt.Errorf("your application's redirect URL does not match with the registered redirect URLs")
+	}
+
 	if parsedRedirectURI.Fragment != "" {
 		return fmt.Errorf("redirect URI must not contain a fragment component")
 	}
@@ -288,11 +400,23 @@ func ValidateRedirectURI(redirectURIs []string, redirectURI string) error {
 	return nil
 }
 
+// RedirectURIErrorDescription returns the error description sent to the client when redirect URI
+// validation fails. Policy violations are described; other failures stay generic.
+func RedirectURIErrorDescription(err error) string {
+	if errors.Is(err, ErrRedirectURISchemeNotAllowed) || errors.Is(err, ErrRedirectURILoopbackPortMismatch) {
+		return "Invalid redirect URI: " + err.Error()
+	}
+	return "Invalid redirect URI"
+}
+
 // matchAnyRedirectURIPattern compares incoming against each registered URI/pattern. AC-11: first match wins.
-func matchAnyRedirectURIPattern(patterns []string, redirectURI string) bool {
+func matchAnyRedirectURIPattern(patterns []string, policy *RedirectURIPolicy, redirectURI string) bool {
 	wildcardEnabled := config.GetServerRuntime().Config.OAuth.AllowWildcardRedirectURI
 	for _, pattern := range patterns {
-		if !wildcardEnabled || !strings.Contains(pattern, "*") {
+		if policy != nil && policy.AllowLoopbackAnyPort && matchLoopbackRedirectURI(pattern, redirectURI) {
+			return true
+		}
+		if !strings.Contains(pattern, "*") || !(wildcardEnabled || policy.allowsHostWildcard(pattern)) {
 			if pattern == redirectURI {
 				return true
 			}
@@ -308,3 +432,36 @@ func matchAnyRedirectURIPattern(patterns []string, redirectURI string) bool {
 	}
 	return false
 }
+
+// matchLoopbackRedirectURI reports whether redirectURI matches the registered loopback redirect URI
+// on any port. Native apps listen on an ephemeral port, so RFC 8252 Section 7.3 requires the port to
+// be ignored when matching http loopback redirect URIs.
+func matchLoopbackRedirectURI(registered, redirectURI string) bool {
+	registeredURL, err := url.Parse(registered)
+	if err != nil || registeredURL.Scheme != "http" || !IsLoopbackHost(registeredURL.Hostname()) {
+		return false
+	}
+	incomingURL, err := url.Parse(redirectURI)
+	if err != nil || incomingURL.Scheme != "http" {
+		return false
+	}
+	return registeredURL.User == nil && incomingURL.User == nil &&
+		strings.EqualFold(incomingURL.Hostname(), registeredURL.Hostname()) &&
+		incomingURL.EscapedPath() == registeredURL.EscapedPath() &&
+		incomingURL.RawQuery == registeredURL.RawQuery &&
+		incomingURL.Fragment == "" && registeredURL.Fragment == ""
+}
+
+// IsLoopbackHost reports whether host, without a port, is a loopback IP literal or localhost.
+func IsLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// IsCustomScheme reports whether scheme is a private-use URI scheme, i.e. anything other than http or https.
+func IsCustomScheme(scheme string) bool {
+	return !strings.EqualFold(scheme, "http") && !strings.EqualFold(scheme, "https")
+}
@@ -456,7 +456,7 @@ func (suite *OAuthHelperTestSuite) TestIsAllowedResponseType_NilList() {
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_ValidSingleURI() {
-	err := model.ValidateRedirectURI([]string{"https://example.com/callback"}, "https://example.com/callback")
+	err := model.ValidateRedirectURI([]string{"https://example.com/callback"}, nil, "https://example.com/callback")
 	suite.NoError(err)
 }
 
@@ -466,23 +466,23 @@ func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_ValidMultipleURIs() {
 		"https://example.com/callback2",
 	}
 
-	err := model.ValidateRedirectURI(redirectURIs, "https://example.com/callback2")
+	err := model.ValidateRedirectURI(redirectURIs, nil, "https://example.com/callback2")
 	suite.NoError(err)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_InvalidNotRegistered() {
-	err := model.ValidateRedirectURI([]string{"https://example.com/callback"}, "https://different.com/callback")
+	err := model.ValidateRedirectURI([]string{"https://example.com/callback"}, nil, "https://different.com/callback")
 	suite.EqualError(err, errRedirectURINotRegistered)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_InvalidWithFragment() {
 	uri := "https://example.com/callback#fragment"
-	err := model.ValidateRedirectURI([]string{uri}, uri)
+	err := model.ValidateRedirectURI([]string{uri}, nil, uri)
 	suite.EqualError(err, errRedirectURIFragment)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_EmptyURIWithSingleFullyQualified() {
-	err := model.ValidateRedirectURI([]string{"https://example.com/callback"}, "")
+	err := model.ValidateRedirectURI([]string{"https://example.com/callback"}, nil, "")
 	suite.NoError(err)
 }
 
@@ -492,63 +492,63 @@ func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_EmptyURIWithMultiple(
 		"https://example.com/callback2",
 	}
 
-	err := model.ValidateRedirectURI(redirectURIs, "")
+	err := model.ValidateRedirectURI(redirectURIs, nil, "")
 	suite.EqualError(err, errRedirectURIRequired)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_EmptyURIWithPartialRegistered() {
-	err := model.ValidateRedirectURI([]string{"/callback"}, "")
+	err := model.ValidateRedirectURI([]string{"/callback"}, nil, "")
 	suite.EqualError(err, errRedirectURINotFullyQualified)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_EmptyURIWithNoScheme() {
-	err := model.ValidateRedirectURI([]string{"example.com/callback"}, "")
+	err := model.ValidateRedirectURI([]string{"example.com/callback"}, nil, "")
 	suite.EqualError(err, errRedirectURINotFullyQualified)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_EmptyURIWithNoHost() {
-	err := model.ValidateRedirectURI([]string{"https:///callback"}, "")
+	err := model.ValidateRedirectURI([]string{"https:///callback"}, nil, "")
 	suite.EqualError(err, errRedirectURINotFullyQualified)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_EmptyURIList() {
-	err := model.ValidateRedirectURI([]string{}, "")
+	err := model.ValidateRedirectURI([]string{}, nil, "")
 	suite.EqualError(err, errRedirectURIRequired)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_NilList() {
-	err := model.ValidateRedirectURI(nil, "")
+	err := model.ValidateRedirectURI(nil, nil, "")
 	suite.EqualError(err, errRedirectURIRequired)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_CustomScheme() {
-	err := model.ValidateRedirectURI([]string{"myapp://callback"}, "myapp://callback")
+	err := model.ValidateRedirectURI([]string{"myapp://callback"}, nil, "myapp://callback")
 	suite.NoError(err)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_LocalhostHTTP() {
-	err := model.ValidateRedirectURI([]string{"http://localhost:3000/callback"}, "http://localhost:3000/callback")
+	err := model.ValidateRedirectURI([]string{"http://localhost:3000/callback"}, nil, "http://localhost:3000/callback")
 	suite.NoError(err)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_WithQueryParams() {
 	uri := "https://example.com/callback?foo=bar"
-	suite.NoError(model.ValidateRedirectURI([]string{uri}, uri))
+	suite.NoError(model.ValidateRedirectURI([]string{uri}, nil, uri))
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_IPAddress() {
-	err := model.ValidateRedirectURI([]string{"https://192.168.1.1/callback"}, "https://192.168.1.1/callback")
+	err := model.ValidateRedirectURI([]string{"https://192.168.1.1/callback"}, nil, "https://192.168.1.1/callback")
 	suite.NoError(err)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_Localhost127() {
-	err := model.ValidateRedirectURI([]string{"http://127.0.0.1:8080/callback"}, "http://127.0.0.1:8080/callback")
+	err := model.ValidateRedirectURI([]string{"http://127.0.0.1:8080/callback"}, nil, "http://127.0.0.1:8080/callback")
 	suite.NoError(err)
 }
 
 func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_InvalidURLFormat() {
 	uri := "http://example.com/callback\x00invalid"
-	err := model.ValidateRedirectURI([]string{uri}, uri)
+	err := model.ValidateRedirectURI([]string{uri}, nil, uri)
 	suite.Error(err)
 	assert.Contains(suite.T(), err.Error(), "invalid redirect URI")
 }
@@ -581,6 +581,7 @@ func (suite *OAuthHelperTestSuite) TestMatchAnyRedirectURIPattern_WildcardEnable
 
 	err := model.ValidateRedirectURI(
 		[]string{"https://app.example.com/*"},
+		nil,
 		"https://app.example.com/cb",
 	)
 	suite.NoError(err)
@@ -589,6 +590,7 @@ func (suite *OAuthHelperTestSuite) TestMatchAnyRedirectURIPattern_WildcardEnable
 func (suite *OAuthHelperTestSuite) TestMatchAnyRedirectURIPattern_WildcardDisabled_NoMatch() {
 	err := model.ValidateRedirectURI(
 		[]string{"https://app.example.com/*"},
+		nil,
 		"https://app.example.com/cb",
 	)
 	suite.Error(err)
@@ -602,6 +604,7 @@ func (suite *OAuthHelperTestSuite) TestMatchAnyRedirectURIPattern_HostWildcardEn
 
 	err := model.ValidateRedirectURI(
 		[]string{"https://tenant-app-*-*.gateway.example.com/cb"},
+		nil,
 		"https://tenant-app-019dfc78-f19ab4f2.gateway.example.com/cb",
 	)
 	suite.NoError(err)
@@ -616,6 +619,7 @@ func (suite *OAuthHelperTestSuite) TestMatchAnyRedirectURIPattern_HostWildcardEn
 	// Hyphen inside the dynamic part is not in [0-9a-zA-Z]+, so this must fail.
 	err := model.ValidateRedirectURI(
 		[]string{"https://app-*-prod.example.com/cb"},
+		nil,
 		"https://app-foo-bar-prod.example.com/cb",
 	)
 	suite.Error(err)
@@ -626,6 +630,7 @@ func (suite *OAuthHelperTestSuite) TestMatchAnyRedirectURIPattern_HostWildcardDi
 	// past registration with the flag off, but we still verify the matcher returns no match.
 	err := model.ValidateRedirectURI(
 		[]string{"https://app-*.example.com/cb"},
+		nil,
 		"https://app-prod.example.com/cb",
 	)
 	suite.Error(err)
@@ -639,7 +644,106 @@ func (suite *OAuthHelperTestSuite) TestMatchAnyRedirectURIPattern_HostWildcardDo
 
 	err := model.ValidateRedirectURI(
 		[]string{"https://app-*.example.com/cb"},
+		nil,
 		"https://app-foo.evil.example.com/cb",
 	)
 	suite.Error(err)
 }
+
+func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_PolicyHostWildcard_Matches() {
+	policy := &model.RedirectURIPolicy{AllowWildcardSubdomains: true}
+
+	err := model.ValidateRedirectURI(
+		[]string{"https://app-*.example.com/cb"},
+		policy,
+		"https://app-prod.example.com/cb",
+	)
+	suite.NoError(err)
+}
+
+func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_PolicyHostWildcard_PathWildcardNoMatch() {
+	policy := &model.RedirectURIPolicy{AllowWildcardSubdomains: true}
+
+	err := model.ValidateRedirectURI(
+		[]string{"https://app-*.example.com/*"},
+		policy,
+		"https://app-prod.example.com/cb",
+	)
+	suite.EqualError(err, errRedirectURINotRegistered)
+}
+
+func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_LoopbackAnyPort_Matches() {
+	policy := &model.RedirectURIPolicy{AllowLoopbackAnyPort: true}
+
+	testCases := []struct {
+		registered string
+		incoming   string
+	}{
+		{"http://127.0.0.1/callback", "http://127.0.0.1:51234/callback"},
+		{"http://127.0.0.1:8080/callback", "http://127.0.0.1:51234/callback"},
+		{"http://[::1]/callback", "http://[::1]:51234/callback"},
+		{"http://localhost/callback", "http://localhost:3000/callback"},
+	}
+	for _, tc := range testCases {
+		suite.NoError(model.ValidateRedirectURI([]string{tc.registered}, policy, tc.incoming), tc.incoming)
+	}
+}
+
+func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_LoopbackAnyPort_NoMatch() {
+	policy := &model.RedirectURIPolicy{AllowLoopbackAnyPort: true}
+
+	testCases := []struct {
+		registered string
+		incoming   string
+	}{
+		{"http://127.0.0.1/callback", "http://127.0.0.1:51234/other"},
+		{"http://127.0.0.1/callback", "http://[::1]:51234/callback"},
+		{"https://127.0.0.1/callback", "https://127.0.0.1:51234/callback"},
+		{"http://example.com/callback", "http://example.com:51234/callback"},
+		{"http://127.0.0.1/callback?a=b", "http://127.0.0.1:51234/callback"},
+	}
+	for _, tc := range testCases {
+		suite.Error(model.ValidateRedirectURI([]string{tc.registered}, policy, tc.incoming), tc.incoming)
+	}
+}
+
+func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_LoopbackPortMismatchWithoutPolicy() {
+	err := model.ValidateRedirectURI(
+		[]string{"http://127.0.0.1:8080/callback"},
+		nil,
+		"http://127.0.0.1:51234/callback",
+	)
+	suite.ErrorIs(err, model.ErrRedirectURILoopbackPortMismatch)
+}
+
+func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_CustomSchemeAllowedByPolicy() {
+	policy := &model.RedirectURIPolicy{AllowedCustomSchemes: []string{"com.example.app"}}
+
+	suite.NoError(model.ValidateRedirectURI(
+		[]string{"com.example.app:/callback"}, policy, "com.example.app:/callback"))
+	suite.NoError(model.ValidateRedirectURI([]string{"com.example.app:/callback"}, policy, ""))
+}
+
+func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_CustomSchemeNotAllowedByPolicy() {
+	policy := &model.RedirectURIPolicy{AllowedCustomSchemes: []string{"com.example.app"}}
+
+	err := model.ValidateRedirectURI([]string{"myapp://callback"}, policy, "myapp://callback")
+	suite.ErrorIs(err, model.ErrRedirectURISchemeNotAllowed)
+}
+
+func (suite *OAuthHelperTestSuite) TestRedirectURIErrorDescription() {
+	suite.Equal("Invalid redirect URI", model.RedirectURIErrorDescription(assert.AnError))
+	suite.Equal("Invalid redirect URI: redirect URI scheme is not allowed for the application",
+		model.RedirectURIErrorDescription(model.ErrRedirectURISchemeNotAllowed))
+	suite.Equal("Invalid redirect URI: redirect URI port does not match the registered loopback redirect URI",
+		model.RedirectURIErrorDescription(model.ErrRedirectURILoopbackPortMismatch))
+}
+
+func (suite *OAuthHelperTestSuite) TestIsLoopbackHost() {
+	suite.True(model.IsLoopbackHost("127.0.0.1"))
+	suite.True(model.IsLoopbackHost("127.0.0.2"))
+	suite.True(model.IsLoopbackHost("::1"))
+	suite.True(model.IsLoopbackHost("LOCALHOST"))
+	suite.False(model.IsLoopbackHost("example.com"))
+	suite.False(model.IsLoopbackHost("192.168.1.1"))
+}
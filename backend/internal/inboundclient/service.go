@@ -23,6 +23,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -46,6 +48,12 @@ import (
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
+// customSchemePattern matches a URI scheme as defined in RFC 3986 Section 3.1.
+var customSchemePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*$`)
+
+// disallowedRedirectURISchemes lists schemes that are never accepted in redirect URIs.
+var disallowedRedirectURISchemes = []string{"javascript", "data", "vbscript", "file", "blob"}
+
 // InboundClientServiceInterface is the public API of the inbound client subsystem.
 type InboundClientServiceInterface interface {
 	// CreateInboundClient validates and persists a new inbound auth profile, certificates, and OAuth config.
@@ -141,6 +149,9 @@ func (s *inboundClientService) CreateInboundClient(ctx context.Context, client *
 		if vErr := validateOAuthProfile(oauthProfile, hasClientSecret); vErr != nil {
 			return vErr
 		}
+		if vErr := s.validateAcrFlows(ctx, oauthProfile); vErr != nil {
+			return vErr
+		}
 	}
 	applyInboundDefaults(client, oauthProfile)
 	oauthClientID := s.resolveClientID(client.ID)
@@ -214,6 +225,9 @@ func (s *inboundClientService) UpdateInboundClient(ctx context.Context, client *
 		if vErr := validateOAuthProfile(oauthProfile, hasClientSecret); vErr != nil {
 			return vErr
 		}
+		if vErr := s.validateAcrFlows(ctx, oauthProfile); vErr != nil {
+			return vErr
+		}
 	}
 	applyInboundDefaults(client, oauthProfile)
 	// Capture existing OAuth client_id before the caller updates entity system attributes.
@@ -281,6 +295,9 @@ func (s *inboundClientService) Validate(ctx context.Context, client *inboundmode
 		if vErr := validateOAuthProfile(oauthProfile, hasClientSecret); vErr != nil {
 			return vErr
 		}
+		if vErr := s.validateAcrFlows(ctx, oauthProfile); vErr != nil {
+			return vErr
+		}
 	}
 	return nil
 }
@@ -422,6 +439,7 @@ func BuildOAuthClient(entityID, clientID, ouID string, p *inboundmodel.OAuthProf
 		OUID:                               ouID,
 		ClientID:                           clientID,
 		RedirectURIs:                       p.RedirectURIs,
+		RedirectURIPolicy:                  p.RedirectURIPolicy,
 		TokenEndpointAuthMethod:            oauth2const.TokenEndpointAuthMethod(p.TokenEndpointAuthMethod),
 		PKCERequired:                       p.PKCERequired,
 		PublicClient:                       p.PublicClient,
@@ -432,6 +450,11 @@ func BuildOAuthClient(entityID, clientID, ouID string, p *inboundmodel.OAuthProf
 		UserInfo:                           p.UserInfo,
 		Certificate:                        p.Certificate,
 		AcrValues:                          p.AcrValues,
+		AcrFlows:                           p.AcrFlows,
+		BackchannelAuthentication:          p.BackchannelAuthentication,
+		PostLogoutRedirectURIs:             p.PostLogoutRedirectURIs,
+		BackchannelLogoutURI:               p.BackchannelLogoutURI,
+		FrontchannelLogoutURI:              p.FrontchannelLogoutURI,
 	}
 	for _, gt := range p.GrantTypes {
 		client.GrantTypes = append(client.GrantTypes, oauth2const.GrantType(gt))
@@ -612,6 +635,15 @@ func validateOAuthProfile(p *inboundmodel.OAuthProfile, hasClientSecret bool) er
 	if err := validateRedirectURIs(p); err != nil {
 		return err
 	}
+	if err := validatePostLogoutRedirectURIs(p); err != nil {
+		return err
+	}
+	if err := validateBackchannelLogoutURI(p); err != nil {
+		return err
+	}
+	if err := validateFrontchannelLogoutURI(p); err != nil {
+		return err
+	}
 	if err := validateGrantAndResponseTypes(p); err != nil {
 		return err
 	}
@@ -629,6 +661,94 @@ func validateOAuthProfile(p *inboundmodel.OAuthProfile, hasClientSecret bool) er
 	if err := validateIDTokenConfig(p); err != nil {
 		return err
 	}
+	if err := validateBackchannelAuthConfig(p); err != nil {
+		return err
+	}
+	if err := validateTokenClaimMappings(p); err != nil {
+		return err
+	}
+	if err := validateTokenValidityPeriods(p); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateTokenValidityPeriods rejects negative access, refresh and ID token validity periods. A zero
+// validity period falls back to the deployment default.
+func validateTokenValidityPeriods(p *inboundmodel.OAuthProfile) error {
+	if p.Token == nil {
+		return nil
+	}
+	if p.Token.AccessToken != nil && p.Token.AccessToken.ValidityPeriod < 0 {
+		return ErrOAuthInvalidTokenValidityPeriod
+	}
+	if p.Token.RefreshToken != nil {
+		if p.Token.RefreshToken.ValidityPeriod < 0 || p.Token.RefreshToken.MaxLifetime < 0 {
+			return ErrOAuthInvalidTokenValidityPeriod
+		}
+		if p.Token.RefreshToken.SlidingExpiration && p.Token.RefreshToken.MaxLifetime == 0 {
+			return ErrOAuthSlidingRefreshTokenRequiresMaxLifetime
+		}
+	}
+	if p.Token.IDToken != nil && p.Token.IDToken.ValidityPeriod < 0 {
+		return ErrOAuthInvalidTokenValidityPeriod
+	}
+	return nil
+}
+
+// validateTokenClaimMappings validates the access token and ID token claim mappings.
+func validateTokenClaimMappings(p *inboundmodel.OAuthProfile) error {
+	if p.Token == nil {
+		return nil
+	}
+	if p.Token.AccessToken != nil {
+		if err := validateClaimMappings(p.Token.AccessToken.ClaimMappings); err != nil {
+			return err
+		}
+	}
+	if p.Token.IDToken != nil {
+		if err := validateClaimMappings(p.Token.IDToken.ClaimMappings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateClaimMappings ensures each mapping targets a distinct, non-reserved claim name.
+func validateClaimMappings(mappings map[string]string) error {
+	claims := make(map[string]bool, len(mappings))
+	for attr, claim := range mappings {
+		if strings.TrimSpace(attr) == "" || strings.TrimSpace(claim) == "" {
+			return ErrOAuthInvalidClaimMapping
+		}
+		if oauth2const.IsReservedTokenClaim(claim) || claims[claim] {
+			return ErrOAuthInvalidClaimMapping
+		}
+		claims[claim] = true
+	}
+	return nil
+}
+
+// validateBackchannelAuthConfig validates the client-initiated backchannel authentication settings.
+func validateBackchannelAuthConfig(p *inboundmodel.OAuthProfile) error {
+	cfg := p.BackchannelAuthentication
+	if cfg == nil {
+		return nil
+	}
+	if cfg.TokenDeliveryMode != "" && !cfg.TokenDeliveryMode.IsValid() {
+		return ErrOAuthInvalidBackchannelTokenDeliveryMode
+	}
+	if cfg.GetTokenDeliveryMode() == oauth2const.BackchannelTokenDeliveryModePing {
+		if cfg.ClientNotificationEndpoint == "" {
+			return ErrOAuthPingModeRequiresNotificationEndpoint
+		}
+	}
+	if cfg.ClientNotificationEndpoint != "" {
+		parsedURI, err := url.Parse(cfg.ClientNotificationEndpoint)
+		if err != nil || parsedURI.Scheme != "https" || parsedURI.Host == "" {
+			return ErrOAuthInvalidClientNotificationEndpoint
+		}
+	}
 	return nil
 }
 
@@ -737,8 +857,15 @@ func validateIDTokenConfig(p *inboundmodel.OAuthProfile) error {
 	return nil
 }
 
-// validateRedirectURIs validates redirect URIs and authorization_code grant requirements.
+// validateRedirectURIs validates redirect URIs against the client's redirect URI policy and the
+// authorization_code grant requirements.
 func validateRedirectURIs(p *inboundmodel.OAuthProfile) error {
+	if err := validateRedirectURIPolicy(p.RedirectURIPolicy); err != nil {
+		return err
+	}
+	wildcardEnabled := config.GetServerRuntime().Config.OAuth.AllowWildcardRedirectURI
+	hostWildcardEnabled := wildcardEnabled ||
+		(p.RedirectURIPolicy != nil && p.RedirectURIPolicy.AllowWildcardSubdomains)
 	for _, redirectURI := range p.RedirectURIs {
 		// Reject wildcards in the scheme before URL parsing — url.Parse may misinterpret them.
 		if idx := strings.Index(redirectURI, "://"); idx != -1 {
@@ -750,16 +877,22 @@ func validateRedirectURIs(p *inboundmodel.OAuthProfile) error {
 		if err != nil {
 			return ErrOAuthInvalidRedirectURI
 		}
-		if parsedURI.Scheme == "" || parsedURI.Host == "" {
+		if parsedURI.Scheme == "" {
+			return ErrOAuthInvalidRedirectURI
+		}
+		if inboundmodel.IsCustomScheme(parsedURI.Scheme) {
+			if err := validateCustomSchemeRedirectURI(parsedURI, p.RedirectURIPolicy); err != nil {
+				return err
+			}
+		} else if parsedURI.Host == "" {
 			return ErrOAuthInvalidRedirectURI
 		}
 		if parsedURI.Fragment != "" {
 			return ErrOAuthRedirectURIFragmentNotAllowed
 		}
-		wildcardEnabled := config.GetServerRuntime().Config.OAuth.AllowWildcardRedirectURI
 		if strings.ContainsRune(parsedURI.Host, '*') {
-			if !wildcardEnabled {
-				return ErrOAuthInvalidRedirectURI
+			if !hostWildcardEnabled {
+				return ErrOAuthRedirectURIWildcardNotAllowed
 			}
 			if err := validateHostWildcardPattern(parsedURI.Host); err != nil {
 				return err
@@ -772,7 +905,7 @@ func validateRedirectURIs(p *inboundmodel.OAuthProfile) error {
 			return ErrOAuthInvalidRedirectURI
 		}
 		if strings.ContainsRune(parsedURI.Path, '*') && !wildcardEnabled {
-			return ErrOAuthInvalidRedirectURI
+			return ErrOAuthRedirectURIWildcardNotAllowed
 		}
 	}
 	if slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode)) &&
@@ -782,6 +915,83 @@ func validateRedirectURIs(p *inboundmodel.OAuthProfile) error {
 	return nil
 }
 
+// validatePostLogoutRedirectURIs validates the post-logout redirect URIs. They are matched exactly at logout, so
+// each must be an absolute URI without a fragment or a wildcard.
+func validatePostLogoutRedirectURIs(p *inboundmodel.OAuthProfile) error {
+	for _, uri := range p.PostLogoutRedirectURIs {
+		if strings.ContainsRune(uri, '*') {
+			return ErrOAuthInvalidPostLogoutRedirectURI
+		}
+		parsedURI, err := sysutils.ParseURL(uri)
+		if err != nil || parsedURI.Scheme == "" || parsedURI.Fragment != "" {
+			return ErrOAuthInvalidPostLogoutRedirectURI
+		}
+		if inboundmodel.IsCustomScheme(parsedURI.Scheme) {
+			if !p.RedirectURIPolicy.AllowsCustomScheme(parsedURI.Scheme) {
+				return ErrOAuthInvalidPostLogoutRedirectURI
+			}
+		} else if parsedURI.Host == "" {
+			return ErrOAuthInvalidPostLogoutRedirectURI
+		}
+	}
+	return nil
+}
+
+// validateBackchannelLogoutURI validates the URI that receives the logout tokens of the client. It is called
+// by the server, so it must be an absolute https URI, and it must not carry a fragment.
+func validateBackchannelLogoutURI(p *inboundmodel.OAuthProfile) error {
+	if p.BackchannelLogoutURI == "" {
+		return nil
+	}
+	parsedURI, err := sysutils.ParseURL(p.BackchannelLogoutURI)
+	if err != nil || parsedURI.Scheme != "https" || parsedURI.Host == "" || parsedURI.Fragment != "" {
+		return ErrOAuthInvalidBackchannelLogoutURI
+	}
+	return nil
+}
+
+// validateFrontchannelLogoutURI validates the URI rendered in an iframe to sign the user out of the client. It
+// is loaded from an https page, so it must be an absolute https URI, and it must not carry a fragment.
+func validateFrontchannelLogoutURI(p *inboundmodel.OAuthProfile) error {
+	if p.FrontchannelLogoutURI == "" {
+		return nil
+	}
+	parsedURI, err := sysutils.ParseURL(p.FrontchannelLogoutURI)
+	if err != nil || parsedURI.Scheme != "https" || parsedURI.Host == "" || parsedURI.Fragment != "" {
+		return ErrOAuthInvalidFrontchannelLogoutURI
+	}
+	return nil
+}
+
+// validateRedirectURIPolicy validates the custom schemes listed in a redirect URI policy. Each must be a
+// syntactically valid private-use scheme in reverse domain name form, as recommended by RFC 8252.
+func validateRedirectURIPolicy(policy *inboundmodel.RedirectURIPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	for _, scheme := range policy.AllowedCustomSchemes {
+		if !customSchemePattern.MatchString(scheme) || !strings.Contains(scheme, ".") ||
+			!inboundmodel.IsCustomScheme(scheme) {
+			return ErrOAuthInvalidRedirectURIPolicy
+		}
+	}
+	return nil
+}
+
+// validateCustomSchemeRedirectURI validates a redirect URI that uses a private-use scheme. Schemes that
+// can execute or read content in the user agent are never allowed, and the policy may restrict the
+// allowed schemes further.
+func validateCustomSchemeRedirectURI(parsedURI *url.URL, policy *inboundmodel.RedirectURIPolicy) error {
+	if slices.Contains(disallowedRedirectURISchemes, strings.ToLower(parsedURI.Scheme)) ||
+		!policy.AllowsCustomScheme(parsedURI.Scheme) {
+		return ErrOAuthRedirectURISchemeNotAllowed
+	}
+	if parsedURI.Opaque != "" || (parsedURI.Host == "" && parsedURI.Path == "") {
+		return ErrOAuthInvalidRedirectURI
+	}
+	return nil
+}
+
 // validateHostWildcardPattern enforces structural rules for wildcards in the host
 // component: no * in the port portion of host:port, and no whole-label *. * matches one
 // or more alphanumeric characters at match time, enforced by the matcher itself.
@@ -841,6 +1051,11 @@ func validateGrantAndResponseTypes(p *inboundmodel.OAuthProfile) error {
 		slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeRefreshToken)) {
 		return ErrOAuthRefreshTokenCannotBeSoleGrant
 	}
+	if slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeRefreshToken)) &&
+		!slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode)) &&
+		!slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeCIBA)) {
+		return ErrOAuthRefreshTokenRequiresIssuingGrant
+	}
 	if p.PKCERequired &&
 		!slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode)) {
 		return ErrOAuthPKCERequiresAuthCode
@@ -943,6 +1158,20 @@ func (s *inboundClientService) validateAuthFlowID(ctx context.Context, flowID st
 	return nil
 }
 
+// validateAcrFlows validates that every ACR-to-flow mapping targets one of the client's ACR values and
+// resolves to an existing authentication flow.
+func (s *inboundClientService) validateAcrFlows(ctx context.Context, p *inboundmodel.OAuthProfile) error {
+	for acr, flowID := range p.AcrFlows {
+		if flowID == "" || !slices.Contains(p.AcrValues, acr) {
+			return ErrOAuthInvalidAcrFlow
+		}
+		if err := s.validateAuthFlowID(ctx, flowID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // validateRegistrationFlowID validates that the registration flow ID exists and is of the correct type.
 func (s *inboundClientService) validateRegistrationFlowID(ctx context.Context, flowID string) error {
 	if flowID == "" || s.flowMgt == nil {
@@ -1140,7 +1369,14 @@ func applyInboundDefaults(c *inboundmodel.InboundClient, oauthProfile *inboundmo
 		assertion = c.Assertion
 	}
 	accessToken, idToken := resolveOAuthTokens(oauthProfile.Token, assertion)
-	oauthProfile.Token = &inboundmodel.OAuthTokenConfig{AccessToken: accessToken, IDToken: idToken}
+	var refreshToken *inboundmodel.RefreshTokenConfig
+	if oauthProfile.Token != nil && oauthProfile.Token.RefreshToken != nil {
+		rt := *oauthProfile.Token.RefreshToken
+		refreshToken = &rt
+	}
+	oauthProfile.Token = &inboundmodel.OAuthTokenConfig{
+		AccessToken: accessToken, RefreshToken: refreshToken, IDToken: idToken,
+	}
 	oauthProfile.UserInfo = resolveUserInfo(oauthProfile.UserInfo, idToken)
 	oauthProfile.ScopeClaims = resolveScopeClaims(oauthProfile.ScopeClaims)
 }
@@ -1189,6 +1425,7 @@ func resolveOAuthTokens(in *inboundmodel.OAuthTokenConfig,
 		accessToken = &inboundmodel.AccessTokenConfig{
 			ValidityPeriod: in.AccessToken.ValidityPeriod,
 			UserAttributes: in.AccessToken.UserAttributes,
+			ClaimMappings:  in.AccessToken.ClaimMappings,
 		}
 	}
 	if accessToken != nil {
@@ -1213,6 +1450,7 @@ func resolveOAuthTokens(in *inboundmodel.OAuthTokenConfig,
 			ResponseType:   in.IDToken.ResponseType,
 			EncryptionAlg:  in.IDToken.EncryptionAlg,
 			EncryptionEnc:  in.IDToken.EncryptionEnc,
+			ClaimMappings:  in.IDToken.ClaimMappings,
 		}
 	}
 	if idToken != nil {
@@ -555,7 +555,7 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_WildcardInH
 		GrantTypes:   []string{"authorization_code"},
 	}
 	err := validateRedirectURIs(p)
-	assert.ErrorIs(suite.T(), err, ErrOAuthInvalidRedirectURI)
+	assert.ErrorIs(suite.T(), err, ErrOAuthRedirectURIWildcardNotAllowed)
 }
 
 func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_WildcardInQuery_Rejected() {
@@ -652,6 +652,244 @@ func (suite *InboundClientServiceTestSuite) TestValidateTokenEndpointAuthMethod_
 	assert.ErrorIs(suite.T(), err, ErrOAuthClientCredentialsCannotUseNoneAuth)
 }
 
+// validateBackchannelAuthConfig
+
+func (suite *InboundClientServiceTestSuite) TestValidateBackchannelAuthConfig() {
+	testCases := []struct {
+		name        string
+		cfg         *inboundmodel.BackchannelAuthConfig
+		expectedErr error
+	}{
+		{"NilConfig", nil, nil},
+		{"DefaultPollMode", &inboundmodel.BackchannelAuthConfig{}, nil},
+		{"PingMode", &inboundmodel.BackchannelAuthConfig{
+			TokenDeliveryMode:          oauth2const.BackchannelTokenDeliveryModePing,
+			ClientNotificationEndpoint: "https://client.example.com/notify",
+		}, nil},
+		{"InvalidMode", &inboundmodel.BackchannelAuthConfig{TokenDeliveryMode: "push"},
+			ErrOAuthInvalidBackchannelTokenDeliveryMode},
+		{"PingModeWithoutEndpoint", &inboundmodel.BackchannelAuthConfig{
+			TokenDeliveryMode: oauth2const.BackchannelTokenDeliveryModePing,
+		}, ErrOAuthPingModeRequiresNotificationEndpoint},
+		{"NonHTTPSEndpoint", &inboundmodel.BackchannelAuthConfig{
+			TokenDeliveryMode:          oauth2const.BackchannelTokenDeliveryModePing,
+			ClientNotificationEndpoint: "http://client.example.com/notify",
+		}, ErrOAuthInvalidClientNotificationEndpoint},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateBackchannelAuthConfig(&inboundmodel.OAuthProfile{BackchannelAuthentication: tc.cfg})
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+// validatePostLogoutRedirectURIs
+
+func (suite *InboundClientServiceTestSuite) TestValidatePostLogoutRedirectURIs() {
+	testCases := []struct {
+		name        string
+		uris        []string
+		policy      *inboundmodel.RedirectURIPolicy
+		expectedErr error
+	}{
+		{"None", nil, nil, nil},
+		{"HTTPS", []string{"https://app.example.com/logged-out"}, nil, nil},
+		{"CustomScheme", []string{"com.example.app:/logged-out"}, nil, nil},
+		{"CustomSchemeNotAllowed", []string{"com.other.app:/logged-out"},
+			&inboundmodel.RedirectURIPolicy{AllowedCustomSchemes: []string{"com.example.app"}},
+			ErrOAuthInvalidPostLogoutRedirectURI},
+		{"Relative", []string{"/logged-out"}, nil, ErrOAuthInvalidPostLogoutRedirectURI},
+		{"MissingHost", []string{"https:///logged-out"}, nil, ErrOAuthInvalidPostLogoutRedirectURI},
+		{"Fragment", []string{"https://app.example.com/logged-out#done"}, nil,
+			ErrOAuthInvalidPostLogoutRedirectURI},
+		{"Wildcard", []string{"https://*.example.com/logged-out"}, nil, ErrOAuthInvalidPostLogoutRedirectURI},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validatePostLogoutRedirectURIs(&inboundmodel.OAuthProfile{
+				PostLogoutRedirectURIs: tc.uris,
+				RedirectURIPolicy:      tc.policy,
+			})
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+// validateBackchannelLogoutURI
+
+func (suite *InboundClientServiceTestSuite) TestValidateBackchannelLogoutURI() {
+	testCases := []struct {
+		name        string
+		uri         string
+		expectedErr error
+	}{
+		{"None", "", nil},
+		{"HTTPS", "https://app.example.com/backchannel-logout", nil},
+		{"HTTP", "http://app.example.com/backchannel-logout", ErrOAuthInvalidBackchannelLogoutURI},
+		{"CustomScheme", "com.example.app:/backchannel-logout", ErrOAuthInvalidBackchannelLogoutURI},
+		{"MissingHost", "https:///backchannel-logout", ErrOAuthInvalidBackchannelLogoutURI},
+		{"Fragment", "https://app.example.com/backchannel-logout#done", ErrOAuthInvalidBackchannelLogoutURI},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateBackchannelLogoutURI(&inboundmodel.OAuthProfile{BackchannelLogoutURI: tc.uri})
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+// validateFrontchannelLogoutURI
+
+func (suite *InboundClientServiceTestSuite) TestValidateFrontchannelLogoutURI() {
+	testCases := []struct {
+		name        string
+		uri         string
+		expectedErr error
+	}{
+		{"None", "", nil},
+		{"HTTPS", "https://app.example.com/frontchannel-logout", nil},
+		{"HTTP", "http://app.example.com/frontchannel-logout", ErrOAuthInvalidFrontchannelLogoutURI},
+		{"CustomScheme", "com.example.app:/frontchannel-logout", ErrOAuthInvalidFrontchannelLogoutURI},
+		{"MissingHost", "https:///frontchannel-logout", ErrOAuthInvalidFrontchannelLogoutURI},
+		{"Fragment", "https://app.example.com/frontchannel-logout#done", ErrOAuthInvalidFrontchannelLogoutURI},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateFrontchannelLogoutURI(&inboundmodel.OAuthProfile{FrontchannelLogoutURI: tc.uri})
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+// validateTokenValidityPeriods
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenValidityPeriods() {
+	testCases := []struct {
+		name        string
+		token       *inboundmodel.OAuthTokenConfig
+		expectedErr error
+	}{
+		{"NilTokenConfig", nil, nil},
+		{"ValidPeriods", &inboundmodel.OAuthTokenConfig{
+			AccessToken:  &inboundmodel.AccessTokenConfig{ValidityPeriod: 600},
+			RefreshToken: &inboundmodel.RefreshTokenConfig{ValidityPeriod: 86400},
+			IDToken:      &inboundmodel.IDTokenConfig{},
+		}, nil},
+		{"NegativeAccessToken", &inboundmodel.OAuthTokenConfig{
+			AccessToken: &inboundmodel.AccessTokenConfig{ValidityPeriod: -1},
+		}, ErrOAuthInvalidTokenValidityPeriod},
+		{"NegativeRefreshToken", &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{ValidityPeriod: -1},
+		}, ErrOAuthInvalidTokenValidityPeriod},
+		{"NegativeIDToken", &inboundmodel.OAuthTokenConfig{
+			IDToken: &inboundmodel.IDTokenConfig{ValidityPeriod: -1},
+		}, ErrOAuthInvalidTokenValidityPeriod},
+		{"NegativeRefreshTokenMaxLifetime", &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{MaxLifetime: -1},
+		}, ErrOAuthInvalidTokenValidityPeriod},
+		{"SlidingRefreshTokenWithMaxLifetime", &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{
+				ValidityPeriod: 86400, SlidingExpiration: true, MaxLifetime: 7776000,
+			},
+		}, nil},
+		{"SlidingRefreshTokenWithoutMaxLifetime", &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{ValidityPeriod: 86400, SlidingExpiration: true},
+		}, ErrOAuthSlidingRefreshTokenRequiresMaxLifetime},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateTokenValidityPeriods(&inboundmodel.OAuthProfile{Token: tc.token})
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestApplyInboundDefaults_KeepsRefreshTokenConfig() {
+	profile := &inboundmodel.OAuthProfile{
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{ValidityPeriod: 86400},
+		},
+	}
+
+	applyInboundDefaults(&inboundmodel.InboundClient{}, profile)
+
+	suite.Require().NotNil(profile.Token.RefreshToken)
+	assert.Equal(suite.T(), int64(86400), profile.Token.RefreshToken.ValidityPeriod)
+	assert.NotNil(suite.T(), profile.Token.AccessToken)
+}
+
+// validateTokenClaimMappings
+
+func (suite *InboundClientServiceTestSuite) TestValidateTokenClaimMappings() {
+	testCases := []struct {
+		name        string
+		token       *inboundmodel.OAuthTokenConfig
+		expectedErr error
+	}{
+		{"NilTokenConfig", nil, nil},
+		{"ValidMappings", &inboundmodel.OAuthTokenConfig{
+			AccessToken: &inboundmodel.AccessTokenConfig{
+				ClaimMappings: map[string]string{"groups": "memberOf", "ouName": "organization"},
+			},
+			IDToken: &inboundmodel.IDTokenConfig{ClaimMappings: map[string]string{"roles": "app_roles"}},
+		}, nil},
+		{"EmptyClaimName", &inboundmodel.OAuthTokenConfig{
+			AccessToken: &inboundmodel.AccessTokenConfig{ClaimMappings: map[string]string{"groups": " "}},
+		}, ErrOAuthInvalidClaimMapping},
+		{"EmptyAttributeName", &inboundmodel.OAuthTokenConfig{
+			IDToken: &inboundmodel.IDTokenConfig{ClaimMappings: map[string]string{"": "memberOf"}},
+		}, ErrOAuthInvalidClaimMapping},
+		{"ReservedAccessTokenClaim", &inboundmodel.OAuthTokenConfig{
+			AccessToken: &inboundmodel.AccessTokenConfig{ClaimMappings: map[string]string{"email": "sub"}},
+		}, ErrOAuthInvalidClaimMapping},
+		{"ReservedIDTokenClaim", &inboundmodel.OAuthTokenConfig{
+			IDToken: &inboundmodel.IDTokenConfig{ClaimMappings: map[string]string{"email": "nonce"}},
+		}, ErrOAuthInvalidClaimMapping},
+		{"DuplicateClaim", &inboundmodel.OAuthTokenConfig{
+			AccessToken: &inboundmodel.AccessTokenConfig{
+				ClaimMappings: map[string]string{"groups": "memberOf", "roles": "memberOf"},
+			},
+		}, ErrOAuthInvalidClaimMapping},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateTokenClaimMappings(&inboundmodel.OAuthProfile{Token: tc.token})
+			if tc.expectedErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 // validateUserInfoConfig — happy paths
 
 func (suite *InboundClientServiceTestSuite) TestValidateUserInfoConfig_NilUserInfo() {
@@ -1147,7 +1385,7 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcar
 		RedirectURIs: []string{"https://*.app.com/cb"},
 		GrantTypes:   []string{"authorization_code"},
 	}
-	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURI)
+	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthRedirectURIWildcardNotAllowed)
 }
 
 func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_QueryWildcardRejected() {
@@ -1221,7 +1459,7 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcar
 		RedirectURIs: []string{"https://app-*.example.com/cb"},
 		GrantTypes:   []string{"authorization_code"},
 	}
-	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURI)
+	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthRedirectURIWildcardNotAllowed)
 }
 
 func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcardMixedWithPath_Accepted() {
@@ -1234,6 +1472,73 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_HostWildcar
 	assert.NoError(suite.T(), validateRedirectURIs(p))
 }
 
+// ----- Redirect URI policy -----
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_PolicyHostWildcard_Accepted() {
+	p := &inboundmodel.OAuthProfile{
+		RedirectURIs:      []string{"https://app-*.example.com/cb"},
+		RedirectURIPolicy: &inboundmodel.RedirectURIPolicy{AllowWildcardSubdomains: true},
+		GrantTypes:        []string{"authorization_code"},
+	}
+	assert.NoError(suite.T(), validateRedirectURIs(p))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_PolicyHostWildcard_PathWildcardRejected() {
+	p := &inboundmodel.OAuthProfile{
+		RedirectURIs:      []string{"https://app-*.example.com/cb/*"},
+		RedirectURIPolicy: &inboundmodel.RedirectURIPolicy{AllowWildcardSubdomains: true},
+		GrantTypes:        []string{"authorization_code"},
+	}
+	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthRedirectURIWildcardNotAllowed)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_CustomScheme() {
+	testCases := []struct {
+		name        string
+		redirectURI string
+		policy      *inboundmodel.RedirectURIPolicy
+		wantErr     error
+	}{
+		{"HostForm", "myapp://callback", nil, nil},
+		{"PathForm", "com.example.app:/callback", nil, nil},
+		{"AllowedByPolicy", "com.example.app:/callback",
+			&inboundmodel.RedirectURIPolicy{AllowedCustomSchemes: []string{"com.example.app"}}, nil},
+		{"NotAllowedByPolicy", "myapp://callback",
+			&inboundmodel.RedirectURIPolicy{AllowedCustomSchemes: []string{"com.example.app"}},
+			ErrOAuthRedirectURISchemeNotAllowed},
+		{"JavaScriptScheme", "javascript:/alert(1)", nil, ErrOAuthRedirectURISchemeNotAllowed},
+		{"DataScheme", "data://text/html,hello", nil, ErrOAuthRedirectURISchemeNotAllowed},
+		{"OpaqueForm", "com.example.app:callback", nil, ErrOAuthInvalidRedirectURI},
+		{"EmptyHostAndPath", "com.example.app://", nil, ErrOAuthInvalidRedirectURI},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			p := &inboundmodel.OAuthProfile{
+				RedirectURIs:      []string{tc.redirectURI},
+				RedirectURIPolicy: tc.policy,
+				GrantTypes:        []string{"authorization_code"},
+			}
+			err := validateRedirectURIs(p)
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_InvalidPolicySchemeRejected() {
+	for _, scheme := range []string{"myapp", "https", "com.example app", "1com.example"} {
+		p := &inboundmodel.OAuthProfile{
+			RedirectURIs:      []string{"https://app.example.com/cb"},
+			RedirectURIPolicy: &inboundmodel.RedirectURIPolicy{AllowedCustomSchemes: []string{scheme}},
+			GrantTypes:        []string{"authorization_code"},
+		}
+		assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURIPolicy, scheme)
+	}
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_MissingSchemeRejected() {
 	p := &inboundmodel.OAuthProfile{
 		RedirectURIs: []string{"//app/cb"},
@@ -1299,6 +1604,33 @@ func (suite *InboundClientServiceTestSuite) TestValidateAuthFlowID_ValidNoError(
 	assert.NoError(suite.T(), svc.validateAuthFlowID(context.Background(), "good"))
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidateAcrFlows() {
+	flowMgt := flowmgtmock.NewFlowMgtServiceInterfaceMock(suite.T())
+	flowMgt.EXPECT().IsValidFlow(mock.Anything, "mfa-flow", flowcommon.FlowTypeAuthentication).
+		Return(true, nil).Once()
+	flowMgt.EXPECT().IsValidFlow(mock.Anything, "bad-flow", flowcommon.FlowTypeAuthentication).
+		Return(false, nil).Once()
+	svc := &inboundClientService{flowMgt: flowMgt}
+	acrValues := []string{"urn:thunder:acr:password", "urn:thunder:acr:mfa"}
+
+	assert.NoError(suite.T(), svc.validateAcrFlows(context.Background(), &inboundmodel.OAuthProfile{
+		AcrValues: acrValues,
+		AcrFlows:  map[string]string{"urn:thunder:acr:mfa": "mfa-flow"},
+	}))
+	assert.ErrorIs(suite.T(), svc.validateAcrFlows(context.Background(), &inboundmodel.OAuthProfile{
+		AcrValues: acrValues,
+		AcrFlows:  map[string]string{"urn:thunder:acr:mfa": "bad-flow"},
+	}), ErrFKInvalidAuthFlow)
+	assert.ErrorIs(suite.T(), svc.validateAcrFlows(context.Background(), &inboundmodel.OAuthProfile{
+		AcrValues: acrValues,
+		AcrFlows:  map[string]string{"urn:thunder:acr:unknown": "mfa-flow"},
+	}), ErrOAuthInvalidAcrFlow)
+	assert.ErrorIs(suite.T(), svc.validateAcrFlows(context.Background(), &inboundmodel.OAuthProfile{
+		AcrValues: acrValues,
+		AcrFlows:  map[string]string{"urn:thunder:acr:mfa": ""},
+	}), ErrOAuthInvalidAcrFlow)
+}
+
 func (suite *InboundClientServiceTestSuite) testValidateFlowID(
 	flowType flowcommon.FlowType,
 	validateFn func(*inboundClientService, context.Context, string) error,
@@ -1551,6 +1883,21 @@ func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_Re
 		ErrOAuthRefreshTokenCannotBeSoleGrant)
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_RefreshTokenWithoutIssuingGrant() {
+	p := &inboundmodel.OAuthProfile{
+		GrantTypes: []string{"client_credentials", "refresh_token"},
+	}
+	assert.ErrorIs(suite.T(), validateGrantAndResponseTypes(p),
+		ErrOAuthRefreshTokenRequiresIssuingGrant)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_RefreshTokenWithCIBA() {
+	p := &inboundmodel.OAuthProfile{
+		GrantTypes: []string{"urn:openid:params:grant-type:ciba", "refresh_token"},
+	}
+	assert.NoError(suite.T(), validateGrantAndResponseTypes(p))
+}
+
 func (suite *InboundClientServiceTestSuite) TestValidateGrantAndResponseTypes_PKCEWithoutAuthCode() {
 	p := &inboundmodel.OAuthProfile{
 		GrantTypes:   []string{"client_credentials"},
@@ -34,10 +34,11 @@ import (
 // inboundClientJSONBlob is the internal structure for marshaling/unmarshaling the
 // PROPERTIES column.
 type inboundClientJSONBlob struct {
-	Assertion        *inboundmodel.AssertionConfig    `json:"assertion,omitempty"`
-	LoginConsent     *inboundmodel.LoginConsentConfig `json:"loginConsent,omitempty"`
-	AllowedUserTypes []string                         `json:"allowedUserTypes,omitempty"`
-	Properties       map[string]interface{}           `json:"properties,omitempty"`
+	Assertion        *inboundmodel.AssertionConfig       `json:"assertion,omitempty"`
+	LoginConsent     *inboundmodel.LoginConsentConfig    `json:"loginConsent,omitempty"`
+	AllowedUserTypes []string                            `json:"allowedUserTypes,omitempty"`
+	LoginExperience  *inboundmodel.LoginExperienceConfig `json:"loginExperience,omitempty"`
+	Properties       map[string]interface{}              `json:"properties,omitempty"`
 }
 
 // inboundClientStoreInterface defines persistence operations for inbound clients.
@@ -109,6 +110,7 @@ func marshalInboundClient(c inboundmodel.InboundClient) (
 		Assertion:        c.Assertion,
 		LoginConsent:     c.LoginConsent,
 		AllowedUserTypes: c.AllowedUserTypes,
+		LoginExperience:  c.LoginExperience,
 		Properties:       c.Properties,
 	}
 	propertiesBytes, err = marshalNullableJSON(blob)
@@ -412,6 +414,7 @@ func buildInboundClientFromRow(row map[string]interface{}) (*inboundmodel.Inboun
 			client.Assertion = blob.Assertion
 			client.LoginConsent = blob.LoginConsent
 			client.AllowedUserTypes = blob.AllowedUserTypes
+			client.LoginExperience = blob.LoginExperience
 			client.Properties = blob.Properties
 		}
 	}
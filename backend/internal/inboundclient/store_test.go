@@ -96,7 +96,13 @@ func (suite *InboundClientStoreTestSuite) TestBuildInboundClientFromRow_Success(
 			ValidityPeriod: 5400,
 		},
 		AllowedUserTypes: []string{"admin", "user"},
-		Properties:       map[string]interface{}{"template": "spa"},
+		LoginExperience: &inboundmodel.LoginExperienceConfig{
+			AllowedIDPs: []string{"google-idp"},
+			PostLoginRedirects: []inboundmodel.PostLoginRedirectRule{
+				{UserType: "admin", RedirectURI: "https://admin.example.com"},
+			},
+		},
+		Properties: map[string]interface{}{"template": "spa"},
 	}
 	blobBytes, _ := json.Marshal(blob)
 
@@ -129,6 +135,9 @@ func (suite *InboundClientStoreTestSuite) TestBuildInboundClientFromRow_Success(
 	suite.NotNil(result.LoginConsent)
 	suite.Equal(int64(5400), result.LoginConsent.ValidityPeriod)
 	suite.Equal([]string{"admin", "user"}, result.AllowedUserTypes)
+	suite.NotNil(result.LoginExperience)
+	suite.Equal([]string{"google-idp"}, result.LoginExperience.AllowedIDPs)
+	suite.Equal("https://admin.example.com", result.LoginExperience.PostLoginRedirects[0].RedirectURI)
 	suite.NotNil(result.Properties)
 	suite.Equal("spa", result.Properties["template"])
 }
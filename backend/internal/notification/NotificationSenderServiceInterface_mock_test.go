@@ -109,3 +109,139 @@ func (_c *NotificationSenderServiceInterfaceMock_Send_Call) RunAndReturn(run fun
 	_c.Call.Return(run)
 	return _c
 }
+
+// SendForOU provides a mock function for the type NotificationSenderServiceInterfaceMock
+func (_mock *NotificationSenderServiceInterfaceMock) SendForOU(ctx context.Context, channel common.ChannelType, ouID string, data common.NotificationData) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, channel, ouID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendForOU")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, common.ChannelType, string, common.NotificationData) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, channel, ouID, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// NotificationSenderServiceInterfaceMock_SendForOU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendForOU'
+type NotificationSenderServiceInterfaceMock_SendForOU_Call struct {
+	*mock.Call
+}
+
+// SendForOU is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel common.ChannelType
+//   - ouID string
+//   - data common.NotificationData
+func (_e *NotificationSenderServiceInterfaceMock_Expecter) SendForOU(ctx interface{}, channel interface{}, ouID interface{}, data interface{}) *NotificationSenderServiceInterfaceMock_SendForOU_Call {
+	return &NotificationSenderServiceInterfaceMock_SendForOU_Call{Call: _e.mock.On("SendForOU", ctx, channel, ouID, data)}
+}
+
+func (_c *NotificationSenderServiceInterfaceMock_SendForOU_Call) Run(run func(ctx context.Context, channel common.ChannelType, ouID string, data common.NotificationData)) *NotificationSenderServiceInterfaceMock_SendForOU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 common.ChannelType
+		if args[1] != nil {
+			arg1 = args[1].(common.ChannelType)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 common.NotificationData
+		if args[3] != nil {
+			arg3 = args[3].(common.NotificationData)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationSenderServiceInterfaceMock_SendForOU_Call) Return(serviceError *serviceerror.ServiceError) *NotificationSenderServiceInterfaceMock_SendForOU_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *NotificationSenderServiceInterfaceMock_SendForOU_Call) RunAndReturn(run func(ctx context.Context, channel common.ChannelType, ouID string, data common.NotificationData) *serviceerror.ServiceError) *NotificationSenderServiceInterfaceMock_SendForOU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendPush provides a mock function for the type NotificationSenderServiceInterfaceMock
+func (_mock *NotificationSenderServiceInterfaceMock) SendPush(ctx context.Context, userID string, pushMessage common.PushMessage) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, userID, pushMessage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendPush")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, common.PushMessage) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, userID, pushMessage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// NotificationSenderServiceInterfaceMock_SendPush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendPush'
+type NotificationSenderServiceInterfaceMock_SendPush_Call struct {
+	*mock.Call
+}
+
+// SendPush is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - pushMessage common.PushMessage
+func (_e *NotificationSenderServiceInterfaceMock_Expecter) SendPush(ctx interface{}, userID interface{}, pushMessage interface{}) *NotificationSenderServiceInterfaceMock_SendPush_Call {
+	return &NotificationSenderServiceInterfaceMock_SendPush_Call{Call: _e.mock.On("SendPush", ctx, userID, pushMessage)}
+}
+
+func (_c *NotificationSenderServiceInterfaceMock_SendPush_Call) Run(run func(ctx context.Context, userID string, pushMessage common.PushMessage)) *NotificationSenderServiceInterfaceMock_SendPush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 common.PushMessage
+		if args[2] != nil {
+			arg2 = args[2].(common.PushMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationSenderServiceInterfaceMock_SendPush_Call) Return(serviceError *serviceerror.ServiceError) *NotificationSenderServiceInterfaceMock_SendPush_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *NotificationSenderServiceInterfaceMock_SendPush_Call) RunAndReturn(run func(ctx context.Context, userID string, pushMessage common.PushMessage) *serviceerror.ServiceError) *NotificationSenderServiceInterfaceMock_SendPush_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -49,6 +49,10 @@ func (p *notificationClientProvider) GetClient(sender common.NotificationSenderD
 		_client, err = message.NewTwilioClient(sender)
 	case common.MessageProviderTypeCustom:
 		_client, err = message.NewCustomClient(sender)
+	case common.MessageProviderTypeFCM:
+		_client, err = message.NewFCMClient(sender)
+	case common.MessageProviderTypeAPNs:
+		_client, err = message.NewAPNsClient(sender)
 	default:
 		return nil, &ErrorInvalidProvider
 	}
@@ -162,6 +162,28 @@ func (suite *ClientProviderTestSuite) TestGetClientWithError() {
 				Properties: makeInvalidSecretProps("url"),
 			},
 		},
+		{
+			name: "fcm_invalid_service_account",
+			sender: common.NotificationSenderDTO{
+				Name:     "Bad FCM",
+				Provider: common.MessageProviderTypeFCM,
+				Properties: []cmodels.Property{
+					createTestProperty("project_id", "test-project", false),
+					createTestProperty("service_account", "not-json", true),
+				},
+			},
+		},
+		{
+			name: "apns_invalid_private_key",
+			sender: common.NotificationSenderDTO{
+				Name:     "Bad APNs",
+				Provider: common.MessageProviderTypeAPNs,
+				Properties: []cmodels.Property{
+					createTestProperty("key_id", "ABC123DEFG", false),
+					createTestProperty("private_key", "invalid", true),
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -36,6 +36,10 @@ const (
 	MessageProviderTypeTwilio MessageProviderType = "twilio"
 	// MessageProviderTypeCustom represents a custom messaging provider.
 	MessageProviderTypeCustom MessageProviderType = "custom"
+	// MessageProviderTypeFCM represents the Firebase Cloud Messaging push provider.
+	MessageProviderTypeFCM MessageProviderType = "fcm"
+	// MessageProviderTypeAPNs represents the Apple Push Notification service push provider.
+	MessageProviderTypeAPNs MessageProviderType = "apns"
 )
 
 // ChannelType defines the type of communication channel.
@@ -44,6 +48,24 @@ type ChannelType string
 const (
 	// ChannelTypeSMS represents the SMS channel.
 	ChannelTypeSMS ChannelType = "sms"
+	// ChannelTypeEmail represents the email channel.
+	ChannelTypeEmail ChannelType = "email"
+	// ChannelTypePush represents the mobile push notification channel.
+	ChannelTypePush ChannelType = "push"
+)
+
+// NotificationEvent defines a system event that can trigger a notification.
+type NotificationEvent string
+
+const (
+	// NotificationEventUserCreated is raised when a user account is created.
+	NotificationEventUserCreated NotificationEvent = "USER_CREATED"
+	// NotificationEventPasswordChanged is raised when the password of a user is changed.
+	NotificationEventPasswordChanged NotificationEvent = "PASSWORD_CHANGED"
+	// NotificationEventNewDeviceLogin is raised when a user signs in from a device not seen before.
+	NotificationEventNewDeviceLogin NotificationEvent = "NEW_DEVICE_LOGIN"
+	// NotificationEventAccountLocked is raised when a user account is locked.
+	NotificationEventAccountLocked NotificationEvent = "ACCOUNT_LOCKED"
 )
 
 // OTPVerifyStatus defines the status of OTP verification.
@@ -72,6 +94,8 @@ const (
 	TwilioPropKeyAuthToken = "auth_token"
 	// TwilioPropKeySenderID is the property key for the Twilio sender ID.
 	TwilioPropKeySenderID = "sender_id"
+	// TwilioPropKeyStatusCallbackURL is the property key for the Twilio delivery status callback URL.
+	TwilioPropKeyStatusCallbackURL = "status_callback_url"
 )
 
 const (
@@ -84,3 +108,62 @@ const (
 	// CustomPropKeyContentType is the property key for the content type.
 	CustomPropKeyContentType = "content_type"
 )
+
+const (
+	// FCMPropKeyProjectID is the property key for the Firebase project ID.
+	FCMPropKeyProjectID = "project_id"
+	// FCMPropKeyServiceAccount is the property key for the Firebase service account key in JSON format.
+	FCMPropKeyServiceAccount = "service_account"
+)
+
+const (
+	// APNsPropKeyKeyID is the property key for the APNs authentication key ID.
+	APNsPropKeyKeyID = "key_id"
+	// APNsPropKeyTeamID is the property key for the Apple developer team ID.
+	APNsPropKeyTeamID = "team_id"
+	// APNsPropKeyPrivateKey is the property key for the PEM encoded APNs authentication key.
+	APNsPropKeyPrivateKey = "private_key"
+	// APNsPropKeyBundleID is the property key for the bundle ID of the mobile app.
+	APNsPropKeyBundleID = "bundle_id"
+	// APNsPropKeyEnvironment is the property key for the APNs environment.
+	APNsPropKeyEnvironment = "environment"
+)
+
+const (
+	// APNsEnvironmentProduction is the APNs production environment.
+	APNsEnvironmentProduction = "production"
+	// APNsEnvironmentSandbox is the APNs sandbox environment used by development builds.
+	APNsEnvironmentSandbox = "sandbox"
+)
+
+const (
+	// PropKeyCallbackToken is the property key for the shared token that providers without request
+	// signing must present when posting delivery status callbacks.
+	PropKeyCallbackToken = "callback_token"
+)
+
+// DeliveryState defines the normalized delivery state reported by a messaging provider.
+type DeliveryState string
+
+const (
+	// DeliveryStateAccepted indicates the provider accepted the message for delivery.
+	DeliveryStateAccepted DeliveryState = "ACCEPTED"
+	// DeliveryStateSent indicates the provider handed the message to the carrier.
+	DeliveryStateSent DeliveryState = "SENT"
+	// DeliveryStateDelivered indicates the message was delivered to the recipient's device.
+	DeliveryStateDelivered DeliveryState = "DELIVERED"
+	// DeliveryStateFailed indicates the message could not be delivered.
+	DeliveryStateFailed DeliveryState = "FAILED"
+	// DeliveryStateUnknown indicates the provider reported a status that could not be mapped.
+	DeliveryStateUnknown DeliveryState = "UNKNOWN"
+)
+
+// SendStatus defines the outcome of a notification send attempt recorded in the send audit.
+type SendStatus string
+
+const (
+	// SendStatusSuccess indicates the notification was accepted by the provider.
+	SendStatusSuccess SendStatus = "SUCCESS"
+	// SendStatusFailed indicates the notification could not be handed over to the provider.
+	SendStatusFailed SendStatus = "FAILED"
+)
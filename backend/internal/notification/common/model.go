@@ -19,7 +19,12 @@
 // Package common contains the common models and constants for notification package.
 package common
 
-import "github.com/thunder-id/thunderid/internal/system/cmodels"
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
 
 // SMSData represents the data structure for a SMS message.
 type SMSData struct {
@@ -31,6 +36,12 @@ type SMSData struct {
 type NotificationData struct {
 	Recipient string
 	Body      string
+	// Template is the scenario of the template rendered for the notification. It is only recorded in the
+	// send audit and is not sent to the provider.
+	Template string
+	// Title and Data are only used by the push channel.
+	Title string
+	Data  map[string]string
 }
 
 // OTP represents the data structure for an OTP (One-Time Password).
@@ -49,6 +60,7 @@ type NotificationSenderDTO struct {
 	Description string                 `yaml:"description,omitempty"`
 	Type        NotificationSenderType `yaml:"-"`
 	Provider    MessageProviderType    `yaml:"provider"`
+	OUID        string                 `yaml:"ou_id,omitempty"`
 	Properties  []cmodels.Property     `yaml:"properties,omitempty"`
 }
 
@@ -57,6 +69,7 @@ type NotificationSenderRequest struct {
 	Name        string                `json:"name"`
 	Description string                `json:"description"`
 	Provider    string                `json:"provider"`
+	OUID        string                `json:"ouId,omitempty"`
 	Properties  []cmodels.PropertyDTO `json:"properties"`
 }
 
@@ -66,6 +79,7 @@ type NotificationSenderResponse struct {
 	Name        string                `json:"name"`
 	Description string                `json:"description"`
 	Provider    MessageProviderType   `json:"provider"`
+	OUID        string                `json:"ouId,omitempty"`
 	Properties  []cmodels.PropertyDTO `json:"properties"`
 }
 
@@ -93,11 +107,20 @@ type VerifyOTPResponse struct {
 	Status string `json:"status"`
 }
 
+// OTPOptions represents the generation options of an OTP. Zero values fall back to the defaults.
+type OTPOptions struct {
+	// Length is the number of characters in the OTP.
+	Length int
+	// ValidityPeriod is the time in seconds for which the OTP is valid.
+	ValidityPeriod int64
+}
+
 // SendOTPDTO represents the service layer data structure for sending an OTP.
 type SendOTPDTO struct {
 	Recipient string
 	SenderID  string
 	Channel   string
+	Options   OTPOptions
 }
 
 // SendOTPResultDTO represents the service layer result for OTP send operation.
@@ -133,5 +156,225 @@ type NotificationSenderRequestWithID struct {
 	Name        string                `yaml:"name"`
 	Description string                `yaml:"description,omitempty"`
 	Provider    string                `yaml:"provider"`
+	OUID        string                `yaml:"ou_id,omitempty"`
 	Properties  []cmodels.PropertyDTO `yaml:"properties,omitempty"`
 }
+
+// DeliveryStatusCallback carries the raw data of a delivery status callback received from a provider.
+type DeliveryStatusCallback struct {
+	Params    map[string]string
+	Signature string
+	Token     string
+}
+
+// DeliveryStatus represents a delivery status reported by a messaging provider for a sent message.
+type DeliveryStatus struct {
+	ID             string
+	SenderID       string
+	MessageID      string
+	Recipient      string
+	State          DeliveryState
+	ProviderStatus string
+	ErrorCode      string
+	CreatedAt      time.Time
+}
+
+// DeliveryStatusResponse represents the response structure for a recorded delivery status.
+type DeliveryStatusResponse struct {
+	ID             string        `json:"id"`
+	MessageID      string        `json:"messageId"`
+	Recipient      string        `json:"recipient"`
+	State          DeliveryState `json:"state"`
+	ProviderStatus string        `json:"providerStatus"`
+	ErrorCode      string        `json:"errorCode,omitempty"`
+	CreatedAt      time.Time     `json:"createdAt"`
+}
+
+// NotificationTriggerDTO represents a mapping of a system event to a notification template and channel.
+type NotificationTriggerDTO struct {
+	ID       string
+	Event    NotificationEvent
+	Channel  ChannelType
+	Scenario string
+	SenderID string
+	OUID     string
+	Enabled  bool
+}
+
+// NotificationTriggerRequest represents the request structure for creating or updating a notification trigger.
+type NotificationTriggerRequest struct {
+	Event    string `json:"event"`
+	Channel  string `json:"channel"`
+	Scenario string `json:"scenario"`
+	SenderID string `json:"senderId,omitempty"`
+	OUID     string `json:"ouId,omitempty"`
+	Enabled  *bool  `json:"enabled,omitempty"`
+}
+
+// NotificationTriggerResponse represents the response structure for a notification trigger.
+type NotificationTriggerResponse struct {
+	ID       string            `json:"id"`
+	Event    NotificationEvent `json:"event"`
+	Channel  ChannelType       `json:"channel"`
+	Scenario string            `json:"scenario"`
+	SenderID string            `json:"senderId,omitempty"`
+	OUID     string            `json:"ouId,omitempty"`
+	Enabled  bool              `json:"enabled"`
+}
+
+// NotificationRateLimitDTO represents the limits on the number of OTP and verification messages sent over a
+// channel within a time window. A limit of zero disables the corresponding check.
+type NotificationRateLimitDTO struct {
+	ID             string
+	Channel        ChannelType
+	OUID           string
+	RecipientLimit int
+	IPLimit        int
+	WindowSeconds  int
+}
+
+// NotificationRateLimitRequest represents the request structure for creating or updating a rate limit.
+type NotificationRateLimitRequest struct {
+	Channel        string `json:"channel"`
+	OUID           string `json:"ouId,omitempty"`
+	RecipientLimit int    `json:"recipientLimit"`
+	IPLimit        int    `json:"ipLimit"`
+	WindowSeconds  int    `json:"windowSeconds"`
+}
+
+// NotificationRateLimitResponse represents the response structure for a rate limit.
+type NotificationRateLimitResponse struct {
+	ID             string      `json:"id"`
+	Channel        ChannelType `json:"channel"`
+	OUID           string      `json:"ouId,omitempty"`
+	RecipientLimit int         `json:"recipientLimit"`
+	IPLimit        int         `json:"ipLimit"`
+	WindowSeconds  int         `json:"windowSeconds"`
+}
+
+// EventNotification holds the details of a system event to be notified to a user.
+type EventNotification struct {
+	Event NotificationEvent
+	OUID  string
+	// Language is the preferred language of the recipient used to select the template variant.
+	Language string
+	// Recipients holds the recipient address of the user for each channel.
+	Recipients map[ChannelType]string
+	// Data holds the values substituted into the template placeholders.
+	Data map[string]string
+}
+
+// PushDevice represents a mobile device registered by a user to receive push notifications.
+type PushDevice struct {
+	ID        string
+	UserID    string
+	Provider  MessageProviderType
+	Token     string
+	Name      string
+	CreatedAt time.Time
+}
+
+// PushDeviceRequest represents the request structure for registering a push device.
+type PushDeviceRequest struct {
+	Provider string `json:"provider"`
+	Token    string `json:"token"`
+	Name     string `json:"name,omitempty"`
+}
+
+// PushDeviceResponse represents the response structure for a registered push device.
+type PushDeviceResponse struct {
+	ID        string              `json:"id"`
+	Provider  MessageProviderType `json:"provider"`
+	Name      string              `json:"name,omitempty"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+// PushMessage represents a push notification to be delivered to the devices of a user.
+type PushMessage struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// QueuedNotification represents a rendered notification queued for asynchronous delivery. Notifications
+// that cannot be delivered are moved to the dead-letter store with the error of the last attempt.
+type QueuedNotification struct {
+	ID        string
+	Channel   ChannelType
+	SenderID  string
+	OUID      string
+	Recipient string
+	Template  string
+	Subject   string
+	Body      string
+	IsHTML    bool
+	Attempts  int
+	LastError string
+	CreatedAt time.Time
+}
+
+// DeadLetterResponse represents the response structure for a notification in the dead-letter store.
+type DeadLetterResponse struct {
+	ID        string      `json:"id"`
+	Channel   ChannelType `json:"channel"`
+	SenderID  string      `json:"senderId,omitempty"`
+	OUID      string      `json:"ouId,omitempty"`
+	Recipient string      `json:"recipient"`
+	Subject   string      `json:"subject,omitempty"`
+	Attempts  int         `json:"attempts"`
+	LastError string      `json:"lastError"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+// SendAuditRecord represents an audit record of a notification send attempt. The recipient is masked before
+// the record is stored.
+type SendAuditRecord struct {
+	ID               string
+	Channel          ChannelType
+	Template         string
+	Recipient        string
+	SenderID         string
+	Provider         string
+	Status           SendStatus
+	ProviderResponse string
+	CreatedAt        time.Time
+}
+
+// SendAuditFilter represents the optional filters applied when querying the send audit.
+type SendAuditFilter struct {
+	Channel   ChannelType
+	Status    SendStatus
+	Template  string
+	Recipient string
+}
+
+// SendAuditList represents a page of send audit records.
+type SendAuditList struct {
+	TotalResults int
+	StartIndex   int
+	Count        int
+	Records      []SendAuditRecord
+	Links        []sysutils.Link
+}
+
+// SendAuditResponse represents the response structure for a send audit record.
+type SendAuditResponse struct {
+	ID               string      `json:"id"`
+	Channel          ChannelType `json:"channel"`
+	Template         string      `json:"template,omitempty"`
+	Recipient        string      `json:"recipient"`
+	SenderID         string      `json:"senderId,omitempty"`
+	Provider         string      `json:"provider,omitempty"`
+	Status           SendStatus  `json:"status"`
+	ProviderResponse string      `json:"providerResponse,omitempty"`
+	CreatedAt        time.Time   `json:"createdAt"`
+}
+
+// SendAuditListResponse represents the response structure for a page of send audit records.
+type SendAuditListResponse struct {
+	TotalResults int                 `json:"totalResults"`
+	StartIndex   int                 `json:"startIndex"`
+	Count        int                 `json:"count"`
+	Records      []SendAuditResponse `json:"records"`
+	Links        []sysutils.Link     `json:"links"`
+}
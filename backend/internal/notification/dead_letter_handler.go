@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// deadLetterHandler handles HTTP requests for inspecting and re-sending undelivered notifications.
+type deadLetterHandler struct {
+	deadLetterService deadLetterServiceInterface
+}
+
+// newDeadLetterHandler creates a new instance of deadLetterHandler.
+func newDeadLetterHandler(deadLetterService deadLetterServiceInterface) *deadLetterHandler {
+	return &deadLetterHandler{
+		deadLetterService: deadLetterService,
+	}
+}
+
+// HandleDeadLetterListRequest handles the request to list the notifications in the dead-letter store.
+func (h *deadLetterHandler) HandleDeadLetterListRequest(w http.ResponseWriter, r *http.Request) {
+	limit := defaultDeadLetterLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	notifications, svcErr := h.deadLetterService.ListDeadLetters(r.Context(), limit)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	response := make([]common.DeadLetterResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		response = append(response, getDeadLetterResponse(notification))
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleDeadLetterGetRequest handles the request to get a notification in the dead-letter store.
+func (h *deadLetterHandler) HandleDeadLetterGetRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidDeadLetterID)
+		return
+	}
+
+	notification, svcErr := h.deadLetterService.GetDeadLetter(r.Context(), id)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, getDeadLetterResponse(*notification))
+}
+
+// HandleDeadLetterResendRequest handles the request to re-send a notification in the dead-letter store.
+func (h *deadLetterHandler) HandleDeadLetterResendRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidDeadLetterID)
+		return
+	}
+
+	if svcErr := h.deadLetterService.ResendDeadLetter(r.Context(), id); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, nil)
+}
+
+// HandleDeadLetterDeleteRequest handles the request to delete a notification from the dead-letter store.
+func (h *deadLetterHandler) HandleDeadLetterDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidDeadLetterID)
+		return
+	}
+
+	if svcErr := h.deadLetterService.DeleteDeadLetter(r.Context(), id); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// handleError writes the HTTP error response for the given service error.
+func (h *deadLetterHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		if svcErr.Code == ErrorDeadLetterNotFound.Code {
+			statusCode = http.StatusNotFound
+		} else {
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
+
+// getDeadLetterResponse converts a dead-letter notification to its response representation. The recipient is
+// masked and the message body is not returned.
+func getDeadLetterResponse(notification common.QueuedNotification) common.DeadLetterResponse {
+	return common.DeadLetterResponse{
+		ID:        notification.ID,
+		Channel:   notification.Channel,
+		SenderID:  notification.SenderID,
+		OUID:      notification.OUID,
+		Recipient: maskRecipient(notification.Recipient),
+		Subject:   notification.Subject,
+		Attempts:  notification.Attempts,
+		LastError: notification.LastError,
+		CreatedAt: notification.CreatedAt,
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type DeadLetterHandlerTestSuite struct {
+	suite.Suite
+	mockService *deadLetterServiceInterfaceMock
+	handler     *deadLetterHandler
+}
+
+func TestDeadLetterHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DeadLetterHandlerTestSuite))
+}
+
+func (suite *DeadLetterHandlerTestSuite) SetupTest() {
+	suite.mockService = newDeadLetterServiceInterfaceMock(suite.T())
+	suite.handler = newDeadLetterHandler(suite.mockService)
+}
+
+func (suite *DeadLetterHandlerTestSuite) TestHandleDeadLetterListRequest() {
+	req := httptest.NewRequest(http.MethodGet, "/notification-dead-letters?limit=5", nil)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().ListDeadLetters(mock.Anything, 5).
+		Return([]common.QueuedNotification{getTestDeadLetter()}, nil).Once()
+
+	suite.handler.HandleDeadLetterListRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var res []common.DeadLetterResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Len(res, 1)
+	suite.Equal(testDeadLetterID, res[0].ID)
+	suite.Equal("********4567", res[0].Recipient)
+	suite.Equal(deliveryMaxAttempts, res[0].Attempts)
+	suite.NotContains(rr.Body.String(), "Body")
+}
+
+func (suite *DeadLetterHandlerTestSuite) TestHandleDeadLetterListRequest_DefaultLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/notification-dead-letters", nil)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().ListDeadLetters(mock.Anything, defaultDeadLetterLimit).
+		Return([]common.QueuedNotification{}, nil).Once()
+
+	suite.handler.HandleDeadLetterListRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.JSONEq("[]", rr.Body.String())
+}
+
+func (suite *DeadLetterHandlerTestSuite) TestHandleDeadLetterListRequest_InvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/notification-dead-letters?limit=abc", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleDeadLetterListRequest(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *DeadLetterHandlerTestSuite) TestHandleDeadLetterGetRequest() {
+	notification := getTestDeadLetter()
+	req := httptest.NewRequest(http.MethodGet, "/notification-dead-letters/"+testDeadLetterID, nil)
+	req.SetPathValue("id", testDeadLetterID)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().GetDeadLetter(mock.Anything, testDeadLetterID).Return(&notification, nil).Once()
+
+	suite.handler.HandleDeadLetterGetRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var res common.DeadLetterResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Equal(testDeadLetterID, res.ID)
+	suite.Equal(common.ChannelTypeSMS, res.Channel)
+	suite.Equal(notification.LastError, res.LastError)
+}
+
+func (suite *DeadLetterHandlerTestSuite) TestHandleDeadLetterGetRequest_WithFailure() {
+	cases := []struct {
+		name       string
+		svcErr     *serviceerror.ServiceError
+		wantStatus int
+	}{
+		{name: "NotFound", svcErr: &ErrorDeadLetterNotFound, wantStatus: http.StatusNotFound},
+		{name: "ServerError", svcErr: &serviceerror.InternalServerError, wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			mockService := newDeadLetterServiceInterfaceMock(suite.T())
+			handler := newDeadLetterHandler(mockService)
+			req := httptest.NewRequest(http.MethodGet, "/notification-dead-letters/"+testDeadLetterID, nil)
+			req.SetPathValue("id", testDeadLetterID)
+			rr := httptest.NewRecorder()
+
+			mockService.EXPECT().GetDeadLetter(mock.Anything, testDeadLetterID).Return(nil, tc.svcErr).Once()
+
+			handler.HandleDeadLetterGetRequest(rr, req)
+			suite.Equal(tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func (suite *DeadLetterHandlerTestSuite) TestHandleDeadLetterResendRequest() {
+	req := httptest.NewRequest(http.MethodPost, "/notification-dead-letters/"+testDeadLetterID+"/resend", nil)
+	req.SetPathValue("id", testDeadLetterID)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().ResendDeadLetter(mock.Anything, testDeadLetterID).Return(nil).Once()
+
+	suite.handler.HandleDeadLetterResendRequest(rr, req)
+	suite.Equal(http.StatusAccepted, rr.Code)
+}
+
+func (suite *DeadLetterHandlerTestSuite) TestHandleDeadLetterResendRequest_NotFound() {
+	req := httptest.NewRequest(http.MethodPost, "/notification-dead-letters/"+testDeadLetterID+"/resend", nil)
+	req.SetPathValue("id", testDeadLetterID)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().ResendDeadLetter(mock.Anything, testDeadLetterID).
+		Return(&ErrorDeadLetterNotFound).Once()
+
+	suite.handler.HandleDeadLetterResendRequest(rr, req)
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *DeadLetterHandlerTestSuite) TestHandleDeadLetterDeleteRequest() {
+	req := httptest.NewRequest(http.MethodDelete, "/notification-dead-letters/"+testDeadLetterID, nil)
+	req.SetPathValue("id", testDeadLetterID)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().DeleteDeadLetter(mock.Anything, testDeadLetterID).Return(nil).Once()
+
+	suite.handler.HandleDeadLetterDeleteRequest(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *DeadLetterHandlerTestSuite) TestHandleDeadLetterRequests_MissingID() {
+	handlers := map[string]http.HandlerFunc{
+		"Get":    suite.handler.HandleDeadLetterGetRequest,
+		"Resend": suite.handler.HandleDeadLetterResendRequest,
+		"Delete": suite.handler.HandleDeadLetterDeleteRequest,
+	}
+
+	for name, handle := range handlers {
+		suite.Run(name, func() {
+			req := httptest.NewRequest(http.MethodPost, "/notification-dead-letters/", nil)
+			rr := httptest.NewRecorder()
+
+			handle(rr, req)
+			suite.Equal(http.StatusBadRequest, rr.Code)
+		})
+	}
+}
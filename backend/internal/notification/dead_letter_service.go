@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// defaultDeadLetterLimit is the default number of dead-letter notifications returned in a listing.
+	defaultDeadLetterLimit = 20
+	// maxDeadLetterLimit is the maximum number of dead-letter notifications returned in a listing.
+	maxDeadLetterLimit = 100
+)
+
+// deadLetterServiceInterface defines the interface for inspecting and re-sending undelivered notifications.
+type deadLetterServiceInterface interface {
+	ListDeadLetters(ctx context.Context, limit int) ([]common.QueuedNotification, *serviceerror.ServiceError)
+	GetDeadLetter(ctx context.Context, id string) (*common.QueuedNotification, *serviceerror.ServiceError)
+	ResendDeadLetter(ctx context.Context, id string) *serviceerror.ServiceError
+	DeleteDeadLetter(ctx context.Context, id string) *serviceerror.ServiceError
+}
+
+// deadLetterService implements deadLetterServiceInterface.
+type deadLetterService struct {
+	store         deadLetterStoreInterface
+	deliveryQueue deliveryQueueInterface
+	logger        *log.Logger
+}
+
+// newDeadLetterService returns a new instance of deadLetterServiceInterface.
+func newDeadLetterService(store deadLetterStoreInterface,
+	deliveryQueue deliveryQueueInterface) deadLetterServiceInterface {
+	return &deadLetterService{
+		store:         store,
+		deliveryQueue: deliveryQueue,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeadLetterService")),
+	}
+}
+
+// ListDeadLetters retrieves the most recent notifications in the dead-letter store.
+func (s *deadLetterService) ListDeadLetters(ctx context.Context, limit int) (
+	[]common.QueuedNotification, *serviceerror.ServiceError) {
+	if limit <= 0 {
+		return nil, &ErrorInvalidLimit
+	}
+	if limit > maxDeadLetterLimit {
+		limit = maxDeadLetterLimit
+	}
+
+	notifications, err := s.store.listDeadLetters(ctx, limit)
+	if err != nil {
+		s.logger.Error("Failed to list dead-letter notifications", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return notifications, nil
+}
+
+// GetDeadLetter retrieves a notification in the dead-letter store by its ID.
+func (s *deadLetterService) GetDeadLetter(ctx context.Context, id string) (
+	*common.QueuedNotification, *serviceerror.ServiceError) {
+	if id == "" {
+		return nil, &ErrorInvalidDeadLetterID
+	}
+
+	notification, err := s.store.getDeadLetter(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve dead-letter notification", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if notification == nil {
+		return nil, &ErrorDeadLetterNotFound
+	}
+
+	return notification, nil
+}
+
+// ResendDeadLetter removes a notification from the dead-letter store and queues it for delivery again with a
+// fresh set of retry attempts.
+func (s *deadLetterService) ResendDeadLetter(ctx context.Context, id string) *serviceerror.ServiceError {
+	notification, svcErr := s.GetDeadLetter(ctx, id)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	deleted, err := s.store.deleteDeadLetter(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to delete dead-letter notification", log.String("id", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	// The notification was already re-sent or deleted by a concurrent request.
+	if !deleted {
+		return &ErrorDeadLetterNotFound
+	}
+
+	notification.Attempts = 0
+	notification.LastError = ""
+	s.deliveryQueue.Enqueue(*notification)
+	s.logger.Debug("Queued dead-letter notification for re-sending", log.String("id", id))
+
+	return nil
+}
+
+// DeleteDeadLetter deletes a notification from the dead-letter store.
+func (s *deadLetterService) DeleteDeadLetter(ctx context.Context, id string) *serviceerror.ServiceError {
+	if id == "" {
+		return &ErrorInvalidDeadLetterID
+	}
+
+	deleted, err := s.store.deleteDeadLetter(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to delete dead-letter notification", log.String("id", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !deleted {
+		return &ErrorDeadLetterNotFound
+	}
+
+	return nil
+}
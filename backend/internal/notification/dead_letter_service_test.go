@@ -0,0 +1,255 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type DeadLetterServiceTestSuite struct {
+	suite.Suite
+	mockStore         *deadLetterStoreInterfaceMock
+	mockDeliveryQueue *deliveryQueueInterfaceMock
+	service           deadLetterServiceInterface
+}
+
+func TestDeadLetterServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DeadLetterServiceTestSuite))
+}
+
+func (suite *DeadLetterServiceTestSuite) SetupSuite() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("", &config.Config{})
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *DeadLetterServiceTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *DeadLetterServiceTestSuite) SetupTest() {
+	suite.mockStore = newDeadLetterStoreInterfaceMock(suite.T())
+	suite.mockDeliveryQueue = newDeliveryQueueInterfaceMock(suite.T())
+	suite.service = newDeadLetterService(suite.mockStore, suite.mockDeliveryQueue)
+}
+
+func getTestDeadLetter() common.QueuedNotification {
+	return common.QueuedNotification{
+		ID:        testDeadLetterID,
+		Channel:   common.ChannelTypeSMS,
+		SenderID:  testSenderID,
+		Recipient: "+15551234567",
+		Body:      "Body",
+		Attempts:  deliveryMaxAttempts,
+		LastError: "SSE-5000: Internal server error",
+	}
+}
+
+func (suite *DeadLetterServiceTestSuite) TestListDeadLetters() {
+	cases := []struct {
+		name          string
+		limit         int
+		expectedLimit int
+	}{
+		{name: "WithinMax", limit: 10, expectedLimit: 10},
+		{name: "AboveMax", limit: 500, expectedLimit: maxDeadLetterLimit},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.mockStore.EXPECT().listDeadLetters(mock.Anything, tc.expectedLimit).
+				Return([]common.QueuedNotification{getTestDeadLetter()}, nil).Once()
+
+			notifications, err := suite.service.ListDeadLetters(context.Background(), tc.limit)
+			suite.Nil(err)
+			suite.Len(notifications, 1)
+		})
+	}
+}
+
+func (suite *DeadLetterServiceTestSuite) TestListDeadLetters_InvalidLimit() {
+	notifications, err := suite.service.ListDeadLetters(context.Background(), 0)
+	suite.Nil(notifications)
+	suite.Equal(ErrorInvalidLimit.Code, err.Code)
+}
+
+func (suite *DeadLetterServiceTestSuite) TestListDeadLetters_StoreError() {
+	suite.mockStore.EXPECT().listDeadLetters(mock.Anything, 10).Return(nil, errors.New("db err")).Once()
+
+	notifications, err := suite.service.ListDeadLetters(context.Background(), 10)
+	suite.Nil(notifications)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *DeadLetterServiceTestSuite) TestGetDeadLetter() {
+	notification := getTestDeadLetter()
+	suite.mockStore.EXPECT().getDeadLetter(mock.Anything, testDeadLetterID).Return(&notification, nil).Once()
+
+	result, err := suite.service.GetDeadLetter(context.Background(), testDeadLetterID)
+	suite.Nil(err)
+	suite.Equal(testDeadLetterID, result.ID)
+}
+
+func (suite *DeadLetterServiceTestSuite) TestGetDeadLetter_WithFailure() {
+	cases := []struct {
+		name         string
+		id           string
+		setup        func()
+		expectedCode string
+	}{
+		{name: "EmptyID", id: "", setup: func() {}, expectedCode: ErrorInvalidDeadLetterID.Code},
+		{
+			name: "NotFound",
+			id:   testDeadLetterID,
+			setup: func() {
+				suite.mockStore.EXPECT().getDeadLetter(mock.Anything, testDeadLetterID).Return(nil, nil).Once()
+			},
+			expectedCode: ErrorDeadLetterNotFound.Code,
+		},
+		{
+			name: "StoreError",
+			id:   testDeadLetterID,
+			setup: func() {
+				suite.mockStore.EXPECT().getDeadLetter(mock.Anything, testDeadLetterID).
+					Return(nil, errors.New("db err")).Once()
+			},
+			expectedCode: serviceerror.InternalServerError.Code,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			tc.setup()
+			result, err := suite.service.GetDeadLetter(context.Background(), tc.id)
+			suite.Nil(result)
+			suite.Equal(tc.expectedCode, err.Code)
+		})
+	}
+}
+
+func (suite *DeadLetterServiceTestSuite) TestResendDeadLetter() {
+	notification := getTestDeadLetter()
+	suite.mockStore.EXPECT().getDeadLetter(mock.Anything, testDeadLetterID).Return(&notification, nil).Once()
+	suite.mockStore.EXPECT().deleteDeadLetter(mock.Anything, testDeadLetterID).Return(true, nil).Once()
+	suite.mockDeliveryQueue.EXPECT().Enqueue(mock.MatchedBy(func(n common.QueuedNotification) bool {
+		return n.ID == testDeadLetterID && n.Attempts == 0 && n.LastError == "" && n.Body == "Body"
+	})).Return().Once()
+
+	err := suite.service.ResendDeadLetter(context.Background(), testDeadLetterID)
+	suite.Nil(err)
+}
+
+func (suite *DeadLetterServiceTestSuite) TestResendDeadLetter_WithFailure() {
+	cases := []struct {
+		name         string
+		setup        func()
+		expectedCode string
+	}{
+		{
+			name: "NotFound",
+			setup: func() {
+				suite.mockStore.EXPECT().getDeadLetter(mock.Anything, testDeadLetterID).Return(nil, nil).Once()
+			},
+			expectedCode: ErrorDeadLetterNotFound.Code,
+		},
+		{
+			name: "ConcurrentlyDeleted",
+			setup: func() {
+				notification := getTestDeadLetter()
+				suite.mockStore.EXPECT().getDeadLetter(mock.Anything, testDeadLetterID).
+					Return(&notification, nil).Once()
+				suite.mockStore.EXPECT().deleteDeadLetter(mock.Anything, testDeadLetterID).Return(false, nil).Once()
+			},
+			expectedCode: ErrorDeadLetterNotFound.Code,
+		},
+		{
+			name: "DeleteError",
+			setup: func() {
+				notification := getTestDeadLetter()
+				suite.mockStore.EXPECT().getDeadLetter(mock.Anything, testDeadLetterID).
+					Return(&notification, nil).Once()
+				suite.mockStore.EXPECT().deleteDeadLetter(mock.Anything, testDeadLetterID).
+					Return(false, errors.New("db err")).Once()
+			},
+			expectedCode: serviceerror.InternalServerError.Code,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			tc.setup()
+			err := suite.service.ResendDeadLetter(context.Background(), testDeadLetterID)
+			suite.Equal(tc.expectedCode, err.Code)
+		})
+	}
+}
+
+func (suite *DeadLetterServiceTestSuite) TestDeleteDeadLetter() {
+	suite.mockStore.EXPECT().deleteDeadLetter(mock.Anything, testDeadLetterID).Return(true, nil).Once()
+
+	err := suite.service.DeleteDeadLetter(context.Background(), testDeadLetterID)
+	suite.Nil(err)
+}
+
+func (suite *DeadLetterServiceTestSuite) TestDeleteDeadLetter_WithFailure() {
+	cases := []struct {
+		name         string
+		id           string
+		setup        func()
+		expectedCode string
+	}{
+		{name: "EmptyID", id: "", setup: func() {}, expectedCode: ErrorInvalidDeadLetterID.Code},
+		{
+			name: "NotFound",
+			id:   testDeadLetterID,
+			setup: func() {
+				suite.mockStore.EXPECT().deleteDeadLetter(mock.Anything, testDeadLetterID).Return(false, nil).Once()
+			},
+			expectedCode: ErrorDeadLetterNotFound.Code,
+		},
+		{
+			name: "StoreError",
+			id:   testDeadLetterID,
+			setup: func() {
+				suite.mockStore.EXPECT().deleteDeadLetter(mock.Anything, testDeadLetterID).
+					Return(false, errors.New("db err")).Once()
+			},
+			expectedCode: serviceerror.InternalServerError.Code,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			tc.setup()
+			err := suite.service.DeleteDeadLetter(context.Background(), tc.id)
+			suite.Equal(tc.expectedCode, err.Code)
+		})
+	}
+}
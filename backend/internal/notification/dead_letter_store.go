@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// deadLetterRetention is the duration for which undelivered notifications are retained.
+const deadLetterRetention = 30 * 24 * time.Hour
+
+// deadLetterStoreInterface defines the interface for dead-letter notification storage operations.
+type deadLetterStoreInterface interface {
+	createDeadLetter(ctx context.Context, notification common.QueuedNotification) error
+	listDeadLetters(ctx context.Context, limit int) ([]common.QueuedNotification, error)
+	getDeadLetter(ctx context.Context, id string) (*common.QueuedNotification, error)
+	deleteDeadLetter(ctx context.Context, id string) (bool, error)
+}
+
+// deadLetterStore is the runtime database implementation of deadLetterStoreInterface.
+type deadLetterStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newDeadLetterStore returns a new instance of deadLetterStoreInterface.
+func newDeadLetterStore() deadLetterStoreInterface {
+	return &deadLetterStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createDeadLetter moves an undelivered notification to the dead-letter store.
+func (s *deadLetterStore) createDeadLetter(ctx context.Context, notification common.QueuedNotification) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	now := time.Now().UTC()
+	_, err = dbClient.ExecuteContext(ctx, queryCreateDeadLetter, notification.ID, string(notification.Channel),
+		dbutils.ToNullableString(notification.SenderID), dbutils.ToNullableString(notification.OUID), notification.Recipient,
+		dbutils.ToNullableString(notification.Subject), notification.Body, sysutils.BoolToNumString(notification.IsHTML),
+		notification.Attempts, notification.LastError, now, now.Add(deadLetterRetention), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// listDeadLetters retrieves the most recent notifications in the dead-letter store.
+func (s *deadLetterStore) listDeadLetters(ctx context.Context, limit int) ([]common.QueuedNotification, error) {
+	return s.queryDeadLetters(ctx, queryListDeadLetters, limit, s.deploymentID)
+}
+
+// getDeadLetter retrieves a notification in the dead-letter store by its ID. Returns nil if it does not exist.
+func (s *deadLetterStore) getDeadLetter(ctx context.Context, id string) (*common.QueuedNotification, error) {
+	notifications, err := s.queryDeadLetters(ctx, queryGetDeadLetterByID, id, s.deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+
+	return &notifications[0], nil
+}
+
+// deleteDeadLetter deletes a notification from the dead-letter store. Returns false if it does not exist.
+func (s *deadLetterStore) deleteDeadLetter(ctx context.Context, id string) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteDeadLetter, id, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// queryDeadLetters executes the given query and builds the notifications from the result rows.
+func (s *deadLetterStore) queryDeadLetters(ctx context.Context, query dbmodel.DBQuery,
+	args ...interface{}) ([]common.QueuedNotification, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	notifications := make([]common.QueuedNotification, 0, len(results))
+	for _, row := range results {
+		notification, err := buildDeadLetterFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build dead-letter notification from result row: %w", err)
+		}
+		notifications = append(notifications, *notification)
+	}
+
+	return notifications, nil
+}
+
+// buildDeadLetterFromResultRow constructs a QueuedNotification from a database result row.
+func buildDeadLetterFromResultRow(row map[string]interface{}) (*common.QueuedNotification, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	channel, ok := row["channel"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse channel as string")
+	}
+	recipient, ok := row["recipient"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse recipient as string")
+	}
+	body, ok := row["body"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse body as string")
+	}
+
+	var attempts int
+	switch v := row["attempts"].(type) {
+	case int64:
+		attempts = int(v)
+	case int:
+		attempts = v
+	default:
+		return nil, errors.New("failed to parse attempts as integer")
+	}
+
+	// Optional columns may be NULL.
+	senderID, _ := row["sender_id"].(string)
+	ouID, _ := row["ou_id"].(string)
+	subject, _ := row["subject"].(string)
+	lastError, _ := row["last_error"].(string)
+
+	var isHTML string
+	switch v := row["is_html"].(type) {
+	case string:
+		isHTML = v
+	case []byte:
+		isHTML = string(v)
+	}
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.QueuedNotification{
+		ID:        id,
+		Channel:   common.ChannelType(channel),
+		SenderID:  senderID,
+		OUID:      ouID,
+		Recipient: recipient,
+		Subject:   subject,
+		Body:      body,
+		IsHTML:    sysutils.NumStringToBool(isHTML),
+		Attempts:  attempts,
+		LastError: lastError,
+		CreatedAt: createdAt,
+	}, nil
+}
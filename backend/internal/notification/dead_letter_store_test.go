@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeadLetterID = "dead-letter-1"
+
+type DeadLetterStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *deadLetterStore
+}
+
+func TestDeadLetterStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeadLetterStoreTestSuite))
+}
+
+func (suite *DeadLetterStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &deadLetterStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func getTestDeadLetterRow() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         testDeadLetterID,
+		"channel":    "email",
+		"sender_id":  nil,
+		"ou_id":      testOUID,
+		"recipient":  "user@example.com",
+		"subject":    "Subject",
+		"body":       "<p>Body</p>",
+		"is_html":    "1",
+		"attempts":   int64(5),
+		"last_error": "SSE-5000: Internal server error",
+		"created_at": "2026-01-02 03:04:05.123456",
+	}
+}
+
+func (suite *DeadLetterStoreTestSuite) TestCreateDeadLetter() {
+	notification := common.QueuedNotification{
+		ID:        testDeadLetterID,
+		Channel:   common.ChannelTypeSMS,
+		SenderID:  testSenderID,
+		Recipient: "+15551234567",
+		Body:      "Body",
+		Attempts:  5,
+		LastError: "error",
+	}
+
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateDeadLetter, testDeadLetterID,
+		"sms", testSenderID, nil, "+15551234567", nil, "Body", "0", 5, "error", mock.Anything, mock.Anything,
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createDeadLetter(context.Background(), notification)
+	suite.NoError(err)
+}
+
+func (suite *DeadLetterStoreTestSuite) TestCreateDeadLetter_WithError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryCreateDeadLetter, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("exec fail")).Once()
+
+	err := suite.store.createDeadLetter(context.Background(), common.QueuedNotification{ID: testDeadLetterID})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to execute query")
+}
+
+func (suite *DeadLetterStoreTestSuite) TestListDeadLetters() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListDeadLetters, 10, testDeploymentID).
+		Return([]map[string]interface{}{getTestDeadLetterRow()}, nil).Once()
+
+	notifications, err := suite.store.listDeadLetters(context.Background(), 10)
+	suite.NoError(err)
+	suite.Len(notifications, 1)
+	suite.Equal(testDeadLetterID, notifications[0].ID)
+	suite.Equal(common.ChannelTypeEmail, notifications[0].Channel)
+	suite.Empty(notifications[0].SenderID)
+	suite.Equal(testOUID, notifications[0].OUID)
+	suite.True(notifications[0].IsHTML)
+	suite.Equal(5, notifications[0].Attempts)
+	suite.Equal(2026, notifications[0].CreatedAt.Year())
+}
+
+func (suite *DeadLetterStoreTestSuite) TestListDeadLetters_WithFailure() {
+	cases := []struct {
+		name    string
+		setup   func()
+		wantErr string
+	}{
+		{
+			name: "GetDBClientError",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+			},
+			wantErr: "failed to get database client",
+		},
+		{
+			name: "QueryError",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListDeadLetters,
+					10, testDeploymentID).Return(nil, errors.New("query fail")).Once()
+			},
+			wantErr: "failed to execute query",
+		},
+		{
+			name: "InvalidAttempts",
+			setup: func() {
+				row := getTestDeadLetterRow()
+				row["attempts"] = "five"
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListDeadLetters,
+					10, testDeploymentID).Return([]map[string]interface{}{row}, nil).Once()
+			},
+			wantErr: "failed to build dead-letter notification",
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			tc.setup()
+			notifications, err := suite.store.listDeadLetters(context.Background(), 10)
+			suite.Nil(notifications)
+			suite.Error(err)
+			suite.Contains(err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func (suite *DeadLetterStoreTestSuite) TestGetDeadLetter() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetDeadLetterByID, testDeadLetterID,
+		testDeploymentID).Return([]map[string]interface{}{getTestDeadLetterRow()}, nil).Once()
+
+	notification, err := suite.store.getDeadLetter(context.Background(), testDeadLetterID)
+	suite.NoError(err)
+	suite.NotNil(notification)
+	suite.Equal("user@example.com", notification.Recipient)
+}
+
+func (suite *DeadLetterStoreTestSuite) TestGetDeadLetter_NotFound() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetDeadLetterByID, testDeadLetterID,
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	notification, err := suite.store.getDeadLetter(context.Background(), testDeadLetterID)
+	suite.NoError(err)
+	suite.Nil(notification)
+}
+
+func (suite *DeadLetterStoreTestSuite) TestDeleteDeadLetter() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Times(2)
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteDeadLetter, testDeadLetterID,
+		testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteDeadLetter, "missing",
+		testDeploymentID).Return(int64(0), nil).Once()
+
+	deleted, err := suite.store.deleteDeadLetter(context.Background(), testDeadLetterID)
+	suite.NoError(err)
+	suite.True(deleted)
+
+	deleted, err = suite.store.deleteDeadLetter(context.Background(), "missing")
+	suite.NoError(err)
+	suite.False(deleted)
+}
+
+func (suite *DeadLetterStoreTestSuite) TestDeleteDeadLetter_WithError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+
+	deleted, err := suite.store.deleteDeadLetter(context.Background(), testDeadLetterID)
+	suite.False(deleted)
+	suite.Error(err)
+}
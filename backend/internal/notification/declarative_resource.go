@@ -164,6 +164,7 @@ func parseToNotificationSenderDTO(data []byte) (*common.NotificationSenderDTO, e
 		Name:        senderRequest.Name,
 		Description: senderRequest.Description,
 		Type:        common.NotificationSenderTypeMessage,
+		OUID:        senderRequest.OUID,
 	}
 
 	// Parse provider type
@@ -198,6 +199,8 @@ func parseProviderType(providerStr string) (common.MessageProviderType, error) {
 		common.MessageProviderTypeVonage,
 		common.MessageProviderTypeTwilio,
 		common.MessageProviderTypeCustom,
+		common.MessageProviderTypeFCM,
+		common.MessageProviderTypeAPNs,
 	}
 
 	for _, supportedProvider := range supportedProviders {
@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// deliveryQueueSize is the number of notifications that can wait for delivery.
+	deliveryQueueSize = 1000
+	// deliveryMaxAttempts is the number of delivery attempts made before a notification is dead-lettered.
+	deliveryMaxAttempts = 5
+	// deliveryInitialBackoff is the delay before the first retry. The delay doubles on each retry.
+	deliveryInitialBackoff = 2 * time.Second
+	// deliveryMaxBackoff is the maximum delay between retries.
+	deliveryMaxBackoff = 5 * time.Minute
+)
+
+// deliveryQueueInterface defines the interface for queueing notifications for asynchronous delivery.
+type deliveryQueueInterface interface {
+	Enqueue(notification common.QueuedNotification)
+}
+
+// deliveryQueue delivers queued notifications in the background. Deliveries that fail with a server error
+// are retried with exponential backoff, while notifications that fail with a client error or exhaust the
+// retry attempts are moved to the dead-letter store. The queue is held in memory, so notifications pending
+// delivery or retry are not preserved across server restarts.
+type deliveryQueue struct {
+	senderService   NotificationSenderServiceInterface
+	emailClient     email.EmailClientInterface
+	deadLetterStore deadLetterStoreInterface
+	auditService    SendAuditServiceInterface
+	queue           chan common.QueuedNotification
+	schedule        func(delay time.Duration, fn func())
+	logger          *log.Logger
+}
+
+// newDeliveryQueue creates a delivery queue and starts the delivery worker.
+func newDeliveryQueue(senderService NotificationSenderServiceInterface, emailClient email.EmailClientInterface,
+	deadLetterStore deadLetterStoreInterface, auditService SendAuditServiceInterface) deliveryQueueInterface {
+	q := &deliveryQueue{
+		senderService:   senderService,
+		emailClient:     emailClient,
+		deadLetterStore: deadLetterStore,
+		auditService:    auditService,
+		queue:           make(chan common.QueuedNotification, deliveryQueueSize),
+		schedule: func(delay time.Duration, fn func()) {
+			time.AfterFunc(delay, fn)
+		},
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationDeliveryQueue")),
+	}
+	go q.run()
+
+	return q
+}
+
+// Enqueue adds a notification to the delivery queue. The notification is dead-lettered if the queue is full.
+func (q *deliveryQueue) Enqueue(notification common.QueuedNotification) {
+	if notification.ID == "" {
+		id, err := sysutils.GenerateUUIDv7()
+		if err != nil {
+			q.logger.Error("Failed to generate UUID for the queued notification", log.Error(err))
+			return
+		}
+		notification.ID = id
+	}
+
+	select {
+	case q.queue <- notification:
+	default:
+		notification.LastError = "delivery queue is full"
+		q.deadLetter(notification)
+	}
+}
+
+// run delivers the queued notifications until the queue is closed.
+func (q *deliveryQueue) run() {
+	for notification := range q.queue {
+		q.process(notification)
+	}
+}
+
+// process attempts to deliver a notification and schedules a retry or dead-letters it on failure.
+func (q *deliveryQueue) process(notification common.QueuedNotification) {
+	notification.Attempts++
+	svcErr := q.deliver(context.Background(), notification)
+	if svcErr == nil {
+		return
+	}
+
+	notification.LastError = fmt.Sprintf("%s: %s", svcErr.Code, svcErr.ErrorDescription.DefaultValue)
+	if svcErr.Type != serviceerror.ServerErrorType || notification.Attempts >= deliveryMaxAttempts {
+		q.deadLetter(notification)
+		return
+	}
+
+	delay := getDeliveryBackoff(notification.Attempts)
+	q.logger.Debug("Scheduling notification delivery retry", log.String("id", notification.ID),
+		log.Int("attempt", notification.Attempts), log.Any("delay", delay))
+	q.schedule(delay, func() {
+		q.Enqueue(notification)
+	})
+}
+
+// deliver sends the notification via its channel.
+func (q *deliveryQueue) deliver(ctx context.Context,
+	notification common.QueuedNotification) *serviceerror.ServiceError {
+	switch notification.Channel {
+	case common.ChannelTypeEmail:
+		if q.emailClient == nil {
+			q.logger.Error("Email client is not configured, unable to send the notification")
+			return &serviceerror.InternalServerError
+		}
+		emailData := email.EmailData{
+			To:      []string{notification.Recipient},
+			Subject: notification.Subject,
+			Body:    notification.Body,
+			IsHTML:  notification.IsHTML,
+		}
+		err := q.emailClient.Send(emailData)
+		q.auditService.RecordSend(ctx, newSendAuditRecord(common.ChannelTypeEmail, notification.Template,
+			notification.Recipient, "", "", err))
+		if err != nil {
+			q.logger.Error("Failed to send email notification", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		return nil
+	default:
+		data := common.NotificationData{
+			Recipient: notification.Recipient,
+			Body:      notification.Body,
+			Template:  notification.Template,
+		}
+		if notification.SenderID != "" {
+			return q.senderService.Send(ctx, notification.Channel, notification.SenderID, data)
+		}
+		return q.senderService.SendForOU(ctx, notification.Channel, notification.OUID, data)
+	}
+}
+
+// deadLetter moves a notification that could not be delivered to the dead-letter store.
+func (q *deliveryQueue) deadLetter(notification common.QueuedNotification) {
+	q.logger.Error("Notification could not be delivered, moving to the dead-letter store",
+		log.String("id", notification.ID), log.String("channel", string(notification.Channel)),
+		log.Int("attempts", notification.Attempts), log.String("error", notification.LastError))
+	if err := q.deadLetterStore.createDeadLetter(context.Background(), notification); err != nil {
+		q.logger.Error("Failed to store the dead-letter notification", log.String("id", notification.ID),
+			log.Error(err))
+	}
+}
+
+// getDeliveryBackoff returns the delay before the retry following the given attempt.
+func getDeliveryBackoff(attempt int) time.Duration {
+	delay := deliveryInitialBackoff
+	for i := 1; i < attempt && delay < deliveryMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > deliveryMaxBackoff {
+		return deliveryMaxBackoff
+	}
+
+	return delay
+}
@@ -0,0 +1,237 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/emailmock"
+)
+
+type DeliveryQueueTestSuite struct {
+	suite.Suite
+	mockSenderService   *NotificationSenderServiceInterfaceMock
+	mockEmailClient     *emailmock.EmailClientInterfaceMock
+	mockDeadLetterStore *deadLetterStoreInterfaceMock
+	mockAuditService    *SendAuditServiceInterfaceMock
+	scheduledDelays     []time.Duration
+	scheduledFns        []func()
+	queue               *deliveryQueue
+}
+
+func TestDeliveryQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryQueueTestSuite))
+}
+
+func (suite *DeliveryQueueTestSuite) SetupSuite() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("", &config.Config{})
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *DeliveryQueueTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *DeliveryQueueTestSuite) SetupTest() {
+	suite.mockSenderService = NewNotificationSenderServiceInterfaceMock(suite.T())
+	suite.mockEmailClient = emailmock.NewEmailClientInterfaceMock(suite.T())
+	suite.mockDeadLetterStore = newDeadLetterStoreInterfaceMock(suite.T())
+	suite.mockAuditService = NewSendAuditServiceInterfaceMock(suite.T())
+	suite.scheduledDelays = nil
+	suite.scheduledFns = nil
+	suite.queue = suite.newTestQueue(suite.mockEmailClient, 1)
+}
+
+// newTestQueue creates a delivery queue without starting the worker, recording the scheduled retries.
+func (suite *DeliveryQueueTestSuite) newTestQueue(emailClient email.EmailClientInterface,
+	size int) *deliveryQueue {
+	return &deliveryQueue{
+		senderService:   suite.mockSenderService,
+		emailClient:     emailClient,
+		deadLetterStore: suite.mockDeadLetterStore,
+		auditService:    suite.mockAuditService,
+		queue:           make(chan common.QueuedNotification, size),
+		schedule: func(delay time.Duration, fn func()) {
+			suite.scheduledDelays = append(suite.scheduledDelays, delay)
+			suite.scheduledFns = append(suite.scheduledFns, fn)
+		},
+		logger: log.GetLogger(),
+	}
+}
+
+func getTestQueuedSMS() common.QueuedNotification {
+	return common.QueuedNotification{
+		ID:        testDeadLetterID,
+		Channel:   common.ChannelTypeSMS,
+		SenderID:  testSenderID,
+		Recipient: "+15551234567",
+		Body:      "Body",
+	}
+}
+
+func (suite *DeliveryQueueTestSuite) TestEnqueue_AssignsID() {
+	suite.queue.Enqueue(common.QueuedNotification{Channel: common.ChannelTypeSMS})
+
+	queued := <-suite.queue.queue
+	suite.NotEmpty(queued.ID)
+}
+
+func (suite *DeliveryQueueTestSuite) TestEnqueue_QueueFull() {
+	suite.queue.Enqueue(getTestQueuedSMS())
+	suite.mockDeadLetterStore.EXPECT().createDeadLetter(mock.Anything,
+		mock.MatchedBy(func(n common.QueuedNotification) bool {
+			return n.ID == "second" && n.LastError == "delivery queue is full"
+		})).Return(nil).Once()
+
+	second := getTestQueuedSMS()
+	second.ID = "second"
+	suite.queue.Enqueue(second)
+	suite.Len(suite.queue.queue, 1)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_SMSWithSender() {
+	suite.mockSenderService.EXPECT().Send(mock.Anything, common.ChannelTypeSMS, testSenderID,
+		common.NotificationData{Recipient: "+15551234567", Body: "Body"}).Return(nil).Once()
+
+	suite.queue.process(getTestQueuedSMS())
+	suite.Empty(suite.scheduledFns)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_SMSWithOUSender() {
+	notification := getTestQueuedSMS()
+	notification.SenderID = ""
+	notification.OUID = testOUID
+	suite.mockSenderService.EXPECT().SendForOU(mock.Anything, common.ChannelTypeSMS, testOUID,
+		common.NotificationData{Recipient: "+15551234567", Body: "Body"}).Return(nil).Once()
+
+	suite.queue.process(notification)
+	suite.Empty(suite.scheduledFns)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_Email() {
+	suite.mockEmailClient.EXPECT().Send(email.EmailData{
+		To:      []string{"user@example.com"},
+		Subject: "Subject",
+		Body:    "<p>Body</p>",
+		IsHTML:  true,
+	}).Return(nil).Once()
+	suite.mockAuditService.EXPECT().RecordSend(mock.Anything, common.SendAuditRecord{
+		Channel:   common.ChannelTypeEmail,
+		Template:  "PASSWORD_CHANGED",
+		Recipient: "user@example.com",
+		Status:    common.SendStatusSuccess,
+	}).Once()
+
+	suite.queue.process(common.QueuedNotification{
+		ID:        testDeadLetterID,
+		Channel:   common.ChannelTypeEmail,
+		Recipient: "user@example.com",
+		Template:  "PASSWORD_CHANGED",
+		Subject:   "Subject",
+		Body:      "<p>Body</p>",
+		IsHTML:    true,
+	})
+	suite.Empty(suite.scheduledFns)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_EmailSendErrorIsRetried() {
+	suite.mockEmailClient.EXPECT().Send(mock.Anything).Return(errors.New("smtp error")).Once()
+	suite.mockAuditService.EXPECT().RecordSend(mock.Anything, mock.MatchedBy(func(record common.SendAuditRecord) bool {
+		return record.Status == common.SendStatusFailed && record.ProviderResponse == "smtp error"
+	})).Once()
+
+	suite.queue.process(common.QueuedNotification{
+		ID:        testDeadLetterID,
+		Channel:   common.ChannelTypeEmail,
+		Recipient: "user@example.com",
+		Body:      "<p>Body</p>",
+	})
+	suite.Len(suite.scheduledFns, 1)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_ServerErrorIsRetried() {
+	suite.mockSenderService.EXPECT().Send(mock.Anything, common.ChannelTypeSMS, testSenderID, mock.Anything).
+		Return(&serviceerror.InternalServerError).Once()
+
+	suite.queue.process(getTestQueuedSMS())
+	suite.Equal([]time.Duration{deliveryInitialBackoff}, suite.scheduledDelays)
+
+	// The scheduled retry re-queues the notification with the attempt recorded.
+	suite.scheduledFns[0]()
+	retried := <-suite.queue.queue
+	suite.Equal(1, retried.Attempts)
+	suite.Contains(retried.LastError, serviceerror.InternalServerError.Code)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_MaxAttemptsExhausted() {
+	notification := getTestQueuedSMS()
+	notification.Attempts = deliveryMaxAttempts - 1
+	suite.mockSenderService.EXPECT().Send(mock.Anything, common.ChannelTypeSMS, testSenderID, mock.Anything).
+		Return(&serviceerror.InternalServerError).Once()
+	suite.mockDeadLetterStore.EXPECT().createDeadLetter(mock.Anything,
+		mock.MatchedBy(func(n common.QueuedNotification) bool {
+			return n.ID == testDeadLetterID && n.Attempts == deliveryMaxAttempts
+		})).Return(nil).Once()
+
+	suite.queue.process(notification)
+	suite.Empty(suite.scheduledFns)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_ClientErrorIsDeadLettered() {
+	suite.mockSenderService.EXPECT().Send(mock.Anything, common.ChannelTypeSMS, testSenderID, mock.Anything).
+		Return(&ErrorSenderNotFound).Once()
+	suite.mockDeadLetterStore.EXPECT().createDeadLetter(mock.Anything,
+		mock.MatchedBy(func(n common.QueuedNotification) bool {
+			return n.Attempts == 1 && n.LastError != ""
+		})).Return(errors.New("db err")).Once()
+
+	suite.queue.process(getTestQueuedSMS())
+	suite.Empty(suite.scheduledFns)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_EmailClientNotConfigured() {
+	queue := suite.newTestQueue(nil, 1)
+
+	queue.process(common.QueuedNotification{
+		ID:        testDeadLetterID,
+		Channel:   common.ChannelTypeEmail,
+		Recipient: "user@example.com",
+	})
+	suite.Len(suite.scheduledFns, 1)
+}
+
+func (suite *DeliveryQueueTestSuite) TestGetDeliveryBackoff() {
+	suite.Equal(deliveryInitialBackoff, getDeliveryBackoff(1))
+	suite.Equal(2*deliveryInitialBackoff, getDeliveryBackoff(2))
+	suite.Equal(4*deliveryInitialBackoff, getDeliveryBackoff(3))
+	suite.Equal(deliveryMaxBackoff, getDeliveryBackoff(20))
+}
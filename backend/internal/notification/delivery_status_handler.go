@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// maxDeliveryStatusCallbackBodySize is the maximum accepted size of a delivery status callback body.
+const maxDeliveryStatusCallbackBodySize = 64 * 1024
+
+// deliveryStatusHandler handles HTTP requests for provider delivery status callbacks.
+type deliveryStatusHandler struct {
+	deliveryStatusService deliveryStatusServiceInterface
+}
+
+// newDeliveryStatusHandler creates a new instance of deliveryStatusHandler.
+func newDeliveryStatusHandler(deliveryStatusService deliveryStatusServiceInterface) *deliveryStatusHandler {
+	return &deliveryStatusHandler{
+		deliveryStatusService: deliveryStatusService,
+	}
+}
+
+// HandleDeliveryStatusCallback handles a delivery status callback posted by a messaging provider.
+func (h *deliveryStatusHandler) HandleDeliveryStatusCallback(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidSenderID)
+		return
+	}
+
+	params, err := readDeliveryStatusParams(w, r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	callback := common.DeliveryStatusCallback{
+		Params:    params,
+		Signature: r.Header.Get("X-Twilio-Signature"),
+		Token:     r.URL.Query().Get("token"),
+	}
+	if svcErr := h.deliveryStatusService.RecordDeliveryStatus(r.Context(), id, callback); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleDeliveryStatusListRequest handles the request to list recent delivery statuses of a sender.
+func (h *deliveryStatusHandler) HandleDeliveryStatusListRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidSenderID)
+		return
+	}
+
+	limit := defaultDeliveryStatusLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	statuses, svcErr := h.deliveryStatusService.ListDeliveryStatuses(r.Context(), id, limit)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	response := make([]common.DeliveryStatusResponse, 0, len(statuses))
+	for _, status := range statuses {
+		response = append(response, common.DeliveryStatusResponse{
+			ID:             status.ID,
+			MessageID:      status.MessageID,
+			Recipient:      status.Recipient,
+			State:          status.State,
+			ProviderStatus: status.ProviderStatus,
+			ErrorCode:      status.ErrorCode,
+			CreatedAt:      status.CreatedAt,
+		})
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// handleError writes the HTTP error response for the given service error.
+func (h *deliveryStatusHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorSenderNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorInvalidDeliveryStatusCallback.Code:
+			statusCode = http.StatusUnauthorized
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
+
+// readDeliveryStatusParams reads the callback parameters from a form encoded or JSON request body.
+// Providers that report delivery receipts with GET requests send the parameters in the query string.
+func readDeliveryStatusParams(w http.ResponseWriter, r *http.Request) (map[string]string, error) {
+	params := make(map[string]string)
+	if r.Method == http.MethodGet {
+		for key, values := range r.URL.Query() {
+			if key != "token" && len(values) > 0 {
+				params[key] = values[0]
+			}
+		}
+		return params, nil
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxDeliveryStatusCallbackBodySize)
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/json" {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return nil, fmt.Errorf("failed to decode callback body: %w", err)
+		}
+		for key, value := range body {
+			switch v := value.(type) {
+			case string:
+				params[key] = v
+			case float64, bool:
+				params[key] = fmt.Sprint(v)
+			}
+		}
+		return params, nil
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("failed to parse callback form: %w", err)
+	}
+	for key, values := range r.PostForm {
+		if len(values) > 0 {
+			params[key] = values[0]
+		}
+	}
+	return params, nil
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type DeliveryStatusHandlerTestSuite struct {
+	suite.Suite
+	mockService *deliveryStatusServiceInterfaceMock
+	handler     *deliveryStatusHandler
+}
+
+func TestDeliveryStatusHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryStatusHandlerTestSuite))
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) SetupTest() {
+	suite.mockService = newDeliveryStatusServiceInterfaceMock(suite.T())
+	suite.handler = newDeliveryStatusHandler(suite.mockService)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestHandleDeliveryStatusCallback_Form() {
+	form := url.Values{"MessageSid": {"SM123"}, "MessageStatus": {"delivered"}}
+	req := httptest.NewRequest(http.MethodPost, "/notification-callbacks/message/test-id/delivery-status",
+		strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Twilio-Signature", "signature")
+	req.SetPathValue("id", testSenderID)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().RecordDeliveryStatus(mock.Anything, testSenderID, common.DeliveryStatusCallback{
+		Params:    map[string]string{"MessageSid": "SM123", "MessageStatus": "delivered"},
+		Signature: "signature",
+	}).Return(nil).Once()
+
+	suite.handler.HandleDeliveryStatusCallback(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestHandleDeliveryStatusCallback_JSON() {
+	body := `{"messageId":"msg-1","status":"delivered","attempts":1}`
+	req := httptest.NewRequest(http.MethodPost,
+		"/notification-callbacks/message/test-id/delivery-status?token=secret", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetPathValue("id", testSenderID)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().RecordDeliveryStatus(mock.Anything, testSenderID, common.DeliveryStatusCallback{
+		Params: map[string]string{"messageId": "msg-1", "status": "delivered", "attempts": "1"},
+		Token:  "secret",
+	}).Return(nil).Once()
+
+	suite.handler.HandleDeliveryStatusCallback(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestHandleDeliveryStatusCallback_Query() {
+	req := httptest.NewRequest(http.MethodGet,
+		"/notification-callbacks/message/test-id/delivery-status?messageId=msg-1&status=delivered&token=secret",
+		nil)
+	req.SetPathValue("id", testSenderID)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().RecordDeliveryStatus(mock.Anything, testSenderID, common.DeliveryStatusCallback{
+		Params: map[string]string{"messageId": "msg-1", "status": "delivered"},
+		Token:  "secret",
+	}).Return(nil).Once()
+
+	suite.handler.HandleDeliveryStatusCallback(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestHandleDeliveryStatusCallback_WithFailure() {
+	cases := []struct {
+		name       string
+		svcErr     *serviceerror.ServiceError
+		wantStatus int
+	}{
+		{name: "InvalidCallback", svcErr: &ErrorInvalidDeliveryStatusCallback, wantStatus: http.StatusUnauthorized},
+		{name: "SenderNotFound", svcErr: &ErrorSenderNotFound, wantStatus: http.StatusNotFound},
+		{name: "ServerError", svcErr: &serviceerror.InternalServerError, wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			mockService := newDeliveryStatusServiceInterfaceMock(suite.T())
+			handler := newDeliveryStatusHandler(mockService)
+			req := httptest.NewRequest(http.MethodPost, "/notification-callbacks/message/test-id/delivery-status",
+				strings.NewReader("messageId=msg-1"))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.SetPathValue("id", testSenderID)
+			rr := httptest.NewRecorder()
+
+			mockService.EXPECT().RecordDeliveryStatus(mock.Anything, testSenderID, mock.Anything).
+				Return(tc.svcErr).Once()
+
+			handler.HandleDeliveryStatusCallback(rr, req)
+			suite.Equal(tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestHandleDeliveryStatusCallback_InvalidJSON() {
+	req := httptest.NewRequest(http.MethodPost, "/notification-callbacks/message/test-id/delivery-status",
+		strings.NewReader("{invalid"))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetPathValue("id", testSenderID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleDeliveryStatusCallback(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestHandleDeliveryStatusListRequest() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	statuses := []common.DeliveryStatus{{
+		ID:             "status-1",
+		SenderID:       testSenderID,
+		MessageID:      "SM123",
+		Recipient:      "********4567",
+		State:          common.DeliveryStateFailed,
+		ProviderStatus: "undelivered",
+		ErrorCode:      "30003",
+		CreatedAt:      createdAt,
+	}}
+	req := httptest.NewRequest(http.MethodGet, "/notification-senders/message/test-id/delivery-status?limit=5", nil)
+	req.SetPathValue("id", testSenderID)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().ListDeliveryStatuses(mock.Anything, testSenderID, 5).Return(statuses, nil).Once()
+
+	suite.handler.HandleDeliveryStatusListRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var res []common.DeliveryStatusResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Len(res, 1)
+	suite.Equal("SM123", res[0].MessageID)
+	suite.Equal(common.DeliveryStateFailed, res[0].State)
+	suite.Equal("30003", res[0].ErrorCode)
+}
+
+func (suite *DeliveryStatusHandlerTestSuite) TestHandleDeliveryStatusListRequest_InvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/notification-senders/message/test-id/delivery-status?limit=abc",
+		nil)
+	req.SetPathValue("id", testSenderID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleDeliveryStatusListRequest(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // SHA-1 is mandated by the Twilio request signature scheme
+	"crypto/subtle"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// defaultDeliveryStatusLimit is the default number of delivery statuses returned in a listing.
+	defaultDeliveryStatusLimit = 20
+	// maxDeliveryStatusLimit is the maximum number of delivery statuses returned in a listing.
+	maxDeliveryStatusLimit = 100
+)
+
+// twilioStatusMap maps Twilio message statuses to normalized delivery states.
+var twilioStatusMap = map[string]common.DeliveryState{
+	"accepted":    common.DeliveryStateAccepted,
+	"scheduled":   common.DeliveryStateAccepted,
+	"queued":      common.DeliveryStateAccepted,
+	"sending":     common.DeliveryStateSent,
+	"sent":        common.DeliveryStateSent,
+	"delivered":   common.DeliveryStateDelivered,
+	"undelivered": common.DeliveryStateFailed,
+	"failed":      common.DeliveryStateFailed,
+	"canceled":    common.DeliveryStateFailed,
+}
+
+// vonageStatusMap maps Vonage delivery receipt statuses to normalized delivery states.
+var vonageStatusMap = map[string]common.DeliveryState{
+	"accepted":  common.DeliveryStateAccepted,
+	"buffered":  common.DeliveryStateAccepted,
+	"delivered": common.DeliveryStateDelivered,
+	"expired":   common.DeliveryStateFailed,
+	"failed":    common.DeliveryStateFailed,
+	"rejected":  common.DeliveryStateFailed,
+}
+
+// deliveryStatusServiceInterface defines the interface for recording and querying provider delivery statuses.
+type deliveryStatusServiceInterface interface {
+	RecordDeliveryStatus(ctx context.Context, senderID string,
+		callback common.DeliveryStatusCallback) *serviceerror.ServiceError
+	ListDeliveryStatuses(ctx context.Context, senderID string, limit int) (
+		[]common.DeliveryStatus, *serviceerror.ServiceError)
+}
+
+// deliveryStatusService implements deliveryStatusServiceInterface.
+type deliveryStatusService struct {
+	senderMgtService NotificationSenderMgtSvcInterface
+	store            deliveryStatusStoreInterface
+	logger           *log.Logger
+}
+
+// newDeliveryStatusService returns a new instance of deliveryStatusServiceInterface.
+func newDeliveryStatusService(senderMgtService NotificationSenderMgtSvcInterface,
+	store deliveryStatusStoreInterface) deliveryStatusServiceInterface {
+	return &deliveryStatusService{
+		senderMgtService: senderMgtService,
+		store:            store,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeliveryStatusService")),
+	}
+}
+
+// RecordDeliveryStatus authenticates a provider delivery status callback and records the reported status.
+func (s *deliveryStatusService) RecordDeliveryStatus(ctx context.Context, senderID string,
+	callback common.DeliveryStatusCallback) *serviceerror.ServiceError {
+	sender, svcErr := s.senderMgtService.GetSender(ctx, senderID)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	var status *common.DeliveryStatus
+	switch sender.Provider {
+	case common.MessageProviderTypeTwilio:
+		if !verifyTwilioSignature(*sender, callback) {
+			s.logger.Debug("Twilio delivery status callback signature verification failed",
+				log.String("senderId", senderID))
+			return &ErrorInvalidDeliveryStatusCallback
+		}
+		status = parseDeliveryStatus(callback.Params, "MessageSid", "MessageStatus", "To", "ErrorCode",
+			twilioStatusMap)
+	case common.MessageProviderTypeVonage:
+		if !verifyCallbackToken(*sender, callback) {
+			return &ErrorInvalidDeliveryStatusCallback
+		}
+		status = parseDeliveryStatus(callback.Params, "messageId", "status", "msisdn", "err-code",
+			vonageStatusMap)
+	case common.MessageProviderTypeCustom:
+		if !verifyCallbackToken(*sender, callback) {
+			return &ErrorInvalidDeliveryStatusCallback
+		}
+		status = parseDeliveryStatus(callback.Params, "messageId", "status", "recipient", "errorCode", nil)
+	default:
+		return &ErrorInvalidProvider
+	}
+	if status == nil {
+		return &ErrorInvalidDeliveryStatusCallback
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	status.ID = id
+	status.SenderID = senderID
+	status.CreatedAt = time.Now().UTC()
+
+	if err := s.store.createDeliveryStatus(ctx, *status); err != nil {
+		s.logger.Error("Failed to record delivery status", log.String("senderId", senderID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Recorded delivery status", log.String("senderId", senderID),
+		log.String("messageId", status.MessageID), log.String("state", string(status.State)))
+	return nil
+}
+
+// ListDeliveryStatuses retrieves the most recent delivery statuses recorded for a sender.
+func (s *deliveryStatusService) ListDeliveryStatuses(ctx context.Context, senderID string, limit int) (
+	[]common.DeliveryStatus, *serviceerror.ServiceError) {
+	if limit <= 0 {
+		return nil, &ErrorInvalidLimit
+	}
+	if limit > maxDeliveryStatusLimit {
+		limit = maxDeliveryStatusLimit
+	}
+
+	if _, svcErr := s.senderMgtService.GetSender(ctx, senderID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	statuses, err := s.store.listDeliveryStatuses(ctx, senderID, limit)
+	if err != nil {
+		s.logger.Error("Failed to list delivery statuses", log.String("senderId", senderID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return statuses, nil
+}
+
+// parseDeliveryStatus extracts a delivery status from callback parameters using the given parameter names.
+// When statusMap is nil the provider status is expected to already be a normalized delivery state.
+func parseDeliveryStatus(params map[string]string, messageIDKey, statusKey, recipientKey, errorCodeKey string,
+	statusMap map[string]common.DeliveryState) *common.DeliveryStatus {
+	messageID := params[messageIDKey]
+	providerStatus := params[statusKey]
+	if messageID == "" || providerStatus == "" {
+		return nil
+	}
+
+	state := common.DeliveryStateUnknown
+	if statusMap != nil {
+		if mapped, ok := statusMap[strings.ToLower(providerStatus)]; ok {
+			state = mapped
+		}
+	} else {
+		switch normalized := common.DeliveryState(strings.ToUpper(providerStatus)); normalized {
+		case common.DeliveryStateAccepted, common.DeliveryStateSent, common.DeliveryStateDelivered,
+			common.DeliveryStateFailed:
+			state = normalized
+		}
+	}
+
+	return &common.DeliveryStatus{
+		MessageID:      messageID,
+		Recipient:      maskRecipient(params[recipientKey]),
+		State:          state,
+		ProviderStatus: providerStatus,
+		ErrorCode:      params[errorCodeKey],
+	}
+}
+
+// verifyTwilioSignature validates the X-Twilio-Signature of a callback against the sender's auth token.
+// The signature is the Base64 encoded HMAC-SHA1 of the callback URL followed by the sorted POST parameters.
+func verifyTwilioSignature(sender common.NotificationSenderDTO, callback common.DeliveryStatusCallback) bool {
+	authToken := getSenderPropertyValue(sender, common.TwilioPropKeyAuthToken)
+	callbackURL := getSenderPropertyValue(sender, common.TwilioPropKeyStatusCallbackURL)
+	if authToken == "" || callbackURL == "" || callback.Signature == "" {
+		return false
+	}
+
+	keys := make([]string, 0, len(callback.Params))
+	for key := range callback.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var data strings.Builder
+	data.WriteString(callbackURL)
+	for _, key := range keys {
+		data.WriteString(key)
+		data.WriteString(callback.Params[key])
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(data.String()))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(callback.Signature))
+}
+
+// verifyCallbackToken validates the shared callback token configured for providers without request signing.
+func verifyCallbackToken(sender common.NotificationSenderDTO, callback common.DeliveryStatusCallback) bool {
+	expected := getSenderPropertyValue(sender, common.PropKeyCallbackToken)
+	if expected == "" || callback.Token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(callback.Token)) == 1
+}
+
+// getSenderPropertyValue returns the value of the named sender property, or an empty string if absent.
+func getSenderPropertyValue(sender common.NotificationSenderDTO, name string) string {
+	for _, prop := range sender.Properties {
+		if prop.GetName() != name {
+			continue
+		}
+		value, err := prop.GetValue()
+		if err != nil {
+			return ""
+		}
+		return value
+	}
+	return ""
+}
+
+// maskRecipient masks a recipient address leaving only the last four characters visible.
+func maskRecipient(recipient string) string {
+	const visibleChars = 4
+	if len(recipient) <= visibleChars {
+		return strings.Repeat("*", len(recipient))
+	}
+	return strings.Repeat("*", len(recipient)-visibleChars) + recipient[len(recipient)-visibleChars:]
+}
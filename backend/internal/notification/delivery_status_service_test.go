@@ -0,0 +1,304 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // SHA-1 is mandated by the Twilio request signature scheme
+	"encoding/base64"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+const (
+	testCallbackURL   = "https://thunder.example.com/notification-callbacks/message/test-id/delivery-status"
+	testAuthToken     = "test-auth-token"
+	testCallbackToken = "test-callback-token"
+)
+
+type DeliveryStatusServiceTestSuite struct {
+	suite.Suite
+	mockMgtService *NotificationSenderMgtSvcInterfaceMock
+	mockStore      *deliveryStatusStoreInterfaceMock
+	service        deliveryStatusServiceInterface
+}
+
+func TestDeliveryStatusServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryStatusServiceTestSuite))
+}
+
+func (suite *DeliveryStatusServiceTestSuite) SetupSuite() {
+	testConfig := &config.Config{
+		Crypto: config.CryptoConfig{
+			Encryption: config.EncryptionConfig{
+				Key: "0579f866ac7c9273580d0ff163fa01a7b2401a7ff3ddc3e3b14ae3136fa6025e",
+			},
+		},
+	}
+	err := config.InitializeServerRuntime("", testConfig)
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *DeliveryStatusServiceTestSuite) SetupTest() {
+	suite.mockMgtService = NewNotificationSenderMgtSvcInterfaceMock(suite.T())
+	suite.mockStore = newDeliveryStatusStoreInterfaceMock(suite.T())
+	suite.service = newDeliveryStatusService(suite.mockMgtService, suite.mockStore)
+}
+
+func (suite *DeliveryStatusServiceTestSuite) TestRecordDeliveryStatus_Twilio() {
+	sender := getTwilioCallbackSender()
+	params := map[string]string{
+		"MessageSid":    "SM123",
+		"MessageStatus": "undelivered",
+		"To":            "+15551234567",
+		"ErrorCode":     "30003",
+	}
+	callback := common.DeliveryStatusCallback{
+		Params:    params,
+		Signature: signTwilioCallback(testCallbackURL, params),
+	}
+
+	suite.mockMgtService.EXPECT().GetSender(mock.Anything, testSenderID).Return(&sender, nil).Once()
+	suite.mockStore.EXPECT().createDeliveryStatus(mock.Anything, mock.MatchedBy(
+		func(s common.DeliveryStatus) bool {
+			return s.ID != "" && s.SenderID == testSenderID && s.MessageID == "SM123" &&
+				s.State == common.DeliveryStateFailed && s.ProviderStatus == "undelivered" &&
+				s.ErrorCode == "30003" && s.Recipient == "********4567" && !s.CreatedAt.IsZero()
+		})).Return(nil).Once()
+
+	err := suite.service.RecordDeliveryStatus(context.Background(), testSenderID, callback)
+	suite.Nil(err)
+}
+
+func (suite *DeliveryStatusServiceTestSuite) TestRecordDeliveryStatus_TwilioInvalidSignature() {
+	sender := getTwilioCallbackSender()
+	params := map[string]string{"MessageSid": "SM123", "MessageStatus": "delivered"}
+	callback := common.DeliveryStatusCallback{
+		Params:    params,
+		Signature: signTwilioCallback("https://attacker.example.com/callback", params),
+	}
+
+	suite.mockMgtService.EXPECT().GetSender(mock.Anything, testSenderID).Return(&sender, nil).Once()
+
+	err := suite.service.RecordDeliveryStatus(context.Background(), testSenderID, callback)
+	suite.NotNil(err)
+	suite.Equal(ErrorInvalidDeliveryStatusCallback.Code, err.Code)
+}
+
+func (suite *DeliveryStatusServiceTestSuite) TestRecordDeliveryStatus_Vonage() {
+	sender := getTokenCallbackSender(common.MessageProviderTypeVonage)
+	callback := common.DeliveryStatusCallback{
+		Params: map[string]string{
+			"messageId": "msg-1",
+			"status":    "delivered",
+			"msisdn":    "447700900000",
+		},
+		Token: testCallbackToken,
+	}
+
+	suite.mockMgtService.EXPECT().GetSender(mock.Anything, testSenderID).Return(&sender, nil).Once()
+	suite.mockStore.EXPECT().createDeliveryStatus(mock.Anything, mock.MatchedBy(
+		func(s common.DeliveryStatus) bool {
+			return s.MessageID == "msg-1" && s.State == common.DeliveryStateDelivered
+		})).Return(nil).Once()
+
+	err := suite.service.RecordDeliveryStatus(context.Background(), testSenderID, callback)
+	suite.Nil(err)
+}
+
+func (suite *DeliveryStatusServiceTestSuite) TestRecordDeliveryStatus_Custom() {
+	sender := getTokenCallbackSender(common.MessageProviderTypeCustom)
+	callback := common.DeliveryStatusCallback{
+		Params: map[string]string{
+			"messageId": "msg-2",
+			"status":    "sent",
+		},
+		Token: testCallbackToken,
+	}
+
+	suite.mockMgtService.EXPECT().GetSender(mock.Anything, testSenderID).Return(&sender, nil).Once()
+	suite.mockStore.EXPECT().createDeliveryStatus(mock.Anything, mock.MatchedBy(
+		func(s common.DeliveryStatus) bool {
+			return s.MessageID == "msg-2" && s.State == common.DeliveryStateSent
+		})).Return(nil).Once()
+
+	err := suite.service.RecordDeliveryStatus(context.Background(), testSenderID, callback)
+	suite.Nil(err)
+}
+
+func (suite *DeliveryStatusServiceTestSuite) TestRecordDeliveryStatus_WithFailure() {
+	cases := []struct {
+		name     string
+		setup    func(m *NotificationSenderMgtSvcInterfaceMock, s *deliveryStatusStoreInterfaceMock)
+		callback common.DeliveryStatusCallback
+		wantCode string
+	}{
+		{
+			name: "SenderNotFound",
+			setup: func(m *NotificationSenderMgtSvcInterfaceMock, s *deliveryStatusStoreInterfaceMock) {
+				m.EXPECT().GetSender(mock.Anything, testSenderID).Return(nil, &ErrorSenderNotFound).Once()
+			},
+			wantCode: ErrorSenderNotFound.Code,
+		},
+		{
+			name: "InvalidToken",
+			setup: func(m *NotificationSenderMgtSvcInterfaceMock, s *deliveryStatusStoreInterfaceMock) {
+				sender := getTokenCallbackSender(common.MessageProviderTypeVonage)
+				m.EXPECT().GetSender(mock.Anything, testSenderID).Return(&sender, nil).Once()
+			},
+			callback: common.DeliveryStatusCallback{
+				Params: map[string]string{"messageId": "msg-1", "status": "delivered"},
+				Token:  "wrong-token",
+			},
+			wantCode: ErrorInvalidDeliveryStatusCallback.Code,
+		},
+		{
+			name: "MissingMessageID",
+			setup: func(m *NotificationSenderMgtSvcInterfaceMock, s *deliveryStatusStoreInterfaceMock) {
+				sender := getTokenCallbackSender(common.MessageProviderTypeCustom)
+				m.EXPECT().GetSender(mock.Anything, testSenderID).Return(&sender, nil).Once()
+			},
+			callback: common.DeliveryStatusCallback{
+				Params: map[string]string{"status": "delivered"},
+				Token:  testCallbackToken,
+			},
+			wantCode: ErrorInvalidDeliveryStatusCallback.Code,
+		},
+		{
+			name: "StoreError",
+			setup: func(m *NotificationSenderMgtSvcInterfaceMock, s *deliveryStatusStoreInterfaceMock) {
+				sender := getTokenCallbackSender(common.MessageProviderTypeCustom)
+				m.EXPECT().GetSender(mock.Anything, testSenderID).Return(&sender, nil).Once()
+				s.EXPECT().createDeliveryStatus(mock.Anything, mock.Anything).
+					Return(errors.New("database error")).Once()
+			},
+			callback: common.DeliveryStatusCallback{
+				Params: map[string]string{"messageId": "msg-1", "status": "delivered"},
+				Token:  testCallbackToken,
+			},
+			wantCode: serviceerror.InternalServerError.Code,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			mockMgtService := NewNotificationSenderMgtSvcInterfaceMock(suite.T())
+			mockStore := newDeliveryStatusStoreInterfaceMock(suite.T())
+			tc.setup(mockMgtService, mockStore)
+			svc := newDeliveryStatusService(mockMgtService, mockStore)
+
+			err := svc.RecordDeliveryStatus(context.Background(), testSenderID, tc.callback)
+			suite.NotNil(err)
+			suite.Equal(tc.wantCode, err.Code)
+		})
+	}
+}
+
+func (suite *DeliveryStatusServiceTestSuite) TestListDeliveryStatuses() {
+	sender := getTwilioCallbackSender()
+	statuses := []common.DeliveryStatus{{ID: "status-1", SenderID: testSenderID, MessageID: "SM123"}}
+
+	suite.mockMgtService.EXPECT().GetSender(mock.Anything, testSenderID).Return(&sender, nil).Once()
+	suite.mockStore.EXPECT().listDeliveryStatuses(mock.Anything, testSenderID, maxDeliveryStatusLimit).
+		Return(statuses, nil).Once()
+
+	result, err := suite.service.ListDeliveryStatuses(context.Background(), testSenderID, 500)
+	suite.Nil(err)
+	suite.Equal(statuses, result)
+}
+
+func (suite *DeliveryStatusServiceTestSuite) TestListDeliveryStatuses_InvalidLimit() {
+	result, err := suite.service.ListDeliveryStatuses(context.Background(), testSenderID, 0)
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(ErrorInvalidLimit.Code, err.Code)
+}
+
+func (suite *DeliveryStatusServiceTestSuite) TestListDeliveryStatuses_StoreError() {
+	sender := getTwilioCallbackSender()
+	suite.mockMgtService.EXPECT().GetSender(mock.Anything, testSenderID).Return(&sender, nil).Once()
+	suite.mockStore.EXPECT().listDeliveryStatuses(mock.Anything, testSenderID, defaultDeliveryStatusLimit).
+		Return(nil, errors.New("database error")).Once()
+
+	result, err := suite.service.ListDeliveryStatuses(context.Background(), testSenderID,
+		defaultDeliveryStatusLimit)
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *DeliveryStatusServiceTestSuite) TestMaskRecipient() {
+	suite.Equal("", maskRecipient(""))
+	suite.Equal("***", maskRecipient("123"))
+	suite.Equal("*******4567", maskRecipient("+1555234567"))
+}
+
+func getTwilioCallbackSender() common.NotificationSenderDTO {
+	return common.NotificationSenderDTO{
+		ID:       testSenderID,
+		Name:     "Twilio Sender",
+		Type:     common.NotificationSenderTypeMessage,
+		Provider: common.MessageProviderTypeTwilio,
+		Properties: []cmodels.Property{
+			createTestProperty(common.TwilioPropKeyAccountSID, "AC00112233445566778899aabbccddeeff", true),
+			createTestProperty(common.TwilioPropKeyAuthToken, testAuthToken, true),
+			createTestProperty(common.TwilioPropKeyStatusCallbackURL, testCallbackURL, false),
+		},
+	}
+}
+
+func getTokenCallbackSender(provider common.MessageProviderType) common.NotificationSenderDTO {
+	return common.NotificationSenderDTO{
+		ID:       testSenderID,
+		Name:     "Callback Sender",
+		Type:     common.NotificationSenderTypeMessage,
+		Provider: provider,
+		Properties: []cmodels.Property{
+			createTestProperty(common.PropKeyCallbackToken, testCallbackToken, true),
+		},
+	}
+}
+
+func signTwilioCallback(callbackURL string, params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	data := callbackURL
+	for _, key := range keys {
+		data += key + params[key]
+	}
+	mac := hmac.New(sha1.New, []byte(testAuthToken))
+	mac.Write([]byte(data))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// deliveryStatusRetention is the duration for which recorded delivery statuses are retained.
+const deliveryStatusRetention = 7 * 24 * time.Hour
+
+// deliveryStatusStoreInterface defines the interface for delivery status storage operations.
+type deliveryStatusStoreInterface interface {
+	createDeliveryStatus(ctx context.Context, status common.DeliveryStatus) error
+	listDeliveryStatuses(ctx context.Context, senderID string, limit int) ([]common.DeliveryStatus, error)
+}
+
+// deliveryStatusStore is the runtime database implementation of deliveryStatusStoreInterface.
+type deliveryStatusStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newDeliveryStatusStore returns a new instance of deliveryStatusStoreInterface.
+func newDeliveryStatusStore() deliveryStatusStoreInterface {
+	return &deliveryStatusStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createDeliveryStatus records a delivery status reported by a provider.
+func (s *deliveryStatusStore) createDeliveryStatus(ctx context.Context, status common.DeliveryStatus) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateDeliveryStatus, status.ID, status.SenderID,
+		status.MessageID, status.Recipient, string(status.State), status.ProviderStatus, status.ErrorCode,
+		status.CreatedAt, status.CreatedAt.Add(deliveryStatusRetention), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// listDeliveryStatuses retrieves the most recent delivery statuses recorded for a sender.
+func (s *deliveryStatusStore) listDeliveryStatuses(ctx context.Context, senderID string,
+	limit int) ([]common.DeliveryStatus, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListDeliveryStatuses, senderID, limit, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	statuses := make([]common.DeliveryStatus, 0, len(results))
+	for _, row := range results {
+		status, err := buildDeliveryStatusFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build delivery status from result row: %w", err)
+		}
+		statuses = append(statuses, *status)
+	}
+
+	return statuses, nil
+}
+
+// buildDeliveryStatusFromResultRow constructs a DeliveryStatus from a database result row.
+func buildDeliveryStatusFromResultRow(row map[string]interface{}) (*common.DeliveryStatus, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	senderID, ok := row["sender_id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse sender_id as string")
+	}
+	messageID, ok := row["message_id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse message_id as string")
+	}
+	state, ok := row["state"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse state as string")
+	}
+
+	// Optional columns may be NULL.
+	recipient, _ := row["recipient"].(string)
+	providerStatus, _ := row["provider_status"].(string)
+	errorCode, _ := row["error_code"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.DeliveryStatus{
+		ID:             id,
+		SenderID:       senderID,
+		MessageID:      messageID,
+		Recipient:      recipient,
+		State:          common.DeliveryState(state),
+		ProviderStatus: providerStatus,
+		ErrorCode:      errorCode,
+		CreatedAt:      createdAt,
+	}, nil
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+type DeliveryStatusStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *deliveryStatusStore
+}
+
+func TestDeliveryStatusStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryStatusStoreTestSuite))
+}
+
+func (suite *DeliveryStatusStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &deliveryStatusStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *DeliveryStatusStoreTestSuite) TestCreateDeliveryStatus() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	status := common.DeliveryStatus{
+		ID:             "status-1",
+		SenderID:       testSenderID,
+		MessageID:      "SM123",
+		Recipient:      "********4567",
+		State:          common.DeliveryStateDelivered,
+		ProviderStatus: "delivered",
+		CreatedAt:      createdAt,
+	}
+
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateDeliveryStatus,
+		status.ID, status.SenderID, status.MessageID, status.Recipient, string(status.State),
+		status.ProviderStatus, status.ErrorCode, createdAt, createdAt.Add(deliveryStatusRetention),
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createDeliveryStatus(context.Background(), status)
+	suite.NoError(err)
+}
+
+func (suite *DeliveryStatusStoreTestSuite) TestCreateDeliveryStatus_WithError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createDeliveryStatus(context.Background(), common.DeliveryStatus{ID: "status-1"})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *DeliveryStatusStoreTestSuite) TestListDeliveryStatuses() {
+	rows := []map[string]interface{}{
+		{
+			"id":              "status-1",
+			"sender_id":       testSenderID,
+			"message_id":      "SM123",
+			"recipient":       "********4567",
+			"state":           "FAILED",
+			"provider_status": "undelivered",
+			"error_code":      "30003",
+			"created_at":      "2026-01-02 03:04:05.123456",
+		},
+	}
+
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListDeliveryStatuses,
+		testSenderID, 10, testDeploymentID).Return(rows, nil).Once()
+
+	statuses, err := suite.store.listDeliveryStatuses(context.Background(), testSenderID, 10)
+	suite.NoError(err)
+	suite.Len(statuses, 1)
+	suite.Equal("SM123", statuses[0].MessageID)
+	suite.Equal(common.DeliveryStateFailed, statuses[0].State)
+	suite.Equal("30003", statuses[0].ErrorCode)
+	suite.Equal(2026, statuses[0].CreatedAt.Year())
+}
+
+func (suite *DeliveryStatusStoreTestSuite) TestListDeliveryStatuses_WithFailure() {
+	cases := []struct {
+		name    string
+		setup   func()
+		wantErr string
+	}{
+		{
+			name: "GetDBClientError",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+			},
+			wantErr: "failed to get database client",
+		},
+		{
+			name: "QueryError",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListDeliveryStatuses,
+					testSenderID, 10, testDeploymentID).Return(nil, errors.New("query fail")).Once()
+			},
+			wantErr: "failed to execute query",
+		},
+		{
+			name: "InvalidRow",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListDeliveryStatuses,
+					testSenderID, 10, testDeploymentID).
+					Return([]map[string]interface{}{{"id": "status-1"}}, nil).Once()
+			},
+			wantErr: "failed to build delivery status",
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			tc.setup()
+			statuses, err := suite.store.listDeliveryStatuses(context.Background(), testSenderID, 10)
+			suite.Nil(statuses)
+			suite.Error(err)
+			suite.Contains(err.Error(), tc.wantErr)
+		})
+	}
+}
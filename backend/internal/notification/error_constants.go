@@ -221,4 +221,321 @@ var (
 			DefaultValue: "An error occurred while retrieving the message client",
 		},
 	}
+	// ErrorInvalidOUID is the error returned when an invalid organization unit ID is provided.
+	ErrorInvalidOUID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1016",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_ou_id",
+			DefaultValue: "Invalid organization unit ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_ou_id_description",
+			DefaultValue: "The provided organization unit ID is invalid",
+		},
+	}
+	// ErrorDuplicateSenderForOU is the error returned when another sender is already assigned to the OU.
+	ErrorDuplicateSenderForOU = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1017",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.duplicate_sender_for_ou",
+			DefaultValue: "Duplicate sender for organization unit",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.duplicate_sender_for_ou_description",
+			DefaultValue: "Another notification sender is already assigned to the organization unit",
+		},
+	}
+	// ErrorInvalidDeliveryStatusCallback is the error returned when a delivery status callback
+	// cannot be authenticated or parsed.
+	ErrorInvalidDeliveryStatusCallback = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1018",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_delivery_status_callback",
+			DefaultValue: "Invalid delivery status callback",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_delivery_status_callback_description",
+			DefaultValue: "The delivery status callback could not be verified or is missing required data",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when an invalid limit is provided.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1019",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_limit",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_limit_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidTriggerID is the error returned when an invalid notification trigger ID is provided.
+	ErrorInvalidTriggerID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1020",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_trigger_id",
+			DefaultValue: "Invalid trigger ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_trigger_id_description",
+			DefaultValue: "The provided notification trigger ID is invalid or empty",
+		},
+	}
+	// ErrorTriggerNotFound is the error returned when a notification trigger is not found.
+	ErrorTriggerNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1021",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.trigger_not_found",
+			DefaultValue: "Trigger not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.trigger_not_found_description",
+			DefaultValue: "The requested notification trigger could not be found",
+		},
+	}
+	// ErrorInvalidNotificationEvent is the error returned when an unsupported event is provided.
+	ErrorInvalidNotificationEvent = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1022",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_event",
+			DefaultValue: "Invalid event",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_event_description",
+			DefaultValue: "The provided event is not a supported notification event",
+		},
+	}
+	// ErrorInvalidTemplateScenario is the error returned when an unsupported template scenario is provided.
+	ErrorInvalidTemplateScenario = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1023",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_template_scenario",
+			DefaultValue: "Invalid template scenario",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_template_scenario_description",
+			DefaultValue: "The provided template scenario is not supported",
+		},
+	}
+	// ErrorDuplicateTrigger is the error returned when a trigger already exists for the event, channel and
+	// organization unit.
+	ErrorDuplicateTrigger = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1024",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.duplicate_trigger",
+			DefaultValue: "Duplicate trigger",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.duplicate_trigger_description",
+			DefaultValue: "A notification trigger already exists for the event, channel and organization unit",
+		},
+	}
+	// ErrorInvalidPushDevice is the error returned when a push device registration request is invalid.
+	ErrorInvalidPushDevice = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1025",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_push_device",
+			DefaultValue: "Invalid push device",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_push_device_description",
+			DefaultValue: "The push device must have a supported provider and a device token",
+		},
+	}
+	// ErrorPushDeviceNotFound is the error returned when a push device is not found for the user.
+	ErrorPushDeviceNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1026",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.push_device_not_found",
+			DefaultValue: "Push device not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.push_device_not_found_description",
+			DefaultValue: "The push device with the specified id does not exist",
+		},
+	}
+	// ErrorNoPushDevices is the error returned when the user has no registered push devices.
+	ErrorNoPushDevices = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1027",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.no_push_devices",
+			DefaultValue: "No push devices",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.no_push_devices_description",
+			DefaultValue: "The user has not registered any devices to receive push notifications",
+		},
+	}
+	// ErrorPushSenderNotConfigured is the error returned when no push sender is configured for the devices of a user.
+	ErrorPushSenderNotConfigured = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1028",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.push_sender_not_configured",
+			DefaultValue: "Push sender not configured",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.push_sender_not_configured_description",
+			DefaultValue: "No notification sender is configured for the push provider of the registered devices",
+		},
+	}
+	// ErrorUnauthenticatedUser is the error returned when the request does not carry an authenticated user.
+	ErrorUnauthenticatedUser = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1029",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.unauthenticated_user",
+			DefaultValue: "Unauthenticated user",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.unauthenticated_user_description",
+			DefaultValue: "The request must be made by an authenticated user",
+		},
+	}
+	// ErrorInvalidDeadLetterID is the error returned when an invalid dead-letter notification ID is provided.
+	ErrorInvalidDeadLetterID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1030",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_dead_letter_id",
+			DefaultValue: "Invalid dead-letter ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_dead_letter_id_description",
+			DefaultValue: "The provided dead-letter notification ID is invalid or empty",
+		},
+	}
+	// ErrorDeadLetterNotFound is the error returned when a dead-letter notification is not found.
+	ErrorDeadLetterNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1031",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.dead_letter_not_found",
+			DefaultValue: "Dead-letter notification not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.dead_letter_not_found_description",
+			DefaultValue: "The requested dead-letter notification could not be found",
+		},
+	}
+	// ErrorInvalidRateLimitID is the error returned when an invalid rate limit ID is provided.
+	ErrorInvalidRateLimitID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1032",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_rate_limit_id",
+			DefaultValue: "Invalid rate limit ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_rate_limit_id_description",
+			DefaultValue: "The provided rate limit ID is invalid or empty",
+		},
+	}
+	// ErrorRateLimitNotFound is the error returned when a rate limit is not found.
+	ErrorRateLimitNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1033",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.rate_limit_not_found",
+			DefaultValue: "Rate limit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.rate_limit_not_found_description",
+			DefaultValue: "The requested notification rate limit could not be found",
+		},
+	}
+	// ErrorInvalidRateLimit is the error returned when the limits or the window of a rate limit are invalid.
+	ErrorInvalidRateLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1034",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_rate_limit",
+			DefaultValue: "Invalid rate limit",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.notificationservice.invalid_rate_limit_description",
+			DefaultValue: "The limits must not be negative, at least one limit must be set and the window " +
+				"must be between 1 second and 1 day",
+		},
+	}
+	// ErrorDuplicateRateLimit is the error returned when a rate limit already exists for the channel and
+	// organization unit.
+	ErrorDuplicateRateLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1035",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.duplicate_rate_limit",
+			DefaultValue: "Duplicate rate limit",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.duplicate_rate_limit_description",
+			DefaultValue: "A notification rate limit already exists for the channel and organization unit",
+		},
+	}
+	// ErrorRateLimitExceeded is the error returned when too many messages are requested for a recipient or from
+	// a client IP address.
+	ErrorRateLimitExceeded = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1036",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.rate_limit_exceeded",
+			DefaultValue: "Rate limit exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.rate_limit_exceeded_description",
+			DefaultValue: "Too many messages have been requested. Please try again later",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when an invalid offset is provided.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1037",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_offset",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_offset_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+	// ErrorInvalidSendStatus is the error returned when an invalid send status filter is provided.
+	ErrorInvalidSendStatus = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1038",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_send_status",
+			DefaultValue: "Invalid send status",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_send_status_description",
+			DefaultValue: "The send status must be one of SUCCESS or FAILED",
+		},
+	}
+	// ErrorInvalidOTPOptions is the error returned when the length or the validity period of an OTP is invalid.
+	ErrorInvalidOTPOptions = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MNS-1039",
+		Error: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_otp_options",
+			DefaultValue: "Invalid OTP options",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.notificationservice.invalid_otp_options_description",
+			DefaultValue: "The OTP length must be between 4 and 10 and the validity period between 1 second and 1 hour",
+		},
+	}
 )
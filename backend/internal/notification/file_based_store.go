@@ -75,6 +75,18 @@ func (f *notificationFileBasedStore) getSenderByName(
 	return data.(*common.NotificationSenderDTO), nil
 }
 
+// getSenderByOUID implements notificationStoreInterface.
+func (f *notificationFileBasedStore) getSenderByOUID(
+	ctx context.Context, ouID string) (*common.NotificationSenderDTO, error) {
+	data, err := f.GenericFileBasedStore.GetByField(ouID, func(d interface{}) string {
+		return d.(*common.NotificationSenderDTO).OUID
+	})
+	if err != nil {
+		return nil, nil
+	}
+	return data.(*common.NotificationSenderDTO), nil
+}
+
 // listSenders implements notificationStoreInterface.
 func (f *notificationFileBasedStore) listSenders(ctx context.Context) ([]common.NotificationSenderDTO, error) {
 	list, err := f.GenericFileBasedStore.List()
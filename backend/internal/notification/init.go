@@ -22,7 +22,9 @@ import (
 	"net/http"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/email"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -31,9 +33,11 @@ import (
 )
 
 // Initialize creates and configures the notification service components.
+// The email client is optional and is used to deliver event notifications configured for the email channel.
 func Initialize(mux *http.ServeMux, jwtService jwt.JWTServiceInterface,
-	templateService template.TemplateServiceInterface) (
+	templateService template.TemplateServiceInterface, emailClient email.EmailClientInterface) (
 	NotificationSenderMgtSvcInterface, OTPServiceInterface, NotificationSenderServiceInterface,
+	NotificationTriggerServiceInterface, SendAuditServiceInterface, PushDeviceServiceInterface,
 	declarativeresource.ResourceExporter, error) {
 	var notificationStore notificationStoreInterface
 	var tx transaction.Transactioner
@@ -45,7 +49,7 @@ func Initialize(mux *http.ServeMux, jwtService jwt.JWTServiceInterface,
 		notificationStore, tx, err = newNotificationStore()
 		if err != nil {
 			log.GetLogger().Error("Failed to initialize notification store", log.Error(err))
-			return nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, nil, nil, err
 		}
 	}
 
@@ -53,22 +57,41 @@ func Initialize(mux *http.ServeMux, jwtService jwt.JWTServiceInterface,
 
 	if config.GetServerRuntime().Config.DeclarativeResources.Enabled {
 		if err := loadDeclarativeResources(notificationStore); err != nil {
-			return nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, nil, nil, err
 		}
 	}
 
-	otpService := newOTPService(mgtService, jwtService, templateService)
-	notificationSenderService := newNotificationSenderService(mgtService)
+	sendAuditService := newSendAuditService(newSendAuditStore())
+	rateLimitService := newRateLimitService(initializeRateLimitStore())
+	otpService := newOTPService(mgtService, jwtService, templateService, rateLimitService, sendAuditService)
+	deviceStore := newPushDeviceStore()
+	notificationSenderService := newNotificationSenderService(mgtService, deviceStore, sendAuditService)
 	handler := newMessageNotificationSenderHandler(mgtService, otpService)
-	registerRoutes(mux, handler)
+	deliveryStatusService := newDeliveryStatusService(mgtService, newDeliveryStatusStore())
+	deliveryHandler := newDeliveryStatusHandler(deliveryStatusService)
+	deadLetterStore := newDeadLetterStore()
+	deliveryQueue := newDeliveryQueue(notificationSenderService, emailClient, deadLetterStore, sendAuditService)
+	deadLetterHandler := newDeadLetterHandler(newDeadLetterService(deadLetterStore, deliveryQueue))
+	triggerService := newNotificationTriggerService(newTriggerStore(), mgtService, templateService, deliveryQueue)
+	triggerHandler := newNotificationTriggerHandler(triggerService)
+	deviceService := newPushDeviceService(deviceStore)
+	deviceHandler := newPushDeviceHandler(deviceService)
+	rateLimitHandler := newRateLimitHandler(rateLimitService)
+	sendAuditHandler := newSendAuditHandler(sendAuditService)
+	registerRoutes(mux, handler, deliveryHandler, triggerHandler, deviceHandler, deadLetterHandler, rateLimitHandler,
+		sendAuditHandler)
 
 	// Create and return exporter
 	exporter := newNotificationSenderExporter(mgtService)
-	return mgtService, otpService, notificationSenderService, exporter, nil
+	return mgtService, otpService, notificationSenderService, triggerService, sendAuditService, deviceService,
+		exporter, nil
 }
 
 // registerRoutes registers the HTTP routes for notification services.
-func registerRoutes(mux *http.ServeMux, handler *messageNotificationSenderHandler) {
+func registerRoutes(mux *http.ServeMux, handler *messageNotificationSenderHandler,
+	deliveryHandler *deliveryStatusHandler, triggerHandler *notificationTriggerHandler,
+	deviceHandler *pushDeviceHandler, deadLetterHandler *deadLetterHandler, rateLimitHandler *rateLimitHandler,
+	sendAuditHandler *sendAuditHandler) {
 	opts1 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -119,4 +142,122 @@ func registerRoutes(mux *http.ServeMux, handler *messageNotificationSenderHandle
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts3))
+
+	opts4 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /notification-senders/message/{id}/delivery-status",
+		deliveryHandler.HandleDeliveryStatusListRequest, opts4))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-senders/message/{id}/delivery-status",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts4))
+
+	mux.HandleFunc(middleware.WithCORS("GET /notification-triggers",
+		triggerHandler.HandleTriggerListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST /notification-triggers",
+		triggerHandler.HandleTriggerCreateRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-triggers",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /notification-triggers/{id}",
+		triggerHandler.HandleTriggerGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /notification-triggers/{id}",
+		triggerHandler.HandleTriggerUpdateRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /notification-triggers/{id}",
+		triggerHandler.HandleTriggerDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-triggers/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	opts5 := middleware.CORSOptions{
+		AllowedMethods:   []string{"DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/push-devices",
+		deviceHandler.HandleDeviceListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST /users/me/push-devices",
+		deviceHandler.HandleDeviceRegisterRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/push-devices",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/push-devices/{id}",
+		deviceHandler.HandleDeviceDeleteRequest, opts5))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/push-devices/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts5))
+
+	mux.HandleFunc(middleware.WithCORS("GET /notification-rate-limits",
+		rateLimitHandler.HandleRateLimitListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST /notification-rate-limits",
+		rateLimitHandler.HandleRateLimitCreateRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-rate-limits",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /notification-rate-limits/{id}",
+		rateLimitHandler.HandleRateLimitGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /notification-rate-limits/{id}",
+		rateLimitHandler.HandleRateLimitUpdateRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /notification-rate-limits/{id}",
+		rateLimitHandler.HandleRateLimitDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-rate-limits/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	mux.HandleFunc(middleware.WithCORS("GET /notification-dead-letters",
+		deadLetterHandler.HandleDeadLetterListRequest, opts4))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-dead-letters",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts4))
+	mux.HandleFunc(middleware.WithCORS("GET /notification-dead-letters/{id}",
+		deadLetterHandler.HandleDeadLetterGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /notification-dead-letters/{id}",
+		deadLetterHandler.HandleDeadLetterDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-dead-letters/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("POST /notification-dead-letters/{id}/resend",
+		deadLetterHandler.HandleDeadLetterResendRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-dead-letters/{id}/resend",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+
+	mux.HandleFunc(middleware.WithCORS("GET /notification-send-audits",
+		sendAuditHandler.HandleSendAuditListRequest, opts4))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /notification-send-audits",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts4))
+
+	// Delivery status callbacks are posted server-to-server by the messaging providers and are
+	// authenticated by the provider signature or the configured callback token.
+	mux.HandleFunc("POST /notification-callbacks/message/{id}/delivery-status",
+		deliveryHandler.HandleDeliveryStatusCallback)
+	mux.HandleFunc("GET /notification-callbacks/message/{id}/delivery-status",
+		deliveryHandler.HandleDeliveryStatusCallback)
+}
+
+// initializeRateLimitStore creates the rate limit store. The rate limit counters are kept in Redis when the
+// runtime store is Redis, and in the runtime database otherwise.
+func initializeRateLimitStore() rateLimitStoreInterface {
+	store := newRateLimitStore()
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisRateLimitStore(store, provider.GetRedisProvider(),
+			config.GetServerRuntime().Config.Server.Identifier)
+	}
+	return store
 }
@@ -84,13 +84,17 @@ func (suite *InitTestSuite) TearDownSuite() {
 }
 
 func (suite *InitTestSuite) TestInitialize() {
-	mgtService, otpService, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	mgtService, otpService, _, triggerService, sendAuditService, deviceService, _, err := Initialize(suite.mux,
+		suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	suite.NotNil(mgtService)
 	suite.NotNil(otpService)
 	suite.Implements((*NotificationSenderMgtSvcInterface)(nil), mgtService)
 	suite.Implements((*OTPServiceInterface)(nil), otpService)
+	suite.NotNil(triggerService)
+	suite.NotNil(sendAuditService)
+	suite.NotNil(deviceService)
 }
 
 // TestInitialize_WithDeclarativeResourcesEnabled_FileLoading tests that notification senders can be
@@ -218,7 +222,7 @@ properties:
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_ListEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodGet, "/notification-senders/message", nil)
@@ -230,7 +234,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_ListEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_CreateEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPost, "/notification-senders/message", nil)
@@ -242,7 +246,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_CreateEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_GetByIDEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodGet, "/notification-senders/message/test-id", nil)
@@ -254,7 +258,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_GetByIDEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_UpdateEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPut, "/notification-senders/message/test-id", nil)
@@ -266,7 +270,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_UpdateEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_DeleteEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodDelete, "/notification-senders/message/test-id", nil)
@@ -278,7 +282,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_DeleteEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_SendOTPEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPost, "/notification-senders/otp/send", nil)
@@ -290,7 +294,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_SendOTPEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_VerifyOTPEndpoint() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	req := httptest.NewRequest(http.MethodPost, "/notification-senders/otp/verify", nil)
@@ -302,7 +306,7 @@ func (suite *InitTestSuite) TestRegisterRoutes_VerifyOTPEndpoint() {
 }
 
 func (suite *InitTestSuite) TestRegisterRoutes_CORSPreflight() {
-	_, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err := Initialize(suite.mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.NoError(err)
 
 	paths := []string{
@@ -310,6 +314,16 @@ func (suite *InitTestSuite) TestRegisterRoutes_CORSPreflight() {
 		"/notification-senders/message/test-id",
 		"/notification-senders/otp/send",
 		"/notification-senders/otp/verify",
+		"/notification-triggers",
+		"/notification-triggers/test-id",
+		"/users/me/push-devices",
+		"/users/me/push-devices/test-id",
+		"/notification-rate-limits",
+		"/notification-rate-limits/test-id",
+		"/notification-dead-letters",
+		"/notification-dead-letters/test-id",
+		"/notification-dead-letters/test-id/resend",
+		"/notification-send-audits",
 	}
 
 	for _, path := range paths {
@@ -515,7 +529,7 @@ func (suite *InitTestSuite) TestInitialize_WithDeclarativeResourcesEnabled_Inval
 	mux := http.NewServeMux()
 
 	// Initialize should return an error due to invalid YAML
-	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to load notification sender resources")
 
@@ -593,7 +607,7 @@ properties:
 	mux := http.NewServeMux()
 
 	// Initialize should return an error due to validation failure
-	_, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService)
+	_, _, _, _, _, _, _, err = Initialize(mux, suite.mockJWTService, suite.mockTemplateService, nil)
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to load notification sender resources")
 
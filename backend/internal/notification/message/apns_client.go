@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package message
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	apnsProductionURL       = "https://api.push.apple.com"
+	apnsSandboxURL          = "https://api.sandbox.push.apple.com"
+	apnsDevicePath          = "/3/device/"
+	apnsLoggerComponentName = "APNsClient"
+	apnsReasonBadToken      = "BadDeviceToken"
+	apnsReasonUnregistered  = "Unregistered"
+	// apnsCoordinateSize is the byte length of each P-256 signature coordinate in a JWS ES256 signature.
+	apnsCoordinateSize = 32
+)
+
+// APNsClient implements the NotificationClientInterface for sending push notifications via the
+// Apple Push Notification service using token based authentication.
+type APNsClient struct {
+	name       string
+	url        string
+	keyID      string
+	teamID     string
+	bundleID   string
+	privateKey *ecdsa.PrivateKey
+	httpClient syshttp.HTTPClientInterface
+}
+
+// NewAPNsClient creates a new instance of APNsClient.
+func NewAPNsClient(sender common.NotificationSenderDTO) (NotificationClientInterface, error) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, apnsLoggerComponentName))
+
+	client := &APNsClient{}
+	client.name = sender.Name
+	client.url = apnsProductionURL
+
+	var privateKeyPEM string
+	for _, prop := range sender.Properties {
+		value, err := prop.GetValue()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get property value for %s: %w", prop.GetName(), err)
+		}
+
+		switch prop.GetName() {
+		case common.APNsPropKeyKeyID:
+			client.keyID = value
+		case common.APNsPropKeyTeamID:
+			client.teamID = value
+		case common.APNsPropKeyPrivateKey:
+			privateKeyPEM = value
+		case common.APNsPropKeyBundleID:
+			client.bundleID = value
+		case common.APNsPropKeyEnvironment:
+			if value == common.APNsEnvironmentSandbox {
+				client.url = apnsSandboxURL
+			}
+		default:
+			logger.Warn("Unknown property for APNs client", log.String("property", prop.GetName()))
+		}
+	}
+
+	key, err := parsePKCS8PrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("APNs private key is not an ECDSA key")
+	}
+	client.privateKey = ecKey
+	client.httpClient = syshttp.NewHTTP2ClientWithTimeout(httpClientTimeout)
+
+	return client, nil
+}
+
+// GetName returns the name of the APNs client.
+func (c *APNsClient) GetName() string {
+	return c.name
+}
+
+// IsChannelSupported reports whether the given channel is supported by APNs.
+func (c *APNsClient) IsChannelSupported(channel common.ChannelType) bool {
+	return channel == common.ChannelTypePush
+}
+
+// Send dispatches a notification via the requested channel.
+func (c *APNsClient) Send(channel common.ChannelType, data common.NotificationData) error {
+	switch channel {
+	case common.ChannelTypePush:
+		return c.sendPush(data)
+	default:
+		return fmt.Errorf("unsupported channel: %s", channel)
+	}
+}
+
+// sendPush sends an alert push notification to the device token in the recipient.
+func (c *APNsClient) sendPush(data common.NotificationData) error {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, apnsLoggerComponentName))
+	logger.Debug("Sending push notification via APNs", log.MaskedString("token", data.Recipient))
+
+	providerToken, err := c.getProviderToken()
+	if err != nil {
+		return err
+	}
+
+	// Custom data is delivered as top level keys alongside the reserved aps dictionary.
+	payload := map[string]interface{}{}
+	for key, value := range data.Data {
+		payload[key] = value
+	}
+	payload["aps"] = map[string]interface{}{
+		"alert": map[string]string{
+			"title": data.Title,
+			"body":  data.Body,
+		},
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.url+apnsDevicePath+url.PathEscape(data.Recipient),
+		bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", c.bundleID)
+	req.Header.Set("apns-push-type", "alert")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send HTTP request: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.Error("Failed to close response body", log.Error(closeErr))
+		}
+	}()
+
+	logger.Debug("Received response from APNs", log.Int("statusCode", resp.StatusCode))
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		var errResponse struct {
+			Reason string `json:"reason"`
+		}
+		_ = json.Unmarshal(bodyBytes, &errResponse)
+		if resp.StatusCode == http.StatusGone || errResponse.Reason == apnsReasonBadToken ||
+			errResponse.Reason == apnsReasonUnregistered {
+			return fmt.Errorf("apns push send failed, reason: %s: %w", errResponse.Reason, ErrInvalidDeviceToken)
+		}
+		logger.Error("Failed to send push notification via APNs", log.Int("statusCode", resp.StatusCode),
+			log.String("response", string(bodyBytes)))
+		return fmt.Errorf("apns push send failed, status: %d, response: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// getProviderToken creates the ES256 signed provider authentication token expected by APNs.
+func (c *APNsClient) getProviderToken() (string, error) {
+	return buildSignedJWT(
+		map[string]interface{}{"alg": "ES256", "kid": c.keyID},
+		map[string]interface{}{"iss": c.teamID, "iat": time.Now().Unix()},
+		func(input []byte) ([]byte, error) {
+			// JWS requires the raw R || S form rather than the ASN.1 encoding produced by cryptolab.
+			hashed := sha256.Sum256(input)
+			r, s, err := ecdsa.Sign(rand.Reader, c.privateKey, hashed[:])
+			if err != nil {
+				return nil, err
+			}
+			signature := make([]byte, 2*apnsCoordinateSize)
+			r.FillBytes(signature[:apnsCoordinateSize])
+			s.FillBytes(signature[apnsCoordinateSize:])
+			return signature, nil
+		})
+}
@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package message
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type APNsClientTestSuite struct {
+	suite.Suite
+	privateKey    *ecdsa.PrivateKey
+	privateKeyPEM string
+}
+
+func TestAPNsClientTestSuite(t *testing.T) {
+	suite.Run(t, new(APNsClientTestSuite))
+}
+
+func (suite *APNsClientTestSuite) SetupSuite() {
+	testConfig := &config.Config{
+		Crypto: config.CryptoConfig{
+			Encryption: config.EncryptionConfig{
+				Key: "0579f866ac7c9273580d0ff163fa01a7b2401a7ff3ddc3e3b14ae3136fa6025e",
+			},
+		},
+	}
+	err := config.InitializeServerRuntime("", testConfig)
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	suite.Require().NoError(err)
+	suite.privateKey = key
+	suite.privateKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}))
+}
+
+func (suite *APNsClientTestSuite) getAPNsSender(environment string) common.NotificationSenderDTO {
+	return common.NotificationSenderDTO{
+		Name:     "Test APNs",
+		Provider: common.MessageProviderTypeAPNs,
+		Properties: []cmodels.Property{
+			createProperty("key_id", "ABC123DEFG", false),
+			createProperty("team_id", "DEF123GHIJ", false),
+			createProperty("private_key", suite.privateKeyPEM, true),
+			createProperty("bundle_id", "com.example.app", false),
+			createProperty("environment", environment, false),
+		},
+	}
+}
+
+// verifyProviderToken verifies the ES256 signature of the provider token sent to APNs.
+func (suite *APNsClientTestSuite) verifyProviderToken(token string) {
+	parts := strings.Split(token, ".")
+	suite.Require().Len(parts, 3)
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	suite.Require().NoError(err)
+	suite.Require().Len(signature, 64)
+
+	hashed := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	suite.True(ecdsa.Verify(&suite.privateKey.PublicKey, hashed[:], r, s))
+}
+
+func (suite *APNsClientTestSuite) TestNewAPNsClient_Environments() {
+	client, err := NewAPNsClient(suite.getAPNsSender("production"))
+	suite.NoError(err)
+	suite.Equal("Test APNs", client.GetName())
+	suite.Equal(apnsProductionURL, client.(*APNsClient).url)
+
+	client, err = NewAPNsClient(suite.getAPNsSender("sandbox"))
+	suite.NoError(err)
+	suite.Equal(apnsSandboxURL, client.(*APNsClient).url)
+}
+
+func (suite *APNsClientTestSuite) TestNewAPNsClient_NonECDSAKey() {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	suite.Require().NoError(err)
+
+	sender := suite.getAPNsSender("production")
+	sender.Properties[2] = createProperty("private_key",
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})), true)
+
+	client, err := NewAPNsClient(sender)
+
+	suite.Error(err)
+	suite.Nil(client)
+}
+
+func (suite *APNsClientTestSuite) TestIsChannelSupported() {
+	client, _ := NewAPNsClient(suite.getAPNsSender("production"))
+
+	suite.True(client.IsChannelSupported(common.ChannelTypePush))
+	suite.False(client.IsChannelSupported(common.ChannelTypeSMS))
+}
+
+func (suite *APNsClientTestSuite) TestSendPush_Success() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		suite.Equal("/3/device/device-token", r.URL.Path)
+		suite.Equal("com.example.app", r.Header.Get("apns-topic"))
+		suite.Equal("alert", r.Header.Get("apns-push-type"))
+		suite.True(strings.HasPrefix(r.Header.Get("Authorization"), "bearer "))
+		suite.verifyProviderToken(strings.TrimPrefix(r.Header.Get("Authorization"), "bearer "))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewAPNsClient(suite.getAPNsSender("production"))
+	client.(*APNsClient).url = server.URL
+
+	err := client.Send(common.ChannelTypePush, common.NotificationData{
+		Recipient: "device-token",
+		Title:     "Sign in request",
+		Body:      "Approve the sign in request",
+		Data:      map[string]string{"requestId": "req-1"},
+	})
+
+	suite.NoError(err)
+}
+
+func (suite *APNsClientTestSuite) TestSendPush_InvalidToken() {
+	testCases := []struct {
+		name     string
+		status   int
+		response string
+	}{
+		{"Gone", http.StatusGone, `{"reason":"Unregistered"}`},
+		{"BadDeviceToken", http.StatusBadRequest, `{"reason":"BadDeviceToken"}`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			client, _ := NewAPNsClient(suite.getAPNsSender("production"))
+			client.(*APNsClient).url = server.URL
+
+			err := client.Send(common.ChannelTypePush, common.NotificationData{Recipient: "device-token"})
+
+			suite.ErrorIs(err, ErrInvalidDeviceToken)
+		})
+	}
+}
+
+func (suite *APNsClientTestSuite) TestSendPush_ServerError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"reason":"InvalidProviderToken"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewAPNsClient(suite.getAPNsSender("production"))
+	client.(*APNsClient).url = server.URL
+
+	err := client.Send(common.ChannelTypePush, common.NotificationData{Recipient: "device-token"})
+
+	suite.Error(err)
+	suite.NotErrorIs(err, ErrInvalidDeviceToken)
+}
+
+func (suite *APNsClientTestSuite) TestSend_UnsupportedChannel() {
+	client, _ := NewAPNsClient(suite.getAPNsSender("production"))
+
+	err := client.Send(common.ChannelTypeSMS, common.NotificationData{Recipient: "device-token"})
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "unsupported channel")
+}
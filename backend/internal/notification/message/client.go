@@ -20,6 +20,7 @@
 package message
 
 import (
+	"errors"
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/notification/common"
@@ -28,6 +29,10 @@ import (
 // httpClientTimeout is the timeout duration for the HTTP client.
 const httpClientTimeout = 10 * time.Second
 
+// ErrInvalidDeviceToken is returned by push clients when the provider reports that the device token
+// is no longer valid, e.g. because the app was uninstalled.
+var ErrInvalidDeviceToken = errors.New("invalid or unregistered device token")
+
 // NotificationClientInterface defines the provider client interface for sending notifications.
 type NotificationClientInterface interface {
 	GetName() string
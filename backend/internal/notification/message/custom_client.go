@@ -72,6 +72,8 @@ func NewCustomClient(sender common.NotificationSenderDTO) (NotificationClientInt
 			client.httpHeaders = headers
 		case common.CustomPropKeyContentType:
 			client.contentType = strings.ToUpper(value)
+		case common.PropKeyCallbackToken:
+			// Used only to authenticate delivery status callbacks.
 		default:
 			logger.Warn("Unknown property for Custom client", log.String("property", prop.GetName()))
 		}
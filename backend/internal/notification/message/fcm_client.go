@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package message
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	fcmURL                 = "https://fcm.googleapis.com/v1/projects/%s/messages:send"
+	fcmDefaultTokenURI     = "https://oauth2.googleapis.com/token"
+	fcmMessagingScope      = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmJWTBearerGrantType  = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	fcmAssertionLifetime   = time.Hour
+	fcmLoggerComponentName = "FCMClient"
+	fcmErrorUnregistered   = "UNREGISTERED"
+)
+
+// fcmServiceAccount holds the fields of a Firebase service account key used to obtain access tokens.
+type fcmServiceAccount struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// FCMClient implements the NotificationClientInterface for sending push notifications via
+// Firebase Cloud Messaging.
+type FCMClient struct {
+	name        string
+	url         string
+	tokenURI    string
+	clientEmail string
+	privateKey  crypto.PrivateKey
+	httpClient  syshttp.HTTPClientInterface
+}
+
+// NewFCMClient creates a new instance of FCMClient.
+func NewFCMClient(sender common.NotificationSenderDTO) (NotificationClientInterface, error) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, fcmLoggerComponentName))
+
+	client := &FCMClient{}
+	client.name = sender.Name
+
+	var projectID, serviceAccountJSON string
+	for _, prop := range sender.Properties {
+		value, err := prop.GetValue()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get property value for %s: %w", prop.GetName(), err)
+		}
+
+		switch prop.GetName() {
+		case common.FCMPropKeyProjectID:
+			projectID = value
+		case common.FCMPropKeyServiceAccount:
+			serviceAccountJSON = value
+		default:
+			logger.Warn("Unknown property for FCM client", log.String("property", prop.GetName()))
+		}
+	}
+
+	var serviceAccount fcmServiceAccount
+	if err := json.Unmarshal([]byte(serviceAccountJSON), &serviceAccount); err != nil {
+		return nil, fmt.Errorf("failed to parse service account: %w", err)
+	}
+	if serviceAccount.ClientEmail == "" {
+		return nil, errors.New("service account does not contain a client email")
+	}
+	privateKey, err := parsePKCS8PrivateKey(serviceAccount.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	client.url = fmt.Sprintf(fcmURL, url.PathEscape(projectID))
+	client.tokenURI = serviceAccount.TokenURI
+	if client.tokenURI == "" {
+		client.tokenURI = fcmDefaultTokenURI
+	}
+	client.clientEmail = serviceAccount.ClientEmail
+	client.privateKey = privateKey
+	client.httpClient = syshttp.NewHTTPClientWithTimeout(httpClientTimeout)
+
+	return client, nil
+}
+
+// GetName returns the name of the FCM client.
+func (c *FCMClient) GetName() string {
+	return c.name
+}
+
+// IsChannelSupported reports whether the given channel is supported by FCM.
+func (c *FCMClient) IsChannelSupported(channel common.ChannelType) bool {
+	return channel == common.ChannelTypePush
+}
+
+// Send dispatches a notification via the requested channel.
+func (c *FCMClient) Send(channel common.ChannelType, data common.NotificationData) error {
+	switch channel {
+	case common.ChannelTypePush:
+		return c.sendPush(data)
+	default:
+		return fmt.Errorf("unsupported channel: %s", channel)
+	}
+}
+
+// sendPush sends a push notification to the device token in the recipient via the FCM HTTP v1 API.
+func (c *FCMClient) sendPush(data common.NotificationData) error {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, fcmLoggerComponentName))
+	logger.Debug("Sending push notification via FCM", log.MaskedString("token", data.Recipient))
+
+	accessToken, err := c.getAccessToken()
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"message": map[string]interface{}{
+			"token": data.Recipient,
+			"notification": map[string]string{
+				"title": data.Title,
+				"body":  data.Body,
+			},
+			"data": data.Data,
+		},
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send HTTP request: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			logger.Error("Failed to close response body", log.Error(closeErr))
+		}
+	}()
+
+	logger.Debug("Received response from FCM", log.Int("statusCode", resp.StatusCode))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode == http.StatusNotFound || strings.Contains(string(bodyBytes), fcmErrorUnregistered) {
+			return fmt.Errorf("fcm push send failed, status: %d: %w", resp.StatusCode, ErrInvalidDeviceToken)
+		}
+		logger.Error("Failed to send push notification via FCM", log.Int("statusCode", resp.StatusCode),
+			log.String("response", string(bodyBytes)))
+		return fmt.Errorf("fcm push send failed, status: %d, response: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// getAccessToken exchanges a signed service account assertion for an OAuth 2.0 access token.
+func (c *FCMClient) getAccessToken() (string, error) {
+	now := time.Now()
+	assertion, err := buildSignedJWT(
+		map[string]interface{}{"alg": "RS256", "typ": "JWT"},
+		map[string]interface{}{
+			"iss":   c.clientEmail,
+			"scope": fcmMessagingScope,
+			"aud":   c.tokenURI,
+			"iat":   now.Unix(),
+			"exp":   now.Add(fcmAssertionLifetime).Unix(),
+		},
+		func(input []byte) ([]byte, error) {
+			return cryptolab.Generate(input, cryptolab.RSASHA256, c.privateKey)
+		})
+	if err != nil {
+		return "", err
+	}
+
+	formData := url.Values{}
+	formData.Set("grant_type", fcmJWTBearerGrantType)
+	formData.Set("assertion", assertion)
+
+	resp, err := c.httpClient.PostForm(c.tokenURI, formData)
+	if err != nil {
+		return "", fmt.Errorf("failed to request access token: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to obtain access token, status: %d", resp.StatusCode)
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return "", fmt.Errorf("failed to decode access token response: %w", err)
+	}
+	if tokenResponse.AccessToken == "" {
+		return "", errors.New("access token response does not contain an access token")
+	}
+
+	return tokenResponse.AccessToken, nil
+}
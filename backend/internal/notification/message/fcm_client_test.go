@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package message
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type FCMClientTestSuite struct {
+	suite.Suite
+	privateKeyPEM string
+}
+
+func TestFCMClientTestSuite(t *testing.T) {
+	suite.Run(t, new(FCMClientTestSuite))
+}
+
+func (suite *FCMClientTestSuite) SetupSuite() {
+	testConfig := &config.Config{
+		Crypto: config.CryptoConfig{
+			Encryption: config.EncryptionConfig{
+				Key: "0579f866ac7c9273580d0ff163fa01a7b2401a7ff3ddc3e3b14ae3136fa6025e",
+			},
+		},
+	}
+	err := config.InitializeServerRuntime("", testConfig)
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	suite.Require().NoError(err)
+	suite.privateKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}))
+}
+
+func (suite *FCMClientTestSuite) getFCMSender(tokenURI string) common.NotificationSenderDTO {
+	serviceAccount, _ := json.Marshal(map[string]string{
+		"client_email": "push@test-project.iam.gserviceaccount.com",
+		"private_key":  suite.privateKeyPEM,
+		"token_uri":    tokenURI,
+	})
+	return common.NotificationSenderDTO{
+		Name:     "Test FCM",
+		Provider: common.MessageProviderTypeFCM,
+		Properties: []cmodels.Property{
+			createProperty("project_id", "test-project", false),
+			createProperty("service_account", string(serviceAccount), true),
+		},
+	}
+}
+
+// newFCMTestServer returns a server that issues access tokens and responds to send requests with the
+// given status and body.
+func (suite *FCMClientTestSuite) newFCMTestServer(status int, response string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			suite.NoError(r.ParseForm())
+			suite.Equal(fcmJWTBearerGrantType, r.Form.Get("grant_type"))
+			suite.NotEmpty(r.Form.Get("assertion"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"test-access-token","expires_in":3600}`))
+			return
+		}
+
+		suite.Equal("Bearer test-access-token", r.Header.Get("Authorization"))
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]map[string]interface{}
+		suite.NoError(json.Unmarshal(body, &payload))
+		suite.Equal("device-token", payload["message"]["token"])
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+}
+
+func (suite *FCMClientTestSuite) TestNewFCMClient_Success() {
+	client, err := NewFCMClient(suite.getFCMSender(""))
+
+	suite.NoError(err)
+	suite.Equal("Test FCM", client.GetName())
+	fcmClient := client.(*FCMClient)
+	suite.Equal("https://fcm.googleapis.com/v1/projects/test-project/messages:send", fcmClient.url)
+	suite.Equal(fcmDefaultTokenURI, fcmClient.tokenURI)
+}
+
+func (suite *FCMClientTestSuite) TestNewFCMClient_InvalidServiceAccount() {
+	sender := common.NotificationSenderDTO{
+		Name: "Test FCM",
+		Properties: []cmodels.Property{
+			createProperty("project_id", "test-project", false),
+			createProperty("service_account", "not-json", true),
+		},
+	}
+
+	client, err := NewFCMClient(sender)
+
+	suite.Error(err)
+	suite.Nil(client)
+}
+
+func (suite *FCMClientTestSuite) TestNewFCMClient_InvalidPrivateKey() {
+	serviceAccount := `{"client_email":"push@test-project.iam.gserviceaccount.com","private_key":"invalid"}`
+	sender := common.NotificationSenderDTO{
+		Name: "Test FCM",
+		Properties: []cmodels.Property{
+			createProperty("service_account", serviceAccount, true),
+		},
+	}
+
+	client, err := NewFCMClient(sender)
+
+	suite.Error(err)
+	suite.Nil(client)
+}
+
+func (suite *FCMClientTestSuite) TestIsChannelSupported() {
+	client, _ := NewFCMClient(suite.getFCMSender(""))
+
+	suite.True(client.IsChannelSupported(common.ChannelTypePush))
+	suite.False(client.IsChannelSupported(common.ChannelTypeSMS))
+}
+
+func (suite *FCMClientTestSuite) TestSendPush_Success() {
+	server := suite.newFCMTestServer(http.StatusOK, `{"name":"projects/test-project/messages/1"}`)
+	defer server.Close()
+
+	client, _ := NewFCMClient(suite.getFCMSender(server.URL + "/token"))
+	client.(*FCMClient).url = server.URL + "/send"
+
+	err := client.Send(common.ChannelTypePush, common.NotificationData{
+		Recipient: "device-token",
+		Title:     "Sign in request",
+		Body:      "Approve the sign in request",
+		Data:      map[string]string{"requestId": "req-1"},
+	})
+
+	suite.NoError(err)
+}
+
+func (suite *FCMClientTestSuite) TestSendPush_UnregisteredToken() {
+	server := suite.newFCMTestServer(http.StatusNotFound,
+		`{"error":{"status":"NOT_FOUND","details":[{"errorCode":"UNREGISTERED"}]}}`)
+	defer server.Close()
+
+	client, _ := NewFCMClient(suite.getFCMSender(server.URL + "/token"))
+	client.(*FCMClient).url = server.URL + "/send"
+
+	err := client.Send(common.ChannelTypePush, common.NotificationData{Recipient: "device-token"})
+
+	suite.ErrorIs(err, ErrInvalidDeviceToken)
+}
+
+func (suite *FCMClientTestSuite) TestSendPush_ServerError() {
+	server := suite.newFCMTestServer(http.StatusInternalServerError, `{"error":{"status":"INTERNAL"}}`)
+	defer server.Close()
+
+	client, _ := NewFCMClient(suite.getFCMSender(server.URL + "/token"))
+	client.(*FCMClient).url = server.URL + "/send"
+
+	err := client.Send(common.ChannelTypePush, common.NotificationData{Recipient: "device-token"})
+
+	suite.Error(err)
+	suite.NotErrorIs(err, ErrInvalidDeviceToken)
+}
+
+func (suite *FCMClientTestSuite) TestSendPush_TokenRequestFailure() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, _ := NewFCMClient(suite.getFCMSender(server.URL + "/token"))
+
+	err := client.Send(common.ChannelTypePush, common.NotificationData{Recipient: "device-token"})
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to obtain access token")
+}
+
+func (suite *FCMClientTestSuite) TestSend_UnsupportedChannel() {
+	client, _ := NewFCMClient(suite.getFCMSender(""))
+
+	err := client.Send(common.ChannelTypeSMS, common.NotificationData{Recipient: "device-token"})
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "unsupported channel")
+}
@@ -43,6 +43,7 @@ type TwilioClient struct {
 	accountSID string
 	authToken  string
 	senderID   string
+	callback   string
 	httpClient syshttp.HTTPClientInterface
 }
 
@@ -66,6 +67,8 @@ func NewTwilioClient(sender common.NotificationSenderDTO) (NotificationClientInt
 			client.authToken = value
 		case common.TwilioPropKeySenderID:
 			client.senderID = value
+		case common.TwilioPropKeyStatusCallbackURL:
+			client.callback = value
 		default:
 			logger.Warn("Unknown property for Twilio client", log.String("property", prop.GetName()))
 		}
@@ -105,6 +108,9 @@ func (c *TwilioClient) sendSMS(data common.NotificationData) error {
 	formData.Set("To", data.Recipient)
 	formData.Set("From", c.senderID)
 	formData.Set("Body", data.Body)
+	if c.callback != "" {
+		formData.Set("StatusCallback", c.callback)
+	}
 
 	req, err := http.NewRequest(http.MethodPost, c.url, strings.NewReader(formData.Encode()))
 	if err != nil {
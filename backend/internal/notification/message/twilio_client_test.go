@@ -127,6 +127,27 @@ func (suite *TwilioClientTestSuite) TestSendSMS_Success() {
 	suite.NoError(err)
 }
 
+func (suite *TwilioClientTestSuite) TestSendSMS_WithStatusCallback() {
+	callbackURL := "https://thunder.example.com/notification-callbacks/message/sender-1/delivery-status"
+	sender := suite.getValidTwilioSender()
+	sender.Properties = append(sender.Properties, createProperty("status_callback_url", callbackURL, false))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.NoError(r.ParseForm())
+		suite.Equal(callbackURL, r.PostForm.Get("StatusCallback"))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client, err := NewTwilioClient(sender)
+	suite.NoError(err)
+	client.(*TwilioClient).url = server.URL
+
+	err = client.Send(common.ChannelTypeSMS, common.NotificationData{Recipient: "+15559876543", Body: "Test"})
+
+	suite.NoError(err)
+}
+
 func (suite *TwilioClientTestSuite) TestSendSMS_Error() {
 	sender := suite.getValidTwilioSender()
 	client, _ := NewTwilioClient(sender)
@@ -66,6 +66,8 @@ func NewVonageClient(sender common.NotificationSenderDTO) (NotificationClientInt
 			client.apiSecret = value
 		case common.VonagePropKeySenderID:
 			client.senderID = value
+		case common.PropKeyCallbackToken:
+			// Used only to authenticate delivery status callbacks.
 		default:
 			logger.Warn("Unknown property for Vonage client", log.String("property", prop.GetName()))
 		}
@@ -214,7 +214,11 @@ func (h *messageNotificationSenderHandler) HandleOTPSendRequest(w http.ResponseW
 		return
 	}
 
-	otpDTO := common.SendOTPDTO(*request)
+	otpDTO := common.SendOTPDTO{
+		Recipient: request.Recipient,
+		SenderID:  request.SenderID,
+		Channel:   request.Channel,
+	}
 	resultDTO, svcErr := h.otpService.SendOTP(ctx, otpDTO)
 	if svcErr != nil {
 		h.handleError(w, svcErr, "")
@@ -273,8 +277,10 @@ func (h *messageNotificationSenderHandler) handleError(w http.ResponseWriter,
 		switch svcErr.Code {
 		case ErrorSenderNotFound.Code:
 			statusCode = http.StatusNotFound
-		case ErrorDuplicateSenderName.Code:
+		case ErrorDuplicateSenderName.Code, ErrorDuplicateSenderForOU.Code:
 			statusCode = http.StatusConflict
+		case ErrorRateLimitExceeded.Code:
+			statusCode = http.StatusTooManyRequests
 		default:
 			statusCode = http.StatusBadRequest
 		}
@@ -297,6 +303,7 @@ func getDTOFromSenderRequest(sender *common.NotificationSenderRequest) (*common.
 	name := sysutils.SanitizeString(sender.Name)
 	description := sysutils.SanitizeString(sender.Description)
 	providerStr := sysutils.SanitizeString(sender.Provider)
+	ouID := sysutils.SanitizeString(sender.OUID)
 
 	// Sanitize properties
 	properties := make([]cmodels.Property, 0, len(sender.Properties))
@@ -318,6 +325,7 @@ func getDTOFromSenderRequest(sender *common.NotificationSenderRequest) (*common.
 		Description: description,
 		Type:        common.NotificationSenderTypeMessage,
 		Provider:    common.MessageProviderType(providerStr),
+		OUID:        ouID,
 		Properties:  properties,
 	}
 	return &senderDTO, nil
@@ -330,6 +338,7 @@ func getSenderResponseFromDTO(sender *common.NotificationSenderDTO) (common.Noti
 		Name:        sender.Name,
 		Description: sender.Description,
 		Provider:    sender.Provider,
+		OUID:        sender.OUID,
 	}
 
 	// Mask secret properties in the response.
@@ -38,6 +38,7 @@ type NotificationSenderMgtSvcInterface interface {
 	ListSenders(ctx context.Context) ([]common.NotificationSenderDTO, *serviceerror.ServiceError)
 	GetSender(ctx context.Context, id string) (*common.NotificationSenderDTO, *serviceerror.ServiceError)
 	GetSenderByName(ctx context.Context, name string) (*common.NotificationSenderDTO, *serviceerror.ServiceError)
+	GetSenderByOU(ctx context.Context, ouID string) (*common.NotificationSenderDTO, *serviceerror.ServiceError)
 	UpdateSender(ctx context.Context, id string, sender common.NotificationSenderDTO) (*common.NotificationSenderDTO,
 		*serviceerror.ServiceError)
 	DeleteSender(ctx context.Context, id string) *serviceerror.ServiceError
@@ -94,6 +95,18 @@ func (s *notificationSenderMgtService) CreateSender(
 			return errors.New("sender already exists")
 		}
 
+		// Ensure no other sender is assigned to the same organization unit
+		conflict, err := s.hasOUSenderConflict(txCtx, sender.ID, sender.OUID)
+		if err != nil {
+			return err
+		}
+		if conflict {
+			logger.Debug("Another sender is already assigned to the organization unit",
+				log.String("ouId", sender.OUID))
+			svcErr = &ErrorDuplicateSenderForOU
+			return errors.New("duplicate ou sender")
+		}
+
 		// Create the sender
 		err = s.notificationStore.createSender(txCtx, sender)
 		if err != nil {
@@ -116,6 +129,7 @@ func (s *notificationSenderMgtService) CreateSender(
 		Description: sender.Description,
 		Type:        sender.Type,
 		Provider:    sender.Provider,
+		OUID:        sender.OUID,
 		Properties:  sender.Properties,
 	}, nil
 }
@@ -181,6 +195,29 @@ func (s *notificationSenderMgtService) GetSenderByName(ctx context.Context, name
 	return sender, nil
 }
 
+// GetSenderByOU retrieves the notification sender assigned to an organization unit.
+func (s *notificationSenderMgtService) GetSenderByOU(ctx context.Context, ouID string) (
+	*common.NotificationSenderDTO, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationSenderMgtService"))
+	logger.Debug("Retrieving notification sender by organization unit", log.String("ouId", ouID))
+
+	if ouID == "" {
+		return nil, &ErrorInvalidOUID
+	}
+
+	sender, err := s.notificationStore.getSenderByOUID(ctx, ouID)
+	if err != nil {
+		logger.Error("Failed to retrieve notification sender", log.String("ouId", ouID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	if sender == nil {
+		return nil, &ErrorSenderNotFound
+	}
+
+	return sender, nil
+}
+
 // UpdateSender updates an existing notification sender
 func (s *notificationSenderMgtService) UpdateSender(ctx context.Context, id string,
 	sender common.NotificationSenderDTO) (*common.NotificationSenderDTO, *serviceerror.ServiceError) {
@@ -233,6 +270,18 @@ func (s *notificationSenderMgtService) UpdateSender(ctx context.Context, id stri
 			return errors.New("cannot change type")
 		}
 
+		// Ensure no other sender is assigned to the same organization unit
+		conflict, err := s.hasOUSenderConflict(txCtx, id, sender.OUID)
+		if err != nil {
+			return err
+		}
+		if conflict {
+			logger.Debug("Another sender is already assigned to the organization unit",
+				log.String("ouId", sender.OUID))
+			svcErr = &ErrorDuplicateSenderForOU
+			return errors.New("duplicate ou sender")
+		}
+
 		// Update the sender
 		if err := s.notificationStore.updateSender(txCtx, id, sender); err != nil {
 			return err
@@ -255,6 +304,7 @@ func (s *notificationSenderMgtService) UpdateSender(ctx context.Context, id stri
 		Description: sender.Description,
 		Type:        sender.Type,
 		Provider:    sender.Provider,
+		OUID:        sender.OUID,
 		Properties:  sender.Properties,
 	}, nil
 }
@@ -286,3 +336,17 @@ func (s *notificationSenderMgtService) DeleteSender(ctx context.Context, id stri
 
 	return nil
 }
+
+// hasOUSenderConflict checks whether a sender other than the given one is assigned to the organization unit.
+func (s *notificationSenderMgtService) hasOUSenderConflict(ctx context.Context, senderID, ouID string) (
+	bool, error) {
+	if ouID == "" {
+		return false, nil
+	}
+
+	existing, err := s.notificationStore.getSenderByOUID(ctx, ouID)
+	if err != nil {
+		return false, err
+	}
+	return existing != nil && existing.ID != senderID, nil
+}
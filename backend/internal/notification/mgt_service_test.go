@@ -247,6 +247,83 @@ func (suite *NotificationSenderMgtServiceTestSuite) TestGetSender_StoreError() {
 	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
 }
 
+func (suite *NotificationSenderMgtServiceTestSuite) TestCreateSender_DuplicateOU() {
+	sender := suite.getValidTwilioSender()
+	sender.OUID = "ou-1"
+	existing := suite.getValidVonageSender()
+	existing.ID = "other-sender"
+	existing.OUID = "ou-1"
+
+	suite.mockStore.EXPECT().getSenderByName(mock.Anything, sender.Name).Return(nil, nil).Once()
+	suite.mockStore.EXPECT().getSenderByOUID(mock.Anything, "ou-1").Return(&existing, nil).Once()
+
+	result, err := suite.service.CreateSender(context.Background(), sender)
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(ErrorDuplicateSenderForOU.Code, err.Code)
+}
+
+func (suite *NotificationSenderMgtServiceTestSuite) TestGetSenderByOU() {
+	sender := suite.getValidTwilioSender()
+	sender.ID = testSenderID
+	sender.OUID = "ou-1"
+	suite.mockStore.EXPECT().getSenderByOUID(mock.Anything, "ou-1").Return(&sender, nil).Once()
+
+	result, err := suite.service.GetSenderByOU(context.Background(), "ou-1")
+	suite.Nil(err)
+	suite.NotNil(result)
+	suite.Equal(testSenderID, result.ID)
+}
+
+func (suite *NotificationSenderMgtServiceTestSuite) TestGetSenderByOU_WithFailure() {
+	cases := []struct {
+		name     string
+		ouID     string
+		setup    func(*notificationStoreInterfaceMock)
+		wantCode string
+	}{
+		{
+			name:     "EmptyOUID",
+			ouID:     "",
+			wantCode: ErrorInvalidOUID.Code,
+		},
+		{
+			name: "NotFound",
+			ouID: "ou-1",
+			setup: func(m *notificationStoreInterfaceMock) {
+				m.EXPECT().getSenderByOUID(mock.Anything, "ou-1").Return(nil, nil).Once()
+			},
+			wantCode: ErrorSenderNotFound.Code,
+		},
+		{
+			name: "StoreError",
+			ouID: "ou-1",
+			setup: func(m *notificationStoreInterfaceMock) {
+				m.EXPECT().getSenderByOUID(mock.Anything, "ou-1").Return(nil, errors.New("database error")).Once()
+			},
+			wantCode: serviceerror.InternalServerError.Code,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			mockStore := newNotificationStoreInterfaceMock(t)
+			svc := &notificationSenderMgtService{
+				notificationStore: mockStore,
+				transactioner:     &fakeTransactioner{},
+			}
+			if tc.setup != nil {
+				tc.setup(mockStore)
+			}
+
+			result, err := svc.GetSenderByOU(context.Background(), tc.ouID)
+			require.Nil(t, result)
+			require.NotNil(t, err)
+			require.Equal(t, tc.wantCode, err.Code)
+		})
+	}
+}
+
 // GetSenderByName Tests
 func (suite *NotificationSenderMgtServiceTestSuite) TestGetSenderByName() {
 	cases := []struct {
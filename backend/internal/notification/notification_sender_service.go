@@ -20,8 +20,10 @@ package notification
 
 import (
 	"context"
+	"errors"
 
 	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/notification/message"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -30,21 +32,28 @@ import (
 type NotificationSenderServiceInterface interface {
 	Send(ctx context.Context, channel common.ChannelType, senderID string,
 		data common.NotificationData) *serviceerror.ServiceError
+	SendForOU(ctx context.Context, channel common.ChannelType, ouID string,
+		data common.NotificationData) *serviceerror.ServiceError
+	SendPush(ctx context.Context, userID string, pushMessage common.PushMessage) *serviceerror.ServiceError
 }
 
 // notificationSenderService implements NotificationSenderServiceInterface.
 type notificationSenderService struct {
 	senderMgtService NotificationSenderMgtSvcInterface
 	clientProvider   notificationClientProviderInterface
+	deviceStore      pushDeviceStoreInterface
+	auditService     SendAuditServiceInterface
 	logger           *log.Logger
 }
 
 // newNotificationSenderService returns a new instance of NotificationSenderServiceInterface.
-func newNotificationSenderService(
-	senderMgtService NotificationSenderMgtSvcInterface) NotificationSenderServiceInterface {
+func newNotificationSenderService(senderMgtService NotificationSenderMgtSvcInterface,
+	deviceStore pushDeviceStoreInterface, auditService SendAuditServiceInterface) NotificationSenderServiceInterface {
 	return &notificationSenderService{
 		senderMgtService: senderMgtService,
 		clientProvider:   newNotificationClientProvider(),
+		deviceStore:      deviceStore,
+		auditService:     auditService,
 		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationSenderService")),
 	}
 }
@@ -57,6 +66,96 @@ func (s *notificationSenderService) Send(ctx context.Context, channel common.Cha
 		return svcErr
 	}
 
+	return s.sendWithSender(ctx, channel, sender, data)
+}
+
+// SendForOU dispatches the notification via the sender assigned to the given organization unit.
+func (s *notificationSenderService) SendForOU(ctx context.Context, channel common.ChannelType, ouID string,
+	data common.NotificationData) *serviceerror.ServiceError {
+	sender, svcErr := s.senderMgtService.GetSenderByOU(ctx, ouID)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	return s.sendWithSender(ctx, channel, sender, data)
+}
+
+// SendPush delivers a push notification to every device registered by the user, using the first sender
+// configured for the push provider of each device. Devices whose token is rejected by the provider are
+// unregistered. The push succeeds if it is delivered to at least one device.
+func (s *notificationSenderService) SendPush(ctx context.Context, userID string,
+	pushMessage common.PushMessage) *serviceerror.ServiceError {
+	devices, err := s.deviceStore.listPushDevices(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list push devices", log.String("userID", userID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if len(devices) == 0 {
+		return &ErrorNoPushDevices
+	}
+
+	senders, svcErr := s.senderMgtService.ListSenders(ctx)
+	if svcErr != nil {
+		return svcErr
+	}
+	clients := make(map[common.MessageProviderType]message.NotificationClientInterface)
+	for i := range senders {
+		if !isPushProvider(senders[i].Provider) || clients[senders[i].Provider] != nil {
+			continue
+		}
+		_client, svcErr := s.clientProvider.GetClient(senders[i])
+		if svcErr != nil {
+			s.logger.Error("Failed to create push client", log.String("senderID", senders[i].ID))
+			continue
+		}
+		clients[senders[i].Provider] = _client
+	}
+
+	delivered := false
+	var lastErr *serviceerror.ServiceError
+	for _, device := range devices {
+		_client, ok := clients[device.Provider]
+		if !ok {
+			lastErr = &ErrorPushSenderNotConfigured
+			continue
+		}
+
+		data := common.NotificationData{
+			Recipient: device.Token,
+			Title:     pushMessage.Title,
+			Body:      pushMessage.Body,
+			Data:      pushMessage.Data,
+		}
+		err := _client.Send(common.ChannelTypePush, data)
+		s.auditService.RecordSend(ctx, newSendAuditRecord(common.ChannelTypePush, "", device.Token, "",
+			string(device.Provider), err))
+		if err != nil {
+			if errors.Is(err, message.ErrInvalidDeviceToken) {
+				s.logger.Debug("Removing push device with invalid token", log.String("deviceID", device.ID))
+				if _, err := s.deviceStore.deletePushDevice(ctx, userID, device.ID); err != nil {
+					s.logger.Error("Failed to remove push device", log.String("deviceID", device.ID),
+						log.Error(err))
+				}
+				lastErr = &ErrorNoPushDevices
+				continue
+			}
+			s.logger.Error("Failed to send push notification", log.String("deviceID", device.ID), log.Error(err))
+			lastErr = &serviceerror.InternalServerError
+			continue
+		}
+		delivered = true
+	}
+
+	if !delivered {
+		return lastErr
+	}
+
+	return nil
+}
+
+// sendWithSender dispatches the notification using the provider client of the given sender.
+func (s *notificationSenderService) sendWithSender(ctx context.Context, channel common.ChannelType,
+	sender *common.NotificationSenderDTO, data common.NotificationData) *serviceerror.ServiceError {
 	if sender.Type != common.NotificationSenderTypeMessage {
 		return &ErrorRequestedSenderIsNotOfExpectedType
 	}
@@ -70,7 +169,10 @@ func (s *notificationSenderService) Send(ctx context.Context, channel common.Cha
 		return &ErrorUnsupportedChannel
 	}
 
-	if err := _client.Send(channel, data); err != nil {
+	err := _client.Send(channel, data)
+	s.auditService.RecordSend(ctx, newSendAuditRecord(channel, data.Template, data.Recipient, sender.ID,
+		string(sender.Provider), err))
+	if err != nil {
 		s.logger.Error("Failed to send notification", log.String("channel", string(channel)), log.Error(err))
 		return &serviceerror.InternalServerError
 	}
@@ -21,12 +21,14 @@ package notification
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/notification/message"
 	"github.com/thunder-id/thunderid/internal/system/cmodels"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -38,6 +40,8 @@ type NotificationSenderServiceTestSuite struct {
 	suite.Suite
 	mockSenderMgtSvc   *NotificationSenderMgtSvcInterfaceMock
 	mockClientProvider *notificationClientProviderInterfaceMock
+	mockDeviceStore    *pushDeviceStoreInterfaceMock
+	mockAuditService   *SendAuditServiceInterfaceMock
 	service            *notificationSenderService
 }
 
@@ -61,9 +65,14 @@ func (suite *NotificationSenderServiceTestSuite) SetupSuite() {
 func (suite *NotificationSenderServiceTestSuite) SetupTest() {
 	suite.mockSenderMgtSvc = NewNotificationSenderMgtSvcInterfaceMock(suite.T())
 	suite.mockClientProvider = newNotificationClientProviderInterfaceMock(suite.T())
+	suite.mockDeviceStore = newPushDeviceStoreInterfaceMock(suite.T())
+	suite.mockAuditService = NewSendAuditServiceInterfaceMock(suite.T())
+	suite.mockAuditService.EXPECT().RecordSend(mock.Anything, mock.Anything).Maybe()
 	suite.service = &notificationSenderService{
 		senderMgtService: suite.mockSenderMgtSvc,
 		clientProvider:   suite.mockClientProvider,
+		deviceStore:      suite.mockDeviceStore,
+		auditService:     suite.mockAuditService,
 		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationSenderService")),
 	}
 }
@@ -91,8 +100,19 @@ func (suite *NotificationSenderServiceTestSuite) TestSendSMS_Success() {
 	mm.EXPECT().Send(common.ChannelTypeSMS, mock.Anything).Return(nil).Once()
 	suite.mockClientProvider.EXPECT().GetClient(mock.Anything).Return(mm, nil).Once()
 
+	mockAuditService := NewSendAuditServiceInterfaceMock(suite.T())
+	mockAuditService.EXPECT().RecordSend(mock.Anything, common.SendAuditRecord{
+		Channel:   common.ChannelTypeSMS,
+		Template:  "USER_INVITE",
+		Recipient: "+94714627887",
+		SenderID:  "sender-001",
+		Provider:  string(sender.Provider),
+		Status:    common.SendStatusSuccess,
+	}).Once()
+	suite.service.auditService = mockAuditService
+
 	err := suite.service.Send(context.Background(), common.ChannelTypeSMS, "sender-001",
-		common.NotificationData{Recipient: "+94714627887", Body: "Test message"})
+		common.NotificationData{Recipient: "+94714627887", Body: "Test message", Template: "USER_INVITE"})
 	suite.Nil(err)
 }
 
@@ -154,8 +174,117 @@ func (suite *NotificationSenderServiceTestSuite) TestSendSMS_ClientSendError() {
 	mm.EXPECT().Send(common.ChannelTypeSMS, mock.Anything).Return(errors.New("network error")).Once()
 	suite.mockClientProvider.EXPECT().GetClient(mock.Anything).Return(mm, nil).Once()
 
+	mockAuditService := NewSendAuditServiceInterfaceMock(suite.T())
+	mockAuditService.EXPECT().RecordSend(mock.Anything, mock.MatchedBy(func(record common.SendAuditRecord) bool {
+		return record.Status == common.SendStatusFailed && record.ProviderResponse == "network error"
+	})).Once()
+	suite.service.auditService = mockAuditService
+
 	err := suite.service.Send(context.Background(), common.ChannelTypeSMS, "sender-001",
 		common.NotificationData{Recipient: "+94714627887", Body: "Test message"})
 	suite.NotNil(err)
 	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
 }
+
+func (suite *NotificationSenderServiceTestSuite) getPushSenders() []common.NotificationSenderDTO {
+	return []common.NotificationSenderDTO{
+		{ID: "sms-sender", Type: common.NotificationSenderTypeMessage, Provider: common.MessageProviderTypeTwilio},
+		{ID: "fcm-sender", Type: common.NotificationSenderTypeMessage, Provider: common.MessageProviderTypeFCM},
+	}
+}
+
+func (suite *NotificationSenderServiceTestSuite) TestSendPush_Success() {
+	devices := []common.PushDevice{
+		{ID: "device-1", UserID: "user-1", Provider: common.MessageProviderTypeFCM, Token: "token-1"},
+	}
+	suite.mockDeviceStore.EXPECT().listPushDevices(mock.Anything, "user-1").Return(devices, nil).Once()
+	suite.mockSenderMgtSvc.EXPECT().ListSenders(mock.Anything).Return(suite.getPushSenders(), nil).Once()
+
+	mm := messagemock.NewNotificationClientInterfaceMock(suite.T())
+	mm.EXPECT().Send(common.ChannelTypePush, common.NotificationData{
+		Recipient: "token-1",
+		Title:     "Sign in request",
+		Body:      "Approve the sign in request",
+		Data:      map[string]string{"requestId": "req-1"},
+	}).Return(nil).Once()
+	suite.mockClientProvider.EXPECT().GetClient(mock.MatchedBy(func(s common.NotificationSenderDTO) bool {
+		return s.ID == "fcm-sender"
+	})).Return(mm, nil).Once()
+
+	err := suite.service.SendPush(context.Background(), "user-1", common.PushMessage{
+		Title: "Sign in request",
+		Body:  "Approve the sign in request",
+		Data:  map[string]string{"requestId": "req-1"},
+	})
+	suite.Nil(err)
+}
+
+func (suite *NotificationSenderServiceTestSuite) TestSendPush_NoDevices() {
+	suite.mockDeviceStore.EXPECT().listPushDevices(mock.Anything, "user-1").
+		Return([]common.PushDevice{}, nil).Once()
+
+	err := suite.service.SendPush(context.Background(), "user-1", common.PushMessage{Body: "Test"})
+	suite.NotNil(err)
+	suite.Equal(ErrorNoPushDevices.Code, err.Code)
+}
+
+func (suite *NotificationSenderServiceTestSuite) TestSendPush_ListDevicesError() {
+	suite.mockDeviceStore.EXPECT().listPushDevices(mock.Anything, "user-1").
+		Return(nil, errors.New("db err")).Once()
+
+	err := suite.service.SendPush(context.Background(), "user-1", common.PushMessage{Body: "Test"})
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *NotificationSenderServiceTestSuite) TestSendPush_SenderNotConfigured() {
+	devices := []common.PushDevice{
+		{ID: "device-1", UserID: "user-1", Provider: common.MessageProviderTypeAPNs, Token: "token-1"},
+	}
+	suite.mockDeviceStore.EXPECT().listPushDevices(mock.Anything, "user-1").Return(devices, nil).Once()
+	mm := messagemock.NewNotificationClientInterfaceMock(suite.T())
+	suite.mockSenderMgtSvc.EXPECT().ListSenders(mock.Anything).Return(suite.getPushSenders(), nil).Once()
+	suite.mockClientProvider.EXPECT().GetClient(mock.Anything).Return(mm, nil).Once()
+
+	err := suite.service.SendPush(context.Background(), "user-1", common.PushMessage{Body: "Test"})
+	suite.NotNil(err)
+	suite.Equal(ErrorPushSenderNotConfigured.Code, err.Code)
+}
+
+func (suite *NotificationSenderServiceTestSuite) TestSendPush_RemovesInvalidDevice() {
+	devices := []common.PushDevice{
+		{ID: "device-1", UserID: "user-1", Provider: common.MessageProviderTypeFCM, Token: "stale-token"},
+		{ID: "device-2", UserID: "user-1", Provider: common.MessageProviderTypeFCM, Token: "token-2"},
+	}
+	suite.mockDeviceStore.EXPECT().listPushDevices(mock.Anything, "user-1").Return(devices, nil).Once()
+	suite.mockSenderMgtSvc.EXPECT().ListSenders(mock.Anything).Return(suite.getPushSenders(), nil).Once()
+
+	mm := messagemock.NewNotificationClientInterfaceMock(suite.T())
+	mm.EXPECT().Send(common.ChannelTypePush, mock.MatchedBy(func(d common.NotificationData) bool {
+		return d.Recipient == "stale-token"
+	})).Return(fmt.Errorf("fcm push send failed: %w", message.ErrInvalidDeviceToken)).Once()
+	mm.EXPECT().Send(common.ChannelTypePush, mock.MatchedBy(func(d common.NotificationData) bool {
+		return d.Recipient == "token-2"
+	})).Return(nil).Once()
+	suite.mockClientProvider.EXPECT().GetClient(mock.Anything).Return(mm, nil).Once()
+	suite.mockDeviceStore.EXPECT().deletePushDevice(mock.Anything, "user-1", "device-1").Return(true, nil).Once()
+
+	err := suite.service.SendPush(context.Background(), "user-1", common.PushMessage{Body: "Test"})
+	suite.Nil(err)
+}
+
+func (suite *NotificationSenderServiceTestSuite) TestSendPush_AllDeliveriesFailed() {
+	devices := []common.PushDevice{
+		{ID: "device-1", UserID: "user-1", Provider: common.MessageProviderTypeFCM, Token: "token-1"},
+	}
+	suite.mockDeviceStore.EXPECT().listPushDevices(mock.Anything, "user-1").Return(devices, nil).Once()
+	suite.mockSenderMgtSvc.EXPECT().ListSenders(mock.Anything).Return(suite.getPushSenders(), nil).Once()
+
+	mm := messagemock.NewNotificationClientInterfaceMock(suite.T())
+	mm.EXPECT().Send(common.ChannelTypePush, mock.Anything).Return(errors.New("provider down")).Once()
+	suite.mockClientProvider.EXPECT().GetClient(mock.Anything).Return(mm, nil).Once()
+
+	err := suite.service.SendPush(context.Background(), "user-1", common.PushMessage{Body: "Test"})
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
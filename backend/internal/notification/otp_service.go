@@ -39,6 +39,18 @@ import (
 // otpUseOnlyNumericChars indicates whether to use only numeric characters for OTP generation.
 var otpUseOnlyNumericChars = true
 
+const (
+	// defaultOTPLength is the length of the OTPs sent without a configured length.
+	defaultOTPLength = 6
+	// minOTPLength and maxOTPLength bound the configurable length of an OTP.
+	minOTPLength = 4
+	maxOTPLength = 10
+	// defaultOTPValidityPeriod is the validity period in seconds of the OTPs sent without a configured period.
+	defaultOTPValidityPeriod = 120
+	// maxOTPValidityPeriod is the maximum configurable validity period of an OTP in seconds.
+	maxOTPValidityPeriod = 3600
+)
+
 // OTPServiceInterface defines the interface for OTP operations.
 type OTPServiceInterface interface {
 	SendOTP(ctx context.Context, request common.SendOTPDTO) (*common.SendOTPResultDTO, *serviceerror.ServiceError)
@@ -52,16 +64,21 @@ type otpService struct {
 	senderMgtService NotificationSenderMgtSvcInterface
 	clientProvider   notificationClientProviderInterface
 	templateService  template.TemplateServiceInterface
+	rateLimitService rateLimitServiceInterface
+	auditService     SendAuditServiceInterface
 }
 
 // newOTPService returns a new instance of OTPServiceInterface.
-func newOTPService(notifSenderSvc NotificationSenderMgtSvcInterface,
-	jwtSvc jwt.JWTServiceInterface, templateSvc template.TemplateServiceInterface) OTPServiceInterface {
+func newOTPService(notifSenderSvc NotificationSenderMgtSvcInterface, jwtSvc jwt.JWTServiceInterface,
+	templateSvc template.TemplateServiceInterface, rateLimitSvc rateLimitServiceInterface,
+	auditSvc SendAuditServiceInterface) OTPServiceInterface {
 	return &otpService{
 		jwtService:       jwtSvc,
 		senderMgtService: notifSenderSvc,
 		clientProvider:   newNotificationClientProvider(),
 		templateService:  templateSvc,
+		rateLimitService: rateLimitSvc,
+		auditService:     auditSvc,
 	}
 }
 
@@ -90,7 +107,13 @@ func (s *otpService) SendOTP(
 	// TODO: Validate whether the sender supports the requested channel when necessary
 	//  improvements are implemented.
 
-	otp, err := s.generateOTP()
+	// Rate limits of the organization unit of the sender apply, falling back to the global rate limits.
+	if svcErr := s.rateLimitService.CheckRateLimit(ctx, common.ChannelType(otpDTO.Channel), sender.OUID,
+		otpDTO.Recipient); svcErr != nil {
+		return nil, svcErr
+	}
+
+	otp, err := s.generateOTP(otpDTO.Options)
 	if err != nil {
 		logger.Error("Failed to generate OTP", log.Error(err))
 		return nil, &serviceerror.InternalServerError
@@ -99,7 +122,7 @@ func (s *otpService) SendOTP(
 	// Send OTP based on channel
 	switch common.ChannelType(otpDTO.Channel) {
 	case common.ChannelTypeSMS:
-		if svcErr := s.sendSMSOTP(ctx, otpDTO.Recipient, otp.Value, *sender, logger); svcErr != nil {
+		if svcErr := s.sendSMSOTP(ctx, otpDTO.Recipient, otp, *sender, logger); svcErr != nil {
 			return nil, svcErr
 		}
 	default:
@@ -183,6 +206,12 @@ func (s *otpService) validateOTPSendRequest(request common.SendOTPDTO) *servicee
 	if request.Channel != string(common.ChannelTypeSMS) {
 		return &ErrorUnsupportedChannel
 	}
+	if length := request.Options.Length; length != 0 && (length < minOTPLength || length > maxOTPLength) {
+		return &ErrorInvalidOTPOptions
+	}
+	if period := request.Options.ValidityPeriod; period < 0 || period > maxOTPValidityPeriod {
+		return &ErrorInvalidOTPOptions
+	}
 	return nil
 }
 
@@ -197,10 +226,10 @@ func (s *otpService) validateOTPVerifyRequest(request common.VerifyOTPDTO) *serv
 	return nil
 }
 
-// generateOTP generates a random OTP based on the configurations.
-func (s *otpService) generateOTP() (common.OTP, error) {
+// generateOTP generates a random OTP based on the configurations and the given options.
+func (s *otpService) generateOTP(options common.OTPOptions) (common.OTP, error) {
 	charSet := s.getOTPCharset()
-	otpLength := s.getOTPLength()
+	otpLength := s.getOTPLength(options)
 
 	chars := []rune(charSet)
 	result := make([]rune, otpLength)
@@ -216,7 +245,7 @@ func (s *otpService) generateOTP() (common.OTP, error) {
 
 	token := string(result)
 	currentTime := time.Now().UnixMilli()
-	validityPeriod := s.getOTPValidityPeriodInMillis()
+	validityPeriod := s.getOTPValidityPeriodInMillis(options)
 
 	return common.OTP{
 		Value:                  token,
@@ -235,9 +264,11 @@ func (s *otpService) getOTPCharset() string {
 }
 
 // getOTPLength returns the length of the OTP.
-func (s *otpService) getOTPLength() int {
-	// TODO: This needs to be configured as a property
-	return 6
+func (s *otpService) getOTPLength(options common.OTPOptions) int {
+	if options.Length > 0 {
+		return options.Length
+	}
+	return defaultOTPLength
 }
 
 // useOnlyNumericChars determines whether to use only numeric characters.
@@ -247,16 +278,18 @@ func (s *otpService) useOnlyNumericChars() bool {
 }
 
 // getOTPValidityPeriodInMillis returns the validity period of the OTP in milliseconds.
-func (s *otpService) getOTPValidityPeriodInMillis() int64 {
-	// TODO: This needs to be configured as a property
-	return 120000 // 2 minutes
+func (s *otpService) getOTPValidityPeriodInMillis(options common.OTPOptions) int64 {
+	if options.ValidityPeriod > 0 {
+		return options.ValidityPeriod * 1000
+	}
+	return defaultOTPValidityPeriod * 1000
 }
 
 // sendSMSOTP sends an SMS OTP to the recipient.
-func (s *otpService) sendSMSOTP(ctx context.Context, recipient, otp string,
+func (s *otpService) sendSMSOTP(ctx context.Context, recipient string, otp common.OTP,
 	sender common.NotificationSenderDTO, logger *log.Logger) *serviceerror.ServiceError {
-	expiryMinutes := strconv.FormatInt(s.getOTPValidityPeriodInMillis()/60000, 10)
-	templateData := template.TemplateData{"otp": otp, "expiryMinutes": expiryMinutes}
+	expiryMinutes := strconv.FormatInt(otp.ValidityPeriodInMillis/60000, 10)
+	templateData := template.TemplateData{"otp": otp.Value, "expiryMinutes": expiryMinutes}
 	rendered, svcErr := s.templateService.Render(ctx, template.ScenarioOTP, template.TemplateTypeSMS, templateData)
 	if svcErr != nil {
 		logger.Error("Failed to render SMS OTP template", log.String("error", svcErr.Code))
@@ -273,7 +306,10 @@ func (s *otpService) sendSMSOTP(ctx context.Context, recipient, otp string,
 	}
 
 	notifData := common.NotificationData{Recipient: recipient, Body: rendered.Body}
-	if err := _client.Send(common.ChannelTypeSMS, notifData); err != nil {
+	err := _client.Send(common.ChannelTypeSMS, notifData)
+	s.auditService.RecordSend(ctx, newSendAuditRecord(common.ChannelTypeSMS, string(template.ScenarioOTP),
+		recipient, sender.ID, string(sender.Provider), err))
+	if err != nil {
 		logger.Error("Failed to send SMS OTP", log.Error(err))
 		return &serviceerror.InternalServerError
 	}
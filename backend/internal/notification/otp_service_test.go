@@ -48,6 +48,8 @@ type OTPServiceTestSuite struct {
 	mockJWTService      *jwtmock.JWTServiceInterfaceMock
 	mockSenderService   *NotificationSenderMgtSvcInterfaceMock
 	mockTemplateService *templatemock.TemplateServiceInterfaceMock
+	mockRateLimitSvc    *rateLimitServiceInterfaceMock
+	mockAuditSvc        *SendAuditServiceInterfaceMock
 	service             *otpService
 }
 
@@ -77,11 +79,18 @@ func (suite *OTPServiceTestSuite) SetupTest() {
 	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	suite.mockSenderService = NewNotificationSenderMgtSvcInterfaceMock(suite.T())
 	suite.mockTemplateService = templatemock.NewTemplateServiceInterfaceMock(suite.T())
+	suite.mockRateLimitSvc = newRateLimitServiceInterfaceMock(suite.T())
+	suite.mockRateLimitSvc.EXPECT().CheckRateLimit(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Maybe()
+	suite.mockAuditSvc = NewSendAuditServiceInterfaceMock(suite.T())
+	suite.mockAuditSvc.EXPECT().RecordSend(mock.Anything, mock.Anything).Maybe()
 	suite.service = &otpService{
 		jwtService:       suite.mockJWTService,
 		senderMgtService: suite.mockSenderService,
 		clientProvider:   newNotificationClientProvider(),
 		templateService:  suite.mockTemplateService,
+		rateLimitService: suite.mockRateLimitSvc,
+		auditService:     suite.mockAuditSvc,
 	}
 }
 
@@ -155,6 +164,35 @@ func (suite *OTPServiceTestSuite) TestSendOTP_UnsupportedChannel() {
 	suite.Equal(ErrorUnsupportedChannel.Code, err.Code)
 }
 
+func (suite *OTPServiceTestSuite) TestSendOTP_InvalidOptions() {
+	testCases := []struct {
+		name    string
+		options common.OTPOptions
+	}{
+		{name: "LengthTooShort", options: common.OTPOptions{Length: 3}},
+		{name: "LengthTooLong", options: common.OTPOptions{Length: 11}},
+		{name: "NegativeValidityPeriod", options: common.OTPOptions{ValidityPeriod: -1}},
+		{name: "ValidityPeriodTooLong", options: common.OTPOptions{ValidityPeriod: 3601}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			request := common.SendOTPDTO{
+				Recipient: "+15559876543",
+				SenderID:  "sender-123",
+				Channel:   string(common.ChannelTypeSMS),
+				Options:   tc.options,
+			}
+
+			result, err := suite.service.SendOTP(context.Background(), request)
+
+			suite.Nil(result)
+			suite.NotNil(err)
+			suite.Equal(ErrorInvalidOTPOptions.Code, err.Code)
+		})
+	}
+}
+
 func (suite *OTPServiceTestSuite) TestSendOTP_SenderNotFound() {
 	request := common.SendOTPDTO{
 		Recipient: "+15559876543",
@@ -171,6 +209,28 @@ func (suite *OTPServiceTestSuite) TestSendOTP_SenderNotFound() {
 	suite.Equal(ErrorSenderNotFound.Code, err.Code)
 }
 
+func (suite *OTPServiceTestSuite) TestSendOTP_RateLimitExceeded() {
+	request := common.SendOTPDTO{
+		Recipient: "+15559876543",
+		SenderID:  "sender-123",
+		Channel:   "sms",
+	}
+	sender := suite.getValidSender()
+	sender.OUID = testOUID
+
+	mockRateLimitSvc := newRateLimitServiceInterfaceMock(suite.T())
+	suite.service.rateLimitService = mockRateLimitSvc
+	suite.mockSenderService.On("GetSender", mock.Anything, "sender-123").Return(sender, nil).Once()
+	mockRateLimitSvc.EXPECT().CheckRateLimit(mock.Anything, common.ChannelTypeSMS, testOUID, "+15559876543").
+		Return(&ErrorRateLimitExceeded).Once()
+
+	result, err := suite.service.SendOTP(context.Background(), request)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(ErrorRateLimitExceeded.Code, err.Code)
+}
+
 func (suite *OTPServiceTestSuite) TestSendOTP_SenderServiceError() {
 	request := common.SendOTPDTO{
 		Recipient: "+15559876543",
@@ -232,7 +292,7 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_InvalidSessionToken() {
 }
 
 func (suite *OTPServiceTestSuite) TestGenerateOTP() {
-	otp, err := suite.service.generateOTP()
+	otp, err := suite.service.generateOTP(common.OTPOptions{})
 
 	suite.NoError(err)
 	suite.NotEmpty(otp.Value)
@@ -241,6 +301,15 @@ func (suite *OTPServiceTestSuite) TestGenerateOTP() {
 	suite.Greater(otp.ValidityPeriodInMillis, int64(0))
 }
 
+func (suite *OTPServiceTestSuite) TestGenerateOTP_WithOptions() {
+	otp, err := suite.service.generateOTP(common.OTPOptions{Length: 8, ValidityPeriod: 300})
+
+	suite.NoError(err)
+	suite.Len(otp.Value, 8)
+	suite.Equal(int64(300000), otp.ValidityPeriodInMillis)
+	suite.Equal(otp.GeneratedTimeInMillis+300000, otp.ExpiryTimeInMillis)
+}
+
 func (suite *OTPServiceTestSuite) TestGetOTPCharset() {
 	charset := suite.service.getOTPCharset()
 
@@ -249,9 +318,8 @@ func (suite *OTPServiceTestSuite) TestGetOTPCharset() {
 }
 
 func (suite *OTPServiceTestSuite) TestGetOTPLength() {
-	length := suite.service.getOTPLength()
-
-	suite.Equal(6, length)
+	suite.Equal(6, suite.service.getOTPLength(common.OTPOptions{}))
+	suite.Equal(4, suite.service.getOTPLength(common.OTPOptions{Length: 4}))
 }
 
 func (suite *OTPServiceTestSuite) TestUseOnlyNumericChars() {
@@ -261,9 +329,10 @@ func (suite *OTPServiceTestSuite) TestUseOnlyNumericChars() {
 }
 
 func (suite *OTPServiceTestSuite) TestGetOTPValidityPeriodInMillis() {
-	validity := suite.service.getOTPValidityPeriodInMillis()
+	validity := suite.service.getOTPValidityPeriodInMillis(common.OTPOptions{})
 
 	suite.Equal(int64(120000), validity) // 2 minutes
+	suite.Equal(int64(60000), suite.service.getOTPValidityPeriodInMillis(common.OTPOptions{ValidityPeriod: 60}))
 }
 
 func (suite *OTPServiceTestSuite) TestGetOTPCharset_NonNumeric() {
@@ -330,6 +399,14 @@ func (suite *OTPServiceTestSuite) TestSendOTP_Success() {
 	cp.EXPECT().GetClient(mock.Anything).Return(mm, nil).Once()
 	suite.service.clientProvider = cp
 
+	mockAuditSvc := NewSendAuditServiceInterfaceMock(suite.T())
+	mockAuditSvc.EXPECT().RecordSend(mock.Anything, mock.MatchedBy(func(record common.SendAuditRecord) bool {
+		return record.Channel == common.ChannelTypeSMS && record.Template == string(template.ScenarioOTP) &&
+			record.Recipient == "+15559876543" && record.SenderID == "sender-123" &&
+			record.Status == common.SendStatusSuccess
+	})).Once()
+	suite.service.auditService = mockAuditSvc
+
 	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("session-token-123", int64(0), nil).Once()
 
@@ -359,6 +436,12 @@ func (suite *OTPServiceTestSuite) TestSendOTP_SendSMSError() {
 	cp.EXPECT().GetClient(mock.Anything).Return(mm, nil).Once()
 	suite.service.clientProvider = cp
 
+	mockAuditSvc := NewSendAuditServiceInterfaceMock(suite.T())
+	mockAuditSvc.EXPECT().RecordSend(mock.Anything, mock.MatchedBy(func(record common.SendAuditRecord) bool {
+		return record.Status == common.SendStatusFailed && record.ProviderResponse == "send failed"
+	})).Once()
+	suite.service.auditService = mockAuditSvc
+
 	res, err := suite.service.SendOTP(context.Background(), req)
 	suite.Nil(res)
 	suite.NotNil(err)
@@ -616,7 +699,8 @@ func (suite *OTPServiceTestSuite) TestVerifyOTP_UnmarshalError() {
 }
 
 func (suite *OTPServiceTestSuite) TestNewOTPService_Constructors() {
-	svc := newOTPService(suite.mockSenderService, suite.mockJWTService, suite.mockTemplateService)
+	svc := newOTPService(suite.mockSenderService, suite.mockJWTService, suite.mockTemplateService,
+		suite.mockRateLimitSvc, suite.mockAuditSvc)
 	suite.NotNil(svc)
 }
 
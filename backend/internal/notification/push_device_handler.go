@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// pushDeviceHandler handles the self-service HTTP requests for managing the push devices of a user.
+type pushDeviceHandler struct {
+	deviceService PushDeviceServiceInterface
+}
+
+// newPushDeviceHandler creates a new instance of pushDeviceHandler.
+func newPushDeviceHandler(deviceService PushDeviceServiceInterface) *pushDeviceHandler {
+	return &pushDeviceHandler{
+		deviceService: deviceService,
+	}
+}
+
+// HandleDeviceListRequest handles the request to list the push devices of the authenticated user.
+func (h *pushDeviceHandler) HandleDeviceListRequest(w http.ResponseWriter, r *http.Request) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		h.handleError(w, &ErrorUnauthenticatedUser)
+		return
+	}
+
+	devices, svcErr := h.deviceService.ListDevices(r.Context(), userID)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	response := make([]common.PushDeviceResponse, 0, len(devices))
+	for _, device := range devices {
+		response = append(response, getPushDeviceResponse(device))
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleDeviceRegisterRequest handles the request to register a push device for the authenticated user.
+func (h *pushDeviceHandler) HandleDeviceRegisterRequest(w http.ResponseWriter, r *http.Request) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		h.handleError(w, &ErrorUnauthenticatedUser)
+		return
+	}
+
+	request, err := sysutils.DecodeJSONBody[common.PushDeviceRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	device := common.PushDevice{
+		UserID:   userID,
+		Provider: common.MessageProviderType(strings.ToLower(strings.TrimSpace(request.Provider))),
+		Token:    request.Token,
+		Name:     strings.TrimSpace(request.Name),
+	}
+	registeredDevice, svcErr := h.deviceService.RegisterDevice(r.Context(), device)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, getPushDeviceResponse(*registeredDevice))
+}
+
+// HandleDeviceDeleteRequest handles the request to delete a push device of the authenticated user.
+func (h *pushDeviceHandler) HandleDeviceDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		h.handleError(w, &ErrorUnauthenticatedUser)
+		return
+	}
+
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorPushDeviceNotFound)
+		return
+	}
+
+	if svcErr := h.deviceService.DeleteDevice(r.Context(), userID, id); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// handleError writes the error response for the given service error.
+func (h *pushDeviceHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorPushDeviceNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorUnauthenticatedUser.Code:
+			statusCode = http.StatusUnauthorized
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
+
+// getPushDeviceResponse converts a push device to its response representation. The device token is not
+// returned as it is a delivery credential of the device.
+func getPushDeviceResponse(device common.PushDevice) common.PushDeviceResponse {
+	return common.PushDeviceResponse{
+		ID:        device.ID,
+		Provider:  device.Provider,
+		Name:      device.Name,
+		CreatedAt: device.CreatedAt,
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+type PushDeviceHandlerTestSuite struct {
+	suite.Suite
+	mockService *PushDeviceServiceInterfaceMock
+	handler     *pushDeviceHandler
+}
+
+func TestPushDeviceHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(PushDeviceHandlerTestSuite))
+}
+
+func (suite *PushDeviceHandlerTestSuite) SetupTest() {
+	suite.mockService = NewPushDeviceServiceInterfaceMock(suite.T())
+	suite.handler = newPushDeviceHandler(suite.mockService)
+}
+
+// withUser returns the request with a security context for the test user.
+func (suite *PushDeviceHandlerTestSuite) withUser(req *http.Request) *http.Request {
+	authCtx := security.NewSecurityContextForTest(testUserID, "", "", nil, nil)
+	return req.WithContext(security.WithSecurityContextTest(context.Background(), authCtx))
+}
+
+func (suite *PushDeviceHandlerTestSuite) TestHandleDeviceListRequest() {
+	devices := []common.PushDevice{
+		{ID: testPushDeviceID, UserID: testUserID, Provider: common.MessageProviderTypeFCM, Token: "device-token"},
+	}
+	suite.mockService.EXPECT().ListDevices(mock.Anything, testUserID).Return(devices, nil).Once()
+
+	req := suite.withUser(httptest.NewRequest(http.MethodGet, "/users/me/push-devices", nil))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeviceListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.NotContains(rr.Body.String(), "device-token")
+	var response []common.PushDeviceResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Len(response, 1)
+	suite.Equal(testPushDeviceID, response[0].ID)
+}
+
+func (suite *PushDeviceHandlerTestSuite) TestHandleDeviceListRequest_Unauthenticated() {
+	req := httptest.NewRequest(http.MethodGet, "/users/me/push-devices", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeviceListRequest(rr, req)
+
+	suite.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+func (suite *PushDeviceHandlerTestSuite) TestHandleDeviceRegisterRequest() {
+	suite.mockService.EXPECT().RegisterDevice(mock.Anything, common.PushDevice{
+		UserID:   testUserID,
+		Provider: common.MessageProviderTypeAPNs,
+		Token:    "device-token",
+		Name:     "iPhone",
+	}).Return(&common.PushDevice{ID: testPushDeviceID, Provider: common.MessageProviderTypeAPNs}, nil).Once()
+
+	body := `{"provider":"APNs","token":"device-token","name":"iPhone"}`
+	req := suite.withUser(httptest.NewRequest(http.MethodPost, "/users/me/push-devices", strings.NewReader(body)))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeviceRegisterRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+}
+
+func (suite *PushDeviceHandlerTestSuite) TestHandleDeviceRegisterRequest_InvalidBody() {
+	req := suite.withUser(httptest.NewRequest(http.MethodPost, "/users/me/push-devices",
+		strings.NewReader("invalid")))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeviceRegisterRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *PushDeviceHandlerTestSuite) TestHandleDeviceRegisterRequest_InvalidDevice() {
+	suite.mockService.EXPECT().RegisterDevice(mock.Anything, mock.Anything).
+		Return(nil, &ErrorInvalidPushDevice).Once()
+
+	req := suite.withUser(httptest.NewRequest(http.MethodPost, "/users/me/push-devices",
+		strings.NewReader(`{"provider":"twilio","token":"device-token"}`)))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeviceRegisterRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidPushDevice.Code)
+}
+
+func (suite *PushDeviceHandlerTestSuite) TestHandleDeviceDeleteRequest() {
+	suite.mockService.EXPECT().DeleteDevice(mock.Anything, testUserID, testPushDeviceID).Return(nil).Once()
+
+	req := suite.withUser(httptest.NewRequest(http.MethodDelete, "/users/me/push-devices/"+testPushDeviceID, nil))
+	req.SetPathValue("id", testPushDeviceID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeviceDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *PushDeviceHandlerTestSuite) TestHandleDeviceDeleteRequest_NotFound() {
+	suite.mockService.EXPECT().DeleteDevice(mock.Anything, testUserID, "missing").
+		Return(&ErrorPushDeviceNotFound).Once()
+
+	req := suite.withUser(httptest.NewRequest(http.MethodDelete, "/users/me/push-devices/missing", nil))
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeviceDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// maxPushDeviceTokenLength is the maximum length of a push device token accepted for registration.
+const maxPushDeviceTokenLength = 512
+
+// PushDeviceServiceInterface defines the interface for managing the push devices of a user.
+type PushDeviceServiceInterface interface {
+	RegisterDevice(ctx context.Context, device common.PushDevice) (*common.PushDevice, *serviceerror.ServiceError)
+	ListDevices(ctx context.Context, userID string) ([]common.PushDevice, *serviceerror.ServiceError)
+	DeleteDevice(ctx context.Context, userID, id string) *serviceerror.ServiceError
+	DeleteUserDevices(ctx context.Context, userID string) (int64, *serviceerror.ServiceError)
+}
+
+// pushDeviceService implements PushDeviceServiceInterface.
+type pushDeviceService struct {
+	store  pushDeviceStoreInterface
+	logger *log.Logger
+}
+
+// newPushDeviceService returns a new instance of PushDeviceServiceInterface.
+func newPushDeviceService(store pushDeviceStoreInterface) PushDeviceServiceInterface {
+	return &pushDeviceService{
+		store:  store,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PushDeviceService")),
+	}
+}
+
+// RegisterDevice registers a push device for a user. A device token can only be registered once, so any
+// existing registration of the token is replaced.
+func (s *pushDeviceService) RegisterDevice(ctx context.Context, device common.PushDevice) (
+	*common.PushDevice, *serviceerror.ServiceError) {
+	device.Token = strings.TrimSpace(device.Token)
+	if !isPushProvider(device.Provider) || device.Token == "" || len(device.Token) > maxPushDeviceTokenLength {
+		return nil, &ErrorInvalidPushDevice
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	device.ID = id
+	device.CreatedAt = time.Now().UTC()
+
+	if err := s.store.deletePushDeviceByToken(ctx, device.Token); err != nil {
+		s.logger.Error("Failed to remove existing push device registration", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if err := s.store.createPushDevice(ctx, device); err != nil {
+		s.logger.Error("Failed to register push device", log.String("userID", device.UserID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &device, nil
+}
+
+// ListDevices retrieves the push devices registered by a user.
+func (s *pushDeviceService) ListDevices(ctx context.Context, userID string) (
+	[]common.PushDevice, *serviceerror.ServiceError) {
+	devices, err := s.store.listPushDevices(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list push devices", log.String("userID", userID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return devices, nil
+}
+
+// DeleteDevice deletes a push device registered by a user.
+func (s *pushDeviceService) DeleteDevice(ctx context.Context, userID, id string) *serviceerror.ServiceError {
+	deleted, err := s.store.deletePushDevice(ctx, userID, id)
+	if err != nil {
+		s.logger.Error("Failed to delete push device", log.String("id", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !deleted {
+		return &ErrorPushDeviceNotFound
+	}
+
+	return nil
+}
+
+// DeleteUserDevices deletes all push devices registered by a user and returns the number of devices deleted.
+func (s *pushDeviceService) DeleteUserDevices(ctx context.Context, userID string) (
+	int64, *serviceerror.ServiceError) {
+	deleted, err := s.store.deletePushDevicesByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to delete the push devices of the user", log.String("userID", userID),
+			log.Error(err))
+		return 0, &serviceerror.InternalServerError
+	}
+
+	return deleted, nil
+}
+
+// isPushProvider reports whether the given provider delivers push notifications.
+func isPushProvider(provider common.MessageProviderType) bool {
+	return provider == common.MessageProviderTypeFCM || provider == common.MessageProviderTypeAPNs
+}
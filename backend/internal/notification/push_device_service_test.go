@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type PushDeviceServiceTestSuite struct {
+	suite.Suite
+	mockStore *pushDeviceStoreInterfaceMock
+	service   PushDeviceServiceInterface
+}
+
+func TestPushDeviceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PushDeviceServiceTestSuite))
+}
+
+func (suite *PushDeviceServiceTestSuite) SetupTest() {
+	suite.mockStore = newPushDeviceStoreInterfaceMock(suite.T())
+	suite.service = newPushDeviceService(suite.mockStore)
+}
+
+func (suite *PushDeviceServiceTestSuite) TestRegisterDevice_Success() {
+	suite.mockStore.EXPECT().deletePushDeviceByToken(mock.Anything, "device-token").Return(nil).Once()
+	suite.mockStore.EXPECT().createPushDevice(mock.Anything, mock.MatchedBy(func(d common.PushDevice) bool {
+		return d.ID != "" && d.UserID == testUserID && d.Token == "device-token" && !d.CreatedAt.IsZero()
+	})).Return(nil).Once()
+
+	device, svcErr := suite.service.RegisterDevice(context.Background(), common.PushDevice{
+		UserID:   testUserID,
+		Provider: common.MessageProviderTypeFCM,
+		Token:    " device-token ",
+	})
+
+	suite.Nil(svcErr)
+	suite.NotEmpty(device.ID)
+	suite.Equal("device-token", device.Token)
+}
+
+func (suite *PushDeviceServiceTestSuite) TestRegisterDevice_InvalidDevice() {
+	testCases := []struct {
+		name   string
+		device common.PushDevice
+	}{
+		{"UnsupportedProvider", common.PushDevice{UserID: testUserID, Provider: "twilio", Token: "device-token"}},
+		{"EmptyToken", common.PushDevice{UserID: testUserID, Provider: common.MessageProviderTypeAPNs}},
+		{"TokenTooLong", common.PushDevice{UserID: testUserID, Provider: common.MessageProviderTypeAPNs,
+			Token: strings.Repeat("a", maxPushDeviceTokenLength+1)}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			device, svcErr := suite.service.RegisterDevice(context.Background(), tc.device)
+
+			suite.Nil(device)
+			suite.Equal(ErrorInvalidPushDevice.Code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *PushDeviceServiceTestSuite) TestRegisterDevice_StoreError() {
+	suite.mockStore.EXPECT().deletePushDeviceByToken(mock.Anything, "device-token").Return(nil).Once()
+	suite.mockStore.EXPECT().createPushDevice(mock.Anything, mock.Anything).Return(errors.New("db err")).Once()
+
+	device, svcErr := suite.service.RegisterDevice(context.Background(), common.PushDevice{
+		UserID:   testUserID,
+		Provider: common.MessageProviderTypeFCM,
+		Token:    "device-token",
+	})
+
+	suite.Nil(device)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *PushDeviceServiceTestSuite) TestListDevices() {
+	devices := []common.PushDevice{{ID: testPushDeviceID, UserID: testUserID}}
+	suite.mockStore.EXPECT().listPushDevices(mock.Anything, testUserID).Return(devices, nil).Once()
+
+	result, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Equal(devices, result)
+}
+
+func (suite *PushDeviceServiceTestSuite) TestDeleteDevice() {
+	suite.mockStore.EXPECT().deletePushDevice(mock.Anything, testUserID, testPushDeviceID).Return(true, nil).Once()
+
+	svcErr := suite.service.DeleteDevice(context.Background(), testUserID, testPushDeviceID)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *PushDeviceServiceTestSuite) TestDeleteDevice_NotFound() {
+	suite.mockStore.EXPECT().deletePushDevice(mock.Anything, testUserID, "missing").Return(false, nil).Once()
+
+	svcErr := suite.service.DeleteDevice(context.Background(), testUserID, "missing")
+
+	suite.Equal(ErrorPushDeviceNotFound.Code, svcErr.Code)
+}
+
+func (suite *PushDeviceServiceTestSuite) TestDeleteUserDevices() {
+	suite.mockStore.EXPECT().deletePushDevicesByUser(mock.Anything, testUserID).Return(int64(2), nil).Once()
+
+	deleted, svcErr := suite.service.DeleteUserDevices(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Equal(int64(2), deleted)
+}
+
+func (suite *PushDeviceServiceTestSuite) TestDeleteUserDevices_StoreError() {
+	suite.mockStore.EXPECT().deletePushDevicesByUser(mock.Anything, testUserID).
+		Return(int64(0), errors.New("db error")).Once()
+
+	deleted, svcErr := suite.service.DeleteUserDevices(context.Background(), testUserID)
+
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+	suite.Zero(deleted)
+}
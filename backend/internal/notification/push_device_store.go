@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// pushDeviceStoreInterface defines the interface for push device storage operations.
+type pushDeviceStoreInterface interface {
+	createPushDevice(ctx context.Context, device common.PushDevice) error
+	listPushDevices(ctx context.Context, userID string) ([]common.PushDevice, error)
+	deletePushDevice(ctx context.Context, userID, id string) (bool, error)
+	deletePushDevicesByUser(ctx context.Context, userID string) (int64, error)
+	deletePushDeviceByToken(ctx context.Context, token string) error
+}
+
+// pushDeviceStore is the user database implementation of pushDeviceStoreInterface.
+type pushDeviceStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPushDeviceStore returns a new instance of pushDeviceStoreInterface.
+func newPushDeviceStore() pushDeviceStoreInterface {
+	return &pushDeviceStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createPushDevice registers a push device for a user.
+func (s *pushDeviceStore) createPushDevice(ctx context.Context, device common.PushDevice) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreatePushDevice, device.ID, device.UserID, string(device.Provider),
+		device.Token, dbutils.ToNullableString(device.Name), device.CreatedAt, device.CreatedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// listPushDevices retrieves the push devices registered by a user.
+func (s *pushDeviceStore) listPushDevices(ctx context.Context, userID string) ([]common.PushDevice, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListPushDevicesByUser, userID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	devices := make([]common.PushDevice, 0, len(results))
+	for _, row := range results {
+		device, err := buildPushDeviceFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build push device from result row: %w", err)
+		}
+		devices = append(devices, *device)
+	}
+
+	return devices, nil
+}
+
+// deletePushDevice deletes a push device of a user. Returns false if the device does not exist.
+func (s *pushDeviceStore) deletePushDevice(ctx context.Context, userID, id string) (bool, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeletePushDevice, id, userID, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// deletePushDevicesByUser deletes all push devices of a user and returns the number of devices deleted.
+func (s *pushDeviceStore) deletePushDevicesByUser(ctx context.Context, userID string) (int64, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeletePushDevicesByUser, userID, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// deletePushDeviceByToken deletes any registration of the given device token.
+func (s *pushDeviceStore) deletePushDeviceByToken(ctx context.Context, token string) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryDeletePushDeviceByToken, token, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildPushDeviceFromResultRow constructs a PushDevice from a database result row.
+func buildPushDeviceFromResultRow(row map[string]interface{}) (*common.PushDevice, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse user_id as string")
+	}
+	providerType, ok := row["provider"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse provider as string")
+	}
+	token, ok := row["device_token"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse device_token as string")
+	}
+
+	// Optional columns may be NULL.
+	name, _ := row["device_name"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.PushDevice{
+		ID:        id,
+		UserID:    userID,
+		Provider:  common.MessageProviderType(providerType),
+		Token:     token,
+		Name:      name,
+		CreatedAt: createdAt,
+	}, nil
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testUserID       = "user-1"
+	testPushDeviceID = "device-1"
+)
+
+type PushDeviceStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *pushDeviceStore
+}
+
+func TestPushDeviceStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(PushDeviceStoreTestSuite))
+}
+
+func (suite *PushDeviceStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &pushDeviceStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *PushDeviceStoreTestSuite) TestCreatePushDevice() {
+	now := time.Now().UTC()
+	device := common.PushDevice{
+		ID:        testPushDeviceID,
+		UserID:    testUserID,
+		Provider:  common.MessageProviderTypeFCM,
+		Token:     "device-token",
+		CreatedAt: now,
+	}
+
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreatePushDevice, testPushDeviceID,
+		testUserID, "fcm", "device-token", nil, now, now, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createPushDevice(context.Background(), device)
+	suite.NoError(err)
+}
+
+func (suite *PushDeviceStoreTestSuite) TestCreatePushDevice_WithError() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createPushDevice(context.Background(), common.PushDevice{ID: testPushDeviceID})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *PushDeviceStoreTestSuite) TestListPushDevices() {
+	rows := []map[string]interface{}{
+		{
+			"id":           testPushDeviceID,
+			"user_id":      testUserID,
+			"provider":     "apns",
+			"device_token": "device-token",
+			"device_name":  "iPhone",
+			"created_at":   "2026-01-02 10:00:00",
+		},
+	}
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListPushDevicesByUser, testUserID,
+		testDeploymentID).Return(rows, nil).Once()
+
+	devices, err := suite.store.listPushDevices(context.Background(), testUserID)
+	suite.NoError(err)
+	suite.Len(devices, 1)
+	suite.Equal(common.MessageProviderTypeAPNs, devices[0].Provider)
+	suite.Equal("device-token", devices[0].Token)
+	suite.Equal("iPhone", devices[0].Name)
+	suite.Equal(2026, devices[0].CreatedAt.Year())
+}
+
+func (suite *PushDeviceStoreTestSuite) TestListPushDevices_InvalidRow() {
+	rows := []map[string]interface{}{{"id": testPushDeviceID}}
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListPushDevicesByUser, testUserID,
+		testDeploymentID).Return(rows, nil).Once()
+
+	devices, err := suite.store.listPushDevices(context.Background(), testUserID)
+	suite.Error(err)
+	suite.Nil(devices)
+}
+
+func (suite *PushDeviceStoreTestSuite) TestDeletePushDevice() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Twice()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeletePushDevice, testPushDeviceID,
+		testUserID, testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeletePushDevice, "missing",
+		testUserID, testDeploymentID).Return(int64(0), nil).Once()
+
+	deleted, err := suite.store.deletePushDevice(context.Background(), testUserID, testPushDeviceID)
+	suite.NoError(err)
+	suite.True(deleted)
+
+	deleted, err = suite.store.deletePushDevice(context.Background(), testUserID, "missing")
+	suite.NoError(err)
+	suite.False(deleted)
+}
+
+func (suite *PushDeviceStoreTestSuite) TestDeletePushDevicesByUser() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeletePushDevicesByUser, testUserID,
+		testDeploymentID).Return(int64(2), nil).Once()
+
+	deleted, err := suite.store.deletePushDevicesByUser(context.Background(), testUserID)
+	suite.NoError(err)
+	suite.Equal(int64(2), deleted)
+}
+
+func (suite *PushDeviceStoreTestSuite) TestDeletePushDeviceByToken() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeletePushDeviceByToken, "device-token",
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.deletePushDeviceByToken(context.Background(), "device-token")
+	suite.NoError(err)
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// rateLimitHandler handles HTTP requests for notification rate limit management.
+type rateLimitHandler struct {
+	rateLimitService rateLimitServiceInterface
+}
+
+// newRateLimitHandler creates a new instance of rateLimitHandler.
+func newRateLimitHandler(rateLimitService rateLimitServiceInterface) *rateLimitHandler {
+	return &rateLimitHandler{
+		rateLimitService: rateLimitService,
+	}
+}
+
+// HandleRateLimitListRequest handles the request to list all notification rate limits.
+func (h *rateLimitHandler) HandleRateLimitListRequest(w http.ResponseWriter, r *http.Request) {
+	rateLimits, svcErr := h.rateLimitService.ListRateLimits(r.Context())
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	response := make([]common.NotificationRateLimitResponse, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		response = append(response, getRateLimitResponseFromDTO(rateLimit))
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleRateLimitCreateRequest handles the request to create a new notification rate limit.
+func (h *rateLimitHandler) HandleRateLimitCreateRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[common.NotificationRateLimitRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	createdRateLimit, svcErr := h.rateLimitService.CreateRateLimit(r.Context(), getDTOFromRateLimitRequest(request))
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, getRateLimitResponseFromDTO(*createdRateLimit))
+}
+
+// HandleRateLimitGetRequest handles the request to get a notification rate limit by ID.
+func (h *rateLimitHandler) HandleRateLimitGetRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidRateLimitID)
+		return
+	}
+
+	rateLimit, svcErr := h.rateLimitService.GetRateLimit(r.Context(), id)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, getRateLimitResponseFromDTO(*rateLimit))
+}
+
+// HandleRateLimitUpdateRequest handles the request to update a notification rate limit.
+func (h *rateLimitHandler) HandleRateLimitUpdateRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidRateLimitID)
+		return
+	}
+
+	request, err := sysutils.DecodeJSONBody[common.NotificationRateLimitRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	updatedRateLimit, svcErr := h.rateLimitService.UpdateRateLimit(r.Context(), id,
+		getDTOFromRateLimitRequest(request))
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, getRateLimitResponseFromDTO(*updatedRateLimit))
+}
+
+// HandleRateLimitDeleteRequest handles the request to delete a notification rate limit.
+func (h *rateLimitHandler) HandleRateLimitDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidRateLimitID)
+		return
+	}
+
+	if svcErr := h.rateLimitService.DeleteRateLimit(r.Context(), id); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// handleError writes the HTTP error response for the given service error.
+func (h *rateLimitHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorRateLimitNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorDuplicateRateLimit.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
+
+// getDTOFromRateLimitRequest sanitizes the rate limit request and converts it to a NotificationRateLimitDTO.
+func getDTOFromRateLimitRequest(request *common.NotificationRateLimitRequest) common.NotificationRateLimitDTO {
+	return common.NotificationRateLimitDTO{
+		Channel:        common.ChannelType(strings.ToLower(sysutils.SanitizeString(request.Channel))),
+		OUID:           sysutils.SanitizeString(request.OUID),
+		RecipientLimit: request.RecipientLimit,
+		IPLimit:        request.IPLimit,
+		WindowSeconds:  request.WindowSeconds,
+	}
+}
+
+// getRateLimitResponseFromDTO converts a NotificationRateLimitDTO to a response object.
+func getRateLimitResponseFromDTO(rateLimit common.NotificationRateLimitDTO) common.NotificationRateLimitResponse {
+	return common.NotificationRateLimitResponse{
+		ID:             rateLimit.ID,
+		Channel:        rateLimit.Channel,
+		OUID:           rateLimit.OUID,
+		RecipientLimit: rateLimit.RecipientLimit,
+		IPLimit:        rateLimit.IPLimit,
+		WindowSeconds:  rateLimit.WindowSeconds,
+	}
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type RateLimitHandlerTestSuite struct {
+	suite.Suite
+	mockService *rateLimitServiceInterfaceMock
+	handler     *rateLimitHandler
+}
+
+func TestRateLimitHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitHandlerTestSuite))
+}
+
+func (suite *RateLimitHandlerTestSuite) SetupTest() {
+	suite.mockService = newRateLimitServiceInterfaceMock(suite.T())
+	suite.handler = newRateLimitHandler(suite.mockService)
+}
+
+func (suite *RateLimitHandlerTestSuite) TestHandleRateLimitListRequest() {
+	suite.mockService.EXPECT().ListRateLimits(mock.Anything).
+		Return([]common.NotificationRateLimitDTO{getTestRateLimit()}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/notification-rate-limits", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleRateLimitListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response []common.NotificationRateLimitResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Len(response, 1)
+	suite.Equal(testRateLimitID, response[0].ID)
+	suite.Equal(5, response[0].RecipientLimit)
+}
+
+func (suite *RateLimitHandlerTestSuite) TestHandleRateLimitCreateRequest() {
+	body := `{"channel":"SMS","ouId":"ou-1","recipientLimit":5,"ipLimit":20,"windowSeconds":3600}`
+	expected := common.NotificationRateLimitDTO{
+		Channel:        common.ChannelTypeSMS,
+		OUID:           testOUID,
+		RecipientLimit: 5,
+		IPLimit:        20,
+		WindowSeconds:  3600,
+	}
+	created := expected
+	created.ID = testRateLimitID
+	suite.mockService.EXPECT().CreateRateLimit(mock.Anything, expected).Return(&created, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/notification-rate-limits", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleRateLimitCreateRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+	var response common.NotificationRateLimitResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Equal(testRateLimitID, response.ID)
+	suite.Equal(testOUID, response.OUID)
+}
+
+func (suite *RateLimitHandlerTestSuite) TestHandleRateLimitCreateRequest_WithFailure() {
+	cases := []struct {
+		name       string
+		body       string
+		svcErr     *serviceerror.ServiceError
+		wantStatus int
+	}{
+		{name: "InvalidBody", body: "{invalid", wantStatus: http.StatusBadRequest},
+		{name: "InvalidRateLimit", body: `{"channel":"sms"}`, svcErr: &ErrorInvalidRateLimit,
+			wantStatus: http.StatusBadRequest},
+		{name: "Duplicate", body: `{"channel":"sms","recipientLimit":5,"windowSeconds":60}`,
+			svcErr: &ErrorDuplicateRateLimit, wantStatus: http.StatusConflict},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			mockService := newRateLimitServiceInterfaceMock(suite.T())
+			handler := newRateLimitHandler(mockService)
+			if tc.svcErr != nil {
+				mockService.EXPECT().CreateRateLimit(mock.Anything, mock.Anything).Return(nil, tc.svcErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/notification-rate-limits", strings.NewReader(tc.body))
+			rr := httptest.NewRecorder()
+			handler.HandleRateLimitCreateRequest(rr, req)
+
+			suite.Equal(tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func (suite *RateLimitHandlerTestSuite) TestHandleRateLimitGetRequest() {
+	rateLimit := getTestRateLimit()
+	suite.mockService.EXPECT().GetRateLimit(mock.Anything, testRateLimitID).Return(&rateLimit, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/notification-rate-limits/"+testRateLimitID, nil)
+	req.SetPathValue("id", testRateLimitID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleRateLimitGetRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response common.NotificationRateLimitResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Equal(common.ChannelTypeSMS, response.Channel)
+}
+
+func (suite *RateLimitHandlerTestSuite) TestHandleRateLimitGetRequest_NotFound() {
+	suite.mockService.EXPECT().GetRateLimit(mock.Anything, testRateLimitID).
+		Return(nil, &ErrorRateLimitNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/notification-rate-limits/"+testRateLimitID, nil)
+	req.SetPathValue("id", testRateLimitID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleRateLimitGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *RateLimitHandlerTestSuite) TestHandleRateLimitUpdateRequest() {
+	expected := common.NotificationRateLimitDTO{
+		Channel:       common.ChannelTypeEmail,
+		IPLimit:       10,
+		WindowSeconds: 600,
+	}
+	updated := expected
+	updated.ID = testRateLimitID
+	suite.mockService.EXPECT().UpdateRateLimit(mock.Anything, testRateLimitID, expected).
+		Return(&updated, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPut, "/notification-rate-limits/"+testRateLimitID,
+		strings.NewReader(`{"channel":"email","ipLimit":10,"windowSeconds":600}`))
+	req.SetPathValue("id", testRateLimitID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleRateLimitUpdateRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response common.NotificationRateLimitResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Equal(10, response.IPLimit)
+	suite.Zero(response.RecipientLimit)
+}
+
+func (suite *RateLimitHandlerTestSuite) TestHandleRateLimitDeleteRequest() {
+	suite.mockService.EXPECT().DeleteRateLimit(mock.Anything, testRateLimitID).Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/notification-rate-limits/"+testRateLimitID, nil)
+	req.SetPathValue("id", testRateLimitID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleRateLimitDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *RateLimitHandlerTestSuite) TestHandleRateLimitDeleteRequest_ServerError() {
+	suite.mockService.EXPECT().DeleteRateLimit(mock.Anything, testRateLimitID).
+		Return(&serviceerror.InternalServerError).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/notification-rate-limits/"+testRateLimitID, nil)
+	req.SetPathValue("id", testRateLimitID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleRateLimitDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (suite *RateLimitHandlerTestSuite) TestHandleRateLimitRequests_EmptyID() {
+	handlers := []func(http.ResponseWriter, *http.Request){
+		suite.handler.HandleRateLimitGetRequest,
+		suite.handler.HandleRateLimitUpdateRequest,
+		suite.handler.HandleRateLimitDeleteRequest,
+	}
+	for _, handle := range handlers {
+		req := httptest.NewRequest(http.MethodGet, "/notification-rate-limits/", nil)
+		req.SetPathValue("id", " ")
+		rr := httptest.NewRecorder()
+		handle(rr, req)
+
+		suite.Equal(http.StatusBadRequest, rr.Code)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// incrementRateLimitCounterScript atomically increments a rate limit counter and sets its expiry, in unix
+// milliseconds, when the counter is created. Returns the updated count.
+var incrementRateLimitCounterScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+  redis.call('PEXPIREAT', KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// rateLimitRedisClient abstracts the Redis commands used by the rate limit store.
+type rateLimitRedisClient interface {
+	redis.Scripter
+}
+
+// redisRateLimitStore is the implementation of rateLimitStoreInterface used when the runtime store is Redis.
+// The rate limits are stored in the config database while the counters are stored in Redis, so that they are
+// shared by all the nodes of a deployment.
+type redisRateLimitStore struct {
+	store        rateLimitStoreInterface
+	client       rateLimitRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisRateLimitStore creates a new rate limit store that keeps the counters in Redis.
+func newRedisRateLimitStore(store rateLimitStoreInterface, p provider.RedisProviderInterface,
+	deploymentID string) rateLimitStoreInterface {
+	return &redisRateLimitStore{
+		store:        store,
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// counterKey builds the Redis key for a rate limit counter.
+func (s *redisRateLimitStore) counterKey(key string) string {
+	return fmt.Sprintf("%s:runtime:%s:notification-rate-limit:%s", s.keyPrefix, s.deploymentID, key)
+}
+
+// createRateLimit creates a new notification rate limit.
+func (s *redisRateLimitStore) createRateLimit(ctx context.Context, rateLimit common.NotificationRateLimitDTO) error {
+	return s.store.createRateLimit(ctx, rateLimit)
+}
+
+// listRateLimits retrieves all notification rate limits.
+func (s *redisRateLimitStore) listRateLimits(ctx context.Context) ([]common.NotificationRateLimitDTO, error) {
+	return s.store.listRateLimits(ctx)
+}
+
+// listRateLimitsByChannel retrieves the notification rate limits of the given channel.
+func (s *redisRateLimitStore) listRateLimitsByChannel(ctx context.Context,
+	channel common.ChannelType) ([]common.NotificationRateLimitDTO, error) {
+	return s.store.listRateLimitsByChannel(ctx, channel)
+}
+
+// getRateLimitByID retrieves a notification rate limit by its ID.
+func (s *redisRateLimitStore) getRateLimitByID(ctx context.Context,
+	id string) (*common.NotificationRateLimitDTO, error) {
+	return s.store.getRateLimitByID(ctx, id)
+}
+
+// updateRateLimit updates a notification rate limit.
+func (s *redisRateLimitStore) updateRateLimit(ctx context.Context, rateLimit common.NotificationRateLimitDTO) error {
+	return s.store.updateRateLimit(ctx, rateLimit)
+}
+
+// deleteRateLimit deletes a notification rate limit.
+func (s *redisRateLimitStore) deleteRateLimit(ctx context.Context, id string) error {
+	return s.store.deleteRateLimit(ctx, id)
+}
+
+// incrementCounter atomically increments the rate limit counter with the given key in Redis and returns the
+// updated count. The counter expires at the given expiry time.
+func (s *redisRateLimitStore) incrementCounter(ctx context.Context, key string, expiryTime time.Time) (int, error) {
+	count, err := incrementRateLimitCounterScript.Run(ctx, s.client, []string{s.counterKey(key)},
+		expiryTime.UnixMilli()).Int()
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment rate limit counter in Redis: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment-id"
+	redisTestCounterKey   = "otp:sms:recipient:abc"
+)
+
+type RedisRateLimitStoreTestSuite struct {
+	suite.Suite
+	mockClient *rateLimitRedisClientMock
+	mockStore  *rateLimitStoreInterfaceMock
+	store      *redisRateLimitStore
+	ctx        context.Context
+	redisKey   string
+	expiryTime time.Time
+}
+
+func TestRedisRateLimitStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisRateLimitStoreTestSuite))
+}
+
+func (suite *RedisRateLimitStoreTestSuite) SetupTest() {
+	suite.mockClient = newRateLimitRedisClientMock(suite.T())
+	suite.mockStore = newRateLimitStoreInterfaceMock(suite.T())
+	suite.store = &redisRateLimitStore{
+		store:        suite.mockStore,
+		client:       suite.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+	}
+	suite.ctx = context.Background()
+	suite.redisKey = redisTestKeyPrefix + ":runtime:" + redisTestDeploymentID + ":notification-rate-limit:" +
+		redisTestCounterKey
+	suite.expiryTime = time.Now().Add(time.Minute)
+}
+
+func (suite *RedisRateLimitStoreTestSuite) TestCounterKey() {
+	suite.Equal(suite.redisKey, suite.store.counterKey(redisTestCounterKey))
+}
+
+// Tests for incrementCounter
+//
+// incrementRateLimitCounterScript.Run() calls EvalSha with the script's precomputed SHA.
+
+func (suite *RedisRateLimitStoreTestSuite) TestIncrementCounter_Success() {
+	cmd := redis.NewCmd(suite.ctx)
+	cmd.SetVal(int64(3))
+	suite.mockClient.On("EvalSha", suite.ctx, incrementRateLimitCounterScript.Hash(),
+		[]string{suite.redisKey}, suite.expiryTime.UnixMilli()).Return(cmd)
+
+	count, err := suite.store.incrementCounter(suite.ctx, redisTestCounterKey, suite.expiryTime)
+	suite.NoError(err)
+	suite.Equal(3, count)
+}
+
+func (suite *RedisRateLimitStoreTestSuite) TestIncrementCounter_ScriptError() {
+	cmd := redis.NewCmd(suite.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("EvalSha", suite.ctx, incrementRateLimitCounterScript.Hash(),
+		[]string{suite.redisKey}, suite.expiryTime.UnixMilli()).Return(cmd)
+
+	count, err := suite.store.incrementCounter(suite.ctx, redisTestCounterKey, suite.expiryTime)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to increment rate limit counter in Redis")
+	suite.Equal(0, count)
+}
+
+// Tests for the rate limit operations delegated to the database store
+
+func (suite *RedisRateLimitStoreTestSuite) TestCreateRateLimit_Delegates() {
+	rateLimit := common.NotificationRateLimitDTO{ID: "rl-1", Channel: common.ChannelTypeSMS}
+	suite.mockStore.On("createRateLimit", suite.ctx, rateLimit).Return(nil)
+
+	suite.NoError(suite.store.createRateLimit(suite.ctx, rateLimit))
+}
+
+func (suite *RedisRateLimitStoreTestSuite) TestListRateLimitsByChannel_Delegates() {
+	rateLimits := []common.NotificationRateLimitDTO{{ID: "rl-1", Channel: common.ChannelTypeSMS}}
+	suite.mockStore.On("listRateLimitsByChannel", suite.ctx, common.ChannelTypeSMS).Return(rateLimits, nil)
+
+	result, err := suite.store.listRateLimitsByChannel(suite.ctx, common.ChannelTypeSMS)
+	suite.NoError(err)
+	suite.Equal(rateLimits, result)
+}
+
+func (suite *RedisRateLimitStoreTestSuite) TestGetRateLimitByID_Delegates() {
+	rateLimit := &common.NotificationRateLimitDTO{ID: "rl-1", Channel: common.ChannelTypeSMS}
+	suite.mockStore.On("getRateLimitByID", suite.ctx, "rl-1").Return(rateLimit, nil)
+
+	result, err := suite.store.getRateLimitByID(suite.ctx, "rl-1")
+	suite.NoError(err)
+	suite.Equal(rateLimit, result)
+}
+
+func (suite *RedisRateLimitStoreTestSuite) TestDeleteRateLimit_Delegates() {
+	suite.mockStore.On("deleteRateLimit", suite.ctx, "rl-1").Return(errors.New("delete failed"))
+
+	err := suite.store.deleteRateLimit(suite.ctx, "rl-1")
+	suite.EqualError(err, "delete failed")
+}
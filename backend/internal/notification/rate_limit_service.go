@@ -0,0 +1,280 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// maxRateLimitWindowSeconds is the maximum length of a rate limit window.
+const maxRateLimitWindowSeconds = 24 * 60 * 60
+
+// rateLimitServiceInterface defines the interface for managing notification rate limits and enforcing them on
+// OTP and verification messages.
+type rateLimitServiceInterface interface {
+	ListRateLimits(ctx context.Context) ([]common.NotificationRateLimitDTO, *serviceerror.ServiceError)
+	GetRateLimit(ctx context.Context, id string) (*common.NotificationRateLimitDTO, *serviceerror.ServiceError)
+	CreateRateLimit(ctx context.Context, rateLimit common.NotificationRateLimitDTO) (
+		*common.NotificationRateLimitDTO, *serviceerror.ServiceError)
+	UpdateRateLimit(ctx context.Context, id string, rateLimit common.NotificationRateLimitDTO) (
+		*common.NotificationRateLimitDTO, *serviceerror.ServiceError)
+	DeleteRateLimit(ctx context.Context, id string) *serviceerror.ServiceError
+	CheckRateLimit(ctx context.Context, channel common.ChannelType, ouID, recipient string) *serviceerror.ServiceError
+}
+
+// rateLimitService implements rateLimitServiceInterface.
+type rateLimitService struct {
+	store  rateLimitStoreInterface
+	now    func() time.Time
+	logger *log.Logger
+}
+
+// newRateLimitService returns a new instance of rateLimitServiceInterface.
+func newRateLimitService(store rateLimitStoreInterface) rateLimitServiceInterface {
+	return &rateLimitService{
+		store:  store,
+		now:    time.Now,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationRateLimitService")),
+	}
+}
+
+// ListRateLimits retrieves all notification rate limits.
+func (s *rateLimitService) ListRateLimits(ctx context.Context) (
+	[]common.NotificationRateLimitDTO, *serviceerror.ServiceError) {
+	rateLimits, err := s.store.listRateLimits(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list notification rate limits", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return rateLimits, nil
+}
+
+// GetRateLimit retrieves a notification rate limit by its ID.
+func (s *rateLimitService) GetRateLimit(ctx context.Context, id string) (
+	*common.NotificationRateLimitDTO, *serviceerror.ServiceError) {
+	if id == "" {
+		return nil, &ErrorInvalidRateLimitID
+	}
+
+	rateLimit, err := s.store.getRateLimitByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve notification rate limit", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if rateLimit == nil {
+		return nil, &ErrorRateLimitNotFound
+	}
+
+	return rateLimit, nil
+}
+
+// CreateRateLimit creates a new notification rate limit.
+func (s *rateLimitService) CreateRateLimit(ctx context.Context, rateLimit common.NotificationRateLimitDTO) (
+	*common.NotificationRateLimitDTO, *serviceerror.ServiceError) {
+	if err := declarativeresource.CheckDeclarativeCreate(); err != nil {
+		return nil, err
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	rateLimit.ID = id
+
+	if svcErr := s.validateRateLimit(ctx, rateLimit); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.store.createRateLimit(ctx, rateLimit); err != nil {
+		s.logger.Error("Failed to create notification rate limit", log.String("channel", string(rateLimit.Channel)),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &rateLimit, nil
+}
+
+// UpdateRateLimit updates an existing notification rate limit.
+func (s *rateLimitService) UpdateRateLimit(ctx context.Context, id string,
+	rateLimit common.NotificationRateLimitDTO) (*common.NotificationRateLimitDTO, *serviceerror.ServiceError) {
+	if err := declarativeresource.CheckDeclarativeUpdate(); err != nil {
+		return nil, err
+	}
+
+	if _, svcErr := s.GetRateLimit(ctx, id); svcErr != nil {
+		return nil, svcErr
+	}
+	rateLimit.ID = id
+
+	if svcErr := s.validateRateLimit(ctx, rateLimit); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.store.updateRateLimit(ctx, rateLimit); err != nil {
+		s.logger.Error("Failed to update notification rate limit", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &rateLimit, nil
+}
+
+// DeleteRateLimit deletes a notification rate limit. Deleting a rate limit that does not exist is not an error.
+func (s *rateLimitService) DeleteRateLimit(ctx context.Context, id string) *serviceerror.ServiceError {
+	if err := declarativeresource.CheckDeclarativeDelete(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return &ErrorInvalidRateLimitID
+	}
+
+	if err := s.store.deleteRateLimit(ctx, id); err != nil {
+		s.logger.Error("Failed to delete notification rate limit", log.String("id", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	return nil
+}
+
+// CheckRateLimit records a message request for the recipient and the client IP address of the request, and
+// returns an error if the rate limit of the channel is exceeded. A rate limit of the organization unit takes
+// precedence over the global rate limit of the channel. Requests are not limited if no rate limit applies.
+func (s *rateLimitService) CheckRateLimit(ctx context.Context, channel common.ChannelType,
+	ouID, recipient string) *serviceerror.ServiceError {
+	rateLimits, err := s.store.listRateLimitsByChannel(ctx, channel)
+	if err != nil {
+		s.logger.Error("Failed to retrieve notification rate limits", log.String("channel", string(channel)),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	rateLimit := selectRateLimit(rateLimits, ouID)
+	if rateLimit == nil {
+		return nil
+	}
+
+	// Counters are kept per fixed window and expire at the end of the window.
+	window := time.Duration(rateLimit.WindowSeconds) * time.Second
+	windowStart := s.now().UTC().Truncate(window)
+	expiryTime := windowStart.Add(window)
+
+	exceeded := false
+	if rateLimit.RecipientLimit > 0 {
+		key := getRateLimitCounterKey(rateLimit.ID, "recipient", recipient, windowStart)
+		count, err := s.store.incrementCounter(ctx, key, expiryTime)
+		if err != nil {
+			s.logger.Error("Failed to update the recipient rate limit counter", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		if count > rateLimit.RecipientLimit {
+			s.logger.Debug("Recipient rate limit exceeded", log.String("channel", string(channel)),
+				log.MaskedString("recipient", recipient))
+			exceeded = true
+		}
+	}
+
+	clientIP := sysContext.GetClientIP(ctx)
+	if rateLimit.IPLimit > 0 && clientIP != "" {
+		key := getRateLimitCounterKey(rateLimit.ID, "ip", clientIP, windowStart)
+		count, err := s.store.incrementCounter(ctx, key, expiryTime)
+		if err != nil {
+			s.logger.Error("Failed to update the client IP rate limit counter", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		if count > rateLimit.IPLimit {
+			s.logger.Debug("Client IP rate limit exceeded", log.String("channel", string(channel)),
+				log.String("clientIP", clientIP))
+			exceeded = true
+		}
+	}
+
+	if exceeded {
+		return &ErrorRateLimitExceeded
+	}
+
+	return nil
+}
+
+// validateRateLimit validates the notification rate limit and ensures no other rate limit is configured for the
+// same channel and organization unit.
+func (s *rateLimitService) validateRateLimit(ctx context.Context,
+	rateLimit common.NotificationRateLimitDTO) *serviceerror.ServiceError {
+	if _, ok := channelTemplateTypes[rateLimit.Channel]; !ok {
+		return &ErrorInvalidChannel
+	}
+	if rateLimit.RecipientLimit < 0 || rateLimit.IPLimit < 0 ||
+		(rateLimit.RecipientLimit == 0 && rateLimit.IPLimit == 0) {
+		return &ErrorInvalidRateLimit
+	}
+	if rateLimit.WindowSeconds <= 0 || rateLimit.WindowSeconds > maxRateLimitWindowSeconds {
+		return &ErrorInvalidRateLimit
+	}
+
+	existing, err := s.store.listRateLimitsByChannel(ctx, rateLimit.Channel)
+	if err != nil {
+		s.logger.Error("Failed to retrieve notification rate limits",
+			log.String("channel", string(rateLimit.Channel)), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	for _, other := range existing {
+		if other.ID != rateLimit.ID && other.OUID == rateLimit.OUID {
+			return &ErrorDuplicateRateLimit
+		}
+	}
+
+	return nil
+}
+
+// selectRateLimit returns the rate limit applicable to the given organization unit. A rate limit scoped to the
+// organization unit overrides the global rate limit. Returns nil if no rate limit applies.
+func selectRateLimit(rateLimits []common.NotificationRateLimitDTO,
+	ouID string) *common.NotificationRateLimitDTO {
+	var selected *common.NotificationRateLimitDTO
+	for i := range rateLimits {
+		switch rateLimits[i].OUID {
+		case "":
+			if selected == nil {
+				selected = &rateLimits[i]
+			}
+		case ouID:
+			return &rateLimits[i]
+		}
+	}
+
+	return selected
+}
+
+// getRateLimitCounterKey returns the key of the rate limit counter for the given subject and window. The subject
+// is hashed so that recipient addresses are not stored in the counters.
+func getRateLimitCounterKey(rateLimitID, subjectType, subject string, windowStart time.Time) string {
+	return fmt.Sprintf("%s:%s:%s:%d", rateLimitID, subjectType, hash.GenerateThumbprintFromString(subject),
+		windowStart.Unix())
+}
@@ -0,0 +1,303 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const testRateLimitID = "rate-limit-1"
+
+type RateLimitServiceTestSuite struct {
+	suite.Suite
+	mockStore *rateLimitStoreInterfaceMock
+	now       time.Time
+	service   *rateLimitService
+}
+
+func TestRateLimitServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitServiceTestSuite))
+}
+
+func (suite *RateLimitServiceTestSuite) SetupSuite() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("", &config.Config{})
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *RateLimitServiceTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *RateLimitServiceTestSuite) SetupTest() {
+	suite.mockStore = newRateLimitStoreInterfaceMock(suite.T())
+	suite.now = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.service = &rateLimitService{
+		store:  suite.mockStore,
+		now:    func() time.Time { return suite.now },
+		logger: log.GetLogger(),
+	}
+}
+
+func getTestRateLimit() common.NotificationRateLimitDTO {
+	return common.NotificationRateLimitDTO{
+		ID:             testRateLimitID,
+		Channel:        common.ChannelTypeSMS,
+		RecipientLimit: 5,
+		IPLimit:        20,
+		WindowSeconds:  3600,
+	}
+}
+
+func (suite *RateLimitServiceTestSuite) TestNewRateLimitService() {
+	suite.NotNil(newRateLimitService(suite.mockStore))
+}
+
+func (suite *RateLimitServiceTestSuite) TestCreateRateLimit() {
+	rateLimit := getTestRateLimit()
+	rateLimit.ID = ""
+	rateLimit.OUID = testOUID
+	suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+		Return([]common.NotificationRateLimitDTO{getTestRateLimit()}, nil).Once()
+	suite.mockStore.EXPECT().createRateLimit(mock.Anything, mock.MatchedBy(func(
+		r common.NotificationRateLimitDTO) bool {
+		return r.ID != "" && r.OUID == testOUID
+	})).Return(nil).Once()
+
+	result, err := suite.service.CreateRateLimit(context.Background(), rateLimit)
+	suite.Nil(err)
+	suite.NotEmpty(result.ID)
+}
+
+func (suite *RateLimitServiceTestSuite) TestCreateRateLimit_Invalid() {
+	cases := []struct {
+		name         string
+		modify       func(r *common.NotificationRateLimitDTO)
+		expectedCode string
+	}{
+		{name: "InvalidChannel", modify: func(r *common.NotificationRateLimitDTO) { r.Channel = "fax" },
+			expectedCode: ErrorInvalidChannel.Code},
+		{name: "NegativeLimit", modify: func(r *common.NotificationRateLimitDTO) { r.IPLimit = -1 },
+			expectedCode: ErrorInvalidRateLimit.Code},
+		{name: "NoLimits", modify: func(r *common.NotificationRateLimitDTO) { r.RecipientLimit, r.IPLimit = 0, 0 },
+			expectedCode: ErrorInvalidRateLimit.Code},
+		{name: "ZeroWindow", modify: func(r *common.NotificationRateLimitDTO) { r.WindowSeconds = 0 },
+			expectedCode: ErrorInvalidRateLimit.Code},
+		{name: "WindowTooLong",
+			modify:       func(r *common.NotificationRateLimitDTO) { r.WindowSeconds = maxRateLimitWindowSeconds + 1 },
+			expectedCode: ErrorInvalidRateLimit.Code},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			rateLimit := getTestRateLimit()
+			tc.modify(&rateLimit)
+
+			result, err := suite.service.CreateRateLimit(context.Background(), rateLimit)
+			suite.Nil(result)
+			suite.Equal(tc.expectedCode, err.Code)
+		})
+	}
+}
+
+func (suite *RateLimitServiceTestSuite) TestCreateRateLimit_Duplicate() {
+	suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+		Return([]common.NotificationRateLimitDTO{getTestRateLimit()}, nil).Once()
+
+	result, err := suite.service.CreateRateLimit(context.Background(), getTestRateLimit())
+	suite.Nil(result)
+	suite.Equal(ErrorDuplicateRateLimit.Code, err.Code)
+}
+
+func (suite *RateLimitServiceTestSuite) TestUpdateRateLimit() {
+	existing := getTestRateLimit()
+	update := getTestRateLimit()
+	update.ID = ""
+	update.RecipientLimit = 3
+	suite.mockStore.EXPECT().getRateLimitByID(mock.Anything, testRateLimitID).Return(&existing, nil).Once()
+	suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+		Return([]common.NotificationRateLimitDTO{existing}, nil).Once()
+	suite.mockStore.EXPECT().updateRateLimit(mock.Anything, mock.MatchedBy(func(
+		r common.NotificationRateLimitDTO) bool {
+		return r.ID == testRateLimitID && r.RecipientLimit == 3
+	})).Return(nil).Once()
+
+	result, err := suite.service.UpdateRateLimit(context.Background(), testRateLimitID, update)
+	suite.Nil(err)
+	suite.Equal(testRateLimitID, result.ID)
+}
+
+func (suite *RateLimitServiceTestSuite) TestUpdateRateLimit_NotFound() {
+	suite.mockStore.EXPECT().getRateLimitByID(mock.Anything, testRateLimitID).Return(nil, nil).Once()
+
+	result, err := suite.service.UpdateRateLimit(context.Background(), testRateLimitID, getTestRateLimit())
+	suite.Nil(result)
+	suite.Equal(ErrorRateLimitNotFound.Code, err.Code)
+}
+
+func (suite *RateLimitServiceTestSuite) TestGetRateLimit_WithFailure() {
+	result, err := suite.service.GetRateLimit(context.Background(), "")
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidRateLimitID.Code, err.Code)
+
+	suite.mockStore.EXPECT().getRateLimitByID(mock.Anything, testRateLimitID).
+		Return(nil, errors.New("db err")).Once()
+	result, err = suite.service.GetRateLimit(context.Background(), testRateLimitID)
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *RateLimitServiceTestSuite) TestListRateLimits() {
+	suite.mockStore.EXPECT().listRateLimits(mock.Anything).
+		Return([]common.NotificationRateLimitDTO{getTestRateLimit()}, nil).Once()
+
+	result, err := suite.service.ListRateLimits(context.Background())
+	suite.Nil(err)
+	suite.Len(result, 1)
+}
+
+func (suite *RateLimitServiceTestSuite) TestDeleteRateLimit() {
+	suite.mockStore.EXPECT().deleteRateLimit(mock.Anything, testRateLimitID).Return(nil).Once()
+
+	err := suite.service.DeleteRateLimit(context.Background(), testRateLimitID)
+	suite.Nil(err)
+}
+
+func (suite *RateLimitServiceTestSuite) TestDeleteRateLimit_EmptyID() {
+	err := suite.service.DeleteRateLimit(context.Background(), "")
+	suite.Equal(ErrorInvalidRateLimitID.Code, err.Code)
+}
+
+func (suite *RateLimitServiceTestSuite) TestCheckRateLimit_NoRateLimit() {
+	suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+		Return([]common.NotificationRateLimitDTO{}, nil).Once()
+
+	err := suite.service.CheckRateLimit(context.Background(), common.ChannelTypeSMS, testOUID, "+15551234567")
+	suite.Nil(err)
+}
+
+func (suite *RateLimitServiceTestSuite) TestCheckRateLimit_WithinLimits() {
+	windowStart := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	expiryTime := windowStart.Add(time.Hour)
+	ctx := sysContext.WithClientIP(context.Background(), "192.0.2.10")
+	suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+		Return([]common.NotificationRateLimitDTO{getTestRateLimit()}, nil).Once()
+	suite.mockStore.EXPECT().incrementCounter(mock.Anything,
+		getRateLimitCounterKey(testRateLimitID, "recipient", "+15551234567", windowStart), expiryTime).
+		Return(5, nil).Once()
+	suite.mockStore.EXPECT().incrementCounter(mock.Anything,
+		getRateLimitCounterKey(testRateLimitID, "ip", "192.0.2.10", windowStart), expiryTime).
+		Return(20, nil).Once()
+
+	err := suite.service.CheckRateLimit(ctx, common.ChannelTypeSMS, "", "+15551234567")
+	suite.Nil(err)
+}
+
+func (suite *RateLimitServiceTestSuite) TestCheckRateLimit_RecipientLimitExceeded() {
+	suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+		Return([]common.NotificationRateLimitDTO{getTestRateLimit()}, nil).Once()
+	suite.mockStore.EXPECT().incrementCounter(mock.Anything, mock.Anything, mock.Anything).Return(6, nil).Once()
+
+	// The client IP is not limited when the context does not carry one.
+	err := suite.service.CheckRateLimit(context.Background(), common.ChannelTypeSMS, "", "+15551234567")
+	suite.Equal(ErrorRateLimitExceeded.Code, err.Code)
+}
+
+func (suite *RateLimitServiceTestSuite) TestCheckRateLimit_IPLimitExceeded() {
+	rateLimit := getTestRateLimit()
+	rateLimit.RecipientLimit = 0
+	ctx := sysContext.WithClientIP(context.Background(), "192.0.2.10")
+	suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+		Return([]common.NotificationRateLimitDTO{rateLimit}, nil).Once()
+	suite.mockStore.EXPECT().incrementCounter(mock.Anything, mock.MatchedBy(func(key string) bool {
+		return key == getRateLimitCounterKey(testRateLimitID, "ip", "192.0.2.10",
+			time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC))
+	}), mock.Anything).Return(21, nil).Once()
+
+	err := suite.service.CheckRateLimit(ctx, common.ChannelTypeSMS, "", "+15551234567")
+	suite.Equal(ErrorRateLimitExceeded.Code, err.Code)
+}
+
+func (suite *RateLimitServiceTestSuite) TestCheckRateLimit_OURateLimitOverridesGlobal() {
+	global := getTestRateLimit()
+	scoped := common.NotificationRateLimitDTO{ID: "scoped", Channel: common.ChannelTypeSMS, OUID: testOUID,
+		RecipientLimit: 1, WindowSeconds: 60}
+	otherOU := common.NotificationRateLimitDTO{ID: "other", Channel: common.ChannelTypeSMS, OUID: "ou-2",
+		RecipientLimit: 100, WindowSeconds: 60}
+	suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+		Return([]common.NotificationRateLimitDTO{global, otherOU, scoped}, nil).Once()
+	suite.mockStore.EXPECT().incrementCounter(mock.Anything,
+		getRateLimitCounterKey("scoped", "recipient", "+15551234567", time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)),
+		time.Date(2026, 1, 2, 3, 5, 0, 0, time.UTC)).Return(2, nil).Once()
+
+	err := suite.service.CheckRateLimit(context.Background(), common.ChannelTypeSMS, testOUID, "+15551234567")
+	suite.Equal(ErrorRateLimitExceeded.Code, err.Code)
+}
+
+func (suite *RateLimitServiceTestSuite) TestCheckRateLimit_StoreError() {
+	cases := []struct {
+		name  string
+		setup func()
+	}{
+		{
+			name: "ListError",
+			setup: func() {
+				suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+					Return(nil, errors.New("db err")).Once()
+			},
+		},
+		{
+			name: "CounterError",
+			setup: func() {
+				suite.mockStore.EXPECT().listRateLimitsByChannel(mock.Anything, common.ChannelTypeSMS).
+					Return([]common.NotificationRateLimitDTO{getTestRateLimit()}, nil).Once()
+				suite.mockStore.EXPECT().incrementCounter(mock.Anything, mock.Anything, mock.Anything).
+					Return(0, errors.New("db err")).Once()
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			tc.setup()
+			err := suite.service.CheckRateLimit(context.Background(), common.ChannelTypeSMS, "", "+15551234567")
+			suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+		})
+	}
+}
+
+func (suite *RateLimitServiceTestSuite) TestGetRateLimitCounterKey_HashesSubject() {
+	key := getRateLimitCounterKey(testRateLimitID, "recipient", "+15551234567", suite.now)
+	suite.NotContains(key, "+15551234567")
+	suite.Contains(key, testRateLimitID+":recipient:")
+}
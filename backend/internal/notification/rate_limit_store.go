@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// rateLimitStoreInterface defines the interface for notification rate limit storage operations.
+type rateLimitStoreInterface interface {
+	createRateLimit(ctx context.Context, rateLimit common.NotificationRateLimitDTO) error
+	listRateLimits(ctx context.Context) ([]common.NotificationRateLimitDTO, error)
+	listRateLimitsByChannel(ctx context.Context, channel common.ChannelType) ([]common.NotificationRateLimitDTO,
+		error)
+	getRateLimitByID(ctx context.Context, id string) (*common.NotificationRateLimitDTO, error)
+	updateRateLimit(ctx context.Context, rateLimit common.NotificationRateLimitDTO) error
+	deleteRateLimit(ctx context.Context, id string) error
+	incrementCounter(ctx context.Context, key string, expiryTime time.Time) (int, error)
+}
+
+// rateLimitStore is the database implementation of rateLimitStoreInterface. The rate limits are stored in the
+// config database while the counters are stored in the runtime database.
+type rateLimitStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newRateLimitStore returns a new instance of rateLimitStoreInterface.
+func newRateLimitStore() rateLimitStoreInterface {
+	return &rateLimitStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createRateLimit creates a new notification rate limit.
+func (s *rateLimitStore) createRateLimit(ctx context.Context, rateLimit common.NotificationRateLimitDTO) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateNotificationRateLimit, rateLimit.ID, string(rateLimit.Channel),
+		dbutils.ToNullableString(rateLimit.OUID), rateLimit.RecipientLimit, rateLimit.IPLimit, rateLimit.WindowSeconds,
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// listRateLimits retrieves all notification rate limits.
+func (s *rateLimitStore) listRateLimits(ctx context.Context) ([]common.NotificationRateLimitDTO, error) {
+	return s.queryRateLimits(ctx, queryGetAllNotificationRateLimits, s.deploymentID)
+}
+
+// listRateLimitsByChannel retrieves the notification rate limits configured for a channel.
+func (s *rateLimitStore) listRateLimitsByChannel(ctx context.Context,
+	channel common.ChannelType) ([]common.NotificationRateLimitDTO, error) {
+	return s.queryRateLimits(ctx, queryGetNotificationRateLimitsByChannel, string(channel), s.deploymentID)
+}
+
+// getRateLimitByID retrieves a notification rate limit by its ID. Returns nil if the rate limit does not exist.
+func (s *rateLimitStore) getRateLimitByID(ctx context.Context,
+	id string) (*common.NotificationRateLimitDTO, error) {
+	rateLimits, err := s.queryRateLimits(ctx, queryGetNotificationRateLimitByID, id, s.deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rateLimits) == 0 {
+		return nil, nil
+	}
+	if len(rateLimits) > 1 {
+		return nil, fmt.Errorf("multiple rate limits found for id: %s", id)
+	}
+
+	return &rateLimits[0], nil
+}
+
+// updateRateLimit updates an existing notification rate limit.
+func (s *rateLimitStore) updateRateLimit(ctx context.Context, rateLimit common.NotificationRateLimitDTO) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateNotificationRateLimit, string(rateLimit.Channel),
+		dbutils.ToNullableString(rateLimit.OUID), rateLimit.RecipientLimit, rateLimit.IPLimit, rateLimit.WindowSeconds,
+		rateLimit.ID, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// deleteRateLimit deletes a notification rate limit.
+func (s *rateLimitStore) deleteRateLimit(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteNotificationRateLimit, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// incrementCounter increments the rate limit counter with the given key and returns the updated count. The
+// counter is created with the given expiry time if it does not exist.
+func (s *rateLimitStore) incrementCounter(ctx context.Context, key string, expiryTime time.Time) (int, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryIncrementRateLimitCounter, key, expiryTime, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, errors.New("no count returned for the rate limit counter")
+	}
+
+	count, err := parseIntField(results[0]["request_count"], "request_count")
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// queryRateLimits executes the given query and builds the notification rate limits from the result rows.
+func (s *rateLimitStore) queryRateLimits(ctx context.Context, query dbmodel.DBQuery,
+	args ...interface{}) ([]common.NotificationRateLimitDTO, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	rateLimits := make([]common.NotificationRateLimitDTO, 0, len(results))
+	for _, row := range results {
+		rateLimit, err := buildRateLimitFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build rate limit from result row: %w", err)
+		}
+		rateLimits = append(rateLimits, *rateLimit)
+	}
+
+	return rateLimits, nil
+}
+
+// buildRateLimitFromResultRow constructs a NotificationRateLimitDTO from a database result row.
+func buildRateLimitFromResultRow(row map[string]interface{}) (*common.NotificationRateLimitDTO, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	channel, ok := row["channel"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse channel as string")
+	}
+	recipientLimit, err := parseIntField(row["recipient_limit"], "recipient_limit")
+	if err != nil {
+		return nil, err
+	}
+	ipLimit, err := parseIntField(row["ip_limit"], "ip_limit")
+	if err != nil {
+		return nil, err
+	}
+	windowSeconds, err := parseIntField(row["window_seconds"], "window_seconds")
+	if err != nil {
+		return nil, err
+	}
+
+	// The organization unit is NULL for global rate limits.
+	ouID, _ := row["ou_id"].(string)
+
+	return &common.NotificationRateLimitDTO{
+		ID:             id,
+		Channel:        common.ChannelType(channel),
+		OUID:           ouID,
+		RecipientLimit: recipientLimit,
+		IPLimit:        ipLimit,
+		WindowSeconds:  windowSeconds,
+	}, nil
+}
+
+// parseIntField parses an integer column value returned by the database driver.
+func parseIntField(value interface{}, fieldName string) (int, error) {
+	switch v := value.(type) {
+	case int64:
+		return int(v), nil
+	case int32:
+		return int(v), nil
+	case int:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("failed to parse %s as integer", fieldName)
+	}
+}
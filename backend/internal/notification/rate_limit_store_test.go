@@ -0,0 +1,207 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+type RateLimitStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *rateLimitStore
+}
+
+func TestRateLimitStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitStoreTestSuite))
+}
+
+func (suite *RateLimitStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &rateLimitStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *RateLimitStoreTestSuite) TestCreateRateLimit() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateNotificationRateLimit,
+		testRateLimitID, "sms", nil, 5, 20, 3600, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createRateLimit(context.Background(), common.NotificationRateLimitDTO{
+		ID:             testRateLimitID,
+		Channel:        common.ChannelTypeSMS,
+		RecipientLimit: 5,
+		IPLimit:        20,
+		WindowSeconds:  3600,
+	})
+	suite.NoError(err)
+}
+
+func (suite *RateLimitStoreTestSuite) TestCreateRateLimit_WithError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createRateLimit(context.Background(), common.NotificationRateLimitDTO{ID: testRateLimitID})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *RateLimitStoreTestSuite) TestListRateLimitsByChannel() {
+	rows := []map[string]interface{}{
+		{"id": testRateLimitID, "channel": "sms", "ou_id": testOUID, "recipient_limit": int64(5),
+			"ip_limit": int64(0), "window_seconds": int64(3600)},
+		{"id": "rate-limit-2", "channel": "sms", "ou_id": nil, "recipient_limit": int64(10),
+			"ip_limit": int64(50), "window_seconds": int64(60)},
+	}
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetNotificationRateLimitsByChannel,
+		"sms", testDeploymentID).Return(rows, nil).Once()
+
+	rateLimits, err := suite.store.listRateLimitsByChannel(context.Background(), common.ChannelTypeSMS)
+	suite.NoError(err)
+	suite.Len(rateLimits, 2)
+	suite.Equal(testOUID, rateLimits[0].OUID)
+	suite.Equal(5, rateLimits[0].RecipientLimit)
+	suite.Empty(rateLimits[1].OUID)
+	suite.Equal(50, rateLimits[1].IPLimit)
+	suite.Equal(60, rateLimits[1].WindowSeconds)
+}
+
+func (suite *RateLimitStoreTestSuite) TestListRateLimits_InvalidRow() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetAllNotificationRateLimits,
+		testDeploymentID).Return([]map[string]interface{}{{"id": testRateLimitID, "channel": "sms"}}, nil).Once()
+
+	rateLimits, err := suite.store.listRateLimits(context.Background())
+	suite.Nil(rateLimits)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to build rate limit")
+}
+
+func (suite *RateLimitStoreTestSuite) TestGetRateLimitByID_NotFound() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetNotificationRateLimitByID,
+		testRateLimitID, testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	rateLimit, err := suite.store.getRateLimitByID(context.Background(), testRateLimitID)
+	suite.NoError(err)
+	suite.Nil(rateLimit)
+}
+
+func (suite *RateLimitStoreTestSuite) TestUpdateRateLimit() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateNotificationRateLimit,
+		"email", testOUID, 0, 10, 600, testRateLimitID, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.updateRateLimit(context.Background(), common.NotificationRateLimitDTO{
+		ID:            testRateLimitID,
+		Channel:       common.ChannelTypeEmail,
+		OUID:          testOUID,
+		IPLimit:       10,
+		WindowSeconds: 600,
+	})
+	suite.NoError(err)
+}
+
+func (suite *RateLimitStoreTestSuite) TestDeleteRateLimit() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteNotificationRateLimit,
+		testRateLimitID, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.deleteRateLimit(context.Background(), testRateLimitID)
+	suite.NoError(err)
+}
+
+func (suite *RateLimitStoreTestSuite) TestIncrementCounter() {
+	expiryTime := time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryIncrementRateLimitCounter, "key",
+		expiryTime, testDeploymentID).Return([]map[string]interface{}{{"request_count": int64(3)}}, nil).Once()
+
+	count, err := suite.store.incrementCounter(context.Background(), "key", expiryTime)
+	suite.NoError(err)
+	suite.Equal(3, count)
+}
+
+func (suite *RateLimitStoreTestSuite) TestIncrementCounter_WithFailure() {
+	expiryTime := time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name    string
+		setup   func()
+		wantErr string
+	}{
+		{
+			name: "GetDBClientError",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+			},
+			wantErr: "failed to get database client",
+		},
+		{
+			name: "QueryError",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryIncrementRateLimitCounter,
+					"key", expiryTime, testDeploymentID).Return(nil, errors.New("query fail")).Once()
+			},
+			wantErr: "failed to execute query",
+		},
+		{
+			name: "NoResult",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryIncrementRateLimitCounter,
+					"key", expiryTime, testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+			},
+			wantErr: "no count returned",
+		},
+		{
+			name: "InvalidCount",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryIncrementRateLimitCounter,
+					"key", expiryTime, testDeploymentID).
+					Return([]map[string]interface{}{{"request_count": "three"}}, nil).Once()
+			},
+			wantErr: "failed to parse request_count",
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			tc.setup()
+			count, err := suite.store.incrementCounter(context.Background(), "key", expiryTime)
+			suite.Zero(count)
+			suite.Error(err)
+			suite.Contains(err.Error(), tc.wantErr)
+		})
+	}
+}
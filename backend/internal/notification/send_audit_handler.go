@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// sendAuditHandler handles HTTP requests for querying the audit of notification sends.
+type sendAuditHandler struct {
+	sendAuditService SendAuditServiceInterface
+}
+
+// newSendAuditHandler creates a new instance of sendAuditHandler.
+func newSendAuditHandler(sendAuditService SendAuditServiceInterface) *sendAuditHandler {
+	return &sendAuditHandler{
+		sendAuditService: sendAuditService,
+	}
+}
+
+// HandleSendAuditListRequest handles the request to list the audit records of notification sends. The records
+// can be filtered by channel, status, template and recipient.
+func (h *sendAuditHandler) HandleSendAuditListRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := serverconst.DefaultPageSize
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidOffset)
+			return
+		}
+		offset = parsed
+	}
+
+	filter := common.SendAuditFilter{
+		Channel:   common.ChannelType(strings.ToLower(strings.TrimSpace(query.Get("channel")))),
+		Status:    common.SendStatus(strings.ToUpper(strings.TrimSpace(query.Get("status")))),
+		Template:  strings.TrimSpace(query.Get("template")),
+		Recipient: strings.TrimSpace(query.Get("recipient")),
+	}
+
+	result, svcErr := h.sendAuditService.ListSendAudits(r.Context(), filter, limit, offset)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	records := make([]common.SendAuditResponse, 0, len(result.Records))
+	for _, record := range result.Records {
+		records = append(records, common.SendAuditResponse(record))
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, common.SendAuditListResponse{
+		TotalResults: result.TotalResults,
+		StartIndex:   result.StartIndex,
+		Count:        result.Count,
+		Records:      records,
+		Links:        result.Links,
+	})
+}
+
+// handleError writes the HTTP error response for the given service error.
+func (h *sendAuditHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
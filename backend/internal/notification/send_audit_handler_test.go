@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+type SendAuditHandlerTestSuite struct {
+	suite.Suite
+	mockService *SendAuditServiceInterfaceMock
+	handler     *sendAuditHandler
+}
+
+func TestSendAuditHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SendAuditHandlerTestSuite))
+}
+
+func (suite *SendAuditHandlerTestSuite) SetupTest() {
+	suite.mockService = NewSendAuditServiceInterfaceMock(suite.T())
+	suite.handler = newSendAuditHandler(suite.mockService)
+}
+
+func (suite *SendAuditHandlerTestSuite) TestHandleSendAuditListRequest() {
+	req := httptest.NewRequest(http.MethodGet, "/notification-send-audits?limit=5&offset=10&channel=EMAIL"+
+		"&status=failed&template=PASSWORD_RESET&recipient=user%40example.com", nil)
+	rr := httptest.NewRecorder()
+
+	expectedFilter := common.SendAuditFilter{
+		Channel:   common.ChannelTypeEmail,
+		Status:    common.SendStatusFailed,
+		Template:  "PASSWORD_RESET",
+		Recipient: "user@example.com",
+	}
+	suite.mockService.EXPECT().ListSendAudits(mock.Anything, expectedFilter, 5, 10).Return(&common.SendAuditList{
+		TotalResults: 11,
+		StartIndex:   11,
+		Count:        1,
+		Records: []common.SendAuditRecord{{
+			ID:               testSendAuditID,
+			Channel:          common.ChannelTypeEmail,
+			Template:         "PASSWORD_RESET",
+			Recipient:        "************.com",
+			Status:           common.SendStatusFailed,
+			ProviderResponse: "mailbox unavailable",
+			CreatedAt:        time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		}},
+		Links: []sysutils.Link{{Href: "/notification-send-audits?offset=5&limit=5", Rel: "prev"}},
+	}, nil).Once()
+
+	suite.handler.HandleSendAuditListRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var res common.SendAuditListResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Equal(11, res.TotalResults)
+	suite.Equal(11, res.StartIndex)
+	suite.Equal(1, res.Count)
+	suite.Len(res.Records, 1)
+	suite.Equal("************.com", res.Records[0].Recipient)
+	suite.Equal("mailbox unavailable", res.Records[0].ProviderResponse)
+	suite.Len(res.Links, 1)
+}
+
+func (suite *SendAuditHandlerTestSuite) TestHandleSendAuditListRequest_DefaultPagination() {
+	req := httptest.NewRequest(http.MethodGet, "/notification-send-audits", nil)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().ListSendAudits(mock.Anything, common.SendAuditFilter{}, serverconst.DefaultPageSize,
+		0).Return(&common.SendAuditList{StartIndex: 1, Records: []common.SendAuditRecord{}}, nil).Once()
+
+	suite.handler.HandleSendAuditListRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var res common.SendAuditListResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Empty(res.Records)
+}
+
+func (suite *SendAuditHandlerTestSuite) TestHandleSendAuditListRequest_InvalidParams() {
+	cases := []struct {
+		name         string
+		query        string
+		expectedCode string
+	}{
+		{name: "InvalidLimit", query: "?limit=abc", expectedCode: ErrorInvalidLimit.Code},
+		{name: "InvalidOffset", query: "?offset=abc", expectedCode: ErrorInvalidOffset.Code},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/notification-send-audits"+tc.query, nil)
+			rr := httptest.NewRecorder()
+
+			suite.handler.HandleSendAuditListRequest(rr, req)
+			suite.Equal(http.StatusBadRequest, rr.Code)
+			suite.Contains(rr.Body.String(), tc.expectedCode)
+		})
+	}
+}
+
+func (suite *SendAuditHandlerTestSuite) TestHandleSendAuditListRequest_ServiceError() {
+	cases := []struct {
+		name           string
+		svcErr         *serviceerror.ServiceError
+		expectedStatus int
+	}{
+		{name: "ClientError", svcErr: &ErrorInvalidSendStatus, expectedStatus: http.StatusBadRequest},
+		{name: "ServerError", svcErr: &serviceerror.InternalServerError,
+			expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/notification-send-audits", nil)
+			rr := httptest.NewRecorder()
+
+			suite.mockService.EXPECT().ListSendAudits(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, tc.svcErr).Once()
+
+			suite.handler.HandleSendAuditListRequest(rr, req)
+			suite.Equal(tc.expectedStatus, rr.Code)
+		})
+	}
+}
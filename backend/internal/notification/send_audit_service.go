@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// maxProviderResponseLength is the maximum length of the provider response stored in a send audit record.
+const maxProviderResponseLength = 1024
+
+// auditedChannels lists the channels for which notification sends are audited.
+var auditedChannels = map[common.ChannelType]bool{
+	common.ChannelTypeSMS:   true,
+	common.ChannelTypeEmail: true,
+	common.ChannelTypePush:  true,
+}
+
+// SendAuditServiceInterface defines the interface for recording and querying the audit of notification sends.
+type SendAuditServiceInterface interface {
+	RecordSend(ctx context.Context, record common.SendAuditRecord)
+	ListSendAudits(ctx context.Context, filter common.SendAuditFilter, limit, offset int) (
+		*common.SendAuditList, *serviceerror.ServiceError)
+	PseudonymizeRecipient(ctx context.Context, recipient, pseudonym string) (int64, *serviceerror.ServiceError)
+}
+
+// sendAuditService implements SendAuditServiceInterface.
+type sendAuditService struct {
+	store  sendAuditStoreInterface
+	logger *log.Logger
+}
+
+// newSendAuditService returns a new instance of SendAuditServiceInterface.
+func newSendAuditService(store sendAuditStoreInterface) SendAuditServiceInterface {
+	return &sendAuditService{
+		store:  store,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SendAuditService")),
+	}
+}
+
+// RecordSend records a notification send attempt. The recipient is masked before the record is stored.
+// Failures to record the attempt are logged and do not affect the notification send.
+func (s *sendAuditService) RecordSend(ctx context.Context, record common.SendAuditRecord) {
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID for the send audit record", log.Error(err))
+		return
+	}
+	record.ID = id
+	record.CreatedAt = time.Now().UTC()
+
+	recipientHash := getRecipientHash(record.Recipient)
+	record.Recipient = maskRecipient(record.Recipient)
+	if len(record.ProviderResponse) > maxProviderResponseLength {
+		record.ProviderResponse = record.ProviderResponse[:maxProviderResponseLength]
+	}
+
+	if err := s.store.createSendAudit(ctx, record, recipientHash); err != nil {
+		s.logger.Error("Failed to record the notification send", log.String("channel", string(record.Channel)),
+			log.String("status", string(record.Status)), log.Error(err))
+	}
+}
+
+// ListSendAudits retrieves a page of the send audit records matching the filters, most recent first.
+func (s *sendAuditService) ListSendAudits(ctx context.Context, filter common.SendAuditFilter, limit,
+	offset int) (*common.SendAuditList, *serviceerror.ServiceError) {
+	if limit <= 0 || limit > serverconst.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return nil, &ErrorInvalidOffset
+	}
+	if filter.Channel != "" && !auditedChannels[filter.Channel] {
+		return nil, &ErrorInvalidChannel
+	}
+	if filter.Status != "" && filter.Status != common.SendStatusSuccess && filter.Status != common.SendStatusFailed {
+		return nil, &ErrorInvalidSendStatus
+	}
+
+	recipientHash := ""
+	if filter.Recipient != "" {
+		recipientHash = getRecipientHash(filter.Recipient)
+	}
+
+	totalCount, err := s.store.countSendAudits(ctx, filter, recipientHash)
+	if err != nil {
+		s.logger.Error("Failed to count send audit records", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	records, err := s.store.listSendAudits(ctx, filter, recipientHash, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list send audit records", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &common.SendAuditList{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(records),
+		Records:      records,
+		Links: sysutils.BuildPaginationLinks("/notification-send-audits", limit, offset, totalCount,
+			getSendAuditFilterQuery(filter)),
+	}, nil
+}
+
+// PseudonymizeRecipient replaces the recipient of the send audit records of a recipient with a pseudonym, so
+// that the records are kept without identifying the recipient. It returns the number of records updated.
+func (s *sendAuditService) PseudonymizeRecipient(ctx context.Context, recipient, pseudonym string) (
+	int64, *serviceerror.ServiceError) {
+	if strings.TrimSpace(recipient) == "" || pseudonym == "" {
+		return 0, &serviceerror.InternalServerError
+	}
+
+	updated, err := s.store.pseudonymizeSendAudits(ctx, getRecipientHash(recipient), pseudonym,
+		getRecipientHash(pseudonym))
+	if err != nil {
+		s.logger.Error("Failed to pseudonymize the recipient of send audit records", log.Error(err))
+		return 0, &serviceerror.InternalServerError
+	}
+
+	return updated, nil
+}
+
+// getRecipientHash returns the hash used to look up the send audit records of a recipient. The recipient is
+// normalized so that differences in letter case do not prevent a match.
+func getRecipientHash(recipient string) string {
+	return hash.GenerateThumbprintFromString(strings.ToLower(strings.TrimSpace(recipient)))
+}
+
+// getSendAuditFilterQuery returns the query string fragment of the filters to be appended to pagination links.
+func getSendAuditFilterQuery(filter common.SendAuditFilter) string {
+	var query strings.Builder
+	if filter.Channel != "" {
+		query.WriteString("&channel=" + string(filter.Channel))
+	}
+	if filter.Status != "" {
+		query.WriteString("&status=" + string(filter.Status))
+	}
+	if filter.Template != "" {
+		query.WriteString("&template=" + url.QueryEscape(filter.Template))
+	}
+	if filter.Recipient != "" {
+		query.WriteString("&recipient=" + url.QueryEscape(filter.Recipient))
+	}
+	return query.String()
+}
+
+// newSendAuditRecord builds the send audit record of a notification send attempt. The error returned by the
+// provider, if any, is recorded as the provider response of a failed send.
+func newSendAuditRecord(channel common.ChannelType, template, recipient, senderID, providerName string,
+	sendErr error) common.SendAuditRecord {
+	record := common.SendAuditRecord{
+		Channel:   channel,
+		Template:  template,
+		Recipient: recipient,
+		SenderID:  senderID,
+		Provider:  providerName,
+		Status:    common.SendStatusSuccess,
+	}
+	if sendErr != nil {
+		record.Status = common.SendStatusFailed
+		record.ProviderResponse = sendErr.Error()
+	}
+
+	return record
+}
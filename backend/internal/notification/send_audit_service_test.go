@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+type SendAuditServiceTestSuite struct {
+	suite.Suite
+	mockStore *sendAuditStoreInterfaceMock
+	service   *sendAuditService
+}
+
+func TestSendAuditServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SendAuditServiceTestSuite))
+}
+
+func (suite *SendAuditServiceTestSuite) SetupSuite() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("", &config.Config{})
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *SendAuditServiceTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *SendAuditServiceTestSuite) SetupTest() {
+	suite.mockStore = newSendAuditStoreInterfaceMock(suite.T())
+	suite.service = &sendAuditService{
+		store:  suite.mockStore,
+		logger: log.GetLogger(),
+	}
+}
+
+func (suite *SendAuditServiceTestSuite) TestNewSendAuditService() {
+	suite.NotNil(newSendAuditService(suite.mockStore))
+}
+
+func (suite *SendAuditServiceTestSuite) TestRecordSend_MasksRecipient() {
+	suite.mockStore.EXPECT().createSendAudit(mock.Anything, mock.MatchedBy(func(record common.SendAuditRecord) bool {
+		return record.ID != "" && !record.CreatedAt.IsZero() && record.Recipient == "************.com" &&
+			record.Status == common.SendStatusSuccess
+	}), getRecipientHash("user@example.com")).Return(nil).Once()
+
+	suite.service.RecordSend(context.Background(), common.SendAuditRecord{
+		Channel:   common.ChannelTypeEmail,
+		Recipient: "user@example.com",
+		Status:    common.SendStatusSuccess,
+	})
+}
+
+func (suite *SendAuditServiceTestSuite) TestRecordSend_TruncatesProviderResponse() {
+	suite.mockStore.EXPECT().createSendAudit(mock.Anything, mock.MatchedBy(func(record common.SendAuditRecord) bool {
+		return len(record.ProviderResponse) == maxProviderResponseLength
+	}), mock.Anything).Return(nil).Once()
+
+	suite.service.RecordSend(context.Background(), common.SendAuditRecord{
+		Channel:          common.ChannelTypeSMS,
+		Recipient:        "+15551234567",
+		Status:           common.SendStatusFailed,
+		ProviderResponse: strings.Repeat("x", maxProviderResponseLength+10),
+	})
+}
+
+func (suite *SendAuditServiceTestSuite) TestRecordSend_StoreErrorIsIgnored() {
+	suite.mockStore.EXPECT().createSendAudit(mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("db err")).Once()
+
+	suite.NotPanics(func() {
+		suite.service.RecordSend(context.Background(), common.SendAuditRecord{Channel: common.ChannelTypeSMS})
+	})
+}
+
+func (suite *SendAuditServiceTestSuite) TestListSendAudits() {
+	filter := common.SendAuditFilter{
+		Channel:   common.ChannelTypeEmail,
+		Template:  "PASSWORD_RESET",
+		Recipient: "User@Example.com ",
+	}
+	recipientHash := getRecipientHash("user@example.com")
+	records := []common.SendAuditRecord{{ID: testSendAuditID, Channel: common.ChannelTypeEmail}}
+	suite.mockStore.EXPECT().countSendAudits(mock.Anything, filter, recipientHash).Return(3, nil).Once()
+	suite.mockStore.EXPECT().listSendAudits(mock.Anything, filter, recipientHash, 1, 1).Return(records, nil).Once()
+
+	result, err := suite.service.ListSendAudits(context.Background(), filter, 1, 1)
+	suite.Nil(err)
+	suite.Equal(3, result.TotalResults)
+	suite.Equal(2, result.StartIndex)
+	suite.Equal(1, result.Count)
+	suite.Equal(records, result.Records)
+	suite.NotEmpty(result.Links)
+	for _, link := range result.Links {
+		suite.Contains(link.Href, "/notification-send-audits?")
+		suite.Contains(link.Href, "&channel=email&template=PASSWORD_RESET")
+	}
+}
+
+func (suite *SendAuditServiceTestSuite) TestListSendAudits_InvalidParams() {
+	cases := []struct {
+		name         string
+		filter       common.SendAuditFilter
+		limit        int
+		offset       int
+		expectedCode string
+	}{
+		{name: "ZeroLimit", limit: 0, expectedCode: ErrorInvalidLimit.Code},
+		{name: "LimitTooLarge", limit: serverconst.MaxPageSize + 1, expectedCode: ErrorInvalidLimit.Code},
+		{name: "NegativeOffset", limit: 10, offset: -1, expectedCode: ErrorInvalidOffset.Code},
+		{name: "InvalidChannel", filter: common.SendAuditFilter{Channel: "fax"}, limit: 10,
+			expectedCode: ErrorInvalidChannel.Code},
+		{name: "InvalidStatus", filter: common.SendAuditFilter{Status: "PENDING"}, limit: 10,
+			expectedCode: ErrorInvalidSendStatus.Code},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			result, err := suite.service.ListSendAudits(context.Background(), tc.filter, tc.limit, tc.offset)
+			suite.Nil(result)
+			suite.Equal(tc.expectedCode, err.Code)
+		})
+	}
+}
+
+func (suite *SendAuditServiceTestSuite) TestListSendAudits_StoreError() {
+	suite.mockStore.EXPECT().countSendAudits(mock.Anything, common.SendAuditFilter{}, "").
+		Return(0, errors.New("db err")).Once()
+
+	result, err := suite.service.ListSendAudits(context.Background(), common.SendAuditFilter{}, 10, 0)
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+
+	suite.mockStore.EXPECT().countSendAudits(mock.Anything, common.SendAuditFilter{}, "").Return(1, nil).Once()
+	suite.mockStore.EXPECT().listSendAudits(mock.Anything, common.SendAuditFilter{}, "", 10, 0).
+		Return(nil, errors.New("db err")).Once()
+
+	result, err = suite.service.ListSendAudits(context.Background(), common.SendAuditFilter{}, 10, 0)
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *SendAuditServiceTestSuite) TestNewSendAuditRecord() {
+	record := newSendAuditRecord(common.ChannelTypeSMS, "OTP", "+15551234567", testSenderID, "twilio", nil)
+	suite.Equal(common.SendStatusSuccess, record.Status)
+	suite.Empty(record.ProviderResponse)
+
+	record = newSendAuditRecord(common.ChannelTypeSMS, "OTP", "+15551234567", testSenderID, "twilio",
+		errors.New("invalid number"))
+	suite.Equal(common.SendStatusFailed, record.Status)
+	suite.Equal("invalid number", record.ProviderResponse)
+}
+
+func (suite *SendAuditServiceTestSuite) TestPseudonymizeRecipient() {
+	suite.mockStore.EXPECT().pseudonymizeSendAudits(mock.Anything, getRecipientHash("User@Example.com"),
+		"erased-1", getRecipientHash("erased-1")).Return(int64(2), nil).Once()
+
+	updated, err := suite.service.PseudonymizeRecipient(context.Background(), "User@Example.com", "erased-1")
+	suite.Nil(err)
+	suite.Equal(int64(2), updated)
+}
+
+func (suite *SendAuditServiceTestSuite) TestPseudonymizeRecipient_Failures() {
+	updated, err := suite.service.PseudonymizeRecipient(context.Background(), " ", "erased-1")
+	suite.Zero(updated)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+
+	suite.mockStore.EXPECT().pseudonymizeSendAudits(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("db err")).Once()
+
+	updated, err = suite.service.PseudonymizeRecipient(context.Background(), "user@example.com", "erased-1")
+	suite.Zero(updated)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
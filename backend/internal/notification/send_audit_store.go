@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// sendAuditRetention is the duration for which the audit records of notification sends are retained.
+const sendAuditRetention = 30 * 24 * time.Hour
+
+// sendAuditStoreInterface defines the interface for notification send audit storage operations.
+type sendAuditStoreInterface interface {
+	createSendAudit(ctx context.Context, record common.SendAuditRecord, recipientHash string) error
+	countSendAudits(ctx context.Context, filter common.SendAuditFilter, recipientHash string) (int, error)
+	listSendAudits(ctx context.Context, filter common.SendAuditFilter, recipientHash string,
+		limit, offset int) ([]common.SendAuditRecord, error)
+	pseudonymizeSendAudits(ctx context.Context, recipientHash, pseudonym, pseudonymHash string) (int64, error)
+}
+
+// sendAuditStore is the runtime database implementation of sendAuditStoreInterface.
+type sendAuditStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newSendAuditStore returns a new instance of sendAuditStoreInterface.
+func newSendAuditStore() sendAuditStoreInterface {
+	return &sendAuditStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createSendAudit records a notification send attempt. The hash of the recipient is stored to allow the
+// records of a recipient to be queried without storing the recipient in clear text.
+func (s *sendAuditStore) createSendAudit(ctx context.Context, record common.SendAuditRecord,
+	recipientHash string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateSendAudit, record.ID, string(record.Channel),
+		dbutils.ToNullableString(record.Template), record.Recipient, recipientHash, dbutils.ToNullableString(record.SenderID),
+		dbutils.ToNullableString(record.Provider), string(record.Status), dbutils.ToNullableString(record.ProviderResponse),
+		record.CreatedAt, record.CreatedAt.Add(sendAuditRetention), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// countSendAudits returns the number of send audit records matching the filters.
+func (s *sendAuditStore) countSendAudits(ctx context.Context, filter common.SendAuditFilter,
+	recipientHash string) (int, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountSendAudits, string(filter.Channel),
+		string(filter.Status), filter.Template, recipientHash, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+
+	return parseIntField(results[0]["total"], "total")
+}
+
+// listSendAudits retrieves a page of the send audit records matching the filters, most recent first.
+func (s *sendAuditStore) listSendAudits(ctx context.Context, filter common.SendAuditFilter,
+	recipientHash string, limit, offset int) ([]common.SendAuditRecord, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListSendAudits, string(filter.Channel),
+		string(filter.Status), filter.Template, recipientHash, s.deploymentID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	records := make([]common.SendAuditRecord, 0, len(results))
+	for _, row := range results {
+		record, err := buildSendAuditFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build send audit record from result row: %w", err)
+		}
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+// pseudonymizeSendAudits replaces the recipient of the send audit records matching the recipient hash with a
+// pseudonym.
+func (s *sendAuditStore) pseudonymizeSendAudits(ctx context.Context, recipientHash, pseudonym,
+	pseudonymHash string) (int64, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryPseudonymizeSendAudits, recipientHash, pseudonym,
+		pseudonymHash, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rows, nil
+}
+
+// buildSendAuditFromResultRow constructs a SendAuditRecord from a database result row.
+func buildSendAuditFromResultRow(row map[string]interface{}) (*common.SendAuditRecord, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	channel, ok := row["channel"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse channel as string")
+	}
+	recipient, ok := row["recipient"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse recipient as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse status as string")
+	}
+
+	// Optional columns may be NULL.
+	template, _ := row["template"].(string)
+	senderID, _ := row["sender_id"].(string)
+	providerName, _ := row["provider"].(string)
+	providerResponse, _ := row["provider_response"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.SendAuditRecord{
+		ID:               id,
+		Channel:          common.ChannelType(channel),
+		Template:         template,
+		Recipient:        recipient,
+		SenderID:         senderID,
+		Provider:         providerName,
+		Status:           common.SendStatus(status),
+		ProviderResponse: providerResponse,
+		CreatedAt:        createdAt,
+	}, nil
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testSendAuditID = "send-audit-1"
+
+type SendAuditStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *sendAuditStore
+}
+
+func TestSendAuditStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(SendAuditStoreTestSuite))
+}
+
+func (suite *SendAuditStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &sendAuditStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *SendAuditStoreTestSuite) TestCreateSendAudit() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateSendAudit, testSendAuditID,
+		"email", "PASSWORD_RESET", "************.com", "recipient-hash", nil, nil, "SUCCESS", nil, createdAt,
+		createdAt.Add(sendAuditRetention), testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createSendAudit(context.Background(), common.SendAuditRecord{
+		ID:        testSendAuditID,
+		Channel:   common.ChannelTypeEmail,
+		Template:  "PASSWORD_RESET",
+		Recipient: "************.com",
+		Status:    common.SendStatusSuccess,
+		CreatedAt: createdAt,
+	}, "recipient-hash")
+	suite.NoError(err)
+}
+
+func (suite *SendAuditStoreTestSuite) TestCreateSendAudit_WithError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryCreateSendAudit, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("db err")).Once()
+
+	err := suite.store.createSendAudit(context.Background(), common.SendAuditRecord{ID: testSendAuditID}, "")
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to execute query")
+}
+
+func (suite *SendAuditStoreTestSuite) TestCountSendAudits() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryCountSendAudits, "sms", "FAILED", "",
+		"recipient-hash", testDeploymentID).Return([]map[string]interface{}{{"total": int64(3)}}, nil).Once()
+
+	count, err := suite.store.countSendAudits(context.Background(), common.SendAuditFilter{
+		Channel: common.ChannelTypeSMS,
+		Status:  common.SendStatusFailed,
+	}, "recipient-hash")
+	suite.NoError(err)
+	suite.Equal(3, count)
+}
+
+func (suite *SendAuditStoreTestSuite) TestCountSendAudits_WithError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+
+	count, err := suite.store.countSendAudits(context.Background(), common.SendAuditFilter{}, "")
+	suite.Error(err)
+	suite.Zero(count)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *SendAuditStoreTestSuite) TestListSendAudits() {
+	rows := []map[string]interface{}{
+		{"id": testSendAuditID, "channel": "sms", "template": "OTP", "recipient": "********4567",
+			"sender_id": testSenderID, "provider": "twilio", "status": "FAILED",
+			"provider_response": "invalid number", "created_at": "2026-01-02 03:04:05"},
+		{"id": "send-audit-2", "channel": "email", "template": nil, "recipient": "************.com",
+			"sender_id": nil, "provider": nil, "status": "SUCCESS", "provider_response": nil,
+			"created_at": time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)},
+	}
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListSendAudits, "", "", "", "",
+		testDeploymentID, 10, 20).Return(rows, nil).Once()
+
+	records, err := suite.store.listSendAudits(context.Background(), common.SendAuditFilter{}, "", 10, 20)
+	suite.NoError(err)
+	suite.Len(records, 2)
+	suite.Equal("OTP", records[0].Template)
+	suite.Equal(testSenderID, records[0].SenderID)
+	suite.Equal("twilio", records[0].Provider)
+	suite.Equal(common.SendStatusFailed, records[0].Status)
+	suite.Equal("invalid number", records[0].ProviderResponse)
+	suite.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), records[0].CreatedAt)
+	suite.Empty(records[1].Template)
+	suite.Empty(records[1].ProviderResponse)
+}
+
+func (suite *SendAuditStoreTestSuite) TestListSendAudits_InvalidRow() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListSendAudits, "", "", "", "",
+		testDeploymentID, 10, 0).Return([]map[string]interface{}{{"id": testSendAuditID}}, nil).Once()
+
+	records, err := suite.store.listSendAudits(context.Background(), common.SendAuditFilter{}, "", 10, 0)
+	suite.Nil(records)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to build send audit record")
+}
+
+func (suite *SendAuditStoreTestSuite) TestPseudonymizeSendAudits() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryPseudonymizeSendAudits, "hash-1",
+		"erased-1", "hash-2", testDeploymentID).Return(int64(4), nil).Once()
+
+	updated, err := suite.store.pseudonymizeSendAudits(context.Background(), "hash-1", "erased-1", "hash-2")
+	suite.NoError(err)
+	suite.Equal(int64(4), updated)
+}
+
+func (suite *SendAuditStoreTestSuite) TestPseudonymizeSendAudits_WithError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+
+	updated, err := suite.store.pseudonymizeSendAudits(context.Background(), "hash-1", "erased-1", "hash-2")
+	suite.Error(err)
+	suite.Zero(updated)
+	suite.Contains(err.Error(), "failed to get database client")
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/config"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
@@ -43,6 +44,7 @@ type notificationStoreInterface interface {
 	listSenders(ctx context.Context) ([]common.NotificationSenderDTO, error)
 	getSenderByID(ctx context.Context, id string) (*common.NotificationSenderDTO, error)
 	getSenderByName(ctx context.Context, name string) (*common.NotificationSenderDTO, error)
+	getSenderByOUID(ctx context.Context, ouID string) (*common.NotificationSenderDTO, error)
 	updateSender(ctx context.Context, id string, sender common.NotificationSenderDTO) error
 	deleteSender(ctx context.Context, id string) error
 }
@@ -88,7 +90,8 @@ func (s *notificationStore) createSender(ctx context.Context, sender common.Noti
 	}
 
 	_, err = dbClient.ExecuteContext(ctx, queryCreateNotificationSender, sender.Name, sender.ID,
-		sender.Description, string(sender.Type), string(sender.Provider), propertiesJSON, s.deploymentID)
+		sender.Description, string(sender.Type), string(sender.Provider), dbutils.ToNullableString(sender.OUID),
+		propertiesJSON, s.deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -148,7 +151,12 @@ func (s *notificationStore) getSenderByName(ctx context.Context, name string) (*
 	return s.getSender(ctx, queryGetNotificationSenderByName, name)
 }
 
-// getSender retrieves a notification sender by a specific identifier (ID or name).
+// getSenderByOUID retrieves the notification sender assigned to an organization unit.
+func (s *notificationStore) getSenderByOUID(ctx context.Context, ouID string) (*common.NotificationSenderDTO, error) {
+	return s.getSender(ctx, queryGetNotificationSenderByOUID, ouID)
+}
+
+// getSender retrieves a notification sender by a specific identifier (ID, name or OU ID).
 func (s *notificationStore) getSender(ctx context.Context, query dbmodel.DBQuery,
 	identifier string) (*common.NotificationSenderDTO, error) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationStore"))
@@ -212,7 +220,8 @@ func (s *notificationStore) updateSender(ctx context.Context, id string, sender
 	}
 
 	_, err = dbClient.ExecuteContext(ctx, queryUpdateNotificationSender, sender.Name, sender.Description,
-		string(sender.Provider), propertiesJSON, id, string(sender.Type), s.deploymentID)
+		string(sender.Provider), dbutils.ToNullableString(sender.OUID), propertiesJSON, id, string(sender.Type),
+		s.deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -268,12 +277,16 @@ func (s *notificationStore) buildSenderFromResultRow(
 		return nil, fmt.Errorf("failed to parse provider as string")
 	}
 
+	// OU ID is optional and may be NULL.
+	ouID, _ := row["ou_id"].(string)
+
 	sender := &common.NotificationSenderDTO{
 		ID:          senderID,
 		Name:        name,
 		Description: description,
 		Type:        common.NotificationSenderType(_type),
 		Provider:    common.MessageProviderType(provider),
+		OUID:        ouID,
 		Properties:  []cmodels.Property{},
 	}
 
@@ -25,21 +25,21 @@ var (
 	queryCreateNotificationSender = dbmodel.DBQuery{
 		ID: "NMQ-SM-01",
 		Query: `INSERT INTO "NOTIFICATION_SENDER" ` +
-			`(NAME, ID, DESCRIPTION, TYPE, PROVIDER, PROPERTIES, DEPLOYMENT_ID) ` +
-			`VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			`(NAME, ID, DESCRIPTION, TYPE, PROVIDER, OU_ID, PROPERTIES, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
 	}
 
 	// queryGetNotificationSenderByID is the query to get a notification sender by its ID.
 	queryGetNotificationSenderByID = dbmodel.DBQuery{
 		ID: "NMQ-SM-03",
-		Query: `SELECT ID, NAME, DESCRIPTION, TYPE, PROVIDER, PROPERTIES ` +
+		Query: `SELECT ID, NAME, DESCRIPTION, TYPE, PROVIDER, OU_ID, PROPERTIES ` +
 			`FROM "NOTIFICATION_SENDER" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
 	}
 
 	// queryGetAllNotificationSenders is the query to get all notification senders.
 	queryGetAllNotificationSenders = dbmodel.DBQuery{
 		ID: "NMQ-SM-05",
-		Query: `SELECT ID, NAME, DESCRIPTION, TYPE, PROVIDER, PROPERTIES ` +
+		Query: `SELECT ID, NAME, DESCRIPTION, TYPE, PROVIDER, OU_ID, PROPERTIES ` +
 			`FROM "NOTIFICATION_SENDER" WHERE DEPLOYMENT_ID = $1`,
 	}
 
@@ -47,12 +47,12 @@ var (
 	queryUpdateNotificationSender = dbmodel.DBQuery{
 		ID: "NMQ-SM-06",
 		PostgresQuery: `UPDATE "NOTIFICATION_SENDER" ` +
-			`SET NAME = $1, DESCRIPTION = $2, PROVIDER = $3, PROPERTIES = $4, ` +
-			`UPDATED_AT = NOW() WHERE ID = $5 AND TYPE = $6 AND DEPLOYMENT_ID = $7`,
-		SQLiteQuery: `UPDATE "NOTIFICATION_SENDER" SET NAME = $1, DESCRIPTION = $2, PROVIDER = $3, PROPERTIES = $4, ` +
-			`UPDATED_AT = datetime('now') WHERE ID = $5 AND TYPE = $6 AND DEPLOYMENT_ID = $7`,
-		Query: `UPDATE "NOTIFICATION_SENDER" SET NAME = $1, DESCRIPTION = $2, PROVIDER = $3, PROPERTIES = $4, ` +
-			`UPDATED_AT = datetime('now') WHERE ID = $5 AND TYPE = $6 AND DEPLOYMENT_ID = $7`,
+			`SET NAME = $1, DESCRIPTION = $2, PROVIDER = $3, OU_ID = $4, PROPERTIES = $5, ` +
+			`UPDATED_AT = NOW() WHERE ID = $6 AND TYPE = $7 AND DEPLOYMENT_ID = $8`,
+		SQLiteQuery: `UPDATE "NOTIFICATION_SENDER" SET NAME = $1, DESCRIPTION = $2, PROVIDER = $3, OU_ID = $4, ` +
+			`PROPERTIES = $5, UPDATED_AT = datetime('now') WHERE ID = $6 AND TYPE = $7 AND DEPLOYMENT_ID = $8`,
+		Query: `UPDATE "NOTIFICATION_SENDER" SET NAME = $1, DESCRIPTION = $2, PROVIDER = $3, OU_ID = $4, ` +
+			`PROPERTIES = $5, UPDATED_AT = datetime('now') WHERE ID = $6 AND TYPE = $7 AND DEPLOYMENT_ID = $8`,
 	}
 
 	// queryDeleteNotificationSender is the query to delete a notification sender
@@ -64,7 +64,237 @@ var (
 	// queryGetNotificationSenderByName is the query to get a notification sender by name
 	queryGetNotificationSenderByName = dbmodel.DBQuery{
 		ID: "NMQ-SM-09",
-		Query: `SELECT ID, NAME, DESCRIPTION, TYPE, PROVIDER, PROPERTIES ` +
+		Query: `SELECT ID, NAME, DESCRIPTION, TYPE, PROVIDER, OU_ID, PROPERTIES ` +
 			`FROM "NOTIFICATION_SENDER" WHERE NAME = $1 AND DEPLOYMENT_ID = $2`,
 	}
+
+	// queryGetNotificationSenderByOUID is the query to get the notification sender assigned to an OU.
+	queryGetNotificationSenderByOUID = dbmodel.DBQuery{
+		ID: "NMQ-SM-10",
+		Query: `SELECT ID, NAME, DESCRIPTION, TYPE, PROVIDER, OU_ID, PROPERTIES ` +
+			`FROM "NOTIFICATION_SENDER" WHERE OU_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
+
+var (
+	// queryCreateNotificationTrigger is the query to create a new notification trigger.
+	queryCreateNotificationTrigger = dbmodel.DBQuery{
+		ID: "NMQ-NT-01",
+		Query: `INSERT INTO "NOTIFICATION_TRIGGER" ` +
+			`(ID, EVENT, CHANNEL, SCENARIO, SENDER_ID, OU_ID, IS_ENABLED, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+
+	// queryGetNotificationTriggerByID is the query to get a notification trigger by its ID.
+	queryGetNotificationTriggerByID = dbmodel.DBQuery{
+		ID: "NMQ-NT-02",
+		Query: `SELECT ID, EVENT, CHANNEL, SCENARIO, SENDER_ID, OU_ID, IS_ENABLED ` +
+			`FROM "NOTIFICATION_TRIGGER" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetAllNotificationTriggers is the query to get all notification triggers.
+	queryGetAllNotificationTriggers = dbmodel.DBQuery{
+		ID: "NMQ-NT-03",
+		Query: `SELECT ID, EVENT, CHANNEL, SCENARIO, SENDER_ID, OU_ID, IS_ENABLED ` +
+			`FROM "NOTIFICATION_TRIGGER" WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryGetNotificationTriggersByEvent is the query to get the notification triggers of an event.
+	queryGetNotificationTriggersByEvent = dbmodel.DBQuery{
+		ID: "NMQ-NT-04",
+		Query: `SELECT ID, EVENT, CHANNEL, SCENARIO, SENDER_ID, OU_ID, IS_ENABLED ` +
+			`FROM "NOTIFICATION_TRIGGER" WHERE EVENT = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpdateNotificationTrigger is the query to update a notification trigger.
+	queryUpdateNotificationTrigger = dbmodel.DBQuery{
+		ID: "NMQ-NT-05",
+		PostgresQuery: `UPDATE "NOTIFICATION_TRIGGER" ` +
+			`SET EVENT = $1, CHANNEL = $2, SCENARIO = $3, SENDER_ID = $4, OU_ID = $5, IS_ENABLED = $6, ` +
+			`UPDATED_AT = NOW() WHERE ID = $7 AND DEPLOYMENT_ID = $8`,
+		SQLiteQuery: `UPDATE "NOTIFICATION_TRIGGER" ` +
+			`SET EVENT = $1, CHANNEL = $2, SCENARIO = $3, SENDER_ID = $4, OU_ID = $5, IS_ENABLED = $6, ` +
+			`UPDATED_AT = datetime('now') WHERE ID = $7 AND DEPLOYMENT_ID = $8`,
+		Query: `UPDATE "NOTIFICATION_TRIGGER" ` +
+			`SET EVENT = $1, CHANNEL = $2, SCENARIO = $3, SENDER_ID = $4, OU_ID = $5, IS_ENABLED = $6, ` +
+			`UPDATED_AT = datetime('now') WHERE ID = $7 AND DEPLOYMENT_ID = $8`,
+	}
+
+	// queryDeleteNotificationTrigger is the query to delete a notification trigger.
+	queryDeleteNotificationTrigger = dbmodel.DBQuery{
+		ID:    "NMQ-NT-06",
+		Query: `DELETE FROM "NOTIFICATION_TRIGGER" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCreateDeliveryStatus is the query to record a provider delivery status callback.
+	queryCreateDeliveryStatus = dbmodel.DBQuery{
+		ID: "NMQ-DS-01",
+		Query: `INSERT INTO "NOTIFICATION_DELIVERY_STATUS" ` +
+			`(ID, SENDER_ID, MESSAGE_ID, RECIPIENT, STATE, PROVIDER_STATUS, ERROR_CODE, CREATED_AT, ` +
+			`EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+	}
+
+	// queryListDeliveryStatuses is the query to list the most recent delivery statuses of a sender.
+	queryListDeliveryStatuses = dbmodel.DBQuery{
+		ID: "NMQ-DS-02",
+		Query: `SELECT ID, SENDER_ID, MESSAGE_ID, RECIPIENT, STATE, PROVIDER_STATUS, ERROR_CODE, CREATED_AT ` +
+			`FROM "NOTIFICATION_DELIVERY_STATUS" WHERE SENDER_ID = $1 AND DEPLOYMENT_ID = $3 ` +
+			`ORDER BY CREATED_AT DESC LIMIT $2`,
+	}
+
+	// queryCreatePushDevice is the query to register a push device for a user.
+	queryCreatePushDevice = dbmodel.DBQuery{
+		ID: "NMQ-PD-01",
+		Query: `INSERT INTO "PUSH_DEVICE" ` +
+			`(ID, USER_ID, PROVIDER, DEVICE_TOKEN, DEVICE_NAME, CREATED_AT, UPDATED_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+
+	// queryListPushDevicesByUser is the query to list the push devices registered by a user.
+	queryListPushDevicesByUser = dbmodel.DBQuery{
+		ID: "NMQ-PD-02",
+		Query: `SELECT ID, USER_ID, PROVIDER, DEVICE_TOKEN, DEVICE_NAME, CREATED_AT ` +
+			`FROM "PUSH_DEVICE" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2 ORDER BY CREATED_AT`,
+	}
+
+	// queryDeletePushDevice is the query to delete a push device of a user.
+	queryDeletePushDevice = dbmodel.DBQuery{
+		ID:    "NMQ-PD-03",
+		Query: `DELETE FROM "PUSH_DEVICE" WHERE ID = $1 AND USER_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryDeletePushDeviceByToken is the query to delete the registration of a device token.
+	queryDeletePushDeviceByToken = dbmodel.DBQuery{
+		ID:    "NMQ-PD-04",
+		Query: `DELETE FROM "PUSH_DEVICE" WHERE DEVICE_TOKEN = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeletePushDevicesByUser is the query to delete all push devices of a user.
+	queryDeletePushDevicesByUser = dbmodel.DBQuery{
+		ID:    "NMQ-PD-05",
+		Query: `DELETE FROM "PUSH_DEVICE" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCreateDeadLetter is the query to move a notification to the dead-letter store.
+	queryCreateDeadLetter = dbmodel.DBQuery{
+		ID: "NMQ-DL-01",
+		Query: `INSERT INTO "NOTIFICATION_DEAD_LETTER" ` +
+			`(ID, CHANNEL, SENDER_ID, OU_ID, RECIPIENT, SUBJECT, BODY, IS_HTML, ATTEMPTS, LAST_ERROR, ` +
+			`CREATED_AT, EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+	}
+
+	// queryListDeadLetters is the query to list the most recent notifications in the dead-letter store.
+	queryListDeadLetters = dbmodel.DBQuery{
+		ID: "NMQ-DL-02",
+		Query: `SELECT ID, CHANNEL, SENDER_ID, OU_ID, RECIPIENT, SUBJECT, BODY, IS_HTML, ATTEMPTS, LAST_ERROR, ` +
+			`CREATED_AT FROM "NOTIFICATION_DEAD_LETTER" WHERE DEPLOYMENT_ID = $2 ORDER BY CREATED_AT DESC LIMIT $1`,
+	}
+
+	// queryGetDeadLetterByID is the query to get a notification in the dead-letter store by its ID.
+	queryGetDeadLetterByID = dbmodel.DBQuery{
+		ID: "NMQ-DL-03",
+		Query: `SELECT ID, CHANNEL, SENDER_ID, OU_ID, RECIPIENT, SUBJECT, BODY, IS_HTML, ATTEMPTS, LAST_ERROR, ` +
+			`CREATED_AT FROM "NOTIFICATION_DEAD_LETTER" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteDeadLetter is the query to delete a notification from the dead-letter store.
+	queryDeleteDeadLetter = dbmodel.DBQuery{
+		ID:    "NMQ-DL-04",
+		Query: `DELETE FROM "NOTIFICATION_DEAD_LETTER" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCreateNotificationRateLimit is the query to create a new notification rate limit.
+	queryCreateNotificationRateLimit = dbmodel.DBQuery{
+		ID: "NMQ-RL-01",
+		Query: `INSERT INTO "NOTIFICATION_RATE_LIMIT" ` +
+			`(ID, CHANNEL, OU_ID, RECIPIENT_LIMIT, IP_LIMIT, WINDOW_SECONDS, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+
+	// queryGetNotificationRateLimitByID is the query to get a notification rate limit by its ID.
+	queryGetNotificationRateLimitByID = dbmodel.DBQuery{
+		ID: "NMQ-RL-02",
+		Query: `SELECT ID, CHANNEL, OU_ID, RECIPIENT_LIMIT, IP_LIMIT, WINDOW_SECONDS ` +
+			`FROM "NOTIFICATION_RATE_LIMIT" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetAllNotificationRateLimits is the query to get all notification rate limits.
+	queryGetAllNotificationRateLimits = dbmodel.DBQuery{
+		ID: "NMQ-RL-03",
+		Query: `SELECT ID, CHANNEL, OU_ID, RECIPIENT_LIMIT, IP_LIMIT, WINDOW_SECONDS ` +
+			`FROM "NOTIFICATION_RATE_LIMIT" WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryGetNotificationRateLimitsByChannel is the query to get the notification rate limits of a channel.
+	queryGetNotificationRateLimitsByChannel = dbmodel.DBQuery{
+		ID: "NMQ-RL-04",
+		Query: `SELECT ID, CHANNEL, OU_ID, RECIPIENT_LIMIT, IP_LIMIT, WINDOW_SECONDS ` +
+			`FROM "NOTIFICATION_RATE_LIMIT" WHERE CHANNEL = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpdateNotificationRateLimit is the query to update a notification rate limit.
+	queryUpdateNotificationRateLimit = dbmodel.DBQuery{
+		ID: "NMQ-RL-05",
+		PostgresQuery: `UPDATE "NOTIFICATION_RATE_LIMIT" ` +
+			`SET CHANNEL = $1, OU_ID = $2, RECIPIENT_LIMIT = $3, IP_LIMIT = $4, WINDOW_SECONDS = $5, ` +
+			`UPDATED_AT = NOW() WHERE ID = $6 AND DEPLOYMENT_ID = $7`,
+		SQLiteQuery: `UPDATE "NOTIFICATION_RATE_LIMIT" ` +
+			`SET CHANNEL = $1, OU_ID = $2, RECIPIENT_LIMIT = $3, IP_LIMIT = $4, WINDOW_SECONDS = $5, ` +
+			`UPDATED_AT = datetime('now') WHERE ID = $6 AND DEPLOYMENT_ID = $7`,
+		Query: `UPDATE "NOTIFICATION_RATE_LIMIT" ` +
+			`SET CHANNEL = $1, OU_ID = $2, RECIPIENT_LIMIT = $3, IP_LIMIT = $4, WINDOW_SECONDS = $5, ` +
+			`UPDATED_AT = datetime('now') WHERE ID = $6 AND DEPLOYMENT_ID = $7`,
+	}
+
+	// queryDeleteNotificationRateLimit is the query to delete a notification rate limit.
+	queryDeleteNotificationRateLimit = dbmodel.DBQuery{
+		ID:    "NMQ-RL-06",
+		Query: `DELETE FROM "NOTIFICATION_RATE_LIMIT" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryIncrementRateLimitCounter is the query to increment a rate limit counter, creating it if it does not
+	// exist, and return the updated count.
+	queryIncrementRateLimitCounter = dbmodel.DBQuery{
+		ID: "NMQ-RL-07",
+		Query: `INSERT INTO "NOTIFICATION_RATE_LIMIT_COUNTER" (COUNTER_KEY, REQUEST_COUNT, EXPIRY_TIME, ` +
+			`DEPLOYMENT_ID) VALUES ($1, 1, $2, $3) ON CONFLICT (COUNTER_KEY, DEPLOYMENT_ID) ` +
+			`DO UPDATE SET REQUEST_COUNT = "NOTIFICATION_RATE_LIMIT_COUNTER".REQUEST_COUNT + 1 ` +
+			`RETURNING REQUEST_COUNT`,
+	}
+
+	// queryCreateSendAudit is the query to record a notification send attempt.
+	queryCreateSendAudit = dbmodel.DBQuery{
+		ID: "NMQ-SA-01",
+		Query: `INSERT INTO "NOTIFICATION_SEND_AUDIT" ` +
+			`(ID, CHANNEL, TEMPLATE, RECIPIENT, RECIPIENT_HASH, SENDER_ID, PROVIDER, STATUS, PROVIDER_RESPONSE, ` +
+			`CREATED_AT, EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+	}
+
+	// querySendAuditFilter is the condition shared by the send audit queries. Empty filter values match all
+	// records.
+	querySendAuditFilter = `WHERE ($1 = '' OR CHANNEL = $1) AND ($2 = '' OR STATUS = $2) ` +
+		`AND ($3 = '' OR TEMPLATE = $3) AND ($4 = '' OR RECIPIENT_HASH = $4) AND DEPLOYMENT_ID = $5`
+
+	// queryCountSendAudits is the query to count the send audit records matching the filters.
+	queryCountSendAudits = dbmodel.DBQuery{
+		ID:    "NMQ-SA-02",
+		Query: `SELECT COUNT(*) as total FROM "NOTIFICATION_SEND_AUDIT" ` + querySendAuditFilter,
+	}
+
+	// queryListSendAudits is the query to list a page of the send audit records matching the filters, most
+	// recent first.
+	queryListSendAudits = dbmodel.DBQuery{
+		ID: "NMQ-SA-03",
+		Query: `SELECT ID, CHANNEL, TEMPLATE, RECIPIENT, SENDER_ID, PROVIDER, STATUS, PROVIDER_RESPONSE, ` +
+			`CREATED_AT FROM "NOTIFICATION_SEND_AUDIT" ` + querySendAuditFilter +
+			` ORDER BY CREATED_AT DESC LIMIT $6 OFFSET $7`,
+	}
+
+	// queryPseudonymizeSendAudits is the query to replace the recipient of the send audit records of a
+	// recipient with a pseudonym.
+	queryPseudonymizeSendAudits = dbmodel.DBQuery{
+		ID: "NMQ-SA-04",
+		Query: `UPDATE "NOTIFICATION_SEND_AUDIT" SET RECIPIENT = $2, RECIPIENT_HASH = $3 ` +
+			`WHERE RECIPIENT_HASH = $1 AND DEPLOYMENT_ID = $4`,
+	}
 )
@@ -109,7 +109,7 @@ func (suite *StoreTestSuite) TestCreateSender() {
 	suite.mockDBClient.EXPECT().ExecuteContext(
 		context.Background(),
 		queryCreateNotificationSender, sender.Name, sender.ID, sender.Description,
-		string(sender.Type), string(sender.Provider), propsJSON, testDeploymentID,
+		string(sender.Type), string(sender.Provider), nil, propsJSON, testDeploymentID,
 	).Return(int64(1), nil).Once()
 
 	err = suite.store.createSender(context.Background(), sender)
@@ -138,7 +138,7 @@ func (suite *StoreTestSuite) TestCreateSender_DBExecuteError() {
 	suite.NoError(err)
 	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateNotificationSender,
 		sender.Name, sender.ID,
-		sender.Description, string(sender.Type), string(sender.Provider), propsJSON, testDeploymentID).Return(
+		sender.Description, string(sender.Type), string(sender.Provider), nil, propsJSON, testDeploymentID).Return(
 		int64(0), errors.New("exec fail")).Once()
 
 	err = suite.store.createSender(context.Background(), sender)
@@ -414,7 +414,7 @@ func (suite *StoreTestSuite) TestUpdateSender() {
 	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
 	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateNotificationSender,
 		sender.Name, sender.Description,
-		string(sender.Provider), "", "s1", string(sender.Type), testDeploymentID).Return(int64(1), nil).Once()
+		string(sender.Provider), nil, "", "s1", string(sender.Type), testDeploymentID).Return(int64(1), nil).Once()
 
 	err := suite.store.updateSender(context.Background(), "s1", sender)
 	suite.NoError(err)
@@ -431,7 +431,8 @@ func (suite *StoreTestSuite) TestUpdateSender_WithProperties() {
 	suite.NoError(err)
 	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateNotificationSender,
 		sender.Name, sender.Description,
-		string(sender.Provider), propsJSON, "s1", string(sender.Type), testDeploymentID).Return(int64(1), nil).Once()
+		string(sender.Provider), nil, propsJSON, "s1", string(sender.Type), testDeploymentID).
+		Return(int64(1), nil).Once()
 
 	err = suite.store.updateSender(context.Background(), "s1", sender)
 	suite.NoError(err)
@@ -463,7 +464,7 @@ func (suite *StoreTestSuite) TestUpdateSender_WithError() {
 				suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
 				suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateNotificationSender,
 					sender.Name,
-					sender.Description, string(sender.Provider), "", "s1", string(sender.Type), testDeploymentID).
+					sender.Description, string(sender.Provider), nil, "", "s1", string(sender.Type), testDeploymentID).
 					Return(int64(0), errors.New("exec fail")).Once()
 			},
 			wantErr: "failed to execute query",
@@ -488,7 +489,7 @@ func (suite *StoreTestSuite) TestUpdateSender_ExecuteError() {
 	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
 	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateNotificationSender,
 		sender.Name, sender.Description,
-		string(sender.Provider), "", "s1", string(sender.Type), testDeploymentID).
+		string(sender.Provider), nil, "", "s1", string(sender.Type), testDeploymentID).
 		Return(int64(0), errors.New("exec fail")).Once()
 
 	err := suite.store.updateSender(context.Background(), "s1", sender)
@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// notificationTriggerHandler handles HTTP requests for notification trigger management.
+type notificationTriggerHandler struct {
+	triggerService NotificationTriggerServiceInterface
+}
+
+// newNotificationTriggerHandler creates a new instance of notificationTriggerHandler.
+func newNotificationTriggerHandler(triggerService NotificationTriggerServiceInterface) *notificationTriggerHandler {
+	return &notificationTriggerHandler{
+		triggerService: triggerService,
+	}
+}
+
+// HandleTriggerListRequest handles the request to list all notification triggers.
+func (h *notificationTriggerHandler) HandleTriggerListRequest(w http.ResponseWriter, r *http.Request) {
+	triggers, svcErr := h.triggerService.ListTriggers(r.Context())
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	response := make([]common.NotificationTriggerResponse, 0, len(triggers))
+	for _, trigger := range triggers {
+		response = append(response, getTriggerResponseFromDTO(trigger))
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleTriggerCreateRequest handles the request to create a new notification trigger.
+func (h *notificationTriggerHandler) HandleTriggerCreateRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[common.NotificationTriggerRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	createdTrigger, svcErr := h.triggerService.CreateTrigger(r.Context(), getDTOFromTriggerRequest(request))
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, getTriggerResponseFromDTO(*createdTrigger))
+}
+
+// HandleTriggerGetRequest handles the request to get a notification trigger by ID.
+func (h *notificationTriggerHandler) HandleTriggerGetRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidTriggerID)
+		return
+	}
+
+	trigger, svcErr := h.triggerService.GetTrigger(r.Context(), id)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, getTriggerResponseFromDTO(*trigger))
+}
+
+// HandleTriggerUpdateRequest handles the request to update a notification trigger.
+func (h *notificationTriggerHandler) HandleTriggerUpdateRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidTriggerID)
+		return
+	}
+
+	request, err := sysutils.DecodeJSONBody[common.NotificationTriggerRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	updatedTrigger, svcErr := h.triggerService.UpdateTrigger(r.Context(), id, getDTOFromTriggerRequest(request))
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, getTriggerResponseFromDTO(*updatedTrigger))
+}
+
+// HandleTriggerDeleteRequest handles the request to delete a notification trigger.
+func (h *notificationTriggerHandler) HandleTriggerDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if strings.TrimSpace(id) == "" {
+		h.handleError(w, &ErrorInvalidTriggerID)
+		return
+	}
+
+	if svcErr := h.triggerService.DeleteTrigger(r.Context(), id); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// handleError writes the HTTP error response for the given service error.
+func (h *notificationTriggerHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorTriggerNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorDuplicateTrigger.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
+
+// getDTOFromTriggerRequest sanitizes the trigger request and converts it to a NotificationTriggerDTO.
+// Triggers are enabled unless explicitly disabled in the request.
+func getDTOFromTriggerRequest(request *common.NotificationTriggerRequest) common.NotificationTriggerDTO {
+	enabled := true
+	if request.Enabled != nil {
+		enabled = *request.Enabled
+	}
+
+	return common.NotificationTriggerDTO{
+		Event:    common.NotificationEvent(strings.ToUpper(sysutils.SanitizeString(request.Event))),
+		Channel:  common.ChannelType(strings.ToLower(sysutils.SanitizeString(request.Channel))),
+		Scenario: strings.ToUpper(sysutils.SanitizeString(request.Scenario)),
+		SenderID: sysutils.SanitizeString(request.SenderID),
+		OUID:     sysutils.SanitizeString(request.OUID),
+		Enabled:  enabled,
+	}
+}
+
+// getTriggerResponseFromDTO converts a NotificationTriggerDTO to a response object.
+func getTriggerResponseFromDTO(trigger common.NotificationTriggerDTO) common.NotificationTriggerResponse {
+	return common.NotificationTriggerResponse{
+		ID:       trigger.ID,
+		Event:    trigger.Event,
+		Channel:  trigger.Channel,
+		Scenario: trigger.Scenario,
+		SenderID: trigger.SenderID,
+		OUID:     trigger.OUID,
+		Enabled:  trigger.Enabled,
+	}
+}
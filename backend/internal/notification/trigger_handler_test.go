@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type TriggerHandlerTestSuite struct {
+	suite.Suite
+	mockService *NotificationTriggerServiceInterfaceMock
+	handler     *notificationTriggerHandler
+}
+
+func TestTriggerHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TriggerHandlerTestSuite))
+}
+
+func (suite *TriggerHandlerTestSuite) SetupTest() {
+	suite.mockService = NewNotificationTriggerServiceInterfaceMock(suite.T())
+	suite.handler = newNotificationTriggerHandler(suite.mockService)
+}
+
+func (suite *TriggerHandlerTestSuite) TestHandleTriggerListRequest() {
+	triggers := []common.NotificationTriggerDTO{
+		{ID: testTriggerID, Event: common.NotificationEventUserCreated, Channel: common.ChannelTypeEmail,
+			Scenario: "USER_CREATED", Enabled: true},
+	}
+	suite.mockService.EXPECT().ListTriggers(mock.Anything).Return(triggers, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/notification-triggers", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTriggerListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response []common.NotificationTriggerResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Len(response, 1)
+	suite.Equal(testTriggerID, response[0].ID)
+	suite.True(response[0].Enabled)
+}
+
+func (suite *TriggerHandlerTestSuite) TestHandleTriggerCreateRequest_DefaultsToEnabled() {
+	body := `{"event":"password_changed","channel":"EMAIL","scenario":"password_changed"}`
+	expected := common.NotificationTriggerDTO{
+		Event:    common.NotificationEventPasswordChanged,
+		Channel:  common.ChannelTypeEmail,
+		Scenario: "PASSWORD_CHANGED",
+		Enabled:  true,
+	}
+	created := expected
+	created.ID = testTriggerID
+	suite.mockService.EXPECT().CreateTrigger(mock.Anything, expected).Return(&created, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/notification-triggers", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTriggerCreateRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+	var response common.NotificationTriggerResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Equal(testTriggerID, response.ID)
+}
+
+func (suite *TriggerHandlerTestSuite) TestHandleTriggerCreateRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/notification-triggers", strings.NewReader("{invalid"))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTriggerCreateRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *TriggerHandlerTestSuite) TestHandleTriggerCreateRequest_Duplicate() {
+	suite.mockService.EXPECT().CreateTrigger(mock.Anything, mock.Anything).
+		Return(nil, &ErrorDuplicateTrigger).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/notification-triggers",
+		strings.NewReader(`{"event":"USER_CREATED","channel":"sms","scenario":"USER_CREATED"}`))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTriggerCreateRequest(rr, req)
+
+	suite.Equal(http.StatusConflict, rr.Code)
+}
+
+func (suite *TriggerHandlerTestSuite) TestHandleTriggerGetRequest_NotFound() {
+	suite.mockService.EXPECT().GetTrigger(mock.Anything, testTriggerID).Return(nil, &ErrorTriggerNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/notification-triggers/"+testTriggerID, nil)
+	req.SetPathValue("id", testTriggerID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTriggerGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *TriggerHandlerTestSuite) TestHandleTriggerUpdateRequest_Disable() {
+	expected := common.NotificationTriggerDTO{
+		Event:    common.NotificationEventAccountLocked,
+		Channel:  common.ChannelTypeSMS,
+		Scenario: "ACCOUNT_LOCKED",
+		OUID:     testOUID,
+	}
+	updated := expected
+	updated.ID = testTriggerID
+	suite.mockService.EXPECT().UpdateTrigger(mock.Anything, testTriggerID, expected).Return(&updated, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPut, "/notification-triggers/"+testTriggerID, strings.NewReader(
+		`{"event":"ACCOUNT_LOCKED","channel":"sms","scenario":"ACCOUNT_LOCKED","ouId":"ou-1","enabled":false}`))
+	req.SetPathValue("id", testTriggerID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTriggerUpdateRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response common.NotificationTriggerResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.False(response.Enabled)
+}
+
+func (suite *TriggerHandlerTestSuite) TestHandleTriggerDeleteRequest() {
+	suite.mockService.EXPECT().DeleteTrigger(mock.Anything, testTriggerID).Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/notification-triggers/"+testTriggerID, nil)
+	req.SetPathValue("id", testTriggerID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTriggerDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *TriggerHandlerTestSuite) TestHandleTriggerDeleteRequest_ServerError() {
+	suite.mockService.EXPECT().DeleteTrigger(mock.Anything, testTriggerID).
+		Return(&serviceerror.InternalServerError).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/notification-triggers/"+testTriggerID, nil)
+	req.SetPathValue("id", testTriggerID)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTriggerDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (suite *TriggerHandlerTestSuite) TestHandleTriggerRequests_EmptyID() {
+	handlers := []func(http.ResponseWriter, *http.Request){
+		suite.handler.HandleTriggerGetRequest,
+		suite.handler.HandleTriggerUpdateRequest,
+		suite.handler.HandleTriggerDeleteRequest,
+	}
+	for _, handle := range handlers {
+		req := httptest.NewRequest(http.MethodGet, "/notification-triggers/", nil)
+		req.SetPathValue("id", " ")
+		rr := httptest.NewRecorder()
+		handle(rr, req)
+
+		suite.Equal(http.StatusBadRequest, rr.Code)
+	}
+}
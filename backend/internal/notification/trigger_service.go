@@ -0,0 +1,307 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/template"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// supportedNotificationEvents contains the system events that can trigger a notification.
+var supportedNotificationEvents = map[common.NotificationEvent]bool{
+	common.NotificationEventUserCreated:     true,
+	common.NotificationEventPasswordChanged: true,
+	common.NotificationEventNewDeviceLogin:  true,
+	common.NotificationEventAccountLocked:   true,
+}
+
+// channelTemplateTypes maps the channels supported by notification triggers to their template types.
+var channelTemplateTypes = map[common.ChannelType]template.TemplateType{
+	common.ChannelTypeSMS:   template.TemplateTypeSMS,
+	common.ChannelTypeEmail: template.TemplateTypeEmail,
+}
+
+// NotificationTriggerServiceInterface defines the interface for managing notification triggers and
+// notifying users of system events.
+type NotificationTriggerServiceInterface interface {
+	ListTriggers(ctx context.Context) ([]common.NotificationTriggerDTO, *serviceerror.ServiceError)
+	GetTrigger(ctx context.Context, id string) (*common.NotificationTriggerDTO, *serviceerror.ServiceError)
+	CreateTrigger(ctx context.Context, trigger common.NotificationTriggerDTO) (*common.NotificationTriggerDTO,
+		*serviceerror.ServiceError)
+	UpdateTrigger(ctx context.Context, id string, trigger common.NotificationTriggerDTO) (
+		*common.NotificationTriggerDTO, *serviceerror.ServiceError)
+	DeleteTrigger(ctx context.Context, id string) *serviceerror.ServiceError
+	Notify(ctx context.Context, notification common.EventNotification) *serviceerror.ServiceError
+}
+
+// notificationTriggerService implements NotificationTriggerServiceInterface.
+type notificationTriggerService struct {
+	store            triggerStoreInterface
+	senderMgtService NotificationSenderMgtSvcInterface
+	templateService  template.TemplateServiceInterface
+	deliveryQueue    deliveryQueueInterface
+	logger           *log.Logger
+}
+
+// newNotificationTriggerService returns a new instance of NotificationTriggerServiceInterface.
+func newNotificationTriggerService(store triggerStoreInterface, senderMgtService NotificationSenderMgtSvcInterface,
+	templateService template.TemplateServiceInterface,
+	deliveryQueue deliveryQueueInterface) NotificationTriggerServiceInterface {
+	return &notificationTriggerService{
+		store:            store,
+		senderMgtService: senderMgtService,
+		templateService:  templateService,
+		deliveryQueue:    deliveryQueue,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationTriggerService")),
+	}
+}
+
+// ListTriggers retrieves all notification triggers.
+func (s *notificationTriggerService) ListTriggers(ctx context.Context) (
+	[]common.NotificationTriggerDTO, *serviceerror.ServiceError) {
+	triggers, err := s.store.listTriggers(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list notification triggers", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return triggers, nil
+}
+
+// GetTrigger retrieves a notification trigger by its ID.
+func (s *notificationTriggerService) GetTrigger(ctx context.Context, id string) (
+	*common.NotificationTriggerDTO, *serviceerror.ServiceError) {
+	if id == "" {
+		return nil, &ErrorInvalidTriggerID
+	}
+
+	trigger, err := s.store.getTriggerByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve notification trigger", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if trigger == nil {
+		return nil, &ErrorTriggerNotFound
+	}
+
+	return trigger, nil
+}
+
+// CreateTrigger creates a new notification trigger.
+func (s *notificationTriggerService) CreateTrigger(ctx context.Context, trigger common.NotificationTriggerDTO) (
+	*common.NotificationTriggerDTO, *serviceerror.ServiceError) {
+	if err := declarativeresource.CheckDeclarativeCreate(); err != nil {
+		return nil, err
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	trigger.ID = id
+
+	if svcErr := s.validateTrigger(ctx, trigger); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.store.createTrigger(ctx, trigger); err != nil {
+		s.logger.Error("Failed to create notification trigger", log.String("event", string(trigger.Event)),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &trigger, nil
+}
+
+// UpdateTrigger updates an existing notification trigger.
+func (s *notificationTriggerService) UpdateTrigger(ctx context.Context, id string,
+	trigger common.NotificationTriggerDTO) (*common.NotificationTriggerDTO, *serviceerror.ServiceError) {
+	if err := declarativeresource.CheckDeclarativeUpdate(); err != nil {
+		return nil, err
+	}
+
+	if _, svcErr := s.GetTrigger(ctx, id); svcErr != nil {
+		return nil, svcErr
+	}
+	trigger.ID = id
+
+	if svcErr := s.validateTrigger(ctx, trigger); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.store.updateTrigger(ctx, trigger); err != nil {
+		s.logger.Error("Failed to update notification trigger", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &trigger, nil
+}
+
+// DeleteTrigger deletes a notification trigger. Deleting a trigger that does not exist is not an error.
+func (s *notificationTriggerService) DeleteTrigger(ctx context.Context, id string) *serviceerror.ServiceError {
+	if err := declarativeresource.CheckDeclarativeDelete(); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return &ErrorInvalidTriggerID
+	}
+
+	if err := s.store.deleteTrigger(ctx, id); err != nil {
+		s.logger.Error("Failed to delete notification trigger", log.String("id", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	return nil
+}
+
+// Notify sends the notifications configured for the given event. For each channel, an enabled trigger of the
+// user's organization unit takes precedence over a global trigger. Channels for which the user has no recipient
+// address are skipped. The rendered notifications are queued for delivery and the first failure to render a
+// notification is returned.
+func (s *notificationTriggerService) Notify(ctx context.Context,
+	notification common.EventNotification) *serviceerror.ServiceError {
+	if !supportedNotificationEvents[notification.Event] {
+		return &ErrorInvalidNotificationEvent
+	}
+
+	triggers, err := s.store.listTriggersByEvent(ctx, notification.Event)
+	if err != nil {
+		s.logger.Error("Failed to retrieve notification triggers", log.String("event", string(notification.Event)),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	var firstErr *serviceerror.ServiceError
+	for _, trigger := range selectTriggers(triggers, notification.OUID) {
+		recipient := notification.Recipients[trigger.Channel]
+		if recipient == "" {
+			s.logger.Debug("No recipient available for the notification channel, skipping",
+				log.String("event", string(notification.Event)), log.String("channel", string(trigger.Channel)))
+			continue
+		}
+
+		if svcErr := s.dispatch(ctx, trigger, notification, recipient); svcErr != nil {
+			s.logger.Error("Failed to queue event notification", log.String("event", string(notification.Event)),
+				log.String("channel", string(trigger.Channel)), log.String("triggerId", trigger.ID))
+			if firstErr == nil {
+				firstErr = svcErr
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// dispatch renders the template of the trigger and queues it for delivery to the recipient via the trigger
+// channel.
+func (s *notificationTriggerService) dispatch(ctx context.Context, trigger common.NotificationTriggerDTO,
+	notification common.EventNotification, recipient string) *serviceerror.ServiceError {
+	rendered, svcErr := s.templateService.RenderLocalized(ctx, template.ScenarioType(trigger.Scenario),
+		channelTemplateTypes[trigger.Channel], notification.OUID, notification.Language, notification.Data)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	queued := common.QueuedNotification{
+		Channel:   trigger.Channel,
+		SenderID:  trigger.SenderID,
+		OUID:      notification.OUID,
+		Recipient: recipient,
+		Template:  trigger.Scenario,
+		Body:      rendered.Body,
+	}
+	if trigger.Channel == common.ChannelTypeEmail {
+		queued.Subject = rendered.Subject
+		queued.IsHTML = rendered.IsHTML
+	}
+	s.deliveryQueue.Enqueue(queued)
+
+	return nil
+}
+
+// validateTrigger validates the notification trigger and ensures no other trigger is configured for the same
+// event, channel and organization unit.
+func (s *notificationTriggerService) validateTrigger(ctx context.Context,
+	trigger common.NotificationTriggerDTO) *serviceerror.ServiceError {
+	if !supportedNotificationEvents[trigger.Event] {
+		return &ErrorInvalidNotificationEvent
+	}
+	if _, ok := channelTemplateTypes[trigger.Channel]; !ok {
+		return &ErrorInvalidChannel
+	}
+	if !template.IsValidScenario(template.ScenarioType(trigger.Scenario)) {
+		return &ErrorInvalidTemplateScenario
+	}
+
+	if trigger.SenderID != "" {
+		// Emails are sent via the server email client and cannot be routed through a message sender.
+		if trigger.Channel == common.ChannelTypeEmail {
+			return &ErrorInvalidSenderID
+		}
+		if _, svcErr := s.senderMgtService.GetSender(ctx, trigger.SenderID); svcErr != nil {
+			return svcErr
+		}
+	}
+
+	existing, err := s.store.listTriggersByEvent(ctx, trigger.Event)
+	if err != nil {
+		s.logger.Error("Failed to retrieve notification triggers", log.String("event", string(trigger.Event)),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	for _, other := range existing {
+		if other.ID != trigger.ID && other.Channel == trigger.Channel && other.OUID == trigger.OUID {
+			return &ErrorDuplicateTrigger
+		}
+	}
+
+	return nil
+}
+
+// selectTriggers returns the enabled triggers applicable to the given organization unit, keeping a single
+// trigger per channel. A trigger scoped to the organization unit overrides the global trigger of the channel.
+func selectTriggers(triggers []common.NotificationTriggerDTO, ouID string) []common.NotificationTriggerDTO {
+	selected := make([]common.NotificationTriggerDTO, 0, len(triggers))
+	indexByChannel := make(map[common.ChannelType]int)
+	for _, trigger := range triggers {
+		if !trigger.Enabled || (trigger.OUID != "" && trigger.OUID != ouID) {
+			continue
+		}
+
+		idx, exists := indexByChannel[trigger.Channel]
+		if !exists {
+			indexByChannel[trigger.Channel] = len(selected)
+			selected = append(selected, trigger)
+			continue
+		}
+		if trigger.OUID != "" {
+			selected[idx] = trigger
+		}
+	}
+
+	return selected
+}
@@ -0,0 +1,340 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/tests/mocks/templatemock"
+)
+
+const (
+	testTriggerID = "trigger-1"
+	testOUID      = "ou-1"
+)
+
+type TriggerServiceTestSuite struct {
+	suite.Suite
+	mockStore           *triggerStoreInterfaceMock
+	mockMgtService      *NotificationSenderMgtSvcInterfaceMock
+	mockTemplateService *templatemock.TemplateServiceInterfaceMock
+	mockDeliveryQueue   *deliveryQueueInterfaceMock
+	service             NotificationTriggerServiceInterface
+}
+
+func TestTriggerServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TriggerServiceTestSuite))
+}
+
+func (suite *TriggerServiceTestSuite) SetupSuite() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("", &config.Config{})
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *TriggerServiceTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *TriggerServiceTestSuite) SetupTest() {
+	suite.mockStore = newTriggerStoreInterfaceMock(suite.T())
+	suite.mockMgtService = NewNotificationSenderMgtSvcInterfaceMock(suite.T())
+	suite.mockTemplateService = templatemock.NewTemplateServiceInterfaceMock(suite.T())
+	suite.mockDeliveryQueue = newDeliveryQueueInterfaceMock(suite.T())
+	suite.service = newNotificationTriggerService(suite.mockStore, suite.mockMgtService, suite.mockTemplateService,
+		suite.mockDeliveryQueue)
+}
+
+func getTestTrigger() common.NotificationTriggerDTO {
+	return common.NotificationTriggerDTO{
+		Event:    common.NotificationEventPasswordChanged,
+		Channel:  common.ChannelTypeEmail,
+		Scenario: string(template.ScenarioPasswordChanged),
+		Enabled:  true,
+	}
+}
+
+func (suite *TriggerServiceTestSuite) TestCreateTrigger() {
+	trigger := getTestTrigger()
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, trigger.Event).
+		Return([]common.NotificationTriggerDTO{}, nil).Once()
+	suite.mockStore.EXPECT().createTrigger(mock.Anything, mock.MatchedBy(func(t common.NotificationTriggerDTO) bool {
+		return t.ID != "" && t.Event == trigger.Event && t.Channel == trigger.Channel
+	})).Return(nil).Once()
+
+	result, err := suite.service.CreateTrigger(context.Background(), trigger)
+	suite.Nil(err)
+	suite.NotNil(result)
+	suite.NotEmpty(result.ID)
+	suite.Equal(trigger.Scenario, result.Scenario)
+}
+
+func (suite *TriggerServiceTestSuite) TestCreateTrigger_ValidationErrors() {
+	testCases := []struct {
+		name     string
+		modify   func(t *common.NotificationTriggerDTO)
+		expected string
+	}{
+		{"InvalidEvent", func(t *common.NotificationTriggerDTO) { t.Event = "UNKNOWN" },
+			ErrorInvalidNotificationEvent.Code},
+		{"InvalidChannel", func(t *common.NotificationTriggerDTO) { t.Channel = "push" },
+			ErrorInvalidChannel.Code},
+		{"InvalidScenario", func(t *common.NotificationTriggerDTO) { t.Scenario = "UNKNOWN" },
+			ErrorInvalidTemplateScenario.Code},
+		{"SenderForEmail", func(t *common.NotificationTriggerDTO) { t.SenderID = testSenderID },
+			ErrorInvalidSenderID.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			trigger := getTestTrigger()
+			tc.modify(&trigger)
+
+			result, err := suite.service.CreateTrigger(context.Background(), trigger)
+			suite.Nil(result)
+			suite.NotNil(err)
+			suite.Equal(tc.expected, err.Code)
+		})
+	}
+}
+
+func (suite *TriggerServiceTestSuite) TestCreateTrigger_SenderNotFound() {
+	trigger := getTestTrigger()
+	trigger.Channel = common.ChannelTypeSMS
+	trigger.SenderID = testSenderID
+	suite.mockMgtService.EXPECT().GetSender(mock.Anything, testSenderID).Return(nil, &ErrorSenderNotFound).Once()
+
+	result, err := suite.service.CreateTrigger(context.Background(), trigger)
+	suite.Nil(result)
+	suite.Equal(ErrorSenderNotFound.Code, err.Code)
+}
+
+func (suite *TriggerServiceTestSuite) TestCreateTrigger_Duplicate() {
+	trigger := getTestTrigger()
+	existing := getTestTrigger()
+	existing.ID = testTriggerID
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, trigger.Event).
+		Return([]common.NotificationTriggerDTO{existing}, nil).Once()
+
+	result, err := suite.service.CreateTrigger(context.Background(), trigger)
+	suite.Nil(result)
+	suite.Equal(ErrorDuplicateTrigger.Code, err.Code)
+}
+
+func (suite *TriggerServiceTestSuite) TestCreateTrigger_OUScopedIsNotDuplicate() {
+	trigger := getTestTrigger()
+	trigger.OUID = testOUID
+	existing := getTestTrigger()
+	existing.ID = testTriggerID
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, trigger.Event).
+		Return([]common.NotificationTriggerDTO{existing}, nil).Once()
+	suite.mockStore.EXPECT().createTrigger(mock.Anything, mock.Anything).Return(nil).Once()
+
+	result, err := suite.service.CreateTrigger(context.Background(), trigger)
+	suite.Nil(err)
+	suite.Equal(testOUID, result.OUID)
+}
+
+func (suite *TriggerServiceTestSuite) TestCreateTrigger_StoreError() {
+	trigger := getTestTrigger()
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, trigger.Event).
+		Return([]common.NotificationTriggerDTO{}, nil).Once()
+	suite.mockStore.EXPECT().createTrigger(mock.Anything, mock.Anything).Return(errors.New("db err")).Once()
+
+	result, err := suite.service.CreateTrigger(context.Background(), trigger)
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *TriggerServiceTestSuite) TestGetTrigger_NotFound() {
+	suite.mockStore.EXPECT().getTriggerByID(mock.Anything, testTriggerID).Return(nil, nil).Once()
+
+	result, err := suite.service.GetTrigger(context.Background(), testTriggerID)
+	suite.Nil(result)
+	suite.Equal(ErrorTriggerNotFound.Code, err.Code)
+}
+
+func (suite *TriggerServiceTestSuite) TestGetTrigger_EmptyID() {
+	result, err := suite.service.GetTrigger(context.Background(), "")
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidTriggerID.Code, err.Code)
+}
+
+func (suite *TriggerServiceTestSuite) TestUpdateTrigger() {
+	existing := getTestTrigger()
+	existing.ID = testTriggerID
+	update := getTestTrigger()
+	update.Enabled = false
+
+	suite.mockStore.EXPECT().getTriggerByID(mock.Anything, testTriggerID).Return(&existing, nil).Once()
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, update.Event).
+		Return([]common.NotificationTriggerDTO{existing}, nil).Once()
+	suite.mockStore.EXPECT().updateTrigger(mock.Anything, mock.MatchedBy(func(t common.NotificationTriggerDTO) bool {
+		return t.ID == testTriggerID && !t.Enabled
+	})).Return(nil).Once()
+
+	result, err := suite.service.UpdateTrigger(context.Background(), testTriggerID, update)
+	suite.Nil(err)
+	suite.Equal(testTriggerID, result.ID)
+	suite.False(result.Enabled)
+}
+
+func (suite *TriggerServiceTestSuite) TestUpdateTrigger_NotFound() {
+	suite.mockStore.EXPECT().getTriggerByID(mock.Anything, testTriggerID).Return(nil, nil).Once()
+
+	result, err := suite.service.UpdateTrigger(context.Background(), testTriggerID, getTestTrigger())
+	suite.Nil(result)
+	suite.Equal(ErrorTriggerNotFound.Code, err.Code)
+}
+
+func (suite *TriggerServiceTestSuite) TestDeleteTrigger() {
+	suite.mockStore.EXPECT().deleteTrigger(mock.Anything, testTriggerID).Return(nil).Once()
+
+	err := suite.service.DeleteTrigger(context.Background(), testTriggerID)
+	suite.Nil(err)
+}
+
+func (suite *TriggerServiceTestSuite) TestNotify_Email() {
+	trigger := getTestTrigger()
+	trigger.ID = testTriggerID
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, common.NotificationEventPasswordChanged).
+		Return([]common.NotificationTriggerDTO{trigger}, nil).Once()
+	suite.mockTemplateService.EXPECT().RenderLocalized(mock.Anything, template.ScenarioPasswordChanged,
+		template.TemplateTypeEmail, testOUID, "fr", template.TemplateData{"appName": "Thunder"}).
+		Return(&template.RenderedTemplate{Subject: "Subject", Body: "<p>Body</p>", IsHTML: true}, nil).Once()
+	suite.mockDeliveryQueue.EXPECT().Enqueue(common.QueuedNotification{
+		Channel:   common.ChannelTypeEmail,
+		OUID:      testOUID,
+		Recipient: "user@example.com",
+		Template:  string(template.ScenarioPasswordChanged),
+		Subject:   "Subject",
+		Body:      "<p>Body</p>",
+		IsHTML:    true,
+	}).Return().Once()
+
+	err := suite.service.Notify(context.Background(), common.EventNotification{
+		Event:    common.NotificationEventPasswordChanged,
+		OUID:     testOUID,
+		Language: "fr",
+		Recipients: map[common.ChannelType]string{
+			common.ChannelTypeEmail: "user@example.com",
+		},
+		Data: map[string]string{"appName": "Thunder"},
+	})
+	suite.Nil(err)
+}
+
+func (suite *TriggerServiceTestSuite) TestNotify_OUTriggerOverridesGlobal() {
+	global := common.NotificationTriggerDTO{ID: "global", Event: common.NotificationEventAccountLocked,
+		Channel: common.ChannelTypeSMS, Scenario: string(template.ScenarioAccountLocked), Enabled: true}
+	scoped := common.NotificationTriggerDTO{ID: "scoped", Event: common.NotificationEventAccountLocked,
+		Channel: common.ChannelTypeSMS, Scenario: string(template.ScenarioOTP), SenderID: testSenderID,
+		OUID: testOUID, Enabled: true}
+	otherOU := common.NotificationTriggerDTO{ID: "other", Event: common.NotificationEventAccountLocked,
+		Channel: common.ChannelTypeSMS, Scenario: string(template.ScenarioOTP), OUID: "ou-2", Enabled: true}
+	disabledEmail := common.NotificationTriggerDTO{ID: "email", Event: common.NotificationEventAccountLocked,
+		Channel: common.ChannelTypeEmail, Scenario: string(template.ScenarioAccountLocked)}
+
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, common.NotificationEventAccountLocked).
+		Return([]common.NotificationTriggerDTO{global, otherOU, scoped, disabledEmail}, nil).Once()
+	suite.mockTemplateService.EXPECT().RenderLocalized(mock.Anything, template.ScenarioOTP,
+		template.TemplateTypeSMS, testOUID, "", mock.Anything).
+		Return(&template.RenderedTemplate{Body: "Locked"}, nil).Once()
+	suite.mockDeliveryQueue.EXPECT().Enqueue(common.QueuedNotification{
+		Channel:   common.ChannelTypeSMS,
+		SenderID:  testSenderID,
+		OUID:      testOUID,
+		Recipient: "+15551234567",
+		Template:  string(template.ScenarioOTP),
+		Body:      "Locked",
+	}).Return().Once()
+
+	err := suite.service.Notify(context.Background(), common.EventNotification{
+		Event: common.NotificationEventAccountLocked,
+		OUID:  testOUID,
+		Recipients: map[common.ChannelType]string{
+			common.ChannelTypeSMS:   "+15551234567",
+			common.ChannelTypeEmail: "user@example.com",
+		},
+	})
+	suite.Nil(err)
+}
+
+func (suite *TriggerServiceTestSuite) TestNotify_SMSQueuedForOUSender() {
+	trigger := common.NotificationTriggerDTO{ID: testTriggerID, Event: common.NotificationEventUserCreated,
+		Channel: common.ChannelTypeSMS, Scenario: string(template.ScenarioUserCreated), Enabled: true}
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, common.NotificationEventUserCreated).
+		Return([]common.NotificationTriggerDTO{trigger}, nil).Once()
+	suite.mockTemplateService.EXPECT().RenderLocalized(mock.Anything, template.ScenarioUserCreated,
+		template.TemplateTypeSMS, testOUID, "", mock.Anything).
+		Return(&template.RenderedTemplate{Body: "Welcome"}, nil).Once()
+	suite.mockDeliveryQueue.EXPECT().Enqueue(common.QueuedNotification{
+		Channel:   common.ChannelTypeSMS,
+		OUID:      testOUID,
+		Recipient: "+15551234567",
+		Template:  string(template.ScenarioUserCreated),
+		Body:      "Welcome",
+	}).Return().Once()
+
+	err := suite.service.Notify(context.Background(), common.EventNotification{
+		Event:      common.NotificationEventUserCreated,
+		OUID:       testOUID,
+		Recipients: map[common.ChannelType]string{common.ChannelTypeSMS: "+15551234567"},
+	})
+	suite.Nil(err)
+}
+
+func (suite *TriggerServiceTestSuite) TestNotify_SkipsChannelWithoutRecipient() {
+	trigger := getTestTrigger()
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, common.NotificationEventPasswordChanged).
+		Return([]common.NotificationTriggerDTO{trigger}, nil).Once()
+
+	err := suite.service.Notify(context.Background(), common.EventNotification{
+		Event: common.NotificationEventPasswordChanged,
+	})
+	suite.Nil(err)
+}
+
+func (suite *TriggerServiceTestSuite) TestNotify_InvalidEvent() {
+	err := suite.service.Notify(context.Background(), common.EventNotification{Event: "UNKNOWN"})
+	suite.NotNil(err)
+	suite.Equal(ErrorInvalidNotificationEvent.Code, err.Code)
+}
+
+func (suite *TriggerServiceTestSuite) TestNotify_StoreError() {
+	suite.mockStore.EXPECT().listTriggersByEvent(mock.Anything, common.NotificationEventNewDeviceLogin).
+		Return(nil, errors.New("db err")).Once()
+
+	err := suite.service.Notify(context.Background(), common.EventNotification{
+		Event: common.NotificationEventNewDeviceLogin,
+	})
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// triggerStoreInterface defines the interface for notification trigger storage operations.
+type triggerStoreInterface interface {
+	createTrigger(ctx context.Context, trigger common.NotificationTriggerDTO) error
+	listTriggers(ctx context.Context) ([]common.NotificationTriggerDTO, error)
+	listTriggersByEvent(ctx context.Context, event common.NotificationEvent) ([]common.NotificationTriggerDTO, error)
+	getTriggerByID(ctx context.Context, id string) (*common.NotificationTriggerDTO, error)
+	updateTrigger(ctx context.Context, trigger common.NotificationTriggerDTO) error
+	deleteTrigger(ctx context.Context, id string) error
+}
+
+// triggerStore is the database implementation of triggerStoreInterface.
+type triggerStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newTriggerStore returns a new instance of triggerStoreInterface.
+func newTriggerStore() triggerStoreInterface {
+	return &triggerStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createTrigger creates a new notification trigger.
+func (s *triggerStore) createTrigger(ctx context.Context, trigger common.NotificationTriggerDTO) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateNotificationTrigger, trigger.ID, string(trigger.Event),
+		string(trigger.Channel), trigger.Scenario, dbutils.ToNullableString(trigger.SenderID),
+		dbutils.ToNullableString(trigger.OUID), sysutils.BoolToNumString(trigger.Enabled), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// listTriggers retrieves all notification triggers.
+func (s *triggerStore) listTriggers(ctx context.Context) ([]common.NotificationTriggerDTO, error) {
+	return s.queryTriggers(ctx, queryGetAllNotificationTriggers, s.deploymentID)
+}
+
+// listTriggersByEvent retrieves the notification triggers configured for an event.
+func (s *triggerStore) listTriggersByEvent(ctx context.Context,
+	event common.NotificationEvent) ([]common.NotificationTriggerDTO, error) {
+	return s.queryTriggers(ctx, queryGetNotificationTriggersByEvent, string(event), s.deploymentID)
+}
+
+// getTriggerByID retrieves a notification trigger by its ID. Returns nil if the trigger does not exist.
+func (s *triggerStore) getTriggerByID(ctx context.Context, id string) (*common.NotificationTriggerDTO, error) {
+	triggers, err := s.queryTriggers(ctx, queryGetNotificationTriggerByID, id, s.deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(triggers) == 0 {
+		return nil, nil
+	}
+	if len(triggers) > 1 {
+		return nil, fmt.Errorf("multiple triggers found for id: %s", id)
+	}
+
+	return &triggers[0], nil
+}
+
+// updateTrigger updates an existing notification trigger.
+func (s *triggerStore) updateTrigger(ctx context.Context, trigger common.NotificationTriggerDTO) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateNotificationTrigger, string(trigger.Event),
+		string(trigger.Channel), trigger.Scenario, dbutils.ToNullableString(trigger.SenderID),
+		dbutils.ToNullableString(trigger.OUID), sysutils.BoolToNumString(trigger.Enabled), trigger.ID, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// deleteTrigger deletes a notification trigger.
+func (s *triggerStore) deleteTrigger(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteNotificationTrigger, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// queryTriggers executes the given query and builds the notification triggers from the result rows.
+func (s *triggerStore) queryTriggers(ctx context.Context, query dbmodel.DBQuery,
+	args ...interface{}) ([]common.NotificationTriggerDTO, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	triggers := make([]common.NotificationTriggerDTO, 0, len(results))
+	for _, row := range results {
+		trigger, err := buildTriggerFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build trigger from result row: %w", err)
+		}
+		triggers = append(triggers, *trigger)
+	}
+
+	return triggers, nil
+}
+
+// buildTriggerFromResultRow constructs a NotificationTriggerDTO from a database result row.
+func buildTriggerFromResultRow(row map[string]interface{}) (*common.NotificationTriggerDTO, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	event, ok := row["event"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse event as string")
+	}
+	channel, ok := row["channel"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse channel as string")
+	}
+	scenario, ok := row["scenario"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse scenario as string")
+	}
+
+	// Optional columns may be NULL.
+	senderID, _ := row["sender_id"].(string)
+	ouID, _ := row["ou_id"].(string)
+
+	var enabled string
+	switch v := row["is_enabled"].(type) {
+	case string:
+		enabled = v
+	case []byte:
+		enabled = string(v)
+	}
+
+	return &common.NotificationTriggerDTO{
+		ID:       id,
+		Event:    common.NotificationEvent(event),
+		Channel:  common.ChannelType(channel),
+		Scenario: scenario,
+		SenderID: senderID,
+		OUID:     ouID,
+		Enabled:  sysutils.NumStringToBool(enabled),
+	}, nil
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/notification/common"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+type TriggerStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *triggerStore
+}
+
+func TestTriggerStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(TriggerStoreTestSuite))
+}
+
+func (suite *TriggerStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &triggerStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *TriggerStoreTestSuite) TestCreateTrigger() {
+	trigger := common.NotificationTriggerDTO{
+		ID:       testTriggerID,
+		Event:    common.NotificationEventPasswordChanged,
+		Channel:  common.ChannelTypeSMS,
+		Scenario: "PASSWORD_CHANGED",
+		SenderID: testSenderID,
+		Enabled:  true,
+	}
+
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateNotificationTrigger,
+		testTriggerID, "PASSWORD_CHANGED", "sms", "PASSWORD_CHANGED", testSenderID, nil, "1",
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createTrigger(context.Background(), trigger)
+	suite.NoError(err)
+}
+
+func (suite *TriggerStoreTestSuite) TestCreateTrigger_WithError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createTrigger(context.Background(), common.NotificationTriggerDTO{ID: testTriggerID})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *TriggerStoreTestSuite) TestListTriggersByEvent() {
+	rows := []map[string]interface{}{
+		{
+			"id":         testTriggerID,
+			"event":      "ACCOUNT_LOCKED",
+			"channel":    "email",
+			"scenario":   "ACCOUNT_LOCKED",
+			"sender_id":  nil,
+			"ou_id":      testOUID,
+			"is_enabled": []byte("1"),
+		},
+		{
+			"id":         "trigger-2",
+			"event":      "ACCOUNT_LOCKED",
+			"channel":    "sms",
+			"scenario":   "ACCOUNT_LOCKED",
+			"is_enabled": "0",
+		},
+	}
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetNotificationTriggersByEvent,
+		"ACCOUNT_LOCKED", testDeploymentID).Return(rows, nil).Once()
+
+	triggers, err := suite.store.listTriggersByEvent(context.Background(), common.NotificationEventAccountLocked)
+	suite.NoError(err)
+	suite.Len(triggers, 2)
+	suite.Equal(testOUID, triggers[0].OUID)
+	suite.Empty(triggers[0].SenderID)
+	suite.True(triggers[0].Enabled)
+	suite.Equal(common.ChannelTypeSMS, triggers[1].Channel)
+	suite.False(triggers[1].Enabled)
+}
+
+func (suite *TriggerStoreTestSuite) TestListTriggers_InvalidRow() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetAllNotificationTriggers,
+		testDeploymentID).Return([]map[string]interface{}{{"id": testTriggerID}}, nil).Once()
+
+	triggers, err := suite.store.listTriggers(context.Background())
+	suite.Error(err)
+	suite.Nil(triggers)
+}
+
+func (suite *TriggerStoreTestSuite) TestGetTriggerByID_NotFound() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetNotificationTriggerByID,
+		testTriggerID, testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	trigger, err := suite.store.getTriggerByID(context.Background(), testTriggerID)
+	suite.NoError(err)
+	suite.Nil(trigger)
+}
+
+func (suite *TriggerStoreTestSuite) TestUpdateTrigger() {
+	trigger := common.NotificationTriggerDTO{
+		ID:       testTriggerID,
+		Event:    common.NotificationEventUserCreated,
+		Channel:  common.ChannelTypeEmail,
+		Scenario: "USER_CREATED",
+		OUID:     testOUID,
+	}
+
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateNotificationTrigger,
+		"USER_CREATED", "email", "USER_CREATED", nil, testOUID, "0", testTriggerID,
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.updateTrigger(context.Background(), trigger)
+	suite.NoError(err)
+}
+
+func (suite *TriggerStoreTestSuite) TestDeleteTrigger() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteNotificationTrigger,
+		testTriggerID, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.deleteTrigger(context.Background(), testTriggerID)
+	suite.NoError(err)
+}
@@ -55,7 +55,9 @@ func validateMessageNotificationSender(sender common.NotificationSenderDTO) *ser
 	}
 	if sender.Provider != common.MessageProviderTypeTwilio &&
 		sender.Provider != common.MessageProviderTypeVonage &&
-		sender.Provider != common.MessageProviderTypeCustom {
+		sender.Provider != common.MessageProviderTypeCustom &&
+		sender.Provider != common.MessageProviderTypeFCM &&
+		sender.Provider != common.MessageProviderTypeAPNs {
 		return &ErrorInvalidProvider
 	}
 
@@ -84,6 +86,10 @@ func validateMessageNotificationSenderProperties(sender common.NotificationSende
 		return validateVonageProperties(sender.Properties)
 	case common.MessageProviderTypeCustom:
 		return validateCustomProperties(sender.Properties)
+	case common.MessageProviderTypeFCM:
+		return validateFCMProperties(sender.Properties)
+	case common.MessageProviderTypeAPNs:
+		return validateAPNsProperties(sender.Properties)
 	default:
 		return errors.New("unsupported message notification sender")
 	}
@@ -172,6 +178,41 @@ func validateCustomProperties(properties []cmodels.Property) error {
 	return nil
 }
 
+// validateFCMProperties validates the message notification sender properties for an FCM client.
+func validateFCMProperties(properties []cmodels.Property) error {
+	requiredProps := map[string]bool{
+		common.FCMPropKeyProjectID:      false,
+		common.FCMPropKeyServiceAccount: false,
+	}
+	return validateSenderProperties(properties, requiredProps)
+}
+
+// validateAPNsProperties validates the message notification sender properties for an APNs client.
+func validateAPNsProperties(properties []cmodels.Property) error {
+	requiredProps := map[string]bool{
+		common.APNsPropKeyKeyID:      false,
+		common.APNsPropKeyTeamID:     false,
+		common.APNsPropKeyPrivateKey: false,
+		common.APNsPropKeyBundleID:   false,
+	}
+	if err := validateSenderProperties(properties, requiredProps); err != nil {
+		return err
+	}
+
+	for _, prop := range properties {
+		if prop.GetName() != common.APNsPropKeyEnvironment {
+			continue
+		}
+		environment, err := prop.GetValue()
+		if err == nil && environment != common.APNsEnvironmentProduction &&
+			environment != common.APNsEnvironmentSandbox {
+			return errors.New("APNs environment must be either production or sandbox")
+		}
+	}
+
+	return nil
+}
+
 // validateSenderProperties validates the properties for a notification sender.
 func validateSenderProperties(properties []cmodels.Property, requiredProperties map[string]bool) error {
 	for _, prop := range properties {
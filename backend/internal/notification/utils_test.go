@@ -227,6 +227,48 @@ func (suite *UtilsTestSuite) TestValidateVonageProperties() {
 	suite.Nil(err)
 }
 
+func (suite *UtilsTestSuite) TestValidateFCMProperties() {
+	properties := []cmodels.Property{
+		createTestProperty("project_id", "test-project", false),
+		createTestProperty("service_account", "{}", true),
+	}
+	suite.Nil(validateFCMProperties(properties))
+
+	err := validateFCMProperties(properties[:1])
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "service_account")
+}
+
+func (suite *UtilsTestSuite) TestValidateAPNsProperties() {
+	properties := []cmodels.Property{
+		createTestProperty("key_id", "ABC123DEFG", false),
+		createTestProperty("team_id", "DEF123GHIJ", false),
+		createTestProperty("private_key", "test-key", true),
+		createTestProperty("bundle_id", "com.example.app", false),
+		createTestProperty("environment", "sandbox", false),
+	}
+	suite.Nil(validateAPNsProperties(properties))
+
+	err := validateAPNsProperties(properties[1:])
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "key_id")
+}
+
+func (suite *UtilsTestSuite) TestValidateAPNsProperties_InvalidEnvironment() {
+	properties := []cmodels.Property{
+		createTestProperty("key_id", "ABC123DEFG", false),
+		createTestProperty("team_id", "DEF123GHIJ", false),
+		createTestProperty("private_key", "test-key", true),
+		createTestProperty("bundle_id", "com.example.app", false),
+		createTestProperty("environment", "staging", false),
+	}
+
+	err := validateAPNsProperties(properties)
+
+	suite.NotNil(err)
+	suite.Contains(err.Error(), "environment")
+}
+
 func (suite *UtilsTestSuite) TestValidateVonageProperties_MissingAPIKey() {
 	properties := []cmodels.Property{
 		createTestProperty("api_secret", "test-secret", true),
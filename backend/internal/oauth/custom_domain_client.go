@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oauth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// customDomainInboundClient resolves the OAuth clients of the requests received on a custom domain with an
+// ou_id only when the client belongs to that organization unit or one of its descendants, so that the clients
+// served on one isolated custom domain cannot obtain or use tokens on another. Requests received on the server
+// host are not restricted.
+type customDomainInboundClient struct {
+	inboundclient.InboundClientServiceInterface
+	ouService ou.OrganizationUnitServiceInterface
+}
+
+// newCustomDomainInboundClient wraps the inbound client service with the ou_id of the custom domains.
+func newCustomDomainInboundClient(
+	inboundClient inboundclient.InboundClientServiceInterface, ouService ou.OrganizationUnitServiceInterface,
+) inboundclient.InboundClientServiceInterface {
+	if ouService == nil {
+		return inboundClient
+	}
+	return &customDomainInboundClient{
+		InboundClientServiceInterface: inboundClient,
+		ouService:                     ouService,
+	}
+}
+
+// GetOAuthClientByClientID resolves a full OAuthClient by its public client_id. A client outside the organization
+// unit of the custom domain the request was received on is treated as not found.
+func (c *customDomainInboundClient) GetOAuthClientByClientID(ctx context.Context, clientID string) (
+	*inboundmodel.OAuthClient, error) {
+	client, err := c.InboundClientServiceInterface.GetOAuthClientByClientID(ctx, clientID)
+	if err != nil || client == nil {
+		return client, err
+	}
+
+	domain := config.ResolveCustomDomain(ctx)
+	if domain == nil || domain.OUID == "" {
+		return client, nil
+	}
+
+	if client.OUID == "" {
+		return nil, nil
+	}
+	isMember, svcErr := c.ouService.IsParent(ctx, domain.OUID, client.OUID)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to resolve the organization unit of the client: %s", svcErr.Code)
+	}
+	if !isMember {
+		return nil, nil
+	}
+
+	return client, nil
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+type CustomDomainInboundClientTestSuite struct {
+	suite.Suite
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	mockOUService     *oumock.OrganizationUnitServiceInterfaceMock
+	client            *customDomainInboundClient
+	tenantCtx         context.Context
+}
+
+func TestCustomDomainInboundClientTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomDomainInboundClientTestSuite))
+}
+
+func (suite *CustomDomainInboundClientTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		CustomDomains: []config.CustomDomainConfig{
+			{Host: "id.tenant.io", OUID: "tenant-ou"},
+			{Host: "login.acme.io"},
+		},
+	}
+	suite.Require().NoError(config.InitializeServerRuntime("", testConfig))
+
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.client = &customDomainInboundClient{
+		InboundClientServiceInterface: suite.mockInboundClient,
+		ouService:                     suite.mockOUService,
+	}
+	suite.tenantCtx = sysContext.WithCustomDomain(context.Background(), "id.tenant.io")
+}
+
+func (suite *CustomDomainInboundClientTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *CustomDomainInboundClientTestSuite) TestGetOAuthClientByClientID_ClientInDomainOU() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(suite.tenantCtx, "client-1").
+		Return(&inboundmodel.OAuthClient{ClientID: "client-1", OUID: "child-ou"}, nil).Once()
+	suite.mockOUService.EXPECT().IsParent(suite.tenantCtx, "tenant-ou", "child-ou").Return(true, nil).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(suite.tenantCtx, "client-1")
+
+	suite.NoError(err)
+	suite.Equal("client-1", client.ClientID)
+}
+
+func (suite *CustomDomainInboundClientTestSuite) TestGetOAuthClientByClientID_ClientOutsideDomainOU() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(suite.tenantCtx, "client-1").
+		Return(&inboundmodel.OAuthClient{ClientID: "client-1", OUID: "other-ou"}, nil).Once()
+	suite.mockOUService.EXPECT().IsParent(suite.tenantCtx, "tenant-ou", "other-ou").Return(false, nil).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(suite.tenantCtx, "client-1")
+
+	suite.NoError(err)
+	suite.Nil(client)
+}
+
+func (suite *CustomDomainInboundClientTestSuite) TestGetOAuthClientByClientID_ClientWithoutOU() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(suite.tenantCtx, "client-1").
+		Return(&inboundmodel.OAuthClient{ClientID: "client-1"}, nil).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(suite.tenantCtx, "client-1")
+
+	suite.NoError(err)
+	suite.Nil(client)
+}
+
+func (suite *CustomDomainInboundClientTestSuite) TestGetOAuthClientByClientID_OUResolutionError() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(suite.tenantCtx, "client-1").
+		Return(&inboundmodel.OAuthClient{ClientID: "client-1", OUID: "child-ou"}, nil).Once()
+	suite.mockOUService.EXPECT().IsParent(suite.tenantCtx, "tenant-ou", "child-ou").
+		Return(false, &serviceerror.InternalServerError).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(suite.tenantCtx, "client-1")
+
+	suite.Error(err)
+	suite.Nil(client)
+}
+
+func (suite *CustomDomainInboundClientTestSuite) TestGetOAuthClientByClientID_DomainWithoutOU() {
+	ctx := sysContext.WithCustomDomain(context.Background(), "login.acme.io")
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(ctx, "client-1").
+		Return(&inboundmodel.OAuthClient{ClientID: "client-1", OUID: "other-ou"}, nil).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(ctx, "client-1")
+
+	suite.NoError(err)
+	suite.Equal("client-1", client.ClientID)
+}
+
+func (suite *CustomDomainInboundClientTestSuite) TestGetOAuthClientByClientID_NoCustomDomain() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(context.Background(), "client-1").
+		Return(&inboundmodel.OAuthClient{ClientID: "client-1", OUID: "other-ou"}, nil).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(context.Background(), "client-1")
+
+	suite.NoError(err)
+	suite.Equal("client-1", client.ClientID)
+}
+
+func (suite *CustomDomainInboundClientTestSuite) TestGetOAuthClientByClientID_ClientNotFound() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(suite.tenantCtx, "missing").Return(nil, nil).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(suite.tenantCtx, "missing")
+
+	suite.NoError(err)
+	suite.Nil(client)
+}
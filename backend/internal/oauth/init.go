@@ -28,21 +28,28 @@ import (
 	"github.com/thunder-id/thunderid/internal/authz"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	"github.com/thunder-id/thunderid/internal/grantrevocation"
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/notification"
 	"github.com/thunder-id/thunderid/internal/oauth/jwks"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/backchannellogout"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dcr"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/granthandlers"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/introspect"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/logout"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/token"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/userinfo"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/ssosession"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
@@ -68,8 +75,13 @@ func Initialize(
 	authzService authz.AuthorizationServiceInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
+	scopeService scope.ScopeServiceInterface,
+	scopeValidator scope.ScopeValidatorInterface,
 	i18nService i18nmgt.I18nServiceInterface,
 	idpService idp.IDPServiceInterface,
+	notifSenderSvc notification.NotificationSenderServiceInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface,
+	grantRevocationService grantrevocation.GrantRevocationServiceInterface,
 ) error {
 	// Fetch runtime transactioner for OAuth services.
 	transactioner, err := provider.GetDBProvider().GetRuntimeDBTransactioner()
@@ -77,27 +89,39 @@ func Initialize(
 		return err
 	}
 
+	// Resolve the OAuth clients with the claims bound to the defined scopes.
+	inboundClient = newScopeClaimsInboundClient(inboundClient, scopeService)
+	// Resolve only the OAuth clients of the organization unit bound to the custom domain of the request.
+	inboundClient = newCustomDomainInboundClient(inboundClient, ouService)
+
 	jwks.Initialize(mux, pkiService)
 	httpClient := syshttp.NewHTTPClientWithCheckRedirect(func(req *http.Request, _ []*http.Request) error {
 		return syshttp.IsSSRFSafeURL(req.URL.String())
 	})
 	resolver := jwksresolver.Initialize(httpClient)
+	requestObjectResolver := requestobject.Initialize(jwtService, httpClient)
 	tokenBuilder, tokenValidator := tokenservice.Initialize(jwtService, jweService, resolver, idpService)
-	scopeValidator := scope.Initialize()
 	discoveryService := discovery.Initialize(mux, pkiService)
 	parService := par.Initialize(mux, inboundClient, authnProvider, jwtService, discoveryService,
 		resourceService)
+	cibaService := ciba.Initialize(mux, inboundClient, authnProvider, jwtService, discoveryService,
+		flowExecService, entityProvider, notifSenderSvc, httpClient, resourceService)
 	grantHandlerProvider, err := granthandlers.Initialize(
 		mux, jwtService, inboundClient, flowExecService, tokenBuilder, tokenValidator,
-		attributeCacheSvc, ouService, authzService, entityProvider, resourceService, parService)
+		attributeCacheSvc, ouService, authzService, entityProvider, resourceService, scopeValidator, parService,
+		requestObjectResolver, ssoSessionService, cibaService, grantRevocationService)
 	if err != nil {
 		return err
 	}
-	token.Initialize(mux, jwtService, inboundClient, authnProvider, grantHandlerProvider,
-		scopeValidator, observabilitySvc, discoveryService, transactioner)
+	if _, err := token.Initialize(mux, jwtService, inboundClient, authnProvider, grantHandlerProvider,
+		scopeValidator, observabilitySvc, discoveryService, transactioner); err != nil {
+		return err
+	}
 	introspect.Initialize(mux, jwtService, inboundClient, authnProvider, discoveryService)
 	userinfo.Initialize(mux, jwtService, jweService, resolver,
 		tokenValidator, inboundClient, ouService, attributeCacheSvc, transactioner)
-	dcr.Initialize(mux, applicationService, ouService, i18nService, transactioner)
+	logout.Initialize(mux, jwtService, tokenValidator, inboundClient, ssoSessionService)
+	backchannellogout.Initialize(mux, jwtService, inboundClient, ssoSessionService, httpClient)
+	dcr.Initialize(mux, applicationService, ouService, i18nService, jwtService, transactioner)
 	return nil
 }
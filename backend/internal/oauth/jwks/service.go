@@ -20,6 +20,7 @@
 package jwks
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/rsa"
@@ -29,6 +30,7 @@ import (
 	// Use crypto/sha1 only for JWKS x5t as required by spec for thumbprint.
 	"crypto/sha1" //nolint:gosec
 
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
@@ -37,7 +39,7 @@ import (
 
 // JWKSServiceInterface defines the interface for JWKS service.
 type JWKSServiceInterface interface {
-	GetJWKS() (*JWKSResponse, *serviceerror.ServiceError)
+	GetJWKS(ctx context.Context) (*JWKSResponse, *serviceerror.ServiceError)
 }
 
 // jwksService implements the JWKSServiceInterface.
@@ -54,8 +56,10 @@ func newJWKSService(pkiService pkiservice.PKIServiceInterface) JWKSServiceInterf
 	}
 }
 
-// GetJWKS retrieves the JSON Web Key Set (JWKS) from all server certificates.
-func (s *jwksService) GetJWKS() (*JWKSResponse, *serviceerror.ServiceError) {
+// GetJWKS retrieves the JSON Web Key Set (JWKS) from the server certificates that sign the tokens issued for
+// the request carried by ctx. A custom domain with a signing key of its own publishes only that key, and the
+// keys of such domains are not published for the other requests.
+func (s *jwksService) GetJWKS(ctx context.Context) (*JWKSResponse, *serviceerror.ServiceError) {
 	// Get all certificates
 	allCerts, err := s.pkiService.GetAllX509Certificates()
 	if err != nil {
@@ -68,7 +72,12 @@ func (s *jwksService) GetJWKS() (*JWKSResponse, *serviceerror.ServiceError) {
 
 	var jwksKeys []JWKS
 
+	issuer := config.GetIssuer(ctx)
 	for certID, parsedCert := range allCerts {
+		if !config.IsSigningKeyAllowed(certID, issuer) {
+			continue
+		}
+
 		// Generate KID from certificate thumbprint
 		kid := s.pkiService.GetCertThumbprint(certID)
 
@@ -19,6 +19,7 @@
 package jwks
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
@@ -31,6 +32,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/tests/mocks/crypto/pki/pkimock"
@@ -65,7 +67,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_RSA_Success() {
 	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(allCerts, nil)
 	suite.pkiMock.EXPECT().GetCertThumbprint("kid-1").Return("kid-1")
 
-	resp, svcErr := suite.jwksService.GetJWKS()
+	resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 	assert.Nil(suite.T(), svcErr)
 	assert.NotNil(suite.T(), resp)
 	assert.Len(suite.T(), resp.Keys, 1)
@@ -87,7 +89,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_ECDSA_P256_Success() {
 	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(allCerts, nil)
 	suite.pkiMock.EXPECT().GetCertThumbprint("kid-1").Return("kid-1")
 
-	resp, svcErr := suite.jwksService.GetJWKS()
+	resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 	assert.Nil(suite.T(), svcErr)
 	assert.NotNil(suite.T(), resp)
 	assert.Len(suite.T(), resp.Keys, 1)
@@ -110,7 +112,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_EdDSA_Success() {
 	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(allCerts, nil)
 	suite.pkiMock.EXPECT().GetCertThumbprint("kid-1").Return("kid-1")
 
-	resp, svcErr := suite.jwksService.GetJWKS()
+	resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 	assert.Nil(suite.T(), svcErr)
 	assert.NotNil(suite.T(), resp)
 	assert.Len(suite.T(), resp.Keys, 1)
@@ -132,7 +134,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_CertParseError() {
 	})
 	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(nil, parseErr)
 
-	resp, svcErr := suite.jwksService.GetJWKS()
+	resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 	assert.Nil(suite.T(), resp)
 	assert.NotNil(suite.T(), svcErr)
 	// The error is passed through from PKI service, so we expect the PKI error code
@@ -145,7 +147,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_NoCertificatesFound() {
 	allCerts := map[string]*x509.Certificate{}
 	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(allCerts, nil)
 
-	resp, svcErr := suite.jwksService.GetJWKS()
+	resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 	assert.Nil(suite.T(), resp)
 	assert.NotNil(suite.T(), svcErr)
 	assert.Equal(suite.T(), serviceerror.InternalServerError.Code, svcErr.Code)
@@ -161,7 +163,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_UnsupportedPublicKeyType() {
 	suite.pkiMock.EXPECT().GetCertThumbprint("kid-1").Return("kid-1")
 	suite.pkiMock.EXPECT().GetCertThumbprint("kid-2").Return("kid-2")
 
-	resp, svcErr := suite.jwksService.GetJWKS()
+	resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 	assert.Nil(suite.T(), svcErr)
 	assert.NotNil(suite.T(), resp)
 	// The unsupported key should be skipped, so only one valid key should be present
@@ -184,7 +186,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_MultipleCertificates() {
 	suite.pkiMock.EXPECT().GetCertThumbprint("rsa-kid").Return("rsa-kid")
 	suite.pkiMock.EXPECT().GetCertThumbprint("ec-kid").Return("ec-kid")
 
-	resp, svcErr := suite.jwksService.GetJWKS()
+	resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 	assert.Nil(suite.T(), svcErr)
 	assert.NotNil(suite.T(), resp)
 	assert.Len(suite.T(), resp.Keys, 2)
@@ -237,7 +239,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_ECDSA_AdditionalCurves() {
 			suite.pkiMock.EXPECT().GetAllX509Certificates().Return(allCerts, nil).Once()
 			suite.pkiMock.EXPECT().GetCertThumbprint(kid).Return(kid).Once()
 
-			resp, svcErr := suite.jwksService.GetJWKS()
+			resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 			assert.Nil(suite.T(), svcErr)
 			assert.NotNil(suite.T(), resp)
 			assert.Len(suite.T(), resp.Keys, 1)
@@ -258,7 +260,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_OnlyUnsupportedKeys() {
 	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(allCerts, nil)
 	suite.pkiMock.EXPECT().GetCertThumbprint("kid-1").Return("kid-1")
 
-	resp, svcErr := suite.jwksService.GetJWKS()
+	resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 	assert.Nil(suite.T(), resp)
 	assert.NotNil(suite.T(), svcErr)
 	assert.Equal(suite.T(), serviceerror.InternalServerError.Code, svcErr.Code)
@@ -273,7 +275,7 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_RSA_ZeroExponent() {
 	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(allCerts, nil)
 	suite.pkiMock.EXPECT().GetCertThumbprint("kid-zero").Return("kid-zero")
 
-	resp, svcErr := suite.jwksService.GetJWKS()
+	resp, svcErr := suite.jwksService.GetJWKS(context.Background())
 	assert.Nil(suite.T(), svcErr)
 	assert.NotNil(suite.T(), resp)
 	assert.Len(suite.T(), resp.Keys, 1)
@@ -285,3 +287,32 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_RSA_ZeroExponent() {
 	// encodeBase64URL([]byte{0}) -> "AA" (because base64 of 0 is AA==, trimmed =) -> "AA"
 	assert.Equal(suite.T(), "AA", k.E)
 }
+
+func (suite *JWKSServiceTestSuite) TestGetJWKS_CustomDomainSigningKeyIsolation() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		CustomDomains: []config.CustomDomainConfig{
+			{Host: "id.tenant.io", Issuer: "https://id.tenant.io", SigningKeyID: "tenant-key"},
+		},
+	}
+	suite.Require().NoError(config.InitializeServerRuntime("", testConfig))
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	allCerts := map[string]*x509.Certificate{
+		"server-key": {Raw: []byte("server-cert-raw"), PublicKey: &key.PublicKey},
+		"tenant-key": {Raw: []byte("tenant-cert-raw"), PublicKey: &key.PublicKey},
+	}
+	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(allCerts, nil)
+	suite.pkiMock.EXPECT().GetCertThumbprint("server-key").Return("server-kid").Once()
+	suite.pkiMock.EXPECT().GetCertThumbprint("tenant-key").Return("tenant-kid").Once()
+
+	resp, svcErr := suite.jwksService.GetJWKS(sysContext.WithCustomDomain(context.Background(), "id.tenant.io"))
+	suite.Require().Nil(svcErr)
+	suite.Require().Len(resp.Keys, 1)
+	suite.Equal("tenant-kid", resp.Keys[0].Kid)
+
+	resp, svcErr = suite.jwksService.GetJWKS(context.Background())
+	suite.Require().Nil(svcErr)
+	suite.Require().Len(resp.Keys, 1)
+	suite.Equal("server-kid", resp.Keys[0].Kid)
+}
@@ -170,6 +170,65 @@ func (_c *authReqRedisClientMock_Get_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// GetDel provides a mock function for the type authReqRedisClientMock
+func (_mock *authReqRedisClientMock) GetDel(ctx context.Context, key string) *redis.StringCmd {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDel")
+	}
+
+	var r0 *redis.StringCmd
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *redis.StringCmd); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.StringCmd)
+		}
+	}
+	return r0
+}
+
+// authReqRedisClientMock_GetDel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDel'
+type authReqRedisClientMock_GetDel_Call struct {
+	*mock.Call
+}
+
+// GetDel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *authReqRedisClientMock_Expecter) GetDel(ctx interface{}, key interface{}) *authReqRedisClientMock_GetDel_Call {
+	return &authReqRedisClientMock_GetDel_Call{Call: _e.mock.On("GetDel", ctx, key)}
+}
+
+func (_c *authReqRedisClientMock_GetDel_Call) Run(run func(ctx context.Context, key string)) *authReqRedisClientMock_GetDel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *authReqRedisClientMock_GetDel_Call) Return(stringCmd *redis.StringCmd) *authReqRedisClientMock_GetDel_Call {
+	_c.Call.Return(stringCmd)
+	return _c
+}
+
+func (_c *authReqRedisClientMock_GetDel_Call) RunAndReturn(run func(ctx context.Context, key string) *redis.StringCmd) *authReqRedisClientMock_GetDel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Set provides a mock function for the type authReqRedisClientMock
 func (_mock *authReqRedisClientMock) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd {
 	ret := _mock.Called(ctx, key, value, expiration)
@@ -31,24 +31,27 @@ import (
 )
 
 const (
-	columnNameCodeID               = "code_id"
-	columnNameAuthorizationCode    = "authorization_code"
-	columnNameClientID             = "client_id"
-	columnNameState                = "state"
-	columnNameAuthZData            = "authz_data"
-	columnNameTimeCreated          = "time_created"
-	columnNameExpiryTime           = "expiry_time"
-	jsonDataKeyRedirectURI         = "redirect_uri"
-	jsonDataKeyAuthorizedUserID    = "authorized_user_id"
-	jsonDataKeyScopes              = "scopes"
-	jsonDataKeyCodeChallenge       = "code_challenge"
-	jsonDataKeyCodeChallengeMethod = "code_challenge_method"
-	jsonDataKeyResource            = "resource"
-	jsonDataKeyAttributeCacheID    = "attribute_cache_id"
-	jsonDataKeyClaimsRequest       = "claims_request"
-	jsonDataKeyClaimsLocales       = "claims_locales"
-	jsonDataKeyNonce               = "nonce"
-	jsonDataKeyCompletedACR        = "completed_acr"
+	columnNameCodeID                = "code_id"
+	columnNameAuthorizationCode     = "authorization_code"
+	columnNameClientID              = "client_id"
+	columnNameState                 = "state"
+	columnNameAuthZData             = "authz_data"
+	columnNameTimeCreated           = "time_created"
+	columnNameExpiryTime            = "expiry_time"
+	jsonDataKeyRedirectURI          = "redirect_uri"
+	jsonDataKeyAuthorizedUserID     = "authorized_user_id"
+	jsonDataKeyScopes               = "scopes"
+	jsonDataKeyCodeChallenge        = "code_challenge"
+	jsonDataKeyCodeChallengeMethod  = "code_challenge_method"
+	jsonDataKeyResource             = "resource"
+	jsonDataKeyAttributeCacheID     = "attribute_cache_id"
+	jsonDataKeyClaimsRequest        = "claims_request"
+	jsonDataKeyClaimsLocales        = "claims_locales"
+	jsonDataKeyNonce                = "nonce"
+	jsonDataKeyCompletedACR         = "completed_acr"
+	jsonDataKeyCompletedAMR         = "completed_amr"
+	jsonDataKeySessionID            = "session_id"
+	jsonDataKeyAuthorizationDetails = "authorization_details"
 )
 
 // AuthorizationCodeStoreInterface defines the interface for managing authorization codes.
@@ -145,6 +148,7 @@ func (acs *authorizationCodeStore) getJSONDataBytes(authzCode AuthorizationCode)
 		jsonDataKeyClaimsLocales:       authzCode.ClaimsLocales,
 		jsonDataKeyNonce:               authzCode.Nonce,
 		jsonDataKeyCompletedACR:        authzCode.CompletedACR,
+		jsonDataKeyCompletedAMR:        authzCode.CompletedAMR,
 	}
 
 	// Include user attributes if present
@@ -152,11 +156,21 @@ func (acs *authorizationCodeStore) getJSONDataBytes(authzCode AuthorizationCode)
 		jsonData[jsonDataKeyAttributeCacheID] = authzCode.AttributeCacheID
 	}
 
+	// Include the SSO session if present
+	if authzCode.SessionID != "" {
+		jsonData[jsonDataKeySessionID] = authzCode.SessionID
+	}
+
 	// Include claims request if present
 	if authzCode.ClaimsRequest != nil {
 		jsonData[jsonDataKeyClaimsRequest] = authzCode.ClaimsRequest
 	}
 
+	// Include authorization details if present
+	if len(authzCode.AuthorizationDetails) > 0 {
+		jsonData[jsonDataKeyAuthorizationDetails] = authzCode.AuthorizationDetails
+	}
+
 	jsonDataBytes, err := json.Marshal(jsonData)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling authz data to JSON: %w", err)
@@ -274,6 +288,12 @@ func appendAuthzDataJSON(row map[string]interface{}, authzCode *AuthorizationCod
 	if completedACR, ok := authzData[jsonDataKeyCompletedACR].(string); ok {
 		authzCode.CompletedACR = completedACR
 	}
+	if completedAMR, ok := authzData[jsonDataKeyCompletedAMR].(string); ok {
+		authzCode.CompletedAMR = completedAMR
+	}
+	if sessionID, ok := authzData[jsonDataKeySessionID].(string); ok {
+		authzCode.SessionID = sessionID
+	}
 
 	if claimsData, ok := authzData[jsonDataKeyClaimsRequest]; ok && claimsData != nil {
 		claimsRequest, err := parseClaimsRequestFromJSON(claimsData)
@@ -283,6 +303,9 @@ func appendAuthzDataJSON(row map[string]interface{}, authzCode *AuthorizationCod
 		authzCode.ClaimsRequest = claimsRequest
 	}
 
+	authzCode.AuthorizationDetails = oauth2utils.ConvertToAuthorizationDetails(
+		authzData[jsonDataKeyAuthorizationDetails])
+
 	return authzCode, nil
 }
 
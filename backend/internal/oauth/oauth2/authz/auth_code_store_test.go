@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/system/config"
 
 	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
@@ -733,3 +734,81 @@ func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_WithNonce
 	suite.mockdbProvider.AssertExpectations(suite.T())
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
+
+func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_WithSessionID() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+
+	authzData := map[string]interface{}{
+		"redirect_uri":       "https://client.example.com/callback",
+		"authorized_user_id": "test-user-id",
+		"scopes":             "read write",
+		"session_id":         "test-session-id",
+	}
+
+	authzDataJSON, _ := json.Marshal(authzData)
+
+	suite.mockDBClient.On("QueryContext",
+		mock.Anything,
+		queryGetAuthorizationCode,
+		"test-code",
+		testDeploymentID,
+	).Return([]map[string]interface{}{
+		{
+			"code_id":            "test-code-id",
+			"authorization_code": "test-code",
+			"client_id":          "test-client-id",
+			"state":              AuthCodeStateActive,
+			"authz_data":         string(authzDataJSON),
+			"time_created":       "2023-01-01 12:00:00",
+			"expiry_time":        "2023-01-01 12:10:00",
+		},
+	}, nil)
+
+	result, err := suite.store.GetAuthorizationCode(context.Background(), "test-code")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "test-session-id", result.SessionID)
+
+	suite.mockdbProvider.AssertExpectations(suite.T())
+	suite.mockDBClient.AssertExpectations(suite.T())
+}
+
+func (suite *AuthorizationCodeStoreTestSuite) TestGetAuthorizationCode_WithAuthorizationDetails() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+
+	authzDataJSON, _ := suite.store.getJSONDataBytes(AuthorizationCode{
+		RedirectURI:      "https://client.example.com/callback",
+		AuthorizedUserID: "test-user-id",
+		Scopes:           "read",
+		AuthorizationDetails: []oauth2model.AuthorizationDetail{
+			{"type": "payment_initiation", "actions": []interface{}{"initiate"}},
+		},
+	})
+
+	suite.mockDBClient.On("QueryContext",
+		mock.Anything,
+		queryGetAuthorizationCode,
+		"test-code",
+		testDeploymentID,
+	).Return([]map[string]interface{}{
+		{
+			"code_id":            "test-code-id",
+			"authorization_code": "test-code",
+			"client_id":          "test-client-id",
+			"state":              AuthCodeStateActive,
+			"authz_data":         string(authzDataJSON),
+			"time_created":       "2023-01-01 12:00:00",
+			"expiry_time":        "2023-01-01 12:10:00",
+		},
+	}, nil)
+
+	result, err := suite.store.GetAuthorizationCode(context.Background(), "test-code")
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.AuthorizationDetails, 1)
+	assert.Equal(suite.T(), "payment_initiation", result.AuthorizationDetails[0].GetType())
+	assert.Equal(suite.T(), []interface{}{"initiate"}, result.AuthorizationDetails[0]["actions"])
+
+	suite.mockdbProvider.AssertExpectations(suite.T())
+	suite.mockDBClient.AssertExpectations(suite.T())
+}
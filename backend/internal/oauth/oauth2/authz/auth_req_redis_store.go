@@ -36,6 +36,7 @@ import (
 type authReqRedisClient interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
 	Get(ctx context.Context, key string) *redis.StringCmd
+	GetDel(ctx context.Context, key string) *redis.StringCmd
 	Del(ctx context.Context, keys ...string) *redis.IntCmd
 }
 
@@ -105,6 +106,30 @@ func (s *redisAuthorizationRequestStore) GetRequest(
 	return true, result, nil
 }
 
+// ConsumeRequest atomically retrieves and deletes an authorization request context entry via Redis GETDEL.
+func (s *redisAuthorizationRequestStore) ConsumeRequest(
+	ctx context.Context, key string,
+) (bool, authRequestContext, error) {
+	if key == "" {
+		return false, authRequestContext{}, nil
+	}
+
+	data, err := s.client.GetDel(ctx, s.authReqKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, authRequestContext{}, nil
+		}
+		return false, authRequestContext{}, fmt.Errorf("failed to consume authorization request from Redis: %w", err)
+	}
+
+	var result authRequestContext
+	if err := json.Unmarshal(data, &result); err != nil {
+		return false, authRequestContext{}, fmt.Errorf("failed to unmarshal authorization request: %w", err)
+	}
+
+	return true, result, nil
+}
+
 // ClearRequest removes a specific authorization request context entry from Redis.
 func (s *redisAuthorizationRequestStore) ClearRequest(ctx context.Context, key string) error {
 	if key == "" {
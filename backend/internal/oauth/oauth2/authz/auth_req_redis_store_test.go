@@ -157,6 +157,60 @@ func (suite *RedisAuthorizationRequestStoreTestSuite) TestGetRequest_UnmarshalEr
 	suite.Equal(authRequestContext{}, result)
 }
 
+// Tests for ConsumeRequest
+
+func (suite *RedisAuthorizationRequestStoreTestSuite) TestConsumeRequest_Success() {
+	data, _ := json.Marshal(suite.authReq)
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal(string(data))
+	suite.mockClient.On("GetDel", suite.ctx, suite.redisKey).Return(stringCmd)
+
+	found, result, err := suite.store.ConsumeRequest(suite.ctx, redisTestReqKey)
+	suite.NoError(err)
+	suite.True(found)
+	suite.Equal(suite.authReq.OAuthParameters.ClientID, result.OAuthParameters.ClientID)
+}
+
+func (suite *RedisAuthorizationRequestStoreTestSuite) TestConsumeRequest_EmptyKey() {
+	found, result, err := suite.store.ConsumeRequest(suite.ctx, "")
+	suite.NoError(err)
+	suite.False(found)
+	suite.Equal(authRequestContext{}, result)
+}
+
+func (suite *RedisAuthorizationRequestStoreTestSuite) TestConsumeRequest_AlreadyConsumed() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(redis.Nil)
+	suite.mockClient.On("GetDel", suite.ctx, suite.redisKey).Return(stringCmd)
+
+	found, result, err := suite.store.ConsumeRequest(suite.ctx, redisTestReqKey)
+	suite.NoError(err)
+	suite.False(found)
+	suite.Equal(authRequestContext{}, result)
+}
+
+func (suite *RedisAuthorizationRequestStoreTestSuite) TestConsumeRequest_GetDelError() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("GetDel", suite.ctx, suite.redisKey).Return(stringCmd)
+
+	found, _, err := suite.store.ConsumeRequest(suite.ctx, redisTestReqKey)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to consume authorization request from Redis")
+	suite.False(found)
+}
+
+func (suite *RedisAuthorizationRequestStoreTestSuite) TestConsumeRequest_UnmarshalError() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal("not valid json{{{")
+	suite.mockClient.On("GetDel", suite.ctx, suite.redisKey).Return(stringCmd)
+
+	found, _, err := suite.store.ConsumeRequest(suite.ctx, redisTestReqKey)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to unmarshal authorization request")
+	suite.False(found)
+}
+
 // Tests for ClearRequest
 
 func (suite *RedisAuthorizationRequestStoreTestSuite) TestClearRequest_Success() {
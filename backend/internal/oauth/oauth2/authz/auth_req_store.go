@@ -27,6 +27,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/utils"
@@ -41,6 +42,7 @@ type authRequestContext struct {
 type authorizationRequestStoreInterface interface {
 	AddRequest(ctx context.Context, value authRequestContext) (string, error)
 	GetRequest(ctx context.Context, key string) (bool, authRequestContext, error)
+	ConsumeRequest(ctx context.Context, key string) (bool, authRequestContext, error)
 	ClearRequest(ctx context.Context, key string) error
 }
 
@@ -121,6 +123,45 @@ func (authzRS *authorizationRequestStore) GetRequest(
 	return true, authRequestCtx, nil
 }
 
+// ConsumeRequest atomically retrieves and deletes an authorization request context entry from the store.
+// Only one of several concurrent callers, possibly on different server instances, obtains the entry;
+// the others see it as not found.
+func (authzRS *authorizationRequestStore) ConsumeRequest(
+	ctx context.Context, key string) (bool, authRequestContext, error) {
+	if key == "" {
+		return false, authRequestContext{}, nil
+	}
+
+	dbClient, err := authzRS.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, authRequestContext{}, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetAuthRequest, key, time.Now(), authzRS.deploymentID)
+	if err != nil {
+		return false, authRequestContext{}, fmt.Errorf("failed to query authorization request: %w", err)
+	}
+	if len(results) == 0 {
+		return false, authRequestContext{}, nil
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteAuthRequest, key, authzRS.deploymentID)
+	if err != nil {
+		return false, authRequestContext{}, fmt.Errorf("failed to delete authorization request: %w", err)
+	}
+	// Another consumer raced us to the delete; treat as already consumed.
+	if rowsAffected == 0 {
+		return false, authRequestContext{}, nil
+	}
+
+	authRequestCtx, err := authzRS.buildAuthRequestContextFromResultRow(results[0])
+	if err != nil {
+		return false, authRequestContext{}, fmt.Errorf("failed to build authorization request context: %w", err)
+	}
+
+	return true, authRequestCtx, nil
+}
+
 // ClearRequest removes a specific authorization request context entry from the store.
 func (authzRS *authorizationRequestStore) ClearRequest(ctx context.Context, key string) error {
 	if key == "" {
@@ -147,6 +188,7 @@ func (authzRS *authorizationRequestStore) getJSONDataBytes(authRequestCtx authRe
 		jsonKeyClientID:            authRequestCtx.OAuthParameters.ClientID,
 		jsonKeyRedirectURI:         authRequestCtx.OAuthParameters.RedirectURI,
 		jsonKeyResponseType:        authRequestCtx.OAuthParameters.ResponseType,
+		jsonKeyResponseMode:        authRequestCtx.OAuthParameters.ResponseMode,
 		jsonKeyStandardScopes:      authRequestCtx.OAuthParameters.StandardScopes,
 		jsonKeyPermissionScopes:    authRequestCtx.OAuthParameters.PermissionScopes,
 		jsonKeyCodeChallenge:       authRequestCtx.OAuthParameters.CodeChallenge,
@@ -161,6 +203,11 @@ func (authzRS *authorizationRequestStore) getJSONDataBytes(authRequestCtx authRe
 		jsonData[jsonKeyClaimsRequest] = authRequestCtx.OAuthParameters.ClaimsRequest
 	}
 
+	// Add authorization_details if present
+	if len(authRequestCtx.OAuthParameters.AuthorizationDetails) > 0 {
+		jsonData[jsonKeyAuthorizationDetails] = authRequestCtx.OAuthParameters.AuthorizationDetails
+	}
+
 	jsonDataBytes, err := json.Marshal(jsonData)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling request context to JSON: %w", err)
@@ -203,6 +250,9 @@ func (authzRS *authorizationRequestStore) buildAuthRequestContextFromResultRow(
 	if responseType, ok := requestDataMap[jsonKeyResponseType].(string); ok {
 		oauthParams.ResponseType = responseType
 	}
+	if responseMode, ok := requestDataMap[jsonKeyResponseMode].(string); ok {
+		oauthParams.ResponseMode = responseMode
+	}
 	// Handle standard_scopes
 	if standardScopes, ok := requestDataMap[jsonKeyStandardScopes].([]interface{}); ok {
 		oauthParams.StandardScopes = convertToStringArray(standardScopes)
@@ -250,6 +300,9 @@ func (authzRS *authorizationRequestStore) buildAuthRequestContextFromResultRow(
 		oauthParams.ClaimsRequest = claimsRequest
 	}
 
+	oauthParams.AuthorizationDetails = oauth2utils.ConvertToAuthorizationDetails(
+		requestDataMap[jsonKeyAuthorizationDetails])
+
 	return authRequestContext{
 		OAuthParameters: oauthParams,
 	}, nil
@@ -476,6 +476,90 @@ func (suite *AuthorizationRequestStoreTestSuite) TestGetRequest_StringScopes() {
 	suite.mockDBClient.AssertExpectations(suite.T())
 }
 
+func (suite *AuthorizationRequestStoreTestSuite) TestConsumeRequest_Success() {
+	requestDataJSON, _ := json.Marshal(map[string]interface{}{
+		"state":         "test-state",
+		"client_id":     "test-client-id",
+		"redirect_uri":  "https://client.example.com/callback",
+		"response_type": "code",
+	})
+
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthRequest,
+		"test-request-id", mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"auth_id":      "test-request-id",
+				"request_data": string(requestDataJSON),
+			},
+		}, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteAuthRequest, "test-request-id", testDeploymentID).
+		Return(int64(1), nil)
+
+	ok, result, err := suite.store.ConsumeRequest(context.Background(), "test-request-id")
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "test-client-id", result.OAuthParameters.ClientID)
+
+	suite.mockdbProvider.AssertExpectations(suite.T())
+	suite.mockDBClient.AssertExpectations(suite.T())
+}
+
+func (suite *AuthorizationRequestStoreTestSuite) TestConsumeRequest_EmptyKey() {
+	ok, _, err := suite.store.ConsumeRequest(context.Background(), "")
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
+func (suite *AuthorizationRequestStoreTestSuite) TestConsumeRequest_NoResults() {
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthRequest,
+		"test-request-id", mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	ok, _, err := suite.store.ConsumeRequest(context.Background(), "test-request-id")
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+	suite.mockDBClient.AssertNotCalled(suite.T(), "ExecuteContext",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizationRequestStoreTestSuite) TestConsumeRequest_LostRace() {
+	requestDataJSON, _ := json.Marshal(map[string]interface{}{"client_id": "test-client-id"})
+
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthRequest,
+		"test-request-id", mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{
+			{"auth_id": "test-request-id", "request_data": string(requestDataJSON)},
+		}, nil)
+	// Another instance deleted the entry between the read and the delete.
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteAuthRequest, "test-request-id", testDeploymentID).
+		Return(int64(0), nil)
+
+	ok, result, err := suite.store.ConsumeRequest(context.Background(), "test-request-id")
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ok)
+	assert.Equal(suite.T(), authRequestContext{}, result)
+}
+
+func (suite *AuthorizationRequestStoreTestSuite) TestConsumeRequest_DeleteError() {
+	requestDataJSON, _ := json.Marshal(map[string]interface{}{"client_id": "test-client-id"})
+
+	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", mock.Anything, queryGetAuthRequest,
+		"test-request-id", mock.Anything, testDeploymentID).
+		Return([]map[string]interface{}{
+			{"auth_id": "test-request-id", "request_data": string(requestDataJSON)},
+		}, nil)
+	suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteAuthRequest, "test-request-id", testDeploymentID).
+		Return(int64(0), errors.New("execute error"))
+
+	ok, _, err := suite.store.ConsumeRequest(context.Background(), "test-request-id")
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), ok)
+}
+
 func (suite *AuthorizationRequestStoreTestSuite) TestClearRequest_Success() {
 	suite.mockdbProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil)
 
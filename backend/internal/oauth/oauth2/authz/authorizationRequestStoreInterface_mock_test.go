@@ -160,6 +160,78 @@ func (_c *authorizationRequestStoreInterfaceMock_ClearRequest_Call) RunAndReturn
 	return _c
 }
 
+// ConsumeRequest provides a mock function for the type authorizationRequestStoreInterfaceMock
+func (_mock *authorizationRequestStoreInterfaceMock) ConsumeRequest(ctx context.Context, key string) (bool, authRequestContext, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeRequest")
+	}
+
+	var r0 bool
+	var r1 authRequestContext
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, authRequestContext, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) authRequestContext); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Get(1).(authRequestContext)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, key)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// authorizationRequestStoreInterfaceMock_ConsumeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeRequest'
+type authorizationRequestStoreInterfaceMock_ConsumeRequest_Call struct {
+	*mock.Call
+}
+
+// ConsumeRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *authorizationRequestStoreInterfaceMock_Expecter) ConsumeRequest(ctx interface{}, key interface{}) *authorizationRequestStoreInterfaceMock_ConsumeRequest_Call {
+	return &authorizationRequestStoreInterfaceMock_ConsumeRequest_Call{Call: _e.mock.On("ConsumeRequest", ctx, key)}
+}
+
+func (_c *authorizationRequestStoreInterfaceMock_ConsumeRequest_Call) Run(run func(ctx context.Context, key string)) *authorizationRequestStoreInterfaceMock_ConsumeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *authorizationRequestStoreInterfaceMock_ConsumeRequest_Call) Return(b bool, authRequestContext authRequestContext, err error) *authorizationRequestStoreInterfaceMock_ConsumeRequest_Call {
+	_c.Call.Return(b, authRequestContext, err)
+	return _c
+}
+
+func (_c *authorizationRequestStoreInterfaceMock_ConsumeRequest_Call) RunAndReturn(run func(ctx context.Context, key string) (bool, authRequestContext, error)) *authorizationRequestStoreInterfaceMock_ConsumeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRequest provides a mock function for the type authorizationRequestStoreInterfaceMock
 func (_mock *authorizationRequestStoreInterfaceMock) GetRequest(ctx context.Context, key string) (bool, authRequestContext, error) {
 	ret := _mock.Called(ctx, key)
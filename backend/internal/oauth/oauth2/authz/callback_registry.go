@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// AuthCallbackHandlerFunc completes an authentication request that another inbound protocol initiated with the
+// flow engine, using the assertion issued by the flow. It returns the URI to which the user agent is redirected.
+type AuthCallbackHandlerFunc func(ctx context.Context, authID, assertion string) (string, error)
+
+var (
+	authCallbackHandlers   = make(map[string]AuthCallbackHandlerFunc)
+	authCallbackHandlersMu sync.RWMutex
+)
+
+// RegisterAuthCallbackHandler registers the handler for the auth callbacks of authentication requests whose auth
+// ID starts with the given prefix. This lets other inbound protocols share the login page and its callback
+// endpoint with OAuth2 authorization requests.
+func RegisterAuthCallbackHandler(prefix string, handler AuthCallbackHandlerFunc) {
+	authCallbackHandlersMu.Lock()
+	defer authCallbackHandlersMu.Unlock()
+	authCallbackHandlers[prefix] = handler
+}
+
+// getAuthCallbackHandler returns the handler registered for the prefix of the auth ID, or nil if the auth ID
+// belongs to an OAuth2 authorization request.
+func getAuthCallbackHandler(authID string) AuthCallbackHandlerFunc {
+	authCallbackHandlersMu.RLock()
+	defer authCallbackHandlersMu.RUnlock()
+
+	for prefix, handler := range authCallbackHandlers {
+		if strings.HasPrefix(authID, prefix) {
+			return handler
+		}
+	}
+	return nil
+}
@@ -19,14 +19,16 @@
 package authz
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
 
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/ssosession"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -35,18 +37,23 @@ import (
 type AuthorizeHandlerInterface interface {
 	HandleAuthorizeGetRequest(w http.ResponseWriter, r *http.Request)
 	HandleAuthCallbackPostRequest(w http.ResponseWriter, r *http.Request)
+	HandleAuthorizationResponseRequest(w http.ResponseWriter, r *http.Request)
 }
 
 // authorizeHandler implements the AuthorizeHandlerInterface for handling OAuth2 authorization requests.
 type authorizeHandler struct {
 	authZService AuthorizeServiceInterface
+	jwtService   jwt.JWTServiceInterface
 	logger       *log.Logger
 }
 
 // newAuthorizeHandler creates a new instance of authorizeHandler with injected dependencies.
-func newAuthorizeHandler(authZService AuthorizeServiceInterface) AuthorizeHandlerInterface {
+func newAuthorizeHandler(
+	authZService AuthorizeServiceInterface, jwtService jwt.JWTServiceInterface,
+) AuthorizeHandlerInterface {
 	return &authorizeHandler{
 		authZService: authZService,
+		jwtService:   jwtService,
 		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeHandler")),
 	}
 }
@@ -62,15 +69,7 @@ func (ah *authorizeHandler) HandleAuthorizeGetRequest(w http.ResponseWriter, r *
 	result, authErr := ah.authZService.HandleInitialAuthorizationRequest(ctx, oAuthMessage)
 	if authErr != nil {
 		if authErr.SendErrorToClient {
-			queryParams := map[string]string{
-				oauth2const.RequestParamError:            authErr.Code,
-				oauth2const.RequestParamErrorDescription: authErr.Message,
-				oauth2const.RequestParamIss:              config.GetServerRuntime().Config.JWT.Issuer,
-			}
-			if authErr.State != "" {
-				queryParams[oauth2const.RequestParamState] = authErr.State
-			}
-			redirectURI, err := oauth2utils.GetURIWithQueryParams(authErr.ClientRedirectURI, queryParams)
+			redirectURI, err := ah.buildErrorResponseURI(ctx, authErr)
 			if err != nil {
 				ah.logger.Error("Failed to construct client redirect URI", log.Error(err))
 				ah.redirectToErrorPage(w, r, oauth2const.ErrorServerError, "Failed to process authorization request")
@@ -83,6 +82,15 @@ func (ah *authorizeHandler) HandleAuthorizeGetRequest(w http.ResponseWriter, r *
 		return
 	}
 
+	// The request was authorized with the SSO session of the user agent.
+	if result.RedirectURI != "" {
+		if result.Session != nil {
+			ssosession.SetSessionCookie(w, result.Session)
+		}
+		http.Redirect(w, r, result.RedirectURI, http.StatusFound)
+		return
+	}
+
 	ah.redirectToLoginPage(w, r, result.QueryParams)
 }
 
@@ -100,16 +108,31 @@ func (ah *authorizeHandler) HandleAuthCallbackPostRequest(w http.ResponseWriter,
 		authID := oAuthMessage.AuthID
 		assertion := oAuthMessage.RequestBodyParams[oauth2const.Assertion]
 
-		redirectURI, authErr := ah.authZService.HandleAuthorizationCallback(ctx, authID, assertion)
+		if callbackHandler := getAuthCallbackHandler(authID); callbackHandler != nil {
+			redirectURI, err := callbackHandler(ctx, authID, assertion)
+			if err != nil {
+				ah.logger.Debug("Failed to complete the authentication request", log.Error(err))
+				ah.writeAuthZResponseToErrorPage(ctx, w, oauth2const.ErrorInvalidRequest,
+					"Invalid authorization request", "")
+				return
+			}
+			ah.writeAuthZResponse(w, redirectURI)
+			return
+		}
+
+		result, authErr := ah.authZService.HandleAuthorizationCallback(ctx, authID, assertion)
 		if authErr != nil {
 			if authErr.SendErrorToClient {
-				ah.writeAuthZResponseToClientRedirect(w, authErr)
+				ah.writeAuthZResponseToClientRedirect(ctx, w, authErr)
 				return
 			}
-			ah.writeAuthZResponseToErrorPage(w, authErr.Code, authErr.Message, authErr.State)
+			ah.writeAuthZResponseToErrorPage(ctx, w, authErr.Code, authErr.Message, authErr.State)
 			return
 		}
-		ah.writeAuthZResponse(w, redirectURI)
+		if result.Session != nil {
+			ssosession.SetSessionCookie(w, result.Session)
+		}
+		ah.writeAuthZResponse(w, result.RedirectURI)
 
 	case oauth2const.TypeConsentResponseFromUser:
 		// TODO: Handle the consent response from the user.
@@ -121,6 +144,22 @@ func (ah *authorizeHandler) HandleAuthCallbackPostRequest(w http.ResponseWriter,
 	}
 }
 
+// HandleAuthorizationResponseRequest posts a JWT secured authorization response to the redirect URI of the
+// client, for authorization requests made with the form_post.jwt response mode.
+func (ah *authorizeHandler) HandleAuthorizationResponseRequest(w http.ResponseWriter, r *http.Request) {
+	response := r.URL.Query().Get(oauth2const.RequestParamResponse)
+	redirectURI := r.URL.Query().Get(oauth2const.RequestParamRedirectURI)
+
+	if authErr := ah.authZService.ValidateFormPostResponse(r.Context(), response, redirectURI); authErr != nil {
+		ah.redirectToErrorPage(w, r, authErr.Code, authErr.Message)
+		return
+	}
+	if err := writeFormPostResponse(w, redirectURI, response); err != nil {
+		ah.logger.Error("Failed to write the form post page", log.Error(err))
+		ah.redirectToErrorPage(w, r, oauth2const.ErrorServerError, "Failed to process authorization request")
+	}
+}
+
 // getOAuthMessage extracts the OAuth message from the request and response writer.
 func (ah *authorizeHandler) getOAuthMessage(r *http.Request, w http.ResponseWriter) *OAuthMessage {
 	logger := ah.logger
@@ -180,6 +219,7 @@ func (ah *authorizeHandler) getOAuthMessageForGetRequest(r *http.Request) (*OAut
 		RequestType:        oauth2const.TypeInitialAuthorizationRequest,
 		RequestQueryParams: queryParams,
 		Resources:          resources,
+		SessionID:          ssosession.GetSessionID(r),
 	}, nil
 }
 
@@ -207,13 +247,8 @@ func (ah *authorizeHandler) getOAuthMessageForPostRequest(r *http.Request) (*OAu
 }
 
 // getLoginPageRedirectURI constructs the login page URL with the provided query parameters.
-func getLoginPageRedirectURI(queryParams map[string]string) (string, error) {
-	gateClientConfig := config.GetServerRuntime().Config.GateClient
-	loginPageURL := (&url.URL{
-		Scheme: gateClientConfig.Scheme,
-		Host:   fmt.Sprintf("%s:%d", gateClientConfig.Hostname, gateClientConfig.Port),
-		Path:   gateClientConfig.LoginPath,
-	}).String()
+func getLoginPageRedirectURI(ctx context.Context, queryParams map[string]string) (string, error) {
+	loginPageURL := config.GetGateClientURL(ctx, config.GetServerRuntime().Config.GateClient.LoginPath)
 
 	return oauth2utils.GetURIWithQueryParams(loginPageURL, queryParams)
 }
@@ -228,7 +263,7 @@ func (ah *authorizeHandler) redirectToLoginPage(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	redirectURI, err := getLoginPageRedirectURI(queryParams)
+	redirectURI, err := getLoginPageRedirectURI(r.Context(), queryParams)
 	if err != nil {
 		logger.Error("Failed to construct login page URL", log.Error(err))
 		ah.redirectToErrorPage(w, r, oauth2const.ErrorServerError, "Failed to process authorization request")
@@ -240,13 +275,8 @@ func (ah *authorizeHandler) redirectToLoginPage(w http.ResponseWriter, r *http.R
 }
 
 // getErrorPageRedirectURL constructs the error page URL with the provided error code and message.
-func getErrorPageRedirectURL(code, msg string) (string, error) {
-	gateClientConfig := config.GetServerRuntime().Config.GateClient
-	errorPageURL := (&url.URL{
-		Scheme: gateClientConfig.Scheme,
-		Host:   fmt.Sprintf("%s:%d", gateClientConfig.Hostname, gateClientConfig.Port),
-		Path:   gateClientConfig.ErrorPath,
-	}).String()
+func getErrorPageRedirectURL(ctx context.Context, code, msg string) (string, error) {
+	errorPageURL := config.GetGateClientURL(ctx, config.GetServerRuntime().Config.GateClient.ErrorPath)
 
 	queryParams := map[string]string{
 		"errorCode":    code,
@@ -265,7 +295,7 @@ func (ah *authorizeHandler) redirectToErrorPage(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	redirectURL, err := getErrorPageRedirectURL(code, msg)
+	redirectURL, err := getErrorPageRedirectURL(r.Context(), code, msg)
 	if err != nil {
 		logger.Error("Failed to construct error page URL", log.Error(err))
 		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
@@ -286,8 +316,9 @@ func (ah *authorizeHandler) writeAuthZResponse(w http.ResponseWriter, redirectUR
 
 // writeAuthZResponseToErrorPage writes the authorization response redirecting to the error page.
 // The state parameter is included in the redirect if non-empty.
-func (ah *authorizeHandler) writeAuthZResponseToErrorPage(w http.ResponseWriter, code, msg, state string) {
-	redirectURI, err := getErrorPageRedirectURL(code, msg)
+func (ah *authorizeHandler) writeAuthZResponseToErrorPage(ctx context.Context, w http.ResponseWriter,
+	code, msg, state string) {
+	redirectURI, err := getErrorPageRedirectURL(ctx, code, msg)
 	if err != nil {
 		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
 		return
@@ -309,23 +340,29 @@ func (ah *authorizeHandler) writeAuthZResponseToErrorPage(w http.ResponseWriter,
 
 // writeAuthZResponseToClientRedirect writes the authorization error response redirecting to the
 // client's registered redirect URI.
-func (ah *authorizeHandler) writeAuthZResponseToClientRedirect(w http.ResponseWriter, authErr *AuthorizationError) {
-	queryParams := map[string]string{
-		oauth2const.RequestParamError:            authErr.Code,
-		oauth2const.RequestParamErrorDescription: authErr.Message,
-		oauth2const.RequestParamIss:              config.GetServerRuntime().Config.JWT.Issuer,
-	}
-	if authErr.State != "" {
-		queryParams[oauth2const.RequestParamState] = authErr.State
-	}
-
-	redirectURI, err := oauth2utils.GetURIWithQueryParams(authErr.ClientRedirectURI, queryParams)
+func (ah *authorizeHandler) writeAuthZResponseToClientRedirect(ctx context.Context, w http.ResponseWriter,
+	authErr *AuthorizationError) {
+	redirectURI, err := ah.buildErrorResponseURI(ctx, authErr)
 	if err != nil {
 		ah.logger.Error("Failed to construct client redirect URI", log.Error(err))
-		ah.writeAuthZResponseToErrorPage(w, oauth2const.ErrorServerError,
+		ah.writeAuthZResponseToErrorPage(ctx, w, oauth2const.ErrorServerError,
 			"Failed to process authorization request", authErr.State)
 		return
 	}
 
 	ah.writeAuthZResponse(w, redirectURI)
 }
+
+// buildErrorResponseURI builds the URI that returns the authorization error to the client's redirect URI, in the
+// response mode of the authorization request.
+func (ah *authorizeHandler) buildErrorResponseURI(ctx context.Context, authErr *AuthorizationError) (string, error) {
+	params := map[string]string{
+		oauth2const.RequestParamError:            authErr.Code,
+		oauth2const.RequestParamErrorDescription: authErr.Message,
+	}
+	if authErr.State != "" {
+		params[oauth2const.RequestParamState] = authErr.State
+	}
+	return buildAuthorizationResponseURI(ctx, ah.jwtService, authErr.ClientRedirectURI, authErr.ClientID,
+		authErr.ResponseMode, params)
+}
@@ -20,7 +20,9 @@ package authz
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -32,7 +34,9 @@ import (
 
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/ssosession"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 )
 
 const (
@@ -43,6 +47,7 @@ type AuthorizeHandlerTestSuite struct {
 	suite.Suite
 	handler          *authorizeHandler
 	mockAuthzService *AuthorizeServiceInterfaceMock
+	mockJWTService   *jwtmock.JWTServiceInterfaceMock
 }
 
 func TestAuthorizeHandlerTestSuite(t *testing.T) {
@@ -84,7 +89,8 @@ func (suite *AuthorizeHandlerTestSuite) BeforeTest(suiteName, testName string) {
 
 func (suite *AuthorizeHandlerTestSuite) SetupTest() {
 	suite.mockAuthzService = NewAuthorizeServiceInterfaceMock(suite.T())
-	suite.handler = newAuthorizeHandler(suite.mockAuthzService).(*authorizeHandler)
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.handler = newAuthorizeHandler(suite.mockAuthzService, suite.mockJWTService).(*authorizeHandler)
 }
 
 func (suite *AuthorizeHandlerTestSuite) TearDownTest() {
@@ -93,7 +99,7 @@ func (suite *AuthorizeHandlerTestSuite) TearDownTest() {
 
 func (suite *AuthorizeHandlerTestSuite) TestnewAuthorizeHandler() {
 	mockSvc := NewAuthorizeServiceInterfaceMock(suite.T())
-	handler := newAuthorizeHandler(mockSvc)
+	handler := newAuthorizeHandler(mockSvc, jwtmock.NewJWTServiceInterfaceMock(suite.T()))
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*AuthorizeHandlerInterface)(nil), handler)
 }
@@ -272,6 +278,49 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_Success()
 	assert.Contains(suite.T(), location, "/login")
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_SSOSessionRedirectsToClient() {
+	redirectURI := "https://example.com/callback?code=test-code"
+	suite.mockAuthzService.EXPECT().
+		HandleInitialAuthorizationRequest(mock.Anything, mock.MatchedBy(func(msg *OAuthMessage) bool {
+			return msg.SessionID == "test-session-id"
+		})).
+		Return(&AuthorizationInitResult{RedirectURI: redirectURI}, nil)
+
+	req := httptest.NewRequest("GET",
+		"/oauth2/authorize?client_id=test-client&redirect_uri=https://example.com/callback&response_type=code", nil)
+	req.AddCookie(&http.Cookie{Name: ssosession.SessionCookieName, Value: "test-session-id"})
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	assert.Equal(suite.T(), redirectURI, rr.Header().Get("Location"))
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_PersistentSSOSessionSetsCookie() {
+	redirectURI := "https://example.com/callback?code=test-code"
+	session := &ssosession.SSOSession{
+		ID:         "test-session-id",
+		Persistent: true,
+		Token:      "new-token",
+		ExpiryTime: time.Now().Add(time.Hour),
+	}
+	suite.mockAuthzService.EXPECT().HandleInitialAuthorizationRequest(mock.Anything, mock.Anything).
+		Return(&AuthorizationInitResult{RedirectURI: redirectURI, Session: session}, nil)
+
+	req := httptest.NewRequest("GET",
+		"/oauth2/authorize?client_id=test-client&redirect_uri=https://example.com/callback&response_type=code", nil)
+	req.AddCookie(&http.Cookie{Name: ssosession.SessionCookieName, Value: "test-session-id.test-token"})
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	cookies := rr.Result().Cookies()
+	assert.Len(suite.T(), cookies, 1)
+	assert.Equal(suite.T(), "test-session-id.new-token", cookies[0].Value)
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_ServiceErrorRedirectToErrorPage() {
 	authErr := &AuthorizationError{
 		Code:              oauth2const.ErrorInvalidRequest,
@@ -337,6 +386,72 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_IssAlwaysP
 	assert.NotContains(suite.T(), location, "state=")
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_ErrorInJWTResponseMode() {
+	authErr := &AuthorizationError{
+		Code:              oauth2const.ErrorInvalidScope,
+		Message:           "Invalid scope",
+		SendErrorToClient: true,
+		ClientRedirectURI: "https://client.example.com/callback",
+		ClientID:          "test-client",
+		ResponseMode:      "jwt",
+		State:             "test-state",
+	}
+	suite.mockAuthzService.EXPECT().HandleInitialAuthorizationRequest(mock.Anything, mock.Anything).Return(nil, authErr)
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, "", "https://localhost:8090", mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["error"] == oauth2const.ErrorInvalidScope && claims["state"] == "test-state" &&
+				claims["aud"] == "test-client"
+		}), mock.Anything, mock.Anything).Return("signed-response", int64(0), nil).Once()
+
+	reqURL := "/oauth2/authorize?client_id=test-client" +
+		"&redirect_uri=https://client.example.com/callback&response_type=code&response_mode=jwt"
+	req := httptest.NewRequest("GET", reqURL, nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizeGetRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	assert.Equal(suite.T(), "https://client.example.com/callback?response=signed-response",
+		rr.Header().Get("Location"))
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizationResponseRequest_Success() {
+	suite.mockAuthzService.EXPECT().ValidateFormPostResponse(mock.Anything, "signed-response",
+		"https://client.example.com/callback").Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/authorize/response?response=signed-response"+
+		"&redirect_uri=https%3A%2F%2Fclient.example.com%2Fcallback", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizationResponseRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	assert.Equal(suite.T(), "text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "no-cache, no-store", rr.Header().Get("Cache-Control"))
+	body := rr.Body.String()
+	assert.Contains(suite.T(), body, `action="https://client.example.com/callback"`)
+	assert.Contains(suite.T(), body, `name="response" value="signed-response"`)
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizationResponseRequest_InvalidResponse() {
+	suite.mockAuthzService.EXPECT().ValidateFormPostResponse(mock.Anything, "tampered",
+		"https://attacker.example.com/cb").Return(&AuthorizationError{
+		Code:    oauth2const.ErrorInvalidRequest,
+		Message: "Invalid authorization response",
+	}).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/authorize/response?response=tampered"+
+		"&redirect_uri=https%3A%2F%2Fattacker.example.com%2Fcb", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthorizationResponseRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusFound, rr.Code)
+	location := rr.Header().Get("Location")
+	assert.Contains(suite.T(), location, "/error")
+	assert.NotContains(suite.T(), location, "attacker.example.com")
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestHandleAuthorizeGetRequest_GetOAuthMessageReturnsNil() {
 	req := httptest.NewRequest("GET", "/oauth2/authorize?client_id=%ZZ", nil)
 	rr := httptest.NewRecorder()
@@ -350,7 +465,7 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Succes
 	redirectURI := "https://client.example.com/callback?code=test-code&state=test-state"
 	suite.mockAuthzService.EXPECT().
 		HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
-		Return(redirectURI, nil)
+		Return(&AuthorizationCallbackResult{RedirectURI: redirectURI}, nil)
 
 	postData := AuthZPostRequest{
 		AuthID:    testAuthID,
@@ -371,6 +486,36 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Succes
 	assert.Equal(suite.T(), redirectURI, resp.RedirectURI)
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_SetsSSOSessionCookie() {
+	redirectURI := "https://client.example.com/callback?code=test-code"
+	session := &ssosession.SSOSession{
+		ID:         "test-session-id",
+		ExpiryTime: time.Now().Add(time.Hour),
+	}
+	suite.mockAuthzService.EXPECT().
+		HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
+		Return(&AuthorizationCallbackResult{RedirectURI: redirectURI, Session: session}, nil)
+
+	postData := AuthZPostRequest{
+		AuthID:    testAuthID,
+		Assertion: "test-assertion",
+	}
+	jsonData, _ := json.Marshal(postData)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/auth/callback", bytes.NewReader(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthCallbackPostRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	cookies := rr.Result().Cookies()
+	assert.Len(suite.T(), cookies, 1)
+	assert.Equal(suite.T(), ssosession.SessionCookieName, cookies[0].Name)
+	assert.Equal(suite.T(), "test-session-id", cookies[0].Value)
+	assert.True(suite.T(), cookies[0].HttpOnly)
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_ServiceError() {
 	authErr := &AuthorizationError{
 		Code:    oauth2const.ErrorInvalidRequest,
@@ -379,7 +524,7 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Servic
 	}
 	suite.mockAuthzService.EXPECT().
 		HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
-		Return("", authErr)
+		Return(nil, authErr)
 
 	postData := AuthZPostRequest{
 		AuthID:    testAuthID,
@@ -410,7 +555,7 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Servic
 		ClientRedirectURI: "https://client.example.com/callback",
 	}
 	suite.mockAuthzService.EXPECT().HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
-		Return("", authErr)
+		Return(nil, authErr)
 
 	postData := AuthZPostRequest{
 		AuthID:    testAuthID,
@@ -443,7 +588,7 @@ func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_Client
 		ClientRedirectURI: "https://client.example.com/callback",
 	}
 	suite.mockAuthzService.EXPECT().HandleAuthorizationCallback(mock.Anything, testAuthID, "test-assertion").
-		Return("", authErr)
+		Return(nil, authErr)
 
 	postData := AuthZPostRequest{
 		AuthID:    testAuthID,
@@ -547,7 +692,7 @@ func (suite *AuthorizeHandlerTestSuite) TestRedirectToErrorPage_NilRequest() {
 
 func (suite *AuthorizeHandlerTestSuite) TestWriteAuthZResponseToErrorPage_WithState() {
 	rr := httptest.NewRecorder()
-	suite.handler.writeAuthZResponseToErrorPage(rr, "error_code", "error message", "test-state")
+	suite.handler.writeAuthZResponseToErrorPage(context.Background(), rr, "error_code", "error message", "test-state")
 
 	assert.Equal(suite.T(), http.StatusOK, rr.Code)
 	var resp AuthZPostResponse
@@ -558,7 +703,7 @@ func (suite *AuthorizeHandlerTestSuite) TestWriteAuthZResponseToErrorPage_WithSt
 
 func (suite *AuthorizeHandlerTestSuite) TestWriteAuthZResponseToErrorPage_NoState() {
 	rr := httptest.NewRecorder()
-	suite.handler.writeAuthZResponseToErrorPage(rr, "error_code", "error message", "")
+	suite.handler.writeAuthZResponseToErrorPage(context.Background(), rr, "error_code", "error message", "")
 
 	assert.Equal(suite.T(), http.StatusOK, rr.Code)
 	var resp AuthZPostResponse
@@ -587,14 +732,14 @@ func (suite *AuthorizeHandlerTestSuite) TestGetLoginPageRedirectURI_Success() {
 		"appId":  "test-app",
 	}
 
-	redirectURI, err := getLoginPageRedirectURI(queryParams)
+	redirectURI, err := getLoginPageRedirectURI(context.Background(), queryParams)
 	assert.NoError(suite.T(), err)
 	assert.Contains(suite.T(), redirectURI, "authId=test-key")
 	assert.Contains(suite.T(), redirectURI, "appId=test-app")
 }
 
 func (suite *AuthorizeHandlerTestSuite) TestGetErrorPageRedirectURL_Success() {
-	redirectURI, err := getErrorPageRedirectURL("invalid_request", "Missing parameter")
+	redirectURI, err := getErrorPageRedirectURL(context.Background(), "invalid_request", "Missing parameter")
 	assert.NoError(suite.T(), err)
 	assert.Contains(suite.T(), redirectURI, "errorCode=invalid_request")
 	assert.Contains(suite.T(), redirectURI, "errorMessage=Missing+parameter")
@@ -830,6 +975,29 @@ func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_WithCo
 	assert.Equal(suite.T(), "urn:thunder:acr:password", clms.completedACR)
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_WithCompletedAuthMethods() {
+	// JWT payload: {"sub":"test-user","completed_auth_methods":"pwd otp"}
+	jwtToken := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJjb21wbGV0ZWRfYXV0aF9tZXRob2RzIjoicHdkIG90cCJ9."
+
+	clms, _, err := decodeAttributesFromAssertion(jwtToken)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "test-user", clms.userID)
+	assert.Equal(suite.T(), "pwd otp", clms.completedAMR)
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_NonStringCompletedAuthMethods() {
+	// JWT payload: {"sub":"test-user","completed_auth_methods":12345}
+	jwtToken := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJjb21wbGV0ZWRfYXV0aF9tZXRob2RzIjoxMjM0NX0."
+
+	_, _, err := decodeAttributesFromAssertion(jwtToken)
+
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "JWT 'completed_auth_methods' claim is not a string")
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_NonStringCompletedAuthClass() {
 	// JWT payload: {"sub":"test-user","completed_auth_class":12345}
 	jwtToken := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
@@ -841,6 +1009,28 @@ func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_NonStr
 	assert.Contains(suite.T(), err.Error(), "JWT 'completed_auth_class' claim is not a string")
 }
 
+func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_WithRememberMe() {
+	// JWT payload: {"sub":"test-user","remember_me":2592000}
+	jwtToken := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJyZW1lbWJlcl9tZSI6MjU5MjAwMH0."
+
+	clms, _, err := decodeAttributesFromAssertion(jwtToken)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2592000), clms.rememberMeLifetime)
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestDecodeAttributesFromAssertion_NonNumericRememberMe() {
+	// JWT payload: {"sub":"test-user","remember_me":"forever"}
+	jwtToken := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJyZW1lbWJlcl9tZSI6ImZvcmV2ZXIifQ."
+
+	_, _, err := decodeAttributesFromAssertion(jwtToken)
+
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "JWT 'remember_me' claim is not a number")
+}
+
 func (suite *AuthorizeHandlerTestSuite) TestValidateSubClaimConstraint() {
 	tests := []struct {
 		name          string
@@ -947,3 +1137,52 @@ func (suite *AuthorizeHandlerTestSuite) TestValidateSubClaimConstraint() {
 		})
 	}
 }
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_DelegatesToRegisteredHandler() {
+	RegisterAuthCallbackHandler("test-prefix-", func(ctx context.Context, authID, assertion string) (string, error) {
+		assert.Equal(suite.T(), "test-prefix-123", authID)
+		assert.Equal(suite.T(), "test-assertion", assertion)
+		return "https://localhost:8090/delegated", nil
+	})
+	defer unregisterAuthCallbackHandler("test-prefix-")
+
+	jsonData, _ := json.Marshal(AuthZPostRequest{AuthID: "test-prefix-123", Assertion: "test-assertion"})
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/auth/callback", bytes.NewReader(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthCallbackPostRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	var resp AuthZPostResponse
+	assert.NoError(suite.T(), json.NewDecoder(rr.Body).Decode(&resp))
+	assert.Equal(suite.T(), "https://localhost:8090/delegated", resp.RedirectURI)
+	suite.mockAuthzService.AssertNotCalled(suite.T(), "HandleAuthorizationCallback",
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeHandlerTestSuite) TestHandleAuthCallbackPostRequest_DelegatedHandlerError() {
+	RegisterAuthCallbackHandler("test-prefix-", func(ctx context.Context, authID, assertion string) (string, error) {
+		return "", errors.New("unknown request")
+	})
+	defer unregisterAuthCallbackHandler("test-prefix-")
+
+	jsonData, _ := json.Marshal(AuthZPostRequest{AuthID: "test-prefix-123", Assertion: "test-assertion"})
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/auth/callback", bytes.NewReader(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAuthCallbackPostRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+	var resp AuthZPostResponse
+	assert.NoError(suite.T(), json.NewDecoder(rr.Body).Decode(&resp))
+	assert.Contains(suite.T(), resp.RedirectURI, "errorCode=invalid_request")
+}
+
+// unregisterAuthCallbackHandler removes the auth callback handler registered for the prefix.
+func unregisterAuthCallbackHandler(prefix string) {
+	authCallbackHandlersMu.Lock()
+	defer authCallbackHandlersMu.Unlock()
+	delete(authCallbackHandlers, prefix)
+}
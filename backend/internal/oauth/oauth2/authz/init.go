@@ -22,10 +22,17 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	authzsvc "github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/ssosession"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
@@ -39,9 +46,16 @@ func Initialize(
 	mux *http.ServeMux,
 	inboundClient inboundclient.InboundClientServiceInterface,
 	resourceService resource.ResourceServiceInterface,
+	scopeValidator scope.ScopeValidatorInterface,
 	jwtService jwt.JWTServiceInterface,
+	tokenValidator tokenservice.TokenValidatorInterface,
 	flowExecService flowexec.FlowExecServiceInterface,
 	parService par.PARServiceInterface,
+	requestObjects requestobject.RequestObjectResolverInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface,
+	attrCacheService attributecache.AttributeCacheServiceInterface,
+	authorizationService authzsvc.AuthorizationServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
 ) (AuthorizeServiceInterface, error) {
 	authzCodeStore, authzReqStore, transactioner, err := initializeAuthorizationStores()
 	if err != nil {
@@ -49,10 +63,11 @@ func Initialize(
 	}
 
 	authzService := newAuthorizeService(
-		inboundClient, resourceService, jwtService, flowExecService,
-		authzCodeStore, authzReqStore, parService, transactioner,
+		inboundClient, resourceService, scopeValidator, jwtService, tokenValidator, flowExecService,
+		authzCodeStore, authzReqStore, parService, requestObjects, ssoSessionService, attrCacheService,
+		authorizationService, entityProvider, transactioner,
 	)
-	authzHandler := newAuthorizeHandler(authzService)
+	authzHandler := newAuthorizeHandler(authzService, jwtService)
 	registerRoutes(mux, authzHandler)
 	return authzService, nil
 }
@@ -82,6 +97,9 @@ func registerRoutes(mux *http.ServeMux, authzHandler AuthorizeHandlerInterface)
 	mux.HandleFunc("GET /oauth2/authorize",
 		withFrameProtection(authzHandler.HandleAuthorizeGetRequest))
 
+	mux.HandleFunc("GET /oauth2/authorize/response",
+		withFrameProtection(authzHandler.HandleAuthorizationResponseRequest))
+
 	callbackOpts := middleware.CORSOptions{
 		AllowedMethods:   []string{"POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
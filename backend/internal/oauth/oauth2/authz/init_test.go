@@ -91,8 +91,8 @@ func (suite *InitTestSuite) TestInitialize() {
 	mux := http.NewServeMux()
 
 	service, err := Initialize(
-		mux, suite.mockInboundClient, suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil,
+		mux, suite.mockInboundClient, suite.mockResourceService, nil,
+		suite.mockJWTService, nil, suite.mockFlowExecService, nil, nil, nil, nil, nil, nil,
 	)
 
 	assert.NoError(suite.T(), err)
@@ -104,8 +104,8 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 	mux := http.NewServeMux()
 
 	_, err := Initialize(
-		mux, suite.mockInboundClient, suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil,
+		mux, suite.mockInboundClient, suite.mockResourceService, nil,
+		suite.mockJWTService, nil, suite.mockFlowExecService, nil, nil, nil, nil, nil, nil,
 	)
 	assert.NoError(suite.T(), err)
 
@@ -125,8 +125,8 @@ func (suite *InitTestSuite) TestRegisterRoutes_CORSConfiguration() {
 	mux := http.NewServeMux()
 
 	_, err := Initialize(
-		mux, suite.mockInboundClient, suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil,
+		mux, suite.mockInboundClient, suite.mockResourceService, nil,
+		suite.mockJWTService, nil, suite.mockFlowExecService, nil, nil, nil, nil, nil, nil,
 	)
 	assert.NoError(suite.T(), err)
 
@@ -175,8 +175,8 @@ func (suite *InitTestSuite) TestRegisterRoutes_CORSHeaders() {
 	mux := http.NewServeMux()
 
 	_, err := Initialize(
-		mux, suite.mockInboundClient, suite.mockResourceService,
-		suite.mockJWTService, suite.mockFlowExecService, nil,
+		mux, suite.mockInboundClient, suite.mockResourceService, nil,
+		suite.mockJWTService, nil, suite.mockFlowExecService, nil, nil, nil, nil, nil, nil,
 	)
 	assert.NoError(suite.T(), err)
 
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/ssosession"
 )
 
 // OAuthMessage represents the OAuth message.
@@ -31,27 +32,31 @@ type OAuthMessage struct {
 	RequestQueryParams map[string]string
 	Resources          []string
 	RequestBodyParams  map[string]string
+	SessionID          string
 }
 
 // AuthorizationCode represents the authorization code.
 type AuthorizationCode struct {
-	CodeID              string
-	Code                string
-	ClientID            string
-	RedirectURI         string
-	AuthorizedUserID    string
-	AttributeCacheID    string
-	TimeCreated         time.Time
-	ExpiryTime          time.Time
-	Scopes              string
-	State               string
-	CodeChallenge       string
-	CodeChallengeMethod string
-	Resources           []string
-	ClaimsRequest       *oauth2model.ClaimsRequest
-	ClaimsLocales       string
-	Nonce               string
-	CompletedACR        string
+	CodeID               string
+	Code                 string
+	ClientID             string
+	RedirectURI          string
+	AuthorizedUserID     string
+	AttributeCacheID     string
+	TimeCreated          time.Time
+	ExpiryTime           time.Time
+	Scopes               string
+	State                string
+	CodeChallenge        string
+	CodeChallengeMethod  string
+	Resources            []string
+	ClaimsRequest        *oauth2model.ClaimsRequest
+	ClaimsLocales        string
+	Nonce                string
+	CompletedACR         string
+	CompletedAMR         string
+	SessionID            string
+	AuthorizationDetails []oauth2model.AuthorizationDetail
 }
 
 // AuthZPostRequest represents the request body for the authorization POST request.
@@ -66,8 +71,18 @@ type AuthZPostResponse struct {
 }
 
 // AuthorizationInitResult holds the result of a successful initial authorization request processing.
+// RedirectURI is set instead of QueryParams when the request is authorized with an existing SSO session,
+// in which case the user agent is redirected straight back to the client.
 type AuthorizationInitResult struct {
 	QueryParams map[string]string
+	RedirectURI string
+	Session     *ssosession.SSOSession // the persistent SSO session whose token was rotated, if any
+}
+
+// AuthorizationCallbackResult holds the result of a successful authorization callback processing.
+type AuthorizationCallbackResult struct {
+	RedirectURI string
+	Session     *ssosession.SSOSession // the SSO session created for the user, if SSO sessions are enabled
 }
 
 // AuthorizationError holds structured error info for authorization failures.
@@ -77,6 +92,8 @@ type AuthorizationError struct {
 	SendErrorToClient bool   // if true, redirect error to client's redirect_uri rather than the error page
 	ClientRedirectURI string // populated when SendErrorToClient is true
 	State             string // from the original request
+	ClientID          string // the client the error is returned to, when SendErrorToClient is true
+	ResponseMode      string // the response mode the error is returned with, when SendErrorToClient is true
 }
 
 // assertionClaims represents the claims extracted from the flow assertion JWT.
@@ -85,4 +102,6 @@ type assertionClaims struct {
 	authorizedPermissions string
 	attributeCacheID      string
 	completedACR          string
+	completedAMR          string
+	rememberMeLifetime    int64
 }
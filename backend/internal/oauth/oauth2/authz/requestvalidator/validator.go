@@ -21,12 +21,15 @@
 package requestvalidator
 
 import (
+	"errors"
 	"slices"
+	"strconv"
 	"strings"
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pkce"
+	"github.com/thunder-id/thunderid/internal/system/config"
 )
 
 // ValidateAuthorizationRequestParams validates the common authorization request parameters
@@ -53,6 +56,11 @@ func ValidateAuthorizationRequestParams(
 		}
 	}
 
+	// Validate the max_age parameter if present.
+	if _, err := ParseMaxAge(params[constants.RequestParamMaxAge]); err != nil {
+		return constants.ErrorInvalidRequest, "The max_age parameter must be a non-negative integer"
+	}
+
 	// Validate grant type is allowed.
 	if !oauthApp.IsAllowedGrantType(constants.GrantTypeAuthorizationCode) {
 		return constants.ErrorUnauthorizedClient,
@@ -63,9 +71,18 @@ func ValidateAuthorizationRequestParams(
 	if responseType == "" {
 		return constants.ErrorInvalidRequest, "Missing response_type parameter"
 	}
-	if !oauthApp.IsAllowedResponseType(responseType) {
+	if !constants.ResponseType(responseType).IsValid() {
 		return constants.ErrorUnsupportedResponseType, "Unsupported response type"
 	}
+	if !oauthApp.IsAllowedResponseType(responseType) {
+		return constants.ErrorUnauthorizedClient, "The client is not authorized to use this response type"
+	}
+
+	// Validate the response mode if present.
+	if responseMode, ok := params[constants.RequestParamResponseMode]; ok &&
+		!constants.ResponseMode(responseMode).IsValid() {
+		return constants.ErrorInvalidRequest, "Unsupported response_mode"
+	}
 
 	// Validate PKCE parameters.
 	if responseType == string(constants.ResponseTypeCode) {
@@ -115,9 +132,11 @@ func ValidatePromptParameter(prompt string) (string, string) {
 				"prompt value 'none' must not be combined with other values"
 		}
 
-		// The server does not support server-side sessions as of now.
-		return constants.ErrorLoginRequired,
-			"User authentication is required"
+		// Without SSO sessions the user can never be authenticated without interaction.
+		if !config.GetServerRuntime().Config.SSOSession.Enabled {
+			return constants.ErrorLoginRequired,
+				"User authentication is required"
+		}
 	}
 
 	// The server does not support consent or account selection prompts as of now.
@@ -134,6 +153,21 @@ func ValidatePromptParameter(prompt string) (string, string) {
 	return "", ""
 }
 
+// ParseMaxAge parses the OIDC max_age parameter. A nil value is returned when the parameter is absent.
+func ParseMaxAge(maxAge string) (*int64, error) {
+	if maxAge == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseInt(maxAge, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if value < 0 {
+		return nil, errors.New("max_age must not be negative")
+	}
+	return &value, nil
+}
+
 // ResolveACRValues returns the effective acr_values: requested ACRs filtered against the
 // app's list, falling back to the app's full list when nothing matches or none were requested.
 func ResolveACRValues(requestedAcrValues string, appAcrValues []string) string {
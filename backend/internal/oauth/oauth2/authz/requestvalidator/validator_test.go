@@ -27,6 +27,7 @@ import (
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
 )
 
 type AuthzValidationTestSuite struct {
@@ -38,6 +39,15 @@ func TestAuthzValidationTestSuite(t *testing.T) {
 	suite.Run(t, new(AuthzValidationTestSuite))
 }
 
+func (suite *AuthzValidationTestSuite) SetupSuite() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("", &config.Config{})
+}
+
+func (suite *AuthzValidationTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
 func (suite *AuthzValidationTestSuite) SetupTest() {
 	suite.oauthApp = &inboundmodel.OAuthClient{
 		ClientID:                "test-client-id",
@@ -83,6 +93,39 @@ func (suite *AuthzValidationTestSuite) TestValidateParams_UnsupportedResponseTyp
 	assert.Equal(suite.T(), constants.ErrorUnsupportedResponseType, errCode)
 }
 
+func (suite *AuthzValidationTestSuite) TestValidateParams_ResponseTypeNotAllowed() {
+	app := *suite.oauthApp
+	app.ResponseTypes = []constants.ResponseType{}
+	params := map[string]string{
+		constants.RequestParamResponseType: string(constants.ResponseTypeCode),
+	}
+
+	errCode, _ := ValidateAuthorizationRequestParams(params, &app)
+
+	assert.Equal(suite.T(), constants.ErrorUnauthorizedClient, errCode)
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_ResponseModes() {
+	for _, responseMode := range []string{"query", "jwt", "query.jwt", "fragment.jwt", "form_post.jwt"} {
+		params := suite.validParams()
+		params[constants.RequestParamResponseMode] = responseMode
+
+		errCode, _ := ValidateAuthorizationRequestParams(params, suite.oauthApp)
+
+		assert.Empty(suite.T(), errCode, responseMode)
+	}
+}
+
+func (suite *AuthzValidationTestSuite) TestValidateParams_UnsupportedResponseMode() {
+	params := suite.validParams()
+	params[constants.RequestParamResponseMode] = "fragment"
+
+	errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.oauthApp)
+
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errCode)
+	assert.Equal(suite.T(), "Unsupported response_mode", errMsg)
+}
+
 func (suite *AuthzValidationTestSuite) TestValidateParams_GrantTypeNotAllowed() {
 	app := &inboundmodel.OAuthClient{
 		ClientID:                "test-client-id",
@@ -172,6 +215,45 @@ func (suite *AuthzValidationTestSuite) TestValidateParams_ValidNonce() {
 	assert.Empty(suite.T(), errMsg)
 }
 
+func (suite *AuthzValidationTestSuite) TestValidateParams_MaxAge() {
+	testCases := []struct {
+		name        string
+		maxAge      string
+		expectError bool
+	}{
+		{"Zero", "0", false},
+		{"Positive", "3600", false},
+		{"Negative", "-1", true},
+		{"NotANumber", "abc", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.validParams()
+			params[constants.RequestParamMaxAge] = tc.maxAge
+
+			errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.oauthApp)
+
+			if tc.expectError {
+				assert.Equal(suite.T(), constants.ErrorInvalidRequest, errCode)
+				assert.Equal(suite.T(), "The max_age parameter must be a non-negative integer", errMsg)
+			} else {
+				assert.Empty(suite.T(), errCode)
+			}
+		})
+	}
+}
+
+func (suite *AuthzValidationTestSuite) TestParseMaxAge() {
+	maxAge, err := ParseMaxAge("")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), maxAge)
+
+	maxAge, err = ParseMaxAge("300")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(300), *maxAge)
+}
+
 func (suite *AuthzValidationTestSuite) TestValidateParams_PromptLogin_Success() {
 	params := suite.validParams()
 	params[constants.RequestParamPrompt] = "login"
@@ -191,6 +273,19 @@ func (suite *AuthzValidationTestSuite) TestValidateParams_PromptNone_LoginRequir
 	assert.Equal(suite.T(), constants.ErrorLoginRequired, errCode)
 }
 
+func (suite *AuthzValidationTestSuite) TestValidateParams_PromptNone_SSOSessionsEnabled() {
+	config.GetServerRuntime().Config.SSOSession.Enabled = true
+	defer func() { config.GetServerRuntime().Config.SSOSession.Enabled = false }()
+
+	params := suite.validParams()
+	params[constants.RequestParamPrompt] = "none"
+
+	errCode, errMsg := ValidateAuthorizationRequestParams(params, suite.oauthApp)
+
+	assert.Empty(suite.T(), errCode)
+	assert.Empty(suite.T(), errMsg)
+}
+
 func (suite *AuthzValidationTestSuite) TestValidateParams_PromptInvalid() {
 	params := suite.validParams()
 	params[constants.RequestParamPrompt] = "invalid_value"
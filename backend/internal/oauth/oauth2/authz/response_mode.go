@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+)
+
+// jwtResponseValidity is the validity period in seconds of a JWT secured authorization response.
+const jwtResponseValidity = 600
+
+// formPostTemplate renders the page that posts a JWT secured authorization response to the client.
+var formPostTemplate = template.Must(template.New("form-post").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Redirecting</title></head>
+<body onload="document.forms[0].submit()">
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="response" value="{{.Response}}">
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+</body>
+</html>
+`))
+
+// formPost holds the values rendered into the form post page.
+type formPost struct {
+	Action   string
+	Response string
+}
+
+// buildAuthorizationResponseURI builds the URI that returns the authorization response parameters to the client
+// in the requested response mode, along with the issuer (RFC 9207). With a JWT response mode (JARM), the
+// parameters are returned as the claims of a JWT signed by the server. A form_post.jwt response is delivered
+// through the authorization response endpoint of the server, which posts it to the client.
+func buildAuthorizationResponseURI(ctx context.Context, jwtService jwt.JWTServiceInterface,
+	redirectURI, clientID, responseMode string, params map[string]string) (string, error) {
+	mode := oauth2const.ResponseMode(responseMode)
+	if !mode.IsJWT() {
+		params[oauth2const.RequestParamIss] = config.GetIssuer(ctx)
+		return oauth2utils.GetURIWithQueryParams(redirectURI, params)
+	}
+
+	claims := make(map[string]interface{}, len(params)+1)
+	for name, value := range params {
+		claims[name] = value
+	}
+	claims[oauth2const.ClaimAud] = clientID
+	response, _, svcErr := jwtService.GenerateJWT(ctx, "", config.GetIssuer(ctx), jwtResponseValidity, claims,
+		jwt.TokenTypeJWT, "")
+	if svcErr != nil {
+		return "", fmt.Errorf("failed to sign the authorization response: %s", svcErr.Code)
+	}
+
+	switch mode {
+	case oauth2const.ResponseModeFragmentJWT:
+		return redirectURI + "#" + url.Values{oauth2const.RequestParamResponse: {response}}.Encode(), nil
+	case oauth2const.ResponseModeFormPostJWT:
+		return oauth2utils.GetURIWithQueryParams(
+			config.GetPublicURL(ctx)+oauth2const.OAuth2AuthorizationResponseEndpoint, map[string]string{
+				oauth2const.RequestParamRedirectURI: redirectURI,
+				oauth2const.RequestParamResponse:    response,
+			})
+	default:
+		return oauth2utils.GetURIWithQueryParams(redirectURI, map[string]string{
+			oauth2const.RequestParamResponse: response,
+		})
+	}
+}
+
+// writeFormPostResponse writes the page that posts a JWT secured authorization response to the redirect URI.
+func writeFormPostResponse(w http.ResponseWriter, redirectURI, response string) error {
+	var buf bytes.Buffer
+	if err := formPostTemplate.Execute(&buf, formPost{
+		Action:   redirectURI,
+		Response: response,
+	}); err != nil {
+		return fmt.Errorf("failed to render the form post page: %w", err)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache, no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
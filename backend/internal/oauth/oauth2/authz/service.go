@@ -28,6 +28,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	authzsvc "github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
 	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
@@ -36,11 +39,15 @@ import (
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/ssosession"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
@@ -53,45 +60,69 @@ type AuthorizeServiceInterface interface {
 	HandleInitialAuthorizationRequest(
 		ctx context.Context, msg *OAuthMessage,
 	) (*AuthorizationInitResult, *AuthorizationError)
-	HandleAuthorizationCallback(ctx context.Context, authID string, assertion string) (string, *AuthorizationError)
+	HandleAuthorizationCallback(
+		ctx context.Context, authID string, assertion string,
+	) (*AuthorizationCallbackResult, *AuthorizationError)
+	ValidateFormPostResponse(ctx context.Context, response, redirectURI string) *AuthorizationError
 }
 
 // authorizeService implements the AuthorizeService for managing OAuth2 authorization flows.
 type authorizeService struct {
-	inboundClient   inboundclient.InboundClientServiceInterface
-	resourceService resource.ResourceServiceInterface
-	authZValidator  AuthorizationValidatorInterface
-	authCodeStore   AuthorizationCodeStoreInterface
-	authReqStore    authorizationRequestStoreInterface
-	parService      par.PARServiceInterface
-	jwtService      jwt.JWTServiceInterface
-	flowExecService flowexec.FlowExecServiceInterface
-	transactioner   transaction.Transactioner
-	logger          *log.Logger
+	inboundClient     inboundclient.InboundClientServiceInterface
+	resourceService   resource.ResourceServiceInterface
+	scopeValidator    scope.ScopeValidatorInterface
+	authZValidator    AuthorizationValidatorInterface
+	authCodeStore     AuthorizationCodeStoreInterface
+	authReqStore      authorizationRequestStoreInterface
+	parService        par.PARServiceInterface
+	requestObjects    requestobject.RequestObjectResolverInterface
+	jwtService        jwt.JWTServiceInterface
+	tokenValidator    tokenservice.TokenValidatorInterface
+	flowExecService   flowexec.FlowExecServiceInterface
+	ssoSessionService ssosession.SSOSessionServiceInterface
+	attrCacheService  attributecache.AttributeCacheServiceInterface
+	authzService      authzsvc.AuthorizationServiceInterface
+	entityProvider    entityprovider.EntityProviderInterface
+	transactioner     transaction.Transactioner
+	logger            *log.Logger
 }
 
 // newAuthorizeService creates a new instance of authorizeService with injected dependencies.
 func newAuthorizeService(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	resourceService resource.ResourceServiceInterface,
+	scopeValidator scope.ScopeValidatorInterface,
 	jwtService jwt.JWTServiceInterface,
+	tokenValidator tokenservice.TokenValidatorInterface,
 	flowExecService flowexec.FlowExecServiceInterface,
 	authCodeStore AuthorizationCodeStoreInterface,
 	authReqStore authorizationRequestStoreInterface,
 	parService par.PARServiceInterface,
+	requestObjects requestobject.RequestObjectResolverInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface,
+	attrCacheService attributecache.AttributeCacheServiceInterface,
+	authzService authzsvc.AuthorizationServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
 	transactioner transaction.Transactioner,
 ) AuthorizeServiceInterface {
 	return &authorizeService{
-		inboundClient:   inboundClient,
-		resourceService: resourceService,
-		authZValidator:  newAuthorizationValidator(),
-		authCodeStore:   authCodeStore,
-		authReqStore:    authReqStore,
-		parService:      parService,
-		jwtService:      jwtService,
-		flowExecService: flowExecService,
-		transactioner:   transactioner,
-		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeService")),
+		inboundClient:     inboundClient,
+		resourceService:   resourceService,
+		scopeValidator:    scopeValidator,
+		authZValidator:    newAuthorizationValidator(),
+		authCodeStore:     authCodeStore,
+		authReqStore:      authReqStore,
+		parService:        parService,
+		requestObjects:    requestObjects,
+		jwtService:        jwtService,
+		tokenValidator:    tokenValidator,
+		flowExecService:   flowExecService,
+		ssoSessionService: ssoSessionService,
+		attrCacheService:  attrCacheService,
+		authzService:      authzService,
+		entityProvider:    entityProvider,
+		transactioner:     transactioner,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeService")),
 	}
 }
 
@@ -135,6 +166,7 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 	*AuthorizationInitResult, *AuthorizationError) {
 	clientID := msg.RequestQueryParams[oauth2const.RequestParamClientID]
 	requestURI := msg.RequestQueryParams[oauth2const.RequestParamRequestURI]
+	requestObject := msg.RequestQueryParams[oauth2const.RequestParamRequest]
 
 	if clientID == "" {
 		return nil, &AuthorizationError{
@@ -159,9 +191,16 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
-	// If request_uri is present, resolve the pushed authorization request.
-	if requestURI != "" {
-		return as.handlePARAuthorizationRequest(ctx, requestURI, clientID, app)
+	if requestObject != "" && requestURI != "" {
+		return nil, &AuthorizationError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "The request and request_uri parameters must not both be present",
+		}
+	}
+
+	// If request_uri is present and does not reference a request object, resolve the pushed authorization request.
+	if requestURI != "" && !isRequestObjectURI(requestURI) {
+		return as.handlePARAuthorizationRequest(ctx, requestURI, clientID, app, msg.SessionID)
 	}
 
 	// Enforce PAR requirement: if PAR is required (per-client or global), reject requests without request_uri.
@@ -172,12 +211,47 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
-	return as.handleStandardAuthorizationRequest(ctx, msg, app)
+	// If a request object is present, its parameters replace those of the request (RFC 9101 Section 6.3).
+	if requestObject != "" || requestURI != "" {
+		resolvedMsg, authErr := as.resolveRequestObject(ctx, msg, requestObject, requestURI, app)
+		if authErr != nil {
+			return nil, authErr
+		}
+		msg = resolvedMsg
+	}
+
+	result, authErr := as.handleStandardAuthorizationRequest(ctx, msg, app)
+	return result, withResponseMode(authErr, app.ClientID, msg.RequestQueryParams[oauth2const.RequestParamResponseMode])
+}
+
+// resolveRequestObject verifies the request object of an authorization request and returns a message
+// carrying the authorization request parameters of the request object.
+func (as *authorizeService) resolveRequestObject(
+	ctx context.Context, msg *OAuthMessage, requestObject, requestURI string, app *inboundmodel.OAuthClient,
+) (*OAuthMessage, *AuthorizationError) {
+	authRequest, err := as.requestObjects.Resolve(ctx, requestObject, requestURI, app)
+	if err != nil {
+		if errors.Is(err, requestobject.ErrInvalidRequestURI) {
+			return nil, &AuthorizationError{
+				Code:    oauth2const.ErrorInvalidRequestURI,
+				Message: "The request object could not be retrieved from the request_uri",
+			}
+		}
+		return nil, &AuthorizationError{
+			Code:    oauth2const.ErrorInvalidRequestObject,
+			Message: "The request object is invalid",
+		}
+	}
+
+	resolvedMsg := *msg
+	resolvedMsg.RequestQueryParams = authRequest.Params
+	resolvedMsg.Resources = authRequest.Resources
+	return &resolvedMsg, nil
 }
 
 // handlePARAuthorizationRequest resolves a request_uri from a PAR and continues the authorization flow.
 func (as *authorizeService) handlePARAuthorizationRequest(
-	ctx context.Context, requestURI string, clientID string, app *inboundmodel.OAuthClient,
+	ctx context.Context, requestURI string, clientID string, app *inboundmodel.OAuthClient, sessionID string,
 ) (*AuthorizationInitResult, *AuthorizationError) {
 	oauthParams, err := as.parService.ResolvePushedAuthorizationRequest(ctx, requestURI, clientID)
 	if err != nil {
@@ -194,7 +268,8 @@ func (as *authorizeService) handlePARAuthorizationRequest(
 		}
 	}
 
-	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app)
+	result, authErr := as.initiateFlowAndStoreRequest(ctx, oauthParams, app, sessionID)
+	return result, withResponseMode(authErr, clientID, oauthParams.ResponseMode)
 }
 
 // handleStandardAuthorizationRequest processes a standard authorization request (without PAR).
@@ -206,6 +281,7 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 	scope := msg.RequestQueryParams[oauth2const.RequestParamScope]
 	state := msg.RequestQueryParams[oauth2const.RequestParamState]
 	responseType := msg.RequestQueryParams[oauth2const.RequestParamResponseType]
+	responseMode := msg.RequestQueryParams[oauth2const.RequestParamResponseMode]
 
 	// Extract PKCE parameters.
 	codeChallenge := msg.RequestQueryParams[oauth2const.RequestParamCodeChallenge]
@@ -221,6 +297,8 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 
 	nonce := msg.RequestQueryParams[oauth2const.RequestParamNonce]
 	acrValues := msg.RequestQueryParams[oauth2const.RequestParamAcrValues]
+	prompt := msg.RequestQueryParams[oauth2const.RequestParamPrompt]
+	organization := msg.RequestQueryParams[oauth2const.RequestParamOrganization]
 
 	// Parse the claims parameter if present.
 	var claimsRequest *oauth2model.ClaimsRequest
@@ -268,22 +346,43 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		}
 	}
 
+	// Parse the authorization_details parameter (RFC 9396) if present.
+	authorizationDetails, err := oauth2utils.ParseAuthorizationDetails(
+		msg.RequestQueryParams[oauth2const.RequestParamAuthorizationDetails],
+		config.GetServerRuntime().Config.OAuth.AuthorizationDetails.Types)
+	if err != nil {
+		as.logger.Debug("Failed to parse authorization_details parameter", log.Error(err))
+		return nil, &AuthorizationError{
+			Code:              oauth2const.ErrorInvalidAuthorizationDetails,
+			Message:           "The authorization_details parameter is malformed or contains unsupported types",
+			SendErrorToClient: true,
+			ClientRedirectURI: redirectURI,
+			State:             state,
+		}
+	}
+
 	// Construct authorization request context.
 	oauthParams := &oauth2model.OAuthParameters{
-		State:               state,
-		ClientID:            app.ClientID,
-		RedirectURI:         redirectURI,
-		ResponseType:        responseType,
-		StandardScopes:      oidcScopes,
-		PermissionScopes:    nonOidcScopes,
-		CodeChallenge:       codeChallenge,
-		CodeChallengeMethod: codeChallengeMethod,
-		Resources:           resources,
-		ClaimsRequest:       claimsRequest,
-		ClaimsLocales:       claimsLocales,
-		Nonce:               nonce,
-		AcrValues:           acrValues,
+		State:                state,
+		ClientID:             app.ClientID,
+		RedirectURI:          redirectURI,
+		ResponseType:         responseType,
+		ResponseMode:         responseMode,
+		StandardScopes:       oidcScopes,
+		PermissionScopes:     nonOidcScopes,
+		CodeChallenge:        codeChallenge,
+		CodeChallengeMethod:  codeChallengeMethod,
+		Resources:            resources,
+		ClaimsRequest:        claimsRequest,
+		ClaimsLocales:        claimsLocales,
+		Nonce:                nonce,
+		AcrValues:            acrValues,
+		Prompt:               prompt,
+		Organization:         organization,
+		AuthorizationDetails: authorizationDetails,
 	}
+	// The max_age parameter is already validated along with the other authorization parameters.
+	oauthParams.MaxAge, _ = requestvalidator.ParseMaxAge(msg.RequestQueryParams[oauth2const.RequestParamMaxAge])
 
 	// Set the redirect URI if not provided in the request. Invalid cases are already handled at this point.
 	// TODO: This should be removed when supporting other means of authorization.
@@ -299,21 +398,85 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		oauthParams.RedirectURI = app.RedirectURIs[0]
 	}
 
-	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app)
+	if idTokenHint := msg.RequestQueryParams[oauth2const.RequestParamIDTokenHint]; idTokenHint != "" {
+		hint, err := as.tokenValidator.ValidateIDTokenHint(ctx, idTokenHint, app.ClientID)
+		if err != nil {
+			as.logger.Debug("Invalid ID token hint", log.Error(err))
+			return nil, &AuthorizationError{
+				Code:              oauth2const.ErrorInvalidRequest,
+				Message:           "Invalid id_token_hint",
+				SendErrorToClient: true,
+				ClientRedirectURI: oauthParams.RedirectURI,
+				State:             state,
+			}
+		}
+		oauthParams.IDTokenHintSubject = hint.Sub
+	}
+
+	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app, msg.SessionID)
+}
+
+// filterPermissionScopes drops the requested permission scopes that are neither configured on the application,
+// defined through the scope management API nor registered on a resource server, so that arbitrary scope values
+// are not carried into the flow and the consent screen.
+func (as *authorizeService) filterPermissionScopes(
+	ctx context.Context, oauthParams *oauth2model.OAuthParameters, app *inboundmodel.OAuthClient,
+) *AuthorizationError {
+	if as.scopeValidator == nil || len(oauthParams.PermissionScopes) == 0 {
+		return nil
+	}
+
+	validScopes, scopeErr := as.scopeValidator.ValidateScopes(
+		ctx, strings.Join(oauthParams.PermissionScopes, " "), app)
+	if scopeErr != nil {
+		return &AuthorizationError{
+			Code:              scopeErr.Error,
+			Message:           scopeErr.ErrorDescription,
+			SendErrorToClient: true,
+			ClientRedirectURI: oauthParams.RedirectURI,
+			State:             oauthParams.State,
+		}
+	}
+	oauthParams.PermissionScopes = strings.Fields(validScopes)
+
+	return nil
 }
 
 // initiateFlowAndStoreRequest initiates the authentication flow and stores the authorization request context.
-// This is the common path shared by both standard and PAR-based authorization requests.
+// This is the common path shared by both standard and PAR-based authorization requests. When the user agent
+// holds an active SSO session that satisfies the request, the request is authorized without a flow.
 func (as *authorizeService) initiateFlowAndStoreRequest(
 	ctx context.Context, oauthParams *oauth2model.OAuthParameters, app *inboundmodel.OAuthClient,
+	sessionID string,
 ) (*AuthorizationInitResult, *AuthorizationError) {
 	effectiveAcrValues := requestvalidator.ResolveACRValues(oauthParams.AcrValues, app.AcrValues)
+
+	if authErr := as.filterPermissionScopes(ctx, oauthParams, app); authErr != nil {
+		return nil, authErr
+	}
+
+	if as.ssoSessionService.IsEnabled() {
+		if result, authErr, handled := as.authorizeWithSSOSession(
+			ctx, oauthParams, app, sessionID, effectiveAcrValues); handled {
+			return result, authErr
+		}
+		if slices.Contains(strings.Fields(oauthParams.Prompt), oauth2const.PromptNone) {
+			return nil, &AuthorizationError{
+				Code:              oauth2const.ErrorLoginRequired,
+				Message:           "User authentication is required",
+				SendErrorToClient: true,
+				ClientRedirectURI: oauthParams.RedirectURI,
+				State:             oauthParams.State,
+			}
+		}
+	}
 	essentialAttributes, optionalAttributes := getRequiredAttributes(
 		oauthParams.StandardScopes, oauthParams.ClaimsRequest, oauthParams.ResponseType, app)
 
 	// Initiate flow with OAuth context.
 	runtimeData := map[string]string{
 		flowcm.RuntimeKeyClientID:                      oauthParams.ClientID,
+		flowcm.RuntimeKeyRequestedScopes:               utils.StringifyStringArray(oauthParams.StandardScopes, " "),
 		flowcm.RuntimeKeyRequestedPermissions:          utils.StringifyStringArray(oauthParams.PermissionScopes, " "),
 		flowcm.RuntimeKeyRequiredEssentialAttributes:   essentialAttributes,
 		flowcm.RuntimeKeyRequiredOptionalAttributes:    optionalAttributes,
@@ -323,9 +486,13 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	if effectiveAcrValues != "" {
 		runtimeData[flowcm.RuntimeKeyRequestedAuthClasses] = effectiveAcrValues
 	}
+	if oauthParams.Organization != "" {
+		runtimeData[flowcm.RuntimeKeyRequestedOrganization] = oauthParams.Organization
+	}
 	flowInitCtx := &flowexec.FlowInitContext{
 		ApplicationID: app.ID,
 		FlowType:      string(flowcm.FlowTypeAuthentication),
+		FlowID:        resolveAcrFlowID(effectiveAcrValues, app),
 		RuntimeData:   runtimeData,
 	}
 
@@ -385,12 +552,199 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	return &AuthorizationInitResult{QueryParams: queryParams}, nil
 }
 
+// resolveAcrFlowID returns the flow mapped to the first effective ACR value that has a flow configured on the
+// application. An empty ID is returned when none is mapped, in which case the default authentication flow runs.
+func resolveAcrFlowID(effectiveAcrValues string, app *inboundmodel.OAuthClient) string {
+	for _, acr := range strings.Fields(effectiveAcrValues) {
+		if flowID := app.AcrFlows[acr]; flowID != "" {
+			return flowID
+		}
+	}
+	return ""
+}
+
+// authorizeWithSSOSession authorizes the request using the SSO session bound to the user agent, without
+// re-authenticating the user. The returned flag is false when the session cannot satisfy the request, in
+// which case the caller falls back to the authentication flow.
+func (as *authorizeService) authorizeWithSSOSession(
+	ctx context.Context, oauthParams *oauth2model.OAuthParameters, app *inboundmodel.OAuthClient,
+	sessionID string, effectiveAcrValues string,
+) (*AuthorizationInitResult, *AuthorizationError, bool) {
+	// The session is not bound to an organization, so a login to a requested organization is always
+	// performed through a flow.
+	if sessionID == "" || oauthParams.Organization != "" ||
+		slices.Contains(strings.Fields(oauthParams.Prompt), oauth2const.PromptLogin) {
+		return nil, nil, false
+	}
+
+	session, svcErr := as.ssoSessionService.GetSession(ctx, sessionID)
+	if svcErr != nil {
+		as.logger.Debug("SSO session is not usable", log.String("error_code", svcErr.Code))
+		return nil, nil, false
+	}
+
+	if oauthParams.IDTokenHintSubject != "" && oauthParams.IDTokenHintSubject != session.UserID {
+		as.logger.Debug("SSO session does not belong to the subject of the ID token hint")
+		return nil, nil, false
+	}
+
+	if oauthParams.MaxAge != nil &&
+		time.Since(session.AuthTime) > time.Duration(*oauthParams.MaxAge)*time.Second {
+		as.logger.Debug("SSO session authentication is older than the requested max_age")
+		return nil, nil, false
+	}
+
+	if effectiveAcrValues != "" &&
+		!slices.Contains(strings.Fields(effectiveAcrValues), session.CompletedACR) {
+		as.logger.Debug("SSO session does not satisfy the requested authentication class")
+		return nil, nil, false
+	}
+
+	if !as.ensureSessionAttributeCache(ctx, session.AttributeCacheID, resolveUserAttributesCacheTTL(app)) {
+		return nil, nil, false
+	}
+
+	serverError := &AuthorizationError{
+		Code:              oauth2const.ErrorServerError,
+		Message:           "Failed to process authorization request",
+		SendErrorToClient: true,
+		ClientRedirectURI: oauthParams.RedirectURI,
+		State:             oauthParams.State,
+	}
+
+	if slices.Contains(oauthParams.StandardScopes, oauth2const.ScopeOpenID) {
+		if err := validateSubClaimConstraint(oauthParams.ClaimsRequest, session.UserID); err != nil {
+			as.logger.Debug("SSO session does not satisfy the sub claim constraint", log.Error(err))
+			return nil, nil, false
+		}
+	}
+
+	authRequestCtx := &authRequestContext{OAuthParameters: *oauthParams}
+	authorizedPermissions, err := as.resolveAuthorizedPermissions(ctx, session.UserID, oauthParams.PermissionScopes)
+	if err != nil {
+		as.logger.Error("Failed to resolve authorized permissions for SSO session", log.Error(err))
+		return nil, serverError, true
+	}
+	authRequestCtx.OAuthParameters.PermissionScopes = authorizedPermissions
+
+	claims := assertionClaims{
+		userID:           session.UserID,
+		attributeCacheID: session.AttributeCacheID,
+		completedACR:     session.CompletedACR,
+		completedAMR:     session.CompletedAMR,
+	}
+	authzCode, err := createAuthorizationCode(authRequestCtx, &claims, session.AuthTime)
+	if err != nil {
+		as.logger.Error("Failed to create authorization code for SSO session", log.Error(err))
+		return nil, serverError, true
+	}
+	authzCode.SessionID = session.ID
+	as.addSessionClient(ctx, session.ID, authzCode.ClientID)
+
+	if err := as.authCodeStore.InsertAuthorizationCode(ctx, authzCode); err != nil {
+		as.logger.Error("Failed to persist authorization code for SSO session", log.Error(err))
+		return nil, serverError, true
+	}
+
+	redirectURI, err := as.buildAuthorizationCodeRedirectURI(ctx, authzCode, oauthParams)
+	if err != nil {
+		as.logger.Error("Failed to build redirect URI for SSO session", log.Error(err))
+		return nil, serverError, true
+	}
+
+	result := &AuthorizationInitResult{RedirectURI: redirectURI}
+
+	// The token of a persistent session is rotated on each use, so the user agent must be given the new one.
+	if session.Persistent {
+		rotated, svcErr := as.ssoSessionService.RotateSessionToken(ctx, session)
+		if svcErr != nil {
+			as.logger.Debug("Failed to rotate the token of the SSO session", log.String("error_code", svcErr.Code))
+			return nil, nil, false
+		}
+		result.Session = rotated
+	}
+
+	as.logger.Debug("Authorized request with SSO session", log.String("client_id", oauthParams.ClientID))
+	return result, nil, true
+}
+
+// addSessionClient records the client in the SSO session, so that the client can be notified when the session
+// is terminated. A failure is logged without failing the authorization.
+func (as *authorizeService) addSessionClient(ctx context.Context, sessionID, clientID string) {
+	if svcErr := as.ssoSessionService.AddSessionClient(ctx, sessionID, clientID); svcErr != nil {
+		as.logger.Warn("Failed to record the client in the SSO session", log.String("error_code", svcErr.Code))
+	}
+}
+
+// ensureSessionAttributeCache reports whether the attribute cache of an SSO session is still available, and
+// extends its TTL when it would expire before the tokens issued for the current request.
+func (as *authorizeService) ensureSessionAttributeCache(ctx context.Context, cacheID string, ttl int64) bool {
+	if cacheID == "" {
+		return true
+	}
+
+	cache, svcErr := as.attrCacheService.GetAttributeCache(ctx, cacheID)
+	if svcErr != nil {
+		as.logger.Debug("Attribute cache of the SSO session is not available",
+			log.String("error_code", svcErr.Code))
+		return false
+	}
+	if int64(cache.TTLSeconds) >= ttl {
+		return true
+	}
+
+	if svcErr := as.attrCacheService.ExtendAttributeCacheTTL(ctx, cacheID, int(ttl)); svcErr != nil {
+		as.logger.Debug("Failed to extend the attribute cache of the SSO session",
+			log.String("error_code", svcErr.Code))
+		return false
+	}
+	return true
+}
+
+// resolveAuthorizedPermissions returns the subset of the requested permissions granted to the user, either
+// directly or through group memberships.
+func (as *authorizeService) resolveAuthorizedPermissions(
+	ctx context.Context, userID string, requestedPermissions []string,
+) ([]string, error) {
+	if len(requestedPermissions) == 0 {
+		return []string{}, nil
+	}
+
+	var groupIDs []string
+	groups, groupErr := as.entityProvider.GetTransitiveEntityGroups(userID)
+	if groupErr != nil {
+		// Ignore unimplemented providers to preserve existing behavior.
+		if groupErr.Code != entityprovider.ErrorCodeNotImplemented {
+			return nil, fmt.Errorf("failed to resolve user group memberships: %s", groupErr.Error())
+		}
+	} else {
+		for _, group := range groups {
+			if group.ID != "" && !slices.Contains(groupIDs, group.ID) {
+				groupIDs = append(groupIDs, group.ID)
+			}
+		}
+	}
+
+	authzResp, svcErr := as.authzService.GetAuthorizedPermissions(ctx, authzsvc.GetAuthorizedPermissionsRequest{
+		EntityID:             userID,
+		GroupIDs:             groupIDs,
+		RequestedPermissions: requestedPermissions,
+	})
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to get authorized permissions: %s", svcErr.Error.DefaultValue)
+	}
+	return authzResp.AuthorizedPermissions, nil
+}
+
 // HandleAuthorizationCallback processes the callback assertion from the flow engine.
-// Returns the client redirect URI (with authorization code) on success, or a structured error.
+// Returns the client redirect URI (with authorization code) on success, along with the SSO session
+// created for the user when SSO sessions are enabled, or a structured error.
 func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, authID string, assertion string) (
-	string, *AuthorizationError) {
+	*AuthorizationCallbackResult, *AuthorizationError) {
 	var redirectURI string
+	var session *ssosession.SSOSession
 	var authErr *AuthorizationError
+	var oauthParams oauth2model.OAuthParameters
 
 	err := func() error {
 		// Load the authorization request context.
@@ -410,6 +764,7 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 			}
 			return err
 		}
+		oauthParams = authRequestCtx.OAuthParameters
 
 		if assertion == "" {
 			authErr = &AuthorizationError{
@@ -501,6 +856,41 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 			return err
 		}
 
+		// Start an SSO session so that subsequent authorization requests from the user agent are not
+		// re-authenticated. A user who chose to stay signed in gets a persistent session.
+		if as.ssoSessionService.IsEnabled() {
+			newSession := &ssosession.SSOSession{
+				UserID:           claims.userID,
+				AuthTime:         authzCode.TimeCreated,
+				CompletedACR:     claims.completedACR,
+				CompletedAMR:     claims.completedAMR,
+				AttributeCacheID: claims.attributeCacheID,
+			}
+			var svcErr *serviceerror.ServiceError
+			if claims.rememberMeLifetime > 0 {
+				// Keep the user attributes for as long as the session may be used.
+				if !as.ensureSessionAttributeCache(ctx, claims.attributeCacheID, claims.rememberMeLifetime) {
+					as.logger.Warn("Failed to retain the user attributes for the persistent SSO session")
+				}
+				session, svcErr = as.ssoSessionService.CreatePersistentSession(ctx, newSession,
+					time.Duration(claims.rememberMeLifetime)*time.Second)
+			} else {
+				session, svcErr = as.ssoSessionService.CreateSession(ctx, newSession)
+			}
+			if svcErr != nil {
+				authErr = &AuthorizationError{
+					Code:              oauth2const.ErrorServerError,
+					Message:           "Failed to process authorization request",
+					SendErrorToClient: true,
+					ClientRedirectURI: authRequestCtx.OAuthParameters.RedirectURI,
+					State:             authRequestCtx.OAuthParameters.State,
+				}
+				return fmt.Errorf("failed to create SSO session: %s", svcErr.Code)
+			}
+			authzCode.SessionID = session.ID
+			as.addSessionClient(ctx, session.ID, authzCode.ClientID)
+		}
+
 		// Persist the authorization code.
 		if persistErr := as.authCodeStore.InsertAuthorizationCode(ctx, authzCode); persistErr != nil {
 			authErr = &AuthorizationError{
@@ -514,14 +904,7 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 		}
 
 		// Construct the redirect URI with the authorization code.
-		queryParams := map[string]string{
-			"code":                      authzCode.Code,
-			oauth2const.RequestParamIss: config.GetServerRuntime().Config.JWT.Issuer,
-		}
-		if authRequestCtx.OAuthParameters.State != "" {
-			queryParams[oauth2const.RequestParamState] = authRequestCtx.OAuthParameters.State
-		}
-		redirectURI, err = oauth2utils.GetURIWithQueryParams(authzCode.RedirectURI, queryParams)
+		redirectURI, err = as.buildAuthorizationCodeRedirectURI(ctx, authzCode, &authRequestCtx.OAuthParameters)
 		if err != nil {
 			authErr = &AuthorizationError{
 				Code:              oauth2const.ErrorServerError,
@@ -540,22 +923,102 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 		if authErr.Code == oauth2const.ErrorServerError {
 			as.logger.Error("Failed to process authorization callback", log.Error(err))
 		}
-		return "", authErr
+		return nil, withResponseMode(authErr, oauthParams.ClientID, oauthParams.ResponseMode)
 	}
 	if err != nil {
 		as.logger.Error("Failed to process authorization callback", log.Error(err))
-		return "", &AuthorizationError{
+		return nil, &AuthorizationError{
 			Code:    oauth2const.ErrorServerError,
 			Message: "Failed to process authorization request",
 		}
 	}
 
-	return redirectURI, nil
+	return &AuthorizationCallbackResult{RedirectURI: redirectURI, Session: session}, nil
 }
 
-// loadAuthRequestContext loads the authorization request context from the store using the auth ID.
+// buildAuthorizationCodeRedirectURI constructs the client redirect URI carrying the authorization code, in the
+// response mode of the authorization request.
+func (as *authorizeService) buildAuthorizationCodeRedirectURI(ctx context.Context, authzCode AuthorizationCode,
+	oauthParams *oauth2model.OAuthParameters) (string, error) {
+	params := map[string]string{
+		oauth2const.RequestParamCode: authzCode.Code,
+	}
+	if oauthParams.State != "" {
+		params[oauth2const.RequestParamState] = oauthParams.State
+	}
+	return buildAuthorizationResponseURI(ctx, as.jwtService, authzCode.RedirectURI, authzCode.ClientID,
+		oauthParams.ResponseMode, params)
+}
+
+// ValidateFormPostResponse validates a JWT secured authorization response before it is posted to the redirect
+// URI with the form_post.jwt response mode. The response must have been issued by the server, and the redirect
+// URI must be registered for the client the response was issued to.
+func (as *authorizeService) ValidateFormPostResponse(
+	ctx context.Context, response, redirectURI string,
+) *AuthorizationError {
+	invalidResponseErr := &AuthorizationError{
+		Code:    oauth2const.ErrorInvalidRequest,
+		Message: "Invalid authorization response",
+	}
+	if response == "" || redirectURI == "" {
+		return invalidResponseErr
+	}
+
+	if svcErr := as.jwtService.VerifyJWTSignature(response); svcErr != nil {
+		as.logger.Debug("Failed to verify the authorization response", log.String("error_code", svcErr.Code))
+		return invalidResponseErr
+	}
+	claims, err := jwt.DecodeJWTPayload(response)
+	if err != nil {
+		return invalidResponseErr
+	}
+	if iss, _ := claims[oauth2const.ClaimIss].(string); iss != config.GetIssuer(ctx) {
+		return invalidResponseErr
+	}
+	if exp, _ := claims[oauth2const.ClaimExp].(float64); time.Now().Unix() >= int64(exp) {
+		return invalidResponseErr
+	}
+	// Only authorization responses are delivered, not other tokens signed by the server.
+	_, hasCode := claims[oauth2const.RequestParamCode]
+	_, hasError := claims[oauth2const.RequestParamError]
+	if !hasCode && !hasError {
+		return invalidResponseErr
+	}
+
+	clientID, _ := claims[oauth2const.ClaimAud].(string)
+	if clientID == "" {
+		return invalidResponseErr
+	}
+	app, err := as.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+	if err != nil {
+		as.logger.Error("Failed to retrieve OAuth client", log.Error(err))
+		return &AuthorizationError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process authorization request",
+		}
+	}
+	if app == nil || app.ValidateRedirectURI(redirectURI) != nil {
+		return invalidResponseErr
+	}
+
+	return nil
+}
+
+// withResponseMode records the client and the response mode an authorization error is returned with, when the
+// error is returned to the client.
+func withResponseMode(authErr *AuthorizationError, clientID, responseMode string) *AuthorizationError {
+	if authErr != nil && authErr.SendErrorToClient {
+		authErr.ClientID = clientID
+		authErr.ResponseMode = responseMode
+	}
+	return authErr
+}
+
+// loadAuthRequestContext loads and consumes the authorization request context from the store using the
+// auth ID. The context is claimed atomically so that a replayed callback is rejected even when it races
+// the original on another server instance.
 func (as *authorizeService) loadAuthRequestContext(ctx context.Context, authID string) (*authRequestContext, error) {
-	ok, authRequestCtx, err := as.authReqStore.GetRequest(ctx, authID)
+	ok, authRequestCtx, err := as.authReqStore.ConsumeRequest(ctx, authID)
 	if err != nil {
 		as.logger.Error("Failed to retrieve authorization request context", log.Error(err))
 		return nil, errors.New("failed to retrieve authorization request context")
@@ -564,11 +1027,6 @@ func (as *authorizeService) loadAuthRequestContext(ctx context.Context, authID s
 		as.logger.Debug("Authorization request context not found", log.String("auth_id", authID))
 		return nil, errAuthRequestNotFound
 	}
-
-	// Remove the authorization request context after retrieval.
-	if clearErr := as.authReqStore.ClearRequest(ctx, authID); clearErr != nil {
-		as.logger.Error("Failed to clear authorization request context", log.Error(clearErr))
-	}
 	return &authRequestCtx, nil
 }
 
@@ -641,6 +1099,27 @@ func decodeAttributesFromAssertion(assertion string) (assertionClaims, time.Time
 			claims.completedACR = strValue
 			continue
 		}
+
+		if key == oauth2const.ClaimCompletedAuthMethods {
+			strValue, ok := value.(string)
+			if !ok {
+				return claims, time.Time{}, errors.New("JWT 'completed_auth_methods' claim is not a string")
+			}
+			claims.completedAMR = strValue
+			continue
+		}
+
+		if key == oauth2const.ClaimRememberMe {
+			switch v := value.(type) {
+			case float64:
+				claims.rememberMeLifetime = int64(v)
+			case int64:
+				claims.rememberMeLifetime = v
+			default:
+				return claims, time.Time{}, errors.New("JWT 'remember_me' claim is not a number")
+			}
+			continue
+		}
 	}
 
 	return claims, authTime, nil
@@ -665,6 +1144,8 @@ func createAuthorizationCode(
 	}
 
 	// Use provided authTime, or fallback to current time if zero (iat claim was not available).
+	// The code expiry is always counted from now, as the authentication may predate the request when it
+	// is authorized with an SSO session.
 	if authTime.IsZero() {
 		authTime = time.Now()
 	}
@@ -676,7 +1157,7 @@ func createAuthorizationCode(
 
 	oauthConfig := config.GetServerRuntime().Config.OAuth
 	validityPeriod := oauthConfig.AuthorizationCode.ValidityPeriod
-	expiryTime := authTime.Add(time.Duration(validityPeriod) * time.Second)
+	expiryTime := time.Now().Add(time.Duration(validityPeriod) * time.Second)
 
 	codeID, err := utils.GenerateUUIDv7()
 	if err != nil {
@@ -689,26 +1170,36 @@ func createAuthorizationCode(
 	}
 
 	return AuthorizationCode{
-		CodeID:              codeID,
-		Code:                code,
-		ClientID:            clientID,
-		RedirectURI:         redirectURI,
-		AuthorizedUserID:    claims.userID,
-		AttributeCacheID:    claims.attributeCacheID,
-		TimeCreated:         authTime,
-		ExpiryTime:          expiryTime,
-		Scopes:              utils.StringifyStringArray(allScopes, " "),
-		State:               AuthCodeStateActive,
-		CodeChallenge:       authRequestCtx.OAuthParameters.CodeChallenge,
-		CodeChallengeMethod: authRequestCtx.OAuthParameters.CodeChallengeMethod,
-		Resources:           resources,
-		ClaimsRequest:       authRequestCtx.OAuthParameters.ClaimsRequest,
-		ClaimsLocales:       authRequestCtx.OAuthParameters.ClaimsLocales,
-		Nonce:               authRequestCtx.OAuthParameters.Nonce,
-		CompletedACR:        claims.completedACR,
+		CodeID:               codeID,
+		Code:                 code,
+		ClientID:             clientID,
+		RedirectURI:          redirectURI,
+		AuthorizedUserID:     claims.userID,
+		AttributeCacheID:     claims.attributeCacheID,
+		TimeCreated:          authTime,
+		ExpiryTime:           expiryTime,
+		Scopes:               utils.StringifyStringArray(allScopes, " "),
+		State:                AuthCodeStateActive,
+		CodeChallenge:        authRequestCtx.OAuthParameters.CodeChallenge,
+		CodeChallengeMethod:  authRequestCtx.OAuthParameters.CodeChallengeMethod,
+		Resources:            resources,
+		ClaimsRequest:        authRequestCtx.OAuthParameters.ClaimsRequest,
+		ClaimsLocales:        authRequestCtx.OAuthParameters.ClaimsLocales,
+		Nonce:                authRequestCtx.OAuthParameters.Nonce,
+		CompletedACR:         claims.completedACR,
+		CompletedAMR:         claims.completedAMR,
+		AuthorizationDetails: authRequestCtx.OAuthParameters.AuthorizationDetails,
 	}, nil
 }
 
+// GetRequiredUserAttributes determines the essential and optional user attributes the login flow must collect
+// to issue tokens for the given OIDC scopes and claims request. It lets other grants that authenticate the
+// user through the flow engine request the same attributes as authorization code requests.
+func GetRequiredUserAttributes(oidcScopes []string, claimsRequest *oauth2model.ClaimsRequest,
+	app *inboundmodel.OAuthClient) (essentialAttributes, optionalAttributes string) {
+	return getRequiredAttributes(oidcScopes, claimsRequest, string(oauth2const.ResponseTypeCode), app)
+}
+
 // getRequiredAttributes determines the essential and optional user attributes required based on OIDC scopes,
 // claims parameter, response type, and app configuration.
 func getRequiredAttributes(oidcScopes []string, claimsRequest *oauth2model.ClaimsRequest, responseType string,
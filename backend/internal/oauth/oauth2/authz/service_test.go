@@ -20,26 +20,43 @@ package authz
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	authzsvc "github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
 	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/ssosession"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/authzmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/requestobjectmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/scopemock"
+	"github.com/thunder-id/thunderid/tests/mocks/ssosessionmock"
 )
 
 // stubTransactioner is a no-op Transactioner for use in service tests.
@@ -55,6 +72,12 @@ const (
 	svcJWTWithIat = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0.eyJzdWIiOiJ0ZXN0LXVzZXIiLCJpYXQiOjE3MDE0MjEyMDB9."
 	// Header: {"alg":"none","typ":"JWT"}   Payload: {"sub":"test-user"}
 	svcJWTMinimal = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0.eyJzdWIiOiJ0ZXN0LXVzZXIifQ."
+	// Header: {"alg":"none","typ":"JWT"}
+	// Payload: {"sub":"test-user","iat":1701421200,"aci":"test-cache-id","remember_me":2592000}
+	svcJWTWithRememberMe = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." +
+		"eyJzdWIiOiJ0ZXN0LXVzZXIiLCJpYXQiOjE3MDE0MjEyMDAsImFjaSI6InRlc3QtY2FjaGUtaWQiLCJyZW1lbWJlcl9tZSI6MjU5MjAwMH0."
+	// testIDTokenHint is an opaque id_token_hint, verified through the mocked token validator.
+	testIDTokenHint = "test.id-token.hint"
 )
 
 type AuthorizeServiceTestSuite struct {
@@ -65,6 +88,12 @@ type AuthorizeServiceTestSuite struct {
 	mockAuthReqStore    *authorizationRequestStoreInterfaceMock
 	mockFlowExecService *flowexecmock.FlowExecServiceInterfaceMock
 	mockValidator       *AuthorizationValidatorInterfaceMock
+	mockSSOSession      *ssosessionmock.SSOSessionServiceInterfaceMock
+	mockAttrCache       *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockAuthzSvc        *authzmock.AuthorizationServiceInterfaceMock
+	mockEntityProvider  *entityprovidermock.EntityProviderInterfaceMock
+	mockRequestObjects  *requestobjectmock.RequestObjectResolverInterfaceMock
+	mockTokenValidator  *tokenservicemock.TokenValidatorInterfaceMock
 }
 
 func TestAuthorizeServiceTestSuite(t *testing.T) {
@@ -102,19 +131,38 @@ func (suite *AuthorizeServiceTestSuite) SetupTest() {
 	suite.mockAuthReqStore = newAuthorizationRequestStoreInterfaceMock(suite.T())
 	suite.mockFlowExecService = flowexecmock.NewFlowExecServiceInterfaceMock(suite.T())
 	suite.mockValidator = NewAuthorizationValidatorInterfaceMock(suite.T())
+	suite.mockSSOSession = ssosessionmock.NewSSOSessionServiceInterfaceMock(suite.T())
+	suite.mockAttrCache = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
+	suite.mockAuthzSvc = authzmock.NewAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockRequestObjects = requestobjectmock.NewRequestObjectResolverInterfaceMock(suite.T())
+	suite.mockTokenValidator = tokenservicemock.NewTokenValidatorInterfaceMock(suite.T())
 }
 
-// newService builds an authorizeService with all mocked dependencies.
+// newService builds an authorizeService with all mocked dependencies and SSO sessions disabled.
 func (suite *AuthorizeServiceTestSuite) newService() *authorizeService {
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(false).Maybe()
+	return suite.newServiceWithSSOSessions()
+}
+
+// newServiceWithSSOSessions builds an authorizeService with all mocked dependencies, leaving the
+// expectations on the SSO session service to the test.
+func (suite *AuthorizeServiceTestSuite) newServiceWithSSOSessions() *authorizeService {
 	return &authorizeService{
-		inboundClient:   suite.mockInboundClient,
-		authZValidator:  suite.mockValidator,
-		authCodeStore:   suite.mockAuthzCodeStore,
-		authReqStore:    suite.mockAuthReqStore,
-		jwtService:      suite.mockJWTService,
-		flowExecService: suite.mockFlowExecService,
-		transactioner:   &stubTransactioner{},
-		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeServiceTest")),
+		inboundClient:     suite.mockInboundClient,
+		authZValidator:    suite.mockValidator,
+		authCodeStore:     suite.mockAuthzCodeStore,
+		authReqStore:      suite.mockAuthReqStore,
+		requestObjects:    suite.mockRequestObjects,
+		jwtService:        suite.mockJWTService,
+		tokenValidator:    suite.mockTokenValidator,
+		flowExecService:   suite.mockFlowExecService,
+		ssoSessionService: suite.mockSSOSession,
+		attrCacheService:  suite.mockAttrCache,
+		authzService:      suite.mockAuthzSvc,
+		entityProvider:    suite.mockEntityProvider,
+		transactioner:     &stubTransactioner{},
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeServiceTest")),
 	}
 }
 
@@ -181,6 +229,139 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_In
 	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestAndRequestURI() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":   "test-client-id",
+			"request":     "request-object",
+			"request_uri": "https://client.example.com/request.jwt",
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	suite.mockRequestObjects.AssertNotCalled(suite.T(), "Resolve", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObject() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockRequestObjects.EXPECT().Resolve(mock.Anything, "request-object", "", app).
+		Return(&requestobject.AuthorizationRequest{
+			Params: map[string]string{
+				"client_id":     "test-client-id",
+				"redirect_uri":  "https://client.example.com/callback",
+				"response_type": "code",
+				"scope":         "openid",
+				"state":         "request-object-state",
+				"resource":      "https://api.example.com",
+			},
+			Resources: []string{"https://api.example.com"},
+		}, nil)
+
+	// The parameters of the request object replace those of the request.
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.MatchedBy(func(msg *OAuthMessage) bool {
+		return msg.RequestQueryParams["state"] == "request-object-state" &&
+			msg.RequestQueryParams["scope"] == "openid" &&
+			msg.RequestQueryParams["request"] == "" &&
+			len(msg.Resources) == 1 && msg.SessionID == "session-id"
+	}), app).Return(false, oauth2const.ErrorInvalidRequest, "Rejected")
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id": "test-client-id",
+			"request":   "request-object",
+			"scope":     "openid profile",
+			"state":     "query-state",
+		},
+		SessionID: "session-id",
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), "request-object-state", authErr.State)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InvalidRequestObject() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockRequestObjects.EXPECT().Resolve(mock.Anything, "request-object", "", app).
+		Return(nil, requestobject.ErrInvalidRequestObject)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id": "test-client-id",
+			"request":   "request-object",
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, authErr.Code)
+	assert.False(suite.T(), authErr.SendErrorToClient)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InvalidRequestObjectURI() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockRequestObjects.EXPECT().Resolve(mock.Anything, "", "https://client.example.com/request.jwt", app).
+		Return(nil, requestobject.ErrInvalidRequestURI)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":   "test-client-id",
+			"request_uri": "https://client.example.com/request.jwt",
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestURI, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectWhenPARRequired() {
+	app := suite.testApp()
+	app.RequirePushedAuthorizationRequests = true
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id": "test-client-id",
+			"request":   "request-object",
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	assert.Contains(suite.T(), authErr.Message, "Pushed authorization request is required")
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InvalidClaimsParameter() {
 	app := suite.testApp()
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
@@ -356,6 +537,66 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Wi
 	assert.NotNil(suite.T(), result)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_WithAuthorizationDetails() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, authRequestCtx authRequestContext) {
+			details := authRequestCtx.OAuthParameters.AuthorizationDetails
+			assert.Len(suite.T(), details, 1)
+			assert.Equal(suite.T(), "payment_initiation", details[0].GetType())
+		}).
+		Return(testAuthID, nil)
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":             "test-client-id",
+			"redirect_uri":          "https://client.example.com/callback",
+			"response_type":         "code",
+			"scope":                 "read",
+			"authorization_details": `[{"type":"payment_initiation","actions":["initiate"]}]`,
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InvalidAuthorizationDetails() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+
+	msg := &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":             "test-client-id",
+			"redirect_uri":          "https://client.example.com/callback",
+			"response_type":         "code",
+			"state":                 "test-state",
+			"authorization_details": `[{"actions":["initiate"]}]`,
+		},
+	}
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidAuthorizationDetails, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "https://client.example.com/callback", authErr.ClientRedirectURI)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SetsRuntimeRequiredAttrs() {
 	app := suite.testApp()
 	app.Token = &inboundmodel.OAuthTokenConfig{
@@ -407,24 +648,25 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Se
 }
 
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_InvalidAuthID() {
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, "invalid-key").Return(false, authRequestContext{}, nil)
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, "invalid-key").
+		Return(false, authRequestContext{}, nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), "invalid-key", "test-assertion")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), "invalid-key", "test-assertion")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
 }
 
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_StoreError() {
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, "db-fail-key").
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, "db-fail-key").
 		Return(false, authRequestContext{}, errors.New("db connection error"))
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), "db-fail-key", "test-assertion")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), "db-fail-key", "test-assertion")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 }
@@ -437,13 +679,12 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_MissingA
 			State:       "test-state",
 		},
 	}
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
-	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
 	assert.Equal(suite.T(), "test-state", authErr.State)
@@ -459,14 +700,13 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_InvalidA
 			State:       "test-state",
 		},
 	}
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
-	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockJWTService.EXPECT().VerifyJWT("invalid-assertion", "", "").Return(&jwt.ErrorInvalidTokenSignature)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "invalid-assertion")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "invalid-assertion")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
 	assert.Equal(suite.T(), "test-state", authErr.State)
@@ -482,15 +722,14 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_FailedTo
 			State:       "test-state",
 		},
 	}
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
-	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	// VerifyJWT succeeds but "not.valid.jwt" cannot be decoded as a valid JWT payload.
 	suite.mockJWTService.EXPECT().VerifyJWT("not.valid.jwt", "", "").Return(nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "not.valid.jwt")
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, "not.valid.jwt")
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 	assert.Equal(suite.T(), "Failed to process authorization request", authErr.Message)
@@ -507,17 +746,16 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_PersistA
 			State:       "test-state",
 		},
 	}
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
-	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().
 		InsertAuthorizationCode(mock.Anything, mock.Anything).
 		Return(errors.New("db error"))
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 	assert.Equal(suite.T(), "test-state", authErr.State)
@@ -532,19 +770,18 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_Success(
 			RedirectURI: "https://client.example.com/callback",
 		},
 	}
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
-	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
 
 	assert.Nil(suite.T(), authErr)
-	assert.Contains(suite.T(), redirectURI, "https://client.example.com/callback")
-	assert.Contains(suite.T(), redirectURI, "code=")
-	assert.Contains(suite.T(), redirectURI, "iss=https%3A%2F%2Flocalhost%3A8090")
-	assert.NotContains(suite.T(), redirectURI, "state=")
+	assert.Contains(suite.T(), result.RedirectURI, "https://client.example.com/callback")
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+	assert.Contains(suite.T(), result.RedirectURI, "iss=https%3A%2F%2Flocalhost%3A8090")
+	assert.NotContains(suite.T(), result.RedirectURI, "state=")
 }
 
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_WithState() {
@@ -555,18 +792,17 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_WithStat
 			State:       "test-state-123",
 		},
 	}
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
-	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
 
 	assert.Nil(suite.T(), authErr)
-	assert.Contains(suite.T(), redirectURI, "code=")
-	assert.Contains(suite.T(), redirectURI, "state=test-state-123")
-	assert.Contains(suite.T(), redirectURI, "iss=https%3A%2F%2Flocalhost%3A8090")
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+	assert.Contains(suite.T(), result.RedirectURI, "state=test-state-123")
+	assert.Contains(suite.T(), result.RedirectURI, "iss=https%3A%2F%2Flocalhost%3A8090")
 }
 
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_EmptyAuthorizedPermissions() {
@@ -579,16 +815,15 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_EmptyAut
 			PermissionScopes: []string{"read", "write"},
 		},
 	}
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
-	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
 	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
 
 	assert.Nil(suite.T(), authErr)
-	assert.NotEmpty(suite.T(), redirectURI)
+	assert.NotEmpty(suite.T(), result.RedirectURI)
 }
 
 func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_CreateAuthCodeError() {
@@ -599,14 +834,13 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_CreateAu
 			RedirectURI: "https://client.example.com/callback",
 		},
 	}
-	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
-	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
 	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTMinimal, "", "").Return(nil)
 
 	svc := suite.newService()
-	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTMinimal)
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTMinimal)
 
-	assert.Empty(suite.T(), redirectURI)
+	assert.Nil(suite.T(), result)
 	assert.NotNil(suite.T(), authErr)
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 }
@@ -1902,3 +2136,744 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Ac
 	assert.Nil(suite.T(), authErr)
 	assert.NotNil(suite.T(), result)
 }
+
+// testSSOSession returns an active SSO session for use in tests.
+func (suite *AuthorizeServiceTestSuite) testSSOSession() *ssosession.SSOSession {
+	return &ssosession.SSOSession{
+		ID:               "test-session-id",
+		UserID:           "test-user",
+		AuthTime:         time.Now().Add(-10 * time.Minute),
+		AttributeCacheID: "test-cache-id",
+		ExpiryTime:       time.Now().Add(time.Hour),
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SSOSessionReused() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().GetSession(mock.Anything, "test-session-id").Return(suite.testSSOSession(), nil)
+	suite.mockAttrCache.EXPECT().GetAttributeCache(mock.Anything, "test-cache-id").
+		Return(&attributecache.AttributeCache{ID: "test-cache-id", TTLSeconds: 100000}, nil)
+	suite.mockEntityProvider.EXPECT().GetTransitiveEntityGroups("test-user").
+		Return([]entityprovider.EntityGroup{{ID: "group-1"}}, nil)
+	suite.mockAuthzSvc.EXPECT().GetAuthorizedPermissions(mock.Anything, authzsvc.GetAuthorizedPermissionsRequest{
+		EntityID:             "test-user",
+		GroupIDs:             []string{"group-1"},
+		RequestedPermissions: []string{"read", "write"},
+	}).Return(&authzsvc.GetAuthorizedPermissionsResponse{AuthorizedPermissions: []string{"read"}}, nil)
+	suite.mockSSOSession.EXPECT().AddSessionClient(mock.Anything, "test-session-id", "test-client-id").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything,
+		mock.MatchedBy(func(code AuthorizationCode) bool {
+			return code.SessionID == "test-session-id" && code.AuthorizedUserID == "test-user" &&
+				code.AttributeCacheID == "test-cache-id" && code.Scopes == "read" &&
+				code.ExpiryTime.After(time.Now())
+		})).Return(nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+	assert.Empty(suite.T(), result.QueryParams)
+	assert.Contains(suite.T(), result.RedirectURI, "https://client.example.com/callback")
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+	assert.Contains(suite.T(), result.RedirectURI, "state=test-state")
+	suite.mockFlowExecService.AssertNotCalled(suite.T(), "InitiateFlow", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_PersistentSSOSessionRotated() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id.test-token"
+	delete(msg.RequestQueryParams, "scope")
+	session := suite.testSSOSession()
+	session.Persistent = true
+	rotated := *session
+	rotated.Token = "new-token"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().GetSession(mock.Anything, "test-session-id.test-token").Return(session, nil)
+	suite.mockAttrCache.EXPECT().GetAttributeCache(mock.Anything, "test-cache-id").
+		Return(&attributecache.AttributeCache{ID: "test-cache-id", TTLSeconds: 100000}, nil)
+	suite.mockSSOSession.EXPECT().AddSessionClient(mock.Anything, "test-session-id", "test-client-id").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+	suite.mockSSOSession.EXPECT().RotateSessionToken(mock.Anything, session).Return(&rotated, nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+	assert.Equal(suite.T(), &rotated, result.Session)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_PersistentSSOSessionRotationFails() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id.test-token"
+	delete(msg.RequestQueryParams, "scope")
+	session := suite.testSSOSession()
+	session.Persistent = true
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().GetSession(mock.Anything, "test-session-id.test-token").Return(session, nil)
+	suite.mockAttrCache.EXPECT().GetAttributeCache(mock.Anything, "test-cache-id").
+		Return(&attributecache.AttributeCache{ID: "test-cache-id", TTLSeconds: 100000}, nil)
+	suite.mockSSOSession.EXPECT().AddSessionClient(mock.Anything, "test-session-id", "test-client-id").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+	suite.mockSSOSession.EXPECT().RotateSessionToken(mock.Anything, session).
+		Return(nil, &ssosession.ErrorSessionNotFound)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Empty(suite.T(), result.RedirectURI)
+	assert.Nil(suite.T(), result.Session)
+	assert.Equal(suite.T(), testAuthID, result.QueryParams[oauth2const.AuthID])
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SSOSessionExtendsAttributeCache() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id"
+	delete(msg.RequestQueryParams, "scope")
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().GetSession(mock.Anything, "test-session-id").Return(suite.testSSOSession(), nil)
+	suite.mockAttrCache.EXPECT().GetAttributeCache(mock.Anything, "test-cache-id").
+		Return(&attributecache.AttributeCache{ID: "test-cache-id", TTLSeconds: 10}, nil)
+	suite.mockAttrCache.EXPECT().ExtendAttributeCacheTTL(mock.Anything, "test-cache-id",
+		int(resolveUserAttributesCacheTTL(app))).Return(nil)
+	suite.mockSSOSession.EXPECT().AddSessionClient(mock.Anything, "test-session-id", "test-client-id").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "GetTransitiveEntityGroups", mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SSOSessionNotFoundStartsFlow() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().GetSession(mock.Anything, "test-session-id").
+		Return(nil, &ssosession.ErrorSessionNotFound)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Empty(suite.T(), result.RedirectURI)
+	assert.Equal(suite.T(), testAuthID, result.QueryParams[oauth2const.AuthID])
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SSOSessionIgnoredForPromptLogin() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id"
+	msg.RequestQueryParams["prompt"] = "login"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), testAuthID, result.QueryParams[oauth2const.AuthID])
+	suite.mockSSOSession.AssertNotCalled(suite.T(), "GetSession", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SSOSessionIgnoredForOrganization() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id"
+	msg.RequestQueryParams[oauth2const.RequestParamOrganization] = "acme"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.AnythingOfType("*flowexec.FlowInitContext")).
+		Run(func(_ context.Context, initContext *flowexec.FlowInitContext) {
+			assert.Equal(suite.T(), "acme", initContext.RuntimeData[flowcm.RuntimeKeyRequestedOrganization])
+		}).
+		Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), testAuthID, result.QueryParams[oauth2const.AuthID])
+	suite.mockSSOSession.AssertNotCalled(suite.T(), "GetSession", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SSOSessionACRNotSatisfied() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id"
+	msg.RequestQueryParams["acr_values"] = "mfa"
+	app.AcrValues = []string{"mfa"}
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().GetSession(mock.Anything, "test-session-id").Return(suite.testSSOSession(), nil)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), testAuthID, result.QueryParams[oauth2const.AuthID])
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_SSOSessionOlderThanMaxAge() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id"
+	msg.RequestQueryParams[oauth2const.RequestParamMaxAge] = "60"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().GetSession(mock.Anything, "test-session-id").Return(suite.testSSOSession(), nil)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Empty(suite.T(), result.RedirectURI)
+	assert.Equal(suite.T(), testAuthID, result.QueryParams[oauth2const.AuthID])
+	suite.mockAuthzCodeStore.AssertNotCalled(suite.T(), "InsertAuthorizationCode", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_PromptNoneSSOSessionOlderThanMaxAge() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id"
+	msg.RequestQueryParams["prompt"] = "none"
+	msg.RequestQueryParams[oauth2const.RequestParamMaxAge] = "0"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().GetSession(mock.Anything, "test-session-id").Return(suite.testSSOSession(), nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorLoginRequired, authErr.Code)
+	suite.mockFlowExecService.AssertNotCalled(suite.T(), "InitiateFlow", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_AcrMappedFlow() {
+	app := suite.testApp()
+	app.AcrValues = []string{"urn:thunder:acr:password", "urn:thunder:acr:mfa"}
+	app.AcrFlows = map[string]string{"urn:thunder:acr:mfa": "mfa-flow-id"}
+
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.AnythingOfType("*flowexec.FlowInitContext")).
+		Run(func(_ context.Context, initContext *flowexec.FlowInitContext) {
+			assert.Equal(suite.T(), "mfa-flow-id", initContext.FlowID)
+		}).
+		Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams[oauth2const.RequestParamAcrValues] = "urn:thunder:acr:password urn:thunder:acr:mfa"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_AcrWithoutMappedFlow() {
+	app := suite.testApp()
+	app.AcrValues = []string{"urn:thunder:acr:password", "urn:thunder:acr:mfa"}
+	app.AcrFlows = map[string]string{"urn:thunder:acr:mfa": "mfa-flow-id"}
+
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything,
+		mock.AnythingOfType("*flowexec.FlowInitContext")).
+		Run(func(_ context.Context, initContext *flowexec.FlowInitContext) {
+			assert.Empty(suite.T(), initContext.FlowID)
+		}).
+		Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).Return(testAuthID, nil)
+
+	msg := suite.testMsg()
+	msg.RequestQueryParams[oauth2const.RequestParamAcrValues] = "urn:thunder:acr:password"
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), authErr)
+	assert.NotNil(suite.T(), result)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_PromptNoneWithoutSSOSession() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.RequestQueryParams["prompt"] = "none"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorLoginRequired, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "https://client.example.com/callback", authErr.ClientRedirectURI)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+}
+
+// buildIDTokenHint builds an unsigned JWT carrying the given claims.
+func buildIDTokenHint(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + ".c2lnbmF0dXJl"
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_PromptNoneIDTokenHintOtherUser() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.SessionID = "test-session-id"
+	msg.RequestQueryParams["prompt"] = "none"
+	msg.RequestQueryParams[oauth2const.RequestParamIDTokenHint] = testIDTokenHint
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, testIDTokenHint, "test-client-id").
+		Return(&tokenservice.IDTokenHintClaims{Sub: "other-user", Audiences: []string{"test-client-id"}}, nil)
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().GetSession(mock.Anything, "test-session-id").Return(suite.testSSOSession(), nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorLoginRequired, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	suite.mockAuthzCodeStore.AssertNotCalled(suite.T(), "InsertAuthorizationCode", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_IDTokenHintForOtherClient() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.RequestQueryParams[oauth2const.RequestParamIDTokenHint] = testIDTokenHint
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, testIDTokenHint, "test-client-id").
+		Return(nil, tokenservice.ErrIDTokenHintAudienceMismatch)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	assert.Equal(suite.T(), "Invalid id_token_hint", authErr.Message)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_IDTokenHintInvalidSignature() {
+	app := suite.testApp()
+	msg := suite.testMsg()
+	msg.RequestQueryParams[oauth2const.RequestParamIDTokenHint] = "invalid.hint.token"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockTokenValidator.EXPECT().ValidateIDTokenHint(mock.Anything, "invalid.hint.token", "test-client-id").
+		Return(nil, tokenservice.ErrInvalidIDTokenHint)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_CreatesSSOSession() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://client.example.com/callback",
+		},
+	}
+	session := suite.testSSOSession()
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().CreateSession(mock.Anything,
+		mock.MatchedBy(func(s *ssosession.SSOSession) bool {
+			return s.UserID == "test-user" && s.AuthTime.Equal(time.Unix(1701421200, 0))
+		})).Return(session, nil)
+	suite.mockSSOSession.EXPECT().AddSessionClient(mock.Anything, "test-session-id", "test-client").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything,
+		mock.MatchedBy(func(code AuthorizationCode) bool {
+			return code.SessionID == session.ID
+		})).Return(nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Contains(suite.T(), result.RedirectURI, "code=")
+	assert.Equal(suite.T(), session, result.Session)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_CreatesPersistentSSOSession() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://client.example.com/callback",
+		},
+	}
+	session := suite.testSSOSession()
+	session.Persistent = true
+	session.Token = "test-token"
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithRememberMe, "", "").Return(nil)
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockAttrCache.EXPECT().GetAttributeCache(mock.Anything, "test-cache-id").
+		Return(&attributecache.AttributeCache{ID: "test-cache-id", TTLSeconds: 3600}, nil)
+	suite.mockAttrCache.EXPECT().ExtendAttributeCacheTTL(mock.Anything, "test-cache-id", 2592000).Return(nil)
+	suite.mockSSOSession.EXPECT().CreatePersistentSession(mock.Anything,
+		mock.MatchedBy(func(s *ssosession.SSOSession) bool {
+			return s.UserID == "test-user" && s.AttributeCacheID == "test-cache-id"
+		}), 30*24*time.Hour).Return(session, nil)
+	suite.mockSSOSession.EXPECT().AddSessionClient(mock.Anything, "test-session-id", "test-client").Return(nil)
+	suite.mockAuthzCodeStore.EXPECT().InsertAuthorizationCode(mock.Anything, mock.Anything).Return(nil)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithRememberMe)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), session, result.Session)
+	suite.mockSSOSession.AssertNotCalled(suite.T(), "CreateSession", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_SSOSessionCreationError() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{
+			ClientID:    "test-client",
+			RedirectURI: "https://client.example.com/callback",
+			State:       "test-state",
+		},
+	}
+	suite.mockAuthReqStore.EXPECT().ConsumeRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+	suite.mockSSOSession.EXPECT().IsEnabled().Return(true)
+	suite.mockSSOSession.EXPECT().CreateSession(mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+
+	svc := suite.newServiceWithSSOSessions()
+	result, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+	suite.mockAuthzCodeStore.AssertNotCalled(suite.T(), "InsertAuthorizationCode", mock.Anything, mock.Anything)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestFilterPermissionScopes_DropsUnknownScopes() {
+	svc := suite.newService()
+	mockScopeValidator := scopemock.NewScopeValidatorInterfaceMock(suite.T())
+	svc.scopeValidator = mockScopeValidator
+	app := suite.testApp()
+	oauthParams := &oauth2model.OAuthParameters{PermissionScopes: []string{"orders:read", "unknown"}}
+	mockScopeValidator.EXPECT().ValidateScopes(mock.Anything, "orders:read unknown", app).
+		Return("orders:read", nil).Once()
+
+	authErr := svc.filterPermissionScopes(context.Background(), oauthParams, app)
+
+	suite.Nil(authErr)
+	suite.Equal([]string{"orders:read"}, oauthParams.PermissionScopes)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestFilterPermissionScopes_ValidatorError() {
+	svc := suite.newService()
+	mockScopeValidator := scopemock.NewScopeValidatorInterfaceMock(suite.T())
+	svc.scopeValidator = mockScopeValidator
+	app := suite.testApp()
+	oauthParams := &oauth2model.OAuthParameters{
+		PermissionScopes: []string{"orders:read"},
+		RedirectURI:      "https://client.example.com/callback",
+		State:            "state-1",
+	}
+	mockScopeValidator.EXPECT().ValidateScopes(mock.Anything, "orders:read", app).
+		Return("", &scope.ScopeError{
+			Error:            oauth2const.ErrorServerError,
+			ErrorDescription: "Failed to validate the requested scopes",
+		}).Once()
+
+	authErr := svc.filterPermissionScopes(context.Background(), oauthParams, app)
+
+	suite.Require().NotNil(authErr)
+	suite.Equal(oauth2const.ErrorServerError, authErr.Code)
+	suite.True(authErr.SendErrorToClient)
+	suite.Equal("https://client.example.com/callback", authErr.ClientRedirectURI)
+	suite.Equal("state-1", authErr.State)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestFilterPermissionScopes_WithoutValidator() {
+	svc := suite.newService()
+	oauthParams := &oauth2model.OAuthParameters{PermissionScopes: []string{"orders:read", "unknown"}}
+
+	authErr := svc.filterPermissionScopes(context.Background(), oauthParams, suite.testApp())
+
+	suite.Nil(authErr)
+	suite.Equal([]string{"orders:read", "unknown"}, oauthParams.PermissionScopes)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestBuildAuthorizationCodeRedirectURI_QueryMode() {
+	svc := suite.newService()
+	authzCode := AuthorizationCode{
+		Code:        "test-code",
+		ClientID:    "test-client-id",
+		RedirectURI: "https://client.example.com/callback",
+	}
+
+	redirectURI, err := svc.buildAuthorizationCodeRedirectURI(context.Background(), authzCode,
+		&oauth2model.OAuthParameters{State: "test-state"})
+
+	suite.NoError(err)
+	suite.Contains(redirectURI, "https://client.example.com/callback?")
+	suite.Contains(redirectURI, "code=test-code")
+	suite.Contains(redirectURI, "state=test-state")
+	suite.Contains(redirectURI, "iss=")
+}
+
+func (suite *AuthorizeServiceTestSuite) TestBuildAuthorizationCodeRedirectURI_JWTModes() {
+	authzCode := AuthorizationCode{
+		Code:        "test-code",
+		ClientID:    "test-client-id",
+		RedirectURI: "https://client.example.com/callback",
+	}
+	testCases := []struct {
+		responseMode string
+		expected     string
+	}{
+		{"jwt", "https://client.example.com/callback?response=signed-response"},
+		{"query.jwt", "https://client.example.com/callback?response=signed-response"},
+		{"fragment.jwt", "https://client.example.com/callback#response=signed-response"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.responseMode, func() {
+			suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+			svc := suite.newService()
+			suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, "", "https://localhost:8090",
+				int64(jwtResponseValidity), mock.MatchedBy(func(claims map[string]interface{}) bool {
+					return claims["code"] == "test-code" && claims["state"] == "test-state" &&
+						claims["aud"] == "test-client-id"
+				}), jwt.TokenTypeJWT, "").Return("signed-response", int64(0), nil).Once()
+
+			redirectURI, err := svc.buildAuthorizationCodeRedirectURI(context.Background(), authzCode,
+				&oauth2model.OAuthParameters{State: "test-state", ResponseMode: tc.responseMode})
+
+			suite.NoError(err)
+			suite.Equal(tc.expected, redirectURI)
+		})
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestBuildAuthorizationCodeRedirectURI_FormPostJWTMode() {
+	svc := suite.newService()
+	authzCode := AuthorizationCode{
+		Code:        "test-code",
+		ClientID:    "test-client-id",
+		RedirectURI: "https://client.example.com/callback",
+	}
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, "", mock.Anything, mock.Anything, mock.Anything,
+		jwt.TokenTypeJWT, "").Return("signed-response", int64(0), nil).Once()
+
+	redirectURI, err := svc.buildAuthorizationCodeRedirectURI(context.Background(), authzCode,
+		&oauth2model.OAuthParameters{ResponseMode: "form_post.jwt"})
+
+	suite.NoError(err)
+	suite.True(strings.HasPrefix(redirectURI,
+		config.GetPublicURL(context.Background())+"/oauth2/authorize/response?"))
+	suite.Contains(redirectURI, "response=signed-response")
+	suite.Contains(redirectURI, "redirect_uri=https%3A%2F%2Fclient.example.com%2Fcallback")
+}
+
+func (suite *AuthorizeServiceTestSuite) TestBuildAuthorizationCodeRedirectURI_SigningError() {
+	svc := suite.newService()
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).
+		Return("", int64(0), &serviceerror.InternalServerError).Once()
+
+	_, err := svc.buildAuthorizationCodeRedirectURI(context.Background(), AuthorizationCode{
+		Code:        "test-code",
+		ClientID:    "test-client-id",
+		RedirectURI: "https://client.example.com/callback",
+	}, &oauth2model.OAuthParameters{ResponseMode: "jwt"})
+
+	suite.Error(err)
+}
+
+// validAuthorizationResponseClaims returns the claims of a valid JWT secured authorization response.
+func validAuthorizationResponseClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":  "https://localhost:8090",
+		"aud":  "test-client-id",
+		"exp":  time.Now().Add(5 * time.Minute).Unix(),
+		"code": "test-code",
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestValidateFormPostResponse_Success() {
+	svc := suite.newService()
+	response := buildIDTokenHint(validAuthorizationResponseClaims())
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(response).Return(nil).Once()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").
+		Return(suite.testApp(), nil).Once()
+
+	authErr := svc.ValidateFormPostResponse(context.Background(), response, "https://client.example.com/callback")
+
+	suite.Nil(authErr)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestValidateFormPostResponse_MissingParameters() {
+	svc := suite.newService()
+
+	authErr := svc.ValidateFormPostResponse(context.Background(), "", "https://client.example.com/callback")
+
+	suite.Require().NotNil(authErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestValidateFormPostResponse_InvalidSignature() {
+	svc := suite.newService()
+	response := buildIDTokenHint(validAuthorizationResponseClaims())
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(response).Return(&serviceerror.InternalServerError).Once()
+
+	authErr := svc.ValidateFormPostResponse(context.Background(), response, "https://client.example.com/callback")
+
+	suite.Require().NotNil(authErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestValidateFormPostResponse_InvalidClaims() {
+	testCases := []struct {
+		name   string
+		modify func(claims map[string]interface{})
+	}{
+		{"OtherIssuer", func(claims map[string]interface{}) { claims["iss"] = "https://other.example.com" }},
+		{"Expired", func(claims map[string]interface{}) { claims["exp"] = time.Now().Add(-time.Minute).Unix() }},
+		{"NotAuthorizationResponse", func(claims map[string]interface{}) { delete(claims, "code") }},
+		{"MissingAudience", func(claims map[string]interface{}) { delete(claims, "aud") }},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+			svc := suite.newService()
+			claims := validAuthorizationResponseClaims()
+			tc.modify(claims)
+			response := buildIDTokenHint(claims)
+			suite.mockJWTService.EXPECT().VerifyJWTSignature(response).Return(nil).Once()
+
+			authErr := svc.ValidateFormPostResponse(context.Background(), response,
+				"https://client.example.com/callback")
+
+			suite.Require().NotNil(authErr)
+			suite.Equal(oauth2const.ErrorInvalidRequest, authErr.Code)
+		})
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestValidateFormPostResponse_UnregisteredRedirectURI() {
+	svc := suite.newService()
+	response := buildIDTokenHint(validAuthorizationResponseClaims())
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(response).Return(nil).Once()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").
+		Return(suite.testApp(), nil).Once()
+
+	authErr := svc.ValidateFormPostResponse(context.Background(), response, "https://attacker.example.com/cb")
+
+	suite.Require().NotNil(authErr)
+	suite.Equal(oauth2const.ErrorInvalidRequest, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestValidateFormPostResponse_ClientLookupError() {
+	svc := suite.newService()
+	response := buildIDTokenHint(validAuthorizationResponseClaims())
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(response).Return(nil).Once()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").
+		Return(nil, errors.New("db error")).Once()
+
+	authErr := svc.ValidateFormPostResponse(context.Background(), response, "https://client.example.com/callback")
+
+	suite.Require().NotNil(authErr)
+	suite.Equal(oauth2const.ErrorServerError, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_ErrorCarriesResponseMode() {
+	msg := suite.testMsg()
+	msg.RequestQueryParams["response_mode"] = "jwt"
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").
+		Return(app, nil).Once()
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(true, oauth2const.ErrorInvalidScope, "Invalid scope")
+
+	svc := suite.newService()
+	_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	suite.Require().NotNil(authErr)
+	suite.True(authErr.SendErrorToClient)
+	suite.Equal("test-client-id", authErr.ClientID)
+	suite.Equal("jwt", authErr.ResponseMode)
+}
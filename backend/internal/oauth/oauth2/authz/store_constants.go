@@ -22,18 +22,20 @@ import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 
 // JSON keys for authorization request context serialization.
 const (
-	jsonKeyState               = "state"
-	jsonKeyClientID            = "client_id"
-	jsonKeyRedirectURI         = "redirect_uri"
-	jsonKeyResponseType        = "response_type"
-	jsonKeyStandardScopes      = "standard_scopes"
-	jsonKeyPermissionScopes    = "permission_scopes"
-	jsonKeyCodeChallenge       = "code_challenge"
-	jsonKeyCodeChallengeMethod = "code_challenge_method"
-	jsonKeyResource            = "resource"
-	jsonKeyClaimsRequest       = "claims_request"
-	jsonKeyClaimsLocales       = "claims_locales"
-	jsonKeyNonce               = "nonce"
+	jsonKeyState                = "state"
+	jsonKeyClientID             = "client_id"
+	jsonKeyRedirectURI          = "redirect_uri"
+	jsonKeyResponseType         = "response_type"
+	jsonKeyResponseMode         = "response_mode"
+	jsonKeyStandardScopes       = "standard_scopes"
+	jsonKeyPermissionScopes     = "permission_scopes"
+	jsonKeyCodeChallenge        = "code_challenge"
+	jsonKeyCodeChallengeMethod  = "code_challenge_method"
+	jsonKeyResource             = "resource"
+	jsonKeyClaimsRequest        = "claims_request"
+	jsonKeyClaimsLocales        = "claims_locales"
+	jsonKeyNonce                = "nonce"
+	jsonKeyAuthorizationDetails = "authorization_details"
 )
 
 // Database column names for authorization request storage.
@@ -56,3 +56,9 @@ func trimTimeString(timeStr string) string {
 	}
 	return timeStr
 }
+
+// isRequestObjectURI reports whether a request_uri references a request object hosted by the client, as
+// opposed to a pushed authorization request.
+func isRequestObjectURI(requestURI string) bool {
+	return strings.HasPrefix(requestURI, "https://")
+}
@@ -54,7 +54,7 @@ func (av *authorizationValidator) validateInitialAuthorizationRequest(msg *OAuth
 
 	if err := oauthApp.ValidateRedirectURI(redirectURI); err != nil {
 		logger.Debug("Validation failed for redirect URI", log.Error(err))
-		return false, constants.ErrorInvalidRequest, "Invalid redirect URI"
+		return false, constants.ErrorInvalidRequest, inboundmodel.RedirectURIErrorDescription(err)
 	}
 
 	// All subsequent validation errors can be sent to the client application via redirect.
@@ -161,8 +161,8 @@ func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthorizationRe
 	assert.Equal(suite.T(), "Missing response_type parameter", errorMessage)
 }
 
-func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthorizationRequest_UnsupportedResponseType() {
-	// Create an app that doesn't support "code" response type
+func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthorizationRequest_ResponseTypeNotAllowed() {
+	// Create an app that isn't registered for the "code" response type
 	restrictedApp := &inboundmodel.OAuthClient{
 		ClientID: "test-client-id",
 
@@ -184,8 +184,8 @@ func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthorizationRe
 		msg, restrictedApp)
 
 	assert.True(suite.T(), sendErrorToApp)
-	assert.Equal(suite.T(), constants.ErrorUnsupportedResponseType, errorCode)
-	assert.Equal(suite.T(), "Unsupported response type", errorMessage)
+	assert.Equal(suite.T(), constants.ErrorUnauthorizedClient, errorCode)
+	assert.Equal(suite.T(), "The client is not authorized to use this response type", errorMessage)
 }
 
 func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthorizationRequest_EmptyRedirectURI() {
@@ -823,3 +823,64 @@ func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthorizationRe
 		})
 	}
 }
+
+func (suite *AuthorizationValidatorTestSuite) TestValidateInitialAuthorizationRequest_RedirectURIPolicy() {
+	tests := []struct {
+		name             string
+		registeredURIs   []string
+		policy           *inboundmodel.RedirectURIPolicy
+		incomingURI      string
+		wantErrorMessage string
+	}{
+		{
+			name:           "LoopbackAnyPortAllowed",
+			registeredURIs: []string{"http://127.0.0.1/callback"},
+			policy:         &inboundmodel.RedirectURIPolicy{AllowLoopbackAnyPort: true},
+			incomingURI:    "http://127.0.0.1:51234/callback",
+		},
+		{
+			name:             "LoopbackPortMismatch",
+			registeredURIs:   []string{"http://127.0.0.1:8080/callback"},
+			incomingURI:      "http://127.0.0.1:51234/callback",
+			wantErrorMessage: "Invalid redirect URI: " + inboundmodel.ErrRedirectURILoopbackPortMismatch.Error(),
+		},
+		{
+			name:             "CustomSchemeNotAllowed",
+			registeredURIs:   []string{"com.example.app:/callback"},
+			policy:           &inboundmodel.RedirectURIPolicy{AllowedCustomSchemes: []string{"com.example.app"}},
+			incomingURI:      "com.evil.app:/callback",
+			wantErrorMessage: "Invalid redirect URI: " + inboundmodel.ErrRedirectURISchemeNotAllowed.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			app := &inboundmodel.OAuthClient{
+				ClientID:                "test-client-id",
+				RedirectURIs:            tt.registeredURIs,
+				RedirectURIPolicy:       tt.policy,
+				GrantTypes:              []constants.GrantType{constants.GrantTypeAuthorizationCode},
+				ResponseTypes:           []constants.ResponseType{constants.ResponseTypeCode},
+				TokenEndpointAuthMethod: constants.TokenEndpointAuthMethodClientSecretPost,
+			}
+			msg := &OAuthMessage{
+				RequestQueryParams: map[string]string{
+					constants.RequestParamClientID:     "test-client-id",
+					constants.RequestParamRedirectURI:  tt.incomingURI,
+					constants.RequestParamResponseType: string(constants.ResponseTypeCode),
+				},
+			}
+
+			sendErrorToApp, errorCode, errorMessage := suite.validator.validateInitialAuthorizationRequest(msg, app)
+
+			assert.False(suite.T(), sendErrorToApp)
+			if tt.wantErrorMessage != "" {
+				assert.Equal(suite.T(), constants.ErrorInvalidRequest, errorCode)
+				assert.Equal(suite.T(), tt.wantErrorMessage, errorMessage)
+			} else {
+				assert.Empty(suite.T(), errorCode)
+				assert.Empty(suite.T(), errorMessage)
+			}
+		})
+	}
+}
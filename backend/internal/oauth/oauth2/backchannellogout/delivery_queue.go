@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// deliveryQueueSize is the number of logout tokens that can wait for delivery.
+	deliveryQueueSize = 1000
+	// deliveryMaxAttempts is the number of delivery attempts made before a delivery is marked as failed.
+	deliveryMaxAttempts = 5
+	// deliveryInitialBackoff is the delay before the first retry. The delay doubles on each retry.
+	deliveryInitialBackoff = 2 * time.Second
+	// deliveryMaxBackoff is the maximum delay between retries.
+	deliveryMaxBackoff = 5 * time.Minute
+	// deliveryTimeout is the maximum time a client is given to acknowledge a logout token.
+	deliveryTimeout = 10 * time.Second
+	// formParamLogoutToken is the form parameter that carries the logout token.
+	formParamLogoutToken = "logout_token"
+)
+
+// deliveryQueueInterface defines the interface for queueing logout tokens for asynchronous delivery.
+type deliveryQueueInterface interface {
+	Enqueue(delivery queuedDelivery)
+}
+
+// deliveryQueue posts queued logout tokens to the back-channel logout URIs of the clients in the background.
+// Deliveries that fail with a network or server error are retried with exponential backoff, while deliveries
+// rejected by the client or that exhaust the retry attempts are marked as failed. The outcome of each attempt
+// is recorded in the delivery store. The queue is held in memory, so deliveries pending delivery or retry are
+// not resumed across server restarts.
+type deliveryQueue struct {
+	httpClient syshttp.HTTPClientInterface
+	store      deliveryStoreInterface
+	queue      chan queuedDelivery
+	schedule   func(delay time.Duration, fn func())
+	logger     *log.Logger
+}
+
+// newDeliveryQueue creates a delivery queue and starts the delivery worker.
+func newDeliveryQueue(httpClient syshttp.HTTPClientInterface, store deliveryStoreInterface) deliveryQueueInterface {
+	q := &deliveryQueue{
+		httpClient: httpClient,
+		store:      store,
+		queue:      make(chan queuedDelivery, deliveryQueueSize),
+		schedule: func(delay time.Duration, fn func()) {
+			time.AfterFunc(delay, fn)
+		},
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackchannelLogoutDeliveryQueue")),
+	}
+	go q.run()
+
+	return q
+}
+
+// Enqueue adds a logout token to the delivery queue. The delivery is marked as failed if the queue is full.
+func (q *deliveryQueue) Enqueue(delivery queuedDelivery) {
+	select {
+	case q.queue <- delivery:
+	default:
+		delivery.Status = DeliveryStatusFailed
+		delivery.LastError = "delivery queue is full"
+		q.updateDelivery(delivery.LogoutDelivery)
+	}
+}
+
+// run delivers the queued logout tokens until the queue is closed.
+func (q *deliveryQueue) run() {
+	for delivery := range q.queue {
+		q.process(delivery)
+	}
+}
+
+// process attempts to deliver a logout token and schedules a retry or marks the delivery as failed on failure.
+func (q *deliveryQueue) process(delivery queuedDelivery) {
+	delivery.Attempts++
+	retryable, err := q.deliver(context.Background(), delivery)
+	switch {
+	case err == nil:
+		delivery.Status = DeliveryStatusDelivered
+		delivery.LastError = ""
+	case retryable && delivery.Attempts < deliveryMaxAttempts:
+		delivery.Status = DeliveryStatusPending
+		delivery.LastError = err.Error()
+	default:
+		delivery.Status = DeliveryStatusFailed
+		delivery.LastError = err.Error()
+		q.logger.Error("Logout token could not be delivered", log.String("id", delivery.ID),
+			log.MaskedString("clientID", delivery.ClientID), log.Int("attempts", delivery.Attempts),
+			log.String("error", delivery.LastError))
+	}
+	q.updateDelivery(delivery.LogoutDelivery)
+
+	if delivery.Status != DeliveryStatusPending {
+		return
+	}
+	delay := getDeliveryBackoff(delivery.Attempts)
+	q.logger.Debug("Scheduling logout token delivery retry", log.String("id", delivery.ID),
+		log.Int("attempt", delivery.Attempts), log.Any("delay", delay))
+	q.schedule(delay, func() {
+		q.Enqueue(delivery)
+	})
+}
+
+// deliver posts the logout token to the back-channel logout URI of the client. It reports whether a failed
+// delivery may succeed when retried.
+func (q *deliveryQueue) deliver(ctx context.Context, delivery queuedDelivery) (bool, error) {
+	if err := syshttp.IsSSRFSafeURL(delivery.LogoutURI); err != nil {
+		return false, fmt.Errorf("back-channel logout URI is not allowed: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	form := url.Values{}
+	form.Set(formParamLogoutToken, delivery.logoutToken)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.LogoutURI,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, fmt.Errorf("failed to build the logout request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := q.httpClient.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("failed to send the logout token: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+
+	err = errors.New("client responded with status " + resp.Status)
+	return resp.StatusCode >= http.StatusInternalServerError, err
+}
+
+// updateDelivery records the outcome of a delivery attempt.
+func (q *deliveryQueue) updateDelivery(delivery LogoutDelivery) {
+	delivery.UpdatedAt = time.Now().UTC()
+	if err := q.store.UpdateDelivery(context.Background(), delivery); err != nil {
+		q.logger.Error("Failed to update the logout token delivery", log.String("id", delivery.ID),
+			log.Error(err))
+	}
+}
+
+// getDeliveryBackoff returns the delay before the retry following the given attempt.
+func getDeliveryBackoff(attempt int) time.Duration {
+	delay := deliveryInitialBackoff
+	for i := 1; i < attempt && delay < deliveryMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > deliveryMaxBackoff {
+		return deliveryMaxBackoff
+	}
+
+	return delay
+}
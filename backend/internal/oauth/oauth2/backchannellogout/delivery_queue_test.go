@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+type DeliveryQueueTestSuite struct {
+	suite.Suite
+	mockHTTPClient  *httpmock.HTTPClientInterfaceMock
+	mockStore       *deliveryStoreInterfaceMock
+	scheduledDelays []time.Duration
+	scheduledFns    []func()
+	queue           *deliveryQueue
+}
+
+func TestDeliveryQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryQueueTestSuite))
+}
+
+func (suite *DeliveryQueueTestSuite) SetupTest() {
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.mockStore = newDeliveryStoreInterfaceMock(suite.T())
+	suite.scheduledDelays = nil
+	suite.scheduledFns = nil
+	suite.queue = &deliveryQueue{
+		httpClient: suite.mockHTTPClient,
+		store:      suite.mockStore,
+		queue:      make(chan queuedDelivery, 1),
+		schedule: func(delay time.Duration, fn func()) {
+			suite.scheduledDelays = append(suite.scheduledDelays, delay)
+			suite.scheduledFns = append(suite.scheduledFns, fn)
+		},
+		logger: log.GetLogger(),
+	}
+}
+
+func getTestQueuedDelivery() queuedDelivery {
+	return queuedDelivery{LogoutDelivery: getTestDelivery(), logoutToken: "logout-token"}
+}
+
+func newTestResponse(statusCode int) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     strconv.Itoa(statusCode) + " " + http.StatusText(statusCode),
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+}
+
+// expectUpdate expects the delivery to be updated with the given status and attempts.
+func (suite *DeliveryQueueTestSuite) expectUpdate(status DeliveryStatus, attempts int) {
+	suite.mockStore.EXPECT().UpdateDelivery(mock.Anything, mock.MatchedBy(func(d LogoutDelivery) bool {
+		return d.ID == "delivery-1" && d.Status == status && d.Attempts == attempts
+	})).Return(nil).Once()
+}
+
+func (suite *DeliveryQueueTestSuite) TestEnqueue_QueueFull() {
+	suite.queue.Enqueue(getTestQueuedDelivery())
+	suite.mockStore.EXPECT().UpdateDelivery(mock.Anything, mock.MatchedBy(func(d LogoutDelivery) bool {
+		return d.ID == "second" && d.Status == DeliveryStatusFailed && d.LastError == "delivery queue is full"
+	})).Return(nil).Once()
+
+	second := getTestQueuedDelivery()
+	second.ID = "second"
+	suite.queue.Enqueue(second)
+	suite.Len(suite.queue.queue, 1)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_Delivered() {
+	suite.mockHTTPClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.Method == http.MethodPost && req.URL.String() == "https://client.example.com/logout" &&
+			req.Header.Get("Content-Type") == "application/x-www-form-urlencoded" &&
+			string(body) == "logout_token=logout-token"
+	})).Return(newTestResponse(http.StatusOK), nil).Once()
+	suite.expectUpdate(DeliveryStatusDelivered, 1)
+
+	suite.queue.process(getTestQueuedDelivery())
+
+	suite.Empty(suite.scheduledFns)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_ServerErrorRetried() {
+	suite.mockHTTPClient.EXPECT().Do(mock.Anything).Return(newTestResponse(http.StatusServiceUnavailable), nil).Once()
+	suite.mockStore.EXPECT().UpdateDelivery(mock.Anything, mock.MatchedBy(func(d LogoutDelivery) bool {
+		return d.Status == DeliveryStatusPending && d.Attempts == 1 &&
+			d.LastError == "client responded with status 503 Service Unavailable"
+	})).Return(nil).Once()
+
+	suite.queue.process(getTestQueuedDelivery())
+
+	suite.Equal([]time.Duration{deliveryInitialBackoff}, suite.scheduledDelays)
+	suite.scheduledFns[0]()
+	queued := <-suite.queue.queue
+	suite.Equal(1, queued.Attempts)
+	suite.Equal("logout-token", queued.logoutToken)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_NetworkErrorRetried() {
+	suite.mockHTTPClient.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	suite.expectUpdate(DeliveryStatusPending, 1)
+
+	suite.queue.process(getTestQueuedDelivery())
+
+	suite.Len(suite.scheduledFns, 1)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_ClientErrorFails() {
+	suite.mockHTTPClient.EXPECT().Do(mock.Anything).Return(newTestResponse(http.StatusBadRequest), nil).Once()
+	suite.expectUpdate(DeliveryStatusFailed, 1)
+
+	suite.queue.process(getTestQueuedDelivery())
+
+	suite.Empty(suite.scheduledFns)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_MaxAttemptsFails() {
+	delivery := getTestQueuedDelivery()
+	delivery.Attempts = deliveryMaxAttempts - 1
+	suite.mockHTTPClient.EXPECT().Do(mock.Anything).Return(newTestResponse(http.StatusBadGateway), nil).Once()
+	suite.expectUpdate(DeliveryStatusFailed, deliveryMaxAttempts)
+
+	suite.queue.process(delivery)
+
+	suite.Empty(suite.scheduledFns)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_UnsafeURIFails() {
+	delivery := getTestQueuedDelivery()
+	delivery.LogoutURI = "https://127.0.0.1/logout"
+	suite.mockStore.EXPECT().UpdateDelivery(mock.Anything, mock.MatchedBy(func(d LogoutDelivery) bool {
+		return d.Status == DeliveryStatusFailed && strings.Contains(d.LastError, "not allowed")
+	})).Return(nil).Once()
+
+	suite.queue.process(delivery)
+
+	suite.Empty(suite.scheduledFns)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *DeliveryQueueTestSuite) TestProcess_UpdateErrorIgnored() {
+	suite.mockHTTPClient.EXPECT().Do(mock.Anything).Return(newTestResponse(http.StatusNoContent), nil).Once()
+	suite.mockStore.EXPECT().UpdateDelivery(mock.Anything, mock.Anything).Return(errors.New("db err")).Once()
+
+	suite.NotPanics(func() {
+		suite.queue.process(getTestQueuedDelivery())
+	})
+}
+
+func (suite *DeliveryQueueTestSuite) TestGetDeliveryBackoff() {
+	suite.Equal(2*time.Second, getDeliveryBackoff(1))
+	suite.Equal(4*time.Second, getDeliveryBackoff(2))
+	suite.Equal(16*time.Second, getDeliveryBackoff(4))
+	suite.Equal(deliveryMaxBackoff, getDeliveryBackoff(20))
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// backchannelLogoutHandler defines the handler for the back-channel logout delivery API.
+type backchannelLogoutHandler struct {
+	service BackchannelLogoutServiceInterface
+	logger  *log.Logger
+}
+
+// newBackchannelLogoutHandler creates a new back-channel logout handler instance.
+func newBackchannelLogoutHandler(service BackchannelLogoutServiceInterface) *backchannelLogoutHandler {
+	return &backchannelLogoutHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackchannelLogoutHandler")),
+	}
+}
+
+// HandleDeliveryListRequest handles the request to list the recent logout token deliveries of an application.
+func (h *backchannelLogoutHandler) HandleDeliveryListRequest(w http.ResponseWriter, r *http.Request) {
+	limit := defaultDeliveryLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	deliveries, svcErr := h.service.ListDeliveries(r.Context(), r.PathValue("id"), limit)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, LogoutDeliveryListResponse{
+		TotalResults: len(deliveries),
+		Deliveries:   deliveries,
+	})
+}
+
+// handleError handles service errors and sends appropriate HTTP responses.
+func (h *backchannelLogoutHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	if statusCode == http.StatusInternalServerError {
+		h.logger.Error("Back-channel logout request failed with server error",
+			log.String("code", svcErr.Code),
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
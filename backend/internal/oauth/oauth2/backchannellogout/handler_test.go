@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type BackchannelLogoutHandlerTestSuite struct {
+	suite.Suite
+	mockService *BackchannelLogoutServiceInterfaceMock
+	handler     *backchannelLogoutHandler
+}
+
+func TestBackchannelLogoutHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(BackchannelLogoutHandlerTestSuite))
+}
+
+func (suite *BackchannelLogoutHandlerTestSuite) SetupTest() {
+	suite.mockService = NewBackchannelLogoutServiceInterfaceMock(suite.T())
+	suite.handler = newBackchannelLogoutHandler(suite.mockService)
+}
+
+func (suite *BackchannelLogoutHandlerTestSuite) newListRequest(target string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.SetPathValue("id", "app-1")
+	return req
+}
+
+func (suite *BackchannelLogoutHandlerTestSuite) TestHandleDeliveryListRequest() {
+	suite.mockService.EXPECT().ListDeliveries(mock.Anything, "app-1", defaultDeliveryLimit).
+		Return([]LogoutDelivery{getTestDelivery()}, nil)
+
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeliveryListRequest(rr,
+		suite.newListRequest("/applications/app-1/backchannel-logout-deliveries"))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response LogoutDeliveryListResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Equal(1, response.TotalResults)
+	suite.Equal("delivery-1", response.Deliveries[0].ID)
+	suite.Equal(DeliveryStatusPending, response.Deliveries[0].Status)
+}
+
+func (suite *BackchannelLogoutHandlerTestSuite) TestHandleDeliveryListRequest_WithLimit() {
+	suite.mockService.EXPECT().ListDeliveries(mock.Anything, "app-1", 5).Return([]LogoutDelivery{}, nil)
+
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeliveryListRequest(rr,
+		suite.newListRequest("/applications/app-1/backchannel-logout-deliveries?limit=5"))
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *BackchannelLogoutHandlerTestSuite) TestHandleDeliveryListRequest_InvalidLimit() {
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeliveryListRequest(rr,
+		suite.newListRequest("/applications/app-1/backchannel-logout-deliveries?limit=abc"))
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidLimit.Code)
+}
+
+func (suite *BackchannelLogoutHandlerTestSuite) TestHandleDeliveryListRequest_ServerError() {
+	suite.mockService.EXPECT().ListDeliveries(mock.Anything, "app-1", defaultDeliveryLimit).
+		Return(nil, &serviceerror.InternalServerError)
+
+	rr := httptest.NewRecorder()
+	suite.handler.HandleDeliveryListRequest(rr,
+		suite.newListRequest("/applications/app-1/backchannel-logout-deliveries"))
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the back-channel logout service, registers it to be notified when SSO sessions are
+// terminated and registers the logout delivery routes.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+) BackchannelLogoutServiceInterface {
+	store := newDeliveryStore()
+	service := newBackchannelLogoutService(jwtService, inboundClient, store, newDeliveryQueue(httpClient, store))
+	ssoSessionService.AddTerminationListener(service)
+	registerRoutes(mux, newBackchannelLogoutHandler(service))
+	return service
+}
+
+// registerRoutes registers the routes of the logout delivery API.
+func registerRoutes(mux *http.ServeMux, handler *backchannelLogoutHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /applications/{id}/backchannel-logout-deliveries",
+		handler.HandleDeliveryListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /applications/{id}/backchannel-logout-deliveries",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import "time"
+
+// DeliveryStatus represents the state of a logout token delivery to a client.
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending indicates that the logout token is waiting to be delivered or retried.
+	DeliveryStatusPending DeliveryStatus = "PENDING"
+	// DeliveryStatusDelivered indicates that the client acknowledged the logout token.
+	DeliveryStatusDelivered DeliveryStatus = "DELIVERED"
+	// DeliveryStatusFailed indicates that the logout token could not be delivered.
+	DeliveryStatusFailed DeliveryStatus = "FAILED"
+)
+
+// LogoutDelivery represents the delivery of a logout token to the back-channel logout URI of a client.
+type LogoutDelivery struct {
+	ID            string         `json:"id"`
+	ApplicationID string         `json:"applicationId"`
+	ClientID      string         `json:"clientId"`
+	SessionID     string         `json:"sessionId"`
+	LogoutURI     string         `json:"logoutUri"`
+	Status        DeliveryStatus `json:"status"`
+	Attempts      int            `json:"attempts"`
+	LastError     string         `json:"lastError,omitempty"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+}
+
+// LogoutDeliveryListResponse represents the response structure for the logout deliveries of an application.
+type LogoutDeliveryListResponse struct {
+	TotalResults int              `json:"totalResults"`
+	Deliveries   []LogoutDelivery `json:"deliveries"`
+}
+
+// queuedDelivery is a logout delivery waiting in the delivery queue. The logout token is only held in memory
+// and is never stored.
+type queuedDelivery struct {
+	LogoutDelivery
+	logoutToken string
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package backchannellogout implements OpenID Connect back-channel logout, which notifies the clients that were
+// issued tokens in an SSO session with a signed logout token when the session is terminated.
+package backchannellogout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// logoutTokenValidity is the validity period of a logout token in seconds.
+	logoutTokenValidity = 120
+	// logoutEventType is the event type that identifies a logout token.
+	logoutEventType = "http://schemas.openid.net/event/backchannel-logout"
+	// defaultDeliveryLimit is the default number of logout deliveries returned in a listing.
+	defaultDeliveryLimit = 20
+	// maxDeliveryLimit is the maximum number of logout deliveries returned in a listing.
+	maxDeliveryLimit = 100
+)
+
+// BackchannelLogoutServiceInterface defines the interface for the back-channel logout service.
+type BackchannelLogoutServiceInterface interface {
+	// OnSessionTerminated sends a logout token to each client of the terminated session that registered a
+	// back-channel logout URI.
+	OnSessionTerminated(ctx context.Context, session ssosession.SSOSession)
+
+	// ListDeliveries lists the most recent logout token deliveries to the client of an application.
+	ListDeliveries(ctx context.Context, applicationID string, limit int) (
+		[]LogoutDelivery, *serviceerror.ServiceError)
+}
+
+// backchannelLogoutService implements BackchannelLogoutServiceInterface.
+type backchannelLogoutService struct {
+	jwtService    jwt.JWTServiceInterface
+	inboundClient inboundclient.InboundClientServiceInterface
+	store         deliveryStoreInterface
+	queue         deliveryQueueInterface
+	logger        *log.Logger
+}
+
+// newBackchannelLogoutService creates a new back-channel logout service instance.
+func newBackchannelLogoutService(
+	jwtService jwt.JWTServiceInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	store deliveryStoreInterface,
+	queue deliveryQueueInterface,
+) BackchannelLogoutServiceInterface {
+	return &backchannelLogoutService{
+		jwtService:    jwtService,
+		inboundClient: inboundClient,
+		store:         store,
+		queue:         queue,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackchannelLogoutService")),
+	}
+}
+
+// OnSessionTerminated sends a logout token to each client of the terminated session that registered a
+// back-channel logout URI. The logout tokens are delivered in the background, so a client that is unavailable
+// does not hold up the termination of the session.
+func (s *backchannelLogoutService) OnSessionTerminated(ctx context.Context, session ssosession.SSOSession) {
+	for _, clientID := range session.ClientIDs {
+		logger := s.logger.With(log.MaskedString("clientID", clientID))
+		client, err := s.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+		if err != nil {
+			logger.Error("Failed to retrieve the OAuth client", log.Error(err))
+			continue
+		}
+		if client == nil || client.BackchannelLogoutURI == "" {
+			continue
+		}
+
+		logoutToken, err := s.generateLogoutToken(ctx, session, clientID)
+		if err != nil {
+			logger.Error("Failed to generate the logout token", log.Error(err))
+			continue
+		}
+
+		id, err := sysutils.GenerateUUIDv7()
+		if err != nil {
+			logger.Error("Failed to generate UUID for the logout delivery", log.Error(err))
+			continue
+		}
+		now := time.Now().UTC()
+		delivery := LogoutDelivery{
+			ID:            id,
+			ApplicationID: client.ID,
+			ClientID:      clientID,
+			SessionID:     session.ID,
+			LogoutURI:     client.BackchannelLogoutURI,
+			Status:        DeliveryStatusPending,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if err := s.store.CreateDelivery(ctx, delivery); err != nil {
+			logger.Error("Failed to record the logout delivery", log.Error(err))
+			continue
+		}
+
+		s.queue.Enqueue(queuedDelivery{LogoutDelivery: delivery, logoutToken: logoutToken})
+	}
+}
+
+// generateLogoutToken generates a logout token that identifies the user and the session to the client. The
+// token is typed so that it cannot be mistaken for an ID token.
+func (s *backchannelLogoutService) generateLogoutToken(
+	ctx context.Context, session ssosession.SSOSession, clientID string,
+) (string, error) {
+	claims := map[string]interface{}{
+		"aud":                clientID,
+		oauth2const.ClaimSid: session.ID,
+		"events": map[string]interface{}{
+			logoutEventType: map[string]interface{}{},
+		},
+	}
+
+	token, _, svcErr := s.jwtService.GenerateJWT(ctx, session.UserID, config.GetIssuer(ctx),
+		logoutTokenValidity, claims, jwt.TokenTypeLogoutToken, "")
+	if svcErr != nil {
+		return "", fmt.Errorf("failed to generate logout token: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+
+	return token, nil
+}
+
+// ListDeliveries lists the most recent logout token deliveries to the client of an application.
+func (s *backchannelLogoutService) ListDeliveries(ctx context.Context, applicationID string, limit int) (
+	[]LogoutDelivery, *serviceerror.ServiceError) {
+	if limit <= 0 {
+		return nil, &ErrorInvalidLimit
+	}
+	if limit > maxDeliveryLimit {
+		limit = maxDeliveryLimit
+	}
+
+	deliveries, err := s.store.ListDeliveries(ctx, applicationID, limit)
+	if err != nil {
+		s.logger.Error("Failed to list logout deliveries", log.String("applicationId", applicationID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testIssuer    = "https://localhost:8090"
+	testUserID    = "user-1"
+	testSessionID = "session-1"
+	testLogoutURI = "https://client.example.com/logout"
+)
+
+type BackchannelLogoutServiceTestSuite struct {
+	suite.Suite
+	jwtMock     *jwtmock.JWTServiceInterfaceMock
+	inboundMock *inboundclientmock.InboundClientServiceInterfaceMock
+	storeMock   *deliveryStoreInterfaceMock
+	queueMock   *deliveryQueueInterfaceMock
+	service     BackchannelLogoutServiceInterface
+	ctx         context.Context
+	session     ssosession.SSOSession
+}
+
+func TestBackchannelLogoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BackchannelLogoutServiceTestSuite))
+}
+
+func (s *BackchannelLogoutServiceTestSuite) SetupTest() {
+	_ = config.InitializeServerRuntime("", &config.Config{JWT: config.JWTConfig{Issuer: testIssuer}})
+
+	s.jwtMock = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.inboundMock = inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	s.storeMock = newDeliveryStoreInterfaceMock(s.T())
+	s.queueMock = newDeliveryQueueInterfaceMock(s.T())
+	s.service = newBackchannelLogoutService(s.jwtMock, s.inboundMock, s.storeMock, s.queueMock)
+	s.ctx = context.Background()
+	s.session = ssosession.SSOSession{
+		ID:        testSessionID,
+		UserID:    testUserID,
+		ClientIDs: []string{"client-1"},
+	}
+}
+
+func (s *BackchannelLogoutServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *BackchannelLogoutServiceTestSuite) TestOnSessionTerminated_SendsLogoutToken() {
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-1").Return(
+		&inboundmodel.OAuthClient{ID: "app-1", ClientID: "client-1", BackchannelLogoutURI: testLogoutURI}, nil)
+	s.jwtMock.EXPECT().GenerateJWT(mock.Anything, testUserID, testIssuer, int64(logoutTokenValidity),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			events, ok := claims["events"].(map[string]interface{})
+			return ok && claims["aud"] == "client-1" && claims["sid"] == testSessionID &&
+				events[logoutEventType] != nil && claims["nonce"] == nil
+		}), jwt.TokenTypeLogoutToken, "").Return("logout-token", int64(0), nil)
+
+	var created LogoutDelivery
+	s.storeMock.EXPECT().CreateDelivery(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, delivery LogoutDelivery) {
+			created = delivery
+		}).Return(nil)
+	s.queueMock.EXPECT().Enqueue(mock.MatchedBy(func(d queuedDelivery) bool {
+		return d.logoutToken == "logout-token" && d.ID == created.ID
+	})).Return()
+
+	s.service.OnSessionTerminated(s.ctx, s.session)
+
+	s.NotEmpty(created.ID)
+	s.Equal("app-1", created.ApplicationID)
+	s.Equal(testSessionID, created.SessionID)
+	s.Equal(testLogoutURI, created.LogoutURI)
+	s.Equal(DeliveryStatusPending, created.Status)
+}
+
+func (s *BackchannelLogoutServiceTestSuite) TestOnSessionTerminated_SkipsClientsWithoutLogoutURI() {
+	s.session.ClientIDs = []string{"client-1", "client-2", "client-3"}
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-1").Return(
+		&inboundmodel.OAuthClient{ClientID: "client-1"}, nil)
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-2").Return(nil, nil)
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-3").Return(
+		nil, errors.New("lookup failed"))
+
+	s.service.OnSessionTerminated(s.ctx, s.session)
+}
+
+func (s *BackchannelLogoutServiceTestSuite) TestOnSessionTerminated_TokenGenerationError() {
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-1").Return(
+		&inboundmodel.OAuthClient{ClientID: "client-1", BackchannelLogoutURI: testLogoutURI}, nil)
+	s.jwtMock.EXPECT().GenerateJWT(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return("", int64(0), &serviceerror.InternalServerError)
+
+	s.service.OnSessionTerminated(s.ctx, s.session)
+}
+
+func (s *BackchannelLogoutServiceTestSuite) TestOnSessionTerminated_StoreError() {
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, "client-1").Return(
+		&inboundmodel.OAuthClient{ClientID: "client-1", BackchannelLogoutURI: testLogoutURI}, nil)
+	s.jwtMock.EXPECT().GenerateJWT(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return("logout-token", int64(0), nil)
+	s.storeMock.EXPECT().CreateDelivery(mock.Anything, mock.Anything).Return(errors.New("db err"))
+
+	s.service.OnSessionTerminated(s.ctx, s.session)
+
+	s.queueMock.AssertNotCalled(s.T(), "Enqueue", mock.Anything)
+}
+
+func (s *BackchannelLogoutServiceTestSuite) TestListDeliveries() {
+	deliveries := []LogoutDelivery{getTestDelivery()}
+	s.storeMock.EXPECT().ListDeliveries(mock.Anything, "app-1", 20).Return(deliveries, nil)
+
+	result, svcErr := s.service.ListDeliveries(s.ctx, "app-1", 20)
+
+	s.Nil(svcErr)
+	s.Equal(deliveries, result)
+}
+
+func (s *BackchannelLogoutServiceTestSuite) TestListDeliveries_LimitCapped() {
+	s.storeMock.EXPECT().ListDeliveries(mock.Anything, "app-1", maxDeliveryLimit).Return(nil, nil)
+
+	_, svcErr := s.service.ListDeliveries(s.ctx, "app-1", 1000)
+
+	s.Nil(svcErr)
+}
+
+func (s *BackchannelLogoutServiceTestSuite) TestListDeliveries_InvalidLimit() {
+	result, svcErr := s.service.ListDeliveries(s.ctx, "app-1", 0)
+
+	s.Nil(result)
+	s.Equal(ErrorInvalidLimit.Code, svcErr.Code)
+}
+
+func (s *BackchannelLogoutServiceTestSuite) TestListDeliveries_StoreError() {
+	s.storeMock.EXPECT().ListDeliveries(mock.Anything, "app-1", 20).Return(nil, errors.New("db err"))
+
+	result, svcErr := s.service.ListDeliveries(s.ctx, "app-1", 20)
+
+	s.Nil(result)
+	s.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
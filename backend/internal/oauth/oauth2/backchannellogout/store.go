@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// deliveryRetention is the duration for which logout token deliveries are retained.
+const deliveryRetention = 7 * 24 * time.Hour
+
+// deliveryStoreInterface defines the interface for logout token delivery storage operations.
+type deliveryStoreInterface interface {
+	// CreateDelivery records a logout token delivery.
+	CreateDelivery(ctx context.Context, delivery LogoutDelivery) error
+
+	// UpdateDelivery records the status, attempts and last error of a logout token delivery.
+	UpdateDelivery(ctx context.Context, delivery LogoutDelivery) error
+
+	// ListDeliveries lists the most recent logout token deliveries of an application.
+	ListDeliveries(ctx context.Context, applicationID string, limit int) ([]LogoutDelivery, error)
+}
+
+// deliveryStore is the runtime database implementation of deliveryStoreInterface.
+type deliveryStore struct {
+	dbProvider   dbprovider.DBProviderInterface
+	deploymentID string
+}
+
+// newDeliveryStore creates a new instance of deliveryStore.
+func newDeliveryStore() deliveryStoreInterface {
+	return &deliveryStore{
+		dbProvider:   dbprovider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateDelivery records a logout token delivery in the database.
+func (s *deliveryStore) CreateDelivery(ctx context.Context, delivery LogoutDelivery) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateDelivery, delivery.ID, delivery.ApplicationID,
+		delivery.ClientID, delivery.SessionID, delivery.LogoutURI, string(delivery.Status), delivery.Attempts,
+		delivery.LastError, delivery.CreatedAt, delivery.UpdatedAt, delivery.CreatedAt.Add(deliveryRetention),
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateDelivery records the outcome of a logout token delivery attempt in the database.
+func (s *deliveryStore) UpdateDelivery(ctx context.Context, delivery LogoutDelivery) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateDelivery, delivery.ID, string(delivery.Status),
+		delivery.Attempts, delivery.LastError, delivery.UpdatedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// ListDeliveries lists the most recent logout token deliveries of an application from the database.
+func (s *deliveryStore) ListDeliveries(ctx context.Context, applicationID string,
+	limit int) ([]LogoutDelivery, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListDeliveries, applicationID, limit, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	deliveries := make([]LogoutDelivery, 0, len(results))
+	for _, row := range results {
+		delivery, err := buildDeliveryFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build logout delivery from result row: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// buildDeliveryFromResultRow constructs a LogoutDelivery from a database result row.
+func buildDeliveryFromResultRow(row map[string]interface{}) (LogoutDelivery, error) {
+	delivery := LogoutDelivery{}
+	var ok bool
+	if delivery.ID, ok = row["id"].(string); !ok {
+		return LogoutDelivery{}, errors.New("failed to parse id as string")
+	}
+	if delivery.ApplicationID, ok = row["application_id"].(string); !ok {
+		return LogoutDelivery{}, errors.New("failed to parse application_id as string")
+	}
+	if delivery.ClientID, ok = row["client_id"].(string); !ok {
+		return LogoutDelivery{}, errors.New("failed to parse client_id as string")
+	}
+	if delivery.SessionID, ok = row["session_id"].(string); !ok {
+		return LogoutDelivery{}, errors.New("failed to parse session_id as string")
+	}
+	if delivery.LogoutURI, ok = row["logout_uri"].(string); !ok {
+		return LogoutDelivery{}, errors.New("failed to parse logout_uri as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return LogoutDelivery{}, errors.New("failed to parse status as string")
+	}
+	delivery.Status = DeliveryStatus(status)
+
+	switch v := row["attempts"].(type) {
+	case int64:
+		delivery.Attempts = int(v)
+	case int:
+		delivery.Attempts = v
+	default:
+		return LogoutDelivery{}, errors.New("failed to parse attempts as integer")
+	}
+
+	// The last error is NULL until a delivery attempt fails.
+	delivery.LastError, _ = row["last_error"].(string)
+
+	var err error
+	if delivery.CreatedAt, err = dbutils.ParseTimeField(row["created_at"], "created_at"); err != nil {
+		return LogoutDelivery{}, err
+	}
+	if delivery.UpdatedAt, err = dbutils.ParseTimeField(row["updated_at"], "updated_at"); err != nil {
+		return LogoutDelivery{}, err
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateDelivery records a logout token delivery.
+	queryCreateDelivery = dbmodel.DBQuery{
+		ID: "BLQ-01",
+		Query: `INSERT INTO "BACKCHANNEL_LOGOUT_DELIVERY" (ID, APPLICATION_ID, CLIENT_ID, SESSION_ID, LOGOUT_URI, ` +
+			`STATUS, ATTEMPTS, LAST_ERROR, CREATED_AT, UPDATED_AT, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+	}
+
+	// queryUpdateDelivery records the outcome of a logout token delivery attempt.
+	queryUpdateDelivery = dbmodel.DBQuery{
+		ID: "BLQ-02",
+		Query: `UPDATE "BACKCHANNEL_LOGOUT_DELIVERY" SET STATUS = $2, ATTEMPTS = $3, LAST_ERROR = $4, ` +
+			`UPDATED_AT = $5 WHERE ID = $1 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryListDeliveries lists the most recent logout token deliveries of an application.
+	queryListDeliveries = dbmodel.DBQuery{
+		ID: "BLQ-03",
+		Query: `SELECT ID, APPLICATION_ID, CLIENT_ID, SESSION_ID, LOGOUT_URI, STATUS, ATTEMPTS, LAST_ERROR, ` +
+			`CREATED_AT, UPDATED_AT FROM "BACKCHANNEL_LOGOUT_DELIVERY" ` +
+			`WHERE APPLICATION_ID = $1 AND DEPLOYMENT_ID = $3 ORDER BY CREATED_AT DESC LIMIT $2`,
+	}
+)
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backchannellogout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment"
+
+type DeliveryStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *deliveryStore
+}
+
+func TestDeliveryStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryStoreTestSuite))
+}
+
+func (suite *DeliveryStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &deliveryStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *DeliveryStoreTestSuite) TestCreateDelivery() {
+	delivery := getTestDelivery()
+
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateDelivery, delivery.ID,
+		delivery.ApplicationID, delivery.ClientID, delivery.SessionID, delivery.LogoutURI,
+		string(DeliveryStatusPending), 0, "", delivery.CreatedAt, delivery.UpdatedAt,
+		delivery.CreatedAt.Add(deliveryRetention), testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.CreateDelivery(context.Background(), delivery)
+	suite.NoError(err)
+}
+
+func (suite *DeliveryStoreTestSuite) TestCreateDelivery_DBClientError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.CreateDelivery(context.Background(), getTestDelivery())
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *DeliveryStoreTestSuite) TestUpdateDelivery() {
+	delivery := getTestDelivery()
+	delivery.Status = DeliveryStatusFailed
+	delivery.Attempts = 2
+	delivery.LastError = "client responded with status 400 Bad Request"
+
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateDelivery, delivery.ID,
+		string(DeliveryStatusFailed), 2, delivery.LastError, delivery.UpdatedAt, testDeploymentID).
+		Return(int64(1), nil).Once()
+
+	err := suite.store.UpdateDelivery(context.Background(), delivery)
+	suite.NoError(err)
+}
+
+func (suite *DeliveryStoreTestSuite) TestUpdateDelivery_ExecuteError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateDelivery, "delivery-1",
+		"", 0, "", time.Time{}, testDeploymentID).Return(int64(0), errors.New("exec err")).Once()
+
+	err := suite.store.UpdateDelivery(context.Background(), LogoutDelivery{ID: "delivery-1"})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to execute query")
+}
+
+func (suite *DeliveryStoreTestSuite) TestListDeliveries() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	rows := []map[string]interface{}{
+		{
+			"id":             "delivery-1",
+			"application_id": "app-1",
+			"client_id":      "client-1",
+			"session_id":     "session-1",
+			"logout_uri":     "https://client.example.com/logout",
+			"status":         "FAILED",
+			"attempts":       int64(5),
+			"last_error":     "client responded with status 503 Service Unavailable",
+			"created_at":     createdAt,
+			"updated_at":     "2026-01-02 03:05:05.123456",
+		},
+	}
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListDeliveries, "app-1", 10,
+		testDeploymentID).Return(rows, nil).Once()
+
+	deliveries, err := suite.store.ListDeliveries(context.Background(), "app-1", 10)
+	suite.NoError(err)
+	suite.Len(deliveries, 1)
+	suite.Equal("delivery-1", deliveries[0].ID)
+	suite.Equal(DeliveryStatusFailed, deliveries[0].Status)
+	suite.Equal(5, deliveries[0].Attempts)
+	suite.Equal(createdAt, deliveries[0].CreatedAt)
+	suite.Equal(time.Date(2026, 1, 2, 3, 5, 5, 123456000, time.UTC), deliveries[0].UpdatedAt)
+}
+
+func (suite *DeliveryStoreTestSuite) TestListDeliveries_InvalidRow() {
+	rows := []map[string]interface{}{{"id": "delivery-1"}}
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListDeliveries, "app-1", 10,
+		testDeploymentID).Return(rows, nil).Once()
+
+	deliveries, err := suite.store.ListDeliveries(context.Background(), "app-1", 10)
+	suite.Error(err)
+	suite.Nil(deliveries)
+}
+
+func (suite *DeliveryStoreTestSuite) TestListDeliveries_QueryError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListDeliveries, "app-1", 10,
+		testDeploymentID).Return(nil, errors.New("query err")).Once()
+
+	deliveries, err := suite.store.ListDeliveries(context.Background(), "app-1", 10)
+	suite.Error(err)
+	suite.Nil(deliveries)
+}
+
+func getTestDelivery() LogoutDelivery {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return LogoutDelivery{
+		ID:            "delivery-1",
+		ApplicationID: "app-1",
+		ClientID:      "client-1",
+		SessionID:     "session-1",
+		LogoutURI:     "https://client.example.com/logout",
+		Status:        DeliveryStatusPending,
+		CreatedAt:     createdAt,
+		UpdatedAt:     createdAt,
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// cibaHandlerInterface defines the interface for handling backchannel authentication requests.
+type cibaHandlerInterface interface {
+	HandleBackchannelAuthRequest(w http.ResponseWriter, r *http.Request)
+}
+
+// cibaHandler implements cibaHandlerInterface.
+type cibaHandler struct {
+	cibaService CIBAServiceInterface
+	logger      *log.Logger
+}
+
+// newCIBAHandler creates a new CIBA handler instance.
+func newCIBAHandler(cibaService CIBAServiceInterface) cibaHandlerInterface {
+	return &cibaHandler{
+		cibaService: cibaService,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CIBAHandler")),
+	}
+}
+
+// HandleBackchannelAuthRequest handles the POST /oauth2/bc-authorize request.
+func (h *cibaHandler) HandleBackchannelAuthRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Client authentication is handled by the ClientAuthMiddleware.
+	clientInfo := clientauth.GetOAuthClient(ctx)
+	if clientInfo == nil {
+		h.logger.Error("OAuth client not found in context - ClientAuthMiddleware must be applied")
+		utils.WriteJSONError(w, oauth2const.ErrorServerError,
+			"Something went wrong", http.StatusInternalServerError, nil)
+		return
+	}
+
+	// Parse form-encoded body.
+	if err := r.ParseForm(); err != nil {
+		utils.WriteJSONError(w, oauth2const.ErrorInvalidRequest, "Failed to parse request body",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	params := make(map[string]string)
+	for key, values := range r.PostForm {
+		if len(values) > 0 {
+			params[key] = values[0]
+		}
+	}
+
+	resp, errCode, errDesc := h.cibaService.HandleBackchannelAuthRequest(
+		ctx, params, r.PostForm[oauth2const.RequestParamResource], clientInfo.OAuthApp)
+	if errCode != "" {
+		statusCode := http.StatusBadRequest
+		switch errCode {
+		case oauth2const.ErrorUnauthorizedClient:
+			statusCode = http.StatusForbidden
+		case oauth2const.ErrorServerError:
+			h.logger.Error("Internal server error processing backchannel authentication request",
+				log.MaskedString("clientID", clientInfo.ClientID),
+				log.String("errorCode", errCode),
+				log.String("errorDescription", errDesc),
+			)
+			statusCode = http.StatusInternalServerError
+		}
+		utils.WriteJSONError(w, errCode, errDesc, statusCode, nil)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, resp)
+}
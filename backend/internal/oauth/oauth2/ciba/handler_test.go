@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	testConfig := &config.Config{}
+	_ = config.InitializeServerRuntime("", testConfig)
+}
+
+func (s *HandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *HandlerTestSuite) newAuthenticatedRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/bc-authorize", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	clientInfo := &clientauth.OAuthClientInfo{
+		ClientID: testClientID,
+		OAuthApp: &inboundmodel.OAuthClient{ClientID: testClientID},
+	}
+	ctx := context.WithValue(req.Context(), clientauth.OAuthClientKey, clientInfo)
+	return req.WithContext(ctx)
+}
+
+func (s *HandlerTestSuite) TestHandleBackchannelAuthRequest_Success() {
+	svc := NewCIBAServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleBackchannelAuthRequest(mock.Anything, mock.MatchedBy(func(p map[string]string) bool {
+		return p[oauth2const.RequestParamLoginHint] == "alice" && p[oauth2const.RequestParamScope] == "openid"
+	}), []string(nil), mock.Anything).Return(&BackchannelAuthResponse{
+		AuthReqID: testAuthReqID,
+		ExpiresIn: 300,
+		Interval:  5,
+	}, "", "")
+	handler := newCIBAHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.HandleBackchannelAuthRequest(rec, s.newAuthenticatedRequest("scope=openid&login_hint=alice"))
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+
+	var resp BackchannelAuthResponse
+	err := json.NewDecoder(rec.Body).Decode(&resp)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), testAuthReqID, resp.AuthReqID)
+	assert.Equal(s.T(), int64(300), resp.ExpiresIn)
+	assert.Equal(s.T(), int64(5), resp.Interval)
+}
+
+func (s *HandlerTestSuite) TestHandleBackchannelAuthRequest_PassesAllResources() {
+	svc := NewCIBAServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleBackchannelAuthRequest(mock.Anything, mock.Anything,
+		[]string{"https://api.example.com/a", "https://api.example.com/b"}, mock.Anything).
+		Return(&BackchannelAuthResponse{AuthReqID: testAuthReqID, ExpiresIn: 300, Interval: 5}, "", "")
+	handler := newCIBAHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.HandleBackchannelAuthRequest(rec, s.newAuthenticatedRequest(
+		"scope=openid&login_hint=alice&resource=https://api.example.com/a&resource=https://api.example.com/b"))
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleBackchannelAuthRequest_NoClientAuth() {
+	svc := NewCIBAServiceInterfaceMock(s.T())
+	handler := newCIBAHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/bc-authorize", strings.NewReader(""))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	handler.HandleBackchannelAuthRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleBackchannelAuthRequest_Errors() {
+	testCases := []struct {
+		name           string
+		errCode        string
+		expectedStatus int
+	}{
+		{"InvalidRequest", oauth2const.ErrorInvalidRequest, http.StatusBadRequest},
+		{"UnknownUser", oauth2const.ErrorUnknownUserID, http.StatusBadRequest},
+		{"UnauthorizedClient", oauth2const.ErrorUnauthorizedClient, http.StatusForbidden},
+		{"ServerError", oauth2const.ErrorServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			svc := NewCIBAServiceInterfaceMock(s.T())
+			svc.EXPECT().HandleBackchannelAuthRequest(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, tc.errCode, "error description")
+			handler := newCIBAHandler(svc)
+
+			rec := httptest.NewRecorder()
+			handler.HandleBackchannelAuthRequest(rec, s.newAuthenticatedRequest("login_hint=alice"))
+
+			assert.Equal(s.T(), tc.expectedStatus, rec.Code)
+
+			var resp map[string]string
+			assert.NoError(s.T(), json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(s.T(), tc.errCode, resp["error"])
+		})
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"net/http"
+
+	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/notification"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the CIBA handler and registers its routes.
+// Returns the CIBAServiceInterface so the token endpoint can redeem auth_req_id values.
+func Initialize(
+	mux *http.ServeMux,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	notifSenderSvc notification.NotificationSenderServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+	resourceService resource.ResourceServiceInterface,
+) CIBAServiceInterface {
+	store := initializeCIBAStore()
+	cibaSvc := newCIBAService(store, flowExecService, jwtService, entityProvider, notifSenderSvc, httpClient,
+		resourceService)
+	handler := newCIBAHandler(cibaSvc)
+	registerRoutes(mux, handler, inboundClient, authnProvider, jwtService, discoveryService)
+	authz.RegisterAuthCallbackHandler(authIDPrefix, cibaSvc.HandleAuthCallback)
+	return cibaSvc
+}
+
+// initializeCIBAStore selects the CIBA store implementation based on the configured runtime DB type.
+func initializeCIBAStore() cibaStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisCIBARequestStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newCIBARequestStore(deploymentID)
+}
+
+// registerRoutes registers the backchannel authentication endpoint route with client authentication middleware.
+func registerRoutes(
+	mux *http.ServeMux,
+	handler cibaHandlerInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+) {
+	corsOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	metadata := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+	endpointURL := metadata.BackchannelAuthenticationEndpoint
+	clientAuthMiddleware := clientauth.ClientAuthMiddleware(inboundClient, authnProvider, jwtService, endpointURL)
+	wrappedHandler := clientAuthMiddleware(http.HandlerFunc(handler.HandleBackchannelAuthRequest))
+
+	pattern, corsHandler := middleware.WithCORS(
+		"POST /oauth2/bc-authorize",
+		wrappedHandler.ServeHTTP,
+		corsOpts,
+	)
+
+	mux.HandleFunc(pattern, corsHandler)
+}
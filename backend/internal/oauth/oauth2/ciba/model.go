@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import "time"
+
+// requestStatus represents the status of a backchannel authentication request.
+type requestStatus string
+
+const (
+	// requestStatusPending indicates that the end user has not yet completed authentication.
+	requestStatusPending requestStatus = "PENDING"
+	// requestStatusApproved indicates that the end user authenticated and approved the request.
+	requestStatusApproved requestStatus = "APPROVED"
+	// requestStatusDenied indicates that the request was denied or authenticated by another user.
+	requestStatusDenied requestStatus = "DENIED"
+)
+
+// backchannelAuthRequest holds the stored state of a backchannel authentication request.
+type backchannelAuthRequest struct {
+	ClientID                string
+	UserID                  string
+	StandardScopes          []string
+	PermissionScopes        []string
+	Resources               []string
+	BindingMessage          string
+	ClientNotificationToken string
+	NotificationEndpoint    string
+	Status                  requestStatus
+	AuthorizedPermissions   string
+	AttributeCacheID        string
+	CompletedACR            string
+	CompletedAMR            string
+	AuthTime                time.Time
+	Interval                int64
+	LastPolledAt            time.Time
+	ExpiryTime              time.Time
+}
+
+// BackchannelAuthResponse represents the backchannel authentication endpoint success response.
+type BackchannelAuthResponse struct {
+	AuthReqID string `json:"auth_req_id"`
+	ExpiresIn int64  `json:"expires_in"`
+	Interval  int64  `json:"interval"`
+}
+
+// pingNotification represents the body of the ping callback sent to the client notification endpoint.
+type pingNotification struct {
+	AuthReqID string `json:"auth_req_id"`
+}
+
+// AuthenticationResult holds the outcome of an approved backchannel authentication request.
+type AuthenticationResult struct {
+	UserID           string
+	Scopes           []string
+	Resources        []string
+	AttributeCacheID string
+	CompletedACR     string
+	CompletedAMR     string
+	AuthTime         time.Time
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// cibaRedisClient abstracts the Redis commands used by the CIBA store.
+type cibaRedisClient interface {
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	SetXX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+}
+
+// redisCIBARequestStore is the Redis-backed implementation of cibaStoreInterface.
+type redisCIBARequestStore struct {
+	client       cibaRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisCIBARequestStore creates a new Redis-backed backchannel authentication request store.
+func newRedisCIBARequestStore(
+	p provider.RedisProviderInterface, deploymentID string,
+) cibaStoreInterface {
+	return &redisCIBARequestStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// cibaKey builds the Redis key for an auth_req_id.
+func (s *redisCIBARequestStore) cibaKey(authReqID string) string {
+	return fmt.Sprintf("%s:runtime:%s:ciba:%s", s.keyPrefix, s.deploymentID, authReqID)
+}
+
+// Store persists a backchannel authentication request in Redis with a TTL.
+func (s *redisCIBARequestStore) Store(
+	ctx context.Context, request backchannelAuthRequest, expirySeconds int64,
+) (string, error) {
+	authReqID, err := generateAuthReqID()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate auth_req_id: %w", err)
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal CIBA request: %w", err)
+	}
+
+	ttl := time.Duration(expirySeconds) * time.Second
+	if err := s.client.Set(ctx, s.cibaKey(authReqID), data, ttl).Err(); err != nil {
+		return "", fmt.Errorf("failed to store CIBA request in Redis: %w", err)
+	}
+
+	return authReqID, nil
+}
+
+// Get retrieves a backchannel authentication request from Redis.
+func (s *redisCIBARequestStore) Get(ctx context.Context, authReqID string) (backchannelAuthRequest, bool, error) {
+	data, err := s.client.Get(ctx, s.cibaKey(authReqID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return backchannelAuthRequest{}, false, nil
+		}
+		return backchannelAuthRequest{}, false, fmt.Errorf("failed to get CIBA request from Redis: %w", err)
+	}
+
+	var request backchannelAuthRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return backchannelAuthRequest{}, false, fmt.Errorf("failed to unmarshal CIBA request: %w", err)
+	}
+	return request, true, nil
+}
+
+// Update replaces the stored state of a backchannel authentication request, keeping its TTL.
+func (s *redisCIBARequestStore) Update(ctx context.Context, authReqID string, request backchannelAuthRequest) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal CIBA request: %w", err)
+	}
+
+	if err := s.client.SetXX(ctx, s.cibaKey(authReqID), data, redis.KeepTTL).Err(); err != nil {
+		return fmt.Errorf("failed to update CIBA request in Redis: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a backchannel authentication request from Redis. Returns whether a request was deleted.
+func (s *redisCIBARequestStore) Delete(ctx context.Context, authReqID string) (bool, error) {
+	deleted, err := s.client.Del(ctx, s.cibaKey(authReqID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete CIBA request from Redis: %w", err)
+	}
+	return deleted > 0, nil
+}
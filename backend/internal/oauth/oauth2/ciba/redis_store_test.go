@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment-id"
+)
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *cibaRedisClientMock
+	store      *redisCIBARequestStore
+	ctx        context.Context
+	testReq    backchannelAuthRequest
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newCibaRedisClientMock(s.T())
+	s.store = &redisCIBARequestStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testReq = backchannelAuthRequest{
+		ClientID:       "test-client",
+		UserID:         "user-1",
+		StandardScopes: []string{"openid"},
+		Status:         requestStatusPending,
+		Interval:       5,
+	}
+}
+
+func (s *RedisStoreTestSuite) buildRedisKey(authReqID string) string {
+	return fmt.Sprintf("%s:runtime:%s:ciba:%s", redisTestKeyPrefix, redisTestDeploymentID, authReqID)
+}
+
+func (s *RedisStoreTestSuite) TestCIBAKey() {
+	s.Equal(s.buildRedisKey(testAuthReqID), s.store.cibaKey(testAuthReqID))
+}
+
+func (s *RedisStoreTestSuite) TestStore_Success() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetVal("OK")
+	s.mockClient.EXPECT().Set(s.ctx,
+		mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, fmt.Sprintf("%s:runtime:%s:ciba:", redisTestKeyPrefix,
+				redisTestDeploymentID))
+		}),
+		mock.Anything, 300*time.Second,
+	).Return(cmd)
+
+	authReqID, err := s.store.Store(s.ctx, s.testReq, int64(300))
+
+	s.NoError(err)
+	s.NotEmpty(authReqID)
+}
+
+func (s *RedisStoreTestSuite) TestStore_SetError() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.EXPECT().Set(s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(cmd)
+
+	authReqID, err := s.store.Store(s.ctx, s.testReq, int64(300))
+
+	s.Error(err)
+	s.Empty(authReqID)
+}
+
+func (s *RedisStoreTestSuite) TestGet_Success() {
+	data, _ := json.Marshal(s.testReq)
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetVal(string(data))
+	s.mockClient.EXPECT().Get(s.ctx, s.buildRedisKey(testAuthReqID)).Return(cmd)
+
+	request, found, err := s.store.Get(s.ctx, testAuthReqID)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal(s.testReq.ClientID, request.ClientID)
+	s.Equal(s.testReq.UserID, request.UserID)
+}
+
+func (s *RedisStoreTestSuite) TestGet_NotFound() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetErr(redis.Nil)
+	s.mockClient.EXPECT().Get(s.ctx, s.buildRedisKey(testAuthReqID)).Return(cmd)
+
+	_, found, err := s.store.Get(s.ctx, testAuthReqID)
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestGet_Error() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.EXPECT().Get(s.ctx, s.buildRedisKey(testAuthReqID)).Return(cmd)
+
+	_, found, err := s.store.Get(s.ctx, testAuthReqID)
+
+	s.Error(err)
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestGet_InvalidJSON() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetVal("not-json")
+	s.mockClient.EXPECT().Get(s.ctx, s.buildRedisKey(testAuthReqID)).Return(cmd)
+
+	_, found, err := s.store.Get(s.ctx, testAuthReqID)
+
+	s.Error(err)
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestUpdate_KeepsTTL() {
+	cmd := redis.NewBoolCmd(s.ctx)
+	cmd.SetVal(true)
+	s.mockClient.EXPECT().SetXX(s.ctx, s.buildRedisKey(testAuthReqID), mock.Anything, time.Duration(redis.KeepTTL)).
+		Return(cmd)
+
+	err := s.store.Update(s.ctx, testAuthReqID, s.testReq)
+
+	s.NoError(err)
+}
+
+func (s *RedisStoreTestSuite) TestUpdate_Error() {
+	cmd := redis.NewBoolCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.EXPECT().SetXX(s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(cmd)
+
+	err := s.store.Update(s.ctx, testAuthReqID, s.testReq)
+
+	s.Error(err)
+}
+
+func (s *RedisStoreTestSuite) TestDelete() {
+	cmd := redis.NewIntCmd(s.ctx)
+	cmd.SetVal(1)
+	s.mockClient.EXPECT().Del(s.ctx, s.buildRedisKey(testAuthReqID)).Return(cmd)
+
+	deleted, err := s.store.Delete(s.ctx, testAuthReqID)
+
+	s.NoError(err)
+	s.True(deleted)
+}
+
+func (s *RedisStoreTestSuite) TestDelete_AlreadyDeleted() {
+	cmd := redis.NewIntCmd(s.ctx)
+	cmd.SetVal(0)
+	s.mockClient.EXPECT().Del(s.ctx, s.buildRedisKey(testAuthReqID)).Return(cmd)
+
+	deleted, err := s.store.Delete(s.ctx, testAuthReqID)
+
+	s.NoError(err)
+	s.False(deleted)
+}
+
+func (s *RedisStoreTestSuite) TestDelete_Error() {
+	cmd := redis.NewIntCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.EXPECT().Del(s.ctx, s.buildRedisKey(testAuthReqID)).Return(cmd)
+
+	deleted, err := s.store.Delete(s.ctx, testAuthReqID)
+
+	s.Error(err)
+	s.False(deleted)
+}
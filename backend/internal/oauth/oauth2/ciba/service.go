@@ -0,0 +1,526 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package ciba implements OpenID Connect Client-Initiated Backchannel Authentication (CIBA).
+package ciba
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/notification"
+	notifcommon "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz/requestvalidator"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// authIDPrefix is the prefix of the auth IDs of the login flows initiated for backchannel authentication
+// requests. It routes the auth callbacks of those flows to this package.
+const authIDPrefix = "ciba-"
+
+// slowDownIntervalIncrement is the number of seconds added to the polling interval of a client that polls
+// faster than allowed.
+const slowDownIntervalIncrement = 5
+
+// maxClientNotificationTokenLength is the maximum allowed length of the client notification token.
+const maxClientNotificationTokenLength = 1024
+
+// loginHintAttributes lists the user attributes matched against the login_hint, in order of precedence.
+var loginHintAttributes = []string{"username", flowcm.AttributeEmail, flowcm.AttributeMobileNumber}
+
+// CIBAServiceInterface defines the interface for the CIBA service.
+type CIBAServiceInterface interface {
+	HandleBackchannelAuthRequest(
+		ctx context.Context, params map[string]string, resources []string, oauthApp *inboundmodel.OAuthClient,
+	) (*BackchannelAuthResponse, string, string)
+	HandleAuthCallback(ctx context.Context, authID, assertion string) (string, error)
+	PollAuthenticationResult(
+		ctx context.Context, authReqID string, clientID string,
+	) (*AuthenticationResult, *oauth2model.ErrorResponse)
+}
+
+// cibaService implements CIBAServiceInterface.
+type cibaService struct {
+	store           cibaStoreInterface
+	flowExecService flowexec.FlowExecServiceInterface
+	jwtService      jwt.JWTServiceInterface
+	entityProvider  entityprovider.EntityProviderInterface
+	notifSenderSvc  notification.NotificationSenderServiceInterface
+	httpClient      syshttp.HTTPClientInterface
+	resourceService resource.ResourceServiceInterface
+	logger          *log.Logger
+}
+
+// newCIBAService creates a new CIBA service instance.
+func newCIBAService(
+	store cibaStoreInterface,
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	notifSenderSvc notification.NotificationSenderServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+	resourceService resource.ResourceServiceInterface,
+) CIBAServiceInterface {
+	return &cibaService{
+		store:           store,
+		flowExecService: flowExecService,
+		jwtService:      jwtService,
+		entityProvider:  entityProvider,
+		notifSenderSvc:  notifSenderSvc,
+		httpClient:      httpClient,
+		resourceService: resourceService,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CIBAService")),
+	}
+}
+
+// HandleBackchannelAuthRequest validates a backchannel authentication request, starts the login flow of the
+// end user identified by the login hint and notifies the user's devices to complete it. The resources are the
+// RFC 8707 resource indicators the issued access token is restricted to.
+// Returns the response on success, or (errorCode, errorDescription) on failure.
+func (s *cibaService) HandleBackchannelAuthRequest(
+	ctx context.Context, params map[string]string, resources []string, oauthApp *inboundmodel.OAuthClient,
+) (*BackchannelAuthResponse, string, string) {
+	if !oauthApp.IsAllowedGrantType(oauth2const.GrantTypeCIBA) {
+		return nil, oauth2const.ErrorUnauthorizedClient,
+			"The client is not authorized to use the CIBA grant type"
+	}
+
+	oidcScopes, permissionScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(
+		params[oauth2const.RequestParamScope], oauthApp.ScopeClaims)
+	if !slices.Contains(oidcScopes, oauth2const.ScopeOpenID) {
+		return nil, oauth2const.ErrorInvalidScope, "The openid scope is required"
+	}
+
+	// Downscope the permission scopes to those defined on the targeted Resource Servers, as done at the
+	// authorization endpoint.
+	if errResp := resourceindicators.ValidateResourceURIs(resources); errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+	_, permissionScopes, errResp := resourceindicators.ResolveAndDownscope(
+		ctx, s.resourceService, resources, permissionScopes)
+	if errResp != nil {
+		return nil, errResp.Error, errResp.ErrorDescription
+	}
+
+	loginHint := params[oauth2const.RequestParamLoginHint]
+	if loginHint == "" {
+		return nil, oauth2const.ErrorInvalidRequest, "login_hint is required"
+	}
+
+	deliveryMode := oauthApp.BackchannelAuthentication.GetTokenDeliveryMode()
+	notificationToken := params[oauth2const.RequestParamClientNotificationToken]
+	if deliveryMode == oauth2const.BackchannelTokenDeliveryModePing && notificationToken == "" {
+		return nil, oauth2const.ErrorInvalidRequest,
+			"client_notification_token is required for the ping token delivery mode"
+	}
+	if len(notificationToken) > maxClientNotificationTokenLength {
+		return nil, oauth2const.ErrorInvalidRequest, "client_notification_token is too long"
+	}
+
+	cibaConfig := config.GetServerRuntime().Config.OAuth.CIBA
+	expiresIn := cibaConfig.ExpiresIn
+	if requestedExpiry := params[oauth2const.RequestParamRequestedExpiry]; requestedExpiry != "" {
+		value, err := strconv.ParseInt(requestedExpiry, 10, 64)
+		if err != nil || value <= 0 {
+			return nil, oauth2const.ErrorInvalidRequest, "requested_expiry must be a positive integer"
+		}
+		expiresIn = min(value, cibaConfig.ExpiresIn)
+	}
+
+	userID, errCode, errDesc := s.resolveLoginHint(loginHint)
+	if errCode != "" {
+		return nil, errCode, errDesc
+	}
+
+	bindingMessage := params[oauth2const.RequestParamBindingMessage]
+	request := backchannelAuthRequest{
+		ClientID:                oauthApp.ClientID,
+		UserID:                  userID,
+		StandardScopes:          oidcScopes,
+		PermissionScopes:        permissionScopes,
+		Resources:               resources,
+		BindingMessage:          bindingMessage,
+		ClientNotificationToken: notificationToken,
+		Status:                  requestStatusPending,
+		Interval:                cibaConfig.Interval,
+		ExpiryTime:              time.Now().UTC().Add(time.Duration(expiresIn) * time.Second),
+	}
+	if deliveryMode == oauth2const.BackchannelTokenDeliveryModePing {
+		request.NotificationEndpoint = oauthApp.BackchannelAuthentication.ClientNotificationEndpoint
+	}
+
+	executionID, errCode, errDesc := s.initiateFlow(ctx, oauthApp, request, params, expiresIn)
+	if errCode != "" {
+		return nil, errCode, errDesc
+	}
+
+	authReqID, err := s.store.Store(ctx, request, expiresIn)
+	if err != nil {
+		s.logger.Error("Failed to store backchannel authentication request", log.Error(err))
+		return nil, oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+	}
+
+	if errCode, errDesc := s.notifyUser(ctx, oauthApp, authReqID, executionID, request); errCode != "" {
+		if _, err := s.store.Delete(ctx, authReqID); err != nil {
+			s.logger.Error("Failed to delete backchannel authentication request", log.Error(err))
+		}
+		return nil, errCode, errDesc
+	}
+
+	return &BackchannelAuthResponse{
+		AuthReqID: authReqID,
+		ExpiresIn: expiresIn,
+		Interval:  request.Interval,
+	}, "", ""
+}
+
+// resolveLoginHint identifies the user referred to by the login hint.
+func (s *cibaService) resolveLoginHint(loginHint string) (string, string, string) {
+	for _, attribute := range loginHintAttributes {
+		userID, providerErr := s.entityProvider.IdentifyEntity(map[string]interface{}{attribute: loginHint})
+		if providerErr != nil {
+			if providerErr.Code == entityprovider.ErrorCodeEntityNotFound ||
+				providerErr.Code == entityprovider.ErrorCodeAmbiguousEntity {
+				continue
+			}
+			s.logger.Error("Failed to identify the user of the login hint",
+				log.String("errorCode", string(providerErr.Code)))
+			return "", oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+		}
+		if userID != nil && *userID != "" {
+			return *userID, "", ""
+		}
+	}
+	return "", oauth2const.ErrorUnknownUserID, "The user identified by the login_hint is unknown"
+}
+
+// initiateFlow starts the authentication flow through which the end user completes the request.
+func (s *cibaService) initiateFlow(ctx context.Context, oauthApp *inboundmodel.OAuthClient,
+	request backchannelAuthRequest, params map[string]string, expiresIn int64) (string, string, string) {
+	essentialAttributes, optionalAttributes := authz.GetRequiredUserAttributes(
+		request.StandardScopes, nil, oauthApp)
+
+	cacheTTL := resolveUserAttributesCacheTTL(oauthApp, expiresIn)
+	runtimeData := map[string]string{
+		flowcm.RuntimeKeyClientID:                      oauthApp.ClientID,
+		flowcm.RuntimeKeyRequestedPermissions:          utils.StringifyStringArray(request.PermissionScopes, " "),
+		flowcm.RuntimeKeyRequiredEssentialAttributes:   essentialAttributes,
+		flowcm.RuntimeKeyRequiredOptionalAttributes:    optionalAttributes,
+		flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: fmt.Sprintf("%d", cacheTTL),
+	}
+	acrValues := requestvalidator.ResolveACRValues(params[oauth2const.RequestParamAcrValues], oauthApp.AcrValues)
+	if acrValues != "" {
+		runtimeData[flowcm.RuntimeKeyRequestedAuthClasses] = acrValues
+	}
+
+	executionID, flowErr := s.flowExecService.InitiateFlow(ctx, &flowexec.FlowInitContext{
+		ApplicationID: oauthApp.ID,
+		FlowType:      string(flowcm.FlowTypeAuthentication),
+		RuntimeData:   runtimeData,
+	})
+	if flowErr != nil {
+		s.logger.Error("Failed to initiate authentication flow", log.String("error_code", flowErr.Code))
+		return "", oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+	}
+	return executionID, "", ""
+}
+
+// notifyUser sends a push notification to the devices of the end user with the link to the login flow.
+func (s *cibaService) notifyUser(ctx context.Context, oauthApp *inboundmodel.OAuthClient, authReqID,
+	executionID string, request backchannelAuthRequest) (string, string) {
+	loginURL, err := oauth2utils.GetURIWithQueryParams(
+		config.GetGateClientURL(ctx, config.GetServerRuntime().Config.GateClient.LoginPath),
+		map[string]string{
+			oauth2const.AuthID:      authIDPrefix + authReqID,
+			oauth2const.AppID:       oauthApp.ID,
+			oauth2const.ExecutionID: executionID,
+		})
+	if err != nil {
+		s.logger.Error("Failed to build the login URL", log.Error(err))
+		return oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+	}
+
+	body := request.BindingMessage
+	if body == "" {
+		body = "An application is requesting you to sign in"
+	}
+	svcErr := s.notifSenderSvc.SendPush(ctx, request.UserID, notifcommon.PushMessage{
+		Title: "Sign-in request",
+		Body:  body,
+		Data: map[string]string{
+			"loginUrl":       loginURL,
+			"bindingMessage": request.BindingMessage,
+		},
+	})
+	if svcErr != nil {
+		if svcErr.Code == notification.ErrorNoPushDevices.Code {
+			return oauth2const.ErrorInvalidRequest, "The user has no registered device to approve the request"
+		}
+		s.logger.Error("Failed to notify the user of the backchannel authentication request",
+			log.String("error_code", svcErr.Code))
+		return oauth2const.ErrorServerError, "Failed to process backchannel authentication request"
+	}
+	return "", ""
+}
+
+// HandleAuthCallback completes a backchannel authentication request with the assertion issued by the login
+// flow. The returned URI lands the end user on the gate once the request is completed.
+func (s *cibaService) HandleAuthCallback(ctx context.Context, authID, assertion string) (string, error) {
+	authReqID := strings.TrimPrefix(authID, authIDPrefix)
+	request, found, err := s.store.Get(ctx, authReqID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the backchannel authentication request: %w", err)
+	}
+	if !found || request.Status != requestStatusPending || time.Now().UTC().After(request.ExpiryTime) {
+		return "", errors.New("backchannel authentication request not found, expired, or already completed")
+	}
+
+	claims, err := s.decodeAssertion(assertion)
+	if err != nil {
+		return "", err
+	}
+
+	if claims.userID != request.UserID {
+		s.logger.Debug("Authenticated user does not match the user of the login hint")
+		request.Status = requestStatusDenied
+	} else {
+		request.Status = requestStatusApproved
+		request.AuthorizedPermissions = claims.authorizedPermissions
+		request.AttributeCacheID = claims.attributeCacheID
+		request.CompletedACR = claims.completedACR
+		request.CompletedAMR = claims.completedAMR
+		request.AuthTime = claims.authTime
+	}
+
+	if err := s.store.Update(ctx, authReqID, request); err != nil {
+		return "", fmt.Errorf("failed to update the backchannel authentication request: %w", err)
+	}
+	if request.NotificationEndpoint != "" {
+		s.sendPingNotification(ctx, authReqID, request)
+	}
+
+	if request.Status == requestStatusDenied {
+		return "", errors.New("authenticated user does not match the user of the login hint")
+	}
+	return config.GetGateClientURL(ctx, config.GetServerRuntime().Config.GateClient.Path), nil
+}
+
+// assertionClaims holds the claims of the flow assertion used to complete a backchannel authentication request.
+type assertionClaims struct {
+	userID                string
+	authorizedPermissions string
+	attributeCacheID      string
+	completedACR          string
+	completedAMR          string
+	authTime              time.Time
+}
+
+// decodeAssertion verifies the flow assertion and extracts the claims of the authenticated user.
+func (s *cibaService) decodeAssertion(assertion string) (*assertionClaims, error) {
+	if assertion == "" {
+		return nil, errors.New("assertion is empty")
+	}
+	if svcErr := s.jwtService.VerifyJWT(assertion, "", ""); svcErr != nil {
+		return nil, fmt.Errorf("invalid assertion: %s", svcErr.Error.DefaultValue)
+	}
+	_, payload, err := jwt.DecodeJWT(assertion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the assertion: %w", err)
+	}
+
+	claims := &assertionClaims{authTime: time.Now().UTC()}
+	claims.userID, _ = payload[oauth2const.ClaimSub].(string)
+	if claims.userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+	claims.authorizedPermissions, _ = payload["authorized_permissions"].(string)
+	claims.attributeCacheID, _ = payload["aci"].(string)
+	claims.completedACR, _ = payload[oauth2const.ClaimCompletedAuthClass].(string)
+	claims.completedAMR, _ = payload[oauth2const.ClaimCompletedAuthMethods].(string)
+	if iat, ok := payload[oauth2const.ClaimIat].(float64); ok {
+		claims.authTime = time.Unix(int64(iat), 0).UTC()
+	}
+	return claims, nil
+}
+
+// sendPingNotification notifies the client that the backchannel authentication request is completed, so that
+// the client can request the tokens. Failures are logged since the client can still poll the token endpoint.
+func (s *cibaService) sendPingNotification(ctx context.Context, authReqID string, request backchannelAuthRequest) {
+	logger := s.logger.With(log.MaskedString("clientID", request.ClientID))
+	if err := syshttp.IsSSRFSafeURL(request.NotificationEndpoint); err != nil {
+		logger.Error("Client notification endpoint is not allowed", log.Error(err))
+		return
+	}
+
+	body, err := json.Marshal(pingNotification{AuthReqID: authReqID})
+	if err != nil {
+		logger.Error("Failed to marshal the ping notification", log.Error(err))
+		return
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.NotificationEndpoint,
+		bytes.NewReader(body))
+	if err != nil {
+		logger.Error("Failed to build the ping notification request", log.Error(err))
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+request.ClientNotificationToken)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		logger.Error("Failed to send the ping notification", log.Error(err))
+		return
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		logger.Error("Client notification endpoint rejected the ping notification",
+			log.Int("statusCode", resp.StatusCode))
+	}
+}
+
+// PollAuthenticationResult returns the outcome of a backchannel authentication request to the token
+// endpoint. Pending requests yield authorization_pending, or slow_down when the client polls faster than the
+// allowed interval. An approved request is consumed so that tokens are issued for it only once.
+func (s *cibaService) PollAuthenticationResult(
+	ctx context.Context, authReqID string, clientID string,
+) (*AuthenticationResult, *oauth2model.ErrorResponse) {
+	request, found, err := s.store.Get(ctx, authReqID)
+	if err != nil {
+		s.logger.Error("Failed to get backchannel authentication request", log.Error(err))
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorServerError,
+			ErrorDescription: "Failed to process token request",
+		}
+	}
+	if !found || request.ClientID != clientID {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorInvalidGrant,
+			ErrorDescription: "Invalid auth_req_id",
+		}
+	}
+
+	now := time.Now().UTC()
+	if now.After(request.ExpiryTime) {
+		s.deleteRequest(ctx, authReqID)
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorExpiredToken,
+			ErrorDescription: "The auth_req_id has expired",
+		}
+	}
+
+	switch request.Status {
+	case requestStatusDenied:
+		s.deleteRequest(ctx, authReqID)
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorAccessDenied,
+			ErrorDescription: "The end user denied the authorization request",
+		}
+	case requestStatusApproved:
+		deleted, err := s.store.Delete(ctx, authReqID)
+		if err != nil {
+			s.logger.Error("Failed to delete backchannel authentication request", log.Error(err))
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorServerError,
+				ErrorDescription: "Failed to process token request",
+			}
+		}
+		// Another token request raced us to the delete; treat as already consumed.
+		if !deleted {
+			return nil, &oauth2model.ErrorResponse{
+				Error:            oauth2const.ErrorInvalidGrant,
+				ErrorDescription: "Invalid auth_req_id",
+			}
+		}
+		return &AuthenticationResult{
+			UserID:           request.UserID,
+			Scopes:           append(request.StandardScopes, strings.Fields(request.AuthorizedPermissions)...),
+			Resources:        request.Resources,
+			AttributeCacheID: request.AttributeCacheID,
+			CompletedACR:     request.CompletedACR,
+			CompletedAMR:     request.CompletedAMR,
+			AuthTime:         request.AuthTime,
+		}, nil
+	}
+
+	errResp := &oauth2model.ErrorResponse{
+		Error:            oauth2const.ErrorAuthorizationPending,
+		ErrorDescription: "The end user has not yet completed authentication",
+	}
+	if !request.LastPolledAt.IsZero() && now.Before(request.LastPolledAt.Add(
+		time.Duration(request.Interval)*time.Second)) {
+		request.Interval += slowDownIntervalIncrement
+		errResp = &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorSlowDown,
+			ErrorDescription: "The client is polling too frequently",
+		}
+	}
+	request.LastPolledAt = now
+	if err := s.store.Update(ctx, authReqID, request); err != nil {
+		s.logger.Error("Failed to update backchannel authentication request", log.Error(err))
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorServerError,
+			ErrorDescription: "Failed to process token request",
+		}
+	}
+	return nil, errResp
+}
+
+// deleteRequest removes a backchannel authentication request that can no longer be used.
+func (s *cibaService) deleteRequest(ctx context.Context, authReqID string) {
+	if _, err := s.store.Delete(ctx, authReqID); err != nil {
+		s.logger.Error("Failed to delete backchannel authentication request", log.Error(err))
+	}
+}
+
+// resolveUserAttributesCacheTTL returns the TTL of the user attributes cached by the login flow. The cache
+// must outlive the request and every token issued for it.
+func resolveUserAttributesCacheTTL(app *inboundmodel.OAuthClient, expiresIn int64) int64 {
+	maxTTL := tokenservice.ResolveTokenConfig(app, tokenservice.TokenTypeAccess).ValidityPeriod
+	if app.IsAllowedGrantType(oauth2const.GrantTypeRefreshToken) {
+		refreshTTL := tokenservice.ResolveTokenConfig(app, tokenservice.TokenTypeRefresh).ValidityPeriod
+		if refreshTTL > maxTTL {
+			maxTTL = refreshTTL
+		}
+	}
+	return maxTTL + expiresIn + oauth2const.AttributeCacheTTLBufferSeconds
+}
@@ -0,0 +1,628 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/notification"
+	notifcommon "github.com/thunder-id/thunderid/internal/notification/common"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/notification/notificationmock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+)
+
+const (
+	testClientID             = "test-client"
+	testAppID                = "test-app-id"
+	testUserID               = "user-1"
+	testExecutionID          = "exec-1"
+	testNotificationEndpoint = "https://client.example.com/ciba/notify"
+	testResourceURI          = "https://api.example.com/orders"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx                context.Context
+	mockStore          *cibaStoreInterfaceMock
+	mockFlowExec       *flowexecmock.FlowExecServiceInterfaceMock
+	mockJWT            *jwtmock.JWTServiceInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockNotifSender    *notificationmock.NotificationSenderServiceInterfaceMock
+	mockHTTPClient     *httpmock.HTTPClientInterfaceMock
+	mockResource       *resourcemock.ResourceServiceInterfaceMock
+	service            *cibaService
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	testConfig := &config.Config{
+		GateClient: config.GateClientConfig{
+			Scheme:    "https",
+			Hostname:  "localhost",
+			Port:      5190,
+			Path:      "/gate",
+			LoginPath: "/login",
+		},
+		OAuth: config.OAuthConfig{
+			CIBA: config.CIBAConfig{
+				ExpiresIn: 300,
+				Interval:  5,
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("", testConfig)
+	s.ctx = context.Background()
+	s.mockStore = newCibaStoreInterfaceMock(s.T())
+	s.mockFlowExec = flowexecmock.NewFlowExecServiceInterfaceMock(s.T())
+	s.mockJWT = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(s.T())
+	s.mockNotifSender = notificationmock.NewNotificationSenderServiceInterfaceMock(s.T())
+	s.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(s.T())
+	s.mockResource = resourcemock.NewResourceServiceInterfaceMock(s.T())
+	s.service = newCIBAService(s.mockStore, s.mockFlowExec, s.mockJWT, s.mockEntityProvider,
+		s.mockNotifSender, s.mockHTTPClient, s.mockResource).(*cibaService)
+}
+
+func (s *ServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *ServiceTestSuite) newTestApp() *inboundmodel.OAuthClient {
+	return &inboundmodel.OAuthClient{
+		ID:         testAppID,
+		ClientID:   testClientID,
+		GrantTypes: []oauth2const.GrantType{oauth2const.GrantTypeCIBA},
+	}
+}
+
+func (s *ServiceTestSuite) newPingApp() *inboundmodel.OAuthClient {
+	app := s.newTestApp()
+	app.BackchannelAuthentication = &inboundmodel.BackchannelAuthConfig{
+		TokenDeliveryMode:          oauth2const.BackchannelTokenDeliveryModePing,
+		ClientNotificationEndpoint: testNotificationEndpoint,
+	}
+	return app
+}
+
+func (s *ServiceTestSuite) newValidParams() map[string]string {
+	return map[string]string{
+		oauth2const.RequestParamScope:          "openid profile read",
+		oauth2const.RequestParamLoginHint:      "alice",
+		oauth2const.RequestParamBindingMessage: "W4SCT",
+	}
+}
+
+func (s *ServiceTestSuite) expectUserResolved() {
+	userID := testUserID
+	s.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "alice"}).
+		Return(&userID, (*entityprovider.EntityProviderError)(nil))
+}
+
+func (s *ServiceTestSuite) expectFlowInitiated() {
+	s.mockFlowExec.On("InitiateFlow", mock.Anything, mock.MatchedBy(func(c *flowexec.FlowInitContext) bool {
+		return c.ApplicationID == testAppID && c.RuntimeData["clientId"] == testClientID
+	})).Return(testExecutionID, (*serviceerror.ServiceError)(nil))
+}
+
+func newTestFlowAssertion(claims map[string]interface{}) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload, _ := json.Marshal(claims)
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func (s *ServiceTestSuite) newPendingRequest() backchannelAuthRequest {
+	return backchannelAuthRequest{
+		ClientID:       testClientID,
+		UserID:         testUserID,
+		StandardScopes: []string{"openid", "profile"},
+		Status:         requestStatusPending,
+		Interval:       5,
+		ExpiryTime:     time.Now().UTC().Add(5 * time.Minute),
+	}
+}
+
+// Tests for HandleBackchannelAuthRequest
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_Success() {
+	s.expectUserResolved()
+	s.expectFlowInitiated()
+	s.mockStore.On("Store", mock.Anything, mock.MatchedBy(func(r backchannelAuthRequest) bool {
+		return r.ClientID == testClientID && r.UserID == testUserID && r.Status == requestStatusPending &&
+			r.BindingMessage == "W4SCT" && assert.ObjectsAreEqual([]string{"read"}, r.PermissionScopes)
+	}), int64(300)).Return(testAuthReqID, nil)
+	s.mockNotifSender.On("SendPush", mock.Anything, testUserID,
+		mock.MatchedBy(func(m notifcommon.PushMessage) bool {
+			return m.Body == "W4SCT" && strings.HasPrefix(m.Data["loginUrl"], "https://localhost:5190/login?") &&
+				strings.Contains(m.Data["loginUrl"], "authId=ciba-"+testAuthReqID)
+		})).Return((*serviceerror.ServiceError)(nil))
+
+	resp, errCode, errDesc := s.service.HandleBackchannelAuthRequest(s.ctx, s.newValidParams(), nil, s.newTestApp())
+
+	s.Empty(errCode, errDesc)
+	s.Require().NotNil(resp)
+	s.Equal(testAuthReqID, resp.AuthReqID)
+	s.Equal(int64(300), resp.ExpiresIn)
+	s.Equal(int64(5), resp.Interval)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_ResourceDownscopesPermissions() {
+	s.expectUserResolved()
+	s.expectFlowInitiated()
+	rs := &resource.ResourceServer{ID: "rs-1", Identifier: testResourceURI}
+	s.mockResource.On("GetResourceServerByIdentifier", mock.Anything, testResourceURI).
+		Return(rs, (*serviceerror.ServiceError)(nil))
+	s.mockResource.On("ValidatePermissions", mock.Anything, "rs-1", []string{"read", "write"}).
+		Return([]string{"write"}, (*serviceerror.ServiceError)(nil))
+	s.mockStore.On("Store", mock.Anything, mock.MatchedBy(func(r backchannelAuthRequest) bool {
+		return assert.ObjectsAreEqual([]string{"read"}, r.PermissionScopes) &&
+			assert.ObjectsAreEqual([]string{testResourceURI}, r.Resources)
+	}), int64(300)).Return(testAuthReqID, nil)
+	s.mockNotifSender.On("SendPush", mock.Anything, testUserID, mock.Anything).
+		Return((*serviceerror.ServiceError)(nil))
+
+	params := s.newValidParams()
+	params[oauth2const.RequestParamScope] = "openid read write"
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, params, []string{testResourceURI},
+		s.newTestApp())
+
+	s.Empty(errCode)
+	s.Require().NotNil(resp)
+	s.Equal(testAuthReqID, resp.AuthReqID)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_InvalidResource() {
+	testCases := []struct {
+		name     string
+		resource string
+		setup    func()
+	}{
+		{name: "RelativeURI", resource: "orders"},
+		{name: "Fragment", resource: testResourceURI + "#frag"},
+		{
+			name:     "UnknownResourceServer",
+			resource: testResourceURI,
+			setup: func() {
+				s.mockResource.On("GetResourceServerByIdentifier", mock.Anything, testResourceURI).
+					Return(nil, &serviceerror.ServiceError{Type: serviceerror.ClientErrorType})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, s.newValidParams(),
+				[]string{tc.resource}, s.newTestApp())
+
+			s.Nil(resp)
+			s.Equal(oauth2const.ErrorInvalidTarget, errCode)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_RequestedExpiryCapped() {
+	testCases := []struct {
+		name            string
+		requestedExpiry string
+		expected        int64
+	}{
+		{"Shorter", "120", 120},
+		{"Longer", "3600", 300},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.expectUserResolved()
+			s.expectFlowInitiated()
+			s.mockStore.On("Store", mock.Anything, mock.Anything, tc.expected).Return(testAuthReqID, nil)
+			s.mockNotifSender.On("SendPush", mock.Anything, testUserID, mock.Anything).
+				Return((*serviceerror.ServiceError)(nil))
+
+			params := s.newValidParams()
+			params[oauth2const.RequestParamRequestedExpiry] = tc.requestedExpiry
+			resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, params, nil, s.newTestApp())
+
+			s.Empty(errCode)
+			s.Equal(tc.expected, resp.ExpiresIn)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_ValidationErrors() {
+	testCases := []struct {
+		name        string
+		app         func() *inboundmodel.OAuthClient
+		params      func(map[string]string)
+		expectedErr string
+	}{
+		{
+			name: "GrantNotAllowed",
+			app: func() *inboundmodel.OAuthClient {
+				app := s.newTestApp()
+				app.GrantTypes = []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode}
+				return app
+			},
+			params:      func(map[string]string) {},
+			expectedErr: oauth2const.ErrorUnauthorizedClient,
+		},
+		{
+			name:        "MissingOpenIDScope",
+			app:         s.newTestApp,
+			params:      func(p map[string]string) { p[oauth2const.RequestParamScope] = "profile" },
+			expectedErr: oauth2const.ErrorInvalidScope,
+		},
+		{
+			name:        "MissingLoginHint",
+			app:         s.newTestApp,
+			params:      func(p map[string]string) { delete(p, oauth2const.RequestParamLoginHint) },
+			expectedErr: oauth2const.ErrorInvalidRequest,
+		},
+		{
+			name:        "PingModeWithoutNotificationToken",
+			app:         s.newPingApp,
+			params:      func(map[string]string) {},
+			expectedErr: oauth2const.ErrorInvalidRequest,
+		},
+		{
+			name:        "InvalidRequestedExpiry",
+			app:         s.newTestApp,
+			params:      func(p map[string]string) { p[oauth2const.RequestParamRequestedExpiry] = "-1" },
+			expectedErr: oauth2const.ErrorInvalidRequest,
+		},
+		{
+			name:        "NonNumericRequestedExpiry",
+			app:         s.newTestApp,
+			params:      func(p map[string]string) { p[oauth2const.RequestParamRequestedExpiry] = "soon" },
+			expectedErr: oauth2const.ErrorInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			params := s.newValidParams()
+			tc.params(params)
+
+			resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, params, nil, tc.app())
+
+			s.Nil(resp)
+			s.Equal(tc.expectedErr, errCode)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_UnknownUser() {
+	s.mockEntityProvider.On("IdentifyEntity", mock.Anything).Return((*string)(nil),
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""))
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, s.newValidParams(), nil, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorUnknownUserID, errCode)
+	s.mockEntityProvider.AssertNumberOfCalls(s.T(), "IdentifyEntity", len(loginHintAttributes))
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_ResolvesByEmail() {
+	userID := testUserID
+	s.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "alice"}).Return((*string)(nil),
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", ""))
+	s.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"email": "alice"}).
+		Return(&userID, (*entityprovider.EntityProviderError)(nil))
+	s.expectFlowInitiated()
+	s.mockStore.On("Store", mock.Anything, mock.MatchedBy(func(r backchannelAuthRequest) bool {
+		return r.UserID == testUserID
+	}), int64(300)).Return(testAuthReqID, nil)
+	s.mockNotifSender.On("SendPush", mock.Anything, testUserID, mock.Anything).
+		Return((*serviceerror.ServiceError)(nil))
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, s.newValidParams(), nil, s.newTestApp())
+
+	s.Empty(errCode)
+	s.NotNil(resp)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_EntityProviderError() {
+	s.mockEntityProvider.On("IdentifyEntity", mock.Anything).Return((*string)(nil),
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "System error", ""))
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, s.newValidParams(), nil, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_FlowInitError() {
+	s.expectUserResolved()
+	s.mockFlowExec.On("InitiateFlow", mock.Anything, mock.Anything).
+		Return("", &serviceerror.ServiceError{Code: "FES-5000"})
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, s.newValidParams(), nil, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_StoreError() {
+	s.expectUserResolved()
+	s.expectFlowInitiated()
+	s.mockStore.On("Store", mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("db error"))
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, s.newValidParams(), nil, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_NoPushDevices() {
+	s.expectUserResolved()
+	s.expectFlowInitiated()
+	s.mockStore.On("Store", mock.Anything, mock.Anything, mock.Anything).Return(testAuthReqID, nil)
+	s.mockNotifSender.On("SendPush", mock.Anything, testUserID, mock.Anything).
+		Return(&notification.ErrorNoPushDevices)
+	s.mockStore.On("Delete", mock.Anything, testAuthReqID).Return(true, nil)
+
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, s.newValidParams(), nil, s.newTestApp())
+
+	s.Nil(resp)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *ServiceTestSuite) TestHandleBackchannelAuthRequest_PingModeStoresEndpoint() {
+	s.expectUserResolved()
+	s.expectFlowInitiated()
+	s.mockStore.On("Store", mock.Anything, mock.MatchedBy(func(r backchannelAuthRequest) bool {
+		return r.NotificationEndpoint == testNotificationEndpoint && r.ClientNotificationToken == "notif-token"
+	}), int64(300)).Return(testAuthReqID, nil)
+	s.mockNotifSender.On("SendPush", mock.Anything, testUserID, mock.Anything).
+		Return((*serviceerror.ServiceError)(nil))
+
+	params := s.newValidParams()
+	params[oauth2const.RequestParamClientNotificationToken] = "notif-token"
+	resp, errCode, _ := s.service.HandleBackchannelAuthRequest(s.ctx, params, nil, s.newPingApp())
+
+	s.Empty(errCode)
+	s.NotNil(resp)
+}
+
+// Tests for HandleAuthCallback
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_Approved() {
+	assertion := newTestFlowAssertion(map[string]interface{}{
+		"sub": testUserID, "aci": "cache-1", "authorized_permissions": "read", "iat": 1700000000,
+	})
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(s.newPendingRequest(), true, nil)
+	s.mockJWT.On("VerifyJWT", assertion, "", "").Return((*serviceerror.ServiceError)(nil))
+	s.mockStore.On("Update", mock.Anything, testAuthReqID, mock.MatchedBy(func(r backchannelAuthRequest) bool {
+		return r.Status == requestStatusApproved && r.AttributeCacheID == "cache-1" &&
+			r.AuthorizedPermissions == "read" && r.AuthTime.Equal(time.Unix(1700000000, 0))
+	})).Return(nil)
+
+	redirectURI, err := s.service.HandleAuthCallback(s.ctx, authIDPrefix+testAuthReqID, assertion)
+
+	s.NoError(err)
+	s.Equal("https://localhost:5190/gate", redirectURI)
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_ApprovedSendsPing() {
+	request := s.newPendingRequest()
+	request.NotificationEndpoint = testNotificationEndpoint
+	request.ClientNotificationToken = "notif-token"
+	assertion := newTestFlowAssertion(map[string]interface{}{"sub": testUserID})
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(request, true, nil)
+	s.mockJWT.On("VerifyJWT", assertion, "", "").Return((*serviceerror.ServiceError)(nil))
+	s.mockStore.On("Update", mock.Anything, testAuthReqID, mock.Anything).Return(nil)
+	s.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.Method == http.MethodPost && req.URL.String() == testNotificationEndpoint &&
+			req.Header.Get("Authorization") == "Bearer notif-token" &&
+			string(body) == `{"auth_req_id":"`+testAuthReqID+`"}`
+	})).Return(&http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil)
+
+	_, err := s.service.HandleAuthCallback(s.ctx, authIDPrefix+testAuthReqID, assertion)
+
+	s.NoError(err)
+	s.mockHTTPClient.AssertExpectations(s.T())
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_UserMismatchDenies() {
+	assertion := newTestFlowAssertion(map[string]interface{}{"sub": "someone-else"})
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(s.newPendingRequest(), true, nil)
+	s.mockJWT.On("VerifyJWT", assertion, "", "").Return((*serviceerror.ServiceError)(nil))
+	s.mockStore.On("Update", mock.Anything, testAuthReqID, mock.MatchedBy(func(r backchannelAuthRequest) bool {
+		return r.Status == requestStatusDenied
+	})).Return(nil)
+
+	redirectURI, err := s.service.HandleAuthCallback(s.ctx, authIDPrefix+testAuthReqID, assertion)
+
+	s.Error(err)
+	s.Empty(redirectURI)
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_RequestNotPending() {
+	request := s.newPendingRequest()
+	request.Status = requestStatusApproved
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(request, true, nil)
+
+	_, err := s.service.HandleAuthCallback(s.ctx, authIDPrefix+testAuthReqID, "assertion")
+
+	s.Error(err)
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_RequestNotFound() {
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(backchannelAuthRequest{}, false, nil)
+
+	_, err := s.service.HandleAuthCallback(s.ctx, authIDPrefix+testAuthReqID, "assertion")
+
+	s.Error(err)
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_InvalidAssertion() {
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(s.newPendingRequest(), true, nil)
+	s.mockJWT.On("VerifyJWT", "bad-assertion", "", "").Return(&serviceerror.ServiceError{Code: "JWT-1001"})
+
+	_, err := s.service.HandleAuthCallback(s.ctx, authIDPrefix+testAuthReqID, "bad-assertion")
+
+	s.Error(err)
+	s.mockStore.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Tests for PollAuthenticationResult
+
+func (s *ServiceTestSuite) TestPollAuthenticationResult_NotFound() {
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(backchannelAuthRequest{}, false, nil)
+
+	result, errResp := s.service.PollAuthenticationResult(s.ctx, testAuthReqID, testClientID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestPollAuthenticationResult_ClientMismatch() {
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(s.newPendingRequest(), true, nil)
+
+	result, errResp := s.service.PollAuthenticationResult(s.ctx, testAuthReqID, "other-client")
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestPollAuthenticationResult_Expired() {
+	request := s.newPendingRequest()
+	request.ExpiryTime = time.Now().UTC().Add(-time.Second)
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(request, true, nil)
+	s.mockStore.On("Delete", mock.Anything, testAuthReqID).Return(true, nil)
+
+	result, errResp := s.service.PollAuthenticationResult(s.ctx, testAuthReqID, testClientID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorExpiredToken, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestPollAuthenticationResult_Pending() {
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(s.newPendingRequest(), true, nil)
+	s.mockStore.On("Update", mock.Anything, testAuthReqID, mock.MatchedBy(func(r backchannelAuthRequest) bool {
+		return !r.LastPolledAt.IsZero() && r.Interval == 5
+	})).Return(nil)
+
+	result, errResp := s.service.PollAuthenticationResult(s.ctx, testAuthReqID, testClientID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestPollAuthenticationResult_SlowDown() {
+	request := s.newPendingRequest()
+	request.LastPolledAt = time.Now().UTC().Add(-time.Second)
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(request, true, nil)
+	s.mockStore.On("Update", mock.Anything, testAuthReqID, mock.MatchedBy(func(r backchannelAuthRequest) bool {
+		return r.Interval == 5+slowDownIntervalIncrement
+	})).Return(nil)
+
+	result, errResp := s.service.PollAuthenticationResult(s.ctx, testAuthReqID, testClientID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorSlowDown, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestPollAuthenticationResult_Denied() {
+	request := s.newPendingRequest()
+	request.Status = requestStatusDenied
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(request, true, nil)
+	s.mockStore.On("Delete", mock.Anything, testAuthReqID).Return(true, nil)
+
+	result, errResp := s.service.PollAuthenticationResult(s.ctx, testAuthReqID, testClientID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorAccessDenied, errResp.Error)
+}
+
+func (s *ServiceTestSuite) TestPollAuthenticationResult_Approved() {
+	request := s.newPendingRequest()
+	request.Status = requestStatusApproved
+	request.AuthorizedPermissions = "read write"
+	request.AttributeCacheID = "cache-1"
+	request.CompletedACR = "mfa"
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(request, true, nil)
+	s.mockStore.On("Delete", mock.Anything, testAuthReqID).Return(true, nil)
+
+	result, errResp := s.service.PollAuthenticationResult(s.ctx, testAuthReqID, testClientID)
+
+	s.Nil(errResp)
+	s.Require().NotNil(result)
+	s.Equal(testUserID, result.UserID)
+	s.Equal([]string{"openid", "profile", "read", "write"}, result.Scopes)
+	s.Equal("cache-1", result.AttributeCacheID)
+	s.Equal("mfa", result.CompletedACR)
+}
+
+func (s *ServiceTestSuite) TestPollAuthenticationResult_ApprovedReturnsResources() {
+	request := s.newPendingRequest()
+	request.Status = requestStatusApproved
+	request.Resources = []string{testResourceURI}
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(request, true, nil)
+	s.mockStore.On("Delete", mock.Anything, testAuthReqID).Return(true, nil)
+
+	result, errResp := s.service.PollAuthenticationResult(s.ctx, testAuthReqID, testClientID)
+
+	s.Nil(errResp)
+	s.Require().NotNil(result)
+	s.Equal([]string{testResourceURI}, result.Resources)
+}
+
+func (s *ServiceTestSuite) TestPollAuthenticationResult_ApprovedAlreadyConsumed() {
+	request := s.newPendingRequest()
+	request.Status = requestStatusApproved
+	s.mockStore.On("Get", mock.Anything, testAuthReqID).Return(request, true, nil)
+	s.mockStore.On("Delete", mock.Anything, testAuthReqID).Return(false, nil)
+
+	result, errResp := s.service.PollAuthenticationResult(s.ctx, testAuthReqID, testClientID)
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidGrant, errResp.Error)
+}
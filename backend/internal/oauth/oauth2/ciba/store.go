@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// authReqIDRandomBytes is the number of random bytes for the auth_req_id (32 bytes = 256 bits).
+const authReqIDRandomBytes = 32
+
+// cibaStoreInterface defines the interface for backchannel authentication request storage.
+type cibaStoreInterface interface {
+	Store(ctx context.Context, request backchannelAuthRequest, expirySeconds int64) (string, error)
+	Get(ctx context.Context, authReqID string) (backchannelAuthRequest, bool, error)
+	Update(ctx context.Context, authReqID string, request backchannelAuthRequest) error
+	Delete(ctx context.Context, authReqID string) (bool, error)
+}
+
+// cibaRequestStore is the relational-DB-backed implementation of cibaStoreInterface.
+type cibaRequestStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newCIBARequestStore creates a new DB-backed backchannel authentication request store.
+func newCIBARequestStore(deploymentID string) cibaStoreInterface {
+	return &cibaRequestStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// Store persists a backchannel authentication request and returns the generated auth_req_id.
+func (s *cibaRequestStore) Store(
+	ctx context.Context, request backchannelAuthRequest, expirySeconds int64,
+) (string, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	authReqID, err := generateAuthReqID()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate auth_req_id: %w", err)
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal CIBA request: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(
+		ctx, queryInsertCIBARequest, authReqID, s.deploymentID, data, request.ExpiryTime,
+	); err != nil {
+		return "", fmt.Errorf("failed to insert CIBA request: %w", err)
+	}
+
+	return authReqID, nil
+}
+
+// Get retrieves a backchannel authentication request. Returns the request, a boolean indicating if found,
+// and any error. Expired requests are returned so that the caller can report the expiry to the client.
+func (s *cibaRequestStore) Get(ctx context.Context, authReqID string) (backchannelAuthRequest, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return backchannelAuthRequest{}, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetCIBARequest, authReqID, s.deploymentID)
+	if err != nil {
+		return backchannelAuthRequest{}, false, fmt.Errorf("failed to query CIBA request: %w", err)
+	}
+	if len(results) == 0 {
+		return backchannelAuthRequest{}, false, nil
+	}
+
+	request, err := buildCIBARequestFromRow(results[0])
+	if err != nil {
+		return backchannelAuthRequest{}, false, err
+	}
+	return request, true, nil
+}
+
+// Update replaces the stored state of a backchannel authentication request.
+func (s *cibaRequestStore) Update(ctx context.Context, authReqID string, request backchannelAuthRequest) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal CIBA request: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateCIBARequest, data, authReqID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to update CIBA request: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a backchannel authentication request. Returns whether a request was deleted, so that
+// concurrent consumers can detect that another consumer raced them to the delete.
+func (s *cibaRequestStore) Delete(ctx context.Context, authReqID string) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteCIBARequest, authReqID, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete CIBA request: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// buildCIBARequestFromRow reconstructs a backchannelAuthRequest from a database row.
+func buildCIBARequestFromRow(row map[string]any) (backchannelAuthRequest, error) {
+	var dataJSON []byte
+	if val, ok := row[dbColumnRequestData].(string); ok && val != "" {
+		dataJSON = []byte(val)
+	} else if val, ok := row[dbColumnRequestData].([]byte); ok && len(val) > 0 {
+		dataJSON = val
+	} else {
+		return backchannelAuthRequest{}, errors.New("request_data is missing or of unexpected type")
+	}
+
+	var request backchannelAuthRequest
+	if err := json.Unmarshal(dataJSON, &request); err != nil {
+		return backchannelAuthRequest{}, fmt.Errorf("failed to unmarshal CIBA request: %w", err)
+	}
+	return request, nil
+}
+
+// generateAuthReqID generates a cryptographically random auth_req_id.
+func generateAuthReqID() (string, error) {
+	b := make([]byte, authReqIDRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
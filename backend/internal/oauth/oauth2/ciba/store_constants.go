@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+// Database column names for backchannel authentication request storage.
+const (
+	dbColumnAuthReqID   = "auth_req_id"
+	dbColumnRequestData = "request_data"
+)
+
+var queryInsertCIBARequest = dbmodel.DBQuery{
+	ID: "CIBAQ-CRS-01",
+	Query: `INSERT INTO "CIBA_REQUEST" (AUTH_REQ_ID, DEPLOYMENT_ID, REQUEST_DATA, EXPIRY_TIME) ` +
+		`VALUES ($1, $2, $3, $4)`,
+}
+
+var queryGetCIBARequest = dbmodel.DBQuery{
+	ID: "CIBAQ-CRS-02",
+	Query: `SELECT AUTH_REQ_ID, REQUEST_DATA FROM "CIBA_REQUEST" ` +
+		`WHERE AUTH_REQ_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
+
+var queryUpdateCIBARequest = dbmodel.DBQuery{
+	ID:    "CIBAQ-CRS-03",
+	Query: `UPDATE "CIBA_REQUEST" SET REQUEST_DATA = $1 WHERE AUTH_REQ_ID = $2 AND DEPLOYMENT_ID = $3`,
+}
+
+var queryDeleteCIBARequest = dbmodel.DBQuery{
+	ID:    "CIBAQ-CRS-04",
+	Query: `DELETE FROM "CIBA_REQUEST" WHERE AUTH_REQ_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
@@ -0,0 +1,244 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ciba
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testAuthReqID    = "test-auth-req-id"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *cibaRequestStore
+	ctx            context.Context
+	testRequest    backchannelAuthRequest
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = &providermock.DBProviderInterfaceMock{}
+	s.mockDBClient = &providermock.DBClientInterfaceMock{}
+	s.store = &cibaRequestStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testRequest = backchannelAuthRequest{
+		ClientID:         "test-client",
+		UserID:           "user-1",
+		StandardScopes:   []string{"openid", "profile"},
+		PermissionScopes: []string{"read"},
+		BindingMessage:   "W4SCT",
+		Status:           requestStatusPending,
+		Interval:         5,
+		ExpiryTime:       time.Now().UTC().Add(5 * time.Minute).Truncate(time.Second),
+	}
+}
+
+func (s *StoreTestSuite) TestStore_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertCIBARequest,
+		mock.MatchedBy(func(key string) bool { return key != "" }),
+		testDeploymentID,
+		mock.MatchedBy(func(data []byte) bool { return len(data) > 0 }),
+		s.testRequest.ExpiryTime,
+	).Return(int64(1), nil)
+
+	authReqID, err := s.store.Store(s.ctx, s.testRequest, int64(300))
+
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), authReqID)
+	s.mockDBProvider.AssertExpectations(s.T())
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *StoreTestSuite) TestStore_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	authReqID, err := s.store.Store(s.ctx, s.testRequest, int64(300))
+
+	assert.Error(s.T(), err)
+	assert.Empty(s.T(), authReqID)
+}
+
+func (s *StoreTestSuite) TestStore_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertCIBARequest,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("insert failed"))
+
+	authReqID, err := s.store.Store(s.ctx, s.testRequest, int64(300))
+
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "failed to insert CIBA request")
+	assert.Empty(s.T(), authReqID)
+}
+
+func (s *StoreTestSuite) TestGet_Success() {
+	data, _ := json.Marshal(s.testRequest)
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetCIBARequest, testAuthReqID, testDeploymentID).
+		Return([]map[string]interface{}{
+			{dbColumnAuthReqID: testAuthReqID, dbColumnRequestData: string(data)},
+		}, nil)
+
+	request, found, err := s.store.Get(s.ctx, testAuthReqID)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), s.testRequest.ClientID, request.ClientID)
+	assert.Equal(s.T(), s.testRequest.UserID, request.UserID)
+	assert.Equal(s.T(), s.testRequest.StandardScopes, request.StandardScopes)
+	assert.Equal(s.T(), requestStatusPending, request.Status)
+	assert.True(s.T(), s.testRequest.ExpiryTime.Equal(request.ExpiryTime))
+}
+
+func (s *StoreTestSuite) TestGet_BytesColumn() {
+	data, _ := json.Marshal(s.testRequest)
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetCIBARequest, testAuthReqID, testDeploymentID).
+		Return([]map[string]interface{}{{dbColumnRequestData: data}}, nil)
+
+	request, found, err := s.store.Get(s.ctx, testAuthReqID)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	assert.Equal(s.T(), s.testRequest.ClientID, request.ClientID)
+}
+
+func (s *StoreTestSuite) TestGet_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetCIBARequest, testAuthReqID, testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	_, found, err := s.store.Get(s.ctx, testAuthReqID)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+func (s *StoreTestSuite) TestGet_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetCIBARequest, testAuthReqID, testDeploymentID).
+		Return(nil, errors.New("query failed"))
+
+	_, found, err := s.store.Get(s.ctx, testAuthReqID)
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+func (s *StoreTestSuite) TestGet_MissingRequestData() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetCIBARequest, testAuthReqID, testDeploymentID).
+		Return([]map[string]interface{}{{dbColumnAuthReqID: testAuthReqID}}, nil)
+
+	_, found, err := s.store.Get(s.ctx, testAuthReqID)
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+func (s *StoreTestSuite) TestUpdate_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateCIBARequest,
+		mock.MatchedBy(func(data []byte) bool {
+			var request backchannelAuthRequest
+			return json.Unmarshal(data, &request) == nil && request.Status == requestStatusApproved
+		}),
+		testAuthReqID, testDeploymentID,
+	).Return(int64(1), nil)
+
+	s.testRequest.Status = requestStatusApproved
+	err := s.store.Update(s.ctx, testAuthReqID, s.testRequest)
+
+	assert.NoError(s.T(), err)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *StoreTestSuite) TestUpdate_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateCIBARequest,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("update failed"))
+
+	err := s.store.Update(s.ctx, testAuthReqID, s.testRequest)
+
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "failed to update CIBA request")
+}
+
+func (s *StoreTestSuite) TestDelete() {
+	testCases := []struct {
+		name         string
+		rowsAffected int64
+		execErr      error
+		expected     bool
+		expectErr    bool
+	}{
+		{"Deleted", 1, nil, true, false},
+		{"AlreadyDeleted", 0, nil, false, false},
+		{"ExecuteError", 0, errors.New("delete failed"), false, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			mockDBProvider := &providermock.DBProviderInterfaceMock{}
+			mockDBClient := &providermock.DBClientInterfaceMock{}
+			store := &cibaRequestStore{dbProvider: mockDBProvider, deploymentID: testDeploymentID}
+			mockDBProvider.On("GetRuntimeDBClient").Return(mockDBClient, nil)
+			mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteCIBARequest,
+				testAuthReqID, testDeploymentID).Return(tc.rowsAffected, tc.execErr)
+
+			deleted, err := store.Delete(s.ctx, testAuthReqID)
+
+			assert.Equal(s.T(), tc.expected, deleted)
+			assert.Equal(s.T(), tc.expectErr, err != nil)
+		})
+	}
+}
+
+func (s *StoreTestSuite) TestGenerateAuthReqID_Unique() {
+	id1, err1 := generateAuthReqID()
+	id2, err2 := generateAuthReqID()
+
+	assert.NoError(s.T(), err1)
+	assert.NoError(s.T(), err2)
+	assert.Len(s.T(), id1, 43)
+	assert.NotEqual(s.T(), id1, id2)
+}
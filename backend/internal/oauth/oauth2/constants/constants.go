@@ -27,40 +27,53 @@ import (
 
 // OAuth2 request parameters.
 const (
-	RequestParamGrantType           string = "grant_type"
-	RequestParamClientID            string = "client_id"
-	RequestParamClientSecret        string = "client_secret"
-	RequestParamClientAssertion     string = "client_assertion"
-	RequestParamClientAssertionType string = "client_assertion_type"
-	RequestParamRedirectURI         string = "redirect_uri"
-	RequestParamUsername            string = "username"
-	RequestParamPassword            string = "password"
-	RequestParamScope               string = "scope"
-	RequestParamCode                string = "code"
-	RequestParamCodeVerifier        string = "code_verifier"
-	RequestParamCodeChallenge       string = "code_challenge"
-	RequestParamCodeChallengeMethod string = "code_challenge_method"
-	RequestParamRefreshToken        string = "refresh_token"
-	RequestParamResponseType        string = "response_type"
-	RequestParamState               string = "state"
-	RequestParamIss                 string = "iss"
-	RequestParamResource            string = "resource"
-	RequestParamError               string = "error"
-	RequestParamErrorDescription    string = "error_description"
-	RequestParamToken               string = "token"
-	RequestParamTokenTypeHint       string = "token_type_hint"
-	RequestParamSubjectToken        string = "subject_token"
-	RequestParamSubjectTokenType    string = "subject_token_type"
-	RequestParamActorToken          string = "actor_token"
-	RequestParamActorTokenType      string = "actor_token_type"
-	RequestParamRequestedTokenType  string = "requested_token_type"
-	RequestParamAudience            string = "audience"
-	RequestParamClaims              string = "claims"
-	RequestParamClaimsLocales       string = "claims_locales"
-	RequestParamNonce               string = "nonce"
-	RequestParamPrompt              string = "prompt"
-	RequestParamRequestURI          string = "request_uri"
-	RequestParamAcrValues           string = "acr_values"
+	RequestParamGrantType               string = "grant_type"
+	RequestParamClientID                string = "client_id"
+	RequestParamClientSecret            string = "client_secret"
+	RequestParamClientAssertion         string = "client_assertion"
+	RequestParamClientAssertionType     string = "client_assertion_type"
+	RequestParamRedirectURI             string = "redirect_uri"
+	RequestParamUsername                string = "username"
+	RequestParamPassword                string = "password"
+	RequestParamScope                   string = "scope"
+	RequestParamCode                    string = "code"
+	RequestParamCodeVerifier            string = "code_verifier"
+	RequestParamCodeChallenge           string = "code_challenge"
+	RequestParamCodeChallengeMethod     string = "code_challenge_method"
+	RequestParamRefreshToken            string = "refresh_token"
+	RequestParamResponseType            string = "response_type"
+	RequestParamResponseMode            string = "response_mode"
+	RequestParamResponse                string = "response"
+	RequestParamState                   string = "state"
+	RequestParamIss                     string = "iss"
+	RequestParamResource                string = "resource"
+	RequestParamError                   string = "error"
+	RequestParamErrorDescription        string = "error_description"
+	RequestParamToken                   string = "token"
+	RequestParamTokenTypeHint           string = "token_type_hint"
+	RequestParamSubjectToken            string = "subject_token"
+	RequestParamSubjectTokenType        string = "subject_token_type"
+	RequestParamActorToken              string = "actor_token"
+	RequestParamActorTokenType          string = "actor_token_type"
+	RequestParamRequestedTokenType      string = "requested_token_type"
+	RequestParamAudience                string = "audience"
+	RequestParamClaims                  string = "claims"
+	RequestParamClaimsLocales           string = "claims_locales"
+	RequestParamNonce                   string = "nonce"
+	RequestParamPrompt                  string = "prompt"
+	RequestParamMaxAge                  string = "max_age"
+	RequestParamRequest                 string = "request"
+	RequestParamRequestURI              string = "request_uri"
+	RequestParamAcrValues               string = "acr_values"
+	RequestParamOrganization            string = "organization"
+	RequestParamAuthorizationDetails    string = "authorization_details"
+	RequestParamAuthReqID               string = "auth_req_id"
+	RequestParamLoginHint               string = "login_hint"
+	RequestParamBindingMessage          string = "binding_message"
+	RequestParamClientNotificationToken string = "client_notification_token"
+	RequestParamRequestedExpiry         string = "requested_expiry"
+	RequestParamIDTokenHint             string = "id_token_hint"
+	RequestParamPostLogoutRedirectURI   string = "post_logout_redirect_uri"
 )
 
 // OIDC prompt parameter values.
@@ -111,6 +124,14 @@ const (
 	OAuth2LogoutEndpoint        string = "/oauth2/logout"
 	OAuth2DCREndpoint           string = "/oauth2/dcr/register"
 	OAuth2PAREndpoint           string = "/oauth2/par"
+	OAuth2CIBAEndpoint          string = "/oauth2/bc-authorize"
+
+	// OAuth2AuthorizationResponseEndpoint delivers JWT secured authorization responses with the form_post.jwt
+	// response mode.
+	OAuth2AuthorizationResponseEndpoint string = "/oauth2/authorize/response"
+
+	// OAuth2FrontchannelLogoutEndpoint resolves the front-channel logout URIs the gate logout page renders.
+	OAuth2FrontchannelLogoutEndpoint string = "/oauth2/logout/frontchannel"
 )
 
 // GrantType defines a type for OAuth2 grant types.
@@ -125,6 +146,8 @@ const (
 	GrantTypeRefreshToken GrantType = "refresh_token"
 	// GrantTypeTokenExchange represents the token exchange grant type.
 	GrantTypeTokenExchange GrantType = "urn:ietf:params:oauth:grant-type:token-exchange" //nolint:gosec
+	// GrantTypeCIBA represents the client-initiated backchannel authentication grant type.
+	GrantTypeCIBA GrantType = "urn:openid:params:grant-type:ciba"
 )
 
 // supportedGrantTypes is the single source of truth for all supported grant types.
@@ -133,6 +156,7 @@ var supportedGrantTypes = []GrantType{
 	GrantTypeClientCredentials,
 	GrantTypeRefreshToken,
 	GrantTypeTokenExchange,
+	GrantTypeCIBA,
 }
 
 // IsValid checks if the GrantType is valid.
@@ -170,6 +194,51 @@ func (rt ResponseType) IsValid() bool {
 	return false
 }
 
+// ResponseMode defines a type for OAuth2 authorization response modes.
+type ResponseMode string
+
+const (
+	// ResponseModeQuery returns the authorization response parameters in the query of the redirect URI.
+	ResponseModeQuery ResponseMode = "query"
+	// ResponseModeJWT returns the authorization response as a JWT in the default mode of the response type.
+	ResponseModeJWT ResponseMode = "jwt"
+	// ResponseModeQueryJWT returns the authorization response as a JWT in the query of the redirect URI.
+	ResponseModeQueryJWT ResponseMode = "query.jwt"
+	// ResponseModeFragmentJWT returns the authorization response as a JWT in the fragment of the redirect URI.
+	ResponseModeFragmentJWT ResponseMode = "fragment.jwt"
+	// ResponseModeFormPostJWT returns the authorization response as a JWT posted to the redirect URI.
+	ResponseModeFormPostJWT ResponseMode = "form_post.jwt"
+)
+
+// supportedResponseModes is the single source of truth for all supported response modes.
+var supportedResponseModes = []ResponseMode{
+	ResponseModeQuery,
+	ResponseModeJWT,
+	ResponseModeQueryJWT,
+	ResponseModeFragmentJWT,
+	ResponseModeFormPostJWT,
+}
+
+// IsValid checks if the ResponseMode is valid.
+func (rm ResponseMode) IsValid() bool {
+	for _, valid := range supportedResponseModes {
+		if rm == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// IsJWT reports whether the response mode returns the authorization response as a signed JWT (JARM).
+func (rm ResponseMode) IsJWT() bool {
+	switch rm {
+	case ResponseModeJWT, ResponseModeQueryJWT, ResponseModeFragmentJWT, ResponseModeFormPostJWT:
+		return true
+	default:
+		return false
+	}
+}
+
 // TokenEndpointAuthMethod defines a type for token endpoint authentication methods.
 type TokenEndpointAuthMethod string
 
@@ -244,21 +313,57 @@ func (tti TokenTypeIdentifier) IsValid() bool {
 
 // OAuth2 error codes.
 const (
-	ErrorInvalidRequest           string = "invalid_request"
-	ErrorInvalidClient            string = "invalid_client"
-	ErrorInvalidGrant             string = "invalid_grant"
-	ErrorUnauthorizedClient       string = "unauthorized_client"
-	ErrorUnsupportedGrantType     string = "unsupported_grant_type"
-	ErrorInvalidScope             string = "invalid_scope"
-	ErrorInvalidTarget            string = "invalid_target"
-	ErrorServerError              string = "server_error"
-	ErrorUnsupportedResponseType  string = "unsupported_response_type"
-	ErrorAccessDenied             string = "access_denied"
-	ErrorLoginRequired            string = "login_required"
-	ErrorConsentRequired          string = "consent_required"
-	ErrorAccountSelectionRequired string = "account_selection_required"
+	ErrorInvalidRequest              string = "invalid_request"
+	ErrorInvalidClient               string = "invalid_client"
+	ErrorInvalidGrant                string = "invalid_grant"
+	ErrorUnauthorizedClient          string = "unauthorized_client"
+	ErrorUnsupportedGrantType        string = "unsupported_grant_type"
+	ErrorInvalidScope                string = "invalid_scope"
+	ErrorInvalidTarget               string = "invalid_target"
+	ErrorServerError                 string = "server_error"
+	ErrorUnsupportedResponseType     string = "unsupported_response_type"
+	ErrorAccessDenied                string = "access_denied"
+	ErrorLoginRequired               string = "login_required"
+	ErrorConsentRequired             string = "consent_required"
+	ErrorAccountSelectionRequired    string = "account_selection_required"
+	ErrorTooManyRequests             string = "too_many_requests"
+	ErrorInvalidRequestObject        string = "invalid_request_object"
+	ErrorInvalidRequestURI           string = "invalid_request_uri"
+	ErrorInvalidAuthorizationDetails string = "invalid_authorization_details"
+	ErrorAuthorizationPending        string = "authorization_pending"
+	ErrorSlowDown                    string = "slow_down"
+	ErrorExpiredToken                string = "expired_token"
+	ErrorUnknownUserID               string = "unknown_user_id"
+)
+
+// BackchannelTokenDeliveryMode defines a type for CIBA token delivery modes.
+type BackchannelTokenDeliveryMode string
+
+const (
+	// BackchannelTokenDeliveryModePoll represents the poll mode where the client polls the token endpoint.
+	BackchannelTokenDeliveryModePoll BackchannelTokenDeliveryMode = "poll"
+	// BackchannelTokenDeliveryModePing represents the ping mode where the client is notified to call the
+	// token endpoint.
+	BackchannelTokenDeliveryModePing BackchannelTokenDeliveryMode = "ping"
 )
 
+// supportedBackchannelTokenDeliveryModes is the single source of truth for all supported CIBA token
+// delivery modes.
+var supportedBackchannelTokenDeliveryModes = []BackchannelTokenDeliveryMode{
+	BackchannelTokenDeliveryModePoll,
+	BackchannelTokenDeliveryModePing,
+}
+
+// IsValid checks if the BackchannelTokenDeliveryMode is valid.
+func (m BackchannelTokenDeliveryMode) IsValid() bool {
+	for _, valid := range supportedBackchannelTokenDeliveryModes {
+		if m == valid {
+			return true
+		}
+	}
+	return false
+}
+
 // UnSupportedGrantTypeError is returned when an unsupported grant type is requested.
 var UnSupportedGrantTypeError = errors.New("unsupported_grant_type")
 
@@ -302,25 +407,65 @@ var StandardOIDCScopes = map[string]model.OIDCScope{
 
 // Standard JWT claim names.
 const (
-	ClaimSub      string = "sub"
-	ClaimIss      string = "iss"
-	ClaimAud      string = "aud"
-	ClaimExp      string = "exp"
-	ClaimIat      string = "iat"
-	ClaimAuthTime string = "auth_time"
+	ClaimSub                  string = "sub"
+	ClaimIss                  string = "iss"
+	ClaimAud                  string = "aud"
+	ClaimExp                  string = "exp"
+	ClaimIat                  string = "iat"
+	ClaimAuthTime             string = "auth_time"
+	ClaimAcr                  string = "acr"
+	ClaimAmr                  string = "amr"
+	ClaimSid                  string = "sid"
+	ClaimAuthorizationDetails string = "authorization_details"
 )
 
 // Custom JWT claim names.
 const (
-	ClaimUserType           string = "userType"
-	ClaimOUID               string = "ouId"
-	ClaimOUName             string = "ouName"
-	ClaimOUHandle           string = "ouHandle"
-	ClaimClaimsRequest      string = "claims_req"
-	ClaimClaimsLocales      string = "claims_locales"
-	ClaimCompletedAuthClass string = "completed_auth_class"
+	ClaimUserType             string = "userType"
+	ClaimOUID                 string = "ouId"
+	ClaimOUName               string = "ouName"
+	ClaimOUHandle             string = "ouHandle"
+	ClaimClaimsRequest        string = "claims_req"
+	ClaimClaimsLocales        string = "claims_locales"
+	ClaimCompletedAuthClass   string = "completed_auth_class"
+	ClaimCompletedAuthMethods string = "completed_auth_methods"
+	ClaimRememberMe           string = "remember_me"
+	ClaimOrgID                string = "org_id"
 )
 
+// reservedTokenClaims lists the claim names that the server sets on issued tokens and that an
+// application's claim mappings must not target.
+var reservedTokenClaims = map[string]bool{
+	ClaimSub:                  true,
+	ClaimIss:                  true,
+	ClaimAud:                  true,
+	ClaimExp:                  true,
+	"nbf":                     true,
+	ClaimIat:                  true,
+	"jti":                     true,
+	"scope":                   true,
+	"client_id":               true,
+	"grant_type":              true,
+	"aci":                     true,
+	"act":                     true,
+	ClaimSid:                  true,
+	ClaimAuthTime:             true,
+	RequestParamNonce:         true,
+	ClaimAcr:                  true,
+	ClaimAmr:                  true,
+	"azp":                     true,
+	"cnf":                     true,
+	ClaimAuthorizationDetails: true,
+	ClaimClaimsRequest:        true,
+	ClaimClaimsLocales:        true,
+	ClaimOrgID:                true,
+}
+
+// IsReservedTokenClaim reports whether the given claim name is set by the server on issued tokens.
+func IsReservedTokenClaim(claim string) bool {
+	return reservedTokenClaims[claim]
+}
+
 // OIDC subject types.
 const (
 	SubjectTypePublic string = "public"
@@ -361,6 +506,15 @@ func GetSupportedResponseTypes() []string {
 	return result
 }
 
+// GetSupportedResponseModes returns all supported OAuth2 response modes.
+func GetSupportedResponseModes() []string {
+	result := make([]string, len(supportedResponseModes))
+	for i, rm := range supportedResponseModes {
+		result[i] = string(rm)
+	}
+	return result
+}
+
 // GetSupportedGrantTypes returns all supported OAuth2 grant types.
 func GetSupportedGrantTypes() []string {
 	result := make([]string, len(supportedGrantTypes))
@@ -370,6 +524,15 @@ func GetSupportedGrantTypes() []string {
 	return result
 }
 
+// GetSupportedBackchannelTokenDeliveryModes returns all supported CIBA token delivery modes.
+func GetSupportedBackchannelTokenDeliveryModes() []string {
+	result := make([]string, len(supportedBackchannelTokenDeliveryModes))
+	for i, mode := range supportedBackchannelTokenDeliveryModes {
+		result[i] = string(mode)
+	}
+	return result
+}
+
 // GetSupportedTokenEndpointAuthMethods returns all supported token endpoint authentication methods.
 func GetSupportedTokenEndpointAuthMethods() []string {
 	result := make([]string, len(supportedTokenEndpointAuthMethods))
@@ -393,5 +556,7 @@ func GetStandardClaims() []string {
 		ClaimExp,
 		ClaimIat,
 		ClaimAuthTime,
+		ClaimAcr,
+		ClaimAmr,
 	}
 }
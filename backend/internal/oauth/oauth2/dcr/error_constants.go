@@ -72,6 +72,21 @@ var (
 		},
 	}
 
+	// ErrorInvalidNativeRedirectURI is the error returned when a native client registers a redirect URI
+	// that is neither a custom scheme nor an http loopback URI.
+	ErrorInvalidNativeRedirectURI = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_redirect_uri",
+		Error: core.I18nMessage{
+			Key:          "error.dcr.invalid_native_redirect_uri",
+			DefaultValue: "Invalid redirect URI",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.dcr.invalid_native_redirect_uri_description",
+			DefaultValue: "Native clients must use custom scheme or http loopback redirect URIs",
+		},
+	}
+
 	// ErrorInvalidClientMetadata is the standard error for client metadata issues
 	ErrorInvalidClientMetadata = serviceerror.ServiceError{
 		Type: serviceerror.ClientErrorType,
@@ -86,6 +101,34 @@ var (
 		},
 	}
 
+	// ErrorInvalidApplicationType is the error returned when application_type is not supported
+	ErrorInvalidApplicationType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_client_metadata",
+		Error: core.I18nMessage{
+			Key:          "error.dcr.invalid_application_type",
+			DefaultValue: "Invalid application type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.dcr.invalid_application_type_description",
+			DefaultValue: "The application_type must be 'web' or 'native'",
+		},
+	}
+
+	// ErrorInvalidTokenLifetime is the error returned when a requested token lifetime is out of bounds
+	ErrorInvalidTokenLifetime = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_client_metadata",
+		Error: core.I18nMessage{
+			Key:          "error.dcr.invalid_token_lifetime",
+			DefaultValue: "Invalid token lifetime",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.dcr.invalid_token_lifetime_description",
+			DefaultValue: "Token lifetimes must be positive and must not exceed the maximum allowed for the server",
+		},
+	}
+
 	// ErrorJWKSConfigurationConflict is the error returned when both jwks and jwks_uri are provided
 	ErrorJWKSConfigurationConflict = serviceerror.ServiceError{
 		Type: serviceerror.ClientErrorType,
@@ -100,6 +143,66 @@ var (
 		},
 	}
 
+	// ErrorClientIDMismatch is the error returned when the client_id of an update request does not match
+	// the client being updated
+	ErrorClientIDMismatch = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_client_metadata",
+		Error: core.I18nMessage{
+			Key:          "error.dcr.client_id_mismatch",
+			DefaultValue: "Client ID mismatch",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.dcr.client_id_mismatch_description",
+			DefaultValue: "The client_id in the request must match the client being updated",
+		},
+	}
+
+	// ErrorInvalidRegistrationToken is the error returned when the registration access token presented to the
+	// client configuration endpoint is missing, invalid, or not issued for the client
+	ErrorInvalidRegistrationToken = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_token",
+		Error: core.I18nMessage{
+			Key:          "error.dcr.invalid_registration_token",
+			DefaultValue: "Invalid registration access token",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.dcr.invalid_registration_token_description",
+			DefaultValue: "The registration access token is missing, invalid, or not issued for the client",
+		},
+	}
+
+	// ErrorInvalidSoftwareStatement is the error returned when a software statement is missing while required,
+	// cannot be decoded, has an invalid signature, or has expired.
+	ErrorInvalidSoftwareStatement = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_software_statement",
+		Error: core.I18nMessage{
+			Key:          "error.dcr.invalid_software_statement",
+			DefaultValue: "Invalid software statement",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.dcr.invalid_software_statement_description",
+			DefaultValue: "The software statement is missing or is not a valid, unexpired statement",
+		},
+	}
+
+	// ErrorUnapprovedSoftwareStatement is the error returned when a software statement is not signed by a
+	// trusted issuer.
+	ErrorUnapprovedSoftwareStatement = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "unapproved_software_statement",
+		Error: core.I18nMessage{
+			Key:          "error.dcr.unapproved_software_statement",
+			DefaultValue: "Unapproved software statement",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.dcr.unapproved_software_statement_description",
+			DefaultValue: "The software statement is not issued by a trusted issuer",
+		},
+	}
+
 	// ErrorServerError is the standard error for server issues
 	ErrorServerError = serviceerror.ServiceError{
 		Type: serviceerror.ServerErrorType,
@@ -19,9 +19,11 @@
 package dcr
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -72,6 +74,75 @@ func (dh *dcrHandler) HandleDCRRegistration(w http.ResponseWriter, r *http.Reque
 	sysutils.WriteSuccessResponse(w, http.StatusCreated, dcrResponse)
 }
 
+// HandleDCRClientRead handles the RFC 7592 client read request.
+func (dh *dcrHandler) HandleDCRClientRead(w http.ResponseWriter, r *http.Request) {
+	registrationAccessToken, ok := dh.extractRegistrationAccessToken(w, r)
+	if !ok {
+		return
+	}
+
+	dcrResponse, svcErr := dh.dcrService.GetClient(r.Context(), r.PathValue("client_id"), registrationAccessToken)
+	if svcErr != nil {
+		dh.writeServiceErrorResponse(w, svcErr)
+		return
+	}
+
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, dcrResponse)
+}
+
+// HandleDCRClientUpdate handles the RFC 7592 client update request.
+func (dh *dcrHandler) HandleDCRClientUpdate(w http.ResponseWriter, r *http.Request) {
+	registrationAccessToken, ok := dh.extractRegistrationAccessToken(w, r)
+	if !ok {
+		return
+	}
+
+	dcrRequest, err := sysutils.DecodeJSONBody[DCRRegistrationRequest](r)
+	if err != nil {
+		sysutils.WriteJSONError(w, ErrorInvalidRequestFormat.Code,
+			ErrorInvalidRequestFormat.ErrorDescription.DefaultValue, http.StatusBadRequest, nil)
+		return
+	}
+
+	dcrResponse, svcErr := dh.dcrService.UpdateClient(
+		r.Context(), r.PathValue("client_id"), registrationAccessToken, dcrRequest)
+	if svcErr != nil {
+		dh.writeServiceErrorResponse(w, svcErr)
+		return
+	}
+
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, dcrResponse)
+}
+
+// HandleDCRClientDelete handles the RFC 7592 client delete request.
+func (dh *dcrHandler) HandleDCRClientDelete(w http.ResponseWriter, r *http.Request) {
+	registrationAccessToken, ok := dh.extractRegistrationAccessToken(w, r)
+	if !ok {
+		return
+	}
+
+	if svcErr := dh.dcrService.DeleteClient(
+		r.Context(), r.PathValue("client_id"), registrationAccessToken); svcErr != nil {
+		dh.writeServiceErrorResponse(w, svcErr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// extractRegistrationAccessToken extracts the registration access token from the Authorization header.
+// Returns false (and writes an HTTP 401) if the header does not carry a Bearer token.
+func (dh *dcrHandler) extractRegistrationAccessToken(w http.ResponseWriter, r *http.Request) (string, bool) {
+	token, err := sysutils.ExtractBearerToken(r.Header.Get(serverconst.AuthorizationHeaderName))
+	if err != nil {
+		dh.writeServiceErrorResponse(w, &ErrorInvalidRegistrationToken)
+		return "", false
+	}
+	return token, true
+}
+
 // checkDCRAuthorization verifies that the caller holds required permission.
 // Returns true if authorized, false (and writes an HTTP 401) otherwise.
 func (dh *dcrHandler) checkDCRAuthorization(r *http.Request, w http.ResponseWriter) bool {
@@ -96,5 +167,13 @@ func (dh *dcrHandler) writeServiceErrorResponse(w http.ResponseWriter, svcErr *s
 		statusCode = http.StatusBadRequest
 	}
 
+	if svcErr.Code == ErrorInvalidRegistrationToken.Code {
+		wwwAuth := fmt.Sprintf("Bearer error=%q, error_description=%q",
+			svcErr.Code, svcErr.ErrorDescription.DefaultValue)
+		sysutils.WriteJSONError(w, svcErr.Code, svcErr.ErrorDescription.DefaultValue, http.StatusUnauthorized,
+			[]map[string]string{{serverconst.WWWAuthenticateHeaderName: wwwAuth}})
+		return
+	}
+
 	sysutils.WriteJSONError(w, svcErr.Code, svcErr.ErrorDescription.DefaultValue, statusCode, nil)
 }
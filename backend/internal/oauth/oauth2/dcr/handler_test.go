@@ -377,3 +377,138 @@ func TestHandleDCRRegistration_ClosedDCR_WithSystemPermission(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, rr.Code)
 	mockService.AssertExpectations(t)
 }
+
+// TestHandleDCRClientRead_Success tests reading a client with a valid registration access token
+func (s *DCRHandlerTestSuite) TestHandleDCRClientRead_Success() {
+	response := &DCRRegistrationResponse{
+		ClientID:                "client-1",
+		RegistrationAccessToken: "registration-token",
+	}
+	s.mockService.On("GetClient", mock.Anything, "client-1", "registration-token").Return(response, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/dcr/register/client-1", nil)
+	req.SetPathValue("client_id", "client-1")
+	req.Header.Set("Authorization", "Bearer registration-token")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientRead(rr, req)
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), "no-store", rr.Header().Get("Cache-Control"))
+	var body DCRRegistrationResponse
+	s.NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	assert.Equal(s.T(), "client-1", body.ClientID)
+	assert.Equal(s.T(), "registration-token", body.RegistrationAccessToken)
+}
+
+// TestHandleDCRClientRead_MissingToken tests that a request without a bearer token is rejected
+func (s *DCRHandlerTestSuite) TestHandleDCRClientRead_MissingToken() {
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/dcr/register/client-1", nil)
+	req.SetPathValue("client_id", "client-1")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientRead(rr, req)
+
+	assert.Equal(s.T(), http.StatusUnauthorized, rr.Code)
+	assert.Contains(s.T(), rr.Header().Get("WWW-Authenticate"), `error="invalid_token"`)
+	s.mockService.AssertNotCalled(s.T(), "GetClient", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestHandleDCRClientRead_InvalidToken tests that an invalid registration access token is rejected
+func (s *DCRHandlerTestSuite) TestHandleDCRClientRead_InvalidToken() {
+	s.mockService.On("GetClient", mock.Anything, "client-1", "wrong-token").
+		Return(nil, &ErrorInvalidRegistrationToken)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/dcr/register/client-1", nil)
+	req.SetPathValue("client_id", "client-1")
+	req.Header.Set("Authorization", "Bearer wrong-token")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientRead(rr, req)
+
+	assert.Equal(s.T(), http.StatusUnauthorized, rr.Code)
+	assert.Contains(s.T(), rr.Header().Get("WWW-Authenticate"), "Bearer")
+}
+
+// TestHandleDCRClientUpdate_Success tests updating a client
+func (s *DCRHandlerTestSuite) TestHandleDCRClientUpdate_Success() {
+	request := &DCRRegistrationRequest{
+		ClientID:     "client-1",
+		RedirectURIs: []string{"https://client.example.com/callback"},
+	}
+	response := &DCRRegistrationResponse{ClientID: "client-1", RedirectURIs: request.RedirectURIs}
+	s.mockService.On("UpdateClient", mock.Anything, "client-1", "registration-token", request).
+		Return(response, nil)
+
+	requestJSON, _ := json.Marshal(request)
+	req := httptest.NewRequest(http.MethodPut, "/oauth2/dcr/register/client-1", bytes.NewReader(requestJSON))
+	req.SetPathValue("client_id", "client-1")
+	req.Header.Set("Authorization", "Bearer registration-token")
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientUpdate(rr, req)
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), "no-store", rr.Header().Get("Cache-Control"))
+}
+
+// TestHandleDCRClientUpdate_InvalidRequestFormat tests handling of invalid JSON in an update request
+func (s *DCRHandlerTestSuite) TestHandleDCRClientUpdate_InvalidRequestFormat() {
+	req := httptest.NewRequest(http.MethodPut, "/oauth2/dcr/register/client-1",
+		bytes.NewReader([]byte(`{"invalid": json}`)))
+	req.SetPathValue("client_id", "client-1")
+	req.Header.Set("Authorization", "Bearer registration-token")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientUpdate(rr, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+}
+
+// TestHandleDCRClientUpdate_ClientIDMismatch tests that a mismatched client_id is reported as a client error
+func (s *DCRHandlerTestSuite) TestHandleDCRClientUpdate_ClientIDMismatch() {
+	s.mockService.On("UpdateClient", mock.Anything, "client-1", "registration-token", mock.Anything).
+		Return(nil, &ErrorClientIDMismatch)
+
+	req := httptest.NewRequest(http.MethodPut, "/oauth2/dcr/register/client-1",
+		bytes.NewReader([]byte(`{"client_id": "client-2"}`)))
+	req.SetPathValue("client_id", "client-1")
+	req.Header.Set("Authorization", "Bearer registration-token")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientUpdate(rr, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Empty(s.T(), rr.Header().Get("WWW-Authenticate"))
+}
+
+// TestHandleDCRClientDelete_Success tests deleting a client
+func (s *DCRHandlerTestSuite) TestHandleDCRClientDelete_Success() {
+	s.mockService.On("DeleteClient", mock.Anything, "client-1", "registration-token").Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/oauth2/dcr/register/client-1", nil)
+	req.SetPathValue("client_id", "client-1")
+	req.Header.Set("Authorization", "Bearer registration-token")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientDelete(rr, req)
+
+	assert.Equal(s.T(), http.StatusNoContent, rr.Code)
+	assert.Empty(s.T(), rr.Body.Bytes())
+}
+
+// TestHandleDCRClientDelete_InvalidToken tests that deleting with an invalid token is rejected
+func (s *DCRHandlerTestSuite) TestHandleDCRClientDelete_InvalidToken() {
+	s.mockService.On("DeleteClient", mock.Anything, "client-1", "wrong-token").
+		Return(&ErrorInvalidRegistrationToken)
+
+	req := httptest.NewRequest(http.MethodDelete, "/oauth2/dcr/register/client-1", nil)
+	req.SetPathValue("client_id", "client-1")
+	req.Header.Set("Authorization", "Bearer wrong-token")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleDCRClientDelete(rr, req)
+
+	assert.Equal(s.T(), http.StatusUnauthorized, rr.Code)
+}
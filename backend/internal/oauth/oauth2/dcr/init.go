@@ -25,6 +25,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/application"
 	"github.com/thunder-id/thunderid/internal/ou"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
@@ -35,9 +36,11 @@ func Initialize(
 	appService application.ApplicationServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
+	jwtService jwt.JWTServiceInterface,
 	transactioner transaction.Transactioner,
 ) DCRServiceInterface {
-	dcrService := newDCRService(appService, ouService, i18nService, transactioner)
+	dcrService := newDCRService(appService, ouService, i18nService, jwtService, transactioner,
+		newRegistrationTokenStore())
 	dcrHandler := newDCRHandler(dcrService)
 	registerRoutes(mux, dcrHandler)
 	return dcrService
@@ -57,4 +60,21 @@ func registerRoutes(mux *http.ServeMux, dcrHandler *dcrHandler) {
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts))
+
+	clientOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE", "OPTIONS"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /oauth2/dcr/register/{client_id}",
+		dcrHandler.HandleDCRClientRead, clientOpts))
+	mux.HandleFunc(middleware.WithCORS("PUT /oauth2/dcr/register/{client_id}",
+		dcrHandler.HandleDCRClientUpdate, clientOpts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /oauth2/dcr/register/{client_id}",
+		dcrHandler.HandleDCRClientDelete, clientOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/dcr/register/{client_id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, clientOpts))
 }
@@ -62,7 +62,7 @@ func (suite *InitTestSuite) TearDownTest() {
 func (suite *InitTestSuite) TestInitialize() {
 	mux := http.NewServeMux()
 
-	service := Initialize(mux, suite.mockAppService, suite.mockOUService, nil, &MockTransactioner{})
+	service := Initialize(mux, suite.mockAppService, suite.mockOUService, nil, nil, &MockTransactioner{})
 
 	assert.NotNil(suite.T(), service)
 	assert.Implements(suite.T(), (*DCRServiceInterface)(nil), service)
@@ -71,7 +71,7 @@ func (suite *InitTestSuite) TestInitialize() {
 func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 	mux := http.NewServeMux()
 
-	Initialize(mux, suite.mockAppService, suite.mockOUService, nil, &MockTransactioner{})
+	Initialize(mux, suite.mockAppService, suite.mockOUService, nil, nil, &MockTransactioner{})
 
 	// Verify that the routes are registered by attempting to get a handler for them.
 	// The pattern includes the method because of CORS middleware wrapping.
@@ -80,4 +80,9 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 
 	_, pattern = mux.Handler(&http.Request{Method: "OPTIONS", URL: &url.URL{Path: "/oauth2/dcr/register"}})
 	assert.Contains(suite.T(), pattern, "/oauth2/dcr/register")
+
+	for _, method := range []string{"GET", "PUT", "DELETE", "OPTIONS"} {
+		_, pattern = mux.Handler(&http.Request{Method: method, URL: &url.URL{Path: "/oauth2/dcr/register/client-1"}})
+		assert.Contains(suite.T(), pattern, "/oauth2/dcr/register/{client_id}")
+	}
 }
@@ -32,8 +32,21 @@ const (
 	maxLocalizedVariantsPerField = 20
 )
 
+// softwareStatementExcludedClaims lists the software statement claims that are not copied into the client
+// metadata. They describe the statement itself or identify the client within this server.
+var softwareStatementExcludedClaims = []string{
+	"iss", "sub", "aud", "exp", "iat", "nbf", "jti", "client_id", "ou_id", "software_statement",
+}
+
+// Supported OpenID Connect application_type values.
+const (
+	ApplicationTypeWeb    = "web"
+	ApplicationTypeNative = "native"
+)
+
 // DCRRegistrationRequest represents the RFC 7591 Dynamic Client Registration request.
 type DCRRegistrationRequest struct {
+	ClientID                string                              `json:"client_id,omitempty"`
 	OUID                    string                              `json:"ou_id,omitempty"`
 	RedirectURIs            []string                            `json:"redirect_uris"`
 	GrantTypes              []oauth2const.GrantType             `json:"grant_types,omitempty"`
@@ -48,13 +61,19 @@ type DCRRegistrationRequest struct {
 	Contacts                []string                            `json:"contacts,omitempty"`
 	TosURI                  string                              `json:"tos_uri,omitempty"`
 	PolicyURI               string                              `json:"policy_uri,omitempty"`
+	ApplicationType         string                              `json:"application_type,omitempty"`
+	SoftwareStatement       string                              `json:"software_statement,omitempty"`
 
 	RequirePushedAuthorizationRequests bool   `json:"require_pushed_authorization_requests,omitempty"`
+	RequirePKCE                        bool   `json:"require_pkce,omitempty"`
 	UserInfoSignedResponseAlg          string `json:"userinfo_signed_response_alg,omitempty"`
 	UserInfoEncryptedResponseAlg       string `json:"userinfo_encrypted_response_alg,omitempty"`
 	UserInfoEncryptedResponseEnc       string `json:"userinfo_encrypted_response_enc,omitempty"`
 	IDTokenEncryptedResponseAlg        string `json:"id_token_encrypted_response_alg,omitempty"`
 	IDTokenEncryptedResponseEnc        string `json:"id_token_encrypted_response_enc,omitempty"`
+	AccessTokenLifetime                int64  `json:"access_token_lifetime,omitempty"`
+	RefreshTokenLifetime               int64  `json:"refresh_token_lifetime,omitempty"`
+	IDTokenLifetime                    int64  `json:"id_token_lifetime,omitempty"`
 	// Localized variant maps — populated from #-keyed JSON fields (e.g. "client_name#fr").
 	LocalizedClientName map[string]string `json:"-"`
 	LocalizedLogoURI    map[string]string `json:"-"`
@@ -143,14 +162,22 @@ type DCRRegistrationResponse struct {
 	Contacts                []string                            `json:"contacts,omitempty"`
 	TosURI                  string                              `json:"tos_uri,omitempty"`
 	PolicyURI               string                              `json:"policy_uri,omitempty"`
+	ApplicationType         string                              `json:"application_type,omitempty"`
+	SoftwareStatement       string                              `json:"software_statement,omitempty"`
 	AppID                   string                              `json:"app_id,omitempty"`
+	RegistrationAccessToken string                              `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string                              `json:"registration_client_uri,omitempty"`
 
 	RequirePushedAuthorizationRequests bool   `json:"require_pushed_authorization_requests,omitempty"`
+	RequirePKCE                        bool   `json:"require_pkce,omitempty"`
 	UserInfoSignedResponseAlg          string `json:"userinfo_signed_response_alg,omitempty"`
 	UserInfoEncryptedResponseAlg       string `json:"userinfo_encrypted_response_alg,omitempty"`
 	UserInfoEncryptedResponseEnc       string `json:"userinfo_encrypted_response_enc,omitempty"`
 	IDTokenEncryptedResponseAlg        string `json:"id_token_encrypted_response_alg,omitempty"`
 	IDTokenEncryptedResponseEnc        string `json:"id_token_encrypted_response_enc,omitempty"`
+	AccessTokenLifetime                int64  `json:"access_token_lifetime,omitempty"`
+	RefreshTokenLifetime               int64  `json:"refresh_token_lifetime,omitempty"`
+	IDTokenLifetime                    int64  `json:"id_token_lifetime,omitempty"`
 	// Localized variant maps — injected as #-keyed top-level fields during serialization.
 	LocalizedClientName map[string]string `json:"-"`
 	LocalizedLogoURI    map[string]string `json:"-"`
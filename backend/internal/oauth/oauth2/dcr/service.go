@@ -20,8 +20,11 @@ package dcr
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -32,8 +35,12 @@ import (
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauthutils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
@@ -44,6 +51,13 @@ type DCRServiceInterface interface {
 	RegisterClient(
 		ctx context.Context, request *DCRRegistrationRequest,
 	) (*DCRRegistrationResponse, *serviceerror.ServiceError)
+	GetClient(
+		ctx context.Context, clientID, registrationAccessToken string,
+	) (*DCRRegistrationResponse, *serviceerror.ServiceError)
+	UpdateClient(
+		ctx context.Context, clientID, registrationAccessToken string, request *DCRRegistrationRequest,
+	) (*DCRRegistrationResponse, *serviceerror.ServiceError)
+	DeleteClient(ctx context.Context, clientID, registrationAccessToken string) *serviceerror.ServiceError
 }
 
 // dcrService is the default implementation of DCRServiceInterface.
@@ -51,7 +65,9 @@ type dcrService struct {
 	appService    application.ApplicationServiceInterface
 	ouService     ou.OrganizationUnitServiceInterface
 	i18nService   i18nmgt.I18nServiceInterface
+	jwtService    jwt.JWTServiceInterface
 	transactioner transaction.Transactioner
+	tokenStore    registrationTokenStoreInterface
 }
 
 // newDCRService creates a new instance of dcrService.
@@ -59,13 +75,17 @@ func newDCRService(
 	appService application.ApplicationServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
+	jwtService jwt.JWTServiceInterface,
 	transactioner transaction.Transactioner,
+	tokenStore registrationTokenStoreInterface,
 ) DCRServiceInterface {
 	return &dcrService{
 		appService:    appService,
 		ouService:     ouService,
 		i18nService:   i18nService,
+		jwtService:    jwtService,
 		transactioner: transactioner,
+		tokenStore:    tokenStore,
 	}
 }
 
@@ -78,8 +98,11 @@ func (ds *dcrService) RegisterClient(ctx context.Context, request *DCRRegistrati
 		return nil, &ErrorInvalidRequestFormat
 	}
 
-	if request.JWKSUri != "" && len(request.JWKS) > 0 {
-		return nil, &ErrorJWKSConfigurationConflict
+	if svcErr := ds.applySoftwareStatement(request); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := validateRegistrationRequest(request); svcErr != nil {
+		return nil, svcErr
 	}
 
 	// TODO: Revisit OU for DCR apps
@@ -97,7 +120,7 @@ func (ds *dcrService) RegisterClient(ctx context.Context, request *DCRRegistrati
 		request.OUID = rootOUs.OrganizationUnits[0].ID
 	}
 
-	appDTO, svcErr := ds.convertDCRToApplication(request)
+	appDTO, svcErr := ds.convertDCRToApplication(request, "", "")
 	if svcErr != nil {
 		logger.Error("Failed to convert DCR request to application DTO", log.String("error", svcErr.Error.DefaultValue))
 		return nil, &ErrorServerError
@@ -133,6 +156,15 @@ func (ds *dcrService) RegisterClient(ctx context.Context, request *DCRRegistrati
 			return errors.New("conversion failed")
 		}
 
+		registrationAccessToken, err := ds.issueRegistrationAccessToken(txCtx, createdAppID)
+		if err != nil {
+			logger.Error("Failed to issue registration access token", log.String("appID", createdAppID),
+				log.Error(err))
+			capturedErr = &ErrorServerError
+			return err
+		}
+		response.RegistrationAccessToken = registrationAccessToken
+
 		return nil
 	})
 
@@ -170,6 +202,137 @@ func (ds *dcrService) RegisterClient(ctx context.Context, request *DCRRegistrati
 		return nil, writeErr
 	}
 
+	response.ApplicationType = request.ApplicationType
+	response.SoftwareStatement = request.SoftwareStatement
+	response.RegistrationClientURI = buildRegistrationClientURI(ctx, response.ClientID)
+	response.LocalizedClientName = request.LocalizedClientName
+	response.LocalizedLogoURI = request.LocalizedLogoURI
+	response.LocalizedTosURI = request.LocalizedTosURI
+	response.LocalizedPolicyURI = request.LocalizedPolicyURI
+
+	return response, nil
+}
+
+// GetClient returns the metadata of a dynamically registered client to the holder of its registration
+// access token, as defined in RFC 7592 Section 2.1.
+func (ds *dcrService) GetClient(ctx context.Context, clientID, registrationAccessToken string) (
+	*DCRRegistrationResponse, *serviceerror.ServiceError) {
+	appDTO, svcErr := ds.getAuthorizedClient(ctx, clientID, registrationAccessToken)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	clientName := appDTO.Name
+	if clientName == application.AppI18nRef(appDTO.ID, "name") {
+		clientName = ""
+	}
+	response, svcErr := ds.convertApplicationToDCRResponse(appDTO, clientName)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := ds.readLocalizedVariants(appDTO.ID, response); svcErr != nil {
+		return nil, svcErr
+	}
+
+	response.ApplicationType = ApplicationTypeWeb
+	if oauthConfig := appDTO.InboundAuthConfig[0].OAuthConfig; oauthConfig.RedirectURIPolicy != nil &&
+		oauthConfig.RedirectURIPolicy.AllowLoopbackAnyPort {
+		response.ApplicationType = ApplicationTypeNative
+	}
+	response.RegistrationAccessToken = registrationAccessToken
+	response.RegistrationClientURI = buildRegistrationClientURI(ctx, clientID)
+
+	return response, nil
+}
+
+// UpdateClient replaces the metadata of a dynamically registered client, as defined in RFC 7592 Section 2.2.
+// Settings that cannot be expressed as client metadata, such as the assigned flows, are kept as they are.
+// The client secret is not rotated.
+func (ds *dcrService) UpdateClient(ctx context.Context, clientID, registrationAccessToken string,
+	request *DCRRegistrationRequest) (*DCRRegistrationResponse, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService"))
+
+	existingApp, svcErr := ds.getAuthorizedClient(ctx, clientID, registrationAccessToken)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if request == nil {
+		return nil, &ErrorInvalidRequestFormat
+	}
+	if request.ClientID != clientID {
+		return nil, &ErrorClientIDMismatch
+	}
+	if svcErr := ds.applySoftwareStatement(request); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := validateRegistrationRequest(request); svcErr != nil {
+		return nil, svcErr
+	}
+
+	requestedApp, svcErr := ds.convertDCRToApplication(request, existingApp.ID, clientID)
+	if svcErr != nil {
+		logger.Error("Failed to convert DCR request to application DTO", log.String("error", svcErr.Error.DefaultValue))
+		return nil, &ErrorServerError
+	}
+
+	updatedApp := *existingApp
+	updatedApp.Name = requestedApp.Name
+	updatedApp.URL = requestedApp.URL
+	updatedApp.LogoURL = requestedApp.LogoURL
+	updatedApp.TosURI = requestedApp.TosURI
+	updatedApp.PolicyURI = requestedApp.PolicyURI
+	updatedApp.Contacts = requestedApp.Contacts
+	updatedApp.Certificate = requestedApp.Certificate
+
+	oauthConfig := *existingApp.InboundAuthConfig[0].OAuthConfig
+	requestedOAuthConfig := requestedApp.InboundAuthConfig[0].OAuthConfig
+	oauthConfig.ClientSecret = ""
+	oauthConfig.RedirectURIs = requestedOAuthConfig.RedirectURIs
+	oauthConfig.RedirectURIPolicy = requestedOAuthConfig.RedirectURIPolicy
+	oauthConfig.GrantTypes = requestedOAuthConfig.GrantTypes
+	oauthConfig.ResponseTypes = requestedOAuthConfig.ResponseTypes
+	oauthConfig.TokenEndpointAuthMethod = requestedOAuthConfig.TokenEndpointAuthMethod
+	oauthConfig.PublicClient = requestedOAuthConfig.PublicClient
+	oauthConfig.PKCERequired = requestedOAuthConfig.PKCERequired
+	oauthConfig.RequirePushedAuthorizationRequests = requestedOAuthConfig.RequirePushedAuthorizationRequests
+	oauthConfig.Scopes = requestedOAuthConfig.Scopes
+	oauthConfig.UserInfo = requestedOAuthConfig.UserInfo
+	oauthConfig.Token = requestedOAuthConfig.Token
+	updatedApp.InboundAuthConfig = []inboundmodel.InboundAuthConfigWithSecret{
+		{
+			Type:        inboundmodel.OAuthInboundAuthType,
+			OAuthConfig: &oauthConfig,
+		},
+	}
+
+	resultApp, svcErr := ds.appService.UpdateApplication(ctx, existingApp.ID, &updatedApp)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ServerErrorType {
+			logger.Error("Failed to update application via Application service",
+				log.String("error_code", svcErr.Code))
+			return nil, &ErrorServerError
+		}
+		logger.Debug("Failed to update application via Application service",
+			log.String("error_code", svcErr.Code))
+		return nil, ds.mapApplicationErrorToDCRError(svcErr)
+	}
+
+	if svcErr := ds.replaceLocalizedVariants(ctx, existingApp.ID, request); svcErr != nil {
+		return nil, svcErr
+	}
+
+	response, svcErr := ds.convertApplicationToDCRResponse(resultApp, request.ClientName)
+	if svcErr != nil {
+		logger.Error("Failed to convert application to DCR response",
+			log.String("error", svcErr.Error.DefaultValue))
+		return nil, svcErr
+	}
+
+	response.ApplicationType = request.ApplicationType
+	response.SoftwareStatement = request.SoftwareStatement
+	response.RegistrationAccessToken = registrationAccessToken
+	response.RegistrationClientURI = buildRegistrationClientURI(ctx, clientID)
 	response.LocalizedClientName = request.LocalizedClientName
 	response.LocalizedLogoURI = request.LocalizedLogoURI
 	response.LocalizedTosURI = request.LocalizedTosURI
@@ -178,8 +341,207 @@ func (ds *dcrService) RegisterClient(ctx context.Context, request *DCRRegistrati
 	return response, nil
 }
 
-// convertDCRToApplication converts DCR registration request to Application DTO.
-func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
+// DeleteClient deregisters a dynamically registered client, as defined in RFC 7592 Section 2.3.
+func (ds *dcrService) DeleteClient(
+	ctx context.Context, clientID, registrationAccessToken string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService"))
+
+	appDTO, svcErr := ds.getAuthorizedClient(ctx, clientID, registrationAccessToken)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if svcErr := ds.appService.DeleteApplication(ctx, appDTO.ID); svcErr != nil {
+		if svcErr.Type == serviceerror.ServerErrorType {
+			logger.Error("Failed to delete application via Application service",
+				log.String("error_code", svcErr.Code))
+			return &ErrorServerError
+		}
+		return ds.mapApplicationErrorToDCRError(svcErr)
+	}
+
+	// The client is gone at this point, so a token left behind can no longer be used and is not reported.
+	if err := ds.tokenStore.DeleteRegistrationToken(ctx, appDTO.ID); err != nil {
+		logger.Error("Failed to delete registration access token of a deleted client",
+			log.String("appID", appDTO.ID), log.Error(err))
+	}
+
+	return nil
+}
+
+// getAuthorizedClient resolves the application of a client and verifies that the registration access token
+// was issued for it. Unknown clients and invalid tokens are reported alike so that the client configuration
+// endpoint does not reveal which clients exist.
+func (ds *dcrService) getAuthorizedClient(ctx context.Context, clientID, registrationAccessToken string) (
+	*model.ApplicationDTO, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService"))
+
+	if clientID == "" || registrationAccessToken == "" {
+		return nil, &ErrorInvalidRegistrationToken
+	}
+
+	oauthClient, svcErr := ds.appService.GetOAuthApplication(ctx, clientID)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ServerErrorType {
+			logger.Error("Failed to retrieve OAuth client", log.String("error_code", svcErr.Code))
+			return nil, &ErrorServerError
+		}
+		return nil, &ErrorInvalidRegistrationToken
+	}
+
+	tokenHash, err := ds.tokenStore.GetRegistrationTokenHash(ctx, oauthClient.ID)
+	if err != nil {
+		if errors.Is(err, errRegistrationTokenNotFound) {
+			return nil, &ErrorInvalidRegistrationToken
+		}
+		logger.Error("Failed to retrieve registration access token", log.String("appID", oauthClient.ID),
+			log.Error(err))
+		return nil, &ErrorServerError
+	}
+	presentedHash := hash.GenerateThumbprintFromString(registrationAccessToken)
+	if subtle.ConstantTimeCompare([]byte(presentedHash), []byte(tokenHash)) != 1 {
+		return nil, &ErrorInvalidRegistrationToken
+	}
+
+	app, svcErr := ds.appService.GetApplication(ctx, oauthClient.ID)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ServerErrorType {
+			logger.Error("Failed to retrieve application", log.String("error_code", svcErr.Code))
+			return nil, &ErrorServerError
+		}
+		return nil, &ErrorInvalidRegistrationToken
+	}
+	if len(app.InboundAuthConfig) == 0 || app.InboundAuthConfig[0].OAuthConfig == nil {
+		logger.Error("Application of a registered client has no OAuth configuration",
+			log.String("appID", app.ID))
+		return nil, &ErrorServerError
+	}
+
+	return &model.ApplicationDTO{
+		ID:                 app.ID,
+		OUID:               app.OUID,
+		Name:               app.Name,
+		Description:        app.Description,
+		Template:           app.Template,
+		URL:                app.URL,
+		LogoURL:            app.LogoURL,
+		TosURI:             app.TosURI,
+		PolicyURI:          app.PolicyURI,
+		Contacts:           app.Contacts,
+		InboundAuthProfile: app.InboundAuthProfile,
+		InboundAuthConfig:  app.InboundAuthConfig,
+		Metadata:           app.Metadata,
+	}, nil
+}
+
+// issueRegistrationAccessToken generates a registration access token for a client and stores its hash.
+func (ds *dcrService) issueRegistrationAccessToken(ctx context.Context, appID string) (string, error) {
+	token, err := oauthutils.GenerateRegistrationAccessToken()
+	if err != nil {
+		return "", err
+	}
+	if err := ds.tokenStore.CreateRegistrationToken(ctx, appID, hash.GenerateThumbprintFromString(token)); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// buildRegistrationClientURI builds the client configuration endpoint URI of a client.
+func buildRegistrationClientURI(ctx context.Context, clientID string) string {
+	return config.GetPublicURL(ctx) + oauth2const.OAuth2DCREndpoint + "/" + url.PathEscape(clientID)
+}
+
+// applySoftwareStatement validates the software statement of a request and copies the client metadata it
+// carries into the request. Metadata in the statement takes precedence over the metadata sent in the request,
+// as defined in RFC 7591 Section 2.3.
+func (ds *dcrService) applySoftwareStatement(request *DCRRegistrationRequest) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService"))
+	statementConfig := config.GetServerRuntime().Config.OAuth.DCR.SoftwareStatement
+
+	if request.SoftwareStatement == "" {
+		if statementConfig.Required {
+			return &ErrorInvalidSoftwareStatement
+		}
+		return nil
+	}
+
+	claims, err := jwt.DecodeJWTPayload(request.SoftwareStatement)
+	if err != nil {
+		logger.Debug("Failed to decode software statement", log.Error(err))
+		return &ErrorInvalidSoftwareStatement
+	}
+	issuer, _ := claims["iss"].(string)
+	issuerIndex := slices.IndexFunc(statementConfig.TrustedIssuers,
+		func(trustedIssuer config.SoftwareStatementIssuer) bool {
+			return issuer != "" && trustedIssuer.Issuer == issuer
+		})
+	if issuerIndex < 0 {
+		logger.Debug("Software statement is not issued by a trusted issuer", log.String("issuer", issuer))
+		return &ErrorUnapprovedSoftwareStatement
+	}
+	if svcErr := ds.jwtService.VerifyJWTSignatureWithJWKS(
+		request.SoftwareStatement, statementConfig.TrustedIssuers[issuerIndex].JWKSURL); svcErr != nil {
+		logger.Debug("Failed to verify software statement signature", log.String("issuer", issuer),
+			log.String("error_code", svcErr.Code))
+		return &ErrorInvalidSoftwareStatement
+	}
+	if exp, ok := claims["exp"].(float64); ok &&
+		time.Now().Unix() >= int64(exp)+config.GetServerRuntime().Config.JWT.Leeway {
+		logger.Debug("Software statement has expired", log.String("issuer", issuer))
+		return &ErrorInvalidSoftwareStatement
+	}
+
+	for _, claim := range softwareStatementExcludedClaims {
+		delete(claims, claim)
+	}
+	_, hasJWKS := claims["jwks"]
+	_, hasJWKSURI := claims["jwks_uri"]
+	if hasJWKS || hasJWKSURI {
+		request.JWKS = nil
+		request.JWKSUri = ""
+	}
+	metadata, err := json.Marshal(claims)
+	if err != nil {
+		return &ErrorInvalidSoftwareStatement
+	}
+	if err := json.Unmarshal(metadata, request); err != nil {
+		logger.Debug("Software statement carries invalid client metadata", log.Error(err))
+		return &ErrorInvalidSoftwareStatement
+	}
+
+	return nil
+}
+
+// validateRegistrationRequest validates the client metadata of a registration or update request and defaults
+// the application type.
+func validateRegistrationRequest(request *DCRRegistrationRequest) *serviceerror.ServiceError {
+	if request.JWKSUri != "" && len(request.JWKS) > 0 {
+		return &ErrorJWKSConfigurationConflict
+	}
+
+	if svcErr := validateTokenLifetimes(request); svcErr != nil {
+		return svcErr
+	}
+
+	if request.ApplicationType == "" {
+		request.ApplicationType = ApplicationTypeWeb
+	}
+	switch request.ApplicationType {
+	case ApplicationTypeWeb:
+	case ApplicationTypeNative:
+		if svcErr := validateNativeRedirectURIs(request.RedirectURIs); svcErr != nil {
+			return svcErr
+		}
+	default:
+		return &ErrorInvalidApplicationType
+	}
+
+	return nil
+}
+
+// convertDCRToApplication converts DCR registration request to Application DTO. An empty appID or clientID
+// is generated for a new registration.
+func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest, appID, clientID string) (
 	*model.ApplicationDTO, *serviceerror.ServiceError) {
 	isPublicClient := request.TokenEndpointAuthMethod == oauth2const.TokenEndpointAuthMethodNone
 
@@ -207,22 +569,26 @@ func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
 	}
 
 	// Pre-generate the application ID so we can build an i18n template reference if needed.
-	appID, uuidErr := sysutils.GenerateUUIDv7()
-	if uuidErr != nil {
-		return nil, &ErrorServerError
+	if appID == "" {
+		generatedAppID, uuidErr := sysutils.GenerateUUIDv7()
+		if uuidErr != nil {
+			return nil, &ErrorServerError
+		}
+		appID = generatedAppID
 	}
 
 	// Generate client ID if client_name is not provided and use it as both app name and client ID.
 	// When localized variants are present without a client_name, use an i18n ref as the app name
 	// so the UI resolves the display name from the i18n table rather than falling back to the clientID.
-	var clientID string
 	appName := request.ClientName
 	if appName == "" {
-		generatedClientID, err := oauthutils.GenerateOAuth2ClientID()
-		if err != nil {
-			return nil, &ErrorServerError
+		if clientID == "" {
+			generatedClientID, err := oauthutils.GenerateOAuth2ClientID()
+			if err != nil {
+				return nil, &ErrorServerError
+			}
+			clientID = generatedClientID
 		}
-		clientID = generatedClientID
 		if len(request.LocalizedClientName) > 0 {
 			appName = application.AppI18nRef(appID, "name")
 		} else {
@@ -236,11 +602,12 @@ func (ds *dcrService) convertDCRToApplication(request *DCRRegistrationRequest) (
 	oauthAppConfig := &inboundmodel.OAuthConfigWithSecret{
 		ClientID:                           clientID,
 		RedirectURIs:                       request.RedirectURIs,
+		RedirectURIPolicy:                  buildRedirectURIPolicy(request),
 		GrantTypes:                         request.GrantTypes,
 		ResponseTypes:                      request.ResponseTypes,
 		TokenEndpointAuthMethod:            request.TokenEndpointAuthMethod,
 		PublicClient:                       isPublicClient,
-		PKCERequired:                       isPublicClient,
+		PKCERequired:                       isPublicClient || request.RequirePKCE,
 		RequirePushedAuthorizationRequests: request.RequirePushedAuthorizationRequests,
 		Scopes:                             scopes,
 		UserInfo:                           buildUserInfoConfig(request),
@@ -302,23 +669,89 @@ func buildUserInfoConfig(request *DCRRegistrationRequest) *inboundmodel.UserInfo
 
 // buildTokenConfig builds the OAuthTokenConfig from DCR request fields.
 func buildTokenConfig(request *DCRRegistrationRequest) *inboundmodel.OAuthTokenConfig {
-	idToken := buildIDTokenConfig(request)
-	if idToken == nil {
+	tokenConfig := &inboundmodel.OAuthTokenConfig{IDToken: buildIDTokenConfig(request)}
+	if request.AccessTokenLifetime > 0 {
+		tokenConfig.AccessToken = &inboundmodel.AccessTokenConfig{ValidityPeriod: request.AccessTokenLifetime}
+	}
+	if request.RefreshTokenLifetime > 0 {
+		tokenConfig.RefreshToken = &inboundmodel.RefreshTokenConfig{ValidityPeriod: request.RefreshTokenLifetime}
+	}
+	if request.IDTokenLifetime > 0 {
+		if tokenConfig.IDToken == nil {
+			tokenConfig.IDToken = &inboundmodel.IDTokenConfig{}
+		}
+		tokenConfig.IDToken.ValidityPeriod = request.IDTokenLifetime
+	}
+	if tokenConfig.AccessToken == nil && tokenConfig.RefreshToken == nil && tokenConfig.IDToken == nil {
+		return nil
+	}
+	return tokenConfig
+}
+
+// validateTokenLifetimes checks that the requested token lifetimes are not negative and do not exceed the
+// maximums configured for dynamically registered clients. A zero maximum places no upper bound.
+func validateTokenLifetimes(request *DCRRegistrationRequest) *serviceerror.ServiceError {
+	if request.AccessTokenLifetime == 0 && request.IDTokenLifetime == 0 && request.RefreshTokenLifetime == 0 {
 		return nil
 	}
-	return &inboundmodel.OAuthTokenConfig{IDToken: idToken}
+	dcrConfig := config.GetServerRuntime().Config.OAuth.DCR
+	for _, lifetime := range []struct {
+		value, maximum int64
+	}{
+		{request.AccessTokenLifetime, dcrConfig.MaxTokenLifetime},
+		{request.IDTokenLifetime, dcrConfig.MaxTokenLifetime},
+		{request.RefreshTokenLifetime, dcrConfig.MaxRefreshTokenLifetime},
+	} {
+		if lifetime.value < 0 || (lifetime.maximum > 0 && lifetime.value > lifetime.maximum) {
+			return &ErrorInvalidTokenLifetime
+		}
+	}
+	return nil
 }
 
 // buildIDTokenConfig maps ID token encryption fields from a DCR request to an IDTokenConfig.
+// When only the key-management algorithm is given, the content encryption defaults to A128CBC-HS256
+// as defined in OpenID Connect Dynamic Client Registration.
 func buildIDTokenConfig(request *DCRRegistrationRequest) *inboundmodel.IDTokenConfig {
 	if request.IDTokenEncryptedResponseAlg == "" && request.IDTokenEncryptedResponseEnc == "" {
 		return nil
 	}
+	encryptionEnc := request.IDTokenEncryptedResponseEnc
+	if encryptionEnc == "" {
+		encryptionEnc = string(jwe.A128CBCHS256)
+	}
 	return &inboundmodel.IDTokenConfig{
 		ResponseType:  inboundmodel.IDTokenResponseTypeJWE,
 		EncryptionAlg: request.IDTokenEncryptedResponseAlg,
-		EncryptionEnc: request.IDTokenEncryptedResponseEnc,
+		EncryptionEnc: encryptionEnc,
+	}
+}
+
+// validateNativeRedirectURIs checks that a native client registers only custom scheme or http loopback
+// redirect URIs, as required by OpenID Connect Dynamic Client Registration.
+func validateNativeRedirectURIs(redirectURIs []string) *serviceerror.ServiceError {
+	for _, redirectURI := range redirectURIs {
+		parsed, err := url.Parse(redirectURI)
+		if err != nil || parsed.Scheme == "" {
+			return &ErrorInvalidNativeRedirectURI
+		}
+		if inboundmodel.IsCustomScheme(parsed.Scheme) {
+			continue
+		}
+		if parsed.Scheme != "http" || !inboundmodel.IsLoopbackHost(parsed.Hostname()) {
+			return &ErrorInvalidNativeRedirectURI
+		}
+	}
+	return nil
+}
+
+// buildRedirectURIPolicy builds the redirect URI policy for the client. Native clients listen on an
+// ephemeral loopback port, so their loopback redirect URIs match on any port (RFC 8252 Section 7.3).
+func buildRedirectURIPolicy(request *DCRRegistrationRequest) *inboundmodel.RedirectURIPolicy {
+	if request.ApplicationType != ApplicationTypeNative {
+		return nil
 	}
+	return &inboundmodel.RedirectURIPolicy{AllowLoopbackAnyPort: true}
 }
 
 // convertApplicationToDCRResponse converts Application DTO to DCR registration response.
@@ -358,9 +791,19 @@ func (ds *dcrService) convertApplicationToDCRResponse(appDTO *model.ApplicationD
 	}
 
 	var idTokenEncryptedAlg, idTokenEncryptedEnc string
-	if oauthConfig.Token != nil && oauthConfig.Token.IDToken != nil {
-		idTokenEncryptedAlg = oauthConfig.Token.IDToken.EncryptionAlg
-		idTokenEncryptedEnc = oauthConfig.Token.IDToken.EncryptionEnc
+	var accessTokenLifetime, refreshTokenLifetime, idTokenLifetime int64
+	if oauthConfig.Token != nil {
+		if oauthConfig.Token.IDToken != nil {
+			idTokenEncryptedAlg = oauthConfig.Token.IDToken.EncryptionAlg
+			idTokenEncryptedEnc = oauthConfig.Token.IDToken.EncryptionEnc
+			idTokenLifetime = oauthConfig.Token.IDToken.ValidityPeriod
+		}
+		if oauthConfig.Token.AccessToken != nil {
+			accessTokenLifetime = oauthConfig.Token.AccessToken.ValidityPeriod
+		}
+		if oauthConfig.Token.RefreshToken != nil {
+			refreshTokenLifetime = oauthConfig.Token.RefreshToken.ValidityPeriod
+		}
 	}
 
 	response := &DCRRegistrationResponse{
@@ -382,11 +825,15 @@ func (ds *dcrService) convertApplicationToDCRResponse(appDTO *model.ApplicationD
 		Contacts:                           appDTO.Contacts,
 		AppID:                              appDTO.ID,
 		RequirePushedAuthorizationRequests: oauthConfig.RequirePushedAuthorizationRequests,
+		RequirePKCE:                        oauthConfig.PKCERequired,
 		UserInfoSignedResponseAlg:          userInfoSignedAlg,
 		UserInfoEncryptedResponseAlg:       userInfoEncryptedAlg,
 		UserInfoEncryptedResponseEnc:       userInfoEncryptedEnc,
 		IDTokenEncryptedResponseAlg:        idTokenEncryptedAlg,
 		IDTokenEncryptedResponseEnc:        idTokenEncryptedEnc,
+		AccessTokenLifetime:                accessTokenLifetime,
+		RefreshTokenLifetime:               refreshTokenLifetime,
+		IDTokenLifetime:                    idTokenLifetime,
 	}
 
 	return response, nil
@@ -459,6 +906,63 @@ func (ds *dcrService) writeLocalizedVariants(
 	return nil
 }
 
+// replaceLocalizedVariants replaces the localized variants of a client with the ones in an update request.
+func (ds *dcrService) replaceLocalizedVariants(
+	ctx context.Context, appID string, request *DCRRegistrationRequest) *serviceerror.ServiceError {
+	if ds.i18nService == nil {
+		return nil
+	}
+	for _, field := range []string{"name", "logo_uri", "tos_uri", "policy_uri"} {
+		if svcErr := ds.i18nService.DeleteTranslationsByKey(
+			ctx, application.AppI18nNamespace(), application.AppI18nKey(appID, field)); svcErr != nil {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService")).Error(
+				"Failed to delete localized variant before update",
+				log.String("appID", appID), log.String("field", field))
+			return &ErrorServerError
+		}
+	}
+	return ds.writeLocalizedVariants(ctx, appID, request)
+}
+
+// readLocalizedVariants populates a DCR response with the localized variants stored for a client. The value
+// stored under SystemLanguage is the non-tagged value of the field.
+func (ds *dcrService) readLocalizedVariants(
+	appID string, response *DCRRegistrationResponse) *serviceerror.ServiceError {
+	if ds.i18nService == nil {
+		return nil
+	}
+	translations, svcErr := ds.i18nService.GetTranslationsByNamespace(application.AppI18nNamespace())
+	if svcErr != nil {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DCRService")).Error(
+			"Failed to read localized variants", log.String("appID", appID),
+			log.String("errorCode", svcErr.Code))
+		return &ErrorServerError
+	}
+	fields := []struct {
+		key        string
+		defaultVal *string
+		variants   *map[string]string
+	}{
+		{application.AppI18nKey(appID, "name"), &response.ClientName, &response.LocalizedClientName},
+		{application.AppI18nKey(appID, "logo_uri"), &response.LogoURI, &response.LocalizedLogoURI},
+		{application.AppI18nKey(appID, "tos_uri"), &response.TosURI, &response.LocalizedTosURI},
+		{application.AppI18nKey(appID, "policy_uri"), &response.PolicyURI, &response.LocalizedPolicyURI},
+	}
+	for _, f := range fields {
+		for tag, val := range translations[f.key] {
+			if tag == i18nmgt.SystemLanguage {
+				*f.defaultVal = val
+				continue
+			}
+			if *f.variants == nil {
+				*f.variants = make(map[string]string)
+			}
+			(*f.variants)[tag] = val
+		}
+	}
+	return nil
+}
+
 // mapApplicationErrorToDCRError maps Application service errors to DCR standard errors.
 func (ds *dcrService) mapApplicationErrorToDCRError(
 	appErr *serviceerror.ServiceError) *serviceerror.ServiceError {
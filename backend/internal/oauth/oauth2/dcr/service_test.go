@@ -20,7 +20,11 @@ package dcr
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -30,11 +34,14 @@ import (
 	"github.com/thunder-id/thunderid/internal/cert"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/tests/mocks/applicationmock"
 	i18nmock "github.com/thunder-id/thunderid/tests/mocks/i18n/mgtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 )
 
@@ -43,6 +50,8 @@ type DCRServiceTestSuite struct {
 	suite.Suite
 	mockAppService *applicationmock.ApplicationServiceInterfaceMock
 	mockOUService  *oumock.OrganizationUnitServiceInterfaceMock
+	mockJWTService *jwtmock.JWTServiceInterfaceMock
+	mockTokenStore *registrationTokenStoreInterfaceMock
 	service        DCRServiceInterface
 }
 
@@ -58,14 +67,28 @@ func (m *MockTransactioner) Transact(ctx context.Context, txFunc func(context.Co
 }
 
 func (s *DCRServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	s.Require().NoError(config.InitializeServerRuntime("", &config.Config{
+		Server: config.ServerConfig{Hostname: "localhost", Port: 8090},
+	}))
 	s.mockAppService = applicationmock.NewApplicationServiceInterfaceMock(s.T())
 	s.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(s.T())
-	s.service = newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{})
+	s.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockTokenStore = newRegistrationTokenStoreInterfaceMock(s.T())
+	s.mockTokenStore.On("CreateRegistrationToken", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Maybe()
+	s.service = newDCRService(s.mockAppService, s.mockOUService, nil, s.mockJWTService,
+		&MockTransactioner{}, s.mockTokenStore)
+}
+
+func (s *DCRServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
 }
 
 // TestNewDCRService tests the service constructor
 func (s *DCRServiceTestSuite) TestNewDCRService() {
-	service := newDCRService(s.mockAppService, s.mockOUService, nil, &MockTransactioner{})
+	service := newDCRService(s.mockAppService, s.mockOUService, nil, s.mockJWTService,
+		&MockTransactioner{}, s.mockTokenStore)
 	s.NotNil(service)
 	s.Implements((*DCRServiceInterface)(nil), service)
 }
@@ -431,6 +454,171 @@ func (s *DCRServiceTestSuite) TestRegisterClient_RequirePushedAuthorizationReque
 	s.True(response.RequirePushedAuthorizationRequests)
 }
 
+func (s *DCRServiceTestSuite) TestRegisterClient_RequirePKCE() {
+	request := &DCRRegistrationRequest{
+		OUID:                    "test-ou-1",
+		RedirectURIs:            []string{"https://client.example.com/callback"},
+		GrantTypes:              []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+		ClientName:              "Test Client",
+		TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodClientSecretBasic,
+		RequirePKCE:             true,
+	}
+
+	appDTO := &model.ApplicationDTO{
+		ID:   "app-id",
+		Name: "Test Client",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
+					ClientID:     "client-id",
+					ClientSecret: "client-secret",
+					Scopes:       []string{},
+					PKCERequired: true,
+				},
+			},
+		},
+	}
+
+	s.mockAppService.On(
+		"CreateApplication", mock.Anything,
+		mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+			if len(dto.InboundAuthConfig) == 0 || dto.InboundAuthConfig[0].OAuthConfig == nil {
+				return false
+			}
+			oauthConfig := dto.InboundAuthConfig[0].OAuthConfig
+			return oauthConfig.PKCERequired && !oauthConfig.PublicClient
+		}),
+	).Return(appDTO, (*serviceerror.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), request)
+
+	s.NotNil(response)
+	s.Nil(err)
+	s.True(response.RequirePKCE)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_PublicClientRequiresPKCE() {
+	request := &DCRRegistrationRequest{
+		OUID:                    "test-ou-1",
+		RedirectURIs:            []string{"https://client.example.com/callback"},
+		GrantTypes:              []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+		ClientName:              "Test Client",
+		TokenEndpointAuthMethod: oauth2const.TokenEndpointAuthMethodNone,
+	}
+
+	appDTO := &model.ApplicationDTO{
+		ID:   "app-id",
+		Name: "Test Client",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
+					ClientID:     "client-id",
+					Scopes:       []string{},
+					PublicClient: true,
+					PKCERequired: true,
+				},
+			},
+		},
+	}
+
+	s.mockAppService.On(
+		"CreateApplication", mock.Anything,
+		mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+			if len(dto.InboundAuthConfig) == 0 || dto.InboundAuthConfig[0].OAuthConfig == nil {
+				return false
+			}
+			oauthConfig := dto.InboundAuthConfig[0].OAuthConfig
+			return oauthConfig.PKCERequired && oauthConfig.PublicClient
+		}),
+	).Return(appDTO, (*serviceerror.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), request)
+
+	s.NotNil(response)
+	s.Nil(err)
+	s.True(response.RequirePKCE)
+}
+
+// TestRegisterClient_NativeApplicationType tests that native clients get loopback any-port matching.
+func (s *DCRServiceTestSuite) TestRegisterClient_NativeApplicationType() {
+	request := &DCRRegistrationRequest{
+		OUID:            "test-ou-1",
+		RedirectURIs:    []string{"http://127.0.0.1/callback", "com.example.app:/callback"},
+		GrantTypes:      []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+		ClientName:      "Native Client",
+		ApplicationType: ApplicationTypeNative,
+	}
+
+	appDTO := &model.ApplicationDTO{
+		ID:   "app-id",
+		Name: "Native Client",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
+					ClientID: "client-id",
+					Scopes:   []string{},
+				},
+			},
+		},
+	}
+
+	s.mockAppService.On(
+		"CreateApplication", mock.Anything,
+		mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+			if len(dto.InboundAuthConfig) == 0 || dto.InboundAuthConfig[0].OAuthConfig == nil {
+				return false
+			}
+			policy := dto.InboundAuthConfig[0].OAuthConfig.RedirectURIPolicy
+			return policy != nil && policy.AllowLoopbackAnyPort
+		}),
+	).Return(appDTO, (*serviceerror.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), request)
+
+	s.NotNil(response)
+	s.Nil(err)
+	s.Equal(ApplicationTypeNative, response.ApplicationType)
+}
+
+// TestRegisterClient_NativeApplicationTypeInvalidRedirectURI tests that native clients cannot register
+// redirect URIs other than custom scheme or http loopback URIs.
+func (s *DCRServiceTestSuite) TestRegisterClient_NativeApplicationTypeInvalidRedirectURI() {
+	for _, redirectURI := range []string{"https://client.example.com/callback", "http://client.example.com/cb"} {
+		request := &DCRRegistrationRequest{
+			OUID:            "test-ou-1",
+			RedirectURIs:    []string{redirectURI},
+			GrantTypes:      []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+			ApplicationType: ApplicationTypeNative,
+		}
+
+		response, err := s.service.RegisterClient(context.Background(), request)
+
+		s.Nil(response)
+		s.NotNil(err)
+		s.Equal(ErrorInvalidNativeRedirectURI.Code, err.Code)
+		s.Equal(ErrorInvalidNativeRedirectURI.ErrorDescription, err.ErrorDescription)
+	}
+	s.mockAppService.AssertNotCalled(s.T(), "CreateApplication", mock.Anything, mock.Anything)
+}
+
+// TestRegisterClient_InvalidApplicationType tests that unsupported application types are rejected.
+func (s *DCRServiceTestSuite) TestRegisterClient_InvalidApplicationType() {
+	request := &DCRRegistrationRequest{
+		RedirectURIs:    []string{"https://client.example.com/callback"},
+		GrantTypes:      []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+		ApplicationType: "desktop",
+	}
+
+	response, err := s.service.RegisterClient(context.Background(), request)
+
+	s.Nil(response)
+	s.NotNil(err)
+	s.Equal(ErrorInvalidApplicationType.Code, err.Code)
+}
+
 // TestRegisterClient_EmptyInboundAuthConfig verifies that a created application returned by
 // the application service without any OAuth inbound config is treated as a server-side
 // invariant violation: the DCR endpoint must NOT silently respond 200 with an empty body.
@@ -463,7 +651,8 @@ func (s *DCRServiceTestSuite) TestRegisterClient_EmptyInboundAuthConfig() {
 // and that the non-tagged default is stored under SystemLanguage.
 func (s *DCRServiceTestSuite) TestRegisterClient_WithLocalizedVariants() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService,
+		&MockTransactioner{}, s.mockTokenStore)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -518,7 +707,8 @@ func (s *DCRServiceTestSuite) TestRegisterClient_WithLocalizedVariants() {
 // client_name is provided (no localized variants), it is stored under SystemLanguage.
 func (s *DCRServiceTestSuite) TestRegisterClient_DefaultOnlyStoresSystemLanguage() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService,
+		&MockTransactioner{}, s.mockTokenStore)
 
 	request := &DCRRegistrationRequest{
 		OUID:       "test-ou-1",
@@ -563,7 +753,8 @@ func (s *DCRServiceTestSuite) TestRegisterClient_DefaultOnlyStoresSystemLanguage
 // default and an explicit #SystemLanguage-tagged variant are provided, the tagged variant wins.
 func (s *DCRServiceTestSuite) TestRegisterClient_TaggedSystemLanguageWinsOverDefault() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService,
+		&MockTransactioner{}, s.mockTokenStore)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -611,7 +802,8 @@ func (s *DCRServiceTestSuite) TestRegisterClient_TaggedSystemLanguageWinsOverDef
 // partial-row cleanup and app compensation delete.
 func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService,
+		&MockTransactioner{}, s.mockTokenStore)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -662,7 +854,8 @@ func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure()
 // validation must return ErrorInvalidClientMetadata and trigger the compensation rollback.
 func (s *DCRServiceTestSuite) TestRegisterClient_InvalidLocalizedURI() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService,
+		&MockTransactioner{}, s.mockTokenStore)
 
 	request := &DCRRegistrationRequest{
 		OUID:             "test-ou-1",
@@ -724,6 +917,31 @@ func (s *DCRServiceTestSuite) TestBuildIDTokenConfig_MapsAlgAndEnc() {
 	s.Equal("A256GCM", cfg.EncryptionEnc)
 }
 
+// TestBuildIDTokenConfig_DefaultsEnc verifies that buildIDTokenConfig defaults the content encryption
+// algorithm to A128CBC-HS256 when only the key-management algorithm is registered.
+func (s *DCRServiceTestSuite) TestBuildIDTokenConfig_DefaultsEnc() {
+	req := &DCRRegistrationRequest{
+		IDTokenEncryptedResponseAlg: "RSA-OAEP-256",
+	}
+	cfg := buildIDTokenConfig(req)
+	s.Require().NotNil(cfg)
+	s.Equal(inboundmodel.IDTokenResponseTypeJWE, cfg.ResponseType)
+	s.Equal("RSA-OAEP-256", cfg.EncryptionAlg)
+	s.Equal("A128CBC-HS256", cfg.EncryptionEnc)
+}
+
+// TestBuildIDTokenConfig_EncWithoutAlg verifies that buildIDTokenConfig leaves the key-management
+// algorithm empty when only the content encryption algorithm is registered, so that validation rejects it.
+func (s *DCRServiceTestSuite) TestBuildIDTokenConfig_EncWithoutAlg() {
+	req := &DCRRegistrationRequest{
+		IDTokenEncryptedResponseEnc: "A256GCM",
+	}
+	cfg := buildIDTokenConfig(req)
+	s.Require().NotNil(cfg)
+	s.Empty(cfg.EncryptionAlg)
+	s.Equal("A256GCM", cfg.EncryptionEnc)
+}
+
 // TestRegisterClient_WithIDTokenEncryption verifies that DCR registration round-trips
 // IDTokenEncryptedResponseAlg and IDTokenEncryptedResponseEnc correctly.
 func (s *DCRServiceTestSuite) TestRegisterClient_WithIDTokenEncryption() {
@@ -779,11 +997,126 @@ func (s *DCRServiceTestSuite) TestRegisterClient_WithIDTokenEncryption() {
 	s.mockAppService.AssertExpectations(s.T())
 }
 
+// TestRegisterClient_WithTokenLifetimes verifies that the registered token lifetimes are stored on the
+// application and returned in the registration response.
+func (s *DCRServiceTestSuite) TestRegisterClient_WithTokenLifetimes() {
+	s.initDCRConfig(7200, 604800)
+	request := &DCRRegistrationRequest{
+		OUID:                 "test-ou-1",
+		ClientName:           "Lifetime Client",
+		AccessTokenLifetime:  600,
+		RefreshTokenLifetime: 86400,
+		IDTokenLifetime:      300,
+	}
+
+	appDTO := &model.ApplicationDTO{
+		ID:   "app-id",
+		Name: "Lifetime Client",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
+					ClientID: "client-id",
+					Token: &inboundmodel.OAuthTokenConfig{
+						AccessToken:  &inboundmodel.AccessTokenConfig{ValidityPeriod: 600},
+						RefreshToken: &inboundmodel.RefreshTokenConfig{ValidityPeriod: 86400},
+						IDToken:      &inboundmodel.IDTokenConfig{ValidityPeriod: 300},
+					},
+				},
+			},
+		},
+	}
+
+	s.mockAppService.On("CreateApplication", mock.Anything,
+		mock.MatchedBy(func(dto *model.ApplicationDTO) bool {
+			token := dto.InboundAuthConfig[0].OAuthConfig.Token
+			return token != nil && token.AccessToken.ValidityPeriod == 600 &&
+				token.RefreshToken.ValidityPeriod == 86400 && token.IDToken.ValidityPeriod == 300 &&
+				token.IDToken.ResponseType == ""
+		}),
+	).Return(appDTO, (*serviceerror.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), request)
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Equal(int64(600), response.AccessTokenLifetime)
+	s.Equal(int64(86400), response.RefreshTokenLifetime)
+	s.Equal(int64(300), response.IDTokenLifetime)
+}
+
+// TestRegisterClient_InvalidTokenLifetime verifies that token lifetimes that are negative or exceed the
+// configured maximums are rejected.
+func (s *DCRServiceTestSuite) TestRegisterClient_InvalidTokenLifetime() {
+	testCases := []struct {
+		name    string
+		request DCRRegistrationRequest
+	}{
+		{"NegativeAccessToken", DCRRegistrationRequest{AccessTokenLifetime: -1}},
+		{"AccessTokenAboveMaximum", DCRRegistrationRequest{AccessTokenLifetime: 7201}},
+		{"IDTokenAboveMaximum", DCRRegistrationRequest{IDTokenLifetime: 7201}},
+		{"RefreshTokenAboveMaximum", DCRRegistrationRequest{RefreshTokenLifetime: 604801}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.initDCRConfig(7200, 604800)
+			request := tc.request
+
+			response, err := s.service.RegisterClient(context.Background(), &request)
+
+			s.Nil(response)
+			s.Require().NotNil(err)
+			s.Equal(ErrorInvalidTokenLifetime.Error.Key, err.Error.Key)
+		})
+	}
+}
+
+// TestRegisterClient_TokenLifetimeWithoutMaximum verifies that a zero maximum places no upper bound.
+func (s *DCRServiceTestSuite) TestRegisterClient_TokenLifetimeWithoutMaximum() {
+	s.initDCRConfig(0, 0)
+	request := &DCRRegistrationRequest{
+		OUID:                 "test-ou-1",
+		ClientName:           "Lifetime Client",
+		RefreshTokenLifetime: 31536000,
+	}
+	appDTO := &model.ApplicationDTO{
+		ID: "app-id",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type:        inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{ClientID: "client-id"},
+			},
+		},
+	}
+	s.mockAppService.On("CreateApplication", mock.Anything, mock.AnythingOfType("*model.ApplicationDTO")).
+		Return(appDTO, (*serviceerror.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), request)
+
+	s.Nil(err)
+	s.NotNil(response)
+}
+
+// initDCRConfig initializes the server runtime with the given DCR token lifetime maximums.
+func (s *DCRServiceTestSuite) initDCRConfig(maxTokenLifetime, maxRefreshTokenLifetime int64) {
+	config.ResetServerRuntime()
+	s.Require().NoError(config.InitializeServerRuntime("", &config.Config{
+		OAuth: config.OAuthConfig{
+			DCR: config.DCRConfig{
+				MaxTokenLifetime:        maxTokenLifetime,
+				MaxRefreshTokenLifetime: maxRefreshTokenLifetime,
+			},
+		},
+	}))
+}
+
 // TestRegisterClient_LocalizedVariantsWriteFailure_ClientError tests that a ClientErrorType
 // i18n error maps to ErrorServerError to avoid leaking internal details to external callers.
 func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure_ClientError() {
 	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
-	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, &MockTransactioner{})
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService,
+		&MockTransactioner{}, s.mockTokenStore)
 
 	request := &DCRRegistrationRequest{
 		OUID:                "test-ou-1",
@@ -832,3 +1165,459 @@ func (s *DCRServiceTestSuite) TestRegisterClient_LocalizedVariantsWriteFailure_C
 	mockI18n.AssertExpectations(s.T())
 	s.mockAppService.AssertExpectations(s.T())
 }
+
+// registeredTestApplication returns an application of a dynamically registered client.
+func registeredTestApplication() *model.Application {
+	return &model.Application{
+		ID:   "app-id",
+		OUID: "test-ou-1",
+		Name: "Test Client",
+		URL:  "https://client.example.com",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
+					ClientID:     "client-id",
+					RedirectURIs: []string{"https://client.example.com/callback"},
+					GrantTypes:   []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+					Scopes:       []string{"openid"},
+				},
+			},
+		},
+	}
+}
+
+// expectAuthorizedClient sets up the mocks for a client whose registration access token is valid.
+func (s *DCRServiceTestSuite) expectAuthorizedClient(app *model.Application) {
+	s.mockAppService.On("GetOAuthApplication", mock.Anything, "client-id").
+		Return(&inboundmodel.OAuthClient{ID: app.ID, ClientID: "client-id"}, (*serviceerror.ServiceError)(nil))
+	s.mockTokenStore.On("GetRegistrationTokenHash", mock.Anything, app.ID).
+		Return(hash.GenerateThumbprintFromString("registration-token"), nil)
+	s.mockAppService.On("GetApplication", mock.Anything, app.ID).Return(app, (*serviceerror.ServiceError)(nil))
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_IssuesRegistrationAccessToken() {
+	tokenStore := newRegistrationTokenStoreInterfaceMock(s.T())
+	svc := newDCRService(s.mockAppService, s.mockOUService, nil, s.mockJWTService,
+		&MockTransactioner{}, tokenStore)
+
+	appDTO := &model.ApplicationDTO{
+		ID: "app-id",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type:        inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{ClientID: "client-id"},
+			},
+		},
+	}
+	s.mockAppService.On(
+		"CreateApplication", mock.Anything, mock.AnythingOfType("*model.ApplicationDTO"),
+	).Return(appDTO, (*serviceerror.ServiceError)(nil))
+
+	var storedHash string
+	tokenStore.On("CreateRegistrationToken", mock.Anything, "app-id", mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { storedHash = args.String(2) }).Return(nil)
+
+	response, err := svc.RegisterClient(context.Background(), &DCRRegistrationRequest{OUID: "test-ou-1"})
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.NotEmpty(response.RegistrationAccessToken)
+	s.Equal(hash.GenerateThumbprintFromString(response.RegistrationAccessToken), storedHash)
+	s.Equal("https://localhost:8090/oauth2/dcr/register/client-id", response.RegistrationClientURI)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_RegistrationTokenStoreError() {
+	tokenStore := newRegistrationTokenStoreInterfaceMock(s.T())
+	svc := newDCRService(s.mockAppService, s.mockOUService, nil, s.mockJWTService,
+		&MockTransactioner{}, tokenStore)
+
+	appDTO := &model.ApplicationDTO{
+		ID: "app-id",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type:        inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{ClientID: "client-id"},
+			},
+		},
+	}
+	s.mockAppService.On(
+		"CreateApplication", mock.Anything, mock.AnythingOfType("*model.ApplicationDTO"),
+	).Return(appDTO, (*serviceerror.ServiceError)(nil))
+	tokenStore.On("CreateRegistrationToken", mock.Anything, "app-id", mock.Anything).
+		Return(errors.New("db error"))
+
+	response, err := svc.RegisterClient(context.Background(), &DCRRegistrationRequest{OUID: "test-ou-1"})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorServerError.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_Success() {
+	s.expectAuthorizedClient(registeredTestApplication())
+
+	response, err := s.service.GetClient(context.Background(), "client-id", "registration-token")
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Equal("client-id", response.ClientID)
+	s.Equal("Test Client", response.ClientName)
+	s.Equal("https://client.example.com", response.ClientURI)
+	s.Equal("openid", response.Scope)
+	s.Empty(response.ClientSecret)
+	s.Equal(ApplicationTypeWeb, response.ApplicationType)
+	s.Equal("registration-token", response.RegistrationAccessToken)
+	s.Equal("https://localhost:8090/oauth2/dcr/register/client-id", response.RegistrationClientURI)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_NativeClientWithLocalizedVariants() {
+	mockI18n := i18nmock.NewI18nServiceInterfaceMock(s.T())
+	svc := newDCRService(s.mockAppService, s.mockOUService, mockI18n, s.mockJWTService,
+		&MockTransactioner{}, s.mockTokenStore)
+
+	app := registeredTestApplication()
+	app.Name = application.AppI18nRef("app-id", "name")
+	app.InboundAuthConfig[0].OAuthConfig.RedirectURIPolicy = &inboundmodel.RedirectURIPolicy{
+		AllowLoopbackAnyPort: true,
+	}
+	s.expectAuthorizedClient(app)
+	mockI18n.On("GetTranslationsByNamespace", application.AppI18nNamespace()).Return(
+		map[string]map[string]string{
+			application.AppI18nKey("app-id", "name"): {
+				i18nmgt.SystemLanguage: "Test Client",
+				"fr":                   "Client FR",
+			},
+			application.AppI18nKey("other-app", "name"): {"fr": "Autre"},
+		}, (*serviceerror.ServiceError)(nil))
+
+	response, err := svc.GetClient(context.Background(), "client-id", "registration-token")
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Equal("Test Client", response.ClientName)
+	s.Equal(map[string]string{"fr": "Client FR"}, response.LocalizedClientName)
+	s.Equal(ApplicationTypeNative, response.ApplicationType)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_InvalidToken() {
+	s.mockAppService.On("GetOAuthApplication", mock.Anything, "client-id").
+		Return(&inboundmodel.OAuthClient{ID: "app-id", ClientID: "client-id"}, (*serviceerror.ServiceError)(nil))
+	s.mockTokenStore.On("GetRegistrationTokenHash", mock.Anything, "app-id").
+		Return(hash.GenerateThumbprintFromString("registration-token"), nil)
+
+	response, err := s.service.GetClient(context.Background(), "client-id", "other-token")
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidRegistrationToken.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_MissingToken() {
+	response, err := s.service.GetClient(context.Background(), "client-id", "")
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidRegistrationToken.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_UnknownClient() {
+	s.mockAppService.On("GetOAuthApplication", mock.Anything, "client-id").
+		Return((*inboundmodel.OAuthClient)(nil), &serviceerror.ServiceError{
+			Type: serviceerror.ClientErrorType,
+			Code: "APP-1004",
+		})
+
+	response, err := s.service.GetClient(context.Background(), "client-id", "registration-token")
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidRegistrationToken.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_TokenNotIssued() {
+	s.mockAppService.On("GetOAuthApplication", mock.Anything, "client-id").
+		Return(&inboundmodel.OAuthClient{ID: "app-id", ClientID: "client-id"}, (*serviceerror.ServiceError)(nil))
+	s.mockTokenStore.On("GetRegistrationTokenHash", mock.Anything, "app-id").
+		Return("", errRegistrationTokenNotFound)
+
+	response, err := s.service.GetClient(context.Background(), "client-id", "registration-token")
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidRegistrationToken.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestGetClient_TokenStoreError() {
+	s.mockAppService.On("GetOAuthApplication", mock.Anything, "client-id").
+		Return(&inboundmodel.OAuthClient{ID: "app-id", ClientID: "client-id"}, (*serviceerror.ServiceError)(nil))
+	s.mockTokenStore.On("GetRegistrationTokenHash", mock.Anything, "app-id").
+		Return("", errors.New("db error"))
+
+	response, err := s.service.GetClient(context.Background(), "client-id", "registration-token")
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorServerError.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestUpdateClient_Success() {
+	s.expectAuthorizedClient(registeredTestApplication())
+
+	var updatedApp *model.ApplicationDTO
+	s.mockAppService.On("UpdateApplication", mock.Anything, "app-id", mock.AnythingOfType("*model.ApplicationDTO")).
+		Run(func(args mock.Arguments) { updatedApp = args.Get(2).(*model.ApplicationDTO) }).
+		Return(func(_ context.Context, _ string, app *model.ApplicationDTO) *model.ApplicationDTO {
+			return app
+		}, (*serviceerror.ServiceError)(nil))
+
+	request := &DCRRegistrationRequest{
+		ClientID:     "client-id",
+		ClientName:   "Updated Client",
+		RedirectURIs: []string{"https://client.example.com/new-callback"},
+		GrantTypes:   []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+	}
+	response, err := s.service.UpdateClient(context.Background(), "client-id", "registration-token", request)
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Require().NotNil(updatedApp)
+	s.Equal("test-ou-1", updatedApp.OUID)
+	s.Equal("Updated Client", updatedApp.Name)
+	s.Empty(updatedApp.URL)
+	s.Equal("client-id", updatedApp.InboundAuthConfig[0].OAuthConfig.ClientID)
+	s.Empty(updatedApp.InboundAuthConfig[0].OAuthConfig.ClientSecret)
+	s.Equal([]string{"https://client.example.com/new-callback"}, response.RedirectURIs)
+	s.Equal("Updated Client", response.ClientName)
+	s.Equal("registration-token", response.RegistrationAccessToken)
+	s.Equal(ApplicationTypeWeb, response.ApplicationType)
+}
+
+func (s *DCRServiceTestSuite) TestUpdateClient_ClientIDMismatch() {
+	s.expectAuthorizedClient(registeredTestApplication())
+
+	response, err := s.service.UpdateClient(context.Background(), "client-id", "registration-token",
+		&DCRRegistrationRequest{ClientID: "other-client"})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorClientIDMismatch.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestUpdateClient_NilRequest() {
+	s.expectAuthorizedClient(registeredTestApplication())
+
+	response, err := s.service.UpdateClient(context.Background(), "client-id", "registration-token", nil)
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidRequestFormat.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestUpdateClient_InvalidToken() {
+	response, err := s.service.UpdateClient(context.Background(), "client-id", "",
+		&DCRRegistrationRequest{ClientID: "client-id"})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidRegistrationToken.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestUpdateClient_ApplicationServiceError() {
+	s.expectAuthorizedClient(registeredTestApplication())
+	s.mockAppService.On("UpdateApplication", mock.Anything, "app-id", mock.Anything).
+		Return((*model.ApplicationDTO)(nil), &serviceerror.ServiceError{
+			Type: serviceerror.ClientErrorType,
+			Code: "APP-1012",
+		})
+
+	response, err := s.service.UpdateClient(context.Background(), "client-id", "registration-token",
+		&DCRRegistrationRequest{ClientID: "client-id", RedirectURIs: []string{"invalid"}})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidRedirectURI.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestDeleteClient_Success() {
+	s.expectAuthorizedClient(registeredTestApplication())
+	s.mockAppService.On("DeleteApplication", mock.Anything, "app-id").Return((*serviceerror.ServiceError)(nil))
+	s.mockTokenStore.On("DeleteRegistrationToken", mock.Anything, "app-id").Return(nil)
+
+	err := s.service.DeleteClient(context.Background(), "client-id", "registration-token")
+
+	s.Nil(err)
+}
+
+func (s *DCRServiceTestSuite) TestDeleteClient_InvalidToken() {
+	s.mockAppService.On("GetOAuthApplication", mock.Anything, "client-id").
+		Return(&inboundmodel.OAuthClient{ID: "app-id", ClientID: "client-id"}, (*serviceerror.ServiceError)(nil))
+	s.mockTokenStore.On("GetRegistrationTokenHash", mock.Anything, "app-id").
+		Return(hash.GenerateThumbprintFromString("registration-token"), nil)
+
+	err := s.service.DeleteClient(context.Background(), "client-id", "other-token")
+
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidRegistrationToken.Code, err.Code)
+	s.mockAppService.AssertNotCalled(s.T(), "DeleteApplication", mock.Anything, mock.Anything)
+}
+
+func (s *DCRServiceTestSuite) TestDeleteClient_ApplicationServiceError() {
+	s.expectAuthorizedClient(registeredTestApplication())
+	s.mockAppService.On("DeleteApplication", mock.Anything, "app-id").Return(&serviceerror.ServiceError{
+		Type: serviceerror.ServerErrorType,
+		Code: "APP-5001",
+	})
+
+	err := s.service.DeleteClient(context.Background(), "client-id", "registration-token")
+
+	s.Require().NotNil(err)
+	s.Equal(ErrorServerError.Code, err.Code)
+}
+
+// initSoftwareStatementConfig initializes the server runtime with a trusted software statement issuer.
+func (s *DCRServiceTestSuite) initSoftwareStatementConfig(required bool) {
+	config.ResetServerRuntime()
+	s.Require().NoError(config.InitializeServerRuntime("", &config.Config{
+		Server: config.ServerConfig{Hostname: "localhost", Port: 8090},
+		OAuth: config.OAuthConfig{
+			DCR: config.DCRConfig{
+				SoftwareStatement: config.SoftwareStatementConfig{
+					Required: required,
+					TrustedIssuers: []config.SoftwareStatementIssuer{
+						{Issuer: "https://directory.example.com", JWKSURL: "https://directory.example.com/jwks"},
+					},
+				},
+			},
+		},
+	}))
+}
+
+// buildSoftwareStatement builds an unsigned JWT carrying the given claims. Signature verification is mocked.
+func buildSoftwareStatement(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "directory-key"})
+	payload, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementRequired() {
+	s.initSoftwareStatementConfig(true)
+
+	response, err := s.service.RegisterClient(context.Background(), &DCRRegistrationRequest{OUID: "test-ou-1"})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidSoftwareStatement.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementMalformed() {
+	s.initSoftwareStatementConfig(false)
+
+	response, err := s.service.RegisterClient(context.Background(), &DCRRegistrationRequest{
+		OUID:              "test-ou-1",
+		SoftwareStatement: "not-a-jwt",
+	})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidSoftwareStatement.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementUntrustedIssuer() {
+	s.initSoftwareStatementConfig(false)
+
+	response, err := s.service.RegisterClient(context.Background(), &DCRRegistrationRequest{
+		OUID:              "test-ou-1",
+		SoftwareStatement: buildSoftwareStatement(map[string]interface{}{"iss": "https://untrusted.example.com"}),
+	})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorUnapprovedSoftwareStatement.Code, err.Code)
+	s.mockJWTService.AssertNotCalled(s.T(), "VerifyJWTSignatureWithJWKS", mock.Anything, mock.Anything)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementInvalidSignature() {
+	s.initSoftwareStatementConfig(false)
+	statement := buildSoftwareStatement(map[string]interface{}{"iss": "https://directory.example.com"})
+	s.mockJWTService.On("VerifyJWTSignatureWithJWKS", statement, "https://directory.example.com/jwks").
+		Return(&serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "JWT-1004"})
+
+	response, err := s.service.RegisterClient(context.Background(), &DCRRegistrationRequest{
+		OUID:              "test-ou-1",
+		SoftwareStatement: statement,
+	})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidSoftwareStatement.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementExpired() {
+	s.initSoftwareStatementConfig(false)
+	statement := buildSoftwareStatement(map[string]interface{}{
+		"iss": "https://directory.example.com",
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	})
+	s.mockJWTService.On("VerifyJWTSignatureWithJWKS", statement, "https://directory.example.com/jwks").
+		Return((*serviceerror.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), &DCRRegistrationRequest{
+		OUID:              "test-ou-1",
+		SoftwareStatement: statement,
+	})
+
+	s.Nil(response)
+	s.Require().NotNil(err)
+	s.Equal(ErrorInvalidSoftwareStatement.Code, err.Code)
+}
+
+func (s *DCRServiceTestSuite) TestRegisterClient_SoftwareStatementMetadataTakesPrecedence() {
+	s.initSoftwareStatementConfig(true)
+	statement := buildSoftwareStatement(map[string]interface{}{
+		"iss":            "https://directory.example.com",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"ou_id":          "other-ou",
+		"client_name":    "Vetted Client",
+		"client_name#fr": "Client Vérifié",
+		"redirect_uris":  []string{"https://vetted.example.com/callback"},
+		"jwks_uri":       "https://vetted.example.com/jwks",
+	})
+	s.mockJWTService.On("VerifyJWTSignatureWithJWKS", statement, "https://directory.example.com/jwks").
+		Return((*serviceerror.ServiceError)(nil))
+
+	var createdApp *model.ApplicationDTO
+	s.mockAppService.On("CreateApplication", mock.Anything, mock.AnythingOfType("*model.ApplicationDTO")).
+		Run(func(args mock.Arguments) { createdApp = args.Get(1).(*model.ApplicationDTO) }).
+		Return(&model.ApplicationDTO{
+			ID:   "app-id",
+			Name: "Vetted Client",
+			InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+				{
+					Type:        inboundmodel.OAuthInboundAuthType,
+					OAuthConfig: &inboundmodel.OAuthConfigWithSecret{ClientID: "client-id"},
+				},
+			},
+		}, (*serviceerror.ServiceError)(nil))
+
+	response, err := s.service.RegisterClient(context.Background(), &DCRRegistrationRequest{
+		OUID:              "test-ou-1",
+		ClientName:        "Requested Client",
+		RedirectURIs:      []string{"https://requested.example.com/callback"},
+		JWKS:              map[string]interface{}{"keys": []interface{}{}},
+		SoftwareStatement: statement,
+	})
+
+	s.Nil(err)
+	s.Require().NotNil(response)
+	s.Require().NotNil(createdApp)
+	s.Equal("test-ou-1", createdApp.OUID)
+	s.Equal([]string{"https://vetted.example.com/callback"},
+		createdApp.InboundAuthConfig[0].OAuthConfig.RedirectURIs)
+	s.Require().NotNil(createdApp.Certificate)
+	s.Equal("https://vetted.example.com/jwks", createdApp.Certificate.Value)
+	s.Equal("Vetted Client", response.ClientName)
+	s.Equal(map[string]string{"fr": "Client Vérifié"}, response.LocalizedClientName)
+	s.Equal(statement, response.SoftwareStatement)
+}
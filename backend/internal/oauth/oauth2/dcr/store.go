@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dcr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// errRegistrationTokenNotFound is returned when no registration access token is stored for a client.
+var errRegistrationTokenNotFound = errors.New("registration access token not found")
+
+// registrationTokenStoreInterface defines the persistence operations for registration access tokens.
+// Tokens are keyed by the entity ID of the registered client and only their hashes are stored.
+type registrationTokenStoreInterface interface {
+	// CreateRegistrationToken stores the registration access token hash issued to a client.
+	CreateRegistrationToken(ctx context.Context, entityID, tokenHash string) error
+
+	// GetRegistrationTokenHash retrieves the registration access token hash of a client. It fails with
+	// errRegistrationTokenNotFound if no token was issued to the client.
+	GetRegistrationTokenHash(ctx context.Context, entityID string) (string, error)
+
+	// DeleteRegistrationToken deletes the registration access token hash of a client.
+	DeleteRegistrationToken(ctx context.Context, entityID string) error
+}
+
+// registrationTokenStore is the SQL implementation of registrationTokenStoreInterface.
+type registrationTokenStore struct {
+	dbProvider   dbprovider.DBProviderInterface
+	deploymentID string
+}
+
+// newRegistrationTokenStore creates a new instance of registrationTokenStore.
+func newRegistrationTokenStore() registrationTokenStoreInterface {
+	return &registrationTokenStore{
+		dbProvider:   dbprovider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateRegistrationToken stores the registration access token hash issued to a client.
+func (s *registrationTokenStore) CreateRegistrationToken(ctx context.Context, entityID, tokenHash string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryInsertRegistrationToken, entityID, tokenHash, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to insert registration access token: %w", err)
+	}
+	if rows == 0 {
+		return errors.New("no rows affected, registration access token creation failed")
+	}
+
+	return nil
+}
+
+// GetRegistrationTokenHash retrieves the registration access token hash of a client.
+func (s *registrationTokenStore) GetRegistrationTokenHash(ctx context.Context, entityID string) (string, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetRegistrationToken, entityID, s.deploymentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return "", errRegistrationTokenNotFound
+	}
+
+	tokenHash, ok := results[0]["token_hash"].(string)
+	if !ok {
+		return "", errors.New("failed to parse token_hash as string")
+	}
+
+	return tokenHash, nil
+}
+
+// DeleteRegistrationToken deletes the registration access token hash of a client.
+func (s *registrationTokenStore) DeleteRegistrationToken(ctx context.Context, entityID string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteRegistrationToken, entityID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete registration access token: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dcr
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryInsertRegistrationToken inserts the registration access token hash of a client.
+	queryInsertRegistrationToken = dbmodel.DBQuery{
+		ID: "DRQ-01",
+		Query: `INSERT INTO "OAUTH_REGISTRATION_TOKEN" (ENTITY_ID, TOKEN_HASH, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3)`,
+	}
+
+	// queryGetRegistrationToken retrieves the registration access token hash of a client.
+	queryGetRegistrationToken = dbmodel.DBQuery{
+		ID: "DRQ-02",
+		Query: `SELECT TOKEN_HASH FROM "OAUTH_REGISTRATION_TOKEN" ` +
+			`WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteRegistrationToken deletes the registration access token hash of a client.
+	queryDeleteRegistrationToken = dbmodel.DBQuery{
+		ID:    "DRQ-03",
+		Query: `DELETE FROM "OAUTH_REGISTRATION_TOKEN" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dcr
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+// RegistrationTokenStoreTestSuite is the test suite for the registration access token store.
+type RegistrationTokenStoreTestSuite struct {
+	suite.Suite
+	store            *registrationTokenStore
+	mockDBProvider   *providermock.DBProviderInterfaceMock
+	mockDBClient     *providermock.DBClientInterfaceMock
+	ctx              context.Context
+	testDeploymentID string
+}
+
+func TestRegistrationTokenStoreSuite(t *testing.T) {
+	suite.Run(t, new(RegistrationTokenStoreTestSuite))
+}
+
+func (suite *RegistrationTokenStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.ctx = context.Background()
+	suite.testDeploymentID = "test-deployment-id"
+
+	suite.store = &registrationTokenStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: suite.testDeploymentID,
+	}
+}
+
+func (suite *RegistrationTokenStoreTestSuite) TestCreateRegistrationToken_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryInsertRegistrationToken, "app-id", "token-hash",
+		suite.testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.CreateRegistrationToken(suite.ctx, "app-id", "token-hash")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *RegistrationTokenStoreTestSuite) TestCreateRegistrationToken_DBProviderError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("db provider error")).Once()
+
+	err := suite.store.CreateRegistrationToken(suite.ctx, "app-id", "token-hash")
+
+	assert.NotNil(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "failed to get database client")
+}
+
+func (suite *RegistrationTokenStoreTestSuite) TestCreateRegistrationToken_NoRowsAffected() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryInsertRegistrationToken, "app-id", "token-hash",
+		suite.testDeploymentID).Return(int64(0), nil).Once()
+
+	err := suite.store.CreateRegistrationToken(suite.ctx, "app-id", "token-hash")
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *RegistrationTokenStoreTestSuite) TestGetRegistrationTokenHash_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetRegistrationToken, "app-id", suite.testDeploymentID).
+		Return([]map[string]interface{}{{"token_hash": "token-hash"}}, nil).Once()
+
+	tokenHash, err := suite.store.GetRegistrationTokenHash(suite.ctx, "app-id")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "token-hash", tokenHash)
+}
+
+func (suite *RegistrationTokenStoreTestSuite) TestGetRegistrationTokenHash_NotFound() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetRegistrationToken, "app-id", suite.testDeploymentID).
+		Return([]map[string]interface{}{}, nil).Once()
+
+	_, err := suite.store.GetRegistrationTokenHash(suite.ctx, "app-id")
+
+	assert.ErrorIs(suite.T(), err, errRegistrationTokenNotFound)
+}
+
+func (suite *RegistrationTokenStoreTestSuite) TestGetRegistrationTokenHash_QueryError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetRegistrationToken, "app-id", suite.testDeploymentID).
+		Return(nil, errors.New("query error")).Once()
+
+	_, err := suite.store.GetRegistrationTokenHash(suite.ctx, "app-id")
+
+	assert.NotNil(suite.T(), err)
+	assert.NotErrorIs(suite.T(), err, errRegistrationTokenNotFound)
+}
+
+func (suite *RegistrationTokenStoreTestSuite) TestGetRegistrationTokenHash_InvalidRow() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetRegistrationToken, "app-id", suite.testDeploymentID).
+		Return([]map[string]interface{}{{"token_hash": 123}}, nil).Once()
+
+	_, err := suite.store.GetRegistrationTokenHash(suite.ctx, "app-id")
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *RegistrationTokenStoreTestSuite) TestDeleteRegistrationToken_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteRegistrationToken, "app-id",
+		suite.testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.DeleteRegistrationToken(suite.ctx, "app-id")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *RegistrationTokenStoreTestSuite) TestDeleteRegistrationToken_ExecuteError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteRegistrationToken, "app-id",
+		suite.testDeploymentID).Return(int64(0), errors.New("execute error")).Once()
+
+	err := suite.store.DeleteRegistrationToken(suite.ctx, "app-id")
+
+	assert.NotNil(suite.T(), err)
+}
@@ -22,10 +22,12 @@ package discovery
 import (
 	"context"
 	"crypto"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -33,6 +35,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 )
 
@@ -59,6 +62,16 @@ func (m *testPKIService) GetAllX509Certificates() (map[string]*x509.Certificate,
 	return nil, nil
 }
 
+func (m *testPKIService) GetKeyIDByThumbprint(string) string { return "" }
+
+func (m *testPKIService) GetSigningKeyID() string { return "" }
+
+func (m *testPKIService) SetSigningKeyID(string) error { return nil }
+
+func (m *testPKIService) AddKey(string, tls.Certificate) error { return nil }
+
+func (m *testPKIService) RemoveKey(string) error { return nil }
+
 type DiscoveryTestSuite struct {
 	suite.Suite
 	pkiService       *testPKIService
@@ -137,8 +150,118 @@ func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata() {
 	// Verify only implemented response types are present
 	assert.Equal(suite.T(), []string{"code"}, metadata.ResponseTypesSupported)
 
+	// Verify JWT secured authorization response (JARM) advertisement
+	assert.Equal(suite.T(), []string{"query", "jwt", "query.jwt", "fragment.jwt", "form_post.jwt"},
+		metadata.ResponseModesSupported)
+	assert.Contains(suite.T(), metadata.AuthorizationSigningAlgValuesSupported, "RS256")
+
 	// Verify RFC 9207 advertisement
 	assert.True(suite.T(), metadata.AuthorizationResponseIssParameterSupported)
+
+	// Authorization details types are only advertised when configured
+	assert.Empty(suite.T(), metadata.AuthorizationDetailsTypesSupported)
+}
+
+func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata_AuthorizationDetailsTypes() {
+	config.GetServerRuntime().Config.OAuth.AuthorizationDetails.Types = []string{"payment_initiation"}
+
+	metadata := suite.discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.Equal(suite.T(), []string{"payment_initiation"}, metadata.AuthorizationDetailsTypesSupported)
+}
+
+func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata_Backchannel() {
+	metadata := suite.discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.True(suite.T(), strings.HasSuffix(metadata.BackchannelAuthenticationEndpoint, "/oauth2/bc-authorize"))
+	assert.Equal(suite.T(), []string{"poll", "ping"}, metadata.BackchannelTokenDeliveryModesSupported)
+	assert.False(suite.T(), metadata.BackchannelUserCodeParameterSupported)
+	assert.Contains(suite.T(), metadata.GrantTypesSupported, "urn:openid:params:grant-type:ciba")
+}
+
+func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata_IssuerOverride() {
+	config.GetServerRuntime().Config.OAuth.Discovery.Issuer = "https://id.example.com"
+
+	metadata := suite.discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.Equal(suite.T(), "https://id.example.com", metadata.Issuer)
+}
+
+func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata_ConfiguredEndpoints() {
+	config.GetServerRuntime().Config.OAuth.Discovery.Endpoints = map[string]string{
+		"token_endpoint":                        "https://gateway.example.com/token",
+		"revocation_endpoint":                   "/oauth2/revoke",
+		"device_authorization_endpoint":         "https://device.example.com/oauth2/device",
+		"pushed_authorization_request_endpoint": "/oauth2/par",
+	}
+
+	metadata := suite.discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.Equal(suite.T(), "https://gateway.example.com/token", metadata.TokenEndpoint)
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/revoke", metadata.RevocationEndpoint)
+	assert.Equal(suite.T(), "https://device.example.com/oauth2/device", metadata.DeviceAuthorizationEndpoint)
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/par", metadata.PushedAuthorizationRequestEndpoint)
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/authorize", metadata.AuthorizationEndpoint)
+	assert.Empty(suite.T(), metadata.AdditionalMetadata)
+}
+
+func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata_AdditionalMetadata() {
+	discoveryConfig := &config.GetServerRuntime().Config.OAuth.Discovery
+	discoveryConfig.Endpoints = map[string]string{
+		"mtls_endpoint": "/oauth2/mtls",
+	}
+	discoveryConfig.AdditionalMetadata = map[string]interface{}{
+		"service_documentation": "https://docs.example.com",
+		"op_policy_uri":         "https://example.com/policy",
+		"issuer":                "https://attacker.example.com",
+		"token_endpoint":        "https://attacker.example.com/token",
+	}
+
+	req := httptest.NewRequest("GET", "/.well-known/oauth-authorization-server", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleOAuth2AuthorizationServerMetadata(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var document map[string]interface{}
+	assert.NoError(suite.T(), json.NewDecoder(w.Body).Decode(&document))
+	assert.Equal(suite.T(), "https://docs.example.com", document["service_documentation"])
+	assert.Equal(suite.T(), "https://example.com/policy", document["op_policy_uri"])
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/mtls", document["mtls_endpoint"])
+	assert.Equal(suite.T(), "https://auth.example.com", document["issuer"])
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/token", document["token_endpoint"])
+	assert.NotContains(suite.T(), document, "AdditionalMetadata")
+}
+
+func (suite *DiscoveryTestSuite) TestOIDCDiscovery_AdditionalMetadata() {
+	config.GetServerRuntime().Config.OAuth.Discovery.AdditionalMetadata = map[string]interface{}{
+		"service_documentation":   "https://docs.example.com",
+		"subject_types_supported": []interface{}{"pairwise"},
+	}
+
+	req := httptest.NewRequest("GET", "/.well-known/openid-configuration", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleOIDCDiscovery(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var document map[string]interface{}
+	assert.NoError(suite.T(), json.NewDecoder(w.Body).Decode(&document))
+	assert.Equal(suite.T(), "https://docs.example.com", document["service_documentation"])
+	assert.Equal(suite.T(), []interface{}{constants.SubjectTypePublic}, document["subject_types_supported"])
+	assert.Contains(suite.T(), document, "claims_supported")
+	assert.Contains(suite.T(), document, "authorization_endpoint")
+}
+
+func (suite *DiscoveryTestSuite) TestOIDCMetadata_ConfiguredEndSessionEndpoint() {
+	config.GetServerRuntime().Config.OAuth.Discovery.Endpoints = map[string]string{
+		"end_session_endpoint": "https://logout.example.com/logout",
+	}
+
+	metadata := suite.discoveryService.GetOIDCMetadata(context.Background())
+
+	assert.Equal(suite.T(), "https://logout.example.com/logout", metadata.EndSessionEndpoint)
+	assert.Empty(suite.T(), metadata.AdditionalMetadata)
 }
 
 func (suite *DiscoveryTestSuite) TestOIDCDiscovery() {
@@ -167,6 +290,9 @@ func (suite *DiscoveryTestSuite) TestOIDCDiscovery() {
 
 	// Verify claims parameter support
 	assert.True(suite.T(), metadata.ClaimsParameterSupported, "claims_parameter_supported should be true")
+	assert.True(suite.T(), metadata.RequestParameterSupported, "request_parameter_supported should be true")
+	assert.True(suite.T(), metadata.RequestURIParameterSupported, "request_uri_parameter_supported should be true")
+	assert.Contains(suite.T(), metadata.RequestObjectSigningAlgValuesSupported, "RS256")
 
 	// Verify RFC 9207 advertisement (inherited from embedded OAuth2AuthorizationServerMetadata)
 	assert.True(suite.T(), metadata.AuthorizationResponseIssParameterSupported)
@@ -234,11 +360,12 @@ func TestGetSupportedGrantTypes(t *testing.T) {
 	supported := constants.GetSupportedGrantTypes()
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 4, len(supported))
+	assert.Equal(t, 5, len(supported))
 	assert.Contains(t, supported, "authorization_code")
 	assert.Contains(t, supported, "client_credentials")
 	assert.Contains(t, supported, "refresh_token")
 	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:token-exchange")
+	assert.Contains(t, supported, "urn:openid:params:grant-type:ciba")
 	assert.NotContains(t, supported, "password")
 	assert.NotContains(t, supported, "implicit")
 }
@@ -353,6 +480,54 @@ func (suite *DiscoveryTestSuite) TestGetBaseURL_WithHTTPOnly() {
 	config.ResetServerRuntime()
 }
 
+func (suite *DiscoveryTestSuite) TestGetBaseURL_WithCustomDomain() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		Server: config.ServerConfig{
+			Hostname: "localhost",
+			Port:     8080,
+		},
+		JWT: config.JWTConfig{
+			Issuer: "https://auth.example.com",
+		},
+		CustomDomains: []config.CustomDomainConfig{
+			{Host: "login.acme.io", PublicURL: "https://login.acme.io", Issuer: "https://acme.io"},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	service := newDiscoveryService(suite.pkiService)
+	ctx := sysContext.WithCustomDomain(context.Background(), "login.acme.io")
+	metadata := service.GetOAuth2AuthorizationServerMetadata(ctx)
+	assert.Equal(suite.T(), "https://acme.io", metadata.Issuer)
+	assert.Equal(suite.T(), "https://login.acme.io"+constants.OAuth2TokenEndpoint, metadata.TokenEndpoint)
+
+	metadata = service.GetOAuth2AuthorizationServerMetadata(context.Background())
+	assert.Equal(suite.T(), "https://auth.example.com", metadata.Issuer)
+	assert.Contains(suite.T(), metadata.TokenEndpoint, "localhost:8080")
+	config.ResetServerRuntime()
+}
+
+func (suite *DiscoveryTestSuite) TestOIDCMetadata_EndSessionEndpoint() {
+	metadata := suite.discoveryService.GetOIDCMetadata(context.Background())
+
+	assert.True(suite.T(), strings.HasSuffix(metadata.EndSessionEndpoint, "/oauth2/logout"))
+}
+
+func (suite *DiscoveryTestSuite) TestOIDCMetadata_BackchannelLogout() {
+	metadata := suite.discoveryService.GetOIDCMetadata(context.Background())
+
+	assert.True(suite.T(), metadata.BackchannelLogoutSupported)
+	assert.True(suite.T(), metadata.BackchannelLogoutSessionSupported)
+}
+
+func (suite *DiscoveryTestSuite) TestOIDCMetadata_FrontchannelLogout() {
+	metadata := suite.discoveryService.GetOIDCMetadata(context.Background())
+
+	assert.True(suite.T(), metadata.FrontchannelLogoutSupported)
+	assert.True(suite.T(), metadata.FrontchannelLogoutSessionSupported)
+}
+
 func (suite *DiscoveryTestSuite) TestOIDCDiscovery_MultipleKeyAlgorithms() {
 	multiPKI := &testPKIService{
 		algorithms: []string{"RS256", "ES256", "EdDSA"},
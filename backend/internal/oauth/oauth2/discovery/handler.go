@@ -19,6 +19,7 @@
 package discovery
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -50,7 +51,7 @@ func (dh *discoveryHandler) HandleOAuth2AuthorizationServerMetadata(w http.Respo
 
 	metadata := dh.discoveryService.GetOAuth2AuthorizationServerMetadata(ctx)
 
-	sysutils.WriteSuccessResponse(w, http.StatusOK, metadata)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, withAdditionalMetadata(metadata, metadata.AdditionalMetadata))
 	logger.Debug("OAuth 2.0 Authorization Server Metadata response sent successfully")
 }
 
@@ -61,6 +62,29 @@ func (dh *discoveryHandler) HandleOIDCDiscovery(w http.ResponseWriter, r *http.R
 
 	metadata := dh.discoveryService.GetOIDCMetadata(ctx)
 
-	sysutils.WriteSuccessResponse(w, http.StatusOK, metadata)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, withAdditionalMetadata(metadata, metadata.AdditionalMetadata))
 	logger.Debug("OIDC discovery response sent successfully")
 }
+
+// withAdditionalMetadata returns the metadata document with the additional metadata fields added as top-level
+// fields. Fields already present in the metadata are kept as they are.
+func withAdditionalMetadata(metadata interface{}, additionalMetadata map[string]interface{}) interface{} {
+	if len(additionalMetadata) == 0 {
+		return metadata
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return metadata
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return metadata
+	}
+	for name, value := range additionalMetadata {
+		if _, exists := document[name]; !exists {
+			document[name] = value
+		}
+	}
+	return document
+}
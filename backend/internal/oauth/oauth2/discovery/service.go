@@ -20,15 +20,32 @@ package discovery
 
 import (
 	"context"
+	"slices"
 	"sort"
+	"strings"
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pkce"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
 )
 
+// endpointMetadataNames lists the endpoint metadata names that the discovery metadata defines a field for.
+var endpointMetadataNames = []string{
+	"authorization_endpoint",
+	"token_endpoint",
+	"userinfo_endpoint",
+	"registration_endpoint",
+	"revocation_endpoint",
+	"device_authorization_endpoint",
+	"introspection_endpoint",
+	"pushed_authorization_request_endpoint",
+	"backchannel_authentication_endpoint",
+	"end_session_endpoint",
+}
+
 // DiscoveryServiceInterface defines the interface for discovery services
 type DiscoveryServiceInterface interface {
 	GetOAuth2AuthorizationServerMetadata(ctx context.Context) *OAuth2AuthorizationServerMetadata
@@ -37,38 +54,44 @@ type DiscoveryServiceInterface interface {
 
 // discoveryService implements DiscoveryServiceInterface
 type discoveryService struct {
-	baseURL    string
 	pkiService pkiservice.PKIServiceInterface
 }
 
 // newDiscoveryService creates a new discovery service instance
 func newDiscoveryService(pkiService pkiservice.PKIServiceInterface) DiscoveryServiceInterface {
-	runtime := config.GetServerRuntime()
-	ds := &discoveryService{pkiService: pkiService}
-	ds.baseURL = config.GetServerURL(&runtime.Config.Server)
-	return ds
+	return &discoveryService{pkiService: pkiService}
 }
 
 // GetOAuth2AuthorizationServerMetadata returns OAuth 2.0 Authorization Server Metadata
 func (ds *discoveryService) GetOAuth2AuthorizationServerMetadata(
 	ctx context.Context,
 ) *OAuth2AuthorizationServerMetadata {
+	baseURL := config.GetPublicURL(ctx)
 	metadata := &OAuth2AuthorizationServerMetadata{
-		Issuer:                                     ds.getIssuer(),
-		AuthorizationEndpoint:                      ds.getAuthorizationEndpoint(),
-		TokenEndpoint:                              ds.getTokenEndpoint(),
-		UserInfoEndpoint:                           ds.getUserInfoEndpoint(),
-		JWKSUri:                                    ds.getJWKSUri(),
-		RegistrationEndpoint:                       ds.getRegistrationEndpoint(),
-		IntrospectionEndpoint:                      ds.getIntrospectionEndpoint(),
-		PushedAuthorizationRequestEndpoint:         ds.getPAREndpoint(),
+		Issuer:                                     ds.getIssuer(ctx),
+		AuthorizationEndpoint:                      ds.getAuthorizationEndpoint(baseURL),
+		TokenEndpoint:                              ds.getTokenEndpoint(baseURL),
+		UserInfoEndpoint:                           ds.getUserInfoEndpoint(baseURL),
+		JWKSUri:                                    ds.getJWKSUri(baseURL),
+		RegistrationEndpoint:                       ds.getRegistrationEndpoint(baseURL),
+		RevocationEndpoint:                         ds.getConfiguredEndpoint(baseURL, "revocation_endpoint"),
+		DeviceAuthorizationEndpoint:                ds.getConfiguredEndpoint(baseURL, "device_authorization_endpoint"),
+		IntrospectionEndpoint:                      ds.getIntrospectionEndpoint(baseURL),
+		PushedAuthorizationRequestEndpoint:         ds.getPAREndpoint(baseURL),
 		RequirePushedAuthorizationRequests:         ds.isGlobalPARRequired(),
 		ScopesSupported:                            ds.getSupportedScopes(),
 		ResponseTypesSupported:                     ds.getSupportedResponseTypes(),
+		ResponseModesSupported:                     ds.getSupportedResponseModes(),
 		GrantTypesSupported:                        ds.getSupportedGrantTypes(),
 		TokenEndpointAuthMethodsSupported:          ds.getSupportedTokenEndpointAuthMethods(),
 		CodeChallengeMethodsSupported:              ds.getSupportedCodeChallengeMethods(),
 		AuthorizationResponseIssParameterSupported: true,
+		AuthorizationSigningAlgValuesSupported:     ds.pkiService.GetSupportedSigningAlgorithms(),
+		AuthorizationDetailsTypesSupported:         ds.getSupportedAuthorizationDetailsTypes(),
+		BackchannelAuthenticationEndpoint:          ds.getBackchannelAuthenticationEndpoint(baseURL),
+		BackchannelTokenDeliveryModesSupported:     constants.GetSupportedBackchannelTokenDeliveryModes(),
+		BackchannelUserCodeParameterSupported:      false,
+		AdditionalMetadata:                         ds.getAdditionalMetadata(baseURL),
 	}
 
 	return metadata
@@ -79,46 +102,101 @@ func (ds *discoveryService) GetOIDCMetadata(ctx context.Context) *OIDCProviderMe
 	oauth2Meta := ds.GetOAuth2AuthorizationServerMetadata(ctx)
 
 	return &OIDCProviderMetadata{
-		OAuth2AuthorizationServerMetadata:    *oauth2Meta,
-		SubjectTypesSupported:                ds.getSupportedSubjectTypes(),
-		IDTokenSigningAlgValuesSupported:     ds.pkiService.GetSupportedSigningAlgorithms(),
-		UserInfoSigningAlgValuesSupported:    ds.pkiService.GetSupportedSigningAlgorithms(),
-		UserInfoEncryptionAlgValuesSupported: inboundmodel.SupportedUserInfoEncryptionAlgs,
-		UserInfoEncryptionEncValuesSupported: inboundmodel.SupportedUserInfoEncryptionEncs,
-		IDTokenEncryptionAlgValuesSupported:  inboundmodel.SupportedIDTokenEncryptionAlgs,
-		IDTokenEncryptionEncValuesSupported:  inboundmodel.SupportedIDTokenEncryptionEncs,
-		ClaimsSupported:                      ds.getSupportedClaims(),
-		ClaimsParameterSupported:             true,
-		AcrValuesSupported:                   ds.getSupportedAcrValues(),
+		OAuth2AuthorizationServerMetadata:      *oauth2Meta,
+		SubjectTypesSupported:                  ds.getSupportedSubjectTypes(),
+		IDTokenSigningAlgValuesSupported:       ds.pkiService.GetSupportedSigningAlgorithms(),
+		UserInfoSigningAlgValuesSupported:      ds.pkiService.GetSupportedSigningAlgorithms(),
+		UserInfoEncryptionAlgValuesSupported:   inboundmodel.SupportedUserInfoEncryptionAlgs,
+		UserInfoEncryptionEncValuesSupported:   inboundmodel.SupportedUserInfoEncryptionEncs,
+		IDTokenEncryptionAlgValuesSupported:    inboundmodel.SupportedIDTokenEncryptionAlgs,
+		IDTokenEncryptionEncValuesSupported:    inboundmodel.SupportedIDTokenEncryptionEncs,
+		ClaimsSupported:                        ds.getSupportedClaims(),
+		ClaimsParameterSupported:               true,
+		EndSessionEndpoint:                     ds.getEndSessionEndpoint(config.GetPublicURL(ctx)),
+		BackchannelLogoutSupported:             true,
+		BackchannelLogoutSessionSupported:      true,
+		FrontchannelLogoutSupported:            true,
+		FrontchannelLogoutSessionSupported:     true,
+		AcrValuesSupported:                     ds.getSupportedAcrValues(),
+		RequestParameterSupported:              true,
+		RequestURIParameterSupported:           true,
+		RequestObjectSigningAlgValuesSupported: requestobject.SupportedSigningAlgs,
+	}
+}
+
+// getIssuer returns the issuer to advertise. An issuer configured for discovery takes precedence over the
+// issuer of the server.
+func (ds *discoveryService) getIssuer(ctx context.Context) string {
+	if issuer := config.GetServerRuntime().Config.OAuth.Discovery.Issuer; issuer != "" {
+		return issuer
 	}
+	return config.GetIssuer(ctx)
+}
+
+func (ds *discoveryService) getAuthorizationEndpoint(baseURL string) string {
+	return ds.getEndpoint(baseURL, "authorization_endpoint", constants.OAuth2AuthorizationEndpoint)
+}
+
+func (ds *discoveryService) getTokenEndpoint(baseURL string) string {
+	return ds.getEndpoint(baseURL, "token_endpoint", constants.OAuth2TokenEndpoint)
 }
 
-func (ds *discoveryService) getIssuer() string {
-	return config.GetServerRuntime().Config.JWT.Issuer
+func (ds *discoveryService) getJWKSUri(baseURL string) string {
+	return baseURL + constants.OAuth2JWKSEndpoint
 }
 
-func (ds *discoveryService) getAuthorizationEndpoint() string {
-	return ds.baseURL + constants.OAuth2AuthorizationEndpoint
+func (ds *discoveryService) getIntrospectionEndpoint(baseURL string) string {
+	return ds.getEndpoint(baseURL, "introspection_endpoint", constants.OAuth2IntrospectionEndpoint)
 }
 
-func (ds *discoveryService) getTokenEndpoint() string {
-	return ds.baseURL + constants.OAuth2TokenEndpoint
+func (ds *discoveryService) getUserInfoEndpoint(baseURL string) string {
+	return ds.getEndpoint(baseURL, "userinfo_endpoint", constants.OAuth2UserInfoEndpoint)
 }
 
-func (ds *discoveryService) getJWKSUri() string {
-	return ds.baseURL + constants.OAuth2JWKSEndpoint
+func (ds *discoveryService) getEndSessionEndpoint(baseURL string) string {
+	return ds.getEndpoint(baseURL, "end_session_endpoint", constants.OAuth2LogoutEndpoint)
 }
 
-func (ds *discoveryService) getIntrospectionEndpoint() string {
-	return ds.baseURL + constants.OAuth2IntrospectionEndpoint
+func (ds *discoveryService) getRegistrationEndpoint(baseURL string) string {
+	return ds.getEndpoint(baseURL, "registration_endpoint", constants.OAuth2DCREndpoint)
+}
+
+// getEndpoint returns the URL of an endpoint served by the server, unless another URL is configured for it.
+func (ds *discoveryService) getEndpoint(baseURL, name, path string) string {
+	if endpoint := ds.getConfiguredEndpoint(baseURL, name); endpoint != "" {
+		return endpoint
+	}
+	return baseURL + path
 }
 
-func (ds *discoveryService) getUserInfoEndpoint() string {
-	return ds.baseURL + constants.OAuth2UserInfoEndpoint
+// getConfiguredEndpoint returns the URL configured for an endpoint, or an empty string if none is configured.
+// A configured path is resolved against the base URL.
+func (ds *discoveryService) getConfiguredEndpoint(baseURL, name string) string {
+	endpoint := config.GetServerRuntime().Config.OAuth.Discovery.Endpoints[name]
+	if strings.HasPrefix(endpoint, "/") {
+		return baseURL + endpoint
+	}
+	return endpoint
 }
 
-func (ds *discoveryService) getRegistrationEndpoint() string {
-	return ds.baseURL + constants.OAuth2DCREndpoint
+// getAdditionalMetadata returns the configured metadata fields, along with the configured endpoints that the
+// metadata does not define a field for.
+func (ds *discoveryService) getAdditionalMetadata(baseURL string) map[string]interface{} {
+	discoveryConfig := config.GetServerRuntime().Config.OAuth.Discovery
+	if len(discoveryConfig.AdditionalMetadata) == 0 && len(discoveryConfig.Endpoints) == 0 {
+		return nil
+	}
+
+	additionalMetadata := make(map[string]interface{}, len(discoveryConfig.AdditionalMetadata))
+	for name, value := range discoveryConfig.AdditionalMetadata {
+		additionalMetadata[name] = value
+	}
+	for name := range discoveryConfig.Endpoints {
+		if !slices.Contains(endpointMetadataNames, name) {
+			additionalMetadata[name] = ds.getConfiguredEndpoint(baseURL, name)
+		}
+	}
+	return additionalMetadata
 }
 
 func (ds *discoveryService) getSupportedScopes() []string {
@@ -133,6 +211,10 @@ func (ds *discoveryService) getSupportedResponseTypes() []string {
 	return constants.GetSupportedResponseTypes()
 }
 
+func (ds *discoveryService) getSupportedResponseModes() []string {
+	return constants.GetSupportedResponseModes()
+}
+
 func (ds *discoveryService) getSupportedGrantTypes() []string {
 	return constants.GetSupportedGrantTypes()
 }
@@ -145,14 +227,22 @@ func (ds *discoveryService) getSupportedCodeChallengeMethods() []string {
 	return pkce.GetSupportedCodeChallengeMethods()
 }
 
-func (ds *discoveryService) getPAREndpoint() string {
-	return ds.baseURL + constants.OAuth2PAREndpoint
+func (ds *discoveryService) getPAREndpoint(baseURL string) string {
+	return ds.getEndpoint(baseURL, "pushed_authorization_request_endpoint", constants.OAuth2PAREndpoint)
+}
+
+func (ds *discoveryService) getBackchannelAuthenticationEndpoint(baseURL string) string {
+	return ds.getEndpoint(baseURL, "backchannel_authentication_endpoint", constants.OAuth2CIBAEndpoint)
 }
 
 func (ds *discoveryService) isGlobalPARRequired() bool {
 	return config.GetServerRuntime().Config.OAuth.PAR.RequirePAR
 }
 
+func (ds *discoveryService) getSupportedAuthorizationDetailsTypes() []string {
+	return config.GetServerRuntime().Config.OAuth.AuthorizationDetails.Types
+}
+
 func (ds *discoveryService) getSupportedSubjectTypes() []string {
 	return constants.GetSupportedSubjectTypes()
 }
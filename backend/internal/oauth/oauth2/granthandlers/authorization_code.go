@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/grantrevocation"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
@@ -39,10 +40,11 @@ import (
 
 // authorizationCodeGrantHandler handles the authorization code grant type.
 type authorizationCodeGrantHandler struct {
-	authzService    authz.AuthorizeServiceInterface
-	tokenBuilder    tokenservice.TokenBuilderInterface
-	attributeCache  attributecache.AttributeCacheServiceInterface
-	resourceService resource.ResourceServiceInterface
+	authzService      authz.AuthorizeServiceInterface
+	tokenBuilder      tokenservice.TokenBuilderInterface
+	attributeCache    attributecache.AttributeCacheServiceInterface
+	resourceService   resource.ResourceServiceInterface
+	revocationService grantrevocation.GrantRevocationServiceInterface
 }
 
 // newAuthorizationCodeGrantHandler creates a new instance of AuthorizationCodeGrantHandler.
@@ -51,12 +53,14 @@ func newAuthorizationCodeGrantHandler(
 	tokenBuilder tokenservice.TokenBuilderInterface,
 	attributeCache attributecache.AttributeCacheServiceInterface,
 	resourceService resource.ResourceServiceInterface,
+	revocationService grantrevocation.GrantRevocationServiceInterface,
 ) GrantHandlerInterface {
 	return &authorizationCodeGrantHandler{
-		authzService:    authzService,
-		tokenBuilder:    tokenBuilder,
-		attributeCache:  attributeCache,
-		resourceService: resourceService,
+		authzService:      authzService,
+		tokenBuilder:      tokenBuilder,
+		attributeCache:    attributeCache,
+		resourceService:   resourceService,
+		revocationService: revocationService,
 	}
 }
 
@@ -185,17 +189,19 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 
 	// Generate access token using tokenBuilder (attributes will be filtered in BuildAccessToken)
 	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
-		Context:          ctx,
-		Subject:          authCode.AuthorizedUserID,
-		Audiences:        accessTokenAudiences,
-		ClientID:         tokenRequest.ClientID,
-		Scopes:           accessTokenScopes,
-		UserAttributes:   attrs,
-		AttributeCacheID: authCode.AttributeCacheID,
-		GrantType:        string(constants.GrantTypeAuthorizationCode),
-		OAuthApp:         oauthApp,
-		ClaimsRequest:    authCode.ClaimsRequest,
-		ClaimsLocales:    authCode.ClaimsLocales,
+		Context:              ctx,
+		Subject:              authCode.AuthorizedUserID,
+		Audiences:            accessTokenAudiences,
+		ClientID:             tokenRequest.ClientID,
+		Scopes:               accessTokenScopes,
+		UserAttributes:       attrs,
+		AttributeCacheID:     authCode.AttributeCacheID,
+		GrantType:            string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:             oauthApp,
+		ClaimsRequest:        authCode.ClaimsRequest,
+		ClaimsLocales:        authCode.ClaimsLocales,
+		SessionID:            authCode.SessionID,
+		AuthorizationDetails: authCode.AuthorizationDetails,
 	})
 	if err != nil {
 		return nil, &model.ErrorResponse{
@@ -226,6 +232,8 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 			ClaimsRequest:  authCode.ClaimsRequest,
 			Nonce:          authCode.Nonce,
 			CompletedACR:   authCode.CompletedACR,
+			CompletedAMR:   authCode.CompletedAMR,
+			SessionID:      authCode.SessionID,
 		})
 		if err != nil {
 			logger.Error("Failed to generate ID token", log.Error(err))
@@ -260,6 +268,22 @@ func (h *authorizationCodeGrantHandler) retrieveAndValidateAuthCode(
 		return nil, errResponse
 	}
 
+	// An authorization code issued before the grants of the user were revoked can no longer be redeemed.
+	revoked, svcErr := h.revocationService.IsGrantRevoked(ctx, authCode.AuthorizedUserID, authCode.TimeCreated)
+	if svcErr != nil {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate authorization code",
+		}
+	}
+	if revoked {
+		logger.Debug("Authorization code was issued before the grants of the user were revoked")
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid authorization code",
+		}
+	}
+
 	// Validate PKCE if required or if code challenge was provided during authorization
 	if oauthApp.RequiresPKCE() || authCode.CodeChallenge != "" {
 		if tokenRequest.CodeVerifier == "" {
@@ -39,6 +39,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/grantrevocationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/authzmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
@@ -82,6 +83,7 @@ type AuthorizationCodeGrantHandlerTestSuite struct {
 	mockAuthzService     *authzmock.AuthorizeServiceInterfaceMock
 	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
 	mockResourceService  *resourcemock.ResourceServiceInterfaceMock
+	mockRevocation       *grantrevocationmock.GrantRevocationServiceInterfaceMock
 	oauthApp             *inboundmodel.OAuthClient
 	testAuthzCode        authz.AuthorizationCode
 	testTokenReq         *model.TokenRequest
@@ -116,12 +118,16 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) SetupTest() {
 		Return([]string{}, nil).Maybe()
 	suite.mockResourceService.On("FindResourceServersByPermissions", mock.Anything, mock.Anything).
 		Return([]resource.ResourceServer{}, nil).Maybe()
+	suite.mockRevocation = grantrevocationmock.NewGrantRevocationServiceInterfaceMock(suite.T())
+	suite.mockRevocation.On("IsGrantRevoked", mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil).Maybe()
 
 	suite.handler = &authorizationCodeGrantHandler{
-		tokenBuilder:    suite.mockTokenBuilder,
-		authzService:    suite.mockAuthzService,
-		attributeCache:  suite.mockAttrCacheService,
-		resourceService: suite.mockResourceService,
+		tokenBuilder:      suite.mockTokenBuilder,
+		authzService:      suite.mockAuthzService,
+		attributeCache:    suite.mockAttrCacheService,
+		resourceService:   suite.mockResourceService,
+		revocationService: suite.mockRevocation,
 	}
 
 	suite.oauthApp = &inboundmodel.OAuthClient{
@@ -161,7 +167,8 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) SetupTest() {
 
 func (suite *AuthorizationCodeGrantHandlerTestSuite) TestNewAuthorizationCodeGrantHandler() {
 	handler := newAuthorizationCodeGrantHandler(
-		suite.mockAuthzService, suite.mockTokenBuilder, suite.mockAttrCacheService, suite.mockResourceService)
+		suite.mockAuthzService, suite.mockTokenBuilder, suite.mockAttrCacheService, suite.mockResourceService,
+		suite.mockRevocation)
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*GrantHandlerInterface)(nil), handler)
 }
@@ -305,6 +312,38 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_InvalidAuth
 	suite.mockAuthzService.AssertExpectations(suite.T())
 }
 
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_GrantRevoked() {
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&suite.testAuthzCode, nil)
+	suite.mockRevocation = grantrevocationmock.NewGrantRevocationServiceInterfaceMock(suite.T())
+	suite.mockRevocation.On("IsGrantRevoked", mock.Anything, suite.testAuthzCode.AuthorizedUserID,
+		suite.testAuthzCode.TimeCreated).Return(true, nil)
+	suite.handler.revocationService = suite.mockRevocation
+
+	result, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+	assert.Equal(suite.T(), "Invalid authorization code", err.ErrorDescription)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_GrantRevocationCheckError() {
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&suite.testAuthzCode, nil)
+	suite.mockRevocation = grantrevocationmock.NewGrantRevocationServiceInterfaceMock(suite.T())
+	suite.mockRevocation.On("IsGrantRevoked", mock.Anything, suite.testAuthzCode.AuthorizedUserID,
+		mock.Anything).Return(false, &serviceerror.InternalServerError)
+	suite.handler.revocationService = suite.mockRevocation
+
+	result, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+}
+
 func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_JWTGenerationError() {
 	// Mock authorization code store to return valid code
 	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
@@ -486,10 +525,11 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_WithGroups(
 			suite.mockResourceService.On("FindResourceServersByPermissions", mock.Anything, mock.Anything).
 				Return([]resource.ResourceServer{}, nil).Maybe()
 			suite.handler = &authorizationCodeGrantHandler{
-				tokenBuilder:    suite.mockTokenBuilder,
-				authzService:    suite.mockAuthzService,
-				attributeCache:  suite.mockAttrCacheService,
-				resourceService: suite.mockResourceService,
+				tokenBuilder:      suite.mockTokenBuilder,
+				authzService:      suite.mockAuthzService,
+				attributeCache:    suite.mockAttrCacheService,
+				resourceService:   suite.mockResourceService,
+				revocationService: suite.mockRevocation,
 			}
 
 			accessTokenAttrs := []string{"email", "username"}
@@ -683,10 +723,11 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_WithEmptyGr
 			suite.mockResourceService.On("FindResourceServersByPermissions", mock.Anything, mock.Anything).
 				Return([]resource.ResourceServer{}, nil).Maybe()
 			suite.handler = &authorizationCodeGrantHandler{
-				tokenBuilder:    suite.mockTokenBuilder,
-				authzService:    suite.mockAuthzService,
-				attributeCache:  suite.mockAttrCacheService,
-				resourceService: suite.mockResourceService,
+				tokenBuilder:      suite.mockTokenBuilder,
+				authzService:      suite.mockAuthzService,
+				attributeCache:    suite.mockAttrCacheService,
+				resourceService:   suite.mockResourceService,
+				revocationService: suite.mockRevocation,
 			}
 
 			accessTokenAttrs := []string{"email", "username"}
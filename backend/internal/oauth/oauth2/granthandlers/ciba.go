@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// cibaGrantHandler handles the CIBA grant type.
+type cibaGrantHandler struct {
+	cibaService     ciba.CIBAServiceInterface
+	tokenBuilder    tokenservice.TokenBuilderInterface
+	attributeCache  attributecache.AttributeCacheServiceInterface
+	resourceService resource.ResourceServiceInterface
+}
+
+// newCIBAGrantHandler creates a new instance of cibaGrantHandler.
+func newCIBAGrantHandler(
+	cibaService ciba.CIBAServiceInterface,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	attributeCache attributecache.AttributeCacheServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+) GrantHandlerInterface {
+	return &cibaGrantHandler{
+		cibaService:     cibaService,
+		tokenBuilder:    tokenBuilder,
+		attributeCache:  attributeCache,
+		resourceService: resourceService,
+	}
+}
+
+// ValidateGrant validates the CIBA grant request.
+func (h *cibaGrantHandler) ValidateGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) *model.ErrorResponse {
+	if constants.GrantType(tokenRequest.GrantType) != constants.GrantTypeCIBA {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorUnsupportedGrantType,
+			ErrorDescription: "Unsupported grant type",
+		}
+	}
+	if tokenRequest.AuthReqID == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "auth_req_id is required",
+		}
+	}
+	if tokenRequest.ClientID == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidClient,
+			ErrorDescription: "client_id is required",
+		}
+	}
+
+	return nil
+}
+
+// HandleGrant redeems the auth_req_id of a completed backchannel authentication request for tokens.
+func (h *cibaGrantHandler) HandleGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) (*model.TokenResponseDTO, *model.ErrorResponse) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CIBAGrantHandler"))
+
+	result, errResp := h.cibaService.PollAuthenticationResult(ctx, tokenRequest.AuthReqID, tokenRequest.ClientID)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	// Get user attributes from attribute cache
+	attrs := make(map[string]interface{})
+	if result.AttributeCacheID != "" {
+		userAttributes, err := h.attributeCache.GetAttributeCache(ctx, result.AttributeCacheID)
+		if err != nil {
+			logger.Error("Failed to get user attributes from attribute cache. " + err.ErrorDescription.DefaultValue)
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to get user attributes from attribute cache",
+			}
+		}
+		attrs = userAttributes.Attributes
+	}
+
+	// The resource indicators of the backchannel authentication request restrict the token audience.
+	resolvedRSes, errResp := resourceindicators.ResolveResourceServers(ctx, h.resourceService, result.Resources)
+	if errResp != nil {
+		return nil, errResp
+	}
+	audiences, errResp := resourceindicators.ComposeAudiences(ctx, h.resourceService, tokenRequest.ClientID,
+		resolvedRSes, result.Scopes)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
+		Context:          ctx,
+		Subject:          result.UserID,
+		Audiences:        audiences,
+		ClientID:         tokenRequest.ClientID,
+		Scopes:           result.Scopes,
+		UserAttributes:   attrs,
+		AttributeCacheID: result.AttributeCacheID,
+		GrantType:        string(constants.GrantTypeCIBA),
+		OAuthApp:         oauthApp,
+	})
+	if err != nil {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+
+	tokenResponse := &model.TokenResponseDTO{
+		AccessToken: *accessToken,
+	}
+
+	if slices.Contains(result.Scopes, constants.ScopeOpenID) {
+		idToken, err := h.tokenBuilder.BuildIDToken(&tokenservice.IDTokenBuildContext{
+			Context:        ctx,
+			Subject:        result.UserID,
+			Audience:       tokenRequest.ClientID,
+			Scopes:         result.Scopes,
+			UserAttributes: attrs,
+			AuthTime:       result.AuthTime.Unix(),
+			OAuthApp:       oauthApp,
+			CompletedACR:   result.CompletedACR,
+			CompletedAMR:   result.CompletedAMR,
+		})
+		if err != nil {
+			logger.Error("Failed to generate ID token", log.Error(err))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to generate token",
+			}
+		}
+		tokenResponse.IDToken = *idToken
+	}
+
+	return tokenResponse, nil
+}
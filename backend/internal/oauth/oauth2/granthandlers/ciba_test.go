@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/cibamock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+)
+
+const testAuthReqID = "test-auth-req-id"
+
+type CIBAGrantHandlerTestSuite struct {
+	suite.Suite
+	mockCIBAService      *cibamock.CIBAServiceInterfaceMock
+	mockTokenBuilder     *tokenservicemock.TokenBuilderInterfaceMock
+	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockResourceService  *resourcemock.ResourceServiceInterfaceMock
+	handler              *cibaGrantHandler
+	oauthApp             *inboundmodel.OAuthClient
+	testTokenReq         *model.TokenRequest
+}
+
+func TestCIBAGrantHandlerSuite(t *testing.T) {
+	suite.Run(t, new(CIBAGrantHandlerTestSuite))
+}
+
+func (suite *CIBAGrantHandlerTestSuite) SetupTest() {
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{
+			ValidityPeriod: 3600,
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	suite.mockCIBAService = cibamock.NewCIBAServiceInterfaceMock(suite.T())
+	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+	suite.mockAttrCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.mockResourceService.On("FindResourceServersByPermissions", mock.Anything, mock.Anything).
+		Return([]resource.ResourceServer{}, nil).Maybe()
+
+	suite.handler = &cibaGrantHandler{
+		cibaService:     suite.mockCIBAService,
+		tokenBuilder:    suite.mockTokenBuilder,
+		attributeCache:  suite.mockAttrCacheService,
+		resourceService: suite.mockResourceService,
+	}
+	suite.oauthApp = &inboundmodel.OAuthClient{
+		ClientID:   testClientID,
+		GrantTypes: []constants.GrantType{constants.GrantTypeCIBA},
+	}
+	suite.testTokenReq = &model.TokenRequest{
+		GrantType: string(constants.GrantTypeCIBA),
+		ClientID:  testClientID,
+		AuthReqID: testAuthReqID,
+	}
+}
+
+func (suite *CIBAGrantHandlerTestSuite) TestNewCIBAGrantHandler() {
+	handler := newCIBAGrantHandler(
+		suite.mockCIBAService, suite.mockTokenBuilder, suite.mockAttrCacheService, suite.mockResourceService)
+	assert.NotNil(suite.T(), handler)
+	assert.Implements(suite.T(), (*GrantHandlerInterface)(nil), handler)
+}
+
+func (suite *CIBAGrantHandlerTestSuite) TestValidateGrant() {
+	testCases := []struct {
+		name          string
+		modify        func(*model.TokenRequest)
+		expectedError string
+	}{
+		{"Valid", func(*model.TokenRequest) {}, ""},
+		{"UnsupportedGrantType", func(r *model.TokenRequest) { r.GrantType = "password" },
+			constants.ErrorUnsupportedGrantType},
+		{"MissingAuthReqID", func(r *model.TokenRequest) { r.AuthReqID = "" }, constants.ErrorInvalidRequest},
+		{"MissingClientID", func(r *model.TokenRequest) { r.ClientID = "" }, constants.ErrorInvalidClient},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tokenReq := *suite.testTokenReq
+			tc.modify(&tokenReq)
+
+			errResp := suite.handler.ValidateGrant(context.Background(), &tokenReq, suite.oauthApp)
+
+			if tc.expectedError == "" {
+				assert.Nil(suite.T(), errResp)
+			} else {
+				assert.Equal(suite.T(), tc.expectedError, errResp.Error)
+			}
+		})
+	}
+}
+
+func (suite *CIBAGrantHandlerTestSuite) TestHandleGrant_Success() {
+	authTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+	suite.mockCIBAService.On("PollAuthenticationResult", mock.Anything, testAuthReqID, testClientID).
+		Return(&ciba.AuthenticationResult{
+			UserID:           testUserID,
+			Scopes:           []string{"openid", "profile"},
+			AttributeCacheID: testCacheID,
+			CompletedACR:     "mfa",
+			AuthTime:         authTime,
+		}, (*model.ErrorResponse)(nil))
+	suite.mockAttrCacheService.On("GetAttributeCache", mock.Anything, testCacheID).
+		Return(&attributecache.AttributeCache{
+			ID:         testCacheID,
+			Attributes: map[string]interface{}{"email": "test@example.com"},
+		}, (*serviceerror.ServiceError)(nil))
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+		return ctx.Subject == testUserID && ctx.ClientID == testClientID &&
+			ctx.GrantType == string(constants.GrantTypeCIBA) && ctx.AttributeCacheID == testCacheID
+	})).Return(&model.TokenDTO{
+		Token:     "test-jwt-token",
+		TokenType: constants.TokenTypeBearer,
+		Scopes:    []string{"openid", "profile"},
+		Subject:   testUserID,
+	}, nil)
+	suite.mockTokenBuilder.On("BuildIDToken", mock.MatchedBy(func(ctx *tokenservice.IDTokenBuildContext) bool {
+		return ctx.Subject == testUserID && ctx.AuthTime == authTime.Unix() && ctx.CompletedACR == "mfa" &&
+			ctx.UserAttributes["email"] == "test@example.com"
+	})).Return(&model.TokenDTO{Token: "test-id-token"}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), "test-jwt-token", result.AccessToken.Token)
+	assert.Equal(suite.T(), "test-id-token", result.IDToken.Token)
+}
+
+func (suite *CIBAGrantHandlerTestSuite) TestHandleGrant_ResourceRestrictsAudience() {
+	resourceURI := "https://api.example.com/orders"
+	suite.mockCIBAService.On("PollAuthenticationResult", mock.Anything, testAuthReqID, testClientID).
+		Return(&ciba.AuthenticationResult{
+			UserID:    testUserID,
+			Scopes:    []string{"read"},
+			Resources: []string{resourceURI},
+		}, (*model.ErrorResponse)(nil))
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, resourceURI).
+		Return(&resource.ResourceServer{ID: "rs-1", Identifier: resourceURI}, (*serviceerror.ServiceError)(nil))
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+		return assert.ObjectsAreEqual([]string{resourceURI}, ctx.Audiences)
+	})).Return(&model.TokenDTO{Token: "test-jwt-token", Scopes: []string{"read"}}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), "test-jwt-token", result.AccessToken.Token)
+}
+
+func (suite *CIBAGrantHandlerTestSuite) TestHandleGrant_ResourceNoLongerRegistered() {
+	resourceURI := "https://api.example.com/orders"
+	suite.mockCIBAService.On("PollAuthenticationResult", mock.Anything, testAuthReqID, testClientID).
+		Return(&ciba.AuthenticationResult{
+			UserID:    testUserID,
+			Scopes:    []string{"read"},
+			Resources: []string{resourceURI},
+		}, (*model.ErrorResponse)(nil))
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, resourceURI).
+		Return(nil, &serviceerror.ServiceError{Type: serviceerror.ClientErrorType})
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), constants.ErrorInvalidTarget, errResp.Error)
+}
+
+func (suite *CIBAGrantHandlerTestSuite) TestHandleGrant_PollError() {
+	suite.mockCIBAService.On("PollAuthenticationResult", mock.Anything, testAuthReqID, testClientID).
+		Return((*ciba.AuthenticationResult)(nil), &model.ErrorResponse{
+			Error:            constants.ErrorAuthorizationPending,
+			ErrorDescription: "The end user has not yet completed authentication",
+		})
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), constants.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (suite *CIBAGrantHandlerTestSuite) TestHandleGrant_TokenBuildError() {
+	suite.mockCIBAService.On("PollAuthenticationResult", mock.Anything, testAuthReqID, testClientID).
+		Return(&ciba.AuthenticationResult{
+			UserID: testUserID,
+			Scopes: []string{"openid"},
+		}, (*model.ErrorResponse)(nil))
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(nil, errors.New("jwt generation failed"))
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), constants.ErrorServerError, errResp.Error)
+}
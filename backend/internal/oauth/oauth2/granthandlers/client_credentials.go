@@ -29,8 +29,10 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
@@ -83,6 +85,16 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 	*model.TokenResponseDTO, *model.ErrorResponse) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ClientCredentialsGrantHandler"))
 
+	authorizationDetails, parseErr := oauth2utils.ParseAuthorizationDetails(tokenRequest.AuthorizationDetails,
+		config.GetServerRuntime().Config.OAuth.AuthorizationDetails.Types)
+	if parseErr != nil {
+		logger.Debug("Failed to parse authorization_details parameter", log.Error(parseErr))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidAuthorizationDetails,
+			ErrorDescription: "The authorization_details parameter is malformed or contains unsupported types",
+		}
+	}
+
 	scopes := tokenservice.ParseScopes(tokenRequest.Scope)
 	hasResourceParam := len(tokenRequest.Resources) > 0
 
@@ -104,6 +116,11 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		scopes = resourceindicators.UnionScopes(rsValidScopes)
 	}
 
+	// Restrict the scopes to those configured on the application, when it defines any.
+	if len(oauthApp.Scopes) > 0 {
+		scopes = filterAllowedScopes(scopes, oauthApp.Scopes)
+	}
+
 	if len(scopes) > 0 {
 		var groupIDs []string
 		if h.entityProv != nil {
@@ -161,15 +178,16 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 	}
 
 	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
-		Context:          ctx,
-		Subject:          tokenRequest.ClientID,
-		Audiences:        audiences,
-		ClientID:         tokenRequest.ClientID,
-		Scopes:           scopes,
-		UserAttributes:   make(map[string]interface{}),
-		GrantType:        string(constants.GrantTypeClientCredentials),
-		OAuthApp:         oauthApp,
-		ClientAttributes: clientAttributes,
+		Context:              ctx,
+		Subject:              tokenRequest.ClientID,
+		Audiences:            audiences,
+		ClientID:             tokenRequest.ClientID,
+		Scopes:               scopes,
+		UserAttributes:       make(map[string]interface{}),
+		GrantType:            string(constants.GrantTypeClientCredentials),
+		OAuthApp:             oauthApp,
+		ClientAttributes:     clientAttributes,
+		AuthorizationDetails: authorizationDetails,
 	})
 	if err != nil {
 		return nil, &model.ErrorResponse{
@@ -182,3 +200,14 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		AccessToken: *accessToken,
 	}, nil
 }
+
+// filterAllowedScopes returns the requested scopes that are present in the allowed scopes, preserving their order.
+func filterAllowedScopes(requestedScopes, allowedScopes []string) []string {
+	scopes := make([]string, 0, len(requestedScopes))
+	for _, scope := range requestedScopes {
+		if slices.Contains(allowedScopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
@@ -556,6 +556,72 @@ func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_NoAuthorize
 	assert.Empty(suite.T(), result.AccessToken.Scopes)
 }
 
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_RestrictedToApplicationScopes() {
+	tokenRequest := &model.TokenRequest{
+		GrantType:    "client_credentials",
+		ClientID:     testClientID,
+		ClientSecret: "secret123",
+		Scope:        "read write delete",
+	}
+	suite.oauthApp.Scopes = []string{"read", "delete"}
+
+	// Only the scopes configured on the application reach the authorization check.
+	suite.mockAuthzService.On("GetAuthorizedPermissions", mock.Anything,
+		authz.GetAuthorizedPermissionsRequest{
+			EntityID:             suite.oauthApp.ID,
+			RequestedPermissions: []string{"read", "delete"},
+		}).Return(&authz.GetAuthorizedPermissionsResponse{
+		AuthorizedPermissions: []string{"read", "delete"},
+	}, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken",
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return tokenservice.JoinScopes(ctx.Scopes) == tokenservice.JoinScopes([]string{"read", "delete"})
+		})).Return(&model.TokenDTO{
+		Token:     testJWTToken,
+		TokenType: constants.TokenTypeBearer,
+		IssuedAt:  int64(1234567890),
+		ExpiresIn: 3600,
+		Scopes:    []string{"read", "delete"},
+		ClientID:  testClientID,
+	}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), []string{"read", "delete"}, result.AccessToken.Scopes)
+}
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_NoApplicationScopeRequested_SkipsAuthzCall() {
+	tokenRequest := &model.TokenRequest{
+		GrantType:    "client_credentials",
+		ClientID:     testClientID,
+		ClientSecret: "secret123",
+		Scope:        "admin:full",
+	}
+	suite.oauthApp.Scopes = []string{"read"}
+
+	suite.mockTokenBuilder.On("BuildAccessToken",
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.Scopes) == 0
+		})).Return(&model.TokenDTO{
+		Token:     testJWTToken,
+		TokenType: constants.TokenTypeBearer,
+		IssuedAt:  int64(1234567890),
+		ExpiresIn: 3600,
+		Scopes:    []string{},
+		ClientID:  testClientID,
+	}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.NotNil(suite.T(), result)
+	assert.Empty(suite.T(), result.AccessToken.Scopes)
+	suite.mockAuthzService.AssertNotCalled(suite.T(), "GetAuthorizedPermissions", mock.Anything, mock.Anything)
+}
+
 func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_AuthzServiceError() {
 	tokenRequest := &model.TokenRequest{
 		GrantType:    "client_credentials",
@@ -611,6 +677,68 @@ func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_EmptyScope_
 	suite.mockAuthzService.AssertNotCalled(suite.T(), "GetAuthorizedPermissions", mock.Anything, mock.Anything)
 }
 
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_WithAuthorizationDetails() {
+	tokenRequest := &model.TokenRequest{
+		GrantType:            "client_credentials",
+		ClientID:             testClientID,
+		ClientSecret:         "secret123",
+		AuthorizationDetails: `[{"type":"payment_initiation","actions":["initiate"]}]`,
+	}
+
+	suite.mockTokenBuilder.On("BuildAccessToken",
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.AuthorizationDetails) == 1 &&
+				ctx.AuthorizationDetails[0].GetType() == "payment_initiation"
+		})).Return(&model.TokenDTO{
+		Token:     testJWTToken,
+		TokenType: constants.TokenTypeBearer,
+		ExpiresIn: 3600,
+		ClientID:  testClientID,
+		AuthorizationDetails: []model.AuthorizationDetail{
+			{"type": "payment_initiation", "actions": []interface{}{"initiate"}},
+		},
+	}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.NotNil(suite.T(), result)
+	assert.Len(suite.T(), result.AccessToken.AuthorizationDetails, 1)
+}
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_InvalidAuthorizationDetails() {
+	authorizationDetailsConfig := &config.GetServerRuntime().Config.OAuth.AuthorizationDetails
+	authorizationDetailsConfig.Types = []string{"account_information"}
+	defer func() { authorizationDetailsConfig.Types = nil }()
+
+	testCases := []struct {
+		name                 string
+		authorizationDetails string
+	}{
+		{"Malformed", `{"type":"account_information"}`},
+		{"MissingType", `[{"actions":["read"]}]`},
+		{"UnsupportedType", `[{"type":"payment_initiation"}]`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tokenRequest := &model.TokenRequest{
+				GrantType:            "client_credentials",
+				ClientID:             testClientID,
+				ClientSecret:         "secret123",
+				AuthorizationDetails: tc.authorizationDetails,
+			}
+
+			result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+			assert.Nil(suite.T(), result)
+			assert.NotNil(suite.T(), errResp)
+			assert.Equal(suite.T(), constants.ErrorInvalidAuthorizationDetails, errResp.Error)
+		})
+	}
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
+
 // QA §4 — Implicit RS discovery: no resource param + scope maps to a registered RS.
 //
 // These tests use fresh mocks (not the suite defaults) so that FindResourceServersByPermissions
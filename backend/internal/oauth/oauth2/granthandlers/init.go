@@ -25,12 +25,17 @@ import (
 	"github.com/thunder-id/thunderid/internal/authz"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	"github.com/thunder-id/thunderid/internal/grantrevocation"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	oauth2authz "github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/requestobject"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/ssosession"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 )
 
@@ -47,10 +52,16 @@ func Initialize(
 	authzService authz.AuthorizationServiceInterface,
 	entityProv entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
+	scopeValidator scope.ScopeValidatorInterface,
 	parService par.PARServiceInterface,
+	requestObjects requestobject.RequestObjectResolverInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface,
+	cibaService ciba.CIBAServiceInterface,
+	grantRevocationService grantrevocation.GrantRevocationServiceInterface,
 ) (GrantHandlerProviderInterface, error) {
 	oauthAuthzService, err := oauth2authz.Initialize(
-		mux, inboundClient, resourceService, jwtService, flowExecService, parService,
+		mux, inboundClient, resourceService, scopeValidator, jwtService, tokenValidator, flowExecService, parService,
+		requestObjects, ssoSessionService, attrCacheService, authzService, entityProv,
 	)
 	if err != nil {
 		return nil, err
@@ -65,6 +76,8 @@ func Initialize(
 		authzService,
 		entityProv,
 		resourceService,
+		cibaService,
+		grantRevocationService,
 	)
 	return grantHandlerProvider, nil
 }
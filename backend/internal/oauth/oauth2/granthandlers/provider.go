@@ -22,7 +22,9 @@ import (
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	rbacauthz "github.com/thunder-id/thunderid/internal/authz"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/grantrevocation"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/ciba"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/ou"
@@ -41,6 +43,7 @@ type GrantHandlerProvider struct {
 	authorizationCodeGrantHandler GrantHandlerInterface
 	refreshTokenGrantHandler      GrantHandlerInterface
 	tokenExchangeGrantHandler     GrantHandlerInterface
+	cibaGrantHandler              GrantHandlerInterface
 }
 
 // newGrantHandlerProvider creates a new instance of GrantHandlerProvider.
@@ -54,16 +57,20 @@ func newGrantHandlerProvider(
 	rbacAuthzService rbacauthz.AuthorizationServiceInterface,
 	entityProv entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
+	cibaService ciba.CIBAServiceInterface,
+	revocationService grantrevocation.GrantRevocationServiceInterface,
 ) GrantHandlerProviderInterface {
 	return &GrantHandlerProvider{
 		clientCredentialsGrantHandler: newClientCredentialsGrantHandler(
 			tokenBuilder, ouService, rbacAuthzService, entityProv, resourceService),
 		authorizationCodeGrantHandler: newAuthorizationCodeGrantHandler(
-			authzService, tokenBuilder, attrCacheService, resourceService),
+			authzService, tokenBuilder, attrCacheService, resourceService, revocationService),
 		refreshTokenGrantHandler: newRefreshTokenGrantHandler(
-			jwtService, tokenBuilder, tokenValidator, attrCacheService, resourceService),
+			jwtService, tokenBuilder, tokenValidator, attrCacheService, resourceService, revocationService),
 		tokenExchangeGrantHandler: newTokenExchangeGrantHandler(
 			tokenBuilder, tokenValidator, resourceService),
+		cibaGrantHandler: newCIBAGrantHandler(
+			cibaService, tokenBuilder, attrCacheService, resourceService),
 	}
 }
 
@@ -78,6 +85,8 @@ func (p *GrantHandlerProvider) GetGrantHandler(grantType constants.GrantType) (G
 		return p.refreshTokenGrantHandler, nil
 	case constants.GrantTypeTokenExchange:
 		return p.tokenExchangeGrantHandler, nil
+	case constants.GrantTypeCIBA:
+		return p.cibaGrantHandler, nil
 	default:
 		return nil, constants.UnSupportedGrantTypeError
 	}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/grantrevocation"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
@@ -40,11 +41,12 @@ import (
 
 // refreshTokenGrantHandler handles the refresh token grant type.
 type refreshTokenGrantHandler struct {
-	jwtService       jwt.JWTServiceInterface
-	tokenBuilder     tokenservice.TokenBuilderInterface
-	tokenValidator   tokenservice.TokenValidatorInterface
-	attrCacheService attributecache.AttributeCacheServiceInterface
-	resourceService  resource.ResourceServiceInterface
+	jwtService        jwt.JWTServiceInterface
+	tokenBuilder      tokenservice.TokenBuilderInterface
+	tokenValidator    tokenservice.TokenValidatorInterface
+	attrCacheService  attributecache.AttributeCacheServiceInterface
+	resourceService   resource.ResourceServiceInterface
+	revocationService grantrevocation.GrantRevocationServiceInterface
 }
 
 // newRefreshTokenGrantHandler creates a new instance of RefreshTokenGrantHandler.
@@ -54,13 +56,15 @@ func newRefreshTokenGrantHandler(
 	tokenValidator tokenservice.TokenValidatorInterface,
 	attrCacheService attributecache.AttributeCacheServiceInterface,
 	resourceService resource.ResourceServiceInterface,
+	revocationService grantrevocation.GrantRevocationServiceInterface,
 ) RefreshTokenGrantHandlerInterface {
 	return &refreshTokenGrantHandler{
-		jwtService:       jwtService,
-		tokenBuilder:     tokenBuilder,
-		tokenValidator:   tokenValidator,
-		attrCacheService: attrCacheService,
-		resourceService:  resourceService,
+		jwtService:        jwtService,
+		tokenBuilder:      tokenBuilder,
+		tokenValidator:    tokenValidator,
+		attrCacheService:  attrCacheService,
+		resourceService:   resourceService,
+		revocationService: revocationService,
 	}
 }
 
@@ -100,7 +104,8 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RefreshTokenGrantHandler"))
 
 	// Validate refresh token using token validator
-	refreshTokenClaims, err := h.tokenValidator.ValidateRefreshToken(tokenRequest.RefreshToken, tokenRequest.ClientID)
+	refreshTokenClaims, err := h.tokenValidator.ValidateRefreshToken(
+		ctx, tokenRequest.RefreshToken, tokenRequest.ClientID)
 	if err != nil {
 		logger.Debug("Failed to validate refresh token", log.Error(err))
 		return nil, &model.ErrorResponse{
@@ -109,6 +114,35 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		}
 	}
 
+	// A sliding refresh token session cannot be extended beyond its maximum lifetime.
+	maxLifetime := tokenservice.ResolveRefreshTokenMaxLifetime(oauthApp)
+	if maxLifetime > 0 && time.Now().Unix() >= refreshTokenClaims.OriginalIat+maxLifetime {
+		logger.Debug("Refresh token session has exceeded its maximum lifetime",
+			log.String("client_id", tokenRequest.ClientID))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid refresh token",
+		}
+	}
+
+	// A refresh token issued before the grants of the user were revoked can no longer be used.
+	revoked, svcErr := h.revocationService.IsGrantRevoked(ctx, refreshTokenClaims.Sub,
+		time.Unix(refreshTokenClaims.Iat, 0))
+	if svcErr != nil {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate refresh token",
+		}
+	}
+	if revoked {
+		logger.Debug("Refresh token was issued before the grants of the user were revoked",
+			log.String("client_id", tokenRequest.ClientID))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid refresh token",
+		}
+	}
+
 	newTokenScopes, scopeErr := h.validateAndApplyScopes(tokenRequest.Scope, refreshTokenClaims.Scopes, logger)
 	if scopeErr != nil {
 		return nil, scopeErr
@@ -181,17 +215,18 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 	}
 
 	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
-		Context:          ctx,
-		Subject:          refreshTokenClaims.Sub,
-		Audiences:        audiences,
-		ClientID:         tokenRequest.ClientID,
-		Scopes:           newTokenScopes,
-		UserAttributes:   attrs,
-		AttributeCacheID: refreshTokenClaims.AttributeCacheID,
-		GrantType:        refreshTokenClaims.GrantType,
-		OAuthApp:         oauthApp,
-		ClaimsRequest:    refreshTokenClaims.ClaimsRequest,
-		ClaimsLocales:    refreshTokenClaims.ClaimsLocales,
+		Context:              ctx,
+		Subject:              refreshTokenClaims.Sub,
+		Audiences:            audiences,
+		ClientID:             tokenRequest.ClientID,
+		Scopes:               newTokenScopes,
+		UserAttributes:       attrs,
+		AttributeCacheID:     refreshTokenClaims.AttributeCacheID,
+		GrantType:            refreshTokenClaims.GrantType,
+		OAuthApp:             oauthApp,
+		ClaimsRequest:        refreshTokenClaims.ClaimsRequest,
+		ClaimsLocales:        refreshTokenClaims.ClaimsLocales,
+		AuthorizationDetails: refreshTokenClaims.AuthorizationDetails,
 	})
 	if err != nil {
 		logger.Error("Failed to generate access token", log.Error(err))
@@ -227,19 +262,20 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		tokenResponse.IDToken = *idToken
 	}
 
-	// Check configuration for refresh token renewal
+	// Check configuration for refresh token renewal. Sliding refresh tokens are always renewed so that
+	// each use extends the expiry.
 	conf := config.GetServerRuntime().Config
-	renewRefreshToken := conf.OAuth.RefreshToken.RenewOnGrant
+	renewRefreshToken := conf.OAuth.RefreshToken.RenewOnGrant || maxLifetime > 0
 
-	// Issue a new refresh token if renew_on_grant is enabled; otherwise reuse the existing one.
+	// Issue a new refresh token if renewal is enabled; otherwise reuse the existing one.
 	// RFC 8707 §5: the refresh token preserves the full original audience, not the narrowed one.
 	if renewRefreshToken {
 		logger.Debug("Renewing refresh token", log.String("client_id", tokenRequest.ClientID))
-		errResp := h.IssueRefreshToken(ctx, tokenResponse, oauthApp,
+		errResp := h.issueRefreshToken(ctx, tokenResponse, oauthApp,
 			refreshTokenClaims.Sub, refreshTokenClaims.Audiences,
 			refreshTokenClaims.GrantType, newTokenScopes,
 			refreshTokenClaims.ClaimsRequest, refreshTokenClaims.ClaimsLocales,
-			refreshTokenClaims.AttributeCacheID)
+			refreshTokenClaims.AttributeCacheID, refreshTokenClaims.OriginalIat)
 		if errResp != nil && errResp.Error != "" {
 			logger.Error("Failed to issue refresh token", log.String("error", errResp.Error))
 			return nil, errResp
@@ -271,6 +307,23 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 	claimsRequest *model.ClaimsRequest,
 	claimsLocales string,
 	attributeCacheID string,
+) *model.ErrorResponse {
+	return h.issueRefreshToken(ctx, tokenResponse, oauthApp, subject, audiences, grantType, scopes,
+		claimsRequest, claimsLocales, attributeCacheID, 0)
+}
+
+// issueRefreshToken generates a new refresh token. originalIssuedAt carries the start of the refresh
+// token session forward when renewing a sliding refresh token; 0 starts a new session.
+func (h *refreshTokenGrantHandler) issueRefreshToken(
+	ctx context.Context,
+	tokenResponse *model.TokenResponseDTO,
+	oauthApp *inboundmodel.OAuthClient,
+	subject string, audiences []string, grantType string,
+	scopes []string,
+	claimsRequest *model.ClaimsRequest,
+	claimsLocales string,
+	attributeCacheID string,
+	originalIssuedAt int64,
 ) *model.ErrorResponse {
 	tokenCtx := &tokenservice.RefreshTokenBuildContext{
 		Context:              ctx,
@@ -283,6 +336,12 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 		OAuthApp:             oauthApp,
 		ClaimsRequest:        claimsRequest,
 		ClaimsLocales:        claimsLocales,
+		OriginalIssuedAt:     originalIssuedAt,
+	}
+
+	// The refresh token carries the authorization details granted with the access token.
+	if tokenResponse != nil {
+		tokenCtx.AuthorizationDetails = tokenResponse.AccessToken.AuthorizationDetails
 	}
 
 	// Build refresh token using token builder
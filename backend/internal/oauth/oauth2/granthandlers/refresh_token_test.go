@@ -39,6 +39,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/grantrevocationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
 	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
@@ -59,6 +60,7 @@ type RefreshTokenGrantHandlerTestSuite struct {
 	mockTokenValidator   *tokenservicemock.TokenValidatorInterfaceMock
 	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
 	mockResourceService  *resourcemock.ResourceServiceInterfaceMock
+	mockRevocation       *grantrevocationmock.GrantRevocationServiceInterfaceMock
 	oauthApp             *inboundmodel.OAuthClient
 	validRefreshToken    string
 	validClaims          map[string]interface{}
@@ -101,13 +103,17 @@ func (suite *RefreshTokenGrantHandlerTestSuite) SetupTest() {
 		}).Maybe()
 	suite.mockResourceService.On("ValidatePermissions", mock.Anything, mock.Anything, mock.Anything).
 		Return([]string{}, nil).Maybe()
+	suite.mockRevocation = grantrevocationmock.NewGrantRevocationServiceInterfaceMock(suite.T())
+	suite.mockRevocation.On("IsGrantRevoked", mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil).Maybe()
 
 	suite.handler = &refreshTokenGrantHandler{
-		jwtService:       suite.mockJWTService,
-		tokenBuilder:     suite.mockTokenBuilder,
-		tokenValidator:   suite.mockTokenValidator,
-		attrCacheService: suite.mockAttrCacheService,
-		resourceService:  suite.mockResourceService,
+		jwtService:        suite.mockJWTService,
+		tokenBuilder:      suite.mockTokenBuilder,
+		tokenValidator:    suite.mockTokenValidator,
+		attrCacheService:  suite.mockAttrCacheService,
+		resourceService:   suite.mockResourceService,
+		revocationService: suite.mockRevocation,
 	}
 
 	suite.oauthApp = &inboundmodel.OAuthClient{
@@ -152,6 +158,7 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestNewRefreshTokenGrantHandler(
 		suite.mockTokenValidator,
 		suite.mockAttrCacheService,
 		suite.mockResourceService,
+		suite.mockRevocation,
 	)
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*RefreshTokenGrantHandlerInterface)(nil), handler)
@@ -201,8 +208,8 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestValidateGrant_MissingClientI
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_InvalidSignature() {
 	// Mock token validator to return error (simulating signature verification failure)
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(nil, errors.New("public key not available"))
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(nil, errors.New("public key not available"))
 
 	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
 
@@ -305,17 +312,41 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_WithClaims
 	assert.Equal(suite.T(), "en-US fr-CA ja", tokenResponse.RefreshToken.ClaimsLocales)
 }
 
+func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_WithAuthorizationDetails() {
+	authorizationDetails := []model.AuthorizationDetail{{"type": "payment_initiation"}}
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.MatchedBy(
+		func(ctx *tokenservice.RefreshTokenBuildContext) bool {
+			return len(ctx.AuthorizationDetails) == 1 &&
+				ctx.AuthorizationDetails[0].GetType() == "payment_initiation"
+		})).Return(&model.TokenDTO{
+		Token:     "new.refresh.token",
+		IssuedAt:  int64(1234567890),
+		ExpiresIn: 3600,
+	}, nil)
+
+	tokenResponse := &model.TokenResponseDTO{
+		AccessToken: model.TokenDTO{AuthorizationDetails: authorizationDetails},
+	}
+
+	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp,
+		testRefreshTokenUserID, []string{testRefreshTokenAudience},
+		"authorization_code", []string{"read"}, nil, "", "")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "new.refresh.token", tokenResponse.RefreshToken.Token)
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_Success_WithRenewOnGrantDisabled() {
 	// Mock successful refresh token validation
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	// Mock successful access token generation
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(
@@ -344,15 +375,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_Success_WithRene
 	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
 
 	// Mock successful refresh token validation
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	// Mock successful access token generation
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
@@ -383,16 +414,128 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_Success_WithRene
 	assert.Equal(suite.T(), "new.refresh.token", response.RefreshToken.Token)
 }
 
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_SlidingExpiration_RenewsRefreshToken() {
+	// RenewOnGrant is disabled by default; sliding expiration renews the refresh token regardless.
+	suite.oauthApp.Token = &inboundmodel.OAuthTokenConfig{
+		RefreshToken: &inboundmodel.RefreshTokenConfig{
+			ValidityPeriod: 3600, SlidingExpiration: true, MaxLifetime: 86400,
+		},
+	}
+	originalIat := time.Now().Unix() - 7200
+
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:         testRefreshTokenUserID,
+		Audiences:   []string{testRefreshTokenAudience},
+		Scopes:      []string{"read", "write"},
+		GrantType:   "authorization_code",
+		Iat:         time.Now().Unix() - 600,
+		OriginalIat: originalIat,
+	}, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+		Scopes:    []string{"read", "write"},
+	}, nil)
+
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.MatchedBy(
+		func(ctx *tokenservice.RefreshTokenBuildContext) bool {
+			return ctx.OriginalIssuedAt == originalIat
+		})).Return(&model.TokenDTO{
+		Token:     "new.refresh.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+	}, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), response)
+	assert.Equal(suite.T(), "new.refresh.token", response.RefreshToken.Token)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_SlidingExpiration_MaxLifetimeExceeded() {
+	suite.oauthApp.Token = &inboundmodel.OAuthTokenConfig{
+		RefreshToken: &inboundmodel.RefreshTokenConfig{
+			ValidityPeriod: 3600, SlidingExpiration: true, MaxLifetime: 86400,
+		},
+	}
+
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:         testRefreshTokenUserID,
+		Audiences:   []string{testRefreshTokenAudience},
+		Scopes:      []string{"read"},
+		GrantType:   "authorization_code",
+		Iat:         time.Now().Unix() - 600,
+		OriginalIat: time.Now().Unix() - 90000,
+	}, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_GrantRevoked() {
+	issuedAt := time.Now().Unix() - 600
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:       testRefreshTokenUserID,
+		Audiences: []string{testRefreshTokenAudience},
+		Scopes:    []string{"read"},
+		GrantType: "authorization_code",
+		Iat:       issuedAt,
+	}, nil)
+	suite.mockRevocation = grantrevocationmock.NewGrantRevocationServiceInterfaceMock(suite.T())
+	suite.mockRevocation.On("IsGrantRevoked", mock.Anything, testRefreshTokenUserID, time.Unix(issuedAt, 0)).
+		Return(true, nil)
+	suite.handler.revocationService = suite.mockRevocation
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+	assert.Equal(suite.T(), "Invalid refresh token", err.ErrorDescription)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_GrantRevocationCheckError() {
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:       testRefreshTokenUserID,
+		Audiences: []string{testRefreshTokenAudience},
+		Scopes:    []string{"read"},
+		GrantType: "authorization_code",
+		Iat:       time.Now().Unix() - 600,
+	}, nil)
+	suite.mockRevocation = grantrevocationmock.NewGrantRevocationServiceInterfaceMock(suite.T())
+	suite.mockRevocation.On("IsGrantRevoked", mock.Anything, testRefreshTokenUserID, mock.Anything).
+		Return(false, &serviceerror.InternalServerError)
+	suite.handler.revocationService = suite.mockRevocation
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_GetAttributeCacheError() {
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: testCacheID,
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: testCacheID,
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	cacheErr := &serviceerror.ServiceError{
 		Type: serviceerror.ServerErrorType,
@@ -415,15 +558,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_GetAttributeCach
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_BuildAccessTokenError() {
 	// Mock successful refresh token validation
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	// Mock failed access token generation
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).
@@ -442,15 +585,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IssueRefreshToke
 	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
 
 	// Mock successful refresh token validation
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	// Mock successful access token generation
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
@@ -477,8 +620,8 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ExtractIatClaimE
 	// RenewOnGrant is disabled by default in SetupTest
 
 	// Mock validator to return error when iat is missing (validation fails)
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(nil, errors.New("missing or invalid 'iat' claim"))
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(nil, errors.New("missing or invalid 'iat' claim"))
 
 	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
 
@@ -536,15 +679,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestValidateAndApplyScopes_NoMat
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IDTokenGenerated_WhenOpenIDScopePresent() {
 	// Mock successful refresh token validation with openid scope
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"openid", "read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"openid", "read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	// Mock successful access token generation
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
@@ -589,15 +732,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IDTokenGenerated
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_NoIDToken_WhenOpenIDScopeAbsent() {
 	// Mock successful refresh token validation without openid scope
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	// Mock successful access token generation
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(
@@ -623,15 +766,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_NoIDToken_WhenOp
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IDTokenGenerationError() {
 	// Mock successful refresh token validation with openid scope
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"openid", "read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"openid", "read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	// Mock successful access token generation
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
@@ -666,15 +809,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_NoRenewOnGrant_R
 	// Access token ExpiresIn is 0 (< 82800), so the cache is extended to the refresh
 	// token's remaining lifetime (~82800 s).
 
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: testCacheID,
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: testCacheID,
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	suite.mockAttrCacheService.On("GetAttributeCache", mock.Anything, testCacheID).
 		Return(&attributecache.AttributeCache{ID: testCacheID, Attributes: map[string]interface{}{}},
@@ -703,15 +846,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ExtendsCache_Whe
 	// Access token ExpiresIn = 7200 > 3400, so the cache must be extended to 7200 s.
 
 	now := time.Now().Unix()
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: testCacheID,
-			Iat:              now - 83000,
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: testCacheID,
+		Iat:              now - 83000,
+	}, nil)
 
 	suite.mockAttrCacheService.On("GetAttributeCache", mock.Anything, testCacheID).
 		Return(&attributecache.AttributeCache{ID: testCacheID, Attributes: map[string]interface{}{}},
@@ -741,15 +884,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_NoRenewOnGrant_E
 	// ExtendAttributeCacheTTL fails → handler returns a server error.
 
 	now := time.Now().Unix()
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: testCacheID,
-			Iat:              now - 83000,
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: testCacheID,
+		Iat:              now - 83000,
+	}, nil)
 
 	suite.mockAttrCacheService.On("GetAttributeCache", mock.Anything, testCacheID).
 		Return(&attributecache.AttributeCache{ID: testCacheID, Attributes: map[string]interface{}{}},
@@ -784,15 +927,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_NoRenewOnGrant_E
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_ExtendsAttributeCacheTTL() {
 	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
 
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: testCacheID,
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: testCacheID,
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	suite.mockAttrCacheService.On("GetAttributeCache", mock.Anything, testCacheID).
 		Return(&attributecache.AttributeCache{ID: testCacheID, Attributes: map[string]interface{}{}},
@@ -829,15 +972,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_Ext
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_ExtendAttributeCacheTTLError() {
 	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
 
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: testCacheID,
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: testCacheID,
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	suite.mockAttrCacheService.On("GetAttributeCache", mock.Anything, testCacheID).
 		Return(&attributecache.AttributeCache{ID: testCacheID, Attributes: map[string]interface{}{}},
@@ -882,15 +1025,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_SkipsCacheExtend
 	// (max of refresh remaining ≈ 82800 and access ExpiresIn 3600, plus buffer 60 = ≈ 82860), so
 	// ExtendAttributeCacheTTL must not be called.
 
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: testCacheID,
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: testCacheID,
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	suite.mockAttrCacheService.On("GetAttributeCache", mock.Anything, testCacheID).
 		Return(&attributecache.AttributeCache{
@@ -1019,15 +1162,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IDTokenWithRenew
 	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
 
 	// Mock successful refresh token validation with openid scope
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRefreshTokenAudience},
-			Scopes:           []string{"openid", "read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRefreshTokenAudience},
+		Scopes:           []string{"openid", "read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	// Mock successful access token generation
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
@@ -1096,15 +1239,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestValidateGrant_MalformedResou
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowing_KnownResource_NarrowsAud() {
 	// Original aud=[rs01, rs02]; request resource=[rs01] → issued aud=[rs01].
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRS01URI, testRS02URI},
-			Scopes:           []string{"read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRS01URI, testRS02URI},
+		Scopes:           []string{"read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(
 		func(ctx *tokenservice.AccessTokenBuildContext) bool {
@@ -1133,15 +1276,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowin
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowing_UnknownResource_InvalidTarget() {
 	// Original aud=[rs01, rs02]; request resource=[rs99] (unknown) → empty intersection → invalid_target.
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRS01URI, testRS02URI},
-			Scopes:           []string{"read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRS01URI, testRS02URI},
+		Scopes:           []string{"read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	tokenReq := &model.TokenRequest{
 		GrantType:    string(constants.GrantTypeRefreshToken),
@@ -1161,15 +1304,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowin
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowing_MixedResources_DropsUnknown() {
 	// Original aud=[rs01, rs02]; request resource=[rs99, rs01] → issued aud=[rs01] (rs99 dropped).
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRS01URI, testRS02URI},
-			Scopes:           []string{"read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRS01URI, testRS02URI},
+		Scopes:           []string{"read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(
 		func(ctx *tokenservice.AccessTokenBuildContext) bool {
@@ -1197,15 +1340,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowin
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_NoResourceParam_AudUnchanged() {
 	// No resource param → issued aud equals original aud (regression guard).
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRS01URI, testRS02URI},
-			Scopes:           []string{"read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRS01URI, testRS02URI},
+		Scopes:           []string{"read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(
 		func(ctx *tokenservice.AccessTokenBuildContext) bool {
@@ -1237,15 +1380,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_Ori
 	// carry the ORIGINAL (un-narrowed) audiences so future refreshes can recover dropped resources.
 	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
 
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRS01URI, testRS02URI},
-			Scopes:           []string{"read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRS01URI, testRS02URI},
+		Scopes:           []string{"read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(
 		func(ctx *tokenservice.AccessTokenBuildContext) bool {
@@ -1287,15 +1430,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_Ori
 
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowing_EmptyIntersection_InvalidTarget() {
 	// All requested resources are outside the original grant → invalid_target (Issue 2).
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRS01URI},
-			Scopes:           []string{"read"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRS01URI},
+		Scopes:           []string{"read"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	tokenReq := &model.TokenRequest{
 		GrantType:    string(constants.GrantTypeRefreshToken),
@@ -1322,15 +1465,15 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowin
 	suite.mockResourceService.On("ValidatePermissions", mock.Anything, testRS01URI, mock.Anything).
 		Return([]string{"write"}, nil)
 
-	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
-		Return(&tokenservice.RefreshTokenClaims{
-			Sub:              testRefreshTokenUserID,
-			Audiences:        []string{testRS01URI, testRS02URI},
-			Scopes:           []string{"read", "write"},
-			GrantType:        "authorization_code",
-			AttributeCacheID: "",
-			Iat:              int64(suite.validClaims["iat"].(float64)),
-		}, nil)
+	suite.mockTokenValidator.On("ValidateRefreshToken", mock.Anything, suite.validRefreshToken,
+		testRefreshTokenClientID).Return(&tokenservice.RefreshTokenClaims{
+		Sub:              testRefreshTokenUserID,
+		Audiences:        []string{testRS01URI, testRS02URI},
+		Scopes:           []string{"read", "write"},
+		GrantType:        "authorization_code",
+		AttributeCacheID: "",
+		Iat:              int64(suite.validClaims["iat"].(float64)),
+	}, nil)
 
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(
 		func(ctx *tokenservice.AccessTokenBuildContext) bool {
@@ -24,6 +24,7 @@ import (
 	"errors"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -70,6 +71,14 @@ func (s *tokenIntrospectionService) IntrospectToken(
 		}, nil
 	}
 
+	// Tokens of an isolated custom domain are only introspected on that domain.
+	if iss, _ := payload[constants.ClaimIss].(string); !config.IsIssuerAccepted(ctx, iss) {
+		logger.Debug("Token issuer is not accepted for the request", log.String("issuer", iss))
+		return &IntrospectResponse{
+			Active: false,
+		}, nil
+	}
+
 	// TODO: Add validations for token revocation and validity to be used by the resource server
 	//  who makes the introspection call when the support is implemented.
 
@@ -136,6 +145,10 @@ func (s *tokenIntrospectionService) prepareValidResponse(payload map[string]inte
 	if jti, ok := payload["jti"].(string); ok {
 		response.Jti = jti
 	}
+	if authorizationDetails, ok := payload[constants.ClaimAuthorizationDetails].([]interface{}); ok &&
+		len(authorizationDetails) > 0 {
+		response.AuthorizationDetails = authorizationDetails
+	}
 
 	return response
 }
@@ -30,6 +30,8 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
@@ -55,6 +57,15 @@ func TestTokenIntrospectionServiceTestSuite(t *testing.T) {
 }
 
 func (s *TokenIntrospectionServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{Issuer: "https://example.com"},
+		CustomDomains: []config.CustomDomainConfig{
+			{Host: "id.tenant.io", Issuer: "https://id.tenant.io", OUID: "tenant-ou"},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
 	s.jwtServiceMock = jwtmock.NewJWTServiceInterfaceMock(s.T())
 
 	// Create a private key for signing JWT tokens
@@ -158,6 +169,16 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_DecodeFailsAfte
 	s.jwtServiceMock.AssertExpectations(s.T())
 }
 
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_IssuerNotAcceptedForDomain() {
+	s.jwtServiceMock.On("VerifyJWT", s.validToken, "", "").Return(nil)
+
+	ctx := sysContext.WithCustomDomain(context.Background(), "id.tenant.io")
+	response, err := s.introspectService.IntrospectToken(ctx, s.validToken, "")
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), response)
+	assert.False(s.T(), response.Active)
+}
+
 func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken() {
 	testCases := []struct {
 		name           string
@@ -211,6 +232,27 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken() {
 				"Aud": []string{"api.example.com", "api2.example.com"},
 			},
 		},
+		{
+			name: "ValidTokenWithAuthorizationDetails",
+			tokenFn: func(s *TokenIntrospectionServiceTestSuite) string {
+				claims := map[string]interface{}{
+					"exp": float64(time.Now().Add(time.Hour).Unix()),
+					"nbf": float64(time.Now().Add(-time.Minute).Unix()),
+					"iat": float64(time.Now().Unix()),
+					"authorization_details": []interface{}{
+						map[string]interface{}{"type": "payment_initiation", "actions": []interface{}{"initiate"}},
+					},
+				}
+				return s.createToken(claims)
+			},
+			expectError: false,
+			active:      true,
+			expectedFields: map[string]interface{}{
+				"AuthorizationDetails": []interface{}{
+					map[string]interface{}{"type": "payment_initiation", "actions": []interface{}{"initiate"}},
+				},
+			},
+		},
 		{
 			name: "TokenWithMissingExpClaim",
 			tokenFn: func(s *TokenIntrospectionServiceTestSuite) string {
@@ -241,14 +283,15 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken() {
 			expectError: false,
 			active:      true,
 			expectedFields: map[string]interface{}{
-				"TokenType": constants.TokenTypeBearer,
-				"Scope":     "",
-				"ClientID":  "",
-				"Username":  "",
-				"Sub":       "",
-				"Aud":       nil,
-				"Iss":       "",
-				"Jti":       "",
+				"TokenType":            constants.TokenTypeBearer,
+				"Scope":                "",
+				"ClientID":             "",
+				"Username":             "",
+				"Sub":                  "",
+				"Aud":                  nil,
+				"Iss":                  "",
+				"Jti":                  "",
+				"AuthorizationDetails": nil,
 			},
 		},
 	}
@@ -367,6 +410,8 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken() {
 							assert.Equal(s.T(), value, response.Iss)
 						case "Jti":
 							assert.Equal(s.T(), value, response.Jti)
+						case "AuthorizationDetails":
+							assert.Equal(s.T(), value, response.AuthorizationDetails)
 						}
 					}
 				}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+const (
+	// frontchannelLogoutAudience is the audience of the state the gate logout page is opened with.
+	frontchannelLogoutAudience = "frontchannel_logout"
+	// frontchannelLogoutStateValidity is the validity period of the front-channel logout state in seconds.
+	frontchannelLogoutStateValidity = 300
+	// claimFrontchannelLogoutURIs is the state claim that carries the front-channel logout URIs.
+	claimFrontchannelLogoutURIs = "frontchannel_logout_uris"
+	// claimRedirectURI is the state claim that carries the URI to redirect the user agent to after logout.
+	claimRedirectURI = "redirect_uri"
+	// logoutConfirmationAudience is the audience of the state the user confirms the logout with.
+	logoutConfirmationAudience = "logout_confirmation"
+	// logoutConfirmationValidity is the validity period of the logout confirmation state in seconds.
+	logoutConfirmationValidity = 300
+	// claimSessionHash is the state claim that carries the hash of the SSO session the logout is confirmed for.
+	claimSessionHash = "session_hash"
+	// requestParamLogoutConfirmation is the parameter that carries the logout confirmation state to the gate
+	// logout page and back to the end session endpoint.
+	requestParamLogoutConfirmation = "logout_confirmation"
+)
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"context"
+	"net/http"
+
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// logoutHandler handles RP-initiated logout requests.
+type logoutHandler struct {
+	logoutService LogoutServiceInterface
+	logger        *log.Logger
+}
+
+// newLogoutHandler creates a new logout handler instance.
+func newLogoutHandler(logoutService LogoutServiceInterface) *logoutHandler {
+	return &logoutHandler{
+		logoutService: logoutService,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LogoutHandler")),
+	}
+}
+
+// HandleLogoutRequest handles the GET and POST /oauth2/logout requests. The parameters are read from the query
+// of a GET request and from the form-encoded body of a POST request.
+func (h *logoutHandler) HandleLogoutRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := r.ParseForm(); err != nil {
+		h.redirectToErrorPage(w, r, oauth2const.ErrorInvalidRequest, "Invalid logout request")
+		return
+	}
+
+	request := &LogoutRequest{
+		IDTokenHint:           r.Form.Get(oauth2const.RequestParamIDTokenHint),
+		ClientID:              r.Form.Get(oauth2const.RequestParamClientID),
+		PostLogoutRedirectURI: r.Form.Get(oauth2const.RequestParamPostLogoutRedirectURI),
+		State:                 r.Form.Get(oauth2const.RequestParamState),
+		SessionRef:            ssosession.GetSessionID(r),
+		Confirmation:          r.Form.Get(requestParamLogoutConfirmation),
+	}
+
+	result, errCode, errDesc := h.logoutService.HandleLogoutRequest(ctx, request)
+	if errCode != "" {
+		h.redirectToErrorPage(w, r, errCode, errDesc)
+		return
+	}
+
+	if !result.ConfirmationRequired {
+		ssosession.ClearSessionCookie(w)
+	}
+	http.Redirect(w, r, result.RedirectURI, http.StatusFound)
+}
+
+// HandleFrontchannelLogoutRequest handles the GET /oauth2/logout/frontchannel requests of the gate logout page.
+// Responds with the front-channel logout URIs to render and the URI to redirect the user agent to afterwards.
+func (h *logoutHandler) HandleFrontchannelLogoutRequest(w http.ResponseWriter, r *http.Request) {
+	state := r.URL.Query().Get(oauth2const.RequestParamState)
+
+	result, errCode, errDesc := h.logoutService.ResolveFrontchannelLogout(r.Context(), state)
+	if errCode != "" {
+		sysutils.WriteJSONError(w, errCode, errDesc, http.StatusBadRequest, nil)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, result)
+}
+
+// redirectToErrorPage redirects the user agent to the error page with the given error code and message.
+func (h *logoutHandler) redirectToErrorPage(w http.ResponseWriter, r *http.Request, code, msg string) {
+	redirectURL, err := getErrorPageRedirectURL(r.Context(), code, msg)
+	if err != nil {
+		h.logger.Error("Failed to construct error page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
+
+// getErrorPageRedirectURL constructs the error page URL with the provided error code and message.
+func getErrorPageRedirectURL(ctx context.Context, code, msg string) (string, error) {
+	errorPageURL := config.GetGateClientURL(ctx, config.GetServerRuntime().Config.GateClient.ErrorPath)
+
+	return oauth2utils.GetURIWithQueryParams(errorPageURL, map[string]string{
+		"errorCode":    code,
+		"errorMessage": msg,
+	})
+}
@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type LogoutHandlerTestSuite struct {
+	suite.Suite
+}
+
+func TestLogoutHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(LogoutHandlerTestSuite))
+}
+
+func (s *LogoutHandlerTestSuite) SetupTest() {
+	testConfig := &config.Config{
+		GateClient: config.GateClientConfig{
+			Hostname:  "localhost",
+			Port:      8090,
+			Scheme:    "https",
+			ErrorPath: "/gate/error",
+		},
+	}
+	_ = config.InitializeServerRuntime("", testConfig)
+}
+
+func (s *LogoutHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_GetRedirectsAndClearsCookie() {
+	svc := NewLogoutServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleLogoutRequest(mock.Anything, mock.MatchedBy(func(r *LogoutRequest) bool {
+		return r.IDTokenHint == "token" && r.PostLogoutRedirectURI == testRedirectURI &&
+			r.State == "xyz" && r.SessionRef == testSessionID
+	})).Return(&LogoutResult{RedirectURI: testRedirectURI + "?state=xyz"}, "", "")
+	handler := newLogoutHandler(svc)
+
+	query := url.Values{
+		oauth2const.RequestParamIDTokenHint:           {"token"},
+		oauth2const.RequestParamPostLogoutRedirectURI: {testRedirectURI},
+		oauth2const.RequestParamState:                 {"xyz"},
+	}
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout?"+query.Encode(), nil)
+	req.AddCookie(&http.Cookie{Name: ssosession.SessionCookieName, Value: testSessionID})
+	rec := httptest.NewRecorder()
+	handler.HandleLogoutRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusFound, rec.Code)
+	assert.Equal(s.T(), testRedirectURI+"?state=xyz", rec.Header().Get("Location"))
+	cookies := rec.Result().Cookies()
+	s.Require().Len(cookies, 1)
+	assert.Equal(s.T(), ssosession.SessionCookieName, cookies[0].Name)
+	assert.Equal(s.T(), -1, cookies[0].MaxAge)
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_PostReadsForm() {
+	svc := NewLogoutServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleLogoutRequest(mock.Anything, mock.MatchedBy(func(r *LogoutRequest) bool {
+		return r.ClientID == testClientID
+	})).Return(&LogoutResult{RedirectURI: testLoginPage}, "", "")
+	handler := newLogoutHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout",
+		strings.NewReader("client_id="+testClientID))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handler.HandleLogoutRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusFound, rec.Code)
+	assert.Equal(s.T(), testLoginPage, rec.Header().Get("Location"))
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_ConfirmationKeepsCookie() {
+	svc := NewLogoutServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleLogoutRequest(mock.Anything, mock.Anything).Return(&LogoutResult{
+		RedirectURI:          testLogoutPage + "?logout_confirmation=confirmation-state",
+		ConfirmationRequired: true,
+	}, "", "")
+	handler := newLogoutHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout", nil)
+	req.AddCookie(&http.Cookie{Name: ssosession.SessionCookieName, Value: testSessionID})
+	rec := httptest.NewRecorder()
+	handler.HandleLogoutRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusFound, rec.Code)
+	assert.Equal(s.T(), testLogoutPage+"?logout_confirmation=confirmation-state", rec.Header().Get("Location"))
+	assert.Empty(s.T(), rec.Result().Cookies())
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_PostReadsConfirmation() {
+	svc := NewLogoutServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleLogoutRequest(mock.Anything, mock.MatchedBy(func(r *LogoutRequest) bool {
+		return r.Confirmation == "confirmation-state" && r.SessionRef == testSessionID
+	})).Return(&LogoutResult{RedirectURI: testRedirectURI}, "", "")
+	handler := newLogoutHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout",
+		strings.NewReader("logout_confirmation=confirmation-state"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(&http.Cookie{Name: ssosession.SessionCookieName, Value: testSessionID})
+	rec := httptest.NewRecorder()
+	handler.HandleLogoutRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusFound, rec.Code)
+	assert.Equal(s.T(), testRedirectURI, rec.Header().Get("Location"))
+	s.Require().Len(rec.Result().Cookies(), 1)
+	assert.Equal(s.T(), -1, rec.Result().Cookies()[0].MaxAge)
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleLogoutRequest_ErrorRedirectsToErrorPage() {
+	svc := NewLogoutServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleLogoutRequest(mock.Anything, mock.Anything).
+		Return(nil, oauth2const.ErrorInvalidRequest, "Invalid id_token_hint")
+	handler := newLogoutHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout?id_token_hint=bad", nil)
+	rec := httptest.NewRecorder()
+	handler.HandleLogoutRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusFound, rec.Code)
+	location, err := url.Parse(rec.Header().Get("Location"))
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "/gate/error", location.Path)
+	assert.Equal(s.T(), oauth2const.ErrorInvalidRequest, location.Query().Get("errorCode"))
+	assert.Empty(s.T(), rec.Result().Cookies())
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleFrontchannelLogoutRequest() {
+	svc := NewLogoutServiceInterfaceMock(s.T())
+	svc.EXPECT().ResolveFrontchannelLogout(mock.Anything, "logout-state").Return(&FrontchannelLogoutResult{
+		FrontchannelLogoutURIs: []string{"https://app.example.com/fc-logout"},
+		RedirectURI:            testRedirectURI,
+	}, "", "")
+	handler := newLogoutHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout/frontchannel?state=logout-state", nil)
+	rec := httptest.NewRecorder()
+	handler.HandleFrontchannelLogoutRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+	var body FrontchannelLogoutResult
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(s.T(), []string{"https://app.example.com/fc-logout"}, body.FrontchannelLogoutURIs)
+	assert.Equal(s.T(), testRedirectURI, body.RedirectURI)
+}
+
+func (s *LogoutHandlerTestSuite) TestHandleFrontchannelLogoutRequest_InvalidState() {
+	svc := NewLogoutServiceInterfaceMock(s.T())
+	svc.EXPECT().ResolveFrontchannelLogout(mock.Anything, "").
+		Return(nil, oauth2const.ErrorInvalidRequest, "The state parameter is required")
+	handler := newLogoutHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout/frontchannel", nil)
+	rec := httptest.NewRecorder()
+	handler.HandleFrontchannelLogoutRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+	assert.Contains(s.T(), rec.Body.String(), oauth2const.ErrorInvalidRequest)
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the RP-initiated logout service and registers the end session endpoint.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	tokenValidator tokenservice.TokenValidatorInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface,
+) LogoutServiceInterface {
+	logoutService := newLogoutService(jwtService, tokenValidator, inboundClient, ssoSessionService)
+	registerRoutes(mux, newLogoutHandler(logoutService))
+	return logoutService
+}
+
+// registerRoutes registers the routes of the end session endpoint. The endpoint is navigated to by the user
+// agent, so it is not exposed to cross-origin requests. The front-channel logout endpoint is fetched by the gate
+// logout page, so it allows cross-origin requests.
+func registerRoutes(mux *http.ServeMux, handler *logoutHandler) {
+	mux.HandleFunc("GET "+oauth2const.OAuth2LogoutEndpoint, handler.HandleLogoutRequest)
+	mux.HandleFunc("POST "+oauth2const.OAuth2LogoutEndpoint, handler.HandleLogoutRequest)
+
+	frontchannelOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+oauth2const.OAuth2FrontchannelLogoutEndpoint,
+		handler.HandleFrontchannelLogoutRequest, frontchannelOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+oauth2const.OAuth2FrontchannelLogoutEndpoint,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, frontchannelOpts))
+}
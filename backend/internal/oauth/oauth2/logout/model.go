@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+// LogoutRequest holds the parameters of an RP-initiated logout request.
+type LogoutRequest struct {
+	IDTokenHint           string
+	ClientID              string
+	PostLogoutRedirectURI string
+	State                 string
+	// SessionRef is the reference to the SSO session bound to the user agent, if any.
+	SessionRef string
+	// Confirmation is the state the user confirmed the logout with at the gate logout page, if any.
+	Confirmation string
+}
+
+// LogoutResult holds the outcome of a successful RP-initiated logout request.
+type LogoutResult struct {
+	// RedirectURI is the URI the user agent is redirected to once the session is terminated.
+	RedirectURI string
+	// ConfirmationRequired is set when the user agent is sent to the gate logout page to confirm the logout, in
+	// which case the session is left intact.
+	ConfirmationRequired bool
+}
+
+// FrontchannelLogoutResult holds the front-channel logout URIs the gate logout page renders and the URI it
+// redirects the user agent to afterwards.
+type FrontchannelLogoutResult struct {
+	FrontchannelLogoutURIs []string `json:"frontchannel_logout_uris"`
+	RedirectURI            string   `json:"redirect_uri"`
+}
@@ -0,0 +1,343 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package logout implements OpenID Connect RP-initiated logout, which lets a client end the user's session at
+// the server and have the user agent redirected back to the client.
+package logout
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// LogoutServiceInterface defines the interface for the RP-initiated logout service.
+type LogoutServiceInterface interface {
+	HandleLogoutRequest(ctx context.Context, request *LogoutRequest) (*LogoutResult, string, string)
+	ResolveFrontchannelLogout(ctx context.Context, state string) (*FrontchannelLogoutResult, string, string)
+}
+
+// logoutService implements LogoutServiceInterface.
+type logoutService struct {
+	jwtService        jwt.JWTServiceInterface
+	tokenValidator    tokenservice.TokenValidatorInterface
+	inboundClient     inboundclient.InboundClientServiceInterface
+	ssoSessionService ssosession.SSOSessionServiceInterface
+	logger            *log.Logger
+}
+
+// newLogoutService creates a new logout service instance.
+func newLogoutService(
+	jwtService jwt.JWTServiceInterface,
+	tokenValidator tokenservice.TokenValidatorInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	ssoSessionService ssosession.SSOSessionServiceInterface,
+) LogoutServiceInterface {
+	return &logoutService{
+		jwtService:        jwtService,
+		tokenValidator:    tokenValidator,
+		inboundClient:     inboundClient,
+		ssoSessionService: ssoSessionService,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LogoutService")),
+	}
+}
+
+// HandleLogoutRequest validates an RP-initiated logout request, terminates the SSO session of the user agent and
+// returns where to redirect the user agent. The post-logout redirect URI must exactly match one registered for
+// the client identified by the client_id or the ID token hint. Without one, the user agent is sent to the login
+// page. A request without a verified ID token hint may have been sent by any site, so the user agent is first
+// sent to the gate logout page for the user to confirm the logout. Returns the result on success, or
+// (errorCode, errorDescription) on failure.
+func (s *logoutService) HandleLogoutRequest(
+	ctx context.Context, request *LogoutRequest,
+) (*LogoutResult, string, string) {
+	if request.Confirmation != "" {
+		return s.handleLogoutConfirmation(ctx, request)
+	}
+
+	var hint *tokenservice.IDTokenHintClaims
+	if request.IDTokenHint != "" {
+		var err error
+		if hint, err = s.tokenValidator.ValidateIDTokenHint(ctx, request.IDTokenHint, request.ClientID); err != nil {
+			s.logger.Debug("Invalid ID token hint", log.Error(err))
+			return nil, oauth2const.ErrorInvalidRequest, getIDTokenHintErrorDescription(err)
+		}
+	}
+
+	clientID := request.ClientID
+	if hint != nil && clientID == "" {
+		clientID = getIDTokenHintClientID(hint)
+	}
+
+	var client *inboundmodel.OAuthClient
+	if clientID != "" {
+		var err error
+		client, err = s.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+		if err != nil {
+			s.logger.Error("Failed to retrieve the OAuth client", log.MaskedString("clientID", clientID),
+				log.Error(err))
+			return nil, oauth2const.ErrorServerError, "Failed to process the logout request"
+		}
+		if client == nil {
+			return nil, oauth2const.ErrorInvalidRequest, "Invalid client"
+		}
+	}
+
+	redirectURI := config.GetGateClientURL(ctx, config.GetServerRuntime().Config.GateClient.LoginPath)
+	if request.PostLogoutRedirectURI != "" {
+		if client == nil {
+			return nil, oauth2const.ErrorInvalidRequest,
+				"The client_id or id_token_hint parameter is required with post_logout_redirect_uri"
+		}
+		if !client.IsValidPostLogoutRedirectURI(request.PostLogoutRedirectURI) {
+			return nil, oauth2const.ErrorInvalidRequest,
+				"The post_logout_redirect_uri is not registered for the client"
+		}
+		redirectURI = request.PostLogoutRedirectURI
+		if request.State != "" {
+			var err error
+			redirectURI, err = oauth2utils.GetURIWithQueryParams(redirectURI,
+				map[string]string{oauth2const.RequestParamState: request.State})
+			if err != nil {
+				s.logger.Error("Failed to construct the post-logout redirect URI", log.Error(err))
+				return nil, oauth2const.ErrorServerError, "Failed to process the logout request"
+			}
+		}
+	}
+
+	if hint == nil && s.ssoSessionService.IsEnabled() && strings.TrimSpace(request.SessionRef) != "" {
+		confirmationPageURI := s.getLogoutPageURI(ctx, requestParamLogoutConfirmation, map[string]interface{}{
+			oauth2const.ClaimAud: logoutConfirmationAudience,
+			claimRedirectURI:     redirectURI,
+			claimSessionHash:     cryptolab.HashToken(request.SessionRef),
+		}, logoutConfirmationValidity)
+		if confirmationPageURI == "" {
+			return nil, oauth2const.ErrorServerError, "Failed to process the logout request"
+		}
+		return &LogoutResult{RedirectURI: confirmationPageURI, ConfirmationRequired: true}, "", ""
+	}
+
+	return s.completeLogout(ctx, request.SessionRef, hint, redirectURI), "", ""
+}
+
+// handleLogoutConfirmation terminates the SSO session the user confirmed the logout of at the gate logout page.
+// The confirmation is only accepted with the session it was issued for, so that it cannot be replayed from
+// another user agent. Returns the result on success, or (errorCode, errorDescription) on failure.
+func (s *logoutService) handleLogoutConfirmation(
+	ctx context.Context, request *LogoutRequest,
+) (*LogoutResult, string, string) {
+	if svcErr := s.jwtService.VerifyJWT(request.Confirmation, logoutConfirmationAudience,
+		config.GetIssuer(ctx)); svcErr != nil {
+		s.logger.Debug("Failed to verify the logout confirmation", log.String("code", svcErr.Code))
+		return nil, oauth2const.ErrorInvalidRequest, "Invalid or expired logout confirmation"
+	}
+	claims, err := jwt.DecodeJWTPayload(request.Confirmation)
+	if err != nil {
+		s.logger.Debug("Failed to decode the logout confirmation", log.Error(err))
+		return nil, oauth2const.ErrorInvalidRequest, "Invalid or expired logout confirmation"
+	}
+
+	redirectURI, _ := claims[claimRedirectURI].(string)
+	sessionHash, _ := claims[claimSessionHash].(string)
+	if redirectURI == "" || sessionHash == "" || strings.TrimSpace(request.SessionRef) == "" ||
+		!cryptolab.ValidateTokenHash(request.SessionRef, sessionHash) {
+		s.logger.Debug("The logout confirmation was not issued for the SSO session of the user agent")
+		return nil, oauth2const.ErrorInvalidRequest, "Invalid or expired logout confirmation"
+	}
+
+	return s.completeLogout(ctx, request.SessionRef, nil, redirectURI), "", ""
+}
+
+// completeLogout terminates the SSO session and returns the result that sends the user agent to the given URI,
+// by way of the gate logout page when clients of the session are notified through front-channel logout.
+func (s *logoutService) completeLogout(
+	ctx context.Context, sessionRef string, hint *tokenservice.IDTokenHintClaims, redirectURI string,
+) *LogoutResult {
+	sessionID, clientIDs := s.terminateSession(ctx, sessionRef, hint)
+
+	if frontchannelURIs := s.getFrontchannelLogoutURIs(ctx, sessionID, clientIDs); len(frontchannelURIs) > 0 {
+		logoutPageURI := s.getLogoutPageURI(ctx, oauth2const.RequestParamState, map[string]interface{}{
+			oauth2const.ClaimAud:        frontchannelLogoutAudience,
+			claimFrontchannelLogoutURIs: frontchannelURIs,
+			claimRedirectURI:            redirectURI,
+		}, frontchannelLogoutStateValidity)
+		if logoutPageURI != "" {
+			redirectURI = logoutPageURI
+		}
+	}
+
+	return &LogoutResult{RedirectURI: redirectURI}
+}
+
+// ResolveFrontchannelLogout verifies the state the gate logout page was opened with and returns the front-channel
+// logout URIs to render and the URI to redirect the user agent to afterwards. Returns the result on success, or
+// (errorCode, errorDescription) on failure.
+func (s *logoutService) ResolveFrontchannelLogout(
+	ctx context.Context, state string,
+) (*FrontchannelLogoutResult, string, string) {
+	if state == "" {
+		return nil, oauth2const.ErrorInvalidRequest, "The state parameter is required"
+	}
+	if svcErr := s.jwtService.VerifyJWT(state, frontchannelLogoutAudience, config.GetIssuer(ctx)); svcErr != nil {
+		s.logger.Debug("Failed to verify the front-channel logout state", log.String("code", svcErr.Code))
+		return nil, oauth2const.ErrorInvalidRequest, "Invalid or expired state"
+	}
+	claims, err := jwt.DecodeJWTPayload(state)
+	if err != nil {
+		s.logger.Debug("Failed to decode the front-channel logout state", log.Error(err))
+		return nil, oauth2const.ErrorInvalidRequest, "Invalid or expired state"
+	}
+
+	result := &FrontchannelLogoutResult{}
+	result.RedirectURI, _ = claims[claimRedirectURI].(string)
+	if values, ok := claims[claimFrontchannelLogoutURIs].([]interface{}); ok {
+		for _, value := range values {
+			if uri, ok := value.(string); ok {
+				result.FrontchannelLogoutURIs = append(result.FrontchannelLogoutURIs, uri)
+			}
+		}
+	}
+	if result.RedirectURI == "" || len(result.FrontchannelLogoutURIs) == 0 {
+		return nil, oauth2const.ErrorInvalidRequest, "Invalid or expired state"
+	}
+	return result, "", ""
+}
+
+// terminateSession deletes the SSO session bound to the user agent. A session of a different user than the
+// subject of the ID token hint is left intact. Without a session bound to the user agent, the session the ID
+// token hint was issued in is deleted. Returns the ID of the terminated session and the clients that took part
+// in it, or an empty session ID if no session was terminated.
+func (s *logoutService) terminateSession(
+	ctx context.Context, sessionRef string, hint *tokenservice.IDTokenHintClaims,
+) (string, []string) {
+	if !s.ssoSessionService.IsEnabled() {
+		return "", nil
+	}
+
+	sessionID := ""
+	if strings.TrimSpace(sessionRef) != "" {
+		session, svcErr := s.ssoSessionService.GetSession(ctx, sessionRef)
+		if svcErr == nil {
+			if hint != nil && hint.Sub != session.UserID {
+				s.logger.Debug("The SSO session does not belong to the subject of the ID token hint")
+				return "", nil
+			}
+			sessionID = session.ID
+		}
+	}
+	if sessionID == "" && hint != nil {
+		sessionID = hint.Sid
+	}
+	if sessionID == "" {
+		return "", nil
+	}
+
+	// The participating clients are removed along with the session, so they are read beforehand.
+	clientIDs, svcErr := s.ssoSessionService.GetSessionClients(ctx, sessionID)
+	if svcErr != nil {
+		s.logger.Error("Failed to retrieve the clients of the SSO session", log.String("code", svcErr.Code))
+		clientIDs = nil
+	}
+
+	if svcErr := s.ssoSessionService.DeleteSession(ctx, sessionID); svcErr != nil &&
+		svcErr.Code != ssosession.ErrorSessionNotFound.Code {
+		s.logger.Error("Failed to delete the SSO session", log.String("code", svcErr.Code))
+		return "", nil
+	}
+	s.logger.Debug("SSO session terminated by RP-initiated logout")
+	return sessionID, clientIDs
+}
+
+// getFrontchannelLogoutURIs returns the front-channel logout URIs of the clients that took part in the
+// terminated session, with the issuer and the session ID appended. Clients that cannot be retrieved or have no
+// front-channel logout URI are skipped.
+func (s *logoutService) getFrontchannelLogoutURIs(
+	ctx context.Context, sessionID string, clientIDs []string,
+) []string {
+	uris := make([]string, 0, len(clientIDs))
+	for _, clientID := range clientIDs {
+		client, err := s.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+		if err != nil || client == nil || client.FrontchannelLogoutURI == "" {
+			continue
+		}
+		uri, err := oauth2utils.GetURIWithQueryParams(client.FrontchannelLogoutURI, map[string]string{
+			oauth2const.ClaimIss: config.GetIssuer(ctx),
+			oauth2const.ClaimSid: sessionID,
+		})
+		if err != nil {
+			s.logger.Error("Failed to construct the front-channel logout URI",
+				log.MaskedString("clientID", clientID), log.Error(err))
+			continue
+		}
+		uris = append(uris, uri)
+	}
+	return uris
+}
+
+// getLogoutPageURI returns the gate logout page URI with a short-lived signed state carrying the given claims in
+// the given query parameter. Returns an empty string if the state cannot be generated.
+func (s *logoutService) getLogoutPageURI(
+	ctx context.Context, queryParam string, claims map[string]interface{}, validity int64,
+) string {
+	state, _, svcErr := s.jwtService.GenerateJWT(ctx, "", config.GetIssuer(ctx), validity, claims,
+		jwt.TokenTypeJWT, "")
+	if svcErr != nil {
+		s.logger.Error("Failed to generate the logout page state", log.String("code", svcErr.Code))
+		return ""
+	}
+
+	logoutPageURI, err := oauth2utils.GetURIWithQueryParams(
+		config.GetGateClientURL(ctx, config.GetServerRuntime().Config.GateClient.LogoutPath),
+		map[string]string{queryParam: state})
+	if err != nil {
+		s.logger.Error("Failed to construct the logout page URI", log.Error(err))
+		return ""
+	}
+	return logoutPageURI
+}
+
+// getIDTokenHintClientID returns the client the ID token hint was issued to.
+func getIDTokenHintClientID(hint *tokenservice.IDTokenHintClaims) string {
+	if len(hint.Audiences) == 1 {
+		return hint.Audiences[0]
+	}
+	return hint.Azp
+}
+
+// getIDTokenHintErrorDescription returns the error description of an ID token hint that failed validation.
+func getIDTokenHintErrorDescription(err error) string {
+	switch {
+	case errors.Is(err, tokenservice.ErrIDTokenHintIssuerMismatch):
+		return "The id_token_hint was not issued by this server"
+	case errors.Is(err, tokenservice.ErrIDTokenHintAudienceMismatch):
+		return "The ID token hint was not issued to the client"
+	default:
+		return "Invalid id_token_hint"
+	}
+}
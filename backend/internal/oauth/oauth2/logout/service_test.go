@@ -0,0 +1,412 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/ssosession"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/ssosessionmock"
+)
+
+const (
+	testClientID    = "test-client"
+	testIssuer      = "https://localhost:8090"
+	testUserID      = "user-1"
+	testSessionID   = "session-1"
+	testRedirectURI = "https://app.example.com/logged-out"
+	testLoginPage   = "https://localhost:8090/gate/signin"
+	testLogoutPage  = "https://localhost:8090/gate/logout"
+	testIDTokenHint = "test.id-token.hint"
+)
+
+type LogoutServiceTestSuite struct {
+	suite.Suite
+	jwtMock            *jwtmock.JWTServiceInterfaceMock
+	tokenValidatorMock *tokenservicemock.TokenValidatorInterfaceMock
+	inboundMock        *inboundclientmock.InboundClientServiceInterfaceMock
+	ssoMock            *ssosessionmock.SSOSessionServiceInterfaceMock
+	service            LogoutServiceInterface
+	ctx                context.Context
+	client             *inboundmodel.OAuthClient
+	idTokenHint        *tokenservice.IDTokenHintClaims
+}
+
+func TestLogoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LogoutServiceTestSuite))
+}
+
+func (s *LogoutServiceTestSuite) SetupTest() {
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{Issuer: testIssuer},
+		GateClient: config.GateClientConfig{
+			Hostname:   "localhost",
+			Port:       8090,
+			Scheme:     "https",
+			LoginPath:  "/gate/signin",
+			ErrorPath:  "/gate/error",
+			LogoutPath: "/gate/logout",
+		},
+	}
+	_ = config.InitializeServerRuntime("", testConfig)
+
+	s.jwtMock = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.tokenValidatorMock = tokenservicemock.NewTokenValidatorInterfaceMock(s.T())
+	s.inboundMock = inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	s.ssoMock = ssosessionmock.NewSSOSessionServiceInterfaceMock(s.T())
+	s.service = newLogoutService(s.jwtMock, s.tokenValidatorMock, s.inboundMock, s.ssoMock)
+	s.ctx = context.Background()
+	s.client = &inboundmodel.OAuthClient{
+		ClientID:               testClientID,
+		PostLogoutRedirectURIs: []string{testRedirectURI},
+	}
+	s.idTokenHint = &tokenservice.IDTokenHintClaims{
+		Sub:       testUserID,
+		Audiences: []string{testClientID},
+		Sid:       testSessionID,
+	}
+}
+
+func (s *LogoutServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// buildIDToken builds an unsigned JWT with the given claims. The signature is verified by the JWT service mock.
+func buildIDToken(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + ".c2lnbmF0dXJl"
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_WithIDTokenHintAndRedirectURI() {
+	s.tokenValidatorMock.EXPECT().ValidateIDTokenHint(mock.Anything, testIDTokenHint, "").Return(s.idTokenHint, nil)
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(s.client, nil)
+	s.ssoMock.EXPECT().IsEnabled().Return(true)
+	s.ssoMock.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(&ssosession.SSOSession{ID: testSessionID, UserID: testUserID}, nil)
+	s.ssoMock.EXPECT().GetSessionClients(mock.Anything, testSessionID).Return([]string{}, nil)
+	s.ssoMock.EXPECT().DeleteSession(mock.Anything, testSessionID).Return(nil)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		IDTokenHint:           testIDTokenHint,
+		PostLogoutRedirectURI: testRedirectURI,
+		State:                 "xyz",
+		SessionRef:            testSessionID,
+	})
+
+	s.Empty(errCode)
+	s.Equal(testRedirectURI+"?state=xyz", result.RedirectURI)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_WithoutSessionRedirectsToLoginPage() {
+	s.ssoMock.EXPECT().IsEnabled().Return(true)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{})
+
+	s.Empty(errCode)
+	s.Equal(testLoginPage, result.RedirectURI)
+	s.False(result.ConfirmationRequired)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_WithoutIDTokenHintRequiresConfirmation() {
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(s.client, nil)
+	s.ssoMock.EXPECT().IsEnabled().Return(true)
+	s.jwtMock.EXPECT().GenerateJWT(mock.Anything, "", testIssuer, int64(logoutConfirmationValidity),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[oauth2const.ClaimAud] == logoutConfirmationAudience &&
+				claims[claimRedirectURI] == testRedirectURI+"?state=xyz" &&
+				claims[claimSessionHash] == cryptolab.HashToken(testSessionID)
+		}), mock.Anything, "").Return("confirmation-state", int64(0), nil)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		ClientID:              testClientID,
+		PostLogoutRedirectURI: testRedirectURI,
+		State:                 "xyz",
+		SessionRef:            testSessionID,
+	})
+
+	s.Empty(errCode)
+	s.True(result.ConfirmationRequired)
+	s.Equal(testLogoutPage+"?logout_confirmation=confirmation-state", result.RedirectURI)
+	s.ssoMock.AssertNotCalled(s.T(), "DeleteSession", mock.Anything, mock.Anything)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_ConfirmationStateFailure() {
+	s.ssoMock.EXPECT().IsEnabled().Return(true)
+	s.jwtMock.EXPECT().GenerateJWT(mock.Anything, "", testIssuer, int64(logoutConfirmationValidity),
+		mock.Anything, mock.Anything, "").Return("", int64(0), &serviceerror.InternalServerError)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{SessionRef: testSessionID})
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorServerError, errCode)
+	s.ssoMock.AssertNotCalled(s.T(), "DeleteSession", mock.Anything, mock.Anything)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_ConfirmedLogout() {
+	confirmation := buildIDToken(map[string]interface{}{
+		"iss":            testIssuer,
+		"aud":            logoutConfirmationAudience,
+		claimRedirectURI: testRedirectURI,
+		claimSessionHash: cryptolab.HashToken(testSessionID),
+	})
+	s.jwtMock.EXPECT().VerifyJWT(confirmation, logoutConfirmationAudience, testIssuer).Return(nil)
+	s.ssoMock.EXPECT().IsEnabled().Return(true)
+	s.ssoMock.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(&ssosession.SSOSession{ID: testSessionID, UserID: testUserID}, nil)
+	s.ssoMock.EXPECT().GetSessionClients(mock.Anything, testSessionID).Return([]string{}, nil)
+	s.ssoMock.EXPECT().DeleteSession(mock.Anything, testSessionID).Return(nil)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		Confirmation: confirmation,
+		SessionRef:   testSessionID,
+	})
+
+	s.Empty(errCode)
+	s.False(result.ConfirmationRequired)
+	s.Equal(testRedirectURI, result.RedirectURI)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_ConfirmationForAnotherSession() {
+	confirmation := buildIDToken(map[string]interface{}{
+		"iss":            testIssuer,
+		"aud":            logoutConfirmationAudience,
+		claimRedirectURI: testRedirectURI,
+		claimSessionHash: cryptolab.HashToken("other-session"),
+	})
+	s.jwtMock.EXPECT().VerifyJWT(confirmation, logoutConfirmationAudience, testIssuer).Return(nil)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		Confirmation: confirmation,
+		SessionRef:   testSessionID,
+	})
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.ssoMock.AssertNotCalled(s.T(), "DeleteSession", mock.Anything, mock.Anything)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_InvalidConfirmation() {
+	s.jwtMock.EXPECT().VerifyJWT("bad-confirmation", logoutConfirmationAudience, testIssuer).
+		Return(&serviceerror.InternalServerError)
+
+	result, errCode, errDesc := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		Confirmation: "bad-confirmation",
+		SessionRef:   testSessionID,
+	})
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("Invalid or expired logout confirmation", errDesc)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_DeletesSessionOfHintWithoutCookie() {
+	s.tokenValidatorMock.EXPECT().ValidateIDTokenHint(mock.Anything, testIDTokenHint, "").Return(s.idTokenHint, nil)
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(s.client, nil)
+	s.ssoMock.EXPECT().IsEnabled().Return(true)
+	s.ssoMock.EXPECT().GetSessionClients(mock.Anything, testSessionID).Return([]string{testClientID}, nil)
+	s.ssoMock.EXPECT().DeleteSession(mock.Anything, testSessionID).Return(&ssosession.ErrorSessionNotFound)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{IDTokenHint: testIDTokenHint})
+
+	s.Empty(errCode)
+	s.Equal(testLoginPage, result.RedirectURI)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_KeepsSessionOfAnotherUser() {
+	s.tokenValidatorMock.EXPECT().ValidateIDTokenHint(mock.Anything, testIDTokenHint, "").Return(s.idTokenHint, nil)
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(s.client, nil)
+	s.ssoMock.EXPECT().IsEnabled().Return(true)
+	s.ssoMock.EXPECT().GetSession(mock.Anything, "other-session").
+		Return(&ssosession.SSOSession{ID: "other-session", UserID: "user-2"}, nil)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		IDTokenHint: testIDTokenHint,
+		SessionRef:  "other-session",
+	})
+
+	s.Empty(errCode)
+	s.NotNil(result)
+	s.ssoMock.AssertNotCalled(s.T(), "DeleteSession", mock.Anything, mock.Anything)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_InvalidIDTokenHint() {
+	s.tokenValidatorMock.EXPECT().ValidateIDTokenHint(mock.Anything, testIDTokenHint, "").
+		Return(nil, tokenservice.ErrInvalidIDTokenHint)
+
+	result, errCode, errDesc := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{IDTokenHint: testIDTokenHint})
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("Invalid id_token_hint", errDesc)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_IDTokenHintFromAnotherIssuer() {
+	s.tokenValidatorMock.EXPECT().ValidateIDTokenHint(mock.Anything, testIDTokenHint, "").
+		Return(nil, tokenservice.ErrIDTokenHintIssuerMismatch)
+
+	result, errCode, errDesc := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{IDTokenHint: testIDTokenHint})
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("The id_token_hint was not issued by this server", errDesc)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_ClientIDNotInAudience() {
+	s.tokenValidatorMock.EXPECT().ValidateIDTokenHint(mock.Anything, testIDTokenHint, "other-client").
+		Return(nil, tokenservice.ErrIDTokenHintAudienceMismatch)
+
+	result, errCode, errDesc := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		IDTokenHint: testIDTokenHint,
+		ClientID:    "other-client",
+	})
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+	s.Equal("The ID token hint was not issued to the client", errDesc)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_UnregisteredRedirectURI() {
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(s.client, nil)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		ClientID:              testClientID,
+		PostLogoutRedirectURI: "https://evil.example.com/",
+	})
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_RedirectURIWithoutClient() {
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		PostLogoutRedirectURI: testRedirectURI,
+	})
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_UnknownClient() {
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, "unknown").Return(nil, nil)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{ClientID: "unknown"})
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_SSODisabled() {
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(s.client, nil)
+	s.ssoMock.EXPECT().IsEnabled().Return(false)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		ClientID:              testClientID,
+		PostLogoutRedirectURI: testRedirectURI,
+		SessionRef:            testSessionID,
+	})
+
+	s.Empty(errCode)
+	assert.Equal(s.T(), testRedirectURI, result.RedirectURI)
+}
+
+func (s *LogoutServiceTestSuite) TestHandleLogoutRequest_RedirectsToLogoutPageForFrontchannelClients() {
+	s.client.FrontchannelLogoutURI = "https://app.example.com/fc-logout"
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(s.client, nil)
+	s.inboundMock.EXPECT().GetOAuthClientByClientID(mock.Anything, "other-client").
+		Return(&inboundmodel.OAuthClient{ClientID: "other-client"}, nil)
+	s.ssoMock.EXPECT().IsEnabled().Return(true)
+	s.ssoMock.EXPECT().GetSession(mock.Anything, testSessionID).
+		Return(&ssosession.SSOSession{ID: testSessionID, UserID: testUserID}, nil)
+	s.ssoMock.EXPECT().GetSessionClients(mock.Anything, testSessionID).
+		Return([]string{testClientID, "other-client"}, nil)
+	s.ssoMock.EXPECT().DeleteSession(mock.Anything, testSessionID).Return(nil)
+	confirmation := buildIDToken(map[string]interface{}{
+		"iss":            testIssuer,
+		"aud":            logoutConfirmationAudience,
+		claimRedirectURI: testRedirectURI,
+		claimSessionHash: cryptolab.HashToken(testSessionID),
+	})
+	s.jwtMock.EXPECT().VerifyJWT(confirmation, logoutConfirmationAudience, testIssuer).Return(nil)
+	s.jwtMock.EXPECT().GenerateJWT(mock.Anything, "", testIssuer, int64(frontchannelLogoutStateValidity),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			uris, _ := claims[claimFrontchannelLogoutURIs].([]string)
+			return claims[oauth2const.ClaimAud] == frontchannelLogoutAudience &&
+				claims[claimRedirectURI] == testRedirectURI && len(uris) == 1 &&
+				uris[0] == "https://app.example.com/fc-logout?iss=https%3A%2F%2Flocalhost%3A8090&sid=session-1"
+		}), mock.Anything, "").Return("logout-state", int64(0), nil)
+
+	result, errCode, _ := s.service.HandleLogoutRequest(s.ctx, &LogoutRequest{
+		Confirmation: confirmation,
+		SessionRef:   testSessionID,
+	})
+
+	s.Empty(errCode)
+	s.Equal(testLogoutPage+"?state=logout-state", result.RedirectURI)
+}
+
+func (s *LogoutServiceTestSuite) TestResolveFrontchannelLogout() {
+	state := buildIDToken(map[string]interface{}{
+		"iss":                       testIssuer,
+		"aud":                       frontchannelLogoutAudience,
+		claimFrontchannelLogoutURIs: []string{"https://app.example.com/fc-logout"},
+		claimRedirectURI:            testRedirectURI,
+	})
+	s.jwtMock.EXPECT().VerifyJWT(state, frontchannelLogoutAudience, testIssuer).Return(nil)
+
+	result, errCode, _ := s.service.ResolveFrontchannelLogout(s.ctx, state)
+
+	s.Empty(errCode)
+	s.Equal([]string{"https://app.example.com/fc-logout"}, result.FrontchannelLogoutURIs)
+	s.Equal(testRedirectURI, result.RedirectURI)
+}
+
+func (s *LogoutServiceTestSuite) TestResolveFrontchannelLogout_InvalidState() {
+	s.jwtMock.EXPECT().VerifyJWT("bad-state", frontchannelLogoutAudience, testIssuer).
+		Return(&serviceerror.InternalServerError)
+
+	result, errCode, _ := s.service.ResolveFrontchannelLogout(s.ctx, "bad-state")
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
+
+func (s *LogoutServiceTestSuite) TestResolveFrontchannelLogout_MissingState() {
+	result, errCode, _ := s.service.ResolveFrontchannelLogout(s.ctx, "")
+
+	s.Nil(result)
+	s.Equal(oauth2const.ErrorInvalidRequest, errCode)
+}
@@ -26,6 +26,7 @@ type OAuthParameters struct {
 	ClientID            string
 	RedirectURI         string
 	ResponseType        string
+	ResponseMode        string
 	StandardScopes      []string
 	PermissionScopes    []string
 	CodeChallenge       string
@@ -35,6 +36,24 @@ type OAuthParameters struct {
 	ClaimsLocales       string
 	Nonce               string
 	AcrValues           string
+	Prompt              string
+	Organization        string
+	// MaxAge is the allowable elapsed time in seconds since the last active authentication, if one was sent.
+	MaxAge *int64
+	// IDTokenHintSubject is the subject of the verified id_token_hint, if one was sent.
+	IDTokenHintSubject string
+	// AuthorizationDetails holds the parsed authorization_details parameter (RFC 9396).
+	AuthorizationDetails []AuthorizationDetail
+}
+
+// AuthorizationDetail represents a single entry of the authorization_details parameter (RFC 9396).
+// Apart from the mandatory type field, the structure of an entry is defined by its type.
+type AuthorizationDetail map[string]interface{}
+
+// GetType returns the type of the authorization detail.
+func (ad AuthorizationDetail) GetType() string {
+	detailType, _ := ad["type"].(string)
+	return detailType
 }
 
 // ClaimsRequest represents the OIDC claims request parameter structure.
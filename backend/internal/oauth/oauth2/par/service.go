@@ -80,7 +80,7 @@ func (s *parService) HandlePushedAuthorizationRequest(
 	// Validate the redirect URI.
 	redirectURI := params[oauth2const.RequestParamRedirectURI]
 	if err := oauthApp.ValidateRedirectURI(redirectURI); err != nil {
-		return nil, oauth2const.ErrorInvalidRequest, "Invalid redirect URI"
+		return nil, oauth2const.ErrorInvalidRequest, inboundmodel.RedirectURIErrorDescription(err)
 	}
 
 	// Validate the authorization parameters using the same rules as the authorize endpoint.
@@ -105,6 +105,15 @@ func (s *parService) HandlePushedAuthorizationRequest(
 		}
 	}
 
+	// Parse the authorization_details parameter (RFC 9396) if present.
+	authorizationDetails, err := oauth2utils.ParseAuthorizationDetails(
+		params[oauth2const.RequestParamAuthorizationDetails],
+		config.GetServerRuntime().Config.OAuth.AuthorizationDetails.Types)
+	if err != nil {
+		return nil, oauth2const.ErrorInvalidAuthorizationDetails,
+			"The authorization_details parameter is malformed or contains unsupported types"
+	}
+
 	scope := params[oauth2const.RequestParamScope]
 	oidcScopes, nonOidcScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(scope, oauthApp.ScopeClaims)
 
@@ -122,20 +131,26 @@ func (s *parService) HandlePushedAuthorizationRequest(
 	}
 
 	oauthParams := oauth2model.OAuthParameters{
-		State:               params[oauth2const.RequestParamState],
-		ClientID:            oauthApp.ClientID,
-		RedirectURI:         redirectURI,
-		ResponseType:        params[oauth2const.RequestParamResponseType],
-		StandardScopes:      oidcScopes,
-		PermissionScopes:    nonOidcScopes,
-		CodeChallenge:       params[oauth2const.RequestParamCodeChallenge],
-		CodeChallengeMethod: params[oauth2const.RequestParamCodeChallengeMethod],
-		Resources:           resources,
-		ClaimsRequest:       claimsRequest,
-		ClaimsLocales:       params[oauth2const.RequestParamClaimsLocales],
-		Nonce:               params[oauth2const.RequestParamNonce],
-		AcrValues:           params[oauth2const.RequestParamAcrValues],
+		State:                params[oauth2const.RequestParamState],
+		ClientID:             oauthApp.ClientID,
+		RedirectURI:          redirectURI,
+		ResponseType:         params[oauth2const.RequestParamResponseType],
+		ResponseMode:         params[oauth2const.RequestParamResponseMode],
+		StandardScopes:       oidcScopes,
+		PermissionScopes:     nonOidcScopes,
+		CodeChallenge:        params[oauth2const.RequestParamCodeChallenge],
+		CodeChallengeMethod:  params[oauth2const.RequestParamCodeChallengeMethod],
+		Resources:            resources,
+		ClaimsRequest:        claimsRequest,
+		ClaimsLocales:        params[oauth2const.RequestParamClaimsLocales],
+		Nonce:                params[oauth2const.RequestParamNonce],
+		AcrValues:            params[oauth2const.RequestParamAcrValues],
+		Prompt:               params[oauth2const.RequestParamPrompt],
+		Organization:         params[oauth2const.RequestParamOrganization],
+		AuthorizationDetails: authorizationDetails,
 	}
+	// The max_age parameter is already validated along with the other authorization parameters.
+	oauthParams.MaxAge, _ = requestvalidator.ParseMaxAge(params[oauth2const.RequestParamMaxAge])
 
 	parRequest := pushedAuthorizationRequest{
 		ClientID:        oauthApp.ClientID,
@@ -390,6 +390,40 @@ func (s *ServiceTestSuite) TestHandlePAR_NonceTooLong() {
 	assert.Equal(s.T(), oauth2const.ErrorInvalidRequest, errCode)
 }
 
+func (s *ServiceTestSuite) TestHandlePAR_AuthorizationDetailsPropagated() {
+	store := newParStoreInterfaceMock(s.T())
+	var captured pushedAuthorizationRequest
+	store.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, req pushedAuthorizationRequest, _ int64) {
+			captured = req
+		}).Return("test-uri", nil)
+
+	svc := newPARService(store, s.newPermissiveResourceMock())
+	app := s.newTestApp()
+	params := s.newValidParams()
+	params[oauth2const.RequestParamAuthorizationDetails] = `[{"type":"payment_initiation"}]`
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app)
+
+	assert.Empty(s.T(), errCode)
+	assert.NotNil(s.T(), resp)
+	assert.Len(s.T(), captured.OAuthParameters.AuthorizationDetails, 1)
+	assert.Equal(s.T(), "payment_initiation", captured.OAuthParameters.AuthorizationDetails[0].GetType())
+}
+
+func (s *ServiceTestSuite) TestHandlePAR_InvalidAuthorizationDetails() {
+	store := newParStoreInterfaceMock(s.T())
+	svc := newPARService(store, s.newPermissiveResourceMock())
+	app := s.newTestApp()
+	params := s.newValidParams()
+	params[oauth2const.RequestParamAuthorizationDetails] = `[{"actions":["initiate"]}]`
+
+	resp, errCode, _ := svc.HandlePushedAuthorizationRequest(s.ctx, params, nil, app)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), oauth2const.ErrorInvalidAuthorizationDetails, errCode)
+}
+
 func (s *ServiceTestSuite) TestResolvePAR_Success() {
 	storedRequest := pushedAuthorizationRequest{
 		ClientID: "test-client",
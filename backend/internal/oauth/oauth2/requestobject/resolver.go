@@ -0,0 +1,300 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package requestobject resolves JWT-secured authorization requests (RFC 9101). A request object carries
+// the authorization request parameters as the claims of a JWT signed by the client, passed by value in the
+// request parameter or by reference in the request_uri parameter.
+package requestobject
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/cert"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jws"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// maxRequestURILength is the maximum length of a request_uri, as recommended by RFC 9101 Section 5.2.
+	maxRequestURILength = 512
+	// maxRequestObjectBytes caps the size of a request object fetched from a request_uri.
+	maxRequestObjectBytes = 64 << 10
+	// requestObjectContentType is the media type of a request object served from a request_uri.
+	requestObjectContentType = "application/oauth-authz-req+jwt"
+)
+
+// SupportedSigningAlgs lists the JWS algorithms accepted for signed request objects.
+var SupportedSigningAlgs = []string{
+	string(jws.RS256), string(jws.RS512), string(jws.PS256),
+	string(jws.ES256), string(jws.ES384), string(jws.ES512),
+	string(jws.EdDSA),
+}
+
+// jwtClaims are the claims of a request object that are not authorization request parameters.
+var jwtClaims = []string{"iss", "aud", "exp", "iat", "nbf", "jti"}
+
+// ErrInvalidRequestObject indicates the request object is malformed or fails signature or claim verification.
+var ErrInvalidRequestObject = errors.New("invalid request object")
+
+// ErrInvalidRequestURI indicates the request object could not be retrieved from the request_uri.
+var ErrInvalidRequestURI = errors.New("invalid request_uri")
+
+// AuthorizationRequest holds the authorization request parameters carried by a verified request object.
+type AuthorizationRequest struct {
+	Params    map[string]string
+	Resources []string
+}
+
+// RequestObjectResolverInterface defines the interface for resolving request objects.
+type RequestObjectResolverInterface interface {
+	Resolve(ctx context.Context, requestObject, requestURI string, app *inboundmodel.OAuthClient) (
+		*AuthorizationRequest, error)
+}
+
+// requestObjectResolver resolves request objects and verifies them against the keys registered for the client.
+type requestObjectResolver struct {
+	jwtService jwt.JWTServiceInterface
+	httpClient syshttp.HTTPClientInterface
+	logger     *log.Logger
+}
+
+// newRequestObjectResolver creates a new instance of requestObjectResolver.
+func newRequestObjectResolver(
+	jwtService jwt.JWTServiceInterface, httpClient syshttp.HTTPClientInterface,
+) RequestObjectResolverInterface {
+	return &requestObjectResolver{
+		jwtService: jwtService,
+		httpClient: httpClient,
+		logger:     log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RequestObjectResolver")),
+	}
+}
+
+// Resolve retrieves the request object passed by value or by reference, verifies it against the keys
+// registered for the client, and returns the authorization request parameters it carries. Exactly one of
+// requestObject and requestURI is expected to be set.
+func (r *requestObjectResolver) Resolve(
+	ctx context.Context, requestObject, requestURI string, app *inboundmodel.OAuthClient,
+) (*AuthorizationRequest, error) {
+	if requestURI != "" {
+		fetched, err := r.fetchRequestObject(ctx, requestURI)
+		if err != nil {
+			r.logger.Debug("Failed to retrieve the request object", log.String("endpoint", endpoint(requestURI)),
+				log.Error(err))
+			return nil, ErrInvalidRequestURI
+		}
+		requestObject = fetched
+	}
+
+	if err := r.verifyRequestObject(ctx, requestObject, app); err != nil {
+		r.logger.Debug("Request object verification failed", log.String("client_id", app.ClientID),
+			log.Error(err))
+		return nil, ErrInvalidRequestObject
+	}
+
+	payload, err := jwt.DecodeJWTPayload(requestObject)
+	if err != nil {
+		return nil, ErrInvalidRequestObject
+	}
+	authRequest, err := buildAuthorizationRequest(payload, app.ClientID)
+	if err != nil {
+		r.logger.Debug("Request object carries invalid parameters", log.String("client_id", app.ClientID),
+			log.Error(err))
+		return nil, ErrInvalidRequestObject
+	}
+	return authRequest, nil
+}
+
+// fetchRequestObject retrieves the request object from the request_uri with SSRF protection and a size cap.
+func (r *requestObjectResolver) fetchRequestObject(ctx context.Context, requestURI string) (string, error) {
+	if len(requestURI) > maxRequestURILength {
+		return "", fmt.Errorf("request_uri exceeds %d characters", maxRequestURILength)
+	}
+	if r.httpClient == nil {
+		return "", errors.New("HTTP client is not configured")
+	}
+	if err := syshttp.IsSSRFSafeURL(requestURI); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURI, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", requestObjectContentType)
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("request_uri responded with status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRequestObjectBytes+1))
+	if err != nil {
+		return "", err
+	}
+	if len(body) > maxRequestObjectBytes {
+		return "", fmt.Errorf("request object exceeds %d bytes", maxRequestObjectBytes)
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// verifyRequestObject verifies the signature of the request object with the certificate of the client, and
+// that it was issued by the client for this server. Unsigned request objects are rejected.
+func (r *requestObjectResolver) verifyRequestObject(
+	ctx context.Context, requestObject string, app *inboundmodel.OAuthClient,
+) error {
+	if app.Certificate == nil {
+		return errors.New("no certificate configured for request object verification")
+	}
+	audience := config.GetIssuer(ctx)
+
+	if app.Certificate.Type == cert.CertificateTypeJWKSURI {
+		if svcErr := r.jwtService.VerifyJWTWithJWKS(requestObject, app.Certificate.Value, audience,
+			app.ClientID); svcErr != nil {
+			return fmt.Errorf("verification with JWKS URI failed: %s", svcErr.Error.DefaultValue)
+		}
+		return nil
+	}
+
+	var jwks struct {
+		Keys []map[string]any `json:"keys"`
+	}
+	if err := json.Unmarshal([]byte(app.Certificate.Value), &jwks); err != nil {
+		return fmt.Errorf("invalid JWKS certificate format: %w", err)
+	}
+
+	header, err := jwt.DecodeJWTHeader(requestObject)
+	if err != nil {
+		return fmt.Errorf("failed to decode header: %w", err)
+	}
+	kid, _ := header["kid"].(string)
+
+	var jwk map[string]any
+	for _, key := range jwks.Keys {
+		// A request object without a kid is verified with the only key of a single-key JWKS.
+		if keyID, _ := key["kid"].(string); keyID == kid || (kid == "" && len(jwks.Keys) == 1) {
+			jwk = key
+			break
+		}
+	}
+	if jwk == nil {
+		return fmt.Errorf("no matching key found in JWKS for kid: %s", kid)
+	}
+
+	pubKey, err := jws.JWKToPublicKey(jwk)
+	if err != nil {
+		return fmt.Errorf("failed to convert JWK to public key: %w", err)
+	}
+	if svcErr := r.jwtService.VerifyJWTWithPublicKey(requestObject, pubKey, audience,
+		app.ClientID); svcErr != nil {
+		return fmt.Errorf("verification failed: %s", svcErr.Error.DefaultValue)
+	}
+	return nil
+}
+
+// buildAuthorizationRequest converts the claims of a request object to authorization request parameters.
+// Structured claims, such as the claims parameter, are carried in their JSON encoding.
+func buildAuthorizationRequest(payload map[string]interface{}, clientID string) (*AuthorizationRequest, error) {
+	params := make(map[string]string, len(payload))
+	var resources []string
+	for name, value := range payload {
+		if slices.Contains(jwtClaims, name) || value == nil {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			params[name] = v
+		case float64:
+			params[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			params[name] = strconv.FormatBool(v)
+		case []interface{}:
+			if name == oauth2const.RequestParamAuthorizationDetails {
+				encoded, err := json.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				params[name] = string(encoded)
+				continue
+			}
+			if name != oauth2const.RequestParamResource {
+				return nil, fmt.Errorf("parameter %q must not be an array", name)
+			}
+			for _, item := range v {
+				resource, ok := item.(string)
+				if !ok {
+					return nil, errors.New("resource values must be strings")
+				}
+				resources = append(resources, resource)
+			}
+		case map[string]interface{}:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			params[name] = string(encoded)
+		default:
+			return nil, fmt.Errorf("parameter %q has an unsupported type", name)
+		}
+	}
+
+	if resource, ok := params[oauth2const.RequestParamResource]; ok {
+		resources = []string{resource}
+	}
+	if len(resources) > 0 {
+		params[oauth2const.RequestParamResource] = resources[0]
+	}
+
+	// A request object must not nest another request object.
+	if params[oauth2const.RequestParamRequest] != "" || params[oauth2const.RequestParamRequestURI] != "" {
+		return nil, errors.New("request object must not contain request or request_uri")
+	}
+	if requestClientID, ok := params[oauth2const.RequestParamClientID]; ok && requestClientID != clientID {
+		return nil, errors.New("client_id does not match the client_id of the request")
+	}
+	params[oauth2const.RequestParamClientID] = clientID
+
+	return &AuthorizationRequest{
+		Params:    params,
+		Resources: resources,
+	}, nil
+}
+
+// endpoint returns the scheme and host of a request_uri for logging, omitting its path and query.
+func endpoint(uri string) string {
+	if u, err := url.Parse(uri); err == nil {
+		return u.Scheme + "://" + u.Host
+	}
+	return "(unparseable)"
+}
@@ -0,0 +1,309 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package requestobject
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/cert"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testIssuer     = "https://localhost:8090/oauth2/token"
+	testClientID   = "test-client"
+	testJWKSURI    = "https://client.example.com/jwks"
+	testRequestURI = "https://client.example.com/request.jwt"
+)
+
+type RequestObjectResolverTestSuite struct {
+	suite.Suite
+	mockJWTService *jwtmock.JWTServiceInterfaceMock
+	mockHTTPClient *httpmock.HTTPClientInterfaceMock
+	resolver       *requestObjectResolver
+	app            *inboundmodel.OAuthClient
+}
+
+func TestRequestObjectResolverTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestObjectResolverTestSuite))
+}
+
+func (suite *RequestObjectResolverTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("", &config.Config{
+		JWT: config.JWTConfig{Issuer: testIssuer},
+	})
+	suite.Require().NoError(err)
+
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.resolver = newRequestObjectResolver(suite.mockJWTService, suite.mockHTTPClient).(*requestObjectResolver)
+	suite.app = &inboundmodel.OAuthClient{
+		ClientID: testClientID,
+		Certificate: &inboundmodel.Certificate{
+			Type:  cert.CertificateTypeJWKSURI,
+			Value: testJWKSURI,
+		},
+	}
+}
+
+func (suite *RequestObjectResolverTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// buildRequestObject builds a compact JWT with the given header and claims. The signature is not
+// meaningful; verification is delegated to the mocked JWT service.
+func buildRequestObject(header, claims map[string]interface{}) string {
+	headerBytes, _ := json.Marshal(header)
+	claimsBytes, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(headerBytes) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsBytes) + ".c2lnbmF0dXJl"
+}
+
+func (suite *RequestObjectResolverTestSuite) testClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":           testClientID,
+		"aud":           testIssuer,
+		"exp":           1900000000,
+		"iat":           1800000000,
+		"jti":           "request-1",
+		"client_id":     testClientID,
+		"response_type": "code",
+		"redirect_uri":  "https://client.example.com/callback",
+		"scope":         "openid profile",
+		"state":         "state-1",
+		"max_age":       300,
+	}
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByValue() {
+	claims := suite.testClaims()
+	claims["resource"] = []interface{}{"https://api1.example.com", "https://api2.example.com"}
+	claims["claims"] = map[string]interface{}{
+		"id_token": map[string]interface{}{"email": map[string]interface{}{"essential": true}},
+	}
+	claims["authorization_details"] = []interface{}{map[string]interface{}{"type": "payment_initiation"}}
+	requestObject := buildRequestObject(map[string]interface{}{"alg": "RS256", "kid": "key-1"}, claims)
+	suite.mockJWTService.On("VerifyJWTWithJWKS", requestObject, testJWKSURI, testIssuer, testClientID).
+		Return(nil)
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), requestObject, "", suite.app)
+
+	suite.Require().NoError(err)
+	suite.Equal(testClientID, authRequest.Params["client_id"])
+	suite.Equal("code", authRequest.Params["response_type"])
+	suite.Equal("openid profile", authRequest.Params["scope"])
+	suite.Equal("300", authRequest.Params["max_age"])
+	suite.JSONEq(`{"id_token":{"email":{"essential":true}}}`, authRequest.Params["claims"])
+	suite.JSONEq(`[{"type":"payment_initiation"}]`, authRequest.Params["authorization_details"])
+	suite.Equal("https://api1.example.com", authRequest.Params["resource"])
+	suite.Equal([]string{"https://api1.example.com", "https://api2.example.com"}, authRequest.Resources)
+	for _, claim := range jwtClaims {
+		suite.NotContains(authRequest.Params, claim)
+	}
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ClientIDDefaultsToClient() {
+	claims := suite.testClaims()
+	delete(claims, "client_id")
+	claims["resource"] = "https://api.example.com"
+	requestObject := buildRequestObject(map[string]interface{}{"alg": "RS256", "kid": "key-1"}, claims)
+	suite.mockJWTService.On("VerifyJWTWithJWKS", requestObject, testJWKSURI, testIssuer, testClientID).
+		Return(nil)
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), requestObject, "", suite.app)
+
+	suite.Require().NoError(err)
+	suite.Equal(testClientID, authRequest.Params["client_id"])
+	suite.Equal([]string{"https://api.example.com"}, authRequest.Resources)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_InlineJWKS() {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	keys, _ := json.Marshal(map[string]interface{}{
+		"keys": []interface{}{
+			map[string]interface{}{
+				"kty": "RSA",
+				"kid": "key-1",
+				"n":   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+			},
+		},
+	})
+	suite.app.Certificate = &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: string(keys)}
+	requestObject := buildRequestObject(map[string]interface{}{"alg": "RS256", "kid": "key-1"}, suite.testClaims())
+	suite.mockJWTService.On("VerifyJWTWithPublicKey", requestObject, mock.Anything, testIssuer, testClientID).
+		Return(nil)
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), requestObject, "", suite.app)
+
+	suite.Require().NoError(err)
+	suite.Equal("state-1", authRequest.Params["state"])
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_InlineJWKS_UnknownKeyID() {
+	keys := `{"keys":[{"kty":"RSA","kid":"key-1","n":"AQAB","e":"AQAB"}]}`
+	suite.app.Certificate = &inboundmodel.Certificate{Type: cert.CertificateTypeJWKS, Value: keys}
+	requestObject := buildRequestObject(map[string]interface{}{"alg": "RS256", "kid": "key-2"}, suite.testClaims())
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), requestObject, "", suite.app)
+
+	suite.Nil(authRequest)
+	suite.ErrorIs(err, ErrInvalidRequestObject)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_NoCertificate() {
+	suite.app.Certificate = nil
+	requestObject := buildRequestObject(map[string]interface{}{"alg": "RS256"}, suite.testClaims())
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), requestObject, "", suite.app)
+
+	suite.Nil(authRequest)
+	suite.ErrorIs(err, ErrInvalidRequestObject)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_VerificationFailure() {
+	requestObject := buildRequestObject(map[string]interface{}{"alg": "RS256", "kid": "key-1"}, suite.testClaims())
+	suite.mockJWTService.On("VerifyJWTWithJWKS", requestObject, testJWKSURI, testIssuer, testClientID).
+		Return(&serviceerror.InternalServerError)
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), requestObject, "", suite.app)
+
+	suite.Nil(authRequest)
+	suite.ErrorIs(err, ErrInvalidRequestObject)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_InvalidParameters() {
+	testCases := []struct {
+		name   string
+		claims map[string]interface{}
+	}{
+		{name: "ClientIDMismatch", claims: map[string]interface{}{"client_id": "other-client"}},
+		{name: "NestedRequest", claims: map[string]interface{}{"request": "eyJ..."}},
+		{name: "NestedRequestURI", claims: map[string]interface{}{"request_uri": testRequestURI}},
+		{name: "ArrayParameter", claims: map[string]interface{}{"scope": []interface{}{"openid"}}},
+		{name: "NonStringResource", claims: map[string]interface{}{"resource": []interface{}{1}}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			claims := suite.testClaims()
+			for name, value := range tc.claims {
+				claims[name] = value
+			}
+			requestObject := buildRequestObject(map[string]interface{}{"alg": "RS256", "kid": "key-1"}, claims)
+			suite.mockJWTService.On("VerifyJWTWithJWKS", requestObject, testJWKSURI, testIssuer, testClientID).
+				Return(nil).Once()
+
+			authRequest, err := suite.resolver.Resolve(context.Background(), requestObject, "", suite.app)
+
+			suite.Nil(authRequest)
+			suite.ErrorIs(err, ErrInvalidRequestObject)
+		})
+	}
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByReference() {
+	requestObject := buildRequestObject(map[string]interface{}{"alg": "RS256", "kid": "key-1"}, suite.testClaims())
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == testRequestURI && req.Header.Get("Accept") == requestObjectContentType
+	})).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(requestObject + "\n")),
+	}, nil)
+	suite.mockJWTService.On("VerifyJWTWithJWKS", requestObject, testJWKSURI, testIssuer, testClientID).
+		Return(nil)
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), "", testRequestURI, suite.app)
+
+	suite.Require().NoError(err)
+	suite.Equal("https://client.example.com/callback", authRequest.Params["redirect_uri"])
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByReference_Non200Status() {
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(&http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}, nil)
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), "", testRequestURI, suite.app)
+
+	suite.Nil(authRequest)
+	suite.ErrorIs(err, ErrInvalidRequestURI)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByReference_FetchFailure() {
+	suite.mockHTTPClient.On("Do", mock.Anything).Return((*http.Response)(nil), assert.AnError)
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), "", testRequestURI, suite.app)
+
+	suite.Nil(authRequest)
+	suite.ErrorIs(err, ErrInvalidRequestURI)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByReference_OversizedResponse() {
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(strings.Repeat("a", maxRequestObjectBytes+1))),
+	}, nil)
+
+	authRequest, err := suite.resolver.Resolve(context.Background(), "", testRequestURI, suite.app)
+
+	suite.Nil(authRequest)
+	suite.ErrorIs(err, ErrInvalidRequestURI)
+}
+
+func (suite *RequestObjectResolverTestSuite) TestResolve_ByReference_RejectedURI() {
+	testCases := []struct {
+		name       string
+		requestURI string
+	}{
+		{name: "NotHTTPS", requestURI: "http://client.example.com/request.jwt"},
+		{name: "PrivateAddress", requestURI: "https://169.254.169.254/request.jwt"},
+		{name: "TooLong", requestURI: "https://client.example.com/" + strings.Repeat("a", maxRequestURILength)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			authRequest, err := suite.resolver.Resolve(context.Background(), "", tc.requestURI, suite.app)
+
+			suite.Nil(authRequest)
+			suite.ErrorIs(err, ErrInvalidRequestURI)
+		})
+	}
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
@@ -20,6 +20,7 @@ package token
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
@@ -40,16 +41,20 @@ type TokenHandlerInterface interface {
 type tokenHandler struct {
 	tokenService     TokenServiceInterface
 	observabilitySvc observability.ObservabilityServiceInterface
+	rateLimiter      tokenRateLimiterInterface
 }
 
-// newTokenHandler creates a new instance of tokenHandler.
+// newTokenHandler creates a new instance of tokenHandler. The rate limiter is nil when the token endpoint
+// rate limits are disabled.
 func newTokenHandler(
 	tokenService TokenServiceInterface,
 	observabilitySvc observability.ObservabilityServiceInterface,
+	rateLimiter tokenRateLimiterInterface,
 ) TokenHandlerInterface {
 	return &tokenHandler{
 		tokenService:     tokenService,
 		observabilitySvc: observabilitySvc,
+		rateLimiter:      rateLimiter,
 	}
 }
 
@@ -80,23 +85,41 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 
 	// Build the token request domain model from the HTTP form values.
 	tokenRequest := &model.TokenRequest{
-		GrantType:          r.FormValue(constants.RequestParamGrantType),
-		ClientID:           clientInfo.ClientID,
-		ClientSecret:       clientInfo.ClientSecret,
-		Scope:              r.FormValue("scope"),
-		Username:           r.FormValue("username"),
-		Password:           r.FormValue("password"),
-		RefreshToken:       r.FormValue("refresh_token"),
-		CodeVerifier:       r.FormValue("code_verifier"),
-		Code:               r.FormValue("code"),
-		RedirectURI:        r.FormValue("redirect_uri"),
-		Resources:          r.Form[constants.RequestParamResource],
-		SubjectToken:       r.FormValue(constants.RequestParamSubjectToken),
-		SubjectTokenType:   r.FormValue(constants.RequestParamSubjectTokenType),
-		ActorToken:         r.FormValue(constants.RequestParamActorToken),
-		ActorTokenType:     r.FormValue(constants.RequestParamActorTokenType),
-		RequestedTokenType: r.FormValue(constants.RequestParamRequestedTokenType),
-		Audiences:          r.Form[constants.RequestParamAudience],
+		GrantType:            r.FormValue(constants.RequestParamGrantType),
+		ClientID:             clientInfo.ClientID,
+		ClientSecret:         clientInfo.ClientSecret,
+		Scope:                r.FormValue("scope"),
+		Username:             r.FormValue("username"),
+		Password:             r.FormValue("password"),
+		RefreshToken:         r.FormValue("refresh_token"),
+		CodeVerifier:         r.FormValue("code_verifier"),
+		Code:                 r.FormValue("code"),
+		RedirectURI:          r.FormValue("redirect_uri"),
+		Resources:            r.Form[constants.RequestParamResource],
+		SubjectToken:         r.FormValue(constants.RequestParamSubjectToken),
+		SubjectTokenType:     r.FormValue(constants.RequestParamSubjectTokenType),
+		ActorToken:           r.FormValue(constants.RequestParamActorToken),
+		ActorTokenType:       r.FormValue(constants.RequestParamActorTokenType),
+		RequestedTokenType:   r.FormValue(constants.RequestParamRequestedTokenType),
+		Audiences:            r.Form[constants.RequestParamAudience],
+		AuthorizationDetails: r.FormValue(constants.RequestParamAuthorizationDetails),
+		AuthReqID:            r.FormValue(constants.RequestParamAuthReqID),
+	}
+
+	// Reject the request when the client has exhausted its token endpoint quota.
+	if th.rateLimiter != nil {
+		allowed, retryAfter := th.rateLimiter.allow(r.Context(), tokenRequest.ClientID, tokenRequest.GrantType)
+		if !allowed {
+			publishTokenIssuanceFailedEvent(th.observabilitySvc, r.Context(), tokenRequest.ClientID,
+				tokenRequest.GrantType, tokenRequest.Scope, http.StatusTooManyRequests,
+				"token rate limit exceeded", startTime)
+			utils.WriteJSONError(w, constants.ErrorTooManyRequests,
+				"Too many token requests for the client. Retry after the time given in the Retry-After header",
+				http.StatusTooManyRequests, []map[string]string{
+					{"Retry-After": strconv.FormatInt(getRetryAfterSeconds(retryAfter), 10)},
+				})
+			return
+		}
 	}
 
 	// Delegate all business logic to the token service.
@@ -26,6 +26,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -52,7 +53,7 @@ func (suite *TokenHandlerTestSuite) SetupTest() {
 
 // newHandler creates a tokenHandler backed by the suite's service mock.
 func (suite *TokenHandlerTestSuite) newHandler() *tokenHandler {
-	return newTokenHandler(suite.mockTokenService, nil).(*tokenHandler)
+	return newTokenHandler(suite.mockTokenService, nil, nil).(*tokenHandler)
 }
 
 // buildRequest constructs a POST /token request with URL-encoded form data.
@@ -76,7 +77,7 @@ func (suite *TokenHandlerTestSuite) withClientContext(
 }
 
 func (suite *TokenHandlerTestSuite) TestnewTokenHandler() {
-	handler := newTokenHandler(suite.mockTokenService, nil)
+	handler := newTokenHandler(suite.mockTokenService, nil, nil)
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*TokenHandlerInterface)(nil), handler)
 }
@@ -145,7 +146,7 @@ func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_ServiceErrors() {
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
 			mockSvc := NewTokenServiceInterfaceMock(suite.T())
-			handler := newTokenHandler(mockSvc, nil).(*tokenHandler)
+			handler := newTokenHandler(mockSvc, nil, nil).(*tokenHandler)
 			mockApp := &inboundmodel.OAuthClient{ClientID: "test-client-id"}
 			formData := url.Values{}
 			formData.Set("grant_type", tc.grantType)
@@ -259,3 +260,47 @@ func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_SuccessWithIssuedToke
 	assert.Equal(suite.T(), "exchanged-token", response["access_token"])
 	assert.Equal(suite.T(), string(constants.TokenTypeIdentifierAccessToken), response["issued_token_type"])
 }
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_RateLimitExceeded() {
+	mockLimiter := newTokenRateLimiterInterfaceMock(suite.T())
+	handler := newTokenHandler(suite.mockTokenService, nil, mockLimiter).(*tokenHandler)
+	formData := url.Values{}
+	formData.Set("grant_type", "client_credentials")
+	req := suite.withClientContext(suite.buildRequest(formData),
+		&inboundmodel.OAuthClient{ClientID: "test-client-id"})
+
+	mockLimiter.EXPECT().allow(mock.Anything, "test-client-id", "client_credentials").
+		Return(false, 1500*time.Millisecond).Once()
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusTooManyRequests, rr.Code)
+	assert.Equal(suite.T(), "2", rr.Header().Get("Retry-After"))
+
+	var response map[string]interface{}
+	err := json.Unmarshal(rr.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorTooManyRequests, response["error"])
+	suite.mockTokenService.AssertNotCalled(suite.T(), "ProcessTokenRequest")
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_RateLimitAllowed() {
+	mockLimiter := newTokenRateLimiterInterfaceMock(suite.T())
+	handler := newTokenHandler(suite.mockTokenService, nil, mockLimiter).(*tokenHandler)
+	formData := url.Values{}
+	formData.Set("grant_type", "client_credentials")
+	req := suite.withClientContext(suite.buildRequest(formData),
+		&inboundmodel.OAuthClient{ClientID: "test-client-id"})
+
+	mockLimiter.EXPECT().allow(mock.Anything, "test-client-id", "client_credentials").
+		Return(true, time.Duration(0)).Once()
+	suite.mockTokenService.EXPECT().
+		ProcessTokenRequest(mock.Anything, mock.Anything, mock.Anything).
+		Return(&model.TokenResponse{AccessToken: "access-token-123", TokenType: "Bearer"}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+}
@@ -20,6 +20,7 @@ package token
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
@@ -28,13 +29,16 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/granthandlers"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/internal/system/observability"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
 
-// Initialize initializes the token handler and registers its routes.
+// Initialize initializes the token handler and registers its routes. Returns an error when the token endpoint
+// rate limit configuration is invalid.
 func Initialize(
 	mux *http.ServeMux,
 	jwtService jwt.JWTServiceInterface,
@@ -45,11 +49,37 @@ func Initialize(
 	observabilitySvc observability.ObservabilityServiceInterface,
 	discoveryService discovery.DiscoveryServiceInterface,
 	transactioner transaction.Transactioner,
-) TokenHandlerInterface {
+) (TokenHandlerInterface, error) {
+	rateLimiter, err := initializeRateLimiter()
+	if err != nil {
+		return nil, err
+	}
+
 	tokenSvc := newTokenService(grantHandlerProvider, scopeValidator, observabilitySvc, transactioner)
-	tokenHandler := newTokenHandler(tokenSvc, observabilitySvc)
+	tokenHandler := newTokenHandler(tokenSvc, observabilitySvc, rateLimiter)
 	registerRoutes(mux, tokenHandler, inboundClient, authnProvider, jwtService, discoveryService)
-	return tokenHandler
+	return tokenHandler, nil
+}
+
+// initializeRateLimiter creates the token endpoint rate limiter when the rate limits are enabled. The counters
+// are kept in Redis when the runtime store is Redis, and in the runtime database otherwise.
+func initializeRateLimiter() (tokenRateLimiterInterface, error) {
+	runtimeConfig := config.GetServerRuntime().Config
+	rateLimitConfig := runtimeConfig.OAuth.TokenRateLimit
+	if !rateLimitConfig.Enabled {
+		return nil, nil
+	}
+	if err := validateTokenRateLimitConfig(rateLimitConfig); err != nil {
+		return nil, fmt.Errorf("invalid token rate limit configuration: %w", err)
+	}
+
+	var store rateLimitCounterStoreInterface
+	if runtimeConfig.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		store = newRedisRateLimitCounterStore(provider.GetRedisProvider(), runtimeConfig.Server.Identifier)
+	} else {
+		store = newRateLimitCounterStore(provider.GetDBProvider(), runtimeConfig.Server.Identifier)
+	}
+	return newTokenRateLimiter(rateLimitConfig, store), nil
 }
 
 // registerRoutes registers the routes for the TokenService.
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// incrementTokenRateLimitCounterScript atomically increments a rate limit counter and sets its expiry, in unix
+// milliseconds, when the counter is created. Returns the updated count.
+var incrementTokenRateLimitCounterScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+  redis.call('PEXPIREAT', KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// rateLimitRedisClient abstracts the Redis commands used by the rate limit counter store.
+type rateLimitRedisClient interface {
+	redis.Scripter
+}
+
+// redisRateLimitCounterStore is the implementation of rateLimitCounterStoreInterface used when the runtime
+// store is Redis, so that the counters are shared by all the nodes of a deployment.
+type redisRateLimitCounterStore struct {
+	client       rateLimitRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisRateLimitCounterStore creates a new rate limit counter store that keeps the counters in Redis.
+func newRedisRateLimitCounterStore(p provider.RedisProviderInterface,
+	deploymentID string) rateLimitCounterStoreInterface {
+	return &redisRateLimitCounterStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// counterKey builds the Redis key for a rate limit counter.
+func (s *redisRateLimitCounterStore) counterKey(key string) string {
+	return fmt.Sprintf("%s:runtime:%s:token-rate-limit:%s", s.keyPrefix, s.deploymentID, key)
+}
+
+// incrementCounter atomically increments the rate limit counter with the given key in Redis and returns the
+// updated count. The counter expires at the given expiry time.
+func (s *redisRateLimitCounterStore) incrementCounter(ctx context.Context, key string,
+	expiryTime time.Time) (int, error) {
+	count, err := incrementTokenRateLimitCounterScript.Run(ctx, s.client, []string{s.counterKey(key)},
+		expiryTime.UnixMilli()).Int()
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment token rate limit counter in Redis: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package token
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+const redisTestKeyPrefix = "thunderid"
+
+type RedisRateLimitCounterStoreTestSuite struct {
+	suite.Suite
+	mockClient *rateLimitRedisClientMock
+	store      *redisRateLimitCounterStore
+	ctx        context.Context
+	redisKey   string
+	expiryTime time.Time
+}
+
+func TestRedisRateLimitCounterStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisRateLimitCounterStoreTestSuite))
+}
+
+func (suite *RedisRateLimitCounterStoreTestSuite) SetupTest() {
+	suite.mockClient = newRateLimitRedisClientMock(suite.T())
+	suite.store = &redisRateLimitCounterStore{
+		client:       suite.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: testRateLimitDeploymentID,
+	}
+	suite.ctx = context.Background()
+	suite.redisKey = redisTestKeyPrefix + ":runtime:" + testRateLimitDeploymentID + ":token-rate-limit:key"
+	suite.expiryTime = time.Now().Add(time.Minute)
+}
+
+func (suite *RedisRateLimitCounterStoreTestSuite) TestCounterKey() {
+	suite.Equal(suite.redisKey, suite.store.counterKey("key"))
+}
+
+// incrementTokenRateLimitCounterScript.Run() calls EvalSha with the script's precomputed SHA.
+
+func (suite *RedisRateLimitCounterStoreTestSuite) TestIncrementCounter_Success() {
+	cmd := redis.NewCmd(suite.ctx)
+	cmd.SetVal(int64(3))
+	suite.mockClient.On("EvalSha", suite.ctx, incrementTokenRateLimitCounterScript.Hash(),
+		[]string{suite.redisKey}, suite.expiryTime.UnixMilli()).Return(cmd)
+
+	count, err := suite.store.incrementCounter(suite.ctx, "key", suite.expiryTime)
+	suite.NoError(err)
+	suite.Equal(3, count)
+}
+
+func (suite *RedisRateLimitCounterStoreTestSuite) TestIncrementCounter_ScriptError() {
+	cmd := redis.NewCmd(suite.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("EvalSha", suite.ctx, incrementTokenRateLimitCounterScript.Hash(),
+		[]string{suite.redisKey}, suite.expiryTime.UnixMilli()).Return(cmd)
+
+	count, err := suite.store.incrementCounter(suite.ctx, "key", suite.expiryTime)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to increment token rate limit counter in Redis")
+	suite.Equal(0, count)
+}
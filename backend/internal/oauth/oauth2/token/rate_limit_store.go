@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// rateLimitCounterStoreInterface defines the interface for the token rate limit counter storage operations.
+type rateLimitCounterStoreInterface interface {
+	incrementCounter(ctx context.Context, key string, expiryTime time.Time) (int, error)
+}
+
+// rateLimitCounterStore is the runtime database implementation of rateLimitCounterStoreInterface.
+type rateLimitCounterStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newRateLimitCounterStore returns a new instance of rateLimitCounterStoreInterface.
+func newRateLimitCounterStore(dbProvider provider.DBProviderInterface,
+	deploymentID string) rateLimitCounterStoreInterface {
+	return &rateLimitCounterStore{
+		dbProvider:   dbProvider,
+		deploymentID: deploymentID,
+	}
+}
+
+// incrementCounter increments the rate limit counter with the given key and returns the updated count. The
+// counter is created with the given expiry time if it does not exist.
+func (s *rateLimitCounterStore) incrementCounter(ctx context.Context, key string,
+	expiryTime time.Time) (int, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryIncrementTokenRateLimitCounter, key, expiryTime,
+		s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, errors.New("no count returned for the token rate limit counter")
+	}
+
+	switch count := results[0]["request_count"].(type) {
+	case int64:
+		return int(count), nil
+	case int32:
+		return int(count), nil
+	case int:
+		return count, nil
+	default:
+		return 0, errors.New("failed to parse request_count as integer")
+	}
+}
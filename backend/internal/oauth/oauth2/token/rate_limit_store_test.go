@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package token
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testRateLimitDeploymentID = "test-deployment-id"
+
+type RateLimitCounterStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *rateLimitCounterStore
+	expiryTime     time.Time
+}
+
+func TestRateLimitCounterStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitCounterStoreTestSuite))
+}
+
+func (suite *RateLimitCounterStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = newRateLimitCounterStore(suite.mockDBProvider,
+		testRateLimitDeploymentID).(*rateLimitCounterStore)
+	suite.expiryTime = time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC)
+}
+
+func (suite *RateLimitCounterStoreTestSuite) TestIncrementCounter() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryIncrementTokenRateLimitCounter, "key",
+		suite.expiryTime, testRateLimitDeploymentID).
+		Return([]map[string]interface{}{{"request_count": int64(3)}}, nil).Once()
+
+	count, err := suite.store.incrementCounter(context.Background(), "key", suite.expiryTime)
+	suite.NoError(err)
+	suite.Equal(3, count)
+}
+
+func (suite *RateLimitCounterStoreTestSuite) TestIncrementCounter_WithFailure() {
+	cases := []struct {
+		name    string
+		setup   func()
+		wantErr string
+	}{
+		{
+			name: "GetDBClientError",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+			},
+			wantErr: "failed to get database client",
+		},
+		{
+			name: "QueryError",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryIncrementTokenRateLimitCounter,
+					"key", suite.expiryTime, testRateLimitDeploymentID).Return(nil, errors.New("query fail")).Once()
+			},
+			wantErr: "failed to execute query",
+		},
+		{
+			name: "NoResults",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryIncrementTokenRateLimitCounter,
+					"key", suite.expiryTime, testRateLimitDeploymentID).
+					Return([]map[string]interface{}{}, nil).Once()
+			},
+			wantErr: "no count returned",
+		},
+		{
+			name: "InvalidCount",
+			setup: func() {
+				suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+				suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryIncrementTokenRateLimitCounter,
+					"key", suite.expiryTime, testRateLimitDeploymentID).
+					Return([]map[string]interface{}{{"request_count": "three"}}, nil).Once()
+			},
+			wantErr: "failed to parse request_count",
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.setup()
+
+			count, err := suite.store.incrementCounter(context.Background(), "key", suite.expiryTime)
+			suite.Error(err)
+			suite.Contains(err.Error(), tc.wantErr)
+			suite.Equal(0, count)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// anyGrantTypeScope is the counter scope used when a quota applies to all the grant types of a client.
+const anyGrantTypeScope = "*"
+
+// tokenRateLimiterInterface defines the interface for enforcing the token endpoint rate limits.
+type tokenRateLimiterInterface interface {
+	allow(ctx context.Context, clientID, grantType string) (bool, time.Duration)
+}
+
+// tokenRateLimiter implements tokenRateLimiterInterface. Token requests are counted per client in fixed
+// windows, and separately per grant type when the applicable quota targets a grant type.
+type tokenRateLimiter struct {
+	config config.TokenRateLimitConfig
+	store  rateLimitCounterStoreInterface
+	now    func() time.Time
+	logger *log.Logger
+}
+
+// newTokenRateLimiter returns a new instance of tokenRateLimiterInterface.
+func newTokenRateLimiter(cfg config.TokenRateLimitConfig,
+	store rateLimitCounterStoreInterface) tokenRateLimiterInterface {
+	return &tokenRateLimiter{
+		config: cfg,
+		store:  store,
+		now:    time.Now,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TokenRateLimiter")),
+	}
+}
+
+// allow counts a token request of the given client and grant type, and reports whether it is within the
+// applicable quota. When the quota is exceeded, the time until the current window ends is returned so that
+// the client can be told when to retry. Requests are allowed when the counter cannot be updated so that a
+// runtime store outage does not block token issuance.
+func (l *tokenRateLimiter) allow(ctx context.Context, clientID, grantType string) (bool, time.Duration) {
+	limit, grantScope := l.selectLimit(clientID, grantType)
+	if limit <= 0 {
+		return true, 0
+	}
+
+	// Counters are kept per fixed window and expire at the end of the window.
+	window := time.Duration(l.config.Window) * time.Second
+	now := l.now().UTC()
+	windowStart := now.Truncate(window)
+	expiryTime := windowStart.Add(window)
+
+	key := getTokenRateLimitCounterKey(clientID, grantScope, windowStart)
+	count, err := l.store.incrementCounter(ctx, key, expiryTime)
+	if err != nil {
+		l.logger.Error("Failed to update the token rate limit counter", log.String("clientID", clientID),
+			log.Error(err))
+		return true, 0
+	}
+	if count <= limit {
+		return true, 0
+	}
+
+	l.logger.Debug("Token rate limit exceeded", log.String("clientID", clientID),
+		log.String("grantType", grantType))
+	return false, expiryTime.Sub(now)
+}
+
+// selectLimit returns the limit that applies to the given client and grant type together with the grant type
+// scope of its counter. The most specific quota wins: a quota of the client for the grant type, then a quota
+// of the client, then a quota of the grant type, and finally the default limit.
+func (l *tokenRateLimiter) selectLimit(clientID, grantType string) (int, string) {
+	var clientQuota, grantQuota *config.TokenRateLimitQuotaConfig
+	for i := range l.config.Quotas {
+		quota := &l.config.Quotas[i]
+		switch {
+		case quota.ClientID == clientID && quota.GrantType == grantType:
+			return quota.Limit, grantType
+		case quota.ClientID == clientID && quota.GrantType == "":
+			if clientQuota == nil {
+				clientQuota = quota
+			}
+		case quota.ClientID == "" && quota.GrantType == grantType:
+			if grantQuota == nil {
+				grantQuota = quota
+			}
+		}
+	}
+
+	if clientQuota != nil {
+		return clientQuota.Limit, anyGrantTypeScope
+	}
+	if grantQuota != nil {
+		return grantQuota.Limit, grantType
+	}
+	return l.config.Limit, anyGrantTypeScope
+}
+
+// validateTokenRateLimitConfig validates the token endpoint rate limit configuration.
+func validateTokenRateLimitConfig(cfg config.TokenRateLimitConfig) error {
+	if cfg.Window <= 0 {
+		return errors.New("token rate limit window must be greater than zero")
+	}
+	if cfg.Limit < 0 {
+		return errors.New("token rate limit must not be negative")
+	}
+	for _, quota := range cfg.Quotas {
+		if quota.ClientID == "" && quota.GrantType == "" {
+			return errors.New("token rate limit quota must specify a client ID or a grant type")
+		}
+		if quota.Limit < 0 {
+			return fmt.Errorf("token rate limit quota of client %q and grant type %q must not be negative",
+				quota.ClientID, quota.GrantType)
+		}
+	}
+	return nil
+}
+
+// getRetryAfterSeconds returns the value of the Retry-After header for the given duration, rounded up to
+// whole seconds.
+func getRetryAfterSeconds(retryAfter time.Duration) int64 {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
+// getTokenRateLimitCounterKey builds the key of a token rate limit counter for the given window.
+func getTokenRateLimitCounterKey(clientID, grantScope string, windowStart time.Time) string {
+	return fmt.Sprintf("%s:%s:%d", hash.GenerateThumbprintFromString(clientID), grantScope, windowStart.Unix())
+}
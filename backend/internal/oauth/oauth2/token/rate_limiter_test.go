@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package token
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	testClientCredentialsGrant = "client_credentials"
+	testRefreshTokenGrant      = "refresh_token"
+)
+
+type TokenRateLimiterTestSuite struct {
+	suite.Suite
+	mockStore *rateLimitCounterStoreInterfaceMock
+	now       time.Time
+	ctx       context.Context
+}
+
+func TestTokenRateLimiterTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenRateLimiterTestSuite))
+}
+
+func (suite *TokenRateLimiterTestSuite) SetupTest() {
+	suite.mockStore = newRateLimitCounterStoreInterfaceMock(suite.T())
+	suite.now = time.Date(2026, 1, 2, 3, 4, 15, 0, time.UTC)
+	suite.ctx = context.Background()
+}
+
+// newLimiter creates a rate limiter with a fixed clock backed by the suite's store mock.
+func (suite *TokenRateLimiterTestSuite) newLimiter(cfg config.TokenRateLimitConfig) *tokenRateLimiter {
+	return &tokenRateLimiter{
+		config: cfg,
+		store:  suite.mockStore,
+		now:    func() time.Time { return suite.now },
+		logger: log.GetLogger(),
+	}
+}
+
+func (suite *TokenRateLimiterTestSuite) TestAllow_WithinLimit() {
+	limiter := suite.newLimiter(config.TokenRateLimitConfig{Enabled: true, Window: 60, Limit: 5})
+	windowStart := time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)
+	key := getTokenRateLimitCounterKey("client-1", anyGrantTypeScope, windowStart)
+	suite.mockStore.EXPECT().incrementCounter(suite.ctx, key, windowStart.Add(time.Minute)).Return(5, nil).Once()
+
+	allowed, retryAfter := limiter.allow(suite.ctx, "client-1", testClientCredentialsGrant)
+	suite.True(allowed)
+	suite.Zero(retryAfter)
+}
+
+func (suite *TokenRateLimiterTestSuite) TestAllow_LimitExceeded() {
+	limiter := suite.newLimiter(config.TokenRateLimitConfig{Enabled: true, Window: 60, Limit: 5})
+	windowStart := time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)
+	key := getTokenRateLimitCounterKey("client-1", anyGrantTypeScope, windowStart)
+	suite.mockStore.EXPECT().incrementCounter(suite.ctx, key, windowStart.Add(time.Minute)).Return(6, nil).Once()
+
+	allowed, retryAfter := limiter.allow(suite.ctx, "client-1", testClientCredentialsGrant)
+	suite.False(allowed)
+	suite.Equal(45*time.Second, retryAfter)
+}
+
+func (suite *TokenRateLimiterTestSuite) TestAllow_Unlimited() {
+	limiter := suite.newLimiter(config.TokenRateLimitConfig{
+		Enabled: true,
+		Window:  60,
+		Limit:   5,
+		Quotas:  []config.TokenRateLimitQuotaConfig{{ClientID: "trusted-client", Limit: 0}},
+	})
+
+	allowed, retryAfter := limiter.allow(suite.ctx, "trusted-client", testClientCredentialsGrant)
+	suite.True(allowed)
+	suite.Zero(retryAfter)
+	suite.mockStore.AssertNotCalled(suite.T(), "incrementCounter")
+}
+
+func (suite *TokenRateLimiterTestSuite) TestAllow_StoreErrorFailsOpen() {
+	limiter := suite.newLimiter(config.TokenRateLimitConfig{Enabled: true, Window: 60, Limit: 5})
+	windowStart := time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)
+	key := getTokenRateLimitCounterKey("client-1", anyGrantTypeScope, windowStart)
+	suite.mockStore.EXPECT().incrementCounter(suite.ctx, key, windowStart.Add(time.Minute)).
+		Return(0, errors.New("store down")).Once()
+
+	allowed, retryAfter := limiter.allow(suite.ctx, "client-1", testClientCredentialsGrant)
+	suite.True(allowed)
+	suite.Zero(retryAfter)
+}
+
+func (suite *TokenRateLimiterTestSuite) TestSelectLimit() {
+	quotas := []config.TokenRateLimitQuotaConfig{
+		{GrantType: testClientCredentialsGrant, Limit: 50},
+		{ClientID: "client-1", Limit: 20},
+		{ClientID: "client-1", GrantType: testRefreshTokenGrant, Limit: 10},
+	}
+	limiter := suite.newLimiter(config.TokenRateLimitConfig{Enabled: true, Window: 60, Limit: 100, Quotas: quotas})
+
+	cases := []struct {
+		name          string
+		clientID      string
+		grantType     string
+		expectedLimit int
+		expectedScope string
+	}{
+		{"ClientAndGrantType", "client-1", testRefreshTokenGrant, 10, testRefreshTokenGrant},
+		{"ClientOverGrantType", "client-1", testClientCredentialsGrant, 20, anyGrantTypeScope},
+		{"GrantType", "client-2", testClientCredentialsGrant, 50, testClientCredentialsGrant},
+		{"Default", "client-2", testRefreshTokenGrant, 100, anyGrantTypeScope},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			limit, scope := limiter.selectLimit(tc.clientID, tc.grantType)
+			suite.Equal(tc.expectedLimit, limit)
+			suite.Equal(tc.expectedScope, scope)
+		})
+	}
+}
+
+func (suite *TokenRateLimiterTestSuite) TestValidateTokenRateLimitConfig() {
+	cases := []struct {
+		name    string
+		cfg     config.TokenRateLimitConfig
+		wantErr string
+	}{
+		{
+			name: "Valid",
+			cfg: config.TokenRateLimitConfig{Window: 60, Limit: 10, Quotas: []config.TokenRateLimitQuotaConfig{
+				{ClientID: "client-1", Limit: 0},
+			}},
+		},
+		{
+			name:    "InvalidWindow",
+			cfg:     config.TokenRateLimitConfig{Window: 0, Limit: 10},
+			wantErr: "window must be greater than zero",
+		},
+		{
+			name:    "NegativeLimit",
+			cfg:     config.TokenRateLimitConfig{Window: 60, Limit: -1},
+			wantErr: "must not be negative",
+		},
+		{
+			name: "QuotaWithoutTarget",
+			cfg: config.TokenRateLimitConfig{Window: 60, Limit: 10, Quotas: []config.TokenRateLimitQuotaConfig{
+				{Limit: 5},
+			}},
+			wantErr: "must specify a client ID or a grant type",
+		},
+		{
+			name: "NegativeQuotaLimit",
+			cfg: config.TokenRateLimitConfig{Window: 60, Limit: 10, Quotas: []config.TokenRateLimitQuotaConfig{
+				{GrantType: testClientCredentialsGrant, Limit: -5},
+			}},
+			wantErr: "must not be negative",
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			err := validateTokenRateLimitConfig(tc.cfg)
+			if tc.wantErr == "" {
+				suite.NoError(err)
+				return
+			}
+			suite.Error(err)
+			suite.Contains(err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func (suite *TokenRateLimiterTestSuite) TestGetRetryAfterSeconds() {
+	suite.Equal(int64(45), getRetryAfterSeconds(45*time.Second))
+	suite.Equal(int64(2), getRetryAfterSeconds(1100*time.Millisecond))
+	suite.Equal(int64(1), getRetryAfterSeconds(0))
+}
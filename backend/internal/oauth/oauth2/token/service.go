@@ -145,10 +145,14 @@ func (ts *tokenService) ProcessTokenRequest(
 	}
 
 	// Validate and filter scopes.
-	validScopes, scopeError := ts.scopeValidator.ValidateScopes(ctx, tokenRequest.Scope, oauthApp.ClientID)
+	validScopes, scopeError := ts.scopeValidator.ValidateScopes(ctx, tokenRequest.Scope, oauthApp)
 	if scopeError != nil {
+		code := 400
+		if scopeError.Error == constants.ErrorServerError {
+			code = 500
+		}
 		publishTokenIssuanceFailedEvent(ts.observabilitySvc, ctx, clientID, grantTypeStr, scopeStr,
-			400, scopeError.ErrorDescription, startTime)
+			code, scopeError.ErrorDescription, startTime)
 		return nil, &model.ErrorResponse{
 			Error:            scopeError.Error,
 			ErrorDescription: scopeError.ErrorDescription,
@@ -182,7 +186,7 @@ func (ts *tokenService) ProcessTokenRequest(
 	}
 
 	// Issue refresh token if applicable.
-	if grantType == constants.GrantTypeAuthorizationCode &&
+	if (grantType == constants.GrantTypeAuthorizationCode || grantType == constants.GrantTypeCIBA) &&
 		oauthApp.IsAllowedGrantType(constants.GrantTypeRefreshToken) {
 		logger.Debug("Issuing refresh token for the token request",
 			log.String("client_id", clientID), log.String("grant_type", grantTypeStr))
@@ -233,12 +237,13 @@ func (ts *tokenService) ProcessTokenRequest(
 	// Build token response.
 	scopes := strings.Join(tokenRespDTO.AccessToken.Scopes, " ")
 	tokenResponse := &model.TokenResponse{
-		AccessToken:  tokenRespDTO.AccessToken.Token,
-		TokenType:    tokenRespDTO.AccessToken.TokenType,
-		ExpiresIn:    tokenRespDTO.AccessToken.ExpiresIn,
-		RefreshToken: tokenRespDTO.RefreshToken.Token,
-		Scope:        scopes,
-		IDToken:      tokenRespDTO.IDToken.Token,
+		AccessToken:          tokenRespDTO.AccessToken.Token,
+		TokenType:            tokenRespDTO.AccessToken.TokenType,
+		ExpiresIn:            tokenRespDTO.AccessToken.ExpiresIn,
+		RefreshToken:         tokenRespDTO.RefreshToken.Token,
+		Scope:                scopes,
+		IDToken:              tokenRespDTO.IDToken.Token,
+		AuthorizationDetails: tokenRespDTO.AccessToken.AuthorizationDetails,
 	}
 
 	// For token exchange, determine the issued_token_type from the request.
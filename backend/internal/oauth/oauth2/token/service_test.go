@@ -219,7 +219,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_ScopeValidationError
 	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
 
 	suite.mockScopeValidator.
-		On("ValidateScopes", mock.Anything, "invalid_scope", "test-client-id").
+		On("ValidateScopes", mock.Anything, "invalid_scope", app).
 		Return("", &scope.ScopeError{
 			Error:            "invalid_scope",
 			ErrorDescription: "Invalid scope requested",
@@ -247,7 +247,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_HandleGrantError() {
 		Return(suite.mockGrantHandler, nil)
 
 	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", app).Return("openid", nil)
 	suite.mockGrantHandler.
 		On("HandleGrant", mock.Anything, mock.Anything, app).
 		Return(nil, &model.ErrorResponse{
@@ -277,7 +277,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_HandleGrantServerErr
 		Return(suite.mockGrantHandler, nil)
 
 	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", app).Return("openid", nil)
 	suite.mockGrantHandler.
 		On("HandleGrant", mock.Anything, mock.Anything, app).
 		Return(nil, &model.ErrorResponse{
@@ -308,7 +308,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_Success() {
 		Return(suite.mockGrantHandler, nil)
 
 	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid profile", "test-client-id").
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid profile", app).
 		Return("openid profile", nil)
 
 	tokenRespDTO := &model.TokenResponseDTO{
@@ -334,6 +334,43 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_Success() {
 	assert.Equal(suite.T(), "openid profile", tokenResp.Scope)
 }
 
+func (suite *TokenServiceTestSuite) TestProcessTokenRequest_WithAuthorizationDetails() {
+	req := &model.TokenRequest{
+		ClientID:  "test-client-id",
+		GrantType: string(constants.GrantTypeAuthorizationCode),
+		Code:      "test-code",
+		Scope:     "read",
+	}
+	app := suite.defaultApp()
+
+	suite.mockGrantProvider.ExpectedCalls = nil
+	suite.mockGrantProvider.
+		On("GetGrantHandler", constants.GrantTypeAuthorizationCode).
+		Return(suite.mockGrantHandler, nil)
+
+	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "read", app).Return("read", nil)
+
+	authorizationDetails := []model.AuthorizationDetail{{"type": "payment_initiation"}}
+	tokenRespDTO := &model.TokenResponseDTO{
+		AccessToken: model.TokenDTO{
+			Token:                "access-token-123",
+			TokenType:            "Bearer",
+			ExpiresIn:            3600,
+			Scopes:               []string{"read"},
+			AuthorizationDetails: authorizationDetails,
+		},
+	}
+	suite.mockGrantHandler.On("HandleGrant", mock.Anything, mock.Anything, app).Return(tokenRespDTO, nil)
+
+	svc := suite.newService()
+	tokenResp, errResp := svc.ProcessTokenRequest(context.Background(), req, app)
+
+	assert.Nil(suite.T(), errResp)
+	assert.NotNil(suite.T(), tokenResp)
+	assert.Equal(suite.T(), authorizationDetails, tokenResp.AuthorizationDetails)
+}
+
 func (suite *TokenServiceTestSuite) TestProcessTokenRequest_WithRefreshToken() {
 	req := &model.TokenRequest{
 		ClientID:  "test-client-id",
@@ -361,7 +398,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_WithRefreshToken() {
 		Return(mockRefreshHandler, nil)
 
 	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", app).Return("openid", nil)
 
 	tokenRespDTO := &model.TokenResponseDTO{
 		AccessToken: model.TokenDTO{
@@ -416,7 +453,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_RefreshTokenIssuance
 		Return(mockRefreshHandler, nil)
 
 	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", app).Return("openid", nil)
 
 	tokenRespDTO := &model.TokenResponseDTO{
 		AccessToken: model.TokenDTO{
@@ -472,7 +509,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_RefreshTokenHandlerN
 		Return(nil, errors.New("refresh handler not found"))
 
 	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", app).Return("openid", nil)
 
 	tokenRespDTO := &model.TokenResponseDTO{
 		AccessToken: model.TokenDTO{
@@ -516,7 +553,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_RefreshTokenHandlerC
 		Return(suite.mockGrantHandler, nil)
 
 	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", app).Return("openid", nil)
 
 	tokenRespDTO := &model.TokenResponseDTO{
 		AccessToken: model.TokenDTO{
@@ -554,7 +591,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_TokenExchange() {
 		Return(mockTEHandler, nil)
 
 	mockTEHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "", "test-client-id").Return("", nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "", app).Return("", nil)
 
 	tokenRespDTO := &model.TokenResponseDTO{
 		AccessToken:  model.TokenDTO{Token: "exchanged-token", TokenType: "Bearer", ExpiresIn: 3600},
@@ -591,7 +628,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_TokenExchangeWithJWT
 		Return(mockTEHandler, nil)
 
 	mockTEHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "", "test-client-id").Return("", nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "", app).Return("", nil)
 
 	tokenRespDTO := &model.TokenResponseDTO{
 		AccessToken:  model.TokenDTO{Token: "exchanged-token", TokenType: "Bearer", ExpiresIn: 3600},
@@ -636,7 +673,7 @@ func (suite *TokenServiceTestSuite) TestProcessTokenRequest_WithRefreshToken_Use
 		Return(mockRefreshHandler, nil)
 
 	suite.mockGrantHandler.On("ValidateGrant", mock.Anything, mock.Anything, app).Return(nil)
-	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", "test-client-id").Return("openid", nil)
+	suite.mockScopeValidator.On("ValidateScopes", mock.Anything, "openid", app).Return("openid", nil)
 
 	tokenRespDTO := &model.TokenResponseDTO{
 		AccessToken: model.TokenDTO{
@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package token
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+// queryIncrementTokenRateLimitCounter is the query to increment a token rate limit counter, creating it if it
+// does not exist, and return the updated count.
+var queryIncrementTokenRateLimitCounter = dbmodel.DBQuery{
+	ID: "TKQ-RL-01",
+	Query: `INSERT INTO "TOKEN_RATE_LIMIT_COUNTER" (COUNTER_KEY, REQUEST_COUNT, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+		`VALUES ($1, 1, $2, $3) ON CONFLICT (COUNTER_KEY, DEPLOYMENT_ID) ` +
+		`DO UPDATE SET REQUEST_COUNT = "TOKEN_RATE_LIMIT_COUNTER".REQUEST_COUNT + 1 ` +
+		`RETURNING REQUEST_COUNT`,
+}
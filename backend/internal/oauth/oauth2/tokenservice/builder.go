@@ -21,12 +21,15 @@ package tokenservice
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 )
@@ -72,6 +75,7 @@ func (tb *tokenBuilder) BuildAccessToken(ctx *AccessTokenBuildContext) (*oauth2m
 	}
 
 	tokenConfig := ResolveTokenConfig(ctx.OAuthApp, TokenTypeAccess)
+	tokenConfig.Issuer = config.GetIssuer(ctx.Context)
 
 	userAttributes := tb.buildAccessTokenUserAttributes(ctx.UserAttributes, ctx.OAuthApp)
 	jwtClaims, claimsErr := tb.buildAccessTokenClaims(ctx, userAttributes)
@@ -80,16 +84,17 @@ func (tb *tokenBuilder) BuildAccessToken(ctx *AccessTokenBuildContext) (*oauth2m
 	}
 
 	tokenDTO := &oauth2model.TokenDTO{
-		TokenType:        constants.TokenTypeBearer,
-		ExpiresIn:        tokenConfig.ValidityPeriod,
-		Scopes:           ctx.Scopes,
-		ClientID:         ctx.ClientID,
-		UserAttributes:   userAttributes,
-		AttributeCacheID: ctx.AttributeCacheID,
-		Subject:          ctx.Subject,
-		Audiences:        ctx.Audiences,
-		ClaimsRequest:    ctx.ClaimsRequest,
-		ClaimsLocales:    ctx.ClaimsLocales,
+		TokenType:            constants.TokenTypeBearer,
+		ExpiresIn:            tokenConfig.ValidityPeriod,
+		Scopes:               ctx.Scopes,
+		ClientID:             ctx.ClientID,
+		UserAttributes:       userAttributes,
+		AttributeCacheID:     ctx.AttributeCacheID,
+		Subject:              ctx.Subject,
+		Audiences:            ctx.Audiences,
+		ClaimsRequest:        ctx.ClaimsRequest,
+		ClaimsLocales:        ctx.ClaimsLocales,
+		AuthorizationDetails: ctx.AuthorizationDetails,
 	}
 
 	token, iat, err := tb.jwtService.GenerateJWT(
@@ -146,11 +151,20 @@ func (tb *tokenBuilder) buildAccessTokenClaims(
 		claims["aci"] = ctx.AttributeCacheID
 	}
 
+	if ctx.SessionID != "" {
+		claims[constants.ClaimSid] = ctx.SessionID
+	}
+
 	if ctx.ActorClaims != nil {
 		actClaim := tb.buildActorClaim(ctx.ActorClaims)
 		claims["act"] = actClaim
 	}
 
+	// Include the granted authorization details (RFC 9396) for the resource servers.
+	if len(ctx.AuthorizationDetails) > 0 {
+		claims[constants.ClaimAuthorizationDetails] = ctx.AuthorizationDetails
+	}
+
 	// Include only userinfo claims request for UserInfo endpoint support
 	if ctx.ClaimsRequest != nil && ctx.ClaimsRequest.UserInfo != nil {
 		userinfoClaims := &oauth2model.ClaimsRequest{
@@ -190,10 +204,12 @@ func (tb *tokenBuilder) buildAccessTokenUserAttributes(
 		attrs = make(map[string]interface{})
 	}
 
-	// Get access token user attributes from config if available
+	// Get access token user attributes and claim mappings from config if available
 	var accessTokenUserAttributes []string
+	var claimMappings map[string]string
 	if oauthApp != nil && oauthApp.Token != nil && oauthApp.Token.AccessToken != nil {
 		accessTokenUserAttributes = oauthApp.Token.AccessToken.UserAttributes
+		claimMappings = oauthApp.Token.AccessToken.ClaimMappings
 	}
 
 	if accessTokenUserAttributes == nil {
@@ -210,6 +226,13 @@ func (tb *tokenBuilder) buildAccessTokenUserAttributes(
 	}
 	// If no filtering configured, return empty attributes
 
+	accessTokenAttributes = applyClaimMappings(accessTokenAttributes, claimMappings)
+
+	// The organization the user logged in to is always included, regardless of the configuration.
+	if orgID, ok := attrs[constants.ClaimOrgID]; ok {
+		accessTokenAttributes[constants.ClaimOrgID] = orgID
+	}
+
 	return accessTokenAttributes
 }
 
@@ -237,14 +260,34 @@ func (tb *tokenBuilder) BuildRefreshToken(ctx *RefreshTokenBuildContext) (*oauth
 	}
 
 	tokenConfig := ResolveTokenConfig(ctx.OAuthApp, TokenTypeRefresh)
+	tokenConfig.Issuer = config.GetIssuer(ctx.Context)
 
 	claims, claimsErr := tb.buildRefreshTokenClaims(ctx)
 	if claimsErr != nil {
 		return nil, fmt.Errorf("failed to build refresh token claims: %w", claimsErr)
 	}
 
+	// A sliding refresh token is valid for the configured validity period from now, but never beyond
+	// the maximum lifetime measured from the start of the refresh token session.
+	validityPeriod := tokenConfig.ValidityPeriod
+	if maxLifetime := ResolveRefreshTokenMaxLifetime(ctx.OAuthApp); maxLifetime > 0 {
+		now := time.Now().Unix()
+		originalIat := ctx.OriginalIssuedAt
+		if originalIat <= 0 {
+			originalIat = now
+		}
+		remaining := originalIat + maxLifetime - now
+		if remaining <= 0 {
+			return nil, fmt.Errorf("refresh token session has exceeded its maximum lifetime")
+		}
+		if remaining < validityPeriod {
+			validityPeriod = remaining
+		}
+		claims["orig_iat"] = originalIat
+	}
+
 	tokenDTO := &oauth2model.TokenDTO{
-		ExpiresIn:     tokenConfig.ValidityPeriod,
+		ExpiresIn:     validityPeriod,
 		Scopes:        ctx.Scopes,
 		ClientID:      ctx.ClientID,
 		Subject:       ctx.AccessTokenSubject,
@@ -258,7 +301,7 @@ func (tb *tokenBuilder) BuildRefreshToken(ctx *RefreshTokenBuildContext) (*oauth
 		resolveContext(ctx.Context),
 		ctx.ClientID,
 		tokenConfig.Issuer,
-		tokenConfig.ValidityPeriod,
+		validityPeriod,
 		claims,
 		jwt.TokenTypeJWT,
 		"",
@@ -306,6 +349,11 @@ func (tb *tokenBuilder) buildRefreshTokenClaims(ctx *RefreshTokenBuildContext) (
 		claims["access_token_claims_locales"] = ctx.ClaimsLocales
 	}
 
+	// Include authorization details if present
+	if len(ctx.AuthorizationDetails) > 0 {
+		claims["access_token_authorization_details"] = ctx.AuthorizationDetails
+	}
+
 	return claims, nil
 }
 
@@ -316,6 +364,7 @@ func (tb *tokenBuilder) BuildIDToken(ctx *IDTokenBuildContext) (*oauth2model.Tok
 	}
 
 	tokenConfig := ResolveTokenConfig(ctx.OAuthApp, TokenTypeID)
+	tokenConfig.Issuer = config.GetIssuer(ctx.Context)
 
 	jwtClaims := tb.buildIDTokenClaims(ctx)
 
@@ -393,7 +442,11 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 	}
 
 	if ctx.CompletedACR != "" {
-		claims["acr"] = ctx.CompletedACR
+		claims[constants.ClaimAcr] = ctx.CompletedACR
+	}
+
+	if amr := strings.Fields(ctx.CompletedAMR); len(amr) > 0 {
+		claims[constants.ClaimAmr] = amr
 	}
 
 	userAttributes := ctx.UserAttributes
@@ -404,10 +457,12 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 	// Get scope claims mapping and allowed user attributes from app config
 	var scopeClaimsMapping map[string][]string
 	var allowedUserAttributes []string
+	var claimMappings map[string]string
 	if ctx.OAuthApp != nil {
 		scopeClaimsMapping = ctx.OAuthApp.ScopeClaims
 		if ctx.OAuthApp.Token != nil && ctx.OAuthApp.Token.IDToken != nil {
 			allowedUserAttributes = ctx.OAuthApp.Token.IDToken.UserAttributes
+			claimMappings = ctx.OAuthApp.Token.IDToken.ClaimMappings
 		}
 	}
 
@@ -423,10 +478,20 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 		scopeClaimsMapping,
 		allowedUserAttributes,
 	)
+	claimData = applyClaimMappings(claimData, claimMappings)
 
 	for key, value := range claimData {
 		claims[key] = value
 	}
 
+	if orgID, ok := userAttributes[constants.ClaimOrgID]; ok {
+		claims[constants.ClaimOrgID] = orgID
+	}
+
+	// Set after merging user attributes to prevent user attributes from overwriting this system claim.
+	if ctx.SessionID != "" {
+		claims[constants.ClaimSid] = ctx.SessionID
+	}
+
 	return claims
 }
@@ -42,6 +42,7 @@ import (
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
@@ -145,6 +146,110 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_Basic() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithSessionID() {
+	ctx := &AccessTokenBuildContext{
+		Subject:        "user123",
+		Audiences:      []string{"app123"},
+		ClientID:       "test-client",
+		Scopes:         []string{"read"},
+		UserAttributes: map[string]interface{}{"sid": "user-attribute"},
+		GrantType:      string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:       suite.oauthApp,
+		SessionID:      "test-session-id",
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["sid"] == "test-session-id"
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithOrganization() {
+	ctx := &AccessTokenBuildContext{
+		Subject:        "user123",
+		Audiences:      []string{"app123"},
+		ClientID:       "test-client",
+		Scopes:         []string{"read"},
+		UserAttributes: map[string]interface{}{constants.ClaimOrgID: "org-123", "email": "user@acme.com"},
+		GrantType:      string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:       suite.oauthApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[constants.ClaimOrgID] == "org-123"
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "org-123", result.UserAttributes[constants.ClaimOrgID])
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithClaimMappings() {
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			AccessToken: &inboundmodel.AccessTokenConfig{
+				ValidityPeriod: 3600,
+				UserAttributes: []string{"name", "groups", "ouName"},
+				ClaimMappings:  map[string]string{"groups": "memberOf", "ouName": "organization"},
+			},
+		},
+	}
+	ctx := &AccessTokenBuildContext{
+		Subject:   "user123",
+		Audiences: []string{"app123"},
+		ClientID:  "test-client",
+		Scopes:    []string{"read"},
+		UserAttributes: map[string]interface{}{
+			"name":   testUserName,
+			"groups": []string{"admins"},
+			"ouName": "Engineering",
+		},
+		GrantType: string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:  oauthApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, hasGroups := claims["groups"]
+			_, hasOUName := claims["ouName"]
+			return claims["name"] == testUserName &&
+				reflect.DeepEqual(claims["memberOf"], []string{"admins"}) &&
+				claims["organization"] == "Engineering" &&
+				!hasGroups && !hasOUName
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Engineering", result.UserAttributes["organization"])
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithActorClaim() {
 	actorClaims := &SubjectTokenClaims{
 		Sub:            "actor123",
@@ -446,6 +551,41 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithClaimsLocal
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithAuthorizationDetails() {
+	authorizationDetails := []oauth2model.AuthorizationDetail{
+		{"type": "payment_initiation", "actions": []interface{}{"initiate"}},
+	}
+	ctx := &AccessTokenBuildContext{
+		Subject:              "user123",
+		Audiences:            []string{"app123"},
+		ClientID:             "test-client",
+		Scopes:               []string{"read"},
+		GrantType:            string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:             suite.oauthApp,
+		AuthorizationDetails: authorizationDetails,
+	}
+
+	expectedToken := testAccessToken
+	expectedIat := time.Now().Unix()
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return reflect.DeepEqual(claims["authorization_details"], authorizationDetails)
+		}), mock.Anything, mock.Anything,
+	).Return(expectedToken, expectedIat, nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), authorizationDetails, result.AuthorizationDetails)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_Basic() {
 	// Create OAuth app with user attributes configured
 	oauthAppWithUserAttrs := &inboundmodel.OAuthClient{
@@ -668,6 +808,103 @@ func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithNilAccessT
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_SlidingExpiration_StartsSession() {
+	slidingApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{
+				ValidityPeriod: 3600, SlidingExpiration: true, MaxLifetime: 7200,
+			},
+		},
+	}
+	ctx := &RefreshTokenBuildContext{
+		ClientID:           "test-client",
+		Scopes:             []string{"read"},
+		GrantType:          string(constants.GrantTypeAuthorizationCode),
+		AccessTokenSubject: "user123",
+		OAuthApp:           slidingApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"test-client",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, ok := claims["orig_iat"].(int64)
+			return ok
+		}), mock.Anything, mock.Anything,
+	).Return(testRefreshToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildRefreshToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3600), result.ExpiresIn)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_SlidingExpiration_CappedAtMaxLifetime() {
+	slidingApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{
+				ValidityPeriod: 3600, SlidingExpiration: true, MaxLifetime: 7200,
+			},
+		},
+	}
+	originalIat := time.Now().Unix() - 7000
+	ctx := &RefreshTokenBuildContext{
+		ClientID:           "test-client",
+		Scopes:             []string{"read"},
+		GrantType:          string(constants.GrantTypeAuthorizationCode),
+		AccessTokenSubject: "user123",
+		OAuthApp:           slidingApp,
+		OriginalIssuedAt:   originalIat,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"test-client",
+		"https://thunder.io",
+		mock.MatchedBy(func(validity int64) bool {
+			return validity > 0 && validity <= 200
+		}),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["orig_iat"] == originalIat
+		}), mock.Anything, mock.Anything,
+	).Return(testRefreshToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildRefreshToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.LessOrEqual(suite.T(), result.ExpiresIn, int64(200))
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_SlidingExpiration_MaxLifetimeExceeded() {
+	slidingApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{
+				ValidityPeriod: 3600, SlidingExpiration: true, MaxLifetime: 7200,
+			},
+		},
+	}
+	ctx := &RefreshTokenBuildContext{
+		ClientID:           "test-client",
+		GrantType:          string(constants.GrantTypeAuthorizationCode),
+		AccessTokenSubject: "user123",
+		OAuthApp:           slidingApp,
+		OriginalIssuedAt:   time.Now().Unix() - 8000,
+	}
+
+	result, err := suite.builder.BuildRefreshToken(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT")
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Error_NilContext() {
 	result, err := suite.builder.BuildRefreshToken(nil)
 
@@ -750,6 +987,35 @@ func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithClaimsLoca
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_WithAuthorizationDetails() {
+	authorizationDetails := []oauth2model.AuthorizationDetail{{"type": "account_information"}}
+	ctx := &RefreshTokenBuildContext{
+		ClientID:             "test-client",
+		Scopes:               []string{"read"},
+		GrantType:            string(constants.GrantTypeAuthorizationCode),
+		AccessTokenSubject:   "user123",
+		AccessTokenAudiences: []string{"app123"},
+		OAuthApp:             suite.oauthApp,
+		AuthorizationDetails: authorizationDetails,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"test-client",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return reflect.DeepEqual(claims["access_token_authorization_details"], authorizationDetails)
+		}), mock.Anything, mock.Anything,
+	).Return(testRefreshToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildRefreshToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 // ============================================================================
 // BuildIDToken Tests - Success Cases
 // ============================================================================
@@ -822,6 +1088,65 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithNonce() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithSessionID() {
+	ctx := &IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "app123",
+		Scopes:         []string{"openid"},
+		UserAttributes: map[string]interface{}{"sub": "user123"},
+		AuthTime:       time.Now().Unix(),
+		OAuthApp:       suite.oauthApp,
+		SessionID:      "test-session-id",
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["sid"] == "test-session-id"
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithACRAndAMR() {
+	ctx := &IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "app123",
+		Scopes:         []string{"openid"},
+		UserAttributes: map[string]interface{}{"sub": "user123"},
+		AuthTime:       time.Now().Unix(),
+		OAuthApp:       suite.oauthApp,
+		CompletedACR:   "urn:thunder:acr:mfa",
+		CompletedAMR:   "pwd otp",
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			amr, ok := claims["amr"].([]string)
+			return claims["acr"] == "urn:thunder:acr:mfa" && ok && len(amr) == 2 &&
+				amr[0] == "pwd" && amr[1] == "otp"
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithoutNonce() {
 	ctx := &IDTokenBuildContext{
 		Subject:        "user123",
@@ -928,6 +1253,49 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithScopeClaims() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithClaimMappings() {
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			IDToken: &inboundmodel.IDTokenConfig{
+				ValidityPeriod: 3600,
+				UserAttributes: []string{"name", "roles"},
+				ClaimMappings:  map[string]string{"roles": "app_roles"},
+			},
+		},
+		ScopeClaims: map[string][]string{
+			"profile": {"name", "roles"},
+		},
+	}
+
+	ctx := &IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "app123",
+		Scopes:         []string{"openid", "profile"},
+		UserAttributes: map[string]interface{}{"name": testUserName, "roles": []string{"editor"}},
+		AuthTime:       time.Now().Unix(),
+		OAuthApp:       oauthApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, hasRoles := claims["roles"]
+			return claims["name"] == testUserName &&
+				reflect.DeepEqual(claims["app_roles"], []string{"editor"}) && !hasRoles
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithStandardOIDCScopes() {
 	oauthAppWithUserAttrs := &inboundmodel.OAuthClient{
 		ClientID: "test-client",
@@ -999,6 +1367,33 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_NoUserAttributes()
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithOrganization() {
+	ctx := &IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "app123",
+		Scopes:         []string{"openid"},
+		UserAttributes: map[string]interface{}{constants.ClaimOrgID: "org-123"},
+		AuthTime:       time.Now().Unix(),
+		OAuthApp:       suite.oauthApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[constants.ClaimOrgID] == "org-123"
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_EmptyUserAttributes() {
 	oauthAppWithEmptyUserAttrs := &inboundmodel.OAuthClient{
 		ClientID: "test-client",
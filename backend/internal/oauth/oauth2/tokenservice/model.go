@@ -48,19 +48,21 @@ type TokenConfig struct {
 // The aud claim is serialized as a JSON array when Audiences has 2+ entries, and as a string
 // when it has a single entry.
 type AccessTokenBuildContext struct {
-	Context          context.Context
-	Subject          string
-	Audiences        []string
-	ClientID         string
-	Scopes           []string
-	UserAttributes   map[string]interface{}
-	AttributeCacheID string
-	GrantType        string
-	OAuthApp         *inboundmodel.OAuthClient
-	ActorClaims      *SubjectTokenClaims
-	ClaimsRequest    *oauth2model.ClaimsRequest
-	ClaimsLocales    string
-	ClientAttributes map[string]interface{}
+	Context              context.Context
+	Subject              string
+	Audiences            []string
+	ClientID             string
+	Scopes               []string
+	UserAttributes       map[string]interface{}
+	AttributeCacheID     string
+	GrantType            string
+	OAuthApp             *inboundmodel.OAuthClient
+	ActorClaims          *SubjectTokenClaims
+	ClaimsRequest        *oauth2model.ClaimsRequest
+	ClaimsLocales        string
+	ClientAttributes     map[string]interface{}
+	SessionID            string
+	AuthorizationDetails []oauth2model.AuthorizationDetail
 }
 
 // RefreshTokenBuildContext contains all the information needed to build a refresh token.
@@ -75,6 +77,10 @@ type RefreshTokenBuildContext struct {
 	OAuthApp             *inboundmodel.OAuthClient
 	ClaimsRequest        *oauth2model.ClaimsRequest
 	ClaimsLocales        string
+	AuthorizationDetails []oauth2model.AuthorizationDetail
+	// OriginalIssuedAt is the time the refresh token session began. It bounds the expiry of sliding
+	// refresh tokens and defaults to the issuance time of the token being built.
+	OriginalIssuedAt int64
 }
 
 // IDTokenBuildContext contains all the information needed to build an ID token (OIDC).
@@ -89,18 +95,32 @@ type IDTokenBuildContext struct {
 	ClaimsRequest  *oauth2model.ClaimsRequest
 	Nonce          string
 	CompletedACR   string
+	// CompletedAMR is the space-separated list of authentication methods used to authenticate the user.
+	CompletedAMR string
+	SessionID    string
 }
 
 // RefreshTokenClaims represents the validated claims from a refresh token.
 type RefreshTokenClaims struct {
-	Sub              string
-	Audiences        []string
-	GrantType        string
-	Scopes           []string
-	AttributeCacheID string
-	Iat              int64
-	ClaimsRequest    *oauth2model.ClaimsRequest
-	ClaimsLocales    string
+	Sub                  string
+	Audiences            []string
+	GrantType            string
+	Scopes               []string
+	AttributeCacheID     string
+	Iat                  int64
+	OriginalIat          int64
+	ClaimsRequest        *oauth2model.ClaimsRequest
+	ClaimsLocales        string
+	AuthorizationDetails []oauth2model.AuthorizationDetail
+}
+
+// IDTokenHintClaims represents the verified claims of an ID token hint that identify the user, the client and
+// the session.
+type IDTokenHintClaims struct {
+	Sub       string
+	Audiences []string
+	Azp       string
+	Sid       string
 }
 
 // SubjectTokenClaims represents the validated claims from a subject token (for token exchange).
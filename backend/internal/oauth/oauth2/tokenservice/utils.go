@@ -82,11 +82,28 @@ func ResolveTokenConfig(oauthApp *inboundmodel.OAuthClient, tokenType TokenType)
 		if conf.OAuth.RefreshToken.ValidityPeriod > 0 {
 			tokenConfig.ValidityPeriod = conf.OAuth.RefreshToken.ValidityPeriod
 		}
+		if oauthApp != nil && oauthApp.Token != nil && oauthApp.Token.RefreshToken != nil {
+			if oauthApp.Token.RefreshToken.ValidityPeriod > 0 {
+				tokenConfig.ValidityPeriod = oauthApp.Token.RefreshToken.ValidityPeriod
+			}
+		}
 	}
 
 	return tokenConfig
 }
 
+// ResolveRefreshTokenMaxLifetime returns the absolute lifetime of a sliding refresh token session for the
+// given application. It returns 0 when sliding refresh token expiration is not enabled.
+func ResolveRefreshTokenMaxLifetime(oauthApp *inboundmodel.OAuthClient) int64 {
+	if oauthApp == nil || oauthApp.Token == nil || oauthApp.Token.RefreshToken == nil {
+		return 0
+	}
+	if !oauthApp.Token.RefreshToken.SlidingExpiration || oauthApp.Token.RefreshToken.MaxLifetime <= 0 {
+		return 0
+	}
+	return oauthApp.Token.RefreshToken.MaxLifetime
+}
+
 // extractStringClaim safely extracts a non-empty string claim from a claims map.
 func extractStringClaim(claims map[string]interface{}, key string) (string, error) {
 	value, ok := claims[key]
@@ -243,9 +260,31 @@ func ExtractUserAttributes(claims map[string]interface{}) map[string]interface{}
 	return userAttributes
 }
 
-// isSelfIssuer reports whether the given issuer is the server's own configured issuer.
+// applyClaimMappings renames the given attributes according to the application's claim mappings.
+// Attributes without a mapping keep their names.
+func applyClaimMappings(attrs map[string]interface{}, mappings map[string]string) map[string]interface{} {
+	if len(mappings) == 0 {
+		return attrs
+	}
+
+	mapped := make(map[string]interface{}, len(attrs))
+	for key, value := range attrs {
+		if claim, ok := mappings[key]; ok {
+			mapped[claim] = value
+			continue
+		}
+		if _, exists := mapped[key]; !exists {
+			mapped[key] = value
+		}
+	}
+
+	return mapped
+}
+
+// isSelfIssuer reports whether the given issuer is the server's own configured issuer or the issuer
+// of one of its custom domains.
 func isSelfIssuer(issuer string) bool {
-	return issuer == config.GetServerRuntime().Config.JWT.Issuer
+	return config.IsServerIssuer(issuer)
 }
 
 // FetchUserAttributes fetches user attributes and merges default claims and groups into the return map.
@@ -214,6 +214,43 @@ func (suite *UtilsTestSuite) TestExtractUserAttributes_NilClaims() {
 	assert.Empty(suite.T(), result)
 }
 
+func (suite *UtilsTestSuite) TestApplyClaimMappings_NoMappings() {
+	attrs := map[string]interface{}{"groups": []string{"admins"}}
+
+	result := applyClaimMappings(attrs, nil)
+
+	assert.Equal(suite.T(), attrs, result)
+}
+
+func (suite *UtilsTestSuite) TestApplyClaimMappings_RenamesMappedAttributes() {
+	attrs := map[string]interface{}{
+		"groups": []string{"admins"},
+		"ouName": "Engineering",
+		"email":  "user@example.com",
+	}
+	mappings := map[string]string{"groups": "memberOf", "ouName": "organization"}
+
+	result := applyClaimMappings(attrs, mappings)
+
+	assert.Equal(suite.T(), map[string]interface{}{
+		"memberOf":     []string{"admins"},
+		"organization": "Engineering",
+		"email":        "user@example.com",
+	}, result)
+}
+
+func (suite *UtilsTestSuite) TestApplyClaimMappings_MappedClaimTakesPrecedence() {
+	attrs := map[string]interface{}{
+		"department": "Sales",
+		"ouName":     "Engineering",
+	}
+	mappings := map[string]string{"ouName": "department"}
+
+	result := applyClaimMappings(attrs, mappings)
+
+	assert.Equal(suite.T(), map[string]interface{}{"department": "Engineering"}, result)
+}
+
 func (suite *UtilsTestSuite) TestextractInt64Claim_WithIntType() {
 	claims := map[string]interface{}{
 		"iat": int(1234567890),
@@ -649,6 +686,34 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithTokenConfig
 	assert.Equal(suite.T(), "https://thunder.io", result.Issuer)
 }
 
+func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithAppValidityPeriod() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{
+			Issuer:         "https://thunder.io",
+			ValidityPeriod: 3600,
+		},
+		OAuth: config.OAuthConfig{
+			RefreshToken: config.RefreshTokenConfig{
+				ValidityPeriod: 86400,
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{ValidityPeriod: 1209600},
+		},
+	}
+
+	result := ResolveTokenConfig(oauthApp, TokenTypeRefresh)
+
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), int64(1209600), result.ValidityPeriod)
+}
+
 func (suite *UtilsTestSuite) TestResolveTokenConfig_AccessToken_WithNilOAuthApp() {
 	config.ResetServerRuntime()
 	testConfig := &config.Config{
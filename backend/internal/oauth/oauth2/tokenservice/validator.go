@@ -20,6 +20,7 @@ package tokenservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"time"
@@ -32,12 +33,22 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 )
 
+var (
+	// ErrInvalidIDTokenHint is returned when an ID token hint cannot be verified or lacks the required claims.
+	ErrInvalidIDTokenHint = errors.New("invalid ID token hint")
+	// ErrIDTokenHintIssuerMismatch is returned when an ID token hint was not issued by this server.
+	ErrIDTokenHintIssuerMismatch = errors.New("the ID token hint was not issued by this server")
+	// ErrIDTokenHintAudienceMismatch is returned when an ID token hint was not issued to the expected client.
+	ErrIDTokenHintAudienceMismatch = errors.New("the ID token hint was not issued to the client")
+)
+
 // TokenValidatorInterface defines the interface for validating tokens.
 type TokenValidatorInterface interface {
-	ValidateAccessToken(token string) (*AccessTokenClaims, error)
-	ValidateRefreshToken(token string, clientID string) (*RefreshTokenClaims, error)
+	ValidateAccessToken(ctx context.Context, token string) (*AccessTokenClaims, error)
+	ValidateRefreshToken(ctx context.Context, token string, clientID string) (*RefreshTokenClaims, error)
 	ValidateSubjectToken(ctx context.Context, token string, oauthApp *inboundmodel.OAuthClient) (
 		*SubjectTokenClaims, error)
+	ValidateIDTokenHint(ctx context.Context, token string, clientID string) (*IDTokenHintClaims, error)
 }
 
 // TokenValidator implements TokenValidatorInterface.
@@ -54,11 +65,11 @@ func newTokenValidator(jwtService jwt.JWTServiceInterface, idpService idp.IDPSer
 	}
 }
 
-// ValidateAccessToken validates an access token and extracts the claims.
-func (tv *tokenValidator) ValidateAccessToken(token string) (*AccessTokenClaims, error) {
+// ValidateAccessToken validates an access token and extracts the claims. Tokens issued for an isolated custom
+// domain are only accepted on that domain.
+func (tv *tokenValidator) ValidateAccessToken(ctx context.Context, token string) (*AccessTokenClaims, error) {
 	// Verify signature and standard claims.
-	expectedIss := config.GetServerRuntime().Config.JWT.Issuer
-	if err := tv.jwtService.VerifyJWT(token, "", expectedIss); err != nil {
+	if err := tv.jwtService.VerifyJWT(token, "", expectedAccessTokenIssuer(token)); err != nil {
 		return nil, fmt.Errorf("access token verification failed: %v", err.Error)
 	}
 
@@ -89,6 +100,9 @@ func (tv *tokenValidator) ValidateAccessToken(token string) (*AccessTokenClaims,
 	if issErr != nil {
 		return nil, fmt.Errorf("missing required 'iss' claim in access token")
 	}
+	if !config.IsIssuerAccepted(ctx, iss) {
+		return nil, fmt.Errorf("access token issuer %q is not accepted for this request", iss)
+	}
 	auds, audErr := extractAudiences(claims)
 	if audErr != nil {
 		return nil, fmt.Errorf("missing required 'aud' claim in access token")
@@ -112,8 +126,23 @@ func (tv *tokenValidator) ValidateAccessToken(token string) (*AccessTokenClaims,
 	}, nil
 }
 
-// ValidateRefreshToken validates a refresh token and extracts the claims.
-func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*RefreshTokenClaims, error) {
+// expectedAccessTokenIssuer returns the issuer an access token is expected to carry. Tokens issued on
+// a custom domain carry the issuer of that domain; any other token is expected to carry the configured
+// JWT issuer.
+func expectedAccessTokenIssuer(token string) string {
+	if claims, err := jwt.DecodeJWTPayload(token); err == nil {
+		if iss, _ := claims["iss"].(string); iss != "" && isSelfIssuer(iss) {
+			return iss
+		}
+	}
+	return config.GetServerRuntime().Config.JWT.Issuer
+}
+
+// ValidateRefreshToken validates a refresh token and extracts the claims. Tokens issued for an isolated custom
+// domain are only accepted on that domain.
+func (tv *tokenValidator) ValidateRefreshToken(
+	ctx context.Context, token string, clientID string,
+) (*RefreshTokenClaims, error) {
 	if err := tv.jwtService.VerifyJWT(token, "", ""); err != nil {
 		return nil, fmt.Errorf("invalid refresh token: %v", err.Error)
 	}
@@ -123,6 +152,10 @@ func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*
 		return nil, fmt.Errorf("failed to decode refresh token: %w", err)
 	}
 
+	if iss, _ := extractStringClaim(claims, "iss"); !config.IsIssuerAccepted(ctx, iss) {
+		return nil, fmt.Errorf("refresh token issuer %q is not accepted for this request", iss)
+	}
+
 	if err := tv.validateOAuth2RefreshClaims(claims, clientID); err != nil {
 		return nil, err
 	}
@@ -132,6 +165,10 @@ func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*
 	audiences := extractStringSliceClaim(claims, "access_token_aud")
 	grantType, _ := extractStringClaim(claims, "grant_type")
 	iat, _ := extractInt64Claim(claims, "iat")
+	originalIat, origErr := extractInt64Claim(claims, "orig_iat")
+	if origErr != nil {
+		originalIat = iat
+	}
 	scopes := extractScopesFromClaims(claims, false)
 	attributeCacheID, _ := extractStringClaim(claims, "aci")
 
@@ -149,19 +186,55 @@ func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*
 	// Extract claims_locales if present
 	claimsLocales, _ := extractStringClaim(claims, "access_token_claims_locales")
 
+	// Extract authorization details if present
+	authorizationDetails := utils.ConvertToAuthorizationDetails(claims["access_token_authorization_details"])
+
 	// Extract user type and organizational unit details if present
 	return &RefreshTokenClaims{
-		Sub:              sub,
-		Audiences:        audiences,
-		GrantType:        grantType,
-		Scopes:           scopes,
-		AttributeCacheID: attributeCacheID,
-		Iat:              iat,
-		ClaimsRequest:    claimsRequest,
-		ClaimsLocales:    claimsLocales,
+		Sub:                  sub,
+		Audiences:            audiences,
+		GrantType:            grantType,
+		Scopes:               scopes,
+		AttributeCacheID:     attributeCacheID,
+		Iat:                  iat,
+		OriginalIat:          originalIat,
+		ClaimsRequest:        claimsRequest,
+		ClaimsLocales:        claimsLocales,
+		AuthorizationDetails: authorizationDetails,
 	}, nil
 }
 
+// ValidateIDTokenHint verifies that an ID token hint was signed and issued by this server and extracts its claims.
+// An expired token is accepted, since the hint only identifies the user, the client and the session. When a
+// client ID is given, the token must have been issued to that client.
+func (tv *tokenValidator) ValidateIDTokenHint(
+	ctx context.Context, token string, clientID string,
+) (*IDTokenHintClaims, error) {
+	if svcErr := tv.jwtService.VerifyJWTSignature(token); svcErr != nil {
+		return nil, fmt.Errorf("%w: signature verification failed: %s", ErrInvalidIDTokenHint, svcErr.Code)
+	}
+	claims, err := jwt.DecodeJWTPayload(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDTokenHint, err)
+	}
+	if iss, _ := claims["iss"].(string); iss != config.GetIssuer(ctx) {
+		return nil, ErrIDTokenHintIssuerMismatch
+	}
+
+	hint := &IDTokenHintClaims{Audiences: extractStringSliceClaim(claims, "aud")}
+	hint.Sub, _ = extractStringClaim(claims, "sub")
+	hint.Azp, _ = extractStringClaim(claims, "azp")
+	hint.Sid, _ = extractStringClaim(claims, "sid")
+	if hint.Sub == "" || len(hint.Audiences) == 0 {
+		return nil, fmt.Errorf("%w: missing subject or audience", ErrInvalidIDTokenHint)
+	}
+	if clientID != "" && !slices.Contains(hint.Audiences, clientID) {
+		return nil, ErrIDTokenHintAudienceMismatch
+	}
+
+	return hint, nil
+}
+
 // ValidateSubjectToken validates a subject token for token exchange.
 func (tv *tokenValidator) ValidateSubjectToken(
 	ctx context.Context,
@@ -197,15 +270,14 @@ func (tv *tokenValidator) ValidateSubjectToken(
 		return nil, fmt.Errorf("invalid subject token signature: %v", svcErr.Error)
 	}
 
-	// Validate that the external token's audience contains this server's issuer.
-	serverIssuer := config.GetServerRuntime().Config.JWT.Issuer
+	// Validate that the external token's audience contains one of this server's issuers.
 	auds, audErr := extractAudiences(claims)
 	if audErr != nil {
 		return nil, fmt.Errorf("failed to extract audience from external token: %w", audErr)
 	}
-	if !slices.Contains(auds, serverIssuer) {
-		return nil, fmt.Errorf(
-			"external token audience does not contain expected server issuer %q", serverIssuer)
+	if !slices.ContainsFunc(auds, isSelfIssuer) {
+		return nil, fmt.Errorf("external token audience does not contain expected server issuer %q",
+			config.GetIssuer(ctx))
 	}
 
 	return tv.extractSubjectTokenClaims(token, iss, claims, oauthApp)
@@ -35,6 +35,7 @@ import (
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/system/cmodels"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
@@ -116,6 +117,85 @@ func (suite *TokenValidatorTestSuite) getDefaultAudience() string {
 // ValidateSubjectToken Tests - Success Cases
 // ============================================================================
 
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint() {
+	testCases := []struct {
+		name        string
+		claims      map[string]interface{}
+		clientID    string
+		expected    *IDTokenHintClaims
+		expectedErr error
+	}{
+		{
+			name: "Success",
+			claims: map[string]interface{}{
+				"iss": "https://thunder.io", "sub": "user-1", "aud": testClientID, "sid": "session-1",
+			},
+			clientID: testClientID,
+			expected: &IDTokenHintClaims{Sub: "user-1", Audiences: []string{testClientID}, Sid: "session-1"},
+		},
+		{
+			name: "SuccessWithoutClient",
+			claims: map[string]interface{}{
+				"iss": "https://thunder.io", "sub": "user-1", "aud": []interface{}{testClientID, "other"},
+				"azp": testClientID, "exp": time.Now().Add(-time.Hour).Unix(),
+			},
+			expected: &IDTokenHintClaims{Sub: "user-1", Audiences: []string{testClientID, "other"}, Azp: testClientID},
+		},
+		{
+			name: "OtherIssuer",
+			claims: map[string]interface{}{
+				"iss": "https://other.example.com", "sub": "user-1", "aud": testClientID,
+			},
+			clientID:    testClientID,
+			expectedErr: ErrIDTokenHintIssuerMismatch,
+		},
+		{
+			name:        "OtherClient",
+			claims:      map[string]interface{}{"iss": "https://thunder.io", "sub": "user-1", "aud": "other"},
+			clientID:    testClientID,
+			expectedErr: ErrIDTokenHintAudienceMismatch,
+		},
+		{
+			name:        "MissingSubject",
+			claims:      map[string]interface{}{"iss": "https://thunder.io", "aud": testClientID},
+			clientID:    testClientID,
+			expectedErr: ErrInvalidIDTokenHint,
+		},
+		{
+			name:        "MissingAudience",
+			claims:      map[string]interface{}{"iss": "https://thunder.io", "sub": "user-1"},
+			expectedErr: ErrInvalidIDTokenHint,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			token := suite.createTestJWT(tc.claims)
+			suite.mockJWTService.On("VerifyJWTSignature", token).Return(nil).Once()
+
+			hint, err := suite.validator.ValidateIDTokenHint(context.Background(), token, tc.clientID)
+
+			if tc.expectedErr != nil {
+				suite.Nil(hint)
+				suite.ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.NoError(err)
+			suite.Equal(tc.expected, hint)
+		})
+	}
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateIDTokenHint_InvalidSignature() {
+	token := suite.createTestJWT(map[string]interface{}{"iss": "https://thunder.io", "sub": "user-1"})
+	suite.mockJWTService.On("VerifyJWTSignature", token).Return(&serviceerror.InternalServerError).Once()
+
+	hint, err := suite.validator.ValidateIDTokenHint(context.Background(), token, testClientID)
+
+	suite.Nil(hint)
+	suite.ErrorIs(err, ErrInvalidIDTokenHint)
+}
+
 func (suite *TokenValidatorTestSuite) TestValidateSubjectToken_Success_BasicToken() {
 	defaultAudience := suite.getDefaultAudience()
 
@@ -705,7 +785,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_Basic() {
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
@@ -714,6 +794,32 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_Basic() {
 	assert.Equal(suite.T(), "authorization_code", result.GrantType)
 	assert.Equal(suite.T(), []string{"read", "write"}, result.Scopes)
 	assert.Equal(suite.T(), "test-cache-id", result.AttributeCacheID)
+	assert.Equal(suite.T(), now, result.OriginalIat)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithOriginalIssuedAt() {
+	now := time.Now().Unix()
+	claims := map[string]interface{}{
+		"sub":              "test-client",
+		"iss":              "https://thunder.io",
+		"aud":              "test-client",
+		"exp":              float64(now + 3600),
+		"iat":              float64(now),
+		"orig_iat":         float64(now - 86400),
+		"access_token_sub": "user123",
+		"access_token_aud": testAppID,
+		"grant_type":       "authorization_code",
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), now, result.Iat)
+	assert.Equal(suite.T(), now-86400, result.OriginalIat)
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
@@ -734,7 +840,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithoutUs
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
@@ -758,7 +864,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_EmptyScop
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
@@ -782,7 +888,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_InvalidSign
 			},
 		})
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -806,7 +912,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_InvalidJWTF
 			},
 		})
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -832,7 +938,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_DecodeFailu
 			},
 		})
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -857,7 +963,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_MissingIa
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
@@ -893,7 +999,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_ExpiredToke
 			},
 		})
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -930,7 +1036,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_NotYetValid
 			},
 		})
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -954,7 +1060,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_MissingSub(
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -978,7 +1084,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_WrongClient
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1002,7 +1108,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_MissingAcce
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1026,7 +1132,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_MissingAcce
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1050,7 +1156,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_MissingGran
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1077,7 +1183,7 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithClaim
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
 
-	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
@@ -1090,6 +1196,35 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithClaim
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_WithAuthorizationDetails() {
+	now := time.Now().Unix()
+	claims := map[string]interface{}{
+		"sub":              "test-client",
+		"iss":              "https://thunder.io",
+		"aud":              "test-client",
+		"exp":              float64(now + 3600),
+		"iat":              float64(now),
+		"scope":            "read",
+		"access_token_sub": "user123",
+		"access_token_aud": testAppID,
+		"grant_type":       "authorization_code",
+		"access_token_authorization_details": []interface{}{
+			map[string]interface{}{"type": "payment_initiation"},
+		},
+	}
+	token := suite.createTestJWT(claims)
+
+	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Len(suite.T(), result.AuthorizationDetails, 1)
+	assert.Equal(suite.T(), "payment_initiation", result.AuthorizationDetails[0].GetType())
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 // ============================================================================
 // ValidateAuthAssertion Tests - Success Cases
 // ============================================================================
@@ -1727,7 +1862,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Success() {
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
@@ -1753,7 +1888,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Success_MinClaims(
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
@@ -1766,6 +1901,141 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Success_MinClaims(
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Success_CustomDomainIssuer() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{Issuer: "https://thunder.io"},
+		CustomDomains: []config.CustomDomainConfig{
+			{Host: "login.acme.io", Issuer: "https://login.acme.io"},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://login.acme.io",
+		"aud":       "test-app",
+		"client_id": "test-client",
+	}
+	token := suite.createTestAccessToken(claims)
+
+	suite.mockJWTService.On("VerifyJWT", token, "", "https://login.acme.io").Return(nil)
+
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "https://login.acme.io", result.Iss)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
+// setIsolatedDomain configures a custom domain whose tokens are isolated from the other issuers.
+func (suite *TokenValidatorTestSuite) setIsolatedDomain() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{Issuer: "https://thunder.io"},
+		CustomDomains: []config.CustomDomainConfig{
+			{Host: "login.acme.io", Issuer: "https://login.acme.io", OUID: "acme-ou"},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_IsolatedIssuerOnOtherDomain() {
+	suite.setIsolatedDomain()
+	token := suite.createTestAccessToken(map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://login.acme.io",
+		"aud":       "test-app",
+		"client_id": "test-client",
+	})
+	suite.mockJWTService.On("VerifyJWT", token, "", "https://login.acme.io").Return(nil)
+
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "is not accepted")
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_ServerIssuerOnIsolatedDomain() {
+	suite.setIsolatedDomain()
+	token := suite.createTestAccessToken(map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://thunder.io",
+		"aud":       "test-app",
+		"client_id": "test-client",
+	})
+	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
+
+	ctx := sysContext.WithCustomDomain(context.Background(), "login.acme.io")
+	result, err := suite.validator.ValidateAccessToken(ctx, token)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Success_IsolatedIssuerOnOwnDomain() {
+	suite.setIsolatedDomain()
+	token := suite.createTestAccessToken(map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://login.acme.io",
+		"aud":       "test-app",
+		"client_id": "test-client",
+	})
+	suite.mockJWTService.On("VerifyJWT", token, "", "https://login.acme.io").Return(nil)
+
+	ctx := sysContext.WithCustomDomain(context.Background(), "login.acme.io")
+	result, err := suite.validator.ValidateAccessToken(ctx, token)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "https://login.acme.io", result.Iss)
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_IsolatedIssuerOnOtherDomain() {
+	suite.setIsolatedDomain()
+	now := time.Now().Unix()
+	token := suite.createTestJWT(map[string]interface{}{
+		"sub":              "test-client",
+		"iss":              "https://login.acme.io",
+		"aud":              "test-client",
+		"exp":              float64(now + 3600),
+		"iat":              float64(now),
+		"access_token_sub": "user123",
+		"access_token_aud": testAppID,
+		"grant_type":       "authorization_code",
+	})
+	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(nil)
+
+	result, err := suite.validator.ValidateRefreshToken(context.Background(), token, "test-client")
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Contains(suite.T(), err.Error(), "is not accepted")
+}
+
+func (suite *TokenValidatorTestSuite) TestValidateAccessToken_UnknownIssuerVerifiedAgainstServerIssuer() {
+	claims := map[string]interface{}{
+		"sub":       "user123",
+		"iss":       "https://evil.example.com",
+		"aud":       "test-app",
+		"client_id": "test-client",
+	}
+	token := suite.createTestAccessToken(claims)
+
+	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").
+		Return(&serviceerror.ServiceError{
+			Type:  serviceerror.ServerErrorType,
+			Code:  "JWT-1004",
+			Error: core.I18nMessage{Key: "error.test.invalid_issuer", DefaultValue: "Invalid issuer"},
+		})
+
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_VerifyFails() {
 	token := "invalid.token.signature"
 
@@ -1776,7 +2046,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_VerifyFails(
 			Error: core.I18nMessage{Key: "error.test.invalid_token_signature", DefaultValue: "Invalid token signature"},
 		})
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1795,7 +2065,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_WrongTyp() {
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1821,7 +2091,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_MissingTyp()
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1839,7 +2109,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_MissingSub()
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1857,7 +2127,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_MissingAud()
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1875,7 +2145,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_MissingIss()
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1893,7 +2163,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_MissingClien
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1912,7 +2182,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_EmptySub() {
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -1931,7 +2201,7 @@ func (suite *TokenValidatorTestSuite) TestValidateAccessToken_Error_EmptyClientI
 
 	suite.mockJWTService.On("VerifyJWT", token, "", "https://thunder.io").Return(nil)
 
-	result, err := suite.validator.ValidateAccessToken(token)
+	result, err := suite.validator.ValidateAccessToken(context.Background(), token)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -94,7 +94,7 @@ func (s *userInfoService) GetUserInfo(
 		return nil, &errorInvalidAccessToken
 	}
 
-	accessTokenClaims, err := s.tokenValidator.ValidateAccessToken(accessToken)
+	accessTokenClaims, err := s.tokenValidator.ValidateAccessToken(ctx, accessToken)
 	if err != nil {
 		s.logger.Debug("Failed to verify access token", log.Error(err))
 		return nil, &errorInvalidAccessToken
@@ -247,7 +247,7 @@ func (s *userInfoService) generateJWSUserInfo(
 
 	runtime := config.GetServerRuntime()
 
-	issuer := runtime.Config.JWT.Issuer
+	issuer := config.GetIssuer(ctx)
 	validity := runtime.Config.JWT.ValidityPeriod
 
 	response["aud"] = clientID
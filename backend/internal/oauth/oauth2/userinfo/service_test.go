@@ -114,7 +114,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_EmptyToken() {
 // TestGetUserInfo_InvalidTokenSignature tests that invalid token signature returns an error
 func (s *UserInfoServiceTestSuite) TestGetUserInfo_InvalidTokenSignature() {
 	token := "invalid.token.signature"
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		nil, errors.New("invalid signature"))
 
 	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
@@ -152,7 +152,7 @@ func (s *UserInfoServiceTestSuite) createToken(claims map[string]interface{}) st
 func (s *UserInfoServiceTestSuite) TestGetUserInfo_InvalidTokenFormat() {
 	// nolint:gosec // This is a test token, not a real credential
 	invalidToken := "not.a.valid.jwt"
-	s.mockTokenValidator.On("ValidateAccessToken", invalidToken).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, invalidToken).Return(
 		nil, errors.New("invalid token format"))
 
 	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), invalidToken)
@@ -171,7 +171,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_NoScopes() {
 	}
 	token := s.createToken(claims)
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 
 	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
@@ -191,7 +191,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_NoScopesEmptyScopeString() {
 	}
 	token := s.createToken(claims)
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 
 	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
@@ -212,7 +212,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_ErrorFetchingUserAttributes()
 	}
 	token := s.createToken(claims)
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-err-123").Return(
 		nil, &serviceerror.InternalServerError)
@@ -242,7 +242,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_ErrorFetchingGroups() {
 			UserAttributes: []string{"name", constants.UserAttributeGroups},
 		},
 	}
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-groups-123").Return(
 		nil, &serviceerror.InternalServerError)
@@ -285,7 +285,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_StandardScopes() {
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-std-123").Return(
 		&attributecache.AttributeCache{ID: "cache-std-123", Attributes: userAttrs}, nil)
@@ -334,7 +334,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithGroups() {
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-grp-123").Return(
 		&attributecache.AttributeCache{ID: "cache-grp-123", Attributes: userAttrs}, nil)
@@ -390,7 +390,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithScopeClaimsMappin
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-scope-123").Return(
 		&attributecache.AttributeCache{ID: "cache-scope-123", Attributes: userAttrs}, nil)
@@ -426,7 +426,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_NoAppConfig() {
 		"email": "john@example.com",
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-noapp-123").Return(
 		&attributecache.AttributeCache{ID: "cache-noapp-123", Attributes: userAttrs}, nil)
@@ -458,7 +458,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_AppNotFound_ReturnsInvalidTok
 
 	userAttrs := map[string]interface{}{}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-anf-123").Return(
 		&attributecache.AttributeCache{ID: "cache-anf-123", Attributes: userAttrs}, nil)
@@ -504,7 +504,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_GroupsNotInAllowedAtt
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-gnaa-123").Return(
 		&attributecache.AttributeCache{ID: "cache-gnaa-123", Attributes: userAttrs}, nil)
@@ -546,7 +546,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_EmptyUserAttributes()
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, "client123").Return(oauthApp, nil)
 
@@ -572,7 +572,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_ScopeAsNonString() {
 	}
 	token := s.createToken(claims)
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 
 	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
@@ -592,7 +592,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_ScopeExistsButNotString() {
 	}
 	token := s.createToken(claims)
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 
 	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
@@ -618,7 +618,7 @@ func (s *UserInfoServiceTestSuite) testGetUserInfoInvalidClientID(clientIDValue
 		"name": "John Doe",
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-inv-cid-123").Return(
 		&attributecache.AttributeCache{ID: "cache-inv-cid-123", Attributes: userAttrs}, nil)
@@ -661,7 +661,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_GroupsWithNilOAuthApp() {
 		"name": "John Doe",
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-nil-app-123").Return(
 		&attributecache.AttributeCache{ID: "cache-nil-app-123", Attributes: userAttrs}, nil)
@@ -696,7 +696,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_GroupsWithNilToken() {
 		Token: nil, // Token is nil
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-nil-tok-123").Return(
 		&attributecache.AttributeCache{ID: "cache-nil-tok-123", Attributes: userAttrs}, nil)
@@ -737,7 +737,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_GroupsWithNilIDToken() {
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-nil-idt-123").Return(
 		&attributecache.AttributeCache{ID: "cache-nil-idt-123", Attributes: userAttrs}, nil)
@@ -786,7 +786,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_GroupsWithEmptyGroups() {
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-eg-123").Return(
 		&attributecache.AttributeCache{ID: "cache-eg-123", Attributes: userAttrs}, nil)
@@ -818,7 +818,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_ClientCredentialsGrant_Reject
 	}
 	token := s.createToken(claims)
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "client123", Claims: claims}, nil)
 
 	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
@@ -860,7 +860,7 @@ func (s *UserInfoServiceTestSuite) testGetUserInfoAllowedGrantType(grantTypeValu
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-agt-123").Return(
 		&attributecache.AttributeCache{ID: "cache-agt-123", Attributes: userAttrs}, nil)
@@ -914,7 +914,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_MissingOpenIDScope_WithOtherS
 	}
 	token := s.createToken(claims)
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 
 	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
@@ -935,7 +935,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_OpenIDScope_CaseSensitive() {
 	}
 	token := s.createToken(claims)
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 
 	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
@@ -956,7 +956,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_OnlyOpenIDScope_Success() {
 	}
 	token := s.createToken(claims)
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-oid-only-123").Return(
 		&attributecache.AttributeCache{ID: "cache-oid-only-123", Attributes: map[string]interface{}{}}, nil)
@@ -995,7 +995,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_OpenIDScope_InMiddleOfScopeSt
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-mid-123").Return(
 		&attributecache.AttributeCache{ID: "cache-mid-123", Attributes: userAttrs}, nil)
@@ -1035,7 +1035,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_OpenIDScope_AtEnd() {
 		},
 	}
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-end-123").Return(
 		&attributecache.AttributeCache{ID: "cache-end-123", Attributes: userAttrs}, nil)
@@ -1083,7 +1083,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_JWS_ResponseType() {
 	issuer := "test-issuer"
 
 	// JWT verification
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 
 	// Attribute cache fetch
@@ -1146,7 +1146,7 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_JWS_GenerateJWTFailure() {
 	}
 	issuer := "test-issuer"
 
-	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+	s.mockTokenValidator.On("ValidateAccessToken", mock.Anything, token).Return(
 		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
 
 	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-jws-fail-123").Return(
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
@@ -74,6 +75,10 @@ const (
 	// OAuth2AuthorizationCodeLength specifies the byte length for OAuth authorization codes (20 bytes = 160 bits)
 	// This requires guessing probability ≤ 2^(-128) and recommends ≤ 2^(-160)
 	OAuth2AuthorizationCodeLength = 20
+
+	// OAuth2RegistrationAccessTokenLength specifies the byte length for DCR registration access tokens
+	// (32 bytes = 256 bits). These tokens are long-lived credentials, so they get the same entropy as secrets.
+	OAuth2RegistrationAccessTokenLength = 32
 )
 
 // OAuth2CredentialType represents the type of OAuth 2.0 credential to generate
@@ -88,6 +93,9 @@ const (
 
 	// AuthorizationCodeCredential represents an OAuth 2.0 authorization code
 	AuthorizationCodeCredential OAuth2CredentialType = "authorization code"
+
+	// RegistrationAccessTokenCredential represents an RFC 7592 registration access token
+	RegistrationAccessTokenCredential OAuth2CredentialType = "registration access token"
 )
 
 // generateOAuth2Credential generates a base64url-encoded OAuth 2.0 credential.
@@ -103,6 +111,8 @@ func generateOAuth2Credential(credentialType OAuth2CredentialType) (string, erro
 		length = OAuth2ClientSecretLength
 	case AuthorizationCodeCredential:
 		length = OAuth2AuthorizationCodeLength
+	case RegistrationAccessTokenCredential:
+		length = OAuth2RegistrationAccessTokenLength
 	default:
 		return "", fmt.Errorf("unsupported credential type: %s", credentialType)
 	}
@@ -132,6 +142,11 @@ func GenerateAuthorizationCode() (string, error) {
 	return generateOAuth2Credential(AuthorizationCodeCredential)
 }
 
+// GenerateRegistrationAccessToken generates a cryptographically secure RFC 7592 registration access token.
+func GenerateRegistrationAccessToken() (string, error) {
+	return generateOAuth2Credential(RegistrationAccessTokenCredential)
+}
+
 // SeparateOIDCAndNonOIDCScopes separates the given scopes into OIDC and non-OIDC scopes.
 // A scope is treated as OIDC if it is a standard OIDC scope or is present in the app's
 // custom scope_claims mapping.
@@ -235,3 +250,92 @@ func SerializeClaimsRequest(cr *model.ClaimsRequest) (string, error) {
 
 	return string(data), nil
 }
+
+// ParseAuthorizationDetails parses the authorization_details parameter (RFC 9396) into a list of
+// authorization details. Returns nil if the input is empty. When supportedTypes is non-empty, entries
+// of any other type are rejected.
+func ParseAuthorizationDetails(
+	authorizationDetailsParam string, supportedTypes []string,
+) ([]model.AuthorizationDetail, error) {
+	if authorizationDetailsParam == "" {
+		return nil, nil
+	}
+
+	var authorizationDetails []model.AuthorizationDetail
+	if err := json.Unmarshal([]byte(authorizationDetailsParam), &authorizationDetails); err != nil {
+		return nil, fmt.Errorf("invalid authorization_details parameter: %w", err)
+	}
+	if len(authorizationDetails) == 0 {
+		return nil, fmt.Errorf("invalid authorization_details parameter: at least one entry is required")
+	}
+
+	for i, detail := range authorizationDetails {
+		if err := validateAuthorizationDetail(detail, supportedTypes); err != nil {
+			return nil, fmt.Errorf("invalid authorization_details parameter: entry %d %w", i, err)
+		}
+	}
+
+	return authorizationDetails, nil
+}
+
+// validateAuthorizationDetail validates an authorization detail against the common data fields
+// defined in RFC 9396 section 2.2.
+func validateAuthorizationDetail(detail model.AuthorizationDetail, supportedTypes []string) error {
+	if detail == nil {
+		return fmt.Errorf("must be a JSON object")
+	}
+
+	detailType := detail.GetType()
+	if detailType == "" {
+		return fmt.Errorf("is missing the 'type' field")
+	}
+	if len(supportedTypes) > 0 && !slices.Contains(supportedTypes, detailType) {
+		return fmt.Errorf("has an unsupported type '%s'", detailType)
+	}
+
+	for _, field := range []string{"locations", "actions", "datatypes", "privileges"} {
+		value, ok := detail[field]
+		if !ok {
+			continue
+		}
+		values, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("has a '%s' field that is not an array of strings", field)
+		}
+		for _, v := range values {
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("has a '%s' field that is not an array of strings", field)
+			}
+		}
+	}
+	if identifier, ok := detail["identifier"]; ok {
+		if _, ok := identifier.(string); !ok {
+			return fmt.Errorf("has an 'identifier' field that is not a string")
+		}
+	}
+
+	return nil
+}
+
+// ConvertToAuthorizationDetails converts authorization details decoded from a generic JSON document, such
+// as a JWT payload or a stored grant, back into a list of authorization details.
+func ConvertToAuthorizationDetails(data interface{}) []model.AuthorizationDetail {
+	rawDetails, ok := data.([]interface{})
+	if !ok {
+		if details, ok := data.([]model.AuthorizationDetail); ok {
+			return details
+		}
+		return nil
+	}
+
+	authorizationDetails := make([]model.AuthorizationDetail, 0, len(rawDetails))
+	for _, raw := range rawDetails {
+		if detail, ok := raw.(map[string]interface{}); ok {
+			authorizationDetails = append(authorizationDetails, detail)
+		}
+	}
+	if len(authorizationDetails) == 0 {
+		return nil
+	}
+	return authorizationDetails
+}
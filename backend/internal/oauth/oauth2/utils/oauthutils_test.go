@@ -503,6 +503,22 @@ func (suite *OAuth2UtilsTestSuite) TestGenerateAuthorizationCode() {
 		"Decoded authorization code should have the expected byte length")
 }
 
+func (suite *OAuth2UtilsTestSuite) TestGenerateRegistrationAccessToken() {
+	token, err := GenerateRegistrationAccessToken()
+
+	assert.NoError(suite.T(), err, "GenerateRegistrationAccessToken should not return an error")
+	assert.NotEmpty(suite.T(), token, "Generated registration access token should not be empty")
+
+	base64URLPattern := regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	assert.True(suite.T(), base64URLPattern.MatchString(token),
+		"Registration access token should contain only base64url characters (A-Z, a-z, 0-9, -, _)")
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	assert.NoError(suite.T(), err, "Generated registration access token should be valid base64url")
+	assert.Equal(suite.T(), OAuth2RegistrationAccessTokenLength, len(decoded),
+		"Decoded registration access token should have the expected byte length")
+}
+
 func (suite *OAuth2UtilsTestSuite) TestGenerateAuthorizationCodeUniqueness() {
 	codes := make(map[string]bool)
 
@@ -1030,3 +1046,75 @@ func (suite *OAuth2UtilsTestSuite) TestParseClaimsRequest_NullClaimRequest() {
 	assert.Nil(suite.T(), claimsRequest.UserInfo["email"])
 	assert.Nil(suite.T(), claimsRequest.UserInfo["name"])
 }
+
+// Authorization details parsing tests
+
+func (suite *OAuth2UtilsTestSuite) TestParseAuthorizationDetails_Valid() {
+	param := `[{"type":"payment_initiation","actions":["initiate"],"locations":["https://bank.example.com"],` +
+		`"instructedAmount":{"currency":"EUR","amount":"123.50"}}]`
+
+	details, err := ParseAuthorizationDetails(param, nil)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), details, 1)
+	assert.Equal(suite.T(), "payment_initiation", details[0].GetType())
+	assert.Equal(suite.T(), []interface{}{"initiate"}, details[0]["actions"])
+	assert.NotNil(suite.T(), details[0]["instructedAmount"])
+}
+
+func (suite *OAuth2UtilsTestSuite) TestParseAuthorizationDetails_EmptyString() {
+	details, err := ParseAuthorizationDetails("", nil)
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), details)
+}
+
+func (suite *OAuth2UtilsTestSuite) TestParseAuthorizationDetails_SupportedTypes() {
+	details, err := ParseAuthorizationDetails(`[{"type":"account_information"}]`,
+		[]string{"payment_initiation", "account_information"})
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), details, 1)
+}
+
+func (suite *OAuth2UtilsTestSuite) TestParseAuthorizationDetails_Invalid() {
+	testCases := []struct {
+		name           string
+		param          string
+		supportedTypes []string
+	}{
+		{"MalformedJSON", `[{"type":`, nil},
+		{"NotAnArray", `{"type":"payment_initiation"}`, nil},
+		{"EmptyArray", `[]`, nil},
+		{"NullEntry", `[null]`, nil},
+		{"MissingType", `[{"actions":["read"]}]`, nil},
+		{"NonStringType", `[{"type":42}]`, nil},
+		{"UnsupportedType", `[{"type":"payment_initiation"}]`, []string{"account_information"}},
+		{"ActionsNotArray", `[{"type":"payment_initiation","actions":"read"}]`, nil},
+		{"LocationsNotStrings", `[{"type":"payment_initiation","locations":[1]}]`, nil},
+		{"IdentifierNotString", `[{"type":"payment_initiation","identifier":{}}]`, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			details, err := ParseAuthorizationDetails(tc.param, tc.supportedTypes)
+
+			assert.Error(suite.T(), err)
+			assert.Nil(suite.T(), details)
+		})
+	}
+}
+
+func (suite *OAuth2UtilsTestSuite) TestConvertToAuthorizationDetails() {
+	raw := []interface{}{
+		map[string]interface{}{"type": "payment_initiation"},
+		"ignored",
+	}
+
+	details := ConvertToAuthorizationDetails(raw)
+
+	assert.Len(suite.T(), details, 1)
+	assert.Equal(suite.T(), "payment_initiation", details[0].GetType())
+	assert.Nil(suite.T(), ConvertToAuthorizationDetails(nil))
+	assert.Nil(suite.T(), ConvertToAuthorizationDetails([]interface{}{}))
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for scope management operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OSC-1001",
+		Error: core.I18nMessage{
+			Key:          "error.scopeservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scopeservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorInvalidScopeName is the error returned when the scope name is missing or is not a valid scope token.
+	ErrorInvalidScopeName = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OSC-1002",
+		Error: core.I18nMessage{
+			Key:          "error.scopeservice.invalid_scope_name",
+			DefaultValue: "Invalid scope name",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.scopeservice.invalid_scope_name_description",
+			DefaultValue: "The scope name must be a scope token of up to 255 characters " +
+				"without spaces, quotes or backslashes",
+		},
+	}
+
+	// ErrorInvalidClaims is the error returned when a claim bound to the scope is empty.
+	ErrorInvalidClaims = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OSC-1003",
+		Error: core.I18nMessage{
+			Key:          "error.scopeservice.invalid_claims",
+			DefaultValue: "Invalid claims",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scopeservice.invalid_claims_description",
+			DefaultValue: "The claims bound to the scope must be non-empty claim names",
+		},
+	}
+
+	// ErrorScopeNotFound is the error returned when the scope does not exist.
+	ErrorScopeNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OSC-1004",
+		Error: core.I18nMessage{
+			Key:          "error.scopeservice.scope_not_found",
+			DefaultValue: "Scope not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scopeservice.scope_not_found_description",
+			DefaultValue: "The scope with the specified id does not exist",
+		},
+	}
+
+	// ErrorScopeAlreadyExists is the error returned when a scope with the same name already exists.
+	ErrorScopeAlreadyExists = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OSC-1005",
+		Error: core.I18nMessage{
+			Key:          "error.scopeservice.scope_already_exists",
+			DefaultValue: "Scope already exists",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scopeservice.scope_already_exists_description",
+			DefaultValue: "A scope with the specified name already exists",
+		},
+	}
+)
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// scopeHandler defines the handler for the scope management API.
+type scopeHandler struct {
+	service ScopeServiceInterface
+	logger  *log.Logger
+}
+
+func newScopeHandler(service ScopeServiceInterface) *scopeHandler {
+	return &scopeHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ScopeHandler")),
+	}
+}
+
+// HandleScopeListRequest handles the request to list the scopes.
+func (h *scopeHandler) HandleScopeListRequest(w http.ResponseWriter, r *http.Request) {
+	scopeList, svcErr := h.service.GetScopeList(r.Context())
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, scopeList)
+}
+
+// HandleScopePostRequest handles the request to create a scope.
+func (h *scopeHandler) HandleScopePostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[ScopeRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	scope, svcErr := h.service.CreateScope(r.Context(), request)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, scope)
+}
+
+// HandleScopeGetRequest handles the request to retrieve a scope.
+func (h *scopeHandler) HandleScopeGetRequest(w http.ResponseWriter, r *http.Request) {
+	scope, svcErr := h.service.GetScope(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, scope)
+}
+
+// HandleScopePutRequest handles the request to update a scope.
+func (h *scopeHandler) HandleScopePutRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[ScopeRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	scope, svcErr := h.service.UpdateScope(r.Context(), r.PathValue("id"), request)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, scope)
+}
+
+// HandleScopeDeleteRequest handles the request to delete a scope.
+func (h *scopeHandler) HandleScopeDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.service.DeleteScope(r.Context(), r.PathValue("id")); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// handleError handles service errors and sends appropriate HTTP responses.
+func (h *scopeHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorScopeNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorScopeAlreadyExists.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	if statusCode == http.StatusInternalServerError {
+		h.logger.Error("Scope request failed with server error",
+			log.String("code", svcErr.Code),
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
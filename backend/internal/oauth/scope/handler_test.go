@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	serviceMock *ScopeServiceInterfaceMock
+	handler     *scopeHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.serviceMock = NewScopeServiceInterfaceMock(suite.T())
+	suite.handler = newScopeHandler(suite.serviceMock)
+}
+
+func (suite *HandlerTestSuite) TestListScopes() {
+	suite.serviceMock.EXPECT().GetScopeList(mock.Anything).Return(&ScopeList{
+		TotalResults: 1,
+		Scopes:       []Scope{{ID: "scope-1", Name: "orders:read", Claims: []string{}}},
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/scopes", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopeListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var list ScopeList
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &list))
+	suite.Equal(1, list.TotalResults)
+	suite.Equal("orders:read", list.Scopes[0].Name)
+}
+
+func (suite *HandlerTestSuite) TestCreateScope() {
+	suite.serviceMock.EXPECT().CreateScope(mock.Anything, &ScopeRequest{
+		Name:            "orders:read",
+		Description:     "Read your orders",
+		Claims:          []string{"customer_id"},
+		ConsentRequired: true,
+	}).Return(&Scope{ID: "scope-1", Name: "orders:read"}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/scopes",
+		strings.NewReader(`{"name":"orders:read","description":"Read your orders",`+
+			`"claims":["customer_id"],"consentRequired":true}`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopePostRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+
+	var scope Scope
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &scope))
+	suite.Equal("scope-1", scope.ID)
+}
+
+func (suite *HandlerTestSuite) TestCreateScope_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/scopes", strings.NewReader("{"))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopePostRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestCreateScope_AlreadyExists() {
+	suite.serviceMock.EXPECT().CreateScope(mock.Anything, mock.Anything).
+		Return(nil, &ErrorScopeAlreadyExists).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/scopes", strings.NewReader(`{"name":"orders:read"}`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopePostRequest(rr, req)
+
+	suite.Equal(http.StatusConflict, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorScopeAlreadyExists.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestGetScope() {
+	suite.serviceMock.EXPECT().GetScope(mock.Anything, "scope-1").
+		Return(&Scope{ID: "scope-1", Name: "orders:read"}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/scopes/scope-1", nil)
+	req.SetPathValue("id", "scope-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopeGetRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *HandlerTestSuite) TestGetScope_NotFound() {
+	suite.serviceMock.EXPECT().GetScope(mock.Anything, "missing").Return(nil, &ErrorScopeNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/scopes/missing", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopeGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorScopeNotFound.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestUpdateScope() {
+	suite.serviceMock.EXPECT().UpdateScope(mock.Anything, "scope-1", &ScopeRequest{Name: "orders:write"}).
+		Return(&Scope{ID: "scope-1", Name: "orders:write"}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPut, "/scopes/scope-1", strings.NewReader(`{"name":"orders:write"}`))
+	req.SetPathValue("id", "scope-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopePutRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *HandlerTestSuite) TestUpdateScope_InvalidName() {
+	suite.serviceMock.EXPECT().UpdateScope(mock.Anything, "scope-1", mock.Anything).
+		Return(nil, &ErrorInvalidScopeName).Once()
+
+	req := httptest.NewRequest(http.MethodPut, "/scopes/scope-1", strings.NewReader(`{"name":"orders read"}`))
+	req.SetPathValue("id", "scope-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopePutRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidScopeName.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestDeleteScope() {
+	suite.serviceMock.EXPECT().DeleteScope(mock.Anything, "scope-1").Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/scopes/scope-1", nil)
+	req.SetPathValue("id", "scope-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopeDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *HandlerTestSuite) TestDeleteScope_ServerError() {
+	suite.serviceMock.EXPECT().DeleteScope(mock.Anything, "scope-1").
+		Return(&serviceerror.InternalServerError).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/scopes/scope-1", nil)
+	req.SetPathValue("id", "scope-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleScopeDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(serviceerror.InternalServerError.Code, errResp.Code)
+}
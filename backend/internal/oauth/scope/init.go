@@ -18,7 +18,47 @@
 
 package scope
 
-// Initialize initializes and returns a new scope validator.
-func Initialize() ScopeValidatorInterface {
-	return newAPIScopeValidator()
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// scopesPath is the path of the scope management API.
+const scopesPath = "/scopes"
+
+// Initialize creates the scope service, registers the scope management API with the provided mux and returns
+// the service along with the scope validator.
+func Initialize(
+	mux *http.ServeMux, resourceService resource.ResourceServiceInterface,
+) (ScopeServiceInterface, ScopeValidatorInterface) {
+	service := newScopeService(newScopeStore())
+	registerRoutes(mux, newScopeHandler(service))
+
+	return service, newAPIScopeValidator(resourceService, service)
+}
+
+// registerRoutes registers the HTTP routes of the scope management API.
+func registerRoutes(mux *http.ServeMux, handler *scopeHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+scopesPath, handler.HandleScopeListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("POST "+scopesPath, handler.HandleScopePostRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+scopesPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+scopesPath+"/{id}", handler.HandleScopeGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("PUT "+scopesPath+"/{id}", handler.HandleScopePutRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+scopesPath+"/{id}", handler.HandleScopeDeleteRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+scopesPath+"/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
 }
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+// Scope represents an OAuth scope defined in the server.
+type Scope struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description,omitempty"`
+	Claims          []string `json:"claims"`
+	ConsentRequired bool     `json:"consentRequired"`
+}
+
+// ScopeRequest represents the request body for creating or updating a scope.
+type ScopeRequest struct {
+	// Name is the scope value requested by the clients.
+	Name string `json:"name"`
+	// Description is the human readable description shown to the user on the consent screen.
+	Description string `json:"description"`
+	// Claims are the user claims released when the scope is granted.
+	Claims []string `json:"claims"`
+	// ConsentRequired indicates whether the scope is shown to the user on the consent screen.
+	ConsentRequired bool `json:"consentRequired"`
+}
+
+// ScopeList represents the list of the scopes defined in the server.
+type ScopeList struct {
+	TotalResults int     `json:"totalResults"`
+	Scopes       []Scope `json:"scopes"`
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// maxScopeNameLength is the maximum length of a scope name.
+const maxScopeNameLength = 255
+
+// ScopeServiceInterface defines the interface for managing the OAuth scopes defined in the server.
+type ScopeServiceInterface interface {
+	CreateScope(ctx context.Context, request *ScopeRequest) (*Scope, *serviceerror.ServiceError)
+	GetScopeList(ctx context.Context) (*ScopeList, *serviceerror.ServiceError)
+	GetScope(ctx context.Context, id string) (*Scope, *serviceerror.ServiceError)
+	UpdateScope(ctx context.Context, id string, request *ScopeRequest) (*Scope, *serviceerror.ServiceError)
+	DeleteScope(ctx context.Context, id string) *serviceerror.ServiceError
+	GetScopesByNames(ctx context.Context, names []string) ([]Scope, *serviceerror.ServiceError)
+}
+
+// scopeService is the default implementation of ScopeServiceInterface.
+type scopeService struct {
+	store  scopeStoreInterface
+	logger *log.Logger
+}
+
+// newScopeService creates a new instance of scopeService.
+func newScopeService(store scopeStoreInterface) ScopeServiceInterface {
+	return &scopeService{
+		store:  store,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ScopeService")),
+	}
+}
+
+// CreateScope creates a scope. Scope names are unique.
+func (s *scopeService) CreateScope(ctx context.Context, request *ScopeRequest) (
+	*Scope, *serviceerror.ServiceError) {
+	scope, svcErr := buildScope(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if _, err := s.store.getScopeByName(ctx, scope.Name); err == nil {
+		return nil, &ErrorScopeAlreadyExists
+	} else if !errors.Is(err, errScopeNotFound) {
+		s.logger.Error("Failed to retrieve the scope by name", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID for the scope", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	scope.ID = id
+
+	if err := s.store.createScope(ctx, *scope); err != nil {
+		s.logger.Error("Failed to create the scope", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Scope created", log.String("id", id), log.String("name", scope.Name))
+	return scope, nil
+}
+
+// GetScopeList lists the scopes, ordered by name.
+func (s *scopeService) GetScopeList(ctx context.Context) (*ScopeList, *serviceerror.ServiceError) {
+	scopes, err := s.store.listScopes(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list the scopes", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &ScopeList{
+		TotalResults: len(scopes),
+		Scopes:       scopes,
+	}, nil
+}
+
+// GetScope retrieves a scope by its ID.
+func (s *scopeService) GetScope(ctx context.Context, id string) (*Scope, *serviceerror.ServiceError) {
+	scope, err := s.store.getScopeByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, errScopeNotFound) {
+			return nil, &ErrorScopeNotFound
+		}
+		s.logger.Error("Failed to retrieve the scope", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return scope, nil
+}
+
+// UpdateScope replaces the definition of a scope. The scope can be renamed as long as the new name is not used
+// by another scope.
+func (s *scopeService) UpdateScope(ctx context.Context, id string, request *ScopeRequest) (
+	*Scope, *serviceerror.ServiceError) {
+	scope, svcErr := buildScope(request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	scope.ID = id
+
+	existing, err := s.store.getScopeByName(ctx, scope.Name)
+	if err == nil && existing.ID != id {
+		return nil, &ErrorScopeAlreadyExists
+	} else if err != nil && !errors.Is(err, errScopeNotFound) {
+		s.logger.Error("Failed to retrieve the scope by name", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	if err := s.store.updateScope(ctx, *scope); err != nil {
+		if errors.Is(err, errScopeNotFound) {
+			return nil, &ErrorScopeNotFound
+		}
+		s.logger.Error("Failed to update the scope", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Scope updated", log.String("id", id), log.String("name", scope.Name))
+	return scope, nil
+}
+
+// DeleteScope deletes a scope. Deleting a scope that does not exist succeeds.
+func (s *scopeService) DeleteScope(ctx context.Context, id string) *serviceerror.ServiceError {
+	if err := s.store.deleteScope(ctx, id); err != nil {
+		s.logger.Error("Failed to delete the scope", log.String("id", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Scope deleted", log.String("id", id))
+	return nil
+}
+
+// GetScopesByNames returns the definitions of the given scopes in the order of the names. Names without a
+// definition are skipped.
+func (s *scopeService) GetScopesByNames(ctx context.Context, names []string) (
+	[]Scope, *serviceerror.ServiceError) {
+	if len(names) == 0 {
+		return []Scope{}, nil
+	}
+
+	defined, err := s.store.listScopes(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list the scopes", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	scopes := make([]Scope, 0, len(names))
+	for _, name := range names {
+		idx := slices.IndexFunc(defined, func(scope Scope) bool { return scope.Name == name })
+		if idx >= 0 && !slices.ContainsFunc(scopes, func(scope Scope) bool { return scope.Name == name }) {
+			scopes = append(scopes, defined[idx])
+		}
+	}
+
+	return scopes, nil
+}
+
+// buildScope validates the scope request and builds the scope it defines.
+func buildScope(request *ScopeRequest) (*Scope, *serviceerror.ServiceError) {
+	if request == nil {
+		return nil, &ErrorInvalidRequestFormat
+	}
+	if !isValidScopeName(request.Name) {
+		return nil, &ErrorInvalidScopeName
+	}
+
+	claims := make([]string, 0, len(request.Claims))
+	for _, claim := range request.Claims {
+		claim = strings.TrimSpace(claim)
+		if claim == "" {
+			return nil, &ErrorInvalidClaims
+		}
+		if !slices.Contains(claims, claim) {
+			claims = append(claims, claim)
+		}
+	}
+
+	return &Scope{
+		Name:            request.Name,
+		Description:     strings.TrimSpace(request.Description),
+		Claims:          claims,
+		ConsentRequired: request.ConsentRequired,
+	}, nil
+}
+
+// isValidScopeName reports whether the name is a scope token as defined in RFC 6749 Section 3.3, which excludes
+// spaces, double quotes, backslashes and control characters.
+func isValidScopeName(name string) bool {
+	if name == "" || len(name) > maxScopeNameLength {
+		return false
+	}
+	for _, c := range name {
+		if c < 0x21 || c > 0x7e || c == '"' || c == '\\' {
+			return false
+		}
+	}
+	return true
+}
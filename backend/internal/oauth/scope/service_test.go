@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	storeMock *scopeStoreInterfaceMock
+	service   ScopeServiceInterface
+	ctx       context.Context
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.storeMock = newScopeStoreInterfaceMock(suite.T())
+	suite.service = newScopeService(suite.storeMock)
+	suite.ctx = context.Background()
+}
+
+func (suite *ServiceTestSuite) TestCreateScope() {
+	suite.storeMock.EXPECT().getScopeByName(mock.Anything, "orders:read").Return(nil, errScopeNotFound).Once()
+	suite.storeMock.EXPECT().createScope(mock.Anything, mock.MatchedBy(func(scope Scope) bool {
+		return scope.ID != "" && scope.Name == "orders:read" && scope.Description == "Read your orders" &&
+			len(scope.Claims) == 1 && scope.Claims[0] == "customer_id" && scope.ConsentRequired
+	})).Return(nil).Once()
+
+	scope, svcErr := suite.service.CreateScope(suite.ctx, &ScopeRequest{
+		Name:            "orders:read",
+		Description:     " Read your orders ",
+		Claims:          []string{"customer_id", " customer_id"},
+		ConsentRequired: true,
+	})
+
+	suite.Nil(svcErr)
+	suite.NotEmpty(scope.ID)
+	suite.Equal([]string{"customer_id"}, scope.Claims)
+}
+
+func (suite *ServiceTestSuite) TestCreateScope_InvalidRequest() {
+	cases := []struct {
+		name     string
+		request  *ScopeRequest
+		expected *serviceerror.ServiceError
+	}{
+		{"NilRequest", nil, &ErrorInvalidRequestFormat},
+		{"EmptyName", &ScopeRequest{}, &ErrorInvalidScopeName},
+		{"NameWithSpace", &ScopeRequest{Name: "orders read"}, &ErrorInvalidScopeName},
+		{"NameWithQuote", &ScopeRequest{Name: `orders"read`}, &ErrorInvalidScopeName},
+		{"NameTooLong", &ScopeRequest{Name: strings.Repeat("a", maxScopeNameLength+1)}, &ErrorInvalidScopeName},
+		{"EmptyClaim", &ScopeRequest{Name: "orders:read", Claims: []string{" "}}, &ErrorInvalidClaims},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			scope, svcErr := suite.service.CreateScope(suite.ctx, tc.request)
+			suite.Nil(scope)
+			suite.Equal(tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestCreateScope_AlreadyExists() {
+	suite.storeMock.EXPECT().getScopeByName(mock.Anything, "orders:read").
+		Return(&Scope{ID: "scope-1", Name: "orders:read"}, nil).Once()
+
+	scope, svcErr := suite.service.CreateScope(suite.ctx, &ScopeRequest{Name: "orders:read"})
+
+	suite.Nil(scope)
+	suite.Equal(&ErrorScopeAlreadyExists, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestCreateScope_StoreError() {
+	suite.storeMock.EXPECT().getScopeByName(mock.Anything, "orders:read").Return(nil, errScopeNotFound).Once()
+	suite.storeMock.EXPECT().createScope(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	scope, svcErr := suite.service.CreateScope(suite.ctx, &ScopeRequest{Name: "orders:read"})
+
+	suite.Nil(scope)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestGetScopeList() {
+	suite.storeMock.EXPECT().listScopes(mock.Anything).
+		Return([]Scope{{ID: "scope-1", Name: "orders:read"}}, nil).Once()
+
+	list, svcErr := suite.service.GetScopeList(suite.ctx)
+
+	suite.Nil(svcErr)
+	suite.Equal(1, list.TotalResults)
+}
+
+func (suite *ServiceTestSuite) TestGetScope_NotFound() {
+	suite.storeMock.EXPECT().getScopeByID(mock.Anything, "missing").Return(nil, errScopeNotFound).Once()
+
+	scope, svcErr := suite.service.GetScope(suite.ctx, "missing")
+
+	suite.Nil(scope)
+	suite.Equal(&ErrorScopeNotFound, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestUpdateScope() {
+	suite.storeMock.EXPECT().getScopeByName(mock.Anything, "orders:read").
+		Return(&Scope{ID: "scope-1", Name: "orders:read"}, nil).Once()
+	suite.storeMock.EXPECT().updateScope(mock.Anything, mock.MatchedBy(func(scope Scope) bool {
+		return scope.ID == "scope-1" && scope.Description == "Read orders"
+	})).Return(nil).Once()
+
+	scope, svcErr := suite.service.UpdateScope(suite.ctx, "scope-1",
+		&ScopeRequest{Name: "orders:read", Description: "Read orders"})
+
+	suite.Nil(svcErr)
+	suite.Equal("scope-1", scope.ID)
+}
+
+func (suite *ServiceTestSuite) TestUpdateScope_NameTakenByAnotherScope() {
+	suite.storeMock.EXPECT().getScopeByName(mock.Anything, "orders:read").
+		Return(&Scope{ID: "scope-2", Name: "orders:read"}, nil).Once()
+
+	scope, svcErr := suite.service.UpdateScope(suite.ctx, "scope-1", &ScopeRequest{Name: "orders:read"})
+
+	suite.Nil(scope)
+	suite.Equal(&ErrorScopeAlreadyExists, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestUpdateScope_NotFound() {
+	suite.storeMock.EXPECT().getScopeByName(mock.Anything, "orders:read").Return(nil, errScopeNotFound).Once()
+	suite.storeMock.EXPECT().updateScope(mock.Anything, mock.Anything).Return(errScopeNotFound).Once()
+
+	scope, svcErr := suite.service.UpdateScope(suite.ctx, "missing", &ScopeRequest{Name: "orders:read"})
+
+	suite.Nil(scope)
+	suite.Equal(&ErrorScopeNotFound, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestDeleteScope() {
+	suite.storeMock.EXPECT().deleteScope(mock.Anything, "scope-1").Return(nil).Once()
+
+	suite.Nil(suite.service.DeleteScope(suite.ctx, "scope-1"))
+}
+
+func (suite *ServiceTestSuite) TestDeleteScope_StoreError() {
+	suite.storeMock.EXPECT().deleteScope(mock.Anything, "scope-1").Return(errors.New("db error")).Once()
+
+	suite.Equal(&serviceerror.InternalServerError, suite.service.DeleteScope(suite.ctx, "scope-1"))
+}
+
+func (suite *ServiceTestSuite) TestGetScopesByNames() {
+	suite.storeMock.EXPECT().listScopes(mock.Anything).Return([]Scope{
+		{ID: "scope-1", Name: "docs:read"},
+		{ID: "scope-2", Name: "orders:read"},
+	}, nil).Once()
+
+	scopes, svcErr := suite.service.GetScopesByNames(suite.ctx, []string{"orders:read", "unknown", "docs:read",
+		"orders:read"})
+
+	suite.Nil(svcErr)
+	suite.Equal([]Scope{{ID: "scope-2", Name: "orders:read"}, {ID: "scope-1", Name: "docs:read"}}, scopes)
+}
+
+func (suite *ServiceTestSuite) TestGetScopesByNames_Empty() {
+	scopes, svcErr := suite.service.GetScopesByNames(suite.ctx, nil)
+
+	suite.Nil(svcErr)
+	suite.Empty(scopes)
+}
+
+func (suite *ServiceTestSuite) TestGetScopesByNames_StoreError() {
+	suite.storeMock.EXPECT().listScopes(mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	scopes, svcErr := suite.service.GetScopesByNames(suite.ctx, []string{"orders:read"})
+
+	suite.Nil(scopes)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// errScopeNotFound is returned by the store when the scope does not exist.
+var errScopeNotFound = errors.New("scope not found")
+
+// scopeStoreInterface defines the interface for the storage operations of the scopes.
+type scopeStoreInterface interface {
+	createScope(ctx context.Context, scope Scope) error
+	listScopes(ctx context.Context) ([]Scope, error)
+	getScopeByID(ctx context.Context, id string) (*Scope, error)
+	getScopeByName(ctx context.Context, name string) (*Scope, error)
+	updateScope(ctx context.Context, scope Scope) error
+	deleteScope(ctx context.Context, id string) error
+}
+
+// scopeStore is the config database implementation of scopeStoreInterface.
+type scopeStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newScopeStore returns a new instance of scopeStoreInterface.
+func newScopeStore() scopeStoreInterface {
+	return &scopeStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createScope stores a scope.
+func (s *scopeStore) createScope(ctx context.Context, scope Scope) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	claims, err := json.Marshal(scope.Claims)
+	if err != nil {
+		return fmt.Errorf("failed to marshal claims: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateScope, scope.ID, scope.Name, scope.Description,
+		string(claims), scope.ConsentRequired, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// listScopes retrieves the scopes, ordered by name.
+func (s *scopeStore) listScopes(ctx context.Context) ([]Scope, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListScopes, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	scopes := make([]Scope, 0, len(results))
+	for _, row := range results {
+		scope, err := buildScopeFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, *scope)
+	}
+
+	return scopes, nil
+}
+
+// getScopeByID retrieves a scope by its ID. errScopeNotFound is returned when the scope does not exist.
+func (s *scopeStore) getScopeByID(ctx context.Context, id string) (*Scope, error) {
+	return s.getScope(ctx, queryGetScopeByID, id)
+}
+
+// getScopeByName retrieves a scope by its name. errScopeNotFound is returned when the scope does not exist.
+func (s *scopeStore) getScopeByName(ctx context.Context, name string) (*Scope, error) {
+	return s.getScope(ctx, queryGetScopeByName, name)
+}
+
+// getScope retrieves a single scope using the given query.
+func (s *scopeStore) getScope(ctx context.Context, query dbmodel.DBQuery, value string) (*Scope, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, value, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, errScopeNotFound
+	}
+
+	return buildScopeFromResultRow(results[0])
+}
+
+// updateScope updates a scope. errScopeNotFound is returned when the scope does not exist.
+func (s *scopeStore) updateScope(ctx context.Context, scope Scope) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	claims, err := json.Marshal(scope.Claims)
+	if err != nil {
+		return fmt.Errorf("failed to marshal claims: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryUpdateScope, scope.Name, scope.Description, string(claims),
+		scope.ConsentRequired, scope.ID, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if rows == 0 {
+		return errScopeNotFound
+	}
+
+	return nil
+}
+
+// deleteScope deletes a scope. Deleting a scope that does not exist is not an error.
+func (s *scopeStore) deleteScope(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteScope, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildScopeFromResultRow constructs a Scope from a database result row.
+func buildScopeFromResultRow(row map[string]interface{}) (*Scope, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	name, ok := row["name"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse name as string")
+	}
+	description := ""
+	if row["description"] != nil {
+		description, ok = row["description"].(string)
+		if !ok {
+			return nil, errors.New("failed to parse description as string")
+		}
+	}
+	claimsJSON, ok := row["claims"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse claims as string")
+	}
+	claims := make([]string, 0)
+	if err := json.Unmarshal([]byte(claimsJSON), &claims); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal claims: %w", err)
+	}
+	if claims == nil {
+		claims = []string{}
+	}
+	consentRequired, err := parseBool(row["consent_required"], "consent_required")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Scope{
+		ID:              id,
+		Name:            name,
+		Description:     description,
+		Claims:          claims,
+		ConsentRequired: consentRequired,
+	}, nil
+}
+
+// parseBool parses a boolean value returned by the database driver.
+func parseBool(value interface{}, fieldName string) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case int64:
+		return v != 0, nil
+	case string:
+		return strings.EqualFold(v, "true") || v == "1", nil
+	case []byte:
+		return strings.EqualFold(string(v), "true") || string(v) == "1", nil
+	default:
+		return false, fmt.Errorf("failed to parse %s as bool", fieldName)
+	}
+}
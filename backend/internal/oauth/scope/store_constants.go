@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateScope is the query to create a scope.
+	queryCreateScope = dbmodel.DBQuery{
+		ID: "OSQ-01",
+		Query: `INSERT INTO "OAUTH_SCOPE" (ID, NAME, DESCRIPTION, CLAIMS, CONSENT_REQUIRED, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6)`,
+	}
+
+	// queryListScopes is the query to list the scopes, ordered by name.
+	queryListScopes = dbmodel.DBQuery{
+		ID: "OSQ-02",
+		Query: `SELECT ID, NAME, DESCRIPTION, CLAIMS, CONSENT_REQUIRED FROM "OAUTH_SCOPE" ` +
+			`WHERE DEPLOYMENT_ID = $1 ORDER BY NAME`,
+	}
+
+	// queryGetScopeByID is the query to retrieve a scope by its ID.
+	queryGetScopeByID = dbmodel.DBQuery{
+		ID: "OSQ-03",
+		Query: `SELECT ID, NAME, DESCRIPTION, CLAIMS, CONSENT_REQUIRED FROM "OAUTH_SCOPE" ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetScopeByName is the query to retrieve a scope by its name.
+	queryGetScopeByName = dbmodel.DBQuery{
+		ID: "OSQ-04",
+		Query: `SELECT ID, NAME, DESCRIPTION, CLAIMS, CONSENT_REQUIRED FROM "OAUTH_SCOPE" ` +
+			`WHERE NAME = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpdateScope is the query to update a scope.
+	queryUpdateScope = dbmodel.DBQuery{
+		ID: "OSQ-05",
+		Query: `UPDATE "OAUTH_SCOPE" SET NAME = $1, DESCRIPTION = $2, CLAIMS = $3, CONSENT_REQUIRED = $4, ` +
+			`UPDATED_AT = CURRENT_TIMESTAMP WHERE ID = $5 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryDeleteScope is the query to delete a scope.
+	queryDeleteScope = dbmodel.DBQuery{
+		ID:    "OSQ-06",
+		Query: `DELETE FROM "OAUTH_SCOPE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
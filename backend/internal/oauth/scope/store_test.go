@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment"
+
+type ScopeStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *scopeStore
+}
+
+func TestScopeStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeStoreTestSuite))
+}
+
+func (suite *ScopeStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &scopeStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *ScopeStoreTestSuite) TestCreateScope() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateScope, "scope-1", "orders:read",
+		"Read your orders", `["customer_id"]`, true, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createScope(context.Background(), Scope{
+		ID:              "scope-1",
+		Name:            "orders:read",
+		Description:     "Read your orders",
+		Claims:          []string{"customer_id"},
+		ConsentRequired: true,
+	})
+	suite.NoError(err)
+}
+
+func (suite *ScopeStoreTestSuite) TestCreateScope_DBClientError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createScope(context.Background(), Scope{ID: "scope-1"})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *ScopeStoreTestSuite) TestListScopes() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListScopes, testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"id":               "scope-1",
+				"name":             "docs:read",
+				"description":      nil,
+				"claims":           "[]",
+				"consent_required": int64(0),
+			},
+			{
+				"id":               "scope-2",
+				"name":             "orders:read",
+				"description":      "Read your orders",
+				"claims":           `["customer_id"]`,
+				"consent_required": true,
+			},
+		}, nil).Once()
+
+	scopes, err := suite.store.listScopes(context.Background())
+	suite.NoError(err)
+	suite.Equal([]Scope{
+		{ID: "scope-1", Name: "docs:read", Claims: []string{}},
+		{ID: "scope-2", Name: "orders:read", Description: "Read your orders", Claims: []string{"customer_id"},
+			ConsentRequired: true},
+	}, scopes)
+}
+
+func (suite *ScopeStoreTestSuite) TestListScopes_InvalidClaims() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListScopes, testDeploymentID).
+		Return([]map[string]interface{}{
+			{"id": "scope-1", "name": "docs:read", "claims": "{", "consent_required": false},
+		}, nil).Once()
+
+	_, err := suite.store.listScopes(context.Background())
+	suite.Error(err)
+}
+
+func (suite *ScopeStoreTestSuite) TestGetScopeByName_NotFound() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetScopeByName, "orders:read",
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	scope, err := suite.store.getScopeByName(context.Background(), "orders:read")
+	suite.Nil(scope)
+	suite.ErrorIs(err, errScopeNotFound)
+}
+
+func (suite *ScopeStoreTestSuite) TestUpdateScope_NotFound() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateScope, "orders:read", "",
+		"[]", false, "missing", testDeploymentID).Return(int64(0), nil).Once()
+
+	err := suite.store.updateScope(context.Background(), Scope{ID: "missing", Name: "orders:read", Claims: []string{}})
+	suite.ErrorIs(err, errScopeNotFound)
+}
+
+func (suite *ScopeStoreTestSuite) TestDeleteScope() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteScope, "scope-1",
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.deleteScope(context.Background(), "scope-1"))
+}
@@ -16,10 +16,19 @@
  * under the License.
  */
 
-// Package scope provides functionality for validating scopes.
+// Package scope provides functionality for defining and validating scopes.
 package scope
 
-import "context"
+import (
+	"context"
+	"slices"
+	"strings"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
 
 // ScopeError represents an error during scope validation.
 type ScopeError struct {
@@ -29,25 +38,105 @@ type ScopeError struct {
 
 // ScopeValidatorInterface defines the interface for scope validation.
 type ScopeValidatorInterface interface {
-	ValidateScopes(ctx context.Context, requestedScopes, clientID string) (string, *ScopeError)
+	ValidateScopes(
+		ctx context.Context, requestedScopes string, oauthApp *inboundmodel.OAuthClient,
+	) (string, *ScopeError)
 }
 
 // apiScopeValidator is the implementation of API scope validation.
-type apiScopeValidator struct{}
+type apiScopeValidator struct {
+	resourceService resource.ResourceServiceInterface
+	scopeService    ScopeServiceInterface
+	logger          *log.Logger
+}
 
 // newAPIScopeValidator creates a new instance of the apiScopeValidator.
-func newAPIScopeValidator() *apiScopeValidator {
-	return &apiScopeValidator{}
+func newAPIScopeValidator(
+	resourceService resource.ResourceServiceInterface, scopeService ScopeServiceInterface,
+) *apiScopeValidator {
+	return &apiScopeValidator{
+		resourceService: resourceService,
+		scopeService:    scopeService,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ScopeValidator")),
+	}
 }
 
-// ValidateScopes validates and filters the requested scopes against the authorized scopes for the application.
+// ValidateScopes validates and filters the requested scopes against the scopes known for the application.
+// Standard OIDC scopes, scopes configured on the application, scopes defined through the scope management
+// API, and permissions registered on a resource server are retained. Unknown scopes are dropped, as permitted
+// by RFC 6749 Section 3.3.
 func (sv *apiScopeValidator) ValidateScopes(
-	ctx context.Context, requestedScopes, clientID string,
+	ctx context.Context, requestedScopes string, oauthApp *inboundmodel.OAuthClient,
 ) (string, *ScopeError) {
 	if requestedScopes == "" {
 		return "", nil
 	}
 
-	// Return all requested scopes for now.
-	return requestedScopes, nil
+	requested := make([]string, 0)
+	candidates := make([]string, 0)
+	for _, scp := range strings.Fields(requestedScopes) {
+		if slices.Contains(requested, scp) {
+			continue
+		}
+		requested = append(requested, scp)
+		if !isApplicationScope(scp, oauthApp) {
+			candidates = append(candidates, scp)
+		}
+	}
+
+	registered := make(map[string]bool)
+	if len(candidates) > 0 && sv.scopeService != nil {
+		defined, svcErr := sv.scopeService.GetScopesByNames(ctx, candidates)
+		if svcErr != nil {
+			sv.logger.Error("Failed to resolve requested scopes against defined scopes",
+				log.String("error_code", svcErr.Code))
+			return "", &ScopeError{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to validate the requested scopes",
+			}
+		}
+		for _, scp := range defined {
+			registered[scp.Name] = true
+		}
+		candidates = slices.DeleteFunc(candidates, func(scp string) bool { return registered[scp] })
+	}
+	if len(candidates) > 0 && sv.resourceService != nil {
+		details, svcErr := sv.resourceService.GetPermissionDetails(ctx, candidates)
+		if svcErr != nil {
+			sv.logger.Error("Failed to resolve requested scopes against registered permissions",
+				log.String("error_code", svcErr.Code))
+			return "", &ScopeError{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to validate the requested scopes",
+			}
+		}
+		for _, detail := range details {
+			registered[detail.Permission] = true
+		}
+	}
+
+	scopes := make([]string, 0, len(requested))
+	for _, scp := range requested {
+		if registered[scp] || isApplicationScope(scp, oauthApp) {
+			scopes = append(scopes, scp)
+			continue
+		}
+		sv.logger.Debug("Dropping unknown scope from the request", log.String("scope", scp))
+	}
+
+	return strings.Join(scopes, " "), nil
+}
+
+// isApplicationScope reports whether the scope is a standard OIDC scope or one configured on the application.
+func isApplicationScope(scope string, oauthApp *inboundmodel.OAuthClient) bool {
+	if _, ok := constants.StandardOIDCScopes[scope]; ok {
+		return true
+	}
+	if oauthApp == nil {
+		return false
+	}
+	if _, ok := oauthApp.ScopeClaims[scope]; ok {
+		return true
+	}
+	return slices.Contains(oauthApp.Scopes, scope)
 }
@@ -23,12 +23,22 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 )
 
 type ScopeValidatorTestSuite struct {
 	suite.Suite
-	validator ScopeValidatorInterface
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+	mockScopeService    *ScopeServiceInterfaceMock
+	validator           ScopeValidatorInterface
+	app                 *inboundmodel.OAuthClient
 }
 
 func TestScopeValidatorSuite(t *testing.T) {
@@ -36,75 +46,106 @@ func TestScopeValidatorSuite(t *testing.T) {
 }
 
 func (suite *ScopeValidatorTestSuite) SetupTest() {
-	suite.validator = newAPIScopeValidator()
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.mockScopeService = NewScopeServiceInterfaceMock(suite.T())
+	suite.validator = newAPIScopeValidator(suite.mockResourceService, suite.mockScopeService)
+	suite.app = &inboundmodel.OAuthClient{
+		ClientID:    "test-client",
+		Scopes:      []string{"read"},
+		ScopeClaims: map[string][]string{"custom": {"department"}},
+	}
 }
 
 func (suite *ScopeValidatorTestSuite) TestNewAPIScopeValidator() {
-	validator := newAPIScopeValidator()
+	validator := newAPIScopeValidator(suite.mockResourceService, suite.mockScopeService)
 	assert.NotNil(suite.T(), validator)
 	assert.IsType(suite.T(), &apiScopeValidator{}, validator)
 }
 
-func (suite *ScopeValidatorTestSuite) TestValidateScopes() {
-	testCases := []struct {
-		name            string
-		requestedScopes string
-		clientID        string
-		expectedScopes  string
-		expectedError   *ScopeError
-	}{
-		{
-			name:            "EmptyScopes",
-			requestedScopes: "",
-			clientID:        "test-client",
-			expectedScopes:  "",
-			expectedError:   nil,
-		},
-		{
-			name:            "SingleScope",
-			requestedScopes: "read",
-			clientID:        "test-client",
-			expectedScopes:  "read",
-			expectedError:   nil,
-		},
-		{
-			name:            "MultipleScopes",
-			requestedScopes: "read write delete",
-			clientID:        "test-client",
-			expectedScopes:  "read write delete",
-			expectedError:   nil,
-		},
-		{
-			name:            "ScopesWithSpecialCharacters",
-			requestedScopes: "api:read profile:write",
-			clientID:        "test-client",
-			expectedScopes:  "api:read profile:write",
-			expectedError:   nil,
-		},
-		{
-			name:            "EmptyClientID",
-			requestedScopes: "read",
-			clientID:        "",
-			expectedScopes:  "read",
-			expectedError:   nil,
-		},
-	}
+func (suite *ScopeValidatorTestSuite) TestValidateScopes_EmptyScopes() {
+	scopes, err := suite.validator.ValidateScopes(context.Background(), "", suite.app)
+
+	assert.Equal(suite.T(), "", scopes)
+	assert.Nil(suite.T(), err)
+}
 
-	for _, tc := range testCases {
-		suite.T().Run(tc.name, func(t *testing.T) {
-			scopes, err := suite.validator.ValidateScopes(context.Background(), tc.requestedScopes, tc.clientID)
+func (suite *ScopeValidatorTestSuite) TestValidateScopes_ApplicationScopes() {
+	scopes, err := suite.validator.ValidateScopes(context.Background(), "openid read custom profile", suite.app)
 
-			assert.Equal(t, tc.expectedScopes, scopes)
-			assert.Equal(t, tc.expectedError, err)
-		})
-	}
+	assert.Equal(suite.T(), "openid read custom profile", scopes)
+	assert.Nil(suite.T(), err)
+	suite.mockResourceService.AssertNotCalled(suite.T(), "GetPermissionDetails", mock.Anything, mock.Anything)
+	suite.mockScopeService.AssertNotCalled(suite.T(), "GetScopesByNames", mock.Anything, mock.Anything)
+}
+
+func (suite *ScopeValidatorTestSuite) TestValidateScopes_RegisteredPermissions() {
+	suite.mockScopeService.On("GetScopesByNames", mock.Anything, []string{"orders:read", "unknown"}).
+		Return([]Scope{}, nil)
+	suite.mockResourceService.On("GetPermissionDetails", mock.Anything, []string{"orders:read", "unknown"}).
+		Return([]resource.PermissionDetail{{Permission: "orders:read", Name: "Read orders"}}, nil)
+
+	scopes, err := suite.validator.ValidateScopes(context.Background(),
+		"orders:read openid unknown read orders:read", suite.app)
+
+	assert.Equal(suite.T(), "orders:read openid read", scopes)
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ScopeValidatorTestSuite) TestValidateScopes_AllUnknown() {
+	suite.mockScopeService.On("GetScopesByNames", mock.Anything, []string{"foo", "bar"}).Return([]Scope{}, nil)
+	suite.mockResourceService.On("GetPermissionDetails", mock.Anything, []string{"foo", "bar"}).
+		Return([]resource.PermissionDetail{}, nil)
+
+	scopes, err := suite.validator.ValidateScopes(context.Background(), "foo bar", suite.app)
+
+	assert.Equal(suite.T(), "", scopes)
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ScopeValidatorTestSuite) TestValidateScopes_NilApplication() {
+	suite.mockScopeService.On("GetScopesByNames", mock.Anything, []string{"read"}).Return([]Scope{}, nil)
+	suite.mockResourceService.On("GetPermissionDetails", mock.Anything, []string{"read"}).
+		Return([]resource.PermissionDetail{}, nil)
+
+	scopes, err := suite.validator.ValidateScopes(context.Background(), "openid read", nil)
+
+	assert.Equal(suite.T(), "openid", scopes)
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ScopeValidatorTestSuite) TestValidateScopes_ResourceServiceError() {
+	suite.mockScopeService.On("GetScopesByNames", mock.Anything, []string{"orders:read"}).Return([]Scope{}, nil)
+	suite.mockResourceService.On("GetPermissionDetails", mock.Anything, []string{"orders:read"}).
+		Return(nil, &serviceerror.InternalServerError)
+
+	scopes, err := suite.validator.ValidateScopes(context.Background(), "openid orders:read", suite.app)
+
+	assert.Equal(suite.T(), "", scopes)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
 }
 
-func (suite *ScopeValidatorTestSuite) TestValidateScopesInterface() {
-	var _ ScopeValidatorInterface = &apiScopeValidator{}
+func (suite *ScopeValidatorTestSuite) TestValidateScopes_DefinedScopes() {
+	suite.mockScopeService.On("GetScopesByNames", mock.Anything, []string{"docs:read", "orders:read", "unknown"}).
+		Return([]Scope{{ID: "scope-1", Name: "docs:read"}}, nil)
+	suite.mockResourceService.On("GetPermissionDetails", mock.Anything, []string{"orders:read", "unknown"}).
+		Return([]resource.PermissionDetail{{Permission: "orders:read", Name: "Read orders"}}, nil)
+
+	scopes, err := suite.validator.ValidateScopes(context.Background(),
+		"docs:read openid orders:read unknown", suite.app)
 
-	validator := newAPIScopeValidator()
-	scopes, err := validator.ValidateScopes(context.Background(), "test", "client")
-	assert.Equal(suite.T(), "test", scopes)
+	assert.Equal(suite.T(), "docs:read openid orders:read", scopes)
 	assert.Nil(suite.T(), err)
 }
+
+func (suite *ScopeValidatorTestSuite) TestValidateScopes_ScopeServiceError() {
+	suite.mockScopeService.On("GetScopesByNames", mock.Anything, []string{"docs:read"}).
+		Return(nil, &serviceerror.InternalServerError)
+
+	scopes, err := suite.validator.ValidateScopes(context.Background(), "openid docs:read", suite.app)
+
+	assert.Equal(suite.T(), "", scopes)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+	suite.mockResourceService.AssertNotCalled(suite.T(), "GetPermissionDetails", mock.Anything, mock.Anything)
+}
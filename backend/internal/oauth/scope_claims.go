@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
+)
+
+// scopeClaimsInboundClient resolves the OAuth clients with the claims bound to the scopes defined through the
+// scope management API, so that the OAuth services release those claims like the scope claims configured on the
+// application. The scope claims configured on the application take precedence.
+type scopeClaimsInboundClient struct {
+	inboundclient.InboundClientServiceInterface
+	scopeService scope.ScopeServiceInterface
+}
+
+// newScopeClaimsInboundClient wraps the inbound client service with the claims bound to the defined scopes.
+func newScopeClaimsInboundClient(
+	inboundClient inboundclient.InboundClientServiceInterface, scopeService scope.ScopeServiceInterface,
+) inboundclient.InboundClientServiceInterface {
+	if scopeService == nil {
+		return inboundClient
+	}
+	return &scopeClaimsInboundClient{
+		InboundClientServiceInterface: inboundClient,
+		scopeService:                  scopeService,
+	}
+}
+
+// GetOAuthClientByClientID resolves a full OAuthClient by its public client_id, adding the claims bound to the
+// defined scopes that are not mapped on the application.
+func (c *scopeClaimsInboundClient) GetOAuthClientByClientID(ctx context.Context, clientID string) (
+	*inboundmodel.OAuthClient, error) {
+	client, err := c.InboundClientServiceInterface.GetOAuthClientByClientID(ctx, clientID)
+	if err != nil || client == nil {
+		return client, err
+	}
+
+	scopeList, svcErr := c.scopeService.GetScopeList(ctx)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to list the defined scopes: %s", svcErr.Code)
+	}
+
+	var scopeClaims map[string][]string
+	for _, definedScope := range scopeList.Scopes {
+		if len(definedScope.Claims) == 0 {
+			continue
+		}
+		if _, ok := client.ScopeClaims[definedScope.Name]; ok {
+			continue
+		}
+		if scopeClaims == nil {
+			scopeClaims = maps.Clone(client.ScopeClaims)
+			if scopeClaims == nil {
+				scopeClaims = make(map[string][]string)
+			}
+		}
+		scopeClaims[definedScope.Name] = definedScope.Claims
+	}
+	if scopeClaims != nil {
+		client.ScopeClaims = scopeClaims
+	}
+
+	return client, nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oauth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/scope"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/scopemock"
+)
+
+type ScopeClaimsInboundClientTestSuite struct {
+	suite.Suite
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	mockScopeService  *scopemock.ScopeServiceInterfaceMock
+	client            *scopeClaimsInboundClient
+}
+
+func TestScopeClaimsInboundClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeClaimsInboundClientTestSuite))
+}
+
+func (suite *ScopeClaimsInboundClientTestSuite) SetupTest() {
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+	suite.mockScopeService = scopemock.NewScopeServiceInterfaceMock(suite.T())
+	suite.client = &scopeClaimsInboundClient{
+		InboundClientServiceInterface: suite.mockInboundClient,
+		scopeService:                  suite.mockScopeService,
+	}
+}
+
+func (suite *ScopeClaimsInboundClientTestSuite) TestGetOAuthClientByClientID_AddsDefinedScopeClaims() {
+	appScopeClaims := map[string][]string{"orders": {"customer_id"}}
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(context.Background(), "client-1").
+		Return(&inboundmodel.OAuthClient{ClientID: "client-1", ScopeClaims: appScopeClaims}, nil).Once()
+	suite.mockScopeService.EXPECT().GetScopeList(context.Background()).Return(&scope.ScopeList{
+		TotalResults: 3,
+		Scopes: []scope.Scope{
+			{Name: "employee", Claims: []string{"employee_id", "department"}},
+			{Name: "orders", Claims: []string{"order_history"}},
+			{Name: "docs:read", Claims: []string{}},
+		},
+	}, nil).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(context.Background(), "client-1")
+
+	suite.NoError(err)
+	suite.Equal(map[string][]string{
+		"orders":   {"customer_id"},
+		"employee": {"employee_id", "department"},
+	}, client.ScopeClaims)
+	suite.Equal(map[string][]string{"orders": {"customer_id"}}, appScopeClaims)
+}
+
+func (suite *ScopeClaimsInboundClientTestSuite) TestGetOAuthClientByClientID_NoDefinedScopeClaims() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(context.Background(), "client-1").
+		Return(&inboundmodel.OAuthClient{ClientID: "client-1"}, nil).Once()
+	suite.mockScopeService.EXPECT().GetScopeList(context.Background()).
+		Return(&scope.ScopeList{Scopes: []scope.Scope{{Name: "docs:read", Claims: []string{}}}}, nil).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(context.Background(), "client-1")
+
+	suite.NoError(err)
+	suite.Nil(client.ScopeClaims)
+}
+
+func (suite *ScopeClaimsInboundClientTestSuite) TestGetOAuthClientByClientID_ClientNotFound() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(context.Background(), "missing").
+		Return(nil, nil).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(context.Background(), "missing")
+
+	suite.NoError(err)
+	suite.Nil(client)
+}
+
+func (suite *ScopeClaimsInboundClientTestSuite) TestGetOAuthClientByClientID_InboundClientError() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(context.Background(), "client-1").
+		Return(nil, errors.New("lookup failed")).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(context.Background(), "client-1")
+
+	suite.Error(err)
+	suite.Nil(client)
+}
+
+func (suite *ScopeClaimsInboundClientTestSuite) TestGetOAuthClientByClientID_ScopeServiceError() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(context.Background(), "client-1").
+		Return(&inboundmodel.OAuthClient{ClientID: "client-1"}, nil).Once()
+	suite.mockScopeService.EXPECT().GetScopeList(context.Background()).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	client, err := suite.client.GetOAuthClientByClientID(context.Background(), "client-1")
+
+	suite.Error(err)
+	suite.Nil(client)
+}
+
+func (suite *ScopeClaimsInboundClientTestSuite) TestNewScopeClaimsInboundClient_WithoutScopeService() {
+	suite.Same(suite.mockInboundClient, newScopeClaimsInboundClient(suite.mockInboundClient, nil))
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package organization
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for organization operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1001",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorOrganizationNotFound is the error returned when an organization with the given ID does not exist.
+	ErrorOrganizationNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1002",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.organization_not_found",
+			DefaultValue: "Organization not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.organization_not_found_description",
+			DefaultValue: "The organization with the given ID does not exist",
+		},
+	}
+
+	// ErrorInvalidHandle is the error returned when the handle of an organization is invalid.
+	ErrorInvalidHandle = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1003",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.invalid_handle",
+			DefaultValue: "Invalid organization handle",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.organizationservice.invalid_handle_description",
+			DefaultValue: "The handle must consist of lowercase letters, digits and hyphens, " +
+				"and must not exceed 100 characters",
+		},
+	}
+
+	// ErrorMissingName is the error returned when the name of an organization is not provided.
+	ErrorMissingName = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1004",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.missing_name",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.missing_name_description",
+			DefaultValue: "The name of the organization must be provided",
+		},
+	}
+
+	// ErrorOrganizationUnitNotFound is the error returned when the organization unit of an organization does not exist.
+	ErrorOrganizationUnitNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1005",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.organization_unit_not_found",
+			DefaultValue: "Organization unit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.organization_unit_not_found_description",
+			DefaultValue: "The organization unit of the organization does not exist",
+		},
+	}
+
+	// ErrorIdentityProviderNotFound is the error returned when an identity provider of an organization does not exist.
+	ErrorIdentityProviderNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1006",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.identity_provider_not_found",
+			DefaultValue: "Identity provider not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.identity_provider_not_found_description",
+			DefaultValue: "An identity provider of the organization does not exist",
+		},
+	}
+
+	// ErrorInvalidDomain is the error returned when an email domain of an organization is invalid.
+	ErrorInvalidDomain = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1007",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.invalid_domain",
+			DefaultValue: "Invalid email domain",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.invalid_domain_description",
+			DefaultValue: "The email domains must be valid domain names",
+		},
+	}
+
+	// ErrorHandleConflict is the error returned when the handle of an organization is already in use.
+	ErrorHandleConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1008",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.handle_conflict",
+			DefaultValue: "Organization handle conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.handle_conflict_description",
+			DefaultValue: "An organization with the same handle already exists",
+		},
+	}
+
+	// ErrorOrganizationUnitConflict is the error returned when an organization is already built on the
+	// organization unit.
+	ErrorOrganizationUnitConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1009",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.organization_unit_conflict",
+			DefaultValue: "Organization unit conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.organization_unit_conflict_description",
+			DefaultValue: "An organization is already built on the organization unit",
+		},
+	}
+
+	// ErrorDomainConflict is the error returned when an email domain is already owned by another organization.
+	ErrorDomainConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1010",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.domain_conflict",
+			DefaultValue: "Email domain conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.domain_conflict_description",
+			DefaultValue: "An email domain is already owned by another organization",
+		},
+	}
+
+	// ErrorInvalidLimit is the error returned when the limit query parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1011",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.invalid_limit",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.invalid_limit_description",
+			DefaultValue: "The limit parameter must be a positive integer not greater than the maximum page size",
+		},
+	}
+
+	// ErrorInvalidOffset is the error returned when the offset query parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ORG-1012",
+		Error: core.I18nMessage{
+			Key:          "error.organizationservice.invalid_offset",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.organizationservice.invalid_offset_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+)
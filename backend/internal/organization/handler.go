@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package organization
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// organizationHandler defines the handler for the organization management API.
+type organizationHandler struct {
+	service OrganizationServiceInterface
+	logger  *log.Logger
+}
+
+func newOrganizationHandler(service OrganizationServiceInterface) *organizationHandler {
+	return &organizationHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "OrganizationHandler")),
+	}
+}
+
+// HandleOrganizationPostRequest handles the request to create an organization.
+func (h *organizationHandler) HandleOrganizationPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[OrganizationRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	organization, svcErr := h.service.CreateOrganization(r.Context(), request)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, organization)
+}
+
+// HandleOrganizationListRequest handles the request to list the organizations.
+func (h *organizationHandler) HandleOrganizationListRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := constants.DefaultPageSize
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidOffset)
+			return
+		}
+		offset = parsed
+	}
+
+	result, svcErr := h.service.ListOrganizations(r.Context(), limit, offset)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, result)
+}
+
+// HandleOrganizationGetRequest handles the request to get an organization.
+func (h *organizationHandler) HandleOrganizationGetRequest(w http.ResponseWriter, r *http.Request) {
+	organization, svcErr := h.service.GetOrganization(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, organization)
+}
+
+// HandleOrganizationPutRequest handles the request to update an organization.
+func (h *organizationHandler) HandleOrganizationPutRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[OrganizationRequest](r)
+	if err != nil {
+		h.handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	organization, svcErr := h.service.UpdateOrganization(r.Context(), r.PathValue("id"), request)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, organization)
+}
+
+// HandleOrganizationDeleteRequest handles the request to delete an organization.
+func (h *organizationHandler) HandleOrganizationDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.service.DeleteOrganization(r.Context(), r.PathValue("id")); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// handleError handles service errors and sends appropriate HTTP responses.
+func (h *organizationHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorOrganizationNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorHandleConflict.Code, ErrorOrganizationUnitConflict.Code, ErrorDomainConflict.Code:
+			statusCode = http.StatusConflict
+		case serviceerror.ErrorUnauthorized.Code:
+			statusCode = http.StatusForbidden
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	if statusCode == http.StatusInternalServerError {
+		h.logger.Error("Organization request failed with server error",
+			log.String("code", svcErr.Code),
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
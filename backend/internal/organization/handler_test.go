@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package organization
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	serviceMock *OrganizationServiceInterfaceMock
+	handler     *organizationHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.serviceMock = NewOrganizationServiceInterfaceMock(suite.T())
+	suite.handler = newOrganizationHandler(suite.serviceMock)
+}
+
+func (suite *HandlerTestSuite) TestCreateOrganization() {
+	suite.serviceMock.EXPECT().CreateOrganization(mock.Anything, &OrganizationRequest{
+		Handle: "acme", Name: "Acme", OUID: testOUID,
+	}).Return(&Organization{ID: testOrganizationID, Handle: "acme"}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/organizations",
+		strings.NewReader(`{"handle":"acme","name":"Acme","ouId":"ou-1"}`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationPostRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+	var resp Organization
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	suite.Equal(testOrganizationID, resp.ID)
+}
+
+func (suite *HandlerTestSuite) TestCreateOrganization_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/organizations", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationPostRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestCreateOrganization_Conflict() {
+	suite.serviceMock.EXPECT().CreateOrganization(mock.Anything, mock.Anything).
+		Return(nil, &ErrorHandleConflict).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/organizations", strings.NewReader(`{"handle":"acme"}`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationPostRequest(rr, req)
+
+	suite.Equal(http.StatusConflict, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorHandleConflict.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestListOrganizations() {
+	suite.serviceMock.EXPECT().ListOrganizations(mock.Anything, 5, 10).Return(&OrganizationList{
+		TotalResults:  11,
+		StartIndex:    11,
+		Count:         1,
+		Organizations: []Organization{{ID: testOrganizationID}},
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/organizations?limit=5&offset=10", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var resp OrganizationList
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	suite.Equal(11, resp.TotalResults)
+	suite.Len(resp.Organizations, 1)
+}
+
+func (suite *HandlerTestSuite) TestListOrganizations_InvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/organizations?limit=abc", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationListRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidLimit.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestGetOrganization() {
+	suite.serviceMock.EXPECT().GetOrganization(mock.Anything, testOrganizationID).
+		Return(&Organization{ID: testOrganizationID}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/organizations/"+testOrganizationID, nil)
+	req.SetPathValue("id", testOrganizationID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationGetRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *HandlerTestSuite) TestGetOrganization_NotFound() {
+	suite.serviceMock.EXPECT().GetOrganization(mock.Anything, testOrganizationID).
+		Return(nil, &ErrorOrganizationNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/organizations/"+testOrganizationID, nil)
+	req.SetPathValue("id", testOrganizationID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorOrganizationNotFound.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestUpdateOrganization() {
+	suite.serviceMock.EXPECT().UpdateOrganization(mock.Anything, testOrganizationID, mock.Anything).
+		Return(&Organization{ID: testOrganizationID}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPut, "/organizations/"+testOrganizationID,
+		strings.NewReader(`{"handle":"acme","name":"Acme"}`))
+	req.SetPathValue("id", testOrganizationID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationPutRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *HandlerTestSuite) TestUpdateOrganization_ServerError() {
+	suite.serviceMock.EXPECT().UpdateOrganization(mock.Anything, testOrganizationID, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	req := httptest.NewRequest(http.MethodPut, "/organizations/"+testOrganizationID,
+		strings.NewReader(`{"handle":"acme"}`))
+	req.SetPathValue("id", testOrganizationID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationPutRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(serviceerror.InternalServerError.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestDeleteOrganization() {
+	suite.serviceMock.EXPECT().DeleteOrganization(mock.Anything, testOrganizationID).Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/organizations/"+testOrganizationID, nil)
+	req.SetPathValue("id", testOrganizationID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOrganizationDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package organization provides the B2B organizations built on organization units. Each organization can
+// register its own identity providers, email domains and authentication policy, which are applied when its users
+// sign in through the organization.
+package organization
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// organizationsPath is the path of the organization management API.
+const organizationsPath = "/organizations"
+
+// Initialize creates the organization service and registers the organization management API with the provided
+// mux.
+func Initialize(mux *http.ServeMux, ouService ou.OrganizationUnitServiceInterface,
+	idpService idp.IDPServiceInterface) (OrganizationServiceInterface, error) {
+	transactioner, err := provider.GetDBProvider().GetUserDBTransactioner()
+	if err != nil {
+		return nil, err
+	}
+
+	organizationService := newOrganizationService(newOrganizationStore(), ouService, idpService, transactioner)
+	registerRoutes(mux, newOrganizationHandler(organizationService))
+
+	return organizationService, nil
+}
+
+// registerRoutes registers the HTTP routes of the organization management API.
+func registerRoutes(mux *http.ServeMux, handler *organizationHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("POST "+organizationsPath, handler.HandleOrganizationPostRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+organizationsPath, handler.HandleOrganizationListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+organizationsPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+organizationsPath+"/{id}", handler.HandleOrganizationGetRequest,
+		opts))
+	mux.HandleFunc(middleware.WithCORS("PUT "+organizationsPath+"/{id}", handler.HandleOrganizationPutRequest,
+		opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+organizationsPath+"/{id}",
+		handler.HandleOrganizationDeleteRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+organizationsPath+"/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
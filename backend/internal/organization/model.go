@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package organization
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// Organization represents a B2B organization. An organization is a tenant built on an organization unit: its
+// users are the users of the organization unit and its descendants, and it can register its own identity
+// providers and authentication policy for signing in.
+type Organization struct {
+	ID                   string               `json:"id"`
+	Handle               string               `json:"handle"`
+	Name                 string               `json:"name"`
+	OUID                 string               `json:"ouId"`
+	Domains              []string             `json:"domains"`
+	IdentityProviders    []string             `json:"identityProviders"`
+	AuthenticationPolicy AuthenticationPolicy `json:"authenticationPolicy"`
+	CreatedAt            time.Time            `json:"createdAt"`
+	UpdatedAt            time.Time            `json:"updatedAt"`
+}
+
+// AuthenticationPolicy represents the policy applied when the users of an organization sign in through it.
+type AuthenticationPolicy struct {
+	// RequireOrganizationIDP requires the users to sign in with one of the identity providers of the
+	// organization, rejecting sign-ins with local credentials.
+	RequireOrganizationIDP bool `json:"requireOrganizationIdp"`
+}
+
+// OrganizationRequest represents the request body for creating or updating an organization.
+type OrganizationRequest struct {
+	Handle               string               `json:"handle"`
+	Name                 string               `json:"name"`
+	OUID                 string               `json:"ouId"`
+	Domains              []string             `json:"domains"`
+	IdentityProviders    []string             `json:"identityProviders"`
+	AuthenticationPolicy AuthenticationPolicy `json:"authenticationPolicy"`
+}
+
+// OrganizationList represents a page of the organizations.
+type OrganizationList struct {
+	TotalResults  int            `json:"totalResults"`
+	StartIndex    int            `json:"startIndex"`
+	Count         int            `json:"count"`
+	Organizations []Organization `json:"organizations"`
+	Links         []utils.Link   `json:"links"`
+}
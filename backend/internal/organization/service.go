@@ -0,0 +1,393 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package organization
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// maxHandleLength is the maximum length of the handle of an organization.
+	maxHandleLength = 100
+	// maxNameLength is the maximum length of the name of an organization.
+	maxNameLength = 255
+)
+
+var (
+	// handlePattern matches the valid handles of organizations.
+	handlePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	// domainPattern matches the valid email domains of organizations.
+	domainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9-]{2,63}$`)
+)
+
+// errRollback is returned from a transaction to roll it back when the request is rejected with a client error.
+var errRollback = errors.New("rollback for client error")
+
+// OrganizationServiceInterface defines the interface for managing the organizations.
+type OrganizationServiceInterface interface {
+	CreateOrganization(ctx context.Context, request *OrganizationRequest) (
+		*Organization, *serviceerror.ServiceError)
+	GetOrganization(ctx context.Context, id string) (*Organization, *serviceerror.ServiceError)
+	GetOrganizationByHandle(ctx context.Context, handle string) (*Organization, *serviceerror.ServiceError)
+	GetOrganizationByEmailDomain(ctx context.Context, domain string) (*Organization, *serviceerror.ServiceError)
+	ListOrganizations(ctx context.Context, limit, offset int) (*OrganizationList, *serviceerror.ServiceError)
+	UpdateOrganization(ctx context.Context, id string, request *OrganizationRequest) (
+		*Organization, *serviceerror.ServiceError)
+	DeleteOrganization(ctx context.Context, id string) *serviceerror.ServiceError
+}
+
+// organizationService implements OrganizationServiceInterface.
+type organizationService struct {
+	store         organizationStoreInterface
+	ouService     ou.OrganizationUnitServiceInterface
+	idpService    idp.IDPServiceInterface
+	transactioner transaction.Transactioner
+	logger        *log.Logger
+}
+
+// newOrganizationService returns a new instance of OrganizationServiceInterface.
+func newOrganizationService(store organizationStoreInterface, ouService ou.OrganizationUnitServiceInterface,
+	idpService idp.IDPServiceInterface, transactioner transaction.Transactioner) OrganizationServiceInterface {
+	return &organizationService{
+		store:         store,
+		ouService:     ouService,
+		idpService:    idpService,
+		transactioner: transactioner,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "OrganizationService")),
+	}
+}
+
+// CreateOrganization creates an organization on an organization unit.
+func (s *organizationService) CreateOrganization(ctx context.Context, request *OrganizationRequest) (
+	*Organization, *serviceerror.ServiceError) {
+	organization, svcErr := s.validateOrganizationRequest(ctx, request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID for the organization", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	now := time.Now().UTC()
+	organization.ID = id
+	organization.CreatedAt = now
+	organization.UpdatedAt = now
+
+	err = s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if svcErr = s.checkConflicts(txCtx, organization); svcErr != nil {
+			return errRollback
+		}
+		return s.store.createOrganization(txCtx, *organization)
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if err != nil {
+		s.logger.Error("Failed to create the organization", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Organization created", log.String("organizationId", id))
+	return organization, nil
+}
+
+// GetOrganization retrieves an organization by its ID.
+func (s *organizationService) GetOrganization(ctx context.Context, id string) (
+	*Organization, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorOrganizationNotFound
+	}
+
+	organization, err := s.store.getOrganization(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the organization", log.String("organizationId", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if organization == nil {
+		return nil, &ErrorOrganizationNotFound
+	}
+
+	return organization, nil
+}
+
+// GetOrganizationByHandle retrieves an organization by its handle.
+func (s *organizationService) GetOrganizationByHandle(ctx context.Context, handle string) (
+	*Organization, *serviceerror.ServiceError) {
+	handle = strings.ToLower(strings.TrimSpace(handle))
+	if handle == "" {
+		return nil, &ErrorOrganizationNotFound
+	}
+
+	organization, err := s.store.getOrganizationByHandle(ctx, handle)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the organization by handle", log.String("handle", handle),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if organization == nil {
+		return nil, &ErrorOrganizationNotFound
+	}
+
+	return organization, nil
+}
+
+// GetOrganizationByEmailDomain retrieves the organization owning an email domain, which is used to discover the
+// organization of a user from the email address of the user.
+func (s *organizationService) GetOrganizationByEmailDomain(ctx context.Context, domain string) (
+	*Organization, *serviceerror.ServiceError) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if domain == "" {
+		return nil, &ErrorOrganizationNotFound
+	}
+
+	organization, err := s.store.getOrganizationByDomain(ctx, domain)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the organization by email domain", log.String("domain", domain),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if organization == nil {
+		return nil, &ErrorOrganizationNotFound
+	}
+
+	return organization, nil
+}
+
+// ListOrganizations retrieves a page of the organizations, ordered by their handle.
+func (s *organizationService) ListOrganizations(ctx context.Context, limit, offset int) (
+	*OrganizationList, *serviceerror.ServiceError) {
+	if limit < 1 || limit > constants.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return nil, &ErrorInvalidOffset
+	}
+
+	total, err := s.store.countOrganizations(ctx)
+	if err != nil {
+		s.logger.Error("Failed to count the organizations", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	organizations, err := s.store.listOrganizations(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list the organizations", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &OrganizationList{
+		TotalResults:  total,
+		StartIndex:    offset + 1,
+		Count:         len(organizations),
+		Organizations: organizations,
+		Links:         sysutils.BuildPaginationLinks(organizationsPath, limit, offset, total, ""),
+	}, nil
+}
+
+// UpdateOrganization replaces the properties of an organization.
+func (s *organizationService) UpdateOrganization(ctx context.Context, id string, request *OrganizationRequest) (
+	*Organization, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorOrganizationNotFound
+	}
+	organization, svcErr := s.validateOrganizationRequest(ctx, request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	organization.ID = id
+	organization.UpdatedAt = time.Now().UTC()
+
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.getOrganization(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			svcErr = &ErrorOrganizationNotFound
+			return errRollback
+		}
+		organization.CreatedAt = existing.CreatedAt
+
+		if svcErr = s.checkConflicts(txCtx, organization); svcErr != nil {
+			return errRollback
+		}
+		return s.store.updateOrganization(txCtx, *organization)
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if err != nil {
+		s.logger.Error("Failed to update the organization", log.String("organizationId", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return organization, nil
+}
+
+// DeleteOrganization deletes an organization. The organization unit and the users of the organization are kept.
+func (s *organizationService) DeleteOrganization(ctx context.Context, id string) *serviceerror.ServiceError {
+	if strings.TrimSpace(id) == "" {
+		return &ErrorOrganizationNotFound
+	}
+
+	var svcErr *serviceerror.ServiceError
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.getOrganization(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			svcErr = &ErrorOrganizationNotFound
+			return errRollback
+		}
+		return s.store.deleteOrganization(txCtx, id)
+	})
+	if svcErr != nil {
+		return svcErr
+	}
+	if err != nil {
+		s.logger.Error("Failed to delete the organization", log.String("organizationId", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Organization deleted", log.String("organizationId", id))
+	return nil
+}
+
+// validateOrganizationRequest validates an organization request and returns the organization it describes, with
+// the handle and the email domains normalized to lower case and duplicate values removed.
+func (s *organizationService) validateOrganizationRequest(ctx context.Context, request *OrganizationRequest) (
+	*Organization, *serviceerror.ServiceError) {
+	if request == nil {
+		return nil, &ErrorInvalidRequestFormat
+	}
+
+	handle := strings.ToLower(strings.TrimSpace(request.Handle))
+	if len(handle) > maxHandleLength || !handlePattern.MatchString(handle) {
+		return nil, &ErrorInvalidHandle
+	}
+	name := strings.TrimSpace(request.Name)
+	if name == "" || len(name) > maxNameLength {
+		return nil, &ErrorMissingName
+	}
+
+	ouID := strings.TrimSpace(request.OUID)
+	if ouID == "" {
+		return nil, &ErrorOrganizationUnitNotFound
+	}
+	exists, svcErr := s.ouService.IsOrganizationUnitExists(ctx, ouID)
+	if svcErr != nil {
+		s.logger.Error("Failed to check the organization unit of the organization", log.String("ouId", ouID),
+			log.String("code", svcErr.Code))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !exists {
+		return nil, &ErrorOrganizationUnitNotFound
+	}
+
+	domains := make([]string, 0, len(request.Domains))
+	for _, domain := range request.Domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if !domainPattern.MatchString(domain) {
+			return nil, &ErrorInvalidDomain
+		}
+		if !slices.Contains(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+
+	idpIDs := make([]string, 0, len(request.IdentityProviders))
+	for _, idpID := range request.IdentityProviders {
+		idpID = strings.TrimSpace(idpID)
+		if idpID == "" || slices.Contains(idpIDs, idpID) {
+			continue
+		}
+		if _, svcErr := s.idpService.GetIdentityProvider(ctx, idpID); svcErr != nil {
+			if svcErr.Type == serviceerror.ClientErrorType {
+				return nil, &ErrorIdentityProviderNotFound
+			}
+			s.logger.Error("Failed to retrieve an identity provider of the organization", log.String("idpId", idpID),
+				log.String("code", svcErr.Code))
+			return nil, &serviceerror.InternalServerError
+		}
+		idpIDs = append(idpIDs, idpID)
+	}
+
+	return &Organization{
+		Handle:               handle,
+		Name:                 name,
+		OUID:                 ouID,
+		Domains:              domains,
+		IdentityProviders:    idpIDs,
+		AuthenticationPolicy: request.AuthenticationPolicy,
+	}, nil
+}
+
+// checkConflicts checks that the handle, the organization unit and the email domains of an organization are not
+// used by another organization.
+func (s *organizationService) checkConflicts(ctx context.Context, organization *Organization) (
+	svcErr *serviceerror.ServiceError) {
+	existing, err := s.store.getOrganizationByHandle(ctx, organization.Handle)
+	if err != nil {
+		return s.logConflictCheckError(err)
+	}
+	if existing != nil && existing.ID != organization.ID {
+		return &ErrorHandleConflict
+	}
+
+	existing, err = s.store.getOrganizationByOUID(ctx, organization.OUID)
+	if err != nil {
+		return s.logConflictCheckError(err)
+	}
+	if existing != nil && existing.ID != organization.ID {
+		return &ErrorOrganizationUnitConflict
+	}
+
+	for _, domain := range organization.Domains {
+		existing, err = s.store.getOrganizationByDomain(ctx, domain)
+		if err != nil {
+			return s.logConflictCheckError(err)
+		}
+		if existing != nil && existing.ID != organization.ID {
+			return &ErrorDomainConflict
+		}
+	}
+
+	return nil
+}
+
+// logConflictCheckError logs a failure to check for conflicting organizations and returns the server error.
+func (s *organizationService) logConflictCheckError(err error) *serviceerror.ServiceError {
+	s.logger.Error("Failed to check for conflicting organizations", log.Error(err))
+	return &serviceerror.InternalServerError
+}
@@ -0,0 +1,316 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package organization
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+// stubTransactioner is a stub implementation of Transactioner for testing.
+type stubTransactioner struct{}
+
+func (s *stubTransactioner) Transact(ctx context.Context, txFunc func(context.Context) error) error {
+	return txFunc(ctx)
+}
+
+type OrganizationServiceTestSuite struct {
+	suite.Suite
+	mockStore      *organizationStoreInterfaceMock
+	mockOUService  *oumock.OrganizationUnitServiceInterfaceMock
+	mockIDPService *idpmock.IDPServiceInterfaceMock
+	service        OrganizationServiceInterface
+}
+
+func TestOrganizationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationServiceTestSuite))
+}
+
+func (suite *OrganizationServiceTestSuite) SetupTest() {
+	suite.mockStore = newOrganizationStoreInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.mockIDPService = idpmock.NewIDPServiceInterfaceMock(suite.T())
+	suite.service = newOrganizationService(suite.mockStore, suite.mockOUService, suite.mockIDPService,
+		&stubTransactioner{})
+}
+
+func (suite *OrganizationServiceTestSuite) validRequest() *OrganizationRequest {
+	return &OrganizationRequest{
+		Handle:            " Acme ",
+		Name:              "Acme",
+		OUID:              testOUID,
+		Domains:           []string{"Acme.com", "acme.com"},
+		IdentityProviders: []string{testIDPID, testIDPID},
+	}
+}
+
+func (suite *OrganizationServiceTestSuite) expectValidReferences() {
+	suite.mockOUService.EXPECT().IsOrganizationUnitExists(mock.Anything, testOUID).Return(true, nil).Once()
+	suite.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testIDPID).
+		Return(&idp.IDPDTO{ID: testIDPID}, nil).Once()
+}
+
+func (suite *OrganizationServiceTestSuite) expectNoConflicts() {
+	suite.mockStore.EXPECT().getOrganizationByHandle(mock.Anything, "acme").Return(nil, nil).Once()
+	suite.mockStore.EXPECT().getOrganizationByOUID(mock.Anything, testOUID).Return(nil, nil).Once()
+	suite.mockStore.EXPECT().getOrganizationByDomain(mock.Anything, "acme.com").Return(nil, nil).Once()
+}
+
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization() {
+	suite.expectValidReferences()
+	suite.expectNoConflicts()
+	suite.mockStore.EXPECT().createOrganization(mock.Anything, mock.MatchedBy(func(o Organization) bool {
+		return o.ID != "" && o.Handle == "acme" && len(o.Domains) == 1 && len(o.IdentityProviders) == 1
+	})).Return(nil).Once()
+
+	organization, svcErr := suite.service.CreateOrganization(context.Background(), suite.validRequest())
+	suite.Nil(svcErr)
+	suite.Require().NotNil(organization)
+	suite.Equal("acme", organization.Handle)
+	suite.Equal([]string{"acme.com"}, organization.Domains)
+	suite.Equal([]string{testIDPID}, organization.IdentityProviders)
+	suite.False(organization.CreatedAt.IsZero())
+}
+
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization_InvalidRequests() {
+	testCases := []struct {
+		name     string
+		request  *OrganizationRequest
+		expected string
+	}{
+		{"NilRequest", nil, ErrorInvalidRequestFormat.Code},
+		{"InvalidHandle", &OrganizationRequest{Handle: "acme inc", Name: "Acme"}, ErrorInvalidHandle.Code},
+		{"MissingName", &OrganizationRequest{Handle: "acme"}, ErrorMissingName.Code},
+		{"MissingOU", &OrganizationRequest{Handle: "acme", Name: "Acme"}, ErrorOrganizationUnitNotFound.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			organization, svcErr := suite.service.CreateOrganization(context.Background(), tc.request)
+			suite.Nil(organization)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization_OrganizationUnitNotFound() {
+	suite.mockOUService.EXPECT().IsOrganizationUnitExists(mock.Anything, testOUID).Return(false, nil).Once()
+
+	_, svcErr := suite.service.CreateOrganization(context.Background(), suite.validRequest())
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorOrganizationUnitNotFound.Code, svcErr.Code)
+}
+
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization_InvalidDomain() {
+	suite.mockOUService.EXPECT().IsOrganizationUnitExists(mock.Anything, testOUID).Return(true, nil).Once()
+	request := suite.validRequest()
+	request.Domains = []string{"not a domain"}
+
+	_, svcErr := suite.service.CreateOrganization(context.Background(), request)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorInvalidDomain.Code, svcErr.Code)
+}
+
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization_IdentityProviderNotFound() {
+	suite.mockOUService.EXPECT().IsOrganizationUnitExists(mock.Anything, testOUID).Return(true, nil).Once()
+	suite.mockIDPService.EXPECT().GetIdentityProvider(mock.Anything, testIDPID).
+		Return(nil, &idp.ErrorIDPNotFound).Once()
+
+	_, svcErr := suite.service.CreateOrganization(context.Background(), suite.validRequest())
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorIdentityProviderNotFound.Code, svcErr.Code)
+}
+
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization_Conflicts() {
+	existing := &Organization{ID: "org-2"}
+	testCases := []struct {
+		name     string
+		setup    func()
+		expected string
+	}{
+		{
+			name: "Handle",
+			setup: func() {
+				suite.mockStore.EXPECT().getOrganizationByHandle(mock.Anything, "acme").Return(existing, nil).Once()
+			},
+			expected: ErrorHandleConflict.Code,
+		},
+		{
+			name: "OrganizationUnit",
+			setup: func() {
+				suite.mockStore.EXPECT().getOrganizationByHandle(mock.Anything, "acme").Return(nil, nil).Once()
+				suite.mockStore.EXPECT().getOrganizationByOUID(mock.Anything, testOUID).Return(existing, nil).Once()
+			},
+			expected: ErrorOrganizationUnitConflict.Code,
+		},
+		{
+			name: "Domain",
+			setup: func() {
+				suite.mockStore.EXPECT().getOrganizationByHandle(mock.Anything, "acme").Return(nil, nil).Once()
+				suite.mockStore.EXPECT().getOrganizationByOUID(mock.Anything, testOUID).Return(nil, nil).Once()
+				suite.mockStore.EXPECT().getOrganizationByDomain(mock.Anything, "acme.com").
+					Return(existing, nil).Once()
+			},
+			expected: ErrorDomainConflict.Code,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.expectValidReferences()
+			tc.setup()
+
+			organization, svcErr := suite.service.CreateOrganization(context.Background(), suite.validRequest())
+			suite.Nil(organization)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *OrganizationServiceTestSuite) TestCreateOrganization_StoreError() {
+	suite.expectValidReferences()
+	suite.expectNoConflicts()
+	suite.mockStore.EXPECT().createOrganization(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	_, svcErr := suite.service.CreateOrganization(context.Background(), suite.validRequest())
+	suite.Require().NotNil(svcErr)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *OrganizationServiceTestSuite) TestGetOrganization() {
+	suite.mockStore.EXPECT().getOrganization(mock.Anything, testOrganizationID).
+		Return(&Organization{ID: testOrganizationID}, nil).Once()
+
+	organization, svcErr := suite.service.GetOrganization(context.Background(), testOrganizationID)
+	suite.Nil(svcErr)
+	suite.Equal(testOrganizationID, organization.ID)
+}
+
+func (suite *OrganizationServiceTestSuite) TestGetOrganization_NotFound() {
+	suite.mockStore.EXPECT().getOrganization(mock.Anything, testOrganizationID).Return(nil, nil).Once()
+
+	_, svcErr := suite.service.GetOrganization(context.Background(), testOrganizationID)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorOrganizationNotFound.Code, svcErr.Code)
+}
+
+func (suite *OrganizationServiceTestSuite) TestGetOrganizationByHandle_NormalizesHandle() {
+	suite.mockStore.EXPECT().getOrganizationByHandle(mock.Anything, "acme").
+		Return(&Organization{ID: testOrganizationID}, nil).Once()
+
+	organization, svcErr := suite.service.GetOrganizationByHandle(context.Background(), " ACME ")
+	suite.Nil(svcErr)
+	suite.Equal(testOrganizationID, organization.ID)
+}
+
+func (suite *OrganizationServiceTestSuite) TestGetOrganizationByEmailDomain() {
+	suite.mockStore.EXPECT().getOrganizationByDomain(mock.Anything, "acme.com").
+		Return(&Organization{ID: testOrganizationID}, nil).Once()
+
+	organization, svcErr := suite.service.GetOrganizationByEmailDomain(context.Background(), "Acme.com")
+	suite.Nil(svcErr)
+	suite.Equal(testOrganizationID, organization.ID)
+}
+
+func (suite *OrganizationServiceTestSuite) TestGetOrganizationByEmailDomain_StoreError() {
+	suite.mockStore.EXPECT().getOrganizationByDomain(mock.Anything, "acme.com").
+		Return(nil, errors.New("db error")).Once()
+
+	_, svcErr := suite.service.GetOrganizationByEmailDomain(context.Background(), "acme.com")
+	suite.Require().NotNil(svcErr)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *OrganizationServiceTestSuite) TestListOrganizations() {
+	suite.mockStore.EXPECT().countOrganizations(mock.Anything).Return(1, nil).Once()
+	suite.mockStore.EXPECT().listOrganizations(mock.Anything, 10, 0).
+		Return([]Organization{{ID: testOrganizationID}}, nil).Once()
+
+	list, svcErr := suite.service.ListOrganizations(context.Background(), 10, 0)
+	suite.Nil(svcErr)
+	suite.Equal(1, list.TotalResults)
+	suite.Equal(1, list.StartIndex)
+	suite.Equal(1, list.Count)
+}
+
+func (suite *OrganizationServiceTestSuite) TestListOrganizations_InvalidPagination() {
+	_, svcErr := suite.service.ListOrganizations(context.Background(), 0, 0)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorInvalidLimit.Code, svcErr.Code)
+
+	_, svcErr = suite.service.ListOrganizations(context.Background(), 10, -1)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorInvalidOffset.Code, svcErr.Code)
+}
+
+func (suite *OrganizationServiceTestSuite) TestUpdateOrganization() {
+	createdAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	existing := &Organization{ID: testOrganizationID, CreatedAt: createdAt}
+	suite.expectValidReferences()
+	suite.mockStore.EXPECT().getOrganization(mock.Anything, testOrganizationID).Return(existing, nil).Once()
+	suite.mockStore.EXPECT().getOrganizationByHandle(mock.Anything, "acme").Return(existing, nil).Once()
+	suite.mockStore.EXPECT().getOrganizationByOUID(mock.Anything, testOUID).Return(existing, nil).Once()
+	suite.mockStore.EXPECT().getOrganizationByDomain(mock.Anything, "acme.com").Return(nil, nil).Once()
+	suite.mockStore.EXPECT().updateOrganization(mock.Anything, mock.MatchedBy(func(o Organization) bool {
+		return o.ID == testOrganizationID && o.CreatedAt.Equal(createdAt)
+	})).Return(nil).Once()
+
+	organization, svcErr := suite.service.UpdateOrganization(context.Background(), testOrganizationID,
+		suite.validRequest())
+	suite.Nil(svcErr)
+	suite.Equal(testOrganizationID, organization.ID)
+	suite.Equal(createdAt, organization.CreatedAt)
+}
+
+func (suite *OrganizationServiceTestSuite) TestUpdateOrganization_NotFound() {
+	suite.expectValidReferences()
+	suite.mockStore.EXPECT().getOrganization(mock.Anything, testOrganizationID).Return(nil, nil).Once()
+
+	_, svcErr := suite.service.UpdateOrganization(context.Background(), testOrganizationID, suite.validRequest())
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorOrganizationNotFound.Code, svcErr.Code)
+}
+
+func (suite *OrganizationServiceTestSuite) TestDeleteOrganization() {
+	suite.mockStore.EXPECT().getOrganization(mock.Anything, testOrganizationID).
+		Return(&Organization{ID: testOrganizationID}, nil).Once()
+	suite.mockStore.EXPECT().deleteOrganization(mock.Anything, testOrganizationID).Return(nil).Once()
+
+	suite.Nil(suite.service.DeleteOrganization(context.Background(), testOrganizationID))
+}
+
+func (suite *OrganizationServiceTestSuite) TestDeleteOrganization_NotFound() {
+	suite.mockStore.EXPECT().getOrganization(mock.Anything, testOrganizationID).Return(nil, nil).Once()
+
+	svcErr := suite.service.DeleteOrganization(context.Background(), testOrganizationID)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorOrganizationNotFound.Code, svcErr.Code)
+}
@@ -0,0 +1,345 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package organization
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// organizationStoreInterface defines the interface for organization storage operations.
+type organizationStoreInterface interface {
+	createOrganization(ctx context.Context, organization Organization) error
+	getOrganization(ctx context.Context, id string) (*Organization, error)
+	getOrganizationByHandle(ctx context.Context, handle string) (*Organization, error)
+	getOrganizationByOUID(ctx context.Context, ouID string) (*Organization, error)
+	getOrganizationByDomain(ctx context.Context, domain string) (*Organization, error)
+	countOrganizations(ctx context.Context) (int, error)
+	listOrganizations(ctx context.Context, limit, offset int) ([]Organization, error)
+	updateOrganization(ctx context.Context, organization Organization) error
+	deleteOrganization(ctx context.Context, id string) error
+}
+
+// organizationStore is the user database implementation of organizationStoreInterface.
+type organizationStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newOrganizationStore returns a new instance of organizationStoreInterface.
+func newOrganizationStore() organizationStoreInterface {
+	return &organizationStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createOrganization stores a new organization along with its email domains.
+func (s *organizationStore) createOrganization(ctx context.Context, organization Organization) error {
+	idpsJSON, policyJSON, err := marshalOrganizationProperties(organization)
+	if err != nil {
+		return err
+	}
+
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateOrganization, organization.ID, organization.Handle,
+		organization.Name, organization.OUID, idpsJSON, policyJSON, organization.CreatedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	for _, domain := range organization.Domains {
+		if _, err := dbClient.ExecuteContext(ctx, queryAddOrganizationDomain, domain, organization.ID,
+			s.deploymentID); err != nil {
+			return fmt.Errorf("failed to add organization domain: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// getOrganization retrieves an organization by its ID. It returns nil if the organization does not exist.
+func (s *organizationStore) getOrganization(ctx context.Context, id string) (*Organization, error) {
+	return s.getOrganizationWithQuery(ctx, queryGetOrganization, id)
+}
+
+// getOrganizationByHandle retrieves an organization by its handle. It returns nil if the organization does not
+// exist.
+func (s *organizationStore) getOrganizationByHandle(ctx context.Context, handle string) (*Organization, error) {
+	return s.getOrganizationWithQuery(ctx, queryGetOrganizationByHandle, handle)
+}
+
+// getOrganizationByOUID retrieves the organization built on an organization unit. It returns nil if there is no
+// such organization.
+func (s *organizationStore) getOrganizationByOUID(ctx context.Context, ouID string) (*Organization, error) {
+	return s.getOrganizationWithQuery(ctx, queryGetOrganizationByOUID, ouID)
+}
+
+// getOrganizationByDomain retrieves the organization owning an email domain. It returns nil if no organization
+// owns the domain.
+func (s *organizationStore) getOrganizationByDomain(ctx context.Context, domain string) (*Organization, error) {
+	return s.getOrganizationWithQuery(ctx, queryGetOrganizationByDomain, domain)
+}
+
+// countOrganizations returns the number of organizations.
+func (s *organizationStore) countOrganizations(ctx context.Context) (int, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountOrganizations, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+
+	return parseCount(results[0]["total"])
+}
+
+// listOrganizations retrieves a page of the organizations, ordered by their handle.
+func (s *organizationStore) listOrganizations(ctx context.Context, limit, offset int) ([]Organization, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListOrganizations, s.deploymentID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	organizations := make([]Organization, 0, len(results))
+	for _, row := range results {
+		organization, err := buildOrganizationFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		if organization.Domains, err = s.listDomains(ctx, organization.ID); err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, *organization)
+	}
+
+	return organizations, nil
+}
+
+// updateOrganization updates an organization and replaces its email domains.
+func (s *organizationStore) updateOrganization(ctx context.Context, organization Organization) error {
+	idpsJSON, policyJSON, err := marshalOrganizationProperties(organization)
+	if err != nil {
+		return err
+	}
+
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateOrganization, organization.ID, organization.Handle,
+		organization.Name, organization.OUID, idpsJSON, policyJSON, organization.UpdatedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteOrganizationDomains, organization.ID,
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to remove organization domains: %w", err)
+	}
+	for _, domain := range organization.Domains {
+		if _, err := dbClient.ExecuteContext(ctx, queryAddOrganizationDomain, domain, organization.ID,
+			s.deploymentID); err != nil {
+			return fmt.Errorf("failed to add organization domain: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteOrganization deletes an organization along with its email domains.
+func (s *organizationStore) deleteOrganization(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteOrganizationDomains, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to remove organization domains: %w", err)
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteOrganization, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// getOrganizationWithQuery retrieves the organization matching the given query along with its email domains.
+// It returns nil if no organization matches.
+func (s *organizationStore) getOrganizationWithQuery(ctx context.Context, query dbmodel.DBQuery, value string) (
+	*Organization, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, value, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	organization, err := buildOrganizationFromResultRow(results[0])
+	if err != nil {
+		return nil, err
+	}
+	if organization.Domains, err = s.listDomains(ctx, organization.ID); err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// listDomains retrieves the email domains of an organization.
+func (s *organizationStore) listDomains(ctx context.Context, organizationID string) ([]string, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListOrganizationDomains, organizationID, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	domains := make([]string, 0, len(results))
+	for _, row := range results {
+		domain, ok := row["domain"].(string)
+		if !ok {
+			return nil, errors.New("failed to parse domain as string")
+		}
+		domains = append(domains, domain)
+	}
+
+	return domains, nil
+}
+
+// marshalOrganizationProperties marshals the identity providers and the authentication policy of an
+// organization to be stored.
+func marshalOrganizationProperties(organization Organization) (string, string, error) {
+	idps := organization.IdentityProviders
+	if idps == nil {
+		idps = []string{}
+	}
+	idpsJSON, err := json.Marshal(idps)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal the identity providers: %w", err)
+	}
+	policyJSON, err := json.Marshal(organization.AuthenticationPolicy)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal the authentication policy: %w", err)
+	}
+
+	return string(idpsJSON), string(policyJSON), nil
+}
+
+// buildOrganizationFromResultRow constructs an Organization from a database result row. The email domains are
+// not part of the row and are left empty.
+func buildOrganizationFromResultRow(row map[string]interface{}) (*Organization, error) {
+	organization := &Organization{}
+	stringFields := []struct {
+		column string
+		value  *string
+	}{
+		{"id", &organization.ID},
+		{"handle", &organization.Handle},
+		{"name", &organization.Name},
+		{"ou_id", &organization.OUID},
+	}
+	for _, field := range stringFields {
+		value, ok := row[field.column].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse %s as string", field.column)
+		}
+		*field.value = value
+	}
+
+	organization.IdentityProviders = []string{}
+	if idpsJSON := getJSONColumn(row["identity_providers"]); len(idpsJSON) > 0 {
+		if err := json.Unmarshal(idpsJSON, &organization.IdentityProviders); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal identity_providers: %w", err)
+		}
+	}
+	if policyJSON := getJSONColumn(row["authentication_policy"]); len(policyJSON) > 0 {
+		if err := json.Unmarshal(policyJSON, &organization.AuthenticationPolicy); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal authentication_policy: %w", err)
+		}
+	}
+
+	var err error
+	if organization.CreatedAt, err = dbutils.ParseTimeField(row["created_at"], "created_at"); err != nil {
+		return nil, err
+	}
+	if organization.UpdatedAt, err = dbutils.ParseTimeField(row["updated_at"], "updated_at"); err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// getJSONColumn returns the raw value of a JSON column, which is returned as a string or a byte slice depending
+// on the database driver.
+func getJSONColumn(value interface{}) []byte {
+	switch v := value.(type) {
+	case string:
+		return []byte(v)
+	case []byte:
+		return v
+	default:
+		return nil
+	}
+}
+
+// parseCount parses an integer value returned by the database driver.
+func parseCount(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case int64:
+		return int(v), nil
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected type for count: %T", v)
+	}
+}
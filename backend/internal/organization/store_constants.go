@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package organization
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+// organizationColumns lists the columns selected when retrieving organizations.
+const organizationColumns = `ID, HANDLE, NAME, OU_ID, IDENTITY_PROVIDERS, AUTHENTICATION_POLICY, CREATED_AT, UPDATED_AT`
+
+var (
+	// queryCreateOrganization is the query to create an organization.
+	queryCreateOrganization = dbmodel.DBQuery{
+		ID: "ORG-01",
+		Query: `INSERT INTO "ORGANIZATION" (ID, HANDLE, NAME, OU_ID, IDENTITY_PROVIDERS, AUTHENTICATION_POLICY, ` +
+			`CREATED_AT, UPDATED_AT, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $8)`,
+	}
+
+	// queryGetOrganization is the query to get an organization by its ID.
+	queryGetOrganization = dbmodel.DBQuery{
+		ID: "ORG-02",
+		Query: `SELECT ` + organizationColumns + ` FROM "ORGANIZATION" ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetOrganizationByHandle is the query to get an organization by its handle.
+	queryGetOrganizationByHandle = dbmodel.DBQuery{
+		ID: "ORG-03",
+		Query: `SELECT ` + organizationColumns + ` FROM "ORGANIZATION" ` +
+			`WHERE HANDLE = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetOrganizationByOUID is the query to get the organization built on an organization unit.
+	queryGetOrganizationByOUID = dbmodel.DBQuery{
+		ID: "ORG-04",
+		Query: `SELECT ` + organizationColumns + ` FROM "ORGANIZATION" ` +
+			`WHERE OU_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetOrganizationByDomain is the query to get the organization owning an email domain.
+	queryGetOrganizationByDomain = dbmodel.DBQuery{
+		ID: "ORG-05",
+		Query: `SELECT o.ID, o.HANDLE, o.NAME, o.OU_ID, o.IDENTITY_PROVIDERS, o.AUTHENTICATION_POLICY, ` +
+			`o.CREATED_AT, o.UPDATED_AT FROM "ORGANIZATION" o JOIN "ORGANIZATION_DOMAIN" d ` +
+			`ON d.ORGANIZATION_ID = o.ID AND d.DEPLOYMENT_ID = o.DEPLOYMENT_ID ` +
+			`WHERE d.DOMAIN = $1 AND d.DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCountOrganizations is the query to count the organizations.
+	queryCountOrganizations = dbmodel.DBQuery{
+		ID:    "ORG-06",
+		Query: `SELECT COUNT(*) as total FROM "ORGANIZATION" WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryListOrganizations is the query to list a page of the organizations, ordered by their handle.
+	queryListOrganizations = dbmodel.DBQuery{
+		ID: "ORG-07",
+		Query: `SELECT ` + organizationColumns + ` FROM "ORGANIZATION" WHERE DEPLOYMENT_ID = $1 ` +
+			`ORDER BY HANDLE LIMIT $2 OFFSET $3`,
+	}
+
+	// queryUpdateOrganization is the query to update an organization.
+	queryUpdateOrganization = dbmodel.DBQuery{
+		ID: "ORG-08",
+		Query: `UPDATE "ORGANIZATION" SET HANDLE = $2, NAME = $3, OU_ID = $4, IDENTITY_PROVIDERS = $5, ` +
+			`AUTHENTICATION_POLICY = $6, UPDATED_AT = $7 WHERE ID = $1 AND DEPLOYMENT_ID = $8`,
+	}
+
+	// queryDeleteOrganization is the query to delete an organization.
+	queryDeleteOrganization = dbmodel.DBQuery{
+		ID:    "ORG-09",
+		Query: `DELETE FROM "ORGANIZATION" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryListOrganizationDomains is the query to list the email domains of an organization.
+	queryListOrganizationDomains = dbmodel.DBQuery{
+		ID: "ORG-10",
+		Query: `SELECT DOMAIN FROM "ORGANIZATION_DOMAIN" WHERE ORGANIZATION_ID = $1 AND DEPLOYMENT_ID = $2 ` +
+			`ORDER BY DOMAIN`,
+	}
+
+	// queryAddOrganizationDomain is the query to add an email domain to an organization.
+	queryAddOrganizationDomain = dbmodel.DBQuery{
+		ID: "ORG-11",
+		Query: `INSERT INTO "ORGANIZATION_DOMAIN" (DOMAIN, ORGANIZATION_ID, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3)`,
+	}
+
+	// queryDeleteOrganizationDomains is the query to remove all the email domains of an organization.
+	queryDeleteOrganizationDomains = dbmodel.DBQuery{
+		ID:    "ORG-12",
+		Query: `DELETE FROM "ORGANIZATION_DOMAIN" WHERE ORGANIZATION_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,220 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package organization
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID   = "test-deployment"
+	testOrganizationID = "org-1"
+	testOUID           = "ou-1"
+	testIDPID          = "idp-1"
+)
+
+type OrganizationStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *organizationStore
+}
+
+func TestOrganizationStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationStoreTestSuite))
+}
+
+func (suite *OrganizationStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &organizationStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *OrganizationStoreTestSuite) organizationRow() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    testOrganizationID,
+		"handle":                "acme",
+		"name":                  "Acme",
+		"ou_id":                 testOUID,
+		"identity_providers":    `["idp-1"]`,
+		"authentication_policy": []byte(`{"requireOrganizationIdp":true}`),
+		"created_at":            "2026-01-02 03:04:05.000000",
+		"updated_at":            time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *OrganizationStoreTestSuite) TestCreateOrganization() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateOrganization, testOrganizationID,
+		"acme", "Acme", testOUID, `["idp-1"]`, `{"requireOrganizationIdp":true}`, createdAt, testDeploymentID).
+		Return(int64(1), nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryAddOrganizationDomain, "acme.com",
+		testOrganizationID, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createOrganization(context.Background(), Organization{
+		ID:                   testOrganizationID,
+		Handle:               "acme",
+		Name:                 "Acme",
+		OUID:                 testOUID,
+		Domains:              []string{"acme.com"},
+		IdentityProviders:    []string{testIDPID},
+		AuthenticationPolicy: AuthenticationPolicy{RequireOrganizationIDP: true},
+		CreatedAt:            createdAt,
+	})
+	suite.NoError(err)
+}
+
+func (suite *OrganizationStoreTestSuite) TestCreateOrganization_WithoutIdentityProviders() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateOrganization, testOrganizationID,
+		"acme", "Acme", testOUID, `[]`, `{"requireOrganizationIdp":false}`, createdAt, testDeploymentID).
+		Return(int64(1), nil).Once()
+
+	err := suite.store.createOrganization(context.Background(), Organization{
+		ID:        testOrganizationID,
+		Handle:    "acme",
+		Name:      "Acme",
+		OUID:      testOUID,
+		CreatedAt: createdAt,
+	})
+	suite.NoError(err)
+}
+
+func (suite *OrganizationStoreTestSuite) TestCreateOrganization_DBClientError() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(nil, errors.New("db error")).Once()
+
+	err := suite.store.createOrganization(context.Background(), Organization{ID: testOrganizationID})
+	suite.Error(err)
+}
+
+func (suite *OrganizationStoreTestSuite) TestGetOrganizationByHandle() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Twice()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetOrganizationByHandle, "acme",
+		testDeploymentID).Return([]map[string]interface{}{suite.organizationRow()}, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListOrganizationDomains,
+		testOrganizationID, testDeploymentID).
+		Return([]map[string]interface{}{{"domain": "acme.com"}}, nil).Once()
+
+	organization, err := suite.store.getOrganizationByHandle(context.Background(), "acme")
+	suite.NoError(err)
+	suite.Require().NotNil(organization)
+	suite.Equal(testOrganizationID, organization.ID)
+	suite.Equal(testOUID, organization.OUID)
+	suite.Equal([]string{testIDPID}, organization.IdentityProviders)
+	suite.Equal([]string{"acme.com"}, organization.Domains)
+	suite.True(organization.AuthenticationPolicy.RequireOrganizationIDP)
+	suite.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), organization.CreatedAt)
+}
+
+func (suite *OrganizationStoreTestSuite) TestGetOrganizationByDomain_NotFound() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetOrganizationByDomain, "acme.com",
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	organization, err := suite.store.getOrganizationByDomain(context.Background(), "acme.com")
+	suite.NoError(err)
+	suite.Nil(organization)
+}
+
+func (suite *OrganizationStoreTestSuite) TestGetOrganization_InvalidRow() {
+	row := suite.organizationRow()
+	row["identity_providers"] = "not-json"
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetOrganization, testOrganizationID,
+		testDeploymentID).Return([]map[string]interface{}{row}, nil).Once()
+
+	organization, err := suite.store.getOrganization(context.Background(), testOrganizationID)
+	suite.Error(err)
+	suite.Nil(organization)
+}
+
+func (suite *OrganizationStoreTestSuite) TestCountOrganizations() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryCountOrganizations, testDeploymentID).
+		Return([]map[string]interface{}{{"total": int64(3)}}, nil).Once()
+
+	count, err := suite.store.countOrganizations(context.Background())
+	suite.NoError(err)
+	suite.Equal(3, count)
+}
+
+func (suite *OrganizationStoreTestSuite) TestListOrganizations() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Twice()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListOrganizations, testDeploymentID,
+		10, 0).Return([]map[string]interface{}{suite.organizationRow()}, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListOrganizationDomains,
+		testOrganizationID, testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	organizations, err := suite.store.listOrganizations(context.Background(), 10, 0)
+	suite.NoError(err)
+	suite.Len(organizations, 1)
+	suite.Empty(organizations[0].Domains)
+}
+
+func (suite *OrganizationStoreTestSuite) TestUpdateOrganization() {
+	updatedAt := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateOrganization, testOrganizationID,
+		"acme", "Acme", testOUID, `[]`, `{"requireOrganizationIdp":false}`, updatedAt, testDeploymentID).
+		Return(int64(1), nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteOrganizationDomains,
+		testOrganizationID, testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryAddOrganizationDomain, "acme.io",
+		testOrganizationID, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.updateOrganization(context.Background(), Organization{
+		ID:        testOrganizationID,
+		Handle:    "acme",
+		Name:      "Acme",
+		OUID:      testOUID,
+		Domains:   []string{"acme.io"},
+		UpdatedAt: updatedAt,
+	})
+	suite.NoError(err)
+}
+
+func (suite *OrganizationStoreTestSuite) TestDeleteOrganization() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteOrganizationDomains,
+		testOrganizationID, testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteOrganization,
+		testOrganizationID, testDeploymentID).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.deleteOrganization(context.Background(), testOrganizationID))
+}
+
+func (suite *OrganizationStoreTestSuite) TestDeleteOrganization_ExecuteError() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteOrganizationDomains,
+		testOrganizationID, testDeploymentID).Return(int64(0), errors.New("exec error")).Once()
+
+	suite.Error(suite.store.deleteOrganization(context.Background(), testOrganizationID))
+}
@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ou
+
+import (
+	"context"
+
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/filter"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ouByIDCacheName is the name of the cache holding organization units by ID.
+const ouByIDCacheName = "OrganizationUnitByIDCache"
+
+// ouByIDRequestCacheKeyPrefix is the prefix of the request cache keys of organization units by ID.
+const ouByIDRequestCacheKeyPrefix = "ou:by-id:"
+
+// cacheBackedOUStore wraps an organizationUnitStoreInterface with a cache of organization units by ID.
+// Organization units are read by ID on every step of a hierarchy walk, such as authorization ancestry checks
+// and design resolution, so caching them avoids a database query per level of the tree.
+type cacheBackedOUStore struct {
+	ouByIDCache cache.CacheInterface[*OrganizationUnit]
+	store       organizationUnitStoreInterface
+	logger      *log.Logger
+}
+
+// newCacheBackedOUStore creates a cache-backed wrapper around the given store.
+func newCacheBackedOUStore(
+	store organizationUnitStoreInterface,
+	ouByIDCache cache.CacheInterface[*OrganizationUnit],
+) organizationUnitStoreInterface {
+	return &cacheBackedOUStore{
+		ouByIDCache: ouByIDCache,
+		store:       store,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheBackedOUStore")),
+	}
+}
+
+// GetOrganizationUnitListCount delegates to the underlying store.
+func (s *cacheBackedOUStore) GetOrganizationUnitListCount(ctx context.Context, f *filter.FilterGroup) (int, error) {
+	return s.store.GetOrganizationUnitListCount(ctx, f)
+}
+
+// GetOrganizationUnitList delegates to the underlying store.
+func (s *cacheBackedOUStore) GetOrganizationUnitList(
+	ctx context.Context, limit, offset int, f *filter.FilterGroup,
+) ([]OrganizationUnitBasic, error) {
+	return s.store.GetOrganizationUnitList(ctx, limit, offset, f)
+}
+
+// GetOrganizationUnitsByIDs delegates to the underlying store.
+func (s *cacheBackedOUStore) GetOrganizationUnitsByIDs(
+	ctx context.Context, ids []string,
+) ([]OrganizationUnitBasic, error) {
+	return s.store.GetOrganizationUnitsByIDs(ctx, ids)
+}
+
+// CreateOrganizationUnit delegates to the underlying store. The organization unit is cached when it is
+// first read, so that the cached state includes the values assigned by the store.
+func (s *cacheBackedOUStore) CreateOrganizationUnit(ctx context.Context, ou OrganizationUnit) error {
+	return s.store.CreateOrganizationUnit(ctx, ou)
+}
+
+// GetOrganizationUnit retrieves an organization unit by ID, checking the request cache and then the cache
+// first. The request cache avoids repeating the lookup when the same hierarchy is walked several times while
+// serving a request, even when the cache is disabled.
+func (s *cacheBackedOUStore) GetOrganizationUnit(ctx context.Context, id string) (OrganizationUnit, error) {
+	requestCacheKey := ouByIDRequestCacheKeyPrefix + id
+	if cached, ok := sysContext.GetRequestCacheValue(ctx, requestCacheKey); ok {
+		if ou, ok := cached.(OrganizationUnit); ok {
+			return ou, nil
+		}
+	}
+
+	if cached, ok := s.ouByIDCache.Get(ctx, cache.CacheKey{Key: id}); ok && cached != nil {
+		sysContext.SetRequestCacheValue(ctx, requestCacheKey, *cached)
+		return *cached, nil
+	}
+
+	ou, err := s.store.GetOrganizationUnit(ctx, id)
+	if err != nil {
+		return ou, err
+	}
+
+	if err := s.ouByIDCache.Set(ctx, cache.CacheKey{Key: id}, &ou); err != nil {
+		s.logger.Error("Failed to cache organization unit by ID", log.String("ouID", id), log.Error(err))
+	}
+	sysContext.SetRequestCacheValue(ctx, requestCacheKey, ou)
+
+	return ou, nil
+}
+
+// GetOrganizationUnitByHandle delegates to the underlying store.
+func (s *cacheBackedOUStore) GetOrganizationUnitByHandle(
+	ctx context.Context, handle string, parent *string,
+) (OrganizationUnit, error) {
+	return s.store.GetOrganizationUnitByHandle(ctx, handle, parent)
+}
+
+// GetOrganizationUnitByPath delegates to the underlying store.
+func (s *cacheBackedOUStore) GetOrganizationUnitByPath(
+	ctx context.Context, handles []string,
+) (OrganizationUnit, error) {
+	return s.store.GetOrganizationUnitByPath(ctx, handles)
+}
+
+// IsOrganizationUnitExists reports whether an organization unit exists, treating a cached organization unit
+// as existing.
+func (s *cacheBackedOUStore) IsOrganizationUnitExists(ctx context.Context, id string) (bool, error) {
+	if cached, ok := s.ouByIDCache.Get(ctx, cache.CacheKey{Key: id}); ok && cached != nil {
+		return true, nil
+	}
+	return s.store.IsOrganizationUnitExists(ctx, id)
+}
+
+// IsOrganizationUnitDeclarative delegates to the underlying store.
+func (s *cacheBackedOUStore) IsOrganizationUnitDeclarative(ctx context.Context, id string) bool {
+	return s.store.IsOrganizationUnitDeclarative(ctx, id)
+}
+
+// CheckOrganizationUnitNameConflict delegates to the underlying store.
+func (s *cacheBackedOUStore) CheckOrganizationUnitNameConflict(
+	ctx context.Context, name string, parent *string,
+) (bool, error) {
+	return s.store.CheckOrganizationUnitNameConflict(ctx, name, parent)
+}
+
+// CheckOrganizationUnitHandleConflict delegates to the underlying store.
+func (s *cacheBackedOUStore) CheckOrganizationUnitHandleConflict(
+	ctx context.Context, handle string, parent *string,
+) (bool, error) {
+	return s.store.CheckOrganizationUnitHandleConflict(ctx, handle, parent)
+}
+
+// UpdateOrganizationUnit updates an organization unit and invalidates its cache entry.
+func (s *cacheBackedOUStore) UpdateOrganizationUnit(ctx context.Context, ou OrganizationUnit) error {
+	if err := s.store.UpdateOrganizationUnit(ctx, ou); err != nil {
+		return err
+	}
+	s.invalidateOrganizationUnit(ctx, ou.ID)
+	return nil
+}
+
+// DeleteOrganizationUnit deletes an organization unit and invalidates its cache entry.
+func (s *cacheBackedOUStore) DeleteOrganizationUnit(ctx context.Context, id string) error {
+	if err := s.store.DeleteOrganizationUnit(ctx, id); err != nil {
+		return err
+	}
+	s.invalidateOrganizationUnit(ctx, id)
+	return nil
+}
+
+// GetOrganizationUnitChildrenCount delegates to the underlying store.
+func (s *cacheBackedOUStore) GetOrganizationUnitChildrenCount(
+	ctx context.Context, id string, f *filter.FilterGroup,
+) (int, error) {
+	return s.store.GetOrganizationUnitChildrenCount(ctx, id, f)
+}
+
+// GetOrganizationUnitChildrenList delegates to the underlying store.
+func (s *cacheBackedOUStore) GetOrganizationUnitChildrenList(
+	ctx context.Context, id string, limit, offset int, f *filter.FilterGroup,
+) ([]OrganizationUnitBasic, error) {
+	return s.store.GetOrganizationUnitChildrenList(ctx, id, limit, offset, f)
+}
+
+// invalidateOrganizationUnit removes the organization unit from the cache. The request cache is cleared as a
+// whole since values resolved from the organization unit hierarchy, such as accessible resource sets, may also
+// be stale.
+func (s *cacheBackedOUStore) invalidateOrganizationUnit(ctx context.Context, id string) {
+	if err := s.ouByIDCache.Delete(ctx, cache.CacheKey{Key: id}); err != nil {
+		s.logger.Error("Failed to invalidate organization unit cache by ID", log.String("ouID", id),
+			log.Error(err))
+	}
+	sysContext.ClearRequestCache(ctx)
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ou
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/cachemock"
+)
+
+const testCachedOUID = "ou-1"
+
+type CacheBackedOUStoreTestSuite struct {
+	suite.Suite
+	mockStore   *organizationUnitStoreInterfaceMock
+	ouByIDCache *cachemock.CacheInterfaceMock[*OrganizationUnit]
+	cachedStore *cacheBackedOUStore
+}
+
+func TestCacheBackedOUStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(CacheBackedOUStoreTestSuite))
+}
+
+func (suite *CacheBackedOUStoreTestSuite) SetupTest() {
+	suite.mockStore = newOrganizationUnitStoreInterfaceMock(suite.T())
+	suite.ouByIDCache = cachemock.NewCacheInterfaceMock[*OrganizationUnit](suite.T())
+	suite.cachedStore = &cacheBackedOUStore{
+		ouByIDCache: suite.ouByIDCache,
+		store:       suite.mockStore,
+		logger:      log.GetLogger(),
+	}
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestNewCacheBackedOUStore() {
+	suite.NotNil(newCacheBackedOUStore(suite.mockStore, suite.ouByIDCache))
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestGetOrganizationUnit_CacheHit() {
+	cached := &OrganizationUnit{ID: testCachedOUID, Handle: "engineering"}
+	suite.ouByIDCache.EXPECT().Get(mock.Anything, cache.CacheKey{Key: testCachedOUID}).Return(cached, true).Once()
+
+	ou, err := suite.cachedStore.GetOrganizationUnit(context.Background(), testCachedOUID)
+	suite.NoError(err)
+	suite.Equal(*cached, ou)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetOrganizationUnit", mock.Anything, mock.Anything)
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestGetOrganizationUnit_CacheMiss() {
+	stored := OrganizationUnit{ID: testCachedOUID, Handle: "engineering"}
+	suite.ouByIDCache.EXPECT().Get(mock.Anything, cache.CacheKey{Key: testCachedOUID}).Return(nil, false).Once()
+	suite.mockStore.EXPECT().GetOrganizationUnit(mock.Anything, testCachedOUID).Return(stored, nil).Once()
+	suite.ouByIDCache.EXPECT().Set(mock.Anything, cache.CacheKey{Key: testCachedOUID}, &stored).Return(nil).Once()
+
+	ou, err := suite.cachedStore.GetOrganizationUnit(context.Background(), testCachedOUID)
+	suite.NoError(err)
+	suite.Equal(stored, ou)
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestGetOrganizationUnit_NotFoundIsNotCached() {
+	suite.ouByIDCache.EXPECT().Get(mock.Anything, cache.CacheKey{Key: testCachedOUID}).Return(nil, false).Once()
+	suite.mockStore.EXPECT().GetOrganizationUnit(mock.Anything, testCachedOUID).
+		Return(OrganizationUnit{}, ErrOrganizationUnitNotFound).Once()
+
+	_, err := suite.cachedStore.GetOrganizationUnit(context.Background(), testCachedOUID)
+	suite.ErrorIs(err, ErrOrganizationUnitNotFound)
+	suite.ouByIDCache.AssertNotCalled(suite.T(), "Set", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestGetOrganizationUnit_RequestCacheHit() {
+	ctx := sysContext.WithRequestCache(context.Background())
+	stored := OrganizationUnit{ID: testCachedOUID, Handle: "engineering"}
+	suite.ouByIDCache.EXPECT().Get(mock.Anything, cache.CacheKey{Key: testCachedOUID}).Return(nil, false).Once()
+	suite.mockStore.EXPECT().GetOrganizationUnit(mock.Anything, testCachedOUID).Return(stored, nil).Once()
+	suite.ouByIDCache.EXPECT().Set(mock.Anything, cache.CacheKey{Key: testCachedOUID}, &stored).Return(nil).Once()
+
+	for i := 0; i < 3; i++ {
+		ou, err := suite.cachedStore.GetOrganizationUnit(ctx, testCachedOUID)
+		suite.NoError(err)
+		suite.Equal(stored, ou)
+	}
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestUpdateOrganizationUnit_ClearsRequestCache() {
+	ctx := sysContext.WithRequestCache(context.Background())
+	sysContext.SetRequestCacheValue(ctx, ouByIDRequestCacheKeyPrefix+testCachedOUID,
+		OrganizationUnit{ID: testCachedOUID, Handle: "engineering"})
+	ou := OrganizationUnit{ID: testCachedOUID, Handle: "research"}
+	suite.mockStore.EXPECT().UpdateOrganizationUnit(mock.Anything, ou).Return(nil).Once()
+	suite.ouByIDCache.EXPECT().Delete(mock.Anything, cache.CacheKey{Key: testCachedOUID}).Return(nil).Once()
+
+	suite.NoError(suite.cachedStore.UpdateOrganizationUnit(ctx, ou))
+	_, ok := sysContext.GetRequestCacheValue(ctx, ouByIDRequestCacheKeyPrefix+testCachedOUID)
+	suite.False(ok)
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestIsOrganizationUnitExists() {
+	suite.ouByIDCache.EXPECT().Get(mock.Anything, cache.CacheKey{Key: testCachedOUID}).
+		Return(&OrganizationUnit{ID: testCachedOUID}, true).Once()
+
+	exists, err := suite.cachedStore.IsOrganizationUnitExists(context.Background(), testCachedOUID)
+	suite.NoError(err)
+	suite.True(exists)
+
+	suite.ouByIDCache.EXPECT().Get(mock.Anything, cache.CacheKey{Key: "ou-2"}).Return(nil, false).Once()
+	suite.mockStore.EXPECT().IsOrganizationUnitExists(mock.Anything, "ou-2").Return(false, nil).Once()
+
+	exists, err = suite.cachedStore.IsOrganizationUnitExists(context.Background(), "ou-2")
+	suite.NoError(err)
+	suite.False(exists)
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestUpdateOrganizationUnit_InvalidatesCache() {
+	ou := OrganizationUnit{ID: testCachedOUID, Handle: "engineering"}
+	suite.mockStore.EXPECT().UpdateOrganizationUnit(mock.Anything, ou).Return(nil).Once()
+	suite.ouByIDCache.EXPECT().Delete(mock.Anything, cache.CacheKey{Key: testCachedOUID}).Return(nil).Once()
+
+	suite.NoError(suite.cachedStore.UpdateOrganizationUnit(context.Background(), ou))
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestUpdateOrganizationUnit_StoreError() {
+	ou := OrganizationUnit{ID: testCachedOUID}
+	suite.mockStore.EXPECT().UpdateOrganizationUnit(mock.Anything, ou).Return(errors.New("db err")).Once()
+
+	suite.Error(suite.cachedStore.UpdateOrganizationUnit(context.Background(), ou))
+	suite.ouByIDCache.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestDeleteOrganizationUnit_InvalidatesCache() {
+	suite.mockStore.EXPECT().DeleteOrganizationUnit(mock.Anything, testCachedOUID).Return(nil).Once()
+	suite.ouByIDCache.EXPECT().Delete(mock.Anything, cache.CacheKey{Key: testCachedOUID}).Return(nil).Once()
+
+	suite.NoError(suite.cachedStore.DeleteOrganizationUnit(context.Background(), testCachedOUID))
+}
+
+func (suite *CacheBackedOUStoreTestSuite) TestDelegatedMethods() {
+	ctx := context.Background()
+	parent := "parent-ou"
+	basics := []OrganizationUnitBasic{{ID: testCachedOUID}}
+	ou := OrganizationUnit{ID: testCachedOUID}
+
+	suite.mockStore.EXPECT().GetOrganizationUnitListCount(ctx, mock.Anything).Return(1, nil).Once()
+	suite.mockStore.EXPECT().GetOrganizationUnitList(ctx, 10, 0, mock.Anything).Return(basics, nil).Once()
+	suite.mockStore.EXPECT().GetOrganizationUnitsByIDs(ctx, []string{testCachedOUID}).Return(basics, nil).Once()
+	suite.mockStore.EXPECT().CreateOrganizationUnit(ctx, ou).Return(nil).Once()
+	suite.mockStore.EXPECT().GetOrganizationUnitByHandle(ctx, "engineering", &parent).Return(ou, nil).Once()
+	suite.mockStore.EXPECT().GetOrganizationUnitByPath(ctx, []string{"engineering"}).Return(ou, nil).Once()
+	suite.mockStore.EXPECT().IsOrganizationUnitDeclarative(ctx, testCachedOUID).Return(true).Once()
+	suite.mockStore.EXPECT().CheckOrganizationUnitNameConflict(ctx, "Engineering", &parent).Return(true, nil).Once()
+	suite.mockStore.EXPECT().CheckOrganizationUnitHandleConflict(ctx, "engineering", &parent).
+		Return(false, nil).Once()
+	suite.mockStore.EXPECT().GetOrganizationUnitChildrenCount(ctx, testCachedOUID, mock.Anything).
+		Return(2, nil).Once()
+	suite.mockStore.EXPECT().GetOrganizationUnitChildrenList(ctx, testCachedOUID, 10, 0, mock.Anything).
+		Return(basics, nil).Once()
+
+	count, err := suite.cachedStore.GetOrganizationUnitListCount(ctx, nil)
+	suite.NoError(err)
+	suite.Equal(1, count)
+	list, err := suite.cachedStore.GetOrganizationUnitList(ctx, 10, 0, nil)
+	suite.NoError(err)
+	suite.Equal(basics, list)
+	list, err = suite.cachedStore.GetOrganizationUnitsByIDs(ctx, []string{testCachedOUID})
+	suite.NoError(err)
+	suite.Equal(basics, list)
+	suite.NoError(suite.cachedStore.CreateOrganizationUnit(ctx, ou))
+	got, err := suite.cachedStore.GetOrganizationUnitByHandle(ctx, "engineering", &parent)
+	suite.NoError(err)
+	suite.Equal(ou, got)
+	got, err = suite.cachedStore.GetOrganizationUnitByPath(ctx, []string{"engineering"})
+	suite.NoError(err)
+	suite.Equal(ou, got)
+	suite.True(suite.cachedStore.IsOrganizationUnitDeclarative(ctx, testCachedOUID))
+	conflict, err := suite.cachedStore.CheckOrganizationUnitNameConflict(ctx, "Engineering", &parent)
+	suite.NoError(err)
+	suite.True(conflict)
+	conflict, err = suite.cachedStore.CheckOrganizationUnitHandleConflict(ctx, "engineering", &parent)
+	suite.NoError(err)
+	suite.False(conflict)
+	count, err = suite.cachedStore.GetOrganizationUnitChildrenCount(ctx, testCachedOUID, nil)
+	suite.NoError(err)
+	suite.Equal(2, count)
+	list, err = suite.cachedStore.GetOrganizationUnitChildrenList(ctx, testCachedOUID, 10, 0, nil)
+	suite.NoError(err)
+	suite.Equal(basics, list)
+}
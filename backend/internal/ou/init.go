@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/thunder-id/thunderid/internal/system/cache"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -34,8 +35,9 @@ import (
 // avoid an import cycle), and the declarative resource exporter.
 func Initialize(
 	mux *http.ServeMux, authzService sysauthz.SystemAuthorizationServiceInterface,
+	cacheManager cache.CacheManagerInterface,
 ) (ConfigurableOUService, sysauthz.OUHierarchyResolver, declarativeresource.ResourceExporter, error) {
-	ouStore, transactioner, err := initializeStore()
+	ouStore, transactioner, err := initializeStore(cacheManager)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -81,8 +83,14 @@ func Initialize(
 // - If organization_unit.store is not specified, falls back to global immutable_resources.enabled:
 //   - If immutable_resources.enabled = true: behaves as IMMUTABLE mode
 //   - If immutable_resources.enabled = false: behaves as MUTABLE mode
-func initializeStore() (organizationUnitStoreInterface, transaction.Transactioner, error) {
+//
+// The database store is wrapped with a cache of organization units by ID. The file-based store keeps its
+// organization units in memory and is not cached.
+func initializeStore(
+	cacheManager cache.CacheManagerInterface,
+) (organizationUnitStoreInterface, transaction.Transactioner, error) {
 	storeMode := getOrganizationUnitStoreMode()
+	ouByIDCache := cache.GetCache[*OrganizationUnit](cacheManager, ouByIDCacheName)
 
 	switch storeMode {
 	case serverconst.StoreModeComposite:
@@ -91,7 +99,7 @@ func initializeStore() (organizationUnitStoreInterface, transaction.Transactione
 		if err != nil {
 			return nil, nil, err
 		}
-		ouStore := newCompositeOUStore(fileStore, dbStore)
+		ouStore := newCompositeOUStore(fileStore, newCacheBackedOUStore(dbStore, ouByIDCache))
 		if err := loadDeclarativeResources(fileStore, dbStore); err != nil {
 			return nil, nil, err
 		}
@@ -105,7 +113,11 @@ func initializeStore() (organizationUnitStoreInterface, transaction.Transactione
 		return fileStore, transactioner, nil
 
 	default:
-		return newOrganizationUnitStore()
+		dbStore, transactioner, err := newOrganizationUnitStore()
+		if err != nil {
+			return nil, nil, err
+		}
+		return newCacheBackedOUStore(dbStore, ouByIDCache), transactioner, nil
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 
@@ -69,7 +70,7 @@ func (suite *InitTestSuite) TestInitialize_WithDeclarativeResourcesDisabled() {
 	mux := http.NewServeMux()
 
 	// Execute
-	service, resolver, exporter, err := Initialize(mux, nil)
+	service, resolver, exporter, err := Initialize(mux, nil, cache.Initialize())
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -90,7 +91,7 @@ func (suite *InitTestSuite) TestInitialize_WithDeclarativeResourcesEnabled() {
 	mux := http.NewServeMux()
 
 	// Execute
-	service, resolver, exporter, err := Initialize(mux, nil)
+	service, resolver, exporter, err := Initialize(mux, nil, cache.Initialize())
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -111,7 +112,7 @@ func (suite *InitTestSuite) TestInitialize_FileBasedStoreCreation() {
 	mux := http.NewServeMux()
 
 	// Execute
-	service, resolver, exporter, err := Initialize(mux, nil)
+	service, resolver, exporter, err := Initialize(mux, nil, cache.Initialize())
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -132,7 +133,7 @@ func (suite *InitTestSuite) TestInitialize_DatabaseStoreCreation() {
 	mux := http.NewServeMux()
 
 	// Execute
-	service, resolver, exporter, err := Initialize(mux, nil)
+	service, resolver, exporter, err := Initialize(mux, nil, cache.Initialize())
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -149,7 +150,7 @@ func (suite *InitTestSuite) TestInitialize_RoutesRegistered() {
 	mux := http.NewServeMux()
 
 	// Execute
-	service, resolver, exporter, err := Initialize(mux, nil)
+	service, resolver, exporter, err := Initialize(mux, nil, cache.Initialize())
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -170,7 +171,7 @@ func (suite *InitTestSuite) TestInitialize_ExporterInterfaceCompliance() {
 	mux := http.NewServeMux()
 
 	// Execute
-	service, resolver, exporter, err := Initialize(mux, nil)
+	service, resolver, exporter, err := Initialize(mux, nil, cache.Initialize())
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -198,7 +199,7 @@ func (suite *InitTestSuite) TestInitialize_ServiceInterfaceCompliance() {
 	mux := http.NewServeMux()
 
 	// Execute
-	service, resolver, exporter, err := Initialize(mux, nil)
+	service, resolver, exporter, err := Initialize(mux, nil, cache.Initialize())
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -215,14 +216,14 @@ func (suite *InitTestSuite) TestInitialize_MultipleInitializations() {
 	runtime.Config.DeclarativeResources.Enabled = false
 
 	mux1 := http.NewServeMux()
-	service1, resolver1, exporter1, err1 := Initialize(mux1, nil)
+	service1, resolver1, exporter1, err1 := Initialize(mux1, nil, cache.Initialize())
 	assert.NoError(suite.T(), err1)
 	assert.NotNil(suite.T(), service1)
 	assert.NotNil(suite.T(), resolver1)
 	assert.NotNil(suite.T(), exporter1)
 
 	mux2 := http.NewServeMux()
-	service2, resolver2, exporter2, err2 := Initialize(mux2, nil)
+	service2, resolver2, exporter2, err2 := Initialize(mux2, nil, cache.Initialize())
 	assert.NoError(suite.T(), err2)
 	assert.NotNil(suite.T(), service2)
 	assert.NotNil(suite.T(), resolver2)
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// provisioningEntityService decorates the entity service to queue the lifecycle changes of the users to be
+// pushed to the provisioning targets. Decorating the entity service covers the users created and updated
+// through the user API as well as through the registration and self-service flows.
+type provisioningEntityService struct {
+	entity.EntityServiceInterface
+	provisioningService ProvisioningServiceInterface
+	logger              *log.Logger
+}
+
+// newProvisioningEntityService creates an entity service that queues the user lifecycle changes.
+func newProvisioningEntityService(
+	entityService entity.EntityServiceInterface,
+	provisioningService ProvisioningServiceInterface,
+) entity.EntityServiceInterface {
+	return &provisioningEntityService{
+		EntityServiceInterface: entityService,
+		provisioningService:    provisioningService,
+		logger: log.GetLogger().With(
+			log.String(log.LoggerKeyComponentName, "ProvisioningEntityService")),
+	}
+}
+
+// CreateEntity creates an entity and queues the creation of the user if the entity is a user.
+func (s *provisioningEntityService) CreateEntity(ctx context.Context, e *entity.Entity,
+	systemCredentials json.RawMessage) (*entity.Entity, error) {
+	created, err := s.EntityServiceInterface.CreateEntity(ctx, e, systemCredentials)
+	if err == nil {
+		s.queueUserChange(ctx, OperationCreate, created)
+	}
+	return created, err
+}
+
+// UpdateEntity updates an entity and queues the update of the user if the entity is a user.
+func (s *provisioningEntityService) UpdateEntity(ctx context.Context, entityID string,
+	e *entity.Entity) (*entity.Entity, error) {
+	updated, err := s.EntityServiceInterface.UpdateEntity(ctx, entityID, e)
+	if err == nil {
+		s.queueUserChange(ctx, OperationUpdate, updated)
+	}
+	return updated, err
+}
+
+// UpdateAttributes updates the attributes of an entity and queues the update of the user if the entity is a
+// user.
+func (s *provisioningEntityService) UpdateAttributes(ctx context.Context, entityID string,
+	attributes json.RawMessage) error {
+	if err := s.EntityServiceInterface.UpdateAttributes(ctx, entityID, attributes); err != nil {
+		return err
+	}
+
+	updated, err := s.EntityServiceInterface.GetEntity(ctx, entityID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the updated entity to provision", log.MaskedString("id", entityID),
+			log.Error(err))
+		return nil
+	}
+	s.queueUserChange(ctx, OperationUpdate, updated)
+
+	return nil
+}
+
+// DeleteEntity deletes an entity and queues the deactivation of the user if the entity is a user.
+func (s *provisioningEntityService) DeleteEntity(ctx context.Context, entityID string) error {
+	// The entity is retrieved before it is deleted to find whether it is a user.
+	existing, getErr := s.EntityServiceInterface.GetEntity(ctx, entityID)
+
+	if err := s.EntityServiceInterface.DeleteEntity(ctx, entityID); err != nil {
+		return err
+	}
+	if getErr == nil {
+		s.queueUserChange(ctx, OperationDeactivate, existing)
+	}
+
+	return nil
+}
+
+// queueUserChange queues a change of an entity to be provisioned if the entity is a user.
+func (s *provisioningEntityService) queueUserChange(ctx context.Context, operation Operation, e *entity.Entity) {
+	if e == nil || e.Category != entity.EntityCategoryUser {
+		return
+	}
+
+	change := ResourceChange{
+		ResourceType: ResourceTypeUser,
+		ResourceID:   e.ID,
+		Operation:    operation,
+	}
+	if operation != OperationDeactivate {
+		change.Attributes = e.Attributes
+	}
+	s.provisioningService.QueueChange(ctx, change)
+}
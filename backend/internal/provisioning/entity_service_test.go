@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+)
+
+type ProvisioningEntityServiceTestSuite struct {
+	suite.Suite
+	mockEntityService       *entitymock.EntityServiceInterfaceMock
+	mockProvisioningService *ProvisioningServiceInterfaceMock
+	service                 entity.EntityServiceInterface
+}
+
+func TestProvisioningEntityServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProvisioningEntityServiceTestSuite))
+}
+
+func (suite *ProvisioningEntityServiceTestSuite) SetupTest() {
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockProvisioningService = NewProvisioningServiceInterfaceMock(suite.T())
+	suite.service = newProvisioningEntityService(suite.mockEntityService, suite.mockProvisioningService)
+}
+
+func (suite *ProvisioningEntityServiceTestSuite) TestCreateEntity_User() {
+	user := &entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser,
+		Attributes: []byte(`{"username":"alice"}`)}
+	suite.mockEntityService.EXPECT().CreateEntity(mock.Anything, user, mock.Anything).Return(user, nil).Once()
+	suite.mockProvisioningService.EXPECT().QueueChange(mock.Anything, ResourceChange{
+		ResourceType: ResourceTypeUser,
+		ResourceID:   testUserID,
+		Operation:    OperationCreate,
+		Attributes:   user.Attributes,
+	}).Once()
+
+	created, err := suite.service.CreateEntity(context.Background(), user, nil)
+	suite.NoError(err)
+	suite.Equal(user, created)
+}
+
+func (suite *ProvisioningEntityServiceTestSuite) TestCreateEntity_NotUser() {
+	app := &entity.Entity{ID: "app-1", Category: entity.EntityCategoryApp}
+	suite.mockEntityService.EXPECT().CreateEntity(mock.Anything, app, mock.Anything).Return(app, nil).Once()
+
+	_, err := suite.service.CreateEntity(context.Background(), app, nil)
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningEntityServiceTestSuite) TestCreateEntity_Error() {
+	user := &entity.Entity{Category: entity.EntityCategoryUser}
+	suite.mockEntityService.EXPECT().CreateEntity(mock.Anything, user, mock.Anything).
+		Return(nil, errors.New("create failed")).Once()
+
+	_, err := suite.service.CreateEntity(context.Background(), user, nil)
+	suite.Error(err)
+}
+
+func (suite *ProvisioningEntityServiceTestSuite) TestUpdateEntity_User() {
+	user := &entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser}
+	suite.mockEntityService.EXPECT().UpdateEntity(mock.Anything, testUserID, user).Return(user, nil).Once()
+	suite.mockProvisioningService.EXPECT().QueueChange(mock.Anything, mock.MatchedBy(func(c ResourceChange) bool {
+		return c.Operation == OperationUpdate && c.ResourceID == testUserID
+	})).Once()
+
+	_, err := suite.service.UpdateEntity(context.Background(), testUserID, user)
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningEntityServiceTestSuite) TestUpdateAttributes_User() {
+	attributes := []byte(`{"username":"alice"}`)
+	suite.mockEntityService.EXPECT().UpdateAttributes(mock.Anything, testUserID, mock.Anything).Return(nil).Once()
+	suite.mockEntityService.EXPECT().GetEntity(mock.Anything, testUserID).Return(&entity.Entity{
+		ID: testUserID, Category: entity.EntityCategoryUser, Attributes: attributes}, nil).Once()
+	suite.mockProvisioningService.EXPECT().QueueChange(mock.Anything, mock.MatchedBy(func(c ResourceChange) bool {
+		return c.Operation == OperationUpdate && string(c.Attributes) == string(attributes)
+	})).Once()
+
+	suite.NoError(suite.service.UpdateAttributes(context.Background(), testUserID, attributes))
+}
+
+func (suite *ProvisioningEntityServiceTestSuite) TestUpdateAttributes_GetEntityError() {
+	suite.mockEntityService.EXPECT().UpdateAttributes(mock.Anything, testUserID, mock.Anything).Return(nil).Once()
+	suite.mockEntityService.EXPECT().GetEntity(mock.Anything, testUserID).Return(nil, errors.New("db err")).Once()
+
+	suite.NoError(suite.service.UpdateAttributes(context.Background(), testUserID, []byte(`{}`)))
+}
+
+func (suite *ProvisioningEntityServiceTestSuite) TestDeleteEntity_User() {
+	suite.mockEntityService.EXPECT().GetEntity(mock.Anything, testUserID).
+		Return(&entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser}, nil).Once()
+	suite.mockEntityService.EXPECT().DeleteEntity(mock.Anything, testUserID).Return(nil).Once()
+	suite.mockProvisioningService.EXPECT().QueueChange(mock.Anything, ResourceChange{
+		ResourceType: ResourceTypeUser,
+		ResourceID:   testUserID,
+		Operation:    OperationDeactivate,
+	}).Once()
+
+	suite.NoError(suite.service.DeleteEntity(context.Background(), testUserID))
+}
+
+func (suite *ProvisioningEntityServiceTestSuite) TestDeleteEntity_Error() {
+	suite.mockEntityService.EXPECT().GetEntity(mock.Anything, testUserID).
+		Return(&entity.Entity{ID: testUserID, Category: entity.EntityCategoryUser}, nil).Once()
+	suite.mockEntityService.EXPECT().DeleteEntity(mock.Anything, testUserID).
+		Return(errors.New("delete failed")).Once()
+
+	suite.Error(suite.service.DeleteEntity(context.Background(), testUserID))
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for provisioning operations.
+var (
+	// ErrorOperationNotFound is the error returned when a provisioning operation with the given ID does not
+	// exist.
+	ErrorOperationNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PRV-1001",
+		Error: core.I18nMessage{
+			Key:          "error.provisioningservice.operation_not_found",
+			DefaultValue: "Provisioning operation not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.provisioningservice.operation_not_found_description",
+			DefaultValue: "The provisioning operation with the given ID does not exist",
+		},
+	}
+
+	// ErrorInvalidLimit is the error returned when the limit query parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PRV-1002",
+		Error: core.I18nMessage{
+			Key:          "error.provisioningservice.invalid_limit",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.provisioningservice.invalid_limit_description",
+			DefaultValue: "The limit parameter must be a positive integer not greater than the maximum page size",
+		},
+	}
+
+	// ErrorInvalidOffset is the error returned when the offset query parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PRV-1003",
+		Error: core.I18nMessage{
+			Key:          "error.provisioningservice.invalid_offset",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.provisioningservice.invalid_offset_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+)
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// provisioningHandler defines the handler for the provisioning sync status API.
+type provisioningHandler struct {
+	service ProvisioningServiceInterface
+	logger  *log.Logger
+}
+
+func newProvisioningHandler(service ProvisioningServiceInterface) *provisioningHandler {
+	return &provisioningHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ProvisioningHandler")),
+	}
+}
+
+// HandleTargetListRequest handles the request to get the sync status of the provisioning targets.
+func (h *provisioningHandler) HandleTargetListRequest(w http.ResponseWriter, r *http.Request) {
+	result, svcErr := h.service.GetTargetSyncStatuses(r.Context())
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, result)
+}
+
+// HandleOperationListRequest handles the request to list the provisioning operations.
+func (h *provisioningHandler) HandleOperationListRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := constants.DefaultPageSize
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidOffset)
+			return
+		}
+		offset = parsed
+	}
+
+	result, svcErr := h.service.ListOperations(r.Context(), limit, offset)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, result)
+}
+
+// HandleOperationGetRequest handles the request to get a provisioning operation along with its sync status.
+func (h *provisioningHandler) HandleOperationGetRequest(w http.ResponseWriter, r *http.Request) {
+	operation, svcErr := h.service.GetOperation(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, operation)
+}
+
+// handleError handles service errors and sends appropriate HTTP responses.
+func (h *provisioningHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorOperationNotFound.Code:
+			statusCode = http.StatusNotFound
+		case serviceerror.ErrorUnauthorized.Code:
+			statusCode = http.StatusForbidden
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	if statusCode == http.StatusInternalServerError {
+		h.logger.Error("Provisioning request failed with server error",
+			log.String("code", svcErr.Code),
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
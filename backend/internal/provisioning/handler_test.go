@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	serviceMock *ProvisioningServiceInterfaceMock
+	handler     *provisioningHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.serviceMock = NewProvisioningServiceInterfaceMock(suite.T())
+	suite.handler = newProvisioningHandler(suite.serviceMock)
+}
+
+func (suite *HandlerTestSuite) TestListTargets() {
+	suite.serviceMock.EXPECT().GetTargetSyncStatuses(mock.Anything).Return(&TargetSyncStatusList{
+		TotalResults: 1,
+		Targets:      []TargetSyncStatus{{ID: testTargetID, PendingCount: 2}},
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/provisioning/targets", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleTargetListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var resp TargetSyncStatusList
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	suite.Equal(2, resp.Targets[0].PendingCount)
+}
+
+func (suite *HandlerTestSuite) TestListTargets_ServerError() {
+	suite.serviceMock.EXPECT().GetTargetSyncStatuses(mock.Anything).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/provisioning/targets", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleTargetListRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(serviceerror.InternalServerError.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestListOperations() {
+	suite.serviceMock.EXPECT().ListOperations(mock.Anything, 5, 10).
+		Return(&ProvisioningOperationList{TotalResults: 11, StartIndex: 11, Count: 1,
+			Operations: []ProvisioningOperation{{ID: testOperationID}}}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/provisioning/operations?limit=5&offset=10", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOperationListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *HandlerTestSuite) TestListOperations_InvalidPagination() {
+	req := httptest.NewRequest(http.MethodGet, "/provisioning/operations?limit=abc", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOperationListRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidLimit.Code, errResp.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/provisioning/operations?offset=abc", nil)
+	rr = httptest.NewRecorder()
+
+	suite.handler.HandleOperationListRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidOffset.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestGetOperation() {
+	suite.serviceMock.EXPECT().GetOperation(mock.Anything, testOperationID).
+		Return(&ProvisioningOperation{ID: testOperationID, Status: OperationStatusFailed}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/provisioning/operations/"+testOperationID, nil)
+	req.SetPathValue("id", testOperationID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOperationGetRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var resp ProvisioningOperation
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	suite.Equal(OperationStatusFailed, resp.Status)
+}
+
+func (suite *HandlerTestSuite) TestGetOperation_NotFound() {
+	suite.serviceMock.EXPECT().GetOperation(mock.Anything, testOperationID).
+		Return(nil, &ErrorOperationNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/provisioning/operations/"+testOperationID, nil)
+	req.SetPathValue("id", testOperationID)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleOperationGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorOperationNotFound.Code, errResp.Code)
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package provisioning pushes the user and group lifecycle changes to the downstream SCIM 2.0 endpoints
+// configured as provisioning targets, retrying failed pushes, and provides the API reporting their sync status.
+package provisioning
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+)
+
+const (
+	// provisioningTargetsPath is the path of the provisioning target sync status API.
+	provisioningTargetsPath = "/provisioning/targets"
+	// provisioningOperationsPath is the path of the provisioning operation API.
+	provisioningOperationsPath = "/provisioning/operations"
+	// defaultRequestTimeout is the timeout of the requests to the SCIM endpoints if none is configured.
+	defaultRequestTimeout = 10 * time.Second
+	// defaultMaxAttempts is the number of attempts made to push a change if none is configured.
+	defaultMaxAttempts = 5
+)
+
+// Initialize creates the provisioning service and registers the provisioning sync status API with the provided
+// mux. It returns the service, the entity service decorated to queue the user lifecycle changes when targets are
+// configured, and the job pushing the queued changes, which is to be run by the scheduler.
+func Initialize(mux *http.ServeMux, entityService entity.EntityServiceInterface) (
+	ProvisioningServiceInterface, entity.EntityServiceInterface, scheduler.Job) {
+	cfg := config.GetServerRuntime().Config.Provisioning
+
+	timeout := defaultRequestTimeout
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	maxAttempts := defaultMaxAttempts
+	if cfg.MaxAttempts > 0 {
+		maxAttempts = cfg.MaxAttempts
+	}
+
+	store := newProvisioningStore()
+	provisioningService := newProvisioningService(store, cfg.Targets)
+	client := newSCIMClient(syshttp.NewHTTPClientWithTimeout(timeout))
+
+	registerRoutes(mux, newProvisioningHandler(provisioningService))
+
+	job := newProvisioningJob(store, client, cfg.Targets, maxAttempts)
+
+	if len(cfg.Targets) > 0 {
+		entityService = newProvisioningEntityService(entityService, provisioningService)
+	}
+
+	return provisioningService, entityService, job
+}
+
+// registerRoutes registers the HTTP routes of the provisioning sync status API.
+func registerRoutes(mux *http.ServeMux, handler *provisioningHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+provisioningTargetsPath, handler.HandleTargetListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+provisioningTargetsPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+provisioningOperationsPath, handler.HandleOperationListRequest,
+		opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+provisioningOperationsPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+provisioningOperationsPath+"/{id}",
+		handler.HandleOperationGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+provisioningOperationsPath+"/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
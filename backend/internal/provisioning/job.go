@@ -0,0 +1,279 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// ProvisioningJobName is the name of the job pushing the queued changes to the provisioning targets.
+	ProvisioningJobName = "scim-provisioning"
+	// provisioningJobSchedule is the default schedule of the job pushing the queued changes.
+	provisioningJobSchedule = "@every 30s"
+	// provisioningBatchSize is the maximum number of queued operations considered in a run of the job.
+	provisioningBatchSize = 50
+	// staleOperationTimeout is the duration after which an operation left in progress, for example by a node
+	// that stopped while pushing it, is pushed again.
+	staleOperationTimeout = 10 * time.Minute
+	// initialRetryBackoff is the delay before the first retry of an operation. The delay doubles on each retry.
+	initialRetryBackoff = 30 * time.Second
+	// maxRetryBackoff is the maximum delay between the retries of an operation.
+	maxRetryBackoff = time.Hour
+)
+
+// provisioningJob pushes the queued provisioning operations to the targets. It implements scheduler.Job.
+type provisioningJob struct {
+	store       provisioningStoreInterface
+	client      scimClientInterface
+	targets     map[string]config.ProvisioningTargetConfig
+	maxAttempts int
+	logger      *log.Logger
+}
+
+// newProvisioningJob returns a new provisioning job.
+func newProvisioningJob(store provisioningStoreInterface, client scimClientInterface,
+	targets []config.ProvisioningTargetConfig, maxAttempts int) *provisioningJob {
+	targetsByID := make(map[string]config.ProvisioningTargetConfig, len(targets))
+	for _, target := range targets {
+		targetsByID[target.ID] = target
+	}
+
+	return &provisioningJob{
+		store:       store,
+		client:      client,
+		targets:     targetsByID,
+		maxAttempts: maxAttempts,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ProvisioningJob")),
+	}
+}
+
+func (j *provisioningJob) Name() string {
+	return ProvisioningJobName
+}
+
+func (j *provisioningJob) Description() string {
+	return "Pushes the queued user and group changes to the downstream SCIM endpoints and retries failed pushes"
+}
+
+func (j *provisioningJob) DefaultSchedule() string {
+	return provisioningJobSchedule
+}
+
+// Run pushes a batch of the queued provisioning operations. The operations of a resource are pushed to a
+// target in the order they were queued, so an operation waiting to be retried holds back the later operations
+// of the same resource.
+func (j *provisioningJob) Run(ctx context.Context) (string, error) {
+	if len(j.targets) == 0 {
+		return "No provisioning targets are configured", nil
+	}
+
+	now := time.Now().UTC()
+	staleBefore := now.Add(-staleOperationTimeout)
+	operations, err := j.store.listQueuedOperations(ctx, staleBefore, provisioningBatchSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to list the queued provisioning operations: %w", err)
+	}
+
+	var succeeded, retried, failed int
+	heldBack := make(map[string]struct{})
+	for _, operation := range operations {
+		key := operation.TargetID + "/" + string(operation.ResourceType) + "/" + operation.ResourceID
+		if _, ok := heldBack[key]; ok {
+			continue
+		}
+		if operation.NextAttemptAt != nil && operation.NextAttemptAt.After(now) {
+			heldBack[key] = struct{}{}
+			continue
+		}
+
+		claimed, err := j.store.claimOperation(ctx, operation.ID, time.Now().UTC(), staleBefore)
+		if err != nil {
+			return summarize(succeeded, retried, failed),
+				fmt.Errorf("failed to claim provisioning operation %s: %w", operation.ID, err)
+		}
+		if !claimed {
+			heldBack[key] = struct{}{}
+			continue
+		}
+
+		status, err := j.process(ctx, operation)
+		if err != nil {
+			return summarize(succeeded, retried, failed),
+				fmt.Errorf("failed to record the outcome of provisioning operation %s: %w", operation.ID, err)
+		}
+		switch status {
+		case OperationStatusSucceeded:
+			succeeded++
+		case OperationStatusPending:
+			retried++
+			heldBack[key] = struct{}{}
+		default:
+			failed++
+		}
+	}
+
+	return summarize(succeeded, retried, failed), nil
+}
+
+// process pushes a provisioning operation to its target and records the outcome. It returns the status of the
+// operation after the attempt.
+func (j *provisioningJob) process(ctx context.Context, operation ProvisioningOperation) (
+	OperationStatus, error) {
+	attempts := operation.Attempts + 1
+	now := time.Now().UTC()
+
+	target, ok := j.targets[operation.TargetID]
+	if !ok {
+		return OperationStatusFailed, j.store.completeOperation(ctx, operation.ID, OperationStatusFailed,
+			attempts, "provisioning target is no longer configured", now)
+	}
+
+	retryable, pushErr := j.push(ctx, target, operation)
+	if pushErr == nil {
+		j.logger.Debug("Provisioning operation pushed", log.String("id", operation.ID),
+			log.String("targetId", operation.TargetID))
+		return OperationStatusSucceeded, j.store.completeOperation(ctx, operation.ID, OperationStatusSucceeded,
+			attempts, "", now)
+	}
+
+	lastError := pushErr.Error()
+	if len(lastError) > maxErrorDetailLength {
+		lastError = lastError[:maxErrorDetailLength]
+	}
+	if !retryable || attempts >= j.maxAttempts {
+		j.logger.Error("Provisioning operation failed", log.String("id", operation.ID),
+			log.String("targetId", operation.TargetID), log.Int("attempts", attempts),
+			log.String("error", lastError))
+		return OperationStatusFailed, j.store.completeOperation(ctx, operation.ID, OperationStatusFailed,
+			attempts, lastError, now)
+	}
+
+	nextAttemptAt := now.Add(getRetryBackoff(attempts))
+	j.logger.Debug("Scheduling provisioning operation retry", log.String("id", operation.ID),
+		log.Int("attempt", attempts), log.String("error", lastError))
+	return OperationStatusPending, j.store.rescheduleOperation(ctx, operation.ID, attempts, lastError, now,
+		nextAttemptAt)
+}
+
+// push pushes a provisioning operation to its target. It returns whether the push may succeed when retried
+// along with the error if it failed.
+func (j *provisioningJob) push(ctx context.Context, target config.ProvisioningTargetConfig,
+	operation ProvisioningOperation) (bool, error) {
+	externalID, err := j.store.getExternalID(ctx, target.ID, operation.ResourceType, operation.ResourceID)
+	if err != nil {
+		return true, fmt.Errorf("failed to get the provisioned resource: %w", err)
+	}
+
+	switch operation.Operation {
+	case OperationCreate, OperationUpdate:
+		resource, err := j.buildResource(ctx, target, operation)
+		if err != nil {
+			return false, err
+		}
+		if externalID != "" {
+			scimErr := j.client.replaceResource(ctx, target, operation.ResourceType, externalID, resource)
+			if scimErr == nil {
+				return false, nil
+			}
+			if !scimErr.isNotFound() {
+				return scimErr.isRetryable(), scimErr
+			}
+		}
+
+		createdID, scimErr := j.client.createResource(ctx, target, operation.ResourceType, resource)
+		if scimErr != nil {
+			return scimErr.isRetryable(), scimErr
+		}
+		if err := j.store.saveExternalID(ctx, target.ID, operation.ResourceType, operation.ResourceID,
+			createdID); err != nil {
+			return true, fmt.Errorf("failed to record the provisioned resource: %w", err)
+		}
+		return false, nil
+	case OperationDeactivate, OperationDelete:
+		if externalID == "" {
+			// The resource was never provisioned to the target.
+			return false, nil
+		}
+
+		var scimErr *scimError
+		if operation.Operation == OperationDeactivate {
+			scimErr = j.client.deactivateUser(ctx, target, externalID)
+		} else {
+			scimErr = j.client.deleteResource(ctx, target, operation.ResourceType, externalID)
+		}
+		if scimErr != nil && !scimErr.isNotFound() {
+			return scimErr.isRetryable(), scimErr
+		}
+		if err := j.store.deleteExternalID(ctx, target.ID, operation.ResourceType,
+			operation.ResourceID); err != nil {
+			return true, fmt.Errorf("failed to remove the provisioned resource: %w", err)
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("unsupported provisioning operation %q", operation.Operation)
+	}
+}
+
+// buildResource builds the SCIM resource pushed for a created or updated user or group. The members of a
+// group are pushed with the IDs assigned to them by the target, and members not provisioned to the target are
+// left out.
+func (j *provisioningJob) buildResource(ctx context.Context, target config.ProvisioningTargetConfig,
+	operation ProvisioningOperation) (map[string]interface{}, error) {
+	if operation.ResourceType == ResourceTypeUser {
+		return buildSCIMUser(operation.ResourceID, operation.payload.Attributes, target.AttributeMapping)
+	}
+
+	memberExternalIDs := make([]string, 0, len(operation.payload.Members))
+	for _, memberID := range operation.payload.Members {
+		externalID, err := j.store.getExternalID(ctx, target.ID, ResourceTypeUser, memberID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the provisioned member: %w", err)
+		}
+		if externalID != "" {
+			memberExternalIDs = append(memberExternalIDs, externalID)
+		}
+	}
+
+	return buildSCIMGroup(operation.ResourceID, operation.payload.DisplayName, memberExternalIDs), nil
+}
+
+// getRetryBackoff returns the delay before the retry following the given attempt.
+func getRetryBackoff(attempt int) time.Duration {
+	delay := initialRetryBackoff
+	for i := 1; i < attempt && delay < maxRetryBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryBackoff {
+		return maxRetryBackoff
+	}
+
+	return delay
+}
+
+// summarize returns the summary of a run of the provisioning job.
+func summarize(succeeded, retried, failed int) string {
+	return fmt.Sprintf("Processed %d provisioning operations: %d succeeded, %d scheduled for retry, %d failed",
+		succeeded+retried+failed, succeeded, retried, failed)
+}
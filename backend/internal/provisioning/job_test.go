@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type ProvisioningJobTestSuite struct {
+	suite.Suite
+	mockStore  *provisioningStoreInterfaceMock
+	mockClient *scimClientInterfaceMock
+	target     config.ProvisioningTargetConfig
+	job        *provisioningJob
+}
+
+func TestProvisioningJobTestSuite(t *testing.T) {
+	suite.Run(t, new(ProvisioningJobTestSuite))
+}
+
+func (suite *ProvisioningJobTestSuite) SetupTest() {
+	suite.mockStore = newProvisioningStoreInterfaceMock(suite.T())
+	suite.mockClient = newScimClientInterfaceMock(suite.T())
+	suite.target = config.ProvisioningTargetConfig{ID: testTargetID, URL: "https://crm.example.com/scim/v2"}
+	suite.job = newProvisioningJob(suite.mockStore, suite.mockClient,
+		[]config.ProvisioningTargetConfig{suite.target}, 3)
+}
+
+func (suite *ProvisioningJobTestSuite) queue(operations ...ProvisioningOperation) {
+	suite.mockStore.EXPECT().listQueuedOperations(mock.Anything, mock.Anything, provisioningBatchSize).
+		Return(operations, nil).Once()
+}
+
+func (suite *ProvisioningJobTestSuite) expectClaim(id string) {
+	suite.mockStore.EXPECT().claimOperation(mock.Anything, id, mock.Anything, mock.Anything).
+		Return(true, nil).Once()
+}
+
+func (suite *ProvisioningJobTestSuite) newUserOperation(id string, operation Operation) ProvisioningOperation {
+	return ProvisioningOperation{
+		ID:           id,
+		TargetID:     testTargetID,
+		ResourceType: ResourceTypeUser,
+		ResourceID:   testUserID,
+		Operation:    operation,
+		Status:       OperationStatusPending,
+		payload:      resourcePayload{Attributes: []byte(`{"username":"alice","email":"alice@example.com"}`)},
+	}
+}
+
+func (suite *ProvisioningJobTestSuite) TestJobDetails() {
+	suite.Equal(ProvisioningJobName, suite.job.Name())
+	suite.NotEmpty(suite.job.Description())
+	suite.Equal(provisioningJobSchedule, suite.job.DefaultSchedule())
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_NoTargets() {
+	job := newProvisioningJob(suite.mockStore, suite.mockClient, nil, 3)
+
+	summary, err := job.Run(context.Background())
+	suite.NoError(err)
+	suite.Contains(summary, "No provisioning targets")
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_CreateUser() {
+	suite.queue(suite.newUserOperation(testOperationID, OperationCreate))
+	suite.expectClaim(testOperationID)
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID).
+		Return("", nil).Once()
+	suite.mockClient.EXPECT().createResource(mock.Anything, suite.target, ResourceTypeUser,
+		mock.MatchedBy(func(resource map[string]interface{}) bool {
+			return resource["userName"] == "alice" && resource["externalId"] == testUserID
+		})).Return(testExternalID, nil).Once()
+	suite.mockStore.EXPECT().saveExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID,
+		testExternalID).Return(nil).Once()
+	suite.mockStore.EXPECT().completeOperation(mock.Anything, testOperationID, OperationStatusSucceeded, 1, "",
+		mock.Anything).Return(nil).Once()
+
+	summary, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+	suite.Contains(summary, "1 succeeded")
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_UpdateUserRecreatesMissingResource() {
+	suite.queue(suite.newUserOperation(testOperationID, OperationUpdate))
+	suite.expectClaim(testOperationID)
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID).
+		Return(testExternalID, nil).Once()
+	suite.mockClient.EXPECT().replaceResource(mock.Anything, suite.target, ResourceTypeUser, testExternalID,
+		mock.Anything).Return(&scimError{statusCode: http.StatusNotFound}).Once()
+	suite.mockClient.EXPECT().createResource(mock.Anything, suite.target, ResourceTypeUser, mock.Anything).
+		Return("ext-2", nil).Once()
+	suite.mockStore.EXPECT().saveExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID, "ext-2").
+		Return(nil).Once()
+	suite.mockStore.EXPECT().completeOperation(mock.Anything, testOperationID, OperationStatusSucceeded, 1, "",
+		mock.Anything).Return(nil).Once()
+
+	_, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_RetryableFailureHoldsBackLaterOperations() {
+	suite.queue(suite.newUserOperation(testOperationID, OperationUpdate),
+		suite.newUserOperation("operation-2", OperationDeactivate))
+	suite.expectClaim(testOperationID)
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID).
+		Return(testExternalID, nil).Once()
+	suite.mockClient.EXPECT().replaceResource(mock.Anything, suite.target, ResourceTypeUser, testExternalID,
+		mock.Anything).Return(&scimError{statusCode: http.StatusServiceUnavailable, detail: "unavailable"}).Once()
+	suite.mockStore.EXPECT().rescheduleOperation(mock.Anything, testOperationID, 1,
+		"SCIM endpoint responded with status 503: unavailable", mock.Anything,
+		mock.MatchedBy(func(next time.Time) bool { return next.After(time.Now().UTC()) })).Return(nil).Once()
+
+	summary, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+	suite.Contains(summary, "1 scheduled for retry")
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_NonRetryableFailure() {
+	suite.queue(suite.newUserOperation(testOperationID, OperationCreate))
+	suite.expectClaim(testOperationID)
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID).
+		Return("", nil).Once()
+	suite.mockClient.EXPECT().createResource(mock.Anything, suite.target, ResourceTypeUser, mock.Anything).
+		Return("", &scimError{statusCode: http.StatusBadRequest, detail: "invalid userName"}).Once()
+	suite.mockStore.EXPECT().completeOperation(mock.Anything, testOperationID, OperationStatusFailed, 1,
+		"SCIM endpoint responded with status 400: invalid userName", mock.Anything).Return(nil).Once()
+
+	summary, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+	suite.Contains(summary, "1 failed")
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_MaxAttemptsReached() {
+	operation := suite.newUserOperation(testOperationID, OperationCreate)
+	operation.Attempts = 2
+	suite.queue(operation)
+	suite.expectClaim(testOperationID)
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID).
+		Return("", nil).Once()
+	suite.mockClient.EXPECT().createResource(mock.Anything, suite.target, ResourceTypeUser, mock.Anything).
+		Return("", &scimError{detail: "request failed: timeout"}).Once()
+	suite.mockStore.EXPECT().completeOperation(mock.Anything, testOperationID, OperationStatusFailed, 3,
+		"request failed: timeout", mock.Anything).Return(nil).Once()
+
+	_, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_DeactivateUser() {
+	suite.queue(suite.newUserOperation(testOperationID, OperationDeactivate))
+	suite.expectClaim(testOperationID)
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID).
+		Return(testExternalID, nil).Once()
+	suite.mockClient.EXPECT().deactivateUser(mock.Anything, suite.target, testExternalID).Return(nil).Once()
+	suite.mockStore.EXPECT().deleteExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID).
+		Return(nil).Once()
+	suite.mockStore.EXPECT().completeOperation(mock.Anything, testOperationID, OperationStatusSucceeded, 1, "",
+		mock.Anything).Return(nil).Once()
+
+	_, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_DeactivateUserNotProvisioned() {
+	suite.queue(suite.newUserOperation(testOperationID, OperationDeactivate))
+	suite.expectClaim(testOperationID)
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID).
+		Return("", nil).Once()
+	suite.mockStore.EXPECT().completeOperation(mock.Anything, testOperationID, OperationStatusSucceeded, 1, "",
+		mock.Anything).Return(nil).Once()
+
+	_, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_UpdateGroupWithProvisionedMembers() {
+	suite.queue(ProvisioningOperation{
+		ID:           testOperationID,
+		TargetID:     testTargetID,
+		ResourceType: ResourceTypeGroup,
+		ResourceID:   "group-1",
+		Operation:    OperationUpdate,
+		payload:      resourcePayload{DisplayName: "Admins", Members: []string{testUserID, "user-2"}},
+	})
+	suite.expectClaim(testOperationID)
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeGroup, "group-1").
+		Return("ext-group", nil).Once()
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeUser, testUserID).
+		Return(testExternalID, nil).Once()
+	suite.mockStore.EXPECT().getExternalID(mock.Anything, testTargetID, ResourceTypeUser, "user-2").
+		Return("", nil).Once()
+	suite.mockClient.EXPECT().replaceResource(mock.Anything, suite.target, ResourceTypeGroup, "ext-group",
+		mock.MatchedBy(func(resource map[string]interface{}) bool {
+			members, ok := resource["members"].([]map[string]interface{})
+			return ok && len(members) == 1 && members[0]["value"] == testExternalID &&
+				resource["displayName"] == "Admins"
+		})).Return(nil).Once()
+	suite.mockStore.EXPECT().completeOperation(mock.Anything, testOperationID, OperationStatusSucceeded, 1, "",
+		mock.Anything).Return(nil).Once()
+
+	_, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_TargetNoLongerConfigured() {
+	operation := suite.newUserOperation(testOperationID, OperationCreate)
+	operation.TargetID = "removed"
+	suite.queue(operation)
+	suite.expectClaim(testOperationID)
+	suite.mockStore.EXPECT().completeOperation(mock.Anything, testOperationID, OperationStatusFailed, 1,
+		"provisioning target is no longer configured", mock.Anything).Return(nil).Once()
+
+	_, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_RetryNotDue() {
+	operation := suite.newUserOperation(testOperationID, OperationUpdate)
+	nextAttemptAt := time.Now().UTC().Add(time.Hour)
+	operation.NextAttemptAt = &nextAttemptAt
+	suite.queue(operation, suite.newUserOperation("operation-2", OperationDeactivate))
+
+	summary, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+	suite.Contains(summary, "Processed 0 provisioning operations")
+}
+
+func (suite *ProvisioningJobTestSuite) TestRun_ListError() {
+	suite.mockStore.EXPECT().listQueuedOperations(mock.Anything, mock.Anything, provisioningBatchSize).
+		Return(nil, errors.New("db err")).Once()
+
+	_, err := suite.job.Run(context.Background())
+	suite.Error(err)
+}
+
+func (suite *ProvisioningJobTestSuite) TestGetRetryBackoff() {
+	suite.Equal(initialRetryBackoff, getRetryBackoff(1))
+	suite.Equal(2*initialRetryBackoff, getRetryBackoff(2))
+	suite.Equal(maxRetryBackoff, getRetryBackoff(20))
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// scimUserSchema is the schema of the SCIM user resource.
+	scimUserSchema = "urn:ietf:params:scim:schemas:core:2.0:User"
+	// scimGroupSchema is the schema of the SCIM group resource.
+	scimGroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+)
+
+// defaultUserAttributeMapping is the attribute mapping used for the targets that do not define one.
+var defaultUserAttributeMapping = map[string]string{
+	"userName": "username",
+	"emails":   "email",
+}
+
+// multiValuedAttributes lists the SCIM user attributes holding a list of values. A mapped user attribute
+// holding a single value is pushed as the primary value of the list.
+var multiValuedAttributes = map[string]struct{}{
+	"emails":       {},
+	"phoneNumbers": {},
+}
+
+// buildSCIMUser builds the SCIM user resource of a user from its attributes. The user ID is pushed as the
+// externalId, and the user ID is used as the userName if no attribute is mapped to it.
+func buildSCIMUser(userID string, attributes json.RawMessage, mapping map[string]string) (
+	map[string]interface{}, error) {
+	userAttributes := map[string]interface{}{}
+	if len(attributes) > 0 {
+		if err := json.Unmarshal(attributes, &userAttributes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the user attributes: %w", err)
+		}
+	}
+	if len(mapping) == 0 {
+		mapping = defaultUserAttributeMapping
+	}
+
+	resource := map[string]interface{}{
+		"schemas":    []string{scimUserSchema},
+		"externalId": userID,
+		"active":     true,
+	}
+	for scimAttr, userAttr := range mapping {
+		value, ok := userAttributes[userAttr]
+		if !ok || value == nil {
+			continue
+		}
+		if _, multiValued := multiValuedAttributes[scimAttr]; multiValued {
+			if _, isList := value.([]interface{}); !isList {
+				value = []map[string]interface{}{{"value": value, "primary": true}}
+			}
+		}
+		setAttribute(resource, scimAttr, value)
+	}
+	if _, ok := resource["userName"]; !ok {
+		resource["userName"] = userID
+	}
+
+	return resource, nil
+}
+
+// buildSCIMGroup builds the SCIM group resource of a group with the given members, identified by the IDs
+// assigned to them by the target.
+func buildSCIMGroup(groupID, displayName string, memberExternalIDs []string) map[string]interface{} {
+	members := make([]map[string]interface{}, 0, len(memberExternalIDs))
+	for _, externalID := range memberExternalIDs {
+		members = append(members, map[string]interface{}{"value": externalID})
+	}
+
+	return map[string]interface{}{
+		"schemas":     []string{scimGroupSchema},
+		"externalId":  groupID,
+		"displayName": displayName,
+		"members":     members,
+	}
+}
+
+// setAttribute sets an attribute of a SCIM resource. Sub-attributes of complex attributes are addressed with a
+// dot, such as name.givenName.
+func setAttribute(resource map[string]interface{}, path string, value interface{}) {
+	segments := strings.Split(path, ".")
+	current := resource
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[segment] = next
+		}
+		current = next
+	}
+	current[segments[len(segments)-1]] = value
+}
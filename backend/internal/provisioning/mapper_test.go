@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSCIMUser_DefaultMapping(t *testing.T) {
+	resource, err := buildSCIMUser(testUserID, []byte(`{"username":"alice","email":"alice@example.com"}`), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{scimUserSchema}, resource["schemas"])
+	assert.Equal(t, testUserID, resource["externalId"])
+	assert.Equal(t, true, resource["active"])
+	assert.Equal(t, "alice", resource["userName"])
+	assert.Equal(t, []map[string]interface{}{{"value": "alice@example.com", "primary": true}}, resource["emails"])
+}
+
+func TestBuildSCIMUser_CustomMapping(t *testing.T) {
+	mapping := map[string]string{
+		"userName":        "email",
+		"name.givenName":  "given_name",
+		"name.familyName": "family_name",
+		"title":           "missing",
+	}
+	resource, err := buildSCIMUser(testUserID,
+		[]byte(`{"email":"alice@example.com","given_name":"Alice","family_name":"Smith"}`), mapping)
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice@example.com", resource["userName"])
+	assert.Equal(t, map[string]interface{}{"givenName": "Alice", "familyName": "Smith"}, resource["name"])
+	assert.NotContains(t, resource, "title")
+}
+
+func TestBuildSCIMUser_UserNameDefaultsToUserID(t *testing.T) {
+	resource, err := buildSCIMUser(testUserID, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, testUserID, resource["userName"])
+}
+
+func TestBuildSCIMUser_InvalidAttributes(t *testing.T) {
+	_, err := buildSCIMUser(testUserID, []byte(`[]`), nil)
+	assert.Error(t, err)
+}
+
+func TestBuildSCIMGroup(t *testing.T) {
+	resource := buildSCIMGroup("group-1", "Admins", []string{"ext-1"})
+
+	assert.Equal(t, []string{scimGroupSchema}, resource["schemas"])
+	assert.Equal(t, "group-1", resource["externalId"])
+	assert.Equal(t, "Admins", resource["displayName"])
+	assert.Equal(t, []map[string]interface{}{{"value": "ext-1"}}, resource["members"])
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ResourceType represents the type of a provisioned resource.
+type ResourceType string
+
+const (
+	// ResourceTypeUser indicates that the provisioned resource is a user.
+	ResourceTypeUser ResourceType = "USER"
+	// ResourceTypeGroup indicates that the provisioned resource is a group.
+	ResourceTypeGroup ResourceType = "GROUP"
+)
+
+// Operation represents the change pushed to a downstream SCIM endpoint.
+type Operation string
+
+const (
+	// OperationCreate creates the resource in the downstream SCIM endpoint.
+	OperationCreate Operation = "CREATE"
+	// OperationUpdate replaces the resource in the downstream SCIM endpoint. The resource is created if it was
+	// not provisioned before.
+	OperationUpdate Operation = "UPDATE"
+	// OperationDeactivate marks a provisioned user as inactive in the downstream SCIM endpoint.
+	OperationDeactivate Operation = "DEACTIVATE"
+	// OperationDelete deletes a provisioned group from the downstream SCIM endpoint.
+	OperationDelete Operation = "DELETE"
+)
+
+// OperationStatus represents the sync status of a provisioning operation.
+type OperationStatus string
+
+const (
+	// OperationStatusPending indicates that the operation is waiting to be pushed or retried.
+	OperationStatusPending OperationStatus = "PENDING"
+	// OperationStatusInProgress indicates that the operation is being pushed.
+	OperationStatusInProgress OperationStatus = "IN_PROGRESS"
+	// OperationStatusSucceeded indicates that the change was pushed to the downstream SCIM endpoint.
+	OperationStatusSucceeded OperationStatus = "SUCCEEDED"
+	// OperationStatusFailed indicates that the change was rejected or could not be pushed within the maximum
+	// number of attempts.
+	OperationStatusFailed OperationStatus = "FAILED"
+)
+
+// ResourceChange represents a lifecycle change of a user or a group to be provisioned.
+type ResourceChange struct {
+	ResourceType ResourceType
+	ResourceID   string
+	Operation    Operation
+	// Attributes are the attributes of a created or updated user.
+	Attributes json.RawMessage
+	// DisplayName is the name of a created or updated group.
+	DisplayName string
+	// Members are the IDs of the users belonging to a created or updated group.
+	Members []string
+}
+
+// resourcePayload is the snapshot of a resource stored with a provisioning operation.
+type resourcePayload struct {
+	Attributes  json.RawMessage `json:"attributes,omitempty"`
+	DisplayName string          `json:"displayName,omitempty"`
+	Members     []string        `json:"members,omitempty"`
+}
+
+// ProvisioningOperation represents a change of a resource to be pushed to a downstream SCIM endpoint, along
+// with its sync status.
+type ProvisioningOperation struct {
+	ID            string          `json:"id"`
+	TargetID      string          `json:"targetId"`
+	ResourceType  ResourceType    `json:"resourceType"`
+	ResourceID    string          `json:"resourceId"`
+	Operation     Operation       `json:"operation"`
+	Status        OperationStatus `json:"status"`
+	Attempts      int             `json:"attempts"`
+	LastError     string          `json:"lastError,omitempty"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+	NextAttemptAt *time.Time      `json:"nextAttemptAt,omitempty"`
+	CompletedAt   *time.Time      `json:"completedAt,omitempty"`
+	payload       resourcePayload
+}
+
+// ProvisioningOperationList represents a page of provisioning operations.
+type ProvisioningOperationList struct {
+	TotalResults int                     `json:"totalResults"`
+	StartIndex   int                     `json:"startIndex"`
+	Count        int                     `json:"count"`
+	Operations   []ProvisioningOperation `json:"operations"`
+	Links        []utils.Link            `json:"links"`
+}
+
+// TargetSyncStatus represents the sync status of a downstream SCIM endpoint.
+type TargetSyncStatus struct {
+	ID               string     `json:"id"`
+	Name             string     `json:"name,omitempty"`
+	URL              string     `json:"url"`
+	PendingCount     int        `json:"pendingCount"`
+	SucceededCount   int        `json:"succeededCount"`
+	FailedCount      int        `json:"failedCount"`
+	LastSucceededAt  *time.Time `json:"lastSucceededAt,omitempty"`
+	ProvisionsGroups bool       `json:"provisionsGroups"`
+}
+
+// TargetSyncStatusList represents the sync status of the downstream SCIM endpoints.
+type TargetSyncStatusList struct {
+	TotalResults int                `json:"totalResults"`
+	Targets      []TargetSyncStatus `json:"targets"`
+}
+
+// targetStatusCount is the number of operations of a target in a status.
+type targetStatusCount struct {
+	targetID        string
+	status          OperationStatus
+	count           int
+	lastCompletedAt *time.Time
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+const (
+	// scimContentType is the media type of the SCIM requests and responses.
+	scimContentType = "application/scim+json"
+	// scimPatchOpSchema is the schema of the SCIM PATCH requests.
+	scimPatchOpSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	// maxErrorDetailLength is the maximum length of the error detail recorded from a SCIM error response.
+	maxErrorDetailLength = 512
+)
+
+// scimError is the error returned when a request to a SCIM endpoint fails.
+type scimError struct {
+	// statusCode is the HTTP status code of the response. It is zero if no response was received.
+	statusCode int
+	detail     string
+}
+
+func (e *scimError) Error() string {
+	if e.statusCode == 0 {
+		return e.detail
+	}
+	return fmt.Sprintf("SCIM endpoint responded with status %d: %s", e.statusCode, e.detail)
+}
+
+// isRetryable returns true if the request may succeed when retried.
+func (e *scimError) isRetryable() bool {
+	return e.statusCode == 0 || e.statusCode == http.StatusTooManyRequests ||
+		e.statusCode >= http.StatusInternalServerError
+}
+
+// isNotFound returns true if the resource does not exist in the SCIM endpoint.
+func (e *scimError) isNotFound() bool {
+	return e.statusCode == http.StatusNotFound
+}
+
+// scimClientInterface defines the interface for pushing resources to a SCIM 2.0 endpoint.
+type scimClientInterface interface {
+	createResource(ctx context.Context, target config.ProvisioningTargetConfig, resourceType ResourceType,
+		resource map[string]interface{}) (string, *scimError)
+	replaceResource(ctx context.Context, target config.ProvisioningTargetConfig, resourceType ResourceType,
+		externalID string, resource map[string]interface{}) *scimError
+	deactivateUser(ctx context.Context, target config.ProvisioningTargetConfig, externalID string) *scimError
+	deleteResource(ctx context.Context, target config.ProvisioningTargetConfig, resourceType ResourceType,
+		externalID string) *scimError
+}
+
+// scimClient is the HTTP implementation of scimClientInterface.
+type scimClient struct {
+	httpClient syshttp.HTTPClientInterface
+}
+
+// newSCIMClient returns a new instance of scimClientInterface.
+func newSCIMClient(httpClient syshttp.HTTPClientInterface) scimClientInterface {
+	return &scimClient{httpClient: httpClient}
+}
+
+// createResource creates a resource in the SCIM endpoint and returns the ID assigned to it.
+func (c *scimClient) createResource(ctx context.Context, target config.ProvisioningTargetConfig,
+	resourceType ResourceType, resource map[string]interface{}) (string, *scimError) {
+	body, scimErr := c.send(ctx, target, http.MethodPost, getResourceEndpoint(target, resourceType, ""), resource)
+	if scimErr != nil {
+		return "", scimErr
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		return "", &scimError{statusCode: http.StatusCreated, detail: "response does not contain the resource id"}
+	}
+
+	return created.ID, nil
+}
+
+// replaceResource replaces a resource in the SCIM endpoint.
+func (c *scimClient) replaceResource(ctx context.Context, target config.ProvisioningTargetConfig,
+	resourceType ResourceType, externalID string, resource map[string]interface{}) *scimError {
+	_, scimErr := c.send(ctx, target, http.MethodPut, getResourceEndpoint(target, resourceType, externalID),
+		resource)
+	return scimErr
+}
+
+// deactivateUser marks a user as inactive in the SCIM endpoint.
+func (c *scimClient) deactivateUser(ctx context.Context, target config.ProvisioningTargetConfig,
+	externalID string) *scimError {
+	patch := map[string]interface{}{
+		"schemas": []string{scimPatchOpSchema},
+		"Operations": []map[string]interface{}{
+			{"op": "replace", "value": map[string]interface{}{"active": false}},
+		},
+	}
+	_, scimErr := c.send(ctx, target, http.MethodPatch, getResourceEndpoint(target, ResourceTypeUser, externalID),
+		patch)
+	return scimErr
+}
+
+// deleteResource deletes a resource from the SCIM endpoint.
+func (c *scimClient) deleteResource(ctx context.Context, target config.ProvisioningTargetConfig,
+	resourceType ResourceType, externalID string) *scimError {
+	_, scimErr := c.send(ctx, target, http.MethodDelete, getResourceEndpoint(target, resourceType, externalID),
+		nil)
+	return scimErr
+}
+
+// send sends a request to the SCIM endpoint and returns the response body of a successful response.
+func (c *scimClient) send(ctx context.Context, target config.ProvisioningTargetConfig, method, endpoint string,
+	payload interface{}) ([]byte, *scimError) {
+	var reqBody io.Reader
+	if payload != nil {
+		payloadJSON, err := json.Marshal(payload)
+		if err != nil {
+			return nil, &scimError{detail: fmt.Sprintf("failed to marshal the request: %v", err)}
+		}
+		reqBody = bytes.NewReader(payloadJSON)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, &scimError{detail: fmt.Sprintf("failed to create the request: %v", err)}
+	}
+	req.Header.Set("Accept", scimContentType+", application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", scimContentType)
+	}
+	if target.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+target.Token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, &scimError{detail: fmt.Sprintf("request failed: %v", err)}
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &scimError{detail: fmt.Sprintf("failed to read the response: %v", err)}
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, &scimError{statusCode: resp.StatusCode, detail: getErrorDetail(body)}
+	}
+
+	return body, nil
+}
+
+// getResourceEndpoint returns the endpoint of a resource type, or of a resource if its ID is given.
+func getResourceEndpoint(target config.ProvisioningTargetConfig, resourceType ResourceType,
+	externalID string) string {
+	endpoint := strings.TrimSuffix(target.URL, "/") + "/Users"
+	if resourceType == ResourceTypeGroup {
+		endpoint = strings.TrimSuffix(target.URL, "/") + "/Groups"
+	}
+	if externalID != "" {
+		endpoint += "/" + url.PathEscape(externalID)
+	}
+
+	return endpoint
+}
+
+// getErrorDetail returns the detail of a SCIM error response, or the truncated response body if it is not a
+// SCIM error.
+func getErrorDetail(body []byte) string {
+	var scimErrResp struct {
+		Detail string `json:"detail"`
+	}
+	detail := strings.TrimSpace(string(body))
+	if err := json.Unmarshal(body, &scimErrResp); err == nil && scimErrResp.Detail != "" {
+		detail = scimErrResp.Detail
+	}
+	if len(detail) > maxErrorDetailLength {
+		detail = detail[:maxErrorDetailLength]
+	}
+
+	return detail
+}
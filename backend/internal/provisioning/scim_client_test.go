@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+type SCIMClientTestSuite struct {
+	suite.Suite
+	mockHTTPClient *httpmock.HTTPClientInterfaceMock
+	client         scimClientInterface
+	target         config.ProvisioningTargetConfig
+}
+
+func TestSCIMClientTestSuite(t *testing.T) {
+	suite.Run(t, new(SCIMClientTestSuite))
+}
+
+func (suite *SCIMClientTestSuite) SetupTest() {
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.client = newSCIMClient(suite.mockHTTPClient)
+	suite.target = config.ProvisioningTargetConfig{
+		ID:    testTargetID,
+		URL:   "https://crm.example.com/scim/v2/",
+		Token: "secret",
+	}
+}
+
+func (suite *SCIMClientTestSuite) respond(method, url string, statusCode int, body string,
+	assertRequest func(req *http.Request)) {
+	suite.mockHTTPClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == method && req.URL.String() == url
+	})).RunAndReturn(func(req *http.Request) (*http.Response, error) {
+		if assertRequest != nil {
+			assertRequest(req)
+		}
+		return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}).Once()
+}
+
+func (suite *SCIMClientTestSuite) TestCreateResource() {
+	suite.respond(http.MethodPost, "https://crm.example.com/scim/v2/Users", http.StatusCreated,
+		`{"id":"ext-1"}`, func(req *http.Request) {
+			suite.Equal("Bearer secret", req.Header.Get("Authorization"))
+			suite.Equal(scimContentType, req.Header.Get("Content-Type"))
+			var body map[string]interface{}
+			suite.Require().NoError(json.NewDecoder(req.Body).Decode(&body))
+			suite.Equal("alice", body["userName"])
+		})
+
+	externalID, scimErr := suite.client.createResource(context.Background(), suite.target, ResourceTypeUser,
+		map[string]interface{}{"userName": "alice"})
+	suite.Nil(scimErr)
+	suite.Equal("ext-1", externalID)
+}
+
+func (suite *SCIMClientTestSuite) TestCreateResource_MissingID() {
+	suite.respond(http.MethodPost, "https://crm.example.com/scim/v2/Groups", http.StatusCreated, `{}`, nil)
+
+	_, scimErr := suite.client.createResource(context.Background(), suite.target, ResourceTypeGroup,
+		map[string]interface{}{"displayName": "Admins"})
+	suite.Require().NotNil(scimErr)
+	suite.False(scimErr.isRetryable())
+}
+
+func (suite *SCIMClientTestSuite) TestReplaceResource_ErrorResponse() {
+	suite.respond(http.MethodPut, "https://crm.example.com/scim/v2/Users/ext-1", http.StatusConflict,
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"detail":"userName is taken","status":"409"}`,
+		nil)
+
+	scimErr := suite.client.replaceResource(context.Background(), suite.target, ResourceTypeUser, "ext-1",
+		map[string]interface{}{"userName": "alice"})
+	suite.Require().NotNil(scimErr)
+	suite.False(scimErr.isRetryable())
+	suite.Equal("SCIM endpoint responded with status 409: userName is taken", scimErr.Error())
+}
+
+func (suite *SCIMClientTestSuite) TestDeactivateUser() {
+	suite.respond(http.MethodPatch, "https://crm.example.com/scim/v2/Users/ext-1", http.StatusOK, `{}`,
+		func(req *http.Request) {
+			body, err := io.ReadAll(req.Body)
+			suite.Require().NoError(err)
+			suite.JSONEq(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],`+
+				`"Operations":[{"op":"replace","value":{"active":false}}]}`, string(body))
+		})
+
+	suite.Nil(suite.client.deactivateUser(context.Background(), suite.target, "ext-1"))
+}
+
+func (suite *SCIMClientTestSuite) TestDeleteResource_NotFound() {
+	suite.respond(http.MethodDelete, "https://crm.example.com/scim/v2/Groups/ext-1", http.StatusNotFound, "",
+		nil)
+
+	scimErr := suite.client.deleteResource(context.Background(), suite.target, ResourceTypeGroup, "ext-1")
+	suite.Require().NotNil(scimErr)
+	suite.True(scimErr.isNotFound())
+}
+
+func (suite *SCIMClientTestSuite) TestSend_RetryableErrors() {
+	suite.mockHTTPClient.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	scimErr := suite.client.deleteResource(context.Background(), suite.target, ResourceTypeUser, "ext-1")
+	suite.Require().NotNil(scimErr)
+	suite.True(scimErr.isRetryable())
+
+	suite.respond(http.MethodDelete, "https://crm.example.com/scim/v2/Users/ext-1", http.StatusTooManyRequests,
+		"", nil)
+	scimErr = suite.client.deleteResource(context.Background(), suite.target, ResourceTypeUser, "ext-1")
+	suite.Require().NotNil(scimErr)
+	suite.True(scimErr.isRetryable())
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ProvisioningServiceInterface defines the interface for queueing the user and group lifecycle changes to be
+// pushed to the downstream SCIM endpoints and for retrieving their sync status.
+type ProvisioningServiceInterface interface {
+	QueueChange(ctx context.Context, change ResourceChange)
+	GetOperation(ctx context.Context, id string) (*ProvisioningOperation, *serviceerror.ServiceError)
+	ListOperations(ctx context.Context, limit, offset int) (*ProvisioningOperationList, *serviceerror.ServiceError)
+	GetTargetSyncStatuses(ctx context.Context) (*TargetSyncStatusList, *serviceerror.ServiceError)
+}
+
+// provisioningService implements ProvisioningServiceInterface.
+type provisioningService struct {
+	store   provisioningStoreInterface
+	targets []config.ProvisioningTargetConfig
+	logger  *log.Logger
+}
+
+// newProvisioningService returns a new instance of ProvisioningServiceInterface.
+func newProvisioningService(store provisioningStoreInterface,
+	targets []config.ProvisioningTargetConfig) ProvisioningServiceInterface {
+	return &provisioningService{
+		store:   store,
+		targets: targets,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ProvisioningService")),
+	}
+}
+
+// QueueChange queues a change of a user or a group to be pushed to each target by the provisioning job. Group
+// changes are only queued for the targets provisioning groups. Failures are logged rather than returned, since
+// the change has already been applied locally.
+func (s *provisioningService) QueueChange(ctx context.Context, change ResourceChange) {
+	if change.ResourceID == "" {
+		return
+	}
+
+	payload := resourcePayload{
+		Attributes:  change.Attributes,
+		DisplayName: change.DisplayName,
+		Members:     change.Members,
+	}
+	for _, target := range s.targets {
+		if change.ResourceType == ResourceTypeGroup && !target.ProvisionGroups {
+			continue
+		}
+
+		id, err := sysutils.GenerateUUIDv7()
+		if err != nil {
+			s.logger.Error("Failed to generate UUID for the provisioning operation", log.Error(err))
+			return
+		}
+
+		now := time.Now().UTC()
+		operation := ProvisioningOperation{
+			ID:           id,
+			TargetID:     target.ID,
+			ResourceType: change.ResourceType,
+			ResourceID:   change.ResourceID,
+			Operation:    change.Operation,
+			Status:       OperationStatusPending,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+			payload:      payload,
+		}
+		if err := s.store.createOperation(ctx, operation); err != nil {
+			s.logger.Error("Failed to queue the provisioning operation", log.String("targetId", target.ID),
+				log.String("resourceType", string(change.ResourceType)),
+				log.String("operation", string(change.Operation)), log.Error(err))
+			continue
+		}
+
+		s.logger.Debug("Provisioning operation queued", log.String("id", id), log.String("targetId", target.ID),
+			log.String("resourceType", string(change.ResourceType)),
+			log.String("operation", string(change.Operation)))
+	}
+}
+
+// GetOperation retrieves a provisioning operation along with its sync status.
+func (s *provisioningService) GetOperation(ctx context.Context, id string) (
+	*ProvisioningOperation, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorOperationNotFound
+	}
+
+	operation, err := s.store.getOperation(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the provisioning operation", log.String("id", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if operation == nil {
+		return nil, &ErrorOperationNotFound
+	}
+
+	return operation, nil
+}
+
+// ListOperations retrieves a page of the provisioning operations, most recent first.
+func (s *provisioningService) ListOperations(ctx context.Context, limit, offset int) (
+	*ProvisioningOperationList, *serviceerror.ServiceError) {
+	if limit <= 0 || limit > constants.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return nil, &ErrorInvalidOffset
+	}
+
+	totalCount, err := s.store.countOperations(ctx)
+	if err != nil {
+		s.logger.Error("Failed to count provisioning operations", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	operations, err := s.store.listOperations(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list provisioning operations", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &ProvisioningOperationList{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(operations),
+		Operations:   operations,
+		Links:        sysutils.BuildPaginationLinks(provisioningOperationsPath, limit, offset, totalCount, ""),
+	}, nil
+}
+
+// GetTargetSyncStatuses retrieves the number of operations of each configured target by status, along with
+// the time a change was last pushed to the target.
+func (s *provisioningService) GetTargetSyncStatuses(ctx context.Context) (
+	*TargetSyncStatusList, *serviceerror.ServiceError) {
+	counts, err := s.store.countOperationsByTarget(ctx)
+	if err != nil {
+		s.logger.Error("Failed to count provisioning operations by target", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	statuses := make([]TargetSyncStatus, 0, len(s.targets))
+	indexes := make(map[string]int, len(s.targets))
+	for i, target := range s.targets {
+		statuses = append(statuses, TargetSyncStatus{
+			ID:               target.ID,
+			Name:             target.Name,
+			URL:              target.URL,
+			ProvisionsGroups: target.ProvisionGroups,
+		})
+		indexes[target.ID] = i
+	}
+
+	for _, count := range counts {
+		i, ok := indexes[count.targetID]
+		if !ok {
+			continue
+		}
+		switch count.status {
+		case OperationStatusPending, OperationStatusInProgress:
+			statuses[i].PendingCount += count.count
+		case OperationStatusSucceeded:
+			statuses[i].SucceededCount = count.count
+			statuses[i].LastSucceededAt = count.lastCompletedAt
+		case OperationStatusFailed:
+			statuses[i].FailedCount = count.count
+		}
+	}
+
+	return &TargetSyncStatusList{
+		TotalResults: len(statuses),
+		Targets:      statuses,
+	}, nil
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type ProvisioningServiceTestSuite struct {
+	suite.Suite
+	mockStore *provisioningStoreInterfaceMock
+	service   ProvisioningServiceInterface
+}
+
+func TestProvisioningServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProvisioningServiceTestSuite))
+}
+
+func (suite *ProvisioningServiceTestSuite) SetupTest() {
+	suite.mockStore = newProvisioningStoreInterfaceMock(suite.T())
+	suite.service = newProvisioningService(suite.mockStore, []config.ProvisioningTargetConfig{
+		{ID: testTargetID, Name: "CRM", URL: "https://crm.example.com/scim/v2"},
+		{ID: "hr", URL: "https://hr.example.com/scim/v2", ProvisionGroups: true},
+	})
+}
+
+func (suite *ProvisioningServiceTestSuite) TestQueueChange_User() {
+	var queued []ProvisioningOperation
+	suite.mockStore.EXPECT().createOperation(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, operation ProvisioningOperation) {
+			queued = append(queued, operation)
+		}).Return(nil).Twice()
+
+	suite.service.QueueChange(context.Background(), ResourceChange{
+		ResourceType: ResourceTypeUser,
+		ResourceID:   testUserID,
+		Operation:    OperationCreate,
+		Attributes:   []byte(`{"username":"alice"}`),
+	})
+
+	suite.Require().Len(queued, 2)
+	suite.Equal(testTargetID, queued[0].TargetID)
+	suite.Equal("hr", queued[1].TargetID)
+	suite.Equal(OperationStatusPending, queued[0].Status)
+	suite.NotEmpty(queued[0].ID)
+	suite.JSONEq(`{"username":"alice"}`, string(queued[0].payload.Attributes))
+}
+
+func (suite *ProvisioningServiceTestSuite) TestQueueChange_GroupOnlyForGroupTargets() {
+	suite.mockStore.EXPECT().createOperation(mock.Anything, mock.MatchedBy(func(op ProvisioningOperation) bool {
+		return op.TargetID == "hr" && op.payload.DisplayName == "Admins"
+	})).Return(nil).Once()
+
+	suite.service.QueueChange(context.Background(), ResourceChange{
+		ResourceType: ResourceTypeGroup,
+		ResourceID:   "group-1",
+		Operation:    OperationUpdate,
+		DisplayName:  "Admins",
+		Members:      []string{testUserID},
+	})
+}
+
+func (suite *ProvisioningServiceTestSuite) TestQueueChange_StoreErrorContinues() {
+	suite.mockStore.EXPECT().createOperation(mock.Anything, mock.Anything).Return(errors.New("db err")).Twice()
+
+	suite.service.QueueChange(context.Background(), ResourceChange{
+		ResourceType: ResourceTypeUser,
+		ResourceID:   testUserID,
+		Operation:    OperationDeactivate,
+	})
+}
+
+func (suite *ProvisioningServiceTestSuite) TestQueueChange_MissingResourceID() {
+	suite.service.QueueChange(context.Background(), ResourceChange{ResourceType: ResourceTypeUser})
+}
+
+func (suite *ProvisioningServiceTestSuite) TestGetOperation() {
+	suite.mockStore.EXPECT().getOperation(mock.Anything, testOperationID).
+		Return(&ProvisioningOperation{ID: testOperationID}, nil).Once()
+
+	operation, svcErr := suite.service.GetOperation(context.Background(), testOperationID)
+	suite.Nil(svcErr)
+	suite.Equal(testOperationID, operation.ID)
+}
+
+func (suite *ProvisioningServiceTestSuite) TestGetOperation_Errors() {
+	_, svcErr := suite.service.GetOperation(context.Background(), " ")
+	suite.Equal(ErrorOperationNotFound.Code, svcErr.Code)
+
+	suite.mockStore.EXPECT().getOperation(mock.Anything, testOperationID).Return(nil, nil).Once()
+	_, svcErr = suite.service.GetOperation(context.Background(), testOperationID)
+	suite.Equal(ErrorOperationNotFound.Code, svcErr.Code)
+
+	suite.mockStore.EXPECT().getOperation(mock.Anything, "operation-2").Return(nil, errors.New("db err")).Once()
+	_, svcErr = suite.service.GetOperation(context.Background(), "operation-2")
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *ProvisioningServiceTestSuite) TestListOperations() {
+	suite.mockStore.EXPECT().countOperations(mock.Anything).Return(1, nil).Once()
+	suite.mockStore.EXPECT().listOperations(mock.Anything, 10, 0).
+		Return([]ProvisioningOperation{{ID: testOperationID}}, nil).Once()
+
+	list, svcErr := suite.service.ListOperations(context.Background(), 10, 0)
+	suite.Nil(svcErr)
+	suite.Equal(1, list.TotalResults)
+	suite.Equal(1, list.StartIndex)
+	suite.Equal(1, list.Count)
+}
+
+func (suite *ProvisioningServiceTestSuite) TestListOperations_InvalidPagination() {
+	_, svcErr := suite.service.ListOperations(context.Background(), 0, 0)
+	suite.Equal(ErrorInvalidLimit.Code, svcErr.Code)
+
+	_, svcErr = suite.service.ListOperations(context.Background(), 10, -1)
+	suite.Equal(ErrorInvalidOffset.Code, svcErr.Code)
+}
+
+func (suite *ProvisioningServiceTestSuite) TestGetTargetSyncStatuses() {
+	lastSucceededAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockStore.EXPECT().countOperationsByTarget(mock.Anything).Return([]targetStatusCount{
+		{targetID: testTargetID, status: OperationStatusSucceeded, count: 4, lastCompletedAt: &lastSucceededAt},
+		{targetID: testTargetID, status: OperationStatusPending, count: 2},
+		{targetID: testTargetID, status: OperationStatusInProgress, count: 1},
+		{targetID: "hr", status: OperationStatusFailed, count: 3},
+		{targetID: "removed", status: OperationStatusFailed, count: 5},
+	}, nil).Once()
+
+	list, svcErr := suite.service.GetTargetSyncStatuses(context.Background())
+	suite.Nil(svcErr)
+	suite.Equal(2, list.TotalResults)
+	suite.Equal("CRM", list.Targets[0].Name)
+	suite.Equal(3, list.Targets[0].PendingCount)
+	suite.Equal(4, list.Targets[0].SucceededCount)
+	suite.Equal(&lastSucceededAt, list.Targets[0].LastSucceededAt)
+	suite.Equal(3, list.Targets[1].FailedCount)
+	suite.True(list.Targets[1].ProvisionsGroups)
+}
+
+func (suite *ProvisioningServiceTestSuite) TestGetTargetSyncStatuses_StoreError() {
+	suite.mockStore.EXPECT().countOperationsByTarget(mock.Anything).Return(nil, errors.New("db err")).Once()
+
+	_, svcErr := suite.service.GetTargetSyncStatuses(context.Background())
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
@@ -0,0 +1,422 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// provisioningStoreInterface defines the interface for provisioning operation storage operations.
+type provisioningStoreInterface interface {
+	createOperation(ctx context.Context, operation ProvisioningOperation) error
+	getOperation(ctx context.Context, id string) (*ProvisioningOperation, error)
+	countOperations(ctx context.Context) (int, error)
+	listOperations(ctx context.Context, limit, offset int) ([]ProvisioningOperation, error)
+	listQueuedOperations(ctx context.Context, staleBefore time.Time, limit int) ([]ProvisioningOperation, error)
+	claimOperation(ctx context.Context, id string, now, staleBefore time.Time) (bool, error)
+	completeOperation(ctx context.Context, id string, status OperationStatus, attempts int, lastError string,
+		completedAt time.Time) error
+	rescheduleOperation(ctx context.Context, id string, attempts int, lastError string,
+		now, nextAttemptAt time.Time) error
+	countOperationsByTarget(ctx context.Context) ([]targetStatusCount, error)
+	getExternalID(ctx context.Context, targetID string, resourceType ResourceType, resourceID string) (
+		string, error)
+	saveExternalID(ctx context.Context, targetID string, resourceType ResourceType, resourceID,
+		externalID string) error
+	deleteExternalID(ctx context.Context, targetID string, resourceType ResourceType, resourceID string) error
+}
+
+// provisioningStore is the user database implementation of provisioningStoreInterface.
+type provisioningStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newProvisioningStore returns a new instance of provisioningStoreInterface.
+func newProvisioningStore() provisioningStoreInterface {
+	return &provisioningStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createOperation stores a new provisioning operation.
+func (s *provisioningStore) createOperation(ctx context.Context, operation ProvisioningOperation) error {
+	payloadJSON, err := json.Marshal(operation.payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the resource payload: %w", err)
+	}
+
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateOperation, operation.ID, operation.TargetID,
+		string(operation.ResourceType), operation.ResourceID, string(operation.Operation), string(payloadJSON),
+		string(operation.Status), operation.CreatedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// getOperation retrieves a provisioning operation by its ID. It returns nil if the operation does not exist.
+func (s *provisioningStore) getOperation(ctx context.Context, id string) (*ProvisioningOperation, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetOperation, id, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	return buildOperationFromResultRow(results[0])
+}
+
+// countOperations returns the number of provisioning operations.
+func (s *provisioningStore) countOperations(ctx context.Context) (int, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountOperations, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+
+	return parseCount(results[0]["total"])
+}
+
+// listOperations retrieves a page of the provisioning operations, most recent first.
+func (s *provisioningStore) listOperations(ctx context.Context, limit, offset int) (
+	[]ProvisioningOperation, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListOperations, s.deploymentID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return buildOperationsFromResultRows(results)
+}
+
+// listQueuedOperations retrieves the provisioning operations waiting to be pushed, oldest first. Operations
+// left in progress before staleBefore are included.
+func (s *provisioningStore) listQueuedOperations(ctx context.Context, staleBefore time.Time, limit int) (
+	[]ProvisioningOperation, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListQueuedOperations, s.deploymentID, staleBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return buildOperationsFromResultRows(results)
+}
+
+// claimOperation marks a provisioning operation as in progress. It returns false if the operation was claimed
+// by another node or is no longer waiting to be pushed.
+func (s *provisioningStore) claimOperation(ctx context.Context, id string, now, staleBefore time.Time) (
+	bool, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryClaimOperation, id, now, staleBefore, s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// completeOperation records the final status of a provisioning operation.
+func (s *provisioningStore) completeOperation(ctx context.Context, id string, status OperationStatus,
+	attempts int, lastError string, completedAt time.Time) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCompleteOperation, id, string(status), attempts,
+		dbutils.ToNullableString(lastError), completedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// rescheduleOperation returns a provisioning operation to the queue to be retried at nextAttemptAt.
+func (s *provisioningStore) rescheduleOperation(ctx context.Context, id string, attempts int, lastError string,
+	now, nextAttemptAt time.Time) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryRescheduleOperation, id, attempts, dbutils.ToNullableString(lastError),
+		now, nextAttemptAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// countOperationsByTarget returns the number of provisioning operations of each target by status.
+func (s *provisioningStore) countOperationsByTarget(ctx context.Context) ([]targetStatusCount, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountOperationsByTarget, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	counts := make([]targetStatusCount, 0, len(results))
+	for _, row := range results {
+		targetID, ok := row["target_id"].(string)
+		if !ok {
+			return nil, errors.New("failed to parse target_id as string")
+		}
+		status, ok := row["status"].(string)
+		if !ok {
+			return nil, errors.New("failed to parse status as string")
+		}
+		count, err := parseCount(row["total"])
+		if err != nil {
+			return nil, err
+		}
+
+		statusCount := targetStatusCount{targetID: targetID, status: OperationStatus(status), count: count}
+		if row["last_completed_at"] != nil {
+			lastCompletedAt, err := dbutils.ParseTimeField(row["last_completed_at"], "last_completed_at")
+			if err != nil {
+				return nil, err
+			}
+			statusCount.lastCompletedAt = &lastCompletedAt
+		}
+		counts = append(counts, statusCount)
+	}
+
+	return counts, nil
+}
+
+// getExternalID returns the ID assigned to a provisioned resource by a target. It returns an empty string if
+// the resource was not provisioned to the target.
+func (s *provisioningStore) getExternalID(ctx context.Context, targetID string, resourceType ResourceType,
+	resourceID string) (string, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetExternalID, targetID, string(resourceType), resourceID,
+		s.deploymentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return "", nil
+	}
+
+	externalID, ok := results[0]["external_id"].(string)
+	if !ok {
+		return "", errors.New("failed to parse external_id as string")
+	}
+
+	return externalID, nil
+}
+
+// saveExternalID records the ID assigned to a provisioned resource by a target.
+func (s *provisioningStore) saveExternalID(ctx context.Context, targetID string, resourceType ResourceType,
+	resourceID, externalID string) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, querySaveExternalID, targetID, string(resourceType), resourceID,
+		externalID, time.Now().UTC(), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// deleteExternalID removes the record of a resource provisioned to a target.
+func (s *provisioningStore) deleteExternalID(ctx context.Context, targetID string, resourceType ResourceType,
+	resourceID string) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteExternalID, targetID, string(resourceType), resourceID,
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildOperationsFromResultRows constructs the provisioning operations from database result rows.
+func buildOperationsFromResultRows(results []map[string]interface{}) ([]ProvisioningOperation, error) {
+	operations := make([]ProvisioningOperation, 0, len(results))
+	for _, row := range results {
+		operation, err := buildOperationFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, *operation)
+	}
+
+	return operations, nil
+}
+
+// buildOperationFromResultRow constructs a ProvisioningOperation from a database result row.
+func buildOperationFromResultRow(row map[string]interface{}) (*ProvisioningOperation, error) {
+	operation := &ProvisioningOperation{}
+	stringFields := []struct {
+		column string
+		value  *string
+	}{
+		{"id", &operation.ID},
+		{"target_id", &operation.TargetID},
+		{"resource_id", &operation.ResourceID},
+	}
+	for _, field := range stringFields {
+		value, ok := row[field.column].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse %s as string", field.column)
+		}
+		*field.value = value
+	}
+
+	resourceType, ok := row["resource_type"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse resource_type as string")
+	}
+	operation.ResourceType = ResourceType(resourceType)
+	operationName, ok := row["operation"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse operation as string")
+	}
+	operation.Operation = Operation(operationName)
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse status as string")
+	}
+	operation.Status = OperationStatus(status)
+
+	attempts, err := parseCount(row["attempts"])
+	if err != nil {
+		return nil, err
+	}
+	operation.Attempts = attempts
+
+	// Optional columns may be NULL.
+	operation.LastError, _ = row["last_error"].(string)
+
+	if operation.CreatedAt, err = dbutils.ParseTimeField(row["created_at"], "created_at"); err != nil {
+		return nil, err
+	}
+	if operation.UpdatedAt, err = dbutils.ParseTimeField(row["updated_at"], "updated_at"); err != nil {
+		return nil, err
+	}
+	if operation.NextAttemptAt, err = parseOptionalTimeField(row["next_attempt_at"], "next_attempt_at"); err != nil {
+		return nil, err
+	}
+	if operation.CompletedAt, err = parseOptionalTimeField(row["completed_at"], "completed_at"); err != nil {
+		return nil, err
+	}
+
+	var payloadJSON []byte
+	switch v := row["payload"].(type) {
+	case string:
+		payloadJSON = []byte(v)
+	case []byte:
+		payloadJSON = v
+	}
+	if len(payloadJSON) > 0 {
+		if err := json.Unmarshal(payloadJSON, &operation.payload); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+		}
+	}
+
+	return operation, nil
+}
+
+// parseCount parses an integer value returned by the database driver.
+func parseCount(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case int64:
+		return int(v), nil
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected type for count: %T", v)
+	}
+}
+
+// parseOptionalTimeField parses a time value of a nullable column. It returns nil if the value is NULL.
+func parseOptionalTimeField(field interface{}, fieldName string) (*time.Time, error) {
+	if field == nil {
+		return nil, nil
+	}
+	parsedTime, err := dbutils.ParseTimeField(field, fieldName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsedTime, nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+// operationColumns lists the columns selected when retrieving provisioning operations.
+const operationColumns = `ID, TARGET_ID, RESOURCE_TYPE, RESOURCE_ID, OPERATION, PAYLOAD, STATUS, ATTEMPTS, ` +
+	`LAST_ERROR, CREATED_AT, UPDATED_AT, NEXT_ATTEMPT_AT, COMPLETED_AT`
+
+var (
+	// queryCreateOperation is the query to create a provisioning operation.
+	queryCreateOperation = dbmodel.DBQuery{
+		ID: "PRV-01",
+		Query: `INSERT INTO "PROVISIONING_OPERATION" ` +
+			`(ID, TARGET_ID, RESOURCE_TYPE, RESOURCE_ID, OPERATION, PAYLOAD, STATUS, ATTEMPTS, CREATED_AT, ` +
+			`UPDATED_AT, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, 0, $8, $8, $9)`,
+	}
+
+	// queryGetOperation is the query to get a provisioning operation by its ID.
+	queryGetOperation = dbmodel.DBQuery{
+		ID: "PRV-02",
+		Query: `SELECT ` + operationColumns + ` FROM "PROVISIONING_OPERATION" ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCountOperations is the query to count the provisioning operations.
+	queryCountOperations = dbmodel.DBQuery{
+		ID:    "PRV-03",
+		Query: `SELECT COUNT(*) as total FROM "PROVISIONING_OPERATION" WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryListOperations is the query to list a page of the provisioning operations, most recent first.
+	queryListOperations = dbmodel.DBQuery{
+		ID: "PRV-04",
+		Query: `SELECT ` + operationColumns + ` FROM "PROVISIONING_OPERATION" WHERE DEPLOYMENT_ID = $1 ` +
+			`ORDER BY CREATED_AT DESC LIMIT $2 OFFSET $3`,
+	}
+
+	// queryListQueuedOperations is the query to list the provisioning operations waiting to be pushed, oldest
+	// first. Operations left in progress before the given time are included so that they are resumed.
+	queryListQueuedOperations = dbmodel.DBQuery{
+		ID: "PRV-05",
+		Query: `SELECT ` + operationColumns + ` FROM "PROVISIONING_OPERATION" WHERE DEPLOYMENT_ID = $1 ` +
+			`AND (STATUS = 'PENDING' OR (STATUS = 'IN_PROGRESS' AND UPDATED_AT < $2)) ` +
+			`ORDER BY CREATED_AT LIMIT $3`,
+	}
+
+	// queryClaimOperation is the query to mark a provisioning operation as in progress. The operation is only
+	// updated if it is still waiting to be pushed, so that it is pushed by a single node.
+	queryClaimOperation = dbmodel.DBQuery{
+		ID: "PRV-06",
+		Query: `UPDATE "PROVISIONING_OPERATION" SET STATUS = 'IN_PROGRESS', UPDATED_AT = $2 ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $4 ` +
+			`AND (STATUS = 'PENDING' OR (STATUS = 'IN_PROGRESS' AND UPDATED_AT < $3))`,
+	}
+
+	// queryCompleteOperation is the query to record the final status of a provisioning operation.
+	queryCompleteOperation = dbmodel.DBQuery{
+		ID: "PRV-07",
+		Query: `UPDATE "PROVISIONING_OPERATION" SET STATUS = $2, ATTEMPTS = $3, LAST_ERROR = $4, ` +
+			`UPDATED_AT = $5, NEXT_ATTEMPT_AT = NULL, COMPLETED_AT = $5 WHERE ID = $1 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryRescheduleOperation is the query to schedule the retry of a provisioning operation.
+	queryRescheduleOperation = dbmodel.DBQuery{
+		ID: "PRV-08",
+		Query: `UPDATE "PROVISIONING_OPERATION" SET STATUS = 'PENDING', ATTEMPTS = $2, LAST_ERROR = $3, ` +
+			`UPDATED_AT = $4, NEXT_ATTEMPT_AT = $5 WHERE ID = $1 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryCountOperationsByTarget is the query to count the provisioning operations of each target by status,
+	// along with the time the last operation in each status was completed.
+	queryCountOperationsByTarget = dbmodel.DBQuery{
+		ID: "PRV-09",
+		Query: `SELECT TARGET_ID, STATUS, COUNT(*) as total, MAX(COMPLETED_AT) as last_completed_at ` +
+			`FROM "PROVISIONING_OPERATION" WHERE DEPLOYMENT_ID = $1 GROUP BY TARGET_ID, STATUS`,
+	}
+
+	// queryGetExternalID is the query to get the ID assigned to a provisioned resource by a target.
+	queryGetExternalID = dbmodel.DBQuery{
+		ID: "PRV-10",
+		Query: `SELECT EXTERNAL_ID FROM "PROVISIONED_RESOURCE" WHERE TARGET_ID = $1 AND RESOURCE_TYPE = $2 ` +
+			`AND RESOURCE_ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// querySaveExternalID is the query to record the ID assigned to a provisioned resource by a target.
+	querySaveExternalID = dbmodel.DBQuery{
+		ID: "PRV-11",
+		Query: `INSERT INTO "PROVISIONED_RESOURCE" ` +
+			`(TARGET_ID, RESOURCE_TYPE, RESOURCE_ID, EXTERNAL_ID, CREATED_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6) ` +
+			`ON CONFLICT (TARGET_ID, RESOURCE_TYPE, RESOURCE_ID, DEPLOYMENT_ID) ` +
+			`DO UPDATE SET EXTERNAL_ID = EXCLUDED.EXTERNAL_ID`,
+	}
+
+	// queryDeleteExternalID is the query to remove the record of a resource provisioned to a target.
+	queryDeleteExternalID = dbmodel.DBQuery{
+		ID: "PRV-12",
+		Query: `DELETE FROM "PROVISIONED_RESOURCE" WHERE TARGET_ID = $1 AND RESOURCE_TYPE = $2 ` +
+			`AND RESOURCE_ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+)
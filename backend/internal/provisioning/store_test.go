@@ -0,0 +1,240 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package provisioning
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment"
+	testOperationID  = "operation-1"
+	testTargetID     = "crm"
+	testUserID       = "user-1"
+	testExternalID   = "ext-1"
+)
+
+type ProvisioningStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *provisioningStore
+}
+
+func TestProvisioningStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ProvisioningStoreTestSuite))
+}
+
+func (suite *ProvisioningStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &provisioningStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *ProvisioningStoreTestSuite) TestCreateOperation() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateOperation, testOperationID,
+		testTargetID, "USER", testUserID, "CREATE", `{"attributes":{"username":"alice"}}`, "PENDING", createdAt,
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createOperation(context.Background(), ProvisioningOperation{
+		ID:           testOperationID,
+		TargetID:     testTargetID,
+		ResourceType: ResourceTypeUser,
+		ResourceID:   testUserID,
+		Operation:    OperationCreate,
+		Status:       OperationStatusPending,
+		CreatedAt:    createdAt,
+		payload:      resourcePayload{Attributes: []byte(`{"username":"alice"}`)},
+	})
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestCreateOperation_DBClientError() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createOperation(context.Background(), ProvisioningOperation{ID: testOperationID})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *ProvisioningStoreTestSuite) TestGetOperation() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetOperation, testOperationID,
+		testDeploymentID).Return([]map[string]interface{}{{
+		"id":              testOperationID,
+		"target_id":       testTargetID,
+		"resource_type":   "GROUP",
+		"resource_id":     "group-1",
+		"operation":       "UPDATE",
+		"payload":         `{"displayName":"Admins","members":["user-1"]}`,
+		"status":          "PENDING",
+		"attempts":        int64(2),
+		"last_error":      "SCIM endpoint responded with status 503: unavailable",
+		"created_at":      "2026-01-02 03:04:05",
+		"updated_at":      "2026-01-02 03:05:05",
+		"next_attempt_at": "2026-01-02 03:06:05",
+		"completed_at":    nil,
+	}}, nil).Once()
+
+	operation, err := suite.store.getOperation(context.Background(), testOperationID)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(operation)
+	suite.Equal(ResourceTypeGroup, operation.ResourceType)
+	suite.Equal(OperationUpdate, operation.Operation)
+	suite.Equal(OperationStatusPending, operation.Status)
+	suite.Equal(2, operation.Attempts)
+	suite.Equal("Admins", operation.payload.DisplayName)
+	suite.Equal([]string{"user-1"}, operation.payload.Members)
+	suite.Require().NotNil(operation.NextAttemptAt)
+	suite.Equal(time.Date(2026, 1, 2, 3, 6, 5, 0, time.UTC), *operation.NextAttemptAt)
+	suite.Nil(operation.CompletedAt)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestGetOperation_NotFound() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetOperation, testOperationID,
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	operation, err := suite.store.getOperation(context.Background(), testOperationID)
+	suite.NoError(err)
+	suite.Nil(operation)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestGetOperation_InvalidRow() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetOperation, testOperationID,
+		testDeploymentID).Return([]map[string]interface{}{{"id": testOperationID}}, nil).Once()
+
+	_, err := suite.store.getOperation(context.Background(), testOperationID)
+	suite.Error(err)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestCountOperations() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryCountOperations, testDeploymentID).
+		Return([]map[string]interface{}{{"total": int64(3)}}, nil).Once()
+
+	count, err := suite.store.countOperations(context.Background())
+	suite.NoError(err)
+	suite.Equal(3, count)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestClaimOperation() {
+	now := time.Now().UTC()
+	staleBefore := now.Add(-time.Minute)
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryClaimOperation, testOperationID, now,
+		staleBefore, testDeploymentID).Return(int64(0), nil).Once()
+
+	claimed, err := suite.store.claimOperation(context.Background(), testOperationID, now, staleBefore)
+	suite.NoError(err)
+	suite.False(claimed)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestCompleteOperation() {
+	now := time.Now().UTC()
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCompleteOperation, testOperationID,
+		"SUCCEEDED", 1, nil, now, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.completeOperation(context.Background(), testOperationID, OperationStatusSucceeded, 1, "",
+		now)
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestRescheduleOperation() {
+	now := time.Now().UTC()
+	next := now.Add(time.Minute)
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryRescheduleOperation, testOperationID,
+		2, "timeout", now, next, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.rescheduleOperation(context.Background(), testOperationID, 2, "timeout", now, next)
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestCountOperationsByTarget() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryCountOperationsByTarget,
+		testDeploymentID).Return([]map[string]interface{}{
+		{"target_id": testTargetID, "status": "SUCCEEDED", "total": int64(4),
+			"last_completed_at": "2026-01-02 03:04:05"},
+		{"target_id": testTargetID, "status": "PENDING", "total": int64(1), "last_completed_at": nil},
+	}, nil).Once()
+
+	counts, err := suite.store.countOperationsByTarget(context.Background())
+	suite.Require().NoError(err)
+	suite.Require().Len(counts, 2)
+	suite.Equal(4, counts[0].count)
+	suite.Require().NotNil(counts[0].lastCompletedAt)
+	suite.Nil(counts[1].lastCompletedAt)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestGetExternalID() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetExternalID, testTargetID, "USER",
+		testUserID, testDeploymentID).Return([]map[string]interface{}{{"external_id": testExternalID}}, nil).Once()
+
+	externalID, err := suite.store.getExternalID(context.Background(), testTargetID, ResourceTypeUser, testUserID)
+	suite.NoError(err)
+	suite.Equal(testExternalID, externalID)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestGetExternalID_NotProvisioned() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetExternalID, testTargetID, "USER",
+		testUserID, testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	externalID, err := suite.store.getExternalID(context.Background(), testTargetID, ResourceTypeUser, testUserID)
+	suite.NoError(err)
+	suite.Empty(externalID)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestSaveExternalID() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), querySaveExternalID, testTargetID, "USER",
+		testUserID, testExternalID, mock.AnythingOfType("time.Time"), testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.saveExternalID(context.Background(), testTargetID, ResourceTypeUser, testUserID,
+		testExternalID)
+	suite.NoError(err)
+}
+
+func (suite *ProvisioningStoreTestSuite) TestDeleteExternalID_ExecuteError() {
+	suite.mockDBProvider.EXPECT().GetUserDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteExternalID, testTargetID, "USER",
+		testUserID, testDeploymentID).Return(int64(0), errors.New("db err")).Once()
+
+	err := suite.store.deleteExternalID(context.Background(), testTargetID, ResourceTypeUser, testUserID)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to execute query")
+}
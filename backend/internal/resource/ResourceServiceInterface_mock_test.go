@@ -701,6 +701,76 @@ func (_c *ResourceServiceInterfaceMock_GetActionList_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetPermissionDetails provides a mock function for the type ResourceServiceInterfaceMock
+func (_mock *ResourceServiceInterfaceMock) GetPermissionDetails(ctx context.Context, permissions []string) ([]PermissionDetail, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, permissions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPermissionDetails")
+	}
+
+	var r0 []PermissionDetail
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]PermissionDetail, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, permissions)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []PermissionDetail); ok {
+		r0 = returnFunc(ctx, permissions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]PermissionDetail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, permissions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ResourceServiceInterfaceMock_GetPermissionDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPermissionDetails'
+type ResourceServiceInterfaceMock_GetPermissionDetails_Call struct {
+	*mock.Call
+}
+
+// GetPermissionDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - permissions []string
+func (_e *ResourceServiceInterfaceMock_Expecter) GetPermissionDetails(ctx interface{}, permissions interface{}) *ResourceServiceInterfaceMock_GetPermissionDetails_Call {
+	return &ResourceServiceInterfaceMock_GetPermissionDetails_Call{Call: _e.mock.On("GetPermissionDetails", ctx, permissions)}
+}
+
+func (_c *ResourceServiceInterfaceMock_GetPermissionDetails_Call) Run(run func(ctx context.Context, permissions []string)) *ResourceServiceInterfaceMock_GetPermissionDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ResourceServiceInterfaceMock_GetPermissionDetails_Call) Return(permissionDetails []PermissionDetail, serviceError *serviceerror.ServiceError) *ResourceServiceInterfaceMock_GetPermissionDetails_Call {
+	_c.Call.Return(permissionDetails, serviceError)
+	return _c
+}
+
+func (_c *ResourceServiceInterfaceMock_GetPermissionDetails_Call) RunAndReturn(run func(ctx context.Context, permissions []string) ([]PermissionDetail, *serviceerror.ServiceError)) *ResourceServiceInterfaceMock_GetPermissionDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResource provides a mock function for the type ResourceServiceInterfaceMock
 func (_mock *ResourceServiceInterfaceMock) GetResource(ctx context.Context, resourceServerID string, id string) (*Resource, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, resourceServerID, id)
@@ -20,6 +20,7 @@ package resource
 
 import (
 	"context"
+	"sort"
 
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
@@ -583,6 +584,43 @@ func (c *compositeResourceStore) FindResourceServersByPermissions(
 	return mergeAndDeduplicateResourceServers(dbServers, fileServers), nil
 }
 
+func (c *compositeResourceStore) GetPermissionDetails(
+	ctx context.Context, permissions []string,
+) ([]PermissionDetail, error) {
+	if len(permissions) == 0 {
+		return []PermissionDetail{}, nil
+	}
+
+	dbDetails, err := c.dbStore.GetPermissionDetails(ctx, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	fileDetails, err := c.fileStore.GetPermissionDetails(ctx, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	// A permission is defined by a single resource or action, so DB entries take precedence.
+	seen := make(map[string]bool, len(dbDetails))
+	result := make([]PermissionDetail, 0, len(dbDetails)+len(fileDetails))
+	for _, detail := range dbDetails {
+		seen[detail.Permission] = true
+		result = append(result, detail)
+	}
+	for _, detail := range fileDetails {
+		if !seen[detail.Permission] {
+			seen[detail.Permission] = true
+			result = append(result, detail)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Permission < result[j].Permission
+	})
+	return result, nil
+}
+
 func mergeAndDeduplicateResourceServers(dbServers, fileServers []ResourceServer) []ResourceServer {
 	seen := make(map[string]bool)
 	result := make([]ResourceServer, 0, len(dbServers)+len(fileServers))
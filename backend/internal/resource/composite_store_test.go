@@ -1075,6 +1075,40 @@ func (s *CompositeResourceStoreTestSuite) TestValidatePermissions_SomeInvalid()
 	s.fileStoreMock.AssertExpectations(s.T())
 }
 
+func (s *CompositeResourceStoreTestSuite) TestGetPermissionDetails_MergesStores() {
+	permissions := []string{"a:read", "b:read", "c:read"}
+
+	s.dbStoreMock.On("GetPermissionDetails", mock.Anything, permissions).Return([]PermissionDetail{
+		{Permission: "c:read", Name: "DB C"},
+		{Permission: "b:read", Name: "DB B"},
+	}, nil)
+	s.fileStoreMock.On("GetPermissionDetails", mock.Anything, permissions).Return([]PermissionDetail{
+		{Permission: "a:read", Name: "File A"},
+		{Permission: "b:read", Name: "File B"},
+	}, nil)
+
+	details, err := s.compositeStore.GetPermissionDetails(s.ctx, permissions)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []PermissionDetail{
+		{Permission: "a:read", Name: "File A"},
+		{Permission: "b:read", Name: "DB B"},
+		{Permission: "c:read", Name: "DB C"},
+	}, details)
+}
+
+func (s *CompositeResourceStoreTestSuite) TestGetPermissionDetails_DBError() {
+	permissions := []string{"a:read"}
+
+	s.dbStoreMock.On("GetPermissionDetails", mock.Anything, permissions).Return(nil, errors.New("db error"))
+
+	details, err := s.compositeStore.GetPermissionDetails(s.ctx, permissions)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), details)
+	s.fileStoreMock.AssertNotCalled(s.T(), "GetPermissionDetails")
+}
+
 // TestMergeAndDeduplicateResourceServers tests the merge helper function for resource servers.
 func (s *CompositeResourceStoreTestSuite) TestMergeAndDeduplicateResourceServers_MarksCorrectIsReadOnly() {
 	dbServers := []ResourceServer{
@@ -655,6 +655,58 @@ func (f *fileBasedResourceStore) FindResourceServersByPermissions(
 	return matched, nil
 }
 
+func (f *fileBasedResourceStore) GetPermissionDetails(
+	ctx context.Context, permissions []string,
+) ([]PermissionDetail, error) {
+	if len(permissions) == 0 {
+		return []PermissionDetail{}, nil
+	}
+
+	list, err := f.GenericFileBasedStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	permSet := make(map[string]struct{}, len(permissions))
+	for _, p := range permissions {
+		permSet[p] = struct{}{}
+	}
+
+	details := make([]PermissionDetail, 0)
+	for _, item := range list {
+		rs, ok := item.Data.(*ResourceServer)
+		if !ok {
+			continue
+		}
+		for _, res := range rs.Resources {
+			if _, ok := permSet[res.Permission]; ok {
+				details = append(details, newPermissionDetail(rs, res.Permission, res.Name, res.Description))
+			}
+			for _, action := range res.Actions {
+				if _, ok := permSet[action.Permission]; ok {
+					details = append(details,
+						newPermissionDetail(rs, action.Permission, action.Name, action.Description))
+				}
+			}
+		}
+	}
+
+	sort.Slice(details, func(i, j int) bool {
+		return details[i].Permission < details[j].Permission
+	})
+	return details, nil
+}
+
+func newPermissionDetail(rs *ResourceServer, permission, name, description string) PermissionDetail {
+	return PermissionDetail{
+		Permission:               permission,
+		Name:                     name,
+		Description:              description,
+		ResourceServerName:       rs.Name,
+		ResourceServerIdentifier: rs.Identifier,
+	}
+}
+
 func containsAnyPermission(rs *ResourceServer, permSet map[string]struct{}) bool {
 	for _, res := range rs.Resources {
 		if _, ok := permSet[res.Permission]; ok {
@@ -287,6 +287,51 @@ func (s *FileBasedResourceStoreTestSuite) TestValidatePermissions_EmptyResult()
 	assert.Contains(s.T(), invalid, "permission2")
 }
 
+func (s *FileBasedResourceStoreTestSuite) TestGetPermissionDetails_WithData() {
+	fileStore, ok := s.store.(*fileBasedResourceStore)
+	assert.True(s.T(), ok)
+
+	rs := &ResourceServer{
+		ID:         "rs-orders",
+		Name:       "Orders API",
+		Identifier: "https://api.example.com/orders",
+		OUID:       "ou1",
+		Resources: []Resource{
+			{
+				Name:        "Orders",
+				Handle:      "orders",
+				Description: "Customer orders",
+				Permission:  "orders",
+				Actions: []Action{
+					{Name: "Read", Handle: "read", Description: "Read orders", Permission: "orders:read"},
+				},
+			},
+		},
+	}
+	err := fileStore.Create("rs-orders", rs)
+	assert.NoError(s.T(), err)
+
+	details, err := s.store.GetPermissionDetails(s.ctx, []string{"orders:read", "orders", "unknown"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []PermissionDetail{
+		{
+			Permission:               "orders",
+			Name:                     "Orders",
+			Description:              "Customer orders",
+			ResourceServerName:       "Orders API",
+			ResourceServerIdentifier: "https://api.example.com/orders",
+		},
+		{
+			Permission:               "orders:read",
+			Name:                     "Read",
+			Description:              "Read orders",
+			ResourceServerName:       "Orders API",
+			ResourceServerIdentifier: "https://api.example.com/orders",
+		},
+	}, details)
+}
+
 func (s *FileBasedResourceStoreTestSuite) TestGetResourceAndLists_WithData() {
 	fileStore, ok := s.store.(*fileBasedResourceStore)
 	assert.True(s.T(), ok)
@@ -163,6 +163,16 @@ type ActionList struct {
 	Links        []Link
 }
 
+// PermissionDetail describes a registered permission, the resource or action that defines it,
+// and the resource server it belongs to.
+type PermissionDetail struct {
+	Permission               string
+	Name                     string
+	Description              string
+	ResourceServerName       string
+	ResourceServerIdentifier string
+}
+
 // Consolidated resource models for YAML parsing, processing, and service layer
 // These models use:
 // - yaml tags for YAML parsing (serialize/deserialize)
@@ -100,6 +100,13 @@ type ResourceServiceInterface interface {
 	FindResourceServersByPermissions(
 		ctx context.Context, permissions []string,
 	) ([]ResourceServer, *serviceerror.ServiceError)
+
+	// GetPermissionDetails returns the name, description, and resource server of each registered
+	// permission in the supplied set. Permissions that are not registered are left out. Used by the
+	// OAuth2 layer to validate requested scopes and to describe them on the consent screen.
+	GetPermissionDetails(
+		ctx context.Context, permissions []string,
+	) ([]PermissionDetail, *serviceerror.ServiceError)
 }
 
 // resourceService is the default implementation of ResourceServiceInterface.
@@ -1314,6 +1321,23 @@ func (rs *resourceService) FindResourceServersByPermissions(
 	return resourceServers, nil
 }
 
+// GetPermissionDetails returns the details of the registered permissions in the supplied set.
+func (rs *resourceService) GetPermissionDetails(
+	ctx context.Context,
+	permissions []string,
+) ([]PermissionDetail, *serviceerror.ServiceError) {
+	if len(permissions) == 0 {
+		return []PermissionDetail{}, nil
+	}
+
+	details, err := rs.resourceStore.GetPermissionDetails(ctx, permissions)
+	if err != nil {
+		rs.logger.Error("Failed to get permission details", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return details, nil
+}
+
 // Validation helper methods
 
 // validateAndGetResourceServer validates resource server exists and returns it.
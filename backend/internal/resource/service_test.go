@@ -4348,6 +4348,38 @@ func (suite *ResourceServiceTestSuite) TestValidatePermissions() {
 	}
 }
 
+func (suite *ResourceServiceTestSuite) TestGetPermissionDetails() {
+	details := []PermissionDetail{
+		{Permission: "orders:read", Name: "Read", ResourceServerIdentifier: "https://api.example.com/orders"},
+	}
+	suite.mockStore.On("GetPermissionDetails", mock.Anything, []string{"orders:read", "unknown"}).
+		Return(details, nil)
+
+	result, err := suite.service.GetPermissionDetails(context.Background(), []string{"orders:read", "unknown"})
+
+	suite.Nil(err)
+	suite.Equal(details, result)
+}
+
+func (suite *ResourceServiceTestSuite) TestGetPermissionDetails_EmptyPermissions() {
+	result, err := suite.service.GetPermissionDetails(context.Background(), nil)
+
+	suite.Nil(err)
+	suite.Empty(result)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetPermissionDetails", mock.Anything, mock.Anything)
+}
+
+func (suite *ResourceServiceTestSuite) TestGetPermissionDetails_StoreError() {
+	suite.mockStore.On("GetPermissionDetails", mock.Anything, []string{"orders:read"}).
+		Return(nil, errors.New("database error"))
+
+	result, err := suite.service.GetPermissionDetails(context.Background(), []string{"orders:read"})
+
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+	suite.Nil(result)
+}
+
 // Test cases for declarative resource functionality
 
 func (suite *ResourceServiceTestSuite) TestIsResourceServerDeclarative_True() {
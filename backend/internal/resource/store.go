@@ -76,6 +76,7 @@ type resourceStoreInterface interface {
 	) (bool, error)
 	ValidatePermissions(ctx context.Context, resServerID string, permissions []string) ([]string, error)
 	FindResourceServersByPermissions(ctx context.Context, permissions []string) ([]ResourceServer, error)
+	GetPermissionDetails(ctx context.Context, permissions []string) ([]PermissionDetail, error)
 }
 
 // resourceStore is the default implementation of resourceStoreInterface.
@@ -900,6 +901,48 @@ func (s *resourceStore) FindResourceServersByPermissions(
 	return resourceServers, nil
 }
 
+// GetPermissionDetails returns the details of the resources and actions that define any of the supplied
+// permissions. Permissions that are not registered are left out of the result.
+func (s *resourceStore) GetPermissionDetails(
+	ctx context.Context, permissions []string,
+) ([]PermissionDetail, error) {
+	if len(permissions) == 0 {
+		return []PermissionDetail{}, nil
+	}
+
+	var details []PermissionDetail
+	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
+		permissionsJSON, jsonErr := json.Marshal(permissions)
+		if jsonErr != nil {
+			return fmt.Errorf("failed to marshal permissions to JSON: %w", jsonErr)
+		}
+
+		results, err := dbClient.QueryContext(
+			ctx,
+			queryGetPermissionDetails,
+			s.deploymentID,
+			string(permissionsJSON),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get permission details: %w", err)
+		}
+
+		details = make([]PermissionDetail, 0, len(results))
+		for _, row := range results {
+			detail, buildErr := buildPermissionDetailFromResultRow(row)
+			if buildErr != nil {
+				return fmt.Errorf("failed to build permission detail: %w", buildErr)
+			}
+			details = append(details, detail)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
 // Helper methods
 
 // getConfigDBClient retrieves the config database client.
@@ -1105,3 +1148,32 @@ func buildActionFromResultRow(row map[string]interface{}) (Action, error) {
 
 	return action, nil
 }
+
+// buildPermissionDetailFromResultRow constructs a PermissionDetail from a database result row.
+func buildPermissionDetailFromResultRow(row map[string]interface{}) (PermissionDetail, error) {
+	detail := PermissionDetail{}
+
+	if permission, ok := row["permission"].(string); ok {
+		detail.Permission = permission
+	} else {
+		return detail, fmt.Errorf("permission field is missing or invalid")
+	}
+
+	if name, ok := row["name"].(string); ok {
+		detail.Name = name
+	}
+
+	if desc, ok := row["description"].(string); ok {
+		detail.Description = desc
+	}
+
+	if rsName, ok := row["resource_server_name"].(string); ok {
+		detail.ResourceServerName = rsName
+	}
+
+	if identifier, ok := row["resource_server_identifier"].(string); ok {
+		detail.ResourceServerIdentifier = identifier
+	}
+
+	return detail, nil
+}
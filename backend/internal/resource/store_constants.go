@@ -445,4 +445,37 @@ var (
 		              AND a.PERMISSION = p.value
 		        )`,
 	}
+	// queryGetPermissionDetails returns the resources and actions that define any of the supplied
+	// permissions, along with the resource server they belong to. Parameter $2 must be a JSON array string.
+	queryGetPermissionDetails = dbmodel.DBQuery{
+		ID: "RSQ-RES_MGT-38",
+		PostgresQuery: `SELECT r.PERMISSION AS permission, r.NAME AS name, r.DESCRIPTION AS description,
+		               rs.NAME AS resource_server_name, rs.IDENTIFIER AS resource_server_identifier
+		        FROM "RESOURCE" r
+		        JOIN "RESOURCE_SERVER" rs ON rs.ID = r.RESOURCE_SERVER_ID AND rs.DEPLOYMENT_ID = r.DEPLOYMENT_ID
+		        JOIN json_array_elements_text($2::json) AS p ON r.PERMISSION = p.value::text
+		        WHERE r.DEPLOYMENT_ID = $1
+		        UNION
+		        SELECT a.PERMISSION AS permission, a.NAME AS name, a.DESCRIPTION AS description,
+		               rs.NAME AS resource_server_name, rs.IDENTIFIER AS resource_server_identifier
+		        FROM "ACTION" a
+		        JOIN "RESOURCE_SERVER" rs ON rs.ID = a.RESOURCE_SERVER_ID AND rs.DEPLOYMENT_ID = a.DEPLOYMENT_ID
+		        JOIN json_array_elements_text($2::json) AS p ON a.PERMISSION = p.value::text
+		        WHERE a.DEPLOYMENT_ID = $1
+		        ORDER BY permission`,
+		SQLiteQuery: `SELECT r.PERMISSION AS permission, r.NAME AS name, r.DESCRIPTION AS description,
+		              rs.NAME AS resource_server_name, rs.IDENTIFIER AS resource_server_identifier
+		        FROM "RESOURCE" r
+		        JOIN "RESOURCE_SERVER" rs ON rs.ID = r.RESOURCE_SERVER_ID AND rs.DEPLOYMENT_ID = r.DEPLOYMENT_ID
+		        JOIN json_each($2) AS p ON r.PERMISSION = p.value
+		        WHERE r.DEPLOYMENT_ID = $1
+		        UNION
+		        SELECT a.PERMISSION AS permission, a.NAME AS name, a.DESCRIPTION AS description,
+		               rs.NAME AS resource_server_name, rs.IDENTIFIER AS resource_server_identifier
+		        FROM "ACTION" a
+		        JOIN "RESOURCE_SERVER" rs ON rs.ID = a.RESOURCE_SERVER_ID AND rs.DEPLOYMENT_ID = a.DEPLOYMENT_ID
+		        JOIN json_each($2) AS p ON a.PERMISSION = p.value
+		        WHERE a.DEPLOYMENT_ID = $1
+		        ORDER BY permission`,
+	}
 )
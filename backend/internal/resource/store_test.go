@@ -3632,6 +3632,67 @@ func (suite *ResourceStoreTestSuite) TestValidatePermissions() {
 	}
 }
 
+func (suite *ResourceStoreTestSuite) TestGetPermissionDetails() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(),
+		queryGetPermissionDetails, "test-deployment", `["orders:read","unknown"]`).
+		Return([]map[string]interface{}{
+			{
+				"permission":                 "orders:read",
+				"name":                       "Read",
+				"description":                "Read orders",
+				"resource_server_name":       "Orders API",
+				"resource_server_identifier": "https://api.example.com/orders",
+			},
+		}, nil)
+
+	details, err := suite.store.GetPermissionDetails(context.Background(), []string{"orders:read", "unknown"})
+
+	suite.NoError(err)
+	suite.Equal([]PermissionDetail{
+		{
+			Permission:               "orders:read",
+			Name:                     "Read",
+			Description:              "Read orders",
+			ResourceServerName:       "Orders API",
+			ResourceServerIdentifier: "https://api.example.com/orders",
+		},
+	}, details)
+}
+
+func (suite *ResourceStoreTestSuite) TestGetPermissionDetails_EmptyPermissions() {
+	details, err := suite.store.GetPermissionDetails(context.Background(), []string{})
+
+	suite.NoError(err)
+	suite.Empty(details)
+}
+
+func (suite *ResourceStoreTestSuite) TestGetPermissionDetails_InvalidRow() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(),
+		queryGetPermissionDetails, "test-deployment", `["orders:read"]`).
+		Return([]map[string]interface{}{{"name": "Read"}}, nil)
+
+	details, err := suite.store.GetPermissionDetails(context.Background(), []string{"orders:read"})
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "permission field is missing or invalid")
+	suite.Nil(details)
+}
+
+func (suite *ResourceStoreTestSuite) TestGetPermissionDetails_QueryError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(),
+		queryGetPermissionDetails, "test-deployment", `["orders:read"]`).
+		Return(nil, errors.New("database connection lost"))
+
+	details, err := suite.store.GetPermissionDetails(context.Background(), []string{"orders:read"})
+
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get permission details")
+	suite.Nil(details)
+}
+
 // TestIsResourceServerDeclarative tests that database store always returns false
 func (suite *ResourceStoreTestSuite) TestIsResourceServerDeclarative() {
 	testCases := []struct {
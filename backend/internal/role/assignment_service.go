@@ -43,6 +43,7 @@ type RoleAssignmentServiceInterface interface {
 		includeDisplay bool, assigneeType string) (*AssignmentList, *serviceerror.ServiceError)
 	AddAssignments(ctx context.Context, id string, assignments []RoleAssignment) *serviceerror.ServiceError
 	RemoveAssignments(ctx context.Context, id string, assignments []RoleAssignment) *serviceerror.ServiceError
+	RemoveEntityAssignments(ctx context.Context, entityID string) (int64, *serviceerror.ServiceError)
 }
 
 // roleAssignmentService is the default implementation of RoleAssignmentServiceInterface.
@@ -286,6 +287,36 @@ func (as *roleAssignmentService) RemoveAssignments(
 	return nil
 }
 
+// RemoveEntityAssignments removes an entity from all roles it is directly assigned to and returns the number of
+// assignments removed. In declarative mode the assignments are defined in the resource files, so there are no
+// stored assignments to remove.
+func (as *roleAssignmentService) RemoveEntityAssignments(
+	ctx context.Context, entityID string) (int64, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, assignmentLoggerComponentName))
+
+	if entityID == "" {
+		return 0, &ErrorInvalidAssignmentID
+	}
+	if isDeclarativeModeEnabled() {
+		return 0, nil
+	}
+
+	var removed int64
+	if err := as.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		var err error
+		removed, err = as.roleStore.DeleteAssignmentsByAssignee(txCtx, assigneeTypeEntity, entityID)
+		return err
+	}); err != nil {
+		logger.Error("Failed to remove the role assignments of the entity",
+			log.MaskedString("entityID", entityID), log.Error(err))
+		return 0, &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Removed the role assignments of the entity", log.MaskedString("entityID", entityID),
+		log.Int("count", int(removed)))
+	return removed, nil
+}
+
 // prepareAssignments validates and normalizes assignments before a mutation.
 // Unlike the previous role service implementation, this allows modifying assignments for
 // both mutable and declarative (file-backed) roles.
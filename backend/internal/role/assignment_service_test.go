@@ -29,6 +29,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
@@ -626,3 +627,60 @@ func (suite *RoleAssignmentServiceTestSuite) TestRemoveAssignments_Success() {
 
 	suite.Nil(err)
 }
+
+// RemoveEntityAssignments Tests
+
+func (suite *RoleAssignmentServiceTestSuite) initRuntime(roleStore string) {
+	testConfig := &config.Config{
+		Role: config.RoleConfig{
+			Store: roleStore,
+		},
+	}
+	config.ResetServerRuntime()
+	if err := config.InitializeServerRuntime("/tmp/test", testConfig); err != nil {
+		suite.Fail("Failed to initialize runtime", err)
+	}
+	suite.T().Cleanup(config.ResetServerRuntime)
+}
+
+func (suite *RoleAssignmentServiceTestSuite) TestRemoveEntityAssignments_Success() {
+	suite.initRuntime("mutable")
+	suite.mockStore.On("DeleteAssignmentsByAssignee", mock.Anything,
+		assigneeTypeEntity, testUserID1).Return(int64(2), nil)
+
+	removed, err := suite.service.RemoveEntityAssignments(context.Background(), testUserID1)
+
+	suite.Nil(err)
+	suite.Equal(int64(2), removed)
+}
+
+func (suite *RoleAssignmentServiceTestSuite) TestRemoveEntityAssignments_MissingEntityID() {
+	removed, err := suite.service.RemoveEntityAssignments(context.Background(), "")
+
+	suite.NotNil(err)
+	suite.Equal(ErrorInvalidAssignmentID.Code, err.Code)
+	suite.Zero(removed)
+}
+
+func (suite *RoleAssignmentServiceTestSuite) TestRemoveEntityAssignments_DeclarativeMode() {
+	suite.initRuntime("declarative")
+
+	removed, err := suite.service.RemoveEntityAssignments(context.Background(), testUserID1)
+
+	suite.Nil(err)
+	suite.Zero(removed)
+	suite.mockStore.AssertNotCalled(suite.T(), "DeleteAssignmentsByAssignee",
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RoleAssignmentServiceTestSuite) TestRemoveEntityAssignments_StoreError() {
+	suite.initRuntime("mutable")
+	suite.mockStore.On("DeleteAssignmentsByAssignee", mock.Anything,
+		assigneeTypeEntity, testUserID1).Return(int64(0), errors.New("store error"))
+
+	removed, err := suite.service.RemoveEntityAssignments(context.Background(), testUserID1)
+
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+	suite.Zero(removed)
+}
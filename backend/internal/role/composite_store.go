@@ -309,6 +309,12 @@ func (c *compositeRoleStore) RemoveAssignments(ctx context.Context, id string, a
 	return c.dbStore.RemoveAssignments(ctx, id, assignments)
 }
 
+// DeleteAssignmentsByAssignee deletes all role assignments of an assignee from the database store only.
+func (c *compositeRoleStore) DeleteAssignmentsByAssignee(
+	ctx context.Context, assigneeType AssigneeType, assigneeID string) (int64, error) {
+	return c.dbStore.DeleteAssignmentsByAssignee(ctx, assigneeType, assigneeID)
+}
+
 // CheckRoleNameExists checks if a role with the given name exists in either store.
 func (c *compositeRoleStore) CheckRoleNameExists(ctx context.Context, ouID, name string) (bool, error) {
 	return declarativeresource.CompositeBooleanCheckHelper(
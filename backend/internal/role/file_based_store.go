@@ -266,6 +266,12 @@ func (f *fileBasedStore) RemoveAssignments(ctx context.Context, id string, assig
 	return errors.New("RemoveAssignments is not supported in file-based store")
 }
 
+// DeleteAssignmentsByAssignee is not supported in file-based store.
+func (f *fileBasedStore) DeleteAssignmentsByAssignee(
+	ctx context.Context, assigneeType AssigneeType, assigneeID string) (int64, error) {
+	return 0, errors.New("DeleteAssignmentsByAssignee is not supported in file-based store")
+}
+
 // CheckRoleNameExists checks if a role with the given name exists in the file-based store.
 func (f *fileBasedStore) CheckRoleNameExists(ctx context.Context, ouID, name string) (bool, error) {
 	list, err := f.GenericFileBasedStore.List()
@@ -247,6 +247,10 @@ func (suite *RoleFileBasedStoreTestSuite) TestImmutability() {
 		{ID: "user1", Type: assigneeTypeEntity},
 	})
 	suite.Error(err)
+
+	// Test DeleteAssignmentsByAssignee returns error
+	_, err = suite.store.DeleteAssignmentsByAssignee(context.Background(), assigneeTypeEntity, "user1")
+	suite.Error(err)
 }
 
 func (suite *RoleFileBasedStoreTestSuite) TestIsRoleDeclarative() {
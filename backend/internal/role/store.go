@@ -50,6 +50,7 @@ type roleStoreInterface interface {
 	DeleteAssignmentsByRoleID(ctx context.Context, id string) error
 	AddAssignments(ctx context.Context, id string, assignments []RoleAssignment) error
 	RemoveAssignments(ctx context.Context, id string, assignments []RoleAssignment) error
+	DeleteAssignmentsByAssignee(ctx context.Context, assigneeType AssigneeType, assigneeID string) (int64, error)
 	CheckRoleNameExists(ctx context.Context, ouID, name string) (bool, error)
 	CheckRoleNameExistsExcludingID(ctx context.Context, ouID, name, excludeRoleID string) (bool, error)
 	GetAuthorizedPermissions(
@@ -382,6 +383,23 @@ func (s *roleStore) RemoveAssignments(ctx context.Context, id string, assignment
 	return nil
 }
 
+// DeleteAssignmentsByAssignee deletes all role assignments of an assignee and returns the number of
+// assignments deleted.
+func (s *roleStore) DeleteAssignmentsByAssignee(
+	ctx context.Context, assigneeType AssigneeType, assigneeID string) (int64, error) {
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := dbClient.ExecuteContext(
+		ctx, queryDeleteRoleAssignmentsByAssignee, assigneeType, assigneeID, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete assignments of assignee: %w", err)
+	}
+	return deleted, nil
+}
+
 // getRolePermissions retrieves all permissions for a role.
 func (s *roleStore) getRolePermissions(
 	ctx context.Context, dbClient provider.DBClientInterface, id string) ([]ResourcePermissions, error) {
@@ -1143,6 +1143,68 @@ func (suite *RoleStoreTestSuite) TestRemoveAssignments() {
 	}
 }
 
+func (suite *RoleStoreTestSuite) TestDeleteAssignmentsByAssignee() {
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedCount int64
+		shouldErr     bool
+		errorMessage  string
+	}{
+		{
+			name: "Success",
+			setupMocks: func() {
+				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteRoleAssignmentsByAssignee,
+					assigneeTypeEntity, "user1", testDeploymentID).Return(int64(3), nil)
+			},
+			expectedCount: 3,
+		},
+		{
+			name: "ExecError",
+			setupMocks: func() {
+				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteRoleAssignmentsByAssignee,
+					assigneeTypeEntity, "user1", testDeploymentID).Return(int64(0), errors.New("delete failed"))
+			},
+			shouldErr:    true,
+			errorMessage: "failed to delete assignments of assignee",
+		},
+		{
+			name: "DBClientError",
+			setupMocks: func() {
+				suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("db client error"))
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+			suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+			suite.store = &roleStore{
+				dbProvider:   suite.mockDBProvider,
+				deploymentID: testDeploymentID,
+			}
+
+			tc.setupMocks()
+
+			count, err := suite.store.DeleteAssignmentsByAssignee(context.Background(), assigneeTypeEntity, "user1")
+
+			if tc.shouldErr {
+				suite.Error(err)
+				if tc.errorMessage != "" {
+					suite.Contains(err.Error(), tc.errorMessage)
+				}
+			} else {
+				suite.NoError(err)
+				suite.Equal(tc.expectedCount, count)
+			}
+		})
+	}
+}
+
 func (suite *RoleStoreTestSuite) TestCheckRoleNameExists() {
 	testCases := []struct {
 		name          string
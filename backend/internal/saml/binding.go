@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxMessageSize is the maximum size of a decoded SAML message.
+const maxMessageSize = 256 * 1024
+
+// postFormTemplate renders the page that delivers a message to a service provider with the HTTP-POST binding.
+var postFormTemplate = template.Must(template.New("saml-post").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Redirecting</title></head>
+<body onload="document.forms[0].submit()">
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="{{.Param}}" value="{{.Message}}">
+{{if .RelayState}}<input type="hidden" name="RelayState" value="{{.RelayState}}">{{end}}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+</body>
+</html>
+`))
+
+// postForm holds the values rendered into the HTTP-POST binding page.
+type postForm struct {
+	Action     string
+	Param      string
+	Message    string
+	RelayState string
+}
+
+// decodeRedirectMessage decodes a message received with the HTTP-Redirect binding, which is deflated and base64
+// encoded.
+func decodeRedirectMessage(encoded string) ([]byte, error) {
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the message: %w", err)
+	}
+
+	reader := flate.NewReader(bytes.NewReader(compressed))
+	defer func() {
+		_ = reader.Close()
+	}()
+	message, err := io.ReadAll(io.LimitReader(reader, maxMessageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to inflate the message: %w", err)
+	}
+	if len(message) > maxMessageSize {
+		return nil, errors.New("message exceeds the maximum size")
+	}
+	return message, nil
+}
+
+// decodePostMessage decodes a message received with the HTTP-POST binding, which is base64 encoded.
+func decodePostMessage(encoded string) ([]byte, error) {
+	message, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the message: %w", err)
+	}
+	if len(message) > maxMessageSize {
+		return nil, errors.New("message exceeds the maximum size")
+	}
+	return message, nil
+}
+
+// verifyRedirectSignature verifies the signature of a message received with the HTTP-Redirect binding. The
+// signature covers the message, relay state, and signature algorithm parameters exactly as they are encoded in
+// the query string.
+func verifyRedirectSignature(rawQuery, messageParam, certificatePEM string) error {
+	values := make(map[string]string)
+	for _, pair := range strings.Split(rawQuery, "&") {
+		key, value, _ := strings.Cut(pair, "=")
+		if _, exists := values[key]; exists {
+			return fmt.Errorf("query parameter %q must not be repeated", key)
+		}
+		values[key] = value
+	}
+
+	encodedSignature, ok := values[paramSignature]
+	if !ok {
+		return errors.New("the message is not signed")
+	}
+	sigAlg, err := queryUnescape(values[paramSigAlg])
+	if err != nil {
+		return err
+	}
+	if sigAlg != algRSASHA256 && sigAlg != algECDSASHA256 {
+		return fmt.Errorf("unsupported signature algorithm %q", sigAlg)
+	}
+
+	signedOctets := messageParam + "=" + values[messageParam]
+	if relayState, ok := values[paramRelayState]; ok {
+		signedOctets += "&" + paramRelayState + "=" + relayState
+	}
+	signedOctets += "&" + paramSigAlg + "=" + values[paramSigAlg]
+
+	decodedSignature, err := queryUnescape(encodedSignature)
+	if err != nil {
+		return err
+	}
+	signature, err := base64.StdEncoding.DecodeString(decodedSignature)
+	if err != nil {
+		return fmt.Errorf("failed to decode the signature: %w", err)
+	}
+
+	cert, err := parseCertificate(certificatePEM)
+	if err != nil {
+		return err
+	}
+	return verifySHA256(cert, []byte(signedOctets), signature)
+}
+
+// queryUnescape decodes a query string value.
+func queryUnescape(value string) (string, error) {
+	decoded, err := url.QueryUnescape(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode query parameter: %w", err)
+	}
+	return decoded, nil
+}
+
+// parseCertificate parses a PEM encoded certificate.
+func parseCertificate(certificatePEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil {
+		return nil, errors.New("failed to decode the certificate PEM")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// writePostForm writes the page that delivers a message to the given URL with the HTTP-POST binding.
+func writePostForm(w http.ResponseWriter, action, param string, message []byte, relayState string) error {
+	var buf bytes.Buffer
+	if err := postFormTemplate.Execute(&buf, postForm{
+		Action:     action,
+		Param:      param,
+		Message:    base64.StdEncoding.EncodeToString(message),
+		RelayState: relayState,
+	}); err != nil {
+		return fmt.Errorf("failed to render the POST binding page: %w", err)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache, no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BindingTestSuite struct {
+	suite.Suite
+	key            *rsa.PrivateKey
+	certificatePEM string
+}
+
+func TestBindingTestSuite(t *testing.T) {
+	suite.Run(t, new(BindingTestSuite))
+}
+
+func (s *BindingTestSuite) SetupSuite() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	s.key = key
+	cert := newTestCertificate(s.T(), key)
+	s.certificatePEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+func (s *BindingTestSuite) TestDecodeRedirectMessage() {
+	message := []byte(`<samlp:AuthnRequest ID="_1"/>`)
+
+	decoded, err := decodeRedirectMessage(deflateAndEncode(s.T(), message))
+
+	s.NoError(err)
+	s.Equal(message, decoded)
+}
+
+func (s *BindingTestSuite) TestDecodeRedirectMessage_Invalid() {
+	_, err := decodeRedirectMessage("not base64!")
+	s.Error(err)
+
+	_, err = decodeRedirectMessage(base64.StdEncoding.EncodeToString([]byte("not deflated")))
+	s.Error(err)
+}
+
+func (s *BindingTestSuite) TestDecodeRedirectMessage_TooLarge() {
+	_, err := decodeRedirectMessage(deflateAndEncode(s.T(), bytes.Repeat([]byte("a"), maxMessageSize+1)))
+
+	s.ErrorContains(err, "maximum size")
+}
+
+func (s *BindingTestSuite) TestDecodePostMessage() {
+	decoded, err := decodePostMessage(base64.StdEncoding.EncodeToString([]byte("<x/>")))
+	s.NoError(err)
+	s.Equal([]byte("<x/>"), decoded)
+
+	_, err = decodePostMessage("%%%")
+	s.Error(err)
+}
+
+func (s *BindingTestSuite) TestVerifyRedirectSignature() {
+	rawQuery := s.signedQuery("SAMLRequest=abc%2B%3D&RelayState=state%201&SigAlg=" + url.QueryEscape(algRSASHA256))
+
+	s.NoError(verifyRedirectSignature(rawQuery, paramSAMLRequest, s.certificatePEM))
+}
+
+func (s *BindingTestSuite) TestVerifyRedirectSignature_Tampered() {
+	rawQuery := s.signedQuery("SAMLRequest=abc&RelayState=state&SigAlg=" + url.QueryEscape(algRSASHA256))
+	rawQuery = strings.Replace(rawQuery, "RelayState=state", "RelayState=other", 1)
+
+	s.Error(verifyRedirectSignature(rawQuery, paramSAMLRequest, s.certificatePEM))
+}
+
+func (s *BindingTestSuite) TestVerifyRedirectSignature_Errors() {
+	testCases := []struct {
+		name     string
+		rawQuery string
+		expected string
+	}{
+		{"Unsigned", "SAMLRequest=abc", "not signed"},
+		{"UnsupportedAlgorithm", "SAMLRequest=abc&SigAlg=" +
+			url.QueryEscape("http://www.w3.org/2000/09/xmldsig#rsa-sha1") + "&Signature=abc",
+			"unsupported signature algorithm"},
+		{"RepeatedParameter", "SAMLRequest=abc&SAMLRequest=def&Signature=abc", "must not be repeated"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := verifyRedirectSignature(tc.rawQuery, paramSAMLRequest, s.certificatePEM)
+			s.ErrorContains(err, tc.expected)
+		})
+	}
+}
+
+func (s *BindingTestSuite) TestWritePostForm() {
+	rr := httptest.NewRecorder()
+
+	err := writePostForm(rr, "https://sp.example.com/acs?a=1", paramSAMLResponse, []byte("<x/>"), `"relay"`)
+
+	s.NoError(err)
+	s.Equal("text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+	s.Equal("no-cache, no-store", rr.Header().Get("Cache-Control"))
+	body := rr.Body.String()
+	s.Contains(body, `action="https://sp.example.com/acs?a=1"`)
+	s.Contains(body, `name="SAMLResponse" value="`+base64.StdEncoding.EncodeToString([]byte("<x/>"))+`"`)
+	s.Contains(body, `name="RelayState" value="&#34;relay&#34;"`)
+}
+
+func (s *BindingTestSuite) TestWritePostForm_WithoutRelayState() {
+	rr := httptest.NewRecorder()
+
+	s.NoError(writePostForm(rr, "https://sp.example.com/acs", paramSAMLResponse, []byte("<x/>"), ""))
+	s.NotContains(rr.Body.String(), "RelayState")
+}
+
+// signedQuery appends the signature of the query string as the HTTP-Redirect binding does.
+func (s *BindingTestSuite) signedQuery(query string) string {
+	hashed := sha256.Sum256([]byte(query))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hashed[:])
+	s.Require().NoError(err)
+	return query + "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+}
+
+// deflateAndEncode encodes a message as the HTTP-Redirect binding does.
+func deflateAndEncode(t *testing.T, message []byte) string {
+	var buf bytes.Buffer
+	writer, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		t.Fatalf("failed to create deflate writer: %v", err)
+	}
+	_, _ = writer.Write(message)
+	_ = writer.Close()
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import "time"
+
+// Endpoint paths of the SAML identity provider.
+const (
+	metadataPath    = "/saml2/metadata"
+	ssoPath         = "/saml2/sso"
+	ssoResponsePath = "/saml2/sso/response"
+	sloPath         = "/saml2/slo"
+)
+
+// authIDPrefix prefixes the auth IDs of SAML authentication requests so that the auth callback of the login
+// page is routed to the SAML identity provider.
+const authIDPrefix = "saml-"
+
+// messageContextValidity is the duration for which a pending authentication request or an issued response is
+// kept in the store.
+const messageContextValidity = 10 * time.Minute
+
+// defaultAssertionValidity is the validity of an assertion when the configuration does not set one.
+const defaultAssertionValidity = 5 * time.Minute
+
+// HTTP parameters of the SAML bindings.
+const (
+	paramSAMLRequest  = "SAMLRequest"
+	paramSAMLResponse = "SAMLResponse"
+	paramRelayState   = "RelayState"
+	paramSigAlg       = "SigAlg"
+	paramSignature    = "Signature"
+	paramResponseID   = "id"
+)
+
+// XML namespaces and their prefixes.
+const (
+	nsProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	nsAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	nsMetadata  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	nsDSig      = "http://www.w3.org/2000/09/xmldsig#"
+
+	prefixProtocol  = "samlp"
+	prefixAssertion = "saml"
+	prefixMetadata  = "md"
+	prefixDSig      = "ds"
+)
+
+// samlVersion is the SAML protocol version supported by the identity provider.
+const samlVersion = "2.0"
+
+// Protocol bindings.
+const (
+	bindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	bindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+)
+
+// Status codes of SAML responses.
+const (
+	statusSuccess             = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	statusRequester           = "urn:oasis:names:tc:SAML:2.0:status:Requester"
+	statusResponder           = "urn:oasis:names:tc:SAML:2.0:status:Responder"
+	statusNoPassive           = "urn:oasis:names:tc:SAML:2.0:status:NoPassive"
+	statusAuthnFailed         = "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed"
+	statusUnsupportedBinding  = "urn:oasis:names:tc:SAML:2.0:status:UnsupportedBinding"
+	statusInvalidNameIDPolicy = "urn:oasis:names:tc:SAML:2.0:status:InvalidNameIDPolicy"
+)
+
+// Name ID formats, subject confirmation methods, and other assertion constants.
+const (
+	nameIDFormatUnspecified  = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	nameIDFormatEmail        = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	nameIDFormatPersistent   = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	subjectConfirmBearer     = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	authnContextUnspecified  = "urn:oasis:names:tc:SAML:2.0:ac:classes:unspecified"
+	attributeNameFormatBasic = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+)
+
+// XML signature algorithms.
+const (
+	algExcC14N            = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	algEnvelopedSignature = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	algSHA256             = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algRSASHA256          = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algECDSASHA256        = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+)
+
+// samlTimestampLayout is the layout of the UTC timestamps in SAML messages.
+const samlTimestampLayout = "2006-01-02T15:04:05Z"
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// samlHandler handles the HTTP requests of the SAML identity provider.
+type samlHandler struct {
+	samlService SAMLServiceInterface
+	logger      *log.Logger
+}
+
+// newSAMLHandler creates a new instance of samlHandler.
+func newSAMLHandler(samlService SAMLServiceInterface) *samlHandler {
+	return &samlHandler{
+		samlService: samlService,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLHandler")),
+	}
+}
+
+// HandleMetadataRequest handles the request for the metadata of the identity provider.
+func (h *samlHandler) HandleMetadataRequest(w http.ResponseWriter, r *http.Request) {
+	metadata, err := h.samlService.GetMetadata(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to build the SAML metadata", log.Error(err))
+		http.Error(w, "Failed to build the SAML metadata", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(metadata)
+}
+
+// HandleSSORequest handles an authentication request received with the HTTP-Redirect or HTTP-POST binding.
+// The user agent is redirected to the login page, or the response is delivered to the service provider when
+// the request is answered right away.
+func (h *samlHandler) HandleSSORequest(w http.ResponseWriter, r *http.Request) {
+	msg, err := getInboundMessage(r)
+	if err != nil {
+		h.logger.Debug("Invalid SAML authentication request", log.Error(err))
+		h.redirectToErrorPage(w, r, newRequestError("Invalid SAML authentication request"))
+		return
+	}
+
+	result, samlErr := h.samlService.HandleSSORequest(r.Context(), msg)
+	if samlErr != nil {
+		h.redirectToErrorPage(w, r, samlErr)
+		return
+	}
+	if result.Response != nil {
+		h.writeOutboundMessage(w, r, result.Response)
+		return
+	}
+
+	loginPageURL := config.GetGateClientURL(r.Context(), config.GetServerRuntime().Config.GateClient.LoginPath)
+	redirectURI, err := oauth2utils.GetURIWithQueryParams(loginPageURL, result.LoginPageQueryParams)
+	if err != nil {
+		h.logger.Error("Failed to construct login page URL", log.Error(err))
+		h.redirectToErrorPage(w, r, newServerError())
+		return
+	}
+	http.Redirect(w, r, redirectURI, http.StatusFound)
+}
+
+// HandleSSOResponseRequest delivers the response of a completed authentication request to the service
+// provider with the HTTP-POST binding.
+func (h *samlHandler) HandleSSOResponseRequest(w http.ResponseWriter, r *http.Request) {
+	response, samlErr := h.samlService.GetResponse(r.Context(), r.URL.Query().Get(paramResponseID))
+	if samlErr != nil {
+		h.redirectToErrorPage(w, r, samlErr)
+		return
+	}
+	h.writeOutboundMessage(w, r, response)
+}
+
+// HandleSLORequest handles a logout request received with the HTTP-Redirect or HTTP-POST binding, and delivers
+// the logout response to the service provider with the HTTP-POST binding.
+func (h *samlHandler) HandleSLORequest(w http.ResponseWriter, r *http.Request) {
+	msg, err := getInboundMessage(r)
+	if err != nil {
+		h.logger.Debug("Invalid SAML logout request", log.Error(err))
+		h.redirectToErrorPage(w, r, newRequestError("Invalid SAML logout request"))
+		return
+	}
+
+	response, samlErr := h.samlService.HandleLogoutRequest(r.Context(), msg)
+	if samlErr != nil {
+		h.redirectToErrorPage(w, r, samlErr)
+		return
+	}
+	h.writeOutboundMessage(w, r, response)
+}
+
+// writeOutboundMessage writes the page that posts a message to the service provider.
+func (h *samlHandler) writeOutboundMessage(w http.ResponseWriter, r *http.Request, msg *OutboundMessage) {
+	w.Header().Set(constants.XFrameOptionsHeaderName, constants.XFrameOptionsDeny)
+	w.Header().Set(constants.ContentSecurityPolicyHeaderName, constants.ContentSecurityPolicyFrameAncestorsNone)
+	if err := writePostForm(w, msg.URL, msg.Param, msg.Message, msg.RelayState); err != nil {
+		h.logger.Error("Failed to write the SAML POST binding page", log.Error(err))
+		h.redirectToErrorPage(w, r, newServerError())
+	}
+}
+
+// redirectToErrorPage redirects the user agent to the error page with the details of the error.
+func (h *samlHandler) redirectToErrorPage(w http.ResponseWriter, r *http.Request, samlErr *SAMLError) {
+	redirectURL, err := getErrorPageRedirectURL(r.Context(), samlErr)
+	if err != nil {
+		h.logger.Error("Failed to construct error page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
+
+// getErrorPageRedirectURL constructs the error page URL for the given error.
+func getErrorPageRedirectURL(ctx context.Context, samlErr *SAMLError) (string, error) {
+	errorPageURL := config.GetGateClientURL(ctx, config.GetServerRuntime().Config.GateClient.ErrorPath)
+
+	return oauth2utils.GetURIWithQueryParams(errorPageURL, map[string]string{
+		"errorCode":    samlErr.Code,
+		"errorMessage": samlErr.Message,
+	})
+}
+
+// getInboundMessage extracts the SAML request from a request received with the HTTP-Redirect binding (GET) or
+// the HTTP-POST binding (POST).
+func getInboundMessage(r *http.Request) (*InboundMessage, error) {
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		encoded := query.Get(paramSAMLRequest)
+		if encoded == "" {
+			return nil, errors.New("SAMLRequest is missing")
+		}
+		message, err := decodeRedirectMessage(encoded)
+		if err != nil {
+			return nil, err
+		}
+		return &InboundMessage{
+			Message:    message,
+			RelayState: query.Get(paramRelayState),
+			RawQuery:   r.URL.RawQuery,
+		}, nil
+	case http.MethodPost:
+		if err := r.ParseForm(); err != nil {
+			return nil, err
+		}
+		encoded := r.PostForm.Get(paramSAMLRequest)
+		if encoded == "" {
+			return nil, errors.New("SAMLRequest is missing")
+		}
+		message, err := decodePostMessage(encoded)
+		if err != nil {
+			return nil, err
+		}
+		return &InboundMessage{
+			Message:    message,
+			RelayState: r.PostForm.Get(paramRelayState),
+		}, nil
+	default:
+		return nil, errors.New("unsupported request method: " + r.Method)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *SAMLServiceInterfaceMock
+	handler     *samlHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		Server: config.ServerConfig{PublicURL: testPublicURL},
+		GateClient: config.GateClientConfig{
+			Scheme:    "https",
+			Hostname:  "localhost",
+			Port:      3000,
+			LoginPath: "/login",
+			ErrorPath: "/error",
+		},
+	}
+	_ = config.InitializeServerRuntime("", testConfig)
+
+	s.mockService = NewSAMLServiceInterfaceMock(s.T())
+	s.handler = newSAMLHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *HandlerTestSuite) TestHandleMetadataRequest() {
+	s.mockService.On("GetMetadata", mock.Anything).Return([]byte("<md:EntityDescriptor/>"), nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleMetadataRequest(rr, httptest.NewRequest(http.MethodGet, metadataPath, nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("application/samlmetadata+xml", rr.Header().Get("Content-Type"))
+	s.Equal("<md:EntityDescriptor/>", rr.Body.String())
+}
+
+func (s *HandlerTestSuite) TestHandleMetadataRequest_Error() {
+	s.mockService.On("GetMetadata", mock.Anything).Return(nil, errors.New("no certificate"))
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleMetadataRequest(rr, httptest.NewRequest(http.MethodGet, metadataPath, nil))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSSORequest_RedirectBinding() {
+	encoded := deflateAndEncode(s.T(), []byte("<samlp:AuthnRequest/>"))
+	query := url.Values{paramSAMLRequest: {encoded}, paramRelayState: {"state"}}.Encode()
+
+	s.mockService.On("HandleSSORequest", mock.Anything, mock.MatchedBy(func(msg *InboundMessage) bool {
+		return string(msg.Message) == "<samlp:AuthnRequest/>" && msg.RelayState == "state" &&
+			msg.RawQuery == query
+	})).Return(&SSOResult{
+		LoginPageQueryParams: map[string]string{"authId": "saml-key", "flowId": "flow-1"},
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSSORequest(rr, httptest.NewRequest(http.MethodGet, ssoPath+"?"+query, nil))
+
+	s.Equal(http.StatusFound, rr.Code)
+	location, err := url.Parse(rr.Header().Get("Location"))
+	s.Require().NoError(err)
+	s.Equal("/login", location.Path)
+	s.Equal("saml-key", location.Query().Get("authId"))
+	s.Equal("flow-1", location.Query().Get("flowId"))
+}
+
+func (s *HandlerTestSuite) TestHandleSSORequest_PostBinding() {
+	form := url.Values{
+		paramSAMLRequest: {base64.StdEncoding.EncodeToString([]byte("<samlp:AuthnRequest/>"))},
+	}
+	s.mockService.On("HandleSSORequest", mock.Anything, mock.MatchedBy(func(msg *InboundMessage) bool {
+		return string(msg.Message) == "<samlp:AuthnRequest/>" && msg.RawQuery == ""
+	})).Return(&SSOResult{
+		Response: &OutboundMessage{URL: testACSURL, Param: paramSAMLResponse, Message: []byte("<samlp:Response/>")},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, ssoPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	s.handler.HandleSSORequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("DENY", rr.Header().Get("X-Frame-Options"))
+	s.Contains(rr.Body.String(), testACSURL)
+	s.Contains(rr.Body.String(), base64.StdEncoding.EncodeToString([]byte("<samlp:Response/>")))
+}
+
+func (s *HandlerTestSuite) TestHandleSSORequest_MissingRequest() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleSSORequest(rr, httptest.NewRequest(http.MethodGet, ssoPath, nil))
+
+	s.Equal(http.StatusFound, rr.Code)
+	location, err := url.Parse(rr.Header().Get("Location"))
+	s.Require().NoError(err)
+	s.Equal("/error", location.Path)
+	s.Equal("invalid_request", location.Query().Get("errorCode"))
+	s.mockService.AssertNotCalled(s.T(), "HandleSSORequest", mock.Anything, mock.Anything)
+}
+
+func (s *HandlerTestSuite) TestHandleSSORequest_ServiceError() {
+	encoded := deflateAndEncode(s.T(), []byte("<samlp:AuthnRequest/>"))
+	s.mockService.On("HandleSSORequest", mock.Anything, mock.Anything).
+		Return(nil, newRequestError("Unknown service provider"))
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSSORequest(rr, httptest.NewRequest(http.MethodGet,
+		ssoPath+"?"+url.Values{paramSAMLRequest: {encoded}}.Encode(), nil))
+
+	s.Equal(http.StatusFound, rr.Code)
+	location, err := url.Parse(rr.Header().Get("Location"))
+	s.Require().NoError(err)
+	s.Equal("/error", location.Path)
+	s.Equal("Unknown service provider", location.Query().Get("errorMessage"))
+}
+
+func (s *HandlerTestSuite) TestHandleSSOResponseRequest() {
+	s.mockService.On("GetResponse", mock.Anything, "response-id").Return(&OutboundMessage{
+		URL: testACSURL, Param: paramSAMLResponse, Message: []byte("<samlp:Response/>"), RelayState: "state",
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSSOResponseRequest(rr, httptest.NewRequest(http.MethodGet,
+		ssoResponsePath+"?"+paramResponseID+"=response-id", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Contains(rr.Body.String(), testACSURL)
+	s.Contains(rr.Body.String(), `value="state"`)
+}
+
+func (s *HandlerTestSuite) TestHandleSSOResponseRequest_Error() {
+	s.mockService.On("GetResponse", mock.Anything, "").Return(nil, newRequestError("Invalid response id"))
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSSOResponseRequest(rr, httptest.NewRequest(http.MethodGet, ssoResponsePath, nil))
+
+	s.Equal(http.StatusFound, rr.Code)
+	s.Contains(rr.Header().Get("Location"), "/error")
+}
+
+func (s *HandlerTestSuite) TestHandleSLORequest() {
+	encoded := deflateAndEncode(s.T(), []byte("<samlp:LogoutRequest/>"))
+	s.mockService.On("HandleLogoutRequest", mock.Anything, mock.MatchedBy(func(msg *InboundMessage) bool {
+		return string(msg.Message) == "<samlp:LogoutRequest/>"
+	})).Return(&OutboundMessage{
+		URL: testSLOURL, Param: paramSAMLResponse, Message: []byte("<samlp:LogoutResponse/>"),
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSLORequest(rr, httptest.NewRequest(http.MethodGet,
+		sloPath+"?"+url.Values{paramSAMLRequest: {encoded}}.Encode(), nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Contains(rr.Body.String(), testSLOURL)
+}
+
+func (s *HandlerTestSuite) TestHandleSLORequest_UnsupportedMethod() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleSLORequest(rr, httptest.NewRequest(http.MethodPut, sloPath, nil))
+
+	s.Equal(http.StatusFound, rr.Code)
+	s.Contains(rr.Header().Get("Location"), "/error")
+	s.mockService.AssertNotCalled(s.T(), "HandleLogoutRequest", mock.Anything, mock.Anything)
+}
+
+func (s *HandlerTestSuite) TestGetErrorPageRedirectURL() {
+	redirectURL, err := getErrorPageRedirectURL(context.Background(), newServerError())
+	s.Require().NoError(err)
+
+	location, err := url.Parse(redirectURL)
+	s.Require().NoError(err)
+	s.Equal("https", location.Scheme)
+	s.Equal("localhost:3000", location.Host)
+	s.Equal("server_error", location.Query().Get("errorCode"))
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
+)
+
+// Initialize initializes the SAML identity provider when it is enabled, registers its routes, and routes the
+// auth callbacks of SAML authentication requests to it.
+func Initialize(
+	mux *http.ServeMux,
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	attrCacheService attributecache.AttributeCacheServiceInterface,
+	pkiService pkiservice.PKIServiceInterface,
+) {
+	if !config.GetServerRuntime().Config.SAML.Enabled {
+		return
+	}
+
+	samlService := newSAMLService(flowExecService, jwtService, attrCacheService, pkiService,
+		initializeMessageContextStore())
+	authz.RegisterAuthCallbackHandler(authIDPrefix, samlService.HandleAuthCallback)
+	registerRoutes(mux, newSAMLHandler(samlService))
+}
+
+// initializeMessageContextStore selects the message context store implementation based on the configured
+// runtime DB type.
+func initializeMessageContextStore() messageContextStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return newRedisMessageContextStore(provider.GetRedisProvider(), deploymentID)
+	}
+	return newMessageContextStore(deploymentID)
+}
+
+// registerRoutes registers the routes of the SAML identity provider. The endpoints are navigated to by the user
+// agent, so CORS is not enabled on them.
+func registerRoutes(mux *http.ServeMux, handler *samlHandler) {
+	mux.HandleFunc("GET "+metadataPath, handler.HandleMetadataRequest)
+	mux.HandleFunc("GET "+ssoPath, handler.HandleSSORequest)
+	mux.HandleFunc("POST "+ssoPath, handler.HandleSSORequest)
+	mux.HandleFunc("GET "+ssoResponsePath, handler.HandleSSOResponseRequest)
+	mux.HandleFunc("GET "+sloPath, handler.HandleSLORequest)
+	mux.HandleFunc("POST "+sloPath, handler.HandleSLORequest)
+}
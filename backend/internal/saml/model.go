@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import "encoding/xml"
+
+// authnRequest is an authentication request sent by a service provider.
+type authnRequest struct {
+	XMLName                     xml.Name      `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+	ID                          string        `xml:"ID,attr"`
+	Version                     string        `xml:"Version,attr"`
+	Destination                 string        `xml:"Destination,attr"`
+	AssertionConsumerServiceURL string        `xml:"AssertionConsumerServiceURL,attr"`
+	ProtocolBinding             string        `xml:"ProtocolBinding,attr"`
+	IsPassive                   bool          `xml:"IsPassive,attr"`
+	Issuer                      string        `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	NameIDPolicy                *nameIDPolicy `xml:"urn:oasis:names:tc:SAML:2.0:protocol NameIDPolicy"`
+}
+
+// nameIDPolicy constrains the name identifier requested by a service provider.
+type nameIDPolicy struct {
+	Format string `xml:"Format,attr"`
+}
+
+// logoutRequest is a logout request sent by a service provider.
+type logoutRequest struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol LogoutRequest"`
+	ID      string   `xml:"ID,attr"`
+	Version string   `xml:"Version,attr"`
+	Issuer  string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	NameID  string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion NameID"`
+}
+
+// InboundMessage is a SAML request received with the HTTP-Redirect or HTTP-POST binding.
+type InboundMessage struct {
+	// Message is the decoded XML message.
+	Message []byte
+	// RelayState is the opaque state the service provider expects back with the response.
+	RelayState string
+	// RawQuery is the query string of a message received with the HTTP-Redirect binding, which carries the
+	// message signature. It is empty for the HTTP-POST binding.
+	RawQuery string
+}
+
+// messageContext holds the state kept between the legs of a SAML exchange. It holds the authentication
+// request while the user signs in, and then the response until it is delivered to the service provider.
+type messageContext struct {
+	RequestID       string `json:"requestId,omitempty"`
+	ServiceProvider string `json:"serviceProvider"`
+	ACSURL          string `json:"acsUrl"`
+	RelayState      string `json:"relayState,omitempty"`
+	NameIDFormat    string `json:"nameIdFormat,omitempty"`
+	Response        []byte `json:"response,omitempty"`
+}
+
+// SSOResult is the outcome of an authentication request. Either the user is redirected to the login page, or
+// a response is delivered to the service provider right away.
+type SSOResult struct {
+	LoginPageQueryParams map[string]string
+	Response             *OutboundMessage
+}
+
+// OutboundMessage is a message delivered to a service provider with the HTTP-POST binding.
+type OutboundMessage struct {
+	URL        string
+	Param      string
+	Message    []byte
+	RelayState string
+}
+
+// SAMLError is an error of a SAML request that cannot be answered with a response to the service provider,
+// such as a request from an unknown service provider. The user agent is sent to the error page instead.
+type SAMLError struct {
+	Code    string
+	Message string
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// redisClient abstracts the Redis commands used by the message context store.
+type redisClient interface {
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	GetDel(ctx context.Context, key string) *redis.StringCmd
+}
+
+// redisMessageContextStore is the Redis-backed implementation of messageContextStoreInterface.
+type redisMessageContextStore struct {
+	client       redisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisMessageContextStore creates a new Redis-backed message context store.
+func newRedisMessageContextStore(
+	p provider.RedisProviderInterface, deploymentID string,
+) messageContextStoreInterface {
+	return &redisMessageContextStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// contextKey builds the Redis key for a message context key.
+func (s *redisMessageContextStore) contextKey(key string) string {
+	return fmt.Sprintf("%s:runtime:%s:saml:%s", s.keyPrefix, s.deploymentID, key)
+}
+
+// Store persists a message context in Redis with a TTL.
+func (s *redisMessageContextStore) Store(
+	ctx context.Context, msgCtx messageContext, validity time.Duration,
+) (string, error) {
+	key, err := generateContextKey()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(msgCtx)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal SAML message context: %w", err)
+	}
+
+	if err := s.client.Set(ctx, s.contextKey(key), data, validity).Err(); err != nil {
+		return "", fmt.Errorf("failed to store SAML message context in Redis: %w", err)
+	}
+
+	return key, nil
+}
+
+// Consume atomically retrieves and deletes a message context via Redis GETDEL.
+func (s *redisMessageContextStore) Consume(ctx context.Context, key string) (messageContext, bool, error) {
+	data, err := s.client.GetDel(ctx, s.contextKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return messageContext{}, false, nil
+		}
+		return messageContext{}, false, fmt.Errorf("failed to get SAML message context from Redis: %w", err)
+	}
+
+	var msgCtx messageContext
+	if err := json.Unmarshal(data, &msgCtx); err != nil {
+		return messageContext{}, false, fmt.Errorf("failed to unmarshal SAML message context: %w", err)
+	}
+	return msgCtx, true, nil
+}
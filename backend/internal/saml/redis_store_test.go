@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *redisClientMock
+	store      *redisMessageContextStore
+	ctx        context.Context
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newRedisClientMock(s.T())
+	s.store = &redisMessageContextStore{
+		client:       s.mockClient,
+		keyPrefix:    "thunderid",
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+func (s *RedisStoreTestSuite) TestStore_Success() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetVal("OK")
+	s.mockClient.On("Set", s.ctx,
+		mock.MatchedBy(func(key string) bool {
+			return len(key) > len("thunderid:runtime:test-deployment-id:saml:")
+		}),
+		mock.Anything, time.Minute).Return(cmd)
+
+	key, err := s.store.Store(s.ctx, messageContext{ServiceProvider: "sp"}, time.Minute)
+
+	s.NoError(err)
+	s.NotEmpty(key)
+}
+
+func (s *RedisStoreTestSuite) TestStore_Error() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(errors.New("redis down"))
+	s.mockClient.On("Set", s.ctx, mock.Anything, mock.Anything, time.Minute).Return(cmd)
+
+	_, err := s.store.Store(s.ctx, messageContext{ServiceProvider: "sp"}, time.Minute)
+
+	s.ErrorContains(err, "failed to store SAML message context in Redis")
+}
+
+func (s *RedisStoreTestSuite) TestConsume_Success() {
+	data, _ := json.Marshal(messageContext{ServiceProvider: "sp", ACSURL: "https://sp.example.com/acs"})
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetVal(string(data))
+	s.mockClient.On("GetDel", s.ctx, "thunderid:runtime:test-deployment-id:saml:key").Return(cmd)
+
+	result, found, err := s.store.Consume(s.ctx, "key")
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal("https://sp.example.com/acs", result.ACSURL)
+}
+
+func (s *RedisStoreTestSuite) TestConsume_NotFound() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetErr(redis.Nil)
+	s.mockClient.On("GetDel", s.ctx, "thunderid:runtime:test-deployment-id:saml:key").Return(cmd)
+
+	_, found, err := s.store.Consume(s.ctx, "key")
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestConsume_Error() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetErr(errors.New("redis down"))
+	s.mockClient.On("GetDel", s.ctx, "thunderid:runtime:test-deployment-id:saml:key").Return(cmd)
+
+	_, found, err := s.store.Consume(s.ctx, "key")
+
+	s.Error(err)
+	s.False(found)
+}
@@ -0,0 +1,469 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package saml implements a SAML 2.0 identity provider, which lets service providers authenticate users with
+// the Web Browser SSO profile and end their sessions with the Single Logout profile.
+package saml
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// SAMLServiceInterface defines the interface of the SAML identity provider.
+type SAMLServiceInterface interface {
+	GetMetadata(ctx context.Context) ([]byte, error)
+	HandleSSORequest(ctx context.Context, msg *InboundMessage) (*SSOResult, *SAMLError)
+	HandleAuthCallback(ctx context.Context, authID, assertion string) (string, error)
+	GetResponse(ctx context.Context, id string) (*OutboundMessage, *SAMLError)
+	HandleLogoutRequest(ctx context.Context, msg *InboundMessage) (*OutboundMessage, *SAMLError)
+}
+
+// samlService is the default implementation of SAMLServiceInterface.
+type samlService struct {
+	flowExecService  flowexec.FlowExecServiceInterface
+	jwtService       jwt.JWTServiceInterface
+	attrCacheService attributecache.AttributeCacheServiceInterface
+	store            messageContextStoreInterface
+	signer           *xmlSigner
+	logger           *log.Logger
+}
+
+// newSAMLService creates a new instance of samlService.
+func newSAMLService(
+	flowExecService flowexec.FlowExecServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	attrCacheService attributecache.AttributeCacheServiceInterface,
+	pkiService pkiservice.PKIServiceInterface,
+	store messageContextStoreInterface,
+) SAMLServiceInterface {
+	return &samlService{
+		flowExecService:  flowExecService,
+		jwtService:       jwtService,
+		attrCacheService: attrCacheService,
+		store:            store,
+		signer:           newXMLSigner(pkiService),
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SAMLService")),
+	}
+}
+
+// GetMetadata returns the metadata of the identity provider, which describes its endpoints and signing
+// certificate.
+func (s *samlService) GetMetadata(ctx context.Context) ([]byte, error) {
+	cert, err := s.signer.getCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := config.GetPublicURL(ctx)
+	descriptor := newElement("md:IDPSSODescriptor").
+		setAttr("WantAuthnRequestsSigned", "false").
+		setAttr("protocolSupportEnumeration", nsProtocol).
+		addChild(newElement("md:KeyDescriptor").setAttr("use", "signing").addChild(newKeyInfoElement(cert)))
+	for _, binding := range []string{bindingHTTPRedirect, bindingHTTPPost} {
+		descriptor.addChild(newElement("md:SingleLogoutService").
+			setAttr("Binding", binding).setAttr("Location", baseURL+sloPath))
+	}
+	for _, format := range []string{nameIDFormatUnspecified, nameIDFormatEmail, nameIDFormatPersistent} {
+		descriptor.addChild(newElement("md:NameIDFormat").setText(format))
+	}
+	for _, binding := range []string{bindingHTTPRedirect, bindingHTTPPost} {
+		descriptor.addChild(newElement("md:SingleSignOnService").
+			setAttr("Binding", binding).setAttr("Location", baseURL+ssoPath))
+	}
+
+	entityDescriptor := newElement("md:EntityDescriptor").
+		setAttr("entityID", getEntityID(ctx)).
+		addChild(descriptor)
+	return withXMLHeader(entityDescriptor.render()), nil
+}
+
+// HandleSSORequest processes an authentication request of a service provider. The request is validated and
+// the login flow of the application bound to the service provider is initiated. Requests that cannot be
+// satisfied are answered with an error response to the service provider.
+func (s *samlService) HandleSSORequest(ctx context.Context, msg *InboundMessage) (*SSOResult, *SAMLError) {
+	var request authnRequest
+	if err := xml.Unmarshal(msg.Message, &request); err != nil {
+		s.logger.Debug("Failed to parse the authentication request", log.Error(err))
+		return nil, newRequestError("Invalid SAML authentication request")
+	}
+	if request.ID == "" || request.Version != samlVersion {
+		return nil, newRequestError("Invalid SAML authentication request")
+	}
+
+	sp, samlErr := s.getVerifiedServiceProvider(request.Issuer, msg, paramSAMLRequest)
+	if samlErr != nil {
+		return nil, samlErr
+	}
+
+	acsURL := sp.ACSURLs[0]
+	if request.AssertionConsumerServiceURL != "" {
+		if !slices.Contains(sp.ACSURLs, request.AssertionConsumerServiceURL) {
+			s.logger.Debug("Unregistered assertion consumer service URL",
+				log.String("serviceProvider", sp.EntityID))
+			return nil, newRequestError("Unregistered assertion consumer service URL")
+		}
+		acsURL = request.AssertionConsumerServiceURL
+	}
+
+	msgCtx := messageContext{
+		RequestID:       request.ID,
+		ServiceProvider: sp.EntityID,
+		ACSURL:          acsURL,
+		RelayState:      msg.RelayState,
+		NameIDFormat:    getNameIDFormat(sp),
+	}
+
+	if request.ProtocolBinding != "" && request.ProtocolBinding != bindingHTTPPost {
+		return s.buildSSOErrorResult(ctx, msgCtx, statusRequester, statusUnsupportedBinding)
+	}
+	if request.NameIDPolicy != nil && request.NameIDPolicy.Format != "" &&
+		request.NameIDPolicy.Format != nameIDFormatUnspecified && request.NameIDPolicy.Format != msgCtx.NameIDFormat {
+		return s.buildSSOErrorResult(ctx, msgCtx, statusRequester, statusInvalidNameIDPolicy)
+	}
+	if request.IsPassive {
+		return s.buildSSOErrorResult(ctx, msgCtx, statusResponder, statusNoPassive)
+	}
+
+	flowInitCtx := &flowexec.FlowInitContext{
+		ApplicationID: sp.ApplicationID,
+		FlowType:      string(flowcm.FlowTypeAuthentication),
+		RuntimeData: map[string]string{
+			flowcm.RuntimeKeyRequiredOptionalAttributes:    strings.Join(getRequiredAttributes(sp), " "),
+			flowcm.RuntimeKeyUserAttributesCacheTTLSeconds: fmt.Sprintf("%d", int(messageContextValidity.Seconds())),
+		},
+	}
+	executionID, flowErr := s.flowExecService.InitiateFlow(ctx, flowInitCtx)
+	if flowErr != nil {
+		s.logger.Error("Failed to initiate authentication flow", log.String("error_code", flowErr.Code))
+		return s.buildSSOErrorResult(ctx, msgCtx, statusResponder, "")
+	}
+
+	key, err := s.store.Store(ctx, msgCtx, messageContextValidity)
+	if err != nil {
+		s.logger.Error("Failed to store the authentication request", log.Error(err))
+		return nil, newServerError()
+	}
+
+	return &SSOResult{
+		LoginPageQueryParams: map[string]string{
+			oauth2const.AuthID:      authIDPrefix + key,
+			oauth2const.AppID:       sp.ApplicationID,
+			oauth2const.ExecutionID: executionID,
+		},
+	}, nil
+}
+
+// HandleAuthCallback completes an authentication request with the assertion issued by the login flow. The
+// response for the service provider is stored and the returned URI delivers it to the service provider.
+func (s *samlService) HandleAuthCallback(ctx context.Context, authID, assertion string) (string, error) {
+	msgCtx, found, err := s.store.Consume(ctx, strings.TrimPrefix(authID, authIDPrefix))
+	if err != nil {
+		return "", fmt.Errorf("failed to load the authentication request: %w", err)
+	}
+	if !found {
+		return "", errors.New("authentication request not found or expired")
+	}
+
+	sp := getServiceProvider(msgCtx.ServiceProvider)
+	if sp == nil {
+		return "", errors.New("service provider of the authentication request is no longer registered")
+	}
+
+	response, err := s.buildAuthnResponse(ctx, sp, msgCtx, assertion)
+	if err != nil {
+		s.logger.Debug("Failed to authenticate the user", log.Error(err))
+		response, err = s.buildStatusResponse(ctx, "samlp:Response", msgCtx.RequestID, msgCtx.ACSURL,
+			statusResponder, statusAuthnFailed)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return s.storeResponse(ctx, msgCtx, response)
+}
+
+// GetResponse returns a stored response to deliver it to the service provider. A response is delivered once.
+func (s *samlService) GetResponse(ctx context.Context, id string) (*OutboundMessage, *SAMLError) {
+	if id == "" {
+		return nil, newRequestError("Invalid SAML response request")
+	}
+
+	msgCtx, found, err := s.store.Consume(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to load the SAML response", log.Error(err))
+		return nil, newServerError()
+	}
+	if !found || len(msgCtx.Response) == 0 {
+		return nil, newRequestError("SAML response not found or already delivered")
+	}
+
+	return &OutboundMessage{
+		URL:        msgCtx.ACSURL,
+		Param:      paramSAMLResponse,
+		Message:    msgCtx.Response,
+		RelayState: msgCtx.RelayState,
+	}, nil
+}
+
+// HandleLogoutRequest processes a logout request of a service provider and returns the logout response. The
+// identity provider does not keep sessions for its service providers, so the logout completes without
+// notifying other service providers.
+func (s *samlService) HandleLogoutRequest(ctx context.Context, msg *InboundMessage) (*OutboundMessage, *SAMLError) {
+	var request logoutRequest
+	if err := xml.Unmarshal(msg.Message, &request); err != nil {
+		s.logger.Debug("Failed to parse the logout request", log.Error(err))
+		return nil, newRequestError("Invalid SAML logout request")
+	}
+	if request.ID == "" || request.Version != samlVersion {
+		return nil, newRequestError("Invalid SAML logout request")
+	}
+
+	sp, samlErr := s.getVerifiedServiceProvider(request.Issuer, msg, paramSAMLRequest)
+	if samlErr != nil {
+		return nil, samlErr
+	}
+	if sp.SLOURL == "" {
+		return nil, newRequestError("The service provider does not have a single logout URL")
+	}
+
+	response, err := s.buildStatusResponse(ctx, "samlp:LogoutResponse", request.ID, sp.SLOURL, statusSuccess, "")
+	if err != nil {
+		s.logger.Error("Failed to build the logout response", log.Error(err))
+		return nil, newServerError()
+	}
+
+	return &OutboundMessage{
+		URL:        sp.SLOURL,
+		Param:      paramSAMLResponse,
+		Message:    response,
+		RelayState: msg.RelayState,
+	}, nil
+}
+
+// getVerifiedServiceProvider returns the registered service provider that issued a request, after verifying
+// the signature of the request when the service provider signs its requests. Signed requests are accepted
+// with the HTTP-Redirect binding, where the signature is carried in the query string.
+func (s *samlService) getVerifiedServiceProvider(
+	issuer string, msg *InboundMessage, messageParam string,
+) (*config.SAMLServiceProviderConfig, *SAMLError) {
+	sp := getServiceProvider(strings.TrimSpace(issuer))
+	if sp == nil {
+		s.logger.Debug("Request from an unknown service provider", log.String("issuer", issuer))
+		return nil, newRequestError("Unknown SAML service provider")
+	}
+
+	if sp.AuthnRequestsSigned {
+		if msg.RawQuery == "" {
+			return nil, newRequestError("Signed requests must use the HTTP-Redirect binding")
+		}
+		if err := verifyRedirectSignature(msg.RawQuery, messageParam, sp.Certificate); err != nil {
+			s.logger.Debug("Invalid request signature", log.String("serviceProvider", sp.EntityID),
+				log.Error(err))
+			return nil, newRequestError("Invalid SAML request signature")
+		}
+	}
+	return sp, nil
+}
+
+// buildSSOErrorResult builds the result of an authentication request answered with an error response.
+func (s *samlService) buildSSOErrorResult(
+	ctx context.Context, msgCtx messageContext, statusCode, subStatusCode string,
+) (*SSOResult, *SAMLError) {
+	response, err := s.buildStatusResponse(ctx, "samlp:Response", msgCtx.RequestID, msgCtx.ACSURL,
+		statusCode, subStatusCode)
+	if err != nil {
+		s.logger.Error("Failed to build the error response", log.Error(err))
+		return nil, newServerError()
+	}
+
+	return &SSOResult{
+		Response: &OutboundMessage{
+			URL:        msgCtx.ACSURL,
+			Param:      paramSAMLResponse,
+			Message:    response,
+			RelayState: msgCtx.RelayState,
+		},
+	}, nil
+}
+
+// buildAuthnResponse verifies the flow assertion and builds the response carrying a signed assertion about the
+// authenticated user.
+func (s *samlService) buildAuthnResponse(
+	ctx context.Context, sp *config.SAMLServiceProviderConfig, msgCtx messageContext, flowAssertion string,
+) ([]byte, error) {
+	if flowAssertion == "" {
+		return nil, errors.New("assertion is empty")
+	}
+	if svcErr := s.jwtService.VerifyJWT(flowAssertion, sp.ApplicationID, ""); svcErr != nil {
+		return nil, fmt.Errorf("invalid assertion: %s", svcErr.Error.DefaultValue)
+	}
+	_, claims, err := jwt.DecodeJWT(flowAssertion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the assertion: %w", err)
+	}
+	userID, _ := claims[oauth2const.ClaimSub].(string)
+	if userID == "" {
+		return nil, errors.New("user ID is empty")
+	}
+
+	attributes, err := s.getUserAttributes(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	nameID := userID
+	if sp.NameIDAttribute != "" {
+		value, ok := attributes[sp.NameIDAttribute].(string)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("user does not have the name ID attribute %q", sp.NameIDAttribute)
+		}
+		nameID = value
+	}
+
+	now := time.Now().UTC()
+	notOnOrAfter := now.Add(getAssertionValidity()).Format(samlTimestampLayout)
+	issueInstant := now.Format(samlTimestampLayout)
+
+	assertionID, err := generateMessageID()
+	if err != nil {
+		return nil, err
+	}
+	samlAssertion := newElement("saml:Assertion").
+		setAttr("ID", assertionID).
+		setAttr("Version", samlVersion).
+		setAttr("IssueInstant", issueInstant).
+		addChild(
+			newElement("saml:Issuer").setText(getEntityID(ctx)),
+			newElement("saml:Subject").addChild(
+				newElement("saml:NameID").setAttr("Format", msgCtx.NameIDFormat).setText(nameID),
+				newElement("saml:SubjectConfirmation").setAttr("Method", subjectConfirmBearer).addChild(
+					newElement("saml:SubjectConfirmationData").
+						setAttr("InResponseTo", msgCtx.RequestID).
+						setAttr("NotOnOrAfter", notOnOrAfter).
+						setAttr("Recipient", msgCtx.ACSURL),
+				),
+			),
+			newElement("saml:Conditions").
+				setAttr("NotBefore", issueInstant).
+				setAttr("NotOnOrAfter", notOnOrAfter).
+				addChild(newElement("saml:AudienceRestriction").addChild(
+					newElement("saml:Audience").setText(sp.EntityID),
+				)),
+			newElement("saml:AuthnStatement").
+				setAttr("AuthnInstant", getAuthnInstant(claims, now)).
+				setAttr("SessionIndex", assertionID).
+				addChild(newElement("saml:AuthnContext").addChild(
+					newElement("saml:AuthnContextClassRef").setText(authnContextUnspecified),
+				)),
+		)
+	if statement := buildAttributeStatement(sp.Attributes, attributes); statement != nil {
+		samlAssertion.addChild(statement)
+	}
+
+	if err := s.signer.signElement(samlAssertion, assertionID); err != nil {
+		return nil, err
+	}
+
+	responseID, err := generateMessageID()
+	if err != nil {
+		return nil, err
+	}
+	response := newElement("samlp:Response").
+		setAttr("ID", responseID).
+		setAttr("Version", samlVersion).
+		setAttr("IssueInstant", issueInstant).
+		setAttr("Destination", msgCtx.ACSURL).
+		setAttr("InResponseTo", msgCtx.RequestID).
+		addChild(
+			newElement("saml:Issuer").setText(getEntityID(ctx)),
+			newStatusElement(statusSuccess, ""),
+			samlAssertion,
+		)
+	return withXMLHeader(response.render()), nil
+}
+
+// getUserAttributes returns the user attributes cached by the login flow, and removes them from the cache.
+func (s *samlService) getUserAttributes(ctx context.Context, claims map[string]interface{}) (
+	map[string]interface{}, error) {
+	cacheID, _ := claims["aci"].(string)
+	if cacheID == "" {
+		return map[string]interface{}{}, nil
+	}
+
+	cache, svcErr := s.attrCacheService.GetAttributeCache(ctx, cacheID)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to get the user attributes: %s", svcErr.Code)
+	}
+	if svcErr := s.attrCacheService.DeleteAttributeCache(ctx, cacheID); svcErr != nil {
+		s.logger.Debug("Failed to delete the user attribute cache", log.String("error_code", svcErr.Code))
+	}
+	if cache.Attributes == nil {
+		return map[string]interface{}{}, nil
+	}
+	return cache.Attributes, nil
+}
+
+// buildStatusResponse builds a signed response of the given element name that carries only a status.
+func (s *samlService) buildStatusResponse(
+	ctx context.Context, name, inResponseTo, destination, statusCode, subStatusCode string,
+) ([]byte, error) {
+	responseID, err := generateMessageID()
+	if err != nil {
+		return nil, err
+	}
+
+	response := newElement(name).
+		setAttr("ID", responseID).
+		setAttr("Version", samlVersion).
+		setAttr("IssueInstant", time.Now().UTC().Format(samlTimestampLayout)).
+		setAttr("Destination", destination).
+		setAttr("InResponseTo", inResponseTo).
+		addChild(
+			newElement("saml:Issuer").setText(getEntityID(ctx)),
+			newStatusElement(statusCode, subStatusCode),
+		)
+	if err := s.signer.signElement(response, responseID); err != nil {
+		return nil, err
+	}
+	return withXMLHeader(response.render()), nil
+}
+
+// storeResponse stores a response for delivery and returns the URI that delivers it to the service provider.
+func (s *samlService) storeResponse(ctx context.Context, msgCtx messageContext, response []byte) (string, error) {
+	msgCtx.Response = response
+	key, err := s.store.Store(ctx, msgCtx, messageContextValidity)
+	if err != nil {
+		return "", fmt.Errorf("failed to store the SAML response: %w", err)
+	}
+	return config.GetPublicURL(ctx) + ssoResponsePath + "?" + paramResponseID + "=" + key, nil
+}
@@ -0,0 +1,541 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	flowcm "github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/pki/pkimock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testSPEntityID = "https://sp.example.com"
+	testACSURL     = "https://sp.example.com/acs"
+	testSLOURL     = "https://sp.example.com/slo"
+	testAppID      = "app-1"
+	testPublicURL  = "https://localhost:8090"
+
+	testSignedSPEntityID = "https://signed.example.com"
+	testNoSLOSPEntityID  = "https://noslo.example.com"
+)
+
+// testResponse is the subset of a SAML response inspected by the tests.
+type testResponse struct {
+	XMLName      xml.Name
+	InResponseTo string `xml:"InResponseTo,attr"`
+	Destination  string `xml:"Destination,attr"`
+	Status       struct {
+		StatusCode struct {
+			Value      string `xml:"Value,attr"`
+			StatusCode struct {
+				Value string `xml:"Value,attr"`
+			} `xml:"StatusCode"`
+		} `xml:"StatusCode"`
+	} `xml:"Status"`
+	Assertion *struct {
+		Signature *struct{} `xml:"http://www.w3.org/2000/09/xmldsig# Signature"`
+		Subject   struct {
+			NameID struct {
+				Format string `xml:"Format,attr"`
+				Value  string `xml:",chardata"`
+			} `xml:"NameID"`
+		} `xml:"Subject"`
+		Audience   string `xml:"Conditions>AudienceRestriction>Audience"`
+		Attributes []struct {
+			Name   string   `xml:"Name,attr"`
+			Values []string `xml:"AttributeValue"`
+		} `xml:"AttributeStatement>Attribute"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Assertion"`
+}
+
+type ServiceTestSuite struct {
+	suite.Suite
+	ctx              context.Context
+	mockFlowExec     *flowexecmock.FlowExecServiceInterfaceMock
+	mockJWT          *jwtmock.JWTServiceInterfaceMock
+	mockAttrCache    *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockPKI          *pkimock.PKIServiceInterfaceMock
+	mockStore        *messageContextStoreInterfaceMock
+	service          *samlService
+	spCertificatePEM string
+	signingCert      *x509.Certificate
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupSuite() {
+	spKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	s.spCertificatePEM = string(pem.EncodeToMemory(&pem.Block{
+		Type: "CERTIFICATE", Bytes: newTestCertificate(s.T(), spKey).Raw,
+	}))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	serviceProviders := []config.SAMLServiceProviderConfig{
+		{
+			EntityID:        testSPEntityID,
+			ApplicationID:   testAppID,
+			ACSURLs:         []string{testACSURL, "https://sp.example.com/acs2"},
+			SLOURL:          testSLOURL,
+			NameIDFormat:    nameIDFormatEmail,
+			NameIDAttribute: "email",
+			Attributes:      []string{"email", "groups"},
+		},
+		{
+			EntityID:            testSignedSPEntityID,
+			ApplicationID:       testAppID,
+			ACSURLs:             []string{"https://signed.example.com/acs"},
+			AuthnRequestsSigned: true,
+			Certificate:         s.spCertificatePEM,
+		},
+		{
+			EntityID:      testNoSLOSPEntityID,
+			ApplicationID: testAppID,
+			ACSURLs:       []string{"https://noslo.example.com/acs"},
+		},
+	}
+	testConfig := &config.Config{
+		Server: config.ServerConfig{PublicURL: testPublicURL},
+		JWT:    config.JWTConfig{PreferredKeyID: testKeyID},
+		SAML:   config.SAMLConfig{Enabled: true, ServiceProviders: serviceProviders},
+	}
+	_ = config.InitializeServerRuntime("", testConfig)
+
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	s.signingCert = newTestCertificate(s.T(), signingKey)
+
+	s.mockFlowExec = flowexecmock.NewFlowExecServiceInterfaceMock(s.T())
+	s.mockJWT = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockAttrCache = attributecachemock.NewAttributeCacheServiceInterfaceMock(s.T())
+	s.mockPKI = pkimock.NewPKIServiceInterfaceMock(s.T())
+	s.mockPKI.On("GetPrivateKey", testKeyID).Return(signingKey, nil).Maybe()
+	s.mockPKI.On("GetX509Certificate", testKeyID).Return(s.signingCert, nil).Maybe()
+	s.mockStore = newMessageContextStoreInterfaceMock(s.T())
+
+	s.service = newSAMLService(s.mockFlowExec, s.mockJWT, s.mockAttrCache, s.mockPKI,
+		s.mockStore).(*samlService)
+}
+
+func (s *ServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *ServiceTestSuite) TestGetMetadata() {
+	metadata, err := s.service.GetMetadata(s.ctx)
+
+	s.NoError(err)
+	content := string(metadata)
+	s.True(strings.HasPrefix(content, xmlHeader))
+	s.Contains(content, `entityID="`+testPublicURL+metadataPath+`"`)
+	s.Contains(content, `Location="`+testPublicURL+ssoPath+`"`)
+	s.Contains(content, `Location="`+testPublicURL+sloPath+`"`)
+	s.Contains(content, base64.StdEncoding.EncodeToString(s.signingCert.Raw))
+}
+
+func (s *ServiceTestSuite) TestGetMetadata_ConfiguredEntityID() {
+	config.GetServerRuntime().Config.SAML.EntityID = "urn:thunder:idp"
+
+	metadata, err := s.service.GetMetadata(s.ctx)
+
+	s.NoError(err)
+	s.Contains(string(metadata), `entityID="urn:thunder:idp"`)
+}
+
+func (s *ServiceTestSuite) TestHandleSSORequest_Success() {
+	s.mockFlowExec.On("InitiateFlow", s.ctx, mock.MatchedBy(func(initCtx *flowexec.FlowInitContext) bool {
+		return initCtx.ApplicationID == testAppID &&
+			initCtx.FlowType == string(flowcm.FlowTypeAuthentication) &&
+			initCtx.RuntimeData[flowcm.RuntimeKeyRequiredOptionalAttributes] == "email groups" &&
+			initCtx.RuntimeData[flowcm.RuntimeKeyUserAttributesCacheTTLSeconds] == "600"
+	})).Return("exec-1", nil)
+	s.mockStore.On("Store", s.ctx, messageContext{
+		RequestID:       "_req1",
+		ServiceProvider: testSPEntityID,
+		ACSURL:          "https://sp.example.com/acs2",
+		RelayState:      "relay",
+		NameIDFormat:    nameIDFormatEmail,
+	}, messageContextValidity).Return("key-1", nil)
+
+	result, samlErr := s.service.HandleSSORequest(s.ctx, &InboundMessage{
+		Message:    s.authnRequest(testSPEntityID, `AssertionConsumerServiceURL="https://sp.example.com/acs2"`),
+		RelayState: "relay",
+	})
+
+	s.Nil(samlErr)
+	s.Nil(result.Response)
+	s.Equal(map[string]string{
+		oauth2const.AuthID:      "saml-key-1",
+		oauth2const.AppID:       testAppID,
+		oauth2const.ExecutionID: "exec-1",
+	}, result.LoginPageQueryParams)
+}
+
+func (s *ServiceTestSuite) TestHandleSSORequest_InvalidRequests() {
+	testCases := []struct {
+		name     string
+		message  []byte
+		expected string
+	}{
+		{"MalformedXML", []byte("<not-xml"), "Invalid SAML authentication request"},
+		{"WrongVersion", []byte(`<samlp:AuthnRequest xmlns:samlp="` + nsProtocol + `" ID="_1" Version="1.1">` +
+			`</samlp:AuthnRequest>`), "Invalid SAML authentication request"},
+		{"UnknownServiceProvider", s.authnRequest("https://unknown.example.com", ""),
+			"Unknown SAML service provider"},
+		{"UnregisteredACSURL", s.authnRequest(testSPEntityID, `AssertionConsumerServiceURL="https://evil.com/acs"`),
+			"Unregistered assertion consumer service URL"},
+		{"SignedRequestWithPOSTBinding", s.authnRequest(testSignedSPEntityID, ""),
+			"Signed requests must use the HTTP-Redirect binding"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result, samlErr := s.service.HandleSSORequest(s.ctx, &InboundMessage{Message: tc.message})
+
+			s.Nil(result)
+			s.Require().NotNil(samlErr)
+			s.Equal(oauth2const.ErrorInvalidRequest, samlErr.Code)
+			s.Equal(tc.expected, samlErr.Message)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestHandleSSORequest_InvalidSignature() {
+	result, samlErr := s.service.HandleSSORequest(s.ctx, &InboundMessage{
+		Message:  s.authnRequest(testSignedSPEntityID, ""),
+		RawQuery: "SAMLRequest=abc&SigAlg=" + algRSASHA256 + "&Signature=abc",
+	})
+
+	s.Nil(result)
+	s.Require().NotNil(samlErr)
+	s.Equal("Invalid SAML request signature", samlErr.Message)
+}
+
+func (s *ServiceTestSuite) TestHandleSSORequest_ErrorResponses() {
+	testCases := []struct {
+		name          string
+		attrs         string
+		statusCode    string
+		subStatusCode string
+	}{
+		{"IsPassive", `IsPassive="true"`, statusResponder, statusNoPassive},
+		{"UnsupportedBinding", `ProtocolBinding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact"`,
+			statusRequester, statusUnsupportedBinding},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result, samlErr := s.service.HandleSSORequest(s.ctx, &InboundMessage{
+				Message:    s.authnRequest(testSPEntityID, tc.attrs),
+				RelayState: "relay",
+			})
+
+			s.Nil(samlErr)
+			s.Require().NotNil(result.Response)
+			s.Equal(testACSURL, result.Response.URL)
+			s.Equal(paramSAMLResponse, result.Response.Param)
+			s.Equal("relay", result.Response.RelayState)
+
+			response := s.parseResponse(result.Response.Message)
+			s.Equal("_req1", response.InResponseTo)
+			s.Equal(tc.statusCode, response.Status.StatusCode.Value)
+			s.Equal(tc.subStatusCode, response.Status.StatusCode.StatusCode.Value)
+			s.Nil(response.Assertion)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestHandleSSORequest_UnsupportedNameIDPolicy() {
+	result, samlErr := s.service.HandleSSORequest(s.ctx, &InboundMessage{
+		Message: []byte(`<samlp:AuthnRequest xmlns:samlp="` + nsProtocol + `" xmlns:saml="` + nsAssertion +
+			`" ID="_req1" Version="2.0"><saml:Issuer>` + testSPEntityID + `</saml:Issuer>` +
+			`<samlp:NameIDPolicy Format="` + nameIDFormatPersistent + `"/></samlp:AuthnRequest>`),
+	})
+
+	s.Nil(samlErr)
+	s.Require().NotNil(result.Response)
+	response := s.parseResponse(result.Response.Message)
+	s.Equal(statusInvalidNameIDPolicy, response.Status.StatusCode.StatusCode.Value)
+}
+
+func (s *ServiceTestSuite) TestHandleSSORequest_FlowInitiationFailure() {
+	s.mockFlowExec.On("InitiateFlow", s.ctx, mock.Anything).Return("", &serviceerror.ServiceError{Code: "FLW-1"})
+
+	result, samlErr := s.service.HandleSSORequest(s.ctx, &InboundMessage{
+		Message: s.authnRequest(testSPEntityID, ""),
+	})
+
+	s.Nil(samlErr)
+	s.Require().NotNil(result.Response)
+	response := s.parseResponse(result.Response.Message)
+	s.Equal(statusResponder, response.Status.StatusCode.Value)
+}
+
+func (s *ServiceTestSuite) TestHandleSSORequest_StoreFailure() {
+	s.mockFlowExec.On("InitiateFlow", s.ctx, mock.Anything).Return("exec-1", nil)
+	s.mockStore.On("Store", s.ctx, mock.Anything, messageContextValidity).Return("", errors.New("db down"))
+
+	result, samlErr := s.service.HandleSSORequest(s.ctx, &InboundMessage{
+		Message: s.authnRequest(testSPEntityID, ""),
+	})
+
+	s.Nil(result)
+	s.Require().NotNil(samlErr)
+	s.Equal(oauth2const.ErrorServerError, samlErr.Code)
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_Success() {
+	assertion := newTestFlowAssertion(map[string]interface{}{"sub": "user-1", "aci": "cache-1", "iat": 1700000000})
+	s.mockStore.On("Consume", s.ctx, "key-1").Return(s.pendingContext(), true, nil)
+	s.mockJWT.On("VerifyJWT", assertion, testAppID, "").Return(nil)
+	s.mockAttrCache.On("GetAttributeCache", s.ctx, "cache-1").Return(&attributecache.AttributeCache{
+		Attributes: map[string]interface{}{
+			"email":  "alice@example.com",
+			"groups": []interface{}{"admins", "users"},
+			"phone":  "123",
+		},
+	}, nil)
+	s.mockAttrCache.On("DeleteAttributeCache", s.ctx, "cache-1").Return(nil)
+
+	var stored messageContext
+	s.mockStore.On("Store", s.ctx, mock.Anything, messageContextValidity).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(messageContext)
+	}).Return("key-2", nil)
+
+	redirectURI, err := s.service.HandleAuthCallback(s.ctx, "saml-key-1", assertion)
+
+	s.NoError(err)
+	s.Equal(testPublicURL+ssoResponsePath+"?id=key-2", redirectURI)
+	s.Equal(testACSURL, stored.ACSURL)
+	s.Equal("relay", stored.RelayState)
+
+	response := s.parseResponse(stored.Response)
+	s.Equal("_req1", response.InResponseTo)
+	s.Equal(testACSURL, response.Destination)
+	s.Equal(statusSuccess, response.Status.StatusCode.Value)
+	s.Require().NotNil(response.Assertion)
+	s.NotNil(response.Assertion.Signature)
+	s.Equal("alice@example.com", response.Assertion.Subject.NameID.Value)
+	s.Equal(nameIDFormatEmail, response.Assertion.Subject.NameID.Format)
+	s.Equal(testSPEntityID, response.Assertion.Audience)
+	s.Require().Len(response.Assertion.Attributes, 2)
+	s.Equal("email", response.Assertion.Attributes[0].Name)
+	s.Equal([]string{"alice@example.com"}, response.Assertion.Attributes[0].Values)
+	s.Equal("groups", response.Assertion.Attributes[1].Name)
+	s.Equal([]string{"admins", "users"}, response.Assertion.Attributes[1].Values)
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_InvalidAssertion() {
+	s.mockStore.On("Consume", s.ctx, "key-1").Return(s.pendingContext(), true, nil)
+	s.mockJWT.On("VerifyJWT", "bad-assertion", testAppID, "").Return(&serviceerror.ServiceError{
+		Code: "JWT-1", Error: core.I18nMessage{DefaultValue: "invalid signature"},
+	})
+
+	var stored messageContext
+	s.mockStore.On("Store", s.ctx, mock.Anything, messageContextValidity).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(messageContext)
+	}).Return("key-2", nil)
+
+	redirectURI, err := s.service.HandleAuthCallback(s.ctx, "saml-key-1", "bad-assertion")
+
+	s.NoError(err)
+	s.Equal(testPublicURL+ssoResponsePath+"?id=key-2", redirectURI)
+	response := s.parseResponse(stored.Response)
+	s.Equal(statusResponder, response.Status.StatusCode.Value)
+	s.Equal(statusAuthnFailed, response.Status.StatusCode.StatusCode.Value)
+	s.Nil(response.Assertion)
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_MissingNameIDAttribute() {
+	assertion := newTestFlowAssertion(map[string]interface{}{"sub": "user-1"})
+	s.mockStore.On("Consume", s.ctx, "key-1").Return(s.pendingContext(), true, nil)
+	s.mockJWT.On("VerifyJWT", assertion, testAppID, "").Return(nil)
+
+	var stored messageContext
+	s.mockStore.On("Store", s.ctx, mock.Anything, messageContextValidity).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(messageContext)
+	}).Return("key-2", nil)
+
+	_, err := s.service.HandleAuthCallback(s.ctx, "saml-key-1", assertion)
+
+	s.NoError(err)
+	s.Equal(statusAuthnFailed, s.parseResponse(stored.Response).Status.StatusCode.StatusCode.Value)
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_RequestNotFound() {
+	s.mockStore.On("Consume", s.ctx, "key-1").Return(messageContext{}, false, nil)
+
+	_, err := s.service.HandleAuthCallback(s.ctx, "saml-key-1", "assertion")
+
+	s.ErrorContains(err, "not found")
+}
+
+func (s *ServiceTestSuite) TestHandleAuthCallback_StoreError() {
+	s.mockStore.On("Consume", s.ctx, "key-1").Return(messageContext{}, false, errors.New("db down"))
+
+	_, err := s.service.HandleAuthCallback(s.ctx, "saml-key-1", "assertion")
+
+	s.ErrorContains(err, "failed to load the authentication request")
+}
+
+func (s *ServiceTestSuite) TestGetResponse() {
+	s.mockStore.On("Consume", s.ctx, "key-2").Return(messageContext{
+		ACSURL: testACSURL, RelayState: "relay", Response: []byte("<response/>"),
+	}, true, nil)
+
+	response, samlErr := s.service.GetResponse(s.ctx, "key-2")
+
+	s.Nil(samlErr)
+	s.Equal(&OutboundMessage{
+		URL: testACSURL, Param: paramSAMLResponse, Message: []byte("<response/>"), RelayState: "relay",
+	}, response)
+}
+
+func (s *ServiceTestSuite) TestGetResponse_NotFound() {
+	s.mockStore.On("Consume", s.ctx, "key-2").Return(messageContext{}, false, nil)
+
+	response, samlErr := s.service.GetResponse(s.ctx, "key-2")
+
+	s.Nil(response)
+	s.Require().NotNil(samlErr)
+	s.Equal(oauth2const.ErrorInvalidRequest, samlErr.Code)
+}
+
+func (s *ServiceTestSuite) TestGetResponse_PendingRequestIsNotAResponse() {
+	s.mockStore.On("Consume", s.ctx, "key-1").Return(s.pendingContext(), true, nil)
+
+	response, samlErr := s.service.GetResponse(s.ctx, "key-1")
+
+	s.Nil(response)
+	s.NotNil(samlErr)
+}
+
+func (s *ServiceTestSuite) TestGetResponse_EmptyID() {
+	response, samlErr := s.service.GetResponse(s.ctx, "")
+
+	s.Nil(response)
+	s.NotNil(samlErr)
+}
+
+func (s *ServiceTestSuite) TestHandleLogoutRequest() {
+	response, samlErr := s.service.HandleLogoutRequest(s.ctx, &InboundMessage{
+		Message:    s.logoutRequest(testSPEntityID),
+		RelayState: "relay",
+	})
+
+	s.Nil(samlErr)
+	s.Equal(testSLOURL, response.URL)
+	s.Equal(paramSAMLResponse, response.Param)
+	s.Equal("relay", response.RelayState)
+
+	parsed := s.parseResponse(response.Message)
+	s.Equal("LogoutResponse", parsed.XMLName.Local)
+	s.Equal("_logout1", parsed.InResponseTo)
+	s.Equal(testSLOURL, parsed.Destination)
+	s.Equal(statusSuccess, parsed.Status.StatusCode.Value)
+}
+
+func (s *ServiceTestSuite) TestHandleLogoutRequest_Errors() {
+	testCases := []struct {
+		name     string
+		message  []byte
+		expected string
+	}{
+		{"MalformedXML", []byte("<x"), "Invalid SAML logout request"},
+		{"UnknownServiceProvider", s.logoutRequest("https://unknown.example.com"), "Unknown SAML service provider"},
+		{"NoSingleLogoutURL", s.logoutRequest(testNoSLOSPEntityID),
+			"The service provider does not have a single logout URL"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			response, samlErr := s.service.HandleLogoutRequest(s.ctx, &InboundMessage{Message: tc.message})
+
+			s.Nil(response)
+			s.Require().NotNil(samlErr)
+			s.Equal(tc.expected, samlErr.Message)
+		})
+	}
+}
+
+// authnRequest builds an authentication request of the service provider with extra attributes.
+func (s *ServiceTestSuite) authnRequest(issuer, extraAttrs string) []byte {
+	return []byte(`<samlp:AuthnRequest xmlns:samlp="` + nsProtocol + `" xmlns:saml="` + nsAssertion +
+		`" ID="_req1" Version="2.0" ` + extraAttrs + `><saml:Issuer>` + issuer + `</saml:Issuer>` +
+		`</samlp:AuthnRequest>`)
+}
+
+// logoutRequest builds a logout request of the service provider.
+func (s *ServiceTestSuite) logoutRequest(issuer string) []byte {
+	return []byte(`<samlp:LogoutRequest xmlns:samlp="` + nsProtocol + `" xmlns:saml="` + nsAssertion +
+		`" ID="_logout1" Version="2.0"><saml:Issuer>` + issuer + `</saml:Issuer>` +
+		`<saml:NameID>alice@example.com</saml:NameID></samlp:LogoutRequest>`)
+}
+
+// pendingContext returns the context of a pending authentication request of the test service provider.
+func (s *ServiceTestSuite) pendingContext() messageContext {
+	return messageContext{
+		RequestID:       "_req1",
+		ServiceProvider: testSPEntityID,
+		ACSURL:          testACSURL,
+		RelayState:      "relay",
+		NameIDFormat:    nameIDFormatEmail,
+	}
+}
+
+// parseResponse parses a response issued by the identity provider.
+func (s *ServiceTestSuite) parseResponse(message []byte) testResponse {
+	var response testResponse
+	s.Require().NoError(xml.Unmarshal(message, &response))
+	return response
+}
+
+// newTestFlowAssertion builds an unsigned JWT carrying the given claims.
+func newTestFlowAssertion(claims map[string]interface{}) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload, _ := json.Marshal(claims)
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
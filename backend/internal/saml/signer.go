@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
+)
+
+// xmlSigner signs the messages of the identity provider with the preferred signing key of the server.
+type xmlSigner struct {
+	pkiService pkiservice.PKIServiceInterface
+}
+
+// newXMLSigner creates a new xmlSigner.
+func newXMLSigner(pkiService pkiservice.PKIServiceInterface) *xmlSigner {
+	return &xmlSigner{pkiService: pkiService}
+}
+
+// getCertificate returns the certificate of the signing key.
+func (s *xmlSigner) getCertificate() (*x509.Certificate, error) {
+	cert, svcErr := s.pkiService.GetX509Certificate(config.GetServerRuntime().Config.JWT.PreferredKeyID)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to get the signing certificate: %s", svcErr.Code)
+	}
+	return cert, nil
+}
+
+// signElement adds an enveloped signature to the element, which must carry the given ID attribute and start with
+// an issuer. The signature references the element by its ID, uses the exclusive canonical form of the element
+// as the digest input, and is placed after the issuer as required by the SAML schema.
+func (s *xmlSigner) signElement(element *xmlElement, id string) error {
+	privateKey, svcErr := s.pkiService.GetPrivateKey(config.GetServerRuntime().Config.JWT.PreferredKeyID)
+	if svcErr != nil {
+		return fmt.Errorf("failed to get the signing key: %s", svcErr.Code)
+	}
+	cert, err := s.getCertificate()
+	if err != nil {
+		return err
+	}
+
+	signatureAlg, err := getSignatureAlgorithm(privateKey)
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256(element.render())
+	signedInfo := newElement("ds:SignedInfo").addChild(
+		newElement("ds:CanonicalizationMethod").setAttr("Algorithm", algExcC14N),
+		newElement("ds:SignatureMethod").setAttr("Algorithm", signatureAlg),
+		newElement("ds:Reference").setAttr("URI", "#"+id).addChild(
+			newElement("ds:Transforms").addChild(
+				newElement("ds:Transform").setAttr("Algorithm", algEnvelopedSignature),
+				newElement("ds:Transform").setAttr("Algorithm", algExcC14N),
+			),
+			newElement("ds:DigestMethod").setAttr("Algorithm", algSHA256),
+			newElement("ds:DigestValue").setText(base64.StdEncoding.EncodeToString(digest[:])),
+		),
+	)
+
+	signatureValue, err := signSHA256(privateKey, signedInfo.render())
+	if err != nil {
+		return err
+	}
+
+	signature := newElement("ds:Signature").addChild(
+		signedInfo,
+		newElement("ds:SignatureValue").setText(base64.StdEncoding.EncodeToString(signatureValue)),
+		newKeyInfoElement(cert),
+	)
+	element.insertChild(1, signature)
+	return nil
+}
+
+// newKeyInfoElement creates the key info element carrying the certificate.
+func newKeyInfoElement(cert *x509.Certificate) *xmlElement {
+	return newElement("ds:KeyInfo").addChild(
+		newElement("ds:X509Data").addChild(
+			newElement("ds:X509Certificate").setText(base64.StdEncoding.EncodeToString(cert.Raw)),
+		),
+	)
+}
+
+// getSignatureAlgorithm returns the XML signature algorithm for the signing key.
+func getSignatureAlgorithm(privateKey crypto.PrivateKey) (string, error) {
+	switch privateKey.(type) {
+	case *rsa.PrivateKey:
+		return algRSASHA256, nil
+	case *ecdsa.PrivateKey:
+		return algECDSASHA256, nil
+	default:
+		return "", errors.New("unsupported signing key type for XML signatures")
+	}
+}
+
+// signSHA256 signs the SHA-256 digest of the data. ECDSA signatures are encoded as the concatenation of r and s
+// as required by XML signatures.
+func signSHA256(privateKey crypto.PrivateKey, data []byte) ([]byte, error) {
+	hashed := sha256.Sum256(data)
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, hashed[:])
+		if err != nil {
+			return nil, err
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		signature := make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+		return signature, nil
+	default:
+		return nil, errors.New("unsupported signing key type for XML signatures")
+	}
+}
+
+// verifySHA256 verifies a signature over the SHA-256 digest of the data with the public key of the certificate.
+func verifySHA256(cert *x509.Certificate, data, signature []byte) error {
+	hashed := sha256.Sum256(data)
+
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], signature)
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid ECDSA signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(key, hashed[:], r, s) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	default:
+		return errors.New("unsupported public key type")
+	}
+}
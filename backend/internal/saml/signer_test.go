@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/pki/pkimock"
+)
+
+const testKeyID = "test-key"
+
+type SignerTestSuite struct {
+	suite.Suite
+}
+
+func TestSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(SignerTestSuite))
+}
+
+func (s *SignerTestSuite) SetupTest() {
+	testConfig := &config.Config{JWT: config.JWTConfig{PreferredKeyID: testKeyID}}
+	_ = config.InitializeServerRuntime("", testConfig)
+}
+
+func (s *SignerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *SignerTestSuite) TestSignElement_RSA() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	s.assertSignedElementVerifies(key, algRSASHA256)
+}
+
+func (s *SignerTestSuite) TestSignElement_ECDSA() {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	s.assertSignedElementVerifies(key, algECDSASHA256)
+}
+
+func (s *SignerTestSuite) TestSignElement_KeyNotFound() {
+	pkiMock := pkimock.NewPKIServiceInterfaceMock(s.T())
+	pkiMock.On("GetPrivateKey", testKeyID).Return(nil, &serviceerror.ServiceError{Code: "PKI-1"})
+
+	err := newXMLSigner(pkiMock).signElement(newElement("saml:Assertion"), "_1")
+
+	s.ErrorContains(err, "failed to get the signing key")
+}
+
+func (s *SignerTestSuite) assertSignedElementVerifies(key crypto.Signer, expectedAlg string) {
+	cert := newTestCertificate(s.T(), key)
+	pkiMock := pkimock.NewPKIServiceInterfaceMock(s.T())
+	pkiMock.On("GetPrivateKey", testKeyID).Return(key, nil)
+	pkiMock.On("GetX509Certificate", testKeyID).Return(cert, nil)
+
+	element := newElement("saml:Assertion").setAttr("ID", "_1").addChild(
+		newElement("saml:Issuer").setText("idp"),
+		newElement("saml:Subject"),
+	)
+	unsigned := element.render()
+
+	s.Require().NoError(newXMLSigner(pkiMock).signElement(element, "_1"))
+
+	s.Len(element.children, 3)
+	signature := element.children[1]
+	s.Equal("ds:Signature", signature.name)
+	signedInfo := signature.children[0]
+	s.Equal(expectedAlg, signedInfo.children[1].attrs["Algorithm"])
+
+	reference := signedInfo.children[2]
+	s.Equal("#_1", reference.attrs["URI"])
+	digest := sha256.Sum256(unsigned)
+	s.Equal(base64.StdEncoding.EncodeToString(digest[:]), reference.children[2].text)
+
+	signatureValue, err := base64.StdEncoding.DecodeString(signature.children[1].text)
+	s.Require().NoError(err)
+	s.NoError(verifySHA256(cert, signedInfo.render(), signatureValue))
+	s.Equal(base64.StdEncoding.EncodeToString(cert.Raw), signature.children[2].children[0].children[0].text)
+}
+
+// newTestCertificate creates a self-signed certificate for the key.
+func newTestCertificate(t *testing.T, key crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return cert
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// contextKeyRandomBytes is the number of random bytes of a message context key (32 bytes = 256 bits).
+const contextKeyRandomBytes = 32
+
+// messageContextStoreInterface defines the interface for storing the contexts of SAML exchanges. Each context
+// can be consumed once.
+type messageContextStoreInterface interface {
+	Store(ctx context.Context, msgCtx messageContext, validity time.Duration) (string, error)
+	Consume(ctx context.Context, key string) (messageContext, bool, error)
+}
+
+// messageContextStore is the relational-DB-backed implementation of messageContextStoreInterface.
+type messageContextStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newMessageContextStore creates a new DB-backed message context store.
+func newMessageContextStore(deploymentID string) messageContextStoreInterface {
+	return &messageContextStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// Store persists a message context and returns the generated key.
+func (s *messageContextStore) Store(
+	ctx context.Context, msgCtx messageContext, validity time.Duration,
+) (string, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	key, err := generateContextKey()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(msgCtx)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal SAML message context: %w", err)
+	}
+
+	expiryTime := time.Now().UTC().Add(validity)
+	if _, err := dbClient.ExecuteContext(
+		ctx, queryInsertMessageContext, key, s.deploymentID, data, expiryTime,
+	); err != nil {
+		return "", fmt.Errorf("failed to insert SAML message context: %w", err)
+	}
+
+	return key, nil
+}
+
+// Consume atomically retrieves and deletes a message context from the store.
+// Returns the context, a boolean indicating if found, and any error.
+func (s *messageContextStore) Consume(ctx context.Context, key string) (messageContext, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return messageContext{}, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetMessageContext, key, time.Now().UTC(), s.deploymentID)
+	if err != nil {
+		return messageContext{}, false, fmt.Errorf("failed to query SAML message context: %w", err)
+	}
+	if len(results) == 0 {
+		return messageContext{}, false, nil
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteMessageContext, key, s.deploymentID)
+	if err != nil {
+		return messageContext{}, false, fmt.Errorf("failed to delete SAML message context: %w", err)
+	}
+	// Another consumer raced us to the delete; treat as already consumed.
+	if rowsAffected == 0 {
+		return messageContext{}, false, nil
+	}
+
+	msgCtx, err := buildMessageContextFromRow(results[0])
+	if err != nil {
+		return messageContext{}, false, err
+	}
+	return msgCtx, true, nil
+}
+
+// buildMessageContextFromRow reconstructs a messageContext from a database row.
+func buildMessageContextFromRow(row map[string]any) (messageContext, error) {
+	var dataJSON []byte
+	if val, ok := row[dbColumnContextData].(string); ok && val != "" {
+		dataJSON = []byte(val)
+	} else if val, ok := row[dbColumnContextData].([]byte); ok && len(val) > 0 {
+		dataJSON = val
+	} else {
+		return messageContext{}, errors.New("context_data is missing or of unexpected type")
+	}
+
+	var msgCtx messageContext
+	if err := json.Unmarshal(dataJSON, &msgCtx); err != nil {
+		return messageContext{}, fmt.Errorf("failed to unmarshal SAML message context: %w", err)
+	}
+	return msgCtx, nil
+}
+
+// generateContextKey generates a cryptographically random key for a message context.
+func generateContextKey() (string, error) {
+	b := make([]byte, contextKeyRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+// dbColumnContextData is the column holding the serialized message context.
+const dbColumnContextData = "context_data"
+
+var queryInsertMessageContext = dbmodel.DBQuery{
+	ID: "SMLQ-SMS-01",
+	Query: `INSERT INTO "SAML_MESSAGE_CONTEXT" (CONTEXT_ID, DEPLOYMENT_ID, CONTEXT_DATA, EXPIRY_TIME) ` +
+		`VALUES ($1, $2, $3, $4)`,
+}
+
+var queryGetMessageContext = dbmodel.DBQuery{
+	ID: "SMLQ-SMS-02",
+	Query: `SELECT CONTEXT_ID, CONTEXT_DATA FROM "SAML_MESSAGE_CONTEXT" ` +
+		`WHERE CONTEXT_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
+}
+
+var queryDeleteMessageContext = dbmodel.DBQuery{
+	ID:    "SMLQ-SMS-03",
+	Query: `DELETE FROM "SAML_MESSAGE_CONTEXT" WHERE CONTEXT_ID = $1 AND DEPLOYMENT_ID = $2`,
+}
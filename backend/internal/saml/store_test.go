@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *messageContextStore
+	ctx            context.Context
+	testContext    messageContext
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = &providermock.DBProviderInterfaceMock{}
+	s.mockDBClient = &providermock.DBClientInterfaceMock{}
+	s.store = &messageContextStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.testContext = messageContext{
+		RequestID:       "_request-1",
+		ServiceProvider: "https://sp.example.com",
+		ACSURL:          "https://sp.example.com/acs",
+		RelayState:      "relay",
+	}
+}
+
+func (s *StoreTestSuite) TestStore_Success() {
+	before := time.Now().UTC()
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertMessageContext,
+		mock.MatchedBy(func(key string) bool { return len(key) == 43 }),
+		testDeploymentID,
+		mock.MatchedBy(func(data []byte) bool { return len(data) > 0 }),
+		mock.MatchedBy(func(t time.Time) bool {
+			diff := t.Sub(before.Add(time.Minute))
+			return diff >= -time.Second && diff <= time.Second
+		}),
+	).Return(int64(1), nil)
+
+	key, err := s.store.Store(s.ctx, s.testContext, time.Minute)
+
+	s.NoError(err)
+	s.NotEmpty(key)
+	s.mockDBClient.AssertExpectations(s.T())
+}
+
+func (s *StoreTestSuite) TestStore_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertMessageContext,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("insert failed"))
+
+	key, err := s.store.Store(s.ctx, s.testContext, time.Minute)
+
+	s.ErrorContains(err, "failed to insert SAML message context")
+	s.Empty(key)
+}
+
+func (s *StoreTestSuite) TestStore_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	key, err := s.store.Store(s.ctx, s.testContext, time.Minute)
+
+	s.Error(err)
+	s.Empty(key)
+}
+
+func (s *StoreTestSuite) TestConsume_Success() {
+	data, _ := json.Marshal(s.testContext)
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetMessageContext,
+		"key", mock.Anything, testDeploymentID,
+	).Return([]map[string]any{{dbColumnContextData: string(data)}}, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteMessageContext,
+		"key", testDeploymentID,
+	).Return(int64(1), nil)
+
+	result, found, err := s.store.Consume(s.ctx, "key")
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal(s.testContext, result)
+}
+
+func (s *StoreTestSuite) TestConsume_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetMessageContext,
+		"key", mock.Anything, testDeploymentID,
+	).Return([]map[string]any{}, nil)
+
+	_, found, err := s.store.Consume(s.ctx, "key")
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *StoreTestSuite) TestConsume_AlreadyConsumed() {
+	data, _ := json.Marshal(s.testContext)
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetMessageContext,
+		"key", mock.Anything, testDeploymentID,
+	).Return([]map[string]any{{dbColumnContextData: data}}, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteMessageContext,
+		"key", testDeploymentID,
+	).Return(int64(0), nil)
+
+	_, found, err := s.store.Consume(s.ctx, "key")
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *StoreTestSuite) TestConsume_InvalidData() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetMessageContext,
+		"key", mock.Anything, testDeploymentID,
+	).Return([]map[string]any{{dbColumnContextData: 123}}, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteMessageContext,
+		"key", testDeploymentID,
+	).Return(int64(1), nil)
+
+	_, found, err := s.store.Consume(s.ctx, "key")
+
+	s.ErrorContains(err, "context_data is missing")
+	s.False(found)
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// xmlHeader is the XML declaration prepended to the messages of the identity provider.
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8"?>`
+
+// getServiceProvider returns the configuration of the service provider with the given entity ID, or nil if
+// the service provider is not registered.
+func getServiceProvider(entityID string) *config.SAMLServiceProviderConfig {
+	if entityID == "" {
+		return nil
+	}
+	serviceProviders := config.GetServerRuntime().Config.SAML.ServiceProviders
+	for i := range serviceProviders {
+		if serviceProviders[i].EntityID == entityID {
+			return &serviceProviders[i]
+		}
+	}
+	return nil
+}
+
+// getEntityID returns the entity ID of the identity provider, which defaults to the URL of its metadata.
+func getEntityID(ctx context.Context) string {
+	if entityID := config.GetServerRuntime().Config.SAML.EntityID; entityID != "" {
+		return entityID
+	}
+	return config.GetPublicURL(ctx) + metadataPath
+}
+
+// getAssertionValidity returns the duration for which an issued assertion is valid.
+func getAssertionValidity() time.Duration {
+	if validity := config.GetServerRuntime().Config.SAML.AssertionValidity; validity > 0 {
+		return time.Duration(validity) * time.Second
+	}
+	return defaultAssertionValidity
+}
+
+// getNameIDFormat returns the name ID format of the service provider.
+func getNameIDFormat(sp *config.SAMLServiceProviderConfig) string {
+	if sp.NameIDFormat != "" {
+		return sp.NameIDFormat
+	}
+	return nameIDFormatUnspecified
+}
+
+// getRequiredAttributes returns the user attributes the login flow must resolve for the service provider.
+func getRequiredAttributes(sp *config.SAMLServiceProviderConfig) []string {
+	attributes := slices.Clone(sp.Attributes)
+	if sp.NameIDAttribute != "" && !slices.Contains(attributes, sp.NameIDAttribute) {
+		attributes = append(attributes, sp.NameIDAttribute)
+	}
+	return attributes
+}
+
+// getAuthnInstant returns the time at which the user authenticated, taken from the flow assertion.
+func getAuthnInstant(claims map[string]interface{}, fallback time.Time) string {
+	if iat, ok := claims[oauth2const.ClaimIat].(float64); ok {
+		return time.Unix(int64(iat), 0).UTC().Format(samlTimestampLayout)
+	}
+	return fallback.Format(samlTimestampLayout)
+}
+
+// buildAttributeStatement builds the attribute statement carrying the attributes released to the service
+// provider, in the configured order. Multi-valued attributes are released with a value per element. Returns nil
+// when the user has none of the attributes.
+func buildAttributeStatement(names []string, attributes map[string]interface{}) *xmlElement {
+	statement := newElement("saml:AttributeStatement")
+	for _, name := range names {
+		value, ok := attributes[name]
+		if !ok || value == nil {
+			continue
+		}
+
+		attribute := newElement("saml:Attribute").
+			setAttr("Name", name).
+			setAttr("NameFormat", attributeNameFormatBasic)
+		values, isList := value.([]interface{})
+		if !isList {
+			values = []interface{}{value}
+		}
+		for _, item := range values {
+			attribute.addChild(newElement("saml:AttributeValue").setText(fmt.Sprint(item)))
+		}
+		statement.addChild(attribute)
+	}
+
+	if len(statement.children) == 0 {
+		return nil
+	}
+	return statement
+}
+
+// newStatusElement creates the status element of a response, with an optional second-level status code.
+func newStatusElement(statusCode, subStatusCode string) *xmlElement {
+	code := newElement("samlp:StatusCode").setAttr("Value", statusCode)
+	if subStatusCode != "" {
+		code.addChild(newElement("samlp:StatusCode").setAttr("Value", subStatusCode))
+	}
+	return newElement("samlp:Status").addChild(code)
+}
+
+// generateMessageID generates a random identifier for a message or an assertion. Identifiers start with an
+// underscore since they must be valid XML names.
+func generateMessageID() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return "_" + hex.EncodeToString(b), nil
+}
+
+// withXMLHeader prepends the XML declaration to a rendered message.
+func withXMLHeader(message []byte) []byte {
+	return append([]byte(xmlHeader), message...)
+}
+
+// newRequestError creates the error of an invalid request.
+func newRequestError(message string) *SAMLError {
+	return &SAMLError{Code: oauth2const.ErrorInvalidRequest, Message: message}
+}
+
+// newServerError creates the error of a request that failed to be processed.
+func newServerError() *SAMLError {
+	return &SAMLError{Code: oauth2const.ErrorServerError, Message: "Failed to process SAML request"}
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+)
+
+// namespaceURIs maps the namespace prefixes used in the messages of the identity provider to their URIs.
+var namespaceURIs = map[string]string{
+	prefixProtocol:  nsProtocol,
+	prefixAssertion: nsAssertion,
+	prefixMetadata:  nsMetadata,
+	prefixDSig:      nsDSig,
+}
+
+// xmlElement is an element of a message issued by the identity provider. Messages are built as element trees and
+// rendered in the exclusive canonical form (http://www.w3.org/2001/10/xml-exc-c14n#), so that a rendered subtree
+// is byte for byte the input of its signature digest. Element names carry one of the known namespace prefixes and
+// attributes are unqualified.
+type xmlElement struct {
+	name     string
+	attrs    map[string]string
+	text     string
+	children []*xmlElement
+}
+
+// newElement creates an element with the given prefixed name.
+func newElement(name string) *xmlElement {
+	return &xmlElement{name: name, attrs: make(map[string]string)}
+}
+
+// setAttr sets an attribute of the element. Empty values are omitted.
+func (e *xmlElement) setAttr(name, value string) *xmlElement {
+	if value != "" {
+		e.attrs[name] = value
+	}
+	return e
+}
+
+// setText sets the text content of the element.
+func (e *xmlElement) setText(text string) *xmlElement {
+	e.text = text
+	return e
+}
+
+// addChild appends child elements to the element.
+func (e *xmlElement) addChild(children ...*xmlElement) *xmlElement {
+	e.children = append(e.children, children...)
+	return e
+}
+
+// insertChild inserts a child element at the given position.
+func (e *xmlElement) insertChild(index int, child *xmlElement) {
+	e.children = slices.Insert(e.children, index, child)
+}
+
+// render returns the exclusive canonical form of the element as the apex of a document subset.
+func (e *xmlElement) render() []byte {
+	var buf bytes.Buffer
+	e.renderTo(&buf, map[string]bool{})
+	return buf.Bytes()
+}
+
+// renderTo writes the canonical form of the element. The namespace prefix of the element is declared on it
+// unless an ancestor in the output already declared it.
+func (e *xmlElement) renderTo(buf *bytes.Buffer, declared map[string]bool) {
+	buf.WriteString("<" + e.name)
+
+	if prefix, _, ok := strings.Cut(e.name, ":"); ok && !declared[prefix] {
+		buf.WriteString(" xmlns:" + prefix + "=\"" + escapeAttr(namespaceURIs[prefix]) + "\"")
+		declared = mapWith(declared, prefix)
+	}
+
+	names := make([]string, 0, len(e.attrs))
+	for name := range e.attrs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		buf.WriteString(" " + name + "=\"" + escapeAttr(e.attrs[name]) + "\"")
+	}
+	buf.WriteString(">")
+
+	buf.WriteString(escapeText(e.text))
+	for _, child := range e.children {
+		child.renderTo(buf, declared)
+	}
+
+	buf.WriteString("</" + e.name + ">")
+}
+
+// mapWith returns a copy of the set of declared prefixes with the prefix added.
+func mapWith(declared map[string]bool, prefix string) map[string]bool {
+	result := make(map[string]bool, len(declared)+1)
+	for key := range declared {
+		result[key] = true
+	}
+	result[prefix] = true
+	return result
+}
+
+// textEscaper escapes text content as required by canonical XML.
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+// attrEscaper escapes attribute values as required by canonical XML.
+var attrEscaper = strings.NewReplacer(
+	"&", "&amp;", "<", "&lt;", "\"", "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+
+// escapeText escapes the text content of an element.
+func escapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+// escapeAttr escapes the value of an attribute.
+func escapeAttr(value string) string {
+	return attrEscaper.Replace(value)
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package saml
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type XMLTestSuite struct {
+	suite.Suite
+}
+
+func TestXMLTestSuite(t *testing.T) {
+	suite.Run(t, new(XMLTestSuite))
+}
+
+func (s *XMLTestSuite) TestRender_DeclaresNamespacesOnFirstUse() {
+	element := newElement("samlp:Response").setAttr("ID", "_1").addChild(
+		newElement("saml:Issuer").setText("idp"),
+		newElement("samlp:Status"),
+		newElement("saml:Assertion").addChild(newElement("saml:Issuer").setText("idp")),
+	)
+
+	s.Equal(`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="_1">`+
+		`<saml:Issuer xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion">idp</saml:Issuer>`+
+		`<samlp:Status></samlp:Status>`+
+		`<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion"><saml:Issuer>idp</saml:Issuer>`+
+		`</saml:Assertion></samlp:Response>`, string(element.render()))
+}
+
+func (s *XMLTestSuite) TestRender_SortsAttributesAndOmitsEmptyValues() {
+	element := newElement("saml:Conditions").
+		setAttr("NotOnOrAfter", "b").
+		setAttr("NotBefore", "a").
+		setAttr("Empty", "")
+
+	s.Equal(`<saml:Conditions xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" NotBefore="a" NotOnOrAfter="b">`+
+		`</saml:Conditions>`, string(element.render()))
+}
+
+func (s *XMLTestSuite) TestRender_EscapesTextAndAttributes() {
+	element := newElement("saml:AttributeValue").
+		setAttr("Name", "a\"b<c&d\n").
+		setText("<x> & \"y\"\r")
+
+	s.Equal(`<saml:AttributeValue xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" `+
+		`Name="a&quot;b&lt;c&amp;d&#xA;">&lt;x&gt; &amp; "y"&#xD;</saml:AttributeValue>`, string(element.render()))
+}
+
+func (s *XMLTestSuite) TestRender_IsWellFormed() {
+	element := newElement("saml:AttributeStatement").addChild(
+		newElement("saml:Attribute").setAttr("Name", "email").addChild(
+			newElement("saml:AttributeValue").setText("alice@example.com & co"),
+		),
+	)
+
+	var parsed struct {
+		Attribute struct {
+			Name  string `xml:"Name,attr"`
+			Value string `xml:"urn:oasis:names:tc:SAML:2.0:assertion AttributeValue"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Attribute"`
+	}
+	s.NoError(xml.Unmarshal(element.render(), &parsed))
+	s.Equal("email", parsed.Attribute.Name)
+	s.Equal("alice@example.com & co", parsed.Attribute.Value)
+}
+
+func (s *XMLTestSuite) TestInsertChild() {
+	element := newElement("saml:Assertion").addChild(newElement("saml:Issuer"), newElement("saml:Subject"))
+	element.insertChild(1, newElement("ds:Signature"))
+
+	s.Len(element.children, 3)
+	s.Equal("ds:Signature", element.children[1].name)
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for signing key operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SGK-1001",
+		Error: core.I18nMessage{
+			Key:          "error.signingkeyservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.signingkeyservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorUnsupportedAlgorithm is the error returned when the algorithm of the new key is not supported.
+	ErrorUnsupportedAlgorithm = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SGK-1002",
+		Error: core.I18nMessage{
+			Key:          "error.signingkeyservice.unsupported_algorithm",
+			DefaultValue: "Unsupported key algorithm",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.signingkeyservice.unsupported_algorithm_description",
+			DefaultValue: "The key algorithm must be one of RSA, P-256, P-384, P-521 or Ed25519",
+		},
+	}
+
+	// ErrorInvalidRolloverWindow is the error returned when the rollover window is negative.
+	ErrorInvalidRolloverWindow = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SGK-1003",
+		Error: core.I18nMessage{
+			Key:          "error.signingkeyservice.invalid_rollover_window",
+			DefaultValue: "Invalid rollover window",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.signingkeyservice.invalid_rollover_window_description",
+			DefaultValue: "The rollover window must be a non-negative number of seconds",
+		},
+	}
+)
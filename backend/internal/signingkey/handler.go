@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// signingKeyHandler defines the handler for the signing key API.
+type signingKeyHandler struct {
+	service SigningKeyServiceInterface
+	logger  *log.Logger
+}
+
+func newSigningKeyHandler(service SigningKeyServiceInterface) *signingKeyHandler {
+	return &signingKeyHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SigningKeyHandler")),
+	}
+}
+
+// HandleSigningKeyListRequest handles the request to list the keys published at the JWKS endpoint.
+func (h *signingKeyHandler) HandleSigningKeyListRequest(w http.ResponseWriter, r *http.Request) {
+	result, svcErr := h.service.ListSigningKeys(r.Context())
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, result)
+}
+
+// HandleSigningKeyRotateRequest handles the request to rotate the signing key.
+func (h *signingKeyHandler) HandleSigningKeyRotateRequest(w http.ResponseWriter, r *http.Request) {
+	request := &RotateSigningKeyRequest{}
+	if r.ContentLength != 0 {
+		decoded, err := sysutils.DecodeJSONBody[RotateSigningKeyRequest](r)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidRequestFormat)
+			return
+		}
+		request = decoded
+	}
+
+	signingKey, svcErr := h.service.RotateSigningKey(r.Context(), request)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, signingKey)
+}
+
+// handleError handles service errors and sends appropriate HTTP responses.
+func (h *signingKeyHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case serviceerror.ErrorUnauthorized.Code:
+			statusCode = http.StatusForbidden
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	if statusCode == http.StatusInternalServerError {
+		h.logger.Error("Signing key request failed with server error",
+			log.String("code", svcErr.Code),
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	serviceMock *SigningKeyServiceInterfaceMock
+	handler     *signingKeyHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.serviceMock = NewSigningKeyServiceInterfaceMock(suite.T())
+	suite.handler = newSigningKeyHandler(suite.serviceMock)
+}
+
+func (suite *HandlerTestSuite) TestListSigningKeys() {
+	suite.serviceMock.EXPECT().ListSigningKeys(mock.Anything).Return(&SigningKeyList{
+		TotalResults: 1,
+		Keys:         []SigningKey{{ID: testConfigKeyID, Status: KeyStatusActive, Source: KeySourceConfig}},
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/signing-keys", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleSigningKeyListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var list SigningKeyList
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &list))
+	suite.Equal(1, list.TotalResults)
+	suite.Equal(testConfigKeyID, list.Keys[0].ID)
+}
+
+func (suite *HandlerTestSuite) TestListSigningKeys_ServerError() {
+	suite.serviceMock.EXPECT().ListSigningKeys(mock.Anything).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/signing-keys", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleSigningKeyListRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(serviceerror.InternalServerError.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestRotateSigningKey() {
+	rolloverWindow := int64(600)
+	suite.serviceMock.EXPECT().RotateSigningKey(mock.Anything,
+		&RotateSigningKeyRequest{Algorithm: "P-256", RolloverWindow: &rolloverWindow}).
+		Return(&SigningKey{ID: testGeneratedKeyID, Status: KeyStatusActive}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/signing-keys/rotate",
+		strings.NewReader(`{"algorithm":"P-256","rolloverWindow":600}`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleSigningKeyRotateRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+
+	var signingKey SigningKey
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &signingKey))
+	suite.Equal(testGeneratedKeyID, signingKey.ID)
+}
+
+func (suite *HandlerTestSuite) TestRotateSigningKey_EmptyBody() {
+	suite.serviceMock.EXPECT().RotateSigningKey(mock.Anything, &RotateSigningKeyRequest{}).
+		Return(&SigningKey{ID: testGeneratedKeyID, Status: KeyStatusActive}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/signing-keys/rotate", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleSigningKeyRotateRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+}
+
+func (suite *HandlerTestSuite) TestRotateSigningKey_Errors() {
+	req := httptest.NewRequest(http.MethodPost, "/signing-keys/rotate", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleSigningKeyRotateRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+
+	suite.serviceMock.EXPECT().RotateSigningKey(mock.Anything, mock.Anything).
+		Return(nil, &ErrorUnsupportedAlgorithm).Once()
+
+	req = httptest.NewRequest(http.MethodPost, "/signing-keys/rotate", strings.NewReader(`{"algorithm":"DSA"}`))
+	rr = httptest.NewRecorder()
+
+	suite.handler.HandleSigningKeyRotateRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorUnsupportedAlgorithm.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestOptionsRequests() {
+	mux := http.NewServeMux()
+	registerRoutes(mux, suite.handler)
+
+	for _, target := range []string{"/signing-keys", "/signing-keys/rotate"} {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodOptions, target, nil))
+		suite.Equal(http.StatusNoContent, rr.Code)
+	}
+}
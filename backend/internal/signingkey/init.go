@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+)
+
+// signingKeysPath is the path of the signing key API.
+const signingKeysPath = "/signing-keys"
+
+// Initialize creates the signing key service, loads the keys generated by earlier rotations into the PKI service,
+// registers the signing key API with the provided mux and returns the service along with the job deleting the
+// retired keys, which is to be run by the scheduler.
+func Initialize(
+	mux *http.ServeMux,
+	pkiService pkiservice.PKIServiceInterface,
+	cryptoProvider kmprovider.ConfigCryptoProvider,
+) (SigningKeyServiceInterface, scheduler.Job, error) {
+	store, transactioner, err := newSigningKeyStore()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rolloverWindow := config.GetServerRuntime().Config.Crypto.KeyRotation.RolloverWindow
+	service := newSigningKeyService(store, transactioner, pkiService, cryptoProvider, rolloverWindow)
+	service.start()
+
+	registerRoutes(mux, newSigningKeyHandler(service))
+
+	return service, newSigningKeyCleanupJob(store), nil
+}
+
+// registerRoutes registers the HTTP routes of the signing key API.
+func registerRoutes(mux *http.ServeMux, handler *signingKeyHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+signingKeysPath, handler.HandleSigningKeyListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+signingKeysPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("POST "+signingKeysPath+"/rotate", handler.HandleSigningKeyRotateRequest,
+		opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+signingKeysPath+"/rotate",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
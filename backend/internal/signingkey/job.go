@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// SigningKeyCleanupJobName is the name of the job deleting the retired signing keys.
+	SigningKeyCleanupJobName = "signing-key-cleanup"
+	// signingKeyCleanupJobSchedule is the default schedule of the job deleting the retired signing keys.
+	signingKeyCleanupJobSchedule = "@every 1h"
+)
+
+// signingKeyCleanupJob deletes the signing keys whose rollover window has ended. It implements scheduler.Job.
+type signingKeyCleanupJob struct {
+	store  signingKeyStoreInterface
+	logger *log.Logger
+}
+
+// newSigningKeyCleanupJob returns a new signing key cleanup job.
+func newSigningKeyCleanupJob(store signingKeyStoreInterface) *signingKeyCleanupJob {
+	return &signingKeyCleanupJob{
+		store:  store,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SigningKeyCleanupJob")),
+	}
+}
+
+func (j *signingKeyCleanupJob) Name() string {
+	return SigningKeyCleanupJobName
+}
+
+func (j *signingKeyCleanupJob) Description() string {
+	return "Deletes the signing keys retired at the end of their rollover window"
+}
+
+func (j *signingKeyCleanupJob) DefaultSchedule() string {
+	return signingKeyCleanupJobSchedule
+}
+
+// Run deletes the retired signing keys. The keys are removed from the JWKS endpoint of each node when they are
+// retired, so deleting them only cleans up the database.
+func (j *signingKeyCleanupJob) Run(ctx context.Context) (string, error) {
+	deleted, err := j.store.deleteRetiredSigningKeys(ctx, time.Now().UTC())
+	if err != nil {
+		return "", fmt.Errorf("failed to delete the retired signing keys: %w", err)
+	}
+	if deleted > 0 {
+		j.logger.Info("Retired signing keys deleted", log.Int("count", int(deleted)))
+	}
+
+	return fmt.Sprintf("Deleted %d retired signing keys", deleted), nil
+}
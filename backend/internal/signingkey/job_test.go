@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SigningKeyCleanupJobTestSuite struct {
+	suite.Suite
+	mockStore *signingKeyStoreInterfaceMock
+	job       *signingKeyCleanupJob
+}
+
+func TestSigningKeyCleanupJobTestSuite(t *testing.T) {
+	suite.Run(t, new(SigningKeyCleanupJobTestSuite))
+}
+
+func (suite *SigningKeyCleanupJobTestSuite) SetupTest() {
+	suite.mockStore = newSigningKeyStoreInterfaceMock(suite.T())
+	suite.job = newSigningKeyCleanupJob(suite.mockStore)
+}
+
+func (suite *SigningKeyCleanupJobTestSuite) TestJobDetails() {
+	suite.Equal(SigningKeyCleanupJobName, suite.job.Name())
+	suite.NotEmpty(suite.job.Description())
+	suite.Equal(signingKeyCleanupJobSchedule, suite.job.DefaultSchedule())
+}
+
+func (suite *SigningKeyCleanupJobTestSuite) TestRun() {
+	suite.mockStore.EXPECT().deleteRetiredSigningKeys(mock.Anything, mock.Anything).Return(int64(2), nil).Once()
+
+	summary, err := suite.job.Run(context.Background())
+	suite.NoError(err)
+	suite.Equal("Deleted 2 retired signing keys", summary)
+}
+
+func (suite *SigningKeyCleanupJobTestSuite) TestRun_StoreError() {
+	suite.mockStore.EXPECT().deleteRetiredSigningKeys(mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("db err")).Once()
+
+	summary, err := suite.job.Run(context.Background())
+	suite.Error(err)
+	suite.Empty(summary)
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import "time"
+
+// KeyStatus represents the status of a signing key.
+type KeyStatus string
+
+const (
+	// KeyStatusActive indicates that the key signs the tokens issued by the server.
+	KeyStatusActive KeyStatus = "ACTIVE"
+	// KeyStatusInactive indicates that the key no longer signs tokens but is still published so that the tokens
+	// it signed can be verified.
+	KeyStatusInactive KeyStatus = "INACTIVE"
+)
+
+// KeySource represents where a signing key is loaded from.
+type KeySource string
+
+const (
+	// KeySourceConfig indicates that the key is loaded from the key files in the server configuration.
+	KeySourceConfig KeySource = "CONFIG"
+	// KeySourceGenerated indicates that the key was generated by a key rotation.
+	KeySourceGenerated KeySource = "GENERATED"
+)
+
+// SigningKey represents a key published at the JWKS endpoint.
+type SigningKey struct {
+	ID        string     `json:"id"`
+	Kid       string     `json:"kid"`
+	Algorithm string     `json:"algorithm"`
+	Status    KeyStatus  `json:"status"`
+	Source    KeySource  `json:"source"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	RetireAt  *time.Time `json:"retireAt,omitempty"`
+}
+
+// SigningKeyList represents the list of the keys published at the JWKS endpoint.
+type SigningKeyList struct {
+	TotalResults int          `json:"totalResults"`
+	Keys         []SigningKey `json:"keys"`
+}
+
+// RotateSigningKeyRequest represents the request body for rotating the signing key.
+type RotateSigningKeyRequest struct {
+	// Algorithm is the algorithm of the new key. The algorithm of the current signing key is used when empty.
+	Algorithm string `json:"algorithm"`
+	// RolloverWindow is the number of seconds the current signing key stays published after the rotation. The
+	// configured rollover window is used when not set.
+	RolloverWindow *int64 `json:"rolloverWindow"`
+}
+
+// signingKeyRecord represents a generated signing key as stored in the database. The private key is encrypted.
+type signingKeyRecord struct {
+	ID          string
+	Algorithm   string
+	Certificate string
+	PrivateKey  string
+	Status      KeyStatus
+	CreatedAt   time.Time
+	RetireAt    *time.Time
+}
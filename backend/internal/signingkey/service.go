@@ -0,0 +1,360 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package signingkey provides the API to rotate the key signing the tokens issued by the server. The previous
+// key stays published at the JWKS endpoint for a rollover window, after which it is retired.
+package signingkey
+
+import (
+	"context"
+	"crypto/tls"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// certificateValidity is the validity period of the self-signed certificates of the generated keys.
+	certificateValidity = 10 * 365 * 24 * time.Hour
+	// syncInterval is the interval at which each node loads the keys rotated by other nodes of the cluster.
+	syncInterval = time.Minute
+)
+
+// supportedAlgorithms lists the algorithms of the keys that can be generated by a rotation.
+var supportedAlgorithms = map[pkiservice.PKIAlgorithm]bool{
+	pkiservice.RSA:     true,
+	pkiservice.P256:    true,
+	pkiservice.P384:    true,
+	pkiservice.P521:    true,
+	pkiservice.Ed25519: true,
+}
+
+// SigningKeyServiceInterface defines the interface for managing the keys signing the tokens issued by the server.
+type SigningKeyServiceInterface interface {
+	ListSigningKeys(ctx context.Context) (*SigningKeyList, *serviceerror.ServiceError)
+	RotateSigningKey(ctx context.Context, request *RotateSigningKeyRequest) (
+		*SigningKey, *serviceerror.ServiceError)
+}
+
+// signingKeyService implements SigningKeyServiceInterface.
+type signingKeyService struct {
+	store          signingKeyStoreInterface
+	transactioner  transaction.Transactioner
+	pkiService     pkiservice.PKIServiceInterface
+	cryptoProvider kmprovider.ConfigCryptoProvider
+	rolloverWindow int64
+	// mu guards loadedKeys, which holds the IDs of the generated keys loaded into the PKI service.
+	mu         sync.Mutex
+	loadedKeys map[string]struct{}
+	logger     *log.Logger
+}
+
+// newSigningKeyService returns a new instance of signingKeyService.
+func newSigningKeyService(
+	store signingKeyStoreInterface,
+	transactioner transaction.Transactioner,
+	pkiService pkiservice.PKIServiceInterface,
+	cryptoProvider kmprovider.ConfigCryptoProvider,
+	rolloverWindow int64,
+) *signingKeyService {
+	return &signingKeyService{
+		store:          store,
+		transactioner:  transactioner,
+		pkiService:     pkiService,
+		cryptoProvider: cryptoProvider,
+		rolloverWindow: rolloverWindow,
+		loadedKeys:     make(map[string]struct{}),
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SigningKeyService")),
+	}
+}
+
+// ListSigningKeys lists the keys published at the JWKS endpoint by this node.
+func (s *signingKeyService) ListSigningKeys(ctx context.Context) (*SigningKeyList, *serviceerror.ServiceError) {
+	records, err := s.store.listSigningKeys(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list the signing keys", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	certificates, svcErr := s.pkiService.GetAllX509Certificates()
+	if svcErr != nil {
+		s.logger.Error("Failed to retrieve the published certificates", log.String("code", svcErr.Code))
+		return nil, &serviceerror.InternalServerError
+	}
+	signingKeyID := s.pkiService.GetSigningKeyID()
+
+	generated := make(map[string]signingKeyRecord, len(records))
+	for _, record := range records {
+		generated[record.ID] = record
+	}
+	configured := make([]string, 0, len(certificates))
+	for id := range certificates {
+		if _, ok := generated[id]; !ok {
+			configured = append(configured, id)
+		}
+	}
+	sort.Strings(configured)
+
+	keys := make([]SigningKey, 0, len(certificates))
+	for _, id := range configured {
+		algorithm := ""
+		if privateKey, svcErr := s.pkiService.GetPrivateKey(id); svcErr == nil {
+			if keyAlgorithm, err := pkiservice.GetKeyAlgorithm(privateKey); err == nil {
+				algorithm = string(keyAlgorithm)
+			}
+		}
+		keys = append(keys, SigningKey{
+			ID:        id,
+			Kid:       s.pkiService.GetCertThumbprint(id),
+			Algorithm: algorithm,
+			Status:    getKeyStatus(id, signingKeyID),
+			Source:    KeySourceConfig,
+		})
+	}
+	for _, record := range records {
+		if _, published := certificates[record.ID]; !published {
+			continue
+		}
+		createdAt := record.CreatedAt
+		keys = append(keys, SigningKey{
+			ID:        record.ID,
+			Kid:       s.pkiService.GetCertThumbprint(record.ID),
+			Algorithm: record.Algorithm,
+			Status:    getKeyStatus(record.ID, signingKeyID),
+			Source:    KeySourceGenerated,
+			CreatedAt: &createdAt,
+			RetireAt:  record.RetireAt,
+		})
+	}
+
+	return &SigningKeyList{
+		TotalResults: len(keys),
+		Keys:         keys,
+	}, nil
+}
+
+// RotateSigningKey generates a new key and makes it the signing key. The previous signing key stays published
+// for the rollover window so that the tokens it signed can still be verified, and is retired afterwards.
+func (s *signingKeyService) RotateSigningKey(ctx context.Context, request *RotateSigningKeyRequest) (
+	*SigningKey, *serviceerror.ServiceError) {
+	if request == nil {
+		return nil, &ErrorInvalidRequestFormat
+	}
+	rolloverWindow := s.rolloverWindow
+	if request.RolloverWindow != nil {
+		if *request.RolloverWindow < 0 {
+			return nil, &ErrorInvalidRolloverWindow
+		}
+		rolloverWindow = *request.RolloverWindow
+	}
+
+	algorithm := pkiservice.PKIAlgorithm(strings.TrimSpace(request.Algorithm))
+	if algorithm == "" {
+		currentAlgorithm, svcErr := s.getSigningKeyAlgorithm()
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		algorithm = currentAlgorithm
+	}
+	if !supportedAlgorithms[algorithm] {
+		return nil, &ErrorUnsupportedAlgorithm
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID for the signing key", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	keyPair, err := pkiservice.GenerateKeyPair(algorithm, id, certificateValidity)
+	if err != nil {
+		s.logger.Error("Failed to generate the signing key", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	certPEM, keyPEM, err := pkiservice.EncodeKeyPair(keyPair)
+	if err != nil {
+		s.logger.Error("Failed to encode the signing key", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	encryptedKey, err := s.cryptoProvider.Encrypt(ctx, keyPEM)
+	if err != nil {
+		s.logger.Error("Failed to encrypt the signing key", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	now := time.Now().UTC()
+	record := signingKeyRecord{
+		ID:          id,
+		Algorithm:   string(algorithm),
+		Certificate: string(certPEM),
+		PrivateKey:  string(encryptedKey),
+		Status:      KeyStatusActive,
+		CreatedAt:   now,
+	}
+	retireAt := now.Add(time.Duration(rolloverWindow) * time.Second)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err = s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if err := s.store.deactivateSigningKeys(txCtx, retireAt); err != nil {
+			return err
+		}
+		return s.store.createSigningKey(txCtx, record)
+	})
+	if err != nil {
+		s.logger.Error("Failed to store the signing key", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	if err := s.pkiService.AddKey(id, keyPair); err != nil {
+		s.logger.Error("Failed to load the signing key", log.String("keyId", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	s.loadedKeys[id] = struct{}{}
+	previousKeyID := s.pkiService.GetSigningKeyID()
+	if err := s.pkiService.SetSigningKeyID(id); err != nil {
+		s.logger.Error("Failed to switch to the signing key", log.String("keyId", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Info("Signing key rotated", log.String("keyId", id), log.String("previousKeyId", previousKeyID),
+		log.Any("retireAt", retireAt))
+
+	return &SigningKey{
+		ID:        id,
+		Kid:       s.pkiService.GetCertThumbprint(id),
+		Algorithm: string(algorithm),
+		Status:    KeyStatusActive,
+		Source:    KeySourceGenerated,
+		CreatedAt: &now,
+	}, nil
+}
+
+// getSigningKeyAlgorithm returns the algorithm of the current signing key.
+func (s *signingKeyService) getSigningKeyAlgorithm() (pkiservice.PKIAlgorithm, *serviceerror.ServiceError) {
+	signingKeyID := s.pkiService.GetSigningKeyID()
+	privateKey, svcErr := s.pkiService.GetPrivateKey(signingKeyID)
+	if svcErr != nil {
+		s.logger.Error("Failed to retrieve the signing key", log.String("keyId", signingKeyID),
+			log.String("code", svcErr.Code))
+		return "", &serviceerror.InternalServerError
+	}
+	algorithm, err := pkiservice.GetKeyAlgorithm(privateKey)
+	if err != nil {
+		s.logger.Error("Failed to determine the algorithm of the signing key", log.Error(err))
+		return "", &serviceerror.InternalServerError
+	}
+	return algorithm, nil
+}
+
+// start loads the generated keys and then reloads them at the sync interval in a background routine, so that the
+// keys rotated and retired by any node of the cluster are picked up by this node.
+func (s *signingKeyService) start() {
+	s.syncSigningKeys(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(syncInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			s.syncSigningKeys(context.Background())
+		}
+	}()
+}
+
+// syncSigningKeys loads the generated keys that are not retired into the PKI service, makes the active key the
+// signing key and removes the retired keys from the PKI service.
+func (s *signingKeyService) syncSigningKeys(ctx context.Context) {
+	records, err := s.store.listSigningKeys(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list the signing keys", log.Error(err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	published := make(map[string]struct{}, len(records))
+	activeKeyID := ""
+	for _, record := range records {
+		if record.RetireAt != nil && !record.RetireAt.After(now) {
+			continue
+		}
+		if _, loaded := s.loadedKeys[record.ID]; !loaded {
+			keyPair, err := s.decodeKeyPair(ctx, record)
+			if err == nil {
+				err = s.pkiService.AddKey(record.ID, keyPair)
+			}
+			if err != nil {
+				s.logger.Error("Failed to load the signing key", log.String("keyId", record.ID), log.Error(err))
+				continue
+			}
+			s.loadedKeys[record.ID] = struct{}{}
+		}
+		published[record.ID] = struct{}{}
+		if record.Status == KeyStatusActive {
+			activeKeyID = record.ID
+		}
+	}
+
+	if activeKeyID != "" && s.pkiService.GetSigningKeyID() != activeKeyID {
+		if err := s.pkiService.SetSigningKeyID(activeKeyID); err != nil {
+			s.logger.Error("Failed to switch to the signing key", log.String("keyId", activeKeyID),
+				log.Error(err))
+		} else {
+			s.logger.Info("Switched to the rotated signing key", log.String("keyId", activeKeyID))
+		}
+	}
+
+	for id := range s.loadedKeys {
+		if _, ok := published[id]; ok {
+			continue
+		}
+		if err := s.pkiService.RemoveKey(id); err != nil {
+			s.logger.Error("Failed to retire the signing key", log.String("keyId", id), log.Error(err))
+			continue
+		}
+		delete(s.loadedKeys, id)
+		s.logger.Info("Signing key retired", log.String("keyId", id))
+	}
+}
+
+// decodeKeyPair decrypts the private key of a generated key and decodes the key pair.
+func (s *signingKeyService) decodeKeyPair(ctx context.Context, record signingKeyRecord) (tls.Certificate, error) {
+	keyPEM, err := s.cryptoProvider.Decrypt(ctx, []byte(record.PrivateKey))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.X509KeyPair([]byte(record.Certificate), keyPEM)
+}
+
+// getKeyStatus returns the status of a published key.
+func getKeyStatus(id, signingKeyID string) KeyStatus {
+	if id == signingKeyID {
+		return KeyStatusActive
+	}
+	return KeyStatusInactive
+}
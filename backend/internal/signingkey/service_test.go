@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/pki/pkimock"
+)
+
+const (
+	testConfigKeyID       = "default-key"
+	testGeneratedKeyID    = "generated-key"
+	testRolloverWindow    = int64(3600)
+	testEncryptedKeyValue = `{"alg":"AESGCM","ciphertext":"encrypted"}`
+)
+
+type SigningKeyServiceTestSuite struct {
+	suite.Suite
+	mockStore  *signingKeyStoreInterfaceMock
+	mockPKI    *pkimock.PKIServiceInterfaceMock
+	mockCrypto *cryptomock.ConfigCryptoProviderMock
+	service    *signingKeyService
+}
+
+func TestSigningKeyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SigningKeyServiceTestSuite))
+}
+
+func (suite *SigningKeyServiceTestSuite) SetupTest() {
+	suite.mockStore = newSigningKeyStoreInterfaceMock(suite.T())
+	suite.mockPKI = pkimock.NewPKIServiceInterfaceMock(suite.T())
+	suite.mockCrypto = cryptomock.NewConfigCryptoProviderMock(suite.T())
+	suite.service = newSigningKeyService(suite.mockStore, transaction.NewNoOpTransactioner(), suite.mockPKI,
+		suite.mockCrypto, testRolloverWindow)
+}
+
+// newTestRecord returns a stored signing key along with the decrypted PEM encoding of its private key.
+func (suite *SigningKeyServiceTestSuite) newTestRecord(id string, status KeyStatus, retireAt *time.Time) (
+	signingKeyRecord, []byte) {
+	keyPair, err := pkiservice.GenerateKeyPair(pkiservice.P256, id, time.Hour)
+	suite.Require().NoError(err)
+	certPEM, keyPEM, err := pkiservice.EncodeKeyPair(keyPair)
+	suite.Require().NoError(err)
+
+	return signingKeyRecord{
+		ID:          id,
+		Algorithm:   string(pkiservice.P256),
+		Certificate: string(certPEM),
+		PrivateKey:  testEncryptedKeyValue,
+		Status:      status,
+		CreatedAt:   time.Now().UTC().Add(-time.Hour),
+		RetireAt:    retireAt,
+	}, keyPEM
+}
+
+func (suite *SigningKeyServiceTestSuite) TestRotateSigningKey_DefaultsToCurrentAlgorithm() {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+
+	suite.mockPKI.EXPECT().GetSigningKeyID().Return(testConfigKeyID)
+	suite.mockPKI.EXPECT().GetPrivateKey(testConfigKeyID).Return(rsaKey, nil).Once()
+	suite.mockCrypto.EXPECT().Encrypt(mock.Anything, mock.Anything).
+		Return([]byte(testEncryptedKeyValue), nil).Once()
+
+	before := time.Now().UTC()
+	suite.mockStore.EXPECT().deactivateSigningKeys(mock.Anything, mock.MatchedBy(func(retireAt time.Time) bool {
+		return !retireAt.Before(before.Add(time.Duration(testRolloverWindow) * time.Second))
+	})).Return(nil).Once()
+
+	var newKeyID string
+	suite.mockStore.EXPECT().createSigningKey(mock.Anything, mock.MatchedBy(func(record signingKeyRecord) bool {
+		newKeyID = record.ID
+		return record.Algorithm == string(pkiservice.RSA) && record.Status == KeyStatusActive &&
+			record.PrivateKey == testEncryptedKeyValue && record.Certificate != ""
+	})).Return(nil).Once()
+	suite.mockPKI.EXPECT().AddKey(mock.Anything, mock.MatchedBy(func(keyPair tls.Certificate) bool {
+		_, ok := keyPair.PrivateKey.(*rsa.PrivateKey)
+		return ok
+	})).Return(nil).Once()
+	suite.mockPKI.EXPECT().SetSigningKeyID(mock.Anything).Return(nil).Once()
+	suite.mockPKI.EXPECT().GetCertThumbprint(mock.Anything).Return("new-kid").Once()
+
+	signingKey, svcErr := suite.service.RotateSigningKey(context.Background(), &RotateSigningKeyRequest{})
+	suite.Require().Nil(svcErr)
+	suite.Equal(newKeyID, signingKey.ID)
+	suite.Equal("new-kid", signingKey.Kid)
+	suite.Equal(string(pkiservice.RSA), signingKey.Algorithm)
+	suite.Equal(KeyStatusActive, signingKey.Status)
+	suite.Equal(KeySourceGenerated, signingKey.Source)
+	suite.Contains(suite.service.loadedKeys, newKeyID)
+}
+
+func (suite *SigningKeyServiceTestSuite) TestRotateSigningKey_WithAlgorithmAndRolloverWindow() {
+	rolloverWindow := int64(0)
+	suite.mockPKI.EXPECT().GetSigningKeyID().Return(testConfigKeyID)
+	suite.mockCrypto.EXPECT().Encrypt(mock.Anything, mock.Anything).
+		Return([]byte(testEncryptedKeyValue), nil).Once()
+	suite.mockStore.EXPECT().deactivateSigningKeys(mock.Anything, mock.MatchedBy(func(retireAt time.Time) bool {
+		return !retireAt.After(time.Now().UTC())
+	})).Return(nil).Once()
+	suite.mockStore.EXPECT().createSigningKey(mock.Anything, mock.MatchedBy(func(record signingKeyRecord) bool {
+		return record.Algorithm == string(pkiservice.Ed25519)
+	})).Return(nil).Once()
+	suite.mockPKI.EXPECT().AddKey(mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockPKI.EXPECT().SetSigningKeyID(mock.Anything).Return(nil).Once()
+	suite.mockPKI.EXPECT().GetCertThumbprint(mock.Anything).Return("new-kid").Once()
+
+	signingKey, svcErr := suite.service.RotateSigningKey(context.Background(), &RotateSigningKeyRequest{
+		Algorithm:      string(pkiservice.Ed25519),
+		RolloverWindow: &rolloverWindow,
+	})
+	suite.Require().Nil(svcErr)
+	suite.Equal(string(pkiservice.Ed25519), signingKey.Algorithm)
+}
+
+func (suite *SigningKeyServiceTestSuite) TestRotateSigningKey_InvalidRequest() {
+	negativeWindow := int64(-1)
+	testCases := []struct {
+		name        string
+		request     *RotateSigningKeyRequest
+		expectedErr *serviceerror.ServiceError
+	}{
+		{"NilRequest", nil, &ErrorInvalidRequestFormat},
+		{"NegativeRolloverWindow", &RotateSigningKeyRequest{RolloverWindow: &negativeWindow},
+			&ErrorInvalidRolloverWindow},
+		{"UnsupportedAlgorithm", &RotateSigningKeyRequest{Algorithm: "DSA"}, &ErrorUnsupportedAlgorithm},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			signingKey, svcErr := suite.service.RotateSigningKey(context.Background(), tc.request)
+			suite.Nil(signingKey)
+			suite.Equal(tc.expectedErr, svcErr)
+		})
+	}
+}
+
+func (suite *SigningKeyServiceTestSuite) TestRotateSigningKey_StoreError() {
+	suite.mockCrypto.EXPECT().Encrypt(mock.Anything, mock.Anything).
+		Return([]byte(testEncryptedKeyValue), nil).Once()
+	suite.mockStore.EXPECT().deactivateSigningKeys(mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockStore.EXPECT().createSigningKey(mock.Anything, mock.Anything).Return(errors.New("db err")).Once()
+
+	signingKey, svcErr := suite.service.RotateSigningKey(context.Background(),
+		&RotateSigningKeyRequest{Algorithm: string(pkiservice.P256)})
+	suite.Nil(signingKey)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+	suite.Empty(suite.service.loadedKeys)
+}
+
+func (suite *SigningKeyServiceTestSuite) TestRotateSigningKey_EncryptError() {
+	suite.mockCrypto.EXPECT().Encrypt(mock.Anything, mock.Anything).Return(nil, errors.New("encrypt err")).Once()
+
+	signingKey, svcErr := suite.service.RotateSigningKey(context.Background(),
+		&RotateSigningKeyRequest{Algorithm: string(pkiservice.P256)})
+	suite.Nil(signingKey)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *SigningKeyServiceTestSuite) TestListSigningKeys() {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	retireAt := time.Now().UTC().Add(time.Hour)
+	retiring, _ := suite.newTestRecord("retiring-key", KeyStatusInactive, &retireAt)
+	active, _ := suite.newTestRecord(testGeneratedKeyID, KeyStatusActive, nil)
+
+	suite.mockStore.EXPECT().listSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{retiring, active}, nil).Once()
+	suite.mockPKI.EXPECT().GetAllX509Certificates().Return(map[string]*x509.Certificate{
+		testConfigKeyID:    {},
+		testGeneratedKeyID: {},
+	}, nil).Once()
+	suite.mockPKI.EXPECT().GetSigningKeyID().Return(testGeneratedKeyID).Once()
+	suite.mockPKI.EXPECT().GetPrivateKey(testConfigKeyID).Return(rsaKey, nil).Once()
+	suite.mockPKI.EXPECT().GetCertThumbprint(testConfigKeyID).Return("config-kid").Once()
+	suite.mockPKI.EXPECT().GetCertThumbprint(testGeneratedKeyID).Return("generated-kid").Once()
+
+	list, svcErr := suite.service.ListSigningKeys(context.Background())
+	suite.Require().Nil(svcErr)
+	suite.Equal(2, list.TotalResults)
+	suite.Equal(SigningKey{
+		ID:        testConfigKeyID,
+		Kid:       "config-kid",
+		Algorithm: string(pkiservice.RSA),
+		Status:    KeyStatusInactive,
+		Source:    KeySourceConfig,
+	}, list.Keys[0])
+	suite.Equal(testGeneratedKeyID, list.Keys[1].ID)
+	suite.Equal("generated-kid", list.Keys[1].Kid)
+	suite.Equal(KeyStatusActive, list.Keys[1].Status)
+	suite.Equal(KeySourceGenerated, list.Keys[1].Source)
+	suite.NotNil(list.Keys[1].CreatedAt)
+}
+
+func (suite *SigningKeyServiceTestSuite) TestListSigningKeys_StoreError() {
+	suite.mockStore.EXPECT().listSigningKeys(mock.Anything).Return(nil, errors.New("db err")).Once()
+
+	list, svcErr := suite.service.ListSigningKeys(context.Background())
+	suite.Nil(list)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *SigningKeyServiceTestSuite) TestSyncSigningKeys() {
+	expired := time.Now().UTC().Add(-time.Minute)
+	retiring := time.Now().UTC().Add(time.Hour)
+	retiredRecord, _ := suite.newTestRecord("retired-key", KeyStatusInactive, &expired)
+	retiringRecord, retiringKeyPEM := suite.newTestRecord("retiring-key", KeyStatusInactive, &retiring)
+	activeRecord, activeKeyPEM := suite.newTestRecord(testGeneratedKeyID, KeyStatusActive, nil)
+	suite.service.loadedKeys["retired-key"] = struct{}{}
+	suite.service.loadedKeys["deleted-key"] = struct{}{}
+
+	suite.mockStore.EXPECT().listSigningKeys(mock.Anything).
+		Return([]signingKeyRecord{retiredRecord, retiringRecord, activeRecord}, nil).Once()
+	suite.mockCrypto.EXPECT().Decrypt(mock.Anything, []byte(testEncryptedKeyValue)).
+		Return(retiringKeyPEM, nil).Once()
+	suite.mockCrypto.EXPECT().Decrypt(mock.Anything, []byte(testEncryptedKeyValue)).
+		Return(activeKeyPEM, nil).Once()
+	suite.mockPKI.EXPECT().AddKey("retiring-key", mock.Anything).Return(nil).Once()
+	suite.mockPKI.EXPECT().AddKey(testGeneratedKeyID, mock.Anything).Return(nil).Once()
+	suite.mockPKI.EXPECT().GetSigningKeyID().Return(testConfigKeyID).Once()
+	suite.mockPKI.EXPECT().SetSigningKeyID(testGeneratedKeyID).Return(nil).Once()
+	suite.mockPKI.EXPECT().RemoveKey("retired-key").Return(nil).Once()
+	suite.mockPKI.EXPECT().RemoveKey("deleted-key").Return(nil).Once()
+
+	suite.service.syncSigningKeys(context.Background())
+
+	suite.Equal(map[string]struct{}{"retiring-key": {}, testGeneratedKeyID: {}}, suite.service.loadedKeys)
+}
+
+func (suite *SigningKeyServiceTestSuite) TestSyncSigningKeys_SkipsLoadedKeys() {
+	activeRecord, _ := suite.newTestRecord(testGeneratedKeyID, KeyStatusActive, nil)
+	suite.service.loadedKeys[testGeneratedKeyID] = struct{}{}
+
+	suite.mockStore.EXPECT().listSigningKeys(mock.Anything).Return([]signingKeyRecord{activeRecord}, nil).Once()
+	suite.mockPKI.EXPECT().GetSigningKeyID().Return(testGeneratedKeyID).Once()
+
+	suite.service.syncSigningKeys(context.Background())
+
+	suite.Contains(suite.service.loadedKeys, testGeneratedKeyID)
+}
+
+func (suite *SigningKeyServiceTestSuite) TestSyncSigningKeys_DecryptError() {
+	activeRecord, _ := suite.newTestRecord(testGeneratedKeyID, KeyStatusActive, nil)
+
+	suite.mockStore.EXPECT().listSigningKeys(mock.Anything).Return([]signingKeyRecord{activeRecord}, nil).Once()
+	suite.mockCrypto.EXPECT().Decrypt(mock.Anything, mock.Anything).Return(nil, errors.New("decrypt err")).Once()
+
+	suite.service.syncSigningKeys(context.Background())
+
+	suite.Empty(suite.service.loadedKeys)
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+// signingKeyStoreInterface defines the interface for the storage operations of the generated signing keys.
+type signingKeyStoreInterface interface {
+	createSigningKey(ctx context.Context, record signingKeyRecord) error
+	listSigningKeys(ctx context.Context) ([]signingKeyRecord, error)
+	deactivateSigningKeys(ctx context.Context, retireAt time.Time) error
+	deleteRetiredSigningKeys(ctx context.Context, before time.Time) (int64, error)
+}
+
+// signingKeyStore is the config database implementation of signingKeyStoreInterface.
+type signingKeyStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newSigningKeyStore returns a new instance of signingKeyStoreInterface along with the transactioner of the
+// config database.
+func newSigningKeyStore() (signingKeyStoreInterface, transaction.Transactioner, error) {
+	dbProvider := provider.GetDBProvider()
+	client, err := dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	transactioner, err := client.GetTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &signingKeyStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// createSigningKey stores a generated signing key.
+func (s *signingKeyStore) createSigningKey(ctx context.Context, record signingKeyRecord) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateSigningKey, record.ID, record.Algorithm, record.Certificate,
+		record.PrivateKey, string(record.Status), record.CreatedAt, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// listSigningKeys retrieves the generated signing keys, oldest first.
+func (s *signingKeyStore) listSigningKeys(ctx context.Context) ([]signingKeyRecord, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListSigningKeys, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	records := make([]signingKeyRecord, 0, len(results))
+	for _, row := range results {
+		record, err := buildSigningKeyRecordFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+// deactivateSigningKeys marks the active signing keys as inactive, to be retired at the given time.
+func (s *signingKeyStore) deactivateSigningKeys(ctx context.Context, retireAt time.Time) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeactivateSigningKeys, retireAt, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// deleteRetiredSigningKeys deletes the inactive signing keys retired before the given time and returns the
+// number of deleted keys.
+func (s *signingKeyStore) deleteRetiredSigningKeys(ctx context.Context, before time.Time) (int64, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteRetiredSigningKeys, before, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rows, nil
+}
+
+// buildSigningKeyRecordFromResultRow constructs a signingKeyRecord from a database result row.
+func buildSigningKeyRecordFromResultRow(row map[string]interface{}) (*signingKeyRecord, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	algorithm, ok := row["algorithm"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse algorithm as string")
+	}
+	certificate, ok := row["certificate"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse certificate as string")
+	}
+	privateKey, ok := row["private_key"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse private_key as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse status as string")
+	}
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	record := &signingKeyRecord{
+		ID:          id,
+		Algorithm:   algorithm,
+		Certificate: certificate,
+		PrivateKey:  privateKey,
+		Status:      KeyStatus(status),
+		CreatedAt:   createdAt,
+	}
+
+	if row["retire_at"] != nil {
+		retireAt, err := dbutils.ParseTimeField(row["retire_at"], "retire_at")
+		if err != nil {
+			return nil, err
+		}
+		record.RetireAt = &retireAt
+	}
+
+	return record, nil
+}
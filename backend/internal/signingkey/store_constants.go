@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateSigningKey is the query to store a generated signing key.
+	queryCreateSigningKey = dbmodel.DBQuery{
+		ID: "SKQ-01",
+		Query: `INSERT INTO "SIGNING_KEY" ` +
+			`(ID, ALGORITHM, CERTIFICATE, PRIVATE_KEY, STATUS, CREATED_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+
+	// queryListSigningKeys is the query to list the generated signing keys, oldest first.
+	queryListSigningKeys = dbmodel.DBQuery{
+		ID: "SKQ-02",
+		Query: `SELECT ID, ALGORITHM, CERTIFICATE, PRIVATE_KEY, STATUS, CREATED_AT, RETIRE_AT ` +
+			`FROM "SIGNING_KEY" WHERE DEPLOYMENT_ID = $1 ORDER BY CREATED_AT`,
+	}
+
+	// queryDeactivateSigningKeys is the query to mark the active signing keys as inactive and set the time at
+	// which they are retired.
+	queryDeactivateSigningKeys = dbmodel.DBQuery{
+		ID: "SKQ-03",
+		Query: `UPDATE "SIGNING_KEY" SET STATUS = 'INACTIVE', RETIRE_AT = $1 ` +
+			`WHERE STATUS = 'ACTIVE' AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteRetiredSigningKeys is the query to delete the inactive signing keys retired before the given
+	// time.
+	queryDeleteRetiredSigningKeys = dbmodel.DBQuery{
+		ID: "SKQ-04",
+		Query: `DELETE FROM "SIGNING_KEY" WHERE STATUS = 'INACTIVE' AND RETIRE_AT <= $1 ` +
+			`AND DEPLOYMENT_ID = $2`,
+	}
+)
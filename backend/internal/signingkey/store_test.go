@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package signingkey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment"
+
+type SigningKeyStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *signingKeyStore
+}
+
+func TestSigningKeyStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(SigningKeyStoreTestSuite))
+}
+
+func (suite *SigningKeyStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &signingKeyStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *SigningKeyStoreTestSuite) TestCreateSigningKey() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateSigningKey, testGeneratedKeyID,
+		"P-256", "cert", testEncryptedKeyValue, "ACTIVE", createdAt, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createSigningKey(context.Background(), signingKeyRecord{
+		ID:          testGeneratedKeyID,
+		Algorithm:   "P-256",
+		Certificate: "cert",
+		PrivateKey:  testEncryptedKeyValue,
+		Status:      KeyStatusActive,
+		CreatedAt:   createdAt,
+	})
+	suite.NoError(err)
+}
+
+func (suite *SigningKeyStoreTestSuite) TestCreateSigningKey_DBClientError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createSigningKey(context.Background(), signingKeyRecord{ID: testGeneratedKeyID})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *SigningKeyStoreTestSuite) TestListSigningKeys() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListSigningKeys, testDeploymentID).
+		Return([]map[string]interface{}{
+			{
+				"id":          "retiring-key",
+				"algorithm":   "RSA",
+				"certificate": "cert-1",
+				"private_key": testEncryptedKeyValue,
+				"status":      "INACTIVE",
+				"created_at":  "2026-01-02 03:04:05",
+				"retire_at":   time.Date(2026, 1, 3, 3, 4, 5, 0, time.UTC),
+			},
+			{
+				"id":          testGeneratedKeyID,
+				"algorithm":   "P-256",
+				"certificate": "cert-2",
+				"private_key": testEncryptedKeyValue,
+				"status":      "ACTIVE",
+				"created_at":  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+				"retire_at":   nil,
+			},
+		}, nil).Once()
+
+	records, err := suite.store.listSigningKeys(context.Background())
+	suite.Require().NoError(err)
+	suite.Require().Len(records, 2)
+	suite.Equal(KeyStatusInactive, records[0].Status)
+	suite.Require().NotNil(records[0].RetireAt)
+	suite.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), records[0].CreatedAt)
+	suite.Equal(testGeneratedKeyID, records[1].ID)
+	suite.Equal(KeyStatusActive, records[1].Status)
+	suite.Nil(records[1].RetireAt)
+}
+
+func (suite *SigningKeyStoreTestSuite) TestListSigningKeys_InvalidRow() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListSigningKeys, testDeploymentID).
+		Return([]map[string]interface{}{{"id": testGeneratedKeyID}}, nil).Once()
+
+	records, err := suite.store.listSigningKeys(context.Background())
+	suite.Error(err)
+	suite.Nil(records)
+}
+
+func (suite *SigningKeyStoreTestSuite) TestDeactivateSigningKeys() {
+	retireAt := time.Date(2026, 1, 3, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeactivateSigningKeys, retireAt,
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.deactivateSigningKeys(context.Background(), retireAt))
+}
+
+func (suite *SigningKeyStoreTestSuite) TestDeleteRetiredSigningKeys() {
+	now := time.Date(2026, 1, 3, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteRetiredSigningKeys, now,
+		testDeploymentID).Return(int64(3), nil).Once()
+
+	deleted, err := suite.store.deleteRetiredSigningKeys(context.Background(), now)
+	suite.NoError(err)
+	suite.Equal(int64(3), deleted)
+}
+
+func (suite *SigningKeyStoreTestSuite) TestDeleteRetiredSigningKeys_QueryError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteRetiredSigningKeys,
+		time.Time{}, testDeploymentID).Return(int64(0), errors.New("query err")).Once()
+
+	deleted, err := suite.store.deleteRetiredSigningKeys(context.Background(), time.Time{})
+	suite.Error(err)
+	suite.Equal(int64(0), deleted)
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// SessionCookieName is the name of the cookie that binds an SSO session to the user agent.
+	SessionCookieName = "sso_session"
+	// sessionCookiePath restricts the session cookie to the OAuth2 endpoints.
+	sessionCookiePath = "/oauth2"
+	// sessionTokenSeparator separates the session ID from the token of a persistent session in the cookie.
+	sessionTokenSeparator = "."
+)
+
+// SetSessionCookie binds the SSO session to the user agent. The cookie of a persistent session carries its
+// current token and outlives the browser session until the session expires, while the cookie of any other
+// session is discarded when the browser closes. SameSite=None is required since the cookie is set on the
+// response to a cross-origin authorization callback.
+func SetSessionCookie(w http.ResponseWriter, session *SSOSession) {
+	cookie := &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    session.ID,
+		Path:     sessionCookiePath,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	}
+	if session.Persistent {
+		cookie.Value = session.ID + sessionTokenSeparator + session.Token
+		cookie.MaxAge = int(time.Until(session.ExpiryTime).Seconds())
+	}
+	http.SetCookie(w, cookie)
+}
+
+// ClearSessionCookie removes the SSO session cookie from the user agent.
+func ClearSessionCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    "",
+		Path:     sessionCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	})
+}
+
+// GetSessionID returns the reference to the SSO session bound to the request, or an empty string if there is
+// none. For a persistent session the reference also carries the session token, so it is passed to GetSession
+// as is.
+func GetSessionID(r *http.Request) string {
+	cookie, err := r.Cookie(SessionCookieName)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+// parseSessionReference splits a session reference into the session ID and the token of a persistent session.
+func parseSessionReference(ref string) (string, string) {
+	id, token, _ := strings.Cut(ref, sessionTokenSeparator)
+	return id, token
+}
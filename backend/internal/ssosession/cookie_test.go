@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetSessionCookie(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	SetSessionCookie(rr, &SSOSession{ID: "test-session-id", ExpiryTime: time.Now().Add(time.Hour)})
+
+	cookies := rr.Result().Cookies()
+	assert.Len(t, cookies, 1)
+	assert.Equal(t, SessionCookieName, cookies[0].Name)
+	assert.Equal(t, "test-session-id", cookies[0].Value)
+	assert.Equal(t, "/oauth2", cookies[0].Path)
+	assert.True(t, cookies[0].HttpOnly)
+	assert.True(t, cookies[0].Secure)
+	assert.Equal(t, http.SameSiteNoneMode, cookies[0].SameSite)
+	assert.Zero(t, cookies[0].MaxAge)
+}
+
+func TestSetSessionCookie_PersistentSession(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	SetSessionCookie(rr, &SSOSession{
+		ID:         "test-session-id",
+		Persistent: true,
+		Token:      "test-token",
+		ExpiryTime: time.Now().Add(time.Hour),
+	})
+
+	cookies := rr.Result().Cookies()
+	assert.Len(t, cookies, 1)
+	assert.Equal(t, "test-session-id.test-token", cookies[0].Value)
+	assert.InDelta(t, 3600, cookies[0].MaxAge, 2)
+}
+
+func TestClearSessionCookie(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	ClearSessionCookie(rr)
+
+	cookies := rr.Result().Cookies()
+	assert.Len(t, cookies, 1)
+	assert.Equal(t, SessionCookieName, cookies[0].Name)
+	assert.Empty(t, cookies[0].Value)
+	assert.Less(t, cookies[0].MaxAge, 0)
+}
+
+func TestGetSessionID(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/authorize", nil)
+	assert.Empty(t, GetSessionID(req))
+
+	req.AddCookie(&http.Cookie{Name: SessionCookieName, Value: "test-session-id"})
+	assert.Equal(t, "test-session-id", GetSessionID(req))
+}
+
+func TestParseSessionReference(t *testing.T) {
+	id, token := parseSessionReference("test-session-id.test-token")
+	assert.Equal(t, "test-session-id", id)
+	assert.Equal(t, "test-token", token)
+
+	id, token = parseSessionReference("test-session-id")
+	assert.Equal(t, "test-session-id", id)
+	assert.Empty(t, token)
+}
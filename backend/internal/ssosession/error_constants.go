@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Store-level errors.
+var (
+	// errSessionNotFound is returned when an SSO session is not found.
+	errSessionNotFound = errors.New("SSO session not found")
+)
+
+// Client-facing service errors.
+var (
+	// ErrorMissingSessionID is returned when the session ID is missing.
+	ErrorMissingSessionID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSO-1001",
+		Error: core.I18nMessage{
+			Key:          "error.ssosession.missing_session_id",
+			DefaultValue: "Missing session ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ssosession.missing_session_id_description",
+			DefaultValue: "Session ID is required",
+		},
+	}
+
+	// ErrorInvalidSession is returned when the details of a new session are invalid.
+	ErrorInvalidSession = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSO-1002",
+		Error: core.I18nMessage{
+			Key:          "error.ssosession.invalid_session",
+			DefaultValue: "Invalid session",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ssosession.invalid_session_description",
+			DefaultValue: "The session must have an authenticated user",
+		},
+	}
+
+	// ErrorSessionNotFound is returned when a session does not exist or has expired.
+	ErrorSessionNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSO-1003",
+		Error: core.I18nMessage{
+			Key:          "error.ssosession.session_not_found",
+			DefaultValue: "Session not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ssosession.session_not_found_description",
+			DefaultValue: "The session with the specified ID does not exist or has expired",
+		},
+	}
+
+	// ErrorMissingUserID is returned when the user ID is missing when listing sessions.
+	ErrorMissingUserID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSO-1004",
+		Error: core.I18nMessage{
+			Key:          "error.ssosession.missing_user_id",
+			DefaultValue: "Missing user ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ssosession.missing_user_id_description",
+			DefaultValue: "The userId query parameter is required",
+		},
+	}
+)
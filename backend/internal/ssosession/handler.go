@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ssoSessionHandler handles HTTP requests for managing the SSO sessions of users.
+type ssoSessionHandler struct {
+	sessionService SSOSessionServiceInterface
+}
+
+// newSSOSessionHandler creates a new instance of ssoSessionHandler.
+func newSSOSessionHandler(sessionService SSOSessionServiceInterface) *ssoSessionHandler {
+	return &ssoSessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+// HandleSessionListRequest handles the request to list the active SSO sessions of a user.
+func (h *ssoSessionHandler) HandleSessionListRequest(w http.ResponseWriter, r *http.Request) {
+	userID := strings.TrimSpace(r.URL.Query().Get("userId"))
+
+	sessions, svcErr := h.sessionService.ListUserSessions(r.Context(), userID)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	response := SSOSessionListResponse{
+		TotalResults: len(sessions),
+		Sessions:     make([]SSOSessionResponse, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, SSOSessionResponse{
+			ID:             session.ID,
+			UserID:         session.UserID,
+			AuthTime:       session.AuthTime,
+			CompletedACR:   session.CompletedACR,
+			CompletedAMR:   strings.Fields(session.CompletedAMR),
+			Persistent:     session.Persistent,
+			CreatedAt:      session.CreatedAt,
+			LastAccessedAt: session.LastAccessedAt,
+			ExpiryTime:     session.ExpiryTime,
+		})
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleSessionDeleteRequest handles the request to revoke an SSO session.
+func (h *ssoSessionHandler) HandleSessionDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	if svcErr := h.sessionService.DeleteSession(r.Context(), id); svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// handleError writes the HTTP error response for the given service error.
+func (h *ssoSessionHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode = http.StatusBadRequest
+		if svcErr.Code == ErrorSessionNotFound.Code {
+			statusCode = http.StatusNotFound
+		}
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type SSOSessionHandlerTestSuite struct {
+	suite.Suite
+	mockService *SSOSessionServiceInterfaceMock
+	handler     *ssoSessionHandler
+}
+
+func TestSSOSessionHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SSOSessionHandlerTestSuite))
+}
+
+func (suite *SSOSessionHandlerTestSuite) SetupTest() {
+	suite.mockService = NewSSOSessionServiceInterfaceMock(suite.T())
+	suite.handler = newSSOSessionHandler(suite.mockService)
+}
+
+func (suite *SSOSessionHandlerTestSuite) TestHandleSessionListRequest() {
+	req := httptest.NewRequest(http.MethodGet, "/sso-sessions?userId=test-user", nil)
+	rr := httptest.NewRecorder()
+
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockService.EXPECT().ListUserSessions(mock.Anything, "test-user").Return([]SSOSession{{
+		ID:             "test-session-id",
+		UserID:         "test-user",
+		AuthTime:       createdAt,
+		Persistent:     true,
+		TokenHash:      "test-token-hash",
+		CreatedAt:      createdAt,
+		LastAccessedAt: createdAt,
+		ExpiryTime:     createdAt.Add(30 * 24 * time.Hour),
+	}}, nil).Once()
+
+	suite.handler.HandleSessionListRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.NotContains(rr.Body.String(), "test-token-hash")
+
+	var res SSOSessionListResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Equal(1, res.TotalResults)
+	suite.Require().Len(res.Sessions, 1)
+	suite.Equal("test-session-id", res.Sessions[0].ID)
+	suite.True(res.Sessions[0].Persistent)
+}
+
+func (suite *SSOSessionHandlerTestSuite) TestHandleSessionListRequest_MissingUserID() {
+	req := httptest.NewRequest(http.MethodGet, "/sso-sessions", nil)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().ListUserSessions(mock.Anything, "").Return(nil, &ErrorMissingUserID).Once()
+
+	suite.handler.HandleSessionListRequest(rr, req)
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorMissingUserID.Code)
+}
+
+func (suite *SSOSessionHandlerTestSuite) TestHandleSessionListRequest_ServerError() {
+	req := httptest.NewRequest(http.MethodGet, "/sso-sessions?userId=test-user", nil)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().ListUserSessions(mock.Anything, "test-user").
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	suite.handler.HandleSessionListRequest(rr, req)
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (suite *SSOSessionHandlerTestSuite) TestHandleSessionDeleteRequest() {
+	req := httptest.NewRequest(http.MethodDelete, "/sso-sessions/test-session-id", nil)
+	req.SetPathValue("id", "test-session-id")
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().DeleteSession(mock.Anything, "test-session-id").Return(nil).Once()
+
+	suite.handler.HandleSessionDeleteRequest(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *SSOSessionHandlerTestSuite) TestHandleSessionDeleteRequest_NotFound() {
+	req := httptest.NewRequest(http.MethodDelete, "/sso-sessions/test-session-id", nil)
+	req.SetPathValue("id", "test-session-id")
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().DeleteSession(mock.Anything, "test-session-id").
+		Return(&ErrorSessionNotFound).Once()
+
+	suite.handler.HandleSessionDeleteRequest(rr, req)
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// ssoSessionsPath is the path of the endpoint to manage SSO sessions.
+const ssoSessionsPath = "/sso-sessions"
+
+// Initialize initializes the SSO session service, registers its management API with the provided mux and
+// returns an instance of SSOSessionServiceInterface.
+func Initialize(mux *http.ServeMux) SSOSessionServiceInterface {
+	var store sessionStoreInterface
+	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		store = newRedisSessionStore(provider.GetRedisProvider())
+	} else {
+		store = newSessionStore()
+	}
+	sessionService := newSSOSessionService(store)
+	registerRoutes(mux, newSSOSessionHandler(sessionService))
+	return sessionService
+}
+
+// registerRoutes registers the HTTP routes for managing SSO sessions.
+func registerRoutes(mux *http.ServeMux, handler *ssoSessionHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+ssoSessionsPath, handler.HandleSessionListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+ssoSessionsPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+ssoSessionsPath+"/{id}", handler.HandleSessionDeleteRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+ssoSessionsPath+"/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import "time"
+
+// SSOSession represents a single sign-on session established for a user on successful authentication.
+type SSOSession struct {
+	// ID is the unique identifier of the session. It is bound to the user agent through the session cookie.
+	ID string `json:"id"`
+
+	// UserID is the ID of the authenticated user.
+	UserID string `json:"userId"`
+
+	// AuthTime is the time at which the user authenticated.
+	AuthTime time.Time `json:"authTime"`
+
+	// CompletedACR is the authentication class completed by the user.
+	CompletedACR string `json:"completedAcr,omitempty"`
+
+	// CompletedAMR is the space-separated list of authentication methods used by the user.
+	CompletedAMR string `json:"completedAmr,omitempty"`
+
+	// AttributeCacheID is the ID of the attribute cache entry holding the user attributes resolved
+	// during the authentication.
+	AttributeCacheID string `json:"attributeCacheId,omitempty"`
+
+	// Persistent reports whether the user chose to stay signed in. A persistent session is not subject to the
+	// idle timeout and is bound to the user agent with a token that is rotated each time the session is used.
+	Persistent bool `json:"persistent"`
+
+	// TokenHash is the hash of the current token of a persistent session.
+	TokenHash string `json:"tokenHash,omitempty"`
+
+	// Token is the current token of a persistent session. It is only set when a token is issued and is never
+	// stored.
+	Token string `json:"-"`
+
+	// CreatedAt is the time at which the session was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// LastAccessedAt is the time at which the session was last used. It drives the idle timeout of a session that
+	// is not persistent.
+	LastAccessedAt time.Time `json:"lastAccessedAt"`
+
+	// ExpiryTime is the time at which the session expires regardless of activity.
+	ExpiryTime time.Time `json:"expiryTime"`
+
+	// ClientIDs are the clients that were issued tokens in the session. They are reported to the termination
+	// listeners when the session is terminated.
+	ClientIDs []string `json:"clientIds,omitempty"`
+}
+
+// SSOSessionResponse represents the response structure for an SSO session. It leaves out the session token.
+type SSOSessionResponse struct {
+	ID             string    `json:"id"`
+	UserID         string    `json:"userId"`
+	AuthTime       time.Time `json:"authTime"`
+	CompletedACR   string    `json:"completedAcr,omitempty"`
+	CompletedAMR   []string  `json:"completedAmr,omitempty"`
+	Persistent     bool      `json:"persistent"`
+	CreatedAt      time.Time `json:"createdAt"`
+	LastAccessedAt time.Time `json:"lastAccessedAt"`
+	ExpiryTime     time.Time `json:"expiryTime"`
+}
+
+// SSOSessionListResponse represents the response structure for the SSO sessions of a user.
+type SSOSessionListResponse struct {
+	TotalResults int                  `json:"totalResults"`
+	Sessions     []SSOSessionResponse `json:"sessions"`
+}
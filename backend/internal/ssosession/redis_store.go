@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// redisClient abstracts the Redis commands used by the SSO session store.
+type redisClient interface {
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	SAdd(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+	SMembers(ctx context.Context, key string) *redis.StringSliceCmd
+	SRem(ctx context.Context, key string, members ...interface{}) *redis.IntCmd
+	TTL(ctx context.Context, key string) *redis.DurationCmd
+	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
+}
+
+// redisSessionStore is the Redis-backed implementation of sessionStoreInterface.
+type redisSessionStore struct {
+	client       redisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisSessionStore creates a new Redis-backed SSO session store.
+func newRedisSessionStore(p provider.RedisProviderInterface) sessionStoreInterface {
+	return &redisSessionStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// sessionKey builds the Redis key for an SSO session.
+func (s *redisSessionStore) sessionKey(id string) string {
+	return fmt.Sprintf("%s:runtime:%s:ssosession:%s", s.keyPrefix, s.deploymentID, id)
+}
+
+// userSessionsKey builds the Redis key for the set of SSO session IDs of a user.
+func (s *redisSessionStore) userSessionsKey(userID string) string {
+	return fmt.Sprintf("%s:runtime:%s:ssosession:user:%s", s.keyPrefix, s.deploymentID, userID)
+}
+
+// CreateSession serializes the SSO session and stores it in Redis until the session expires. The session is
+// also added to the set of sessions of the user, which is kept until the last of them expires.
+func (s *redisSessionStore) CreateSession(ctx context.Context, session SSOSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SSO session: %w", err)
+	}
+
+	ttl := time.Until(session.ExpiryTime)
+	if ttl <= 0 {
+		return errors.New("SSO session already expired")
+	}
+	if err := s.client.Set(ctx, s.sessionKey(session.ID), data, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store SSO session in Redis: %w", err)
+	}
+
+	userKey := s.userSessionsKey(session.UserID)
+	if err := s.client.SAdd(ctx, userKey, session.ID).Err(); err != nil {
+		return fmt.Errorf("failed to index SSO session in Redis: %w", err)
+	}
+	currentTTL, err := s.client.TTL(ctx, userKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get TTL of SSO session index in Redis: %w", err)
+	}
+	if currentTTL < ttl {
+		if err := s.client.Expire(ctx, userKey, ttl).Err(); err != nil {
+			return fmt.Errorf("failed to set TTL of SSO session index in Redis: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSession retrieves an SSO session from Redis.
+func (s *redisSessionStore) GetSession(ctx context.Context, id string) (SSOSession, error) {
+	data, err := s.client.Get(ctx, s.sessionKey(id)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return SSOSession{}, errSessionNotFound
+		}
+		return SSOSession{}, fmt.Errorf("failed to get SSO session from Redis: %w", err)
+	}
+
+	var session SSOSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return SSOSession{}, fmt.Errorf("failed to unmarshal SSO session: %w", err)
+	}
+
+	return session, nil
+}
+
+// UpdateLastAccessedTime updates the time at which an SSO session was last used, keeping its expiry.
+func (s *redisSessionStore) UpdateLastAccessedTime(ctx context.Context, id string, lastAccessedAt time.Time) error {
+	session, err := s.GetSession(ctx, id)
+	if err != nil {
+		return err
+	}
+	session.LastAccessedAt = lastAccessedAt
+
+	return s.updateSession(ctx, session)
+}
+
+// UpdateSessionToken replaces the token hash of a persistent SSO session, keeping its expiry. The update only
+// applies while the session holds the current token hash.
+func (s *redisSessionStore) UpdateSessionToken(
+	ctx context.Context, id, currentTokenHash, newTokenHash string, lastAccessedAt time.Time,
+) error {
+	session, err := s.GetSession(ctx, id)
+	if err != nil {
+		return err
+	}
+	if session.TokenHash != currentTokenHash {
+		return errSessionNotFound
+	}
+	session.TokenHash = newTokenHash
+	session.LastAccessedAt = lastAccessedAt
+
+	return s.updateSession(ctx, session)
+}
+
+// ListSessionsByUser lists the SSO sessions of a user that have not expired by the given time, most recent
+// first. Sessions that no longer exist are removed from the set of sessions of the user.
+func (s *redisSessionStore) ListSessionsByUser(
+	ctx context.Context, userID string, now time.Time,
+) ([]SSOSession, error) {
+	userKey := s.userSessionsKey(userID)
+	ids, err := s.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SSO sessions from Redis: %w", err)
+	}
+
+	sessions := make([]SSOSession, 0, len(ids))
+	for _, id := range ids {
+		session, err := s.GetSession(ctx, id)
+		if err != nil {
+			if errors.Is(err, errSessionNotFound) {
+				if err := s.client.SRem(ctx, userKey, id).Err(); err != nil {
+					return nil, fmt.Errorf("failed to remove SSO session from index in Redis: %w", err)
+				}
+				continue
+			}
+			return nil, err
+		}
+		if now.Before(session.ExpiryTime) {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+	})
+
+	return sessions, nil
+}
+
+// updateSession stores the updated SSO session in Redis, keeping its expiry.
+func (s *redisSessionStore) updateSession(ctx context.Context, session SSOSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SSO session: %w", err)
+	}
+	if err := s.client.Set(ctx, s.sessionKey(session.ID), data, redis.KeepTTL).Err(); err != nil {
+		return fmt.Errorf("failed to update SSO session in Redis: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSession removes an SSO session from Redis.
+func (s *redisSessionStore) DeleteSession(ctx context.Context, id string) error {
+	n, err := s.client.Del(ctx, s.sessionKey(id)).Result()
+	if err != nil {
+		return fmt.Errorf("failed to delete SSO session from Redis: %w", err)
+	}
+	if n == 0 {
+		return errSessionNotFound
+	}
+
+	return nil
+}
+
+// AddSessionClient records a client that was issued tokens in an SSO session, keeping its expiry.
+func (s *redisSessionStore) AddSessionClient(ctx context.Context, id, clientID string) error {
+	session, err := s.GetSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			return nil
+		}
+		return err
+	}
+	if slices.Contains(session.ClientIDs, clientID) {
+		return nil
+	}
+	session.ClientIDs = append(session.ClientIDs, clientID)
+
+	return s.updateSession(ctx, session)
+}
+
+// GetSessionClients retrieves the clients that were issued tokens in an SSO session from Redis.
+func (s *redisSessionStore) GetSessionClients(ctx context.Context, id string) ([]string, error) {
+	session, err := s.GetSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return session.ClientIDs, nil
+}
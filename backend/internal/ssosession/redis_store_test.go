@@ -0,0 +1,331 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	redisTestKeyPrefix    = "thunderid"
+	redisTestDeploymentID = "test-deployment"
+	redisTestSessionID    = "test-session-id"
+)
+
+type RedisSessionStoreTestSuite struct {
+	suite.Suite
+	store       *redisSessionStore
+	mockClient  *redisClientMock
+	ctx         context.Context
+	testSession SSOSession
+	sessionKey  string
+	userKey     string
+}
+
+func TestRedisSessionStoreSuite(t *testing.T) {
+	suite.Run(t, new(RedisSessionStoreTestSuite))
+}
+
+func (suite *RedisSessionStoreTestSuite) SetupTest() {
+	suite.mockClient = newRedisClientMock(suite.T())
+	suite.ctx = context.Background()
+	now := time.Now().UTC()
+	suite.testSession = SSOSession{
+		ID:             redisTestSessionID,
+		UserID:         "test-user",
+		AuthTime:       now,
+		CreatedAt:      now,
+		LastAccessedAt: now,
+		ExpiryTime:     now.Add(time.Hour),
+	}
+	suite.store = &redisSessionStore{
+		client:       suite.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: redisTestDeploymentID,
+	}
+	suite.sessionKey = fmt.Sprintf("%s:runtime:%s:ssosession:%s",
+		redisTestKeyPrefix, redisTestDeploymentID, redisTestSessionID)
+	suite.userKey = fmt.Sprintf("%s:runtime:%s:ssosession:user:%s",
+		redisTestKeyPrefix, redisTestDeploymentID, suite.testSession.UserID)
+}
+
+// mockGetSession sets up the mock to return the given session for its key.
+func (suite *RedisSessionStoreTestSuite) mockGetSession(session SSOSession) {
+	data, _ := json.Marshal(session)
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal(string(data))
+	suite.mockClient.On("Get", suite.ctx, suite.store.sessionKey(session.ID)).Return(stringCmd)
+}
+
+// Tests for sessionKey
+
+func (suite *RedisSessionStoreTestSuite) TestSessionKey() {
+	suite.Equal(suite.sessionKey, suite.store.sessionKey(redisTestSessionID))
+}
+
+func (suite *RedisSessionStoreTestSuite) TestUserSessionsKey() {
+	suite.Equal(suite.userKey, suite.store.userSessionsKey(suite.testSession.UserID))
+}
+
+// Tests for CreateSession
+
+func (suite *RedisSessionStoreTestSuite) TestCreateSession_Success() {
+	suite.mockClient.On("Set", suite.ctx, suite.sessionKey, mock.Anything,
+		mock.MatchedBy(func(ttl time.Duration) bool {
+			return ttl > 59*time.Minute && ttl <= time.Hour
+		})).Return(redis.NewStatusCmd(suite.ctx))
+	suite.mockClient.On("SAdd", suite.ctx, suite.userKey, redisTestSessionID).Return(redis.NewIntCmd(suite.ctx))
+	ttlCmd := redis.NewDurationCmd(suite.ctx, time.Second)
+	ttlCmd.SetVal(-1)
+	suite.mockClient.On("TTL", suite.ctx, suite.userKey).Return(ttlCmd)
+	suite.mockClient.On("Expire", suite.ctx, suite.userKey, mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 59*time.Minute && ttl <= time.Hour
+	})).Return(redis.NewBoolCmd(suite.ctx))
+
+	err := suite.store.CreateSession(suite.ctx, suite.testSession)
+	suite.NoError(err)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestCreateSession_KeepsLongerIndexTTL() {
+	suite.mockClient.On("Set", suite.ctx, suite.sessionKey, mock.Anything, mock.Anything).
+		Return(redis.NewStatusCmd(suite.ctx))
+	suite.mockClient.On("SAdd", suite.ctx, suite.userKey, redisTestSessionID).Return(redis.NewIntCmd(suite.ctx))
+	ttlCmd := redis.NewDurationCmd(suite.ctx, time.Second)
+	ttlCmd.SetVal(24 * time.Hour)
+	suite.mockClient.On("TTL", suite.ctx, suite.userKey).Return(ttlCmd)
+
+	err := suite.store.CreateSession(suite.ctx, suite.testSession)
+	suite.NoError(err)
+	suite.mockClient.AssertNotCalled(suite.T(), "Expire", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestCreateSession_AlreadyExpired() {
+	suite.testSession.ExpiryTime = time.Now().Add(-time.Second)
+
+	err := suite.store.CreateSession(suite.ctx, suite.testSession)
+	suite.Error(err)
+	suite.mockClient.AssertNotCalled(suite.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestCreateSession_SetError() {
+	statusCmd := redis.NewStatusCmd(suite.ctx)
+	statusCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Set", suite.ctx, suite.sessionKey, mock.Anything, mock.Anything).Return(statusCmd)
+
+	err := suite.store.CreateSession(suite.ctx, suite.testSession)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to store SSO session in Redis")
+}
+
+// Tests for GetSession
+
+func (suite *RedisSessionStoreTestSuite) TestGetSession_Success() {
+	data, _ := json.Marshal(suite.testSession)
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal(string(data))
+	suite.mockClient.On("Get", suite.ctx, suite.sessionKey).Return(stringCmd)
+
+	result, err := suite.store.GetSession(suite.ctx, redisTestSessionID)
+	suite.NoError(err)
+	suite.Equal(suite.testSession.UserID, result.UserID)
+	suite.True(suite.testSession.ExpiryTime.Equal(result.ExpiryTime))
+}
+
+func (suite *RedisSessionStoreTestSuite) TestGetSession_NotFound() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(redis.Nil)
+	suite.mockClient.On("Get", suite.ctx, suite.sessionKey).Return(stringCmd)
+
+	_, err := suite.store.GetSession(suite.ctx, redisTestSessionID)
+	suite.ErrorIs(err, errSessionNotFound)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestGetSession_InvalidJSON() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal("not-json")
+	suite.mockClient.On("Get", suite.ctx, suite.sessionKey).Return(stringCmd)
+
+	_, err := suite.store.GetSession(suite.ctx, redisTestSessionID)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to unmarshal SSO session")
+}
+
+// Tests for UpdateLastAccessedTime
+
+func (suite *RedisSessionStoreTestSuite) TestUpdateLastAccessedTime_Success() {
+	data, _ := json.Marshal(suite.testSession)
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetVal(string(data))
+	suite.mockClient.On("Get", suite.ctx, suite.sessionKey).Return(stringCmd)
+
+	lastAccessedAt := suite.testSession.LastAccessedAt.Add(time.Minute)
+	suite.mockClient.On("Set", suite.ctx, suite.sessionKey, mock.MatchedBy(func(value []byte) bool {
+		var stored SSOSession
+		return json.Unmarshal(value, &stored) == nil && stored.LastAccessedAt.Equal(lastAccessedAt)
+	}), time.Duration(redis.KeepTTL)).Return(redis.NewStatusCmd(suite.ctx))
+
+	err := suite.store.UpdateLastAccessedTime(suite.ctx, redisTestSessionID, lastAccessedAt)
+	suite.NoError(err)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestUpdateLastAccessedTime_NotFound() {
+	stringCmd := redis.NewStringCmd(suite.ctx)
+	stringCmd.SetErr(redis.Nil)
+	suite.mockClient.On("Get", suite.ctx, suite.sessionKey).Return(stringCmd)
+
+	err := suite.store.UpdateLastAccessedTime(suite.ctx, redisTestSessionID, time.Now())
+	suite.ErrorIs(err, errSessionNotFound)
+}
+
+// Tests for UpdateSessionToken
+
+func (suite *RedisSessionStoreTestSuite) TestUpdateSessionToken_Success() {
+	suite.testSession.Persistent = true
+	suite.testSession.TokenHash = "current-token-hash"
+	suite.mockGetSession(suite.testSession)
+
+	lastAccessedAt := suite.testSession.LastAccessedAt.Add(time.Minute)
+	suite.mockClient.On("Set", suite.ctx, suite.sessionKey, mock.MatchedBy(func(value []byte) bool {
+		var stored SSOSession
+		return json.Unmarshal(value, &stored) == nil && stored.TokenHash == "new-token-hash" &&
+			stored.LastAccessedAt.Equal(lastAccessedAt)
+	}), time.Duration(redis.KeepTTL)).Return(redis.NewStatusCmd(suite.ctx))
+
+	err := suite.store.UpdateSessionToken(suite.ctx, redisTestSessionID, "current-token-hash",
+		"new-token-hash", lastAccessedAt)
+	suite.NoError(err)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestUpdateSessionToken_StaleToken() {
+	suite.testSession.Persistent = true
+	suite.testSession.TokenHash = "rotated-token-hash"
+	suite.mockGetSession(suite.testSession)
+
+	err := suite.store.UpdateSessionToken(suite.ctx, redisTestSessionID, "current-token-hash",
+		"new-token-hash", time.Now())
+	suite.ErrorIs(err, errSessionNotFound)
+	suite.mockClient.AssertNotCalled(suite.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Tests for ListSessionsByUser
+
+func (suite *RedisSessionStoreTestSuite) TestListSessionsByUser_Success() {
+	older := suite.testSession
+	older.ID = "older-session-id"
+	older.CreatedAt = suite.testSession.CreatedAt.Add(-time.Hour)
+	suite.mockGetSession(older)
+	suite.mockGetSession(suite.testSession)
+
+	membersCmd := redis.NewStringSliceCmd(suite.ctx)
+	membersCmd.SetVal([]string{older.ID, redisTestSessionID, "deleted-session-id"})
+	suite.mockClient.On("SMembers", suite.ctx, suite.userKey).Return(membersCmd)
+
+	notFoundCmd := redis.NewStringCmd(suite.ctx)
+	notFoundCmd.SetErr(redis.Nil)
+	suite.mockClient.On("Get", suite.ctx, suite.store.sessionKey("deleted-session-id")).Return(notFoundCmd)
+	suite.mockClient.On("SRem", suite.ctx, suite.userKey, "deleted-session-id").Return(redis.NewIntCmd(suite.ctx))
+
+	result, err := suite.store.ListSessionsByUser(suite.ctx, suite.testSession.UserID, time.Now())
+	suite.NoError(err)
+	suite.Len(result, 2)
+	suite.Equal(redisTestSessionID, result[0].ID)
+	suite.Equal(older.ID, result[1].ID)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestListSessionsByUser_SMembersError() {
+	membersCmd := redis.NewStringSliceCmd(suite.ctx)
+	membersCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("SMembers", suite.ctx, suite.userKey).Return(membersCmd)
+
+	_, err := suite.store.ListSessionsByUser(suite.ctx, suite.testSession.UserID, time.Now())
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to list SSO sessions from Redis")
+}
+
+// Tests for DeleteSession
+
+func (suite *RedisSessionStoreTestSuite) TestDeleteSession_Success() {
+	intCmd := redis.NewIntCmd(suite.ctx)
+	intCmd.SetVal(1)
+	suite.mockClient.On("Del", suite.ctx, suite.sessionKey).Return(intCmd)
+
+	err := suite.store.DeleteSession(suite.ctx, redisTestSessionID)
+	suite.NoError(err)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestDeleteSession_NotFound() {
+	intCmd := redis.NewIntCmd(suite.ctx)
+	intCmd.SetVal(0)
+	suite.mockClient.On("Del", suite.ctx, suite.sessionKey).Return(intCmd)
+
+	err := suite.store.DeleteSession(suite.ctx, redisTestSessionID)
+	suite.ErrorIs(err, errSessionNotFound)
+}
+
+// Tests for AddSessionClient
+
+func (suite *RedisSessionStoreTestSuite) TestAddSessionClient_Success() {
+	suite.mockGetSession(suite.testSession)
+	suite.mockClient.On("Set", suite.ctx, suite.sessionKey, mock.MatchedBy(func(data []byte) bool {
+		var session SSOSession
+		return json.Unmarshal(data, &session) == nil && len(session.ClientIDs) == 1 &&
+			session.ClientIDs[0] == "test-client"
+	}), time.Duration(redis.KeepTTL)).Return(redis.NewStatusCmd(suite.ctx))
+
+	err := suite.store.AddSessionClient(suite.ctx, redisTestSessionID, "test-client")
+	suite.NoError(err)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestAddSessionClient_AlreadyRecorded() {
+	session := suite.testSession
+	session.ClientIDs = []string{"test-client"}
+	suite.mockGetSession(session)
+
+	err := suite.store.AddSessionClient(suite.ctx, redisTestSessionID, "test-client")
+	suite.NoError(err)
+	suite.mockClient.AssertNotCalled(suite.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RedisSessionStoreTestSuite) TestAddSessionClient_SessionNotFound() {
+	suite.mockClient.On("Get", suite.ctx, suite.sessionKey).Return(redis.NewStringResult("", redis.Nil))
+
+	err := suite.store.AddSessionClient(suite.ctx, redisTestSessionID, "test-client")
+	suite.NoError(err)
+}
+
+// Tests for GetSessionClients
+
+func (suite *RedisSessionStoreTestSuite) TestGetSessionClients_Success() {
+	session := suite.testSession
+	session.ClientIDs = []string{"client-a", "client-b"}
+	suite.mockGetSession(session)
+
+	clientIDs, err := suite.store.GetSessionClients(suite.ctx, redisTestSessionID)
+	suite.NoError(err)
+	suite.Equal([]string{"client-a", "client-b"}, clientIDs)
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"time"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// ResolveRememberMeLifetime returns the lifetime of a persistent SSO session for a user of the given organization
+// unit who signs in to an entity with the given remember me configuration, or zero if the user may not stay
+// signed in. The entity configuration takes precedence over the configuration of the organization unit, which
+// takes precedence over the server configuration.
+func ResolveRememberMeLifetime(entityConfig *inboundmodel.RememberMeConfig, ouID string) time.Duration {
+	cfg := config.GetServerRuntime().Config.SSOSession
+	if !cfg.Enabled {
+		return 0
+	}
+
+	enabled := cfg.RememberMe.Enabled
+	lifetime := cfg.RememberMe.Lifetime
+	if ouID != "" {
+		for _, ouConfig := range cfg.RememberMe.OrganizationUnits {
+			if ouConfig.OUID != ouID {
+				continue
+			}
+			if ouConfig.Enabled != nil {
+				enabled = *ouConfig.Enabled
+			}
+			if ouConfig.Lifetime > 0 {
+				lifetime = ouConfig.Lifetime
+			}
+			break
+		}
+	}
+	if entityConfig != nil {
+		enabled = entityConfig.Enabled
+		if entityConfig.Lifetime > 0 {
+			lifetime = entityConfig.Lifetime
+		}
+	}
+
+	if !enabled || lifetime <= 0 {
+		return 0
+	}
+	return time.Duration(lifetime) * time.Second
+}
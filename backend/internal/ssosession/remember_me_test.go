@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// initRememberMeConfig initializes the server runtime with the given remember me configuration.
+func initRememberMeConfig(t *testing.T, ssoEnabled bool, rememberMe config.RememberMeConfig) {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		SSOSession: config.SSOSessionConfig{Enabled: ssoEnabled, RememberMe: rememberMe},
+	})
+	t.Cleanup(config.ResetServerRuntime)
+}
+
+func TestResolveRememberMeLifetime(t *testing.T) {
+	disabled := false
+	serverConfig := config.RememberMeConfig{
+		Enabled:  true,
+		Lifetime: 86400,
+		OrganizationUnits: []config.RememberMeOUConfig{
+			{OUID: "disabled-ou", Enabled: &disabled},
+			{OUID: "long-lived-ou", Lifetime: 604800},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		entityConfig *inboundmodel.RememberMeConfig
+		ouID         string
+		expected     time.Duration
+	}{
+		{name: "ServerDefault", ouID: "other-ou", expected: 24 * time.Hour},
+		{name: "DisabledForOU", ouID: "disabled-ou", expected: 0},
+		{name: "LifetimeOfOU", ouID: "long-lived-ou", expected: 7 * 24 * time.Hour},
+		{
+			name:         "EnabledForEntity",
+			entityConfig: &inboundmodel.RememberMeConfig{Enabled: true, Lifetime: 3600},
+			ouID:         "disabled-ou",
+			expected:     time.Hour,
+		},
+		{
+			name:         "DisabledForEntity",
+			entityConfig: &inboundmodel.RememberMeConfig{Enabled: false},
+			ouID:         "long-lived-ou",
+			expected:     0,
+		},
+		{
+			name:         "EntityInheritsLifetime",
+			entityConfig: &inboundmodel.RememberMeConfig{Enabled: true},
+			ouID:         "long-lived-ou",
+			expected:     7 * 24 * time.Hour,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			initRememberMeConfig(t, true, serverConfig)
+			assert.Equal(t, tc.expected, ResolveRememberMeLifetime(tc.entityConfig, tc.ouID))
+		})
+	}
+}
+
+func TestResolveRememberMeLifetime_SSOSessionsDisabled(t *testing.T) {
+	initRememberMeConfig(t, false, config.RememberMeConfig{Enabled: true, Lifetime: 86400})
+
+	assert.Zero(t, ResolveRememberMeLifetime(&inboundmodel.RememberMeConfig{Enabled: true}, ""))
+}
@@ -0,0 +1,396 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package ssosession provides server-side single sign-on sessions, which let an authenticated user access
+// further applications without authenticating again.
+package ssosession
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	loggerComponentName = "SSOSessionService"
+	// sessionTokenRandomBytes is the number of random bytes in the token of a persistent session.
+	sessionTokenRandomBytes = 32
+)
+
+// SSOSessionServiceInterface defines the interface for the SSO session service.
+type SSOSessionServiceInterface interface {
+	// IsEnabled reports whether SSO sessions are enabled.
+	IsEnabled() bool
+
+	// CreateSession creates a new SSO session for an authenticated user.
+	CreateSession(ctx context.Context, session *SSOSession) (*SSOSession, *serviceerror.ServiceError)
+
+	// CreatePersistentSession creates a new persistent SSO session for an authenticated user who chose to
+	// stay signed in. The session expires after the given lifetime.
+	CreatePersistentSession(
+		ctx context.Context, session *SSOSession, lifetime time.Duration,
+	) (*SSOSession, *serviceerror.ServiceError)
+
+	// GetSession retrieves an active SSO session by the reference bound to the user agent and records its
+	// use. Sessions that exceeded the idle or absolute timeout are not returned.
+	GetSession(ctx context.Context, ref string) (*SSOSession, *serviceerror.ServiceError)
+
+	// RotateSessionToken issues a new token for a persistent SSO session retrieved with GetSession. The
+	// returned session must be bound to the user agent again.
+	RotateSessionToken(ctx context.Context, session *SSOSession) (*SSOSession, *serviceerror.ServiceError)
+
+	// ListUserSessions lists the active SSO sessions of a user.
+	ListUserSessions(ctx context.Context, userID string) ([]SSOSession, *serviceerror.ServiceError)
+
+	// DeleteSession deletes an SSO session by ID and notifies the termination listeners.
+	DeleteSession(ctx context.Context, id string) *serviceerror.ServiceError
+
+	// AddSessionClient records a client that was issued tokens in an SSO session, so that the client can be
+	// told when the session is terminated.
+	AddSessionClient(ctx context.Context, id, clientID string) *serviceerror.ServiceError
+
+	// GetSessionClients retrieves the clients that were issued tokens in an SSO session.
+	GetSessionClients(ctx context.Context, id string) ([]string, *serviceerror.ServiceError)
+
+	// AddTerminationListener registers a listener that is notified when an SSO session is deleted. Listeners
+	// are expected to be registered at startup.
+	AddTerminationListener(listener SessionTerminationListener)
+}
+
+// SessionTerminationListener is notified when an SSO session is terminated.
+type SessionTerminationListener interface {
+	// OnSessionTerminated is called after an SSO session is deleted. The session carries the clients that were
+	// issued tokens in it.
+	OnSessionTerminated(ctx context.Context, session SSOSession)
+}
+
+// ssoSessionService is the default implementation of the SSOSessionServiceInterface.
+type ssoSessionService struct {
+	store           sessionStoreInterface
+	enabled         bool
+	idleTimeout     time.Duration
+	absoluteTimeout time.Duration
+	listeners       []SessionTerminationListener
+}
+
+// newSSOSessionService creates a new instance of ssoSessionService with injected dependencies.
+func newSSOSessionService(store sessionStoreInterface) SSOSessionServiceInterface {
+	cfg := config.GetServerRuntime().Config.SSOSession
+	return &ssoSessionService{
+		store:           store,
+		enabled:         cfg.Enabled,
+		idleTimeout:     time.Duration(cfg.IdleTimeout) * time.Second,
+		absoluteTimeout: time.Duration(cfg.AbsoluteTimeout) * time.Second,
+	}
+}
+
+// IsEnabled reports whether SSO sessions are enabled.
+func (s *ssoSessionService) IsEnabled() bool {
+	return s.enabled
+}
+
+// CreateSession creates a new SSO session for an authenticated user. The session expires after the
+// absolute timeout regardless of activity.
+func (s *ssoSessionService) CreateSession(
+	ctx context.Context, session *SSOSession,
+) (*SSOSession, *serviceerror.ServiceError) {
+	return s.createSession(ctx, session, s.absoluteTimeout, false)
+}
+
+// CreatePersistentSession creates a new persistent SSO session for an authenticated user. The session is not
+// subject to the idle timeout and expires after the given lifetime.
+func (s *ssoSessionService) CreatePersistentSession(
+	ctx context.Context, session *SSOSession, lifetime time.Duration,
+) (*SSOSession, *serviceerror.ServiceError) {
+	if lifetime <= 0 {
+		return nil, &ErrorInvalidSession
+	}
+	return s.createSession(ctx, session, lifetime, true)
+}
+
+// createSession stores a new SSO session that expires after the given lifetime. A persistent session is
+// issued its first token.
+func (s *ssoSessionService) createSession(
+	ctx context.Context, session *SSOSession, lifetime time.Duration, persistent bool,
+) (*SSOSession, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug("Creating SSO session", log.Bool("persistent", persistent))
+
+	if session == nil || strings.TrimSpace(session.UserID) == "" {
+		return nil, &ErrorInvalidSession
+	}
+
+	var err error
+	session.ID, err = utils.GenerateUUIDv7()
+	if err != nil {
+		logger.Error("Failed to generate UUID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	session.Persistent = persistent
+	session.Token = ""
+	session.TokenHash = ""
+	if persistent {
+		if session.Token, err = generateSessionToken(); err != nil {
+			logger.Error("Failed to generate SSO session token", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		session.TokenHash = hash.GenerateThumbprintFromString(session.Token)
+	}
+
+	now := time.Now()
+	if session.AuthTime.IsZero() {
+		session.AuthTime = now
+	}
+	session.CreatedAt = now
+	session.LastAccessedAt = now
+	session.ExpiryTime = now.Add(lifetime)
+
+	stored := *session
+	stored.Token = ""
+	if err := s.store.CreateSession(ctx, stored); err != nil {
+		logger.Error("Failed to create SSO session", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Successfully created SSO session", log.MaskedString(log.LoggerKeyUserID, session.UserID))
+	return session, nil
+}
+
+// GetSession retrieves an active SSO session by the reference bound to the user agent and records its use. A
+// session that exceeded the idle or absolute timeout is deleted and reported as not found. A persistent session
+// is only returned for its current token. Presenting a token that is no longer current indicates that the token
+// was stolen and replayed, so the session is revoked.
+func (s *ssoSessionService) GetSession(ctx context.Context, ref string) (*SSOSession, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	id, token := parseSessionReference(ref)
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorMissingSessionID
+	}
+
+	session, err := s.store.GetSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			logger.Debug("SSO session not found")
+			return nil, &ErrorSessionNotFound
+		}
+		logger.Error("Failed to retrieve SSO session", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	now := time.Now()
+	if !s.isActive(session, now) {
+		logger.Debug("SSO session has expired")
+		s.revokeSession(ctx, id)
+		return nil, &ErrorSessionNotFound
+	}
+
+	if session.Persistent && (token == "" || hash.GenerateThumbprintFromString(token) != session.TokenHash) {
+		logger.Warn("Invalid token presented for persistent SSO session, revoking the session",
+			log.MaskedString(log.LoggerKeyUserID, session.UserID))
+		s.revokeSession(ctx, id)
+		return nil, &ErrorSessionNotFound
+	}
+
+	if err := s.store.UpdateLastAccessedTime(ctx, id, now); err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			return nil, &ErrorSessionNotFound
+		}
+		logger.Error("Failed to update SSO session", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	session.LastAccessedAt = now
+
+	return &session, nil
+}
+
+// RotateSessionToken issues a new token for a persistent SSO session. The token is only replaced while the
+// session holds the token it was retrieved with, so a concurrent rotation reports the session as not found.
+func (s *ssoSessionService) RotateSessionToken(
+	ctx context.Context, session *SSOSession,
+) (*SSOSession, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if session == nil || strings.TrimSpace(session.ID) == "" {
+		return nil, &ErrorMissingSessionID
+	}
+	if !session.Persistent {
+		return nil, &ErrorInvalidSession
+	}
+
+	newToken, err := generateSessionToken()
+	if err != nil {
+		logger.Error("Failed to generate SSO session token", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	newTokenHash := hash.GenerateThumbprintFromString(newToken)
+
+	now := time.Now()
+	if err := s.store.UpdateSessionToken(ctx, session.ID, session.TokenHash, newTokenHash, now); err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			return nil, &ErrorSessionNotFound
+		}
+		logger.Error("Failed to rotate SSO session token", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	rotated := *session
+	rotated.Token = newToken
+	rotated.TokenHash = newTokenHash
+	rotated.LastAccessedAt = now
+	return &rotated, nil
+}
+
+// ListUserSessions lists the active SSO sessions of a user, most recent first.
+func (s *ssoSessionService) ListUserSessions(
+	ctx context.Context, userID string,
+) ([]SSOSession, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(userID) == "" {
+		return nil, &ErrorMissingUserID
+	}
+
+	now := time.Now()
+	sessions, err := s.store.ListSessionsByUser(ctx, userID, now)
+	if err != nil {
+		logger.Error("Failed to list SSO sessions", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	activeSessions := make([]SSOSession, 0, len(sessions))
+	for _, session := range sessions {
+		if s.isActive(session, now) {
+			activeSessions = append(activeSessions, session)
+		}
+	}
+
+	return activeSessions, nil
+}
+
+// DeleteSession deletes an SSO session by ID. When termination listeners are registered, the session and its
+// clients are read before the deletion so that the listeners can be notified afterwards.
+func (s *ssoSessionService) DeleteSession(ctx context.Context, id string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if strings.TrimSpace(id) == "" {
+		return &ErrorMissingSessionID
+	}
+
+	var session SSOSession
+	if len(s.listeners) > 0 {
+		var err error
+		if session, err = s.store.GetSession(ctx, id); err != nil {
+			if errors.Is(err, errSessionNotFound) {
+				return &ErrorSessionNotFound
+			}
+			logger.Error("Failed to retrieve SSO session", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		if session.ClientIDs, err = s.store.GetSessionClients(ctx, id); err != nil {
+			logger.Error("Failed to retrieve SSO session clients", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+	}
+
+	if err := s.store.DeleteSession(ctx, id); err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			return &ErrorSessionNotFound
+		}
+		logger.Error("Failed to delete SSO session", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	for _, listener := range s.listeners {
+		listener.OnSessionTerminated(ctx, session)
+	}
+
+	return nil
+}
+
+// AddSessionClient records a client that was issued tokens in an SSO session.
+func (s *ssoSessionService) AddSessionClient(ctx context.Context, id, clientID string) *serviceerror.ServiceError {
+	if strings.TrimSpace(id) == "" {
+		return &ErrorMissingSessionID
+	}
+	if err := s.store.AddSessionClient(ctx, id, clientID); err != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to record SSO session client", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	return nil
+}
+
+// GetSessionClients retrieves the clients that were issued tokens in an SSO session.
+func (s *ssoSessionService) GetSessionClients(ctx context.Context, id string) ([]string, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorMissingSessionID
+	}
+	clientIDs, err := s.store.GetSessionClients(ctx, id)
+	if err != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to retrieve SSO session clients", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return clientIDs, nil
+}
+
+// AddTerminationListener registers a listener that is notified when an SSO session is deleted.
+func (s *ssoSessionService) AddTerminationListener(listener SessionTerminationListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+// isActive reports whether an SSO session is within its absolute timeout and, unless it is persistent, within
+// its idle timeout.
+func (s *ssoSessionService) isActive(session SSOSession, now time.Time) bool {
+	if !now.Before(session.ExpiryTime) {
+		return false
+	}
+	return session.Persistent || now.Before(session.LastAccessedAt.Add(s.idleTimeout))
+}
+
+// revokeSession deletes an SSO session that may no longer be used.
+func (s *ssoSessionService) revokeSession(ctx context.Context, id string) {
+	if err := s.store.DeleteSession(ctx, id); err != nil && !errors.Is(err, errSessionNotFound) {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to delete SSO session", log.Error(err))
+	}
+}
+
+// generateSessionToken generates a cryptographically random token for a persistent SSO session.
+func generateSessionToken() (string, error) {
+	b := make([]byte, sessionTokenRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
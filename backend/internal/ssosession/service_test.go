@@ -0,0 +1,493 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// SSOSessionServiceTestSuite is the test suite for the SSO session service.
+type SSOSessionServiceTestSuite struct {
+	suite.Suite
+	service   SSOSessionServiceInterface
+	mockStore *sessionStoreInterfaceMock
+	ctx       context.Context
+}
+
+func TestSSOSessionServiceSuite(t *testing.T) {
+	suite.Run(t, new(SSOSessionServiceTestSuite))
+}
+
+func (suite *SSOSessionServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		SSOSession: config.SSOSessionConfig{
+			Enabled:         true,
+			IdleTimeout:     1800,
+			AbsoluteTimeout: 28800,
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	suite.mockStore = newSessionStoreInterfaceMock(suite.T())
+	suite.service = newSSOSessionService(suite.mockStore)
+	suite.ctx = context.Background()
+}
+
+func (suite *SSOSessionServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// activeSession returns a session that is within both the idle and absolute timeouts.
+func (suite *SSOSessionServiceTestSuite) activeSession() SSOSession {
+	now := time.Now()
+	return SSOSession{
+		ID:             "test-session-id",
+		UserID:         "test-user",
+		AuthTime:       now.Add(-time.Hour),
+		CreatedAt:      now.Add(-time.Hour),
+		LastAccessedAt: now.Add(-time.Minute),
+		ExpiryTime:     now.Add(time.Hour),
+	}
+}
+
+// persistentSession returns an active persistent session holding the given token.
+func (suite *SSOSessionServiceTestSuite) persistentSession(token string) SSOSession {
+	session := suite.activeSession()
+	session.Persistent = true
+	session.TokenHash = hash.GenerateThumbprintFromString(token)
+	session.LastAccessedAt = time.Now().Add(-24 * time.Hour)
+	session.ExpiryTime = time.Now().Add(24 * time.Hour)
+	return session
+}
+
+func (suite *SSOSessionServiceTestSuite) TestIsEnabled() {
+	assert.True(suite.T(), suite.service.IsEnabled())
+}
+
+// Tests for CreateSession
+
+func (suite *SSOSessionServiceTestSuite) TestCreateSession_Success() {
+	authTime := time.Now().Add(-time.Minute)
+	suite.mockStore.On("CreateSession", suite.ctx, mock.MatchedBy(func(s SSOSession) bool {
+		return s.ID != "" && s.UserID == "test-user" && s.AuthTime.Equal(authTime) &&
+			s.ExpiryTime.Sub(s.CreatedAt) == 8*time.Hour && s.LastAccessedAt.Equal(s.CreatedAt)
+	})).Return(nil).Once()
+
+	result, err := suite.service.CreateSession(suite.ctx, &SSOSession{
+		UserID:           "test-user",
+		AuthTime:         authTime,
+		CompletedACR:     "mfa",
+		AttributeCacheID: "test-cache-id",
+	})
+
+	assert.Nil(suite.T(), err)
+	assert.NotEmpty(suite.T(), result.ID)
+	assert.Equal(suite.T(), "mfa", result.CompletedACR)
+	assert.Equal(suite.T(), "test-cache-id", result.AttributeCacheID)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestCreateSession_DefaultsAuthTime() {
+	suite.mockStore.On("CreateSession", suite.ctx, mock.Anything).Return(nil).Once()
+
+	result, err := suite.service.CreateSession(suite.ctx, &SSOSession{UserID: "test-user"})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), result.CreatedAt, result.AuthTime)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestCreateSession_MissingUser() {
+	result, err := suite.service.CreateSession(suite.ctx, &SSOSession{})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorInvalidSession, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestCreateSession_NilSession() {
+	result, err := suite.service.CreateSession(suite.ctx, nil)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorInvalidSession, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestCreateSession_StoreError() {
+	suite.mockStore.On("CreateSession", suite.ctx, mock.Anything).Return(errors.New("db error")).Once()
+
+	result, err := suite.service.CreateSession(suite.ctx, &SSOSession{UserID: "test-user"})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &serviceerror.InternalServerError, err)
+}
+
+// Tests for CreatePersistentSession
+
+func (suite *SSOSessionServiceTestSuite) TestCreatePersistentSession_Success() {
+	suite.mockStore.On("CreateSession", suite.ctx, mock.MatchedBy(func(s SSOSession) bool {
+		return s.Persistent && s.Token == "" && s.TokenHash != "" &&
+			s.ExpiryTime.Sub(s.CreatedAt) == 30*24*time.Hour
+	})).Return(nil).Once()
+
+	result, err := suite.service.CreatePersistentSession(suite.ctx, &SSOSession{UserID: "test-user"},
+		30*24*time.Hour)
+
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), result.Persistent)
+	assert.NotEmpty(suite.T(), result.Token)
+	assert.Equal(suite.T(), hash.GenerateThumbprintFromString(result.Token), result.TokenHash)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestCreatePersistentSession_InvalidLifetime() {
+	result, err := suite.service.CreatePersistentSession(suite.ctx, &SSOSession{UserID: "test-user"}, 0)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorInvalidSession, err)
+}
+
+// Tests for GetSession
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_Success() {
+	session := suite.activeSession()
+	suite.mockStore.On("GetSession", suite.ctx, session.ID).Return(session, nil).Once()
+	suite.mockStore.On("UpdateLastAccessedTime", suite.ctx, session.ID, mock.AnythingOfType("time.Time")).
+		Return(nil).Once()
+
+	result, err := suite.service.GetSession(suite.ctx, session.ID)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), session.UserID, result.UserID)
+	assert.True(suite.T(), result.LastAccessedAt.After(session.LastAccessedAt))
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_MissingID() {
+	result, err := suite.service.GetSession(suite.ctx, " ")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorMissingSessionID, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_NotFound() {
+	suite.mockStore.On("GetSession", suite.ctx, "test-session-id").
+		Return(SSOSession{}, errSessionNotFound).Once()
+
+	result, err := suite.service.GetSession(suite.ctx, "test-session-id")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorSessionNotFound, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_StoreError() {
+	suite.mockStore.On("GetSession", suite.ctx, "test-session-id").
+		Return(SSOSession{}, errors.New("db error")).Once()
+
+	result, err := suite.service.GetSession(suite.ctx, "test-session-id")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &serviceerror.InternalServerError, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_AbsoluteTimeoutExceeded() {
+	session := suite.activeSession()
+	session.ExpiryTime = time.Now().Add(-time.Second)
+	suite.mockStore.On("GetSession", suite.ctx, session.ID).Return(session, nil).Once()
+	suite.mockStore.On("DeleteSession", suite.ctx, session.ID).Return(nil).Once()
+
+	result, err := suite.service.GetSession(suite.ctx, session.ID)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorSessionNotFound, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_IdleTimeoutExceeded() {
+	session := suite.activeSession()
+	session.LastAccessedAt = time.Now().Add(-31 * time.Minute)
+	suite.mockStore.On("GetSession", suite.ctx, session.ID).Return(session, nil).Once()
+	suite.mockStore.On("DeleteSession", suite.ctx, session.ID).Return(nil).Once()
+
+	result, err := suite.service.GetSession(suite.ctx, session.ID)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorSessionNotFound, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_UpdateError() {
+	session := suite.activeSession()
+	suite.mockStore.On("GetSession", suite.ctx, session.ID).Return(session, nil).Once()
+	suite.mockStore.On("UpdateLastAccessedTime", suite.ctx, session.ID, mock.AnythingOfType("time.Time")).
+		Return(errors.New("db error")).Once()
+
+	result, err := suite.service.GetSession(suite.ctx, session.ID)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &serviceerror.InternalServerError, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_PersistentSession() {
+	session := suite.persistentSession("test-token")
+	suite.mockStore.On("GetSession", suite.ctx, session.ID).Return(session, nil).Once()
+	suite.mockStore.On("UpdateLastAccessedTime", suite.ctx, session.ID, mock.AnythingOfType("time.Time")).
+		Return(nil).Once()
+
+	result, err := suite.service.GetSession(suite.ctx, session.ID+".test-token")
+
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), result.Persistent)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_PersistentSessionStaleToken() {
+	session := suite.persistentSession("test-token")
+	suite.mockStore.On("GetSession", suite.ctx, session.ID).Return(session, nil).Once()
+	suite.mockStore.On("DeleteSession", suite.ctx, session.ID).Return(nil).Once()
+
+	result, err := suite.service.GetSession(suite.ctx, session.ID+".stale-token")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorSessionNotFound, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSession_PersistentSessionMissingToken() {
+	session := suite.persistentSession("test-token")
+	suite.mockStore.On("GetSession", suite.ctx, session.ID).Return(session, nil).Once()
+	suite.mockStore.On("DeleteSession", suite.ctx, session.ID).Return(nil).Once()
+
+	result, err := suite.service.GetSession(suite.ctx, session.ID)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorSessionNotFound, err)
+}
+
+// Tests for RotateSessionToken
+
+func (suite *SSOSessionServiceTestSuite) TestRotateSessionToken_Success() {
+	session := suite.persistentSession("test-token")
+	var newTokenHash string
+	suite.mockStore.On("UpdateSessionToken", suite.ctx, session.ID, session.TokenHash,
+		mock.MatchedBy(func(h string) bool {
+			newTokenHash = h
+			return h != session.TokenHash
+		}), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	result, err := suite.service.RotateSessionToken(suite.ctx, &session)
+
+	assert.Nil(suite.T(), err)
+	assert.NotEmpty(suite.T(), result.Token)
+	assert.Equal(suite.T(), newTokenHash, result.TokenHash)
+	assert.Equal(suite.T(), hash.GenerateThumbprintFromString(result.Token), result.TokenHash)
+	assert.Equal(suite.T(), hash.GenerateThumbprintFromString("test-token"), session.TokenHash)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestRotateSessionToken_NotPersistent() {
+	session := suite.activeSession()
+
+	result, err := suite.service.RotateSessionToken(suite.ctx, &session)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorInvalidSession, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestRotateSessionToken_ConcurrentRotation() {
+	session := suite.persistentSession("test-token")
+	suite.mockStore.On("UpdateSessionToken", suite.ctx, session.ID, session.TokenHash, mock.Anything,
+		mock.AnythingOfType("time.Time")).Return(errSessionNotFound).Once()
+
+	result, err := suite.service.RotateSessionToken(suite.ctx, &session)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorSessionNotFound, err)
+}
+
+// Tests for ListUserSessions
+
+func (suite *SSOSessionServiceTestSuite) TestListUserSessions_Success() {
+	active := suite.activeSession()
+	idle := suite.activeSession()
+	idle.ID = "idle-session-id"
+	idle.LastAccessedAt = time.Now().Add(-time.Hour)
+	persistent := suite.persistentSession("test-token")
+	persistent.ID = "persistent-session-id"
+	suite.mockStore.On("ListSessionsByUser", suite.ctx, "test-user", mock.AnythingOfType("time.Time")).
+		Return([]SSOSession{active, idle, persistent}, nil).Once()
+
+	result, err := suite.service.ListUserSessions(suite.ctx, "test-user")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []SSOSession{active, persistent}, result)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestListUserSessions_MissingUserID() {
+	result, err := suite.service.ListUserSessions(suite.ctx, "")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorMissingUserID, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestListUserSessions_StoreError() {
+	suite.mockStore.On("ListSessionsByUser", suite.ctx, "test-user", mock.AnythingOfType("time.Time")).
+		Return(nil, errors.New("db error")).Once()
+
+	result, err := suite.service.ListUserSessions(suite.ctx, "test-user")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &serviceerror.InternalServerError, err)
+}
+
+// Tests for DeleteSession
+
+func (suite *SSOSessionServiceTestSuite) TestDeleteSession_Success() {
+	suite.mockStore.On("DeleteSession", suite.ctx, "test-session-id").Return(nil).Once()
+
+	err := suite.service.DeleteSession(suite.ctx, "test-session-id")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestDeleteSession_MissingID() {
+	err := suite.service.DeleteSession(suite.ctx, "")
+
+	assert.Equal(suite.T(), &ErrorMissingSessionID, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestDeleteSession_NotFound() {
+	suite.mockStore.On("DeleteSession", suite.ctx, "test-session-id").Return(errSessionNotFound).Once()
+
+	err := suite.service.DeleteSession(suite.ctx, "test-session-id")
+
+	assert.Equal(suite.T(), &ErrorSessionNotFound, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestDeleteSession_StoreError() {
+	suite.mockStore.On("DeleteSession", suite.ctx, "test-session-id").Return(errors.New("db error")).Once()
+
+	err := suite.service.DeleteSession(suite.ctx, "test-session-id")
+
+	assert.Equal(suite.T(), &serviceerror.InternalServerError, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestDeleteSession_NotifiesListeners() {
+	session := suite.activeSession()
+	listener := &terminationListenerStub{}
+	suite.service.AddTerminationListener(listener)
+	suite.mockStore.On("GetSession", suite.ctx, "test-session-id").Return(session, nil).Once()
+	suite.mockStore.On("GetSessionClients", suite.ctx, "test-session-id").
+		Return([]string{"client-a", "client-b"}, nil).Once()
+	suite.mockStore.On("DeleteSession", suite.ctx, "test-session-id").Return(nil).Once()
+
+	err := suite.service.DeleteSession(suite.ctx, "test-session-id")
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), listener.sessions, 1)
+	assert.Equal(suite.T(), "test-user", listener.sessions[0].UserID)
+	assert.Equal(suite.T(), []string{"client-a", "client-b"}, listener.sessions[0].ClientIDs)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestDeleteSession_WithListenerNotFound() {
+	listener := &terminationListenerStub{}
+	suite.service.AddTerminationListener(listener)
+	suite.mockStore.On("GetSession", suite.ctx, "test-session-id").
+		Return(SSOSession{}, errSessionNotFound).Once()
+
+	err := suite.service.DeleteSession(suite.ctx, "test-session-id")
+
+	assert.Equal(suite.T(), &ErrorSessionNotFound, err)
+	assert.Empty(suite.T(), listener.sessions)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestDeleteSession_ListenerNotNotifiedOnDeleteError() {
+	listener := &terminationListenerStub{}
+	suite.service.AddTerminationListener(listener)
+	suite.mockStore.On("GetSession", suite.ctx, "test-session-id").Return(suite.activeSession(), nil).Once()
+	suite.mockStore.On("GetSessionClients", suite.ctx, "test-session-id").Return([]string{}, nil).Once()
+	suite.mockStore.On("DeleteSession", suite.ctx, "test-session-id").Return(errors.New("db error")).Once()
+
+	err := suite.service.DeleteSession(suite.ctx, "test-session-id")
+
+	assert.Equal(suite.T(), &serviceerror.InternalServerError, err)
+	assert.Empty(suite.T(), listener.sessions)
+}
+
+// Tests for AddSessionClient
+
+func (suite *SSOSessionServiceTestSuite) TestAddSessionClient_Success() {
+	suite.mockStore.On("AddSessionClient", suite.ctx, "test-session-id", "test-client").Return(nil).Once()
+
+	err := suite.service.AddSessionClient(suite.ctx, "test-session-id", "test-client")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestAddSessionClient_MissingID() {
+	err := suite.service.AddSessionClient(suite.ctx, "", "test-client")
+
+	assert.Equal(suite.T(), &ErrorMissingSessionID, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestAddSessionClient_StoreError() {
+	suite.mockStore.On("AddSessionClient", suite.ctx, "test-session-id", "test-client").
+		Return(errors.New("db error")).Once()
+
+	err := suite.service.AddSessionClient(suite.ctx, "test-session-id", "test-client")
+
+	assert.Equal(suite.T(), &serviceerror.InternalServerError, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSessionClients_Success() {
+	suite.mockStore.On("GetSessionClients", suite.ctx, "test-session-id").
+		Return([]string{"client-a", "client-b"}, nil).Once()
+
+	clientIDs, err := suite.service.GetSessionClients(suite.ctx, "test-session-id")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{"client-a", "client-b"}, clientIDs)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSessionClients_MissingID() {
+	clientIDs, err := suite.service.GetSessionClients(suite.ctx, " ")
+
+	assert.Nil(suite.T(), clientIDs)
+	assert.Equal(suite.T(), &ErrorMissingSessionID, err)
+}
+
+func (suite *SSOSessionServiceTestSuite) TestGetSessionClients_StoreError() {
+	suite.mockStore.On("GetSessionClients", suite.ctx, "test-session-id").
+		Return(nil, errors.New("db error")).Once()
+
+	clientIDs, err := suite.service.GetSessionClients(suite.ctx, "test-session-id")
+
+	assert.Nil(suite.T(), clientIDs)
+	assert.Equal(suite.T(), &serviceerror.InternalServerError, err)
+}
+
+// terminationListenerStub records the sessions it is notified of.
+type terminationListenerStub struct {
+	sessions []SSOSession
+}
+
+func (l *terminationListenerStub) OnSessionTerminated(_ context.Context, session SSOSession) {
+	l.sessions = append(l.sessions, session)
+}
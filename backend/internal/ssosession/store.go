@@ -0,0 +1,295 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// sessionStoreInterface defines the interface for the SSO session store.
+type sessionStoreInterface interface {
+	// CreateSession creates a new SSO session in the store.
+	CreateSession(ctx context.Context, session SSOSession) error
+
+	// GetSession retrieves an SSO session by ID from the store.
+	GetSession(ctx context.Context, id string) (SSOSession, error)
+
+	// UpdateLastAccessedTime updates the time at which an SSO session was last used.
+	UpdateLastAccessedTime(ctx context.Context, id string, lastAccessedAt time.Time) error
+
+	// UpdateSessionToken replaces the token hash of a persistent SSO session and records its use. It fails
+	// with errSessionNotFound if the session no longer holds the current token hash.
+	UpdateSessionToken(ctx context.Context, id, currentTokenHash, newTokenHash string,
+		lastAccessedAt time.Time) error
+
+	// ListSessionsByUser lists the SSO sessions of a user that have not expired by the given time.
+	ListSessionsByUser(ctx context.Context, userID string, now time.Time) ([]SSOSession, error)
+
+	// DeleteSession deletes an SSO session by ID from the store, along with the clients recorded for it.
+	DeleteSession(ctx context.Context, id string) error
+
+	// AddSessionClient records a client that was issued tokens in an SSO session. Recording a client again or
+	// for a session that no longer exists has no effect.
+	AddSessionClient(ctx context.Context, id, clientID string) error
+
+	// GetSessionClients retrieves the clients that were issued tokens in an SSO session.
+	GetSessionClients(ctx context.Context, id string) ([]string, error)
+}
+
+// sessionStore is the SQL implementation of sessionStoreInterface.
+type sessionStore struct {
+	dbProvider   dbprovider.DBProviderInterface
+	deploymentID string
+}
+
+// newSessionStore creates a new instance of sessionStore.
+func newSessionStore() sessionStoreInterface {
+	return &sessionStore{
+		dbProvider:   dbprovider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateSession creates a new SSO session in the database.
+func (s *sessionStore) CreateSession(ctx context.Context, session SSOSession) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryInsertSession, session.ID, session.UserID, session.AuthTime,
+		session.CompletedACR, session.CompletedAMR, session.AttributeCacheID, session.TokenHash, session.CreatedAt,
+		session.LastAccessedAt, session.ExpiryTime, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to insert SSO session: %w", err)
+	}
+	if rows == 0 {
+		return errors.New("no rows affected, SSO session creation failed")
+	}
+
+	return nil
+}
+
+// GetSession retrieves an SSO session by ID from the database.
+func (s *sessionStore) GetSession(ctx context.Context, id string) (SSOSession, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return SSOSession{}, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSession, id, s.deploymentID)
+	if err != nil {
+		return SSOSession{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return SSOSession{}, errSessionNotFound
+	}
+	if len(results) > 1 {
+		return SSOSession{}, errors.New("multiple SSO sessions found")
+	}
+
+	session, err := buildSessionFromResultRow(results[0])
+	if err != nil {
+		return SSOSession{}, fmt.Errorf("failed to build SSO session from result row: %w", err)
+	}
+
+	return session, nil
+}
+
+// UpdateLastAccessedTime updates the time at which an SSO session was last used in the database.
+func (s *sessionStore) UpdateLastAccessedTime(ctx context.Context, id string, lastAccessedAt time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryUpdateSessionLastAccessedTime, id, lastAccessedAt,
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update SSO session: %w", err)
+	}
+	if rows == 0 {
+		return errSessionNotFound
+	}
+
+	return nil
+}
+
+// UpdateSessionToken replaces the token hash of a persistent SSO session in the database. The update only
+// applies while the session holds the current token hash, so a token can be exchanged only once.
+func (s *sessionStore) UpdateSessionToken(
+	ctx context.Context, id, currentTokenHash, newTokenHash string, lastAccessedAt time.Time,
+) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryUpdateSessionToken, id, newTokenHash, lastAccessedAt,
+		currentTokenHash, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to update SSO session token: %w", err)
+	}
+	if rows == 0 {
+		return errSessionNotFound
+	}
+
+	return nil
+}
+
+// ListSessionsByUser lists the SSO sessions of a user that have not expired by the given time from the database.
+func (s *sessionStore) ListSessionsByUser(ctx context.Context, userID string, now time.Time) ([]SSOSession, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListSessionsByUser, userID, now, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	sessions := make([]SSOSession, 0, len(results))
+	for _, row := range results {
+		session, err := buildSessionFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build SSO session from result row: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// DeleteSession deletes an SSO session and the clients recorded for it by ID from the database.
+func (s *sessionStore) DeleteSession(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteSessionClients, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete SSO session clients: %w", err)
+	}
+	rows, err := dbClient.ExecuteContext(ctx, queryDeleteSession, id, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete SSO session: %w", err)
+	}
+	if rows == 0 {
+		return errSessionNotFound
+	}
+
+	return nil
+}
+
+// AddSessionClient records a client that was issued tokens in an SSO session in the database. The client is
+// kept until the session expires.
+func (s *sessionStore) AddSessionClient(ctx context.Context, id, clientID string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryInsertSessionClient, id, clientID, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to insert SSO session client: %w", err)
+	}
+
+	return nil
+}
+
+// GetSessionClients retrieves the clients that were issued tokens in an SSO session from the database.
+func (s *sessionStore) GetSessionClients(ctx context.Context, id string) ([]string, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetSessionClients, id, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	clientIDs := make([]string, 0, len(results))
+	for _, row := range results {
+		clientID, ok := row["client_id"].(string)
+		if !ok {
+			return nil, errors.New("failed to parse client_id as string")
+		}
+		clientIDs = append(clientIDs, clientID)
+	}
+
+	return clientIDs, nil
+}
+
+// buildSessionFromResultRow builds an SSOSession object from a database result row.
+func buildSessionFromResultRow(row map[string]interface{}) (SSOSession, error) {
+	id, ok := row["session_id"].(string)
+	if !ok {
+		return SSOSession{}, errors.New("failed to parse session_id as string")
+	}
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return SSOSession{}, errors.New("failed to parse user_id as string")
+	}
+
+	session := SSOSession{
+		ID:               id,
+		UserID:           userID,
+		CompletedACR:     parseOptionalString(row["completed_acr"]),
+		CompletedAMR:     parseOptionalString(row["completed_amr"]),
+		AttributeCacheID: parseOptionalString(row["attribute_cache_id"]),
+		TokenHash:        parseOptionalString(row["token_hash"]),
+	}
+	session.Persistent = session.TokenHash != ""
+
+	var err error
+	if session.AuthTime, err = dbutils.ParseTimeField(row["auth_time"], "auth_time"); err != nil {
+		return SSOSession{}, err
+	}
+	if session.CreatedAt, err = dbutils.ParseTimeField(row["created_at"], "created_at"); err != nil {
+		return SSOSession{}, err
+	}
+	if session.LastAccessedAt, err = dbutils.ParseTimeField(row["last_accessed_at"], "last_accessed_at"); err != nil {
+		return SSOSession{}, err
+	}
+	if session.ExpiryTime, err = dbutils.ParseTimeField(row["expiry_time"], "expiry_time"); err != nil {
+		return SSOSession{}, err
+	}
+
+	return session, nil
+}
+
+// parseOptionalString parses a nullable string field from the database result.
+func parseOptionalString(field interface{}) string {
+	switch v := field.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryInsertSession inserts a new SSO session.
+	queryInsertSession = dbmodel.DBQuery{
+		ID: "SSQ-01",
+		Query: `INSERT INTO "SSO_SESSION" (SESSION_ID, USER_ID, AUTH_TIME, COMPLETED_ACR, COMPLETED_AMR, ` +
+			`ATTRIBUTE_CACHE_ID, TOKEN_HASH, CREATED_AT, LAST_ACCESSED_AT, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+	}
+
+	// queryGetSession retrieves an SSO session by ID.
+	queryGetSession = dbmodel.DBQuery{
+		ID: "SSQ-02",
+		Query: `SELECT SESSION_ID, USER_ID, AUTH_TIME, COMPLETED_ACR, COMPLETED_AMR, ATTRIBUTE_CACHE_ID, TOKEN_HASH, ` +
+			`CREATED_AT, LAST_ACCESSED_AT, EXPIRY_TIME FROM "SSO_SESSION" WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpdateSessionLastAccessedTime updates the last accessed time of an SSO session.
+	queryUpdateSessionLastAccessedTime = dbmodel.DBQuery{
+		ID: "SSQ-03",
+		Query: `UPDATE "SSO_SESSION" SET LAST_ACCESSED_AT = $2 ` +
+			`WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryDeleteSession deletes an SSO session by ID.
+	queryDeleteSession = dbmodel.DBQuery{
+		ID:    "SSQ-04",
+		Query: `DELETE FROM "SSO_SESSION" WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpdateSessionToken replaces the token hash of a persistent SSO session, provided it still holds the
+	// expected token hash.
+	queryUpdateSessionToken = dbmodel.DBQuery{
+		ID: "SSQ-05",
+		Query: `UPDATE "SSO_SESSION" SET TOKEN_HASH = $2, LAST_ACCESSED_AT = $3 ` +
+			`WHERE SESSION_ID = $1 AND TOKEN_HASH = $4 AND DEPLOYMENT_ID = $5`,
+	}
+
+	// queryListSessionsByUser lists the unexpired SSO sessions of a user, most recent first.
+	queryListSessionsByUser = dbmodel.DBQuery{
+		ID: "SSQ-06",
+		Query: `SELECT SESSION_ID, USER_ID, AUTH_TIME, COMPLETED_ACR, COMPLETED_AMR, ATTRIBUTE_CACHE_ID, TOKEN_HASH, ` +
+			`CREATED_AT, LAST_ACCESSED_AT, EXPIRY_TIME FROM "SSO_SESSION" ` +
+			`WHERE USER_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3 ORDER BY CREATED_AT DESC`,
+	}
+
+	// queryInsertSessionClient records a client that was issued tokens in an SSO session.
+	queryInsertSessionClient = dbmodel.DBQuery{
+		ID: "SSQ-07",
+		Query: `INSERT INTO "SSO_SESSION_CLIENT" (SESSION_ID, CLIENT_ID, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+			`SELECT SESSION_ID, $2, EXPIRY_TIME, DEPLOYMENT_ID FROM "SSO_SESSION" ` +
+			`WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $3 ` +
+			`ON CONFLICT (SESSION_ID, CLIENT_ID, DEPLOYMENT_ID) DO NOTHING`,
+	}
+
+	// queryGetSessionClients retrieves the clients that were issued tokens in an SSO session.
+	queryGetSessionClients = dbmodel.DBQuery{
+		ID:    "SSQ-08",
+		Query: `SELECT CLIENT_ID FROM "SSO_SESSION_CLIENT" WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteSessionClients deletes the clients recorded for an SSO session.
+	queryDeleteSessionClients = dbmodel.DBQuery{
+		ID:    "SSQ-09",
+		Query: `DELETE FROM "SSO_SESSION_CLIENT" WHERE SESSION_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,375 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ssosession
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+// SessionStoreTestSuite is the test suite for the SSO session store.
+type SessionStoreTestSuite struct {
+	suite.Suite
+	store            *sessionStore
+	mockDBProvider   *providermock.DBProviderInterfaceMock
+	mockDBClient     *providermock.DBClientInterfaceMock
+	ctx              context.Context
+	testSession      SSOSession
+	testDeploymentID string
+}
+
+func TestSessionStoreSuite(t *testing.T) {
+	suite.Run(t, new(SessionStoreTestSuite))
+}
+
+func (suite *SessionStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.ctx = context.Background()
+	suite.testDeploymentID = "test-deployment-id"
+
+	now := time.Now()
+	suite.testSession = SSOSession{
+		ID:               "test-session-id",
+		UserID:           "test-user",
+		AuthTime:         now,
+		CompletedACR:     "mfa",
+		CompletedAMR:     "pwd otp",
+		AttributeCacheID: "test-cache-id",
+		CreatedAt:        now,
+		LastAccessedAt:   now,
+		ExpiryTime:       now.Add(time.Hour),
+	}
+
+	suite.store = &sessionStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: suite.testDeploymentID,
+	}
+}
+
+// resultRow returns a database result row for the test session.
+func (suite *SessionStoreTestSuite) resultRow() map[string]interface{} {
+	return map[string]interface{}{
+		"session_id":         suite.testSession.ID,
+		"user_id":            suite.testSession.UserID,
+		"auth_time":          suite.testSession.AuthTime,
+		"completed_acr":      suite.testSession.CompletedACR,
+		"completed_amr":      suite.testSession.CompletedAMR,
+		"attribute_cache_id": suite.testSession.AttributeCacheID,
+		"token_hash":         suite.testSession.TokenHash,
+		"created_at":         suite.testSession.CreatedAt,
+		"last_accessed_at":   suite.testSession.LastAccessedAt,
+		"expiry_time":        suite.testSession.ExpiryTime,
+	}
+}
+
+// Tests for CreateSession
+
+func (suite *SessionStoreTestSuite) TestCreateSession_Success() {
+	s := suite.testSession
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryInsertSession, s.ID, s.UserID, s.AuthTime,
+		s.CompletedACR, s.CompletedAMR, s.AttributeCacheID, s.TokenHash, s.CreatedAt, s.LastAccessedAt, s.ExpiryTime,
+		suite.testDeploymentID).
+		Return(int64(1), nil).Once()
+
+	err := suite.store.CreateSession(suite.ctx, s)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SessionStoreTestSuite) TestCreateSession_DBProviderError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db provider error")).Once()
+
+	err := suite.store.CreateSession(suite.ctx, suite.testSession)
+
+	assert.NotNil(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "failed to get database client")
+}
+
+func (suite *SessionStoreTestSuite) TestCreateSession_NoRowsAffected() {
+	s := suite.testSession
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryInsertSession, s.ID, s.UserID, s.AuthTime,
+		s.CompletedACR, s.CompletedAMR, s.AttributeCacheID, s.TokenHash, s.CreatedAt, s.LastAccessedAt, s.ExpiryTime,
+		suite.testDeploymentID).
+		Return(int64(0), nil).Once()
+
+	err := suite.store.CreateSession(suite.ctx, s)
+
+	assert.NotNil(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "no rows affected")
+}
+
+// Tests for GetSession
+
+func (suite *SessionStoreTestSuite) TestGetSession_Success() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetSession, suite.testSession.ID, suite.testDeploymentID).
+		Return([]map[string]interface{}{suite.resultRow()}, nil).Once()
+
+	result, err := suite.store.GetSession(suite.ctx, suite.testSession.ID)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), suite.testSession, result)
+}
+
+func (suite *SessionStoreTestSuite) TestGetSession_SQLiteTimeStrings() {
+	row := suite.resultRow()
+	row["auth_time"] = "2026-01-02 15:04:05.123456789 +0000 UTC"
+	row["created_at"] = "2026-01-02 15:04:05"
+	row["last_accessed_at"] = "2026-01-02T15:04:05Z"
+	row["expiry_time"] = "2026-01-02 23:04:05"
+	row["completed_acr"] = nil
+	row["attribute_cache_id"] = []byte("test-cache-id")
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetSession, suite.testSession.ID, suite.testDeploymentID).
+		Return([]map[string]interface{}{row}, nil).Once()
+
+	result, err := suite.store.GetSession(suite.ctx, suite.testSession.ID)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), result.CompletedACR)
+	assert.Equal(suite.T(), "test-cache-id", result.AttributeCacheID)
+	assert.Equal(suite.T(), time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), result.LastAccessedAt)
+	assert.Equal(suite.T(), time.Date(2026, 1, 2, 23, 4, 5, 0, time.UTC), result.ExpiryTime)
+}
+
+func (suite *SessionStoreTestSuite) TestGetSession_NotFound() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetSession, suite.testSession.ID, suite.testDeploymentID).
+		Return([]map[string]interface{}{}, nil).Once()
+
+	_, err := suite.store.GetSession(suite.ctx, suite.testSession.ID)
+
+	assert.ErrorIs(suite.T(), err, errSessionNotFound)
+}
+
+func (suite *SessionStoreTestSuite) TestGetSession_QueryError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetSession, suite.testSession.ID, suite.testDeploymentID).
+		Return(nil, errors.New("query error")).Once()
+
+	_, err := suite.store.GetSession(suite.ctx, suite.testSession.ID)
+
+	assert.NotNil(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "failed to execute query")
+}
+
+func (suite *SessionStoreTestSuite) TestGetSession_InvalidRow() {
+	row := suite.resultRow()
+	row["expiry_time"] = 12345
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetSession, suite.testSession.ID, suite.testDeploymentID).
+		Return([]map[string]interface{}{row}, nil).Once()
+
+	_, err := suite.store.GetSession(suite.ctx, suite.testSession.ID)
+
+	assert.NotNil(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "unexpected type for expiry_time")
+}
+
+func (suite *SessionStoreTestSuite) TestGetSession_PersistentSession() {
+	row := suite.resultRow()
+	row["token_hash"] = "test-token-hash"
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetSession, suite.testSession.ID, suite.testDeploymentID).
+		Return([]map[string]interface{}{row}, nil).Once()
+
+	result, err := suite.store.GetSession(suite.ctx, suite.testSession.ID)
+
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), result.Persistent)
+	assert.Equal(suite.T(), "test-token-hash", result.TokenHash)
+}
+
+// Tests for UpdateLastAccessedTime
+
+func (suite *SessionStoreTestSuite) TestUpdateLastAccessedTime_Success() {
+	now := time.Now()
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateSessionLastAccessedTime,
+		suite.testSession.ID, now, suite.testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.UpdateLastAccessedTime(suite.ctx, suite.testSession.ID, now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SessionStoreTestSuite) TestUpdateLastAccessedTime_NotFound() {
+	now := time.Now()
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateSessionLastAccessedTime,
+		suite.testSession.ID, now, suite.testDeploymentID).Return(int64(0), nil).Once()
+
+	err := suite.store.UpdateLastAccessedTime(suite.ctx, suite.testSession.ID, now)
+
+	assert.ErrorIs(suite.T(), err, errSessionNotFound)
+}
+
+// Tests for UpdateSessionToken
+
+func (suite *SessionStoreTestSuite) TestUpdateSessionToken_Success() {
+	now := time.Now()
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateSessionToken, suite.testSession.ID,
+		"new-token-hash", now, "current-token-hash", suite.testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.UpdateSessionToken(suite.ctx, suite.testSession.ID, "current-token-hash",
+		"new-token-hash", now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SessionStoreTestSuite) TestUpdateSessionToken_StaleToken() {
+	now := time.Now()
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateSessionToken, suite.testSession.ID,
+		"new-token-hash", now, "current-token-hash", suite.testDeploymentID).Return(int64(0), nil).Once()
+
+	err := suite.store.UpdateSessionToken(suite.ctx, suite.testSession.ID, "current-token-hash",
+		"new-token-hash", now)
+
+	assert.ErrorIs(suite.T(), err, errSessionNotFound)
+}
+
+// Tests for ListSessionsByUser
+
+func (suite *SessionStoreTestSuite) TestListSessionsByUser_Success() {
+	now := time.Now()
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListSessionsByUser, suite.testSession.UserID, now,
+		suite.testDeploymentID).Return([]map[string]interface{}{suite.resultRow()}, nil).Once()
+
+	result, err := suite.store.ListSessionsByUser(suite.ctx, suite.testSession.UserID, now)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []SSOSession{suite.testSession}, result)
+}
+
+func (suite *SessionStoreTestSuite) TestListSessionsByUser_QueryError() {
+	now := time.Now()
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListSessionsByUser, suite.testSession.UserID, now,
+		suite.testDeploymentID).Return(nil, errors.New("query error")).Once()
+
+	_, err := suite.store.ListSessionsByUser(suite.ctx, suite.testSession.UserID, now)
+
+	assert.NotNil(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "failed to execute query")
+}
+
+// Tests for DeleteSession
+
+func (suite *SessionStoreTestSuite) TestDeleteSession_Success() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteSessionClients,
+		suite.testSession.ID, suite.testDeploymentID).Return(int64(0), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteSession,
+		suite.testSession.ID, suite.testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.DeleteSession(suite.ctx, suite.testSession.ID)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SessionStoreTestSuite) TestDeleteSession_NotFound() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteSessionClients,
+		suite.testSession.ID, suite.testDeploymentID).Return(int64(0), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteSession,
+		suite.testSession.ID, suite.testDeploymentID).Return(int64(0), nil).Once()
+
+	err := suite.store.DeleteSession(suite.ctx, suite.testSession.ID)
+
+	assert.ErrorIs(suite.T(), err, errSessionNotFound)
+}
+
+func (suite *SessionStoreTestSuite) TestDeleteSession_ExecuteError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteSessionClients,
+		suite.testSession.ID, suite.testDeploymentID).Return(int64(0), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteSession,
+		suite.testSession.ID, suite.testDeploymentID).Return(int64(0), errors.New("database error")).Once()
+
+	err := suite.store.DeleteSession(suite.ctx, suite.testSession.ID)
+
+	assert.NotNil(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "failed to delete SSO session")
+}
+
+// Tests for AddSessionClient
+
+func (suite *SessionStoreTestSuite) TestAddSessionClient_Success() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryInsertSessionClient,
+		suite.testSession.ID, "test-client", suite.testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.AddSessionClient(suite.ctx, suite.testSession.ID, "test-client")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SessionStoreTestSuite) TestAddSessionClient_ExecuteError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryInsertSessionClient,
+		suite.testSession.ID, "test-client", suite.testDeploymentID).
+		Return(int64(0), errors.New("database error")).Once()
+
+	err := suite.store.AddSessionClient(suite.ctx, suite.testSession.ID, "test-client")
+
+	assert.NotNil(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "failed to insert SSO session client")
+}
+
+// Tests for GetSessionClients
+
+func (suite *SessionStoreTestSuite) TestGetSessionClients_Success() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetSessionClients,
+		suite.testSession.ID, suite.testDeploymentID).Return([]map[string]interface{}{
+		{"client_id": "client-a"},
+		{"client_id": "client-b"},
+	}, nil).Once()
+
+	clientIDs, err := suite.store.GetSessionClients(suite.ctx, suite.testSession.ID)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{"client-a", "client-b"}, clientIDs)
+}
+
+func (suite *SessionStoreTestSuite) TestGetSessionClients_InvalidRow() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetSessionClients,
+		suite.testSession.ID, suite.testDeploymentID).Return([]map[string]interface{}{
+		{"client_id": 1},
+	}, nil).Once()
+
+	clientIDs, err := suite.store.GetSessionClients(suite.ctx, suite.testSession.ID)
+
+	assert.Nil(suite.T(), clientIDs)
+	assert.NotNil(suite.T(), err)
+}
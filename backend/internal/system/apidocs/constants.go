@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+const (
+	apiDocsPath   = "/api-docs"
+	swaggerUIPath = "/develop"
+
+	// swaggerUIVersion is the version of the Swagger UI assets loaded by the Swagger UI page.
+	swaggerUIVersion       = "5.17.14"
+	swaggerUIAssetsBaseURL = "https://unpkg.com/swagger-ui-dist@" + swaggerUIVersion
+
+	// swaggerUIContentSecurityPolicy allows the Swagger UI page to load its assets and to call the APIs
+	// of the server.
+	swaggerUIContentSecurityPolicy = "default-src 'none'; script-src 'unsafe-inline' https://unpkg.com; " +
+		"style-src https://unpkg.com; img-src 'self' data: https://unpkg.com; connect-src 'self'; " +
+		"frame-ancestors 'none'"
+)
+
+// swaggerUITemplate is the Swagger UI page. Its arguments are the base URL of the Swagger UI assets and the
+// URL of the OpenAPI document.
+const swaggerUITemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>API Reference</title>
+  <link rel="stylesheet" href="%[1]s/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="%[1]s/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({ url: %[2]q, dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
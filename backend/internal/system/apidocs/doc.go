@@ -0,0 +1,27 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package apidocs serves the OpenAPI document of the server and an optional Swagger UI for it.
+//
+// The document is generated from the routes registered in the sources and the API specifications, and is
+// embedded into the binary. Regenerate it after changing the routes or the specifications:
+//
+//	go generate ./internal/system/apidocs
+package apidocs
+
+//go:generate go run ../../../cmd/apidocs -source ../../.. -specs ../../../../api -out openapi.json
@@ -0,0 +1,485 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	openAPIVersion  = "3.1.0"
+	documentTitle   = "ThunderID API"
+	documentVersion = "1.0"
+	componentRefFmt = "#/components/%s/%s"
+)
+
+// componentSections are the component sections merged across the API specifications. Security schemes are
+// merged separately since they are referenced by name.
+var componentSections = []string{
+	"schemas", "responses", "parameters", "examples", "requestBodies", "headers", "links", "callbacks",
+}
+
+// operationMethods are the operation keys of an OpenAPI path item.
+var operationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// pathParamRegex matches the path parameters of a path template.
+var pathParamRegex = regexp.MustCompile(`\{[^}]+\}`)
+
+// spec is an API specification loaded from the specification directory.
+type spec struct {
+	name string
+	doc  map[string]interface{}
+}
+
+// Generate builds the OpenAPI document of the server. The operations are taken from the routes registered
+// in the Go sources under sourceRoot, and are described with the matching operations of the OpenAPI
+// specifications in specDir. Routes that are not described by any specification are included with a
+// minimal operation marked with the x-undocumented extension.
+func Generate(sourceRoot, specDir string) ([]byte, error) {
+	routes, err := scanRoutes(sourceRoot)
+	if err != nil {
+		return nil, err
+	}
+	specs, err := loadSpecs(specDir)
+	if err != nil {
+		return nil, err
+	}
+
+	components := mergeComponents(specs)
+	operations := indexOperations(specs)
+
+	paths := make(map[string]interface{})
+	documented := make(map[string]bool)
+	addOperation := func(path, method string, op map[string]interface{}) {
+		pathItem, ok := paths[path].(map[string]interface{})
+		if !ok {
+			pathItem = make(map[string]interface{})
+			paths[path] = pathItem
+		}
+		pathItem[method] = op
+		documented[normalizePath(path)+" "+method] = true
+	}
+
+	for _, r := range routes {
+		method := strings.ToLower(r.Method)
+		if op, ok := operations[normalizePath(r.Path)+" "+method]; ok {
+			addOperation(r.Path, method, renamePathParams(op.operation, op.path, r.Path))
+		} else if !r.Subtree {
+			addOperation(r.Path, method, undocumentedOperation(r))
+		}
+	}
+
+	// A route ending with a slash or a wildcard matches all the paths under it, and its handler dispatches
+	// the sub paths itself. Such a route serves the documented operations under it that no other route
+	// serves.
+	for _, r := range routes {
+		method := strings.ToLower(r.Method)
+		if !r.Subtree {
+			continue
+		}
+		prefix := normalizePath(r.Path)
+		if !strings.HasSuffix(prefix, "/") {
+			prefix += "/"
+		}
+		found := documented[normalizePath(r.Path)+" "+method]
+		for _, key := range sortedKeys(operations) {
+			op := operations[key]
+			normalized := normalizePath(op.path)
+			if !strings.HasPrefix(normalized, prefix) || key != normalized+" "+method || documented[key] {
+				continue
+			}
+			addOperation(op.path, method, op.operation)
+			found = true
+		}
+		if !found {
+			addOperation(r.Path, method, undocumentedOperation(r))
+		}
+	}
+
+	doc := map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":       documentTitle,
+			"version":     documentVersion,
+			"description": "APIs served by the server, generated from the registered routes.",
+		},
+		"paths":      paths,
+		"components": components,
+		"tags":       mergeTags(specs),
+	}
+	if len(specs) > 0 {
+		if servers, ok := specs[0].doc["servers"]; ok {
+			doc["servers"] = servers
+		}
+	}
+	convertNullable(doc)
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode the OpenAPI document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// loadSpecs loads the YAML specifications at the top level of the directory, sorted by file name.
+func loadSpecs(specDir string) ([]spec, error) {
+	entries, err := os.ReadDir(specDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the specification directory: %w", err)
+	}
+
+	specs := make([]spec, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Clean(filepath.Join(specDir, entry.Name())))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(content, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", entry.Name(), err)
+		}
+		specs = append(specs, spec{name: specName(entry.Name()), doc: doc})
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].name < specs[j].name })
+	return specs, nil
+}
+
+// specName derives the prefix used for the conflicting components of a specification from its file name,
+// e.g. FlowManagement for flow-management.yaml.
+func specName(fileName string) string {
+	parts := strings.FieldsFunc(strings.TrimSuffix(fileName, ".yaml"), func(r rune) bool {
+		return r == '-' || r == '_' || r == '.'
+	})
+	var builder strings.Builder
+	for _, part := range parts {
+		builder.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return builder.String()
+}
+
+// mergeComponents merges the components of the specifications. A component defined differently by several
+// specifications is renamed with the specification name as a prefix, and the references to it within each
+// specification are updated. Security schemes with the same name are merged, combining their scopes.
+func mergeComponents(specs []spec) map[string]interface{} {
+	definitions := make(map[string]map[string][]interface{})
+	for _, section := range componentSections {
+		definitions[section] = make(map[string][]interface{})
+	}
+	for _, s := range specs {
+		for _, section := range componentSections {
+			for name, def := range getMap(getMap(s.doc, "components"), section) {
+				definitions[section][name] = appendUnique(definitions[section][name], def)
+			}
+		}
+	}
+
+	// The new names must not collide with the names of other components, including the renamed ones.
+	taken := make(map[string]map[string]bool)
+	for _, section := range componentSections {
+		taken[section] = make(map[string]bool)
+		for name := range definitions[section] {
+			taken[section][name] = true
+		}
+	}
+
+	components := make(map[string]interface{})
+	for _, section := range componentSections {
+		components[section] = make(map[string]interface{})
+	}
+	for _, s := range specs {
+		renames := make(map[string]string)
+		for _, section := range componentSections {
+			defs := getMap(getMap(s.doc, "components"), section)
+			for _, name := range sortedKeys(defs) {
+				finalName := name
+				if len(definitions[section][name]) > 1 {
+					finalName = s.name + name
+					for i := 2; taken[section][finalName]; i++ {
+						finalName = fmt.Sprintf("%s%s%d", s.name, name, i)
+					}
+					taken[section][finalName] = true
+					renames[fmt.Sprintf(componentRefFmt, section, name)] =
+						fmt.Sprintf(componentRefFmt, section, finalName)
+				}
+				components[section].(map[string]interface{})[finalName] = defs[name]
+			}
+		}
+		if len(renames) > 0 {
+			rewriteRefs(s.doc, renames)
+		}
+	}
+	for _, section := range componentSections {
+		if len(components[section].(map[string]interface{})) == 0 {
+			delete(components, section)
+		}
+	}
+
+	securitySchemes := make(map[string]interface{})
+	for _, s := range specs {
+		for name, scheme := range getMap(getMap(s.doc, "components"), "securitySchemes") {
+			securitySchemes[name] = deepMerge(securitySchemes[name], scheme)
+		}
+	}
+	if len(securitySchemes) > 0 {
+		components["securitySchemes"] = securitySchemes
+	}
+	return components
+}
+
+// documentedOperation is an operation of a specification together with the path it is documented under.
+type documentedOperation struct {
+	path      string
+	operation map[string]interface{}
+}
+
+// indexOperations indexes the operations of the specifications by normalized path and method. The path
+// level parameters and the top level security requirement are folded into each operation.
+func indexOperations(specs []spec) map[string]documentedOperation {
+	operations := make(map[string]documentedOperation)
+	for _, s := range specs {
+		security, hasSecurity := s.doc["security"]
+		paths := getMap(s.doc, "paths")
+		for _, path := range sortedKeys(paths) {
+			pathItem, ok := paths[path].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pathParams, _ := pathItem["parameters"].([]interface{})
+			for _, method := range operationMethods {
+				op, ok := pathItem[method].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if len(pathParams) > 0 {
+					opParams, _ := op["parameters"].([]interface{})
+					op["parameters"] = append(append([]interface{}{}, pathParams...), opParams...)
+				}
+				if _, ok := op["security"]; !ok && hasSecurity {
+					op["security"] = security
+				}
+				key := normalizePath(path) + " " + method
+				if _, exists := operations[key]; !exists {
+					operations[key] = documentedOperation{path: path, operation: op}
+				}
+			}
+		}
+	}
+	return operations
+}
+
+// normalizePath replaces the path parameters of a path template with empty placeholders, so that templates
+// using different parameter names can be matched.
+func normalizePath(path string) string {
+	return pathParamRegex.ReplaceAllString(path, "{}")
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// renamePathParams renames the path parameters of an operation documented under specPath to the names
+// used in the route path.
+func renamePathParams(op map[string]interface{}, specPath, routePath string) map[string]interface{} {
+	specParams := pathParamRegex.FindAllString(specPath, -1)
+	routeParams := pathParamRegex.FindAllString(routePath, -1)
+	if len(specParams) != len(routeParams) || reflect.DeepEqual(specParams, routeParams) {
+		return op
+	}
+
+	names := make(map[string]string, len(specParams))
+	for i := range specParams {
+		names[strings.Trim(specParams[i], "{}")] = strings.Trim(routeParams[i], "{}")
+	}
+	params, _ := op["parameters"].([]interface{})
+	renamed := make([]interface{}, 0, len(params))
+	for _, p := range params {
+		param, ok := p.(map[string]interface{})
+		if ok && param["in"] == "path" {
+			if name, ok := names[fmt.Sprint(param["name"])]; ok {
+				copied := make(map[string]interface{}, len(param))
+				for k, v := range param {
+					copied[k] = v
+				}
+				copied["name"] = name
+				param = copied
+			}
+		}
+		renamed = append(renamed, param)
+	}
+
+	copied := make(map[string]interface{}, len(op))
+	for k, v := range op {
+		copied[k] = v
+	}
+	copied["parameters"] = renamed
+	return copied
+}
+
+// undocumentedOperation builds the minimal operation of a route without a specification.
+func undocumentedOperation(r route) map[string]interface{} {
+	op := map[string]interface{}{
+		"summary": r.Method + " " + r.Path,
+		"responses": map[string]interface{}{
+			"default": map[string]interface{}{"description": "Response of the operation."},
+		},
+		"x-undocumented": true,
+	}
+	params := pathParamRegex.FindAllString(r.Path, -1)
+	if len(params) > 0 {
+		parameters := make([]interface{}, 0, len(params))
+		for _, p := range params {
+			parameters = append(parameters, map[string]interface{}{
+				"name":     strings.Trim(p, "{}"),
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]interface{}{"type": "string"},
+			})
+		}
+		op["parameters"] = parameters
+	}
+	return op
+}
+
+// mergeTags returns the tags of all specifications, sorted by name.
+func mergeTags(specs []spec) []interface{} {
+	tags := make(map[string]interface{})
+	for _, s := range specs {
+		list, _ := s.doc["tags"].([]interface{})
+		for _, t := range list {
+			tag, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name := fmt.Sprint(tag["name"])
+			if _, exists := tags[name]; !exists {
+				tags[name] = tag
+			}
+		}
+	}
+
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	merged := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		merged = append(merged, tags[name])
+	}
+	return merged
+}
+
+// convertNullable converts the OpenAPI 3.0 nullable keyword into the OpenAPI 3.1 null type.
+func convertNullable(node interface{}) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if nullable, ok := n["nullable"].(bool); ok {
+			delete(n, "nullable")
+			if typ, ok := n["type"].(string); ok && nullable {
+				n["type"] = []interface{}{typ, "null"}
+			}
+		}
+		for _, v := range n {
+			convertNullable(v)
+		}
+	case []interface{}:
+		for _, v := range n {
+			convertNullable(v)
+		}
+	}
+}
+
+// rewriteRefs replaces the component references of a document according to the given mapping.
+func rewriteRefs(node interface{}, renames map[string]string) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			if ref, ok := v.(string); ok && k == "$ref" {
+				if renamed, ok := renames[ref]; ok {
+					n[k] = renamed
+				}
+				continue
+			}
+			rewriteRefs(v, renames)
+		}
+	case []interface{}:
+		for _, v := range n {
+			rewriteRefs(v, renames)
+		}
+	}
+}
+
+// deepMerge merges two decoded YAML values. Maps are merged recursively and other values of the second
+// argument replace those of the first.
+func deepMerge(base, overlay interface{}) interface{} {
+	baseMap, ok := base.(map[string]interface{})
+	overlayMap, ok2 := overlay.(map[string]interface{})
+	if !ok || !ok2 {
+		return overlay
+	}
+	merged := make(map[string]interface{}, len(baseMap))
+	for k, v := range baseMap {
+		merged[k] = v
+	}
+	for k, v := range overlayMap {
+		merged[k] = deepMerge(merged[k], v)
+	}
+	return merged
+}
+
+// appendUnique appends a value unless an equal value is already present.
+func appendUnique(values []interface{}, value interface{}) []interface{} {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+// getMap returns the map stored under a key, or nil.
+func getMap(node map[string]interface{}, key string) map[string]interface{} {
+	if node == nil {
+		return nil
+	}
+	value, _ := node[key].(map[string]interface{})
+	return value
+}
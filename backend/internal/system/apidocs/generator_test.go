@@ -0,0 +1,336 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	fixtureGoMod = "module example.com/server\n\ngo 1.26\n"
+
+	fixtureConstants = `package paths
+
+const BasePath = "/things"
+`
+
+	fixtureInit = `package things
+
+import (
+	"net/http"
+
+	"example.com/server/internal/middleware"
+	"example.com/server/internal/paths"
+)
+
+const itemPath = paths.BasePath + "/{id}"
+
+func Initialize(mux *http.ServeMux, h handler) {
+	mux.HandleFunc(middleware.WithCORS("GET "+paths.BasePath, h.List, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+paths.BasePath, h.List, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+itemPath, h.Get, opts))
+	mux.HandleFunc("GET /tree/{path...}", h.Tree)
+	mux.HandleFunc("DELETE /legacy/", h.Legacy)
+	mux.Handle("/plain", h)
+	registerTypeRoutes(mux, "/user-types", h)
+	registerTypeRoutes(mux, "/agent-types", h)
+	pattern := dynamicPattern()
+	mux.HandleFunc(pattern, h.Dynamic)
+}
+
+func registerTypeRoutes(mux *http.ServeMux, basePath string, h handler) {
+	mux.HandleFunc("POST "+basePath, h.Create)
+}
+`
+
+	fixtureThingsSpec = `openapi: 3.0.3
+info:
+  title: Things
+  version: "1.0"
+servers:
+  - url: https://localhost:8090
+tags:
+  - name: things
+security:
+  - OAuth2: [system]
+paths:
+  /things:
+    get:
+      tags: [things]
+      summary: List things
+      responses:
+        "400":
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Error'
+  /things/{thingId}:
+    parameters:
+      - name: thingId
+        in: path
+        required: true
+        schema:
+          type: string
+    get:
+      summary: Get a thing
+      responses:
+        "200":
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Thing'
+  /tree/{path}/children:
+    get:
+      summary: List the children of a tree node
+  /legacy/{id}:
+    delete:
+      summary: Delete a legacy item
+  /not-served:
+    get:
+      summary: Not served
+components:
+  schemas:
+    Error:
+      type: object
+      properties:
+        code:
+          type: string
+    ThingsError:
+      type: string
+    Thing:
+      type: object
+      properties:
+        name:
+          type: string
+          nullable: true
+  securitySchemes:
+    OAuth2:
+      type: oauth2
+      flows:
+        authorizationCode:
+          scopes:
+            system: System access
+`
+
+	fixtureTypesSpec = `openapi: 3.0.3
+info:
+  title: Types
+  version: "1.0"
+tags:
+  - name: types
+  - name: things
+security: []
+paths:
+  /user-types:
+    post:
+      summary: Create a user type
+      responses:
+        "400":
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Error'
+components:
+  schemas:
+    Error:
+      type: object
+      properties:
+        message:
+          type: string
+  securitySchemes:
+    OAuth2:
+      type: oauth2
+      flows:
+        authorizationCode:
+          scopes:
+            system:types: Manage types
+`
+)
+
+type GeneratorTestSuite struct {
+	suite.Suite
+	sourceRoot string
+	specDir    string
+}
+
+func TestGeneratorTestSuite(t *testing.T) {
+	suite.Run(t, new(GeneratorTestSuite))
+}
+
+func (suite *GeneratorTestSuite) SetupTest() {
+	root := suite.T().TempDir()
+	suite.sourceRoot = filepath.Join(root, "backend")
+	suite.specDir = filepath.Join(root, "api")
+
+	suite.writeFile(filepath.Join(suite.sourceRoot, "go.mod"), fixtureGoMod)
+	suite.writeFile(filepath.Join(suite.sourceRoot, "internal", "paths", "paths.go"), fixtureConstants)
+	suite.writeFile(filepath.Join(suite.sourceRoot, "internal", "things", "init.go"), fixtureInit)
+	suite.writeFile(filepath.Join(suite.sourceRoot, "internal", "things", "init_test.go"),
+		"package things\n\nfunc f(mux *http.ServeMux) { mux.HandleFunc(\"GET /test-only\", nil) }\n")
+	suite.writeFile(filepath.Join(suite.specDir, "things.yaml"), fixtureThingsSpec)
+	suite.writeFile(filepath.Join(suite.specDir, "entity-types.yaml"), fixtureTypesSpec)
+	suite.writeFile(filepath.Join(suite.specDir, "extensions", "ignored.yaml"), "not: [valid")
+}
+
+func (suite *GeneratorTestSuite) writeFile(path, content string) {
+	suite.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o750))
+	suite.Require().NoError(os.WriteFile(path, []byte(content), 0o600))
+}
+
+func (suite *GeneratorTestSuite) generate() map[string]interface{} {
+	data, err := Generate(suite.sourceRoot, suite.specDir)
+	suite.Require().NoError(err)
+	var doc map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(data, &doc))
+	return doc
+}
+
+func (suite *GeneratorTestSuite) operation(doc map[string]interface{}, path, method string) map[string]interface{} {
+	pathItem, ok := doc["paths"].(map[string]interface{})[path].(map[string]interface{})
+	suite.Require().True(ok, "path %s not found", path)
+	op, ok := pathItem[method].(map[string]interface{})
+	suite.Require().True(ok, "operation %s %s not found", method, path)
+	return op
+}
+
+func (suite *GeneratorTestSuite) TestScanRoutes() {
+	routes, err := scanRoutes(suite.sourceRoot)
+
+	suite.Require().NoError(err)
+	suite.Equal([]route{
+		{Method: "POST", Path: "/agent-types"},
+		{Method: "DELETE", Path: "/legacy/", Subtree: true},
+		{Method: "GET", Path: "/plain"},
+		{Method: "GET", Path: "/things"},
+		{Method: "GET", Path: "/things/{id}"},
+		{Method: "GET", Path: "/tree/{path}", Subtree: true},
+		{Method: "POST", Path: "/user-types"},
+	}, routes)
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_DocumentedOperations() {
+	doc := suite.generate()
+
+	suite.Equal(openAPIVersion, doc["openapi"])
+	list := suite.operation(doc, "/things", "get")
+	suite.Equal("List things", list["summary"])
+	suite.Equal([]interface{}{map[string]interface{}{"OAuth2": []interface{}{"system"}}}, list["security"])
+
+	get := suite.operation(doc, "/things/{id}", "get")
+	suite.Equal("Get a thing", get["summary"])
+	params := get["parameters"].([]interface{})
+	suite.Require().Len(params, 1)
+	suite.Equal("id", params[0].(map[string]interface{})["name"])
+
+	create := suite.operation(doc, "/user-types", "post")
+	suite.Equal([]interface{}{}, create["security"])
+
+	suite.NotContains(doc["paths"], "/not-served")
+	suite.NotContains(doc["paths"], "/test-only")
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_SubtreeRoutes() {
+	doc := suite.generate()
+
+	suite.Equal("List the children of a tree node", suite.operation(doc, "/tree/{path}/children", "get")["summary"])
+	suite.Equal("Delete a legacy item", suite.operation(doc, "/legacy/{id}", "delete")["summary"])
+	suite.NotContains(doc["paths"], "/legacy/")
+	suite.NotContains(doc["paths"], "/tree/{path}")
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_UndocumentedOperations() {
+	doc := suite.generate()
+
+	op := suite.operation(doc, "/agent-types", "post")
+	suite.Equal(true, op["x-undocumented"])
+	suite.Equal("POST /agent-types", op["summary"])
+	suite.Contains(op, "responses")
+	suite.Equal(true, suite.operation(doc, "/plain", "get")["x-undocumented"])
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_MergesComponents() {
+	doc := suite.generate()
+
+	components := doc["components"].(map[string]interface{})
+	schemas := components["schemas"].(map[string]interface{})
+	suite.Equal(map[string]interface{}{"type": "string"}, schemas["ThingsError"])
+	suite.Contains(schemas, "ThingsError2")
+	suite.Contains(schemas, "EntityTypesError")
+	suite.NotContains(schemas, "Error")
+	suite.Contains(schemas, "Thing")
+
+	listResponse := suite.operation(doc, "/things", "get")["responses"].(map[string]interface{})["400"]
+	suite.Contains(mustJSON(suite, listResponse), "#/components/schemas/ThingsError2")
+	createResponse := suite.operation(doc, "/user-types", "post")["responses"].(map[string]interface{})["400"]
+	suite.Contains(mustJSON(suite, createResponse), "#/components/schemas/EntityTypesError")
+
+	name := schemas["Thing"].(map[string]interface{})["properties"].(map[string]interface{})["name"]
+	suite.Equal(map[string]interface{}{"type": []interface{}{"string", "null"}}, name)
+
+	oauth2 := getMap(getMap(components, "securitySchemes"), "OAuth2")
+	scopes := getMap(getMap(oauth2, "flows"), "authorizationCode")["scopes"]
+	suite.Equal(map[string]interface{}{"system": "System access", "system:types": "Manage types"}, scopes)
+
+	tags := doc["tags"].([]interface{})
+	suite.Len(tags, 2)
+	suite.Equal("things", tags[0].(map[string]interface{})["name"])
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_Deterministic() {
+	first, err := Generate(suite.sourceRoot, suite.specDir)
+	suite.Require().NoError(err)
+	second, err := Generate(suite.sourceRoot, suite.specDir)
+	suite.Require().NoError(err)
+
+	suite.Equal(first, second)
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_Errors() {
+	_, err := Generate(suite.T().TempDir(), suite.specDir)
+	suite.ErrorContains(err, "go.mod")
+
+	_, err = Generate(suite.sourceRoot, filepath.Join(suite.T().TempDir(), "missing"))
+	suite.ErrorContains(err, "specification directory")
+
+	suite.writeFile(filepath.Join(suite.specDir, "broken.yaml"), "paths: [")
+	_, err = Generate(suite.sourceRoot, suite.specDir)
+	suite.ErrorContains(err, "broken.yaml")
+}
+
+// TestOpenAPIDocumentIsUpToDate fails when the embedded OpenAPI document does not match the routes and the
+// API specifications, so that changes to either are not released without updating the document.
+func (suite *GeneratorTestSuite) TestOpenAPIDocumentIsUpToDate() {
+	doc, err := Generate(filepath.Join("..", "..", ".."), filepath.Join("..", "..", "..", "..", "api"))
+	suite.Require().NoError(err)
+
+	suite.True(bytes.Equal(doc, openAPIDocument),
+		"the OpenAPI document is out of date, run 'go generate ./internal/system/apidocs' in the backend")
+}
+
+func mustJSON(suite *GeneratorTestSuite, value interface{}) string {
+	data, err := json.Marshal(value)
+	suite.Require().NoError(err)
+	return string(data)
+}
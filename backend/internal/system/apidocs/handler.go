@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// apiDocsHandler serves the OpenAPI document and the Swagger UI page.
+type apiDocsHandler struct {
+	document  []byte
+	swaggerUI []byte
+}
+
+// newAPIDocsHandler creates a handler serving the OpenAPI document with the server URL set to the public
+// URL of the server.
+func newAPIDocsHandler(document []byte, publicURL string) (*apiDocsHandler, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(document, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse the OpenAPI document: %w", err)
+	}
+	doc["servers"] = []interface{}{map[string]interface{}{"url": publicURL}}
+	served, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the OpenAPI document: %w", err)
+	}
+
+	return &apiDocsHandler{
+		document:  served,
+		swaggerUI: []byte(fmt.Sprintf(swaggerUITemplate, swaggerUIAssetsBaseURL, publicURL+apiDocsPath)),
+	}, nil
+}
+
+// HandleAPIDocsRequest serves the OpenAPI document.
+func (h *apiDocsHandler) HandleAPIDocsRequest(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(constants.ContentTypeHeaderName, constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(h.document); err != nil {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, "APIDocsHandler")).
+			Error("Failed to write the OpenAPI document", log.Error(err))
+	}
+}
+
+// HandleSwaggerUIRequest serves the Swagger UI page for the OpenAPI document.
+func (h *apiDocsHandler) HandleSwaggerUIRequest(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(constants.ContentTypeHeaderName, "text/html; charset=utf-8")
+	w.Header().Set(constants.ContentSecurityPolicyHeaderName, swaggerUIContentSecurityPolicy)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(h.swaggerUI); err != nil {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, "APIDocsHandler")).
+			Error("Failed to write the Swagger UI page", log.Error(err))
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/constants"
+)
+
+const testPublicURL = "https://id.example.com"
+
+type HandlerTestSuite struct {
+	suite.Suite
+	handler *apiDocsHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	handler, err := newAPIDocsHandler(
+		[]byte(`{"openapi":"3.1.0","servers":[{"url":"https://localhost:8090"}],"paths":{}}`), testPublicURL)
+	suite.Require().NoError(err)
+	suite.handler = handler
+}
+
+func (suite *HandlerTestSuite) TestNewAPIDocsHandler_InvalidDocument() {
+	_, err := newAPIDocsHandler([]byte("not json"), testPublicURL)
+
+	suite.Error(err)
+}
+
+func (suite *HandlerTestSuite) TestNewAPIDocsHandler_EmbeddedDocument() {
+	handler, err := newAPIDocsHandler(openAPIDocument, testPublicURL)
+
+	suite.Require().NoError(err)
+	var doc map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(handler.document, &doc))
+	suite.Equal(openAPIVersion, doc["openapi"])
+	suite.Contains(doc["paths"], apiDocsPath)
+}
+
+func (suite *HandlerTestSuite) TestHandleAPIDocsRequest() {
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAPIDocsRequest(rr, httptest.NewRequest(http.MethodGet, apiDocsPath, nil))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal(constants.ContentTypeJSON, rr.Header().Get(constants.ContentTypeHeaderName))
+	var doc map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &doc))
+	suite.Equal([]interface{}{map[string]interface{}{"url": testPublicURL}}, doc["servers"])
+	suite.Equal("3.1.0", doc["openapi"])
+}
+
+func (suite *HandlerTestSuite) TestHandleSwaggerUIRequest() {
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleSwaggerUIRequest(rr, httptest.NewRequest(http.MethodGet, swaggerUIPath, nil))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Header().Get(constants.ContentTypeHeaderName), "text/html")
+	suite.Equal(swaggerUIContentSecurityPolicy, rr.Header().Get(constants.ContentSecurityPolicyHeaderName))
+	suite.Contains(rr.Body.String(), swaggerUIAssetsBaseURL+"/swagger-ui-bundle.js")
+	suite.Contains(rr.Body.String(), `"`+testPublicURL+apiDocsPath+`"`)
+}
+
+func (suite *HandlerTestSuite) TestRegisterRoutes() {
+	testCases := []struct {
+		name             string
+		swaggerUIEnabled bool
+		expectedUIStatus int
+	}{
+		{name: "SwaggerUIEnabled", swaggerUIEnabled: true, expectedUIStatus: http.StatusOK},
+		{name: "SwaggerUIDisabled", swaggerUIEnabled: false, expectedUIStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			mux := http.NewServeMux()
+			registerRoutes(mux, suite.handler, tc.swaggerUIEnabled)
+
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, apiDocsPath, nil))
+			suite.Equal(http.StatusOK, rr.Code)
+
+			rr = httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, swaggerUIPath, nil))
+			suite.Equal(tc.expectedUIStatus, rr.Code)
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestInitialize() {
+	testCases := []struct {
+		name           string
+		disabled       bool
+		expectedStatus int
+	}{
+		{name: "Enabled", disabled: false, expectedStatus: http.StatusOK},
+		{name: "Disabled", disabled: true, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			config.ResetServerRuntime()
+			cfg := &config.Config{
+				Server:  config.ServerConfig{PublicURL: testPublicURL},
+				APIDocs: config.APIDocsConfig{Disabled: tc.disabled},
+			}
+			suite.Require().NoError(config.InitializeServerRuntime("", cfg))
+			defer config.ResetServerRuntime()
+
+			mux := http.NewServeMux()
+			suite.Require().NoError(Initialize(mux))
+
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, apiDocsPath, nil))
+			suite.Equal(tc.expectedStatus, rr.Code)
+		})
+	}
+}
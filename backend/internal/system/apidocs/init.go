@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// openAPIDocument is the generated OpenAPI document of the server.
+//
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// Initialize registers the route serving the OpenAPI document and, when enabled, the Swagger UI.
+func Initialize(mux *http.ServeMux) error {
+	cfg := config.GetServerRuntime().Config
+	if cfg.APIDocs.Disabled {
+		return nil
+	}
+
+	handler, err := newAPIDocsHandler(openAPIDocument, config.GetServerURL(&cfg.Server))
+	if err != nil {
+		return err
+	}
+	registerRoutes(mux, handler, cfg.APIDocs.SwaggerUIEnabled)
+	return nil
+}
+
+// registerRoutes registers the routes of the API documentation.
+func registerRoutes(mux *http.ServeMux, handler *apiDocsHandler, swaggerUIEnabled bool) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+apiDocsPath, handler.HandleAPIDocsRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+apiDocsPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+
+	// The Swagger UI page is navigated to by the user agent, so CORS is not enabled on it.
+	if swaggerUIEnabled {
+		mux.HandleFunc("GET "+swaggerUIPath, handler.HandleSwaggerUIRequest)
+	}
+}
@@ -0,0 +1,385 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apidocs
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// route is an HTTP route registered by the server.
+type route struct {
+	Method string
+	Path   string
+	// Subtree is true when the pattern ends with a slash or a wildcard, and so matches the paths under it.
+	Subtree bool
+}
+
+// constDecl is a package level constant together with the imports of the file declaring it, which are
+// needed to resolve constants it refers to in other packages.
+type constDecl struct {
+	expr    ast.Expr
+	imports map[string]string
+}
+
+// callSite is a call of a package level function, used to resolve the values of the function parameters.
+type callSite struct {
+	call    *ast.CallExpr
+	caller  *ast.FuncDecl
+	imports map[string]string
+}
+
+// evalContext is the scope in which an expression is evaluated.
+type evalContext struct {
+	dir     string
+	imports map[string]string
+	fn      *ast.FuncDecl
+}
+
+// routeScanner collects the routes registered on the server mux by reading the Go sources.
+type routeScanner struct {
+	modulePath string
+	sourceRoot string
+	// consts maps a package directory to its package level constants.
+	consts map[string]map[string]constDecl
+	// calls maps a package directory to the call sites of its package level functions by function name.
+	calls map[string]map[string][]callSite
+	files map[string][]*ast.File
+}
+
+// scanRoutes returns the routes registered in the Go sources under the internal directory of the source
+// root. A route is recognized from the pattern passed to middleware.WithCORS or to the Handle and
+// HandleFunc methods of the mux. Patterns built from string literals, constants and string parameters of
+// functions called with such values within the package are resolved; routes with other dynamic patterns
+// are not detected.
+func scanRoutes(sourceRoot string) ([]route, error) {
+	modulePath, err := readModulePath(sourceRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &routeScanner{
+		modulePath: modulePath,
+		sourceRoot: sourceRoot,
+		consts:     make(map[string]map[string]constDecl),
+		calls:      make(map[string]map[string][]callSite),
+		files:      make(map[string][]*ast.File),
+	}
+	if err := s.parse(filepath.Join(sourceRoot, "internal")); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[route]bool)
+	routes := make([]route, 0)
+	dirs := make([]string, 0, len(s.files))
+	for dir := range s.files {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		for _, file := range s.files[dir] {
+			for _, r := range s.fileRoutes(dir, file) {
+				if !seen[r] {
+					seen[r] = true
+					routes = append(routes, r)
+				}
+			}
+		}
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes, nil
+}
+
+// readModulePath reads the module path from the go.mod file of the source root.
+func readModulePath(sourceRoot string) (string, error) {
+	content, err := os.ReadFile(filepath.Clean(filepath.Join(sourceRoot, "go.mod")))
+	if err != nil {
+		return "", fmt.Errorf("failed to read go.mod: %w", err)
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if modulePath, found := strings.CutPrefix(strings.TrimSpace(line), "module "); found {
+			return strings.TrimSpace(modulePath), nil
+		}
+	}
+	return "", fmt.Errorf("module path not found in go.mod")
+}
+
+// parse parses the non test Go files under the directory and records their package level constants.
+func (s *routeScanner) parse(root string) error {
+	fset := token.NewFileSet()
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == "testdata" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		dir := filepath.Dir(path)
+		s.files[dir] = append(s.files[dir], file)
+		s.recordConsts(dir, file)
+		s.recordCalls(dir, file)
+		return nil
+	})
+}
+
+// recordConsts records the package level constants of a file.
+func (s *routeScanner) recordConsts(dir string, file *ast.File) {
+	imports := fileImports(file)
+	if s.consts[dir] == nil {
+		s.consts[dir] = make(map[string]constDecl)
+	}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec, ok := spec.(*ast.ValueSpec)
+			if !ok || len(valueSpec.Values) != len(valueSpec.Names) {
+				continue
+			}
+			for i, name := range valueSpec.Names {
+				s.consts[dir][name.Name] = constDecl{expr: valueSpec.Values[i], imports: imports}
+			}
+		}
+	}
+}
+
+// recordCalls records the calls of package level functions made within the functions of a file.
+func (s *routeScanner) recordCalls(dir string, file *ast.File) {
+	imports := fileImports(file)
+	if s.calls[dir] == nil {
+		s.calls[dir] = make(map[string][]callSite)
+	}
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			if call, ok := node.(*ast.CallExpr); ok {
+				if ident, ok := call.Fun.(*ast.Ident); ok {
+					s.calls[dir][ident.Name] = append(s.calls[dir][ident.Name],
+						callSite{call: call, caller: fn, imports: imports})
+				}
+			}
+			return true
+		})
+	}
+}
+
+// fileImports maps the names under which the packages are imported in a file to their import paths.
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string, len(file.Imports))
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = importPath
+	}
+	return imports
+}
+
+// fileRoutes returns the routes registered in a file.
+func (s *routeScanner) fileRoutes(dir string, file *ast.File) []route {
+	imports := fileImports(file)
+	routes := make([]route, 0)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		ctx := evalContext{dir: dir, imports: imports, fn: fn}
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			switch selector.Sel.Name {
+			case "WithCORS", "HandleFunc", "Handle":
+			default:
+				return true
+			}
+
+			for _, pattern := range s.evalStrings(ctx, call.Args[0], 0) {
+				if r, ok := parsePattern(pattern); ok {
+					routes = append(routes, r)
+				}
+			}
+			return true
+		})
+	}
+	return routes
+}
+
+// maxEvalDepth bounds the resolution of constants and parameters defined in terms of other ones.
+const maxEvalDepth = 10
+
+// evalStrings evaluates a string expression made of literals, constants, function parameters and
+// concatenations. It returns every value the expression can take, or nil when it cannot be evaluated.
+func (s *routeScanner) evalStrings(ctx evalContext, expr ast.Expr, depth int) []string {
+	if depth > maxEvalDepth {
+		return nil
+	}
+
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return nil
+		}
+		value, err := strconv.Unquote(e.Value)
+		if err != nil {
+			return nil
+		}
+		return []string{value}
+	case *ast.ParenExpr:
+		return s.evalStrings(ctx, e.X, depth)
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return nil
+		}
+		lefts := s.evalStrings(ctx, e.X, depth)
+		rights := s.evalStrings(ctx, e.Y, depth)
+		values := make([]string, 0, len(lefts)*len(rights))
+		for _, left := range lefts {
+			for _, right := range rights {
+				values = append(values, left+right)
+			}
+		}
+		return values
+	case *ast.Ident:
+		if values := s.evalConst(ctx.dir, e.Name, depth); values != nil {
+			return values
+		}
+		return s.evalParam(ctx, e.Name, depth)
+	case *ast.SelectorExpr:
+		pkg, ok := e.X.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		importPath, ok := ctx.imports[pkg.Name]
+		if !ok {
+			return nil
+		}
+		relPath, ok := strings.CutPrefix(importPath, s.modulePath+"/")
+		if !ok {
+			return nil
+		}
+		return s.evalConst(filepath.Join(s.sourceRoot, filepath.FromSlash(relPath)), e.Sel.Name, depth)
+	}
+	return nil
+}
+
+// evalConst evaluates a package level string constant.
+func (s *routeScanner) evalConst(dir, name string, depth int) []string {
+	decl, ok := s.consts[dir][name]
+	if !ok {
+		return nil
+	}
+	return s.evalStrings(evalContext{dir: dir, imports: decl.imports}, decl.expr, depth+1)
+}
+
+// evalParam evaluates a parameter of the enclosing package level function from the arguments passed to it
+// at its call sites within the package.
+func (s *routeScanner) evalParam(ctx evalContext, name string, depth int) []string {
+	if ctx.fn == nil || ctx.fn.Recv != nil {
+		return nil
+	}
+
+	index := -1
+	position := 0
+	for _, field := range ctx.fn.Type.Params.List {
+		for _, fieldName := range field.Names {
+			if fieldName.Name == name {
+				index = position
+			}
+			position++
+		}
+	}
+	if index < 0 {
+		return nil
+	}
+
+	var values []string
+	for _, site := range s.calls[ctx.dir][ctx.fn.Name.Name] {
+		if index >= len(site.call.Args) {
+			continue
+		}
+		callerCtx := evalContext{dir: ctx.dir, imports: site.imports, fn: site.caller}
+		values = append(values, s.evalStrings(callerCtx, site.call.Args[index], depth+1)...)
+	}
+	return values
+}
+
+// parsePattern parses a ServeMux pattern into a route. Patterns without a method match every method and
+// are documented as GET routes. CORS preflight routes are left out. A pattern ending with {$} matches only
+// the path itself, so it is not a subtree pattern.
+func parsePattern(pattern string) (route, bool) {
+	method, path, found := strings.Cut(pattern, " ")
+	if !found {
+		method, path = "GET", pattern
+	}
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "/") || method == "OPTIONS" {
+		return route{}, false
+	}
+	switch method {
+	case "GET", "POST", "PUT", "PATCH", "DELETE", "HEAD":
+	default:
+		return route{}, false
+	}
+	path = strings.TrimSuffix(path, "{$}")
+	subtree := strings.HasSuffix(path, "...}") || (len(path) > 1 && strings.HasSuffix(path, "/"))
+	path = strings.ReplaceAll(path, "...}", "}")
+	return route{Method: method, Path: path, Subtree: subtree}, true
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backup
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for backup operations.
+var (
+	// ErrorInvalidRestoreRequest is the error returned when the restore request is malformed.
+	ErrorInvalidRestoreRequest = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BKP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.backupservice.invalid_restore_request",
+			DefaultValue: "Invalid restore request",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.backupservice.invalid_restore_request_description",
+			DefaultValue: "The restore request must contain a snapshot",
+		},
+	}
+
+	// ErrorUnsupportedSnapshotVersion is the error returned when the snapshot format is not supported.
+	ErrorUnsupportedSnapshotVersion = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BKP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.backupservice.unsupported_snapshot_version",
+			DefaultValue: "Unsupported snapshot version",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.backupservice.unsupported_snapshot_version_description",
+			DefaultValue: "The snapshot was created with a format version this server does not support",
+		},
+	}
+
+	// ErrorSnapshotChecksumMismatch is the error returned when the snapshot content does not match its checksum.
+	ErrorSnapshotChecksumMismatch = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BKP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.backupservice.snapshot_checksum_mismatch",
+			DefaultValue: "Snapshot checksum mismatch",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.backupservice.snapshot_checksum_mismatch_description",
+			DefaultValue: "The snapshot content was modified or truncated after it was created",
+		},
+	}
+)
+
+// Server errors for backup operations.
+var (
+	// ErrorIncompleteBackup is the error returned when some resources could not be exported.
+	ErrorIncompleteBackup = serviceerror.ServiceError{
+		Type: serviceerror.ServerErrorType,
+		Code: "BKP-5001",
+		Error: core.I18nMessage{
+			Key:          "error.backupservice.incomplete_backup",
+			DefaultValue: "Incomplete backup",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.backupservice.incomplete_backup_description",
+			DefaultValue: "Some resources could not be exported, so no snapshot was created",
+		},
+	}
+)
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backup
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// backupHandler defines the handler for managing backup API requests.
+type backupHandler struct {
+	service BackupServiceInterface
+	logger  *log.Logger
+}
+
+func newBackupHandler(service BackupServiceInterface) *backupHandler {
+	return &backupHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackupHandler")),
+	}
+}
+
+// HandleBackupRequest handles the request to create a snapshot of the instance.
+func (bh *backupHandler) HandleBackupRequest(w http.ResponseWriter, r *http.Request) {
+	snapshot, svcErr := bh.service.CreateBackup(r.Context())
+	if svcErr != nil {
+		bh.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, snapshot)
+}
+
+// HandleRestoreRequest handles the request to restore a snapshot into the instance.
+func (bh *backupHandler) HandleRestoreRequest(w http.ResponseWriter, r *http.Request) {
+	restoreRequest, err := sysutils.DecodeJSONBody[RestoreRequest](r)
+	if err != nil {
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorInvalidRestoreRequest.Code,
+			Message:     ErrorInvalidRestoreRequest.Error,
+			Description: ErrorInvalidRestoreRequest.ErrorDescription,
+		}
+		sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+		return
+	}
+
+	restoreResponse, svcErr := bh.service.RestoreBackup(r.Context(), restoreRequest)
+	if svcErr != nil {
+		bh.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, restoreResponse)
+}
+
+// handleError handles service errors and sends appropriate HTTP responses.
+func (bh *backupHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	if statusCode == http.StatusInternalServerError {
+		bh.logger.Error("Backup request failed with server error",
+			log.String("code", svcErr.Code),
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
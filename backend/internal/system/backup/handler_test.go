@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backup
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/importer"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	serviceMock *BackupServiceInterfaceMock
+	handler     *backupHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.serviceMock = NewBackupServiceInterfaceMock(suite.T())
+	suite.handler = newBackupHandler(suite.serviceMock)
+}
+
+func (suite *HandlerTestSuite) TestHandleBackupRequest() {
+	suite.serviceMock.EXPECT().CreateBackup(mock.Anything).Return(&Snapshot{
+		FormatVersion: snapshotFormatVersion,
+		Checksum:      "sha256:abc",
+		Resources:     "name: console\n",
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/backup", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleBackupRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var snapshot Snapshot
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &snapshot))
+	suite.Equal("sha256:abc", snapshot.Checksum)
+	suite.Equal("name: console\n", snapshot.Resources)
+}
+
+func (suite *HandlerTestSuite) TestHandleBackupRequest_ServerError() {
+	suite.serviceMock.EXPECT().CreateBackup(mock.Anything).Return(nil, &ErrorIncompleteBackup)
+
+	req := httptest.NewRequest(http.MethodPost, "/backup", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleBackupRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorIncompleteBackup.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleRestoreRequest() {
+	suite.serviceMock.EXPECT().RestoreBackup(mock.Anything, mock.MatchedBy(func(req *RestoreRequest) bool {
+		return req.DryRun && req.Snapshot != nil && req.Snapshot.Checksum == "sha256:abc"
+	})).Return(&RestoreResponse{
+		DryRun:  true,
+		Summary: &importer.ImportSummary{TotalDocuments: 1, Imported: 1},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/backup/restore",
+		strings.NewReader(`{"snapshot":{"checksum":"sha256:abc"},"dryRun":true}`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleRestoreRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response RestoreResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.True(response.DryRun)
+	suite.Equal(1, response.Summary.Imported)
+}
+
+func (suite *HandlerTestSuite) TestHandleRestoreRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/backup/restore", strings.NewReader("not json"))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleRestoreRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorInvalidRestoreRequest.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleRestoreRequest_ClientError() {
+	suite.serviceMock.EXPECT().RestoreBackup(mock.Anything, mock.Anything).
+		Return(nil, &ErrorSnapshotChecksumMismatch)
+
+	req := httptest.NewRequest(http.MethodPost, "/backup/restore", strings.NewReader(`{"snapshot":{}}`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleRestoreRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorSnapshotChecksumMismatch.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleRestoreRequest_ServerError() {
+	suite.serviceMock.EXPECT().RestoreBackup(mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodPost, "/backup/restore", strings.NewReader(`{"snapshot":{}}`))
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleRestoreRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (suite *HandlerTestSuite) TestOptionsRequests() {
+	mux := http.NewServeMux()
+	registerRoutes(mux, suite.handler)
+
+	for _, path := range []string{"/backup", "/backup/restore"} {
+		rr := httptest.NewRecorder()
+		mux.ServeHTTP(rr, httptest.NewRequest(http.MethodOptions, path, nil))
+
+		suite.Equal(http.StatusNoContent, rr.Code, path)
+	}
+}
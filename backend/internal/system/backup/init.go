@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package backup provides the backup and restore of the configuration of a server instance.
+package backup
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/export"
+	"github.com/thunder-id/thunderid/internal/system/importer"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the backup service and registers its routes.
+func Initialize(mux *http.ServeMux, exportService export.ExportServiceInterface,
+	importService importer.ImportServiceInterface, pkiService pkiservice.PKIServiceInterface,
+) BackupServiceInterface {
+	backupService := newBackupService(exportService, importService, pkiService)
+	backupHandler := newBackupHandler(backupService)
+	registerRoutes(mux, backupHandler)
+
+	return backupService
+}
+
+func registerRoutes(mux *http.ServeMux, backupHandler *backupHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("POST /backup",
+		backupHandler.HandleBackupRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /backup",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+
+	mux.HandleFunc(middleware.WithCORS("POST /backup/restore",
+		backupHandler.HandleRestoreRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /backup/restore",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
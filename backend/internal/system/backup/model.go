@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backup
+
+import "github.com/thunder-id/thunderid/internal/system/importer"
+
+const (
+	// snapshotFormatVersion is the version of the snapshot format produced by this server.
+	snapshotFormatVersion = "1"
+
+	// checksumPrefix prefixes the hex encoded SHA-256 checksum of a snapshot.
+	checksumPrefix = "sha256:"
+
+	keyStatusPresent = "present"
+	keyStatusChanged = "changed"
+	keyStatusMissing = "missing"
+)
+
+// Snapshot is a backup of the configuration of a server instance.
+type Snapshot struct {
+	FormatVersion string `json:"formatVersion"`
+	CreatedAt     string `json:"createdAt"`
+	// Checksum covers the resources and the environment variables, so that a modified or truncated
+	// snapshot is rejected on restore.
+	Checksum string `json:"checksum"`
+	// Resources holds the exported resource documents, in the same format as the export API.
+	Resources string `json:"resources"`
+	// EnvironmentVariables holds the values of the template variables used in the resources.
+	EnvironmentVariables string         `json:"environmentVariables"`
+	ResourceTypes        map[string]int `json:"resourceTypes"`
+	// Keys describes the signing keys of the instance. Key material is never included in a snapshot.
+	Keys []KeyMetadata `json:"keys"`
+}
+
+// KeyMetadata describes a signing key of a server instance.
+type KeyMetadata struct {
+	ID         string `json:"id"`
+	Thumbprint string `json:"thumbprint"`
+	Subject    string `json:"subject"`
+	Algorithm  string `json:"algorithm"`
+	NotBefore  string `json:"notBefore"`
+	NotAfter   string `json:"notAfter"`
+}
+
+// RestoreRequest is the request to restore a snapshot.
+type RestoreRequest struct {
+	Snapshot *Snapshot `json:"snapshot"`
+	// Variables overrides the values of the template variables recorded in the snapshot, e.g. to use the
+	// redirect URIs of the target instance.
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	DryRun    bool                   `json:"dryRun,omitempty"`
+}
+
+// RestoreResponse reports the outcome of restoring a snapshot.
+type RestoreResponse struct {
+	DryRun  bool                         `json:"dryRun"`
+	Summary *importer.ImportSummary      `json:"summary"`
+	Results []importer.ImportItemOutcome `json:"results"`
+	// Keys compares the signing keys recorded in the snapshot with the keys of this instance.
+	Keys []KeyStatus `json:"keys"`
+}
+
+// KeyStatus reports whether a signing key recorded in a snapshot is available on this instance.
+type KeyStatus struct {
+	ID         string `json:"id"`
+	Thumbprint string `json:"thumbprint"`
+	// Status is present when the instance has the same key, changed when it has a different key with the
+	// same ID, and missing when it has no key with the ID.
+	Status string `json:"status"`
+}
@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backup
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/export"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/importer"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// allResources selects every resource of a type in an export request.
+var allResources = []string{"*"}
+
+// BackupServiceInterface defines the interface for the backup service.
+type BackupServiceInterface interface {
+	CreateBackup(ctx context.Context) (*Snapshot, *serviceerror.ServiceError)
+	RestoreBackup(ctx context.Context, request *RestoreRequest) (*RestoreResponse, *serviceerror.ServiceError)
+}
+
+// backupService implements the BackupServiceInterface on top of the export and import services.
+type backupService struct {
+	exportService export.ExportServiceInterface
+	importService importer.ImportServiceInterface
+	pkiService    pkiservice.PKIServiceInterface
+	logger        *log.Logger
+}
+
+// newBackupService creates a new instance of backupService.
+func newBackupService(exportService export.ExportServiceInterface, importService importer.ImportServiceInterface,
+	pkiService pkiservice.PKIServiceInterface) BackupServiceInterface {
+	return &backupService{
+		exportService: exportService,
+		importService: importService,
+		pkiService:    pkiService,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "BackupService")),
+	}
+}
+
+// CreateBackup exports all configuration resources of the instance into a snapshot. The backup fails when
+// any resource cannot be exported, since restoring a partial snapshot would leave dangling references.
+func (bs *backupService) CreateBackup(ctx context.Context) (*Snapshot, *serviceerror.ServiceError) {
+	exportResponse, svcErr := bs.exportService.ExportResources(ctx, &export.ExportRequest{
+		Applications:        allResources,
+		IdentityProviders:   allResources,
+		NotificationSenders: allResources,
+		UserTypes:           allResources,
+		OrganizationUnits:   allResources,
+		Users:               allResources,
+		Groups:              allResources,
+		ResourceServers:     allResources,
+		Roles:               allResources,
+		Flows:               allResources,
+		Translations:        allResources,
+		Layouts:             allResources,
+		Themes:              allResources,
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	resourceTypes := map[string]int{}
+	if exportResponse.Summary != nil {
+		if len(exportResponse.Summary.Errors) > 0 {
+			for _, exportErr := range exportResponse.Summary.Errors {
+				bs.logger.Error("Failed to export resource for backup",
+					log.String("resourceType", exportErr.ResourceType),
+					log.String("resourceID", exportErr.ResourceID),
+					log.String("error", exportErr.Error))
+			}
+			return nil, serviceerror.CustomServiceError(ErrorIncompleteBackup, core.I18nMessage{
+				Key:          "error.backupservice.incomplete_backup_count_description",
+				DefaultValue: fmt.Sprintf("%d resources could not be exported", len(exportResponse.Summary.Errors)),
+			})
+		}
+		resourceTypes = exportResponse.Summary.ResourceTypes
+	}
+
+	keys, svcErr := bs.getKeyMetadata()
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	snapshot := &Snapshot{
+		FormatVersion: snapshotFormatVersion,
+		CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+		Resources:     export.BuildCombinedResources(exportResponse.Files),
+		ResourceTypes: resourceTypes,
+		Keys:          keys,
+	}
+	if exportResponse.EnvFile != nil {
+		snapshot.EnvironmentVariables = exportResponse.EnvFile.Content
+	}
+	snapshot.Checksum = computeChecksum(snapshot)
+
+	return snapshot, nil
+}
+
+// RestoreBackup imports the resources of a snapshot into the instance. Resources that already exist are
+// updated. With DryRun set, the resources are validated without changing the instance.
+func (bs *backupService) RestoreBackup(
+	ctx context.Context, request *RestoreRequest,
+) (*RestoreResponse, *serviceerror.ServiceError) {
+	if request == nil || request.Snapshot == nil {
+		return nil, &ErrorInvalidRestoreRequest
+	}
+	snapshot := request.Snapshot
+	if snapshot.FormatVersion != snapshotFormatVersion {
+		return nil, &ErrorUnsupportedSnapshotVersion
+	}
+	if snapshot.Checksum != computeChecksum(snapshot) {
+		return nil, &ErrorSnapshotChecksumMismatch
+	}
+
+	variables := parseEnvironmentVariables(snapshot.EnvironmentVariables)
+	for name, value := range request.Variables {
+		variables[name] = value
+	}
+
+	importResponse, svcErr := bs.importService.ImportResources(ctx, &importer.ImportRequest{
+		Content:   snapshot.Resources,
+		Variables: variables,
+		DryRun:    request.DryRun,
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	keys, svcErr := bs.compareKeys(snapshot.Keys)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &RestoreResponse{
+		DryRun:  request.DryRun,
+		Summary: importResponse.Summary,
+		Results: importResponse.Results,
+		Keys:    keys,
+	}, nil
+}
+
+// getKeyMetadata describes the signing keys of the instance, sorted by key ID.
+func (bs *backupService) getKeyMetadata() ([]KeyMetadata, *serviceerror.ServiceError) {
+	certificates, svcErr := bs.pkiService.GetAllX509Certificates()
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	keys := make([]KeyMetadata, 0, len(certificates))
+	for id, cert := range certificates {
+		keys = append(keys, KeyMetadata{
+			ID:         id,
+			Thumbprint: bs.pkiService.GetCertThumbprint(id),
+			Subject:    cert.Subject.String(),
+			Algorithm:  cert.PublicKeyAlgorithm.String(),
+			NotBefore:  cert.NotBefore.UTC().Format(time.RFC3339),
+			NotAfter:   cert.NotAfter.UTC().Format(time.RFC3339),
+		})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys, nil
+}
+
+// compareKeys reports which of the signing keys recorded in a snapshot the instance has. Keys are not
+// restored, so tokens and assertions signed with a missing or changed key do not validate on this instance.
+func (bs *backupService) compareKeys(keys []KeyMetadata) ([]KeyStatus, *serviceerror.ServiceError) {
+	certificates, svcErr := bs.pkiService.GetAllX509Certificates()
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	statuses := make([]KeyStatus, 0, len(keys))
+	for _, key := range keys {
+		status := keyStatusMissing
+		if _, ok := certificates[key.ID]; ok {
+			status = keyStatusChanged
+			if bs.pkiService.GetCertThumbprint(key.ID) == key.Thumbprint {
+				status = keyStatusPresent
+			}
+		}
+		if status != keyStatusPresent {
+			bs.logger.Warn("Signing key of the snapshot is not available on this instance",
+				log.String("keyID", key.ID), log.String("status", status))
+		}
+		statuses = append(statuses, KeyStatus{ID: key.ID, Thumbprint: key.Thumbprint, Status: status})
+	}
+	return statuses, nil
+}
+
+// computeChecksum returns the checksum of the resources and the environment variables of a snapshot.
+func computeChecksum(snapshot *Snapshot) string {
+	hash := sha256.New()
+	hash.Write([]byte(snapshot.Resources))
+	hash.Write([]byte{0})
+	hash.Write([]byte(snapshot.EnvironmentVariables))
+	return checksumPrefix + hex.EncodeToString(hash.Sum(nil))
+}
+
+// parseEnvironmentVariables parses the environment variables generated by the export into import template
+// variables. Array variables are exported as JSON arrays and are parsed back into lists.
+func parseEnvironmentVariables(content string) map[string]interface{} {
+	variables := make(map[string]interface{})
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+
+		var list []interface{}
+		if strings.HasPrefix(value, "[") && json.Unmarshal([]byte(value), &list) == nil {
+			variables[name] = list
+			continue
+		}
+		variables[name] = value
+	}
+	return variables
+}
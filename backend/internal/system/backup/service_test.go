@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backup
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/export"
+	"github.com/thunder-id/thunderid/internal/system/importer"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/pki/pkimock"
+	"github.com/thunder-id/thunderid/tests/mocks/exportmock"
+	"github.com/thunder-id/thunderid/tests/mocks/importermock"
+)
+
+const testKeyID = "default-key"
+
+type ServiceTestSuite struct {
+	suite.Suite
+	exportMock *exportmock.ExportServiceInterfaceMock
+	importMock *importermock.ImportServiceInterfaceMock
+	pkiMock    *pkimock.PKIServiceInterfaceMock
+	service    BackupServiceInterface
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.exportMock = exportmock.NewExportServiceInterfaceMock(suite.T())
+	suite.importMock = importermock.NewImportServiceInterfaceMock(suite.T())
+	suite.pkiMock = pkimock.NewPKIServiceInterfaceMock(suite.T())
+	suite.service = newBackupService(suite.exportMock, suite.importMock, suite.pkiMock)
+}
+
+func (suite *ServiceTestSuite) mockKeys(thumbprint string) {
+	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(map[string]*x509.Certificate{
+		testKeyID: {
+			Subject:            pkix.Name{CommonName: "thunderid"},
+			PublicKeyAlgorithm: x509.RSA,
+			NotBefore:          time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			NotAfter:           time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}, nil)
+	suite.pkiMock.EXPECT().GetCertThumbprint(testKeyID).Return(thumbprint)
+}
+
+func (suite *ServiceTestSuite) newSnapshot() *Snapshot {
+	snapshot := &Snapshot{
+		FormatVersion:        snapshotFormatVersion,
+		Resources:            "# resource_type: application\nname: {{.APP_NAME}}\n",
+		EnvironmentVariables: "APP_NAME=Console\nAPP_REDIRECT_URIS=[\"https://a.example.com\"]\n",
+		Keys:                 []KeyMetadata{{ID: testKeyID, Thumbprint: "thumbprint"}},
+	}
+	snapshot.Checksum = computeChecksum(snapshot)
+	return snapshot
+}
+
+func (suite *ServiceTestSuite) TestCreateBackup() {
+	suite.exportMock.EXPECT().ExportResources(mock.Anything, mock.MatchedBy(func(req *export.ExportRequest) bool {
+		return len(req.Applications) == 1 && req.Applications[0] == "*" && req.Users[0] == "*" &&
+			req.Flows[0] == "*" && req.OrganizationUnits[0] == "*"
+	})).Return(&export.ExportResponse{
+		Files: []export.ExportFile{
+			{FileName: "console.yaml", Content: "# resource_type: application\nname: {{.APP_NAME}}\n"},
+			{FileName: "default.yaml", Content: "# resource_type: organization_unit\nhandle: default\n"},
+		},
+		EnvFile: &export.EnvironmentFile{Content: "APP_NAME=Console\n"},
+		Summary: &export.ExportSummary{ResourceTypes: map[string]int{"application": 1, "organization_unit": 1}},
+	}, nil)
+	suite.mockKeys("thumbprint")
+
+	snapshot, svcErr := suite.service.CreateBackup(context.Background())
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(snapshotFormatVersion, snapshot.FormatVersion)
+	suite.NotEmpty(snapshot.CreatedAt)
+	suite.Contains(snapshot.Resources, "# File: console.yaml")
+	suite.Contains(snapshot.Resources, "\n---\n# File: default.yaml")
+	suite.Equal("APP_NAME=Console\n", snapshot.EnvironmentVariables)
+	suite.Equal(map[string]int{"application": 1, "organization_unit": 1}, snapshot.ResourceTypes)
+	suite.Equal(computeChecksum(snapshot), snapshot.Checksum)
+	suite.Equal([]KeyMetadata{{
+		ID:         testKeyID,
+		Thumbprint: "thumbprint",
+		Subject:    "CN=thunderid",
+		Algorithm:  "RSA",
+		NotBefore:  "2026-01-01T00:00:00Z",
+		NotAfter:   "2027-01-01T00:00:00Z",
+	}}, snapshot.Keys)
+}
+
+func (suite *ServiceTestSuite) TestCreateBackup_ExportErrors() {
+	suite.exportMock.EXPECT().ExportResources(mock.Anything, mock.Anything).Return(&export.ExportResponse{
+		Files: []export.ExportFile{{FileName: "console.yaml", Content: "name: console\n"}},
+		Summary: &export.ExportSummary{Errors: []declarativeresource.ExportError{
+			{ResourceType: "user", ResourceID: "u1", Error: "user name is empty"},
+		}},
+	}, nil)
+
+	snapshot, svcErr := suite.service.CreateBackup(context.Background())
+
+	suite.Nil(snapshot)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorIncompleteBackup.Code, svcErr.Code)
+	suite.Equal("1 resources could not be exported", svcErr.ErrorDescription.DefaultValue)
+}
+
+func (suite *ServiceTestSuite) TestCreateBackup_ExportFails() {
+	suite.exportMock.EXPECT().ExportResources(mock.Anything, mock.Anything).
+		Return(nil, &export.ErrorNoResourcesFound)
+
+	snapshot, svcErr := suite.service.CreateBackup(context.Background())
+
+	suite.Nil(snapshot)
+	suite.Equal(&export.ErrorNoResourcesFound, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestCreateBackup_KeyLookupFails() {
+	suite.exportMock.EXPECT().ExportResources(mock.Anything, mock.Anything).Return(&export.ExportResponse{
+		Files: []export.ExportFile{{FileName: "console.yaml", Content: "name: console\n"}},
+	}, nil)
+	suite.pkiMock.EXPECT().GetAllX509Certificates().Return(nil, &serviceerror.InternalServerError)
+
+	snapshot, svcErr := suite.service.CreateBackup(context.Background())
+
+	suite.Nil(snapshot)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestRestoreBackup() {
+	snapshot := suite.newSnapshot()
+	suite.importMock.EXPECT().ImportResources(mock.Anything, mock.MatchedBy(func(req *importer.ImportRequest) bool {
+		return req.Content == snapshot.Resources && req.DryRun &&
+			req.Variables["APP_NAME"] == "Production console" &&
+			len(req.Variables["APP_REDIRECT_URIS"].([]interface{})) == 1
+	})).Return(&importer.ImportResponse{
+		Summary: &importer.ImportSummary{TotalDocuments: 1, Imported: 1},
+		Results: []importer.ImportItemOutcome{{ResourceType: "application", Status: "success"}},
+	}, nil)
+	suite.mockKeys("thumbprint")
+
+	response, svcErr := suite.service.RestoreBackup(context.Background(), &RestoreRequest{
+		Snapshot:  snapshot,
+		Variables: map[string]interface{}{"APP_NAME": "Production console"},
+		DryRun:    true,
+	})
+
+	suite.Require().Nil(svcErr)
+	suite.True(response.DryRun)
+	suite.Equal(1, response.Summary.Imported)
+	suite.Len(response.Results, 1)
+	suite.Equal([]KeyStatus{{ID: testKeyID, Thumbprint: "thumbprint", Status: keyStatusPresent}}, response.Keys)
+}
+
+func (suite *ServiceTestSuite) TestRestoreBackup_KeyStatuses() {
+	snapshot := suite.newSnapshot()
+	snapshot.Keys = append(snapshot.Keys, KeyMetadata{ID: "old-key", Thumbprint: "old"})
+	suite.importMock.EXPECT().ImportResources(mock.Anything, mock.Anything).
+		Return(&importer.ImportResponse{Summary: &importer.ImportSummary{}}, nil)
+	suite.mockKeys("rotated")
+
+	response, svcErr := suite.service.RestoreBackup(context.Background(), &RestoreRequest{Snapshot: snapshot})
+
+	suite.Require().Nil(svcErr)
+	suite.Equal([]KeyStatus{
+		{ID: testKeyID, Thumbprint: "thumbprint", Status: keyStatusChanged},
+		{ID: "old-key", Thumbprint: "old", Status: keyStatusMissing},
+	}, response.Keys)
+}
+
+func (suite *ServiceTestSuite) TestRestoreBackup_InvalidSnapshot() {
+	unsupported := suite.newSnapshot()
+	unsupported.FormatVersion = "0"
+	tampered := suite.newSnapshot()
+	tampered.Resources += "name: injected\n"
+
+	testCases := []struct {
+		name     string
+		request  *RestoreRequest
+		expected *serviceerror.ServiceError
+	}{
+		{"NilRequest", nil, &ErrorInvalidRestoreRequest},
+		{"MissingSnapshot", &RestoreRequest{}, &ErrorInvalidRestoreRequest},
+		{"UnsupportedVersion", &RestoreRequest{Snapshot: unsupported}, &ErrorUnsupportedSnapshotVersion},
+		{"ChecksumMismatch", &RestoreRequest{Snapshot: tampered}, &ErrorSnapshotChecksumMismatch},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, svcErr := suite.service.RestoreBackup(context.Background(), tc.request)
+
+			suite.Nil(response)
+			suite.Equal(tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *ServiceTestSuite) TestRestoreBackup_ImportFails() {
+	suite.importMock.EXPECT().ImportResources(mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+
+	response, svcErr := suite.service.RestoreBackup(context.Background(), &RestoreRequest{
+		Snapshot: suite.newSnapshot(),
+	})
+
+	suite.Nil(response)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestParseEnvironmentVariables() {
+	variables := parseEnvironmentVariables("# comment\n\nNAME=Console\nURL=https://a.example.com?x=1\n" +
+		"LIST=[\"a\",\"b\"]\nNOT_A_LIST=[broken\nINVALID\n")
+
+	suite.Equal(map[string]interface{}{
+		"NAME":       "Console",
+		"URL":        "https://a.example.com?x=1",
+		"LIST":       []interface{}{"a", "b"},
+		"NOT_A_LIST": "[broken",
+	}, variables)
+}
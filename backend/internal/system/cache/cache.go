@@ -39,9 +39,10 @@ type CacheInterface[T any] interface {
 
 // Cache implements the CacheInterface for individual caches.
 type Cache[T any] struct {
-	enabled   bool
-	cacheName string
-	cacheImpl CacheInterface[T]
+	enabled     bool
+	cacheName   string
+	cacheImpl   CacheInterface[T]
+	invalidator invalidationPublisher
 }
 
 // GetName returns the name of the cache.
@@ -84,6 +85,9 @@ func (c *Cache[T]) Delete(ctx context.Context, key CacheKey) error {
 		if err := c.cacheImpl.Delete(ctx, key); err != nil {
 			logger.Warn("Failed to delete value from the cache", log.String("key", key.ToString()), log.Error(err))
 		}
+		if c.invalidator != nil {
+			c.invalidator.publish(ctx, c.cacheName, invalidationOperationDelete, key.Key)
+		}
 	}
 
 	return nil
@@ -100,6 +104,9 @@ func (c *Cache[T]) Clear(ctx context.Context) error {
 		if err := c.cacheImpl.Clear(ctx); err != nil {
 			logger.Warn("Failed to clear the cache", log.Error(err))
 		}
+		if c.invalidator != nil {
+			c.invalidator.publish(ctx, c.cacheName, invalidationOperationClear, "")
+		}
 	}
 
 	return nil
@@ -124,3 +131,25 @@ func (c *Cache[T]) CleanupExpired() {
 		c.cacheImpl.CleanupExpired()
 	}
 }
+
+// applyInvalidation applies an invalidation received from another node to the local cache, without
+// publishing it again. Caches that are not node-local are shared by all nodes and are left untouched.
+func (c *Cache[T]) applyInvalidation(ctx context.Context, operation, key string) {
+	if c.invalidator == nil || !c.IsEnabled() || !c.cacheImpl.IsEnabled() {
+		return
+	}
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "Cache"),
+		log.String("cacheName", c.cacheName))
+
+	switch operation {
+	case invalidationOperationDelete:
+		if err := c.cacheImpl.Delete(ctx, CacheKey{Key: key}); err != nil {
+			logger.Warn("Failed to delete invalidated value from the cache", log.String("key", key), log.Error(err))
+		}
+	case invalidationOperationClear:
+		if err := c.cacheImpl.Clear(ctx); err != nil {
+			logger.Warn("Failed to clear the invalidated cache", log.Error(err))
+		}
+	}
+}
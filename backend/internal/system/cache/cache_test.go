@@ -278,6 +278,87 @@ func (suite *CacheTestSuite) TestClearWithError() {
 	assert.NoError(t, err)
 }
 
+func (suite *CacheTestSuite) TestDeletePublishesInvalidation() {
+	t := suite.T()
+
+	mockCache := NewCacheInterfaceMock[string](t)
+	mockCache.EXPECT().IsEnabled().Return(true)
+	mockPublisher := newInvalidationPublisherMock(t)
+
+	cache := &Cache[string]{
+		enabled:     true,
+		cacheName:   "testCache",
+		cacheImpl:   mockCache,
+		invalidator: mockPublisher,
+	}
+
+	key := CacheKey{Key: "testKey"}
+	mockCache.EXPECT().Delete(context.Background(), key).Return(nil)
+	mockPublisher.EXPECT().publish(context.Background(), "testCache", invalidationOperationDelete, "testKey").
+		Return()
+
+	err := cache.Delete(context.Background(), key)
+	assert.NoError(t, err)
+}
+
+func (suite *CacheTestSuite) TestClearPublishesInvalidation() {
+	t := suite.T()
+
+	mockCache := NewCacheInterfaceMock[string](t)
+	mockCache.EXPECT().IsEnabled().Return(true)
+	mockPublisher := newInvalidationPublisherMock(t)
+
+	cache := &Cache[string]{
+		enabled:     true,
+		cacheName:   "testCache",
+		cacheImpl:   mockCache,
+		invalidator: mockPublisher,
+	}
+
+	mockCache.EXPECT().Clear(context.Background()).Return(nil)
+	mockPublisher.EXPECT().publish(context.Background(), "testCache", invalidationOperationClear, "").Return()
+
+	err := cache.Clear(context.Background())
+	assert.NoError(t, err)
+}
+
+func (suite *CacheTestSuite) TestApplyInvalidation() {
+	t := suite.T()
+
+	mockCache := NewCacheInterfaceMock[string](t)
+	mockCache.EXPECT().IsEnabled().Return(true)
+	// The publisher must not be called, so that received invalidations are not published again.
+	mockPublisher := newInvalidationPublisherMock(t)
+
+	cache := &Cache[string]{
+		enabled:     true,
+		cacheName:   "testCache",
+		cacheImpl:   mockCache,
+		invalidator: mockPublisher,
+	}
+
+	mockCache.EXPECT().Delete(context.Background(), CacheKey{Key: "testKey"}).Return(nil)
+	cache.applyInvalidation(context.Background(), invalidationOperationDelete, "testKey")
+
+	mockCache.EXPECT().Clear(context.Background()).Return(fmt.Errorf("clear error"))
+	cache.applyInvalidation(context.Background(), invalidationOperationClear, "")
+}
+
+func (suite *CacheTestSuite) TestApplyInvalidationWithoutInvalidator() {
+	t := suite.T()
+
+	mockCache := NewCacheInterfaceMock[string](t)
+	cache := &Cache[string]{
+		enabled:   true,
+		cacheName: "testCache",
+		cacheImpl: mockCache,
+	}
+
+	// Caches shared through Redis ignore invalidations from other nodes.
+	cache.applyInvalidation(context.Background(), invalidationOperationClear, "")
+	mockCache.AssertNotCalled(t, "Clear", context.Background())
+}
+
 func (suite *CacheTestSuite) TestGetCacheProperty() {
 	testCases := []struct {
 		name             string
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// invalidationOperationDelete removes a single entry from the cache.
+	invalidationOperationDelete = "delete"
+	// invalidationOperationClear removes all entries from the cache.
+	invalidationOperationClear = "clear"
+)
+
+const (
+	// invalidationChannelName is the name of the Redis channel on which invalidations are published.
+	invalidationChannelName = "cache-invalidation"
+	// invalidationRetryInterval is the delay before receiving again after the subscription fails.
+	invalidationRetryInterval = time.Second
+)
+
+// invalidationMessage is an invalidation exchanged between nodes.
+type invalidationMessage struct {
+	NodeID    string `json:"nodeId"`
+	CacheName string `json:"cacheName"`
+	Operation string `json:"operation"`
+	Key       string `json:"key,omitempty"`
+}
+
+// invalidationPublisher publishes invalidations of a local cache to the other nodes.
+type invalidationPublisher interface {
+	publish(ctx context.Context, cacheName, operation, key string)
+}
+
+// invalidationBus propagates invalidations of node-local caches between nodes over Redis pub/sub.
+type invalidationBus struct {
+	client  *redis.Client
+	channel string
+	nodeID  string
+	apply   func(msg invalidationMessage)
+	reset   func()
+	cancel  context.CancelFunc
+	done    chan struct{}
+	logger  *log.Logger
+}
+
+// newInvalidationBus creates a new invalidation bus. Invalidations received from other nodes are passed to
+// apply, and reset is called when invalidations may have been missed while the subscription was down.
+func newInvalidationBus(client *redis.Client, channel string, nodeID string,
+	apply func(msg invalidationMessage), reset func()) *invalidationBus {
+	return &invalidationBus{
+		client:  client,
+		channel: channel,
+		nodeID:  nodeID,
+		apply:   apply,
+		reset:   reset,
+		done:    make(chan struct{}),
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheInvalidationBus"),
+			log.String("channel", channel)),
+	}
+}
+
+// publish notifies the other nodes that an entry or all entries of a cache are no longer valid.
+func (b *invalidationBus) publish(ctx context.Context, cacheName, operation, key string) {
+	data, err := json.Marshal(invalidationMessage{
+		NodeID:    b.nodeID,
+		CacheName: cacheName,
+		Operation: operation,
+		Key:       key,
+	})
+	if err != nil {
+		b.logger.Warn("Failed to marshal cache invalidation", log.String("cacheName", cacheName), log.Error(err))
+		return
+	}
+
+	if err := b.client.Publish(ctx, b.channel, data).Err(); err != nil {
+		b.logger.Warn("Failed to publish cache invalidation", log.String("cacheName", cacheName), log.Error(err))
+	}
+}
+
+// start subscribes to the invalidation channel and applies received invalidations in the background.
+func (b *invalidationBus) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+	pubsub := b.client.Subscribe(ctx, b.channel)
+
+	go b.listen(ctx, pubsub)
+	b.logger.Debug("Cache invalidation bus started", log.String("nodeId", b.nodeID))
+}
+
+// listen receives invalidations until the bus is closed.
+func (b *invalidationBus) listen(ctx context.Context, pubsub *redis.PubSub) {
+	defer close(b.done)
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			b.logger.Debug("Failed to close cache invalidation subscription", log.Error(err))
+		}
+	}()
+
+	subscribed := false
+	for {
+		received, err := pubsub.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			b.logger.Warn("Failed to receive cache invalidations, retrying", log.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(invalidationRetryInterval):
+			}
+			continue
+		}
+
+		switch msg := received.(type) {
+		case *redis.Subscription:
+			if msg.Kind != "subscribe" {
+				continue
+			}
+			// Invalidations published while the subscription was down are lost, so the local caches can
+			// no longer be trusted once it is re-established.
+			if subscribed {
+				b.logger.Debug("Cache invalidation subscription re-established, resetting local caches")
+				b.reset()
+			}
+			subscribed = true
+		case *redis.Message:
+			b.handleMessage(msg.Payload)
+		}
+	}
+}
+
+// handleMessage decodes an invalidation and applies it unless it was published by this node.
+func (b *invalidationBus) handleMessage(payload string) {
+	var msg invalidationMessage
+	if err := json.Unmarshal([]byte(payload), &msg); err != nil {
+		b.logger.Warn("Failed to unmarshal cache invalidation", log.Error(err))
+		return
+	}
+	if msg.NodeID == b.nodeID {
+		return
+	}
+
+	b.logger.Debug("Applying cache invalidation", log.String("cacheName", msg.CacheName),
+		log.String("operation", msg.Operation))
+	b.apply(msg)
+}
+
+// close stops receiving invalidations.
+func (b *invalidationBus) close() {
+	if b.cancel == nil {
+		return
+	}
+	b.cancel()
+	<-b.done
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type InvalidationBusTestSuite struct {
+	suite.Suite
+}
+
+func TestInvalidationBusSuite(t *testing.T) {
+	suite.Run(t, new(InvalidationBusTestSuite))
+}
+
+func (suite *InvalidationBusTestSuite) TestHandleMessageAppliesInvalidationFromOtherNode() {
+	t := suite.T()
+
+	var applied []invalidationMessage
+	bus := newInvalidationBus(nil, "test:cache-invalidation", "node-1", func(msg invalidationMessage) {
+		applied = append(applied, msg)
+	}, nil)
+
+	bus.handleMessage(`{"nodeId":"node-2","cacheName":"IDPByIDCache","operation":"delete","key":"idp-1"}`)
+
+	assert.Equal(t, []invalidationMessage{{
+		NodeID:    "node-2",
+		CacheName: "IDPByIDCache",
+		Operation: invalidationOperationDelete,
+		Key:       "idp-1",
+	}}, applied)
+}
+
+func (suite *InvalidationBusTestSuite) TestHandleMessageSkipsOwnInvalidation() {
+	t := suite.T()
+
+	applied := false
+	bus := newInvalidationBus(nil, "test:cache-invalidation", "node-1", func(msg invalidationMessage) {
+		applied = true
+	}, nil)
+
+	bus.handleMessage(`{"nodeId":"node-1","cacheName":"IDPByIDCache","operation":"clear"}`)
+
+	assert.False(t, applied, "Invalidations published by the node itself should not be applied")
+}
+
+func (suite *InvalidationBusTestSuite) TestHandleMessageIgnoresInvalidPayload() {
+	t := suite.T()
+
+	applied := false
+	bus := newInvalidationBus(nil, "test:cache-invalidation", "node-1", func(msg invalidationMessage) {
+		applied = true
+	}, nil)
+
+	bus.handleMessage("not-json")
+
+	assert.False(t, applied, "Malformed invalidations should be ignored")
+}
+
+func (suite *InvalidationBusTestSuite) TestCloseWithoutStart() {
+	bus := newInvalidationBus(nil, "test:cache-invalidation", "node-1", nil, nil)
+
+	suite.NotPanics(bus.close)
+}
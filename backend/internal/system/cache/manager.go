@@ -28,6 +28,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
 )
 
 // CacheManagerInterface defines the interface for managing caches.
@@ -45,11 +46,19 @@ type CacheManagerInterface interface {
 
 // CacheManager implements the CacheManagerInterface for managing multiple caches.
 type CacheManager struct {
-	caches          map[string]interface{}
-	mu              sync.RWMutex
-	enabled         bool
-	cleanupInterval time.Duration
-	redisClient     *redis.Client
+	caches             map[string]interface{}
+	mu                 sync.RWMutex
+	enabled            bool
+	cleanupInterval    time.Duration
+	redisClient        *redis.Client
+	invalidationClient *redis.Client
+	invalidationBus    *invalidationBus
+}
+
+// invalidationTarget is implemented by caches that accept invalidations received from other nodes.
+type invalidationTarget interface {
+	GetName() string
+	applyInvalidation(ctx context.Context, operation, key string)
 }
 
 // Initialize creates and returns a new CacheManagerInterface instance.
@@ -71,18 +80,7 @@ func Initialize() CacheManagerInterface {
 	cm.enabled = true
 
 	if getCacheType(cacheConfig) == cacheTypeRedis {
-		cm.redisClient = redis.NewClient(&redis.Options{
-			Addr:            cacheConfig.Redis.Address,
-			Username:        cacheConfig.Redis.Username,
-			Password:        cacheConfig.Redis.Password,
-			DB:              cacheConfig.Redis.DB,
-			MaxRetries:      cacheConfig.Redis.MaxRetries,
-			MinRetryBackoff: time.Duration(cacheConfig.Redis.MinRetryBackoffMS) * time.Millisecond,
-			MaxRetryBackoff: time.Duration(cacheConfig.Redis.MaxRetryBackoffMS) * time.Millisecond,
-			DialTimeout:     time.Duration(cacheConfig.Redis.DialTimeoutMS) * time.Millisecond,
-			ReadTimeout:     time.Duration(cacheConfig.Redis.ReadTimeoutMS) * time.Millisecond,
-			WriteTimeout:    time.Duration(cacheConfig.Redis.WriteTimeoutMS) * time.Millisecond,
-		})
+		cm.redisClient = newRedisClient(cacheConfig.Redis)
 		if err := cm.redisClient.Ping(context.Background()).Err(); err != nil {
 			logger.Error("Failed to connect to Redis. Cache initialization aborted.", log.Error(err))
 			if closeErr := cm.redisClient.Close(); closeErr != nil {
@@ -98,6 +96,10 @@ func Initialize() CacheManagerInterface {
 		cm.startCleanupRoutine()
 	}
 
+	if cacheConfig.Invalidation.Enabled {
+		cm.startInvalidationBus(cacheConfig)
+	}
+
 	logger.Debug("Cache Manager initialized", log.Bool("enabled", cm.enabled),
 		log.Any("cleanupInterval", cm.cleanupInterval))
 	return cm
@@ -107,9 +109,22 @@ func Initialize() CacheManagerInterface {
 func (cm *CacheManager) Close() {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheManager"))
 
+	// Stop the invalidation bus before locking, as it locks the manager to apply invalidations.
+	if cm.invalidationBus != nil {
+		cm.invalidationBus.close()
+	}
+
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
+	cm.invalidationBus = nil
+	if cm.invalidationClient != nil {
+		if err := cm.invalidationClient.Close(); err != nil {
+			logger.Warn("Failed to close cache invalidation Redis client", log.Error(err))
+		}
+		cm.invalidationClient = nil
+	}
+
 	if cm.redisClient != nil {
 		if err := cm.redisClient.Close(); err != nil {
 			logger.Warn("Failed to close Redis client", log.Error(err))
@@ -152,6 +167,65 @@ func (cm *CacheManager) getRedisClient() *redis.Client {
 	return cm.redisClient
 }
 
+// startInvalidationBus starts propagating invalidations of node-local caches between nodes over Redis
+// pub/sub. The shared Redis client is used when caches are backed by Redis, otherwise a dedicated client is
+// connected using the cache Redis configuration.
+func (cm *CacheManager) startInvalidationBus(cacheConfig config.CacheConfig) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheManager"))
+
+	client := cm.redisClient
+	if client == nil {
+		if cacheConfig.Redis.Address == "" {
+			logger.Error("Cache invalidation requires a Redis address. Cache invalidation is not started.")
+			return
+		}
+		client = newRedisClient(cacheConfig.Redis)
+		if err := client.Ping(context.Background()).Err(); err != nil {
+			logger.Error("Failed to connect to Redis. Cache invalidation is not started.", log.Error(err))
+			if closeErr := client.Close(); closeErr != nil {
+				logger.Warn("Failed to close Redis client after ping failure", log.Error(closeErr))
+			}
+			return
+		}
+		cm.invalidationClient = client
+	}
+
+	nodeID, err := utils.GenerateUUIDv7()
+	if err != nil {
+		logger.Error("Failed to generate node ID. Cache invalidation is not started.", log.Error(err))
+		return
+	}
+
+	channel := buildRedisKeyPrefix(cacheConfig.Redis.KeyPrefix) + ":" + invalidationChannelName
+	cm.invalidationBus = newInvalidationBus(client, channel, nodeID, cm.applyInvalidation, cm.resetLocalCaches)
+	cm.invalidationBus.start()
+}
+
+// applyInvalidation applies an invalidation received from another node to the local caches with the
+// invalidated name.
+func (cm *CacheManager) applyInvalidation(msg invalidationMessage) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	for _, cacheEntry := range cm.caches {
+		if target, ok := cacheEntry.(invalidationTarget); ok && target.GetName() == msg.CacheName {
+			target.applyInvalidation(context.Background(), msg.Operation, msg.Key)
+		}
+	}
+}
+
+// resetLocalCaches clears all node-local caches.
+func (cm *CacheManager) resetLocalCaches() {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+
+	for _, cacheEntry := range cm.caches {
+		if target, ok := cacheEntry.(invalidationTarget); ok {
+			target.applyInvalidation(context.Background(), invalidationOperationClear, "")
+		}
+	}
+}
+
 // startCleanupRoutine starts a background routine to clean up expired caches at regular intervals.
 func (cm *CacheManager) startCleanupRoutine() {
 	if cm.cleanupInterval <= 0 {
@@ -205,6 +279,32 @@ func (cm *CacheManager) reset() {
 	cm.enabled = false
 }
 
+// getInvalidationPublisher returns the publisher that propagates invalidations of node-local caches, or nil
+// if cache invalidation is not enabled.
+func getInvalidationPublisher(cm CacheManagerInterface) invalidationPublisher {
+	manager, ok := cm.(*CacheManager)
+	if !ok || manager.invalidationBus == nil {
+		return nil
+	}
+	return manager.invalidationBus
+}
+
+// newRedisClient creates a Redis client from the cache Redis configuration.
+func newRedisClient(redisConfig config.RedisConfig) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:            redisConfig.Address,
+		Username:        redisConfig.Username,
+		Password:        redisConfig.Password,
+		DB:              redisConfig.DB,
+		MaxRetries:      redisConfig.MaxRetries,
+		MinRetryBackoff: time.Duration(redisConfig.MinRetryBackoffMS) * time.Millisecond,
+		MaxRetryBackoff: time.Duration(redisConfig.MaxRetryBackoffMS) * time.Millisecond,
+		DialTimeout:     time.Duration(redisConfig.DialTimeoutMS) * time.Millisecond,
+		ReadTimeout:     time.Duration(redisConfig.ReadTimeoutMS) * time.Millisecond,
+		WriteTimeout:    time.Duration(redisConfig.WriteTimeoutMS) * time.Millisecond,
+	})
+}
+
 // buildRedisKeyPrefix composes the Redis key prefix with deployment ID for per-deployment isolation.
 func buildRedisKeyPrefix(basePrefix string) string {
 	deploymentID := config.GetServerRuntime().Config.Server.Identifier
@@ -248,6 +348,7 @@ func newCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[
 	logger.Debug("Initializing the cache")
 
 	var internalCache CacheInterface[T]
+	var invalidator invalidationPublisher
 	switch getCacheType(cacheConfig) {
 	case cacheTypeInMemory:
 		internalCache = newInMemoryCache[T](
@@ -256,6 +357,7 @@ func newCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[
 			cacheConfig,
 			cacheProperty,
 		)
+		invalidator = getInvalidationPublisher(cm)
 	case cacheTypeRedis:
 		redisClient := cm.getRedisClient()
 		if redisClient == nil {
@@ -284,12 +386,14 @@ func newCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[
 			cacheConfig,
 			cacheProperty,
 		)
+		invalidator = getInvalidationPublisher(cm)
 	}
 
 	cacheInst := &Cache[T]{
-		enabled:   true,
-		cacheName: cacheName,
-		cacheImpl: internalCache,
+		enabled:     true,
+		cacheName:   cacheName,
+		cacheImpl:   internalCache,
+		invalidator: invalidator,
 	}
 
 	return cacheInst
@@ -335,9 +439,10 @@ func GetInMemoryCache[T any](cm CacheManagerInterface, cacheName string) CacheIn
 	}
 
 	newCacheInst := &Cache[T]{
-		enabled:   !cacheConfig.Disabled && !cacheProperty.Disabled,
-		cacheName: cacheName,
-		cacheImpl: internalCache,
+		enabled:     !cacheConfig.Disabled && !cacheProperty.Disabled,
+		cacheName:   cacheName,
+		cacheImpl:   internalCache,
+		invalidator: getInvalidationPublisher(cm),
 	}
 	cm.addCache(cacheKey, newCacheInst)
 	return newCacheInst
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -466,3 +467,70 @@ func (suite *CacheManagerTestSuite) TestStartCleanupRoutine() {
 	// The mock is configured with Maybe() since we can't predict exactly how many times
 	// the cleanup routine will execute in the short time window
 }
+
+func (suite *CacheManagerTestSuite) TestApplyInvalidation() {
+	t := suite.T()
+
+	target := newInvalidationTargetMock(t)
+	target.EXPECT().GetName().Return("targetCache")
+	target.EXPECT().applyInvalidation(mock.Anything, invalidationOperationDelete, "testKey").Return()
+	other := newInvalidationTargetMock(t)
+	other.EXPECT().GetName().Return("otherCache")
+
+	manager := &CacheManager{
+		caches: map[string]interface{}{
+			"targetKey": target,
+			"otherKey":  other,
+		},
+	}
+
+	manager.applyInvalidation(invalidationMessage{
+		NodeID:    "node-2",
+		CacheName: "targetCache",
+		Operation: invalidationOperationDelete,
+		Key:       "testKey",
+	})
+}
+
+func (suite *CacheManagerTestSuite) TestResetLocalCaches() {
+	t := suite.T()
+
+	first := newInvalidationTargetMock(t)
+	first.EXPECT().applyInvalidation(mock.Anything, invalidationOperationClear, "").Return()
+	second := newInvalidationTargetMock(t)
+	second.EXPECT().applyInvalidation(mock.Anything, invalidationOperationClear, "").Return()
+
+	manager := &CacheManager{
+		caches: map[string]interface{}{
+			"firstKey":  first,
+			"secondKey": second,
+		},
+	}
+
+	manager.resetLocalCaches()
+}
+
+func (suite *CacheManagerTestSuite) TestGetInvalidationPublisher() {
+	t := suite.T()
+
+	manager := &CacheManager{caches: make(map[string]interface{})}
+	assert.Nil(t, getInvalidationPublisher(manager), "Publisher should be nil without an invalidation bus")
+
+	manager.invalidationBus = newInvalidationBus(nil, "test:cache-invalidation", "node-1", nil, nil)
+	assert.Same(t, manager.invalidationBus, getInvalidationPublisher(manager))
+
+	mockManager := NewCacheManagerInterfaceMock(t)
+	assert.Nil(t, getInvalidationPublisher(mockManager), "Publisher should be nil for other cache managers")
+}
+
+func (suite *CacheManagerTestSuite) TestStartInvalidationBusWithoutRedisAddress() {
+	t := suite.T()
+
+	manager := &CacheManager{caches: make(map[string]interface{})}
+	manager.startInvalidationBus(config.CacheConfig{
+		Invalidation: config.CacheInvalidationConfig{Enabled: true},
+	})
+
+	assert.Nil(t, manager.invalidationBus, "Invalidation bus should not start without a Redis address")
+	assert.Nil(t, manager.invalidationClient)
+}
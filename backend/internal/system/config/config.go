@@ -72,12 +72,32 @@ type ServerConfig struct {
 
 // GateClientConfig holds the client configuration details.
 type GateClientConfig struct {
-	Hostname  string `yaml:"hostname" json:"hostname"`
-	Port      int    `yaml:"port" json:"port"`
-	Scheme    string `yaml:"scheme" json:"scheme"`
-	Path      string `yaml:"path" json:"path"`
-	LoginPath string `yaml:"login_path" json:"login_path"`
-	ErrorPath string `yaml:"error_path" json:"error_path"`
+	Hostname   string `yaml:"hostname" json:"hostname"`
+	Port       int    `yaml:"port" json:"port"`
+	Scheme     string `yaml:"scheme" json:"scheme"`
+	Path       string `yaml:"path" json:"path"`
+	LoginPath  string `yaml:"login_path" json:"login_path"`
+	ErrorPath  string `yaml:"error_path" json:"error_path"`
+	LogoutPath string `yaml:"logout_path" json:"logout_path"`
+}
+
+// CustomDomainConfig holds the configuration of a customer-owned domain serving the gate pages and the
+// OAuth/OIDC endpoints. Requests received on Host are issued tokens with Issuer, redirected to the gate
+// pages under PublicURL, and have the cookies they set scoped to CookieDomain. CertFile and KeyFile hold
+// the certificate presented for Host when TLS is enabled. OUID restricts the domain to the applications
+// of an organization unit and its descendants, and SigningKeyID names the key that signs the tokens
+// issued on the domain. A domain with either of them is isolated from the server and the other domains.
+// Isolation is a property of the domain: organization units served on the server host share its issuer
+// and signing key.
+type CustomDomainConfig struct {
+	Host         string `yaml:"host" json:"host"`
+	PublicURL    string `yaml:"public_url" json:"public_url"`
+	Issuer       string `yaml:"issuer" json:"issuer"`
+	CookieDomain string `yaml:"cookie_domain" json:"cookie_domain"`
+	CertFile     string `yaml:"cert_file" json:"cert_file"`
+	KeyFile      string `yaml:"key_file" json:"key_file"`
+	OUID         string `yaml:"ou_id" json:"ou_id"`
+	SigningKeyID string `yaml:"signing_key_id" json:"signing_key_id"`
 }
 
 // TLSConfig holds the TLS configuration details.
@@ -158,14 +178,20 @@ type CacheProperty struct {
 
 // CacheConfig holds the cache configuration details.
 type CacheConfig struct {
-	Disabled        bool            `yaml:"disabled" json:"disabled"`
-	Type            string          `yaml:"type" json:"type"`
-	Size            int             `yaml:"size" json:"size"`
-	TTL             int             `yaml:"ttl" json:"ttl"`
-	EvictionPolicy  string          `yaml:"eviction_policy" json:"eviction_policy"`
-	CleanupInterval int             `yaml:"cleanup_interval" json:"cleanup_interval"`
-	Properties      []CacheProperty `yaml:"properties,omitempty" json:"properties,omitempty"`
-	Redis           RedisConfig     `yaml:"redis" json:"redis"`
+	Disabled        bool                    `yaml:"disabled" json:"disabled"`
+	Type            string                  `yaml:"type" json:"type"`
+	Size            int                     `yaml:"size" json:"size"`
+	TTL             int                     `yaml:"ttl" json:"ttl"`
+	EvictionPolicy  string                  `yaml:"eviction_policy" json:"eviction_policy"`
+	CleanupInterval int                     `yaml:"cleanup_interval" json:"cleanup_interval"`
+	Properties      []CacheProperty         `yaml:"properties,omitempty" json:"properties,omitempty"`
+	Redis           RedisConfig             `yaml:"redis" json:"redis"`
+	Invalidation    CacheInvalidationConfig `yaml:"invalidation" json:"invalidation"`
+}
+
+// CacheInvalidationConfig holds the configuration for propagating cache invalidations between nodes.
+type CacheInvalidationConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
 }
 
 // RedisConfig holds the Redis connection configuration.
@@ -203,9 +229,58 @@ type AuthorizationCodeConfig struct {
 	ValidityPeriod int64 `yaml:"validity_period" json:"validity_period"`
 }
 
-// DCRConfig holds the Dynamic Client Registration configuration.
+// DCRConfig holds the Dynamic Client Registration configuration. MaxTokenLifetime bounds the access and ID
+// token lifetimes and MaxRefreshTokenLifetime the refresh token lifetime a client can register, in seconds.
+// A zero maximum places no upper bound.
 type DCRConfig struct {
-	Insecure bool `yaml:"insecure" json:"insecure"`
+	Insecure                bool                    `yaml:"insecure" json:"insecure"`
+	MaxTokenLifetime        int64                   `yaml:"max_token_lifetime" json:"max_token_lifetime"`
+	MaxRefreshTokenLifetime int64                   `yaml:"max_refresh_token_lifetime" json:"max_refresh_token_lifetime"` //nolint:lll
+	SoftwareStatement       SoftwareStatementConfig `yaml:"software_statement" json:"software_statement"`
+}
+
+// SoftwareStatementConfig holds the configuration for software statements (RFC 7591 Section 2.3) presented in
+// dynamic client registration requests. A software statement is accepted only when it is signed by one of the
+// trusted issuers. When Required is set, every registration request must carry a software statement.
+type SoftwareStatementConfig struct {
+	Required       bool                      `yaml:"required" json:"required"`
+	TrustedIssuers []SoftwareStatementIssuer `yaml:"trusted_issuers" json:"trusted_issuers"`
+}
+
+// SoftwareStatementIssuer identifies an issuer trusted to sign software statements and the JWKS endpoint
+// that publishes its signing keys.
+type SoftwareStatementIssuer struct {
+	Issuer  string `yaml:"issuer" json:"issuer"`
+	JWKSURL string `yaml:"jwks_url" json:"jwks_url"`
+}
+
+// Validate checks the software statement configuration for correctness. Each trusted issuer must be unique
+// and have an HTTPS JWKS URL, and software statements can be required only when an issuer is trusted.
+func (c *SoftwareStatementConfig) Validate() error {
+	if c.Required && len(c.TrustedIssuers) == 0 {
+		return fmt.Errorf("oauth.dcr.software_statement.trusted_issuers must be set when " +
+			"oauth.dcr.software_statement.required is true")
+	}
+	issuers := make(map[string]struct{}, len(c.TrustedIssuers))
+	for _, trustedIssuer := range c.TrustedIssuers {
+		if strings.TrimSpace(trustedIssuer.Issuer) == "" {
+			return fmt.Errorf("oauth.dcr.software_statement.trusted_issuers: issuer must not be empty")
+		}
+		if _, exists := issuers[trustedIssuer.Issuer]; exists {
+			return fmt.Errorf("oauth.dcr.software_statement.trusted_issuers: duplicate issuer %q",
+				trustedIssuer.Issuer)
+		}
+		issuers[trustedIssuer.Issuer] = struct{}{}
+		if trustedIssuer.JWKSURL == "" {
+			return fmt.Errorf("oauth.dcr.software_statement.trusted_issuers: jwks_url must be set for issuer %q",
+				trustedIssuer.Issuer)
+		}
+		if err := validateJWKSURL("oauth.dcr.software_statement.trusted_issuers.jwks_url",
+			trustedIssuer.JWKSURL); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // PARConfig holds the Pushed Authorization Request (RFC 9126) configuration.
@@ -214,25 +289,133 @@ type PARConfig struct {
 	ExpiresIn  int64 `yaml:"expires_in" json:"expires_in"`
 }
 
+// AuthorizationDetailsConfig holds the Rich Authorization Requests (RFC 9396) configuration. When Types is
+// non-empty, authorization_details entries with any other type are rejected.
+type AuthorizationDetailsConfig struct {
+	Types []string `yaml:"types" json:"types"`
+}
+
+// CIBAConfig holds the Client-Initiated Backchannel Authentication configuration. ExpiresIn is the default and
+// maximum lifetime of a backchannel authentication request in seconds and Interval is the minimum number of
+// seconds a client must wait between token requests.
+type CIBAConfig struct {
+	ExpiresIn int64 `yaml:"expires_in" json:"expires_in"`
+	Interval  int64 `yaml:"interval" json:"interval"`
+}
+
+// TokenRateLimitConfig holds the configuration of the rate limits applied to the token endpoint. Requests are
+// counted per client in fixed windows of Window seconds.
+type TokenRateLimitConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Window is the length of a rate limit window in seconds.
+	Window int64 `yaml:"window" json:"window"`
+	// Limit is the maximum number of token requests of a client in a window. Zero means no limit.
+	Limit int `yaml:"limit" json:"limit"`
+	// Quotas override the limit for a client, a grant type, or a grant type of a client.
+	Quotas []TokenRateLimitQuotaConfig `yaml:"quotas" json:"quotas"`
+}
+
+// TokenRateLimitQuotaConfig holds a token endpoint quota. An empty client ID or grant type matches any client or
+// grant type.
+type TokenRateLimitQuotaConfig struct {
+	ClientID  string `yaml:"client_id" json:"client_id"`
+	GrantType string `yaml:"grant_type" json:"grant_type"`
+	Limit     int    `yaml:"limit" json:"limit"`
+}
+
+// SSOSessionConfig holds the single sign-on session configuration.
+type SSOSessionConfig struct {
+	Enabled         bool             `yaml:"enabled" json:"enabled"`
+	IdleTimeout     int64            `yaml:"idle_timeout" json:"idle_timeout"`
+	AbsoluteTimeout int64            `yaml:"absolute_timeout" json:"absolute_timeout"`
+	RememberMe      RememberMeConfig `yaml:"remember_me" json:"remember_me"`
+}
+
+// RememberMeConfig holds the configuration of persistent "keep me signed in" SSO sessions.
+// OrganizationUnits overrides the defaults for users of specific organization units.
+type RememberMeConfig struct {
+	Enabled           bool                 `yaml:"enabled" json:"enabled"`
+	Lifetime          int64                `yaml:"lifetime" json:"lifetime"`
+	OrganizationUnits []RememberMeOUConfig `yaml:"organization_units" json:"organization_units"`
+}
+
+// RememberMeOUConfig holds the persistent SSO session configuration of an organization unit.
+type RememberMeOUConfig struct {
+	OUID     string `yaml:"ou_id" json:"ou_id"`
+	Enabled  *bool  `yaml:"enabled,omitempty" json:"enabled,omitempty"`
+	Lifetime int64  `yaml:"lifetime,omitempty" json:"lifetime,omitempty"`
+}
+
 // OAuthConfig holds the OAuth configuration details.
 type OAuthConfig struct {
-	RefreshToken      RefreshTokenConfig      `yaml:"refresh_token" json:"refresh_token"`
-	AuthorizationCode AuthorizationCodeConfig `yaml:"authorization_code" json:"authorization_code"`
-	DCR               DCRConfig               `yaml:"dcr" json:"dcr"`
-	PAR               PARConfig               `yaml:"par" json:"par"`
-	AuthClass         AuthClassConfig         `yaml:"auth_class" json:"auth_class"`
+	RefreshToken         RefreshTokenConfig         `yaml:"refresh_token" json:"refresh_token"`
+	AuthorizationCode    AuthorizationCodeConfig    `yaml:"authorization_code" json:"authorization_code"`
+	DCR                  DCRConfig                  `yaml:"dcr" json:"dcr"`
+	PAR                  PARConfig                  `yaml:"par" json:"par"`
+	AuthorizationDetails AuthorizationDetailsConfig `yaml:"authorization_details" json:"authorization_details"`
+	CIBA                 CIBAConfig                 `yaml:"ciba" json:"ciba"`
+	AuthClass            AuthClassConfig            `yaml:"auth_class" json:"auth_class"`
+	TokenRateLimit       TokenRateLimitConfig       `yaml:"token_rate_limit" json:"token_rate_limit"`
+	Discovery            DiscoveryConfig            `yaml:"discovery" json:"discovery"`
 	// AllowWildcardRedirectURI enables wildcard pattern matching for redirect URIs.
 	// When false (default), only exact redirect URI matching is performed.
 	AllowWildcardRedirectURI bool `yaml:"allow_wildcard_redirect_uri" json:"allow_wildcard_redirect_uri"`
 }
 
+// DiscoveryConfig holds the configuration of the OAuth 2.0 authorization server metadata (RFC 8414) and
+// the OpenID Connect provider metadata served by the discovery endpoints.
+//
+// Issuer overrides the issuer advertised in the metadata. Endpoints maps metadata names, such as
+// revocation_endpoint or device_authorization_endpoint, to the endpoint URL to advertise. A value that starts
+// with a slash is resolved against the public URL of the request. A configured endpoint replaces the one the
+// server advertises by default. AdditionalMetadata holds custom metadata fields; they never replace the
+// fields generated by the server.
+type DiscoveryConfig struct {
+	Issuer             string                 `yaml:"issuer" json:"issuer"`
+	Endpoints          map[string]string      `yaml:"endpoints" json:"endpoints"`
+	AdditionalMetadata map[string]interface{} `yaml:"additional_metadata" json:"additional_metadata"`
+}
+
+// Validate checks the discovery configuration for correctness. Endpoint names must end with "_endpoint" and
+// endpoint values must be absolute HTTP(S) URLs or paths starting with a slash.
+func (c *DiscoveryConfig) Validate() error {
+	for name, endpoint := range c.Endpoints {
+		if !strings.HasSuffix(name, "_endpoint") {
+			return fmt.Errorf("oauth.discovery.endpoints: %q is not an endpoint metadata name", name)
+		}
+		if strings.HasPrefix(endpoint, "/") {
+			continue
+		}
+		parsed, err := url.Parse(endpoint)
+		if err != nil || (parsed.Scheme != schemeHTTPS && parsed.Scheme != "http") || parsed.Host == "" {
+			return fmt.Errorf("oauth.discovery.endpoints: %s must be an absolute http(s) URL or a path "+
+				"starting with /", name)
+		}
+	}
+	for name := range c.AdditionalMetadata {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("oauth.discovery.additional_metadata: metadata name must not be empty")
+		}
+	}
+	return nil
+}
+
 // FlowConfig holds the configuration details for the flow service.
 type FlowConfig struct {
-	DefaultAuthFlowHandle    string `yaml:"default_auth_flow_handle" json:"default_auth_flow_handle"`
-	UserOnboardingFlowHandle string `yaml:"user_onboarding_flow_handle" json:"user_onboarding_flow_handle"`
-	MaxVersionHistory        int    `yaml:"max_version_history" json:"max_version_history"`
-	AutoInferRegistration    bool   `yaml:"auto_infer_registration" json:"auto_infer_registration"`
-	Store                    string `yaml:"store" json:"store"`
+	DefaultAuthFlowHandle    string            `yaml:"default_auth_flow_handle" json:"default_auth_flow_handle"`
+	UserOnboardingFlowHandle string            `yaml:"user_onboarding_flow_handle" json:"user_onboarding_flow_handle"`
+	MFAEnrollmentFlowHandle  string            `yaml:"mfa_enrollment_flow_handle" json:"mfa_enrollment_flow_handle"`
+	MaxVersionHistory        int               `yaml:"max_version_history" json:"max_version_history"`
+	AutoInferRegistration    bool              `yaml:"auto_infer_registration" json:"auto_infer_registration"`
+	Store                    string            `yaml:"store" json:"store"`
+	Sandbox                  FlowSandboxConfig `yaml:"sandbox" json:"sandbox"`
+}
+
+// FlowSandboxConfig holds the configuration details for sandbox flow executions.
+type FlowSandboxConfig struct {
+	Enabled        bool  `yaml:"enabled" json:"enabled"`
+	SessionTimeout int64 `yaml:"session_timeout" json:"session_timeout"`
+	MaxSessions    int   `yaml:"max_sessions" json:"max_sessions"`
 }
 
 // CryptoConfig holds the cryptographic configuration details.
@@ -240,6 +423,14 @@ type CryptoConfig struct {
 	Encryption      EncryptionConfig      `yaml:"encryption" json:"encryption"`
 	PasswordHashing PasswordHashingConfig `yaml:"password_hashing" json:"password_hashing"`
 	Keys            []KeyConfig           `yaml:"keys" json:"keys"`
+	KeyRotation     KeyRotationConfig     `yaml:"key_rotation" json:"key_rotation"`
+}
+
+// KeyRotationConfig holds the configuration details for rotating the token signing key.
+type KeyRotationConfig struct {
+	// RolloverWindow is the number of seconds the previous signing key stays published after a rotation
+	// when the rotation request does not specify one.
+	RolloverWindow int64 `yaml:"rollover_window" json:"rollover_window"`
 }
 
 // KeyConfig holds the key configuration details.
@@ -402,6 +593,21 @@ type IdentityProviderConfig struct {
 	//   - If DeclarativeResources.Enabled = true: behaves as "declarative"
 	//   - If DeclarativeResources.Enabled = false: behaves as "mutable"
 	Store string `yaml:"store" json:"store"`
+	// HealthCheck configures the periodic probing of the endpoints of the identity providers.
+	HealthCheck IDPHealthCheckConfig `yaml:"health_check" json:"health_check"`
+}
+
+// IDPHealthCheckConfig holds the configuration of the identity provider health checks.
+type IDPHealthCheckConfig struct {
+	// Enabled turns on the periodic probing of the token, userinfo and JWKS endpoints of the identity providers.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Interval is the time in seconds between two rounds of probes.
+	Interval int `yaml:"interval" json:"interval"`
+	// Timeout is the time in seconds to wait for an endpoint to respond to a probe.
+	Timeout int `yaml:"timeout" json:"timeout"`
+	// FailureThreshold is the number of consecutive failed probes after which the circuit of an identity
+	// provider opens and the executors using it fail fast.
+	FailureThreshold int `yaml:"failure_threshold" json:"failure_threshold"`
 }
 
 // ApplicationConfig holds the application service configuration.
@@ -442,6 +648,9 @@ type ThemeConfig struct {
 	//   - If DeclarativeResources.Enabled = true: behaves as "declarative"
 	//   - If DeclarativeResources.Enabled = false: behaves as "mutable"
 	Store string `yaml:"store" json:"store"`
+	// DefaultID is the ID of the theme used when neither the application nor its organization unit
+	// hierarchy has a theme configured.
+	DefaultID string `yaml:"default_id" json:"default_id"`
 }
 
 // LayoutConfig holds the layout service configuration.
@@ -452,6 +661,43 @@ type LayoutConfig struct {
 	//   - If DeclarativeResources.Enabled = true: behaves as "declarative"
 	//   - If DeclarativeResources.Enabled = false: behaves as "mutable"
 	Store string `yaml:"store" json:"store"`
+	// DefaultID is the ID of the layout used when neither the application nor its organization unit
+	// hierarchy has a layout configured.
+	DefaultID string `yaml:"default_id" json:"default_id"`
+}
+
+// DesignAssetConfig holds the configuration of branding assets, such as logos and background images,
+// uploaded for use in themes and layouts.
+type DesignAssetConfig struct {
+	// Storage defines the storage backend for assets. Valid values: "filesystem", "s3".
+	Storage string `yaml:"storage" json:"storage"`
+	// MaxSize is the maximum size of an uploaded asset in bytes.
+	MaxSize int64 `yaml:"max_size" json:"max_size"`
+	// AllowedContentTypes lists the content types that may be uploaded.
+	AllowedContentTypes []string `yaml:"allowed_content_types" json:"allowed_content_types"`
+	// CacheMaxAge is the max-age in seconds of the Cache-Control header set when serving assets.
+	CacheMaxAge int                         `yaml:"cache_max_age" json:"cache_max_age"`
+	FileSystem  DesignAssetFileSystemConfig `yaml:"filesystem" json:"filesystem"`
+	S3          DesignAssetS3Config         `yaml:"s3" json:"s3"`
+}
+
+// DesignAssetFileSystemConfig holds the configuration of the filesystem asset storage.
+type DesignAssetFileSystemConfig struct {
+	// Path is the directory assets are stored in, relative to the server home.
+	Path string `yaml:"path" json:"path"`
+}
+
+// DesignAssetS3Config holds the configuration of the S3 asset storage.
+type DesignAssetS3Config struct {
+	Bucket string `yaml:"bucket" json:"bucket"`
+	Region string `yaml:"region" json:"region"`
+	// Endpoint overrides the S3 endpoint for S3 compatible object stores. Objects are addressed
+	// path-style when an endpoint is set.
+	Endpoint        string `yaml:"endpoint" json:"endpoint"`
+	Prefix          string `yaml:"prefix" json:"prefix"`
+	AccessKeyID     string `yaml:"access_key_id" json:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key" json:"secret_access_key"`
+	SessionToken    string `yaml:"session_token" json:"session_token"`
 }
 
 // PasskeyConfig holds the passkey configuration details.
@@ -503,6 +749,182 @@ type SMTPEmailConfig struct {
 	EnableAuthentication *bool  `yaml:"enable_authentication" json:"enable_authentication"`
 }
 
+// I18nConfig holds the internationalization configuration.
+type I18nConfig struct {
+	// FallbackChains maps a language tag to the ordered list of languages tried when a translation
+	// is not available in that language (e.g. "fr-CA": ["fr", "en"]).
+	FallbackChains map[string][]string `yaml:"fallback_chains" json:"fallback_chains"`
+	// DefaultFallbackChain lists the languages tried for every language after its own fallback chain.
+	DefaultFallbackChain []string `yaml:"default_fallback_chain" json:"default_fallback_chain"`
+}
+
+// MCPConfig holds the configuration details for the MCP server transport.
+type MCPConfig struct {
+	// SessionTimeout is the duration in seconds after which an idle MCP session is closed.
+	SessionTimeout int64 `yaml:"session_timeout" json:"session_timeout"`
+	// EventStoreMaxBytes is the maximum size of the stream events retained for resuming MCP streams.
+	EventStoreMaxBytes int `yaml:"event_store_max_bytes" json:"event_store_max_bytes"`
+}
+
+// SAMLConfig holds the configuration of the SAML 2.0 identity provider.
+type SAMLConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// EntityID is the entity ID of the identity provider. Defaults to the metadata endpoint URL.
+	EntityID string `yaml:"entity_id" json:"entity_id"`
+	// AssertionValidity is the duration in seconds for which an issued assertion is valid.
+	AssertionValidity int64 `yaml:"assertion_validity" json:"assertion_validity"`
+	// ServiceProviders lists the service providers allowed to authenticate users with the identity provider.
+	ServiceProviders []SAMLServiceProviderConfig `yaml:"service_providers" json:"service_providers"`
+}
+
+// SAMLServiceProviderConfig holds the configuration of a SAML service provider. Users of the service provider
+// sign in through the login flow of the application it is bound to.
+type SAMLServiceProviderConfig struct {
+	EntityID      string   `yaml:"entity_id" json:"entity_id"`
+	ApplicationID string   `yaml:"application_id" json:"application_id"`
+	ACSURLs       []string `yaml:"acs_urls" json:"acs_urls"`
+	SLOURL        string   `yaml:"slo_url" json:"slo_url"`
+	// NameIDFormat is the format of the NameID issued to the service provider. Defaults to unspecified.
+	NameIDFormat string `yaml:"name_id_format" json:"name_id_format"`
+	// NameIDAttribute is the user attribute used as the NameID. Defaults to the user ID.
+	NameIDAttribute string `yaml:"name_id_attribute" json:"name_id_attribute"`
+	// Attributes are the user attributes released to the service provider in the attribute statement.
+	Attributes []string `yaml:"attributes" json:"attributes"`
+	// AuthnRequestsSigned requires the authentication and logout requests of the service provider to be
+	// signed with the key of the PEM encoded certificate.
+	AuthnRequestsSigned bool   `yaml:"authn_requests_signed" json:"authn_requests_signed"`
+	Certificate         string `yaml:"certificate" json:"certificate"`
+}
+
+// Validate checks the SAML configuration for correctness. Every service provider must have a unique entity ID,
+// an application and at least one assertion consumer service URL, and a certificate when its requests are
+// signed.
+func (c *SAMLConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.AssertionValidity < 0 {
+		return fmt.Errorf("saml.assertion_validity must be non-negative (got %d)", c.AssertionValidity)
+	}
+
+	entityIDs := make(map[string]struct{}, len(c.ServiceProviders))
+	for _, sp := range c.ServiceProviders {
+		if strings.TrimSpace(sp.EntityID) == "" {
+			return fmt.Errorf("saml: service provider entity_id must not be empty")
+		}
+		if _, ok := entityIDs[sp.EntityID]; ok {
+			return fmt.Errorf("saml: duplicate service provider entity_id %q", sp.EntityID)
+		}
+		entityIDs[sp.EntityID] = struct{}{}
+
+		if sp.ApplicationID == "" {
+			return fmt.Errorf("saml: service provider %q must have an application_id", sp.EntityID)
+		}
+		if len(sp.ACSURLs) == 0 {
+			return fmt.Errorf("saml: service provider %q must have at least one acs_urls entry", sp.EntityID)
+		}
+		for _, endpoint := range append([]string{sp.SLOURL}, sp.ACSURLs...) {
+			if endpoint == "" {
+				continue
+			}
+			if parsed, err := url.Parse(endpoint); err != nil || !parsed.IsAbs() {
+				return fmt.Errorf("saml: service provider %q has an invalid endpoint URL %q", sp.EntityID, endpoint)
+			}
+		}
+		if sp.AuthnRequestsSigned && sp.Certificate == "" {
+			return fmt.Errorf("saml: service provider %q must have a certificate when authn_requests_signed is set",
+				sp.EntityID)
+		}
+	}
+
+	return nil
+}
+
+// APIDocsConfig holds the configuration of the OpenAPI document served by the server.
+type APIDocsConfig struct {
+	// Disabled stops serving the OpenAPI document at /api-docs.
+	Disabled bool `yaml:"disabled" json:"disabled"`
+	// SwaggerUIEnabled serves a Swagger UI for the OpenAPI document at /develop.
+	SwaggerUIEnabled bool `yaml:"swagger_ui_enabled" json:"swagger_ui_enabled"`
+}
+
+// SchedulerConfig holds the configuration of the scheduler running the recurring jobs of the server.
+type SchedulerConfig struct {
+	// Disabled stops the scheduler. Jobs can still be run on demand through the job API.
+	Disabled bool `yaml:"disabled" json:"disabled"`
+	// Clustered makes the nodes of a deployment coordinate through a lock in the runtime database so that
+	// each scheduled run of a job executes on a single node.
+	Clustered bool `yaml:"clustered" json:"clustered"`
+	// LockTimeout is the time in seconds after which the lock of a job held by an unresponsive node expires.
+	LockTimeout int `yaml:"lock_timeout" json:"lock_timeout"`
+	// Jobs overrides the schedules of individual jobs.
+	Jobs []SchedulerJobConfig `yaml:"jobs" json:"jobs"`
+}
+
+// SchedulerJobConfig holds the configuration of a scheduled job.
+type SchedulerJobConfig struct {
+	Name     string `yaml:"name" json:"name"`
+	Schedule string `yaml:"schedule" json:"schedule"`
+	Disabled bool   `yaml:"disabled" json:"disabled"`
+}
+
+// ProvisioningConfig holds the configuration of the outbound provisioning of users and groups to downstream
+// SCIM 2.0 endpoints.
+type ProvisioningConfig struct {
+	// Timeout is the HTTP request timeout in seconds for the requests to the SCIM endpoints.
+	Timeout int `yaml:"timeout" json:"timeout"`
+	// MaxAttempts is the number of attempts made to push a change before it is marked as failed.
+	MaxAttempts int `yaml:"max_attempts" json:"max_attempts"`
+	// Targets lists the downstream SCIM endpoints the changes are pushed to.
+	Targets []ProvisioningTargetConfig `yaml:"targets" json:"targets"`
+}
+
+// ProvisioningTargetConfig holds the configuration of a downstream SCIM 2.0 endpoint.
+type ProvisioningTargetConfig struct {
+	ID   string `yaml:"id" json:"id"`
+	Name string `yaml:"name" json:"name"`
+	// URL is the base URL of the SCIM 2.0 service, under which the /Users and /Groups endpoints are served.
+	URL string `yaml:"url" json:"url"`
+	// Token is the bearer token sent in the requests to the SCIM service.
+	Token string `yaml:"token" json:"token"`
+	// ProvisionGroups pushes the group changes in addition to the user changes.
+	ProvisionGroups bool `yaml:"provision_groups" json:"provision_groups"`
+	// AttributeMapping maps the SCIM attributes of the provisioned users to the user attributes. Sub-attributes
+	// are addressed with a dot, such as name.givenName. Defaults to userName and emails mapped from the username
+	// and email attributes.
+	AttributeMapping map[string]string `yaml:"attribute_mapping" json:"attribute_mapping"`
+}
+
+// Validate checks the provisioning configuration for correctness. Every target must have a unique ID and an
+// absolute URL.
+func (c *ProvisioningConfig) Validate() error {
+	if c.MaxAttempts < 0 {
+		return fmt.Errorf("provisioning.max_attempts must be non-negative (got %d)", c.MaxAttempts)
+	}
+
+	ids := make(map[string]struct{}, len(c.Targets))
+	for _, target := range c.Targets {
+		if strings.TrimSpace(target.ID) == "" {
+			return fmt.Errorf("provisioning: target id must not be empty")
+		}
+		if _, ok := ids[target.ID]; ok {
+			return fmt.Errorf("provisioning: duplicate target id %q", target.ID)
+		}
+		ids[target.ID] = struct{}{}
+
+		if parsed, err := url.Parse(target.URL); err != nil || !parsed.IsAbs() {
+			return fmt.Errorf("provisioning: target %q has an invalid url %q", target.ID, target.URL)
+		}
+		for scimAttr, userAttr := range target.AttributeMapping {
+			if strings.TrimSpace(scimAttr) == "" || strings.TrimSpace(userAttr) == "" {
+				return fmt.Errorf("provisioning: target %q has an empty attribute mapping", target.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
 // ConsentConfig holds the configuration for the consent service integration.
 type ConsentConfig struct {
 	Enabled    bool   `yaml:"enabled" json:"enabled"`
@@ -559,9 +981,14 @@ func (c *TrustedIssuerConfig) Validate() error {
 		return fmt.Errorf("trusted_issuer.audience must be set when trusted_issuer.issuer is set")
 	}
 
-	parsed, err := url.Parse(c.JWKSURL)
+	return validateJWKSURL("trusted_issuer.jwks_url", c.JWKSURL)
+}
+
+// validateJWKSURL checks that a JWKS URL uses HTTPS. HTTP is allowed only for localhost.
+func validateJWKSURL(name, jwksURL string) error {
+	parsed, err := url.Parse(jwksURL)
 	if err != nil {
-		return fmt.Errorf("trusted_issuer.jwks_url is not a valid URL: %w", err)
+		return fmt.Errorf("%s is not a valid URL: %w", name, err)
 	}
 	switch parsed.Scheme {
 	case schemeHTTPS:
@@ -572,10 +999,10 @@ func (c *TrustedIssuerConfig) Validate() error {
 			return nil
 		}
 		return fmt.Errorf(
-			"trusted_issuer.jwks_url must use https (got http://%s); "+
-				"http is only allowed for localhost", host)
+			"%s must use https (got http://%s); "+
+				"http is only allowed for localhost", name, host)
 	default:
-		return fmt.Errorf("trusted_issuer.jwks_url must use https scheme (got %q)", parsed.Scheme)
+		return fmt.Errorf("%s must use https scheme (got %q)", name, parsed.Scheme)
 	}
 }
 
@@ -628,6 +1055,7 @@ type Config struct {
 	Cache                CacheConfig            `yaml:"cache" json:"cache"`
 	JWT                  JWTConfig              `yaml:"jwt" json:"jwt"`
 	OAuth                OAuthConfig            `yaml:"oauth" json:"oauth"`
+	SSOSession           SSOSessionConfig       `yaml:"sso_session" json:"sso_session"`
 	Flow                 FlowConfig             `yaml:"flow" json:"flow"`
 	Crypto               CryptoConfig           `yaml:"crypto" json:"crypto"`
 	CORS                 CORSConfig             `yaml:"cors" json:"cors"`
@@ -646,8 +1074,16 @@ type Config struct {
 	Role                 RoleConfig             `yaml:"role" json:"role"`
 	Theme                ThemeConfig            `yaml:"theme" json:"theme"`
 	Layout               LayoutConfig           `yaml:"layout" json:"layout"`
+	DesignAsset          DesignAssetConfig      `yaml:"design_asset" json:"design_asset"`
 	Email                EmailConfig            `yaml:"email" json:"email"`
 	Consent              ConsentConfig          `yaml:"consent" json:"consent"`
+	I18n                 I18nConfig             `yaml:"i18n" json:"i18n"`
+	MCP                  MCPConfig              `yaml:"mcp" json:"mcp"`
+	SAML                 SAMLConfig             `yaml:"saml" json:"saml"`
+	APIDocs              APIDocsConfig          `yaml:"api_docs" json:"api_docs"`
+	Scheduler            SchedulerConfig        `yaml:"scheduler" json:"scheduler"`
+	Provisioning         ProvisioningConfig     `yaml:"provisioning" json:"provisioning"`
+	CustomDomains        []CustomDomainConfig   `yaml:"custom_domains" json:"custom_domains"`
 }
 
 // LoadConfig loads the configurations from the specified YAML file and applies defaults.
@@ -672,7 +1108,7 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 
 	// Merge user configuration with defaults
 	mergeConfigs(&cfg, &userCfg)
-	// Derive login_path and error_path from path if not explicitly set
+	// Derive login_path, error_path and logout_path from path if not explicitly set
 	if cfg.GateClient.Path != "" {
 		if cfg.GateClient.LoginPath == "" {
 			cfg.GateClient.LoginPath = urlpath.Join(cfg.GateClient.Path, "signin")
@@ -680,6 +1116,9 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 		if cfg.GateClient.ErrorPath == "" {
 			cfg.GateClient.ErrorPath = urlpath.Join(cfg.GateClient.Path, "error")
 		}
+		if cfg.GateClient.LogoutPath == "" {
+			cfg.GateClient.LogoutPath = urlpath.Join(cfg.GateClient.Path, "logout")
+		}
 	}
 
 	// Derive JWT issuer from server config if not set
@@ -687,6 +1126,9 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 		cfg.JWT.Issuer = GetServerURL(&cfg.Server)
 	}
 
+	// Derive custom domain public URLs and issuers from the host if not set.
+	applyCustomDomainDefaults(&cfg)
+
 	// Default system resource server identifier to "system" if not set.
 	if cfg.Resource.SystemResourceServer.Identifier == "" {
 		cfg.Resource.SystemResourceServer.Identifier = "system"
@@ -703,6 +1145,24 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 	if err := cfg.OAuth.AuthClass.Validate(); err != nil {
 		return nil, err
 	}
+	if err := cfg.OAuth.DCR.SoftwareStatement.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OAuth.Discovery.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateCustomDomains(cfg.CustomDomains); err != nil {
+		return nil, err
+	}
+	if err := validateCustomDomainIsolation(&cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.SAML.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Provisioning.Validate(); err != nil {
+		return nil, err
+	}
 
 	return &cfg, nil
 }
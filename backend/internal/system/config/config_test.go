@@ -785,6 +785,7 @@ gate_client:
 	assert.Equal(suite.T(), "/app", config1.GateClient.Path)
 	assert.Equal(suite.T(), "/app/signin", config1.GateClient.LoginPath)
 	assert.Equal(suite.T(), "/app/error", config1.GateClient.ErrorPath)
+	assert.Equal(suite.T(), "/app/logout", config1.GateClient.LogoutPath)
 
 	// Case 2: Path and LoginPath are set
 	userContent2 := `
@@ -948,6 +949,119 @@ func (suite *ConfigTestSuite) TestTrustedIssuerConfig_Validate_InvalidURL() {
 	assert.Error(suite.T(), err)
 }
 
+func (suite *ConfigTestSuite) TestSoftwareStatementConfig_Validate_NotConfigured() {
+	cfg := &SoftwareStatementConfig{}
+	assert.NoError(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestSoftwareStatementConfig_Validate_Valid() {
+	cfg := &SoftwareStatementConfig{
+		Required: true,
+		TrustedIssuers: []SoftwareStatementIssuer{
+			{Issuer: "https://directory.example.com", JWKSURL: "https://directory.example.com/jwks"},
+			{Issuer: "https://sandbox.example.com", JWKSURL: "http://localhost:9000/jwks"},
+		},
+	}
+	assert.NoError(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestSoftwareStatementConfig_Validate_RequiredWithoutIssuers() {
+	cfg := &SoftwareStatementConfig{Required: true}
+	err := cfg.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "trusted_issuers must be set")
+}
+
+func (suite *ConfigTestSuite) TestSoftwareStatementConfig_Validate_InvalidIssuers() {
+	testCases := []struct {
+		name     string
+		issuers  []SoftwareStatementIssuer
+		contains string
+	}{
+		{
+			name:     "EmptyIssuer",
+			issuers:  []SoftwareStatementIssuer{{JWKSURL: "https://directory.example.com/jwks"}},
+			contains: "issuer must not be empty",
+		},
+		{
+			name:     "MissingJWKSURL",
+			issuers:  []SoftwareStatementIssuer{{Issuer: "https://directory.example.com"}},
+			contains: "jwks_url must be set",
+		},
+		{
+			name: "DuplicateIssuer",
+			issuers: []SoftwareStatementIssuer{
+				{Issuer: "https://directory.example.com", JWKSURL: "https://directory.example.com/jwks"},
+				{Issuer: "https://directory.example.com", JWKSURL: "https://directory.example.com/jwks2"},
+			},
+			contains: "duplicate issuer",
+		},
+		{
+			name: "HTTPJWKSURL",
+			issuers: []SoftwareStatementIssuer{
+				{Issuer: "https://directory.example.com", JWKSURL: "http://directory.example.com/jwks"},
+			},
+			contains: "must use https",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cfg := &SoftwareStatementConfig{TrustedIssuers: tc.issuers}
+			err := cfg.Validate()
+			assert.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), tc.contains)
+		})
+	}
+}
+
+func (suite *ConfigTestSuite) TestDiscoveryConfig_Validate_Valid() {
+	cfg := &DiscoveryConfig{
+		Issuer: "https://id.example.com",
+		Endpoints: map[string]string{
+			"revocation_endpoint":           "/oauth2/revoke",
+			"device_authorization_endpoint": "https://device.example.com/device",
+		},
+		AdditionalMetadata: map[string]interface{}{"service_documentation": "https://docs.example.com"},
+	}
+	assert.NoError(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestDiscoveryConfig_Validate_Invalid() {
+	testCases := []struct {
+		name     string
+		cfg      DiscoveryConfig
+		contains string
+	}{
+		{
+			name:     "NotAnEndpointName",
+			cfg:      DiscoveryConfig{Endpoints: map[string]string{"jwks": "/oauth2/jwks"}},
+			contains: "is not an endpoint metadata name",
+		},
+		{
+			name:     "RelativeURL",
+			cfg:      DiscoveryConfig{Endpoints: map[string]string{"revocation_endpoint": "oauth2/revoke"}},
+			contains: "revocation_endpoint must be an absolute http(s) URL",
+		},
+		{
+			name:     "UnsupportedScheme",
+			cfg:      DiscoveryConfig{Endpoints: map[string]string{"revocation_endpoint": "ftp://id.example.com"}},
+			contains: "revocation_endpoint must be an absolute http(s) URL",
+		},
+		{
+			name:     "EmptyMetadataName",
+			cfg:      DiscoveryConfig{AdditionalMetadata: map[string]interface{}{" ": "value"}},
+			contains: "metadata name must not be empty",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.cfg.Validate()
+			assert.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), tc.contains)
+		})
+	}
+}
+
 func (suite *ConfigTestSuite) TestSecurityConfig_Validate_NegativeJWKSCacheTTL() {
 	cfg := &SecurityConfig{
 		JWKSCacheTTL: -1,
@@ -1089,3 +1203,126 @@ func (suite *ConfigTestSuite) TestAuthClassValidate_EmptyAMRReference() {
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "references an empty AMR key")
 }
+
+func (suite *ConfigTestSuite) TestSAMLConfigValidate_Disabled() {
+	cfg := SAMLConfig{ServiceProviders: []SAMLServiceProviderConfig{{}}}
+	assert.NoError(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestSAMLConfigValidate_ValidServiceProvider() {
+	cfg := SAMLConfig{
+		Enabled: true,
+		ServiceProviders: []SAMLServiceProviderConfig{
+			{
+				EntityID:      "https://sp.example.com",
+				ApplicationID: "app-1",
+				ACSURLs:       []string{"https://sp.example.com/acs"},
+				SLOURL:        "https://sp.example.com/slo",
+			},
+		},
+	}
+	assert.NoError(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestSAMLConfigValidate_InvalidServiceProviders() {
+	validSP := SAMLServiceProviderConfig{
+		EntityID:      "https://sp.example.com",
+		ApplicationID: "app-1",
+		ACSURLs:       []string{"https://sp.example.com/acs"},
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(sp *SAMLServiceProviderConfig)
+		extraSP  bool
+		expected string
+	}{
+		{"EmptyEntityID", func(sp *SAMLServiceProviderConfig) { sp.EntityID = " " }, false, "entity_id"},
+		{"DuplicateEntityID", func(sp *SAMLServiceProviderConfig) {}, true, "duplicate"},
+		{"MissingApplication", func(sp *SAMLServiceProviderConfig) { sp.ApplicationID = "" }, false,
+			"application_id"},
+		{"MissingACSURL", func(sp *SAMLServiceProviderConfig) { sp.ACSURLs = nil }, false, "acs_urls"},
+		{"RelativeACSURL", func(sp *SAMLServiceProviderConfig) { sp.ACSURLs = []string{"/acs"} }, false,
+			"invalid endpoint URL"},
+		{"RelativeSLOURL", func(sp *SAMLServiceProviderConfig) { sp.SLOURL = "slo" }, false,
+			"invalid endpoint URL"},
+		{"SignedWithoutCertificate", func(sp *SAMLServiceProviderConfig) { sp.AuthnRequestsSigned = true },
+			false, "certificate"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			sp := validSP
+			tc.modify(&sp)
+			cfg := SAMLConfig{Enabled: true, ServiceProviders: []SAMLServiceProviderConfig{sp}}
+			if tc.extraSP {
+				cfg.ServiceProviders = append(cfg.ServiceProviders, validSP)
+			}
+
+			err := cfg.Validate()
+			assert.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), tc.expected)
+		})
+	}
+}
+
+func (suite *ConfigTestSuite) TestProvisioningConfigValidate_ValidTarget() {
+	cfg := ProvisioningConfig{
+		MaxAttempts: 5,
+		Targets: []ProvisioningTargetConfig{
+			{
+				ID:               "crm",
+				URL:              "https://crm.example.com/scim/v2",
+				AttributeMapping: map[string]string{"userName": "username"},
+			},
+		},
+	}
+	assert.NoError(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestProvisioningConfigValidate_InvalidTargets() {
+	validTarget := ProvisioningTargetConfig{ID: "crm", URL: "https://crm.example.com/scim/v2"}
+
+	testCases := []struct {
+		name        string
+		modify      func(target *ProvisioningTargetConfig)
+		extraTarget bool
+		expected    string
+	}{
+		{"EmptyID", func(target *ProvisioningTargetConfig) { target.ID = " " }, false, "id must not be empty"},
+		{"DuplicateID", func(target *ProvisioningTargetConfig) {}, true, "duplicate"},
+		{"RelativeURL", func(target *ProvisioningTargetConfig) { target.URL = "/scim/v2" }, false, "invalid url"},
+		{"EmptyMapping", func(target *ProvisioningTargetConfig) {
+			target.AttributeMapping = map[string]string{"userName": ""}
+		}, false, "empty attribute mapping"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			target := validTarget
+			tc.modify(&target)
+			cfg := ProvisioningConfig{Targets: []ProvisioningTargetConfig{target}}
+			if tc.extraTarget {
+				cfg.Targets = append(cfg.Targets, validTarget)
+			}
+
+			err := cfg.Validate()
+			assert.Error(suite.T(), err)
+			assert.Contains(suite.T(), err.Error(), tc.expected)
+		})
+	}
+}
+
+func (suite *ConfigTestSuite) TestProvisioningConfigValidate_NegativeMaxAttempts() {
+	cfg := ProvisioningConfig{MaxAttempts: -1}
+	err := cfg.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "max_attempts")
+}
+
+func (suite *ConfigTestSuite) TestSAMLConfigValidate_NegativeAssertionValidity() {
+	cfg := SAMLConfig{Enabled: true, AssertionValidity: -1}
+	err := cfg.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "assertion_validity")
+}
@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+// applyCustomDomainDefaults derives the public URL of each custom domain from its host, and the issuer
+// from the public URL, when they are not configured explicitly.
+func applyCustomDomainDefaults(cfg *Config) {
+	scheme := schemeHTTPS
+	if cfg.Server.HTTPOnly {
+		scheme = "http"
+	}
+	for i := range cfg.CustomDomains {
+		domain := &cfg.CustomDomains[i]
+		domain.Host = strings.ToLower(strings.TrimSpace(domain.Host))
+		if domain.PublicURL == "" && domain.Host != "" {
+			domain.PublicURL = fmt.Sprintf("%s://%s", scheme, domain.Host)
+		}
+		domain.PublicURL = strings.TrimSuffix(domain.PublicURL, "/")
+		if domain.Issuer == "" {
+			domain.Issuer = domain.PublicURL
+		}
+	}
+}
+
+// validateCustomDomains checks that every custom domain has a unique host name, a valid public URL,
+// a cookie domain that covers the host, and either both or neither of the certificate and key files.
+func validateCustomDomains(domains []CustomDomainConfig) error {
+	seen := make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		if domain.Host == "" || strings.ContainsAny(domain.Host, ":/") {
+			return fmt.Errorf("custom_domains: host must be a host name without scheme or port (got %q)",
+				domain.Host)
+		}
+		if _, exists := seen[domain.Host]; exists {
+			return fmt.Errorf("custom_domains: duplicate host %q", domain.Host)
+		}
+		seen[domain.Host] = struct{}{}
+
+		parsed, err := url.Parse(domain.PublicURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != schemeHTTPS) || parsed.Host == "" {
+			return fmt.Errorf("custom_domains: invalid public_url %q for host %q", domain.PublicURL, domain.Host)
+		}
+		if domain.CookieDomain != "" && !isCookieDomainOf(domain.CookieDomain, domain.Host) {
+			return fmt.Errorf("custom_domains: cookie_domain %q does not cover host %q",
+				domain.CookieDomain, domain.Host)
+		}
+		if (domain.CertFile == "") != (domain.KeyFile == "") {
+			return fmt.Errorf("custom_domains: cert_file and key_file must be set together for host %q",
+				domain.Host)
+		}
+	}
+	return nil
+}
+
+// validateCustomDomainIsolation checks that every isolated custom domain has an issuer of its own, and that
+// the signing key of a custom domain is a configured key that is neither the server's preferred key nor the
+// signing key of another custom domain.
+func validateCustomDomainIsolation(cfg *Config) error {
+	keyIDs := make(map[string]struct{}, len(cfg.Crypto.Keys))
+	for _, key := range cfg.Crypto.Keys {
+		keyIDs[key.ID] = struct{}{}
+	}
+	issuers := map[string]int{cfg.JWT.Issuer: 1}
+	for _, domain := range cfg.CustomDomains {
+		issuers[domain.Issuer]++
+	}
+
+	signingKeys := make(map[string]struct{}, len(cfg.CustomDomains))
+	for _, domain := range cfg.CustomDomains {
+		if !domain.IsIsolated() {
+			continue
+		}
+		if issuers[domain.Issuer] > 1 {
+			return fmt.Errorf("custom_domains: isolated host %q must have an issuer of its own", domain.Host)
+		}
+		if domain.SigningKeyID == "" {
+			continue
+		}
+		if _, exists := keyIDs[domain.SigningKeyID]; !exists {
+			return fmt.Errorf("custom_domains: signing_key_id %q of host %q is not a configured key",
+				domain.SigningKeyID, domain.Host)
+		}
+		if domain.SigningKeyID == cfg.JWT.PreferredKeyID {
+			return fmt.Errorf("custom_domains: signing_key_id %q of host %q is the server signing key",
+				domain.SigningKeyID, domain.Host)
+		}
+		if _, exists := signingKeys[domain.SigningKeyID]; exists {
+			return fmt.Errorf("custom_domains: signing_key_id %q is shared by more than one host",
+				domain.SigningKeyID)
+		}
+		signingKeys[domain.SigningKeyID] = struct{}{}
+	}
+	return nil
+}
+
+// isCookieDomainOf reports whether a cookie scoped to cookieDomain is sent to host.
+func isCookieDomainOf(cookieDomain, host string) bool {
+	cookieDomain = strings.ToLower(strings.TrimPrefix(cookieDomain, "."))
+	return host == cookieDomain || strings.HasSuffix(host, "."+cookieDomain)
+}
+
+// IsIsolated reports whether the domain is isolated from the server and the other custom domains. The
+// tokens issued on an isolated domain are accepted only on that domain.
+func (d *CustomDomainConfig) IsIsolated() bool {
+	return d.OUID != "" || d.SigningKeyID != ""
+}
+
+// GetCustomDomain returns the custom domain configured for the given request host, or nil when the
+// host is not a custom domain. The host may carry a port, which is ignored.
+func (c *Config) GetCustomDomain(host string) *CustomDomainConfig {
+	if len(c.CustomDomains) == 0 || host == "" {
+		return nil
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+	for i := range c.CustomDomains {
+		if c.CustomDomains[i].Host == host {
+			return &c.CustomDomains[i]
+		}
+	}
+	return nil
+}
+
+// ResolveCustomDomain returns the custom domain the request carried by ctx was received on, or nil
+// when the request was received on the server's own host.
+func ResolveCustomDomain(ctx context.Context) *CustomDomainConfig {
+	host := sysContext.GetCustomDomain(ctx)
+	if host == "" {
+		return nil
+	}
+	return GetServerRuntime().Config.GetCustomDomain(host)
+}
+
+// GetIssuer returns the token issuer for the request carried by ctx. Requests received on a custom
+// domain use the issuer of that domain; all other requests use the configured JWT issuer.
+func GetIssuer(ctx context.Context) string {
+	if domain := ResolveCustomDomain(ctx); domain != nil {
+		return domain.Issuer
+	}
+	return GetServerRuntime().Config.JWT.Issuer
+}
+
+// GetPublicURL returns the public base URL of the server for the request carried by ctx.
+func GetPublicURL(ctx context.Context) string {
+	if domain := ResolveCustomDomain(ctx); domain != nil {
+		return domain.PublicURL
+	}
+	return GetServerURL(&GetServerRuntime().Config.Server)
+}
+
+// GetGateClientURL returns the URL of the gate page at the given path for the request carried by ctx.
+// Requests received on a custom domain are sent to the gate pages served on the same domain.
+func GetGateClientURL(ctx context.Context, path string) string {
+	if domain := ResolveCustomDomain(ctx); domain != nil {
+		return domain.PublicURL + path
+	}
+	gateClient := GetServerRuntime().Config.GateClient
+	return (&url.URL{
+		Scheme: gateClient.Scheme,
+		Host:   fmt.Sprintf("%s:%d", gateClient.Hostname, gateClient.Port),
+		Path:   path,
+	}).String()
+}
+
+// IsServerIssuer reports whether the given issuer is the configured JWT issuer or the issuer of a
+// configured custom domain.
+func IsServerIssuer(issuer string) bool {
+	cfg := GetServerRuntime().Config
+	if issuer == cfg.JWT.Issuer {
+		return true
+	}
+	for _, domain := range cfg.CustomDomains {
+		if issuer == domain.Issuer {
+			return true
+		}
+	}
+	return false
+}
+
+// GetIssuerSigningKeyID returns the ID of the key that signs the tokens carrying the given issuer, or an
+// empty string when the tokens are signed with the server's signing key.
+func GetIssuerSigningKeyID(issuer string) string {
+	for _, domain := range GetServerRuntime().Config.CustomDomains {
+		if domain.SigningKeyID != "" && domain.Issuer == issuer {
+			return domain.SigningKeyID
+		}
+	}
+	return ""
+}
+
+// IsSigningKeyAllowed reports whether a token carrying the given issuer may be signed with the key with
+// the given ID. The signing key of a custom domain signs only the tokens of that domain, and the tokens of
+// a custom domain with a signing key are signed only with that key.
+func IsSigningKeyAllowed(keyID, issuer string) bool {
+	if expected := GetIssuerSigningKeyID(issuer); expected != "" {
+		return keyID == expected
+	}
+	for _, domain := range GetServerRuntime().Config.CustomDomains {
+		if domain.SigningKeyID != "" && domain.SigningKeyID == keyID {
+			return false
+		}
+	}
+	return true
+}
+
+// IsIssuerAccepted reports whether a token carrying the given issuer is accepted on the request carried
+// by ctx. The tokens issued on an isolated custom domain are accepted only on that domain, and an
+// isolated custom domain accepts only its own tokens.
+func IsIssuerAccepted(ctx context.Context, issuer string) bool {
+	if issuer == GetIssuer(ctx) {
+		return true
+	}
+	if domain := ResolveCustomDomain(ctx); domain != nil && domain.IsIsolated() {
+		return false
+	}
+	for _, domain := range GetServerRuntime().Config.CustomDomains {
+		if domain.IsIsolated() && domain.Issuer == issuer {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+type CustomDomainTestSuite struct {
+	suite.Suite
+}
+
+func TestCustomDomainSuite(t *testing.T) {
+	suite.Run(t, new(CustomDomainTestSuite))
+}
+
+func (suite *CustomDomainTestSuite) SetupTest() {
+	runtimeConfig = nil
+	once = sync.Once{}
+	cfg := &Config{
+		Server:     ServerConfig{Hostname: "localhost", Port: 8090},
+		GateClient: GateClientConfig{Hostname: "localhost", Port: 8090, Scheme: "https", Path: "/gate"},
+		JWT:        JWTConfig{Issuer: "https://localhost:8090"},
+		CustomDomains: []CustomDomainConfig{
+			{Host: "Login.Example.com", CookieDomain: "example.com"},
+			{Host: "auth.acme.io", PublicURL: "https://auth.acme.io:8443/", Issuer: "https://acme.io"},
+			{Host: "id.tenant.io", OUID: "tenant-ou", SigningKeyID: "tenant-key"},
+		},
+	}
+	applyCustomDomainDefaults(cfg)
+	suite.Require().NoError(InitializeServerRuntime("/test/thunderid/home", cfg))
+}
+
+func (suite *CustomDomainTestSuite) TearDownTest() {
+	runtimeConfig = nil
+	once = sync.Once{}
+}
+
+func (suite *CustomDomainTestSuite) TestApplyCustomDomainDefaults() {
+	domains := GetServerRuntime().Config.CustomDomains
+
+	suite.Equal("login.example.com", domains[0].Host)
+	suite.Equal("https://login.example.com", domains[0].PublicURL)
+	suite.Equal("https://login.example.com", domains[0].Issuer)
+	suite.Equal("https://auth.acme.io:8443", domains[1].PublicURL)
+	suite.Equal("https://acme.io", domains[1].Issuer)
+}
+
+func (suite *CustomDomainTestSuite) TestApplyCustomDomainDefaults_HTTPOnly() {
+	cfg := &Config{
+		Server:        ServerConfig{HTTPOnly: true},
+		CustomDomains: []CustomDomainConfig{{Host: "login.example.com"}},
+	}
+
+	applyCustomDomainDefaults(cfg)
+
+	suite.Equal("http://login.example.com", cfg.CustomDomains[0].PublicURL)
+	suite.Equal("http://login.example.com", cfg.CustomDomains[0].Issuer)
+}
+
+func (suite *CustomDomainTestSuite) TestValidateCustomDomains() {
+	valid := CustomDomainConfig{Host: "login.example.com", PublicURL: "https://login.example.com"}
+
+	testCases := []struct {
+		name      string
+		domains   []CustomDomainConfig
+		expectErr string
+	}{
+		{name: "Empty", domains: nil},
+		{name: "Valid", domains: []CustomDomainConfig{valid}},
+		{name: "EmptyHost", domains: []CustomDomainConfig{{PublicURL: "https://login.example.com"}},
+			expectErr: "host must be a host name"},
+		{name: "HostWithPort", domains: []CustomDomainConfig{
+			{Host: "login.example.com:8443", PublicURL: "https://login.example.com"}},
+			expectErr: "host must be a host name"},
+		{name: "DuplicateHost", domains: []CustomDomainConfig{valid, valid}, expectErr: "duplicate host"},
+		{name: "InvalidPublicURL", domains: []CustomDomainConfig{
+			{Host: "login.example.com", PublicURL: "ftp://login.example.com"}},
+			expectErr: "invalid public_url"},
+		{name: "CookieDomainNotCoveringHost", domains: []CustomDomainConfig{
+			{Host: "login.example.com", PublicURL: "https://login.example.com", CookieDomain: "acme.io"}},
+			expectErr: "does not cover host"},
+		{name: "CookieDomainWithLeadingDot", domains: []CustomDomainConfig{
+			{Host: "login.example.com", PublicURL: "https://login.example.com", CookieDomain: ".example.com"}}},
+		{name: "CertWithoutKey", domains: []CustomDomainConfig{
+			{Host: "login.example.com", PublicURL: "https://login.example.com", CertFile: "login.cert"}},
+			expectErr: "cert_file and key_file must be set together"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateCustomDomains(tc.domains)
+			if tc.expectErr == "" {
+				suite.NoError(err)
+				return
+			}
+			suite.ErrorContains(err, tc.expectErr)
+		})
+	}
+}
+
+func (suite *CustomDomainTestSuite) TestValidateCustomDomainIsolation() {
+	isolated := CustomDomainConfig{Host: "id.tenant.io", Issuer: "https://id.tenant.io", SigningKeyID: "tenant-key"}
+	newConfig := func(domains ...CustomDomainConfig) *Config {
+		return &Config{
+			JWT:           JWTConfig{Issuer: "https://localhost:8090", PreferredKeyID: "default-key"},
+			Crypto:        CryptoConfig{Keys: []KeyConfig{{ID: "default-key"}, {ID: "tenant-key"}}},
+			CustomDomains: domains,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		cfg       *Config
+		expectErr string
+	}{
+		{name: "NoDomains", cfg: newConfig()},
+		{name: "Isolated", cfg: newConfig(isolated)},
+		{name: "NotIsolatedSharedIssuer", cfg: newConfig(
+			CustomDomainConfig{Host: "login.example.com", Issuer: "https://localhost:8090"})},
+		{name: "OUOnly", cfg: newConfig(
+			CustomDomainConfig{Host: "id.tenant.io", Issuer: "https://id.tenant.io", OUID: "tenant-ou"})},
+		{name: "IsolatedSharedIssuer", cfg: newConfig(
+			CustomDomainConfig{Host: "id.tenant.io", Issuer: "https://localhost:8090", OUID: "tenant-ou"}),
+			expectErr: "must have an issuer of its own"},
+		{name: "UnknownSigningKey", cfg: newConfig(
+			CustomDomainConfig{Host: "id.tenant.io", Issuer: "https://id.tenant.io", SigningKeyID: "missing"}),
+			expectErr: "is not a configured key"},
+		{name: "ServerSigningKey", cfg: newConfig(
+			CustomDomainConfig{Host: "id.tenant.io", Issuer: "https://id.tenant.io", SigningKeyID: "default-key"}),
+			expectErr: "is the server signing key"},
+		{name: "SharedSigningKey", cfg: newConfig(isolated,
+			CustomDomainConfig{Host: "id.other.io", Issuer: "https://id.other.io", SigningKeyID: "tenant-key"}),
+			expectErr: "is shared by more than one host"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateCustomDomainIsolation(tc.cfg)
+			if tc.expectErr == "" {
+				suite.NoError(err)
+				return
+			}
+			suite.ErrorContains(err, tc.expectErr)
+		})
+	}
+}
+
+func (suite *CustomDomainTestSuite) TestGetCustomDomain() {
+	cfg := &GetServerRuntime().Config
+
+	suite.Equal("login.example.com", cfg.GetCustomDomain("LOGIN.example.com").Host)
+	suite.Equal("auth.acme.io", cfg.GetCustomDomain("auth.acme.io:8443").Host)
+	suite.Nil(cfg.GetCustomDomain("localhost:8090"))
+	suite.Nil(cfg.GetCustomDomain(""))
+}
+
+func (suite *CustomDomainTestSuite) TestResolveForServerHost() {
+	ctx := context.Background()
+
+	suite.Nil(ResolveCustomDomain(ctx))
+	suite.Equal("https://localhost:8090", GetIssuer(ctx))
+	suite.Equal("https://localhost:8090", GetPublicURL(ctx))
+	suite.Equal("https://localhost:8090/gate/signin", GetGateClientURL(ctx, "/gate/signin"))
+}
+
+func (suite *CustomDomainTestSuite) TestResolveForCustomDomain() {
+	ctx := sysContext.WithCustomDomain(context.Background(), "auth.acme.io")
+
+	suite.Equal("auth.acme.io", ResolveCustomDomain(ctx).Host)
+	suite.Equal("https://acme.io", GetIssuer(ctx))
+	suite.Equal("https://auth.acme.io:8443", GetPublicURL(ctx))
+	suite.Equal("https://auth.acme.io:8443/gate/signin", GetGateClientURL(ctx, "/gate/signin"))
+}
+
+func (suite *CustomDomainTestSuite) TestIsServerIssuer() {
+	suite.True(IsServerIssuer("https://localhost:8090"))
+	suite.True(IsServerIssuer("https://login.example.com"))
+	suite.True(IsServerIssuer("https://acme.io"))
+	suite.False(IsServerIssuer("https://evil.example.com"))
+}
+
+func (suite *CustomDomainTestSuite) TestGetIssuerSigningKeyID() {
+	suite.Equal("tenant-key", GetIssuerSigningKeyID("https://id.tenant.io"))
+	suite.Empty(GetIssuerSigningKeyID("https://acme.io"))
+	suite.Empty(GetIssuerSigningKeyID("https://localhost:8090"))
+}
+
+func (suite *CustomDomainTestSuite) TestIsSigningKeyAllowed() {
+	suite.True(IsSigningKeyAllowed("tenant-key", "https://id.tenant.io"))
+	suite.False(IsSigningKeyAllowed("default-key", "https://id.tenant.io"))
+	suite.False(IsSigningKeyAllowed("tenant-key", "https://localhost:8090"))
+	suite.False(IsSigningKeyAllowed("tenant-key", "https://acme.io"))
+	suite.True(IsSigningKeyAllowed("default-key", "https://localhost:8090"))
+	suite.True(IsSigningKeyAllowed("default-key", "https://acme.io"))
+}
+
+func (suite *CustomDomainTestSuite) TestIsIssuerAccepted() {
+	serverCtx := context.Background()
+	acmeCtx := sysContext.WithCustomDomain(context.Background(), "auth.acme.io")
+	tenantCtx := sysContext.WithCustomDomain(context.Background(), "id.tenant.io")
+
+	suite.True(IsIssuerAccepted(serverCtx, "https://localhost:8090"))
+	suite.True(IsIssuerAccepted(serverCtx, "https://acme.io"))
+	suite.False(IsIssuerAccepted(serverCtx, "https://id.tenant.io"))
+	suite.True(IsIssuerAccepted(acmeCtx, "https://localhost:8090"))
+	suite.False(IsIssuerAccepted(acmeCtx, "https://id.tenant.io"))
+	suite.True(IsIssuerAccepted(tenantCtx, "https://id.tenant.io"))
+	suite.False(IsIssuerAccepted(tenantCtx, "https://localhost:8090"))
+	suite.False(IsIssuerAccepted(tenantCtx, "https://acme.io"))
+}
@@ -53,6 +53,9 @@ const ContentTypeJWT = "application/jwt"
 // ContentTypeFormURLEncoded is the content type for form-urlencoded data.
 const ContentTypeFormURLEncoded = "application/x-www-form-urlencoded"
 
+// ContentTypeYAML is the content type for YAML data.
+const ContentTypeYAML = "application/yaml"
+
 // WWWAuthenticateHeaderName is the name of the WWW-Authenticate header used in HTTP responses.
 const WWWAuthenticateHeaderName = "WWW-Authenticate"
 
@@ -16,13 +16,14 @@
  * under the License.
  */
 
-// Package context provides utilities for managing trace IDs (correlation IDs)
+// Package context provides utilities for managing trace IDs (correlation IDs) and other request-scoped values
 package context
 
 import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"sync"
 )
 
 type contextKey string
@@ -30,6 +31,12 @@ type contextKey string
 const (
 	// TraceIDKey is the context key for storing the trace ID (correlation ID).
 	TraceIDKey contextKey = "trace_id"
+	// ClientIPKey is the context key for storing the IP address of the client that made the request.
+	ClientIPKey contextKey = "client_ip"
+	// CustomDomainKey is the context key for storing the custom domain host the request was received on.
+	CustomDomainKey contextKey = "custom_domain"
+	// RequestCacheKey is the context key for storing the cache of values resolved while serving a request.
+	RequestCacheKey contextKey = "request_cache"
 )
 
 // ============================================================================
@@ -95,3 +102,145 @@ func EnsureTraceID(ctx context.Context) context.Context {
 
 	return ctx
 }
+
+// ============================================================================
+// Client IP Functions
+// ============================================================================
+
+// WithClientIP adds the IP address of the client that made the request to the context.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ClientIPKey, clientIP)
+}
+
+// GetClientIP retrieves the IP address of the client that made the request from the context.
+// Returns an empty string if the context does not carry a client IP, such as for internal operations.
+func GetClientIP(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	if clientIP, ok := ctx.Value(ClientIPKey).(string); ok {
+		return clientIP
+	}
+
+	return ""
+}
+
+// ============================================================================
+// Custom Domain Functions
+// ============================================================================
+
+// WithCustomDomain adds the host of the custom domain the request was received on to the context.
+func WithCustomDomain(ctx context.Context, host string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, CustomDomainKey, host)
+}
+
+// GetCustomDomain retrieves the host of the custom domain the request was received on from the context.
+// Returns an empty string if the request was not received on a custom domain.
+func GetCustomDomain(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	if host, ok := ctx.Value(CustomDomainKey).(string); ok {
+		return host
+	}
+
+	return ""
+}
+
+// ============================================================================
+// Request Cache Functions
+// ============================================================================
+
+// requestCache holds values resolved while serving a single request, such as organization units and
+// authorization decisions, so that they are not resolved again by other components serving the same request.
+// It is safe for concurrent use since a request may be served by multiple goroutines.
+type requestCache struct {
+	mu     sync.RWMutex
+	values map[string]interface{}
+}
+
+// WithRequestCache adds an empty request cache to the context. The context is returned unchanged if it
+// already carries a request cache, so that the values resolved earlier in the request are kept.
+func WithRequestCache(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := ctx.Value(RequestCacheKey).(*requestCache); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, RequestCacheKey, &requestCache{values: make(map[string]interface{})})
+}
+
+// GetRequestCacheValue retrieves the value cached with the given key for the current request.
+// Returns false if the value is not cached or the context does not carry a request cache.
+func GetRequestCacheValue(ctx context.Context, key string) (interface{}, bool) {
+	cache := getRequestCache(ctx)
+	if cache == nil {
+		return nil, false
+	}
+
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	value, ok := cache.values[key]
+	return value, ok
+}
+
+// SetRequestCacheValue caches the given value with the given key for the current request.
+// Does nothing if the context does not carry a request cache.
+func SetRequestCacheValue(ctx context.Context, key string, value interface{}) {
+	cache := getRequestCache(ctx)
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.values[key] = value
+}
+
+// DeleteRequestCacheValue removes the value cached with the given key for the current request.
+// Use this when the cached value is modified while serving the request.
+func DeleteRequestCacheValue(ctx context.Context, key string) {
+	cache := getRequestCache(ctx)
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	delete(cache.values, key)
+}
+
+// ClearRequestCache removes all the values cached for the current request.
+// Use this when a change may affect values cached under different keys.
+func ClearRequestCache(ctx context.Context) {
+	cache := getRequestCache(ctx)
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.values = make(map[string]interface{})
+}
+
+// getRequestCache retrieves the request cache from the context. Returns nil if the context does not carry one.
+func getRequestCache(ctx context.Context) *requestCache {
+	if ctx == nil {
+		return nil
+	}
+
+	if cache, ok := ctx.Value(RequestCacheKey).(*requestCache); ok {
+		return cache
+	}
+
+	return nil
+}
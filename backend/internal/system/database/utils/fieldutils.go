@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// dbTimeFormat is the format of the time strings returned by database drivers that store time as text.
+const dbTimeFormat = "2006-01-02 15:04:05.999999999"
+
+// ParseTimeField parses a time field from a database result row. The field may be a time value or a string in
+// the database time format, optionally followed by a time zone, or in RFC 3339 format.
+func ParseTimeField(field interface{}, fieldName string) (time.Time, error) {
+	switch v := field.(type) {
+	case string:
+		parsedTime, err := time.Parse(dbTimeFormat, TrimTimeString(v))
+		if err != nil {
+			parsedTime, err = time.Parse(time.RFC3339, v)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("error parsing %s: %w", fieldName, err)
+			}
+		}
+		return parsedTime, nil
+	case time.Time:
+		return v, nil
+	case nil:
+		return time.Time{}, fmt.Errorf("%s is nil", fieldName)
+	default:
+		return time.Time{}, fmt.Errorf("unexpected type for %s: %T", fieldName, field)
+	}
+}
+
+// TrimTimeString trims the time zone information following the date and time of a time string.
+func TrimTimeString(timeStr string) string {
+	parts := strings.SplitN(timeStr, " ", 3)
+	if len(parts) >= 2 {
+		return parts[0] + " " + parts[1]
+	}
+	return timeStr
+}
+
+// ToNullableString returns nil for an empty string so that optional columns are stored as NULL.
+func ToNullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FieldUtilsTestSuite struct {
+	suite.Suite
+}
+
+func TestFieldUtilsTestSuite(t *testing.T) {
+	suite.Run(t, new(FieldUtilsTestSuite))
+}
+
+func (suite *FieldUtilsTestSuite) TestParseTimeField() {
+	now := time.Now().UTC()
+	testCases := []struct {
+		name     string
+		field    interface{}
+		expected time.Time
+	}{
+		{"DBTimeFormat", "2025-01-01 10:00:00.123456789", time.Date(2025, 1, 1, 10, 0, 0, 123456789, time.UTC)},
+		{"DBTimeFormatWithTimeZone", "2025-01-01 10:00:00 +0000 UTC", time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{"RFC3339", "2025-01-01T10:00:00Z", time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{"Time", now, now},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			parsed, err := ParseTimeField(tc.field, "created_at")
+
+			suite.NoError(err)
+			suite.True(tc.expected.Equal(parsed), parsed.String())
+		})
+	}
+}
+
+func (suite *FieldUtilsTestSuite) TestParseTimeField_Errors() {
+	testCases := []struct {
+		name     string
+		field    interface{}
+		errorMsg string
+	}{
+		{"InvalidString", "bad-time", "error parsing created_at"},
+		{"Nil", nil, "created_at is nil"},
+		{"UnsupportedType", 42, "unexpected type for created_at: int"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := ParseTimeField(tc.field, "created_at")
+
+			suite.Error(err)
+			suite.Contains(err.Error(), tc.errorMsg)
+		})
+	}
+}
+
+func (suite *FieldUtilsTestSuite) TestTrimTimeString() {
+	suite.Equal("2025-01-01 10:00:00.123456789", TrimTimeString("2025-01-01 10:00:00.123456789 +0000 UTC"))
+	suite.Equal("2025-01-01 10:00:00", TrimTimeString("2025-01-01 10:00:00"))
+	suite.Equal("2025-01-01T10:00:00Z", TrimTimeString("2025-01-01T10:00:00Z"))
+}
+
+func (suite *FieldUtilsTestSuite) TestToNullableString() {
+	suite.Nil(ToNullableString(""))
+	suite.Equal("value", ToNullableString("value"))
+}
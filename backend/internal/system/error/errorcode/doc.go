@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package errorcode provides the registry of the service error codes returned by the server APIs, so that
+// SDKs and UIs can map the codes to their categories, HTTP statuses and user facing messages.
+//
+// The registry is generated from the service errors declared in the sources and is embedded into the
+// binary. Regenerate it after adding or changing service errors:
+//
+//	go generate ./internal/system/error/errorcode
+package errorcode
+
+//go:generate go run ../../../../cmd/errorcodes -source ../../../.. -out error_codes.json
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package errorcode
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrorErrorCodeNotFound is the error returned when the requested error code is not registered.
+var ErrorErrorCodeNotFound = serviceerror.ServiceError{
+	Type: serviceerror.ClientErrorType,
+	Code: "ECR-1001",
+	Error: core.I18nMessage{
+		Key:          "error.errorcodeservice.error_code_not_found",
+		DefaultValue: "Error code not found",
+	},
+	ErrorDescription: core.I18nMessage{
+		Key:          "error.errorcodeservice.error_code_not_found_description",
+		DefaultValue: "The specified error code is not registered",
+	},
+}
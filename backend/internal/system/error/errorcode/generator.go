@@ -0,0 +1,451 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package errorcode
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+const serviceErrorPackagePath = "/internal/system/error/serviceerror"
+
+// codeRegex matches the registered error codes. Protocol error codes defined by specifications, such as the
+// OAuth 2.0 error codes, do not follow this format and are left out of the registry.
+var codeRegex = regexp.MustCompile(`^[A-Z0-9]+-[0-9]+$`)
+
+// httpStatuses maps the names of the net/http status constants used by the handlers to their values.
+var httpStatuses = map[string]int{
+	"StatusBadRequest":            http.StatusBadRequest,
+	"StatusUnauthorized":          http.StatusUnauthorized,
+	"StatusForbidden":             http.StatusForbidden,
+	"StatusNotFound":              http.StatusNotFound,
+	"StatusMethodNotAllowed":      http.StatusMethodNotAllowed,
+	"StatusConflict":              http.StatusConflict,
+	"StatusGone":                  http.StatusGone,
+	"StatusPreconditionFailed":    http.StatusPreconditionFailed,
+	"StatusRequestEntityTooLarge": http.StatusRequestEntityTooLarge,
+	"StatusUnsupportedMediaType":  http.StatusUnsupportedMediaType,
+	"StatusUnprocessableEntity":   http.StatusUnprocessableEntity,
+	"StatusTooManyRequests":       http.StatusTooManyRequests,
+	"StatusInternalServerError":   http.StatusInternalServerError,
+	"StatusNotImplemented":        http.StatusNotImplemented,
+	"StatusBadGateway":            http.StatusBadGateway,
+	"StatusServiceUnavailable":    http.StatusServiceUnavailable,
+	"StatusGatewayTimeout":        http.StatusGatewayTimeout,
+}
+
+// errorScanner collects the service errors defined in the Go sources and the HTTP statuses the handlers
+// respond with for them.
+type errorScanner struct {
+	modulePath string
+	sourceRoot string
+	// errors maps a package directory to its service errors by variable name.
+	errors map[string]map[string]*ErrorCode
+	// order holds the variable names of the service errors of a package directory in declaration order.
+	order map[string][]string
+	files map[string][]*ast.File
+}
+
+// Generate builds the error code registry from the service errors declared as package level variables in
+// the Go sources under the internal directory of sourceRoot. The HTTP status of an error is taken from the
+// handler branch that checks for it, and otherwise defaults to 400 for client errors and 500 for server
+// errors.
+func Generate(sourceRoot string) ([]byte, error) {
+	modulePath, err := readModulePath(sourceRoot)
+	if err != nil {
+		return nil, err
+	}
+	s := &errorScanner{
+		modulePath: modulePath,
+		sourceRoot: sourceRoot,
+		errors:     make(map[string]map[string]*ErrorCode),
+		order:      make(map[string][]string),
+		files:      make(map[string][]*ast.File),
+	}
+	if err := s.parse(filepath.Join(sourceRoot, "internal")); err != nil {
+		return nil, err
+	}
+
+	dirs := make([]string, 0, len(s.files))
+	for dir := range s.files {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		for _, file := range s.files[dir] {
+			s.recordStatuses(dir, file)
+		}
+	}
+
+	// Some packages declare variants of an error that share its code, e.g. the user and agent specific
+	// variants of the entity type errors. The first declaration describes the code in that case, and the
+	// HTTP status is taken from the first variant a handler maps.
+	codes := make(map[string]*ErrorCode)
+	for _, dir := range dirs {
+		for _, name := range s.order[dir] {
+			entry := s.errors[dir][name]
+			if existing, ok := codes[entry.Code]; ok {
+				if existing.HTTPStatus == 0 {
+					existing.HTTPStatus = entry.HTTPStatus
+				}
+				continue
+			}
+			copied := *entry
+			codes[entry.Code] = &copied
+		}
+	}
+
+	registry := make([]ErrorCode, 0, len(codes))
+	for _, code := range codes {
+		if code.HTTPStatus == 0 {
+			code.HTTPStatus = defaultStatus(code.Type)
+		}
+		registry = append(registry, *code)
+	}
+	sort.Slice(registry, func(i, j int) bool { return registry[i].Code < registry[j].Code })
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(registry); err != nil {
+		return nil, fmt.Errorf("failed to encode the error code registry: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// defaultStatus returns the HTTP status of an error that no handler maps explicitly.
+func defaultStatus(errType serviceerror.ServiceErrorType) int {
+	if errType == serviceerror.ClientErrorType {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// readModulePath reads the module path from the go.mod file of the source root.
+func readModulePath(sourceRoot string) (string, error) {
+	content, err := os.ReadFile(filepath.Clean(filepath.Join(sourceRoot, "go.mod")))
+	if err != nil {
+		return "", fmt.Errorf("failed to read go.mod: %w", err)
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if modulePath, found := strings.CutPrefix(strings.TrimSpace(line), "module "); found {
+			return strings.TrimSpace(modulePath), nil
+		}
+	}
+	return "", fmt.Errorf("module path not found in go.mod")
+}
+
+// parse parses the non test Go files under the directory and records the service errors they declare.
+func (s *errorScanner) parse(root string) error {
+	fset := token.NewFileSet()
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == "testdata" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		dir := filepath.Dir(path)
+		s.files[dir] = append(s.files[dir], file)
+		s.recordErrors(dir, file)
+		return nil
+	})
+}
+
+// recordErrors records the service errors declared as package level variables of a file.
+func (s *errorScanner) recordErrors(dir string, file *ast.File) {
+	category, err := filepath.Rel(filepath.Join(s.sourceRoot, "internal"), dir)
+	if err != nil {
+		return
+	}
+	inServiceErrorPackage := strings.HasSuffix(filepath.ToSlash(dir), serviceErrorPackagePath)
+	serviceErrorPackage := ""
+	for name, importPath := range fileImports(file) {
+		if importPath == s.modulePath+serviceErrorPackagePath {
+			serviceErrorPackage = name
+		}
+	}
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec, ok := spec.(*ast.ValueSpec)
+			if !ok || len(valueSpec.Values) != len(valueSpec.Names) {
+				continue
+			}
+			for i, name := range valueSpec.Names {
+				lit, ok := valueSpec.Values[i].(*ast.CompositeLit)
+				if !ok || !isServiceErrorType(lit.Type, serviceErrorPackage, inServiceErrorPackage) {
+					continue
+				}
+				entry := parseServiceError(lit)
+				if entry == nil {
+					continue
+				}
+				entry.Category = filepath.ToSlash(category)
+				if s.errors[dir] == nil {
+					s.errors[dir] = make(map[string]*ErrorCode)
+				}
+				s.errors[dir][name.Name] = entry
+				s.order[dir] = append(s.order[dir], name.Name)
+			}
+		}
+	}
+}
+
+// isServiceErrorType reports whether a composite literal type is serviceerror.ServiceError.
+func isServiceErrorType(expr ast.Expr, serviceErrorPackage string, inServiceErrorPackage bool) bool {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return inServiceErrorPackage && t.Name == "ServiceError"
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		return ok && serviceErrorPackage != "" && pkg.Name == serviceErrorPackage && t.Sel.Name == "ServiceError"
+	}
+	return false
+}
+
+// parseServiceError reads the fields of a service error literal. It returns nil when the error does not
+// have a registered code format or its fields are not literals.
+func parseServiceError(lit *ast.CompositeLit) *ErrorCode {
+	entry := &ErrorCode{}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		switch key.Name {
+		case "Code":
+			entry.Code = stringLiteral(kv.Value)
+		case "Type":
+			if typeName(kv.Value) == "ServerErrorType" {
+				entry.Type = serviceerror.ServerErrorType
+			} else {
+				entry.Type = serviceerror.ClientErrorType
+			}
+		case "Error":
+			entry.Message = i18nMessageLiteral(kv.Value)
+		case "ErrorDescription":
+			entry.Description = i18nMessageLiteral(kv.Value)
+		}
+	}
+	if !codeRegex.MatchString(entry.Code) || entry.Type == "" {
+		return nil
+	}
+	return entry
+}
+
+// typeName returns the name of an identifier, qualified or not.
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	}
+	return ""
+}
+
+// i18nMessageLiteral reads a core.I18nMessage literal.
+func i18nMessageLiteral(expr ast.Expr) core.I18nMessage {
+	msg := core.I18nMessage{}
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return msg
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		switch key.Name {
+		case "Key":
+			msg.Key = stringLiteral(kv.Value)
+		case "DefaultValue":
+			msg.DefaultValue = stringLiteral(kv.Value)
+		}
+	}
+	return msg
+}
+
+// stringLiteral returns the value of a string literal or a concatenation of string literals, or an empty
+// string for other expressions.
+func stringLiteral(expr ast.Expr) string {
+	if bin, ok := expr.(*ast.BinaryExpr); ok && bin.Op == token.ADD {
+		return stringLiteral(bin.X) + stringLiteral(bin.Y)
+	}
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// recordStatuses records the HTTP statuses of the errors checked by the if statements and switch cases of
+// a file. A branch maps the errors referenced by its condition to the first HTTP status its body uses, and a
+// call such as WriteErrorResponse maps the errors passed to it to the HTTP status passed along with them.
+func (s *errorScanner) recordStatuses(dir string, file *ast.File) {
+	imports := fileImports(file)
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.IfStmt:
+			s.mapStatus(dir, imports, []ast.Expr{n.Cond}, n.Body.List)
+		case *ast.CaseClause:
+			s.mapStatus(dir, imports, n.List, n.Body)
+		case *ast.CallExpr:
+			s.mapStatus(dir, imports, n.Args, []ast.Stmt{&ast.ExprStmt{X: n}})
+		}
+		return true
+	})
+}
+
+// mapStatus assigns the HTTP status used by a branch body to the errors referenced by its condition, unless
+// another branch already mapped them.
+func (s *errorScanner) mapStatus(dir string, imports map[string]string, conditions []ast.Expr, body []ast.Stmt) {
+	status := findStatus(body)
+	if status == 0 {
+		return
+	}
+	for _, cond := range conditions {
+		ast.Inspect(cond, func(node ast.Node) bool {
+			if entry := s.resolveError(dir, imports, node); entry != nil {
+				if entry.HTTPStatus == 0 {
+					entry.HTTPStatus = status
+				}
+				return false
+			}
+			return true
+		})
+	}
+}
+
+// resolveError returns the service error a node refers to, either directly or through its Code field.
+func (s *errorScanner) resolveError(dir string, imports map[string]string, node ast.Node) *ErrorCode {
+	switch n := node.(type) {
+	case *ast.Ident:
+		return s.errors[dir][n.Name]
+	case *ast.SelectorExpr:
+		if n.Sel.Name == "Code" {
+			return s.resolveError(dir, imports, n.X)
+		}
+		pkg, ok := n.X.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		importPath, ok := imports[pkg.Name]
+		if !ok {
+			return nil
+		}
+		relPath, ok := strings.CutPrefix(importPath, s.modulePath+"/")
+		if !ok {
+			return nil
+		}
+		return s.errors[filepath.Join(s.sourceRoot, filepath.FromSlash(relPath))][n.Sel.Name]
+	}
+	return nil
+}
+
+// findStatus returns the first net/http status constant used by the statements of a branch body, without
+// descending into nested blocks.
+func findStatus(body []ast.Stmt) int {
+	for _, stmt := range body {
+		var exprs []ast.Expr
+		switch st := stmt.(type) {
+		case *ast.AssignStmt:
+			exprs = st.Rhs
+		case *ast.ReturnStmt:
+			exprs = st.Results
+		case *ast.ExprStmt:
+			if call, ok := st.X.(*ast.CallExpr); ok {
+				exprs = call.Args
+			}
+		}
+		for _, expr := range exprs {
+			sel, ok := expr.(*ast.SelectorExpr)
+			if !ok {
+				continue
+			}
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "http" {
+				if status, ok := httpStatuses[sel.Sel.Name]; ok {
+					return status
+				}
+			}
+		}
+	}
+	return 0
+}
+
+// fileImports maps the names under which the packages are imported in a file to their import paths.
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string, len(file.Imports))
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = importPath
+	}
+	return imports
+}
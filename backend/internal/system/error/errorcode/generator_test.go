@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package errorcode
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+const (
+	fixtureGoMod = "module example.com/server\n\ngo 1.26\n"
+
+	fixtureServiceError = `package serviceerror
+
+type ServiceError struct {
+	Type  ServiceErrorType
+	Code  string
+	Error core.I18nMessage
+}
+
+var InternalServerError = ServiceError{
+	Type: ServerErrorType,
+	Code: "SSE-5000",
+	Error: core.I18nMessage{Key: "error.internal_server_error", DefaultValue: "Internal server error"},
+}
+`
+
+	fixtureErrors = `package things
+
+import (
+	"example.com/server/internal/system/error/serviceerror"
+)
+
+var (
+	ErrorThingNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "THG-1001",
+		Error: core.I18nMessage{Key: "error.things.not_found", DefaultValue: "Thing not found"},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.things.not_found_description",
+			DefaultValue: "The thing " +
+				"does not exist",
+		},
+	}
+	ErrorThingConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "THG-1002",
+		Error: core.I18nMessage{Key: "error.things.conflict", DefaultValue: "Thing conflict"},
+	}
+	ErrorSmallThingConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "THG-1002",
+		Error: core.I18nMessage{Key: "error.things.small_conflict", DefaultValue: "Small thing conflict"},
+	}
+	ErrorInvalidThing = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "THG-1003",
+		Error: core.I18nMessage{Key: "error.things.invalid", DefaultValue: "Invalid thing"},
+	}
+	ErrorThingStore = serviceerror.ServiceError{
+		Type: serviceerror.ServerErrorType,
+		Code: "THG-5001",
+		Error: core.I18nMessage{Key: "error.things.store", DefaultValue: "Thing store failure"},
+	}
+	ErrorInvalidGrant = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_grant",
+	}
+)
+`
+
+	fixtureHandler = `package things
+
+import (
+	"net/http"
+
+	"example.com/server/internal/system/error/serviceerror"
+)
+
+func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusBadRequest
+	switch svcErr.Code {
+	case ErrorThingNotFound.Code:
+		statusCode = http.StatusNotFound
+	case serviceerror.InternalServerError.Code:
+		statusCode = http.StatusServiceUnavailable
+	}
+	if svcErr.Code == ErrorSmallThingConflict.Code {
+		writeError(w, http.StatusConflict, svcErr)
+	}
+	writeError(w, statusCode, svcErr)
+}
+`
+
+	fixtureOtherHandler = `package other
+
+import (
+	"net/http"
+
+	thingpkg "example.com/server/internal/things"
+)
+
+func statusOf(code string) int {
+	if code == thingpkg.ErrorInvalidThing.Code {
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusOK
+}
+`
+)
+
+type GeneratorTestSuite struct {
+	suite.Suite
+	sourceRoot string
+}
+
+func TestGeneratorTestSuite(t *testing.T) {
+	suite.Run(t, new(GeneratorTestSuite))
+}
+
+func (suite *GeneratorTestSuite) SetupTest() {
+	suite.sourceRoot = suite.T().TempDir()
+
+	suite.writeFile("go.mod", fixtureGoMod)
+	suite.writeFile(filepath.Join("internal", "system", "error", "serviceerror", "error.go"), fixtureServiceError)
+	suite.writeFile(filepath.Join("internal", "things", "error_constants.go"), fixtureErrors)
+	suite.writeFile(filepath.Join("internal", "things", "handler.go"), fixtureHandler)
+	suite.writeFile(filepath.Join("internal", "things", "handler_test.go"),
+		"package things\n\nvar errorTest = serviceerror.ServiceError{Code: \"TST-1001\"}\n")
+	suite.writeFile(filepath.Join("internal", "other", "handler.go"), fixtureOtherHandler)
+}
+
+func (suite *GeneratorTestSuite) writeFile(path, content string) {
+	path = filepath.Join(suite.sourceRoot, path)
+	suite.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o750))
+	suite.Require().NoError(os.WriteFile(path, []byte(content), 0o600))
+}
+
+func (suite *GeneratorTestSuite) generate() map[string]ErrorCode {
+	data, err := Generate(suite.sourceRoot)
+	suite.Require().NoError(err)
+	var errorCodes []ErrorCode
+	suite.Require().NoError(json.Unmarshal(data, &errorCodes))
+
+	byCode := make(map[string]ErrorCode, len(errorCodes))
+	for _, errorCode := range errorCodes {
+		byCode[errorCode.Code] = errorCode
+	}
+	suite.Len(byCode, len(errorCodes))
+	return byCode
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_Entries() {
+	errorCodes := suite.generate()
+
+	suite.Len(errorCodes, 5)
+	notFound := errorCodes["THG-1001"]
+	suite.Equal(serviceerror.ClientErrorType, notFound.Type)
+	suite.Equal("things", notFound.Category)
+	suite.Equal("error.things.not_found", notFound.Message.Key)
+	suite.Equal("Thing not found", notFound.Message.DefaultValue)
+	suite.Equal("error.things.not_found_description", notFound.Description.Key)
+	suite.Equal("The thing does not exist", notFound.Description.DefaultValue)
+
+	internal := errorCodes["SSE-5000"]
+	suite.Equal(serviceerror.ServerErrorType, internal.Type)
+	suite.Equal("system/error/serviceerror", internal.Category)
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_SkipsProtocolAndTestErrors() {
+	errorCodes := suite.generate()
+
+	suite.NotContains(errorCodes, "invalid_grant")
+	suite.NotContains(errorCodes, "TST-1001")
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_SharedCodeUsesFirstDeclaration() {
+	errorCodes := suite.generate()
+
+	suite.Equal("Thing conflict", errorCodes["THG-1002"].Message.DefaultValue)
+	suite.Equal(http.StatusConflict, errorCodes["THG-1002"].HTTPStatus)
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_HTTPStatuses() {
+	errorCodes := suite.generate()
+
+	suite.Equal(http.StatusNotFound, errorCodes["THG-1001"].HTTPStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errorCodes["THG-1003"].HTTPStatus)
+	suite.Equal(http.StatusInternalServerError, errorCodes["THG-5001"].HTTPStatus)
+	suite.Equal(http.StatusServiceUnavailable, errorCodes["SSE-5000"].HTTPStatus)
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_Deterministic() {
+	first, err := Generate(suite.sourceRoot)
+	suite.Require().NoError(err)
+	second, err := Generate(suite.sourceRoot)
+	suite.Require().NoError(err)
+
+	suite.Equal(first, second)
+}
+
+func (suite *GeneratorTestSuite) TestGenerate_Errors() {
+	_, err := Generate(suite.T().TempDir())
+	suite.ErrorContains(err, "go.mod")
+
+	suite.writeFile(filepath.Join("internal", "broken", "broken.go"), "package broken\n\nvar = \n")
+	_, err = Generate(suite.sourceRoot)
+	suite.ErrorContains(err, "broken.go")
+}
+
+// TestErrorCodeRegistryIsUpToDate fails when the embedded error code registry does not match the service
+// errors declared in the sources, so that new or changed errors are not released without updating it.
+func (suite *GeneratorTestSuite) TestErrorCodeRegistryIsUpToDate() {
+	registry, err := Generate(filepath.Join("..", "..", "..", ".."))
+	suite.Require().NoError(err)
+
+	suite.True(bytes.Equal(registry, errorCodeRegistry),
+		"the error code registry is out of date, run 'go generate ./internal/system/error/errorcode' in the backend")
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package errorcode
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	errorCodesPath        = "/error-codes"
+	queryParamCategory    = "category"
+	pathParamErrorCodeKey = "code"
+)
+
+// errorCodeHandler serves the error code registry.
+type errorCodeHandler struct {
+	errorCodes []ErrorCode
+	byCode     map[string]ErrorCode
+}
+
+// newErrorCodeHandler creates a handler serving the given error code registry.
+func newErrorCodeHandler(registry []byte) (*errorCodeHandler, error) {
+	var errorCodes []ErrorCode
+	if err := json.Unmarshal(registry, &errorCodes); err != nil {
+		return nil, fmt.Errorf("failed to parse the error code registry: %w", err)
+	}
+
+	byCode := make(map[string]ErrorCode, len(errorCodes))
+	for _, errorCode := range errorCodes {
+		byCode[errorCode.Code] = errorCode
+	}
+	return &errorCodeHandler{
+		errorCodes: errorCodes,
+		byCode:     byCode,
+	}, nil
+}
+
+// HandleErrorCodeListRequest lists the registered error codes, optionally filtered by category.
+func (h *errorCodeHandler) HandleErrorCodeListRequest(w http.ResponseWriter, r *http.Request) {
+	errorCodes := h.errorCodes
+	if category := r.URL.Query().Get(queryParamCategory); category != "" {
+		errorCodes = make([]ErrorCode, 0)
+		for _, errorCode := range h.errorCodes {
+			if errorCode.Category == category {
+				errorCodes = append(errorCodes, errorCode)
+			}
+		}
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, ErrorCodeListResponse{
+		TotalResults: len(errorCodes),
+		ErrorCodes:   errorCodes,
+	})
+}
+
+// HandleErrorCodeGetRequest returns a registered error code.
+func (h *errorCodeHandler) HandleErrorCodeGetRequest(w http.ResponseWriter, r *http.Request) {
+	errorCode, ok := h.byCode[r.PathValue(pathParamErrorCodeKey)]
+	if !ok {
+		sysutils.WriteErrorResponse(w, http.StatusNotFound, apierror.ErrorResponse{
+			Code:        ErrorErrorCodeNotFound.Code,
+			Message:     ErrorErrorCodeNotFound.Error,
+			Description: ErrorErrorCodeNotFound.ErrorDescription,
+		})
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, errorCode)
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package errorcode
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+const testRegistry = `[
+  {"code": "APP-1001", "type": "client_error", "category": "application", "httpStatus": 404,
+   "message": {"key": "error.applicationservice.application_not_found", "defaultValue": "Application not found"},
+   "description": {"key": "", "defaultValue": ""}},
+  {"code": "APP-1002", "type": "client_error", "category": "application", "httpStatus": 400,
+   "message": {"key": "error.applicationservice.invalid_application_id", "defaultValue": "Invalid application ID"},
+   "description": {"key": "", "defaultValue": ""}},
+  {"code": "SSE-5000", "type": "server_error", "category": "system/error/serviceerror", "httpStatus": 500,
+   "message": {"key": "error.internal_server_error", "defaultValue": "Internal server error"},
+   "description": {"key": "", "defaultValue": ""}}
+]`
+
+type HandlerTestSuite struct {
+	suite.Suite
+	handler *errorCodeHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	handler, err := newErrorCodeHandler([]byte(testRegistry))
+	suite.Require().NoError(err)
+	suite.handler = handler
+}
+
+func (suite *HandlerTestSuite) TestNewErrorCodeHandler_InvalidRegistry() {
+	_, err := newErrorCodeHandler([]byte("not json"))
+
+	suite.Error(err)
+}
+
+func (suite *HandlerTestSuite) TestNewErrorCodeHandler_EmbeddedRegistry() {
+	handler, err := newErrorCodeHandler(errorCodeRegistry)
+
+	suite.Require().NoError(err)
+	suite.Contains(handler.byCode, serviceerror.InternalServerError.Code)
+	suite.Equal(http.StatusNotFound, handler.byCode[ErrorErrorCodeNotFound.Code].HTTPStatus)
+}
+
+func (suite *HandlerTestSuite) TestHandleErrorCodeListRequest() {
+	req := httptest.NewRequest(http.MethodGet, errorCodesPath, nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErrorCodeListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response ErrorCodeListResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Equal(3, response.TotalResults)
+	suite.Len(response.ErrorCodes, 3)
+}
+
+func (suite *HandlerTestSuite) TestHandleErrorCodeListRequest_CategoryFilter() {
+	req := httptest.NewRequest(http.MethodGet, errorCodesPath+"?category=application", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErrorCodeListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var response ErrorCodeListResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &response))
+	suite.Equal(2, response.TotalResults)
+	for _, errorCode := range response.ErrorCodes {
+		suite.Equal("application", errorCode.Category)
+	}
+}
+
+func (suite *HandlerTestSuite) TestHandleErrorCodeListRequest_UnknownCategory() {
+	req := httptest.NewRequest(http.MethodGet, errorCodesPath+"?category=unknown", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErrorCodeListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.JSONEq(`{"totalResults":0,"errorCodes":[]}`, rr.Body.String())
+}
+
+func (suite *HandlerTestSuite) TestHandleErrorCodeGetRequest() {
+	req := httptest.NewRequest(http.MethodGet, errorCodesPath+"/APP-1001", nil)
+	req.SetPathValue(pathParamErrorCodeKey, "APP-1001")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErrorCodeGetRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var errorCode ErrorCode
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errorCode))
+	suite.Equal("APP-1001", errorCode.Code)
+	suite.Equal(http.StatusNotFound, errorCode.HTTPStatus)
+	suite.Equal("Application not found", errorCode.Message.DefaultValue)
+}
+
+func (suite *HandlerTestSuite) TestHandleErrorCodeGetRequest_NotFound() {
+	req := httptest.NewRequest(http.MethodGet, errorCodesPath+"/XYZ-0000", nil)
+	req.SetPathValue(pathParamErrorCodeKey, "XYZ-0000")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleErrorCodeGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorErrorCodeNotFound.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestOptionsRequest() {
+	mux := http.NewServeMux()
+	registerRoutes(mux, suite.handler)
+	rr := httptest.NewRecorder()
+
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodOptions, errorCodesPath, nil))
+
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package errorcode
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// errorCodeRegistry is the generated error code registry.
+//
+//go:embed error_codes.json
+var errorCodeRegistry []byte
+
+// Initialize registers the routes serving the error code registry.
+func Initialize(mux *http.ServeMux) error {
+	handler, err := newErrorCodeHandler(errorCodeRegistry)
+	if err != nil {
+		return err
+	}
+	registerRoutes(mux, handler)
+	return nil
+}
+
+// registerRoutes registers the routes of the error code registry.
+func registerRoutes(mux *http.ServeMux, handler *errorCodeHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+errorCodesPath, handler.HandleErrorCodeListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+errorCodesPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+errorCodesPath+"/{code}", handler.HandleErrorCodeGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+errorCodesPath+"/{code}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
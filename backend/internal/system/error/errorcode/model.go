@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package errorcode
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrorCode describes a service error code returned by the server APIs.
+type ErrorCode struct {
+	Code string `json:"code"`
+	// Type is client_error when the caller can correct the request, and server_error otherwise.
+	Type serviceerror.ServiceErrorType `json:"type"`
+	// Category is the component that returns the error, e.g. application or oauth/oauth2/token.
+	Category   string `json:"category"`
+	HTTPStatus int    `json:"httpStatus"`
+	// Message and Description carry the i18n keys and default values of the error message and description.
+	Message     core.I18nMessage `json:"message"`
+	Description core.I18nMessage `json:"description"`
+}
+
+// ErrorCodeListResponse is the response of the error code list endpoint.
+type ErrorCodeListResponse struct {
+	TotalResults int         `json:"totalResults"`
+	ErrorCodes   []ErrorCode `json:"errorCodes"`
+}
@@ -69,7 +69,7 @@ func (eh *exportHandler) HandleExportRequest(w http.ResponseWriter, r *http.Requ
 	}
 
 	jsonResponse := JSONExportResponse{
-		Resources:            buildCombinedResources(exportResponse.Files),
+		Resources:            BuildCombinedResources(exportResponse.Files),
 		EnvironmentVariables: "",
 	}
 	if exportResponse.EnvFile != nil {
@@ -79,7 +79,9 @@ func (eh *exportHandler) HandleExportRequest(w http.ResponseWriter, r *http.Requ
 	sysutils.WriteSuccessResponse(w, http.StatusOK, jsonResponse)
 }
 
-func buildCombinedResources(files []ExportFile) string {
+// BuildCombinedResources joins the exported files into a single multi-document YAML payload that the import
+// API accepts.
+func BuildCombinedResources(files []ExportFile) string {
 	var builder strings.Builder
 
 	for i, file := range files {
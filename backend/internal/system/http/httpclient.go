@@ -86,6 +86,23 @@ func NewHTTPClientWithTimeout(timeout time.Duration) HTTPClientInterface {
 	}
 }
 
+// NewHTTP2ClientWithTimeout creates a new HTTPClient with a custom timeout that negotiates HTTP/2 over TLS.
+// Use this for upstream services that only accept HTTP/2, such as the Apple Push Notification service.
+func NewHTTP2ClientWithTimeout(timeout time.Duration) HTTPClientInterface {
+	return &HTTPClient{
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				// #nosec G402 -- Min TLS version is TLS 1.2 or higher based on config
+				TLSClientConfig: &tls.Config{
+					MinVersion: GetTLSVersion(config.GetServerRuntime().Config),
+				},
+				ForceAttemptHTTP2: true,
+			},
+		},
+	}
+}
+
 // NewHTTPClientWithCheckRedirect creates an HTTPClient with a custom redirect policy.
 // Use this when redirect behavior must be controlled, e.g. to prevent HTTPS→HTTP downgrades.
 // Requires server runtime to be initialized before calling (reads TLS config at construction time).
@@ -71,6 +71,18 @@ func (suite *HTTPClientTestSuite) TestNewHTTPClientWithTimeout() {
 	assert.Equal(suite.T(), timeout, httpClient.client.Timeout)
 }
 
+func (suite *HTTPClientTestSuite) TestNewHTTP2ClientWithTimeout() {
+	timeout := 5 * time.Second
+	client := NewHTTP2ClientWithTimeout(timeout)
+	assert.NotNil(suite.T(), client)
+
+	httpClient := client.(*HTTPClient)
+	assert.Equal(suite.T(), timeout, httpClient.client.Timeout)
+	transport, ok := httpClient.client.Transport.(*http.Transport)
+	assert.True(suite.T(), ok)
+	assert.True(suite.T(), transport.ForceAttemptHTTP2)
+}
+
 func (suite *HTTPClientTestSuite) TestNewHTTPClientWithDefaultSettings() {
 	// Test default behavior when no client is provided
 	client := NewHTTPClient()
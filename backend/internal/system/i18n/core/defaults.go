@@ -25,6 +25,16 @@ package core
 // This map is populated by the i18n extraction script at build time.
 // Helper functions for accessing these messages are in helpers.go.
 var defaultMessages = map[string]string{
+	"design.asset.error.content_type_mismatch": "Content type mismatch",
+	"design.asset.error.content_type_mismatch_description": "The content of the uploaded asset does not match its declared content type",
+	"design.asset.error.invalid_request": "Invalid asset upload request",
+	"design.asset.error.invalid_request_description": "The request must be a multipart form carrying the asset in the 'file' field",
+	"design.asset.error.not_found": "Asset not found",
+	"design.asset.error.not_found_description": "The requested asset was not found",
+	"design.asset.error.too_large": "Asset too large",
+	"design.asset.error.too_large_description": "The uploaded asset exceeds the maximum allowed size",
+	"design.asset.error.unsupported_content_type": "Unsupported content type",
+	"design.asset.error.unsupported_content_type_description": "The content type of the uploaded asset is not allowed",
 	"design.resolve.error.app_no_design": "Application has no design configuration",
 	"design.resolve.error.app_no_design_description": "The specified application does not have an associated theme or layout configuration",
 	"design.resolve.error.app_not_found": "Application not found",
@@ -33,8 +43,10 @@ var defaultMessages = map[string]string{
 	"design.resolve.error.invalid_type_description": "The 'type' query parameter is required and must be either 'APP' or 'OU'",
 	"design.resolve.error.missing_id": "Invalid request format",
 	"design.resolve.error.missing_id_description": "The 'id' query parameter is required",
-	"design.resolve.error.unsupported_type": "Unsupported resolve type",
-	"design.resolve.error.unsupported_type_description": "The specified resolve type is not yet supported. Currently only 'APP' type is supported",
+	"design.resolve.error.ou_no_design": "Organization unit has no design configuration",
+	"design.resolve.error.ou_no_design_description": "The specified organization unit does not have an associated theme or layout configuration",
+	"design.resolve.error.ou_not_found": "Organization unit not found",
+	"design.resolve.error.ou_not_found_description": "The organization unit with the specified id does not exist",
 	"error.agentservice.agent_already_exists_with_client_id": "Client ID already in use",
 	"error.agentservice.agent_already_exists_with_client_id_description": "An entity with the same client ID already exists",
 	"error.agentservice.agent_already_exists_with_name": "Agent already exists",
@@ -64,6 +76,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.idtoken_unsupported_encryption_alg_description": "ID token encryption algorithm is not supported",
 	"error.agentservice.idtoken_unsupported_encryption_enc_description": "ID token content-encryption algorithm is not supported",
 	"error.agentservice.idtoken_unsupported_response_type_description": "ID token responseType is not supported",
+	"error.agentservice.invalid_acr_flow_description": "ACR flow mappings must reference an allowed ACR value and a flow ID",
 	"error.agentservice.invalid_agent_name": "Invalid agent name",
 	"error.agentservice.invalid_agent_name_description": "The agent name must be provided and non-empty",
 	"error.agentservice.invalid_agent_type": "Invalid agent type",
@@ -92,6 +105,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_public_client_configuration_description": "The public client configuration is invalid",
 	"error.agentservice.invalid_redirect_uri": "Invalid redirect URI",
 	"error.agentservice.invalid_redirect_uri_description": "One or more redirect URIs are not valid",
+	"error.agentservice.invalid_redirect_uri_policy_description": "Allowed custom schemes must be valid URI schemes in reverse domain name form",
 	"error.agentservice.invalid_registration_flow_id": "Invalid registration flow ID",
 	"error.agentservice.invalid_registration_flow_id_description": "The provided registration flow ID is invalid",
 	"error.agentservice.invalid_request_format": "Invalid request format",
@@ -100,6 +114,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_response_type_description": "One or more provided response types are invalid",
 	"error.agentservice.invalid_token_endpoint_auth_method": "Invalid token endpoint authentication method",
 	"error.agentservice.invalid_token_endpoint_auth_method_description": "The provided token endpoint authentication method is not supported",
+	"error.agentservice.invalid_token_validity_period_description": "Token validity periods must not be negative",
 	"error.agentservice.invalid_user_attribute": "Invalid user attribute",
 	"error.agentservice.invalid_user_attribute_description": "One or more user attributes are not valid for the configured allowed user types",
 	"error.agentservice.invalid_user_type": "Invalid user type",
@@ -122,10 +137,14 @@ var defaultMessages = map[string]string{
 	"error.agentservice.public_client_must_have_pkce_description": "Public clients must have PKCE required set to true",
 	"error.agentservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
 	"error.agentservice.redirect_uri_fragment_not_allowed_description": "Redirect URIs must not contain a fragment component",
+	"error.agentservice.redirect_uri_scheme_not_allowed_description": "Redirect URIs must use http, https, or a custom scheme allowed by the redirect URI policy",
+	"error.agentservice.redirect_uri_wildcard_not_allowed_description": "Wildcard redirect URIs are not allowed by the redirect URI policy",
 	"error.agentservice.refresh_token_cannot_be_sole_grant_description": "refresh_token grant type cannot be used without another grant type",
+	"error.agentservice.refresh_token_requires_issuing_grant_description": "refresh_token grant type requires the authorization_code or CIBA grant type",
 	"error.agentservice.response_types_require_authorization_code_description": "Response types can only be configured with the authorization_code grant type",
 	"error.agentservice.schema_validation_failed": "Schema validation failed",
 	"error.agentservice.schema_validation_failed_description": "The provided attributes failed schema validation",
+	"error.agentservice.sliding_refresh_token_requires_max_lifetime_description": "Sliding refresh token expiration requires a maximum lifetime",
 	"error.agentservice.theme_not_found": "Theme not found",
 	"error.agentservice.theme_not_found_description": "The specified theme does not exist",
 	"error.agentservice.userinfo_alg_requires_response_type_description": "userinfo responseType is required when signingAlg or encryptionAlg is set",
@@ -150,6 +169,8 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.application_with_client_id_already_exists_description": "An application with the same client ID already exists",
 	"error.applicationservice.auth_code_requires_code_response_type_description": "authorization_code grant type requires 'code' response type",
 	"error.applicationservice.auth_code_requires_redirect_uris_description": "authorization_code grant type requires redirect URIs",
+	"error.applicationservice.cannot_delete_last_client_secret": "Cannot delete the last client secret",
+	"error.applicationservice.cannot_delete_last_client_secret_description": "Create a new client secret before deleting the last active client secret of the application",
 	"error.applicationservice.cannot_modify_declarative_resource": "Cannot modify declarative resource",
 	"error.applicationservice.cannot_modify_declarative_resource_description": "The application is declarative and cannot be modified or deleted",
 	"error.applicationservice.certificate_operation_failed": "Certificate operation failed",
@@ -157,6 +178,10 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.client_credentials_cannot_use_none_auth_description": "client_credentials grant type cannot use 'none' authentication method",
 	"error.applicationservice.client_credentials_cannot_use_response_types_description": "client_credentials grant type cannot be used with response types",
 	"error.applicationservice.client_secret_cannot_have_certificate_description": "client_secret authentication methods cannot have a certificate",
+	"error.applicationservice.client_secret_not_found": "Client secret not found",
+	"error.applicationservice.client_secret_not_found_description": "The requested client secret could not be found",
+	"error.applicationservice.client_secrets_not_supported": "Client secrets not supported",
+	"error.applicationservice.client_secrets_not_supported_description": "The application does not authenticate with a client secret",
 	"error.applicationservice.consent_service_not_enabled": "Consent service not enabled",
 	"error.applicationservice.consent_service_not_enabled_description": "Cannot enable consent for the application as the consent service is not enabled",
 	"error.applicationservice.consent_synchronization_failed": "Consent synchronization failed",
@@ -171,6 +196,7 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.idtoken_unsupported_encryption_alg_description": "ID token encryption algorithm is not supported",
 	"error.applicationservice.idtoken_unsupported_encryption_enc_description": "ID token content-encryption algorithm is not supported",
 	"error.applicationservice.idtoken_unsupported_response_type_description": "ID token responseType is not supported",
+	"error.applicationservice.invalid_acr_flow_description": "ACR flow mappings must reference an allowed ACR value and a flow ID",
 	"error.applicationservice.invalid_acr_values": "Invalid ACR value",
 	"error.applicationservice.invalid_acr_values_description": "One or more ACR values in acr_values are not recognized by the system",
 	"error.applicationservice.invalid_application_id": "Invalid application ID",
@@ -181,12 +207,19 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_application_url_description": "The provided application URL is not a valid URI",
 	"error.applicationservice.invalid_auth_flow_id": "Invalid auth flow ID",
 	"error.applicationservice.invalid_auth_flow_id_description": "The provided authentication flow ID is invalid",
+	"error.applicationservice.invalid_backchannel_logout_uri_description": "The back-channel logout URI must be an absolute https URI without a fragment",
+	"error.applicationservice.invalid_backchannel_token_delivery_mode_description": "Backchannel token delivery mode must be either 'poll' or 'ping'",
 	"error.applicationservice.invalid_certificate_type": "Invalid certificate type",
 	"error.applicationservice.invalid_certificate_type_description": "The provided certificate type is not supported",
 	"error.applicationservice.invalid_certificate_value": "Invalid certificate value",
 	"error.applicationservice.invalid_certificate_value_description": "The provided certificate value is invalid",
+	"error.applicationservice.invalid_claim_mapping_description": "Token claim mappings must map each attribute to a distinct, non-reserved claim name",
 	"error.applicationservice.invalid_client_id": "Invalid client ID",
 	"error.applicationservice.invalid_client_id_description": "The provided client ID is invalid or empty",
+	"error.applicationservice.invalid_client_notification_endpoint_description": "The client notification endpoint must be an absolute https URL",
+	"error.applicationservice.invalid_client_secret_expiry": "Invalid client secret expiry",
+	"error.applicationservice.invalid_client_secret_expiry_description": "The expiry time of a client secret must be in the future",
+	"error.applicationservice.invalid_frontchannel_logout_uri_description": "The front-channel logout URI must be an absolute https URI without a fragment",
 	"error.applicationservice.invalid_grant_type": "Invalid grant type",
 	"error.applicationservice.invalid_grant_type_description": "One or more provided grant types are invalid",
 	"error.applicationservice.invalid_inbound_auth_config": "Invalid inbound auth config",
@@ -195,16 +228,20 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_jwks_uri_description": "The provided JWKS URI is not a valid URI",
 	"error.applicationservice.invalid_jwks_uri_scheme": "Invalid JWKS URI scheme",
 	"error.applicationservice.invalid_jwks_uri_scheme_description": "'jwks_uri' must use HTTPS scheme",
+	"error.applicationservice.invalid_login_experience": "Invalid login experience configuration",
+	"error.applicationservice.invalid_login_experience_description": "Identity provider IDs must not be empty, post-login redirect URIs must be absolute HTTP(S) URLs, and the remember me lifetime must not be negative",
 	"error.applicationservice.invalid_logo_url": "Invalid logo URL",
 	"error.applicationservice.invalid_logo_url_description": "The provided logo URL is not a valid URI",
 	"error.applicationservice.invalid_oauth_configuration": "Invalid OAuth configuration",
 	"error.applicationservice.invalid_oauth_configuration_description": "The OAuth configuration is invalid",
+	"error.applicationservice.invalid_post_logout_redirect_uri_description": "Post-logout redirect URIs must be absolute URIs without a fragment or a wildcard",
 	"error.applicationservice.invalid_public_client_configuration": "Invalid public client configuration",
 	"error.applicationservice.invalid_public_client_configuration_description": "The public client configuration is invalid",
 	"error.applicationservice.invalid_recovery_flow_id": "Invalid recovery flow ID",
 	"error.applicationservice.invalid_recovery_flow_id_description": "The provided recovery flow ID is invalid",
 	"error.applicationservice.invalid_redirect_uri": "Invalid redirect URI",
 	"error.applicationservice.invalid_redirect_uri_description": "One or more provided redirect URIs are not valid URIs",
+	"error.applicationservice.invalid_redirect_uri_policy_description": "Allowed custom schemes must be valid URI schemes in reverse domain name form",
 	"error.applicationservice.invalid_registration_flow_id": "Invalid registration flow ID",
 	"error.applicationservice.invalid_registration_flow_id_description": "The provided registration flow ID is invalid",
 	"error.applicationservice.invalid_request_format": "Invalid request format",
@@ -213,6 +250,7 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_response_type_description": "One or more provided response types are invalid",
 	"error.applicationservice.invalid_token_endpoint_auth_method": "Invalid token endpoint authentication method",
 	"error.applicationservice.invalid_token_endpoint_auth_method_description": "The provided token endpoint authentication method is invalid",
+	"error.applicationservice.invalid_token_validity_period_description": "Token validity periods must not be negative",
 	"error.applicationservice.invalid_user_attribute": "Invalid user attribute",
 	"error.applicationservice.invalid_user_attribute_description": "One or more user attributes are not valid for the configured allowed user types",
 	"error.applicationservice.invalid_user_type": "Invalid user type",
@@ -223,15 +261,20 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.multiple_oauth_configs_description": "An application may have at most one inbound auth config per protocol",
 	"error.applicationservice.none_auth_method_cannot_have_cert_or_secret_description": "'none' authentication method cannot have a certificate or client secret",
 	"error.applicationservice.none_auth_method_requires_public_client_description": "'none' authentication method requires the client to be a public client",
+	"error.applicationservice.ping_mode_requires_notification_endpoint_description": "The 'ping' backchannel token delivery mode requires a client notification endpoint",
 	"error.applicationservice.pkce_requires_authorization_code_description": "PKCE can only be enabled when the authorization_code grant type is selected",
 	"error.applicationservice.private_key_jwt_cannot_have_client_secret_description": "private_key_jwt authentication method cannot have a client secret",
 	"error.applicationservice.private_key_jwt_requires_certificate_description": "private_key_jwt authentication method requires a certificate",
 	"error.applicationservice.public_client_must_have_pkce_description": "Public clients must have PKCE required set to true",
 	"error.applicationservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
 	"error.applicationservice.redirect_uri_fragment_not_allowed_description": "Redirect URIs must not contain a fragment component",
+	"error.applicationservice.redirect_uri_scheme_not_allowed_description": "Redirect URIs must use http, https, or a custom scheme allowed by the redirect URI policy",
+	"error.applicationservice.redirect_uri_wildcard_not_allowed_description": "Wildcard redirect URIs are not allowed by the redirect URI policy",
 	"error.applicationservice.refresh_token_cannot_be_sole_grant_description": "refresh_token grant type cannot be used without another grant type",
+	"error.applicationservice.refresh_token_requires_issuing_grant_description": "refresh_token grant type requires the authorization_code or CIBA grant type",
 	"error.applicationservice.response_types_require_authorization_code_description": "Response types can only be configured with the authorization_code grant type",
 	"error.applicationservice.result_limit_exceeded": "Result limit exceeded",
+	"error.applicationservice.sliding_refresh_token_requires_max_lifetime_description": "Sliding refresh token expiration requires a maximum lifetime",
 	"error.applicationservice.theme_not_found": "Theme not found",
 	"error.applicationservice.theme_not_found_description": "The specified theme configuration does not exist",
 	"error.applicationservice.userinfo_alg_requires_response_type_description": "userinfo responseType is required when signingAlg or encryptionAlg is set",
@@ -336,6 +379,18 @@ var defaultMessages = map[string]string{
 	"error.authnservice.sub_claim_not_found_description": "The 'sub' claim is not found in the ID token claims",
 	"error.authnservice.user_not_found": "User not found",
 	"error.authnservice.user_not_found_description": "No user found with the provided attributes",
+	"error.authntotpservice.incorrect_totp_code": "Incorrect TOTP code",
+	"error.authntotpservice.incorrect_totp_code_description": "The provided TOTP code is incorrect or has already been used",
+	"error.authntotpservice.invalid_account_name": "Invalid account name",
+	"error.authntotpservice.invalid_account_name_description": "The account name to show in the authenticator app is invalid or empty",
+	"error.authntotpservice.invalid_enrollment_token": "Invalid enrollment token",
+	"error.authntotpservice.invalid_enrollment_token_description": "The provided TOTP enrollment token is invalid or empty",
+	"error.authntotpservice.invalid_totp_code": "Invalid TOTP code",
+	"error.authntotpservice.invalid_totp_code_description": "The provided TOTP code must be a 6 digit number",
+	"error.authntotpservice.invalid_user_id": "Invalid user ID",
+	"error.authntotpservice.invalid_user_id_description": "The provided user ID is invalid or empty",
+	"error.authntotpservice.totp_not_enrolled": "TOTP not enrolled",
+	"error.authntotpservice.totp_not_enrolled_description": "The user has not registered a TOTP authenticator",
 	"error.authoauthservice.empty_access_token": "Empty access token",
 	"error.authoauthservice.empty_access_token_description": "The access token cannot be empty",
 	"error.authoauthservice.empty_authorization_code": "Empty authorization code",
@@ -356,6 +411,16 @@ var defaultMessages = map[string]string{
 	"error.authoidcservice.invalid_id_token_description": "The ID token is invalid or malformed",
 	"error.authoidcservice.invalid_id_token_signature": "Invalid ID token signature",
 	"error.authoidcservice.invalid_id_token_signature_description": "The ID token signature verification failed",
+	"error.backchannellogoutservice.invalid_limit": "Invalid limit parameter",
+	"error.backchannellogoutservice.invalid_limit_description": "The limit parameter must be a positive integer",
+	"error.backupservice.incomplete_backup": "Incomplete backup",
+	"error.backupservice.incomplete_backup_description": "Some resources could not be exported, so no snapshot was created",
+	"error.backupservice.invalid_restore_request": "Invalid restore request",
+	"error.backupservice.invalid_restore_request_description": "The restore request must contain a snapshot",
+	"error.backupservice.snapshot_checksum_mismatch": "Snapshot checksum mismatch",
+	"error.backupservice.snapshot_checksum_mismatch_description": "The snapshot content was modified or truncated after it was created",
+	"error.backupservice.unsupported_snapshot_version": "Unsupported snapshot version",
+	"error.backupservice.unsupported_snapshot_version_description": "The snapshot was created with a format version this server does not support",
 	"error.certservice.certificate_already_exists": "Certificate already exists",
 	"error.certservice.certificate_already_exists_description": "A certificate with the same reference type and ID already exists",
 	"error.certservice.certificate_not_found": "Certificate not found",
@@ -418,18 +483,34 @@ var defaultMessages = map[string]string{
 	"error.consentservice.purpose_already_exists_description": "A consent purpose with the same name already exists for this resource",
 	"error.consentservice.purpose_not_found": "Consent purpose not found",
 	"error.consentservice.purpose_not_found_description": "The consent purpose with the specified ID does not exist",
+	"error.consentservice.unauthenticated_user": "Unauthenticated user",
+	"error.consentservice.unauthenticated_user_description": "The request must be made by an authenticated user",
 	"error.consentservice.unauthorized": "Unauthorized to access consent service",
 	"error.consentservice.unauthorized_description": "The consent service returned an unauthorized response",
+	"error.dcr.client_id_mismatch": "Client ID mismatch",
+	"error.dcr.client_id_mismatch_description": "The client_id in the request must match the client being updated",
+	"error.dcr.invalid_application_type": "Invalid application type",
+	"error.dcr.invalid_application_type_description": "The application_type must be 'web' or 'native'",
 	"error.dcr.invalid_client_metadata": "Invalid client metadata",
 	"error.dcr.invalid_client_metadata_description": "One or more client metadata values are invalid",
+	"error.dcr.invalid_native_redirect_uri": "Invalid redirect URI",
+	"error.dcr.invalid_native_redirect_uri_description": "Native clients must use custom scheme or http loopback redirect URIs",
 	"error.dcr.invalid_redirect_uri": "Invalid redirect URI",
 	"error.dcr.invalid_redirect_uri_description": "One or more redirect URIs are invalid",
+	"error.dcr.invalid_registration_token": "Invalid registration access token",
+	"error.dcr.invalid_registration_token_description": "The registration access token is missing, invalid, or not issued for the client",
 	"error.dcr.invalid_request_format": "Invalid request format",
 	"error.dcr.invalid_request_format_description": "The request body is missing or has an invalid format",
+	"error.dcr.invalid_software_statement": "Invalid software statement",
+	"error.dcr.invalid_software_statement_description": "The software statement is missing or is not a valid, unexpired statement",
+	"error.dcr.invalid_token_lifetime": "Invalid token lifetime",
+	"error.dcr.invalid_token_lifetime_description": "Token lifetimes must be positive and must not exceed the maximum allowed for the server",
 	"error.dcr.jwks_configuration_conflict": "JWKS configuration conflict",
 	"error.dcr.jwks_configuration_conflict_description": "Cannot specify both 'jwks' and 'jwks_uri' parameters",
 	"error.dcr.server_error": "Server error",
 	"error.dcr.server_error_description": "An unexpected error occurred while processing the request",
+	"error.dcr.unapproved_software_statement": "Unapproved software statement",
+	"error.dcr.unapproved_software_statement_description": "The software statement is not issued by a trusted issuer",
 	"error.dcr.unauthorized": "Unauthorized",
 	"error.dcr.unauthorized_description": "Authentication with sufficient permissions is required to register a client",
 	"error.declarative_resource.create_operation_not_allowed": "Declarative resource create operation is not allowed",
@@ -484,14 +565,38 @@ var defaultMessages = map[string]string{
 	"error.entitytypeservice.user_type_not_found_description": "The user type with the specified id does not exist",
 	"error.entitytypeservice.user_validation_failed": "User validation failed",
 	"error.entitytypeservice.user_validation_failed_description": "User attributes do not conform to the required schema",
+	"error.erasureservice.already_requested": "Erasure already requested",
+	"error.erasureservice.already_requested_description": "An erasure request for the user is already pending or in progress",
+	"error.erasureservice.invalid_limit": "Invalid pagination parameter",
+	"error.erasureservice.invalid_limit_description": "The limit parameter must be a positive integer not greater than the maximum page size",
+	"error.erasureservice.invalid_offset": "Invalid pagination parameter",
+	"error.erasureservice.invalid_offset_description": "The offset parameter must be a non-negative integer",
+	"error.erasureservice.invalid_request_format": "Invalid request format",
+	"error.erasureservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.erasureservice.missing_user_id": "Invalid request format",
+	"error.erasureservice.missing_user_id_description": "The ID of the user to erase must be provided",
+	"error.erasureservice.reason_too_long": "Invalid request format",
+	"error.erasureservice.reason_too_long_description": "The reason must not exceed 1024 characters",
+	"error.erasureservice.request_not_found": "Erasure request not found",
+	"error.erasureservice.request_not_found_description": "The erasure request with the given ID does not exist",
+	"error.erasureservice.user_not_found": "User not found",
+	"error.erasureservice.user_not_found_description": "The user with the given ID does not exist",
+	"error.errorcodeservice.error_code_not_found": "Error code not found",
+	"error.errorcodeservice.error_code_not_found_description": "The specified error code is not registered",
 	"error.exportservice.invalid_request": "Invalid export request",
 	"error.exportservice.invalid_request_description": "The provided export request is invalid or malformed",
 	"error.exportservice.nil_request_description": "Export request cannot be nil",
 	"error.exportservice.no_resources_found": "No resources found",
 	"error.exportservice.no_resources_found_description": "No valid resources found for the provided identifiers",
 	"error.exportservice.no_valid_resources_for_export_description": "No valid resources found for export",
+	"error.flowanalyticsservice.flow_not_found": "Flow not found",
+	"error.flowanalyticsservice.flow_not_found_description": "The flow with the specified ID does not exist",
+	"error.flowanalyticsservice.invalid_period": "Invalid analytics period",
+	"error.flowanalyticsservice.invalid_period_description": "The from and to parameters must be RFC 3339 timestamps and from must be before to",
 	"error.flowexecservice.application_retrieval_error": "Application retrieval error",
 	"error.flowexecservice.application_retrieval_error_description": "Error while retrieving application details",
+	"error.flowexecservice.authentication_required": "Authentication required",
+	"error.flowexecservice.authentication_required_description": "The flow can only be started with the access token of an authenticated user",
 	"error.flowexecservice.invalid_app_id": "Invalid request",
 	"error.flowexecservice.invalid_app_id_description": "Invalid app ID provided in the request",
 	"error.flowexecservice.invalid_challenge_token": "Invalid challenge token",
@@ -506,10 +611,16 @@ var defaultMessages = map[string]string{
 	"error.flowexecservice.invalid_node_response_description": "Error response received from the node",
 	"error.flowexecservice.invalid_request_payload": "Invalid request payload",
 	"error.flowexecservice.invalid_request_payload_description": "Failed to decode request payload",
+	"error.flowexecservice.invalid_sandbox_mock_user": "Invalid mock user",
+	"error.flowexecservice.invalid_sandbox_mock_user_description": "A mock user is missing its type or organization unit, or its attributes are invalid",
 	"error.flowexecservice.recovery_not_allowed": "Recovery not allowed",
 	"error.flowexecservice.recovery_not_allowed_description": "Recovery flow is disabled for the application",
 	"error.flowexecservice.registration_not_allowed": "Registration not allowed",
 	"error.flowexecservice.registration_not_allowed_description": "Registration flow is disabled for the application",
+	"error.flowexecservice.sandbox_flow_not_specified": "Flow not specified",
+	"error.flowexecservice.sandbox_flow_not_specified_description": "Either a flow ID or an inline flow definition must be provided",
+	"error.flowexecservice.sandbox_session_limit_reached": "Sandbox session limit reached",
+	"error.flowexecservice.sandbox_session_limit_reached_description": "The maximum number of concurrent sandbox executions has been reached",
 	"error.flowmetaservice.application_fetch_failed_description": "Failed to retrieve application information",
 	"error.flowmetaservice.application_not_found_description": "The specified application does not exist",
 	"error.flowmetaservice.internal_server_error": "Internal server error",
@@ -531,6 +642,10 @@ var defaultMessages = map[string]string{
 	"error.flowmgtservice.duplicate_flow_id": "Duplicate flow ID",
 	"error.flowmgtservice.duplicate_flow_id_description": "Flow ID already exists",
 	"error.flowmgtservice.flow_definition_nil_or_empty_description": "Flow definition is nil or has no nodes",
+	"error.flowmgtservice.flow_draft_not_found": "Flow draft not found",
+	"error.flowmgtservice.flow_draft_not_found_description": "The flow does not have an unpublished draft",
+	"error.flowmgtservice.flow_draft_outdated": "Flow draft is outdated",
+	"error.flowmgtservice.flow_draft_outdated_description": "The flow was updated after the draft was created",
 	"error.flowmgtservice.flow_is_immutable": "Flow is immutable",
 	"error.flowmgtservice.flow_is_immutable_description": "Declarative flows cannot be modified or deleted",
 	"error.flowmgtservice.flow_not_found": "Flow not found",
@@ -543,6 +658,11 @@ var defaultMessages = map[string]string{
 	"error.flowmgtservice.graph_build_failure_description": "Failed to build executable graph from flow definition",
 	"error.flowmgtservice.handle_update_not_allowed": "Invalid update request",
 	"error.flowmgtservice.handle_update_not_allowed_description": "The flow handle cannot be modified after creation",
+	"error.flowmgtservice.invalid_draft_flow_description": "The draft flow definition is not valid",
+	"error.flowmgtservice.invalid_export_format": "Invalid export format",
+	"error.flowmgtservice.invalid_export_format_description": "The export format must be either json or yaml",
+	"error.flowmgtservice.invalid_flow_bundle": "Invalid flow bundle",
+	"error.flowmgtservice.invalid_flow_bundle_description": "The flow bundle must be a JSON or YAML document",
 	"error.flowmgtservice.invalid_flow_data": "Invalid flow data",
 	"error.flowmgtservice.invalid_flow_data_description": "The flow definition contains invalid data",
 	"error.flowmgtservice.invalid_flow_handle": "Invalid flow handle",
@@ -559,12 +679,15 @@ var defaultMessages = map[string]string{
 	"error.flowmgtservice.invalid_flow_type_description": "The specified flow type is invalid",
 	"error.flowmgtservice.invalid_flow_version": "Invalid flow version",
 	"error.flowmgtservice.invalid_flow_version_description": "The specified flow version is invalid",
+	"error.flowmgtservice.invalid_imported_flow_description": "The imported flow definition is not valid",
 	"error.flowmgtservice.invalid_limit_parameter": "Invalid pagination parameter",
 	"error.flowmgtservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
 	"error.flowmgtservice.invalid_offset_parameter": "Invalid pagination parameter",
 	"error.flowmgtservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
 	"error.flowmgtservice.invalid_request_format": "Invalid request format",
 	"error.flowmgtservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.flowmgtservice.unresolved_idp_reference": "Unresolved identity provider reference",
+	"error.flowmgtservice.unresolved_idp_reference_description": "An identity provider referred to by the flow does not exist in this environment",
 	"error.groupservice.cannot_delete_group": "Cannot delete group",
 	"error.groupservice.cannot_delete_group_description": "Cannot delete group with child groups",
 	"error.groupservice.empty_members_list": "Empty members list",
@@ -592,10 +715,18 @@ var defaultMessages = map[string]string{
 	"error.groupservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.groupservice.missing_group_id": "Invalid request format",
 	"error.groupservice.missing_group_id_description": "Group ID is required",
+	"error.i18nservice.bundle_mismatch": "Translation bundle mismatch",
+	"error.i18nservice.bundle_mismatch_description": "The language or namespace of the bundle does not match the request",
 	"error.i18nservice.empty_translations": "Empty translations",
 	"error.i18nservice.empty_translations_description": "At least one translation must be provided",
 	"error.i18nservice.export_error": "Failed to fetch translation languages for export",
 	"error.i18nservice.fetch_error": "Failed to fetch translations for export",
+	"error.i18nservice.invalid_bundle": "Invalid translation bundle",
+	"error.i18nservice.invalid_bundle_description": "The translation bundle is malformed and could not be parsed",
+	"error.i18nservice.invalid_bundle_format": "Invalid bundle format",
+	"error.i18nservice.invalid_bundle_format_description": "The bundle format must be one of json or xliff",
+	"error.i18nservice.invalid_import_mode": "Invalid import mode",
+	"error.i18nservice.invalid_import_mode_description": "The import mode must be one of apply or diff",
 	"error.i18nservice.invalid_key": "Invalid key format",
 	"error.i18nservice.invalid_key_description": "The key can only contain alphanumeric characters, dots, underscores, and hyphens",
 	"error.i18nservice.invalid_language": "Invalid language tag format",
@@ -604,12 +735,16 @@ var defaultMessages = map[string]string{
 	"error.i18nservice.invalid_namespace_description": "The namespace can only contain alphanumeric characters, underscores, and hyphens",
 	"error.i18nservice.invalid_request_description": "The request body is malformed or contains invalid data",
 	"error.i18nservice.invalid_request_format": "Invalid request format",
+	"error.i18nservice.invalid_translation_scope": "Invalid translation scope",
+	"error.i18nservice.invalid_translation_scope_description": "Specify a valid identifier for either the app or the ou, but not both",
 	"error.i18nservice.missing_language": "Missing language code",
 	"error.i18nservice.missing_language_description": "Language code is required",
 	"error.i18nservice.missing_value": "Missing translation value",
 	"error.i18nservice.missing_value_description": "Translation value is required",
 	"error.i18nservice.translation_not_found": "Translation not found",
 	"error.i18nservice.translation_not_found_description": "The requested translation does not exist for the specified language, namespace, and key",
+	"error.idpservice.health_check_disabled": "Health checks disabled",
+	"error.idpservice.health_check_disabled_description": "The health checks of the identity providers are not enabled",
 	"error.idpservice.idp_already_exists": "Identity provider already exists",
 	"error.idpservice.idp_already_exists_description": "An identity provider with the same name already exists",
 	"error.idpservice.idp_declarative_read_only": "Identity provider is immutable",
@@ -693,20 +828,56 @@ var defaultMessages = map[string]string{
 	"error.magiclinkservice.resolving_user_description": "An error occurred while resolving the user for the recipient",
 	"error.magiclinkservice.token_generation_failed": "Token generation failed",
 	"error.magiclinkservice.token_generation_failed_description": "Failed to generate magic link token",
+	"error.mcpauditservice.invalid_limit": "Invalid limit parameter",
+	"error.mcpauditservice.invalid_limit_description": "The limit parameter must be a positive integer",
+	"error.mcpauditservice.invalid_offset": "Invalid offset parameter",
+	"error.mcpauditservice.invalid_offset_description": "The offset parameter must be a non-negative integer",
+	"error.mcpauditservice.invalid_tool_call_status": "Invalid tool call status",
+	"error.mcpauditservice.invalid_tool_call_status_description": "The tool call status must be one of SUCCESS, FAILED or DENIED",
+	"error.notificationservice.dead_letter_not_found": "Dead-letter notification not found",
+	"error.notificationservice.dead_letter_not_found_description": "The requested dead-letter notification could not be found",
+	"error.notificationservice.duplicate_rate_limit": "Duplicate rate limit",
+	"error.notificationservice.duplicate_rate_limit_description": "A notification rate limit already exists for the channel and organization unit",
+	"error.notificationservice.duplicate_sender_for_ou": "Duplicate sender for organization unit",
+	"error.notificationservice.duplicate_sender_for_ou_description": "Another notification sender is already assigned to the organization unit",
 	"error.notificationservice.duplicate_sender_name": "Duplicate sender name",
 	"error.notificationservice.duplicate_sender_name_description": "A sender with the same name already exists",
+	"error.notificationservice.duplicate_trigger": "Duplicate trigger",
+	"error.notificationservice.duplicate_trigger_description": "A notification trigger already exists for the event, channel and organization unit",
 	"error.notificationservice.error_while_retrieving_message_client": "Error while retrieving message client",
 	"error.notificationservice.error_while_retrieving_message_client_description": "An error occurred while retrieving the message client",
 	"error.notificationservice.invalid_channel": "Invalid channel",
 	"error.notificationservice.invalid_channel_description": "The provided channel is invalid",
+	"error.notificationservice.invalid_dead_letter_id": "Invalid dead-letter ID",
+	"error.notificationservice.invalid_dead_letter_id_description": "The provided dead-letter notification ID is invalid or empty",
+	"error.notificationservice.invalid_delivery_status_callback": "Invalid delivery status callback",
+	"error.notificationservice.invalid_delivery_status_callback_description": "The delivery status callback could not be verified or is missing required data",
+	"error.notificationservice.invalid_event": "Invalid event",
+	"error.notificationservice.invalid_event_description": "The provided event is not a supported notification event",
+	"error.notificationservice.invalid_limit": "Invalid limit parameter",
+	"error.notificationservice.invalid_limit_description": "The limit parameter must be a positive integer",
 	"error.notificationservice.invalid_notification_provider": "Invalid notification provider",
 	"error.notificationservice.invalid_notification_provider_description": "The specified notification provider is invalid or unsupported",
+	"error.notificationservice.invalid_offset": "Invalid offset parameter",
+	"error.notificationservice.invalid_offset_description": "The offset parameter must be a non-negative integer",
 	"error.notificationservice.invalid_otp": "Invalid OTP",
 	"error.notificationservice.invalid_otp_description": "The provided OTP is invalid",
+	"error.notificationservice.invalid_otp_options": "Invalid OTP options",
+	"error.notificationservice.invalid_otp_options_description": "The OTP length must be between 4 and 10 and the validity period between 1 second and 1 hour",
+	"error.notificationservice.invalid_ou_id": "Invalid organization unit ID",
+	"error.notificationservice.invalid_ou_id_description": "The provided organization unit ID is invalid",
+	"error.notificationservice.invalid_push_device": "Invalid push device",
+	"error.notificationservice.invalid_push_device_description": "The push device must have a supported provider and a device token",
+	"error.notificationservice.invalid_rate_limit": "Invalid rate limit",
+	"error.notificationservice.invalid_rate_limit_description": "The limits must not be negative, at least one limit must be set and the window must be between 1 second and 1 day",
+	"error.notificationservice.invalid_rate_limit_id": "Invalid rate limit ID",
+	"error.notificationservice.invalid_rate_limit_id_description": "The provided rate limit ID is invalid or empty",
 	"error.notificationservice.invalid_recipient": "Invalid recipient",
 	"error.notificationservice.invalid_recipient_description": "The provided recipient is invalid",
 	"error.notificationservice.invalid_request_format": "Invalid request format",
 	"error.notificationservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.notificationservice.invalid_send_status": "Invalid send status",
+	"error.notificationservice.invalid_send_status_description": "The send status must be one of SUCCESS or FAILED",
 	"error.notificationservice.invalid_sender_id": "Invalid sender ID",
 	"error.notificationservice.invalid_sender_id_description": "The provided sender ID is invalid",
 	"error.notificationservice.invalid_sender_name": "Invalid sender name",
@@ -715,14 +886,56 @@ var defaultMessages = map[string]string{
 	"error.notificationservice.invalid_sender_type_description": "The provided sender type is invalid or unsupported",
 	"error.notificationservice.invalid_session_token": "Invalid session token",
 	"error.notificationservice.invalid_session_token_description": "The provided session token is invalid, malformed, or expired",
+	"error.notificationservice.invalid_template_scenario": "Invalid template scenario",
+	"error.notificationservice.invalid_template_scenario_description": "The provided template scenario is not supported",
+	"error.notificationservice.invalid_trigger_id": "Invalid trigger ID",
+	"error.notificationservice.invalid_trigger_id_description": "The provided notification trigger ID is invalid or empty",
+	"error.notificationservice.no_push_devices": "No push devices",
+	"error.notificationservice.no_push_devices_description": "The user has not registered any devices to receive push notifications",
+	"error.notificationservice.push_device_not_found": "Push device not found",
+	"error.notificationservice.push_device_not_found_description": "The push device with the specified id does not exist",
+	"error.notificationservice.push_sender_not_configured": "Push sender not configured",
+	"error.notificationservice.push_sender_not_configured_description": "No notification sender is configured for the push provider of the registered devices",
+	"error.notificationservice.rate_limit_exceeded": "Rate limit exceeded",
+	"error.notificationservice.rate_limit_exceeded_description": "Too many messages have been requested. Please try again later",
+	"error.notificationservice.rate_limit_not_found": "Rate limit not found",
+	"error.notificationservice.rate_limit_not_found_description": "The requested notification rate limit could not be found",
 	"error.notificationservice.sender_not_found": "Sender not found",
 	"error.notificationservice.sender_not_found_description": "The requested notification sender could not be found",
 	"error.notificationservice.sender_type_mismatch": "Sender type mismatch",
 	"error.notificationservice.sender_type_mismatch_description": "The requested sender is not of the expected type",
+	"error.notificationservice.trigger_not_found": "Trigger not found",
+	"error.notificationservice.trigger_not_found_description": "The requested notification trigger could not be found",
+	"error.notificationservice.unauthenticated_user": "Unauthenticated user",
+	"error.notificationservice.unauthenticated_user_description": "The request must be made by an authenticated user",
 	"error.notificationservice.unsupported_channel": "Unsupported channel",
 	"error.notificationservice.unsupported_channel_description": "The provided channel is not supported",
 	"error.notificationservice.update_not_allowed": "Update not allowed",
 	"error.notificationservice.update_not_allowed_description": "Updating the sender type is not allowed",
+	"error.organizationservice.domain_conflict": "Email domain conflict",
+	"error.organizationservice.domain_conflict_description": "An email domain is already owned by another organization",
+	"error.organizationservice.handle_conflict": "Organization handle conflict",
+	"error.organizationservice.handle_conflict_description": "An organization with the same handle already exists",
+	"error.organizationservice.identity_provider_not_found": "Identity provider not found",
+	"error.organizationservice.identity_provider_not_found_description": "An identity provider of the organization does not exist",
+	"error.organizationservice.invalid_domain": "Invalid email domain",
+	"error.organizationservice.invalid_domain_description": "The email domains must be valid domain names",
+	"error.organizationservice.invalid_handle": "Invalid organization handle",
+	"error.organizationservice.invalid_handle_description": "The handle must consist of lowercase letters, digits and hyphens, and must not exceed 100 characters",
+	"error.organizationservice.invalid_limit": "Invalid pagination parameter",
+	"error.organizationservice.invalid_limit_description": "The limit parameter must be a positive integer not greater than the maximum page size",
+	"error.organizationservice.invalid_offset": "Invalid pagination parameter",
+	"error.organizationservice.invalid_offset_description": "The offset parameter must be a non-negative integer",
+	"error.organizationservice.invalid_request_format": "Invalid request format",
+	"error.organizationservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.organizationservice.missing_name": "Invalid request format",
+	"error.organizationservice.missing_name_description": "The name of the organization must be provided",
+	"error.organizationservice.organization_not_found": "Organization not found",
+	"error.organizationservice.organization_not_found_description": "The organization with the given ID does not exist",
+	"error.organizationservice.organization_unit_conflict": "Organization unit conflict",
+	"error.organizationservice.organization_unit_conflict_description": "An organization is already built on the organization unit",
+	"error.organizationservice.organization_unit_not_found": "Organization unit not found",
+	"error.organizationservice.organization_unit_not_found_description": "The organization unit of the organization does not exist",
 	"error.ouservice.cannot_modify_declarative_resource": "Cannot modify declarative resource",
 	"error.ouservice.cannot_modify_declarative_resource_description": "The organization unit is declarative and cannot be modified or deleted",
 	"error.ouservice.circular_dependency_detected": "Circular dependency detected",
@@ -780,6 +993,12 @@ var defaultMessages = map[string]string{
 	"error.passkeyservice.session_expired_description": "The session has expired. Please start a new session",
 	"error.passkeyservice.user_not_found": "User not found",
 	"error.passkeyservice.user_not_found_description": "The specified user was not found",
+	"error.provisioningservice.invalid_limit": "Invalid pagination parameter",
+	"error.provisioningservice.invalid_limit_description": "The limit parameter must be a positive integer not greater than the maximum page size",
+	"error.provisioningservice.invalid_offset": "Invalid pagination parameter",
+	"error.provisioningservice.invalid_offset_description": "The offset parameter must be a non-negative integer",
+	"error.provisioningservice.operation_not_found": "Provisioning operation not found",
+	"error.provisioningservice.operation_not_found_description": "The provisioning operation with the given ID does not exist",
 	"error.resourceservice.action_not_found": "Action not found",
 	"error.resourceservice.action_not_found_description": "The action with the specified id does not exist",
 	"error.resourceservice.cannot_delete": "Cannot delete",
@@ -864,6 +1083,56 @@ var defaultMessages = map[string]string{
 	"error.roleservice.role_name_conflict_description": "A role with the same name exists under the same organization unit",
 	"error.roleservice.role_not_found": "Role not found",
 	"error.roleservice.role_not_found_description": "The role with the specified id does not exist",
+	"error.schedulerservice.job_already_running": "Job already running",
+	"error.schedulerservice.job_already_running_description": "The job is already running on this node",
+	"error.schedulerservice.job_not_found": "Job not found",
+	"error.schedulerservice.job_not_found_description": "No job with the given name is registered with the scheduler",
+	"error.scopeservice.invalid_claims": "Invalid claims",
+	"error.scopeservice.invalid_claims_description": "The claims bound to the scope must be non-empty claim names",
+	"error.scopeservice.invalid_request_format": "Invalid request format",
+	"error.scopeservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.scopeservice.invalid_scope_name": "Invalid scope name",
+	"error.scopeservice.invalid_scope_name_description": "The scope name must be a scope token of up to 255 characters without spaces, quotes or backslashes",
+	"error.scopeservice.scope_already_exists": "Scope already exists",
+	"error.scopeservice.scope_already_exists_description": "A scope with the specified name already exists",
+	"error.scopeservice.scope_not_found": "Scope not found",
+	"error.scopeservice.scope_not_found_description": "The scope with the specified id does not exist",
+	"error.signingkeyservice.invalid_request_format": "Invalid request format",
+	"error.signingkeyservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.signingkeyservice.invalid_rollover_window": "Invalid rollover window",
+	"error.signingkeyservice.invalid_rollover_window_description": "The rollover window must be a non-negative number of seconds",
+	"error.signingkeyservice.unsupported_algorithm": "Unsupported key algorithm",
+	"error.signingkeyservice.unsupported_algorithm_description": "The key algorithm must be one of RSA, P-256, P-384, P-521 or Ed25519",
+	"error.ssosession.invalid_session": "Invalid session",
+	"error.ssosession.invalid_session_description": "The session must have an authenticated user",
+	"error.ssosession.missing_session_id": "Missing session ID",
+	"error.ssosession.missing_session_id_description": "Session ID is required",
+	"error.ssosession.missing_user_id": "Missing user ID",
+	"error.ssosession.missing_user_id_description": "The userId query parameter is required",
+	"error.ssosession.session_not_found": "Session not found",
+	"error.ssosession.session_not_found_description": "The session with the specified ID does not exist or has expired",
+	"error.templateservice.duplicate_template": "Duplicate template",
+	"error.templateservice.duplicate_template_description": "A custom template already exists for the given scenario, type and organization unit",
+	"error.templateservice.invalid_content_type": "Invalid content type",
+	"error.templateservice.invalid_content_type_description": "The content type must be text/plain, or text/html for email templates",
+	"error.templateservice.invalid_display_name": "Invalid display name",
+	"error.templateservice.invalid_display_name_description": "The template display name is required",
+	"error.templateservice.invalid_placeholder": "Invalid placeholder",
+	"error.templateservice.invalid_placeholder_description": "Placeholders must be alphanumeric and content may only reference declared placeholders",
+	"error.templateservice.invalid_request_format": "Invalid request format",
+	"error.templateservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.templateservice.invalid_scenario": "Invalid scenario",
+	"error.templateservice.invalid_scenario_description": "The provided template scenario is not supported",
+	"error.templateservice.invalid_template_id": "Invalid template ID",
+	"error.templateservice.invalid_template_id_description": "The provided template ID is invalid or empty",
+	"error.templateservice.invalid_template_type": "Invalid template type",
+	"error.templateservice.invalid_template_type_description": "The template type must be either email or sms",
+	"error.templateservice.invalid_template_variant": "Invalid template variant",
+	"error.templateservice.invalid_template_variant_description": "Each variant requires a unique BCP 47 language tag and a body, and a subject for email",
+	"error.templateservice.missing_default_variant": "Missing default variant",
+	"error.templateservice.missing_default_variant_description": "A variant for the system default language en-US is required",
+	"error.templateservice.template_is_immutable": "Template is immutable",
+	"error.templateservice.template_is_immutable_description": "System templates loaded from declarative resources cannot be modified or deleted",
 	"error.templateservice.template_not_found": "Template not found",
 	"error.templateservice.template_not_found_description": "The requested template does not exist for the given scenario",
 	"error.unauthorized": "Unauthorized",
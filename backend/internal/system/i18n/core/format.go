@@ -0,0 +1,348 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+const (
+	argTypePlural = "plural"
+	argTypeSelect = "select"
+	selectorOther = "other"
+)
+
+// errMalformedMessage is returned when a message contains an invalid plural or select argument.
+var errMalformedMessage = errors.New("malformed message")
+
+// pluralFormNames maps CLDR plural categories to their ICU selector keywords.
+var pluralFormNames = map[plural.Form]string{
+	plural.Other: "other",
+	plural.Zero:  "zero",
+	plural.One:   "one",
+	plural.Two:   "two",
+	plural.Few:   "few",
+	plural.Many:  "many",
+}
+
+// FormatMessage formats a message written in a subset of the ICU MessageFormat syntax using the
+// provided arguments. The supported constructs are:
+//
+//   - {name} is replaced with the value of the argument. Unknown arguments are left as is.
+//   - {name, plural, =0 {...} one {...} other {...}} selects a branch using the CLDR plural rules
+//     of the language. Inside a branch, # is replaced with the argument value.
+//   - {name, select, key {...} other {...}} selects a branch matching the argument value.
+//   - ” renders a single apostrophe and '{...}' renders the quoted text literally.
+//
+// Braces that do not start one of the constructs above are rendered literally, so that content such
+// as inline CSS can pass through unchanged.
+func FormatMessage(lang string, message string, args map[string]string) (string, error) {
+	if !strings.ContainsAny(message, "{'") {
+		return message, nil
+	}
+
+	tag := language.English
+	if lang != "" {
+		if parsed, err := language.Parse(lang); err == nil {
+			tag = parsed
+		}
+	}
+
+	f := &messageFormatter{src: []rune(message), tag: tag, args: args}
+	return f.formatText("", false)
+}
+
+// messageFormatter formats a single message, keeping track of the current position in the message.
+type messageFormatter struct {
+	src  []rune
+	pos  int
+	tag  language.Tag
+	args map[string]string
+}
+
+// formatText formats message text until the end of the message, or until the closing brace of the
+// enclosing branch when nested is true. pound holds the value rendered for # within a plural branch.
+func (f *messageFormatter) formatText(pound string, nested bool) (string, error) {
+	var sb strings.Builder
+	openBraces := 0
+
+	for f.pos < len(f.src) {
+		ch := f.src[f.pos]
+		switch {
+		case ch == '\'':
+			sb.WriteString(f.readQuoted(pound != ""))
+		case ch == '#' && pound != "":
+			sb.WriteString(pound)
+			f.pos++
+		case ch == '{':
+			formatted, ok, err := f.formatArgument(pound)
+			if err != nil {
+				return "", err
+			}
+			if !ok {
+				sb.WriteRune(ch)
+				f.pos++
+				openBraces++
+				continue
+			}
+			sb.WriteString(formatted)
+		case ch == '}' && nested && openBraces == 0:
+			f.pos++
+			return sb.String(), nil
+		default:
+			if ch == '}' && openBraces > 0 {
+				openBraces--
+			}
+			sb.WriteRune(ch)
+			f.pos++
+		}
+	}
+
+	if nested {
+		return "", fmt.Errorf("%w: unterminated branch", errMalformedMessage)
+	}
+	return sb.String(), nil
+}
+
+// readQuoted consumes an apostrophe at the current position along with any text it quotes and
+// returns the literal text to render.
+func (f *messageFormatter) readQuoted(inPlural bool) string {
+	f.pos++
+	if f.pos >= len(f.src) {
+		return "'"
+	}
+
+	next := f.src[f.pos]
+	if next == '\'' {
+		f.pos++
+		return "'"
+	}
+	if next != '{' && next != '}' && (next != '#' || !inPlural) {
+		return "'"
+	}
+
+	var sb strings.Builder
+	for f.pos < len(f.src) {
+		ch := f.src[f.pos]
+		f.pos++
+		if ch != '\'' {
+			sb.WriteRune(ch)
+			continue
+		}
+		if f.pos < len(f.src) && f.src[f.pos] == '\'' {
+			sb.WriteRune('\'')
+			f.pos++
+			continue
+		}
+		break
+	}
+	return sb.String()
+}
+
+// formatArgument formats the argument starting at the opening brace at the current position. It
+// returns false without consuming any input if the brace does not start a supported argument.
+func (f *messageFormatter) formatArgument(pound string) (string, bool, error) {
+	start := f.pos
+	f.pos++
+	f.skipWhitespace()
+	name := f.readIdentifier()
+	if name == "" {
+		f.pos = start
+		return "", false, nil
+	}
+	f.skipWhitespace()
+
+	if f.peek() == '}' {
+		f.pos++
+		if value, ok := f.args[name]; ok {
+			return value, true, nil
+		}
+		return string(f.src[start:f.pos]), true, nil
+	}
+	if f.peek() != ',' {
+		f.pos = start
+		return "", false, nil
+	}
+
+	f.pos++
+	f.skipWhitespace()
+	argType := f.readIdentifier()
+	if argType != argTypePlural && argType != argTypeSelect {
+		f.pos = start
+		return "", false, nil
+	}
+	f.skipWhitespace()
+	if f.peek() != ',' {
+		return "", false, fmt.Errorf("%w: expected ',' after %s in argument %q",
+			errMalformedMessage, argType, name)
+	}
+	f.pos++
+
+	value, ok := f.args[name]
+	if !ok {
+		return "", false, fmt.Errorf("%w: missing value for argument %q", errMalformedMessage, name)
+	}
+	value = strings.TrimSpace(value)
+
+	if argType == argTypeSelect {
+		branches, err := f.readBranches(pound)
+		if err != nil {
+			return "", false, err
+		}
+		if branch, ok := branches[value]; ok {
+			return branch, true, nil
+		}
+		return branches[selectorOther], true, nil
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return "", false, fmt.Errorf("%w: argument %q is not a number", errMalformedMessage, name)
+	}
+	branches, err := f.readBranches(value)
+	if err != nil {
+		return "", false, err
+	}
+	for selector, branch := range branches {
+		if exact, ok := strings.CutPrefix(selector, "="); ok {
+			if n, err := strconv.ParseFloat(exact, 64); err == nil && n == number {
+				return branch, true, nil
+			}
+		}
+	}
+	if branch, ok := branches[pluralFormNames[f.matchPlural(value)]]; ok {
+		return branch, true, nil
+	}
+	return branches[selectorOther], true, nil
+}
+
+// readBranches reads the branches of a plural or select argument up to and including the closing
+// brace of the argument, and returns the formatted branches keyed by their selector.
+func (f *messageFormatter) readBranches(pound string) (map[string]string, error) {
+	branches := make(map[string]string)
+	for {
+		f.skipWhitespace()
+		if f.pos >= len(f.src) {
+			return nil, fmt.Errorf("%w: unterminated argument", errMalformedMessage)
+		}
+		if f.peek() == '}' {
+			f.pos++
+			break
+		}
+
+		selector := f.readSelector()
+		if selector == "" {
+			return nil, fmt.Errorf("%w: expected selector at position %d", errMalformedMessage, f.pos)
+		}
+		f.skipWhitespace()
+		if f.peek() != '{' {
+			return nil, fmt.Errorf("%w: expected '{' after selector %q", errMalformedMessage, selector)
+		}
+		f.pos++
+
+		branch, err := f.formatText(pound, true)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := branches[selector]; exists {
+			return nil, fmt.Errorf("%w: duplicate selector %q", errMalformedMessage, selector)
+		}
+		branches[selector] = branch
+	}
+
+	if _, ok := branches[selectorOther]; !ok {
+		return nil, fmt.Errorf("%w: missing '%s' selector", errMalformedMessage, selectorOther)
+	}
+	return branches, nil
+}
+
+// matchPlural returns the CLDR plural category of the given decimal number for the language.
+func (f *messageFormatter) matchPlural(value string) plural.Form {
+	value = strings.TrimLeft(value, "+-")
+	intPart, fraction, _ := strings.Cut(value, ".")
+	trimmed := strings.TrimRight(fraction, "0")
+
+	i := atoiPrefix(intPart)
+	return plural.Cardinal.MatchPlural(f.tag, i, len(fraction), len(trimmed), atoiPrefix(fraction),
+		atoiPrefix(trimmed))
+}
+
+// readIdentifier reads an argument name or keyword at the current position.
+func (f *messageFormatter) readIdentifier() string {
+	start := f.pos
+	for f.pos < len(f.src) {
+		ch := f.src[f.pos]
+		if ch != '_' && (ch < 'a' || ch > 'z') && (ch < 'A' || ch > 'Z') &&
+			(f.pos == start || ch < '0' || ch > '9') {
+			break
+		}
+		f.pos++
+	}
+	return string(f.src[start:f.pos])
+}
+
+// readSelector reads a plural or select selector, including explicit value selectors such as =0.
+func (f *messageFormatter) readSelector() string {
+	start := f.pos
+	for f.pos < len(f.src) && !isWhitespace(f.src[f.pos]) && f.src[f.pos] != '{' && f.src[f.pos] != '}' {
+		f.pos++
+	}
+	return string(f.src[start:f.pos])
+}
+
+// skipWhitespace advances the current position past any whitespace.
+func (f *messageFormatter) skipWhitespace() {
+	for f.pos < len(f.src) && isWhitespace(f.src[f.pos]) {
+		f.pos++
+	}
+}
+
+// peek returns the rune at the current position, or zero at the end of the message.
+func (f *messageFormatter) peek() rune {
+	if f.pos >= len(f.src) {
+		return 0
+	}
+	return f.src[f.pos]
+}
+
+// isWhitespace reports whether the rune is whitespace within an argument.
+func isWhitespace(ch rune) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+// atoiPrefix parses the leading digits of s, returning zero if there are none. Values are capped to
+// avoid overflowing on very long digit sequences.
+func atoiPrefix(s string) int {
+	n := 0
+	for _, ch := range s {
+		if ch < '0' || ch > '9' {
+			break
+		}
+		if n < 1e15 {
+			n = n*10 + int(ch-'0')
+		}
+	}
+	return n
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMessage(t *testing.T) {
+	minutes := "{minutes, plural, =0 {now} one {in # minute} other {in # minutes}}"
+	tests := []struct {
+		name     string
+		lang     string
+		message  string
+		args     map[string]string
+		expected string
+	}{
+		{"Plain text", "en", "Hello", nil, "Hello"},
+		{"Simple argument", "en", "Hello {name}!", map[string]string{"name": "Alice"}, "Hello Alice!"},
+		{"Argument with whitespace", "en", "Hello { name }!", map[string]string{"name": "Alice"}, "Hello Alice!"},
+		{"Unknown argument", "en", "Hello {name}!", nil, "Hello {name}!"},
+		{"Plural exact match", "en", minutes, map[string]string{"minutes": "0"}, "now"},
+		{"Plural one", "en", minutes, map[string]string{"minutes": "1"}, "in 1 minute"},
+		{"Plural other", "en", minutes, map[string]string{"minutes": "5"}, "in 5 minutes"},
+		{"Plural decimal", "en", minutes, map[string]string{"minutes": "1.5"}, "in 1.5 minutes"},
+		{"Plural defaults to English", "", minutes, map[string]string{"minutes": "1"}, "in 1 minute"},
+		{"Plural invalid language", "!!", minutes, map[string]string{"minutes": "1"}, "in 1 minute"},
+		{
+			"Plural few in Polish", "pl",
+			"{n, plural, one {# minuta} few {# minuty} many {# minut} other {# minuty}}",
+			map[string]string{"n": "3"}, "3 minuty",
+		},
+		{
+			"Plural many in Polish", "pl",
+			"{n, plural, one {# minuta} few {# minuty} many {# minut} other {# minuty}}",
+			map[string]string{"n": "5"}, "5 minut",
+		},
+		{
+			"Plural with regional language", "fr-CA",
+			"{n, plural, one {# minute} other {# minutes}}",
+			map[string]string{"n": "0"}, "0 minute",
+		},
+		{
+			"Plural missing category uses other", "ar",
+			"{n, plural, one {# item} other {# items}}",
+			map[string]string{"n": "2"}, "2 items",
+		},
+		{
+			"Plural with nested argument", "en",
+			"{n, plural, one {{user} has # message} other {{user} has # messages}}",
+			map[string]string{"n": "2", "user": "Bob"}, "Bob has 2 messages",
+		},
+		{
+			"Select", "en",
+			"{channel, select, sms {Check your phone} email {Check your inbox} other {Check your device}}",
+			map[string]string{"channel": "email"}, "Check your inbox",
+		},
+		{
+			"Select other", "en",
+			"{channel, select, sms {Check your phone} other {Check your device}}",
+			map[string]string{"channel": "push"}, "Check your device",
+		},
+		{
+			"Select with nested plural", "en",
+			"{g, select, team {{n, plural, one {# member} other {# members}}} other {#}}",
+			map[string]string{"g": "team", "n": "1"}, "1 member",
+		},
+		{"Escaped apostrophe", "en", "It''s {name}", map[string]string{"name": "here"}, "It's here"},
+		{"Literal apostrophe", "en", "Don't wait", nil, "Don't wait"},
+		{"Quoted braces", "en", "Use '{name}' literally", map[string]string{"name": "x"}, "Use {name} literally"},
+		{
+			"Quoted pound in plural", "en",
+			"{n, plural, other {'#' is #}}",
+			map[string]string{"n": "4"}, "# is 4",
+		},
+		{"Pound outside plural", "en", "Item #{n}", map[string]string{"n": "4"}, "Item #4"},
+		{"Context placeholder untouched", "en", "Code {{ctx(otp)}}", map[string]string{"otp": "1"},
+			"Code {{ctx(otp)}}"},
+		{"CSS untouched", "en", "p { color: red; } a{b:c}", nil, "p { color: red; } a{b:c}"},
+		{"Unsupported argument type untouched", "en", "{n, number}", map[string]string{"n": "1"}, "{n, number}"},
+		{
+			"Literal braces in branch", "en",
+			"{n, plural, other {a {b: c} #}}",
+			map[string]string{"n": "2"}, "a {b: c} 2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := FormatMessage(tc.lang, tc.message, tc.args)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestFormatMessage_Malformed(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		args    map[string]string
+	}{
+		{"Missing comma after type", "{n, plural one {x} other {y}}", map[string]string{"n": "1"}},
+		{"Missing argument value", "{n, plural, other {y}}", nil},
+		{"Non numeric plural value", "{n, plural, other {y}}", map[string]string{"n": "abc"}},
+		{"Missing other", "{n, plural, one {x}}", map[string]string{"n": "1"}},
+		{"Duplicate selector", "{n, select, a {x} a {y} other {z}}", map[string]string{"n": "a"}},
+		{"Missing branch", "{n, select, a other {z}}", map[string]string{"n": "a"}},
+		{"Unterminated branch", "{n, select, other {z", map[string]string{"n": "a"}},
+		{"Unterminated argument", "{n, select, other {z}", map[string]string{"n": "a"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := FormatMessage("en", tc.message, tc.args)
+			assert.True(t, errors.Is(err, errMalformedMessage))
+			assert.Empty(t, result)
+		})
+	}
+}
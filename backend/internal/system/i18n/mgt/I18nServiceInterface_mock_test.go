@@ -39,16 +39,16 @@ func (_m *I18nServiceInterfaceMock) EXPECT() *I18nServiceInterfaceMock_Expecter
 }
 
 // ClearTranslationOverrideForKey provides a mock function for the type I18nServiceInterfaceMock
-func (_mock *I18nServiceInterfaceMock) ClearTranslationOverrideForKey(language string, namespace string, key string) *serviceerror.ServiceError {
-	ret := _mock.Called(language, namespace, key)
+func (_mock *I18nServiceInterfaceMock) ClearTranslationOverrideForKey(language string, namespace string, key string, scope TranslationScope) *serviceerror.ServiceError {
+	ret := _mock.Called(language, namespace, key, scope)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ClearTranslationOverrideForKey")
 	}
 
 	var r0 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(string, string, string) *serviceerror.ServiceError); ok {
-		r0 = returnFunc(language, namespace, key)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, TranslationScope) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(language, namespace, key, scope)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*serviceerror.ServiceError)
@@ -66,11 +66,12 @@ type I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call struct {
 //   - language string
 //   - namespace string
 //   - key string
-func (_e *I18nServiceInterfaceMock_Expecter) ClearTranslationOverrideForKey(language interface{}, namespace interface{}, key interface{}) *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call {
-	return &I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call{Call: _e.mock.On("ClearTranslationOverrideForKey", language, namespace, key)}
+//   - scope TranslationScope
+func (_e *I18nServiceInterfaceMock_Expecter) ClearTranslationOverrideForKey(language interface{}, namespace interface{}, key interface{}, scope interface{}) *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call {
+	return &I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call{Call: _e.mock.On("ClearTranslationOverrideForKey", language, namespace, key, scope)}
 }
 
-func (_c *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call) Run(run func(language string, namespace string, key string)) *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call {
+func (_c *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call) Run(run func(language string, namespace string, key string, scope TranslationScope)) *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -84,10 +85,15 @@ func (_c *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call) Run(run
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 TranslationScope
+		if args[3] != nil {
+			arg3 = args[3].(TranslationScope)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -98,7 +104,7 @@ func (_c *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call) Return(s
 	return _c
 }
 
-func (_c *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call) RunAndReturn(run func(language string, namespace string, key string) *serviceerror.ServiceError) *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call {
+func (_c *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call) RunAndReturn(run func(language string, namespace string, key string, scope TranslationScope) *serviceerror.ServiceError) *I18nServiceInterfaceMock_ClearTranslationOverrideForKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -280,6 +286,152 @@ func (_c *I18nServiceInterfaceMock_DeleteTranslationsByNamespace_Call) RunAndRet
 	return _c
 }
 
+// ExportTranslations provides a mock function for the type I18nServiceInterfaceMock
+func (_mock *I18nServiceInterfaceMock) ExportTranslations(language string, namespace string, format string) ([]byte, *serviceerror.ServiceError) {
+	ret := _mock.Called(language, namespace, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportTranslations")
+	}
+
+	var r0 []byte
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) ([]byte, *serviceerror.ServiceError)); ok {
+		return returnFunc(language, namespace, format)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) []byte); ok {
+		r0 = returnFunc(language, namespace, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(language, namespace, format)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// I18nServiceInterfaceMock_ExportTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportTranslations'
+type I18nServiceInterfaceMock_ExportTranslations_Call struct {
+	*mock.Call
+}
+
+// ExportTranslations is a helper method to define mock.On call
+//   - language string
+//   - namespace string
+//   - format string
+func (_e *I18nServiceInterfaceMock_Expecter) ExportTranslations(language interface{}, namespace interface{}, format interface{}) *I18nServiceInterfaceMock_ExportTranslations_Call {
+	return &I18nServiceInterfaceMock_ExportTranslations_Call{Call: _e.mock.On("ExportTranslations", language, namespace, format)}
+}
+
+func (_c *I18nServiceInterfaceMock_ExportTranslations_Call) Run(run func(language string, namespace string, format string)) *I18nServiceInterfaceMock_ExportTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *I18nServiceInterfaceMock_ExportTranslations_Call) Return(bytes []byte, serviceError *serviceerror.ServiceError) *I18nServiceInterfaceMock_ExportTranslations_Call {
+	_c.Call.Return(bytes, serviceError)
+	return _c
+}
+
+func (_c *I18nServiceInterfaceMock_ExportTranslations_Call) RunAndReturn(run func(language string, namespace string, format string) ([]byte, *serviceerror.ServiceError)) *I18nServiceInterfaceMock_ExportTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMissingTranslationReport provides a mock function for the type I18nServiceInterfaceMock
+func (_mock *I18nServiceInterfaceMock) GetMissingTranslationReport(language string, namespace string) (*MissingTranslationReportResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(language, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMissingTranslationReport")
+	}
+
+	var r0 *MissingTranslationReportResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*MissingTranslationReportResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(language, namespace)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *MissingTranslationReportResponse); ok {
+		r0 = returnFunc(language, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MissingTranslationReportResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(language, namespace)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// I18nServiceInterfaceMock_GetMissingTranslationReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMissingTranslationReport'
+type I18nServiceInterfaceMock_GetMissingTranslationReport_Call struct {
+	*mock.Call
+}
+
+// GetMissingTranslationReport is a helper method to define mock.On call
+//   - language string
+//   - namespace string
+func (_e *I18nServiceInterfaceMock_Expecter) GetMissingTranslationReport(language interface{}, namespace interface{}) *I18nServiceInterfaceMock_GetMissingTranslationReport_Call {
+	return &I18nServiceInterfaceMock_GetMissingTranslationReport_Call{Call: _e.mock.On("GetMissingTranslationReport", language, namespace)}
+}
+
+func (_c *I18nServiceInterfaceMock_GetMissingTranslationReport_Call) Run(run func(language string, namespace string)) *I18nServiceInterfaceMock_GetMissingTranslationReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *I18nServiceInterfaceMock_GetMissingTranslationReport_Call) Return(missingTranslationReportResponse *MissingTranslationReportResponse, serviceError *serviceerror.ServiceError) *I18nServiceInterfaceMock_GetMissingTranslationReport_Call {
+	_c.Call.Return(missingTranslationReportResponse, serviceError)
+	return _c
+}
+
+func (_c *I18nServiceInterfaceMock_GetMissingTranslationReport_Call) RunAndReturn(run func(language string, namespace string) (*MissingTranslationReportResponse, *serviceerror.ServiceError)) *I18nServiceInterfaceMock_GetMissingTranslationReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTranslationsByNamespace provides a mock function for the type I18nServiceInterfaceMock
 func (_mock *I18nServiceInterfaceMock) GetTranslationsByNamespace(namespace string) (map[string]map[string]string, *serviceerror.ServiceError) {
 	ret := _mock.Called(namespace)
@@ -344,6 +496,100 @@ func (_c *I18nServiceInterfaceMock_GetTranslationsByNamespace_Call) RunAndReturn
 	return _c
 }
 
+// ImportTranslations provides a mock function for the type I18nServiceInterfaceMock
+func (_mock *I18nServiceInterfaceMock) ImportTranslations(ctx context.Context, language string, namespace string, format string, mode string, data []byte) (*TranslationImportResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, language, namespace, format, mode, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportTranslations")
+	}
+
+	var r0 *TranslationImportResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, []byte) (*TranslationImportResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, language, namespace, format, mode, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, []byte) *TranslationImportResponse); ok {
+		r0 = returnFunc(ctx, language, namespace, format, mode, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TranslationImportResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string, []byte) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, language, namespace, format, mode, data)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// I18nServiceInterfaceMock_ImportTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportTranslations'
+type I18nServiceInterfaceMock_ImportTranslations_Call struct {
+	*mock.Call
+}
+
+// ImportTranslations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - language string
+//   - namespace string
+//   - format string
+//   - mode string
+//   - data []byte
+func (_e *I18nServiceInterfaceMock_Expecter) ImportTranslations(ctx interface{}, language interface{}, namespace interface{}, format interface{}, mode interface{}, data interface{}) *I18nServiceInterfaceMock_ImportTranslations_Call {
+	return &I18nServiceInterfaceMock_ImportTranslations_Call{Call: _e.mock.On("ImportTranslations", ctx, language, namespace, format, mode, data)}
+}
+
+func (_c *I18nServiceInterfaceMock_ImportTranslations_Call) Run(run func(ctx context.Context, language string, namespace string, format string, mode string, data []byte)) *I18nServiceInterfaceMock_ImportTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 []byte
+		if args[5] != nil {
+			arg5 = args[5].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *I18nServiceInterfaceMock_ImportTranslations_Call) Return(translationImportResponse *TranslationImportResponse, serviceError *serviceerror.ServiceError) *I18nServiceInterfaceMock_ImportTranslations_Call {
+	_c.Call.Return(translationImportResponse, serviceError)
+	return _c
+}
+
+func (_c *I18nServiceInterfaceMock_ImportTranslations_Call) RunAndReturn(run func(ctx context.Context, language string, namespace string, format string, mode string, data []byte) (*TranslationImportResponse, *serviceerror.ServiceError)) *I18nServiceInterfaceMock_ImportTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListLanguages provides a mock function for the type I18nServiceInterfaceMock
 func (_mock *I18nServiceInterfaceMock) ListLanguages() ([]string, *serviceerror.ServiceError) {
 	ret := _mock.Called()
@@ -402,8 +648,8 @@ func (_c *I18nServiceInterfaceMock_ListLanguages_Call) RunAndReturn(run func() (
 }
 
 // ResolveTranslations provides a mock function for the type I18nServiceInterfaceMock
-func (_mock *I18nServiceInterfaceMock) ResolveTranslations(language string, namespace string) (*LanguageTranslationsResponse, *serviceerror.ServiceError) {
-	ret := _mock.Called(language, namespace)
+func (_mock *I18nServiceInterfaceMock) ResolveTranslations(language string, namespace string, scope TranslationScope) (*LanguageTranslationsResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(language, namespace, scope)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ResolveTranslations")
@@ -411,18 +657,18 @@ func (_mock *I18nServiceInterfaceMock) ResolveTranslations(language string, name
 
 	var r0 *LanguageTranslationsResponse
 	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(string, string) (*LanguageTranslationsResponse, *serviceerror.ServiceError)); ok {
-		return returnFunc(language, namespace)
+	if returnFunc, ok := ret.Get(0).(func(string, string, TranslationScope) (*LanguageTranslationsResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(language, namespace, scope)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) *LanguageTranslationsResponse); ok {
-		r0 = returnFunc(language, namespace)
+	if returnFunc, ok := ret.Get(0).(func(string, string, TranslationScope) *LanguageTranslationsResponse); ok {
+		r0 = returnFunc(language, namespace, scope)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*LanguageTranslationsResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(language, namespace)
+	if returnFunc, ok := ret.Get(1).(func(string, string, TranslationScope) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(language, namespace, scope)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*serviceerror.ServiceError)
@@ -439,11 +685,12 @@ type I18nServiceInterfaceMock_ResolveTranslations_Call struct {
 // ResolveTranslations is a helper method to define mock.On call
 //   - language string
 //   - namespace string
-func (_e *I18nServiceInterfaceMock_Expecter) ResolveTranslations(language interface{}, namespace interface{}) *I18nServiceInterfaceMock_ResolveTranslations_Call {
-	return &I18nServiceInterfaceMock_ResolveTranslations_Call{Call: _e.mock.On("ResolveTranslations", language, namespace)}
+//   - scope TranslationScope
+func (_e *I18nServiceInterfaceMock_Expecter) ResolveTranslations(language interface{}, namespace interface{}, scope interface{}) *I18nServiceInterfaceMock_ResolveTranslations_Call {
+	return &I18nServiceInterfaceMock_ResolveTranslations_Call{Call: _e.mock.On("ResolveTranslations", language, namespace, scope)}
 }
 
-func (_c *I18nServiceInterfaceMock_ResolveTranslations_Call) Run(run func(language string, namespace string)) *I18nServiceInterfaceMock_ResolveTranslations_Call {
+func (_c *I18nServiceInterfaceMock_ResolveTranslations_Call) Run(run func(language string, namespace string, scope TranslationScope)) *I18nServiceInterfaceMock_ResolveTranslations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -453,9 +700,14 @@ func (_c *I18nServiceInterfaceMock_ResolveTranslations_Call) Run(run func(langua
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 TranslationScope
+		if args[2] != nil {
+			arg2 = args[2].(TranslationScope)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -466,14 +718,14 @@ func (_c *I18nServiceInterfaceMock_ResolveTranslations_Call) Return(languageTran
 	return _c
 }
 
-func (_c *I18nServiceInterfaceMock_ResolveTranslations_Call) RunAndReturn(run func(language string, namespace string) (*LanguageTranslationsResponse, *serviceerror.ServiceError)) *I18nServiceInterfaceMock_ResolveTranslations_Call {
+func (_c *I18nServiceInterfaceMock_ResolveTranslations_Call) RunAndReturn(run func(language string, namespace string, scope TranslationScope) (*LanguageTranslationsResponse, *serviceerror.ServiceError)) *I18nServiceInterfaceMock_ResolveTranslations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ResolveTranslationsForKey provides a mock function for the type I18nServiceInterfaceMock
-func (_mock *I18nServiceInterfaceMock) ResolveTranslationsForKey(language string, namespace string, key string) (*TranslationResponse, *serviceerror.ServiceError) {
-	ret := _mock.Called(language, namespace, key)
+func (_mock *I18nServiceInterfaceMock) ResolveTranslationsForKey(language string, namespace string, key string, scope TranslationScope) (*TranslationResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(language, namespace, key, scope)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ResolveTranslationsForKey")
@@ -481,18 +733,18 @@ func (_mock *I18nServiceInterfaceMock) ResolveTranslationsForKey(language string
 
 	var r0 *TranslationResponse
 	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(string, string, string) (*TranslationResponse, *serviceerror.ServiceError)); ok {
-		return returnFunc(language, namespace, key)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, TranslationScope) (*TranslationResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(language, namespace, key, scope)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string, string) *TranslationResponse); ok {
-		r0 = returnFunc(language, namespace, key)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, TranslationScope) *TranslationResponse); ok {
+		r0 = returnFunc(language, namespace, key, scope)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*TranslationResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string, string) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(language, namespace, key)
+	if returnFunc, ok := ret.Get(1).(func(string, string, string, TranslationScope) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(language, namespace, key, scope)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*serviceerror.ServiceError)
@@ -510,11 +762,12 @@ type I18nServiceInterfaceMock_ResolveTranslationsForKey_Call struct {
 //   - language string
 //   - namespace string
 //   - key string
-func (_e *I18nServiceInterfaceMock_Expecter) ResolveTranslationsForKey(language interface{}, namespace interface{}, key interface{}) *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call {
-	return &I18nServiceInterfaceMock_ResolveTranslationsForKey_Call{Call: _e.mock.On("ResolveTranslationsForKey", language, namespace, key)}
+//   - scope TranslationScope
+func (_e *I18nServiceInterfaceMock_Expecter) ResolveTranslationsForKey(language interface{}, namespace interface{}, key interface{}, scope interface{}) *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call {
+	return &I18nServiceInterfaceMock_ResolveTranslationsForKey_Call{Call: _e.mock.On("ResolveTranslationsForKey", language, namespace, key, scope)}
 }
 
-func (_c *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call) Run(run func(language string, namespace string, key string)) *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call {
+func (_c *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call) Run(run func(language string, namespace string, key string, scope TranslationScope)) *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -528,10 +781,15 @@ func (_c *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call) Run(run func(
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 TranslationScope
+		if args[3] != nil {
+			arg3 = args[3].(TranslationScope)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -542,14 +800,14 @@ func (_c *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call) Return(transl
 	return _c
 }
 
-func (_c *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call) RunAndReturn(run func(language string, namespace string, key string) (*TranslationResponse, *serviceerror.ServiceError)) *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call {
+func (_c *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call) RunAndReturn(run func(language string, namespace string, key string, scope TranslationScope) (*TranslationResponse, *serviceerror.ServiceError)) *I18nServiceInterfaceMock_ResolveTranslationsForKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // SetTranslationOverrideForKey provides a mock function for the type I18nServiceInterfaceMock
-func (_mock *I18nServiceInterfaceMock) SetTranslationOverrideForKey(language string, namespace string, key string, value string) (*TranslationResponse, *serviceerror.ServiceError) {
-	ret := _mock.Called(language, namespace, key, value)
+func (_mock *I18nServiceInterfaceMock) SetTranslationOverrideForKey(language string, namespace string, key string, value string, scope TranslationScope) (*TranslationResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(language, namespace, key, value, scope)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetTranslationOverrideForKey")
@@ -557,18 +815,18 @@ func (_mock *I18nServiceInterfaceMock) SetTranslationOverrideForKey(language str
 
 	var r0 *TranslationResponse
 	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) (*TranslationResponse, *serviceerror.ServiceError)); ok {
-		return returnFunc(language, namespace, key, value)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, TranslationScope) (*TranslationResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(language, namespace, key, value, scope)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) *TranslationResponse); ok {
-		r0 = returnFunc(language, namespace, key, value)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, TranslationScope) *TranslationResponse); ok {
+		r0 = returnFunc(language, namespace, key, value, scope)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*TranslationResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string, string, string) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(language, namespace, key, value)
+	if returnFunc, ok := ret.Get(1).(func(string, string, string, string, TranslationScope) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(language, namespace, key, value, scope)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*serviceerror.ServiceError)
@@ -587,11 +845,12 @@ type I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call struct {
 //   - namespace string
 //   - key string
 //   - value string
-func (_e *I18nServiceInterfaceMock_Expecter) SetTranslationOverrideForKey(language interface{}, namespace interface{}, key interface{}, value interface{}) *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call {
-	return &I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call{Call: _e.mock.On("SetTranslationOverrideForKey", language, namespace, key, value)}
+//   - scope TranslationScope
+func (_e *I18nServiceInterfaceMock_Expecter) SetTranslationOverrideForKey(language interface{}, namespace interface{}, key interface{}, value interface{}, scope interface{}) *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call {
+	return &I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call{Call: _e.mock.On("SetTranslationOverrideForKey", language, namespace, key, value, scope)}
 }
 
-func (_c *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call) Run(run func(language string, namespace string, key string, value string)) *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call {
+func (_c *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call) Run(run func(language string, namespace string, key string, value string, scope TranslationScope)) *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -609,11 +868,16 @@ func (_c *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call) Run(run fu
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 TranslationScope
+		if args[4] != nil {
+			arg4 = args[4].(TranslationScope)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -624,7 +888,7 @@ func (_c *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call) Return(tra
 	return _c
 }
 
-func (_c *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call) RunAndReturn(run func(language string, namespace string, key string, value string) (*TranslationResponse, *serviceerror.ServiceError)) *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call {
+func (_c *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call) RunAndReturn(run func(language string, namespace string, key string, value string, scope TranslationScope) (*TranslationResponse, *serviceerror.ServiceError)) *I18nServiceInterfaceMock_SetTranslationOverrideForKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
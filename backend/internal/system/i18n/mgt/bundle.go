@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mgt
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Supported translation bundle formats.
+const (
+	BundleFormatJSON  = "json"
+	BundleFormatXLIFF = "xliff"
+)
+
+// Supported translation bundle import modes.
+const (
+	// ImportModeApply stores the added and changed translations of the bundle.
+	ImportModeApply = "apply"
+	// ImportModeDiff only reports the differences without storing anything.
+	ImportModeDiff = "diff"
+)
+
+const (
+	xliffVersion   = "1.2"
+	xliffNamespace = "urn:oasis:names:tc:xliff:document:1.2"
+)
+
+// xliffDocument represents an XLIFF 1.2 document.
+type xliffDocument struct {
+	XMLName xml.Name    `xml:"xliff"`
+	Xmlns   string      `xml:"xmlns,attr,omitempty"`
+	Version string      `xml:"version,attr"`
+	Files   []xliffFile `xml:"file"`
+}
+
+// xliffFile represents a file element of an XLIFF document. The original attribute carries the namespace.
+type xliffFile struct {
+	Original       string      `xml:"original,attr"`
+	SourceLanguage string      `xml:"source-language,attr"`
+	TargetLanguage string      `xml:"target-language,attr,omitempty"`
+	Datatype       string      `xml:"datatype,attr"`
+	Units          []xliffUnit `xml:"body>trans-unit"`
+}
+
+// xliffUnit represents a single translation unit keyed by the translation key.
+type xliffUnit struct {
+	ID     string `xml:"id,attr"`
+	Source string `xml:"source"`
+	Target string `xml:"target,omitempty"`
+}
+
+// IsValidBundleFormat reports whether the given translation bundle format is supported.
+func IsValidBundleFormat(format string) bool {
+	return format == BundleFormatJSON || format == BundleFormatXLIFF
+}
+
+// getBundleContentType returns the content type used to serve a bundle of the given format.
+func getBundleContentType(format string) string {
+	if format == BundleFormatXLIFF {
+		return "application/xliff+xml"
+	}
+	return "application/json"
+}
+
+// encodeBundle serializes the translations of a language and namespace in the given format.
+// The sources are the system language values and are only used by formats that carry the source text.
+func encodeBundle(format, language, namespace string, sources, targets map[string]string) ([]byte, error) {
+	switch format {
+	case BundleFormatJSON:
+		return json.MarshalIndent(TranslationBundle{
+			Language:     language,
+			Namespace:    namespace,
+			Translations: targets,
+		}, "", "  ")
+	case BundleFormatXLIFF:
+		return encodeXLIFFBundle(language, namespace, sources, targets)
+	default:
+		return nil, fmt.Errorf("unsupported bundle format: %s", format)
+	}
+}
+
+// encodeXLIFFBundle serializes the translations as an XLIFF 1.2 document with one unit per key.
+func encodeXLIFFBundle(language, namespace string, sources, targets map[string]string) ([]byte, error) {
+	keys := make([]string, 0, len(sources)+len(targets))
+	for key := range sources {
+		keys = append(keys, key)
+	}
+	for key := range targets {
+		if _, exists := sources[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	units := make([]xliffUnit, 0, len(keys))
+	for _, key := range keys {
+		units = append(units, xliffUnit{
+			ID:     key,
+			Source: sources[key],
+			Target: targets[key],
+		})
+	}
+
+	doc := xliffDocument{
+		Xmlns:   xliffNamespace,
+		Version: xliffVersion,
+		Files: []xliffFile{
+			{
+				Original:       namespace,
+				SourceLanguage: SystemLanguage,
+				TargetLanguage: language,
+				Datatype:       "plaintext",
+				Units:          units,
+			},
+		},
+	}
+
+	content, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), content...), nil
+}
+
+// decodeBundle parses a translation bundle of the given format.
+// Units of an XLIFF bundle without a target are treated as untranslated and skipped.
+func decodeBundle(format string, data []byte) (*TranslationBundle, error) {
+	switch format {
+	case BundleFormatJSON:
+		var bundle TranslationBundle
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			return nil, err
+		}
+		if bundle.Translations == nil {
+			return nil, errors.New("bundle does not contain translations")
+		}
+		return &bundle, nil
+	case BundleFormatXLIFF:
+		var doc xliffDocument
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		if len(doc.Files) != 1 {
+			return nil, errors.New("bundle must contain exactly one file")
+		}
+		file := doc.Files[0]
+		bundle := &TranslationBundle{
+			Language:     file.TargetLanguage,
+			Namespace:    file.Original,
+			Translations: make(map[string]string, len(file.Units)),
+		}
+		for _, unit := range file.Units {
+			if unit.Target == "" {
+				continue
+			}
+			bundle.Translations[unit.ID] = unit.Target
+		}
+		return bundle, nil
+	default:
+		return nil, fmt.Errorf("unsupported bundle format: %s", format)
+	}
+}
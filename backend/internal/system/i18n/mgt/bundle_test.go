@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mgt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BundleTestSuite struct {
+	suite.Suite
+}
+
+func TestBundleTestSuite(t *testing.T) {
+	suite.Run(t, new(BundleTestSuite))
+}
+
+func (suite *BundleTestSuite) TestIsValidBundleFormat() {
+	suite.True(IsValidBundleFormat(BundleFormatJSON))
+	suite.True(IsValidBundleFormat(BundleFormatXLIFF))
+	suite.False(IsValidBundleFormat(""))
+	suite.False(IsValidBundleFormat("yaml"))
+}
+
+func (suite *BundleTestSuite) TestGetBundleContentType() {
+	suite.Equal("application/json", getBundleContentType(BundleFormatJSON))
+	suite.Equal("application/xliff+xml", getBundleContentType(BundleFormatXLIFF))
+}
+
+func (suite *BundleTestSuite) TestEncodeDecodeJSONBundle() {
+	targets := map[string]string{"login.title": "Bienvenue", "login.button": "Connexion"}
+
+	content, err := encodeBundle(BundleFormatJSON, "fr", "auth", nil, targets)
+	suite.NoError(err)
+
+	bundle, err := decodeBundle(BundleFormatJSON, content)
+	suite.NoError(err)
+	suite.Equal("fr", bundle.Language)
+	suite.Equal("auth", bundle.Namespace)
+	suite.Equal(targets, bundle.Translations)
+}
+
+func (suite *BundleTestSuite) TestEncodeDecodeXLIFFBundle() {
+	sources := map[string]string{"login.title": "Welcome", "login.button": "Sign in"}
+	targets := map[string]string{"login.title": "Bienvenue", "legacy.key": "Ancien"}
+
+	content, err := encodeBundle(BundleFormatXLIFF, "fr", "auth", sources, targets)
+	suite.NoError(err)
+	suite.Contains(string(content), `xmlns="urn:oasis:names:tc:xliff:document:1.2"`)
+	suite.Contains(string(content), `source-language="en-US"`)
+	suite.Contains(string(content), `<source>Sign in</source>`)
+
+	bundle, err := decodeBundle(BundleFormatXLIFF, content)
+	suite.NoError(err)
+	suite.Equal("fr", bundle.Language)
+	suite.Equal("auth", bundle.Namespace)
+	// Units without a target are untranslated and skipped.
+	suite.Equal(targets, bundle.Translations)
+}
+
+func (suite *BundleTestSuite) TestDecodeXLIFFBundle_WithoutNamespace() {
+	data := `<xliff version="1.2"><file original="auth" source-language="en-US" target-language="fr">
+<body><trans-unit id="login.title"><source>Welcome</source><target>Bienvenue</target></trans-unit></body>
+</file></xliff>`
+
+	bundle, err := decodeBundle(BundleFormatXLIFF, []byte(data))
+	suite.NoError(err)
+	suite.Equal(map[string]string{"login.title": "Bienvenue"}, bundle.Translations)
+}
+
+func (suite *BundleTestSuite) TestDecodeBundle_Errors() {
+	testCases := []struct {
+		name   string
+		format string
+		data   string
+	}{
+		{"MalformedJSON", BundleFormatJSON, "{"},
+		{"JSONWithoutTranslations", BundleFormatJSON, `{"language":"fr"}`},
+		{"MalformedXLIFF", BundleFormatXLIFF, "<xliff"},
+		{"XLIFFWithoutFile", BundleFormatXLIFF, `<xliff version="1.2"></xliff>`},
+		{"UnsupportedFormat", "yaml", "{}"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			bundle, err := decodeBundle(tc.format, []byte(tc.data))
+			suite.Error(err)
+			suite.Nil(bundle)
+		})
+	}
+}
+
+func (suite *BundleTestSuite) TestEncodeBundle_UnsupportedFormat() {
+	content, err := encodeBundle("yaml", "fr", "auth", nil, nil)
+	suite.Error(err)
+	suite.Nil(content)
+}
@@ -30,6 +30,15 @@ const SystemLanguage = "en-US"
 // SystemNamespace is the default namespace for system translations.
 const SystemNamespace = "system"
 
+// Scope types of translation overrides.
+const (
+	translationScopeTypeApp = "APP"
+	translationScopeTypeOU  = "OU"
+)
+
+// maxScopeIDLength is the maximum length of an application or organization unit identifier.
+const maxScopeIDLength = 36
+
 // LanguagePreferenceOrder defines the priority of languages for fallback.
 var LanguagePreferenceOrder = map[string]int{
 	"en-US": 0,
@@ -129,4 +129,69 @@ var (
 			DefaultValue: "At least one translation must be provided",
 		},
 	}
+	// ErrorInvalidBundleFormat is the error returned when the bundle format is not supported.
+	ErrorInvalidBundleFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "I18N-1009",
+		Error: core.I18nMessage{
+			Key:          "error.i18nservice.invalid_bundle_format",
+			DefaultValue: "Invalid bundle format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.i18nservice.invalid_bundle_format_description",
+			DefaultValue: "The bundle format must be one of json or xliff",
+		},
+	}
+	// ErrorInvalidBundle is the error returned when the bundle content cannot be parsed.
+	ErrorInvalidBundle = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "I18N-1010",
+		Error: core.I18nMessage{
+			Key:          "error.i18nservice.invalid_bundle",
+			DefaultValue: "Invalid translation bundle",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.i18nservice.invalid_bundle_description",
+			DefaultValue: "The translation bundle is malformed and could not be parsed",
+		},
+	}
+	// ErrorBundleMismatch is the error returned when the bundle targets a different language or namespace.
+	ErrorBundleMismatch = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "I18N-1011",
+		Error: core.I18nMessage{
+			Key:          "error.i18nservice.bundle_mismatch",
+			DefaultValue: "Translation bundle mismatch",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.i18nservice.bundle_mismatch_description",
+			DefaultValue: "The language or namespace of the bundle does not match the request",
+		},
+	}
+	// ErrorInvalidImportMode is the error returned when the import mode is not supported.
+	ErrorInvalidImportMode = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "I18N-1012",
+		Error: core.I18nMessage{
+			Key:          "error.i18nservice.invalid_import_mode",
+			DefaultValue: "Invalid import mode",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.i18nservice.invalid_import_mode_description",
+			DefaultValue: "The import mode must be one of apply or diff",
+		},
+	}
+	// ErrorInvalidTranslationScope is the error returned when the translation override scope is invalid.
+	ErrorInvalidTranslationScope = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "I18N-1013",
+		Error: core.I18nMessage{
+			Key:          "error.i18nservice.invalid_translation_scope",
+			DefaultValue: "Invalid translation scope",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.i18nservice.invalid_translation_scope_description",
+			DefaultValue: "Specify a valid identifier for either the app or the ou, but not both",
+		},
+	}
 )
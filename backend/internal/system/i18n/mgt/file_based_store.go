@@ -186,6 +186,22 @@ func (f *fileBasedStore) DeleteTranslationsByKey(_ context.Context, namespace st
 	return errors.New("DeleteTranslationsByKey is not supported in file-based store")
 }
 
+// GetTranslationOverrides returns no overrides as they are not supported in file-based store.
+func (f *fileBasedStore) GetTranslationOverrides(_ string, _ string, _ string) (
+	map[string]map[string]Translation, error) {
+	return make(map[string]map[string]Translation), nil
+}
+
+// UpsertTranslationOverride is not supported in file-based store.
+func (f *fileBasedStore) UpsertTranslationOverride(_ string, _ string, _ Translation) error {
+	return errors.New("UpsertTranslationOverride is not supported in file-based store")
+}
+
+// DeleteTranslationOverride is not supported in file-based store.
+func (f *fileBasedStore) DeleteTranslationOverride(_ string, _ string, _ string, _ string, _ string) error {
+	return errors.New("DeleteTranslationOverride is not supported in file-based store")
+}
+
 // IsTranslationDeclarative checks if a translation is immutable (exists in file store).
 // Helper method for composite store.
 func (f *fileBasedStore) IsTranslationDeclarative(id string) bool {
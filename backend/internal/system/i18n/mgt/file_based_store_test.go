@@ -267,6 +267,20 @@ func (s *FileBasedStoreTestSuite) TestDeleteTranslationsByKey_NotSupported() {
 	assert.Contains(s.T(), err.Error(), "not supported")
 }
 
+func (s *FileBasedStoreTestSuite) TestTranslationOverrides_NotSupported() {
+	overrides, err := s.store.GetTranslationOverrides(translationScopeTypeApp, "app-1", "custom")
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), overrides)
+
+	err = s.store.UpsertTranslationOverride(translationScopeTypeApp, "app-1", Translation{})
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "not supported")
+
+	err = s.store.DeleteTranslationOverride(translationScopeTypeApp, "app-1", "en", "key", "custom")
+	assert.Error(s.T(), err)
+	assert.Contains(s.T(), err.Error(), "not supported")
+}
+
 func (s *FileBasedStoreTestSuite) TestIsTranslationDeclarative() {
 	enTrans := &LanguageTranslations{
 		Language: "en-US",
@@ -19,8 +19,11 @@
 package mgt
 
 import (
+	"fmt"
+	"io"
 	"net/http"
 
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -29,6 +32,9 @@ import (
 
 const handlerLoggerComponentName = "I18nHandler"
 
+// maxTranslationBundleSize is the maximum accepted size of an imported translation bundle in bytes.
+const maxTranslationBundleSize = 5 << 20
+
 // i18nHandler is the handler for i18n management operations.
 type i18nHandler struct {
 	i18nService I18nServiceInterface
@@ -69,7 +75,7 @@ func (h *i18nHandler) HandleResolveTranslationsByLanguage(w http.ResponseWriter,
 	sanitizedLanguage := sysutils.SanitizeString(language)
 	sanitizedNamespace := sysutils.SanitizeString(namespace)
 
-	resp, svcErr := h.i18nService.ResolveTranslations(sanitizedLanguage, sanitizedNamespace)
+	resp, svcErr := h.i18nService.ResolveTranslations(sanitizedLanguage, sanitizedNamespace, getTranslationScope(r))
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
@@ -136,7 +142,8 @@ func (h *i18nHandler) HandleResolveTranslation(w http.ResponseWriter, r *http.Re
 	sanitizedNamespace := sysutils.SanitizeString(namespace)
 	sanitizedKey := sysutils.SanitizeString(key)
 
-	resp, svcErr := h.i18nService.ResolveTranslationsForKey(sanitizedLanguage, sanitizedNamespace, sanitizedKey)
+	resp, svcErr := h.i18nService.ResolveTranslationsForKey(
+		sanitizedLanguage, sanitizedNamespace, sanitizedKey, getTranslationScope(r))
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
@@ -170,7 +177,7 @@ func (h *i18nHandler) HandleSetOverrideTranslation(w http.ResponseWriter, r *htt
 	sanitizedValue := sysutils.SanitizeString(req.Value)
 
 	resp, svcErr := h.i18nService.SetTranslationOverrideForKey(
-		sanitizedLanguage, sanitizedNamespace, sanitizedKey, sanitizedValue)
+		sanitizedLanguage, sanitizedNamespace, sanitizedKey, sanitizedValue, getTranslationScope(r))
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
@@ -196,7 +203,8 @@ func (h *i18nHandler) HandleClearOverrideTranslation(w http.ResponseWriter, r *h
 	sanitizedNamespace := sysutils.SanitizeString(namespace)
 	sanitizedKey := sysutils.SanitizeString(key)
 
-	svcErr := h.i18nService.ClearTranslationOverrideForKey(sanitizedLanguage, sanitizedNamespace, sanitizedKey)
+	svcErr := h.i18nService.ClearTranslationOverrideForKey(
+		sanitizedLanguage, sanitizedNamespace, sanitizedKey, getTranslationScope(r))
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
@@ -209,6 +217,107 @@ func (h *i18nHandler) HandleClearOverrideTranslation(w http.ResponseWriter, r *h
 		log.String("key", sanitizedKey))
 }
 
+// HandleExportTranslations handles GET /i18n/languages/{language}/translations/ns/{namespace}/export
+func (h *i18nHandler) HandleExportTranslations(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	sanitizedLanguage := sysutils.SanitizeString(r.PathValue("language"))
+	sanitizedNamespace := sysutils.SanitizeString(r.PathValue("namespace"))
+	format := getBundleFormat(r)
+
+	content, svcErr := h.i18nService.ExportTranslations(sanitizedLanguage, sanitizedNamespace, format)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	extension := "json"
+	if format == BundleFormatXLIFF {
+		extension = "xlf"
+	}
+	w.Header().Set(serverconst.ContentTypeHeaderName, getBundleContentType(format))
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%s-%s.%s", sanitizedLanguage, sanitizedNamespace, extension))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(content); err != nil {
+		logger.Error("Failed to write translation bundle", log.Error(err))
+		return
+	}
+	logger.Debug("Successfully exported translations",
+		log.String("language", sanitizedLanguage),
+		log.String("namespace", sanitizedNamespace),
+		log.String("format", format))
+}
+
+// HandleImportTranslations handles POST /i18n/languages/{language}/translations/ns/{namespace}/import
+func (h *i18nHandler) HandleImportTranslations(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	sanitizedLanguage := sysutils.SanitizeString(r.PathValue("language"))
+	sanitizedNamespace := sysutils.SanitizeString(r.PathValue("namespace"))
+	format := getBundleFormat(r)
+	mode := r.URL.Query().Get("mode")
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxTranslationBundleSize))
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	resp, svcErr := h.i18nService.ImportTranslations(
+		r.Context(), sanitizedLanguage, sanitizedNamespace, format, mode, data)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, resp)
+	logger.Debug("Successfully imported translations",
+		log.String("language", sanitizedLanguage),
+		log.String("namespace", sanitizedNamespace),
+		log.String("mode", resp.Mode),
+		log.Int("added", len(resp.Added)),
+		log.Int("changed", len(resp.Changed)),
+		log.Int("missing", len(resp.Missing)))
+}
+
+// HandleGetMissingTranslationReport handles GET /i18n/reports/missing-translations
+func (h *i18nHandler) HandleGetMissingTranslationReport(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	sanitizedLanguage := sysutils.SanitizeString(r.URL.Query().Get("language"))
+	sanitizedNamespace := sysutils.SanitizeString(r.URL.Query().Get("namespace"))
+
+	resp, svcErr := h.i18nService.GetMissingTranslationReport(sanitizedLanguage, sanitizedNamespace)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, resp)
+	logger.Debug("Successfully retrieved missing translation report",
+		log.String("language", sanitizedLanguage),
+		log.String("namespace", sanitizedNamespace),
+		log.Int("totalResults", resp.TotalResults))
+}
+
+// getTranslationScope returns the translation override scope given through the app and ou query parameters.
+func getTranslationScope(r *http.Request) TranslationScope {
+	return TranslationScope{
+		AppID: sysutils.SanitizeString(r.URL.Query().Get("app")),
+		OUID:  sysutils.SanitizeString(r.URL.Query().Get("ou")),
+	}
+}
+
+// getBundleFormat returns the bundle format requested through the format query parameter, defaulting to JSON.
+func getBundleFormat(r *http.Request) string {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		return BundleFormatJSON
+	}
+	return format
+}
+
 // handleError handles service errors and returns appropriate HTTP responses.
 func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
 	statusCode := http.StatusInternalServerError
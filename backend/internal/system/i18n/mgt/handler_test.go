@@ -25,6 +25,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -82,7 +83,7 @@ func (suite *I18nHandlerTestSuite) TestHandleResolveTranslationsByLanguage_Succe
 			"common": {"welcome": "Welcome"},
 		},
 	}
-	suite.mockService.On("ResolveTranslations", "en-US", "common").
+	suite.mockService.On("ResolveTranslations", "en-US", "common", TranslationScope{}).
 		Return(expectedResp, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/i18n/languages/en-US/translations/resolve?namespace=common", nil)
@@ -99,7 +100,7 @@ func (suite *I18nHandlerTestSuite) TestHandleResolveTranslationsByLanguage_Succe
 }
 
 func (suite *I18nHandlerTestSuite) TestHandleResolveTranslationsByLanguage_ServiceError() {
-	suite.mockService.On("ResolveTranslations", "en-US", "").
+	suite.mockService.On("ResolveTranslations", "en-US", "", TranslationScope{}).
 		Return(nil, &ErrorInvalidLanguage)
 
 	req := httptest.NewRequest(http.MethodGet, "/i18n/languages/en-US/translations/resolve", nil)
@@ -167,7 +168,8 @@ func (suite *I18nHandlerTestSuite) TestHandleResolveTranslation_Success() {
 		Key:       "key",
 		Value:     "val",
 	}
-	suite.mockService.On("ResolveTranslationsForKey", "en-US", "ns", "key").Return(expectedResp, nil)
+	suite.mockService.On("ResolveTranslationsForKey", "en-US", "ns", "key", TranslationScope{}).
+		Return(expectedResp, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/i18n/languages/en-US/translations/ns/ns/keys/key/resolve", nil)
 	req.SetPathValue("language", "en-US")
@@ -189,7 +191,7 @@ func (suite *I18nHandlerTestSuite) TestHandleSetOverrideTranslation_Success() {
 		Value:     "new val",
 	}
 
-	suite.mockService.On("SetTranslationOverrideForKey", "en-US", "ns", "key", "new val").
+	suite.mockService.On("SetTranslationOverrideForKey", "en-US", "ns", "key", "new val", TranslationScope{}).
 		Return(expectedResp, nil)
 
 	body, _ := json.Marshal(request)
@@ -206,7 +208,7 @@ func (suite *I18nHandlerTestSuite) TestHandleSetOverrideTranslation_Success() {
 }
 
 func (suite *I18nHandlerTestSuite) TestHandleClearOverrideTranslation_Success() {
-	suite.mockService.On("ClearTranslationOverrideForKey", "en-US", "ns", "key").Return(nil)
+	suite.mockService.On("ClearTranslationOverrideForKey", "en-US", "ns", "key", TranslationScope{}).Return(nil)
 
 	req := httptest.NewRequest(http.MethodDelete, "/i18n/languages/en-US/translations/ns/ns/keys/key", nil)
 	req.SetPathValue("language", "en-US")
@@ -231,3 +233,141 @@ func (suite *I18nHandlerTestSuite) TestHandleError_NotFound() {
 
 	suite.Equal(http.StatusNotFound, w.Code)
 }
+
+func (suite *I18nHandlerTestSuite) TestHandleExportTranslations_XLIFF() {
+	content := []byte(`<xliff version="1.2"></xliff>`)
+	suite.mockService.On("ExportTranslations", "fr", "auth", BundleFormatXLIFF).Return(content, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/i18n/languages/fr/translations/ns/auth/export?format=xliff", nil)
+	req.SetPathValue("language", "fr")
+	req.SetPathValue("namespace", "auth")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleExportTranslations(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("application/xliff+xml", w.Header().Get("Content-Type"))
+	suite.Equal("attachment; filename=fr-auth.xlf", w.Header().Get("Content-Disposition"))
+	suite.Equal(content, w.Body.Bytes())
+}
+
+func (suite *I18nHandlerTestSuite) TestHandleExportTranslations_DefaultsToJSON() {
+	suite.mockService.On("ExportTranslations", "fr", "auth", BundleFormatJSON).
+		Return(nil, &ErrorInvalidNamespace)
+
+	req := httptest.NewRequest(http.MethodGet, "/i18n/languages/fr/translations/ns/auth/export", nil)
+	req.SetPathValue("language", "fr")
+	req.SetPathValue("namespace", "auth")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleExportTranslations(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *I18nHandlerTestSuite) TestHandleImportTranslations_Success() {
+	body := []byte(`{"translations":{"login.title":"Bienvenue"}}`)
+	expectedResp := &TranslationImportResponse{
+		Language:  "fr",
+		Namespace: "auth",
+		Mode:      ImportModeDiff,
+		Added:     []string{"login.title"},
+		Changed:   []string{},
+		Missing:   []string{},
+	}
+	suite.mockService.On("ImportTranslations", mock.Anything, "fr", "auth", BundleFormatJSON,
+		ImportModeDiff, body).Return(expectedResp, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/i18n/languages/fr/translations/ns/auth/import?mode=diff",
+		bytes.NewBuffer(body))
+	req.SetPathValue("language", "fr")
+	req.SetPathValue("namespace", "auth")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleImportTranslations(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var response TranslationImportResponse
+	suite.NoError(json.NewDecoder(w.Body).Decode(&response))
+	suite.Equal([]string{"login.title"}, response.Added)
+	suite.Equal(ImportModeDiff, response.Mode)
+}
+
+func (suite *I18nHandlerTestSuite) TestHandleImportTranslations_ServiceError() {
+	suite.mockService.On("ImportTranslations", mock.Anything, "fr", "auth", BundleFormatXLIFF, "",
+		mock.Anything).Return(nil, &ErrorInvalidBundle)
+
+	req := httptest.NewRequest(http.MethodPost, "/i18n/languages/fr/translations/ns/auth/import?format=xliff",
+		bytes.NewBufferString("<xliff"))
+	req.SetPathValue("language", "fr")
+	req.SetPathValue("namespace", "auth")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleImportTranslations(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *I18nHandlerTestSuite) TestHandleResolveTranslationsByLanguage_WithScope() {
+	expectedResp := &LanguageTranslationsResponse{Language: "en-US", TotalResults: 1}
+	suite.mockService.On("ResolveTranslations", "en-US", "",
+		TranslationScope{AppID: "app-1", OUID: "ou-1"}).Return(expectedResp, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/i18n/languages/en-US/translations/resolve?app=app-1&ou=ou-1", nil)
+	req.SetPathValue("language", "en-US")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleResolveTranslationsByLanguage(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *I18nHandlerTestSuite) TestHandleSetOverrideTranslation_WithScope() {
+	suite.mockService.On("SetTranslationOverrideForKey", "en-US", "ns", "key", "val",
+		TranslationScope{OUID: "ou-1"}).Return(nil, &ErrorInvalidTranslationScope)
+
+	body, _ := json.Marshal(SetTranslationRequest{Value: "val"})
+	req := httptest.NewRequest(http.MethodPost, "/i18n/languages/en-US/translations/ns/ns/keys/key?ou=ou-1",
+		bytes.NewBuffer(body))
+	req.SetPathValue("language", "en-US")
+	req.SetPathValue("namespace", "ns")
+	req.SetPathValue("key", "key")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleSetOverrideTranslation(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *I18nHandlerTestSuite) TestHandleGetMissingTranslationReport_Success() {
+	expectedResp := &MissingTranslationReportResponse{
+		TotalResults: 1,
+		Languages: []MissingTranslationLanguageReport{{
+			Language:     "fr",
+			MissingCount: 1,
+			Keys:         []MissingTranslation{{Namespace: "auth", Key: "title", Count: 2}},
+		}},
+	}
+	suite.mockService.On("GetMissingTranslationReport", "fr", "auth").Return(expectedResp, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/i18n/reports/missing-translations?language=fr&namespace=auth", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleGetMissingTranslationReport(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp MissingTranslationReportResponse
+	suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal(*expectedResp, resp)
+}
+
+func (suite *I18nHandlerTestSuite) TestHandleGetMissingTranslationReport_ServiceError() {
+	suite.mockService.On("GetMissingTranslationReport", "invalid_lang", "").Return(nil, &ErrorInvalidLanguage)
+
+	req := httptest.NewRequest(http.MethodGet, "/i18n/reports/missing-translations?language=invalid_lang", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleGetMissingTranslationReport(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
@@ -138,4 +138,52 @@ func registerRoutes(mux *http.ServeMux, handler *i18nHandler) {
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, singleEditOpts))
+
+	// Translation bundle operations
+	bundleExportOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS(
+		"GET /i18n/languages/{language}/translations/ns/{namespace}/export",
+		handler.HandleExportTranslations, bundleExportOpts))
+	mux.HandleFunc(middleware.WithCORS(
+		"OPTIONS /i18n/languages/{language}/translations/ns/{namespace}/export",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, bundleExportOpts))
+
+	bundleImportOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS(
+		"POST /i18n/languages/{language}/translations/ns/{namespace}/import",
+		handler.HandleImportTranslations, bundleImportOpts))
+	mux.HandleFunc(middleware.WithCORS(
+		"OPTIONS /i18n/languages/{language}/translations/ns/{namespace}/import",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, bundleImportOpts))
+
+	// Translation reports
+	reportOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET /i18n/reports/missing-translations",
+		handler.HandleGetMissingTranslationReport, reportOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /i18n/reports/missing-translations",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, reportOpts))
 }
@@ -159,4 +159,10 @@ func (suite *InitTestSuite) TestRegisterRoutes() {
 	checkRoute("POST", "/i18n/languages/en/translations/ns/ns1/keys/k1")
 	checkRoute("DELETE", "/i18n/languages/en/translations/ns/ns1/keys/k1")
 	checkRoute("OPTIONS", "/i18n/languages/en/translations/ns/ns1/keys/k1")
+	checkRoute("GET", "/i18n/languages/en/translations/ns/ns1/export")
+	checkRoute("OPTIONS", "/i18n/languages/en/translations/ns/ns1/export")
+	checkRoute("POST", "/i18n/languages/en/translations/ns/ns1/import")
+	checkRoute("OPTIONS", "/i18n/languages/en/translations/ns/ns1/import")
+	checkRoute("GET", "/i18n/reports/missing-translations")
+	checkRoute("OPTIONS", "/i18n/reports/missing-translations")
 }
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mgt
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	goi18n "golang.org/x/text/language"
+)
+
+// maxMissingTranslationEntries is the maximum number of distinct missing translations tracked across all
+// languages. Occurrences of translations that are already tracked are still counted once the limit is reached.
+const maxMissingTranslationEntries = 10000
+
+// missingTranslationEntry holds the aggregated occurrences of a translation missing for a language.
+type missingTranslationEntry struct {
+	namespace        string
+	key              string
+	fallbackLanguage string
+	count            int64
+	firstSeen        time.Time
+	lastSeen         time.Time
+}
+
+// missingTranslationTracker aggregates the translations resolved by falling back to the system language or
+// not resolved at all, per requested language. The aggregation is kept in memory of the server node.
+type missingTranslationTracker struct {
+	mu      sync.Mutex
+	entries map[string]map[string]*missingTranslationEntry
+	size    int
+}
+
+// newMissingTranslationTracker creates a new instance of missingTranslationTracker.
+func newMissingTranslationTracker() *missingTranslationTracker {
+	return &missingTranslationTracker{
+		entries: make(map[string]map[string]*missingTranslationEntry),
+	}
+}
+
+// record records an occurrence of a translation missing for the language. The fallback language is the
+// language the translation was resolved in, or empty if it could not be resolved.
+func (t *missingTranslationTracker) record(language string, namespace string, key string,
+	fallbackLanguage string) {
+	now := time.Now().UTC()
+	compositeKey := namespace + "|" + key
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry, exists := t.entries[language][compositeKey]
+	if !exists {
+		if t.size >= maxMissingTranslationEntries {
+			return
+		}
+		if t.entries[language] == nil {
+			t.entries[language] = make(map[string]*missingTranslationEntry)
+		}
+		entry = &missingTranslationEntry{namespace: namespace, key: key, firstSeen: now}
+		t.entries[language][compositeKey] = entry
+		t.size++
+	}
+	entry.fallbackLanguage = fallbackLanguage
+	entry.count++
+	entry.lastSeen = now
+}
+
+// report returns the tracked missing translations, optionally filtered by language and namespace,
+// ordered by language, namespace and key.
+func (t *missingTranslationTracker) report(language string, namespace string) *MissingTranslationReportResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	resp := &MissingTranslationReportResponse{Languages: []MissingTranslationLanguageReport{}}
+	for lang, entries := range t.entries {
+		if language != "" && lang != language {
+			continue
+		}
+
+		langReport := MissingTranslationLanguageReport{Language: lang, Keys: []MissingTranslation{}}
+		for _, entry := range entries {
+			if namespace != "" && entry.namespace != namespace {
+				continue
+			}
+			langReport.Keys = append(langReport.Keys, MissingTranslation{
+				Namespace:        entry.namespace,
+				Key:              entry.key,
+				FallbackLanguage: entry.fallbackLanguage,
+				Count:            entry.count,
+				FirstSeenAt:      entry.firstSeen.Format(time.RFC3339),
+				LastSeenAt:       entry.lastSeen.Format(time.RFC3339),
+			})
+		}
+		if len(langReport.Keys) == 0 {
+			continue
+		}
+
+		slices.SortFunc(langReport.Keys, func(a, b MissingTranslation) int {
+			if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Key, b.Key)
+		})
+		langReport.MissingCount = len(langReport.Keys)
+		resp.Languages = append(resp.Languages, langReport)
+		resp.TotalResults += langReport.MissingCount
+	}
+
+	slices.SortFunc(resp.Languages, func(a, b MissingTranslationLanguageReport) int {
+		return strings.Compare(a.Language, b.Language)
+	})
+	return resp
+}
+
+// isSystemLanguageFallback reports whether a translation resolved in the given language for the requested
+// language is a fallback to the system language. Variants of the system language, such as "en" for "en-US",
+// are not considered fallbacks.
+func isSystemLanguageFallback(language string, resolvedLanguage string) bool {
+	if resolvedLanguage != SystemLanguage {
+		return false
+	}
+	requestedBase, _ := goi18n.Make(language).Base()
+	systemBase, _ := goi18n.Make(SystemLanguage).Base()
+	return requestedBase != systemBase
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mgt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingTranslationTracker_Report(t *testing.T) {
+	tracker := newMissingTranslationTracker()
+	tracker.record("fr", "auth", "title", SystemLanguage)
+	tracker.record("fr", "auth", "title", SystemLanguage)
+	tracker.record("fr", "apps", "name", "")
+	tracker.record("de", "auth", "title", SystemLanguage)
+
+	report := tracker.report("", "")
+	assert.Equal(t, 3, report.TotalResults)
+	assert.Len(t, report.Languages, 2)
+	assert.Equal(t, "de", report.Languages[0].Language)
+	assert.Equal(t, "fr", report.Languages[1].Language)
+	assert.Equal(t, 2, report.Languages[1].MissingCount)
+	assert.Equal(t, "apps", report.Languages[1].Keys[0].Namespace)
+	assert.Equal(t, int64(2), report.Languages[1].Keys[1].Count)
+	assert.NotEmpty(t, report.Languages[1].Keys[1].FirstSeenAt)
+	assert.NotEmpty(t, report.Languages[1].Keys[1].LastSeenAt)
+
+	report = tracker.report("fr", "auth")
+	assert.Equal(t, 1, report.TotalResults)
+	assert.Equal(t, "title", report.Languages[0].Keys[0].Key)
+
+	report = tracker.report("", "unknown")
+	assert.Equal(t, 0, report.TotalResults)
+	assert.Empty(t, report.Languages)
+}
+
+func TestMissingTranslationTracker_Limit(t *testing.T) {
+	tracker := newMissingTranslationTracker()
+	tracker.size = maxMissingTranslationEntries - 1
+	tracker.record("fr", "auth", "title", SystemLanguage)
+	tracker.record("fr", "auth", "next", SystemLanguage)
+	tracker.record("fr", "auth", "title", SystemLanguage)
+
+	report := tracker.report("", "")
+	assert.Equal(t, 1, report.TotalResults)
+	assert.Equal(t, int64(2), report.Languages[0].Keys[0].Count)
+}
+
+func TestIsSystemLanguageFallback(t *testing.T) {
+	assert.True(t, isSystemLanguageFallback("fr", SystemLanguage))
+	assert.False(t, isSystemLanguageFallback("fr", "fr-FR"))
+	assert.False(t, isSystemLanguageFallback("en", SystemLanguage))
+	assert.False(t, isSystemLanguageFallback("en-GB", SystemLanguage))
+}
@@ -50,6 +50,48 @@ type SetTranslationsRequest struct {
 	Translations map[string]map[string]string `json:"translations"`
 }
 
+// TranslationBundle represents the JSON bundle of translations for a single language and namespace.
+type TranslationBundle struct {
+	Language     string            `json:"language"`
+	Namespace    string            `json:"namespace"`
+	Translations map[string]string `json:"translations"`
+}
+
+// TranslationImportResponse represents the result of importing a translation bundle.
+type TranslationImportResponse struct {
+	Language  string   `json:"language"`
+	Namespace string   `json:"namespace"`
+	Mode      string   `json:"mode"`
+	Added     []string `json:"added"`
+	Changed   []string `json:"changed"`
+	Missing   []string `json:"missing"`
+	Unchanged int      `json:"unchanged"`
+}
+
+// MissingTranslationReportResponse represents the report of translations missing per language.
+type MissingTranslationReportResponse struct {
+	TotalResults int                                `json:"totalResults"`
+	Languages    []MissingTranslationLanguageReport `json:"languages"`
+}
+
+// MissingTranslationLanguageReport represents the translations missing for a single language.
+type MissingTranslationLanguageReport struct {
+	Language     string               `json:"language"`
+	MissingCount int                  `json:"missingCount"`
+	Keys         []MissingTranslation `json:"keys"`
+}
+
+// MissingTranslation represents a translation that was resolved by falling back to the system language,
+// or could not be resolved, along with the number of times it was requested.
+type MissingTranslation struct {
+	Namespace        string `json:"namespace"`
+	Key              string `json:"key"`
+	FallbackLanguage string `json:"fallbackLanguage,omitempty"`
+	Count            int64  `json:"count"`
+	FirstSeenAt      string `json:"firstSeenAt"`
+	LastSeenAt       string `json:"lastSeenAt"`
+}
+
 // --- Service Models ---
 
 // Translation represents a translation entity in the service layer.
@@ -65,3 +107,11 @@ type LanguageTranslations struct {
 	Language     string                       `yaml:"language"`
 	Translations map[string]map[string]string `yaml:"translations"`
 }
+
+// TranslationScope identifies the application and organization unit whose translation overrides take
+// precedence over the translations of a language. Application overrides take precedence over
+// organization unit overrides. An empty scope resolves the translations without overrides.
+type TranslationScope struct {
+	AppID string
+	OUID  string
+}
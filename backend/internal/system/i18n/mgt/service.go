@@ -26,6 +26,7 @@ import (
 
 	goi18n "golang.org/x/text/language"
 
+	"github.com/thunder-id/thunderid/internal/system/config"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	sysi18n "github.com/thunder-id/thunderid/internal/system/i18n/core"
@@ -37,36 +38,62 @@ const loggerComponentName = "I18nMgtService"
 // I18nServiceInterface defines the interface for the i18n service.
 type I18nServiceInterface interface {
 	ListLanguages() ([]string, *serviceerror.ServiceError)
-	ResolveTranslations(language string, namespace string) (
+	ResolveTranslations(language string, namespace string, scope TranslationScope) (
 		*LanguageTranslationsResponse, *serviceerror.ServiceError)
 	SetTranslationOverrides(language string, translations map[string]map[string]string) (
 		*LanguageTranslationsResponse, *serviceerror.ServiceError)
 	ClearTranslationOverrides(language string) *serviceerror.ServiceError
-	ResolveTranslationsForKey(language string, namespace string, key string) (
-		*TranslationResponse, *serviceerror.ServiceError)
-	SetTranslationOverrideForKey(language string, namespace string, key string, value string) (
+	ResolveTranslationsForKey(language string, namespace string, key string, scope TranslationScope) (
 		*TranslationResponse, *serviceerror.ServiceError)
+	SetTranslationOverrideForKey(language string, namespace string, key string, value string,
+		scope TranslationScope) (*TranslationResponse, *serviceerror.ServiceError)
 	SetTranslationOverridesForNamespace(ctx context.Context, namespace string,
 		entries map[string]map[string]string) *serviceerror.ServiceError
-	ClearTranslationOverrideForKey(language string, namespace string, key string) *serviceerror.ServiceError
+	ClearTranslationOverrideForKey(language string, namespace string, key string,
+		scope TranslationScope) *serviceerror.ServiceError
 	DeleteTranslationsByNamespace(ctx context.Context, namespace string) *serviceerror.ServiceError
 	DeleteTranslationsByKey(ctx context.Context, namespace string, key string) *serviceerror.ServiceError
 	// GetTranslationsByNamespace returns all raw translations for a namespace as
 	// map[key]map[language]value without locale resolution or best-match logic.
 	GetTranslationsByNamespace(namespace string) (map[string]map[string]string, *serviceerror.ServiceError)
+	ExportTranslations(language string, namespace string, format string) ([]byte, *serviceerror.ServiceError)
+	ImportTranslations(ctx context.Context, language string, namespace string, format string, mode string,
+		data []byte) (*TranslationImportResponse, *serviceerror.ServiceError)
+	GetMissingTranslationReport(language string, namespace string) (
+		*MissingTranslationReportResponse, *serviceerror.ServiceError)
 }
 
 // i18nService is the default implementation of I18nServiceInterface.
 type i18nService struct {
-	store  i18nStoreInterface
-	logger *log.Logger
+	store                i18nStoreInterface
+	fallbackChains       map[string][]string
+	defaultFallbackChain []string
+	missingTranslations  *missingTranslationTracker
+	logger               *log.Logger
 }
 
 // newI18nService creates a new instance of i18nService with injected dependencies.
 func newI18nService(store i18nStoreInterface) I18nServiceInterface {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	i18nConfig := config.GetServerRuntime().Config.I18n
+
+	fallbackChains := make(map[string][]string, len(i18nConfig.FallbackChains))
+	for language, chain := range i18nConfig.FallbackChains {
+		normalisedLanguage, ok := NormaliseBCP47Tag(language)
+		if !ok {
+			logger.Warn("Ignoring fallback chain configured for an invalid language tag",
+				log.String("language", language))
+			continue
+		}
+		fallbackChains[normalisedLanguage] = normaliseFallbackChain(chain, logger)
+	}
+
 	return &i18nService{
-		store:  store,
-		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+		store:                store,
+		fallbackChains:       fallbackChains,
+		defaultFallbackChain: normaliseFallbackChain(i18nConfig.DefaultFallbackChain, logger),
+		missingTranslations:  newMissingTranslationTracker(),
+		logger:               logger,
 	}
 }
 
@@ -96,12 +123,15 @@ func (s *i18nService) ListLanguages() ([]string, *serviceerror.ServiceError) {
 }
 
 // ResolveTranslationsForKey resolves a single translation by language, namespace, and key.
-// It merges custom overrides with default values.
-func (s *i18nService) ResolveTranslationsForKey(
-	language string, namespace string, key string) (*TranslationResponse, *serviceerror.ServiceError) {
+// It merges custom overrides with default values. Overrides of the given scope take precedence.
+func (s *i18nService) ResolveTranslationsForKey(language string, namespace string, key string,
+	scope TranslationScope) (*TranslationResponse, *serviceerror.ServiceError) {
 	if err := validate(language, namespace, key); err != nil {
 		return nil, err
 	}
+	if !isValidScopeID(scope.AppID) || !isValidScopeID(scope.OUID) {
+		return nil, &ErrorInvalidTranslationScope
+	}
 
 	trans, err := s.store.GetTranslationsByKey(key, namespace)
 	if err != nil {
@@ -124,9 +154,15 @@ func (s *i18nService) ResolveTranslationsForKey(
 		}
 	}
 
-	requestedLang := goi18n.Make(language)
+	scopeOverrides, err := s.getScopeOverrides(scope, namespace)
+	if err != nil {
+		s.logger.Error("Failed to get translation overrides from store", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
 
-	bestTranslation := selectBestTranslation(trans, requestedLang)
+	bestTranslation := s.selectTranslation(trans, language,
+		getKeyOverrides(scopeOverrides, namespace+"|"+key)...)
+	s.trackMissingTranslation(language, namespace, key, bestTranslation)
 
 	if bestTranslation.Value != "" {
 		return &TranslationResponse{
@@ -141,9 +177,9 @@ func (s *i18nService) ResolveTranslationsForKey(
 }
 
 // SetTranslationOverrideForKey creates or updates a custom override for a single translation.
-func (s *i18nService) SetTranslationOverrideForKey(
-	language string, namespace string, key string, value string) (
-	*TranslationResponse, *serviceerror.ServiceError) {
+// When a scope is given the override only applies to the application or organization unit.
+func (s *i18nService) SetTranslationOverrideForKey(language string, namespace string, key string, value string,
+	scope TranslationScope) (*TranslationResponse, *serviceerror.ServiceError) {
 	if err := declarativeresource.CheckDeclarativeUpdate(); err != nil {
 		return nil, err
 	}
@@ -161,8 +197,16 @@ func (s *i18nService) SetTranslationOverrideForKey(
 		Value:     value,
 	}
 
-	// Use upsert to create or update
-	if err := s.store.UpsertTranslation(trans); err != nil {
+	if scope != (TranslationScope{}) {
+		scopeType, scopeID, svcErr := getOverrideScope(scope)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		if err := s.store.UpsertTranslationOverride(scopeType, scopeID, trans); err != nil {
+			s.logger.Error("Failed to set scoped translation override", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+	} else if err := s.store.UpsertTranslation(trans); err != nil {
 		s.logger.Error("Failed to set translation override", log.Error(err))
 		return nil, &serviceerror.InternalServerError
 	}
@@ -220,8 +264,9 @@ func (s *i18nService) SetTranslationOverridesForNamespace(
 }
 
 // ClearTranslationOverrideForKey removes the custom override for a single translation.
-func (s *i18nService) ClearTranslationOverrideForKey(
-	language string, namespace string, key string) *serviceerror.ServiceError {
+// When a scope is given only the override of the application or organization unit is removed.
+func (s *i18nService) ClearTranslationOverrideForKey(language string, namespace string, key string,
+	scope TranslationScope) *serviceerror.ServiceError {
 	if err := declarativeresource.CheckDeclarativeDelete(); err != nil {
 		return err
 	}
@@ -229,6 +274,18 @@ func (s *i18nService) ClearTranslationOverrideForKey(
 		return err
 	}
 
+	if scope != (TranslationScope{}) {
+		scopeType, scopeID, svcErr := getOverrideScope(scope)
+		if svcErr != nil {
+			return svcErr
+		}
+		if err := s.store.DeleteTranslationOverride(scopeType, scopeID, language, key, namespace); err != nil {
+			s.logger.Error("Failed to clear scoped translation override", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		return nil
+	}
+
 	if err := s.store.DeleteTranslation(language, key, namespace); err != nil {
 		s.logger.Error("Failed to clear translation override", log.Error(err))
 		return &serviceerror.InternalServerError
@@ -238,9 +295,9 @@ func (s *i18nService) ClearTranslationOverrideForKey(
 }
 
 // ResolveTranslations resolves all translations for a language, organized by namespace.
-// Merges custom overrides with default values.
-func (s *i18nService) ResolveTranslations(
-	language string, namespace string) (*LanguageTranslationsResponse, *serviceerror.ServiceError) {
+// Merges custom overrides with default values. Overrides of the given scope take precedence.
+func (s *i18nService) ResolveTranslations(language string, namespace string,
+	scope TranslationScope) (*LanguageTranslationsResponse, *serviceerror.ServiceError) {
 	if language == "" {
 		language = SystemLanguage
 	}
@@ -252,8 +309,9 @@ func (s *i18nService) ResolveTranslations(
 	if namespace != "" && !ValidateNamespace(namespace) {
 		return nil, &ErrorInvalidNamespace
 	}
-
-	requestedLang := goi18n.Make(language)
+	if !isValidScopeID(scope.AppID) || !isValidScopeID(scope.OUID) {
+		return nil, &ErrorInvalidTranslationScope
+	}
 
 	var allTranslations map[string]map[string]Translation
 	var err error
@@ -293,9 +351,24 @@ func (s *i18nService) ResolveTranslations(
 		}
 	}
 
+	scopeOverrides, err := s.getScopeOverrides(scope, namespace)
+	if err != nil {
+		s.logger.Error("Failed to get translation overrides from store", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	for _, overrides := range scopeOverrides {
+		for compositeKey := range overrides {
+			if allTranslations[compositeKey] == nil {
+				allTranslations[compositeKey] = make(map[string]Translation)
+			}
+		}
+	}
+
 	result := make(map[string]map[string]string)
-	for _, translations := range allTranslations {
-		translation := selectBestTranslation(translations, requestedLang)
+	for compositeKey, translations := range allTranslations {
+		translation := s.selectTranslation(translations, language, getKeyOverrides(scopeOverrides, compositeKey)...)
+		keyNamespace, key, _ := strings.Cut(compositeKey, "|")
+		s.trackMissingTranslation(language, keyNamespace, key, translation)
 
 		if translation.Value == "" {
 			continue
@@ -451,6 +524,185 @@ func (s *i18nService) GetTranslationsByNamespace(
 	return result, nil
 }
 
+// ExportTranslations serializes the translations of a language and namespace as a bundle of the given format.
+func (s *i18nService) ExportTranslations(
+	language string, namespace string, format string) ([]byte, *serviceerror.ServiceError) {
+	if err := validateBundleRequest(language, namespace, format); err != nil {
+		return nil, err
+	}
+
+	sources, targets, err := s.getBundleValues(language, namespace)
+	if err != nil {
+		s.logger.Error("Failed to get translations for export", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	content, err := encodeBundle(format, language, namespace, sources, targets)
+	if err != nil {
+		s.logger.Error("Failed to encode translation bundle", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return content, nil
+}
+
+// ImportTranslations compares a translation bundle with the stored translations of a language and namespace.
+// Keys that are added or changed by the bundle are stored unless the mode is diff. Keys that exist for the
+// language or the system language but are absent from the bundle are reported as missing and left untouched.
+func (s *i18nService) ImportTranslations(ctx context.Context, language string, namespace string, format string,
+	mode string, data []byte) (*TranslationImportResponse, *serviceerror.ServiceError) {
+	if err := validateBundleRequest(language, namespace, format); err != nil {
+		return nil, err
+	}
+	if mode == "" {
+		mode = ImportModeApply
+	}
+	if mode != ImportModeApply && mode != ImportModeDiff {
+		return nil, &ErrorInvalidImportMode
+	}
+	if mode == ImportModeApply {
+		if err := declarativeresource.CheckDeclarativeUpdate(); err != nil {
+			return nil, err
+		}
+	}
+
+	bundle, err := decodeBundle(format, data)
+	if err != nil {
+		s.logger.Debug("Failed to decode translation bundle", log.Error(err))
+		return nil, &ErrorInvalidBundle
+	}
+	if (bundle.Language != "" && bundle.Language != language) ||
+		(bundle.Namespace != "" && bundle.Namespace != namespace) {
+		return nil, &ErrorBundleMismatch
+	}
+	for key, value := range bundle.Translations {
+		if !ValidateKey(key) {
+			return nil, &ErrorInvalidKey
+		}
+		if value == "" {
+			return nil, &ErrorMissingValue
+		}
+	}
+
+	sources, targets, err := s.getBundleValues(language, namespace)
+	if err != nil {
+		s.logger.Error("Failed to get translations for import", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	resp := &TranslationImportResponse{
+		Language:  language,
+		Namespace: namespace,
+		Mode:      mode,
+		Added:     []string{},
+		Changed:   []string{},
+		Missing:   []string{},
+	}
+	translations := make([]Translation, 0)
+	for key, value := range bundle.Translations {
+		current, exists := targets[key]
+		switch {
+		case !exists:
+			resp.Added = append(resp.Added, key)
+		case current != value:
+			resp.Changed = append(resp.Changed, key)
+		default:
+			resp.Unchanged++
+			continue
+		}
+		translations = append(translations, Translation{
+			Key:       key,
+			Language:  language,
+			Namespace: namespace,
+			Value:     value,
+		})
+	}
+	missing := make(map[string]struct{})
+	for _, values := range []map[string]string{sources, targets} {
+		for key := range values {
+			if _, exists := bundle.Translations[key]; !exists {
+				missing[key] = struct{}{}
+			}
+		}
+	}
+	for key := range missing {
+		resp.Missing = append(resp.Missing, key)
+	}
+	slices.Sort(resp.Added)
+	slices.Sort(resp.Changed)
+	slices.Sort(resp.Missing)
+
+	if mode == ImportModeApply && len(translations) > 0 {
+		if err := s.store.UpsertTranslations(ctx, translations); err != nil {
+			s.logger.Error("Failed to store imported translations", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+	}
+
+	return resp, nil
+}
+
+// GetMissingTranslationReport returns the translations resolved by falling back to the system language or
+// not resolved at all since the server started, aggregated per requested language. The report can be
+// filtered by language and namespace.
+func (s *i18nService) GetMissingTranslationReport(language string, namespace string) (
+	*MissingTranslationReportResponse, *serviceerror.ServiceError) {
+	if language != "" && !ValidateLanguage(language) {
+		return nil, &ErrorInvalidLanguage
+	}
+	if namespace != "" && !ValidateNamespace(namespace) {
+		return nil, &ErrorInvalidNamespace
+	}
+
+	return s.missingTranslations.report(language, namespace), nil
+}
+
+// trackMissingTranslation records the resolved translation of a key as missing for the language if it was
+// resolved by falling back to the system language or could not be resolved.
+func (s *i18nService) trackMissingTranslation(language string, namespace string, key string,
+	translation Translation) {
+	if translation.Value == "" {
+		s.missingTranslations.record(language, namespace, key, "")
+		return
+	}
+	if isSystemLanguageFallback(language, translation.Language) {
+		s.missingTranslations.record(language, namespace, key, translation.Language)
+	}
+}
+
+// getBundleValues returns the system language values and the values of the given language for a namespace,
+// both keyed by translation key. System defaults are included for the system namespace.
+func (s *i18nService) getBundleValues(language string, namespace string) (
+	map[string]string, map[string]string, error) {
+	byNs, err := s.store.GetTranslationsByNamespace(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sources := make(map[string]string)
+	targets := make(map[string]string)
+	for _, langs := range byNs {
+		if trans, exists := langs[SystemLanguage]; exists {
+			sources[trans.Key] = trans.Value
+		}
+		if trans, exists := langs[language]; exists {
+			targets[trans.Key] = trans.Value
+		}
+	}
+
+	if namespace == SystemNamespace {
+		for key, value := range sysi18n.GetAllDefaults() {
+			if _, exists := sources[key]; !exists {
+				sources[key] = value
+			}
+			if _, exists := targets[key]; !exists && language == SystemLanguage {
+				targets[key] = value
+			}
+		}
+	}
+
+	return sources, targets, nil
+}
+
 func (s *i18nService) clearAllOverrides(language string) error {
 	err := s.store.DeleteTranslationsByLanguage(language)
 	if err != nil {
@@ -459,6 +711,114 @@ func (s *i18nService) clearAllOverrides(language string) error {
 	return nil
 }
 
+// selectTranslation selects the translation for the requested language. For the language and each language of
+// its configured fallback chain, in order, the overrides are tried before the available translations. The closest
+// matching available language is used when none of them has a translation.
+func (s *i18nService) selectTranslation(availableTranslations map[string]Translation, language string,
+	overrides ...map[string]Translation) Translation {
+	chain := s.getFallbackChain(language)
+	if chain == nil {
+		chain = []string{language}
+	}
+	for _, candidate := range chain {
+		for _, override := range overrides {
+			if trans, exists := override[candidate]; exists {
+				return trans
+			}
+		}
+		if trans, exists := availableTranslations[candidate]; exists {
+			return trans
+		}
+	}
+	return selectBestTranslation(availableTranslations, goi18n.Make(language))
+}
+
+// getScopeOverrides returns the translation overrides of the application and organization unit of the scope,
+// in order of precedence.
+func (s *i18nService) getScopeOverrides(scope TranslationScope, namespace string) (
+	[]map[string]map[string]Translation, error) {
+	scopeOverrides := make([]map[string]map[string]Translation, 0, 2)
+	for _, scopeEntry := range []struct{ scopeType, scopeID string }{
+		{translationScopeTypeApp, scope.AppID},
+		{translationScopeTypeOU, scope.OUID},
+	} {
+		if scopeEntry.scopeID == "" {
+			continue
+		}
+		overrides, err := s.store.GetTranslationOverrides(scopeEntry.scopeType, scopeEntry.scopeID, namespace)
+		if err != nil {
+			return nil, err
+		}
+		scopeOverrides = append(scopeOverrides, overrides)
+	}
+	return scopeOverrides, nil
+}
+
+// getFallbackChain returns the ordered languages to try for the given language, starting with the language
+// itself. Returns nil when no fallback chain applies to the language.
+func (s *i18nService) getFallbackChain(language string) []string {
+	chain, exists := s.fallbackChains[language]
+	if !exists && len(s.defaultFallbackChain) == 0 {
+		return nil
+	}
+
+	result := []string{language}
+	for _, candidate := range slices.Concat(chain, s.defaultFallbackChain) {
+		if !slices.Contains(result, candidate) {
+			result = append(result, candidate)
+		}
+	}
+	return result
+}
+
+// normaliseFallbackChain returns the canonical form of the language tags in a fallback chain,
+// skipping invalid tags.
+func normaliseFallbackChain(chain []string, logger *log.Logger) []string {
+	result := make([]string, 0, len(chain))
+	for _, language := range chain {
+		normalisedLanguage, ok := NormaliseBCP47Tag(language)
+		if !ok {
+			logger.Warn("Ignoring invalid language tag in fallback chain", log.String("language", language))
+			continue
+		}
+		result = append(result, normalisedLanguage)
+	}
+	return result
+}
+
+// getKeyOverrides returns the overrides of a composite key from each scope, in order of precedence.
+func getKeyOverrides(scopeOverrides []map[string]map[string]Translation, compositeKey string) []map[string]Translation {
+	keyOverrides := make([]map[string]Translation, 0, len(scopeOverrides))
+	for _, overrides := range scopeOverrides {
+		if langs, exists := overrides[compositeKey]; exists {
+			keyOverrides = append(keyOverrides, langs)
+		}
+	}
+	return keyOverrides
+}
+
+// getOverrideScope returns the scope type and identifier for managing a scoped translation override.
+// Exactly one of the application or the organization unit must be given.
+func getOverrideScope(scope TranslationScope) (string, string, *serviceerror.ServiceError) {
+	if !isValidScopeID(scope.AppID) || !isValidScopeID(scope.OUID) {
+		return "", "", &ErrorInvalidTranslationScope
+	}
+	switch {
+	case scope.AppID != "" && scope.OUID == "":
+		return translationScopeTypeApp, scope.AppID, nil
+	case scope.OUID != "" && scope.AppID == "":
+		return translationScopeTypeOU, scope.OUID, nil
+	default:
+		return "", "", &ErrorInvalidTranslationScope
+	}
+}
+
+// isValidScopeID reports whether an application or organization unit identifier of a scope is valid.
+// An empty identifier is valid as the scope is optional.
+func isValidScopeID(scopeID string) bool {
+	return len(scopeID) <= maxScopeIDLength
+}
+
 func validate(language string, namespace string, key string) *serviceerror.ServiceError {
 	if language == "" {
 		return &ErrorMissingLanguage
@@ -475,6 +835,22 @@ func validate(language string, namespace string, key string) *serviceerror.Servi
 	return nil
 }
 
+func validateBundleRequest(language string, namespace string, format string) *serviceerror.ServiceError {
+	if language == "" {
+		return &ErrorMissingLanguage
+	}
+	if !ValidateLanguage(language) {
+		return &ErrorInvalidLanguage
+	}
+	if !ValidateNamespace(namespace) {
+		return &ErrorInvalidNamespace
+	}
+	if !IsValidBundleFormat(format) {
+		return &ErrorInvalidBundleFormat
+	}
+	return nil
+}
+
 func selectBestTranslation(availableTranslations map[string]Translation, requestedLang goi18n.Tag) Translation {
 	if len(availableTranslations) == 0 {
 		return Translation{}
@@ -21,6 +21,7 @@ package mgt
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -108,7 +109,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_Success_From
 
 	suite.mockStore.On("GetTranslationsByKey", "welcome", "common").Return(translationsMap, nil)
 
-	result, err := suite.service.ResolveTranslationsForKey("en-US", "common", "welcome")
+	result, err := suite.service.ResolveTranslationsForKey("en-US", "common", "welcome", TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -131,7 +132,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_ValidationEr
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			result, err := suite.service.ResolveTranslationsForKey(tc.lang, tc.namespace, tc.key)
+			result, err := suite.service.ResolveTranslationsForKey(tc.lang, tc.namespace, tc.key, TranslationScope{})
 			suite.Nil(result)
 			suite.NotNil(err)
 			suite.Equal(tc.errCode, err.Code)
@@ -142,7 +143,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_ValidationEr
 func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_NotFound() {
 	suite.mockStore.On("GetTranslationsByKey", "unknown", "common").Return((map[string]Translation)(nil), nil)
 
-	result, err := suite.service.ResolveTranslationsForKey("en-US", "common", "unknown")
+	result, err := suite.service.ResolveTranslationsForKey("en-US", "common", "unknown", TranslationScope{})
 
 	suite.Nil(result)
 	suite.NotNil(err)
@@ -152,7 +153,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_NotFound() {
 func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_StoreError() {
 	suite.mockStore.On("GetTranslationsByKey", "welcome", "common").Return(nil, errors.New("db error"))
 
-	result, err := suite.service.ResolveTranslationsForKey("en-US", "common", "welcome")
+	result, err := suite.service.ResolveTranslationsForKey("en-US", "common", "welcome", TranslationScope{})
 
 	suite.Nil(result)
 	suite.NotNil(err)
@@ -166,7 +167,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_UsesSystemDe
 	suite.mockStore.On("GetTranslationsByKey", key, SystemNamespace).Return(make(map[string]Translation), nil)
 
 	// Request en-US, expecting fallback to system default (en)
-	result, err := suite.service.ResolveTranslationsForKey("en-US", SystemNamespace, key)
+	result, err := suite.service.ResolveTranslationsForKey("en-US", SystemNamespace, key, TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -194,7 +195,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_SystemDefaul
 	suite.mockStore.On("GetTranslationsByKey", key, SystemNamespace).Return(dbTranslations, nil)
 
 	// Request en-US, expecting fallback to system default (en)
-	result, err := suite.service.ResolveTranslationsForKey("en-US", SystemNamespace, key)
+	result, err := suite.service.ResolveTranslationsForKey("en-US", SystemNamespace, key, TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -220,7 +221,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_UsesDBValue_
 	suite.mockStore.On("GetTranslationsByKey", key, SystemNamespace).Return(dbTranslations, nil)
 
 	// Request en-US, expecting fallback to system default (en)
-	result, err := suite.service.ResolveTranslationsForKey("en-US", SystemNamespace, key)
+	result, err := suite.service.ResolveTranslationsForKey("en-US", SystemNamespace, key, TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -234,7 +235,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_UsesDBValue_
 func (suite *I18nMgtServiceTestSuite) TestSetTranslationOverrideForKey_Success() {
 	suite.mockStore.On("UpsertTranslation", mock.AnythingOfType("mgt.Translation")).Return(nil)
 
-	result, err := suite.service.SetTranslationOverrideForKey("en-US", "common", "welcome", "Hello")
+	result, err := suite.service.SetTranslationOverrideForKey("en-US", "common", "welcome", "Hello", TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -243,30 +244,31 @@ func (suite *I18nMgtServiceTestSuite) TestSetTranslationOverrideForKey_Success()
 
 func (suite *I18nMgtServiceTestSuite) TestSetTranslationOverrideForKey_ValidationErrors() {
 	// Simple check for one validation case as others share logic
-	result, err := suite.service.SetTranslationOverrideForKey("", "ns", "key", "val")
+	result, err := suite.service.SetTranslationOverrideForKey("", "ns", "key", "val", TranslationScope{})
 	suite.Nil(result)
 	suite.NotNil(err)
 	suite.Equal(ErrorMissingLanguage.Code, err.Code)
 
 	// Invalid Lang
-	result, err = suite.service.SetTranslationOverrideForKey("invalid", "ns", "key", "val")
+	result, err = suite.service.SetTranslationOverrideForKey("invalid", "ns", "key", "val", TranslationScope{})
 	suite.Nil(result)
 	suite.NotNil(err)
 	suite.Equal(ErrorInvalidLanguage.Code, err.Code)
 
 	// Invalid Namespace
-	result, err = suite.service.SetTranslationOverrideForKey("en-US", "invalid!", "key", "val")
+	result, err = suite.service.SetTranslationOverrideForKey("en-US", "invalid!", "key", "val", TranslationScope{})
 	suite.Nil(result)
 	suite.NotNil(err)
 	suite.Equal(ErrorInvalidNamespace.Code, err.Code)
 
 	// Invalid Key
-	result, err = suite.service.SetTranslationOverrideForKey("en-US", "common", "invalid key!", "val")
+	result, err = suite.service.SetTranslationOverrideForKey("en-US", "common", "invalid key!", "val",
+		TranslationScope{})
 	suite.Nil(result)
 	suite.NotNil(err)
 	suite.Equal(ErrorInvalidKey.Code, err.Code)
 
-	result, err = suite.service.SetTranslationOverrideForKey("en-US", "ns", "key", "")
+	result, err = suite.service.SetTranslationOverrideForKey("en-US", "ns", "key", "", TranslationScope{})
 	suite.Nil(result)
 	suite.NotNil(err)
 	suite.Equal(ErrorMissingValue.Code, err.Code)
@@ -275,7 +277,7 @@ func (suite *I18nMgtServiceTestSuite) TestSetTranslationOverrideForKey_Validatio
 func (suite *I18nMgtServiceTestSuite) TestSetTranslationOverrideForKey_StoreError() {
 	suite.mockStore.On("UpsertTranslation", mock.AnythingOfType("mgt.Translation")).Return(errors.New("db error"))
 
-	result, err := suite.service.SetTranslationOverrideForKey("en-US", "common", "welcome", "Hello")
+	result, err := suite.service.SetTranslationOverrideForKey("en-US", "common", "welcome", "Hello", TranslationScope{})
 
 	suite.Nil(result)
 	suite.NotNil(err)
@@ -289,7 +291,7 @@ func (suite *I18nMgtServiceTestSuite) TestSetTranslationOverrideForKey_Declarati
 		config.GetServerRuntime().Config.DeclarativeResources.Enabled = false
 	}()
 
-	result, err := suite.service.SetTranslationOverrideForKey("en-US", "common", "welcome", "Hello")
+	result, err := suite.service.SetTranslationOverrideForKey("en-US", "common", "welcome", "Hello", TranslationScope{})
 
 	suite.Nil(result)
 	suite.NotNil(err)
@@ -300,25 +302,25 @@ func (suite *I18nMgtServiceTestSuite) TestSetTranslationOverrideForKey_Declarati
 func (suite *I18nMgtServiceTestSuite) TestClearTranslationOverrideForKey_Success() {
 	suite.mockStore.On("DeleteTranslation", "en-US", "welcome", "common").Return(nil)
 
-	err := suite.service.ClearTranslationOverrideForKey("en-US", "common", "welcome")
+	err := suite.service.ClearTranslationOverrideForKey("en-US", "common", "welcome", TranslationScope{})
 
 	suite.Nil(err)
 }
 
 func (suite *I18nMgtServiceTestSuite) TestClearTranslationOverrideForKey_ValidationErrors() {
-	err := suite.service.ClearTranslationOverrideForKey("", "ns", "key")
+	err := suite.service.ClearTranslationOverrideForKey("", "ns", "key", TranslationScope{})
 	suite.NotNil(err)
 	suite.Equal(ErrorMissingLanguage.Code, err.Code)
 
-	err = suite.service.ClearTranslationOverrideForKey("invalid", "ns", "key")
+	err = suite.service.ClearTranslationOverrideForKey("invalid", "ns", "key", TranslationScope{})
 	suite.NotNil(err)
 	suite.Equal(ErrorInvalidLanguage.Code, err.Code)
 
-	err = suite.service.ClearTranslationOverrideForKey("en-US", "invalid!", "key")
+	err = suite.service.ClearTranslationOverrideForKey("en-US", "invalid!", "key", TranslationScope{})
 	suite.NotNil(err)
 	suite.Equal(ErrorInvalidNamespace.Code, err.Code)
 
-	err = suite.service.ClearTranslationOverrideForKey("en-US", "ns", "invalid key!")
+	err = suite.service.ClearTranslationOverrideForKey("en-US", "ns", "invalid key!", TranslationScope{})
 	suite.NotNil(err)
 	suite.Equal(ErrorInvalidKey.Code, err.Code)
 }
@@ -326,7 +328,7 @@ func (suite *I18nMgtServiceTestSuite) TestClearTranslationOverrideForKey_Validat
 func (suite *I18nMgtServiceTestSuite) TestClearTranslationOverrideForKey_StoreError() {
 	suite.mockStore.On("DeleteTranslation", "en-US", "welcome", "common").Return(errors.New("db error"))
 
-	err := suite.service.ClearTranslationOverrideForKey("en-US", "common", "welcome")
+	err := suite.service.ClearTranslationOverrideForKey("en-US", "common", "welcome", TranslationScope{})
 
 	suite.NotNil(err)
 	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
@@ -339,7 +341,7 @@ func (suite *I18nMgtServiceTestSuite) TestClearTranslationOverrideForKey_Declara
 		config.GetServerRuntime().Config.DeclarativeResources.Enabled = false
 	}()
 
-	err := suite.service.ClearTranslationOverrideForKey("en-US", "common", "welcome")
+	err := suite.service.ClearTranslationOverrideForKey("en-US", "common", "welcome", TranslationScope{})
 
 	suite.NotNil(err)
 	suite.Equal(declarativeresource.ErrorDeclarativeResourceDeleteOperation.Code, err.Code)
@@ -363,7 +365,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_CustomNamespace_Su
 
 	suite.mockStore.On("GetTranslationsByNamespace", "console").Return(mockDataCorrect, nil)
 
-	result, err := suite.service.ResolveTranslations("en-US", "console")
+	result, err := suite.service.ResolveTranslations("en-US", "console", TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -372,7 +374,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_CustomNamespace_Su
 }
 
 func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_InvalidNamespace() {
-	result, err := suite.service.ResolveTranslations("en-US", "invalid!")
+	result, err := suite.service.ResolveTranslations("en-US", "invalid!", TranslationScope{})
 
 	suite.Nil(result)
 	suite.NotNil(err)
@@ -382,7 +384,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_InvalidNamespace()
 func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_StoreError() {
 	suite.mockStore.On("GetTranslationsByNamespace", "console").Return(nil, errors.New("db error"))
 
-	result, err := suite.service.ResolveTranslations("en-US", "console")
+	result, err := suite.service.ResolveTranslations("en-US", "console", TranslationScope{})
 
 	suite.Nil(result)
 	suite.NotNil(err)
@@ -397,7 +399,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_UsesSystemDefaults
 	key := testErrKey
 	expectedDefaultValue := testErrVal
 
-	result, err := suite.service.ResolveTranslations("en-US", "system")
+	result, err := suite.service.ResolveTranslations("en-US", "system", TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -423,7 +425,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_UsesSystemDefaults
 
 	suite.mockStore.On("GetTranslationsByNamespace", "system").Return(dbTranslations, nil)
 
-	result, err := suite.service.ResolveTranslations("en-US", "system")
+	result, err := suite.service.ResolveTranslations("en-US", "system", TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -448,7 +450,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_UsesDBValue_WhenDB
 
 	suite.mockStore.On("GetTranslationsByNamespace", "system").Return(dbTranslations, nil)
 
-	result, err := suite.service.ResolveTranslations("en-US", "system")
+	result, err := suite.service.ResolveTranslations("en-US", "system", TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -461,7 +463,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_DefaultsFallback()
 	suite.mockStore.On("GetTranslationsByNamespace", "system").
 		Return(make(map[string]map[string]Translation), nil)
 
-	result, err := suite.service.ResolveTranslations("fr-FR", "system")
+	result, err := suite.service.ResolveTranslations("fr-FR", "system", TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -483,7 +485,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_MergeLogic() {
 
 	suite.mockStore.On("GetTranslationsByNamespace", "system").Return(dbTranslations, nil)
 
-	result, err := suite.service.ResolveTranslations("en-US", "system")
+	result, err := suite.service.ResolveTranslations("en-US", "system", TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -501,7 +503,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_AllNamespaces() {
 
 	suite.mockStore.On("GetTranslations").Return(dbTranslations, nil)
 
-	result, err := suite.service.ResolveTranslations("en-US", "")
+	result, err := suite.service.ResolveTranslations("en-US", "", TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -512,7 +514,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_AllNamespaces() {
 func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_AllNamespaces_StoreError() {
 	suite.mockStore.On("GetTranslations").Return(nil, errors.New("db error"))
 
-	result, err := suite.service.ResolveTranslations("en-US", "")
+	result, err := suite.service.ResolveTranslations("en-US", "", TranslationScope{})
 
 	suite.Nil(result)
 	suite.NotNil(err)
@@ -534,7 +536,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_AppNamespace_Exact
 	}
 	suite.mockStore.On("GetTranslationsByNamespace", ns).Return(dbTranslations, nil)
 
-	result, err := suite.service.ResolveTranslations("fr", ns)
+	result, err := suite.service.ResolveTranslations("fr", ns, TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -554,7 +556,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_AppNamespace_BestM
 	}
 	suite.mockStore.On("GetTranslationsByNamespace", ns).Return(dbTranslations, nil)
 
-	result, err := suite.service.ResolveTranslations("en", ns)
+	result, err := suite.service.ResolveTranslations("en", ns, TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -573,7 +575,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_SystemNamespace_St
 	suite.mockStore.On("GetTranslationsByNamespace", SystemNamespace).Return(dbTranslations, nil)
 
 	// Request "en-US" — no en-US stored, but system defaults fill it in.
-	result, err := suite.service.ResolveTranslations("en-US", SystemNamespace)
+	result, err := suite.service.ResolveTranslations("en-US", SystemNamespace, TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -593,7 +595,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_AppNamespace
 		"fr": {Key: key, Namespace: ns, Language: "fr", Value: "Mon Application"},
 	}, nil)
 
-	result, err := suite.service.ResolveTranslationsForKey("fr", ns, key)
+	result, err := suite.service.ResolveTranslationsForKey("fr", ns, key, TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -611,7 +613,7 @@ func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_AppNamespace
 		"fr": {Key: key, Namespace: ns, Language: "fr", Value: "Mon Application"},
 	}, nil)
 
-	result, err := suite.service.ResolveTranslationsForKey("en", ns, key)
+	result, err := suite.service.ResolveTranslationsForKey("en", ns, key, TranslationScope{})
 
 	suite.Nil(err)
 	suite.NotNil(result)
@@ -922,3 +924,482 @@ func (suite *I18nMgtServiceTestSuite) TestDeleteTranslationsByKey_Success() {
 
 	suite.Nil(err)
 }
+
+// ExportTranslations Tests
+func (suite *I18nMgtServiceTestSuite) TestExportTranslations_JSON() {
+	suite.mockStore.On("GetTranslationsByNamespace", "auth").Return(map[string]map[string]Translation{
+		"auth|login.title": {
+			"en-US": {Key: "login.title", Language: "en-US", Namespace: "auth", Value: "Welcome"},
+			"fr":    {Key: "login.title", Language: "fr", Namespace: "auth", Value: "Bienvenue"},
+		},
+		"auth|login.button": {
+			"en-US": {Key: "login.button", Language: "en-US", Namespace: "auth", Value: "Sign in"},
+		},
+	}, nil)
+
+	content, err := suite.service.ExportTranslations("fr", "auth", BundleFormatJSON)
+
+	suite.Nil(err)
+	bundle, decodeErr := decodeBundle(BundleFormatJSON, content)
+	suite.NoError(decodeErr)
+	suite.Equal("fr", bundle.Language)
+	suite.Equal("auth", bundle.Namespace)
+	suite.Equal(map[string]string{"login.title": "Bienvenue"}, bundle.Translations)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestExportTranslations_XLIFFIncludesSystemDefaults() {
+	suite.mockStore.On("GetTranslationsByNamespace", SystemNamespace).
+		Return(map[string]map[string]Translation{}, nil)
+
+	content, err := suite.service.ExportTranslations("fr", SystemNamespace, BundleFormatXLIFF)
+
+	suite.Nil(err)
+	suite.Contains(string(content), `<trans-unit id="`+testErrKey+`">`)
+	suite.Contains(string(content), "<source>"+testErrVal+"</source>")
+}
+
+func (suite *I18nMgtServiceTestSuite) TestExportTranslations_ValidationErrors() {
+	testCases := []struct {
+		name      string
+		language  string
+		namespace string
+		format    string
+		expected  string
+	}{
+		{"MissingLanguage", "", "auth", BundleFormatJSON, ErrorMissingLanguage.Code},
+		{"InvalidLanguage", "en_us", "auth", BundleFormatJSON, ErrorInvalidLanguage.Code},
+		{"InvalidNamespace", "fr", "auth!", BundleFormatJSON, ErrorInvalidNamespace.Code},
+		{"InvalidFormat", "fr", "auth", "yaml", ErrorInvalidBundleFormat.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			content, err := suite.service.ExportTranslations(tc.language, tc.namespace, tc.format)
+			suite.Nil(content)
+			suite.NotNil(err)
+			suite.Equal(tc.expected, err.Code)
+		})
+	}
+}
+
+func (suite *I18nMgtServiceTestSuite) TestExportTranslations_StoreError() {
+	suite.mockStore.On("GetTranslationsByNamespace", "auth").Return(nil, errors.New("db error"))
+
+	content, err := suite.service.ExportTranslations("fr", "auth", BundleFormatJSON)
+
+	suite.Nil(content)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+// ImportTranslations Tests
+func (suite *I18nMgtServiceTestSuite) getImportStoreData() map[string]map[string]Translation {
+	return map[string]map[string]Translation{
+		"auth|login.title": {
+			"en-US": {Key: "login.title", Language: "en-US", Namespace: "auth", Value: "Welcome"},
+			"fr":    {Key: "login.title", Language: "fr", Namespace: "auth", Value: "Bienvenue"},
+		},
+		"auth|login.button": {
+			"en-US": {Key: "login.button", Language: "en-US", Namespace: "auth", Value: "Sign in"},
+			"fr":    {Key: "login.button", Language: "fr", Namespace: "auth", Value: "Connexion"},
+		},
+		"auth|logout.button": {
+			"en-US": {Key: "logout.button", Language: "en-US", Namespace: "auth", Value: "Sign out"},
+		},
+	}
+}
+
+func (suite *I18nMgtServiceTestSuite) TestImportTranslations_Apply() {
+	suite.mockStore.On("GetTranslationsByNamespace", "auth").Return(suite.getImportStoreData(), nil)
+	suite.mockStore.On("UpsertTranslations", mock.Anything, mock.MatchedBy(func(translations []Translation) bool {
+		if len(translations) != 2 {
+			return false
+		}
+		values := map[string]string{}
+		for _, t := range translations {
+			if t.Language != "fr" || t.Namespace != "auth" {
+				return false
+			}
+			values[t.Key] = t.Value
+		}
+		return values["login.button"] == "Se connecter" && values["help.link"] == "Aide"
+	})).Return(nil)
+
+	data := []byte(`{"language":"fr","namespace":"auth","translations":{` +
+		`"login.title":"Bienvenue","login.button":"Se connecter","help.link":"Aide"}}`)
+	result, err := suite.service.ImportTranslations(context.Background(), "fr", "auth",
+		BundleFormatJSON, "", data)
+
+	suite.Nil(err)
+	suite.Equal(ImportModeApply, result.Mode)
+	suite.Equal([]string{"help.link"}, result.Added)
+	suite.Equal([]string{"login.button"}, result.Changed)
+	suite.Equal([]string{"logout.button"}, result.Missing)
+	suite.Equal(1, result.Unchanged)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestImportTranslations_DiffDoesNotStore() {
+	suite.mockStore.On("GetTranslationsByNamespace", "auth").Return(suite.getImportStoreData(), nil)
+
+	data := []byte(`<xliff version="1.2"><file original="auth" source-language="en-US" target-language="fr">` +
+		`<body><trans-unit id="login.title"><source>Welcome</source><target>Bienvenue!</target></trans-unit>` +
+		`<trans-unit id="logout.button"><source>Sign out</source></trans-unit></body></file></xliff>`)
+	result, err := suite.service.ImportTranslations(context.Background(), "fr", "auth",
+		BundleFormatXLIFF, ImportModeDiff, data)
+
+	suite.Nil(err)
+	suite.Equal(ImportModeDiff, result.Mode)
+	suite.Empty(result.Added)
+	suite.Equal([]string{"login.title"}, result.Changed)
+	suite.Equal([]string{"login.button", "logout.button"}, result.Missing)
+	suite.mockStore.AssertNotCalled(suite.T(), "UpsertTranslations", mock.Anything, mock.Anything)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestImportTranslations_NothingToStore() {
+	suite.mockStore.On("GetTranslationsByNamespace", "auth").Return(suite.getImportStoreData(), nil)
+
+	data := []byte(`{"translations":{"login.title":"Bienvenue","login.button":"Connexion"}}`)
+	result, err := suite.service.ImportTranslations(context.Background(), "fr", "auth",
+		BundleFormatJSON, ImportModeApply, data)
+
+	suite.Nil(err)
+	suite.Equal(2, result.Unchanged)
+	suite.mockStore.AssertNotCalled(suite.T(), "UpsertTranslations", mock.Anything, mock.Anything)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestImportTranslations_ValidationErrors() {
+	testCases := []struct {
+		name     string
+		language string
+		format   string
+		mode     string
+		data     string
+		expected string
+	}{
+		{"InvalidLanguage", "fr_FR", BundleFormatJSON, "", `{"translations":{}}`, ErrorInvalidLanguage.Code},
+		{"InvalidFormat", "fr", "csv", "", `{"translations":{}}`, ErrorInvalidBundleFormat.Code},
+		{"InvalidMode", "fr", BundleFormatJSON, "merge", `{"translations":{}}`, ErrorInvalidImportMode.Code},
+		{"MalformedBundle", "fr", BundleFormatJSON, "", `{"translations":`, ErrorInvalidBundle.Code},
+		{"LanguageMismatch", "fr", BundleFormatJSON, "", `{"language":"de","translations":{}}`,
+			ErrorBundleMismatch.Code},
+		{"NamespaceMismatch", "fr", BundleFormatJSON, "", `{"namespace":"other","translations":{}}`,
+			ErrorBundleMismatch.Code},
+		{"InvalidKey", "fr", BundleFormatJSON, "", `{"translations":{"bad key":"x"}}`, ErrorInvalidKey.Code},
+		{"EmptyValue", "fr", BundleFormatJSON, "", `{"translations":{"login.title":""}}`, ErrorMissingValue.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			result, err := suite.service.ImportTranslations(context.Background(), tc.language, "auth",
+				tc.format, tc.mode, []byte(tc.data))
+			suite.Nil(result)
+			suite.NotNil(err)
+			suite.Equal(tc.expected, err.Code)
+		})
+	}
+}
+
+func (suite *I18nMgtServiceTestSuite) TestImportTranslations_StoreErrors() {
+	data := []byte(`{"translations":{"help.link":"Aide"}}`)
+
+	suite.Run("GetError", func() {
+		suite.mockStore.On("GetTranslationsByNamespace", "auth").Return(nil, errors.New("db error")).Once()
+
+		result, err := suite.service.ImportTranslations(context.Background(), "fr", "auth",
+			BundleFormatJSON, ImportModeApply, data)
+		suite.Nil(result)
+		suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+	})
+
+	suite.Run("UpsertError", func() {
+		suite.mockStore.On("GetTranslationsByNamespace", "auth").Return(suite.getImportStoreData(), nil).Once()
+		suite.mockStore.On("UpsertTranslations", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+		result, err := suite.service.ImportTranslations(context.Background(), "fr", "auth",
+			BundleFormatJSON, ImportModeApply, data)
+		suite.Nil(result)
+		suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+	})
+}
+
+func (suite *I18nMgtServiceTestSuite) TestImportTranslations_Declarative() {
+	// Enable declarative mode
+	config.GetServerRuntime().Config.DeclarativeResources.Enabled = true
+	defer func() {
+		config.GetServerRuntime().Config.DeclarativeResources.Enabled = false
+	}()
+
+	result, err := suite.service.ImportTranslations(context.Background(), "fr", "auth",
+		BundleFormatJSON, ImportModeApply, []byte(`{"translations":{}}`))
+
+	suite.Nil(result)
+	suite.Equal(declarativeresource.ErrorDeclarativeResourceUpdateOperation.Code, err.Code)
+}
+
+// Fallback chain Tests
+func (suite *I18nMgtServiceTestSuite) newServiceWithFallbackChains(
+	chains map[string][]string, defaultChain []string) I18nServiceInterface {
+	config.GetServerRuntime().Config.I18n = config.I18nConfig{
+		FallbackChains:       chains,
+		DefaultFallbackChain: defaultChain,
+	}
+	return newI18nService(suite.mockStore)
+}
+
+func (suite *I18nMgtServiceTestSuite) getFallbackStoreData() map[string]map[string]Translation {
+	return map[string]map[string]Translation{
+		"console|title": {
+			"en-US": {Key: "title", Language: "en-US", Namespace: "console", Value: "Title"},
+			"fr":    {Key: "title", Language: "fr", Namespace: "console", Value: "Titre"},
+			"fr-FR": {Key: "title", Language: "fr-FR", Namespace: "console", Value: "Titre FR"},
+			"es":    {Key: "title", Language: "es", Namespace: "console", Value: "Título"},
+		},
+	}
+}
+
+func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_UsesFallbackChain() {
+	service := suite.newServiceWithFallbackChains(map[string][]string{"fr-ca": {"es", "fr"}}, nil)
+	suite.mockStore.On("GetTranslationsByNamespace", "console").Return(suite.getFallbackStoreData(), nil)
+
+	result, err := service.ResolveTranslations("fr-CA", "console", TranslationScope{})
+
+	suite.Nil(err)
+	suite.Equal("Título", result.Translations["console"]["title"])
+}
+
+func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_UsesDefaultFallbackChain() {
+	service := suite.newServiceWithFallbackChains(map[string][]string{"pt-BR": {"pt"}}, []string{"es"})
+	suite.mockStore.On("GetTranslationsByKey", "title", "console").
+		Return(suite.getFallbackStoreData()["console|title"], nil)
+
+	result, err := service.ResolveTranslationsForKey("pt-BR", "console", "title", TranslationScope{})
+
+	suite.Nil(err)
+	suite.Equal("Título", result.Value)
+	suite.Equal("pt-BR", result.Language)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_FallbackChainFallsBackToBestMatch() {
+	service := suite.newServiceWithFallbackChains(map[string][]string{"fr-CA": {"de"}}, nil)
+	suite.mockStore.On("GetTranslationsByNamespace", "console").Return(suite.getFallbackStoreData(), nil)
+
+	result, err := service.ResolveTranslations("fr-CA", "console", TranslationScope{})
+
+	suite.Nil(err)
+	suite.Contains([]string{"Titre", "Titre FR"}, result.Translations["console"]["title"])
+}
+
+func (suite *I18nMgtServiceTestSuite) TestGetFallbackChain() {
+	service := suite.newServiceWithFallbackChains(
+		map[string][]string{"fr-CA": {"fr", "invalid_tag", "en-us"}, "not a tag": {"en"}},
+		[]string{"en-US", "fr"}).(*i18nService)
+
+	suite.Equal([]string{"fr-CA", "fr", "en-US"}, service.getFallbackChain("fr-CA"))
+	suite.Equal([]string{"de", "en-US", "fr"}, service.getFallbackChain("de"))
+	suite.Len(service.fallbackChains, 1)
+
+	service = suite.newServiceWithFallbackChains(nil, nil).(*i18nService)
+	suite.Nil(service.getFallbackChain("fr-CA"))
+}
+
+// Scoped translation override Tests
+func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_ScopedOverridesTakePrecedence() {
+	suite.mockStore.On("GetTranslationsByNamespace", "console").Return(map[string]map[string]Translation{
+		"console|title": {
+			"en-US": {Key: "title", Language: "en-US", Namespace: "console", Value: "Title"},
+		},
+		"console|button": {
+			"en-US": {Key: "button", Language: "en-US", Namespace: "console", Value: "Continue"},
+		},
+	}, nil)
+	suite.mockStore.On("GetTranslationOverrides", translationScopeTypeApp, "app-1", "console").
+		Return(map[string]map[string]Translation{
+			"console|title": {
+				"en-US": {Key: "title", Language: "en-US", Namespace: "console", Value: "App Title"},
+			},
+		}, nil)
+	suite.mockStore.On("GetTranslationOverrides", translationScopeTypeOU, "ou-1", "console").
+		Return(map[string]map[string]Translation{
+			"console|title": {
+				"en-US": {Key: "title", Language: "en-US", Namespace: "console", Value: "OU Title"},
+			},
+			"console|product": {
+				"en-US": {Key: "product", Language: "en-US", Namespace: "console", Value: "Acme"},
+				"fr":    {Key: "product", Language: "fr", Namespace: "console", Value: "Acme FR"},
+			},
+		}, nil)
+
+	result, err := suite.service.ResolveTranslations("en-US", "console",
+		TranslationScope{AppID: "app-1", OUID: "ou-1"})
+
+	suite.Nil(err)
+	suite.Equal(3, result.TotalResults)
+	suite.Equal("App Title", result.Translations["console"]["title"])
+	suite.Equal("Continue", result.Translations["console"]["button"])
+	suite.Equal("Acme", result.Translations["console"]["product"])
+}
+
+func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_OverrideInOtherLanguageIsIgnored() {
+	suite.mockStore.On("GetTranslationsByNamespace", "console").Return(map[string]map[string]Translation{
+		"console|title": {
+			"fr": {Key: "title", Language: "fr", Namespace: "console", Value: "Titre"},
+		},
+	}, nil)
+	suite.mockStore.On("GetTranslationOverrides", translationScopeTypeOU, "ou-1", "console").
+		Return(map[string]map[string]Translation{
+			"console|title": {
+				"en-US": {Key: "title", Language: "en-US", Namespace: "console", Value: "OU Title"},
+			},
+		}, nil)
+
+	result, err := suite.service.ResolveTranslations("fr", "console", TranslationScope{OUID: "ou-1"})
+
+	suite.Nil(err)
+	suite.Equal("Titre", result.Translations["console"]["title"])
+}
+
+func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_OverrideStoreError() {
+	suite.mockStore.On("GetTranslationsByNamespace", "console").Return(map[string]map[string]Translation{}, nil)
+	suite.mockStore.On("GetTranslationOverrides", translationScopeTypeApp, "app-1", "console").
+		Return(nil, errors.New("db error"))
+
+	result, err := suite.service.ResolveTranslations("en-US", "console", TranslationScope{AppID: "app-1"})
+
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_ScopedOverride() {
+	suite.mockStore.On("GetTranslationsByKey", "title", "console").Return(map[string]Translation{
+		"en-US": {Key: "title", Language: "en-US", Namespace: "console", Value: "Title"},
+	}, nil)
+	suite.mockStore.On("GetTranslationOverrides", translationScopeTypeApp, "app-1", "console").
+		Return(map[string]map[string]Translation{
+			"console|title": {
+				"en-US": {Key: "title", Language: "en-US", Namespace: "console", Value: "App Title"},
+			},
+		}, nil)
+
+	result, err := suite.service.ResolveTranslationsForKey("en-US", "console", "title",
+		TranslationScope{AppID: "app-1"})
+
+	suite.Nil(err)
+	suite.Equal("App Title", result.Value)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestResolve_InvalidScope() {
+	scope := TranslationScope{AppID: strings.Repeat("a", maxScopeIDLength+1)}
+
+	result, err := suite.service.ResolveTranslations("en-US", "console", scope)
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidTranslationScope.Code, err.Code)
+
+	keyResult, err := suite.service.ResolveTranslationsForKey("en-US", "console", "title", scope)
+	suite.Nil(keyResult)
+	suite.Equal(ErrorInvalidTranslationScope.Code, err.Code)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestSetTranslationOverrideForKey_Scoped() {
+	suite.mockStore.On("UpsertTranslationOverride", translationScopeTypeOU, "ou-1",
+		Translation{Key: "title", Language: "en-US", Namespace: "console", Value: "OU Title"}).Return(nil)
+
+	result, err := suite.service.SetTranslationOverrideForKey("en-US", "console", "title", "OU Title",
+		TranslationScope{OUID: "ou-1"})
+
+	suite.Nil(err)
+	suite.Equal("OU Title", result.Value)
+	suite.mockStore.AssertNotCalled(suite.T(), "UpsertTranslation", mock.Anything)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestSetTranslationOverrideForKey_ScopedErrors() {
+	result, err := suite.service.SetTranslationOverrideForKey("en-US", "console", "title", "Title",
+		TranslationScope{AppID: "app-1", OUID: "ou-1"})
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidTranslationScope.Code, err.Code)
+
+	suite.mockStore.On("UpsertTranslationOverride", translationScopeTypeApp, "app-1", mock.Anything).
+		Return(errors.New("db error"))
+	result, err = suite.service.SetTranslationOverrideForKey("en-US", "console", "title", "Title",
+		TranslationScope{AppID: "app-1"})
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestClearTranslationOverrideForKey_Scoped() {
+	suite.mockStore.On("DeleteTranslationOverride", translationScopeTypeApp, "app-1", "en-US", "title", "console").
+		Return(nil).Once()
+
+	err := suite.service.ClearTranslationOverrideForKey("en-US", "console", "title", TranslationScope{AppID: "app-1"})
+	suite.Nil(err)
+	suite.mockStore.AssertNotCalled(suite.T(), "DeleteTranslation", mock.Anything, mock.Anything, mock.Anything)
+
+	suite.mockStore.On("DeleteTranslationOverride", translationScopeTypeApp, "app-1", "en-US", "title", "console").
+		Return(errors.New("db error")).Once()
+	err = suite.service.ClearTranslationOverrideForKey("en-US", "console", "title", TranslationScope{AppID: "app-1"})
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+
+	err = suite.service.ClearTranslationOverrideForKey("en-US", "console", "title",
+		TranslationScope{AppID: "app-1", OUID: "ou-1"})
+	suite.Equal(ErrorInvalidTranslationScope.Code, err.Code)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestResolveTranslationsForKey_TracksMissingTranslations() {
+	suite.mockStore.On("GetTranslationsByKey", "title", "auth").Return(map[string]Translation{
+		"en-US": {Key: "title", Namespace: "auth", Language: "en-US", Value: "Sign In"},
+	}, nil)
+	suite.mockStore.On("GetTranslationsByKey", "unknown", "auth").Return(map[string]Translation{}, nil)
+
+	for range 2 {
+		_, err := suite.service.ResolveTranslationsForKey("fr", "auth", "title", TranslationScope{})
+		suite.Nil(err)
+	}
+	_, err := suite.service.ResolveTranslationsForKey("en", "auth", "title", TranslationScope{})
+	suite.Nil(err)
+	_, err = suite.service.ResolveTranslationsForKey("fr", "auth", "unknown", TranslationScope{})
+	suite.Equal(ErrorTranslationNotFound.Code, err.Code)
+
+	report, err := suite.service.GetMissingTranslationReport("", "")
+	suite.Nil(err)
+	suite.Equal(2, report.TotalResults)
+	suite.Len(report.Languages, 1)
+	suite.Equal("fr", report.Languages[0].Language)
+	suite.Equal(2, report.Languages[0].MissingCount)
+
+	keys := report.Languages[0].Keys
+	suite.Equal("title", keys[0].Key)
+	suite.Equal(SystemLanguage, keys[0].FallbackLanguage)
+	suite.Equal(int64(2), keys[0].Count)
+	suite.Equal("unknown", keys[1].Key)
+	suite.Empty(keys[1].FallbackLanguage)
+	suite.Equal(int64(1), keys[1].Count)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestResolveTranslations_TracksMissingTranslations() {
+	dbTranslations := map[string]map[string]Translation{
+		"auth|title": {"en-US": {Key: "title", Namespace: "auth", Language: "en-US", Value: "Sign In"}},
+		"auth|next":  {"de": {Key: "next", Namespace: "auth", Language: "de", Value: "Weiter"}},
+	}
+	suite.mockStore.On("GetTranslationsByNamespace", "auth").Return(dbTranslations, nil)
+
+	_, err := suite.service.ResolveTranslations("de", "auth", TranslationScope{})
+	suite.Nil(err)
+
+	report, err := suite.service.GetMissingTranslationReport("de", "auth")
+	suite.Nil(err)
+	suite.Equal(1, report.TotalResults)
+	suite.Equal("title", report.Languages[0].Keys[0].Key)
+
+	report, err = suite.service.GetMissingTranslationReport("fr", "")
+	suite.Nil(err)
+	suite.Equal(0, report.TotalResults)
+	suite.Empty(report.Languages)
+}
+
+func (suite *I18nMgtServiceTestSuite) TestGetMissingTranslationReport_ValidationErrors() {
+	result, err := suite.service.GetMissingTranslationReport("invalid_lang", "")
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidLanguage.Code, err.Code)
+
+	result, err = suite.service.GetMissingTranslationReport("", "invalid ns")
+	suite.Nil(result)
+	suite.Equal(ErrorInvalidNamespace.Code, err.Code)
+}
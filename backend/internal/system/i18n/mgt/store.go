@@ -40,6 +40,10 @@ type i18nStoreInterface interface {
 	DeleteTranslation(language string, key string, namespace string) error
 	DeleteTranslationsByNamespace(ctx context.Context, namespace string) error
 	DeleteTranslationsByKey(ctx context.Context, namespace string, key string) error
+	GetTranslationOverrides(scopeType string, scopeID string, namespace string) (
+		map[string]map[string]Translation, error)
+	UpsertTranslationOverride(scopeType string, scopeID string, trans Translation) error
+	DeleteTranslationOverride(scopeType string, scopeID string, language string, key string, namespace string) error
 }
 
 // i18nStore is the default implementation of i18nStoreInterface.
@@ -267,6 +271,54 @@ func (s *i18nStore) DeleteTranslationsByNamespace(ctx context.Context, namespace
 	return nil
 }
 
+// GetTranslationOverrides retrieves the translation overrides of a scope. All namespaces are
+// returned when the namespace is empty.
+func (s *i18nStore) GetTranslationOverrides(scopeType string, scopeID string, namespace string) (
+	map[string]map[string]Translation, error) {
+	dbClient, err := s.getDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dbClient.Query(queryGetTranslationOverrides, scopeType, scopeID, namespace, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get translation overrides: %w", err)
+	}
+
+	return transformResults(results)
+}
+
+// UpsertTranslationOverride creates or updates a translation override of a scope.
+func (s *i18nStore) UpsertTranslationOverride(scopeType string, scopeID string, trans Translation) error {
+	dbClient, err := s.getDBClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.Execute(queryUpsertTranslationOverride, scopeType, scopeID, trans.Key, trans.Language,
+		trans.Namespace, trans.Value, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to upsert translation override: %w", err)
+	}
+	return nil
+}
+
+// DeleteTranslationOverride deletes a translation override of a scope.
+func (s *i18nStore) DeleteTranslationOverride(
+	scopeType string, scopeID string, language string, key string, namespace string) error {
+	dbClient, err := s.getDBClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.Execute(queryDeleteTranslationOverride, scopeType, scopeID, language, key, namespace,
+		s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete translation override: %w", err)
+	}
+	return nil
+}
+
 // buildTranslationFromRow constructs a Translation from a database result row.
 func buildTranslationFromRow(row map[string]interface{}) (*Translation, error) {
 	key, ok := row["message_key"].(string)
@@ -95,4 +95,32 @@ var (
 		ID:    "I18N-10",
 		Query: `DELETE FROM "TRANSLATION" WHERE NAMESPACE = $1 AND MESSAGE_KEY = $2 AND DEPLOYMENT_ID = $3`,
 	}
+
+	// queryGetTranslationOverrides retrieves the translation overrides of a scope, optionally filtered by namespace.
+	queryGetTranslationOverrides = dbmodel.DBQuery{
+		ID: "I18N-11",
+		Query: `SELECT MESSAGE_KEY, LANGUAGE_CODE, NAMESPACE, VALUE FROM "TRANSLATION_OVERRIDE" ` +
+			`WHERE SCOPE_TYPE = $1 AND SCOPE_ID = $2 AND ($3 = '' OR NAMESPACE = $3) AND DEPLOYMENT_ID = $4 ` +
+			`ORDER BY MESSAGE_KEY`,
+	}
+
+	// queryUpsertTranslationOverride inserts or updates a translation override of a scope.
+	queryUpsertTranslationOverride = dbmodel.DBQuery{
+		ID: "I18N-12",
+		Query: `INSERT INTO "TRANSLATION_OVERRIDE" (SCOPE_TYPE, SCOPE_ID, MESSAGE_KEY, LANGUAGE_CODE, NAMESPACE, ` +
+			`VALUE, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7) ` +
+			`ON CONFLICT (DEPLOYMENT_ID, SCOPE_TYPE, SCOPE_ID, NAMESPACE, MESSAGE_KEY, LANGUAGE_CODE) ` +
+			`DO UPDATE SET VALUE = EXCLUDED.VALUE, UPDATED_AT = NOW()`,
+		SQLiteQuery: `INSERT INTO "TRANSLATION_OVERRIDE" (SCOPE_TYPE, SCOPE_ID, MESSAGE_KEY, LANGUAGE_CODE, ` +
+			`NAMESPACE, VALUE, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7) ` +
+			`ON CONFLICT (DEPLOYMENT_ID, SCOPE_TYPE, SCOPE_ID, NAMESPACE, MESSAGE_KEY, LANGUAGE_CODE) ` +
+			`DO UPDATE SET VALUE = excluded.VALUE, UPDATED_AT = datetime('now')`,
+	}
+
+	// queryDeleteTranslationOverride deletes a translation override of a scope.
+	queryDeleteTranslationOverride = dbmodel.DBQuery{
+		ID: "I18N-13",
+		Query: `DELETE FROM "TRANSLATION_OVERRIDE" WHERE SCOPE_TYPE = $1 AND SCOPE_ID = $2 ` +
+			`AND LANGUAGE_CODE = $3 AND MESSAGE_KEY = $4 AND NAMESPACE = $5 AND DEPLOYMENT_ID = $6`,
+	}
 )
@@ -518,3 +518,60 @@ func (suite *I18nStoreTestSuite) TestDeleteTranslationsByKey_Error() {
 	suite.Error(err)
 	suite.Contains(err.Error(), "failed to delete translations by namespace and key")
 }
+
+// Translation override Tests
+func (suite *I18nStoreTestSuite) TestGetTranslationOverrides_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("Query", queryGetTranslationOverrides, translationScopeTypeApp, "app-1", "ns1",
+		testDeploymentID).Return([]map[string]interface{}{
+		{"message_key": "k1", "language_code": "en-US", "namespace": "ns1", "value": "v1"},
+	}, nil)
+
+	overrides, err := suite.store.GetTranslationOverrides(translationScopeTypeApp, "app-1", "ns1")
+
+	suite.NoError(err)
+	suite.Equal("v1", overrides["ns1|k1"]["en-US"].Value)
+}
+
+func (suite *I18nStoreTestSuite) TestGetTranslationOverrides_QueryError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("Query", queryGetTranslationOverrides, translationScopeTypeOU, "ou-1", "",
+		testDeploymentID).Return(nil, errors.New("db error"))
+
+	overrides, err := suite.store.GetTranslationOverrides(translationScopeTypeOU, "ou-1", "")
+
+	suite.Error(err)
+	suite.Nil(overrides)
+}
+
+func (suite *I18nStoreTestSuite) TestUpsertTranslationOverride() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("Execute", queryUpsertTranslationOverride, translationScopeTypeApp, "app-1", "k", "en",
+		"ns", "v", testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.UpsertTranslationOverride(translationScopeTypeApp, "app-1",
+		Translation{Key: "k", Language: "en", Namespace: "ns", Value: "v"})
+	suite.NoError(err)
+
+	suite.mockDBClient.On("Execute", queryUpsertTranslationOverride, translationScopeTypeApp, "app-1", "k", "en",
+		"ns", "v", testDeploymentID).Return(int64(0), errors.New("db error")).Once()
+
+	err = suite.store.UpsertTranslationOverride(translationScopeTypeApp, "app-1",
+		Translation{Key: "k", Language: "en", Namespace: "ns", Value: "v"})
+	suite.Error(err)
+}
+
+func (suite *I18nStoreTestSuite) TestDeleteTranslationOverride() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("Execute", queryDeleteTranslationOverride, translationScopeTypeOU, "ou-1", "en", "k",
+		"ns", testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.DeleteTranslationOverride(translationScopeTypeOU, "ou-1", "en", "k", "ns")
+	suite.NoError(err)
+
+	suite.mockDBClient.On("Execute", queryDeleteTranslationOverride, translationScopeTypeOU, "ou-1", "en", "k",
+		"ns", testDeploymentID).Return(int64(0), errors.New("db error")).Once()
+
+	err = suite.store.DeleteTranslationOverride(translationScopeTypeOU, "ou-1", "en", "k", "ns")
+	suite.Error(err)
+}
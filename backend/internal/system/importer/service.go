@@ -802,6 +802,7 @@ func applicationRequestToDTO(req *appmodel.ApplicationRequestWithID) *appmodel.A
 			Assertion:                 req.Assertion,
 			LoginConsent:              req.LoginConsent,
 			AllowedUserTypes:          req.AllowedUserTypes,
+			LoginExperience:           req.LoginExperience,
 			Certificate:               req.Certificate,
 		},
 		Template:  req.Template,
@@ -826,6 +827,11 @@ func applicationRequestToDTO(req *appmodel.ApplicationRequestWithID) *appmodel.A
 					ClientID:                           config.OAuthConfig.ClientID,
 					ClientSecret:                       config.OAuthConfig.ClientSecret,
 					RedirectURIs:                       config.OAuthConfig.RedirectURIs,
+					RedirectURIPolicy:                  config.OAuthConfig.RedirectURIPolicy,
+					BackchannelAuthentication:          config.OAuthConfig.BackchannelAuthentication,
+					PostLogoutRedirectURIs:             config.OAuthConfig.PostLogoutRedirectURIs,
+					BackchannelLogoutURI:               config.OAuthConfig.BackchannelLogoutURI,
+					FrontchannelLogoutURI:              config.OAuthConfig.FrontchannelLogoutURI,
 					GrantTypes:                         config.OAuthConfig.GrantTypes,
 					ResponseTypes:                      config.OAuthConfig.ResponseTypes,
 					TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
@@ -838,6 +844,7 @@ func applicationRequestToDTO(req *appmodel.ApplicationRequestWithID) *appmodel.A
 					ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 					Certificate:                        config.OAuthConfig.Certificate,
 					AcrValues:                          config.OAuthConfig.AcrValues,
+					AcrFlows:                           config.OAuthConfig.AcrFlows,
 				},
 			})
 		}
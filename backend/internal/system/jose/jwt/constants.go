@@ -18,10 +18,25 @@
 
 package jwt
 
+import "time"
+
 const (
 	// TokenTypeJWT is the standard JWT type header value used for general-purpose JWTs.
 	TokenTypeJWT = "JWT"
 
 	// TokenTypeAccessToken is the JWT type header value for access tokens as defined in RFC 9068.
 	TokenTypeAccessToken = "at+jwt"
+
+	// TokenTypeLogoutToken is the JWT type header value for back-channel logout tokens.
+	TokenTypeLogoutToken = "logout+jwt"
+)
+
+const (
+	// jwksRefreshAheadRatio is the fraction of the JWKS cache TTL after which cached keys are refreshed
+	// in the background, so that verifications do not wait for a fetch when the cached keys expire.
+	jwksRefreshAheadRatio = 0.8
+
+	// jwksRefetchMinInterval is the minimum time between fetches of a JWKS triggered by a token signed with
+	// an unknown key ID. It prevents tokens with arbitrary key IDs from forcing a fetch on every verification.
+	jwksRefetchMinInterval = 30 * time.Second
 )
@@ -32,6 +32,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -58,15 +59,20 @@ type JWTServiceInterface interface {
 	VerifyJWTSignatureWithJWKS(jwtToken string, jwksURL string) *serviceerror.ServiceError
 }
 
-// jwksCacheEntry holds a cached JWKS response with its expiry time.
+// jwksCacheEntry holds a cached JWKS response with its refresh and expiry times.
 type jwksCacheEntry struct {
-	keys      []map[string]interface{}
-	expiresAt time.Time
+	keys       []map[string]interface{}
+	fetchedAt  time.Time
+	refreshAt  time.Time
+	expiresAt  time.Time
+	refreshing atomic.Bool
 }
 
 // jwtService implements the JWTServiceInterface for generating and managing JWT tokens.
 type jwtService struct {
 	cryptoProvider kmprovider.RuntimeCryptoProvider
+	pkiService     pkiservice.PKIServiceInterface
+	mu             sync.RWMutex
 	keyRef         kmprovider.KeyRef
 	publicKey      crypto.PublicKey
 	signAlg        cryptolab.SignAlgorithm
@@ -74,9 +80,19 @@ type jwtService struct {
 	kid            string
 	logger         *log.Logger
 	jwksCache      sync.Map
+	issuerKeys     sync.Map
 	httpClient     httpservice.HTTPClientInterface
 }
 
+// signingKey holds the key material used to sign and verify the JWTs issued by the server.
+type signingKey struct {
+	keyRef    kmprovider.KeyRef
+	publicKey crypto.PublicKey
+	signAlg   cryptolab.SignAlgorithm
+	jwsAlg    jws.Algorithm
+	kid       string
+}
+
 // newJWTService creates a new JWT service instance.
 func newJWTService(
 	pkiService pkiservice.PKIServiceInterface,
@@ -84,84 +100,162 @@ func newJWTService(
 ) (JWTServiceInterface, error) {
 	preferredKid := config.GetServerRuntime().Config.JWT.PreferredKeyID
 
-	privateKey, err := pkiService.GetPrivateKey(preferredKid)
+	key, err := loadSigningKey(pkiService, preferredKid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jwtService{
+		cryptoProvider: cryptoProvider,
+		pkiService:     pkiService,
+		keyRef:         key.keyRef,
+		publicKey:      key.publicKey,
+		signAlg:        key.signAlg,
+		jwsAlg:         key.jwsAlg,
+		kid:            key.kid,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, "JWTService")),
+		httpClient:     httpClient,
+	}, nil
+}
+
+// loadSigningKey loads the key with the given ID from the PKI service and resolves its algorithms.
+func loadSigningKey(pkiService pkiservice.PKIServiceInterface, keyID string) (signingKey, error) {
+	privateKey, err := pkiService.GetPrivateKey(keyID)
 	if err != nil {
-		return nil, errors.New("failed to retrieve private key for the key id: " + preferredKid)
+		return signingKey{}, errors.New("failed to retrieve private key for the key id: " + keyID)
 	}
 
-	kid := pkiService.GetCertThumbprint(preferredKid)
-	keyRef := kmprovider.KeyRef{KeyID: preferredKid}
-	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "JWTService"))
+	publicKey, signAlg, jwsAlg, algErr := getKeyAlgorithms(privateKey)
+	if algErr != nil {
+		return signingKey{}, algErr
+	}
 
-	// Get algorithm based on the type of private key
+	return signingKey{
+		keyRef:    kmprovider.KeyRef{KeyID: keyID},
+		publicKey: publicKey,
+		signAlg:   signAlg,
+		jwsAlg:    jwsAlg,
+		kid:       pkiService.GetCertThumbprint(keyID),
+	}, nil
+}
+
+// getKeyAlgorithms returns the public key and the signing algorithms based on the type of the private key.
+func getKeyAlgorithms(
+	privateKey crypto.PrivateKey,
+) (crypto.PublicKey, cryptolab.SignAlgorithm, jws.Algorithm, error) {
 	switch k := privateKey.(type) {
 	case *rsa.PrivateKey:
-		return &jwtService{
-			cryptoProvider: cryptoProvider,
-			keyRef:         keyRef,
-			publicKey:      &k.PublicKey,
-			signAlg:        cryptolab.RSASHA256,
-			jwsAlg:         jws.RS256,
-			kid:            kid,
-			logger:         logger,
-			httpClient:     httpClient,
-		}, nil
+		return &k.PublicKey, cryptolab.RSASHA256, jws.RS256, nil
 	case *ecdsa.PrivateKey:
 		// Determine ECDSA algorithm based on curve
 		crvName := k.Curve.Params().Name
 		switch crvName {
 		case jws.P256:
-			return &jwtService{
-				cryptoProvider: cryptoProvider,
-				keyRef:         keyRef,
-				publicKey:      &k.PublicKey,
-				signAlg:        cryptolab.ECDSASHA256,
-				jwsAlg:         jws.ES256,
-				kid:            kid,
-				logger:         logger,
-				httpClient:     httpClient,
-			}, nil
+			return &k.PublicKey, cryptolab.ECDSASHA256, jws.ES256, nil
 		case jws.P384:
-			return &jwtService{
-				cryptoProvider: cryptoProvider,
-				keyRef:         keyRef,
-				publicKey:      &k.PublicKey,
-				signAlg:        cryptolab.ECDSASHA384,
-				jwsAlg:         jws.ES384,
-				kid:            kid,
-				logger:         logger,
-				httpClient:     httpClient,
-			}, nil
+			return &k.PublicKey, cryptolab.ECDSASHA384, jws.ES384, nil
 		case jws.P521:
-			return &jwtService{
-				cryptoProvider: cryptoProvider,
-				keyRef:         keyRef,
-				publicKey:      &k.PublicKey,
-				signAlg:        cryptolab.ECDSASHA512,
-				jwsAlg:         jws.ES512,
-				kid:            kid,
-				logger:         logger,
-				httpClient:     httpClient,
-			}, nil
+			return &k.PublicKey, cryptolab.ECDSASHA512, jws.ES512, nil
 		default:
-			return nil, errors.New("unsupported EC curve: " + crvName + " only P-256, P-384 and P-521 are supported")
+			return nil, "", "", errors.New("unsupported EC curve: " + crvName +
+				" only P-256, P-384 and P-521 are supported")
 		}
 	case ed25519.PrivateKey:
-		return &jwtService{
-			cryptoProvider: cryptoProvider,
-			keyRef:         keyRef,
-			publicKey:      k.Public(),
-			signAlg:        cryptolab.ED25519,
-			jwsAlg:         jws.EdDSA,
-			kid:            kid,
-			logger:         logger,
-			httpClient:     httpClient,
-		}, nil
+		return k.Public(), cryptolab.ED25519, jws.EdDSA, nil
 	default:
-		return nil, errors.New("unsupported private key type")
+		return nil, "", "", errors.New("unsupported private key type")
 	}
 }
 
+// getSigningKey returns the key currently used to sign the JWTs. When the signing key of the PKI service has
+// been rotated, the new key is loaded before it is returned.
+func (js *jwtService) getSigningKey() signingKey {
+	if js.pkiService != nil {
+		keyID := js.pkiService.GetSigningKeyID()
+		js.mu.RLock()
+		rotated := keyID != "" && keyID != js.keyRef.KeyID
+		js.mu.RUnlock()
+
+		if rotated {
+			key, err := loadSigningKey(js.pkiService, keyID)
+			if err != nil {
+				js.logger.Error("Failed to load the rotated signing key, continuing with the previous key",
+					log.String("keyID", keyID), log.Error(err))
+			} else {
+				js.mu.Lock()
+				js.keyRef = key.keyRef
+				js.publicKey = key.publicKey
+				js.signAlg = key.signAlg
+				js.jwsAlg = key.jwsAlg
+				js.kid = key.kid
+				js.mu.Unlock()
+			}
+		}
+	}
+
+	js.mu.RLock()
+	defer js.mu.RUnlock()
+	return signingKey{
+		keyRef:    js.keyRef,
+		publicKey: js.publicKey,
+		signAlg:   js.signAlg,
+		jwsAlg:    js.jwsAlg,
+		kid:       js.kid,
+	}
+}
+
+// getIssuerSigningKey returns the key to sign the JWTs carrying the given issuer. The JWTs of a custom domain
+// with a signing key of its own are signed with that key, and all others with the server's signing key.
+func (js *jwtService) getIssuerSigningKey(issuer string) (signingKey, error) {
+	keyID := config.GetIssuerSigningKeyID(issuer)
+	if keyID == "" || js.pkiService == nil {
+		return js.getSigningKey(), nil
+	}
+
+	if cached, ok := js.issuerKeys.Load(keyID); ok {
+		return cached.(signingKey), nil
+	}
+	key, err := loadSigningKey(js.pkiService, keyID)
+	if err != nil {
+		return signingKey{}, err
+	}
+	js.issuerKeys.Store(keyID, key)
+	return key, nil
+}
+
+// getVerificationKey returns the key to verify a JWT issued by the server. Tokens signed with a key that is
+// still published during a key rollover are verified with that key, and all others with the signing key.
+func (js *jwtService) getVerificationKey(headerBase64 string) signingKey {
+	current := js.getSigningKey()
+	if js.pkiService == nil {
+		return current
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(headerBase64)
+	if err != nil {
+		return current
+	}
+	var header map[string]interface{}
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return current
+	}
+	kid, ok := header["kid"].(string)
+	if !ok || kid == "" || kid == current.kid {
+		return current
+	}
+
+	keyID := js.pkiService.GetKeyIDByThumbprint(kid)
+	if keyID == "" {
+		return current
+	}
+	key, loadErr := loadSigningKey(js.pkiService, keyID)
+	if loadErr != nil {
+		js.logger.Debug("Failed to load the verification key", log.String("keyID", keyID), log.Error(loadErr))
+		return current
+	}
+	return key
+}
+
 // GenerateJWT generates a JWT signed with the server's private key.
 // The typ parameter sets the JWT header "typ" field. If empty, defaults to "JWT".
 // The alg parameter overrides the signing algorithm (e.g. "RS256"). When empty, the server's
@@ -176,10 +270,22 @@ func (js *jwtService) GenerateJWT(
 		ctx = context.Background()
 	}
 
-	jwsAlg := js.jwsAlg
+	serverRuntime := config.GetServerRuntime()
+	tokenIssuer := iss
+	if tokenIssuer == "" {
+		tokenIssuer = serverRuntime.Config.JWT.Issuer
+	}
+
+	key, keyErr := js.getIssuerSigningKey(tokenIssuer)
+	if keyErr != nil {
+		js.logger.Error("Failed to load the signing key of the issuer", log.String("issuer", tokenIssuer),
+			log.Error(keyErr))
+		return "", 0, &serviceerror.InternalServerError
+	}
+	jwsAlg := key.jwsAlg
 	if alg != "" {
 		mapped, err := jws.MapAlgorithmToSignAlg(jws.Algorithm(alg))
-		if err != nil || mapped != js.signAlg {
+		if err != nil || mapped != key.signAlg {
 			return "", 0, &ErrorUnsupportedJWSAlgorithm
 		}
 		jwsAlg = jws.Algorithm(alg)
@@ -203,8 +309,6 @@ func (js *jwtService) GenerateJWT(
 		return "", 0, &serviceerror.InternalServerError
 	}
 
-	serverRuntime := config.GetServerRuntime()
-
 	// Create the JWT header.
 	if typ == "" {
 		typ = TokenTypeJWT
@@ -212,7 +316,7 @@ func (js *jwtService) GenerateJWT(
 	header := map[string]string{
 		"alg": string(jwsAlg),
 		"typ": typ,
-		"kid": js.kid,
+		"kid": key.kid,
 	}
 
 	headerJSON, err := json.Marshal(header)
@@ -221,11 +325,6 @@ func (js *jwtService) GenerateJWT(
 		return "", 0, &serviceerror.InternalServerError
 	}
 
-	tokenIssuer := iss
-	if tokenIssuer == "" {
-		tokenIssuer = serverRuntime.Config.JWT.Issuer
-	}
-
 	// Calculate the expiration time based on the validity period.
 	if validityPeriod == 0 {
 		validityPeriod = serverRuntime.Config.JWT.ValidityPeriod
@@ -268,7 +367,7 @@ func (js *jwtService) GenerateJWT(
 
 	// Create the signing input and sign it with the crypto provider.
 	signingInput := headerBase64 + "." + payloadBase64
-	signature, err := js.cryptoProvider.Sign(ctx, js.keyRef, js.signAlg, []byte(signingInput))
+	signature, err := js.cryptoProvider.Sign(ctx, key.keyRef, key.signAlg, []byte(signingInput))
 	if err != nil {
 		js.logger.Error("Failed to sign JWT: " + err.Error())
 		return "", 0, &serviceerror.InternalServerError
@@ -282,7 +381,7 @@ func (js *jwtService) GenerateJWT(
 
 // VerifyJWT verifies the JWT token using the server's public key.
 func (js *jwtService) VerifyJWT(jwtToken string, expectedAud, expectedIss string) *serviceerror.ServiceError {
-	if js.publicKey == nil {
+	if js.getSigningKey().publicKey == nil {
 		js.logger.Error("Public key not found for JWT verification")
 		return &serviceerror.InternalServerError
 	}
@@ -342,9 +441,22 @@ func (js *jwtService) VerifyJWTSignature(jwtToken string) *serviceerror.ServiceE
 	// Create the signing input
 	signingInput := parts[0] + "." + parts[1]
 
-	// Verify the signature using the configured algorithm
-	err = cryptolab.Verify([]byte(signingInput), signature, js.signAlg, js.publicKey)
+	// Verify the signature using the key that signed the token
+	key := js.getVerificationKey(parts[0])
+	err = cryptolab.Verify([]byte(signingInput), signature, key.signAlg, key.publicKey)
+	if err != nil {
+		return &ErrorInvalidTokenSignature
+	}
+
+	// The token must be signed with the key of its issuer, so that a token of a custom domain with a signing
+	// key of its own is not accepted as a token of another issuer.
+	payload, err := DecodeJWTPayload(jwtToken)
 	if err != nil {
+		return &ErrorDecodingJWTPayload
+	}
+	if iss, _ := payload["iss"].(string); !config.IsSigningKeyAllowed(key.keyRef.KeyID, iss) {
+		js.logger.Debug("JWT is signed with a key that is not allowed for its issuer",
+			log.String("issuer", iss))
 		return &ErrorInvalidTokenSignature
 	}
 	return nil
@@ -405,13 +517,15 @@ func (js *jwtService) VerifyJWTSignatureWithJWKS(jwtToken string, jwksURL string
 		return svcErr
 	}
 
-	// Find the key with matching kid
-	var jwk map[string]interface{}
-	for _, key := range keys {
-		if keyID, ok := key["kid"].(string); ok && keyID == kid {
-			jwk = key
-			break
+	// Find the key with matching kid. If it is not found, the issuer may have rotated its signing keys
+	// after the JWKS was cached, so fetch the JWKS again.
+	jwk := findJWKByKeyID(keys, kid)
+	if jwk == nil {
+		keys, svcErr = js.refetchJWKSKeys(jwksURL)
+		if svcErr != nil {
+			return svcErr
 		}
+		jwk = findJWKByKeyID(keys, kid)
 	}
 	if jwk == nil {
 		return &ErrorNoMatchingJWKFound
@@ -432,15 +546,49 @@ func (js *jwtService) VerifyJWTSignatureWithJWKS(jwtToken string, jwksURL string
 	return nil
 }
 
-// getJWKSKeys returns JWKS keys for the given URL, using a TTL-based cache.
+// getJWKSKeys returns JWKS keys for the given URL, using a TTL-based cache. Cached keys that are close
+// to expiry are refreshed in the background.
 func (js *jwtService) getJWKSKeys(jwksURL string) ([]map[string]interface{}, *serviceerror.ServiceError) {
 	if cached, ok := js.jwksCache.Load(jwksURL); ok {
 		entry := cached.(*jwksCacheEntry)
-		if time.Now().Before(entry.expiresAt) {
+		now := time.Now()
+		if now.Before(entry.expiresAt) {
+			if !now.Before(entry.refreshAt) && entry.refreshing.CompareAndSwap(false, true) {
+				go js.refreshJWKSKeys(jwksURL, entry)
+			}
+			return entry.keys, nil
+		}
+	}
+
+	return js.fetchJWKSKeys(jwksURL)
+}
+
+// refetchJWKSKeys fetches the JWKS keys for the given URL again, unless they were fetched less than
+// jwksRefetchMinInterval ago, in which case the cached keys are returned.
+func (js *jwtService) refetchJWKSKeys(jwksURL string) ([]map[string]interface{}, *serviceerror.ServiceError) {
+	if cached, ok := js.jwksCache.Load(jwksURL); ok {
+		entry := cached.(*jwksCacheEntry)
+		if time.Since(entry.fetchedAt) < jwksRefetchMinInterval {
 			return entry.keys, nil
 		}
 	}
 
+	js.logger.Debug("Signing key not found in the cached JWKS, fetching the JWKS again")
+	return js.fetchJWKSKeys(jwksURL)
+}
+
+// refreshJWKSKeys fetches the JWKS keys for the given URL in the background to replace the cached entry.
+// If the fetch fails, the cached keys are kept until they expire.
+func (js *jwtService) refreshJWKSKeys(jwksURL string, entry *jwksCacheEntry) {
+	defer entry.refreshing.Store(false)
+
+	if _, svcErr := js.fetchJWKSKeys(jwksURL); svcErr != nil {
+		js.logger.Debug("Failed to refresh the cached JWKS", log.String("error_code", svcErr.Code))
+	}
+}
+
+// fetchJWKSKeys fetches the JWKS keys from the given URL and caches them.
+func (js *jwtService) fetchJWKSKeys(jwksURL string) ([]map[string]interface{}, *serviceerror.ServiceError) {
 	resp, err := js.httpClient.Get(jwksURL)
 	if err != nil {
 		js.logger.Debug("Failed to fetch JWKS from URL: " + err.Error())
@@ -472,14 +620,27 @@ func (js *jwtService) getJWKSKeys(jwksURL string) ([]map[string]interface{}, *se
 	}
 
 	ttl := time.Duration(config.GetServerRuntime().Config.Server.SecurityConfig.JWKSCacheTTL) * time.Second
+	now := time.Now()
 	js.jwksCache.Store(jwksURL, &jwksCacheEntry{
 		keys:      jwks.Keys,
-		expiresAt: time.Now().Add(ttl),
+		fetchedAt: now,
+		refreshAt: now.Add(time.Duration(float64(ttl) * jwksRefreshAheadRatio)),
+		expiresAt: now.Add(ttl),
 	})
 
 	return jwks.Keys, nil
 }
 
+// findJWKByKeyID returns the key with the given key ID, or nil if none matches.
+func findJWKByKeyID(keys []map[string]interface{}, kid string) map[string]interface{} {
+	for _, key := range keys {
+		if keyID, ok := key["kid"].(string); ok && keyID == kid {
+			return key
+		}
+	}
+	return nil
+}
+
 // verifyJWTClaims verifies the standard claims of a JWT token.
 func (js *jwtService) verifyJWTClaims(jwtToken string, expectedAud, expectedIss string) *serviceerror.ServiceError {
 	// Decode the JWT payload
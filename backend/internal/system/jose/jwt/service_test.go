@@ -195,6 +195,139 @@ func (suite *JWTServiceTestSuite) TestNewJWTService() {
 	assert.Implements(suite.T(), (*JWTServiceInterface)(nil), service)
 }
 
+func (suite *JWTServiceTestSuite) TestGenerateJWT_UsesRotatedSigningKey() {
+	rotatedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	suite.pkiMock.EXPECT().GetSigningKeyID().Return("rotated-key")
+	suite.pkiMock.EXPECT().GetPrivateKey("rotated-key").Return(rotatedKey, nil).Once()
+	suite.pkiMock.EXPECT().GetCertThumbprint("rotated-key").Return("rotated-kid").Once()
+
+	cryptoMock := cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+	cryptoMock.EXPECT().
+		Sign(mock.Anything, kmprovider.KeyRef{KeyID: "rotated-key"}, cryptolab.ECDSASHA256, mock.Anything).
+		RunAndReturn(func(
+			_ context.Context, _ kmprovider.KeyRef, _ cryptolab.SignAlgorithm, content []byte,
+		) ([]byte, error) {
+			return cryptolab.Generate(content, cryptolab.ECDSASHA256, rotatedKey)
+		})
+	suite.jwtService.cryptoProvider = cryptoMock
+	suite.jwtService.pkiService = suite.pkiMock
+
+	token, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", testIss, 3600, map[string]interface{}{"aud": testAud}, TokenTypeJWT, "")
+	suite.Nil(svcErr)
+
+	header, err := DecodeJWTHeader(token)
+	suite.Require().NoError(err)
+	suite.Equal("rotated-kid", header["kid"])
+	suite.Equal("ES256", header["alg"])
+	suite.Nil(suite.jwtService.VerifyJWTSignature(token))
+}
+
+func (suite *JWTServiceTestSuite) TestVerifyJWTSignature_WithPreviousSigningKey() {
+	token, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", testIss, 3600, map[string]interface{}{"aud": testAud}, TokenTypeJWT, "")
+	suite.Require().Nil(svcErr)
+
+	rotatedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	suite.pkiMock.EXPECT().GetSigningKeyID().Return("rotated-key")
+	suite.pkiMock.EXPECT().GetPrivateKey("rotated-key").Return(rotatedKey, nil).Once()
+	suite.pkiMock.EXPECT().GetCertThumbprint("rotated-key").Return("rotated-kid").Once()
+	suite.pkiMock.EXPECT().GetKeyIDByThumbprint("test-kid").Return("previous-key").Once()
+	suite.pkiMock.EXPECT().GetPrivateKey("previous-key").Return(suite.testPrivateKey, nil).Once()
+	suite.pkiMock.EXPECT().GetCertThumbprint("previous-key").Return("test-kid").Once()
+	suite.jwtService.pkiService = suite.pkiMock
+
+	suite.Nil(suite.jwtService.VerifyJWTSignature(token))
+}
+
+func (suite *JWTServiceTestSuite) TestVerifyJWTSignature_WithRetiredSigningKey() {
+	token, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", testIss, 3600, map[string]interface{}{"aud": testAud}, TokenTypeJWT, "")
+	suite.Require().Nil(svcErr)
+
+	rotatedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	suite.pkiMock.EXPECT().GetSigningKeyID().Return("rotated-key")
+	suite.pkiMock.EXPECT().GetPrivateKey("rotated-key").Return(rotatedKey, nil).Once()
+	suite.pkiMock.EXPECT().GetCertThumbprint("rotated-key").Return("rotated-kid").Once()
+	suite.pkiMock.EXPECT().GetKeyIDByThumbprint("test-kid").Return("").Once()
+	suite.jwtService.pkiService = suite.pkiMock
+
+	suite.Equal(&ErrorInvalidTokenSignature, suite.jwtService.VerifyJWTSignature(token))
+}
+
+// setTenantDomain configures a custom domain that signs its tokens with a key of its own.
+func (suite *JWTServiceTestSuite) setTenantDomain() {
+	cfg := config.GetServerRuntime().Config
+	cfg.CustomDomains = []config.CustomDomainConfig{
+		{Host: "id.tenant.io", Issuer: "https://id.tenant.io", SigningKeyID: "tenant-key"},
+	}
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &cfg))
+}
+
+func (suite *JWTServiceTestSuite) TestGenerateJWT_UsesIssuerSigningKey() {
+	suite.setTenantDomain()
+	tenantKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	// The key is loaded once to sign the tokens of the issuer and once more to verify a token.
+	suite.pkiMock.EXPECT().GetPrivateKey("tenant-key").Return(tenantKey, nil).Twice()
+	suite.pkiMock.EXPECT().GetCertThumbprint("tenant-key").Return("tenant-kid").Twice()
+	suite.pkiMock.EXPECT().GetSigningKeyID().Return("test-kid")
+	suite.pkiMock.EXPECT().GetKeyIDByThumbprint("tenant-kid").Return("tenant-key").Once()
+
+	cryptoMock := cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+	cryptoMock.EXPECT().
+		Sign(mock.Anything, kmprovider.KeyRef{KeyID: "tenant-key"}, cryptolab.ECDSASHA256, mock.Anything).
+		RunAndReturn(func(
+			_ context.Context, _ kmprovider.KeyRef, _ cryptolab.SignAlgorithm, content []byte,
+		) ([]byte, error) {
+			return cryptolab.Generate(content, cryptolab.ECDSASHA256, tenantKey)
+		}).Twice()
+	suite.jwtService.cryptoProvider = cryptoMock
+	suite.jwtService.pkiService = suite.pkiMock
+
+	token, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", "https://id.tenant.io", 3600, map[string]interface{}{"aud": testAud}, TokenTypeJWT, "")
+	suite.Require().Nil(svcErr)
+
+	header, err := DecodeJWTHeader(token)
+	suite.Require().NoError(err)
+	suite.Equal("tenant-kid", header["kid"])
+	suite.Equal("ES256", header["alg"])
+	suite.Nil(suite.jwtService.VerifyJWTSignature(token))
+
+	_, _, svcErr = suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", "https://id.tenant.io", 3600, map[string]interface{}{"aud": testAud}, TokenTypeJWT, "")
+	suite.Nil(svcErr)
+}
+
+func (suite *JWTServiceTestSuite) TestGenerateJWT_IssuerSigningKeyLoadError() {
+	suite.setTenantDomain()
+	suite.pkiMock.EXPECT().GetPrivateKey("tenant-key").Return(nil, &serviceerror.InternalServerError).Once()
+	suite.jwtService.pkiService = suite.pkiMock
+
+	_, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", "https://id.tenant.io", 3600, map[string]interface{}{"aud": testAud}, TokenTypeJWT, "")
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *JWTServiceTestSuite) TestVerifyJWTSignature_KeyNotAllowedForIssuer() {
+	suite.setTenantDomain()
+
+	// A token of the tenant domain signed with the server's signing key is rejected.
+	token, _, svcErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", testIss, 3600, map[string]interface{}{"aud": testAud, "iss": "https://id.tenant.io"},
+		TokenTypeJWT, "")
+	suite.Require().Nil(svcErr)
+
+	suite.Equal(&ErrorInvalidTokenSignature, suite.jwtService.VerifyJWTSignature(token))
+}
+
 func (suite *JWTServiceTestSuite) TestInitScenarios() {
 	testCases := []struct {
 		name           string
@@ -1577,6 +1710,97 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSKeyIDNotFound()
 	assert.Equal(suite.T(), ErrorNoMatchingJWKFound, *err)
 }
 
+func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSRefetchesOnUnknownKeyID() {
+	suite.initJWKSCacheTTL(300)
+	defer config.ResetServerRuntime()
+
+	var fetchCount int32
+	testServer := suite.countingJWKSServer(&fetchCount)
+	defer testServer.Close()
+
+	// Cache a JWKS that no longer contains the signing key, as if the issuer rotated its keys.
+	fetchedAt := time.Now().Add(-2 * jwksRefetchMinInterval)
+	suite.jwtService.jwksCache.Store(testServer.URL, &jwksCacheEntry{
+		keys:      []map[string]interface{}{{"kty": "RSA", "kid": "rotated-kid"}},
+		fetchedAt: fetchedAt,
+		refreshAt: time.Now().Add(time.Minute),
+		expiresAt: time.Now().Add(time.Hour),
+	})
+
+	token, _, genErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", testIssuer, 3600, map[string]interface{}{"aud": testAudience}, TokenTypeJWT, "")
+	assert.Nil(suite.T(), genErr)
+
+	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(token, testServer.URL))
+	assert.Equal(suite.T(), int32(1), atomic.LoadInt32(&fetchCount),
+		"an unknown key ID should trigger a fetch of the JWKS")
+
+	// The refetched JWKS replaces the cached entry.
+	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(token, testServer.URL))
+	assert.Equal(suite.T(), int32(1), atomic.LoadInt32(&fetchCount))
+}
+
+func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSLimitsRefetchOnUnknownKeyID() {
+	suite.initJWKSCacheTTL(300)
+	defer config.ResetServerRuntime()
+
+	var fetchCount int32
+	testServer := suite.countingJWKSServer(&fetchCount)
+	defer testServer.Close()
+
+	// The JWKS was fetched moments ago, so an unknown key ID must not trigger another fetch.
+	suite.jwtService.jwksCache.Store(testServer.URL, &jwksCacheEntry{
+		keys:      []map[string]interface{}{{"kty": "RSA", "kid": "rotated-kid"}},
+		fetchedAt: time.Now(),
+		refreshAt: time.Now().Add(time.Minute),
+		expiresAt: time.Now().Add(time.Hour),
+	})
+
+	token, _, genErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", testIssuer, 3600, map[string]interface{}{"aud": testAudience}, TokenTypeJWT, "")
+	assert.Nil(suite.T(), genErr)
+
+	err := suite.jwtService.VerifyJWTSignatureWithJWKS(token, testServer.URL)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), ErrorNoMatchingJWKFound, *err)
+	assert.Equal(suite.T(), int32(0), atomic.LoadInt32(&fetchCount))
+}
+
+func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSRefreshesInBackground() {
+	suite.initJWKSCacheTTL(300)
+	defer config.ResetServerRuntime()
+
+	var fetchCount int32
+	testServer := suite.countingJWKSServer(&fetchCount)
+	defer testServer.Close()
+
+	var jwks struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}
+	assert.NoError(suite.T(), json.Unmarshal([]byte(suite.createMockJWKSData()), &jwks))
+
+	// Cache a JWKS that is due for refresh but has not expired yet.
+	fetchedAt := time.Now().Add(-time.Hour)
+	suite.jwtService.jwksCache.Store(testServer.URL, &jwksCacheEntry{
+		keys:      jwks.Keys,
+		fetchedAt: fetchedAt,
+		refreshAt: time.Now().Add(-time.Minute),
+		expiresAt: time.Now().Add(time.Minute),
+	})
+
+	token, _, genErr := suite.jwtService.GenerateJWT(context.Background(),
+		"test-subject", testIssuer, 3600, map[string]interface{}{"aud": testAudience}, TokenTypeJWT, "")
+	assert.Nil(suite.T(), genErr)
+
+	// The cached keys are used while the JWKS is refreshed in the background.
+	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(token, testServer.URL))
+	assert.Eventually(suite.T(), func() bool {
+		cached, ok := suite.jwtService.jwksCache.Load(testServer.URL)
+		return ok && cached.(*jwksCacheEntry).fetchedAt.After(fetchedAt)
+	}, time.Second, 10*time.Millisecond, "the cached JWKS should be refreshed in the background")
+	assert.Equal(suite.T(), int32(1), atomic.LoadInt32(&fetchCount))
+}
+
 func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSNoKeyID() {
 	testServer := suite.mockJWKSServer()
 	defer testServer.Close()
@@ -1813,6 +2037,39 @@ func (suite *JWTServiceTestSuite) mockJWKSServer() *httptest.Server {
 	return server
 }
 
+// Helper method to mock a JWKS server that counts the fetches
+func (suite *JWTServiceTestSuite) countingJWKSServer(counter *int32) *httptest.Server {
+	jwksData := suite.createMockJWKSData()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(counter, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if _, err := fmt.Fprintln(w, jwksData); err != nil {
+			suite.T().Errorf("Failed to write JWKS response: %v", err)
+		}
+	}))
+}
+
+// Helper method to re-initialize the runtime with the given JWKS cache TTL
+func (suite *JWTServiceTestSuite) initJWKSCacheTTL(ttl int) {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{
+			Issuer:         testIssuer,
+			ValidityPeriod: 3600,
+			Leeway:         30,
+		},
+		Server: config.ServerConfig{
+			SecurityConfig: config.SecurityConfig{
+				JWKSCacheTTL: ttl,
+			},
+		},
+	}
+	err := config.InitializeServerRuntime("", testConfig)
+	assert.NoError(suite.T(), err)
+}
+
 // Helper method to create a JWT with custom claims and validity
 func (suite *JWTServiceTestSuite) createJWTWithClaims(sub, aud, iss string, exp int64, nbf int64,
 	customClaims map[string]interface{}) string {
@@ -1958,6 +2215,7 @@ func (suite *JWTServiceTestSuite) TestInitWithECDSAKeys() {
 			pkiMock := pkimock.NewPKIServiceInterfaceMock(t)
 			pkiMock.EXPECT().GetPrivateKey(mock.Anything).Return(ecKey, nil)
 			pkiMock.EXPECT().GetCertThumbprint(mock.Anything).Return("test-kid")
+			pkiMock.EXPECT().GetSigningKeyID().Return("")
 
 			service, err := Initialize(pkiMock)
 
@@ -2021,6 +2279,7 @@ func (suite *JWTServiceTestSuite) TestInitWithEd25519Key() {
 	pkiMock := pkimock.NewPKIServiceInterfaceMock(suite.T())
 	pkiMock.EXPECT().GetPrivateKey(mock.Anything).Return(priv, nil)
 	pkiMock.EXPECT().GetCertThumbprint(mock.Anything).Return("test-kid")
+	pkiMock.EXPECT().GetSigningKeyID().Return("")
 
 	service, err := Initialize(pkiMock)
 
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkiservice
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"time"
+)
+
+const (
+	// rsaKeySize is the size of the generated RSA keys in bits.
+	rsaKeySize = 2048
+	// pemTypeCertificate is the PEM block type of a certificate.
+	pemTypeCertificate = "CERTIFICATE"
+	// pemTypePrivateKey is the PEM block type of a PKCS #8 private key.
+	pemTypePrivateKey = "PRIVATE KEY"
+)
+
+// GenerateKeyPair generates a new key pair of the given algorithm together with a self-signed certificate
+// issued to the given common name and valid for the given duration.
+func GenerateKeyPair(algorithm PKIAlgorithm, commonName string, validity time.Duration) (tls.Certificate, error) {
+	var privateKey crypto.Signer
+	var err error
+	switch algorithm {
+	case RSA:
+		privateKey, err = rsa.GenerateKey(rand.Reader, rsaKeySize)
+	case P256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case P384:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case P521:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case Ed25519:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return tls.Certificate{}, errors.New("unsupported key algorithm: " + string(algorithm))
+	}
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now().UTC()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now,
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, privateKey.Public(), privateKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	leaf, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{certDER},
+		PrivateKey:  privateKey,
+		Leaf:        leaf,
+	}, nil
+}
+
+// EncodeKeyPair encodes the certificate and the private key of a key pair in PEM format.
+func EncodeKeyPair(keyPair tls.Certificate) ([]byte, []byte, error) {
+	if len(keyPair.Certificate) == 0 {
+		return nil, nil, errors.New("certificate data is empty")
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(keyPair.PrivateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: keyPair.Certificate[0]})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
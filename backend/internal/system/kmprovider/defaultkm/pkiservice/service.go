@@ -31,6 +31,7 @@ import (
 	"os"
 	"path"
 	"slices"
+	"sync"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
@@ -46,11 +47,19 @@ type PKIServiceInterface interface {
 	GetX509Certificate(id string) (*x509.Certificate, *serviceerror.ServiceError)
 	GetAllX509Certificates() (map[string]*x509.Certificate, *serviceerror.ServiceError)
 	GetSupportedSigningAlgorithms() []string
+	GetKeyIDByThumbprint(thumbprint string) string
+	GetSigningKeyID() string
+	SetSigningKeyID(id string) error
+	AddKey(id string, keyPair tls.Certificate) error
+	RemoveKey(id string) error
 }
 
-// pkiService stores loaded certificates indexed by their ID.
+// pkiService stores loaded certificates indexed by their ID. Keys loaded from the configuration are kept for the
+// lifetime of the server, while keys generated at runtime can be added and removed.
 type pkiService struct {
+	mu           sync.RWMutex
 	certificates map[string]PKI
+	signingKeyID string
 	logger       *log.Logger
 }
 
@@ -82,21 +91,11 @@ func newPKIService() (PKIServiceInterface, error) {
 		if err != nil {
 			return nil, err
 		}
-		algorithm, err := getAlgorithmFromKey(tlsCert.PrivateKey)
+		pki, err := buildPKI(keyConfig.ID, tlsCert)
 		if err != nil {
 			return nil, err
 		}
-		thumbprint, err := getThumbprint(tlsCert)
-		if err != nil {
-			return nil, err
-		}
-		certificates[keyConfig.ID] = PKI{
-			ID:          keyConfig.ID,
-			Algorithm:   algorithm,
-			PrivateKey:  tlsCert.PrivateKey,
-			Certificate: tlsCert,
-			ThumbPrint:  thumbprint,
-		}
+		certificates[keyConfig.ID] = pki
 	}
 
 	if len(certificates) == 0 {
@@ -105,12 +104,34 @@ func newPKIService() (PKIServiceInterface, error) {
 
 	return &pkiService{
 		certificates: certificates,
+		signingKeyID: serverRuntime.Config.JWT.PreferredKeyID,
 		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "PKIService")),
 	}, nil
 }
 
+// buildPKI builds the PKI entry of a key pair.
+func buildPKI(id string, keyPair tls.Certificate) (PKI, error) {
+	algorithm, err := GetKeyAlgorithm(keyPair.PrivateKey)
+	if err != nil {
+		return PKI{}, err
+	}
+	thumbprint, err := getThumbprint(keyPair)
+	if err != nil {
+		return PKI{}, err
+	}
+	return PKI{
+		ID:          id,
+		Algorithm:   algorithm,
+		PrivateKey:  keyPair.PrivateKey,
+		Certificate: keyPair,
+		ThumbPrint:  thumbprint,
+	}, nil
+}
+
 // GetPrivateKey retrieves the private key associated with the given ID.
 func (s *pkiService) GetPrivateKey(id string) (crypto.PrivateKey, *serviceerror.ServiceError) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	cert, exists := s.certificates[id]
 	if !exists || cert.PrivateKey == nil {
 		s.logger.Error("Private key not found for certificate ID: " + id)
@@ -121,6 +142,8 @@ func (s *pkiService) GetPrivateKey(id string) (crypto.PrivateKey, *serviceerror.
 
 // GetCertThumbprint retrieves the thumbprint of the certificate associated with the given ID.
 func (s *pkiService) GetCertThumbprint(id string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	cert, exists := s.certificates[id]
 	if !exists {
 		return ""
@@ -130,6 +153,8 @@ func (s *pkiService) GetCertThumbprint(id string) string {
 
 // GetX509Certificate retrieves the x509 certificate associated with the given ID.
 func (s *pkiService) GetX509Certificate(id string) (*x509.Certificate, *serviceerror.ServiceError) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	cert, exists := s.certificates[id]
 	if !exists {
 		s.logger.Error("Certificate not found for certificate ID: " + id)
@@ -149,6 +174,8 @@ func (s *pkiService) GetX509Certificate(id string) (*x509.Certificate, *servicee
 
 // GetAllX509Certificates retrieves all x509 certificates as a map indexed by their ID.
 func (s *pkiService) GetAllX509Certificates() (map[string]*x509.Certificate, *serviceerror.ServiceError) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	result := make(map[string]*x509.Certificate)
 	for id, cert := range s.certificates {
 		if len(cert.Certificate.Certificate) == 0 {
@@ -168,6 +195,8 @@ func (s *pkiService) GetAllX509Certificates() (map[string]*x509.Certificate, *se
 // GetSupportedSigningAlgorithms returns a deduplicated list of JWS algorithm strings
 // supported across all configured keys.
 func (s *pkiService) GetSupportedSigningAlgorithms() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	var result []string
 	for _, cert := range s.certificates {
 		for _, alg := range pkiAlgorithmToJWSAlgorithms(cert.Algorithm) {
@@ -179,6 +208,67 @@ func (s *pkiService) GetSupportedSigningAlgorithms() []string {
 	return result
 }
 
+// GetKeyIDByThumbprint returns the ID of the key whose certificate has the given thumbprint, or an empty
+// string if there is no such key.
+func (s *pkiService) GetKeyIDByThumbprint(thumbprint string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for id, cert := range s.certificates {
+		if cert.ThumbPrint == thumbprint {
+			return id
+		}
+	}
+	return ""
+}
+
+// GetSigningKeyID returns the ID of the key that signs the tokens issued by the server.
+func (s *pkiService) GetSigningKeyID() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.signingKeyID
+}
+
+// SetSigningKeyID makes the key with the given ID the key that signs the tokens issued by the server.
+func (s *pkiService) SetSigningKeyID(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.certificates[id]; !exists {
+		return errors.New("key not found for key ID: " + id)
+	}
+	s.signingKeyID = id
+	return nil
+}
+
+// AddKey adds a key pair with the given ID. A key that is already loaded with the same ID is replaced.
+func (s *pkiService) AddKey(id string, keyPair tls.Certificate) error {
+	if id == "" {
+		return errors.New("key ID is empty")
+	}
+	if len(keyPair.Certificate) == 0 {
+		return errors.New("certificate data is empty for key ID: " + id)
+	}
+	pki, err := buildPKI(id, keyPair)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.certificates[id] = pki
+	return nil
+}
+
+// RemoveKey removes the key with the given ID. The signing key cannot be removed.
+func (s *pkiService) RemoveKey(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id == s.signingKeyID {
+		return errors.New("the signing key cannot be removed: " + id)
+	}
+	delete(s.certificates, id)
+	return nil
+}
+
 // pkiAlgorithmToJWSAlgorithms returns the JWS algorithm strings supported for the given PKI algorithm.
 func pkiAlgorithmToJWSAlgorithms(alg PKIAlgorithm) []string {
 	switch alg {
@@ -197,8 +287,8 @@ func pkiAlgorithmToJWSAlgorithms(alg PKIAlgorithm) []string {
 	}
 }
 
-// getAlgorithmFromKey determines the PKIAlgorithm based on the type of the private key.
-func getAlgorithmFromKey(key crypto.PrivateKey) (PKIAlgorithm, error) {
+// GetKeyAlgorithm determines the PKIAlgorithm based on the type of the private key.
+func GetKeyAlgorithm(key crypto.PrivateKey) (PKIAlgorithm, error) {
 	switch k := key.(type) {
 	case *rsa.PrivateKey:
 		return RSA, nil
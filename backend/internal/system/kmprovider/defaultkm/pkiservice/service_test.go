@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package pkiservice
+
+import (
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+func newTestPKIService(t *testing.T, signingKeyID string) *pkiService {
+	keyPair, err := GenerateKeyPair(RSA, signingKeyID, time.Hour)
+	require.NoError(t, err)
+	pki, err := buildPKI(signingKeyID, keyPair)
+	require.NoError(t, err)
+
+	return &pkiService{
+		certificates: map[string]PKI{signingKeyID: pki},
+		signingKeyID: signingKeyID,
+		logger:       log.GetLogger(),
+	}
+}
+
+func TestGenerateKeyPair(t *testing.T) {
+	for _, algorithm := range []PKIAlgorithm{RSA, P256, P384, P521, Ed25519} {
+		t.Run(string(algorithm), func(t *testing.T) {
+			keyPair, err := GenerateKeyPair(algorithm, "test-key", time.Hour)
+			require.NoError(t, err)
+
+			keyAlgorithm, err := GetKeyAlgorithm(keyPair.PrivateKey)
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, keyAlgorithm)
+			assert.Equal(t, "test-key", keyPair.Leaf.Subject.CommonName)
+
+			certPEM, keyPEM, err := EncodeKeyPair(keyPair)
+			require.NoError(t, err)
+			decoded, err := tls.X509KeyPair(certPEM, keyPEM)
+			require.NoError(t, err)
+			assert.Equal(t, keyPair.Certificate[0], decoded.Certificate[0])
+		})
+	}
+}
+
+func TestGenerateKeyPair_UnsupportedAlgorithm(t *testing.T) {
+	_, err := GenerateKeyPair(PKIAlgorithm("DSA"), "test-key", time.Hour)
+	assert.Error(t, err)
+}
+
+func TestAddKey(t *testing.T) {
+	svc := newTestPKIService(t, "key-1")
+	keyPair, err := GenerateKeyPair(P256, "key-2", time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, svc.AddKey("key-2", keyPair))
+
+	thumbprint := svc.GetCertThumbprint("key-2")
+	assert.NotEmpty(t, thumbprint)
+	assert.Equal(t, "key-2", svc.GetKeyIDByThumbprint(thumbprint))
+	certs, svcErr := svc.GetAllX509Certificates()
+	assert.Nil(t, svcErr)
+	assert.Len(t, certs, 2)
+	assert.Contains(t, svc.GetSupportedSigningAlgorithms(), "ES256")
+}
+
+func TestAddKey_InvalidKeyPair(t *testing.T) {
+	svc := newTestPKIService(t, "key-1")
+
+	assert.Error(t, svc.AddKey("", tls.Certificate{}))
+	assert.Error(t, svc.AddKey("key-2", tls.Certificate{}))
+}
+
+func TestSetSigningKeyID(t *testing.T) {
+	svc := newTestPKIService(t, "key-1")
+	keyPair, err := GenerateKeyPair(RSA, "key-2", time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, svc.AddKey("key-2", keyPair))
+
+	require.NoError(t, svc.SetSigningKeyID("key-2"))
+	assert.Equal(t, "key-2", svc.GetSigningKeyID())
+
+	assert.Error(t, svc.SetSigningKeyID("unknown"))
+	assert.Equal(t, "key-2", svc.GetSigningKeyID())
+}
+
+func TestRemoveKey(t *testing.T) {
+	svc := newTestPKIService(t, "key-1")
+	keyPair, err := GenerateKeyPair(RSA, "key-2", time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, svc.AddKey("key-2", keyPair))
+
+	assert.Error(t, svc.RemoveKey("key-1"))
+	require.NoError(t, svc.RemoveKey("key-2"))
+
+	_, svcErr := svc.GetPrivateKey("key-2")
+	assert.NotNil(t, svcErr)
+	assert.Empty(t, svc.GetKeyIDByThumbprint(svc.GetCertThumbprint("key-2")))
+}
@@ -21,6 +21,7 @@ package pkiservice
 import (
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"os"
 	"path"
 
@@ -66,3 +67,24 @@ func LoadTLSConfig(cfg *config.Config, certFilePath string, keyFilePath string)
 		MinVersion:   http.GetTLSVersion(*cfg),
 	}, nil
 }
+
+// AddCustomDomainCertificates loads the certificates configured for custom domains and adds them to the
+// given TLS configuration. The certificate presented for a connection is selected by the requested
+// server name, falling back to the server certificate. File paths are resolved against serverHome.
+func AddCustomDomainCertificates(tlsConfig *tls.Config, domains []config.CustomDomainConfig,
+	serverHome string) error {
+	for _, domain := range domains {
+		if domain.CertFile == "" {
+			continue
+		}
+		certFilePath := path.Clean(path.Join(serverHome, domain.CertFile))
+		keyFilePath := path.Clean(path.Join(serverHome, domain.KeyFile))
+
+		cert, err := tls.LoadX509KeyPair(certFilePath, keyFilePath)
+		if err != nil {
+			return fmt.Errorf("failed to load certificate for custom domain %q: %w", domain.Host, err)
+		}
+		tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+	}
+	return nil
+}
@@ -84,3 +84,14 @@ func (lrw *loggingResponseWriter) Write(b []byte) (int, error) {
 	lrw.size += size
 	return size, err
 }
+
+// Flush sends any buffered data to the client, so that streamed responses such as server-sent events
+// are delivered as they are written.
+func (lrw *loggingResponseWriter) Flush() {
+	_ = http.NewResponseController(lrw.ResponseWriter).Flush()
+}
+
+// Unwrap returns the underlying response writer for use with http.ResponseController.
+func (lrw *loggingResponseWriter) Unwrap() http.ResponseWriter {
+	return lrw.ResponseWriter
+}
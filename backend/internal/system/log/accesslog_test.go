@@ -109,3 +109,19 @@ func (suite *AccessLogTestSuite) TestLoggingResponseWriter() {
 	// Verify the actual content was written to the underlying ResponseWriter
 	assert.Equal(suite.T(), "test content more", rec.Body.String())
 }
+
+func (suite *AccessLogTestSuite) TestLoggingResponseWriter_Flush() {
+	rec := httptest.NewRecorder()
+	lrw := &loggingResponseWriter{
+		ResponseWriter: rec,
+		statusCode:     http.StatusOK,
+	}
+
+	var w http.ResponseWriter = lrw
+	flusher, ok := w.(http.Flusher)
+	assert.True(suite.T(), ok)
+
+	flusher.Flush()
+	assert.True(suite.T(), rec.Flushed)
+	assert.Equal(suite.T(), rec, lrw.Unwrap())
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for MCP tool call audit operations.
+var (
+	// ErrorInvalidLimit is the error returned when an invalid limit is provided.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MCP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.mcpauditservice.invalid_limit",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.mcpauditservice.invalid_limit_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when an invalid offset is provided.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MCP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.mcpauditservice.invalid_offset",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.mcpauditservice.invalid_offset_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+	// ErrorInvalidToolCallStatus is the error returned when an invalid tool call status filter is provided.
+	ErrorInvalidToolCallStatus = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "MCP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.mcpauditservice.invalid_tool_call_status",
+			DefaultValue: "Invalid tool call status",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.mcpauditservice.invalid_tool_call_status_description",
+			DefaultValue: "The tool call status must be one of SUCCESS, FAILED or DENIED",
+		},
+	}
+)
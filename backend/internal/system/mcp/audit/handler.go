@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// toolCallAuditHandler handles HTTP requests for querying the audit of MCP tool invocations.
+type toolCallAuditHandler struct {
+	auditService ToolCallAuditServiceInterface
+}
+
+// newToolCallAuditHandler creates a new instance of toolCallAuditHandler.
+func newToolCallAuditHandler(auditService ToolCallAuditServiceInterface) *toolCallAuditHandler {
+	return &toolCallAuditHandler{
+		auditService: auditService,
+	}
+}
+
+// HandleToolCallAuditListRequest handles the request to list the audit records of MCP tool invocations. The
+// records can be filtered by tool, status and subject.
+func (h *toolCallAuditHandler) HandleToolCallAuditListRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := serverconst.DefaultPageSize
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			h.handleError(w, &ErrorInvalidOffset)
+			return
+		}
+		offset = parsed
+	}
+
+	filter := ToolCallAuditFilter{
+		Tool:    strings.TrimSpace(query.Get("tool")),
+		Status:  ToolCallStatus(strings.ToUpper(strings.TrimSpace(query.Get("status")))),
+		Subject: strings.TrimSpace(query.Get("subject")),
+	}
+
+	result, svcErr := h.auditService.ListToolCallAudits(r.Context(), filter, limit, offset)
+	if svcErr != nil {
+		h.handleError(w, svcErr)
+		return
+	}
+
+	records := make([]ToolCallAuditResponse, 0, len(result.Records))
+	for _, record := range result.Records {
+		records = append(records, ToolCallAuditResponse(record))
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, ToolCallAuditListResponse{
+		TotalResults: result.TotalResults,
+		StartIndex:   result.StartIndex,
+		Count:        result.Count,
+		Records:      records,
+		Links:        result.Links,
+	})
+}
+
+// handleError writes the HTTP error response for the given service error.
+func (h *toolCallAuditHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode = http.StatusBadRequest
+	}
+
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+type ToolCallAuditHandlerTestSuite struct {
+	suite.Suite
+	mockService *ToolCallAuditServiceInterfaceMock
+	handler     *toolCallAuditHandler
+}
+
+func TestToolCallAuditHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ToolCallAuditHandlerTestSuite))
+}
+
+func (suite *ToolCallAuditHandlerTestSuite) SetupTest() {
+	suite.mockService = NewToolCallAuditServiceInterfaceMock(suite.T())
+	suite.handler = newToolCallAuditHandler(suite.mockService)
+}
+
+func (suite *ToolCallAuditHandlerTestSuite) TestHandleToolCallAuditListRequest() {
+	req := httptest.NewRequest(http.MethodGet, "/mcp-tool-call-audits?limit=5&offset=10&tool="+testToolName+
+		"&status=denied&subject="+testSubject, nil)
+	rr := httptest.NewRecorder()
+
+	expectedFilter := ToolCallAuditFilter{
+		Tool:    testToolName,
+		Status:  ToolCallStatusDenied,
+		Subject: testSubject,
+	}
+	suite.mockService.EXPECT().ListToolCallAudits(mock.Anything, expectedFilter, 5, 10).Return(&ToolCallAuditList{
+		TotalResults: 11,
+		StartIndex:   11,
+		Count:        1,
+		Records: []ToolCallAuditRecord{{
+			ID:           testAuditID,
+			Tool:         testToolName,
+			Arguments:    `{"name":"app"}`,
+			Subject:      testSubject,
+			Status:       ToolCallStatusDenied,
+			ErrorMessage: "Insufficient permissions",
+			CreatedAt:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		}},
+		Links: []sysutils.Link{{Href: "/mcp-tool-call-audits?offset=5&limit=5", Rel: "prev"}},
+	}, nil).Once()
+
+	suite.handler.HandleToolCallAuditListRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var res ToolCallAuditListResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Equal(11, res.TotalResults)
+	suite.Equal(11, res.StartIndex)
+	suite.Equal(1, res.Count)
+	suite.Len(res.Records, 1)
+	suite.Equal(`{"name":"app"}`, res.Records[0].Arguments)
+	suite.Equal(ToolCallStatusDenied, res.Records[0].Status)
+	suite.Equal("Insufficient permissions", res.Records[0].ErrorMessage)
+	suite.Len(res.Links, 1)
+}
+
+func (suite *ToolCallAuditHandlerTestSuite) TestHandleToolCallAuditListRequest_DefaultPagination() {
+	req := httptest.NewRequest(http.MethodGet, "/mcp-tool-call-audits", nil)
+	rr := httptest.NewRecorder()
+
+	suite.mockService.EXPECT().ListToolCallAudits(mock.Anything, ToolCallAuditFilter{},
+		serverconst.DefaultPageSize, 0).Return(&ToolCallAuditList{StartIndex: 1, Records: []ToolCallAuditRecord{}},
+		nil).Once()
+
+	suite.handler.HandleToolCallAuditListRequest(rr, req)
+	suite.Equal(http.StatusOK, rr.Code)
+
+	var res ToolCallAuditListResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &res))
+	suite.Empty(res.Records)
+}
+
+func (suite *ToolCallAuditHandlerTestSuite) TestHandleToolCallAuditListRequest_InvalidParams() {
+	cases := []struct {
+		name         string
+		query        string
+		expectedCode string
+	}{
+		{name: "InvalidLimit", query: "?limit=abc", expectedCode: ErrorInvalidLimit.Code},
+		{name: "InvalidOffset", query: "?offset=abc", expectedCode: ErrorInvalidOffset.Code},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/mcp-tool-call-audits"+tc.query, nil)
+			rr := httptest.NewRecorder()
+
+			suite.handler.HandleToolCallAuditListRequest(rr, req)
+			suite.Equal(http.StatusBadRequest, rr.Code)
+			suite.Contains(rr.Body.String(), tc.expectedCode)
+		})
+	}
+}
+
+func (suite *ToolCallAuditHandlerTestSuite) TestHandleToolCallAuditListRequest_ServiceError() {
+	cases := []struct {
+		name           string
+		svcErr         *serviceerror.ServiceError
+		expectedStatus int
+	}{
+		{name: "ClientError", svcErr: &ErrorInvalidToolCallStatus, expectedStatus: http.StatusBadRequest},
+		{name: "ServerError", svcErr: &serviceerror.InternalServerError,
+			expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/mcp-tool-call-audits", nil)
+			rr := httptest.NewRecorder()
+
+			suite.mockService.EXPECT().ListToolCallAudits(mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything).Return(nil, tc.svcErr).Once()
+
+			suite.handler.HandleToolCallAuditListRequest(rr, req)
+			suite.Equal(tc.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// toolCallAuditsPath is the path of the endpoint to query the tool call audit.
+const toolCallAuditsPath = "/mcp-tool-call-audits"
+
+// Initialize initializes the MCP tool call audit and registers its query API with the provided mux.
+func Initialize(mux *http.ServeMux) ToolCallAuditServiceInterface {
+	auditService := newToolCallAuditService(newToolCallAuditStore())
+	registerRoutes(mux, newToolCallAuditHandler(auditService))
+	return auditService
+}
+
+// registerRoutes registers the HTTP routes for querying the tool call audit.
+func registerRoutes(mux *http.ServeMux, handler *toolCallAuditHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+toolCallAuditsPath, handler.HandleToolCallAuditListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+toolCallAuditsPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
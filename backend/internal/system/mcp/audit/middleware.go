@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	mcpauth "github.com/thunder-id/thunderid/internal/system/mcp/auth"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+const methodCallTool = "tools/call"
+
+// NewAuditMiddleware returns MCP middleware that records every tool invocation in the tool call audit, along
+// with the caller and the outcome of the call. It must run before the authorization middleware so that denied
+// invocations are recorded as well.
+func NewAuditMiddleware(auditService ToolCallAuditServiceInterface) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method != methodCallTool {
+				return next(ctx, method, req)
+			}
+			params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+			if !ok || params == nil {
+				return next(ctx, method, req)
+			}
+
+			result, err := next(ctx, method, req)
+
+			callerCtx := mcpauth.WithCallerSecurityContext(ctx, req)
+			clientID, _ := security.GetAttribute(callerCtx, "client_id").(string)
+			status, errorMessage := getToolCallOutcome(result, err)
+			// The invocation is recorded even if the client cancelled the request while the tool was running.
+			auditService.RecordToolCall(context.WithoutCancel(ctx), ToolCallAuditRecord{
+				Tool:         params.Name,
+				Arguments:    string(params.Arguments),
+				Subject:      security.GetSubject(callerCtx),
+				ClientID:     clientID,
+				Status:       status,
+				ErrorMessage: errorMessage,
+			})
+
+			return result, err
+		}
+	}
+}
+
+// getToolCallOutcome returns the status and the error message of a tool invocation from the result returned
+// by the tool or the error returned for the request.
+func getToolCallOutcome(result mcp.Result, err error) (ToolCallStatus, string) {
+	if err != nil {
+		var rpcErr *jsonrpc.Error
+		if errors.As(err, &rpcErr) && rpcErr.Code == mcpauth.CodeToolAccessDenied {
+			return ToolCallStatusDenied, rpcErr.Message
+		}
+		return ToolCallStatusFailed, err.Error()
+	}
+
+	callResult, ok := result.(*mcp.CallToolResult)
+	if !ok || callResult == nil || !callResult.IsError {
+		return ToolCallStatusSuccess, ""
+	}
+	for _, content := range callResult.Content {
+		if text, ok := content.(*mcp.TextContent); ok {
+			return ToolCallStatusFailed, text.Text
+		}
+	}
+	return ToolCallStatusFailed, ""
+}
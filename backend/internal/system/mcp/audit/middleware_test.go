@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/auth"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	mcpauth "github.com/thunder-id/thunderid/internal/system/mcp/auth"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+type AuditMiddlewareTestSuite struct {
+	suite.Suite
+	mockService *ToolCallAuditServiceInterfaceMock
+	rootPerm    string
+}
+
+func TestAuditMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditMiddlewareTestSuite))
+}
+
+func (suite *AuditMiddlewareTestSuite) SetupTest() {
+	security.InitSystemPermissions("")
+	suite.rootPerm = security.GetSystemPermissions().Root
+	suite.mockService = NewToolCallAuditServiceInterfaceMock(suite.T())
+}
+
+// newCallToolRequest builds a tool call request carrying a verified token with the given scope.
+func (suite *AuditMiddlewareTestSuite) newCallToolRequest(arguments, scope string) *mcp.CallToolRequest {
+	return &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: testToolName, Arguments: json.RawMessage(arguments)},
+		Extra: &mcp.RequestExtra{
+			TokenInfo: &auth.TokenInfo{
+				UserID: testSubject,
+				Extra: map[string]any{
+					"token": "test-token",
+					"claims": map[string]interface{}{"sub": testSubject, "client_id": "mcp-client",
+						"scope": scope},
+				},
+			},
+		},
+	}
+}
+
+// newHandler returns the audit middleware wrapping the authorization middleware and the given handler, in
+// the order they are added to the MCP server.
+func (suite *AuditMiddlewareTestSuite) newHandler(next mcp.MethodHandler) mcp.MethodHandler {
+	return NewAuditMiddleware(suite.mockService)(mcpauth.NewAuthorizationMiddleware()(next))
+}
+
+func (suite *AuditMiddlewareTestSuite) TestCallTool_Success() {
+	suite.mockService.EXPECT().RecordToolCall(mock.Anything, ToolCallAuditRecord{
+		Tool:      testToolName,
+		Arguments: `{"name":"app"}`,
+		Subject:   testSubject,
+		ClientID:  "mcp-client",
+		Status:    ToolCallStatusSuccess,
+	}).Once()
+
+	handler := suite.newHandler(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{}, nil
+	})
+	result, err := handler(context.Background(), methodCallTool,
+		suite.newCallToolRequest(`{"name":"app"}`, suite.rootPerm))
+
+	suite.NoError(err)
+	suite.NotNil(result)
+}
+
+func (suite *AuditMiddlewareTestSuite) TestCallTool_ToolError() {
+	suite.mockService.EXPECT().RecordToolCall(mock.Anything, mock.MatchedBy(func(record ToolCallAuditRecord) bool {
+		return record.Status == ToolCallStatusFailed && record.ErrorMessage == "application name is required"
+	})).Once()
+
+	handler := suite.newHandler(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{
+			IsError: true,
+			Content: []mcp.Content{&mcp.TextContent{Text: "application name is required"}},
+		}, nil
+	})
+	_, err := handler(context.Background(), methodCallTool, suite.newCallToolRequest(`{}`, suite.rootPerm))
+
+	suite.NoError(err)
+}
+
+func (suite *AuditMiddlewareTestSuite) TestCallTool_RequestError() {
+	suite.mockService.EXPECT().RecordToolCall(mock.Anything, mock.MatchedBy(func(record ToolCallAuditRecord) bool {
+		return record.Status == ToolCallStatusFailed && record.ErrorMessage == "invalid params"
+	})).Once()
+
+	handler := suite.newHandler(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return nil, errors.New("invalid params")
+	})
+	_, err := handler(context.Background(), methodCallTool, suite.newCallToolRequest(`{}`, suite.rootPerm))
+
+	suite.Error(err)
+}
+
+func (suite *AuditMiddlewareTestSuite) TestCallTool_Denied() {
+	called := false
+	suite.mockService.EXPECT().RecordToolCall(mock.Anything, mock.MatchedBy(func(record ToolCallAuditRecord) bool {
+		return record.Status == ToolCallStatusDenied && record.Subject == testSubject &&
+			record.Arguments == `{"name":"app"}`
+	})).Once()
+
+	handler := suite.newHandler(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		called = true
+		return &mcp.CallToolResult{}, nil
+	})
+	_, err := handler(context.Background(), methodCallTool, suite.newCallToolRequest(`{"name":"app"}`, "openid"))
+
+	suite.Error(err)
+	suite.False(called)
+}
+
+func (suite *AuditMiddlewareTestSuite) TestCallTool_RecordedWhenCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.mockService.EXPECT().RecordToolCall(mock.Anything, mock.Anything).
+		Run(func(recordCtx context.Context, _ ToolCallAuditRecord) {
+			suite.NoError(recordCtx.Err())
+		}).Once()
+
+	handler := suite.newHandler(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		cancel()
+		return nil, ctx.Err()
+	})
+	_, err := handler(ctx, methodCallTool, suite.newCallToolRequest(`{}`, suite.rootPerm))
+
+	suite.Error(err)
+}
+
+func (suite *AuditMiddlewareTestSuite) TestOtherMethodsNotRecorded() {
+	handler := suite.newHandler(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ListToolsResult{}, nil
+	})
+	_, err := handler(context.Background(), "tools/list", &mcp.ListToolsRequest{})
+
+	suite.NoError(err)
+	suite.mockService.AssertNotCalled(suite.T(), "RecordToolCall", mock.Anything, mock.Anything)
+}
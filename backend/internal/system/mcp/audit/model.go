@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package audit records the MCP tool invocations and provides the API to query them.
+package audit
+
+import (
+	"time"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ToolCallStatus defines the outcome of an MCP tool invocation recorded in the tool call audit.
+type ToolCallStatus string
+
+const (
+	// ToolCallStatusSuccess indicates the tool completed without reporting an error.
+	ToolCallStatusSuccess ToolCallStatus = "SUCCESS"
+	// ToolCallStatusFailed indicates the tool reported an error or the invocation could not be completed.
+	ToolCallStatusFailed ToolCallStatus = "FAILED"
+	// ToolCallStatusDenied indicates the caller was not permitted to invoke the tool.
+	ToolCallStatusDenied ToolCallStatus = "DENIED"
+)
+
+// ToolCallAuditRecord represents an audit record of an MCP tool invocation. Sensitive values in the arguments
+// are redacted before the record is stored.
+type ToolCallAuditRecord struct {
+	ID           string
+	Tool         string
+	Arguments    string
+	Subject      string
+	ClientID     string
+	Status       ToolCallStatus
+	ErrorMessage string
+	CreatedAt    time.Time
+}
+
+// ToolCallAuditFilter represents the optional filters applied when querying the tool call audit.
+type ToolCallAuditFilter struct {
+	Tool    string
+	Status  ToolCallStatus
+	Subject string
+}
+
+// ToolCallAuditList represents a page of tool call audit records.
+type ToolCallAuditList struct {
+	TotalResults int
+	StartIndex   int
+	Count        int
+	Records      []ToolCallAuditRecord
+	Links        []sysutils.Link
+}
+
+// ToolCallAuditResponse represents the response structure for a tool call audit record.
+type ToolCallAuditResponse struct {
+	ID           string         `json:"id"`
+	Tool         string         `json:"tool"`
+	Arguments    string         `json:"arguments,omitempty"`
+	Subject      string         `json:"subject,omitempty"`
+	ClientID     string         `json:"clientId,omitempty"`
+	Status       ToolCallStatus `json:"status"`
+	ErrorMessage string         `json:"errorMessage,omitempty"`
+	CreatedAt    time.Time      `json:"createdAt"`
+}
+
+// ToolCallAuditListResponse represents the response structure for a page of tool call audit records.
+type ToolCallAuditListResponse struct {
+	TotalResults int                     `json:"totalResults"`
+	StartIndex   int                     `json:"startIndex"`
+	Count        int                     `json:"count"`
+	Records      []ToolCallAuditResponse `json:"records"`
+	Links        []sysutils.Link         `json:"links"`
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// maxArgumentsLength is the maximum length of the tool arguments stored in a tool call audit record.
+	maxArgumentsLength = 4096
+	// maxErrorMessageLength is the maximum length of the error message stored in a tool call audit record.
+	maxErrorMessageLength = 1024
+	// redactedValue replaces the values of sensitive tool arguments.
+	redactedValue = "******"
+)
+
+// sensitiveArgumentKeys lists the fragments of argument names whose values are redacted. Argument names are
+// compared in lower case with underscores and hyphens removed.
+var sensitiveArgumentKeys = []string{"password", "passwd", "secret", "token", "credential", "apikey", "privatekey"}
+
+// ToolCallAuditServiceInterface defines the interface for recording and querying the audit of MCP tool
+// invocations.
+type ToolCallAuditServiceInterface interface {
+	RecordToolCall(ctx context.Context, record ToolCallAuditRecord)
+	ListToolCallAudits(ctx context.Context, filter ToolCallAuditFilter, limit, offset int) (
+		*ToolCallAuditList, *serviceerror.ServiceError)
+	PseudonymizeSubject(ctx context.Context, subject, pseudonym string) (int64, *serviceerror.ServiceError)
+}
+
+// toolCallAuditService implements ToolCallAuditServiceInterface.
+type toolCallAuditService struct {
+	store  toolCallAuditStoreInterface
+	logger *log.Logger
+}
+
+// newToolCallAuditService returns a new instance of ToolCallAuditServiceInterface.
+func newToolCallAuditService(store toolCallAuditStoreInterface) ToolCallAuditServiceInterface {
+	return &toolCallAuditService{
+		store:  store,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "MCPToolCallAuditService")),
+	}
+}
+
+// RecordToolCall records an MCP tool invocation. The arguments are expected as the raw JSON received from the
+// client, and the values of sensitive arguments are redacted before the record is stored. Failures to record
+// the invocation are logged and do not affect the tool call.
+func (s *toolCallAuditService) RecordToolCall(ctx context.Context, record ToolCallAuditRecord) {
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate UUID for the tool call audit record", log.Error(err))
+		return
+	}
+	record.ID = id
+	record.CreatedAt = time.Now().UTC()
+	record.Arguments = truncate(redactArguments(record.Arguments), maxArgumentsLength)
+	record.ErrorMessage = truncate(record.ErrorMessage, maxErrorMessageLength)
+
+	if err := s.store.createToolCallAudit(ctx, record); err != nil {
+		s.logger.Error("Failed to record the MCP tool call", log.String("tool", record.Tool),
+			log.String("status", string(record.Status)), log.Error(err))
+	}
+}
+
+// ListToolCallAudits retrieves a page of the tool call audit records matching the filters, most recent first.
+func (s *toolCallAuditService) ListToolCallAudits(ctx context.Context, filter ToolCallAuditFilter, limit,
+	offset int) (*ToolCallAuditList, *serviceerror.ServiceError) {
+	if limit <= 0 || limit > serverconst.MaxPageSize {
+		return nil, &ErrorInvalidLimit
+	}
+	if offset < 0 {
+		return nil, &ErrorInvalidOffset
+	}
+	if filter.Status != "" && filter.Status != ToolCallStatusSuccess && filter.Status != ToolCallStatusFailed &&
+		filter.Status != ToolCallStatusDenied {
+		return nil, &ErrorInvalidToolCallStatus
+	}
+
+	totalCount, err := s.store.countToolCallAudits(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to count tool call audit records", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	records, err := s.store.listToolCallAudits(ctx, filter, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list tool call audit records", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &ToolCallAuditList{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(records),
+		Records:      records,
+		Links: sysutils.BuildPaginationLinks(toolCallAuditsPath, limit, offset, totalCount,
+			getToolCallAuditFilterQuery(filter)),
+	}, nil
+}
+
+// PseudonymizeSubject replaces the subject of the tool call audit records of a subject with a pseudonym, so that
+// the records are kept without identifying the subject. It returns the number of records updated.
+func (s *toolCallAuditService) PseudonymizeSubject(ctx context.Context, subject, pseudonym string) (
+	int64, *serviceerror.ServiceError) {
+	if subject == "" || pseudonym == "" {
+		return 0, &serviceerror.InternalServerError
+	}
+
+	updated, err := s.store.pseudonymizeSubject(ctx, subject, pseudonym)
+	if err != nil {
+		s.logger.Error("Failed to pseudonymize the subject of tool call audit records", log.Error(err))
+		return 0, &serviceerror.InternalServerError
+	}
+
+	return updated, nil
+}
+
+// redactArguments returns the JSON encoding of the tool arguments with the values of sensitive arguments
+// replaced, including those nested in objects and arrays. Arguments that are not valid JSON are not recorded
+// since they cannot be redacted.
+func redactArguments(arguments string) string {
+	if arguments == "" {
+		return ""
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(arguments), &value); err != nil {
+		return ""
+	}
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return ""
+	}
+	return string(redacted)
+}
+
+// redactValue replaces the values of sensitive keys in the given JSON value.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveArgument(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+// isSensitiveArgument reports whether the value of the argument with the given name must be redacted.
+func isSensitiveArgument(name string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	for _, key := range sensitiveArgumentKeys {
+		if strings.Contains(normalized, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// truncate shortens the value to the given maximum length.
+func truncate(value string, maxLength int) string {
+	if len(value) > maxLength {
+		return value[:maxLength]
+	}
+	return value
+}
+
+// getToolCallAuditFilterQuery returns the query string fragment of the filters to be appended to pagination
+// links.
+func getToolCallAuditFilterQuery(filter ToolCallAuditFilter) string {
+	var query strings.Builder
+	if filter.Tool != "" {
+		query.WriteString("&tool=" + url.QueryEscape(filter.Tool))
+	}
+	if filter.Status != "" {
+		query.WriteString("&status=" + string(filter.Status))
+	}
+	if filter.Subject != "" {
+		query.WriteString("&subject=" + url.QueryEscape(filter.Subject))
+	}
+	return query.String()
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+type ToolCallAuditServiceTestSuite struct {
+	suite.Suite
+	mockStore *toolCallAuditStoreInterfaceMock
+	service   *toolCallAuditService
+}
+
+func TestToolCallAuditServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ToolCallAuditServiceTestSuite))
+}
+
+func (suite *ToolCallAuditServiceTestSuite) SetupSuite() {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("", &config.Config{})
+	if err != nil {
+		suite.T().Fatalf("Failed to initialize server runtime: %v", err)
+	}
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TearDownSuite() {
+	config.ResetServerRuntime()
+}
+
+func (suite *ToolCallAuditServiceTestSuite) SetupTest() {
+	suite.mockStore = newToolCallAuditStoreInterfaceMock(suite.T())
+	suite.service = &toolCallAuditService{
+		store:  suite.mockStore,
+		logger: log.GetLogger(),
+	}
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestNewToolCallAuditService() {
+	suite.NotNil(newToolCallAuditService(suite.mockStore))
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestRecordToolCall_RedactsArguments() {
+	var stored ToolCallAuditRecord
+	suite.mockStore.EXPECT().createToolCallAudit(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, record ToolCallAuditRecord) {
+			stored = record
+		}).Return(nil).Once()
+
+	suite.service.RecordToolCall(context.Background(), ToolCallAuditRecord{
+		Tool: testToolName,
+		Arguments: `{"name":"app","inboundAuthConfig":[{"config":{"client_secret":"s3cret",` +
+			`"redirect_uris":["https://localhost"]}}],"Password":"p@ss","refresh-token":"abc"}`,
+		Subject: testSubject,
+		Status:  ToolCallStatusSuccess,
+	})
+
+	suite.NotEmpty(stored.ID)
+	suite.False(stored.CreatedAt.IsZero())
+	suite.JSONEq(`{"name":"app","inboundAuthConfig":[{"config":{"client_secret":"******",`+
+		`"redirect_uris":["https://localhost"]}}],"Password":"******","refresh-token":"******"}`, stored.Arguments)
+	suite.NotContains(stored.Arguments, "s3cret")
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestRecordToolCall_DropsInvalidArguments() {
+	suite.mockStore.EXPECT().createToolCallAudit(mock.Anything, mock.MatchedBy(func(record ToolCallAuditRecord) bool {
+		return record.Arguments == ""
+	})).Return(nil).Once()
+
+	suite.service.RecordToolCall(context.Background(), ToolCallAuditRecord{
+		Tool:      testToolName,
+		Arguments: `{"password":"p@ss"`,
+		Status:    ToolCallStatusFailed,
+	})
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestRecordToolCall_TruncatesLongValues() {
+	suite.mockStore.EXPECT().createToolCallAudit(mock.Anything, mock.MatchedBy(func(record ToolCallAuditRecord) bool {
+		return len(record.Arguments) == maxArgumentsLength && len(record.ErrorMessage) == maxErrorMessageLength
+	})).Return(nil).Once()
+
+	suite.service.RecordToolCall(context.Background(), ToolCallAuditRecord{
+		Tool:         testToolName,
+		Arguments:    `{"description":"` + strings.Repeat("x", maxArgumentsLength) + `"}`,
+		Status:       ToolCallStatusFailed,
+		ErrorMessage: strings.Repeat("x", maxErrorMessageLength+10),
+	})
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestRecordToolCall_StoreErrorIsIgnored() {
+	suite.mockStore.EXPECT().createToolCallAudit(mock.Anything, mock.Anything).Return(errors.New("db err")).Once()
+
+	suite.NotPanics(func() {
+		suite.service.RecordToolCall(context.Background(), ToolCallAuditRecord{Tool: testToolName})
+	})
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestListToolCallAudits() {
+	filter := ToolCallAuditFilter{Tool: testToolName, Status: ToolCallStatusDenied}
+	records := []ToolCallAuditRecord{{ID: testAuditID, Tool: testToolName}}
+	suite.mockStore.EXPECT().countToolCallAudits(mock.Anything, filter).Return(3, nil).Once()
+	suite.mockStore.EXPECT().listToolCallAudits(mock.Anything, filter, 1, 1).Return(records, nil).Once()
+
+	result, err := suite.service.ListToolCallAudits(context.Background(), filter, 1, 1)
+	suite.Nil(err)
+	suite.Equal(3, result.TotalResults)
+	suite.Equal(2, result.StartIndex)
+	suite.Equal(1, result.Count)
+	suite.Equal(records, result.Records)
+	suite.NotEmpty(result.Links)
+	for _, link := range result.Links {
+		suite.Contains(link.Href, "/mcp-tool-call-audits?")
+		suite.Contains(link.Href, "&tool="+testToolName+"&status=DENIED")
+	}
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestListToolCallAudits_InvalidParams() {
+	cases := []struct {
+		name         string
+		filter       ToolCallAuditFilter
+		limit        int
+		offset       int
+		expectedCode string
+	}{
+		{name: "ZeroLimit", limit: 0, expectedCode: ErrorInvalidLimit.Code},
+		{name: "LimitTooLarge", limit: serverconst.MaxPageSize + 1, expectedCode: ErrorInvalidLimit.Code},
+		{name: "NegativeOffset", limit: 10, offset: -1, expectedCode: ErrorInvalidOffset.Code},
+		{name: "InvalidStatus", filter: ToolCallAuditFilter{Status: "PENDING"}, limit: 10,
+			expectedCode: ErrorInvalidToolCallStatus.Code},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			result, err := suite.service.ListToolCallAudits(context.Background(), tc.filter, tc.limit, tc.offset)
+			suite.Nil(result)
+			suite.Equal(tc.expectedCode, err.Code)
+		})
+	}
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestListToolCallAudits_StoreError() {
+	suite.mockStore.EXPECT().countToolCallAudits(mock.Anything, ToolCallAuditFilter{}).
+		Return(0, errors.New("db err")).Once()
+
+	result, err := suite.service.ListToolCallAudits(context.Background(), ToolCallAuditFilter{}, 10, 0)
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+
+	suite.mockStore.EXPECT().countToolCallAudits(mock.Anything, ToolCallAuditFilter{}).Return(1, nil).Once()
+	suite.mockStore.EXPECT().listToolCallAudits(mock.Anything, ToolCallAuditFilter{}, 10, 0).
+		Return(nil, errors.New("db err")).Once()
+
+	result, err = suite.service.ListToolCallAudits(context.Background(), ToolCallAuditFilter{}, 10, 0)
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestPseudonymizeSubject() {
+	suite.mockStore.EXPECT().pseudonymizeSubject(mock.Anything, "user-1", "erased-1").Return(int64(3), nil).Once()
+
+	updated, err := suite.service.PseudonymizeSubject(context.Background(), "user-1", "erased-1")
+	suite.Nil(err)
+	suite.Equal(int64(3), updated)
+}
+
+func (suite *ToolCallAuditServiceTestSuite) TestPseudonymizeSubject_Failures() {
+	updated, err := suite.service.PseudonymizeSubject(context.Background(), "", "erased-1")
+	suite.Zero(updated)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+
+	suite.mockStore.EXPECT().pseudonymizeSubject(mock.Anything, "user-1", "erased-1").
+		Return(int64(0), errors.New("db err")).Once()
+
+	updated, err = suite.service.PseudonymizeSubject(context.Background(), "user-1", "erased-1")
+	suite.Zero(updated)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
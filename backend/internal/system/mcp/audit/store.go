@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+// toolCallAuditRetention is the duration for which the audit records of MCP tool invocations are retained.
+const toolCallAuditRetention = 90 * 24 * time.Hour
+
+// toolCallAuditStoreInterface defines the interface for MCP tool call audit storage operations.
+type toolCallAuditStoreInterface interface {
+	createToolCallAudit(ctx context.Context, record ToolCallAuditRecord) error
+	countToolCallAudits(ctx context.Context, filter ToolCallAuditFilter) (int, error)
+	listToolCallAudits(ctx context.Context, filter ToolCallAuditFilter, limit, offset int) (
+		[]ToolCallAuditRecord, error)
+	pseudonymizeSubject(ctx context.Context, subject, pseudonym string) (int64, error)
+}
+
+// toolCallAuditStore is the runtime database implementation of toolCallAuditStoreInterface.
+type toolCallAuditStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newToolCallAuditStore returns a new instance of toolCallAuditStoreInterface.
+func newToolCallAuditStore() toolCallAuditStoreInterface {
+	return &toolCallAuditStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// createToolCallAudit records an MCP tool invocation.
+func (s *toolCallAuditStore) createToolCallAudit(ctx context.Context, record ToolCallAuditRecord) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateToolCallAudit, record.ID, record.Tool,
+		dbutils.ToNullableString(record.Arguments), dbutils.ToNullableString(record.Subject), dbutils.ToNullableString(record.ClientID),
+		string(record.Status), dbutils.ToNullableString(record.ErrorMessage), record.CreatedAt,
+		record.CreatedAt.Add(toolCallAuditRetention), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// countToolCallAudits returns the number of tool call audit records matching the filters.
+func (s *toolCallAuditStore) countToolCallAudits(ctx context.Context, filter ToolCallAuditFilter) (int, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountToolCallAudits, filter.Tool, string(filter.Status),
+		filter.Subject, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+
+	return parseIntField(results[0]["total"], "total")
+}
+
+// listToolCallAudits retrieves a page of the tool call audit records matching the filters, most recent first.
+func (s *toolCallAuditStore) listToolCallAudits(ctx context.Context, filter ToolCallAuditFilter,
+	limit, offset int) ([]ToolCallAuditRecord, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListToolCallAudits, filter.Tool, string(filter.Status),
+		filter.Subject, s.deploymentID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	records := make([]ToolCallAuditRecord, 0, len(results))
+	for _, row := range results {
+		record, err := buildToolCallAuditFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build tool call audit record from result row: %w", err)
+		}
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+// pseudonymizeSubject replaces the subject of the tool call audit records of a subject with a pseudonym.
+func (s *toolCallAuditStore) pseudonymizeSubject(ctx context.Context, subject, pseudonym string) (int64, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryPseudonymizeSubject, subject, pseudonym, s.deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rows, nil
+}
+
+// buildToolCallAuditFromResultRow constructs a ToolCallAuditRecord from a database result row.
+func buildToolCallAuditFromResultRow(row map[string]interface{}) (*ToolCallAuditRecord, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse id as string")
+	}
+	toolName, ok := row["tool_name"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse tool_name as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, errors.New("failed to parse status as string")
+	}
+
+	// Optional columns may be NULL.
+	arguments, _ := row["arguments"].(string)
+	subject, _ := row["subject"].(string)
+	clientID, _ := row["client_id"].(string)
+	errorMessage, _ := row["error_message"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ToolCallAuditRecord{
+		ID:           id,
+		Tool:         toolName,
+		Arguments:    arguments,
+		Subject:      subject,
+		ClientID:     clientID,
+		Status:       ToolCallStatus(status),
+		ErrorMessage: errorMessage,
+		CreatedAt:    createdAt,
+	}, nil
+}
+
+// parseIntField parses an integer column value returned by the database driver.
+func parseIntField(value interface{}, fieldName string) (int, error) {
+	switch v := value.(type) {
+	case int64:
+		return int(v), nil
+	case int32:
+		return int(v), nil
+	case int:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("failed to parse %s as integer", fieldName)
+	}
+}
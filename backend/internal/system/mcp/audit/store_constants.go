@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateToolCallAudit is the query to record an MCP tool invocation.
+	queryCreateToolCallAudit = dbmodel.DBQuery{
+		ID: "MCQ-TA-01",
+		Query: `INSERT INTO "MCP_TOOL_CALL_AUDIT" ` +
+			`(ID, TOOL_NAME, ARGUMENTS, SUBJECT, CLIENT_ID, STATUS, ERROR_MESSAGE, CREATED_AT, EXPIRY_TIME, ` +
+			`DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+	}
+
+	// queryToolCallAuditFilter is the condition shared by the tool call audit queries. Empty filter values match
+	// all records.
+	queryToolCallAuditFilter = `WHERE ($1 = '' OR TOOL_NAME = $1) AND ($2 = '' OR STATUS = $2) ` +
+		`AND ($3 = '' OR SUBJECT = $3) AND DEPLOYMENT_ID = $4`
+
+	// queryCountToolCallAudits is the query to count the tool call audit records matching the filters.
+	queryCountToolCallAudits = dbmodel.DBQuery{
+		ID:    "MCQ-TA-02",
+		Query: `SELECT COUNT(*) as total FROM "MCP_TOOL_CALL_AUDIT" ` + queryToolCallAuditFilter,
+	}
+
+	// queryListToolCallAudits is the query to list a page of the tool call audit records matching the filters,
+	// most recent first.
+	queryListToolCallAudits = dbmodel.DBQuery{
+		ID: "MCQ-TA-03",
+		Query: `SELECT ID, TOOL_NAME, ARGUMENTS, SUBJECT, CLIENT_ID, STATUS, ERROR_MESSAGE, CREATED_AT ` +
+			`FROM "MCP_TOOL_CALL_AUDIT" ` + queryToolCallAuditFilter + ` ORDER BY CREATED_AT DESC LIMIT $5 OFFSET $6`,
+	}
+
+	// queryPseudonymizeSubject is the query to replace the subject of the tool call audit records of a subject.
+	queryPseudonymizeSubject = dbmodel.DBQuery{
+		ID:    "MCQ-TA-04",
+		Query: `UPDATE "MCP_TOOL_CALL_AUDIT" SET SUBJECT = $2 WHERE SUBJECT = $1 AND DEPLOYMENT_ID = $3`,
+	}
+)
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testAuditID      = "tool-call-audit-1"
+	testDeploymentID = "test-deployment"
+	testToolName     = "thunderid_create_application"
+	testSubject      = "user123"
+)
+
+type ToolCallAuditStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *toolCallAuditStore
+}
+
+func TestToolCallAuditStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ToolCallAuditStoreTestSuite))
+}
+
+func (suite *ToolCallAuditStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &toolCallAuditStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *ToolCallAuditStoreTestSuite) TestCreateToolCallAudit() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateToolCallAudit, testAuditID,
+		testToolName, `{"name":"app"}`, testSubject, nil, "SUCCESS", nil, createdAt,
+		createdAt.Add(toolCallAuditRetention), testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.createToolCallAudit(context.Background(), ToolCallAuditRecord{
+		ID:        testAuditID,
+		Tool:      testToolName,
+		Arguments: `{"name":"app"}`,
+		Subject:   testSubject,
+		Status:    ToolCallStatusSuccess,
+		CreatedAt: createdAt,
+	})
+	suite.NoError(err)
+}
+
+func (suite *ToolCallAuditStoreTestSuite) TestCreateToolCallAudit_WithError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryCreateToolCallAudit, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return(int64(0), errors.New("db err")).Once()
+
+	err := suite.store.createToolCallAudit(context.Background(), ToolCallAuditRecord{ID: testAuditID})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to execute query")
+}
+
+func (suite *ToolCallAuditStoreTestSuite) TestCreateToolCallAudit_DBClientError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.createToolCallAudit(context.Background(), ToolCallAuditRecord{ID: testAuditID})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *ToolCallAuditStoreTestSuite) TestCountToolCallAudits() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryCountToolCallAudits, testToolName,
+		"DENIED", testSubject, testDeploymentID).Return([]map[string]interface{}{{"total": int64(3)}}, nil).Once()
+
+	count, err := suite.store.countToolCallAudits(context.Background(), ToolCallAuditFilter{
+		Tool:    testToolName,
+		Status:  ToolCallStatusDenied,
+		Subject: testSubject,
+	})
+	suite.NoError(err)
+	suite.Equal(3, count)
+}
+
+func (suite *ToolCallAuditStoreTestSuite) TestCountToolCallAudits_WithError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(nil, errors.New("db err")).Once()
+
+	count, err := suite.store.countToolCallAudits(context.Background(), ToolCallAuditFilter{})
+	suite.Error(err)
+	suite.Zero(count)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *ToolCallAuditStoreTestSuite) TestListToolCallAudits() {
+	rows := []map[string]interface{}{
+		{"id": testAuditID, "tool_name": testToolName, "arguments": `{"clientSecret":"******"}`,
+			"subject": testSubject, "client_id": "mcp-client", "status": "FAILED",
+			"error_message": "invalid input", "created_at": "2026-01-02 03:04:05"},
+		{"id": "tool-call-audit-2", "tool_name": "thunderid_list_flows", "arguments": nil, "subject": nil,
+			"client_id": nil, "status": "SUCCESS", "error_message": nil,
+			"created_at": time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)},
+	}
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListToolCallAudits, "", "", "",
+		testDeploymentID, 10, 20).Return(rows, nil).Once()
+
+	records, err := suite.store.listToolCallAudits(context.Background(), ToolCallAuditFilter{}, 10, 20)
+	suite.NoError(err)
+	suite.Len(records, 2)
+	suite.Equal(testToolName, records[0].Tool)
+	suite.Equal(`{"clientSecret":"******"}`, records[0].Arguments)
+	suite.Equal(testSubject, records[0].Subject)
+	suite.Equal("mcp-client", records[0].ClientID)
+	suite.Equal(ToolCallStatusFailed, records[0].Status)
+	suite.Equal("invalid input", records[0].ErrorMessage)
+	suite.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), records[0].CreatedAt)
+	suite.Empty(records[1].Arguments)
+	suite.Empty(records[1].Subject)
+}
+
+func (suite *ToolCallAuditStoreTestSuite) TestListToolCallAudits_InvalidRow() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryListToolCallAudits, "", "", "",
+		testDeploymentID, 10, 0).Return([]map[string]interface{}{{"id": testAuditID}}, nil).Once()
+
+	records, err := suite.store.listToolCallAudits(context.Background(), ToolCallAuditFilter{}, 10, 0)
+	suite.Nil(records)
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to build tool call audit record")
+}
+
+func (suite *ToolCallAuditStoreTestSuite) TestPseudonymizeSubject() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryPseudonymizeSubject, testSubject,
+		"erased-1", testDeploymentID).Return(int64(2), nil).Once()
+
+	updated, err := suite.store.pseudonymizeSubject(context.Background(), testSubject, "erased-1")
+	suite.NoError(err)
+	suite.Equal(int64(2), updated)
+}
+
+func (suite *ToolCallAuditStoreTestSuite) TestPseudonymizeSubject_WithError() {
+	suite.mockDBProvider.EXPECT().GetRuntimeDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(mock.Anything, queryPseudonymizeSubject, mock.Anything,
+		mock.Anything, mock.Anything).Return(int64(0), errors.New("db err")).Once()
+
+	updated, err := suite.store.pseudonymizeSubject(context.Background(), testSubject, "erased-1")
+	suite.Error(err)
+	suite.Zero(updated)
+	suite.Contains(err.Error(), "failed to execute query")
+}
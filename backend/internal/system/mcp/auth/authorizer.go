@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// CodeToolAccessDenied is the JSON-RPC error code returned when the caller is not permitted to invoke a tool.
+// It is within the range reserved for implementation-defined server errors.
+const CodeToolAccessDenied = -32003
+
+// CodeResourceAccessDenied is the JSON-RPC error code returned when the caller is not permitted to read or
+// subscribe to a resource. It is within the range reserved for implementation-defined server errors.
+const CodeResourceAccessDenied = -32004
+
+// CodeMethodAccessDenied is the JSON-RPC error code returned when the caller invokes a method the server does
+// not authorize. It is within the range reserved for implementation-defined server errors.
+const CodeMethodAccessDenied = -32005
+
+const (
+	methodCallTool              = "tools/call"
+	methodListTools             = "tools/list"
+	methodReadResource          = "resources/read"
+	methodSubscribeResource     = "resources/subscribe"
+	methodListResources         = "resources/list"
+	methodListResourceTemplates = "resources/templates/list"
+)
+
+// sessionMethods are the methods that manage the MCP session rather than access a tool or resource. Any caller
+// with a valid access token may invoke them.
+var sessionMethods = map[string]bool{
+	"initialize":                       true,
+	"ping":                             true,
+	"logging/setLevel":                 true,
+	"resources/unsubscribe":            true,
+	"notifications/initialized":        true,
+	"notifications/cancelled":          true,
+	"notifications/progress":           true,
+	"notifications/roots/list_changed": true,
+}
+
+// toolAccessDeniedData is the structured data of a tool access denied error.
+type toolAccessDeniedData struct {
+	Tool               string `json:"tool"`
+	RequiredPermission string `json:"requiredPermission"`
+}
+
+// resourceAccessDeniedData is the structured data of a resource access denied error.
+type resourceAccessDeniedData struct {
+	URI                string `json:"uri"`
+	RequiredPermission string `json:"requiredPermission"`
+}
+
+// NewAuthorizationMiddleware returns MCP middleware that authorizes each request against the caller's
+// security context. Every tool invocation requires the permission mapped to the tool, and reading or
+// subscribing to a resource requires the permission mapped to the resource. Tools and resources the caller
+// may not access are left out of the respective lists. Session methods are allowed, and every other method
+// is denied. The security context is built from the access token verified by the bearer token middleware and
+// is passed on to the tool and resource handlers.
+func NewAuthorizationMiddleware() mcp.Middleware {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "MCPAuthorizer"))
+
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			ctx = WithCallerSecurityContext(ctx, req)
+
+			switch method {
+			case methodCallTool:
+				params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+				if !ok || params == nil {
+					logger.Debug("Denied MCP tool invocation with unexpected parameters",
+						log.MaskedString("subject", security.GetSubject(ctx)))
+					return nil, toolAccessDeniedError("", security.ResolveMCPToolPermission(""))
+				}
+				if permission, allowed := isToolAllowed(ctx, params.Name); !allowed {
+					logger.Debug("Denied MCP tool invocation", log.String("tool", params.Name),
+						log.MaskedString("subject", security.GetSubject(ctx)))
+					return nil, toolAccessDeniedError(params.Name, permission)
+				}
+			case methodReadResource, methodSubscribeResource:
+				uri := getResourceURI(req)
+				if permission, allowed := isResourceAllowed(ctx, uri); uri == "" || !allowed {
+					logger.Debug("Denied MCP resource access", log.String("uri", uri),
+						log.MaskedString("subject", security.GetSubject(ctx)))
+					return nil, resourceAccessDeniedError(uri, permission)
+				}
+			case methodListTools, methodListResources, methodListResourceTemplates:
+				result, err := next(ctx, method, req)
+				if err == nil {
+					filterAllowedItems(ctx, result)
+				}
+				return result, err
+			default:
+				if !sessionMethods[method] {
+					logger.Debug("Denied MCP method", log.String("method", method),
+						log.MaskedString("subject", security.GetSubject(ctx)))
+					return nil, methodAccessDeniedError(method)
+				}
+			}
+
+			return next(ctx, method, req)
+		}
+	}
+}
+
+// WithCallerSecurityContext adds the security context of the caller to the context, using the access token
+// recorded in the request by the token verifier. Middleware that runs before the authorization middleware uses
+// it to identify the caller.
+func WithCallerSecurityContext(ctx context.Context, req mcp.Request) context.Context {
+	extra := req.GetExtra()
+	if extra == nil || extra.TokenInfo == nil {
+		return ctx
+	}
+	token, _ := extra.TokenInfo.Extra[tokenInfoExtraToken].(string)
+	claims, _ := extra.TokenInfo.Extra[tokenInfoExtraClaims].(map[string]interface{})
+	if token == "" || claims == nil {
+		return ctx
+	}
+	return security.WithVerifiedToken(ctx, token, claims)
+}
+
+// getResourceURI returns the URI of the resource targeted by a read or subscribe request.
+func getResourceURI(req mcp.Request) string {
+	switch params := req.GetParams().(type) {
+	case *mcp.ReadResourceParams:
+		if params != nil {
+			return params.URI
+		}
+	case *mcp.SubscribeParams:
+		if params != nil {
+			return params.URI
+		}
+	}
+	return ""
+}
+
+// isToolAllowed reports whether the caller holds the permission required to invoke the tool, and returns
+// that permission.
+func isToolAllowed(ctx context.Context, toolName string) (string, bool) {
+	permission := security.ResolveMCPToolPermission(toolName)
+	return permission, security.HasSufficientPermission(security.GetPermissions(ctx), permission)
+}
+
+// isResourceAllowed reports whether the caller holds the permission required to read the resource, and
+// returns that permission.
+func isResourceAllowed(ctx context.Context, uri string) (string, bool) {
+	permission := security.ResolveMCPResourcePermission(uri)
+	return permission, security.HasSufficientPermission(security.GetPermissions(ctx), permission)
+}
+
+// filterAllowedItems removes the tools, resources, and resource templates the caller may not access from
+// a list result.
+func filterAllowedItems(ctx context.Context, result mcp.Result) {
+	switch listResult := result.(type) {
+	case *mcp.ListToolsResult:
+		listResult.Tools = filterAllowed(listResult.Tools, func(tool *mcp.Tool) bool {
+			_, ok := isToolAllowed(ctx, tool.Name)
+			return ok
+		})
+	case *mcp.ListResourcesResult:
+		listResult.Resources = filterAllowed(listResult.Resources, func(resource *mcp.Resource) bool {
+			_, ok := isResourceAllowed(ctx, resource.URI)
+			return ok
+		})
+	case *mcp.ListResourceTemplatesResult:
+		listResult.ResourceTemplates = filterAllowed(listResult.ResourceTemplates,
+			func(template *mcp.ResourceTemplate) bool {
+				_, ok := isResourceAllowed(ctx, template.URITemplate)
+				return ok
+			})
+	}
+}
+
+// filterAllowed returns the items for which allowed returns true.
+func filterAllowed[T any](items []T, allowed func(T) bool) []T {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		if allowed(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// toolAccessDeniedError builds the structured error returned when a tool invocation is denied.
+func toolAccessDeniedError(toolName, permission string) error {
+	data, _ := json.Marshal(toolAccessDeniedData{Tool: toolName, RequiredPermission: permission})
+	return &jsonrpc.Error{
+		Code:    CodeToolAccessDenied,
+		Message: fmt.Sprintf("Insufficient permissions to invoke tool %q", toolName),
+		Data:    data,
+	}
+}
+
+// resourceAccessDeniedError builds the structured error returned when access to a resource is denied.
+func resourceAccessDeniedError(uri, permission string) error {
+	data, _ := json.Marshal(resourceAccessDeniedData{URI: uri, RequiredPermission: permission})
+	return &jsonrpc.Error{
+		Code:    CodeResourceAccessDenied,
+		Message: fmt.Sprintf("Insufficient permissions to access resource %q", uri),
+		Data:    data,
+	}
+}
+
+// methodAccessDeniedError builds the error returned when a method is not authorized.
+func methodAccessDeniedError(method string) error {
+	return &jsonrpc.Error{
+		Code:    CodeMethodAccessDenied,
+		Message: fmt.Sprintf("Method %q is not permitted", method),
+	}
+}
@@ -0,0 +1,373 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/auth"
+	"github.com/modelcontextprotocol/go-sdk/jsonrpc"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+const (
+	testRestrictedTool = "thunderid_list_flows"
+	testPublicTool     = "thunderid_integrate_react_sdk"
+	testResourceURI    = "thunderid://flows"
+)
+
+type AuthorizerTestSuite struct {
+	suite.Suite
+	rootPerm     string
+	flowViewPerm string
+}
+
+func TestAuthorizerTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizerTestSuite))
+}
+
+func (suite *AuthorizerTestSuite) SetupTest() {
+	security.InitSystemPermissions("")
+	suite.rootPerm = security.GetSystemPermissions().Root
+	suite.flowViewPerm = security.GetSystemPermissions().FlowView
+}
+
+// newRequestExtra builds request extra data carrying a verified token with the given scope.
+func (suite *AuthorizerTestSuite) newRequestExtra(scope string) *mcp.RequestExtra {
+	return &mcp.RequestExtra{
+		TokenInfo: &auth.TokenInfo{
+			UserID: "user123",
+			Extra: map[string]any{
+				tokenInfoExtraToken:  "test-token",
+				tokenInfoExtraClaims: map[string]interface{}{"sub": "user123", "scope": scope},
+			},
+		},
+	}
+}
+
+func (suite *AuthorizerTestSuite) newCallToolRequest(toolName, scope string) *mcp.CallToolRequest {
+	return &mcp.CallToolRequest{
+		Params: &mcp.CallToolParamsRaw{Name: toolName},
+		Extra:  suite.newRequestExtra(scope),
+	}
+}
+
+func (suite *AuthorizerTestSuite) TestCallTool_Allowed() {
+	var handlerCtx context.Context
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		handlerCtx = ctx
+		return &mcp.CallToolResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodCallTool,
+		suite.newCallToolRequest(testRestrictedTool, suite.rootPerm))
+
+	suite.NoError(err)
+	suite.NotNil(result)
+	suite.Equal("user123", security.GetSubject(handlerCtx))
+	suite.Contains(security.GetPermissions(handlerCtx), suite.rootPerm)
+}
+
+func (suite *AuthorizerTestSuite) TestCallTool_Denied() {
+	called := false
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		called = true
+		return &mcp.CallToolResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodCallTool,
+		suite.newCallToolRequest(testRestrictedTool, "openid"))
+
+	suite.Nil(result)
+	suite.False(called)
+
+	var rpcErr *jsonrpc.Error
+	suite.Require().True(errors.As(err, &rpcErr))
+	suite.Equal(int64(CodeToolAccessDenied), rpcErr.Code)
+
+	var data toolAccessDeniedData
+	suite.Require().NoError(json.Unmarshal(rpcErr.Data, &data))
+	suite.Equal(testRestrictedTool, data.Tool)
+	suite.Equal(suite.flowViewPerm, data.RequiredPermission)
+}
+
+func (suite *AuthorizerTestSuite) TestCallTool_DeniedWithoutToken() {
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	_, err := handler(context.Background(), methodCallTool,
+		&mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: testRestrictedTool}})
+
+	var rpcErr *jsonrpc.Error
+	suite.Require().True(errors.As(err, &rpcErr))
+	suite.Equal(int64(CodeToolAccessDenied), rpcErr.Code)
+}
+
+func (suite *AuthorizerTestSuite) TestCallTool_PublicToolAllowed() {
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodCallTool, suite.newCallToolRequest(testPublicTool, ""))
+
+	suite.NoError(err)
+	suite.NotNil(result)
+}
+
+func (suite *AuthorizerTestSuite) TestListTools_FiltersDeniedTools() {
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ListToolsResult{
+			Tools: []*mcp.Tool{{Name: testRestrictedTool}, {Name: testPublicTool}},
+		}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodListTools, &mcp.ListToolsRequest{
+		Params: &mcp.ListToolsParams{},
+		Extra:  suite.newRequestExtra("openid"),
+	})
+
+	suite.NoError(err)
+	listResult, ok := result.(*mcp.ListToolsResult)
+	suite.Require().True(ok)
+	suite.Require().Len(listResult.Tools, 1)
+	suite.Equal(testPublicTool, listResult.Tools[0].Name)
+}
+
+func (suite *AuthorizerTestSuite) TestListTools_ReturnsAllowedTools() {
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ListToolsResult{
+			Tools: []*mcp.Tool{{Name: testRestrictedTool}, {Name: testPublicTool}},
+		}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodListTools, &mcp.ListToolsRequest{
+		Params: &mcp.ListToolsParams{},
+		Extra:  suite.newRequestExtra(suite.rootPerm),
+	})
+
+	suite.NoError(err)
+	listResult, ok := result.(*mcp.ListToolsResult)
+	suite.Require().True(ok)
+	suite.Len(listResult.Tools, 2)
+}
+
+func (suite *AuthorizerTestSuite) TestCallTool_AllowedWithToolPermission() {
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.CallToolResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodCallTool,
+		suite.newCallToolRequest(testRestrictedTool, suite.flowViewPerm))
+
+	suite.NoError(err)
+	suite.NotNil(result)
+
+	_, err = handler(context.Background(), methodCallTool,
+		suite.newCallToolRequest("thunderid_update_flow", suite.flowViewPerm))
+
+	var rpcErr *jsonrpc.Error
+	suite.Require().True(errors.As(err, &rpcErr))
+	suite.Equal(int64(CodeToolAccessDenied), rpcErr.Code)
+}
+
+func (suite *AuthorizerTestSuite) TestCallTool_DeniedWithUnexpectedParams() {
+	called := false
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		called = true
+		return &mcp.CallToolResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	_, err := handler(context.Background(), methodCallTool, &mcp.CallToolRequest{
+		Extra: suite.newRequestExtra(suite.rootPerm),
+	})
+
+	suite.False(called)
+
+	var rpcErr *jsonrpc.Error
+	suite.Require().True(errors.As(err, &rpcErr))
+	suite.Equal(int64(CodeToolAccessDenied), rpcErr.Code)
+}
+
+func (suite *AuthorizerTestSuite) TestSessionMethods_Allowed() {
+	called := false
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		called = true
+		return nil, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	_, err := handler(context.Background(), "notifications/initialized", &mcp.InitializedRequest{
+		Params: &mcp.InitializedParams{},
+		Extra:  suite.newRequestExtra("openid"),
+	})
+
+	suite.NoError(err)
+	suite.True(called)
+}
+
+func (suite *AuthorizerTestSuite) TestUnknownMethods_Denied() {
+	called := false
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		called = true
+		return &mcp.ListPromptsResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	_, err := handler(context.Background(), "prompts/list", &mcp.ListPromptsRequest{
+		Params: &mcp.ListPromptsParams{},
+		Extra:  suite.newRequestExtra(suite.rootPerm),
+	})
+
+	suite.False(called)
+
+	var rpcErr *jsonrpc.Error
+	suite.Require().True(errors.As(err, &rpcErr))
+	suite.Equal(int64(CodeMethodAccessDenied), rpcErr.Code)
+}
+
+func (suite *AuthorizerTestSuite) TestReadResource_Allowed() {
+	called := false
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		called = true
+		return &mcp.ReadResourceResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodReadResource, &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{URI: testResourceURI},
+		Extra:  suite.newRequestExtra(suite.rootPerm),
+	})
+
+	suite.NoError(err)
+	suite.NotNil(result)
+	suite.True(called)
+}
+
+func (suite *AuthorizerTestSuite) TestReadResource_Denied() {
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ReadResourceResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodReadResource, &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{URI: testResourceURI},
+		Extra:  suite.newRequestExtra("openid"),
+	})
+
+	suite.Nil(result)
+
+	var rpcErr *jsonrpc.Error
+	suite.Require().True(errors.As(err, &rpcErr))
+	suite.Equal(int64(CodeResourceAccessDenied), rpcErr.Code)
+
+	var data resourceAccessDeniedData
+	suite.Require().NoError(json.Unmarshal(rpcErr.Data, &data))
+	suite.Equal(testResourceURI, data.URI)
+	suite.Equal(suite.flowViewPerm, data.RequiredPermission)
+}
+
+func (suite *AuthorizerTestSuite) TestReadResource_DeniedWithoutURI() {
+	called := false
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		called = true
+		return &mcp.ReadResourceResult{}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	_, err := handler(context.Background(), methodReadResource, &mcp.ReadResourceRequest{
+		Extra: suite.newRequestExtra(suite.rootPerm),
+	})
+
+	suite.False(called)
+
+	var rpcErr *jsonrpc.Error
+	suite.Require().True(errors.As(err, &rpcErr))
+	suite.Equal(int64(CodeResourceAccessDenied), rpcErr.Code)
+}
+
+func (suite *AuthorizerTestSuite) TestSubscribeResource_Denied() {
+	called := false
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		called = true
+		return nil, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	_, err := handler(context.Background(), methodSubscribeResource, &mcp.SubscribeRequest{
+		Params: &mcp.SubscribeParams{URI: testResourceURI},
+		Extra:  suite.newRequestExtra("openid"),
+	})
+
+	suite.False(called)
+
+	var rpcErr *jsonrpc.Error
+	suite.Require().True(errors.As(err, &rpcErr))
+	suite.Equal(int64(CodeResourceAccessDenied), rpcErr.Code)
+}
+
+func (suite *AuthorizerTestSuite) TestListResources_FiltersDeniedResources() {
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ListResourcesResult{Resources: []*mcp.Resource{{URI: testResourceURI}}}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodListResources, &mcp.ListResourcesRequest{
+		Params: &mcp.ListResourcesParams{},
+		Extra:  suite.newRequestExtra("openid"),
+	})
+
+	suite.NoError(err)
+	listResult, ok := result.(*mcp.ListResourcesResult)
+	suite.Require().True(ok)
+	suite.Empty(listResult.Resources)
+}
+
+func (suite *AuthorizerTestSuite) TestListResourceTemplates_ReturnsAllowedTemplates() {
+	next := func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		return &mcp.ListResourceTemplatesResult{
+			ResourceTemplates: []*mcp.ResourceTemplate{{URITemplate: testResourceURI + "/{id}"}},
+		}, nil
+	}
+
+	handler := NewAuthorizationMiddleware()(next)
+	result, err := handler(context.Background(), methodListResourceTemplates, &mcp.ListResourceTemplatesRequest{
+		Params: &mcp.ListResourceTemplatesParams{},
+		Extra:  suite.newRequestExtra(suite.rootPerm),
+	})
+
+	suite.NoError(err)
+	listResult, ok := result.(*mcp.ListResourceTemplatesResult)
+	suite.Require().True(ok)
+	suite.Len(listResult.ResourceTemplates, 1)
+}
@@ -31,6 +31,13 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
+const (
+	// tokenInfoExtraToken is the TokenInfo extra key holding the verified access token.
+	tokenInfoExtraToken = "token"
+	// tokenInfoExtraClaims is the TokenInfo extra key holding the claims of the verified access token.
+	tokenInfoExtraClaims = "claims"
+)
+
 // NewTokenVerifier creates a TokenVerifier function that verifies tokens
 // issued by the OAuth server. This implements the auth.TokenVerifier
 // function type from the MCP SDK.
@@ -78,11 +85,16 @@ func NewTokenVerifier(
 			userID = sub
 		}
 
-		// Build TokenInfo with user ID, scopes, and expiration
+		// Build TokenInfo with user ID, scopes, and expiration. The token and its claims are kept so that
+		// tool invocations can be authorized against the caller's security context.
 		tokenInfo := &auth.TokenInfo{
 			UserID:     userID,
 			Scopes:     scopes,
 			Expiration: expiration,
+			Extra: map[string]any{
+				tokenInfoExtraToken:  token,
+				tokenInfoExtraClaims: payload,
+			},
 		}
 
 		return tokenInfo, nil
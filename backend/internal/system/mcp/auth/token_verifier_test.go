@@ -179,6 +179,10 @@ func (suite *TokenVerifierTestSuite) TestNewTokenVerifier_Success() {
 	assert.Contains(suite.T(), tokenInfo.Scopes, "profile")
 	assert.Contains(suite.T(), tokenInfo.Scopes, "email")
 	assert.False(suite.T(), tokenInfo.Expiration.IsZero())
+	assert.Equal(suite.T(), testToken, tokenInfo.Extra[tokenInfoExtraToken])
+	claims, ok := tokenInfo.Extra[tokenInfoExtraClaims].(map[string]interface{})
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "user123", claims["sub"])
 
 	mockJWTService.AssertExpectations(suite.T())
 }
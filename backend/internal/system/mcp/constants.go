@@ -25,3 +25,10 @@ const (
 	// OAuthProtectedResourceMetadataPath is the path for the OAuth protected resource metadata endpoint.
 	OAuthProtectedResourceMetadataPath = "/.well-known/oauth-protected-resource"
 )
+
+const (
+	// defaultSessionTimeout is the default idle timeout of an MCP session in seconds.
+	defaultSessionTimeout int64 = 1800
+	// defaultEventStoreMaxBytes is the default maximum size of the stream events retained for resumption.
+	defaultEventStoreMaxBytes = 10 << 20
+)
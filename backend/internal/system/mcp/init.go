@@ -29,15 +29,17 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	mcpaudit "github.com/thunder-id/thunderid/internal/system/mcp/audit"
 	mcpauth "github.com/thunder-id/thunderid/internal/system/mcp/auth"
 	"github.com/thunder-id/thunderid/internal/system/security"
 )
 
-// Initialize initializes the MCP server and registers its routes with the provided mux.
+// Initialize initializes the MCP server and registers its routes with the provided mux. It returns the server
+// along with the service recording the audit of tool invocations.
 func Initialize(
 	mux *http.ServeMux,
 	jwtService jwt.JWTServiceInterface,
-) *mcpsdk.Server {
+) (*mcpsdk.Server, mcpaudit.ToolCallAuditServiceInterface) {
 	cfg := config.GetServerRuntime().Config
 	baseURL := config.GetServerURL(&cfg.Server)
 
@@ -46,29 +48,32 @@ func Initialize(
 
 	// Create MCP server and register standalone tools
 	mcpServer := newServer()
+	// Record each tool invocation in the audit, and authorize each tool invocation and resource access against
+	// the permission mapped to it. The audit runs first so that denied invocations are recorded as well.
+	auditService := mcpaudit.Initialize(mux)
+	mcpServer.AddReceivingMiddleware(mcpaudit.NewAuditMiddleware(auditService), mcpauth.NewAuthorizationMiddleware())
 
 	sysPerm := security.GetSystemPermissions()
 	if sysPerm == nil {
 		log.GetLogger().Fatal("System permissions not initialized before MCP initialization")
 	}
-	rootPerm := sysPerm.Root
 
 	tokenVerifier := mcpauth.NewTokenVerifier(jwtService, cfg.JWT.Issuer, mcpURL)
-	httpHandler := mcpsdk.NewStreamableHTTPHandler(func(*http.Request) *mcpsdk.Server {
-		return mcpServer
-	}, nil)
+	httpHandler := newStreamableHTTPHandler(mcpServer, cfg.MCP)
 
-	// Secure MCP handler with bearer token authentication
+	// Secure MCP handler with bearer token authentication. Scopes are not enforced here since the
+	// permissions required differ per tool and resource and are checked by the authorization middleware,
+	// which also denies every method that is not a tool, resource or session method.
 	securedHandler := auth.RequireBearerToken(tokenVerifier, &auth.RequireBearerTokenOptions{
 		ResourceMetadataURL: resourceMetadataURL,
-		Scopes:              []string{rootPerm},
 	})(httpHandler)
 
 	// Register protected resource metadata endpoint
 	metadata := &oauthex.ProtectedResourceMetadata{
 		Resource:             mcpURL,
 		AuthorizationServers: []string{cfg.JWT.Issuer},
-		ScopesSupported:      []string{rootPerm},
+		ScopesSupported: []string{sysPerm.Root, sysPerm.Application, sysPerm.ApplicationView,
+			sysPerm.Flow, sysPerm.FlowView, sysPerm.IDPView},
 	}
 	mux.Handle(OAuthProtectedResourceMetadataPath, auth.ProtectedResourceMetadataHandler(metadata))
 
@@ -76,5 +81,5 @@ func Initialize(
 	mux.Handle(MCPEndpointPath, securedHandler)
 	mux.Handle(MCPEndpointPath+"/", securedHandler)
 
-	return mcpServer
+	return mcpServer, auditService
 }
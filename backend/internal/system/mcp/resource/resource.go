@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package resource provides common utilities for MCP resources.
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// URIScheme is the URI scheme of the resources published by the MCP server.
+const URIScheme = "thunderid://"
+
+// MIMETypeJSON is the MIME type of resources with JSON contents.
+const MIMETypeJSON = "application/json"
+
+// BuildURI builds a resource URI from the given path segments.
+func BuildURI(segments ...string) string {
+	return URIScheme + strings.Join(segments, "/")
+}
+
+// ExtractID returns the identifier that follows the given collection URI in a resource URI, or false if the
+// resource URI does not identify a single member of the collection.
+func ExtractID(uri, collectionURI string) (string, bool) {
+	id, found := strings.CutPrefix(uri, collectionURI+"/")
+	if !found || id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	return id, true
+}
+
+// JSONContents serializes the given value as the JSON contents of the resource.
+func JSONContents(uri string, v any) (*mcp.ReadResourceResult, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize resource %s: %w", uri, err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{
+				URI:      uri,
+				MIMEType: MIMETypeJSON,
+				Text:     string(data),
+			},
+		},
+	}, nil
+}
+
+// NotifierInterface defines the interface for notifying MCP clients about resource changes.
+type NotifierInterface interface {
+	NotifyUpdated(ctx context.Context, uris ...string)
+}
+
+// notifier is the default implementation of NotifierInterface.
+type notifier struct {
+	server *mcp.Server
+	logger *log.Logger
+}
+
+// NewNotifier creates a notifier that sends resource update notifications to the clients subscribed
+// to the resources on the given MCP server.
+func NewNotifier(server *mcp.Server) NotifierInterface {
+	return &notifier{
+		server: server,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "MCPResourceNotifier")),
+	}
+}
+
+// NotifyUpdated notifies the subscribed clients that the given resources have changed.
+func (n *notifier) NotifyUpdated(ctx context.Context, uris ...string) {
+	for _, uri := range uris {
+		if err := n.server.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: uri}); err != nil {
+			n.logger.Warn("Failed to send resource updated notification", log.String("uri", uri), log.Error(err))
+		}
+	}
+}
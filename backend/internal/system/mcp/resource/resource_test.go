@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResourceTestSuite struct {
+	suite.Suite
+}
+
+func TestResourceTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourceTestSuite))
+}
+
+func (suite *ResourceTestSuite) TestBuildURI() {
+	assert.Equal(suite.T(), "thunderid://flows", BuildURI("flows"))
+	assert.Equal(suite.T(), "thunderid://flows/flow-1", BuildURI("flows", "flow-1"))
+}
+
+func (suite *ResourceTestSuite) TestExtractID() {
+	collectionURI := BuildURI("flows")
+
+	tests := []struct {
+		name   string
+		uri    string
+		wantID string
+		wantOK bool
+	}{
+		{name: "MemberURI", uri: "thunderid://flows/flow-1", wantID: "flow-1", wantOK: true},
+		{name: "CollectionURI", uri: "thunderid://flows", wantOK: false},
+		{name: "EmptyID", uri: "thunderid://flows/", wantOK: false},
+		{name: "NestedPath", uri: "thunderid://flows/flow-1/versions", wantOK: false},
+		{name: "OtherCollection", uri: "thunderid://applications/app-1", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			id, ok := ExtractID(tt.uri, collectionURI)
+			assert.Equal(suite.T(), tt.wantOK, ok)
+			assert.Equal(suite.T(), tt.wantID, id)
+		})
+	}
+}
+
+func (suite *ResourceTestSuite) TestJSONContents() {
+	result, err := JSONContents("thunderid://flows/flow-1", map[string]string{"id": "flow-1"})
+
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), result.Contents, 1)
+	assert.Equal(suite.T(), "thunderid://flows/flow-1", result.Contents[0].URI)
+	assert.Equal(suite.T(), MIMETypeJSON, result.Contents[0].MIMEType)
+	assert.JSONEq(suite.T(), `{"id":"flow-1"}`, result.Contents[0].Text)
+}
+
+func (suite *ResourceTestSuite) TestJSONContents_SerializationError() {
+	result, err := JSONContents("thunderid://flows/flow-1", make(chan int))
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *ResourceTestSuite) TestNotifyUpdated_WithoutSubscribers() {
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	notifier := NewNotifier(server)
+
+	assert.NotPanics(suite.T(), func() {
+		notifier.NotifyUpdated(context.Background(), BuildURI("flows"), BuildURI("flows", "flow-1"))
+	})
+}
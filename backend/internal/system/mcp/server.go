@@ -19,14 +19,20 @@
 package mcp
 
 import (
+	"context"
+
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // newServer creates a new MCP server.
 func newServer() *mcp.Server {
-	// Create the MCP server instance.
+	// Create the MCP server instance. Clients may subscribe to any resource they are authorized to read,
+	// which is enforced by the authorization middleware.
 	return mcp.NewServer(&mcp.Implementation{
 		Name:    "thunderid-mcp",
 		Version: "1.0.0",
-	}, nil)
+	}, &mcp.ServerOptions{
+		SubscribeHandler:   func(context.Context, *mcp.SubscribeRequest) error { return nil },
+		UnsubscribeHandler: func(context.Context, *mcp.UnsubscribeRequest) error { return nil },
+	})
 }
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcp
+
+import (
+	"net/http"
+	"time"
+
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// newStreamableHTTPHandler creates the streamable HTTP transport of the MCP server.
+// Each client is assigned a session identified by the Mcp-Session-Id header, which is closed after it stays
+// idle for the configured session timeout. Responses and server notifications are streamed as server-sent
+// events, and the events of each stream are retained in memory so that a client can resume an interrupted
+// stream by reconnecting with the Last-Event-ID header.
+func newStreamableHTTPHandler(server *mcpsdk.Server, mcpConfig config.MCPConfig) http.Handler {
+	sessionTimeout := mcpConfig.SessionTimeout
+	if sessionTimeout <= 0 {
+		sessionTimeout = defaultSessionTimeout
+	}
+	eventStoreMaxBytes := mcpConfig.EventStoreMaxBytes
+	if eventStoreMaxBytes <= 0 {
+		eventStoreMaxBytes = defaultEventStoreMaxBytes
+	}
+
+	eventStore := mcpsdk.NewMemoryEventStore(nil)
+	eventStore.SetMaxBytes(eventStoreMaxBytes)
+
+	handler := mcpsdk.NewStreamableHTTPHandler(func(*http.Request) *mcpsdk.Server {
+		return server
+	}, &mcpsdk.StreamableHTTPOptions{
+		EventStore:     eventStore,
+		SessionTimeout: time.Duration(sessionTimeout) * time.Second,
+	})
+	return withoutWriteDeadline(handler)
+}
+
+// withoutWriteDeadline lifts the server write timeout for MCP requests. An event stream stays open for as
+// long as a tool call runs or the client listens for notifications, which exceeds the write timeout applied
+// to regular API requests.
+func withoutWriteDeadline(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+			log.GetLogger().Debug("Failed to clear the write deadline of the MCP request", log.Error(err))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
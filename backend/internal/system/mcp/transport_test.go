@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+type TransportTestSuite struct {
+	suite.Suite
+}
+
+func TestTransportTestSuite(t *testing.T) {
+	suite.Run(t, new(TransportTestSuite))
+}
+
+type slowToolInput struct {
+	Steps int `json:"steps"`
+}
+
+type slowToolOutput struct {
+	Completed int `json:"completed"`
+}
+
+// newTestServer starts a TLS server with a short write timeout that serves the MCP transport behind the
+// access log handler, as the server does in production. The slow tool waits for each progress notification
+// to be acknowledged through the given channel, so it only completes if notifications are streamed to the
+// client while the call is in progress.
+func (suite *TransportTestSuite) newTestServer(writeTimeout time.Duration, acks chan struct{}) *httptest.Server {
+	server := newServer()
+	mcpsdk.AddTool(server, &mcpsdk.Tool{Name: "slow_tool"}, func(
+		ctx context.Context, req *mcpsdk.CallToolRequest, input slowToolInput,
+	) (*mcpsdk.CallToolResult, slowToolOutput, error) {
+		for i := 1; i <= input.Steps; i++ {
+			time.Sleep(writeTimeout / 2)
+			if err := req.Session.NotifyProgress(ctx, &mcpsdk.ProgressNotificationParams{
+				ProgressToken: req.Params.GetProgressToken(),
+				Progress:      float64(i),
+				Total:         float64(input.Steps),
+			}); err != nil {
+				return nil, slowToolOutput{}, err
+			}
+			select {
+			case <-acks:
+			case <-time.After(2 * time.Second):
+				return nil, slowToolOutput{}, errors.New("progress notification was not delivered")
+			}
+		}
+		return nil, slowToolOutput{Completed: input.Steps}, nil
+	})
+
+	handler := newStreamableHTTPHandler(server, config.MCPConfig{})
+	testServer := httptest.NewUnstartedServer(log.AccessLogHandler(log.GetLogger(), handler))
+	testServer.Config.WriteTimeout = writeTimeout
+	testServer.StartTLS()
+	suite.T().Cleanup(testServer.Close)
+	return testServer
+}
+
+func (suite *TransportTestSuite) TestLongRunningToolCallStreamsProgress() {
+	writeTimeout := 200 * time.Millisecond
+	acks := make(chan struct{}, 1)
+	testServer := suite.newTestServer(writeTimeout, acks)
+
+	var progressCount atomic.Int32
+	client := mcpsdk.NewClient(&mcpsdk.Implementation{Name: "test-client", Version: "1.0.0"},
+		&mcpsdk.ClientOptions{
+			ProgressNotificationHandler: func(context.Context, *mcpsdk.ProgressNotificationClientRequest) {
+				progressCount.Add(1)
+				acks <- struct{}{}
+			},
+		})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	session, err := client.Connect(ctx, &mcpsdk.StreamableClientTransport{
+		Endpoint:             testServer.URL,
+		HTTPClient:           testServer.Client(),
+		MaxRetries:           -1,
+		DisableStandaloneSSE: true,
+	}, nil)
+	suite.Require().NoError(err)
+	defer func() { _ = session.Close() }()
+	suite.NotEmpty(session.ID())
+
+	// The tool runs for longer than the server write timeout.
+	result, err := session.CallTool(ctx, &mcpsdk.CallToolParams{
+		Meta:      mcpsdk.Meta{"progressToken": "progress-1"},
+		Name:      "slow_tool",
+		Arguments: map[string]any{"steps": 5},
+	})
+
+	suite.Require().NoError(err)
+	suite.False(result.IsError)
+	suite.Equal(int32(5), progressCount.Load())
+}
+
+func (suite *TransportTestSuite) TestNewStreamableHTTPHandler_RejectsUnknownSession() {
+	testServer := suite.newTestServer(time.Second, nil)
+
+	req, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+	suite.Require().NoError(err)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Mcp-Session-Id", "unknown-session")
+
+	resp, err := testServer.Client().Do(req)
+	suite.Require().NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+
+	suite.Equal(http.StatusNotFound, resp.StatusCode)
+}
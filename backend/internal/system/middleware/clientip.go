@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"net"
+	"net/http"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+// ClientIPMiddleware stores the IP address of the client that made the request in the request context.
+// The address is taken from the connection rather than from forwarding headers such as X-Forwarded-For,
+// which can be set by the client and therefore cannot be trusted for abuse prevention.
+func ClientIPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientIP, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			clientIP = r.RemoteAddr
+		}
+
+		r = r.WithContext(sysContext.WithClientIP(r.Context(), clientIP))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+func TestClientIPMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		expectedIP string
+	}{
+		{name: "IPv4WithPort", remoteAddr: "192.0.2.10:54321", expectedIP: "192.0.2.10"},
+		{name: "IPv6WithPort", remoteAddr: "[2001:db8::1]:54321", expectedIP: "2001:db8::1"},
+		{name: "WithoutPort", remoteAddr: "192.0.2.10", expectedIP: "192.0.2.10"},
+		{name: "IgnoresForwardedFor", remoteAddr: "192.0.2.10:54321", forwarded: "198.51.100.7",
+			expectedIP: "192.0.2.10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actualIP string
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actualIP = sysContext.GetClientIP(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			w := httptest.NewRecorder()
+
+			ClientIPMiddleware(handler).ServeHTTP(w, req)
+
+			if actualIP != tt.expectedIP {
+				t.Errorf("Expected client IP %q, got %q", tt.expectedIP, actualIP)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+// CustomDomainMiddleware records the custom domain a request was received on in the request context, so
+// that issuers and gate URLs are resolved for that domain. Cookies set while serving the request are
+// scoped to the cookie domain configured for the custom domain.
+func CustomDomainMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		domain := config.GetServerRuntime().Config.GetCustomDomain(r.Host)
+		if domain == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		r = r.WithContext(sysContext.WithCustomDomain(r.Context(), domain.Host))
+		if domain.CookieDomain != "" {
+			w = &cookieScopingResponseWriter{ResponseWriter: w, cookieDomain: domain.CookieDomain}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// cookieScopingResponseWriter rewrites the Domain attribute of the cookies set on the response before
+// the headers are written.
+type cookieScopingResponseWriter struct {
+	http.ResponseWriter
+	cookieDomain string
+	wroteHeader  bool
+}
+
+// WriteHeader scopes the cookies set on the response and writes the status code.
+func (w *cookieScopingResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.scopeCookies()
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write writes the response body, writing the headers first if they have not been written yet.
+func (w *cookieScopingResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush sends any buffered data to the client, writing the headers first if they have not been written yet.
+func (w *cookieScopingResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap returns the underlying response writer for use with http.ResponseController.
+func (w *cookieScopingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// scopeCookies sets the Domain attribute of every cookie in the Set-Cookie headers to the configured
+// cookie domain. Headers that cannot be parsed are left unchanged.
+func (w *cookieScopingResponseWriter) scopeCookies() {
+	header := w.Header()
+	values := header.Values("Set-Cookie")
+	if len(values) == 0 {
+		return
+	}
+
+	scoped := make([]string, 0, len(values))
+	for _, value := range values {
+		cookie, err := http.ParseSetCookie(value)
+		if err != nil {
+			scoped = append(scoped, value)
+			continue
+		}
+		cookie.Domain = w.cookieDomain
+		scoped = append(scoped, cookie.String())
+	}
+	header.Del("Set-Cookie")
+	for _, value := range scoped {
+		header.Add("Set-Cookie", value)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+type CustomDomainMiddlewareTestSuite struct {
+	suite.Suite
+}
+
+func TestCustomDomainMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomDomainMiddlewareTestSuite))
+}
+
+func (suite *CustomDomainMiddlewareTestSuite) SetupTest() {
+	cfg := &config.Config{
+		CustomDomains: []config.CustomDomainConfig{
+			{Host: "login.example.com", CookieDomain: "example.com"},
+			{Host: "auth.acme.io"},
+		},
+	}
+	suite.Require().NoError(config.InitializeServerRuntime("/tmp", cfg))
+}
+
+func (suite *CustomDomainMiddlewareTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// serve runs the middleware for a request to the given host, recording the custom domain seen by the
+// wrapped handler. The wrapped handler sets a session cookie without a domain.
+func (suite *CustomDomainMiddlewareTestSuite) serve(host string) (*httptest.ResponseRecorder, string) {
+	var customDomain string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		customDomain = sysContext.GetCustomDomain(r.Context())
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc", Path: "/"})
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Host = host
+	rr := httptest.NewRecorder()
+	CustomDomainMiddleware(handler).ServeHTTP(rr, req)
+
+	return rr, customDomain
+}
+
+func (suite *CustomDomainMiddlewareTestSuite) TestServerHost() {
+	rr, customDomain := suite.serve("localhost:8090")
+
+	suite.Empty(customDomain)
+	suite.Equal("session=abc; Path=/", rr.Header().Get("Set-Cookie"))
+}
+
+func (suite *CustomDomainMiddlewareTestSuite) TestCustomDomainWithCookieDomain() {
+	rr, customDomain := suite.serve("Login.Example.com:443")
+
+	suite.Equal("login.example.com", customDomain)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal("ok", rr.Body.String())
+	suite.Equal("session=abc; Path=/; Domain=example.com", rr.Header().Get("Set-Cookie"))
+}
+
+func (suite *CustomDomainMiddlewareTestSuite) TestCustomDomainWithoutCookieDomain() {
+	rr, customDomain := suite.serve("auth.acme.io")
+
+	suite.Equal("auth.acme.io", customDomain)
+	suite.Equal("session=abc; Path=/", rr.Header().Get("Set-Cookie"))
+}
+
+func (suite *CustomDomainMiddlewareTestSuite) TestScopeCookiesKeepsUnparsableHeaders() {
+	rr := httptest.NewRecorder()
+	w := &cookieScopingResponseWriter{ResponseWriter: rr, cookieDomain: "example.com"}
+	w.Header().Add("Set-Cookie", "=invalid")
+	w.Header().Add("Set-Cookie", "theme=dark")
+
+	w.WriteHeader(http.StatusFound)
+
+	suite.Equal(http.StatusFound, rr.Code)
+	suite.Equal([]string{"=invalid", "theme=dark; Domain=example.com"}, rr.Header().Values("Set-Cookie"))
+	suite.Equal(rr, w.Unwrap())
+}
+
+func (suite *CustomDomainMiddlewareTestSuite) TestFlushScopesCookies() {
+	rr := httptest.NewRecorder()
+	w := &cookieScopingResponseWriter{ResponseWriter: rr, cookieDomain: "example.com"}
+	w.Header().Add("Set-Cookie", "theme=dark")
+
+	w.Flush()
+
+	suite.True(rr.Flushed)
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal([]string{"theme=dark; Domain=example.com"}, rr.Header().Values("Set-Cookie"))
+}
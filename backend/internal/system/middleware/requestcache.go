@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"net/http"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+// RequestCacheMiddleware adds a request cache to the request context, so that the values resolved while
+// serving the request, such as organization unit hierarchies and accessible resource sets, are reused instead
+// of being resolved again by each component that needs them. The cache is discarded with the request.
+func RequestCacheMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(sysContext.WithRequestCache(r.Context()))
+		next.ServeHTTP(w, r)
+	})
+}
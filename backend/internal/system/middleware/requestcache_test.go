@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+func TestRequestCacheMiddleware(t *testing.T) {
+	var cached bool
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sysContext.SetRequestCacheValue(r.Context(), "key", "value")
+		_, cached = sysContext.GetRequestCacheValue(r.Context(), "key")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	w := httptest.NewRecorder()
+
+	RequestCacheMiddleware(handler).ServeHTTP(w, req)
+
+	if !cached {
+		t.Error("Expected the request context to carry a request cache")
+	}
+	if _, ok := sysContext.GetRequestCacheValue(req.Context(), "key"); ok {
+		t.Error("Expected the request cache not to leak into the original request context")
+	}
+}
@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// everyPrefix is the prefix of a schedule that runs a job at a fixed interval.
+const everyPrefix = "@every "
+
+// maxScheduleLookahead bounds the search for the next run time of a cron schedule that never matches,
+// such as one that only runs on the 31st of February.
+const maxScheduleLookahead = 5 * 366 * 24 * time.Hour
+
+// scheduleDescriptors maps the predefined schedules to their cron expressions.
+var scheduleDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// schedule computes the times at which a job runs.
+type schedule interface {
+	// next returns the first run time after the given time, or the zero time if there is none.
+	next(t time.Time) time.Time
+}
+
+// intervalSchedule runs a job at a fixed interval.
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+func (s intervalSchedule) next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+// cronSchedule runs a job at the times matching a cron expression. Each field holds a bit per matching value.
+type cronSchedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	// anyDay is set when the day of month or the day of week field is "*". Otherwise a day matches when it
+	// matches either of the fields, as in cron.
+	anyDay bool
+}
+
+// cronField describes the range of values a field of a cron expression accepts.
+type cronField struct {
+	name string
+	min  int
+	max  int
+}
+
+var (
+	minuteField     = cronField{name: "minute", min: 0, max: 59}
+	hourField       = cronField{name: "hour", min: 0, max: 23}
+	dayOfMonthField = cronField{name: "day of month", min: 1, max: 31}
+	monthField      = cronField{name: "month", min: 1, max: 12}
+	// Both 0 and 7 denote Sunday in the day of week field.
+	dayOfWeekField = cronField{name: "day of week", min: 0, max: 7}
+)
+
+// parseSchedule parses a schedule given as a five field cron expression (minute, hour, day of month, month and
+// day of week), one of the predefined schedules such as "@daily", or a fixed interval such as "@every 15m".
+// Cron schedules are evaluated in UTC.
+func parseSchedule(spec string) (schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, errors.New("schedule is empty")
+	}
+
+	if strings.HasPrefix(spec, everyPrefix) {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, everyPrefix)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval in schedule %q: %w", spec, err)
+		}
+		if interval < time.Second {
+			return nil, fmt.Errorf("interval in schedule %q must be at least one second", spec)
+		}
+		return intervalSchedule{interval: interval}, nil
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		expression, ok := scheduleDescriptors[spec]
+		if !ok {
+			return nil, fmt.Errorf("unknown schedule %q", spec)
+		}
+		spec = expression
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have five fields", spec)
+	}
+
+	s := &cronSchedule{
+		anyDay: fields[2] == "*" || fields[4] == "*",
+	}
+	var err error
+	if s.minute, err = parseCronField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseCronField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if s.dayOfMonth, err = parseCronField(fields[2], dayOfMonthField); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseCronField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek, err = parseCronField(fields[4], dayOfWeekField); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek&(1<<7) != 0 {
+		s.dayOfWeek |= 1
+	}
+
+	return s, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges and steps such as "*/15" or "1-5,10".
+func parseCronField(value string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepPart, field.name)
+			}
+		}
+
+		start, end := field.min, field.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			lowPart, highPart, _ := strings.Cut(rangePart, "-")
+			low, err := parseCronValue(lowPart, field)
+			if err != nil {
+				return 0, err
+			}
+			high, err := parseCronValue(highPart, field)
+			if err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q in %s field", rangePart, field.name)
+			}
+			start, end = low, high
+		default:
+			single, err := parseCronValue(rangePart, field)
+			if err != nil {
+				return 0, err
+			}
+			start = single
+			if !hasStep {
+				end = single
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+// parseCronValue parses a single value of a cron field and checks it is within the range of the field.
+func parseCronValue(value string, field cronField) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, field.name)
+	}
+	if v < field.min || v > field.max {
+		return 0, fmt.Errorf("value %d in %s field is out of range %d-%d", v, field.name, field.min, field.max)
+	}
+	return v, nil
+}
+
+// next returns the first minute after the given time that matches the cron expression.
+func (s *cronSchedule) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxScheduleLookahead)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchesDay reports whether the day of the given time matches the day of month and day of week fields.
+func (s *cronSchedule) matchesDay(t time.Time) bool {
+	domMatch := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.anyDay {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CronTestSuite struct {
+	suite.Suite
+}
+
+func TestCronTestSuite(t *testing.T) {
+	suite.Run(t, new(CronTestSuite))
+}
+
+// base is a Wednesday.
+var base = time.Date(2026, time.January, 14, 10, 7, 30, 0, time.UTC)
+
+func (suite *CronTestSuite) TestParseSchedule_Next() {
+	testCases := []struct {
+		name     string
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		{"EveryMinute", "* * * * *", base, time.Date(2026, 1, 14, 10, 8, 0, 0, time.UTC)},
+		{"MinuteStep", "*/15 * * * *", base, time.Date(2026, 1, 14, 10, 15, 0, 0, time.UTC)},
+		{"MinuteStepWrapsHour", "*/15 * * * *", base.Add(45 * time.Minute),
+			time.Date(2026, 1, 14, 11, 0, 0, 0, time.UTC)},
+		{"FixedTimeLaterToday", "30 18 * * *", base, time.Date(2026, 1, 14, 18, 30, 0, 0, time.UTC)},
+		{"FixedTimeTomorrow", "0 3 * * *", base, time.Date(2026, 1, 15, 3, 0, 0, 0, time.UTC)},
+		{"List", "5,50 10 * * *", base, time.Date(2026, 1, 14, 10, 50, 0, 0, time.UTC)},
+		{"Range", "0 1-3 * * *", base, time.Date(2026, 1, 15, 1, 0, 0, 0, time.UTC)},
+		{"RangeWithStep", "0 9-17/4 * * *", base, time.Date(2026, 1, 14, 13, 0, 0, 0, time.UTC)},
+		{"DayOfWeek", "0 0 * * 1", base, time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)},
+		{"SundayAsSeven", "0 0 * * 7", base, time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC)},
+		{"DayOfMonth", "0 0 1 * *", base, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"Month", "0 0 1 6 *", base, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"DayOfMonthOrDayOfWeek", "0 0 20 * 5", base, time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{"LeapDay", "0 0 29 2 *", base, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"Hourly", "@hourly", base, time.Date(2026, 1, 14, 11, 0, 0, 0, time.UTC)},
+		{"Daily", "@daily", base, time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"Weekly", "@weekly", base, time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC)},
+		{"Monthly", "@monthly", base, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"Yearly", "@yearly", base, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"Every", "@every 90s", base, base.Add(90 * time.Second)},
+		{"NonUTCInput", "0 12 * * *", base.In(time.FixedZone("UTC+5", 5*3600)),
+			time.Date(2026, 1, 14, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			s, err := parseSchedule(tc.spec)
+			suite.Require().NoError(err)
+			suite.True(tc.expected.Equal(s.next(tc.from)), "got %s", s.next(tc.from))
+		})
+	}
+}
+
+func (suite *CronTestSuite) TestParseSchedule_NeverMatches() {
+	s, err := parseSchedule("0 0 31 2 *")
+	suite.Require().NoError(err)
+
+	suite.True(s.next(base).IsZero())
+}
+
+func (suite *CronTestSuite) TestParseSchedule_Invalid() {
+	testCases := []struct {
+		name string
+		spec string
+	}{
+		{"Empty", " "},
+		{"TooFewFields", "* * * *"},
+		{"TooManyFields", "* * * * * *"},
+		{"UnknownDescriptor", "@fortnightly"},
+		{"InvalidInterval", "@every soon"},
+		{"IntervalTooShort", "@every 10ms"},
+		{"NotANumber", "a * * * *"},
+		{"MinuteOutOfRange", "60 * * * *"},
+		{"HourOutOfRange", "0 24 * * *"},
+		{"DayOfMonthZero", "0 0 0 * *"},
+		{"MonthOutOfRange", "0 0 1 13 *"},
+		{"DayOfWeekOutOfRange", "0 0 * * 8"},
+		{"ReversedRange", "0 5-1 * * *"},
+		{"ZeroStep", "*/0 * * * *"},
+		{"InvalidStep", "*/x * * * *"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := parseSchedule(tc.spec)
+			suite.Error(err)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for scheduled job operations.
+var (
+	// ErrorJobNotFound is the error returned when a job with the given name is not registered.
+	ErrorJobNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCH-1001",
+		Error: core.I18nMessage{
+			Key:          "error.schedulerservice.job_not_found",
+			DefaultValue: "Job not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.schedulerservice.job_not_found_description",
+			DefaultValue: "No job with the given name is registered with the scheduler",
+		},
+	}
+
+	// ErrorJobAlreadyRunning is the error returned when a run is requested for a job that is running.
+	ErrorJobAlreadyRunning = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCH-1002",
+		Error: core.I18nMessage{
+			Key:          "error.schedulerservice.job_already_running",
+			DefaultValue: "Job already running",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.schedulerservice.job_already_running_description",
+			DefaultValue: "The job is already running on this node",
+		},
+	}
+)
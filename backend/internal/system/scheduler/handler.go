@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// schedulerHandler defines the handler for the job API.
+type schedulerHandler struct {
+	service SchedulerServiceInterface
+	logger  *log.Logger
+}
+
+func newSchedulerHandler(service SchedulerServiceInterface) *schedulerHandler {
+	return &schedulerHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SchedulerHandler")),
+	}
+}
+
+// HandleJobListRequest handles the request to list the scheduled jobs.
+func (sh *schedulerHandler) HandleJobListRequest(w http.ResponseWriter, r *http.Request) {
+	jobs := sh.service.ListJobs()
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, JobListResponse{
+		TotalResults: len(jobs),
+		Jobs:         jobs,
+	})
+}
+
+// HandleJobGetRequest handles the request to get the status of a job.
+func (sh *schedulerHandler) HandleJobGetRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := sh.service.GetJob(r.PathValue("name"))
+	if svcErr != nil {
+		sh.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, job)
+}
+
+// HandleJobRunRequest handles the request to run a job. The job runs in the background.
+func (sh *schedulerHandler) HandleJobRunRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := sh.service.RunJob(r.PathValue("name"))
+	if svcErr != nil {
+		sh.handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, job)
+}
+
+// handleError handles service errors and sends appropriate HTTP responses.
+func (sh *schedulerHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorJobNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorJobAlreadyRunning.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	if statusCode == http.StatusInternalServerError {
+		sh.logger.Error("Job request failed with server error",
+			log.String("code", svcErr.Code),
+			log.String("error", svcErr.Error.DefaultValue),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
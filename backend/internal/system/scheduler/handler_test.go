@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	serviceMock *SchedulerServiceInterfaceMock
+	handler     *schedulerHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.serviceMock = NewSchedulerServiceInterfaceMock(suite.T())
+	suite.handler = newSchedulerHandler(suite.serviceMock)
+}
+
+func (suite *HandlerTestSuite) TestHandleJobListRequest() {
+	suite.serviceMock.EXPECT().ListJobs().Return([]JobStatus{
+		{Name: ExpiredTokenCleanupJobName, Schedule: "@hourly", Enabled: true},
+		{Name: FlowContextGCJobName, Schedule: "*/15 * * * *", Enabled: true},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleJobListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var resp JobListResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	suite.Equal(2, resp.TotalResults)
+	suite.Equal(FlowContextGCJobName, resp.Jobs[1].Name)
+}
+
+func (suite *HandlerTestSuite) TestHandleJobGetRequest() {
+	suite.serviceMock.EXPECT().GetJob(FlowContextGCJobName).Return(&JobStatus{
+		Name:     FlowContextGCJobName,
+		RunCount: 4,
+		LastRun:  &JobRun{Trigger: RunTriggerScheduled, Status: RunStatusSuccess},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/jobs/"+FlowContextGCJobName, nil)
+	req.SetPathValue("name", FlowContextGCJobName)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleJobGetRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var status JobStatus
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &status))
+	suite.Equal(int64(4), status.RunCount)
+	suite.Equal(RunStatusSuccess, status.LastRun.Status)
+}
+
+func (suite *HandlerTestSuite) TestHandleJobGetRequest_NotFound() {
+	suite.serviceMock.EXPECT().GetJob("missing").Return(nil, &ErrorJobNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/jobs/missing", nil)
+	req.SetPathValue("name", "missing")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleJobGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorJobNotFound.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleJobRunRequest() {
+	suite.serviceMock.EXPECT().RunJob(FlowContextGCJobName).Return(&JobStatus{
+		Name:    FlowContextGCJobName,
+		Running: true,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/jobs/"+FlowContextGCJobName+"/run", nil)
+	req.SetPathValue("name", FlowContextGCJobName)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleJobRunRequest(rr, req)
+
+	suite.Equal(http.StatusAccepted, rr.Code)
+	var status JobStatus
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &status))
+	suite.True(status.Running)
+}
+
+func (suite *HandlerTestSuite) TestHandleJobRunRequest_NotFound() {
+	suite.serviceMock.EXPECT().RunJob("missing").Return(nil, &ErrorJobNotFound)
+
+	req := httptest.NewRequest(http.MethodPost, "/jobs/missing/run", nil)
+	req.SetPathValue("name", "missing")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleJobRunRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorJobNotFound.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleJobRunRequest_AlreadyRunning() {
+	suite.serviceMock.EXPECT().RunJob(FlowContextGCJobName).Return(nil, &ErrorJobAlreadyRunning)
+
+	req := httptest.NewRequest(http.MethodPost, "/jobs/"+FlowContextGCJobName+"/run", nil)
+	req.SetPathValue("name", FlowContextGCJobName)
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleJobRunRequest(rr, req)
+
+	suite.Equal(http.StatusConflict, rr.Code)
+	var errResp apierror.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	suite.Equal(ErrorJobAlreadyRunning.Code, errResp.Code)
+}
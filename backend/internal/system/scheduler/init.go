@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package scheduler provides the scheduler running the recurring jobs of the server, such as the cleanup of
+// expired runtime data, and the API reporting the status of the jobs.
+package scheduler
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// jobsPath is the path of the job API.
+const jobsPath = "/jobs"
+
+// Initialize creates the scheduler with the built-in jobs and the given additional jobs, registers the job API
+// with the provided mux and starts the scheduler.
+func Initialize(mux *http.ServeMux, jobs ...Job) (SchedulerServiceInterface, error) {
+	cfg := config.GetServerRuntime().Config
+	isRedis := cfg.Database.Runtime.Type == provider.DataSourceTypeRedis
+
+	var allJobs []Job
+	if !isRedis {
+		allJobs = append(allJobs, newRuntimeCleanupJobs()...)
+	}
+	allJobs = append(allJobs, jobs...)
+
+	var lockStore jobLockStoreInterface
+	if cfg.Scheduler.Clustered {
+		if isRedis {
+			lockStore = newRedisJobLockStore(provider.GetRedisProvider())
+		} else {
+			lockStore = newJobLockStore()
+		}
+	}
+
+	schedulerService, err := newSchedulerService(cfg.Scheduler, allJobs, lockStore)
+	if err != nil {
+		return nil, err
+	}
+	registerRoutes(mux, newSchedulerHandler(schedulerService))
+	schedulerService.Start()
+
+	return schedulerService, nil
+}
+
+// registerRoutes registers the HTTP routes of the job API.
+func registerRoutes(mux *http.ServeMux, handler *schedulerHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+jobsPath, handler.HandleJobListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+jobsPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+jobsPath+"/{name}", handler.HandleJobGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+jobsPath+"/{name}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("POST "+jobsPath+"/{name}/run", handler.HandleJobRunRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+jobsPath+"/{name}/run",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+const (
+	// ExpiredTokenCleanupJobName is the name of the job deleting expired tokens and runtime records.
+	ExpiredTokenCleanupJobName = "expired-token-cleanup"
+	// FlowContextGCJobName is the name of the job deleting the contexts of expired flows.
+	FlowContextGCJobName = "flow-context-gc"
+)
+
+// runtimeCleanupJob deletes the expired rows of a set of runtime database tables. It is only needed when the
+// runtime store is a SQL database, as Redis expires runtime keys on its own.
+type runtimeCleanupJob struct {
+	name            string
+	description     string
+	defaultSchedule string
+	queries         []dbmodel.DBQuery
+	dbProvider      dbprovider.DBProviderInterface
+	deploymentID    string
+}
+
+// newRuntimeCleanupJobs creates the jobs deleting the expired rows of the runtime database.
+func newRuntimeCleanupJobs() []Job {
+	dbProvider := dbprovider.GetDBProvider()
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	return []Job{
+		&runtimeCleanupJob{
+			name: ExpiredTokenCleanupJobName,
+			description: "Deletes expired authorization codes, authorization requests, pushed authorization " +
+				"requests, backchannel authentication requests, SSO sessions and other expired runtime records",
+			defaultSchedule: "@hourly",
+			queries: []dbmodel.DBQuery{
+				queryDeleteExpiredAuthorizationCodes,
+				queryDeleteExpiredAuthorizationRequests,
+				queryDeleteExpiredPARRequests,
+				queryDeleteExpiredCIBARequests,
+				queryDeleteExpiredSSOSessionClients,
+				queryDeleteExpiredSSOSessions,
+				queryDeleteExpiredLogoutDeliveries,
+				queryDeleteExpiredWebAuthnSessions,
+				queryDeleteExpiredAttributeCache,
+				queryDeleteExpiredSAMLMessageContexts,
+				queryDeleteExpiredDeliveryStatuses,
+				queryDeleteExpiredDeadLetters,
+				queryDeleteExpiredRateLimitCounters,
+				queryDeleteExpiredSendAudits,
+				queryDeleteExpiredToolCallAudits,
+				queryDeleteExpiredTokenRateLimits,
+				queryDeleteExpiredFlowExecutions,
+				queryDeleteExpiredFlowNodeExecutions,
+			},
+			dbProvider:   dbProvider,
+			deploymentID: deploymentID,
+		},
+		&runtimeCleanupJob{
+			name:            FlowContextGCJobName,
+			description:     "Deletes the contexts of flows that expired before they completed",
+			defaultSchedule: "*/15 * * * *",
+			queries:         []dbmodel.DBQuery{queryDeleteExpiredFlowContexts},
+			dbProvider:      dbProvider,
+			deploymentID:    deploymentID,
+		},
+	}
+}
+
+func (j *runtimeCleanupJob) Name() string {
+	return j.name
+}
+
+func (j *runtimeCleanupJob) Description() string {
+	return j.description
+}
+
+func (j *runtimeCleanupJob) DefaultSchedule() string {
+	return j.defaultSchedule
+}
+
+// Run deletes the rows of the tables of the job that have expired.
+func (j *runtimeCleanupJob) Run(ctx context.Context) (string, error) {
+	dbClient, err := j.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	now := time.Now().UTC()
+	var deleted int64
+	for _, query := range j.queries {
+		rows, err := dbClient.ExecuteContext(ctx, query, now, j.deploymentID)
+		if err != nil {
+			return fmt.Sprintf("Deleted %d expired records", deleted),
+				fmt.Errorf("failed to execute query %s: %w", query.ID, err)
+		}
+		deleted += rows
+	}
+
+	return fmt.Sprintf("Deleted %d expired records", deleted), nil
+}
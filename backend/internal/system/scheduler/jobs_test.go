@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+type RuntimeCleanupJobTestSuite struct {
+	suite.Suite
+	job            *runtimeCleanupJob
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	ctx            context.Context
+}
+
+func TestRuntimeCleanupJobTestSuite(t *testing.T) {
+	suite.Run(t, new(RuntimeCleanupJobTestSuite))
+}
+
+func (suite *RuntimeCleanupJobTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.ctx = context.Background()
+	suite.job = &runtimeCleanupJob{
+		name:            ExpiredTokenCleanupJobName,
+		defaultSchedule: "@hourly",
+		queries:         []dbmodel.DBQuery{queryDeleteExpiredAuthorizationCodes, queryDeleteExpiredSSOSessions},
+		dbProvider:      suite.mockDBProvider,
+		deploymentID:    testDeploymentID,
+	}
+}
+
+func (suite *RuntimeCleanupJobTestSuite) TestDeleteExpiredQuery() {
+	suite.Equal(`DELETE FROM "FLOW_CONTEXT" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2`,
+		queryDeleteExpiredFlowContexts.Query)
+}
+
+func (suite *RuntimeCleanupJobTestSuite) TestRun() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteExpiredAuthorizationCodes,
+		mock.AnythingOfType("time.Time"), testDeploymentID).Return(int64(3), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteExpiredSSOSessions,
+		mock.AnythingOfType("time.Time"), testDeploymentID).Return(int64(2), nil).Once()
+
+	message, err := suite.job.Run(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal("Deleted 5 expired records", message)
+}
+
+func (suite *RuntimeCleanupJobTestSuite) TestRun_QueryError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteExpiredAuthorizationCodes,
+		mock.Anything, testDeploymentID).Return(int64(3), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteExpiredSSOSessions,
+		mock.Anything, testDeploymentID).Return(int64(0), errors.New("table locked")).Once()
+
+	message, err := suite.job.Run(suite.ctx)
+
+	suite.ErrorContains(err, queryDeleteExpiredSSOSessions.ID)
+	suite.Equal("Deleted 3 expired records", message)
+}
+
+func (suite *RuntimeCleanupJobTestSuite) TestRun_DBClientError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db provider error")).Once()
+
+	_, err := suite.job.Run(suite.ctx)
+
+	suite.ErrorContains(err, "failed to get database client")
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// Job is a recurring task run by the scheduler.
+type Job interface {
+	// Name returns the unique name of the job, used to configure it and to address it in the job API.
+	Name() string
+
+	// Description returns a short description of what the job does.
+	Description() string
+
+	// DefaultSchedule returns the schedule of the job unless it is overridden in the configuration.
+	DefaultSchedule() string
+
+	// Run runs the job once and returns a short summary of the outcome. The context is cancelled when the
+	// server shuts down or the run exceeds the lock timeout of the scheduler.
+	Run(ctx context.Context) (string, error)
+}
+
+// RunStatus is the outcome of a run of a job.
+type RunStatus string
+
+const (
+	// RunStatusSuccess indicates the run completed successfully.
+	RunStatusSuccess RunStatus = "SUCCESS"
+	// RunStatusFailed indicates the run returned an error.
+	RunStatusFailed RunStatus = "FAILED"
+	// RunStatusSkipped indicates the run was skipped because another node of the cluster held the lock of the job.
+	RunStatusSkipped RunStatus = "SKIPPED"
+)
+
+// RunTrigger is what started a run of a job.
+type RunTrigger string
+
+const (
+	// RunTriggerScheduled indicates the run was started by the schedule of the job.
+	RunTriggerScheduled RunTrigger = "SCHEDULED"
+	// RunTriggerManual indicates the run was requested through the job API.
+	RunTriggerManual RunTrigger = "MANUAL"
+)
+
+// JobRun holds the details of a run of a job.
+type JobRun struct {
+	Trigger    RunTrigger `json:"trigger"`
+	Status     RunStatus  `json:"status"`
+	StartedAt  time.Time  `json:"startedAt"`
+	DurationMS int64      `json:"durationMs"`
+	Message    string     `json:"message,omitempty"`
+}
+
+// JobStatus holds the schedule and the run history of a job on this node.
+type JobStatus struct {
+	Name         string     `json:"name"`
+	Description  string     `json:"description"`
+	Schedule     string     `json:"schedule"`
+	Enabled      bool       `json:"enabled"`
+	Running      bool       `json:"running"`
+	NextRunAt    *time.Time `json:"nextRunAt,omitempty"`
+	LastRun      *JobRun    `json:"lastRun,omitempty"`
+	RunCount     int64      `json:"runCount"`
+	FailureCount int64      `json:"failureCount"`
+}
+
+// JobListResponse is the response of the job list API.
+type JobListResponse struct {
+	TotalResults int         `json:"totalResults"`
+	Jobs         []JobStatus `json:"jobs"`
+}
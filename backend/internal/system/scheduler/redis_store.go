@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// releaseLockScript deletes the lock key only if it still holds the value of the owner, so that a node never
+// releases a lock another node took over after it expired.
+const releaseLockScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) end return 0`
+
+// redisClient abstracts the Redis commands used by the job lock store.
+type redisClient interface {
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.BoolCmd
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd
+}
+
+// redisJobLockStore is the Redis-backed implementation of jobLockStoreInterface.
+type redisJobLockStore struct {
+	client       redisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisJobLockStore creates a new Redis-backed job lock store.
+func newRedisJobLockStore(p provider.RedisProviderInterface) jobLockStoreInterface {
+	return &redisJobLockStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// lockKey builds the Redis key for the lock of a job.
+func (s *redisJobLockStore) lockKey(jobName string) string {
+	return fmt.Sprintf("%s:runtime:%s:scheduler:lock:%s", s.keyPrefix, s.deploymentID, jobName)
+}
+
+// AcquireLock sets the lock key of a job unless it exists. The key expires at the expiry time of the lock.
+func (s *redisJobLockStore) AcquireLock(
+	ctx context.Context, jobName, owner string, expiryTime time.Time,
+) (bool, error) {
+	ttl := time.Until(expiryTime)
+	if ttl <= 0 {
+		return false, fmt.Errorf("lock of job %q expires in the past", jobName)
+	}
+
+	acquired, err := s.client.SetNX(ctx, s.lockKey(jobName), owner, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire job lock: %w", err)
+	}
+
+	return acquired, nil
+}
+
+// ReleaseLock deletes the lock key of a job if it is held by the given owner.
+func (s *redisJobLockStore) ReleaseLock(ctx context.Context, jobName, owner string) error {
+	if err := s.client.Eval(ctx, releaseLockScript, []string{s.lockKey(jobName)}, owner).Err(); err != nil {
+		return fmt.Errorf("failed to release job lock: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const testLockKey = "thunderid:runtime:test-deployment-id:scheduler:lock:" + testJobName
+
+type RedisJobLockStoreTestSuite struct {
+	suite.Suite
+	store      *redisJobLockStore
+	mockClient *redisClientMock
+	ctx        context.Context
+}
+
+func TestRedisJobLockStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisJobLockStoreTestSuite))
+}
+
+func (suite *RedisJobLockStoreTestSuite) SetupTest() {
+	suite.mockClient = newRedisClientMock(suite.T())
+	suite.ctx = context.Background()
+	suite.store = &redisJobLockStore{
+		client:       suite.mockClient,
+		keyPrefix:    "thunderid",
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *RedisJobLockStoreTestSuite) TestAcquireLock() {
+	for _, held := range []bool{true, false} {
+		boolCmd := redis.NewBoolCmd(suite.ctx)
+		boolCmd.SetVal(held)
+		suite.mockClient.On("SetNX", suite.ctx, testLockKey, "node-1", mock.MatchedBy(func(ttl time.Duration) bool {
+			return ttl > 59*time.Second && ttl <= time.Minute
+		})).Return(boolCmd).Once()
+
+		acquired, err := suite.store.AcquireLock(suite.ctx, testJobName, "node-1", time.Now().Add(time.Minute))
+
+		suite.NoError(err)
+		suite.Equal(held, acquired)
+	}
+}
+
+func (suite *RedisJobLockStoreTestSuite) TestAcquireLock_ExpiryInPast() {
+	acquired, err := suite.store.AcquireLock(suite.ctx, testJobName, "node-1", time.Now().Add(-time.Second))
+
+	suite.False(acquired)
+	suite.Error(err)
+	suite.mockClient.AssertNotCalled(suite.T(), "SetNX", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RedisJobLockStoreTestSuite) TestAcquireLock_Error() {
+	boolCmd := redis.NewBoolCmd(suite.ctx)
+	boolCmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("SetNX", suite.ctx, testLockKey, "node-1", mock.Anything).Return(boolCmd).Once()
+
+	acquired, err := suite.store.AcquireLock(suite.ctx, testJobName, "node-1", time.Now().Add(time.Minute))
+
+	suite.False(acquired)
+	suite.ErrorContains(err, "failed to acquire job lock")
+}
+
+func (suite *RedisJobLockStoreTestSuite) TestReleaseLock() {
+	suite.mockClient.On("Eval", suite.ctx, releaseLockScript, []string{testLockKey}, "node-1").
+		Return(redis.NewCmd(suite.ctx)).Once()
+
+	suite.NoError(suite.store.ReleaseLock(suite.ctx, testJobName, "node-1"))
+}
+
+func (suite *RedisJobLockStoreTestSuite) TestReleaseLock_Error() {
+	cmd := redis.NewCmd(suite.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	suite.mockClient.On("Eval", suite.ctx, releaseLockScript, []string{testLockKey}, "node-1").Return(cmd).Once()
+
+	suite.ErrorContains(suite.store.ReleaseLock(suite.ctx, testJobName, "node-1"), "failed to release job lock")
+}
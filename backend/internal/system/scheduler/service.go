@@ -0,0 +1,364 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// defaultLockTimeout is the lock timeout used when none is configured.
+const defaultLockTimeout = 10 * time.Minute
+
+// SchedulerServiceInterface defines the interface for the scheduler running the recurring jobs of the server.
+type SchedulerServiceInterface interface {
+	// ListJobs returns the status of all registered jobs.
+	ListJobs() []JobStatus
+
+	// GetJob returns the status of the job with the given name.
+	GetJob(name string) (*JobStatus, *serviceerror.ServiceError)
+
+	// RunJob starts a run of the job with the given name in the background and returns its status.
+	RunJob(name string) (*JobStatus, *serviceerror.ServiceError)
+
+	// Start starts running the enabled jobs on their schedules.
+	Start()
+
+	// Shutdown stops the scheduler and waits for the running jobs to return.
+	Shutdown()
+}
+
+// scheduledJob holds a registered job along with its schedule and run history.
+type scheduledJob struct {
+	job      Job
+	spec     string
+	schedule schedule
+	enabled  bool
+
+	mu           sync.Mutex
+	running      bool
+	nextRunAt    time.Time
+	lastRun      *JobRun
+	runCount     int64
+	failureCount int64
+}
+
+// schedulerService is the default implementation of SchedulerServiceInterface.
+type schedulerService struct {
+	jobs        []*scheduledJob
+	jobsByName  map[string]*scheduledJob
+	disabled    bool
+	lockStore   jobLockStoreInterface
+	lockTimeout time.Duration
+	owner       string
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	logger      *log.Logger
+}
+
+// newSchedulerService creates the scheduler for the given jobs. The lock store is nil unless the scheduler runs
+// in clustered mode.
+func newSchedulerService(cfg config.SchedulerConfig, jobs []Job,
+	lockStore jobLockStoreInterface) (*schedulerService, error) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "SchedulerService"))
+
+	jobConfigs := make(map[string]config.SchedulerJobConfig, len(cfg.Jobs))
+	for _, jobConfig := range cfg.Jobs {
+		jobConfigs[jobConfig.Name] = jobConfig
+	}
+
+	s := &schedulerService{
+		jobsByName:  make(map[string]*scheduledJob, len(jobs)),
+		disabled:    cfg.Disabled,
+		lockStore:   lockStore,
+		lockTimeout: time.Duration(cfg.LockTimeout) * time.Second,
+		owner:       newLockOwner(),
+		logger:      logger,
+	}
+	if s.lockTimeout <= 0 {
+		s.lockTimeout = defaultLockTimeout
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	for _, job := range jobs {
+		name := job.Name()
+		if _, exists := s.jobsByName[name]; exists {
+			return nil, fmt.Errorf("duplicate job %q", name)
+		}
+
+		spec := job.DefaultSchedule()
+		enabled := true
+		if jobConfig, ok := jobConfigs[name]; ok {
+			if jobConfig.Schedule != "" {
+				spec = jobConfig.Schedule
+			}
+			enabled = !jobConfig.Disabled
+			delete(jobConfigs, name)
+		}
+
+		sched, err := parseSchedule(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule of job %q: %w", name, err)
+		}
+
+		sj := &scheduledJob{job: job, spec: spec, schedule: sched, enabled: enabled}
+		s.jobs = append(s.jobs, sj)
+		s.jobsByName[name] = sj
+	}
+
+	// Jobs that only apply to some deployments, such as the cleanup of a SQL runtime database, may be
+	// configured but not registered.
+	for name := range jobConfigs {
+		logger.Warn("Ignoring the configuration of a job that is not registered", log.String("job", name))
+	}
+
+	return s, nil
+}
+
+// newLockOwner returns an identifier of this node used as the owner of the job locks it takes.
+func newLockOwner() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+	return hostname + "/" + sysutils.GenerateUUID()
+}
+
+// ListJobs returns the status of all registered jobs in registration order.
+func (s *schedulerService) ListJobs() []JobStatus {
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, sj := range s.jobs {
+		statuses = append(statuses, sj.status())
+	}
+	return statuses
+}
+
+// GetJob returns the status of the job with the given name.
+func (s *schedulerService) GetJob(name string) (*JobStatus, *serviceerror.ServiceError) {
+	sj, ok := s.jobsByName[name]
+	if !ok {
+		return nil, &ErrorJobNotFound
+	}
+
+	status := sj.status()
+	return &status, nil
+}
+
+// RunJob starts a run of the job with the given name in the background. Disabled jobs can also be run.
+func (s *schedulerService) RunJob(name string) (*JobStatus, *serviceerror.ServiceError) {
+	sj, ok := s.jobsByName[name]
+	if !ok {
+		return nil, &ErrorJobNotFound
+	}
+	if !sj.tryStart() {
+		return nil, &ErrorJobAlreadyRunning
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.runStarted(sj, RunTriggerManual)
+	}()
+
+	status := sj.status()
+	return &status, nil
+}
+
+// Start starts a goroutine per enabled job that runs the job on its schedule.
+func (s *schedulerService) Start() {
+	if s.disabled {
+		s.logger.Info("Scheduler is disabled, jobs run only on demand")
+		return
+	}
+
+	for _, sj := range s.jobs {
+		if !sj.enabled {
+			s.logger.Debug("Job is disabled", log.String("job", sj.job.Name()))
+			continue
+		}
+		s.wg.Add(1)
+		go s.loop(sj)
+	}
+	s.logger.Debug("Scheduler started", log.Int("jobs", len(s.jobs)), log.Bool("clustered", s.lockStore != nil))
+}
+
+// Shutdown cancels the scheduler and waits for the running jobs to return.
+func (s *schedulerService) Shutdown() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// loop runs a job on its schedule until the scheduler is shut down.
+func (s *schedulerService) loop(sj *scheduledJob) {
+	defer s.wg.Done()
+
+	for {
+		next := sj.schedule.next(time.Now())
+		if next.IsZero() {
+			s.logger.Warn("Schedule of job has no upcoming run", log.String("job", sj.job.Name()))
+			return
+		}
+		sj.setNextRunAt(next)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if !sj.tryStart() {
+			s.logger.Debug("Skipping scheduled run of a job that is already running",
+				log.String("job", sj.job.Name()))
+			continue
+		}
+		s.runStarted(sj, RunTriggerScheduled)
+	}
+}
+
+// runStarted runs a job that has been marked as running and records the outcome. In clustered mode the job runs
+// only if this node takes the lock of the job.
+func (s *schedulerService) runStarted(sj *scheduledJob, trigger RunTrigger) {
+	name := sj.job.Name()
+	run := &JobRun{Trigger: trigger, StartedAt: time.Now().UTC()}
+	defer func() {
+		run.DurationMS = time.Since(run.StartedAt).Milliseconds()
+		sj.finish(run)
+	}()
+
+	if s.lockStore != nil {
+		acquired, err := s.lockStore.AcquireLock(s.ctx, name, s.owner, time.Now().UTC().Add(s.lockTimeout))
+		if err != nil {
+			s.logger.Error("Failed to acquire the lock of a job", log.String("job", name), log.Error(err))
+			run.Status, run.Message = RunStatusFailed, "Failed to acquire the lock of the job"
+			return
+		}
+		if !acquired {
+			s.logger.Debug("Job is running on another node", log.String("job", name))
+			run.Status, run.Message = RunStatusSkipped, "The job is running on another node"
+			return
+		}
+		defer func() {
+			// The scheduler context may already be cancelled when the server is shutting down.
+			if err := s.lockStore.ReleaseLock(context.Background(), name, s.owner); err != nil {
+				s.logger.Error("Failed to release the lock of a job", log.String("job", name), log.Error(err))
+			}
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, s.lockTimeout)
+	defer cancel()
+
+	message, err := s.runJob(ctx, sj.job)
+	if err != nil {
+		s.logger.Error("Job failed", log.String("job", name), log.Error(err))
+		run.Status, run.Message = RunStatusFailed, err.Error()
+		return
+	}
+
+	s.logger.Debug("Job completed", log.String("job", name), log.String("message", message))
+	run.Status, run.Message = RunStatusSuccess, message
+}
+
+// runJob runs a job, turning a panic of the job into an error so that it does not bring the server down.
+func (s *schedulerService) runJob(ctx context.Context, job Job) (message string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	message, err = job.Run(ctx)
+	if err == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = errors.New("job did not complete within the lock timeout")
+	}
+	return message, err
+}
+
+// tryStart marks the job as running, unless it is already running.
+func (sj *scheduledJob) tryStart() bool {
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+
+	if sj.running {
+		return false
+	}
+	sj.running = true
+	return true
+}
+
+// finish records the outcome of a run and marks the job as not running.
+func (sj *scheduledJob) finish(run *JobRun) {
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+
+	sj.running = false
+	sj.lastRun = run
+	if run.Status == RunStatusSkipped {
+		return
+	}
+	sj.runCount++
+	if run.Status == RunStatusFailed {
+		sj.failureCount++
+	}
+}
+
+// setNextRunAt records the time of the next scheduled run of the job.
+func (sj *scheduledJob) setNextRunAt(next time.Time) {
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+
+	sj.nextRunAt = next
+}
+
+// status returns a snapshot of the status of the job.
+func (sj *scheduledJob) status() JobStatus {
+	sj.mu.Lock()
+	defer sj.mu.Unlock()
+
+	status := JobStatus{
+		Name:         sj.job.Name(),
+		Description:  sj.job.Description(),
+		Schedule:     sj.spec,
+		Enabled:      sj.enabled,
+		Running:      sj.running,
+		RunCount:     sj.runCount,
+		FailureCount: sj.failureCount,
+	}
+	if !sj.nextRunAt.IsZero() {
+		nextRunAt := sj.nextRunAt
+		status.NextRunAt = &nextRunAt
+	}
+	if sj.lastRun != nil {
+		lastRun := *sj.lastRun
+		status.LastRun = &lastRun
+	}
+	return status
+}
@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const testJobName = "test-job"
+
+type SchedulerServiceTestSuite struct {
+	suite.Suite
+	jobMock       *JobMock
+	lockStoreMock *jobLockStoreInterfaceMock
+}
+
+func TestSchedulerServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerServiceTestSuite))
+}
+
+func (suite *SchedulerServiceTestSuite) SetupTest() {
+	suite.jobMock = NewJobMock(suite.T())
+	suite.jobMock.EXPECT().Name().Return(testJobName).Maybe()
+	suite.jobMock.EXPECT().Description().Return("Test job").Maybe()
+	suite.jobMock.EXPECT().DefaultSchedule().Return("@hourly").Maybe()
+	suite.lockStoreMock = newJobLockStoreInterfaceMock(suite.T())
+}
+
+// newService creates a scheduler for the test job with the given configuration.
+func (suite *SchedulerServiceTestSuite) newService(
+	cfg config.SchedulerConfig, lockStore jobLockStoreInterface,
+) *schedulerService {
+	s, err := newSchedulerService(cfg, []Job{suite.jobMock}, lockStore)
+	suite.Require().NoError(err)
+	suite.T().Cleanup(s.Shutdown)
+	return s
+}
+
+// runAndWait runs the test job on demand and waits for the run to finish.
+func (suite *SchedulerServiceTestSuite) runAndWait(s *schedulerService) JobStatus {
+	_, svcErr := s.RunJob(testJobName)
+	suite.Require().Nil(svcErr)
+	s.wg.Wait()
+
+	status, svcErr := s.GetJob(testJobName)
+	suite.Require().Nil(svcErr)
+	return *status
+}
+
+func (suite *SchedulerServiceTestSuite) TestNewSchedulerService_Defaults() {
+	s := suite.newService(config.SchedulerConfig{}, nil)
+
+	suite.Equal(defaultLockTimeout, s.lockTimeout)
+	jobs := s.ListJobs()
+	suite.Require().Len(jobs, 1)
+	suite.Equal(JobStatus{Name: testJobName, Description: "Test job", Schedule: "@hourly", Enabled: true}, jobs[0])
+}
+
+func (suite *SchedulerServiceTestSuite) TestNewSchedulerService_JobConfig() {
+	s := suite.newService(config.SchedulerConfig{
+		LockTimeout: 30,
+		Jobs: []config.SchedulerJobConfig{
+			{Name: testJobName, Schedule: "0 2 * * *", Disabled: true},
+			{Name: "unregistered-job", Schedule: "@daily"},
+		},
+	}, nil)
+
+	suite.Equal(30*time.Second, s.lockTimeout)
+	status, svcErr := s.GetJob(testJobName)
+	suite.Require().Nil(svcErr)
+	suite.Equal("0 2 * * *", status.Schedule)
+	suite.False(status.Enabled)
+}
+
+func (suite *SchedulerServiceTestSuite) TestNewSchedulerService_InvalidSchedule() {
+	_, err := newSchedulerService(config.SchedulerConfig{
+		Jobs: []config.SchedulerJobConfig{{Name: testJobName, Schedule: "every hour"}},
+	}, []Job{suite.jobMock}, nil)
+
+	suite.ErrorContains(err, testJobName)
+}
+
+func (suite *SchedulerServiceTestSuite) TestNewSchedulerService_DuplicateJob() {
+	_, err := newSchedulerService(config.SchedulerConfig{}, []Job{suite.jobMock, suite.jobMock}, nil)
+
+	suite.ErrorContains(err, "duplicate job")
+}
+
+func (suite *SchedulerServiceTestSuite) TestGetJob_NotFound() {
+	s := suite.newService(config.SchedulerConfig{}, nil)
+
+	status, svcErr := s.GetJob("missing")
+
+	suite.Nil(status)
+	suite.Equal(&ErrorJobNotFound, svcErr)
+}
+
+func (suite *SchedulerServiceTestSuite) TestRunJob_NotFound() {
+	s := suite.newService(config.SchedulerConfig{}, nil)
+
+	status, svcErr := s.RunJob("missing")
+
+	suite.Nil(status)
+	suite.Equal(&ErrorJobNotFound, svcErr)
+}
+
+func (suite *SchedulerServiceTestSuite) TestRunJob_Success() {
+	suite.jobMock.EXPECT().Run(mock.Anything).Return("Deleted 3 expired records", nil).Once()
+	s := suite.newService(config.SchedulerConfig{}, nil)
+
+	status := suite.runAndWait(s)
+
+	suite.False(status.Running)
+	suite.Equal(int64(1), status.RunCount)
+	suite.Equal(int64(0), status.FailureCount)
+	suite.Require().NotNil(status.LastRun)
+	suite.Equal(RunTriggerManual, status.LastRun.Trigger)
+	suite.Equal(RunStatusSuccess, status.LastRun.Status)
+	suite.Equal("Deleted 3 expired records", status.LastRun.Message)
+}
+
+func (suite *SchedulerServiceTestSuite) TestRunJob_Failure() {
+	suite.jobMock.EXPECT().Run(mock.Anything).Return("", errors.New("database unavailable")).Once()
+	s := suite.newService(config.SchedulerConfig{}, nil)
+
+	status := suite.runAndWait(s)
+
+	suite.Equal(int64(1), status.RunCount)
+	suite.Equal(int64(1), status.FailureCount)
+	suite.Equal(RunStatusFailed, status.LastRun.Status)
+	suite.Equal("database unavailable", status.LastRun.Message)
+}
+
+func (suite *SchedulerServiceTestSuite) TestRunJob_Panic() {
+	suite.jobMock.EXPECT().Run(mock.Anything).RunAndReturn(func(ctx context.Context) (string, error) {
+		panic("boom")
+	}).Once()
+	s := suite.newService(config.SchedulerConfig{}, nil)
+
+	status := suite.runAndWait(s)
+
+	suite.Equal(RunStatusFailed, status.LastRun.Status)
+	suite.Contains(status.LastRun.Message, "boom")
+}
+
+func (suite *SchedulerServiceTestSuite) TestRunJob_AlreadyRunning() {
+	release := make(chan struct{})
+	suite.jobMock.EXPECT().Run(mock.Anything).RunAndReturn(func(ctx context.Context) (string, error) {
+		<-release
+		return "", nil
+	}).Once()
+	s := suite.newService(config.SchedulerConfig{}, nil)
+
+	status, svcErr := s.RunJob(testJobName)
+	suite.Require().Nil(svcErr)
+	suite.True(status.Running)
+
+	_, svcErr = s.RunJob(testJobName)
+	suite.Equal(&ErrorJobAlreadyRunning, svcErr)
+
+	close(release)
+	s.wg.Wait()
+}
+
+func (suite *SchedulerServiceTestSuite) TestRunJob_ClusteredLockAcquired() {
+	suite.lockStoreMock.EXPECT().AcquireLock(mock.Anything, testJobName, mock.Anything, mock.Anything).
+		Return(true, nil).Once()
+	suite.lockStoreMock.EXPECT().ReleaseLock(mock.Anything, testJobName, mock.Anything).Return(nil).Once()
+	suite.jobMock.EXPECT().Run(mock.Anything).Return("done", nil).Once()
+	s := suite.newService(config.SchedulerConfig{Clustered: true}, suite.lockStoreMock)
+
+	status := suite.runAndWait(s)
+
+	suite.Equal(RunStatusSuccess, status.LastRun.Status)
+}
+
+func (suite *SchedulerServiceTestSuite) TestRunJob_ClusteredLockHeldByAnotherNode() {
+	suite.lockStoreMock.EXPECT().AcquireLock(mock.Anything, testJobName, mock.Anything, mock.Anything).
+		Return(false, nil).Once()
+	s := suite.newService(config.SchedulerConfig{Clustered: true}, suite.lockStoreMock)
+
+	status := suite.runAndWait(s)
+
+	suite.Equal(RunStatusSkipped, status.LastRun.Status)
+	suite.Equal(int64(0), status.RunCount)
+	suite.jobMock.AssertNotCalled(suite.T(), "Run", mock.Anything)
+}
+
+func (suite *SchedulerServiceTestSuite) TestRunJob_ClusteredLockError() {
+	suite.lockStoreMock.EXPECT().AcquireLock(mock.Anything, testJobName, mock.Anything, mock.Anything).
+		Return(false, errors.New("connection refused")).Once()
+	s := suite.newService(config.SchedulerConfig{Clustered: true}, suite.lockStoreMock)
+
+	status := suite.runAndWait(s)
+
+	suite.Equal(RunStatusFailed, status.LastRun.Status)
+	suite.Equal(int64(1), status.FailureCount)
+	suite.jobMock.AssertNotCalled(suite.T(), "Run", mock.Anything)
+}
+
+func (suite *SchedulerServiceTestSuite) TestStart_RunsJobOnSchedule() {
+	ran := make(chan struct{}, 1)
+	suite.jobMock.EXPECT().Run(mock.Anything).RunAndReturn(func(ctx context.Context) (string, error) {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+		return "", nil
+	})
+	s := suite.newService(config.SchedulerConfig{}, nil)
+	s.jobs[0].schedule = intervalSchedule{interval: 10 * time.Millisecond}
+
+	s.Start()
+
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		suite.Fail("job did not run on its schedule")
+	}
+	s.Shutdown()
+
+	status, _ := s.GetJob(testJobName)
+	suite.NotNil(status.NextRunAt)
+	suite.Equal(RunTriggerScheduled, status.LastRun.Trigger)
+}
+
+func (suite *SchedulerServiceTestSuite) TestStart_Disabled() {
+	s := suite.newService(config.SchedulerConfig{Disabled: true}, nil)
+	s.jobs[0].schedule = intervalSchedule{interval: time.Millisecond}
+
+	s.Start()
+	time.Sleep(20 * time.Millisecond)
+	s.Shutdown()
+
+	status, _ := s.GetJob(testJobName)
+	suite.Nil(status.NextRunAt)
+	suite.Nil(status.LastRun)
+}
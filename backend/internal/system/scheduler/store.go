@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// jobLockStoreInterface defines the lock the nodes of a cluster take before running a job.
+type jobLockStoreInterface interface {
+	// AcquireLock takes the lock of a job for the given owner until the expiry time. It returns false if another
+	// owner holds an unexpired lock of the job.
+	AcquireLock(ctx context.Context, jobName, owner string, expiryTime time.Time) (bool, error)
+
+	// ReleaseLock releases the lock of a job if it is held by the given owner.
+	ReleaseLock(ctx context.Context, jobName, owner string) error
+}
+
+// jobLockStore is the SQL implementation of jobLockStoreInterface backed by the runtime database.
+type jobLockStore struct {
+	dbProvider   dbprovider.DBProviderInterface
+	deploymentID string
+}
+
+// newJobLockStore creates a new instance of jobLockStore.
+func newJobLockStore() jobLockStoreInterface {
+	return &jobLockStore{
+		dbProvider:   dbprovider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// AcquireLock takes the lock of a job in the database.
+func (s *jobLockStore) AcquireLock(ctx context.Context, jobName, owner string, expiryTime time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rows, err := dbClient.ExecuteContext(ctx, queryAcquireJobLock, jobName, owner, expiryTime, s.deploymentID,
+		time.Now().UTC())
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire job lock: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// ReleaseLock releases the lock of a job in the database.
+func (s *jobLockStore) ReleaseLock(ctx context.Context, jobName, owner string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryReleaseJobLock, jobName, owner, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to release job lock: %w", err)
+	}
+
+	return nil
+}
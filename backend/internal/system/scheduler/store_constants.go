@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryAcquireJobLock takes the lock of a job, or takes over a lock that has expired.
+	queryAcquireJobLock = dbmodel.DBQuery{
+		ID: "SCQ-01",
+		Query: `INSERT INTO "SCHEDULER_JOB_LOCK" (JOB_NAME, OWNER, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4) ` +
+			`ON CONFLICT (JOB_NAME, DEPLOYMENT_ID) DO UPDATE ` +
+			`SET OWNER = excluded.OWNER, EXPIRY_TIME = excluded.EXPIRY_TIME ` +
+			`WHERE "SCHEDULER_JOB_LOCK".EXPIRY_TIME < $5`,
+	}
+
+	// queryReleaseJobLock releases the lock of a job held by the given owner.
+	queryReleaseJobLock = dbmodel.DBQuery{
+		ID:    "SCQ-02",
+		Query: `DELETE FROM "SCHEDULER_JOB_LOCK" WHERE JOB_NAME = $1 AND OWNER = $2 AND DEPLOYMENT_ID = $3`,
+	}
+)
+
+// Queries deleting the expired rows of the runtime tables. FLOW_USER_DATA rows are deleted along with their
+// FLOW_CONTEXT rows through the foreign key.
+var (
+	queryDeleteExpiredFlowContexts          = newDeleteExpiredQuery("SCQ-10", "FLOW_CONTEXT")
+	queryDeleteExpiredAuthorizationCodes    = newDeleteExpiredQuery("SCQ-11", "AUTHORIZATION_CODE")
+	queryDeleteExpiredAuthorizationRequests = newDeleteExpiredQuery("SCQ-12", "AUTHORIZATION_REQUEST")
+	queryDeleteExpiredPARRequests           = newDeleteExpiredQuery("SCQ-13", "PAR_REQUEST")
+	queryDeleteExpiredSSOSessions           = newDeleteExpiredQuery("SCQ-14", "SSO_SESSION")
+	queryDeleteExpiredWebAuthnSessions      = newDeleteExpiredQuery("SCQ-15", "WEBAUTHN_SESSION")
+	queryDeleteExpiredAttributeCache        = newDeleteExpiredQuery("SCQ-16", "ATTRIBUTE_CACHE")
+	queryDeleteExpiredSAMLMessageContexts   = newDeleteExpiredQuery("SCQ-17", "SAML_MESSAGE_CONTEXT")
+	queryDeleteExpiredDeliveryStatuses      = newDeleteExpiredQuery("SCQ-18", "NOTIFICATION_DELIVERY_STATUS")
+	queryDeleteExpiredDeadLetters           = newDeleteExpiredQuery("SCQ-19", "NOTIFICATION_DEAD_LETTER")
+	queryDeleteExpiredRateLimitCounters     = newDeleteExpiredQuery("SCQ-20", "NOTIFICATION_RATE_LIMIT_COUNTER")
+	queryDeleteExpiredSendAudits            = newDeleteExpiredQuery("SCQ-21", "NOTIFICATION_SEND_AUDIT")
+	queryDeleteExpiredToolCallAudits        = newDeleteExpiredQuery("SCQ-22", "MCP_TOOL_CALL_AUDIT")
+	queryDeleteExpiredTokenRateLimits       = newDeleteExpiredQuery("SCQ-23", "TOKEN_RATE_LIMIT_COUNTER")
+	queryDeleteExpiredCIBARequests          = newDeleteExpiredQuery("SCQ-24", "CIBA_REQUEST")
+	queryDeleteExpiredSSOSessionClients     = newDeleteExpiredQuery("SCQ-25", "SSO_SESSION_CLIENT")
+	queryDeleteExpiredLogoutDeliveries      = newDeleteExpiredQuery("SCQ-26", "BACKCHANNEL_LOGOUT_DELIVERY")
+	queryDeleteExpiredFlowExecutions        = newDeleteExpiredQuery("SCQ-27", "FLOW_EXECUTION_ANALYTICS")
+	queryDeleteExpiredFlowNodeExecutions    = newDeleteExpiredQuery("SCQ-28", "FLOW_NODE_EXECUTION_ANALYTICS")
+)
+
+// newDeleteExpiredQuery builds the query deleting the rows of a runtime table that expired before a time.
+func newDeleteExpiredQuery(id, table string) dbmodel.DBQuery {
+	return dbmodel.DBQuery{
+		ID:    id,
+		Query: `DELETE FROM "` + table + `" WHERE EXPIRY_TIME < $1 AND DEPLOYMENT_ID = $2`,
+	}
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type JobLockStoreTestSuite struct {
+	suite.Suite
+	store          *jobLockStore
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	ctx            context.Context
+}
+
+func TestJobLockStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(JobLockStoreTestSuite))
+}
+
+func (suite *JobLockStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.ctx = context.Background()
+	suite.store = &jobLockStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *JobLockStoreTestSuite) TestAcquireLock() {
+	testCases := []struct {
+		name     string
+		rows     int64
+		expected bool
+	}{
+		{"Acquired", 1, true},
+		{"HeldByAnotherOwner", 0, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			expiry := time.Now().Add(time.Minute)
+			suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+			suite.mockDBClient.On("ExecuteContext", suite.ctx, queryAcquireJobLock, testJobName, "node-1", expiry,
+				testDeploymentID, mock.AnythingOfType("time.Time")).Return(tc.rows, nil).Once()
+
+			acquired, err := suite.store.AcquireLock(suite.ctx, testJobName, "node-1", expiry)
+
+			suite.NoError(err)
+			suite.Equal(tc.expected, acquired)
+		})
+	}
+}
+
+func (suite *JobLockStoreTestSuite) TestAcquireLock_DBClientError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db provider error")).Once()
+
+	acquired, err := suite.store.AcquireLock(suite.ctx, testJobName, "node-1", time.Now())
+
+	suite.False(acquired)
+	suite.ErrorContains(err, "failed to get database client")
+}
+
+func (suite *JobLockStoreTestSuite) TestAcquireLock_ExecuteError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryAcquireJobLock, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("deadlock")).Once()
+
+	acquired, err := suite.store.AcquireLock(suite.ctx, testJobName, "node-1", time.Now())
+
+	suite.False(acquired)
+	suite.ErrorContains(err, "failed to acquire job lock")
+}
+
+func (suite *JobLockStoreTestSuite) TestReleaseLock() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryReleaseJobLock, testJobName, "node-1",
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.ReleaseLock(suite.ctx, testJobName, "node-1"))
+}
+
+func (suite *JobLockStoreTestSuite) TestReleaseLock_ExecuteError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryReleaseJobLock, testJobName, "node-1",
+		testDeploymentID).Return(int64(0), errors.New("connection reset")).Once()
+
+	suite.ErrorContains(suite.store.ReleaseLock(suite.ctx, testJobName, "node-1"), "failed to release job lock")
+}
@@ -64,6 +64,16 @@ func withSecurityContext(ctx context.Context, authCtx *SecurityContext) context.
 	return context.WithValue(ctx, securityContextKey, authCtx)
 }
 
+// WithVerifiedToken adds a security context built from the claims of an access token to the context.
+// It is intended for handlers on public paths that verify the token themselves, such as the MCP server,
+// so that the services they call see the same authenticated caller as on secured paths.
+// The token must already be verified; the claims are not validated here.
+func WithVerifiedToken(ctx context.Context, token string, claims map[string]interface{}) context.Context {
+	authCtx := newSecurityContext(extractAttribute(claims, "sub"), extractAttribute(claims, "ouId"), token,
+		extractScopes(claims), claims)
+	return withSecurityContext(ctx, authCtx)
+}
+
 // withSecuritySkipped marks the context to indicate that security enforcement was skipped.
 func withSecuritySkipped(ctx context.Context) context.Context {
 	if ctx == nil {
@@ -65,6 +65,21 @@ func (s *SecurityContextTestSuite) TestNewSecurityContext() {
 	}
 }
 
+func (s *SecurityContextTestSuite) TestWithVerifiedToken() {
+	claims := map[string]interface{}{
+		"sub":   testUserID,
+		"ouId":  "ou456",
+		"scope": "system users:view",
+	}
+
+	ctx := WithVerifiedToken(context.Background(), "test-token-123", claims)
+
+	s.Equal(testUserID, GetSubject(ctx))
+	s.Equal("ou456", GetOUID(ctx))
+	s.ElementsMatch([]string{"system", "users:view"}, GetPermissions(ctx))
+	s.Equal("ou456", GetAttribute(ctx, "ouId"))
+}
+
 func (s *SecurityContextTestSuite) TestWithSecurityContext_NilContext() {
 	authCtx := newSecurityContext(testUserID, "ou456", "token", nil, map[string]interface{}{
 		"sub": testUserID,
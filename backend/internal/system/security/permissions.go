@@ -22,7 +22,7 @@ import "strings"
 
 const (
 	// maxPublicPathLength defines the maximum allowed length for a public path.
-	// This prevents potential DoS attacks via excessively long paths (even with safe regex).
+	// This prevents potential DoS attacks via excessively long paths.
 	maxPublicPathLength = 4096
 )
 
@@ -42,6 +42,7 @@ var publicPaths = []string{
 	"/flow/execute/**",
 	"/flow/meta",
 	"/oauth2/**",
+	"/saml2/**",
 	"/.well-known/openid-configuration/**",
 	"/.well-known/oauth-authorization-server/**",
 	"/.well-known/oauth-protected-resource",
@@ -49,10 +50,15 @@ var publicPaths = []string{
 	"/console/**",
 	"/error/**",
 	"/design/resolve/**",
+	"/design/assets/*/content",
 	"/i18n/languages",
 	"/i18n/languages/*/translations/resolve",
 	"/i18n/languages/*/translations/ns/*/keys/*/resolve",
-	"/mcp/**", // MCP authorization is handled at MCP server handler.
+	"/notification-callbacks/**", // Callbacks are authenticated by the provider signature or callback token.
+	"/mcp/**",                    // MCP tokens and tool calls are authorized by the MCP server handler.
+	"/api-docs",
+	"/develop",
+	"/error-codes/**",
 }
 
 // ---- Resource types ----
@@ -143,17 +149,22 @@ const (
 // SystemPermissions holds the runtime-resolved permission strings for the system resource server.
 // All values are set by InitSystemPermissions and must not be used before it is called.
 type SystemPermissions struct {
-	Root          string
-	OU            string
-	OUView        string
-	User          string
-	UserView      string
-	Group         string
-	GroupView     string
-	UserType      string
-	UserTypeView  string
-	AgentType     string
-	AgentTypeView string
+	Root            string
+	OU              string
+	OUView          string
+	User            string
+	UserView        string
+	Group           string
+	GroupView       string
+	UserType        string
+	UserTypeView    string
+	AgentType       string
+	AgentTypeView   string
+	Application     string
+	ApplicationView string
+	Flow            string
+	FlowView        string
+	IDPView         string
 }
 
 // sysPerms holds the active system permissions, initialized by InitSystemPermissions.
@@ -176,17 +187,22 @@ func buildPermission(parts ...string) string {
 // This function must be called once at startup before any service or middleware uses permissions.
 func InitSystemPermissions(handle string) {
 	p := &SystemPermissions{
-		Root:          buildPermission(handle, "system"),
-		OU:            buildPermission(handle, "system", "ou"),
-		OUView:        buildPermission(handle, "system", "ou", "view"),
-		User:          buildPermission(handle, "system", "user"),
-		UserView:      buildPermission(handle, "system", "user", "view"),
-		Group:         buildPermission(handle, "system", "group"),
-		GroupView:     buildPermission(handle, "system", "group", "view"),
-		UserType:      buildPermission(handle, "system", "usertype"),
-		UserTypeView:  buildPermission(handle, "system", "usertype", "view"),
-		AgentType:     buildPermission(handle, "system", "agenttype"),
-		AgentTypeView: buildPermission(handle, "system", "agenttype", "view"),
+		Root:            buildPermission(handle, "system"),
+		OU:              buildPermission(handle, "system", "ou"),
+		OUView:          buildPermission(handle, "system", "ou", "view"),
+		User:            buildPermission(handle, "system", "user"),
+		UserView:        buildPermission(handle, "system", "user", "view"),
+		Group:           buildPermission(handle, "system", "group"),
+		GroupView:       buildPermission(handle, "system", "group", "view"),
+		UserType:        buildPermission(handle, "system", "usertype"),
+		UserTypeView:    buildPermission(handle, "system", "usertype", "view"),
+		AgentType:       buildPermission(handle, "system", "agenttype"),
+		AgentTypeView:   buildPermission(handle, "system", "agenttype", "view"),
+		Application:     buildPermission(handle, "system", "application"),
+		ApplicationView: buildPermission(handle, "system", "application", "view"),
+		Flow:            buildPermission(handle, "system", "flow"),
+		FlowView:        buildPermission(handle, "system", "flow", "view"),
+		IDPView:         buildPermission(handle, "system", "idp", "view"),
 	}
 	sysPerms = p
 
@@ -236,6 +252,9 @@ func InitSystemPermissions(handle string) {
 		{"GET /users/me/**", ""},
 		{"PUT /users/me/**", ""},
 		{"POST /users/me/update-credentials", ""},
+		{"POST /users/me/push-devices", ""},
+		{"DELETE /users/me/push-devices/*", ""},
+		{"DELETE /users/me/consents/*", ""},
 		{"GET /register/passkey/**", ""},
 		{"POST /register/passkey/**", ""},
 
@@ -249,6 +268,13 @@ func InitSystemPermissions(handle string) {
 		{"PUT /organization-units/**", p.OU},
 		{"DELETE /organization-units/**", p.OU},
 
+		// Organization APIs.
+		{"GET /organizations", p.OUView},
+		{"POST /organizations", p.OU},
+		{"GET /organizations/**", p.OUView},
+		{"PUT /organizations/**", p.OU},
+		{"DELETE /organizations/**", p.OU},
+
 		// User APIs.
 		{"GET /users", p.UserView},
 		{"POST /users", p.User},
@@ -278,9 +304,67 @@ func InitSystemPermissions(handle string) {
 		{"PUT /agent-types/**", p.AgentType},
 		{"DELETE /agent-types/**", p.AgentType},
 
+		// Application APIs.
+		{"GET /applications", p.ApplicationView},
+		{"POST /applications", p.Application},
+		{"GET /applications/**", p.ApplicationView},
+		{"POST /applications/**", p.Application},
+		{"PUT /applications/**", p.Application},
+		{"DELETE /applications/**", p.Application},
+
 		// Import APIs.
 		{"POST /import", p.Root},
 		{"POST /import/delete", p.Root},
+
+		// Backup APIs.
+		{"POST /backup", p.Root},
+		{"POST /backup/restore", p.Root},
+
+		// Scheduled job APIs.
+		{"GET /jobs", p.Root},
+		{"GET /jobs/**", p.Root},
+		{"POST /jobs/**", p.Root},
+
+		// Signing key APIs.
+		{"GET /signing-keys", p.Root},
+		{"POST /signing-keys/rotate", p.Root},
+
+		// Erasure request APIs.
+		{"GET /erasure-requests", p.UserView},
+		{"POST /erasure-requests", p.User},
+		{"GET /erasure-requests/**", p.UserView},
+
+		// Provisioning sync status APIs.
+		{"GET /provisioning/targets", p.UserView},
+		{"GET /provisioning/operations", p.UserView},
+		{"GET /provisioning/operations/**", p.UserView},
+	}
+
+	mcpToolPermissionMap = map[string]string{
+		// Application tools.
+		"thunderid_list_applications":            p.ApplicationView,
+		"thunderid_get_application_by_id":        p.ApplicationView,
+		"thunderid_get_application_by_client_id": p.ApplicationView,
+		"thunderid_create_application":           p.Application,
+		"thunderid_update_application":           p.Application,
+		"thunderid_get_application_templates":    p.ApplicationView,
+
+		// Flow tools.
+		"thunderid_list_flows":         p.FlowView,
+		"thunderid_get_flow_by_handle": p.FlowView,
+		"thunderid_get_flow_by_id":     p.FlowView,
+		"thunderid_create_flow":        p.Flow,
+		"thunderid_update_flow":        p.Flow,
+
+		// Integration guidance tools — they do not access any resource, so any authenticated caller may
+		// use them (empty permission).
+		"thunderid_integrate_react_sdk": "",
+	}
+
+	mcpResourcePermissionMap = map[string]string{
+		"thunderid://applications":       p.ApplicationView,
+		"thunderid://flows":              p.FlowView,
+		"thunderid://identity-providers": p.IDPView,
 	}
 }
 
@@ -317,6 +401,20 @@ type apiPermissionEntry struct {
 // Rebuilt by InitSystemPermissions at startup.
 var apiPermissionEntries []apiPermissionEntry
 
+// ---- MCP tool → Permission map ----
+
+// mcpToolPermissionMap maps each MCP tool name to the minimum permission required to invoke it.
+// Tools not present in this map default to requiring the root system permission.
+// Rebuilt by InitSystemPermissions at startup.
+var mcpToolPermissionMap map[string]string
+
+// ---- MCP resource → Permission map ----
+
+// mcpResourcePermissionMap maps each MCP resource collection URI to the minimum permission required to read
+// the collection and its members. Resources not covered by this map default to requiring the root system
+// permission. Rebuilt by InitSystemPermissions at startup.
+var mcpResourcePermissionMap map[string]string
+
 // ---- Helper functions ----
 
 // HasSystemPermission returns true if the caller holds the root system permission.
@@ -364,3 +462,31 @@ func ResolveActionPermission(action Action) string {
 	}
 	return UninitializedPermissionSentinel
 }
+
+// ResolveMCPToolPermission returns the minimum permission required to invoke the given MCP tool.
+// Falls back to the root system permission for tools not listed in the MCP tool permission map, so that
+// newly registered tools are restricted until they are mapped.
+func ResolveMCPToolPermission(toolName string) string {
+	if perm, ok := mcpToolPermissionMap[toolName]; ok {
+		return perm
+	}
+	if sysPerms != nil {
+		return sysPerms.Root
+	}
+	return UninitializedPermissionSentinel
+}
+
+// ResolveMCPResourcePermission returns the minimum permission required to read the MCP resource with the given
+// URI. The permission of a collection applies to the collection URI and to the URIs of its members.
+// Falls back to the root system permission for resources not listed in the MCP resource permission map.
+func ResolveMCPResourcePermission(uri string) string {
+	for collectionURI, perm := range mcpResourcePermissionMap {
+		if uri == collectionURI || strings.HasPrefix(uri, collectionURI+"/") {
+			return perm
+		}
+	}
+	if sysPerms != nil {
+		return sysPerms.Root
+	}
+	return UninitializedPermissionSentinel
+}
@@ -251,6 +251,60 @@ func (s *SecurityContextTestSuite) TestResolveActionPermission_CoversAllMappedAc
 	}
 }
 
+// ---------------------------------------------------------------------------
+// ResolveMCPToolPermission
+// ---------------------------------------------------------------------------
+
+func (s *SecurityContextTestSuite) TestResolveMCPToolPermission() {
+	InitSystemPermissions("")
+	p := GetSystemPermissions()
+
+	tests := []struct {
+		name     string
+		toolName string
+		wantPerm string
+	}{
+		{name: "ApplicationReadTool", toolName: "thunderid_list_applications", wantPerm: p.ApplicationView},
+		{name: "ApplicationWriteTool", toolName: "thunderid_create_application", wantPerm: p.Application},
+		{name: "FlowReadTool", toolName: "thunderid_list_flows", wantPerm: p.FlowView},
+		{name: "FlowWriteTool", toolName: "thunderid_update_flow", wantPerm: p.Flow},
+		{name: "PublicTool", toolName: "thunderid_integrate_react_sdk", wantPerm: ""},
+		{name: "UnmappedTool_FallsBackToSystem", toolName: "custom_unknown_tool", wantPerm: p.Root},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.wantPerm, ResolveMCPToolPermission(tt.toolName))
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ResolveMCPResourcePermission
+// ---------------------------------------------------------------------------
+
+func (s *SecurityContextTestSuite) TestResolveMCPResourcePermission() {
+	InitSystemPermissions("")
+	p := GetSystemPermissions()
+
+	tests := []struct {
+		name     string
+		uri      string
+		wantPerm string
+	}{
+		{name: "Collection", uri: "thunderid://flows", wantPerm: p.FlowView},
+		{name: "IdentityProviders", uri: "thunderid://identity-providers", wantPerm: p.IDPView},
+		{name: "Member", uri: "thunderid://applications/app-1", wantPerm: p.ApplicationView},
+		{name: "UnmappedResource_FallsBackToSystem", uri: "thunderid://unknown", wantPerm: p.Root},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.wantPerm, ResolveMCPResourcePermission(tt.uri))
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // InitSystemPermissions
 // ---------------------------------------------------------------------------
@@ -271,6 +325,11 @@ func TestInitSystemPermissions_EmptyHandle(t *testing.T) {
 	assert.Equal(t, "system:usertype:view", p.UserTypeView)
 	assert.Equal(t, "system:agenttype", p.AgentType)
 	assert.Equal(t, "system:agenttype:view", p.AgentTypeView)
+	assert.Equal(t, "system:application", p.Application)
+	assert.Equal(t, "system:application:view", p.ApplicationView)
+	assert.Equal(t, "system:flow", p.Flow)
+	assert.Equal(t, "system:flow:view", p.FlowView)
+	assert.Equal(t, "system:idp:view", p.IDPView)
 }
 
 func TestInitSystemPermissions_NonEmptyHandle(t *testing.T) {
@@ -289,6 +348,11 @@ func TestInitSystemPermissions_NonEmptyHandle(t *testing.T) {
 	assert.Equal(t, "mgmt:system:usertype:view", p.UserTypeView)
 	assert.Equal(t, "mgmt:system:agenttype", p.AgentType)
 	assert.Equal(t, "mgmt:system:agenttype:view", p.AgentTypeView)
+	assert.Equal(t, "mgmt:system:application", p.Application)
+	assert.Equal(t, "mgmt:system:application:view", p.ApplicationView)
+	assert.Equal(t, "mgmt:system:flow", p.Flow)
+	assert.Equal(t, "mgmt:system:flow:view", p.FlowView)
+	assert.Equal(t, "mgmt:system:idp:view", p.IDPView)
 
 	// Restore default for other tests.
 	InitSystemPermissions("")
@@ -340,6 +404,18 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 		{name: "POST /users exact", method: http.MethodPost, path: "/users", wantPerm: p.User},
 		{name: "GET /groups exact", method: http.MethodGet, path: "/groups", wantPerm: p.GroupView},
 		{name: "POST /groups exact", method: http.MethodPost, path: "/groups", wantPerm: p.Group},
+		{
+			name:   "GET /applications exact",
+			method: http.MethodGet, path: "/applications", wantPerm: p.ApplicationView,
+		},
+		{
+			name:   "POST /applications exact",
+			method: http.MethodPost, path: "/applications", wantPerm: p.Application,
+		},
+		{
+			name:   "POST /applications/{id}/client-secrets",
+			method: http.MethodPost, path: "/applications/app-1/client-secrets", wantPerm: p.Application,
+		},
 
 		// ---- Self-service paths (empty permission = any authenticated user) ----
 		{name: "GET /users/me self-service", method: http.MethodGet, path: "/users/me", wantPerm: ""},
@@ -350,6 +426,24 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 			path:     "/users/me/update-credentials",
 			wantPerm: "",
 		},
+		{
+			name:     "POST /users/me/push-devices self-service",
+			method:   http.MethodPost,
+			path:     "/users/me/push-devices",
+			wantPerm: "",
+		},
+		{
+			name:     "DELETE /users/me/push-devices/{id} self-service",
+			method:   http.MethodDelete,
+			path:     "/users/me/push-devices/device-1",
+			wantPerm: "",
+		},
+		{
+			name:     "DELETE /users/me/consents/{id} self-service",
+			method:   http.MethodDelete,
+			path:     "/users/me/consents/consent-1",
+			wantPerm: "",
+		},
 		{
 			name:   "GET /register/passkey/start self-service",
 			method: http.MethodGet, path: "/register/passkey/start", wantPerm: "",
@@ -372,6 +466,14 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 			name:   "DELETE /organization-units/{id} prefix",
 			method: http.MethodDelete, path: "/organization-units/ou-123", wantPerm: p.OU,
 		},
+		{
+			name:   "GET /organizations/{id} prefix",
+			method: http.MethodGet, path: "/organizations/org-123", wantPerm: p.OUView,
+		},
+		{
+			name:   "PUT /organizations/{id} prefix",
+			method: http.MethodPut, path: "/organizations/org-123", wantPerm: p.OU,
+		},
 		{
 			name:   "GET /users/{id} prefix",
 			method: http.MethodGet, path: "/users/user-456", wantPerm: p.UserView,
@@ -392,6 +494,18 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 			name:   "DELETE /groups/{id} prefix",
 			method: http.MethodDelete, path: "/groups/grp-222", wantPerm: p.Group,
 		},
+		{
+			name:   "GET /applications/{id} prefix",
+			method: http.MethodGet, path: "/applications/app-1", wantPerm: p.ApplicationView,
+		},
+		{
+			name:   "PUT /applications/{id} prefix",
+			method: http.MethodPut, path: "/applications/app-1", wantPerm: p.Application,
+		},
+		{
+			name:   "DELETE /applications/{id} prefix",
+			method: http.MethodDelete, path: "/applications/app-1", wantPerm: p.Application,
+		},
 
 		// ---- Self-service wins over parent prefix ----
 		{name: "GET /users/me wins over /users/ prefix", method: http.MethodGet, path: "/users/me", wantPerm: ""},
@@ -417,7 +531,7 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 		// ---- Unmapped paths fall back to Root ----
 		{
 			name:   "Unmapped path falls back to system",
-			method: http.MethodGet, path: "/applications", wantPerm: p.Root,
+			method: http.MethodGet, path: "/flows", wantPerm: p.Root,
 		},
 		{name: "Root path falls back to system", method: http.MethodGet, path: "/", wantPerm: p.Root},
 		{
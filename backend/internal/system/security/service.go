@@ -23,7 +23,6 @@ import (
 	"context"
 	"net/http"
 	"os"
-	"regexp"
 
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -37,11 +36,12 @@ type SecurityServiceInterface interface {
 
 // securityService orchestrates authentication and authorization for HTTP requests.
 type securityService struct {
-	authenticators         []AuthenticatorInterface
-	logger                 *log.Logger
-	compiledPaths          []*regexp.Regexp
-	compiledAPIPermissions []compiledAPIPermission
-	skipSecurity           bool
+	authenticators    []AuthenticatorInterface
+	logger            *log.Logger
+	publicPathMatcher *pathMatcher
+	apiMatcher        *pathMatcher
+	apiPermissions    []string
+	skipSecurity      bool
 }
 
 // newSecurityService creates a new instance of the security service.
@@ -56,12 +56,18 @@ type securityService struct {
 //   - error: An error if any of the provided path patterns are invalid and cannot be compiled.
 func newSecurityService(authenticators []AuthenticatorInterface, publicPaths []string,
 	apiPermissions []apiPermissionEntry) (*securityService, error) {
-	compiledPaths, err := compilePathPatterns(publicPaths)
+	publicPathMatcher, err := newPathMatcher(publicPaths)
 	if err != nil {
 		return nil, err
 	}
 
-	compiledPerms, err := compileAPIPermissions(apiPermissions)
+	apiPatterns := make([]string, 0, len(apiPermissions))
+	apiPermissionsByIndex := make([]string, 0, len(apiPermissions))
+	for _, entry := range apiPermissions {
+		apiPatterns = append(apiPatterns, entry.pattern)
+		apiPermissionsByIndex = append(apiPermissionsByIndex, entry.permission)
+	}
+	apiMatcher, err := newPathMatcher(apiPatterns)
 	if err != nil {
 		return nil, err
 	}
@@ -83,11 +89,12 @@ func newSecurityService(authenticators []AuthenticatorInterface, publicPaths []s
 	}
 
 	return &securityService{
-		authenticators:         authenticators,
-		logger:                 logger,
-		compiledPaths:          compiledPaths,
-		compiledAPIPermissions: compiledPerms,
-		skipSecurity:           skipSecurity,
+		authenticators:    authenticators,
+		logger:            logger,
+		publicPathMatcher: publicPathMatcher,
+		apiMatcher:        apiMatcher,
+		apiPermissions:    apiPermissionsByIndex,
+		skipSecurity:      skipSecurity,
 	}, nil
 }
 
@@ -153,18 +160,15 @@ func (s *securityService) authorize(r *http.Request) error {
 // getRequiredPermissionForAPI returns the minimum permission required to access the
 // given HTTP method + path combination. Returns an empty string for self-service paths
 // that any authenticated user may access. Falls back to the root system permission for paths not
-// covered by any entry in compiledAPIPermissions.
+// covered by any entry in apiPermissionEntries.
 //
-// Matching uses pre-compiled regular expressions evaluated in declaration order;
-// the first matching pattern wins. More specific patterns (exact paths, named
-// sub-resources) are listed before broader wildcards in apiPermissionEntries to
-// ensure correct precedence — no manual prefix arithmetic is required.
+// Matching uses a pre-compiled path segment trie; when several patterns match, the one
+// declared first wins. More specific patterns (exact paths, named sub-resources) are
+// listed before broader wildcards in apiPermissionEntries to ensure correct precedence —
+// no manual prefix arithmetic is required.
 func (s *securityService) getRequiredPermissionForAPI(method, path string) string {
-	key := method + " " + path
-	for _, entry := range s.compiledAPIPermissions {
-		if entry.re.MatchString(key) {
-			return entry.permission
-		}
+	if index, ok := s.apiMatcher.match(method + " " + path); ok {
+		return s.apiPermissions[index]
 	}
 	if sysPerms != nil {
 		return sysPerms.Root
@@ -181,13 +185,8 @@ func (s *securityService) isPublicPath(requestPath string) bool {
 		return false
 	}
 
-	for _, regex := range s.compiledPaths {
-		if regex.MatchString(requestPath) {
-			return true
-		}
-	}
-
-	return false
+	_, ok := s.publicPathMatcher.match(requestPath)
+	return ok
 }
 
 // handleAuthError handles authentication/authorization errors based on whether
@@ -24,73 +24,176 @@ import (
 	"strings"
 )
 
-// compiledAPIPermission holds the pre-compiled regex form of a single apiPermissionEntry.
-type compiledAPIPermission struct {
-	re         *regexp.Regexp
-	permission string
-}
+// noMatch is the pattern index reported when a path does not match any pattern.
+const noMatch = -1
 
-// compilePathPattern compiles a single glob-style path pattern into a regular expression.
-// It returns an error if the pattern is invalid.
+// pathMatcher matches paths against an ordered set of glob-style path patterns. The patterns are compiled into
+// a trie of path segments, so that a path is matched by walking its segments once instead of evaluating every
+// pattern in turn. When several patterns match, the one declared first wins.
 //
 // Supported syntax:
-//   - "*"  matches exactly one path segment (no slashes).
+//   - "*"  matches exactly one path segment (no slashes). It may also be combined with other characters within
+//     a segment (e.g., "v*"), in which case it matches one or more characters within the segment.
 //   - "**" matches zero or more path segments; only valid as the suffix after "/" (e.g., "/a/**").
-func compilePathPattern(pattern string) (*regexp.Regexp, error) {
-	var regexPattern string
+type pathMatcher struct {
+	root *pathMatcherNode
+}
+
+// pathMatcherNode is a node of the path segment trie. It represents the path segments leading to it from the
+// root, and records the patterns that end at it.
+type pathMatcherNode struct {
+	// literals holds the children reached by a segment without wildcards, keyed by the segment.
+	literals map[string]*pathMatcherNode
+	// wildcard is the child reached by a "*" segment, matching any non-empty segment.
+	wildcard *pathMatcherNode
+	// globs holds the children reached by segments combining "*" with other characters.
+	globs []*globSegmentNode
+	// exactIndex is the index of the first pattern ending at this node, or noMatch.
+	exactIndex int
+	// recursiveIndex is the index of the first pattern ending at this node with the "/**" suffix, or noMatch.
+	recursiveIndex int
+}
+
+// globSegmentNode is a trie child reached by a segment combining "*" with other characters.
+type globSegmentNode struct {
+	segment string
+	re      *regexp.Regexp
+	node    *pathMatcherNode
+}
+
+// newPathMatcherNode creates an empty trie node.
+func newPathMatcherNode() *pathMatcherNode {
+	return &pathMatcherNode{exactIndex: noMatch, recursiveIndex: noMatch}
+}
+
+// newPathMatcher compiles the given glob-style path patterns into a pathMatcher.
+// It returns an error if any pattern is invalid.
+func newPathMatcher(patterns []string) (*pathMatcher, error) {
+	matcher := &pathMatcher{root: newPathMatcherNode()}
+	for index, pattern := range patterns {
+		if err := matcher.add(pattern, index); err != nil {
+			return nil, err
+		}
+	}
+	return matcher, nil
+}
 
+// add adds a pattern with the given declaration index to the trie.
+func (m *pathMatcher) add(pattern string, index int) error {
+	recursive := false
 	if strings.Contains(pattern, "**") {
 		// Ensure "**" is only used as a suffix "/**"
 		if !strings.HasSuffix(pattern, "/**") {
-			return nil,
-				fmt.Errorf("invalid pattern: recursive wildcard '**' is only allowed as a suffix: %s", pattern)
+			return fmt.Errorf("invalid pattern: recursive wildcard '**' is only allowed as a suffix: %s", pattern)
 		}
 		// Ensure "**" appears only once
 		if strings.Count(pattern, "**") > 1 {
-			return nil, fmt.Errorf("invalid pattern: recursive wildcard '**' can only appear once: %s", pattern)
+			return fmt.Errorf("invalid pattern: recursive wildcard '**' can only appear once: %s", pattern)
+		}
+		pattern = strings.TrimSuffix(pattern, "/**")
+		recursive = true
+	}
+
+	node := m.root
+	for _, segment := range strings.Split(pattern, "/") {
+		child, err := node.child(segment)
+		if err != nil {
+			return fmt.Errorf("error compiling path pattern %s: %w", pattern, err)
 		}
-		base := strings.TrimSuffix(pattern, "/**")
-		baseRegex := regexp.QuoteMeta(base)
-		baseRegex = strings.ReplaceAll(baseRegex, "\\*", "[^/]+")
-		regexPattern = "^" + baseRegex + "(?:/.*)?$"
-	} else {
-		// Normal pattern (no recursive wildcards)
-		regexPattern = regexp.QuoteMeta(pattern)
-		regexPattern = strings.ReplaceAll(regexPattern, "\\*", "[^/]+")
-		regexPattern = "^" + regexPattern + "$"
+		node = child
 	}
 
-	re, err := regexp.Compile(regexPattern)
-	if err != nil {
-		return nil, fmt.Errorf("error compiling path pattern regex for pattern %s: %w", pattern, err)
+	if recursive {
+		if node.recursiveIndex == noMatch {
+			node.recursiveIndex = index
+		}
+	} else if node.exactIndex == noMatch {
+		node.exactIndex = index
 	}
-	return re, nil
+	return nil
 }
 
-// compilePathPatterns compiles a slice of glob-style path patterns into regular expressions.
-// It returns an error if any pattern is invalid.
-func compilePathPatterns(patterns []string) ([]*regexp.Regexp, error) {
-	compiled := make([]*regexp.Regexp, 0, len(patterns))
-	for _, pattern := range patterns {
-		re, err := compilePathPattern(pattern)
+// child returns the child of the node for the given pattern segment, creating it if it does not exist.
+func (n *pathMatcherNode) child(segment string) (*pathMatcherNode, error) {
+	switch {
+	case segment == "*":
+		if n.wildcard == nil {
+			n.wildcard = newPathMatcherNode()
+		}
+		return n.wildcard, nil
+	case strings.Contains(segment, "*"):
+		for _, glob := range n.globs {
+			if glob.segment == segment {
+				return glob.node, nil
+			}
+		}
+		re, err := regexp.Compile("^" + strings.ReplaceAll(regexp.QuoteMeta(segment), "\\*", "[^/]+") + "$")
 		if err != nil {
 			return nil, err
 		}
-		compiled = append(compiled, re)
+		glob := &globSegmentNode{segment: segment, re: re, node: newPathMatcherNode()}
+		n.globs = append(n.globs, glob)
+		return glob.node, nil
+	default:
+		if n.literals == nil {
+			n.literals = make(map[string]*pathMatcherNode)
+		}
+		child, ok := n.literals[segment]
+		if !ok {
+			child = newPathMatcherNode()
+			n.literals[segment] = child
+		}
+		return child, nil
 	}
-	return compiled, nil
 }
 
-// compileAPIPermissions compiles a slice of apiPermissionEntry values into their regex form.
-// It returns an error if any pattern is invalid.
-func compileAPIPermissions(entries []apiPermissionEntry) ([]compiledAPIPermission, error) {
-	compiled := make([]compiledAPIPermission, 0, len(entries))
-	for _, entry := range entries {
-		re, err := compilePathPattern(entry.pattern)
-		if err != nil {
-			return nil, err
+// match returns the index of the first declared pattern matching the given path, or false if no pattern
+// matches it.
+func (m *pathMatcher) match(path string) (int, bool) {
+	index := m.root.match(path, noMatch)
+	return index, index != noMatch
+}
+
+// match returns the lowest index of the patterns matching the given remainder of the path below this node, or
+// best if it is lower. The remainder starts with the next segment to match.
+func (n *pathMatcherNode) match(rest string, best int) int {
+	// A "/**" suffix matches when nothing remains or the remainder is a sub path. Like the "." of a regular
+	// expression, the sub path must not contain a newline.
+	if n.recursiveIndex != noMatch && isLowerIndex(n.recursiveIndex, best) && !strings.Contains(rest, "\n") {
+		best = n.recursiveIndex
+	}
+
+	segment, remainder, hasMore := strings.Cut(rest, "/")
+	if child, ok := n.literals[segment]; ok {
+		best = child.matchRemainder(remainder, hasMore, best)
+	}
+	if n.wildcard != nil && segment != "" {
+		best = n.wildcard.matchRemainder(remainder, hasMore, best)
+	}
+	for _, glob := range n.globs {
+		if glob.re.MatchString(segment) {
+			best = glob.node.matchRemainder(remainder, hasMore, best)
+		}
+	}
+	return best
+}
+
+// matchRemainder matches the remainder of the path after the segment that led to this node. When no segments
+// remain, the node matches if a pattern ends at it.
+func (n *pathMatcherNode) matchRemainder(remainder string, hasMore bool, best int) int {
+	if !hasMore {
+		if n.exactIndex != noMatch && isLowerIndex(n.exactIndex, best) {
+			best = n.exactIndex
+		}
+		if n.recursiveIndex != noMatch && isLowerIndex(n.recursiveIndex, best) {
+			best = n.recursiveIndex
 		}
-		compiled = append(compiled, compiledAPIPermission{re: re, permission: entry.permission})
+		return best
 	}
-	return compiled, nil
+	return n.match(remainder, best)
+}
+
+// isLowerIndex reports whether index was declared before best.
+func isLowerIndex(index, best int) bool {
+	return best == noMatch || index < best
 }
@@ -19,115 +19,119 @@
 package security
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
-// TestCompilePathPattern verifies that individual glob-style patterns are compiled
-// to the correct anchored regular expression, and that invalid patterns are rejected.
-func TestCompilePathPattern(t *testing.T) {
+// TestPathMatcher_Pattern verifies that individual glob-style patterns match the expected paths,
+// and that invalid patterns are rejected.
+func TestPathMatcher_Pattern(t *testing.T) {
 	tests := []struct {
 		name           string
 		pattern        string
-		expectedRegex  string
+		invalid        bool
 		shouldMatch    []string
 		shouldNotMatch []string
 	}{
 		{
 			name:           "Exact path",
 			pattern:        "/users/me",
-			expectedRegex:  "^/users/me$",
 			shouldMatch:    []string{"/users/me"},
 			shouldNotMatch: []string{"/users/menu", "/users/me/profile", "/users"},
 		},
 		{
 			name:           "Single wildcard segment",
 			pattern:        "/api/*/users",
-			expectedRegex:  "^/api/[^/]+/users$",
 			shouldMatch:    []string{"/api/v1/users", "/api/test/users"},
-			shouldNotMatch: []string{"/api/users", "/api/v1/v2/users"},
+			shouldNotMatch: []string{"/api/users", "/api/v1/v2/users", "/api//users"},
 		},
 		{
 			name:           "Recursive wildcard suffix",
 			pattern:        "/health/**",
-			expectedRegex:  "^/health(?:/.*)?$",
 			shouldMatch:    []string{"/health", "/health/", "/health/liveness", "/health/readiness/full"},
-			shouldNotMatch: []string{"/healthz", "/other"},
+			shouldNotMatch: []string{"/healthz", "/other", "/health/live\nness"},
 		},
 		{
 			name:           "Multiple single wildcards",
 			pattern:        "/i18n/languages/*/translations/ns/*/keys/*/resolve",
-			expectedRegex:  "^/i18n/languages/[^/]+/translations/ns/[^/]+/keys/[^/]+/resolve$",
 			shouldMatch:    []string{"/i18n/languages/en/translations/ns/common/keys/btn.submit/resolve"},
 			shouldNotMatch: []string{"/i18n/languages/en/translations/ns/common/keys/btn.submit/extra"},
 		},
 		{
-			name:           "Special characters escaped",
+			name:           "Wildcard within a segment",
+			pattern:        "/api/v*/users",
+			shouldMatch:    []string{"/api/v1/users", "/api/v1.0/users"},
+			shouldNotMatch: []string{"/api/v/users", "/api/x1/users", "/api/v1/v2/users"},
+		},
+		{
+			name:           "Special characters are literal",
 			pattern:        "/api/v1.0/user",
-			expectedRegex:  "^/api/v1\\.0/user$",
 			shouldMatch:    []string{"/api/v1.0/user"},
 			shouldNotMatch: []string{"/api/v1a0/user"},
 		},
 		{
-			name:           "Invalid: globstar in middle",
-			pattern:        "/api/**/users",
-			expectedRegex:  "",
-			shouldMatch:    nil,
-			shouldNotMatch: nil,
+			name:           "Method prefixed pattern",
+			pattern:        "GET /users/*/groups/**",
+			shouldMatch:    []string{"GET /users/u1/groups", "GET /users/u1/groups/g1/members"},
+			shouldNotMatch: []string{"POST /users/u1/groups", "GET /users/groups"},
 		},
 		{
-			name:           "Invalid: multiple globstars",
-			pattern:        "/api/**/users/**",
-			expectedRegex:  "",
-			shouldMatch:    nil,
-			shouldNotMatch: nil,
+			name:    "Invalid: globstar in middle",
+			pattern: "/api/**/users",
+			invalid: true,
+		},
+		{
+			name:    "Invalid: multiple globstars",
+			pattern: "/api/**/users/**",
+			invalid: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			re, err := compilePathPattern(tt.pattern)
+			matcher, err := newPathMatcher([]string{tt.pattern})
 
-			if tt.expectedRegex == "" {
+			if tt.invalid {
 				assert.Error(t, err)
-				assert.Nil(t, re)
+				assert.Nil(t, matcher)
 				assert.Contains(t, err.Error(), "invalid pattern")
-			} else {
-				assert.NoError(t, err)
-				assert.NotNil(t, re)
-				assert.Equal(t, tt.expectedRegex, re.String())
+				return
+			}
 
-				for _, matchPath := range tt.shouldMatch {
-					assert.True(t, re.MatchString(matchPath), "Should match: %s", matchPath)
-				}
-				for _, mismatchPath := range tt.shouldNotMatch {
-					assert.False(t, re.MatchString(mismatchPath), "Should not match: %s", mismatchPath)
-				}
+			assert.NoError(t, err)
+			assert.NotNil(t, matcher)
+			for _, matchPath := range tt.shouldMatch {
+				_, ok := matcher.match(matchPath)
+				assert.True(t, ok, "Should match: %s", matchPath)
+			}
+			for _, mismatchPath := range tt.shouldNotMatch {
+				_, ok := matcher.match(mismatchPath)
+				assert.False(t, ok, "Should not match: %s", mismatchPath)
 			}
 		})
 	}
 }
 
-// TestCompilePathPatterns verifies the batch wrapper: it returns the correct
-// count of compiled patterns and stops at the first invalid entry.
-func TestCompilePathPatterns(t *testing.T) {
+// TestNewPathMatcher verifies that the matcher is built from valid patterns and that
+// building stops at the first invalid entry.
+func TestNewPathMatcher(t *testing.T) {
 	tests := []struct {
 		name        string
 		patterns    []string
-		wantLen     int
 		wantError   bool
 		errContains string
 	}{
 		{
 			name:     "Empty slice",
 			patterns: []string{},
-			wantLen:  0,
 		},
 		{
 			name:     "All valid patterns",
 			patterns: []string{"/health/**", "/api/*/resource", "/exact"},
-			wantLen:  3,
 		},
 		{
 			name:        "First pattern invalid",
@@ -145,80 +149,177 @@ func TestCompilePathPatterns(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			compiled, err := compilePathPatterns(tt.patterns)
+			matcher, err := newPathMatcher(tt.patterns)
 			if tt.wantError {
 				assert.Error(t, err)
-				assert.Nil(t, compiled)
+				assert.Nil(t, matcher)
 				assert.Contains(t, err.Error(), tt.errContains)
 			} else {
 				assert.NoError(t, err)
-				assert.Len(t, compiled, tt.wantLen)
+				assert.NotNil(t, matcher)
 			}
 		})
 	}
 }
 
-// TestCompileAPIPermissions verifies that API permission entries are compiled
-// to regex form correctly, and that invalid patterns are rejected.
-func TestCompileAPIPermissions(t *testing.T) {
+// TestPathMatcher_FirstDeclaredPatternWins verifies that when several patterns match a path,
+// the index of the one declared first is returned regardless of how specific it is.
+func TestPathMatcher_FirstDeclaredPatternWins(t *testing.T) {
+	matcher, err := newPathMatcher([]string{
+		"GET /users/me",
+		"GET /users/me/**",
+		"GET /users/*",
+		"GET /users/**",
+		"GET /users/*/groups",
+	})
+	assert.NoError(t, err)
+
 	tests := []struct {
-		name        string
-		entries     []apiPermissionEntry
-		wantLen     int
-		wantError   bool
-		errContains string
+		path      string
+		wantIndex int
 	}{
-		{
-			name:    "Empty slice",
-			entries: []apiPermissionEntry{},
-			wantLen: 0,
-		},
-		{
-			name: "Valid entries compiled",
-			entries: []apiPermissionEntry{
-				{"GET /users", "system:user:view"},
-				{"GET /users/**", "system:user:view"},
-				{"POST /users", "system:user"},
-			},
-			wantLen: 3,
-		},
-		{
-			name: "Single wildcard entry",
-			entries: []apiPermissionEntry{
-				{"GET /users/*/profile", "system:user:view"},
-			},
-			wantLen: 1,
-		},
-		{
-			name: "Invalid pattern stops compilation",
-			entries: []apiPermissionEntry{
-				{"GET /valid/**", "system:user:view"},
-				{"GET /invalid/**/middle/**", "system:user"},
-			},
-			wantError:   true,
-			errContains: "invalid pattern",
-		},
-		{
-			name: "Invalid pattern as first entry",
-			entries: []apiPermissionEntry{
-				{"GET /invalid/**/middle/**", "system:user"},
-				{"GET /valid/**", "system:user:view"},
-			},
-			wantError:   true,
-			errContains: "invalid pattern",
-		},
+		{"GET /users/me", 0},
+		{"GET /users/me/groups", 1},
+		{"GET /users/u1", 2},
+		{"GET /users", 3},
+		{"GET /users/u1/groups", 3},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			compiled, err := compileAPIPermissions(tt.entries)
-			if tt.wantError {
-				assert.Error(t, err)
-				assert.Nil(t, compiled)
-				assert.Contains(t, err.Error(), tt.errContains)
-			} else {
-				assert.NoError(t, err)
-				assert.Len(t, compiled, tt.wantLen)
+		index, ok := matcher.match(tt.path)
+		assert.True(t, ok, "Should match: %s", tt.path)
+		assert.Equal(t, tt.wantIndex, index, "Unexpected pattern for: %s", tt.path)
+	}
+
+	_, ok := matcher.match("POST /users")
+	assert.False(t, ok)
+}
+
+// TestPathMatcher_MatchesRegexSemantics verifies that the matcher returns the same pattern as
+// evaluating the equivalent regular expressions in declaration order, for the configured
+// public paths and API permission entries.
+func TestPathMatcher_MatchesRegexSemantics(t *testing.T) {
+	InitSystemPermissions("")
+
+	apiPatterns := make([]string, 0, len(apiPermissionEntries))
+	for _, entry := range apiPermissionEntries {
+		apiPatterns = append(apiPatterns, entry.pattern)
+	}
+
+	requestPaths := []string{
+		"", "/", "/users", "/users/", "/users/me", "/users/me/", "/users/me/groups", "/users/u1",
+		"/users/u1/groups", "/users//me", "/organization-units/tree", "/organization-units/ou-1/users",
+		"/groups/g1/members/add", "/health", "/health/liveness", "/healthz", "/oauth2/token",
+		"/i18n/languages/en/translations/resolve", "/i18n/languages//translations/resolve",
+		"/i18n/languages/en/translations/ns/common/keys/title/resolve", "/design/assets/a1/content",
+		"/design/assets/a1/a2/content", "/.well-known/openid-configuration", "/jobs/user-erasure/run",
+		"/erasure-requests/e1", "/flow/meta/extra", "/auth/credentials/authenticate", "/unknown/path",
+	}
+
+	for _, patterns := range [][]string{publicPaths, apiPatterns} {
+		matcher, err := newPathMatcher(patterns)
+		assert.NoError(t, err)
+		regexes := compileRegexPatterns(t, patterns)
+
+		for _, method := range []string{"", "GET ", "POST ", "PUT ", "DELETE "} {
+			for _, requestPath := range requestPaths {
+				key := method + requestPath
+				wantIndex := noMatch
+				for index, re := range regexes {
+					if re.MatchString(key) {
+						wantIndex = index
+						break
+					}
+				}
+
+				index, ok := matcher.match(key)
+				if !ok {
+					index = noMatch
+				}
+				assert.Equal(t, wantIndex, index, "Unexpected pattern for: %q", key)
+			}
+		}
+	}
+}
+
+// compileRegexPatterns compiles glob-style path patterns into the anchored regular expressions
+// previously used for path matching. It serves as the reference for the matcher semantics.
+func compileRegexPatterns(tb testing.TB, patterns []string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		var regexPattern string
+		if strings.HasSuffix(pattern, "/**") {
+			base := regexp.QuoteMeta(strings.TrimSuffix(pattern, "/**"))
+			regexPattern = "^" + strings.ReplaceAll(base, "\\*", "[^/]+") + "(?:/.*)?$"
+		} else {
+			regexPattern = "^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), "\\*", "[^/]+") + "$"
+		}
+		re, err := regexp.Compile(regexPattern)
+		if err != nil {
+			tb.Fatalf("failed to compile pattern %s: %v", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled
+}
+
+// benchmarkAPIPatterns returns a set of API permission patterns resembling apiPermissionEntries,
+// with the given number of resource collections.
+func benchmarkAPIPatterns(resources int) []string {
+	patterns := make([]string, 0, resources*5)
+	for i := 0; i < resources; i++ {
+		collection := fmt.Sprintf("/resources-%d", i)
+		patterns = append(patterns,
+			"GET "+collection,
+			"POST "+collection,
+			"GET "+collection+"/**",
+			"PUT "+collection+"/**",
+			"DELETE "+collection+"/**",
+		)
+	}
+	return patterns
+}
+
+// BenchmarkPathMatcher_Match measures matching a request against the path segment trie.
+func BenchmarkPathMatcher_Match(b *testing.B) {
+	for _, resources := range []int{10, 100} {
+		patterns := benchmarkAPIPatterns(resources)
+		key := fmt.Sprintf("DELETE /resources-%d/id-1/members/id-2", resources-1)
+		b.Run(fmt.Sprintf("Patterns-%d", len(patterns)), func(b *testing.B) {
+			matcher, err := newPathMatcher(patterns)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, ok := matcher.match(key); !ok {
+					b.Fatal("expected a match")
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkRegexPatterns_Match measures matching the same requests by evaluating the equivalent
+// regular expressions in declaration order, as a baseline for BenchmarkPathMatcher_Match.
+func BenchmarkRegexPatterns_Match(b *testing.B) {
+	for _, resources := range []int{10, 100} {
+		patterns := benchmarkAPIPatterns(resources)
+		key := fmt.Sprintf("DELETE /resources-%d/id-1/members/id-2", resources-1)
+		b.Run(fmt.Sprintf("Patterns-%d", len(patterns)), func(b *testing.B) {
+			regexes := compileRegexPatterns(b, patterns)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				matched := false
+				for _, re := range regexes {
+					if re.MatchString(key) {
+						matched = true
+						break
+					}
+				}
+				if !matched {
+					b.Fatal("expected a match")
+				}
 			}
 		})
 	}
@@ -21,6 +21,7 @@ package sysauthz
 import (
 	"context"
 
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/security"
 )
@@ -99,6 +100,13 @@ func (p *ouMembershipPolicy) getAccessibleResources(ctx context.Context, action
 	return true, &AccessibleResources{AllAllowed: false, IDs: []string{ouID}}, nil
 }
 
+// Request cache key prefixes of the organization unit hierarchy lookups made by the policies. List endpoints
+// and the checks on their results resolve the hierarchy of the same caller OU several times within a request.
+const (
+	isAncestorRequestCacheKeyPrefix    = "sysauthz:is-ancestor:"
+	ancestorOUIDsRequestCacheKeyPrefix = "sysauthz:ancestor-ou-ids:"
+)
+
 // ouInheritancePolicy grants read-only access to resources whose OU is an ancestor of
 // (or the same as) the caller's OU. This enables child OUs to see resources defined in
 // parent OUs without being able to modify them.
@@ -125,7 +133,7 @@ func (p *ouInheritancePolicy) isActionAllowed(ctx context.Context,
 	}
 	// Allow if the resource's OU is an ancestor of the caller's OU.
 	// i.e. the caller belongs to one of its descendants.
-	isAncestor, svcErr := p.resolver.IsAncestor(ctx, actionCtx.OUID, callerOUID)
+	isAncestor, svcErr := p.isAncestor(ctx, actionCtx.OUID, callerOUID)
 	if svcErr != nil {
 		return policyDecisionDenied, svcErr
 	}
@@ -146,7 +154,7 @@ func (p *ouInheritancePolicy) getAccessibleResources(ctx context.Context, action
 	if callerOUID == "" {
 		return true, &AccessibleResources{AllAllowed: false, IDs: []string{}}, nil
 	}
-	ancestorIDs, svcErr := p.resolver.GetAncestorOUIDs(ctx, callerOUID)
+	ancestorIDs, svcErr := p.getAncestorOUIDs(ctx, callerOUID)
 	if svcErr != nil {
 		return true, nil, svcErr
 	}
@@ -157,6 +165,44 @@ func (p *ouInheritancePolicy) getAccessibleResources(ctx context.Context, action
 	return true, &AccessibleResources{AllAllowed: false, IDs: resultIDs}, nil
 }
 
+// isAncestor resolves whether ancestorOUID is an ancestor of descendantOUID, reusing the result resolved
+// earlier in the same request if any. Failed lookups are not cached.
+func (p *ouInheritancePolicy) isAncestor(ctx context.Context,
+	ancestorOUID, descendantOUID string) (bool, *serviceerror.ServiceError) {
+	key := isAncestorRequestCacheKeyPrefix + ancestorOUID + ":" + descendantOUID
+	if cached, ok := sysContext.GetRequestCacheValue(ctx, key); ok {
+		if isAncestor, ok := cached.(bool); ok {
+			return isAncestor, nil
+		}
+	}
+
+	isAncestor, svcErr := p.resolver.IsAncestor(ctx, ancestorOUID, descendantOUID)
+	if svcErr != nil {
+		return false, svcErr
+	}
+	sysContext.SetRequestCacheValue(ctx, key, isAncestor)
+	return isAncestor, nil
+}
+
+// getAncestorOUIDs resolves the ancestor OU IDs of the given OU, reusing the result resolved earlier in the
+// same request if any. Failed lookups are not cached.
+func (p *ouInheritancePolicy) getAncestorOUIDs(ctx context.Context,
+	ouID string) ([]string, *serviceerror.ServiceError) {
+	key := ancestorOUIDsRequestCacheKeyPrefix + ouID
+	if cached, ok := sysContext.GetRequestCacheValue(ctx, key); ok {
+		if ancestorIDs, ok := cached.([]string); ok {
+			return ancestorIDs, nil
+		}
+	}
+
+	ancestorIDs, svcErr := p.resolver.GetAncestorOUIDs(ctx, ouID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	sysContext.SetRequestCacheValue(ctx, key, ancestorIDs)
+	return ancestorIDs, nil
+}
+
 // inheritanceReadActions is the set of read-only actions that use OU-inheritance semantics.
 // An action listed here gives callers in child OUs visibility into resources defined in
 // parent OUs. Write actions must NOT be listed here — child OUs must never be able to
@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -61,17 +62,23 @@ type stubOUHierarchyResolver struct {
 	// GetAncestorOUIDs response fields.
 	ancestorIDs    []string
 	ancestorIDsErr *serviceerror.ServiceError
+
+	// Number of calls made to each method.
+	isAncestorCalls  int
+	ancestorIDsCalls int
 }
 
 func (r *stubOUHierarchyResolver) IsAncestor(
 	_ context.Context, _, _ string,
 ) (bool, *serviceerror.ServiceError) {
+	r.isAncestorCalls++
 	return r.isAncestorResult, r.isAncestorErr
 }
 
 func (r *stubOUHierarchyResolver) GetAncestorOUIDs(
 	_ context.Context, _ string,
 ) ([]string, *serviceerror.ServiceError) {
+	r.ancestorIDsCalls++
 	return r.ancestorIDs, r.ancestorIDsErr
 }
 
@@ -486,6 +493,53 @@ func TestOuInheritancePolicy_GetAccessibleResources(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// ouInheritancePolicy request cache
+// ---------------------------------------------------------------------------
+
+func TestOuInheritancePolicy_ReusesLookupsWithinRequest(t *testing.T) {
+	resolver := &stubOUHierarchyResolver{isAncestorResult: true, ancestorIDs: []string{"parent-ou"}}
+	policy := &ouInheritancePolicy{resolver: resolver}
+	ctx := sysContext.WithRequestCache(buildCtxWithOU("", "child-ou"))
+	actionCtx := &ActionContext{OUID: "parent-ou", ResourceType: security.ResourceTypeUserType}
+
+	for i := 0; i < 3; i++ {
+		decision, err := policy.isActionAllowed(ctx, actionCtx)
+		assert.Nil(t, err)
+		assert.Equal(t, policyDecisionAllowed, decision)
+
+		_, result, err := policy.getAccessibleResources(ctx, security.ActionListUserTypes,
+			security.ResourceTypeUserType)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"child-ou", "parent-ou"}, result.IDs)
+	}
+
+	assert.Equal(t, 1, resolver.isAncestorCalls)
+	assert.Equal(t, 1, resolver.ancestorIDsCalls)
+}
+
+func TestOuInheritancePolicy_DoesNotCacheFailedLookups(t *testing.T) {
+	errSvc := &serviceerror.ServiceError{
+		Code:  "ERR-400",
+		Error: i18ncore.I18nMessage{DefaultValue: "ancestor lookup error"},
+	}
+	resolver := &stubOUHierarchyResolver{isAncestorErr: errSvc, ancestorIDsErr: errSvc}
+	policy := &ouInheritancePolicy{resolver: resolver}
+	ctx := sysContext.WithRequestCache(buildCtxWithOU("", "child-ou"))
+	actionCtx := &ActionContext{OUID: "parent-ou", ResourceType: security.ResourceTypeUserType}
+
+	for i := 0; i < 2; i++ {
+		_, err := policy.isActionAllowed(ctx, actionCtx)
+		assert.NotNil(t, err)
+
+		_, _, err = policy.getAccessibleResources(ctx, security.ActionListUserTypes, security.ResourceTypeUserType)
+		assert.NotNil(t, err)
+	}
+
+	assert.Equal(t, 2, resolver.isAncestorCalls)
+	assert.Equal(t, 2, resolver.ancestorIDsCalls)
+}
+
 // ---------------------------------------------------------------------------
 // isInheritanceEligible + selectPolicies
 // ---------------------------------------------------------------------------
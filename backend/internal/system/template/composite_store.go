@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"context"
+	"errors"
+
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+)
+
+// compositeTemplateStore combines the file-based store holding the declarative system templates with the
+// database store holding custom templates.
+// - Read operations check the database store first and fall back to the file-based store
+// - Write operations (Create/Update/Delete) only affect the database store
+// - Declarative templates (from YAML files) cannot be modified or deleted
+type compositeTemplateStore struct {
+	fileStore templateStoreInterface
+	dbStore   templateStoreInterface
+}
+
+// newCompositeTemplateStore creates a new composite store with both file-based and database stores.
+func newCompositeTemplateStore(fileStore, dbStore templateStoreInterface) *compositeTemplateStore {
+	return &compositeTemplateStore{
+		fileStore: fileStore,
+		dbStore:   dbStore,
+	}
+}
+
+// GetTemplate retrieves a template by ID from either store.
+func (c *compositeTemplateStore) GetTemplate(ctx context.Context, id string) (*TemplateDTO, error) {
+	return declarativeresource.CompositeGetHelper(
+		func() (*TemplateDTO, error) { return c.dbStore.GetTemplate(ctx, id) },
+		func() (*TemplateDTO, error) { return c.fileStore.GetTemplate(ctx, id) },
+		errTemplateNotFound,
+	)
+}
+
+// GetTemplateByScenario retrieves the template for a scenario and template type. A custom template in the
+// database store takes precedence over the declarative system template.
+func (c *compositeTemplateStore) GetTemplateByScenario(
+	ctx context.Context, scenario ScenarioType, tmplType TemplateType,
+) (*TemplateDTO, error) {
+	return declarativeresource.CompositeGetHelper(
+		func() (*TemplateDTO, error) { return c.dbStore.GetTemplateByScenario(ctx, scenario, tmplType) },
+		func() (*TemplateDTO, error) { return c.fileStore.GetTemplateByScenario(ctx, scenario, tmplType) },
+		errTemplateNotFound,
+	)
+}
+
+// GetTemplateByScenarioAndOU retrieves the template overriding a scenario and template type for an
+// organization unit. Only custom templates can be scoped to an organization unit.
+func (c *compositeTemplateStore) GetTemplateByScenarioAndOU(
+	ctx context.Context, scenario ScenarioType, tmplType TemplateType, ouID string,
+) (*TemplateDTO, error) {
+	return c.dbStore.GetTemplateByScenarioAndOU(ctx, scenario, tmplType, ouID)
+}
+
+// ListTemplates retrieves templates from both stores and merges the results.
+func (c *compositeTemplateStore) ListTemplates(ctx context.Context) ([]*TemplateDTO, error) {
+	dbTemplates, err := c.dbStore.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fileTemplates, err := c.fileStore.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeAndDeduplicateTemplates(dbTemplates, fileTemplates), nil
+}
+
+// CreateTemplate creates a new template in the database store only.
+func (c *compositeTemplateStore) CreateTemplate(ctx context.Context, tmpl TemplateDTO) error {
+	return c.dbStore.CreateTemplate(ctx, tmpl)
+}
+
+// UpdateTemplate updates a template in the database store only.
+// Returns an error if the template is declarative (exists in file store).
+func (c *compositeTemplateStore) UpdateTemplate(ctx context.Context, tmpl TemplateDTO) error {
+	return declarativeresource.CompositeUpdateHelper(
+		&tmpl,
+		func(dto *TemplateDTO) string { return dto.ID },
+		c.existsInFileStore(ctx),
+		func(dto *TemplateDTO) error {
+			return c.dbStore.UpdateTemplate(ctx, *dto)
+		},
+		errTemplateIsImmutable,
+	)
+}
+
+// DeleteTemplate deletes a template from the database store only.
+// Returns an error if the template is declarative (exists in file store).
+func (c *compositeTemplateStore) DeleteTemplate(ctx context.Context, id string) error {
+	return declarativeresource.CompositeDeleteHelper(
+		id,
+		c.existsInFileStore(ctx),
+		func(id string) error {
+			return c.dbStore.DeleteTemplate(ctx, id)
+		},
+		errTemplateIsImmutable,
+	)
+}
+
+// existsInFileStore returns a function reporting whether a template ID belongs to the file store.
+func (c *compositeTemplateStore) existsInFileStore(ctx context.Context) func(string) (bool, error) {
+	return func(id string) (bool, error) {
+		_, err := c.fileStore.GetTemplate(ctx, id)
+		if err == nil {
+			return true, nil
+		}
+		if errors.Is(err, errTemplateNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+}
+
+// mergeAndDeduplicateTemplates merges templates from both stores and removes duplicates by ID.
+// Database templates are marked as mutable, file-based templates as immutable.
+func mergeAndDeduplicateTemplates(dbTemplates, fileTemplates []*TemplateDTO) []*TemplateDTO {
+	seen := make(map[string]bool)
+	result := make([]*TemplateDTO, 0, len(dbTemplates)+len(fileTemplates))
+
+	for _, tmpl := range dbTemplates {
+		if !seen[tmpl.ID] {
+			seen[tmpl.ID] = true
+			tmpl.IsReadOnly = false
+			result = append(result, tmpl)
+		}
+	}
+
+	for _, tmpl := range fileTemplates {
+		if !seen[tmpl.ID] {
+			seen[tmpl.ID] = true
+			tmpl.IsReadOnly = true
+			result = append(result, tmpl)
+		}
+	}
+
+	return result
+}
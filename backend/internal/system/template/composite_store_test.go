@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CompositeTemplateStoreTestSuite struct {
+	suite.Suite
+	fileStore *templateStoreInterfaceMock
+	dbStore   *templateStoreInterfaceMock
+	store     *compositeTemplateStore
+}
+
+func TestCompositeTemplateStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(CompositeTemplateStoreTestSuite))
+}
+
+func (suite *CompositeTemplateStoreTestSuite) SetupTest() {
+	suite.fileStore = newTemplateStoreInterfaceMock(suite.T())
+	suite.dbStore = newTemplateStoreInterfaceMock(suite.T())
+	suite.store = newCompositeTemplateStore(suite.fileStore, suite.dbStore)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestGetTemplateByScenario_PrefersDatabase() {
+	suite.dbStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).
+		Return(&TemplateDTO{ID: "custom"}, nil)
+
+	tmpl, err := suite.store.GetTemplateByScenario(context.Background(), ScenarioOTP, TemplateTypeSMS)
+	suite.NoError(err)
+	suite.Equal("custom", tmpl.ID)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestGetTemplateByScenario_FallsBackToFile() {
+	suite.dbStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).
+		Return(nil, errTemplateNotFound)
+	suite.fileStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).
+		Return(&TemplateDTO{ID: "sms-otp", IsReadOnly: true}, nil)
+
+	tmpl, err := suite.store.GetTemplateByScenario(context.Background(), ScenarioOTP, TemplateTypeSMS)
+	suite.NoError(err)
+	suite.Equal("sms-otp", tmpl.ID)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestGetTemplate_DatabaseError() {
+	suite.dbStore.On("GetTemplate", mock.Anything, "id").Return(nil, errors.New("db error"))
+
+	tmpl, err := suite.store.GetTemplate(context.Background(), "id")
+	suite.Nil(tmpl)
+	suite.EqualError(err, "db error")
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestGetTemplateByScenarioAndOU_UsesDatabaseOnly() {
+	suite.dbStore.On("GetTemplateByScenarioAndOU", mock.Anything, ScenarioOTP, TemplateTypeSMS, "ou-1").
+		Return(nil, errTemplateNotFound)
+
+	tmpl, err := suite.store.GetTemplateByScenarioAndOU(context.Background(), ScenarioOTP, TemplateTypeSMS, "ou-1")
+	suite.Nil(tmpl)
+	suite.ErrorIs(err, errTemplateNotFound)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestListTemplates_MergesAndMarksReadOnly() {
+	suite.dbStore.On("ListTemplates", mock.Anything).Return([]*TemplateDTO{{ID: "custom"}, {ID: "dup"}}, nil)
+	suite.fileStore.On("ListTemplates", mock.Anything).Return([]*TemplateDTO{{ID: "dup"}, {ID: "sms-otp"}}, nil)
+
+	templates, err := suite.store.ListTemplates(context.Background())
+	suite.NoError(err)
+	suite.Len(templates, 3)
+	suite.False(templates[0].IsReadOnly)
+	suite.False(templates[1].IsReadOnly)
+	suite.Equal("sms-otp", templates[2].ID)
+	suite.True(templates[2].IsReadOnly)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestListTemplates_DatabaseError() {
+	suite.dbStore.On("ListTemplates", mock.Anything).Return(nil, errors.New("db error"))
+
+	templates, err := suite.store.ListTemplates(context.Background())
+	suite.Nil(templates)
+	suite.Error(err)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestCreateTemplate() {
+	suite.dbStore.On("CreateTemplate", mock.Anything, mock.Anything).Return(nil)
+
+	err := suite.store.CreateTemplate(context.Background(), TemplateDTO{ID: "custom"})
+	suite.NoError(err)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestUpdateTemplate_DeclarativeTemplateIsImmutable() {
+	suite.fileStore.On("GetTemplate", mock.Anything, "sms-otp").Return(&TemplateDTO{ID: "sms-otp"}, nil)
+
+	err := suite.store.UpdateTemplate(context.Background(), TemplateDTO{ID: "sms-otp"})
+	suite.ErrorIs(err, errTemplateIsImmutable)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestUpdateTemplate() {
+	suite.fileStore.On("GetTemplate", mock.Anything, "custom").Return(nil, errTemplateNotFound)
+	suite.dbStore.On("UpdateTemplate", mock.Anything, TemplateDTO{ID: "custom"}).Return(nil)
+
+	err := suite.store.UpdateTemplate(context.Background(), TemplateDTO{ID: "custom"})
+	suite.NoError(err)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestDeleteTemplate_DeclarativeTemplateIsImmutable() {
+	suite.fileStore.On("GetTemplate", mock.Anything, "sms-otp").Return(&TemplateDTO{ID: "sms-otp"}, nil)
+
+	err := suite.store.DeleteTemplate(context.Background(), "sms-otp")
+	suite.ErrorIs(err, errTemplateIsImmutable)
+}
+
+func (suite *CompositeTemplateStoreTestSuite) TestDeleteTemplate() {
+	suite.fileStore.On("GetTemplate", mock.Anything, "custom").Return(nil, errTemplateNotFound)
+	suite.dbStore.On("DeleteTemplate", mock.Anything, "custom").Return(nil)
+
+	err := suite.store.DeleteTemplate(context.Background(), "custom")
+	suite.NoError(err)
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+const (
+	// contentTypeText is the content type of plain text templates.
+	contentTypeText = "text/plain"
+	// contentTypeHTML is the content type of HTML templates.
+	contentTypeHTML = "text/html"
+)
+
+// Translation keys under which the localized content of a custom template is stored.
+const (
+	i18nKeySubject  = "subject"
+	i18nKeyBody     = "body"
+	i18nKeyTextBody = "textBody"
+)
+
+// templateI18nNamespacePrefix is prefixed to the template ID to form the i18n namespace that holds the
+// localized variants of a custom template.
+const templateI18nNamespacePrefix = "template-"
+
+// templateI18nNamespace returns the i18n namespace holding the localized variants of a template.
+func templateI18nNamespace(templateID string) string {
+	return templateI18nNamespacePrefix + templateID
+}
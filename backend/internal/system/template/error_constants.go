@@ -29,6 +29,8 @@ import (
 var (
 	// errTemplateNotFound indicates the requested template was not found.
 	errTemplateNotFound = errors.New("template not found")
+	// errTemplateIsImmutable indicates an attempt to modify a declarative (file-based) template.
+	errTemplateIsImmutable = errors.New("template is immutable")
 )
 
 // Client errors for template operations.
@@ -46,4 +48,158 @@ var (
 			DefaultValue: "The requested template does not exist for the given scenario",
 		},
 	}
+	// ErrorInvalidRequestFormat is returned when the request body cannot be parsed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+
+	// ErrorInvalidTemplateID is returned when the template ID is missing or malformed.
+	ErrorInvalidTemplateID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.invalid_template_id",
+			DefaultValue: "Invalid template ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.invalid_template_id_description",
+			DefaultValue: "The provided template ID is invalid or empty",
+		},
+	}
+
+	// ErrorInvalidDisplayName is returned when the template display name is missing.
+	ErrorInvalidDisplayName = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.invalid_display_name",
+			DefaultValue: "Invalid display name",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.invalid_display_name_description",
+			DefaultValue: "The template display name is required",
+		},
+	}
+
+	// ErrorInvalidScenario is returned when the template scenario is not supported.
+	ErrorInvalidScenario = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1005",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.invalid_scenario",
+			DefaultValue: "Invalid scenario",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.invalid_scenario_description",
+			DefaultValue: "The provided template scenario is not supported",
+		},
+	}
+
+	// ErrorInvalidTemplateType is returned when the template type is not supported.
+	ErrorInvalidTemplateType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1006",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.invalid_template_type",
+			DefaultValue: "Invalid template type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.invalid_template_type_description",
+			DefaultValue: "The template type must be either email or sms",
+		},
+	}
+
+	// ErrorInvalidContentType is returned when the content type is not supported for the template type.
+	ErrorInvalidContentType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1007",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.invalid_content_type",
+			DefaultValue: "Invalid content type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.invalid_content_type_description",
+			DefaultValue: "The content type must be text/plain, or text/html for email templates",
+		},
+	}
+
+	// ErrorInvalidTemplateVariant is returned when a localized variant is malformed.
+	ErrorInvalidTemplateVariant = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1008",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.invalid_template_variant",
+			DefaultValue: "Invalid template variant",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.invalid_template_variant_description",
+			DefaultValue: "Each variant requires a unique BCP 47 language tag and a body, and a subject for email",
+		},
+	}
+
+	// ErrorMissingDefaultVariant is returned when no variant exists for the system default language.
+	ErrorMissingDefaultVariant = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1009",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.missing_default_variant",
+			DefaultValue: "Missing default variant",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.missing_default_variant_description",
+			DefaultValue: "A variant for the system default language en-US is required",
+		},
+	}
+
+	// ErrorDuplicateTemplate is returned when a custom template already exists for the scenario, type and OU.
+	ErrorDuplicateTemplate = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1010",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.duplicate_template",
+			DefaultValue: "Duplicate template",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.duplicate_template_description",
+			DefaultValue: "A custom template already exists for the given scenario, type and organization unit",
+		},
+	}
+
+	// ErrorTemplateIsImmutable is returned when attempting to modify a declarative template.
+	ErrorTemplateIsImmutable = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1011",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.template_is_immutable",
+			DefaultValue: "Template is immutable",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.template_is_immutable_description",
+			DefaultValue: "System templates loaded from declarative resources cannot be modified or deleted",
+		},
+	}
+
+	// ErrorInvalidPlaceholder is returned when a placeholder is malformed or content references an
+	// undeclared placeholder.
+	ErrorInvalidPlaceholder = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TMP-1012",
+		Error: core.I18nMessage{
+			Key:          "error.templateservice.invalid_placeholder",
+			DefaultValue: "Invalid placeholder",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.templateservice.invalid_placeholder_description",
+			DefaultValue: "Placeholders must be alphanumeric and content may only reference declared placeholders",
+		},
+	}
 )
@@ -38,6 +38,7 @@ func (f *templateFileBasedStore) Create(id string, data interface{}) error {
 		declarativeresource.LogTypeAssertionError("template", id)
 		return errors.New("invalid data type: expected *TemplateDTO")
 	}
+	tmpl.IsReadOnly = true
 	return f.GenericFileBasedStore.Create(tmpl.ID, tmpl)
 }
 
@@ -77,6 +78,14 @@ func (f *templateFileBasedStore) GetTemplateByScenario(
 	return tmpl, nil
 }
 
+// GetTemplateByScenarioAndOU always returns errTemplateNotFound as declarative templates are not
+// scoped to organization units.
+func (f *templateFileBasedStore) GetTemplateByScenarioAndOU(
+	_ context.Context, _ ScenarioType, _ TemplateType, _ string,
+) (*TemplateDTO, error) {
+	return nil, errTemplateNotFound
+}
+
 // ListTemplates returns all templates stored in the file-based store.
 func (f *templateFileBasedStore) ListTemplates(_ context.Context) ([]*TemplateDTO, error) {
 	list, err := f.GenericFileBasedStore.List()
@@ -92,6 +101,21 @@ func (f *templateFileBasedStore) ListTemplates(_ context.Context) ([]*TemplateDT
 	return templates, nil
 }
 
+// CreateTemplate is not supported for declarative templates.
+func (f *templateFileBasedStore) CreateTemplate(_ context.Context, _ TemplateDTO) error {
+	return errTemplateIsImmutable
+}
+
+// UpdateTemplate is not supported for declarative templates.
+func (f *templateFileBasedStore) UpdateTemplate(_ context.Context, _ TemplateDTO) error {
+	return errTemplateIsImmutable
+}
+
+// DeleteTemplate is not supported for declarative templates.
+func (f *templateFileBasedStore) DeleteTemplate(_ context.Context, _ string) error {
+	return errTemplateIsImmutable
+}
+
 // newTemplateFileBasedStore creates a new templateFileBasedStore using the underlying generic store.
 func newTemplateFileBasedStore() *templateFileBasedStore {
 	genericStore := declarativeresource.NewGenericFileBasedStore(entity.KeyTypeTemplate)
@@ -171,3 +171,20 @@ func (suite *FileBasedStoreTestSuite) TestFileBasedStore_ListTemplates_WithCorru
 	suite.Len(list, 1)
 	suite.Equal("t1", list[0].ID)
 }
+
+func (suite *FileBasedStoreTestSuite) TestFileBasedStore_TemplatesAreReadOnly() {
+	dto := &TemplateDTO{ID: "t1", Scenario: ScenarioOTP, Type: TemplateTypeSMS}
+	suite.NoError(suite.store.Create("t1", dto))
+
+	res, err := suite.store.GetTemplate(context.Background(), "t1")
+	suite.NoError(err)
+	suite.True(res.IsReadOnly)
+
+	resOU, err := suite.store.GetTemplateByScenarioAndOU(context.Background(), ScenarioOTP, TemplateTypeSMS, "ou-1")
+	suite.ErrorIs(err, errTemplateNotFound)
+	suite.Nil(resOU)
+
+	suite.ErrorIs(suite.store.CreateTemplate(context.Background(), *dto), errTemplateIsImmutable)
+	suite.ErrorIs(suite.store.UpdateTemplate(context.Background(), *dto), errTemplateIsImmutable)
+	suite.ErrorIs(suite.store.DeleteTemplate(context.Background(), "t1"), errTemplateIsImmutable)
+}
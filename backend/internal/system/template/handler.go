@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// templateHandler handles HTTP requests for template management.
+type templateHandler struct {
+	templateService TemplateServiceInterface
+}
+
+// newTemplateHandler creates a new instance of templateHandler.
+func newTemplateHandler(templateService TemplateServiceInterface) *templateHandler {
+	return &templateHandler{
+		templateService: templateService,
+	}
+}
+
+// HandleTemplateListRequest handles the request to list all templates.
+func (h *templateHandler) HandleTemplateListRequest(w http.ResponseWriter, r *http.Request) {
+	templates, svcErr := h.templateService.ListTemplates(r.Context())
+	if svcErr != nil {
+		writeServiceErrorResponse(w, svcErr)
+		return
+	}
+
+	response := make([]BasicTemplateResponse, 0, len(templates))
+	for _, tmpl := range templates {
+		response = append(response, BasicTemplateResponse{
+			ID:          tmpl.ID,
+			DisplayName: tmpl.DisplayName,
+			Scenario:    tmpl.Scenario,
+			Type:        tmpl.Type,
+			OUID:        tmpl.OUID,
+			IsReadOnly:  tmpl.IsReadOnly,
+		})
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleTemplateCreateRequest handles the request to create a custom template.
+func (h *templateHandler) HandleTemplateCreateRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[TemplateRequest](r)
+	if err != nil {
+		writeServiceErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	created, svcErr := h.templateService.CreateTemplate(r.Context(), getTemplateDTOFromRequest(request))
+	if svcErr != nil {
+		writeServiceErrorResponse(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, getTemplateResponse(created))
+}
+
+// HandleTemplateGetRequest handles the request to retrieve a template by ID.
+func (h *templateHandler) HandleTemplateGetRequest(w http.ResponseWriter, r *http.Request) {
+	tmpl, svcErr := h.templateService.GetTemplate(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		writeServiceErrorResponse(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, getTemplateResponse(tmpl))
+}
+
+// HandleTemplateUpdateRequest handles the request to update a custom template.
+func (h *templateHandler) HandleTemplateUpdateRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[TemplateRequest](r)
+	if err != nil {
+		writeServiceErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	updated, svcErr := h.templateService.UpdateTemplate(r.Context(), r.PathValue("id"),
+		getTemplateDTOFromRequest(request))
+	if svcErr != nil {
+		writeServiceErrorResponse(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, getTemplateResponse(updated))
+}
+
+// HandleTemplateDeleteRequest handles the request to delete a custom template.
+func (h *templateHandler) HandleTemplateDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.templateService.DeleteTemplate(r.Context(), r.PathValue("id")); svcErr != nil {
+		writeServiceErrorResponse(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// writeServiceErrorResponse writes the appropriate HTTP error response based on the service error.
+func writeServiceErrorResponse(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		switch svcErr.Code {
+		case ErrorTemplateNotFound.Code:
+			statusCode = http.StatusNotFound
+		case ErrorDuplicateTemplate.Code:
+			statusCode = http.StatusConflict
+		default:
+			statusCode = http.StatusBadRequest
+		}
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	}
+	sysutils.WriteErrorResponse(w, statusCode, errResp)
+}
+
+// getTemplateDTOFromRequest converts a template request into a TemplateDTO. The localized content is kept
+// as is since it may legitimately contain markup.
+func getTemplateDTOFromRequest(request *TemplateRequest) TemplateDTO {
+	variants := make([]TemplateVariant, 0, len(request.Variants))
+	for _, variant := range request.Variants {
+		variants = append(variants, TemplateVariant{
+			Language: sysutils.SanitizeString(variant.Language),
+			Subject:  variant.Subject,
+			Body:     variant.Body,
+			TextBody: variant.TextBody,
+		})
+	}
+
+	return TemplateDTO{
+		DisplayName:  sysutils.SanitizeString(request.DisplayName),
+		Scenario:     ScenarioType(sysutils.SanitizeString(string(request.Scenario))),
+		Type:         TemplateType(sysutils.SanitizeString(string(request.Type))),
+		OUID:         sysutils.SanitizeString(request.OUID),
+		ContentType:  sysutils.SanitizeString(request.ContentType),
+		Placeholders: request.Placeholders,
+		Variants:     variants,
+	}
+}
+
+// getTemplateResponse converts a TemplateDTO into a template response.
+func getTemplateResponse(tmpl *TemplateDTO) TemplateResponse {
+	variants := tmpl.Variants
+	if variants == nil {
+		variants = []TemplateVariant{}
+	}
+
+	return TemplateResponse{
+		ID:           tmpl.ID,
+		DisplayName:  tmpl.DisplayName,
+		Scenario:     tmpl.Scenario,
+		Type:         tmpl.Type,
+		OUID:         tmpl.OUID,
+		ContentType:  tmpl.ContentType,
+		Placeholders: tmpl.Placeholders,
+		Variants:     variants,
+		IsReadOnly:   tmpl.IsReadOnly,
+	}
+}
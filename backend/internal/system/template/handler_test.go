@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type TemplateHandlerTestSuite struct {
+	suite.Suite
+	mockService *TemplateServiceInterfaceMock
+	handler     *templateHandler
+}
+
+func TestTemplateHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplateHandlerTestSuite))
+}
+
+func (suite *TemplateHandlerTestSuite) SetupTest() {
+	suite.mockService = NewTemplateServiceInterfaceMock(suite.T())
+	suite.handler = newTemplateHandler(suite.mockService)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateListRequest() {
+	suite.mockService.On("ListTemplates", mock.Anything).Return([]TemplateDTO{
+		{ID: "sms-otp", DisplayName: "SMS OTP", Scenario: ScenarioOTP, Type: TemplateTypeSMS, IsReadOnly: true},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/templates", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var resp []BasicTemplateResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	suite.Len(resp, 1)
+	suite.True(resp[0].IsReadOnly)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateCreateRequest() {
+	body := `{"displayName":"OTP","scenario":"OTP","type":"sms","ouId":"ou-1",` +
+		`"variants":[{"language":"en-US","body":"Code {{ctx(otp)}}"}]}`
+	suite.mockService.On("CreateTemplate", mock.Anything, mock.MatchedBy(func(dto TemplateDTO) bool {
+		return dto.OUID == "ou-1" && len(dto.Variants) == 1 && dto.Variants[0].Body == "Code {{ctx(otp)}}"
+	})).Return(&TemplateDTO{
+		ID: "tmpl-1", DisplayName: "OTP", Scenario: ScenarioOTP, Type: TemplateTypeSMS, OUID: "ou-1",
+		Variants: []TemplateVariant{{Language: "en-US", Body: "Code {{ctx(otp)}}"}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/templates", bytes.NewBufferString(body))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateCreateRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+	var resp TemplateResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	suite.Equal("tmpl-1", resp.ID)
+	suite.Equal("ou-1", resp.OUID)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateCreateRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/templates", bytes.NewBufferString("{invalid"))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateCreateRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidRequestFormat.Code)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateCreateRequest_Duplicate() {
+	suite.mockService.On("CreateTemplate", mock.Anything, mock.Anything).Return(nil, &ErrorDuplicateTemplate)
+
+	req := httptest.NewRequest(http.MethodPost, "/templates", bytes.NewBufferString(`{"displayName":"OTP"}`))
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateCreateRequest(rr, req)
+
+	suite.Equal(http.StatusConflict, rr.Code)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateGetRequest() {
+	suite.mockService.On("GetTemplate", mock.Anything, "tmpl-1").Return(&TemplateDTO{ID: "tmpl-1"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/templates/tmpl-1", nil)
+	req.SetPathValue("id", "tmpl-1")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateGetRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var resp TemplateResponse
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	suite.NotNil(resp.Variants)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateGetRequest_NotFound() {
+	suite.mockService.On("GetTemplate", mock.Anything, "missing").Return(nil, &ErrorTemplateNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/templates/missing", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateGetRequest(rr, req)
+
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateUpdateRequest() {
+	suite.mockService.On("UpdateTemplate", mock.Anything, "tmpl-1", mock.Anything).
+		Return(&TemplateDTO{ID: "tmpl-1"}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/templates/tmpl-1", bytes.NewBufferString(`{"displayName":"OTP"}`))
+	req.SetPathValue("id", "tmpl-1")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateUpdateRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateUpdateRequest_Immutable() {
+	suite.mockService.On("UpdateTemplate", mock.Anything, "sms-otp", mock.Anything).
+		Return(nil, &ErrorTemplateIsImmutable)
+
+	req := httptest.NewRequest(http.MethodPut, "/templates/sms-otp", bytes.NewBufferString(`{}`))
+	req.SetPathValue("id", "sms-otp")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateUpdateRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateDeleteRequest() {
+	suite.mockService.On("DeleteTemplate", mock.Anything, "tmpl-1").Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/templates/tmpl-1", nil)
+	req.SetPathValue("id", "tmpl-1")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (suite *TemplateHandlerTestSuite) TestHandleTemplateDeleteRequest_ServerError() {
+	suite.mockService.On("DeleteTemplate", mock.Anything, "tmpl-1").Return(&serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodDelete, "/templates/tmpl-1", nil)
+	req.SetPathValue("id", "tmpl-1")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleTemplateDeleteRequest(rr, req)
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+}
@@ -18,16 +18,71 @@
 
 package template
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
 
-// Initialize sets up the template service with a file-based store loaded from declarative resources.
-func Initialize() (TemplateServiceInterface, error) {
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize sets up the template service and registers its routes. The system templates are loaded from
+// declarative resources; unless declarative mode is enabled, custom templates are additionally managed in
+// the database with their localized variants held by the i18n service.
+func Initialize(
+	mux *http.ServeMux, i18nService i18nmgt.I18nServiceInterface,
+) (TemplateServiceInterface, error) {
 	fileStore := newTemplateFileBasedStore()
 
 	if err := loadDeclarativeResources(fileStore); err != nil {
 		return nil, fmt.Errorf("failed to initialize template service: %w", err)
 	}
 
-	service := newTemplateService(fileStore)
+	var store templateStoreInterface = fileStore
+	if !declarativeresource.IsDeclarativeModeEnabled() {
+		store = newCompositeTemplateStore(fileStore, newTemplateStore())
+	}
+
+	service := newTemplateService(store, i18nService)
+
+	handler := newTemplateHandler(service)
+	registerRoutes(mux, handler)
+
 	return service, nil
 }
+
+// registerRoutes registers the routes for template management operations.
+func registerRoutes(mux *http.ServeMux, handler *templateHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /templates",
+		handler.HandleTemplateListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST /templates",
+		handler.HandleTemplateCreateRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /templates",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /templates/{id}",
+		handler.HandleTemplateGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /templates/{id}",
+		handler.HandleTemplateUpdateRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /templates/{id}",
+		handler.HandleTemplateDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /templates/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
@@ -41,4 +41,30 @@ type TemplateServiceInterface interface {
 		tmplType TemplateType,
 		data TemplateData,
 	) (*RenderedTemplate, *serviceerror.ServiceError)
+
+	// RenderLocalized renders a template with the provided data, resolving the override for the
+	// organization unit and the localized variant best matching the given language.
+	RenderLocalized(
+		ctx context.Context,
+		scenario ScenarioType,
+		tmplType TemplateType,
+		ouID string,
+		language string,
+		data TemplateData,
+	) (*RenderedTemplate, *serviceerror.ServiceError)
+
+	// ListTemplates lists all system and custom templates.
+	ListTemplates(ctx context.Context) ([]TemplateDTO, *serviceerror.ServiceError)
+
+	// GetTemplate retrieves a template by its ID along with its localized variants.
+	GetTemplate(ctx context.Context, id string) (*TemplateDTO, *serviceerror.ServiceError)
+
+	// CreateTemplate creates a custom template.
+	CreateTemplate(ctx context.Context, tmpl TemplateDTO) (*TemplateDTO, *serviceerror.ServiceError)
+
+	// UpdateTemplate updates a custom template.
+	UpdateTemplate(ctx context.Context, id string, tmpl TemplateDTO) (*TemplateDTO, *serviceerror.ServiceError)
+
+	// DeleteTemplate deletes a custom template.
+	DeleteTemplate(ctx context.Context, id string) *serviceerror.ServiceError
 }
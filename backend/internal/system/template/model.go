@@ -42,6 +42,14 @@ const (
 	ScenarioOTP ScenarioType = "OTP"
 	// ScenarioPasswordRecovery represents the password recovery via email link scenario.
 	ScenarioPasswordRecovery ScenarioType = "PASSWORD_RECOVERY"
+	// ScenarioUserCreated represents the account created security notification scenario.
+	ScenarioUserCreated ScenarioType = "USER_CREATED"
+	// ScenarioPasswordChanged represents the password changed security notification scenario.
+	ScenarioPasswordChanged ScenarioType = "PASSWORD_CHANGED"
+	// ScenarioNewDeviceLogin represents the sign-in from a new device security notification scenario.
+	ScenarioNewDeviceLogin ScenarioType = "NEW_DEVICE_LOGIN"
+	// ScenarioAccountLocked represents the account locked security notification scenario.
+	ScenarioAccountLocked ScenarioType = "ACCOUNT_LOCKED"
 )
 
 // supportedScenarios contains all valid scenario types.
@@ -51,6 +59,10 @@ var supportedScenarios = map[ScenarioType]bool{
 	ScenarioSelfRegistration: true,
 	ScenarioOTP:              true,
 	ScenarioPasswordRecovery: true,
+	ScenarioUserCreated:      true,
+	ScenarioPasswordChanged:  true,
+	ScenarioNewDeviceLogin:   true,
+	ScenarioAccountLocked:    true,
 }
 
 // IsValidScenario checks if the given scenario type is supported.
@@ -60,13 +72,60 @@ func IsValidScenario(scenario ScenarioType) bool {
 
 // TemplateDTO represents a template with embedded metadata.
 type TemplateDTO struct {
-	ID          string       `yaml:"id"`
-	DisplayName string       `yaml:"displayName"`
-	Scenario    ScenarioType `yaml:"scenario"`
-	Type        TemplateType `yaml:"type"`
-	Subject     string       `yaml:"subject"`
-	ContentType string       `yaml:"contentType"`
-	Body        string       `yaml:"body"`
+	ID           string            `yaml:"id"`
+	DisplayName  string            `yaml:"displayName"`
+	Scenario     ScenarioType      `yaml:"scenario"`
+	Type         TemplateType      `yaml:"type"`
+	OUID         string            `yaml:"ouId,omitempty"`
+	Subject      string            `yaml:"subject"`
+	ContentType  string            `yaml:"contentType"`
+	Body         string            `yaml:"body"`
+	TextBody     string            `yaml:"textBody,omitempty"`
+	Placeholders []string          `yaml:"placeholders,omitempty"`
+	Variants     []TemplateVariant `yaml:"-"`
+	IsReadOnly   bool              `yaml:"-"`
+}
+
+// TemplateVariant holds the localized content of a template for a single language.
+type TemplateVariant struct {
+	Language string `json:"language"`
+	Subject  string `json:"subject,omitempty"`
+	Body     string `json:"body"`
+	TextBody string `json:"textBody,omitempty"`
+}
+
+// TemplateRequest represents the request body for creating or updating a custom template.
+type TemplateRequest struct {
+	DisplayName  string            `json:"displayName"`
+	Scenario     ScenarioType      `json:"scenario"`
+	Type         TemplateType      `json:"type"`
+	OUID         string            `json:"ouId,omitempty"`
+	ContentType  string            `json:"contentType,omitempty"`
+	Placeholders []string          `json:"placeholders,omitempty"`
+	Variants     []TemplateVariant `json:"variants"`
+}
+
+// TemplateResponse represents a template returned by the management API.
+type TemplateResponse struct {
+	ID           string            `json:"id"`
+	DisplayName  string            `json:"displayName"`
+	Scenario     ScenarioType      `json:"scenario"`
+	Type         TemplateType      `json:"type"`
+	OUID         string            `json:"ouId,omitempty"`
+	ContentType  string            `json:"contentType,omitempty"`
+	Placeholders []string          `json:"placeholders,omitempty"`
+	Variants     []TemplateVariant `json:"variants"`
+	IsReadOnly   bool              `json:"isReadOnly"`
+}
+
+// BasicTemplateResponse represents a template summary returned in list responses.
+type BasicTemplateResponse struct {
+	ID          string       `json:"id"`
+	DisplayName string       `json:"displayName"`
+	Scenario    ScenarioType `json:"scenario"`
+	Type        TemplateType `json:"type"`
+	OUID        string       `json:"ouId,omitempty"`
+	IsReadOnly  bool         `json:"isReadOnly"`
 }
 
 // TemplateData holds key-value pairs for template substitution.
@@ -74,7 +133,8 @@ type TemplateData = map[string]string
 
 // RenderedTemplate holds the result after template processing.
 type RenderedTemplate struct {
-	Subject string
-	Body    string
-	IsHTML  bool
+	Subject  string
+	Body     string
+	TextBody string
+	IsHTML   bool
 }
@@ -22,24 +22,36 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"slices"
+	"strings"
 
+	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
+	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
-var ctxPlaceholderRegex = regexp.MustCompile(`\{\{ctx\((\w+)\)}}`)
+var (
+	ctxPlaceholderRegex = regexp.MustCompile(`\{\{ctx\((\w+)\)}}`)
+	placeholderRegex    = regexp.MustCompile(`^\w+$`)
+)
 
 // templateService implements TemplateServiceInterface using a templateStoreInterface.
 type templateService struct {
-	store  templateStoreInterface
-	logger *log.Logger
+	store       templateStoreInterface
+	i18nService i18nmgt.I18nServiceInterface
+	logger      *log.Logger
 }
 
-// newTemplateService creates a new template service with the provided store.
-func newTemplateService(store templateStoreInterface) TemplateServiceInterface {
+// newTemplateService creates a new template service with the provided store and i18n service.
+func newTemplateService(
+	store templateStoreInterface, i18nService i18nmgt.I18nServiceInterface) TemplateServiceInterface {
 	return &templateService{
-		store:  store,
-		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TemplateService")),
+		store:       store,
+		i18nService: i18nService,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TemplateService")),
 	}
 }
 
@@ -72,15 +84,51 @@ func (s *templateService) Render(
 	scenario ScenarioType,
 	tmplType TemplateType,
 	data TemplateData,
+) (*RenderedTemplate, *serviceerror.ServiceError) {
+	return s.RenderLocalized(ctx, scenario, tmplType, "", "", data)
+}
+
+// RenderLocalized renders a template for the specified scenario and template type using the provided
+// data. A custom template overriding the scenario for the organization unit takes precedence over the
+// template applying to all organization units, and the localized variant best matching the language is used.
+func (s *templateService) RenderLocalized(
+	ctx context.Context,
+	scenario ScenarioType,
+	tmplType TemplateType,
+	ouID string,
+	language string,
+	data TemplateData,
 ) (*RenderedTemplate, *serviceerror.ServiceError) {
 	s.logger.Debug("Rendering template", log.String("scenario", string(scenario)))
-	tmpl, svcErr := s.GetTemplateByScenario(ctx, scenario, tmplType)
+	tmpl, svcErr := s.resolveTemplate(ctx, scenario, tmplType, ouID)
 	if svcErr != nil {
 		return nil, svcErr
 	}
 
-	replacePlaceholders := func(s string) string {
-		return ctxPlaceholderRegex.ReplaceAllStringFunc(s, func(match string) string {
+	variant := TemplateVariant{
+		Language: i18nmgt.SystemLanguage,
+		Subject:  tmpl.Subject,
+		Body:     tmpl.Body,
+		TextBody: tmpl.TextBody,
+	}
+	if !tmpl.IsReadOnly {
+		localized, svcErr := s.resolveLocalizedVariant(tmpl.ID, language)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		variant = *localized
+	}
+
+	replacePlaceholders := func(text string) string {
+		// Format plural, select and named arguments before substituting the context placeholders, so that
+		// substituted values are never interpreted as message syntax.
+		formatted, err := i18ncore.FormatMessage(variant.Language, text, data)
+		if err != nil {
+			s.logger.Warn("Failed to format template message; rendering it unformatted",
+				log.String("templateID", tmpl.ID), log.Error(err))
+			formatted = text
+		}
+		return ctxPlaceholderRegex.ReplaceAllStringFunc(formatted, func(match string) string {
 			// Extract the key from {{ctx(key)}}
 			submatches := ctxPlaceholderRegex.FindStringSubmatch(match)
 			if len(submatches) < 2 {
@@ -95,9 +143,10 @@ func (s *templateService) Render(
 	}
 
 	rendered := &RenderedTemplate{
-		Subject: replacePlaceholders(tmpl.Subject),
-		Body:    replacePlaceholders(tmpl.Body),
-		IsHTML:  tmpl.ContentType == "text/html",
+		Subject:  replacePlaceholders(variant.Subject),
+		Body:     replacePlaceholders(variant.Body),
+		TextBody: replacePlaceholders(variant.TextBody),
+		IsHTML:   tmpl.ContentType == contentTypeHTML,
 	}
 
 	s.logger.Debug("Template rendered successfully",
@@ -111,3 +160,443 @@ func (s *templateService) Render(
 
 	return rendered, nil
 }
+
+// ListTemplates retrieves all system and custom templates.
+func (s *templateService) ListTemplates(ctx context.Context) ([]TemplateDTO, *serviceerror.ServiceError) {
+	templates, err := s.store.ListTemplates(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list templates", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	result := make([]TemplateDTO, 0, len(templates))
+	for _, tmpl := range templates {
+		result = append(result, *tmpl)
+	}
+	return result, nil
+}
+
+// GetTemplate retrieves a template by its ID along with its localized variants.
+func (s *templateService) GetTemplate(ctx context.Context, id string) (*TemplateDTO, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorInvalidTemplateID
+	}
+
+	tmpl, svcErr := s.getTemplate(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	result := *tmpl
+	if result.IsReadOnly {
+		result.Variants = []TemplateVariant{{
+			Language: i18nmgt.SystemLanguage,
+			Subject:  result.Subject,
+			Body:     result.Body,
+			TextBody: result.TextBody,
+		}}
+		return &result, nil
+	}
+
+	variants, svcErr := s.getLocalizedVariants(id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	result.Variants = variants
+	return &result, nil
+}
+
+// CreateTemplate creates a custom template and stores its localized variants as translations.
+func (s *templateService) CreateTemplate(
+	ctx context.Context, tmpl TemplateDTO) (*TemplateDTO, *serviceerror.ServiceError) {
+	if err := declarativeresource.CheckDeclarativeCreate(); err != nil {
+		return nil, err
+	}
+	if svcErr := validateCustomTemplate(&tmpl); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := s.checkDuplicateTemplate(ctx, &tmpl, ""); svcErr != nil {
+		return nil, svcErr
+	}
+
+	id, err := sysutils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate template ID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	tmpl.ID = id
+	tmpl.IsReadOnly = false
+
+	if err := s.store.CreateTemplate(ctx, tmpl); err != nil {
+		s.logger.Error("Failed to create template", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	// Translations are written through the i18n service which does not share the store transaction,
+	// so the template is removed again if its variants cannot be written.
+	if svcErr := s.writeLocalizedVariants(ctx, tmpl.ID, tmpl.Variants); svcErr != nil {
+		if err := s.store.DeleteTemplate(ctx, tmpl.ID); err != nil {
+			s.logger.Error("Failed to remove template after variant write failure",
+				log.String("templateID", tmpl.ID), log.Error(err))
+		}
+		return nil, svcErr
+	}
+
+	s.logger.Debug("Template created successfully", log.String("templateID", tmpl.ID))
+	return &tmpl, nil
+}
+
+// UpdateTemplate updates a custom template and replaces its localized variants.
+func (s *templateService) UpdateTemplate(
+	ctx context.Context, id string, tmpl TemplateDTO) (*TemplateDTO, *serviceerror.ServiceError) {
+	if err := declarativeresource.CheckDeclarativeUpdate(); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorInvalidTemplateID
+	}
+	if svcErr := validateCustomTemplate(&tmpl); svcErr != nil {
+		return nil, svcErr
+	}
+
+	existing, svcErr := s.getTemplate(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if existing.IsReadOnly {
+		return nil, &ErrorTemplateIsImmutable
+	}
+	if svcErr := s.checkDuplicateTemplate(ctx, &tmpl, id); svcErr != nil {
+		return nil, svcErr
+	}
+
+	tmpl.ID = id
+	tmpl.IsReadOnly = false
+	if err := s.store.UpdateTemplate(ctx, tmpl); err != nil {
+		if errors.Is(err, errTemplateNotFound) {
+			return nil, &ErrorTemplateNotFound
+		}
+		if errors.Is(err, errTemplateIsImmutable) {
+			return nil, &ErrorTemplateIsImmutable
+		}
+		s.logger.Error("Failed to update template", log.String("templateID", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	previous, svcErr := s.getLocalizedVariants(id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := s.writeLocalizedVariants(ctx, id, tmpl.Variants); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := s.clearStaleVariants(id, previous, tmpl.Variants); svcErr != nil {
+		return nil, svcErr
+	}
+
+	s.logger.Debug("Template updated successfully", log.String("templateID", id))
+	return &tmpl, nil
+}
+
+// DeleteTemplate deletes a custom template along with its localized variants.
+func (s *templateService) DeleteTemplate(ctx context.Context, id string) *serviceerror.ServiceError {
+	if err := declarativeresource.CheckDeclarativeDelete(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(id) == "" {
+		return &ErrorInvalidTemplateID
+	}
+
+	existing, err := s.store.GetTemplate(ctx, id)
+	if err != nil {
+		if errors.Is(err, errTemplateNotFound) {
+			return nil
+		}
+		s.logger.Error("Failed to retrieve template", log.String("templateID", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if existing.IsReadOnly {
+		return &ErrorTemplateIsImmutable
+	}
+
+	if err := s.store.DeleteTemplate(ctx, id); err != nil {
+		if errors.Is(err, errTemplateIsImmutable) {
+			return &ErrorTemplateIsImmutable
+		}
+		s.logger.Error("Failed to delete template", log.String("templateID", id), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	if svcErr := s.i18nService.DeleteTranslationsByNamespace(ctx, templateI18nNamespace(id)); svcErr != nil {
+		s.logger.Error("Failed to delete template variants", log.String("templateID", id),
+			log.String("error", svcErr.Error.DefaultValue))
+		return &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Template deleted successfully", log.String("templateID", id))
+	return nil
+}
+
+// getTemplate retrieves a template by ID, mapping store errors to service errors.
+func (s *templateService) getTemplate(ctx context.Context, id string) (*TemplateDTO, *serviceerror.ServiceError) {
+	tmpl, err := s.store.GetTemplate(ctx, id)
+	if err != nil {
+		if errors.Is(err, errTemplateNotFound) {
+			return nil, &ErrorTemplateNotFound
+		}
+		s.logger.Error("Failed to retrieve template", log.String("templateID", id), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return tmpl, nil
+}
+
+// resolveTemplate resolves the template to render, preferring the override for the organization unit.
+func (s *templateService) resolveTemplate(
+	ctx context.Context, scenario ScenarioType, tmplType TemplateType, ouID string,
+) (*TemplateDTO, *serviceerror.ServiceError) {
+	if ouID != "" {
+		tmpl, err := s.store.GetTemplateByScenarioAndOU(ctx, scenario, tmplType, ouID)
+		if err == nil {
+			return tmpl, nil
+		}
+		if !errors.Is(err, errTemplateNotFound) {
+			s.logger.Error("Failed to retrieve template for organization unit",
+				log.String("scenario", string(scenario)),
+				log.String("ouID", ouID),
+				log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+	}
+
+	return s.GetTemplateByScenario(ctx, scenario, tmplType)
+}
+
+// resolveLocalizedVariant resolves the content of a custom template for the language best matching the
+// requested one, falling back to the system language.
+func (s *templateService) resolveLocalizedVariant(
+	templateID string, language string) (*TemplateVariant, *serviceerror.ServiceError) {
+	lang, ok := i18nmgt.NormaliseBCP47Tag(language)
+	if !ok {
+		lang = i18nmgt.SystemLanguage
+	}
+	namespace := templateI18nNamespace(templateID)
+
+	variant := &TemplateVariant{Language: lang}
+	for key, target := range map[string]*string{
+		i18nKeySubject:  &variant.Subject,
+		i18nKeyBody:     &variant.Body,
+		i18nKeyTextBody: &variant.TextBody,
+	} {
+		resp, svcErr := s.i18nService.ResolveTranslationsForKey(lang, namespace, key,
+			i18nmgt.TranslationScope{})
+		if svcErr != nil {
+			if svcErr.Code == i18nmgt.ErrorTranslationNotFound.Code {
+				continue
+			}
+			s.logger.Error("Failed to resolve template variant", log.String("templateID", templateID),
+				log.String("key", key), log.String("error", svcErr.Error.DefaultValue))
+			return nil, &serviceerror.InternalServerError
+		}
+		*target = resp.Value
+	}
+
+	if variant.Body == "" {
+		s.logger.Error("No localized body found for template", log.String("templateID", templateID))
+		return nil, &serviceerror.InternalServerError
+	}
+	return variant, nil
+}
+
+// getLocalizedVariants loads all localized variants of a custom template.
+func (s *templateService) getLocalizedVariants(templateID string) ([]TemplateVariant, *serviceerror.ServiceError) {
+	entries, svcErr := s.i18nService.GetTranslationsByNamespace(templateI18nNamespace(templateID))
+	if svcErr != nil {
+		s.logger.Error("Failed to load template variants", log.String("templateID", templateID),
+			log.String("error", svcErr.Error.DefaultValue))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	byLanguage := make(map[string]*TemplateVariant)
+	for key, langs := range entries {
+		for lang, value := range langs {
+			variant, ok := byLanguage[lang]
+			if !ok {
+				variant = &TemplateVariant{Language: lang}
+				byLanguage[lang] = variant
+			}
+			switch key {
+			case i18nKeySubject:
+				variant.Subject = value
+			case i18nKeyBody:
+				variant.Body = value
+			case i18nKeyTextBody:
+				variant.TextBody = value
+			}
+		}
+	}
+
+	variants := make([]TemplateVariant, 0, len(byLanguage))
+	for _, variant := range byLanguage {
+		variants = append(variants, *variant)
+	}
+	// Keep the system language first and order the remaining variants by language tag.
+	slices.SortFunc(variants, func(a, b TemplateVariant) int {
+		if a.Language == i18nmgt.SystemLanguage {
+			return -1
+		}
+		if b.Language == i18nmgt.SystemLanguage {
+			return 1
+		}
+		return strings.Compare(a.Language, b.Language)
+	})
+	return variants, nil
+}
+
+// writeLocalizedVariants stores the localized variants of a custom template as translations.
+func (s *templateService) writeLocalizedVariants(
+	ctx context.Context, templateID string, variants []TemplateVariant) *serviceerror.ServiceError {
+	entries := make(map[string]map[string]string)
+	set := func(key, lang, value string) {
+		if value == "" {
+			return
+		}
+		if entries[key] == nil {
+			entries[key] = make(map[string]string)
+		}
+		entries[key][lang] = value
+	}
+	for _, variant := range variants {
+		set(i18nKeySubject, variant.Language, variant.Subject)
+		set(i18nKeyBody, variant.Language, variant.Body)
+		set(i18nKeyTextBody, variant.Language, variant.TextBody)
+	}
+
+	if svcErr := s.i18nService.SetTranslationOverridesForNamespace(
+		ctx, templateI18nNamespace(templateID), entries); svcErr != nil {
+		s.logger.Error("Failed to write template variants", log.String("templateID", templateID),
+			log.String("errorCode", svcErr.Code), log.String("error", svcErr.Error.DefaultValue))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// clearStaleVariants removes the translations of previous variant content that is not part of the
+// updated variants.
+func (s *templateService) clearStaleVariants(
+	templateID string, previous, updated []TemplateVariant) *serviceerror.ServiceError {
+	current := make(map[string]TemplateVariant, len(updated))
+	for _, variant := range updated {
+		current[variant.Language] = variant
+	}
+
+	namespace := templateI18nNamespace(templateID)
+	for _, old := range previous {
+		variant := current[old.Language]
+		stale := map[string]bool{
+			i18nKeySubject:  old.Subject != "" && variant.Subject == "",
+			i18nKeyBody:     old.Body != "" && variant.Body == "",
+			i18nKeyTextBody: old.TextBody != "" && variant.TextBody == "",
+		}
+		for key, isStale := range stale {
+			if !isStale {
+				continue
+			}
+			if svcErr := s.i18nService.ClearTranslationOverrideForKey(
+				old.Language, namespace, key, i18nmgt.TranslationScope{}); svcErr != nil {
+				s.logger.Error("Failed to clear stale template variant", log.String("templateID", templateID),
+					log.String("language", old.Language), log.String("error", svcErr.Error.DefaultValue))
+				return &serviceerror.InternalServerError
+			}
+		}
+	}
+	return nil
+}
+
+// checkDuplicateTemplate ensures no other custom template exists for the same scenario, template type and
+// organization unit. Declarative templates may be overridden and are not considered duplicates.
+func (s *templateService) checkDuplicateTemplate(
+	ctx context.Context, tmpl *TemplateDTO, currentID string) *serviceerror.ServiceError {
+	var existing *TemplateDTO
+	var err error
+	if tmpl.OUID != "" {
+		existing, err = s.store.GetTemplateByScenarioAndOU(ctx, tmpl.Scenario, tmpl.Type, tmpl.OUID)
+	} else {
+		existing, err = s.store.GetTemplateByScenario(ctx, tmpl.Scenario, tmpl.Type)
+	}
+	if err != nil {
+		if errors.Is(err, errTemplateNotFound) {
+			return nil
+		}
+		s.logger.Error("Failed to check for duplicate template", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if existing.IsReadOnly || existing.ID == currentID {
+		return nil
+	}
+	return &ErrorDuplicateTemplate
+}
+
+// validateCustomTemplate validates a custom template and normalises its content type and variant languages.
+func validateCustomTemplate(tmpl *TemplateDTO) *serviceerror.ServiceError {
+	if strings.TrimSpace(tmpl.DisplayName) == "" {
+		return &ErrorInvalidDisplayName
+	}
+	if !IsValidScenario(tmpl.Scenario) {
+		return &ErrorInvalidScenario
+	}
+	if tmpl.Type != TemplateTypeEmail && tmpl.Type != TemplateTypeSMS {
+		return &ErrorInvalidTemplateType
+	}
+
+	switch tmpl.ContentType {
+	case "":
+		tmpl.ContentType = contentTypeText
+	case contentTypeText:
+	case contentTypeHTML:
+		if tmpl.Type != TemplateTypeEmail {
+			return &ErrorInvalidContentType
+		}
+	default:
+		return &ErrorInvalidContentType
+	}
+
+	declared := make(map[string]bool, len(tmpl.Placeholders))
+	for _, placeholder := range tmpl.Placeholders {
+		if !placeholderRegex.MatchString(placeholder) {
+			return &ErrorInvalidPlaceholder
+		}
+		declared[placeholder] = true
+	}
+
+	if len(tmpl.Variants) == 0 {
+		return &ErrorMissingDefaultVariant
+	}
+	seen := make(map[string]bool, len(tmpl.Variants))
+	for i := range tmpl.Variants {
+		variant := &tmpl.Variants[i]
+		lang, ok := i18nmgt.NormaliseBCP47Tag(variant.Language)
+		if !ok || seen[lang] {
+			return &ErrorInvalidTemplateVariant
+		}
+		seen[lang] = true
+		variant.Language = lang
+
+		if variant.Body == "" || (tmpl.Type == TemplateTypeEmail && variant.Subject == "") {
+			return &ErrorInvalidTemplateVariant
+		}
+		if len(declared) > 0 {
+			for _, content := range []string{variant.Subject, variant.Body, variant.TextBody} {
+				for _, match := range ctxPlaceholderRegex.FindAllStringSubmatch(content, -1) {
+					if !declared[match[1]] {
+						return &ErrorInvalidPlaceholder
+					}
+				}
+			}
+		}
+	}
+	if !seen[i18nmgt.SystemLanguage] {
+		return &ErrorMissingDefaultVariant
+	}
+
+	return nil
+}
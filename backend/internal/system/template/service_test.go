@@ -27,11 +27,14 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
+	"github.com/thunder-id/thunderid/tests/mocks/i18n/mgtmock"
 )
 
 type TemplateServiceTestSuite struct {
 	suite.Suite
 	mockStore *templateStoreInterfaceMock
+	mockI18n  *mgtmock.I18nServiceInterfaceMock
 	service   TemplateServiceInterface
 }
 
@@ -41,7 +44,8 @@ func TestTemplateServiceTestSuite(t *testing.T) {
 
 func (suite *TemplateServiceTestSuite) SetupTest() {
 	suite.mockStore = newTemplateStoreInterfaceMock(suite.T())
-	suite.service = newTemplateService(suite.mockStore)
+	suite.mockI18n = mgtmock.NewI18nServiceInterfaceMock(suite.T())
+	suite.service = newTemplateService(suite.mockStore, suite.mockI18n)
 }
 
 func (suite *TemplateServiceTestSuite) TestGetTemplateByScenario() {
@@ -56,6 +60,7 @@ func (suite *TemplateServiceTestSuite) TestGetTemplateByScenario() {
 func (suite *TemplateServiceTestSuite) TestRender() {
 	dto := &TemplateDTO{
 		ID:          "1",
+		IsReadOnly:  true,
 		Scenario:    ScenarioUserInvite,
 		Subject:     "Test Invite",
 		ContentType: "text/html",
@@ -95,6 +100,7 @@ func (suite *TemplateServiceTestSuite) TestRender_StoreError() {
 func (suite *TemplateServiceTestSuite) TestRender_UnknownPlaceholder() {
 	dto := &TemplateDTO{
 		ID:          "1",
+		IsReadOnly:  true,
 		Scenario:    ScenarioUserInvite,
 		Subject:     "Test",
 		ContentType: "text/html",
@@ -110,6 +116,7 @@ func (suite *TemplateServiceTestSuite) TestRender_UnknownPlaceholder() {
 func (suite *TemplateServiceTestSuite) TestRender_SubjectPlaceholderReplaced() {
 	dto := &TemplateDTO{
 		ID:          "1",
+		IsReadOnly:  true,
 		Scenario:    ScenarioSelfRegistration,
 		Subject:     "Complete your registration for {{ctx(appName)}}",
 		ContentType: "text/html",
@@ -130,6 +137,7 @@ func (suite *TemplateServiceTestSuite) TestRender_SMSBodyOver160Chars_SucceedsAn
 		"succeeds and returns the rendered result without failing or truncating the content."
 	dto := &TemplateDTO{
 		ID:          "sms-1",
+		IsReadOnly:  true,
 		Scenario:    ScenarioOTP,
 		Type:        TemplateTypeSMS,
 		ContentType: "text/plain",
@@ -147,6 +155,7 @@ func (suite *TemplateServiceTestSuite) TestRender_EmailBodyOver160Chars_RendersS
 		"segment warning is triggered for non-SMS template types during rendering of the content."
 	dto := &TemplateDTO{
 		ID:          "email-1",
+		IsReadOnly:  true,
 		Scenario:    ScenarioUserInvite,
 		ContentType: "text/html",
 		Body:        longBody,
@@ -161,6 +170,7 @@ func (suite *TemplateServiceTestSuite) TestRender_EmailBodyOver160Chars_RendersS
 func (suite *TemplateServiceTestSuite) TestRender_SelfRegistrationScenario() {
 	dto := &TemplateDTO{
 		ID:          "2",
+		IsReadOnly:  true,
 		Scenario:    ScenarioSelfRegistration,
 		Subject:     "You're invited",
 		ContentType: "text/plain",
@@ -176,3 +186,422 @@ func (suite *TemplateServiceTestSuite) TestRender_SelfRegistrationScenario() {
 	suite.Equal("Register at https://example.com/invite", res.Body)
 	suite.False(res.IsHTML)
 }
+
+func (suite *TemplateServiceTestSuite) TestRenderLocalized_OUOverrideWithLocalizedVariant() {
+	dto := &TemplateDTO{
+		ID:          "custom-1",
+		Scenario:    ScenarioOTP,
+		Type:        TemplateTypeSMS,
+		OUID:        "ou-1",
+		ContentType: "text/plain",
+	}
+	suite.mockStore.On("GetTemplateByScenarioAndOU", mock.Anything, ScenarioOTP, TemplateTypeSMS, "ou-1").
+		Return(dto, nil)
+	suite.mockI18n.On("ResolveTranslationsForKey", "fr-FR", "template-custom-1", "body", i18nmgt.TranslationScope{}).
+		Return(&i18nmgt.TranslationResponse{Value: "Votre code est {{ctx(otp)}}"}, nil)
+	suite.mockI18n.On("ResolveTranslationsForKey", "fr-FR", "template-custom-1", mock.Anything,
+		i18nmgt.TranslationScope{}).
+		Return(nil, &i18nmgt.ErrorTranslationNotFound)
+
+	res, err := suite.service.RenderLocalized(context.Background(), ScenarioOTP, TemplateTypeSMS, "ou-1", "fr-fr",
+		TemplateData{"otp": "123456"})
+	suite.Nil(err)
+	suite.Equal("Votre code est 123456", res.Body)
+	suite.Empty(res.Subject)
+	suite.False(res.IsHTML)
+}
+
+func (suite *TemplateServiceTestSuite) TestRenderLocalized_FallsBackWhenNoOUOverride() {
+	dto := &TemplateDTO{
+		ID:          "sms-otp",
+		IsReadOnly:  true,
+		Scenario:    ScenarioOTP,
+		Type:        TemplateTypeSMS,
+		ContentType: "text/plain",
+		Body:        "Code: {{ctx(otp)}}",
+	}
+	suite.mockStore.On("GetTemplateByScenarioAndOU", mock.Anything, ScenarioOTP, TemplateTypeSMS, "ou-1").
+		Return(nil, errTemplateNotFound)
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).Return(dto, nil)
+
+	res, err := suite.service.RenderLocalized(context.Background(), ScenarioOTP, TemplateTypeSMS, "ou-1", "de",
+		TemplateData{"otp": "42"})
+	suite.Nil(err)
+	suite.Equal("Code: 42", res.Body)
+}
+
+func (suite *TemplateServiceTestSuite) TestRender_FormatsPluralAndSelect() {
+	dto := &TemplateDTO{
+		ID:          "sms-otp",
+		IsReadOnly:  true,
+		Scenario:    ScenarioOTP,
+		Type:        TemplateTypeSMS,
+		ContentType: "text/plain",
+		Body: "Code: {{ctx(otp)}}. Expires in {expiryMinutes, plural, one {# minute} other {# minutes}}. " +
+			"{channel, select, sms {Sent by SMS} other {Sent}}.",
+	}
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).Return(dto, nil)
+
+	res, err := suite.service.Render(context.Background(), ScenarioOTP, TemplateTypeSMS,
+		TemplateData{"otp": "123", "expiryMinutes": "1", "channel": "sms"})
+	suite.Nil(err)
+	suite.Equal("Code: 123. Expires in 1 minute. Sent by SMS.", res.Body)
+}
+
+func (suite *TemplateServiceTestSuite) TestRenderLocalized_FormatsPluralForVariantLanguage() {
+	dto := &TemplateDTO{
+		ID:          "custom-1",
+		Scenario:    ScenarioOTP,
+		Type:        TemplateTypeSMS,
+		ContentType: "text/plain",
+	}
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).Return(dto, nil)
+	suite.mockI18n.On("ResolveTranslationsForKey", "pl", "template-custom-1", "body", i18nmgt.TranslationScope{}).
+		Return(&i18nmgt.TranslationResponse{
+			Value: "Kod wygasa za {n, plural, one {# minutę} few {# minuty} many {# minut} other {# minuty}}",
+		}, nil)
+	suite.mockI18n.On("ResolveTranslationsForKey", "pl", "template-custom-1", mock.Anything,
+		i18nmgt.TranslationScope{}).
+		Return(nil, &i18nmgt.ErrorTranslationNotFound)
+
+	res, err := suite.service.RenderLocalized(context.Background(), ScenarioOTP, TemplateTypeSMS, "", "pl",
+		TemplateData{"n": "5"})
+	suite.Nil(err)
+	suite.Equal("Kod wygasa za 5 minut", res.Body)
+}
+
+func (suite *TemplateServiceTestSuite) TestRender_MalformedMessageRendersUnformatted() {
+	dto := &TemplateDTO{
+		ID:          "sms-otp",
+		IsReadOnly:  true,
+		Scenario:    ScenarioOTP,
+		Type:        TemplateTypeSMS,
+		ContentType: "text/plain",
+		Body:        "Code: {{ctx(otp)}} {n, plural, one {# minute}}",
+	}
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).Return(dto, nil)
+
+	res, err := suite.service.Render(context.Background(), ScenarioOTP, TemplateTypeSMS,
+		TemplateData{"otp": "123", "n": "1"})
+	suite.Nil(err)
+	suite.Equal("Code: 123 {n, plural, one {# minute}}", res.Body)
+}
+
+func (suite *TemplateServiceTestSuite) TestRenderLocalized_OUStoreError() {
+	suite.mockStore.On("GetTemplateByScenarioAndOU", mock.Anything, ScenarioOTP, TemplateTypeSMS, "ou-1").
+		Return(nil, errors.New("db error"))
+
+	res, err := suite.service.RenderLocalized(context.Background(), ScenarioOTP, TemplateTypeSMS, "ou-1", "",
+		TemplateData{})
+	suite.Nil(res)
+	suite.Equal(&serviceerror.InternalServerError, err)
+}
+
+func (suite *TemplateServiceTestSuite) TestRender_CustomTemplateUsesSystemLanguage() {
+	dto := &TemplateDTO{
+		ID:          "custom-2",
+		Scenario:    ScenarioUserInvite,
+		Type:        TemplateTypeEmail,
+		ContentType: "text/html",
+	}
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioUserInvite, TemplateTypeEmail).
+		Return(dto, nil)
+	suite.mockI18n.On("ResolveTranslationsForKey", "en-US", "template-custom-2", "subject", i18nmgt.TranslationScope{}).
+		Return(&i18nmgt.TranslationResponse{Value: "Join {{ctx(appName)}}"}, nil)
+	suite.mockI18n.On("ResolveTranslationsForKey", "en-US", "template-custom-2", "body", i18nmgt.TranslationScope{}).
+		Return(&i18nmgt.TranslationResponse{Value: "<a href=\"{{ctx(inviteLink)}}\">Join</a>"}, nil)
+	suite.mockI18n.On("ResolveTranslationsForKey", "en-US", "template-custom-2", "textBody",
+		i18nmgt.TranslationScope{}).
+		Return(&i18nmgt.TranslationResponse{Value: "Join: {{ctx(inviteLink)}}"}, nil)
+
+	res, err := suite.service.Render(context.Background(), ScenarioUserInvite, TemplateTypeEmail,
+		TemplateData{"appName": "App", "inviteLink": "https://example.com"})
+	suite.Nil(err)
+	suite.Equal("Join App", res.Subject)
+	suite.Equal("<a href=\"https://example.com\">Join</a>", res.Body)
+	suite.Equal("Join: https://example.com", res.TextBody)
+	suite.True(res.IsHTML)
+}
+
+func (suite *TemplateServiceTestSuite) TestRender_CustomTemplateMissingBody() {
+	dto := &TemplateDTO{ID: "custom-3", Scenario: ScenarioOTP, Type: TemplateTypeSMS}
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).Return(dto, nil)
+	suite.mockI18n.On("ResolveTranslationsForKey", "en-US", "template-custom-3", mock.Anything,
+		i18nmgt.TranslationScope{}).
+		Return(nil, &i18nmgt.ErrorTranslationNotFound)
+
+	res, err := suite.service.Render(context.Background(), ScenarioOTP, TemplateTypeSMS, TemplateData{})
+	suite.Nil(res)
+	suite.Equal(&serviceerror.InternalServerError, err)
+}
+
+func (suite *TemplateServiceTestSuite) TestListTemplates() {
+	suite.mockStore.On("ListTemplates", mock.Anything).Return([]*TemplateDTO{
+		{ID: "custom-1"}, {ID: "sms-otp", IsReadOnly: true},
+	}, nil)
+
+	res, err := suite.service.ListTemplates(context.Background())
+	suite.Nil(err)
+	suite.Len(res, 2)
+	suite.True(res[1].IsReadOnly)
+}
+
+func (suite *TemplateServiceTestSuite) TestListTemplates_StoreError() {
+	suite.mockStore.On("ListTemplates", mock.Anything).Return(nil, errors.New("db error"))
+
+	res, err := suite.service.ListTemplates(context.Background())
+	suite.Nil(res)
+	suite.Equal(&serviceerror.InternalServerError, err)
+}
+
+func (suite *TemplateServiceTestSuite) TestGetTemplate_SystemTemplate() {
+	suite.mockStore.On("GetTemplate", mock.Anything, "sms-otp").Return(&TemplateDTO{
+		ID: "sms-otp", IsReadOnly: true, Type: TemplateTypeSMS, Body: "Code: {{ctx(otp)}}",
+	}, nil)
+
+	res, err := suite.service.GetTemplate(context.Background(), "sms-otp")
+	suite.Nil(err)
+	suite.Equal([]TemplateVariant{{Language: "en-US", Body: "Code: {{ctx(otp)}}"}}, res.Variants)
+}
+
+func (suite *TemplateServiceTestSuite) TestGetTemplate_CustomTemplate() {
+	suite.mockStore.On("GetTemplate", mock.Anything, "custom-1").Return(&TemplateDTO{
+		ID: "custom-1", Type: TemplateTypeEmail,
+	}, nil)
+	suite.mockI18n.On("GetTranslationsByNamespace", "template-custom-1").Return(map[string]map[string]string{
+		"subject": {"en-US": "Hello", "de": "Hallo"},
+		"body":    {"en-US": "Body", "de": "Inhalt"},
+	}, nil)
+
+	res, err := suite.service.GetTemplate(context.Background(), "custom-1")
+	suite.Nil(err)
+	suite.Equal([]TemplateVariant{
+		{Language: "en-US", Subject: "Hello", Body: "Body"},
+		{Language: "de", Subject: "Hallo", Body: "Inhalt"},
+	}, res.Variants)
+}
+
+func (suite *TemplateServiceTestSuite) TestGetTemplate_NotFound() {
+	suite.mockStore.On("GetTemplate", mock.Anything, "missing").Return(nil, errTemplateNotFound)
+
+	res, err := suite.service.GetTemplate(context.Background(), "missing")
+	suite.Nil(res)
+	suite.Equal(&ErrorTemplateNotFound, err)
+}
+
+func (suite *TemplateServiceTestSuite) TestGetTemplate_EmptyID() {
+	res, err := suite.service.GetTemplate(context.Background(), " ")
+	suite.Nil(res)
+	suite.Equal(&ErrorInvalidTemplateID, err)
+}
+
+func (suite *TemplateServiceTestSuite) TestCreateTemplate() {
+	tmpl := TemplateDTO{
+		DisplayName:  "Branded OTP",
+		Scenario:     ScenarioOTP,
+		Type:         TemplateTypeSMS,
+		OUID:         "ou-1",
+		Placeholders: []string{"otp"},
+		Variants: []TemplateVariant{
+			{Language: "en-us", Body: "Code {{ctx(otp)}}"},
+			{Language: "fr", Body: "Code {{ctx(otp)}}"},
+		},
+	}
+	suite.mockStore.On("GetTemplateByScenarioAndOU", mock.Anything, ScenarioOTP, TemplateTypeSMS, "ou-1").
+		Return(nil, errTemplateNotFound)
+	suite.mockStore.On("CreateTemplate", mock.Anything, mock.MatchedBy(func(dto TemplateDTO) bool {
+		return dto.ID != "" && dto.ContentType == "text/plain" && dto.OUID == "ou-1"
+	})).Return(nil)
+	suite.mockI18n.On("SetTranslationOverridesForNamespace", mock.Anything, mock.Anything,
+		map[string]map[string]string{"body": {"en-US": "Code {{ctx(otp)}}", "fr": "Code {{ctx(otp)}}"}}).
+		Return(nil)
+
+	res, err := suite.service.CreateTemplate(context.Background(), tmpl)
+	suite.Nil(err)
+	suite.NotEmpty(res.ID)
+	suite.Equal("en-US", res.Variants[0].Language)
+}
+
+func (suite *TemplateServiceTestSuite) TestCreateTemplate_Duplicate() {
+	tmpl := TemplateDTO{
+		DisplayName: "Invite",
+		Scenario:    ScenarioUserInvite,
+		Type:        TemplateTypeEmail,
+		Variants:    []TemplateVariant{{Language: "en-US", Subject: "Hi", Body: "Body"}},
+	}
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioUserInvite, TemplateTypeEmail).
+		Return(&TemplateDTO{ID: "existing"}, nil)
+
+	res, err := suite.service.CreateTemplate(context.Background(), tmpl)
+	suite.Nil(res)
+	suite.Equal(&ErrorDuplicateTemplate, err)
+}
+
+func (suite *TemplateServiceTestSuite) TestCreateTemplate_OverridesSystemTemplate() {
+	tmpl := TemplateDTO{
+		DisplayName: "Invite",
+		Scenario:    ScenarioUserInvite,
+		Type:        TemplateTypeEmail,
+		ContentType: "text/html",
+		Variants:    []TemplateVariant{{Language: "en-US", Subject: "Hi", Body: "<p>Body</p>"}},
+	}
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioUserInvite, TemplateTypeEmail).
+		Return(&TemplateDTO{ID: "user-invite", IsReadOnly: true}, nil)
+	suite.mockStore.On("CreateTemplate", mock.Anything, mock.Anything).Return(nil)
+	suite.mockI18n.On("SetTranslationOverridesForNamespace", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	res, err := suite.service.CreateTemplate(context.Background(), tmpl)
+	suite.Nil(err)
+	suite.NotNil(res)
+}
+
+func (suite *TemplateServiceTestSuite) TestCreateTemplate_VariantWriteFailureRemovesTemplate() {
+	tmpl := TemplateDTO{
+		DisplayName: "OTP",
+		Scenario:    ScenarioOTP,
+		Type:        TemplateTypeSMS,
+		Variants:    []TemplateVariant{{Language: "en-US", Body: "Code"}},
+	}
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).
+		Return(nil, errTemplateNotFound)
+	suite.mockStore.On("CreateTemplate", mock.Anything, mock.Anything).Return(nil)
+	suite.mockI18n.On("SetTranslationOverridesForNamespace", mock.Anything, mock.Anything, mock.Anything).
+		Return(&serviceerror.InternalServerError)
+	suite.mockStore.On("DeleteTemplate", mock.Anything, mock.Anything).Return(nil)
+
+	res, err := suite.service.CreateTemplate(context.Background(), tmpl)
+	suite.Nil(res)
+	suite.Equal(&serviceerror.InternalServerError, err)
+}
+
+func (suite *TemplateServiceTestSuite) TestCreateTemplate_ValidationErrors() {
+	valid := func() TemplateDTO {
+		return TemplateDTO{
+			DisplayName: "Invite",
+			Scenario:    ScenarioUserInvite,
+			Type:        TemplateTypeEmail,
+			Variants:    []TemplateVariant{{Language: "en-US", Subject: "Hi", Body: "Body"}},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(*TemplateDTO)
+		expected *serviceerror.ServiceError
+	}{
+		{"MissingDisplayName", func(t *TemplateDTO) { t.DisplayName = " " }, &ErrorInvalidDisplayName},
+		{"InvalidScenario", func(t *TemplateDTO) { t.Scenario = "UNKNOWN" }, &ErrorInvalidScenario},
+		{"InvalidType", func(t *TemplateDTO) { t.Type = "push" }, &ErrorInvalidTemplateType},
+		{"InvalidContentType", func(t *TemplateDTO) { t.ContentType = "application/json" }, &ErrorInvalidContentType},
+		{"HTMLForSMS", func(t *TemplateDTO) {
+			t.Type = TemplateTypeSMS
+			t.ContentType = "text/html"
+		}, &ErrorInvalidContentType},
+		{"NoVariants", func(t *TemplateDTO) { t.Variants = nil }, &ErrorMissingDefaultVariant},
+		{"NoDefaultVariant", func(t *TemplateDTO) { t.Variants[0].Language = "fr" }, &ErrorMissingDefaultVariant},
+		{"InvalidLanguage", func(t *TemplateDTO) { t.Variants[0].Language = "not a tag" },
+			&ErrorInvalidTemplateVariant},
+		{"DuplicateLanguage", func(t *TemplateDTO) {
+			t.Variants = append(t.Variants, TemplateVariant{Language: "en-us", Subject: "Hi", Body: "Body"})
+		}, &ErrorInvalidTemplateVariant},
+		{"MissingBody", func(t *TemplateDTO) { t.Variants[0].Body = "" }, &ErrorInvalidTemplateVariant},
+		{"MissingEmailSubject", func(t *TemplateDTO) { t.Variants[0].Subject = "" }, &ErrorInvalidTemplateVariant},
+		{"InvalidPlaceholder", func(t *TemplateDTO) { t.Placeholders = []string{"bad key"} },
+			&ErrorInvalidPlaceholder},
+		{"UndeclaredPlaceholder", func(t *TemplateDTO) {
+			t.Placeholders = []string{"appName"}
+			t.Variants[0].Body = "Link: {{ctx(inviteLink)}}"
+		}, &ErrorInvalidPlaceholder},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tmpl := valid()
+			tc.modify(&tmpl)
+
+			res, err := suite.service.CreateTemplate(context.Background(), tmpl)
+			suite.Nil(res)
+			suite.Equal(tc.expected, err)
+		})
+	}
+}
+
+func (suite *TemplateServiceTestSuite) TestUpdateTemplate() {
+	tmpl := TemplateDTO{
+		DisplayName: "OTP",
+		Scenario:    ScenarioOTP,
+		Type:        TemplateTypeSMS,
+		Variants:    []TemplateVariant{{Language: "en-US", Body: "New code"}},
+	}
+	suite.mockStore.On("GetTemplate", mock.Anything, "custom-1").Return(&TemplateDTO{ID: "custom-1"}, nil)
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioOTP, TemplateTypeSMS).
+		Return(&TemplateDTO{ID: "custom-1"}, nil)
+	suite.mockStore.On("UpdateTemplate", mock.Anything, mock.MatchedBy(func(dto TemplateDTO) bool {
+		return dto.ID == "custom-1"
+	})).Return(nil)
+	suite.mockI18n.On("GetTranslationsByNamespace", "template-custom-1").Return(map[string]map[string]string{
+		"body": {"en-US": "Old code", "fr": "Ancien code"},
+	}, nil)
+	suite.mockI18n.On("SetTranslationOverridesForNamespace", mock.Anything, "template-custom-1",
+		map[string]map[string]string{"body": {"en-US": "New code"}}).Return(nil)
+	suite.mockI18n.On("ClearTranslationOverrideForKey", "fr", "template-custom-1", "body",
+		i18nmgt.TranslationScope{}).Return(nil)
+
+	res, err := suite.service.UpdateTemplate(context.Background(), "custom-1", tmpl)
+	suite.Nil(err)
+	suite.Equal("custom-1", res.ID)
+}
+
+func (suite *TemplateServiceTestSuite) TestUpdateTemplate_SystemTemplateIsImmutable() {
+	tmpl := TemplateDTO{
+		DisplayName: "OTP",
+		Scenario:    ScenarioOTP,
+		Type:        TemplateTypeSMS,
+		Variants:    []TemplateVariant{{Language: "en-US", Body: "Code"}},
+	}
+	suite.mockStore.On("GetTemplate", mock.Anything, "sms-otp").
+		Return(&TemplateDTO{ID: "sms-otp", IsReadOnly: true}, nil)
+
+	res, err := suite.service.UpdateTemplate(context.Background(), "sms-otp", tmpl)
+	suite.Nil(res)
+	suite.Equal(&ErrorTemplateIsImmutable, err)
+}
+
+func (suite *TemplateServiceTestSuite) TestUpdateTemplate_NotFound() {
+	tmpl := TemplateDTO{
+		DisplayName: "OTP",
+		Scenario:    ScenarioOTP,
+		Type:        TemplateTypeSMS,
+		Variants:    []TemplateVariant{{Language: "en-US", Body: "Code"}},
+	}
+	suite.mockStore.On("GetTemplate", mock.Anything, "missing").Return(nil, errTemplateNotFound)
+
+	res, err := suite.service.UpdateTemplate(context.Background(), "missing", tmpl)
+	suite.Nil(res)
+	suite.Equal(&ErrorTemplateNotFound, err)
+}
+
+func (suite *TemplateServiceTestSuite) TestDeleteTemplate() {
+	suite.mockStore.On("GetTemplate", mock.Anything, "custom-1").Return(&TemplateDTO{ID: "custom-1"}, nil)
+	suite.mockStore.On("DeleteTemplate", mock.Anything, "custom-1").Return(nil)
+	suite.mockI18n.On("DeleteTranslationsByNamespace", mock.Anything, "template-custom-1").Return(nil)
+
+	err := suite.service.DeleteTemplate(context.Background(), "custom-1")
+	suite.Nil(err)
+}
+
+func (suite *TemplateServiceTestSuite) TestDeleteTemplate_NotFound() {
+	suite.mockStore.On("GetTemplate", mock.Anything, "missing").Return(nil, errTemplateNotFound)
+
+	err := suite.service.DeleteTemplate(context.Background(), "missing")
+	suite.Nil(err)
+}
+
+func (suite *TemplateServiceTestSuite) TestDeleteTemplate_SystemTemplateIsImmutable() {
+	suite.mockStore.On("GetTemplate", mock.Anything, "sms-otp").
+		Return(&TemplateDTO{ID: "sms-otp", IsReadOnly: true}, nil)
+
+	err := suite.service.DeleteTemplate(context.Background(), "sms-otp")
+	suite.Equal(&ErrorTemplateIsImmutable, err)
+}
@@ -18,7 +18,16 @@
 
 package template
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
 
 // templateStoreInterface defines the interface for template store operations.
 type templateStoreInterface interface {
@@ -26,5 +35,232 @@ type templateStoreInterface interface {
 
 	GetTemplateByScenario(ctx context.Context, scenario ScenarioType, tmplType TemplateType) (*TemplateDTO, error)
 
+	GetTemplateByScenarioAndOU(
+		ctx context.Context, scenario ScenarioType, tmplType TemplateType, ouID string) (*TemplateDTO, error)
+
 	ListTemplates(ctx context.Context) ([]*TemplateDTO, error)
+
+	CreateTemplate(ctx context.Context, tmpl TemplateDTO) error
+
+	UpdateTemplate(ctx context.Context, tmpl TemplateDTO) error
+
+	DeleteTemplate(ctx context.Context, id string) error
+}
+
+// templateStore is the database backed implementation of templateStoreInterface. It persists the
+// metadata of custom templates; their localized content is held by the i18n subsystem.
+type templateStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newTemplateStore creates a new database backed template store.
+func newTemplateStore() templateStoreInterface {
+	return &templateStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// getDBClient is a helper method to get the database client.
+func (s *templateStore) getDBClient() (provider.DBClientInterface, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	return dbClient, nil
+}
+
+// GetTemplate retrieves a custom template by its ID.
+func (s *templateStore) GetTemplate(ctx context.Context, id string) (*TemplateDTO, error) {
+	return s.getTemplate(ctx, queryGetTemplateByID, id, s.deploymentID)
+}
+
+// GetTemplateByScenario retrieves the custom template that applies to all organization units for the
+// specified scenario and template type.
+func (s *templateStore) GetTemplateByScenario(
+	ctx context.Context, scenario ScenarioType, tmplType TemplateType,
+) (*TemplateDTO, error) {
+	return s.getTemplate(ctx, queryGetTemplateByScenario, string(scenario), string(tmplType), s.deploymentID)
+}
+
+// GetTemplateByScenarioAndOU retrieves the custom template overriding the specified scenario and template
+// type for an organization unit.
+func (s *templateStore) GetTemplateByScenarioAndOU(
+	ctx context.Context, scenario ScenarioType, tmplType TemplateType, ouID string,
+) (*TemplateDTO, error) {
+	return s.getTemplate(ctx, queryGetTemplateByScenarioAndOU, string(scenario), string(tmplType), ouID,
+		s.deploymentID)
+}
+
+// ListTemplates retrieves all custom templates.
+func (s *templateStore) ListTemplates(ctx context.Context) ([]*TemplateDTO, error) {
+	dbClient, err := s.getDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetAllTemplates, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	templates := make([]*TemplateDTO, 0, len(results))
+	for _, row := range results {
+		tmpl, err := buildTemplateFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build template from result row: %w", err)
+		}
+		templates = append(templates, tmpl)
+	}
+
+	return templates, nil
+}
+
+// CreateTemplate persists a new custom template.
+func (s *templateStore) CreateTemplate(ctx context.Context, tmpl TemplateDTO) error {
+	dbClient, err := s.getDBClient()
+	if err != nil {
+		return err
+	}
+
+	placeholders, err := serializePlaceholders(tmpl.Placeholders)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateTemplate, tmpl.ID, tmpl.DisplayName, string(tmpl.Scenario),
+		string(tmpl.Type), dbutils.ToNullableString(tmpl.OUID), tmpl.ContentType, placeholders, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateTemplate updates an existing custom template.
+func (s *templateStore) UpdateTemplate(ctx context.Context, tmpl TemplateDTO) error {
+	dbClient, err := s.getDBClient()
+	if err != nil {
+		return err
+	}
+
+	placeholders, err := serializePlaceholders(tmpl.Placeholders)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryUpdateTemplate, tmpl.DisplayName,
+		string(tmpl.Scenario), string(tmpl.Type), dbutils.ToNullableString(tmpl.OUID), tmpl.ContentType, placeholders,
+		tmpl.ID, s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if rowsAffected == 0 {
+		return errTemplateNotFound
+	}
+
+	return nil
+}
+
+// DeleteTemplate deletes a custom template.
+func (s *templateStore) DeleteTemplate(ctx context.Context, id string) error {
+	dbClient, err := s.getDBClient()
+	if err != nil {
+		return err
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteTemplate, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute delete query: %w", err)
+	}
+
+	return nil
+}
+
+// getTemplate retrieves a single custom template using the given query and arguments.
+func (s *templateStore) getTemplate(
+	ctx context.Context, query dbmodel.DBQuery, args ...interface{},
+) (*TemplateDTO, error) {
+	dbClient, err := s.getDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, errTemplateNotFound
+	}
+	if len(results) > 1 {
+		return nil, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildTemplateFromResultRow(results[0])
+}
+
+// buildTemplateFromResultRow constructs a TemplateDTO from a database result row.
+func buildTemplateFromResultRow(row map[string]interface{}) (*TemplateDTO, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+
+	displayName, ok := row["display_name"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse display_name as string")
+	}
+
+	scenario, ok := row["scenario"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse scenario as string")
+	}
+
+	tmplType, ok := row["type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse type as string")
+	}
+
+	// OU ID and content type are optional and may be NULL.
+	ouID, _ := row["ou_id"].(string)
+	contentType, _ := row["content_type"].(string)
+
+	tmpl := &TemplateDTO{
+		ID:          id,
+		DisplayName: displayName,
+		Scenario:    ScenarioType(scenario),
+		Type:        TemplateType(tmplType),
+		OUID:        ouID,
+		ContentType: contentType,
+	}
+
+	var placeholdersJSON string
+	// Handle both string and []byte types for placeholders
+	switch v := row["placeholders"].(type) {
+	case string:
+		placeholdersJSON = v
+	case []byte:
+		placeholdersJSON = string(v)
+	}
+	if placeholdersJSON != "" {
+		if err := json.Unmarshal([]byte(placeholdersJSON), &tmpl.Placeholders); err != nil {
+			return nil, fmt.Errorf("failed to deserialize placeholders: %w", err)
+		}
+	}
+
+	return tmpl, nil
+}
+
+// serializePlaceholders serializes the template placeholders to a JSON array, returning nil when there
+// are none so that the column is stored as NULL.
+func serializePlaceholders(placeholders []string) (interface{}, error) {
+	if len(placeholders) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(placeholders)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize placeholders: %w", err)
+	}
+	return string(data), nil
 }
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateTemplate is the query to create a new custom template.
+	queryCreateTemplate = dbmodel.DBQuery{
+		ID: "TMQ-TM-01",
+		Query: `INSERT INTO "TEMPLATE" ` +
+			`(ID, DISPLAY_NAME, SCENARIO, TYPE, OU_ID, CONTENT_TYPE, PLACEHOLDERS, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+
+	// queryGetTemplateByID is the query to get a custom template by its ID.
+	queryGetTemplateByID = dbmodel.DBQuery{
+		ID: "TMQ-TM-02",
+		Query: `SELECT ID, DISPLAY_NAME, SCENARIO, TYPE, OU_ID, CONTENT_TYPE, PLACEHOLDERS ` +
+			`FROM "TEMPLATE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetTemplateByScenario is the query to get the custom template that applies to all OUs
+	// for a scenario and template type.
+	queryGetTemplateByScenario = dbmodel.DBQuery{
+		ID: "TMQ-TM-03",
+		Query: `SELECT ID, DISPLAY_NAME, SCENARIO, TYPE, OU_ID, CONTENT_TYPE, PLACEHOLDERS ` +
+			`FROM "TEMPLATE" WHERE SCENARIO = $1 AND TYPE = $2 AND OU_ID IS NULL AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryGetTemplateByScenarioAndOU is the query to get the custom template overriding a scenario
+	// and template type for an organization unit.
+	queryGetTemplateByScenarioAndOU = dbmodel.DBQuery{
+		ID: "TMQ-TM-04",
+		Query: `SELECT ID, DISPLAY_NAME, SCENARIO, TYPE, OU_ID, CONTENT_TYPE, PLACEHOLDERS ` +
+			`FROM "TEMPLATE" WHERE SCENARIO = $1 AND TYPE = $2 AND OU_ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryGetAllTemplates is the query to get all custom templates.
+	queryGetAllTemplates = dbmodel.DBQuery{
+		ID: "TMQ-TM-05",
+		Query: `SELECT ID, DISPLAY_NAME, SCENARIO, TYPE, OU_ID, CONTENT_TYPE, PLACEHOLDERS ` +
+			`FROM "TEMPLATE" WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryUpdateTemplate is the query to update a custom template.
+	queryUpdateTemplate = dbmodel.DBQuery{
+		ID: "TMQ-TM-06",
+		PostgresQuery: `UPDATE "TEMPLATE" SET DISPLAY_NAME = $1, SCENARIO = $2, TYPE = $3, OU_ID = $4, ` +
+			`CONTENT_TYPE = $5, PLACEHOLDERS = $6, UPDATED_AT = NOW() WHERE ID = $7 AND DEPLOYMENT_ID = $8`,
+		SQLiteQuery: `UPDATE "TEMPLATE" SET DISPLAY_NAME = $1, SCENARIO = $2, TYPE = $3, OU_ID = $4, ` +
+			`CONTENT_TYPE = $5, PLACEHOLDERS = $6, UPDATED_AT = datetime('now') ` +
+			`WHERE ID = $7 AND DEPLOYMENT_ID = $8`,
+		Query: `UPDATE "TEMPLATE" SET DISPLAY_NAME = $1, SCENARIO = $2, TYPE = $3, OU_ID = $4, ` +
+			`CONTENT_TYPE = $5, PLACEHOLDERS = $6, UPDATED_AT = datetime('now') ` +
+			`WHERE ID = $7 AND DEPLOYMENT_ID = $8`,
+	}
+
+	// queryDeleteTemplate is the query to delete a custom template.
+	queryDeleteTemplate = dbmodel.DBQuery{
+		ID:    "TMQ-TM-07",
+		Query: `DELETE FROM "TEMPLATE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package template
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type TemplateStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *templateStore
+}
+
+func TestTemplateStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplateStoreTestSuite))
+}
+
+func (suite *TemplateStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &templateStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (suite *TemplateStoreTestSuite) TestCreateTemplate() {
+	tmpl := TemplateDTO{
+		ID:           "tmpl-1",
+		DisplayName:  "OTP",
+		Scenario:     ScenarioOTP,
+		Type:         TemplateTypeSMS,
+		OUID:         "ou-1",
+		ContentType:  "text/plain",
+		Placeholders: []string{"otp"},
+	}
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateTemplate, "tmpl-1", "OTP",
+		"OTP", "sms", "ou-1", "text/plain", `["otp"]`, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.CreateTemplate(context.Background(), tmpl)
+	suite.NoError(err)
+}
+
+func (suite *TemplateStoreTestSuite) TestCreateTemplate_WithoutOUAndPlaceholders() {
+	tmpl := TemplateDTO{ID: "tmpl-1", DisplayName: "OTP", Scenario: ScenarioOTP, Type: TemplateTypeSMS}
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryCreateTemplate, "tmpl-1", "OTP",
+		"OTP", "sms", nil, "", nil, testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.CreateTemplate(context.Background(), tmpl)
+	suite.NoError(err)
+}
+
+func (suite *TemplateStoreTestSuite) TestCreateTemplate_DBClientError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(nil, errors.New("db err")).Once()
+
+	err := suite.store.CreateTemplate(context.Background(), TemplateDTO{ID: "tmpl-1"})
+	suite.Error(err)
+	suite.Contains(err.Error(), "failed to get database client")
+}
+
+func (suite *TemplateStoreTestSuite) TestGetTemplate() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetTemplateByID, "tmpl-1",
+		testDeploymentID).Return([]map[string]interface{}{{
+		"id":           "tmpl-1",
+		"display_name": "Invite",
+		"scenario":     "USER_INVITE",
+		"type":         "email",
+		"ou_id":        nil,
+		"content_type": "text/html",
+		"placeholders": []byte(`["inviteLink"]`),
+	}}, nil).Once()
+
+	tmpl, err := suite.store.GetTemplate(context.Background(), "tmpl-1")
+	suite.NoError(err)
+	suite.Equal(&TemplateDTO{
+		ID:           "tmpl-1",
+		DisplayName:  "Invite",
+		Scenario:     ScenarioUserInvite,
+		Type:         TemplateTypeEmail,
+		ContentType:  "text/html",
+		Placeholders: []string{"inviteLink"},
+	}, tmpl)
+}
+
+func (suite *TemplateStoreTestSuite) TestGetTemplate_NotFound() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetTemplateByID, "missing",
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	tmpl, err := suite.store.GetTemplate(context.Background(), "missing")
+	suite.Nil(tmpl)
+	suite.ErrorIs(err, errTemplateNotFound)
+}
+
+func (suite *TemplateStoreTestSuite) TestGetTemplate_InvalidRow() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetTemplateByID, "tmpl-1",
+		testDeploymentID).Return([]map[string]interface{}{{"id": "tmpl-1"}}, nil).Once()
+
+	tmpl, err := suite.store.GetTemplate(context.Background(), "tmpl-1")
+	suite.Nil(tmpl)
+	suite.Error(err)
+}
+
+func (suite *TemplateStoreTestSuite) TestGetTemplateByScenarioAndOU() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetTemplateByScenarioAndOU, "OTP", "sms",
+		"ou-1", testDeploymentID).Return([]map[string]interface{}{{
+		"id":           "tmpl-1",
+		"display_name": "OTP",
+		"scenario":     "OTP",
+		"type":         "sms",
+		"ou_id":        "ou-1",
+	}}, nil).Once()
+
+	tmpl, err := suite.store.GetTemplateByScenarioAndOU(context.Background(), ScenarioOTP, TemplateTypeSMS, "ou-1")
+	suite.NoError(err)
+	suite.Equal("ou-1", tmpl.OUID)
+}
+
+func (suite *TemplateStoreTestSuite) TestListTemplates() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().QueryContext(context.Background(), queryGetAllTemplates, testDeploymentID).
+		Return([]map[string]interface{}{
+			{"id": "tmpl-1", "display_name": "OTP", "scenario": "OTP", "type": "sms"},
+			{"id": "tmpl-2", "display_name": "Invite", "scenario": "USER_INVITE", "type": "email"},
+		}, nil).Once()
+
+	templates, err := suite.store.ListTemplates(context.Background())
+	suite.NoError(err)
+	suite.Len(templates, 2)
+}
+
+func (suite *TemplateStoreTestSuite) TestUpdateTemplate() {
+	tmpl := TemplateDTO{ID: "tmpl-1", DisplayName: "OTP", Scenario: ScenarioOTP, Type: TemplateTypeSMS}
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateTemplate, "OTP", "OTP", "sms",
+		nil, "", nil, "tmpl-1", testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.UpdateTemplate(context.Background(), tmpl)
+	suite.NoError(err)
+}
+
+func (suite *TemplateStoreTestSuite) TestUpdateTemplate_NotFound() {
+	tmpl := TemplateDTO{ID: "tmpl-1", DisplayName: "OTP", Scenario: ScenarioOTP, Type: TemplateTypeSMS}
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryUpdateTemplate, "OTP", "OTP", "sms",
+		nil, "", nil, "tmpl-1", testDeploymentID).Return(int64(0), nil).Once()
+
+	err := suite.store.UpdateTemplate(context.Background(), tmpl)
+	suite.ErrorIs(err, errTemplateNotFound)
+}
+
+func (suite *TemplateStoreTestSuite) TestDeleteTemplate() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteTemplate, "tmpl-1",
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	err := suite.store.DeleteTemplate(context.Background(), "tmpl-1")
+	suite.NoError(err)
+}
+
+func (suite *TemplateStoreTestSuite) TestDeleteTemplate_DBError() {
+	suite.mockDBProvider.EXPECT().GetConfigDBClient().Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.EXPECT().ExecuteContext(context.Background(), queryDeleteTemplate, "tmpl-1",
+		testDeploymentID).Return(int64(0), errors.New("db err")).Once()
+
+	err := suite.store.DeleteTemplate(context.Background(), "tmpl-1")
+	suite.Error(err)
+}
@@ -31,6 +31,7 @@ set -euo pipefail
 #   4. WEBAUTHN_SESSION
 #   5. ATTRIBUTE_CACHE
 #   6. PAR_REQUEST
+#   7. CIBA_REQUEST
 #
 # Usage examples:
 #   # SQLite (local development)
@@ -70,7 +71,7 @@ USERNAME=""
 PASSWORD=""
 
 # Tables to clean (order matters: FLOW_CONTEXT first for cascade).
-TABLES=("FLOW_CONTEXT" "AUTHORIZATION_CODE" "AUTHORIZATION_REQUEST" "WEBAUTHN_SESSION" "ATTRIBUTE_CACHE" "PAR_REQUEST")
+TABLES=("FLOW_CONTEXT" "AUTHORIZATION_CODE" "AUTHORIZATION_REQUEST" "WEBAUTHN_SESSION" "ATTRIBUTE_CACHE" "PAR_REQUEST" "CIBA_REQUEST")
 
 # Totals for summary.
 TOTAL_DELETED=0
@@ -117,8 +117,8 @@ func (_c *OTPAuthnServiceInterfaceMock_Authenticate_Call) RunAndReturn(run func(
 }
 
 // SendOTP provides a mock function for the type OTPAuthnServiceInterfaceMock
-func (_mock *OTPAuthnServiceInterfaceMock) SendOTP(ctx context.Context, senderID string, channel common.ChannelType, recipient string) (string, *serviceerror.ServiceError) {
-	ret := _mock.Called(ctx, senderID, channel, recipient)
+func (_mock *OTPAuthnServiceInterfaceMock) SendOTP(ctx context.Context, senderID string, channel common.ChannelType, recipient string, options common.OTPOptions) (string, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, senderID, channel, recipient, options)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SendOTP")
@@ -126,16 +126,16 @@ func (_mock *OTPAuthnServiceInterfaceMock) SendOTP(ctx context.Context, senderID
 
 	var r0 string
 	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, common.ChannelType, string) (string, *serviceerror.ServiceError)); ok {
-		return returnFunc(ctx, senderID, channel, recipient)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, common.ChannelType, string, common.OTPOptions) (string, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, senderID, channel, recipient, options)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, common.ChannelType, string) string); ok {
-		r0 = returnFunc(ctx, senderID, channel, recipient)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, common.ChannelType, string, common.OTPOptions) string); ok {
+		r0 = returnFunc(ctx, senderID, channel, recipient, options)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, common.ChannelType, string) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(ctx, senderID, channel, recipient)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, common.ChannelType, string, common.OTPOptions) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, senderID, channel, recipient, options)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*serviceerror.ServiceError)
@@ -154,11 +154,12 @@ type OTPAuthnServiceInterfaceMock_SendOTP_Call struct {
 //   - senderID string
 //   - channel common.ChannelType
 //   - recipient string
-func (_e *OTPAuthnServiceInterfaceMock_Expecter) SendOTP(ctx interface{}, senderID interface{}, channel interface{}, recipient interface{}) *OTPAuthnServiceInterfaceMock_SendOTP_Call {
-	return &OTPAuthnServiceInterfaceMock_SendOTP_Call{Call: _e.mock.On("SendOTP", ctx, senderID, channel, recipient)}
+//   - options common.OTPOptions
+func (_e *OTPAuthnServiceInterfaceMock_Expecter) SendOTP(ctx interface{}, senderID interface{}, channel interface{}, recipient interface{}, options interface{}) *OTPAuthnServiceInterfaceMock_SendOTP_Call {
+	return &OTPAuthnServiceInterfaceMock_SendOTP_Call{Call: _e.mock.On("SendOTP", ctx, senderID, channel, recipient, options)}
 }
 
-func (_c *OTPAuthnServiceInterfaceMock_SendOTP_Call) Run(run func(ctx context.Context, senderID string, channel common.ChannelType, recipient string)) *OTPAuthnServiceInterfaceMock_SendOTP_Call {
+func (_c *OTPAuthnServiceInterfaceMock_SendOTP_Call) Run(run func(ctx context.Context, senderID string, channel common.ChannelType, recipient string, options common.OTPOptions)) *OTPAuthnServiceInterfaceMock_SendOTP_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -176,11 +177,16 @@ func (_c *OTPAuthnServiceInterfaceMock_SendOTP_Call) Run(run func(ctx context.Co
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 common.OTPOptions
+		if args[4] != nil {
+			arg4 = args[4].(common.OTPOptions)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -191,7 +197,7 @@ func (_c *OTPAuthnServiceInterfaceMock_SendOTP_Call) Return(s string, serviceErr
 	return _c
 }
 
-func (_c *OTPAuthnServiceInterfaceMock_SendOTP_Call) RunAndReturn(run func(ctx context.Context, senderID string, channel common.ChannelType, recipient string) (string, *serviceerror.ServiceError)) *OTPAuthnServiceInterfaceMock_SendOTP_Call {
+func (_c *OTPAuthnServiceInterfaceMock_SendOTP_Call) RunAndReturn(run func(ctx context.Context, senderID string, channel common.ChannelType, recipient string, options common.OTPOptions) (string, *serviceerror.ServiceError)) *OTPAuthnServiceInterfaceMock_SendOTP_Call {
 	_c.Call.Return(run)
 	return _c
 }
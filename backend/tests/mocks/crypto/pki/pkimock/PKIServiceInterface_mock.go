@@ -6,6 +6,7 @@ package pkimock
 
 import (
 	"crypto"
+	"crypto/tls"
 	"crypto/x509"
 
 	mock "github.com/stretchr/testify/mock"
@@ -39,6 +40,63 @@ func (_m *PKIServiceInterfaceMock) EXPECT() *PKIServiceInterfaceMock_Expecter {
 	return &PKIServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// AddKey provides a mock function for the type PKIServiceInterfaceMock
+func (_mock *PKIServiceInterfaceMock) AddKey(id string, keyPair tls.Certificate) error {
+	ret := _mock.Called(id, keyPair)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, tls.Certificate) error); ok {
+		r0 = returnFunc(id, keyPair)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PKIServiceInterfaceMock_AddKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddKey'
+type PKIServiceInterfaceMock_AddKey_Call struct {
+	*mock.Call
+}
+
+// AddKey is a helper method to define mock.On call
+//   - id string
+//   - keyPair tls.Certificate
+func (_e *PKIServiceInterfaceMock_Expecter) AddKey(id interface{}, keyPair interface{}) *PKIServiceInterfaceMock_AddKey_Call {
+	return &PKIServiceInterfaceMock_AddKey_Call{Call: _e.mock.On("AddKey", id, keyPair)}
+}
+
+func (_c *PKIServiceInterfaceMock_AddKey_Call) Run(run func(id string, keyPair tls.Certificate)) *PKIServiceInterfaceMock_AddKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 tls.Certificate
+		if args[1] != nil {
+			arg1 = args[1].(tls.Certificate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_AddKey_Call) Return(err error) *PKIServiceInterfaceMock_AddKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_AddKey_Call) RunAndReturn(run func(id string, keyPair tls.Certificate) error) *PKIServiceInterfaceMock_AddKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllX509Certificates provides a mock function for the type PKIServiceInterfaceMock
 func (_mock *PKIServiceInterfaceMock) GetAllX509Certificates() (map[string]*x509.Certificate, *serviceerror.ServiceError) {
 	ret := _mock.Called()
@@ -147,6 +205,57 @@ func (_c *PKIServiceInterfaceMock_GetCertThumbprint_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetKeyIDByThumbprint provides a mock function for the type PKIServiceInterfaceMock
+func (_mock *PKIServiceInterfaceMock) GetKeyIDByThumbprint(thumbprint string) string {
+	ret := _mock.Called(thumbprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKeyIDByThumbprint")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(thumbprint)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKeyIDByThumbprint'
+type PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call struct {
+	*mock.Call
+}
+
+// GetKeyIDByThumbprint is a helper method to define mock.On call
+//   - thumbprint string
+func (_e *PKIServiceInterfaceMock_Expecter) GetKeyIDByThumbprint(thumbprint interface{}) *PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call {
+	return &PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call{Call: _e.mock.On("GetKeyIDByThumbprint", thumbprint)}
+}
+
+func (_c *PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call) Run(run func(thumbprint string)) *PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call) Return(s string) *PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call) RunAndReturn(run func(thumbprint string) string) *PKIServiceInterfaceMock_GetKeyIDByThumbprint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPrivateKey provides a mock function for the type PKIServiceInterfaceMock
 func (_mock *PKIServiceInterfaceMock) GetPrivateKey(id string) (crypto.PrivateKey, *serviceerror.ServiceError) {
 	ret := _mock.Called(id)
@@ -211,6 +320,50 @@ func (_c *PKIServiceInterfaceMock_GetPrivateKey_Call) RunAndReturn(run func(id s
 	return _c
 }
 
+// GetSigningKeyID provides a mock function for the type PKIServiceInterfaceMock
+func (_mock *PKIServiceInterfaceMock) GetSigningKeyID() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSigningKeyID")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// PKIServiceInterfaceMock_GetSigningKeyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSigningKeyID'
+type PKIServiceInterfaceMock_GetSigningKeyID_Call struct {
+	*mock.Call
+}
+
+// GetSigningKeyID is a helper method to define mock.On call
+func (_e *PKIServiceInterfaceMock_Expecter) GetSigningKeyID() *PKIServiceInterfaceMock_GetSigningKeyID_Call {
+	return &PKIServiceInterfaceMock_GetSigningKeyID_Call{Call: _e.mock.On("GetSigningKeyID")}
+}
+
+func (_c *PKIServiceInterfaceMock_GetSigningKeyID_Call) Run(run func()) *PKIServiceInterfaceMock_GetSigningKeyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_GetSigningKeyID_Call) Return(s string) *PKIServiceInterfaceMock_GetSigningKeyID_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_GetSigningKeyID_Call) RunAndReturn(run func() string) *PKIServiceInterfaceMock_GetSigningKeyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSupportedSigningAlgorithms provides a mock function for the type PKIServiceInterfaceMock
 func (_mock *PKIServiceInterfaceMock) GetSupportedSigningAlgorithms() []string {
 	ret := _mock.Called()
@@ -320,3 +473,105 @@ func (_c *PKIServiceInterfaceMock_GetX509Certificate_Call) RunAndReturn(run func
 	_c.Call.Return(run)
 	return _c
 }
+
+// RemoveKey provides a mock function for the type PKIServiceInterfaceMock
+func (_mock *PKIServiceInterfaceMock) RemoveKey(id string) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PKIServiceInterfaceMock_RemoveKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveKey'
+type PKIServiceInterfaceMock_RemoveKey_Call struct {
+	*mock.Call
+}
+
+// RemoveKey is a helper method to define mock.On call
+//   - id string
+func (_e *PKIServiceInterfaceMock_Expecter) RemoveKey(id interface{}) *PKIServiceInterfaceMock_RemoveKey_Call {
+	return &PKIServiceInterfaceMock_RemoveKey_Call{Call: _e.mock.On("RemoveKey", id)}
+}
+
+func (_c *PKIServiceInterfaceMock_RemoveKey_Call) Run(run func(id string)) *PKIServiceInterfaceMock_RemoveKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_RemoveKey_Call) Return(err error) *PKIServiceInterfaceMock_RemoveKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_RemoveKey_Call) RunAndReturn(run func(id string) error) *PKIServiceInterfaceMock_RemoveKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSigningKeyID provides a mock function for the type PKIServiceInterfaceMock
+func (_mock *PKIServiceInterfaceMock) SetSigningKeyID(id string) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSigningKeyID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PKIServiceInterfaceMock_SetSigningKeyID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSigningKeyID'
+type PKIServiceInterfaceMock_SetSigningKeyID_Call struct {
+	*mock.Call
+}
+
+// SetSigningKeyID is a helper method to define mock.On call
+//   - id string
+func (_e *PKIServiceInterfaceMock_Expecter) SetSigningKeyID(id interface{}) *PKIServiceInterfaceMock_SetSigningKeyID_Call {
+	return &PKIServiceInterfaceMock_SetSigningKeyID_Call{Call: _e.mock.On("SetSigningKeyID", id)}
+}
+
+func (_c *PKIServiceInterfaceMock_SetSigningKeyID_Call) Run(run func(id string)) *PKIServiceInterfaceMock_SetSigningKeyID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_SetSigningKeyID_Call) Return(err error) *PKIServiceInterfaceMock_SetSigningKeyID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PKIServiceInterfaceMock_SetSigningKeyID_Call) RunAndReturn(run func(id string) error) *PKIServiceInterfaceMock_SetSigningKeyID_Call {
+	_c.Call.Return(run)
+	return _c
+}
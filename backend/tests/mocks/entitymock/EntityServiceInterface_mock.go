@@ -7,6 +7,7 @@ package entitymock
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/thunder-id/thunderid/internal/entity"
@@ -39,6 +40,92 @@ func (_m *EntityServiceInterfaceMock) EXPECT() *EntityServiceInterfaceMock_Expec
 	return &EntityServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// AddSystemCredential provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) AddSystemCredential(ctx context.Context, entityID string, credType string, value string, expiresAt *time.Time) (*entity.StoredCredential, error) {
+	ret := _mock.Called(ctx, entityID, credType, value, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSystemCredential")
+	}
+
+	var r0 *entity.StoredCredential
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, *time.Time) (*entity.StoredCredential, error)); ok {
+		return returnFunc(ctx, entityID, credType, value, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, *time.Time) *entity.StoredCredential); ok {
+		r0 = returnFunc(ctx, entityID, credType, value, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.StoredCredential)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, *time.Time) error); ok {
+		r1 = returnFunc(ctx, entityID, credType, value, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_AddSystemCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSystemCredential'
+type EntityServiceInterfaceMock_AddSystemCredential_Call struct {
+	*mock.Call
+}
+
+// AddSystemCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - credType string
+//   - value string
+//   - expiresAt *time.Time
+func (_e *EntityServiceInterfaceMock_Expecter) AddSystemCredential(ctx interface{}, entityID interface{}, credType interface{}, value interface{}, expiresAt interface{}) *EntityServiceInterfaceMock_AddSystemCredential_Call {
+	return &EntityServiceInterfaceMock_AddSystemCredential_Call{Call: _e.mock.On("AddSystemCredential", ctx, entityID, credType, value, expiresAt)}
+}
+
+func (_c *EntityServiceInterfaceMock_AddSystemCredential_Call) Run(run func(ctx context.Context, entityID string, credType string, value string, expiresAt *time.Time)) *EntityServiceInterfaceMock_AddSystemCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 *time.Time
+		if args[4] != nil {
+			arg4 = args[4].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_AddSystemCredential_Call) Return(storedCredential *entity.StoredCredential, err error) *EntityServiceInterfaceMock_AddSystemCredential_Call {
+	_c.Call.Return(storedCredential, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_AddSystemCredential_Call) RunAndReturn(run func(ctx context.Context, entityID string, credType string, value string, expiresAt *time.Time) (*entity.StoredCredential, error)) *EntityServiceInterfaceMock_AddSystemCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AuthenticateEntity provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) AuthenticateEntity(ctx context.Context, identifiers map[string]interface{}, credentials map[string]interface{}) (*entity.AuthenticateResult, error) {
 	ret := _mock.Called(ctx, identifiers, credentials)
@@ -318,6 +405,75 @@ func (_c *EntityServiceInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// DeleteSystemCredential provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) DeleteSystemCredential(ctx context.Context, entityID string, credType string, credentialID string) error {
+	ret := _mock.Called(ctx, entityID, credType, credentialID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSystemCredential")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, entityID, credType, credentialID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EntityServiceInterfaceMock_DeleteSystemCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSystemCredential'
+type EntityServiceInterfaceMock_DeleteSystemCredential_Call struct {
+	*mock.Call
+}
+
+// DeleteSystemCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - credType string
+//   - credentialID string
+func (_e *EntityServiceInterfaceMock_Expecter) DeleteSystemCredential(ctx interface{}, entityID interface{}, credType interface{}, credentialID interface{}) *EntityServiceInterfaceMock_DeleteSystemCredential_Call {
+	return &EntityServiceInterfaceMock_DeleteSystemCredential_Call{Call: _e.mock.On("DeleteSystemCredential", ctx, entityID, credType, credentialID)}
+}
+
+func (_c *EntityServiceInterfaceMock_DeleteSystemCredential_Call) Run(run func(ctx context.Context, entityID string, credType string, credentialID string)) *EntityServiceInterfaceMock_DeleteSystemCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_DeleteSystemCredential_Call) Return(err error) *EntityServiceInterfaceMock_DeleteSystemCredential_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_DeleteSystemCredential_Call) RunAndReturn(run func(ctx context.Context, entityID string, credType string, credentialID string) error) *EntityServiceInterfaceMock_DeleteSystemCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCredentialsByType provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) GetCredentialsByType(ctx context.Context, entityID string, credType string) ([]entity.StoredCredential, error) {
 	ret := _mock.Called(ctx, entityID, credType)
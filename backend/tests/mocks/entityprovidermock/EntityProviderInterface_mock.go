@@ -6,6 +6,7 @@ package entityprovidermock
 
 import (
 	"encoding/json"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
@@ -38,6 +39,88 @@ func (_m *EntityProviderInterfaceMock) EXPECT() *EntityProviderInterfaceMock_Exp
 	return &EntityProviderInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// AddSystemCredential provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) AddSystemCredential(entityID string, credType string, value string, expiresAt *time.Time) (*entityprovider.CredentialMetadata, *entityprovider.EntityProviderError) {
+	ret := _mock.Called(entityID, credType, value, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSystemCredential")
+	}
+
+	var r0 *entityprovider.CredentialMetadata
+	var r1 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, *time.Time) (*entityprovider.CredentialMetadata, *entityprovider.EntityProviderError)); ok {
+		return returnFunc(entityID, credType, value, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, *time.Time) *entityprovider.CredentialMetadata); ok {
+		r0 = returnFunc(entityID, credType, value, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entityprovider.CredentialMetadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string, *time.Time) *entityprovider.EntityProviderError); ok {
+		r1 = returnFunc(entityID, credType, value, expiresAt)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityProviderInterfaceMock_AddSystemCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSystemCredential'
+type EntityProviderInterfaceMock_AddSystemCredential_Call struct {
+	*mock.Call
+}
+
+// AddSystemCredential is a helper method to define mock.On call
+//   - entityID string
+//   - credType string
+//   - value string
+//   - expiresAt *time.Time
+func (_e *EntityProviderInterfaceMock_Expecter) AddSystemCredential(entityID interface{}, credType interface{}, value interface{}, expiresAt interface{}) *EntityProviderInterfaceMock_AddSystemCredential_Call {
+	return &EntityProviderInterfaceMock_AddSystemCredential_Call{Call: _e.mock.On("AddSystemCredential", entityID, credType, value, expiresAt)}
+}
+
+func (_c *EntityProviderInterfaceMock_AddSystemCredential_Call) Run(run func(entityID string, credType string, value string, expiresAt *time.Time)) *EntityProviderInterfaceMock_AddSystemCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *time.Time
+		if args[3] != nil {
+			arg3 = args[3].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_AddSystemCredential_Call) Return(credentialMetadata *entityprovider.CredentialMetadata, entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_AddSystemCredential_Call {
+	_c.Call.Return(credentialMetadata, entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_AddSystemCredential_Call) RunAndReturn(run func(entityID string, credType string, value string, expiresAt *time.Time) (*entityprovider.CredentialMetadata, *entityprovider.EntityProviderError)) *EntityProviderInterfaceMock_AddSystemCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateEntity provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) CreateEntity(entity *entityprovider.Entity, systemCredentials json.RawMessage) (*entityprovider.Entity, *entityprovider.EntityProviderError) {
 	ret := _mock.Called(entity, systemCredentials)
@@ -161,6 +244,71 @@ func (_c *EntityProviderInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(e
 	return _c
 }
 
+// DeleteSystemCredential provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) DeleteSystemCredential(entityID string, credType string, credentialID string) *entityprovider.EntityProviderError {
+	ret := _mock.Called(entityID, credType, credentialID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSystemCredential")
+	}
+
+	var r0 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) *entityprovider.EntityProviderError); ok {
+		r0 = returnFunc(entityID, credType, credentialID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0
+}
+
+// EntityProviderInterfaceMock_DeleteSystemCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSystemCredential'
+type EntityProviderInterfaceMock_DeleteSystemCredential_Call struct {
+	*mock.Call
+}
+
+// DeleteSystemCredential is a helper method to define mock.On call
+//   - entityID string
+//   - credType string
+//   - credentialID string
+func (_e *EntityProviderInterfaceMock_Expecter) DeleteSystemCredential(entityID interface{}, credType interface{}, credentialID interface{}) *EntityProviderInterfaceMock_DeleteSystemCredential_Call {
+	return &EntityProviderInterfaceMock_DeleteSystemCredential_Call{Call: _e.mock.On("DeleteSystemCredential", entityID, credType, credentialID)}
+}
+
+func (_c *EntityProviderInterfaceMock_DeleteSystemCredential_Call) Run(run func(entityID string, credType string, credentialID string)) *EntityProviderInterfaceMock_DeleteSystemCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_DeleteSystemCredential_Call) Return(entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_DeleteSystemCredential_Call {
+	_c.Call.Return(entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_DeleteSystemCredential_Call) RunAndReturn(run func(entityID string, credType string, credentialID string) *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_DeleteSystemCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntitiesByIDs provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) GetEntitiesByIDs(entityIDs []string) ([]entityprovider.Entity, *entityprovider.EntityProviderError) {
 	ret := _mock.Called(entityIDs)
@@ -439,6 +587,76 @@ func (_c *EntityProviderInterfaceMock_GetEntityListCount_Call) RunAndReturn(run
 	return _c
 }
 
+// GetSystemCredentials provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) GetSystemCredentials(entityID string, credType string) ([]entityprovider.CredentialMetadata, *entityprovider.EntityProviderError) {
+	ret := _mock.Called(entityID, credType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystemCredentials")
+	}
+
+	var r0 []entityprovider.CredentialMetadata
+	var r1 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string, string) ([]entityprovider.CredentialMetadata, *entityprovider.EntityProviderError)); ok {
+		return returnFunc(entityID, credType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) []entityprovider.CredentialMetadata); ok {
+		r0 = returnFunc(entityID, credType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entityprovider.CredentialMetadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) *entityprovider.EntityProviderError); ok {
+		r1 = returnFunc(entityID, credType)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityProviderInterfaceMock_GetSystemCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSystemCredentials'
+type EntityProviderInterfaceMock_GetSystemCredentials_Call struct {
+	*mock.Call
+}
+
+// GetSystemCredentials is a helper method to define mock.On call
+//   - entityID string
+//   - credType string
+func (_e *EntityProviderInterfaceMock_Expecter) GetSystemCredentials(entityID interface{}, credType interface{}) *EntityProviderInterfaceMock_GetSystemCredentials_Call {
+	return &EntityProviderInterfaceMock_GetSystemCredentials_Call{Call: _e.mock.On("GetSystemCredentials", entityID, credType)}
+}
+
+func (_c *EntityProviderInterfaceMock_GetSystemCredentials_Call) Run(run func(entityID string, credType string)) *EntityProviderInterfaceMock_GetSystemCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetSystemCredentials_Call) Return(credentialMetadatas []entityprovider.CredentialMetadata, entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_GetSystemCredentials_Call {
+	_c.Call.Return(credentialMetadatas, entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetSystemCredentials_Call) RunAndReturn(run func(entityID string, credType string) ([]entityprovider.CredentialMetadata, *entityprovider.EntityProviderError)) *EntityProviderInterfaceMock_GetSystemCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTransitiveEntityGroups provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) GetTransitiveEntityGroups(entityID string) ([]entityprovider.EntityGroup, *entityprovider.EntityProviderError) {
 	ret := _mock.Called(entityID)
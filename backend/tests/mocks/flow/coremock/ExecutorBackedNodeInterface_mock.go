@@ -454,6 +454,50 @@ func (_c *ExecutorBackedNodeInterfaceMock_GetInputs_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetMaxAttempts provides a mock function for the type ExecutorBackedNodeInterfaceMock
+func (_mock *ExecutorBackedNodeInterfaceMock) GetMaxAttempts() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMaxAttempts")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMaxAttempts'
+type ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call struct {
+	*mock.Call
+}
+
+// GetMaxAttempts is a helper method to define mock.On call
+func (_e *ExecutorBackedNodeInterfaceMock_Expecter) GetMaxAttempts() *ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call {
+	return &ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call{Call: _e.mock.On("GetMaxAttempts")}
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call) Run(run func()) *ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call) Return(n int) *ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call) RunAndReturn(run func() int) *ExecutorBackedNodeInterfaceMock_GetMaxAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMode provides a mock function for the type ExecutorBackedNodeInterfaceMock
 func (_mock *ExecutorBackedNodeInterfaceMock) GetMode() string {
 	ret := _mock.Called()
@@ -1206,6 +1250,46 @@ func (_c *ExecutorBackedNodeInterfaceMock_SetInputs_Call) RunAndReturn(run func(
 	return _c
 }
 
+// SetMaxAttempts provides a mock function for the type ExecutorBackedNodeInterfaceMock
+func (_mock *ExecutorBackedNodeInterfaceMock) SetMaxAttempts(maxAttempts int) {
+	_mock.Called(maxAttempts)
+	return
+}
+
+// ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMaxAttempts'
+type ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call struct {
+	*mock.Call
+}
+
+// SetMaxAttempts is a helper method to define mock.On call
+//   - maxAttempts int
+func (_e *ExecutorBackedNodeInterfaceMock_Expecter) SetMaxAttempts(maxAttempts interface{}) *ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call {
+	return &ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call{Call: _e.mock.On("SetMaxAttempts", maxAttempts)}
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call) Run(run func(maxAttempts int)) *ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call) Return() *ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call) RunAndReturn(run func(maxAttempts int)) *ExecutorBackedNodeInterfaceMock_SetMaxAttempts_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetMode provides a mock function for the type ExecutorBackedNodeInterfaceMock
 func (_mock *ExecutorBackedNodeInterfaceMock) SetMode(mode string) {
 	_mock.Called(mode)
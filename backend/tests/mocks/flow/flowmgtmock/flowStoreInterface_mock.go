@@ -170,6 +170,63 @@ func (_c *flowStoreInterfaceMock_DeleteFlow_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// DeleteFlowDraft provides a mock function for the type flowStoreInterfaceMock
+func (_mock *flowStoreInterfaceMock) DeleteFlowDraft(ctx context.Context, flowID string) error {
+	ret := _mock.Called(ctx, flowID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFlowDraft")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, flowID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// flowStoreInterfaceMock_DeleteFlowDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFlowDraft'
+type flowStoreInterfaceMock_DeleteFlowDraft_Call struct {
+	*mock.Call
+}
+
+// DeleteFlowDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowID string
+func (_e *flowStoreInterfaceMock_Expecter) DeleteFlowDraft(ctx interface{}, flowID interface{}) *flowStoreInterfaceMock_DeleteFlowDraft_Call {
+	return &flowStoreInterfaceMock_DeleteFlowDraft_Call{Call: _e.mock.On("DeleteFlowDraft", ctx, flowID)}
+}
+
+func (_c *flowStoreInterfaceMock_DeleteFlowDraft_Call) Run(run func(ctx context.Context, flowID string)) *flowStoreInterfaceMock_DeleteFlowDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *flowStoreInterfaceMock_DeleteFlowDraft_Call) Return(err error) *flowStoreInterfaceMock_DeleteFlowDraft_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *flowStoreInterfaceMock_DeleteFlowDraft_Call) RunAndReturn(run func(ctx context.Context, flowID string) error) *flowStoreInterfaceMock_DeleteFlowDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlowByHandle provides a mock function for the type flowStoreInterfaceMock
 func (_mock *flowStoreInterfaceMock) GetFlowByHandle(ctx context.Context, handle string, flowType common.FlowType) (*flowmgt.CompleteFlowDefinition, error) {
 	ret := _mock.Called(ctx, handle, flowType)
@@ -312,6 +369,74 @@ func (_c *flowStoreInterfaceMock_GetFlowByID_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetFlowDraft provides a mock function for the type flowStoreInterfaceMock
+func (_mock *flowStoreInterfaceMock) GetFlowDraft(ctx context.Context, flowID string) (*flowmgt.FlowDraft, error) {
+	ret := _mock.Called(ctx, flowID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlowDraft")
+	}
+
+	var r0 *flowmgt.FlowDraft
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*flowmgt.FlowDraft, error)); ok {
+		return returnFunc(ctx, flowID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *flowmgt.FlowDraft); ok {
+		r0 = returnFunc(ctx, flowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flowmgt.FlowDraft)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, flowID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// flowStoreInterfaceMock_GetFlowDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlowDraft'
+type flowStoreInterfaceMock_GetFlowDraft_Call struct {
+	*mock.Call
+}
+
+// GetFlowDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowID string
+func (_e *flowStoreInterfaceMock_Expecter) GetFlowDraft(ctx interface{}, flowID interface{}) *flowStoreInterfaceMock_GetFlowDraft_Call {
+	return &flowStoreInterfaceMock_GetFlowDraft_Call{Call: _e.mock.On("GetFlowDraft", ctx, flowID)}
+}
+
+func (_c *flowStoreInterfaceMock_GetFlowDraft_Call) Run(run func(ctx context.Context, flowID string)) *flowStoreInterfaceMock_GetFlowDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *flowStoreInterfaceMock_GetFlowDraft_Call) Return(flowDraft *flowmgt.FlowDraft, err error) *flowStoreInterfaceMock_GetFlowDraft_Call {
+	_c.Call.Return(flowDraft, err)
+	return _c
+}
+
+func (_c *flowStoreInterfaceMock_GetFlowDraft_Call) RunAndReturn(run func(ctx context.Context, flowID string) (*flowmgt.FlowDraft, error)) *flowStoreInterfaceMock_GetFlowDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlowVersion provides a mock function for the type flowStoreInterfaceMock
 func (_mock *flowStoreInterfaceMock) GetFlowVersion(ctx context.Context, flowID string, version int) (*flowmgt.FlowVersion, error) {
 	ret := _mock.Called(ctx, flowID, version)
@@ -686,6 +811,86 @@ func (_c *flowStoreInterfaceMock_RestoreFlowVersion_Call) RunAndReturn(run func(
 	return _c
 }
 
+// SaveFlowDraft provides a mock function for the type flowStoreInterfaceMock
+func (_mock *flowStoreInterfaceMock) SaveFlowDraft(ctx context.Context, flowID string, baseVersion int, flow *flowmgt.FlowDefinition) (*flowmgt.FlowDraft, error) {
+	ret := _mock.Called(ctx, flowID, baseVersion, flow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveFlowDraft")
+	}
+
+	var r0 *flowmgt.FlowDraft
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, *flowmgt.FlowDefinition) (*flowmgt.FlowDraft, error)); ok {
+		return returnFunc(ctx, flowID, baseVersion, flow)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, *flowmgt.FlowDefinition) *flowmgt.FlowDraft); ok {
+		r0 = returnFunc(ctx, flowID, baseVersion, flow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flowmgt.FlowDraft)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, *flowmgt.FlowDefinition) error); ok {
+		r1 = returnFunc(ctx, flowID, baseVersion, flow)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// flowStoreInterfaceMock_SaveFlowDraft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveFlowDraft'
+type flowStoreInterfaceMock_SaveFlowDraft_Call struct {
+	*mock.Call
+}
+
+// SaveFlowDraft is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flowID string
+//   - baseVersion int
+//   - flow *flowmgt.FlowDefinition
+func (_e *flowStoreInterfaceMock_Expecter) SaveFlowDraft(ctx interface{}, flowID interface{}, baseVersion interface{}, flow interface{}) *flowStoreInterfaceMock_SaveFlowDraft_Call {
+	return &flowStoreInterfaceMock_SaveFlowDraft_Call{Call: _e.mock.On("SaveFlowDraft", ctx, flowID, baseVersion, flow)}
+}
+
+func (_c *flowStoreInterfaceMock_SaveFlowDraft_Call) Run(run func(ctx context.Context, flowID string, baseVersion int, flow *flowmgt.FlowDefinition)) *flowStoreInterfaceMock_SaveFlowDraft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 *flowmgt.FlowDefinition
+		if args[3] != nil {
+			arg3 = args[3].(*flowmgt.FlowDefinition)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *flowStoreInterfaceMock_SaveFlowDraft_Call) Return(flowDraft *flowmgt.FlowDraft, err error) *flowStoreInterfaceMock_SaveFlowDraft_Call {
+	_c.Call.Return(flowDraft, err)
+	return _c
+}
+
+func (_c *flowStoreInterfaceMock_SaveFlowDraft_Call) RunAndReturn(run func(ctx context.Context, flowID string, baseVersion int, flow *flowmgt.FlowDefinition) (*flowmgt.FlowDraft, error)) *flowStoreInterfaceMock_SaveFlowDraft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateFlow provides a mock function for the type flowStoreInterfaceMock
 func (_mock *flowStoreInterfaceMock) UpdateFlow(ctx context.Context, flowID string, flow *flowmgt.FlowDefinition) (*flowmgt.CompleteFlowDefinition, error) {
 	ret := _mock.Called(ctx, flowID, flow)
@@ -40,6 +40,70 @@ func (_m *graphBuilderInterfaceMock) EXPECT() *graphBuilderInterfaceMock_Expecte
 	return &graphBuilderInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// BuildGraph provides a mock function for the type graphBuilderInterfaceMock
+func (_mock *graphBuilderInterfaceMock) BuildGraph(flow *flowmgt.CompleteFlowDefinition) (core.GraphInterface, *serviceerror.ServiceError) {
+	ret := _mock.Called(flow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildGraph")
+	}
+
+	var r0 core.GraphInterface
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(*flowmgt.CompleteFlowDefinition) (core.GraphInterface, *serviceerror.ServiceError)); ok {
+		return returnFunc(flow)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*flowmgt.CompleteFlowDefinition) core.GraphInterface); ok {
+		r0 = returnFunc(flow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.GraphInterface)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*flowmgt.CompleteFlowDefinition) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(flow)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// graphBuilderInterfaceMock_BuildGraph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildGraph'
+type graphBuilderInterfaceMock_BuildGraph_Call struct {
+	*mock.Call
+}
+
+// BuildGraph is a helper method to define mock.On call
+//   - flow *flowmgt.CompleteFlowDefinition
+func (_e *graphBuilderInterfaceMock_Expecter) BuildGraph(flow interface{}) *graphBuilderInterfaceMock_BuildGraph_Call {
+	return &graphBuilderInterfaceMock_BuildGraph_Call{Call: _e.mock.On("BuildGraph", flow)}
+}
+
+func (_c *graphBuilderInterfaceMock_BuildGraph_Call) Run(run func(flow *flowmgt.CompleteFlowDefinition)) *graphBuilderInterfaceMock_BuildGraph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *flowmgt.CompleteFlowDefinition
+		if args[0] != nil {
+			arg0 = args[0].(*flowmgt.CompleteFlowDefinition)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *graphBuilderInterfaceMock_BuildGraph_Call) Return(graphInterface core.GraphInterface, serviceError *serviceerror.ServiceError) *graphBuilderInterfaceMock_BuildGraph_Call {
+	_c.Call.Return(graphInterface, serviceError)
+	return _c
+}
+
+func (_c *graphBuilderInterfaceMock_BuildGraph_Call) RunAndReturn(run func(flow *flowmgt.CompleteFlowDefinition) (core.GraphInterface, *serviceerror.ServiceError)) *graphBuilderInterfaceMock_BuildGraph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGraph provides a mock function for the type graphBuilderInterfaceMock
 func (_mock *graphBuilderInterfaceMock) GetGraph(ctx context.Context, flow *flowmgt.CompleteFlowDefinition) (core.GraphInterface, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, flow)
@@ -101,6 +101,81 @@ func (_c *i18nStoreInterfaceMock_DeleteTranslation_Call) RunAndReturn(run func(l
 	return _c
 }
 
+// DeleteTranslationOverride provides a mock function for the type i18nStoreInterfaceMock
+func (_mock *i18nStoreInterfaceMock) DeleteTranslationOverride(scopeType string, scopeID string, language string, key string, namespace string) error {
+	ret := _mock.Called(scopeType, scopeID, language, key, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTranslationOverride")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, string) error); ok {
+		r0 = returnFunc(scopeType, scopeID, language, key, namespace)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// i18nStoreInterfaceMock_DeleteTranslationOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTranslationOverride'
+type i18nStoreInterfaceMock_DeleteTranslationOverride_Call struct {
+	*mock.Call
+}
+
+// DeleteTranslationOverride is a helper method to define mock.On call
+//   - scopeType string
+//   - scopeID string
+//   - language string
+//   - key string
+//   - namespace string
+func (_e *i18nStoreInterfaceMock_Expecter) DeleteTranslationOverride(scopeType interface{}, scopeID interface{}, language interface{}, key interface{}, namespace interface{}) *i18nStoreInterfaceMock_DeleteTranslationOverride_Call {
+	return &i18nStoreInterfaceMock_DeleteTranslationOverride_Call{Call: _e.mock.On("DeleteTranslationOverride", scopeType, scopeID, language, key, namespace)}
+}
+
+func (_c *i18nStoreInterfaceMock_DeleteTranslationOverride_Call) Run(run func(scopeType string, scopeID string, language string, key string, namespace string)) *i18nStoreInterfaceMock_DeleteTranslationOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *i18nStoreInterfaceMock_DeleteTranslationOverride_Call) Return(err error) *i18nStoreInterfaceMock_DeleteTranslationOverride_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *i18nStoreInterfaceMock_DeleteTranslationOverride_Call) RunAndReturn(run func(scopeType string, scopeID string, language string, key string, namespace string) error) *i18nStoreInterfaceMock_DeleteTranslationOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteTranslationsByKey provides a mock function for the type i18nStoreInterfaceMock
 func (_mock *i18nStoreInterfaceMock) DeleteTranslationsByKey(ctx context.Context, namespace string, key string) error {
 	ret := _mock.Called(ctx, namespace, key)
@@ -327,6 +402,80 @@ func (_c *i18nStoreInterfaceMock_GetDistinctLanguages_Call) RunAndReturn(run fun
 	return _c
 }
 
+// GetTranslationOverrides provides a mock function for the type i18nStoreInterfaceMock
+func (_mock *i18nStoreInterfaceMock) GetTranslationOverrides(scopeType string, scopeID string, namespace string) (map[string]map[string]mgt.Translation, error) {
+	ret := _mock.Called(scopeType, scopeID, namespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTranslationOverrides")
+	}
+
+	var r0 map[string]map[string]mgt.Translation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) (map[string]map[string]mgt.Translation, error)); ok {
+		return returnFunc(scopeType, scopeID, namespace)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) map[string]map[string]mgt.Translation); ok {
+		r0 = returnFunc(scopeType, scopeID, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]mgt.Translation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = returnFunc(scopeType, scopeID, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// i18nStoreInterfaceMock_GetTranslationOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTranslationOverrides'
+type i18nStoreInterfaceMock_GetTranslationOverrides_Call struct {
+	*mock.Call
+}
+
+// GetTranslationOverrides is a helper method to define mock.On call
+//   - scopeType string
+//   - scopeID string
+//   - namespace string
+func (_e *i18nStoreInterfaceMock_Expecter) GetTranslationOverrides(scopeType interface{}, scopeID interface{}, namespace interface{}) *i18nStoreInterfaceMock_GetTranslationOverrides_Call {
+	return &i18nStoreInterfaceMock_GetTranslationOverrides_Call{Call: _e.mock.On("GetTranslationOverrides", scopeType, scopeID, namespace)}
+}
+
+func (_c *i18nStoreInterfaceMock_GetTranslationOverrides_Call) Run(run func(scopeType string, scopeID string, namespace string)) *i18nStoreInterfaceMock_GetTranslationOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *i18nStoreInterfaceMock_GetTranslationOverrides_Call) Return(stringToStringToTranslation map[string]map[string]mgt.Translation, err error) *i18nStoreInterfaceMock_GetTranslationOverrides_Call {
+	_c.Call.Return(stringToStringToTranslation, err)
+	return _c
+}
+
+func (_c *i18nStoreInterfaceMock_GetTranslationOverrides_Call) RunAndReturn(run func(scopeType string, scopeID string, namespace string) (map[string]map[string]mgt.Translation, error)) *i18nStoreInterfaceMock_GetTranslationOverrides_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTranslations provides a mock function for the type i18nStoreInterfaceMock
 func (_mock *i18nStoreInterfaceMock) GetTranslations() (map[string]map[string]mgt.Translation, error) {
 	ret := _mock.Called()
@@ -563,6 +712,69 @@ func (_c *i18nStoreInterfaceMock_UpsertTranslation_Call) RunAndReturn(run func(t
 	return _c
 }
 
+// UpsertTranslationOverride provides a mock function for the type i18nStoreInterfaceMock
+func (_mock *i18nStoreInterfaceMock) UpsertTranslationOverride(scopeType string, scopeID string, trans mgt.Translation) error {
+	ret := _mock.Called(scopeType, scopeID, trans)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertTranslationOverride")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, mgt.Translation) error); ok {
+		r0 = returnFunc(scopeType, scopeID, trans)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// i18nStoreInterfaceMock_UpsertTranslationOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertTranslationOverride'
+type i18nStoreInterfaceMock_UpsertTranslationOverride_Call struct {
+	*mock.Call
+}
+
+// UpsertTranslationOverride is a helper method to define mock.On call
+//   - scopeType string
+//   - scopeID string
+//   - trans mgt.Translation
+func (_e *i18nStoreInterfaceMock_Expecter) UpsertTranslationOverride(scopeType interface{}, scopeID interface{}, trans interface{}) *i18nStoreInterfaceMock_UpsertTranslationOverride_Call {
+	return &i18nStoreInterfaceMock_UpsertTranslationOverride_Call{Call: _e.mock.On("UpsertTranslationOverride", scopeType, scopeID, trans)}
+}
+
+func (_c *i18nStoreInterfaceMock_UpsertTranslationOverride_Call) Run(run func(scopeType string, scopeID string, trans mgt.Translation)) *i18nStoreInterfaceMock_UpsertTranslationOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 mgt.Translation
+		if args[2] != nil {
+			arg2 = args[2].(mgt.Translation)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *i18nStoreInterfaceMock_UpsertTranslationOverride_Call) Return(err error) *i18nStoreInterfaceMock_UpsertTranslationOverride_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *i18nStoreInterfaceMock_UpsertTranslationOverride_Call) RunAndReturn(run func(scopeType string, scopeID string, trans mgt.Translation) error) *i18nStoreInterfaceMock_UpsertTranslationOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertTranslations provides a mock function for the type i18nStoreInterfaceMock
 func (_mock *i18nStoreInterfaceMock) UpsertTranslations(ctx context.Context, translations []mgt.Translation) error {
 	ret := _mock.Called(ctx, translations)
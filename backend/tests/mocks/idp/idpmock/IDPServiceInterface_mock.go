@@ -378,6 +378,70 @@ func (_c *IDPServiceInterfaceMock_GetIdentityProviderByName_Call) RunAndReturn(r
 	return _c
 }
 
+// GetIdentityProviderHealth provides a mock function for the type IDPServiceInterfaceMock
+func (_mock *IDPServiceInterfaceMock) GetIdentityProviderHealth(ctx context.Context) ([]idp.IDPHealthStatus, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIdentityProviderHealth")
+	}
+
+	var r0 []idp.IDPHealthStatus
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]idp.IDPHealthStatus, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []idp.IDPHealthStatus); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]idp.IDPHealthStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// IDPServiceInterfaceMock_GetIdentityProviderHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdentityProviderHealth'
+type IDPServiceInterfaceMock_GetIdentityProviderHealth_Call struct {
+	*mock.Call
+}
+
+// GetIdentityProviderHealth is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IDPServiceInterfaceMock_Expecter) GetIdentityProviderHealth(ctx interface{}) *IDPServiceInterfaceMock_GetIdentityProviderHealth_Call {
+	return &IDPServiceInterfaceMock_GetIdentityProviderHealth_Call{Call: _e.mock.On("GetIdentityProviderHealth", ctx)}
+}
+
+func (_c *IDPServiceInterfaceMock_GetIdentityProviderHealth_Call) Run(run func(ctx context.Context)) *IDPServiceInterfaceMock_GetIdentityProviderHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *IDPServiceInterfaceMock_GetIdentityProviderHealth_Call) Return(iDPHealthStatuss []idp.IDPHealthStatus, serviceError *serviceerror.ServiceError) *IDPServiceInterfaceMock_GetIdentityProviderHealth_Call {
+	_c.Call.Return(iDPHealthStatuss, serviceError)
+	return _c
+}
+
+func (_c *IDPServiceInterfaceMock_GetIdentityProviderHealth_Call) RunAndReturn(run func(ctx context.Context) ([]idp.IDPHealthStatus, *serviceerror.ServiceError)) *IDPServiceInterfaceMock_GetIdentityProviderHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetIdentityProviderList provides a mock function for the type IDPServiceInterfaceMock
 func (_mock *IDPServiceInterfaceMock) GetIdentityProviderList(ctx context.Context) ([]idp.BasicIDPDTO, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx)
@@ -442,6 +506,57 @@ func (_c *IDPServiceInterfaceMock_GetIdentityProviderList_Call) RunAndReturn(run
 	return _c
 }
 
+// IsIdentityProviderAvailable provides a mock function for the type IDPServiceInterfaceMock
+func (_mock *IDPServiceInterfaceMock) IsIdentityProviderAvailable(idpID string) bool {
+	ret := _mock.Called(idpID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsIdentityProviderAvailable")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = returnFunc(idpID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsIdentityProviderAvailable'
+type IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call struct {
+	*mock.Call
+}
+
+// IsIdentityProviderAvailable is a helper method to define mock.On call
+//   - idpID string
+func (_e *IDPServiceInterfaceMock_Expecter) IsIdentityProviderAvailable(idpID interface{}) *IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call {
+	return &IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call{Call: _e.mock.On("IsIdentityProviderAvailable", idpID)}
+}
+
+func (_c *IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call) Run(run func(idpID string)) *IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call) Return(b bool) *IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call) RunAndReturn(run func(idpID string) bool) *IDPServiceInterfaceMock_IsIdentityProviderAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateIdentityProvider provides a mock function for the type IDPServiceInterfaceMock
 func (_mock *IDPServiceInterfaceMock) UpdateIdentityProvider(ctx context.Context, idpID string, idp1 *idp.IDPDTO) (*idp.IDPDTO, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, idpID, idp1)
@@ -308,6 +308,76 @@ func (_c *NotificationSenderMgtSvcInterfaceMock_GetSenderByName_Call) RunAndRetu
 	return _c
 }
 
+// GetSenderByOU provides a mock function for the type NotificationSenderMgtSvcInterfaceMock
+func (_mock *NotificationSenderMgtSvcInterfaceMock) GetSenderByOU(ctx context.Context, ouID string) (*common.NotificationSenderDTO, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, ouID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSenderByOU")
+	}
+
+	var r0 *common.NotificationSenderDTO
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*common.NotificationSenderDTO, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, ouID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *common.NotificationSenderDTO); ok {
+		r0 = returnFunc(ctx, ouID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.NotificationSenderDTO)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, ouID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSenderByOU'
+type NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call struct {
+	*mock.Call
+}
+
+// GetSenderByOU is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ouID string
+func (_e *NotificationSenderMgtSvcInterfaceMock_Expecter) GetSenderByOU(ctx interface{}, ouID interface{}) *NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call {
+	return &NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call{Call: _e.mock.On("GetSenderByOU", ctx, ouID)}
+}
+
+func (_c *NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call) Run(run func(ctx context.Context, ouID string)) *NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call) Return(notificationSenderDTO *common.NotificationSenderDTO, serviceError *serviceerror.ServiceError) *NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call {
+	_c.Call.Return(notificationSenderDTO, serviceError)
+	return _c
+}
+
+func (_c *NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call) RunAndReturn(run func(ctx context.Context, ouID string) (*common.NotificationSenderDTO, *serviceerror.ServiceError)) *NotificationSenderMgtSvcInterfaceMock_GetSenderByOU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSenders provides a mock function for the type NotificationSenderMgtSvcInterfaceMock
 func (_mock *NotificationSenderMgtSvcInterfaceMock) ListSenders(ctx context.Context) ([]common.NotificationSenderDTO, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx)
@@ -288,6 +288,74 @@ func (_c *notificationStoreInterfaceMock_getSenderByName_Call) RunAndReturn(run
 	return _c
 }
 
+// getSenderByOUID provides a mock function for the type notificationStoreInterfaceMock
+func (_mock *notificationStoreInterfaceMock) getSenderByOUID(ctx context.Context, ouID string) (*common.NotificationSenderDTO, error) {
+	ret := _mock.Called(ctx, ouID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for getSenderByOUID")
+	}
+
+	var r0 *common.NotificationSenderDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*common.NotificationSenderDTO, error)); ok {
+		return returnFunc(ctx, ouID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *common.NotificationSenderDTO); ok {
+		r0 = returnFunc(ctx, ouID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.NotificationSenderDTO)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, ouID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// notificationStoreInterfaceMock_getSenderByOUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'getSenderByOUID'
+type notificationStoreInterfaceMock_getSenderByOUID_Call struct {
+	*mock.Call
+}
+
+// getSenderByOUID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ouID string
+func (_e *notificationStoreInterfaceMock_Expecter) getSenderByOUID(ctx interface{}, ouID interface{}) *notificationStoreInterfaceMock_getSenderByOUID_Call {
+	return &notificationStoreInterfaceMock_getSenderByOUID_Call{Call: _e.mock.On("getSenderByOUID", ctx, ouID)}
+}
+
+func (_c *notificationStoreInterfaceMock_getSenderByOUID_Call) Run(run func(ctx context.Context, ouID string)) *notificationStoreInterfaceMock_getSenderByOUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *notificationStoreInterfaceMock_getSenderByOUID_Call) Return(notificationSenderDTO *common.NotificationSenderDTO, err error) *notificationStoreInterfaceMock_getSenderByOUID_Call {
+	_c.Call.Return(notificationSenderDTO, err)
+	return _c
+}
+
+func (_c *notificationStoreInterfaceMock_getSenderByOUID_Call) RunAndReturn(run func(ctx context.Context, ouID string) (*common.NotificationSenderDTO, error)) *notificationStoreInterfaceMock_getSenderByOUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // listSenders provides a mock function for the type notificationStoreInterfaceMock
 func (_mock *notificationStoreInterfaceMock) listSenders(ctx context.Context) ([]common.NotificationSenderDTO, error) {
 	ret := _mock.Called(ctx)
@@ -83,6 +83,52 @@ func (_c *AuthorizeHandlerInterfaceMock_HandleAuthCallbackPostRequest_Call) RunA
 	return _c
 }
 
+// HandleAuthorizationResponseRequest provides a mock function for the type AuthorizeHandlerInterfaceMock
+func (_mock *AuthorizeHandlerInterfaceMock) HandleAuthorizationResponseRequest(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleAuthorizationResponseRequest'
+type AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call struct {
+	*mock.Call
+}
+
+// HandleAuthorizationResponseRequest is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *AuthorizeHandlerInterfaceMock_Expecter) HandleAuthorizationResponseRequest(w interface{}, r interface{}) *AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call {
+	return &AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call{Call: _e.mock.On("HandleAuthorizationResponseRequest", w, r)}
+}
+
+func (_c *AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call) Return() *AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *AuthorizeHandlerInterfaceMock_HandleAuthorizationResponseRequest_Call {
+	_c.Run(run)
+	return _c
+}
+
 // HandleAuthorizeGetRequest provides a mock function for the type AuthorizeHandlerInterfaceMock
 func (_mock *AuthorizeHandlerInterfaceMock) HandleAuthorizeGetRequest(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
@@ -113,22 +113,24 @@ func (_c *AuthorizeServiceInterfaceMock_GetAuthorizationCodeDetails_Call) RunAnd
 }
 
 // HandleAuthorizationCallback provides a mock function for the type AuthorizeServiceInterfaceMock
-func (_mock *AuthorizeServiceInterfaceMock) HandleAuthorizationCallback(ctx context.Context, authID string, assertion string) (string, *authz.AuthorizationError) {
+func (_mock *AuthorizeServiceInterfaceMock) HandleAuthorizationCallback(ctx context.Context, authID string, assertion string) (*authz.AuthorizationCallbackResult, *authz.AuthorizationError) {
 	ret := _mock.Called(ctx, authID, assertion)
 
 	if len(ret) == 0 {
 		panic("no return value specified for HandleAuthorizationCallback")
 	}
 
-	var r0 string
+	var r0 *authz.AuthorizationCallbackResult
 	var r1 *authz.AuthorizationError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, *authz.AuthorizationError)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*authz.AuthorizationCallbackResult, *authz.AuthorizationError)); ok {
 		return returnFunc(ctx, authID, assertion)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *authz.AuthorizationCallbackResult); ok {
 		r0 = returnFunc(ctx, authID, assertion)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*authz.AuthorizationCallbackResult)
+		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *authz.AuthorizationError); ok {
 		r1 = returnFunc(ctx, authID, assertion)
@@ -176,12 +178,12 @@ func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) Run(ru
 	return _c
 }
 
-func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) Return(s string, authorizationError *authz.AuthorizationError) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
-	_c.Call.Return(s, authorizationError)
+func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) Return(authorizationCallbackResult *authz.AuthorizationCallbackResult, authorizationError *authz.AuthorizationError) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
+	_c.Call.Return(authorizationCallbackResult, authorizationError)
 	return _c
 }
 
-func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) RunAndReturn(run func(ctx context.Context, authID string, assertion string) (string, *authz.AuthorizationError)) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
+func (_c *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call) RunAndReturn(run func(ctx context.Context, authID string, assertion string) (*authz.AuthorizationCallbackResult, *authz.AuthorizationError)) *AuthorizeServiceInterfaceMock_HandleAuthorizationCallback_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -255,3 +257,68 @@ func (_c *AuthorizeServiceInterfaceMock_HandleInitialAuthorizationRequest_Call)
 	_c.Call.Return(run)
 	return _c
 }
+
+// ValidateFormPostResponse provides a mock function for the type AuthorizeServiceInterfaceMock
+func (_mock *AuthorizeServiceInterfaceMock) ValidateFormPostResponse(ctx context.Context, response string, redirectURI string) *authz.AuthorizationError {
+	ret := _mock.Called(ctx, response, redirectURI)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateFormPostResponse")
+	}
+
+	var r0 *authz.AuthorizationError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *authz.AuthorizationError); ok {
+		r0 = returnFunc(ctx, response, redirectURI)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*authz.AuthorizationError)
+		}
+	}
+	return r0
+}
+
+// AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateFormPostResponse'
+type AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call struct {
+	*mock.Call
+}
+
+// ValidateFormPostResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - response string
+//   - redirectURI string
+func (_e *AuthorizeServiceInterfaceMock_Expecter) ValidateFormPostResponse(ctx interface{}, response interface{}, redirectURI interface{}) *AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call {
+	return &AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call{Call: _e.mock.On("ValidateFormPostResponse", ctx, response, redirectURI)}
+}
+
+func (_c *AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call) Run(run func(ctx context.Context, response string, redirectURI string)) *AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call) Return(authorizationError *authz.AuthorizationError) *AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call {
+	_c.Call.Return(authorizationError)
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call) RunAndReturn(run func(ctx context.Context, response string, redirectURI string) *authz.AuthorizationError) *AuthorizeServiceInterfaceMock_ValidateFormPostResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
 )
 
@@ -39,8 +40,8 @@ func (_m *ScopeValidatorInterfaceMock) EXPECT() *ScopeValidatorInterfaceMock_Exp
 }
 
 // ValidateScopes provides a mock function for the type ScopeValidatorInterfaceMock
-func (_mock *ScopeValidatorInterfaceMock) ValidateScopes(ctx context.Context, requestedScopes string, clientID string) (string, *scope.ScopeError) {
-	ret := _mock.Called(ctx, requestedScopes, clientID)
+func (_mock *ScopeValidatorInterfaceMock) ValidateScopes(ctx context.Context, requestedScopes string, oauthApp *model.OAuthClient) (string, *scope.ScopeError) {
+	ret := _mock.Called(ctx, requestedScopes, oauthApp)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidateScopes")
@@ -48,16 +49,16 @@ func (_mock *ScopeValidatorInterfaceMock) ValidateScopes(ctx context.Context, re
 
 	var r0 string
 	var r1 *scope.ScopeError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, *scope.ScopeError)); ok {
-		return returnFunc(ctx, requestedScopes, clientID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.OAuthClient) (string, *scope.ScopeError)); ok {
+		return returnFunc(ctx, requestedScopes, oauthApp)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
-		r0 = returnFunc(ctx, requestedScopes, clientID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.OAuthClient) string); ok {
+		r0 = returnFunc(ctx, requestedScopes, oauthApp)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *scope.ScopeError); ok {
-		r1 = returnFunc(ctx, requestedScopes, clientID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *model.OAuthClient) *scope.ScopeError); ok {
+		r1 = returnFunc(ctx, requestedScopes, oauthApp)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*scope.ScopeError)
@@ -74,12 +75,12 @@ type ScopeValidatorInterfaceMock_ValidateScopes_Call struct {
 // ValidateScopes is a helper method to define mock.On call
 //   - ctx context.Context
 //   - requestedScopes string
-//   - clientID string
-func (_e *ScopeValidatorInterfaceMock_Expecter) ValidateScopes(ctx interface{}, requestedScopes interface{}, clientID interface{}) *ScopeValidatorInterfaceMock_ValidateScopes_Call {
-	return &ScopeValidatorInterfaceMock_ValidateScopes_Call{Call: _e.mock.On("ValidateScopes", ctx, requestedScopes, clientID)}
+//   - oauthApp *model.OAuthClient
+func (_e *ScopeValidatorInterfaceMock_Expecter) ValidateScopes(ctx interface{}, requestedScopes interface{}, oauthApp interface{}) *ScopeValidatorInterfaceMock_ValidateScopes_Call {
+	return &ScopeValidatorInterfaceMock_ValidateScopes_Call{Call: _e.mock.On("ValidateScopes", ctx, requestedScopes, oauthApp)}
 }
 
-func (_c *ScopeValidatorInterfaceMock_ValidateScopes_Call) Run(run func(ctx context.Context, requestedScopes string, clientID string)) *ScopeValidatorInterfaceMock_ValidateScopes_Call {
+func (_c *ScopeValidatorInterfaceMock_ValidateScopes_Call) Run(run func(ctx context.Context, requestedScopes string, oauthApp *model.OAuthClient)) *ScopeValidatorInterfaceMock_ValidateScopes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,9 +90,9 @@ func (_c *ScopeValidatorInterfaceMock_ValidateScopes_Call) Run(run func(ctx cont
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 *model.OAuthClient
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(*model.OAuthClient)
 		}
 		run(
 			arg0,
@@ -107,7 +108,7 @@ func (_c *ScopeValidatorInterfaceMock_ValidateScopes_Call) Return(s string, scop
 	return _c
 }
 
-func (_c *ScopeValidatorInterfaceMock_ValidateScopes_Call) RunAndReturn(run func(ctx context.Context, requestedScopes string, clientID string) (string, *scope.ScopeError)) *ScopeValidatorInterfaceMock_ValidateScopes_Call {
+func (_c *ScopeValidatorInterfaceMock_ValidateScopes_Call) RunAndReturn(run func(ctx context.Context, requestedScopes string, oauthApp *model.OAuthClient) (string, *scope.ScopeError)) *ScopeValidatorInterfaceMock_ValidateScopes_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1302,6 +1302,74 @@ func (_c *resourceStoreInterfaceMock_GetActionListCount_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetPermissionDetails provides a mock function for the type resourceStoreInterfaceMock
+func (_mock *resourceStoreInterfaceMock) GetPermissionDetails(ctx context.Context, permissions []string) ([]resource.PermissionDetail, error) {
+	ret := _mock.Called(ctx, permissions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPermissionDetails")
+	}
+
+	var r0 []resource.PermissionDetail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]resource.PermissionDetail, error)); ok {
+		return returnFunc(ctx, permissions)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []resource.PermissionDetail); ok {
+		r0 = returnFunc(ctx, permissions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.PermissionDetail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, permissions)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// resourceStoreInterfaceMock_GetPermissionDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPermissionDetails'
+type resourceStoreInterfaceMock_GetPermissionDetails_Call struct {
+	*mock.Call
+}
+
+// GetPermissionDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - permissions []string
+func (_e *resourceStoreInterfaceMock_Expecter) GetPermissionDetails(ctx interface{}, permissions interface{}) *resourceStoreInterfaceMock_GetPermissionDetails_Call {
+	return &resourceStoreInterfaceMock_GetPermissionDetails_Call{Call: _e.mock.On("GetPermissionDetails", ctx, permissions)}
+}
+
+func (_c *resourceStoreInterfaceMock_GetPermissionDetails_Call) Run(run func(ctx context.Context, permissions []string)) *resourceStoreInterfaceMock_GetPermissionDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *resourceStoreInterfaceMock_GetPermissionDetails_Call) Return(permissionDetails []resource.PermissionDetail, err error) *resourceStoreInterfaceMock_GetPermissionDetails_Call {
+	_c.Call.Return(permissionDetails, err)
+	return _c
+}
+
+func (_c *resourceStoreInterfaceMock_GetPermissionDetails_Call) RunAndReturn(run func(ctx context.Context, permissions []string) ([]resource.PermissionDetail, error)) *resourceStoreInterfaceMock_GetPermissionDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResource provides a mock function for the type resourceStoreInterfaceMock
 func (_mock *resourceStoreInterfaceMock) GetResource(ctx context.Context, id string, resServerID string) (resource.Resource, error) {
 	ret := _mock.Called(ctx, id, resServerID)
@@ -350,3 +350,71 @@ func (_c *RoleAssignmentServiceInterfaceMock_RemoveAssignments_Call) RunAndRetur
 	_c.Call.Return(run)
 	return _c
 }
+
+// RemoveEntityAssignments provides a mock function for the type RoleAssignmentServiceInterfaceMock
+func (_mock *RoleAssignmentServiceInterfaceMock) RemoveEntityAssignments(ctx context.Context, entityID string) (int64, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveEntityAssignments")
+	}
+
+	var r0 int64
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, entityID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveEntityAssignments'
+type RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call struct {
+	*mock.Call
+}
+
+// RemoveEntityAssignments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *RoleAssignmentServiceInterfaceMock_Expecter) RemoveEntityAssignments(ctx interface{}, entityID interface{}) *RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call {
+	return &RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call{Call: _e.mock.On("RemoveEntityAssignments", ctx, entityID)}
+}
+
+func (_c *RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call) Run(run func(ctx context.Context, entityID string)) *RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call) Return(n int64, serviceError *serviceerror.ServiceError) *RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call {
+	_c.Call.Return(n, serviceError)
+	return _c
+}
+
+func (_c *RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call) RunAndReturn(run func(ctx context.Context, entityID string) (int64, *serviceerror.ServiceError)) *RoleAssignmentServiceInterfaceMock_RemoveEntityAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,6 +39,205 @@ func (_m *TemplateServiceInterfaceMock) EXPECT() *TemplateServiceInterfaceMock_E
 	return &TemplateServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// CreateTemplate provides a mock function for the type TemplateServiceInterfaceMock
+func (_mock *TemplateServiceInterfaceMock) CreateTemplate(ctx context.Context, tmpl template.TemplateDTO) (*template.TemplateDTO, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, tmpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemplate")
+	}
+
+	var r0 *template.TemplateDTO
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, template.TemplateDTO) (*template.TemplateDTO, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, tmpl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, template.TemplateDTO) *template.TemplateDTO); ok {
+		r0 = returnFunc(ctx, tmpl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*template.TemplateDTO)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, template.TemplateDTO) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, tmpl)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// TemplateServiceInterfaceMock_CreateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTemplate'
+type TemplateServiceInterfaceMock_CreateTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tmpl template.TemplateDTO
+func (_e *TemplateServiceInterfaceMock_Expecter) CreateTemplate(ctx interface{}, tmpl interface{}) *TemplateServiceInterfaceMock_CreateTemplate_Call {
+	return &TemplateServiceInterfaceMock_CreateTemplate_Call{Call: _e.mock.On("CreateTemplate", ctx, tmpl)}
+}
+
+func (_c *TemplateServiceInterfaceMock_CreateTemplate_Call) Run(run func(ctx context.Context, tmpl template.TemplateDTO)) *TemplateServiceInterfaceMock_CreateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 template.TemplateDTO
+		if args[1] != nil {
+			arg1 = args[1].(template.TemplateDTO)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_CreateTemplate_Call) Return(templateDTO *template.TemplateDTO, serviceError *serviceerror.ServiceError) *TemplateServiceInterfaceMock_CreateTemplate_Call {
+	_c.Call.Return(templateDTO, serviceError)
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_CreateTemplate_Call) RunAndReturn(run func(ctx context.Context, tmpl template.TemplateDTO) (*template.TemplateDTO, *serviceerror.ServiceError)) *TemplateServiceInterfaceMock_CreateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTemplate provides a mock function for the type TemplateServiceInterfaceMock
+func (_mock *TemplateServiceInterfaceMock) DeleteTemplate(ctx context.Context, id string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplate")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// TemplateServiceInterfaceMock_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type TemplateServiceInterfaceMock_DeleteTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TemplateServiceInterfaceMock_Expecter) DeleteTemplate(ctx interface{}, id interface{}) *TemplateServiceInterfaceMock_DeleteTemplate_Call {
+	return &TemplateServiceInterfaceMock_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", ctx, id)}
+}
+
+func (_c *TemplateServiceInterfaceMock_DeleteTemplate_Call) Run(run func(ctx context.Context, id string)) *TemplateServiceInterfaceMock_DeleteTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_DeleteTemplate_Call) Return(serviceError *serviceerror.ServiceError) *TemplateServiceInterfaceMock_DeleteTemplate_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_DeleteTemplate_Call) RunAndReturn(run func(ctx context.Context, id string) *serviceerror.ServiceError) *TemplateServiceInterfaceMock_DeleteTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplate provides a mock function for the type TemplateServiceInterfaceMock
+func (_mock *TemplateServiceInterfaceMock) GetTemplate(ctx context.Context, id string) (*template.TemplateDTO, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplate")
+	}
+
+	var r0 *template.TemplateDTO
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*template.TemplateDTO, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *template.TemplateDTO); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*template.TemplateDTO)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// TemplateServiceInterfaceMock_GetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplate'
+type TemplateServiceInterfaceMock_GetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TemplateServiceInterfaceMock_Expecter) GetTemplate(ctx interface{}, id interface{}) *TemplateServiceInterfaceMock_GetTemplate_Call {
+	return &TemplateServiceInterfaceMock_GetTemplate_Call{Call: _e.mock.On("GetTemplate", ctx, id)}
+}
+
+func (_c *TemplateServiceInterfaceMock_GetTemplate_Call) Run(run func(ctx context.Context, id string)) *TemplateServiceInterfaceMock_GetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_GetTemplate_Call) Return(templateDTO *template.TemplateDTO, serviceError *serviceerror.ServiceError) *TemplateServiceInterfaceMock_GetTemplate_Call {
+	_c.Call.Return(templateDTO, serviceError)
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_GetTemplate_Call) RunAndReturn(run func(ctx context.Context, id string) (*template.TemplateDTO, *serviceerror.ServiceError)) *TemplateServiceInterfaceMock_GetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTemplateByScenario provides a mock function for the type TemplateServiceInterfaceMock
 func (_mock *TemplateServiceInterfaceMock) GetTemplateByScenario(ctx context.Context, scenario template.ScenarioType, tmplType template.TemplateType) (*template.TemplateDTO, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, scenario, tmplType)
@@ -115,6 +314,70 @@ func (_c *TemplateServiceInterfaceMock_GetTemplateByScenario_Call) RunAndReturn(
 	return _c
 }
 
+// ListTemplates provides a mock function for the type TemplateServiceInterfaceMock
+func (_mock *TemplateServiceInterfaceMock) ListTemplates(ctx context.Context) ([]template.TemplateDTO, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTemplates")
+	}
+
+	var r0 []template.TemplateDTO
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]template.TemplateDTO, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []template.TemplateDTO); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]template.TemplateDTO)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// TemplateServiceInterfaceMock_ListTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTemplates'
+type TemplateServiceInterfaceMock_ListTemplates_Call struct {
+	*mock.Call
+}
+
+// ListTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TemplateServiceInterfaceMock_Expecter) ListTemplates(ctx interface{}) *TemplateServiceInterfaceMock_ListTemplates_Call {
+	return &TemplateServiceInterfaceMock_ListTemplates_Call{Call: _e.mock.On("ListTemplates", ctx)}
+}
+
+func (_c *TemplateServiceInterfaceMock_ListTemplates_Call) Run(run func(ctx context.Context)) *TemplateServiceInterfaceMock_ListTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_ListTemplates_Call) Return(templateDTOs []template.TemplateDTO, serviceError *serviceerror.ServiceError) *TemplateServiceInterfaceMock_ListTemplates_Call {
+	_c.Call.Return(templateDTOs, serviceError)
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_ListTemplates_Call) RunAndReturn(run func(ctx context.Context) ([]template.TemplateDTO, *serviceerror.ServiceError)) *TemplateServiceInterfaceMock_ListTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Render provides a mock function for the type TemplateServiceInterfaceMock
 func (_mock *TemplateServiceInterfaceMock) Render(ctx context.Context, scenario template.ScenarioType, tmplType template.TemplateType, data template.TemplateData) (*template.RenderedTemplate, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, scenario, tmplType, data)
@@ -196,3 +459,173 @@ func (_c *TemplateServiceInterfaceMock_Render_Call) RunAndReturn(run func(ctx co
 	_c.Call.Return(run)
 	return _c
 }
+
+// RenderLocalized provides a mock function for the type TemplateServiceInterfaceMock
+func (_mock *TemplateServiceInterfaceMock) RenderLocalized(ctx context.Context, scenario template.ScenarioType, tmplType template.TemplateType, ouID string, language string, data template.TemplateData) (*template.RenderedTemplate, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, scenario, tmplType, ouID, language, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderLocalized")
+	}
+
+	var r0 *template.RenderedTemplate
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, template.ScenarioType, template.TemplateType, string, string, template.TemplateData) (*template.RenderedTemplate, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, scenario, tmplType, ouID, language, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, template.ScenarioType, template.TemplateType, string, string, template.TemplateData) *template.RenderedTemplate); ok {
+		r0 = returnFunc(ctx, scenario, tmplType, ouID, language, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*template.RenderedTemplate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, template.ScenarioType, template.TemplateType, string, string, template.TemplateData) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, scenario, tmplType, ouID, language, data)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// TemplateServiceInterfaceMock_RenderLocalized_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderLocalized'
+type TemplateServiceInterfaceMock_RenderLocalized_Call struct {
+	*mock.Call
+}
+
+// RenderLocalized is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scenario template.ScenarioType
+//   - tmplType template.TemplateType
+//   - ouID string
+//   - language string
+//   - data template.TemplateData
+func (_e *TemplateServiceInterfaceMock_Expecter) RenderLocalized(ctx interface{}, scenario interface{}, tmplType interface{}, ouID interface{}, language interface{}, data interface{}) *TemplateServiceInterfaceMock_RenderLocalized_Call {
+	return &TemplateServiceInterfaceMock_RenderLocalized_Call{Call: _e.mock.On("RenderLocalized", ctx, scenario, tmplType, ouID, language, data)}
+}
+
+func (_c *TemplateServiceInterfaceMock_RenderLocalized_Call) Run(run func(ctx context.Context, scenario template.ScenarioType, tmplType template.TemplateType, ouID string, language string, data template.TemplateData)) *TemplateServiceInterfaceMock_RenderLocalized_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 template.ScenarioType
+		if args[1] != nil {
+			arg1 = args[1].(template.ScenarioType)
+		}
+		var arg2 template.TemplateType
+		if args[2] != nil {
+			arg2 = args[2].(template.TemplateType)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 template.TemplateData
+		if args[5] != nil {
+			arg5 = args[5].(template.TemplateData)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_RenderLocalized_Call) Return(renderedTemplate *template.RenderedTemplate, serviceError *serviceerror.ServiceError) *TemplateServiceInterfaceMock_RenderLocalized_Call {
+	_c.Call.Return(renderedTemplate, serviceError)
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_RenderLocalized_Call) RunAndReturn(run func(ctx context.Context, scenario template.ScenarioType, tmplType template.TemplateType, ouID string, language string, data template.TemplateData) (*template.RenderedTemplate, *serviceerror.ServiceError)) *TemplateServiceInterfaceMock_RenderLocalized_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTemplate provides a mock function for the type TemplateServiceInterfaceMock
+func (_mock *TemplateServiceInterfaceMock) UpdateTemplate(ctx context.Context, id string, tmpl template.TemplateDTO) (*template.TemplateDTO, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, id, tmpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplate")
+	}
+
+	var r0 *template.TemplateDTO
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, template.TemplateDTO) (*template.TemplateDTO, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, id, tmpl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, template.TemplateDTO) *template.TemplateDTO); ok {
+		r0 = returnFunc(ctx, id, tmpl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*template.TemplateDTO)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, template.TemplateDTO) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, id, tmpl)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// TemplateServiceInterfaceMock_UpdateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTemplate'
+type TemplateServiceInterfaceMock_UpdateTemplate_Call struct {
+	*mock.Call
+}
+
+// UpdateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - tmpl template.TemplateDTO
+func (_e *TemplateServiceInterfaceMock_Expecter) UpdateTemplate(ctx interface{}, id interface{}, tmpl interface{}) *TemplateServiceInterfaceMock_UpdateTemplate_Call {
+	return &TemplateServiceInterfaceMock_UpdateTemplate_Call{Call: _e.mock.On("UpdateTemplate", ctx, id, tmpl)}
+}
+
+func (_c *TemplateServiceInterfaceMock_UpdateTemplate_Call) Run(run func(ctx context.Context, id string, tmpl template.TemplateDTO)) *TemplateServiceInterfaceMock_UpdateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 template.TemplateDTO
+		if args[2] != nil {
+			arg2 = args[2].(template.TemplateDTO)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_UpdateTemplate_Call) Return(templateDTO *template.TemplateDTO, serviceError *serviceerror.ServiceError) *TemplateServiceInterfaceMock_UpdateTemplate_Call {
+	_c.Call.Return(templateDTO, serviceError)
+	return _c
+}
+
+func (_c *TemplateServiceInterfaceMock_UpdateTemplate_Call) RunAndReturn(run func(ctx context.Context, id string, tmpl template.TemplateDTO) (*template.TemplateDTO, *serviceerror.ServiceError)) *TemplateServiceInterfaceMock_UpdateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -38,6 +38,120 @@ func (_m *templateStoreInterfaceMock) EXPECT() *templateStoreInterfaceMock_Expec
 	return &templateStoreInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// CreateTemplate provides a mock function for the type templateStoreInterfaceMock
+func (_mock *templateStoreInterfaceMock) CreateTemplate(ctx context.Context, tmpl template.TemplateDTO) error {
+	ret := _mock.Called(ctx, tmpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, template.TemplateDTO) error); ok {
+		r0 = returnFunc(ctx, tmpl)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// templateStoreInterfaceMock_CreateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTemplate'
+type templateStoreInterfaceMock_CreateTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tmpl template.TemplateDTO
+func (_e *templateStoreInterfaceMock_Expecter) CreateTemplate(ctx interface{}, tmpl interface{}) *templateStoreInterfaceMock_CreateTemplate_Call {
+	return &templateStoreInterfaceMock_CreateTemplate_Call{Call: _e.mock.On("CreateTemplate", ctx, tmpl)}
+}
+
+func (_c *templateStoreInterfaceMock_CreateTemplate_Call) Run(run func(ctx context.Context, tmpl template.TemplateDTO)) *templateStoreInterfaceMock_CreateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 template.TemplateDTO
+		if args[1] != nil {
+			arg1 = args[1].(template.TemplateDTO)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *templateStoreInterfaceMock_CreateTemplate_Call) Return(err error) *templateStoreInterfaceMock_CreateTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *templateStoreInterfaceMock_CreateTemplate_Call) RunAndReturn(run func(ctx context.Context, tmpl template.TemplateDTO) error) *templateStoreInterfaceMock_CreateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTemplate provides a mock function for the type templateStoreInterfaceMock
+func (_mock *templateStoreInterfaceMock) DeleteTemplate(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// templateStoreInterfaceMock_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type templateStoreInterfaceMock_DeleteTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *templateStoreInterfaceMock_Expecter) DeleteTemplate(ctx interface{}, id interface{}) *templateStoreInterfaceMock_DeleteTemplate_Call {
+	return &templateStoreInterfaceMock_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", ctx, id)}
+}
+
+func (_c *templateStoreInterfaceMock_DeleteTemplate_Call) Run(run func(ctx context.Context, id string)) *templateStoreInterfaceMock_DeleteTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *templateStoreInterfaceMock_DeleteTemplate_Call) Return(err error) *templateStoreInterfaceMock_DeleteTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *templateStoreInterfaceMock_DeleteTemplate_Call) RunAndReturn(run func(ctx context.Context, id string) error) *templateStoreInterfaceMock_DeleteTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTemplate provides a mock function for the type templateStoreInterfaceMock
 func (_mock *templateStoreInterfaceMock) GetTemplate(ctx context.Context, id string) (*template.TemplateDTO, error) {
 	ret := _mock.Called(ctx, id)
@@ -180,6 +294,86 @@ func (_c *templateStoreInterfaceMock_GetTemplateByScenario_Call) RunAndReturn(ru
 	return _c
 }
 
+// GetTemplateByScenarioAndOU provides a mock function for the type templateStoreInterfaceMock
+func (_mock *templateStoreInterfaceMock) GetTemplateByScenarioAndOU(ctx context.Context, scenario template.ScenarioType, tmplType template.TemplateType, ouID string) (*template.TemplateDTO, error) {
+	ret := _mock.Called(ctx, scenario, tmplType, ouID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplateByScenarioAndOU")
+	}
+
+	var r0 *template.TemplateDTO
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, template.ScenarioType, template.TemplateType, string) (*template.TemplateDTO, error)); ok {
+		return returnFunc(ctx, scenario, tmplType, ouID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, template.ScenarioType, template.TemplateType, string) *template.TemplateDTO); ok {
+		r0 = returnFunc(ctx, scenario, tmplType, ouID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*template.TemplateDTO)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, template.ScenarioType, template.TemplateType, string) error); ok {
+		r1 = returnFunc(ctx, scenario, tmplType, ouID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplateByScenarioAndOU'
+type templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call struct {
+	*mock.Call
+}
+
+// GetTemplateByScenarioAndOU is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scenario template.ScenarioType
+//   - tmplType template.TemplateType
+//   - ouID string
+func (_e *templateStoreInterfaceMock_Expecter) GetTemplateByScenarioAndOU(ctx interface{}, scenario interface{}, tmplType interface{}, ouID interface{}) *templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call {
+	return &templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call{Call: _e.mock.On("GetTemplateByScenarioAndOU", ctx, scenario, tmplType, ouID)}
+}
+
+func (_c *templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call) Run(run func(ctx context.Context, scenario template.ScenarioType, tmplType template.TemplateType, ouID string)) *templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 template.ScenarioType
+		if args[1] != nil {
+			arg1 = args[1].(template.ScenarioType)
+		}
+		var arg2 template.TemplateType
+		if args[2] != nil {
+			arg2 = args[2].(template.TemplateType)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call) Return(templateDTO *template.TemplateDTO, err error) *templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call {
+	_c.Call.Return(templateDTO, err)
+	return _c
+}
+
+func (_c *templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call) RunAndReturn(run func(ctx context.Context, scenario template.ScenarioType, tmplType template.TemplateType, ouID string) (*template.TemplateDTO, error)) *templateStoreInterfaceMock_GetTemplateByScenarioAndOU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListTemplates provides a mock function for the type templateStoreInterfaceMock
 func (_mock *templateStoreInterfaceMock) ListTemplates(ctx context.Context) ([]*template.TemplateDTO, error) {
 	ret := _mock.Called(ctx)
@@ -241,3 +435,60 @@ func (_c *templateStoreInterfaceMock_ListTemplates_Call) RunAndReturn(run func(c
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateTemplate provides a mock function for the type templateStoreInterfaceMock
+func (_mock *templateStoreInterfaceMock) UpdateTemplate(ctx context.Context, tmpl template.TemplateDTO) error {
+	ret := _mock.Called(ctx, tmpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, template.TemplateDTO) error); ok {
+		r0 = returnFunc(ctx, tmpl)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// templateStoreInterfaceMock_UpdateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTemplate'
+type templateStoreInterfaceMock_UpdateTemplate_Call struct {
+	*mock.Call
+}
+
+// UpdateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tmpl template.TemplateDTO
+func (_e *templateStoreInterfaceMock_Expecter) UpdateTemplate(ctx interface{}, tmpl interface{}) *templateStoreInterfaceMock_UpdateTemplate_Call {
+	return &templateStoreInterfaceMock_UpdateTemplate_Call{Call: _e.mock.On("UpdateTemplate", ctx, tmpl)}
+}
+
+func (_c *templateStoreInterfaceMock_UpdateTemplate_Call) Run(run func(ctx context.Context, tmpl template.TemplateDTO)) *templateStoreInterfaceMock_UpdateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 template.TemplateDTO
+		if args[1] != nil {
+			arg1 = args[1].(template.TemplateDTO)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *templateStoreInterfaceMock_UpdateTemplate_Call) Return(err error) *templateStoreInterfaceMock_UpdateTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *templateStoreInterfaceMock_UpdateTemplate_Call) RunAndReturn(run func(ctx context.Context, tmpl template.TemplateDTO) error) *templateStoreInterfaceMock_UpdateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -235,6 +235,11 @@ const sidebars: SidebarsConfig = {
             },
           ],
         },
+        {
+          type: 'doc',
+          id: 'guides/guides/email-link-login',
+          label: 'Email Link Sign-In',
+        },
         {
           type: 'doc',
           id: 'guides/guides/consent',
@@ -245,6 +250,46 @@ const sidebars: SidebarsConfig = {
           id: 'guides/guides/trusted-issuer',
           label: 'Trusted Issuer',
         },
+        {
+          type: 'doc',
+          id: 'guides/guides/saml-identity-provider',
+          label: 'SAML Identity Provider',
+        },
+        {
+          type: 'doc',
+          id: 'guides/guides/thunderctl',
+          label: 'Command Line Client',
+        },
+        {
+          type: 'doc',
+          id: 'guides/guides/error-codes',
+          label: 'Error Codes',
+        },
+        {
+          type: 'doc',
+          id: 'guides/guides/backup-restore',
+          label: 'Backup and Restore',
+        },
+        {
+          type: 'doc',
+          id: 'guides/guides/scheduled-jobs',
+          label: 'Scheduled Jobs',
+        },
+        {
+          type: 'doc',
+          id: 'guides/guides/user-erasure',
+          label: 'User Erasure',
+        },
+        {
+          type: 'doc',
+          id: 'guides/guides/scim-provisioning',
+          label: 'SCIM Provisioning',
+        },
+        {
+          type: 'doc',
+          id: 'guides/guides/b2b-organizations',
+          label: 'B2B Organizations',
+        },
       ],
     },
 
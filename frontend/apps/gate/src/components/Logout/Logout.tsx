@@ -0,0 +1,128 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {useConfig} from '@thunderid/contexts';
+import {useLogger} from '@thunderid/logger/react';
+import {CircularProgress, Stack, Typography} from '@wso2/oxygen-ui';
+import {type JSX, useCallback, useEffect, useRef, useState} from 'react';
+import {useTranslation} from 'react-i18next';
+import {useNavigate, useSearchParams} from 'react-router';
+import ROUTES from '../../constants/routes';
+
+/**
+ * Maximum time in milliseconds to wait for the front-channel logout URIs to load before redirecting.
+ */
+export const FRONTCHANNEL_LOGOUT_TIMEOUT = 5000;
+
+/**
+ * Response of the front-channel logout endpoint.
+ */
+interface FrontchannelLogoutResponse {
+  /**
+   * Front-channel logout URIs of the clients that took part in the terminated session.
+   */
+  frontchannel_logout_uris: string[];
+  /**
+   * URI to redirect the user agent to once the clients are notified.
+   */
+  redirect_uri: string;
+}
+
+/**
+ * Renders the front-channel logout URIs of the clients that took part in a terminated session in hidden iframes
+ * and redirects the user agent once they have loaded, or once the timeout elapses.
+ */
+export default function Logout(): JSX.Element {
+  const [searchParams] = useSearchParams();
+  const navigate = useNavigate();
+  const {t} = useTranslation();
+  const {getServerUrl} = useConfig();
+  const logger = useLogger('Logout');
+
+  const [logout, setLogout] = useState<FrontchannelLogoutResponse | null>(null);
+  const loadedCount = useRef(0);
+
+  const state = searchParams.get('state') ?? '';
+  const baseUrl = getServerUrl() ?? (import.meta.env.VITE_ASGARDEO_BASE_URL as string);
+
+  useEffect(() => {
+    const controller = new AbortController();
+    const query = new URLSearchParams({state});
+
+    fetch(`${baseUrl}/oauth2/logout/frontchannel?${query.toString()}`, {signal: controller.signal})
+      .then(async (response) => {
+        if (!response.ok) {
+          throw new Error(`Front-channel logout request failed with status ${response.status}`);
+        }
+        setLogout((await response.json()) as FrontchannelLogoutResponse);
+      })
+      .catch((error: unknown) => {
+        if (controller.signal.aborted) {
+          return;
+        }
+        logger.error('Failed to resolve the front-channel logout:', error);
+        const result = navigate(`${ROUTES.AUTH.ERROR}?errorCode=invalid_request`, {replace: true});
+        if (result instanceof Promise) {
+          result.catch(() => null);
+        }
+      });
+
+    return () => controller.abort();
+  }, [baseUrl, state, logger, navigate]);
+
+  useEffect(() => {
+    if (!logout) {
+      return undefined;
+    }
+
+    const timer = setTimeout(() => window.location.replace(logout.redirect_uri), FRONTCHANNEL_LOGOUT_TIMEOUT);
+
+    return () => clearTimeout(timer);
+  }, [logout]);
+
+  const handleFrameLoad = useCallback(() => {
+    loadedCount.current += 1;
+    if (logout && loadedCount.current === logout.frontchannel_logout_uris.length) {
+      window.location.replace(logout.redirect_uri);
+    }
+  }, [logout]);
+
+  return (
+    <Stack
+      direction="column"
+      component="main"
+      gap={2}
+      sx={{justifyContent: 'center', alignItems: 'center', minHeight: '100%'}}
+    >
+      <CircularProgress />
+      <Typography variant="body1" color="text.secondary">
+        {t('auth:signingOut')}
+      </Typography>
+      {logout?.frontchannel_logout_uris.map((uri) => (
+        <iframe
+          key={uri}
+          src={uri}
+          title={uri}
+          onLoad={handleFrameLoad}
+          style={{display: 'none'}}
+          sandbox="allow-scripts allow-same-origin"
+        />
+      ))}
+    </Stack>
+  );
+}
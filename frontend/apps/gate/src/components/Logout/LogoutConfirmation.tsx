@@ -0,0 +1,76 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {useConfig} from '@thunderid/contexts';
+import {Button, Stack, Typography} from '@wso2/oxygen-ui';
+import {type JSX, useState} from 'react';
+import {useTranslation} from 'react-i18next';
+import {useSearchParams} from 'react-router';
+
+/**
+ * Query parameter that carries the logout confirmation state to the page and back to the end session endpoint.
+ */
+export const LOGOUT_CONFIRMATION_PARAM = 'logout_confirmation';
+
+/**
+ * Asks the user to confirm a logout requested without an ID token hint. Confirming posts the logout confirmation
+ * state back to the end session endpoint, which then ends the session. Declining leaves the session intact.
+ */
+export default function LogoutConfirmation(): JSX.Element {
+  const [searchParams] = useSearchParams();
+  const {t} = useTranslation();
+  const {getServerUrl} = useConfig();
+
+  const [declined, setDeclined] = useState(false);
+
+  const confirmation = searchParams.get(LOGOUT_CONFIRMATION_PARAM) ?? '';
+  const baseUrl = getServerUrl() ?? (import.meta.env.VITE_ASGARDEO_BASE_URL as string);
+
+  return (
+    <Stack
+      direction="column"
+      component="main"
+      gap={2}
+      sx={{justifyContent: 'center', alignItems: 'center', minHeight: '100%'}}
+    >
+      <Typography component="h1" variant="h4">
+        {t('auth:logoutConfirmation.title')}
+      </Typography>
+      {declined ? (
+        <Typography variant="body1" color="text.secondary">
+          {t('auth:logoutConfirmation.declined')}
+        </Typography>
+      ) : (
+        <>
+          <Typography variant="body1" color="text.secondary">
+            {t('auth:logoutConfirmation.description')}
+          </Typography>
+          <Stack component="form" method="post" action={`${baseUrl}/oauth2/logout`} direction="row" gap={2}>
+            <input type="hidden" name={LOGOUT_CONFIRMATION_PARAM} value={confirmation} />
+            <Button variant="outlined" onClick={() => setDeclined(true)}>
+              {t('auth:logoutConfirmation.decline')}
+            </Button>
+            <Button type="submit" variant="contained">
+              {t('auth:logoutConfirmation.confirm')}
+            </Button>
+          </Stack>
+        </>
+      )}
+    </Stack>
+  );
+}
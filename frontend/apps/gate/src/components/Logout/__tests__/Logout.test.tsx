@@ -0,0 +1,119 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {render, screen, fireEvent, waitFor} from '@thunderid/test-utils';
+import {describe, it, expect, vi, beforeEach, afterEach} from 'vitest';
+import Logout from '../Logout';
+
+const {mockLogger} = vi.hoisted(() => ({
+  mockLogger: {
+    error: vi.fn(),
+    warn: vi.fn(),
+    info: vi.fn(),
+    debug: vi.fn(),
+  },
+}));
+
+vi.mock('@thunderid/logger/react', async (importOriginal) => {
+  const actual = await importOriginal<typeof import('@thunderid/logger/react')>();
+
+  return {
+    ...actual,
+    useLogger: () => mockLogger,
+  };
+});
+
+// Mock useConfig
+vi.mock('@thunderid/contexts', () => ({
+  useConfig: () => ({
+    getServerUrl: () => 'https://api.example.com',
+  }),
+}));
+
+// Mock react-router hooks
+const mockNavigate = vi.fn();
+vi.mock('react-router', () => ({
+  useNavigate: () => mockNavigate,
+  useSearchParams: () => [new URLSearchParams({state: 'logout-state'})],
+}));
+
+describe('Logout', () => {
+  const mockFetch = vi.fn();
+  const mockReplace = vi.fn();
+
+  beforeEach(() => {
+    vi.clearAllMocks();
+    vi.stubGlobal('fetch', mockFetch);
+    Object.defineProperty(window, 'location', {
+      value: {...window.location, replace: mockReplace},
+      writable: true,
+    });
+  });
+
+  afterEach(() => {
+    vi.unstubAllGlobals();
+  });
+
+  it('requests the front-channel logout with the state', async () => {
+    mockFetch.mockResolvedValue({
+      ok: true,
+      json: () => Promise.resolve({frontchannel_logout_uris: [], redirect_uri: ''}),
+    });
+
+    render(<Logout />);
+
+    await waitFor(() => {
+      expect(mockFetch).toHaveBeenCalledWith(
+        'https://api.example.com/oauth2/logout/frontchannel?state=logout-state',
+        expect.objectContaining({signal: expect.any(AbortSignal) as AbortSignal}),
+      );
+    });
+  });
+
+  it('renders the front-channel logout URIs and redirects once they load', async () => {
+    mockFetch.mockResolvedValue({
+      ok: true,
+      json: () =>
+        Promise.resolve({
+          frontchannel_logout_uris: ['https://app1.example.com/logout', 'https://app2.example.com/logout'],
+          redirect_uri: 'https://app1.example.com/logged-out',
+        }),
+    });
+
+    render(<Logout />);
+
+    const frames = await screen.findAllByTitle(/https:\/\/app\d\.example\.com\/logout/);
+    expect(frames).toHaveLength(2);
+
+    fireEvent.load(frames[0]);
+    expect(mockReplace).not.toHaveBeenCalled();
+    fireEvent.load(frames[1]);
+    expect(mockReplace).toHaveBeenCalledWith('https://app1.example.com/logged-out');
+  });
+
+  it('navigates to the error page when the state is rejected', async () => {
+    mockFetch.mockResolvedValue({ok: false, status: 400});
+
+    render(<Logout />);
+
+    await waitFor(() => {
+      expect(mockNavigate).toHaveBeenCalledWith('/error?errorCode=invalid_request', {replace: true});
+    });
+    expect(mockLogger.error).toHaveBeenCalled();
+  });
+});
@@ -0,0 +1,55 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {render, screen, fireEvent} from '@thunderid/test-utils';
+import {describe, it, expect, vi} from 'vitest';
+import LogoutConfirmation from '../LogoutConfirmation';
+
+// Mock useConfig
+vi.mock('@thunderid/contexts', () => ({
+  useConfig: () => ({
+    getServerUrl: () => 'https://api.example.com',
+  }),
+}));
+
+// Mock react-router hooks
+vi.mock('react-router', () => ({
+  useSearchParams: () => [new URLSearchParams({logout_confirmation: 'confirmation-state'})],
+}));
+
+describe('LogoutConfirmation', () => {
+  it('posts the logout confirmation to the end session endpoint', () => {
+    const {container} = render(<LogoutConfirmation />);
+
+    const form = container.querySelector('form');
+    expect(form).toHaveAttribute('method', 'post');
+    expect(form).toHaveAttribute('action', 'https://api.example.com/oauth2/logout');
+    const input = container.querySelector('input[name="logout_confirmation"]');
+    expect(input).toHaveValue('confirmation-state');
+    expect(screen.getByRole('button', {name: 'Sign out'})).toHaveAttribute('type', 'submit');
+  });
+
+  it('keeps the user signed in when the logout is declined', () => {
+    const {container} = render(<LogoutConfirmation />);
+
+    fireEvent.click(screen.getByRole('button', {name: 'Stay signed in'}));
+
+    expect(container.querySelector('form')).not.toBeInTheDocument();
+    expect(screen.getByText('You are still signed in.')).toBeInTheDocument();
+  });
+});
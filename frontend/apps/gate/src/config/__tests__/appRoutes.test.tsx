@@ -59,6 +59,12 @@ describe('appRoutes', () => {
     expect(inviteRoute).toBeDefined();
   });
 
+  it('has logout route in children', () => {
+    const rootRoute = appRoutes.find((route) => route.path === ROUTES.ROOT);
+    const logoutRoute = rootRoute?.children?.find((route) => route.path === ROUTES.AUTH.LOGOUT);
+    expect(logoutRoute).toBeDefined();
+  });
+
   it('has redirect from root to sign-in', () => {
     const rootRoute = appRoutes.find((route) => route.path === ROUTES.ROOT);
     const redirectRoute = rootRoute?.children?.find((route) => route.path === ROUTES.ROOT);
@@ -22,6 +22,7 @@ import ROUTES from '../constants/routes';
 import DefaultLayout from '../layouts/DefaultLayout';
 import AcceptInvitePage from '../pages/AcceptInvitePage';
 import ErrorPage from '../pages/ErrorPage';
+import LogoutPage from '../pages/LogoutPage';
 import RecoveryPage from '../pages/RecoveryPage';
 import SignInPage from '../pages/SignInPage';
 import SignUpPage from '../pages/SignUpPage';
@@ -68,6 +69,7 @@ const appRoutes: AppRoute[] = [
       {path: ROUTES.AUTH.RECOVERY, element: <RecoveryPage />},
       {path: ROUTES.AUTH.CALLBACK, element: <CallbackRoute />},
       {path: ROUTES.AUTH.ERROR, element: <ErrorPage />},
+      {path: ROUTES.AUTH.LOGOUT, element: <LogoutPage />},
     ],
   },
 ];
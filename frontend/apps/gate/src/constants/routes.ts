@@ -52,6 +52,10 @@ export interface Routes {
      * Recovery page route.
      */
     RECOVERY: string;
+    /**
+     * Logout page route.
+     */
+    LOGOUT: string;
   };
 }
 
@@ -78,6 +82,7 @@ const ROUTES: Routes = {
     INVITE: '/invite',
     CALLBACK: '/callback',
     RECOVERY: '/recovery',
+    LOGOUT: '/logout',
   },
 } as const;
 
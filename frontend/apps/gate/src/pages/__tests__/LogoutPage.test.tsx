@@ -0,0 +1,66 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {render, screen} from '@thunderid/test-utils';
+import {describe, it, expect, vi, beforeEach} from 'vitest';
+import LogoutPage from '../LogoutPage';
+
+const {mockSearchParams} = vi.hoisted(() => ({
+  mockSearchParams: {current: new URLSearchParams()},
+}));
+
+// Mock react-router hooks
+vi.mock('react-router', () => ({
+  useSearchParams: () => [mockSearchParams.current],
+}));
+
+// Mock the Logout component
+vi.mock('../../components/Logout/Logout', () => ({
+  default: () => <div data-testid="logout-component">Logout Component</div>,
+}));
+
+// Mock the LogoutConfirmation component
+vi.mock('../../components/Logout/LogoutConfirmation', () => ({
+  LOGOUT_CONFIRMATION_PARAM: 'logout_confirmation',
+  default: () => <div data-testid="logout-confirmation-component">Logout Confirmation Component</div>,
+}));
+
+describe('LogoutPage', () => {
+  beforeEach(() => {
+    mockSearchParams.current = new URLSearchParams({state: 'logout-state'});
+  });
+
+  it('renders without crashing', () => {
+    const {container} = render(<LogoutPage />);
+    expect(container).toBeInTheDocument();
+  });
+
+  it('renders Logout component', () => {
+    render(<LogoutPage />);
+    expect(screen.getByTestId('logout-component')).toBeInTheDocument();
+  });
+
+  it('renders LogoutConfirmation component for a logout confirmation', () => {
+    mockSearchParams.current = new URLSearchParams({logout_confirmation: 'confirmation-state'});
+
+    render(<LogoutPage />);
+
+    expect(screen.getByTestId('logout-confirmation-component')).toBeInTheDocument();
+    expect(screen.queryByTestId('logout-component')).not.toBeInTheDocument();
+  });
+});
@@ -19,7 +19,7 @@
 import {EmbeddedFlowComponentType, EmbeddedFlowEventType, type ConsentPurpose} from '@asgardeo/react';
 import type {JSX} from 'react';
 import BlockAdapter from './adapters/BlockAdapter';
-import ConsentAdapter from './adapters/ConsentAdapter';
+import ConsentAdapter, {type ConsentScope} from './adapters/ConsentAdapter';
 import CopyableTextAdapter from './adapters/CopyableTextAdapter';
 import DividerAdapter from './adapters/DividerAdapter';
 import IconAdapter from './adapters/IconAdapter';
@@ -147,6 +147,7 @@ export default function FlowComponentRenderer({
             consentData={
               additionalData?.['consentPrompt'] as string | ConsentPurpose[] | {purposes: ConsentPurpose[]} | undefined
             }
+            scopesData={additionalData?.['consentScopes'] as string | ConsentScope[] | undefined}
             formValues={values}
             onInputChange={onInputChange}
           />
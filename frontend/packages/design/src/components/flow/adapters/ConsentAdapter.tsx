@@ -21,6 +21,35 @@ import {cn} from '@thunderid/utils';
 import {Box, Divider, FormControlLabel, Switch, Typography} from '@wso2/oxygen-ui';
 import type {JSX} from 'react';
 
+/**
+ * A permission scope requested by the application, described using the details registered on its resource server.
+ */
+export interface ConsentScope {
+  /** The scope value requested by the application */
+  scope: string;
+  /** Display name of the permission */
+  name: string;
+  /** Description of the permission */
+  description?: string;
+  /** Display name of the resource server that defines the permission */
+  resourceServer?: string;
+}
+
+/**
+ * Parses the requested scopes received in additionalData.consentScopes.
+ */
+function parseConsentScopes(scopesData?: string | ConsentScope[]): ConsentScope[] {
+  if (!scopesData) return [];
+  if (Array.isArray(scopesData)) return scopesData;
+
+  try {
+    const parsed: unknown = JSON.parse(scopesData);
+    return Array.isArray(parsed) ? (parsed as ConsentScope[]) : [];
+  } catch {
+    return [];
+  }
+}
+
 /**
  * Props for the ConsentAdapter component.
  *  Includes the raw consent data from the backend, current form values for tracking optional checkbox state,
@@ -29,6 +58,8 @@ import type {JSX} from 'react';
 interface ConsentAdapterProps {
   /** Raw consent data from additionalData.consentPrompt */
   consentData?: string | ConsentPurpose[] | {purposes: ConsentPurpose[]};
+  /** Requested permission scopes from additionalData.consentScopes */
+  scopesData?: string | ConsentScope[];
   /** Current form values for tracking optional checkbox state */
   formValues: Record<string, string>;
   /** Handler invoked when the user toggles an optional attribute */
@@ -43,15 +74,50 @@ interface ConsentAdapterProps {
  */
 export default function ConsentAdapter({
   consentData = undefined,
+  scopesData = undefined,
   formValues,
   onInputChange,
 }: ConsentAdapterProps): JSX.Element | null {
   if (!consentData) return null;
 
+  const scopes: ConsentScope[] = parseConsentScopes(scopesData);
+
   return (
     <Consent consentData={consentData} formValues={formValues} onInputChange={onInputChange}>
       {({purposes}: ConsentRenderProps) => (
         <Box className={cn('Flow--consent')} sx={{display: 'flex', flexDirection: 'column', gap: 2, mt: 1}}>
+          {scopes.length > 0 && (
+            <Box>
+              <Typography className={cn('Text--subtitle2')} variant="subtitle2" fontWeight="bold" sx={{mb: 0.5}}>
+                Requested Permissions
+              </Typography>
+              <Box sx={{display: 'flex', flexDirection: 'column'}}>
+                {scopes.map((scope) => (
+                  <Box key={scope.scope} sx={{px: 1, py: 0.5}}>
+                    <Typography className={cn('Text--body2')} variant="body2" sx={{fontWeight: 500}}>
+                      {scope.name || scope.scope}
+                      {scope.resourceServer && (
+                        <Typography component="span" variant="caption" color="text.secondary" sx={{ml: 1}}>
+                          {scope.resourceServer}
+                        </Typography>
+                      )}
+                    </Typography>
+                    {scope.description && (
+                      <Typography
+                        className={cn('Text--caption')}
+                        variant="caption"
+                        color="text.secondary"
+                        sx={{display: 'block'}}
+                      >
+                        {scope.description}
+                      </Typography>
+                    )}
+                  </Box>
+                ))}
+              </Box>
+              {purposes.length > 0 && <Divider className={cn('Divider--root')} sx={{mt: 2}} />}
+            </Box>
+          )}
           {purposes.map((purpose, idx) => (
             <Box key={purpose.purposeId ?? idx}>
               {purpose.essential && purpose.essential.length > 0 && (
@@ -1238,6 +1238,14 @@ const translations = {
     signUpSuccess: 'Account created successfully',
     passwordResetSent: 'Password reset link sent to your email',
     passwordResetSuccess: 'Password reset successfully',
+    signingOut: 'Signing you out...',
+    logoutConfirmation: {
+      title: 'Sign out',
+      description: 'Do you want to sign out?',
+      confirm: 'Sign out',
+      decline: 'Stay signed in',
+      declined: 'You are still signed in.',
+    },
   },
 
   // ============================================================================
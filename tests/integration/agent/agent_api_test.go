@@ -32,10 +32,11 @@ import (
 )
 
 const (
-	testServerURL = "https://localhost:8095"
 	agentBasePath = "/agents"
 )
 
+var testServerURL = testutils.TestServerURL
+
 var (
 	testOU = testutils.OrganizationUnit{
 		Handle:      "test_agent_ou",
@@ -33,9 +33,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	testServerURL = "https://localhost:8095"
-)
+var testServerURL = testutils.TestServerURL
 
 var (
 	testOU = testutils.OrganizationUnit{
@@ -30,13 +30,13 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
+var (
 	// mockConsentServerPort is the port the mock OpenFGC consent server will bind to.
-	mockConsentServerPort = 8096
+	mockConsentServerPort = testutils.MockPort(8096)
 	// mockConsentServerBaseURL is the inspection/test base URL of the mock server.
-	mockConsentServerBaseURL = "http://localhost:8096"
+	mockConsentServerBaseURL = fmt.Sprintf("http://localhost:%d", mockConsentServerPort)
 	// mockConsentServerAPIBaseURL is the API base URL passed to the server's consent config.
-	mockConsentServerAPIBaseURL = "http://localhost:8096/api/v1"
+	mockConsentServerAPIBaseURL = mockConsentServerBaseURL + "/api/v1"
 )
 
 // consentEnabledPatch is the deployment.yaml patch applied in SetupSuite to enable the
@@ -34,9 +34,10 @@ import (
 const (
 	githubAuthStart  = "/auth/oauth/github/start"
 	githubAuthFinish = "/auth/oauth/github/finish"
-	mockGithubPort   = 8091
 )
 
+var mockGithubPort = testutils.MockPort(8091)
+
 var githubAuthTestOU = testutils.OrganizationUnit{
 	Handle:      "github-auth-test-ou",
 	Name:        "GitHub Auth Test Organization Unit",
@@ -176,7 +177,7 @@ func (suite *GithubAuthTestSuite) SetupSuite() {
 			},
 			{
 				Name:     "redirect_uri",
-				Value:    "https://localhost:8095/callback",
+				Value:    testutils.TestServerURL + "/callback",
 				IsSecret: false,
 			},
 		},
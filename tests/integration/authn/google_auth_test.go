@@ -32,10 +32,13 @@ import (
 )
 
 const (
-	testServerURL    = testutils.TestServerURL
 	googleAuthStart  = "/auth/oauth/google/start"
 	googleAuthFinish = "/auth/oauth/google/finish"
-	mockGooglePort   = 8090
+)
+
+var (
+	testServerURL  = testutils.TestServerURL
+	mockGooglePort = testutils.MockPort(8090)
 )
 
 var googleAuthTestOU = testutils.OrganizationUnit{
@@ -176,7 +179,7 @@ func (suite *GoogleAuthTestSuite) SetupSuite() {
 			},
 			{
 				Name:     "redirect_uri",
-				Value:    "https://localhost:8095/callback",
+				Value:    testutils.TestServerURL + "/callback",
 				IsSecret: false,
 			},
 		},
@@ -34,9 +34,10 @@ import (
 const (
 	oauthAuthStart  = "/auth/oauth/standard/start"
 	oauthAuthFinish = "/auth/oauth/standard/finish"
-	mockOAuthPort   = 8092
 )
 
+var mockOAuthPort = testutils.MockPort(8092)
+
 var oauthAuthTestOU = testutils.OrganizationUnit{
 	Handle:      "oauth-auth-test-ou",
 	Name:        "OAuth Auth Test Organization Unit",
@@ -168,7 +169,7 @@ func (suite *OAuthAuthTestSuite) SetupSuite() {
 			},
 			{
 				Name:     "redirect_uri",
-				Value:    "https://localhost:8095/callback",
+				Value:    testutils.TestServerURL + "/callback",
 				IsSecret: false,
 			},
 		},
@@ -33,9 +33,10 @@ import (
 const (
 	oidcAuthStart  = "/auth/oauth/standard/start"
 	oidcAuthFinish = "/auth/oauth/standard/finish"
-	mockOIDCPort   = 8093
 )
 
+var mockOIDCPort = testutils.MockPort(8093)
+
 var oidcAuthTestOU = testutils.OrganizationUnit{
 	Handle:      "oidc-auth-test-ou",
 	Name:        "OIDC Auth Test Organization Unit",
@@ -177,7 +178,7 @@ func (suite *OIDCAuthTestSuite) SetupSuite() {
 			},
 			{
 				Name:     "redirect_uri",
-				Value:    "https://localhost:8095/callback",
+				Value:    testutils.TestServerURL + "/callback",
 				IsSecret: false,
 			},
 		},
@@ -32,12 +32,13 @@ import (
 )
 
 const (
-	mockNotificationServerPort = 8099
-	smsOTPAuthSendEndpoint     = "/auth/otp/sms/send"
-	smsOTPAuthVerifyEndpoint   = "/auth/otp/sms/verify"
-	testMobileNumber           = "+1234567890"
+	smsOTPAuthSendEndpoint   = "/auth/otp/sms/send"
+	smsOTPAuthVerifyEndpoint = "/auth/otp/sms/verify"
+	testMobileNumber         = "+1234567890"
 )
 
+var mockNotificationServerPort = testutils.MockPort(8099)
+
 var smsOTPEntityType = testutils.UserType{
 	Name: "smsotp_user",
 	Schema: map[string]interface{}{
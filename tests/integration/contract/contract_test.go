@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package contract
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/thunder-id/thunderid/tests/integration/testutils"
+	"github.com/thunder-id/thunderid/tests/integration/testutils/openapi"
+)
+
+// unknownID is a well-formed identifier that does not belong to any resource.
+const unknownID = "7f3c1e9a-52b4-4d0e-9a61-000000000000"
+
+var testServerURL = testutils.TestServerURL
+
+type contractTestCase struct {
+	name           string
+	method         string
+	path           string
+	body           string
+	expectedStatus int
+}
+
+// ContractTestSuite validates live API responses against the OpenAPI specifications in the api directory.
+type ContractTestSuite struct {
+	suite.Suite
+	specs *openapi.Specs
+}
+
+func TestContractTestSuite(t *testing.T) {
+	suite.Run(t, new(ContractTestSuite))
+}
+
+func (ts *ContractTestSuite) SetupSuite() {
+	specs, err := openapi.LoadSpecs(openapi.DefaultSpecDir())
+	ts.Require().NoError(err, "Failed to load the OpenAPI specifications")
+	ts.specs = specs
+}
+
+// TestSpecReferencesResolve verifies that every reference in the specifications points to a definition.
+func (ts *ContractTestSuite) TestSpecReferencesResolve() {
+	for _, spec := range ts.specs.All() {
+		ts.Run(spec.Name, func() {
+			ts.Assert().NoError(spec.CheckReferences())
+		})
+	}
+}
+
+// TestListResponses validates the responses of the list and health endpoints.
+func (ts *ContractTestSuite) TestListResponses() {
+	ts.runContractTests([]contractTestCase{
+		{name: "Liveness", method: http.MethodGet, path: "/health/liveness", expectedStatus: http.StatusOK},
+		{name: "Readiness", method: http.MethodGet, path: "/health/readiness", expectedStatus: http.StatusOK},
+		{name: "Users", method: http.MethodGet, path: "/users?limit=5", expectedStatus: http.StatusOK},
+		{name: "User types", method: http.MethodGet, path: "/user-types", expectedStatus: http.StatusOK},
+		{name: "Groups", method: http.MethodGet, path: "/groups?limit=5", expectedStatus: http.StatusOK},
+		{name: "Roles", method: http.MethodGet, path: "/roles?limit=5", expectedStatus: http.StatusOK},
+		{name: "Organization units", method: http.MethodGet, path: "/organization-units",
+			expectedStatus: http.StatusOK},
+		{name: "Applications", method: http.MethodGet, path: "/applications", expectedStatus: http.StatusOK},
+		{name: "Identity providers", method: http.MethodGet, path: "/identity-providers",
+			expectedStatus: http.StatusOK},
+		{name: "Notification senders", method: http.MethodGet, path: "/notification-senders/message",
+			expectedStatus: http.StatusOK},
+		{name: "Flows", method: http.MethodGet, path: "/flows", expectedStatus: http.StatusOK},
+		{name: "Themes", method: http.MethodGet, path: "/design/themes", expectedStatus: http.StatusOK},
+		{name: "Layouts", method: http.MethodGet, path: "/design/layouts", expectedStatus: http.StatusOK},
+		{name: "Resource servers", method: http.MethodGet, path: "/resource-servers", expectedStatus: http.StatusOK},
+		{name: "Agents", method: http.MethodGet, path: "/agents", expectedStatus: http.StatusOK},
+		{name: "Agent types", method: http.MethodGet, path: "/agent-types", expectedStatus: http.StatusOK},
+		{name: "Languages", method: http.MethodGet, path: "/i18n/languages", expectedStatus: http.StatusOK},
+		{name: "Templates", method: http.MethodGet, path: "/templates", expectedStatus: http.StatusOK},
+	})
+}
+
+// TestNotFoundResponses validates the error envelopes returned for unknown resources.
+func (ts *ContractTestSuite) TestNotFoundResponses() {
+	ts.runContractTests([]contractTestCase{
+		{name: "User", method: http.MethodGet, path: "/users/" + unknownID, expectedStatus: http.StatusNotFound},
+		{name: "User type", method: http.MethodGet, path: "/user-types/" + unknownID,
+			expectedStatus: http.StatusNotFound},
+		{name: "Group", method: http.MethodGet, path: "/groups/" + unknownID, expectedStatus: http.StatusNotFound},
+		{name: "Role", method: http.MethodGet, path: "/roles/" + unknownID, expectedStatus: http.StatusNotFound},
+		{name: "Organization unit", method: http.MethodGet, path: "/organization-units/" + unknownID,
+			expectedStatus: http.StatusNotFound},
+		{name: "Application", method: http.MethodGet, path: "/applications/" + unknownID,
+			expectedStatus: http.StatusNotFound},
+		{name: "Identity provider", method: http.MethodGet, path: "/identity-providers/" + unknownID,
+			expectedStatus: http.StatusNotFound},
+		{name: "Notification sender", method: http.MethodGet, path: "/notification-senders/message/" + unknownID,
+			expectedStatus: http.StatusNotFound},
+		{name: "Flow", method: http.MethodGet, path: "/flows/" + unknownID, expectedStatus: http.StatusNotFound},
+		{name: "Theme", method: http.MethodGet, path: "/design/themes/" + unknownID,
+			expectedStatus: http.StatusNotFound},
+		{name: "Layout", method: http.MethodGet, path: "/design/layouts/" + unknownID,
+			expectedStatus: http.StatusNotFound},
+		{name: "Resource server", method: http.MethodGet, path: "/resource-servers/" + unknownID,
+			expectedStatus: http.StatusNotFound},
+		{name: "Agent", method: http.MethodGet, path: "/agents/" + unknownID, expectedStatus: http.StatusNotFound},
+	})
+}
+
+// TestBadRequestResponses validates the error envelopes returned for invalid requests.
+func (ts *ContractTestSuite) TestBadRequestResponses() {
+	ts.runContractTests([]contractTestCase{
+		{name: "Invalid pagination", method: http.MethodGet, path: "/users?limit=invalid",
+			expectedStatus: http.StatusBadRequest},
+		{name: "Invalid design resolve type", method: http.MethodGet, path: "/design/resolve?type=INVALID&id=x",
+			expectedStatus: http.StatusBadRequest},
+		{name: "Malformed group", method: http.MethodPost, path: "/groups", body: "{",
+			expectedStatus: http.StatusBadRequest},
+		{name: "Malformed organization unit", method: http.MethodPost, path: "/organization-units", body: "{",
+			expectedStatus: http.StatusBadRequest},
+	})
+}
+
+// runContractTests sends each request and validates the response against the documented operation.
+func (ts *ContractTestSuite) runContractTests(testCases []contractTestCase) {
+	client := testutils.GetHTTPClient()
+	for _, tc := range testCases {
+		ts.Run(tc.name, func() {
+			operation, err := ts.specs.FindOperation(tc.method, tc.path)
+			ts.Require().NoError(err)
+
+			var body io.Reader
+			if tc.body != "" {
+				body = bytes.NewBufferString(tc.body)
+			}
+			req, err := http.NewRequest(tc.method, testServerURL+tc.path, body)
+			ts.Require().NoError(err)
+			if tc.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+
+			resp, err := client.Do(req)
+			ts.Require().NoError(err)
+			defer resp.Body.Close()
+			respBody, err := io.ReadAll(resp.Body)
+			ts.Require().NoError(err)
+
+			ts.Assert().Equal(tc.expectedStatus, resp.StatusCode, "Unexpected status, body: %s", string(respBody))
+			ts.Assert().NoError(operation.ValidateResponse(resp.StatusCode, resp.Header.Get("Content-Type"),
+				respBody))
+		})
+	}
+}
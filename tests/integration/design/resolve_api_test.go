@@ -129,11 +129,20 @@ func (suite *ResolveAPITestSuite) TestResolveDesign_MissingID() {
 
 // Test Resolve Design - Unsupported Type
 func (suite *ResolveAPITestSuite) TestResolveDesign_UnsupportedType() {
-	_, statusCode, err := suite.resolveDesign("OU", "00000000-0000-0000-0000-000000000000")
+	_, statusCode, err := suite.resolveDesign("USER", "00000000-0000-0000-0000-000000000000")
 
 	suite.Error(err)
 	suite.Equal(http.StatusBadRequest, statusCode)
-	suite.Contains(err.Error(), "DSR-1003")
+	suite.Contains(err.Error(), "DSR-1001")
+}
+
+// Test Resolve Design - Organization Unit Not Found
+func (suite *ResolveAPITestSuite) TestResolveDesign_OrganizationUnitNotFound() {
+	_, statusCode, err := suite.resolveDesign("OU", "00000000-0000-0000-0000-000000000000")
+
+	suite.Error(err)
+	suite.Equal(http.StatusNotFound, statusCode)
+	suite.Contains(err.Error(), "DSR-1006")
 }
 
 // Test Resolve Design - Application Not Found
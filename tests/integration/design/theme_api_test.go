@@ -32,10 +32,11 @@ import (
 )
 
 const (
-	testServerURL = "https://localhost:8095"
 	themeBasePath = "/design/themes"
 )
 
+var testServerURL = testutils.TestServerURL
+
 var (
 	testTheme = json.RawMessage(`{
 		"direction": "ltr",
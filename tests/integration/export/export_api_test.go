@@ -31,9 +31,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	testServerURL = "https://localhost:8095"
-)
+var testServerURL = testutils.TestServerURL
 
 // ExportAPITestSuite is a test suite for export API tests.
 type ExportAPITestSuite struct {
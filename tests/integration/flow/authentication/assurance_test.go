@@ -28,9 +28,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	assuranceMockNotificationServerPort = 8099
-)
+var assuranceMockNotificationServerPort = testutils.MockPort(8099)
 
 // Authenticator names used in assurance
 const (
@@ -30,7 +30,6 @@ import (
 )
 
 const (
-	conditionalExecMockGooglePort    = 8093
 	conditionalExecNewUserSub        = "conditional-exec-new-user-sub"
 	conditionalExecNewUserEmail      = "newuser@conditional-exec-test.com"
 	conditionalExecExistingUserSub   = "conditional-exec-existing-user-sub"
@@ -38,6 +37,8 @@ const (
 	conditionalExecNewOUHandle       = "conditional_exec_ou"
 )
 
+var conditionalExecMockGooglePort = testutils.MockPort(8093)
+
 var (
 	conditionalExecFlow = testutils.Flow{
 		Name:     "Conditional Exec Flow",
@@ -20,6 +20,7 @@ package authentication
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strings"
 	"testing"
@@ -90,9 +91,7 @@ var (
 	}
 )
 
-const (
-	mockGithubFlowPort = 8092
-)
+var mockGithubFlowPort = testutils.MockPort(8092)
 
 var githubEntityType = testutils.UserType{
 	Name: "github_auth_user",
@@ -326,7 +325,7 @@ func (ts *GithubAuthFlowTestSuite) TestGithubAuthFlowInitiation() {
 	ts.Require().NotEmpty(flowStep.Data, "Flow data should not be empty")
 	ts.Require().NotEmpty(flowStep.Data.RedirectURL, "Redirect URL should not be empty")
 	redirectURLStr := flowStep.Data.RedirectURL
-	ts.Require().True(strings.HasPrefix(redirectURLStr, "http://localhost:8092/login/oauth/authorize"),
+	ts.Require().True(strings.HasPrefix(redirectURLStr, fmt.Sprintf("http://localhost:%d/login/oauth/authorize", mockGithubFlowPort)),
 		"Redirect URL should point to mock GitHub server")
 
 	// Parse and validate the redirect URL
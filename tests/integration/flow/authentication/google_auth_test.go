@@ -20,6 +20,7 @@ package authentication
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strings"
 	"testing"
@@ -90,9 +91,7 @@ var (
 	}
 )
 
-const (
-	mockGoogleFlowPort = 8093
-)
+var mockGoogleFlowPort = testutils.MockPort(8093)
 
 var googleEntityType = testutils.UserType{
 	Name: "google_auth_user",
@@ -338,7 +337,7 @@ func (ts *GoogleAuthFlowTestSuite) TestGoogleAuthFlowInitiation() {
 	ts.Require().NotEmpty(flowStep.Data, "Flow data should not be empty")
 	ts.Require().NotEmpty(flowStep.Data.RedirectURL, "Redirect URL should not be empty")
 	redirectURLStr := flowStep.Data.RedirectURL
-	ts.Require().True(strings.HasPrefix(redirectURLStr, "http://localhost:8093/o/oauth2/v2/auth"),
+	ts.Require().True(strings.HasPrefix(redirectURLStr, fmt.Sprintf("http://localhost:%d/o/oauth2/v2/auth", mockGoogleFlowPort)),
 		"Redirect URL should point to mock Google server")
 
 	// Parse and validate the redirect URL
@@ -374,46 +373,17 @@ func (ts *GoogleAuthFlowTestSuite) TestGoogleAuthFlowInvalidAppID() {
 }
 
 func (ts *GoogleAuthFlowTestSuite) TestGoogleAuthFlowCompleteSuccess() {
-	// Step 1: Initialize the flow by calling the flow execution API
-	flowStep, err := common.InitiateAuthenticationFlow(googleAuthTestAppID, false, nil, "")
-	if err != nil {
-		ts.T().Fatalf("Failed to initiate Google authentication flow: %v", err)
-	}
+	// Initiate the flow and complete the redirection with the authorization code issued by Google
+	simulator, err := testutils.RunFlow(googleAuthTestAppID, testutils.FlowTypeAuthentication,
+		testutils.FlowInputStep{FollowRedirect: true})
+	ts.Require().NoError(err, "Failed to run Google authentication flow")
 
-	// Verify flow status and type
-	ts.Require().Equal("INCOMPLETE", flowStep.FlowStatus, "Expected flow status to be INCOMPLETE")
-	ts.Require().Equal("REDIRECTION", flowStep.Type, "Expected flow type to be REDIRECT")
-	ts.Require().NotEmpty(flowStep.ExecutionID, "Execution ID should not be empty")
-
-	ExecutionID := flowStep.ExecutionID
-	redirectURLStr := flowStep.Data.RedirectURL
-	ts.Require().NotEmpty(redirectURLStr, "Redirect URL should not be empty")
-
-	// Step 2: Simulate user authorization at Google (get authorization code)
-	authCode, state, err := testutils.SimulateFederatedOAuthFlow(redirectURLStr)
-	if err != nil {
-		ts.T().Fatalf("Failed to simulate Google authorization: %v", err)
-	}
-	ts.Require().NotEmpty(authCode, "Authorization code should not be empty")
-
-	// Step 3: Complete the flow with the authorization code
-	inputs := map[string]string{
-		"code":  authCode,
-		"state": state,
-	}
-
-	completeFlowStep, err := common.CompleteFlow(ExecutionID, inputs, "", flowStep.ChallengeToken)
-	if err != nil {
-		ts.T().Fatalf("Failed to complete Google authentication flow: %v", err)
-	}
-
-	// Verify flow completion
-	ts.Require().Equal("COMPLETE", completeFlowStep.FlowStatus, "Expected flow status to be COMPLETE")
-	ts.Require().NotEmpty(completeFlowStep.Assertion, "Assertion token should be present")
+	assertion, err := simulator.Assertion()
+	ts.Require().NoError(err, "Expected the flow to complete with an assertion")
 
 	// Validate JWT assertion fields using common utility
 	jwtClaims, err := testutils.ValidateJWTAssertionFields(
-		completeFlowStep.Assertion,
+		assertion,
 		googleAuthTestAppID,
 		googleEntityType.Name,
 		googleAuthTestOU.ID,
@@ -20,6 +20,7 @@ package authentication
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -28,9 +29,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	mockHTTPServerPort = 9091
-)
+var mockHTTPServerPort = testutils.MockPort(9091)
 
 var (
 	httpRequestExecutorFlow = testutils.Flow{
@@ -55,7 +54,7 @@ var (
 				"id":   "http_request_notification",
 				"type": "TASK_EXECUTION",
 				"properties": map[string]interface{}{
-					"url":    "http://localhost:9091/api/notifications",
+					"url":    fmt.Sprintf("http://localhost:%d/api/notifications", mockHTTPServerPort),
 					"method": "POST",
 					"headers": "{\"Content-Type\": \"application/json\", " +
 						"\"X-Flow-Id\": \"{{ context.flowID }}\"}",
@@ -107,7 +106,7 @@ var (
 				"id":   "http_request_notification",
 				"type": "TASK_EXECUTION",
 				"properties": map[string]interface{}{
-					"url":           "http://localhost:9091/api/error",
+					"url":           fmt.Sprintf("http://localhost:%d/api/error", mockHTTPServerPort),
 					"method":        "POST",
 					"headers":       "{\"Content-Type\": \"application/json\"}",
 					"body":          "{\"userId\": \"{{ context.userId }}\"}",
@@ -156,7 +155,7 @@ var (
 				"id":   "http_request_notification",
 				"type": "TASK_EXECUTION",
 				"properties": map[string]interface{}{
-					"url":           "http://localhost:9091/api/error",
+					"url":           fmt.Sprintf("http://localhost:%d/api/error", mockHTTPServerPort),
 					"method":        "POST",
 					"headers":       "{\"Content-Type\": \"application/json\"}",
 					"body":          "{\"userId\": \"{{ context.userId }}\"}",
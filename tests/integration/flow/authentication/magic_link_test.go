@@ -68,7 +68,7 @@ var magicLinkAuthFlow = testutils.Flow{
 			"id":   "send_magic_link",
 			"type": "TASK_EXECUTION",
 			"properties": map[string]interface{}{
-				"magicLinkURL": "https://localhost:8095/gate/signin",
+				"magicLinkURL": testutils.TestServerURL + "/gate/signin",
 			},
 			"executor": map[string]interface{}{
 				"name": "MagicLinkAuthExecutor",
@@ -29,9 +29,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/integration/testutils"
 )
 
-const (
-	mockMultiActionGooglePort = 8099
-)
+var mockMultiActionGooglePort = testutils.MockPort(8099)
 
 var (
 	multiActionInputBindingFlow = testutils.Flow{
@@ -28,9 +28,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	mockPromptActionsNotificationServerPort = 8098
-)
+var mockPromptActionsNotificationServerPort = testutils.MockPort(8098)
 
 var (
 	promptActionsFlow = testutils.Flow{
@@ -28,9 +28,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	mockNotificationServerPort = 8098
-)
+var mockNotificationServerPort = testutils.MockPort(8098)
 
 var (
 	smsAuthFlowWithMobile = testutils.Flow{
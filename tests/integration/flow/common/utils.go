@@ -29,7 +29,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/integration/testutils"
 )
 
-const testServerURL = "https://localhost:8095"
+var testServerURL = testutils.TestServerURL
 
 // InitiateAuthenticationFlow initiates the authentication flow
 func InitiateAuthenticationFlow(appID string, verbose bool, inputs map[string]string, action string) (
@@ -30,10 +30,11 @@ import (
 )
 
 const (
-	testServerURL    = "https://localhost:8095"
 	flowMetaEndpoint = "/flow/meta"
 )
 
+var testServerURL = testutils.TestServerURL
+
 var (
 	testOU = testutils.OrganizationUnit{
 		Handle:          "flowmeta-test-ou",
@@ -52,7 +53,7 @@ var (
 		IsRegistrationFlowEnabled: true,
 		ClientID:                  "flowmeta_test_client",
 		ClientSecret:              "flowmeta_test_secret",
-		RedirectURIs:              []string{"https://localhost:8095/callback"},
+		RedirectURIs:              []string{testutils.TestServerURL + "/callback"},
 	}
 )
 
@@ -33,10 +33,11 @@ import (
 )
 
 const (
-	testServerURL = "https://localhost:8095"
 	flowsEndpoint = "/flows"
 )
 
+var testServerURL = testutils.TestServerURL
+
 var (
 	testAuthFlow = FlowDefinition{
 		Name:     "Test Authentication Flow",
@@ -28,9 +28,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	mockSMTPPort = 2525
-)
+var mockSMTPPort = testutils.MockPort(2525)
 
 // emailPatch configures Thunder to use the mock SMTP server.
 var emailPatch = map[string]interface{}{
@@ -29,9 +29,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	smsRecoveryMockNotificationPort = 8099
-)
+var smsRecoveryMockNotificationPort = testutils.MockPort(8099)
 
 var (
 	smsRecoveryOU = testutils.OrganizationUnit{
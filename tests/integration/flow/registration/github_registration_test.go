@@ -20,6 +20,7 @@ package registration
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strings"
 	"testing"
@@ -277,9 +278,7 @@ var (
 	githubRegTestOUID  string
 )
 
-const (
-	mockGithubRegFlowPort = 8092
-)
+var mockGithubRegFlowPort = testutils.MockPort(8092)
 
 type GithubRegistrationFlowTestSuite struct {
 	suite.Suite
@@ -485,7 +484,7 @@ func (ts *GithubRegistrationFlowTestSuite) TestGithubRegistrationFlowInitiation(
 	ts.Require().NotEmpty(flowStep.Data, "Flow data should not be empty")
 	ts.Require().NotEmpty(flowStep.Data.RedirectURL, "Redirect URL should not be empty")
 	redirectURLStr := flowStep.Data.RedirectURL
-	ts.Require().True(strings.HasPrefix(redirectURLStr, "http://localhost:8092/login/oauth/authorize"),
+	ts.Require().True(strings.HasPrefix(redirectURLStr, fmt.Sprintf("http://localhost:%d/login/oauth/authorize", mockGithubRegFlowPort)),
 		"Redirect URL should point to mock GitHub server")
 
 	// Parse and validate the redirect URL
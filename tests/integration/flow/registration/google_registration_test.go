@@ -20,6 +20,7 @@ package registration
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strings"
 	"testing"
@@ -258,9 +259,7 @@ var (
 	googleRegTestOUID  string
 )
 
-const (
-	mockGoogleRegFlowPort = 8093
-)
+var mockGoogleRegFlowPort = testutils.MockPort(8093)
 
 type GoogleRegistrationFlowTestSuite struct {
 	suite.Suite
@@ -468,7 +467,7 @@ func (ts *GoogleRegistrationFlowTestSuite) TestGoogleRegistrationFlowInitiation(
 	ts.Require().NotEmpty(flowStep.Data, "Flow data should not be empty")
 	ts.Require().NotEmpty(flowStep.Data.RedirectURL, "Redirect URL should not be empty")
 	redirectURLStr := flowStep.Data.RedirectURL
-	ts.Require().True(strings.HasPrefix(redirectURLStr, "http://localhost:8093/o/oauth2/v2/auth"),
+	ts.Require().True(strings.HasPrefix(redirectURLStr, fmt.Sprintf("http://localhost:%d/o/oauth2/v2/auth", mockGoogleRegFlowPort)),
 		"Redirect URL should point to mock Google server")
 
 	// Parse and validate the redirect URL
@@ -202,9 +202,7 @@ var (
 	googleRegGroupRoleTestOUID  string
 )
 
-const (
-	mockGoogleRegGroupRoleFlowPort = 8094
-)
+var mockGoogleRegGroupRoleFlowPort = testutils.MockPort(8094)
 
 type GoogleRegistrationGroupRoleTestSuite struct {
 	suite.Suite
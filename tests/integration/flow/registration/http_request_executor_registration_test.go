@@ -19,6 +19,7 @@
 package registration
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -27,9 +28,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	mockHTTPServerPortReg = 9091
-)
+var mockHTTPServerPortReg = testutils.MockPort(9091)
 
 var (
 	httpRequestRegistrationFlow = testutils.Flow{
@@ -147,7 +146,7 @@ var (
 				"id":   "create_external_profile",
 				"type": "TASK_EXECUTION",
 				"properties": map[string]interface{}{
-					"url":    "http://localhost:9091/api/users",
+					"url":    fmt.Sprintf("http://localhost:%d/api/users", mockHTTPServerPortReg),
 					"method": "POST",
 					"headers": map[string]interface{}{
 						"Content-Type":  "application/json",
@@ -28,10 +28,10 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	mockGoogleRuntimeDataPort       = 8096
-	mockNotificationRuntimeDataPort = 8097
-	mockHTTPRuntimeDataPort         = 9092
+var (
+	mockGoogleRuntimeDataPort       = testutils.MockPort(8096)
+	mockNotificationRuntimeDataPort = testutils.MockPort(8097)
+	mockHTTPRuntimeDataPort         = testutils.MockPort(9092)
 )
 
 var (
@@ -185,7 +185,7 @@ var (
 				"id":   "http_request",
 				"type": "TASK_EXECUTION",
 				"properties": map[string]interface{}{
-					"url":    "http://localhost:9092/api/notifications",
+					"url":    fmt.Sprintf("http://localhost:%d/api/notifications", mockHTTPRuntimeDataPort),
 					"method": "POST",
 					"headers": map[string]interface{}{
 						"X-App-Id":    "{{ context.applicationId }}",
@@ -28,9 +28,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	mockNotificationServerPortOU = 8098
-)
+var mockNotificationServerPortOU = testutils.MockPort(8098)
 
 var (
 	basicRegistrationFlowWithOU = testutils.Flow{
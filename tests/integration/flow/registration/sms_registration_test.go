@@ -260,9 +260,7 @@ var (
 	}
 )
 
-const (
-	mockNotificationServerPort = 8098
-)
+var mockNotificationServerPort = testutils.MockPort(8098)
 
 type SMSRegistrationFlowTestSuite struct {
 	suite.Suite
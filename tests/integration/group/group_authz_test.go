@@ -27,6 +27,7 @@ import (
 	"testing"
 
 	"github.com/thunder-id/thunderid/tests/integration/testutils"
+	"github.com/thunder-id/thunderid/tests/integration/testutils/fixtures"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -53,13 +54,13 @@ import (
 type GroupAuthzTestSuite struct {
 	suite.Suite
 
+	// Seeded OUs, users and groups
+	fixtures *fixtures.Fixtures
+
 	// Admin-created OUs
 	groupOU1ID string
 	groupOU2ID string
 
-	// user types (one for the user-manager in OU1)
-	entityTypeOU1ID string
-
 	// Test role and manager
 	groupMgrRoleID      string
 	groupMgrUserID      string
@@ -68,17 +69,14 @@ type GroupAuthzTestSuite struct {
 	targetGroupOU2ID    string
 
 	// Member users created in each OU to test membership authz
-	memberUserOU1ID   string
-	memberUserOU2ID   string
-	memberSchemaOU2ID string
+	memberUserOU1ID string
+	memberUserOU2ID string
 
 	// HTTP client carrying the user-manager's system:group scoped token
 	groupAdminClient *http.Client
 }
 
 const (
-	groupAuthzServerURL = "https://localhost:8095"
-
 	groupAuthzOU1Handle = "authz-group-ou1"
 	groupAuthzOU2Handle = "authz-group-ou2"
 
@@ -87,17 +85,23 @@ const (
 	groupMgrRoleName  = "Group Admin (group-authz-test)"
 	entityTypeOU1Name = "authz-mgr-schema-ou1"
 
-	groupAuthzDevelopClientID    = "CONSOLE"
-	groupAuthzDevelopRedirectURI = "https://localhost:8095/console"
+	groupAuthzDevelopClientID = "CONSOLE"
 
 	memberSchemaOU2Name = "authz-member-schema-ou2"
 
+	deletableGroupOU1Key = "deletable-group-ou1"
+
 	memberOU1Username = "authz-member-ou1"
 	memberOU1Password = "MemberOU1@123"
 	memberOU2Username = "authz-member-ou2"
 	memberOU2Password = "MemberOU2@123"
 )
 
+var (
+	groupAuthzServerURL          = testutils.TestServerURL
+	groupAuthzDevelopRedirectURI = testutils.TestServerURL + "/console"
+)
+
 func TestGroupAuthzTestSuite(t *testing.T) {
 	suite.Run(t, new(GroupAuthzTestSuite))
 }
@@ -107,109 +111,70 @@ func TestGroupAuthzTestSuite(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func (ts *GroupAuthzTestSuite) SetupSuite() {
-	// ---- 1. Create the two OUs ----
-	ou1ID, err := testutils.CreateOrganizationUnit(testutils.OrganizationUnit{
-		Handle:      groupAuthzOU1Handle,
-		Name:        "Group Authz Test OU1",
-		Description: "Primary OU for group authz integration test",
-	})
-	ts.Require().NoError(err, "create group-authz OU1")
-	ts.groupOU1ID = ou1ID
-
-	ou2ID, err := testutils.CreateOrganizationUnit(testutils.OrganizationUnit{
-		Handle:      groupAuthzOU2Handle,
-		Name:        "Group Authz Test OU2",
-		Description: "Sibling OU for group authz integration test",
-	})
-	ts.Require().NoError(err, "create group-authz OU2")
-	ts.groupOU2ID = ou2ID
+	// ---- 1-4. Seed the OUs, user types, users and target groups ----
+	userSchema := map[string]interface{}{
+		"username":     map[string]interface{}{"type": "string"},
+		"password":     map[string]interface{}{"type": "string", "credential": true},
+		"display_name": map[string]interface{}{"type": "string"},
+	}
+	userAttributes := func(username, password, displayName string) json.RawMessage {
+		return json.RawMessage(fmt.Sprintf(`{"username": %q, "password": %q, "display_name": %q}`,
+			username, password, displayName))
+	}
 
-	// ---- 2. Create user type for user-manager in OU1 ----
-	schemaOU1ID, err := testutils.CreateUserType(testutils.UserType{
-		Name:               entityTypeOU1Name,
-		OUID: ts.groupOU1ID,
-		Schema: map[string]interface{}{
-			"username":     map[string]interface{}{"type": "string"},
-			"password":     map[string]interface{}{"type": "string", "credential": true},
-			"display_name": map[string]interface{}{"type": "string"},
+	f, err := fixtures.Seed(fixtures.Spec{
+		OrganizationUnits: []fixtures.OrganizationUnit{
+			{Key: "ou1", OrganizationUnit: testutils.OrganizationUnit{
+				Handle:      groupAuthzOU1Handle,
+				Name:        "Group Authz Test OU1",
+				Description: "Primary OU for group authz integration test",
+			}},
+			{Key: "ou2", OrganizationUnit: testutils.OrganizationUnit{
+				Handle:      groupAuthzOU2Handle,
+				Name:        "Group Authz Test OU2",
+				Description: "Sibling OU for group authz integration test",
+			}},
 		},
-	})
-	ts.Require().NoError(err, "create user type for OU1")
-	ts.entityTypeOU1ID = schemaOU1ID
-
-	// ---- 3. Create the user-manager in OU1 ----
-	userMgrID, err := testutils.CreateUser(testutils.User{
-		Type:             entityTypeOU1Name,
-		OUID: ts.groupOU1ID,
-		Attributes: json.RawMessage(fmt.Sprintf(
-			`{"username": %q, "password": %q, "display_name": "Group Manager"}`,
-			groupMgrUsername, groupMgrPassword,
-		)),
-	})
-	ts.Require().NoError(err, "create group-manager user")
-	ts.groupMgrUserID = userMgrID
-
-	// ---- 3b. Create a plain member user in OU1 (used in membership authz tests) ----
-	memberOU1ID, err := testutils.CreateUser(testutils.User{
-		Type:             entityTypeOU1Name,
-		OUID: ts.groupOU1ID,
-		Attributes: json.RawMessage(fmt.Sprintf(
-			`{"username": %q, "password": %q, "display_name": "Member OU1"}`,
-			memberOU1Username, memberOU1Password,
-		)),
-	})
-	ts.Require().NoError(err, "create member user in OU1")
-	ts.memberUserOU1ID = memberOU1ID
-
-	// ---- 3c. Create a user type for OU2 ----
-	schemaOU2ID, err := testutils.CreateUserType(testutils.UserType{
-		Name:               memberSchemaOU2Name,
-		OUID: ts.groupOU2ID,
-		Schema: map[string]interface{}{
-			"username":     map[string]interface{}{"type": "string"},
-			"password":     map[string]interface{}{"type": "string", "credential": true},
-			"display_name": map[string]interface{}{"type": "string"},
+		UserTypes: []fixtures.UserType{
+			{Key: "user-type-ou1", OUKey: "ou1",
+				UserType: testutils.UserType{Name: entityTypeOU1Name, Schema: userSchema}},
+			{Key: "user-type-ou2", OUKey: "ou2",
+				UserType: testutils.UserType{Name: memberSchemaOU2Name, Schema: userSchema}},
+		},
+		Users: []fixtures.User{
+			{Key: "group-manager", OUKey: "ou1", User: testutils.User{
+				Type:       entityTypeOU1Name,
+				Attributes: userAttributes(groupMgrUsername, groupMgrPassword, "Group Manager"),
+			}},
+			{Key: "member-ou1", OUKey: "ou1", User: testutils.User{
+				Type:       entityTypeOU1Name,
+				Attributes: userAttributes(memberOU1Username, memberOU1Password, "Member OU1"),
+			}},
+			{Key: "member-ou2", OUKey: "ou2", User: testutils.User{
+				Type:       memberSchemaOU2Name,
+				Attributes: userAttributes(memberOU2Username, memberOU2Password, "Member OU2"),
+			}},
+		},
+		Groups: []fixtures.Group{
+			{Key: "target-group-ou1", OUKey: "ou1",
+				Group: testutils.Group{Name: "authz-target-ou1", Description: "Target Group OU1"}},
+			{Key: deletableGroupOU1Key, OUKey: "ou1",
+				Group: testutils.Group{Name: "authz-deletable-ou1", Description: "Deletable Group OU1"}},
+			{Key: "target-group-ou2", OUKey: "ou2",
+				Group: testutils.Group{Name: "authz-target-ou2", Description: "Target Group OU2"}},
 		},
 	})
-	ts.Require().NoError(err, "create user type for OU2")
-	ts.memberSchemaOU2ID = schemaOU2ID
-
-	// ---- 3d. Create a plain member user in OU2 (used in membership authz tests) ----
-	memberOU2ID, err := testutils.CreateUser(testutils.User{
-		Type:             memberSchemaOU2Name,
-		OUID: ts.groupOU2ID,
-		Attributes: json.RawMessage(fmt.Sprintf(
-			`{"username": %q, "password": %q, "display_name": "Member OU2"}`,
-			memberOU2Username, memberOU2Password,
-		)),
-	})
-	ts.Require().NoError(err, "create member user in OU2")
-	ts.memberUserOU2ID = memberOU2ID
-
-	// ---- 4. Create target groups ----
-	targetOU1ID, err := testutils.CreateGroup(testutils.Group{
-		Name:               "authz-target-ou1",
-		Description:        "Target Group OU1",
-		OUID: ts.groupOU1ID,
-	})
-	ts.Require().NoError(err, "create target group in OU1")
-	ts.targetGroupOU1ID = targetOU1ID
-
-	deletableID, err := testutils.CreateGroup(testutils.Group{
-		Name:               "authz-deletable-ou1",
-		Description:        "Deletable Group OU1",
-		OUID: ts.groupOU1ID,
-	})
-	ts.Require().NoError(err, "create deletable group in OU1")
-	ts.deletableGroupOU1ID = deletableID
-
-	targetOU2ID, err := testutils.CreateGroup(testutils.Group{
-		Name:               "authz-target-ou2",
-		Description:        "Target Group OU2",
-		OUID: ts.groupOU2ID,
-	})
-	ts.Require().NoError(err, "create target group in OU2")
-	ts.targetGroupOU2ID = targetOU2ID
+	ts.Require().NoError(err, "seed group-authz fixtures")
+	ts.fixtures = f
+
+	ts.groupOU1ID = f.ID("ou1")
+	ts.groupOU2ID = f.ID("ou2")
+	ts.groupMgrUserID = f.ID("group-manager")
+	ts.memberUserOU1ID = f.ID("member-ou1")
+	ts.memberUserOU2ID = f.ID("member-ou2")
+	ts.targetGroupOU1ID = f.ID("target-group-ou1")
+	ts.deletableGroupOU1ID = f.ID(deletableGroupOU1Key)
+	ts.targetGroupOU2ID = f.ID("target-group-ou2")
 
 	// ---- 5. Look up the system resource server seeded by bootstrap ----
 	systemRSID, err := testutils.GetResourceServerByName("System")
@@ -257,52 +222,8 @@ func (ts *GroupAuthzTestSuite) TearDownSuite() {
 			ts.T().Logf("teardown: delete group-manager role: %v", err)
 		}
 	}
-	for _, id := range []string{ts.targetGroupOU1ID, ts.deletableGroupOU1ID} {
-		if id != "" {
-			if err := testutils.DeleteGroup(id); err != nil {
-				ts.T().Logf("teardown: delete group %s: %v", id, err)
-			}
-		}
-	}
-	if ts.groupMgrUserID != "" {
-		if err := testutils.DeleteUser(ts.groupMgrUserID); err != nil {
-			ts.T().Logf("teardown: delete user manager: %v", err)
-		}
-	}
-	if ts.memberUserOU1ID != "" {
-		if err := testutils.DeleteUser(ts.memberUserOU1ID); err != nil {
-			ts.T().Logf("teardown: delete member user OU1: %v", err)
-		}
-	}
-	if ts.memberUserOU2ID != "" {
-		if err := testutils.DeleteUser(ts.memberUserOU2ID); err != nil {
-			ts.T().Logf("teardown: delete member user OU2: %v", err)
-		}
-	}
-	if ts.targetGroupOU2ID != "" {
-		if err := testutils.DeleteGroup(ts.targetGroupOU2ID); err != nil {
-			ts.T().Logf("teardown: delete target group in OU2: %v", err)
-		}
-	}
-	if ts.memberSchemaOU2ID != "" {
-		if err := testutils.DeleteUserType(ts.memberSchemaOU2ID); err != nil {
-			ts.T().Logf("teardown: delete member schema OU2: %v", err)
-		}
-	}
-	if ts.entityTypeOU1ID != "" {
-		if err := testutils.DeleteUserType(ts.entityTypeOU1ID); err != nil {
-			ts.T().Logf("teardown: delete user type OU1: %v", err)
-		}
-	}
-	if ts.groupOU2ID != "" {
-		if err := testutils.DeleteOrganizationUnit(ts.groupOU2ID); err != nil {
-			ts.T().Logf("teardown: delete group-authz OU2: %v", err)
-		}
-	}
-	if ts.groupOU1ID != "" {
-		if err := testutils.DeleteOrganizationUnit(ts.groupOU1ID); err != nil {
-			ts.T().Logf("teardown: delete group-authz OU1: %v", err)
-		}
+	if err := ts.fixtures.Cleanup(); err != nil {
+		ts.T().Logf("teardown: clean up group-authz fixtures: %v", err)
 	}
 }
 
@@ -455,8 +376,8 @@ func (ts *GroupAuthzTestSuite) TestDeleteGroupInOwnOU() {
 	ts.Equal(http.StatusNoContent, resp.StatusCode,
 		"group-manager should be able to delete a group in their own OU")
 
-	// Clear so TearDownSuite does not attempt a double-delete.
-	ts.deletableGroupOU1ID = ""
+	// Forget so TearDownSuite does not attempt a double-delete.
+	ts.fixtures.Forget(deletableGroupOU1Key)
 }
 
 // TestDeleteGroupInOtherOU verifies the group-manager is denied deleting a group in OU2.
@@ -27,9 +27,7 @@ type I18nMessage struct {
 	DefaultValue string `json:"defaultValue,omitempty"`
 }
 
-const (
-	testServerURL = "https://localhost:8095"
-)
+var testServerURL = testutils.TestServerURL
 
 // MemberType represents the type of member entity.
 type MemberType string
@@ -26,9 +26,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	testServerURL = "https://localhost:8095"
-)
+var testServerURL = testutils.TestServerURL
 
 type HealthCheckAPITestSuite struct {
 	suite.Suite
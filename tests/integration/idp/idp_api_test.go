@@ -31,9 +31,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	testServerURL = testutils.TestServerURL
-)
+var testServerURL = testutils.TestServerURL
 
 var (
 	testGithubIdp = testutils.IDP{
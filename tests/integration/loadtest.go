@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/tests/integration/testutils/loadtest"
+)
+
+var (
+	loadConfigPath   string
+	loadDuration     time.Duration
+	loadReportPath   string
+	loadBaselinePath string
+	loadConfig       *loadtest.Config
+)
+
+// registerLoadTestFlags registers the flags of the load test mode.
+func registerLoadTestFlags() {
+	flag.StringVar(&loadConfigPath, "load", "",
+		"Run the load test described by the given configuration instead of the integration tests")
+	flag.DurationVar(&loadDuration, "load-duration", 0,
+		"Override the load test duration, e.g. 2h for a soak test")
+	flag.StringVar(&loadReportPath, "load-report", "", "Write the load test report as JSON to the given path")
+	flag.StringVar(&loadBaselinePath, "load-baseline", "",
+		"Fail when latencies regress beyond the configured tolerance of the given baseline report")
+}
+
+// isLoadTest reports whether the load test mode is enabled.
+func isLoadTest() bool {
+	return loadConfigPath != ""
+}
+
+// initLoadTest reads the load test configuration so that an invalid configuration fails before the server
+// is started.
+func initLoadTest() error {
+	cfg, err := loadtest.LoadConfig(loadConfigPath)
+	if err != nil {
+		return err
+	}
+	if loadDuration > 0 {
+		cfg.Duration = loadDuration
+	}
+	loadConfig = cfg
+	return nil
+}
+
+// runLoadTest drives the configured load against the server and fails when a threshold is exceeded or the
+// latencies regressed from the baseline.
+func runLoadTest() error {
+	var baseline *loadtest.Report
+	if loadBaselinePath != "" {
+		var err error
+		if baseline, err = loadtest.ReadReport(loadBaselinePath); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Running load test for %s after a warmup of %s...\n", loadConfig.Duration, loadConfig.Warmup)
+	report, err := loadtest.Run(loadConfig)
+	if err != nil {
+		return fmt.Errorf("failed to run load test: %w", err)
+	}
+	loadtest.PrintReport(os.Stdout, report)
+
+	if loadReportPath != "" {
+		if err := loadtest.WriteReport(report, loadReportPath); err != nil {
+			return err
+		}
+		fmt.Printf("Load test report written to %s\n", loadReportPath)
+	}
+
+	if violations := loadtest.Evaluate(loadConfig, report, baseline); len(violations) > 0 {
+		return errors.New("load test thresholds exceeded:\n  " + strings.Join(violations, "\n  "))
+	}
+	return nil
+}
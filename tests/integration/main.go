@@ -24,25 +24,51 @@ import (
 	"os"
 	"os/exec"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/thunder-id/thunderid/tests/integration/testutils"
 )
 
 const (
-	serverPort = "8095"
+	serverStartupTimeout = 60 * time.Second
 )
 
 var (
+	serverPort     = testutils.GetServerPortFromEnv()
 	zipFilePattern string
 	testRun        string
 	testPackage    string
+	parallelism    int
+	dbType         string
+	provisionDB    bool
 )
 
 func main() {
 	parseFlags()
 	initTests()
 
+	if isLoadTest() {
+		if err := initLoadTest(); err != nil {
+			fmt.Printf("Failed to initialize load test: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Split the test packages across parallel server instances if requested
+	if parallelism > 1 {
+		os.Exit(runParallel())
+	}
+
+	// Step 0: Provision the PostgreSQL database if requested
+	if provisionDB {
+		err := testutils.StartPostgres()
+		if err != nil {
+			fmt.Printf("Failed to provision PostgreSQL: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	// Step 1: Unzip the product
 	err := testutils.UnzipProduct()
 	if err != nil {
@@ -89,9 +115,14 @@ func main() {
 	}
 	defer testutils.StopServer()
 
-	// Wait for the server to start
-	fmt.Println("Waiting for the server to start...")
-	time.Sleep(5 * time.Second)
+	// Wait for the server to become ready
+	fmt.Println("Waiting for the server to become ready...")
+	err = testutils.WaitForServerReady(serverStartupTimeout)
+	if err != nil {
+		fmt.Printf("Failed to start server: %v\n", err)
+		testutils.StopServer()
+		os.Exit(1)
+	}
 
 	// Step 7: Obtain admin access token once for all test packages
 	fmt.Println("Obtaining admin access token...")
@@ -102,31 +133,71 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Step 8: Run all tests
-	err = runTests()
+	// Step 8: Run all tests, or the load test if requested
+	if isLoadTest() {
+		err = runLoadTest()
+	} else {
+		err = runTests()
+	}
 	if err != nil {
 		fmt.Printf("there are test failures: %v\n", err)
 		testutils.StopServer()
 		os.Exit(1)
 	}
 
+	// Stop the server before checking the coverage data, since the coverage counters are written on shutdown.
+	testutils.StopServer()
+	testutils.ReportCoverageData()
+
+	// The provisioned database is left running after a failure for inspection; it is recreated on the next run.
+	if provisionDB {
+		testutils.StopPostgres()
+	}
+
 	fmt.Println("All tests completed successfully!")
 }
 
 func parseFlags() {
 	flag.StringVar(&testRun, "run", "", "Run only tests matching the regular expression (passed to go test -run)")
 	flag.StringVar(&testPackage, "package", "./...", "Package(s) to test (default: ./...)")
+	flag.IntVar(&parallelism, "parallel", 0,
+		"Number of server instances to run the test packages against in parallel (default: 1)")
+	registerLoadTestFlags()
 	flag.Parse()
 }
 
 func initTests() {
 	// Read database type from environment variable
-	dbType := os.Getenv("DB_TYPE")
+	dbType = os.Getenv("DB_TYPE")
 	if dbType == "" {
 		dbType = "sqlite" // Default to SQLite
 	}
 	fmt.Printf("Database type: %s\n", dbType)
 
+	// PROVISION_DB=true starts a disposable PostgreSQL container instead of using an existing server
+	provisionDB, _ = strconv.ParseBool(os.Getenv("PROVISION_DB"))
+	if provisionDB && dbType != "postgres" {
+		fmt.Printf("PROVISION_DB is only supported for the postgres database type, ignoring it for %s\n", dbType)
+		provisionDB = false
+	}
+
+	// TEST_PARALLELISM sets the number of parallel server instances when the -parallel flag is not given
+	if parallelism == 0 {
+		parallelism, _ = strconv.Atoi(os.Getenv(parallelismEnvVar))
+	}
+	if parallelism < 1 || isShard() || isLoadTest() {
+		parallelism = 1
+	}
+	if parallelism > maxParallelism {
+		fmt.Printf("Parallelism is limited to %d server instances\n", maxParallelism)
+		parallelism = maxParallelism
+	}
+	if parallelism > 1 && dbType != "sqlite" {
+		fmt.Printf("Parallel server instances are only supported for the sqlite database type, running %s "+
+			"tests against a single server instance\n", dbType)
+		parallelism = 1
+	}
+
 	zipFilePattern = testutils.GetZipFilePattern()
 	if zipFilePattern == "" {
 		fmt.Println("Failed to determine the zip file pattern.")
@@ -140,19 +211,15 @@ func initTests() {
 }
 
 func runTests() error {
-	// Clean the test cache to avoid getting results from previous runs.
-	// This is important to avoid false positives in test results as the
-	// server and integration test suite are two separate applications.
-	cmd := exec.Command("go", "clean", "-testcache")
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	err := cmd.Run()
-	if err != nil {
-		return fmt.Errorf("failed to clean test cache: %w", err)
+	// The test cache of a parallel run is cleaned once before the shards start.
+	if !isShard() {
+		if err := cleanTestCache(); err != nil {
+			return err
+		}
 	}
 
 	// Determine command and build args
-	_, err = exec.LookPath("gotestsum")
+	_, err := exec.LookPath("gotestsum")
 	useGotestsum := err == nil
 
 	var cmdName string
@@ -173,10 +240,10 @@ func runTests() error {
 		args = append(args, "-run", testRun)
 		fmt.Printf("Test filter: -run %s\n", testRun)
 	}
-	args = append(args, testPackage)
+	args = append(args, strings.Fields(testPackage)...)
 	fmt.Printf("Test package: %s\n", testPackage)
 
-	cmd = exec.Command(cmdName, args...)
+	cmd := exec.Command(cmdName, args...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
@@ -191,3 +258,16 @@ func runTests() error {
 
 	return cmd.Run()
 }
+
+// cleanTestCache cleans the test cache to avoid getting results from previous runs.
+// This is important to avoid false positives in test results as the
+// server and integration test suite are two separate applications.
+func cleanTestCache() error {
+	cmd := exec.Command("go", "clean", "-testcache")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to clean test cache: %w", err)
+	}
+	return nil
+}
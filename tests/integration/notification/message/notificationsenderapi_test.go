@@ -30,9 +30,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const (
-	testServerURL = "https://localhost:8095"
-)
+var testServerURL = testutils.TestServerURL
 
 // SenderTestData defines a data provider structure for testing different sender types
 type SenderTestData struct {
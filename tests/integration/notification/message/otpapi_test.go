@@ -30,10 +30,11 @@ import (
 )
 
 const (
-	mockNotificationServerPort = 8097
-	testMobileNumber           = "+1234567890"
+	testMobileNumber = "+1234567890"
 )
 
+var mockNotificationServerPort = testutils.MockPort(8097)
+
 // OTPRequest represents the request to send an OTP
 type OTPRequest struct {
 	Recipient string `json:"recipient"`
@@ -31,9 +31,10 @@ import (
 const (
 	oauth2DiscoveryEndpoint = "/.well-known/oauth-authorization-server"
 	oidcDiscoveryEndpoint   = "/.well-known/openid-configuration"
-	testServerURL           = testutils.TestServerURL
 )
 
+var testServerURL = testutils.TestServerURL
+
 // OAuth2AuthorizationServerMetadata represents OAuth2 Authorization Server Metadata (RFC 8414)
 type OAuth2AuthorizationServerMetadata struct {
 	Issuer                                     string   `json:"issuer"`
@@ -33,12 +33,13 @@ import (
 )
 
 const (
-	testServerURL = "https://localhost:8095"
-	clientId      = "token_test_client_123"
-	clientSecret  = "token_test_secret_123"
-	appName       = "TokenTestApp"
+	clientId     = "token_test_client_123"
+	clientSecret = "token_test_secret_123"
+	appName      = "TokenTestApp"
 )
 
+var testServerURL = testutils.TestServerURL
+
 type TokenTestSuite struct {
 	suite.Suite
 	applicationIDBasic string
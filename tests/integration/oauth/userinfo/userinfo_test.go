@@ -38,13 +38,14 @@ import (
 )
 
 const (
-	testServerURL = "https://localhost:8095"
-	clientID      = "userinfo_test_client_123"
-	clientSecret  = "userinfo_test_secret_123"
-	appName       = "UserInfoTestApp"
-	redirectURI   = "https://localhost:3000"
+	clientID     = "userinfo_test_client_123"
+	clientSecret = "userinfo_test_secret_123"
+	appName      = "UserInfoTestApp"
+	redirectURI  = "https://localhost:3000"
 )
 
+var testServerURL = testutils.TestServerURL
+
 var (
 	testUserType = testutils.UserType{
 		Name: "userinfo-person",
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/thunder-id/thunderid/tests/integration/testutils"
+)
+
+const (
+	// shardEnvVar marks a runner process that runs one shard of a parallel run.
+	shardEnvVar = "TEST_SHARD"
+	// parallelismEnvVar sets the number of server instances when the -parallel flag is not given.
+	parallelismEnvVar = "TEST_PARALLELISM"
+	// maxParallelism bounds the number of server instances so that the shifted mock server ports of
+	// different shards never overlap.
+	maxParallelism = 16
+	// mockPortStride is the mock server port offset between consecutive shards.
+	mockPortStride = 20
+)
+
+// isShard reports whether this runner process runs one shard of a parallel run.
+func isShard() bool {
+	return os.Getenv(shardEnvVar) != ""
+}
+
+// runParallel splits the test packages across the requested number of shards and runs every shard in its
+// own runner process. Each shard extracts its own copy of the product, so that it gets its own SQLite
+// databases, and starts its server on a free port with the mock servers shifted to a dedicated port range.
+// It returns the exit code of the run.
+func runParallel() int {
+	if err := cleanTestCache(); err != nil {
+		fmt.Println(err)
+		return 1
+	}
+
+	packages, err := listTestPackages(testPackage)
+	if err != nil {
+		fmt.Printf("Failed to list test packages: %v\n", err)
+		return 1
+	}
+	shards := splitPackages(packages, parallelism)
+	fmt.Printf("Running %d test packages across %d server instances\n", len(packages), len(shards))
+
+	executable, err := os.Executable()
+	if err != nil {
+		fmt.Printf("Failed to locate the test runner executable: %v\n", err)
+		return 1
+	}
+
+	var wg sync.WaitGroup
+	var outputMu sync.Mutex
+	results := make([]error, len(shards))
+	for i, shardPackages := range shards {
+		port, err := testutils.GetFreePort()
+		if err != nil {
+			fmt.Printf("Failed to find a free port for shard %d: %v\n", i, err)
+			return 1
+		}
+
+		args := []string{"-package", strings.Join(shardPackages, " ")}
+		if testRun != "" {
+			args = append(args, "-run", testRun)
+		}
+		cmd := exec.Command(executable, args...)
+		cmd.Env = append(os.Environ(),
+			shardEnvVar+"="+strconv.Itoa(i),
+			testutils.ServerPortEnvVar+"="+port,
+			testutils.MockPortOffsetEnvVar+"="+strconv.Itoa(i*mockPortStride),
+			testutils.ExtractDirEnvVar+"="+filepath.Join(testutils.ExtractedDir, "shard-"+strconv.Itoa(i)),
+		)
+		output := &prefixWriter{prefix: fmt.Sprintf("[shard %d] ", i), out: os.Stdout, mu: &outputMu}
+		cmd.Stdout = output
+		cmd.Stderr = output
+
+		fmt.Printf("Shard %d: port %s, packages %s\n", i, port, strings.Join(shardPackages, ", "))
+		if err := cmd.Start(); err != nil {
+			fmt.Printf("Failed to start shard %d: %v\n", i, err)
+			results[i] = err
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = cmd.Wait()
+			output.Flush()
+		}(i)
+	}
+	wg.Wait()
+
+	exitCode := 0
+	for i, err := range results {
+		if err != nil {
+			fmt.Printf("Shard %d failed: %v\n", i, err)
+			exitCode = 1
+		}
+	}
+	if exitCode == 0 {
+		fmt.Println("All tests completed successfully!")
+	}
+	return exitCode
+}
+
+// listTestPackages returns the import paths of the packages matching the given patterns that contain tests.
+func listTestPackages(patterns string) ([]string, error) {
+	args := append([]string{"list", "-f", "{{if or .TestGoFiles .XTestGoFiles}}{{.ImportPath}}{{end}}"},
+		strings.Fields(patterns)...)
+	cmd := exec.Command("go", args...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	packages := strings.Fields(string(out))
+	if len(packages) == 0 {
+		return nil, fmt.Errorf("no test packages match %q", patterns)
+	}
+	sort.Strings(packages)
+	return packages, nil
+}
+
+// splitPackages distributes the packages round-robin across at most n shards.
+func splitPackages(packages []string, n int) [][]string {
+	if n > len(packages) {
+		n = len(packages)
+	}
+	shards := make([][]string, n)
+	for i, pkg := range packages {
+		shards[i%n] = append(shards[i%n], pkg)
+	}
+	return shards
+}
+
+// prefixWriter prefixes every line written by a shard so that the interleaved output of the shards stays
+// readable. Only complete lines are written to the shared output.
+type prefixWriter struct {
+	prefix string
+	out    io.Writer
+	mu     *sync.Mutex
+	buf    bytes.Buffer
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf.Write(p)
+	for {
+		line, err := w.buf.ReadBytes('\n')
+		if err != nil {
+			// Keep the incomplete line until the rest of it is written.
+			w.buf.Write(line)
+			break
+		}
+		if _, err := fmt.Fprintf(w.out, "%s%s", w.prefix, line); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes any remaining incomplete line.
+func (w *prefixWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.buf.Len() > 0 {
+		fmt.Fprintf(w.out, "%s%s\n", w.prefix, w.buf.String())
+		w.buf.Reset()
+	}
+}
@@ -0,0 +1,16 @@
+-- Create databases
+CREATE DATABASE runtimedb;
+CREATE DATABASE configdb;
+CREATE DATABASE userdb;
+
+-- Initialize the runtime database
+\connect runtimedb
+\i /dbscripts/runtimedb.sql
+
+-- Initialize the config database
+\connect configdb
+\i /dbscripts/configdb.sql
+
+-- Initialize the user database
+\connect userdb
+\i /dbscripts/userdb.sql
@@ -31,10 +31,11 @@ import (
 )
 
 const (
-	testServerURL = "https://localhost:8095"
 	rolesBasePath = "/roles"
 )
 
+var testServerURL = testutils.TestServerURL
+
 var (
 	testOU = testutils.OrganizationUnit{
 		Handle:      "test-role-ou",
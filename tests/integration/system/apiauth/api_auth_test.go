@@ -31,7 +31,7 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
-const testServerURL = testutils.TestServerURL
+var testServerURL = testutils.TestServerURL
 
 // i18nMessage mirrors the i18n message structure returned in API error responses.
 type i18nMessage struct {
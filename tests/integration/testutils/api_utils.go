@@ -30,9 +30,8 @@ import (
 	"time"
 )
 
-const (
-	TestServerURL = "https://localhost:8095"
-)
+// TestServerURL is the base URL of the server under test.
+var TestServerURL = "https://localhost:" + GetServerPortFromEnv()
 
 // GetHTTPClient returns a configured HTTP client for test requests with automatic auth injection
 func GetHTTPClient() *http.Client {
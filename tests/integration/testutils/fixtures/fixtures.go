@@ -0,0 +1,341 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package fixtures declaratively seeds the resources that integration test suites depend on and removes
+// them again once the suite completes.
+//
+// A suite describes its resources in a Spec and refers to other resources in the same Spec by key instead
+// of by ID, e.g. a user declares the key of its organization unit. Seed creates the resources in dependency
+// order and Cleanup deletes them in the reverse order:
+//
+//	func (ts *MySuite) SetupSuite() {
+//		f, err := fixtures.Seed(fixtures.Spec{
+//			OrganizationUnits: []fixtures.OrganizationUnit{
+//				{Key: "ou", OrganizationUnit: testutils.OrganizationUnit{Handle: "my-ou", Name: "My OU"}},
+//			},
+//			Users: []fixtures.User{
+//				{Key: "alice", OUKey: "ou", User: testutils.User{Type: "Person", Attributes: attrs}},
+//			},
+//		})
+//		ts.Require().NoError(err)
+//		ts.fixtures = f
+//	}
+//
+//	func (ts *MySuite) TearDownSuite() {
+//		if err := ts.fixtures.Cleanup(); err != nil {
+//			ts.T().Logf("teardown: %v", err)
+//		}
+//	}
+package fixtures
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/thunder-id/thunderid/tests/integration/testutils"
+)
+
+// OrganizationUnit declares an organization unit. ParentKey refers to another organization unit of the
+// same Spec; when it is empty, the parent set on the organization unit, if any, is used as is.
+type OrganizationUnit struct {
+	Key       string
+	ParentKey string
+	testutils.OrganizationUnit
+}
+
+// UserType declares a user type created in the organization unit referred to by OUKey.
+type UserType struct {
+	Key   string
+	OUKey string
+	testutils.UserType
+}
+
+// User declares a user created in the organization unit referred to by OUKey.
+type User struct {
+	Key   string
+	OUKey string
+	testutils.User
+}
+
+// Group declares a group created in the organization unit referred to by OUKey. MemberKeys refer to users
+// or groups of the same Spec and are added to the members set on the group.
+type Group struct {
+	Key        string
+	OUKey      string
+	MemberKeys []string
+	testutils.Group
+}
+
+// IDP declares an identity provider.
+type IDP struct {
+	Key string
+	testutils.IDP
+}
+
+// Application declares an application created in the organization unit referred to by OUKey.
+type Application struct {
+	Key   string
+	OUKey string
+	testutils.Application
+}
+
+// Spec declares the resources to seed. Keys must be unique across all resources of a Spec. A reference key
+// that is left empty keeps the corresponding ID set on the embedded resource.
+type Spec struct {
+	OrganizationUnits []OrganizationUnit
+	UserTypes         []UserType
+	Users             []User
+	Groups            []Group
+	IDPs              []IDP
+	Applications      []Application
+}
+
+// resourceKind identifies the type of a seeded resource.
+type resourceKind string
+
+const (
+	kindOrganizationUnit resourceKind = "organization unit"
+	kindUserType         resourceKind = "user type"
+	kindUser             resourceKind = "user"
+	kindGroup            resourceKind = "group"
+	kindIDP              resourceKind = "identity provider"
+	kindApplication      resourceKind = "application"
+)
+
+// resource is a resource created by Seed.
+type resource struct {
+	key  string
+	kind resourceKind
+	id   string
+}
+
+// Fixtures holds the resources created by Seed.
+type Fixtures struct {
+	resources []resource
+	byKey     map[string]resource
+}
+
+// Seed creates the resources declared in the spec. Organization units are created first, followed by user
+// types, users, groups, identity providers and applications, so that every resource can refer to the ones
+// it depends on. When a resource cannot be created, the resources created so far are deleted again.
+func Seed(spec Spec) (*Fixtures, error) {
+	f := &Fixtures{byKey: make(map[string]resource)}
+	if err := f.seed(spec); err != nil {
+		if cleanupErr := f.Cleanup(); cleanupErr != nil {
+			log.Printf("Failed to clean up partially seeded fixtures: %v", cleanupErr)
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+// ID returns the ID of the resource seeded with the given key. It panics when no resource has the key, since
+// that is a mistake in the test itself.
+func (f *Fixtures) ID(key string) string {
+	r, ok := f.byKey[key]
+	if !ok {
+		panic(fmt.Sprintf("fixtures: no resource seeded with key %q", key))
+	}
+	return r.id
+}
+
+// Forget stops tracking the resource seeded with the given key, so that Cleanup does not delete it. Use it
+// when a test deletes a seeded resource itself.
+func (f *Fixtures) Forget(key string) {
+	if _, ok := f.byKey[key]; !ok {
+		return
+	}
+	delete(f.byKey, key)
+	for i, r := range f.resources {
+		if r.key == key {
+			f.resources = append(f.resources[:i], f.resources[i+1:]...)
+			break
+		}
+	}
+}
+
+// Cleanup deletes the seeded resources in the reverse order of creation. It attempts to delete every
+// resource and returns the errors of the deletions that failed.
+func (f *Fixtures) Cleanup() error {
+	if f == nil {
+		return nil
+	}
+
+	var errs []error
+	for i := len(f.resources) - 1; i >= 0; i-- {
+		r := f.resources[i]
+		if err := deleteResource(r); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete %s %q: %w", r.kind, r.key, err))
+		}
+	}
+	f.resources = nil
+	f.byKey = make(map[string]resource)
+	return errors.Join(errs...)
+}
+
+func (f *Fixtures) seed(spec Spec) error {
+	for _, ou := range spec.OrganizationUnits {
+		value := ou.OrganizationUnit
+		if ou.ParentKey != "" {
+			parentID, err := f.resolve(ou.ParentKey, kindOrganizationUnit)
+			if err != nil {
+				return err
+			}
+			value.Parent = &parentID
+		}
+		if err := f.create(ou.Key, kindOrganizationUnit, func() (string, error) {
+			return testutils.CreateOrganizationUnit(value)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, userType := range spec.UserTypes {
+		value := userType.UserType
+		if err := f.resolveInto(&value.OUID, userType.OUKey, kindOrganizationUnit); err != nil {
+			return err
+		}
+		if err := f.create(userType.Key, kindUserType, func() (string, error) {
+			return testutils.CreateUserType(value)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, user := range spec.Users {
+		value := user.User
+		if err := f.resolveInto(&value.OUID, user.OUKey, kindOrganizationUnit); err != nil {
+			return err
+		}
+		if err := f.create(user.Key, kindUser, func() (string, error) {
+			return testutils.CreateUser(value)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range spec.Groups {
+		value := group.Group
+		if err := f.resolveInto(&value.OUID, group.OUKey, kindOrganizationUnit); err != nil {
+			return err
+		}
+		value.Members = append([]testutils.Member(nil), value.Members...)
+		for _, memberKey := range group.MemberKeys {
+			member, ok := f.byKey[memberKey]
+			if !ok || (member.kind != kindUser && member.kind != kindGroup) {
+				return fmt.Errorf("group %q refers to unknown member %q", group.Key, memberKey)
+			}
+			memberType := "user"
+			if member.kind == kindGroup {
+				memberType = "group"
+			}
+			value.Members = append(value.Members, testutils.Member{Id: member.id, Type: memberType})
+		}
+		if err := f.create(group.Key, kindGroup, func() (string, error) {
+			return testutils.CreateGroup(value)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, idp := range spec.IDPs {
+		value := idp.IDP
+		if err := f.create(idp.Key, kindIDP, func() (string, error) {
+			return testutils.CreateIDP(value)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, app := range spec.Applications {
+		value := app.Application
+		if err := f.resolveInto(&value.OUID, app.OUKey, kindOrganizationUnit); err != nil {
+			return err
+		}
+		if err := f.create(app.Key, kindApplication, func() (string, error) {
+			return testutils.CreateApplication(value)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// create creates a resource and tracks it under the given key.
+func (f *Fixtures) create(key string, kind resourceKind, createFn func() (string, error)) error {
+	if key == "" {
+		return fmt.Errorf("%s fixture is missing a key", kind)
+	}
+	if _, exists := f.byKey[key]; exists {
+		return fmt.Errorf("duplicate fixture key %q", key)
+	}
+
+	id, err := createFn()
+	if err != nil {
+		return fmt.Errorf("failed to create %s %q: %w", kind, key, err)
+	}
+
+	r := resource{key: key, kind: kind, id: id}
+	f.resources = append(f.resources, r)
+	f.byKey[key] = r
+	return nil
+}
+
+// resolve returns the ID of the already seeded resource of the given kind with the given key.
+func (f *Fixtures) resolve(key string, kind resourceKind) (string, error) {
+	r, ok := f.byKey[key]
+	if !ok || r.kind != kind {
+		return "", fmt.Errorf("unknown %s fixture key %q", kind, key)
+	}
+	return r.id, nil
+}
+
+// resolveInto sets the target to the ID of the referenced resource when a reference key is given.
+func (f *Fixtures) resolveInto(target *string, key string, kind resourceKind) error {
+	if key == "" {
+		return nil
+	}
+	id, err := f.resolve(key, kind)
+	if err != nil {
+		return err
+	}
+	*target = id
+	return nil
+}
+
+// deleteResource deletes a seeded resource using the API of its kind.
+func deleteResource(r resource) error {
+	switch r.kind {
+	case kindOrganizationUnit:
+		return testutils.DeleteOrganizationUnit(r.id)
+	case kindUserType:
+		return testutils.DeleteUserType(r.id)
+	case kindUser:
+		return testutils.DeleteUser(r.id)
+	case kindGroup:
+		return testutils.DeleteGroup(r.id)
+	case kindIDP:
+		return testutils.DeleteIDP(r.id)
+	case kindApplication:
+		return testutils.DeleteApplication(r.id)
+	default:
+		return fmt.Errorf("unsupported fixture kind %q", r.kind)
+	}
+}
@@ -0,0 +1,294 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package testutils
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Flow types accepted by the flow execution API.
+const (
+	FlowTypeAuthentication = "AUTHENTICATION"
+	FlowTypeRegistration   = "REGISTRATION"
+	FlowTypeRecovery       = "RECOVERY"
+)
+
+// Flow statuses returned by the flow execution API.
+const (
+	FlowStatusComplete   = "COMPLETE"
+	FlowStatusIncomplete = "INCOMPLETE"
+	FlowStatusError      = "ERROR"
+)
+
+// flowStepTypeRedirection is the type of a flow step that redirects the user to an external party.
+const flowStepTypeRedirection = "REDIRECTION"
+
+// FlowInputStep is the input submitted to a single step of a simulated flow.
+type FlowInputStep struct {
+	// Inputs are the input values submitted to the step.
+	Inputs map[string]string
+	// Action is the action selected at the step, if the step prompts for one.
+	Action string
+	// FollowRedirect authorizes at the federated identity provider the step redirects to, and submits the
+	// returned authorization code and state together with Inputs.
+	FollowRedirect bool
+}
+
+// FlowSimulator drives a flow through the flow execution API, forwarding the execution ID and the challenge
+// token of each step to the next one. Steps records every step returned by the server, in order.
+type FlowSimulator struct {
+	ExecutionID string
+	Steps       []*FlowStep
+}
+
+// StartFlow initiates a flow of the given type for the application.
+func StartFlow(appID, flowType string) (*FlowSimulator, error) {
+	simulator := &FlowSimulator{}
+	if _, err := simulator.execute(map[string]interface{}{
+		"applicationId": appID,
+		"flowType":      flowType,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to start %s flow: %w", flowType, err)
+	}
+	return simulator, nil
+}
+
+// ResumeFlowSimulation continues a flow that was initiated elsewhere, e.g. through the OAuth authorization
+// endpoint, by executing it once without inputs.
+func ResumeFlowSimulation(executionID string) (*FlowSimulator, error) {
+	simulator := &FlowSimulator{ExecutionID: executionID}
+	if _, err := simulator.execute(map[string]interface{}{"executionId": executionID}); err != nil {
+		return nil, fmt.Errorf("failed to resume flow: %w", err)
+	}
+	return simulator, nil
+}
+
+// RunFlow initiates a flow and submits the given steps in order. It fails when the flow ends before all the
+// steps are submitted; the final status of the flow is left to the caller, e.g. through Assertion.
+func RunFlow(appID, flowType string, steps ...FlowInputStep) (*FlowSimulator, error) {
+	simulator, err := StartFlow(appID, flowType)
+	if err != nil {
+		return nil, err
+	}
+	if err := simulator.Run(steps...); err != nil {
+		return nil, err
+	}
+	return simulator, nil
+}
+
+// Current returns the latest step returned by the server.
+func (s *FlowSimulator) Current() *FlowStep {
+	if len(s.Steps) == 0 {
+		return nil
+	}
+	return s.Steps[len(s.Steps)-1]
+}
+
+// Run submits the given steps in order.
+func (s *FlowSimulator) Run(steps ...FlowInputStep) error {
+	for i, step := range steps {
+		if current := s.Current(); current.FlowStatus != FlowStatusIncomplete {
+			return fmt.Errorf("flow ended with status %s before step %d: %s", current.FlowStatus, i+1,
+				current.FailureReason)
+		}
+
+		var err error
+		if step.FollowRedirect {
+			_, err = s.FollowRedirect(step.Inputs)
+		} else {
+			_, err = s.Submit(step.Inputs, step.Action)
+		}
+		if err != nil {
+			return fmt.Errorf("step %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// Submit submits inputs and an optional action to the current step and returns the next step.
+func (s *FlowSimulator) Submit(inputs map[string]string, action string) (*FlowStep, error) {
+	body := map[string]interface{}{
+		"executionId": s.ExecutionID,
+	}
+	if len(inputs) > 0 {
+		body["inputs"] = inputs
+	}
+	if action != "" {
+		body["action"] = action
+	}
+	if current := s.Current(); current != nil && current.ChallengeToken != "" {
+		body["challengeToken"] = current.ChallengeToken
+	}
+	return s.execute(body)
+}
+
+// FollowRedirect completes a redirection step by authorizing at the federated identity provider and
+// submitting the returned authorization code and state, along with any additional inputs.
+func (s *FlowSimulator) FollowRedirect(inputs map[string]string) (*FlowStep, error) {
+	current := s.Current()
+	if current == nil || current.Type != flowStepTypeRedirection || current.Data == nil ||
+		current.Data.RedirectURL == "" {
+		return nil, fmt.Errorf("current flow step is not a redirection")
+	}
+
+	code, state, err := SimulateFederatedOAuthFlow(current.Data.RedirectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectInputs := map[string]string{"code": code}
+	if state != "" {
+		redirectInputs["state"] = state
+	}
+	for name, value := range inputs {
+		redirectInputs[name] = value
+	}
+	return s.Submit(redirectInputs, "")
+}
+
+// Assertion returns the assertion of a completed flow, or an error describing why the flow did not complete.
+func (s *FlowSimulator) Assertion() (string, error) {
+	current := s.Current()
+	if current == nil {
+		return "", fmt.Errorf("flow has not been started")
+	}
+	if current.FlowStatus != FlowStatusComplete {
+		stepJSON, _ := json.Marshal(current)
+		return "", fmt.Errorf("flow not complete: status=%s, failureReason=%s, step=%s", current.FlowStatus,
+			current.FailureReason, string(stepJSON))
+	}
+	if current.Assertion == "" {
+		return "", fmt.Errorf("no assertion returned from the completed flow")
+	}
+	return current.Assertion, nil
+}
+
+// execute sends a flow execution request and records the returned step.
+func (s *FlowSimulator) execute(body map[string]interface{}) (*FlowStep, error) {
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal flow request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, TestServerURL+"/flow/execute", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create flow request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute flow: %w", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read flow response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("flow execution failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var step FlowStep
+	if err := json.Unmarshal(bodyBytes, &step); err != nil {
+		return nil, fmt.Errorf("failed to decode flow response: %w", err)
+	}
+	if step.ExecutionID != "" {
+		s.ExecutionID = step.ExecutionID
+	}
+	s.Steps = append(s.Steps, &step)
+	return &step, nil
+}
+
+// ObtainAccessTokenWithFlow performs the OAuth authorization code flow with PKCE, authenticating the user by
+// submitting the given steps to the authentication flow of the application, and returns the issued tokens.
+// clientSecret is sent with client_secret_post when set.
+func ObtainAccessTokenWithFlow(clientID, clientSecret, redirectURI, scope string, steps ...FlowInputStep) (
+	*TokenResponse, error) {
+	codeVerifier, err := generateCodeVerifier()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate code verifier: %w", err)
+	}
+
+	resp, err := InitiateAuthorizationFlowWithPKCE(clientID, redirectURI, "code", scope, "test-state", "",
+		generateCodeChallenge(codeVerifier), "S256")
+	if err != nil {
+		return nil, fmt.Errorf("failed to initiate authorization: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusFound && resp.StatusCode != http.StatusSeeOther &&
+		resp.StatusCode != http.StatusTemporaryRedirect {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("expected redirect response, got status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	authID, executionID, err := ExtractAuthData(resp.Header.Get("Location"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract auth ID: %w", err)
+	}
+
+	simulator, err := ResumeFlowSimulation(executionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := simulator.Run(steps...); err != nil {
+		return nil, err
+	}
+	assertion, err := simulator.Assertion()
+	if err != nil {
+		return nil, err
+	}
+
+	authzResp, err := CompleteAuthorization(authID, assertion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete authorization: %w", err)
+	}
+	code, err := ExtractAuthorizationCode(authzResp.RedirectURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract authorization code: %w", err)
+	}
+
+	tokenResult, err := RequestTokenWithPKCE(clientID, clientSecret, code, redirectURI, "authorization_code",
+		codeVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request token: %w", err)
+	}
+	if tokenResult.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token request failed with status %d: %s", tokenResult.StatusCode,
+			string(tokenResult.Body))
+	}
+	if tokenResult.Token == nil {
+		return nil, fmt.Errorf("no token in response")
+	}
+	return tokenResult.Token, nil
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package loadtest drives a configurable request rate against a running server and evaluates the recorded
+// latencies and error rates against thresholds and a baseline report.
+package loadtest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario types supported by the load test.
+const (
+	ScenarioTypeToken       = "token"
+	ScenarioTypeFlowExecute = "flow_execute"
+	ScenarioTypeUsers       = "users"
+)
+
+const (
+	defaultMaxInFlight    = 256
+	defaultRequestTimeout = 30 * time.Second
+	defaultFlowType       = "AUTHENTICATION"
+)
+
+// Config is the configuration of a load test run.
+type Config struct {
+	// Duration is the time each scenario is driven for after the warmup.
+	Duration time.Duration `yaml:"duration"`
+	// Warmup is the time each scenario is driven for before its results are recorded.
+	Warmup time.Duration `yaml:"warmup"`
+	// MaxInFlight bounds the concurrent requests of a scenario. Requests that would exceed it are counted as
+	// errors instead of being queued, so that a saturated server shows up in the error rate.
+	MaxInFlight int `yaml:"maxInFlight"`
+	// RequestTimeout bounds the duration of a single request.
+	RequestTimeout time.Duration `yaml:"requestTimeout"`
+	// RegressionTolerance is the allowed relative increase of the p95 and p99 latencies over the baseline,
+	// e.g. 0.2 for 20%.
+	RegressionTolerance float64          `yaml:"regressionTolerance"`
+	Scenarios           []ScenarioConfig `yaml:"scenarios"`
+}
+
+// ScenarioConfig is the configuration of a single scenario.
+type ScenarioConfig struct {
+	Name          string     `yaml:"name"`
+	Type          string     `yaml:"type"`
+	RPS           float64    `yaml:"rps"`
+	ClientID      string     `yaml:"clientId"`
+	ClientSecret  string     `yaml:"clientSecret"`
+	ApplicationID string     `yaml:"applicationId"`
+	FlowType      string     `yaml:"flowType"`
+	Thresholds    Thresholds `yaml:"thresholds"`
+}
+
+// Thresholds are the limits a scenario must stay within. Zero values are not checked.
+type Thresholds struct {
+	MaxErrorRate float64       `yaml:"maxErrorRate"`
+	P50          time.Duration `yaml:"p50"`
+	P95          time.Duration `yaml:"p95"`
+	P99          time.Duration `yaml:"p99"`
+}
+
+// LoadConfig reads and validates a load test configuration file.
+func LoadConfig(path string) (*Config, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- the configuration path is provided by the developer
+	if err != nil {
+		return nil, fmt.Errorf("failed to read load test configuration: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(content, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse load test configuration: %w", err)
+	}
+	if cfg.MaxInFlight == 0 {
+		cfg.MaxInFlight = defaultMaxInFlight
+	}
+	if cfg.RequestTimeout == 0 {
+		cfg.RequestTimeout = defaultRequestTimeout
+	}
+	for i := range cfg.Scenarios {
+		if cfg.Scenarios[i].Type == ScenarioTypeFlowExecute && cfg.Scenarios[i].FlowType == "" {
+			cfg.Scenarios[i].FlowType = defaultFlowType
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid load test configuration %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Validate checks that the configuration can be run.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Duration <= 0 {
+		errs = append(errs, errors.New("duration must be positive"))
+	}
+	if c.Warmup < 0 {
+		errs = append(errs, errors.New("warmup must not be negative"))
+	}
+	if c.MaxInFlight < 0 {
+		errs = append(errs, errors.New("maxInFlight must not be negative"))
+	}
+	if c.RegressionTolerance < 0 {
+		errs = append(errs, errors.New("regressionTolerance must not be negative"))
+	}
+	if len(c.Scenarios) == 0 {
+		errs = append(errs, errors.New("at least one scenario is required"))
+	}
+
+	names := make(map[string]bool)
+	for _, scenario := range c.Scenarios {
+		if scenario.Name == "" {
+			errs = append(errs, errors.New("scenario name is required"))
+		} else if names[scenario.Name] {
+			errs = append(errs, fmt.Errorf("scenario %q is defined more than once", scenario.Name))
+		}
+		names[scenario.Name] = true
+
+		if scenario.RPS <= 0 {
+			errs = append(errs, fmt.Errorf("scenario %q: rps must be positive", scenario.Name))
+		}
+		switch scenario.Type {
+		case ScenarioTypeToken:
+			if scenario.ClientID == "" || scenario.ClientSecret == "" {
+				errs = append(errs, fmt.Errorf("scenario %q: clientId and clientSecret are required",
+					scenario.Name))
+			}
+		case ScenarioTypeFlowExecute:
+			if scenario.ApplicationID == "" {
+				errs = append(errs, fmt.Errorf("scenario %q: applicationId is required", scenario.Name))
+			}
+		case ScenarioTypeUsers:
+		default:
+			errs = append(errs, fmt.Errorf("scenario %q: unsupported type %q", scenario.Name, scenario.Type))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// Report is the result of a load test run. It is written as JSON so that it can be kept as the baseline of
+// later runs.
+type Report struct {
+	StartedAt time.Time        `json:"startedAt"`
+	Duration  string           `json:"duration"`
+	Warmup    string           `json:"warmup"`
+	Scenarios []ScenarioResult `json:"scenarios"`
+}
+
+// ScenarioResult is the result of a single scenario. Latency percentiles cover successful requests only.
+type ScenarioResult struct {
+	Name         string         `json:"name"`
+	Type         string         `json:"type"`
+	TargetRPS    float64        `json:"targetRps"`
+	AchievedRPS  float64        `json:"achievedRps"`
+	Requests     int            `json:"requests"`
+	Errors       int            `json:"errors"`
+	ErrorRate    float64        `json:"errorRate"`
+	ErrorReasons map[string]int `json:"errorReasons,omitempty"`
+	P50Ms        float64        `json:"p50Ms"`
+	P90Ms        float64        `json:"p90Ms"`
+	P95Ms        float64        `json:"p95Ms"`
+	P99Ms        float64        `json:"p99Ms"`
+	MaxMs        float64        `json:"maxMs"`
+}
+
+// WriteReport writes the report as JSON to the given path.
+func WriteReport(report *Report, path string) error {
+	content, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal load test report: %w", err)
+	}
+	if err := os.WriteFile(path, append(content, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write load test report: %w", err)
+	}
+	return nil
+}
+
+// ReadReport reads a report written by WriteReport.
+func ReadReport(path string) (*Report, error) {
+	content, err := os.ReadFile(path) // #nosec G304 -- the baseline path is provided by the developer
+	if err != nil {
+		return nil, fmt.Errorf("failed to read load test report: %w", err)
+	}
+	var report Report
+	if err := json.Unmarshal(content, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse load test report %s: %w", path, err)
+	}
+	return &report, nil
+}
+
+// PrintReport writes a summary table of the report.
+func PrintReport(w io.Writer, report *Report) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "SCENARIO\tTARGET RPS\tACHIEVED RPS\tREQUESTS\tERROR RATE\t"+
+		"P50 MS\tP90 MS\tP95 MS\tP99 MS\tMAX MS")
+	for _, result := range report.Scenarios {
+		_, _ = fmt.Fprintf(tw, "%s\t%.1f\t%.1f\t%d\t%.2f%%\t%.1f\t%.1f\t%.1f\t%.1f\t%.1f\n", result.Name,
+			result.TargetRPS, result.AchievedRPS, result.Requests, result.ErrorRate*100, result.P50Ms,
+			result.P90Ms, result.P95Ms, result.P99Ms, result.MaxMs)
+	}
+	_ = tw.Flush()
+
+	for _, result := range report.Scenarios {
+		reasons := make([]string, 0, len(result.ErrorReasons))
+		for reason := range result.ErrorReasons {
+			reasons = append(reasons, reason)
+		}
+		sort.Strings(reasons)
+		for _, reason := range reasons {
+			_, _ = fmt.Fprintf(w, "%s: %d requests failed with %s\n", result.Name, result.ErrorReasons[reason],
+				reason)
+		}
+	}
+}
+
+// Evaluate compares the report with the thresholds of the configuration and, when given, with a baseline
+// report. It returns a description of every violation.
+func Evaluate(cfg *Config, report *Report, baseline *Report) []string {
+	var violations []string
+
+	for _, scenario := range cfg.Scenarios {
+		result, ok := findResult(report, scenario.Name)
+		if !ok {
+			violations = append(violations, fmt.Sprintf("%s: no results recorded", scenario.Name))
+			continue
+		}
+		if result.Requests == 0 {
+			violations = append(violations, fmt.Sprintf("%s: no requests were sent", scenario.Name))
+			continue
+		}
+
+		thresholds := scenario.Thresholds
+		if thresholds.MaxErrorRate > 0 && result.ErrorRate > thresholds.MaxErrorRate {
+			violations = append(violations, fmt.Sprintf("%s: error rate %.2f%% exceeds %.2f%%", scenario.Name,
+				result.ErrorRate*100, thresholds.MaxErrorRate*100))
+		}
+		violations = appendLatencyViolation(violations, scenario.Name, "p50", result.P50Ms, thresholds.P50)
+		violations = appendLatencyViolation(violations, scenario.Name, "p95", result.P95Ms, thresholds.P95)
+		violations = appendLatencyViolation(violations, scenario.Name, "p99", result.P99Ms, thresholds.P99)
+
+		if baseline == nil {
+			continue
+		}
+		previous, ok := findResult(baseline, scenario.Name)
+		if !ok {
+			continue
+		}
+		violations = appendRegression(violations, scenario.Name, "p95", result.P95Ms, previous.P95Ms,
+			cfg.RegressionTolerance)
+		violations = appendRegression(violations, scenario.Name, "p99", result.P99Ms, previous.P99Ms,
+			cfg.RegressionTolerance)
+	}
+	return violations
+}
+
+// appendLatencyViolation appends a violation when the latency exceeds the configured limit.
+func appendLatencyViolation(violations []string, name, label string, latencyMs float64,
+	limit time.Duration) []string {
+	if limit <= 0 || latencyMs <= milliseconds(limit) {
+		return violations
+	}
+	return append(violations, fmt.Sprintf("%s: %s latency %.1fms exceeds %s", name, label, latencyMs, limit))
+}
+
+// appendRegression appends a violation when the latency regressed beyond the tolerance of the baseline.
+func appendRegression(violations []string, name, label string, latencyMs, baselineMs, tolerance float64) []string {
+	if baselineMs <= 0 || latencyMs <= baselineMs*(1+tolerance) {
+		return violations
+	}
+	return append(violations, fmt.Sprintf("%s: %s latency %.1fms regressed from the baseline %.1fms by more "+
+		"than %.0f%%", name, label, latencyMs, baselineMs, tolerance*100))
+}
+
+// findResult returns the result of the named scenario.
+func findResult(report *Report, name string) (ScenarioResult, bool) {
+	for _, result := range report.Scenarios {
+		if result.Name == name {
+			return result, true
+		}
+	}
+	return ScenarioResult{}, false
+}
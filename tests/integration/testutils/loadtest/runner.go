@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package loadtest
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// errSaturated is recorded for requests that were not sent because the in-flight limit was reached.
+var errSaturated = errors.New("in-flight limit reached")
+
+// Run drives every scenario of the configuration concurrently and returns the recorded results.
+func Run(cfg *Config) (*Report, error) {
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:     &tls.Config{InsecureSkipVerify: true}, // #nosec G402 -- local test server
+			MaxIdleConns:        cfg.MaxInFlight * len(cfg.Scenarios),
+			MaxIdleConnsPerHost: cfg.MaxInFlight * len(cfg.Scenarios),
+		},
+		Timeout: cfg.RequestTimeout,
+	}
+
+	requests := make([]requestFunc, len(cfg.Scenarios))
+	for i, scenario := range cfg.Scenarios {
+		request, err := newRequestFunc(scenario)
+		if err != nil {
+			return nil, fmt.Errorf("scenario %q: %w", scenario.Name, err)
+		}
+		requests[i] = request
+	}
+
+	report := &Report{
+		StartedAt: time.Now().UTC(),
+		Duration:  cfg.Duration.String(),
+		Warmup:    cfg.Warmup.String(),
+		Scenarios: make([]ScenarioResult, len(cfg.Scenarios)),
+	}
+
+	var wg sync.WaitGroup
+	for i, scenario := range cfg.Scenarios {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Scenarios[i] = runScenario(cfg, scenario, requests[i], client)
+		}()
+	}
+	wg.Wait()
+
+	return report, nil
+}
+
+// runScenario sends requests at the target rate of the scenario. The rate is kept regardless of the response
+// times, so that a slow server results in higher latencies and errors rather than a lower request rate.
+func runScenario(cfg *Config, scenario ScenarioConfig, request requestFunc, client *http.Client) ScenarioResult {
+	recorder := &recorder{errorReasons: make(map[string]int)}
+	inFlight := make(chan struct{}, cfg.MaxInFlight)
+	interval := time.Duration(float64(time.Second) / scenario.RPS)
+
+	start := time.Now()
+	recordFrom := start.Add(cfg.Warmup)
+	end := recordFrom.Add(cfg.Duration)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	for now := start; now.Before(end); now = <-ticker.C {
+		record := !now.Before(recordFrom)
+		select {
+		case inFlight <- struct{}{}:
+		default:
+			if record {
+				recorder.add(0, errSaturated)
+			}
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-inFlight }()
+
+			sent := time.Now()
+			err := request(client)
+			if record {
+				recorder.add(time.Since(sent), err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return recorder.result(scenario, cfg.Duration)
+}
+
+// recorder collects the outcomes of the requests of a scenario.
+type recorder struct {
+	mu           sync.Mutex
+	latencies    []time.Duration
+	errors       int
+	errorReasons map[string]int
+}
+
+// add records the outcome of a request. Latencies are only recorded for successful requests.
+func (r *recorder) add(latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		r.errors++
+		r.errorReasons[errorReason(err)]++
+		return
+	}
+	r.latencies = append(r.latencies, latency)
+}
+
+// result summarizes the recorded outcomes.
+func (r *recorder) result(scenario ScenarioConfig, duration time.Duration) ScenarioResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sort.Slice(r.latencies, func(i, j int) bool { return r.latencies[i] < r.latencies[j] })
+	requests := len(r.latencies) + r.errors
+
+	result := ScenarioResult{
+		Name:         scenario.Name,
+		Type:         scenario.Type,
+		TargetRPS:    scenario.RPS,
+		AchievedRPS:  float64(len(r.latencies)) / duration.Seconds(),
+		Requests:     requests,
+		Errors:       r.errors,
+		ErrorReasons: r.errorReasons,
+		P50Ms:        milliseconds(percentile(r.latencies, 50)),
+		P90Ms:        milliseconds(percentile(r.latencies, 90)),
+		P95Ms:        milliseconds(percentile(r.latencies, 95)),
+		P99Ms:        milliseconds(percentile(r.latencies, 99)),
+	}
+	if len(r.latencies) > 0 {
+		result.MaxMs = milliseconds(r.latencies[len(r.latencies)-1])
+	}
+	if requests > 0 {
+		result.ErrorRate = float64(r.errors) / float64(requests)
+	}
+	return result
+}
+
+// percentile returns the nearest-rank percentile of sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(float64(len(sorted))*p/100)) - 1
+	rank = max(0, min(rank, len(sorted)-1))
+	return sorted[rank]
+}
+
+// milliseconds converts a duration to fractional milliseconds.
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// errorReason groups request errors into a small set of reasons for the report.
+func errorReason(err error) string {
+	var status statusError
+	if errors.As(err, &status) || errors.Is(err, errSaturated) {
+		return err.Error()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return "timeout"
+	}
+	return "request failed"
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package loadtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thunder-id/thunderid/tests/integration/testutils"
+)
+
+// requestFunc sends a single request of a scenario and returns an error when the response is not successful.
+type requestFunc func(client *http.Client) error
+
+// newRequestFunc builds the request of a scenario.
+func newRequestFunc(scenario ScenarioConfig) (requestFunc, error) {
+	switch scenario.Type {
+	case ScenarioTypeToken:
+		return tokenRequest(scenario), nil
+	case ScenarioTypeFlowExecute:
+		return flowExecuteRequest(scenario)
+	case ScenarioTypeUsers:
+		return usersRequest, nil
+	default:
+		return nil, fmt.Errorf("unsupported scenario type %q", scenario.Type)
+	}
+}
+
+// tokenRequest issues access tokens with the client credentials grant.
+func tokenRequest(scenario ScenarioConfig) requestFunc {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	body := form.Encode()
+
+	return func(client *http.Client) error {
+		req, err := http.NewRequest(http.MethodPost, testutils.TestServerURL+"/oauth2/token",
+			strings.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(scenario.ClientID, scenario.ClientSecret)
+		return send(client, req)
+	}
+}
+
+// flowExecuteRequest initiates a flow for the configured application.
+func flowExecuteRequest(scenario ScenarioConfig) (requestFunc, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"applicationId": scenario.ApplicationID,
+		"flowType":      scenario.FlowType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal flow request: %w", err)
+	}
+
+	return func(client *http.Client) error {
+		req, err := http.NewRequest(http.MethodPost, testutils.TestServerURL+"/flow/execute",
+			bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+		return send(client, req)
+	}, nil
+}
+
+// usersRequest lists users with the admin access token.
+func usersRequest(client *http.Client) error {
+	token, err := testutils.GetAccessToken()
+	if err != nil {
+		return fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, testutils.TestServerURL+"/users?limit=10", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	return send(client, req)
+}
+
+// send sends the request and drains the response so that the connection can be reused.
+func send(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return statusError(resp.StatusCode)
+	}
+	return nil
+}
+
+// statusError is returned when a request completes with an unexpected status code.
+type statusError int
+
+// Error implements the error interface.
+func (e statusError) Error() string {
+	return fmt.Sprintf("status %d", int(e))
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -145,9 +146,16 @@ func (m *MockGithubOAuthServer) Start() error {
 		Handler: mux,
 	}
 
+	// Bind before returning so that the server accepts requests as soon as Start returns and a port
+	// conflict fails the test setup instead of surfacing as connection errors later.
+	ln, err := net.Listen("tcp", m.server.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", m.port, err)
+	}
+
 	go func() {
 		log.Printf("Starting mock GitHub OAuth server on port %d", m.port)
-		if err := m.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		if err := m.server.Serve(ln); err != nil && err != http.ErrServerClosed {
 			log.Printf("Mock GitHub OAuth server error: %v", err)
 		}
 	}()
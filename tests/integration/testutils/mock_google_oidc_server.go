@@ -31,6 +31,7 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -200,9 +201,16 @@ func (m *MockGoogleOIDCServer) Start() error {
 		Handler: mux,
 	}
 
+	// Bind before returning so that the server accepts requests as soon as Start returns and a port
+	// conflict fails the test setup instead of surfacing as connection errors later.
+	ln, err := net.Listen("tcp", m.server.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", m.port, err)
+	}
+
 	go func() {
 		log.Printf("Starting mock Google OIDC server on port %d", m.port)
-		if err := m.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		if err := m.server.Serve(ln); err != nil && err != http.ErrServerClosed {
 			log.Printf("Mock Google OIDC server error: %v", err)
 		}
 	}()
@@ -27,6 +27,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -162,8 +163,15 @@ func (m *MockOIDCServer) Start() error {
 		Handler: mux,
 	}
 
+	// Bind before returning so that the server accepts requests as soon as Start returns and a port
+	// conflict fails the test setup instead of surfacing as connection errors later.
+	ln, err := net.Listen("tcp", m.server.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", m.port, err)
+	}
+
 	go func() {
-		if err := m.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		if err := m.server.Serve(ln); err != nil && err != http.ErrServerClosed {
 			fmt.Printf("Mock OIDC server error: %v\n", err)
 		}
 	}()
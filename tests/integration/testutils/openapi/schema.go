@@ -0,0 +1,246 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// maxRefDepth bounds the number of references followed for a single node, guarding against cycles.
+const maxRefDepth = 32
+
+// validator validates decoded JSON values against the schemas of a spec. It covers the subset of
+// OpenAPI 3.0 schema keywords used by the server specs: $ref, type, nullable, enum, properties, required,
+// additionalProperties, items, allOf, oneOf and anyOf. Formats and value ranges are not checked.
+type validator struct {
+	spec  *Spec
+	depth int
+}
+
+// newValidator creates a validator resolving references within the spec.
+func newValidator(spec *Spec) *validator {
+	return &validator{spec: spec}
+}
+
+// decodeJSON decodes a JSON document keeping numbers as json.Number to distinguish integers.
+func decodeJSON(body []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the JSON document")
+	}
+	return value, nil
+}
+
+// validate returns the violations of the value against the schema. The location is a JSON path used in the
+// reported errors.
+func (v *validator) validate(schema map[string]interface{}, value interface{}, location string) []error {
+	if v.depth > maxRefDepth {
+		return []error{fmt.Errorf("%s: schema nesting is too deep", location)}
+	}
+	v.depth++
+	defer func() { v.depth-- }()
+
+	schema, err := v.spec.resolve(schema)
+	if err != nil {
+		return []error{fmt.Errorf("%s: %w", location, err)}
+	}
+
+	var errs []error
+	for _, sub := range schemaList(schema["allOf"]) {
+		errs = append(errs, v.validate(sub, value, location)...)
+	}
+	// oneOf is checked like anyOf since the object schemas of the specs are open and more than one
+	// alternative commonly matches the same value.
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if alternatives := schemaList(schema[keyword]); len(alternatives) > 0 && !v.matchesAny(alternatives,
+			value, location) {
+			errs = append(errs, fmt.Errorf("%s: value does not match any schema in %s", location, keyword))
+		}
+	}
+
+	if value == nil {
+		if nullable, _ := schema["nullable"].(bool); nullable || schema["type"] == nil {
+			return errs
+		}
+		return append(errs, fmt.Errorf("%s: value must not be null", location))
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		errs = append(errs, fmt.Errorf("%s: value %v is not one of %v", location, value, enum))
+	}
+
+	schemaType, _ := schema["type"].(string)
+	if schemaType != "" && !matchesType(schemaType, value) {
+		return append(errs, fmt.Errorf("%s: expected %s but got %s", location, schemaType, typeName(value)))
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		errs = append(errs, v.validateObject(schema, typed, location)...)
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range typed {
+				errs = append(errs, v.validate(items, item, fmt.Sprintf("%s[%d]", location, i))...)
+			}
+		}
+	}
+	return errs
+}
+
+// validateObject validates the properties of an object value.
+func (v *validator) validateObject(schema, value map[string]interface{}, location string) []error {
+	var errs []error
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			key, _ := name.(string)
+			if _, present := value[key]; present || isWriteOnly(v.spec, properties[key]) {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("%s: missing required property %q", location, key))
+		}
+	}
+
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		propertyLocation := location + "." + key
+		if property, ok := properties[key].(map[string]interface{}); ok {
+			errs = append(errs, v.validate(property, value[key], propertyLocation)...)
+			continue
+		}
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				errs = append(errs, fmt.Errorf("%s: property is not allowed", propertyLocation))
+			}
+		case map[string]interface{}:
+			errs = append(errs, v.validate(additional, value[key], propertyLocation)...)
+		}
+	}
+	return errs
+}
+
+// matchesAny reports whether the value matches at least one of the schemas.
+func (v *validator) matchesAny(schemas []map[string]interface{}, value interface{}, location string) bool {
+	for _, schema := range schemas {
+		if len(v.validate(schema, value, location)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaList converts a list of schemas decoded from YAML.
+func schemaList(node interface{}) []map[string]interface{} {
+	list, _ := node.([]interface{})
+	schemas := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		if schema, ok := item.(map[string]interface{}); ok {
+			schemas = append(schemas, schema)
+		}
+	}
+	return schemas
+}
+
+// isWriteOnly reports whether a property schema is write only and therefore absent from responses.
+func isWriteOnly(spec *Spec, node interface{}) bool {
+	property, ok := node.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	property, err := spec.resolve(property)
+	if err != nil {
+		return false
+	}
+	writeOnly, _ := property["writeOnly"].(bool)
+	return writeOnly
+}
+
+// matchesType reports whether a decoded JSON value is of the given OpenAPI type.
+func matchesType(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(json.Number)
+		return ok
+	case "integer":
+		number, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := number.Int64()
+		return err == nil
+	default:
+		return true
+	}
+}
+
+// typeName returns the JSON type name of a decoded value for error messages.
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// containsValue reports whether a decoded JSON value is one of the enum values decoded from YAML.
+func containsValue(enum []interface{}, value interface{}) bool {
+	for _, candidate := range enum {
+		if fmt.Sprint(candidate) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,283 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package openapi loads the OpenAPI specifications of the server and validates API responses against them,
+// so that integration tests can detect drift between the documented contract and the live behavior.
+package openapi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// errorSchemaRef references the error envelope schema defined by the specs.
+const errorSchemaRef = "#/components/schemas/Error"
+
+// Spec is a parsed OpenAPI document.
+type Spec struct {
+	Name string
+	doc  map[string]interface{}
+}
+
+// Operation is an operation of a spec matched for a request.
+type Operation struct {
+	Spec       *Spec
+	Method     string
+	PathTmpl   string
+	definition map[string]interface{}
+}
+
+// Specs is the set of OpenAPI documents describing the server APIs.
+type Specs struct {
+	specs []*Spec
+}
+
+// DefaultSpecDir returns the directory holding the OpenAPI specifications in the repository.
+func DefaultSpecDir() string {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return filepath.Join("..", "..", "..", "api")
+	}
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "..", "api")
+}
+
+// LoadSpecs parses every YAML OpenAPI document in the given directory.
+func LoadSpecs(dir string) (*Specs, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OpenAPI specs in %s: %w", dir, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no OpenAPI specs found in %s", dir)
+	}
+	sort.Strings(files)
+
+	specs := &Specs{}
+	for _, file := range files {
+		content, err := os.ReadFile(file) // #nosec G304 -- spec files are read from the repository
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OpenAPI spec %s: %w", file, err)
+		}
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(content, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse OpenAPI spec %s: %w", file, err)
+		}
+		specs.specs = append(specs.specs, &Spec{Name: filepath.Base(file), doc: doc})
+	}
+	return specs, nil
+}
+
+// All returns the loaded specs.
+func (s *Specs) All() []*Spec {
+	return s.specs
+}
+
+// FindOperation returns the documented operation for the method and request path. Paths without templated
+// segments take precedence over templated ones, e.g. /users/me over /users/{id}.
+func (s *Specs) FindOperation(method, requestPath string) (*Operation, error) {
+	requestPath = strings.SplitN(requestPath, "?", 2)[0]
+	method = strings.ToLower(method)
+
+	var match *Operation
+	matchParams := -1
+	for _, spec := range s.specs {
+		paths, _ := spec.doc["paths"].(map[string]interface{})
+		for tmpl, item := range paths {
+			params, ok := matchPath(tmpl, requestPath)
+			if !ok {
+				continue
+			}
+			pathItem, _ := item.(map[string]interface{})
+			definition, ok := pathItem[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if match == nil || params < matchParams {
+				match = &Operation{Spec: spec, Method: method, PathTmpl: tmpl, definition: definition}
+				matchParams = params
+			}
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("operation %s %s is not documented", strings.ToUpper(method), requestPath)
+	}
+	return match, nil
+}
+
+// matchPath matches a request path against a path template and returns the number of templated segments.
+func matchPath(tmpl, requestPath string) (int, bool) {
+	tmplSegments := strings.Split(strings.Trim(tmpl, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(requestPath, "/"), "/")
+	if len(tmplSegments) != len(pathSegments) {
+		return 0, false
+	}
+	params := 0
+	for i, segment := range tmplSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return 0, false
+			}
+			params++
+			continue
+		}
+		if segment != pathSegments[i] {
+			return 0, false
+		}
+	}
+	return params, true
+}
+
+// ValidateResponse validates the status code and JSON body of a response against the operation. The status
+// code must be documented, either explicitly, as a range such as 4XX, or through a default response.
+func (o *Operation) ValidateResponse(statusCode int, contentType string, body []byte) error {
+	response, err := o.response(statusCode)
+	if err != nil {
+		return err
+	}
+
+	mediaType := strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0])
+	if !strings.HasSuffix(mediaType, "json") || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var schema map[string]interface{}
+	if content, ok := response["content"].(map[string]interface{}); ok && len(content) > 0 {
+		media, ok := content[mediaType].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: content type %q is not documented for status %d", o, mediaType, statusCode)
+		}
+		schema, _ = media["schema"].(map[string]interface{})
+	} else if statusCode >= http.StatusBadRequest {
+		// Error responses without a documented body must still use the error envelope of the API.
+		schema, _ = o.Spec.errorSchema()
+	}
+	if schema == nil {
+		return nil
+	}
+
+	value, err := decodeJSON(body)
+	if err != nil {
+		return fmt.Errorf("%s: response body is not valid JSON: %w", o, err)
+	}
+	if errs := newValidator(o.Spec).validate(schema, value, "$"); len(errs) > 0 {
+		return fmt.Errorf("%s: response for status %d does not match the schema:\n%w", o, statusCode,
+			errors.Join(errs...))
+	}
+	return nil
+}
+
+// response returns the documented response definition for the status code.
+func (o *Operation) response(statusCode int) (map[string]interface{}, error) {
+	responses, _ := o.definition["responses"].(map[string]interface{})
+	code := strconv.Itoa(statusCode)
+	for _, key := range []string{code, code[:1] + "XX", code[:1] + "xx", "default"} {
+		if response, ok := responses[key].(map[string]interface{}); ok {
+			resolved, err := o.Spec.resolve(response)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", o, err)
+			}
+			return resolved, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: status %d is not documented", o, statusCode)
+}
+
+// errorSchema returns the error envelope schema shared by the operations of the spec, if defined.
+func (s *Spec) errorSchema() (map[string]interface{}, bool) {
+	schema, err := s.lookup(errorSchemaRef)
+	return schema, err == nil
+}
+
+// String returns a readable identifier of the operation.
+func (o *Operation) String() string {
+	return fmt.Sprintf("%s %s (%s)", strings.ToUpper(o.Method), o.PathTmpl, o.Spec.Name)
+}
+
+// CheckReferences verifies that every local $ref in the spec resolves.
+func (s *Spec) CheckReferences() error {
+	var errs []error
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			if ref, ok := n["$ref"].(string); ok {
+				if _, err := s.lookup(ref); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			for _, value := range n {
+				walk(value)
+			}
+		case []interface{}:
+			for _, value := range n {
+				walk(value)
+			}
+		}
+	}
+	walk(s.doc)
+	return errors.Join(errs...)
+}
+
+// resolve follows the $ref of a node, if any, and returns the referenced definition.
+func (s *Spec) resolve(node map[string]interface{}) (map[string]interface{}, error) {
+	for depth := 0; depth < maxRefDepth; depth++ {
+		ref, ok := node["$ref"].(string)
+		if !ok {
+			return node, nil
+		}
+		target, err := s.lookup(ref)
+		if err != nil {
+			return nil, err
+		}
+		node = target
+	}
+	return nil, fmt.Errorf("reference chain is too deep in %s", s.Name)
+}
+
+// lookup resolves a local JSON pointer reference such as #/components/schemas/User.
+func (s *Spec) lookup(ref string) (map[string]interface{}, error) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("unsupported reference %q in %s", ref, s.Name)
+	}
+	var node interface{} = s.doc
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		parent, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unresolved reference %q in %s", ref, s.Name)
+		}
+		if node, ok = parent[token]; !ok {
+			return nil, fmt.Errorf("unresolved reference %q in %s", ref, s.Name)
+		}
+	}
+	target, ok := node.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("reference %q in %s does not point to an object", ref, s.Name)
+	}
+	return target, nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package testutils
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+)
+
+const (
+	// PostgresComposeFilePath is the docker compose file that provisions the PostgreSQL test database.
+	PostgresComposeFilePath = "./resources/postgres/docker-compose.yml"
+	postgresComposeProject  = "thunderid-integration-tests"
+)
+
+// StartPostgres provisions a fresh PostgreSQL container with the product schemas using docker compose and
+// waits until it accepts connections. Any container left behind by a previous run is removed first so
+// that every run starts with empty databases.
+func StartPostgres() error {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return fmt.Errorf("docker CLI not found in PATH: please install docker to provision PostgreSQL")
+	}
+
+	log.Println("Provisioning PostgreSQL with docker compose...")
+	if err := runCompose("down", "--volumes", "--remove-orphans"); err != nil {
+		return fmt.Errorf("failed to remove the existing PostgreSQL container: %v", err)
+	}
+	if err := runCompose("up", "--detach", "--wait"); err != nil {
+		return fmt.Errorf("failed to start the PostgreSQL container: %v", err)
+	}
+
+	log.Println("PostgreSQL is ready")
+	return nil
+}
+
+// StopPostgres removes the PostgreSQL container and its volumes provisioned by StartPostgres.
+func StopPostgres() {
+	log.Println("Stopping PostgreSQL...")
+	if err := runCompose("down", "--volumes", "--remove-orphans"); err != nil {
+		log.Printf("Failed to stop the PostgreSQL container: %v", err)
+	}
+}
+
+// runCompose runs a docker compose command against the PostgreSQL compose project.
+func runCompose(args ...string) error {
+	cmdArgs := append([]string{"compose", "-f", PostgresComposeFilePath, "-p", postgresComposeProject}, args...)
+	cmd := exec.Command("docker", cmdArgs...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
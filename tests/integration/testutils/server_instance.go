@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package testutils
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultServerPort is the port of the server under test when the suites are not split across
+	// parallel server instances.
+	DefaultServerPort = "8095"
+
+	// ServerPortEnvVar overrides the port of the server under test.
+	ServerPortEnvVar = "SERVER_PORT"
+	// MockPortOffsetEnvVar shifts the ports of the mock servers so that parallel server instances do not
+	// compete for the same ports.
+	MockPortOffsetEnvVar = "MOCK_PORT_OFFSET"
+	// ExtractDirEnvVar overrides the directory the product is extracted to, so that every parallel server
+	// instance runs from its own copy with its own databases.
+	ExtractDirEnvVar = "SERVER_EXTRACT_DIR"
+)
+
+// GetServerPortFromEnv returns the port of the server under test.
+func GetServerPortFromEnv() string {
+	if port := os.Getenv(ServerPortEnvVar); port != "" {
+		return port
+	}
+	return DefaultServerPort
+}
+
+// MockPort returns the port a mock server should listen on for the given base port. The base port is
+// shifted by the offset assigned to the server instance the suite runs against.
+func MockPort(basePort int) int {
+	offset, err := strconv.Atoi(os.Getenv(MockPortOffsetEnvVar))
+	if err != nil {
+		return basePort
+	}
+	return basePort + offset
+}
+
+// GetFreePort returns a port that is currently free on the loopback interface.
+func GetFreePort() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer listener.Close()
+	return strconv.Itoa(listener.Addr().(*net.TCPAddr).Port), nil
+}
+
+// getExtractedDir returns the directory the product is extracted to.
+func getExtractedDir() string {
+	if dir := os.Getenv(ExtractDirEnvVar); dir != "" {
+		return dir
+	}
+	return ExtractedDir
+}
+
+// copyDeploymentConfig copies a deployment.yaml and points it at the port of the server under test.
+func copyDeploymentConfig(src, dest string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	if port := GetServerPortFromEnv(); port != DefaultServerPort {
+		content := strings.ReplaceAll(string(data), "port: "+DefaultServerPort, "port: "+port)
+		content = strings.ReplaceAll(content, "localhost:"+DefaultServerPort, "localhost:"+port)
+		data = []byte(content)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(dest, data, 0644)
+}
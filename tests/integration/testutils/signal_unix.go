@@ -21,6 +21,8 @@
 package testutils
 
 import (
+	"bytes"
+	"fmt"
 	"os"
 	"syscall"
 )
@@ -33,7 +35,21 @@ func sendStopSignal(process *os.Process) error {
 // isProcessAlive reports whether the process identified by proc is still running.
 // On Unix the null signal (signal 0) is used: syscall.Kill returns ESRCH when the
 // PID no longer exists, which also protects against accidentally killing a recycled
-// PID after a grace-period sleep.
+// PID after a grace-period sleep. An exited process that has not been reaped by its
+// parent yet (a zombie) still accepts the null signal, so on Linux its state is read
+// from /proc as well.
 func isProcessAlive(proc *os.Process) bool {
-	return proc.Signal(syscall.Signal(0)) == nil
+	if proc.Signal(syscall.Signal(0)) != nil {
+		return false
+	}
+
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", proc.Pid))
+	if err != nil {
+		return true
+	}
+	// The state follows the parenthesized command name, which may itself contain spaces.
+	if idx := bytes.LastIndexByte(stat, ')'); idx != -1 && idx+2 < len(stat) {
+		return stat[idx+2] != 'Z'
+	}
+	return true
 }
@@ -42,6 +42,8 @@ const (
 	DefaultConfigJSONPath       = "../../backend/cmd/server/repository/resources/conf/default.json"
 	TestDatabaseSchemaDirectory = "resources/dbscripts"
 	DatabaseFileBasePath        = "repository/database/"
+
+	serverLogTailLines = 50
 )
 
 // ServerBinary is the name of the server binary, platform-dependent.
@@ -61,6 +63,7 @@ var (
 	zipFilePattern       string
 	extractedProductHome string
 	serverCmd            *exec.Cmd
+	serverLogFile        *os.File
 	serverPid            int
 	isInitialized        bool
 	subprocessMode       bool
@@ -149,7 +152,7 @@ func UnzipProduct() error {
 	}
 	defer r.Close()
 
-	if err := os.MkdirAll(ExtractedDir, os.ModePerm); err != nil {
+	if err := os.MkdirAll(getExtractedDir(), os.ModePerm); err != nil {
 		return fmt.Errorf("failed to create extraction directory: %v", err)
 	}
 
@@ -168,10 +171,10 @@ func UnzipProduct() error {
 		}
 	}
 
-	extractDir := ExtractedDir
+	extractDir := getExtractedDir()
 	if !hasRootDir {
 		// Zip entries don't have the root directory prefix; extract into a subdirectory
-		extractDir = filepath.Join(ExtractedDir, filepath.Base(zipFile[:len(zipFile)-4]))
+		extractDir = filepath.Join(getExtractedDir(), filepath.Base(zipFile[:len(zipFile)-4]))
 		if err := os.MkdirAll(extractDir, os.ModePerm); err != nil {
 			return fmt.Errorf("failed to create extraction subdirectory: %v", err)
 		}
@@ -241,7 +244,7 @@ func getExtractedProductHome() (string, error) {
 	}
 	zipFile := files[0]
 
-	return filepath.Join(ExtractedDir, filepath.Base(zipFile[:len(zipFile)-4])), nil
+	return filepath.Join(getExtractedDir(), filepath.Base(zipFile[:len(zipFile)-4])), nil
 }
 
 // findMatchingZipFile finds zip files that match our specific version pattern criteria
@@ -373,7 +376,7 @@ func ReplaceResources(zipFilePattern string) error {
 		return fmt.Errorf("failed to create conf directory: %v", err)
 	}
 
-	err = copyFile(TestDeploymentYamlPath, destPath)
+	err = copyDeploymentConfig(TestDeploymentYamlPath, destPath)
 	if err != nil {
 		return fmt.Errorf("failed to replace deployment.yaml: %v", err)
 	}
@@ -511,7 +514,7 @@ func RunInitScript(zipFilePattern string) error {
 
 	// Skip database initialization for PostgreSQL
 	if dbType == "postgres" {
-		log.Println("Skipping database initialization for PostgreSQL (already initialized in workflow)")
+		log.Println("Skipping database initialization for PostgreSQL (initialized with the database server)")
 		return nil
 	}
 
@@ -659,7 +662,7 @@ func StartServer(port string, zipFilePattern string) error {
 		// drain before declaring the test done, and the long-lived server process
 		// would keep those pipes open indefinitely, causing a 60s WaitDelay timeout.
 		// Redirect to a log file in the extracted product home so output is not lost.
-		logPath := filepath.Join(extractedProductHome, "thunderid-restart.log")
+		logPath := serverLogPath()
 		var openErr error
 		logFile, openErr = os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
 		if openErr != nil {
@@ -671,8 +674,18 @@ func StartServer(port string, zipFilePattern string) error {
 			cmd.Stderr = logFile
 		}
 	} else {
+		// Stream the output and keep a copy in the extracted product home, so that the log can be
+		// reported when the server does not become ready.
 		cmd.Stdout = os.Stdout
 		cmd.Stderr = os.Stderr
+		var openErr error
+		serverLogFile, openErr = os.Create(serverLogPath())
+		if openErr != nil {
+			log.Printf("Warning: could not create server log file: %v", openErr)
+		} else {
+			cmd.Stdout = io.MultiWriter(os.Stdout, serverLogFile)
+			cmd.Stderr = cmd.Stdout
+		}
 	}
 
 	// Preserve GOCOVERDIR environment variable for coverage collection
@@ -725,7 +738,7 @@ func StopServer() {
 				// Process exited gracefully
 				// Give a brief moment for coverage files to be fully flushed to disk
 				time.Sleep(100 * time.Millisecond)
-			case <-time.After(3 * time.Second):
+			case <-time.After(serverStopTimeout()):
 				// Timeout - force kill
 				log.Println("Server did not stop gracefully, forcing kill...")
 				serverCmd.Process.Kill()
@@ -745,8 +758,7 @@ func StopServer() {
 				// We cannot call proc.Wait() for a process we did not fork,
 				// so we give the server a grace period and then ensure it is dead.
 				// Check liveness before killing to avoid hitting a recycled PID.
-				time.Sleep(3 * time.Second)
-				if isProcessAlive(proc) {
+				if !waitForProcessExit(proc, serverStopTimeout()) {
 					proc.Kill()
 				}
 			}
@@ -758,6 +770,10 @@ func StopServer() {
 
 	serverCmd = nil
 	serverPid = 0
+	if serverLogFile != nil {
+		serverLogFile.Close()
+		serverLogFile = nil
+	}
 
 	// Kill any residual the server process that may have been started by a test
 	// subprocess (e.g. after a config swap) whose PID is different from the one
@@ -766,8 +782,7 @@ func StopServer() {
 	if residualPid := readPidFile(); residualPid != 0 {
 		if proc, err := os.FindProcess(residualPid); err == nil && proc != nil {
 			_ = sendStopSignal(proc)
-			time.Sleep(2 * time.Second)
-			if isProcessAlive(proc) {
+			if !waitForProcessExit(proc, serverStopTimeout()) {
 				_ = proc.Kill()
 			}
 		}
@@ -775,6 +790,52 @@ func StopServer() {
 	}
 }
 
+// serverStopTimeout returns how long the server is given to exit after the stop signal before it is
+// killed. A server built with coverage instrumentation writes its coverage counters to GOCOVERDIR only
+// when it exits gracefully, so it is given more time when coverage is collected. On Windows the server is
+// always killed, so there is nothing to wait for.
+func serverStopTimeout() time.Duration {
+	if os.Getenv("GOCOVERDIR") != "" && runtime.GOOS != "windows" {
+		return 30 * time.Second
+	}
+	return 3 * time.Second
+}
+
+// waitForProcessExit polls until the process exits or the timeout is exceeded. It reports whether the
+// process exited.
+func waitForProcessExit(proc *os.Process, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if !isProcessAlive(proc) {
+			return true
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return !isProcessAlive(proc)
+}
+
+// ReportCoverageData logs the coverage data collected from the server in GOCOVERDIR. The coverage
+// counters are written when the server shuts down, so it must be called after the server is stopped.
+func ReportCoverageData() {
+	coverDir := os.Getenv("GOCOVERDIR")
+	if coverDir == "" {
+		return
+	}
+
+	metaFiles, _ := filepath.Glob(filepath.Join(coverDir, "covmeta.*"))
+	counterFiles, _ := filepath.Glob(filepath.Join(coverDir, "covcounters.*"))
+	switch {
+	case len(metaFiles) == 0:
+		log.Printf("Warning: no coverage data found in %s. Build the server with coverage instrumentation "+
+			"(ENABLE_COVERAGE=true) to collect integration test coverage.", coverDir)
+	case len(counterFiles) == 0:
+		log.Printf("Warning: no coverage counters found in %s. The server did not shut down gracefully.",
+			coverDir)
+	default:
+		log.Printf("Collected %d coverage counter file(s) from the server in %s", len(counterFiles), coverDir)
+	}
+}
+
 // RestartServer stops the current server and starts a new one with the same configuration.
 func RestartServer() error {
 	ensureInitialized()
@@ -792,22 +853,23 @@ func RestartServer() error {
 		return fmt.Errorf("failed to restart server: %v", err)
 	}
 
-	if err := waitForServerReady(30 * time.Second); err != nil {
+	if err := WaitForServerReady(30 * time.Second); err != nil {
 		return fmt.Errorf("server did not become ready after restart: %w", err)
 	}
 
 	return nil
 }
 
-// waitForServerReady polls the server's health endpoint until it responds with a 2xx
-// status or the timeout is exceeded. A polling interval of 500ms is used.
-func waitForServerReady(timeout time.Duration) error {
-	healthURL := "https://localhost:" + serverPort + "/health/liveness"
+// WaitForServerReady polls the server's readiness endpoint until it responds with a 2xx
+// status or the timeout is exceeded. A polling interval of 500ms is used. When the server
+// does not become ready, the error includes the tail of the server log.
+func WaitForServerReady(timeout time.Duration) error {
+	readinessURL := "https://localhost:" + serverPort + "/health/readiness"
 	client := GetHTTPClient()
 	deadline := time.Now().Add(timeout)
 
 	for time.Now().Before(deadline) {
-		resp, err := client.Get(healthURL)
+		resp, err := client.Get(readinessURL)
 		if err == nil {
 			resp.Body.Close()
 			if resp.StatusCode >= 200 && resp.StatusCode < 300 {
@@ -818,7 +880,30 @@ func waitForServerReady(timeout time.Duration) error {
 		time.Sleep(500 * time.Millisecond)
 	}
 
-	return fmt.Errorf("server did not become ready within %s", timeout)
+	return fmt.Errorf("server did not become ready within %s\n%s", timeout, readServerLogTail(serverLogTailLines))
+}
+
+// serverLogPath returns the path of the log file the server output is written to.
+func serverLogPath() string {
+	if subprocessMode {
+		return filepath.Join(extractedProductHome, "thunderid-restart.log")
+	}
+	return filepath.Join(extractedProductHome, "thunderid.log")
+}
+
+// readServerLogTail returns the last lines of the server log for diagnosing startup failures.
+func readServerLogTail(lines int) string {
+	data, err := os.ReadFile(serverLogPath())
+	if err != nil {
+		return fmt.Sprintf("server log is not available: %v", err)
+	}
+
+	logLines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(logLines) > lines {
+		logLines = logLines[len(logLines)-lines:]
+	}
+	return fmt.Sprintf("last %d lines of the server log (%s):\n%s", len(logLines), serverLogPath(),
+		strings.Join(logLines, "\n"))
 }
 
 // UpdateDeploymentConfig overwrites the extracted product's deployment.yaml with the
@@ -832,7 +917,7 @@ func UpdateDeploymentConfig(srcPath string) error {
 		return fmt.Errorf("failed to ensure conf directory exists: %w", err)
 	}
 
-	if err := copyFile(srcPath, destPath); err != nil {
+	if err := copyDeploymentConfig(srcPath, destPath); err != nil {
 		return fmt.Errorf("failed to update deployment.yaml from %s: %w", srcPath, err)
 	}
 
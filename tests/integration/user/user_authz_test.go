@@ -73,8 +73,6 @@ type UserAuthzTestSuite struct {
 }
 
 const (
-	userAuthzServerURL = "https://localhost:8095"
-
 	userAuthzOU1Handle = "authz-user-ou1"
 	userAuthzOU2Handle = "authz-user-ou2"
 
@@ -84,8 +82,12 @@ const (
 	entityTypeOU1Name = "authz-user-type-ou1"
 	entityTypeOU2Name = "authz-user-type-ou2"
 
-	userAuthzDevelopClientID    = "CONSOLE"
-	userAuthzDevelopRedirectURI = "https://localhost:8095/console"
+	userAuthzDevelopClientID = "CONSOLE"
+)
+
+var (
+	userAuthzServerURL          = testutils.TestServerURL
+	userAuthzDevelopRedirectURI = testutils.TestServerURL + "/console"
 )
 
 func TestUserAuthzTestSuite(t *testing.T) {
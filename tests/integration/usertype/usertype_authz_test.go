@@ -82,8 +82,6 @@ type UserTypeAuthzTestSuite struct {
 }
 
 const (
-	authzSchemaTestServerURL = testutils.TestServerURL
-
 	schemaAuthzOU1Handle  = "schema-authz-ou1"
 	schemaAuthzOU2Handle  = "schema-authz-ou2"
 	schemaAuthzOU12Handle = "schema-authz-ou12"
@@ -91,13 +89,17 @@ const (
 	schemaAdminUsername = "schema-authz-admin"
 	schemaAdminPassword = "SchemaAdmin@123"
 
-	schemaAuthzDevelopClientID    = "CONSOLE"
-	schemaAuthzDevelopRedirectURI = "https://localhost:8095/console"
+	schemaAuthzDevelopClientID = "CONSOLE"
 
 	// Unique role name to avoid collisions across test runs.
 	schemaAdminRoleName = "User Type Admin (authz-test)"
 )
 
+var (
+	authzSchemaTestServerURL      = testutils.TestServerURL
+	schemaAuthzDevelopRedirectURI = testutils.TestServerURL + "/console"
+)
+
 // schemaAuthzUserTypeID persists the user type ID used to create the test
 // user across SetupSuite/TearDownSuite.
 var schemaAuthzUserTypeID string